@@ -37,14 +37,23 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/configascode"
 	"github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/faultinjection"
+	"github.com/thunder-id/thunderid/internal/system/grpcapi"
+	"github.com/thunder-id/thunderid/internal/system/idempotency"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/maintenance"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/mockidp"
+	"github.com/thunder-id/thunderid/internal/system/netaccess"
 	"github.com/thunder-id/thunderid/internal/system/revocationcache"
 	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/securityheaders"
+	"github.com/thunder-id/thunderid/internal/system/sysinfo"
 )
 
 // shutdownTimeout defines the timeout duration for graceful shutdown.
@@ -55,6 +64,18 @@ var (
 	tlsListen = tls.Listen
 )
 
+// Configuration files, relative to the server home. They are read at startup and on every reload.
+const (
+	deploymentConfigFile = "deployment.yaml"
+	defaultConfigFile    = "config/default.json"
+)
+
+// Build metadata stamped at link time via -ldflags "-X main.version=... -X main.buildDate=...".
+var (
+	version   string
+	buildDate string
+)
+
 func main() {
 	// Server bootstrap/shutdown logging has no request scope, so context.Background() is used.
 	ctx := context.Background()
@@ -62,6 +83,7 @@ func main() {
 	logger := log.GetLogger()
 
 	flag.String("resources", "", "Path to declarative resources YAML file")
+	validate := flag.Bool(validateFlag, false, "Check the deployment configuration and exit")
 	serverHome := getThunderHome(ctx, logger)
 
 	cfg := initThunderConfigurations(ctx, logger, serverHome)
@@ -88,6 +110,12 @@ func main() {
 	// Initialize system permission strings before any service or middleware uses them.
 	security.InitSystemPermissions(cfg.Server.SecurityConfig.SystemPermissionPrefix)
 
+	// Activate fault injection before any database, executor or outbound HTTP call is made.
+	configureFaultInjection(ctx, logger, cfg)
+
+	// Check the deployment configuration before any service opens a database or key file.
+	runStartupChecks(ctx, logger)
+
 	// Create a new HTTP multiplexer.
 	mux := http.NewServeMux()
 	if mux == nil {
@@ -95,7 +123,19 @@ func main() {
 	}
 
 	// Register the services.
-	jwtService, runtimeCryptoSvc, importService := registerServices(mux, cacheManager)
+	jwtService, runtimeCryptoSvc, importService := registerServices(mux, cacheManager,
+		sysinfo.BuildInfo{Version: version, BuildDate: buildDate})
+
+	// When invoked with --validate, run the checks that need the initialized services and exit without
+	// starting the HTTP server.
+	if *validate {
+		if err := runValidate(ctx, logger, cacheManager); err != nil {
+			logger.Error(ctx, "Deployment configuration check failed", log.Error(err))
+			os.Exit(1)
+		}
+		logger.Info(ctx, "Deployment configuration check passed")
+		return
+	}
 
 	// When invoked as the bootstrap one-shot (`thunderid bootstrap`), create the
 	// default resources in-process and exit without starting the HTTP server.
@@ -108,6 +148,19 @@ func main() {
 		return
 	}
 
+	// When invoked as `thunderid reencrypt`, encrypt the stored secrets again with the current
+	// encryption key and exit without starting the HTTP server.
+	if isReencryptInvocation() {
+		if err := runReencrypt(ctx, logger, idpSecretReencrypter, cacheManager); err != nil {
+			logger.Error(ctx, "Secret re-encryption failed; exiting", log.Error(err))
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Reconcile the configuration-as-code directory before the server accepts requests.
+	reconcileConfigAsCode(ctx, logger, cfg, serverHome)
+
 	// Initialize the Resource Server token-revocation cache. The initial deny-list snapshot is loaded
 	// synchronously so enforcement is live before the first request; if that load fails the server
 	// still starts and the syncer repopulates the cache on its next tick.
@@ -117,21 +170,38 @@ func main() {
 	// Register static file handlers for frontend applications.
 	registerStaticFileHandlers(ctx, logger, mux, serverHome)
 
+	// Start the embedded mock identity provider on its own listener when it is enabled.
+	mockIdP := startMockIdP(ctx, logger, cfg)
+
 	// Setup signal handling for graceful shutdown
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
+	// Reload the reloadable configuration settings on SIGHUP.
+	reloadChan := make(chan os.Signal, 1)
+	signal.Notify(reloadChan, syscall.SIGHUP)
+	go handleReloadSignals(ctx, logger, reloadChan)
+
 	// Create the HTTP server.
 	server := createHTTPServer(ctx, logger, cfg, mux, jwtService, revocationEnforcer)
 	var ln net.Listener
+	var tlsConfig *tls.Config
 	if cfg.Server.HTTPOnly {
 		logger.Info(ctx, "TLS is not enabled, starting server without TLS")
 		ln = createListener(ctx, logger, server)
 	} else {
-		tlsConfig := loadCertConfig(ctx, logger, runtimeCryptoSvc)
+		tlsConfig = loadCertConfig(ctx, logger, runtimeCryptoSvc)
+		if cfg.CertificateAuth.Enabled {
+			// Ask for, but do not require or verify, a client certificate. Certificate authentication
+			// validates it against its own CAs, so clients without a certificate are still served.
+			tlsConfig.ClientAuth = tls.RequestClientCert
+		}
 		ln = createTLSListener(ctx, logger, server, tlsConfig)
 	}
 
+	// Start the gRPC management API on its own listener when it is enabled.
+	grpcServer := startGRPCServer(ctx, logger, cfg, server.Handler, tlsConfig)
+
 	serverURL := config.GetServerURL(&cfg.Server)
 	consoleURL := fmt.Sprintf("%s/console", strings.TrimSuffix(serverURL, "/"))
 	logger.Info(ctx, "ThunderID Server URL", log.String("url", serverURL))
@@ -149,7 +219,35 @@ func main() {
 	// Wait for shutdown signal
 	<-sigChan
 	logger.Info(ctx, "Shutting down server...")
-	gracefulShutdown(ctx, logger, server, cacheManager, revocationSyncer)
+	gracefulShutdown(ctx, logger, server, grpcServer, cacheManager, revocationSyncer, mockIdP)
+}
+
+// handleReloadSignals reloads the configuration for every signal received. A failed reload is logged by
+// the reload service and leaves the running configuration unchanged.
+func handleReloadSignals(ctx context.Context, logger *log.Logger, reloadChan <-chan os.Signal) {
+	for range reloadChan {
+		logger.Info(ctx, "Received SIGHUP, reloading configuration")
+		_, _ = configReloader.Reload(ctx)
+	}
+}
+
+// reconcileConfigAsCode applies the configured configuration-as-code directory. A directory that cannot
+// be applied fails startup, so a broken configuration never serves traffic.
+func reconcileConfigAsCode(ctx context.Context, logger *log.Logger, cfg *config.Config, serverHome string) {
+	if !cfg.ConfigAsCode.Enabled {
+		return
+	}
+
+	directory := cfg.ConfigAsCode.Directory
+	if !filepath.IsAbs(directory) {
+		directory = filepath.Join(serverHome, directory)
+	}
+	if err := configReconciler.Reconcile(ctx, configascode.Options{
+		Directory: directory,
+		Prune:     cfg.ConfigAsCode.Prune,
+	}); err != nil {
+		logger.Fatal(ctx, "Failed to reconcile configuration-as-code resources", log.Error(err))
+	}
 }
 
 // initRevocationCache builds the Resource Server token-revocation enforcer and its background syncer
@@ -169,6 +267,66 @@ func initRevocationCache(ctx context.Context, logger *log.Logger,
 	return enforcer, syncer
 }
 
+// startMockIdP starts the embedded mock OpenID Connect provider when it is enabled. A provider that
+// cannot be initialized or bound fails startup.
+func startMockIdP(ctx context.Context, logger *log.Logger, cfg *config.Config) mockidp.Server {
+	mc := cfg.MockIdP
+	server, err := mockidp.Initialize(mockidp.Config{
+		Enabled:      mc.Enabled,
+		Address:      fmt.Sprintf("%s:%d", mc.Hostname, mc.Port),
+		ClientID:     mc.ClientID,
+		ClientSecret: mc.ClientSecret,
+		Claims:       mc.Claims,
+	})
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize mock identity provider", log.Error(err))
+	}
+	if err := server.Start(ctx); err != nil {
+		logger.Fatal(ctx, "Failed to start mock identity provider", log.Error(err))
+	}
+	return server
+}
+
+// startGRPCServer starts the gRPC management API when it is enabled. Calls are served by the REST
+// handler chain and share the TLS configuration of the HTTP server. A server that cannot be initialized
+// or bound fails startup.
+func startGRPCServer(ctx context.Context, logger *log.Logger, cfg *config.Config, handler http.Handler,
+	tlsConfig *tls.Config) grpcapi.Server {
+	gc := cfg.GRPC
+	server, err := grpcapi.Initialize(grpcapi.Config{
+		Enabled:   gc.Enabled,
+		Address:   fmt.Sprintf("%s:%d", gc.Hostname, gc.Port),
+		TLSConfig: tlsConfig,
+		Handler:   handler,
+	})
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize gRPC server", log.Error(err))
+	}
+	if err := server.Start(ctx); err != nil {
+		logger.Fatal(ctx, "Failed to start gRPC server", log.Error(err))
+	}
+	return server
+}
+
+// configureFaultInjection activates the configured fault injection rules. An invalid rule fails
+// startup.
+func configureFaultInjection(ctx context.Context, logger *log.Logger, cfg *config.Config) {
+	fc := cfg.FaultInjection
+	rules := make([]faultinjection.Rule, 0, len(fc.Rules))
+	for _, rule := range fc.Rules {
+		rules = append(rules, faultinjection.Rule{
+			Target:      faultinjection.Target(rule.Target),
+			Match:       rule.Match,
+			Probability: rule.Probability,
+			Latency:     time.Duration(rule.LatencyMs) * time.Millisecond,
+			Fail:        rule.Error,
+		})
+	}
+	if err := faultinjection.Initialize(faultinjection.Config{Enabled: fc.Enabled, Rules: rules}); err != nil {
+		logger.Fatal(ctx, "Failed to initialize fault injection", log.Error(err))
+	}
+}
+
 // getThunderHome retrieves and return the home directory.
 func getThunderHome(ctx context.Context, logger *log.Logger) string {
 	// Parse project directory from command line arguments.
@@ -195,9 +353,8 @@ func getThunderHome(ctx context.Context, logger *log.Logger) string {
 // initThunderConfigurations initializes the configurations.
 func initThunderConfigurations(ctx context.Context, logger *log.Logger, serverHome string) *config.Config {
 	// Load the configurations.
-	configFilePath := path.Join(serverHome, "deployment.yaml")
-	defaultConfigPath := path.Join(serverHome, "config/default.json")
-	cfg, err := config.LoadConfig(configFilePath, defaultConfigPath, serverHome)
+	cfg, err := config.LoadConfig(path.Join(serverHome, deploymentConfigFile),
+		path.Join(serverHome, defaultConfigFile), serverHome)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to load configurations", log.Error(err))
 	}
@@ -226,13 +383,27 @@ func loadCertConfig(ctx context.Context, logger *log.Logger, runtimeSvc kmprovid
 // createHTTPServer creates and configures an HTTP server with common settings.
 func createHTTPServer(ctx context.Context, logger *log.Logger, cfg *config.Config, mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface, revocationEnforcer revocationcache.EnforcerInterface) *http.Server {
-	securityMiddleware := createSecurityMiddleware(ctx, logger, mux, jwtService, revocationEnforcer,
-		cfg.Server.SecurityConfig.DirectAuthSecret)
+	idempotencyMiddleware := createIdempotencyMiddleware(ctx, logger, cfg, mux)
+	securityMiddleware := createSecurityMiddleware(ctx, logger, idempotencyMiddleware, jwtService,
+		revocationEnforcer, cfg.Server.SecurityConfig.DirectAuthSecret)
+
+	maintenanceMiddleware := createMaintenanceMiddleware(ctx, logger, cfg, securityMiddleware)
+	clientIPMiddleware, networkAccessGuard := createNetworkAccessMiddlewares(ctx, logger, cfg)
+	networkAccessMiddleware := networkAccessGuard(maintenanceMiddleware)
+
+	// Count API calls ahead of the access checks so rejected requests are metered too.
+	var routedHandler http.Handler = networkAccessMiddleware
+	if usageMeter != nil {
+		routedHandler = usageMeter.Middleware(routedHandler)
+	}
 
 	// Build the middleware chain with proper execution order.
-	// Request flow: CorrelationID (outermost) -> AccessLog -> Security -> Route Handler (innermost)
+	// Request flow: CorrelationID (outermost) -> ClientIP -> SecurityHeaders -> AccessLog -> UsageMeter ->
+	// NetworkAccess -> Maintenance -> Security -> Idempotency -> Route Handler (innermost)
 	// Note: Middlewares are wrapped in reverse order - the last added will execute first.
-	handler := log.AccessLogHandler(logger, securityMiddleware)
+	handler := log.AccessLogHandler(logger, routedHandler)
+	handler = createSecurityHeadersMiddleware(ctx, logger, cfg, handler)
+	handler = clientIPMiddleware(handler)
 	handler = middleware.CorrelationIDMiddleware(handler)
 
 	// Build the server address using hostname and port from the configurations.
@@ -269,14 +440,99 @@ func createTLSListener(ctx context.Context, logger *log.Logger, server *http.Ser
 	return ln
 }
 
-func createSecurityMiddleware(ctx context.Context, logger *log.Logger, mux *http.ServeMux,
+func createSecurityMiddleware(ctx context.Context, logger *log.Logger, next http.Handler,
 	jwtService jwt.JWTServiceInterface, revocationEnforcer revocationcache.EnforcerInterface,
 	directAuthSecret string) http.Handler {
 	middlewareFunc, err := security.Initialize(jwtService, revocationEnforcer, directAuthSecret)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize security middleware", log.Error(err))
 	}
-	return middlewareFunc(mux)
+	return middlewareFunc(next)
+}
+
+// createIdempotencyMiddleware wraps next with the Idempotency-Key handling of the create endpoints.
+// It runs after the security middleware so idempotency keys are scoped to the authenticated caller.
+func createIdempotencyMiddleware(ctx context.Context, logger *log.Logger, cfg *config.Config,
+	next http.Handler) http.Handler {
+	ic := cfg.Server.Idempotency
+	middlewareFunc, err := idempotency.Initialize(idempotency.Config{
+		Enabled:   ic.Enabled && idempotencyStore != nil,
+		TTL:       time.Duration(ic.TTLSeconds) * time.Second,
+		Endpoints: ic.Endpoints,
+	}, idempotencyStore)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize idempotency middleware", log.Error(err))
+	}
+	return middlewareFunc(next)
+}
+
+// createMaintenanceMiddleware wraps next with the maintenance read-only and disabled-endpoint switches
+// from the server maintenance configuration.
+func createMaintenanceMiddleware(ctx context.Context, logger *log.Logger, cfg *config.Config,
+	next http.Handler) http.Handler {
+	mc := cfg.Server.Maintenance
+	middlewareFunc, err := maintenance.Initialize(maintenance.Config{
+		ReadOnly:           mc.ReadOnly,
+		AllowTokenIssuance: mc.AllowTokenIssuance,
+		AllowedPaths:       mc.AllowedPaths,
+		DisabledEndpoints:  mc.DisabledEndpoints,
+		RetryAfter:         time.Duration(mc.RetryAfterSeconds) * time.Second,
+	})
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize maintenance middleware", log.Error(err))
+	}
+	if mc.ReadOnly {
+		logger.Warn(ctx, "Server is running in read-only maintenance mode")
+	}
+	return middlewareFunc(next)
+}
+
+// createNetworkAccessMiddlewares builds the client IP resolution and admin network restriction
+// middlewares configured under server.network_access.
+func createNetworkAccessMiddlewares(ctx context.Context, logger *log.Logger, cfg *config.Config) (
+	clientIP, guard func(http.Handler) http.Handler) {
+	nc := cfg.Server.NetworkAccess
+	clientIP, guard, err := netaccess.Initialize(netaccess.Config{
+		AdminNetworks:    nc.AdminNetworks,
+		AdminExemptPaths: nc.AdminExemptPaths,
+		TrustedProxies:   nc.TrustedProxies,
+		ClientIPHeaders:  nc.ClientIPHeaders,
+	})
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize network access middleware", log.Error(err))
+	}
+	if len(nc.AdminNetworks) > 0 {
+		logger.Info(ctx, "Management endpoints are restricted to the admin networks",
+			log.Int("networks", len(nc.AdminNetworks)))
+	}
+	return clientIP, guard
+}
+
+// createSecurityHeadersMiddleware wraps next with the browser security headers configured under
+// server.security_headers.
+func createSecurityHeadersMiddleware(ctx context.Context, logger *log.Logger, cfg *config.Config,
+	next http.Handler) http.Handler {
+	hc := cfg.Server.SecurityHeaders
+	overrides := make([]securityheaders.Override, 0, len(hc.Overrides))
+	for _, o := range hc.Overrides {
+		overrides = append(overrides, securityheaders.Override{
+			Paths:                 o.Paths,
+			ContentSecurityPolicy: o.ContentSecurityPolicy,
+			ReferrerPolicy:        o.ReferrerPolicy,
+		})
+	}
+	middlewareFunc, err := securityheaders.Initialize(securityheaders.Config{
+		Enabled:               hc.Enabled,
+		HSTSMaxAgeSeconds:     hc.HSTSMaxAgeSeconds,
+		HSTSIncludeSubdomains: hc.HSTSIncludeSubdomains,
+		ContentSecurityPolicy: hc.ContentSecurityPolicy,
+		ReferrerPolicy:        hc.ReferrerPolicy,
+		Overrides:             overrides,
+	})
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize security headers middleware", log.Error(err))
+	}
+	return middlewareFunc(next)
 }
 
 // gracefulShutdown handles the graceful shutdown of all components.
@@ -284,8 +540,10 @@ func gracefulShutdown(
 	ctx context.Context,
 	logger *log.Logger,
 	server *http.Server,
+	grpcServer grpcapi.Server,
 	cacheManager cache.CacheManagerInterface,
 	revocationSyncer revocationcache.Syncer,
+	mockIdP mockidp.Server,
 ) {
 	ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
 	defer cancel()
@@ -297,9 +555,19 @@ func gracefulShutdown(
 		logger.Debug(ctx, "HTTP server shutdown completed")
 	}
 
+	// Shutdown the gRPC server
+	if err := grpcServer.Stop(ctx); err != nil {
+		logger.Error(ctx, "Error during gRPC server shutdown", log.Error(err))
+	}
+
 	// Stop the token-revocation cache syncer.
 	revocationSyncer.Stop()
 
+	// Stop the embedded mock identity provider.
+	if err := mockIdP.Stop(ctx); err != nil {
+		logger.Error(ctx, "Error during mock identity provider shutdown", log.Error(err))
+	}
+
 	// Shutdown services
 	unregisterServices()
 
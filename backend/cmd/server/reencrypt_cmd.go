@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// reencryptSubcommand is the first positional argument that selects the secret re-encryption
+// one-shot instead of starting the long-running server.
+const reencryptSubcommand = "reencrypt"
+
+// isReencryptInvocation reports whether the process was started as the re-encryption one-shot
+// (e.g. `thunderid reencrypt`).
+func isReencryptInvocation() bool {
+	return flag.Arg(0) == reencryptSubcommand
+}
+
+// runReencrypt encrypts the stored identity provider secrets again with the current encryption key,
+// so that a retired key can be removed from crypto.encryption.previous_keys, and tears down the
+// shared resources. It does not start an HTTP listener.
+func runReencrypt(ctx context.Context, logger *log.Logger, idpService idp.IDPServiceInterface,
+	cacheManager cache.CacheManagerInterface) error {
+	defer shutdownBootstrap(ctx, logger, cacheManager)
+
+	updated, svcErr := idpService.ReencryptSecrets(ctx)
+	if svcErr != nil {
+		return fmt.Errorf("%s: %s", svcErr.Code, svcErr.ErrorDescription.DefaultValue)
+	}
+	logger.Info(ctx, "Re-encrypted identity provider secrets", log.Int("identityProviders", updated))
+	return nil
+}
@@ -23,34 +23,44 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/actorprovider"
+	"github.com/thunder-id/thunderid/internal/adminnotification"
 	"github.com/thunder-id/thunderid/internal/agent"
 	"github.com/thunder-id/thunderid/internal/application"
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	"github.com/thunder-id/thunderid/internal/authn"
+	"github.com/thunder-id/thunderid/internal/authn/accesspolicy"
+	"github.com/thunder-id/thunderid/internal/authn/anomaly"
 	authnAssert "github.com/thunder-id/thunderid/internal/authn/assert"
+	"github.com/thunder-id/thunderid/internal/authn/certificate"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	authnConsent "github.com/thunder-id/thunderid/internal/authn/consent"
+	"github.com/thunder-id/thunderid/internal/authn/crossdevice"
 	"github.com/thunder-id/thunderid/internal/authn/github"
 	"github.com/thunder-id/thunderid/internal/authn/google"
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
 	"github.com/thunder-id/thunderid/internal/authn/magiclink"
 	authnOAuth "github.com/thunder-id/thunderid/internal/authn/oauth"
 	authnOIDC "github.com/thunder-id/thunderid/internal/authn/oidc"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/push"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/authz"
 	"github.com/thunder-id/thunderid/internal/authzen"
 	"github.com/thunder-id/thunderid/internal/cert"
+	"github.com/thunder-id/thunderid/internal/claimsource"
 	"github.com/thunder-id/thunderid/internal/connection"
 	"github.com/thunder-id/thunderid/internal/consent"
 	layoutmgt "github.com/thunder-id/thunderid/internal/design/layout/mgt"
 	"github.com/thunder-id/thunderid/internal/design/resolve"
 	thememgt "github.com/thunder-id/thunderid/internal/design/theme/mgt"
+	"github.com/thunder-id/thunderid/internal/device"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/entitytype"
@@ -62,9 +72,11 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/graphql"
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/job"
 	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/oauth"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
@@ -73,18 +85,26 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
 	"github.com/thunder-id/thunderid/internal/openid4vci"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/policy"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/role"
 	"github.com/thunder-id/thunderid/internal/runtimestore"
+	"github.com/thunder-id/thunderid/internal/scope"
+	"github.com/thunder-id/thunderid/internal/search"
 	"github.com/thunder-id/thunderid/internal/serverconfig"
+	"github.com/thunder-id/thunderid/internal/signingkey"
+	"github.com/thunder-id/thunderid/internal/system/apidocs"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/configascode"
+	"github.com/thunder-id/thunderid/internal/system/configreload"
 	"github.com/thunder-id/thunderid/internal/system/cors"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/export"
+	"github.com/thunder-id/thunderid/internal/system/featureflag"
 	healthcheckservice "github.com/thunder-id/thunderid/internal/system/healthcheck/service"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/internal/system/importer"
@@ -96,10 +116,15 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/mcp"
 	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/outbox"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
+	"github.com/thunder-id/thunderid/internal/system/retention"
+	"github.com/thunder-id/thunderid/internal/system/selfcheck"
 	"github.com/thunder-id/thunderid/internal/system/services"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/sysinfo"
 	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/internal/usage"
 	"github.com/thunder-id/thunderid/internal/user"
 	"github.com/thunder-id/thunderid/internal/vc/credential"
 	"github.com/thunder-id/thunderid/internal/vc/presentation"
@@ -109,10 +134,49 @@ import (
 // observabilitySvc is the observability service instance. This is used for graceful shutdown.
 var observabilitySvc observability.ObservabilityServiceInterface
 
+// entityPurger purges expired soft-deleted entities. It is nil when soft delete is disabled and is
+// stopped during graceful shutdown.
+var entityPurger entity.Purger
+
+// usageMeter counts usage and enforces the usage quotas. It is nil when usage metering is disabled
+// and is stopped during graceful shutdown, which flushes the remaining counts.
+var usageMeter usage.MeterInterface
+
+// outboxDispatcher delivers the events recorded in the transactional outbox. It is nil when the
+// outbox is disabled and is stopped during graceful shutdown.
+var outboxDispatcher outbox.Dispatcher
+
+// retentionPurger deletes expired runtime data and processed audit events. It is nil when retention
+// is disabled and is stopped during graceful shutdown.
+var retentionPurger retention.Purger
+
+// signingKeySyncer reloads signing keys rotated by other nodes. It is nil when periodic reloading is
+// disabled and is stopped during graceful shutdown.
+var signingKeySyncer signingkey.KeySyncer
+
+// idpSecretReencrypter rewrites the stored identity provider secrets for the reencrypt subcommand.
+var idpSecretReencrypter idp.IDPServiceInterface
+
+// configReloader reloads the deployment configuration on SIGHUP and through the reload endpoint.
+var configReloader configreload.ConfigReloadServiceInterface
+
+// idempotencyStore persists the idempotency records of the create endpoints. It is set once the
+// runtime store is initialized and used when building the HTTP middleware chain.
+var idempotencyStore providers.RuntimeStoreProvider
+
+// jobWorkerPool runs the background jobs of the bulk operations and is stopped during graceful shutdown.
+var jobWorkerPool job.WorkerPool
+
+// configReconciler applies the configuration-as-code directory at startup.
+var configReconciler configascode.ReconcilerInterface
+
+// flowExecutorCheck reports the flow nodes that reference an unregistered executor for --validate.
+var flowExecutorCheck selfcheck.CheckFunc
+
 // registerServices registers all the services with the provided HTTP multiplexer.
 // It also returns the import service so the bootstrap subcommand can create default
 // resources in-process through the same service instances.
-func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterface) (
+func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterface, buildInfo sysinfo.BuildInfo) (
 	jwt.JWTServiceInterface, kmprovider.RuntimeCryptoProvider, importer.ImportServiceInterface) {
 	logger := log.GetLogger()
 
@@ -139,13 +203,38 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		Leeway:         runtime.Config.JWT.Leeway,
 		JWKSCacheTTL:   time.Duration(runtime.Config.Server.SecurityConfig.JWKSCacheTTL) * time.Second,
 	}
-	jwtService, jweService, err := jose.Initialize(runtimeCryptoSvc, joseCfg)
+	jwtService, jweService, err := jose.Initialize(cacheManager, runtimeCryptoSvc, joseCfg)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize JOSE services", log.Error(err))
 	}
 
+	// Initialize signing key management. Persisted rotated keys are loaded before any token is issued.
+	_, signingKeySyncer, err = signingkey.Initialize(mux, pkiService, runtimeCryptoSvc, configCryptoSvc, jwtService)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize signing key service", log.Error(err))
+	}
+	if signingKeySyncer != nil {
+		signingKeySyncer.Start(ctx)
+	}
+
 	observabilitySvc = observability.Initialize(config.GetServerRuntime().Config.Observability)
 
+	var outboxService outbox.OutboxServiceInterface
+	outboxService, outboxDispatcher, err = outbox.Initialize(config.GetServerRuntime().Config.Outbox,
+		observabilitySvc)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize outbox", log.Error(err))
+	}
+	if outboxDispatcher != nil {
+		outboxDispatcher.Start(ctx)
+	}
+
+	retentionPurger = retention.Initialize(config.GetServerRuntime().Config.Retention,
+		config.GetServerRuntime().Config.Database.Runtime.Type)
+	if retentionPurger != nil {
+		retentionPurger.Start(ctx)
+	}
+
 	// Initialize MCP server early so packages initializing below can register tools.
 	mcpServer := mcp.Initialize(mux, jwtService)
 
@@ -165,7 +254,20 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		logger.Fatal(ctx, "Failed to initialize system authorization service", log.Error(err))
 	}
 
-	ouService, ouHierarchyResolver, ouExporter, err := ou.Initialize(mux, mcpServer, cacheManager, ouAuthzService)
+	runtimeStoreProvider, transactioner, err := runtimestore.Initialize(runtime.Config.Database.Runtime.Type,
+		runtime.Config.Server.Identifier)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize runtime store", log.Error(err))
+	}
+	idempotencyStore = runtimeStoreProvider
+
+	// Initialize the background job workers used by the bulk operations.
+	var jobService job.JobServiceInterface
+	jobService, jobWorkerPool = job.Initialize(mux, runtimeStoreProvider, runtime.Config.Jobs)
+	jobWorkerPool.Start(ctx)
+
+	ouService, ouHierarchyResolver, ouExporter, err := ou.Initialize(
+		mux, mcpServer, cacheManager, ouAuthzService, jobService)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize OrganizationUnitService", log.Error(err))
 	}
@@ -188,6 +290,9 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize consent service
 	consentService := consent.Initialize()
 
+	// Initialize policy service
+	policyService := policy.Initialize(mux)
+
 	// Initialize user type service
 	entityTypeService, entityTypeExporter, err := entitytype.Initialize(
 		mux, mcpServer, cacheManager, ouService, ouAuthzService, consentService)
@@ -205,8 +310,15 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize entity provider
 	entityProvider := entityprovider.InitializeEntityProvider(entityService)
 
+	// Initialize device service
+	deviceService := device.Initialize(runtimeStoreProvider)
+
+	// Initialize login history service
+	loginHistoryService := loginhistory.Initialize(runtimeStoreProvider)
+
 	userService, ouUserResolver, userExporter, err := user.Initialize(
-		mux, entityService, ouService, entityTypeService, ouAuthzService,
+		mux, entityService, ouService, entityTypeService, ouAuthzService, observabilitySvc, deviceService,
+		loginHistoryService, jobService, outboxService, consentService, runtimeStoreProvider,
 	)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize UserService", log.Error(err))
@@ -221,15 +333,19 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 	exporters = append(exporters, groupExporter)
 
+	// Initialize the search across users, applications and groups.
+	_ = search.Initialize(mux, ouAuthzService, entityTypeService)
+
 	resourceService, resourceExporter, err := resource.Initialize(mux, ouService, consentService)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize Resource Service", log.Error(err))
 	}
 	exporters = append(exporters, resourceExporter)
 
-	roleService, roleAssignmentService, ouRoleResolver, roleExporter, err := role.Initialize(
-		mux, entityService, groupService, ouService, resourceService, entityTypeService,
-	)
+	roleService, roleAssignmentService, ouRoleResolver, effectiveAccessResolver, roleExporter, err :=
+		role.Initialize(
+			mux, entityService, groupService, ouService, resourceService, entityTypeService,
+		)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize RoleService", log.Error(err))
 	}
@@ -239,6 +355,15 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	ouService.SetOUUserResolver(ouUserResolver)
 	ouService.SetOUGroupResolver(ouGroupResolver)
 	ouService.SetOURoleResolver(ouRoleResolver)
+	// Two-phase initialization: inject the role based effective access resolver into user service.
+	userService.SetEffectiveAccessResolver(effectiveAccessResolver)
+
+	// Initialize scope service
+	scopeService, scopeExporter := scope.Initialize(mux, cacheManager, entityProvider, roleService, ouService)
+	exporters = append(exporters, scopeExporter)
+
+	// Initialize claim source service
+	claimSourceService := claimsource.Initialize(mux, jwtService)
 
 	authZService := authz.Initialize(roleService)
 	authzen.Initialize(mux, authZService, entityProvider, resourceService)
@@ -248,14 +373,15 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		logger.Fatal(ctx, "Failed to initialize IDPService", log.Error(err))
 	}
 	exporters = append(exporters, idpExporter)
+	idpSecretReencrypter = idpService
 
-	templateService, err := template.Initialize()
+	templateService, err := template.Initialize(mux)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize template service", log.Error(err))
 	}
 
 	notifSenderMgtSvc, notifOTPService, notifSenderSvc, notificationExporter, err := notification.Initialize(
-		mux, jwtService, templateService)
+		mux, jwtService, templateService, runtimeStoreProvider)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize NotificationService", log.Error(err))
 	}
@@ -274,6 +400,24 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize otp core service
 	otpCoreService := otp.Initialize(notifOTPService)
 
+	// Initialize push notification authentication service
+	pushAuthService, err := push.Initialize(mux, runtimeStoreProvider)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize push authentication service", log.Error(err))
+	}
+
+	// Initialize cross-device (QR code) authentication service
+	crossDeviceAuthService, err := crossdevice.Initialize(mux, runtimeStoreProvider)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize cross-device authentication service", log.Error(err))
+	}
+
+	// Initialize X.509 client certificate authentication service
+	certificateAuthService, err := certificate.Initialize()
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize certificate authentication service", log.Error(err))
+	}
+
 	// Initialize federated authentication services.
 	oauthAuthnService := authnOAuth.Initialize(idpService, entityProvider)
 	oidcAuthnService := authnOIDC.Initialize(oauthAuthnService, jwtService)
@@ -298,7 +442,8 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	// Initialize authn provider
 	authnProvider := authnprovidermgr.InitializeAuthnProviderManager(entityService, passkeyService, otpCoreService,
-		magicLinkService, openid4vpSvc, federatedAuths)
+		magicLinkService, openid4vpSvc, pushAuthService, crossDeviceAuthService, certificateAuthService,
+		federatedAuths)
 
 	// Initialize authentication services.
 	authAssertGen := authnAssert.Initialize()
@@ -308,42 +453,53 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		otpCoreService, notifSenderSvc, templateService, magicLinkService, oauthAuthnService, oidcAuthnService,
 		googleAuthnService, githubAuthnService)
 
-	runtimeStoreProvider, transactioner, err := runtimestore.Initialize(runtime.Config.Database.Runtime.Type,
-		runtime.Config.Server.Identifier)
+	attributeCacheService := attributecache.Initialize(runtimeStoreProvider)
+
+	anomalyService, err := anomaly.Initialize(runtimeStoreProvider, observabilitySvc)
 	if err != nil {
-		logger.Fatal(ctx, "Failed to initialize runtime store", log.Error(err))
+		logger.Fatal(ctx, "Failed to initialize anomaly detection service", log.Error(err))
 	}
 
-	attributeCacheService := attributecache.Initialize(runtimeStoreProvider)
+	accessPolicyService, err := accesspolicy.Initialize(observabilitySvc)
+	if err != nil {
+		logger.Fatal(ctx, "Failed to initialize access policy service", log.Error(err))
+	}
 
-	emailClient := initEmailClient(ctx, logger)
+	emailClient := initEmailClient(ctx, logger, mux)
 	flowConfig := flowconfig.FromServerRuntime()
 	flowFactory, execRegistry, interceptorRegistry, graphBuilder := initializeFlowCoreAndExecutor(ctx, logger,
 		cacheManager, executor.ExecutorDependencies{
-			OUService:             ouService,
-			IDPService:            idpService,
-			NotifSenderSvc:        notifSenderSvc,
-			JWTService:            jwtService,
-			AuthAssertGen:         authAssertGen,
-			ConsentEnforcer:       consentEnforcer,
-			AuthnProvider:         authnProvider,
-			OTPService:            otpCoreService,
-			PasskeyService:        passkeyService,
-			MagicLinkService:      magicLinkService,
-			AuthZService:          authZService,
-			EntityTypeService:     entityTypeService,
-			GroupService:          groupService,
-			RoleService:           roleService,
-			RoleAssignmentService: roleAssignmentService,
-			EntityProvider:        entityProvider,
-			AttributeCacheSvc:     attributeCacheService,
-			EmailClient:           emailClient,
-			TemplateService:       templateService,
-			OAuthSvc:              oauthAuthnService,
-			OIDCSvc:               oidcAuthnService,
-			GithubSvc:             githubAuthnService,
-			GoogleSvc:             googleAuthnService,
-			OpenID4VPVerifierSvc:  openid4vpSvc,
+			OUService:              ouService,
+			IDPService:             idpService,
+			NotifSenderSvc:         notifSenderSvc,
+			JWTService:             jwtService,
+			AuthAssertGen:          authAssertGen,
+			ConsentEnforcer:        consentEnforcer,
+			AuthnProvider:          authnProvider,
+			OTPService:             otpCoreService,
+			PasskeyService:         passkeyService,
+			MagicLinkService:       magicLinkService,
+			PushAuthService:        pushAuthService,
+			CrossDeviceAuthService: crossDeviceAuthService,
+			AuthZService:           authZService,
+			EntityTypeService:      entityTypeService,
+			GroupService:           groupService,
+			RoleService:            roleService,
+			RoleAssignmentService:  roleAssignmentService,
+			EntityProvider:         entityProvider,
+			AttributeCacheSvc:      attributeCacheService,
+			EmailClient:            emailClient,
+			TemplateService:        templateService,
+			OAuthSvc:               oauthAuthnService,
+			OIDCSvc:                oidcAuthnService,
+			GithubSvc:              githubAuthnService,
+			GoogleSvc:              googleAuthnService,
+			OpenID4VPVerifierSvc:   openid4vpSvc,
+			PolicyService:          policyService,
+			ScopeService:           scopeService,
+			AnomalyService:         anomalyService,
+			DeviceService:          deviceService,
+			LoginHistoryService:    loginHistoryService,
 		},
 		interceptor.InterceptorDependencies{},
 		flowConfig,
@@ -354,6 +510,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize FlowMgtService", log.Error(err))
 	}
+	flowExecutorCheck = newFlowExecutorCheck(flowMgtService, execRegistry)
 
 	exporters = append(exporters, flowMgtExporter)
 	certservice, err := cert.Initialize(cacheManager, dbprovider.GetDBProvider())
@@ -376,7 +533,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	inboundClientService, err := inboundclient.Initialize(
 		cacheManager, certservice, entityProvider,
-		themeMgtService, layoutMgtService, flowMgtService, entityTypeService, consentService)
+		themeMgtService, layoutMgtService, flowMgtService, entityTypeService, consentService, scopeService)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize InboundClientService", log.Error(err))
 	}
@@ -396,6 +553,16 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 	exporters = append(exporters, agentExporter)
 
+	if err := graphql.Initialize(mux, config.GetServerRuntime().Config.GraphQL, userService, groupService,
+		roleService, applicationService, deviceService); err != nil {
+		logger.Fatal(ctx, "Failed to initialize the GraphQL API", log.Error(err))
+	}
+
+	if err := adminnotification.Initialize(
+		mux, config.GetServerRuntime().Config.AdminNotifications, observabilitySvc); err != nil {
+		logger.Fatal(ctx, "Failed to initialize admin notifications", log.Error(err))
+	}
+
 	// Wire the dependency registry into the consuming services (two-phase init to avoid cyclic
 	// imports). flowMgtService is both a consumer and a provider: it reports which flows reference an
 	// identity provider or notification sender.
@@ -414,6 +581,16 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}, applicationService, agentService, flowMgtService, roleAssignmentService, groupService,
 		ouService, ouUserResolver, ouGroupResolver, resourceService)
 
+	if softDeleteCfg := config.GetServerRuntime().Config.SoftDelete; softDeleteCfg.Enabled {
+		entityPurger = initEntityPurger(entityService, userService, applicationService, softDeleteCfg)
+		entityPurger.Start(ctx)
+	}
+
+	usageMeter = usage.Initialize(mux, config.GetServerRuntime().Config.Usage)
+	if usageMeter != nil {
+		usageMeter.Start(ctx)
+	}
+
 	// Initialize design resolve service for theme and layout resolution
 	designResolveService := resolve.Initialize(mux, themeMgtService, layoutMgtService, applicationService)
 
@@ -426,6 +603,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	serverConfigHandlers := map[serverconfig.ConfigName]serverconfig.ServerConfigHandlerInterface{
 		serverconfig.ConfigNameCORS:                  cors.OriginHandler{},
 		serverconfig.ConfigNameDefaultResourceServer: resource.NewDefaultResourceServerConfigHandler(resourceService),
+		serverconfig.ConfigNameFeatureFlags:          featureflag.FlagsHandler{},
 	}
 	serverConfigService, serverConfigExporter, err := serverconfig.Initialize(mux, cacheManager, serverConfigHandlers)
 	if err != nil {
@@ -433,8 +611,13 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 	exporters = append(exporters, serverConfigExporter)
 
-	// CORS origins come from the server-config cors section.
+	// CORS origins come from the server-config cors section, plus the origins registered by applications
+	// on the endpoints browser-based applications call directly.
 	cors.InitializeDynamicMatcher(serverConfigService)
+	cors.InitializeApplicationOrigins(inboundClientService, cors.DefaultApplicationOriginsTTL)
+
+	// Feature flags take their runtime overrides from the server-config featureFlags section.
+	featureflag.Initialize(serverConfigService)
 
 	// Initialize export service with collected exporters
 	_ = export.Initialize(mux, exporters)
@@ -459,12 +642,28 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		openid4vpDefSvc,
 		openid4vciCredSvc,
 		serverConfigService,
+		scopeService,
 	)
 
+	configReconciler = configascode.Initialize(importService, map[string]configascode.ResourceDeleter{
+		configascode.ResourceTypeApplication: {
+			Delete: applicationService.DeleteApplication, NotFoundCode: application.ErrorApplicationNotFound.Code,
+		},
+		configascode.ResourceTypeFlow: {
+			Delete: flowMgtService.DeleteFlow, NotFoundCode: flowmgt.ErrorFlowNotFound.Code,
+		},
+		configascode.ResourceTypeScope: {
+			Delete: scopeService.DeleteScope, NotFoundCode: scope.ErrorScopeNotFound.Code,
+		},
+		configascode.ResourceTypeOrganizationUnit: {
+			Delete: ouService.DeleteOrganizationUnit, NotFoundCode: ou.ErrorOrganizationUnitNotFound.Code,
+		},
+	})
+
 	flowCfg := flowconfig.FromServerRuntime()
-	flowExecService, err := flowexec.Initialize(mux, flowMgtService, actorProvider,
+	flowExecService, err := flowexec.Initialize(mux, flowMgtService, actorProvider, accessPolicyService,
 		execRegistry, interceptorRegistry, observabilitySvc, runtimeCryptoSvc, graphBuilder,
-		runtimeStoreProvider, transactioner, flowCfg)
+		runtimeStoreProvider, transactioner, i18nService, flowCfg)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize flow execution service", log.Error(err))
 	}
@@ -472,7 +671,8 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize OAuth services.
 	err = oauth.Initialize(mux, actorProvider, authnProvider, jwtService, jweService,
 		flowExecService, observabilitySvc, runtimeCryptoSvc, ouService, attributeCacheService, authZService,
-		resourceService, i18nService, idpService, dpopVerifier, oauthCfg)
+		resourceService, i18nService, idpService, dpopVerifier, deviceService, scopeService,
+		claimSourceService, usageMeter, oauthCfg)
 	if err != nil {
 		logger.Fatal(ctx, "Failed to initialize OAuth services", log.Error(err))
 	}
@@ -484,9 +684,23 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 
 	// Register the health service.
-	healthSvc := healthcheckservice.Initialize(dbprovider.GetDBProvider(), dbprovider.GetRedisProvider())
+	healthSvc := healthcheckservice.Initialize(dbprovider.GetDBProvider(), dbprovider.GetRedisProvider(),
+		dbprovider.GetBoltProvider())
 	services.NewHealthCheckService(mux, healthSvc)
 
+	// Register the system information endpoints.
+	sysinfo.Initialize(mux, runtimeCryptoSvc, buildInfo)
+
+	// Register the OpenAPI document endpoint.
+	runtimeConfig := &config.GetServerRuntime().Config
+	if err := apidocs.Initialize(mux, runtimeConfig.APIDocs, config.GetServerURL(&runtimeConfig.Server)); err != nil {
+		logger.Fatal(ctx, "Failed to initialize API docs", log.Error(err))
+	}
+
+	serverHome := config.GetServerRuntime().ServerHome
+	configReloader = configreload.Initialize(mux, path.Join(serverHome, deploymentConfigFile),
+		path.Join(serverHome, defaultConfigFile), serverHome)
+
 	return jwtService, runtimeCryptoSvc, importService
 }
 
@@ -525,12 +739,52 @@ func registerDependencyRegistry(consumers dependencyConsumers, providers ...reso
 
 // unregisterServices unregisters all services that require cleanup during shutdown.
 func unregisterServices() {
+	if entityPurger != nil {
+		entityPurger.Stop()
+	}
+	if signingKeySyncer != nil {
+		signingKeySyncer.Stop()
+	}
+	if usageMeter != nil {
+		usageMeter.Stop()
+	}
+	if jobWorkerPool != nil {
+		jobWorkerPool.Stop()
+	}
+	if outboxDispatcher != nil {
+		outboxDispatcher.Stop()
+	}
+	if retentionPurger != nil {
+		retentionPurger.Stop()
+	}
 	observabilitySvc.Shutdown()
 }
 
+// initEntityPurger creates the purger that permanently deletes users and applications whose soft
+// delete retention window has passed.
+func initEntityPurger(entityService entity.EntityServiceInterface, userService user.UserServiceInterface,
+	applicationService application.ApplicationServiceInterface,
+	softDeleteCfg config.SoftDeleteConfig) entity.Purger {
+	purgeFuncs := map[providers.EntityCategory]entity.PurgeFunc{
+		providers.EntityCategoryUser: func(ctx context.Context, id string) error {
+			if svcErr := userService.PurgeUser(ctx, id); svcErr != nil {
+				return fmt.Errorf("%s: %s", svcErr.Code, svcErr.ErrorDescription.DefaultValue)
+			}
+			return nil
+		},
+		providers.EntityCategoryApp: func(ctx context.Context, id string) error {
+			if svcErr := applicationService.PurgeApplication(ctx, id); svcErr != nil {
+				return fmt.Errorf("%s: %s", svcErr.Code, svcErr.ErrorDescription.DefaultValue)
+			}
+			return nil
+		},
+	}
+	return entity.NewPurger(entityService, purgeFuncs, softDeleteCfg.Retention(), softDeleteCfg.PurgeInterval())
+}
+
 // initEmailClient initializes the email client, returning nil if not configured.
-func initEmailClient(ctx context.Context, logger *log.Logger) email.EmailClientInterface {
-	client, err := email.Initialize()
+func initEmailClient(ctx context.Context, logger *log.Logger, mux *http.ServeMux) email.EmailClientInterface {
+	client, err := email.Initialize(mux)
 	if err != nil {
 		logger.Debug(ctx, "Email client not configured. "+
 			"EmailExecutor will be registered but will not send emails.", log.Error(err))
@@ -623,6 +877,11 @@ func buildHashConfig() (cryptolib.HashConfig, error) {
 		return cryptolib.HashConfig{Algorithm: alg, SaltSize: cfg.Argon2ID.SaltSize,
 			Iterations: cfg.Argon2ID.Iterations, Memory: cfg.Argon2ID.Memory,
 			Parallelism: cfg.Argon2ID.Parallelism, KeySize: cfg.Argon2ID.KeySize}, nil
+	case cryptolib.SCRYPT:
+		return cryptolib.HashConfig{Algorithm: alg, SaltSize: cfg.Scrypt.SaltSize, Cost: cfg.Scrypt.Cost,
+			BlockSize: cfg.Scrypt.BlockSize, Parallelism: cfg.Scrypt.Parallelism, KeySize: cfg.Scrypt.KeySize}, nil
+	case cryptolib.BCRYPT:
+		return cryptolib.HashConfig{Algorithm: alg, Cost: cfg.Bcrypt.Cost}, nil
 	default:
 		return cryptolib.HashConfig{}, fmt.Errorf("unrecognized password hashing algorithm %q", cfg.Algorithm)
 	}
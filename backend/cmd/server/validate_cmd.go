@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/selfcheck"
+)
+
+// validateFlag is the command line flag that checks the deployment and exits instead of starting the
+// long-running server (e.g. `thunderid --validate`).
+const validateFlag = "validate"
+
+// runStartupChecks checks the deployment configuration before the services open their databases and
+// key files, and stops the process when a check fails so that a misconfiguration is reported at startup
+// rather than as a failure of the first request that reaches it.
+func runStartupChecks(ctx context.Context, logger *log.Logger) {
+	findings := selfcheck.Run(ctx, selfcheck.ConfigChecks(config.GetServerRuntime())...)
+	if len(findings) == 0 {
+		return
+	}
+	reportFindings(ctx, logger, findings)
+	logger.Fatal(ctx, "Deployment configuration check failed; fix the problems reported above",
+		log.Int("problems", len(findings)))
+}
+
+// runValidate runs the checks that need the initialized services, such as the executor references of
+// the stored flows, and tears down the shared resources. The configuration checks have already passed
+// at this point. It does not start an HTTP listener.
+func runValidate(ctx context.Context, logger *log.Logger, cacheManager cache.CacheManagerInterface) error {
+	defer shutdownBootstrap(ctx, logger, cacheManager)
+
+	findings := selfcheck.Run(ctx, flowExecutorCheck)
+	if len(findings) > 0 {
+		reportFindings(ctx, logger, findings)
+		return fmt.Errorf("%d problem(s) found", len(findings))
+	}
+	return nil
+}
+
+// reportFindings logs each finding as an error.
+func reportFindings(ctx context.Context, logger *log.Logger, findings []selfcheck.Finding) {
+	for _, f := range findings {
+		logger.Error(ctx, "Deployment check failed", log.String("check", f.Check), log.String("problem", f.Message))
+	}
+}
+
+// newFlowExecutorCheck returns a check that reports flow nodes referencing an executor that is not
+// registered.
+func newFlowExecutorCheck(flowMgtService flowmgt.FlowMgtServiceInterface,
+	execRegistry executor.ExecutorRegistryInterface) selfcheck.CheckFunc {
+	return func(ctx context.Context) []selfcheck.Finding {
+		problems, err := flowmgt.FindUnregisteredExecutors(ctx, flowMgtService, execRegistry)
+		if err != nil {
+			return []selfcheck.Finding{{Check: selfcheck.CheckFlows, Message: err.Error()}}
+		}
+		findings := make([]selfcheck.Finding, 0, len(problems))
+		for _, problem := range problems {
+			findings = append(findings, selfcheck.Finding{Check: selfcheck.CheckFlows,
+				Message: problem + "; register the executor or update the flow"})
+		}
+		return findings
+	}
+}
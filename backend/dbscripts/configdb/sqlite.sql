@@ -125,6 +125,19 @@ CREATE TABLE "OAUTH_INBOUND_PROFILE" (
     FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
 );
 
+-- Table to store the change history of the OAuth inbound profile of an entity.
+CREATE TABLE "OAUTH_INBOUND_PROFILE_HISTORY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ENTITY_ID VARCHAR(36) NOT NULL,
+    VERSION INTEGER NOT NULL,
+    OAUTH_CONFIG TEXT,
+    CHANGES TEXT NOT NULL,
+    CHANGED_BY VARCHAR(255),
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (ENTITY_ID, VERSION, DEPLOYMENT_ID),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
+);
+
 -- Table to store identity providers.
 CREATE TABLE "IDP" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
@@ -160,6 +173,28 @@ CREATE TABLE "NOTIFICATION_SENDER" (
 -- Composite index for name-based notification sender lookups
 CREATE INDEX idx_notification_sender_name_deployment ON "NOTIFICATION_SENDER" (DEPLOYMENT_ID, NAME);
 
+-- Table to store notification templates that override the default template of a scenario for the
+-- deployment or for one application. An empty APP_ID or LOCALE applies to all applications or locales.
+CREATE TABLE "NOTIFICATION_TEMPLATE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    DISPLAY_NAME VARCHAR(255),
+    SCENARIO VARCHAR(50) NOT NULL,
+    TYPE VARCHAR(20) NOT NULL,
+    APP_ID VARCHAR(36) NOT NULL DEFAULT '',
+    LOCALE VARCHAR(35) NOT NULL DEFAULT '',
+    SUBJECT VARCHAR(500),
+    CONTENT_TYPE VARCHAR(50),
+    BODY TEXT NOT NULL,
+    VARIABLES TEXT,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now'))
+);
+
+-- A scenario has at most one template per channel, application and locale.
+CREATE UNIQUE INDEX idx_notification_template_scope ON "NOTIFICATION_TEMPLATE"
+    (DEPLOYMENT_ID, SCENARIO, TYPE, APP_ID, LOCALE);
+
 -- Table to store certificates associated with various entities.
 CREATE TABLE "CERTIFICATE" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
@@ -370,3 +405,74 @@ CREATE TABLE "SERVER_CONFIG" (
     UPDATED_AT    TEXT         DEFAULT (datetime('now')),
     PRIMARY KEY (DEPLOYMENT_ID, NAME)
 );
+
+-- Table to store published versions of policy documents (terms of service, privacy policy)
+CREATE TABLE "POLICY_VERSION" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID            VARCHAR(36)  PRIMARY KEY,
+    HANDLE        VARCHAR(100) NOT NULL,
+    VERSION       VARCHAR(50)  NOT NULL,
+    TITLE         VARCHAR(255) NOT NULL,
+    URL           VARCHAR(2048) NOT NULL,
+    PUBLISHED_AT  TEXT         NOT NULL
+);
+
+-- Each policy version is published once per deployment; also serves latest-version lookups by handle.
+CREATE UNIQUE INDEX idx_policy_version_handle ON "POLICY_VERSION" (DEPLOYMENT_ID, HANDLE, VERSION);
+
+-- Table to store signing keys created by key rotation and the lifecycle state of configured keys.
+-- PRIVATE_KEY (encrypted) and CERTIFICATE are NULL for keys loaded from the deployment configuration.
+CREATE TABLE "SIGNING_KEY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID            VARCHAR(100) NOT NULL,
+    STATUS        VARCHAR(20)  NOT NULL,
+    PRIVATE_KEY   TEXT,
+    CERTIFICATE   TEXT,
+    CREATED_AT    TEXT         NOT NULL,
+    RETIRED_AT    TEXT,
+    EXPIRES_AT    TEXT,
+    PRIMARY KEY (DEPLOYMENT_ID, ID)
+);
+
+-- Table to store OAuth scope definitions with their display text and claim bindings.
+-- CLAIMS holds a JSON array of the user claim names the scope releases. RESTRICTION holds the JSON
+-- role and organization unit restriction of a restricted scope, and is NULL for unrestricted scopes.
+-- REQUIRED_ACRS holds the JSON array of the ACR values that qualify for a step-up scope, and is NULL
+-- for other scopes.
+CREATE TABLE "OAUTH_SCOPE" (
+    DEPLOYMENT_ID VARCHAR(255)  NOT NULL,
+    ID            VARCHAR(36)   PRIMARY KEY,
+    NAME          VARCHAR(100)  NOT NULL,
+    DISPLAY_NAME  VARCHAR(255)  NOT NULL,
+    DESCRIPTION   VARCHAR(1024),
+    CLAIMS        TEXT          NOT NULL,
+    RESTRICTION   TEXT,
+    REQUIRED_ACRS TEXT
+);
+
+-- Scope names are unique per deployment.
+CREATE UNIQUE INDEX idx_oauth_scope_name ON "OAUTH_SCOPE" (DEPLOYMENT_ID, NAME);
+
+-- Table to store external claim sources released as OIDC aggregated or distributed claims.
+-- SOURCE_TYPE is aggregated or distributed. CLAIMS holds a JSON array of the claim names the source serves.
+CREATE TABLE "CLAIM_SOURCE" (
+    DEPLOYMENT_ID VARCHAR(255)  NOT NULL,
+    ID            VARCHAR(36)   PRIMARY KEY,
+    NAME          VARCHAR(100)  NOT NULL,
+    SOURCE_TYPE   VARCHAR(20)   NOT NULL,
+    ENDPOINT      VARCHAR(2048) NOT NULL,
+    CLAIMS        TEXT          NOT NULL
+);
+
+-- Claim source names are unique per deployment.
+CREATE UNIQUE INDEX idx_claim_source_name ON "CLAIM_SOURCE" (DEPLOYMENT_ID, NAME);
+
+-- Table to record the resources reconciled from the configuration-as-code directory at startup.
+-- Pruning only removes resources listed here, so resources created through the API or the bootstrap
+-- are never deleted by the reconciler.
+CREATE TABLE "CONFIG_AS_CODE_RESOURCE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    RESOURCE_TYPE VARCHAR(50)  NOT NULL,
+    RESOURCE_ID   VARCHAR(255) NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, RESOURCE_TYPE, RESOURCE_ID)
+);
@@ -54,5 +54,7 @@ DECLARE
     v_now TIMESTAMP := NOW() AT TIME ZONE 'UTC';
 BEGIN
     DELETE FROM "REVOKED_TOKEN" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "REFRESH_TOKEN" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "EMAIL_DELIVERY_EVENT" WHERE EXPIRY_TIME < v_now;
 END;
 $$;
@@ -22,7 +22,7 @@ CREATE TABLE "REVOKED_TOKEN" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
     ID VARCHAR(36) NOT NULL PRIMARY KEY,
     JTI VARCHAR(255) NOT NULL,
-    REVOCATION_REASON VARCHAR(30) NOT NULL CHECK (REVOCATION_REASON IN ('explicit', 'refresh_rotation')),
+    REVOCATION_REASON VARCHAR(30) NOT NULL CHECK (REVOCATION_REASON IN ('explicit', 'refresh_rotation', 'code_replay', 'admin')),
     REVOKED_AT TIMESTAMP NOT NULL,
     EXPIRY_TIME TIMESTAMP NOT NULL
 );
@@ -32,3 +32,62 @@ CREATE UNIQUE INDEX idx_revoked_token_jti_deployment ON "REVOKED_TOKEN" (DEPLOYM
 
 -- Index for expiry time on REVOKED_TOKEN (supports cleanup and expiry checks).
 CREATE INDEX idx_revoked_token_expiry_time ON "REVOKED_TOKEN" (EXPIRY_TIME);
+
+-- Table to store the refresh tokens issued to clients, so that administrators can list the active
+-- refresh tokens and revoke them by user or client. A row is removed when its token is revoked.
+CREATE TABLE "REFRESH_TOKEN" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    JTI VARCHAR(255) NOT NULL,
+    CLIENT_ID VARCHAR(255) NOT NULL,
+    SUBJECT VARCHAR(255) NOT NULL,
+    ISSUED_AT TIMESTAMP NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, JTI)
+);
+
+-- Indexes for listing and revoking the refresh tokens of a user or a client.
+CREATE INDEX idx_refresh_token_subject ON "REFRESH_TOKEN" (DEPLOYMENT_ID, SUBJECT);
+CREATE INDEX idx_refresh_token_client_id ON "REFRESH_TOKEN" (DEPLOYMENT_ID, CLIENT_ID);
+
+-- Index for expiry time on REFRESH_TOKEN (supports cleanup and expiry checks).
+CREATE INDEX idx_refresh_token_expiry_time ON "REFRESH_TOKEN" (EXPIRY_TIME);
+
+-- Table to store usage counters per calendar month (PERIOD, formatted YYYY-MM in UTC).
+-- Each node adds its locally counted increments on every flush, so VALUE is the deployment-wide total.
+CREATE TABLE "USAGE_COUNTER" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    PERIOD        VARCHAR(7)   NOT NULL,
+    METRIC        VARCHAR(50)  NOT NULL,
+    VALUE         BIGINT       NOT NULL DEFAULT 0,
+    PRIMARY KEY (DEPLOYMENT_ID, PERIOD, METRIC)
+);
+
+-- Table to store the distinct users that were issued tokens in a calendar month, for monthly active users.
+CREATE TABLE "USAGE_ACTIVE_USER" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    PERIOD        VARCHAR(7)   NOT NULL,
+    SUBJECT       VARCHAR(255) NOT NULL,
+    PRIMARY KEY (DEPLOYMENT_ID, PERIOD, SUBJECT)
+);
+
+-- Table to store the delivery statuses reported by the email providers, kept for troubleshooting
+-- until EXPIRY_TIME.
+CREATE TABLE "EMAIL_DELIVERY_EVENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) NOT NULL PRIMARY KEY,
+    PROVIDER VARCHAR(20) NOT NULL,
+    MESSAGE_ID VARCHAR(255) NOT NULL DEFAULT '',
+    RECIPIENT VARCHAR(320) NOT NULL,
+    STATUS VARCHAR(30) NOT NULL,
+    DETAIL TEXT,
+    OCCURRED_AT TIMESTAMP NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL
+);
+
+-- Indexes for looking up the delivery events of a recipient or a message.
+CREATE INDEX idx_email_delivery_event_recipient ON "EMAIL_DELIVERY_EVENT" (DEPLOYMENT_ID, RECIPIENT);
+CREATE INDEX idx_email_delivery_event_message ON "EMAIL_DELIVERY_EVENT" (DEPLOYMENT_ID, MESSAGE_ID);
+
+-- Index for expiry time on EMAIL_DELIVERY_EVENT (supports cleanup).
+CREATE INDEX idx_email_delivery_event_expiry_time ON "EMAIL_DELIVERY_EVENT" (EXPIRY_TIME);
@@ -7,7 +7,8 @@ CREATE TABLE "AUTHORIZATION_CODE" (
     STATE VARCHAR(50) NOT NULL,
     AUTHZ_DATA JSONB NOT NULL,
     TIME_CREATED TIMESTAMP NOT NULL,
-    EXPIRY_TIME TIMESTAMP NOT NULL
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    ISSUED_TOKENS JSONB
 );
 
 -- Composite index for authorization code lookup by code + deployment (hot login-path query)
@@ -142,6 +143,7 @@ CREATE TABLE "RUNTIME_STORE" (
 -- Adding a new namespace constant REQUIRES adding a matching partition here.
 CREATE TABLE "RUNTIME_STORE_ATTRIBUTE_CACHE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('attribute:cache');
 CREATE TABLE "RUNTIME_STORE_FLOW_STATE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('flow:state');
+CREATE TABLE "RUNTIME_STORE_FLOW_TRACE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('flow:trace');
 CREATE TABLE "RUNTIME_STORE_AUTHZ_CODE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:code');
 CREATE TABLE "RUNTIME_STORE_AUTHZ_REQ"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('authz:req');
 CREATE TABLE "RUNTIME_STORE_PAR_REQ"    PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('par:req');
@@ -150,6 +152,18 @@ CREATE TABLE "RUNTIME_STORE_JTI_TOKEN"  PARTITION OF "RUNTIME_STORE" FOR VALUES
 CREATE TABLE "RUNTIME_STORE_VCI_NONCE"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vci:nonce');
 CREATE TABLE "RUNTIME_STORE_VCI_OFFER"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vci:offer');
 CREATE TABLE "RUNTIME_STORE_VP_STATE"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('vp:state');
+CREATE TABLE "RUNTIME_STORE_ANOMALY_PROFILE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('anomaly:profile');
+CREATE TABLE "RUNTIME_STORE_DEVICE_USER"    PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('device:user');
+CREATE TABLE "RUNTIME_STORE_DEVICE_TRUST"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('device:trust');
+CREATE TABLE "RUNTIME_STORE_LOGIN_HISTORY"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('login:history');
+CREATE TABLE "RUNTIME_STORE_IDEMPOTENCY"   PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('idempotency:key');
+CREATE TABLE "RUNTIME_STORE_JOB_STATUS"    PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('job:status');
+CREATE TABLE "RUNTIME_STORE_USER_ERASURE"  PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('user:erasure');
+CREATE TABLE "RUNTIME_STORE_PUSH_DEVICE"    PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('push:device');
+CREATE TABLE "RUNTIME_STORE_PUSH_CHALLENGE" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('push:challenge');
+CREATE TABLE "RUNTIME_STORE_CROSSDEVICE_REQ" PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('crossdevice:req');
+CREATE TABLE "RUNTIME_STORE_OTP_SESSION"    PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('otp:session');
+CREATE TABLE "RUNTIME_STORE_OTP_RESEND"     PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('otp:resend');
 
 -- Index for expiry time on RUNTIME_STORE (propagates to all partitions; supports cleanup and expiry checks)
 CREATE INDEX idx_runtime_store_expiry_time ON "RUNTIME_STORE" (EXPIRY_TIME);
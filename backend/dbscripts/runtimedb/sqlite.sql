@@ -7,7 +7,8 @@ CREATE TABLE "AUTHORIZATION_CODE" (
     STATE VARCHAR(50) NOT NULL,
     AUTHZ_DATA TEXT NOT NULL,
     TIME_CREATED DATETIME NOT NULL,
-    EXPIRY_TIME DATETIME NOT NULL
+    EXPIRY_TIME DATETIME NOT NULL,
+    ISSUED_TOKENS TEXT
 );
 
 -- Composite index for authorization code lookup by code + deployment (hot login-path query)
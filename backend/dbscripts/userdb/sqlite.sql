@@ -29,7 +29,9 @@ CREATE TABLE "ENTITY" (
     CREDENTIALS         TEXT,
     SYSTEM_CREDENTIALS  TEXT,
     CREATED_AT          TEXT NOT NULL,
-    UPDATED_AT          TEXT NOT NULL
+    UPDATED_AT          TEXT NOT NULL,
+    DELETED_AT          TEXT,
+    PREVIOUS_STATE      VARCHAR(50)
 );
 
 -- Composite index for category-based entity listing
@@ -38,6 +40,9 @@ CREATE INDEX idx_entity_category_deployment ON "ENTITY" (DEPLOYMENT_ID, CATEGORY
 -- Composite index for OU-based entity listing
 CREATE INDEX idx_entity_ou_deployment ON "ENTITY" (DEPLOYMENT_ID, OU_ID);
 
+-- Composite index for listing and purging soft-deleted entities
+CREATE INDEX idx_entity_state_deployment ON "ENTITY" (DEPLOYMENT_ID, STATE, DELETED_AT);
+
 -- Table to store Groups
 CREATE TABLE "GROUP" (
     DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
@@ -77,3 +82,34 @@ CREATE TABLE "ENTITY_IDENTIFIER" (
 
 -- Index for fast identifier lookups (primary use case for authentication)
 CREATE INDEX idx_entity_identifier_lookup ON "ENTITY_IDENTIFIER" (NAME, VALUE);
+
+-- Table to store the policy versions accepted by each entity
+CREATE TABLE "POLICY_ACCEPTANCE" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ENTITY_ID       VARCHAR(36)  NOT NULL,
+    POLICY_HANDLE   VARCHAR(100) NOT NULL,
+    VERSION         VARCHAR(50)  NOT NULL,
+    ACCEPTED_AT     TEXT NOT NULL,
+    PRIMARY KEY (ENTITY_ID, DEPLOYMENT_ID, POLICY_HANDLE, VERSION),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "ENTITY" (ID) ON DELETE CASCADE
+);
+
+-- Table to store the transactional outbox of audit and webhook events. Events are written in the
+-- same transaction as the state change they describe and delivered by a background dispatcher.
+CREATE TABLE "OUTBOX_EVENT" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ID              VARCHAR(36)  NOT NULL,
+    EVENT_ID        VARCHAR(36)  NOT NULL,
+    EVENT_TYPE      VARCHAR(100) NOT NULL,
+    PAYLOAD         TEXT         NOT NULL,
+    STATUS          VARCHAR(20)  NOT NULL CHECK (STATUS IN ('PENDING', 'DELIVERED', 'FAILED')),
+    ATTEMPTS        INTEGER      NOT NULL DEFAULT 0,
+    LAST_ERROR      TEXT,
+    CREATED_AT      TEXT NOT NULL,
+    NEXT_ATTEMPT_AT TEXT NOT NULL,
+    PROCESSED_AT    TEXT,
+    PRIMARY KEY (ID, DEPLOYMENT_ID)
+);
+
+-- Index for the dispatcher scan of due events
+CREATE INDEX idx_outbox_event_due ON "OUTBOX_EVENT" (DEPLOYMENT_ID, STATUS, NEXT_ATTEMPT_AT);
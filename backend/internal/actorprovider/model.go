@@ -18,6 +18,8 @@
 
 package actorprovider
 
+import "github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
 // ApplicationMetadata is the application view composed from inbound-client and actor records.
 type ApplicationMetadata struct {
 	ID          string `json:"id"`
@@ -27,4 +29,6 @@ type ApplicationMetadata struct {
 	URL         string `json:"url,omitempty"`
 	TosURI      string `json:"tosUri,omitempty"`
 	PolicyURI   string `json:"policyUri,omitempty"`
+
+	Branding *providers.ApplicationBranding `json:"branding,omitempty"`
 }
@@ -121,6 +121,19 @@ func (p *actorProvider) GetActor(actorID string) (*providers.Entity, *tidcommon.
 	return entity, nil
 }
 
+// GetDeletedActor returns the soft-deleted entity record for the given actor ID. Actors that were
+// not deleted, or whose entity store does not support soft deletion, are reported as not found.
+func (p *actorProvider) GetDeletedActor(actorID string) (*providers.Entity, *tidcommon.ServiceError) {
+	entity, epErr := p.entityProvider.GetDeletedEntity(actorID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeNotImplemented {
+			return nil, &ErrorEntityNotFound
+		}
+		return nil, mapEntityProviderError(epErr)
+	}
+	return entity, nil
+}
+
 // GetActorGroups returns transitive group memberships for the given actor ID.
 func (p *actorProvider) GetActorGroups(
 	actorID string,
@@ -161,7 +174,10 @@ func toProviderOAuthClient(c *providers.OAuthClient) *providers.OAuthClient {
 		PublicClient:                       c.PublicClient,
 		RequirePushedAuthorizationRequests: c.RequirePushedAuthorizationRequests,
 		DPoPBoundAccessTokens:              c.DPoPBoundAccessTokens,
+		RedirectURIMatching:                c.RedirectURIMatching,
+		ApplicationType:                    c.ApplicationType,
 		IncludeActClaim:                    c.IncludeActClaim,
+		EnforceEssentialClaims:             c.EnforceEssentialClaims,
 		EntityCategory:                     c.EntityCategory,
 		Token:                              c.Token,
 		Scopes:                             c.Scopes,
@@ -169,6 +185,8 @@ func toProviderOAuthClient(c *providers.OAuthClient) *providers.OAuthClient {
 		ScopeClaims:                        c.ScopeClaims,
 		Certificate:                        c.Certificate,
 		AcrValues:                          c.AcrValues,
+		AllowedOrigins:                     c.AllowedOrigins,
+		AllowedNetworks:                    c.AllowedNetworks,
 	}
 	client.GrantTypes = append(client.GrantTypes, c.GrantTypes...)
 	client.ResponseTypes = append(client.ResponseTypes, c.ResponseTypes...)
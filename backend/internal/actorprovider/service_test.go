@@ -164,3 +164,24 @@ func (s *ActorProviderTestSuite) TestGetActorGroups_Delegates() {
 	s.Nil(err)
 	s.Equal(expected, groups)
 }
+
+func (s *ActorProviderTestSuite) TestGetDeletedActor_Delegates() {
+	expected := &providers.Entity{ID: "user-1"}
+	s.mockEntity.On("GetDeletedEntity", "user-1").Return(expected, (*entityprovider.EntityProviderError)(nil))
+
+	entity, err := s.provider.GetDeletedActor("user-1")
+
+	s.Nil(err)
+	s.Equal(expected, entity)
+}
+
+func (s *ActorProviderTestSuite) TestGetDeletedActor_NotImplementedReportedAsNotFound() {
+	s.mockEntity.On("GetDeletedEntity", "user-1").Return(nil,
+		&entityprovider.EntityProviderError{Code: entityprovider.ErrorCodeNotImplemented})
+
+	entity, err := s.provider.GetDeletedActor("user-1")
+
+	s.Nil(entity)
+	s.Require().NotNil(err)
+	s.Equal(ErrorEntityNotFound.Code, err.Code)
+}
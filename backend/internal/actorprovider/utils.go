@@ -57,6 +57,8 @@ func assembleApplication(
 			Assertion:        client.Assertion,
 			LoginConsent:     client.LoginConsent,
 			AllowedUserTypes: client.AllowedUserTypes,
+			Sharing:          client.Sharing,
+			AccessPolicy:     client.AccessPolicy,
 		},
 	}
 
@@ -111,10 +113,29 @@ func BuildApplicationMetadata(
 		if v, ok := props["policy_uri"].(string); ok {
 			meta.PolicyURI = v
 		}
+		meta.Branding = ParseApplicationBranding(props)
 	}
 	return meta
 }
 
+// ParseApplicationBranding decodes the branding entry of inbound-client properties.
+// Returns nil when the properties hold no valid branding.
+func ParseApplicationBranding(props map[string]interface{}) *providers.ApplicationBranding {
+	raw, ok := props["branding"]
+	if !ok || raw == nil {
+		return nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+	var branding providers.ApplicationBranding
+	if err := json.Unmarshal(data, &branding); err != nil {
+		return nil
+	}
+	return &branding
+}
+
 // readEntitySystemAttributes unmarshals system attributes from an actor record.
 func readEntitySystemAttributes(entity *providers.Entity) map[string]interface{} {
 	if entity == nil || len(entity.SystemAttributes) == 0 {
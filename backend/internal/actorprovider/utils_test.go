@@ -131,6 +131,27 @@ func (s *UtilsTestSuite) TestBuildApplicationMetadata_InvalidEntityJSON() {
 	s.Empty(meta.Name)
 }
 
+func (s *UtilsTestSuite) TestBuildApplicationMetadata_Branding() {
+	props := map[string]interface{}{
+		"logo_url": "https://example.com/logo.png",
+		"branding": map[string]interface{}{
+			"logoUrl":       "https://example.com/brand.png",
+			"colors":        map[string]interface{}{"primary": "#112233"},
+			"localizedText": map[string]interface{}{"en": map[string]interface{}{"title": "Welcome"}},
+		},
+	}
+	meta := BuildApplicationMetadata("app-1", nil, props)
+	s.Require().NotNil(meta.Branding)
+	s.Equal("https://example.com/brand.png", meta.Branding.LogoURL)
+	s.Equal("#112233", meta.Branding.Colors.Primary)
+	s.Equal("Welcome", meta.Branding.LocalizedText["en"]["title"])
+}
+
+func (s *UtilsTestSuite) TestParseApplicationBranding_Absent() {
+	s.Nil(ParseApplicationBranding(map[string]interface{}{"url": "https://example.com"}))
+	s.Nil(ParseApplicationBranding(map[string]interface{}{"branding": "not-an-object"}))
+}
+
 func (s *UtilsTestSuite) TestAssembleApplication_NoClientID() {
 	client := &providers.InboundClient{ID: "app-1"}
 	app := assembleApplication(client, nil)
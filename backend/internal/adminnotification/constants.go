@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import "time"
+
+const loggerComponentName = "AdminNotificationService"
+
+const (
+	// notificationProtocol is the WebSocket subprotocol a client must offer to receive notifications.
+	notificationProtocol = "admin-notifications.v1"
+	// clientSendBuffer is the number of notifications queued for a client before it is dropped as too slow.
+	clientSendBuffer = 64
+	// notificationWriteTimeout bounds a single write to a client.
+	notificationWriteTimeout = 10 * time.Second
+	// pingInterval is how often a ping is sent to detect clients that went away without closing.
+	pingInterval = 30 * time.Second
+	// deliveryFailureCooldown is the minimum time between two webhook delivery failure notifications.
+	deliveryFailureCooldown = time.Minute
+)
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for admin notification operations.
+var (
+	// ErrorInvalidUpgradeRequest is the error returned when the request is not a WebSocket upgrade that offers
+	// the admin notification subprotocol.
+	ErrorInvalidUpgradeRequest = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "ADN-1001",
+		Error: common.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_upgrade_request",
+			DefaultValue: "Invalid upgrade request",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_upgrade_request_description",
+			DefaultValue: "The request must be a WebSocket upgrade that offers the admin-notifications.v1 subprotocol",
+		},
+	}
+	// ErrorTooManyConnections is the error returned when the maximum number of clients is connected.
+	ErrorTooManyConnections = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "ADN-1002",
+		Error: common.I18nMessage{
+			Key:          "error.adminnotificationservice.too_many_connections",
+			DefaultValue: "Too many connections",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.adminnotificationservice.too_many_connections_description",
+			DefaultValue: "The maximum number of admin notification connections is reached; retry later",
+		},
+	}
+)
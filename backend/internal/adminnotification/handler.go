@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// adminNotificationHandler serves the admin notification WebSocket.
+type adminNotificationHandler struct {
+	hub    *hub
+	logger *log.Logger
+}
+
+func newAdminNotificationHandler(h *hub) *adminNotificationHandler {
+	return &adminNotificationHandler{
+		hub:    h,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// HandleNotifications upgrades the request to a WebSocket and pushes admin notifications to it until the
+// client disconnects.
+func (h *adminNotificationHandler) HandleNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") || !offersNotificationProtocol(r) {
+		handleError(ctx, w, http.StatusBadRequest, &ErrorInvalidUpgradeRequest)
+		return
+	}
+
+	c, ok := h.hub.register()
+	if !ok {
+		h.logger.Warn(ctx, "Rejected an admin notification client as the connection limit is reached")
+		handleError(ctx, w, http.StatusServiceUnavailable, &ErrorTooManyConnections)
+		return
+	}
+	defer h.hub.unregister(c)
+
+	server := websocket.Server{
+		Handshake: func(cfg *websocket.Config, _ *http.Request) error {
+			// Only the notification subprotocol is accepted, so that the bearer token offered as a
+			// subprotocol is never echoed back.
+			cfg.Protocol = []string{notificationProtocol}
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			h.serve(ctx, conn, c)
+		},
+	}
+	server.ServeHTTP(hijackableWriter{w}, r)
+}
+
+// serve writes the notifications queued for a client and pings it until either side closes.
+func (h *adminNotificationHandler) serve(ctx context.Context, conn *websocket.Conn, c *client) {
+	h.logger.Debug(ctx, "Admin notification client connected")
+
+	// The server read and write timeouts still apply to the hijacked connection.
+	_ = conn.SetReadDeadline(time.Time{})
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		var discard string
+		for {
+			if err := websocket.Message.Receive(conn, &discard); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-closed:
+			h.logger.Debug(ctx, "Admin notification client disconnected")
+			return
+		case n, ok := <-c.send:
+			if !ok {
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(notificationWriteTimeout))
+			if err := websocket.JSON.Send(conn, n); err != nil {
+				h.logger.Debug(ctx, "Failed to send admin notification", log.Error(err))
+				return
+			}
+		case <-ping.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(notificationWriteTimeout))
+			conn.PayloadType = websocket.PingFrame
+			_, err := conn.Write(nil)
+			conn.PayloadType = websocket.TextFrame
+			if err != nil {
+				h.logger.Debug(ctx, "Failed to ping admin notification client", log.Error(err))
+				return
+			}
+		}
+	}
+}
+
+// offersNotificationProtocol reports whether the request offers the admin notification subprotocol.
+func offersNotificationProtocol(r *http.Request) bool {
+	for _, header := range r.Header.Values(serverconst.WebSocketProtocolHeaderName) {
+		for _, protocol := range strings.Split(header, ",") {
+			if strings.TrimSpace(protocol) == notificationProtocol {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hijackableWriter exposes connection hijacking through the response writer wrappers of the middleware
+// chain, which the WebSocket server requires.
+type hijackableWriter struct {
+	http.ResponseWriter
+}
+
+// Hijack takes over the underlying connection.
+func (w hijackableWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+// handleError writes a service error with the given status code.
+func handleError(ctx context.Context, w http.ResponseWriter, statusCode int, svcErr *common.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+// wrappingWriter mimics the response writer wrappers of the middleware chain.
+type wrappingWriter struct {
+	http.ResponseWriter
+}
+
+func (w *wrappingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func newTestServer(h *hub) *httptest.Server {
+	handler := newAdminNotificationHandler(h)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.HandleNotifications(&wrappingWriter{w}, r)
+	}))
+}
+
+func dial(t *testing.T, server *httptest.Server, protocols ...string) *websocket.Conn {
+	cfg, err := websocket.NewConfig(strings.Replace(server.URL, "http", "ws", 1), server.URL)
+	require.NoError(t, err)
+	cfg.Protocol = protocols
+	conn, err := websocket.DialConfig(cfg)
+	require.NoError(t, err)
+	return conn
+}
+
+func TestHandleNotifications_PushesNotifications(t *testing.T) {
+	h := newHub(1)
+	server := newTestServer(h)
+	defer server.Close()
+
+	conn := dial(t, server, notificationProtocol, "bearer.secret-token")
+	defer func() { _ = conn.Close() }()
+	assert.Equal(t, []string{notificationProtocol}, conn.Config().Protocol)
+
+	require.Eventually(t, func() bool { return h.connectionCount() == 1 }, time.Second, 10*time.Millisecond)
+	h.broadcast(Notification{ID: "n-1", Type: NotificationTypeAccountLocked,
+		Data: map[string]interface{}{"userId": "user-1"}})
+
+	var received Notification
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	require.NoError(t, websocket.JSON.Receive(conn, &received))
+	assert.Equal(t, "n-1", received.ID)
+	assert.Equal(t, NotificationTypeAccountLocked, received.Type)
+	assert.Equal(t, "user-1", received.Data["userId"])
+
+	require.NoError(t, conn.Close())
+	assert.Eventually(t, func() bool { return h.connectionCount() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestHandleNotifications_RejectsRequestWithoutProtocol(t *testing.T) {
+	server := newTestServer(newHub(1))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	cfg, err := websocket.NewConfig(strings.Replace(server.URL, "http", "ws", 1), server.URL)
+	require.NoError(t, err)
+	cfg.Protocol = []string{"other"}
+	_, err = websocket.DialConfig(cfg)
+	assert.Error(t, err)
+}
+
+func TestHandleNotifications_RejectsClientsOverLimit(t *testing.T) {
+	h := newHub(1)
+	server := newTestServer(h)
+	defer server.Close()
+
+	conn := dial(t, server, notificationProtocol)
+	defer func() { _ = conn.Close() }()
+	require.Eventually(t, func() bool { return h.connectionCount() == 1 }, time.Second, 10*time.Millisecond)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Protocol", notificationProtocol)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
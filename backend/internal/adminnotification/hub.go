@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import (
+	"context"
+	"sync"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// client is a connected admin client.
+type client struct {
+	send chan Notification
+}
+
+// hub keeps the connected clients and broadcasts notifications to them.
+type hub struct {
+	mu             sync.Mutex
+	clients        map[*client]struct{}
+	maxConnections int
+	logger         *log.Logger
+}
+
+func newHub(maxConnections int) *hub {
+	return &hub{
+		clients:        make(map[*client]struct{}),
+		maxConnections: maxConnections,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// register adds a client, unless the connection limit is reached.
+func (h *hub) register() (*client, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.clients) >= h.maxConnections {
+		return nil, false
+	}
+	c := &client{send: make(chan Notification, clientSendBuffer)}
+	h.clients[c] = struct{}{}
+	return c, true
+}
+
+// unregister removes a client and closes its send channel. It is a no-op for a client already removed.
+func (h *hub) unregister(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(c)
+}
+
+// remove removes a client. The caller must hold the lock.
+func (h *hub) remove(c *client) {
+	if _, ok := h.clients[c]; ok {
+		delete(h.clients, c)
+		close(c.send)
+	}
+}
+
+// broadcast queues a notification for every client without blocking. A client whose queue is full is
+// disconnected, as it cannot keep up.
+func (h *hub) broadcast(n Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for c := range h.clients {
+		select {
+		case c.send <- n:
+		default:
+			h.remove(c)
+			// Broadcasts run on the observability dispatch goroutines, outside any request.
+			h.logger.Warn(context.Background(), "Disconnected an admin notification client that is not keeping up")
+		}
+	}
+}
+
+// connectionCount returns the number of connected clients.
+func (h *hub) connectionCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.clients)
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_RegisterEnforcesConnectionLimit(t *testing.T) {
+	h := newHub(1)
+
+	c, ok := h.register()
+	require.True(t, ok)
+	_, ok = h.register()
+	assert.False(t, ok)
+
+	h.unregister(c)
+	h.unregister(c)
+	_, ok = h.register()
+	assert.True(t, ok)
+}
+
+func TestHub_BroadcastDropsSlowClients(t *testing.T) {
+	h := newHub(2)
+	slow, _ := h.register()
+	fast, _ := h.register()
+
+	for i := 0; i < clientSendBuffer; i++ {
+		h.broadcast(Notification{ID: "n"})
+		<-fast.send
+	}
+	h.broadcast(Notification{ID: "last"})
+
+	assert.Equal(t, 1, h.connectionCount())
+	assert.Equal(t, "last", (<-fast.send).ID)
+	for range slow.send {
+	}
+	h.unregister(slow)
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package adminnotification provides a WebSocket channel that pushes admin-relevant events, such as account
+// lockouts, spikes in failed operations and webhook delivery failures, to the operations dashboard as they
+// happen.
+package adminnotification
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// Initialize subscribes the notifier to the observability events and registers the WebSocket route.
+// Nothing is registered when admin notifications are disabled.
+func Initialize(mux *http.ServeMux, cfg config.AdminNotificationsConfig,
+	observabilitySvc observability.ObservabilityServiceInterface) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	// Initialization runs during application startup, outside any request.
+	ctx := context.Background()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return err
+	}
+	h := newHub(cfg.MaxConnections)
+	n := newNotifier(id, h, cfg.FailureSpikeThreshold, time.Duration(cfg.FailureSpikeWindowSeconds)*time.Second)
+
+	// The notifications are derived from observability events, so none are sent without it.
+	if pub := observabilitySvc.GetPublisher(); pub != nil {
+		pub.Subscribe(n)
+	} else {
+		logger.Warn(ctx, "Admin notifications are enabled but observability is disabled; no notifications "+
+			"will be sent")
+	}
+	subscriber.AddWebhookDeliveryFailureListener(n.onDeliveryFailure)
+
+	registerRoutes(mux, newAdminNotificationHandler(h))
+	return nil
+}
+
+// registerRoutes registers the routes for admin notifications.
+func registerRoutes(mux *http.ServeMux, handler *adminNotificationHandler) {
+	mux.HandleFunc("GET "+constants.AdminNotificationsPath, handler.HandleNotifications)
+}
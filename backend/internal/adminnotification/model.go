@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import "time"
+
+// NotificationType identifies the kind of an admin notification.
+type NotificationType string
+
+const (
+	// NotificationTypeAccountLocked is sent when a user account is locked.
+	NotificationTypeAccountLocked NotificationType = "ACCOUNT_LOCKED"
+	// NotificationTypeFailureSpike is sent when the number of failed operations within the configured
+	// window reaches the configured threshold.
+	NotificationTypeFailureSpike NotificationType = "FAILURE_SPIKE"
+	// NotificationTypeWebhookDeliveryFailed is sent when an observability event could not be delivered to
+	// the webhook endpoint.
+	NotificationTypeWebhookDeliveryFailed NotificationType = "WEBHOOK_DELIVERY_FAILED"
+)
+
+// Notification is a message pushed to the connected admin clients.
+type Notification struct {
+	ID        string                 `json:"id"`
+	Type      NotificationType       `json:"type"`
+	Timestamp time.Time              `json:"timestamp"`
+	Data      map[string]interface{} `json:"data"`
+}
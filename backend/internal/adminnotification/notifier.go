@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// failureRecord is a failed operation counted towards a failure spike.
+type failureRecord struct {
+	at        time.Time
+	eventType string
+}
+
+// notifier turns observability events and webhook delivery failures into admin notifications.
+type notifier struct {
+	id        string
+	hub       *hub
+	threshold int
+	window    time.Duration
+	now       func() time.Time
+	logger    *log.Logger
+
+	mu                   sync.Mutex
+	failures             []failureRecord
+	lastSpike            time.Time
+	lastDeliveryFailure  time.Time
+	suppressedDeliveries int
+}
+
+var _ subscriber.SubscriberInterface = (*notifier)(nil)
+
+func newNotifier(id string, h *hub, threshold int, window time.Duration) *notifier {
+	return &notifier{
+		id:        id,
+		hub:       h,
+		threshold: threshold,
+		window:    window,
+		now:       time.Now,
+		logger:    log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetID returns the unique identifier of the subscriber.
+func (n *notifier) GetID() string {
+	return n.id
+}
+
+// GetCategories returns the categories the subscriber listens to. Failures are counted across all of them.
+func (n *notifier) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryAll}
+}
+
+// IsEnabled always returns true, as the notifier is only subscribed when admin notifications are enabled.
+func (n *notifier) IsEnabled() bool {
+	return true
+}
+
+// Initialize is a no-op; the notifier is fully set up by newNotifier.
+func (n *notifier) Initialize() error {
+	return nil
+}
+
+// Close is a no-op; the connected clients are owned by the hub.
+func (n *notifier) Close() error {
+	return nil
+}
+
+// OnEvent notifies the clients of account lockouts and failure spikes.
+func (n *notifier) OnEvent(evt *providers.Event) error {
+	if evt == nil {
+		return nil
+	}
+
+	if evt.Type == string(event.EventTypeUserStateChanged) &&
+		evt.Data[event.DataKey.AccountState] == string(providers.EntityStateLocked) {
+		n.notify(NotificationTypeAccountLocked, map[string]interface{}{
+			"userId":        evt.Data[event.DataKey.UserID],
+			"actorId":       evt.Data[event.DataKey.ActorID],
+			"previousState": evt.Data[event.DataKey.PreviousState],
+		})
+	}
+
+	if evt.Status == providers.StatusFailure {
+		n.recordFailure(evt.Type)
+	}
+	return nil
+}
+
+// recordFailure counts a failed operation and notifies the clients once the threshold is reached within
+// the window. At most one spike is reported per window.
+func (n *notifier) recordFailure(eventType string) {
+	now := n.now()
+
+	n.mu.Lock()
+	n.failures = append(n.failures, failureRecord{at: now, eventType: eventType})
+	// Only the latest threshold failures matter, which keeps the memory bounded.
+	if len(n.failures) > n.threshold {
+		n.failures = n.failures[len(n.failures)-n.threshold:]
+	}
+	if len(n.failures) < n.threshold || now.Sub(n.failures[0].at) > n.window ||
+		(!n.lastSpike.IsZero() && now.Sub(n.lastSpike) < n.window) {
+		n.mu.Unlock()
+		return
+	}
+
+	eventTypes := make(map[string]int)
+	for _, f := range n.failures {
+		eventTypes[f.eventType]++
+	}
+	failureCount := len(n.failures)
+	n.failures = nil
+	n.lastSpike = now
+	n.mu.Unlock()
+
+	n.notify(NotificationTypeFailureSpike, map[string]interface{}{
+		"failureCount":  failureCount,
+		"windowSeconds": int(n.window.Seconds()),
+		"eventTypes":    eventTypes,
+	})
+}
+
+// onDeliveryFailure notifies the clients of a webhook delivery failure. Failures within the cooldown of
+// the last notification are only counted and reported with the next one.
+func (n *notifier) onDeliveryFailure(evt *providers.Event, err error) {
+	now := n.now()
+
+	n.mu.Lock()
+	if !n.lastDeliveryFailure.IsZero() && now.Sub(n.lastDeliveryFailure) < deliveryFailureCooldown {
+		n.suppressedDeliveries++
+		n.mu.Unlock()
+		return
+	}
+	suppressed := n.suppressedDeliveries
+	n.suppressedDeliveries = 0
+	n.lastDeliveryFailure = now
+	n.mu.Unlock()
+
+	data := map[string]interface{}{
+		"error":              err.Error(),
+		"suppressedFailures": suppressed,
+	}
+	if evt != nil {
+		data["eventId"] = evt.EventID
+		data["eventType"] = evt.Type
+	}
+	n.notify(NotificationTypeWebhookDeliveryFailed, data)
+}
+
+// notify broadcasts a notification to the connected clients.
+func (n *notifier) notify(notificationType NotificationType, data map[string]interface{}) {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		// Notifications are raised from observability dispatch goroutines, outside any request.
+		n.logger.Error(context.Background(), "Failed to generate admin notification ID", log.Error(err))
+		return
+	}
+	n.hub.broadcast(Notification{
+		ID:        id,
+		Type:      notificationType,
+		Timestamp: n.now().UTC(),
+		Data:      data,
+	})
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package adminnotification
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+func newTestNotifier(t *testing.T, threshold int) (*notifier, *client, *time.Time) {
+	h := newHub(1)
+	c, ok := h.register()
+	require.True(t, ok)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	n := newNotifier("notifier-1", h, threshold, time.Minute)
+	n.now = func() time.Time { return now }
+	return n, c, &now
+}
+
+func failureEvent(eventType providers.EventType) *providers.Event {
+	return event.NewEvent("trace-1", string(eventType), event.ComponentAuthHandler).
+		WithStatus(providers.StatusFailure)
+}
+
+func TestNotifier_AccountLocked(t *testing.T) {
+	n, c, _ := newTestNotifier(t, 10)
+
+	evt := event.NewEvent("trace-1", string(event.EventTypeUserStateChanged), event.ComponentUserManagement).
+		WithStatus(providers.StatusSuccess).
+		WithData(event.DataKey.UserID, "user-1").
+		WithData(event.DataKey.ActorID, "admin-1").
+		WithData(event.DataKey.PreviousState, string(providers.EntityStateActive)).
+		WithData(event.DataKey.AccountState, string(providers.EntityStateLocked))
+	require.NoError(t, n.OnEvent(evt))
+
+	notification := <-c.send
+	assert.Equal(t, NotificationTypeAccountLocked, notification.Type)
+	assert.NotEmpty(t, notification.ID)
+	assert.Equal(t, "user-1", notification.Data["userId"])
+	assert.Equal(t, "admin-1", notification.Data["actorId"])
+	assert.Equal(t, string(providers.EntityStateActive), notification.Data["previousState"])
+}
+
+func TestNotifier_IgnoresOtherStateChanges(t *testing.T) {
+	n, c, _ := newTestNotifier(t, 10)
+
+	evt := event.NewEvent("trace-1", string(event.EventTypeUserStateChanged), event.ComponentUserManagement).
+		WithStatus(providers.StatusSuccess).
+		WithData(event.DataKey.AccountState, string(providers.EntityStateActive))
+	require.NoError(t, n.OnEvent(evt))
+	require.NoError(t, n.OnEvent(nil))
+
+	assert.Empty(t, c.send)
+}
+
+func TestNotifier_FailureSpike(t *testing.T) {
+	n, c, now := newTestNotifier(t, 3)
+
+	require.NoError(t, n.OnEvent(failureEvent(event.EventTypeTokenIssuanceFailed)))
+	// A failure that falls out of the window does not count towards the spike.
+	*now = now.Add(2 * time.Minute)
+	require.NoError(t, n.OnEvent(failureEvent(event.EventTypeTokenIssuanceFailed)))
+	require.NoError(t, n.OnEvent(failureEvent(event.EventTypeFlowFailed)))
+	assert.Empty(t, c.send)
+
+	require.NoError(t, n.OnEvent(failureEvent(event.EventTypeTokenIssuanceFailed)))
+	notification := <-c.send
+	assert.Equal(t, NotificationTypeFailureSpike, notification.Type)
+	assert.Equal(t, 3, notification.Data["failureCount"])
+	assert.Equal(t, 60, notification.Data["windowSeconds"])
+	assert.Equal(t, map[string]int{
+		string(event.EventTypeTokenIssuanceFailed): 2,
+		string(event.EventTypeFlowFailed):          1,
+	}, notification.Data["eventTypes"])
+
+	// Only one spike is reported per window.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, n.OnEvent(failureEvent(event.EventTypeTokenIssuanceFailed)))
+	}
+	assert.Empty(t, c.send)
+
+	*now = now.Add(time.Minute)
+	require.NoError(t, n.OnEvent(failureEvent(event.EventTypeTokenIssuanceFailed)))
+	assert.Equal(t, NotificationTypeFailureSpike, (<-c.send).Type)
+}
+
+func TestNotifier_DeliveryFailuresAreThrottled(t *testing.T) {
+	n, c, now := newTestNotifier(t, 10)
+	evt := event.NewEvent("trace-1", string(event.EventTypeTokenIssued), event.ComponentAuthHandler)
+
+	n.onDeliveryFailure(evt, errors.New("webhook endpoint responded with status 500"))
+	notification := <-c.send
+	assert.Equal(t, NotificationTypeWebhookDeliveryFailed, notification.Type)
+	assert.Equal(t, evt.EventID, notification.Data["eventId"])
+	assert.Equal(t, string(event.EventTypeTokenIssued), notification.Data["eventType"])
+	assert.Equal(t, "webhook endpoint responded with status 500", notification.Data["error"])
+	assert.Equal(t, 0, notification.Data["suppressedFailures"])
+
+	n.onDeliveryFailure(evt, errors.New("timeout"))
+	n.onDeliveryFailure(evt, errors.New("timeout"))
+	assert.Empty(t, c.send)
+
+	*now = now.Add(deliveryFailureCooldown)
+	n.onDeliveryFailure(evt, errors.New("timeout"))
+	notification = <-c.send
+	assert.Equal(t, 2, notification.Data["suppressedFailures"])
+}
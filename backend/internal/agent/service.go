@@ -1292,7 +1292,10 @@ func buildOAuthProfile(configs []providers.InboundAuthConfigWithSecret) *provide
 		PublicClient:                       cfg.PublicClient,
 		RequirePushedAuthorizationRequests: cfg.RequirePushedAuthorizationRequests,
 		DPoPBoundAccessTokens:              cfg.DPoPBoundAccessTokens,
+		RedirectURIMatching:                string(cfg.RedirectURIMatching),
+		ApplicationType:                    string(cfg.ApplicationType),
 		IncludeActClaim:                    cfg.IncludeActClaim,
+		EnforceEssentialClaims:             cfg.EnforceEssentialClaims,
 		Certificate:                        cfg.Certificate,
 		Token:                              cfg.Token,
 		Scopes:                             cfg.Scopes,
@@ -1317,7 +1320,10 @@ func oauthProfileToComplete(clientID string, p *providers.OAuthProfile) *provide
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
 		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		RedirectURIMatching:                providers.RedirectURIMatching(p.RedirectURIMatching),
+		ApplicationType:                    providers.ApplicationType(p.ApplicationType),
 		IncludeActClaim:                    p.IncludeActClaim,
+		EnforceEssentialClaims:             p.EnforceEssentialClaims,
 		Certificate:                        p.Certificate,
 		Token:                              p.Token,
 		Scopes:                             p.Scopes,
@@ -1456,6 +1462,16 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.agentservice.redirect_uri_fragment_not_allowed_description",
 			DefaultValue: "Redirect URIs must not contain a fragment component",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURIWildcardHostNotAllowed):
+		return tidcommon.CustomServiceError(ErrorInvalidRedirectURI, tidcommon.I18nMessage{
+			Key:          "error.agentservice.redirect_uri_wildcard_host_not_allowed_description",
+			DefaultValue: "Redirect URI hosts must not contain wildcards",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRedirectURIMatching):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_redirect_uri_matching_description",
+			DefaultValue: "Redirect URI matching must be 'exact' or 'loopback'",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.agentservice.auth_code_requires_redirect_uris_description",
@@ -1543,6 +1559,29 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.agentservice.public_client_must_have_pkce_description",
 			DefaultValue: "Public clients must have PKCE required set to true",
 		})
+
+	// OAuth: application type
+	case errors.Is(err, inboundclient.ErrOAuthInvalidApplicationType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.invalid_application_type_description",
+			DefaultValue: "Application type must be 'web', 'native' or 'spa'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthApplicationTypeCannotUseClientSecret):
+		return tidcommon.CustomServiceError(ErrorInvalidPublicClientConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.application_type_cannot_use_client_secret_description",
+			DefaultValue: "Native and SPA applications cannot use a client secret",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthApplicationTypeRequiresPKCE):
+		return tidcommon.CustomServiceError(ErrorInvalidPublicClientConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.application_type_requires_pkce_description",
+			DefaultValue: "Native and SPA applications must have PKCE required set to true",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURINotAllowedForApplicationType):
+		return tidcommon.CustomServiceError(ErrorInvalidRedirectURI, tidcommon.I18nMessage{
+			Key: "error.agentservice.redirect_uri_not_allowed_for_application_type_description",
+			DefaultValue: "Web and SPA applications must use http or https redirect URIs; native applications " +
+				"must use a custom scheme, https, or an http loopback redirect URI",
+		})
 	}
 	return nil
 }
@@ -1628,6 +1667,22 @@ func translateIDTokenValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.agentservice.idtoken_unsupported_response_type_description",
 			DefaultValue: "ID token responseType is not supported",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthIDTokenUnsupportedSigningAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.idtoken_unsupported_signing_alg_description",
+			DefaultValue: "ID token signing algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAccessTokenUnsupportedSigningAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.agentservice.accesstoken_unsupported_signing_alg_description",
+			DefaultValue: "Access token signing algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAccessTokenInvalidClaimsPolicy):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.agentservice.accesstoken_invalid_claims_policy_description",
+			DefaultValue: "Access token claims policy must use non-empty claim names, must not both exclude " +
+				"and hash a claim, and must map scoped claims to a non-empty scope",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthIDTokenUnsupportedEncryptionAlg):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.agentservice.idtoken_unsupported_encryption_alg_description",
@@ -1032,6 +1032,15 @@ func (suite *AgentServiceTestSuite) TestTranslateOAuthValidationError() {
 		{"RedirectURIFragmentNotAllowed", inboundclient.ErrOAuthRedirectURIFragmentNotAllowed,
 			ErrorInvalidRedirectURI.Code,
 			"error.agentservice.redirect_uri_fragment_not_allowed_description"},
+		{"RedirectURIWildcardHostNotAllowed", inboundclient.ErrOAuthRedirectURIWildcardHostNotAllowed,
+			ErrorInvalidRedirectURI.Code,
+			"error.agentservice.redirect_uri_wildcard_host_not_allowed_description"},
+		{"InvalidRedirectURIMatching", inboundclient.ErrOAuthInvalidRedirectURIMatching,
+			ErrorInvalidOAuthConfiguration.Code,
+			"error.agentservice.invalid_redirect_uri_matching_description"},
+		{"RedirectURINotAllowedForApplicationType", inboundclient.ErrOAuthRedirectURINotAllowedForApplicationType,
+			ErrorInvalidRedirectURI.Code,
+			"error.agentservice.redirect_uri_not_allowed_for_application_type_description"},
 		{"AuthCodeRequiresRedirectURIs", inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs,
 			ErrorInvalidOAuthConfiguration.Code,
 			"error.agentservice.auth_code_requires_redirect_uris_description"},
@@ -1081,6 +1090,15 @@ func (suite *AgentServiceTestSuite) TestTranslateOAuthValidationError() {
 		{"PublicClientMustHavePKCE", inboundclient.ErrOAuthPublicClientMustHavePKCE,
 			ErrorInvalidPublicClientConfiguration.Code,
 			"error.agentservice.public_client_must_have_pkce_description"},
+		{"InvalidApplicationType", inboundclient.ErrOAuthInvalidApplicationType,
+			ErrorInvalidOAuthConfiguration.Code,
+			"error.agentservice.invalid_application_type_description"},
+		{"ApplicationTypeCannotUseClientSecret", inboundclient.ErrOAuthApplicationTypeCannotUseClientSecret,
+			ErrorInvalidPublicClientConfiguration.Code,
+			"error.agentservice.application_type_cannot_use_client_secret_description"},
+		{"ApplicationTypeRequiresPKCE", inboundclient.ErrOAuthApplicationTypeRequiresPKCE,
+			ErrorInvalidPublicClientConfiguration.Code,
+			"error.agentservice.application_type_requires_pkce_description"},
 	}
 	for _, tc := range cases {
 		suite.Run(tc.name, func() {
@@ -36,5 +36,15 @@ const (
 	propContacts    = "contacts"
 	propTemplate    = "template"
 	propMetadata    = "metadata"
+	propBranding    = "branding"
 	propOAuthConfig = "oauth_config"
 )
+
+// queryParamPermanent is the query parameter that forces a permanent delete when soft deletion is enabled.
+const queryParamPermanent = "permanent"
+
+// Query parameters for paginating the application configuration history.
+const (
+	queryParamLimit  = "limit"
+	queryParamOffset = "offset"
+)
@@ -187,6 +187,8 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 			LayoutID:                  appRequest.LayoutID,
 			Assertion:                 appRequest.Assertion,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			Sharing:                   appRequest.Sharing,
+			AccessPolicy:              appRequest.AccessPolicy,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:   appRequest.Template,
@@ -218,12 +220,17 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 					PublicClient:                       config.OAuthConfig.PublicClient,
 					RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
 					DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+					RedirectURIMatching:                config.OAuthConfig.RedirectURIMatching,
+					ApplicationType:                    config.OAuthConfig.ApplicationType,
 					IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
+					EnforceEssentialClaims:             config.OAuthConfig.EnforceEssentialClaims,
 					Token:                              config.OAuthConfig.Token,
 					Scopes:                             config.OAuthConfig.Scopes,
 					UserInfo:                           config.OAuthConfig.UserInfo,
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
+					AllowedOrigins:                     config.OAuthConfig.AllowedOrigins,
+					AllowedNetworks:                    config.OAuthConfig.AllowedNetworks,
 				},
 			}
 			inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, inboundAuthConfigDTO)
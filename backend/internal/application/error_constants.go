@@ -516,4 +516,115 @@ var (
 				"browser-based single-page applications.",
 		},
 	}
+	// ErrorApplicationRestoreWindowExpired is returned when a soft-deleted application is restored after
+	// the retention window has passed.
+	ErrorApplicationRestoreWindowExpired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1038",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.restore_window_expired",
+			DefaultValue: "Restore window expired",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.restore_window_expired_description",
+			DefaultValue: "The retention window for restoring the application has passed",
+		},
+	}
+	// ErrorInvalidSharingConfiguration is returned when the organization unit sharing configuration
+	// references an unknown organization unit or shares the same organization unit or email domain twice.
+	ErrorInvalidSharingConfiguration = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1039",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_sharing_configuration",
+			DefaultValue: "Invalid sharing configuration",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.applicationservice.invalid_sharing_configuration_description",
+			DefaultValue: "Each shared organization unit must exist and be listed once, " +
+				"and an email domain can be claimed by only one organization unit",
+		},
+	}
+	// ErrorInvalidBranding is returned when the branding has an invalid logo URL, color, or language tag.
+	ErrorInvalidBranding = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1040",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_branding",
+			DefaultValue: "Invalid branding",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.applicationservice.invalid_branding_description",
+			DefaultValue: "The logo URL must be a valid URL, colors must be hex values, " +
+				"and localized text must be keyed by valid language tags",
+		},
+	}
+	// ErrorInvalidAccessPolicy is returned when the access policy has a malformed network entry or an
+	// invalid country code.
+	ErrorInvalidAccessPolicy = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1041",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_access_policy",
+			DefaultValue: "Invalid access policy",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.applicationservice.invalid_access_policy_description",
+			DefaultValue: "Networks must be valid IP addresses or CIDR ranges, " +
+				"and countries must be ISO 3166-1 alpha-2 codes",
+		},
+	}
+	// ErrorApplicationHistoryVersionNotFound is returned when the requested version of an application's
+	// inbound auth configuration history does not exist.
+	ErrorApplicationHistoryVersionNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1042",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.history_version_not_found",
+			DefaultValue: "History version not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.history_version_not_found_description",
+			DefaultValue: "The requested version of the application's configuration history could not be found",
+		},
+	}
+	// ErrorInvalidHistoryVersion is returned when the history version is not a positive integer.
+	ErrorInvalidHistoryVersion = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1043",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_history_version",
+			DefaultValue: "Invalid history version",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_history_version_description",
+			DefaultValue: "The history version must be a positive integer",
+		},
+	}
+	// ErrorInvalidLimit is returned for an invalid pagination limit.
+	ErrorInvalidLimit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1044",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_limit",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_limit_description",
+			DefaultValue: "The limit parameter must be between 1 and 100",
+		},
+	}
+	// ErrorInvalidOffset is returned for an invalid pagination offset.
+	ErrorInvalidOffset = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "APP-1045",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_offset",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_offset_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
 )
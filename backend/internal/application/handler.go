@@ -20,8 +20,11 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -29,6 +32,7 @@ import (
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 
 	"github.com/thunder-id/thunderid/internal/application/model"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
@@ -62,7 +66,7 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 			Message:     ErrorInvalidRequestFormat.Error,
 			Description: ErrorInvalidRequestFormat.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -80,6 +84,8 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 			LayoutID:                  appRequest.LayoutID,
 			Assertion:                 appRequest.Assertion,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			Sharing:                   appRequest.Sharing,
+			AccessPolicy:              appRequest.AccessPolicy,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:   appRequest.Template,
@@ -115,6 +121,8 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 			LayoutID:                  createdAppDTO.LayoutID,
 			Assertion:                 createdAppDTO.Assertion,
 			AllowedUserTypes:          createdAppDTO.AllowedUserTypes,
+			Sharing:                   createdAppDTO.Sharing,
+			AccessPolicy:              createdAppDTO.AccessPolicy,
 			LoginConsent:              createdAppDTO.LoginConsent,
 		},
 		Template:   createdAppDTO.Template,
@@ -136,7 +144,7 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 				Message:     tidcommon.InternalServerError.Error,
 				Description: tidcommon.InternalServerError.ErrorDescription,
 			}
-			sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
+			sysutils.WriteProblemResponse(ctx, w, http.StatusInternalServerError, errResp)
 			return
 		}
 	}
@@ -156,6 +164,151 @@ func (ah *applicationHandler) HandleApplicationListRequest(w http.ResponseWriter
 	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, listResponse)
 }
 
+// HandleDeletedApplicationListRequest handles the list soft-deleted applications request.
+func (ah *applicationHandler) HandleDeletedApplicationListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	listResponse, svcErr := ah.service.GetDeletedApplicationList(ctx)
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, listResponse)
+}
+
+// HandleApplicationRestoreRequest handles the restore soft-deleted application request.
+func (ah *applicationHandler) HandleApplicationRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	if svcErr := ah.service.RestoreApplication(ctx, id); svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+}
+
+// HandleApplicationBrandingGetRequest handles the get application branding request.
+func (ah *applicationHandler) HandleApplicationBrandingGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	branding, svcErr := ah.service.GetApplicationBranding(ctx, id)
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, branding)
+}
+
+// HandleApplicationBrandingPutRequest handles the replace application branding request.
+func (ah *applicationHandler) HandleApplicationBrandingPutRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	brandingRequest, err := sysutils.DecodeJSONBody[providers.ApplicationBranding](r)
+	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidRequestFormat.Code,
+			Message:     ErrorInvalidRequestFormat.Error,
+			Description: ErrorInvalidRequestFormat.ErrorDescription,
+		}
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	branding, svcErr := ah.service.UpdateApplicationBranding(ctx, id, brandingRequest)
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, branding)
+}
+
+// HandleApplicationHistoryListRequest handles the list application configuration history request.
+func (ah *applicationHandler) HandleApplicationHistoryListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	limit, offset, svcErr := parseHistoryPaginationParams(r.URL.Query())
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	history, svcErr := ah.service.GetApplicationHistory(ctx, id, limit, offset)
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, history)
+}
+
+// HandleApplicationHistoryGetRequest handles the get application configuration history version request.
+func (ah *applicationHandler) HandleApplicationHistoryGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	version, err := strconv.Atoi(r.PathValue("version"))
+	if err != nil {
+		ah.handleError(ctx, w, r, &ErrorInvalidHistoryVersion)
+		return
+	}
+
+	historyVersion, svcErr := ah.service.GetApplicationHistoryVersion(ctx, id, version)
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, historyVersion)
+}
+
+// HandleApplicationHistoryRestoreRequest handles the restore application configuration history version
+// request. The inbound auth configuration of the version replaces the current one through a regular
+// update, so it is validated like any other change and is recorded as a new version.
+func (ah *applicationHandler) HandleApplicationHistoryRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ApplicationHandler"))
+
+	id := r.PathValue("id")
+	version, err := strconv.Atoi(r.PathValue("version"))
+	if err != nil {
+		ah.handleError(ctx, w, r, &ErrorInvalidHistoryVersion)
+		return
+	}
+
+	historyVersion, svcErr := ah.service.GetApplicationHistoryVersion(ctx, id, version)
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	appDTO, svcErr := ah.service.GetApplication(ctx, id)
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	current, ok := buildApplicationGetResponse(ctx, logger, appDTO)
+	if !ok {
+		ah.handleError(ctx, w, r, &tidcommon.InternalServerError)
+		return
+	}
+	current.InboundAuthConfig = historyVersion.InboundAuthConfig
+
+	appRequest, err := toApplicationRequest(current)
+	if err != nil {
+		logger.Error(ctx, "Failed to build the application restore request", log.Error(err))
+		ah.handleError(ctx, w, r, &tidcommon.InternalServerError)
+		return
+	}
+
+	ah.updateApplication(ctx, w, r, logger, id, appRequest)
+}
+
 // HandleApplicationGetRequest handles the application request.
 func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -168,7 +321,7 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 			Message:     ErrorInvalidApplicationID.Error,
 			Description: ErrorInvalidApplicationID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -178,115 +331,59 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 		return
 	}
 
-	returnApp := model.ApplicationGetResponse{
-		ID:          appDTO.ID,
-		OUID:        appDTO.OUID,
-		Name:        appDTO.Name,
-		Description: appDTO.Description,
-		InboundAuthProfile: providers.InboundAuthProfile{
-			AuthFlowID:                appDTO.AuthFlowID,
-			RegistrationFlowID:        appDTO.RegistrationFlowID,
-			IsRegistrationFlowEnabled: appDTO.IsRegistrationFlowEnabled,
-			RecoveryFlowID:            appDTO.RecoveryFlowID,
-			IsRecoveryFlowEnabled:     appDTO.IsRecoveryFlowEnabled,
-			ThemeID:                   appDTO.ThemeID,
-			LayoutID:                  appDTO.LayoutID,
-			Assertion:                 appDTO.Assertion,
-			AllowedUserTypes:          appDTO.AllowedUserTypes,
-			LoginConsent:              appDTO.LoginConsent,
-		},
-		Template:  appDTO.Template,
-		URL:       appDTO.URL,
-		LogoURL:   appDTO.LogoURL,
-		TosURI:    appDTO.TosURI,
-		PolicyURI: appDTO.PolicyURI,
-		Contacts:  appDTO.Contacts,
-		Metadata:  appDTO.Metadata,
+	returnApp, ok := buildApplicationGetResponse(ctx, logger, appDTO)
+	if !ok {
+		errResp := apierror.ErrorResponse{
+			Code:        tidcommon.InternalServerError.Code,
+			Message:     tidcommon.InternalServerError.Error,
+			Description: tidcommon.InternalServerError.ErrorDescription,
+		}
+		sysutils.WriteProblemResponse(ctx, w, http.StatusInternalServerError, errResp)
+		return
 	}
 
-	// TODO: Need to refactor when supporting other/multiple inbound auth types.
-	if len(appDTO.InboundAuthConfig) > 0 {
-		if appDTO.InboundAuthConfig[0].Type != providers.OAuthInboundAuthType {
-			logger.Error(ctx, "Unsupported inbound authentication type returned",
-				log.String("type", string(appDTO.InboundAuthConfig[0].Type)))
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, returnApp)
+}
 
-			errResp := apierror.ErrorResponse{
-				Code:        tidcommon.InternalServerError.Code,
-				Message:     tidcommon.InternalServerError.Error,
-				Description: tidcommon.InternalServerError.ErrorDescription,
-			}
-			sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
-			return
-		}
+// HandleApplicationPutRequest handles the application request.
+func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ApplicationHandler"))
 
-		if appDTO.InboundAuthConfig[0].OAuthConfig == nil {
-			logger.Error(ctx, "OAuth application configuration is nil")
+	id := r.PathValue("id")
+	if id == "" {
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidApplicationID.Code,
+			Message:     ErrorInvalidApplicationID.Error,
+			Description: ErrorInvalidApplicationID.ErrorDescription,
+		}
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
+		return
+	}
 
-			errResp := apierror.ErrorResponse{
-				Code:        tidcommon.InternalServerError.Code,
-				Message:     tidcommon.InternalServerError.Error,
-				Description: tidcommon.InternalServerError.ErrorDescription,
-			}
-			sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
+	appRequest, err := sysutils.DecodeJSONBody[model.ApplicationRequest](r)
+	if err != nil {
+		var valErr *sysutils.ValidationError
+		if errors.As(err, &valErr) {
+			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
 			return
 		}
-
-		returnInboundAuthConfigs := make([]inboundmodel.InboundAuthConfig, 0, len(appDTO.InboundAuthConfig))
-		for _, config := range appDTO.InboundAuthConfig {
-			if config.OAuthConfig == nil {
-				logger.Error(ctx, "OAuth application configuration is nil")
-				errResp := apierror.ErrorResponse{
-					Code:        tidcommon.InternalServerError.Code,
-					Message:     tidcommon.InternalServerError.Error,
-					Description: tidcommon.InternalServerError.ErrorDescription,
-				}
-				sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
-				return
-			}
-			redirectURIs := config.OAuthConfig.RedirectURIs
-			if len(redirectURIs) == 0 {
-				redirectURIs = []string{}
-			}
-			grantTypes := config.OAuthConfig.GrantTypes
-			if len(grantTypes) == 0 {
-				grantTypes = []providers.GrantType{}
-			}
-			responseTypes := config.OAuthConfig.ResponseTypes
-			if len(responseTypes) == 0 {
-				responseTypes = []providers.ResponseType{}
-			}
-			oAuthAppConfig := inboundmodel.OAuthConfig{
-				ClientID:                           config.OAuthConfig.ClientID,
-				RedirectURIs:                       redirectURIs,
-				GrantTypes:                         grantTypes,
-				ResponseTypes:                      responseTypes,
-				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
-				PKCERequired:                       config.OAuthConfig.PKCERequired,
-				PublicClient:                       config.OAuthConfig.PublicClient,
-				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
-				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
-				IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
-				Token:                              config.OAuthConfig.Token,
-				Scopes:                             config.OAuthConfig.Scopes,
-				UserInfo:                           config.OAuthConfig.UserInfo,
-				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
-				Certificate:                        config.OAuthConfig.Certificate,
-				AcrValues:                          config.OAuthConfig.AcrValues,
-			}
-			returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfig{
-				Type:        config.Type,
-				OAuthConfig: &oAuthAppConfig,
-			})
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidRequestFormat.Code,
+			Message:     ErrorInvalidRequestFormat.Error,
+			Description: ErrorInvalidRequestFormat.ErrorDescription,
 		}
-		returnApp.InboundAuthConfig = returnInboundAuthConfigs
-		returnApp.ClientID = appDTO.InboundAuthConfig[0].OAuthConfig.ClientID
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
+		return
 	}
 
-	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, returnApp)
+	ah.updateApplication(ctx, w, r, logger, id, appRequest)
 }
 
-// HandleApplicationPutRequest handles the application request.
-func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter, r *http.Request) {
+// HandleApplicationPatchRequest handles the partial update application request. The body is a JSON
+// Merge Patch (RFC 7396) document applied to the application's current representation, as returned
+// by the GET endpoint. Arrays such as inboundAuthConfig are replaced as a whole, as RFC 7396 defines.
+func (ah *applicationHandler) HandleApplicationPatchRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ApplicationHandler"))
 
@@ -297,11 +394,28 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 			Message:     ErrorInvalidApplicationID.Error,
 			Description: ErrorInvalidApplicationID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
-	appRequest, err := sysutils.DecodeJSONBody[model.ApplicationRequest](r)
+	appDTO, svcErr := ah.service.GetApplication(ctx, id)
+	if svcErr != nil {
+		ah.handleError(ctx, w, r, svcErr)
+		return
+	}
+
+	current, ok := buildApplicationGetResponse(ctx, logger, appDTO)
+	if !ok {
+		errResp := apierror.ErrorResponse{
+			Code:        tidcommon.InternalServerError.Code,
+			Message:     tidcommon.InternalServerError.Error,
+			Description: tidcommon.InternalServerError.ErrorDescription,
+		}
+		sysutils.WriteProblemResponse(ctx, w, http.StatusInternalServerError, errResp)
+		return
+	}
+
+	appRequest, err := sysutils.DecodeMergePatchBody[model.ApplicationRequest](r, current)
 	if err != nil {
 		var valErr *sysutils.ValidationError
 		if errors.As(err, &valErr) {
@@ -313,10 +427,16 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 			Message:     ErrorInvalidRequestFormat.Error,
 			Description: ErrorInvalidRequestFormat.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
+	ah.updateApplication(ctx, w, r, logger, id, appRequest)
+}
+
+// updateApplication saves a full application update request and writes the updated application.
+func (ah *applicationHandler) updateApplication(ctx context.Context, w http.ResponseWriter, r *http.Request,
+	logger *log.Logger, id string, appRequest *model.ApplicationRequest) {
 	updateReqAppDTO := model.ApplicationDTO{
 		ID:          id,
 		OUID:        appRequest.OUID,
@@ -332,6 +452,8 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 			LayoutID:                  appRequest.LayoutID,
 			Assertion:                 appRequest.Assertion,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			Sharing:                   appRequest.Sharing,
+			AccessPolicy:              appRequest.AccessPolicy,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:   appRequest.Template,
@@ -367,6 +489,8 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 			LayoutID:                  updatedAppDTO.LayoutID,
 			Assertion:                 updatedAppDTO.Assertion,
 			AllowedUserTypes:          updatedAppDTO.AllowedUserTypes,
+			Sharing:                   updatedAppDTO.Sharing,
+			AccessPolicy:              updatedAppDTO.AccessPolicy,
 			LoginConsent:              updatedAppDTO.LoginConsent,
 		},
 		Template:  updatedAppDTO.Template,
@@ -387,7 +511,7 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 				Message:     tidcommon.InternalServerError.Error,
 				Description: tidcommon.InternalServerError.ErrorDescription,
 			}
-			sysutils.WriteErrorResponse(ctx, w, http.StatusInternalServerError, errResp)
+			sysutils.WriteProblemResponse(ctx, w, http.StatusInternalServerError, errResp)
 			return
 		}
 	}
@@ -405,11 +529,17 @@ func (ah *applicationHandler) HandleApplicationDeleteRequest(w http.ResponseWrit
 			Message:     ErrorInvalidApplicationID.Error,
 			Description: ErrorInvalidApplicationID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
-	svcErr := ah.service.DeleteApplication(ctx, id)
+	// permanent=true bypasses soft deletion.
+	var svcErr *tidcommon.ServiceError
+	if r.URL.Query().Get(queryParamPermanent) == "true" {
+		svcErr = ah.service.PurgeApplication(ctx, id)
+	} else {
+		svcErr = ah.service.DeleteApplication(ctx, id)
+	}
 	if svcErr != nil {
 		ah.handleError(ctx, w, r, svcErr)
 		return
@@ -464,13 +594,18 @@ func (ah *applicationHandler) processInboundAuthConfig(
 				PublicClient:                       config.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
 				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				RedirectURIMatching:                config.OAuthConfig.RedirectURIMatching,
+				ApplicationType:                    config.OAuthConfig.ApplicationType,
 				IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
+				EnforceEssentialClaims:             config.OAuthConfig.EnforceEssentialClaims,
 				Token:                              config.OAuthConfig.Token,
 				Scopes:                             config.OAuthConfig.Scopes,
 				UserInfo:                           config.OAuthConfig.UserInfo,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				AllowedOrigins:                     config.OAuthConfig.AllowedOrigins,
+				AllowedNetworks:                    config.OAuthConfig.AllowedNetworks,
 			}
 			returnInboundAuthConfigs = append(returnInboundAuthConfigs, providers.InboundAuthConfigWithSecret{
 				Type:        config.Type,
@@ -496,9 +631,12 @@ func (ah *applicationHandler) handleError(ctx context.Context, w http.ResponseWr
 
 	statusCode := http.StatusInternalServerError
 	if svcErr.Type == tidcommon.ClientErrorType {
-		if svcErr.Code == ErrorApplicationNotFound.Code {
+		switch svcErr.Code {
+		case ErrorApplicationNotFound.Code, ErrorApplicationHistoryVersionNotFound.Code:
 			statusCode = http.StatusNotFound
-		} else {
+		case ErrorApplicationRestoreWindowExpired.Code:
+			statusCode = http.StatusGone
+		default:
 			statusCode = http.StatusBadRequest
 		}
 	}
@@ -513,7 +651,7 @@ func (ah *applicationHandler) handleError(ctx context.Context, w http.ResponseWr
 		)
 	}
 
-	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+	sysutils.WriteProblemResponse(ctx, w, statusCode, errResp)
 }
 
 // processInboundAuthConfigFromRequest processes inbound auth config from request to DTO.
@@ -542,16 +680,156 @@ func (ah *applicationHandler) processInboundAuthConfigFromRequest(
 				PublicClient:                       config.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
 				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				RedirectURIMatching:                config.OAuthConfig.RedirectURIMatching,
+				ApplicationType:                    config.OAuthConfig.ApplicationType,
 				IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
+				EnforceEssentialClaims:             config.OAuthConfig.EnforceEssentialClaims,
 				Token:                              config.OAuthConfig.Token,
 				Scopes:                             config.OAuthConfig.Scopes,
 				UserInfo:                           config.OAuthConfig.UserInfo,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				AllowedOrigins:                     config.OAuthConfig.AllowedOrigins,
+				AllowedNetworks:                    config.OAuthConfig.AllowedNetworks,
 			},
 		}
 		inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, inboundAuthConfigDTO)
 	}
 	return inboundAuthConfigDTOs
 }
+
+// parseHistoryPaginationParams parses the limit and offset query parameters, applying the default page
+// size when the limit is absent.
+func parseHistoryPaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
+	limit := serverconst.DefaultPageSize
+	if limitStr := query.Get(queryParamLimit); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	offset := 0
+	if offsetStr := query.Get(queryParamOffset); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+	return limit, offset, nil
+}
+
+// toApplicationRequest converts an application's GET response representation into a full update
+// request, the same way a merge patch without changes would.
+func toApplicationRequest(current *model.ApplicationGetResponse) (*model.ApplicationRequest, error) {
+	data, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+	var appRequest model.ApplicationRequest
+	if err := json.Unmarshal(data, &appRequest); err != nil {
+		return nil, err
+	}
+	return &appRequest, nil
+}
+
+// buildApplicationGetResponse converts an application into its GET response representation. It
+// returns false when the application's inbound auth configuration cannot be represented.
+func buildApplicationGetResponse(ctx context.Context, logger *log.Logger,
+	appDTO *providers.Application) (*model.ApplicationGetResponse, bool) {
+	returnApp := model.ApplicationGetResponse{
+		ID:          appDTO.ID,
+		OUID:        appDTO.OUID,
+		Name:        appDTO.Name,
+		Description: appDTO.Description,
+		InboundAuthProfile: providers.InboundAuthProfile{
+			AuthFlowID:                appDTO.AuthFlowID,
+			RegistrationFlowID:        appDTO.RegistrationFlowID,
+			IsRegistrationFlowEnabled: appDTO.IsRegistrationFlowEnabled,
+			RecoveryFlowID:            appDTO.RecoveryFlowID,
+			IsRecoveryFlowEnabled:     appDTO.IsRecoveryFlowEnabled,
+			ThemeID:                   appDTO.ThemeID,
+			LayoutID:                  appDTO.LayoutID,
+			Assertion:                 appDTO.Assertion,
+			AllowedUserTypes:          appDTO.AllowedUserTypes,
+			Sharing:                   appDTO.Sharing,
+			AccessPolicy:              appDTO.AccessPolicy,
+			LoginConsent:              appDTO.LoginConsent,
+		},
+		Template:  appDTO.Template,
+		URL:       appDTO.URL,
+		LogoURL:   appDTO.LogoURL,
+		TosURI:    appDTO.TosURI,
+		PolicyURI: appDTO.PolicyURI,
+		Contacts:  appDTO.Contacts,
+		Metadata:  appDTO.Metadata,
+	}
+
+	// TODO: Need to refactor when supporting other/multiple inbound auth types.
+	if len(appDTO.InboundAuthConfig) > 0 {
+		if appDTO.InboundAuthConfig[0].Type != providers.OAuthInboundAuthType {
+			logger.Error(ctx, "Unsupported inbound authentication type returned",
+				log.String("type", string(appDTO.InboundAuthConfig[0].Type)))
+			return nil, false
+		}
+
+		if appDTO.InboundAuthConfig[0].OAuthConfig == nil {
+			logger.Error(ctx, "OAuth application configuration is nil")
+			return nil, false
+		}
+
+		returnInboundAuthConfigs := make([]inboundmodel.InboundAuthConfig, 0, len(appDTO.InboundAuthConfig))
+		for _, config := range appDTO.InboundAuthConfig {
+			if config.OAuthConfig == nil {
+				logger.Error(ctx, "OAuth application configuration is nil")
+				return nil, false
+			}
+			redirectURIs := config.OAuthConfig.RedirectURIs
+			if len(redirectURIs) == 0 {
+				redirectURIs = []string{}
+			}
+			grantTypes := config.OAuthConfig.GrantTypes
+			if len(grantTypes) == 0 {
+				grantTypes = []providers.GrantType{}
+			}
+			responseTypes := config.OAuthConfig.ResponseTypes
+			if len(responseTypes) == 0 {
+				responseTypes = []providers.ResponseType{}
+			}
+			oAuthAppConfig := inboundmodel.OAuthConfig{
+				ClientID:                           config.OAuthConfig.ClientID,
+				RedirectURIs:                       redirectURIs,
+				GrantTypes:                         grantTypes,
+				ResponseTypes:                      responseTypes,
+				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
+				PKCERequired:                       config.OAuthConfig.PKCERequired,
+				PublicClient:                       config.OAuthConfig.PublicClient,
+				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				RedirectURIMatching:                config.OAuthConfig.RedirectURIMatching,
+				ApplicationType:                    config.OAuthConfig.ApplicationType,
+				IncludeActClaim:                    config.OAuthConfig.IncludeActClaim,
+				EnforceEssentialClaims:             config.OAuthConfig.EnforceEssentialClaims,
+				Token:                              config.OAuthConfig.Token,
+				Scopes:                             config.OAuthConfig.Scopes,
+				UserInfo:                           config.OAuthConfig.UserInfo,
+				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
+				Certificate:                        config.OAuthConfig.Certificate,
+				AcrValues:                          config.OAuthConfig.AcrValues,
+				AllowedOrigins:                     config.OAuthConfig.AllowedOrigins,
+				AllowedNetworks:                    config.OAuthConfig.AllowedNetworks,
+			}
+			returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfig{
+				Type:        config.Type,
+				OAuthConfig: &oAuthAppConfig,
+			})
+		}
+		returnApp.InboundAuthConfig = returnInboundAuthConfigs
+		returnApp.ClientID = appDTO.InboundAuthConfig[0].OAuthConfig.ClientID
+	}
+
+	return &returnApp, true
+}
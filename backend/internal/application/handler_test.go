@@ -36,6 +36,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/application/model"
 	"github.com/thunder-id/thunderid/internal/cert"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -236,7 +237,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPostRequest_InvalidJSON() {
 	handler.HandleApplicationPostRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -286,7 +287,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPostRequest_ServiceError() {
 			handler.HandleApplicationPostRequest(w, req)
 
 			assert.Equal(suite.T(), tt.expectedStatus, w.Code)
-			assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+			assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 			var errResp apierror.ErrorResponse
 			err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -438,7 +439,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationListRequest_ServiceError() {
 	handler.HandleApplicationListRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -600,7 +601,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_InvalidID() {
 	handler.HandleApplicationGetRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -624,7 +625,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_NotFound() {
 	handler.HandleApplicationGetRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -650,7 +651,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_ServiceError() {
 	handler.HandleApplicationGetRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -882,7 +883,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPutRequest_InvalidID() {
 	handler.HandleApplicationPutRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -902,7 +903,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPutRequest_InvalidJSON() {
 	handler.HandleApplicationPutRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -934,7 +935,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPutRequest_ServiceError() {
 	handler.HandleApplicationPutRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -999,7 +1000,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationDeleteRequest_InvalidID() {
 	handler.HandleApplicationDeleteRequest(w, req)
 
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -1236,7 +1237,7 @@ func (suite *HandlerTestSuite) TestHandleError_ClientError() {
 	handler.handleError(context.Background(), w, r, svcErr)
 
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -1256,7 +1257,7 @@ func (suite *HandlerTestSuite) TestHandleError_NotFoundError() {
 	handler.handleError(context.Background(), w, r, svcErr)
 
 	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -1276,7 +1277,7 @@ func (suite *HandlerTestSuite) TestHandleError_ServerError() {
 	handler.handleError(context.Background(), w, r, svcErr)
 
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -1990,7 +1991,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPostRequest_UnsupportedInbou
 
 	// Should return 500 because processInboundAuthConfig returns false
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -2036,7 +2037,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPostRequest_NilOAuthConfig()
 
 	// Should return 500 because processInboundAuthConfig returns false
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -2157,7 +2158,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPutRequest_UnsupportedInboun
 
 	// Should return 500 because processInboundAuthConfig returns false
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -2205,7 +2206,7 @@ func (suite *HandlerTestSuite) TestHandleApplicationPutRequest_NilOAuthConfig()
 
 	// Should return 500 because processInboundAuthConfig returns false
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
-	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "application/problem+json", w.Header().Get("Content-Type"))
 
 	var errResp apierror.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &errResp)
@@ -2433,3 +2434,281 @@ func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_EmptyResponseType
 
 	mockService.AssertExpectations(suite.T())
 }
+
+func (suite *HandlerTestSuite) TestHandleApplicationPatchRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	currentApp := &providers.Application{
+		ID:          "test-app-id",
+		OUID:        "ou-123",
+		Name:        "TestApp",
+		Description: "Old Description",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{
+			{
+				Type: providers.OAuthInboundAuthType,
+				OAuthConfig: &providers.OAuthConfigWithSecret{
+					ClientID:     "test-client-id",
+					RedirectURIs: []string{"https://example.com/callback"},
+					GrantTypes:   []providers.GrantType{"authorization_code"},
+				},
+			},
+		},
+	}
+	mockService.On("GetApplication", mock.Anything, "test-app-id").Return(currentApp, nil)
+	mockService.On("UpdateApplication", mock.Anything, "test-app-id",
+		mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+			if dto.Name != "TestApp" || dto.OUID != "ou-123" || dto.Description != "New Description" ||
+				len(dto.InboundAuthConfig) != 1 || dto.InboundAuthConfig[0].OAuthConfig == nil {
+				return false
+			}
+			oauth := dto.InboundAuthConfig[0].OAuthConfig
+			return oauth.ClientID == "test-client-id" &&
+				len(oauth.RedirectURIs) == 1 && oauth.RedirectURIs[0] == "https://example.com/callback"
+		})).
+		Return(&model.ApplicationDTO{ID: "test-app-id", Name: "TestApp", Description: "New Description"}, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/applications/test-app-id",
+		bytes.NewBufferString(`{"description":"New Description"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationPatchRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response model.ApplicationCompleteResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), "New Description", response.Description)
+
+	mockService.AssertExpectations(suite.T())
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationPatchRequest_NotFound() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplication", mock.Anything, "test-app-id").Return(nil, &ErrorApplicationNotFound)
+
+	req := httptest.NewRequest(http.MethodPatch, "/applications/test-app-id",
+		bytes.NewBufferString(`{"description":"New Description"}`))
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationPatchRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	mockService.AssertNotCalled(suite.T(), "UpdateApplication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationPatchRequest_InvalidPatch() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplication", mock.Anything, "test-app-id").
+		Return(&providers.Application{ID: "test-app-id", Name: "TestApp"}, nil)
+
+	req := httptest.NewRequest(http.MethodPatch, "/applications/test-app-id", bytes.NewBufferString(`{"name":`))
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationPatchRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(suite.T(), "UpdateApplication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationPatchRequest_MissingID() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPatch, "/applications/", bytes.NewBufferString(`{}`))
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationPatchRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(suite.T(), "GetApplication", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationHistoryListRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationHistory", mock.Anything, "test-app-id", 5, 10).
+		Return(&model.ApplicationHistoryListResponse{
+			TotalResults: 11,
+			StartIndex:   11,
+			Count:        1,
+			History:      []model.ApplicationHistoryEntry{{Version: 1, ChangedBy: "admin-user"}},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/history?limit=5&offset=10", nil)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationHistoryListRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response model.ApplicationHistoryListResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), 11, response.TotalResults)
+	assert.Equal(suite.T(), "admin-user", response.History[0].ChangedBy)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationHistoryListRequest_DefaultPageSize() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationHistory", mock.Anything, "test-app-id", serverconst.DefaultPageSize, 0).
+		Return(&model.ApplicationHistoryListResponse{History: []model.ApplicationHistoryEntry{}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/history", nil)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationHistoryListRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationHistoryListRequest_InvalidPagination() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	for _, query := range []string{"limit=abc", "offset=abc"} {
+		req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/history?"+query, nil)
+		req.SetPathValue("id", "test-app-id")
+		w := httptest.NewRecorder()
+
+		handler.HandleApplicationHistoryListRequest(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	}
+	mockService.AssertNotCalled(suite.T(), "GetApplicationHistory",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationHistoryGetRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationHistoryVersion", mock.Anything, "test-app-id", 2).
+		Return(&model.ApplicationHistoryVersion{
+			ApplicationHistoryEntry: model.ApplicationHistoryEntry{Version: 2},
+			InboundAuthConfig:       []inboundmodel.InboundAuthConfig{},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/history/2", nil)
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("version", "2")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationHistoryGetRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response model.ApplicationHistoryVersion
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), 2, response.Version)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationHistoryGetRequest_InvalidVersion() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/history/latest", nil)
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("version", "latest")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationHistoryGetRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationHistoryGetRequest_NotFound() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationHistoryVersion", mock.Anything, "test-app-id", 7).
+		Return(nil, &ErrorApplicationHistoryVersionNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/history/7", nil)
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("version", "7")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationHistoryGetRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationHistoryRestoreRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationHistoryVersion", mock.Anything, "test-app-id", 1).
+		Return(&model.ApplicationHistoryVersion{
+			ApplicationHistoryEntry: model.ApplicationHistoryEntry{Version: 1},
+			InboundAuthConfig: []inboundmodel.InboundAuthConfig{{
+				Type: providers.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfig{
+					ClientID:     "test-client-id",
+					RedirectURIs: []string{"https://old.example.com/callback"},
+					GrantTypes:   []providers.GrantType{"authorization_code"},
+				},
+			}},
+		}, nil)
+	mockService.On("GetApplication", mock.Anything, "test-app-id").Return(&providers.Application{
+		ID:          "test-app-id",
+		OUID:        "ou-123",
+		Name:        "TestApp",
+		Description: "Description",
+		InboundAuthConfig: []providers.InboundAuthConfigWithSecret{{
+			Type: providers.OAuthInboundAuthType,
+			OAuthConfig: &providers.OAuthConfigWithSecret{
+				ClientID:     "test-client-id",
+				RedirectURIs: []string{"https://new.example.com/callback"},
+				GrantTypes:   []providers.GrantType{"authorization_code"},
+			},
+		}},
+	}, nil)
+	mockService.On("UpdateApplication", mock.Anything, "test-app-id",
+		mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+			if dto.Name != "TestApp" || dto.Description != "Description" ||
+				len(dto.InboundAuthConfig) != 1 || dto.InboundAuthConfig[0].OAuthConfig == nil {
+				return false
+			}
+			oauth := dto.InboundAuthConfig[0].OAuthConfig
+			return oauth.ClientID == "test-client-id" &&
+				len(oauth.RedirectURIs) == 1 && oauth.RedirectURIs[0] == "https://old.example.com/callback"
+		})).
+		Return(&model.ApplicationDTO{ID: "test-app-id", Name: "TestApp"}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/applications/test-app-id/history/1/restore", nil)
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("version", "1")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationHistoryRestoreRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationHistoryRestoreRequest_VersionNotFound() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationHistoryVersion", mock.Anything, "test-app-id", 4).
+		Return(nil, &ErrorApplicationHistoryVersionNotFound)
+
+	req := httptest.NewRequest(http.MethodPost, "/applications/test-app-id/history/4/restore", nil)
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("version", "4")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationHistoryRestoreRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	mockService.AssertNotCalled(suite.T(), "UpdateApplication", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
@@ -46,7 +47,7 @@ func Initialize(
 	i18nService i18nmgt.I18nServiceInterface,
 ) (ApplicationServiceInterface, declarativeresource.ResourceExporter, error) {
 	appService := newApplicationService(
-		inboundClient, entityProvider, ouService, i18nService,
+		inboundClient, entityProvider, ouService, i18nService, config.GetServerRuntime().Config.SoftDelete,
 	)
 
 	if err := entityService.LoadIndexedAttributes(getAppIndexedAttributes()); err != nil {
@@ -92,15 +93,31 @@ func registerRoutes(mux *http.ServeMux, appHandler *applicationHandler) {
 		}, opts1))
 
 	opts2 := middleware.CORSOptions{
-		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedMethods:   []string{"GET", "PUT", "PATCH", "DELETE", "POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
+	mux.HandleFunc(middleware.WithCORS("GET /applications/deleted",
+		appHandler.HandleDeletedApplicationListRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("POST /applications/{id}/restore",
+		appHandler.HandleApplicationRestoreRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}/history",
+		appHandler.HandleApplicationHistoryListRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}/history/{version}",
+		appHandler.HandleApplicationHistoryGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("POST /applications/{id}/history/{version}/restore",
+		appHandler.HandleApplicationHistoryRestoreRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}/branding",
+		appHandler.HandleApplicationBrandingGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /applications/{id}/branding",
+		appHandler.HandleApplicationBrandingPutRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}",
 		appHandler.HandleApplicationGetRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("PUT /applications/{id}",
 		appHandler.HandleApplicationPutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PATCH /applications/{id}",
+		appHandler.HandleApplicationPatchRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("DELETE /applications/{id}",
 		appHandler.HandleApplicationDeleteRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /applications/",
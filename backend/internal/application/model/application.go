@@ -22,7 +22,10 @@
 package model
 
 import (
+	"time"
+
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -82,6 +85,7 @@ type ApplicationProcessedDTO struct {
 	providers.InboundAuthProfile `yaml:",inline"`
 	InboundAuthConfig            []inboundmodel.InboundAuthConfigProcessed `yaml:"inboundAuthConfig,omitempty"`
 	Metadata                     map[string]interface{}                    `yaml:"metadata,omitempty"`
+	Branding                     *providers.ApplicationBranding            `yaml:"branding,omitempty"`
 }
 
 // ApplicationRequest represents the request structure for creating or updating an application.
@@ -95,7 +99,7 @@ type ApplicationRequest struct {
 	LogoURL     string   `json:"logoUrl,omitempty" yaml:"logoUrl,omitempty" native:"omitempty,url,max=2048"`
 	TosURI      string   `json:"tosUri,omitempty" yaml:"tosUri,omitempty" native:"omitempty,url,max=2048"`
 	PolicyURI   string   `json:"policyUri,omitempty" yaml:"policyUri,omitempty" native:"omitempty,url,max=2048"`
-	Contacts    []string `json:"contacts,omitempty" yaml:"contacts,omitempty"`
+	Contacts    []string `json:"contacts,omitempty" yaml:"contacts,omitempty" native:"omitempty,dive,email"`
 
 	providers.InboundAuthProfile `yaml:",inline"`
 	InboundAuthConfig            []providers.InboundAuthConfigWithSecret `json:"inboundAuthConfig,omitempty" yaml:"inboundAuthConfig,omitempty"`
@@ -165,20 +169,21 @@ type ApplicationGetResponse struct {
 // Only carries the subset of inbound-profile fields that make sense in the list view, so it
 // does not embed InboundAuthProfile (which carries Assertion/LoginConsent/etc.).
 type BasicApplicationResponse struct {
-	ID                        string `json:"id,omitempty" jsonschema:"Application ID."`
-	Name                      string `json:"name" jsonschema:"Application name."`
-	Description               string `json:"description,omitempty" jsonschema:"Application description."`
-	ClientID                  string `json:"clientId,omitempty" jsonschema:"OAuth Client ID."`
-	LogoURL                   string `json:"logoUrl,omitempty" jsonschema:"Logo URL."`
-	AuthFlowID                string `json:"authFlowId,omitempty" jsonschema:"Authentication Flow ID."`
-	RegistrationFlowID        string `json:"registrationFlowId,omitempty" jsonschema:"Registration Flow ID."`
-	IsRegistrationFlowEnabled bool   `json:"isRegistrationFlowEnabled" jsonschema:"Registration enabled status."`
-	RecoveryFlowID            string `json:"recoveryFlowId,omitempty" jsonschema:"Recovery Flow ID."`
-	IsRecoveryFlowEnabled     bool   `json:"isRecoveryFlowEnabled" jsonschema:"Recovery enabled status."`
-	ThemeID                   string `json:"themeId,omitempty" jsonschema:"Theme ID."`
-	LayoutID                  string `json:"layoutId,omitempty" jsonschema:"Layout ID."`
-	Template                  string `json:"template,omitempty" jsonschema:"Application Template."`
-	IsReadOnly                bool   `json:"isReadOnly" jsonschema:"Indicates if the application is read-only (declarative/immutable)."`
+	ID                        string     `json:"id,omitempty" jsonschema:"Application ID."`
+	Name                      string     `json:"name" jsonschema:"Application name."`
+	Description               string     `json:"description,omitempty" jsonschema:"Application description."`
+	ClientID                  string     `json:"clientId,omitempty" jsonschema:"OAuth Client ID."`
+	LogoURL                   string     `json:"logoUrl,omitempty" jsonschema:"Logo URL."`
+	AuthFlowID                string     `json:"authFlowId,omitempty" jsonschema:"Authentication Flow ID."`
+	RegistrationFlowID        string     `json:"registrationFlowId,omitempty" jsonschema:"Registration Flow ID."`
+	IsRegistrationFlowEnabled bool       `json:"isRegistrationFlowEnabled" jsonschema:"Registration enabled status."`
+	RecoveryFlowID            string     `json:"recoveryFlowId,omitempty" jsonschema:"Recovery Flow ID."`
+	IsRecoveryFlowEnabled     bool       `json:"isRecoveryFlowEnabled" jsonschema:"Recovery enabled status."`
+	ThemeID                   string     `json:"themeId,omitempty" jsonschema:"Theme ID."`
+	LayoutID                  string     `json:"layoutId,omitempty" jsonschema:"Layout ID."`
+	Template                  string     `json:"template,omitempty" jsonschema:"Application Template."`
+	IsReadOnly                bool       `json:"isReadOnly" jsonschema:"Indicates if the application is read-only (declarative/immutable)."`
+	DeletedAt                 *time.Time `json:"deletedAt,omitempty" jsonschema:"Time the application was soft-deleted."`
 }
 
 // ApplicationListResponse represents the response structure for listing applications.
@@ -187,3 +192,28 @@ type ApplicationListResponse struct {
 	Count        int                        `json:"count"`
 	Applications []BasicApplicationResponse `json:"applications"`
 }
+
+// ApplicationHistoryEntry represents a recorded change to an application's inbound auth configuration.
+type ApplicationHistoryEntry struct {
+	Version   int                         `json:"version"`
+	ChangedBy string                      `json:"changedBy,omitempty"`
+	CreatedAt time.Time                   `json:"createdAt"`
+	Changes   []inboundmodel.ConfigChange `json:"changes"`
+}
+
+// ApplicationHistoryListResponse represents the response structure for listing an application's
+// configuration history, most recent version first.
+type ApplicationHistoryListResponse struct {
+	TotalResults int                       `json:"totalResults"`
+	StartIndex   int                       `json:"startIndex"`
+	Count        int                       `json:"count"`
+	History      []ApplicationHistoryEntry `json:"history"`
+	Links        []utils.Link              `json:"links"`
+}
+
+// ApplicationHistoryVersion represents a version of an application's inbound auth configuration as it
+// was saved. InboundAuthConfig is empty when the version removed the configuration.
+type ApplicationHistoryVersion struct {
+	ApplicationHistoryEntry
+	InboundAuthConfig []inboundmodel.InboundAuthConfig `json:"inboundAuthConfig"`
+}
@@ -23,12 +23,15 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	"encoding/json"
 
+	"github.com/thunder-id/thunderid/internal/actorprovider"
 	"github.com/thunder-id/thunderid/internal/application/model"
 	"github.com/thunder-id/thunderid/internal/cert"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
@@ -40,6 +43,7 @@ import (
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/netaccess"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -58,6 +62,18 @@ type ApplicationServiceInterface interface {
 		ctx context.Context, appID string, app *model.ApplicationDTO) (
 		*model.ApplicationDTO, *tidcommon.ServiceError)
 	DeleteApplication(ctx context.Context, appID string) *tidcommon.ServiceError
+	PurgeApplication(ctx context.Context, appID string) *tidcommon.ServiceError
+	RestoreApplication(ctx context.Context, appID string) *tidcommon.ServiceError
+	GetDeletedApplicationList(ctx context.Context) (*model.ApplicationListResponse, *tidcommon.ServiceError)
+	GetApplicationBranding(
+		ctx context.Context, appID string) (*providers.ApplicationBranding, *tidcommon.ServiceError)
+	UpdateApplicationBranding(
+		ctx context.Context, appID string, branding *providers.ApplicationBranding) (
+		*providers.ApplicationBranding, *tidcommon.ServiceError)
+	GetApplicationHistory(ctx context.Context, appID string, limit, offset int) (
+		*model.ApplicationHistoryListResponse, *tidcommon.ServiceError)
+	GetApplicationHistoryVersion(ctx context.Context, appID string, version int) (
+		*model.ApplicationHistoryVersion, *tidcommon.ServiceError)
 	GetResourceDependencies(
 		ctx context.Context, resourceType, id string) ([]resourcedependency.ResourceDependency, error)
 	SetDependencyRegistry(r resourcedependency.Registry)
@@ -71,6 +87,7 @@ type applicationService struct {
 	ouService            oupkg.OrganizationUnitServiceInterface
 	i18nService          i18nmgt.I18nServiceInterface
 	dependencyRegistry   resourcedependency.Registry
+	softDelete           config.SoftDeleteConfig
 }
 
 // newApplicationService creates a new instance of ApplicationService.
@@ -79,6 +96,7 @@ func newApplicationService(
 	entityProvider entityprovider.EntityProviderInterface,
 	ouService oupkg.OrganizationUnitServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
+	softDelete config.SoftDeleteConfig,
 ) ApplicationServiceInterface {
 	return &applicationService{
 		logger:               log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ApplicationService")),
@@ -86,6 +104,7 @@ func newApplicationService(
 		entityProvider:       entityProvider,
 		ouService:            ouService,
 		i18nService:          i18nService,
+		softDelete:           softDelete,
 	}
 }
 
@@ -389,6 +408,8 @@ func (as *applicationService) UpdateApplication(ctx context.Context, appID strin
 	}
 
 	processedDTO := as.buildProcessedDTOForUpdate(appID, app, inboundAuthConfig)
+	// Branding is managed through its own endpoint and is carried over unchanged.
+	processedDTO.Branding = existingApp.Branding
 
 	inboundClient := toInboundClient(processedDTO)
 	oauthProfile := toOAuthProfile(processedDTO)
@@ -566,19 +587,22 @@ func appRequiresClientSecret(cfg *providers.OAuthConfigWithSecret) bool {
 	return true
 }
 
-// DeleteApplication delete the application for given app id.
 // SetDependencyRegistry injects the dependency registry. Called by servicemanager after the
 // provider services are initialized to avoid a cyclic import.
 func (as *applicationService) SetDependencyRegistry(r resourcedependency.Registry) {
 	as.dependencyRegistry = r
 }
 
+// DeleteApplication deletes the application for given app id. When soft deletion is enabled the
+// application is only marked as deleted; its configuration and dependents are kept so it can be
+// restored within the retention window.
 func (as *applicationService) DeleteApplication(ctx context.Context, appID string) *tidcommon.ServiceError {
 	if appID == "" {
 		return &ErrorInvalidApplicationID
 	}
 
-	if existing, epErr := as.entityProvider.GetEntity(appID); epErr != nil {
+	existing, epErr := as.entityProvider.GetEntity(appID)
+	if epErr != nil {
 		if epErr.Code != entityprovider.ErrorCodeEntityNotFound {
 			as.logger.Error(ctx, "Failed to load entity before delete",
 				log.String("appID", appID), log.Error(epErr))
@@ -588,6 +612,54 @@ func (as *applicationService) DeleteApplication(ctx context.Context, appID strin
 		return &ErrorApplicationNotFound
 	}
 
+	if as.softDelete.Enabled {
+		if existing == nil {
+			return &ErrorApplicationNotFound
+		}
+		if epErr := as.entityProvider.SoftDeleteEntity(appID); epErr != nil {
+			if svcErr := mapEntityProviderError(epErr); svcErr != nil {
+				return svcErr
+			}
+			as.logger.Error(ctx, "Failed to soft-delete application entity",
+				log.String("appID", appID), log.Error(epErr))
+			return &tidcommon.InternalServerError
+		}
+		return nil
+	}
+
+	return as.deleteApplicationPermanently(ctx, appID)
+}
+
+// PurgeApplication permanently deletes an application, whether it is active or soft-deleted,
+// together with its configuration and the dependents that must be removed with it.
+func (as *applicationService) PurgeApplication(ctx context.Context, appID string) *tidcommon.ServiceError {
+	if appID == "" {
+		return &ErrorInvalidApplicationID
+	}
+
+	existing, epErr := as.entityProvider.GetEntity(appID)
+	if epErr != nil && epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+		existing, epErr = as.entityProvider.GetDeletedEntity(appID)
+	}
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return &ErrorApplicationNotFound
+		}
+		as.logger.Error(ctx, "Failed to load entity before purge",
+			log.String("appID", appID), log.Error(epErr))
+		return &tidcommon.InternalServerError
+	}
+	if existing == nil || existing.Category != providers.EntityCategoryApp {
+		return &ErrorApplicationNotFound
+	}
+
+	return as.deleteApplicationPermanently(ctx, appID)
+}
+
+// deleteApplicationPermanently removes the application's dependents, configuration, entity and
+// localized variants.
+func (as *applicationService) deleteApplicationPermanently(
+	ctx context.Context, appID string) *tidcommon.ServiceError {
 	// Remove dependents that must be deleted with the application (e.g. its role assignments and
 	// group memberships). Run before the deletes so a cleanup failure aborts and leaves the
 	// application retriable. Fails closed when the registry is unavailable.
@@ -627,6 +699,223 @@ func (as *applicationService) DeleteApplication(ctx context.Context, appID strin
 	return as.deleteLocalizedVariants(ctx, appID)
 }
 
+// RestoreApplication returns a soft-deleted application to the state it had before it was deleted.
+// Restores are only allowed within the configured retention window and while the application's name
+// and client ID have not been claimed by another application.
+func (as *applicationService) RestoreApplication(ctx context.Context, appID string) *tidcommon.ServiceError {
+	if appID == "" {
+		return &ErrorInvalidApplicationID
+	}
+
+	deleted, epErr := as.entityProvider.GetDeletedEntity(appID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return &ErrorApplicationNotFound
+		}
+		as.logger.Error(ctx, "Failed to load deleted application entity",
+			log.String("appID", appID), log.Error(epErr))
+		return &tidcommon.InternalServerError
+	}
+	if deleted == nil || deleted.Category != providers.EntityCategoryApp {
+		return &ErrorApplicationNotFound
+	}
+
+	if deleted.DeletedAt != nil && time.Since(*deleted.DeletedAt) > as.softDelete.Retention() {
+		return &ErrorApplicationRestoreWindowExpired
+	}
+
+	summary := buildBasicApplicationResponse(inboundmodel.InboundClient{ID: appID}, deleted)
+	if summary.Name != "" {
+		if taken, svcErr := as.isIdentifierTaken(ctx, fieldName, summary.Name, appID); svcErr != nil {
+			return svcErr
+		} else if taken {
+			return &ErrorApplicationAlreadyExistsWithName
+		}
+	}
+	if summary.ClientID != "" {
+		if taken, svcErr := as.isIdentifierTaken(ctx, fieldClientID, summary.ClientID, appID); svcErr != nil {
+			return svcErr
+		} else if taken {
+			return &ErrorApplicationAlreadyExistsWithClientID
+		}
+	}
+
+	if epErr := as.entityProvider.RestoreEntity(appID); epErr != nil {
+		if svcErr := mapEntityProviderError(epErr); svcErr != nil {
+			return svcErr
+		}
+		as.logger.Error(ctx, "Failed to restore application entity",
+			log.String("appID", appID), log.Error(epErr))
+		return &tidcommon.InternalServerError
+	}
+
+	return nil
+}
+
+// GetDeletedApplicationList lists the soft-deleted applications, most recently deleted first.
+func (as *applicationService) GetDeletedApplicationList(
+	ctx context.Context) (*model.ApplicationListResponse, *tidcommon.ServiceError) {
+	totalResults, epErr := as.entityProvider.GetDeletedEntityListCount(providers.EntityCategoryApp)
+	if epErr != nil {
+		as.logger.Error(ctx, "Failed to count deleted application entities", log.Error(epErr))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	entities, epErr := as.entityProvider.GetDeletedEntityList(
+		providers.EntityCategoryApp, serverconst.MaxCompositeStoreRecords, 0)
+	if epErr != nil {
+		as.logger.Error(ctx, "Failed to list deleted application entities", log.Error(epErr))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	applicationList := make([]model.BasicApplicationResponse, 0, len(entities))
+	for i := range entities {
+		resp := buildBasicApplicationResponse(inboundmodel.InboundClient{ID: entities[i].ID}, &entities[i])
+		resp.DeletedAt = entities[i].DeletedAt
+		applicationList = append(applicationList, resp)
+	}
+
+	return &model.ApplicationListResponse{
+		TotalResults: totalResults,
+		Count:        len(applicationList),
+		Applications: applicationList,
+	}, nil
+}
+
+// GetApplicationBranding returns the branding of the given application. An application without
+// branding yields an empty branding.
+func (as *applicationService) GetApplicationBranding(ctx context.Context, appID string) (
+	*providers.ApplicationBranding, *tidcommon.ServiceError) {
+	if appID == "" {
+		return nil, &ErrorInvalidApplicationID
+	}
+
+	app, svcErr := as.getApplication(ctx, appID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if app.Branding == nil {
+		return &providers.ApplicationBranding{}, nil
+	}
+	return app.Branding, nil
+}
+
+// UpdateApplicationBranding replaces the branding of the given application.
+func (as *applicationService) UpdateApplicationBranding(ctx context.Context, appID string,
+	branding *providers.ApplicationBranding) (*providers.ApplicationBranding, *tidcommon.ServiceError) {
+	if appID == "" {
+		return nil, &ErrorInvalidApplicationID
+	}
+	if branding == nil {
+		return nil, &ErrorInvalidBranding
+	}
+	if as.inboundClientService.IsDeclarative(ctx, appID) {
+		return nil, &ErrorCannotModifyDeclarativeResource
+	}
+	if !isValidBranding(branding) {
+		return nil, &ErrorInvalidBranding
+	}
+
+	app, svcErr := as.getApplication(ctx, appID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	app.Branding = branding
+	inboundClient := toInboundClient(app)
+
+	if err := as.inboundClientService.UpdateInboundClientProperties(
+		ctx, appID, inboundClient.Properties); err != nil {
+		if errors.Is(err, inboundclient.ErrInboundClientNotFound) {
+			return nil, &ErrorApplicationNotFound
+		}
+		if svcErr := as.translateInboundClientError(ctx, err); svcErr != nil {
+			return nil, svcErr
+		}
+		as.logger.Error(ctx, "Failed to update application branding", log.Error(err), log.String("appID", appID))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return branding, nil
+}
+
+// GetApplicationHistory returns a page of the recorded changes to the application's inbound auth
+// configuration, most recent first.
+func (as *applicationService) GetApplicationHistory(ctx context.Context, appID string, limit, offset int) (
+	*model.ApplicationHistoryListResponse, *tidcommon.ServiceError) {
+	if appID == "" {
+		return nil, &ErrorInvalidApplicationID
+	}
+	if limit <= 0 || limit > serverconst.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+
+	if _, svcErr := as.getApplication(ctx, appID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	revisions, totalCount, err := as.inboundClientService.GetOAuthProfileRevisionList(ctx, appID, limit, offset)
+	if err != nil {
+		as.logger.Error(ctx, "Failed to list application configuration history",
+			log.String("appID", appID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	history := make([]model.ApplicationHistoryEntry, 0, len(revisions))
+	for _, revision := range revisions {
+		history = append(history, toApplicationHistoryEntry(revision))
+	}
+	return &model.ApplicationHistoryListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(history),
+		History:      history,
+		Links: sysutils.BuildPaginationLinks(
+			"/applications/"+appID+"/history", limit, offset, totalCount, ""),
+	}, nil
+}
+
+// GetApplicationHistoryVersion returns a version of the application's inbound auth configuration as it
+// was saved. The configuration carries the application's current client ID, since a version does not
+// record credentials.
+func (as *applicationService) GetApplicationHistoryVersion(ctx context.Context, appID string, version int) (
+	*model.ApplicationHistoryVersion, *tidcommon.ServiceError) {
+	if appID == "" {
+		return nil, &ErrorInvalidApplicationID
+	}
+	if version <= 0 {
+		return nil, &ErrorInvalidHistoryVersion
+	}
+
+	app, svcErr := as.getApplication(ctx, appID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	revision, err := as.inboundClientService.GetOAuthProfileRevision(ctx, appID, version)
+	if err != nil {
+		if errors.Is(err, inboundclient.ErrOAuthProfileRevisionNotFound) {
+			return nil, &ErrorApplicationHistoryVersionNotFound
+		}
+		as.logger.Error(ctx, "Failed to get application configuration history version",
+			log.String("appID", appID), log.Int("version", version), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	var clientID string
+	if oauthProcessed := getOAuthInboundAuthConfigProcessedDTO(app.InboundAuthConfig); oauthProcessed != nil &&
+		oauthProcessed.OAuthConfig != nil {
+		clientID = oauthProcessed.OAuthConfig.ClientID
+	}
+
+	return &model.ApplicationHistoryVersion{
+		ApplicationHistoryEntry: toApplicationHistoryEntry(*revision),
+		InboundAuthConfig:       toHistoryInboundAuthConfig(revision.OAuthProfile, clientID),
+	}, nil
+}
+
 // GetResourceDependencies returns the applications that reference the resource identified
 // by (resourceType, id). It implements the resourcedependency.Provider interface. The
 // inbound-client store resolves which reference types are tracked, so no per-type handling is
@@ -764,6 +1053,8 @@ func toInboundClient(dto *model.ApplicationProcessedDTO) inboundmodel.InboundCli
 		Assertion:                 dto.Assertion,
 		LoginConsent:              dto.LoginConsent,
 		AllowedUserTypes:          dto.AllowedUserTypes,
+		Sharing:                   dto.Sharing,
+		AccessPolicy:              dto.AccessPolicy,
 	}
 
 	// Pack remaining fields into Properties.
@@ -789,6 +1080,9 @@ func toInboundClient(dto *model.ApplicationProcessedDTO) inboundmodel.InboundCli
 	if dto.Metadata != nil {
 		props[propMetadata] = dto.Metadata
 	}
+	if dto.Branding != nil {
+		props[propBranding] = dto.Branding
+	}
 	if len(props) > 0 {
 		dao.Properties = props
 	}
@@ -814,6 +1108,8 @@ func toProcessedDTO(
 			Assertion:                 dao.Assertion,
 			LoginConsent:              dao.LoginConsent,
 			AllowedUserTypes:          dao.AllowedUserTypes,
+			Sharing:                   dao.Sharing,
+			AccessPolicy:              dao.AccessPolicy,
 		},
 	}
 
@@ -864,6 +1160,7 @@ func toProcessedDTO(
 		if metadata, ok := dao.Properties[propMetadata].(map[string]interface{}); ok {
 			dto.Metadata = metadata
 		}
+		dto.Branding = actorprovider.ParseApplicationBranding(dao.Properties)
 	}
 
 	// Merge OAuth profile if present.
@@ -921,13 +1218,70 @@ func buildOAuthProfileFromProcessed(inboundAuth inboundmodel.InboundAuthConfigPr
 		PublicClient:                       oa.PublicClient,
 		RequirePushedAuthorizationRequests: oa.RequirePushedAuthorizationRequests,
 		DPoPBoundAccessTokens:              oa.DPoPBoundAccessTokens,
+		RedirectURIMatching:                string(oa.RedirectURIMatching),
+		ApplicationType:                    string(oa.ApplicationType),
 		IncludeActClaim:                    oa.IncludeActClaim,
+		EnforceEssentialClaims:             oa.EnforceEssentialClaims,
 		Scopes:                             oa.Scopes,
 		ScopeClaims:                        oa.ScopeClaims,
 		Token:                              oa.Token,
 		UserInfo:                           oa.UserInfo,
 		Certificate:                        oa.Certificate,
 		AcrValues:                          oa.AcrValues,
+		AllowedOrigins:                     oa.AllowedOrigins,
+		AllowedNetworks:                    oa.AllowedNetworks,
+	}
+}
+
+// toApplicationHistoryEntry converts a recorded OAuth profile revision into a history entry.
+func toApplicationHistoryEntry(revision inboundmodel.OAuthProfileRevision) model.ApplicationHistoryEntry {
+	changes := revision.Changes
+	if changes == nil {
+		changes = []inboundmodel.ConfigChange{}
+	}
+	return model.ApplicationHistoryEntry{
+		Version:   revision.Version,
+		ChangedBy: revision.ChangedBy,
+		CreatedAt: revision.CreatedAt,
+		Changes:   changes,
+	}
+}
+
+// toHistoryInboundAuthConfig builds the inbound auth configuration of a saved OAuth profile. Returns an
+// empty list when the profile was removed.
+func toHistoryInboundAuthConfig(
+	oauthProfile *providers.OAuthProfile, clientID string) []inboundmodel.InboundAuthConfig {
+	if oauthProfile == nil {
+		return []inboundmodel.InboundAuthConfig{}
+	}
+	oauthClient := inboundclient.BuildOAuthClient("", clientID, "", providers.EntityCategoryApp, oauthProfile)
+	return []inboundmodel.InboundAuthConfig{
+		{
+			Type: providers.OAuthInboundAuthType,
+			OAuthConfig: &inboundmodel.OAuthConfig{
+				ClientID:                           oauthClient.ClientID,
+				RedirectURIs:                       oauthClient.RedirectURIs,
+				RedirectURIMatching:                oauthClient.RedirectURIMatching,
+				ApplicationType:                    oauthClient.ApplicationType,
+				GrantTypes:                         oauthClient.GrantTypes,
+				ResponseTypes:                      oauthClient.ResponseTypes,
+				TokenEndpointAuthMethod:            oauthClient.TokenEndpointAuthMethod,
+				PKCERequired:                       oauthClient.PKCERequired,
+				PublicClient:                       oauthClient.PublicClient,
+				RequirePushedAuthorizationRequests: oauthClient.RequirePushedAuthorizationRequests,
+				DPoPBoundAccessTokens:              oauthClient.DPoPBoundAccessTokens,
+				IncludeActClaim:                    oauthClient.IncludeActClaim,
+				EnforceEssentialClaims:             oauthClient.EnforceEssentialClaims,
+				Token:                              oauthClient.Token,
+				Scopes:                             oauthClient.Scopes,
+				UserInfo:                           oauthClient.UserInfo,
+				ScopeClaims:                        oauthClient.ScopeClaims,
+				Certificate:                        oauthClient.Certificate,
+				AcrValues:                          oauthClient.AcrValues,
+				AllowedOrigins:                     oauthClient.AllowedOrigins,
+				AllowedNetworks:                    oauthClient.AllowedNetworks,
+			},
+		},
 	}
 }
 
@@ -1101,10 +1455,90 @@ func (as *applicationService) validateApplicationFields(
 		}
 		isOAuthConfig = true
 	}
+	if svcErr := as.validateSharingConfig(ctx, app.Sharing); svcErr != nil {
+		return svcErr
+	}
+	if svcErr := validateAccessPolicy(app.AccessPolicy); svcErr != nil {
+		return svcErr
+	}
 	as.validateConsentConfig(app)
 	return nil
 }
 
+// validateSharingConfig resolves each shared organization unit to its ID and handle, and rejects
+// unknown organization units, duplicate entries and email domains claimed by more than one unit.
+func (as *applicationService) validateSharingConfig(
+	ctx context.Context, sharing *providers.ApplicationSharing) *tidcommon.ServiceError {
+	if sharing == nil {
+		return nil
+	}
+	seenOUs := make(map[string]bool, len(sharing.OrganizationUnits))
+	seenDomains := make(map[string]bool)
+	for i := range sharing.OrganizationUnits {
+		shared := &sharing.OrganizationUnits[i]
+		var ou providers.OrganizationUnit
+		var svcErr *tidcommon.ServiceError
+		switch {
+		case shared.OUID != "":
+			ou, svcErr = as.ouService.GetOrganizationUnit(ctx, shared.OUID)
+		case shared.OUHandle != "":
+			ou, svcErr = as.ouService.GetOrganizationUnitByPath(ctx, shared.OUHandle)
+		default:
+			return &ErrorInvalidSharingConfiguration
+		}
+		if svcErr != nil {
+			if svcErr.Type == tidcommon.ServerErrorType {
+				return &tidcommon.InternalServerError
+			}
+			return &ErrorInvalidSharingConfiguration
+		}
+		if seenOUs[ou.ID] {
+			return &ErrorInvalidSharingConfiguration
+		}
+		seenOUs[ou.ID] = true
+		shared.OUID = ou.ID
+		shared.OUHandle = ou.Handle
+
+		for j, domain := range shared.EmailDomains {
+			domain = strings.ToLower(strings.TrimSpace(domain))
+			if domain == "" || strings.Contains(domain, "@") || seenDomains[domain] {
+				return &ErrorInvalidSharingConfiguration
+			}
+			seenDomains[domain] = true
+			shared.EmailDomains[j] = domain
+		}
+	}
+	return nil
+}
+
+// validateAccessPolicy rejects malformed network entries and country codes in the access policy, and
+// normalizes country codes to upper case.
+func validateAccessPolicy(policy *providers.ApplicationAccessPolicy) *tidcommon.ServiceError {
+	if policy == nil {
+		return nil
+	}
+	for _, networks := range [][]string{policy.AllowedNetworks, policy.DeniedNetworks} {
+		if _, err := netaccess.ParseNetworks(networks); err != nil {
+			return &ErrorInvalidAccessPolicy
+		}
+	}
+	for _, countries := range [][]string{policy.AllowedCountries, policy.DeniedCountries} {
+		for i, country := range countries {
+			country = strings.ToUpper(strings.TrimSpace(country))
+			if !isCountryCode(country) {
+				return &ErrorInvalidAccessPolicy
+			}
+			countries[i] = country
+		}
+	}
+	return nil
+}
+
+// isCountryCode reports whether code is a two-letter upper-case country code.
+func isCountryCode(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
+
 // validateConsentConfig validates the consent configuration for the application.
 func (as *applicationService) validateConsentConfig(appDTO *model.ApplicationDTO) {
 	if appDTO.LoginConsent == nil {
@@ -1235,6 +1669,26 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.applicationservice.redirect_uri_fragment_not_allowed_description",
 			DefaultValue: "Redirect URIs must not contain a fragment component",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURIWildcardHostNotAllowed):
+		return tidcommon.CustomServiceError(ErrorInvalidRedirectURI, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.redirect_uri_wildcard_host_not_allowed_description",
+			DefaultValue: "Redirect URI hosts must not contain wildcards",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRedirectURIMatching):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_redirect_uri_matching_description",
+			DefaultValue: "Redirect URI matching must be 'exact' or 'loopback'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidAllowedOrigin):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_allowed_origin_description",
+			DefaultValue: "Allowed origins must be http or https origins without a path, wildcard, or the null origin",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidAllowedNetwork):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_allowed_network_description",
+			DefaultValue: "Allowed networks must be CIDR ranges or IP addresses",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.applicationservice.auth_code_requires_redirect_uris_description",
@@ -1322,6 +1776,46 @@ func translateOAuthValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.applicationservice.public_client_must_have_pkce_description",
 			DefaultValue: "Public clients must have PKCE required set to true",
 		})
+
+	// OAuth: application type
+	case errors.Is(err, inboundclient.ErrOAuthInvalidApplicationType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.invalid_application_type_description",
+			DefaultValue: "Application type must be 'web', 'native' or 'spa'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthApplicationTypeCannotUseClientSecret):
+		return tidcommon.CustomServiceError(ErrorInvalidPublicClientConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.application_type_cannot_use_client_secret_description",
+			DefaultValue: "Native and SPA applications cannot use a client secret",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthApplicationTypeRequiresPKCE):
+		return tidcommon.CustomServiceError(ErrorInvalidPublicClientConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.application_type_requires_pkce_description",
+			DefaultValue: "Native and SPA applications must have PKCE required set to true",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURINotAllowedForApplicationType):
+		return tidcommon.CustomServiceError(ErrorInvalidRedirectURI, tidcommon.I18nMessage{
+			Key: "error.applicationservice.redirect_uri_not_allowed_for_application_type_description",
+			DefaultValue: "Web and SPA applications must use http or https redirect URIs; native applications " +
+				"must use a custom scheme, https, or an http loopback redirect URI",
+		})
+
+	// OAuth: token validity periods
+	case errors.Is(err, inboundclient.ErrOAuthTokenValidityOutOfBounds):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.token_validity_out_of_bounds_description",
+			DefaultValue: "Token validity periods must fall within the token lifetime bounds of the deployment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthGrantValidityInvalidGrantType):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.grant_validity_invalid_grant_type_description",
+			DefaultValue: "Grant validity periods must be keyed by a supported grant type",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthRefreshTokenInvalidMaxLifetime):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.refresh_token_invalid_max_lifetime_description",
+			DefaultValue: "Refresh token maximum lifetime must not be shorter than the refresh token validity period",
+		})
 	}
 	return nil
 }
@@ -1409,6 +1903,22 @@ func translateIDTokenValidationError(err error) *tidcommon.ServiceError {
 			Key:          "error.applicationservice.idtoken_unsupported_response_type_description",
 			DefaultValue: "ID token responseType is not supported",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthIDTokenUnsupportedSigningAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.idtoken_unsupported_signing_alg_description",
+			DefaultValue: "ID token signing algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAccessTokenUnsupportedSigningAlg):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key:          "error.applicationservice.accesstoken_unsupported_signing_alg_description",
+			DefaultValue: "Access token signing algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthAccessTokenInvalidClaimsPolicy):
+		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
+			Key: "error.applicationservice.accesstoken_invalid_claims_policy_description",
+			DefaultValue: "Access token claims policy must use non-empty claim names, must not both exclude " +
+				"and hash a claim, and must map scoped claims to a non-empty scope",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthIDTokenUnsupportedEncryptionAlg):
 		return tidcommon.CustomServiceError(ErrorInvalidOAuthConfiguration, tidcommon.I18nMessage{
 			Key:          "error.applicationservice.idtoken_unsupported_encryption_alg_description",
@@ -1682,6 +2192,8 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *providers.App
 			LayoutID:                  dto.LayoutID,
 			Assertion:                 dto.Assertion,
 			AllowedUserTypes:          dto.AllowedUserTypes,
+			Sharing:                   dto.Sharing,
+			AccessPolicy:              dto.AccessPolicy,
 			LoginConsent:              dto.LoginConsent,
 		},
 		Template:  dto.Template,
@@ -1708,12 +2220,17 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *providers.App
 					PublicClient:                       oauthAppConfig.PublicClient,
 					RequirePushedAuthorizationRequests: oauthAppConfig.RequirePushedAuthorizationRequests,
 					DPoPBoundAccessTokens:              oauthAppConfig.DPoPBoundAccessTokens,
+					RedirectURIMatching:                oauthAppConfig.RedirectURIMatching,
+					ApplicationType:                    oauthAppConfig.ApplicationType,
 					IncludeActClaim:                    oauthAppConfig.IncludeActClaim,
+					EnforceEssentialClaims:             oauthAppConfig.EnforceEssentialClaims,
 					Token:                              oauthAppConfig.Token,
 					Scopes:                             oauthAppConfig.Scopes,
 					UserInfo:                           oauthAppConfig.UserInfo,
 					ScopeClaims:                        oauthAppConfig.ScopeClaims,
 					AcrValues:                          oauthAppConfig.AcrValues,
+					AllowedOrigins:                     oauthAppConfig.AllowedOrigins,
+					AllowedNetworks:                    oauthAppConfig.AllowedNetworks,
 				},
 			})
 		}
@@ -1785,6 +2302,8 @@ func buildBaseApplicationProcessedDTO(appID string, app *model.ApplicationDTO,
 			LayoutID:                  app.LayoutID,
 			Assertion:                 assertion,
 			AllowedUserTypes:          app.AllowedUserTypes,
+			Sharing:                   app.Sharing,
+			AccessPolicy:              app.AccessPolicy,
 			LoginConsent:              app.LoginConsent,
 		},
 		Template:  app.Template,
@@ -1833,13 +2352,18 @@ func buildOAuthInboundAuthConfigProcessedDTO(
 			PublicClient:                       inboundAuthConfig.OAuthConfig.PublicClient,
 			RequirePushedAuthorizationRequests: inboundAuthConfig.OAuthConfig.RequirePushedAuthorizationRequests,
 			DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+			RedirectURIMatching:                inboundAuthConfig.OAuthConfig.RedirectURIMatching,
+			ApplicationType:                    inboundAuthConfig.OAuthConfig.ApplicationType,
 			IncludeActClaim:                    inboundAuthConfig.OAuthConfig.IncludeActClaim,
+			EnforceEssentialClaims:             inboundAuthConfig.OAuthConfig.EnforceEssentialClaims,
 			Token:                              oauthToken,
 			Scopes:                             inboundAuthConfig.OAuthConfig.Scopes,
 			UserInfo:                           userInfo,
 			ScopeClaims:                        scopeClaims,
 			Certificate:                        certificate,
 			AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+			AllowedOrigins:                     inboundAuthConfig.OAuthConfig.AllowedOrigins,
+			AllowedNetworks:                    inboundAuthConfig.OAuthConfig.AllowedNetworks,
 		},
 	}
 }
@@ -1865,6 +2389,8 @@ func buildReturnApplicationDTO(
 			LayoutID:                  app.LayoutID,
 			Assertion:                 assertion,
 			AllowedUserTypes:          app.AllowedUserTypes,
+			Sharing:                   app.Sharing,
+			AccessPolicy:              app.AccessPolicy,
 			LoginConsent:              app.LoginConsent,
 		},
 		Template:  app.Template,
@@ -1893,13 +2419,18 @@ func buildReturnApplicationDTO(
 				PublicClient:                       inboundAuthConfig.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: inboundAuthConfig.OAuthConfig.RequirePushedAuthorizationRequests,
 				DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+				RedirectURIMatching:                inboundAuthConfig.OAuthConfig.RedirectURIMatching,
+				ApplicationType:                    inboundAuthConfig.OAuthConfig.ApplicationType,
 				IncludeActClaim:                    inboundAuthConfig.OAuthConfig.IncludeActClaim,
+				EnforceEssentialClaims:             inboundAuthConfig.OAuthConfig.EnforceEssentialClaims,
 				Token:                              oauthToken,
 				Scopes:                             inboundAuthConfig.OAuthConfig.Scopes,
 				UserInfo:                           userInfo,
 				ScopeClaims:                        scopeClaims,
 				Certificate:                        oauthCert,
 				AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+				AllowedOrigins:                     inboundAuthConfig.OAuthConfig.AllowedOrigins,
+				AllowedNetworks:                    inboundAuthConfig.OAuthConfig.AllowedNetworks,
 			},
 		}
 		returnApp.InboundAuthConfig = []providers.InboundAuthConfigWithSecret{returnInboundAuthConfig}
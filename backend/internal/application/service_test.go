@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 
@@ -3085,6 +3086,24 @@ func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 			wantCode:    ErrorInvalidRedirectURI.Code,
 			wantDescKey: "error.applicationservice.redirect_uri_fragment_not_allowed_description",
 		},
+		{
+			name:        "RedirectURIWildcardHostNotAllowed",
+			err:         inboundclient.ErrOAuthRedirectURIWildcardHostNotAllowed,
+			wantCode:    ErrorInvalidRedirectURI.Code,
+			wantDescKey: "error.applicationservice.redirect_uri_wildcard_host_not_allowed_description",
+		},
+		{
+			name:        "InvalidRedirectURIMatching",
+			err:         inboundclient.ErrOAuthInvalidRedirectURIMatching,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.invalid_redirect_uri_matching_description",
+		},
+		{
+			name:        "RedirectURINotAllowedForApplicationType",
+			err:         inboundclient.ErrOAuthRedirectURINotAllowedForApplicationType,
+			wantCode:    ErrorInvalidRedirectURI.Code,
+			wantDescKey: "error.applicationservice.redirect_uri_not_allowed_for_application_type_description",
+		},
 		{
 			name:        "AuthCodeRequiresRedirectURIs",
 			err:         inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs,
@@ -3190,6 +3209,24 @@ func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 			wantCode:    ErrorInvalidPublicClientConfiguration.Code,
 			wantDescKey: "error.applicationservice.public_client_must_have_pkce_description",
 		},
+		{
+			name:        "InvalidApplicationType",
+			err:         inboundclient.ErrOAuthInvalidApplicationType,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.invalid_application_type_description",
+		},
+		{
+			name:        "ApplicationTypeCannotUseClientSecret",
+			err:         inboundclient.ErrOAuthApplicationTypeCannotUseClientSecret,
+			wantCode:    ErrorInvalidPublicClientConfiguration.Code,
+			wantDescKey: "error.applicationservice.application_type_cannot_use_client_secret_description",
+		},
+		{
+			name:        "ApplicationTypeRequiresPKCE",
+			err:         inboundclient.ErrOAuthApplicationTypeRequiresPKCE,
+			wantCode:    ErrorInvalidPublicClientConfiguration.Code,
+			wantDescKey: "error.applicationservice.application_type_requires_pkce_description",
+		},
 	}
 	for _, tc := range cases {
 		suite.Run(tc.name, func() {
@@ -3533,6 +3570,88 @@ func (suite *ServiceTestSuite) TestValidateApplicationFields_OUIDWinsWhenBothPro
 	ouMock.AssertNotCalled(suite.T(), "GetOrganizationUnitByPath", mock.Anything, mock.Anything)
 }
 
+func (suite *ServiceTestSuite) TestValidateApplicationFields_SharingResolved() {
+	testConfig := &config.Config{
+		DeclarativeResources: config.DeclarativeResources{Enabled: false},
+	}
+	config.ResetServerRuntime()
+	require.NoError(suite.T(), config.InitializeServerRuntime("/tmp/test", testConfig))
+	defer config.ResetServerRuntime()
+
+	service, _ := suite.setupTestService()
+
+	ouMock := service.ouService.(*oumock.OrganizationUnitServiceInterfaceMock)
+	ouMock.On("GetOrganizationUnitByPath", mock.Anything, "acme").
+		Return(providers.OrganizationUnit{ID: "ou-acme", Handle: "acme"}, nil).Once()
+
+	app := &model.ApplicationDTO{
+		Name: "test-app",
+		OUID: testOUID,
+		InboundAuthProfile: providers.InboundAuthProfile{
+			Sharing: &providers.ApplicationSharing{
+				OrganizationUnits: []providers.SharedOrganizationUnit{
+					{OUHandle: "acme", EmailDomains: []string{" Acme.COM "}},
+				},
+			},
+		},
+	}
+
+	svcErr := service.validateApplicationFields(context.Background(), app)
+
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), "ou-acme", app.Sharing.OrganizationUnits[0].OUID)
+	assert.Equal(suite.T(), []string{"acme.com"}, app.Sharing.OrganizationUnits[0].EmailDomains)
+}
+
+func (suite *ServiceTestSuite) TestValidateApplicationFields_SharingInvalid() {
+	testConfig := &config.Config{
+		DeclarativeResources: config.DeclarativeResources{Enabled: false},
+	}
+	config.ResetServerRuntime()
+	require.NoError(suite.T(), config.InitializeServerRuntime("/tmp/test", testConfig))
+	defer config.ResetServerRuntime()
+
+	testCases := []struct {
+		name   string
+		shared []providers.SharedOrganizationUnit
+	}{
+		{name: "missing OU reference", shared: []providers.SharedOrganizationUnit{{}}},
+		{name: "unknown OU", shared: []providers.SharedOrganizationUnit{{OUID: "ou-missing"}}},
+		{name: "duplicate OU", shared: []providers.SharedOrganizationUnit{{OUID: "ou-acme"}, {OUID: "ou-acme"}}},
+		{name: "duplicate domain", shared: []providers.SharedOrganizationUnit{
+			{OUID: "ou-acme", EmailDomains: []string{"acme.com"}},
+			{OUID: "ou-globex", EmailDomains: []string{"ACME.com"}},
+		}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			service, _ := suite.setupTestService()
+			ouMock := service.ouService.(*oumock.OrganizationUnitServiceInterfaceMock)
+			ouMock.On("GetOrganizationUnit", mock.Anything, "ou-acme").
+				Return(providers.OrganizationUnit{ID: "ou-acme", Handle: "acme"}, nil).Maybe()
+			ouMock.On("GetOrganizationUnit", mock.Anything, "ou-globex").
+				Return(providers.OrganizationUnit{ID: "ou-globex", Handle: "globex"}, nil).Maybe()
+			ouMock.On("GetOrganizationUnit", mock.Anything, "ou-missing").
+				Return(providers.OrganizationUnit{}, &tidcommon.ServiceError{
+					Type: tidcommon.ClientErrorType, Code: "OU-1003"}).Maybe()
+
+			app := &model.ApplicationDTO{
+				Name: "test-app",
+				OUID: testOUID,
+				InboundAuthProfile: providers.InboundAuthProfile{
+					Sharing: &providers.ApplicationSharing{OrganizationUnits: tc.shared},
+				},
+			}
+
+			svcErr := service.validateApplicationFields(context.Background(), app)
+
+			assert.NotNil(suite.T(), svcErr)
+			assert.Equal(suite.T(), ErrorInvalidSharingConfiguration.Code, svcErr.Code)
+		})
+	}
+}
+
 func (suite *ServiceTestSuite) TestValidateApplicationFields_FlowHandleResolutionError() {
 	testConfig := &config.Config{
 		DeclarativeResources: config.DeclarativeResources{Enabled: false},
@@ -4012,3 +4131,403 @@ func (suite *ServiceTestSuite) TestDeleteApplication_EntityDeleteFailsAfterCasca
 	assert.Equal(suite.T(), 1, cascadeCalls)
 	ep.AssertCalled(suite.T(), "DeleteEntity", mock.Anything)
 }
+
+func (suite *ServiceTestSuite) TestDeleteApplication_SoftDelete() {
+	service, mockStore := suite.setupTestService()
+	service.softDelete = config.SoftDeleteConfig{Enabled: true, RetentionDays: 30, PurgeIntervalMinutes: 60}
+	ep := resetEntityProviderMethod(service, "GetEntity")
+	ep.On("GetEntity", testServiceAppID).Return(
+		&providers.Entity{ID: testServiceAppID, Category: providers.EntityCategoryApp},
+		(*entityprovider.EntityProviderError)(nil))
+	ep.On("SoftDeleteEntity", testServiceAppID).Return((*entityprovider.EntityProviderError)(nil))
+
+	svcErr := service.DeleteApplication(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	ep.AssertNotCalled(suite.T(), "DeleteEntity", mock.Anything)
+	mockStore.AssertNotCalled(suite.T(), "DeleteInboundClient", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestDeleteApplication_SoftDelete_NotFound() {
+	service, _ := suite.setupTestService()
+	service.softDelete = config.SoftDeleteConfig{Enabled: true, RetentionDays: 30, PurgeIntervalMinutes: 60}
+
+	svcErr := service.DeleteApplication(context.Background(), testServiceAppID)
+
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), ErrorApplicationNotFound.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestPurgeApplication_DeletedApplication() {
+	service, mockStore := suite.setupTestService()
+	ep := service.entityProvider.(*entityprovidermock.EntityProviderInterfaceMock)
+	ep.On("GetDeletedEntity", testServiceAppID).Return(
+		&providers.Entity{ID: testServiceAppID, Category: providers.EntityCategoryApp},
+		(*entityprovider.EntityProviderError)(nil))
+	mockStore.On("DeleteInboundClient", mock.Anything, testServiceAppID).Return(nil)
+
+	svcErr := service.PurgeApplication(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	ep.AssertCalled(suite.T(), "DeleteEntity", testServiceAppID)
+}
+
+func (suite *ServiceTestSuite) TestPurgeApplication_NotFound() {
+	service, _ := suite.setupTestService()
+	ep := service.entityProvider.(*entityprovidermock.EntityProviderInterfaceMock)
+	ep.On("GetDeletedEntity", testServiceAppID).Return((*providers.Entity)(nil),
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+
+	svcErr := service.PurgeApplication(context.Background(), testServiceAppID)
+
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), ErrorApplicationNotFound.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestRestoreApplication_Success() {
+	service, _ := suite.setupTestService()
+	service.softDelete = config.SoftDeleteConfig{Enabled: true, RetentionDays: 30, PurgeIntervalMinutes: 60}
+	sysAttrs, _ := json.Marshal(map[string]interface{}{"name": "Test App", "clientId": testClientID})
+	deletedAt := time.Now().Add(-time.Hour)
+	ep := service.entityProvider.(*entityprovidermock.EntityProviderInterfaceMock)
+	ep.On("GetDeletedEntity", testServiceAppID).Return(&providers.Entity{
+		ID: testServiceAppID, Category: providers.EntityCategoryApp,
+		SystemAttributes: sysAttrs, DeletedAt: &deletedAt,
+	}, (*entityprovider.EntityProviderError)(nil))
+	ep.On("RestoreEntity", testServiceAppID).Return((*entityprovider.EntityProviderError)(nil))
+
+	svcErr := service.RestoreApplication(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	ep.AssertCalled(suite.T(), "RestoreEntity", testServiceAppID)
+}
+
+func (suite *ServiceTestSuite) TestRestoreApplication_WindowExpired() {
+	service, _ := suite.setupTestService()
+	service.softDelete = config.SoftDeleteConfig{Enabled: true, RetentionDays: 1, PurgeIntervalMinutes: 60}
+	deletedAt := time.Now().Add(-48 * time.Hour)
+	ep := service.entityProvider.(*entityprovidermock.EntityProviderInterfaceMock)
+	ep.On("GetDeletedEntity", testServiceAppID).Return(&providers.Entity{
+		ID: testServiceAppID, Category: providers.EntityCategoryApp, DeletedAt: &deletedAt,
+	}, (*entityprovider.EntityProviderError)(nil))
+
+	svcErr := service.RestoreApplication(context.Background(), testServiceAppID)
+
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), ErrorApplicationRestoreWindowExpired.Code, svcErr.Code)
+	ep.AssertNotCalled(suite.T(), "RestoreEntity", mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestRestoreApplication_NameTaken() {
+	service, _ := suite.setupTestService()
+	service.softDelete = config.SoftDeleteConfig{Enabled: true, RetentionDays: 30, PurgeIntervalMinutes: 60}
+	sysAttrs, _ := json.Marshal(map[string]interface{}{"name": "Test App"})
+	deletedAt := time.Now()
+	ep := resetEntityProviderMethod(service, "IdentifyEntity")
+	ep.On("IdentifyEntity", mock.Anything).Return(
+		func() *string { id := testConflictingAppID; return &id }(), (*entityprovider.EntityProviderError)(nil))
+	ep.On("GetDeletedEntity", testServiceAppID).Return(&providers.Entity{
+		ID: testServiceAppID, Category: providers.EntityCategoryApp,
+		SystemAttributes: sysAttrs, DeletedAt: &deletedAt,
+	}, (*entityprovider.EntityProviderError)(nil))
+
+	svcErr := service.RestoreApplication(context.Background(), testServiceAppID)
+
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), ErrorApplicationAlreadyExistsWithName.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestGetDeletedApplicationList() {
+	service, _ := suite.setupTestService()
+	sysAttrs, _ := json.Marshal(map[string]interface{}{"name": "Test App"})
+	deletedAt := time.Now()
+	ep := service.entityProvider.(*entityprovidermock.EntityProviderInterfaceMock)
+	ep.On("GetDeletedEntityListCount", providers.EntityCategoryApp).
+		Return(1, (*entityprovider.EntityProviderError)(nil))
+	ep.On("GetDeletedEntityList", providers.EntityCategoryApp, serverconst.MaxCompositeStoreRecords, 0).
+		Return([]providers.Entity{{
+			ID: testServiceAppID, Category: providers.EntityCategoryApp,
+			SystemAttributes: sysAttrs, DeletedAt: &deletedAt,
+		}}, (*entityprovider.EntityProviderError)(nil))
+
+	resp, svcErr := service.GetDeletedApplicationList(context.Background())
+
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), 1, resp.TotalResults)
+	suite.Require().Len(resp.Applications, 1)
+	assert.Equal(suite.T(), "Test App", resp.Applications[0].Name)
+	assert.Equal(suite.T(), &deletedAt, resp.Applications[0].DeletedAt)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationBranding_Success() {
+	service, mockStore := suite.setupTestService()
+
+	branding := &providers.ApplicationBranding{
+		LogoURL: "https://cdn.example.com/logo.png",
+		Colors:  &providers.BrandingColors{Primary: "#1A73E8"},
+	}
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{
+		ID:       testServiceAppID,
+		Name:     "Test App",
+		Branding: branding,
+	})
+
+	result, svcErr := service.GetApplicationBranding(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), branding, result)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationBranding_NotConfigured() {
+	service, mockStore := suite.setupTestService()
+
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "Test App"})
+
+	result, svcErr := service.GetApplicationBranding(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), &providers.ApplicationBranding{}, result)
+}
+
+func (suite *ServiceTestSuite) TestUpdateApplicationBranding_Success() {
+	service, mockStore := suite.setupTestService()
+
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Return(false)
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{
+		ID:      testServiceAppID,
+		Name:    "Test App",
+		LogoURL: "https://example.com/app.png",
+	})
+	branding := &providers.ApplicationBranding{
+		Colors:        &providers.BrandingColors{Primary: "#fff", Background: "#00000080"},
+		LocalizedText: map[string]map[string]string{"en-US": {"signin.title": "Welcome"}},
+	}
+	mockStore.On("UpdateInboundClientProperties", mock.Anything, testServiceAppID,
+		mock.MatchedBy(func(props map[string]interface{}) bool {
+			return props[propBranding] == branding && props[propLogoURL] == "https://example.com/app.png"
+		})).Return(nil).Once()
+
+	result, svcErr := service.UpdateApplicationBranding(context.Background(), testServiceAppID, branding)
+
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), branding, result)
+}
+
+func (suite *ServiceTestSuite) TestUpdateApplicationBranding_Declarative() {
+	service, mockStore := suite.setupTestService()
+
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Return(true)
+
+	result, svcErr := service.UpdateApplicationBranding(
+		context.Background(), testServiceAppID, &providers.ApplicationBranding{})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorCannotModifyDeclarativeResource, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestUpdateApplicationBranding_Invalid() {
+	testCases := []struct {
+		name     string
+		branding *providers.ApplicationBranding
+	}{
+		{name: "nil branding", branding: nil},
+		{name: "invalid logo URL", branding: &providers.ApplicationBranding{LogoURL: "not a url"}},
+		{name: "invalid color", branding: &providers.ApplicationBranding{
+			Colors: &providers.BrandingColors{Text: "blue"}}},
+		{name: "invalid language", branding: &providers.ApplicationBranding{
+			LocalizedText: map[string]map[string]string{"not_a_tag!": {"title": "Hi"}}}},
+		{name: "empty text key", branding: &providers.ApplicationBranding{
+			LocalizedText: map[string]map[string]string{"en": {" ": "Hi"}}}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			service, mockStore := suite.setupTestService()
+			mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Return(false).Maybe()
+
+			result, svcErr := service.UpdateApplicationBranding(context.Background(), testServiceAppID, tc.branding)
+
+			assert.Nil(suite.T(), result)
+			assert.Equal(suite.T(), &ErrorInvalidBranding, svcErr)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestValidateAccessPolicy() {
+	testCases := []struct {
+		name    string
+		policy  *providers.ApplicationAccessPolicy
+		wantErr bool
+	}{
+		{name: "nil policy", policy: nil},
+		{name: "valid policy", policy: &providers.ApplicationAccessPolicy{
+			AllowedNetworks: []string{"10.0.0.0/8", "2001:db8::1"}, DeniedCountries: []string{" kp "}}},
+		{name: "invalid allowed network", policy: &providers.ApplicationAccessPolicy{
+			AllowedNetworks: []string{"10.0.0.0/33"}}, wantErr: true},
+		{name: "invalid denied network", policy: &providers.ApplicationAccessPolicy{
+			DeniedNetworks: []string{"not-an-ip"}}, wantErr: true},
+		{name: "invalid country", policy: &providers.ApplicationAccessPolicy{
+			AllowedCountries: []string{"USA"}}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			svcErr := validateAccessPolicy(tc.policy)
+			if tc.wantErr {
+				assert.Equal(suite.T(), &ErrorInvalidAccessPolicy, svcErr)
+				return
+			}
+			assert.Nil(suite.T(), svcErr)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestValidateAccessPolicy_NormalizesCountries() {
+	policy := &providers.ApplicationAccessPolicy{AllowedCountries: []string{"lk", " Gb"}}
+
+	assert.Nil(suite.T(), validateAccessPolicy(policy))
+	assert.Equal(suite.T(), []string{"LK", "GB"}, policy.AllowedCountries)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationHistory_Success() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "Test App"})
+	createdAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	mockStore.On("GetOAuthProfileRevisionList", mock.Anything, testServiceAppID, 1, 1).
+		Return([]inboundmodel.OAuthProfileRevision{{
+			Version:   2,
+			ChangedBy: "admin-user",
+			CreatedAt: createdAt,
+			Changes:   []inboundmodel.ConfigChange{{Field: "redirectUris", NewValue: []interface{}{"https://a"}}},
+		}}, 3, nil)
+
+	resp, svcErr := service.GetApplicationHistory(context.Background(), testServiceAppID, 1, 1)
+
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), 3, resp.TotalResults)
+	assert.Equal(suite.T(), 2, resp.StartIndex)
+	assert.Equal(suite.T(), 1, resp.Count)
+	suite.Require().Len(resp.History, 1)
+	assert.Equal(suite.T(), 2, resp.History[0].Version)
+	assert.Equal(suite.T(), "admin-user", resp.History[0].ChangedBy)
+	assert.Equal(suite.T(), createdAt, resp.History[0].CreatedAt)
+	assert.Equal(suite.T(), "redirectUris", resp.History[0].Changes[0].Field)
+	assert.NotEmpty(suite.T(), resp.Links)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationHistory_InvalidParams() {
+	testCases := []struct {
+		name     string
+		appID    string
+		limit    int
+		offset   int
+		expected *tidcommon.ServiceError
+	}{
+		{name: "empty app ID", appID: "", limit: 10, expected: &ErrorInvalidApplicationID},
+		{name: "zero limit", appID: testServiceAppID, limit: 0, expected: &ErrorInvalidLimit},
+		{name: "limit too large", appID: testServiceAppID, limit: serverconst.MaxPageSize + 1,
+			expected: &ErrorInvalidLimit},
+		{name: "negative offset", appID: testServiceAppID, limit: 10, offset: -1, expected: &ErrorInvalidOffset},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			service, _ := suite.setupTestService()
+			resp, svcErr := service.GetApplicationHistory(context.Background(), tc.appID, tc.limit, tc.offset)
+			assert.Nil(suite.T(), resp)
+			assert.Equal(suite.T(), tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationHistory_ApplicationNotFound() {
+	service, mockStore := suite.setupTestService()
+	mockStore.On("GetInboundClientByEntityID", mock.Anything, testServiceAppID).
+		Return((*inboundmodel.InboundClient)(nil), nil)
+
+	resp, svcErr := service.GetApplicationHistory(context.Background(), testServiceAppID, 10, 0)
+
+	assert.Nil(suite.T(), resp)
+	assert.Equal(suite.T(), &ErrorApplicationNotFound, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationHistory_StoreError() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "Test App"})
+	mockStore.On("GetOAuthProfileRevisionList", mock.Anything, testServiceAppID, 10, 0).
+		Return(nil, 0, errors.New("db error"))
+
+	resp, svcErr := service.GetApplicationHistory(context.Background(), testServiceAppID, 10, 0)
+
+	assert.Nil(suite.T(), resp)
+	assert.Equal(suite.T(), &tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationHistoryVersion_Success() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{
+		ID:   testServiceAppID,
+		Name: "Test App",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigProcessed{{
+			Type: providers.OAuthInboundAuthType,
+			OAuthConfig: &providers.OAuthClient{
+				ClientID:     "current-client",
+				RedirectURIs: []string{"https://current.example.com/cb"},
+			},
+		}},
+	})
+	mockStore.On("GetOAuthProfileRevision", mock.Anything, testServiceAppID, 1).
+		Return(&inboundmodel.OAuthProfileRevision{
+			Version: 1,
+			OAuthProfile: &providers.OAuthProfile{
+				RedirectURIs:            []string{"https://old.example.com/cb"},
+				GrantTypes:              []string{"authorization_code"},
+				TokenEndpointAuthMethod: "client_secret_basic",
+			},
+		}, nil)
+
+	resp, svcErr := service.GetApplicationHistoryVersion(context.Background(), testServiceAppID, 1)
+
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), 1, resp.Version)
+	assert.Equal(suite.T(), []inboundmodel.ConfigChange{}, resp.Changes)
+	suite.Require().Len(resp.InboundAuthConfig, 1)
+	oauthConfig := resp.InboundAuthConfig[0].OAuthConfig
+	assert.Equal(suite.T(), "current-client", oauthConfig.ClientID)
+	assert.Equal(suite.T(), []string{"https://old.example.com/cb"}, oauthConfig.RedirectURIs)
+	assert.Equal(suite.T(), []providers.GrantType{providers.GrantTypeAuthorizationCode}, oauthConfig.GrantTypes)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationHistoryVersion_RemovedConfiguration() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "Test App"})
+	mockStore.On("GetOAuthProfileRevision", mock.Anything, testServiceAppID, 3).
+		Return(&inboundmodel.OAuthProfileRevision{Version: 3}, nil)
+
+	resp, svcErr := service.GetApplicationHistoryVersion(context.Background(), testServiceAppID, 3)
+
+	suite.Require().Nil(svcErr)
+	assert.Empty(suite.T(), resp.InboundAuthConfig)
+	assert.NotNil(suite.T(), resp.InboundAuthConfig)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationHistoryVersion_InvalidVersion() {
+	service, _ := suite.setupTestService()
+
+	resp, svcErr := service.GetApplicationHistoryVersion(context.Background(), testServiceAppID, 0)
+
+	assert.Nil(suite.T(), resp)
+	assert.Equal(suite.T(), &ErrorInvalidHistoryVersion, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationHistoryVersion_NotFound() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "Test App"})
+	mockStore.On("GetOAuthProfileRevision", mock.Anything, testServiceAppID, 9).
+		Return((*inboundmodel.OAuthProfileRevision)(nil), inboundclient.ErrOAuthProfileRevisionNotFound)
+
+	resp, svcErr := service.GetApplicationHistoryVersion(context.Background(), testServiceAppID, 9)
+
+	assert.Nil(suite.T(), resp)
+	assert.Equal(suite.T(), &ErrorApplicationHistoryVersionNotFound, svcErr)
+}
@@ -18,7 +18,17 @@
 
 package application
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+
+	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// hexColorPattern matches #RGB, #RRGGBB and #RRGGBBAA color values.
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
 
 // AppI18nNamespace returns the i18n namespace for application localized metadata.
 func AppI18nNamespace() string {
@@ -41,3 +51,28 @@ func AppI18nRef(appID, field string) string {
 func isI18nRef(s string) bool {
 	return strings.HasPrefix(s, "{{t(") && strings.HasSuffix(s, ")}}")
 }
+
+// isValidBranding reports whether the branding logo URL, colors and localized text are well formed.
+func isValidBranding(branding *providers.ApplicationBranding) bool {
+	if branding.LogoURL != "" && !sysutils.IsValidLogoURI(branding.LogoURL) {
+		return false
+	}
+	if c := branding.Colors; c != nil {
+		for _, color := range []string{c.Primary, c.Secondary, c.Background, c.Text} {
+			if color != "" && !hexColorPattern.MatchString(color) {
+				return false
+			}
+		}
+	}
+	for language, texts := range branding.LocalizedText {
+		if !i18nmgt.ValidateLanguage(language) {
+			return false
+		}
+		for key := range texts {
+			if strings.TrimSpace(key) == "" {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accesspolicy
+
+// denyReason identifies why an access policy blocked an authentication attempt.
+type denyReason string
+
+const (
+	// denyReasonDeniedNetwork indicates the client IP address is in a denied network.
+	denyReasonDeniedNetwork denyReason = "denied_network"
+	// denyReasonDeniedCountry indicates the client IP address is located in a denied country.
+	denyReasonDeniedCountry denyReason = "denied_country"
+	// denyReasonNotAllowed indicates the client matches none of the allowed networks or countries.
+	denyReasonNotAllowed denyReason = "not_allowed"
+)
@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accesspolicy
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/geoip"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize initializes the access policy service, locating clients through the geo_ip deployment
+// configuration. A malformed geo_ip network returns a non-nil error.
+func Initialize(observabilitySvc providers.ObservabilityProvider) (AccessPolicyServiceInterface, error) {
+	locator, err := geoip.Initialize(config.GetServerRuntime().Config.GeoIP)
+	if err != nil {
+		return nil, err
+	}
+	return newAccessPolicyService(locator, observabilitySvc), nil
+}
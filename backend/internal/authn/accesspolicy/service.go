@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package accesspolicy evaluates the network and geolocation access policies of applications
+// against the client IP address of a request, and publishes a security event for blocked attempts.
+package accesspolicy
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/geoip"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/netaccess"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// AccessPolicyServiceInterface defines the interface for evaluating application access policies.
+type AccessPolicyServiceInterface interface {
+	// IsAllowed reports whether the client IP address of the request is permitted by the access
+	// policy of the given application. A blocked attempt publishes a security event.
+	IsAllowed(ctx context.Context, appID string, policy *providers.ApplicationAccessPolicy) bool
+}
+
+// accessPolicyService is the default implementation of AccessPolicyServiceInterface.
+type accessPolicyService struct {
+	locator          geoip.LocatorInterface
+	observabilitySvc providers.ObservabilityProvider
+	logger           *log.Logger
+}
+
+// newAccessPolicyService creates a new instance of accessPolicyService.
+func newAccessPolicyService(locator geoip.LocatorInterface,
+	observabilitySvc providers.ObservabilityProvider) AccessPolicyServiceInterface {
+	return &accessPolicyService{
+		locator:          locator,
+		observabilitySvc: observabilitySvc,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AccessPolicyService")),
+	}
+}
+
+// IsAllowed reports whether the client IP address of the request is permitted by the access policy
+// of the given application. The client IP address is the one resolved by the network access
+// middleware, which honours the trusted proxy configuration.
+func (s *accessPolicyService) IsAllowed(ctx context.Context, appID string,
+	policy *providers.ApplicationAccessPolicy) bool {
+	if policy.IsEmpty() {
+		return true
+	}
+
+	addr := sysContext.GetClientIP(ctx)
+	country := s.locate(addr)
+	reason := evaluate(policy, addr, country)
+	if reason == "" {
+		return true
+	}
+
+	s.logger.Debug(ctx, "Authentication attempt blocked by application access policy",
+		log.String("appID", appID), log.String("reason", string(reason)))
+	s.publish(ctx, appID, addr, country, reason)
+	return false
+}
+
+// locate returns the country of addr, or an empty string when it cannot be determined.
+func (s *accessPolicyService) locate(addr netip.Addr) string {
+	if s.locator == nil || !addr.IsValid() {
+		return ""
+	}
+	location, ok := s.locator.Locate(addr)
+	if !ok {
+		return ""
+	}
+	return location.Country
+}
+
+// evaluate applies policy to the client and returns why it is blocked, or an empty reason when it is
+// permitted. Denied entries take precedence over allowed entries. When any allow list is set, a
+// client whose address or country cannot be determined is blocked.
+func evaluate(policy *providers.ApplicationAccessPolicy, addr netip.Addr, country string) denyReason {
+	if netaccess.IsAllowed(policy.DeniedNetworks, addr) {
+		return denyReasonDeniedNetwork
+	}
+	if containsCountry(policy.DeniedCountries, country) {
+		return denyReasonDeniedCountry
+	}
+	if len(policy.AllowedNetworks) == 0 && len(policy.AllowedCountries) == 0 {
+		return ""
+	}
+	if netaccess.IsAllowed(policy.AllowedNetworks, addr) || containsCountry(policy.AllowedCountries, country) {
+		return ""
+	}
+	return denyReasonNotAllowed
+}
+
+// containsCountry reports whether country is one of the given country codes.
+func containsCountry(countries []string, country string) bool {
+	if country == "" {
+		return false
+	}
+	for _, c := range countries {
+		if strings.EqualFold(c, country) {
+			return true
+		}
+	}
+	return false
+}
+
+// publish emits a security event for an authentication attempt blocked by an access policy.
+func (s *accessPolicyService) publish(ctx context.Context, appID string, addr netip.Addr, country string,
+	reason denyReason) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeAccessPolicyDenied),
+		event.ComponentAccessPolicy).
+		WithStatus(providers.StatusFailure).
+		WithData(event.DataKey.EntityID, appID).
+		WithData(event.DataKey.DenyReason, string(reason))
+	if addr.IsValid() {
+		evt.WithData(event.DataKey.ClientIP, addr.String())
+	}
+	if country != "" {
+		evt.WithData(event.DataKey.Country, country)
+	}
+	s.observabilitySvc.PublishEvent(ctx, evt)
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accesspolicy
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/geoip"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+)
+
+const (
+	colomboIP = "203.0.113.10"
+	londonIP  = "198.51.100.20"
+	unknownIP = "192.0.2.5"
+	testAppID = "app-1"
+)
+
+type AccessPolicyServiceTestSuite struct {
+	suite.Suite
+	obs     *observabilitymock.ObservabilityServiceInterfaceMock
+	events  []*providers.Event
+	service AccessPolicyServiceInterface
+}
+
+func TestAccessPolicyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AccessPolicyServiceTestSuite))
+}
+
+func (suite *AccessPolicyServiceTestSuite) SetupTest() {
+	locator, err := geoip.Initialize(config.GeoIPConfig{Networks: []config.GeoIPNetworkConfig{
+		{CIDR: "203.0.113.0/24", Country: "LK"},
+		{CIDR: "198.51.100.0/24", Country: "GB"},
+	}})
+	suite.Require().NoError(err)
+
+	suite.events = nil
+	suite.obs = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.obs.On("IsEnabled").Return(true).Maybe()
+	suite.obs.On("PublishEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(1).(*providers.Event))
+	}).Return().Maybe()
+	suite.service = newAccessPolicyService(locator, suite.obs)
+}
+
+func clientCtx(ip string) context.Context {
+	if ip == "" {
+		return context.Background()
+	}
+	return sysContext.WithClientIP(context.Background(), netip.MustParseAddr(ip))
+}
+
+func (suite *AccessPolicyServiceTestSuite) TestIsAllowed() {
+	testCases := []struct {
+		name     string
+		policy   *providers.ApplicationAccessPolicy
+		clientIP string
+		allowed  bool
+		reason   denyReason
+	}{
+		{name: "NilPolicy", policy: nil, clientIP: colomboIP, allowed: true},
+		{name: "EmptyPolicy", policy: &providers.ApplicationAccessPolicy{}, allowed: true},
+		{
+			name:     "DeniedNetwork",
+			policy:   &providers.ApplicationAccessPolicy{DeniedNetworks: []string{"203.0.113.0/24"}},
+			clientIP: colomboIP, reason: denyReasonDeniedNetwork,
+		},
+		{
+			name:     "DeniedCountry",
+			policy:   &providers.ApplicationAccessPolicy{DeniedCountries: []string{"gb"}},
+			clientIP: londonIP, reason: denyReasonDeniedCountry,
+		},
+		{
+			name:     "DeniedCountryUnknownLocation",
+			policy:   &providers.ApplicationAccessPolicy{DeniedCountries: []string{"GB"}},
+			clientIP: unknownIP, allowed: true,
+		},
+		{
+			name:     "AllowedNetwork",
+			policy:   &providers.ApplicationAccessPolicy{AllowedNetworks: []string{"192.0.2.0/24"}},
+			clientIP: unknownIP, allowed: true,
+		},
+		{
+			name:     "AllowedCountry",
+			policy:   &providers.ApplicationAccessPolicy{AllowedCountries: []string{"LK"}},
+			clientIP: colomboIP, allowed: true,
+		},
+		{
+			name:     "NotInAllowList",
+			policy:   &providers.ApplicationAccessPolicy{AllowedCountries: []string{"LK"}},
+			clientIP: londonIP, reason: denyReasonNotAllowed,
+		},
+		{
+			name:   "UnknownClientWithAllowList",
+			policy: &providers.ApplicationAccessPolicy{AllowedNetworks: []string{"0.0.0.0/0"}},
+			reason: denyReasonNotAllowed,
+		},
+		{
+			name: "DenyTakesPrecedence",
+			policy: &providers.ApplicationAccessPolicy{
+				AllowedCountries: []string{"LK"}, DeniedNetworks: []string{colomboIP},
+			},
+			clientIP: colomboIP, reason: denyReasonDeniedNetwork,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.events = nil
+			allowed := suite.service.IsAllowed(clientCtx(tc.clientIP), testAppID, tc.policy)
+			suite.Equal(tc.allowed, allowed)
+			if tc.allowed {
+				suite.Empty(suite.events)
+				return
+			}
+			suite.Require().Len(suite.events, 1)
+			evt := suite.events[0]
+			suite.Equal(string(event.EventTypeAccessPolicyDenied), evt.Type)
+			suite.Equal(testAppID, evt.Data[event.DataKey.EntityID])
+			suite.Equal(string(tc.reason), evt.Data[event.DataKey.DenyReason])
+		})
+	}
+}
+
+func (suite *AccessPolicyServiceTestSuite) TestIsAllowed_PublishesClientDetails() {
+	policy := &providers.ApplicationAccessPolicy{DeniedCountries: []string{"GB"}}
+
+	suite.False(suite.service.IsAllowed(clientCtx(londonIP), testAppID, policy))
+
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(londonIP, suite.events[0].Data[event.DataKey.ClientIP])
+	suite.Equal("GB", suite.events[0].Data[event.DataKey.Country])
+}
+
+func (suite *AccessPolicyServiceTestSuite) TestIsAllowed_ObservabilityDisabled() {
+	obs := observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	obs.On("IsEnabled").Return(false)
+	service := newAccessPolicyService(nil, obs)
+
+	suite.False(service.IsAllowed(clientCtx(colomboIP), testAppID,
+		&providers.ApplicationAccessPolicy{AllowedNetworks: []string{"10.0.0.0/8"}}))
+}
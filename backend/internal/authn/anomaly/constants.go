@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package anomaly
+
+// minTravelDistanceKm is the distance below which a move between sign-ins is never treated as
+// impossible travel, absorbing the imprecision of IP geolocation.
+const minTravelDistanceKm = 100.0
+
+// minElapsedHours bounds the elapsed time used to compute the travel speed, so two sign-ins in the
+// same instant do not divide by zero.
+const minElapsedHours = 1.0 / 3600
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package anomaly
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/geoip"
+)
+
+// LoginSignal describes a successful sign-in to be assessed.
+type LoginSignal struct {
+	UserID    string
+	ClientIP  netip.Addr
+	UserAgent string
+	// DeviceID is an optional device identifier supplied by the client application. When set, it
+	// identifies the device instead of the User-Agent.
+	DeviceID string
+}
+
+// Assessment is the outcome of evaluating a sign-in against the user's previous sign-ins.
+type Assessment struct {
+	// DeviceID is the fingerprint of the device the sign-in came from, or empty when unknown.
+	DeviceID string
+	// NewDevice is true when the device has not been seen for the user before.
+	NewDevice bool
+	// ImpossibleTravel is true when the user could not have travelled from the previous sign-in
+	// location in the elapsed time.
+	ImpossibleTravel bool
+	// Location is the resolved location of the sign-in, or nil when it is unknown.
+	Location *geoip.Location
+	// DistanceKm and TravelSpeedKmh describe the move from the previous located sign-in.
+	DistanceKm     float64
+	TravelSpeedKmh float64
+}
+
+// IsAnomalous reports whether any anomaly was detected.
+func (a *Assessment) IsAnomalous() bool {
+	return a != nil && (a.NewDevice || a.ImpossibleTravel)
+}
+
+// knownDevice is a device fingerprint the user has signed in from.
+type knownDevice struct {
+	ID        string    `json:"id"`
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+}
+
+// loginProfile is the sign-in history kept per user to detect anomalies.
+type loginProfile struct {
+	Devices      []knownDevice   `json:"devices,omitempty"`
+	LastLocation *geoip.Location `json:"lastLocation,omitempty"`
+	LastLocated  time.Time       `json:"lastLocated,omitempty"`
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package anomaly detects anomalous sign-ins, such as a sign-in from a new device or one implying
+// impossible travel, and publishes them as security events.
+package anomaly
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/geoip"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// AnomalyServiceInterface defines the interface for sign-in anomaly detection.
+type AnomalyServiceInterface interface {
+	// IsEnabled reports whether anomaly detection is enabled for the deployment.
+	IsEnabled() bool
+
+	// Evaluate assesses a successful sign-in against the user's previous sign-ins, records it, and
+	// publishes a security event for each detected anomaly.
+	Evaluate(ctx context.Context, signal LoginSignal) (*Assessment, error)
+}
+
+// anomalyService is the default implementation of AnomalyServiceInterface.
+type anomalyService struct {
+	cfg              config.AnomalyDetectionConfig
+	store            anomalyStoreInterface
+	locator          geoip.LocatorInterface
+	observabilitySvc providers.ObservabilityProvider
+	now              func() time.Time
+	logger           *log.Logger
+}
+
+// newAnomalyService creates a new instance of anomalyService.
+func newAnomalyService(cfg config.AnomalyDetectionConfig, store anomalyStoreInterface,
+	locator geoip.LocatorInterface, observabilitySvc providers.ObservabilityProvider) AnomalyServiceInterface {
+	return &anomalyService{
+		cfg:              cfg,
+		store:            store,
+		locator:          locator,
+		observabilitySvc: observabilitySvc,
+		now:              time.Now,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AnomalyService")),
+	}
+}
+
+// IsEnabled reports whether anomaly detection is enabled for the deployment.
+func (s *anomalyService) IsEnabled() bool {
+	return s.cfg.Enabled
+}
+
+// Evaluate assesses a successful sign-in against the user's previous sign-ins. The first sign-in of
+// a user establishes the baseline and is never anomalous.
+func (s *anomalyService) Evaluate(ctx context.Context, signal LoginSignal) (*Assessment, error) {
+	if !s.cfg.Enabled || signal.UserID == "" {
+		return &Assessment{}, nil
+	}
+
+	profile, err := s.store.GetLoginProfile(ctx, signal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	assessment := &Assessment{DeviceID: deviceFingerprint(signal)}
+	if loc, ok := s.locator.Locate(signal.ClientIP); ok {
+		assessment.Location = &loc
+	}
+
+	// Keep the previous sign-in location for the events, as recording the sign-in replaces it.
+	var previous loginProfile
+	if profile == nil {
+		profile = &loginProfile{}
+	} else {
+		previous = *profile
+		s.assess(assessment, profile, now)
+	}
+
+	s.record(profile, assessment, now)
+	ttl := int64(s.cfg.RetentionDays) * int64(24*time.Hour/time.Second)
+	if err := s.store.SaveLoginProfile(ctx, signal.UserID, profile, ttl); err != nil {
+		return nil, err
+	}
+
+	if assessment.NewDevice {
+		s.publish(ctx, event.EventTypeNewDeviceLogin, signal.UserID, assessment, &previous)
+	}
+	if assessment.ImpossibleTravel {
+		s.publish(ctx, event.EventTypeImpossibleTravel, signal.UserID, assessment, &previous)
+	}
+	if assessment.IsAnomalous() {
+		s.logger.Debug(ctx, "Anomalous sign-in detected", log.MaskedString("userID", signal.UserID),
+			log.Bool("newDevice", assessment.NewDevice), log.Bool("impossibleTravel", assessment.ImpossibleTravel))
+	}
+	return assessment, nil
+}
+
+// assess compares the sign-in with the stored profile and fills in the detected anomalies.
+func (s *anomalyService) assess(assessment *Assessment, profile *loginProfile, now time.Time) {
+	if assessment.DeviceID != "" && !slices.ContainsFunc(profile.Devices, func(d knownDevice) bool {
+		return d.ID == assessment.DeviceID
+	}) {
+		assessment.NewDevice = true
+	}
+
+	if assessment.Location == nil || profile.LastLocation == nil {
+		return
+	}
+	distance := geoip.DistanceKm(*profile.LastLocation, *assessment.Location)
+	hours := max(now.Sub(profile.LastLocated).Hours(), minElapsedHours)
+	assessment.DistanceKm = distance
+	assessment.TravelSpeedKmh = distance / hours
+	assessment.ImpossibleTravel = distance >= minTravelDistanceKm &&
+		assessment.TravelSpeedKmh > s.cfg.ImpossibleTravelSpeedKmh
+}
+
+// record adds the sign-in to the profile, keeping the most recently used devices.
+func (s *anomalyService) record(profile *loginProfile, assessment *Assessment, now time.Time) {
+	if assessment.DeviceID != "" {
+		idx := slices.IndexFunc(profile.Devices, func(d knownDevice) bool { return d.ID == assessment.DeviceID })
+		if idx >= 0 {
+			profile.Devices[idx].LastSeen = now
+		} else {
+			profile.Devices = append(profile.Devices,
+				knownDevice{ID: assessment.DeviceID, FirstSeen: now, LastSeen: now})
+		}
+		slices.SortStableFunc(profile.Devices, func(a, b knownDevice) int { return b.LastSeen.Compare(a.LastSeen) })
+		if len(profile.Devices) > s.cfg.MaxKnownDevices {
+			profile.Devices = profile.Devices[:s.cfg.MaxKnownDevices]
+		}
+	}
+	if assessment.Location != nil {
+		profile.LastLocation = assessment.Location
+		profile.LastLocated = now
+	}
+}
+
+// publish emits a security event for a detected anomaly.
+func (s *anomalyService) publish(ctx context.Context, eventType providers.EventType, userID string,
+	assessment *Assessment, previous *loginProfile) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(eventType), event.ComponentAnomalyDetection).
+		WithStatus(providers.StatusSuccess).
+		WithData(event.DataKey.UserID, userID).
+		WithData(event.DataKey.DeviceID, assessment.DeviceID)
+	if assessment.Location != nil {
+		evt.WithData(event.DataKey.Country, assessment.Location.Country)
+	}
+	if eventType == event.EventTypeImpossibleTravel && previous.LastLocation != nil {
+		evt.WithData(event.DataKey.PreviousCountry, previous.LastLocation.Country).
+			WithData(event.DataKey.PreviousLoginAt, previous.LastLocated.Format(time.RFC3339)).
+			WithData(event.DataKey.DistanceKm, fmt.Sprintf("%.0f", assessment.DistanceKm)).
+			WithData(event.DataKey.TravelSpeedKmh, fmt.Sprintf("%.0f", assessment.TravelSpeedKmh))
+	}
+	s.observabilitySvc.PublishEvent(ctx, evt)
+}
+
+// deviceFingerprint derives a stable, non-reversible identifier for the device of the sign-in. A
+// client-supplied device ID takes precedence over the User-Agent.
+func deviceFingerprint(signal LoginSignal) string {
+	source := strings.TrimSpace(signal.DeviceID)
+	if source == "" {
+		source = strings.TrimSpace(signal.UserAgent)
+	}
+	if source == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package anomaly
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/geoip"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+)
+
+const (
+	testUserAgent = "Mozilla/5.0 (X11; Linux x86_64)"
+	colomboIP     = "203.0.113.10"
+	londonIP      = "198.51.100.20"
+	kandyIP       = "203.0.114.30"
+)
+
+type AnomalyServiceTestSuite struct {
+	suite.Suite
+	obs     *observabilitymock.ObservabilityServiceInterfaceMock
+	events  []*providers.Event
+	now     time.Time
+	service *anomalyService
+}
+
+func TestAnomalyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AnomalyServiceTestSuite))
+}
+
+func (suite *AnomalyServiceTestSuite) SetupTest() {
+	locator, err := geoip.Initialize(config.GeoIPConfig{Networks: []config.GeoIPNetworkConfig{
+		{CIDR: "203.0.113.0/24", Country: "LK", Latitude: 6.93, Longitude: 79.85},
+		{CIDR: "203.0.114.0/24", Country: "LK", Latitude: 7.29, Longitude: 80.63},
+		{CIDR: "198.51.100.0/24", Country: "GB", Latitude: 51.51, Longitude: -0.13},
+	}})
+	suite.Require().NoError(err)
+
+	suite.events = nil
+	suite.obs = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.obs.On("IsEnabled").Return(true).Maybe()
+	suite.obs.On("PublishEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(1).(*providers.Event))
+	}).Return().Maybe()
+
+	suite.now = time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	cfg := config.AnomalyDetectionConfig{
+		Enabled: true, RetentionDays: 90, MaxKnownDevices: 2, ImpossibleTravelSpeedKmh: 1000,
+	}
+	svc := newAnomalyService(cfg, newAnomalyStore(inmemory.Initialize("test")), locator, suite.obs)
+	suite.service = svc.(*anomalyService)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *AnomalyServiceTestSuite) evaluate(ip, userAgent string) *Assessment {
+	assessment, err := suite.service.Evaluate(context.Background(), LoginSignal{
+		UserID: "user-1", ClientIP: netip.MustParseAddr(ip), UserAgent: userAgent,
+	})
+	suite.Require().NoError(err)
+	return assessment
+}
+
+func (suite *AnomalyServiceTestSuite) TestFirstSignInEstablishesBaseline() {
+	assessment := suite.evaluate(colomboIP, testUserAgent)
+
+	suite.False(assessment.IsAnomalous())
+	suite.NotEmpty(assessment.DeviceID)
+	suite.Equal("LK", assessment.Location.Country)
+	suite.Empty(suite.events)
+}
+
+func (suite *AnomalyServiceTestSuite) TestNewDevice() {
+	suite.evaluate(colomboIP, testUserAgent)
+	suite.now = suite.now.Add(time.Hour)
+
+	suite.False(suite.evaluate(colomboIP, testUserAgent).NewDevice)
+
+	assessment := suite.evaluate(colomboIP, "Mozilla/5.0 (iPhone)")
+	suite.True(assessment.NewDevice)
+	suite.False(assessment.ImpossibleTravel)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeNewDeviceLogin), suite.events[0].Type)
+	suite.Equal(assessment.DeviceID, suite.events[0].Data[event.DataKey.DeviceID])
+}
+
+func (suite *AnomalyServiceTestSuite) TestKnownDevicesAreCapped() {
+	suite.evaluate(colomboIP, "device-a")
+	suite.now = suite.now.Add(time.Hour)
+	suite.evaluate(colomboIP, "device-b")
+	suite.now = suite.now.Add(time.Hour)
+	suite.evaluate(colomboIP, "device-c")
+	suite.now = suite.now.Add(time.Hour)
+
+	// device-a was the least recently used and was dropped when device-c was recorded.
+	suite.True(suite.evaluate(colomboIP, "device-a").NewDevice)
+}
+
+func (suite *AnomalyServiceTestSuite) TestImpossibleTravel() {
+	suite.evaluate(colomboIP, testUserAgent)
+	suite.now = suite.now.Add(2 * time.Hour)
+
+	assessment := suite.evaluate(londonIP, testUserAgent)
+	suite.True(assessment.ImpossibleTravel)
+	suite.False(assessment.NewDevice)
+	suite.Greater(assessment.TravelSpeedKmh, 4000.0)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeImpossibleTravel), suite.events[0].Type)
+	suite.Equal("LK", suite.events[0].Data[event.DataKey.PreviousCountry])
+	suite.Equal("GB", suite.events[0].Data[event.DataKey.Country])
+}
+
+func (suite *AnomalyServiceTestSuite) TestPlausibleTravel() {
+	suite.evaluate(colomboIP, testUserAgent)
+
+	// Short moves are within geolocation error even when they happen in the same instant.
+	suite.False(suite.evaluate(kandyIP, testUserAgent).ImpossibleTravel)
+
+	suite.now = suite.now.Add(24 * time.Hour)
+	suite.False(suite.evaluate(londonIP, testUserAgent).ImpossibleTravel)
+	suite.Empty(suite.events)
+}
+
+func (suite *AnomalyServiceTestSuite) TestUnknownLocationKeepsLastLocation() {
+	suite.evaluate(colomboIP, testUserAgent)
+	suite.now = suite.now.Add(time.Hour)
+
+	assessment := suite.evaluate("192.0.2.1", testUserAgent)
+	suite.Nil(assessment.Location)
+	suite.False(assessment.ImpossibleTravel)
+
+	suite.now = suite.now.Add(time.Hour)
+	suite.True(suite.evaluate(londonIP, testUserAgent).ImpossibleTravel)
+}
+
+func (suite *AnomalyServiceTestSuite) TestDisabled() {
+	suite.service.cfg.Enabled = false
+	suite.False(suite.service.IsEnabled())
+
+	suite.evaluate(colomboIP, testUserAgent)
+	suite.False(suite.evaluate(londonIP, "another device").IsAnomalous())
+}
+
+func (suite *AnomalyServiceTestSuite) TestDeviceFingerprint() {
+	suite.Empty(deviceFingerprint(LoginSignal{}))
+	suite.Equal(deviceFingerprint(LoginSignal{UserAgent: "ua"}), deviceFingerprint(LoginSignal{UserAgent: " ua "}))
+	suite.NotEqual(deviceFingerprint(LoginSignal{UserAgent: "ua"}),
+		deviceFingerprint(LoginSignal{UserAgent: "ua", DeviceID: "device-1"}))
+}
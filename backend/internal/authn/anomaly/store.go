@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package anomaly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// anomalyStoreInterface defines the interface for the login profile store.
+type anomalyStoreInterface interface {
+	// GetLoginProfile returns the login profile of the user, or nil when none is stored.
+	GetLoginProfile(ctx context.Context, userID string) (*loginProfile, error)
+
+	// SaveLoginProfile stores the login profile of the user for ttlSeconds.
+	SaveLoginProfile(ctx context.Context, userID string, profile *loginProfile, ttlSeconds int64) error
+}
+
+// anomalyStore keeps login profiles in the runtime store.
+type anomalyStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newAnomalyStore creates a new instance of anomalyStore.
+func newAnomalyStore(store providers.RuntimeStoreProvider) anomalyStoreInterface {
+	return &anomalyStore{store: store}
+}
+
+// GetLoginProfile returns the login profile of the user, or nil when none is stored.
+func (s *anomalyStore) GetLoginProfile(ctx context.Context, userID string) (*loginProfile, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceLoginProfile, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login profile: %w", err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+	var profile loginProfile
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal login profile: %w", err)
+	}
+	return &profile, nil
+}
+
+// SaveLoginProfile stores the login profile of the user for ttlSeconds.
+func (s *anomalyStore) SaveLoginProfile(ctx context.Context, userID string, profile *loginProfile,
+	ttlSeconds int64) error {
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return fmt.Errorf("failed to marshal login profile: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceLoginProfile, userID, data, ttlSeconds)
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certificate
+
+import (
+	"encoding/asn1"
+	"time"
+)
+
+const (
+	// defaultMappingSource is the certificate field that identifies the user when not configured.
+	defaultMappingSource = MappingSourceSubjectCN
+	// defaultMappingAttribute is the user attribute the certificate identity is matched against when
+	// not configured.
+	defaultMappingAttribute = "username"
+	// defaultOCSPTimeout is how long an OCSP responder is waited for when not configured.
+	defaultOCSPTimeout = 5 * time.Second
+	// maxOCSPResponseSize bounds the OCSP response read from a responder.
+	maxOCSPResponseSize = 1 << 20
+)
+
+// Certificate fields a user can be identified by.
+const (
+	MappingSourceSubjectDN = "subject_dn"
+	MappingSourceSubjectCN = "subject_cn"
+	MappingSourceSANEmail  = "san_email"
+	MappingSourceSANUPN    = "san_upn"
+	MappingSourceSANDNS    = "san_dns"
+)
+
+var (
+	// oidSubjectAltName identifies the subject alternative name extension.
+	oidSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+	// oidUserPrincipalName identifies the Microsoft user principal name carried by smart card
+	// certificates as an otherName of the subject alternative name.
+	oidUserPrincipalName = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}
+)
+
+// revocationStatus is the outcome of a revocation check.
+type revocationStatus int
+
+const (
+	revocationStatusUnknown revocationStatus = iota
+	revocationStatusGood
+	revocationStatusRevoked
+)
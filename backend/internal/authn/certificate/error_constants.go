@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certificate
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for certificate authentication operations.
+var (
+	// ErrorCertificateNotPresented is returned when the client did not present a certificate.
+	ErrorCertificateNotPresented = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CRT-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.certificate_not_presented",
+			DefaultValue: "Certificate not presented",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.certificate_not_presented_description",
+			DefaultValue: "The client did not present a certificate",
+		},
+	}
+
+	// ErrorCertificateAuthDisabled is returned when certificate authentication is not enabled.
+	ErrorCertificateAuthDisabled = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CRT-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.certificate_auth_disabled",
+			DefaultValue: "Certificate authentication disabled",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.certificate_auth_disabled_description",
+			DefaultValue: "Certificate authentication is not enabled on the server",
+		},
+	}
+
+	// ErrorInvalidCertificate is returned when the certificate is expired, not issued by a trusted CA, or not
+	// valid for client authentication.
+	ErrorInvalidCertificate = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CRT-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.invalid_certificate",
+			DefaultValue: "Invalid certificate",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.invalid_certificate_description",
+			DefaultValue: "The certificate is not trusted or not valid for client authentication",
+		},
+	}
+
+	// ErrorCertificateRevoked is returned when the certificate was revoked by its issuer.
+	ErrorCertificateRevoked = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CRT-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.certificate_revoked",
+			DefaultValue: "Certificate revoked",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.certificate_revoked_description",
+			DefaultValue: "The certificate was revoked by its issuer",
+		},
+	}
+
+	// ErrorRevocationStatusUnknown is returned when the revocation status of the certificate cannot be
+	// determined and soft fail is not enabled.
+	ErrorRevocationStatusUnknown = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CRT-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.revocation_status_unknown",
+			DefaultValue: "Revocation status unknown",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.revocation_status_unknown_description",
+			DefaultValue: "The revocation status of the certificate could not be determined",
+		},
+	}
+
+	// ErrorIdentityNotFound is returned when the certificate does not carry the field that identifies the user.
+	ErrorIdentityNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CRT-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.identity_not_found",
+			DefaultValue: "Certificate identity not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.certificateauthservice.identity_not_found_description",
+			DefaultValue: "The certificate does not carry the field that identifies the user",
+		},
+	}
+)
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certificate
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// Initialize creates the certificate authentication service from the server configuration. The
+// service rejects every certificate when certificate authentication is disabled.
+func Initialize() (CertificateAuthServiceInterface, error) {
+	runtime := config.GetServerRuntime()
+	certConfig := runtime.Config.CertificateAuth
+	if !certConfig.Enabled {
+		return newCertificateAuthService(nil, "", "", nil, false), nil
+	}
+
+	roots, err := loadCAPool(runtime.ServerHome, certConfig.CAFiles)
+	if err != nil {
+		return nil, err
+	}
+	crls, err := loadCRLs(runtime.ServerHome, certConfig.Revocation.CRLFiles)
+	if err != nil {
+		return nil, err
+	}
+	revocation := newRevocationChecker(crls, certConfig.Revocation.OCSPEnabled,
+		time.Duration(certConfig.Revocation.OCSPTimeoutSeconds)*time.Second)
+	return newCertificateAuthService(roots, certConfig.UserMapping.Source, certConfig.UserMapping.Attribute,
+		revocation, certConfig.Revocation.SoftFail), nil
+}
+
+// loadCAPool reads the PEM encoded CA certificates that client certificates must chain to.
+func loadCAPool(serverHome string, paths []string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, path := range paths {
+		data, err := readFile(serverHome, path)
+		if err != nil {
+			return nil, err
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no CA certificates found in %q", path)
+		}
+	}
+	return pool, nil
+}
+
+// loadCRLs reads the CRLs, each either PEM or DER encoded.
+func loadCRLs(serverHome string, paths []string) ([]*x509.RevocationList, error) {
+	crls := make([]*x509.RevocationList, 0, len(paths))
+	for _, path := range paths {
+		data, err := readFile(serverHome, path)
+		if err != nil {
+			return nil, err
+		}
+		if block, _ := pem.Decode(data); block != nil {
+			data = block.Bytes
+		}
+		crl, err := x509.ParseRevocationList(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the CRL %q: %w", path, err)
+		}
+		crls = append(crls, crl)
+	}
+	return crls, nil
+}
+
+// readFile reads a certificate file, resolving a relative path against the server home.
+func readFile(serverHome, path string) ([]byte, error) {
+	if !filepath.IsAbs(path) && serverHome != "" {
+		path = filepath.Join(serverHome, path)
+	}
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the certificate file %q: %w", path, err)
+	}
+	return data, nil
+}
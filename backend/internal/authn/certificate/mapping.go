@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certificate
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"strings"
+)
+
+// extractIdentity returns the value of the certificate field that identifies the user, or an empty
+// string when the certificate does not carry the field.
+func extractIdentity(cert *x509.Certificate, source string) string {
+	switch source {
+	case MappingSourceSubjectDN:
+		return cert.Subject.String()
+	case MappingSourceSubjectCN:
+		return cert.Subject.CommonName
+	case MappingSourceSANEmail:
+		return firstNonEmpty(cert.EmailAddresses)
+	case MappingSourceSANDNS:
+		return firstNonEmpty(cert.DNSNames)
+	case MappingSourceSANUPN:
+		return extractUPN(cert)
+	default:
+		return ""
+	}
+}
+
+// firstNonEmpty returns the first non-blank value of the list.
+func firstNonEmpty(values []string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// extractUPN returns the user principal name carried as an otherName of the subject alternative
+// name. The Go x509 parser skips otherName entries, so the extension is decoded here.
+func extractUPN(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(oidSubjectAltName) {
+			continue
+		}
+		var names []asn1.RawValue
+		if _, err := asn1.Unmarshal(ext.Value, &names); err != nil {
+			return ""
+		}
+		for _, name := range names {
+			// otherName is the context-specific, constructed [0] choice of GeneralName.
+			if name.Class != asn1.ClassContextSpecific || name.Tag != 0 {
+				continue
+			}
+			var other struct {
+				TypeID asn1.ObjectIdentifier
+				Value  string `asn1:"explicit,tag:0,utf8"`
+			}
+			if _, err := asn1.UnmarshalWithParams(name.FullBytes, &other, "tag:0"); err != nil {
+				continue
+			}
+			if other.TypeID.Equal(oidUserPrincipalName) {
+				return strings.TrimSpace(other.Value)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+// revocationChecker determines whether a client certificate was revoked, from the configured CRLs
+// and, when enabled, the OCSP responder named in the certificate.
+type revocationChecker struct {
+	crls        []*x509.RevocationList
+	ocspEnabled bool
+	httpClient  syshttp.HTTPClientInterface
+	now         func() time.Time
+}
+
+// newRevocationChecker creates a revocation checker, or returns nil when no revocation source is
+// configured.
+func newRevocationChecker(crls []*x509.RevocationList, ocspEnabled bool,
+	ocspTimeout time.Duration) *revocationChecker {
+	if len(crls) == 0 && !ocspEnabled {
+		return nil
+	}
+	if ocspTimeout <= 0 {
+		ocspTimeout = defaultOCSPTimeout
+	}
+	checker := &revocationChecker{
+		crls:        crls,
+		ocspEnabled: ocspEnabled,
+		now:         time.Now,
+	}
+	if ocspEnabled {
+		checker.httpClient = syshttp.NewHTTPClientWithTimeout(ocspTimeout)
+	}
+	return checker
+}
+
+// check returns the revocation status of the certificate issued by issuer. A current CRL of the
+// issuer decides the status; otherwise the OCSP responder of the certificate is asked.
+func (c *revocationChecker) check(ctx context.Context, cert, issuer *x509.Certificate) (revocationStatus, error) {
+	if status := c.checkCRLs(cert, issuer); status != revocationStatusUnknown {
+		return status, nil
+	}
+	if !c.ocspEnabled || len(cert.OCSPServer) == 0 {
+		return revocationStatusUnknown, nil
+	}
+	return c.checkOCSP(ctx, cert, issuer, cert.OCSPServer[0])
+}
+
+// checkCRLs looks the certificate up in the current CRLs signed by its issuer. Stale CRLs are
+// ignored, so a certificate without a current CRL has an unknown status.
+func (c *revocationChecker) checkCRLs(cert, issuer *x509.Certificate) revocationStatus {
+	now := c.now()
+	for _, crl := range c.crls {
+		if !bytes.Equal(crl.RawIssuer, cert.RawIssuer) || crl.CheckSignatureFrom(issuer) != nil {
+			continue
+		}
+		if !crl.NextUpdate.IsZero() && now.After(crl.NextUpdate) {
+			continue
+		}
+		for _, entry := range crl.RevokedCertificateEntries {
+			if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				return revocationStatusRevoked
+			}
+		}
+		return revocationStatusGood
+	}
+	return revocationStatusUnknown
+}
+
+// checkOCSP asks the OCSP responder for the status of the certificate.
+func (c *revocationChecker) checkOCSP(ctx context.Context, cert, issuer *x509.Certificate,
+	responderURL string) (revocationStatus, error) {
+	reqBody, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return revocationStatusUnknown, fmt.Errorf("failed to create the OCSP request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responderURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return revocationStatusUnknown, fmt.Errorf("failed to create the OCSP HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+	req.Header.Set("Accept", "application/ocsp-response")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return revocationStatusUnknown, fmt.Errorf("failed to reach the OCSP responder: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return revocationStatusUnknown, fmt.Errorf("OCSP responder returned status %d", resp.StatusCode)
+	}
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxOCSPResponseSize))
+	if err != nil {
+		return revocationStatusUnknown, fmt.Errorf("failed to read the OCSP response: %w", err)
+	}
+
+	ocspResp, err := ocsp.ParseResponseForCert(respBody, cert, issuer)
+	if err != nil {
+		return revocationStatusUnknown, fmt.Errorf("failed to parse the OCSP response: %w", err)
+	}
+	if !ocspResp.NextUpdate.IsZero() && c.now().After(ocspResp.NextUpdate) {
+		return revocationStatusUnknown, nil
+	}
+	switch ocspResp.Status {
+	case ocsp.Good:
+		return revocationStatusGood, nil
+	case ocsp.Revoked:
+		return revocationStatusRevoked, nil
+	default:
+		return revocationStatusUnknown, nil
+	}
+}
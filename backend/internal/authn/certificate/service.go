@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package certificate implements X.509 client certificate authentication for smart cards and mTLS
+// clients. The certificate presented during the TLS handshake is validated against the configured
+// CAs and revocation sources, and a field of it identifies the user.
+package certificate
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// CertificateAuthServiceInterface defines the interface for certificate authentication.
+type CertificateAuthServiceInterface interface {
+	// Authenticate validates the client certificate chain and returns the authentication result
+	// that identifies its user.
+	Authenticate(ctx context.Context, cred *authncommon.CertificateCredential) (
+		*authncommon.AuthnResult, *tidcommon.ServiceError)
+}
+
+// certificateAuthService is the default implementation of CertificateAuthServiceInterface.
+type certificateAuthService struct {
+	roots            *x509.CertPool
+	mappingSource    string
+	mappingAttribute string
+	revocation       *revocationChecker
+	softFail         bool
+	now              func() time.Time
+	logger           *log.Logger
+}
+
+// newCertificateAuthService creates a new instance of certificateAuthService. A nil roots pool
+// disables certificate authentication, and a nil revocation checker skips the revocation check.
+func newCertificateAuthService(roots *x509.CertPool, mappingSource, mappingAttribute string,
+	revocation *revocationChecker, softFail bool) CertificateAuthServiceInterface {
+	if mappingSource == "" {
+		mappingSource = defaultMappingSource
+	}
+	if mappingAttribute == "" {
+		mappingAttribute = defaultMappingAttribute
+	}
+	return &certificateAuthService{
+		roots:            roots,
+		mappingSource:    mappingSource,
+		mappingAttribute: mappingAttribute,
+		revocation:       revocation,
+		softFail:         softFail,
+		now:              time.Now,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CertificateAuthService")),
+	}
+}
+
+// Authenticate validates the client certificate chain and returns the authentication result that
+// identifies its user. The first certificate is the client certificate and the rest are the
+// intermediates it was presented with.
+func (s *certificateAuthService) Authenticate(ctx context.Context, cred *authncommon.CertificateCredential) (
+	*authncommon.AuthnResult, *tidcommon.ServiceError) {
+	if s.roots == nil {
+		return nil, &ErrorCertificateAuthDisabled
+	}
+	if cred == nil || len(cred.Certificates) == 0 || cred.Certificates[0] == nil {
+		return nil, &ErrorCertificateNotPresented
+	}
+	cert := cred.Certificates[0]
+
+	intermediates := x509.NewCertPool()
+	for _, intermediate := range cred.Certificates[1:] {
+		if intermediate != nil {
+			intermediates.AddCert(intermediate)
+		}
+	}
+	chains, err := cert.Verify(x509.VerifyOptions{
+		Roots:         s.roots,
+		Intermediates: intermediates,
+		CurrentTime:   s.now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		s.logger.Debug(ctx, "Client certificate validation failed", log.String("subject", cert.Subject.String()),
+			log.Error(err))
+		return nil, &ErrorInvalidCertificate
+	}
+
+	if s.revocation != nil {
+		// The verified chain starts with the certificate; a self-signed trusted certificate is its own issuer.
+		issuer := cert
+		if len(chains[0]) > 1 {
+			issuer = chains[0][1]
+		}
+		status, err := s.revocation.check(ctx, cert, issuer)
+		if err != nil {
+			s.logger.Warn(ctx, "Failed to check client certificate revocation", log.Error(err))
+		}
+		switch {
+		case status == revocationStatusRevoked:
+			s.logger.Debug(ctx, "Client certificate is revoked", log.String("subject", cert.Subject.String()))
+			return nil, &ErrorCertificateRevoked
+		case status == revocationStatusUnknown && !s.softFail:
+			return nil, &ErrorRevocationStatusUnknown
+		}
+	}
+
+	identity := extractIdentity(cert, s.mappingSource)
+	if identity == "" {
+		return nil, &ErrorIdentityNotFound
+	}
+
+	return &authncommon.AuthnResult{
+		Token:               map[string]interface{}{s.mappingAttribute: identity},
+		AuthenticatedClaims: map[string]interface{}{s.mappingAttribute: identity},
+	}, nil
+}
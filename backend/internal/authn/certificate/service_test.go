@@ -0,0 +1,457 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certificate
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/ocsp"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// testIssuer is a CA that issues test certificates.
+type testIssuer struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+type CertificateAuthServiceTestSuite struct {
+	suite.Suite
+	now    time.Time
+	serial int64
+	ca     *testIssuer
+}
+
+func TestCertificateAuthServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CertificateAuthServiceTestSuite))
+}
+
+func (suite *CertificateAuthServiceTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+}
+
+func (suite *CertificateAuthServiceTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *CertificateAuthServiceTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	suite.ca = suite.newCA("Test Root CA", nil)
+}
+
+func (suite *CertificateAuthServiceTestSuite) newKey() *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	return key
+}
+
+func (suite *CertificateAuthServiceTestSuite) nextSerial() *big.Int {
+	suite.serial++
+	return big.NewInt(suite.serial)
+}
+
+// newCA creates a CA certificate, self-signed when parent is nil.
+func (suite *CertificateAuthServiceTestSuite) newCA(name string, parent *testIssuer) *testIssuer {
+	key := suite.newKey()
+	template := &x509.Certificate{
+		SerialNumber:          suite.nextSerial(),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             suite.now.Add(-time.Hour),
+		NotAfter:              suite.now.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	signerCert, signerKey := template, crypto.Signer(key)
+	if parent != nil {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signerCert, key.Public(), signerKey)
+	suite.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+	return &testIssuer{cert: cert, key: key}
+}
+
+// newClientCert issues a client certificate from the issuer, applying modify to the template.
+func (suite *CertificateAuthServiceTestSuite) newClientCert(issuer *testIssuer,
+	modify func(*x509.Certificate)) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: suite.nextSerial(),
+		Subject:      pkix.Name{CommonName: "alice", Organization: []string{"Example"}},
+		NotBefore:    suite.now.Add(-time.Hour),
+		NotAfter:     suite.now.Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if modify != nil {
+		modify(template)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer.cert, suite.newKey().Public(), issuer.key)
+	suite.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	suite.Require().NoError(err)
+	return cert
+}
+
+// newCRL creates a CRL of the issuer that revokes the given certificates.
+func (suite *CertificateAuthServiceTestSuite) newCRL(issuer *testIssuer, nextUpdate time.Time,
+	revoked ...*x509.Certificate) *x509.RevocationList {
+	entries := make([]x509.RevocationListEntry, 0, len(revoked))
+	for _, cert := range revoked {
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: cert.SerialNumber,
+			RevocationTime: suite.now.Add(-time.Minute)})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                suite.now.Add(-time.Hour),
+		NextUpdate:                nextUpdate,
+		RevokedCertificateEntries: entries,
+	}, issuer.cert, issuer.key)
+	suite.Require().NoError(err)
+	crl, err := x509.ParseRevocationList(der)
+	suite.Require().NoError(err)
+	return crl
+}
+
+// newOCSPResponder starts an OCSP responder of the issuer that reports the given status.
+func (suite *CertificateAuthServiceTestSuite) newOCSPResponder(issuer *testIssuer, status int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		suite.Require().NoError(err)
+		req, err := ocsp.ParseRequest(body)
+		suite.Require().NoError(err)
+		resp, err := ocsp.CreateResponse(issuer.cert, issuer.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: req.SerialNumber,
+			ThisUpdate:   suite.now.Add(-time.Minute),
+			NextUpdate:   suite.now.Add(time.Hour),
+			RevokedAt:    suite.now.Add(-time.Minute),
+		}, issuer.key)
+		suite.Require().NoError(err)
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		_, _ = w.Write(resp)
+	}))
+	suite.T().Cleanup(server.Close)
+	return server
+}
+
+// newService creates a service that trusts the test root CA.
+func (suite *CertificateAuthServiceTestSuite) newService(mappingSource, mappingAttribute string,
+	revocation *revocationChecker, softFail bool) *certificateAuthService {
+	roots := x509.NewCertPool()
+	roots.AddCert(suite.ca.cert)
+	if revocation != nil {
+		revocation.now = func() time.Time { return suite.now }
+	}
+	svc := newCertificateAuthService(roots, mappingSource, mappingAttribute, revocation, softFail)
+	service := svc.(*certificateAuthService)
+	service.now = func() time.Time { return suite.now }
+	return service
+}
+
+func (suite *CertificateAuthServiceTestSuite) authenticate(service *certificateAuthService,
+	certs ...*x509.Certificate) (*authncommon.AuthnResult, string) {
+	result, svcErr := service.Authenticate(context.Background(),
+		&authncommon.CertificateCredential{Certificates: certs})
+	if svcErr != nil {
+		return nil, svcErr.Code
+	}
+	return result, ""
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_MapsSubjectCNToUsername() {
+	service := suite.newService("", "", nil, false)
+
+	result, code := suite.authenticate(service, suite.newClientCert(suite.ca, nil))
+
+	suite.Empty(code)
+	suite.Equal(map[string]interface{}{"username": "alice"}, result.Token)
+	suite.Equal(map[string]interface{}{"username": "alice"}, result.AuthenticatedClaims)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_Disabled() {
+	service := newCertificateAuthService(nil, "", "", nil, false)
+
+	_, svcErr := service.Authenticate(context.Background(),
+		&authncommon.CertificateCredential{Certificates: []*x509.Certificate{suite.newClientCert(suite.ca, nil)}})
+
+	suite.Equal(ErrorCertificateAuthDisabled.Code, svcErr.Code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_NoCertificate() {
+	service := suite.newService("", "", nil, false)
+
+	_, svcErr := service.Authenticate(context.Background(), nil)
+	suite.Equal(ErrorCertificateNotPresented.Code, svcErr.Code)
+
+	_, code := suite.authenticate(service)
+	suite.Equal(ErrorCertificateNotPresented.Code, code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_IntermediateChain() {
+	intermediate := suite.newCA("Test Issuing CA", suite.ca)
+	service := suite.newService("", "", nil, false)
+	cert := suite.newClientCert(intermediate, nil)
+
+	result, code := suite.authenticate(service, cert, intermediate.cert)
+	suite.Empty(code)
+	suite.Equal("alice", result.Token["username"])
+
+	// Without the intermediate the certificate does not chain to a trusted CA.
+	_, code = suite.authenticate(service, cert)
+	suite.Equal(ErrorInvalidCertificate.Code, code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_RejectsInvalidCertificates() {
+	untrusted := suite.newCA("Untrusted CA", nil)
+	service := suite.newService("", "", nil, false)
+
+	testCases := []struct {
+		name string
+		cert *x509.Certificate
+	}{
+		{"UntrustedCA", suite.newClientCert(untrusted, nil)},
+		{"Expired", suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+			c.NotAfter = suite.now.Add(-time.Minute)
+		})},
+		{"NotYetValid", suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+			c.NotBefore = suite.now.Add(time.Minute)
+		})},
+		{"ServerAuthOnly", suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+			c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		})},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, code := suite.authenticate(service, tc.cert)
+			suite.Equal(ErrorInvalidCertificate.Code, code)
+		})
+	}
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_CRL() {
+	revoked := suite.newClientCert(suite.ca, nil)
+	good := suite.newClientCert(suite.ca, nil)
+	crl := suite.newCRL(suite.ca, suite.now.Add(time.Hour), revoked)
+	service := suite.newService("", "", newRevocationChecker([]*x509.RevocationList{crl}, false, 0), false)
+
+	_, code := suite.authenticate(service, revoked)
+	suite.Equal(ErrorCertificateRevoked.Code, code)
+
+	_, code = suite.authenticate(service, good)
+	suite.Empty(code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_StaleCRL() {
+	crl := suite.newCRL(suite.ca, suite.now.Add(-time.Minute))
+	cert := suite.newClientCert(suite.ca, nil)
+
+	service := suite.newService("", "", newRevocationChecker([]*x509.RevocationList{crl}, false, 0), false)
+	_, code := suite.authenticate(service, cert)
+	suite.Equal(ErrorRevocationStatusUnknown.Code, code)
+
+	softFailService := suite.newService("", "", newRevocationChecker([]*x509.RevocationList{crl}, false, 0), true)
+	_, code = suite.authenticate(softFailService, cert)
+	suite.Empty(code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_CRLOfAnotherIssuerIsIgnored() {
+	other := suite.newCA("Other CA", nil)
+	crl := suite.newCRL(other, suite.now.Add(time.Hour))
+	service := suite.newService("", "", newRevocationChecker([]*x509.RevocationList{crl}, false, 0), false)
+
+	_, code := suite.authenticate(service, suite.newClientCert(suite.ca, nil))
+
+	suite.Equal(ErrorRevocationStatusUnknown.Code, code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_OCSP() {
+	testCases := []struct {
+		name     string
+		status   int
+		wantCode string
+	}{
+		{"Good", ocsp.Good, ""},
+		{"Revoked", ocsp.Revoked, ErrorCertificateRevoked.Code},
+		{"Unknown", ocsp.Unknown, ErrorRevocationStatusUnknown.Code},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			responder := suite.newOCSPResponder(suite.ca, tc.status)
+			cert := suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+				c.OCSPServer = []string{responder.URL}
+			})
+			service := suite.newService("", "", newRevocationChecker(nil, true, time.Second), false)
+
+			_, code := suite.authenticate(service, cert)
+			suite.Equal(tc.wantCode, code)
+		})
+	}
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_OCSPResponderUnavailable() {
+	responder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer responder.Close()
+	cert := suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+		c.OCSPServer = []string{responder.URL}
+	})
+
+	service := suite.newService("", "", newRevocationChecker(nil, true, time.Second), false)
+	_, code := suite.authenticate(service, cert)
+	suite.Equal(ErrorRevocationStatusUnknown.Code, code)
+
+	softFailService := suite.newService("", "", newRevocationChecker(nil, true, time.Second), true)
+	_, code = suite.authenticate(softFailService, cert)
+	suite.Empty(code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_CRLTakesPrecedenceOverOCSP() {
+	responder := suite.newOCSPResponder(suite.ca, ocsp.Good)
+	cert := suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+		c.OCSPServer = []string{responder.URL}
+	})
+	crl := suite.newCRL(suite.ca, suite.now.Add(time.Hour), cert)
+	service := suite.newService("", "", newRevocationChecker([]*x509.RevocationList{crl}, true, time.Second), false)
+
+	_, code := suite.authenticate(service, cert)
+
+	suite.Equal(ErrorCertificateRevoked.Code, code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_UserMapping() {
+	upnSAN := suite.marshalUPNSAN("alice@corp.example.com")
+	cert := suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+		c.EmailAddresses = []string{"alice@example.com"}
+		c.DNSNames = []string{"alice.devices.example.com"}
+	})
+	upnCert := suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+		c.ExtraExtensions = []pkix.Extension{{Id: oidSubjectAltName, Value: upnSAN}}
+	})
+
+	testCases := []struct {
+		source   string
+		cert     *x509.Certificate
+		expected string
+	}{
+		{MappingSourceSubjectDN, cert, "CN=alice,O=Example"},
+		{MappingSourceSubjectCN, cert, "alice"},
+		{MappingSourceSANEmail, cert, "alice@example.com"},
+		{MappingSourceSANDNS, cert, "alice.devices.example.com"},
+		{MappingSourceSANUPN, upnCert, "alice@corp.example.com"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.source, func() {
+			service := suite.newService(tc.source, "identity", nil, false)
+
+			result, code := suite.authenticate(service, tc.cert)
+
+			suite.Empty(code)
+			suite.Equal(map[string]interface{}{"identity": tc.expected}, result.Token)
+		})
+	}
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestAuthenticate_IdentityNotFound() {
+	service := suite.newService(MappingSourceSANUPN, "", nil, false)
+
+	_, code := suite.authenticate(service, suite.newClientCert(suite.ca, func(c *x509.Certificate) {
+		c.EmailAddresses = []string{"alice@example.com"}
+	}))
+
+	suite.Equal(ErrorIdentityNotFound.Code, code)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestInitialize() {
+	dir := suite.T().TempDir()
+	caPath := filepath.Join(dir, "ca.pem")
+	suite.Require().NoError(os.WriteFile(caPath,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: suite.ca.cert.Raw}), 0o600))
+	crlPath := filepath.Join(dir, "ca.crl")
+	suite.Require().NoError(os.WriteFile(crlPath, suite.newCRL(suite.ca, time.Now().Add(time.Hour)).Raw, 0o600))
+
+	config.ResetServerRuntime()
+	defer func() {
+		config.ResetServerRuntime()
+		suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+	}()
+	suite.Require().NoError(config.InitializeServerRuntime(dir, &config.Config{
+		CertificateAuth: config.CertificateAuthConfig{
+			Enabled:     true,
+			CAFiles:     []string{"ca.pem"},
+			UserMapping: config.CertificateUserMappingConfig{Source: MappingSourceSANEmail},
+			Revocation:  config.CertificateRevocationConfig{CRLFiles: []string{crlPath}},
+		},
+	}))
+
+	svc, err := Initialize()
+	suite.Require().NoError(err)
+	service := svc.(*certificateAuthService)
+	suite.NotNil(service.roots)
+	suite.Equal(MappingSourceSANEmail, service.mappingSource)
+	suite.Equal(defaultMappingAttribute, service.mappingAttribute)
+	suite.Require().NotNil(service.revocation)
+	suite.Len(service.revocation.crls, 1)
+	suite.False(service.revocation.ocspEnabled)
+}
+
+func (suite *CertificateAuthServiceTestSuite) TestInitialize_InvalidCAFile() {
+	dir := suite.T().TempDir()
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, "ca.pem"), []byte("not a certificate"), 0o600))
+
+	_, err := loadCAPool(dir, []string{"ca.pem"})
+	suite.Error(err)
+
+	_, err = loadCAPool(dir, []string{"missing.pem"})
+	suite.Error(err)
+}
+
+// marshalUPNSAN encodes a subject alternative name extension carrying a user principal name.
+func (suite *CertificateAuthServiceTestSuite) marshalUPNSAN(upn string) []byte {
+	otherName, err := asn1.MarshalWithParams(struct {
+		TypeID asn1.ObjectIdentifier
+		Value  string `asn1:"explicit,tag:0,utf8"`
+	}{TypeID: oidUserPrincipalName, Value: upn}, "tag:0")
+	suite.Require().NoError(err)
+	san, err := asn1.Marshal([]asn1.RawValue{{FullBytes: otherName}})
+	suite.Require().NoError(err)
+	return san
+}
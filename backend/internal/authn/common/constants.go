@@ -37,6 +37,9 @@ const (
 	AuthenticatorOIDC        = "OIDCAuthenticator"
 	AuthenticatorPasskey     = "Passkey"
 	AuthenticatorOpenID4VP   = "OpenID4VPAuthenticator"
+	AuthenticatorPush        = "PushAuthenticator"
+	AuthenticatorCrossDevice = "CrossDeviceAuthenticator"
+	AuthenticatorCertificate = "CertificateAuthenticator"
 )
 
 // AuthenticationFactor represents the type of authentication factor.
@@ -21,6 +21,7 @@ package common
 
 import (
 	"context"
+	"crypto/x509"
 	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
@@ -88,6 +89,22 @@ type OpenID4VPCredential struct {
 	Claims  map[string]interface{}
 }
 
+// PushCredential identifies the approved push challenge to the authn provider.
+type PushCredential struct {
+	ChallengeID string
+}
+
+// CrossDeviceCredential identifies the approved cross-device sign-in request to the authn provider.
+type CrossDeviceCredential struct {
+	Reference string
+}
+
+// CertificateCredential carries the client certificate chain presented during the TLS handshake to
+// the authn provider, client certificate first.
+type CertificateCredential struct {
+	Certificates []*x509.Certificate
+}
+
 // FederatedAuthResult is the result of a federated authentication attempt.
 // InternalEntity is nil when no local user was found or when the user is ambiguous.
 type FederatedAuthResult struct {
@@ -88,9 +88,9 @@ func (s *consentEnforcerService) ResolveConsent(ctx context.Context, ouID, appID
 		return nil, nil
 	}
 
-	// Build the set of elements that already have active consent. When forceReprompt is set, existing
-	// consent is ignored so every required claim is prompted again; the lookup is skipped entirely.
-	var consentedElements map[string]bool
+	// Build the sets of elements that already have an active consent decision. When forceReprompt is
+	// set, existing consent is ignored so every required claim is prompted again; the lookup is skipped.
+	var consentedElements, declinedElements map[string]bool
 	if !forceReprompt {
 		// Search for existing consent records for this user and application
 		filter := &consent.ConsentSearchFilter{
@@ -109,19 +109,29 @@ func (s *consentEnforcerService) ResolveConsent(ctx context.Context, ouID, appID
 			return nil, &tidcommon.InternalServerError
 		}
 		consentedElements = buildConsentedElementSet(existingConsents)
+		declinedElements = buildDeclinedElementSet(existingConsents, consentedElements)
 	}
 
 	// Build a set of attributes present in the user's profile for profile filtering
 	userAttributeSet := buildUserAttributeSet(availableAttributes)
 
 	promptPurposes := buildPurposePrompts(purposes, essentialAttributes, optionalAttributes,
-		consentedElements, userAttributeSet, authorizedPermissions)
+		consentedElements, declinedElements, userAttributeSet, authorizedPermissions)
+	declinedPermissions := buildDeclinedPermissions(purposes, declinedElements, authorizedPermissions)
 	if len(promptPurposes) == 0 {
+		if len(declinedPermissions) > 0 {
+			logger.Debug(ctx, "Permission consents are partially granted; no prompt needed",
+				log.Int("declinedCount", len(declinedPermissions)))
+			return &providers.ConsentPromptData{DeclinedPermissions: declinedPermissions}, nil
+		}
 		logger.Debug(ctx, "All required consents are active; no prompt needed")
 		return nil, nil
 	}
 
-	promptData := &providers.ConsentPromptData{Purposes: promptPurposes}
+	promptData := &providers.ConsentPromptData{
+		Purposes:            promptPurposes,
+		DeclinedPermissions: declinedPermissions,
+	}
 
 	// Generate a signed session token capturing the prompted purposes and their elements.
 	// This token should be verified in RecordConsent to ensure the user's decisions match what was prompted
@@ -446,6 +456,54 @@ func buildConsentedElementSet(consents []providers.Consent) map[string]bool {
 	return consentedSet
 }
 
+// buildDeclinedElementSet returns a set of "purposeName:elementName" keys that the user explicitly
+// declined in an active consent. Elements approved in any of the consents are not considered declined.
+func buildDeclinedElementSet(consents []providers.Consent, consentedElements map[string]bool) map[string]bool {
+	declinedSet := make(map[string]bool)
+	for _, c := range consents {
+		for _, p := range c.Purposes {
+			for _, e := range p.Elements {
+				key := purposeElementKey(p.Name, e.Name)
+				if !e.IsUserApproved && !consentedElements[key] {
+					declinedSet[key] = true
+				}
+			}
+		}
+	}
+
+	return declinedSet
+}
+
+// buildDeclinedPermissions returns the authorized permissions that the user previously declined in the
+// permission purposes, preserving the order of the authorized permissions.
+func buildDeclinedPermissions(purposes []consent.ConsentPurpose, declinedElements map[string]bool,
+	authorizedPermissions []string) []string {
+	if len(declinedElements) == 0 {
+		return nil
+	}
+
+	declined := make(map[string]bool)
+	for _, purpose := range purposes {
+		if purpose.Namespace != providers.NamespacePermission {
+			continue
+		}
+		for _, elem := range purpose.Elements {
+			if declinedElements[purposeElementKey(purpose.Name, elem.Name)] {
+				declined[elem.Name] = true
+			}
+		}
+	}
+
+	var result []string
+	for _, permission := range authorizedPermissions {
+		if declined[permission] {
+			result = append(result, permission)
+			delete(declined, permission)
+		}
+	}
+	return result
+}
+
 // buildUserAttributeSet builds a set of attribute names present in the user's profile.
 // When availableAttributes is nil, the returned set is empty — meaning no profile filtering is applied.
 func buildUserAttributeSet(available *providers.AttributesResponse) map[string]bool {
@@ -469,7 +527,7 @@ func purposeElementKey(purposeName, elementName string) string {
 // buildPurposePrompts dispatches each purpose to the per-namespace builder and returns the
 // prompts that still require user consent. Purposes whose Namespace was not inferred are skipped.
 func buildPurposePrompts(purposes []consent.ConsentPurpose, essentialAttributes, optionalAttributes []string,
-	consentedElements map[string]bool, userAttributeSet map[string]bool,
+	consentedElements, declinedElements map[string]bool, userAttributeSet map[string]bool,
 	authorizedPermissions []string) []providers.ConsentPurposePrompt {
 	promptPurposes := make([]providers.ConsentPurposePrompt, 0, len(purposes))
 	for _, purpose := range purposes {
@@ -480,7 +538,7 @@ func buildPurposePrompts(purposes []consent.ConsentPurpose, essentialAttributes,
 				promptPurposes = append(promptPurposes, prompt)
 			}
 		case providers.NamespacePermission:
-			if prompt, ok := buildPermissionPurposePrompt(purpose, consentedElements,
+			if prompt, ok := buildPermissionPurposePrompt(purpose, consentedElements, declinedElements,
 				authorizedPermissions); ok {
 				promptPurposes = append(promptPurposes, prompt)
 			}
@@ -537,17 +595,18 @@ func buildAttributePurposePrompt(purpose consent.ConsentPurpose,
 }
 
 // buildPermissionPurposePrompt builds a ConsentPurposePrompt for a permission purpose. Only
-// elements that appear in the authorized permissions and are not already consented are included.
-// Rollup parent linkage is computed server-side from the prompted-element set.
-func buildPermissionPurposePrompt(purpose consent.ConsentPurpose,
-	consentedElements map[string]bool, authorizedPermissions []string) (providers.ConsentPurposePrompt, bool) {
+// elements that appear in the authorized permissions and have no active consent decision are
+// included. Rollup parent linkage is computed server-side from the prompted-element set.
+func buildPermissionPurposePrompt(purpose consent.ConsentPurpose, consentedElements, declinedElements map[string]bool,
+	authorizedPermissions []string) (providers.ConsentPurposePrompt, bool) {
 	prompted := make([]string, 0, len(purpose.Elements))
 	for _, elem := range purpose.Elements {
-		// Skip elements outside the user's authorized permissions or already consented
+		// Skip elements outside the user's authorized permissions or already approved or declined
 		if !slices.Contains(authorizedPermissions, elem.Name) {
 			continue
 		}
-		if consentedElements[purposeElementKey(purpose.Name, elem.Name)] {
+		key := purposeElementKey(purpose.Name, elem.Name)
+		if consentedElements[key] || declinedElements[key] {
 			continue
 		}
 		prompted = append(prompted, elem.Name)
@@ -414,6 +414,163 @@ func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_PartialConsentsExis
 	s.Equal([]providers.PromptElement{{Name: "phone"}}, result.Purposes[0].Optional)
 }
 
+func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_PreviouslyDeclinedPermissionsNotPrompted() {
+	purposeName := consent.PermissionsPurposeName("app1")
+	purposes := []consent.ConsentPurpose{
+		{
+			ID:        "perm-p",
+			Namespace: providers.NamespacePermission,
+			Name:      purposeName,
+			Elements: []consent.PurposeElement{
+				{Name: "read:docs", Namespace: providers.NamespacePermission},
+				{Name: "write:docs", Namespace: providers.NamespacePermission},
+			},
+		},
+	}
+	existingConsents := []providers.Consent{
+		{
+			ID: "consent-1",
+			Purposes: []providers.ConsentPurposeItem{
+				{
+					Name: purposeName,
+					Elements: []providers.ConsentElementApproval{
+						{Name: "read:docs", IsUserApproved: true},
+						{Name: "write:docs", IsUserApproved: false},
+					},
+				},
+			},
+		},
+	}
+
+	s.mockConsentSvc.On("IsEnabled").Return(true)
+	s.mockConsentSvc.On("ListConsentPurposes", mock.Anything, "ou1", "app1").
+		Return(purposes, nil)
+	s.mockConsentSvc.On("SearchConsents", mock.Anything, "ou1",
+		mock.AnythingOfType("*consent.ConsentSearchFilter")).Return(existingConsents, nil)
+
+	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
+		nil, nil, []string{"read:docs", "write:docs"}, nil, false, nil)
+
+	s.Nil(svcErr)
+	s.NotNil(result)
+	s.Empty(result.Purposes)
+	s.Empty(result.SessionToken)
+	s.Equal([]string{"write:docs"}, result.DeclinedPermissions)
+	s.mockJWTSvc.AssertNotCalled(s.T(), "GenerateJWT", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_PromptsNewPermissionsOnly() {
+	purposeName := consent.PermissionsPurposeName("app1")
+	purposes := []consent.ConsentPurpose{
+		{
+			ID:        "perm-p",
+			Namespace: providers.NamespacePermission,
+			Name:      purposeName,
+			Elements: []consent.PurposeElement{
+				{Name: "read:docs", Namespace: providers.NamespacePermission},
+				{Name: "write:docs", Namespace: providers.NamespacePermission},
+				{Name: "share:docs", Namespace: providers.NamespacePermission},
+			},
+		},
+	}
+	existingConsents := []providers.Consent{
+		{
+			ID: "consent-1",
+			Purposes: []providers.ConsentPurposeItem{
+				{
+					Name: purposeName,
+					Elements: []providers.ConsentElementApproval{
+						{Name: "read:docs", IsUserApproved: true},
+						{Name: "write:docs", IsUserApproved: false},
+					},
+				},
+			},
+		},
+	}
+
+	s.mockConsentSvc.On("IsEnabled").Return(true)
+	s.mockConsentSvc.On("ListConsentPurposes", mock.Anything, "ou1", "app1").
+		Return(purposes, nil)
+	s.mockConsentSvc.On("SearchConsents", mock.Anything, "ou1",
+		mock.AnythingOfType("*consent.ConsentSearchFilter")).Return(existingConsents, nil)
+	s.mockJWTSvc.On("GenerateJWT", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("test-session-token", int64(0), nil)
+
+	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
+		nil, nil, []string{"read:docs", "write:docs", "share:docs"}, nil, false, nil)
+
+	s.Nil(svcErr)
+	s.NotNil(result)
+	s.Len(result.Purposes, 1)
+	s.Equal([]providers.PromptElement{{Name: "share:docs"}}, result.Purposes[0].Optional)
+	s.Equal([]string{"write:docs"}, result.DeclinedPermissions)
+	s.NotEmpty(result.SessionToken)
+}
+
+func (s *ConsentEnforcerServiceTestSuite) TestResolveConsent_ForceRepromptIncludesDeclinedPermissions() {
+	purposeName := consent.PermissionsPurposeName("app1")
+	purposes := []consent.ConsentPurpose{
+		{
+			ID:        "perm-p",
+			Namespace: providers.NamespacePermission,
+			Name:      purposeName,
+			Elements: []consent.PurposeElement{
+				{Name: "read:docs", Namespace: providers.NamespacePermission},
+				{Name: "write:docs", Namespace: providers.NamespacePermission},
+			},
+		},
+	}
+
+	s.mockConsentSvc.On("IsEnabled").Return(true)
+	s.mockConsentSvc.On("ListConsentPurposes", mock.Anything, "ou1", "app1").
+		Return(purposes, nil)
+	s.mockJWTSvc.On("GenerateJWT", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("test-session-token", int64(0), nil)
+
+	result, svcErr := s.service.ResolveConsent(context.Background(), "ou1", "app1", "App 1", "user1",
+		nil, nil, []string{"read:docs", "write:docs"}, nil, true, nil)
+
+	s.Nil(svcErr)
+	s.NotNil(result)
+	s.Len(result.Purposes, 1)
+	s.Len(result.Purposes[0].Optional, 2)
+	s.Empty(result.DeclinedPermissions)
+}
+
+func (s *ConsentEnforcerServiceTestSuite) TestBuildDeclinedElementSet() {
+	consents := []providers.Consent{
+		{
+			Purposes: []providers.ConsentPurposeItem{
+				{
+					Name: "p:a",
+					Elements: []providers.ConsentElementApproval{
+						{Name: "x", IsUserApproved: true},
+						{Name: "y", IsUserApproved: false},
+						{Name: "z", IsUserApproved: false},
+					},
+				},
+			},
+		},
+		{
+			Purposes: []providers.ConsentPurposeItem{
+				{
+					Name: "p:a",
+					Elements: []providers.ConsentElementApproval{
+						{Name: "z", IsUserApproved: true},
+					},
+				},
+			},
+		},
+	}
+
+	consented := buildConsentedElementSet(consents)
+	declined := buildDeclinedElementSet(consents, consented)
+
+	// z is approved in another consent, so only y is considered declined
+	s.Equal(map[string]bool{purposeElementKey("p:a", "y"): true}, declined)
+}
+
 // RecordConsent tests
 
 // buildTestSessionToken creates a fake JWT with the given consent session payload embedded.
@@ -1195,7 +1352,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_AllNeedConsent
 		},
 	}
 
-	result := buildPurposePrompts(purposes, nil, []string{"email", "phone"}, map[string]bool{}, nil, nil)
+	result := buildPurposePrompts(purposes, nil, []string{"email", "phone"}, map[string]bool{}, nil, nil, nil)
 
 	s.Len(result, 1)
 	s.Equal("purpose1", result[0].PurposeName)
@@ -1218,7 +1375,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_AllAlreadyCons
 	consentedElements := map[string]bool{"purpose1:email": true}
 
 	// "email" is requested but already consented; the prompt builder must drop it.
-	result := buildPurposePrompts(purposes, []string{"email"}, nil, consentedElements, nil, nil)
+	result := buildPurposePrompts(purposes, []string{"email"}, nil, consentedElements, nil, nil, nil)
 
 	s.Empty(result)
 }
@@ -1235,7 +1392,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_RequiredAttrib
 		},
 	}
 
-	result := buildPurposePrompts(purposes, []string{"email"}, nil, map[string]bool{}, nil, nil)
+	result := buildPurposePrompts(purposes, []string{"email"}, nil, map[string]bool{}, nil, nil, nil)
 
 	s.Len(result, 1)
 	s.Equal([]providers.PromptElement{{Name: "email"}}, result[0].Essential)
@@ -1258,7 +1415,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_UserProfileFil
 	// Both elements are requested; the user-profile filter must drop "phone" since it is
 	// not in availableAttributes.
 	result := buildPurposePrompts(purposes, nil, []string{"email", "phone"}, map[string]bool{},
-		userAttributeSet, nil)
+		nil, userAttributeSet, nil)
 
 	s.Len(result, 1)
 	s.Empty(result[0].Essential)
@@ -1277,7 +1434,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPurposePrompts_NoMatchingElem
 	}
 
 	// email is filtered out by required attributes
-	result := buildPurposePrompts(purposes, []string{"phone"}, nil, map[string]bool{}, nil, nil)
+	result := buildPurposePrompts(purposes, []string{"phone"}, nil, map[string]bool{}, nil, nil, nil)
 
 	s.Empty(result)
 }
@@ -1794,7 +1951,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPermissionPurposePrompt_Filte
 	authorized := []string{"p1", "p2", "p4"}
 	consented := map[string]bool{purposeElementKey(purpose.Name, "p2"): true}
 
-	prompt, ok := buildPermissionPurposePrompt(purpose, consented, authorized)
+	prompt, ok := buildPermissionPurposePrompt(purpose, consented, nil, authorized)
 	s.True(ok)
 	s.Equal("permissions", prompt.Type)
 	s.Empty(prompt.Essential)
@@ -1816,7 +1973,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPermissionPurposePrompt_Attac
 			{Name: "users.read"},
 		},
 	}
-	prompt, ok := buildPermissionPurposePrompt(purpose, map[string]bool{}, []string{"users", "users.read"})
+	prompt, ok := buildPermissionPurposePrompt(purpose, map[string]bool{}, nil, []string{"users", "users.read"})
 	s.True(ok)
 	parentByName := make(map[string]string, len(prompt.Optional))
 	for _, e := range prompt.Optional {
@@ -1837,7 +1994,7 @@ func (s *ConsentEnforcerServiceTestSuite) TestBuildPermissionPurposePrompt_Empty
 	}
 	// p1 is consented, so nothing to prompt
 	consented := map[string]bool{purposeElementKey(purpose.Name, "p1"): true}
-	_, ok := buildPermissionPurposePrompt(purpose, consented, []string{"p1"})
+	_, ok := buildPermissionPurposePrompt(purpose, consented, nil, []string{"p1"})
 	s.False(ok)
 }
 
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package crossdevice
+
+import "time"
+
+const (
+	// defaultRequestTTL is how long a sign-in request can be approved when not configured.
+	defaultRequestTTL = 2 * time.Minute
+	// defaultLongPoll is how long a poll waits for the approval when not configured.
+	defaultLongPoll = 10 * time.Second
+	// decisionPollInterval is how often a waiting poll re-reads the sign-in request.
+	decisionPollInterval = 500 * time.Millisecond
+	// defaultApprovalPath is the gate client page the QR code opens when no approval URL is configured.
+	defaultApprovalPath = "/cross-device"
+	// approvalURIReferenceParam is the query parameter of the approval URI that carries the reference.
+	approvalURIReferenceParam = "ref"
+)
+
+// Route paths of the cross-device authentication endpoints.
+const (
+	selfRequestClaimPath    = "/users/me/cross-device-requests/{reference}/claim"
+	selfRequestDecisionPath = "/users/me/cross-device-requests/{reference}/decision"
+)
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package crossdevice
+
+import (
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for cross-device authentication operations.
+var (
+	// ErrorInvalidRequestFormat is returned when the request body is malformed.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CDA-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorRequestNotFound is returned when the sign-in request is unknown, has expired, or was claimed by
+	// another user.
+	ErrorRequestNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CDA-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_not_found",
+			DefaultValue: "Sign-in request not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_not_found_description",
+			DefaultValue: "The sign-in request is unknown or has expired",
+		},
+	}
+
+	// ErrorRequestAlreadyClaimed is returned when the QR code was already scanned by another user.
+	ErrorRequestAlreadyClaimed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CDA-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_already_claimed",
+			DefaultValue: "Sign-in request already claimed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_already_claimed_description",
+			DefaultValue: "The sign-in request was already claimed by another user",
+		},
+	}
+
+	// ErrorRequestNotClaimed is returned when a decision is made on a sign-in request that was not claimed.
+	ErrorRequestNotClaimed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CDA-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_not_claimed",
+			DefaultValue: "Sign-in request not claimed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_not_claimed_description",
+			DefaultValue: "The sign-in request must be claimed before it can be approved or denied",
+		},
+	}
+
+	// ErrorRequestAlreadyDecided is returned when the sign-in request was already approved or denied.
+	ErrorRequestAlreadyDecided = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CDA-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_already_decided",
+			DefaultValue: "Sign-in request already decided",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_already_decided_description",
+			DefaultValue: "The sign-in request was already approved or denied",
+		},
+	}
+
+	// ErrorRequestNotApproved is returned when a sign-in request that was not approved is used to sign in.
+	ErrorRequestNotApproved = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "CDA-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_not_approved",
+			DefaultValue: "Sign-in request not approved",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.crossdeviceauthservice.request_not_approved_description",
+			DefaultValue: "The sign-in request was not approved",
+		},
+	}
+)
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package crossdevice
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// crossDeviceAuthHandler serves the endpoints the phone uses to claim and decide sign-in requests.
+type crossDeviceAuthHandler struct {
+	service CrossDeviceAuthServiceInterface
+}
+
+// newCrossDeviceAuthHandler creates a new instance of crossDeviceAuthHandler.
+func newCrossDeviceAuthHandler(service CrossDeviceAuthServiceInterface) *crossDeviceAuthHandler {
+	return &crossDeviceAuthHandler{service: service}
+}
+
+// HandleSelfRequestClaimRequest handles POST /users/me/cross-device-requests/{reference}/claim.
+func (h *crossDeviceAuthHandler) HandleSelfRequestClaimRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := h.getSubject(w, r)
+	if !ok {
+		return
+	}
+	response, svcErr := h.service.ClaimRequest(ctx, r.PathValue("reference"), userID)
+	if svcErr != nil {
+		writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, response)
+}
+
+// HandleSelfRequestDecisionRequest handles POST /users/me/cross-device-requests/{reference}/decision.
+func (h *crossDeviceAuthHandler) HandleSelfRequestDecisionRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := h.getSubject(w, r)
+	if !ok {
+		return
+	}
+	decision, err := sysutils.DecodeJSONBody[DecisionRequest](r)
+	if err != nil {
+		writeServiceErrorResponse(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+	svcErr := h.service.DecideRequest(ctx, r.PathValue("reference"), userID, decision.Approved)
+	if svcErr != nil {
+		writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getSubject returns the authenticated user of the request, writing an error response when there
+// is none.
+func (h *crossDeviceAuthHandler) getSubject(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		writeServiceErrorResponse(r.Context(), w, &tidcommon.ErrorUnauthorized)
+		return "", false
+	}
+	return userID, true
+}
+
+// writeServiceErrorResponse writes the service error with the matching HTTP status code.
+func writeServiceErrorResponse(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorRequestNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorRequestAlreadyClaimed.Code, ErrorRequestAlreadyDecided.Code:
+			statusCode = http.StatusConflict
+		case tidcommon.ErrorUnauthorized.Code:
+			statusCode = http.StatusUnauthorized
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package crossdevice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+func newTestHandler(t *testing.T) *crossDeviceAuthHandler {
+	t.Helper()
+	svc := newCrossDeviceAuthService(newCrossDeviceStore(inmemory.Initialize("test")), nil, time.Minute,
+		time.Millisecond)
+	return newCrossDeviceAuthHandler(svc)
+}
+
+func newSelfRequest(userID, target, reference string, body []byte) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	req.SetPathValue("reference", reference)
+	authCtx := security.NewSecurityContextForTest(userID, "", "", nil, nil)
+	return req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+}
+
+func decodeErrorCode(t *testing.T, rr *httptest.ResponseRecorder) string {
+	t.Helper()
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	return errResp.Code
+}
+
+func TestHandleSelfRequest_ClaimAndApprove(t *testing.T) {
+	handler := newTestHandler(t)
+	request, svcErr := handler.service.CreateRequest(context.Background(),
+		RequestContext{ApplicationName: "Console", ClientIP: "203.0.113.10"})
+	require.Nil(t, svcErr)
+	claimPath := "/users/me/cross-device-requests/" + request.Reference + "/claim"
+	decisionPath := "/users/me/cross-device-requests/" + request.Reference + "/decision"
+	approval, _ := json.Marshal(DecisionRequest{Approved: true})
+
+	rr := httptest.NewRecorder()
+	handler.HandleSelfRequestDecisionRequest(rr, newSelfRequest(testUserID, decisionPath, request.Reference,
+		approval))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Equal(t, ErrorRequestNotClaimed.Code, decodeErrorCode(t, rr))
+
+	rr = httptest.NewRecorder()
+	handler.HandleSelfRequestClaimRequest(rr, newSelfRequest(testUserID, claimPath, request.Reference, nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	var claim ClaimResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&claim))
+	require.Equal(t, "Console", claim.ApplicationName)
+	require.Equal(t, "203.0.113.10", claim.ClientIP)
+
+	rr = httptest.NewRecorder()
+	handler.HandleSelfRequestClaimRequest(rr, newSelfRequest(testOtherUserID, claimPath, request.Reference, nil))
+	require.Equal(t, http.StatusConflict, rr.Code)
+	require.Equal(t, ErrorRequestAlreadyClaimed.Code, decodeErrorCode(t, rr))
+
+	rr = httptest.NewRecorder()
+	handler.HandleSelfRequestDecisionRequest(rr, newSelfRequest(testUserID, decisionPath, request.Reference,
+		approval))
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = httptest.NewRecorder()
+	handler.HandleSelfRequestDecisionRequest(rr, newSelfRequest(testUserID, decisionPath, request.Reference,
+		approval))
+	require.Equal(t, http.StatusConflict, rr.Code)
+	require.Equal(t, ErrorRequestAlreadyDecided.Code, decodeErrorCode(t, rr))
+}
+
+func TestHandleSelfRequestClaimRequest_NotFound(t *testing.T) {
+	handler := newTestHandler(t)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfRequestClaimRequest(rr, newSelfRequest(testUserID,
+		"/users/me/cross-device-requests/unknown/claim", "unknown", nil))
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	require.Equal(t, ErrorRequestNotFound.Code, decodeErrorCode(t, rr))
+}
+
+func TestHandleSelfRequestDecisionRequest_InvalidRequest(t *testing.T) {
+	handler := newTestHandler(t)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfRequestDecisionRequest(rr, newSelfRequest(testUserID,
+		"/users/me/cross-device-requests/ref/decision", "ref", []byte("{invalid")))
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Equal(t, ErrorInvalidRequestFormat.Code, decodeErrorCode(t, rr))
+}
+
+func TestHandleSelfRequestClaimRequest_Unauthorized(t *testing.T) {
+	handler := newTestHandler(t)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfRequestClaimRequest(rr, httptest.NewRequest(http.MethodPost,
+		"/users/me/cross-device-requests/ref/claim", nil))
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package crossdevice
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize wires the cross-device authentication service and registers its routes.
+func Initialize(mux *http.ServeMux, storeProvider providers.RuntimeStoreProvider) (
+	CrossDeviceAuthServiceInterface, error) {
+	runtime := config.GetServerRuntime()
+	crossDeviceConfig := runtime.Config.CrossDeviceAuth
+
+	approvalURL, err := resolveApprovalURL(crossDeviceConfig.ApprovalURL, runtime.GateClientLoginURL)
+	if err != nil {
+		return nil, err
+	}
+	service := newCrossDeviceAuthService(newCrossDeviceStore(storeProvider), approvalURL,
+		time.Duration(crossDeviceConfig.RequestTTLSeconds)*time.Second,
+		time.Duration(crossDeviceConfig.LongPollSeconds)*time.Second)
+	registerRoutes(mux, newCrossDeviceAuthHandler(service))
+	return service, nil
+}
+
+// resolveApprovalURL returns the configured approval URL, or the default approval page on the host
+// of the gate client.
+func resolveApprovalURL(configured string, gateClientLoginURL *url.URL) (*url.URL, error) {
+	if configured != "" {
+		return url.Parse(configured)
+	}
+	if gateClientLoginURL == nil {
+		return nil, nil
+	}
+	return &url.URL{Scheme: gateClientLoginURL.Scheme, Host: gateClientLoginURL.Host, Path: defaultApprovalPath}, nil
+}
+
+// registerRoutes registers the claim and decision routes of the signed-in phone.
+func registerRoutes(mux *http.ServeMux, handler *crossDeviceAuthHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST "+selfRequestClaimPath, handler.HandleSelfRequestClaimRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("POST "+selfRequestDecisionPath, handler.HandleSelfRequestDecisionRequest,
+		opts))
+	for _, path := range []string{selfRequestClaimPath, selfRequestDecisionPath} {
+		mux.HandleFunc(middleware.WithCORS("OPTIONS "+path,
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}, opts))
+	}
+}
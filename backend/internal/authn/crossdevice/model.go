@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package crossdevice
+
+import "time"
+
+// RequestStatus is the lifecycle status of a cross-device sign-in request.
+type RequestStatus string
+
+// Cross-device sign-in request statuses.
+const (
+	// RequestStatusPending means the QR code has not been scanned yet.
+	RequestStatusPending RequestStatus = "PENDING"
+	// RequestStatusClaimed means a signed-in user scanned the QR code and is deciding on the request.
+	RequestStatusClaimed  RequestStatus = "CLAIMED"
+	RequestStatusApproved RequestStatus = "APPROVED"
+	RequestStatusDenied   RequestStatus = "DENIED"
+	RequestStatusExpired  RequestStatus = "EXPIRED"
+)
+
+// AuthRequest is a sign-in started on one device that is approved on another device on which the
+// user is already signed in.
+type AuthRequest struct {
+	// Reference identifies the request in the QR code. It is stored only as a hash.
+	Reference string `json:"-"`
+	// ApprovalURI is the URI encoded in the QR code. It is derived from the reference.
+	ApprovalURI     string        `json:"-"`
+	Status          RequestStatus `json:"status"`
+	UserID          string        `json:"userId,omitempty"`
+	ApplicationName string        `json:"applicationName,omitempty"`
+	ClientIP        string        `json:"clientIp,omitempty"`
+	UserAgent       string        `json:"userAgent,omitempty"`
+	ExpiresAt       time.Time     `json:"expiresAt"`
+}
+
+// RequestContext describes the device that started a cross-device sign-in, so that the user can
+// recognize it before approving.
+type RequestContext struct {
+	ApplicationName string
+	ClientIP        string
+	UserAgent       string
+}
+
+// ClaimResponse describes the device that started the sign-in to the user who scanned the QR code.
+type ClaimResponse struct {
+	ApplicationName string    `json:"applicationName,omitempty"`
+	ClientIP        string    `json:"clientIp,omitempty"`
+	UserAgent       string    `json:"userAgent,omitempty"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+}
+
+// DecisionRequest is the decision of the user on a claimed sign-in request.
+type DecisionRequest struct {
+	Approved bool `json:"approved"`
+}
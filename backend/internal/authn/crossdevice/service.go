@@ -0,0 +1,285 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package crossdevice implements QR code sign-in, where a sign-in started on one device is approved
+// on a phone on which the user is already signed in. The device shows a QR code with a reference to
+// a pending sign-in request; the phone claims the request for its user and then approves or denies
+// it, which completes the sign-in on the original device.
+package crossdevice
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// CrossDeviceAuthServiceInterface defines the interface for cross-device authentication.
+type CrossDeviceAuthServiceInterface interface {
+	// CreateRequest creates a pending sign-in request for the device described by the request
+	// context. The returned request carries the reference and the URI to encode in the QR code.
+	CreateRequest(ctx context.Context, requestCtx RequestContext) (*AuthRequest, *tidcommon.ServiceError)
+
+	// ClaimRequest binds a pending sign-in request to the user who scanned the QR code and returns
+	// the details of the device that started the sign-in.
+	ClaimRequest(ctx context.Context, reference, userID string) (*ClaimResponse, *tidcommon.ServiceError)
+
+	// DecideRequest records the decision of the user on a sign-in request the user has claimed.
+	DecideRequest(ctx context.Context, reference, userID string, approved bool) *tidcommon.ServiceError
+
+	// WaitForDecision waits up to the long poll duration for the sign-in request to be decided and
+	// returns its current state. An expired request is returned with the expired status.
+	WaitForDecision(ctx context.Context, reference string) (*AuthRequest, *tidcommon.ServiceError)
+
+	// Authenticate consumes an approved sign-in request and returns the authentication result of
+	// the user who approved it.
+	Authenticate(ctx context.Context, cred *authncommon.CrossDeviceCredential) (
+		*authncommon.AuthnResult, *tidcommon.ServiceError)
+}
+
+// crossDeviceAuthService is the default implementation of CrossDeviceAuthServiceInterface.
+type crossDeviceAuthService struct {
+	store       crossDeviceStoreInterface
+	approvalURL *url.URL
+	requestTTL  time.Duration
+	longPoll    time.Duration
+	now         func() time.Time
+	logger      *log.Logger
+}
+
+// newCrossDeviceAuthService creates a new instance of crossDeviceAuthService. The QR codes open the
+// given approval URL with the reference of the request added as a query parameter.
+func newCrossDeviceAuthService(store crossDeviceStoreInterface, approvalURL *url.URL,
+	requestTTL, longPoll time.Duration) CrossDeviceAuthServiceInterface {
+	if requestTTL <= 0 {
+		requestTTL = defaultRequestTTL
+	}
+	if longPoll <= 0 {
+		longPoll = defaultLongPoll
+	}
+	return &crossDeviceAuthService{
+		store:       store,
+		approvalURL: approvalURL,
+		requestTTL:  requestTTL,
+		longPoll:    longPoll,
+		now:         time.Now,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CrossDeviceAuthService")),
+	}
+}
+
+// CreateRequest creates a pending sign-in request for the device described by the request context.
+func (s *crossDeviceAuthService) CreateRequest(ctx context.Context, requestCtx RequestContext) (
+	*AuthRequest, *tidcommon.ServiceError) {
+	reference, err := cryptolib.GenerateSecureToken()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate cross-device request reference", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	request := AuthRequest{
+		Reference:       reference,
+		Status:          RequestStatusPending,
+		ApplicationName: requestCtx.ApplicationName,
+		ClientIP:        requestCtx.ClientIP,
+		UserAgent:       requestCtx.UserAgent,
+		ExpiresAt:       s.now().UTC().Add(s.requestTTL),
+	}
+	if err := s.store.CreateRequest(ctx, request, s.requestTTL); err != nil {
+		s.logger.Error(ctx, "Failed to store cross-device request", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	request.ApprovalURI = s.buildApprovalURI(reference)
+	s.logger.Debug(ctx, "Created cross-device sign-in request")
+	return &request, nil
+}
+
+// ClaimRequest binds a pending sign-in request to the user who scanned the QR code. Claiming a
+// request again as the same user returns the same details, so that the phone can retry.
+func (s *crossDeviceAuthService) ClaimRequest(ctx context.Context, reference, userID string) (
+	*ClaimResponse, *tidcommon.ServiceError) {
+	request, svcErr := s.getActiveRequest(ctx, reference)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	switch request.Status {
+	case RequestStatusPending:
+		request.Status = RequestStatusClaimed
+		request.UserID = userID
+		if svcErr := s.updateRequest(ctx, *request); svcErr != nil {
+			return nil, svcErr
+		}
+		s.logger.Debug(ctx, "Claimed cross-device sign-in request",
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	case RequestStatusClaimed:
+		if request.UserID != userID {
+			return nil, &ErrorRequestAlreadyClaimed
+		}
+	default:
+		if request.UserID != userID {
+			return nil, &ErrorRequestAlreadyClaimed
+		}
+		return nil, &ErrorRequestAlreadyDecided
+	}
+
+	return &ClaimResponse{
+		ApplicationName: request.ApplicationName,
+		ClientIP:        request.ClientIP,
+		UserAgent:       request.UserAgent,
+		ExpiresAt:       request.ExpiresAt,
+	}, nil
+}
+
+// DecideRequest records the decision of the user on a sign-in request the user has claimed. A
+// request claimed by another user is reported as not found.
+func (s *crossDeviceAuthService) DecideRequest(ctx context.Context, reference, userID string,
+	approved bool) *tidcommon.ServiceError {
+	request, svcErr := s.getActiveRequest(ctx, reference)
+	if svcErr != nil {
+		return svcErr
+	}
+	if request.Status == RequestStatusPending {
+		return &ErrorRequestNotClaimed
+	}
+	if request.UserID != userID {
+		return &ErrorRequestNotFound
+	}
+	if request.Status != RequestStatusClaimed {
+		return &ErrorRequestAlreadyDecided
+	}
+
+	request.Status = RequestStatusDenied
+	if approved {
+		request.Status = RequestStatusApproved
+	}
+	if svcErr := s.updateRequest(ctx, *request); svcErr != nil {
+		return svcErr
+	}
+
+	s.logger.Debug(ctx, "Recorded cross-device sign-in decision", log.String("status", string(request.Status)))
+	return nil
+}
+
+// WaitForDecision waits up to the long poll duration for the sign-in request to be decided. The
+// returned request carries the approval URI, so that the QR code can be shown again.
+func (s *crossDeviceAuthService) WaitForDecision(ctx context.Context, reference string) (*AuthRequest,
+	*tidcommon.ServiceError) {
+	deadline := s.now().Add(s.longPoll)
+	for {
+		request, err := s.store.GetRequest(ctx, reference)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to get cross-device request", log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if request == nil {
+			return nil, &ErrorRequestNotFound
+		}
+		request.ApprovalURI = s.buildApprovalURI(reference)
+		if request.Status == RequestStatusApproved || request.Status == RequestStatusDenied {
+			return request, nil
+		}
+		now := s.now()
+		if !now.Before(request.ExpiresAt) {
+			request.Status = RequestStatusExpired
+			return request, nil
+		}
+		if !now.Before(deadline) {
+			return request, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return request, nil
+		case <-time.After(decisionPollInterval):
+		}
+	}
+}
+
+// Authenticate consumes an approved sign-in request and returns the authentication result of the
+// user who approved it. A request can be used only once.
+func (s *crossDeviceAuthService) Authenticate(ctx context.Context, cred *authncommon.CrossDeviceCredential) (
+	*authncommon.AuthnResult, *tidcommon.ServiceError) {
+	if cred == nil || cred.Reference == "" {
+		return nil, &ErrorRequestNotFound
+	}
+	request, err := s.store.TakeRequest(ctx, cred.Reference)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to take cross-device request", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if request == nil || !s.now().Before(request.ExpiresAt) {
+		return nil, &ErrorRequestNotFound
+	}
+	if request.Status != RequestStatusApproved || request.UserID == "" {
+		return nil, &ErrorRequestNotApproved
+	}
+
+	return &authncommon.AuthnResult{
+		Token:               map[string]interface{}{authncommon.UserAttributeUserID: request.UserID},
+		AuthenticatedClaims: map[string]interface{}{authncommon.UserAttributeUserID: request.UserID},
+	}, nil
+}
+
+// getActiveRequest returns the sign-in request of the reference, reporting an unknown or expired
+// request as not found.
+func (s *crossDeviceAuthService) getActiveRequest(ctx context.Context, reference string) (*AuthRequest,
+	*tidcommon.ServiceError) {
+	if reference == "" {
+		return nil, &ErrorRequestNotFound
+	}
+	request, err := s.store.GetRequest(ctx, reference)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get cross-device request", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if request == nil || !s.now().Before(request.ExpiresAt) {
+		return nil, &ErrorRequestNotFound
+	}
+	return request, nil
+}
+
+// updateRequest stores the changed sign-in request, reporting a request that expired meanwhile as
+// not found.
+func (s *crossDeviceAuthService) updateRequest(ctx context.Context, request AuthRequest) *tidcommon.ServiceError {
+	if err := s.store.UpdateRequest(ctx, request); err != nil {
+		if errors.Is(err, providers.ErrRuntimeStoreKeyNotFound) {
+			return &ErrorRequestNotFound
+		}
+		s.logger.Error(ctx, "Failed to update cross-device request", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
+// buildApprovalURI returns the URI the QR code of the reference opens.
+func (s *crossDeviceAuthService) buildApprovalURI(reference string) string {
+	if s.approvalURL == nil {
+		return ""
+	}
+	uri := *s.approvalURL
+	query := uri.Query()
+	query.Set(approvalURIReferenceParam, reference)
+	uri.RawQuery = query.Encode()
+	return uri.String()
+}
@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package crossdevice
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+)
+
+const (
+	testUserID      = "user-1"
+	testOtherUserID = "user-2"
+)
+
+type CrossDeviceAuthServiceTestSuite struct {
+	suite.Suite
+	now     time.Time
+	service *crossDeviceAuthService
+}
+
+func TestCrossDeviceAuthServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CrossDeviceAuthServiceTestSuite))
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	approvalURL, _ := url.Parse("https://gate.example.com/cross-device?lang=en")
+
+	svc := newCrossDeviceAuthService(newCrossDeviceStore(inmemory.Initialize("test")), approvalURL,
+		2*time.Minute, 0)
+	suite.service = svc.(*crossDeviceAuthService)
+	suite.service.now = func() time.Time { return suite.now }
+	// Return immediately from WaitForDecision instead of long-polling.
+	suite.service.longPoll = 0
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) createRequest() *AuthRequest {
+	request, svcErr := suite.service.CreateRequest(context.Background(), RequestContext{
+		ApplicationName: "Console", ClientIP: "203.0.113.10", UserAgent: "Firefox",
+	})
+	suite.Require().Nil(svcErr)
+	return request
+}
+
+// claimedRequest creates a sign-in request and claims it for the test user.
+func (suite *CrossDeviceAuthServiceTestSuite) claimedRequest() *AuthRequest {
+	request := suite.createRequest()
+	_, svcErr := suite.service.ClaimRequest(context.Background(), request.Reference, testUserID)
+	suite.Require().Nil(svcErr)
+	return request
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestCreateRequest() {
+	request := suite.createRequest()
+
+	suite.NotEmpty(request.Reference)
+	suite.Equal(RequestStatusPending, request.Status)
+	suite.Equal(suite.now.Add(2*time.Minute), request.ExpiresAt)
+	suite.Equal("https://gate.example.com/cross-device?lang=en&ref="+request.Reference, request.ApprovalURI)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestClaimRequest() {
+	request := suite.createRequest()
+
+	claim, svcErr := suite.service.ClaimRequest(context.Background(), request.Reference, testUserID)
+	suite.Require().Nil(svcErr)
+	suite.Equal(&ClaimResponse{
+		ApplicationName: "Console", ClientIP: "203.0.113.10", UserAgent: "Firefox", ExpiresAt: request.ExpiresAt,
+	}, claim)
+
+	// Claiming again as the same user is allowed, so that the phone can retry.
+	_, svcErr = suite.service.ClaimRequest(context.Background(), request.Reference, testUserID)
+	suite.Nil(svcErr)
+
+	_, svcErr = suite.service.ClaimRequest(context.Background(), request.Reference, testOtherUserID)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestAlreadyClaimed.Code, svcErr.Code)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestClaimRequest_NotFound() {
+	request := suite.createRequest()
+	suite.now = request.ExpiresAt
+
+	testCases := map[string]string{"Unknown": "unknown", "Empty": "", "Expired": request.Reference}
+	for name, reference := range testCases {
+		suite.Run(name, func() {
+			claim, svcErr := suite.service.ClaimRequest(context.Background(), reference, testUserID)
+
+			suite.Nil(claim)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(ErrorRequestNotFound.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestDecideRequest_Approve() {
+	request := suite.claimedRequest()
+
+	suite.Nil(suite.service.DecideRequest(context.Background(), request.Reference, testUserID, true))
+
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), request.Reference)
+	suite.Require().Nil(svcErr)
+	suite.Equal(RequestStatusApproved, decided.Status)
+	suite.Equal(request.ApprovalURI, decided.ApprovalURI)
+
+	svcErr = suite.service.DecideRequest(context.Background(), request.Reference, testUserID, false)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestAlreadyDecided.Code, svcErr.Code)
+
+	_, svcErr = suite.service.ClaimRequest(context.Background(), request.Reference, testUserID)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestAlreadyDecided.Code, svcErr.Code)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestDecideRequest_Deny() {
+	request := suite.claimedRequest()
+
+	suite.Nil(suite.service.DecideRequest(context.Background(), request.Reference, testUserID, false))
+
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), request.Reference)
+	suite.Require().Nil(svcErr)
+	suite.Equal(RequestStatusDenied, decided.Status)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestDecideRequest_NotClaimed() {
+	request := suite.createRequest()
+
+	svcErr := suite.service.DecideRequest(context.Background(), request.Reference, testUserID, true)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestNotClaimed.Code, svcErr.Code)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestDecideRequest_ClaimedByAnotherUser() {
+	request := suite.claimedRequest()
+
+	svcErr := suite.service.DecideRequest(context.Background(), request.Reference, testOtherUserID, true)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestNotFound.Code, svcErr.Code)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestWaitForDecision_Pending() {
+	request := suite.createRequest()
+
+	current, svcErr := suite.service.WaitForDecision(context.Background(), request.Reference)
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(RequestStatusPending, current.Status)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestWaitForDecision_Expired() {
+	request := suite.claimedRequest()
+	suite.now = request.ExpiresAt
+
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), request.Reference)
+	suite.Require().Nil(svcErr)
+	suite.Equal(RequestStatusExpired, decided.Status)
+
+	svcErr = suite.service.DecideRequest(context.Background(), request.Reference, testUserID, true)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestNotFound.Code, svcErr.Code)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestWaitForDecision_NotFound() {
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), "unknown")
+
+	suite.Nil(decided)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestNotFound.Code, svcErr.Code)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestWaitForDecision_ReturnsWhenDecided() {
+	suite.service.now = time.Now
+	suite.service.longPoll = 5 * time.Second
+	request := suite.claimedRequest()
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = suite.service.DecideRequest(context.Background(), request.Reference, testUserID, true)
+	}()
+
+	started := time.Now()
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), request.Reference)
+
+	suite.Nil(svcErr)
+	suite.Equal(RequestStatusApproved, decided.Status)
+	suite.Less(time.Since(started), 5*time.Second)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestAuthenticate() {
+	request := suite.claimedRequest()
+	suite.Require().Nil(suite.service.DecideRequest(context.Background(), request.Reference, testUserID, true))
+	cred := &authncommon.CrossDeviceCredential{Reference: request.Reference}
+
+	result, svcErr := suite.service.Authenticate(context.Background(), cred)
+	suite.Require().Nil(svcErr)
+	suite.Equal(testUserID, result.Token[authncommon.UserAttributeUserID])
+
+	// An approved request can be used only once.
+	_, svcErr = suite.service.Authenticate(context.Background(), cred)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestNotFound.Code, svcErr.Code)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestAuthenticate_NotApproved() {
+	request := suite.claimedRequest()
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&authncommon.CrossDeviceCredential{Reference: request.Reference})
+
+	suite.Nil(result)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestNotApproved.Code, svcErr.Code)
+}
+
+func (suite *CrossDeviceAuthServiceTestSuite) TestAuthenticate_MissingCredential() {
+	result, svcErr := suite.service.Authenticate(context.Background(), nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRequestNotFound.Code, svcErr.Code)
+}
+
+func TestResolveApprovalURL(t *testing.T) {
+	gateLoginURL := &url.URL{Scheme: "https", Host: "gate.example.com:8090", Path: "/signin"}
+
+	resolved, err := resolveApprovalURL("", gateLoginURL)
+	if err != nil || resolved.String() != "https://gate.example.com:8090/cross-device" {
+		t.Fatalf("unexpected default approval URL %v (err %v)", resolved, err)
+	}
+
+	resolved, err = resolveApprovalURL("https://app.example.com/approve", gateLoginURL)
+	if err != nil || resolved.String() != "https://app.example.com/approve" {
+		t.Fatalf("unexpected configured approval URL %v (err %v)", resolved, err)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package crossdevice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// crossDeviceStoreInterface defines the interface for the cross-device sign-in request store.
+type crossDeviceStoreInterface interface {
+	// CreateRequest stores a new sign-in request for the given lifetime.
+	CreateRequest(ctx context.Context, request AuthRequest, ttl time.Duration) error
+
+	// GetRequest returns the sign-in request, or nil when it is unknown or has expired.
+	GetRequest(ctx context.Context, reference string) (*AuthRequest, error)
+
+	// UpdateRequest replaces a stored sign-in request without extending its lifetime. It returns
+	// providers.ErrRuntimeStoreKeyNotFound when the request has expired.
+	UpdateRequest(ctx context.Context, request AuthRequest) error
+
+	// TakeRequest removes and returns the sign-in request, or nil when it is unknown or has expired.
+	TakeRequest(ctx context.Context, reference string) (*AuthRequest, error)
+}
+
+// crossDeviceStore keeps cross-device sign-in requests in the runtime store. Requests are keyed by
+// the hash of their reference, so that the stored data cannot be used to approve a request.
+type crossDeviceStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newCrossDeviceStore creates a new instance of crossDeviceStore.
+func newCrossDeviceStore(store providers.RuntimeStoreProvider) crossDeviceStoreInterface {
+	return &crossDeviceStore{store: store}
+}
+
+// CreateRequest stores a new sign-in request for the given lifetime.
+func (s *crossDeviceStore) CreateRequest(ctx context.Context, request AuthRequest, ttl time.Duration) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cross-device request: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceCrossDeviceReq, cryptolib.HashToken(request.Reference), data,
+		int64(ttl.Seconds()))
+}
+
+// GetRequest returns the sign-in request, or nil when it is unknown or has expired.
+func (s *crossDeviceStore) GetRequest(ctx context.Context, reference string) (*AuthRequest, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceCrossDeviceReq, cryptolib.HashToken(reference))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cross-device request: %w", err)
+	}
+	return unmarshalRequest(data, reference)
+}
+
+// UpdateRequest replaces a stored sign-in request without extending its lifetime.
+func (s *crossDeviceStore) UpdateRequest(ctx context.Context, request AuthRequest) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cross-device request: %w", err)
+	}
+	err = s.store.Update(ctx, providers.NamespaceCrossDeviceReq, cryptolib.HashToken(request.Reference), data)
+	if err != nil {
+		if errors.Is(err, providers.ErrRuntimeStoreKeyNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to update cross-device request: %w", err)
+	}
+	return nil
+}
+
+// TakeRequest removes and returns the sign-in request, or nil when it is unknown or has expired.
+func (s *crossDeviceStore) TakeRequest(ctx context.Context, reference string) (*AuthRequest, error) {
+	data, err := s.store.Take(ctx, providers.NamespaceCrossDeviceReq, cryptolib.HashToken(reference))
+	if err != nil {
+		return nil, fmt.Errorf("failed to take cross-device request: %w", err)
+	}
+	return unmarshalRequest(data, reference)
+}
+
+// unmarshalRequest decodes a stored sign-in request, returning nil for a missing entry.
+func unmarshalRequest(data []byte, reference string) (*AuthRequest, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var request AuthRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cross-device request: %w", err)
+	}
+	request.Reference = reference
+	return &request, nil
+}
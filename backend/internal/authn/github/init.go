@@ -19,12 +19,14 @@
 package github
 
 import (
+	"time"
+
 	authnoauth "github.com/thunder-id/thunderid/internal/authn/oauth"
 	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 )
 
 // Initialize initializes the GitHub OAuth authentication service.
 func Initialize(oauthSvc authnoauth.OAuthAuthnServiceInterface) GithubOAuthAuthnServiceInterface {
-	httpClient := syshttp.NewHTTPClient()
+	httpClient := syshttp.NewOutboundHTTPClient(30 * time.Second)
 	return newGithubOAuthAuthnService(oauthSvc, httpClient)
 }
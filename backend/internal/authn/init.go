@@ -100,6 +100,18 @@ func Initialize(
 		Name:    common.AuthenticatorOpenID4VP,
 		Factors: []common.AuthenticationFactor{common.FactorPossession, common.FactorInherence},
 	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:    common.AuthenticatorPush,
+		Factors: []common.AuthenticationFactor{common.FactorPossession},
+	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:    common.AuthenticatorCrossDevice,
+		Factors: []common.AuthenticationFactor{common.FactorPossession},
+	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:    common.AuthenticatorCertificate,
+		Factors: []common.AuthenticationFactor{common.FactorPossession},
+	})
 
 	authnService := newAuthenticationService(
 		idpSvc,
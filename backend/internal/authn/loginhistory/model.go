@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package loginhistory
+
+import "time"
+
+// LoginOutcome is the outcome of a sign-in attempt.
+type LoginOutcome string
+
+const (
+	// LoginOutcomeSuccess marks a sign-in that completed.
+	LoginOutcomeSuccess LoginOutcome = "SUCCESS"
+	// LoginOutcomeFailure marks a sign-in step that the user did not pass.
+	LoginOutcomeFailure LoginOutcome = "FAILURE"
+)
+
+// LoginAttempt is a recorded sign-in attempt of a user.
+type LoginAttempt struct {
+	Timestamp     time.Time    `json:"timestamp"`
+	ApplicationID string       `json:"applicationId,omitempty"`
+	IPAddress     string       `json:"ipAddress,omitempty"`
+	Outcome       LoginOutcome `json:"outcome"`
+	FailureCode   string       `json:"failureCode,omitempty"`
+	FailureReason string       `json:"failureReason,omitempty"`
+	Executors     []string     `json:"executors,omitempty"`
+}
+
+// LoginHistoryFilter selects a page of the sign-in attempts of a user. A zero From or To leaves
+// that end of the time range open.
+type LoginHistoryFilter struct {
+	From   time.Time
+	To     time.Time
+	Limit  int
+	Offset int
+}
+
+// LoginHistoryPage is a page of the sign-in attempts of a user, most recent first.
+type LoginHistoryPage struct {
+	TotalResults int
+	Attempts     []LoginAttempt
+}
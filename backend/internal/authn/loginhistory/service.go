@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package loginhistory records the sign-in attempts of users so that users and administrators can
+// review recent account activity.
+package loginhistory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// LoginHistoryServiceInterface defines the interface for the login history service.
+type LoginHistoryServiceInterface interface {
+	// RecordAttempt records a sign-in attempt of the user. The timestamp is set when it is zero.
+	RecordAttempt(ctx context.Context, userID string, attempt LoginAttempt) error
+
+	// GetLoginHistory returns the sign-in attempts of the user that match the filter, most recent
+	// first.
+	GetLoginHistory(ctx context.Context, userID string, filter LoginHistoryFilter) (
+		*LoginHistoryPage, *common.ServiceError)
+
+	// DeleteLoginHistory removes all recorded sign-in attempts of the user.
+	DeleteLoginHistory(ctx context.Context, userID string) *common.ServiceError
+}
+
+// loginHistoryService is the default implementation of LoginHistoryServiceInterface.
+type loginHistoryService struct {
+	store  loginHistoryStoreInterface
+	now    func() time.Time
+	logger *log.Logger
+}
+
+// newLoginHistoryService creates a new instance of loginHistoryService.
+func newLoginHistoryService(store loginHistoryStoreInterface) LoginHistoryServiceInterface {
+	return &loginHistoryService{
+		store:  store,
+		now:    time.Now,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LoginHistoryService")),
+	}
+}
+
+// RecordAttempt records a sign-in attempt of the user. Attempts older than the retention period and
+// attempts beyond the per-user limit are dropped.
+func (s *loginHistoryService) RecordAttempt(ctx context.Context, userID string, attempt LoginAttempt) error {
+	if userID == "" {
+		return nil
+	}
+
+	attempts, err := s.store.GetAttempts(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := s.now().UTC()
+	if attempt.Timestamp.IsZero() {
+		attempt.Timestamp = now
+	}
+	cutoff := now.Add(-time.Duration(retentionSeconds) * time.Second)
+	kept := make([]LoginAttempt, 0, len(attempts)+1)
+	kept = append(kept, attempt)
+	for _, previous := range attempts {
+		if len(kept) == maxAttemptsPerUser {
+			break
+		}
+		if previous.Timestamp.After(cutoff) {
+			kept = append(kept, previous)
+		}
+	}
+
+	if err := s.store.SaveAttempts(ctx, userID, kept); err != nil {
+		return fmt.Errorf("failed to save login history: %w", err)
+	}
+	return nil
+}
+
+// GetLoginHistory returns the sign-in attempts of the user that match the filter, most recent first.
+func (s *loginHistoryService) GetLoginHistory(ctx context.Context, userID string,
+	filter LoginHistoryFilter) (*LoginHistoryPage, *common.ServiceError) {
+	attempts, err := s.store.GetAttempts(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get login history", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	matched := make([]LoginAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		if !filter.From.IsZero() && attempt.Timestamp.Before(filter.From) {
+			continue
+		}
+		if !filter.To.IsZero() && attempt.Timestamp.After(filter.To) {
+			continue
+		}
+		matched = append(matched, attempt)
+	}
+
+	page := &LoginHistoryPage{TotalResults: len(matched), Attempts: []LoginAttempt{}}
+	if filter.Offset < len(matched) {
+		end := len(matched)
+		if filter.Limit > 0 && filter.Offset+filter.Limit < end {
+			end = filter.Offset + filter.Limit
+		}
+		page.Attempts = matched[filter.Offset:end]
+	}
+	return page, nil
+}
+
+// DeleteLoginHistory removes all recorded sign-in attempts of the user.
+func (s *loginHistoryService) DeleteLoginHistory(ctx context.Context, userID string) *common.ServiceError {
+	if err := s.store.DeleteAttempts(ctx, userID); err != nil {
+		s.logger.Error(ctx, "Failed to delete login history", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &common.InternalServerError
+	}
+	return nil
+}
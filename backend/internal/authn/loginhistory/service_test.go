@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package loginhistory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+)
+
+const testUserID = "user-1"
+
+type LoginHistoryServiceTestSuite struct {
+	suite.Suite
+	now     time.Time
+	service *loginHistoryService
+}
+
+func TestLoginHistoryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LoginHistoryServiceTestSuite))
+}
+
+func (suite *LoginHistoryServiceTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	suite.service = newLoginHistoryService(newLoginHistoryStore(inmemory.Initialize("test"))).(*loginHistoryService)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *LoginHistoryServiceTestSuite) record(outcome LoginOutcome) {
+	suite.Require().NoError(suite.service.RecordAttempt(context.Background(), testUserID,
+		LoginAttempt{Outcome: outcome, ApplicationID: "app-1"}))
+}
+
+func (suite *LoginHistoryServiceTestSuite) history(filter LoginHistoryFilter) *LoginHistoryPage {
+	page, svcErr := suite.service.GetLoginHistory(context.Background(), testUserID, filter)
+	suite.Require().Nil(svcErr)
+	return page
+}
+
+func (suite *LoginHistoryServiceTestSuite) TestRecordAttempt_MostRecentFirst() {
+	suite.record(LoginOutcomeFailure)
+	suite.now = suite.now.Add(time.Minute)
+	suite.record(LoginOutcomeSuccess)
+
+	page := suite.history(LoginHistoryFilter{})
+
+	suite.Equal(2, page.TotalResults)
+	suite.Equal(LoginOutcomeSuccess, page.Attempts[0].Outcome)
+	suite.Equal(suite.now, page.Attempts[0].Timestamp)
+	suite.Equal(LoginOutcomeFailure, page.Attempts[1].Outcome)
+}
+
+func (suite *LoginHistoryServiceTestSuite) TestRecordAttempt_IgnoresMissingUser() {
+	suite.NoError(suite.service.RecordAttempt(context.Background(), "", LoginAttempt{Outcome: LoginOutcomeSuccess}))
+}
+
+func (suite *LoginHistoryServiceTestSuite) TestRecordAttempt_KeepsLimitedAttempts() {
+	for i := 0; i < maxAttemptsPerUser+5; i++ {
+		suite.now = suite.now.Add(time.Second)
+		suite.record(LoginOutcomeSuccess)
+	}
+
+	page := suite.history(LoginHistoryFilter{})
+
+	suite.Equal(maxAttemptsPerUser, page.TotalResults)
+	suite.Equal(suite.now, page.Attempts[0].Timestamp)
+}
+
+func (suite *LoginHistoryServiceTestSuite) TestRecordAttempt_DropsExpiredAttempts() {
+	suite.record(LoginOutcomeFailure)
+	suite.now = suite.now.Add(time.Duration(retentionSeconds+1) * time.Second)
+	suite.record(LoginOutcomeSuccess)
+
+	page := suite.history(LoginHistoryFilter{})
+
+	suite.Equal(1, page.TotalResults)
+	suite.Equal(LoginOutcomeSuccess, page.Attempts[0].Outcome)
+}
+
+func (suite *LoginHistoryServiceTestSuite) TestGetLoginHistory_NoAttempts() {
+	page := suite.history(LoginHistoryFilter{Limit: 10})
+
+	suite.Equal(0, page.TotalResults)
+	suite.Empty(page.Attempts)
+}
+
+func (suite *LoginHistoryServiceTestSuite) TestGetLoginHistory_TimeRange() {
+	start := suite.now
+	for i := 0; i < 5; i++ {
+		suite.now = start.Add(time.Duration(i) * time.Hour)
+		suite.record(LoginOutcomeSuccess)
+	}
+
+	page := suite.history(LoginHistoryFilter{From: start.Add(time.Hour), To: start.Add(3 * time.Hour)})
+
+	suite.Equal(3, page.TotalResults)
+	suite.Equal(start.Add(3*time.Hour), page.Attempts[0].Timestamp)
+	suite.Equal(start.Add(time.Hour), page.Attempts[2].Timestamp)
+}
+
+func (suite *LoginHistoryServiceTestSuite) TestGetLoginHistory_Pagination() {
+	for i := 0; i < 5; i++ {
+		suite.now = suite.now.Add(time.Minute)
+		suite.record(LoginOutcomeSuccess)
+	}
+
+	tests := []struct {
+		limit, offset, expectedCount int
+	}{
+		{2, 0, 2},
+		{2, 4, 1},
+		{2, 5, 0},
+	}
+	for _, tc := range tests {
+		suite.Run(fmt.Sprintf("limit=%d,offset=%d", tc.limit, tc.offset), func() {
+			page := suite.history(LoginHistoryFilter{Limit: tc.limit, Offset: tc.offset})
+
+			suite.Equal(5, page.TotalResults)
+			suite.Len(page.Attempts, tc.expectedCount)
+		})
+	}
+}
+
+func (suite *LoginHistoryServiceTestSuite) TestDeleteLoginHistory() {
+	suite.record(LoginOutcomeSuccess)
+
+	suite.Nil(suite.service.DeleteLoginHistory(context.Background(), testUserID))
+
+	page := suite.history(LoginHistoryFilter{})
+	suite.Equal(0, page.TotalResults)
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package loginhistory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// loginHistoryStoreInterface defines the interface for the login history store.
+type loginHistoryStoreInterface interface {
+	// GetAttempts returns the sign-in attempts of the user, or an empty list when none are stored.
+	GetAttempts(ctx context.Context, userID string) ([]LoginAttempt, error)
+
+	// SaveAttempts stores the sign-in attempts of the user.
+	SaveAttempts(ctx context.Context, userID string, attempts []LoginAttempt) error
+
+	// DeleteAttempts removes all stored sign-in attempts of the user.
+	DeleteAttempts(ctx context.Context, userID string) error
+}
+
+// loginHistoryStore keeps sign-in attempts in the runtime store. The entry of a user expires
+// retentionSeconds after the last attempt.
+type loginHistoryStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newLoginHistoryStore creates a new instance of loginHistoryStore.
+func newLoginHistoryStore(store providers.RuntimeStoreProvider) loginHistoryStoreInterface {
+	return &loginHistoryStore{store: store}
+}
+
+// GetAttempts returns the sign-in attempts of the user, or an empty list when none are stored.
+func (s *loginHistoryStore) GetAttempts(ctx context.Context, userID string) ([]LoginAttempt, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceLoginHistory, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login history: %w", err)
+	}
+	attempts := make([]LoginAttempt, 0)
+	if data == nil {
+		return attempts, nil
+	}
+	if err := json.Unmarshal(data, &attempts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal login history: %w", err)
+	}
+	return attempts, nil
+}
+
+// SaveAttempts stores the sign-in attempts of the user.
+func (s *loginHistoryStore) SaveAttempts(ctx context.Context, userID string, attempts []LoginAttempt) error {
+	data, err := json.Marshal(attempts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal login history: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceLoginHistory, userID, data, retentionSeconds)
+}
+
+// DeleteAttempts removes all stored sign-in attempts of the user.
+func (s *loginHistoryStore) DeleteAttempts(ctx context.Context, userID string) error {
+	if err := s.store.Delete(ctx, providers.NamespaceLoginHistory, userID); err != nil {
+		return fmt.Errorf("failed to delete login history: %w", err)
+	}
+	return nil
+}
@@ -19,6 +19,8 @@
 package oauth
 
 import (
+	"time"
+
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/idp"
 	syshttp "github.com/thunder-id/thunderid/internal/system/http"
@@ -27,6 +29,6 @@ import (
 // Initialize initializes the OAuth authentication service.
 func Initialize(idpSvc idp.IDPServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface) OAuthAuthnServiceInterface {
-	httpClient := syshttp.NewHTTPClient()
+	httpClient := syshttp.NewOutboundHTTPClient(30 * time.Second)
 	return newOAuthAuthnService(httpClient, idpSvc, entityProvider)
 }
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package openid4vp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// boltOpenID4VPStore is the bolt-backed implementation of openID4VPStoreInterface. It keeps request
+// state across restarts of a single-node deployment.
+type boltOpenID4VPStore struct {
+	client       provider.BoltProviderInterface
+	deploymentID string
+	crypto       kmprovider.ConfigCryptoProvider
+	logger       *log.Logger
+}
+
+// newBoltOpenID4VPStore creates a new bolt-backed request state store using the given crypto provider.
+func newBoltOpenID4VPStore(
+	p provider.BoltProviderInterface, crypto kmprovider.ConfigCryptoProvider,
+) openID4VPStoreInterface {
+	return &boltOpenID4VPStore{
+		client:       p,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+		crypto:       crypto,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OpenID4VPStateStore")),
+	}
+}
+
+// stateKey builds the bolt key for a request state.
+func (s *boltOpenID4VPStore) stateKey(state string) string {
+	return fmt.Sprintf("runtime:%s:openid4vp:%s", s.deploymentID, state)
+}
+
+// SaveRequestState stores the request state in bolt, encrypting the ephemeral key. The entry
+// expires expiredStateRetention after the state itself expires.
+func (s *boltOpenID4VPStore) SaveRequestState(ctx context.Context, st *RequestState) error {
+	data, err := encodeRequestState(ctx, s.crypto, st)
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(st.ExpiresAt) + expiredStateRetention
+	if ttl <= 0 {
+		return s.DeleteRequestState(ctx, st.State)
+	}
+	if err := s.client.Set(s.stateKey(st.State), data, ttl); err != nil {
+		return fmt.Errorf("failed to store request state in bolt: %w", err)
+	}
+	return nil
+}
+
+// GetRequestState retrieves and reconstructs the request state for the given state from bolt.
+func (s *boltOpenID4VPStore) GetRequestState(ctx context.Context, state string) (*RequestState, bool) {
+	data, err := s.client.Get(s.stateKey(state))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get request state from bolt", log.Error(err))
+		return nil, false
+	}
+	if data == nil {
+		return nil, false
+	}
+
+	rs, err := decodeRequestState(ctx, s.crypto, data)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to restore request state", log.Error(err))
+		return nil, false
+	}
+	return rs, true
+}
+
+// DeleteRequestState removes the request state for the given state from bolt.
+func (s *boltOpenID4VPStore) DeleteRequestState(_ context.Context, state string) error {
+	if err := s.client.Del(s.stateKey(state)); err != nil {
+		return fmt.Errorf("failed to delete request state from bolt: %w", err)
+	}
+	return nil
+}
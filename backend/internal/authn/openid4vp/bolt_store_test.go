@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package openid4vp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider/bolttest"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+type BoltOpenID4VPStoreTestSuite struct {
+	suite.Suite
+	store *boltOpenID4VPStore
+	ctx   context.Context
+}
+
+func TestBoltOpenID4VPStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(BoltOpenID4VPStoreTestSuite))
+}
+
+func (suite *BoltOpenID4VPStoreTestSuite) SetupTest() {
+	suite.store = &boltOpenID4VPStore{
+		client:       bolttest.NewProvider(suite.T()),
+		deploymentID: "test-deployment",
+		crypto:       identityCrypto{},
+		logger:       log.GetLogger(),
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *BoltOpenID4VPStoreTestSuite) TestStateKey() {
+	suite.Equal("runtime:test-deployment:openid4vp:s1", suite.store.stateKey("s1"))
+}
+
+func (suite *BoltOpenID4VPStoreTestSuite) TestSaveAndGetRequestState_RoundTrip() {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	st := &RequestState{
+		State:        "s1",
+		DefinitionID: "def-1",
+		Nonce:        "n-1",
+		EphemeralKey: key,
+		ClientID:     "x509_san_dns:rp.example.com",
+		RequestURI:   "https://rp.example.com/request/s1",
+		Status:       StatusPending,
+		ExpiresAt:    time.Now().Add(5 * time.Minute).UTC().Truncate(time.Second),
+	}
+
+	suite.Require().NoError(suite.store.SaveRequestState(suite.ctx, st))
+
+	got, ok := suite.store.GetRequestState(suite.ctx, "s1")
+	suite.Require().True(ok)
+	suite.Equal(st.DefinitionID, got.DefinitionID)
+	suite.Equal(st.Status, got.Status)
+	suite.True(st.ExpiresAt.Equal(got.ExpiresAt))
+	suite.Require().NotNil(got.EphemeralKey)
+	suite.True(key.Equal(got.EphemeralKey))
+
+	suite.Require().NoError(suite.store.DeleteRequestState(suite.ctx, "s1"))
+	_, ok = suite.store.GetRequestState(suite.ctx, "s1")
+	suite.False(ok)
+}
+
+func (suite *BoltOpenID4VPStoreTestSuite) TestSaveRequestState_LongExpiredDeletes() {
+	st := &RequestState{State: "s1", Status: StatusPending, ExpiresAt: time.Now().Add(time.Minute)}
+	suite.Require().NoError(suite.store.SaveRequestState(suite.ctx, st))
+
+	st.ExpiresAt = time.Now().Add(-time.Hour)
+	suite.Require().NoError(suite.store.SaveRequestState(suite.ctx, st))
+
+	_, ok := suite.store.GetRequestState(suite.ctx, "s1")
+	suite.False(ok)
+}
+
+func (suite *BoltOpenID4VPStoreTestSuite) TestGetRequestState_NotFound() {
+	_, ok := suite.store.GetRequestState(suite.ctx, "missing")
+	suite.False(ok)
+}
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/jose/jws"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
@@ -111,7 +112,7 @@ func Initialize(
 		ResultTokenValidity:   resultTokenValidity,
 		VerifierInfo:          verifierInfo,
 		EnforceKeyBinding:     cfg.EnforceKeyBinding,
-	}, newOpenID4VPStore(configCrypto), clientID,
+	}, initializeStore(configCrypto), clientID,
 		cryptoProvider, kmprovider.KeyRef{KeyID: cfg.SigningKeyID}, string(signingKey.Algorithm), x5c,
 		trust, defSvc, jwtService, base)
 	if err != nil {
@@ -123,6 +124,17 @@ func Initialize(
 	return svc, nil
 }
 
+// initializeStore selects the request state store implementation based on the configured runtime DB type.
+func initializeStore(configCrypto kmprovider.ConfigCryptoProvider) openID4VPStoreInterface {
+	switch config.GetServerRuntime().Config.Database.Runtime.Type {
+	case provider.DataSourceTypeRedis:
+		return newRedisOpenID4VPStore(provider.GetRedisProvider(), configCrypto)
+	case provider.DataSourceTypeBolt:
+		return newBoltOpenID4VPStore(provider.GetBoltProvider(), configCrypto)
+	}
+	return newOpenID4VPStore(configCrypto)
+}
+
 // registerRoutes registers the OpenID4VP HTTP routes on mux with CORS middleware.
 func registerRoutes(mux *http.ServeMux, h *openID4VPHandler) {
 	opts := middleware.CORSOptions{
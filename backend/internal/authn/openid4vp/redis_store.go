@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package openid4vp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// expiredStateRetention keeps a request state in Redis for a while after it expires so that a
+// polling relying party observes the expired status rather than an unknown state.
+const expiredStateRetention = 5 * time.Minute
+
+// redisClient abstracts the Redis commands used by the OpenID4VP request state store.
+type redisClient interface {
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+}
+
+// serializedRequestState is the serialized form of RequestState stored in a key-value runtime store
+// (Redis or bolt). The ephemeral response-decryption key is stored encrypted with the server's
+// configured symmetric key.
+type serializedRequestState struct {
+	State         string                `json:"state"`
+	DefinitionID  string                `json:"definition_id"`
+	Nonce         string                `json:"nonce"`
+	EphemeralKey  []byte                `json:"ephemeral_key,omitempty"`
+	ClientID      string                `json:"client_id"`
+	RequestURI    string                `json:"request_uri"`
+	Status        Status                `json:"status"`
+	Result        *VerifiedPresentation `json:"result,omitempty"`
+	FailureReason string                `json:"failure_reason,omitempty"`
+	ExpiresAt     time.Time             `json:"expires_at"`
+}
+
+// redisOpenID4VPStore is the Redis-backed implementation of openID4VPStoreInterface. Like the
+// database-backed store it makes request state visible to every replica.
+type redisOpenID4VPStore struct {
+	client       redisClient
+	keyPrefix    string
+	deploymentID string
+	crypto       kmprovider.ConfigCryptoProvider
+	logger       *log.Logger
+}
+
+// newRedisOpenID4VPStore creates a new Redis-backed request state store using the given crypto provider.
+func newRedisOpenID4VPStore(
+	p provider.RedisProviderInterface, crypto kmprovider.ConfigCryptoProvider,
+) openID4VPStoreInterface {
+	return &redisOpenID4VPStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+		crypto:       crypto,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OpenID4VPStateStore")),
+	}
+}
+
+// stateKey builds the Redis key for a request state.
+func (s *redisOpenID4VPStore) stateKey(state string) string {
+	return fmt.Sprintf("%s:runtime:%s:openid4vp:%s", s.keyPrefix, s.deploymentID, state)
+}
+
+// SaveRequestState stores the request state in Redis, encrypting the ephemeral key. The entry
+// expires expiredStateRetention after the state itself expires.
+func (s *redisOpenID4VPStore) SaveRequestState(ctx context.Context, st *RequestState) error {
+	data, err := encodeRequestState(ctx, s.crypto, st)
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(st.ExpiresAt) + expiredStateRetention
+	if ttl <= 0 {
+		return s.DeleteRequestState(ctx, st.State)
+	}
+	if err := s.client.Set(ctx, s.stateKey(st.State), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store request state in Redis: %w", err)
+	}
+	return nil
+}
+
+// GetRequestState retrieves and reconstructs the request state for the given state from Redis.
+func (s *redisOpenID4VPStore) GetRequestState(ctx context.Context, state string) (*RequestState, bool) {
+	data, err := s.client.Get(ctx, s.stateKey(state)).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			s.logger.Error(ctx, "Failed to get request state from Redis", log.Error(err))
+		}
+		return nil, false
+	}
+
+	rs, err := decodeRequestState(ctx, s.crypto, data)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to restore request state", log.Error(err))
+		return nil, false
+	}
+	return rs, true
+}
+
+// DeleteRequestState removes the request state for the given state from Redis.
+func (s *redisOpenID4VPStore) DeleteRequestState(ctx context.Context, state string) error {
+	if err := s.client.Del(ctx, s.stateKey(state)).Err(); err != nil {
+		return fmt.Errorf("failed to delete request state from Redis: %w", err)
+	}
+	return nil
+}
+
+// encodeRequestState serializes a request state for a key-value runtime store, encrypting the
+// ephemeral key.
+func encodeRequestState(
+	ctx context.Context, crypto kmprovider.ConfigCryptoProvider, st *RequestState,
+) ([]byte, error) {
+	stored := serializedRequestState{
+		State:         st.State,
+		DefinitionID:  st.DefinitionID,
+		Nonce:         st.Nonce,
+		ClientID:      st.ClientID,
+		RequestURI:    st.RequestURI,
+		Status:        st.Status,
+		Result:        st.Result,
+		FailureReason: st.FailureReason,
+		ExpiresAt:     st.ExpiresAt.UTC(),
+	}
+	if st.EphemeralKey != nil {
+		pkcs8, err := x509.MarshalPKCS8PrivateKey(st.EphemeralKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal ephemeral key: %w", err)
+		}
+		stored.EphemeralKey, err = crypto.Encrypt(ctx, pkcs8)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt ephemeral key: %w", err)
+		}
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request state: %w", err)
+	}
+	return data, nil
+}
+
+// decodeRequestState reconstructs a request state serialized by encodeRequestState.
+func decodeRequestState(
+	ctx context.Context, crypto kmprovider.ConfigCryptoProvider, data []byte,
+) (*RequestState, error) {
+	var stored serializedRequestState
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request state: %w", err)
+	}
+
+	rs := &RequestState{
+		State:         stored.State,
+		DefinitionID:  stored.DefinitionID,
+		Nonce:         stored.Nonce,
+		ClientID:      stored.ClientID,
+		RequestURI:    stored.RequestURI,
+		Status:        stored.Status,
+		Result:        stored.Result,
+		FailureReason: stored.FailureReason,
+		ExpiresAt:     stored.ExpiresAt,
+	}
+	if len(stored.EphemeralKey) > 0 {
+		key, err := decryptEphemeralKey(ctx, crypto, stored.EphemeralKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore ephemeral key: %w", err)
+		}
+		rs.EphemeralKey = key
+	}
+	return rs, nil
+}
+
+// decryptEphemeralKey decrypts and parses a stored ephemeral EC private key.
+func decryptEphemeralKey(
+	ctx context.Context, crypto kmprovider.ConfigCryptoProvider, encKey []byte,
+) (*ecdsa.PrivateKey, error) {
+	pkcs8, err := crypto.Decrypt(ctx, encKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt ephemeral key: %w", err)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(pkcs8)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ephemeral key: %w", err)
+	}
+	ecKey, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: ephemeral key is not an EC private key", ErrPolicy)
+	}
+	return ecKey, nil
+}
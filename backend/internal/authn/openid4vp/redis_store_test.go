@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package openid4vp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment"
+	redisTestState        = "state-1"
+)
+
+type RedisOpenID4VPStoreTestSuite struct {
+	suite.Suite
+	store      *redisOpenID4VPStore
+	mockClient *redisClientMock
+	redisKey   string
+}
+
+func TestRedisOpenID4VPStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisOpenID4VPStoreTestSuite))
+}
+
+func (suite *RedisOpenID4VPStoreTestSuite) SetupTest() {
+	suite.mockClient = newRedisClientMock(suite.T())
+	suite.store = &redisOpenID4VPStore{
+		client:       suite.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+		crypto:       identityCrypto{},
+		logger:       log.GetLogger(),
+	}
+	suite.redisKey = fmt.Sprintf("%s:runtime:%s:openid4vp:%s",
+		redisTestKeyPrefix, redisTestDeploymentID, redisTestState)
+}
+
+func (suite *RedisOpenID4VPStoreTestSuite) TestStateKey() {
+	suite.Equal(suite.redisKey, suite.store.stateKey(redisTestState))
+}
+
+// A saved request state is restored with its ephemeral key and verification result intact.
+func (suite *RedisOpenID4VPStoreTestSuite) TestSaveAndGetRequestState_RoundTrip() {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	rs := &RequestState{
+		State:        redisTestState,
+		DefinitionID: "def-1",
+		Nonce:        "nonce-1",
+		EphemeralKey: key,
+		ClientID:     "x509_san_dns:verifier.example",
+		RequestURI:   "https://verifier.example/request/state-1",
+		Status:       StatusCompleted,
+		Result: &VerifiedPresentation{
+			Subject: "sub-1",
+			Claims:  map[string]interface{}{"given_name": "Erika"},
+		},
+		ExpiresAt: time.Now().Add(5 * time.Minute),
+	}
+
+	var stored []byte
+	suite.mockClient.On("Set", context.Background(), suite.redisKey, mock.Anything,
+		mock.MatchedBy(func(ttl time.Duration) bool {
+			return ttl > 5*time.Minute && ttl <= 5*time.Minute+expiredStateRetention
+		})).
+		Run(func(args mock.Arguments) { stored = args.Get(2).([]byte) }).
+		Return(redis.NewStatusCmd(context.Background()))
+
+	suite.Require().NoError(suite.store.SaveRequestState(context.Background(), rs))
+	suite.NotContains(string(stored), "result_token")
+
+	stringCmd := redis.NewStringCmd(context.Background())
+	stringCmd.SetVal(string(stored))
+	suite.mockClient.On("Get", context.Background(), suite.redisKey).Return(stringCmd)
+
+	got, ok := suite.store.GetRequestState(context.Background(), redisTestState)
+	suite.Require().True(ok)
+	suite.Equal(rs.DefinitionID, got.DefinitionID)
+	suite.Equal(rs.Nonce, got.Nonce)
+	suite.Equal(rs.ClientID, got.ClientID)
+	suite.Equal(StatusCompleted, got.Status)
+	suite.Equal("sub-1", got.Result.Subject)
+	suite.Equal("Erika", got.Result.Claims["given_name"])
+	suite.True(rs.ExpiresAt.Equal(got.ExpiresAt))
+	suite.Require().NotNil(got.EphemeralKey)
+	suite.True(key.Equal(got.EphemeralKey))
+}
+
+// A state whose retention window has passed is deleted rather than stored with a non-positive TTL.
+func (suite *RedisOpenID4VPStoreTestSuite) TestSaveRequestState_LongExpiredDeletes() {
+	suite.mockClient.On("Del", context.Background(), suite.redisKey).
+		Return(redis.NewIntCmd(context.Background()))
+
+	err := suite.store.SaveRequestState(context.Background(), &RequestState{
+		State:     redisTestState,
+		ExpiresAt: time.Now().Add(-expiredStateRetention - time.Minute),
+	})
+	suite.NoError(err)
+	suite.mockClient.AssertNotCalled(suite.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RedisOpenID4VPStoreTestSuite) TestSaveRequestState_SetError() {
+	statusCmd := redis.NewStatusCmd(context.Background())
+	statusCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Set", context.Background(), suite.redisKey, mock.Anything, mock.Anything).
+		Return(statusCmd)
+
+	err := suite.store.SaveRequestState(context.Background(), &RequestState{
+		State:     redisTestState,
+		ExpiresAt: time.Now().Add(time.Minute),
+	})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to store request state in Redis")
+}
+
+func (suite *RedisOpenID4VPStoreTestSuite) TestGetRequestState_NotFound() {
+	stringCmd := redis.NewStringCmd(context.Background())
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("Get", context.Background(), suite.redisKey).Return(stringCmd)
+
+	got, ok := suite.store.GetRequestState(context.Background(), redisTestState)
+	suite.False(ok)
+	suite.Nil(got)
+}
+
+func (suite *RedisOpenID4VPStoreTestSuite) TestGetRequestState_InvalidJSON() {
+	stringCmd := redis.NewStringCmd(context.Background())
+	stringCmd.SetVal("not-json")
+	suite.mockClient.On("Get", context.Background(), suite.redisKey).Return(stringCmd)
+
+	got, ok := suite.store.GetRequestState(context.Background(), redisTestState)
+	suite.False(ok)
+	suite.Nil(got)
+}
+
+func (suite *RedisOpenID4VPStoreTestSuite) TestDeleteRequestState_Error() {
+	intCmd := redis.NewIntCmd(context.Background())
+	intCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Del", context.Background(), suite.redisKey).Return(intCmd)
+
+	err := suite.store.DeleteRequestState(context.Background(), redisTestState)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to delete request state from Redis")
+}
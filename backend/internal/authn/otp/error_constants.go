@@ -128,4 +128,18 @@ var (
 			DefaultValue: "An error occurred while resolving the user for the recipient",
 		},
 	}
+	// ErrorOTPResendThrottled is the error returned when an OTP is requested again before the resend interval
+	// has elapsed.
+	ErrorOTPResendThrottled = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-OTP-1009",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authnotpservice.otp_resend_throttled",
+			DefaultValue: "OTP requested too soon",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authnotpservice.otp_resend_throttled_description",
+			DefaultValue: "An OTP was sent to this recipient recently. Wait before requesting another one",
+		},
+	}
 )
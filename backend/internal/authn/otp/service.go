@@ -73,6 +73,9 @@ func (s *otpAuthnService) GenerateOTP(ctx context.Context,
 
 	sessionToken, otpValue, expirySeconds, svcErr := s.notifOTPService.GenerateOTP(ctx, recipient, recipientAttr)
 	if svcErr != nil {
+		if svcErr.Code == notification.ErrorOTPResendThrottled.Code {
+			return "", "", 0, &ErrorOTPResendThrottled
+		}
 		if svcErr.Type == tidcommon.ClientErrorType {
 			return "", "", 0, &ErrorClientErrorFromOTPService
 		}
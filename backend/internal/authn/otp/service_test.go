@@ -112,6 +112,18 @@ func (suite *OTPAuthnServiceTestSuite) TestGenerateOTPDelegatesError() {
 	suite.Equal(tidcommon.InternalServerError.Code, err.Code)
 }
 
+func (suite *OTPAuthnServiceTestSuite) TestGenerateOTPResendThrottled() {
+	suite.mockNotifOTPSvc.On("GenerateOTP",
+		mock.Anything, testRecipient, authnprovidercm.UserAttributeUserID,
+	).Return("", "", int64(0), &notification.ErrorOTPResendThrottled).Once()
+
+	_, _, _, err := suite.service.GenerateOTP(
+		context.Background(), testRecipient, authnprovidercm.UserAttributeUserID)
+
+	suite.NotNil(err)
+	suite.Equal(ErrorOTPResendThrottled.Code, err.Code)
+}
+
 // --- Authenticate tests ---
 
 func (suite *OTPAuthnServiceTestSuite) TestAuthenticateWithInvalidInputs() {
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package passkey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// boltSessionStore is the bolt-backed implementation of sessionStoreInterface.
+type boltSessionStore struct {
+	client       provider.BoltProviderInterface
+	deploymentID string
+}
+
+// newBoltSessionStore creates a new bolt-backed passkey session store.
+func newBoltSessionStore(p provider.BoltProviderInterface) sessionStoreInterface {
+	return &boltSessionStore{
+		client:       p,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// sessionKey builds the bolt key for a passkey session.
+func (s *boltSessionStore) sessionKey(key string) string {
+	return fmt.Sprintf("runtime:%s:passkey:%s", s.deploymentID, key)
+}
+
+// storeSession serializes the WebAuthn session data and stores it in bolt with a TTL.
+func (s *boltSessionStore) storeSession(
+	_ context.Context, sessionKey string, session *sessionData, expirySeconds int64) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal passkey session: %w", err)
+	}
+
+	ttl := time.Duration(expirySeconds) * time.Second
+	if err := s.client.Set(s.sessionKey(sessionKey), data, ttl); err != nil {
+		return fmt.Errorf("failed to store passkey session in bolt: %w", err)
+	}
+
+	return nil
+}
+
+// retrieveSession retrieves the WebAuthn session data from bolt.
+func (s *boltSessionStore) retrieveSession(_ context.Context, sessionKey string) (*sessionData, error) {
+	if sessionKey == "" {
+		return nil, nil
+	}
+
+	data, err := s.client.Get(s.sessionKey(sessionKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get passkey session from bolt: %w", err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var result sessionData
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal passkey session: %w", err)
+	}
+
+	return &result, nil
+}
+
+// deleteSession removes the passkey session from bolt.
+func (s *boltSessionStore) deleteSession(_ context.Context, sessionKey string) error {
+	if sessionKey == "" {
+		return nil
+	}
+
+	if err := s.client.Del(s.sessionKey(sessionKey)); err != nil {
+		return fmt.Errorf("failed to delete passkey session from bolt: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package passkey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider/bolttest"
+)
+
+type BoltSessionStoreTestSuite struct {
+	suite.Suite
+	store *boltSessionStore
+	ctx   context.Context
+}
+
+func TestBoltSessionStoreSuite(t *testing.T) {
+	suite.Run(t, new(BoltSessionStoreTestSuite))
+}
+
+func (suite *BoltSessionStoreTestSuite) SetupTest() {
+	suite.store = &boltSessionStore{
+		client:       bolttest.NewProvider(suite.T()),
+		deploymentID: "test-deployment",
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *BoltSessionStoreTestSuite) TestSessionKey() {
+	suite.Equal("runtime:test-deployment:passkey:"+testSessionKey, suite.store.sessionKey(testSessionKey))
+}
+
+func (suite *BoltSessionStoreTestSuite) TestSessionLifecycle() {
+	session := &sessionData{
+		Challenge:        "test-challenge",
+		UserID:           []byte("test-user-id"),
+		UserVerification: "preferred",
+	}
+
+	suite.Require().NoError(suite.store.storeSession(suite.ctx, testSessionKey, session, 60))
+
+	result, err := suite.store.retrieveSession(suite.ctx, testSessionKey)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	suite.Equal(session.Challenge, result.Challenge)
+	suite.Equal(session.UserID, result.UserID)
+
+	suite.Require().NoError(suite.store.deleteSession(suite.ctx, testSessionKey))
+
+	result, err = suite.store.retrieveSession(suite.ctx, testSessionKey)
+	suite.NoError(err)
+	suite.Nil(result)
+}
+
+func (suite *BoltSessionStoreTestSuite) TestEmptySessionKey() {
+	result, err := suite.store.retrieveSession(suite.ctx, "")
+	suite.NoError(err)
+	suite.Nil(result)
+	suite.NoError(suite.store.deleteSession(suite.ctx, ""))
+}
@@ -27,9 +27,12 @@ import (
 // Initialize initializes the WebAuthn authentication service.
 func Initialize(entitySvc entity.EntityServiceInterface) PasskeyServiceInterface {
 	var store sessionStoreInterface
-	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+	switch config.GetServerRuntime().Config.Database.Runtime.Type {
+	case provider.DataSourceTypeRedis:
 		store = newRedisSessionStore(provider.GetRedisProvider())
-	} else {
+	case provider.DataSourceTypeBolt:
+		store = newBoltSessionStore(provider.GetBoltProvider())
+	default:
 		store = newSessionStore()
 	}
 
@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	apnsLoggerComponentName = "APNsPushSender"
+	apnsProductionEndpoint  = "https://api.push.apple.com"
+	apnsSandboxEndpoint     = "https://api.sandbox.push.apple.com"
+	apnsDevicePath          = "/3/device/"
+	// apnsTokenLifetime is how long a provider token is reused. APNs rejects tokens older than an hour
+	// and throttles tokens refreshed more often than every twenty minutes.
+	apnsTokenLifetime = 50 * time.Minute
+	// apnsCategory is the notification category the app registers its approve and deny actions for.
+	apnsCategory = "PUSH_AUTH_CHALLENGE"
+)
+
+// apnsConfig holds the settings of the APNs sender.
+type apnsConfig struct {
+	teamID     string
+	keyID      string
+	topic      string
+	endpoint   string
+	production bool
+}
+
+// apnsAlert is the displayed part of an APNs notification.
+type apnsAlert struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// apnsAps is the Apple defined dictionary of an APNs payload.
+type apnsAps struct {
+	Alert    apnsAlert `json:"alert"`
+	Sound    string    `json:"sound"`
+	Category string    `json:"category"`
+}
+
+// apnsSender sends push notifications through the Apple Push Notification service, authorized with
+// provider tokens signed by a token signing key.
+type apnsSender struct {
+	config        apnsConfig
+	privateKey    *ecdsa.PrivateKey
+	ttl           time.Duration
+	httpClient    syshttp.HTTPClientInterface
+	now           func() time.Time
+	mu            sync.Mutex
+	providerToken string
+	issuedAt      time.Time
+}
+
+// newAPNsSender creates a new instance of apnsSender from the PEM encoded token signing key.
+// Notifications that cannot be delivered within ttl are dropped by APNs.
+func newAPNsSender(config apnsConfig, signingKey []byte, ttl time.Duration) (pushSenderInterface, error) {
+	if config.teamID == "" || config.keyID == "" || config.topic == "" {
+		return nil, errors.New("the APNs team ID, key ID and topic are required")
+	}
+	privateKey, err := parseECPrivateKey(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	if config.endpoint == "" {
+		config.endpoint = apnsSandboxEndpoint
+		if config.production {
+			config.endpoint = apnsProductionEndpoint
+		}
+	}
+	config.endpoint = strings.TrimRight(config.endpoint, "/")
+	return &apnsSender{
+		config:     config,
+		privateKey: privateKey,
+		ttl:        ttl,
+		httpClient: syshttp.NewHTTP2ClientWithTimeout(httpTimeout),
+		now:        time.Now,
+	}, nil
+}
+
+// Send delivers the notification as an alert with immediate priority. The notification data is sent
+// as custom keys of the payload.
+func (s *apnsSender) Send(ctx context.Context, pushToken string, notification Notification) error {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, apnsLoggerComponentName))
+
+	providerToken, err := s.getProviderToken()
+	if err != nil {
+		return err
+	}
+
+	body := make(map[string]interface{}, len(notification.Data)+1)
+	for key, value := range notification.Data {
+		body[key] = value
+	}
+	body["aps"] = apnsAps{
+		Alert:    apnsAlert{Title: notification.Title, Body: notification.Body},
+		Sound:    "default",
+		Category: apnsCategory,
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the APNs payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		s.config.endpoint+apnsDevicePath+url.PathEscape(pushToken), bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create the APNs request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", s.config.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+	if s.ttl > 0 {
+		req.Header.Set("apns-expiration", strconv.FormatInt(s.now().Add(s.ttl).Unix(), 10))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send the APNs request: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.Error(ctx, "Failed to close response body", log.Error(closeErr))
+		}
+	}()
+	if err := checkPushResponse(resp, "apns"); err != nil {
+		return err
+	}
+
+	logger.Debug(ctx, "Push notification sent via APNs", log.String("apnsId", resp.Header.Get("apns-id")))
+	return nil
+}
+
+// getProviderToken returns the cached provider token, or signs a new one when it is about to expire.
+func (s *apnsSender) getProviderToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.providerToken != "" && now.Before(s.issuedAt.Add(apnsTokenLifetime)) {
+		return s.providerToken, nil
+	}
+	token, err := signJWT(map[string]interface{}{"alg": "ES256", "kid": s.config.keyID},
+		map[string]interface{}{"iss": s.config.teamID, "iat": now.Unix()}, cryptolib.ECDSASHA256, s.privateKey)
+	if err != nil {
+		return "", err
+	}
+	s.providerToken = token
+	s.issuedAt = now
+	return token, nil
+}
+
+// parseECPrivateKey parses a PEM encoded PKCS #8 or SEC 1 P-256 private key, such as an APNs .p8 key.
+func parseECPrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode the PEM encoded private key")
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the private key: %w", err)
+	}
+	key, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok || key.Curve.Params().BitSize != 256 {
+		return nil, errors.New("the private key is not a P-256 EC key")
+	}
+	return key, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import "time"
+
+const (
+	// maxDevicesPerUser is the number of push devices a user can register.
+	maxDevicesPerUser = 10
+	// defaultChallengeTTL is how long an approval request can be answered when not configured.
+	defaultChallengeTTL = 2 * time.Minute
+	// defaultLongPoll is how long a poll waits for a decision when not configured.
+	defaultLongPoll = 10 * time.Second
+	// decisionPollInterval is how often a waiting poll re-reads the approval request.
+	decisionPollInterval = 500 * time.Millisecond
+	// minMatchNumber and maxMatchNumber bound the number the user must select on the device.
+	minMatchNumber = 10
+	maxMatchNumber = 99
+	// maxDeviceNameLength is the maximum length of a device name.
+	maxDeviceNameLength = 100
+	// maxPushTokenLength is the maximum length of a push token.
+	maxPushTokenLength = 4096
+	// httpTimeout is the timeout of the requests to the push services.
+	httpTimeout = 10 * time.Second
+	// maxProviderResponseSize is the maximum number of bytes read from an error response of a push service.
+	maxProviderResponseSize = 4096
+)
+
+// Platforms a push device can be registered for.
+const (
+	// PlatformFCM is an Android or web device reached through Firebase Cloud Messaging.
+	PlatformFCM = "fcm"
+	// PlatformAPNs is an Apple device reached through the Apple Push Notification service.
+	PlatformAPNs = "apns"
+)
+
+// Route paths of the push authentication endpoints.
+const (
+	selfDevicesPath       = "/users/me/push-devices"
+	selfDevicePath        = "/users/me/push-devices/{deviceId}"
+	challengeDecisionPath = "/push-auth/challenges/{challengeId}/decision"
+)
+
+// Data keys of the approval request notification.
+const (
+	notificationKeyChallengeID     = "challengeId"
+	notificationKeyApplicationName = "applicationName"
+	notificationKeyClientIP        = "clientIp"
+	notificationKeyExpiresAt       = "expiresAt"
+)
+
+// Reasons an approval request was denied.
+const (
+	// DenyReasonRejected means the user rejected the request on the device.
+	DenyReasonRejected = "REJECTED"
+	// DenyReasonNumberMismatch means the user selected a number other than the one shown at sign-in.
+	DenyReasonNumberMismatch = "NUMBER_MISMATCH"
+)
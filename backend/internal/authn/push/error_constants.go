@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"errors"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for push authentication operations.
+var (
+	// ErrorInvalidRequestFormat is returned when the request body is malformed.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidDeviceName is returned when the device name is empty or too long.
+	ErrorInvalidDeviceName = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.invalid_device_name",
+			DefaultValue: "Invalid device name",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.invalid_device_name_description",
+			DefaultValue: "The device name must be between 1 and 100 characters",
+		},
+	}
+
+	// ErrorUnsupportedPlatform is returned when push notifications are not configured for the platform.
+	ErrorUnsupportedPlatform = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.unsupported_platform",
+			DefaultValue: "Unsupported platform",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.unsupported_platform_description",
+			DefaultValue: "Push notifications are not configured for the requested platform",
+		},
+	}
+
+	// ErrorInvalidPushToken is returned when the push token is empty or too long.
+	ErrorInvalidPushToken = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.invalid_push_token",
+			DefaultValue: "Invalid push token",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.invalid_push_token_description",
+			DefaultValue: "The push token is missing or too long",
+		},
+	}
+
+	// ErrorDeviceLimitReached is returned when the user has registered the maximum number of devices.
+	ErrorDeviceLimitReached = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.device_limit_reached",
+			DefaultValue: "Device limit reached",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.device_limit_reached_description",
+			DefaultValue: "The maximum number of push devices is already registered; remove a device first",
+		},
+	}
+
+	// ErrorDeviceNotFound is returned when the device is not registered for the user.
+	ErrorDeviceNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.device_not_found",
+			DefaultValue: "Device not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.device_not_found_description",
+			DefaultValue: "The push device is not registered for the user",
+		},
+	}
+
+	// ErrorNoRegisteredDevice is returned when the user has not registered a push device.
+	ErrorNoRegisteredDevice = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.no_registered_device",
+			DefaultValue: "No registered device",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.no_registered_device_description",
+			DefaultValue: "The user has not registered a device for push notifications",
+		},
+	}
+
+	// ErrorChallengeNotFound is returned when the approval request is unknown or has expired.
+	ErrorChallengeNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.challenge_not_found",
+			DefaultValue: "Approval request not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.challenge_not_found_description",
+			DefaultValue: "The approval request is unknown or has expired",
+		},
+	}
+
+	// ErrorInvalidDeviceCredentials is returned when the device ID or secret of a decision is not valid
+	// for the request.
+	ErrorInvalidDeviceCredentials = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1009",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.invalid_device_credentials",
+			DefaultValue: "Invalid device credentials",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.invalid_device_credentials_description",
+			DefaultValue: "The device is not allowed to decide the approval request",
+		},
+	}
+
+	// ErrorChallengeAlreadyDecided is returned when a decision is sent for a request that was already decided.
+	ErrorChallengeAlreadyDecided = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1010",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.challenge_already_decided",
+			DefaultValue: "Approval request already decided",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.challenge_already_decided_description",
+			DefaultValue: "The approval request was already approved or denied",
+		},
+	}
+
+	// ErrorNumberMismatch is returned when the number selected on the device does not match the sign-in
+	// screen. The request is denied.
+	ErrorNumberMismatch = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1011",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.number_mismatch",
+			DefaultValue: "Number mismatch",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.number_mismatch_description",
+			DefaultValue: "The selected number does not match the number shown at sign-in; the request was denied",
+		},
+	}
+
+	// ErrorChallengeNotApproved is returned when an approval request that was not approved is used to authenticate.
+	ErrorChallengeNotApproved = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "PSH-1012",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.challenge_not_approved",
+			DefaultValue: "Approval request not approved",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.pushauthservice.challenge_not_approved_description",
+			DefaultValue: "The approval request was not approved on a registered device",
+		},
+	}
+)
+
+// Errors returned while sending push notifications.
+var (
+	// errPushRejected is returned when the push service rejects a notification.
+	errPushRejected = errors.New("push notification rejected by the push service")
+)
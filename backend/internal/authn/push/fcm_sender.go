@@ -0,0 +1,271 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	fcmLoggerComponentName = "FCMPushSender"
+	fcmDefaultEndpointURL  = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+	fcmDefaultTokenURI     = "https://oauth2.googleapis.com/token"
+	fcmMessagingScope      = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmJWTBearerGrantType  = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	// fcmAssertionLifetime is the lifetime of the service account assertion exchanged for an access token.
+	fcmAssertionLifetime = time.Hour
+	// fcmTokenRefreshMargin is how long before its expiry an access token is replaced.
+	fcmTokenRefreshMargin = time.Minute
+)
+
+// fcmServiceAccount holds the fields of a Google service account key used to obtain access tokens.
+type fcmServiceAccount struct {
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyID string `json:"private_key_id"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// fcmTokenResponse is the response of the Google OAuth 2.0 token endpoint.
+type fcmTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// fcmMessage is the request body of the FCM HTTP v1 send API.
+type fcmMessage struct {
+	Message fcmMessageBody `json:"message"`
+}
+
+// fcmMessageBody is the message of an FCM HTTP v1 send request.
+type fcmMessageBody struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+	Android      fcmAndroidConfig  `json:"android"`
+}
+
+// fcmNotification is the displayed part of an FCM message.
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// fcmAndroidConfig holds the Android delivery options of an FCM message.
+type fcmAndroidConfig struct {
+	Priority string `json:"priority"`
+	TTL      string `json:"ttl,omitempty"`
+}
+
+// fcmSender sends push notifications through the Firebase Cloud Messaging HTTP v1 API, authorized
+// with access tokens obtained for a service account.
+type fcmSender struct {
+	endpoint    string
+	account     fcmServiceAccount
+	privateKey  *rsa.PrivateKey
+	ttl         time.Duration
+	httpClient  syshttp.HTTPClientInterface
+	now         func() time.Time
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+// newFCMSender creates a new instance of fcmSender from the service account key. Notifications
+// that cannot be delivered within ttl are dropped by FCM.
+func newFCMSender(projectID, endpoint string, serviceAccountKey []byte, ttl time.Duration) (pushSenderInterface,
+	error) {
+	var account fcmServiceAccount
+	if err := json.Unmarshal(serviceAccountKey, &account); err != nil {
+		return nil, fmt.Errorf("failed to parse the FCM service account key: %w", err)
+	}
+	if account.ClientEmail == "" || account.PrivateKey == "" {
+		return nil, errors.New("the FCM service account key must contain client_email and private_key")
+	}
+	privateKey, err := parseRSAPrivateKey([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = fcmDefaultTokenURI
+	}
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(fcmDefaultEndpointURL, url.PathEscape(projectID))
+	}
+	return &fcmSender{
+		endpoint:   endpoint,
+		account:    account,
+		privateKey: privateKey,
+		ttl:        ttl,
+		httpClient: syshttp.NewHTTPClientWithTimeout(httpTimeout),
+		now:        time.Now,
+	}, nil
+}
+
+// Send delivers the notification through the FCM HTTP v1 API with high priority, so that it reaches
+// the device while the approval request can still be answered.
+func (s *fcmSender) Send(ctx context.Context, pushToken string, notification Notification) error {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, fcmLoggerComponentName))
+
+	accessToken, err := s.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	android := fcmAndroidConfig{Priority: "high"}
+	if s.ttl > 0 {
+		android.TTL = fmt.Sprintf("%ds", int64(s.ttl.Seconds()))
+	}
+	payload, err := json.Marshal(fcmMessage{Message: fcmMessageBody{
+		Token:        pushToken,
+		Notification: fcmNotification{Title: notification.Title, Body: notification.Body},
+		Data:         notification.Data,
+		Android:      android,
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal the FCM message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create the FCM request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send the FCM request: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.Error(ctx, "Failed to close response body", log.Error(closeErr))
+		}
+	}()
+	if resp.StatusCode == http.StatusUnauthorized {
+		s.clearAccessToken()
+	}
+	if err := checkPushResponse(resp, "fcm"); err != nil {
+		return err
+	}
+
+	logger.Debug(ctx, "Push notification sent via FCM")
+	return nil
+}
+
+// getAccessToken returns a cached access token, or exchanges a new service account assertion for one
+// when the cached token is missing or about to expire.
+func (s *fcmSender) getAccessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.accessToken != "" && now.Add(fcmTokenRefreshMargin).Before(s.tokenExpiry) {
+		return s.accessToken, nil
+	}
+
+	header := map[string]interface{}{"alg": "RS256", "typ": "JWT"}
+	if s.account.PrivateKeyID != "" {
+		header["kid"] = s.account.PrivateKeyID
+	}
+	assertion, err := signJWT(header, map[string]interface{}{
+		"iss":   s.account.ClientEmail,
+		"scope": fcmMessagingScope,
+		"aud":   s.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(fcmAssertionLifetime).Unix(),
+	}, cryptolib.RSASHA256, s.privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{"grant_type": {fcmJWTBearerGrantType}, "assertion": {assertion}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.account.TokenURI,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create the FCM token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request an FCM access token: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if err := checkPushResponse(resp, "fcm token endpoint"); err != nil {
+		return "", err
+	}
+
+	var token fcmTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode the FCM token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("the FCM token response does not contain an access token")
+	}
+
+	s.accessToken = token.AccessToken
+	s.tokenExpiry = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	return s.accessToken, nil
+}
+
+// clearAccessToken forgets the cached access token, so that the next notification obtains a new one.
+func (s *fcmSender) clearAccessToken() {
+	s.mu.Lock()
+	s.accessToken = ""
+	s.mu.Unlock()
+}
+
+// parseRSAPrivateKey parses a PEM encoded PKCS #8 or PKCS #1 RSA private key.
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode the PEM encoded private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the private key is not an RSA key")
+	}
+	return key, nil
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// pushAuthHandler serves the push device and approval request decision endpoints.
+type pushAuthHandler struct {
+	service PushAuthServiceInterface
+}
+
+// newPushAuthHandler creates a new instance of pushAuthHandler.
+func newPushAuthHandler(service PushAuthServiceInterface) *pushAuthHandler {
+	return &pushAuthHandler{service: service}
+}
+
+// HandleSelfDevicesGetRequest handles GET /users/me/push-devices.
+func (h *pushAuthHandler) HandleSelfDevicesGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := h.getSubject(w, r)
+	if !ok {
+		return
+	}
+	devices, svcErr := h.service.ListDevices(ctx, userID)
+	if svcErr != nil {
+		writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK,
+		DeviceListResponse{TotalResults: len(devices), Devices: devices})
+}
+
+// HandleSelfDevicePostRequest handles POST /users/me/push-devices.
+func (h *pushAuthHandler) HandleSelfDevicePostRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := h.getSubject(w, r)
+	if !ok {
+		return
+	}
+	request, err := sysutils.DecodeJSONBody[DeviceRegistrationRequest](r)
+	if err != nil {
+		writeServiceErrorResponse(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+	response, svcErr := h.service.RegisterDevice(ctx, userID, *request)
+	if svcErr != nil {
+		writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, response)
+}
+
+// HandleSelfDeviceDeleteRequest handles DELETE /users/me/push-devices/{deviceId}.
+func (h *pushAuthHandler) HandleSelfDeviceDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := h.getSubject(w, r)
+	if !ok {
+		return
+	}
+	if svcErr := h.service.DeleteDevice(ctx, userID, r.PathValue("deviceId")); svcErr != nil {
+		writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleChallengeDecisionRequest handles POST /push-auth/challenges/{challengeId}/decision. The
+// endpoint is public; the device authenticates with its device secret.
+func (h *pushAuthHandler) HandleChallengeDecisionRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	decision, err := sysutils.DecodeJSONBody[ChallengeDecisionRequest](r)
+	if err != nil {
+		writeServiceErrorResponse(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+	if svcErr := h.service.RespondToChallenge(ctx, r.PathValue("challengeId"), *decision); svcErr != nil {
+		writeServiceErrorResponse(ctx, w, svcErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getSubject returns the authenticated user of the request, writing an error response when there
+// is none.
+func (h *pushAuthHandler) getSubject(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		writeServiceErrorResponse(r.Context(), w, &tidcommon.ErrorUnauthorized)
+		return "", false
+	}
+	return userID, true
+}
+
+// writeServiceErrorResponse writes the service error with the matching HTTP status code.
+func writeServiceErrorResponse(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorDeviceNotFound.Code, ErrorChallengeNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorDeviceLimitReached.Code, ErrorChallengeAlreadyDecided.Code:
+			statusCode = http.StatusConflict
+		case ErrorInvalidDeviceCredentials.Code:
+			statusCode = http.StatusUnauthorized
+		case tidcommon.ErrorUnauthorized.Code:
+			statusCode = http.StatusUnauthorized
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
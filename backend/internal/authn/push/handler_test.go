@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+func newTestHandler(t *testing.T) (*pushAuthHandler, *pushSenderInterfaceMock) {
+	t.Helper()
+	sender := newPushSenderInterfaceMock(t)
+	svc := newPushAuthService(newPushStore(inmemory.Initialize("test")),
+		map[string]pushSenderInterface{PlatformFCM: sender}, time.Minute, time.Millisecond)
+	return newPushAuthHandler(svc), sender
+}
+
+func newSelfRequest(method, target string, body []byte) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	authCtx := security.NewSecurityContextForTest(testUserID, "", "", nil, nil)
+	return req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+}
+
+func registerTestDevice(t *testing.T, handler *pushAuthHandler) DeviceRegistrationResponse {
+	t.Helper()
+	body, _ := json.Marshal(DeviceRegistrationRequest{Name: "Phone", Platform: PlatformFCM, PushToken: "fcm-token"})
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfDevicePostRequest(rr, newSelfRequest(http.MethodPost, "/users/me/push-devices", body))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	require.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	var resp DeviceRegistrationResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	return resp
+}
+
+func decodeErrorCode(t *testing.T, rr *httptest.ResponseRecorder) string {
+	t.Helper()
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	return errResp.Code
+}
+
+func TestHandleSelfDevices_RegisterListDelete(t *testing.T) {
+	handler, _ := newTestHandler(t)
+	registered := registerTestDevice(t, handler)
+	require.NotEmpty(t, registered.DeviceSecret)
+
+	rr := httptest.NewRecorder()
+	handler.HandleSelfDevicesGetRequest(rr, newSelfRequest(http.MethodGet, "/users/me/push-devices", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NotContains(t, rr.Body.String(), registered.DeviceSecret)
+	require.NotContains(t, rr.Body.String(), "fcm-token")
+	var list DeviceListResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+	require.Equal(t, 1, list.TotalResults)
+	require.Equal(t, registered.Device.ID, list.Devices[0].ID)
+
+	req := newSelfRequest(http.MethodDelete, "/users/me/push-devices/"+registered.Device.ID, nil)
+	req.SetPathValue("deviceId", registered.Device.ID)
+	rr = httptest.NewRecorder()
+	handler.HandleSelfDeviceDeleteRequest(rr, req)
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = httptest.NewRecorder()
+	handler.HandleSelfDeviceDeleteRequest(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	require.Equal(t, ErrorDeviceNotFound.Code, decodeErrorCode(t, rr))
+}
+
+func TestHandleSelfDevicePostRequest_InvalidRequest(t *testing.T) {
+	handler, _ := newTestHandler(t)
+
+	rr := httptest.NewRecorder()
+	handler.HandleSelfDevicePostRequest(rr, newSelfRequest(http.MethodPost, "/users/me/push-devices",
+		[]byte("{invalid")))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Equal(t, ErrorInvalidRequestFormat.Code, decodeErrorCode(t, rr))
+
+	body, _ := json.Marshal(DeviceRegistrationRequest{Name: "Phone", Platform: PlatformAPNs, PushToken: "t"})
+	rr = httptest.NewRecorder()
+	handler.HandleSelfDevicePostRequest(rr, newSelfRequest(http.MethodPost, "/users/me/push-devices", body))
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Equal(t, ErrorUnsupportedPlatform.Code, decodeErrorCode(t, rr))
+}
+
+func TestHandleSelfDevicesGetRequest_Unauthorized(t *testing.T) {
+	handler, _ := newTestHandler(t)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfDevicesGetRequest(rr, httptest.NewRequest(http.MethodGet, "/users/me/push-devices", nil))
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestHandleChallengeDecisionRequest(t *testing.T) {
+	handler, sender := newTestHandler(t)
+	registered := registerTestDevice(t, handler)
+	sender.On("Send", mock.Anything, "fcm-token", mock.Anything).Return(nil)
+	challenge, svcErr := handler.service.InitiateChallenge(context.Background(), testUserID, ChallengeContext{})
+	require.Nil(t, svcErr)
+
+	decide := func(decision ChallengeDecisionRequest) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(decision)
+		req := httptest.NewRequest(http.MethodPost, "/push-auth/challenges/"+challenge.ID+"/decision",
+			bytes.NewReader(body))
+		req.SetPathValue("challengeId", challenge.ID)
+		rr := httptest.NewRecorder()
+		handler.HandleChallengeDecisionRequest(rr, req)
+		return rr
+	}
+
+	rr := decide(ChallengeDecisionRequest{DeviceID: registered.Device.ID, DeviceSecret: "wrong", Approved: true})
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+	require.Equal(t, ErrorInvalidDeviceCredentials.Code, decodeErrorCode(t, rr))
+
+	approval := ChallengeDecisionRequest{DeviceID: registered.Device.ID, DeviceSecret: registered.DeviceSecret,
+		Approved: true, Number: challenge.Number}
+	rr = decide(approval)
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = decide(approval)
+	require.Equal(t, http.StatusConflict, rr.Code)
+	require.Equal(t, ErrorChallengeAlreadyDecided.Code, decodeErrorCode(t, rr))
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize wires the push authentication service and registers its routes. A sender is created
+// for each configured push service; devices cannot be registered while none is configured.
+func Initialize(mux *http.ServeMux, storeProvider providers.RuntimeStoreProvider) (PushAuthServiceInterface,
+	error) {
+	runtime := config.GetServerRuntime()
+	pushConfig := runtime.Config.PushAuth
+	challengeTTL := time.Duration(pushConfig.ChallengeTTLSeconds) * time.Second
+	if challengeTTL <= 0 {
+		challengeTTL = defaultChallengeTTL
+	}
+
+	senders, err := newSendersFromConfig(pushConfig, runtime.ServerHome, challengeTTL)
+	if err != nil {
+		return nil, err
+	}
+	service := newPushAuthService(newPushStore(storeProvider), senders, challengeTTL,
+		time.Duration(pushConfig.LongPollSeconds)*time.Second)
+	registerRoutes(mux, newPushAuthHandler(service))
+	return service, nil
+}
+
+// newSendersFromConfig creates the senders of the configured push services, keyed by platform.
+func newSendersFromConfig(pushConfig config.PushAuthConfig, serverHome string,
+	ttl time.Duration) (map[string]pushSenderInterface, error) {
+	senders := make(map[string]pushSenderInterface)
+	if pushConfig.FCM.ProjectID != "" {
+		key, err := readKeyFile(serverHome, pushConfig.FCM.ServiceAccountFile)
+		if err != nil {
+			return nil, err
+		}
+		sender, err := newFCMSender(pushConfig.FCM.ProjectID, pushConfig.FCM.Endpoint, key, ttl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the FCM push sender: %w", err)
+		}
+		senders[PlatformFCM] = sender
+	}
+	if pushConfig.APNs.TeamID != "" {
+		key, err := readKeyFile(serverHome, pushConfig.APNs.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		sender, err := newAPNsSender(apnsConfig{
+			teamID:     pushConfig.APNs.TeamID,
+			keyID:      pushConfig.APNs.KeyID,
+			topic:      pushConfig.APNs.Topic,
+			endpoint:   pushConfig.APNs.Endpoint,
+			production: pushConfig.APNs.Production,
+		}, key, ttl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the APNs push sender: %w", err)
+		}
+		senders[PlatformAPNs] = sender
+	}
+	return senders, nil
+}
+
+// readKeyFile reads a key file, resolving a relative path against the server home.
+func readKeyFile(serverHome, path string) ([]byte, error) {
+	if !filepath.IsAbs(path) && serverHome != "" {
+		path = filepath.Join(serverHome, path)
+	}
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the push key file %q: %w", path, err)
+	}
+	return data, nil
+}
+
+// registerRoutes registers the push device and decision routes. The decision route is called by
+// the authenticator app rather than browsers, so it is registered without CORS.
+func registerRoutes(mux *http.ServeMux, handler *pushAuthHandler) {
+	mux.HandleFunc("POST "+challengeDecisionPath, handler.HandleChallengeDecisionRequest)
+
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+selfDevicesPath, handler.HandleSelfDevicesGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("POST "+selfDevicesPath, handler.HandleSelfDevicePostRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+selfDevicePath, handler.HandleSelfDeviceDeleteRequest, opts))
+	for _, path := range []string{selfDevicesPath, selfDevicePath} {
+		mux.HandleFunc(middleware.WithCORS("OPTIONS "+path,
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}, opts))
+	}
+}
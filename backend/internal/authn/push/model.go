@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import "time"
+
+// PushDevice is a device registered by a user to approve sign-ins.
+type PushDevice struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Platform   string     `json:"platform"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// storedDevice is the stored form of a push device. PushToken is the FCM registration token or the
+// APNs device token of the app installation, and SecretHash is the hash of the secret the device
+// authenticates its decisions with.
+type storedDevice struct {
+	PushDevice
+	PushToken  string `json:"pushToken"`
+	SecretHash string `json:"secretHash"`
+}
+
+// DeviceRegistrationRequest is the request body for registering a push device.
+type DeviceRegistrationRequest struct {
+	Name      string `json:"name"`
+	Platform  string `json:"platform"`
+	PushToken string `json:"pushToken"`
+}
+
+// DeviceRegistrationResponse is the response body for registering a push device. The device secret
+// is returned only once; the app keeps it to authenticate its decisions.
+type DeviceRegistrationResponse struct {
+	Device       PushDevice `json:"device"`
+	DeviceSecret string     `json:"deviceSecret"`
+}
+
+// DeviceListResponse is the response body for listing the push devices of a user.
+type DeviceListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	Devices      []PushDevice `json:"devices"`
+}
+
+// ChallengeStatus is the lifecycle status of an approval request.
+type ChallengeStatus string
+
+// Status values of an approval request.
+const (
+	ChallengeStatusPending  ChallengeStatus = "PENDING"
+	ChallengeStatusApproved ChallengeStatus = "APPROVED"
+	ChallengeStatusDenied   ChallengeStatus = "DENIED"
+	ChallengeStatusExpired  ChallengeStatus = "EXPIRED"
+)
+
+// Challenge is an approval request sent to the push devices of a user.
+type Challenge struct {
+	ID     string `json:"id"`
+	UserID string `json:"userId"`
+	// Number is shown on the sign-in screen and must be selected on the device to approve the request.
+	// It is never sent to the device, so a user approving a request they did not start cannot guess it.
+	Number int             `json:"number"`
+	Status ChallengeStatus `json:"status"`
+	// DeviceID is the device that decided the request.
+	DeviceID   string    `json:"deviceId,omitempty"`
+	DenyReason string    `json:"denyReason,omitempty"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// ChallengeContext describes the sign-in an approval request is sent for, so that the user can
+// recognize it on the device.
+type ChallengeContext struct {
+	ApplicationName string
+	ClientIP        string
+}
+
+// ChallengeDecisionRequest is the request body a device sends to approve or deny a request.
+type ChallengeDecisionRequest struct {
+	DeviceID     string `json:"deviceId"`
+	DeviceSecret string `json:"deviceSecret"`
+	Approved     bool   `json:"approved"`
+	// Number is the number the user selected on the device. It is required to approve the request.
+	Number int `json:"number,omitempty"`
+}
+
+// Notification is a push notification sent to a device.
+type Notification struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+)
+
+// pushSenderInterface sends push notifications through the push service of a platform.
+type pushSenderInterface interface {
+	// Send delivers the notification to the app installation identified by the push token.
+	Send(ctx context.Context, pushToken string, notification Notification) error
+}
+
+// signJWT creates a compact JWS of the claims signed with the private key.
+func signJWT(header, claims map[string]interface{}, alg cryptolib.SignAlgorithm,
+	privateKey crypto.PrivateKey) (string, error) {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT header: %w", err)
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT claims: %w", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsJSON)
+	signature, err := cryptolib.Generate([]byte(signingInput), alg, privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign JWT: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// checkPushResponse returns an error carrying the response body when the push service did not accept
+// the request.
+func checkPushResponse(resp *http.Response, service string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxProviderResponseSize))
+	return fmt.Errorf("%w: %s returned status %d: %s", errPushRejected, service, resp.StatusCode, string(body))
+}
@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+var testNotification = Notification{
+	Title: "Sign-in request",
+	Body:  "Approve the sign-in",
+	Data:  map[string]string{notificationKeyChallengeID: "challenge-1"},
+}
+
+// initTestServerRuntime initializes the server runtime the HTTP clients of the senders read.
+func initTestServerRuntime(t *testing.T) {
+	t.Helper()
+	require.NoError(t, config.InitializeServerRuntime("", &config.Config{}))
+}
+
+func newTestServiceAccountKey(t *testing.T, tokenURI string) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: mustMarshalPKCS8(t, key)})
+	account, err := json.Marshal(fcmServiceAccount{
+		ClientEmail:  "push@project.iam.gserviceaccount.com",
+		PrivateKey:   string(keyPEM),
+		PrivateKeyID: "key-1",
+		TokenURI:     tokenURI,
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func mustMarshalPKCS8(t *testing.T, key interface{}) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return der
+}
+
+// decodeJWTPart decodes a base64url encoded JWT header or claims segment.
+func decodeJWTPart(t *testing.T, part string) map[string]interface{} {
+	t.Helper()
+	raw, err := base64.RawURLEncoding.DecodeString(part)
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	return decoded
+}
+
+func TestFCMSender_Send(t *testing.T) {
+	initTestServerRuntime(t)
+	var tokenRequests atomic.Int32
+	var sent fcmMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			tokenRequests.Add(1)
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, fcmJWTBearerGrantType, r.PostForm.Get("grant_type"))
+			parts := strings.Split(r.PostForm.Get("assertion"), ".")
+			require.Len(t, parts, 3)
+			require.Equal(t, "key-1", decodeJWTPart(t, parts[0])["kid"])
+			require.Equal(t, fcmMessagingScope, decodeJWTPart(t, parts[1])["scope"])
+			_, _ = w.Write([]byte(`{"access_token":"access-1","expires_in":3600}`))
+		case "/send":
+			require.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&sent))
+			_, _ = w.Write([]byte(`{"name":"projects/p/messages/1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	sender, err := newFCMSender("project", server.URL+"/send",
+		newTestServiceAccountKey(t, server.URL+"/token"), 2*time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, sender.Send(context.Background(), "device-token", testNotification))
+	require.NoError(t, sender.Send(context.Background(), "device-token", testNotification))
+
+	require.Equal(t, int32(1), tokenRequests.Load(), "the access token must be reused")
+	require.Equal(t, "device-token", sent.Message.Token)
+	require.Equal(t, testNotification.Title, sent.Message.Notification.Title)
+	require.Equal(t, "challenge-1", sent.Message.Data[notificationKeyChallengeID])
+	require.Equal(t, "high", sent.Message.Android.Priority)
+	require.Equal(t, "120s", sent.Message.Android.TTL)
+}
+
+func TestFCMSender_SendRejected(t *testing.T) {
+	initTestServerRuntime(t)
+	var tokenRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			tokenRequests.Add(1)
+			_, _ = w.Write([]byte(`{"access_token":"access-1","expires_in":3600}`))
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":{"status":"UNAUTHENTICATED"}}`))
+	}))
+	defer server.Close()
+
+	sender, err := newFCMSender("project", server.URL+"/send",
+		newTestServiceAccountKey(t, server.URL+"/token"), 0)
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), "device-token", testNotification)
+	require.ErrorIs(t, err, errPushRejected)
+	require.Contains(t, err.Error(), "UNAUTHENTICATED")
+
+	// A rejected access token is not reused.
+	_ = sender.Send(context.Background(), "device-token", testNotification)
+	require.Equal(t, int32(2), tokenRequests.Load())
+}
+
+func TestNewFCMSender_InvalidServiceAccountKey(t *testing.T) {
+	_, err := newFCMSender("project", "", []byte("not json"), 0)
+	require.Error(t, err)
+
+	_, err = newFCMSender("project", "", []byte(`{"client_email":"a@b"}`), 0)
+	require.Error(t, err)
+
+	_, err = newFCMSender("project", "", []byte(`{"client_email":"a@b","private_key":"not a key"}`), 0)
+	require.Error(t, err)
+}
+
+func TestAPNsSender_Send(t *testing.T) {
+	initTestServerRuntime(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: mustMarshalPKCS8(t, key)})
+
+	var request *http.Request
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.Header().Set("apns-id", "apns-1")
+	}))
+	defer server.Close()
+
+	sender, err := newAPNsSender(apnsConfig{
+		teamID: "TEAM123456", keyID: "KEY1234567", topic: "com.example.authenticator", endpoint: server.URL + "/",
+	}, keyPEM, time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, sender.Send(context.Background(), "device-token", testNotification))
+
+	require.Equal(t, apnsDevicePath+"device-token", request.URL.Path)
+	require.Equal(t, "com.example.authenticator", request.Header.Get("apns-topic"))
+	require.Equal(t, "alert", request.Header.Get("apns-push-type"))
+	require.Equal(t, "10", request.Header.Get("apns-priority"))
+	require.NotEmpty(t, request.Header.Get("apns-expiration"))
+	require.Equal(t, "challenge-1", payload[notificationKeyChallengeID])
+	aps := payload["aps"].(map[string]interface{})
+	require.Equal(t, apnsCategory, aps["category"])
+	require.Equal(t, testNotification.Body, aps["alert"].(map[string]interface{})["body"])
+
+	// The provider token is an ES256 JWS signed with the token signing key.
+	token := strings.TrimPrefix(request.Header.Get("Authorization"), "bearer ")
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	require.Equal(t, "KEY1234567", decodeJWTPart(t, parts[0])["kid"])
+	require.Equal(t, "TEAM123456", decodeJWTPart(t, parts[1])["iss"])
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	require.Len(t, signature, 64)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	require.True(t, ecdsa.Verify(&key.PublicKey, digest[:],
+		new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])))
+}
+
+func TestAPNsSender_SendRejected(t *testing.T) {
+	initTestServerRuntime(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusGone)
+		_, _ = w.Write([]byte(`{"reason":"Unregistered"}`))
+	}))
+	defer server.Close()
+
+	sender, err := newAPNsSender(apnsConfig{teamID: "TEAM", keyID: "KEY", topic: "topic", endpoint: server.URL},
+		keyPEM, 0)
+	require.NoError(t, err)
+
+	err = sender.Send(context.Background(), "device-token", testNotification)
+	require.True(t, errors.Is(err, errPushRejected))
+	require.Contains(t, err.Error(), "Unregistered")
+}
+
+func TestNewAPNsSender_InvalidConfig(t *testing.T) {
+	initTestServerRuntime(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: mustMarshalPKCS8(t, rsaKey)})
+
+	_, err = newAPNsSender(apnsConfig{teamID: "TEAM", keyID: "KEY"}, rsaPEM, 0)
+	require.Error(t, err)
+
+	_, err = newAPNsSender(apnsConfig{teamID: "TEAM", keyID: "KEY", topic: "topic"}, rsaPEM, 0)
+	require.Error(t, err)
+}
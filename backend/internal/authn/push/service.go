@@ -0,0 +1,410 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package push implements push notification authentication. Users register the devices their
+// authenticator app runs on, and a sign-in is approved on one of those devices by selecting the
+// number shown on the sign-in screen.
+package push
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// PushAuthServiceInterface defines the interface for push notification authentication.
+type PushAuthServiceInterface interface {
+	// RegisterDevice registers a device of the user for approval requests. The returned device secret
+	// authenticates the decisions of the device and cannot be retrieved again.
+	RegisterDevice(ctx context.Context, userID string, request DeviceRegistrationRequest) (
+		*DeviceRegistrationResponse, *tidcommon.ServiceError)
+
+	// ListDevices returns the push devices of the user, most recently registered first.
+	ListDevices(ctx context.Context, userID string) ([]PushDevice, *tidcommon.ServiceError)
+
+	// DeleteDevice removes a push device of the user.
+	DeleteDevice(ctx context.Context, userID, deviceID string) *tidcommon.ServiceError
+
+	// InitiateChallenge sends an approval request to the push devices of the user. The returned
+	// challenge carries the number to show on the sign-in screen.
+	InitiateChallenge(ctx context.Context, userID string, challengeCtx ChallengeContext) (
+		*Challenge, *tidcommon.ServiceError)
+
+	// WaitForDecision waits up to the long poll duration for the approval request to be decided and
+	// returns its current state. An expired request is returned with the expired status.
+	WaitForDecision(ctx context.Context, challengeID string) (*Challenge, *tidcommon.ServiceError)
+
+	// RespondToChallenge records the decision of a push device on an approval request.
+	RespondToChallenge(ctx context.Context, challengeID string,
+		decision ChallengeDecisionRequest) *tidcommon.ServiceError
+
+	// Authenticate consumes an approved approval request and returns the authentication result of
+	// its user.
+	Authenticate(ctx context.Context, cred *authncommon.PushCredential) (
+		*authncommon.AuthnResult, *tidcommon.ServiceError)
+}
+
+// pushAuthService is the default implementation of PushAuthServiceInterface.
+type pushAuthService struct {
+	store        pushStoreInterface
+	senders      map[string]pushSenderInterface
+	challengeTTL time.Duration
+	longPoll     time.Duration
+	now          func() time.Time
+	logger       *log.Logger
+}
+
+// newPushAuthService creates a new instance of pushAuthService. Devices can be registered for the
+// platforms that have a sender.
+func newPushAuthService(store pushStoreInterface, senders map[string]pushSenderInterface,
+	challengeTTL, longPoll time.Duration) PushAuthServiceInterface {
+	if challengeTTL <= 0 {
+		challengeTTL = defaultChallengeTTL
+	}
+	if longPoll <= 0 {
+		longPoll = defaultLongPoll
+	}
+	return &pushAuthService{
+		store:        store,
+		senders:      senders,
+		challengeTTL: challengeTTL,
+		longPoll:     longPoll,
+		now:          time.Now,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PushAuthService")),
+	}
+}
+
+// RegisterDevice registers a device of the user for approval requests.
+func (s *pushAuthService) RegisterDevice(ctx context.Context, userID string,
+	request DeviceRegistrationRequest) (*DeviceRegistrationResponse, *tidcommon.ServiceError) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" || len(name) > maxDeviceNameLength {
+		return nil, &ErrorInvalidDeviceName
+	}
+	if _, ok := s.senders[request.Platform]; !ok {
+		return nil, &ErrorUnsupportedPlatform
+	}
+	pushToken := strings.TrimSpace(request.PushToken)
+	if pushToken == "" || len(pushToken) > maxPushTokenLength {
+		return nil, &ErrorInvalidPushToken
+	}
+
+	devices, err := s.store.GetDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get push devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	// Registering the same app installation again replaces the earlier registration.
+	devices = slices.DeleteFunc(devices, func(d storedDevice) bool { return d.PushToken == pushToken })
+	if len(devices) >= maxDevicesPerUser {
+		return nil, &ErrorDeviceLimitReached
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate push device ID", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	secret, err := cryptolib.GenerateSecureToken()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate push device secret", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	device := storedDevice{
+		PushDevice: PushDevice{
+			ID:        id,
+			Name:      name,
+			Platform:  request.Platform,
+			CreatedAt: s.now().UTC(),
+		},
+		PushToken:  pushToken,
+		SecretHash: cryptolib.HashToken(secret),
+	}
+	if err := s.store.SaveDevices(ctx, userID, append(devices, device)); err != nil {
+		s.logger.Error(ctx, "Failed to save push devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Registered push device", log.String("deviceId", id),
+		log.String("platform", request.Platform))
+	return &DeviceRegistrationResponse{Device: device.PushDevice, DeviceSecret: secret}, nil
+}
+
+// ListDevices returns the push devices of the user, most recently registered first.
+func (s *pushAuthService) ListDevices(ctx context.Context, userID string) ([]PushDevice,
+	*tidcommon.ServiceError) {
+	devices, err := s.store.GetDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list push devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	result := make([]PushDevice, 0, len(devices))
+	for i := len(devices) - 1; i >= 0; i-- {
+		result = append(result, devices[i].PushDevice)
+	}
+	return result, nil
+}
+
+// DeleteDevice removes a push device of the user. Decisions of the device are rejected from then on.
+func (s *pushAuthService) DeleteDevice(ctx context.Context, userID, deviceID string) *tidcommon.ServiceError {
+	devices, err := s.store.GetDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get push devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	idx := slices.IndexFunc(devices, func(d storedDevice) bool { return d.ID == deviceID })
+	if idx < 0 {
+		return &ErrorDeviceNotFound
+	}
+	if err := s.store.SaveDevices(ctx, userID, slices.Delete(devices, idx, idx+1)); err != nil {
+		s.logger.Error(ctx, "Failed to save push devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Removed push device", log.String("deviceId", deviceID))
+	return nil
+}
+
+// InitiateChallenge sends an approval request to the push devices of the user. The request is kept
+// when at least one device was reached.
+func (s *pushAuthService) InitiateChallenge(ctx context.Context, userID string,
+	challengeCtx ChallengeContext) (*Challenge, *tidcommon.ServiceError) {
+	devices, err := s.store.GetDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get push devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if len(devices) == 0 {
+		return nil, &ErrorNoRegisteredDevice
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate push challenge ID", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	number, err := generateMatchNumber()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate push challenge number", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	challenge := Challenge{
+		ID:        id,
+		UserID:    userID,
+		Number:    number,
+		Status:    ChallengeStatusPending,
+		ExpiresAt: s.now().UTC().Add(s.challengeTTL),
+	}
+	if err := s.store.CreateChallenge(ctx, challenge, s.challengeTTL); err != nil {
+		s.logger.Error(ctx, "Failed to store push challenge", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	notification := buildNotification(challenge, challengeCtx)
+	delivered := 0
+	for _, device := range devices {
+		if err := s.senders[device.Platform].Send(ctx, device.PushToken, notification); err != nil {
+			s.logger.Warn(ctx, "Failed to send push notification", log.String("deviceId", device.ID),
+				log.String("platform", device.Platform), log.Error(err))
+			continue
+		}
+		delivered++
+	}
+	if delivered == 0 {
+		s.logger.Error(ctx, "Failed to send the push notification to any device",
+			log.MaskedString(log.LoggerKeyUserID, userID))
+		if _, err := s.store.TakeChallenge(ctx, id); err != nil {
+			s.logger.Warn(ctx, "Failed to remove undelivered push challenge", log.Error(err))
+		}
+		return nil, &tidcommon.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Sent push challenge", log.String("challengeId", id),
+		log.Int("deviceCount", delivered))
+	return &challenge, nil
+}
+
+// WaitForDecision waits up to the long poll duration for the approval request to be decided.
+func (s *pushAuthService) WaitForDecision(ctx context.Context, challengeID string) (*Challenge,
+	*tidcommon.ServiceError) {
+	deadline := s.now().Add(s.longPoll)
+	for {
+		challenge, err := s.store.GetChallenge(ctx, challengeID)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to get push challenge", log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if challenge == nil {
+			return nil, &ErrorChallengeNotFound
+		}
+		if challenge.Status != ChallengeStatusPending {
+			return challenge, nil
+		}
+		now := s.now()
+		if !now.Before(challenge.ExpiresAt) {
+			challenge.Status = ChallengeStatusExpired
+			return challenge, nil
+		}
+		if !now.Before(deadline) {
+			return challenge, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return challenge, nil
+		case <-time.After(decisionPollInterval):
+		}
+	}
+}
+
+// RespondToChallenge records the decision of a push device on an approval request. An approval with
+// a number other than the one shown at sign-in denies the request, so it cannot be retried.
+func (s *pushAuthService) RespondToChallenge(ctx context.Context, challengeID string,
+	decision ChallengeDecisionRequest) *tidcommon.ServiceError {
+	if decision.DeviceID == "" || decision.DeviceSecret == "" {
+		return &ErrorInvalidRequestFormat
+	}
+
+	challenge, err := s.store.GetChallenge(ctx, challengeID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get push challenge", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if challenge == nil || !s.now().Before(challenge.ExpiresAt) {
+		return &ErrorChallengeNotFound
+	}
+
+	devices, err := s.store.GetDevices(ctx, challenge.UserID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get push devices", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	idx := slices.IndexFunc(devices, func(d storedDevice) bool { return d.ID == decision.DeviceID })
+	if idx < 0 || !cryptolib.ValidateTokenHash(decision.DeviceSecret, devices[idx].SecretHash) {
+		s.logger.Debug(ctx, "Rejected push decision with invalid device credentials",
+			log.String("challengeId", challengeID))
+		return &ErrorInvalidDeviceCredentials
+	}
+	if challenge.Status != ChallengeStatusPending {
+		return &ErrorChallengeAlreadyDecided
+	}
+
+	challenge.DeviceID = decision.DeviceID
+	var svcErr *tidcommon.ServiceError
+	switch {
+	case !decision.Approved:
+		challenge.Status = ChallengeStatusDenied
+		challenge.DenyReason = DenyReasonRejected
+	case decision.Number != challenge.Number:
+		challenge.Status = ChallengeStatusDenied
+		challenge.DenyReason = DenyReasonNumberMismatch
+		svcErr = &ErrorNumberMismatch
+	default:
+		challenge.Status = ChallengeStatusApproved
+	}
+	if err := s.store.UpdateChallenge(ctx, *challenge); err != nil {
+		if errors.Is(err, providers.ErrRuntimeStoreKeyNotFound) {
+			return &ErrorChallengeNotFound
+		}
+		s.logger.Error(ctx, "Failed to update push challenge", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	now := s.now().UTC()
+	devices[idx].LastUsedAt = &now
+	if err := s.store.SaveDevices(ctx, challenge.UserID, devices); err != nil {
+		s.logger.Warn(ctx, "Failed to record the last use of the push device", log.Error(err))
+	}
+
+	s.logger.Debug(ctx, "Recorded push decision", log.String("challengeId", challengeID),
+		log.String("status", string(challenge.Status)))
+	return svcErr
+}
+
+// Authenticate consumes an approved approval request and returns the authentication result of its
+// user. A request can be used only once.
+func (s *pushAuthService) Authenticate(ctx context.Context, cred *authncommon.PushCredential) (
+	*authncommon.AuthnResult, *tidcommon.ServiceError) {
+	if cred == nil || cred.ChallengeID == "" {
+		return nil, &ErrorChallengeNotFound
+	}
+	challenge, err := s.store.TakeChallenge(ctx, cred.ChallengeID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to take push challenge", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if challenge == nil || !s.now().Before(challenge.ExpiresAt) {
+		return nil, &ErrorChallengeNotFound
+	}
+	if challenge.Status != ChallengeStatusApproved {
+		return nil, &ErrorChallengeNotApproved
+	}
+
+	return &authncommon.AuthnResult{
+		Token:               map[string]interface{}{authncommon.UserAttributeUserID: challenge.UserID},
+		AuthenticatedClaims: map[string]interface{}{authncommon.UserAttributeUserID: challenge.UserID},
+	}, nil
+}
+
+// buildNotification builds the notification of an approval request. The number to select is left
+// out, so that the user has to read it from the sign-in screen.
+func buildNotification(challenge Challenge, challengeCtx ChallengeContext) Notification {
+	body := "Approve the sign-in request by selecting the number shown on your screen."
+	if challengeCtx.ApplicationName != "" {
+		body = "Approve the sign-in to " + challengeCtx.ApplicationName +
+			" by selecting the number shown on your screen."
+	}
+	data := map[string]string{
+		notificationKeyChallengeID: challenge.ID,
+		notificationKeyExpiresAt:   challenge.ExpiresAt.Format(time.RFC3339),
+	}
+	if challengeCtx.ApplicationName != "" {
+		data[notificationKeyApplicationName] = challengeCtx.ApplicationName
+	}
+	if challengeCtx.ClientIP != "" {
+		data[notificationKeyClientIP] = challengeCtx.ClientIP
+	}
+	return Notification{Title: "Sign-in request", Body: body, Data: data}
+}
+
+// generateMatchNumber returns a random number between minMatchNumber and maxMatchNumber.
+func generateMatchNumber() (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(maxMatchNumber-minMatchNumber+1))
+	if err != nil {
+		return 0, err
+	}
+	return minMatchNumber + int(n.Int64()), nil
+}
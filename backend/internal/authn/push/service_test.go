@@ -0,0 +1,374 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const testUserID = "user-1"
+
+type PushAuthServiceTestSuite struct {
+	suite.Suite
+	fcm     *pushSenderInterfaceMock
+	apns    *pushSenderInterfaceMock
+	now     time.Time
+	service *pushAuthService
+}
+
+func TestPushAuthServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PushAuthServiceTestSuite))
+}
+
+func (suite *PushAuthServiceTestSuite) SetupTest() {
+	suite.fcm = newPushSenderInterfaceMock(suite.T())
+	suite.apns = newPushSenderInterfaceMock(suite.T())
+	suite.now = time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	svc := newPushAuthService(newPushStore(inmemory.Initialize("test")), map[string]pushSenderInterface{
+		PlatformFCM:  suite.fcm,
+		PlatformAPNs: suite.apns,
+	}, 2*time.Minute, 0)
+	suite.service = svc.(*pushAuthService)
+	suite.service.now = func() time.Time { return suite.now }
+	// Return immediately from WaitForDecision instead of long-polling.
+	suite.service.longPoll = 0
+}
+
+func (suite *PushAuthServiceTestSuite) registerDevice(platform, pushToken string) *DeviceRegistrationResponse {
+	resp, svcErr := suite.service.RegisterDevice(context.Background(), testUserID, DeviceRegistrationRequest{
+		Name: "Phone", Platform: platform, PushToken: pushToken,
+	})
+	suite.Require().Nil(svcErr)
+	return resp
+}
+
+// initiateChallenge registers a device and sends an approval request to it.
+func (suite *PushAuthServiceTestSuite) initiateChallenge() (*DeviceRegistrationResponse, *Challenge) {
+	device := suite.registerDevice(PlatformFCM, "fcm-token")
+	suite.fcm.On("Send", mock.Anything, "fcm-token", mock.Anything).Return(nil).Once()
+	challenge, svcErr := suite.service.InitiateChallenge(context.Background(), testUserID,
+		ChallengeContext{ApplicationName: "Console", ClientIP: "203.0.113.10"})
+	suite.Require().Nil(svcErr)
+	return device, challenge
+}
+
+func (suite *PushAuthServiceTestSuite) TestRegisterDevice() {
+	resp := suite.registerDevice(PlatformAPNs, "apns-token")
+
+	suite.NotEmpty(resp.Device.ID)
+	suite.NotEmpty(resp.DeviceSecret)
+	suite.Equal("Phone", resp.Device.Name)
+	suite.Equal(PlatformAPNs, resp.Device.Platform)
+	suite.Equal(suite.now, resp.Device.CreatedAt)
+
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.Len(devices, 1)
+}
+
+func (suite *PushAuthServiceTestSuite) TestRegisterDevice_InvalidRequest() {
+	testCases := []struct {
+		name     string
+		request  DeviceRegistrationRequest
+		expected string
+	}{
+		{"EmptyName", DeviceRegistrationRequest{Name: " ", Platform: PlatformFCM, PushToken: "t"},
+			ErrorInvalidDeviceName.Code},
+		{"UnsupportedPlatform", DeviceRegistrationRequest{Name: "Phone", Platform: "wns", PushToken: "t"},
+			ErrorUnsupportedPlatform.Code},
+		{"EmptyPushToken", DeviceRegistrationRequest{Name: "Phone", Platform: PlatformFCM},
+			ErrorInvalidPushToken.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp, svcErr := suite.service.RegisterDevice(context.Background(), testUserID, tc.request)
+
+			suite.Nil(resp)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *PushAuthServiceTestSuite) TestRegisterDevice_SamePushTokenReplacesRegistration() {
+	first := suite.registerDevice(PlatformFCM, "fcm-token")
+	second := suite.registerDevice(PlatformFCM, "fcm-token")
+
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.Len(devices, 1)
+	suite.Equal(second.Device.ID, devices[0].ID)
+	suite.NotEqual(first.Device.ID, devices[0].ID)
+}
+
+func (suite *PushAuthServiceTestSuite) TestRegisterDevice_LimitReached() {
+	for i := 0; i < maxDevicesPerUser; i++ {
+		suite.registerDevice(PlatformFCM, fmt.Sprintf("fcm-token-%d", i))
+	}
+
+	resp, svcErr := suite.service.RegisterDevice(context.Background(), testUserID, DeviceRegistrationRequest{
+		Name: "Phone", Platform: PlatformFCM, PushToken: "one-too-many",
+	})
+
+	suite.Nil(resp)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorDeviceLimitReached.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestListDevices_NewestFirst() {
+	first := suite.registerDevice(PlatformFCM, "fcm-token")
+	suite.now = suite.now.Add(time.Hour)
+	second := suite.registerDevice(PlatformAPNs, "apns-token")
+
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Require().Len(devices, 2)
+	suite.Equal(second.Device.ID, devices[0].ID)
+	suite.Equal(first.Device.ID, devices[1].ID)
+}
+
+func (suite *PushAuthServiceTestSuite) TestDeleteDevice() {
+	device := suite.registerDevice(PlatformFCM, "fcm-token")
+
+	suite.Nil(suite.service.DeleteDevice(context.Background(), testUserID, device.Device.ID))
+
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.Empty(devices)
+
+	svcErr = suite.service.DeleteDevice(context.Background(), testUserID, device.Device.ID)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorDeviceNotFound.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestInitiateChallenge() {
+	suite.registerDevice(PlatformFCM, "fcm-token")
+	suite.registerDevice(PlatformAPNs, "apns-token")
+	var sent Notification
+	suite.fcm.On("Send", mock.Anything, "fcm-token", mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(2).(Notification)
+	}).Return(nil).Once()
+	suite.apns.On("Send", mock.Anything, "apns-token", mock.Anything).Return(errors.New("unreachable")).Once()
+
+	challenge, svcErr := suite.service.InitiateChallenge(context.Background(), testUserID,
+		ChallengeContext{ApplicationName: "Console", ClientIP: "203.0.113.10"})
+
+	suite.Nil(svcErr)
+	suite.Require().NotNil(challenge)
+	suite.Equal(testUserID, challenge.UserID)
+	suite.Equal(ChallengeStatusPending, challenge.Status)
+	suite.GreaterOrEqual(challenge.Number, minMatchNumber)
+	suite.LessOrEqual(challenge.Number, maxMatchNumber)
+	suite.Equal(suite.now.Add(2*time.Minute), challenge.ExpiresAt)
+
+	suite.Equal(challenge.ID, sent.Data[notificationKeyChallengeID])
+	suite.Equal("Console", sent.Data[notificationKeyApplicationName])
+	suite.Equal("203.0.113.10", sent.Data[notificationKeyClientIP])
+	suite.NotContains(sent.Body, fmt.Sprint(challenge.Number))
+}
+
+func (suite *PushAuthServiceTestSuite) TestInitiateChallenge_NoRegisteredDevice() {
+	challenge, svcErr := suite.service.InitiateChallenge(context.Background(), testUserID, ChallengeContext{})
+
+	suite.Nil(challenge)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorNoRegisteredDevice.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestInitiateChallenge_NotDelivered() {
+	suite.registerDevice(PlatformFCM, "fcm-token")
+	suite.fcm.On("Send", mock.Anything, "fcm-token", mock.Anything).Return(errPushRejected).Once()
+
+	challenge, svcErr := suite.service.InitiateChallenge(context.Background(), testUserID, ChallengeContext{})
+
+	suite.Nil(challenge)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestRespondToChallenge_Approve() {
+	device, challenge := suite.initiateChallenge()
+
+	svcErr := suite.service.RespondToChallenge(context.Background(), challenge.ID, ChallengeDecisionRequest{
+		DeviceID: device.Device.ID, DeviceSecret: device.DeviceSecret, Approved: true, Number: challenge.Number,
+	})
+	suite.Nil(svcErr)
+
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), challenge.ID)
+	suite.Nil(svcErr)
+	suite.Equal(ChallengeStatusApproved, decided.Status)
+	suite.Equal(device.Device.ID, decided.DeviceID)
+
+	devices, _ := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Require().NotNil(devices[0].LastUsedAt)
+	suite.Equal(suite.now, *devices[0].LastUsedAt)
+
+	result, svcErr := suite.service.Authenticate(context.Background(),
+		&authncommon.PushCredential{ChallengeID: challenge.ID})
+	suite.Nil(svcErr)
+	suite.Equal(testUserID, result.Token[authncommon.UserAttributeUserID])
+
+	// An approved request can be used only once.
+	_, svcErr = suite.service.Authenticate(context.Background(),
+		&authncommon.PushCredential{ChallengeID: challenge.ID})
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorChallengeNotFound.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestRespondToChallenge_Reject() {
+	device, challenge := suite.initiateChallenge()
+
+	svcErr := suite.service.RespondToChallenge(context.Background(), challenge.ID, ChallengeDecisionRequest{
+		DeviceID: device.Device.ID, DeviceSecret: device.DeviceSecret, Approved: false,
+	})
+	suite.Nil(svcErr)
+
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), challenge.ID)
+	suite.Nil(svcErr)
+	suite.Equal(ChallengeStatusDenied, decided.Status)
+	suite.Equal(DenyReasonRejected, decided.DenyReason)
+
+	_, svcErr = suite.service.Authenticate(context.Background(),
+		&authncommon.PushCredential{ChallengeID: challenge.ID})
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorChallengeNotApproved.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestRespondToChallenge_NumberMismatchDeniesRequest() {
+	device, challenge := suite.initiateChallenge()
+	wrongNumber := challenge.Number + 1
+	if wrongNumber > maxMatchNumber {
+		wrongNumber = minMatchNumber
+	}
+
+	svcErr := suite.service.RespondToChallenge(context.Background(), challenge.ID, ChallengeDecisionRequest{
+		DeviceID: device.Device.ID, DeviceSecret: device.DeviceSecret, Approved: true, Number: wrongNumber,
+	})
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorNumberMismatch.Code, svcErr.Code)
+
+	// The request cannot be retried with another number.
+	svcErr = suite.service.RespondToChallenge(context.Background(), challenge.ID, ChallengeDecisionRequest{
+		DeviceID: device.Device.ID, DeviceSecret: device.DeviceSecret, Approved: true, Number: challenge.Number,
+	})
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorChallengeAlreadyDecided.Code, svcErr.Code)
+
+	decided, _ := suite.service.WaitForDecision(context.Background(), challenge.ID)
+	suite.Equal(ChallengeStatusDenied, decided.Status)
+	suite.Equal(DenyReasonNumberMismatch, decided.DenyReason)
+}
+
+func (suite *PushAuthServiceTestSuite) TestRespondToChallenge_InvalidDeviceCredentials() {
+	device, challenge := suite.initiateChallenge()
+
+	testCases := []struct {
+		name     string
+		decision ChallengeDecisionRequest
+		expected string
+	}{
+		{"MissingCredentials", ChallengeDecisionRequest{Approved: true}, ErrorInvalidRequestFormat.Code},
+		{"UnknownDevice", ChallengeDecisionRequest{DeviceID: "unknown", DeviceSecret: device.DeviceSecret},
+			ErrorInvalidDeviceCredentials.Code},
+		{"WrongSecret", ChallengeDecisionRequest{DeviceID: device.Device.ID, DeviceSecret: "wrong"},
+			ErrorInvalidDeviceCredentials.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			svcErr := suite.service.RespondToChallenge(context.Background(), challenge.ID, tc.decision)
+
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+
+	pending, _ := suite.service.WaitForDecision(context.Background(), challenge.ID)
+	suite.Equal(ChallengeStatusPending, pending.Status)
+}
+
+func (suite *PushAuthServiceTestSuite) TestRespondToChallenge_DeletedDevice() {
+	device, challenge := suite.initiateChallenge()
+	suite.Require().Nil(suite.service.DeleteDevice(context.Background(), testUserID, device.Device.ID))
+
+	svcErr := suite.service.RespondToChallenge(context.Background(), challenge.ID, ChallengeDecisionRequest{
+		DeviceID: device.Device.ID, DeviceSecret: device.DeviceSecret, Approved: true, Number: challenge.Number,
+	})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorInvalidDeviceCredentials.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestWaitForDecision_Expired() {
+	device, challenge := suite.initiateChallenge()
+	suite.now = challenge.ExpiresAt
+
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), challenge.ID)
+	suite.Nil(svcErr)
+	suite.Equal(ChallengeStatusExpired, decided.Status)
+
+	svcErr = suite.service.RespondToChallenge(context.Background(), challenge.ID, ChallengeDecisionRequest{
+		DeviceID: device.Device.ID, DeviceSecret: device.DeviceSecret, Approved: true, Number: challenge.Number,
+	})
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorChallengeNotFound.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestWaitForDecision_NotFound() {
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), "unknown")
+
+	suite.Nil(decided)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorChallengeNotFound.Code, svcErr.Code)
+}
+
+func (suite *PushAuthServiceTestSuite) TestWaitForDecision_ReturnsWhenDecided() {
+	suite.service.now = time.Now
+	suite.service.longPoll = 5 * time.Second
+	device, challenge := suite.initiateChallenge()
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = suite.service.RespondToChallenge(context.Background(), challenge.ID, ChallengeDecisionRequest{
+			DeviceID: device.Device.ID, DeviceSecret: device.DeviceSecret, Approved: true,
+			Number: challenge.Number,
+		})
+	}()
+
+	started := time.Now()
+	decided, svcErr := suite.service.WaitForDecision(context.Background(), challenge.ID)
+
+	suite.Nil(svcErr)
+	suite.Equal(ChallengeStatusApproved, decided.Status)
+	suite.Less(time.Since(started), 5*time.Second)
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// pushStoreInterface defines the interface for the push device and approval request store.
+type pushStoreInterface interface {
+	// GetDevices returns the push devices of the user, or an empty list when none are registered.
+	GetDevices(ctx context.Context, userID string) ([]storedDevice, error)
+
+	// SaveDevices stores the push devices of the user. An empty list removes the entry.
+	SaveDevices(ctx context.Context, userID string, devices []storedDevice) error
+
+	// CreateChallenge stores a new approval request for the given lifetime.
+	CreateChallenge(ctx context.Context, challenge Challenge, ttl time.Duration) error
+
+	// GetChallenge returns the approval request, or nil when it is unknown or has expired.
+	GetChallenge(ctx context.Context, challengeID string) (*Challenge, error)
+
+	// UpdateChallenge replaces a stored approval request without extending its lifetime. It returns
+	// providers.ErrRuntimeStoreKeyNotFound when the request has expired.
+	UpdateChallenge(ctx context.Context, challenge Challenge) error
+
+	// TakeChallenge removes and returns the approval request, or nil when it is unknown or has expired.
+	TakeChallenge(ctx context.Context, challengeID string) (*Challenge, error)
+}
+
+// pushStore keeps push devices and approval requests in the runtime store. Devices are kept until
+// they are removed, and approval requests expire with their TTL.
+type pushStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newPushStore creates a new instance of pushStore.
+func newPushStore(store providers.RuntimeStoreProvider) pushStoreInterface {
+	return &pushStore{store: store}
+}
+
+// GetDevices returns the push devices of the user, or an empty list when none are registered.
+func (s *pushStore) GetDevices(ctx context.Context, userID string) ([]storedDevice, error) {
+	data, err := s.store.Get(ctx, providers.NamespacePushDevices, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get push devices: %w", err)
+	}
+	devices := make([]storedDevice, 0)
+	if data == nil {
+		return devices, nil
+	}
+	if err := json.Unmarshal(data, &devices); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal push devices: %w", err)
+	}
+	return devices, nil
+}
+
+// SaveDevices stores the push devices of the user. An empty list removes the entry.
+func (s *pushStore) SaveDevices(ctx context.Context, userID string, devices []storedDevice) error {
+	if len(devices) == 0 {
+		return s.store.Delete(ctx, providers.NamespacePushDevices, userID)
+	}
+	data, err := json.Marshal(devices)
+	if err != nil {
+		return fmt.Errorf("failed to marshal push devices: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespacePushDevices, userID, data, 0)
+}
+
+// CreateChallenge stores a new approval request for the given lifetime.
+func (s *pushStore) CreateChallenge(ctx context.Context, challenge Challenge, ttl time.Duration) error {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return fmt.Errorf("failed to marshal push challenge: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespacePushChallenge, challenge.ID, data, int64(ttl.Seconds()))
+}
+
+// GetChallenge returns the approval request, or nil when it is unknown or has expired.
+func (s *pushStore) GetChallenge(ctx context.Context, challengeID string) (*Challenge, error) {
+	data, err := s.store.Get(ctx, providers.NamespacePushChallenge, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get push challenge: %w", err)
+	}
+	return unmarshalChallenge(data)
+}
+
+// UpdateChallenge replaces a stored approval request without extending its lifetime.
+func (s *pushStore) UpdateChallenge(ctx context.Context, challenge Challenge) error {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return fmt.Errorf("failed to marshal push challenge: %w", err)
+	}
+	if err := s.store.Update(ctx, providers.NamespacePushChallenge, challenge.ID, data); err != nil {
+		if errors.Is(err, providers.ErrRuntimeStoreKeyNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to update push challenge: %w", err)
+	}
+	return nil
+}
+
+// TakeChallenge removes and returns the approval request, or nil when it is unknown or has expired.
+func (s *pushStore) TakeChallenge(ctx context.Context, challengeID string) (*Challenge, error) {
+	data, err := s.store.Take(ctx, providers.NamespacePushChallenge, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take push challenge: %w", err)
+	}
+	return unmarshalChallenge(data)
+}
+
+// unmarshalChallenge decodes a stored approval request, returning nil for a missing entry.
+func unmarshalChallenge(data []byte) (*Challenge, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var challenge Challenge
+	if err := json.Unmarshal(data, &challenge); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal push challenge: %w", err)
+	}
+	return &challenge, nil
+}
@@ -113,4 +113,19 @@ var (
 			DefaultValue: "The entity reference fetch was rejected by the provider",
 		},
 	}
+
+	// ErrorUserNotActive is returned when the underlying provider indicates that the user account
+	// is not active (e.g. locked or disabled).
+	ErrorUserNotActive = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "AUTHN-MGR-1011",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.authnmgrservice.user_not_active",
+			DefaultValue: "User not active",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.authnmgrservice.user_not_active_description",
+			DefaultValue: "The user account is locked, disabled or pending activation",
+		},
+	}
 )
@@ -19,11 +19,14 @@
 package manager
 
 import (
+	"github.com/thunder-id/thunderid/internal/authn/certificate"
 	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/crossdevice"
 	"github.com/thunder-id/thunderid/internal/authn/magiclink"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/push"
 	"github.com/thunder-id/thunderid/internal/authnprovider/provider"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -34,7 +37,11 @@ func InitializeAuthnProviderManager(entitySvc entity.EntityServiceInterface,
 	passkeySvc passkey.PasskeyServiceInterface, otpSvc otp.OTPAuthnServiceInterface,
 	magicLinkSvc magiclink.MagicLinkAuthnServiceInterface,
 	openid4vpSvc openid4vp.OpenID4VPServiceInterface,
+	pushSvc push.PushAuthServiceInterface,
+	crossDeviceSvc crossdevice.CrossDeviceAuthServiceInterface,
+	certificateSvc certificate.CertificateAuthServiceInterface,
 	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator) providers.AuthnProviderManager {
-	p := provider.InitializeAuthnProvider(entitySvc, passkeySvc, otpSvc, magicLinkSvc, openid4vpSvc, federatedAuths)
+	p := provider.InitializeAuthnProvider(entitySvc, passkeySvc, otpSvc, magicLinkSvc, openid4vpSvc, pushSvc,
+		crossDeviceSvc, certificateSvc, federatedAuths)
 	return newAuthnProviderManager(p)
 }
@@ -121,6 +121,10 @@ func (m *authnProviderManager) AuthenticateUser(ctx context.Context, identifiers
 			m.logger.Debug(ctx, "authentication failed with user not found error from provider",
 				log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
 			return authUser, nil, &ErrorUserNotFound
+		case authnprovidercm.ErrorCodeUserNotActive:
+			m.logger.Debug(ctx, "authentication failed with user not active error from provider",
+				log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
+			return authUser, nil, &ErrorUserNotActive
 		case authnprovidercm.ErrorCodeInvalidRequest:
 			m.logger.Debug(ctx, "authentication failed with invalid request error from provider",
 				log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
@@ -209,6 +209,15 @@ func (s *ManagerTestSuite) TestAuthenticateUser_UserNotFound() {
 	)
 }
 
+func (s *ManagerTestSuite) TestAuthenticateUser_UserNotActive() {
+	s.assertAuthenticateUserClientErrorMapping(
+		authnprovidercm.ErrorCodeUserNotActive,
+		"user not active",
+		"the user account is not active",
+		ErrorUserNotActive.Code,
+	)
+}
+
 func (s *ManagerTestSuite) TestAuthenticateUser_InvalidRequest() {
 	s.assertAuthenticateUserClientErrorMapping(
 		authnprovidercm.ErrorCodeInvalidRequest,
@@ -27,11 +27,14 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"github.com/thunder-id/thunderid/internal/authn/certificate"
 	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/crossdevice"
 	"github.com/thunder-id/thunderid/internal/authn/magiclink"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/push"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -48,6 +51,9 @@ type defaultAuthnProvider struct {
 	otpService       otp.OTPAuthnServiceInterface
 	magicLinkService magiclink.MagicLinkAuthnServiceInterface
 	openid4vpService openid4vp.OpenID4VPServiceInterface
+	pushService      push.PushAuthServiceInterface
+	crossDeviceSvc   crossdevice.CrossDeviceAuthServiceInterface
+	certificateSvc   certificate.CertificateAuthServiceInterface
 	federatedAuths   map[providers.IDPType]authncommon.FederatedAuthenticator
 	logger           *log.Logger
 }
@@ -57,6 +63,9 @@ func newDefaultAuthnProvider(entitySvc entity.EntityServiceInterface,
 	passkeyService passkey.PasskeyServiceInterface, otpService otp.OTPAuthnServiceInterface,
 	magicLinkService magiclink.MagicLinkAuthnServiceInterface,
 	openid4vpService openid4vp.OpenID4VPServiceInterface,
+	pushService push.PushAuthServiceInterface,
+	crossDeviceSvc crossdevice.CrossDeviceAuthServiceInterface,
+	certificateSvc certificate.CertificateAuthServiceInterface,
 	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator) AuthnProviderInterface {
 	return &defaultAuthnProvider{
 		entitySvc:        entitySvc,
@@ -64,6 +73,9 @@ func newDefaultAuthnProvider(entitySvc entity.EntityServiceInterface,
 		otpService:       otpService,
 		magicLinkService: magicLinkService,
 		openid4vpService: openid4vpService,
+		pushService:      pushService,
+		crossDeviceSvc:   crossDeviceSvc,
+		certificateSvc:   certificateSvc,
 		federatedAuths:   federatedAuths,
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DefaultAuthnProvider")),
 	}
@@ -268,6 +280,15 @@ func (p *defaultAuthnProvider) resolveCredentials(
 	if vpCred, ok := credentials["openid4vp"]; ok {
 		return p.authenticateWithOpenID4VP(ctx, vpCred)
 	}
+	if pushCred, ok := credentials["push"]; ok {
+		return p.authenticateWithPush(ctx, pushCred)
+	}
+	if cdCred, ok := credentials["crossdevice"]; ok {
+		return p.authenticateWithCrossDevice(ctx, cdCred)
+	}
+	if certCred, ok := credentials["certificate"]; ok {
+		return p.authenticateWithCertificate(ctx, certCred)
+	}
 	if userID, ok := identifiers["userID"]; ok && userID != "" {
 		return p.authenticateByUserID(ctx, userID, credentials)
 	}
@@ -450,6 +471,81 @@ func (p *defaultAuthnProvider) authenticateWithOpenID4VP(
 	}, nil
 }
 
+// authenticateWithPush authenticates the user using the push authentication service.
+// The raw credential is expected to be a PushCredential identifying an approved push challenge.
+func (p *defaultAuthnProvider) authenticateWithPush(
+	ctx context.Context, raw interface{},
+) (*authnResult, *tidcommon.ServiceError) {
+	cred, ok := raw.(*authncommon.PushCredential)
+	if !ok || cred == nil {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid push payload", "The provided push credential is invalid")
+	}
+	result, svcErr := p.pushService.Authenticate(ctx, cred)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return nil, newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
+				svcErr.Error.DefaultValue, svcErr.ErrorDescription.DefaultValue)
+		}
+		return nil, p.logAndReturnServerError(ctx, "Push authentication failed with server error",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+	}
+	return &authnResult{
+		token:               result.Token,
+		authenticatedClaims: result.AuthenticatedClaims,
+	}, nil
+}
+
+// authenticateWithCrossDevice authenticates the user using the cross-device authentication service.
+// The raw credential is expected to be a CrossDeviceCredential identifying an approved sign-in request.
+func (p *defaultAuthnProvider) authenticateWithCrossDevice(
+	ctx context.Context, raw interface{},
+) (*authnResult, *tidcommon.ServiceError) {
+	cred, ok := raw.(*authncommon.CrossDeviceCredential)
+	if !ok || cred == nil {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid cross-device payload", "The provided cross-device credential is invalid")
+	}
+	result, svcErr := p.crossDeviceSvc.Authenticate(ctx, cred)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return nil, newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
+				svcErr.Error.DefaultValue, svcErr.ErrorDescription.DefaultValue)
+		}
+		return nil, p.logAndReturnServerError(ctx, "Cross-device authentication failed with server error",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+	}
+	return &authnResult{
+		token:               result.Token,
+		authenticatedClaims: result.AuthenticatedClaims,
+	}, nil
+}
+
+// authenticateWithCertificate authenticates the user using the certificate authentication service.
+// The raw credential is expected to be a CertificateCredential carrying the client certificate chain.
+func (p *defaultAuthnProvider) authenticateWithCertificate(
+	ctx context.Context, raw interface{},
+) (*authnResult, *tidcommon.ServiceError) {
+	cred, ok := raw.(*authncommon.CertificateCredential)
+	if !ok || cred == nil {
+		return nil, newClientError(authnprovidercm.ErrorCodeInvalidRequest,
+			"Invalid certificate payload", "The provided certificate credential is invalid")
+	}
+	result, svcErr := p.certificateSvc.Authenticate(ctx, cred)
+	if svcErr != nil {
+		if svcErr.Type == tidcommon.ClientErrorType {
+			return nil, newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
+				svcErr.Error.DefaultValue, svcErr.ErrorDescription.DefaultValue)
+		}
+		return nil, p.logAndReturnServerError(ctx, "Certificate authentication failed with server error",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+	}
+	return &authnResult{
+		token:               result.Token,
+		authenticatedClaims: result.AuthenticatedClaims,
+	}, nil
+}
+
 // authenticateByUserID authenticates the user using a user ID and credentials.
 func (p *defaultAuthnProvider) authenticateByUserID(
 	ctx context.Context, userID interface{}, credentials map[string]interface{},
@@ -493,6 +589,10 @@ func (p *defaultAuthnProvider) handleEntityAuthError(
 		return newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
 			"Authentication failed", "Invalid credentials provided")
 	}
+	if errors.Is(err, entity.ErrEntityNotActive) {
+		return newClientError(authnprovidercm.ErrorCodeUserNotActive,
+			"User not active", "The user account is not active")
+	}
 	return p.logAndReturnServerError(ctx, serverMsg, log.String("error", err.Error()))
 }
 
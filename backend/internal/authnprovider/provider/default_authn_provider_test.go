@@ -20,6 +20,7 @@ package provider
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -35,10 +36,13 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/certificatemock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/commonmock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/crossdevicemock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/magiclinkmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/otpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/passkeymock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/pushmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 )
 
@@ -54,7 +58,7 @@ func (suite *DefaultAuthnProviderTestSuite) SetupTest() {
 	suite.mockService = entitymock.NewEntityServiceInterfaceMock(suite.T())
 	suite.mockPasskey = passkeymock.NewWebAuthnAuthnServiceInterfaceMock(suite.T())
 	suite.mockFederated = commonmock.NewFederatedAuthenticatorMock(suite.T())
-	suite.provider = newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil)
+	suite.provider = newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
 }
 
 func TestDefaultAuthnProviderTestSuite(t *testing.T) {
@@ -138,6 +142,20 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_AuthenticationFaile
 	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
 }
 
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_UserNotActive() {
+	identifiers := map[string]interface{}{"username": "testuser"}
+	credentials := map[string]interface{}{"password": "password"}
+
+	suite.mockService.On("AuthenticateEntity", mock.Anything, identifiers, credentials).
+		Return(nil, entity.ErrEntityNotActive).Once()
+
+	result, err := suite.provider.Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeUserNotActive, err.Code)
+}
+
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_GenericAuthError() {
 	identifiers := map[string]interface{}{"username": "testuser"}
 	credentials := map[string]interface{}{"password": "password123"}
@@ -412,7 +430,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Provisioning_GetEnt
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_ServerError() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -440,7 +458,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Serv
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Success_ThenGetEntity() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -479,7 +497,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_Succ
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_IdentifyEntity_GetEntityFails() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -775,7 +793,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestGetAttributes_InvalidTokenFormat
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_IncorrectOTP() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -796,7 +814,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_IncorrectOTP()
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_InvalidPayload() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": "not-a-map",
@@ -811,7 +829,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_InvalidPayload(
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingSessionToken() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -828,7 +846,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingSessionT
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingOTPValue() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -845,7 +863,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_MissingOTPValue
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ClientError_NonIncorrectOTP() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -871,7 +889,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ClientError_Non
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ServerError() {
 	mockOTP := otpmock.NewOTPAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"otp": map[string]interface{}{
@@ -899,7 +917,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_OTP_ServerError() {
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_AuthenticationFailed() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{
@@ -924,7 +942,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_Authentic
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_ServerError() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{
@@ -949,7 +967,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_ServerErr
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_InvalidPayload() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": "not-a-map",
@@ -964,7 +982,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_InvalidPa
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_MissingToken() {
 	mockML := magiclinkmock.NewMagicLinkAuthnServiceInterfaceMock(suite.T())
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"magiclink": map[string]interface{}{},
@@ -977,6 +995,179 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_MagicLink_MissingTo
 	suite.Equal(authnprovidercm.ErrorCodeInvalidRequest, err.Code)
 }
 
+// --- Push authentication tests ---
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Push_Success() {
+	mockPush := pushmock.NewPushAuthServiceInterfaceMock(suite.T())
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, mockPush, nil, nil, nil)
+	cred := &authncommon.PushCredential{ChallengeID: "challenge-1"}
+	token := map[string]interface{}{authnprovidercm.UserAttributeUserID: "user-1"}
+
+	mockPush.On("Authenticate", mock.Anything, cred).
+		Return(&authncommon.AuthnResult{Token: token, AuthenticatedClaims: token}, nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-1").
+		Return(&providers.Entity{
+			ID:       "user-1",
+			Category: providers.EntityCategoryUser,
+			Type:     "customer",
+			State:    providers.EntityStateActive,
+			OUID:     "ou1",
+		}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), nil, map[string]interface{}{"push": cred}, nil)
+
+	suite.Nil(err)
+	suite.NotNil(result.EntityReference)
+	suite.Equal("user-1", result.EntityReference.EntityID)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Push_NotApproved() {
+	mockPush := pushmock.NewPushAuthServiceInterfaceMock(suite.T())
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, mockPush, nil, nil, nil)
+	cred := &authncommon.PushCredential{ChallengeID: "challenge-1"}
+
+	mockPush.On("Authenticate", mock.Anything, cred).Return(nil, &tidcommon.ServiceError{
+		Type:             tidcommon.ClientErrorType,
+		Code:             "PSH-1012",
+		Error:            tidcommon.I18nMessage{DefaultValue: "Approval request not approved"},
+		ErrorDescription: tidcommon.I18nMessage{DefaultValue: "The approval request was not approved"},
+	}).Once()
+
+	result, err := provider.Authenticate(context.Background(), nil, map[string]interface{}{"push": cred}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Push_InvalidPayload() {
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	result, err := provider.Authenticate(context.Background(), nil,
+		map[string]interface{}{"push": "challenge-1"}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeInvalidRequest, err.Code)
+}
+
+// --- Cross-device authentication tests ---
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_CrossDevice_Success() {
+	mockCrossDevice := crossdevicemock.NewCrossDeviceAuthServiceInterfaceMock(suite.T())
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, mockCrossDevice, nil, nil)
+	cred := &authncommon.CrossDeviceCredential{Reference: "ref-1"}
+	token := map[string]interface{}{authnprovidercm.UserAttributeUserID: "user-1"}
+
+	mockCrossDevice.On("Authenticate", mock.Anything, cred).
+		Return(&authncommon.AuthnResult{Token: token, AuthenticatedClaims: token}, nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-1").
+		Return(&providers.Entity{
+			ID:       "user-1",
+			Category: providers.EntityCategoryUser,
+			Type:     "customer",
+			State:    providers.EntityStateActive,
+			OUID:     "ou1",
+		}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), nil,
+		map[string]interface{}{"crossdevice": cred}, nil)
+
+	suite.Nil(err)
+	suite.NotNil(result.EntityReference)
+	suite.Equal("user-1", result.EntityReference.EntityID)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_CrossDevice_NotApproved() {
+	mockCrossDevice := crossdevicemock.NewCrossDeviceAuthServiceInterfaceMock(suite.T())
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, mockCrossDevice, nil, nil)
+	cred := &authncommon.CrossDeviceCredential{Reference: "ref-1"}
+
+	mockCrossDevice.On("Authenticate", mock.Anything, cred).Return(nil, &tidcommon.ServiceError{
+		Type:             tidcommon.ClientErrorType,
+		Code:             "CDA-1006",
+		Error:            tidcommon.I18nMessage{DefaultValue: "Sign-in request not approved"},
+		ErrorDescription: tidcommon.I18nMessage{DefaultValue: "The sign-in request was not approved"},
+	}).Once()
+
+	result, err := provider.Authenticate(context.Background(), nil,
+		map[string]interface{}{"crossdevice": cred}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_CrossDevice_InvalidPayload() {
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	result, err := provider.Authenticate(context.Background(), nil,
+		map[string]interface{}{"crossdevice": "ref-1"}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeInvalidRequest, err.Code)
+}
+
+// --- Certificate authentication tests ---
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Certificate_Success() {
+	mockCertificate := certificatemock.NewCertificateAuthServiceInterfaceMock(suite.T())
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, mockCertificate, nil)
+	cred := &authncommon.CertificateCredential{Certificates: []*x509.Certificate{{}}}
+	token := map[string]interface{}{"username": "alice"}
+
+	mockCertificate.On("Authenticate", mock.Anything, cred).
+		Return(&authncommon.AuthnResult{Token: token, AuthenticatedClaims: token}, nil).Once()
+	suite.mockService.On("IdentifyEntity", mock.Anything, token).Return(new("user-1"), nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-1").
+		Return(&providers.Entity{
+			ID:       "user-1",
+			Category: providers.EntityCategoryUser,
+			Type:     "customer",
+			State:    providers.EntityStateActive,
+			OUID:     "ou1",
+		}, nil).Once()
+
+	result, err := provider.Authenticate(context.Background(), nil,
+		map[string]interface{}{"certificate": cred}, nil)
+
+	suite.Nil(err)
+	suite.NotNil(result.EntityReference)
+	suite.Equal("user-1", result.EntityReference.EntityID)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Certificate_Rejected() {
+	mockCertificate := certificatemock.NewCertificateAuthServiceInterfaceMock(suite.T())
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, mockCertificate, nil)
+	cred := &authncommon.CertificateCredential{Certificates: []*x509.Certificate{{}}}
+
+	mockCertificate.On("Authenticate", mock.Anything, cred).Return(nil, &tidcommon.ServiceError{
+		Type:             tidcommon.ClientErrorType,
+		Code:             "CRT-1004",
+		Error:            tidcommon.I18nMessage{DefaultValue: "Certificate revoked"},
+		ErrorDescription: tidcommon.I18nMessage{DefaultValue: "The certificate was revoked by its issuer"},
+	}).Once()
+
+	result, err := provider.Authenticate(context.Background(), nil,
+		map[string]interface{}{"certificate": cred}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Certificate_InvalidPayload() {
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	result, err := provider.Authenticate(context.Background(), nil,
+		map[string]interface{}{"certificate": "not-a-certificate"}, nil)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeInvalidRequest, err.Code)
+}
+
 // --- Tokenized credential authentication tests (OTP + MagicLink) ---
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_EntityFound() {
@@ -994,7 +1185,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Entit
 				"otp":          "123456",
 			},
 		}
-		return newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil), creds, token
+		return newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil), creds, token
 	}
 
 	setupMagicLink := func() (AuthnProviderInterface, map[string]interface{}, map[string]interface{}) {
@@ -1011,7 +1202,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Entit
 				"subjectAttribute": "",
 			},
 		}
-		return newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil), creds, token
+		return newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil, nil, nil, nil), creds, token
 	}
 
 	tests := []struct {
@@ -1063,7 +1254,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 				"otp":          "123456",
 			},
 		}
-		return newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil), creds, token
+		return newDefaultAuthnProvider(suite.mockService, nil, mockOTP, nil, nil, nil, nil, nil, nil), creds, token
 	}
 
 	setupMagicLink := func() (AuthnProviderInterface, map[string]interface{}, map[string]interface{}) {
@@ -1080,7 +1271,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 				"subjectAttribute": "email",
 			},
 		}
-		return newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil), creds, token
+		return newDefaultAuthnProvider(suite.mockService, nil, nil, mockML, nil, nil, nil, nil, nil), creds, token
 	}
 
 	tests := []struct {
@@ -1114,7 +1305,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_TokenizedAuth_Ident
 // --- Passkey authentication tests ---
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_InvalidPayload() {
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": "not-a-passkey-struct",
@@ -1128,7 +1319,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_InvalidPayl
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_NilPayload() {
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": (*passkey.PasskeyAuthenticationFinishRequest)(nil),
@@ -1144,7 +1335,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_NilPayload(
 // --- Federated authentication tests ---
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_InvalidPayload() {
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": "not-a-struct",
@@ -1158,7 +1349,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_InvalidPa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_NilPayload() {
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": (*authncommon.FederatedAuthCredential)(nil),
@@ -1172,7 +1363,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_NilPayloa
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingIDPID() {
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1189,7 +1380,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingID
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingCode() {
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1206,7 +1397,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_MissingCo
 }
 
 func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_UnsupportedIDPType() {
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil,
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil,
 		map[providers.IDPType]authncommon.FederatedAuthenticator{})
 
 	credentials := map[string]interface{}{
@@ -1233,7 +1424,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_Success() {
 			Token:               passkeyToken,
 			AuthenticatedClaims: map[string]interface{}{"userID": "pk-user-1"},
 		}, nil).Once()
-	provider := newDefaultAuthnProvider(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": &passkey.PasskeyAuthenticationFinishRequest{
@@ -1268,7 +1459,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Passkey_AuthFailed(
 			Error:            tidcommon.I18nMessage{DefaultValue: "Passkey auth failed"},
 			ErrorDescription: tidcommon.I18nMessage{DefaultValue: "Invalid passkey credential"},
 		}).Once()
-	provider := newDefaultAuthnProvider(suite.mockService, suite.mockPasskey, nil, nil, nil, nil)
+	provider := newDefaultAuthnProvider(suite.mockService, suite.mockPasskey, nil, nil, nil, nil, nil, nil, nil)
 
 	credentials := map[string]interface{}{
 		"passkey": &passkey.PasskeyAuthenticationFinishRequest{
@@ -1295,7 +1486,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_Success()
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, federatedAuths)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1337,7 +1528,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_ClientErr
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, federatedAuths)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
@@ -1365,7 +1556,7 @@ func (suite *DefaultAuthnProviderTestSuite) TestAuthenticate_Federated_ServerErr
 	federatedAuths := map[providers.IDPType]authncommon.FederatedAuthenticator{
 		providers.IDPType("google"): suite.mockFederated,
 	}
-	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, federatedAuths)
+	provider := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, federatedAuths)
 
 	credentials := map[string]interface{}{
 		"federated": &authncommon.FederatedAuthCredential{
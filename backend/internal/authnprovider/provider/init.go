@@ -22,15 +22,19 @@ import (
 	"context"
 	"time"
 
+	"github.com/thunder-id/thunderid/internal/authn/certificate"
 	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/crossdevice"
 	"github.com/thunder-id/thunderid/internal/authn/magiclink"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/push"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	systemhttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/ldap"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -42,14 +46,21 @@ func InitializeAuthnProvider(
 	otpSvc otp.OTPAuthnServiceInterface,
 	magicLinkSvc magiclink.MagicLinkAuthnServiceInterface,
 	openid4vpSvc openid4vp.OpenID4VPServiceInterface,
+	pushSvc push.PushAuthServiceInterface,
+	crossDeviceSvc crossdevice.CrossDeviceAuthServiceInterface,
+	certificateSvc certificate.CertificateAuthServiceInterface,
 	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator,
 ) AuthnProviderInterface {
 	authnProviderConfig := config.GetServerRuntime().Config.AuthnProvider
 	switch authnProviderConfig.Type {
 	case "rest":
 		return initializeRestAuthnProvider()
+	case "ldap":
+		return initializeLDAPAuthnProvider(entitySvc, initializeDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc,
+			magicLinkSvc, openid4vpSvc, pushSvc, crossDeviceSvc, certificateSvc, federatedAuths))
 	default:
-		return initializeDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, magicLinkSvc, openid4vpSvc, federatedAuths)
+		return initializeDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, magicLinkSvc, openid4vpSvc, pushSvc,
+			crossDeviceSvc, certificateSvc, federatedAuths)
 	}
 }
 
@@ -60,9 +71,13 @@ func initializeDefaultAuthnProvider(
 	otpSvc otp.OTPAuthnServiceInterface,
 	magicLinkSvc magiclink.MagicLinkAuthnServiceInterface,
 	openid4vpSvc openid4vp.OpenID4VPServiceInterface,
+	pushSvc push.PushAuthServiceInterface,
+	crossDeviceSvc crossdevice.CrossDeviceAuthServiceInterface,
+	certificateSvc certificate.CertificateAuthServiceInterface,
 	federatedAuths map[providers.IDPType]authncommon.FederatedAuthenticator,
 ) AuthnProviderInterface {
-	return newDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, magicLinkSvc, openid4vpSvc, federatedAuths)
+	return newDefaultAuthnProvider(entitySvc, passkeySvc, otpSvc, magicLinkSvc, openid4vpSvc, pushSvc,
+		crossDeviceSvc, certificateSvc, federatedAuths)
 }
 
 // initializeRestAuthnProvider initializes the REST authentication provider.
@@ -85,3 +100,52 @@ func initializeRestAuthnProvider() AuthnProviderInterface {
 	httpClient := systemhttp.NewHTTPClientWithTimeout(timeout)
 	return newRestAuthnProvider(baseURL, apiKey, correlationIDHeader, httpClient)
 }
+
+// initializeLDAPAuthnProvider initializes the LDAP authentication provider. The fallback provider
+// handles users that are not in the directory and credentials other than a password.
+func initializeLDAPAuthnProvider(
+	entitySvc entity.EntityServiceInterface, fallback AuthnProviderInterface,
+) AuthnProviderInterface {
+	ldapConfig := config.GetServerRuntime().Config.AuthnProvider.LDAP
+	// Provider initialization runs during application startup, outside any request.
+	logger := log.GetLogger()
+	if ldapConfig.URL == "" || ldapConfig.BaseDN == "" {
+		logger.Fatal(context.Background(), "AuthnProvider LDAP URL and BaseDN are required but found empty")
+	}
+	if ldapConfig.Provisioning.Enabled &&
+		(ldapConfig.Provisioning.UserType == "" || ldapConfig.Provisioning.OUID == "") {
+		logger.Fatal(context.Background(),
+			"AuthnProvider LDAP provisioning requires a user type and an organization unit ID")
+	}
+
+	settings := ldapSettings{
+		bindDN:           ldapConfig.BindDN,
+		bindPassword:     ldapConfig.BindPassword,
+		baseDN:           ldapConfig.BaseDN,
+		userObjectClass:  ldapConfig.UserObjectClass,
+		loginAttribute:   ldapConfig.LoginAttribute,
+		loginIdentifier:  ldapConfig.LoginIdentifier,
+		attributeMapping: ldapConfig.AttributeMapping,
+		provisioning:     ldapConfig.Provisioning.Enabled,
+		provisionType:    ldapConfig.Provisioning.UserType,
+		provisionOUID:    ldapConfig.Provisioning.OUID,
+	}
+	if settings.userObjectClass == "" {
+		settings.userObjectClass = "person"
+	}
+	if settings.loginAttribute == "" {
+		settings.loginAttribute = "uid"
+	}
+	if settings.loginIdentifier == "" {
+		settings.loginIdentifier = "username"
+	}
+	timeout := time.Duration(ldapConfig.Timeout) * time.Second
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+
+	dial := func(ctx context.Context) (ldap.ConnInterface, error) {
+		return ldap.Dial(ctx, ldapConfig.URL, timeout, nil)
+	}
+	return newLDAPAuthnProvider(settings, dial, entitySvc, fallback)
+}
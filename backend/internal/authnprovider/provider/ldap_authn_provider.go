@@ -0,0 +1,324 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/ldap"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ldapPasswordCredential is the credential verified by binding to the directory as the user.
+const ldapPasswordCredential = "password"
+
+// ldapSettings holds the directory settings of the LDAP authentication provider.
+type ldapSettings struct {
+	bindDN          string
+	bindPassword    string
+	baseDN          string
+	userObjectClass string
+	loginAttribute  string
+	loginIdentifier string
+	// attributeMapping maps local user attribute names to directory attribute names.
+	attributeMapping map[string]string
+	provisioning     bool
+	provisionType    string
+	provisionOUID    string
+}
+
+// ldapAuthnProvider verifies passwords by binding to an LDAP or Active Directory server as the
+// user. Users that are not in the directory and credentials other than a password are handled by
+// the fallback provider, so local users and other authentication methods keep working.
+type ldapAuthnProvider struct {
+	settings  ldapSettings
+	dial      func(ctx context.Context) (ldap.ConnInterface, error)
+	entitySvc entity.EntityServiceInterface
+	fallback  AuthnProviderInterface
+	logger    *log.Logger
+}
+
+// newLDAPAuthnProvider creates a new LDAP authentication provider.
+func newLDAPAuthnProvider(settings ldapSettings, dial func(ctx context.Context) (ldap.ConnInterface, error),
+	entitySvc entity.EntityServiceInterface, fallback AuthnProviderInterface) AuthnProviderInterface {
+	return &ldapAuthnProvider{
+		settings:  settings,
+		dial:      dial,
+		entitySvc: entitySvc,
+		fallback:  fallback,
+		logger:    log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LDAPAuthnProvider")),
+	}
+}
+
+// Authenticate verifies the password of a directory user and maps the directory attributes of
+// the user. The user is matched to a local user by the login identifier, and is created locally
+// on the first sign-in when provisioning is enabled.
+func (p *ldapAuthnProvider) Authenticate(ctx context.Context, identifiers, credentials map[string]interface{},
+	metadata *providers.AuthnMetadata) (*providers.AuthnResult, *tidcommon.ServiceError) {
+	password, isPassword := credentials[ldapPasswordCredential].(string)
+	if !isPassword || len(credentials) != 1 {
+		return p.fallback.Authenticate(ctx, identifiers, credentials, metadata)
+	}
+
+	login, svcErr := p.resolveLogin(ctx, identifiers)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if login == "" {
+		return p.fallback.Authenticate(ctx, identifiers, credentials, metadata)
+	}
+
+	entry, svcErr := p.verifyPassword(ctx, login, password)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if entry == nil {
+		p.logger.Debug(ctx, "User not found in the directory, falling back to local authentication")
+		return p.fallback.Authenticate(ctx, identifiers, credentials, metadata)
+	}
+
+	attributes := p.mapAttributes(entry)
+	attributes[p.settings.loginIdentifier] = login
+
+	return p.buildResult(ctx, login, attributes)
+}
+
+// GetEntityReference resolves the local user of an entity reference token.
+func (p *ldapAuthnProvider) GetEntityReference(ctx context.Context, entityReferenceToken any,
+) (*providers.EntityReference, *tidcommon.ServiceError) {
+	return p.fallback.GetEntityReference(ctx, entityReferenceToken)
+}
+
+// GetAttributes returns the attributes of the local user of an attribute token. Attributes of
+// directory users are returned with the authentication result and do not use a token.
+func (p *ldapAuthnProvider) GetAttributes(ctx context.Context, attributeToken any,
+	consentedAttributes *providers.RequestedAttributes,
+	metadata *providers.GetAttributesMetadata) (*providers.AttributesResponse, *tidcommon.ServiceError) {
+	return p.fallback.GetAttributes(ctx, attributeToken, consentedAttributes, metadata)
+}
+
+// resolveLogin returns the value to search the directory with. It is the login identifier input,
+// or the login attribute of the local user when the flow has already resolved the user ID.
+func (p *ldapAuthnProvider) resolveLogin(ctx context.Context,
+	identifiers map[string]interface{}) (string, *tidcommon.ServiceError) {
+	if login, ok := identifiers[p.settings.loginIdentifier].(string); ok {
+		return login, nil
+	}
+	userID, ok := identifiers[authnprovidercm.UserAttributeUserID].(string)
+	if !ok || userID == "" {
+		return "", nil
+	}
+
+	user, err := p.entitySvc.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return "", newClientError(authnprovidercm.ErrorCodeUserNotFound,
+				"User not found", "The specified user does not exist")
+		}
+		return "", p.logAndReturnServerError(ctx, "Failed to get user for directory authentication",
+			log.Error(err))
+	}
+	var attrs map[string]interface{}
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attrs); err != nil {
+			return "", p.logAndReturnServerError(ctx, "Failed to unmarshal user attributes", log.Error(err))
+		}
+	}
+	login, _ := attrs[p.settings.loginIdentifier].(string)
+	return login, nil
+}
+
+// verifyPassword finds the directory entry of the user and binds as it with the password. A nil
+// entry without an error means that the directory has no such user.
+func (p *ldapAuthnProvider) verifyPassword(ctx context.Context, login, password string,
+) (*goldap.Entry, *tidcommon.ServiceError) {
+	conn, err := p.dial(ctx)
+	if err != nil {
+		return nil, p.logAndReturnServerError(ctx, "Failed to connect to the directory", log.Error(err))
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if p.settings.bindDN != "" {
+		if err := conn.Bind(p.settings.bindDN, p.settings.bindPassword); err != nil {
+			return nil, p.logAndReturnServerError(ctx, "Failed to bind to the directory with the service account",
+				log.Error(err))
+		}
+	}
+
+	result, err := conn.Search(&goldap.SearchRequest{
+		BaseDN: p.settings.baseDN,
+		Scope:  goldap.ScopeWholeSubtree,
+		Filter: ldap.And(
+			ldap.Equal("objectClass", p.settings.userObjectClass),
+			ldap.Equal(p.settings.loginAttribute, login),
+		),
+		Attributes: p.directoryAttributes(),
+		SizeLimit:  2,
+	})
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, p.logAndReturnServerError(ctx, "Failed to search the directory for the user", log.Error(err))
+	}
+	switch {
+	case result == nil || len(result.Entries) == 0:
+		return nil, nil
+	case len(result.Entries) > 1:
+		return nil, newClientError(authnprovidercm.ErrorCodeAmbiguousUser,
+			"Ambiguous user", "Multiple directory users match the provided identifier")
+	}
+
+	// An empty password is rejected by the client rather than sent as an unauthenticated bind, which
+	// servers accept without verifying anything.
+	entry := result.Entries[0]
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.ErrorEmptyPassword) ||
+			goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, newClientError(authnprovidercm.ErrorCodeAuthenticationFailed,
+				"Authentication failed", "Invalid credentials provided")
+		}
+		return nil, p.logAndReturnServerError(ctx, "Failed to bind to the directory as the user", log.Error(err))
+	}
+	return entry, nil
+}
+
+// directoryAttributes returns the directory attributes to read, in a stable order.
+func (p *ldapAuthnProvider) directoryAttributes() []string {
+	attrs := make([]string, 0, len(p.settings.attributeMapping))
+	for _, directoryAttr := range p.settings.attributeMapping {
+		attrs = append(attrs, directoryAttr)
+	}
+	sort.Strings(attrs)
+	return attrs
+}
+
+// mapAttributes converts the directory attributes of an entry into local user attributes.
+// Multi-valued attributes are kept as lists.
+func (p *ldapAuthnProvider) mapAttributes(entry *goldap.Entry) map[string]interface{} {
+	attributes := make(map[string]interface{}, len(p.settings.attributeMapping)+1)
+	for localAttr, directoryAttr := range p.settings.attributeMapping {
+		values := entry.GetEqualFoldAttributeValues(directoryAttr)
+		switch len(values) {
+		case 0:
+			continue
+		case 1:
+			attributes[localAttr] = values[0]
+		default:
+			list := make([]interface{}, len(values))
+			for i, value := range values {
+				list[i] = value
+			}
+			attributes[localAttr] = list
+		}
+	}
+	return attributes
+}
+
+// buildResult matches the directory user to a local user, provisioning one when enabled. Without
+// a local user the result carries an entity reference token so that a later flow step can
+// provision the user.
+func (p *ldapAuthnProvider) buildResult(ctx context.Context, login string,
+	attributes map[string]interface{}) (*providers.AuthnResult, *tidcommon.ServiceError) {
+	claims := make(map[string]interface{}, len(attributes)+1)
+	for key, value := range attributes {
+		claims[key] = value
+	}
+	result := &providers.AuthnResult{
+		AuthenticatedClaims: claims,
+		Attributes:          buildAttributesResponse(attributes),
+	}
+
+	filters := map[string]interface{}{p.settings.loginIdentifier: login}
+	userID, err := p.entitySvc.IdentifyEntity(ctx, filters)
+	switch {
+	case err == nil:
+	case errors.Is(err, entity.ErrAmbiguousEntity):
+		return nil, newClientError(authnprovidercm.ErrorCodeAmbiguousUser,
+			"Ambiguous user", "Multiple users match the directory user")
+	case errors.Is(err, entity.ErrEntityNotFound):
+		if !p.settings.provisioning {
+			result.EntityReferenceToken = filters
+			return result, nil
+		}
+		created, svcErr := p.provisionUser(ctx, attributes)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		userID = &created.ID
+	default:
+		return nil, p.logAndReturnServerError(ctx, "Failed to identify the local user of a directory user",
+			log.Error(err))
+	}
+
+	user, err := p.entitySvc.GetEntity(ctx, *userID)
+	if err != nil {
+		return nil, p.logAndReturnServerError(ctx, "Failed to get the local user of a directory user",
+			log.Error(err))
+	}
+	if user.State != "" && user.State != providers.EntityStateActive {
+		return nil, newClientError(authnprovidercm.ErrorCodeUserNotActive,
+			"User not active", "The user account is not active")
+	}
+	claims[authnprovidercm.UserAttributeUserID] = user.ID
+	result.EntityReference = &providers.EntityReference{
+		EntityID:       user.ID,
+		EntityCategory: string(user.Category),
+		EntityType:     user.Type,
+		OUID:           user.OUID,
+	}
+	return result, nil
+}
+
+// provisionUser creates a local user from the mapped directory attributes.
+func (p *ldapAuthnProvider) provisionUser(ctx context.Context,
+	attributes map[string]interface{}) (*providers.Entity, *tidcommon.ServiceError) {
+	attrsJSON, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, p.logAndReturnServerError(ctx, "Failed to marshal directory attributes", log.Error(err))
+	}
+	created, err := p.entitySvc.CreateEntity(ctx, &providers.Entity{
+		Category:   providers.EntityCategoryUser,
+		Type:       p.settings.provisionType,
+		State:      providers.EntityStateActive,
+		OUID:       p.settings.provisionOUID,
+		Attributes: attrsJSON,
+	}, nil)
+	if err != nil {
+		return nil, p.logAndReturnServerError(ctx, "Failed to provision the directory user", log.Error(err))
+	}
+	p.logger.Debug(ctx, "Provisioned directory user", log.MaskedString("userID", created.ID))
+	return created, nil
+}
+
+func (p *ldapAuthnProvider) logAndReturnServerError(
+	ctx context.Context, msg string, fields ...log.Field) *tidcommon.ServiceError {
+	p.logger.Error(ctx, msg, fields...)
+	err := tidcommon.InternalServerError
+	return &err
+}
@@ -0,0 +1,293 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/ldap"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/ldapmock"
+)
+
+const testUserDN = "uid=alice,ou=people,dc=example,dc=com"
+
+type LDAPAuthnProviderTestSuite struct {
+	suite.Suite
+	mockService *entitymock.EntityServiceInterfaceMock
+	mockConn    *ldapmock.ConnInterfaceMock
+	settings    ldapSettings
+	dialErr     error
+}
+
+func TestLDAPAuthnProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(LDAPAuthnProviderTestSuite))
+}
+
+func (suite *LDAPAuthnProviderTestSuite) SetupTest() {
+	suite.mockService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockConn = ldapmock.NewConnInterfaceMock(suite.T())
+	suite.dialErr = nil
+	suite.settings = ldapSettings{
+		bindDN:           "cn=service,dc=example,dc=com",
+		bindPassword:     "service-secret",
+		baseDN:           "dc=example,dc=com",
+		userObjectClass:  "person",
+		loginAttribute:   "uid",
+		loginIdentifier:  "username",
+		attributeMapping: map[string]string{"email": "mail", "groups": "memberOf"},
+	}
+}
+
+func (suite *LDAPAuthnProviderTestSuite) newProvider() AuthnProviderInterface {
+	dial := func(ctx context.Context) (ldap.ConnInterface, error) {
+		if suite.dialErr != nil {
+			return nil, suite.dialErr
+		}
+		return suite.mockConn, nil
+	}
+	fallback := newDefaultAuthnProvider(suite.mockService, nil, nil, nil, nil, nil, nil, nil, nil)
+	return newLDAPAuthnProvider(suite.settings, dial, suite.mockService, fallback)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) expectDirectoryUser(entries []*goldap.Entry) {
+	suite.mockConn.On("Bind", "cn=service,dc=example,dc=com", "service-secret").Return(nil).Once()
+	suite.mockConn.On("Search", &goldap.SearchRequest{
+		BaseDN:     "dc=example,dc=com",
+		Scope:      goldap.ScopeWholeSubtree,
+		Filter:     "(&(objectClass=person)(uid=alice))",
+		Attributes: []string{"mail", "memberOf"},
+		SizeLimit:  2,
+	}).Return(&goldap.SearchResult{Entries: entries}, nil).Once()
+	suite.mockConn.On("Close").Return(nil).Once()
+}
+
+func directoryEntry() *goldap.Entry {
+	return goldap.NewEntry(testUserDN, map[string][]string{
+		"mail":     {"alice@example.com"},
+		"memberOf": {"cn=admins", "cn=staff"},
+	})
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_ExistingLocalUser() {
+	suite.expectDirectoryUser([]*goldap.Entry{directoryEntry()})
+	suite.mockConn.On("Bind", testUserDN, "secret").Return(nil).Once()
+	userID := "user-1"
+	suite.mockService.On("IdentifyEntity", mock.Anything, map[string]interface{}{"username": "alice"}).
+		Return(&userID, nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-1").Return(&providers.Entity{
+		ID: "user-1", Category: providers.EntityCategoryUser, Type: "employee",
+		State: providers.EntityStateActive, OUID: "ou-1",
+	}, nil).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Require().Nil(err)
+	suite.Equal(&providers.EntityReference{
+		EntityID: "user-1", EntityCategory: "user", EntityType: "employee", OUID: "ou-1",
+	}, result.EntityReference)
+	suite.Equal("alice@example.com", result.AuthenticatedClaims["email"])
+	suite.Equal([]interface{}{"cn=admins", "cn=staff"}, result.AuthenticatedClaims["groups"])
+	suite.Equal("user-1", result.AuthenticatedClaims[authnprovidercm.UserAttributeUserID])
+	suite.Equal("alice@example.com", result.Attributes.Attributes["email"].Value)
+	suite.NotContains(result.Attributes.Attributes, authnprovidercm.UserAttributeUserID)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_ResolvesLoginFromUserID() {
+	suite.expectDirectoryUser([]*goldap.Entry{directoryEntry()})
+	suite.mockConn.On("Bind", testUserDN, "secret").Return(nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-1").Return(&providers.Entity{
+		ID: "user-1", Category: providers.EntityCategoryUser, Type: "employee",
+		Attributes: json.RawMessage(`{"username":"alice"}`),
+	}, nil).Twice()
+	userID := "user-1"
+	suite.mockService.On("IdentifyEntity", mock.Anything, map[string]interface{}{"username": "alice"}).
+		Return(&userID, nil).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{authnprovidercm.UserAttributeUserID: "user-1"},
+		map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Require().Nil(err)
+	suite.Equal("user-1", result.EntityReference.EntityID)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_InvalidCredentials() {
+	suite.expectDirectoryUser([]*goldap.Entry{directoryEntry()})
+	suite.mockConn.On("Bind", testUserDN, "wrong").
+		Return(goldap.NewError(goldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": "wrong"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(tidcommon.ClientErrorType, err.Type)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_EmptyPassword() {
+	suite.expectDirectoryUser([]*goldap.Entry{directoryEntry()})
+	suite.mockConn.On("Bind", testUserDN, "").
+		Return(goldap.NewError(goldap.ErrorEmptyPassword, errors.New("empty password not allowed"))).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": ""}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_AmbiguousDirectoryUser() {
+	suite.expectDirectoryUser([]*goldap.Entry{directoryEntry(), directoryEntry()})
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAmbiguousUser, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_NotInDirectoryFallsBackToLocalUser() {
+	suite.expectDirectoryUser(nil)
+	identifiers := map[string]interface{}{"username": "alice"}
+	credentials := map[string]interface{}{"password": "secret"}
+	suite.mockService.On("AuthenticateEntity", mock.Anything, identifiers, credentials).
+		Return(&entity.AuthenticateResult{EntityID: "user-1", EntityCategory: providers.EntityCategoryUser},
+			nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-1").
+		Return(&providers.Entity{ID: "user-1", Category: providers.EntityCategoryUser}, nil).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Require().Nil(err)
+	suite.Equal("user-1", result.EntityReference.EntityID)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_NonPasswordCredentialUsesFallback() {
+	identifiers := map[string]interface{}{"username": "alice"}
+	credentials := map[string]interface{}{"pin": "1234"}
+	suite.mockService.On("AuthenticateEntity", mock.Anything, identifiers, credentials).
+		Return(nil, entity.ErrAuthenticationFailed).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(), identifiers, credentials, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeAuthenticationFailed, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_UnknownLocalUserWithoutProvisioning() {
+	suite.expectDirectoryUser([]*goldap.Entry{directoryEntry()})
+	suite.mockConn.On("Bind", testUserDN, "secret").Return(nil).Once()
+	suite.mockService.On("IdentifyEntity", mock.Anything, map[string]interface{}{"username": "alice"}).
+		Return(nil, entity.ErrEntityNotFound).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Require().Nil(err)
+	suite.Nil(result.EntityReference)
+	suite.Equal(map[string]interface{}{"username": "alice"}, result.EntityReferenceToken)
+	suite.Equal("alice@example.com", result.AuthenticatedClaims["email"])
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_ProvisionsUnknownLocalUser() {
+	suite.settings.provisioning = true
+	suite.settings.provisionType = "employee"
+	suite.settings.provisionOUID = "ou-1"
+	suite.expectDirectoryUser([]*goldap.Entry{directoryEntry()})
+	suite.mockConn.On("Bind", testUserDN, "secret").Return(nil).Once()
+	suite.mockService.On("IdentifyEntity", mock.Anything, map[string]interface{}{"username": "alice"}).
+		Return(nil, entity.ErrEntityNotFound).Once()
+
+	var provisioned map[string]interface{}
+	suite.mockService.On("CreateEntity", mock.Anything, mock.MatchedBy(func(e *providers.Entity) bool {
+		return e.Category == providers.EntityCategoryUser && e.Type == "employee" && e.OUID == "ou-1" &&
+			e.State == providers.EntityStateActive && json.Unmarshal(e.Attributes, &provisioned) == nil
+	}), json.RawMessage(nil)).Return(&providers.Entity{ID: "user-2"}, nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-2").Return(&providers.Entity{
+		ID: "user-2", Category: providers.EntityCategoryUser, Type: "employee",
+		State: providers.EntityStateActive, OUID: "ou-1",
+	}, nil).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Require().Nil(err)
+	suite.Equal("user-2", result.EntityReference.EntityID)
+	suite.Equal("alice", provisioned["username"])
+	suite.Equal("alice@example.com", provisioned["email"])
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_InactiveLocalUser() {
+	suite.expectDirectoryUser([]*goldap.Entry{directoryEntry()})
+	suite.mockConn.On("Bind", testUserDN, "secret").Return(nil).Once()
+	userID := "user-1"
+	suite.mockService.On("IdentifyEntity", mock.Anything, mock.Anything).Return(&userID, nil).Once()
+	suite.mockService.On("GetEntity", mock.Anything, "user-1").
+		Return(&providers.Entity{ID: "user-1", State: providers.EntityStateDisabled}, nil).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(authnprovidercm.ErrorCodeUserNotActive, err.Code)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_ServiceBindFailure() {
+	suite.mockConn.On("Bind", "cn=service,dc=example,dc=com", "service-secret").
+		Return(goldap.NewError(goldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))).Once()
+	suite.mockConn.On("Close").Return(nil).Once()
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(tidcommon.ServerErrorType, err.Type)
+}
+
+func (suite *LDAPAuthnProviderTestSuite) TestAuthenticate_DialFailure() {
+	suite.dialErr = errors.New("connection refused")
+
+	result, err := suite.newProvider().Authenticate(context.Background(),
+		map[string]interface{}{"username": "alice"}, map[string]interface{}{"password": "secret"}, nil)
+
+	suite.Nil(result)
+	suite.Require().NotNil(err)
+	suite.Equal(tidcommon.ServerErrorType, err.Type)
+}
@@ -61,6 +61,9 @@ func (h *handler) HandleMetadataRequest(w http.ResponseWriter, r *http.Request)
 func (h *handler) HandleAccessEvaluationRequest(w http.ResponseWriter, r *http.Request) {
 	req, err := sysutils.DecodeJSONBody[AccessEvaluationRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(w, &ErrorInvalidRequestFormat)
 		return
 	}
@@ -78,6 +81,9 @@ func (h *handler) HandleAccessEvaluationRequest(w http.ResponseWriter, r *http.R
 func (h *handler) HandleAccessEvaluationsRequest(w http.ResponseWriter, r *http.Request) {
 	req, err := sysutils.DecodeJSONBody[AccessEvaluationsRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(w, &ErrorInvalidRequestFormat)
 		return
 	}
@@ -95,6 +101,9 @@ func (h *handler) HandleAccessEvaluationsRequest(w http.ResponseWriter, r *http.
 func (h *handler) HandleActionSearchRequest(w http.ResponseWriter, r *http.Request) {
 	req, err := sysutils.DecodeJSONBody[AccessActionSearchRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(w, &ErrorInvalidRequestFormat)
 		return
 	}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package claimsource provides management of external claim sources. A claim source is an external
+// claims provider whose claims are released in ID tokens and userinfo responses as OIDC aggregated or
+// distributed claims instead of being resolved from the user's own attributes.
+package claimsource
+
+import (
+	"regexp"
+	"time"
+)
+
+const (
+	// loggerComponentName is the component name used for logging in the claimsource package.
+	loggerComponentName = "ClaimSourceService"
+
+	// maxClaimSourceNameLength is the maximum length of a claim source name.
+	maxClaimSourceNameLength = 100
+	// maxClaimSourceEndpointLength is the maximum length of a claim source endpoint.
+	maxClaimSourceEndpointLength = 2048
+	// maxClaimSourceClaimLength is the maximum length of a claim name served by a claim source.
+	maxClaimSourceClaimLength = 100
+	// maxClaimSourceRequestBodyBytes caps the create and update request bodies; claim sources are small.
+	maxClaimSourceRequestBodyBytes = 64 << 10 // 64 KiB
+	// maxAggregatedClaimsBytes caps the signed JWT fetched from an aggregated claim source.
+	maxAggregatedClaimsBytes = 64 << 10 // 64 KiB
+
+	// sourceAccessTokenValidity is the validity period, in seconds, of the access tokens issued to
+	// claim source endpoints.
+	sourceAccessTokenValidity = 300
+	// aggregatedClaimsFetchTimeout bounds the time spent fetching claims from an aggregated claim source.
+	aggregatedClaimsFetchTimeout = 5 * time.Second
+
+	// claimNamesMember is the OIDC member that maps claim names to the claim sources serving them.
+	claimNamesMember = "_claim_names"
+	// claimSourcesMember is the OIDC member that describes each referenced claim source.
+	claimSourcesMember = "_claim_sources"
+)
+
+// claimSourceNameRegex validates claim source names, which are used as the source keys in the
+// _claim_names and _claim_sources members.
+var claimSourceNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for claim source operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "CLS-1001",
+		Error: common.I18nMessage{
+			Key:          "error.claimsourceservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.claimsourceservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidName is the error returned when the claim source name is missing or malformed.
+	ErrorInvalidName = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "CLS-1002",
+		Error: common.I18nMessage{
+			Key:          "error.claimsourceservice.invalid_name",
+			DefaultValue: "Invalid claim source name",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key: "error.claimsourceservice.invalid_name_description",
+			DefaultValue: "The claim source name is required, must not exceed 100 characters, must start with a " +
+				"letter or digit and may contain only letters, digits, dots, underscores and hyphens",
+		},
+	}
+
+	// ErrorInvalidType is the error returned when the claim source type is not supported.
+	ErrorInvalidType = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "CLS-1003",
+		Error: common.I18nMessage{
+			Key:          "error.claimsourceservice.invalid_type",
+			DefaultValue: "Invalid claim source type",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.claimsourceservice.invalid_type_description",
+			DefaultValue: "The claim source type must be aggregated or distributed",
+		},
+	}
+
+	// ErrorInvalidEndpoint is the error returned when the claim source endpoint is not an absolute HTTPS URL.
+	ErrorInvalidEndpoint = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "CLS-1004",
+		Error: common.I18nMessage{
+			Key:          "error.claimsourceservice.invalid_endpoint",
+			DefaultValue: "Invalid claim source endpoint",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.claimsourceservice.invalid_endpoint_description",
+			DefaultValue: "The claim source endpoint must be an absolute HTTPS URL of at most 2048 characters",
+		},
+	}
+
+	// ErrorInvalidClaims is the error returned when the claim source serves no claims or a claim name is invalid.
+	ErrorInvalidClaims = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "CLS-1005",
+		Error: common.I18nMessage{
+			Key:          "error.claimsourceservice.invalid_claims",
+			DefaultValue: "Invalid claims",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key: "error.claimsourceservice.invalid_claims_description",
+			DefaultValue: "At least one claim is required, and each claim name must be non-empty, must not exceed " +
+				"100 characters and must not start with an underscore",
+		},
+	}
+
+	// ErrorClaimSourceNotFound is the error returned when the claim source does not exist.
+	ErrorClaimSourceNotFound = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "CLS-1006",
+		Error: common.I18nMessage{
+			Key:          "error.claimsourceservice.claim_source_not_found",
+			DefaultValue: "Claim source not found",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.claimsourceservice.claim_source_not_found_description",
+			DefaultValue: "The claim source with the specified id does not exist",
+		},
+	}
+
+	// ErrorClaimSourceAlreadyExists is the error returned when a claim source with the same name already exists.
+	ErrorClaimSourceAlreadyExists = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "CLS-1007",
+		Error: common.I18nMessage{
+			Key:          "error.claimsourceservice.claim_source_already_exists",
+			DefaultValue: "Claim source already exists",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.claimsourceservice.claim_source_already_exists_description",
+			DefaultValue: "A claim source with the same name already exists",
+		},
+	}
+)
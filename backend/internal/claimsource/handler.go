@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// claimSourceHandler is the handler for claim source management operations.
+type claimSourceHandler struct {
+	claimSourceService ClaimSourceServiceInterface
+}
+
+// newClaimSourceHandler creates a new instance of claimSourceHandler.
+func newClaimSourceHandler(claimSourceService ClaimSourceServiceInterface) *claimSourceHandler {
+	return &claimSourceHandler{claimSourceService: claimSourceService}
+}
+
+// HandleListClaimSources handles GET /claim-sources, returning every claim source.
+func (h *claimSourceHandler) HandleListClaimSources(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sources, svcErr := h.claimSourceService.ListClaimSources(ctx)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, ClaimSourceListResponse{
+		TotalResults: len(sources),
+		ClaimSources: sources,
+	})
+}
+
+// HandleCreateClaimSource handles POST /claim-sources, registering a new claim source.
+func (h *claimSourceHandler) HandleCreateClaimSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	request, ok := decodeClaimSourceRequest(w, r)
+	if !ok {
+		return
+	}
+
+	source, svcErr := h.claimSourceService.CreateClaimSource(ctx, request)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, source)
+}
+
+// HandleGetClaimSource handles GET /claim-sources/{id}, returning a claim source.
+func (h *claimSourceHandler) HandleGetClaimSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	source, svcErr := h.claimSourceService.GetClaimSource(ctx, r.PathValue("id"))
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, source)
+}
+
+// HandleUpdateClaimSource handles PUT /claim-sources/{id}, replacing a claim source.
+func (h *claimSourceHandler) HandleUpdateClaimSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	request, ok := decodeClaimSourceRequest(w, r)
+	if !ok {
+		return
+	}
+
+	source, svcErr := h.claimSourceService.UpdateClaimSource(ctx, r.PathValue("id"), request)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, source)
+}
+
+// HandleDeleteClaimSource handles DELETE /claim-sources/{id}, deleting a claim source.
+func (h *claimSourceHandler) HandleDeleteClaimSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if svcErr := h.claimSourceService.DeleteClaimSource(ctx, r.PathValue("id")); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeClaimSourceRequest decodes the claim source request body, writing an error response when it is malformed.
+func decodeClaimSourceRequest(w http.ResponseWriter, r *http.Request) (ClaimSourceRequest, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxClaimSourceRequestBodyBytes)
+	var request ClaimSourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handleError(r.Context(), w, &ErrorInvalidRequestFormat)
+		return ClaimSourceRequest{}, false
+	}
+	return request, true
+}
+
+// handleError maps a service error to an HTTP error response.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *common.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == common.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorClaimSourceNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorClaimSourceAlreadyExists.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
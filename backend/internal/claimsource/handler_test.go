@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *ClaimSourceServiceInterfaceMock
+	handler     *claimSourceHandler
+	mux         *http.ServeMux
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.mockService = NewClaimSourceServiceInterfaceMock(suite.T())
+	suite.handler = newClaimSourceHandler(suite.mockService)
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, suite.handler)
+}
+
+func (suite *HandlerTestSuite) decodeErrorCode(body []byte) string {
+	var errResp struct {
+		Code string `json:"code"`
+	}
+	suite.Require().NoError(json.Unmarshal(body, &errResp))
+	return errResp.Code
+}
+
+func (suite *HandlerTestSuite) TestHandleListClaimSources_OK() {
+	suite.mockService.EXPECT().ListClaimSources(mock.Anything).Return([]ClaimSource{
+		testClaimSource("c1", "credit", ClaimSourceTypeAggregated, testCreditEndpoint, "credit_score"),
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/claim-sources", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var resp ClaimSourceListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), 1, resp.TotalResults)
+	assert.Equal(suite.T(), "credit", resp.ClaimSources[0].Name)
+}
+
+func (suite *HandlerTestSuite) TestHandleCreateClaimSource_Created() {
+	suite.mockService.EXPECT().CreateClaimSource(mock.Anything, ClaimSourceRequest{
+		Name: "credit", Type: ClaimSourceTypeAggregated, Endpoint: testCreditEndpoint,
+		Claims: []string{"credit_score"},
+	}).Return(&ClaimSource{ID: "c1", Name: "credit"}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/claim-sources", strings.NewReader(
+		`{"name":"credit","type":"aggregated","endpoint":"`+testCreditEndpoint+`","claims":["credit_score"]}`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	var resp ClaimSource
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), "c1", resp.ID)
+}
+
+func (suite *HandlerTestSuite) TestHandleCreateClaimSource_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/claim-sources", strings.NewReader(`{`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Equal(suite.T(), ErrorInvalidRequestFormat.Code, suite.decodeErrorCode(w.Body.Bytes()))
+}
+
+func (suite *HandlerTestSuite) TestHandleCreateClaimSource_Conflict() {
+	suite.mockService.EXPECT().CreateClaimSource(mock.Anything, mock.Anything).
+		Return(nil, &ErrorClaimSourceAlreadyExists)
+
+	req := httptest.NewRequest(http.MethodPost, "/claim-sources", strings.NewReader(`{"name":"credit"}`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleGetClaimSource_NotFound() {
+	suite.mockService.EXPECT().GetClaimSource(mock.Anything, "c1").Return(nil, &ErrorClaimSourceNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/claim-sources/c1", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Equal(suite.T(), ErrorClaimSourceNotFound.Code, suite.decodeErrorCode(w.Body.Bytes()))
+}
+
+func (suite *HandlerTestSuite) TestHandleUpdateClaimSource_OK() {
+	suite.mockService.EXPECT().UpdateClaimSource(mock.Anything, "c1", ClaimSourceRequest{Name: "credit"}).
+		Return(&ClaimSource{ID: "c1", Name: "credit"}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/claim-sources/c1", strings.NewReader(`{"name":"credit"}`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleDeleteClaimSource() {
+	suite.mockService.EXPECT().DeleteClaimSource(mock.Anything, "c1").Return(nil)
+	suite.mockService.EXPECT().DeleteClaimSource(mock.Anything, "c2").Return(&common.InternalServerError)
+
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/claim-sources/c1", nil))
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+
+	w = httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/claim-sources/c2", nil))
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	"net/http"
+
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize wires the claim source store, service, and management routes. The JWT service issues
+// the access tokens presented to claim source endpoints.
+func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface) ClaimSourceServiceInterface {
+	service := newClaimSourceService(newClaimSourceStore(), jwtService,
+		syshttp.NewHTTPClientWithTimeout(aggregatedClaimsFetchTimeout))
+	registerRoutes(mux, newClaimSourceHandler(service))
+	return service
+}
+
+// registerRoutes registers the routes for claim source management operations.
+func registerRoutes(mux *http.ServeMux, handler *claimSourceHandler) {
+	noContent := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /claim-sources", handler.HandleListClaimSources, listOpts))
+	mux.HandleFunc(middleware.WithCORS("POST /claim-sources", handler.HandleCreateClaimSource, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /claim-sources", noContent, listOpts))
+
+	itemOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /claim-sources/{id}", handler.HandleGetClaimSource, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT /claim-sources/{id}", handler.HandleUpdateClaimSource, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /claim-sources/{id}", handler.HandleDeleteClaimSource, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /claim-sources/{id}", noContent, itemOpts))
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+// ClaimSourceType defines how the claims of a claim source are released to relying parties.
+type ClaimSourceType string
+
+const (
+	// ClaimSourceTypeAggregated fetches a signed JWT holding the claims from the claim source when the
+	// token or userinfo response is built, and embeds it as an aggregated claim.
+	ClaimSourceTypeAggregated ClaimSourceType = "aggregated"
+	// ClaimSourceTypeDistributed references the claim source endpoint together with an access token
+	// the relying party presents to it to fetch the claims itself.
+	ClaimSourceTypeDistributed ClaimSourceType = "distributed"
+)
+
+// ClaimSource represents an external claims provider.
+type ClaimSource struct {
+	ID       string          `json:"id"`
+	Name     string          `json:"name"`
+	Type     ClaimSourceType `json:"type"`
+	Endpoint string          `json:"endpoint"`
+	Claims   []string        `json:"claims"`
+}
+
+// ClaimSourceRequest represents the request body for creating or updating a claim source.
+type ClaimSourceRequest struct {
+	Name     string          `json:"name"`
+	Type     ClaimSourceType `json:"type"`
+	Endpoint string          `json:"endpoint"`
+	Claims   []string        `json:"claims"`
+}
+
+// ClaimSourceListResponse represents the response body for claim source listings.
+type ClaimSourceListResponse struct {
+	TotalResults int           `json:"totalResults"`
+	ClaimSources []ClaimSource `json:"claimSources"`
+}
@@ -0,0 +1,337 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// ClaimSourceServiceInterface defines the operations for managing claim sources and releasing their
+// claims as OIDC aggregated and distributed claims.
+type ClaimSourceServiceInterface interface {
+	ListClaimSources(ctx context.Context) ([]ClaimSource, *common.ServiceError)
+	CreateClaimSource(ctx context.Context, request ClaimSourceRequest) (*ClaimSource, *common.ServiceError)
+	GetClaimSource(ctx context.Context, id string) (*ClaimSource, *common.ServiceError)
+	UpdateClaimSource(ctx context.Context, id string, request ClaimSourceRequest) (
+		*ClaimSource, *common.ServiceError)
+	DeleteClaimSource(ctx context.Context, id string) *common.ServiceError
+	AddClaimReferences(ctx context.Context, claims map[string]interface{}, subject, clientID string,
+		claimNames []string)
+}
+
+// claimSourceService is the default implementation of ClaimSourceServiceInterface.
+type claimSourceService struct {
+	store      claimSourceStoreInterface
+	jwtService jwt.JWTServiceInterface
+	httpClient syshttp.HTTPClientInterface
+	logger     *log.Logger
+}
+
+// newClaimSourceService creates a new instance of claimSourceService.
+func newClaimSourceService(
+	store claimSourceStoreInterface,
+	jwtService jwt.JWTServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+) ClaimSourceServiceInterface {
+	return &claimSourceService{
+		store:      store,
+		jwtService: jwtService,
+		httpClient: httpClient,
+		logger:     log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ListClaimSources returns all claim sources ordered by name.
+func (s *claimSourceService) ListClaimSources(ctx context.Context) ([]ClaimSource, *common.ServiceError) {
+	sources, err := s.store.ListClaimSources(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list claim sources", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	return sources, nil
+}
+
+// CreateClaimSource registers a new claim source. Claim source names are unique.
+func (s *claimSourceService) CreateClaimSource(
+	ctx context.Context, request ClaimSourceRequest) (*ClaimSource, *common.ServiceError) {
+	source, svcErr := buildClaimSource(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if _, err := s.store.GetClaimSourceByName(ctx, source.Name); err == nil {
+		return nil, &ErrorClaimSourceAlreadyExists
+	} else if !errors.Is(err, errClaimSourceNotFound) {
+		s.logger.Error(ctx, "Failed to get claim source by name", log.String("name", source.Name), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate claim source ID", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	source.ID = id
+
+	if err := s.store.CreateClaimSource(ctx, source); err != nil {
+		s.logger.Error(ctx, "Failed to create claim source", log.String("name", source.Name), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Created claim source", log.String("id", source.ID), log.String("name", source.Name))
+	return &source, nil
+}
+
+// GetClaimSource returns the claim source with the given ID.
+func (s *claimSourceService) GetClaimSource(ctx context.Context, id string) (*ClaimSource, *common.ServiceError) {
+	source, err := s.store.GetClaimSourceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, errClaimSourceNotFound) {
+			return nil, &ErrorClaimSourceNotFound
+		}
+		s.logger.Error(ctx, "Failed to get claim source", log.String("id", id), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	return &source, nil
+}
+
+// UpdateClaimSource replaces the claim source with the given ID. Renaming a claim source to the name
+// of another claim source is rejected.
+func (s *claimSourceService) UpdateClaimSource(
+	ctx context.Context, id string, request ClaimSourceRequest) (*ClaimSource, *common.ServiceError) {
+	source, svcErr := buildClaimSource(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	source.ID = id
+
+	existing, err := s.store.GetClaimSourceByName(ctx, source.Name)
+	if err == nil && existing.ID != id {
+		return nil, &ErrorClaimSourceAlreadyExists
+	} else if err != nil && !errors.Is(err, errClaimSourceNotFound) {
+		s.logger.Error(ctx, "Failed to get claim source by name", log.String("name", source.Name), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	if err := s.store.UpdateClaimSource(ctx, source); err != nil {
+		if errors.Is(err, errClaimSourceNotFound) {
+			return nil, &ErrorClaimSourceNotFound
+		}
+		s.logger.Error(ctx, "Failed to update claim source", log.String("id", id), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Updated claim source", log.String("id", id), log.String("name", source.Name))
+	return &source, nil
+}
+
+// DeleteClaimSource deletes the claim source with the given ID.
+func (s *claimSourceService) DeleteClaimSource(ctx context.Context, id string) *common.ServiceError {
+	if err := s.store.DeleteClaimSource(ctx, id); err != nil {
+		if errors.Is(err, errClaimSourceNotFound) {
+			return &ErrorClaimSourceNotFound
+		}
+		s.logger.Error(ctx, "Failed to delete claim source", log.String("id", id), log.Error(err))
+		return &common.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Deleted claim source", log.String("id", id))
+	return nil
+}
+
+// AddClaimReferences adds the _claim_names and _claim_sources members for the given claim names that
+// are served by a claim source and not already present in claims. A claim served by more than one
+// source is referenced from the first source by name. Aggregated sources are called with an access
+// token issued to the subject and client; distributed sources are referenced with such a token for
+// the relying party to call them itself. Per OIDC, claims that cannot be resolved are simply
+// omitted, so a failing claim source is logged and skipped rather than failing the response.
+func (s *claimSourceService) AddClaimReferences(ctx context.Context, claims map[string]interface{},
+	subject, clientID string, claimNames []string) {
+	pending := make([]string, 0, len(claimNames))
+	for _, name := range claimNames {
+		if _, ok := claims[name]; !ok && !slices.Contains(pending, name) {
+			pending = append(pending, name)
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	sources, err := s.store.ListClaimSources(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list claim sources", log.Error(err))
+		return
+	}
+
+	claimRefs := make(map[string]interface{})
+	sourceRefs := make(map[string]interface{})
+	for _, source := range sources {
+		served := make([]string, 0, len(source.Claims))
+		for _, name := range pending {
+			if _, referenced := claimRefs[name]; !referenced && slices.Contains(source.Claims, name) {
+				served = append(served, name)
+			}
+		}
+		if len(served) == 0 {
+			continue
+		}
+
+		sourceRef, err := s.buildSourceReference(ctx, source, subject, clientID)
+		if err != nil {
+			s.logger.Warn(ctx, "Skipping claim source", log.String("name", source.Name), log.Error(err))
+			continue
+		}
+		sourceRefs[source.Name] = sourceRef
+		for _, name := range served {
+			claimRefs[name] = source.Name
+		}
+	}
+
+	if len(sourceRefs) > 0 {
+		claims[claimNamesMember] = claimRefs
+		claims[claimSourcesMember] = sourceRefs
+	}
+}
+
+// buildSourceReference builds the _claim_sources entry of a claim source.
+func (s *claimSourceService) buildSourceReference(ctx context.Context, source ClaimSource,
+	subject, clientID string) (map[string]interface{}, error) {
+	accessToken, err := s.issueSourceAccessToken(ctx, source, subject, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if source.Type == ClaimSourceTypeDistributed {
+		return map[string]interface{}{"endpoint": source.Endpoint, "access_token": accessToken}, nil
+	}
+
+	claimsJWT, err := s.fetchAggregatedClaims(ctx, source, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"JWT": claimsJWT}, nil
+}
+
+// issueSourceAccessToken issues a short-lived access token for the claim source endpoint. The token
+// identifies the subject whose claims are requested and the client they are released to.
+func (s *claimSourceService) issueSourceAccessToken(ctx context.Context, source ClaimSource,
+	subject, clientID string) (string, error) {
+	tokenClaims := map[string]interface{}{
+		"aud":       source.Endpoint,
+		"client_id": clientID,
+	}
+	token, _, svcErr := s.jwtService.GenerateJWT(ctx, subject, config.GetServerRuntime().Config.JWT.Issuer,
+		sourceAccessTokenValidity, tokenClaims, jwt.TokenTypeAccessToken, "")
+	if svcErr != nil {
+		return "", fmt.Errorf("failed to issue claim source access token: %s", svcErr.Error.DefaultValue)
+	}
+	return token, nil
+}
+
+// fetchAggregatedClaims fetches the signed JWT holding the subject's claims from an aggregated claim
+// source.
+func (s *claimSourceService) fetchAggregatedClaims(
+	ctx context.Context, source ClaimSource, accessToken string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source.Endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build claim source request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/jwt")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to call claim source: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("claim source returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxAggregatedClaimsBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read claim source response: %w", err)
+	}
+	if len(body) > maxAggregatedClaimsBytes {
+		return "", errors.New("claim source response exceeds the size limit")
+	}
+	claimsJWT := strings.TrimSpace(string(body))
+	if strings.Count(claimsJWT, ".") != 2 {
+		return "", errors.New("claim source response is not a signed JWT")
+	}
+	return claimsJWT, nil
+}
+
+// buildClaimSource validates a create or update request and converts it into a claim source.
+func buildClaimSource(request ClaimSourceRequest) (ClaimSource, *common.ServiceError) {
+	source := ClaimSource{
+		Name:     strings.TrimSpace(request.Name),
+		Type:     request.Type,
+		Endpoint: strings.TrimSpace(request.Endpoint),
+		Claims:   make([]string, 0, len(request.Claims)),
+	}
+
+	if len(source.Name) > maxClaimSourceNameLength || !claimSourceNameRegex.MatchString(source.Name) {
+		return ClaimSource{}, &ErrorInvalidName
+	}
+	if source.Type != ClaimSourceTypeAggregated && source.Type != ClaimSourceTypeDistributed {
+		return ClaimSource{}, &ErrorInvalidType
+	}
+	if !isValidEndpoint(source.Endpoint) {
+		return ClaimSource{}, &ErrorInvalidEndpoint
+	}
+	for _, claim := range request.Claims {
+		claim = strings.TrimSpace(claim)
+		if claim == "" || len(claim) > maxClaimSourceClaimLength || strings.HasPrefix(claim, "_") {
+			return ClaimSource{}, &ErrorInvalidClaims
+		}
+		if !slices.Contains(source.Claims, claim) {
+			source.Claims = append(source.Claims, claim)
+		}
+	}
+	if len(source.Claims) == 0 {
+		return ClaimSource{}, &ErrorInvalidClaims
+	}
+	return source, nil
+}
+
+// isValidEndpoint reports whether the endpoint is an absolute HTTPS URL without a fragment.
+func isValidEndpoint(endpoint string) bool {
+	if endpoint == "" || len(endpoint) > maxClaimSourceEndpointLength {
+		return false
+	}
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	return parsed.Scheme == "https" && parsed.Host != "" && parsed.Fragment == ""
+}
@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testIssuer          = "https://id.example.com"
+	testAggregatedJWT   = "eyJhbGciOiJSUzI1NiJ9.eyJjcmVkaXRfc2NvcmUiOjcwMH0.c2ln"
+	testSourceToken     = "source-access-token"
+	testCreditEndpoint  = "https://credit.example.com/claims"
+	testPayrollEndpoint = "https://payroll.example.com/claims"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockStore      *claimSourceStoreInterfaceMock
+	mockJWTService *jwtmock.JWTServiceInterfaceMock
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+	service        ClaimSourceServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("/tmp/test", &config.Config{
+		JWT: engineconfig.JWTConfig{Issuer: testIssuer},
+	}))
+	suite.ctx = context.Background()
+	suite.mockStore = newClaimSourceStoreInterfaceMock(suite.T())
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newClaimSourceService(suite.mockStore, suite.mockJWTService, suite.mockHTTPClient)
+}
+
+func (suite *ServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func testClaimSource(id, name string, sourceType ClaimSourceType, endpoint string, claims ...string) ClaimSource {
+	return ClaimSource{ID: id, Name: name, Type: sourceType, Endpoint: endpoint, Claims: claims}
+}
+
+func (suite *ServiceTestSuite) expectSourceToken(endpoint string) {
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-1", testIssuer, int64(sourceAccessTokenValidity),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["aud"] == endpoint && claims["client_id"] == "client-1"
+		}), jwt.TokenTypeAccessToken, "").Return(testSourceToken, int64(0), nil).Once()
+}
+
+// --- CreateClaimSource ---
+
+func (suite *ServiceTestSuite) TestCreateClaimSource_Success() {
+	suite.mockStore.On("GetClaimSourceByName", mock.Anything, "credit").Return(ClaimSource{}, errClaimSourceNotFound)
+	suite.mockStore.On("CreateClaimSource", mock.Anything, mock.MatchedBy(func(s ClaimSource) bool {
+		return s.ID != "" && s.Name == "credit" && s.Endpoint == testCreditEndpoint &&
+			len(s.Claims) == 1 && s.Claims[0] == "credit_score"
+	})).Return(nil)
+
+	source, svcErr := suite.service.CreateClaimSource(suite.ctx, ClaimSourceRequest{
+		Name: " credit ", Type: ClaimSourceTypeAggregated, Endpoint: testCreditEndpoint,
+		Claims: []string{"credit_score", " credit_score"},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal("credit", source.Name)
+	suite.Equal([]string{"credit_score"}, source.Claims)
+}
+
+func (suite *ServiceTestSuite) TestCreateClaimSource_Validation() {
+	valid := func(modify func(*ClaimSourceRequest)) ClaimSourceRequest {
+		request := ClaimSourceRequest{Name: "credit", Type: ClaimSourceTypeDistributed,
+			Endpoint: testCreditEndpoint, Claims: []string{"credit_score"}}
+		modify(&request)
+		return request
+	}
+	cases := []struct {
+		name     string
+		request  ClaimSourceRequest
+		expected string
+	}{
+		{"MissingName", valid(func(r *ClaimSourceRequest) { r.Name = "" }), ErrorInvalidName.Code},
+		{"NameWithSpace", valid(func(r *ClaimSourceRequest) { r.Name = "credit bureau" }), ErrorInvalidName.Code},
+		{"NameTooLong", valid(func(r *ClaimSourceRequest) { r.Name = strings.Repeat("a", 101) }),
+			ErrorInvalidName.Code},
+		{"UnknownType", valid(func(r *ClaimSourceRequest) { r.Type = "federated" }), ErrorInvalidType.Code},
+		{"HTTPEndpoint", valid(func(r *ClaimSourceRequest) { r.Endpoint = "http://credit.example.com" }),
+			ErrorInvalidEndpoint.Code},
+		{"RelativeEndpoint", valid(func(r *ClaimSourceRequest) { r.Endpoint = "/claims" }),
+			ErrorInvalidEndpoint.Code},
+		{"NoClaims", valid(func(r *ClaimSourceRequest) { r.Claims = nil }), ErrorInvalidClaims.Code},
+		{"BlankClaim", valid(func(r *ClaimSourceRequest) { r.Claims = []string{" "} }), ErrorInvalidClaims.Code},
+		{"ReservedClaim", valid(func(r *ClaimSourceRequest) { r.Claims = []string{"_claim_names"} }),
+			ErrorInvalidClaims.Code},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			_, svcErr := suite.service.CreateClaimSource(suite.ctx, tc.request)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestCreateClaimSource_AlreadyExists() {
+	suite.mockStore.On("GetClaimSourceByName", mock.Anything, "credit").
+		Return(testClaimSource("c1", "credit", ClaimSourceTypeAggregated, testCreditEndpoint, "credit_score"), nil)
+
+	_, svcErr := suite.service.CreateClaimSource(suite.ctx, ClaimSourceRequest{
+		Name: "credit", Type: ClaimSourceTypeAggregated, Endpoint: testCreditEndpoint,
+		Claims: []string{"credit_score"},
+	})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorClaimSourceAlreadyExists.Code, svcErr.Code)
+}
+
+// --- GetClaimSource / UpdateClaimSource / DeleteClaimSource ---
+
+func (suite *ServiceTestSuite) TestGetClaimSource_NotFound() {
+	suite.mockStore.On("GetClaimSourceByID", mock.Anything, "c1").Return(ClaimSource{}, errClaimSourceNotFound)
+
+	_, svcErr := suite.service.GetClaimSource(suite.ctx, "c1")
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorClaimSourceNotFound.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestUpdateClaimSource_RenameConflict() {
+	suite.mockStore.On("GetClaimSourceByName", mock.Anything, "payroll").
+		Return(testClaimSource("c2", "payroll", ClaimSourceTypeDistributed, testPayrollEndpoint, "salary"), nil)
+
+	_, svcErr := suite.service.UpdateClaimSource(suite.ctx, "c1", ClaimSourceRequest{
+		Name: "payroll", Type: ClaimSourceTypeDistributed, Endpoint: testPayrollEndpoint,
+		Claims: []string{"salary"},
+	})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorClaimSourceAlreadyExists.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestUpdateClaimSource_Success() {
+	suite.mockStore.On("GetClaimSourceByName", mock.Anything, "payroll").
+		Return(testClaimSource("c1", "payroll", ClaimSourceTypeDistributed, testPayrollEndpoint, "salary"), nil)
+	suite.mockStore.On("UpdateClaimSource", mock.Anything, mock.MatchedBy(func(s ClaimSource) bool {
+		return s.ID == "c1" && s.Type == ClaimSourceTypeAggregated
+	})).Return(nil)
+
+	source, svcErr := suite.service.UpdateClaimSource(suite.ctx, "c1", ClaimSourceRequest{
+		Name: "payroll", Type: ClaimSourceTypeAggregated, Endpoint: testPayrollEndpoint,
+		Claims: []string{"salary"},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal("c1", source.ID)
+}
+
+func (suite *ServiceTestSuite) TestDeleteClaimSource() {
+	suite.mockStore.On("DeleteClaimSource", mock.Anything, "c1").Return(nil).Once()
+	suite.Nil(suite.service.DeleteClaimSource(suite.ctx, "c1"))
+
+	suite.mockStore.On("DeleteClaimSource", mock.Anything, "c2").Return(errClaimSourceNotFound).Once()
+	svcErr := suite.service.DeleteClaimSource(suite.ctx, "c2")
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorClaimSourceNotFound.Code, svcErr.Code)
+
+	suite.mockStore.On("DeleteClaimSource", mock.Anything, "c3").Return(errors.New("db down")).Once()
+	svcErr = suite.service.DeleteClaimSource(suite.ctx, "c3")
+	suite.Require().NotNil(svcErr)
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
+
+// --- AddClaimReferences ---
+
+func (suite *ServiceTestSuite) TestAddClaimReferences_AggregatedAndDistributed() {
+	suite.mockStore.On("ListClaimSources", mock.Anything).Return([]ClaimSource{
+		testClaimSource("c1", "credit", ClaimSourceTypeAggregated, testCreditEndpoint, "credit_score", "email"),
+		testClaimSource("c2", "payroll", ClaimSourceTypeDistributed, testPayrollEndpoint, "salary", "credit_score"),
+	}, nil)
+	suite.expectSourceToken(testCreditEndpoint)
+	suite.expectSourceToken(testPayrollEndpoint)
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == testCreditEndpoint && req.Method == http.MethodGet &&
+			req.Header.Get("Authorization") == "Bearer "+testSourceToken
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(testAggregatedJWT + "\n")),
+	}, nil)
+
+	claims := map[string]interface{}{"sub": "user-1", "email": "a@b.com"}
+	suite.service.AddClaimReferences(suite.ctx, claims, "user-1", "client-1",
+		[]string{"email", "credit_score", "salary", "nickname"})
+
+	suite.Equal("a@b.com", claims["email"])
+	suite.Equal(map[string]interface{}{"credit_score": "credit", "salary": "payroll"}, claims[claimNamesMember])
+	suite.Equal(map[string]interface{}{
+		"credit":  map[string]interface{}{"JWT": testAggregatedJWT},
+		"payroll": map[string]interface{}{"endpoint": testPayrollEndpoint, "access_token": testSourceToken},
+	}, claims[claimSourcesMember])
+}
+
+func (suite *ServiceTestSuite) TestAddClaimReferences_NothingPending() {
+	claims := map[string]interface{}{"email": "a@b.com"}
+
+	suite.service.AddClaimReferences(suite.ctx, claims, "user-1", "client-1", []string{"email"})
+
+	suite.NotContains(claims, claimNamesMember)
+	suite.mockStore.AssertNotCalled(suite.T(), "ListClaimSources", mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestAddClaimReferences_SkipsFailingAggregatedSource() {
+	cases := []struct {
+		name     string
+		response *http.Response
+	}{
+		{"ErrorStatus", &http.Response{StatusCode: http.StatusUnauthorized, Body: io.NopCloser(strings.NewReader(""))}},
+		{"NotAJWT", &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"a":1}`))}},
+		{"TooLarge", &http.Response{StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(strings.Repeat("a", maxAggregatedClaimsBytes+1)))}},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockStore.On("ListClaimSources", mock.Anything).Return([]ClaimSource{
+				testClaimSource("c1", "credit", ClaimSourceTypeAggregated, testCreditEndpoint, "credit_score"),
+			}, nil)
+			suite.expectSourceToken(testCreditEndpoint)
+			suite.mockHTTPClient.On("Do", mock.Anything).Return(tc.response, nil)
+
+			claims := map[string]interface{}{}
+			suite.service.AddClaimReferences(suite.ctx, claims, "user-1", "client-1", []string{"credit_score"})
+
+			suite.Empty(claims)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestAddClaimReferences_ListError() {
+	suite.mockStore.On("ListClaimSources", mock.Anything).Return(nil, errors.New("db down"))
+
+	claims := map[string]interface{}{}
+	suite.service.AddClaimReferences(suite.ctx, claims, "user-1", "client-1", []string{"credit_score"})
+
+	suite.Empty(claims)
+}
@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// errClaimSourceNotFound is returned by the store when the claim source does not exist.
+var errClaimSourceNotFound = errors.New("claim source not found")
+
+// claimSourceStoreInterface defines the persistence operations for claim sources.
+type claimSourceStoreInterface interface {
+	ListClaimSources(ctx context.Context) ([]ClaimSource, error)
+	GetClaimSourceByID(ctx context.Context, id string) (ClaimSource, error)
+	GetClaimSourceByName(ctx context.Context, name string) (ClaimSource, error)
+	CreateClaimSource(ctx context.Context, source ClaimSource) error
+	UpdateClaimSource(ctx context.Context, source ClaimSource) error
+	DeleteClaimSource(ctx context.Context, id string) error
+}
+
+// claimSourceStore is the database-backed claim source store. Claim sources live in the
+// configuration database.
+type claimSourceStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newClaimSourceStore creates a new instance of claimSourceStore.
+func newClaimSourceStore() claimSourceStoreInterface {
+	return &claimSourceStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// ListClaimSources returns all claim sources ordered by name.
+func (s *claimSourceStore) ListClaimSources(ctx context.Context) ([]ClaimSource, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListClaimSources, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list claim sources: %w", err)
+	}
+
+	sources := make([]ClaimSource, 0, len(results))
+	for _, row := range results {
+		source, err := buildClaimSourceFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// GetClaimSourceByID returns the claim source with the given ID.
+func (s *claimSourceStore) GetClaimSourceByID(ctx context.Context, id string) (ClaimSource, error) {
+	return s.getClaimSource(ctx, queryGetClaimSourceByID, id)
+}
+
+// GetClaimSourceByName returns the claim source with the given name.
+func (s *claimSourceStore) GetClaimSourceByName(ctx context.Context, name string) (ClaimSource, error) {
+	return s.getClaimSource(ctx, queryGetClaimSourceByName, name)
+}
+
+// CreateClaimSource persists a new claim source.
+func (s *claimSourceStore) CreateClaimSource(ctx context.Context, source ClaimSource) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	claims, err := json.Marshal(source.Claims)
+	if err != nil {
+		return fmt.Errorf("failed to marshal claim source claims: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateClaimSource, source.ID, source.Name, string(source.Type),
+		source.Endpoint, string(claims), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to create claim source: %w", err)
+	}
+	return nil
+}
+
+// UpdateClaimSource updates an existing claim source.
+func (s *claimSourceStore) UpdateClaimSource(ctx context.Context, source ClaimSource) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	claims, err := json.Marshal(source.Claims)
+	if err != nil {
+		return fmt.Errorf("failed to marshal claim source claims: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryUpdateClaimSource, source.ID, source.Name, string(source.Type),
+		source.Endpoint, string(claims), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update claim source: %w", err)
+	}
+	if rows == 0 {
+		return errClaimSourceNotFound
+	}
+	return nil
+}
+
+// DeleteClaimSource deletes a claim source.
+func (s *claimSourceStore) DeleteClaimSource(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteClaimSource, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete claim source: %w", err)
+	}
+	if rows == 0 {
+		return errClaimSourceNotFound
+	}
+	return nil
+}
+
+// getClaimSource runs a single claim source lookup query keyed by the given value.
+func (s *claimSourceStore) getClaimSource(
+	ctx context.Context, query dbmodel.DBQuery, key string) (ClaimSource, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return ClaimSource{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, key, s.deploymentID)
+	if err != nil {
+		return ClaimSource{}, fmt.Errorf("failed to get claim source: %w", err)
+	}
+	if len(results) == 0 {
+		return ClaimSource{}, errClaimSourceNotFound
+	}
+	return buildClaimSourceFromRow(results[0])
+}
+
+// buildClaimSourceFromRow converts a database result row into a claim source.
+func buildClaimSourceFromRow(row map[string]interface{}) (ClaimSource, error) {
+	var source ClaimSource
+	var sourceType string
+	for column, target := range map[string]*string{
+		"id":          &source.ID,
+		"name":        &source.Name,
+		"source_type": &sourceType,
+		"endpoint":    &source.Endpoint,
+	} {
+		value, ok := row[column].(string)
+		if !ok {
+			return ClaimSource{}, fmt.Errorf("failed to parse %s as string", column)
+		}
+		*target = value
+	}
+	source.Type = ClaimSourceType(sourceType)
+
+	source.Claims = []string{}
+	var claims string
+	switch v := row["claims"].(type) {
+	case string:
+		claims = v
+	case []byte:
+		claims = string(v)
+	}
+	if claims != "" {
+		if err := json.Unmarshal([]byte(claims), &source.Claims); err != nil {
+			return ClaimSource{}, fmt.Errorf("failed to parse claim source claims: %w", err)
+		}
+	}
+	return source, nil
+}
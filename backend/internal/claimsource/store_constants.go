@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+const (
+	// claimSourceColumns is the column list selected for claim sources.
+	claimSourceColumns = `ID, NAME, SOURCE_TYPE, ENDPOINT, CLAIMS`
+)
+
+var (
+	// queryListClaimSources retrieves all claim sources ordered by name.
+	queryListClaimSources = dbmodel.DBQuery{
+		ID:    "CLSQ-CLAIM_SOURCE_MGT-01",
+		Query: `SELECT ` + claimSourceColumns + ` FROM "CLAIM_SOURCE" WHERE DEPLOYMENT_ID = $1 ORDER BY NAME`,
+	}
+
+	// queryGetClaimSourceByID retrieves a claim source by its ID.
+	queryGetClaimSourceByID = dbmodel.DBQuery{
+		ID:    "CLSQ-CLAIM_SOURCE_MGT-02",
+		Query: `SELECT ` + claimSourceColumns + ` FROM "CLAIM_SOURCE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetClaimSourceByName retrieves a claim source by its name.
+	queryGetClaimSourceByName = dbmodel.DBQuery{
+		ID:    "CLSQ-CLAIM_SOURCE_MGT-03",
+		Query: `SELECT ` + claimSourceColumns + ` FROM "CLAIM_SOURCE" WHERE NAME = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCreateClaimSource inserts a new claim source.
+	queryCreateClaimSource = dbmodel.DBQuery{
+		ID: "CLSQ-CLAIM_SOURCE_MGT-04",
+		Query: `INSERT INTO "CLAIM_SOURCE" (ID, NAME, SOURCE_TYPE, ENDPOINT, CLAIMS, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+
+	// queryUpdateClaimSource updates an existing claim source.
+	queryUpdateClaimSource = dbmodel.DBQuery{
+		ID: "CLSQ-CLAIM_SOURCE_MGT-05",
+		Query: `UPDATE "CLAIM_SOURCE" SET NAME = $2, SOURCE_TYPE = $3, ENDPOINT = $4, CLAIMS = $5 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryDeleteClaimSource deletes a claim source.
+	queryDeleteClaimSource = dbmodel.DBQuery{
+		ID:    "CLSQ-CLAIM_SOURCE_MGT-06",
+		Query: `DELETE FROM "CLAIM_SOURCE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimsource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *claimSourceStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &claimSourceStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func testClaimSourceRow(id, name string, claims interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"id": id, "name": name, "source_type": "aggregated", "endpoint": testCreditEndpoint, "claims": claims,
+	}
+}
+
+func (suite *StoreTestSuite) TestListClaimSources() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListClaimSources, testDeploymentID).
+		Return([]map[string]interface{}{
+			testClaimSourceRow("c1", "credit", `["credit_score"]`),
+			testClaimSourceRow("c2", "payroll", []byte(`["salary"]`)),
+		}, nil)
+
+	sources, err := suite.store.ListClaimSources(suite.ctx)
+
+	suite.NoError(err)
+	suite.Len(sources, 2)
+	suite.Equal(ClaimSourceTypeAggregated, sources[0].Type)
+	suite.Equal(testCreditEndpoint, sources[0].Endpoint)
+	suite.Equal([]string{"credit_score"}, sources[0].Claims)
+	suite.Equal([]string{"salary"}, sources[1].Claims)
+}
+
+func (suite *StoreTestSuite) TestListClaimSources_InvalidRow() {
+	row := testClaimSourceRow("c1", "credit", `["credit_score"]`)
+	delete(row, "endpoint")
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListClaimSources, testDeploymentID).
+		Return([]map[string]interface{}{row}, nil)
+
+	_, err := suite.store.ListClaimSources(suite.ctx)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestGetClaimSourceByName_NotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetClaimSourceByName, "credit", testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, err := suite.store.GetClaimSourceByName(suite.ctx, "credit")
+
+	suite.ErrorIs(err, errClaimSourceNotFound)
+}
+
+func (suite *StoreTestSuite) TestCreateClaimSource() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateClaimSource, "c1", "credit", "aggregated",
+		testCreditEndpoint, `["credit_score"]`, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.CreateClaimSource(suite.ctx, ClaimSource{ID: "c1", Name: "credit",
+		Type: ClaimSourceTypeAggregated, Endpoint: testCreditEndpoint, Claims: []string{"credit_score"}})
+
+	suite.NoError(err)
+}
+
+func (suite *StoreTestSuite) TestUpdateClaimSource_NotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateClaimSource, "c1", "credit", "distributed",
+		testCreditEndpoint, `["credit_score"]`, testDeploymentID).Return(int64(0), nil)
+
+	err := suite.store.UpdateClaimSource(suite.ctx, ClaimSource{ID: "c1", Name: "credit",
+		Type: ClaimSourceTypeDistributed, Endpoint: testCreditEndpoint, Claims: []string{"credit_score"}})
+
+	suite.ErrorIs(err, errClaimSourceNotFound)
+}
+
+func (suite *StoreTestSuite) TestDeleteClaimSource_Errors() {
+	suite.Run("ClientError", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("client error"))
+
+		suite.Error(suite.store.DeleteClaimSource(suite.ctx, "c1"))
+	})
+
+	suite.Run("NotFound", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteClaimSource, "c1", testDeploymentID).
+			Return(int64(0), nil)
+
+		suite.ErrorIs(suite.store.DeleteClaimSource(suite.ctx, "c1"), errClaimSourceNotFound)
+	})
+}
@@ -49,6 +49,9 @@ func createConnection[Req any, Resp any](h *handler, w http.ResponseWriter, r *h
 	ctx := r.Context()
 	req, err := sysutils.DecodeJSONBody[Req](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		writeInvalidBody(ctx, w)
 		return
 	}
@@ -105,6 +108,9 @@ func updateConnection[Req any, Resp any](h *handler, w http.ResponseWriter, r *h
 	}
 	req, err := sysutils.DecodeJSONBody[Req](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		writeInvalidBody(ctx, w)
 		return
 	}
@@ -252,6 +258,9 @@ func createSMSConnection[Req any, Resp any](h *handler, w http.ResponseWriter, r
 	ctx := r.Context()
 	req, err := sysutils.DecodeJSONBody[Req](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		writeInvalidBody(ctx, w)
 		return
 	}
@@ -308,6 +317,9 @@ func updateSMSConnection[Req any, Resp any](h *handler, w http.ResponseWriter, r
 	}
 	req, err := sysutils.DecodeJSONBody[Req](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		writeInvalidBody(ctx, w)
 		return
 	}
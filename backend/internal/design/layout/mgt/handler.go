@@ -98,6 +98,9 @@ func (lh *layoutMgtHandler) HandleLayoutPostRequest(w http.ResponseWriter, r *ht
 	ctx := r.Context()
 	createRequest, err := sysutils.DecodeJSONBody[CreateLayoutRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(ctx, w, &ErrorInvalidLayoutData)
 		return
 	}
@@ -157,6 +160,9 @@ func (lh *layoutMgtHandler) HandleLayoutPutRequest(w http.ResponseWriter, r *htt
 	id := r.PathValue("id")
 	updateRequest, err := sysutils.DecodeJSONBody[UpdateLayoutRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(ctx, w, &ErrorInvalidLayoutData)
 		return
 	}
@@ -45,17 +45,17 @@ type LayoutListItem struct {
 
 // CreateLayoutRequest represents the request body for creating a layout configuration.
 type CreateLayoutRequest struct {
-	Handle      string          `json:"handle"`
-	DisplayName string          `json:"displayName"`
-	Description string          `json:"description,omitempty"`
+	Handle      string          `json:"handle" native:"max=255"`
+	DisplayName string          `json:"displayName" native:"max=255"`
+	Description string          `json:"description,omitempty" native:"max=512"`
 	Layout      json.RawMessage `json:"layout"`
 }
 
 // UpdateLayoutRequest represents the request body for updating a layout configuration.
 type UpdateLayoutRequest struct {
-	Handle      string          `json:"handle"`
-	DisplayName string          `json:"displayName"`
-	Description string          `json:"description,omitempty"`
+	Handle      string          `json:"handle" native:"max=255"`
+	DisplayName string          `json:"displayName" native:"max=255"`
+	Description string          `json:"description,omitempty" native:"max=512"`
 	Layout      json.RawMessage `json:"layout"`
 }
 
@@ -114,3 +114,79 @@ func (_c *DesignResolveServiceInterfaceMock_ResolveDesign_Call) RunAndReturn(run
 	_c.Call.Return(run)
 	return _c
 }
+
+// ResolveSharedApplicationDesign provides a mock function for the type DesignResolveServiceInterfaceMock
+func (_mock *DesignResolveServiceInterfaceMock) ResolveSharedApplicationDesign(ctx context.Context, appID string, orgHandle string) (*providers.DesignResponse, *common.ServiceError) {
+	ret := _mock.Called(ctx, appID, orgHandle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveSharedApplicationDesign")
+	}
+
+	var r0 *providers.DesignResponse
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*providers.DesignResponse, *common.ServiceError)); ok {
+		return returnFunc(ctx, appID, orgHandle)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *providers.DesignResponse); ok {
+		r0 = returnFunc(ctx, appID, orgHandle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.DesignResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, appID, orgHandle)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveSharedApplicationDesign'
+type DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call struct {
+	*mock.Call
+}
+
+// ResolveSharedApplicationDesign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - orgHandle string
+func (_e *DesignResolveServiceInterfaceMock_Expecter) ResolveSharedApplicationDesign(ctx interface{}, appID interface{}, orgHandle interface{}) *DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call {
+	return &DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call{Call: _e.mock.On("ResolveSharedApplicationDesign", ctx, appID, orgHandle)}
+}
+
+func (_c *DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call) Run(run func(ctx context.Context, appID string, orgHandle string)) *DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call) Return(designResponse *providers.DesignResponse, serviceError *common.ServiceError) *DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call {
+	_c.Call.Return(designResponse, serviceError)
+	return _c
+}
+
+func (_c *DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call) RunAndReturn(run func(ctx context.Context, appID string, orgHandle string) (*providers.DesignResponse, *common.ServiceError)) *DesignResolveServiceInterfaceMock_ResolveSharedApplicationDesign_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -32,7 +32,11 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
 
-const handlerLogger = "DesignResolveHandler"
+const (
+	handlerLogger = "DesignResolveHandler"
+	// orgHandleQueryParam is the optional query parameter selecting a shared organization unit's design.
+	orgHandleQueryParam = "orgHandle"
+)
 
 // designResolveHandler is the handler for design resolve operations.
 type designResolveHandler struct {
@@ -54,8 +58,15 @@ func (rh *designResolveHandler) HandleResolveRequest(w http.ResponseWriter, r *h
 	ctx := r.Context()
 	resolveType := providers.DesignResolveType(strings.ToUpper(r.URL.Query().Get("type")))
 	id := r.URL.Query().Get("id")
+	orgHandle := r.URL.Query().Get(orgHandleQueryParam)
 
-	designResponse, svcErr := rh.resolveService.ResolveDesign(ctx, resolveType, id)
+	var designResponse *providers.DesignResponse
+	var svcErr *tidcommon.ServiceError
+	if orgHandle != "" && resolveType == providers.DesignResolveTypeAPP {
+		designResponse, svcErr = rh.resolveService.ResolveSharedApplicationDesign(ctx, id, orgHandle)
+	} else {
+		designResponse, svcErr = rh.resolveService.ResolveDesign(ctx, resolveType, id)
+	}
 	if svcErr != nil {
 		rh.handleError(ctx, w, svcErr)
 		return
@@ -41,6 +41,11 @@ type mockDesignResolveService struct {
 		resolveType providers.DesignResolveType,
 		id string,
 	) (*providers.DesignResponse, *tidcommon.ServiceError)
+	resolveSharedFn func(
+		ctx context.Context,
+		appID string,
+		orgHandle string,
+	) (*providers.DesignResponse, *tidcommon.ServiceError)
 }
 
 func (m *mockDesignResolveService) ResolveDesign(
@@ -49,6 +54,12 @@ func (m *mockDesignResolveService) ResolveDesign(
 	return m.resolveDesignFn(ctx, resolveType, id)
 }
 
+func (m *mockDesignResolveService) ResolveSharedApplicationDesign(
+	ctx context.Context, appID string, orgHandle string,
+) (*providers.DesignResponse, *tidcommon.ServiceError) {
+	return m.resolveSharedFn(ctx, appID, orgHandle)
+}
+
 // Test Suite
 type ResolveHandlerTestSuite struct {
 	suite.Suite
@@ -86,6 +97,33 @@ func (suite *ResolveHandlerTestSuite) TestHandleResolveRequest_Success() {
 	assert.Equal(suite.T(), http.StatusOK, w.Code)
 }
 
+// Test HandleResolveRequest - orgHandle resolves the shared organization unit design
+func (suite *ResolveHandlerTestSuite) TestHandleResolveRequest_WithOrgHandle() {
+	designResponse := &providers.DesignResponse{
+		Theme: json.RawMessage(`{"colors": {"primary": "#ff0000"}}`),
+	}
+
+	mockService := &mockDesignResolveService{
+		resolveSharedFn: func(
+			ctx context.Context,
+			appID string,
+			orgHandle string,
+		) (*providers.DesignResponse, *tidcommon.ServiceError) {
+			assert.Equal(suite.T(), "app-123", appID)
+			assert.Equal(suite.T(), "acme", orgHandle)
+			return designResponse, nil
+		},
+	}
+
+	handler := newDesignResolveHandler(mockService)
+	req := httptest.NewRequest(http.MethodGet, "/design/resolve?type=APP&id=app-123&orgHandle=acme", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleResolveRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
 // Test HandleResolveRequest - Type is case-insensitive (lowercased input)
 func (suite *ResolveHandlerTestSuite) TestHandleResolveRequest_CaseInsensitiveType() {
 	designResponse := &providers.DesignResponse{
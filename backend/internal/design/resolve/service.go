@@ -39,6 +39,9 @@ type DesignResolveServiceInterface interface {
 	ResolveDesign(
 		ctx context.Context, resolveType providers.DesignResolveType, id string,
 	) (*providers.DesignResponse, *tidcommon.ServiceError)
+	ResolveSharedApplicationDesign(
+		ctx context.Context, appID string, orgHandle string,
+	) (*providers.DesignResponse, *tidcommon.ServiceError)
 }
 
 // designResolveService is the default implementation of the DesignResolveServiceInterface.
@@ -82,7 +85,47 @@ func (drs *designResolveService) ResolveDesign(
 		return nil, &common.ErrorUnsupportedResolveType
 	}
 
-	// Get the application by ID
+	app, svcErr := drs.getApplication(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return drs.resolveApplicationDesign(ctx, id, app.ThemeID, app.LayoutID)
+}
+
+// ResolveSharedApplicationDesign resolves the design of an application for the shared organization unit
+// identified by orgHandle. Theme and layout overrides of the shared organization unit take precedence
+// over the application's own design; an unknown handle resolves to the application's design.
+func (drs *designResolveService) ResolveSharedApplicationDesign(
+	ctx context.Context, appID string, orgHandle string,
+) (*providers.DesignResponse, *tidcommon.ServiceError) {
+	if appID == "" {
+		return nil, &common.ErrorMissingResolveID
+	}
+
+	app, svcErr := drs.getApplication(ctx, appID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	themeID, layoutID := app.ThemeID, app.LayoutID
+	if shared := app.Sharing.FindByOUHandle(orgHandle); shared != nil {
+		if shared.ThemeID != "" {
+			themeID = shared.ThemeID
+		}
+		if shared.LayoutID != "" {
+			layoutID = shared.LayoutID
+		}
+	}
+
+	return drs.resolveApplicationDesign(ctx, appID, themeID, layoutID)
+}
+
+// getApplication retrieves the application and converts application service errors to design
+// resolve errors.
+func (drs *designResolveService) getApplication(
+	ctx context.Context, id string,
+) (*providers.Application, *tidcommon.ServiceError) {
 	if drs.applicationService == nil {
 		drs.logger.Error(ctx, "Application service is not available")
 		return nil, &tidcommon.InternalServerError
@@ -99,29 +142,35 @@ func (drs *designResolveService) ResolveDesign(
 		}
 		return nil, svcErr
 	}
+	return app, nil
+}
 
+// resolveApplicationDesign loads the given theme and layout of an application into a design response.
+func (drs *designResolveService) resolveApplicationDesign(
+	ctx context.Context, id string, themeID string, layoutID string,
+) (*providers.DesignResponse, *tidcommon.ServiceError) {
 	// Check if the application has theme or layout configured
-	if app.ThemeID == "" && app.LayoutID == "" {
+	if themeID == "" && layoutID == "" {
 		return nil, &common.ErrorApplicationHasNoDesign
 	}
 
 	designResponse := &providers.DesignResponse{}
 
 	// Get theme configuration if available
-	if app.ThemeID != "" {
+	if themeID != "" {
 		if drs.themeMgtService == nil {
 			drs.logger.Error(ctx, "Theme management service is not available")
 			return nil, &tidcommon.InternalServerError
 		}
 
-		themeConfig, svcErr := drs.themeMgtService.GetTheme(ctx, app.ThemeID)
+		themeConfig, svcErr := drs.themeMgtService.GetTheme(ctx, themeID)
 		if svcErr != nil {
 			if svcErr.Code == thememgt.ErrorThemeNotFound.Code {
 				// The referenced theme has been deleted; fall back to the system default by leaving
 				// the theme unset in the response.
 				drs.logger.Warn(ctx, "Application references a deleted theme; falling back to default",
 					log.String("applicationId", id),
-					log.String("themeId", app.ThemeID))
+					log.String("themeId", themeID))
 			} else {
 				return nil, svcErr
 			}
@@ -131,20 +180,20 @@ func (drs *designResolveService) ResolveDesign(
 	}
 
 	// Get layout configuration if available
-	if app.LayoutID != "" {
+	if layoutID != "" {
 		if drs.layoutMgtService == nil {
 			drs.logger.Error(ctx, "Layout management service is not available")
 			return nil, &tidcommon.InternalServerError
 		}
 
-		layoutConfig, svcErr := drs.layoutMgtService.GetLayout(ctx, app.LayoutID)
+		layoutConfig, svcErr := drs.layoutMgtService.GetLayout(ctx, layoutID)
 		if svcErr != nil {
 			if svcErr.Code == layoutmgt.ErrorLayoutNotFound.Code {
 				// The referenced layout has been deleted; fall back to the system default by leaving
 				// the layout unset in the response.
 				drs.logger.Warn(ctx, "Application references a deleted layout; falling back to default",
 					log.String("applicationId", id),
-					log.String("layoutId", app.LayoutID))
+					log.String("layoutId", layoutID))
 			} else {
 				return nil, svcErr
 			}
@@ -154,10 +203,9 @@ func (drs *designResolveService) ResolveDesign(
 	}
 
 	drs.logger.Debug(ctx, "Successfully resolved design configuration",
-		log.String("type", string(resolveType)),
 		log.String("id", id),
-		log.String("themeId", app.ThemeID),
-		log.String("layoutId", app.LayoutID))
+		log.String("themeId", themeID),
+		log.String("layoutId", layoutID))
 
 	return designResponse, nil
 }
@@ -391,3 +391,57 @@ func (suite *ResolveServiceTestSuite) TestResolveDesign_NilLayoutService() {
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), tidcommon.InternalServerError.Code, err.Code)
 }
+
+// Test ResolveSharedApplicationDesign - Shared organization unit theme overrides the application theme
+func (suite *ResolveServiceTestSuite) TestResolveSharedApplicationDesign_ThemeOverride() {
+	app := &providers.Application{
+		ID:   "00000000-0000-0000-0000-000000000001",
+		Name: "Test App",
+		InboundAuthProfile: providers.InboundAuthProfile{
+			ThemeID: "theme-app",
+			Sharing: &providers.ApplicationSharing{
+				OrganizationUnits: []providers.SharedOrganizationUnit{
+					{OUID: "ou-1", OUHandle: "acme", ThemeID: "theme-acme"},
+				},
+			},
+		},
+	}
+	themeConfig := &thememgt.Theme{
+		ID:    "theme-acme",
+		Theme: json.RawMessage(`{"colors": {"primary": "#ff0000"}}`),
+	}
+
+	suite.mockAppService.On("GetApplication", mock.Anything, "00000000-0000-0000-0000-000000000001").Return(app, nil)
+	suite.mockThemeService.On("GetTheme", mock.Anything, "theme-acme").Return(themeConfig, nil)
+
+	result, err := suite.service.ResolveSharedApplicationDesign(context.Background(),
+		"00000000-0000-0000-0000-000000000001", "acme")
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.JSONEq(suite.T(), `{"colors": {"primary": "#ff0000"}}`, string(result.Theme))
+}
+
+// Test ResolveSharedApplicationDesign - Unknown handle falls back to the application design
+func (suite *ResolveServiceTestSuite) TestResolveSharedApplicationDesign_UnknownHandle() {
+	app := &providers.Application{
+		ID:   "00000000-0000-0000-0000-000000000001",
+		Name: "Test App",
+		InboundAuthProfile: providers.InboundAuthProfile{
+			ThemeID: "theme-app",
+		},
+	}
+	themeConfig := &thememgt.Theme{
+		ID:    "theme-app",
+		Theme: json.RawMessage(`{"colors": {"primary": "#007bff"}}`),
+	}
+
+	suite.mockAppService.On("GetApplication", mock.Anything, "00000000-0000-0000-0000-000000000001").Return(app, nil)
+	suite.mockThemeService.On("GetTheme", mock.Anything, "theme-app").Return(themeConfig, nil)
+
+	result, err := suite.service.ResolveSharedApplicationDesign(context.Background(),
+		"00000000-0000-0000-0000-000000000001", "unknown")
+
+	assert.Nil(suite.T(), err)
+	assert.JSONEq(suite.T(), `{"colors": {"primary": "#007bff"}}`, string(result.Theme))
+}
@@ -101,6 +101,9 @@ func (th *themeMgtHandler) HandleThemePostRequest(w http.ResponseWriter, r *http
 	ctx := r.Context()
 	createRequest, err := sysutils.DecodeJSONBody[CreateThemeRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(ctx, w, &ErrorInvalidThemeData)
 		return
 	}
@@ -163,6 +166,9 @@ func (th *themeMgtHandler) HandleThemePutRequest(w http.ResponseWriter, r *http.
 	id := r.PathValue("id")
 	updateRequest, err := sysutils.DecodeJSONBody[UpdateThemeRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(ctx, w, &ErrorInvalidThemeData)
 		return
 	}
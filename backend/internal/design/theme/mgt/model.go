@@ -80,9 +80,9 @@ func extractThemeColorInfo(themeJSON json.RawMessage) (defaultColorScheme, prima
 
 // CreateThemeRequest represents the request body for creating a theme configuration.
 type CreateThemeRequest struct {
-	Handle      string          `json:"handle"`
-	DisplayName string          `json:"displayName"`
-	Description string          `json:"description"`
+	Handle      string          `json:"handle" native:"max=255"`
+	DisplayName string          `json:"displayName" native:"max=255"`
+	Description string          `json:"description" native:"max=512"`
 	Theme       json.RawMessage `json:"theme"`
 }
 
@@ -97,9 +97,9 @@ type CreateThemeRequestWithID struct {
 
 // UpdateThemeRequest represents the request body for updating a theme configuration.
 type UpdateThemeRequest struct {
-	Handle      string          `json:"handle"`
-	DisplayName string          `json:"displayName"`
-	Description string          `json:"description"`
+	Handle      string          `json:"handle" native:"max=255"`
+	DisplayName string          `json:"displayName" native:"max=255"`
+	Description string          `json:"description" native:"max=512"`
 	Theme       json.RawMessage `json:"theme"`
 }
 
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+const (
+	// maxDevicesPerUser is the number of devices remembered per user. The least recently seen device
+	// is forgotten first.
+	maxDevicesPerUser = 20
+	// deviceRetentionSeconds is how long a device is remembered after the last sign-in from it.
+	deviceRetentionSeconds int64 = 90 * 24 * 60 * 60
+)
+
+// Strategies applied when a sign-in from a new device would exceed the concurrent session limit.
+const (
+	// sessionLimitStrategyTerminateOldest ends the sessions of the least recently seen devices.
+	sessionLimitStrategyTerminateOldest = "terminate_oldest"
+	// sessionLimitStrategyDenyNew rejects the sign-in from the new device.
+	sessionLimitStrategyDenyNew = "deny_new"
+)
+
+// Platforms detected from the User-Agent of a device.
+const (
+	platformWindows  = "Windows"
+	platformIOS      = "iOS"
+	platformAndroid  = "Android"
+	platformChromeOS = "ChromeOS"
+	platformMacOS    = "macOS"
+	platformLinux    = "Linux"
+)
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// ErrSessionLimitReached is returned by RecordDevice when a sign-in from a new device is denied
+// because the user already has the maximum number of concurrent sessions.
+var ErrSessionLimitReached = errors.New("concurrent session limit reached")
+
+// Client errors for device operations.
+var (
+	// ErrorDeviceNotFound is the error returned when the device is not known for the user.
+	ErrorDeviceNotFound = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "DEV-1001",
+		Error: common.I18nMessage{
+			Key:          "error.deviceservice.device_not_found",
+			DefaultValue: "Device not found",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.deviceservice.device_not_found_description",
+			DefaultValue: "The device is not known for the user",
+		},
+	}
+)
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Fingerprint derives a stable, non-reversible identifier for a device. A client-supplied device ID
+// takes precedence over the User-Agent. It returns an empty string when neither is known.
+func Fingerprint(clientDeviceID, userAgent string) string {
+	source := strings.TrimSpace(clientDeviceID)
+	if source == "" {
+		source = strings.TrimSpace(userAgent)
+	}
+	if source == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:16])
+}
+
+// detectPlatform returns the operating system named in the User-Agent, or an empty string when it
+// is not recognised. Mobile platforms are checked first as their User-Agents also name a desktop one.
+func detectPlatform(userAgent string) string {
+	switch {
+	case strings.Contains(userAgent, "iPhone"), strings.Contains(userAgent, "iPad"),
+		strings.Contains(userAgent, "iPod"):
+		return platformIOS
+	case strings.Contains(userAgent, "Android"):
+		return platformAndroid
+	case strings.Contains(userAgent, "Windows"):
+		return platformWindows
+	case strings.Contains(userAgent, "CrOS"):
+		return platformChromeOS
+	case strings.Contains(userAgent, "Macintosh"), strings.Contains(userAgent, "Mac OS X"):
+		return platformMacOS
+	case strings.Contains(userAgent, "Linux"):
+		return platformLinux
+	}
+	return ""
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import "time"
+
+// Device is a device a user has signed in from.
+type Device struct {
+	ID string `json:"id"`
+	// Fingerprint is a non-reversible identifier derived from the client-supplied device ID or the
+	// User-Agent of the device.
+	Fingerprint string    `json:"fingerprint"`
+	UserAgent   string    `json:"userAgent,omitempty"`
+	Platform    string    `json:"platform,omitempty"`
+	FirstSeen   time.Time `json:"firstSeen"`
+	LastSeen    time.Time `json:"lastSeen"`
+	// AAL is the authenticator assurance level of the last sign-in from the device.
+	AAL string `json:"aal,omitempty"`
+}
+
+// DeviceListResponse is the response body for listing the devices of a user.
+type DeviceListResponse struct {
+	TotalResults int      `json:"totalResults"`
+	Devices      []Device `json:"devices"`
+}
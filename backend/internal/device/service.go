@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package device keeps track of the devices users sign in from and lets users and administrators
+// revoke the trust of a device. Tokens issued to a revoked device can no longer be refreshed. Each
+// trusted device is a session of the user, which bounds the number of concurrent sessions.
+package device
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/authn/assert"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// DeviceServiceInterface defines the interface for device management.
+type DeviceServiceInterface interface {
+	// RecordDevice records a sign-in of the user from the device identified by the client-supplied
+	// device ID or the User-Agent, and marks the device as trusted. aal is the authenticator assurance
+	// level of the sign-in. It returns nil when the device cannot be identified, and
+	// ErrSessionLimitReached when the sign-in is denied by the concurrent session limit.
+	RecordDevice(ctx context.Context, userID, userAgent, clientDeviceID string,
+		aal assert.AssuranceLevel) (*Device, error)
+
+	// ListDevices returns the devices of the user, most recently seen first.
+	ListDevices(ctx context.Context, userID string) ([]Device, *common.ServiceError)
+
+	// RevokeDevice forgets the device and revokes its trust, which ends the sessions of the device.
+	RevokeDevice(ctx context.Context, userID, deviceID string) *common.ServiceError
+
+	// IsDeviceTrusted reports whether the device has not been revoked or forgotten.
+	IsDeviceTrusted(ctx context.Context, deviceID string) (bool, error)
+}
+
+// deviceService is the default implementation of DeviceServiceInterface.
+type deviceService struct {
+	store         deviceStoreInterface
+	maxSessions   int
+	limitStrategy string
+	now           func() time.Time
+	logger        *log.Logger
+}
+
+// newDeviceService creates a new instance of deviceService. maxSessions limits the trusted devices
+// of a user; zero or a value above maxDevicesPerUser falls back to maxDevicesPerUser.
+func newDeviceService(store deviceStoreInterface, maxSessions int, limitStrategy string) DeviceServiceInterface {
+	if maxSessions <= 0 || maxSessions > maxDevicesPerUser {
+		maxSessions = maxDevicesPerUser
+	}
+	if limitStrategy == "" {
+		limitStrategy = sessionLimitStrategyTerminateOldest
+	}
+	return &deviceService{
+		store:         store,
+		maxSessions:   maxSessions,
+		limitStrategy: limitStrategy,
+		now:           time.Now,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceService")),
+	}
+}
+
+// RecordDevice records a sign-in of the user from the device and marks the device as trusted. A
+// device seen before keeps its ID, so tokens issued to it stay bound to the same device, unless the
+// sign-in elevates the assurance level: the ID is then regenerated so that tokens bound to an ID
+// obtained before the elevation, possibly by an attacker, do not share the elevated session.
+func (s *deviceService) RecordDevice(ctx context.Context, userID, userAgent,
+	clientDeviceID string, aal assert.AssuranceLevel) (*Device, error) {
+	fingerprint := Fingerprint(clientDeviceID, userAgent)
+	if userID == "" || fingerprint == "" {
+		return nil, nil
+	}
+
+	devices, err := s.store.GetDevices(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	userAgent = strings.TrimSpace(userAgent)
+	idx := slices.IndexFunc(devices, func(d Device) bool { return d.Fingerprint == fingerprint })
+	if idx >= 0 {
+		if aal.Level() > assert.AssuranceLevel(devices[idx].AAL).Level() {
+			if err := s.regenerateDeviceID(ctx, &devices[idx]); err != nil {
+				return nil, err
+			}
+		}
+		devices[idx].LastSeen = now
+		if userAgent != "" {
+			devices[idx].UserAgent = userAgent
+			devices[idx].Platform = detectPlatform(userAgent)
+		}
+		if aal.Level() > 0 {
+			devices[idx].AAL = string(aal)
+		}
+	} else {
+		if len(devices) >= s.maxSessions && s.limitStrategy == sessionLimitStrategyDenyNew {
+			s.logger.Debug(ctx, "Denied sign-in from a new device at the concurrent session limit",
+				log.MaskedString(log.LoggerKeyUserID, userID))
+			return nil, ErrSessionLimitReached
+		}
+		id, err := sysutils.GenerateUUIDv7()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate device ID: %w", err)
+		}
+		device := Device{
+			ID:          id,
+			Fingerprint: fingerprint,
+			UserAgent:   userAgent,
+			Platform:    detectPlatform(userAgent),
+			FirstSeen:   now,
+			LastSeen:    now,
+		}
+		if aal.Level() > 0 {
+			device.AAL = string(aal)
+		}
+		devices = append(devices, device)
+	}
+
+	sortByLastSeen(devices)
+	var forgotten []Device
+	if len(devices) > s.maxSessions {
+		forgotten = devices[s.maxSessions:]
+		devices = devices[:s.maxSessions]
+	}
+
+	if err := s.store.SaveDevices(ctx, userID, devices); err != nil {
+		return nil, fmt.Errorf("failed to save devices: %w", err)
+	}
+	recorded := devices[slices.IndexFunc(devices, func(d Device) bool { return d.Fingerprint == fingerprint })]
+	if err := s.store.SaveDeviceOwner(ctx, recorded.ID, userID); err != nil {
+		return nil, fmt.Errorf("failed to save device trust: %w", err)
+	}
+	for _, device := range forgotten {
+		if err := s.store.DeleteDeviceOwner(ctx, device.ID); err != nil {
+			s.logger.Warn(ctx, "Failed to revoke the trust of a forgotten device", log.Error(err))
+		}
+	}
+
+	return &recorded, nil
+}
+
+// regenerateDeviceID assigns a new ID to the device and revokes the trust of the old one. Trust is
+// revoked first so that a failure never leaves the old ID trusted alongside the new one.
+func (s *deviceService) regenerateDeviceID(ctx context.Context, device *Device) error {
+	if err := s.store.DeleteDeviceOwner(ctx, device.ID); err != nil {
+		return fmt.Errorf("failed to revoke the trust of the previous device ID: %w", err)
+	}
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate device ID: %w", err)
+	}
+	s.logger.Debug(ctx, "Regenerated the device ID on assurance elevation", log.String("deviceId", device.ID))
+	device.ID = id
+	return nil
+}
+
+// ListDevices returns the devices of the user, most recently seen first.
+func (s *deviceService) ListDevices(ctx context.Context, userID string) ([]Device, *common.ServiceError) {
+	devices, err := s.store.GetDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	sortByLastSeen(devices)
+	return devices, nil
+}
+
+// RevokeDevice forgets the device and revokes its trust. Refresh tokens issued to the device are
+// rejected from then on; a new sign-in from the device records it again.
+func (s *deviceService) RevokeDevice(ctx context.Context, userID, deviceID string) *common.ServiceError {
+	devices, err := s.store.GetDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &common.InternalServerError
+	}
+
+	idx := slices.IndexFunc(devices, func(d Device) bool { return d.ID == deviceID })
+	if idx < 0 {
+		return &ErrorDeviceNotFound
+	}
+
+	// Trust is revoked first so that a failure to update the device list never leaves the device trusted.
+	if err := s.store.DeleteDeviceOwner(ctx, deviceID); err != nil {
+		s.logger.Error(ctx, "Failed to revoke device trust", log.Error(err))
+		return &common.InternalServerError
+	}
+	if err := s.store.SaveDevices(ctx, userID, slices.Delete(devices, idx, idx+1)); err != nil {
+		s.logger.Error(ctx, "Failed to save devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &common.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Revoked device", log.String("deviceId", deviceID))
+	return nil
+}
+
+// IsDeviceTrusted reports whether the device has not been revoked or forgotten.
+func (s *deviceService) IsDeviceTrusted(ctx context.Context, deviceID string) (bool, error) {
+	owner, err := s.store.GetDeviceOwner(ctx, deviceID)
+	if err != nil {
+		return false, err
+	}
+	return owner != "", nil
+}
+
+// sortByLastSeen orders devices with the most recently seen first.
+func sortByLastSeen(devices []Device) {
+	slices.SortStableFunc(devices, func(a, b Device) int { return b.LastSeen.Compare(a.LastSeen) })
+}
@@ -0,0 +1,254 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/authn/assert"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+)
+
+const (
+	testUserID    = "user-1"
+	testUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4) AppleWebKit/605.1.15"
+)
+
+type DeviceServiceTestSuite struct {
+	suite.Suite
+	now     time.Time
+	service *deviceService
+}
+
+func TestDeviceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceServiceTestSuite))
+}
+
+func (suite *DeviceServiceTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	suite.service = suite.newService(0, "")
+}
+
+func (suite *DeviceServiceTestSuite) newService(maxSessions int, limitStrategy string) *deviceService {
+	service := newDeviceService(newDeviceStore(inmemory.Initialize("test")), maxSessions,
+		limitStrategy).(*deviceService)
+	service.now = func() time.Time { return suite.now }
+	return service
+}
+
+func (suite *DeviceServiceTestSuite) record(userAgent, clientDeviceID string) *Device {
+	return suite.recordWithAAL(userAgent, clientDeviceID, assert.AALLevel1)
+}
+
+func (suite *DeviceServiceTestSuite) recordWithAAL(userAgent, clientDeviceID string,
+	aal assert.AssuranceLevel) *Device {
+	recorded, err := suite.service.RecordDevice(context.Background(), testUserID, userAgent, clientDeviceID, aal)
+	suite.Require().NoError(err)
+	return recorded
+}
+
+func (suite *DeviceServiceTestSuite) isTrusted(deviceID string) bool {
+	trusted, err := suite.service.IsDeviceTrusted(context.Background(), deviceID)
+	suite.Require().NoError(err)
+	return trusted
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_NewDevice() {
+	recorded := suite.record(testUserAgent, "")
+
+	suite.Require().NotNil(recorded)
+	suite.NotEmpty(recorded.ID)
+	suite.Equal(platformMacOS, recorded.Platform)
+	suite.Equal(suite.now, recorded.FirstSeen)
+	suite.Equal(suite.now, recorded.LastSeen)
+
+	trusted, err := suite.service.IsDeviceTrusted(context.Background(), recorded.ID)
+	suite.NoError(err)
+	suite.True(trusted)
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_KnownDeviceKeepsID() {
+	first := suite.record(testUserAgent, "")
+	suite.now = suite.now.Add(time.Hour)
+
+	second := suite.record(testUserAgent, "")
+
+	suite.Equal(first.ID, second.ID)
+	suite.Equal(first.FirstSeen, second.FirstSeen)
+	suite.Equal(suite.now, second.LastSeen)
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.Len(devices, 1)
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_ClientDeviceIDTakesPrecedence() {
+	first := suite.record(testUserAgent, "device-a")
+	second := suite.record("Mozilla/5.0 (X11; Linux x86_64)", "device-a")
+	third := suite.record(testUserAgent, "device-b")
+
+	suite.Equal(first.ID, second.ID)
+	suite.Equal(platformLinux, second.Platform)
+	suite.NotEqual(first.ID, third.ID)
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_UnidentifiedDevice() {
+	recorded, err := suite.service.RecordDevice(context.Background(), testUserID, " ", "", assert.AALLevel1)
+
+	suite.NoError(err)
+	suite.Nil(recorded)
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_ForgetsLeastRecentlySeenDevice() {
+	oldest := suite.record(testUserAgent, "device-0")
+	for i := 1; i <= maxDevicesPerUser; i++ {
+		suite.now = suite.now.Add(time.Minute)
+		suite.record(testUserAgent, fmt.Sprintf("device-%d", i))
+	}
+
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.Len(devices, maxDevicesPerUser)
+	trusted, err := suite.service.IsDeviceTrusted(context.Background(), oldest.ID)
+	suite.NoError(err)
+	suite.False(trusted)
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_TerminatesOldestSessionAtLimit() {
+	suite.service = suite.newService(2, sessionLimitStrategyTerminateOldest)
+	oldest := suite.record(testUserAgent, "device-0")
+	suite.now = suite.now.Add(time.Minute)
+	second := suite.record(testUserAgent, "device-1")
+	suite.now = suite.now.Add(time.Minute)
+	third := suite.record(testUserAgent, "device-2")
+
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.Len(devices, 2)
+	suite.False(suite.isTrusted(oldest.ID))
+	suite.True(suite.isTrusted(second.ID))
+	suite.True(suite.isTrusted(third.ID))
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_DeniesNewSessionAtLimit() {
+	suite.service = suite.newService(2, sessionLimitStrategyDenyNew)
+	first := suite.record(testUserAgent, "device-0")
+	suite.record(testUserAgent, "device-1")
+
+	recorded, err := suite.service.RecordDevice(context.Background(), testUserID, testUserAgent, "device-2",
+		assert.AALLevel1)
+
+	suite.ErrorIs(err, ErrSessionLimitReached)
+	suite.Nil(recorded)
+	suite.True(suite.isTrusted(first.ID))
+	// A known device can still sign in at the limit.
+	suite.Equal(first.ID, suite.record(testUserAgent, "device-0").ID)
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_RegeneratesIDOnAssuranceElevation() {
+	first := suite.recordWithAAL(testUserAgent, "", assert.AALLevel1)
+
+	elevated := suite.recordWithAAL(testUserAgent, "", assert.AALLevel2)
+
+	suite.NotEqual(first.ID, elevated.ID)
+	suite.Equal(string(assert.AALLevel2), elevated.AAL)
+	suite.False(suite.isTrusted(first.ID))
+	suite.True(suite.isTrusted(elevated.ID))
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Nil(svcErr)
+	suite.Len(devices, 1)
+}
+
+func (suite *DeviceServiceTestSuite) TestRecordDevice_KeepsIDWithoutAssuranceElevation() {
+	first := suite.recordWithAAL(testUserAgent, "", assert.AALLevel2)
+
+	same := suite.recordWithAAL(testUserAgent, "", assert.AALLevel2)
+	lower := suite.recordWithAAL(testUserAgent, "", assert.AALLevel1)
+	unknown := suite.recordWithAAL(testUserAgent, "", assert.AALUnknown)
+
+	suite.Equal(first.ID, same.ID)
+	suite.Equal(first.ID, lower.ID)
+	suite.Equal(string(assert.AALLevel1), lower.AAL)
+	suite.Equal(first.ID, unknown.ID)
+	suite.Equal(string(assert.AALLevel1), unknown.AAL)
+}
+
+func (suite *DeviceServiceTestSuite) TestListDevices_MostRecentFirst() {
+	first := suite.record(testUserAgent, "device-a")
+	suite.now = suite.now.Add(time.Minute)
+	second := suite.record(testUserAgent, "device-b")
+
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Require().Len(devices, 2)
+	suite.Equal(second.ID, devices[0].ID)
+	suite.Equal(first.ID, devices[1].ID)
+}
+
+func (suite *DeviceServiceTestSuite) TestListDevices_NoDevices() {
+	devices, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Empty(devices)
+}
+
+func (suite *DeviceServiceTestSuite) TestRevokeDevice() {
+	recorded := suite.record(testUserAgent, "")
+
+	svcErr := suite.service.RevokeDevice(context.Background(), testUserID, recorded.ID)
+
+	suite.Nil(svcErr)
+	trusted, err := suite.service.IsDeviceTrusted(context.Background(), recorded.ID)
+	suite.NoError(err)
+	suite.False(trusted)
+	devices, _ := suite.service.ListDevices(context.Background(), testUserID)
+	suite.Empty(devices)
+}
+
+func (suite *DeviceServiceTestSuite) TestRevokeDevice_OtherUsersDevice() {
+	recorded := suite.record(testUserAgent, "")
+
+	svcErr := suite.service.RevokeDevice(context.Background(), "user-2", recorded.ID)
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorDeviceNotFound.Code, svcErr.Code)
+	trusted, err := suite.service.IsDeviceTrusted(context.Background(), recorded.ID)
+	suite.NoError(err)
+	suite.True(trusted)
+}
+
+func (suite *DeviceServiceTestSuite) TestDetectPlatform() {
+	cases := map[string]string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64)":              platformWindows,
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X)": platformIOS,
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8)":               platformAndroid,
+		"Mozilla/5.0 (X11; CrOS x86_64 14541.0.0)":               platformChromeOS,
+		testUserAgent:                     platformMacOS,
+		"Mozilla/5.0 (X11; Linux x86_64)": platformLinux,
+		"curl/8.5.0":                      "",
+	}
+	for userAgent, expected := range cases {
+		suite.Equal(expected, detectPlatform(userAgent), userAgent)
+	}
+}
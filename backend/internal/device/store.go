@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// deviceStoreInterface defines the interface for the device store.
+type deviceStoreInterface interface {
+	// GetDevices returns the devices of the user, or an empty list when none are stored.
+	GetDevices(ctx context.Context, userID string) ([]Device, error)
+
+	// SaveDevices stores the devices of the user. An empty list removes the entry.
+	SaveDevices(ctx context.Context, userID string, devices []Device) error
+
+	// GetDeviceOwner returns the ID of the user a trusted device belongs to, or an empty string when
+	// the device is not trusted.
+	GetDeviceOwner(ctx context.Context, deviceID string) (string, error)
+
+	// SaveDeviceOwner marks the device as trusted for the user.
+	SaveDeviceOwner(ctx context.Context, deviceID, userID string) error
+
+	// DeleteDeviceOwner removes the trust of the device.
+	DeleteDeviceOwner(ctx context.Context, deviceID string) error
+}
+
+// deviceStore keeps devices in the runtime store. Every entry expires deviceRetentionSeconds after
+// it was last written.
+type deviceStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newDeviceStore creates a new instance of deviceStore.
+func newDeviceStore(store providers.RuntimeStoreProvider) deviceStoreInterface {
+	return &deviceStore{store: store}
+}
+
+// GetDevices returns the devices of the user, or an empty list when none are stored.
+func (s *deviceStore) GetDevices(ctx context.Context, userID string) ([]Device, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceUserDevices, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get devices: %w", err)
+	}
+	devices := make([]Device, 0)
+	if data == nil {
+		return devices, nil
+	}
+	if err := json.Unmarshal(data, &devices); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal devices: %w", err)
+	}
+	return devices, nil
+}
+
+// SaveDevices stores the devices of the user. An empty list removes the entry.
+func (s *deviceStore) SaveDevices(ctx context.Context, userID string, devices []Device) error {
+	if len(devices) == 0 {
+		return s.store.Delete(ctx, providers.NamespaceUserDevices, userID)
+	}
+	data, err := json.Marshal(devices)
+	if err != nil {
+		return fmt.Errorf("failed to marshal devices: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceUserDevices, userID, data, deviceRetentionSeconds)
+}
+
+// GetDeviceOwner returns the ID of the user a trusted device belongs to, or an empty string when
+// the device is not trusted.
+func (s *deviceStore) GetDeviceOwner(ctx context.Context, deviceID string) (string, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceDeviceTrust, deviceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get device trust: %w", err)
+	}
+	return string(data), nil
+}
+
+// SaveDeviceOwner marks the device as trusted for the user.
+func (s *deviceStore) SaveDeviceOwner(ctx context.Context, deviceID, userID string) error {
+	return s.store.Put(ctx, providers.NamespaceDeviceTrust, deviceID, []byte(userID), deviceRetentionSeconds)
+}
+
+// DeleteDeviceOwner removes the trust of the device.
+func (s *deviceStore) DeleteDeviceOwner(ctx context.Context, deviceID string) error {
+	return s.store.Delete(ctx, providers.NamespaceDeviceTrust, deviceID)
+}
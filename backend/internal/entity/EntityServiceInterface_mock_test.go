@@ -7,6 +7,7 @@ package entity
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -392,6 +393,220 @@ func (_c *EntityServiceInterfaceMock_GetCredentialsByType_Call) RunAndReturn(run
 	return _c
 }
 
+// GetDeletedEntity provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetDeletedEntity(ctx context.Context, entityID string) (*providers.Entity, error) {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntity")
+	}
+
+	var r0 *providers.Entity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*providers.Entity, error)); ok {
+		return returnFunc(ctx, entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *providers.Entity); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, entityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_GetDeletedEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntity'
+type EntityServiceInterfaceMock_GetDeletedEntity_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *EntityServiceInterfaceMock_Expecter) GetDeletedEntity(ctx interface{}, entityID interface{}) *EntityServiceInterfaceMock_GetDeletedEntity_Call {
+	return &EntityServiceInterfaceMock_GetDeletedEntity_Call{Call: _e.mock.On("GetDeletedEntity", ctx, entityID)}
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntity_Call) Run(run func(ctx context.Context, entityID string)) *EntityServiceInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntity_Call) Return(entity *providers.Entity, err error) *EntityServiceInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Return(entity, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntity_Call) RunAndReturn(run func(ctx context.Context, entityID string) (*providers.Entity, error)) *EntityServiceInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedEntityList provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetDeletedEntityList(ctx context.Context, category providers.EntityCategory, limit int, offset int) ([]providers.Entity, error) {
+	ret := _mock.Called(ctx, category, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntityList")
+	}
+
+	var r0 []providers.Entity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory, int, int) ([]providers.Entity, error)); ok {
+		return returnFunc(ctx, category, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory, int, int) []providers.Entity); ok {
+		r0 = returnFunc(ctx, category, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, providers.EntityCategory, int, int) error); ok {
+		r1 = returnFunc(ctx, category, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_GetDeletedEntityList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntityList'
+type EntityServiceInterfaceMock_GetDeletedEntityList_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntityList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category providers.EntityCategory
+//   - limit int
+//   - offset int
+func (_e *EntityServiceInterfaceMock_Expecter) GetDeletedEntityList(ctx interface{}, category interface{}, limit interface{}, offset interface{}) *EntityServiceInterfaceMock_GetDeletedEntityList_Call {
+	return &EntityServiceInterfaceMock_GetDeletedEntityList_Call{Call: _e.mock.On("GetDeletedEntityList", ctx, category, limit, offset)}
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntityList_Call) Run(run func(ctx context.Context, category providers.EntityCategory, limit int, offset int)) *EntityServiceInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 providers.EntityCategory
+		if args[1] != nil {
+			arg1 = args[1].(providers.EntityCategory)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntityList_Call) Return(entitys []providers.Entity, err error) *EntityServiceInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Return(entitys, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntityList_Call) RunAndReturn(run func(ctx context.Context, category providers.EntityCategory, limit int, offset int) ([]providers.Entity, error)) *EntityServiceInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedEntityListCount provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetDeletedEntityListCount(ctx context.Context, category providers.EntityCategory) (int, error) {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntityListCount")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory) (int, error)); ok {
+		return returnFunc(ctx, category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory) int); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, providers.EntityCategory) error); ok {
+		r1 = returnFunc(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_GetDeletedEntityListCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntityListCount'
+type EntityServiceInterfaceMock_GetDeletedEntityListCount_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntityListCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category providers.EntityCategory
+func (_e *EntityServiceInterfaceMock_Expecter) GetDeletedEntityListCount(ctx interface{}, category interface{}) *EntityServiceInterfaceMock_GetDeletedEntityListCount_Call {
+	return &EntityServiceInterfaceMock_GetDeletedEntityListCount_Call{Call: _e.mock.On("GetDeletedEntityListCount", ctx, category)}
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntityListCount_Call) Run(run func(ctx context.Context, category providers.EntityCategory)) *EntityServiceInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 providers.EntityCategory
+		if args[1] != nil {
+			arg1 = args[1].(providers.EntityCategory)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntityListCount_Call) Return(n int, err error) *EntityServiceInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetDeletedEntityListCount_Call) RunAndReturn(run func(ctx context.Context, category providers.EntityCategory) (int, error)) *EntityServiceInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntitiesByIDs provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) GetEntitiesByIDs(ctx context.Context, entityIDs []string) ([]providers.Entity, error) {
 	ret := _mock.Called(ctx, entityIDs)
@@ -936,6 +1151,86 @@ func (_c *EntityServiceInterfaceMock_GetEntityListCountByOUIDs_Call) RunAndRetur
 	return _c
 }
 
+// GetExpiredDeletedEntityIDs provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetExpiredDeletedEntityIDs(ctx context.Context, category providers.EntityCategory, deletedBefore time.Time, limit int) ([]string, error) {
+	ret := _mock.Called(ctx, category, deletedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiredDeletedEntityIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory, time.Time, int) ([]string, error)); ok {
+		return returnFunc(ctx, category, deletedBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, providers.EntityCategory, time.Time, int) []string); ok {
+		r0 = returnFunc(ctx, category, deletedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, providers.EntityCategory, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, category, deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiredDeletedEntityIDs'
+type EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call struct {
+	*mock.Call
+}
+
+// GetExpiredDeletedEntityIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category providers.EntityCategory
+//   - deletedBefore time.Time
+//   - limit int
+func (_e *EntityServiceInterfaceMock_Expecter) GetExpiredDeletedEntityIDs(ctx interface{}, category interface{}, deletedBefore interface{}, limit interface{}) *EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	return &EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call{Call: _e.mock.On("GetExpiredDeletedEntityIDs", ctx, category, deletedBefore, limit)}
+}
+
+func (_c *EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call) Run(run func(ctx context.Context, category providers.EntityCategory, deletedBefore time.Time, limit int)) *EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 providers.EntityCategory
+		if args[1] != nil {
+			arg1 = args[1].(providers.EntityCategory)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call) Return(strings []string, err error) *EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call) RunAndReturn(run func(ctx context.Context, category providers.EntityCategory, deletedBefore time.Time, limit int) ([]string, error)) *EntityServiceInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGroupCountForEntity provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) GetGroupCountForEntity(ctx context.Context, entityID string) (int, error) {
 	ret := _mock.Called(ctx, entityID)
@@ -1306,6 +1601,63 @@ func (_c *EntityServiceInterfaceMock_LoadIndexedAttributes_Call) RunAndReturn(ru
 	return _c
 }
 
+// RestoreEntity provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) RestoreEntity(ctx context.Context, entityID string) error {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreEntity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EntityServiceInterfaceMock_RestoreEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreEntity'
+type EntityServiceInterfaceMock_RestoreEntity_Call struct {
+	*mock.Call
+}
+
+// RestoreEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *EntityServiceInterfaceMock_Expecter) RestoreEntity(ctx interface{}, entityID interface{}) *EntityServiceInterfaceMock_RestoreEntity_Call {
+	return &EntityServiceInterfaceMock_RestoreEntity_Call{Call: _e.mock.On("RestoreEntity", ctx, entityID)}
+}
+
+func (_c *EntityServiceInterfaceMock_RestoreEntity_Call) Run(run func(ctx context.Context, entityID string)) *EntityServiceInterfaceMock_RestoreEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_RestoreEntity_Call) Return(err error) *EntityServiceInterfaceMock_RestoreEntity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_RestoreEntity_Call) RunAndReturn(run func(ctx context.Context, entityID string) error) *EntityServiceInterfaceMock_RestoreEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchEntities provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) SearchEntities(ctx context.Context, filters map[string]interface{}) ([]providers.Entity, error) {
 	ret := _mock.Called(ctx, filters)
@@ -1414,6 +1766,63 @@ func (_c *EntityServiceInterfaceMock_SetGroupMembershipProvider_Call) RunAndRetu
 	return _c
 }
 
+// SoftDeleteEntity provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) SoftDeleteEntity(ctx context.Context, entityID string) error {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteEntity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EntityServiceInterfaceMock_SoftDeleteEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeleteEntity'
+type EntityServiceInterfaceMock_SoftDeleteEntity_Call struct {
+	*mock.Call
+}
+
+// SoftDeleteEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *EntityServiceInterfaceMock_Expecter) SoftDeleteEntity(ctx interface{}, entityID interface{}) *EntityServiceInterfaceMock_SoftDeleteEntity_Call {
+	return &EntityServiceInterfaceMock_SoftDeleteEntity_Call{Call: _e.mock.On("SoftDeleteEntity", ctx, entityID)}
+}
+
+func (_c *EntityServiceInterfaceMock_SoftDeleteEntity_Call) Run(run func(ctx context.Context, entityID string)) *EntityServiceInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_SoftDeleteEntity_Call) Return(err error) *EntityServiceInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_SoftDeleteEntity_Call) RunAndReturn(run func(ctx context.Context, entityID string) error) *EntityServiceInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAttributes provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) UpdateAttributes(ctx context.Context, entityID string, attributes json.RawMessage) error {
 	ret := _mock.Called(ctx, entityID, attributes)
@@ -1614,6 +2023,69 @@ func (_c *EntityServiceInterfaceMock_UpdateEntity_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// UpdateEntityState provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) UpdateEntityState(ctx context.Context, entityID string, state providers.EntityState) error {
+	ret := _mock.Called(ctx, entityID, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEntityState")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, providers.EntityState) error); ok {
+		r0 = returnFunc(ctx, entityID, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EntityServiceInterfaceMock_UpdateEntityState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEntityState'
+type EntityServiceInterfaceMock_UpdateEntityState_Call struct {
+	*mock.Call
+}
+
+// UpdateEntityState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - state providers.EntityState
+func (_e *EntityServiceInterfaceMock_Expecter) UpdateEntityState(ctx interface{}, entityID interface{}, state interface{}) *EntityServiceInterfaceMock_UpdateEntityState_Call {
+	return &EntityServiceInterfaceMock_UpdateEntityState_Call{Call: _e.mock.On("UpdateEntityState", ctx, entityID, state)}
+}
+
+func (_c *EntityServiceInterfaceMock_UpdateEntityState_Call) Run(run func(ctx context.Context, entityID string, state providers.EntityState)) *EntityServiceInterfaceMock_UpdateEntityState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 providers.EntityState
+		if args[2] != nil {
+			arg2 = args[2].(providers.EntityState)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_UpdateEntityState_Call) Return(err error) *EntityServiceInterfaceMock_UpdateEntityState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_UpdateEntityState_Call) RunAndReturn(run func(ctx context.Context, entityID string, state providers.EntityState) error) *EntityServiceInterfaceMock_UpdateEntityState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSystemAttributes provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) UpdateSystemAttributes(ctx context.Context, entityID string, attrs json.RawMessage) error {
 	ret := _mock.Called(ctx, entityID, attrs)
@@ -21,6 +21,7 @@ package entity
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -159,6 +160,55 @@ func (s *cacheBackedEntityStore) DeleteEntity(ctx context.Context, id string) er
 	return nil
 }
 
+func (s *cacheBackedEntityStore) SoftDeleteEntity(ctx context.Context, id string) error {
+	// Invalidate identifier cache before the store update, mirroring DeleteEntity.
+	s.invalidateIdentifierCache(ctx, id)
+
+	if err := s.store.SoftDeleteEntity(ctx, id); err != nil {
+		return err
+	}
+
+	s.invalidateEntityByID(ctx, id)
+	return nil
+}
+
+func (s *cacheBackedEntityStore) RestoreEntity(ctx context.Context, id string) error {
+	if err := s.store.RestoreEntity(ctx, id); err != nil {
+		return err
+	}
+
+	s.invalidateEntityByID(ctx, id)
+	return nil
+}
+
+func (s *cacheBackedEntityStore) UpdateEntityState(
+	ctx context.Context, id string, state providers.EntityState) error {
+	if err := s.store.UpdateEntityState(ctx, id, state); err != nil {
+		return err
+	}
+
+	s.invalidateEntityByID(ctx, id)
+	return nil
+}
+
+func (s *cacheBackedEntityStore) GetDeletedEntity(ctx context.Context, id string) (providers.Entity, error) {
+	return s.store.GetDeletedEntity(ctx, id)
+}
+
+func (s *cacheBackedEntityStore) GetDeletedEntityListCount(ctx context.Context, category string) (int, error) {
+	return s.store.GetDeletedEntityListCount(ctx, category)
+}
+
+func (s *cacheBackedEntityStore) GetDeletedEntityList(ctx context.Context, category string,
+	limit, offset int) ([]providers.Entity, error) {
+	return s.store.GetDeletedEntityList(ctx, category, limit, offset)
+}
+
+func (s *cacheBackedEntityStore) GetExpiredDeletedEntityIDs(ctx context.Context, category string,
+	deletedBefore time.Time, limit int) ([]string, error) {
+	return s.store.GetExpiredDeletedEntityIDs(ctx, category, deletedBefore, limit)
+}
+
 func (s *cacheBackedEntityStore) IdentifyEntity(ctx context.Context,
 	filters map[string]interface{}) (*string, error) {
 	if len(filters) == 1 {
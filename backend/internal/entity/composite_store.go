@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
@@ -118,6 +119,44 @@ func (c *entityCompositeStore) DeleteEntity(ctx context.Context, id string) erro
 	return c.dbStore.DeleteEntity(ctx, id)
 }
 
+// SoftDeleteEntity soft-deletes an entity in the database store only.
+func (c *entityCompositeStore) SoftDeleteEntity(ctx context.Context, id string) error {
+	return c.dbStore.SoftDeleteEntity(ctx, id)
+}
+
+// RestoreEntity restores a soft-deleted entity in the database store only.
+func (c *entityCompositeStore) RestoreEntity(ctx context.Context, id string) error {
+	return c.dbStore.RestoreEntity(ctx, id)
+}
+
+// UpdateEntityState changes the state of an entity in the database store only.
+func (c *entityCompositeStore) UpdateEntityState(
+	ctx context.Context, id string, state providers.EntityState) error {
+	return c.dbStore.UpdateEntityState(ctx, id, state)
+}
+
+// GetDeletedEntity retrieves a soft-deleted entity from the database store only.
+func (c *entityCompositeStore) GetDeletedEntity(ctx context.Context, id string) (providers.Entity, error) {
+	return c.dbStore.GetDeletedEntity(ctx, id)
+}
+
+// GetDeletedEntityListCount counts soft-deleted entities in the database store only.
+func (c *entityCompositeStore) GetDeletedEntityListCount(ctx context.Context, category string) (int, error) {
+	return c.dbStore.GetDeletedEntityListCount(ctx, category)
+}
+
+// GetDeletedEntityList lists soft-deleted entities from the database store only.
+func (c *entityCompositeStore) GetDeletedEntityList(ctx context.Context, category string,
+	limit, offset int) ([]providers.Entity, error) {
+	return c.dbStore.GetDeletedEntityList(ctx, category, limit, offset)
+}
+
+// GetExpiredDeletedEntityIDs lists expired soft-deleted entity IDs from the database store only.
+func (c *entityCompositeStore) GetExpiredDeletedEntityIDs(ctx context.Context, category string,
+	deletedBefore time.Time, limit int) ([]string, error) {
+	return c.dbStore.GetExpiredDeletedEntityIDs(ctx, category, deletedBefore, limit)
+}
+
 // IdentifyEntity identifies an entity from either store (DB first, then file fallback).
 func (c *entityCompositeStore) IdentifyEntity(ctx context.Context,
 	filters map[string]interface{}) (*string, error) {
@@ -7,6 +7,7 @@ package entity
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -165,6 +166,218 @@ func (_c *entityStoreInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetDeletedEntity provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) GetDeletedEntity(ctx context.Context, id string) (providers.Entity, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntity")
+	}
+
+	var r0 providers.Entity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (providers.Entity, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) providers.Entity); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(providers.Entity)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_GetDeletedEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntity'
+type entityStoreInterfaceMock_GetDeletedEntity_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *entityStoreInterfaceMock_Expecter) GetDeletedEntity(ctx interface{}, id interface{}) *entityStoreInterfaceMock_GetDeletedEntity_Call {
+	return &entityStoreInterfaceMock_GetDeletedEntity_Call{Call: _e.mock.On("GetDeletedEntity", ctx, id)}
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntity_Call) Run(run func(ctx context.Context, id string)) *entityStoreInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntity_Call) Return(entity providers.Entity, err error) *entityStoreInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Return(entity, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntity_Call) RunAndReturn(run func(ctx context.Context, id string) (providers.Entity, error)) *entityStoreInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedEntityList provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) GetDeletedEntityList(ctx context.Context, category string, limit int, offset int) ([]providers.Entity, error) {
+	ret := _mock.Called(ctx, category, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntityList")
+	}
+
+	var r0 []providers.Entity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]providers.Entity, error)); ok {
+		return returnFunc(ctx, category, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []providers.Entity); ok {
+		r0 = returnFunc(ctx, category, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = returnFunc(ctx, category, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_GetDeletedEntityList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntityList'
+type entityStoreInterfaceMock_GetDeletedEntityList_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntityList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category string
+//   - limit int
+//   - offset int
+func (_e *entityStoreInterfaceMock_Expecter) GetDeletedEntityList(ctx interface{}, category interface{}, limit interface{}, offset interface{}) *entityStoreInterfaceMock_GetDeletedEntityList_Call {
+	return &entityStoreInterfaceMock_GetDeletedEntityList_Call{Call: _e.mock.On("GetDeletedEntityList", ctx, category, limit, offset)}
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntityList_Call) Run(run func(ctx context.Context, category string, limit int, offset int)) *entityStoreInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntityList_Call) Return(entitys []providers.Entity, err error) *entityStoreInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Return(entitys, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntityList_Call) RunAndReturn(run func(ctx context.Context, category string, limit int, offset int) ([]providers.Entity, error)) *entityStoreInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedEntityListCount provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) GetDeletedEntityListCount(ctx context.Context, category string) (int, error) {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntityListCount")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return returnFunc(ctx, category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_GetDeletedEntityListCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntityListCount'
+type entityStoreInterfaceMock_GetDeletedEntityListCount_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntityListCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category string
+func (_e *entityStoreInterfaceMock_Expecter) GetDeletedEntityListCount(ctx interface{}, category interface{}) *entityStoreInterfaceMock_GetDeletedEntityListCount_Call {
+	return &entityStoreInterfaceMock_GetDeletedEntityListCount_Call{Call: _e.mock.On("GetDeletedEntityListCount", ctx, category)}
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntityListCount_Call) Run(run func(ctx context.Context, category string)) *entityStoreInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntityListCount_Call) Return(n int, err error) *entityStoreInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetDeletedEntityListCount_Call) RunAndReturn(run func(ctx context.Context, category string) (int, error)) *entityStoreInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntitiesByIDs provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) GetEntitiesByIDs(ctx context.Context, entityIDs []string) ([]providers.Entity, error) {
 	ret := _mock.Called(ctx, entityIDs)
@@ -775,6 +988,86 @@ func (_c *entityStoreInterfaceMock_GetEntityWithCredentials_Call) RunAndReturn(r
 	return _c
 }
 
+// GetExpiredDeletedEntityIDs provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) GetExpiredDeletedEntityIDs(ctx context.Context, category string, deletedBefore time.Time, limit int) ([]string, error) {
+	ret := _mock.Called(ctx, category, deletedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiredDeletedEntityIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, int) ([]string, error)); ok {
+		return returnFunc(ctx, category, deletedBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, int) []string); ok {
+		r0 = returnFunc(ctx, category, deletedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, category, deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiredDeletedEntityIDs'
+type entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call struct {
+	*mock.Call
+}
+
+// GetExpiredDeletedEntityIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category string
+//   - deletedBefore time.Time
+//   - limit int
+func (_e *entityStoreInterfaceMock_Expecter) GetExpiredDeletedEntityIDs(ctx interface{}, category interface{}, deletedBefore interface{}, limit interface{}) *entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	return &entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call{Call: _e.mock.On("GetExpiredDeletedEntityIDs", ctx, category, deletedBefore, limit)}
+}
+
+func (_c *entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call) Run(run func(ctx context.Context, category string, deletedBefore time.Time, limit int)) *entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call) Return(strings []string, err error) *entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call) RunAndReturn(run func(ctx context.Context, category string, deletedBefore time.Time, limit int) ([]string, error)) *entityStoreInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGroupCountForEntity provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) GetGroupCountForEntity(ctx context.Context, entityID string) (int, error) {
 	ret := _mock.Called(ctx, entityID)
@@ -1072,6 +1365,63 @@ func (_c *entityStoreInterfaceMock_LoadIndexedAttributes_Call) RunAndReturn(run
 	return _c
 }
 
+// RestoreEntity provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) RestoreEntity(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreEntity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// entityStoreInterfaceMock_RestoreEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreEntity'
+type entityStoreInterfaceMock_RestoreEntity_Call struct {
+	*mock.Call
+}
+
+// RestoreEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *entityStoreInterfaceMock_Expecter) RestoreEntity(ctx interface{}, id interface{}) *entityStoreInterfaceMock_RestoreEntity_Call {
+	return &entityStoreInterfaceMock_RestoreEntity_Call{Call: _e.mock.On("RestoreEntity", ctx, id)}
+}
+
+func (_c *entityStoreInterfaceMock_RestoreEntity_Call) Run(run func(ctx context.Context, id string)) *entityStoreInterfaceMock_RestoreEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_RestoreEntity_Call) Return(err error) *entityStoreInterfaceMock_RestoreEntity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_RestoreEntity_Call) RunAndReturn(run func(ctx context.Context, id string) error) *entityStoreInterfaceMock_RestoreEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchEntities provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) SearchEntities(ctx context.Context, filters map[string]interface{}) ([]providers.Entity, error) {
 	ret := _mock.Called(ctx, filters)
@@ -1140,6 +1490,63 @@ func (_c *entityStoreInterfaceMock_SearchEntities_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// SoftDeleteEntity provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) SoftDeleteEntity(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteEntity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// entityStoreInterfaceMock_SoftDeleteEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeleteEntity'
+type entityStoreInterfaceMock_SoftDeleteEntity_Call struct {
+	*mock.Call
+}
+
+// SoftDeleteEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *entityStoreInterfaceMock_Expecter) SoftDeleteEntity(ctx interface{}, id interface{}) *entityStoreInterfaceMock_SoftDeleteEntity_Call {
+	return &entityStoreInterfaceMock_SoftDeleteEntity_Call{Call: _e.mock.On("SoftDeleteEntity", ctx, id)}
+}
+
+func (_c *entityStoreInterfaceMock_SoftDeleteEntity_Call) Run(run func(ctx context.Context, id string)) *entityStoreInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_SoftDeleteEntity_Call) Return(err error) *entityStoreInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_SoftDeleteEntity_Call) RunAndReturn(run func(ctx context.Context, id string) error) *entityStoreInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAttributes provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) UpdateAttributes(ctx context.Context, entityID string, attributes json.RawMessage) error {
 	ret := _mock.Called(ctx, entityID, attributes)
@@ -1323,6 +1730,69 @@ func (_c *entityStoreInterfaceMock_UpdateEntity_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// UpdateEntityState provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) UpdateEntityState(ctx context.Context, id string, state providers.EntityState) error {
+	ret := _mock.Called(ctx, id, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEntityState")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, providers.EntityState) error); ok {
+		r0 = returnFunc(ctx, id, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// entityStoreInterfaceMock_UpdateEntityState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEntityState'
+type entityStoreInterfaceMock_UpdateEntityState_Call struct {
+	*mock.Call
+}
+
+// UpdateEntityState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - state providers.EntityState
+func (_e *entityStoreInterfaceMock_Expecter) UpdateEntityState(ctx interface{}, id interface{}, state interface{}) *entityStoreInterfaceMock_UpdateEntityState_Call {
+	return &entityStoreInterfaceMock_UpdateEntityState_Call{Call: _e.mock.On("UpdateEntityState", ctx, id, state)}
+}
+
+func (_c *entityStoreInterfaceMock_UpdateEntityState_Call) Run(run func(ctx context.Context, id string, state providers.EntityState)) *entityStoreInterfaceMock_UpdateEntityState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 providers.EntityState
+		if args[2] != nil {
+			arg2 = args[2].(providers.EntityState)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_UpdateEntityState_Call) Return(err error) *entityStoreInterfaceMock_UpdateEntityState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_UpdateEntityState_Call) RunAndReturn(run func(ctx context.Context, id string, state providers.EntityState) error) *entityStoreInterfaceMock_UpdateEntityState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSystemAttributes provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) UpdateSystemAttributes(ctx context.Context, entityID string, attrs json.RawMessage) error {
 	ret := _mock.Called(ctx, entityID, attrs)
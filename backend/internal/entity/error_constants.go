@@ -25,6 +25,12 @@ var (
 	// ErrEntityNotFound is returned when the entity is not found in the system.
 	ErrEntityNotFound = errors.New("entity not found")
 
+	// ErrEntityNotActive is returned when an entity that is not in the active state attempts to authenticate.
+	ErrEntityNotActive = errors.New("entity not active")
+
+	// ErrInvalidEntityState is returned when an entity state cannot be set through the requested operation.
+	ErrInvalidEntityState = errors.New("invalid entity state")
+
 	// ErrAuthenticationFailed is returned when entity credential verification fails.
 	ErrAuthenticationFailed = errors.New("authentication failed")
 
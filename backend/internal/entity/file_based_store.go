@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"strings"
+	"time"
 
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	entitystore "github.com/thunder-id/thunderid/internal/system/declarative_resource/entity"
@@ -130,6 +131,43 @@ func (f *entityFileBasedStore) DeleteEntity(ctx context.Context, id string) erro
 	return errors.New("DeleteEntity is not supported in file-based store")
 }
 
+// SoftDeleteEntity is not supported in file-based store.
+func (f *entityFileBasedStore) SoftDeleteEntity(ctx context.Context, id string) error {
+	return errors.New("SoftDeleteEntity is not supported in file-based store")
+}
+
+// RestoreEntity is not supported in file-based store.
+func (f *entityFileBasedStore) RestoreEntity(ctx context.Context, id string) error {
+	return errors.New("RestoreEntity is not supported in file-based store")
+}
+
+// UpdateEntityState is not supported in file-based store.
+func (f *entityFileBasedStore) UpdateEntityState(ctx context.Context, id string, state providers.EntityState) error {
+	return errors.New("UpdateEntityState is not supported in file-based store")
+}
+
+// GetDeletedEntity always returns not found as declarative entities cannot be soft-deleted.
+func (f *entityFileBasedStore) GetDeletedEntity(ctx context.Context, id string) (providers.Entity, error) {
+	return providers.Entity{}, ErrEntityNotFound
+}
+
+// GetDeletedEntityListCount always returns zero as declarative entities cannot be soft-deleted.
+func (f *entityFileBasedStore) GetDeletedEntityListCount(ctx context.Context, category string) (int, error) {
+	return 0, nil
+}
+
+// GetDeletedEntityList always returns an empty list as declarative entities cannot be soft-deleted.
+func (f *entityFileBasedStore) GetDeletedEntityList(ctx context.Context, category string,
+	limit, offset int) ([]providers.Entity, error) {
+	return []providers.Entity{}, nil
+}
+
+// GetExpiredDeletedEntityIDs always returns an empty list as declarative entities cannot be soft-deleted.
+func (f *entityFileBasedStore) GetExpiredDeletedEntityIDs(ctx context.Context, category string,
+	deletedBefore time.Time, limit int) ([]string, error) {
+	return []string{}, nil
+}
+
 // IdentifyEntity identifies an entity with the given filters by linear search.
 func (f *entityFileBasedStore) IdentifyEntity(ctx context.Context,
 	filters map[string]interface{}) (*string, error) {
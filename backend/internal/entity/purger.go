@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/retention"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	purgerLoggerComponentName = "EntityPurger"
+	// purgeBatchSize caps how many expired entities of a category are purged per tick, so a large
+	// backlog is drained over several ticks instead of holding the loop for a long time.
+	purgeBatchSize = 100
+)
+
+// PurgeFunc permanently deletes a soft-deleted entity together with its dependents. Consumer
+// packages (e.g., user, application) provide one per entity category.
+type PurgeFunc func(ctx context.Context, entityID string) error
+
+// Purger owns the background loop that permanently deletes soft-deleted entities once their
+// retention window has passed.
+type Purger = retention.Purger
+
+// purger periodically purges expired soft-deleted entities through the registered purge functions.
+type purger struct {
+	*retention.PurgeLoop
+	entityService EntityServiceInterface
+	purgeFuncs    map[providers.EntityCategory]PurgeFunc
+	retention     time.Duration
+	logger        *log.Logger
+}
+
+// NewPurger creates a purger that, every interval, purges entities soft-deleted more than
+// retentionWindow ago using the purge function registered for their category.
+func NewPurger(entityService EntityServiceInterface, purgeFuncs map[providers.EntityCategory]PurgeFunc,
+	retentionWindow, interval time.Duration) Purger {
+	p := &purger{
+		entityService: entityService,
+		purgeFuncs:    purgeFuncs,
+		retention:     retentionWindow,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, purgerLoggerComponentName)),
+	}
+	p.PurgeLoop = retention.NewPurgeLoop(interval, p.purgeExpired)
+	return p
+}
+
+// Start launches the periodic purge loop. Purges run as an internal runtime caller so the consumer
+// purge functions bypass per-user authorization.
+func (p *purger) Start(ctx context.Context) {
+	p.PurgeLoop.Start(security.WithRuntimeContext(ctx))
+}
+
+// purgeExpired purges one batch of expired soft-deleted entities per registered category. A failed
+// purge is logged and the entity is retried on a later tick.
+func (p *purger) purgeExpired(ctx context.Context) {
+	deletedBefore := time.Now().UTC().Add(-p.retention)
+	for category, purge := range p.purgeFuncs {
+		ids, err := p.entityService.GetExpiredDeletedEntityIDs(ctx, category, deletedBefore, purgeBatchSize)
+		if err != nil {
+			p.logger.Error(ctx, "Failed to list expired soft-deleted entities",
+				log.String("category", string(category)), log.Error(err))
+			continue
+		}
+		purged := 0
+		for _, id := range ids {
+			if ctx.Err() != nil {
+				break
+			}
+			if err := purge(ctx, id); err != nil {
+				p.logger.Error(ctx, "Failed to purge soft-deleted entity",
+					log.String("category", string(category)), log.MaskedString("id", id), log.Error(err))
+				continue
+			}
+			purged++
+		}
+		retention.RecordPurged(ctx, retention.CategorySoftDeletedEntity, string(category), int64(purged))
+		if purged > 0 {
+			p.logger.Debug(ctx, "Purged expired soft-deleted entities",
+				log.String("category", string(category)), log.Int("count", purged))
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
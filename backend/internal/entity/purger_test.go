@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+func TestPurger_PurgeExpired(t *testing.T) {
+	svc := NewEntityServiceInterfaceMock(t)
+	svc.On("GetExpiredDeletedEntityIDs", mock.Anything, providers.EntityCategoryUser,
+		mock.AnythingOfType("time.Time"), purgeBatchSize).Return([]string{"u1", "u2"}, nil).Once()
+
+	var purged []string
+	p := NewPurger(svc, map[providers.EntityCategory]PurgeFunc{
+		providers.EntityCategoryUser: func(ctx context.Context, id string) error {
+			purged = append(purged, id)
+			if id == "u1" {
+				return errors.New("purge failed")
+			}
+			return nil
+		},
+	}, time.Hour, time.Minute).(*purger)
+
+	p.purgeExpired(context.Background())
+
+	// A failed purge is logged and the remaining entities are still purged.
+	require.Equal(t, []string{"u1", "u2"}, purged)
+}
+
+func TestPurger_PurgeExpired_ListError(t *testing.T) {
+	svc := NewEntityServiceInterfaceMock(t)
+	svc.On("GetExpiredDeletedEntityIDs", mock.Anything, providers.EntityCategoryApp,
+		mock.Anything, purgeBatchSize).Return(nil, errors.New("db down")).Once()
+
+	called := false
+	p := NewPurger(svc, map[providers.EntityCategory]PurgeFunc{
+		providers.EntityCategoryApp: func(context.Context, string) error {
+			called = true
+			return nil
+		},
+	}, time.Hour, time.Minute).(*purger)
+
+	p.purgeExpired(context.Background())
+
+	require.False(t, called)
+}
+
+func TestPurger_StartStop(t *testing.T) {
+	svc := NewEntityServiceInterfaceMock(t)
+	done := make(chan struct{})
+	svc.On("GetExpiredDeletedEntityIDs", mock.Anything, providers.EntityCategoryUser,
+		mock.Anything, purgeBatchSize).Return([]string{"u1"}, nil)
+
+	p := NewPurger(svc, map[providers.EntityCategory]PurgeFunc{
+		providers.EntityCategoryUser: func(ctx context.Context, _ string) error {
+			// Purges run as the internal runtime caller.
+			require.True(t, security.IsRuntimeContext(ctx))
+			select {
+			case <-done:
+			default:
+				close(done)
+			}
+			return nil
+		},
+	}, time.Hour, 10*time.Millisecond)
+
+	p.Start(context.Background())
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("purger did not run")
+	}
+	p.Stop()
+	p.Stop()
+}
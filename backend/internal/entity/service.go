@@ -20,10 +20,12 @@ package entity
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/ou"
@@ -45,7 +47,18 @@ type EntityServiceInterface interface {
 	UpdateEntity(ctx context.Context, entityID string, entity *providers.Entity) (*providers.Entity, error)
 	DeleteEntity(ctx context.Context, entityID string) error
 
+	// Soft delete
+	SoftDeleteEntity(ctx context.Context, entityID string) error
+	RestoreEntity(ctx context.Context, entityID string) error
+	GetDeletedEntity(ctx context.Context, entityID string) (*providers.Entity, error)
+	GetDeletedEntityListCount(ctx context.Context, category providers.EntityCategory) (int, error)
+	GetDeletedEntityList(ctx context.Context, category providers.EntityCategory,
+		limit, offset int) ([]providers.Entity, error)
+	GetExpiredDeletedEntityIDs(ctx context.Context, category providers.EntityCategory,
+		deletedBefore time.Time, limit int) ([]string, error)
+
 	// Partial updates
+	UpdateEntityState(ctx context.Context, entityID string, state providers.EntityState) error
 	UpdateAttributes(ctx context.Context, entityID string, attributes json.RawMessage) error
 	UpdateSystemAttributes(ctx context.Context, entityID string, attrs json.RawMessage) error
 	UpdateCredentials(ctx context.Context, entityID string,
@@ -154,7 +167,8 @@ func (s *entityService) CreateEntity(ctx context.Context, entity *providers.Enti
 	s.logger.Debug(ctx, "Creating entity", log.MaskedString("id", entity.ID))
 
 	// Validate entity attributes and uniqueness via schema.
-	if err := s.validateEntityType(ctx, entity.Category, entity.Type, entity.Attributes, "", false); err != nil {
+	if err := s.validateEntityType(
+		ctx, entity.Category, entity.Type, entity.OUID, entity.Attributes, "", false); err != nil {
 		return nil, err
 	}
 
@@ -241,7 +255,8 @@ func (s *entityService) UpdateEntity(
 	s.logger.Debug(ctx, "Updating entity", log.MaskedString("id", entityID))
 
 	// Validate entity attributes and uniqueness via schema (excludes self for uniqueness).
-	if err := s.validateEntityType(ctx, entity.Category, entity.Type, entity.Attributes, entityID, true); err != nil {
+	if err := s.validateEntityType(
+		ctx, entity.Category, entity.Type, entity.OUID, entity.Attributes, entityID, true); err != nil {
 		return nil, err
 	}
 
@@ -296,6 +311,78 @@ func (s *entityService) DeleteEntity(ctx context.Context, entityID string) error
 	return err
 }
 
+// SoftDeleteEntity marks an entity as deleted. The entity stops resolving through reads and
+// identification but is retained until it is restored or purged.
+func (s *entityService) SoftDeleteEntity(ctx context.Context, entityID string) error {
+	s.logger.Debug(ctx, "Soft-deleting entity", log.MaskedString("id", entityID))
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		return s.store.SoftDeleteEntity(txCtx, entityID)
+	})
+}
+
+// RestoreEntity returns a soft-deleted entity to the state it had before it was deleted, so that a
+// locked or disabled entity stays locked or disabled. The entity's attributes are
+// re-validated first so a restore cannot collide with a unique value claimed while it was deleted.
+func (s *entityService) RestoreEntity(ctx context.Context, entityID string) error {
+	s.logger.Debug(ctx, "Restoring entity", log.MaskedString("id", entityID))
+
+	deleted, err := s.store.GetDeletedEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.validateEntityType(
+		ctx, deleted.Category, deleted.Type, deleted.OUID, deleted.Attributes, entityID, true); err != nil {
+		return err
+	}
+
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		return s.store.RestoreEntity(txCtx, entityID)
+	})
+}
+
+// UpdateEntityState changes the lifecycle state of an entity. Soft deletion and restore have their
+// own operations and are not performed through this method.
+func (s *entityService) UpdateEntityState(
+	ctx context.Context, entityID string, state providers.EntityState) error {
+	s.logger.Debug(ctx, "Updating entity state", log.MaskedString("id", entityID),
+		log.String("state", string(state)))
+	if state == providers.EntityStateDeleted {
+		return fmt.Errorf("%w: use soft delete to delete an entity", ErrInvalidEntityState)
+	}
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		return s.store.UpdateEntityState(txCtx, entityID, state)
+	})
+}
+
+// GetDeletedEntity retrieves a soft-deleted entity by ID, including its deletion time.
+func (s *entityService) GetDeletedEntity(ctx context.Context, entityID string) (*providers.Entity, error) {
+	result, err := s.store.GetDeletedEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetDeletedEntityListCount retrieves the total count of soft-deleted entities by category.
+func (s *entityService) GetDeletedEntityListCount(ctx context.Context,
+	category providers.EntityCategory) (int, error) {
+	return s.store.GetDeletedEntityListCount(ctx, string(category))
+}
+
+// GetDeletedEntityList retrieves a page of soft-deleted entities by category.
+func (s *entityService) GetDeletedEntityList(ctx context.Context, category providers.EntityCategory,
+	limit, offset int) ([]providers.Entity, error) {
+	return s.store.GetDeletedEntityList(ctx, string(category), limit, offset)
+}
+
+// GetExpiredDeletedEntityIDs retrieves up to limit IDs of entities of the given category that were
+// soft-deleted before deletedBefore.
+func (s *entityService) GetExpiredDeletedEntityIDs(ctx context.Context, category providers.EntityCategory,
+	deletedBefore time.Time, limit int) ([]string, error) {
+	return s.store.GetExpiredDeletedEntityIDs(ctx, string(category), deletedBefore, limit)
+}
+
 // UpdateAttributes updates only the schema attributes of an entity.
 // Any credential fields present in the attributes are extracted, hashed, and merged
 // with the existing credentials atomically.
@@ -309,7 +396,8 @@ func (s *entityService) UpdateAttributes(ctx context.Context, entityID string, a
 	}
 
 	// Validate attribute uniqueness via schema (excludes self, credentials not required for updates).
-	if err := s.validateEntityType(ctx, existing.Category, existing.Type, attributes, entityID, true); err != nil {
+	if err := s.validateEntityType(
+		ctx, existing.Category, existing.Type, existing.OUID, attributes, entityID, true); err != nil {
 		return err
 	}
 
@@ -485,12 +573,18 @@ func (s *entityService) AuthenticateEntityByID(
 		return nil, err
 	}
 
-	if result.Entity.State != providers.EntityStateActive {
-		return nil, ErrEntityNotFound
+	rehash, err := s.verifyCredentials(credentials, result.SchemaCredentials, result.SystemCredentials)
+	if err != nil {
+		return nil, err
+	}
+	if len(rehash) > 0 {
+		s.rehashCredentials(ctx, entityID, rehash)
 	}
 
-	if err := s.verifyCredentials(credentials, result.SchemaCredentials, result.SystemCredentials); err != nil {
-		return nil, err
+	// The account state is only disclosed once the credentials are proven, so a wrong password
+	// never reveals whether an account is locked or disabled.
+	if result.Entity.State != providers.EntityStateActive {
+		return nil, ErrEntityNotActive
 	}
 
 	return &AuthenticateResult{
@@ -501,32 +595,23 @@ func (s *entityService) AuthenticateEntityByID(
 	}, nil
 }
 
-// verifyCredentials verifies provided credentials from both schema and system credentials.
+// verifyCredentials verifies provided credentials from both schema and system credentials. It
+// returns the verified credentials whose stored form should be hashed again: values stored in plain
+// text by older releases, and hashes made with an algorithm or parameters other than the configured
+// ones.
 func (s *entityService) verifyCredentials(credentials map[string]interface{},
-	schemaCredsJSON, systemCredsJSON json.RawMessage) error {
+	schemaCredsJSON, systemCredsJSON json.RawMessage) ([]credentialRehash, error) {
 	// Merge both credential columns for verification.
-	storedCreds := make(map[string][]StoredCredential)
-	if len(schemaCredsJSON) > 0 {
-		var schemaCreds map[string][]StoredCredential
-		if err := json.Unmarshal(schemaCredsJSON, &schemaCreds); err != nil {
-			return fmt.Errorf("failed to unmarshal schema credentials: %w", err)
-		}
-		for k, v := range schemaCreds {
-			storedCreds[k] = v
-		}
+	storedCreds := make(map[string]storedCredentialEntry)
+	if err := collectStoredCredentials(schemaCredsJSON, false, storedCreds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema credentials: %w", err)
 	}
-	if len(systemCredsJSON) > 0 {
-		var sysCreds map[string][]StoredCredential
-		if err := json.Unmarshal(systemCredsJSON, &sysCreds); err != nil {
-			return fmt.Errorf("failed to unmarshal system credentials: %w", err)
-		}
-		for k, v := range sysCreds {
-			storedCreds[k] = v
-		}
+	if err := collectStoredCredentials(systemCredsJSON, true, storedCreds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal system credentials: %w", err)
 	}
 
 	if len(storedCreds) == 0 {
-		return ErrAuthenticationFailed
+		return nil, ErrAuthenticationFailed
 	}
 
 	// Filter to credentials that have stored entries.
@@ -543,37 +628,173 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 	}
 
 	if len(credentialsToVerify) == 0 {
-		return ErrAuthenticationFailed
+		return nil, ErrAuthenticationFailed
 	}
 
 	// Verify each credential against stored values.
+	var rehash []credentialRehash
 	for credType, credValue := range credentialsToVerify {
-		credList := storedCreds[credType]
+		entry := storedCreds[credType]
+		if entry.plaintext != nil {
+			if subtle.ConstantTimeCompare([]byte(credValue), []byte(*entry.plaintext)) != 1 {
+				return nil, ErrAuthenticationFailed
+			}
+			rehash = append(rehash, credentialRehash{credType: credType, value: credValue, index: -1,
+				system: entry.system})
+			continue
+		}
+
 		verified := false
-		for _, stored := range credList {
+		for i, stored := range entry.hashed {
 			ref := cryptolib.Credential{
-				Algorithm: stored.StorageAlgo,
-				Hash:      stored.Value,
-				Parameters: cryptolib.CredParameters{
-					Salt:       stored.StorageAlgoParams.Salt,
-					Iterations: stored.StorageAlgoParams.Iterations,
-					KeySize:    stored.StorageAlgoParams.KeySize,
-				},
+				Algorithm:  stored.StorageAlgo,
+				Hash:       stored.Value,
+				Parameters: stored.StorageAlgoParams,
 			}
 			ok, verifyErr := s.hashService.Verify([]byte(credValue), ref)
 			if verifyErr == nil && ok {
 				verified = true
+				if s.hashService.NeedsRehash(ref) {
+					rehash = append(rehash, credentialRehash{credType: credType, value: credValue, index: i,
+						system: entry.system})
+				}
 				break
 			}
 		}
 		if !verified {
-			return ErrAuthenticationFailed
+			return nil, ErrAuthenticationFailed
 		}
 	}
 
+	return rehash, nil
+}
+
+// storedCredentialEntry holds the stored form of one credential type. Older releases stored some
+// credentials as plain strings; those are kept in plaintext until they are hashed again.
+type storedCredentialEntry struct {
+	hashed    []StoredCredential
+	plaintext *string
+	system    bool
+}
+
+// credentialRehash identifies a verified credential to hash again with the configured algorithm.
+// index is the position of the matched stored hash, or -1 for a plain text value.
+type credentialRehash struct {
+	credType string
+	value    string
+	index    int
+	system   bool
+}
+
+// collectStoredCredentials parses a credential column into entries keyed by credential type.
+func collectStoredCredentials(credsJSON json.RawMessage, system bool,
+	entries map[string]storedCredentialEntry) error {
+	if len(credsJSON) == 0 {
+		return nil
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(credsJSON, &raw); err != nil {
+		return err
+	}
+	for credType, value := range raw {
+		var plaintext string
+		if err := json.Unmarshal(value, &plaintext); err == nil {
+			entries[credType] = storedCredentialEntry{plaintext: &plaintext, system: system}
+			continue
+		}
+		var hashed []StoredCredential
+		if err := json.Unmarshal(value, &hashed); err != nil {
+			return err
+		}
+		entries[credType] = storedCredentialEntry{hashed: hashed, system: system}
+	}
 	return nil
 }
 
+// rehashCredentials hashes the given verified credentials again with the configured algorithm and
+// stores them. Failures are logged and do not affect the authentication result.
+func (s *entityService) rehashCredentials(ctx context.Context, entityID string, rehash []credentialRehash) {
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		current, err := s.store.GetEntityWithCredentials(txCtx, entityID)
+		if err != nil {
+			return err
+		}
+		schemaCreds, schemaChanged, err := s.replaceCredentialHashes(current.SchemaCredentials, rehash, false)
+		if err != nil {
+			return err
+		}
+		systemCreds, systemChanged, err := s.replaceCredentialHashes(current.SystemCredentials, rehash, true)
+		if err != nil {
+			return err
+		}
+		if schemaChanged {
+			if err := s.store.UpdateCredentials(txCtx, entityID, schemaCreds); err != nil {
+				return err
+			}
+		}
+		if systemChanged {
+			return s.store.UpdateSystemCredentials(txCtx, entityID, systemCreds)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to rehash entity credentials", log.MaskedString("id", entityID), log.Error(err))
+	}
+}
+
+// replaceCredentialHashes replaces the stored values named in rehash within one credential column.
+func (s *entityService) replaceCredentialHashes(credsJSON json.RawMessage, rehash []credentialRehash,
+	system bool) (json.RawMessage, bool, error) {
+	if len(credsJSON) == 0 {
+		return credsJSON, false, nil
+	}
+	var creds map[string]json.RawMessage
+	if err := json.Unmarshal(credsJSON, &creds); err != nil {
+		return nil, false, err
+	}
+
+	changed := false
+	for _, r := range rehash {
+		if r.system != system {
+			continue
+		}
+		value, ok := creds[r.credType]
+		if !ok {
+			continue
+		}
+		credHash, err := s.hashService.Generate([]byte(r.value))
+		if err != nil {
+			return nil, false, err
+		}
+		newCred := StoredCredential{
+			StorageAlgo:       credHash.Algorithm,
+			StorageAlgoParams: credHash.Parameters,
+			Value:             credHash.Hash,
+		}
+
+		var hashed []StoredCredential
+		if r.index >= 0 {
+			if err := json.Unmarshal(value, &hashed); err != nil || r.index >= len(hashed) {
+				continue
+			}
+			hashed[r.index] = newCred
+		} else {
+			hashed = []StoredCredential{newCred}
+		}
+		updated, err := json.Marshal(hashed)
+		if err != nil {
+			return nil, false, err
+		}
+		creds[r.credType] = updated
+		changed = true
+	}
+	if !changed {
+		return credsJSON, false, nil
+	}
+	updatedJSON, err := json.Marshal(creds)
+	return updatedJSON, err == nil, err
+}
+
 // UpdateCredentials updates schema-defined credentials (e.g., password) by hashing new
 // plaintext values and merging with existing stored credentials. Payload keys are
 // restricted to fields declared as credentials in the entity's schema.
@@ -775,6 +996,7 @@ func (s *entityService) populateOUHandles(ctx context.Context, entities []provid
 }
 
 // validateEntityType validates entity attributes and uniqueness against the entity type.
+// ouID is the organization unit the entity belongs to and bounds OU-scoped uniqueness checks.
 // excludeEntityID is used to exclude the entity itself from uniqueness
 // checks during updates (empty string for creates). skipCredentialRequired controls whether
 // credential fields are required (false for creates, true for updates).
@@ -782,6 +1004,7 @@ func (s *entityService) validateEntityType(
 	ctx context.Context,
 	category providers.EntityCategory,
 	entityType string,
+	ouID string,
 	attributes json.RawMessage,
 	excludeEntityID string,
 	skipCredentialRequired bool,
@@ -804,7 +1027,10 @@ func (s *entityService) validateEntityType(
 
 	// Validate attribute uniqueness
 	isValid, svcErr = s.entityTypeService.ValidateEntityUniqueness(ctx, schemaCategory, entityType, attributes,
-		func(filters map[string]interface{}) (bool, error) {
+		func(filters map[string]interface{}, scope entitytype.UniqueScope) (bool, error) {
+			if scope == entitytype.UniqueScopeOU {
+				return s.existsInOU(ctx, filters, ouID, excludeEntityID)
+			}
 			id, err := s.IdentifyEntity(ctx, filters)
 			if err != nil {
 				if errors.Is(err, ErrEntityNotFound) {
@@ -831,6 +1057,26 @@ func (s *entityService) validateEntityType(
 	return nil
 }
 
+// existsInOU reports whether an entity other than excludeEntityID in the given organization unit
+// matches the filters. It backs uniqueness constraints that are scoped to an organization unit.
+func (s *entityService) existsInOU(
+	ctx context.Context, filters map[string]interface{}, ouID, excludeEntityID string,
+) (bool, error) {
+	matches, err := s.store.SearchEntities(ctx, filters)
+	if err != nil {
+		if errors.Is(err, ErrEntityNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	for i := range matches {
+		if matches[i].OUID == ouID && matches[i].ID != excludeEntityID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // mergeCredentialJSON merges new credential JSON into existing credential JSON.
 // New credential types replace existing ones; types not in the update are preserved.
 func mergeCredentialJSON(existing, updates json.RawMessage) json.RawMessage {
@@ -950,13 +1196,9 @@ func (s *entityService) hashPlaintextCredentials(creds json.RawMessage) (json.Ra
 			}
 			result[credType] = []StoredCredential{
 				{
-					StorageAlgo: credHash.Algorithm,
-					StorageAlgoParams: cryptolib.CredParameters{
-						Salt:       credHash.Parameters.Salt,
-						Iterations: credHash.Parameters.Iterations,
-						KeySize:    credHash.Parameters.KeySize,
-					},
-					Value: credHash.Hash,
+					StorageAlgo:       credHash.Algorithm,
+					StorageAlgoParams: credHash.Parameters,
+					Value:             credHash.Hash,
 				},
 			}
 		default:
@@ -57,6 +57,7 @@ func (s *ServiceTestSuite) SetupTest() {
 			Salt: "testsalt", Iterations: 1, KeySize: 32,
 		},
 	}, nil).Maybe()
+	s.hashService.On("NeedsRehash", mock.Anything).Return(false).Maybe()
 	s.svc = newEntityService(s.store, s.hashService, nil, nil, transaction.NewNoOpTransactioner())
 	s.ctx = context.Background()
 	s.testErr = errors.New("store error")
@@ -433,6 +434,52 @@ func (s *ServiceTestSuite) TestAuthenticateEntityByID_Success() {
 	s.Equal(e.OUID, result.OUID)
 }
 
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_RehashesPlaintextSystemCredential() {
+	e := testEntity("auth-plain")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SystemCredentials: json.RawMessage(`{"clientSecret":"s3cret"}`)},
+			nil)
+	s.store.On("UpdateSystemCredentials", mock.Anything, e.ID, mock.MatchedBy(func(creds json.RawMessage) bool {
+		var stored map[string][]StoredCredential
+		return json.Unmarshal(creds, &stored) == nil && len(stored["clientSecret"]) == 1 &&
+			stored["clientSecret"][0].Value == "testhash"
+	})).Return(nil).Once()
+
+	result, err := s.svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"clientSecret": "s3cret"})
+	s.NoError(err)
+	s.Equal(e.ID, result.EntityID)
+
+	_, err = s.svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"clientSecret": "wrong"})
+	s.ErrorIs(err, ErrAuthenticationFailed)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_RehashesOutdatedHash() {
+	e := testEntity("auth-outdated")
+	storedCreds := json.RawMessage(`{"clientSecret":[{"value":"oldhash","storageAlgo":"SHA256",` +
+		`"storageAlgoParams":{"salt":"oldsalt"}}]}`)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SystemCredentials: storedCreds}, nil)
+	hashService := hashmock.NewHashServiceInterfaceMock(s.T())
+	hashService.On("Verify", []byte("s3cret"), mock.MatchedBy(func(ref cryptolib.Credential) bool {
+		return ref.Algorithm == cryptolib.SHA256 && ref.Parameters.Salt == "oldsalt"
+	})).Return(true, nil)
+	hashService.On("NeedsRehash", mock.Anything).Return(true)
+	hashService.On("Generate", []byte("s3cret")).Return(cryptolib.Credential{
+		Algorithm: cryptolib.PBKDF2, Hash: "testhash",
+		Parameters: cryptolib.CredParameters{Salt: "testsalt", Iterations: 1, KeySize: 32},
+	}, nil)
+	svc := newEntityService(s.store, hashService, nil, nil, transaction.NewNoOpTransactioner())
+	s.store.On("UpdateSystemCredentials", mock.Anything, e.ID, mock.MatchedBy(func(creds json.RawMessage) bool {
+		var stored map[string][]StoredCredential
+		return json.Unmarshal(creds, &stored) == nil &&
+			stored["clientSecret"][0].StorageAlgo == "PBKDF2" && stored["clientSecret"][0].Value == "testhash"
+	})).Return(errors.New("db error"))
+
+	result, err := svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"clientSecret": "s3cret"})
+	s.NoError(err, "a failed rehash should not fail authentication")
+	s.Equal(e.ID, result.EntityID)
+}
+
 func (s *ServiceTestSuite) TestAuthenticateEntityByID_EmptyID() {
 	_, err := s.svc.AuthenticateEntityByID(s.ctx, "", map[string]interface{}{"password": "p"})
 	s.ErrorIs(err, ErrEntityNotFound)
@@ -453,12 +500,43 @@ func (s *ServiceTestSuite) TestAuthenticateEntityByID_EntityNotFound() {
 
 func (s *ServiceTestSuite) TestAuthenticateEntityByID_InactiveEntity() {
 	e := testEntity("inactive-1")
-	e.State = providers.EntityState("SUSPENDED")
+	e.State = providers.EntityStateDisabled
 	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
 		Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil)
+	s.hashService.On("Verify", []byte("p"), mock.Anything).Return(true, nil)
 
 	_, err := s.svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"password": "p"})
-	s.ErrorIs(err, ErrEntityNotFound)
+	s.ErrorIs(err, ErrEntityNotActive)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_InactiveEntityWrongCredentials() {
+	e := testEntity("inactive-2")
+	e.State = providers.EntityStateLocked
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil)
+	s.hashService.On("Verify", []byte("wrong"), mock.Anything).Return(false, nil)
+
+	_, err := s.svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"password": "wrong"})
+	s.ErrorIs(err, ErrAuthenticationFailed)
+}
+
+func (s *ServiceTestSuite) TestUpdateEntityState_Delegates() {
+	s.store.On("UpdateEntityState", mock.Anything, "e1", providers.EntityStateLocked).Return(nil)
+
+	err := s.svc.UpdateEntityState(s.ctx, "e1", providers.EntityStateLocked)
+	s.NoError(err)
+}
+
+func (s *ServiceTestSuite) TestUpdateEntityState_StoreFails() {
+	s.store.On("UpdateEntityState", mock.Anything, "e1", providers.EntityStateDisabled).Return(s.testErr)
+
+	err := s.svc.UpdateEntityState(s.ctx, "e1", providers.EntityStateDisabled)
+	s.ErrorIs(err, s.testErr)
+}
+
+func (s *ServiceTestSuite) TestUpdateEntityState_RejectsDeleted() {
+	err := s.svc.UpdateEntityState(s.ctx, "e1", providers.EntityStateDeleted)
+	s.ErrorIs(err, ErrInvalidEntityState)
 }
 
 func (s *ServiceTestSuite) TestAuthenticateEntityByID_WrongCredentials() {
@@ -518,3 +596,43 @@ func (s *ServiceTestSuite) TestGetTransitiveEntityGroups_ProviderError() {
 
 	s.ErrorIs(err, s.testErr)
 }
+
+func (s *ServiceTestSuite) TestExistsInOU_MatchInSameOU() {
+	filters := map[string]interface{}{"email": "a@example.com"}
+	s.store.On("SearchEntities", mock.Anything, filters).Return([]providers.Entity{
+		{ID: "other", OUID: "ou-1"},
+	}, nil)
+
+	found, err := s.svc.(*entityService).existsInOU(s.ctx, filters, "ou-1", "self")
+	s.NoError(err)
+	s.True(found)
+}
+
+func (s *ServiceTestSuite) TestExistsInOU_IgnoresOtherOUsAndSelf() {
+	filters := map[string]interface{}{"email": "a@example.com"}
+	s.store.On("SearchEntities", mock.Anything, filters).Return([]providers.Entity{
+		{ID: "other", OUID: "ou-2"},
+		{ID: "self", OUID: "ou-1"},
+	}, nil)
+
+	found, err := s.svc.(*entityService).existsInOU(s.ctx, filters, "ou-1", "self")
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *ServiceTestSuite) TestExistsInOU_NotFound() {
+	filters := map[string]interface{}{"email": "a@example.com"}
+	s.store.On("SearchEntities", mock.Anything, filters).Return(nil, ErrEntityNotFound)
+
+	found, err := s.svc.(*entityService).existsInOU(s.ctx, filters, "ou-1", "")
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *ServiceTestSuite) TestExistsInOU_StoreError() {
+	filters := map[string]interface{}{"email": "a@example.com"}
+	s.store.On("SearchEntities", mock.Anything, filters).Return(nil, s.testErr)
+
+	_, err := s.svc.(*entityService).existsInOU(s.ctx, filters, "ou-1", "")
+	s.ErrorIs(err, s.testErr)
+}
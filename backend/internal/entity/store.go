@@ -31,6 +31,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -51,6 +52,16 @@ type entityStoreInterface interface {
 		creds json.RawMessage) error
 	DeleteEntity(ctx context.Context, id string) error
 
+	// Soft delete
+	SoftDeleteEntity(ctx context.Context, id string) error
+	RestoreEntity(ctx context.Context, id string) error
+	UpdateEntityState(ctx context.Context, id string, state providers.EntityState) error
+	GetDeletedEntity(ctx context.Context, id string) (providers.Entity, error)
+	GetDeletedEntityListCount(ctx context.Context, category string) (int, error)
+	GetDeletedEntityList(ctx context.Context, category string, limit, offset int) ([]providers.Entity, error)
+	GetExpiredDeletedEntityIDs(ctx context.Context, category string,
+		deletedBefore time.Time, limit int) ([]string, error)
+
 	// Query
 	IdentifyEntity(ctx context.Context, filters map[string]interface{}) (*string, error)
 	SearchEntities(ctx context.Context, filters map[string]interface{}) ([]providers.Entity, error)
@@ -212,7 +223,8 @@ func (es *entityDBStore) GetEntity(ctx context.Context, id string) (providers.En
 	return buildEntityFromResultRow(results[0])
 }
 
-// GetEntityWithCredentials retrieves an entity with all credential columns.
+// GetEntityWithCredentials retrieves an entity with all credential columns. Credentials are read from
+// the primary database so that a changed or revoked credential is never accepted from a lagging replica.
 func (es *entityDBStore) GetEntityWithCredentials(ctx context.Context, id string) (
 	*entityWithCredentials, error) {
 	dbClient, err := es.dbProvider.GetUserDBClient()
@@ -220,7 +232,8 @@ func (es *entityDBStore) GetEntityWithCredentials(ctx context.Context, id string
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, QueryGetEntityWithCredentials, id, es.deploymentID)
+	results, err := dbClient.QueryContext(
+		provider.WithPrimaryReads(ctx), QueryGetEntityWithCredentials, id, es.deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -422,6 +435,165 @@ func (es *entityDBStore) DeleteEntity(ctx context.Context, id string) error {
 	return nil
 }
 
+// SoftDeleteEntity marks an entity as deleted and removes its indexed identifiers so the entity no
+// longer resolves through identification, while its row is kept for restore until it is purged.
+func (es *entityDBStore) SoftDeleteEntity(ctx context.Context, id string) error {
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, QuerySoftDeleteEntity, id, time.Now().UTC(), es.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrEntityNotFound
+	}
+
+	if _, err = dbClient.ExecuteContext(ctx, QueryDeleteIdentifiersByEntity, id, es.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete entity identifiers: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreEntity returns a soft-deleted entity to the state it had before it was deleted and re-syncs
+// its identifiers.
+func (es *entityDBStore) RestoreEntity(ctx context.Context, id string) error {
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, QueryRestoreEntity, id, time.Now().UTC(), es.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrEntityNotFound
+	}
+
+	current, err := es.GetEntity(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to reload entity for identifier sync: %w", err)
+	}
+
+	if err := es.syncAttributeIdentifiers(
+		ctx, id, current.Attributes, current.SystemAttributes, es.indexedAttributes); err != nil {
+		return fmt.Errorf("failed to sync identifiers: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateEntityState changes the state of an entity that is not deleted.
+func (es *entityDBStore) UpdateEntityState(ctx context.Context, id string, state providers.EntityState) error {
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(
+		ctx, QueryUpdateEntityState, id, string(state), time.Now().UTC(), es.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// GetDeletedEntity retrieves a soft-deleted entity by ID.
+func (es *entityDBStore) GetDeletedEntity(ctx context.Context, id string) (providers.Entity, error) {
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return providers.Entity{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, QueryGetDeletedEntityByID, id, es.deploymentID)
+	if err != nil {
+		return providers.Entity{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if len(results) == 0 {
+		return providers.Entity{}, ErrEntityNotFound
+	}
+
+	if len(results) != 1 {
+		return providers.Entity{}, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildDeletedEntityFromResultRow(results[0])
+}
+
+// GetDeletedEntityListCount retrieves the total count of soft-deleted entities by category.
+func (es *entityDBStore) GetDeletedEntityListCount(ctx context.Context, category string) (int, error) {
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	return executeCountQuery(dbClient, ctx, QueryGetDeletedEntityCount, []interface{}{category, es.deploymentID})
+}
+
+// GetDeletedEntityList retrieves a page of soft-deleted entities by category.
+func (es *entityDBStore) GetDeletedEntityList(ctx context.Context, category string,
+	limit, offset int) ([]providers.Entity, error) {
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, QueryGetDeletedEntityList, limit, offset, es.deploymentID, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute paginated query: %w", err)
+	}
+
+	entities := make([]providers.Entity, 0, len(results))
+	for _, row := range results {
+		entity, err := buildDeletedEntityFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+
+	return entities, nil
+}
+
+// GetExpiredDeletedEntityIDs retrieves up to limit IDs of soft-deleted entities of the given category
+// that were deleted before deletedBefore, oldest first.
+func (es *entityDBStore) GetExpiredDeletedEntityIDs(ctx context.Context, category string,
+	deletedBefore time.Time, limit int) ([]string, error) {
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, QueryGetExpiredDeletedEntityIDs,
+		category, deletedBefore.UTC(), es.deploymentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	ids := make([]string, 0, len(results))
+	for _, row := range results {
+		id, ok := row["id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse id as string")
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
 // syncAttributeIdentifiers synchronizes indexed attributes from both Attributes and SystemAttributes
 // to the identifier store. Schema attributes get source="attribute", system attributes get source="system".
 func (es *entityDBStore) syncAttributeIdentifiers(ctx context.Context, entityID string,
@@ -876,6 +1048,23 @@ func buildEntityFromResultRow(row map[string]interface{}) (providers.Entity, err
 	return entity, nil
 }
 
+// buildDeletedEntityFromResultRow builds an entity from a soft-deleted entity row, including its
+// deletion time.
+func buildDeletedEntityFromResultRow(row map[string]interface{}) (providers.Entity, error) {
+	entity, err := buildEntityFromResultRow(row)
+	if err != nil {
+		return providers.Entity{}, err
+	}
+
+	deletedAt, err := sysutils.ParseDBTimeField(row["deleted_at"], "deleted_at")
+	if err != nil {
+		return providers.Entity{}, err
+	}
+	entity.DeletedAt = &deletedAt
+
+	return entity, nil
+}
+
 func buildGroupFromResultRow(row map[string]interface{}) (providers.EntityGroup, error) {
 	groupID, ok := row["id"].(string)
 	if !ok {
@@ -41,20 +41,21 @@ const (
 var (
 	// QueryGetEntityCount is the query to get total count of entities by category.
 	QueryGetEntityCount = model.DBQuery{
-		ID:    "ASQ-ENTITY_MGT-01",
-		Query: `SELECT COUNT(*) as total FROM "ENTITY" WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $2`,
+		ID: "ASQ-ENTITY_MGT-01",
+		Query: `SELECT COUNT(*) as total FROM "ENTITY" WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $2 ` +
+			`AND STATE <> 'DELETED'`,
 	}
 	// QueryGetEntityList is the query to get a list of entities by category.
 	QueryGetEntityList = model.DBQuery{
 		ID: "ASQ-ENTITY_MGT-02",
 		Query: `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES FROM "ENTITY" ` +
-			`WHERE CATEGORY = $4 AND DEPLOYMENT_ID = $3 ORDER BY ID LIMIT $1 OFFSET $2`,
+			`WHERE CATEGORY = $4 AND DEPLOYMENT_ID = $3 AND STATE <> 'DELETED' ORDER BY ID LIMIT $1 OFFSET $2`,
 	}
 	// QuerySearchEntityList is the query to search entities across all categories.
 	QuerySearchEntityList = model.DBQuery{
 		ID: "ASQ-ENTITY_MGT-03",
 		Query: `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES FROM "ENTITY" ` +
-			`WHERE DEPLOYMENT_ID = $3 ORDER BY ID LIMIT $1 OFFSET $2`,
+			`WHERE DEPLOYMENT_ID = $3 AND STATE <> 'DELETED' ORDER BY ID LIMIT $1 OFFSET $2`,
 	}
 	// QueryCreateEntity is the query to create a new entity.
 	QueryCreateEntity = model.DBQuery{
@@ -68,7 +69,7 @@ var (
 	QueryGetEntityByID = model.DBQuery{
 		ID: "ASQ-ENTITY_MGT-05",
 		Query: `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES ` +
-			`FROM "ENTITY" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+			`FROM "ENTITY" WHERE ID = $1 AND DEPLOYMENT_ID = $2 AND STATE <> 'DELETED'`,
 	}
 	// QueryUpdateEntity is the query to fully update an entity including system attributes.
 	QueryUpdateEntity = model.DBQuery{
@@ -106,7 +107,7 @@ var (
 		ID: "ASQ-ENTITY_MGT-12",
 		Query: `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, ` +
 			`SYSTEM_ATTRIBUTES, CREDENTIALS, SYSTEM_CREDENTIALS ` +
-			`FROM "ENTITY" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+			`FROM "ENTITY" WHERE ID = $1 AND DEPLOYMENT_ID = $2 AND STATE <> 'DELETED'`,
 	}
 	// QueryGetGroupCountForEntity is the query to get the count of groups for a given entity.
 	QueryGetGroupCountForEntity = model.DBQuery{
@@ -142,6 +143,54 @@ var (
 		ID:    "ASQ-ENTITY_MGT-19",
 		Query: `DELETE FROM "ENTITY_IDENTIFIER" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2 AND SOURCE = 'system'`,
 	}
+	// QuerySoftDeleteEntity is the query to mark an entity as deleted without removing it. The state
+	// before the deletion is kept so that a restore brings it back.
+	QuerySoftDeleteEntity = model.DBQuery{
+		ID: "ASQ-ENTITY_MGT-30",
+		Query: `UPDATE "ENTITY" SET PREVIOUS_STATE = STATE, STATE = 'DELETED', DELETED_AT = $2, UPDATED_AT = $2 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $3 AND STATE <> 'DELETED'`,
+	}
+	// QueryRestoreEntity is the query to bring a soft-deleted entity back to the state it had before it
+	// was deleted. Entities deleted without a recorded state are restored as active.
+	QueryRestoreEntity = model.DBQuery{
+		ID: "ASQ-ENTITY_MGT-31",
+		Query: `UPDATE "ENTITY" SET STATE = COALESCE(PREVIOUS_STATE, 'ACTIVE'), PREVIOUS_STATE = NULL, ` +
+			`DELETED_AT = NULL, UPDATED_AT = $2 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $3 AND STATE = 'DELETED'`,
+	}
+	// QueryUpdateEntityState is the query to change the state of an entity that is not deleted.
+	QueryUpdateEntityState = model.DBQuery{
+		ID: "ASQ-ENTITY_MGT-36",
+		Query: `UPDATE "ENTITY" SET STATE = $2, UPDATED_AT = $3 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $4 AND STATE <> 'DELETED'`,
+	}
+	// QueryGetDeletedEntityByID is the query to get a soft-deleted entity by ID.
+	QueryGetDeletedEntityByID = model.DBQuery{
+		ID: "ASQ-ENTITY_MGT-32",
+		Query: `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES, DELETED_AT ` +
+			`FROM "ENTITY" WHERE ID = $1 AND DEPLOYMENT_ID = $2 AND STATE = 'DELETED'`,
+	}
+	// QueryGetDeletedEntityCount is the query to get the total count of soft-deleted entities by category.
+	QueryGetDeletedEntityCount = model.DBQuery{
+		ID: "ASQ-ENTITY_MGT-33",
+		Query: `SELECT COUNT(*) as total FROM "ENTITY" ` +
+			`WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $2 AND STATE = 'DELETED'`,
+	}
+	// QueryGetDeletedEntityList is the query to get a list of soft-deleted entities by category,
+	// most recently deleted first.
+	QueryGetDeletedEntityList = model.DBQuery{
+		ID: "ASQ-ENTITY_MGT-34",
+		Query: `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES, DELETED_AT ` +
+			`FROM "ENTITY" WHERE CATEGORY = $4 AND DEPLOYMENT_ID = $3 AND STATE = 'DELETED' ` +
+			`ORDER BY DELETED_AT DESC, ID LIMIT $1 OFFSET $2`,
+	}
+	// QueryGetExpiredDeletedEntityIDs is the query to get the IDs of soft-deleted entities of a category
+	// that were deleted before the given time.
+	QueryGetExpiredDeletedEntityIDs = model.DBQuery{
+		ID: "ASQ-ENTITY_MGT-35",
+		Query: `SELECT ID FROM "ENTITY" WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $3 AND STATE = 'DELETED' ` +
+			`AND DELETED_AT < $2 ORDER BY DELETED_AT LIMIT $4`,
+	}
 )
 
 // appendOUIDsINClause appends an "AND OU_ID IN (...)" condition to a query for the given OU IDs.
@@ -188,7 +237,7 @@ func buildEntityCountQueryByOUIDs(
 	category string, ouIDs []string, filters map[string]interface{}, deploymentID string,
 ) (model.DBQuery, []interface{}, error) {
 	queryID := "ASQ-ENTITY_MGT-20"
-	baseQuery := `SELECT COUNT(*) as total FROM "ENTITY" WHERE CATEGORY = $1`
+	baseQuery := `SELECT COUNT(*) as total FROM "ENTITY" WHERE CATEGORY = $1 AND STATE <> 'DELETED'`
 	args := []interface{}{category}
 
 	if len(filters) > 0 {
@@ -220,7 +269,7 @@ func buildEntityListQueryByOUIDs(
 ) (model.DBQuery, []interface{}, error) {
 	queryID := "ASQ-ENTITY_MGT-21"
 	baseQuery := `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES ` +
-		`FROM "ENTITY" WHERE CATEGORY = $1`
+		`FROM "ENTITY" WHERE CATEGORY = $1 AND STATE <> 'DELETED'`
 	args := []interface{}{category}
 	var query model.DBQuery
 
@@ -280,8 +329,8 @@ func buildIdentifyQuery(filters map[string]interface{}, deploymentID string) (mo
 	}
 	sort.Strings(keys)
 
-	pgQuery := `SELECT ID FROM "ENTITY" WHERE 1=1`
-	sqQuery := `SELECT ID FROM "ENTITY" WHERE 1=1`
+	pgQuery := `SELECT ID FROM "ENTITY" WHERE STATE <> 'DELETED'`
+	sqQuery := `SELECT ID FROM "ENTITY" WHERE STATE <> 'DELETED'`
 	args := make([]interface{}, 0, len(keys)+1)
 
 	for i, key := range keys {
@@ -341,7 +390,7 @@ func buildEntityINClauseQuery(
 func buildBulkEntityExistsQuery(entityIDs []string, deploymentID string) (model.DBQuery, []interface{}, error) {
 	return buildEntityINClauseQuery(
 		"ASQ-ENTITY_MGT-23",
-		`SELECT ID FROM "ENTITY" WHERE ID IN (%s) AND DEPLOYMENT_ID = %s`,
+		`SELECT ID FROM "ENTITY" WHERE ID IN (%s) AND DEPLOYMENT_ID = %s AND STATE <> 'DELETED'`,
 		entityIDs, deploymentID,
 	)
 }
@@ -378,7 +427,8 @@ func buildBulkEntityExistsQueryInOUs(
 		args = append(args, entityID)
 	}
 
-	baseQueryTpl := `SELECT ID FROM "ENTITY" WHERE DEPLOYMENT_ID = %s AND OU_ID IN (%s) AND ID IN (%s)`
+	baseQueryTpl := `SELECT ID FROM "ENTITY" WHERE DEPLOYMENT_ID = %s AND STATE <> 'DELETED' ` +
+		`AND OU_ID IN (%s) AND ID IN (%s)`
 	postgresQuery := fmt.Sprintf(baseQueryTpl, "$1",
 		strings.Join(postgresOUPlaceholders, ","), strings.Join(postgresIDPlaceholders, ","))
 	sqliteQuery := fmt.Sprintf(baseQueryTpl, "?",
@@ -405,10 +455,10 @@ func buildEntityListQuery(
 		var baseWithCategory string
 		var args []interface{}
 		if category != "" {
-			baseWithCategory = baseQuery + " WHERE CATEGORY = $1"
+			baseWithCategory = baseQuery + " WHERE CATEGORY = $1 AND STATE <> 'DELETED'"
 			args = []interface{}{category}
 		} else {
-			baseWithCategory = baseQuery + " WHERE 1=1"
+			baseWithCategory = baseQuery + " WHERE STATE <> 'DELETED'"
 			args = []interface{}{}
 		}
 		fq, fArgs, err := buildFilterQueryWithOffset(queryID, baseWithCategory, filters, len(args))
@@ -453,7 +503,7 @@ func buildEntityCountQuery(
 	queryID := "ASQ-ENTITY_MGT-26"
 
 	if len(filters) > 0 {
-		baseWithCategory := baseQuery + " WHERE CATEGORY = $1"
+		baseWithCategory := baseQuery + " WHERE CATEGORY = $1 AND STATE <> 'DELETED'"
 		args := []interface{}{category}
 		fq, fArgs, err := buildFilterQueryWithOffset(queryID, baseWithCategory, filters, len(args))
 		if err != nil {
@@ -571,8 +621,8 @@ func buildIdentifyQueryHybrid(
 		paramIndex += 2
 	}
 
-	postgresQuery += " WHERE " + strings.Join(whereConditions, " AND ")
-	sqliteQuery += " WHERE " + strings.Join(whereConditions, " AND ")
+	postgresQuery += " WHERE e.STATE <> 'DELETED' AND " + strings.Join(whereConditions, " AND ")
+	sqliteQuery += " WHERE e.STATE <> 'DELETED' AND " + strings.Join(whereConditions, " AND ")
 
 	nonIndexedKeys := make([]string, 0, len(nonIndexedFilters))
 	for key := range nonIndexedFilters {
@@ -610,7 +660,7 @@ func buildGetEntitiesByIDsQuery(entityIDs []string, deploymentID string) (model.
 	return buildEntityINClauseQuery(
 		"ASQ-ENTITY_MGT-29",
 		`SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES `+
-			`FROM "ENTITY" WHERE ID IN (%s) AND DEPLOYMENT_ID = %s`,
+			`FROM "ENTITY" WHERE ID IN (%s) AND DEPLOYMENT_ID = %s AND STATE <> 'DELETED'`,
 		entityIDs, deploymentID,
 	)
 }
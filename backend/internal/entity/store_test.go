@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -369,6 +370,105 @@ func (s *DBStoreTestSuite) TestDeleteEntity_Success() {
 	s.NoError(err)
 }
 
+func (s *DBStoreTestSuite) TestSoftDeleteEntity_NotFound() {
+	s.expectClient()
+	s.onExecAny(0, nil)
+	err := s.store.SoftDeleteEntity(s.ctx, "e1")
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *DBStoreTestSuite) TestSoftDeleteEntity_Success() {
+	s.expectClient()
+	s.onExecAny(1, nil)
+	err := s.store.SoftDeleteEntity(s.ctx, "e1")
+	s.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "ExecuteContext", 2)
+}
+
+func (s *DBStoreTestSuite) TestSoftDeleteEntity_DeleteIdentifiersError() {
+	s.expectClient()
+	s.onExecAny(1, nil).Once()
+	s.onExecAny(0, s.testErr).Once()
+	err := s.store.SoftDeleteEntity(s.ctx, "e1")
+	s.Error(err)
+}
+
+func (s *DBStoreTestSuite) TestRestoreEntity_NotFound() {
+	s.expectClient()
+	s.onExecAny(0, nil)
+	err := s.store.RestoreEntity(s.ctx, "e1")
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *DBStoreTestSuite) TestSoftDeleteAndRestoreEntity_PreserveState() {
+	s.Contains(QuerySoftDeleteEntity.Query, "PREVIOUS_STATE = STATE")
+	s.Contains(QueryRestoreEntity.Query, "STATE = COALESCE(PREVIOUS_STATE, 'ACTIVE')")
+	s.Contains(QueryRestoreEntity.Query, "PREVIOUS_STATE = NULL")
+
+	s.expectClient()
+	s.client.On("ExecuteContext", mock.Anything, QueryRestoreEntity,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil).Once()
+	err := s.store.RestoreEntity(s.ctx, "e1")
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *DBStoreTestSuite) TestUpdateEntityState_NotFound() {
+	s.expectClient()
+	s.onExecAny(0, nil)
+	err := s.store.UpdateEntityState(s.ctx, "e1", providers.EntityStateLocked)
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *DBStoreTestSuite) TestUpdateEntityState_Success() {
+	s.expectClient()
+	s.onExecAny(1, nil)
+	err := s.store.UpdateEntityState(s.ctx, "e1", providers.EntityStateDisabled)
+	s.NoError(err)
+}
+
+func (s *DBStoreTestSuite) TestGetDeletedEntity_NotFound() {
+	s.expectClient()
+	s.onQueryAny([]map[string]interface{}{}, nil)
+	_, err := s.store.GetDeletedEntity(s.ctx, "e1")
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *DBStoreTestSuite) TestGetDeletedEntity_Success() {
+	row := dbEntityRow()
+	row["state"] = "DELETED"
+	row["deleted_at"] = "2026-01-02T03:04:05Z"
+	s.expectClient()
+	s.onQueryAny([]map[string]interface{}{row}, nil)
+	got, err := s.store.GetDeletedEntity(s.ctx, "e1")
+	s.NoError(err)
+	s.Equal("e1", got.ID)
+	s.Require().NotNil(got.DeletedAt)
+	s.Equal(2026, got.DeletedAt.Year())
+}
+
+func (s *DBStoreTestSuite) TestGetDeletedEntityListCount_Success() {
+	s.expectClient()
+	s.onQueryAny([]map[string]interface{}{{"total": int64(2)}}, nil)
+	count, err := s.store.GetDeletedEntityListCount(s.ctx, "user")
+	s.NoError(err)
+	s.Equal(2, count)
+}
+
+func (s *DBStoreTestSuite) TestGetDeletedEntityList_MissingDeletedAt() {
+	s.expectClient()
+	s.onQueryAny([]map[string]interface{}{dbEntityRow()}, nil)
+	_, err := s.store.GetDeletedEntityList(s.ctx, "user", 10, 0)
+	s.Error(err)
+}
+
+func (s *DBStoreTestSuite) TestGetExpiredDeletedEntityIDs_Success() {
+	s.expectClient()
+	s.onQueryAny([]map[string]interface{}{{"id": "e1"}, {"id": "e2"}}, nil)
+	ids, err := s.store.GetExpiredDeletedEntityIDs(s.ctx, "user", time.Now(), 10)
+	s.NoError(err)
+	s.Equal([]string{"e1", "e2"}, ids)
+}
+
 func (s *DBStoreTestSuite) TestIdentifyEntity_ProviderError() {
 	s.expectClientError()
 	_, err := s.store.IdentifyEntity(s.ctx, map[string]interface{}{"email": "a@b.com"})
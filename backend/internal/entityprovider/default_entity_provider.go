@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -254,6 +255,76 @@ func (p *defaultEntityProvider) GetEntityList(
 	return result, nil
 }
 
+// SoftDeleteEntity marks an entity as deleted while retaining it for restore or purge.
+func (p *defaultEntityProvider) SoftDeleteEntity(entityID string) *EntityProviderError {
+	ctx := security.WithRuntimeContext(context.Background())
+	if err := p.entitySvc.SoftDeleteEntity(ctx, entityID); err != nil {
+		return mapEntityError(err)
+	}
+	return nil
+}
+
+// RestoreEntity returns a soft-deleted entity to the state it had before it was deleted.
+func (p *defaultEntityProvider) RestoreEntity(entityID string) *EntityProviderError {
+	ctx := security.WithRuntimeContext(context.Background())
+	if err := p.entitySvc.RestoreEntity(ctx, entityID); err != nil {
+		return mapEntityError(err)
+	}
+	return nil
+}
+
+// GetDeletedEntity retrieves a soft-deleted entity by ID.
+func (p *defaultEntityProvider) GetDeletedEntity(
+	entityID string,
+) (*providers.Entity, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	result, err := p.entitySvc.GetDeletedEntity(ctx, entityID)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+	return toProviderEntity(result), nil
+}
+
+// GetDeletedEntityListCount returns the total number of soft-deleted entities in the given category.
+func (p *defaultEntityProvider) GetDeletedEntityListCount(
+	category providers.EntityCategory,
+) (int, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	count, err := p.entitySvc.GetDeletedEntityListCount(ctx, category)
+	if err != nil {
+		return 0, mapEntityError(err)
+	}
+	return count, nil
+}
+
+// GetDeletedEntityList returns a page of soft-deleted entities in the given category.
+func (p *defaultEntityProvider) GetDeletedEntityList(
+	category providers.EntityCategory, limit, offset int,
+) ([]providers.Entity, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	entities, err := p.entitySvc.GetDeletedEntityList(ctx, category, limit, offset)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+	result := make([]providers.Entity, len(entities))
+	for i := range entities {
+		result[i] = *toProviderEntity(&entities[i])
+	}
+	return result, nil
+}
+
+// GetExpiredDeletedEntityIDs returns IDs of entities soft-deleted before deletedBefore.
+func (p *defaultEntityProvider) GetExpiredDeletedEntityIDs(
+	category providers.EntityCategory, deletedBefore time.Time, limit int,
+) ([]string, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	ids, err := p.entitySvc.GetExpiredDeletedEntityIDs(ctx, category, deletedBefore, limit)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+	return ids, nil
+}
+
 // toProviderEntity converts an entity service Entity to a provider Entity.
 func toProviderEntity(e *providers.Entity) *providers.Entity {
 	if e == nil {
@@ -268,6 +339,7 @@ func toProviderEntity(e *providers.Entity) *providers.Entity {
 		OUHandle:         e.OUHandle,
 		Attributes:       e.Attributes,
 		SystemAttributes: e.SystemAttributes,
+		DeletedAt:        e.DeletedAt,
 	}
 }
 
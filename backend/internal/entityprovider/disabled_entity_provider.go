@@ -20,6 +20,7 @@ package entityprovider
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -68,6 +69,32 @@ func (p *disabledEntityProvider) DeleteEntity(_ string) *EntityProviderError {
 	return errNotImplemented
 }
 
+func (p *disabledEntityProvider) SoftDeleteEntity(_ string) *EntityProviderError {
+	return errNotImplemented
+}
+
+func (p *disabledEntityProvider) RestoreEntity(_ string) *EntityProviderError {
+	return errNotImplemented
+}
+
+func (p *disabledEntityProvider) GetDeletedEntity(_ string) (*providers.Entity, *EntityProviderError) {
+	return nil, errNotImplemented
+}
+
+func (p *disabledEntityProvider) GetDeletedEntityListCount(_ providers.EntityCategory) (int, *EntityProviderError) {
+	return 0, errNotImplemented
+}
+
+func (p *disabledEntityProvider) GetDeletedEntityList(
+	_ providers.EntityCategory, _, _ int) ([]providers.Entity, *EntityProviderError) {
+	return nil, errNotImplemented
+}
+
+func (p *disabledEntityProvider) GetExpiredDeletedEntityIDs(
+	_ providers.EntityCategory, _ time.Time, _ int) ([]string, *EntityProviderError) {
+	return nil, errNotImplemented
+}
+
 func (p *disabledEntityProvider) UpdateCredentials(_ string,
 	_ json.RawMessage) *EntityProviderError {
 	return errNotImplemented
@@ -0,0 +1,431 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entityprovider
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// federatedMember is a named member of the federated entity provider.
+type federatedMember struct {
+	name     string
+	provider EntityProviderInterface
+}
+
+// federatedEntityProvider presents several entity providers as a single store. Lookups resolve
+// against the members in order, operations on an existing entity go to the member that owns it,
+// and new entities are routed by organization unit.
+type federatedEntityProvider struct {
+	members []federatedMember
+	// ouRoutes maps organization unit IDs to the member that creates their entities. Entities of
+	// other organization units are created in the first member.
+	ouRoutes map[string]EntityProviderInterface
+}
+
+// newFederatedEntityProvider creates a new federated entity provider.
+func newFederatedEntityProvider(members []federatedMember,
+	ouRoutes map[string]EntityProviderInterface) EntityProviderInterface {
+	return &federatedEntityProvider{
+		members:  members,
+		ouRoutes: ouRoutes,
+	}
+}
+
+// isSkippable reports whether the next member should be tried after a member returned err.
+func isSkippable(err *EntityProviderError) bool {
+	return err.Code == ErrorCodeEntityNotFound || err.Code == ErrorCodeNotImplemented
+}
+
+func errFederatedEntityNotFound() *EntityProviderError {
+	return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found",
+		"The entity was not found in any entity provider")
+}
+
+// IdentifyEntity resolves an entity ID from the first member that identifies the entity.
+func (p *federatedEntityProvider) IdentifyEntity(
+	filters map[string]interface{},
+) (*string, *EntityProviderError) {
+	for _, member := range p.members {
+		entityID, err := member.provider.IdentifyEntity(filters)
+		if err == nil {
+			return entityID, nil
+		}
+		if !isSkippable(err) {
+			return nil, err
+		}
+	}
+	return nil, errFederatedEntityNotFound()
+}
+
+// SearchEntities returns the entities matching the filters in all members.
+func (p *federatedEntityProvider) SearchEntities(
+	filters map[string]interface{},
+) ([]*providers.Entity, *EntityProviderError) {
+	result := make([]*providers.Entity, 0)
+	for _, member := range p.members {
+		entities, err := member.provider.SearchEntities(filters)
+		if err != nil {
+			if isSkippable(err) {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, entities...)
+	}
+	return result, nil
+}
+
+// GetEntity retrieves an entity from the member that owns it.
+func (p *federatedEntityProvider) GetEntity(
+	entityID string,
+) (*providers.Entity, *EntityProviderError) {
+	_, entity, err := p.owner(entityID)
+	if err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// CreateEntity creates an entity in the member routed for its organization unit.
+func (p *federatedEntityProvider) CreateEntity(
+	e *providers.Entity, systemCredentials json.RawMessage,
+) (*providers.Entity, *EntityProviderError) {
+	if e == nil {
+		return nil, NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request",
+			"Entity cannot be nil")
+	}
+	return p.route(e.OUID).CreateEntity(e, systemCredentials)
+}
+
+// UpdateEntity updates an entity in the member that owns it.
+func (p *federatedEntityProvider) UpdateEntity(
+	entityID string, e *providers.Entity,
+) (*providers.Entity, *EntityProviderError) {
+	owner, _, err := p.owner(entityID)
+	if err != nil {
+		return nil, err
+	}
+	return owner.UpdateEntity(entityID, e)
+}
+
+// DeleteEntity deletes an entity from the member that owns it.
+func (p *federatedEntityProvider) DeleteEntity(entityID string) *EntityProviderError {
+	owner, _, err := p.owner(entityID)
+	if err != nil {
+		if err.Code == ErrorCodeEntityNotFound {
+			return nil
+		}
+		return err
+	}
+	return owner.DeleteEntity(entityID)
+}
+
+// SoftDeleteEntity soft-deletes an entity in the member that owns it.
+func (p *federatedEntityProvider) SoftDeleteEntity(entityID string) *EntityProviderError {
+	owner, _, err := p.owner(entityID)
+	if err != nil {
+		return err
+	}
+	return owner.SoftDeleteEntity(entityID)
+}
+
+// RestoreEntity restores a soft-deleted entity in the member that holds it.
+func (p *federatedEntityProvider) RestoreEntity(entityID string) *EntityProviderError {
+	owner, _, err := p.deletedOwner(entityID)
+	if err != nil {
+		return err
+	}
+	return owner.RestoreEntity(entityID)
+}
+
+// GetDeletedEntity retrieves a soft-deleted entity from the member that holds it.
+func (p *federatedEntityProvider) GetDeletedEntity(
+	entityID string,
+) (*providers.Entity, *EntityProviderError) {
+	_, entity, err := p.deletedOwner(entityID)
+	if err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// GetDeletedEntityListCount returns the number of soft-deleted entities across all members.
+func (p *federatedEntityProvider) GetDeletedEntityListCount(
+	category providers.EntityCategory,
+) (int, *EntityProviderError) {
+	return p.count(func(provider EntityProviderInterface) (int, *EntityProviderError) {
+		return provider.GetDeletedEntityListCount(category)
+	})
+}
+
+// GetDeletedEntityList returns a page of soft-deleted entities across all members, in member order.
+func (p *federatedEntityProvider) GetDeletedEntityList(
+	category providers.EntityCategory, limit, offset int,
+) ([]providers.Entity, *EntityProviderError) {
+	return p.page(limit, offset,
+		func(provider EntityProviderInterface) (int, *EntityProviderError) {
+			return provider.GetDeletedEntityListCount(category)
+		},
+		func(provider EntityProviderInterface, limit, offset int) ([]providers.Entity, *EntityProviderError) {
+			return provider.GetDeletedEntityList(category, limit, offset)
+		})
+}
+
+// GetExpiredDeletedEntityIDs returns up to limit expired soft-deleted entity IDs across all members.
+func (p *federatedEntityProvider) GetExpiredDeletedEntityIDs(
+	category providers.EntityCategory, deletedBefore time.Time, limit int,
+) ([]string, *EntityProviderError) {
+	result := make([]string, 0)
+	for _, member := range p.members {
+		if len(result) >= limit {
+			break
+		}
+		ids, err := member.provider.GetExpiredDeletedEntityIDs(category, deletedBefore, limit-len(result))
+		if err != nil {
+			if isSkippable(err) {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, ids...)
+	}
+	return result, nil
+}
+
+// UpdateCredentials updates the credentials of an entity in the member that owns it.
+func (p *federatedEntityProvider) UpdateCredentials(
+	entityID string, credentials json.RawMessage,
+) *EntityProviderError {
+	owner, _, err := p.owner(entityID)
+	if err != nil {
+		return err
+	}
+	return owner.UpdateCredentials(entityID, credentials)
+}
+
+// UpdateAttributes updates the attributes of an entity in the member that owns it.
+func (p *federatedEntityProvider) UpdateAttributes(
+	entityID string, attributes json.RawMessage,
+) *EntityProviderError {
+	owner, _, err := p.owner(entityID)
+	if err != nil {
+		return err
+	}
+	return owner.UpdateAttributes(entityID, attributes)
+}
+
+// UpdateSystemAttributes updates the system attributes of an entity in the member that owns it.
+func (p *federatedEntityProvider) UpdateSystemAttributes(
+	entityID string, attributes json.RawMessage,
+) *EntityProviderError {
+	owner, _, err := p.owner(entityID)
+	if err != nil {
+		return err
+	}
+	return owner.UpdateSystemAttributes(entityID, attributes)
+}
+
+// UpdateSystemCredentials updates the system credentials of an entity in the member that owns it.
+func (p *federatedEntityProvider) UpdateSystemCredentials(
+	entityID string, credentials json.RawMessage,
+) *EntityProviderError {
+	owner, _, err := p.owner(entityID)
+	if err != nil {
+		return err
+	}
+	return owner.UpdateSystemCredentials(entityID, credentials)
+}
+
+// GetTransitiveEntityGroups retrieves the groups of an entity from the member that owns it.
+func (p *federatedEntityProvider) GetTransitiveEntityGroups(
+	entityID string,
+) ([]providers.EntityGroup, *EntityProviderError) {
+	owner, _, err := p.owner(entityID)
+	if err != nil {
+		return nil, err
+	}
+	return owner.GetTransitiveEntityGroups(entityID)
+}
+
+// ValidateEntityIDs returns the IDs that no member knows.
+func (p *federatedEntityProvider) ValidateEntityIDs(
+	entityIDs []string,
+) ([]string, *EntityProviderError) {
+	invalidIDs := entityIDs
+	for _, member := range p.members {
+		if len(invalidIDs) == 0 {
+			break
+		}
+		remaining, err := member.provider.ValidateEntityIDs(invalidIDs)
+		if err != nil {
+			if isSkippable(err) {
+				continue
+			}
+			return nil, err
+		}
+		invalidIDs = remaining
+	}
+	return invalidIDs, nil
+}
+
+// GetEntitiesByIDs retrieves the entities with the given IDs from all members.
+func (p *federatedEntityProvider) GetEntitiesByIDs(
+	entityIDs []string,
+) ([]providers.Entity, *EntityProviderError) {
+	result := make([]providers.Entity, 0, len(entityIDs))
+	found := make(map[string]bool, len(entityIDs))
+	for _, member := range p.members {
+		remaining := make([]string, 0, len(entityIDs))
+		for _, id := range entityIDs {
+			if !found[id] {
+				remaining = append(remaining, id)
+			}
+		}
+		if len(remaining) == 0 {
+			break
+		}
+		entities, err := member.provider.GetEntitiesByIDs(remaining)
+		if err != nil {
+			if isSkippable(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, entity := range entities {
+			found[entity.ID] = true
+		}
+		result = append(result, entities...)
+	}
+	return result, nil
+}
+
+// GetEntityListCount returns the number of entities across all members.
+func (p *federatedEntityProvider) GetEntityListCount(
+	category providers.EntityCategory, filters map[string]interface{},
+) (int, *EntityProviderError) {
+	return p.count(func(provider EntityProviderInterface) (int, *EntityProviderError) {
+		return provider.GetEntityListCount(category, filters)
+	})
+}
+
+// GetEntityList returns a page of entities across all members, in member order.
+func (p *federatedEntityProvider) GetEntityList(
+	category providers.EntityCategory, limit, offset int, filters map[string]interface{},
+) ([]providers.Entity, *EntityProviderError) {
+	return p.page(limit, offset,
+		func(provider EntityProviderInterface) (int, *EntityProviderError) {
+			return provider.GetEntityListCount(category, filters)
+		},
+		func(provider EntityProviderInterface, limit, offset int) ([]providers.Entity, *EntityProviderError) {
+			return provider.GetEntityList(category, limit, offset, filters)
+		})
+}
+
+// route returns the member that creates entities of the organization unit.
+func (p *federatedEntityProvider) route(ouID string) EntityProviderInterface {
+	if provider, ok := p.ouRoutes[ouID]; ok {
+		return provider
+	}
+	return p.members[0].provider
+}
+
+// owner returns the first member that holds the entity, with the entity.
+func (p *federatedEntityProvider) owner(
+	entityID string,
+) (EntityProviderInterface, *providers.Entity, *EntityProviderError) {
+	for _, member := range p.members {
+		entity, err := member.provider.GetEntity(entityID)
+		if err == nil {
+			return member.provider, entity, nil
+		}
+		if !isSkippable(err) {
+			return nil, nil, err
+		}
+	}
+	return nil, nil, errFederatedEntityNotFound()
+}
+
+// deletedOwner returns the first member that holds the soft-deleted entity, with the entity.
+func (p *federatedEntityProvider) deletedOwner(
+	entityID string,
+) (EntityProviderInterface, *providers.Entity, *EntityProviderError) {
+	for _, member := range p.members {
+		entity, err := member.provider.GetDeletedEntity(entityID)
+		if err == nil {
+			return member.provider, entity, nil
+		}
+		if !isSkippable(err) {
+			return nil, nil, err
+		}
+	}
+	return nil, nil, errFederatedEntityNotFound()
+}
+
+// count sums a count across the members that support it.
+func (p *federatedEntityProvider) count(
+	countFn func(EntityProviderInterface) (int, *EntityProviderError),
+) (int, *EntityProviderError) {
+	total := 0
+	for _, member := range p.members {
+		count, err := countFn(member.provider)
+		if err != nil {
+			if isSkippable(err) {
+				continue
+			}
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// page returns a page of the concatenation of the member lists. The offset is moved past the
+// members whose entities all precede the page.
+func (p *federatedEntityProvider) page(limit, offset int,
+	countFn func(EntityProviderInterface) (int, *EntityProviderError),
+	listFn func(EntityProviderInterface, int, int) ([]providers.Entity, *EntityProviderError),
+) ([]providers.Entity, *EntityProviderError) {
+	result := make([]providers.Entity, 0)
+	for _, member := range p.members {
+		if len(result) >= limit {
+			break
+		}
+		count, err := countFn(member.provider)
+		if err != nil {
+			if isSkippable(err) {
+				continue
+			}
+			return nil, err
+		}
+		if offset >= count {
+			offset -= count
+			continue
+		}
+		entities, err := listFn(member.provider, limit-len(result), offset)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entities...)
+		offset = 0
+	}
+	return result, nil
+}
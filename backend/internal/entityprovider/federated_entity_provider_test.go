@@ -0,0 +1,300 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entityprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// memoryEntityProvider is an in-memory entity provider used as a federation member in tests.
+type memoryEntityProvider struct {
+	disabledEntityProvider
+	entities map[string]*providers.Entity
+	nextID   int
+	prefix   string
+}
+
+func newMemoryEntityProvider(prefix string, entities ...*providers.Entity) *memoryEntityProvider {
+	p := &memoryEntityProvider{entities: make(map[string]*providers.Entity), prefix: prefix}
+	for _, e := range entities {
+		p.entities[e.ID] = e
+	}
+	return p
+}
+
+func (p *memoryEntityProvider) matches(e *providers.Entity, filters map[string]interface{}) bool {
+	var attrs map[string]interface{}
+	_ = json.Unmarshal(e.Attributes, &attrs)
+	for key, value := range filters {
+		if attrs[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *memoryEntityProvider) sortedIDs() []string {
+	ids := make([]string, 0, len(p.entities))
+	for id := range p.entities {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (p *memoryEntityProvider) IdentifyEntity(filters map[string]interface{}) (*string, *EntityProviderError) {
+	var found []string
+	for _, id := range p.sortedIDs() {
+		if p.matches(p.entities[id], filters) {
+			found = append(found, id)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found", "")
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, NewEntityProviderError(ErrorCodeAmbiguousEntity, "Ambiguous entity", "")
+	}
+}
+
+func (p *memoryEntityProvider) SearchEntities(filters map[string]interface{}) ([]*providers.Entity,
+	*EntityProviderError) {
+	result := make([]*providers.Entity, 0)
+	for _, id := range p.sortedIDs() {
+		if p.matches(p.entities[id], filters) {
+			result = append(result, p.entities[id])
+		}
+	}
+	return result, nil
+}
+
+func (p *memoryEntityProvider) GetEntity(entityID string) (*providers.Entity, *EntityProviderError) {
+	if e, ok := p.entities[entityID]; ok {
+		return e, nil
+	}
+	return nil, NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found", "")
+}
+
+func (p *memoryEntityProvider) CreateEntity(e *providers.Entity,
+	_ json.RawMessage) (*providers.Entity, *EntityProviderError) {
+	p.nextID++
+	created := *e
+	created.ID = fmt.Sprintf("%s-%d", p.prefix, p.nextID)
+	p.entities[created.ID] = &created
+	return &created, nil
+}
+
+func (p *memoryEntityProvider) DeleteEntity(entityID string) *EntityProviderError {
+	delete(p.entities, entityID)
+	return nil
+}
+
+func (p *memoryEntityProvider) UpdateAttributes(entityID string, attributes json.RawMessage) *EntityProviderError {
+	e, ok := p.entities[entityID]
+	if !ok {
+		return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found", "")
+	}
+	e.Attributes = attributes
+	return nil
+}
+
+func (p *memoryEntityProvider) ValidateEntityIDs(entityIDs []string) ([]string, *EntityProviderError) {
+	invalid := make([]string, 0)
+	for _, id := range entityIDs {
+		if _, ok := p.entities[id]; !ok {
+			invalid = append(invalid, id)
+		}
+	}
+	return invalid, nil
+}
+
+func (p *memoryEntityProvider) GetEntitiesByIDs(entityIDs []string) ([]providers.Entity, *EntityProviderError) {
+	result := make([]providers.Entity, 0)
+	for _, id := range entityIDs {
+		if e, ok := p.entities[id]; ok {
+			result = append(result, *e)
+		}
+	}
+	return result, nil
+}
+
+func (p *memoryEntityProvider) GetEntityListCount(_ providers.EntityCategory,
+	_ map[string]interface{}) (int, *EntityProviderError) {
+	return len(p.entities), nil
+}
+
+func (p *memoryEntityProvider) GetEntityList(_ providers.EntityCategory, limit, offset int,
+	_ map[string]interface{}) ([]providers.Entity, *EntityProviderError) {
+	ids := p.sortedIDs()
+	result := make([]providers.Entity, 0)
+	for i := offset; i < len(ids) && len(result) < limit; i++ {
+		result = append(result, *p.entities[ids[i]])
+	}
+	return result, nil
+}
+
+func userEntity(id, username string) *providers.Entity {
+	return &providers.Entity{
+		ID:         id,
+		Category:   providers.EntityCategoryUser,
+		Attributes: json.RawMessage(fmt.Sprintf(`{"username":%q}`, username)),
+	}
+}
+
+type FederatedEntityProviderTestSuite struct {
+	suite.Suite
+	local    *memoryEntityProvider
+	remote   *memoryEntityProvider
+	provider EntityProviderInterface
+}
+
+func TestFederatedEntityProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(FederatedEntityProviderTestSuite))
+}
+
+func (suite *FederatedEntityProviderTestSuite) SetupTest() {
+	suite.local = newMemoryEntityProvider("local", userEntity("local-a", "alice"), userEntity("local-b", "bob"))
+	suite.remote = newMemoryEntityProvider("remote", userEntity("remote-c", "carol"),
+		userEntity("remote-d", "dave"), userEntity("remote-e", "erin"))
+	suite.provider = newFederatedEntityProvider(
+		[]federatedMember{
+			{name: "local", provider: suite.local},
+			{name: "disabled", provider: newDisabledEntityProvider()},
+			{name: "remote", provider: suite.remote},
+		},
+		map[string]EntityProviderInterface{"partner-ou": suite.remote},
+	)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestIdentifyEntity_ResolvesInMemberOrder() {
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "carol"})
+
+	suite.Nil(err)
+	suite.Equal("remote-c", *id)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestIdentifyEntity_FirstMemberWins() {
+	suite.remote.entities["remote-x"] = userEntity("remote-x", "alice")
+
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "alice"})
+
+	suite.Nil(err)
+	suite.Equal("local-a", *id)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestIdentifyEntity_AmbiguousStopsResolution() {
+	suite.local.entities["local-x"] = userEntity("local-x", "carol")
+	suite.local.entities["local-y"] = userEntity("local-y", "carol")
+
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "carol"})
+
+	suite.Nil(id)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeAmbiguousEntity, err.Code)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestIdentifyEntity_NotFound() {
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "nobody"})
+
+	suite.Nil(id)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestSearchEntities_AggregatesMembers() {
+	suite.remote.entities["remote-x"] = userEntity("remote-x", "alice")
+
+	entities, err := suite.provider.SearchEntities(map[string]interface{}{"username": "alice"})
+
+	suite.Nil(err)
+	suite.Require().Len(entities, 2)
+	suite.Equal("local-a", entities[0].ID)
+	suite.Equal("remote-x", entities[1].ID)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestCreateEntity_RoutesByOU() {
+	created, err := suite.provider.CreateEntity(&providers.Entity{OUID: "partner-ou"}, nil)
+	suite.Nil(err)
+	suite.Equal("remote-1", created.ID)
+
+	created, err = suite.provider.CreateEntity(&providers.Entity{OUID: "other-ou"}, nil)
+	suite.Nil(err)
+	suite.Equal("local-1", created.ID)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestUpdateAttributes_GoesToOwner() {
+	err := suite.provider.UpdateAttributes("remote-d", json.RawMessage(`{"username":"david"}`))
+
+	suite.Nil(err)
+	suite.JSONEq(`{"username":"david"}`, string(suite.remote.entities["remote-d"].Attributes))
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestUpdateAttributes_UnknownEntity() {
+	err := suite.provider.UpdateAttributes("missing", json.RawMessage(`{}`))
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestDeleteEntity_UnknownEntityIsNoop() {
+	suite.Nil(suite.provider.DeleteEntity("missing"))
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestValidateEntityIDs_AcrossMembers() {
+	invalid, err := suite.provider.ValidateEntityIDs([]string{"local-a", "remote-e", "missing"})
+
+	suite.Nil(err)
+	suite.Equal([]string{"missing"}, invalid)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestGetEntitiesByIDs_AcrossMembers() {
+	entities, err := suite.provider.GetEntitiesByIDs([]string{"remote-c", "local-b", "missing"})
+
+	suite.Nil(err)
+	suite.Require().Len(entities, 2)
+	suite.Equal("local-b", entities[0].ID)
+	suite.Equal("remote-c", entities[1].ID)
+}
+
+func (suite *FederatedEntityProviderTestSuite) TestGetEntityList_PagesAcrossMembers() {
+	count, err := suite.provider.GetEntityListCount(providers.EntityCategoryUser, nil)
+	suite.Nil(err)
+	suite.Equal(5, count)
+
+	page, err := suite.provider.GetEntityList(providers.EntityCategoryUser, 2, 1, nil)
+	suite.Nil(err)
+	suite.Require().Len(page, 2)
+	suite.Equal("local-b", page[0].ID)
+	suite.Equal("remote-c", page[1].ID)
+
+	page, err = suite.provider.GetEntityList(providers.EntityCategoryUser, 10, 3, nil)
+	suite.Nil(err)
+	suite.Require().Len(page, 2)
+	suite.Equal("remote-d", page[0].ID)
+	suite.Equal("remote-e", page[1].ID)
+}
@@ -19,8 +19,16 @@
 package entityprovider
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	systemhttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/ldap"
+	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
 // InitializeEntityProvider initializes the entity provider.
@@ -31,6 +39,8 @@ func InitializeEntityProvider(
 	switch entityProviderConfig.Type {
 	case "disabled":
 		return initializeDisabledEntityProvider()
+	case "federated":
+		return initializeFederatedEntityProvider(entitySvc)
 	default:
 		return initializeDefaultEntityProvider(entitySvc)
 	}
@@ -47,3 +57,121 @@ func initializeDefaultEntityProvider(
 func initializeDisabledEntityProvider() EntityProviderInterface {
 	return newDisabledEntityProvider()
 }
+
+// initializeFederatedEntityProvider initializes the federated entity provider.
+func initializeFederatedEntityProvider(
+	entitySvc entity.EntityServiceInterface,
+) EntityProviderInterface {
+	federationConfig := config.GetServerRuntime().Config.EntityProvider.Federation
+	provider, err := buildFederatedEntityProvider(federationConfig, entitySvc)
+	if err != nil {
+		// Provider initialization runs during application startup, outside any request.
+		log.GetLogger().Fatal(context.Background(), "Invalid federated entity provider configuration",
+			log.Error(err))
+	}
+	return provider
+}
+
+// buildFederatedEntityProvider builds the federated entity provider from its member and routing
+// configuration.
+func buildFederatedEntityProvider(federationConfig config.EntityFederationConfig,
+	entitySvc entity.EntityServiceInterface) (EntityProviderInterface, error) {
+	if len(federationConfig.Providers) == 0 {
+		return nil, errors.New("at least one member provider is required")
+	}
+
+	members := make([]federatedMember, 0, len(federationConfig.Providers))
+	byName := make(map[string]federatedMember, len(federationConfig.Providers))
+	for _, memberConfig := range federationConfig.Providers {
+		if memberConfig.Name == "" {
+			return nil, errors.New("member provider name is required")
+		}
+		if _, exists := byName[memberConfig.Name]; exists {
+			return nil, fmt.Errorf("duplicate member provider name %q", memberConfig.Name)
+		}
+		provider, err := buildFederatedMember(memberConfig, entitySvc)
+		if err != nil {
+			return nil, fmt.Errorf("member provider %q: %w", memberConfig.Name, err)
+		}
+		member := federatedMember{name: memberConfig.Name, provider: provider}
+		members = append(members, member)
+		byName[memberConfig.Name] = member
+	}
+
+	ouRoutes := make(map[string]EntityProviderInterface, len(federationConfig.OURouting))
+	for ouID, name := range federationConfig.OURouting {
+		member, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("organization unit %q is routed to unknown member provider %q", ouID, name)
+		}
+		if _, readOnly := member.provider.(*ldapEntityProvider); readOnly {
+			return nil, fmt.Errorf("organization unit %q is routed to read-only member provider %q", ouID, name)
+		}
+		ouRoutes[ouID] = member.provider
+	}
+
+	return newFederatedEntityProvider(members, ouRoutes), nil
+}
+
+// buildFederatedMember builds a member provider of the federated entity provider.
+func buildFederatedMember(memberConfig config.FederatedEntityProviderConfig,
+	entitySvc entity.EntityServiceInterface) (EntityProviderInterface, error) {
+	switch memberConfig.Type {
+	case "", "default":
+		return newDefaultEntityProvider(entitySvc), nil
+	case "rest":
+		if memberConfig.Rest.BaseURL == "" {
+			return nil, errors.New("rest base URL is required")
+		}
+		timeout := time.Duration(memberConfig.Rest.Timeout) * time.Second
+		if timeout == 0 {
+			timeout = 10 * time.Second
+		}
+		return newRestEntityProvider(memberConfig.Rest.BaseURL, memberConfig.Rest.Security.APIKey,
+			systemhttp.NewHTTPClientWithTimeout(timeout)), nil
+	case "ldap":
+		return buildLDAPMember(memberConfig)
+	default:
+		return nil, fmt.Errorf("unsupported member provider type %q", memberConfig.Type)
+	}
+}
+
+// buildLDAPMember builds a read-only directory member of the federated entity provider.
+func buildLDAPMember(memberConfig config.FederatedEntityProviderConfig) (EntityProviderInterface, error) {
+	ldapConfig := memberConfig.LDAP
+	if ldapConfig.URL == "" || ldapConfig.BaseDN == "" {
+		return nil, errors.New("ldap URL and base DN are required")
+	}
+	if memberConfig.IDAttribute == "" || memberConfig.UserType == "" || memberConfig.OUID == "" {
+		return nil, errors.New("ldap members require an ID attribute, a user type and an organization unit ID")
+	}
+
+	settings := ldapEntitySettings{
+		bindDN:           ldapConfig.BindDN,
+		bindPassword:     ldapConfig.BindPassword,
+		baseDN:           ldapConfig.BaseDN,
+		userObjectClass:  ldapConfig.UserObjectClass,
+		idAttribute:      memberConfig.IDAttribute,
+		attributeMapping: make(map[string]string, len(ldapConfig.AttributeMapping)+1),
+		userType:         memberConfig.UserType,
+		ouID:             memberConfig.OUID,
+	}
+	if settings.userObjectClass == "" {
+		settings.userObjectClass = "person"
+	}
+	for localAttr, directoryAttr := range ldapConfig.AttributeMapping {
+		settings.attributeMapping[localAttr] = directoryAttr
+	}
+	if ldapConfig.LoginIdentifier != "" && ldapConfig.LoginAttribute != "" {
+		settings.attributeMapping[ldapConfig.LoginIdentifier] = ldapConfig.LoginAttribute
+	}
+
+	timeout := time.Duration(ldapConfig.Timeout) * time.Second
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	dial := func(ctx context.Context) (ldap.ConnInterface, error) {
+		return ldap.Dial(ctx, ldapConfig.URL, timeout, nil)
+	}
+	return newLDAPEntityProvider(settings, dial), nil
+}
@@ -105,3 +105,81 @@ func (suite *InitEntityProviderTestSuite) TestInitializeEntityProvider_WithUnkno
 	_, ok := provider.(*defaultEntityProvider)
 	suite.True(ok, "Expected provider to be of type *defaultEntityProvider for unknown type")
 }
+
+func (suite *InitEntityProviderTestSuite) TestInitializeEntityProvider_WithFederatedType() {
+	config.GetServerRuntime().Config.EntityProvider = config.EntityProviderConfig{
+		Type: "federated",
+		Federation: config.EntityFederationConfig{
+			Providers: []config.FederatedEntityProviderConfig{
+				{Name: "local", Type: "default"},
+				{Name: "partners", Type: "rest", Rest: config.RestConfig{BaseURL: "https://users.example.com"}},
+			},
+			OURouting: map[string]string{"partner-ou": "partners"},
+		},
+	}
+
+	provider := InitializeEntityProvider(suite.mockEntityService)
+
+	federated, ok := provider.(*federatedEntityProvider)
+	suite.Require().True(ok, "Expected provider to be of type *federatedEntityProvider")
+	suite.Len(federated.members, 2)
+	_, ok = federated.ouRoutes["partner-ou"].(*restEntityProvider)
+	suite.True(ok, "Expected partner-ou to be routed to the REST member")
+}
+
+func (suite *InitEntityProviderTestSuite) TestBuildFederatedEntityProvider_InvalidConfig() {
+	ldapMember := config.FederatedEntityProviderConfig{
+		Name: "directory", Type: "ldap", IDAttribute: "entryUUID", UserType: "employee", OUID: "ou-1",
+		LDAP: config.LDAPConfig{URL: "ldap://ldap.example.com", BaseDN: "dc=example,dc=com"},
+	}
+	testCases := []struct {
+		name   string
+		config config.EntityFederationConfig
+	}{
+		{"NoMembers", config.EntityFederationConfig{}},
+		{"MissingName", config.EntityFederationConfig{
+			Providers: []config.FederatedEntityProviderConfig{{Type: "default"}}}},
+		{"DuplicateName", config.EntityFederationConfig{
+			Providers: []config.FederatedEntityProviderConfig{{Name: "a"}, {Name: "a"}}}},
+		{"UnknownType", config.EntityFederationConfig{
+			Providers: []config.FederatedEntityProviderConfig{{Name: "a", Type: "soap"}}}},
+		{"RestWithoutBaseURL", config.EntityFederationConfig{
+			Providers: []config.FederatedEntityProviderConfig{{Name: "a", Type: "rest"}}}},
+		{"LDAPWithoutIDAttribute", config.EntityFederationConfig{
+			Providers: []config.FederatedEntityProviderConfig{{Name: "a", Type: "ldap",
+				LDAP: ldapMember.LDAP}}}},
+		{"RouteToUnknownMember", config.EntityFederationConfig{
+			Providers: []config.FederatedEntityProviderConfig{{Name: "local"}},
+			OURouting: map[string]string{"ou-1": "missing"}}},
+		{"RouteToReadOnlyMember", config.EntityFederationConfig{
+			Providers: []config.FederatedEntityProviderConfig{{Name: "local"}, ldapMember},
+			OURouting: map[string]string{"ou-1": "directory"}}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			provider, err := buildFederatedEntityProvider(tc.config, suite.mockEntityService)
+			suite.Nil(provider)
+			suite.Error(err)
+		})
+	}
+}
+
+func (suite *InitEntityProviderTestSuite) TestBuildFederatedEntityProvider_LDAPMemberMapsLoginAttribute() {
+	provider, err := buildFederatedEntityProvider(config.EntityFederationConfig{
+		Providers: []config.FederatedEntityProviderConfig{{
+			Name: "directory", Type: "ldap", IDAttribute: "entryUUID", UserType: "employee", OUID: "ou-1",
+			LDAP: config.LDAPConfig{
+				URL: "ldap://ldap.example.com", BaseDN: "dc=example,dc=com",
+				LoginAttribute: "sAMAccountName", LoginIdentifier: "username",
+				AttributeMapping: map[string]string{"email": "mail"},
+			},
+		}},
+	}, suite.mockEntityService)
+
+	suite.Require().NoError(err)
+	member := provider.(*federatedEntityProvider).members[0].provider.(*ldapEntityProvider)
+	suite.Equal(map[string]string{"email": "mail", "username": "sAMAccountName"},
+		member.settings.attributeMapping)
+	suite.Equal("person", member.settings.userObjectClass)
+}
@@ -20,6 +20,7 @@ package entityprovider
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -46,6 +47,27 @@ type EntityProviderInterface interface {
 	// DeleteEntity deletes an entity by ID. Cascades to identifiers.
 	DeleteEntity(entityID string) *EntityProviderError
 
+	// SoftDeleteEntity marks an entity as deleted while retaining it for restore or purge.
+	SoftDeleteEntity(entityID string) *EntityProviderError
+
+	// RestoreEntity returns a soft-deleted entity to the state it had before it was deleted.
+	RestoreEntity(entityID string) *EntityProviderError
+
+	// GetDeletedEntity retrieves a soft-deleted entity by ID, including its deletion time.
+	GetDeletedEntity(entityID string) (*providers.Entity, *EntityProviderError)
+
+	// GetDeletedEntityListCount returns the total number of soft-deleted entities in the given category.
+	GetDeletedEntityListCount(category providers.EntityCategory) (int, *EntityProviderError)
+
+	// GetDeletedEntityList returns a page of soft-deleted entities in the given category.
+	GetDeletedEntityList(category providers.EntityCategory, limit, offset int) ([]providers.Entity,
+		*EntityProviderError)
+
+	// GetExpiredDeletedEntityIDs returns up to limit IDs of entities in the given category that were
+	// soft-deleted before deletedBefore.
+	GetExpiredDeletedEntityIDs(category providers.EntityCategory, deletedBefore time.Time,
+		limit int) ([]string, *EntityProviderError)
+
 	// UpdateCredentials updates schema-defined credentials for an entity.
 	UpdateCredentials(entityID string,
 		credentials json.RawMessage) *EntityProviderError
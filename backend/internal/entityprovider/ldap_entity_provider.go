@@ -0,0 +1,307 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entityprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	"github.com/thunder-id/thunderid/internal/system/ldap"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// ldapEntitySettings holds the directory settings of the LDAP entity provider.
+type ldapEntitySettings struct {
+	bindDN          string
+	bindPassword    string
+	baseDN          string
+	userObjectClass string
+	// idAttribute is the directory attribute whose value is used as the entity ID.
+	idAttribute string
+	// attributeMapping maps local user attribute names to directory attribute names.
+	attributeMapping map[string]string
+	userType         string
+	ouID             string
+}
+
+// ldapEntityProvider is a read-only entity provider that looks users up in an LDAP or Active
+// Directory server. Users are created and changed in the directory itself, so write operations
+// are not implemented.
+type ldapEntityProvider struct {
+	disabledEntityProvider
+	settings ldapEntitySettings
+	dial     func(ctx context.Context) (ldap.ConnInterface, error)
+	logger   *log.Logger
+}
+
+// newLDAPEntityProvider creates a new LDAP entity provider.
+func newLDAPEntityProvider(settings ldapEntitySettings,
+	dial func(ctx context.Context) (ldap.ConnInterface, error)) EntityProviderInterface {
+	return &ldapEntityProvider{
+		settings: settings,
+		dial:     dial,
+		logger:   log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LDAPEntityProvider")),
+	}
+}
+
+// IdentifyEntity resolves the ID of the single directory user matching the filters.
+func (p *ldapEntityProvider) IdentifyEntity(
+	filters map[string]interface{},
+) (*string, *EntityProviderError) {
+	filter, ok := p.buildFilter(filters)
+	if !ok {
+		return nil, errLDAPEntityNotFound()
+	}
+	entries, err := p.search(filter, 2)
+	if err != nil {
+		return nil, err
+	}
+	switch len(entries) {
+	case 0:
+		return nil, errLDAPEntityNotFound()
+	case 1:
+		entity, err := p.toEntity(entries[0])
+		if err != nil {
+			return nil, err
+		}
+		return &entity.ID, nil
+	default:
+		return nil, NewEntityProviderError(ErrorCodeAmbiguousEntity, "Ambiguous entity",
+			"Multiple directory users match the provided filters")
+	}
+}
+
+// SearchEntities returns the directory users matching the filters.
+func (p *ldapEntityProvider) SearchEntities(
+	filters map[string]interface{},
+) ([]*providers.Entity, *EntityProviderError) {
+	filter, ok := p.buildFilter(filters)
+	if !ok {
+		return []*providers.Entity{}, nil
+	}
+	entries, err := p.search(filter, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*providers.Entity, 0, len(entries))
+	for i := range entries {
+		entity, err := p.toEntity(entries[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entity)
+	}
+	return result, nil
+}
+
+// GetEntity retrieves a directory user by ID.
+func (p *ldapEntityProvider) GetEntity(
+	entityID string,
+) (*providers.Entity, *EntityProviderError) {
+	entries, err := p.search(p.userFilter(ldap.Equal(p.settings.idAttribute, entityID)), 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errLDAPEntityNotFound()
+	}
+	return p.toEntity(entries[0])
+}
+
+// GetTransitiveEntityGroups returns no groups, since groups of directory users are not managed locally.
+func (p *ldapEntityProvider) GetTransitiveEntityGroups(
+	_ string,
+) ([]providers.EntityGroup, *EntityProviderError) {
+	return []providers.EntityGroup{}, nil
+}
+
+// ValidateEntityIDs returns the IDs that do not belong to a directory user.
+func (p *ldapEntityProvider) ValidateEntityIDs(
+	entityIDs []string,
+) ([]string, *EntityProviderError) {
+	entities, err := p.GetEntitiesByIDs(entityIDs)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool, len(entities))
+	for _, entity := range entities {
+		found[entity.ID] = true
+	}
+	invalidIDs := make([]string, 0)
+	for _, entityID := range entityIDs {
+		if !found[entityID] {
+			invalidIDs = append(invalidIDs, entityID)
+		}
+	}
+	return invalidIDs, nil
+}
+
+// GetEntitiesByIDs retrieves the directory users with the given IDs.
+func (p *ldapEntityProvider) GetEntitiesByIDs(
+	entityIDs []string,
+) ([]providers.Entity, *EntityProviderError) {
+	if len(entityIDs) == 0 {
+		return []providers.Entity{}, nil
+	}
+	idFilters := make([]string, 0, len(entityIDs))
+	for _, entityID := range entityIDs {
+		idFilters = append(idFilters, ldap.Equal(p.settings.idAttribute, entityID))
+	}
+	entries, err := p.search(p.userFilter(ldap.Or(idFilters...)), 0)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]providers.Entity, 0, len(entries))
+	for i := range entries {
+		entity, err := p.toEntity(entries[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *entity)
+	}
+	return result, nil
+}
+
+// buildFilter converts local attribute filters into a directory filter. It returns false when a
+// filter uses an attribute that is not mapped to the directory, since no directory user can match it.
+func (p *ldapEntityProvider) buildFilter(filters map[string]interface{}) (string, bool) {
+	if len(filters) == 0 {
+		return "", false
+	}
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	conditions := make([]string, 0, len(filters))
+	for _, key := range keys {
+		directoryAttr, ok := p.settings.attributeMapping[key]
+		if !ok {
+			return "", false
+		}
+		conditions = append(conditions, ldap.Equal(directoryAttr, fmt.Sprint(filters[key])))
+	}
+	return p.userFilter(conditions...), true
+}
+
+// userFilter restricts the conditions to user entries.
+func (p *ldapEntityProvider) userFilter(conditions ...string) string {
+	return ldap.And(append([]string{ldap.Equal("objectClass", p.settings.userObjectClass)},
+		conditions...)...)
+}
+
+// search runs a directory search with the service account. A missing base DN yields no entries.
+func (p *ldapEntityProvider) search(filter string, sizeLimit int) ([]*goldap.Entry, *EntityProviderError) {
+	// Entity provider calls are not bound to a request context.
+	ctx := context.Background()
+	conn, err := p.dial(ctx)
+	if err != nil {
+		return nil, p.logAndReturnSystemError(ctx, "Failed to connect to the directory", log.Error(err))
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if p.settings.bindDN != "" {
+		if err := conn.Bind(p.settings.bindDN, p.settings.bindPassword); err != nil {
+			return nil, p.logAndReturnSystemError(ctx, "Failed to bind to the directory with the service account",
+				log.Error(err))
+		}
+	}
+
+	result, err := conn.Search(&goldap.SearchRequest{
+		BaseDN:     p.settings.baseDN,
+		Scope:      goldap.ScopeWholeSubtree,
+		Filter:     filter,
+		Attributes: p.directoryAttributes(),
+		SizeLimit:  sizeLimit,
+	})
+	switch {
+	case err == nil, goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded):
+		return result.Entries, nil
+	case goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject):
+		return nil, nil
+	default:
+		return nil, p.logAndReturnSystemError(ctx, "Failed to search the directory", log.Error(err))
+	}
+}
+
+// directoryAttributes returns the directory attributes to read, in a stable order.
+func (p *ldapEntityProvider) directoryAttributes() []string {
+	attrs := make([]string, 0, len(p.settings.attributeMapping)+1)
+	attrs = append(attrs, p.settings.idAttribute)
+	for _, directoryAttr := range p.settings.attributeMapping {
+		attrs = append(attrs, directoryAttr)
+	}
+	sort.Strings(attrs)
+	return attrs
+}
+
+// toEntity converts a directory entry into a user entity with the mapped attributes.
+func (p *ldapEntityProvider) toEntity(entry *goldap.Entry) (*providers.Entity, *EntityProviderError) {
+	ctx := context.Background()
+	entityID := entry.GetEqualFoldAttributeValue(p.settings.idAttribute)
+	if entityID == "" {
+		return nil, p.logAndReturnSystemError(ctx, "Directory user has no ID attribute",
+			log.String("idAttribute", p.settings.idAttribute))
+	}
+
+	attributes := make(map[string]interface{}, len(p.settings.attributeMapping))
+	for localAttr, directoryAttr := range p.settings.attributeMapping {
+		values := entry.GetEqualFoldAttributeValues(directoryAttr)
+		switch len(values) {
+		case 0:
+			continue
+		case 1:
+			attributes[localAttr] = values[0]
+		default:
+			attributes[localAttr] = values
+		}
+	}
+	attrsJSON, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, p.logAndReturnSystemError(ctx, "Failed to marshal directory attributes", log.Error(err))
+	}
+
+	return &providers.Entity{
+		ID:         entityID,
+		Category:   providers.EntityCategoryUser,
+		Type:       p.settings.userType,
+		State:      providers.EntityStateActive,
+		OUID:       p.settings.ouID,
+		Attributes: attrsJSON,
+	}, nil
+}
+
+func errLDAPEntityNotFound() *EntityProviderError {
+	return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found",
+		"The entity was not found in the directory")
+}
+
+func (p *ldapEntityProvider) logAndReturnSystemError(
+	ctx context.Context, msg string, fields ...log.Field) *EntityProviderError {
+	p.logger.Error(ctx, msg, fields...)
+	return NewEntityProviderError(ErrorCodeSystemError, "System error", msg)
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entityprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/ldap"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/ldapmock"
+)
+
+type LDAPEntityProviderTestSuite struct {
+	suite.Suite
+	mockConn *ldapmock.ConnInterfaceMock
+	dialErr  error
+	provider EntityProviderInterface
+}
+
+func TestLDAPEntityProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(LDAPEntityProviderTestSuite))
+}
+
+func (suite *LDAPEntityProviderTestSuite) SetupTest() {
+	suite.mockConn = ldapmock.NewConnInterfaceMock(suite.T())
+	suite.dialErr = nil
+	settings := ldapEntitySettings{
+		bindDN:           "cn=service,dc=example,dc=com",
+		bindPassword:     "service-secret",
+		baseDN:           "dc=example,dc=com",
+		userObjectClass:  "person",
+		idAttribute:      "entryUUID",
+		attributeMapping: map[string]string{"username": "uid", "email": "mail"},
+		userType:         "employee",
+		ouID:             "ou-1",
+	}
+	suite.provider = newLDAPEntityProvider(settings, func(ctx context.Context) (ldap.ConnInterface, error) {
+		if suite.dialErr != nil {
+			return nil, suite.dialErr
+		}
+		return suite.mockConn, nil
+	})
+}
+
+func (suite *LDAPEntityProviderTestSuite) expectSearch(filter string, sizeLimit int,
+	entries []*goldap.Entry, err error) {
+	suite.mockConn.On("Bind", "cn=service,dc=example,dc=com", "service-secret").Return(nil).Once()
+	suite.mockConn.On("Search", &goldap.SearchRequest{
+		BaseDN:     "dc=example,dc=com",
+		Scope:      goldap.ScopeWholeSubtree,
+		Filter:     filter,
+		Attributes: []string{"entryUUID", "mail", "uid"},
+		SizeLimit:  sizeLimit,
+	}).Return(&goldap.SearchResult{Entries: entries}, err).Once()
+	suite.mockConn.On("Close").Return(nil).Once()
+}
+
+func aliceEntry() *goldap.Entry {
+	return goldap.NewEntry("uid=alice,dc=example,dc=com", map[string][]string{
+		"entryUUID": {"uuid-alice"},
+		"uid":       {"alice"},
+		"mail":      {"alice@example.com"},
+	})
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_Success() {
+	suite.expectSearch("(&(objectClass=person)(uid=alice))", 2, []*goldap.Entry{aliceEntry()}, nil)
+
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "alice"})
+
+	suite.Nil(err)
+	suite.Equal("uuid-alice", *id)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_EscapesFilterValues() {
+	suite.expectSearch(`(&(objectClass=person)(uid=\2a\29\28uid=\2a))`, 2, nil, nil)
+
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "*)(uid=*"})
+
+	suite.Nil(id)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_UnmappedAttributeIsNotFound() {
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"mobileNumber": "+15550100"})
+
+	suite.Nil(id)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestIdentifyEntity_Ambiguous() {
+	suite.expectSearch("(&(objectClass=person)(mail=a@example.com))", 2, []*goldap.Entry{aliceEntry(), aliceEntry()},
+		goldap.NewError(goldap.LDAPResultSizeLimitExceeded, errors.New("size limit exceeded")))
+
+	_, err := suite.provider.IdentifyEntity(map[string]interface{}{"email": "a@example.com"})
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeAmbiguousEntity, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntity_MapsAttributes() {
+	suite.expectSearch("(&(objectClass=person)(entryUUID=uuid-alice))", 1, []*goldap.Entry{aliceEntry()}, nil)
+
+	entity, err := suite.provider.GetEntity("uuid-alice")
+
+	suite.Require().Nil(err)
+	suite.Equal("uuid-alice", entity.ID)
+	suite.Equal(providers.EntityCategoryUser, entity.Category)
+	suite.Equal("employee", entity.Type)
+	suite.Equal("ou-1", entity.OUID)
+	suite.JSONEq(`{"username":"alice","email":"alice@example.com"}`, string(entity.Attributes))
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntity_NotFound() {
+	suite.expectSearch("(&(objectClass=person)(entryUUID=missing))", 1, nil, nil)
+
+	_, err := suite.provider.GetEntity("missing")
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestGetEntity_MissingBaseDN() {
+	suite.expectSearch("(&(objectClass=person)(entryUUID=uuid-alice))", 1, nil,
+		goldap.NewError(goldap.LDAPResultNoSuchObject, errors.New("no such object")))
+
+	_, err := suite.provider.GetEntity("uuid-alice")
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestValidateEntityIDs() {
+	suite.expectSearch("(&(objectClass=person)(|(entryUUID=uuid-alice)(entryUUID=missing)))", 0,
+		[]*goldap.Entry{aliceEntry()}, nil)
+
+	invalid, err := suite.provider.ValidateEntityIDs([]string{"uuid-alice", "missing"})
+
+	suite.Nil(err)
+	suite.Equal([]string{"missing"}, invalid)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestDialFailure() {
+	suite.dialErr = errors.New("connection refused")
+
+	_, err := suite.provider.GetEntity("uuid-alice")
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeSystemError, err.Code)
+}
+
+func (suite *LDAPEntityProviderTestSuite) TestCreateEntityIsNotImplemented() {
+	_, err := suite.provider.CreateEntity(&providers.Entity{}, nil)
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeNotImplemented, err.Code)
+}
@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entityprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	systemhttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// restEntityProvider is an entity provider backed by an external user store exposed over REST.
+// Operations that the REST contract does not cover are not implemented.
+type restEntityProvider struct {
+	disabledEntityProvider
+	baseURL    string
+	apiKey     string
+	httpClient systemhttp.HTTPClientInterface
+	logger     *log.Logger
+}
+
+// restFilterRequest is the request body of the identify and search endpoints.
+type restFilterRequest struct {
+	Filters map[string]interface{} `json:"filters"`
+}
+
+// restIdentifyResponse is the response body of the identify endpoint.
+type restIdentifyResponse struct {
+	ID string `json:"id"`
+}
+
+// restSearchResponse is the response body of the search endpoint.
+type restSearchResponse struct {
+	Entities []*providers.Entity `json:"entities"`
+}
+
+// restCreateRequest is the request body of the create endpoint.
+type restCreateRequest struct {
+	Entity            *providers.Entity `json:"entity"`
+	SystemCredentials json.RawMessage   `json:"systemCredentials,omitempty"`
+}
+
+// newRestEntityProvider creates a new REST entity provider.
+func newRestEntityProvider(baseURL, apiKey string,
+	httpClient systemhttp.HTTPClientInterface) EntityProviderInterface {
+	return &restEntityProvider{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: httpClient,
+		logger:     log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RestEntityProvider")),
+	}
+}
+
+// IdentifyEntity resolves an entity ID from attribute filters.
+func (p *restEntityProvider) IdentifyEntity(
+	filters map[string]interface{},
+) (*string, *EntityProviderError) {
+	var resp restIdentifyResponse
+	if err := p.call(http.MethodPost, "/entities/identify", restFilterRequest{Filters: filters},
+		&resp); err != nil {
+		return nil, err
+	}
+	return &resp.ID, nil
+}
+
+// SearchEntities searches for all entities matching the given filters.
+func (p *restEntityProvider) SearchEntities(
+	filters map[string]interface{},
+) ([]*providers.Entity, *EntityProviderError) {
+	var resp restSearchResponse
+	if err := p.call(http.MethodPost, "/entities/search", restFilterRequest{Filters: filters},
+		&resp); err != nil {
+		return nil, err
+	}
+	return resp.Entities, nil
+}
+
+// GetEntity retrieves an entity by ID.
+func (p *restEntityProvider) GetEntity(
+	entityID string,
+) (*providers.Entity, *EntityProviderError) {
+	var entity providers.Entity
+	if err := p.call(http.MethodGet, "/entities/"+url.PathEscape(entityID), nil, &entity); err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// CreateEntity creates a new entity.
+func (p *restEntityProvider) CreateEntity(
+	e *providers.Entity, systemCredentials json.RawMessage,
+) (*providers.Entity, *EntityProviderError) {
+	if e == nil {
+		return nil, NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request",
+			"Entity cannot be nil")
+	}
+	var entity providers.Entity
+	if err := p.call(http.MethodPost, "/entities",
+		restCreateRequest{Entity: e, SystemCredentials: systemCredentials}, &entity); err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// UpdateEntity updates an existing entity.
+func (p *restEntityProvider) UpdateEntity(
+	entityID string, e *providers.Entity,
+) (*providers.Entity, *EntityProviderError) {
+	if e == nil {
+		return nil, NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request",
+			"Entity cannot be nil")
+	}
+	var entity providers.Entity
+	if err := p.call(http.MethodPut, "/entities/"+url.PathEscape(entityID), e, &entity); err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// DeleteEntity deletes an entity by ID.
+func (p *restEntityProvider) DeleteEntity(entityID string) *EntityProviderError {
+	err := p.call(http.MethodDelete, "/entities/"+url.PathEscape(entityID), nil, nil)
+	if err != nil && err.Code != ErrorCodeEntityNotFound {
+		return err
+	}
+	return nil
+}
+
+// GetTransitiveEntityGroups returns no groups, since groups of external users are not managed locally.
+func (p *restEntityProvider) GetTransitiveEntityGroups(
+	_ string,
+) ([]providers.EntityGroup, *EntityProviderError) {
+	return []providers.EntityGroup{}, nil
+}
+
+// ValidateEntityIDs returns the IDs that the external store does not know.
+func (p *restEntityProvider) ValidateEntityIDs(
+	entityIDs []string,
+) ([]string, *EntityProviderError) {
+	invalidIDs := make([]string, 0)
+	for _, entityID := range entityIDs {
+		if _, err := p.GetEntity(entityID); err != nil {
+			if err.Code != ErrorCodeEntityNotFound {
+				return nil, err
+			}
+			invalidIDs = append(invalidIDs, entityID)
+		}
+	}
+	return invalidIDs, nil
+}
+
+// GetEntitiesByIDs retrieves the entities with the given IDs that the external store knows.
+func (p *restEntityProvider) GetEntitiesByIDs(
+	entityIDs []string,
+) ([]providers.Entity, *EntityProviderError) {
+	result := make([]providers.Entity, 0, len(entityIDs))
+	for _, entityID := range entityIDs {
+		entity, err := p.GetEntity(entityID)
+		if err != nil {
+			if err.Code == ErrorCodeEntityNotFound {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, *entity)
+	}
+	return result, nil
+}
+
+// call sends a request to the external store and decodes a successful response into out.
+func (p *restEntityProvider) call(method, path string, reqBody, out interface{}) *EntityProviderError {
+	// Entity provider calls are not bound to a request context.
+	ctx := context.Background()
+
+	var body io.Reader
+	if reqBody != nil {
+		jsonBody, err := json.Marshal(reqBody)
+		if err != nil {
+			return p.logAndReturnSystemError(ctx, "Failed to marshal request", log.Error(err))
+		}
+		body = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.baseURL+path, body)
+	if err != nil {
+		return p.logAndReturnSystemError(ctx, "Failed to create request", log.Error(err))
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if p.apiKey != "" {
+		req.Header.Set("API-KEY", p.apiKey)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return p.logAndReturnSystemError(ctx, "Failed to send request", log.Error(err))
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		if out == nil || resp.StatusCode == http.StatusNoContent {
+			return nil
+		}
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return p.logAndReturnSystemError(ctx, "Failed to decode response", log.Error(err))
+		}
+		return nil
+	}
+	return p.decodeError(ctx, resp)
+}
+
+// decodeError converts an error response into an EntityProviderError. Entity provider error codes
+// returned by the store are kept; other errors are mapped from the status code.
+func (p *restEntityProvider) decodeError(ctx context.Context, resp *http.Response) *EntityProviderError {
+	var apiErr EntityProviderError
+	_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+	if strings.HasPrefix(string(apiErr.Code), "EP-") && apiErr.Code != ErrorCodeSystemError {
+		return NewEntityProviderError(apiErr.Code, apiErr.Message, apiErr.Description)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found",
+			"The entity was not found in the external user store")
+	case http.StatusConflict:
+		return NewEntityProviderError(ErrorCodeAttributeConflict, "Attribute conflict", apiErr.Description)
+	case http.StatusBadRequest:
+		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request", apiErr.Description)
+	default:
+		return p.logAndReturnSystemError(ctx, "External user store returned an error",
+			log.Int("status", resp.StatusCode), log.String("message", apiErr.Message))
+	}
+}
+
+func (p *restEntityProvider) logAndReturnSystemError(
+	ctx context.Context, msg string, fields ...log.Field) *EntityProviderError {
+	p.logger.Error(ctx, msg, fields...)
+	return NewEntityProviderError(ErrorCodeSystemError, "System error", msg)
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entityprovider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+type RestEntityProviderTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	handler  http.HandlerFunc
+	provider EntityProviderInterface
+}
+
+func TestRestEntityProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(RestEntityProviderTestSuite))
+}
+
+func (suite *RestEntityProviderTestSuite) SetupTest() {
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.handler(w, r)
+	}))
+	client := httpmock.NewHTTPClientInterfaceMock(suite.T())
+	client.EXPECT().Do(mock.Anything).RunAndReturn(func(req *http.Request) (*http.Response, error) {
+		return http.DefaultClient.Do(req)
+	}).Maybe()
+	suite.provider = newRestEntityProvider(suite.server.URL+"/", "secret-key", client)
+}
+
+func (suite *RestEntityProviderTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (suite *RestEntityProviderTestSuite) TestIdentifyEntity_Success() {
+	suite.handler = func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		suite.Equal("/entities/identify", r.URL.Path)
+		suite.Equal("secret-key", r.Header.Get("API-KEY"))
+		var req restFilterRequest
+		suite.NoError(json.NewDecoder(r.Body).Decode(&req))
+		suite.Equal("alice", req.Filters["username"])
+		writeJSON(w, http.StatusOK, restIdentifyResponse{ID: "ext-1"})
+	}
+
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "alice"})
+
+	suite.Nil(err)
+	suite.Equal("ext-1", *id)
+}
+
+func (suite *RestEntityProviderTestSuite) TestIdentifyEntity_NotFound() {
+	suite.handler = func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}
+
+	id, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "alice"})
+
+	suite.Nil(id)
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *RestEntityProviderTestSuite) TestIdentifyEntity_KeepsProviderErrorCode() {
+	suite.handler = func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusConflict, EntityProviderError{Code: ErrorCodeAmbiguousEntity,
+			Message: "Ambiguous entity", Description: "Multiple users match"})
+	}
+
+	_, err := suite.provider.IdentifyEntity(map[string]interface{}{"username": "alice"})
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeAmbiguousEntity, err.Code)
+	suite.Equal("Multiple users match", err.Description)
+}
+
+func (suite *RestEntityProviderTestSuite) TestGetEntity_EscapesID() {
+	suite.handler = func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodGet, r.Method)
+		suite.Equal("/entities/a%2Fb", r.URL.EscapedPath())
+		writeJSON(w, http.StatusOK, providers.Entity{ID: "a/b", Category: providers.EntityCategoryUser})
+	}
+
+	entity, err := suite.provider.GetEntity("a/b")
+
+	suite.Nil(err)
+	suite.Equal("a/b", entity.ID)
+}
+
+func (suite *RestEntityProviderTestSuite) TestCreateEntity_Success() {
+	suite.handler = func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		suite.Equal("/entities", r.URL.Path)
+		var req restCreateRequest
+		suite.NoError(json.NewDecoder(r.Body).Decode(&req))
+		suite.Equal("partner-ou", req.Entity.OUID)
+		created := *req.Entity
+		created.ID = "ext-2"
+		writeJSON(w, http.StatusCreated, created)
+	}
+
+	entity, err := suite.provider.CreateEntity(&providers.Entity{OUID: "partner-ou"}, nil)
+
+	suite.Nil(err)
+	suite.Equal("ext-2", entity.ID)
+}
+
+func (suite *RestEntityProviderTestSuite) TestDeleteEntity_NotFoundIsNoop() {
+	suite.handler = func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+	}
+
+	suite.Nil(suite.provider.DeleteEntity("ext-1"))
+}
+
+func (suite *RestEntityProviderTestSuite) TestServerError() {
+	suite.handler = func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}
+
+	_, err := suite.provider.GetEntity("ext-1")
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeSystemError, err.Code)
+}
+
+func (suite *RestEntityProviderTestSuite) TestValidateEntityIDs() {
+	suite.handler = func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/entities/ext-1" {
+			writeJSON(w, http.StatusOK, providers.Entity{ID: "ext-1"})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}
+
+	invalid, err := suite.provider.ValidateEntityIDs([]string{"ext-1", "ext-9"})
+
+	suite.Nil(err)
+	suite.Equal([]string{"ext-9"}, invalid)
+}
+
+func (suite *RestEntityProviderTestSuite) TestUnsupportedOperationIsNotImplemented() {
+	err := suite.provider.SoftDeleteEntity("ext-1")
+
+	suite.Require().NotNil(err)
+	suite.Equal(ErrorCodeNotImplemented, err.Code)
+}
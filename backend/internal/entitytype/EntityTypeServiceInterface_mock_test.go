@@ -900,7 +900,7 @@ func (_c *EntityTypeServiceInterfaceMock_ValidateEntity_Call) RunAndReturn(run f
 }
 
 // ValidateEntityUniqueness provides a mock function for the type EntityTypeServiceInterfaceMock
-func (_mock *EntityTypeServiceInterfaceMock) ValidateEntityUniqueness(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}) (bool, error)) (bool, *common.ServiceError) {
+func (_mock *EntityTypeServiceInterfaceMock) ValidateEntityUniqueness(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}, UniqueScope) (bool, error)) (bool, *common.ServiceError) {
 	ret := _mock.Called(ctx, category, entityType, attributes, exists)
 
 	if len(ret) == 0 {
@@ -909,15 +909,15 @@ func (_mock *EntityTypeServiceInterfaceMock) ValidateEntityUniqueness(ctx contex
 
 	var r0 bool
 	var r1 *common.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}) (bool, error)) (bool, *common.ServiceError)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}, UniqueScope) (bool, error)) (bool, *common.ServiceError)); ok {
 		return returnFunc(ctx, category, entityType, attributes, exists)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}) (bool, error)) bool); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}, UniqueScope) (bool, error)) bool); ok {
 		r0 = returnFunc(ctx, category, entityType, attributes, exists)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}) (bool, error)) *common.ServiceError); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}, UniqueScope) (bool, error)) *common.ServiceError); ok {
 		r1 = returnFunc(ctx, category, entityType, attributes, exists)
 	} else {
 		if ret.Get(1) != nil {
@@ -937,12 +937,12 @@ type EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call struct {
 //   - category TypeCategory
 //   - entityType string
 //   - attributes json.RawMessage
-//   - exists func(map[string]interface{}) (bool, error)
+//   - exists func(map[string]interface{}, UniqueScope) (bool, error)
 func (_e *EntityTypeServiceInterfaceMock_Expecter) ValidateEntityUniqueness(ctx interface{}, category interface{}, entityType interface{}, attributes interface{}, exists interface{}) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
 	return &EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call{Call: _e.mock.On("ValidateEntityUniqueness", ctx, category, entityType, attributes, exists)}
 }
 
-func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}) (bool, error))) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
+func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}, UniqueScope) (bool, error))) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -960,9 +960,9 @@ func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) Run(run
 		if args[3] != nil {
 			arg3 = args[3].(json.RawMessage)
 		}
-		var arg4 func(map[string]interface{}) (bool, error)
+		var arg4 func(map[string]interface{}, UniqueScope) (bool, error)
 		if args[4] != nil {
-			arg4 = args[4].(func(map[string]interface{}) (bool, error))
+			arg4 = args[4].(func(map[string]interface{}, UniqueScope) (bool, error))
 		}
 		run(
 			arg0,
@@ -980,7 +980,7 @@ func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) Return(b
 	return _c
 }
 
-func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}) (bool, error)) (bool, *common.ServiceError)) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
+func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}, UniqueScope) (bool, error)) (bool, *common.ServiceError)) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
 	_c.Call.Return(run)
 	return _c
 }
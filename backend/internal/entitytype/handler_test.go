@@ -107,7 +107,7 @@ func (s *InlineStubEntityTypeService) ValidateEntity(
 
 func (s *InlineStubEntityTypeService) ValidateEntityUniqueness(
 	ctx context.Context, cat TypeCategory, name string, schema json.RawMessage,
-	eval func(map[string]interface{}) (bool, error),
+	eval func(map[string]interface{}, UniqueScope) (bool, error),
 ) (bool, *tidcommon.ServiceError) {
 	return true, nil
 }
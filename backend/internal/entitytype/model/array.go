@@ -87,7 +87,7 @@ func (p *array) validateValue(ctx context.Context, value interface{}, path strin
 func (p *array) validateUniqueness(ctx context.Context,
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists func(map[string]interface{}, UniqueScope) (bool, error),
 	logger *log.Logger,
 ) (bool, error) {
 	// Arrays are not supported for uniqueness validation
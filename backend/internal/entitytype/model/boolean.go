@@ -65,7 +65,7 @@ func (p *boolean) validateValue(ctx context.Context, value interface{}, path str
 func (p *boolean) validateUniqueness(ctx context.Context,
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists func(map[string]interface{}, UniqueScope) (bool, error),
 	logger *log.Logger,
 ) (bool, error) {
 	return true, nil
@@ -29,6 +29,7 @@ import (
 type number struct {
 	required    bool
 	unique      bool
+	uniqueScope UniqueScope
 	credential  bool
 	displayName string
 	enum        map[float64]struct{}
@@ -76,14 +77,14 @@ func (p *number) validateValue(ctx context.Context, value interface{}, path stri
 func (p *number) validateUniqueness(ctx context.Context,
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists func(map[string]interface{}, UniqueScope) (bool, error),
 	logger *log.Logger,
 ) (bool, error) {
 	if !p.unique {
 		return true, nil
 	}
 
-	found, err := exists(map[string]interface{}{path: value})
+	found, err := exists(map[string]interface{}{path: value}, p.uniqueScope)
 	if err != nil {
 		return false, err
 	}
@@ -96,6 +97,7 @@ func compileNumberProperty(propMap map[string]json.RawMessage) (property, error)
 		"type":        {},
 		"required":    {},
 		"unique":      {},
+		"uniqueScope": {},
 		"credential":  {},
 		"displayName": {},
 		"enum":        {},
@@ -115,11 +117,12 @@ func compileNumberProperty(propMap map[string]json.RawMessage) (property, error)
 		}
 	}
 
-	if raw, exists := propMap["unique"]; exists {
-		if err := json.Unmarshal(raw, &prop.unique); err != nil {
-			return nil, fmt.Errorf("'unique' field must be a boolean")
-		}
+	unique, scope, err := compileUniqueness(propMap)
+	if err != nil {
+		return nil, err
 	}
+	prop.unique = unique
+	prop.uniqueScope = scope
 
 	if raw, exists := propMap["credential"]; exists {
 		if err := json.Unmarshal(raw, &prop.credential); err != nil {
@@ -99,7 +99,7 @@ func (p *object) validateValue(ctx context.Context, value interface{}, path stri
 func (p *object) validateUniqueness(ctx context.Context,
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists func(map[string]interface{}, UniqueScope) (bool, error),
 	logger *log.Logger,
 ) (bool, error) {
 	valueMap, ok := value.(map[string]interface{})
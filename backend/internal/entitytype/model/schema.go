@@ -41,6 +41,34 @@ const (
 	TypeArray = "array"
 )
 
+// selfServiceField is the top-level property field that defines the self-service access of an attribute.
+const selfServiceField = "selfService"
+
+// SelfServiceAccess defines how an entity can access its own attribute through self-service APIs.
+type SelfServiceAccess string
+
+const (
+	// SelfServiceReadWrite allows the entity to read and update the attribute. This is the default.
+	SelfServiceReadWrite SelfServiceAccess = "readWrite"
+	// SelfServiceReadOnly allows the entity to read but not update the attribute.
+	SelfServiceReadOnly SelfServiceAccess = "readOnly"
+	// SelfServiceNone hides the attribute from the entity.
+	SelfServiceNone SelfServiceAccess = "none"
+)
+
+// uniqueScopeField is the leaf property field that defines the scope of a uniqueness constraint.
+const uniqueScopeField = "uniqueScope"
+
+// UniqueScope defines the boundary within which a unique attribute value must not repeat.
+type UniqueScope string
+
+const (
+	// UniqueScopeDeployment requires the value to be unique across the deployment. This is the default.
+	UniqueScopeDeployment UniqueScope = "deployment"
+	// UniqueScopeOU requires the value to be unique only within the entity's organization unit.
+	UniqueScopeOU UniqueScope = "ou"
+)
+
 type property interface {
 	isRequired() bool
 	isCredential() bool
@@ -49,12 +77,13 @@ type property interface {
 	getDisplayName() string
 	validateValue(ctx context.Context, value interface{}, path string, logger *log.Logger) (bool, error)
 	validateUniqueness(ctx context.Context, value interface{}, path string,
-		exists func(map[string]interface{}) (bool, error), logger *log.Logger) (bool, error)
+		exists func(map[string]interface{}, UniqueScope) (bool, error), logger *log.Logger) (bool, error)
 }
 
 // Schema represents an entity type schema with a set of properties.
 type Schema struct {
-	properties map[string]property
+	properties  map[string]property
+	selfService map[string]SelfServiceAccess
 }
 
 // getPropertyByPath returns the property at the given dot-notation path
@@ -122,6 +151,7 @@ type AttributeInfo struct {
 	DisplayName string
 	Required    bool
 	Credential  bool
+	SelfService SelfServiceAccess
 }
 
 // GetAttributes returns top-level properties filtered by the provided flags.
@@ -145,11 +175,21 @@ func (cs *Schema) GetAttributes(allowCredential, allowNonCredential, requiredOnl
 			DisplayName: prop.getDisplayName(),
 			Required:    prop.isRequired(),
 			Credential:  isCredential,
+			SelfService: cs.GetSelfServiceAccess(attr),
 		})
 	}
 	return result
 }
 
+// GetSelfServiceAccess returns the self-service access of the given top-level attribute. Attributes
+// without an explicit policy are readable and writable by the entity.
+func (cs *Schema) GetSelfServiceAccess(attr string) SelfServiceAccess {
+	if access, ok := cs.selfService[attr]; ok {
+		return access
+	}
+	return SelfServiceReadWrite
+}
+
 // GetUniqueAttributes returns the names of top-level properties marked as unique.
 func (cs *Schema) GetUniqueAttributes() []string {
 	var fields []string
@@ -215,7 +255,7 @@ func (cs *Schema) Validate(
 func (cs *Schema) ValidateUniqueness(
 	ctx context.Context,
 	attrs map[string]interface{},
-	exists func(map[string]interface{}) (bool, error),
+	exists func(map[string]interface{}, UniqueScope) (bool, error),
 	logger *log.Logger,
 ) (bool, error) {
 	if len(cs.properties) == 0 {
@@ -269,11 +309,27 @@ func CompileSchema(schema json.RawMessage) (*Schema, error) {
 	}
 
 	compiled := &Schema{
-		properties: make(map[string]property, len(schemaMap)),
+		properties:  make(map[string]property, len(schemaMap)),
+		selfService: make(map[string]SelfServiceAccess),
 	}
 
 	for propName, propRaw := range schemaMap {
-		compiledProp, err := compileProperty(propName, propRaw)
+		var propMap map[string]json.RawMessage
+		if err := json.Unmarshal(propRaw, &propMap); err != nil {
+			return nil, fmt.Errorf("invalid property '%s': property definition must be an object", propName)
+		}
+
+		// The self-service access applies only to top-level properties.
+		if raw, exists := propMap[selfServiceField]; exists {
+			access, err := parseSelfServiceAccess(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid property '%s': %w", propName, err)
+			}
+			compiled.selfService[propName] = access
+			delete(propMap, selfServiceField)
+		}
+
+		compiledProp, err := compilePropertyMap(propName, propMap)
 		if err != nil {
 			return nil, fmt.Errorf("invalid property '%s': %w", propName, err)
 		}
@@ -283,12 +339,32 @@ func CompileSchema(schema json.RawMessage) (*Schema, error) {
 	return compiled, nil
 }
 
+// parseSelfServiceAccess parses the self-service access of a property.
+func parseSelfServiceAccess(raw json.RawMessage) (SelfServiceAccess, error) {
+	var access SelfServiceAccess
+	if err := json.Unmarshal(raw, &access); err != nil {
+		return "", fmt.Errorf("'%s' field must be a string", selfServiceField)
+	}
+	switch access {
+	case SelfServiceReadWrite, SelfServiceReadOnly, SelfServiceNone:
+		return access, nil
+	default:
+		return "", fmt.Errorf("invalid '%s' value '%s', must be one of: %s, %s, %s", selfServiceField, access,
+			SelfServiceReadWrite, SelfServiceReadOnly, SelfServiceNone)
+	}
+}
+
 func compileProperty(propName string, propRaw json.RawMessage) (property, error) {
 	var propMap map[string]json.RawMessage
 	if err := json.Unmarshal(propRaw, &propMap); err != nil {
 		return nil, fmt.Errorf("property definition must be an object")
 	}
 
+	return compilePropertyMap(propName, propMap)
+}
+
+func compilePropertyMap(propName string, propMap map[string]json.RawMessage) (property, error) {
+
 	typeRaw, exists := propMap["type"]
 	if !exists {
 		return nil, fmt.Errorf("missing required 'type' field")
@@ -314,3 +390,34 @@ func compileProperty(propName string, propRaw json.RawMessage) (property, error)
 		return nil, fmt.Errorf("invalid type '%s', must be one of: string, number, boolean, object, array", typeStr)
 	}
 }
+
+// compileUniqueness parses the "unique" and "uniqueScope" fields of a leaf property.
+// The scope defaults to the deployment and may only be set on unique properties.
+func compileUniqueness(propMap map[string]json.RawMessage) (bool, UniqueScope, error) {
+	var unique bool
+	if raw, exists := propMap["unique"]; exists {
+		if err := json.Unmarshal(raw, &unique); err != nil {
+			return false, "", fmt.Errorf("'unique' field must be a boolean")
+		}
+	}
+
+	raw, exists := propMap[uniqueScopeField]
+	if !exists {
+		return unique, UniqueScopeDeployment, nil
+	}
+	if !unique {
+		return false, "", fmt.Errorf("'%s' field requires 'unique' to be true", uniqueScopeField)
+	}
+
+	var scope UniqueScope
+	if err := json.Unmarshal(raw, &scope); err != nil {
+		return false, "", fmt.Errorf("'%s' field must be a string", uniqueScopeField)
+	}
+	switch scope {
+	case UniqueScopeDeployment, UniqueScopeOU:
+		return true, scope, nil
+	default:
+		return false, "", fmt.Errorf("'%s' field must be one of '%s' or '%s'",
+			uniqueScopeField, UniqueScopeDeployment, UniqueScopeOU)
+	}
+}
@@ -461,3 +461,97 @@ func (s *SchemaValidateTestSuite) TestGetAttributes_AllAttrs_CredentialFieldSet(
 	s.True(attrMap["password"].Credential, "credential attribute must have Credential=true")
 	s.False(attrMap["email"].Credential, "non-credential attribute must have Credential=false")
 }
+
+func (s *SchemaValidateTestSuite) TestCompileSchema_SelfServiceAccess() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "selfService": "readOnly"},
+		"riskScore": {"type": "number", "selfService": "none"},
+		"address": {"type": "object", "selfService": "readWrite", "properties": {"city": {"type": "string"}}},
+		"givenName": {"type": "string"}
+	}`))
+	s.Require().NoError(err)
+
+	s.Equal(SelfServiceReadOnly, schema.GetSelfServiceAccess("email"))
+	s.Equal(SelfServiceNone, schema.GetSelfServiceAccess("riskScore"))
+	s.Equal(SelfServiceReadWrite, schema.GetSelfServiceAccess("address"))
+	s.Equal(SelfServiceReadWrite, schema.GetSelfServiceAccess("givenName"))
+
+	for _, attr := range schema.GetAttributes(false, true, false) {
+		s.Equal(schema.GetSelfServiceAccess(attr.Attribute), attr.SelfService)
+	}
+}
+
+func (s *SchemaValidateTestSuite) TestCompileSchema_InvalidSelfServiceAccess() {
+	testCases := []struct {
+		name   string
+		schema string
+	}{
+		{"UnknownValue", `{"email": {"type": "string", "selfService": "writeOnly"}}`},
+		{"NonStringValue", `{"email": {"type": "string", "selfService": true}}`},
+		{"NestedProperty", `{"address": {"type": "object", "properties": {
+			"city": {"type": "string", "selfService": "readOnly"}}}}`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := CompileSchema(json.RawMessage(tc.schema))
+			s.Error(err)
+		})
+	}
+}
+
+func (s *SchemaValidateTestSuite) TestValidateUniqueness_PassesDeclaredScope() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "unique": true, "uniqueScope": "ou"},
+		"username": {"type": "string", "unique": true},
+		"employeeId": {"type": "number", "unique": true, "uniqueScope": "deployment"}
+	}`))
+	s.Require().NoError(err)
+
+	scopes := map[string]UniqueScope{}
+	ok, err := schema.ValidateUniqueness(context.Background(),
+		map[string]interface{}{"email": "a@example.com", "username": "alice", "employeeId": float64(7)},
+		func(filters map[string]interface{}, scope UniqueScope) (bool, error) {
+			for key := range filters {
+				scopes[key] = scope
+			}
+			return false, nil
+		}, s.logger)
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal(map[string]UniqueScope{
+		"email":      UniqueScopeOU,
+		"username":   UniqueScopeDeployment,
+		"employeeId": UniqueScopeDeployment,
+	}, scopes)
+}
+
+func (s *SchemaValidateTestSuite) TestValidateUniqueness_ConflictRejected() {
+	schema, err := CompileSchema(json.RawMessage(`{"email": {"type": "string", "unique": true}}`))
+	s.Require().NoError(err)
+
+	ok, err := schema.ValidateUniqueness(context.Background(),
+		map[string]interface{}{"email": "a@example.com"},
+		func(map[string]interface{}, UniqueScope) (bool, error) { return true, nil }, s.logger)
+	s.Require().NoError(err)
+	s.False(ok)
+}
+
+func (s *SchemaValidateTestSuite) TestCompileSchema_InvalidUniqueScope() {
+	testCases := []struct {
+		name   string
+		schema string
+	}{
+		{"UnknownValue", `{"email": {"type": "string", "unique": true, "uniqueScope": "tenant"}}`},
+		{"NonStringValue", `{"email": {"type": "string", "unique": true, "uniqueScope": 1}}`},
+		{"WithoutUnique", `{"email": {"type": "string", "uniqueScope": "ou"}}`},
+		{"BooleanProperty", `{"active": {"type": "boolean", "unique": true, "uniqueScope": "ou"}}`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := CompileSchema(json.RawMessage(tc.schema))
+			s.Error(err)
+		})
+	}
+}
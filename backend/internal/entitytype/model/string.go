@@ -30,6 +30,7 @@ import (
 type str struct {
 	required    bool
 	unique      bool
+	uniqueScope UniqueScope
 	credential  bool
 	displayName string
 	enum        map[string]struct{}
@@ -84,14 +85,14 @@ func (p *str) validateValue(ctx context.Context, value interface{}, path string,
 func (p *str) validateUniqueness(ctx context.Context,
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists func(map[string]interface{}, UniqueScope) (bool, error),
 	logger *log.Logger,
 ) (bool, error) {
 	if !p.unique {
 		return true, nil
 	}
 
-	found, err := exists(map[string]interface{}{path: value})
+	found, err := exists(map[string]interface{}{path: value}, p.uniqueScope)
 	if err != nil {
 		return false, err
 	}
@@ -104,6 +105,7 @@ func compileStringProperty(propMap map[string]json.RawMessage) (property, error)
 		"type":        {},
 		"required":    {},
 		"unique":      {},
+		"uniqueScope": {},
 		"credential":  {},
 		"displayName": {},
 		"enum":        {},
@@ -125,11 +127,12 @@ func compileStringProperty(propMap map[string]json.RawMessage) (property, error)
 		}
 	}
 
-	if raw, exists := propMap["unique"]; exists {
-		if err := json.Unmarshal(raw, &prop.unique); err != nil {
-			return nil, fmt.Errorf("'unique' field must be a boolean")
-		}
+	unique, scope, err := compileUniqueness(propMap)
+	if err != nil {
+		return nil, err
 	}
+	prop.unique = unique
+	prop.uniqueScope = scope
 
 	if raw, exists := propMap["credential"]; exists {
 		if err := json.Unmarshal(raw, &prop.credential); err != nil {
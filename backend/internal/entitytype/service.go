@@ -45,6 +45,25 @@ const entityTypeLoggerComponentName = "EntityTypeService"
 // level so callers do not need to import the internal model package directly.
 type AttributeInfo = model.AttributeInfo
 
+// SelfServiceAccess is an alias for model.SelfServiceAccess.
+type SelfServiceAccess = model.SelfServiceAccess
+
+// Self-service access levels of a schema attribute.
+const (
+	SelfServiceReadWrite = model.SelfServiceReadWrite
+	SelfServiceReadOnly  = model.SelfServiceReadOnly
+	SelfServiceNone      = model.SelfServiceNone
+)
+
+// UniqueScope is an alias for model.UniqueScope.
+type UniqueScope = model.UniqueScope
+
+// Scopes within which a unique schema attribute value must not repeat.
+const (
+	UniqueScopeDeployment = model.UniqueScopeDeployment
+	UniqueScopeOU         = model.UniqueScopeOU
+)
+
 // EntityTypeServiceInterface defines the interface for the entity type service.
 // All methods take a TypeCategory to scope the operation to a specific entity kind
 // (user or agent).
@@ -73,7 +92,7 @@ type EntityTypeServiceInterface interface {
 		category TypeCategory,
 		entityType string,
 		attributes json.RawMessage,
-		exists func(map[string]interface{}) (bool, error),
+		exists func(map[string]interface{}, UniqueScope) (bool, error),
 	) (bool, *tidcommon.ServiceError)
 	GetAttributes(
 		ctx context.Context, category TypeCategory, entityType string,
@@ -586,12 +605,14 @@ func (us *entityTypeService) ValidateEntity(
 }
 
 // ValidateEntityUniqueness validates the uniqueness constraints of entity attributes.
+// The exists callback receives the filter for a unique attribute together with the scope
+// declared on it, and reports whether another entity in that scope already holds the value.
 func (us *entityTypeService) ValidateEntityUniqueness(
 	ctx context.Context,
 	category TypeCategory,
 	entityType string,
 	attributes json.RawMessage,
-	exists func(map[string]interface{}) (bool, error),
+	exists func(map[string]interface{}, UniqueScope) (bool, error),
 ) (bool, *tidcommon.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
 
@@ -747,8 +747,9 @@ func TestValidateEntityUniquenessReturnsTrueWhenNoConflicts(t *testing.T) {
 		context.Background(), TypeCategoryUser,
 		"employee",
 		json.RawMessage(`{"email":"unique@example.com"}`),
-		func(filters map[string]interface{}) (bool, error) {
+		func(filters map[string]interface{}, scope UniqueScope) (bool, error) {
 			require.Equal(t, map[string]interface{}{"email": "unique@example.com"}, filters)
+			require.Equal(t, UniqueScopeDeployment, scope)
 			return false, nil
 		},
 	)
@@ -797,7 +798,7 @@ func TestValidateEntityUniquenessReturnsSchemaNotFoundWhenSchemaMissing(t *testi
 		context.Background(), TypeCategoryUser,
 		"employee",
 		json.RawMessage(`{}`),
-		func(map[string]interface{}) (bool, error) { return false, nil },
+		func(map[string]interface{}, UniqueScope) (bool, error) { return false, nil },
 	)
 
 	require.False(t, ok)
@@ -821,7 +822,7 @@ func TestValidateEntityUniquenessReturnsInternalErrorWhenSchemaLoadFails(t *test
 		context.Background(), TypeCategoryUser,
 		"employee",
 		json.RawMessage(`{}`),
-		func(map[string]interface{}) (bool, error) { return false, nil },
+		func(map[string]interface{}, UniqueScope) (bool, error) { return false, nil },
 	)
 
 	require.False(t, ok)
@@ -45,6 +45,12 @@ const (
 	NodeTypePrompt NodeType = "PROMPT"
 	// NodeTypeCall represents a CALL node that invokes another flow
 	NodeTypeCall NodeType = "CALL"
+	// NodeTypeDecision represents a DECISION node that routes to a next node based on the flow context
+	NodeTypeDecision NodeType = "DECISION"
+	// NodeTypeParallel represents a PARALLEL node that runs several branches concurrently
+	NodeTypeParallel NodeType = "PARALLEL"
+	// NodeTypeJoin represents a JOIN node where the branches of a PARALLEL node converge
+	NodeTypeJoin NodeType = "JOIN"
 )
 
 // NodeStatus defines the status of a node in the flow execution.
@@ -62,6 +68,9 @@ const (
 	NodeStatusForward NodeStatus = "FORWARD"
 	// NodeStatusCall signals the engine to push a frame and transfer execution to the referenced flow.
 	NodeStatusCall NodeStatus = "CALL_FLOW"
+	// NodeStatusParallel signals the engine to run the branches in ParallelBranches concurrently and
+	// then continue at the join node in NextNodeID.
+	NodeStatusParallel NodeStatus = "PARALLEL"
 )
 
 // NodeResponseType defines the type of response from a node in the flow execution.
@@ -81,6 +90,10 @@ const (
 	DataIDPName = "idpName"
 	// DataConsentPrompt is the key used for the consent prompt data in the flow response.
 	DataConsentPrompt = "consentPrompt"
+	// DataConsentScopes is the key used for the definitions of the requested scopes shown on the consent prompt.
+	DataConsentScopes = "consentScopes"
+	// DataPolicyPrompt is the key used for the pending policy versions in the flow response.
+	DataPolicyPrompt = "policyPrompt"
 	// DataStepTimeout is the key used for the step expiry timestamp in the flow response.
 	DataStepTimeout = "stepTimeout"
 	// DataInviteLink is the key used for the invite link in the flow response additional data.
@@ -99,6 +112,16 @@ const (
 	DataOpenID4VPRequestURI = "openid4vpRequestUri"
 	// DataOpenID4VPWalletURI is the openid4vp:// authorization URI for the wallet.
 	DataOpenID4VPWalletURI = "openid4vpWalletUri"
+	// DataPushMatchNumber is the number to select on the device to approve a push sign-in request.
+	DataPushMatchNumber = "pushMatchNumber"
+	// DataPushExpiresAt is the RFC 3339 time at which the push sign-in request expires.
+	DataPushExpiresAt = "pushExpiresAt"
+	// DataCrossDeviceApprovalURI is the URI to encode in the QR code of a cross-device sign-in request.
+	DataCrossDeviceApprovalURI = "crossDeviceApprovalUri"
+	// DataCrossDeviceExpiresAt is the RFC 3339 time at which the cross-device sign-in request expires.
+	DataCrossDeviceExpiresAt = "crossDeviceExpiresAt"
+	// DataCrossDeviceClaimed is "true" once the QR code was scanned on a signed-in device.
+	DataCrossDeviceClaimed = "crossDeviceClaimed"
 )
 
 // DefaultHTTPTimeout defines the default timeout duration for HTTP requests.
@@ -133,6 +156,8 @@ const (
 	RuntimeKeyClientID = "clientId"
 	// RuntimeKeyRequestedPermissions holds the space-separated permission scopes requested by the OAuth client.
 	RuntimeKeyRequestedPermissions = "requested_permissions"
+	// RuntimeKeyRequestedScopes holds the space-separated OpenID Connect scopes requested by the OAuth client.
+	RuntimeKeyRequestedScopes = "requested_scopes"
 	// RuntimeKeyConsentedPermissions holds the space-separated permission scopes the user has consented to
 	// release to the client, as produced by the ConsentExecutor.
 	RuntimeKeyConsentedPermissions = "consented_permissions"
@@ -140,8 +165,13 @@ const (
 	RuntimeKeyRequiredEssentialAttributes = "required_essential_attributes"
 	// RuntimeKeyRequiredOptionalAttributes holds the space-separated optional user attributes required for the flow.
 	RuntimeKeyRequiredOptionalAttributes = "required_optional_attributes"
+	// RuntimeKeyEnforceEssentialAttributes indicates that the flow must fail when a required essential
+	// attribute cannot be resolved for the user, instead of omitting it.
+	RuntimeKeyEnforceEssentialAttributes = "enforce_essential_attributes"
 	// RuntimeKeyRequiredLocales holds the space-separated locales requested for claims.
 	RuntimeKeyRequiredLocales = "required_locales"
+	// RuntimeKeyUILocales holds the space-separated end-user preferred locales for the flow UI.
+	RuntimeKeyUILocales = "ui_locales"
 	// RuntimeKeyConsentID holds the consent record ID after consent has been recorded.
 	RuntimeKeyConsentID = "consent_id"
 	// RuntimeKeyStepTimeout holds the expiry timestamp for the current flow step.
@@ -150,6 +180,22 @@ const (
 	RuntimeKeyConsentedAttributes = "consented_attributes"
 	// RuntimeKeyConsentSessionToken holds the signed JWT session token for consent validation.
 	RuntimeKeyConsentSessionToken = "consent_session_token"
+	// RuntimeKeyPromptedPolicies holds the JSON map of policy handles to the versions shown to the user.
+	RuntimeKeyPromptedPolicies = "prompted_policies"
+	// RuntimeKeySharedOUID holds the ID of the shared organization unit the login was routed to.
+	RuntimeKeySharedOUID = "shared_ou_id"
+	// RuntimeKeyHRDRoute holds the home realm discovery route selected for the user: idp, ou or local.
+	RuntimeKeyHRDRoute = "hrdRoute"
+	// RuntimeKeyHRDIdpID holds the identity provider ID selected by home realm discovery.
+	RuntimeKeyHRDIdpID = "hrdIdpId"
+	// RuntimeKeyHRDOUID holds the organization unit ID selected by home realm discovery.
+	RuntimeKeyHRDOUID = "hrdOuId"
+	// RuntimeKeyAnomalyDetected indicates whether the sign-in was assessed as anomalous.
+	RuntimeKeyAnomalyDetected = "anomalyDetected"
+	// RuntimeKeyAnomalyNewDevice indicates whether the sign-in came from a device not seen for the user.
+	RuntimeKeyAnomalyNewDevice = "anomalyNewDevice"
+	// RuntimeKeyAnomalyImpossibleTravel indicates whether the sign-in implies impossible travel.
+	RuntimeKeyAnomalyImpossibleTravel = "anomalyImpossibleTravel"
 	// RuntimeKeyForceConsentReprompt indicates that consent must be re-prompted for all required
 	// claims, set when the authorization request includes prompt=consent.
 	RuntimeKeyForceConsentReprompt = "force_consent_reprompt"
@@ -172,6 +218,9 @@ const (
 	// RuntimeKeyPresentedOptionalInputs holds a space-separated list of optional input identifiers
 	// that have already been prompted to the user, even if the user left them empty.
 	RuntimeKeyPresentedOptionalInputs = "presentedOptionalInputs"
+	// RuntimeKeyParallelBranchStatusPrefix prefixes the keys holding the outcome of each parallel branch,
+	// keyed by the ID of the first node of the branch.
+	RuntimeKeyParallelBranchStatusPrefix = "parallelBranchStatus."
 	// RuntimeKeyOTPSessionToken holds the OTP session JWT produced by OTPExecutor in generate mode
 	// and consumed by OTPExecutor in verify mode.
 	RuntimeKeyOTPSessionToken = "otpSessionToken"
@@ -183,6 +232,10 @@ const (
 	RuntimeKeyOAuthState = "oauthState"
 	// RuntimeKeyOpenID4VPState holds the OpenID4VP request state across poll steps.
 	RuntimeKeyOpenID4VPState = "openid4vpVerificationState"
+	// RuntimeKeyPushChallengeID holds the push approval request ID across poll steps.
+	RuntimeKeyPushChallengeID = "pushChallengeId"
+	// RuntimeKeyCrossDeviceReference holds the cross-device sign-in request reference across poll steps.
+	RuntimeKeyCrossDeviceReference = "crossDeviceReference"
 	// RuntimeKeyRequestedAuthClasses holds the space-separated ACR values from acr_values.
 	RuntimeKeyRequestedAuthClasses = "requested_auth_classes"
 	// RuntimeKeySelectedAuthClass holds the ACR value of the chosen authentication method.
@@ -199,6 +252,9 @@ const (
 	// RuntimeKeyAuthorizationRequestID holds the auth request identifier bound to the current flow
 	// execution (the OAuth authorize authId or the CIBA auth_req_id), if applicable.
 	RuntimeKeyAuthorizationRequestID = "authorizationRequestId"
+	// RuntimeKeyAuthorizationRequestNonce holds the nonce the OAuth authorize request expects in the
+	// flow assertion, binding the assertion to that request.
+	RuntimeKeyAuthorizationRequestNonce = "authorizationRequestNonce"
 )
 
 // MetaComponentType constants define known component types used in flow meta definitions.
@@ -274,4 +330,7 @@ var ValidNodeTypes = map[string]bool{
 	string(NodeTypeTaskExecution): true,
 	string(NodeTypePrompt):        true,
 	string(NodeTypeCall):          true,
+	string(NodeTypeDecision):      true,
+	string(NodeTypeParallel):      true,
+	string(NodeTypeJoin):          true,
 }
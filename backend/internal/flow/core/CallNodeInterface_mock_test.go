@@ -452,6 +452,52 @@ func (_c *CallNodeInterfaceMock_GetOnSuccess_Call) RunAndReturn(run func() strin
 	return _c
 }
 
+// GetOutputs provides a mock function for the type CallNodeInterfaceMock
+func (_mock *CallNodeInterfaceMock) GetOutputs() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutputs")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// CallNodeInterfaceMock_GetOutputs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOutputs'
+type CallNodeInterfaceMock_GetOutputs_Call struct {
+	*mock.Call
+}
+
+// GetOutputs is a helper method to define mock.On call
+func (_e *CallNodeInterfaceMock_Expecter) GetOutputs() *CallNodeInterfaceMock_GetOutputs_Call {
+	return &CallNodeInterfaceMock_GetOutputs_Call{Call: _e.mock.On("GetOutputs")}
+}
+
+func (_c *CallNodeInterfaceMock_GetOutputs_Call) Run(run func()) *CallNodeInterfaceMock_GetOutputs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CallNodeInterfaceMock_GetOutputs_Call) Return(strings []string) *CallNodeInterfaceMock_GetOutputs_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *CallNodeInterfaceMock_GetOutputs_Call) RunAndReturn(run func() []string) *CallNodeInterfaceMock_GetOutputs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPreviousNodeList provides a mock function for the type CallNodeInterfaceMock
 func (_mock *CallNodeInterfaceMock) GetPreviousNodeList() []string {
 	ret := _mock.Called()
@@ -588,6 +634,50 @@ func (_c *CallNodeInterfaceMock_GetReferencedFlow_Call) RunAndReturn(run func()
 	return _c
 }
 
+// GetReferencedFlowHandle provides a mock function for the type CallNodeInterfaceMock
+func (_mock *CallNodeInterfaceMock) GetReferencedFlowHandle() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferencedFlowHandle")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// CallNodeInterfaceMock_GetReferencedFlowHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferencedFlowHandle'
+type CallNodeInterfaceMock_GetReferencedFlowHandle_Call struct {
+	*mock.Call
+}
+
+// GetReferencedFlowHandle is a helper method to define mock.On call
+func (_e *CallNodeInterfaceMock_Expecter) GetReferencedFlowHandle() *CallNodeInterfaceMock_GetReferencedFlowHandle_Call {
+	return &CallNodeInterfaceMock_GetReferencedFlowHandle_Call{Call: _e.mock.On("GetReferencedFlowHandle")}
+}
+
+func (_c *CallNodeInterfaceMock_GetReferencedFlowHandle_Call) Run(run func()) *CallNodeInterfaceMock_GetReferencedFlowHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CallNodeInterfaceMock_GetReferencedFlowHandle_Call) Return(s string) *CallNodeInterfaceMock_GetReferencedFlowHandle_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *CallNodeInterfaceMock_GetReferencedFlowHandle_Call) RunAndReturn(run func() string) *CallNodeInterfaceMock_GetReferencedFlowHandle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetType provides a mock function for the type CallNodeInterfaceMock
 func (_mock *CallNodeInterfaceMock) GetType() common.NodeType {
 	ret := _mock.Called()
@@ -1026,6 +1116,46 @@ func (_c *CallNodeInterfaceMock_SetOnSuccess_Call) RunAndReturn(run func(nodeID
 	return _c
 }
 
+// SetOutputs provides a mock function for the type CallNodeInterfaceMock
+func (_mock *CallNodeInterfaceMock) SetOutputs(outputs []string) {
+	_mock.Called(outputs)
+	return
+}
+
+// CallNodeInterfaceMock_SetOutputs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOutputs'
+type CallNodeInterfaceMock_SetOutputs_Call struct {
+	*mock.Call
+}
+
+// SetOutputs is a helper method to define mock.On call
+//   - outputs []string
+func (_e *CallNodeInterfaceMock_Expecter) SetOutputs(outputs interface{}) *CallNodeInterfaceMock_SetOutputs_Call {
+	return &CallNodeInterfaceMock_SetOutputs_Call{Call: _e.mock.On("SetOutputs", outputs)}
+}
+
+func (_c *CallNodeInterfaceMock_SetOutputs_Call) Run(run func(outputs []string)) *CallNodeInterfaceMock_SetOutputs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *CallNodeInterfaceMock_SetOutputs_Call) Return() *CallNodeInterfaceMock_SetOutputs_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CallNodeInterfaceMock_SetOutputs_Call) RunAndReturn(run func(outputs []string)) *CallNodeInterfaceMock_SetOutputs_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetPreviousNodeList provides a mock function for the type CallNodeInterfaceMock
 func (_mock *CallNodeInterfaceMock) SetPreviousNodeList(previousNodeIDList []string) {
 	_mock.Called(previousNodeIDList)
@@ -1106,6 +1236,46 @@ func (_c *CallNodeInterfaceMock_SetReferencedFlow_Call) RunAndReturn(run func(fl
 	return _c
 }
 
+// SetReferencedFlowHandle provides a mock function for the type CallNodeInterfaceMock
+func (_mock *CallNodeInterfaceMock) SetReferencedFlowHandle(handle string) {
+	_mock.Called(handle)
+	return
+}
+
+// CallNodeInterfaceMock_SetReferencedFlowHandle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReferencedFlowHandle'
+type CallNodeInterfaceMock_SetReferencedFlowHandle_Call struct {
+	*mock.Call
+}
+
+// SetReferencedFlowHandle is a helper method to define mock.On call
+//   - handle string
+func (_e *CallNodeInterfaceMock_Expecter) SetReferencedFlowHandle(handle interface{}) *CallNodeInterfaceMock_SetReferencedFlowHandle_Call {
+	return &CallNodeInterfaceMock_SetReferencedFlowHandle_Call{Call: _e.mock.On("SetReferencedFlowHandle", handle)}
+}
+
+func (_c *CallNodeInterfaceMock_SetReferencedFlowHandle_Call) Run(run func(handle string)) *CallNodeInterfaceMock_SetReferencedFlowHandle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *CallNodeInterfaceMock_SetReferencedFlowHandle_Call) Return() *CallNodeInterfaceMock_SetReferencedFlowHandle_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CallNodeInterfaceMock_SetReferencedFlowHandle_Call) RunAndReturn(run func(handle string)) *CallNodeInterfaceMock_SetReferencedFlowHandle_Call {
+	_c.Run(run)
+	return _c
+}
+
 // ShouldExecute provides a mock function for the type CallNodeInterfaceMock
 func (_mock *CallNodeInterfaceMock) ShouldExecute(ctx *providers.NodeContext) bool {
 	ret := _mock.Called(ctx)
@@ -544,6 +544,50 @@ func (_c *ExecutorBackedNodeInterfaceMock_GetNextNodeList_Call) RunAndReturn(run
 	return _c
 }
 
+// GetOnCircuitOpen provides a mock function for the type ExecutorBackedNodeInterfaceMock
+func (_mock *ExecutorBackedNodeInterfaceMock) GetOnCircuitOpen() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOnCircuitOpen")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOnCircuitOpen'
+type ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call struct {
+	*mock.Call
+}
+
+// GetOnCircuitOpen is a helper method to define mock.On call
+func (_e *ExecutorBackedNodeInterfaceMock_Expecter) GetOnCircuitOpen() *ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call {
+	return &ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call{Call: _e.mock.On("GetOnCircuitOpen")}
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call) Run(run func()) *ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call) Return(s string) *ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call) RunAndReturn(run func() string) *ExecutorBackedNodeInterfaceMock_GetOnCircuitOpen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOnFailure provides a mock function for the type ExecutorBackedNodeInterfaceMock
 func (_mock *ExecutorBackedNodeInterfaceMock) GetOnFailure() string {
 	ret := _mock.Called()
@@ -768,6 +812,50 @@ func (_c *ExecutorBackedNodeInterfaceMock_GetProperties_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetTaskExecutionPolicy provides a mock function for the type ExecutorBackedNodeInterfaceMock
+func (_mock *ExecutorBackedNodeInterfaceMock) GetTaskExecutionPolicy() TaskExecutionPolicy {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskExecutionPolicy")
+	}
+
+	var r0 TaskExecutionPolicy
+	if returnFunc, ok := ret.Get(0).(func() TaskExecutionPolicy); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(TaskExecutionPolicy)
+	}
+	return r0
+}
+
+// ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskExecutionPolicy'
+type ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call struct {
+	*mock.Call
+}
+
+// GetTaskExecutionPolicy is a helper method to define mock.On call
+func (_e *ExecutorBackedNodeInterfaceMock_Expecter) GetTaskExecutionPolicy() *ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call {
+	return &ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call{Call: _e.mock.On("GetTaskExecutionPolicy")}
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call) Run(run func()) *ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call) Return(taskExecutionPolicy TaskExecutionPolicy) *ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call {
+	_c.Call.Return(taskExecutionPolicy)
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call) RunAndReturn(run func() TaskExecutionPolicy) *ExecutorBackedNodeInterfaceMock_GetTaskExecutionPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetType provides a mock function for the type ExecutorBackedNodeInterfaceMock
 func (_mock *ExecutorBackedNodeInterfaceMock) GetType() common.NodeType {
 	ret := _mock.Called()
@@ -1286,6 +1374,46 @@ func (_c *ExecutorBackedNodeInterfaceMock_SetNextNodeList_Call) RunAndReturn(run
 	return _c
 }
 
+// SetOnCircuitOpen provides a mock function for the type ExecutorBackedNodeInterfaceMock
+func (_mock *ExecutorBackedNodeInterfaceMock) SetOnCircuitOpen(nodeID string) {
+	_mock.Called(nodeID)
+	return
+}
+
+// ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOnCircuitOpen'
+type ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call struct {
+	*mock.Call
+}
+
+// SetOnCircuitOpen is a helper method to define mock.On call
+//   - nodeID string
+func (_e *ExecutorBackedNodeInterfaceMock_Expecter) SetOnCircuitOpen(nodeID interface{}) *ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call {
+	return &ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call{Call: _e.mock.On("SetOnCircuitOpen", nodeID)}
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call) Run(run func(nodeID string)) *ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call) Return() *ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call) RunAndReturn(run func(nodeID string)) *ExecutorBackedNodeInterfaceMock_SetOnCircuitOpen_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetOnFailure provides a mock function for the type ExecutorBackedNodeInterfaceMock
 func (_mock *ExecutorBackedNodeInterfaceMock) SetOnFailure(nodeID string) {
 	_mock.Called(nodeID)
@@ -1446,6 +1574,46 @@ func (_c *ExecutorBackedNodeInterfaceMock_SetPreviousNodeList_Call) RunAndReturn
 	return _c
 }
 
+// SetTaskExecutionPolicy provides a mock function for the type ExecutorBackedNodeInterfaceMock
+func (_mock *ExecutorBackedNodeInterfaceMock) SetTaskExecutionPolicy(policy TaskExecutionPolicy) {
+	_mock.Called(policy)
+	return
+}
+
+// ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTaskExecutionPolicy'
+type ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call struct {
+	*mock.Call
+}
+
+// SetTaskExecutionPolicy is a helper method to define mock.On call
+//   - policy TaskExecutionPolicy
+func (_e *ExecutorBackedNodeInterfaceMock_Expecter) SetTaskExecutionPolicy(policy interface{}) *ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call {
+	return &ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call{Call: _e.mock.On("SetTaskExecutionPolicy", policy)}
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call) Run(run func(policy TaskExecutionPolicy)) *ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 TaskExecutionPolicy
+		if args[0] != nil {
+			arg0 = args[0].(TaskExecutionPolicy)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call) Return() *ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call) RunAndReturn(run func(policy TaskExecutionPolicy)) *ExecutorBackedNodeInterfaceMock_SetTaskExecutionPolicy_Call {
+	_c.Run(run)
+	return _c
+}
+
 // ShouldExecute provides a mock function for the type ExecutorBackedNodeInterfaceMock
 func (_mock *ExecutorBackedNodeInterfaceMock) ShouldExecute(ctx *providers.NodeContext) bool {
 	ret := _mock.Called(ctx)
@@ -27,10 +27,15 @@ import (
 
 // CallNodeInterface extends NodeInterface for CALL nodes, which transfer execution to
 // a referenced flow and return control to the caller when the callee's END node is reached.
+// The referenced flow is identified either by its ID or by its handle.
 type CallNodeInterface interface {
 	NodeInterface
 	GetReferencedFlow() string
 	SetReferencedFlow(flowID string)
+	GetReferencedFlowHandle() string
+	SetReferencedFlowHandle(handle string)
+	GetOutputs() []string
+	SetOutputs(outputs []string)
 	GetOnSuccess() string
 	SetOnSuccess(nodeID string)
 	GetOnFailure() string
@@ -40,10 +45,12 @@ type CallNodeInterface interface {
 // callNode implements CallNodeInterface and represents a CALL node in the flow graph.
 type callNode struct {
 	*node
-	referencedFlow string
-	onSuccess      string
-	onFailure      string
-	logger         *log.Logger
+	referencedFlow       string
+	referencedFlowHandle string
+	outputs              []string
+	onSuccess            string
+	onFailure            string
+	logger               *log.Logger
 }
 
 var _ CallNodeInterface = (*callNode)(nil)
@@ -70,13 +77,14 @@ func newCallNode(id string, properties map[string]interface{}, isStartNode, isFi
 
 // Execute executes the CALL node logic, transferring control to the referenced flow.
 func (n *callNode) Execute(ctx *providers.NodeContext) (*common.NodeResponse, *tidcommon.ServiceError) {
-	if n.referencedFlow == "" {
-		n.logger.Error(ctx.Context, "Referenced flow ID is not set for CALL node")
+	if n.referencedFlow == "" && n.referencedFlowHandle == "" {
+		n.logger.Error(ctx.Context, "Referenced flow is not set for CALL node")
 		return nil, &tidcommon.InternalServerError
 	}
 	return &common.NodeResponse{
-		Status:           common.NodeStatusCall,
-		CallTargetFlowID: n.referencedFlow,
+		Status:               common.NodeStatusCall,
+		CallTargetFlowID:     n.referencedFlow,
+		CallTargetFlowHandle: n.referencedFlowHandle,
 	}, nil
 }
 
@@ -90,6 +98,27 @@ func (n *callNode) SetReferencedFlow(flowID string) {
 	n.referencedFlow = flowID
 }
 
+// GetReferencedFlowHandle returns the handle of the flow referenced by this CALL node.
+func (n *callNode) GetReferencedFlowHandle() string {
+	return n.referencedFlowHandle
+}
+
+// SetReferencedFlowHandle sets the handle of the flow to be referenced by this CALL node.
+func (n *callNode) SetReferencedFlowHandle(handle string) {
+	n.referencedFlowHandle = handle
+}
+
+// GetOutputs returns the runtime data keys returned to the caller when the referenced flow completes.
+// An empty list returns all runtime data produced by the referenced flow.
+func (n *callNode) GetOutputs() []string {
+	return n.outputs
+}
+
+// SetOutputs sets the runtime data keys returned to the caller when the referenced flow completes.
+func (n *callNode) SetOutputs(outputs []string) {
+	n.outputs = outputs
+}
+
 // GetOnSuccess returns the ID of the node to transition to upon successful completion of the referenced flow.
 func (n *callNode) GetOnSuccess() string {
 	return n.onSuccess
@@ -91,6 +91,28 @@ func (s *CallNodeTestSuite) TestGetAndSetReferencedFlow() {
 	s.Empty(callNode.GetReferencedFlow())
 }
 
+func (s *CallNodeTestSuite) TestGetAndSetReferencedFlowHandle() {
+	node := newCallNode("call-1", nil, false, false)
+	callNode, ok := node.(CallNodeInterface)
+	s.True(ok)
+
+	s.Empty(callNode.GetReferencedFlowHandle())
+
+	callNode.SetReferencedFlowHandle("mfa-subflow")
+	s.Equal("mfa-subflow", callNode.GetReferencedFlowHandle())
+}
+
+func (s *CallNodeTestSuite) TestGetAndSetOutputs() {
+	node := newCallNode("call-1", nil, false, false)
+	callNode, ok := node.(CallNodeInterface)
+	s.True(ok)
+
+	s.Empty(callNode.GetOutputs())
+
+	callNode.SetOutputs([]string{"mfaMethod", "mfaCompleted"})
+	s.Equal([]string{"mfaMethod", "mfaCompleted"}, callNode.GetOutputs())
+}
+
 func (s *CallNodeTestSuite) TestGetAndSetOnSuccess() {
 	node := newCallNode("call-1", nil, false, false)
 	callNode, ok := node.(CallNodeInterface)
@@ -180,3 +202,24 @@ func (s *CallNodeTestSuite) TestExecute_ReturnsCorrectCallTargetFlowID() {
 	s.NotNil(resp)
 	s.Equal("registration-flow-123", resp.CallTargetFlowID)
 }
+
+func (s *CallNodeTestSuite) TestExecute_WithReferencedFlowHandle_ReturnsCallTargetFlowHandle() {
+	node := newCallNode("call-1", nil, false, false)
+	callNode, ok := node.(CallNodeInterface)
+	s.True(ok)
+
+	callNode.SetReferencedFlowHandle("mfa-subflow")
+
+	ctx := &providers.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "exec-789",
+	}
+
+	resp, err := callNode.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusCall, resp.Status)
+	s.Empty(resp.CallTargetFlowID)
+	s.Equal("mfa-subflow", resp.CallTargetFlowHandle)
+}
@@ -0,0 +1,234 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// userAttributePlaceholderPattern matches {{user(attribute)}} with optional whitespace.
+var userAttributePlaceholderPattern = regexp.MustCompile(`{{\s*user\(\s*([\w.]+)\s*\)\s*}}`)
+
+// DecisionNodeInterface extends NodeInterface for DECISION nodes, which route to one of several
+// next nodes by evaluating conditions over the flow context.
+type DecisionNodeInterface interface {
+	NodeInterface
+	GetBranches() []DecisionBranch
+	SetBranches(branches []DecisionBranch)
+	GetDefaultNext() string
+	SetDefaultNext(nodeID string)
+}
+
+// decisionNode implements DecisionNodeInterface and represents a DECISION node in the flow graph.
+type decisionNode struct {
+	*node
+	branches    []DecisionBranch
+	defaultNext string
+	logger      *log.Logger
+}
+
+var _ DecisionNodeInterface = (*decisionNode)(nil)
+
+// newDecisionNode creates a new instance of decisionNode with the given parameters.
+func newDecisionNode(id string, properties map[string]interface{}, isStartNode, isFinalNode bool) NodeInterface {
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+	return &decisionNode{
+		node: &node{
+			id:               id,
+			_type:            common.NodeTypeDecision,
+			properties:       properties,
+			isStartNode:      isStartNode,
+			isFinalNode:      isFinalNode,
+			nextNodeList:     []string{},
+			previousNodeList: []string{},
+		},
+		branches: []DecisionBranch{},
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DecisionNode"),
+			log.String(log.LoggerKeyNodeID, id)),
+	}
+}
+
+// Execute evaluates the branches in order and routes to the first branch whose conditions all match,
+// falling back to the default next node when none match.
+func (n *decisionNode) Execute(ctx *providers.NodeContext) (*common.NodeResponse, *tidcommon.ServiceError) {
+	nextNodeID := n.defaultNext
+	for i, branch := range n.branches {
+		if EvaluateDecisionBranch(ctx, branch) {
+			n.logger.Debug(ctx.Context, "Decision branch matched", log.Int("branchIndex", i),
+				log.String("nextNodeID", branch.Next))
+			nextNodeID = branch.Next
+			break
+		}
+	}
+
+	if nextNodeID == "" {
+		n.logger.Error(ctx.Context, "No decision branch matched and no default node is set")
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &common.NodeResponse{
+		Status:         common.NodeStatusComplete,
+		NextNodeID:     nextNodeID,
+		RuntimeData:    make(map[string]string),
+		AdditionalData: make(map[string]string),
+	}, nil
+}
+
+// GetBranches returns the ordered branches of the DECISION node.
+func (n *decisionNode) GetBranches() []DecisionBranch {
+	return n.branches
+}
+
+// SetBranches sets the ordered branches of the DECISION node.
+func (n *decisionNode) SetBranches(branches []DecisionBranch) {
+	if branches == nil {
+		branches = []DecisionBranch{}
+	}
+	n.branches = branches
+}
+
+// GetDefaultNext returns the ID of the node to route to when no branch matches.
+func (n *decisionNode) GetDefaultNext() string {
+	return n.defaultNext
+}
+
+// SetDefaultNext sets the ID of the node to route to when no branch matches.
+func (n *decisionNode) SetDefaultNext(nodeID string) {
+	n.defaultNext = nodeID
+}
+
+// EvaluateDecisionBranch reports whether all conditions of the branch match the given node context.
+// A branch without conditions never matches.
+func EvaluateDecisionBranch(ctx *providers.NodeContext, branch DecisionBranch) bool {
+	if len(branch.Conditions) == 0 {
+		return false
+	}
+	for _, condition := range branch.Conditions {
+		if !EvaluateDecisionCondition(ctx, condition) {
+			return false
+		}
+	}
+	return true
+}
+
+// EvaluateDecisionCondition resolves the condition key against the node context and compares it with
+// the condition value. Keys that cannot be resolved are treated as empty values.
+func EvaluateDecisionCondition(ctx *providers.NodeContext, condition DecisionCondition) bool {
+	resolved, found := resolveDecisionOperand(ctx, condition.Key)
+
+	switch condition.Operator {
+	case providers.DecisionOperatorExists:
+		return found
+	case providers.DecisionOperatorNotExists:
+		return !found
+	case providers.DecisionOperatorEquals:
+		return resolved == condition.Value
+	case providers.DecisionOperatorNotEquals:
+		return resolved != condition.Value
+	case providers.DecisionOperatorIn:
+		return found && slices.Contains(splitDecisionValues(condition.Value), resolved)
+	case providers.DecisionOperatorNotIn:
+		return !found || !slices.Contains(splitDecisionValues(condition.Value), resolved)
+	case providers.DecisionOperatorGreaterThan, providers.DecisionOperatorGreaterThanOrEqual,
+		providers.DecisionOperatorLessThan, providers.DecisionOperatorLessThanOrEqual:
+		return compareDecisionNumbers(resolved, condition.Value, condition.Operator)
+	default:
+		return false
+	}
+}
+
+// resolveDecisionOperand resolves {{ctx(key)}} and {{user(attribute)}} placeholders in the given key.
+// The second return value is false when a placeholder could not be resolved or the result is empty.
+func resolveDecisionOperand(ctx *providers.NodeContext, key string) (string, bool) {
+	resolved := ResolvePlaceholder(ctx, key, nil, nil, nil)
+	resolved = userAttributePlaceholderPattern.ReplaceAllStringFunc(resolved, func(match string) string {
+		submatches := userAttributePlaceholderPattern.FindStringSubmatch(match)
+		if value, ok := getUserAttribute(ctx, submatches[1]); ok {
+			return value
+		}
+		return match
+	})
+
+	if placeholderPattern.MatchString(resolved) || userAttributePlaceholderPattern.MatchString(resolved) {
+		return "", false
+	}
+	return resolved, resolved != ""
+}
+
+// getUserAttribute returns the string form of an attribute of the authenticated user, when the
+// attributes have already been resolved in the flow context.
+func getUserAttribute(ctx *providers.NodeContext, name string) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	attributes := ctx.AuthUser.Attributes()
+	if attributes == nil {
+		return "", false
+	}
+	attribute, ok := attributes.Attributes[name]
+	if !ok || attribute == nil || attribute.Value == nil {
+		return "", false
+	}
+	return fmt.Sprint(attribute.Value), true
+}
+
+// splitDecisionValues splits a comma-separated list of values and trims surrounding whitespace.
+func splitDecisionValues(value string) []string {
+	values := strings.Split(value, ",")
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values
+}
+
+// compareDecisionNumbers compares two values numerically. Returns false when either value is not a number.
+func compareDecisionNumbers(left, right string, operator providers.DecisionOperator) bool {
+	leftNum, err := strconv.ParseFloat(strings.TrimSpace(left), 64)
+	if err != nil {
+		return false
+	}
+	rightNum, err := strconv.ParseFloat(strings.TrimSpace(right), 64)
+	if err != nil {
+		return false
+	}
+
+	switch operator {
+	case providers.DecisionOperatorGreaterThan:
+		return leftNum > rightNum
+	case providers.DecisionOperatorGreaterThanOrEqual:
+		return leftNum >= rightNum
+	case providers.DecisionOperatorLessThan:
+		return leftNum < rightNum
+	case providers.DecisionOperatorLessThanOrEqual:
+		return leftNum <= rightNum
+	default:
+		return false
+	}
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type DecisionNodeTestSuite struct {
+	suite.Suite
+}
+
+func TestDecisionNodeTestSuite(t *testing.T) {
+	suite.Run(t, new(DecisionNodeTestSuite))
+}
+
+func (s *DecisionNodeTestSuite) newContext(runtimeData map[string]string) *providers.NodeContext {
+	return &providers.NodeContext{
+		Context:     context.Background(),
+		RuntimeData: runtimeData,
+		UserInputs:  map[string]string{},
+	}
+}
+
+func (s *DecisionNodeTestSuite) TestNewDecisionNode() {
+	node := newDecisionNode("decision-1", nil, false, false)
+
+	decisionNode, ok := node.(DecisionNodeInterface)
+	s.True(ok, "Node should implement DecisionNodeInterface")
+	s.Equal("decision-1", decisionNode.GetID())
+	s.Equal(common.NodeTypeDecision, decisionNode.GetType())
+	s.NotNil(decisionNode.GetProperties())
+	s.Empty(decisionNode.GetBranches())
+	s.Empty(decisionNode.GetDefaultNext())
+}
+
+func (s *DecisionNodeTestSuite) TestSetBranches_NilResetsToEmpty() {
+	node := newDecisionNode("decision-1", nil, false, false).(DecisionNodeInterface)
+	node.SetBranches([]DecisionBranch{{Next: "mfa"}})
+	s.Len(node.GetBranches(), 1)
+
+	node.SetBranches(nil)
+	s.NotNil(node.GetBranches())
+	s.Empty(node.GetBranches())
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_RoutesToFirstMatchingBranch() {
+	node := newDecisionNode("decision-1", nil, false, false).(DecisionNodeInterface)
+	node.SetBranches([]DecisionBranch{
+		{
+			Conditions: []DecisionCondition{
+				{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+			},
+			Next: "mfa",
+		},
+		{
+			Conditions: []DecisionCondition{
+				{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorExists},
+			},
+			Next: "consent",
+		},
+	})
+	node.SetDefaultNext("end")
+
+	resp, err := node.Execute(s.newContext(map[string]string{"userType": "admin"}))
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("mfa", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_FallsBackToDefault() {
+	node := newDecisionNode("decision-1", nil, false, false).(DecisionNodeInterface)
+	node.SetBranches([]DecisionBranch{
+		{
+			Conditions: []DecisionCondition{
+				{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+			},
+			Next: "mfa",
+		},
+	})
+	node.SetDefaultNext("end")
+
+	resp, err := node.Execute(s.newContext(map[string]string{"userType": "customer"}))
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("end", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecute_NoMatchAndNoDefault() {
+	node := newDecisionNode("decision-1", nil, false, false).(DecisionNodeInterface)
+	node.SetBranches([]DecisionBranch{
+		{
+			Conditions: []DecisionCondition{
+				{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+			},
+			Next: "mfa",
+		},
+	})
+
+	resp, err := node.Execute(s.newContext(map[string]string{}))
+
+	s.Nil(resp)
+	s.NotNil(err)
+}
+
+func (s *DecisionNodeTestSuite) TestEvaluateDecisionBranch() {
+	ctx := s.newContext(map[string]string{"userType": "admin", "riskScore": "80"})
+
+	s.False(EvaluateDecisionBranch(ctx, DecisionBranch{Next: "mfa"}), "branch without conditions never matches")
+	s.True(EvaluateDecisionBranch(ctx, DecisionBranch{
+		Conditions: []DecisionCondition{
+			{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+			{Key: "{{ctx(riskScore)}}", Operator: providers.DecisionOperatorGreaterThan, Value: "50"},
+		},
+	}))
+	s.False(EvaluateDecisionBranch(ctx, DecisionBranch{
+		Conditions: []DecisionCondition{
+			{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+			{Key: "{{ctx(riskScore)}}", Operator: providers.DecisionOperatorLessThan, Value: "50"},
+		},
+	}))
+}
+
+func (s *DecisionNodeTestSuite) TestEvaluateDecisionCondition_Operators() {
+	ctx := s.newContext(map[string]string{"userType": "admin", "riskScore": "42.5"})
+
+	testCases := []struct {
+		name      string
+		condition DecisionCondition
+		expected  bool
+	}{
+		{"Equals", DecisionCondition{"{{ctx(userType)}}", providers.DecisionOperatorEquals, "admin"}, true},
+		{"NotEquals", DecisionCondition{"{{ctx(userType)}}", providers.DecisionOperatorNotEquals, "admin"}, false},
+		{"In", DecisionCondition{"{{ctx(userType)}}", providers.DecisionOperatorIn, "customer, admin"}, true},
+		{"InNoMatch", DecisionCondition{"{{ctx(userType)}}", providers.DecisionOperatorIn, "customer"}, false},
+		{"NotIn", DecisionCondition{"{{ctx(userType)}}", providers.DecisionOperatorNotIn, "customer"}, true},
+		{"NotInMissingKey", DecisionCondition{"{{ctx(missing)}}", providers.DecisionOperatorNotIn, "a"}, true},
+		{"GreaterThan", DecisionCondition{"{{ctx(riskScore)}}", providers.DecisionOperatorGreaterThan, "40"}, true},
+		{"GreaterThanOrEqual",
+			DecisionCondition{"{{ctx(riskScore)}}", providers.DecisionOperatorGreaterThanOrEqual, "42.5"}, true},
+		{"LessThan", DecisionCondition{"{{ctx(riskScore)}}", providers.DecisionOperatorLessThan, "40"}, false},
+		{"LessThanOrEqual",
+			DecisionCondition{"{{ctx(riskScore)}}", providers.DecisionOperatorLessThanOrEqual, "42.5"}, true},
+		{"NonNumeric", DecisionCondition{"{{ctx(userType)}}", providers.DecisionOperatorGreaterThan, "1"}, false},
+		{"Exists", DecisionCondition{"{{ctx(userType)}}", providers.DecisionOperatorExists, ""}, true},
+		{"ExistsMissingKey", DecisionCondition{"{{ctx(missing)}}", providers.DecisionOperatorExists, ""}, false},
+		{"NotExists", DecisionCondition{"{{ctx(missing)}}", providers.DecisionOperatorNotExists, ""}, true},
+		{"UnknownOperator", DecisionCondition{"{{ctx(userType)}}", "MATCHES", "admin"}, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, EvaluateDecisionCondition(ctx, tc.condition))
+		})
+	}
+}
+
+func (s *DecisionNodeTestSuite) TestEvaluateDecisionCondition_UserAttribute() {
+	ctx := s.newContext(map[string]string{})
+	ctx.AuthUser.SetAttributes(&providers.AttributesResponse{
+		Attributes: map[string]*providers.AttributeResponse{
+			"department": {Value: "finance"},
+		},
+	})
+
+	s.True(EvaluateDecisionCondition(ctx, DecisionCondition{
+		Key: "{{user(department)}}", Operator: providers.DecisionOperatorEquals, Value: "finance",
+	}))
+	s.False(EvaluateDecisionCondition(ctx, DecisionCondition{
+		Key: "{{user(country)}}", Operator: providers.DecisionOperatorExists,
+	}))
+}
+
+func (s *DecisionNodeTestSuite) TestEvaluateDecisionCondition_UserAttributeWithoutAttributes() {
+	ctx := s.newContext(map[string]string{})
+
+	s.True(EvaluateDecisionCondition(ctx, DecisionCondition{
+		Key: "{{user(department)}}", Operator: providers.DecisionOperatorNotExists,
+	}))
+}
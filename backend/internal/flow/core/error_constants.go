@@ -52,3 +52,63 @@ var ErrInvalidActionProvided = tidcommon.ServiceError{
 		DefaultValue: "The action provided is not valid for the current flow step",
 	},
 }
+
+// ErrParallelBranchesFailed is returned when a JOIN node's completion condition is not met and the node
+// has no onFailure target.
+var ErrParallelBranchesFailed = tidcommon.ServiceError{
+	Type: tidcommon.ClientErrorType,
+	Code: "FLC-1003",
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flow.core.parallel_branches_failed",
+		DefaultValue: "Parallel branches failed",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flow.core.parallel_branches_failed_description",
+		DefaultValue: "Not enough parallel branches completed successfully to continue the flow",
+	},
+}
+
+// ErrExecutorRetryExhausted is returned when an executor keeps failing after all retries configured on
+// the task execution node have been attempted.
+var ErrExecutorRetryExhausted = tidcommon.ServiceError{
+	Type: tidcommon.ClientErrorType,
+	Code: "FLC-1004",
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flow.core.executor_retry_exhausted",
+		DefaultValue: "Executor retries exhausted",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flow.core.executor_retry_exhausted_description",
+		DefaultValue: "The step could not be completed after retrying. Please try again later",
+	},
+}
+
+// ErrExecutorTimedOut is returned when an executor does not complete within the timeout configured on
+// the task execution node.
+var ErrExecutorTimedOut = tidcommon.ServiceError{
+	Type: tidcommon.ClientErrorType,
+	Code: "FLC-1005",
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flow.core.executor_timed_out",
+		DefaultValue: "Executor timed out",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flow.core.executor_timed_out_description",
+		DefaultValue: "The step did not complete in time. Please try again later",
+	},
+}
+
+// ErrExecutorCircuitOpen is returned when a task execution node fails fast because the circuit breaker of
+// its executor is open.
+var ErrExecutorCircuitOpen = tidcommon.ServiceError{
+	Type: tidcommon.ClientErrorType,
+	Code: "FLC-1006",
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flow.core.executor_circuit_open",
+		DefaultValue: "Executor temporarily unavailable",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flow.core.executor_circuit_open_description",
+		DefaultValue: "The step is temporarily unavailable. Please try again later",
+	},
+}
@@ -72,6 +72,12 @@ func (f *flowFactory) CreateNode(id, _type string, properties map[string]interfa
 		return newRepresentationNode(id, nodeType, properties, isStartNode, isFinalNode), nil
 	case common.NodeTypeCall:
 		return newCallNode(id, properties, isStartNode, isFinalNode), nil
+	case common.NodeTypeDecision:
+		return newDecisionNode(id, properties, isStartNode, isFinalNode), nil
+	case common.NodeTypeParallel:
+		return newParallelNode(id, properties, isStartNode, isFinalNode), nil
+	case common.NodeTypeJoin:
+		return newJoinNode(id, properties, isStartNode, isFinalNode), nil
 	default:
 		return nil, errors.New("unsupported node type: " + _type)
 	}
@@ -169,6 +175,8 @@ func (f *flowFactory) CloneNode(source NodeInterface) (NodeInterface, error) {
 			executableCopy.SetOnSuccess(executableSource.GetOnSuccess())
 			executableCopy.SetOnFailure(executableSource.GetOnFailure())
 			executableCopy.SetOnIncomplete(executableSource.GetOnIncomplete())
+			executableCopy.SetOnCircuitOpen(executableSource.GetOnCircuitOpen())
+			executableCopy.SetTaskExecutionPolicy(executableSource.GetTaskExecutionPolicy())
 		} else {
 			return nil, errors.New("mismatch in node types during cloning. copy is not executor-backed")
 		}
@@ -190,6 +198,10 @@ func (f *flowFactory) CloneNode(source NodeInterface) (NodeInterface, error) {
 	if callSource, ok := source.(CallNodeInterface); ok {
 		if callCopy, ok := nodeCopy.(CallNodeInterface); ok {
 			callCopy.SetReferencedFlow(callSource.GetReferencedFlow())
+			callCopy.SetReferencedFlowHandle(callSource.GetReferencedFlowHandle())
+			if outputs := callSource.GetOutputs(); outputs != nil {
+				callCopy.SetOutputs(append([]string(nil), outputs...))
+			}
 			callCopy.SetOnSuccess(callSource.GetOnSuccess())
 			callCopy.SetOnFailure(callSource.GetOnFailure())
 		} else {
@@ -197,6 +209,45 @@ func (f *flowFactory) CloneNode(source NodeInterface) (NodeInterface, error) {
 		}
 	}
 
+	// Copy branches and the default next node if the node is a decision node
+	if decisionSource, ok := source.(DecisionNodeInterface); ok {
+		if decisionCopy, ok := nodeCopy.(DecisionNodeInterface); ok {
+			branches := make([]DecisionBranch, 0, len(decisionSource.GetBranches()))
+			for _, branch := range decisionSource.GetBranches() {
+				branches = append(branches, DecisionBranch{
+					Conditions: append([]DecisionCondition{}, branch.Conditions...),
+					Next:       branch.Next,
+				})
+			}
+			decisionCopy.SetBranches(branches)
+			decisionCopy.SetDefaultNext(decisionSource.GetDefaultNext())
+		} else {
+			return nil, errors.New("mismatch in node types during cloning. copy is not a decision node")
+		}
+	}
+
+	// Copy branches and the join node if the node is a parallel node
+	if parallelSource, ok := source.(ParallelNodeInterface); ok {
+		if parallelCopy, ok := nodeCopy.(ParallelNodeInterface); ok {
+			parallelCopy.SetBranches(append([]string{}, parallelSource.GetBranches()...))
+			parallelCopy.SetJoinNode(parallelSource.GetJoinNode())
+		} else {
+			return nil, errors.New("mismatch in node types during cloning. copy is not a parallel node")
+		}
+	}
+
+	// Copy mode, branches, onSuccess and onFailure if the node is a join node
+	if joinSource, ok := source.(JoinNodeInterface); ok {
+		if joinCopy, ok := nodeCopy.(JoinNodeInterface); ok {
+			joinCopy.SetMode(joinSource.GetMode())
+			joinCopy.SetBranches(append([]string{}, joinSource.GetBranches()...))
+			joinCopy.SetOnSuccess(joinSource.GetOnSuccess())
+			joinCopy.SetOnFailure(joinSource.GetOnFailure())
+		} else {
+			return nil, errors.New("mismatch in node types during cloning. copy is not a join node")
+		}
+	}
+
 	return nodeCopy, nil
 }
 
@@ -20,6 +20,7 @@ package core
 
 import (
 	"testing"
+	"time"
 
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
@@ -300,6 +301,33 @@ func (s *FlowFactoryTestSuite) TestCloneTaskExecutionNodeWithOnSuccess() {
 	}
 }
 
+func (s *FlowFactoryTestSuite) TestCloneTaskExecutionNodeWithPolicy() {
+	node, _ := s.factory.CreateNode("task", string(common.NodeTypeTaskExecution),
+		map[string]interface{}{}, false, false)
+	policy := TaskExecutionPolicy{RetryCount: 2, RetryDelay: time.Second, Timeout: 10 * time.Second}
+	node.(ExecutorBackedNodeInterface).SetTaskExecutionPolicy(policy)
+
+	clonedNode, err := s.factory.CloneNode(node)
+
+	s.NoError(err)
+	clonedExecNode, ok := clonedNode.(ExecutorBackedNodeInterface)
+	s.True(ok)
+	s.Equal(policy, clonedExecNode.GetTaskExecutionPolicy())
+}
+
+func (s *FlowFactoryTestSuite) TestCloneTaskExecutionNodeWithOnCircuitOpen() {
+	node, _ := s.factory.CreateNode("task", string(common.NodeTypeTaskExecution),
+		map[string]interface{}{}, false, false)
+	node.(ExecutorBackedNodeInterface).SetOnCircuitOpen("fallback")
+
+	clonedNode, err := s.factory.CloneNode(node)
+
+	s.NoError(err)
+	clonedExecNode, ok := clonedNode.(ExecutorBackedNodeInterface)
+	s.True(ok)
+	s.Equal("fallback", clonedExecNode.GetOnCircuitOpen())
+}
+
 func (s *FlowFactoryTestSuite) TestCloneNodeWithMeta() {
 	promptNode, _ := s.factory.CreateNode("prompt-1", string(common.NodeTypePrompt),
 		map[string]interface{}{}, false, false)
@@ -437,6 +465,92 @@ func (s *FlowFactoryTestSuite) TestCloneCallNode() {
 	s.Equal("target-flow-id", callNode.GetReferencedFlow())
 }
 
+func (s *FlowFactoryTestSuite) TestCloneCallNodeWithHandleAndOutputs() {
+	node, _ := s.factory.CreateNode("call-1", string(common.NodeTypeCall), nil, false, false)
+	callNode, ok := node.(CallNodeInterface)
+	s.True(ok)
+	callNode.SetReferencedFlowHandle("mfa-subflow")
+	callNode.SetOutputs([]string{"mfaMethod"})
+
+	clonedNode, err := s.factory.CloneNode(node)
+
+	s.NoError(err)
+	clonedCallNode, ok := clonedNode.(CallNodeInterface)
+	s.True(ok)
+	s.Equal("mfa-subflow", clonedCallNode.GetReferencedFlowHandle())
+	s.Equal([]string{"mfaMethod"}, clonedCallNode.GetOutputs())
+
+	clonedCallNode.GetOutputs()[0] = "changed"
+	s.Equal([]string{"mfaMethod"}, callNode.GetOutputs())
+}
+
+func (s *FlowFactoryTestSuite) TestCloneDecisionNode() {
+	node, err := s.factory.CreateNode("decision-1", string(common.NodeTypeDecision),
+		map[string]interface{}{}, false, false)
+	s.NoError(err)
+
+	decisionNode, ok := node.(DecisionNodeInterface)
+	s.True(ok, "Node should implement DecisionNodeInterface")
+	decisionNode.SetBranches([]DecisionBranch{
+		{
+			Conditions: []DecisionCondition{
+				{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+			},
+			Next: "mfa",
+		},
+	})
+	decisionNode.SetDefaultNext("end")
+
+	clonedNode, err := s.factory.CloneNode(node)
+
+	s.NoError(err)
+	clonedDecisionNode, ok := clonedNode.(DecisionNodeInterface)
+	s.True(ok, "Cloned node should implement DecisionNodeInterface")
+	s.Equal(decisionNode.GetBranches(), clonedDecisionNode.GetBranches())
+	s.Equal("end", clonedDecisionNode.GetDefaultNext())
+
+	// Verify independence — mutating clone does not affect source
+	clonedDecisionNode.GetBranches()[0].Conditions[0].Value = "customer"
+	s.Equal("admin", decisionNode.GetBranches()[0].Conditions[0].Value)
+}
+
+func (s *FlowFactoryTestSuite) TestCloneParallelAndJoinNodes() {
+	node, err := s.factory.CreateNode("parallel-1", string(common.NodeTypeParallel),
+		map[string]interface{}{}, false, false)
+	s.NoError(err)
+	parallelNode, ok := node.(ParallelNodeInterface)
+	s.True(ok, "Node should implement ParallelNodeInterface")
+	parallelNode.SetBranches([]string{"send-email", "send-sms"})
+	parallelNode.SetJoinNode("join")
+
+	clonedNode, err := s.factory.CloneNode(node)
+	s.NoError(err)
+	clonedParallelNode, ok := clonedNode.(ParallelNodeInterface)
+	s.True(ok, "Cloned node should implement ParallelNodeInterface")
+	s.Equal([]string{"send-email", "send-sms"}, clonedParallelNode.GetBranches())
+	s.Equal("join", clonedParallelNode.GetJoinNode())
+	clonedParallelNode.GetBranches()[0] = "changed"
+	s.Equal("send-email", parallelNode.GetBranches()[0])
+
+	node, err = s.factory.CreateNode("join", string(common.NodeTypeJoin), map[string]interface{}{}, false, false)
+	s.NoError(err)
+	joinNode, ok := node.(JoinNodeInterface)
+	s.True(ok, "Node should implement JoinNodeInterface")
+	joinNode.SetMode(providers.JoinModeAny)
+	joinNode.SetBranches([]string{"send-email"})
+	joinNode.SetOnSuccess("otp-prompt")
+	joinNode.SetOnFailure("error-prompt")
+
+	clonedNode, err = s.factory.CloneNode(node)
+	s.NoError(err)
+	clonedJoinNode, ok := clonedNode.(JoinNodeInterface)
+	s.True(ok, "Cloned node should implement JoinNodeInterface")
+	s.Equal(providers.JoinModeAny, clonedJoinNode.GetMode())
+	s.Equal([]string{"send-email"}, clonedJoinNode.GetBranches())
+	s.Equal("otp-prompt", clonedJoinNode.GetOnSuccess())
+	s.Equal("error-prompt", clonedJoinNode.GetOnFailure())
+}
+
 // fakeExecutorBackedNode implements ExecutorBackedNodeInterface but will report a
 // NodeType that CreateNode maps to a non-executor-backed node. This allows
 // exercising the defensive mismatch branch in CloneNode.
@@ -542,8 +656,20 @@ func (f *fakeExecutorBackedNode) GetOnIncomplete() string {
 
 func (f *fakeExecutorBackedNode) SetOnIncomplete(nodeID string) {}
 
+func (f *fakeExecutorBackedNode) GetOnCircuitOpen() string {
+	return ""
+}
+
+func (f *fakeExecutorBackedNode) SetOnCircuitOpen(nodeID string) {}
+
 func (f *fakeExecutorBackedNode) GetMode() string {
 	return ""
 }
 
 func (f *fakeExecutorBackedNode) SetMode(mode string) {}
+
+func (f *fakeExecutorBackedNode) GetTaskExecutionPolicy() TaskExecutionPolicy {
+	return TaskExecutionPolicy{}
+}
+
+func (f *fakeExecutorBackedNode) SetTaskExecutionPolicy(policy TaskExecutionPolicy) {}
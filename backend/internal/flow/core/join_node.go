@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// JoinNodeInterface extends NodeInterface for JOIN nodes, which decide how to continue once the
+// branches of a PARALLEL node have finished.
+type JoinNodeInterface interface {
+	NodeInterface
+	GetMode() providers.JoinMode
+	SetMode(mode providers.JoinMode)
+	GetBranches() []string
+	SetBranches(branches []string)
+	GetOnSuccess() string
+	SetOnSuccess(nodeID string)
+	GetOnFailure() string
+	SetOnFailure(nodeID string)
+}
+
+// joinNode implements JoinNodeInterface and represents a JOIN node in the flow graph.
+type joinNode struct {
+	*node
+	mode      providers.JoinMode
+	branches  []string
+	onSuccess string
+	onFailure string
+	logger    *log.Logger
+}
+
+var _ JoinNodeInterface = (*joinNode)(nil)
+
+// newJoinNode creates a new instance of joinNode with the given parameters.
+func newJoinNode(id string, properties map[string]interface{}, isStartNode, isFinalNode bool) NodeInterface {
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+	return &joinNode{
+		node: &node{
+			id:               id,
+			_type:            common.NodeTypeJoin,
+			properties:       properties,
+			isStartNode:      isStartNode,
+			isFinalNode:      isFinalNode,
+			nextNodeList:     []string{},
+			previousNodeList: []string{},
+		},
+		mode:     providers.JoinModeAll,
+		branches: []string{},
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "JoinNode"),
+			log.String(log.LoggerKeyNodeID, id)),
+	}
+}
+
+// Execute counts the branches that completed successfully and routes to onSuccess when the join mode
+// is satisfied. Otherwise it forwards to onFailure, or fails the flow when onFailure is not set.
+func (n *joinNode) Execute(ctx *providers.NodeContext) (*common.NodeResponse, *tidcommon.ServiceError) {
+	completed := 0
+	for _, branch := range n.branches {
+		if ctx.RuntimeData[ParallelBranchStatusKey(branch)] == string(common.NodeStatusComplete) {
+			completed++
+		}
+	}
+
+	satisfied := completed > 0
+	if n.mode != providers.JoinModeAny {
+		satisfied = len(n.branches) > 0 && completed == len(n.branches)
+	}
+	n.logger.Debug(ctx.Context, "Evaluated parallel branches", log.String("mode", string(n.mode)),
+		log.Int("completedBranches", completed), log.Int("totalBranches", len(n.branches)))
+
+	if satisfied {
+		return &common.NodeResponse{
+			Status:         common.NodeStatusComplete,
+			NextNodeID:     n.onSuccess,
+			RuntimeData:    make(map[string]string),
+			AdditionalData: make(map[string]string),
+		}, nil
+	}
+	if n.onFailure != "" {
+		return &common.NodeResponse{
+			Status:     common.NodeStatusForward,
+			NextNodeID: n.onFailure,
+		}, nil
+	}
+	return &common.NodeResponse{
+		Status: common.NodeStatusFailure,
+		Error:  &ErrParallelBranchesFailed,
+	}, nil
+}
+
+// GetMode returns the completion semantics of the JOIN node.
+func (n *joinNode) GetMode() providers.JoinMode {
+	return n.mode
+}
+
+// SetMode sets the completion semantics of the JOIN node. An empty mode defaults to ALL.
+func (n *joinNode) SetMode(mode providers.JoinMode) {
+	if mode == "" {
+		mode = providers.JoinModeAll
+	}
+	n.mode = mode
+}
+
+// GetBranches returns the IDs of the first node of each branch that converges at this node.
+func (n *joinNode) GetBranches() []string {
+	return n.branches
+}
+
+// SetBranches sets the IDs of the first node of each branch that converges at this node.
+func (n *joinNode) SetBranches(branches []string) {
+	if branches == nil {
+		branches = []string{}
+	}
+	n.branches = branches
+}
+
+// GetOnSuccess returns the ID of the node to transition to when the join mode is satisfied.
+func (n *joinNode) GetOnSuccess() string {
+	return n.onSuccess
+}
+
+// SetOnSuccess sets the ID of the node to transition to when the join mode is satisfied.
+func (n *joinNode) SetOnSuccess(nodeID string) {
+	n.onSuccess = nodeID
+}
+
+// GetOnFailure returns the ID of the node to transition to when the join mode is not satisfied.
+func (n *joinNode) GetOnFailure() string {
+	return n.onFailure
+}
+
+// SetOnFailure sets the ID of the node to transition to when the join mode is not satisfied.
+func (n *joinNode) SetOnFailure(nodeID string) {
+	n.onFailure = nodeID
+}
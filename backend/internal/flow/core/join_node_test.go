@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type JoinNodeTestSuite struct {
+	suite.Suite
+}
+
+func TestJoinNodeTestSuite(t *testing.T) {
+	suite.Run(t, new(JoinNodeTestSuite))
+}
+
+func (s *JoinNodeTestSuite) newJoinNode(mode providers.JoinMode, onFailure string) JoinNodeInterface {
+	node := newJoinNode("join", nil, false, false).(JoinNodeInterface)
+	node.SetMode(mode)
+	node.SetBranches([]string{"send-email", "send-sms"})
+	node.SetOnSuccess("otp-prompt")
+	node.SetOnFailure(onFailure)
+	return node
+}
+
+func (s *JoinNodeTestSuite) newContext(emailStatus, smsStatus common.NodeStatus) *providers.NodeContext {
+	return &providers.NodeContext{
+		Context: context.Background(),
+		RuntimeData: map[string]string{
+			ParallelBranchStatusKey("send-email"): string(emailStatus),
+			ParallelBranchStatusKey("send-sms"):   string(smsStatus),
+		},
+	}
+}
+
+func (s *JoinNodeTestSuite) TestNewJoinNode_DefaultsToAll() {
+	node := newJoinNode("join", nil, false, false)
+
+	joinNode, ok := node.(JoinNodeInterface)
+	s.True(ok, "Node should implement JoinNodeInterface")
+	s.Equal(common.NodeTypeJoin, joinNode.GetType())
+	s.Equal(providers.JoinModeAll, joinNode.GetMode())
+	s.Empty(joinNode.GetBranches())
+
+	joinNode.SetMode("")
+	s.Equal(providers.JoinModeAll, joinNode.GetMode())
+}
+
+func (s *JoinNodeTestSuite) TestExecute_AllSatisfied() {
+	node := s.newJoinNode(providers.JoinModeAll, "")
+
+	resp, err := node.Execute(s.newContext(common.NodeStatusComplete, common.NodeStatusComplete))
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("otp-prompt", resp.NextNodeID)
+}
+
+func (s *JoinNodeTestSuite) TestExecute_AllNotSatisfiedForwardsToOnFailure() {
+	node := s.newJoinNode(providers.JoinModeAll, "error-prompt")
+
+	resp, err := node.Execute(s.newContext(common.NodeStatusComplete, common.NodeStatusFailure))
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusForward, resp.Status)
+	s.Equal("error-prompt", resp.NextNodeID)
+}
+
+func (s *JoinNodeTestSuite) TestExecute_AnySatisfied() {
+	node := s.newJoinNode(providers.JoinModeAny, "")
+
+	resp, err := node.Execute(s.newContext(common.NodeStatusFailure, common.NodeStatusComplete))
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("otp-prompt", resp.NextNodeID)
+}
+
+func (s *JoinNodeTestSuite) TestExecute_AnyNotSatisfiedWithoutOnFailure() {
+	node := s.newJoinNode(providers.JoinModeAny, "")
+
+	resp, err := node.Execute(s.newContext(common.NodeStatusFailure, common.NodeStatusFailure))
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+	s.Equal(ErrParallelBranchesFailed.Code, resp.Error.Code)
+}
+
+func (s *JoinNodeTestSuite) TestExecute_NoBranchesIsNotSatisfied() {
+	node := newJoinNode("join", nil, false, false).(JoinNodeInterface)
+	node.SetOnSuccess("otp-prompt")
+
+	resp, err := node.Execute(&providers.NodeContext{Context: context.Background()})
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+}
@@ -20,8 +20,10 @@ package core
 
 import (
 	"context"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/circuitbreaker"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -34,6 +36,32 @@ type NodeCondition struct {
 	OnSkip string
 }
 
+// DecisionBranch represents a routing rule of a DECISION node. The branch is taken when all of its
+// conditions match.
+type DecisionBranch struct {
+	Conditions []DecisionCondition
+	Next       string
+}
+
+// DecisionCondition compares the resolved value of key against value using the given operator.
+type DecisionCondition struct {
+	Key      string
+	Operator providers.DecisionOperator
+	Value    string
+}
+
+// TaskExecutionPolicy bounds the execution of the executor of a task execution node. When the executor
+// returns an error, it is re-executed up to RetryCount times with RetryDelay between attempts. Timeout
+// bounds the total execution time of the node, including retries. Zero values disable the respective limit.
+// When CircuitBreaker is set, executions that still end in an error are counted by a circuit breaker shared
+// by all nodes calling the same executor and destination, and the node fails fast while it is open.
+type TaskExecutionPolicy struct {
+	RetryCount     int
+	RetryDelay     time.Duration
+	Timeout        time.Duration
+	CircuitBreaker *circuitbreaker.Settings
+}
+
 // Segment represents a contiguous section of a flow graph bounded by display-only prompt nodes.
 type Segment struct {
 	ID          string
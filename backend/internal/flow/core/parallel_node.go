@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// ParallelNodeInterface extends NodeInterface for PARALLEL nodes, which hand a set of branches to the
+// engine to run concurrently before continuing at a JOIN node.
+type ParallelNodeInterface interface {
+	NodeInterface
+	GetBranches() []string
+	SetBranches(branches []string)
+	GetJoinNode() string
+	SetJoinNode(nodeID string)
+}
+
+// parallelNode implements ParallelNodeInterface and represents a PARALLEL node in the flow graph.
+type parallelNode struct {
+	*node
+	branches []string
+	joinNode string
+	logger   *log.Logger
+}
+
+var _ ParallelNodeInterface = (*parallelNode)(nil)
+
+// newParallelNode creates a new instance of parallelNode with the given parameters.
+func newParallelNode(id string, properties map[string]interface{}, isStartNode, isFinalNode bool) NodeInterface {
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+	return &parallelNode{
+		node: &node{
+			id:               id,
+			_type:            common.NodeTypeParallel,
+			properties:       properties,
+			isStartNode:      isStartNode,
+			isFinalNode:      isFinalNode,
+			nextNodeList:     []string{},
+			previousNodeList: []string{},
+		},
+		branches: []string{},
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ParallelNode"),
+			log.String(log.LoggerKeyNodeID, id)),
+	}
+}
+
+// Execute signals the engine to run the branches of the PARALLEL node and continue at the join node.
+func (n *parallelNode) Execute(ctx *providers.NodeContext) (*common.NodeResponse, *tidcommon.ServiceError) {
+	if len(n.branches) == 0 || n.joinNode == "" {
+		n.logger.Error(ctx.Context, "Branches or join node is not set for PARALLEL node")
+		return nil, &tidcommon.InternalServerError
+	}
+	return &common.NodeResponse{
+		Status:           common.NodeStatusParallel,
+		ParallelBranches: append([]string{}, n.branches...),
+		NextNodeID:       n.joinNode,
+	}, nil
+}
+
+// GetBranches returns the IDs of the first node of each branch.
+func (n *parallelNode) GetBranches() []string {
+	return n.branches
+}
+
+// SetBranches sets the IDs of the first node of each branch.
+func (n *parallelNode) SetBranches(branches []string) {
+	if branches == nil {
+		branches = []string{}
+	}
+	n.branches = branches
+}
+
+// GetJoinNode returns the ID of the JOIN node where the branches converge.
+func (n *parallelNode) GetJoinNode() string {
+	return n.joinNode
+}
+
+// SetJoinNode sets the ID of the JOIN node where the branches converge.
+func (n *parallelNode) SetJoinNode(nodeID string) {
+	n.joinNode = nodeID
+}
+
+// ParallelBranchStatusKey returns the runtime data key holding the outcome of the parallel branch that
+// starts at the given node.
+func ParallelBranchStatusKey(branchNodeID string) string {
+	return common.RuntimeKeyParallelBranchStatusPrefix + branchNodeID
+}
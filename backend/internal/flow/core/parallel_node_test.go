@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type ParallelNodeTestSuite struct {
+	suite.Suite
+}
+
+func TestParallelNodeTestSuite(t *testing.T) {
+	suite.Run(t, new(ParallelNodeTestSuite))
+}
+
+func (s *ParallelNodeTestSuite) TestNewParallelNode() {
+	node := newParallelNode("parallel-1", nil, false, false)
+
+	parallelNode, ok := node.(ParallelNodeInterface)
+	s.True(ok, "Node should implement ParallelNodeInterface")
+	s.Equal("parallel-1", parallelNode.GetID())
+	s.Equal(common.NodeTypeParallel, parallelNode.GetType())
+	s.NotNil(parallelNode.GetProperties())
+	s.Empty(parallelNode.GetBranches())
+	s.Empty(parallelNode.GetJoinNode())
+}
+
+func (s *ParallelNodeTestSuite) TestSetBranches_NilResetsToEmpty() {
+	node := newParallelNode("parallel-1", nil, false, false).(ParallelNodeInterface)
+	node.SetBranches([]string{"send-email"})
+	s.Len(node.GetBranches(), 1)
+
+	node.SetBranches(nil)
+	s.NotNil(node.GetBranches())
+	s.Empty(node.GetBranches())
+}
+
+func (s *ParallelNodeTestSuite) TestExecute_ReturnsBranchesAndJoinNode() {
+	node := newParallelNode("parallel-1", nil, false, false).(ParallelNodeInterface)
+	node.SetBranches([]string{"send-email", "send-sms"})
+	node.SetJoinNode("join")
+
+	resp, err := node.Execute(&providers.NodeContext{Context: context.Background()})
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusParallel, resp.Status)
+	s.Equal([]string{"send-email", "send-sms"}, resp.ParallelBranches)
+	s.Equal("join", resp.NextNodeID)
+
+	// The response must not share the node's branch slice
+	resp.ParallelBranches[0] = "changed"
+	s.Equal("send-email", node.GetBranches()[0])
+}
+
+func (s *ParallelNodeTestSuite) TestExecute_MissingConfiguration() {
+	node := newParallelNode("parallel-1", nil, false, false).(ParallelNodeInterface)
+	node.SetBranches([]string{"send-email"})
+
+	resp, err := node.Execute(&providers.NodeContext{Context: context.Background()})
+
+	s.Nil(resp)
+	s.NotNil(err)
+}
+
+func (s *ParallelNodeTestSuite) TestParallelBranchStatusKey() {
+	s.Equal("parallelBranchStatus.send-email", ParallelBranchStatusKey("send-email"))
+}
@@ -19,13 +19,17 @@
 package core
 
 import (
+	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/circuitbreaker"
+	"github.com/thunder-id/thunderid/internal/system/faultinjection"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
@@ -45,21 +49,31 @@ type ExecutorBackedNodeInterface interface {
 	SetOnFailure(nodeID string)
 	GetOnIncomplete() string
 	SetOnIncomplete(nodeID string)
+	GetOnCircuitOpen() string
+	SetOnCircuitOpen(nodeID string)
 	GetMode() string
 	SetMode(mode string)
+	GetTaskExecutionPolicy() TaskExecutionPolicy
+	SetTaskExecutionPolicy(policy TaskExecutionPolicy)
 }
 
+// executorCircuitBreakers holds the circuit breakers of executors, shared by every task execution node
+// that calls the same executor and destination.
+var executorCircuitBreakers = circuitbreaker.NewRegistry()
+
 // taskExecutionNode represents a node that executes a task via an executor
 type taskExecutionNode struct {
 	*node
-	executorName string
-	executor     providers.Executor
-	mode         string
-	inputs       []providers.Input
-	onSuccess    string
-	onFailure    string
-	onIncomplete string
-	logger       *log.Logger
+	executorName  string
+	executor      providers.Executor
+	mode          string
+	inputs        []providers.Input
+	onSuccess     string
+	onFailure     string
+	onIncomplete  string
+	onCircuitOpen string
+	policy        TaskExecutionPolicy
+	logger        *log.Logger
 }
 
 // Ensure taskExecutionNode implements ExecutorBackedNodeInterface
@@ -108,15 +122,36 @@ func (n *taskExecutionNode) Execute(ctx *providers.NodeContext) (*common.NodeRes
 
 	n.enrichRuntimeData(ctx)
 
-	execResp, svcErr := n.triggerExecutor(ctx, logger)
-	if svcErr != nil {
-		return nil, svcErr
+	var execResp *providers.ExecutorResponse
+	breaker := n.getCircuitBreaker(ctx)
+	circuitOpen := breaker != nil && !breaker.Allow()
+	if circuitOpen {
+		logger.Warn(ctx.Context, "Circuit breaker of the executor is open, failing fast",
+			log.String("breaker", breaker.Name()))
+		execResp = n.buildPolicyFailureResponse(ErrExecutorCircuitOpen)
+	} else {
+		var svcErr *tidcommon.ServiceError
+		execResp, svcErr = n.triggerExecutor(ctx, logger)
+		if breaker != nil {
+			n.recordExecutorOutcome(ctx, breaker, execResp, svcErr)
+		}
+		if svcErr != nil {
+			return nil, svcErr
+		}
 	}
 
 	nodeResp := n.buildNodeResponse(execResp)
 
 	// Set the next node ID based on execution outcome
-	if nodeResp.Status == common.NodeStatusComplete {
+	if circuitOpen && n.onCircuitOpen != "" {
+		// Forward to the fallback branch while the executor is unavailable. User inputs are kept so that
+		// the fallback branch can reuse them.
+		nodeResp.Status = common.NodeStatusForward
+		nodeResp.NextNodeID = n.onCircuitOpen
+		if jsonBytes, err := json.Marshal(nodeResp.Error); err == nil {
+			nodeResp.RuntimeData["failureReasonJSON"] = string(jsonBytes)
+		}
+	} else if nodeResp.Status == common.NodeStatusComplete {
 		if n.onSuccess != "" {
 			nodeResp.NextNodeID = n.onSuccess
 		}
@@ -188,20 +223,119 @@ func (n *taskExecutionNode) enrichRuntimeData(ctx *providers.NodeContext) {
 	}
 }
 
-// triggerExecutor triggers the executor configured for the node.
+// getCircuitBreaker returns the circuit breaker of the node's executor, or nil if the node's task execution
+// policy does not enable one. Nodes that call an executor for a specific identity provider or message
+// sender share a breaker per destination, so that one unavailable destination does not affect the others.
+func (n *taskExecutionNode) getCircuitBreaker(ctx *providers.NodeContext) *circuitbreaker.Breaker {
+	if n.policy.CircuitBreaker == nil {
+		return nil
+	}
+
+	name := "executor:" + n.GetExecutorName()
+	if idpID, ok := ctx.NodeProperties["idpId"].(string); ok && idpID != "" {
+		name += ":" + idpID
+	} else if senderID, ok := ctx.NodeProperties["senderId"].(string); ok && senderID != "" {
+		name += ":" + senderID
+	}
+	return executorCircuitBreakers.Get(name, *n.policy.CircuitBreaker)
+}
+
+// recordExecutorOutcome records the outcome of an executor run on the circuit breaker. Executor errors,
+// including timeouts and exhausted retries, count as failures. Failures reported by the executor itself,
+// such as invalid credentials, show that the executor is reachable and count as successes.
+func (n *taskExecutionNode) recordExecutorOutcome(ctx *providers.NodeContext, breaker *circuitbreaker.Breaker,
+	execResp *providers.ExecutorResponse, svcErr *tidcommon.ServiceError) {
+	switch {
+	case ctx.Context != nil && ctx.Context.Err() != nil:
+		breaker.Cancel()
+	case svcErr != nil:
+		breaker.Failure()
+	case execResp.Status == providers.ExecFailure && execResp.Error != nil &&
+		(execResp.Error.Code == ErrExecutorTimedOut.Code || execResp.Error.Code == ErrExecutorRetryExhausted.Code):
+		breaker.Failure()
+	default:
+		breaker.Success()
+	}
+}
+
+// triggerExecutor triggers the executor configured for the node, applying the node's task execution
+// policy. Executor errors are retried up to the configured retry count, and the configured timeout is
+// applied to the context passed to the executor.
 func (n *taskExecutionNode) triggerExecutor(ctx *providers.NodeContext, logger *log.Logger) (
 	*providers.ExecutorResponse, *tidcommon.ServiceError) {
-	execResp, err := n.executor.Execute(ctx)
-	if err != nil {
-		logger.Error(ctx.Context, "Error executing node executor", log.Error(err))
-		return nil, &tidcommon.InternalServerError
+	if n.policy.Timeout > 0 {
+		parentCtx := ctx.Context
+		baseCtx := parentCtx
+		if baseCtx == nil {
+			baseCtx = context.Background()
+		}
+		timeoutCtx, cancel := context.WithTimeout(baseCtx, n.policy.Timeout)
+		ctx.Context = timeoutCtx
+		defer func() {
+			cancel()
+			ctx.Context = parentCtx
+		}()
 	}
-	if execResp == nil {
-		logger.Error(ctx.Context, "Executor returned a nil response")
-		return nil, &tidcommon.InternalServerError
+
+	for attempt := 0; ; attempt++ {
+		var execResp *providers.ExecutorResponse
+		err := faultinjection.Inject(ctx.Context, faultinjection.TargetExecutor, n.GetExecutorName())
+		if err == nil {
+			execResp, err = n.executor.Execute(ctx)
+		}
+		if err == nil {
+			if execResp == nil {
+				logger.Error(ctx.Context, "Executor returned a nil response")
+				return nil, &tidcommon.InternalServerError
+			}
+			return execResp, nil
+		}
+
+		if n.policy.Timeout > 0 && ctx.Context.Err() == context.DeadlineExceeded {
+			logger.Warn(ctx.Context, "Executor timed out", log.Int("attempt", attempt+1), log.Error(err))
+			return n.buildPolicyFailureResponse(ErrExecutorTimedOut), nil
+		}
+		if attempt >= n.policy.RetryCount {
+			logger.Error(ctx.Context, "Error executing node executor", log.Int("attempt", attempt+1),
+				log.Error(err))
+			if n.policy.RetryCount > 0 {
+				return n.buildPolicyFailureResponse(ErrExecutorRetryExhausted), nil
+			}
+			return nil, &tidcommon.InternalServerError
+		}
+
+		logger.Warn(ctx.Context, "Error executing node executor, retrying", log.Int("attempt", attempt+1),
+			log.Error(err))
+		if !waitForRetry(ctx.Context, n.policy.RetryDelay) {
+			logger.Warn(ctx.Context, "Executor timed out while waiting to retry", log.Int("attempt", attempt+1))
+			return n.buildPolicyFailureResponse(ErrExecutorTimedOut), nil
+		}
 	}
+}
 
-	return execResp, nil
+// buildPolicyFailureResponse builds a failed executor response carrying the given error, used when the
+// executor cannot complete within the limits of the node's task execution policy.
+func (n *taskExecutionNode) buildPolicyFailureResponse(svcErr tidcommon.ServiceError) *providers.ExecutorResponse {
+	return &providers.ExecutorResponse{
+		Status: providers.ExecFailure,
+		Error:  &svcErr,
+	}
+}
+
+// waitForRetry waits for the given delay before an executor is retried. Returns false if the context is
+// done before the delay elapses.
+func waitForRetry(ctx context.Context, delay time.Duration) bool {
+	if delay <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
 }
 
 // buildNodeResponse constructs a NodeResponse from the ExecutorResponse.
@@ -318,6 +452,16 @@ func (n *taskExecutionNode) SetOnIncomplete(nodeID string) {
 	n.onIncomplete = nodeID
 }
 
+// GetOnCircuitOpen returns the onCircuitOpen node ID
+func (n *taskExecutionNode) GetOnCircuitOpen() string {
+	return n.onCircuitOpen
+}
+
+// SetOnCircuitOpen sets the onCircuitOpen node ID
+func (n *taskExecutionNode) SetOnCircuitOpen(nodeID string) {
+	n.onCircuitOpen = nodeID
+}
+
 // GetMode returns the mode for the executor that supports multi-step execution
 func (n *taskExecutionNode) GetMode() string {
 	return n.mode
@@ -328,6 +472,16 @@ func (n *taskExecutionNode) SetMode(mode string) {
 	n.mode = mode
 }
 
+// GetTaskExecutionPolicy returns the retry, timeout and circuit breaker policy applied to the node's executor
+func (n *taskExecutionNode) GetTaskExecutionPolicy() TaskExecutionPolicy {
+	return n.policy
+}
+
+// SetTaskExecutionPolicy sets the retry, timeout and circuit breaker policy applied to the node's executor
+func (n *taskExecutionNode) SetTaskExecutionPolicy(policy TaskExecutionPolicy) {
+	n.policy = policy
+}
+
 // GetInputs returns the inputs required for the task execution node
 func (n *taskExecutionNode) GetInputs() []providers.Input {
 	return n.inputs
@@ -19,8 +19,10 @@
 package core
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
@@ -31,6 +33,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/circuitbreaker"
+	"github.com/thunder-id/thunderid/internal/system/faultinjection"
 )
 
 type TaskExecutionNodeTestSuite struct {
@@ -44,6 +48,7 @@ func TestTaskExecutionNodeTestSuite(t *testing.T) {
 
 func (s *TaskExecutionNodeTestSuite) SetupTest() {
 	s.mockExecutor = NewExecutorInterfaceMock(s.T())
+	executorCircuitBreakers = circuitbreaker.NewRegistry()
 }
 
 func (s *TaskExecutionNodeTestSuite) TestNewTaskExecutionNode() {
@@ -233,6 +238,121 @@ func (s *TaskExecutionNodeTestSuite) TestExecuteExecutorError() {
 	s.Nil(resp)
 }
 
+func (s *TaskExecutionNodeTestSuite) TestTaskExecutionPolicyMethods() {
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+
+	s.Equal(TaskExecutionPolicy{}, execNode.GetTaskExecutionPolicy())
+
+	policy := TaskExecutionPolicy{RetryCount: 2, RetryDelay: time.Second, Timeout: 5 * time.Second}
+	execNode.SetTaskExecutionPolicy(policy)
+	s.Equal(policy, execNode.GetTaskExecutionPolicy())
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteRetriesExecutorError() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(nil, assert.AnError).Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(
+		&providers.ExecutorResponse{Status: providers.ExecComplete}, nil).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetOnSuccess("next-node")
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{RetryCount: 2, RetryDelay: time.Millisecond})
+
+	ctx := &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("next-node", resp.NextNodeID)
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteRetryExhaustedWithOnFailureHandler() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(nil, assert.AnError).Times(3)
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetOnFailure("error-prompt")
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{RetryCount: 2})
+
+	ctx := &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusForward, resp.Status)
+	s.Equal("error-prompt", resp.NextNodeID)
+	s.Equal(ErrExecutorRetryExhausted.Code, resp.Error.Code)
+	var failureReason tidcommon.ServiceError
+	s.NoError(json.Unmarshal([]byte(resp.RuntimeData["failureReasonJSON"]), &failureReason))
+	s.Equal(ErrExecutorRetryExhausted.Code, failureReason.Code)
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteRetryExhaustedWithoutOnFailureHandler() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(nil, assert.AnError).Twice()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{RetryCount: 1})
+
+	ctx := &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+	s.Equal(ErrExecutorRetryExhausted.Code, resp.Error.Code)
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteTimeout() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Run(func(args mock.Arguments) {
+		nodeCtx := args.Get(0).(*providers.NodeContext)
+		<-nodeCtx.Context.Done()
+	}).Return(nil, context.DeadlineExceeded).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{RetryCount: 3, Timeout: 10 * time.Millisecond})
+
+	parentCtx := context.Background()
+	ctx := &providers.NodeContext{Context: parentCtx, ExecutionID: "test-flow"}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+	s.Equal(ErrExecutorTimedOut.Code, resp.Error.Code)
+	s.Equal(parentCtx, ctx.Context)
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteTimeoutWhileWaitingToRetry() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(nil, assert.AnError).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{
+		RetryCount: 3, RetryDelay: time.Minute, Timeout: 10 * time.Millisecond})
+
+	ctx := &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+	s.Equal(ErrExecutorTimedOut.Code, resp.Error.Code)
+}
+
 func (s *TaskExecutionNodeTestSuite) TestExecuteNilExecutorResponse() {
 	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
 	execNode, _ := node.(ExecutorBackedNodeInterface)
@@ -1035,3 +1155,178 @@ func (s *TaskExecutionNodeTestSuite) TestGetExecutionPolicy_WithEmptyMode() {
 	s.NotNil(policy)
 	s.False(policy.SkipChallengeValidation)
 }
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteInjectedFaultIsRetried() {
+	s.Require().NoError(faultinjection.Initialize(faultinjection.Config{
+		Enabled: true,
+		Rules: []faultinjection.Rule{
+			{Target: faultinjection.TargetExecutor, Match: "test-executor", Probability: 1, Fail: true},
+		},
+	}))
+	defer func() { _ = faultinjection.Initialize(faultinjection.Config{}) }()
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{RetryCount: 1})
+
+	ctx := &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+	s.Equal(ErrExecutorRetryExhausted.Code, resp.Error.Code)
+	s.mockExecutor.AssertNotCalled(s.T(), "Execute", mock.Anything)
+}
+
+func (s *TaskExecutionNodeTestSuite) TestOnCircuitOpen() {
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+
+	s.Equal("", execNode.GetOnCircuitOpen())
+	execNode.SetOnCircuitOpen("fallback-node")
+	s.Equal("fallback-node", execNode.GetOnCircuitOpen())
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteCircuitOpenForwardsToFallback() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(nil, assert.AnError).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetOnFailure("error-prompt")
+	execNode.SetOnCircuitOpen("fallback-node")
+	execNode.SetInputs([]providers.Input{{Identifier: "mobileNumber"}})
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{
+		CircuitBreaker: &circuitbreaker.Settings{FailureThreshold: 1, OpenDuration: time.Minute}})
+
+	ctx := &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"}
+	_, err := node.Execute(ctx)
+	s.NotNil(err)
+
+	ctx = &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow",
+		UserInputs: map[string]string{"mobileNumber": "+94771234567"}}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusForward, resp.Status)
+	s.Equal("fallback-node", resp.NextNodeID)
+	s.Equal(ErrExecutorCircuitOpen.Code, resp.Error.Code)
+	var failureReason tidcommon.ServiceError
+	s.NoError(json.Unmarshal([]byte(resp.RuntimeData["failureReasonJSON"]), &failureReason))
+	s.Equal(ErrExecutorCircuitOpen.Code, failureReason.Code)
+	s.Equal("+94771234567", ctx.UserInputs["mobileNumber"])
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteCircuitOpenWithoutFallbackUsesOnFailure() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(nil, assert.AnError).Twice()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetOnFailure("error-prompt")
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{
+		RetryCount: 1, CircuitBreaker: &circuitbreaker.Settings{FailureThreshold: 1, OpenDuration: time.Minute}})
+
+	ctx := &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"}
+	resp, err := node.Execute(ctx)
+	s.Nil(err)
+	s.Equal(ErrExecutorRetryExhausted.Code, resp.Error.Code)
+
+	resp, err = node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusForward, resp.Status)
+	s.Equal("error-prompt", resp.NextNodeID)
+	s.Equal(ErrExecutorCircuitOpen.Code, resp.Error.Code)
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteExecutorFailureDoesNotOpenCircuit() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(&providers.ExecutorResponse{
+		Status: providers.ExecFailure,
+		Error:  &tidcommon.ServiceError{Code: "INVALID_CREDENTIALS"},
+	}, nil).Twice()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetOnCircuitOpen("fallback-node")
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{
+		CircuitBreaker: &circuitbreaker.Settings{FailureThreshold: 1, OpenDuration: time.Minute}})
+
+	for i := 0; i < 2; i++ {
+		ctx := &providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"}
+		resp, err := node.Execute(ctx)
+
+		s.Nil(err)
+		s.Equal(common.NodeStatusFailure, resp.Status)
+		s.Equal("INVALID_CREDENTIALS", resp.Error.Code)
+	}
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteCircuitBreakerIsSharedPerDestination() {
+	settings := &circuitbreaker.Settings{FailureThreshold: 1, OpenDuration: time.Minute}
+	newNode := func(idpID string, executor providers.Executor) NodeInterface {
+		node := newTaskExecutionNode("task-"+idpID, map[string]interface{}{"idpId": idpID}, false, false)
+		execNode, _ := node.(ExecutorBackedNodeInterface)
+		execNode.SetOnSuccess("next-node")
+		execNode.SetOnCircuitOpen("fallback-node")
+		execNode.SetExecutor(executor)
+		execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{CircuitBreaker: settings})
+		return node
+	}
+
+	failingExecutor := NewExecutorInterfaceMock(s.T())
+	failingExecutor.On("GetName").Return("test-executor")
+	failingExecutor.On("Execute", mock.Anything).Return(nil, assert.AnError).Once()
+	_, err := newNode("idp-1", failingExecutor).Execute(
+		&providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"})
+	s.NotNil(err)
+
+	// Another node calling the same destination fails fast.
+	resp, err := newNode("idp-1", failingExecutor).Execute(
+		&providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"})
+	s.Nil(err)
+	s.Equal("fallback-node", resp.NextNodeID)
+
+	// A node calling another destination is not affected.
+	s.mockExecutor.On("GetName").Return("test-executor")
+	s.mockExecutor.On("Execute", mock.Anything).Return(
+		&providers.ExecutorResponse{Status: providers.ExecComplete}, nil).Once()
+	resp, err = newNode("idp-2", s.mockExecutor).Execute(
+		&providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"})
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("next-node", resp.NextNodeID)
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteCancelledCallDoesNotOpenCircuit() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Run(func(args mock.Arguments) {
+		cancel()
+	}).Return(nil, context.Canceled).Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(
+		&providers.ExecutorResponse{Status: providers.ExecComplete}, nil).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetOnCircuitOpen("fallback-node")
+	execNode.SetExecutor(s.mockExecutor)
+	execNode.SetTaskExecutionPolicy(TaskExecutionPolicy{
+		CircuitBreaker: &circuitbreaker.Settings{FailureThreshold: 1, OpenDuration: time.Minute}})
+
+	_, err := node.Execute(&providers.NodeContext{Context: ctx, ExecutionID: "test-flow"})
+	s.NotNil(err)
+
+	resp, err := node.Execute(&providers.NodeContext{Context: context.Background(), ExecutionID: "test-flow"})
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/authn/anomaly"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// anomalyDetectionExecutor assesses the sign-in of the authenticated user for anomalies such as a
+// new device or impossible travel. The outcome is exposed in the runtime data so that a decision
+// node can route anomalous sign-ins to a step-up authentication.
+type anomalyDetectionExecutor struct {
+	providers.Executor
+	anomalyService anomaly.AnomalyServiceInterface
+	authnProvider  providers.AuthnProviderManager
+	logger         *log.Logger
+}
+
+var _ providers.Executor = (*anomalyDetectionExecutor)(nil)
+
+// newAnomalyDetectionExecutor creates a new instance of anomalyDetectionExecutor.
+func newAnomalyDetectionExecutor(
+	flowFactory core.FlowFactoryInterface,
+	anomalyService anomaly.AnomalyServiceInterface,
+	authnProvider providers.AuthnProviderManager,
+) *anomalyDetectionExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, "AnomalyDetectionExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameAnomalyDetection),
+	)
+	prerequisites := []providers.Input{
+		{
+			Identifier: userAttributeUserID,
+			Type:       providers.InputTypeText,
+			Required:   true,
+		},
+	}
+
+	base := flowFactory.CreateExecutor(ExecutorNameAnomalyDetection, providers.ExecutorTypeUtility,
+		[]providers.Input{}, prerequisites)
+
+	return &anomalyDetectionExecutor{
+		Executor:       base,
+		anomalyService: anomalyService,
+		authnProvider:  authnProvider,
+		logger:         logger,
+	}
+}
+
+// Execute runs the anomaly detection logic.
+func (e *anomalyDetectionExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Executing anomaly detection executor")
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	if !e.ValidatePrerequisites(ctx, execResp, e.authnProvider) {
+		logger.Debug(ctx.Context, "Prerequisites validation failed for anomaly detection executor")
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrPrerequisitesFailed
+		return execResp, nil
+	}
+
+	if !execResp.AuthUser.IsAuthenticated() {
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrUserNotAuthenticated
+		return execResp, nil
+	}
+
+	setAnomalyRuntimeData(execResp, nil)
+	if !e.anomalyService.IsEnabled() {
+		logger.Debug(ctx.Context, "Anomaly detection is disabled; completing anomaly detection executor")
+		execResp.Status = providers.ExecComplete
+		return execResp, nil
+	}
+
+	authUser, entityRef, svcErr := e.authnProvider.GetEntityReference(ctx.Context, execResp.AuthUser)
+	execResp.AuthUser = authUser
+	if svcErr != nil {
+		return execResp, errors.New("failed to get entity reference from AuthUser")
+	}
+
+	signal := anomaly.LoginSignal{
+		UserID:    entityRef.EntityID,
+		ClientIP:  sysContext.GetClientIP(ctx.Context),
+		UserAgent: sysContext.GetUserAgent(ctx.Context),
+		DeviceID:  ctx.UserInputs[userInputDeviceID],
+	}
+	assessment, err := e.anomalyService.Evaluate(ctx.Context, signal)
+	if err != nil {
+		// A failure to assess the sign-in must not lock users out, so the sign-in is let through.
+		logger.Warn(ctx.Context, "Failed to assess the sign-in for anomalies", log.Error(err))
+		execResp.Status = providers.ExecComplete
+		return execResp, nil
+	}
+
+	setAnomalyRuntimeData(execResp, assessment)
+	if assessment.IsAnomalous() && isFailOnAnomaly(ctx) {
+		logger.Debug(ctx.Context, "Denying anomalous sign-in")
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrSignInAnomalyDetected
+		return execResp, nil
+	}
+
+	execResp.Status = providers.ExecComplete
+	return execResp, nil
+}
+
+// setAnomalyRuntimeData records the outcome of the assessment in the runtime data.
+func setAnomalyRuntimeData(execResp *providers.ExecutorResponse, assessment *anomaly.Assessment) {
+	execResp.RuntimeData[common.RuntimeKeyAnomalyDetected] = strconv.FormatBool(assessment.IsAnomalous())
+	execResp.RuntimeData[common.RuntimeKeyAnomalyNewDevice] = strconv.FormatBool(
+		assessment != nil && assessment.NewDevice)
+	execResp.RuntimeData[common.RuntimeKeyAnomalyImpossibleTravel] = strconv.FormatBool(
+		assessment != nil && assessment.ImpossibleTravel)
+}
+
+// isFailOnAnomaly returns whether the node is configured to deny anomalous sign-ins.
+func isFailOnAnomaly(ctx *providers.NodeContext) bool {
+	if val, ok := ctx.NodeProperties[propertyKeyFailOnAnomaly].(bool); ok {
+		return val
+	}
+	return false
+}
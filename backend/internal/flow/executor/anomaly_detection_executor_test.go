@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/authn/anomaly"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/anomalymock"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type AnomalyDetectionExecutorTestSuite struct {
+	suite.Suite
+	mockAnomalyService *anomalymock.AnomalyServiceInterfaceMock
+	mockAuthnProvider  *managermock.AuthnProviderManagerMock
+	mockExec           *coremock.ExecutorInterfaceMock
+	executor           *anomalyDetectionExecutor
+}
+
+func TestAnomalyDetectionExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(AnomalyDetectionExecutorTestSuite))
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) SetupTest() {
+	suite.mockAnomalyService = anomalymock.NewAnomalyServiceInterfaceMock(suite.T())
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerMock(suite.T())
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+
+	suite.mockExec = coremock.NewExecutorInterfaceMock(suite.T())
+	suite.mockExec.On("GetName").Return(ExecutorNameAnomalyDetection).Maybe()
+	mockFlowFactory.On("CreateExecutor", ExecutorNameAnomalyDetection, providers.ExecutorTypeUtility,
+		mock.AnythingOfType("[]providers.Input"), mock.AnythingOfType("[]providers.Input")).Return(suite.mockExec)
+
+	suite.executor = newAnomalyDetectionExecutor(mockFlowFactory, suite.mockAnomalyService, suite.mockAuthnProvider)
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) buildNodeContext() *providers.NodeContext {
+	ctx := sysContext.WithClientIP(context.Background(), netip.MustParseAddr("203.0.113.10"))
+	ctx = sysContext.WithUserAgent(ctx, "test-agent")
+	return &providers.NodeContext{
+		Context:        ctx,
+		ExecutionID:    "flow-123",
+		AuthUser:       buildConsentAuthUser(),
+		UserInputs:     map[string]string{},
+		RuntimeData:    map[string]string{},
+		NodeProperties: map[string]interface{}{},
+	}
+}
+
+// setupAuthenticatedUser sets up the mocks for an authenticated user who passes the prerequisites.
+func (suite *AnomalyDetectionExecutorTestSuite) setupAuthenticatedUser() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	suite.mockAnomalyService.On("IsEnabled").Return(true)
+	suite.mockAuthnProvider.On("GetEntityReference", mock.Anything, mock.Anything).
+		Return(buildConsentAuthUser(), buildConsentEntityRef(), (*tidcommon.ServiceError)(nil))
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) TestExecute_PrerequisitesFailure() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(false)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrPrerequisitesFailed.Code, resp.Error.Code)
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) TestExecute_Disabled() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	suite.mockAnomalyService.On("IsEnabled").Return(false)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "false", resp.RuntimeData[common.RuntimeKeyAnomalyDetected])
+	suite.mockAnomalyService.AssertNotCalled(suite.T(), "Evaluate", mock.Anything, mock.Anything)
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) TestExecute_NoAnomaly() {
+	suite.setupAuthenticatedUser()
+	suite.mockAnomalyService.On("Evaluate", mock.Anything, anomaly.LoginSignal{
+		UserID:    testUserID,
+		ClientIP:  netip.MustParseAddr("203.0.113.10"),
+		UserAgent: "test-agent",
+		DeviceID:  "device-1",
+	}).Return(&anomaly.Assessment{DeviceID: "abc"}, nil)
+	ctx := suite.buildNodeContext()
+	ctx.UserInputs[userInputDeviceID] = "device-1"
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "false", resp.RuntimeData[common.RuntimeKeyAnomalyDetected])
+	assert.Equal(suite.T(), "false", resp.RuntimeData[common.RuntimeKeyAnomalyNewDevice])
+	assert.Equal(suite.T(), "false", resp.RuntimeData[common.RuntimeKeyAnomalyImpossibleTravel])
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) TestExecute_AnomalyExposedForStepUp() {
+	suite.setupAuthenticatedUser()
+	suite.mockAnomalyService.On("Evaluate", mock.Anything, mock.Anything).
+		Return(&anomaly.Assessment{NewDevice: true, ImpossibleTravel: true}, nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "true", resp.RuntimeData[common.RuntimeKeyAnomalyDetected])
+	assert.Equal(suite.T(), "true", resp.RuntimeData[common.RuntimeKeyAnomalyNewDevice])
+	assert.Equal(suite.T(), "true", resp.RuntimeData[common.RuntimeKeyAnomalyImpossibleTravel])
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) TestExecute_FailOnAnomaly() {
+	suite.setupAuthenticatedUser()
+	suite.mockAnomalyService.On("Evaluate", mock.Anything, mock.Anything).
+		Return(&anomaly.Assessment{NewDevice: true}, nil)
+	ctx := suite.buildNodeContext()
+	ctx.NodeProperties[propertyKeyFailOnAnomaly] = true
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrSignInAnomalyDetected.Code, resp.Error.Code)
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) TestExecute_EvaluationErrorFailsOpen() {
+	suite.setupAuthenticatedUser()
+	suite.mockAnomalyService.On("Evaluate", mock.Anything, mock.Anything).
+		Return(nil, errors.New("store unavailable"))
+	ctx := suite.buildNodeContext()
+	ctx.NodeProperties[propertyKeyFailOnAnomaly] = true
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "false", resp.RuntimeData[common.RuntimeKeyAnomalyDetected])
+}
+
+func (suite *AnomalyDetectionExecutorTestSuite) TestExecute_EntityReferenceError() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	suite.mockAnomalyService.On("IsEnabled").Return(true)
+	suite.mockAuthnProvider.On("GetEntityReference", mock.Anything, mock.Anything).
+		Return(buildConsentAuthUser(), (*providers.EntityReference)(nil), &tidcommon.InternalServerError)
+
+	_, err := suite.executor.Execute(suite.buildNodeContext())
+
+	assert.Error(suite.T(), err)
+}
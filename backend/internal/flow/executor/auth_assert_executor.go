@@ -32,12 +32,15 @@ import (
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	"github.com/thunder-id/thunderid/internal/authn/assert"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
+	"github.com/thunder-id/thunderid/internal/device"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/role"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -46,6 +49,10 @@ const (
 	authAssertLoggerComponentName = "AuthAssertExecutor"
 )
 
+// errEssentialAttributesUnavailable is returned when the flow enforces essential attributes and one of
+// them cannot be resolved for the authenticated user.
+var errEssentialAttributesUnavailable = errors.New("essential attributes are not available for the user")
+
 // authAssertExecutor is an executor that handles authentication assertions in the flow.
 type authAssertExecutor struct {
 	providers.Executor
@@ -56,6 +63,8 @@ type authAssertExecutor struct {
 	entityProvider      entityprovider.EntityProviderInterface
 	attributeCacheSvc   attributecache.AttributeCacheServiceInterface
 	roleService         role.RoleServiceInterface
+	deviceService       device.DeviceServiceInterface
+	loginHistory        loginhistory.LoginHistoryServiceInterface
 	logger              *log.Logger
 }
 
@@ -71,6 +80,8 @@ func newAuthAssertExecutor(
 	entityProvider entityprovider.EntityProviderInterface,
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
 	roleService role.RoleServiceInterface,
+	deviceService device.DeviceServiceInterface,
+	loginHistory loginhistory.LoginHistoryServiceInterface,
 ) *authAssertExecutor {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, authAssertLoggerComponentName),
 		log.String(log.LoggerKeyExecutorName, ExecutorNameAuthAssert))
@@ -87,6 +98,8 @@ func newAuthAssertExecutor(
 		entityProvider:      entityProvider,
 		attributeCacheSvc:   attributeCacheSvc,
 		roleService:         roleService,
+		deviceService:       deviceService,
+		loginHistory:        loginHistory,
 		logger:              logger,
 	}
 }
@@ -104,6 +117,16 @@ func (a *authAssertExecutor) Execute(ctx *providers.NodeContext) (*providers.Exe
 
 	if execResp.AuthUser.IsAuthenticated() {
 		token, err := a.generateAuthAssertion(ctx, execResp, logger)
+		if errors.Is(err, device.ErrSessionLimitReached) {
+			execResp.Status = providers.ExecFailure
+			execResp.Error = &ErrConcurrentSessionLimitReached
+			return execResp, nil
+		}
+		if errors.Is(err, errEssentialAttributesUnavailable) {
+			execResp.Status = providers.ExecFailure
+			execResp.Error = &ErrEssentialAttributesUnavailable
+			return execResp, nil
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -142,6 +165,7 @@ func (a *authAssertExecutor) generateAuthAssertion(
 	authenticatorRefs := a.extractAuthenticatorReferences(ctx.ExecutionHistory)
 
 	// Generate assertion from engaged authenticators
+	aal := assert.AALUnknown
 	if len(authenticatorRefs) > 0 {
 		assertionResult, svcErr := a.authAssertGenerator.GenerateAssertion(ctx.Context, authenticatorRefs)
 		if svcErr != nil {
@@ -154,6 +178,9 @@ func (a *authAssertExecutor) generateAuthAssertion(
 		}
 
 		jwtClaims["assurance"] = assertionResult.Context
+		if assertionResult.Context != nil {
+			aal = assertionResult.Context.AAL
+		}
 	}
 
 	// Include permissions in the JWT (see resolvePermissionsForClaim for the precedence chain).
@@ -170,8 +197,12 @@ func (a *authAssertExecutor) generateAuthAssertion(
 	if authReqID, exists := ctx.RuntimeData[common.RuntimeKeyAuthorizationRequestID]; exists && authReqID != "" {
 		jwtClaims[oauth2const.ClaimAuthorizationRequestID] = authReqID
 	}
+	if nonce, exists := ctx.RuntimeData[common.RuntimeKeyAuthorizationRequestNonce]; exists && nonce != "" {
+		jwtClaims[oauth2const.ClaimAuthorizationRequestNonce] = nonce
+	}
 
-	requiredAttributes := a.getRequiredUserAttributes(ctx)
+	requiredAttributes := appendLocalizedAttributeNames(a.getRequiredUserAttributes(ctx),
+		ctx.RuntimeData[common.RuntimeKeyRequiredLocales])
 
 	metadata := buildGetAttributesMetadata(ctx)
 
@@ -204,6 +235,14 @@ func (a *authAssertExecutor) generateAuthAssertion(
 
 	tokenSub = entityRef.EntityID
 
+	deviceID, deviceErr := a.recordDevice(ctx, tokenSub, aal, logger)
+	if deviceErr != nil {
+		return "", deviceErr
+	}
+	if deviceID != "" {
+		jwtClaims[oauth2const.ClaimDeviceID] = deviceID
+	}
+
 	fetchedAttributes := make(map[string]interface{})
 
 	if attrResp != nil && len(attrResp.Attributes) > 0 {
@@ -219,6 +258,13 @@ func (a *authAssertExecutor) generateAuthAssertion(
 	if attrErr != nil {
 		return "", attrErr
 	}
+	if ctx.RuntimeData[common.RuntimeKeyEnforceEssentialAttributes] == dataValueTrue {
+		if missing := getMissingEssentialAttributes(ctx, resolvedAttributes); len(missing) > 0 {
+			logger.Debug(ctx.Context, "Essential attributes could not be resolved for the user",
+				log.Any("missingAttributes", missing))
+			return "", errEssentialAttributesUnavailable
+		}
+	}
 
 	if ttlSecondsStr, exists := ctx.RuntimeData[common.RuntimeKeyUserAttributesCacheTTLSeconds]; exists {
 		// We are not in an App Native flow, so we need to cache the user attributes
@@ -260,9 +306,37 @@ func (a *authAssertExecutor) generateAuthAssertion(
 		return "", errors.New("failed to generate JWT token: " + err.Error.DefaultValue)
 	}
 
+	recordLoginAttempt(ctx, a.loginHistory, tokenSub, loginhistory.LoginAttempt{
+		Outcome:   loginhistory.LoginOutcomeSuccess,
+		Executors: getCompletedAuthExecutors(ctx.ExecutionHistory),
+	}, logger)
+
 	return token, nil
 }
 
+// recordDevice records the device the user signed in from and returns its ID, or an empty string
+// when devices are not tracked. Only the concurrent session limit fails the sign-in; any other
+// failure to record the device is logged.
+func (a *authAssertExecutor) recordDevice(ctx *providers.NodeContext, userID string,
+	aal assert.AssuranceLevel, logger *log.Logger) (string, error) {
+	if a.deviceService == nil {
+		return "", nil
+	}
+	recorded, err := a.deviceService.RecordDevice(ctx.Context, userID,
+		sysContext.GetUserAgent(ctx.Context), ctx.UserInputs[userInputDeviceID], aal)
+	if errors.Is(err, device.ErrSessionLimitReached) {
+		return "", err
+	}
+	if err != nil {
+		logger.Warn(ctx.Context, "Failed to record the sign-in device", log.Error(err))
+		return "", nil
+	}
+	if recorded == nil {
+		return "", nil
+	}
+	return recorded.ID, nil
+}
+
 // extractAuthenticatorReferences extracts authenticator references from execution history.
 func (a *authAssertExecutor) extractAuthenticatorReferences(
 	history map[string]*providers.NodeExecutionRecord) []authncm.AuthenticatorReference {
@@ -363,6 +437,36 @@ func (a *authAssertExecutor) getRequiredUserAttributes(ctx *providers.NodeContex
 	return []string{}
 }
 
+// appendLocalizedAttributeNames appends the localized variants of the given attributes for each of the
+// space-separated claims locales and their base languages, so that values stored as "<attribute>#<tag>"
+// are resolved along with the attribute and can be selected per locale when the tokens are issued.
+func appendLocalizedAttributeNames(attributes []string, claimsLocales string) []string {
+	locales := strings.Fields(claimsLocales)
+	if len(locales) == 0 || len(attributes) == 0 {
+		return attributes
+	}
+
+	tags := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		tags = append(tags, locale)
+		if baseLanguage, _, hasSubtags := strings.Cut(locale, "-"); hasSubtags {
+			tags = append(tags, baseLanguage)
+		}
+	}
+
+	result := slices.Clone(attributes)
+	for _, attr := range attributes {
+		for _, tag := range tags {
+			localizedName := attr + oauth2const.ClaimLanguageTagSeparator + tag
+			if !slices.Contains(result, localizedName) {
+				result = append(result, localizedName)
+			}
+		}
+	}
+
+	return result
+}
+
 // resolveUserAttributes resolves the user attributes map from the requested attributes.
 func (a *authAssertExecutor) resolveUserAttributes(
 	ctx *providers.NodeContext,
@@ -417,6 +521,25 @@ func (a *authAssertExecutor) resolveUserAttributes(
 	return attributes, nil
 }
 
+// getMissingEssentialAttributes returns the essential attributes required for the flow that were not
+// resolved for the user. Standard JWT claims are always issued and are never reported as missing.
+func getMissingEssentialAttributes(
+	ctx *providers.NodeContext, resolvedAttributes map[string]interface{}) []string {
+	standardClaims := oauth2const.GetStandardClaims()
+
+	missing := []string{}
+	for _, attr := range strings.Fields(ctx.RuntimeData[common.RuntimeKeyRequiredEssentialAttributes]) {
+		if slices.Contains(standardClaims, attr) {
+			continue
+		}
+		if _, ok := resolvedAttributes[attr]; !ok {
+			missing = append(missing, attr)
+		}
+	}
+
+	return missing
+}
+
 // appendComputedAttributes appends computed/derived attributes (groups, roles, userType, OU details) to the claims.
 func (a *authAssertExecutor) appendComputedAttributes(
 	ctx *providers.NodeContext,
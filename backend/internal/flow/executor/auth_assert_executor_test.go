@@ -24,6 +24,7 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	"context"
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,14 +34,19 @@ import (
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	authnassert "github.com/thunder-id/thunderid/internal/authn/assert"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
+	"github.com/thunder-id/thunderid/internal/device"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/assertmock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/loginhistorymock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/devicemock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
@@ -65,6 +71,7 @@ type AuthAssertExecutorTestSuite struct {
 	mockFlowFactory       *coremock.FlowFactoryInterfaceMock
 	mockAttributeCacheSvc *attributecachemock.AttributeCacheServiceInterfaceMock
 	mockRoleService       *rolemock.RoleServiceInterfaceMock
+	mockDeviceService     *devicemock.DeviceServiceInterfaceMock
 	executor              *authAssertExecutor
 }
 
@@ -84,6 +91,9 @@ func (suite *AuthAssertExecutorTestSuite) SetupTest() {
 	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
 	suite.mockAttributeCacheSvc = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
 	suite.mockRoleService = rolemock.NewRoleServiceInterfaceMock(suite.T())
+	suite.mockDeviceService = devicemock.NewDeviceServiceInterfaceMock(suite.T())
+	suite.mockDeviceService.On("RecordDevice", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(nil, nil).Maybe()
 
 	mockExec := createMockExecutorSimple(suite.T(), ExecutorNameAuthAssert, providers.ExecutorTypeUtility)
 	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameAuthAssert, providers.ExecutorTypeUtility,
@@ -91,7 +101,7 @@ func (suite *AuthAssertExecutorTestSuite) SetupTest() {
 
 	suite.executor = newAuthAssertExecutor(suite.mockFlowFactory, suite.mockJWTService,
 		suite.mockOUService, suite.mockAssertGenerator, suite.mockAuthnProvider, suite.mockEntityProvider,
-		suite.mockAttributeCacheSvc, suite.mockRoleService)
+		suite.mockAttributeCacheSvc, suite.mockRoleService, suite.mockDeviceService, nil)
 }
 
 func createMockExecutorSimple(t *testing.T, name string,
@@ -252,6 +262,230 @@ func (suite *AuthAssertExecutorTestSuite) TestExecute_WithAuthorizedPermissions(
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *AuthAssertExecutorTestSuite) TestExecute_BindsAuthorizationRequest() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		AuthUser:    newTestAuthenticatedAuthUser(),
+		RuntimeData: map[string]string{
+			common.RuntimeKeyAuthorizationRequestID:    "auth-req-123",
+			common.RuntimeKeyAuthorizationRequestNonce: "nonce-123",
+		},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+		Application:      providers.Application{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.setupGetUserAttributesEmpty()
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[oauth2const.ClaimAuthorizationRequestID] == "auth-req-123" &&
+				claims[oauth2const.ClaimAuthorizationRequestNonce] == "nonce-123" &&
+				claims["aud"] == "app-123"
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_BindsSignInDevice() {
+	ctx := &providers.NodeContext{
+		Context:          sysContext.WithUserAgent(context.Background(), "test-agent"),
+		ExecutionID:      "flow-123",
+		EntityID:         "app-123",
+		FlowType:         providers.FlowTypeAuthentication,
+		AuthUser:         newTestAuthenticatedAuthUser(),
+		UserInputs:       map[string]string{userInputDeviceID: "client-device"},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+		Application:      providers.Application{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.setupGetUserAttributesEmpty()
+	suite.mockDeviceService = devicemock.NewDeviceServiceInterfaceMock(suite.T())
+	suite.mockDeviceService.On("RecordDevice", mock.Anything, "user-123", "test-agent", "client-device",
+		authnassert.AALUnknown).Return(&device.Device{ID: "device-1"}, nil)
+	suite.executor.deviceService = suite.mockDeviceService
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[oauth2const.ClaimDeviceID] == "device-1"
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_ConcurrentSessionLimitReached() {
+	ctx := &providers.NodeContext{
+		Context:          sysContext.WithUserAgent(context.Background(), "test-agent"),
+		ExecutionID:      "flow-123",
+		EntityID:         "app-123",
+		FlowType:         providers.FlowTypeAuthentication,
+		AuthUser:         newTestAuthenticatedAuthUser(),
+		UserInputs:       map[string]string{userInputDeviceID: "client-device"},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+		Application:      providers.Application{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.setupGetUserAttributesEmpty()
+	suite.mockDeviceService = devicemock.NewDeviceServiceInterfaceMock(suite.T())
+	suite.mockDeviceService.On("RecordDevice", mock.Anything, "user-123", "test-agent", "client-device",
+		authnassert.AALUnknown).Return(nil, device.ErrSessionLimitReached)
+	suite.executor.deviceService = suite.mockDeviceService
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), &ErrConcurrentSessionLimitReached, resp.Error)
+	assert.Empty(suite.T(), resp.Assertion)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_EssentialAttributesUnavailable() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		Context:     context.Background(),
+		AuthUser:    newTestAuthenticatedAuthUser(),
+		RuntimeData: map[string]string{
+			common.RuntimeKeyRequiredEssentialAttributes: "email phone_number",
+			common.RuntimeKeyEnforceEssentialAttributes:  dataValueTrue,
+		},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.setupGetUserAttributesWith(map[string]*providers.AttributeResponse{
+		"email": {Value: testEmail},
+	})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), &ErrEssentialAttributesUnavailable, resp.Error)
+	assert.Empty(suite.T(), resp.Assertion)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_EssentialAttributesEnforced_AllResolved() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		Context:     context.Background(),
+		AuthUser:    newTestAuthenticatedAuthUser(),
+		RuntimeData: map[string]string{
+			common.RuntimeKeyRequiredEssentialAttributes: "email sub",
+			common.RuntimeKeyEnforceEssentialAttributes:  dataValueTrue,
+		},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.setupGetUserAttributesWith(map[string]*providers.AttributeResponse{
+		"email": {Value: testEmail},
+	})
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["email"] == testEmail
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "jwt-token", resp.Assertion)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_EssentialAttributesNotEnforced_MissingOmitted() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		Context:     context.Background(),
+		AuthUser:    newTestAuthenticatedAuthUser(),
+		RuntimeData: map[string]string{
+			common.RuntimeKeyRequiredEssentialAttributes: "email phone_number",
+		},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.setupGetUserAttributesWith(map[string]*providers.AttributeResponse{
+		"email": {Value: testEmail},
+	})
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, hasPhone := claims["phone_number"]
+			return claims["email"] == testEmail && !hasPhone
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_RecordsSuccessfulSignIn() {
+	ctx := &providers.NodeContext{
+		Context:     sysContext.WithClientIP(context.Background(), netip.MustParseAddr("203.0.113.7")),
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		AuthUser:    newTestAuthenticatedAuthUser(),
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{
+			"node2": {
+				ExecutorName: ExecutorNameOTPExecutor,
+				ExecutorType: providers.ExecutorTypeAuthentication,
+				Status:       providers.FlowStatusComplete,
+				Step:         2,
+			},
+			"node1": {
+				ExecutorName: ExecutorNameCredentialsAuth,
+				ExecutorType: providers.ExecutorTypeAuthentication,
+				Status:       providers.FlowStatusComplete,
+				Step:         1,
+			},
+		},
+		Application: providers.Application{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.setupGetUserAttributesEmpty()
+	suite.mockAssertGenerator.On("GenerateAssertion", mock.Anything, mock.Anything).
+		Return(&authnassert.AssertionResult{Context: &authnassert.AssuranceContext{}}, nil)
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+	mockLoginHistory := loginhistorymock.NewLoginHistoryServiceInterfaceMock(suite.T())
+	mockLoginHistory.On("RecordAttempt", mock.Anything, "user-123", loginhistory.LoginAttempt{
+		ApplicationID: "app-123",
+		IPAddress:     "203.0.113.7",
+		Outcome:       loginhistory.LoginOutcomeSuccess,
+		Executors:     []string{ExecutorNameCredentialsAuth, ExecutorNameOTPExecutor},
+	}).Return(nil)
+	suite.executor.loginHistory = mockLoginHistory
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	mockLoginHistory.AssertExpectations(suite.T())
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestExecute_WithUserAttributes() {
 	ctx := &providers.NodeContext{
 		ExecutionID:      "flow-123",
@@ -943,6 +1177,83 @@ func (suite *AuthAssertExecutorTestSuite) TestGetRequiredUserAttributes_NoRuntim
 	assert.Empty(suite.T(), result)
 }
 
+func (suite *AuthAssertExecutorTestSuite) TestAppendLocalizedAttributeNames() {
+	testCases := []struct {
+		name          string
+		attributes    []string
+		claimsLocales string
+		expected      []string
+	}{
+		{
+			name:          "NoLocales",
+			attributes:    []string{"name", "email"},
+			claimsLocales: "",
+			expected:      []string{"name", "email"},
+		},
+		{
+			name:          "LocalesWithBaseLanguage",
+			attributes:    []string{"name"},
+			claimsLocales: "ja-JP fr",
+			expected:      []string{"name", "name#ja-JP", "name#ja", "name#fr"},
+		},
+		{
+			name:          "DuplicateBaseLanguage",
+			attributes:    []string{"name"},
+			claimsLocales: "en-US en-GB",
+			expected:      []string{"name", "name#en-US", "name#en", "name#en-GB"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			result := appendLocalizedAttributeNames(tc.attributes, tc.claimsLocales)
+			assert.Equal(suite.T(), tc.expected, result)
+		})
+	}
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_WithClaimsLocales_CachesLocalizedAttributes() {
+	ctx := &providers.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		Context:     context.Background(),
+		AuthUser:    newTestAuthenticatedAuthUser(),
+		RuntimeData: map[string]string{
+			common.RuntimeKeyUserAttributesCacheTTLSeconds: "300",
+			common.RuntimeKeyRequiredOptionalAttributes:    "name",
+			common.RuntimeKeyRequiredLocales:               "ja",
+		},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+	}
+
+	suite.setupGetEntityReference("", "")
+	suite.mockAuthnProvider.On("GetUserAttributes", mock.Anything,
+		mock.MatchedBy(func(reqAttrs *providers.RequestedAttributes) bool {
+			_, hasName := reqAttrs.Attributes["name"]
+			_, hasLocalizedName := reqAttrs.Attributes["name#ja"]
+			return hasName && hasLocalizedName
+		}), mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, &providers.AttributesResponse{
+			Attributes: map[string]*providers.AttributeResponse{
+				"name":    {Value: "Taro Yamada"},
+				"name#ja": {Value: "山田太郎"},
+			},
+		}, (*tidcommon.ServiceError)(nil))
+	suite.mockAttributeCacheSvc.On("CreateAttributeCache", mock.Anything,
+		mock.MatchedBy(func(cache *attributecache.AttributeCache) bool {
+			return cache.Attributes["name"] == "Taro Yamada" && cache.Attributes["name#ja"] == "山田太郎"
+		})).Return(&attributecache.AttributeCache{ID: "cache-ja"}, nil)
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	suite.mockAuthnProvider.AssertExpectations(suite.T())
+	suite.mockAttributeCacheSvc.AssertExpectations(suite.T())
+}
+
 // ----- Execute with Consented Attributes in RuntimeData -----
 
 func (suite *AuthAssertExecutorTestSuite) TestExecute_WithConsentedAttributes_FiltersUserAttrs() {
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"errors"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// certificateAuthExecutor signs the user in with the X.509 client certificate presented during the
+// TLS handshake, such as a smart card certificate. The certificate identifies the user, so the
+// executor has no inputs or prerequisites.
+type certificateAuthExecutor struct {
+	providers.Executor
+	authnProvider providers.AuthnProviderManager
+	logger        *log.Logger
+}
+
+var _ providers.Executor = (*certificateAuthExecutor)(nil)
+
+// newCertificateAuthExecutor creates a new instance of certificateAuthExecutor.
+func newCertificateAuthExecutor(
+	flowFactory core.FlowFactoryInterface,
+	authnProvider providers.AuthnProviderManager,
+) *certificateAuthExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, "CertificateAuthExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameCertificateAuth),
+	)
+
+	base := flowFactory.CreateExecutor(ExecutorNameCertificateAuth, providers.ExecutorTypeAuthentication,
+		[]providers.Input{}, []providers.Input{})
+
+	return &certificateAuthExecutor{
+		Executor:      base,
+		authnProvider: authnProvider,
+		logger:        logger,
+	}
+}
+
+// Execute authenticates the client certificate of the request and resolves its user.
+func (e *certificateAuthExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	certificates := sysContext.GetClientCertificates(ctx.Context)
+	if len(certificates) == 0 {
+		logger.Debug(ctx.Context, "No client certificate presented")
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrCertificateNotPresented
+		return execResp, nil
+	}
+
+	credentials := map[string]interface{}{
+		"certificate": &authncommon.CertificateCredential{Certificates: certificates},
+	}
+	authUser, authenticatedClaims, svcErr := e.authnProvider.AuthenticateUser(
+		ctx.Context, nil, credentials, nil, nil, execResp.AuthUser)
+	if svcErr != nil {
+		if svcErr.Type != tidcommon.ClientErrorType {
+			return execResp, errors.New("failed to authenticate the client certificate")
+		}
+		logger.Debug(ctx.Context, "Certificate authentication through provider failed",
+			log.String("errorCode", svcErr.Code))
+		execResp.Status = providers.ExecFailure
+		if svcErr.Code == authnprovidermgr.ErrorUserNotFound.Code {
+			execResp.Error = &ErrUserNotFound
+		} else {
+			execResp.Error = &ErrCertificateAuthenticationFailed
+		}
+		return execResp, nil
+	}
+	// A certificate that is valid but does not match a user leaves the user unresolved.
+	if authUser.EntityReference() == nil {
+		logger.Debug(ctx.Context, "No user matches the client certificate")
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrUserNotFound
+		return execResp, nil
+	}
+
+	execResp.AuthUser = authUser
+	for key, value := range authenticatedClaims {
+		execResp.RuntimeData[key] = systemutils.ConvertInterfaceValueToString(value)
+	}
+	execResp.Status = providers.ExecComplete
+	return execResp, nil
+}
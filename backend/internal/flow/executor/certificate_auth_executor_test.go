@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"crypto/x509"
+	"math/big"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type CertificateAuthExecutorTestSuite struct {
+	suite.Suite
+	mockAuthnProvider *managermock.AuthnProviderManagerMock
+	executor          *certificateAuthExecutor
+	certificates      []*x509.Certificate
+}
+
+func TestCertificateAuthExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(CertificateAuthExecutorTestSuite))
+}
+
+func (suite *CertificateAuthExecutorTestSuite) SetupTest() {
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerMock(suite.T())
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+
+	mockExec := coremock.NewExecutorInterfaceMock(suite.T())
+	mockExec.On("GetName").Return(ExecutorNameCertificateAuth).Maybe()
+	mockFlowFactory.On("CreateExecutor", ExecutorNameCertificateAuth, providers.ExecutorTypeAuthentication,
+		[]providers.Input{}, []providers.Input{}).Return(mockExec)
+
+	suite.executor = newCertificateAuthExecutor(mockFlowFactory, suite.mockAuthnProvider)
+	suite.certificates = []*x509.Certificate{{SerialNumber: big.NewInt(1)}}
+}
+
+func (suite *CertificateAuthExecutorTestSuite) buildNodeContext(
+	certificates []*x509.Certificate) *providers.NodeContext {
+	return &providers.NodeContext{
+		Context:     sysContext.WithClientCertificates(context.Background(), certificates),
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs:  map[string]string{},
+		RuntimeData: map[string]string{},
+	}
+}
+
+// expectAuthenticate expects the client certificates to be passed to the authn provider.
+func (suite *CertificateAuthExecutorTestSuite) expectAuthenticate() *mock.Call {
+	return suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(credentials map[string]interface{}) bool {
+			cred, ok := credentials["certificate"].(*authncommon.CertificateCredential)
+			return ok && len(cred.Certificates) == 1 && cred.Certificates[0] == suite.certificates[0]
+		}), mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CertificateAuthExecutorTestSuite) TestExecute_Success() {
+	authUser := buildConsentAuthUser()
+	authUser.SetEntityReference(&providers.EntityReference{EntityID: testUserID})
+	suite.expectAuthenticate().
+		Return(authUser, providers.AuthenticatedClaims{"username": "alice"}, nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(suite.certificates))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+	suite.Equal(testUserID, resp.AuthUser.EntityReference().EntityID)
+	suite.Equal("alice", resp.RuntimeData["username"])
+}
+
+func (suite *CertificateAuthExecutorTestSuite) TestExecute_NoCertificate() {
+	resp, err := suite.executor.Execute(suite.buildNodeContext(nil))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(ErrCertificateNotPresented.Code, resp.Error.Code)
+	suite.mockAuthnProvider.AssertNotCalled(suite.T(), "AuthenticateUser")
+}
+
+func (suite *CertificateAuthExecutorTestSuite) TestExecute_CertificateRejected() {
+	suite.expectAuthenticate().
+		Return(providers.AuthUser{}, nil, &authnprovidermgr.ErrorAuthenticationFailed)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(suite.certificates))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(ErrCertificateAuthenticationFailed.Code, resp.Error.Code)
+}
+
+func (suite *CertificateAuthExecutorTestSuite) TestExecute_UserNotFound() {
+	// A valid certificate whose identity matches no user leaves the entity reference unresolved.
+	suite.expectAuthenticate().
+		Return(buildConsentAuthUser(), providers.AuthenticatedClaims{"username": "alice"}, nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(suite.certificates))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(ErrUserNotFound.Code, resp.Error.Code)
+	suite.Empty(resp.RuntimeData)
+}
+
+func (suite *CertificateAuthExecutorTestSuite) TestExecute_ServerError() {
+	suite.expectAuthenticate().Return(providers.AuthUser{}, nil, &tidcommon.InternalServerError)
+
+	_, err := suite.executor.Execute(suite.buildNodeContext(suite.certificates))
+
+	suite.Error(err)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
@@ -45,6 +46,7 @@ type consentExecutor struct {
 	providers.Executor
 	consentEnforcer providers.ConsentProvider
 	authnProvider   providers.AuthnProviderManager
+	scopeService    scope.ScopeServiceInterface
 	logger          *log.Logger
 }
 
@@ -55,6 +57,7 @@ func newConsentExecutor(
 	flowFactory core.FlowFactoryInterface,
 	consentEnforcer providers.ConsentProvider,
 	authnProvider providers.AuthnProviderManager,
+	scopeService scope.ScopeServiceInterface,
 ) *consentExecutor {
 	logger := log.GetLogger().With(
 		log.String(log.LoggerKeyComponentName, "ConsentExecutor"),
@@ -82,6 +85,7 @@ func newConsentExecutor(
 		Executor:        base,
 		consentEnforcer: consentEnforcer,
 		authnProvider:   authnProvider,
+		scopeService:    scopeService,
 		logger:          logger,
 	}
 }
@@ -171,7 +175,14 @@ func (e *consentExecutor) checkConsent(ctx *providers.NodeContext, execResp *pro
 	}
 
 	// All consents are active — nothing to prompt
-	if promptData == nil {
+	if promptData == nil || len(promptData.Purposes) == 0 {
+		// Permissions the user declined earlier stay excluded from the grant without a new prompt
+		if promptData != nil && len(promptData.DeclinedPermissions) > 0 {
+			consentedPerms := slices.DeleteFunc(authorizedPermissions, func(p string) bool {
+				return slices.Contains(promptData.DeclinedPermissions, p)
+			})
+			execResp.RuntimeData[common.RuntimeKeyConsentedPermissions] = strings.Join(consentedPerms, " ")
+		}
 		logger.Debug(ctx.Context, "All required consents are active; completing consent executor")
 		execResp.Status = providers.ExecComplete
 		return execResp, nil
@@ -186,6 +197,7 @@ func (e *consentExecutor) checkConsent(ctx *providers.NodeContext, execResp *pro
 
 	execResp.ForwardedData[common.ForwardedDataKeyConsentPrompt] = promptData.Purposes
 	execResp.AdditionalData[common.DataConsentPrompt] = string(promptJSON)
+	e.appendConsentScopes(ctx, execResp)
 
 	// Store the session token in RuntimeData for validation during consent recording
 	if promptData.SessionToken != "" {
@@ -210,6 +222,38 @@ func (e *consentExecutor) checkConsent(ctx *providers.NodeContext, execResp *pro
 	return execResp, nil
 }
 
+// appendConsentScopes adds the definitions of the requested scopes to the consent prompt data, so the
+// consent screen can show the display name and description of each scope. Requested scopes without a
+// definition are omitted, and a failure to load the definitions does not block the consent prompt.
+func (e *consentExecutor) appendConsentScopes(ctx *providers.NodeContext, execResp *providers.ExecutorResponse) {
+	if e.scopeService == nil {
+		return
+	}
+
+	names := append(strings.Fields(ctx.RuntimeData[common.RuntimeKeyRequestedScopes]),
+		strings.Fields(ctx.RuntimeData[common.RuntimeKeyRequestedPermissions])...)
+	if len(names) == 0 {
+		return
+	}
+
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	scopes, svcErr := e.scopeService.GetScopesByNames(ctx.Context, names)
+	if svcErr != nil {
+		logger.Warn(ctx.Context, "Failed to get scope definitions for the consent prompt", log.Any("error", svcErr))
+		return
+	}
+	if len(scopes) == 0 {
+		return
+	}
+
+	scopesJSON, err := json.Marshal(scopes)
+	if err != nil {
+		logger.Warn(ctx.Context, "Failed to marshal scope definitions for the consent prompt", log.Error(err))
+		return
+	}
+	execResp.AdditionalData[common.DataConsentScopes] = string(scopesJSON)
+}
+
 // handleConsentDecisions processes the user's consent decisions.
 func (e *consentExecutor) handleConsentDecisions(ctx *providers.NodeContext, execResp *providers.ExecutorResponse,
 	ouID, appID, userID string) (*providers.ExecutorResponse, error) {
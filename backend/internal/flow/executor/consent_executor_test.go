@@ -35,9 +35,11 @@ import (
 	consentauthn "github.com/thunder-id/thunderid/internal/authn/consent"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/consentprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/scopemock"
 )
 
 const (
@@ -65,7 +67,7 @@ func (suite *ConsentExecutorTestSuite) SetupTest() {
 	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameConsent, providers.ExecutorTypeUtility,
 		mock.AnythingOfType("[]providers.Input"), mock.AnythingOfType("[]providers.Input")).Return(mockExec)
 
-	suite.executor = newConsentExecutor(suite.mockFlowFactory, suite.mockConsentEnforcer, suite.mockAuthnProvider)
+	suite.executor = newConsentExecutor(suite.mockFlowFactory, suite.mockConsentEnforcer, suite.mockAuthnProvider, nil)
 }
 
 // createMockExecutorWithInputs creates a mock executor that supports ValidatePrerequisites and HasRequiredInputs
@@ -204,6 +206,54 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AllConsentsActive()
 	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
 }
 
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_AllConsentsActive_DoesNotSetConsentedPermissions() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData["authorized_permissions"] = "read:docs write:docs"
+	suite.setupDefaultAuthnProviderMocks()
+
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*providers.ExecutorResponse"), mock.Anything).Return(true)
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*providers.ExecutorResponse")).Return(false)
+
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "", "user-123",
+		mock.Anything, mock.Anything, []string{"read:docs", "write:docs"}, mock.Anything, mock.Anything,
+		mock.Anything).Return(nil, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.NotContains(suite.T(), resp.RuntimeData, common.RuntimeKeyConsentedPermissions)
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PreviouslyDeclinedPermissions() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData["authorized_permissions"] = "read:docs write:docs delete:docs"
+	suite.setupDefaultAuthnProviderMocks()
+
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*providers.ExecutorResponse"), mock.Anything).Return(true)
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*providers.ExecutorResponse")).Return(false)
+
+	// No purposes to prompt, but the user declined some of the authorized permissions earlier
+	promptData := &providers.ConsentPromptData{
+		DeclinedPermissions: []string{"write:docs", "delete:docs"},
+	}
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "", "user-123",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(promptData, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "read:docs", resp.RuntimeData[common.RuntimeKeyConsentedPermissions])
+	assert.NotContains(suite.T(), resp.AdditionalData, common.DataConsentPrompt)
+	assert.NotContains(suite.T(), resp.RuntimeData, common.RuntimeKeyConsentSessionToken)
+}
+
 func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_ForceRepromptFromRuntimeData() {
 	ctx := buildConsentNodeContext()
 	ctx.RuntimeData[common.RuntimeKeyForceConsentReprompt] = "true"
@@ -424,6 +474,72 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_NoTim
 	assert.Equal(suite.T(), "app:app-123:attrs", parsedPrompt[0].PurposeName)
 }
 
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_IncludesScopeDefinitions() {
+	scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+	suite.executor.scopeService = scopeService
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedScopes] = "openid orders"
+	ctx.RuntimeData[common.RuntimeKeyRequestedPermissions] = "billing:read"
+	suite.setupDefaultAuthnProviderMocks()
+
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*providers.ExecutorResponse"), mock.Anything).Return(true)
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*providers.ExecutorResponse")).Return(false)
+
+	promptData := &providers.ConsentPromptData{
+		Purposes: []providers.ConsentPurposePrompt{{
+			PurposeName: "attributes:test-app",
+			Optional:    []providers.PromptElement{{Name: "email"}},
+		}},
+	}
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "", "user-123",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(promptData, nil)
+	scopeService.On("GetScopesByNames", mock.Anything, []string{"openid", "orders", "billing:read"}).
+		Return([]scope.Scope{{ID: "s1", Name: "orders", DisplayName: "Orders",
+			Description: "View your orders", Claims: []string{"order_id"}}}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	var scopes []scope.Scope
+	assert.NoError(suite.T(), json.Unmarshal([]byte(resp.AdditionalData[common.DataConsentScopes]), &scopes))
+	assert.Len(suite.T(), scopes, 1)
+	assert.Equal(suite.T(), "Orders", scopes[0].DisplayName)
+	assert.Equal(suite.T(), "View your orders", scopes[0].Description)
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_ScopeLookupFailureDoesNotBlock() {
+	scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+	suite.executor.scopeService = scopeService
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedScopes] = "orders"
+	suite.setupDefaultAuthnProviderMocks()
+
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*providers.ExecutorResponse"), mock.Anything).Return(true)
+	suite.executor.Executor.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*providers.ExecutorResponse")).Return(false)
+
+	promptData := &providers.ConsentPromptData{
+		Purposes: []providers.ConsentPurposePrompt{{PurposeName: "attributes:test-app"}},
+	}
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "", "user-123",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(promptData, nil)
+	scopeService.On("GetScopesByNames", mock.Anything, []string{"orders"}).
+		Return(nil, &tidcommon.InternalServerError)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.NotEmpty(suite.T(), resp.AdditionalData[common.DataConsentPrompt])
+	assert.Empty(suite.T(), resp.AdditionalData[common.DataConsentScopes])
+}
+
 func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_StoresSessionToken() {
 	ctx := buildConsentNodeContext()
 	suite.setupDefaultAuthnProviderMocks()
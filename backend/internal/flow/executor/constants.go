@@ -37,6 +37,7 @@ const (
 	ExecutorNamePermissionValidator          = "PermissionValidator"
 	ExecutorNameOUCreation                   = "OUExecutor"
 	ExecutorNameHTTPRequest                  = "HTTPRequestExecutor"
+	ExecutorNameExternalTask                 = "ExternalTaskExecutor"
 	ExecutorNameUserTypeResolver             = "UserTypeResolver"
 	ExecutorNameInviteExecutor               = "InviteExecutor"
 	ExecutorNameEmailExecutor                = "EmailExecutor"
@@ -47,6 +48,12 @@ const (
 	ExecutorNameSMSExecutor                  = "SMSExecutor"
 	ExecutorNameFederatedAuthResolver        = "FederatedAuthResolverExecutor"
 	ExecutorNameOTPExecutor                  = "OTPExecutor"
+	ExecutorNamePolicyAcceptance             = "PolicyAcceptanceExecutor"
+	ExecutorNameHRD                          = "HRDExecutor"
+	ExecutorNameAnomalyDetection             = "AnomalyDetectionExecutor"
+	ExecutorNamePushAuth                     = "PushAuthExecutor"
+	ExecutorNameCrossDeviceAuth              = "CrossDeviceAuthExecutor"
+	ExecutorNameCertificateAuth              = "CertificateAuthExecutor"
 )
 
 // Executor mode constants
@@ -80,6 +87,8 @@ const (
 	userInputMagicLinkToken   = "token"
 	userInputConsentDecisions = "consent_decisions"
 	userInputLoginHint        = "login_hint"
+	userInputAcceptedPolicies = "accepted_policies"
+	userInputDeviceID         = "deviceId"
 
 	ouIDKey        = "ouId"
 	defaultOUIDKey = "defaultOUID"
@@ -113,6 +122,9 @@ const (
 	propertyKeyCallbackType                            = "callbackType"
 	propertyKeyLoginHintAttribute                      = "loginHintAttribute"
 	propertyKeyMaxOTPAttempts                          = "maxAttempts"
+	propertyKeyPolicies                                = "policies"
+	propertyKeyDomainMappings                          = "domainMappings"
+	propertyKeyFailOnAnomaly                           = "failOnAnomaly"
 )
 
 // nonSearchableInputs contains the list of user inputs/ attributes that are non-searchable.
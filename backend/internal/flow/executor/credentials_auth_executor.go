@@ -26,6 +26,7 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/core"
@@ -38,6 +39,7 @@ type credentialsAuthExecutor struct {
 	identifyingExecutorInterface
 	entityProvider entityprovider.EntityProviderInterface
 	authnProvider  providers.AuthnProviderManager
+	loginHistory   loginhistory.LoginHistoryServiceInterface
 	logger         *log.Logger
 }
 
@@ -49,6 +51,7 @@ func newCredentialsAuthExecutor(
 	flowFactory core.FlowFactoryInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	authnProvider providers.AuthnProviderManager,
+	loginHistory loginhistory.LoginHistoryServiceInterface,
 ) *credentialsAuthExecutor {
 	defaultInputs := []providers.Input{
 		{
@@ -76,6 +79,7 @@ func newCredentialsAuthExecutor(
 		identifyingExecutorInterface: identifyExec,
 		entityProvider:               entityProvider,
 		authnProvider:                authnProvider,
+		loginHistory:                 loginHistory,
 		logger:                       logger,
 	}
 }
@@ -217,10 +221,16 @@ func (b *credentialsAuthExecutor) authenticateUser(ctx *providers.NodeContext,
 				execResp.Error = &ErrUserNotFound
 			case authnprovidermgr.ErrorAuthenticationFailed.Code:
 				execResp.Error = &ErrInvalidCredentials
+			case authnprovidermgr.ErrorUserNotActive.Code:
+				execResp.Error = &ErrUserAccountNotActive
 			default:
 				execResp.Error = &ErrUserAuthFailed
 			}
 
+			if svcErr.Code == authnprovidermgr.ErrorAuthenticationFailed.Code ||
+				svcErr.Code == authnprovidermgr.ErrorUserNotActive.Code {
+				b.recordFailedAttempt(ctx, userIdentifiers, execResp.Error, logger)
+			}
 			return nil
 		}
 
@@ -236,3 +246,23 @@ func (b *credentialsAuthExecutor) authenticateUser(ctx *providers.NodeContext,
 
 	return nil
 }
+
+// recordFailedAttempt records a failed sign-in attempt in the login history of the identified user.
+// Attempts for identifiers that do not match a user are not recorded.
+func (b *credentialsAuthExecutor) recordFailedAttempt(ctx *providers.NodeContext,
+	userIdentifiers map[string]interface{}, failure *tidcommon.ServiceError, logger *log.Logger) {
+	if b.loginHistory == nil {
+		return
+	}
+	userID, err := b.IdentifyUser(ctx.Context, userIdentifiers, &providers.ExecutorResponse{})
+	if err != nil || userID == nil {
+		return
+	}
+
+	recordLoginAttempt(ctx, b.loginHistory, *userID, loginhistory.LoginAttempt{
+		Outcome:       loginhistory.LoginOutcomeFailure,
+		FailureCode:   failure.Code,
+		FailureReason: failure.Error.DefaultValue,
+		Executors:     []string{ExecutorNameCredentialsAuth},
+	}, logger)
+}
@@ -22,14 +22,19 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"context"
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/loginhistorymock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
@@ -67,7 +72,7 @@ func (suite *CredentialsAuthExecutorTestSuite) SetupTest() {
 		defaultInputs, []providers.Input{}).Return(mockExec)
 
 	suite.executor = newCredentialsAuthExecutor(suite.mockFlowFactory, suite.mockEntityProvider,
-		suite.mockAuthnProvider)
+		suite.mockAuthnProvider, nil)
 }
 
 // newCredentialsAuthAuthenticatedUser creates an AuthUser that returns true for IsAuthenticated().
@@ -584,6 +589,14 @@ func (suite *CredentialsAuthExecutorTestSuite) TestExecute_RetryableAuthenticati
 			expectedErrorCode: ErrUserNotFound.Code,
 			message:           "Should return specific failure reason for user not found",
 		},
+		{
+			name:              "User not active",
+			username:          "lockeduser",
+			password:          "password123",
+			errorCode:         authnprovidermgr.ErrorUserNotActive.Code,
+			expectedErrorCode: ErrUserAccountNotActive.Code,
+			message:           "Should return specific failure reason for an inactive account",
+		},
 	}
 
 	for _, tt := range tests {
@@ -703,3 +716,72 @@ func (suite *CredentialsAuthExecutorTestSuite) TestExecute_PreResolvedUser_WithP
 	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
 	assert.True(suite.T(), resp.AuthUser.IsAuthenticated())
 }
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_InvalidCredentials_RecordsFailedAttempt() {
+	ctx := &providers.NodeContext{
+		Context:     sysContext.WithClientIP(context.Background(), netip.MustParseAddr("203.0.113.7")),
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "testuser",
+			userAttributePassword: "wrongpassword",
+		},
+		RuntimeData: make(map[string]string),
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(providers.AuthUser{},
+		(providers.AuthenticatedClaims)(nil), &tidcommon.ServiceError{
+			Type: tidcommon.ClientErrorType,
+			Code: authnprovidermgr.ErrorAuthenticationFailed.Code,
+		})
+	userID := "user-123"
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{
+		userAttributeUsername: "testuser",
+	}).Return(&userID, nil)
+	mockLoginHistory := loginhistorymock.NewLoginHistoryServiceInterfaceMock(suite.T())
+	mockLoginHistory.On("RecordAttempt", mock.Anything, "user-123", loginhistory.LoginAttempt{
+		ApplicationID: "app-123",
+		IPAddress:     "203.0.113.7",
+		Outcome:       loginhistory.LoginOutcomeFailure,
+		FailureCode:   ErrInvalidCredentials.Code,
+		FailureReason: ErrInvalidCredentials.Error.DefaultValue,
+		Executors:     []string{ExecutorNameCredentialsAuth},
+	}).Return(nil)
+	suite.executor.loginHistory = mockLoginHistory
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), ErrInvalidCredentials.Code, resp.Error.Code)
+	mockLoginHistory.AssertExpectations(suite.T())
+}
+
+func (suite *CredentialsAuthExecutorTestSuite) TestExecute_UnknownUser_DoesNotRecordAttempt() {
+	ctx := &providers.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "unknown",
+			userAttributePassword: "password",
+		},
+		RuntimeData: make(map[string]string),
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(providers.AuthUser{},
+		(providers.AuthenticatedClaims)(nil), &tidcommon.ServiceError{
+			Type: tidcommon.ClientErrorType,
+			Code: authnprovidermgr.ErrorUserNotFound.Code,
+		})
+	mockLoginHistory := loginhistorymock.NewLoginHistoryServiceInterfaceMock(suite.T())
+	suite.executor.loginHistory = mockLoginHistory
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), ErrUserNotFound.Code, resp.Error.Code)
+	mockLoginHistory.AssertNotCalled(suite.T(), "RecordAttempt", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/crossdevice"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// crossDeviceAuthExecutor signs the user in with a QR code. It creates a sign-in request whose
+// approval URI the client shows as a QR code, and then polls until the request is approved on a
+// device on which the user is already signed in. The user is identified by that approval, so the
+// executor has no prerequisites.
+type crossDeviceAuthExecutor struct {
+	providers.Executor
+	crossDeviceService crossdevice.CrossDeviceAuthServiceInterface
+	authnProvider      providers.AuthnProviderManager
+	logger             *log.Logger
+}
+
+var _ providers.Executor = (*crossDeviceAuthExecutor)(nil)
+var _ AsyncStepExecutor = (*crossDeviceAuthExecutor)(nil)
+
+// newCrossDeviceAuthExecutor creates a new instance of crossDeviceAuthExecutor.
+func newCrossDeviceAuthExecutor(
+	flowFactory core.FlowFactoryInterface,
+	crossDeviceService crossdevice.CrossDeviceAuthServiceInterface,
+	authnProvider providers.AuthnProviderManager,
+) *crossDeviceAuthExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, "CrossDeviceAuthExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameCrossDeviceAuth),
+	)
+
+	base := flowFactory.CreateExecutor(ExecutorNameCrossDeviceAuth, providers.ExecutorTypeAuthentication,
+		[]providers.Input{}, []providers.Input{})
+
+	return &crossDeviceAuthExecutor{
+		Executor:           base,
+		crossDeviceService: crossDeviceService,
+		authnProvider:      authnProvider,
+		logger:             logger,
+	}
+}
+
+// Execute creates the sign-in request on first entry and polls for the approval on subsequent entries.
+func (e *crossDeviceAuthExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	reference := ctx.RuntimeData[common.RuntimeKeyCrossDeviceReference]
+	if reference == "" {
+		return e.initiate(ctx, execResp, logger)
+	}
+	return e.poll(ctx, reference, execResp, logger)
+}
+
+// initiate creates a new sign-in request and returns the approval URI to show as a QR code. The
+// application, IP address, and user agent of the client are shown to the user who scans the code, so
+// that a QR code relayed from another sign-in page can be recognized.
+func (e *crossDeviceAuthExecutor) initiate(
+	ctx *providers.NodeContext, execResp *providers.ExecutorResponse, logger *log.Logger,
+) (*providers.ExecutorResponse, error) {
+	requestCtx := crossdevice.RequestContext{
+		ApplicationName: ctx.Application.Name,
+		UserAgent:       sysContext.GetUserAgent(ctx.Context),
+	}
+	if clientIP := sysContext.GetClientIP(ctx.Context); clientIP.IsValid() {
+		requestCtx.ClientIP = clientIP.String()
+	}
+	request, svcErr := e.crossDeviceService.CreateRequest(ctx.Context, requestCtx)
+	if svcErr != nil {
+		logger.Error(ctx.Context, "Failed to create cross-device sign-in request",
+			log.String("errorCode", svcErr.Code))
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrCrossDeviceInitiateFailed
+		return execResp, nil
+	}
+
+	execResp.RuntimeData[common.RuntimeKeyCrossDeviceReference] = request.Reference
+	setCrossDeviceData(execResp, request)
+	execResp.Status = providers.ExecUserInputRequired
+	return execResp, nil
+}
+
+// poll waits for the decision on the sign-in request, completing, failing, or continuing to wait.
+func (e *crossDeviceAuthExecutor) poll(
+	ctx *providers.NodeContext, reference string, execResp *providers.ExecutorResponse, logger *log.Logger,
+) (*providers.ExecutorResponse, error) {
+	request, svcErr := e.crossDeviceService.WaitForDecision(ctx.Context, reference)
+	if svcErr != nil {
+		if svcErr.Code != crossdevice.ErrorRequestNotFound.Code {
+			return execResp, errors.New("failed to get the state of the cross-device sign-in request")
+		}
+		logger.Debug(ctx.Context, "Cross-device sign-in request not found or expired")
+		failCrossDeviceRequest(execResp, &ErrCrossDeviceExpired)
+		return execResp, nil
+	}
+
+	switch request.Status {
+	case crossdevice.RequestStatusApproved:
+		e.authenticate(ctx, reference, execResp, logger)
+		if execResp.Status == providers.ExecFailure {
+			return execResp, nil
+		}
+		delete(ctx.RuntimeData, common.RuntimeKeyCrossDeviceReference)
+		execResp.Status = providers.ExecComplete
+	case crossdevice.RequestStatusDenied:
+		logger.Debug(ctx.Context, "Cross-device sign-in request denied")
+		failCrossDeviceRequest(execResp, &ErrCrossDeviceDenied)
+	case crossdevice.RequestStatusExpired:
+		failCrossDeviceRequest(execResp, &ErrCrossDeviceExpired)
+	default:
+		// Still pending: keep the request, re-emit the QR code data so the wait view keeps rendering
+		// it across polls, and keep the client polling.
+		execResp.RuntimeData[common.RuntimeKeyCrossDeviceReference] = reference
+		setCrossDeviceData(execResp, request)
+		execResp.Status = providers.ExecUserInputRequired
+	}
+	return execResp, nil
+}
+
+// AwaitStep waits for the decision on the sign-in request of the flow.
+func (e *crossDeviceAuthExecutor) AwaitStep(ctx context.Context, runtimeData map[string]string) (bool, error) {
+	reference := runtimeData[common.RuntimeKeyCrossDeviceReference]
+	if reference == "" {
+		return false, nil
+	}
+	request, svcErr := e.crossDeviceService.WaitForDecision(ctx, reference)
+	if svcErr != nil {
+		if svcErr.Code == crossdevice.ErrorRequestNotFound.Code {
+			return true, nil
+		}
+		return false, errors.New("failed to get the state of the cross-device sign-in request")
+	}
+	switch request.Status {
+	case crossdevice.RequestStatusApproved, crossdevice.RequestStatusDenied, crossdevice.RequestStatusExpired:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// authenticate passes the approved request through the authn provider so that the provider resolves
+// the entity of the user and populates AuthUser via the standard chain.
+func (e *crossDeviceAuthExecutor) authenticate(
+	ctx *providers.NodeContext, reference string, execResp *providers.ExecutorResponse, logger *log.Logger,
+) {
+	credentials := map[string]interface{}{
+		"crossdevice": &authncommon.CrossDeviceCredential{Reference: reference},
+	}
+
+	authUser, authenticatedClaims, svcErr := e.authnProvider.AuthenticateUser(
+		ctx.Context, nil, credentials, nil, nil, execResp.AuthUser)
+	execResp.AuthUser = authUser
+	if svcErr != nil {
+		logger.Debug(ctx.Context, "Cross-device authentication through provider failed",
+			log.String("errorCode", svcErr.Code))
+		failCrossDeviceRequest(execResp, &ErrCrossDeviceAuthenticationFailed)
+		return
+	}
+
+	for key, value := range authenticatedClaims {
+		execResp.RuntimeData[key] = systemutils.ConvertInterfaceValueToString(value)
+	}
+}
+
+// setCrossDeviceData populates the approval URI, expiry, and scan state of the sign-in request for
+// the client.
+func setCrossDeviceData(execResp *providers.ExecutorResponse, request *crossdevice.AuthRequest) {
+	execResp.AdditionalData[common.DataCrossDeviceApprovalURI] = request.ApprovalURI
+	execResp.AdditionalData[common.DataCrossDeviceExpiresAt] = request.ExpiresAt.UTC().Format(time.RFC3339)
+	execResp.AdditionalData[common.DataCrossDeviceClaimed] =
+		strconv.FormatBool(request.Status == crossdevice.RequestStatusClaimed)
+}
+
+// failCrossDeviceRequest fails the node and clears the sign-in request, so that a retry of the node
+// creates a new request and QR code.
+func failCrossDeviceRequest(execResp *providers.ExecutorResponse, svcErr *tidcommon.ServiceError) {
+	execResp.RuntimeData[common.RuntimeKeyCrossDeviceReference] = ""
+	execResp.Status = providers.ExecFailure
+	execResp.Error = svcErr
+}
@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/crossdevice"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/crossdevicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+const (
+	crossDeviceTestReference   = "ref-1"
+	crossDeviceTestApprovalURI = "https://gate.example.com/cross-device?ref=ref-1"
+)
+
+type CrossDeviceAuthExecutorTestSuite struct {
+	suite.Suite
+	mockCrossDeviceService *crossdevicemock.CrossDeviceAuthServiceInterfaceMock
+	mockAuthnProvider      *managermock.AuthnProviderManagerMock
+	executor               *crossDeviceAuthExecutor
+}
+
+func TestCrossDeviceAuthExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(CrossDeviceAuthExecutorTestSuite))
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) SetupTest() {
+	suite.mockCrossDeviceService = crossdevicemock.NewCrossDeviceAuthServiceInterfaceMock(suite.T())
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerMock(suite.T())
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+
+	mockExec := coremock.NewExecutorInterfaceMock(suite.T())
+	mockExec.On("GetName").Return(ExecutorNameCrossDeviceAuth).Maybe()
+	mockFlowFactory.On("CreateExecutor", ExecutorNameCrossDeviceAuth, providers.ExecutorTypeAuthentication,
+		[]providers.Input{}, []providers.Input{}).Return(mockExec)
+
+	suite.executor = newCrossDeviceAuthExecutor(mockFlowFactory, suite.mockCrossDeviceService,
+		suite.mockAuthnProvider)
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) buildNodeContext(runtime map[string]string) *providers.NodeContext {
+	if runtime == nil {
+		runtime = map[string]string{}
+	}
+	ctx := sysContext.WithClientIP(context.Background(), netip.MustParseAddr("203.0.113.10"))
+	return &providers.NodeContext{
+		Context:     sysContext.WithUserAgent(ctx, "Firefox"),
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		Application: providers.Application{Name: "Console"},
+		UserInputs:  map[string]string{},
+		RuntimeData: runtime,
+	}
+}
+
+func buildCrossDeviceRequest(status crossdevice.RequestStatus) *crossdevice.AuthRequest {
+	return &crossdevice.AuthRequest{
+		Reference:   crossDeviceTestReference,
+		ApprovalURI: crossDeviceTestApprovalURI,
+		Status:      status,
+		ExpiresAt:   time.Date(2026, 1, 1, 10, 2, 0, 0, time.UTC),
+	}
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestExecute_Initiate() {
+	suite.mockCrossDeviceService.On("CreateRequest", mock.Anything, crossdevice.RequestContext{
+		ApplicationName: "Console",
+		ClientIP:        "203.0.113.10",
+		UserAgent:       "Firefox",
+	}).Return(buildCrossDeviceRequest(crossdevice.RequestStatusPending), nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(nil))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Equal(crossDeviceTestReference, resp.RuntimeData[common.RuntimeKeyCrossDeviceReference])
+	suite.Equal(crossDeviceTestApprovalURI, resp.AdditionalData[common.DataCrossDeviceApprovalURI])
+	suite.Equal("2026-01-01T10:02:00Z", resp.AdditionalData[common.DataCrossDeviceExpiresAt])
+	suite.Equal("false", resp.AdditionalData[common.DataCrossDeviceClaimed])
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestExecute_InitiateFailure() {
+	suite.mockCrossDeviceService.On("CreateRequest", mock.Anything, mock.Anything).
+		Return(nil, &tidcommon.InternalServerError)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(nil))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(ErrCrossDeviceInitiateFailed.Code, resp.Error.Code)
+	suite.Empty(resp.RuntimeData[common.RuntimeKeyCrossDeviceReference])
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestExecute_PollClaimed() {
+	suite.mockCrossDeviceService.On("WaitForDecision", mock.Anything, crossDeviceTestReference).
+		Return(buildCrossDeviceRequest(crossdevice.RequestStatusClaimed), nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(
+		map[string]string{common.RuntimeKeyCrossDeviceReference: crossDeviceTestReference}))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Equal(crossDeviceTestReference, resp.RuntimeData[common.RuntimeKeyCrossDeviceReference])
+	suite.Equal(crossDeviceTestApprovalURI, resp.AdditionalData[common.DataCrossDeviceApprovalURI])
+	suite.Equal("true", resp.AdditionalData[common.DataCrossDeviceClaimed])
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestExecute_PollApproved() {
+	suite.mockCrossDeviceService.On("WaitForDecision", mock.Anything, crossDeviceTestReference).
+		Return(buildCrossDeviceRequest(crossdevice.RequestStatusApproved), nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything,
+		map[string]interface{}{
+			"crossdevice": &authncommon.CrossDeviceCredential{Reference: crossDeviceTestReference},
+		},
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(buildConsentAuthUser(), providers.AuthenticatedClaims{"userID": testUserID},
+			(*tidcommon.ServiceError)(nil))
+
+	ctx := suite.buildNodeContext(map[string]string{common.RuntimeKeyCrossDeviceReference: crossDeviceTestReference})
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+	suite.Equal(testUserID, resp.RuntimeData["userID"])
+	suite.NotContains(ctx.RuntimeData, common.RuntimeKeyCrossDeviceReference)
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestExecute_PollApprovedAuthenticationFailure() {
+	suite.mockCrossDeviceService.On("WaitForDecision", mock.Anything, crossDeviceTestReference).
+		Return(buildCrossDeviceRequest(crossdevice.RequestStatusApproved), nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims(nil), &tidcommon.InternalServerError)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(
+		map[string]string{common.RuntimeKeyCrossDeviceReference: crossDeviceTestReference}))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(ErrCrossDeviceAuthenticationFailed.Code, resp.Error.Code)
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestExecute_PollFailures() {
+	testCases := []struct {
+		name        string
+		request     *crossdevice.AuthRequest
+		svcErr      *tidcommon.ServiceError
+		expectedErr string
+	}{
+		{"Denied", buildCrossDeviceRequest(crossdevice.RequestStatusDenied), nil, ErrCrossDeviceDenied.Code},
+		{"Expired", buildCrossDeviceRequest(crossdevice.RequestStatusExpired), nil, ErrCrossDeviceExpired.Code},
+		{"NotFound", nil, &crossdevice.ErrorRequestNotFound, ErrCrossDeviceExpired.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockCrossDeviceService.On("WaitForDecision", mock.Anything, crossDeviceTestReference).
+				Return(tc.request, tc.svcErr)
+
+			resp, err := suite.executor.Execute(suite.buildNodeContext(
+				map[string]string{common.RuntimeKeyCrossDeviceReference: crossDeviceTestReference}))
+
+			suite.NoError(err)
+			suite.Equal(providers.ExecFailure, resp.Status)
+			suite.Equal(tc.expectedErr, resp.Error.Code)
+			suite.Empty(resp.RuntimeData[common.RuntimeKeyCrossDeviceReference])
+		})
+	}
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestExecute_PollServerError() {
+	suite.mockCrossDeviceService.On("WaitForDecision", mock.Anything, crossDeviceTestReference).
+		Return(nil, &tidcommon.InternalServerError)
+
+	_, err := suite.executor.Execute(suite.buildNodeContext(
+		map[string]string{common.RuntimeKeyCrossDeviceReference: crossDeviceTestReference}))
+
+	suite.Error(err)
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestAwaitStep() {
+	testCases := []struct {
+		name     string
+		request  *crossdevice.AuthRequest
+		svcErr   *tidcommon.ServiceError
+		expected bool
+	}{
+		{"Pending", buildCrossDeviceRequest(crossdevice.RequestStatusPending), nil, false},
+		{"Claimed", buildCrossDeviceRequest(crossdevice.RequestStatusClaimed), nil, false},
+		{"Approved", buildCrossDeviceRequest(crossdevice.RequestStatusApproved), nil, true},
+		{"Expired", buildCrossDeviceRequest(crossdevice.RequestStatusExpired), nil, true},
+		{"NotFound", nil, &crossdevice.ErrorRequestNotFound, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockCrossDeviceService.On("WaitForDecision", mock.Anything, crossDeviceTestReference).
+				Return(tc.request, tc.svcErr)
+
+			resolved, err := suite.executor.AwaitStep(context.Background(),
+				map[string]string{common.RuntimeKeyCrossDeviceReference: crossDeviceTestReference})
+
+			suite.NoError(err)
+			suite.Equal(tc.expected, resolved)
+		})
+	}
+}
+
+func (suite *CrossDeviceAuthExecutorTestSuite) TestAwaitStep_ServerError() {
+	suite.mockCrossDeviceService.On("WaitForDecision", mock.Anything, crossDeviceTestReference).
+		Return(nil, &tidcommon.InternalServerError)
+
+	_, err := suite.executor.AwaitStep(context.Background(),
+		map[string]string{common.RuntimeKeyCrossDeviceReference: crossDeviceTestReference})
+
+	suite.Error(err)
+}
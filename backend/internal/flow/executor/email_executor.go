@@ -131,7 +131,8 @@ func (e *emailExecutor) executeSend(ctx *providers.NodeContext) (*providers.Exec
 
 	templateData := e.resolveTemplateData(ctx)
 
-	rendered, svcErr := e.templateService.Render(ctx.Context, scenario, template.TemplateTypeEmail, templateData)
+	rendered, svcErr := e.templateService.RenderFor(ctx.Context, scenario, template.TemplateTypeEmail,
+		buildTemplateSelector(ctx), templateData)
 	if svcErr != nil {
 		return nil, fmt.Errorf("failed to render email template: %s", svcErr.Code)
 	}
@@ -91,10 +91,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_UserInviteTemplate_Suc
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
 		},
@@ -138,10 +139,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_SelfRegistration_Invit
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioSelfRegistration,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
 		},
@@ -186,10 +188,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_UsesRuntimeRecipientOv
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			"email":                     "runtime@example.com",
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
@@ -231,10 +234,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_EmailFromRuntimeData()
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			"email":                     "runtime@example.com",
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
@@ -299,10 +303,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_MissingInviteLink() {
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{},
 	).Return(&template.RenderedTemplate{
 		Subject: "You're Invited to Register",
@@ -341,10 +346,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_SelfRegistration_Missi
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioSelfRegistration,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{},
 	).Return(&template.RenderedTemplate{
 		Subject: "Complete Your Registration",
@@ -387,7 +393,7 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_MissingTemplatePropert
 	suite.Error(err)
 	suite.Contains(err.Error(), "missing required property: emailTemplate")
 	suite.Nil(resp)
-	suite.mockTemplateService.AssertNumberOfCalls(suite.T(), "Render", 0)
+	suite.mockTemplateService.AssertNumberOfCalls(suite.T(), "RenderFor", 0)
 }
 
 func (suite *EmailExecutorTestSuite) TestExecute_SendMode_EmptyTemplateString_Fails() {
@@ -413,7 +419,7 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_EmptyTemplateString_Fa
 	suite.Error(err)
 	suite.Contains(err.Error(), "email template property is empty in node configuration")
 	suite.Nil(resp)
-	suite.mockTemplateService.AssertNumberOfCalls(suite.T(), "Render", 0)
+	suite.mockTemplateService.AssertNumberOfCalls(suite.T(), "RenderFor", 0)
 }
 
 func (suite *EmailExecutorTestSuite) TestExecute_SendMode_InvalidTemplateType_ReturnsError() {
@@ -459,10 +465,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_TemplateRenderError()
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
 		},
@@ -532,10 +539,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_ClientError() {
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
 		},
@@ -591,10 +599,10 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_KnownSMTPErrors() {
 				},
 			}
 
-			suite.mockTemplateService.On("Render",
+			suite.mockTemplateService.On("RenderFor",
 				ctx.Context,
 				template.ScenarioUserInvite,
-				template.TemplateTypeEmail,
+				template.TemplateTypeEmail, mock.Anything,
 				template.TemplateData{
 					common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
 				},
@@ -640,10 +648,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_UnexpectedError() {
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
 		},
@@ -757,10 +766,11 @@ func (suite *EmailExecutorTestSuite) assertExecuteSendSuccess(ctx *providers.Nod
 		}
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		mock.Anything,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		expectedTemplateData,
 	).Return(&template.RenderedTemplate{
 		Subject: "You're Invited",
@@ -802,10 +812,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_ResolvesEmailFromForwa
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
 		},
@@ -849,10 +860,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_UsesNodePropertiesAndF
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			"magicLink":     "https://localhost:5190/gate/signin?token=abc",
 			"expiryMinutes": "5",
@@ -895,10 +907,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_ResolvesEmailUsingConf
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
 		},
@@ -944,10 +957,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_ResolvesEmailFromEntit
 	}
 	suite.mockEntityProvider.On("GetEntity", "test-db-user-id").Return(mockEntity, nil)
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioUserInvite,
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{
 			userAttributeUserID:         "test-db-user-id",
 			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
@@ -1145,10 +1159,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_InvalidNodePropertySce
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioType("NON_EXISTENT_TEMPLATE"),
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{},
 	).Return(nil, &tidcommon.ServiceError{Code: "TMP-404"})
 
@@ -1173,10 +1188,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_MissingEmailInputConfi
 		},
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioType("USER_INVITE"),
 		template.TemplateTypeEmail,
+		mock.Anything,
 		template.TemplateData{},
 	).Return(&template.RenderedTemplate{
 		Subject: "Invite",
@@ -1216,10 +1232,11 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_ApplicationNameInTempl
 		"appName": "Test Application",
 	}
 
-	suite.mockTemplateService.On("Render",
+	suite.mockTemplateService.On("RenderFor",
 		ctx.Context,
 		template.ScenarioType("USER_INVITE"),
 		template.TemplateTypeEmail,
+		mock.Anything,
 		expectedTemplateData,
 	).Return(&template.RenderedTemplate{
 		Subject: "Test App Invite",
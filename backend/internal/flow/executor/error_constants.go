@@ -1158,6 +1158,319 @@ var (
 			DefaultValue: "User provisioning failed because one or more unique attribute values are already taken",
 		},
 	}
+
+	// ErrExternalTaskConfigInvalid is returned when the external task executor configuration is invalid.
+	ErrExternalTaskConfigInvalid = tidcommon.ServiceError{
+		Type: tidcommon.ServerErrorType,
+		Code: "FET-1083",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.external_task_config_invalid",
+			DefaultValue: "Invalid external task configuration",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.external_task_config_invalid_desc",
+			DefaultValue: "The external task executor is not configured correctly",
+		},
+	}
+
+	// ErrExternalTaskRequestRejected is returned when the external task service rejects the request.
+	ErrExternalTaskRequestRejected = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1084",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.external_task_request_rejected",
+			DefaultValue: "External task request rejected",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.external_task_request_rejected_desc",
+			DefaultValue: "The external task service rejected the request",
+		},
+	}
+
+	// ErrExternalTaskInvalidResponse is returned when the external task service response is invalid.
+	ErrExternalTaskInvalidResponse = tidcommon.ServiceError{
+		Type: tidcommon.ServerErrorType,
+		Code: "FET-1085",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.external_task_invalid_response",
+			DefaultValue: "Invalid external task response",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.external_task_invalid_response_desc",
+			DefaultValue: "The external task service returned a response that does not match the expected contract",
+		},
+	}
+
+	// ErrExternalTaskFailed is returned when the external task service reports a failure.
+	ErrExternalTaskFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1086",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.external_task_failed",
+			DefaultValue: "External task failed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.external_task_failed_desc",
+			DefaultValue: "The external task service reported a failure",
+		},
+	}
+
+	// ErrUserAccountNotActive is returned when the user account is locked, disabled or pending activation.
+	ErrUserAccountNotActive = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1087",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.user_account_not_active",
+			DefaultValue: "User account is not active",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.user_account_not_active_desc",
+			DefaultValue: "The user account is locked, disabled or pending activation",
+		},
+	}
+
+	// ErrPoliciesNotAccepted is returned when the user does not accept every pending policy.
+	ErrPoliciesNotAccepted = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1088",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.policies_not_accepted",
+			DefaultValue: "Policies not accepted",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.policies_not_accepted_desc",
+			DefaultValue: "The current version of every required policy must be accepted to continue",
+		},
+	}
+
+	// ErrSignInAnomalyDetected is returned when a sign-in is assessed as anomalous and the node
+	// is configured to deny anomalous sign-ins.
+	ErrSignInAnomalyDetected = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1089",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.sign_in_anomaly_detected",
+			DefaultValue: "Unusual sign-in detected",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.sign_in_anomaly_detected_desc",
+			DefaultValue: "The sign-in was blocked because it differs from the usual sign-in activity of the user",
+		},
+	}
+
+	// ErrPushNoRegisteredDevice is returned when the user has no device registered for push approval requests.
+	ErrPushNoRegisteredDevice = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1090",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_no_registered_device",
+			DefaultValue: "No device registered for push approval",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_no_registered_device_desc",
+			DefaultValue: "The user has no device registered to approve sign-in requests",
+		},
+	}
+
+	// ErrPushInitiateFailed is returned when the push approval request cannot be sent.
+	ErrPushInitiateFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1091",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_initiate_failed",
+			DefaultValue: "Failed to send the push approval request",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_initiate_failed_desc",
+			DefaultValue: "An error occurred while sending the approval request to the registered devices",
+		},
+	}
+
+	// ErrPushDenied is returned when the push approval request is rejected on the device.
+	ErrPushDenied = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1092",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_denied",
+			DefaultValue: "Sign-in request rejected",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_denied_desc",
+			DefaultValue: "The sign-in request was rejected on the registered device",
+		},
+	}
+
+	// ErrPushNumberMismatch is returned when the number selected on the device does not match the number shown
+	// at sign-in.
+	ErrPushNumberMismatch = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1093",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_number_mismatch",
+			DefaultValue: "Sign-in request number mismatch",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_number_mismatch_desc",
+			DefaultValue: "The number selected on the device does not match the number shown on the sign-in screen",
+		},
+	}
+
+	// ErrPushExpired is returned when the push approval request expires before it is decided.
+	ErrPushExpired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1094",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_expired",
+			DefaultValue: "The push approval request expired",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_expired_desc",
+			DefaultValue: "The sign-in request was not approved on the device before it expired",
+		},
+	}
+
+	// ErrPushAuthenticationFailed is returned when an approved push approval request cannot be authenticated.
+	ErrPushAuthenticationFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1095",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_authentication_failed",
+			DefaultValue: "Push authentication failed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.push_authentication_failed_desc",
+			DefaultValue: "An error occurred while authenticating the approved sign-in request",
+		},
+	}
+	// ErrCrossDeviceInitiateFailed is returned when the cross-device sign-in request cannot be created.
+	ErrCrossDeviceInitiateFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1096",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.cross_device_initiate_failed",
+			DefaultValue: "Failed to start the QR code sign-in",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.cross_device_initiate_failed_desc",
+			DefaultValue: "An error occurred while creating the cross-device sign-in request",
+		},
+	}
+
+	// ErrCrossDeviceDenied is returned when the cross-device sign-in request is denied on the other device.
+	ErrCrossDeviceDenied = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1097",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.cross_device_denied",
+			DefaultValue: "Sign-in request denied",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.cross_device_denied_desc",
+			DefaultValue: "The sign-in request was denied on the device that scanned the QR code",
+		},
+	}
+
+	// ErrCrossDeviceExpired is returned when the cross-device sign-in request expires before it is approved.
+	ErrCrossDeviceExpired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1098",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.cross_device_expired",
+			DefaultValue: "The QR code sign-in request expired",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.cross_device_expired_desc",
+			DefaultValue: "The sign-in request was not approved on another device before it expired",
+		},
+	}
+
+	// ErrCrossDeviceAuthenticationFailed is returned when an approved cross-device sign-in request cannot be
+	// authenticated.
+	ErrCrossDeviceAuthenticationFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1099",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.cross_device_authentication_failed",
+			DefaultValue: "Cross-device authentication failed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.cross_device_authentication_failed_desc",
+			DefaultValue: "An error occurred while authenticating the approved sign-in request",
+		},
+	}
+
+	// ErrCertificateNotPresented is returned when the client did not present a certificate during the TLS handshake.
+	ErrCertificateNotPresented = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1100",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.certificate_not_presented",
+			DefaultValue: "No client certificate presented",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.certificate_not_presented_desc",
+			DefaultValue: "Insert your smart card or select a client certificate and try again",
+		},
+	}
+
+	// ErrCertificateAuthenticationFailed is returned when the client certificate is not trusted, is revoked, or
+	// does not identify a user.
+	ErrCertificateAuthenticationFailed = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1101",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.certificate_authentication_failed",
+			DefaultValue: "Certificate authentication failed",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.certificate_authentication_failed_desc",
+			DefaultValue: "The client certificate is not valid for signing in",
+		},
+	}
+
+	// ErrOTPResendThrottled is returned when a new OTP is requested before the resend interval has elapsed.
+	ErrOTPResendThrottled = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1102",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.otp_resend_throttled",
+			DefaultValue: "OTP requested too soon",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.otp_resend_throttled_desc",
+			DefaultValue: "A code was sent recently. Wait a moment before requesting a new one",
+		},
+	}
+
+	// ErrConcurrentSessionLimitReached is returned when a sign-in from a new device is denied because
+	// the user already has the maximum number of concurrent sessions.
+	ErrConcurrentSessionLimitReached = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1103",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.concurrent_session_limit_reached",
+			DefaultValue: "Too many active sessions",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.concurrent_session_limit_reached_desc",
+			DefaultValue: "Sign out from another device before signing in from this one",
+		},
+	}
+
+	// ErrEssentialAttributesUnavailable is returned when the application enforces essential claims and an
+	// essential claim requested for the sign-in cannot be provided for the user.
+	ErrEssentialAttributesUnavailable = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "FET-1104",
+		Error: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.essential_attributes_unavailable",
+			DefaultValue: "Required information unavailable",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "flows.executor.errors.essential_attributes_unavailable_desc",
+			DefaultValue: "The application requires information that is not available for your account",
+		},
+	}
 )
 
 // errAttributeNotUniqueFor returns a ServiceError for a specific attribute that is not unique.
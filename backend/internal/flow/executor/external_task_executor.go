@@ -0,0 +1,445 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	httpservice "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	externalTaskLoggerComponentName = "ExternalTaskExecutor"
+
+	// Maximum size of the remote service response body in bytes
+	maxExternalTaskResponseSize = 1 << 20
+	// Default header used to send the API key for API key authentication
+	defaultExternalTaskAPIKeyHeader = "X-API-Key"
+)
+
+// externalTaskAuthType defines the authentication schemes supported when calling the remote service.
+type externalTaskAuthType string
+
+const (
+	externalTaskAuthNone   externalTaskAuthType = "NONE"
+	externalTaskAuthBearer externalTaskAuthType = "BEARER"
+	externalTaskAuthBasic  externalTaskAuthType = "BASIC"
+	externalTaskAuthAPIKey externalTaskAuthType = "API_KEY"
+)
+
+// externalTaskStatus defines the outcomes the remote service can report.
+type externalTaskStatus string
+
+const (
+	externalTaskStatusComplete          externalTaskStatus = "COMPLETE"
+	externalTaskStatusFailure           externalTaskStatus = "FAILURE"
+	externalTaskStatusUserInputRequired externalTaskStatus = "USER_INPUT_REQUIRED"
+)
+
+// externalTaskConfig represents the external task configuration from node properties.
+type externalTaskConfig struct {
+	URL            string
+	Auth           externalTaskAuthConfig
+	Timeout        int
+	RuntimeData    []string
+	ResponseSchema *jsonschema.Resolved
+}
+
+// externalTaskAuthConfig represents the credentials used to call the remote service.
+type externalTaskAuthConfig struct {
+	Type     externalTaskAuthType `json:"type"`
+	Token    string               `json:"token"`
+	Username string               `json:"username"`
+	Password string               `json:"password"`
+	Header   string               `json:"header"`
+	APIKey   string               `json:"apiKey"`
+}
+
+// externalTaskRequest is the request body sent to the remote service.
+type externalTaskRequest struct {
+	ExecutionID   string                `json:"executionId"`
+	FlowType      providers.FlowType    `json:"flowType"`
+	ApplicationID string                `json:"applicationId,omitempty"`
+	NodeID        string                `json:"nodeId"`
+	Mode          string                `json:"mode,omitempty"`
+	Inputs        map[string]string     `json:"inputs"`
+	RuntimeData   map[string]string     `json:"runtimeData"`
+	User          *externalTaskUserInfo `json:"user,omitempty"`
+}
+
+// externalTaskUserInfo identifies the user the flow is running for.
+type externalTaskUserInfo struct {
+	ID   string `json:"id"`
+	Type string `json:"type,omitempty"`
+	OUID string `json:"ouId,omitempty"`
+}
+
+// externalTaskResponse is the response body expected from the remote service.
+type externalTaskResponse struct {
+	Status        externalTaskStatus  `json:"status"`
+	FailureReason string              `json:"failureReason,omitempty"`
+	Inputs        []externalTaskInput `json:"inputs,omitempty"`
+	RuntimeData   map[string]string   `json:"runtimeData,omitempty"`
+}
+
+// externalTaskInput is an input the remote service requests from the user.
+type externalTaskInput struct {
+	Identifier string `json:"identifier"`
+	Type       string `json:"type,omitempty"`
+	Required   bool   `json:"required,omitempty"`
+}
+
+// externalTaskExecutor implements the ExecutorInterface for delegating node execution to a remote service.
+type externalTaskExecutor struct {
+	providers.Executor
+	logger *log.Logger
+}
+
+var _ providers.Executor = (*externalTaskExecutor)(nil)
+
+// newExternalTaskExecutor creates a new instance of ExternalTaskExecutor.
+func newExternalTaskExecutor(flowFactory core.FlowFactoryInterface) *externalTaskExecutor {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, externalTaskLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameExternalTask))
+
+	base := flowFactory.CreateExecutor(ExecutorNameExternalTask, providers.ExecutorTypeUtility,
+		[]providers.Input{}, []providers.Input{})
+
+	return &externalTaskExecutor{
+		Executor: base,
+		logger:   logger,
+	}
+}
+
+// Execute delegates the node execution to the configured remote service. Transport failures and
+// server errors of the remote service are returned as errors so that the node's retry policy applies.
+func (e *externalTaskExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Executing external task executor")
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	config, err := e.parseConfig(ctx.NodeProperties)
+	if err != nil {
+		logger.Error(ctx.Context, "Failed to parse external task configuration", log.Error(err))
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrExternalTaskConfigInvalid
+		return execResp, nil
+	}
+
+	if len(e.GetRequiredInputs(ctx)) > 0 && !e.HasRequiredInputs(ctx, execResp) {
+		logger.Debug(ctx.Context, "Required inputs for external task executor are not provided")
+		execResp.Status = providers.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	statusCode, body, err := e.sendRequest(ctx, config, e.buildRequest(ctx, config))
+	if err != nil {
+		return nil, err
+	}
+	if statusCode >= http.StatusInternalServerError || statusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("external task service responded with status %d", statusCode)
+	}
+	if statusCode >= http.StatusBadRequest {
+		logger.Debug(ctx.Context, "External task service rejected the request", log.Int("statusCode", statusCode))
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrExternalTaskRequestRejected
+		return execResp, nil
+	}
+
+	taskResp, err := e.parseResponse(body, config)
+	if err != nil {
+		logger.Error(ctx.Context, "Invalid response from external task service", log.Error(err))
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrExternalTaskInvalidResponse
+		return execResp, nil
+	}
+
+	for key, value := range taskResp.RuntimeData {
+		execResp.RuntimeData[key] = value
+	}
+
+	switch taskResp.Status {
+	case externalTaskStatusComplete:
+		execResp.Status = providers.ExecComplete
+	case externalTaskStatusUserInputRequired:
+		execResp.Status = providers.ExecUserInputRequired
+		for _, input := range taskResp.Inputs {
+			inputType := input.Type
+			if inputType == "" {
+				inputType = "string"
+			}
+			execResp.Inputs = append(execResp.Inputs, providers.Input{
+				Identifier: input.Identifier,
+				Type:       inputType,
+				Required:   input.Required,
+			})
+		}
+	case externalTaskStatusFailure:
+		execResp.Status = providers.ExecFailure
+		execResp.Error = tidcommon.CustomServiceError(ErrExternalTaskFailed, tidcommon.I18nMessage{
+			Key:          ErrExternalTaskFailed.ErrorDescription.Key,
+			DefaultValue: taskResp.FailureReason,
+		})
+	}
+
+	logger.Debug(ctx.Context, "External task executor execution completed",
+		log.String("status", string(execResp.Status)))
+	return execResp, nil
+}
+
+// parseConfig parses and validates the external task configuration from node properties.
+func (e *externalTaskExecutor) parseConfig(properties map[string]interface{}) (*externalTaskConfig, error) {
+	config := &externalTaskConfig{
+		Auth:    externalTaskAuthConfig{Type: externalTaskAuthNone},
+		Timeout: defaultHTTPTimeout,
+	}
+
+	rawURL, _ := properties["url"].(string)
+	if rawURL == "" {
+		return nil, errors.New("url is required")
+	}
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || (parsedURL.Scheme != "https" && parsedURL.Scheme != "http") || parsedURL.Host == "" {
+		return nil, errors.New("url must be an absolute http or https URL")
+	}
+	config.URL = rawURL
+
+	if timeout, ok := properties["timeout"].(float64); ok && timeout > 0 {
+		config.Timeout = min(int(timeout), maxHTTPRequestTimeout)
+	}
+
+	if rawAuth, ok := properties["auth"]; ok {
+		if err := remarshal(rawAuth, &config.Auth); err != nil {
+			return nil, fmt.Errorf("invalid auth configuration: %w", err)
+		}
+		if err := validateExternalTaskAuth(&config.Auth); err != nil {
+			return nil, err
+		}
+	}
+
+	if rawKeys, ok := properties["runtimeData"]; ok {
+		if err := remarshal(rawKeys, &config.RuntimeData); err != nil {
+			return nil, fmt.Errorf("runtimeData must be a list of keys: %w", err)
+		}
+	}
+
+	if rawSchema, ok := properties["responseSchema"]; ok {
+		var schema jsonschema.Schema
+		if err := remarshal(rawSchema, &schema); err != nil {
+			return nil, fmt.Errorf("invalid responseSchema: %w", err)
+		}
+		resolved, err := schema.Resolve(nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid responseSchema: %w", err)
+		}
+		config.ResponseSchema = resolved
+	}
+
+	return config, nil
+}
+
+// validateExternalTaskAuth validates the authentication configuration and applies defaults.
+func validateExternalTaskAuth(auth *externalTaskAuthConfig) error {
+	auth.Type = externalTaskAuthType(strings.ToUpper(string(auth.Type)))
+	switch auth.Type {
+	case "", externalTaskAuthNone:
+		auth.Type = externalTaskAuthNone
+	case externalTaskAuthBearer:
+		if auth.Token == "" {
+			return errors.New("auth token is required for BEARER authentication")
+		}
+	case externalTaskAuthBasic:
+		if auth.Username == "" || auth.Password == "" {
+			return errors.New("auth username and password are required for BASIC authentication")
+		}
+	case externalTaskAuthAPIKey:
+		if auth.APIKey == "" {
+			return errors.New("auth apiKey is required for API_KEY authentication")
+		}
+		if auth.Header == "" {
+			auth.Header = defaultExternalTaskAPIKeyHeader
+		}
+	default:
+		return fmt.Errorf("unsupported auth type: %s", auth.Type)
+	}
+	return nil
+}
+
+// buildRequest builds the request sent to the remote service. Only the node's inputs and the runtime
+// data keys listed in the configuration are shared with the remote service.
+func (e *externalTaskExecutor) buildRequest(ctx *providers.NodeContext,
+	config *externalTaskConfig) *externalTaskRequest {
+	req := &externalTaskRequest{
+		ExecutionID:   ctx.ExecutionID,
+		FlowType:      ctx.FlowType,
+		ApplicationID: ctx.EntityID,
+		NodeID:        ctx.CurrentNodeID,
+		Mode:          ctx.ExecutorMode,
+		Inputs:        make(map[string]string),
+		RuntimeData:   make(map[string]string),
+	}
+
+	for _, input := range e.GetRequiredInputs(ctx) {
+		if value, ok := ctx.UserInputs[input.Identifier]; ok {
+			req.Inputs[input.Identifier] = value
+		}
+	}
+	for _, key := range config.RuntimeData {
+		if value, ok := ctx.RuntimeData[key]; ok {
+			req.RuntimeData[key] = value
+		}
+	}
+	if entityRef := ctx.AuthUser.EntityReference(); entityRef != nil && entityRef.EntityID != "" {
+		req.User = &externalTaskUserInfo{
+			ID:   entityRef.EntityID,
+			Type: entityRef.EntityType,
+			OUID: entityRef.OUID,
+		}
+	}
+
+	return req
+}
+
+// sendRequest sends the request to the remote service and returns the response status code and body.
+func (e *externalTaskExecutor) sendRequest(ctx *providers.NodeContext, config *externalTaskConfig,
+	taskReq *externalTaskRequest) (int, []byte, error) {
+	reqBody, err := json.Marshal(taskReq)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to marshal external task request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx.Context, http.MethodPost, config.URL, bytes.NewReader(reqBody))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create external task request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(serverconst.CorrelationIDHeaderName, sysContext.GetTraceID(ctx.Context))
+
+	switch config.Auth.Type {
+	case externalTaskAuthBearer:
+		req.Header.Set("Authorization", "Bearer "+config.Auth.Token)
+	case externalTaskAuthBasic:
+		req.SetBasicAuth(config.Auth.Username, config.Auth.Password)
+	case externalTaskAuthAPIKey:
+		req.Header.Set(config.Auth.Header, config.Auth.APIKey)
+	}
+
+	e.logger.Debug(ctx.Context, "Sending external task request", log.MaskedString("url", config.URL))
+
+	httpClient := httpservice.NewOutboundHTTPClient(time.Duration(config.Timeout) * time.Second)
+	response, err := httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to execute external task request: %w", err)
+	}
+	defer func() {
+		if closeErr := response.Body.Close(); closeErr != nil {
+			e.logger.Error(ctx.Context, "Failed to close response body", log.Error(closeErr))
+		}
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxExternalTaskResponseSize+1))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read external task response: %w", err)
+	}
+	if len(body) > maxExternalTaskResponseSize {
+		return 0, nil, errors.New("external task response exceeds the maximum allowed size")
+	}
+
+	return response.StatusCode, body, nil
+}
+
+// parseResponse parses the remote service response and validates it against the response contract and
+// the configured response schema.
+func (e *externalTaskExecutor) parseResponse(body []byte, config *externalTaskConfig) (
+	*externalTaskResponse, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	var taskResp externalTaskResponse
+	if err := decoder.Decode(&taskResp); err != nil {
+		return nil, fmt.Errorf("response does not match the external task contract: %w", err)
+	}
+
+	switch taskResp.Status {
+	case externalTaskStatusComplete:
+	case externalTaskStatusFailure:
+		if taskResp.FailureReason == "" {
+			return nil, errors.New("failureReason is required when status is FAILURE")
+		}
+	case externalTaskStatusUserInputRequired:
+		if len(taskResp.Inputs) == 0 {
+			return nil, errors.New("inputs are required when status is USER_INPUT_REQUIRED")
+		}
+		for _, input := range taskResp.Inputs {
+			if input.Identifier == "" {
+				return nil, errors.New("input identifier must not be empty")
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported status: %q", taskResp.Status)
+	}
+	for key := range taskResp.RuntimeData {
+		if key == "" {
+			return nil, errors.New("runtimeData keys must not be empty")
+		}
+	}
+
+	if config.ResponseSchema != nil {
+		var instance any
+		if err := json.Unmarshal(body, &instance); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		if err := config.ResponseSchema.Validate(instance); err != nil {
+			return nil, fmt.Errorf("response does not match the configured schema: %w", err)
+		}
+	}
+
+	return &taskResp, nil
+}
+
+// remarshal converts a node property value into the given target through its JSON representation.
+func remarshal(value interface{}, target interface{}) error {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonBytes, target)
+}
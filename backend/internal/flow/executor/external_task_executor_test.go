@@ -0,0 +1,337 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type ExternalTaskExecutorTestSuite struct {
+	suite.Suite
+	executor   *externalTaskExecutor
+	mockServer *httptest.Server
+}
+
+func TestExternalTaskExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(ExternalTaskExecutorTestSuite))
+}
+
+func (suite *ExternalTaskExecutorTestSuite) SetupSuite() {
+	_ = config.InitializeServerRuntime("test", &config.Config{})
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *ExternalTaskExecutorTestSuite) SetupTest() {
+	suite.executor = suite.newExecutor([]providers.Input{})
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TearDownTest() {
+	if suite.mockServer != nil {
+		suite.mockServer.Close()
+		suite.mockServer = nil
+	}
+}
+
+func (suite *ExternalTaskExecutorTestSuite) newExecutor(inputs []providers.Input) *externalTaskExecutor {
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+	mockFlowFactory.On("CreateExecutor", ExecutorNameExternalTask, providers.ExecutorTypeUtility,
+		[]providers.Input{}, []providers.Input{}).
+		Return(newMockExecutor(ExecutorNameExternalTask, providers.ExecutorTypeUtility,
+			inputs, []providers.Input{}))
+	return newExternalTaskExecutor(mockFlowFactory)
+}
+
+func (suite *ExternalTaskExecutorTestSuite) startServer(status int, body string,
+	capture func(r *http.Request, req externalTaskRequest)) {
+	suite.mockServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if capture != nil {
+			var req externalTaskRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			capture(r, req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func (suite *ExternalTaskExecutorTestSuite) newContext(properties map[string]interface{}) *providers.NodeContext {
+	if _, ok := properties["url"]; !ok {
+		properties["url"] = suite.mockServer.URL
+	}
+	return &providers.NodeContext{
+		Context:        context.Background(),
+		ExecutionID:    "exec-1",
+		FlowType:       providers.FlowTypeAuthentication,
+		EntityID:       "app-1",
+		CurrentNodeID:  "kyc_check",
+		NodeProperties: properties,
+		RuntimeData: map[string]string{
+			"country": "LK",
+			"secret":  "do-not-send",
+		},
+	}
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_Complete() {
+	var captured externalTaskRequest
+	var authHeader string
+	suite.startServer(http.StatusOK, `{"status":"COMPLETE","runtimeData":{"kycStatus":"verified"}}`,
+		func(r *http.Request, req externalTaskRequest) {
+			captured = req
+			authHeader = r.Header.Get("Authorization")
+		})
+
+	ctx := suite.newContext(map[string]interface{}{
+		"auth":        map[string]interface{}{"type": "bearer", "token": "secret-token"},
+		"runtimeData": []interface{}{"country"},
+	})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+	suite.Equal("verified", resp.RuntimeData["kycStatus"])
+	suite.Equal("Bearer secret-token", authHeader)
+	suite.Equal("exec-1", captured.ExecutionID)
+	suite.Equal("app-1", captured.ApplicationID)
+	suite.Equal("kyc_check", captured.NodeID)
+	suite.Equal(map[string]string{"country": "LK"}, captured.RuntimeData)
+	suite.Nil(captured.User)
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_SendsRequiredInputsAndUser() {
+	suite.executor = suite.newExecutor([]providers.Input{{Identifier: "nationalId", Type: "string", Required: true}})
+	var captured externalTaskRequest
+	suite.startServer(http.StatusOK, `{"status":"COMPLETE"}`, func(_ *http.Request, req externalTaskRequest) {
+		captured = req
+	})
+
+	ctx := suite.newContext(map[string]interface{}{})
+	ctx.UserInputs = map[string]string{"nationalId": "123456789V", "other": "ignored"}
+	ctx.AuthUser.SetEntityReference(&providers.EntityReference{EntityID: "user-1", OUID: "ou-1"})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+	suite.Equal(map[string]string{"nationalId": "123456789V"}, captured.Inputs)
+	suite.Require().NotNil(captured.User)
+	suite.Equal("user-1", captured.User.ID)
+	suite.Equal("ou-1", captured.User.OUID)
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_MissingRequiredInputs() {
+	suite.executor = suite.newExecutor([]providers.Input{{Identifier: "nationalId", Type: "string", Required: true}})
+	ctx := &providers.NodeContext{
+		Context:        context.Background(),
+		NodeProperties: map[string]interface{}{"url": "https://kyc.example.com/check"},
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Len(resp.Inputs, 1)
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_UserInputRequired() {
+	suite.startServer(http.StatusOK,
+		`{"status":"USER_INPUT_REQUIRED","inputs":[{"identifier":"passportNumber","required":true}]}`, nil)
+
+	resp, err := suite.executor.Execute(suite.newContext(map[string]interface{}{}))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Require().Len(resp.Inputs, 1)
+	suite.Equal("passportNumber", resp.Inputs[0].Identifier)
+	suite.Equal("string", resp.Inputs[0].Type)
+	suite.True(resp.Inputs[0].Required)
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_RemoteFailure() {
+	suite.startServer(http.StatusOK, `{"status":"FAILURE","failureReason":"Identity could not be verified"}`, nil)
+
+	resp, err := suite.executor.Execute(suite.newContext(map[string]interface{}{}))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Require().NotNil(resp.Error)
+	suite.Equal(ErrExternalTaskFailed.Code, resp.Error.Code)
+	suite.Equal("Identity could not be verified", resp.Error.ErrorDescription.DefaultValue)
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_InvalidContract() {
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"UnknownStatus", `{"status":"DONE"}`},
+		{"UnknownField", `{"status":"COMPLETE","extra":true}`},
+		{"FailureWithoutReason", `{"status":"FAILURE"}`},
+		{"InputRequiredWithoutInputs", `{"status":"USER_INPUT_REQUIRED"}`},
+		{"MalformedJSON", `not-json`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.startServer(http.StatusOK, tc.body, nil)
+			defer suite.TearDownTest()
+
+			resp, err := suite.executor.Execute(suite.newContext(map[string]interface{}{}))
+
+			suite.NoError(err)
+			suite.Equal(providers.ExecFailure, resp.Status)
+			suite.Equal(&ErrExternalTaskInvalidResponse, resp.Error)
+		})
+	}
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_ResponseSchemaValidation() {
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"runtimeData"},
+		"properties": map[string]interface{}{
+			"runtimeData": map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"kycStatus"},
+			},
+		},
+	}
+
+	suite.Run("Valid", func() {
+		suite.startServer(http.StatusOK, `{"status":"COMPLETE","runtimeData":{"kycStatus":"verified"}}`, nil)
+		defer suite.TearDownTest()
+
+		resp, err := suite.executor.Execute(suite.newContext(map[string]interface{}{"responseSchema": schema}))
+
+		suite.NoError(err)
+		suite.Equal(providers.ExecComplete, resp.Status)
+	})
+
+	suite.Run("Invalid", func() {
+		suite.startServer(http.StatusOK, `{"status":"COMPLETE","runtimeData":{"other":"x"}}`, nil)
+		defer suite.TearDownTest()
+
+		resp, err := suite.executor.Execute(suite.newContext(map[string]interface{}{"responseSchema": schema}))
+
+		suite.NoError(err)
+		suite.Equal(providers.ExecFailure, resp.Status)
+		suite.Equal(&ErrExternalTaskInvalidResponse, resp.Error)
+	})
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_ServerErrorIsReturnedAsError() {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusTooManyRequests} {
+		suite.startServer(status, `{}`, nil)
+
+		resp, err := suite.executor.Execute(suite.newContext(map[string]interface{}{}))
+
+		suite.Error(err)
+		suite.Nil(resp)
+		suite.TearDownTest()
+	}
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_ClientErrorRejectsRequest() {
+	suite.startServer(http.StatusBadRequest, `{}`, nil)
+
+	resp, err := suite.executor.Execute(suite.newContext(map[string]interface{}{}))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(&ErrExternalTaskRequestRejected, resp.Error)
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_AuthSchemes() {
+	suite.Run("Basic", func() {
+		var username, password string
+		suite.startServer(http.StatusOK, `{"status":"COMPLETE"}`, func(r *http.Request, _ externalTaskRequest) {
+			username, password, _ = r.BasicAuth()
+		})
+		defer suite.TearDownTest()
+
+		_, err := suite.executor.Execute(suite.newContext(map[string]interface{}{
+			"auth": map[string]interface{}{"type": "BASIC", "username": "client", "password": "pass"},
+		}))
+
+		suite.NoError(err)
+		suite.Equal("client", username)
+		suite.Equal("pass", password)
+	})
+
+	suite.Run("APIKeyDefaultHeader", func() {
+		var apiKey string
+		suite.startServer(http.StatusOK, `{"status":"COMPLETE"}`, func(r *http.Request, _ externalTaskRequest) {
+			apiKey = r.Header.Get(defaultExternalTaskAPIKeyHeader)
+		})
+		defer suite.TearDownTest()
+
+		_, err := suite.executor.Execute(suite.newContext(map[string]interface{}{
+			"auth": map[string]interface{}{"type": "API_KEY", "apiKey": "key-1"},
+		}))
+
+		suite.NoError(err)
+		suite.Equal("key-1", apiKey)
+	})
+}
+
+func (suite *ExternalTaskExecutorTestSuite) TestExecute_InvalidConfig() {
+	testCases := []struct {
+		name       string
+		properties map[string]interface{}
+	}{
+		{"MissingURL", map[string]interface{}{"url": ""}},
+		{"RelativeURL", map[string]interface{}{"url": "/kyc"}},
+		{"UnsupportedScheme", map[string]interface{}{"url": "ftp://kyc.example.com"}},
+		{"UnsupportedAuthType", map[string]interface{}{
+			"url": "https://kyc.example.com", "auth": map[string]interface{}{"type": "digest"}}},
+		{"BearerWithoutToken", map[string]interface{}{
+			"url": "https://kyc.example.com", "auth": map[string]interface{}{"type": "bearer"}}},
+		{"InvalidRuntimeData", map[string]interface{}{"url": "https://kyc.example.com", "runtimeData": "country"}},
+		{"InvalidSchema", map[string]interface{}{
+			"url": "https://kyc.example.com", "responseSchema": map[string]interface{}{"type": 5}}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := &providers.NodeContext{Context: context.Background(), NodeProperties: tc.properties}
+
+			resp, err := suite.executor.Execute(ctx)
+
+			suite.NoError(err)
+			suite.Equal(providers.ExecFailure, resp.Status)
+			suite.Equal(&ErrExternalTaskConfigInvalid, resp.Error)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// Home realm discovery routes written to RuntimeKeyHRDRoute.
+const (
+	hrdRouteIDP   = "idp"
+	hrdRouteOU    = "ou"
+	hrdRouteLocal = "local"
+)
+
+// hrdDomainMapping maps an email domain to an identity provider or an organization unit.
+type hrdDomainMapping struct {
+	domain   string
+	idpID    string
+	ouHandle string
+}
+
+// hrdExecutor performs home realm discovery. It takes the username entered by the user, matches
+// the domain of an email-style username against the domain mappings configured on the node and
+// the email domains of the organization units the application is shared with, and records the
+// selected route in the runtime data so that a DECISION node can branch to the matching
+// federation executor or to local login.
+type hrdExecutor struct {
+	providers.Executor
+	ouService ou.OrganizationUnitServiceInterface
+	logger    *log.Logger
+}
+
+var _ providers.Executor = (*hrdExecutor)(nil)
+
+// newHRDExecutor creates a new instance of hrdExecutor.
+func newHRDExecutor(
+	flowFactory core.FlowFactoryInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) *hrdExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, "HRDExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameHRD),
+	)
+	defaultInputs := []providers.Input{
+		{
+			Identifier: userAttributeUsername,
+			Type:       providers.InputTypeText,
+			Required:   true,
+		},
+	}
+
+	base := flowFactory.CreateExecutor(ExecutorNameHRD, providers.ExecutorTypeUtility,
+		defaultInputs, nil)
+
+	return &hrdExecutor{
+		Executor:  base,
+		ouService: ouService,
+		logger:    logger,
+	}
+}
+
+// Execute runs the home realm discovery logic.
+func (e *hrdExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Executing home realm discovery executor")
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	if !e.HasRequiredInputs(ctx, execResp) {
+		logger.Debug(ctx.Context, "Required inputs for home realm discovery executor are not provided")
+		execResp.Status = providers.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	domain := getEmailDomain(ctx.UserInputs[userAttributeUsername])
+	execResp.RuntimeData[common.RuntimeKeyHRDRoute] = hrdRouteLocal
+	if domain == "" {
+		logger.Debug(ctx.Context, "Username is not an email address; routing to local login")
+		execResp.Status = providers.ExecComplete
+		return execResp, nil
+	}
+
+	for _, mapping := range getDomainMappings(ctx) {
+		if !strings.EqualFold(mapping.domain, domain) {
+			continue
+		}
+		if mapping.idpID != "" {
+			logger.Debug(ctx.Context, "Domain mapped to an identity provider", log.String("idpID", mapping.idpID))
+			execResp.RuntimeData[common.RuntimeKeyHRDRoute] = hrdRouteIDP
+			execResp.RuntimeData[common.RuntimeKeyHRDIdpID] = mapping.idpID
+			execResp.Status = providers.ExecComplete
+			return execResp, nil
+		}
+
+		orgUnit, svcErr := e.ouService.GetOrganizationUnitByPath(ctx.Context, mapping.ouHandle)
+		if svcErr != nil {
+			logger.Error(ctx.Context, "Failed to resolve the organization unit of a domain mapping",
+				log.String("ouHandle", mapping.ouHandle), log.String("error", svcErr.Error.DefaultValue))
+			return nil, errors.New("failed to resolve organization unit for home realm discovery")
+		}
+		logger.Debug(ctx.Context, "Domain mapped to an organization unit", log.String("ouID", orgUnit.ID))
+		execResp.RuntimeData[common.RuntimeKeyHRDRoute] = hrdRouteOU
+		execResp.RuntimeData[common.RuntimeKeyHRDOUID] = orgUnit.ID
+		execResp.Status = providers.ExecComplete
+		return execResp, nil
+	}
+
+	if shared := ctx.Application.Sharing.FindByEmailDomain(ctx.UserInputs[userAttributeUsername]); shared != nil {
+		logger.Debug(ctx.Context, "Domain claimed by a shared organization unit", log.String("ouID", shared.OUID))
+		execResp.RuntimeData[common.RuntimeKeyHRDRoute] = hrdRouteOU
+		execResp.RuntimeData[common.RuntimeKeyHRDOUID] = shared.OUID
+		execResp.RuntimeData[common.RuntimeKeySharedOUID] = shared.OUID
+	}
+
+	execResp.Status = providers.ExecComplete
+	return execResp, nil
+}
+
+// getEmailDomain returns the lower-cased domain of an email address, or an empty string when the
+// value is not an email address.
+func getEmailDomain(value string) string {
+	at := strings.LastIndex(value, "@")
+	if at <= 0 || at == len(value)-1 {
+		return ""
+	}
+	return strings.ToLower(value[at+1:])
+}
+
+// getDomainMappings returns the domain mappings configured on the node. Mappings without a domain
+// or without exactly one of idpId and ouHandle are ignored.
+func getDomainMappings(ctx *providers.NodeContext) []hrdDomainMapping {
+	mappings := make([]hrdDomainMapping, 0)
+	items, ok := ctx.NodeProperties[propertyKeyDomainMappings].([]interface{})
+	if !ok {
+		return mappings
+	}
+	for _, item := range items {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mapping := hrdDomainMapping{}
+		mapping.domain, _ = entry["domain"].(string)
+		mapping.idpID, _ = entry["idpId"].(string)
+		mapping.ouHandle, _ = entry["ouHandle"].(string)
+		if mapping.domain == "" || (mapping.idpID == "") == (mapping.ouHandle == "") {
+			continue
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings
+}
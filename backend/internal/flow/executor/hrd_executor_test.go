@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+type HRDExecutorTestSuite struct {
+	suite.Suite
+	mockOUService *oumock.OrganizationUnitServiceInterfaceMock
+	mockExec      *coremock.ExecutorInterfaceMock
+	executor      *hrdExecutor
+}
+
+func TestHRDExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(HRDExecutorTestSuite))
+}
+
+func (suite *HRDExecutorTestSuite) SetupTest() {
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+
+	suite.mockExec = coremock.NewExecutorInterfaceMock(suite.T())
+	suite.mockExec.On("GetName").Return(ExecutorNameHRD).Maybe()
+	mockFlowFactory.On("CreateExecutor", ExecutorNameHRD, providers.ExecutorTypeUtility,
+		mock.Anything, mock.Anything).Return(suite.mockExec)
+
+	suite.executor = newHRDExecutor(mockFlowFactory, suite.mockOUService)
+}
+
+func (suite *HRDExecutorTestSuite) buildNodeContext(username string) *providers.NodeContext {
+	return &providers.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "flow-123",
+		UserInputs:  map[string]string{userAttributeUsername: username},
+		RuntimeData: map[string]string{},
+		NodeProperties: map[string]interface{}{
+			propertyKeyDomainMappings: []interface{}{
+				map[string]interface{}{"domain": "acme.com", "idpId": "idp-acme"},
+				map[string]interface{}{"domain": "globex.com", "ouHandle": "globex"},
+				map[string]interface{}{"domain": "invalid.com"},
+			},
+		},
+	}
+}
+
+func (suite *HRDExecutorTestSuite) TestExecute_InputRequired() {
+	suite.mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(false)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(""))
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+}
+
+func (suite *HRDExecutorTestSuite) TestExecute_RoutesToIdentityProvider() {
+	suite.mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(true)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext("alice@ACME.com"))
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), hrdRouteIDP, resp.RuntimeData[common.RuntimeKeyHRDRoute])
+	assert.Equal(suite.T(), "idp-acme", resp.RuntimeData[common.RuntimeKeyHRDIdpID])
+}
+
+func (suite *HRDExecutorTestSuite) TestExecute_RoutesToOrganizationUnit() {
+	suite.mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(true)
+	suite.mockOUService.On("GetOrganizationUnitByPath", mock.Anything, "globex").
+		Return(providers.OrganizationUnit{ID: "ou-globex", Handle: "globex"}, nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext("bob@globex.com"))
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), hrdRouteOU, resp.RuntimeData[common.RuntimeKeyHRDRoute])
+	assert.Equal(suite.T(), "ou-globex", resp.RuntimeData[common.RuntimeKeyHRDOUID])
+}
+
+func (suite *HRDExecutorTestSuite) TestExecute_OrganizationUnitLookupFails() {
+	suite.mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(true)
+	suite.mockOUService.On("GetOrganizationUnitByPath", mock.Anything, "globex").
+		Return(providers.OrganizationUnit{}, &tidcommon.InternalServerError)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext("bob@globex.com"))
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), resp)
+}
+
+func (suite *HRDExecutorTestSuite) TestExecute_RoutesToSharedOrganizationUnit() {
+	suite.mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(true)
+	ctx := suite.buildNodeContext("carol@initech.com")
+	ctx.Application = providers.Application{
+		InboundAuthProfile: providers.InboundAuthProfile{
+			Sharing: &providers.ApplicationSharing{
+				OrganizationUnits: []providers.SharedOrganizationUnit{
+					{OUID: "ou-initech", OUHandle: "initech", EmailDomains: []string{"initech.com"}},
+				},
+			},
+		},
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), hrdRouteOU, resp.RuntimeData[common.RuntimeKeyHRDRoute])
+	assert.Equal(suite.T(), "ou-initech", resp.RuntimeData[common.RuntimeKeyHRDOUID])
+	assert.Equal(suite.T(), "ou-initech", resp.RuntimeData[common.RuntimeKeySharedOUID])
+}
+
+func (suite *HRDExecutorTestSuite) TestExecute_RoutesToLocalLogin() {
+	suite.mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(true)
+
+	for _, username := range []string{"dave", "dave@unknown.com", "dave@invalid.com"} {
+		resp, err := suite.executor.Execute(suite.buildNodeContext(username))
+
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+		assert.Equal(suite.T(), hrdRouteLocal, resp.RuntimeData[common.RuntimeKeyHRDRoute], username)
+		assert.Empty(suite.T(), resp.RuntimeData[common.RuntimeKeyHRDIdpID])
+	}
+}
@@ -400,8 +400,7 @@ func (h *httpRequestExecutor) executeRequestWithRetry(ctx *providers.NodeContext
 		retryDelay = config.ErrorHandling.RetryDelay
 	}
 
-	httpClient := httpservice.NewHTTPClientWithTimeout(
-		time.Duration(config.Timeout) * time.Second)
+	httpClient := httpservice.NewOutboundHTTPClient(time.Duration(config.Timeout) * time.Second)
 
 	var lastErr error
 	attempts := retryCount + 1
@@ -19,6 +19,8 @@
 package executor
 
 import (
+	"context"
+
 	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/flow/common"
@@ -160,6 +162,20 @@ func (e *openid4vpVerifier) poll(
 	return execResp, nil
 }
 
+// AwaitStep checks whether the wallet has answered the request of the flow. The request state is read
+// once, without waiting.
+func (e *openid4vpVerifier) AwaitStep(ctx context.Context, runtimeData map[string]string) (bool, error) {
+	state := runtimeData[common.RuntimeKeyOpenID4VPState]
+	if state == "" {
+		return false, nil
+	}
+	rs, svcErr := e.service.GetResult(ctx, state)
+	if svcErr != nil {
+		return true, nil
+	}
+	return rs.Status != openid4vp.StatusPending, nil
+}
+
 // authenticate passes the verified presentation result through the authn provider
 // so that the provider resolves the holder's entity and populates AuthUser via
 // the standard chain, just like OAuth/OIDC/Passkey executors.
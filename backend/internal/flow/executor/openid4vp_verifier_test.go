@@ -245,3 +245,46 @@ func TestOpenID4VPExecutorPollExpired(t *testing.T) {
 	assert.Equal(t, providers.ExecFailure, resp.Status)
 	assert.Equal(t, ErrOpenID4VPExpired.Code, resp.Error.Code)
 }
+
+func TestOpenID4VPExecutorAwaitStep(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   openid4vp.Status
+		svcErr   *tidcommon.ServiceError
+		expected bool
+	}{
+		{"Pending", openid4vp.StatusPending, nil, false},
+		{"Completed", openid4vp.StatusCompleted, nil, true},
+		{"Failed", openid4vp.StatusFailed, nil, true},
+		{"NotFound", "", &tidcommon.ServiceError{Code: "VP-1001"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeOpenID4VPService{
+				getResult: func(_ context.Context, state string) (*openid4vp.RequestState, *tidcommon.ServiceError) {
+					assert.Equal(t, "state-123", state)
+					if tc.svcErr != nil {
+						return nil, tc.svcErr
+					}
+					return &openid4vp.RequestState{State: state, Status: tc.status}, nil
+				},
+			}
+			exec := newTestOpenID4VPExecutor(t, svc).(*openid4vpVerifier)
+
+			resolved, err := exec.AwaitStep(context.Background(),
+				map[string]string{common.RuntimeKeyOpenID4VPState: "state-123"})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, resolved)
+		})
+	}
+}
+
+// Before the request is initiated there is nothing to wait for.
+func TestOpenID4VPExecutorAwaitStepNotStarted(t *testing.T) {
+	exec := newTestOpenID4VPExecutor(t, &fakeOpenID4VPService{}).(*openid4vpVerifier)
+
+	resolved, err := exec.AwaitStep(context.Background(), map[string]string{})
+	require.NoError(t, err)
+	assert.False(t, resolved)
+}
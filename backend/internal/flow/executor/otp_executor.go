@@ -160,6 +160,12 @@ func (e *otpExecutor) executeGenerate(ctx *providers.NodeContext,
 
 	sessionToken, otpValue, expirySeconds, svcErr := e.otpService.GenerateOTP(ctx.Context, recipient, recipientAttr)
 	if svcErr != nil {
+		if svcErr.Code == otp.ErrorOTPResendThrottled.Code {
+			logger.Debug(ctx.Context, "OTP generate: resend throttled")
+			execResp.Status = providers.ExecFailure
+			execResp.Error = &ErrOTPResendThrottled
+			return execResp, nil
+		}
 		return execResp, fmt.Errorf("failed to generate OTP: %s", svcErr.ErrorDescription.DefaultValue)
 	}
 
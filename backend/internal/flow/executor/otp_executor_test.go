@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/authn/otp"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
@@ -720,6 +721,32 @@ func (suite *OTPExecutorTestSuite) TestExecuteGenerate_GenerateOTPError_ReturnsE
 	assert.Error(suite.T(), err)
 }
 
+func (suite *OTPExecutorTestSuite) TestExecuteGenerate_ResendThrottled_ReturnsFailure() {
+	userID := testOTPUserID
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return(&userID, nil)
+	suite.mockOTPService.On("GenerateOTP", mock.Anything, userID, authnprovidercm.UserAttributeUserID).
+		Return("", "", int64(0), &otp.ErrorOTPResendThrottled)
+
+	ctx := &providers.NodeContext{
+		ExecutionID:  "exec-gen-throttled",
+		FlowType:     providers.FlowTypeAuthentication,
+		ExecutorMode: ExecutorModeGenerate,
+		NodeInputs: []providers.Input{
+			{Ref: "mobile_input", Identifier: common.AttributeMobileNumber,
+				Type: providers.InputTypePhone, Required: true},
+		},
+		UserInputs:  map[string]string{common.AttributeMobileNumber: "+1234567890"},
+		RuntimeData: map[string]string{},
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrOTPResendThrottled.Code, resp.Error.Code)
+	assert.Empty(suite.T(), resp.RuntimeData[common.RuntimeKeyOTPSessionToken])
+}
+
 // validateAttempts: invalid count string
 
 func (suite *OTPExecutorTestSuite) TestExecuteGenerate_InvalidAttemptCount_ReturnsError() {
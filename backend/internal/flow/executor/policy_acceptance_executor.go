@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/policy"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// policyAcceptanceExecutor prompts the authenticated user to accept the current version of the
+// policies configured on the node (for example terms of service) and records the acceptance.
+// Users who already accepted the current version of every policy pass through without a prompt.
+type policyAcceptanceExecutor struct {
+	providers.Executor
+	policyService policy.PolicyServiceInterface
+	authnProvider providers.AuthnProviderManager
+	logger        *log.Logger
+}
+
+var _ providers.Executor = (*policyAcceptanceExecutor)(nil)
+
+// newPolicyAcceptanceExecutor creates a new instance of policyAcceptanceExecutor.
+func newPolicyAcceptanceExecutor(
+	flowFactory core.FlowFactoryInterface,
+	policyService policy.PolicyServiceInterface,
+	authnProvider providers.AuthnProviderManager,
+) *policyAcceptanceExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, "PolicyAcceptanceExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNamePolicyAcceptance),
+	)
+	defaultInputs := []providers.Input{
+		{
+			Identifier: userInputAcceptedPolicies,
+			Type:       providers.InputTypeConsent,
+			Required:   true,
+		},
+	}
+	prerequisites := []providers.Input{
+		{
+			Identifier: userAttributeUserID,
+			Type:       providers.InputTypeText,
+			Required:   true,
+		},
+	}
+
+	base := flowFactory.CreateExecutor(ExecutorNamePolicyAcceptance, providers.ExecutorTypeUtility,
+		defaultInputs, prerequisites)
+
+	return &policyAcceptanceExecutor{
+		Executor:      base,
+		policyService: policyService,
+		authnProvider: authnProvider,
+		logger:        logger,
+	}
+}
+
+// Execute runs the policy acceptance logic.
+func (e *policyAcceptanceExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug(ctx.Context, "Executing policy acceptance executor")
+
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	if !e.ValidatePrerequisites(ctx, execResp, e.authnProvider) {
+		logger.Debug(ctx.Context, "Prerequisites validation failed for policy acceptance executor")
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrPrerequisitesFailed
+		return execResp, nil
+	}
+
+	if !execResp.AuthUser.IsAuthenticated() {
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrUserNotAuthenticated
+		return execResp, nil
+	}
+
+	authUser, entityRef, svcErr := e.authnProvider.GetEntityReference(ctx.Context, execResp.AuthUser)
+	execResp.AuthUser = authUser
+	if svcErr != nil {
+		return execResp, errors.New("failed to get entity reference from AuthUser")
+	}
+
+	handles := getPolicyHandles(ctx)
+	if len(handles) == 0 {
+		logger.Debug(ctx.Context, "No policies configured; completing policy acceptance executor")
+		execResp.Status = providers.ExecComplete
+		return execResp, nil
+	}
+
+	pending, svcErr := e.policyService.GetPendingPolicies(ctx.Context, entityRef.EntityID, handles)
+	if svcErr != nil {
+		logger.Error(ctx.Context, "Failed to resolve pending policies", log.Any("error", svcErr))
+		return nil, errors.New("failed to resolve pending policies")
+	}
+	if len(pending) == 0 {
+		logger.Debug(ctx.Context, "Current version of every policy is accepted")
+		execResp.Status = providers.ExecComplete
+		return execResp, nil
+	}
+
+	if !e.HasRequiredInputs(ctx, execResp) || !wasPromptedFor(ctx, pending) {
+		// A version published after the prompt was shown must be accepted on a fresh prompt.
+		return e.promptPolicies(ctx, execResp, pending)
+	}
+
+	accepted := strings.Fields(strings.ReplaceAll(ctx.UserInputs[userInputAcceptedPolicies], ",", " "))
+	for _, pendingPolicy := range pending {
+		if !slices.Contains(accepted, pendingPolicy.Handle) {
+			logger.Debug(ctx.Context, "Pending policy was not accepted", log.String("handle", pendingPolicy.Handle))
+			execResp.Status = providers.ExecFailure
+			execResp.Error = &ErrPoliciesNotAccepted
+			return execResp, nil
+		}
+	}
+
+	if svcErr := e.policyService.RecordPolicyAcceptance(ctx.Context, entityRef.EntityID, pending); svcErr != nil {
+		logger.Error(ctx.Context, "Failed to record policy acceptance", log.Any("error", svcErr))
+		return nil, errors.New("failed to record policy acceptance")
+	}
+
+	logger.Debug(ctx.Context, "Policy acceptance recorded", log.Int("policyCount", len(pending)))
+	execResp.Status = providers.ExecComplete
+	return execResp, nil
+}
+
+// promptPolicies asks the user to accept the pending policy versions.
+func (e *policyAcceptanceExecutor) promptPolicies(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse, pending []policy.PolicyVersion) (*providers.ExecutorResponse, error) {
+	promptJSON, err := json.Marshal(pending)
+	if err != nil {
+		e.logger.Error(ctx.Context, "Failed to marshal policy prompt data", log.Error(err))
+		return nil, errors.New("failed to prepare policy prompt data")
+	}
+
+	prompted := make(map[string]string, len(pending))
+	for _, pendingPolicy := range pending {
+		prompted[pendingPolicy.Handle] = pendingPolicy.Version
+	}
+	promptedJSON, err := json.Marshal(prompted)
+	if err != nil {
+		e.logger.Error(ctx.Context, "Failed to marshal prompted policies", log.Error(err))
+		return nil, errors.New("failed to prepare policy prompt data")
+	}
+
+	if len(execResp.Inputs) == 0 {
+		execResp.Inputs = append(execResp.Inputs, e.GetRequiredInputs(ctx)...)
+	}
+	execResp.AdditionalData[common.DataPolicyPrompt] = string(promptJSON)
+	execResp.RuntimeData[common.RuntimeKeyPromptedPolicies] = string(promptedJSON)
+	execResp.Status = providers.ExecUserInputRequired
+	return execResp, nil
+}
+
+// wasPromptedFor reports whether the user was shown exactly the given policy versions.
+func wasPromptedFor(ctx *providers.NodeContext, pending []policy.PolicyVersion) bool {
+	var prompted map[string]string
+	if err := json.Unmarshal([]byte(ctx.RuntimeData[common.RuntimeKeyPromptedPolicies]), &prompted); err != nil {
+		return false
+	}
+	for _, pendingPolicy := range pending {
+		if prompted[pendingPolicy.Handle] != pendingPolicy.Version {
+			return false
+		}
+	}
+	return true
+}
+
+// getPolicyHandles returns the policy handles configured on the node.
+func getPolicyHandles(ctx *providers.NodeContext) []string {
+	handles := make([]string, 0)
+	if v, ok := ctx.NodeProperties[propertyKeyPolicies].([]interface{}); ok {
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				handles = append(handles, s)
+			}
+		}
+	}
+	return handles
+}
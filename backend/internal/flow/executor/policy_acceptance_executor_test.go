@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/policy"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/policymock"
+)
+
+type PolicyAcceptanceExecutorTestSuite struct {
+	suite.Suite
+	mockPolicyService *policymock.PolicyServiceInterfaceMock
+	mockAuthnProvider *managermock.AuthnProviderManagerMock
+	mockExec          *coremock.ExecutorInterfaceMock
+	executor          *policyAcceptanceExecutor
+	pending           []policy.PolicyVersion
+}
+
+func TestPolicyAcceptanceExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyAcceptanceExecutorTestSuite))
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) SetupTest() {
+	suite.mockPolicyService = policymock.NewPolicyServiceInterfaceMock(suite.T())
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerMock(suite.T())
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+
+	suite.mockExec = coremock.NewExecutorInterfaceMock(suite.T())
+	suite.mockExec.On("GetName").Return(ExecutorNamePolicyAcceptance).Maybe()
+	suite.mockExec.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
+		{Identifier: userInputAcceptedPolicies, Type: providers.InputTypeConsent, Required: true},
+	}).Maybe()
+	mockFlowFactory.On("CreateExecutor", ExecutorNamePolicyAcceptance, providers.ExecutorTypeUtility,
+		mock.AnythingOfType("[]providers.Input"), mock.AnythingOfType("[]providers.Input")).Return(suite.mockExec)
+
+	suite.executor = newPolicyAcceptanceExecutor(mockFlowFactory, suite.mockPolicyService, suite.mockAuthnProvider)
+	suite.pending = []policy.PolicyVersion{
+		{Handle: "terms-of-service", Version: "2.0"},
+		{Handle: "privacy-policy", Version: "1.1"},
+	}
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) buildNodeContext() *providers.NodeContext {
+	return &providers.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "flow-123",
+		AuthUser:    buildConsentAuthUser(),
+		UserInputs:  map[string]string{},
+		RuntimeData: map[string]string{},
+		NodeProperties: map[string]interface{}{
+			propertyKeyPolicies: []interface{}{"terms-of-service", "privacy-policy"},
+		},
+	}
+}
+
+// setupAuthenticatedUser sets up the mocks for an authenticated user who passes the prerequisites.
+func (suite *PolicyAcceptanceExecutorTestSuite) setupAuthenticatedUser(hasInputs bool) {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	suite.mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(hasInputs).Maybe()
+	suite.mockAuthnProvider.On("GetEntityReference", mock.Anything, mock.Anything).
+		Return(buildConsentAuthUser(), buildConsentEntityRef(), (*tidcommon.ServiceError)(nil))
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_PrerequisitesFailure() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(false)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrPrerequisitesFailed.Code, resp.Error.Code)
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_NoPoliciesConfigured() {
+	suite.setupAuthenticatedUser(false)
+	ctx := suite.buildNodeContext()
+	ctx.NodeProperties = map[string]interface{}{}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_AllPoliciesAccepted() {
+	suite.setupAuthenticatedUser(false)
+	suite.mockPolicyService.On("GetPendingPolicies", mock.Anything, testUserID,
+		[]string{"terms-of-service", "privacy-policy"}).Return([]policy.PolicyVersion{}, nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_PromptsForPendingPolicies() {
+	suite.setupAuthenticatedUser(false)
+	suite.mockPolicyService.On("GetPendingPolicies", mock.Anything, testUserID, mock.Anything).
+		Return(suite.pending, nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.Contains(suite.T(), resp.AdditionalData[common.DataPolicyPrompt], `"version":"2.0"`)
+	assert.JSONEq(suite.T(), `{"terms-of-service":"2.0","privacy-policy":"1.1"}`,
+		resp.RuntimeData[common.RuntimeKeyPromptedPolicies])
+	assert.Equal(suite.T(), userInputAcceptedPolicies, resp.Inputs[0].Identifier)
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_RecordsAcceptance() {
+	suite.setupAuthenticatedUser(true)
+	suite.mockPolicyService.On("GetPendingPolicies", mock.Anything, testUserID, mock.Anything).
+		Return(suite.pending, nil)
+	suite.mockPolicyService.On("RecordPolicyAcceptance", mock.Anything, testUserID, suite.pending).Return(nil)
+	ctx := suite.buildNodeContext()
+	ctx.UserInputs[userInputAcceptedPolicies] = "terms-of-service,privacy-policy"
+	ctx.RuntimeData[common.RuntimeKeyPromptedPolicies] = `{"terms-of-service":"2.0","privacy-policy":"1.1"}`
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecComplete, resp.Status)
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_PartialAcceptanceFails() {
+	suite.setupAuthenticatedUser(true)
+	suite.mockPolicyService.On("GetPendingPolicies", mock.Anything, testUserID, mock.Anything).
+		Return(suite.pending, nil)
+	ctx := suite.buildNodeContext()
+	ctx.UserInputs[userInputAcceptedPolicies] = "terms-of-service"
+	ctx.RuntimeData[common.RuntimeKeyPromptedPolicies] = `{"terms-of-service":"2.0","privacy-policy":"1.1"}`
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), ErrPoliciesNotAccepted.Code, resp.Error.Code)
+	suite.mockPolicyService.AssertNotCalled(suite.T(), "RecordPolicyAcceptance", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_NewVersionPublishedAfterPromptReprompts() {
+	suite.setupAuthenticatedUser(true)
+	suite.mockPolicyService.On("GetPendingPolicies", mock.Anything, testUserID, mock.Anything).
+		Return(suite.pending, nil)
+	ctx := suite.buildNodeContext()
+	ctx.UserInputs[userInputAcceptedPolicies] = "terms-of-service privacy-policy"
+	ctx.RuntimeData[common.RuntimeKeyPromptedPolicies] = `{"terms-of-service":"1.0","privacy-policy":"1.1"}`
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), providers.ExecUserInputRequired, resp.Status)
+	assert.JSONEq(suite.T(), `{"terms-of-service":"2.0","privacy-policy":"1.1"}`,
+		resp.RuntimeData[common.RuntimeKeyPromptedPolicies])
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_ServiceErrors() {
+	suite.Run("GetPendingPolicies", func() {
+		suite.SetupTest()
+		suite.setupAuthenticatedUser(false)
+		suite.mockPolicyService.On("GetPendingPolicies", mock.Anything, testUserID, mock.Anything).
+			Return(nil, &tidcommon.InternalServerError)
+
+		resp, err := suite.executor.Execute(suite.buildNodeContext())
+
+		assert.Error(suite.T(), err)
+		assert.Nil(suite.T(), resp)
+	})
+
+	suite.Run("RecordPolicyAcceptance", func() {
+		suite.SetupTest()
+		suite.setupAuthenticatedUser(true)
+		suite.mockPolicyService.On("GetPendingPolicies", mock.Anything, testUserID, mock.Anything).
+			Return(suite.pending, nil)
+		suite.mockPolicyService.On("RecordPolicyAcceptance", mock.Anything, testUserID, suite.pending).
+			Return(&tidcommon.InternalServerError)
+		ctx := suite.buildNodeContext()
+		ctx.UserInputs[userInputAcceptedPolicies] = "terms-of-service privacy-policy"
+		ctx.RuntimeData[common.RuntimeKeyPromptedPolicies] = `{"terms-of-service":"2.0","privacy-policy":"1.1"}`
+
+		resp, err := suite.executor.Execute(ctx)
+
+		assert.Error(suite.T(), err)
+		assert.Nil(suite.T(), resp)
+	})
+}
+
+func (suite *PolicyAcceptanceExecutorTestSuite) TestExecute_EntityReferenceError() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	suite.mockAuthnProvider.On("GetEntityReference", mock.Anything, mock.Anything).
+		Return(buildConsentAuthUser(), nil, &tidcommon.InternalServerError)
+
+	_, err := suite.executor.Execute(suite.buildNodeContext())
+
+	assert.Equal(suite.T(), errors.New("failed to get entity reference from AuthUser"), err)
+}
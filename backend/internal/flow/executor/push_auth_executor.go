@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/push"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// pushAuthExecutor sends an approval request to the registered push devices of the user and then
+// polls until the request is decided on a device. The number returned to the client is shown on the
+// sign-in screen and must be selected on the device, so that a user cannot approve a request they
+// did not start by reflex.
+type pushAuthExecutor struct {
+	providers.Executor
+	pushService   push.PushAuthServiceInterface
+	authnProvider providers.AuthnProviderManager
+	logger        *log.Logger
+}
+
+var _ providers.Executor = (*pushAuthExecutor)(nil)
+var _ AsyncStepExecutor = (*pushAuthExecutor)(nil)
+
+// newPushAuthExecutor creates a new instance of pushAuthExecutor.
+func newPushAuthExecutor(
+	flowFactory core.FlowFactoryInterface,
+	pushService push.PushAuthServiceInterface,
+	authnProvider providers.AuthnProviderManager,
+) *pushAuthExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, "PushAuthExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNamePushAuth),
+	)
+	prerequisites := []providers.Input{
+		{
+			Identifier: userAttributeUserID,
+			Type:       providers.InputTypeText,
+			Required:   true,
+		},
+	}
+
+	base := flowFactory.CreateExecutor(ExecutorNamePushAuth, providers.ExecutorTypeAuthentication,
+		[]providers.Input{}, prerequisites)
+
+	return &pushAuthExecutor{
+		Executor:      base,
+		pushService:   pushService,
+		authnProvider: authnProvider,
+		logger:        logger,
+	}
+}
+
+// Execute sends the approval request on first entry and polls for the decision on subsequent entries.
+func (e *pushAuthExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	execResp := &providers.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+		AuthUser:       ctx.AuthUser,
+	}
+
+	challengeID := ctx.RuntimeData[common.RuntimeKeyPushChallengeID]
+	if challengeID == "" {
+		return e.initiate(ctx, execResp, logger)
+	}
+	return e.poll(ctx, challengeID, execResp, logger)
+}
+
+// initiate sends a new approval request to the devices of the user and returns the number to show.
+func (e *pushAuthExecutor) initiate(
+	ctx *providers.NodeContext, execResp *providers.ExecutorResponse, logger *log.Logger,
+) (*providers.ExecutorResponse, error) {
+	if !e.ValidatePrerequisites(ctx, execResp, e.authnProvider) {
+		logger.Debug(ctx.Context, "Prerequisites validation failed for push authentication executor")
+		execResp.Status = providers.ExecFailure
+		execResp.Error = &ErrPrerequisitesFailed
+		return execResp, nil
+	}
+
+	userID, err := e.resolveUserID(ctx, execResp)
+	if err != nil {
+		return execResp, err
+	}
+
+	challengeCtx := push.ChallengeContext{ApplicationName: ctx.Application.Name}
+	if clientIP := sysContext.GetClientIP(ctx.Context); clientIP.IsValid() {
+		challengeCtx.ClientIP = clientIP.String()
+	}
+	challenge, svcErr := e.pushService.InitiateChallenge(ctx.Context, userID, challengeCtx)
+	if svcErr != nil {
+		execResp.Status = providers.ExecFailure
+		if svcErr.Code == push.ErrorNoRegisteredDevice.Code {
+			logger.Debug(ctx.Context, "User has no registered push device")
+			execResp.Error = &ErrPushNoRegisteredDevice
+			return execResp, nil
+		}
+		logger.Error(ctx.Context, "Failed to initiate push approval request",
+			log.String("errorCode", svcErr.Code))
+		execResp.Error = &ErrPushInitiateFailed
+		return execResp, nil
+	}
+
+	execResp.RuntimeData[common.RuntimeKeyPushChallengeID] = challenge.ID
+	setPushData(execResp, challenge)
+	execResp.Status = providers.ExecUserInputRequired
+	return execResp, nil
+}
+
+// resolveUserID returns the ID of the user to send the approval request to, either identified by a
+// previous node or authenticated by a previous authentication step.
+func (e *pushAuthExecutor) resolveUserID(ctx *providers.NodeContext,
+	execResp *providers.ExecutorResponse) (string, error) {
+	if userID := ctx.RuntimeData[userAttributeUserID]; userID != "" {
+		return userID, nil
+	}
+
+	authUser, entityRef, svcErr := e.authnProvider.GetEntityReference(ctx.Context, execResp.AuthUser)
+	execResp.AuthUser = authUser
+	if svcErr != nil || entityRef == nil || entityRef.EntityID == "" {
+		return "", errors.New("failed to get entity reference from AuthUser")
+	}
+	return entityRef.EntityID, nil
+}
+
+// poll waits for the decision on the approval request, completing, failing, or continuing to wait.
+func (e *pushAuthExecutor) poll(
+	ctx *providers.NodeContext, challengeID string, execResp *providers.ExecutorResponse, logger *log.Logger,
+) (*providers.ExecutorResponse, error) {
+	challenge, svcErr := e.pushService.WaitForDecision(ctx.Context, challengeID)
+	if svcErr != nil {
+		if svcErr.Code != push.ErrorChallengeNotFound.Code {
+			return execResp, errors.New("failed to get the state of the push approval request")
+		}
+		logger.Debug(ctx.Context, "Push approval request not found or expired")
+		failPushChallenge(execResp, &ErrPushExpired)
+		return execResp, nil
+	}
+
+	switch challenge.Status {
+	case push.ChallengeStatusApproved:
+		e.authenticate(ctx, challengeID, execResp, logger)
+		if execResp.Status == providers.ExecFailure {
+			return execResp, nil
+		}
+		delete(ctx.RuntimeData, common.RuntimeKeyPushChallengeID)
+		execResp.Status = providers.ExecComplete
+	case push.ChallengeStatusDenied:
+		logger.Debug(ctx.Context, "Push approval request denied on the device",
+			log.String("reason", challenge.DenyReason))
+		if challenge.DenyReason == push.DenyReasonNumberMismatch {
+			failPushChallenge(execResp, &ErrPushNumberMismatch)
+		} else {
+			failPushChallenge(execResp, &ErrPushDenied)
+		}
+	case push.ChallengeStatusExpired:
+		failPushChallenge(execResp, &ErrPushExpired)
+	default:
+		// Still pending: keep the request, re-emit the number so the wait view keeps rendering it
+		// across polls, and keep the client polling.
+		execResp.RuntimeData[common.RuntimeKeyPushChallengeID] = challengeID
+		setPushData(execResp, challenge)
+		execResp.Status = providers.ExecUserInputRequired
+	}
+	return execResp, nil
+}
+
+// AwaitStep waits for the decision on the approval request of the flow.
+func (e *pushAuthExecutor) AwaitStep(ctx context.Context, runtimeData map[string]string) (bool, error) {
+	challengeID := runtimeData[common.RuntimeKeyPushChallengeID]
+	if challengeID == "" {
+		return false, nil
+	}
+	challenge, svcErr := e.pushService.WaitForDecision(ctx, challengeID)
+	if svcErr != nil {
+		if svcErr.Code == push.ErrorChallengeNotFound.Code {
+			return true, nil
+		}
+		return false, errors.New("failed to get the state of the push approval request")
+	}
+	return challenge.Status != push.ChallengeStatusPending, nil
+}
+
+// authenticate passes the approved request through the authn provider so that the provider resolves
+// the entity of the user and populates AuthUser via the standard chain.
+func (e *pushAuthExecutor) authenticate(
+	ctx *providers.NodeContext, challengeID string, execResp *providers.ExecutorResponse, logger *log.Logger,
+) {
+	credentials := map[string]interface{}{
+		"push": &authncommon.PushCredential{ChallengeID: challengeID},
+	}
+
+	authUser, authenticatedClaims, svcErr := e.authnProvider.AuthenticateUser(
+		ctx.Context, nil, credentials, nil, nil, execResp.AuthUser)
+	execResp.AuthUser = authUser
+	if svcErr != nil {
+		logger.Debug(ctx.Context, "Push authentication through provider failed",
+			log.String("errorCode", svcErr.Code))
+		failPushChallenge(execResp, &ErrPushAuthenticationFailed)
+		return
+	}
+
+	for key, value := range authenticatedClaims {
+		execResp.RuntimeData[key] = systemutils.ConvertInterfaceValueToString(value)
+	}
+}
+
+// setPushData populates the number and expiry of the approval request for the client.
+func setPushData(execResp *providers.ExecutorResponse, challenge *push.Challenge) {
+	execResp.AdditionalData[common.DataPushMatchNumber] = strconv.Itoa(challenge.Number)
+	execResp.AdditionalData[common.DataPushExpiresAt] = challenge.ExpiresAt.UTC().Format(time.RFC3339)
+}
+
+// failPushChallenge fails the node and clears the approval request, so that a retry of the node
+// sends a new request.
+func failPushChallenge(execResp *providers.ExecutorResponse, svcErr *tidcommon.ServiceError) {
+	execResp.RuntimeData[common.RuntimeKeyPushChallengeID] = ""
+	execResp.Status = providers.ExecFailure
+	execResp.Error = svcErr
+}
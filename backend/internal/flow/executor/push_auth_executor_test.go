@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncommon "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/push"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/pushmock"
+	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+const pushTestChallengeID = "challenge-1"
+
+type PushAuthExecutorTestSuite struct {
+	suite.Suite
+	mockPushService   *pushmock.PushAuthServiceInterfaceMock
+	mockAuthnProvider *managermock.AuthnProviderManagerMock
+	mockExec          *coremock.ExecutorInterfaceMock
+	executor          *pushAuthExecutor
+}
+
+func TestPushAuthExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(PushAuthExecutorTestSuite))
+}
+
+func (suite *PushAuthExecutorTestSuite) SetupTest() {
+	suite.mockPushService = pushmock.NewPushAuthServiceInterfaceMock(suite.T())
+	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerMock(suite.T())
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+
+	suite.mockExec = coremock.NewExecutorInterfaceMock(suite.T())
+	suite.mockExec.On("GetName").Return(ExecutorNamePushAuth).Maybe()
+	mockFlowFactory.On("CreateExecutor", ExecutorNamePushAuth, providers.ExecutorTypeAuthentication,
+		mock.AnythingOfType("[]providers.Input"), mock.AnythingOfType("[]providers.Input")).Return(suite.mockExec)
+
+	suite.executor = newPushAuthExecutor(mockFlowFactory, suite.mockPushService, suite.mockAuthnProvider)
+}
+
+func (suite *PushAuthExecutorTestSuite) buildNodeContext(runtime map[string]string) *providers.NodeContext {
+	if runtime == nil {
+		runtime = map[string]string{}
+	}
+	return &providers.NodeContext{
+		Context:     sysContext.WithClientIP(context.Background(), netip.MustParseAddr("203.0.113.10")),
+		ExecutionID: "flow-123",
+		FlowType:    providers.FlowTypeAuthentication,
+		Application: providers.Application{Name: "Console"},
+		UserInputs:  map[string]string{},
+		RuntimeData: runtime,
+	}
+}
+
+func buildPushChallenge(status push.ChallengeStatus) *push.Challenge {
+	return &push.Challenge{
+		ID:        pushTestChallengeID,
+		UserID:    testUserID,
+		Number:    42,
+		Status:    status,
+		ExpiresAt: time.Date(2026, 1, 1, 10, 2, 0, 0, time.UTC),
+	}
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_PrerequisitesFailure() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(false)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(nil))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(ErrPrerequisitesFailed.Code, resp.Error.Code)
+	suite.mockPushService.AssertNotCalled(suite.T(), "InitiateChallenge", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_Initiate() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	suite.mockPushService.On("InitiateChallenge", mock.Anything, testUserID, push.ChallengeContext{
+		ApplicationName: "Console",
+		ClientIP:        "203.0.113.10",
+	}).Return(buildPushChallenge(push.ChallengeStatusPending), nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(map[string]string{userAttributeUserID: testUserID}))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Equal(pushTestChallengeID, resp.RuntimeData[common.RuntimeKeyPushChallengeID])
+	suite.Equal("42", resp.AdditionalData[common.DataPushMatchNumber])
+	suite.Equal("2026-01-01T10:02:00Z", resp.AdditionalData[common.DataPushExpiresAt])
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_InitiateForAuthenticatedUser() {
+	suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true)
+	suite.mockAuthnProvider.On("GetEntityReference", mock.Anything, mock.Anything).
+		Return(buildConsentAuthUser(), buildConsentEntityRef(), (*tidcommon.ServiceError)(nil))
+	suite.mockPushService.On("InitiateChallenge", mock.Anything, testUserID, mock.Anything).
+		Return(buildPushChallenge(push.ChallengeStatusPending), nil)
+
+	ctx := suite.buildNodeContext(nil)
+	ctx.AuthUser = buildConsentAuthUser()
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Equal(pushTestChallengeID, resp.RuntimeData[common.RuntimeKeyPushChallengeID])
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_InitiateFailures() {
+	testCases := []struct {
+		name        string
+		svcErr      *tidcommon.ServiceError
+		expectedErr string
+	}{
+		{"NoRegisteredDevice", &push.ErrorNoRegisteredDevice, ErrPushNoRegisteredDevice.Code},
+		{"DeliveryFailed", &tidcommon.InternalServerError, ErrPushInitiateFailed.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything, mock.Anything).Return(true)
+			suite.mockPushService.On("InitiateChallenge", mock.Anything, testUserID, mock.Anything).
+				Return(nil, tc.svcErr)
+
+			resp, err := suite.executor.Execute(
+				suite.buildNodeContext(map[string]string{userAttributeUserID: testUserID}))
+
+			suite.NoError(err)
+			suite.Equal(providers.ExecFailure, resp.Status)
+			suite.Equal(tc.expectedErr, resp.Error.Code)
+		})
+	}
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_PollPending() {
+	suite.mockPushService.On("WaitForDecision", mock.Anything, pushTestChallengeID).
+		Return(buildPushChallenge(push.ChallengeStatusPending), nil)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(
+		map[string]string{common.RuntimeKeyPushChallengeID: pushTestChallengeID}))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecUserInputRequired, resp.Status)
+	suite.Equal(pushTestChallengeID, resp.RuntimeData[common.RuntimeKeyPushChallengeID])
+	suite.Equal("42", resp.AdditionalData[common.DataPushMatchNumber])
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_PollApproved() {
+	suite.mockPushService.On("WaitForDecision", mock.Anything, pushTestChallengeID).
+		Return(buildPushChallenge(push.ChallengeStatusApproved), nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything,
+		map[string]interface{}{"push": &authncommon.PushCredential{ChallengeID: pushTestChallengeID}},
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(buildConsentAuthUser(), providers.AuthenticatedClaims{"userID": testUserID},
+			(*tidcommon.ServiceError)(nil))
+
+	ctx := suite.buildNodeContext(map[string]string{common.RuntimeKeyPushChallengeID: pushTestChallengeID})
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecComplete, resp.Status)
+	suite.Equal(testUserID, resp.RuntimeData["userID"])
+	suite.NotContains(ctx.RuntimeData, common.RuntimeKeyPushChallengeID)
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_PollApprovedAuthenticationFailure() {
+	suite.mockPushService.On("WaitForDecision", mock.Anything, pushTestChallengeID).
+		Return(buildPushChallenge(push.ChallengeStatusApproved), nil)
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(providers.AuthUser{}, providers.AuthenticatedClaims(nil), &tidcommon.InternalServerError)
+
+	resp, err := suite.executor.Execute(suite.buildNodeContext(
+		map[string]string{common.RuntimeKeyPushChallengeID: pushTestChallengeID}))
+
+	suite.NoError(err)
+	suite.Equal(providers.ExecFailure, resp.Status)
+	suite.Equal(ErrPushAuthenticationFailed.Code, resp.Error.Code)
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_PollFailures() {
+	denied := buildPushChallenge(push.ChallengeStatusDenied)
+	denied.DenyReason = push.DenyReasonRejected
+	mismatch := buildPushChallenge(push.ChallengeStatusDenied)
+	mismatch.DenyReason = push.DenyReasonNumberMismatch
+
+	testCases := []struct {
+		name        string
+		challenge   *push.Challenge
+		svcErr      *tidcommon.ServiceError
+		expectedErr string
+	}{
+		{"Denied", denied, nil, ErrPushDenied.Code},
+		{"NumberMismatch", mismatch, nil, ErrPushNumberMismatch.Code},
+		{"Expired", buildPushChallenge(push.ChallengeStatusExpired), nil, ErrPushExpired.Code},
+		{"NotFound", nil, &push.ErrorChallengeNotFound, ErrPushExpired.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockPushService.On("WaitForDecision", mock.Anything, pushTestChallengeID).
+				Return(tc.challenge, tc.svcErr)
+
+			resp, err := suite.executor.Execute(suite.buildNodeContext(
+				map[string]string{common.RuntimeKeyPushChallengeID: pushTestChallengeID}))
+
+			suite.NoError(err)
+			suite.Equal(providers.ExecFailure, resp.Status)
+			suite.Equal(tc.expectedErr, resp.Error.Code)
+			suite.Empty(resp.RuntimeData[common.RuntimeKeyPushChallengeID])
+		})
+	}
+}
+
+func (suite *PushAuthExecutorTestSuite) TestExecute_PollServerError() {
+	suite.mockPushService.On("WaitForDecision", mock.Anything, pushTestChallengeID).
+		Return(nil, &tidcommon.InternalServerError)
+
+	_, err := suite.executor.Execute(suite.buildNodeContext(
+		map[string]string{common.RuntimeKeyPushChallengeID: pushTestChallengeID}))
+
+	suite.Error(err)
+}
+
+func (suite *PushAuthExecutorTestSuite) TestAwaitStep() {
+	testCases := []struct {
+		name      string
+		challenge *push.Challenge
+		svcErr    *tidcommon.ServiceError
+		expected  bool
+	}{
+		{"Pending", buildPushChallenge(push.ChallengeStatusPending), nil, false},
+		{"Approved", buildPushChallenge(push.ChallengeStatusApproved), nil, true},
+		{"Denied", buildPushChallenge(push.ChallengeStatusDenied), nil, true},
+		{"NotFound", nil, &push.ErrorChallengeNotFound, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockPushService.On("WaitForDecision", mock.Anything, pushTestChallengeID).
+				Return(tc.challenge, tc.svcErr)
+
+			resolved, err := suite.executor.AwaitStep(context.Background(),
+				map[string]string{common.RuntimeKeyPushChallengeID: pushTestChallengeID})
+
+			suite.NoError(err)
+			suite.Equal(tc.expected, resolved)
+		})
+	}
+}
+
+func (suite *PushAuthExecutorTestSuite) TestAwaitStep_NotStarted() {
+	resolved, err := suite.executor.AwaitStep(context.Background(), map[string]string{})
+
+	suite.NoError(err)
+	suite.False(resolved)
+}
+
+func (suite *PushAuthExecutorTestSuite) TestAwaitStep_ServerError() {
+	suite.mockPushService.On("WaitForDecision", mock.Anything, pushTestChallengeID).
+		Return(nil, &tidcommon.InternalServerError)
+
+	_, err := suite.executor.AwaitStep(context.Background(),
+		map[string]string{common.RuntimeKeyPushChallengeID: pushTestChallengeID})
+
+	suite.Error(err)
+}
@@ -26,15 +26,20 @@ import (
 	"sync"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/authn/anomaly"
 	"github.com/thunder-id/thunderid/internal/authn/assert"
+	"github.com/thunder-id/thunderid/internal/authn/crossdevice"
 	"github.com/thunder-id/thunderid/internal/authn/github"
 	"github.com/thunder-id/thunderid/internal/authn/google"
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
 	"github.com/thunder-id/thunderid/internal/authn/magiclink"
 	"github.com/thunder-id/thunderid/internal/authn/oauth"
 	"github.com/thunder-id/thunderid/internal/authn/oidc"
 	"github.com/thunder-id/thunderid/internal/authn/openid4vp"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/push"
+	"github.com/thunder-id/thunderid/internal/device"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/flow/core"
@@ -42,7 +47,9 @@ import (
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/policy"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -57,6 +64,16 @@ type ExecutorRegistryInterface interface {
 	IsRegistered(name string) bool
 }
 
+// AsyncStepExecutor is implemented by executors whose step is completed outside of the flow, such as an
+// approval on another device. It lets the flow execution stream tell the client when to resume the flow
+// instead of the client polling the flow execute endpoint.
+type AsyncStepExecutor interface {
+	// AwaitStep waits until the pending step recorded in runtimeData is decided, expired or gone, or
+	// until the executor stops waiting, and reports whether resuming the flow would make progress.
+	// It reports false when the step has not been started.
+	AwaitStep(ctx context.Context, runtimeData map[string]string) (bool, error)
+}
+
 // executorRegistry is the default implementation of ExecutorRegistryInterface.
 type executorRegistry struct {
 	mu        sync.RWMutex
@@ -118,31 +135,38 @@ func (r *executorRegistry) IsRegistered(name string) bool {
 
 // ExecutorDependencies holds service dependencies required to construct built-in executors.
 type ExecutorDependencies struct {
-	FlowFactory           core.FlowFactoryInterface
-	OUService             ou.OrganizationUnitServiceInterface
-	IDPService            idp.IDPServiceInterface
-	NotifSenderSvc        notification.NotificationSenderServiceInterface
-	JWTService            jwt.JWTServiceInterface
-	AuthAssertGen         assert.AuthAssertGeneratorInterface
-	ConsentEnforcer       providers.ConsentProvider
-	AuthnProvider         providers.AuthnProviderManager
-	OTPService            otp.OTPAuthnServiceInterface
-	PasskeyService        passkey.PasskeyServiceInterface
-	MagicLinkService      magiclink.MagicLinkAuthnServiceInterface
-	AuthZService          providers.AuthorizationProvider
-	EntityTypeService     entitytype.EntityTypeServiceInterface
-	GroupService          group.GroupServiceInterface
-	RoleService           role.RoleServiceInterface
-	RoleAssignmentService role.RoleAssignmentServiceInterface
-	EntityProvider        entityprovider.EntityProviderInterface
-	AttributeCacheSvc     attributecache.AttributeCacheServiceInterface
-	EmailClient           email.EmailClientInterface
-	TemplateService       template.TemplateServiceInterface
-	OAuthSvc              oauth.OAuthAuthnServiceInterface
-	OIDCSvc               oidc.OIDCAuthnServiceInterface
-	GithubSvc             github.GithubOAuthAuthnServiceInterface
-	GoogleSvc             google.GoogleOIDCAuthnServiceInterface
-	OpenID4VPVerifierSvc  openid4vp.OpenID4VPServiceInterface
+	FlowFactory            core.FlowFactoryInterface
+	OUService              ou.OrganizationUnitServiceInterface
+	IDPService             idp.IDPServiceInterface
+	NotifSenderSvc         notification.NotificationSenderServiceInterface
+	JWTService             jwt.JWTServiceInterface
+	AuthAssertGen          assert.AuthAssertGeneratorInterface
+	ConsentEnforcer        providers.ConsentProvider
+	AuthnProvider          providers.AuthnProviderManager
+	OTPService             otp.OTPAuthnServiceInterface
+	PasskeyService         passkey.PasskeyServiceInterface
+	MagicLinkService       magiclink.MagicLinkAuthnServiceInterface
+	PushAuthService        push.PushAuthServiceInterface
+	CrossDeviceAuthService crossdevice.CrossDeviceAuthServiceInterface
+	AuthZService           providers.AuthorizationProvider
+	EntityTypeService      entitytype.EntityTypeServiceInterface
+	GroupService           group.GroupServiceInterface
+	RoleService            role.RoleServiceInterface
+	RoleAssignmentService  role.RoleAssignmentServiceInterface
+	EntityProvider         entityprovider.EntityProviderInterface
+	AttributeCacheSvc      attributecache.AttributeCacheServiceInterface
+	EmailClient            email.EmailClientInterface
+	TemplateService        template.TemplateServiceInterface
+	OAuthSvc               oauth.OAuthAuthnServiceInterface
+	OIDCSvc                oidc.OIDCAuthnServiceInterface
+	GithubSvc              github.GithubOAuthAuthnServiceInterface
+	GoogleSvc              google.GoogleOIDCAuthnServiceInterface
+	OpenID4VPVerifierSvc   openid4vp.OpenID4VPServiceInterface
+	PolicyService          policy.PolicyServiceInterface
+	ScopeService           scope.ScopeServiceInterface
+	AnomalyService         anomaly.AnomalyServiceInterface
+	DeviceService          device.DeviceServiceInterface
+	LoginHistoryService    loginhistory.LoginHistoryServiceInterface
 }
 
 type builtInExecutorRegistrar func(ExecutorRegistryInterface, ExecutorDependencies)
@@ -152,7 +176,7 @@ func newBuiltInExecutorRegistrars() map[string]builtInExecutorRegistrar {
 	return map[string]builtInExecutorRegistrar{
 		ExecutorNameCredentialsAuth: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameCredentialsAuth, newCredentialsAuthExecutor(
-				deps.FlowFactory, deps.EntityProvider, deps.AuthnProvider))
+				deps.FlowFactory, deps.EntityProvider, deps.AuthnProvider, deps.LoginHistoryService))
 		},
 		ExecutorNamePasskeyAuth: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNamePasskeyAuth, newPasskeyAuthExecutor(
@@ -196,7 +220,7 @@ func newBuiltInExecutorRegistrars() map[string]builtInExecutorRegistrar {
 		ExecutorNameAuthAssert: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameAuthAssert, newAuthAssertExecutor(deps.FlowFactory, deps.JWTService,
 				deps.OUService, deps.AuthAssertGen, deps.AuthnProvider, deps.EntityProvider,
-				deps.AttributeCacheSvc, deps.RoleService))
+				deps.AttributeCacheSvc, deps.RoleService, deps.DeviceService, deps.LoginHistoryService))
 		},
 		ExecutorNameAuthorization: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameAuthorization, newAuthorizationExecutor(
@@ -206,6 +230,9 @@ func newBuiltInExecutorRegistrars() map[string]builtInExecutorRegistrar {
 			reg.RegisterExecutor(ExecutorNameHTTPRequest, newHTTPRequestExecutor(deps.FlowFactory, deps.OUService,
 				deps.AuthnProvider))
 		},
+		ExecutorNameExternalTask: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNameExternalTask, newExternalTaskExecutor(deps.FlowFactory))
+		},
 		ExecutorNameUserTypeResolver: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameUserTypeResolver, newUserTypeResolver(
 				deps.FlowFactory, deps.EntityTypeService, deps.OUService))
@@ -233,7 +260,7 @@ func newBuiltInExecutorRegistrars() map[string]builtInExecutorRegistrar {
 		},
 		ExecutorNameConsent: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameConsent, newConsentExecutor(
-				deps.FlowFactory, deps.ConsentEnforcer, deps.AuthnProvider))
+				deps.FlowFactory, deps.ConsentEnforcer, deps.AuthnProvider, deps.ScopeService))
 		},
 		ExecutorNameOUResolver: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
 			reg.RegisterExecutor(ExecutorNameOUResolver, newOUResolverExecutor(deps.FlowFactory, deps.OUService))
@@ -258,6 +285,29 @@ func newBuiltInExecutorRegistrars() map[string]builtInExecutorRegistrar {
 			reg.RegisterExecutor(ExecutorNameOTPExecutor, newOTPExecutor(
 				deps.FlowFactory, deps.OTPService, deps.AuthnProvider, deps.EntityProvider))
 		},
+		ExecutorNamePolicyAcceptance: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNamePolicyAcceptance, newPolicyAcceptanceExecutor(
+				deps.FlowFactory, deps.PolicyService, deps.AuthnProvider))
+		},
+		ExecutorNameHRD: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNameHRD, newHRDExecutor(deps.FlowFactory, deps.OUService))
+		},
+		ExecutorNameAnomalyDetection: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNameAnomalyDetection, newAnomalyDetectionExecutor(
+				deps.FlowFactory, deps.AnomalyService, deps.AuthnProvider))
+		},
+		ExecutorNamePushAuth: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNamePushAuth, newPushAuthExecutor(
+				deps.FlowFactory, deps.PushAuthService, deps.AuthnProvider))
+		},
+		ExecutorNameCrossDeviceAuth: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNameCrossDeviceAuth, newCrossDeviceAuthExecutor(
+				deps.FlowFactory, deps.CrossDeviceAuthService, deps.AuthnProvider))
+		},
+		ExecutorNameCertificateAuth: func(reg ExecutorRegistryInterface, deps ExecutorDependencies) {
+			reg.RegisterExecutor(ExecutorNameCertificateAuth, newCertificateAuthExecutor(
+				deps.FlowFactory, deps.AuthnProvider))
+		},
 	}
 }
 
@@ -144,7 +144,8 @@ func (e *smsExecutor) Execute(ctx *providers.NodeContext) (*providers.ExecutorRe
 		}
 	}
 
-	rendered, svcErr := e.templateService.Render(ctx.Context, scenario, template.TemplateTypeSMS, templateData)
+	rendered, svcErr := e.templateService.RenderFor(ctx.Context, scenario, template.TemplateTypeSMS,
+		buildTemplateSelector(ctx), templateData)
 	if svcErr != nil {
 		return nil, fmt.Errorf("failed to render SMS template: %s", svcErr.Code)
 	}
@@ -81,8 +81,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_Success() {
 	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
 		{Identifier: common.AttributeMobileNumber, Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
@@ -113,8 +113,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_RecipientFromRuntimeData
 	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
 		{Identifier: common.AttributeMobileNumber, Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
@@ -147,8 +147,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_UserInputOverridesRuntim
 	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
 		{Identifier: common.AttributeMobileNumber, Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
@@ -182,8 +182,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_CustomPhoneAttribute() {
 	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
 		{Identifier: "phoneNumber", Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
@@ -367,8 +367,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_UserOnboarding_ClientErr
 	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
 		{Identifier: common.AttributeMobileNumber, Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	clientErr := &tidcommon.ServiceError{
 		Type:  tidcommon.ClientErrorType,
@@ -407,8 +407,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_UserOnboarding_ServerErr
 	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
 		{Identifier: common.AttributeMobileNumber, Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	serverErr := &tidcommon.ServiceError{
 		Type: tidcommon.ServerErrorType,
@@ -445,8 +445,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_OtherFlow_NotificationEr
 	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
 		{Identifier: common.AttributeMobileNumber, Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	clientErr := &tidcommon.ServiceError{
 		Type:  tidcommon.ClientErrorType,
@@ -489,7 +489,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_NoSMSTemplateProperty_ReturnsFlow
 	suite.NoError(err)
 	suite.Equal(providers.ExecFailure, resp.Status)
 	suite.Equal(ErrSMSTemplateMissing.Error.DefaultValue, resp.Error.Error.DefaultValue)
-	suite.mockTemplateService.AssertNotCalled(suite.T(), "Render", mock.Anything, mock.Anything, mock.Anything)
+	suite.mockTemplateService.AssertNotCalled(suite.T(), "RenderFor",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	suite.mockSMSSenderSvc.AssertNotCalled(suite.T(), "Send",
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
@@ -517,7 +518,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_EmptySMSTemplateProperty_ReturnsF
 	suite.NoError(err)
 	suite.Equal(providers.ExecFailure, resp.Status)
 	suite.Equal(ErrSMSTemplateMissing.Error.DefaultValue, resp.Error.Error.DefaultValue)
-	suite.mockTemplateService.AssertNotCalled(suite.T(), "Render", mock.Anything, mock.Anything, mock.Anything)
+	suite.mockTemplateService.AssertNotCalled(suite.T(), "RenderFor",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	suite.mockSMSSenderSvc.AssertNotCalled(suite.T(), "Send",
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
@@ -539,8 +541,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SMSTemplatePropertySet_UsesCustom
 	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]providers.Input{
 		{Identifier: common.AttributeMobileNumber, Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
@@ -551,8 +553,9 @@ func (suite *SMSExecutorTestSuite) TestExecute_SMSTemplatePropertySet_UsesCustom
 
 	suite.NoError(err)
 	suite.Equal(providers.ExecComplete, resp.Status)
-	suite.mockTemplateService.AssertCalled(suite.T(), "Render",
-		mock.Anything, template.ScenarioSelfRegistration, template.TemplateTypeSMS, mock.Anything)
+	suite.mockTemplateService.AssertCalled(suite.T(), "RenderFor",
+		mock.Anything, template.ScenarioSelfRegistration, template.TemplateTypeSMS,
+		mock.Anything, mock.Anything)
 }
 
 func (suite *SMSExecutorTestSuite) TestExecute_SendMode_TemplateRenderFailure_ReturnsError() {
@@ -573,8 +576,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_TemplateRenderFailure_Re
 		{Identifier: common.AttributeMobileNumber, Type: providers.InputTypePhone, Required: true},
 	}).Maybe()
 	renderErr := &tidcommon.ServiceError{Code: "TPL-5000"}
-	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
-		template.TemplateTypeSMS, mock.Anything).
+	suite.mockTemplateService.On("RenderFor", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything, mock.Anything).
 		Return(nil, renderErr)
 
 	resp, err := suite.executor.Execute(ctx)
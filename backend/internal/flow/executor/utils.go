@@ -22,10 +22,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/template"
 	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -41,6 +47,9 @@ func getAuthnServiceName(executorName string) string {
 		ExecutorNameGitHubAuth:      authncm.AuthenticatorGithub,
 		ExecutorNameGoogleAuth:      authncm.AuthenticatorGoogle,
 		ExecutorNameMagicLink:       authncm.AuthenticatorMagicLink,
+		ExecutorNamePushAuth:        authncm.AuthenticatorPush,
+		ExecutorNameCrossDeviceAuth: authncm.AuthenticatorCrossDevice,
+		ExecutorNameCertificateAuth: authncm.AuthenticatorCertificate,
 	}
 	return executorToAuthnServiceMap[executorName]
 }
@@ -235,3 +244,53 @@ func buildGetAttributesMetadata(ctx *providers.NodeContext) *providers.GetAttrib
 
 	return metadata
 }
+
+// recordLoginAttempt records a sign-in attempt of the user in the login history, adding the application
+// and client IP of the flow. Nothing is recorded when login history is not tracked, and a failure to
+// record the attempt does not fail the sign-in.
+func recordLoginAttempt(ctx *providers.NodeContext, loginHistory loginhistory.LoginHistoryServiceInterface,
+	userID string, attempt loginhistory.LoginAttempt, logger *log.Logger) {
+	if loginHistory == nil || userID == "" {
+		return
+	}
+
+	attempt.ApplicationID = ctx.EntityID
+	if clientIP := sysContext.GetClientIP(ctx.Context); clientIP.IsValid() {
+		attempt.IPAddress = clientIP.String()
+	}
+	if err := loginHistory.RecordAttempt(ctx.Context, userID, attempt); err != nil {
+		logger.Warn(ctx.Context, "Failed to record the sign-in attempt", log.Error(err))
+	}
+}
+
+// getCompletedAuthExecutors returns the names of the authentication executors completed in the flow,
+// in the order they were executed.
+func getCompletedAuthExecutors(history map[string]*providers.NodeExecutionRecord) []string {
+	records := make([]*providers.NodeExecutionRecord, 0, len(history))
+	for _, record := range history {
+		if record.ExecutorType == providers.ExecutorTypeAuthentication &&
+			record.Status == providers.FlowStatusComplete {
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Step < records[j].Step
+	})
+
+	executors := make([]string, 0, len(records))
+	for _, record := range records {
+		if !slices.Contains(executors, record.ExecutorName) {
+			executors = append(executors, record.ExecutorName)
+		}
+	}
+	return executors
+}
+
+// buildTemplateSelector selects the notification template of the flow application in the preferred
+// locales of the end user.
+func buildTemplateSelector(ctx *providers.NodeContext) template.TemplateSelector {
+	return template.TemplateSelector{
+		ApplicationID: ctx.Application.ID,
+		Locales:       strings.Fields(ctx.RuntimeData[common.RuntimeKeyUILocales]),
+	}
+}
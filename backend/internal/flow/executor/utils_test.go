@@ -52,6 +52,9 @@ func (s *UtilsTestSuite) TestGetAuthnServiceName() {
 		{"GitHub Auth executor", ExecutorNameGitHubAuth, authncm.AuthenticatorGithub},
 		{"Google Auth executor", ExecutorNameGoogleAuth, authncm.AuthenticatorGoogle},
 		{"MagicLink executor", ExecutorNameMagicLink, authncm.AuthenticatorMagicLink},
+		{"Push executor", ExecutorNamePushAuth, authncm.AuthenticatorPush},
+		{"Cross-device executor", ExecutorNameCrossDeviceAuth, authncm.AuthenticatorCrossDevice},
+		{"Certificate executor", ExecutorNameCertificateAuth, authncm.AuthenticatorCertificate},
 		{"Unknown executor returns empty string", "UnknownExecutor", ""},
 		{"Provisioning executor returns empty string", ExecutorNameProvisioning, ""},
 		{"AuthAssert executor returns empty string", ExecutorNameAuthAssert, ""},
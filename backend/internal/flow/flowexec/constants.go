@@ -18,13 +18,30 @@
 
 package flowexec
 
+import "time"
+
 const (
 	defaultAuthFlowExpiry           int64 = 1800  // 30 minutes in seconds
 	defaultRegistrationFlowExpiry   int64 = 3600  // 60 minutes in seconds
 	defaultUserOnboardingFlowExpiry int64 = 86400 // 24 hours in seconds
 	defaultRecoveryFlowExpiry       int64 = 1800  // 30 minutes in seconds
 
+	flowTraceExpiry     int64 = 3600 // 60 minutes in seconds
+	maxFlowTraceEntries       = 200
+	redactedTraceValue        = "[REDACTED]"
+
 	fieldFlowSecret = "flowSecret"
+
+	// executionWatchInterval is how often a watched flow execution is checked for updates.
+	executionWatchInterval = 2 * time.Second
+	// executionEventHeartbeatInterval is how often a comment is written to an idle flow execution event
+	// stream so that proxies keep the connection open.
+	executionEventHeartbeatInterval = 15 * time.Second
+	// executionEventWriteTimeout bounds each write to a flow execution event stream.
+	executionEventWriteTimeout = 10 * time.Second
+	// executionEventName is the server-sent event name of flow execution status events.
+	executionEventName     = "status"
+	contentTypeEventStream = "text/event-stream"
 )
 
 // flowInitiationMode classifies how an application is permitted to initiate a new authentication
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"sync"
 	"time"
 
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -241,9 +242,21 @@ func (fe *flowEngine) executeNodePackage(ctx *EngineContext,
 	// Publish node execution started event
 	publishNodeExecutionStartedEvent(ctx, currentNode, fe.observabilitySvc)
 
+	var sensitiveKeys map[string]struct{}
+	var beforeSnapshot NodeContextSnapshot
+	if ctx.Debug {
+		sensitiveKeys = getSensitiveInputKeys(currentNode)
+		beforeSnapshot = snapshotNodeContext(nodeCtx, sensitiveKeys)
+	}
+
 	nodeResp, nodeErr := currentNode.Execute(nodeCtx)
 	executionEndTime := time.Now().UnixMilli()
 
+	if ctx.Debug {
+		recordTraceEntry(ctx, currentNode, beforeSnapshot, snapshotNodeResponse(nodeCtx, nodeResp, sensitiveKeys),
+			nodeResp, nodeErr, executionStartTime, executionEndTime)
+	}
+
 	if consumed := nodeCtx.GetConsumedInputs(); len(consumed) > 0 {
 		ctx.consumedInputs = append(ctx.consumedInputs, consumed...)
 	}
@@ -817,6 +830,12 @@ func (fe *flowEngine) processNodeResponse(ctx *EngineContext, nodeResp *common.N
 			return nil, false, svcErr
 		}
 		return nextNode, true, nil
+	case common.NodeStatusParallel:
+		nextNode, svcErr := fe.handleParallelResponse(ctx, nodeResp, logger)
+		if svcErr != nil {
+			return nil, false, svcErr
+		}
+		return nextNode, true, nil
 	case common.NodeStatusFailure:
 		if ctx.frameDepth() > 0 {
 			return fe.handleCalleeFailure(ctx, nodeResp, flowStep, logger)
@@ -971,23 +990,34 @@ func (fe *flowEngine) handleCallResponse(ctx *EngineContext,
 		return nil, &ErrorMaxCallDepthExceeded
 	}
 
-	flow, svcErr := fe.flowProvider.GetFlow(ctx.Context, nodeResp.CallTargetFlowID)
+	flow, svcErr := fe.getCallTargetFlow(ctx, nodeResp)
 	if svcErr != nil {
 		logger.Error(ctx.Context, "Failed to get call target flow",
-			log.String("targetFlowID", nodeResp.CallTargetFlowID))
+			log.String("targetFlowID", nodeResp.CallTargetFlowID),
+			log.String("targetFlowHandle", nodeResp.CallTargetFlowHandle))
 		return nil, &tidcommon.InternalServerError
 	}
 
 	calleeGraph, svcErr := fe.graphBuilder.GetGraph(ctx.Context, flow)
 	if svcErr != nil {
 		logger.Error(ctx.Context, "Failed to build call target graph",
-			log.String("targetFlowID", nodeResp.CallTargetFlowID))
+			log.String("targetFlowID", flow.ID))
 		return nil, &tidcommon.InternalServerError
 	}
 
 	return fe.switchContextToCallee(ctx, nodeResp, calleeGraph, logger)
 }
 
+// getCallTargetFlow retrieves the flow referenced by a CALL node response. Flows referenced by handle
+// are resolved among flows of the caller's flow type.
+func (fe *flowEngine) getCallTargetFlow(ctx *EngineContext, nodeResp *common.NodeResponse) (
+	*providers.CompleteFlowDefinition, *tidcommon.ServiceError) {
+	if nodeResp.CallTargetFlowID == "" && nodeResp.CallTargetFlowHandle != "" {
+		return fe.flowProvider.GetFlowByHandle(ctx.Context, nodeResp.CallTargetFlowHandle, ctx.FlowType)
+	}
+	return fe.flowProvider.GetFlow(ctx.Context, nodeResp.CallTargetFlowID)
+}
+
 // switchContextToCallee switches the engine context to the callee flow's graph and
 // sets the current node to the start node.
 func (fe *flowEngine) switchContextToCallee(ctx *EngineContext,
@@ -1022,9 +1052,11 @@ func (fe *flowEngine) switchContextToCallee(ctx *EngineContext,
 }
 
 // handleCalleeReturn is called when the callee flow's END node completes while there is a
-// caller frame on the stack. It pops the frame and routes to the caller call node's onSuccess.
+// caller frame on the stack. It pops the frame, returns the callee's runtime data selected by the
+// caller call node's outputs and routes to the caller call node's onSuccess.
 func (fe *flowEngine) handleCalleeReturn(ctx *EngineContext, logger *log.Logger) (
 	core.NodeInterface, *tidcommon.ServiceError) {
+	calleeRuntimeData := ctx.RuntimeData
 	savedFrame := ctx.popFrame()
 	if savedFrame == nil {
 		logger.Error(ctx.Context, "Frame stack underflow on callee return")
@@ -1053,6 +1085,10 @@ func (fe *flowEngine) handleCalleeReturn(ctx *EngineContext, logger *log.Logger)
 		return nil, &tidcommon.InternalServerError
 	}
 
+	if len(calleeRuntimeData) > 0 {
+		ctx.mergeRuntimeData(selectCallOutputs(calleeRuntimeData, cn.GetOutputs()))
+	}
+
 	nextNode, ok := ctx.Graph.GetNode(onSuccessID)
 	if !ok {
 		logger.Error(ctx.Context, "call onSuccess node not found",
@@ -1064,6 +1100,21 @@ func (fe *flowEngine) handleCalleeReturn(ctx *EngineContext, logger *log.Logger)
 	return nextNode, nil
 }
 
+// selectCallOutputs returns the entries of the callee runtime data to return to the caller. All entries
+// are returned when no outputs are configured.
+func selectCallOutputs(calleeRuntimeData map[string]string, outputs []string) map[string]string {
+	if len(outputs) == 0 {
+		return calleeRuntimeData
+	}
+	selected := make(map[string]string, len(outputs))
+	for _, key := range outputs {
+		if value, ok := calleeRuntimeData[key]; ok {
+			selected[key] = value
+		}
+	}
+	return selected
+}
+
 // handleCalleeFailure is called when the callee flow ends with NodeStatusFailure while
 // there is a caller frame on the stack. It pops the frame and either routes to the caller
 // call node's onFailure (forwarding the error) or terminates the whole flow.
@@ -1116,6 +1167,189 @@ func (fe *flowEngine) handleCalleeFailure(ctx *EngineContext, nodeResp *common.N
 	return nextNode, true, nil
 }
 
+// parallelBranchExecution captures a single node execution within a parallel branch so that it can be
+// recorded against the engine context once all branches have finished.
+type parallelBranchExecution struct {
+	node      core.NodeInterface
+	nodeResp  *common.NodeResponse
+	nodeErr   *tidcommon.ServiceError
+	startTime int64
+	endTime   int64
+}
+
+// parallelBranchResult holds the outcome of a parallel branch and the data it produced.
+type parallelBranchResult struct {
+	status         common.NodeStatus
+	executions     []parallelBranchExecution
+	runtimeData    map[string]string
+	additionalData map[string]string
+	authUser       providers.AuthUser
+	consumedInputs []string
+}
+
+// handleParallelResponse handles a NodeStatusParallel response by running every branch concurrently
+// until it reaches the join node. Each branch works on its own copy of the flow context. Once all
+// branches have finished, the data produced by successful branches is merged into the engine context
+// in branch order, the outcome of each branch is recorded in the runtime data and execution continues
+// at the join node.
+func (fe *flowEngine) handleParallelResponse(ctx *EngineContext,
+	nodeResp *common.NodeResponse, logger *log.Logger) (
+	core.NodeInterface, *tidcommon.ServiceError) {
+	joinNodeID := nodeResp.NextNodeID
+	if len(nodeResp.ParallelBranches) == 0 || joinNodeID == "" {
+		logger.Error(ctx.Context, "Parallel response does not define branches or a join node")
+		return nil, &tidcommon.InternalServerError
+	}
+
+	results := make([]*parallelBranchResult, len(nodeResp.ParallelBranches))
+	var wg sync.WaitGroup
+	for i, branchNodeID := range nodeResp.ParallelBranches {
+		wg.Add(1)
+		go func(i int, branchNodeID string) {
+			defer wg.Done()
+			results[i] = fe.runParallelBranch(ctx, branchNodeID, joinNodeID, logger)
+		}(i, branchNodeID)
+	}
+	wg.Wait()
+
+	for i, result := range results {
+		branchNodeID := nodeResp.ParallelBranches[i]
+		for _, execution := range result.executions {
+			publishNodeExecutionStartedEvent(ctx, execution.node, fe.observabilitySvc)
+			recordNodeExecution(ctx, execution.node, execution.nodeResp, execution.nodeErr,
+				execution.startTime, execution.endTime)
+			publishNodeExecutionCompletedEvent(ctx, execution.node, execution.nodeResp, execution.nodeErr,
+				execution.startTime, execution.endTime, fe.observabilitySvc)
+			fe.clearSensitiveInputs(ctx, execution.node)
+		}
+		ctx.consumedInputs = append(ctx.consumedInputs, result.consumedInputs...)
+
+		if result.status == common.NodeStatusComplete {
+			ctx.mergeRuntimeData(result.runtimeData)
+			if len(result.additionalData) > 0 {
+				if ctx.AdditionalData == nil {
+					ctx.AdditionalData = make(map[string]string)
+				}
+				ctx.AdditionalData = sysutils.MergeStringMaps(ctx.AdditionalData, result.additionalData)
+			}
+			if result.authUser.IsAuthenticated() {
+				ctx.AuthUser = result.authUser
+			}
+		}
+		ctx.mergeRuntimeData(map[string]string{core.ParallelBranchStatusKey(branchNodeID): string(result.status)})
+
+		logger.Debug(ctx.Context, "Parallel branch finished", log.String("branchNodeID", branchNodeID),
+			log.String("status", string(result.status)))
+	}
+
+	nextNode, err := fe.resolveToNextNode(ctx, nodeResp)
+	if err != nil {
+		logger.Error(ctx.Context, "Error moving to the join node", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	ctx.CurrentNode = nextNode
+	return nextNode, nil
+}
+
+// runParallelBranch executes the TASK_EXECUTION nodes of a branch, following onSuccess, until the join
+// node is reached. The branch fails as soon as a node does not complete successfully. It must not
+// modify the engine context, since branches run concurrently.
+func (fe *flowEngine) runParallelBranch(ctx *EngineContext, branchNodeID, joinNodeID string,
+	logger *log.Logger) *parallelBranchResult {
+	logger = logger.With(log.String("branchNodeID", branchNodeID))
+	result := &parallelBranchResult{
+		status:         common.NodeStatusFailure,
+		runtimeData:    make(map[string]string),
+		additionalData: make(map[string]string),
+	}
+
+	userInputs := maps.Clone(ctx.UserInputs)
+	if userInputs == nil {
+		userInputs = make(map[string]string)
+	}
+	runtimeData := maps.Clone(ctx.RuntimeData)
+	if runtimeData == nil {
+		runtimeData = make(map[string]string)
+	}
+	authUser := ctx.AuthUser
+	visited := make(map[string]bool)
+
+	for nodeID := branchNodeID; nodeID != joinNodeID; {
+		if visited[nodeID] {
+			logger.Error(ctx.Context, "Parallel branch revisits a node", log.String("nodeID", nodeID))
+			return result
+		}
+		visited[nodeID] = true
+
+		node, ok := ctx.Graph.GetNode(nodeID)
+		if !ok || node.GetType() != common.NodeTypeTaskExecution {
+			logger.Error(ctx.Context, "Parallel branch node is not a task execution node",
+				log.String("nodeID", nodeID))
+			return result
+		}
+
+		nodeCtx := &providers.NodeContext{
+			Context:          ctx.Context,
+			ExecutionID:      ctx.ExecutionID,
+			FlowType:         ctx.FlowType,
+			EntityID:         ctx.AppID,
+			Verbose:          ctx.Verbose,
+			NodeInputs:       getNodeInputs(node),
+			UserInputs:       userInputs,
+			CurrentNodeID:    nodeID,
+			RuntimeData:      runtimeData,
+			ForwardedData:    make(map[string]interface{}),
+			Application:      ctx.Application,
+			AuthUser:         authUser,
+			ExecutionHistory: maps.Clone(ctx.ExecutionHistory),
+		}
+		if nodeCtx.NodeInputs == nil {
+			nodeCtx.NodeInputs = make([]providers.Input, 0)
+		}
+
+		if !node.ShouldExecute(nodeCtx) {
+			condition := node.GetCondition()
+			if condition == nil || condition.OnSkip == "" {
+				logger.Error(ctx.Context, "Node has condition but onSkip is not specified",
+					log.String("nodeID", nodeID))
+				return result
+			}
+			nodeID = condition.OnSkip
+			continue
+		}
+
+		if svcErr := fe.setNodeExecutor(ctx.Context, node, logger); svcErr != nil {
+			return result
+		}
+
+		startTime := time.Now().UnixMilli()
+		nodeResp, nodeErr := node.Execute(nodeCtx)
+		result.executions = append(result.executions, parallelBranchExecution{
+			node: node, nodeResp: nodeResp, nodeErr: nodeErr,
+			startTime: startTime, endTime: time.Now().UnixMilli(),
+		})
+		result.consumedInputs = append(result.consumedInputs, nodeCtx.GetConsumedInputs()...)
+
+		if nodeErr != nil || nodeResp == nil || nodeResp.Status != common.NodeStatusComplete ||
+			nodeResp.NextNodeID == "" {
+			logger.Debug(ctx.Context, "Parallel branch node did not complete", log.String("nodeID", nodeID))
+			return result
+		}
+
+		maps.Copy(runtimeData, nodeResp.RuntimeData)
+		maps.Copy(result.runtimeData, nodeResp.RuntimeData)
+		maps.Copy(result.additionalData, nodeResp.AdditionalData)
+		if nodeResp.AuthUser.IsAuthenticated() {
+			authUser = nodeResp.AuthUser
+			result.authUser = nodeResp.AuthUser
+		}
+		nodeID = nodeResp.NextNodeID
+	}
+
+	result.status = common.NodeStatusComplete
+	return result
+}
+
 // skipToNextNode skips the current node and moves to the next node. It updates the context with the
 // next node and returns it.
 func (fe *flowEngine) skipToNextNode(ctx *EngineContext, currentNode core.NodeInterface,
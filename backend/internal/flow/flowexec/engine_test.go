@@ -2641,6 +2641,103 @@ func (s *EngineTestSuite) TestExecuteNodePackage_IncompleteExitClearsNodeScopeBe
 	s.Empty(ctx.consumedInputs, "consumed list should be fully drained at this exit path")
 }
 
+func (s *EngineTestSuite) TestExecuteNodePackage_DebugRecordsRedactedTraceEntry() {
+	t := s.T()
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+	mockGraph.On("HasSegments").Return(false).Maybe()
+	mockGraph.On("GetInterceptors", mock.Anything).Return([]core.InterceptorUnitInterface{}).Maybe()
+
+	mockNode := coremock.NewNodeInterfaceMock(t)
+	mockNode.On("GetID").Return("n1").Maybe()
+	mockNode.On("GetType").Return(common.NodeTypeStart).Maybe()
+	mockNode.On("GetProperties").Return(map[string]interface{}(nil)).Maybe()
+	mockNode.On("GetExecutionPolicy").Return((*providers.ExecutionPolicy)(nil)).Maybe()
+	mockNode.On("ShouldExecute", mock.Anything).Return(true)
+	mockNode.On("Execute", mock.Anything).Return(&common.NodeResponse{
+		Status:      common.NodeStatusIncomplete,
+		Type:        common.NodeResponseTypeView,
+		Inputs:      []providers.Input{{Identifier: "email", Required: true}},
+		RuntimeData: map[string]string{"otpSessionToken": "session", "step": "2"},
+	}, nil)
+
+	mockRunner := NewInterceptorRunnerInterfaceMock(t)
+	mockRunner.On("runInterceptors", mock.Anything, mock.Anything).
+		Return(&common.InterceptorResponse{Status: common.InterceptorStatusComplete}, nil).Maybe()
+
+	fe := &flowEngine{
+		logger:            log.GetLogger(),
+		observabilitySvc:  setupNodePackageMockObs(t),
+		interceptorRunner: mockRunner,
+	}
+	ctx := &EngineContext{
+		Context:          context.Background(),
+		ExecutionID:      "exec-debug",
+		Debug:            true,
+		Graph:            mockGraph,
+		CurrentNode:      mockNode,
+		CurrentAction:    "submit",
+		UserInputs:       map[string]string{"username": "alice", "password": "secret"},
+		RuntimeData:      map[string]string{"step": "1"},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+	}
+
+	_, _, err := fe.executeNodePackage(ctx, mockNode, &FlowStep{}, 0)
+
+	s.Nil(err)
+	s.Require().Len(ctx.traceEntries, 1)
+	entry := ctx.traceEntries[0]
+	s.Equal("n1", entry.NodeID)
+	s.Equal(string(common.NodeStatusIncomplete), entry.Status)
+	s.Equal("submit", entry.Before.CurrentAction)
+	s.Equal("alice", entry.Before.UserInputs["username"])
+	s.Equal(redactedTraceValue, entry.Before.UserInputs["password"])
+	s.Equal("1", entry.Before.RuntimeData["step"])
+	s.Require().NotNil(entry.After)
+	s.Equal("2", entry.After.RuntimeData["step"])
+	s.Equal(redactedTraceValue, entry.After.RuntimeData["otpSessionToken"])
+}
+
+func (s *EngineTestSuite) TestExecuteNodePackage_WithoutDebugRecordsNoTrace() {
+	t := s.T()
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+	mockGraph.On("HasSegments").Return(false).Maybe()
+	mockGraph.On("GetInterceptors", mock.Anything).Return([]core.InterceptorUnitInterface{}).Maybe()
+
+	mockNode := coremock.NewNodeInterfaceMock(t)
+	mockNode.On("GetID").Return("n1").Maybe()
+	mockNode.On("GetType").Return(common.NodeTypeStart).Maybe()
+	mockNode.On("GetProperties").Return(map[string]interface{}(nil)).Maybe()
+	mockNode.On("GetExecutionPolicy").Return((*providers.ExecutionPolicy)(nil)).Maybe()
+	mockNode.On("ShouldExecute", mock.Anything).Return(true)
+	mockNode.On("Execute", mock.Anything).Return(&common.NodeResponse{
+		Status: common.NodeStatusIncomplete,
+		Type:   common.NodeResponseTypeView,
+		Inputs: []providers.Input{{Identifier: "email", Required: true}},
+	}, nil)
+
+	mockRunner := NewInterceptorRunnerInterfaceMock(t)
+	mockRunner.On("runInterceptors", mock.Anything, mock.Anything).
+		Return(&common.InterceptorResponse{Status: common.InterceptorStatusComplete}, nil).Maybe()
+
+	fe := &flowEngine{
+		logger:            log.GetLogger(),
+		observabilitySvc:  setupNodePackageMockObs(t),
+		interceptorRunner: mockRunner,
+	}
+	ctx := &EngineContext{
+		Context:          context.Background(),
+		ExecutionID:      "exec-no-debug",
+		Graph:            mockGraph,
+		CurrentNode:      mockNode,
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+	}
+
+	_, _, err := fe.executeNodePackage(ctx, mockNode, &FlowStep{}, 0)
+
+	s.Nil(err)
+	s.Empty(ctx.traceEntries)
+}
+
 func (s *EngineTestSuite) TestFlowEngineExecute_NilGraph() {
 	t := s.T()
 	mockObs := observabilitymock.NewObservabilityServiceInterfaceMock(t)
@@ -3945,8 +4042,124 @@ func (s *EngineTestSuite) TestHandleCallResponse_Success() {
 	s.Equal(mockStartNode, ctx.CurrentNode)
 }
 
+func (s *EngineTestSuite) TestHandleCallResponse_ByHandle() {
+	t := s.T()
+	mockCurrentNode := coremock.NewNodeInterfaceMock(t)
+	mockCurrentNode.On("GetID").Return("call-node-1")
+	mockFlowProvider := NewFlowProviderMock(t)
+	mockGraphBuilder := NewGraphBuilderInterfaceMock(t)
+	mockCalleeGraph := coremock.NewGraphInterfaceMock(t)
+	mockStartNode := coremock.NewNodeInterfaceMock(t)
+
+	flow := &providers.CompleteFlowDefinition{ID: "mfa-flow-id", FlowType: providers.FlowTypeAuthentication}
+	mockFlowProvider.On("GetFlowByHandle", mock.Anything, "mfa-subflow", providers.FlowTypeAuthentication).
+		Return(flow, nil)
+	mockGraphBuilder.On("GetGraph", mock.Anything, flow).Return(mockCalleeGraph, nil)
+	mockCalleeGraph.On("GetType").Return(providers.FlowTypeAuthentication)
+	mockCalleeGraph.On("GetStartNode").Return(mockStartNode, nil)
+
+	fe := &flowEngine{
+		logger:       log.GetLogger(),
+		flowProvider: mockFlowProvider,
+		graphBuilder: mockGraphBuilder,
+	}
+	ctx := &EngineContext{
+		Context:     context.Background(),
+		CurrentNode: mockCurrentNode,
+		FlowType:    providers.FlowTypeAuthentication,
+	}
+
+	nodeResp := &common.NodeResponse{
+		Status:               common.NodeStatusCall,
+		CallTargetFlowHandle: "mfa-subflow",
+	}
+	next, err := fe.handleCallResponse(ctx, nodeResp, log.GetLogger())
+	s.Nil(err)
+	s.Equal(mockStartNode, next)
+	s.Equal(1, ctx.frameDepth())
+	s.Equal(mockCalleeGraph, ctx.Graph)
+}
+
+func (s *EngineTestSuite) TestHandleCallResponse_HandleNotFound() {
+	t := s.T()
+	mockFlowProvider := NewFlowProviderMock(t)
+	mockFlowProvider.On("GetFlowByHandle", mock.Anything, "missing", providers.FlowTypeAuthentication).
+		Return(nil, &tidcommon.ServiceError{Code: "not-found"})
+
+	fe := &flowEngine{
+		logger:       log.GetLogger(),
+		flowProvider: mockFlowProvider,
+	}
+	ctx := &EngineContext{Context: context.Background(), FlowType: providers.FlowTypeAuthentication}
+
+	nodeResp := &common.NodeResponse{
+		Status:               common.NodeStatusCall,
+		CallTargetFlowHandle: "missing",
+	}
+	next, err := fe.handleCallResponse(ctx, nodeResp, log.GetLogger())
+	s.Nil(next)
+	s.NotNil(err)
+	s.Equal(tidcommon.InternalServerError.Code, err.Code)
+	s.Equal(0, ctx.frameDepth())
+}
+
 // --- handleCalleeReturn ---
 
+func (s *EngineTestSuite) TestHandleCalleeReturn_ReturnsAllRuntimeData() {
+	t := s.T()
+	mockCallerGraph := coremock.NewGraphInterfaceMock(t)
+	mockCallNode := coremock.NewCallNodeInterfaceMock(t)
+	mockNextNode := coremock.NewNodeInterfaceMock(t)
+
+	mockCallerGraph.On("GetNode", "call-node-1").Return(mockCallNode, true)
+	mockCallNode.On("GetOnSuccess").Return("next-node")
+	mockCallNode.On("GetOutputs").Return(nil)
+	mockCallerGraph.On("GetNode", "next-node").Return(mockNextNode, true)
+
+	fe := &flowEngine{logger: log.GetLogger()}
+	ctx := &EngineContext{
+		Context:     context.Background(),
+		Graph:       mockCallerGraph,
+		RuntimeData: map[string]string{"caller-key": "caller-val", "shared": "caller"},
+	}
+	ctx.pushFrame("call-node-1")
+
+	ctx.Graph = coremock.NewGraphInterfaceMock(t)
+	ctx.RuntimeData = map[string]string{"mfaMethod": "totp", "shared": "callee"}
+
+	_, svcErr := fe.handleCalleeReturn(ctx, log.GetLogger())
+	s.Nil(svcErr)
+	s.Equal(map[string]string{"caller-key": "caller-val", "shared": "callee", "mfaMethod": "totp"},
+		ctx.RuntimeData)
+}
+
+func (s *EngineTestSuite) TestHandleCalleeReturn_ReturnsSelectedOutputs() {
+	t := s.T()
+	mockCallerGraph := coremock.NewGraphInterfaceMock(t)
+	mockCallNode := coremock.NewCallNodeInterfaceMock(t)
+	mockNextNode := coremock.NewNodeInterfaceMock(t)
+
+	mockCallerGraph.On("GetNode", "call-node-1").Return(mockCallNode, true)
+	mockCallNode.On("GetOnSuccess").Return("next-node")
+	mockCallNode.On("GetOutputs").Return([]string{"mfaMethod", "notProduced"})
+	mockCallerGraph.On("GetNode", "next-node").Return(mockNextNode, true)
+
+	fe := &flowEngine{logger: log.GetLogger()}
+	ctx := &EngineContext{
+		Context:     context.Background(),
+		Graph:       mockCallerGraph,
+		RuntimeData: map[string]string{"caller-key": "caller-val"},
+	}
+	ctx.pushFrame("call-node-1")
+
+	ctx.Graph = coremock.NewGraphInterfaceMock(t)
+	ctx.RuntimeData = map[string]string{"mfaMethod": "totp", "otpAttempts": "2"}
+
+	_, svcErr := fe.handleCalleeReturn(ctx, log.GetLogger())
+	s.Nil(svcErr)
+	s.Equal(map[string]string{"caller-key": "caller-val", "mfaMethod": "totp"}, ctx.RuntimeData)
+}
+
 func (s *EngineTestSuite) TestHandleCalleeReturn_Success() {
 	t := s.T()
 	mockCallerGraph := coremock.NewGraphInterfaceMock(t)
@@ -4307,3 +4520,149 @@ func (s *EngineTestSuite) TestHandleCompletedResponse_EndNodeWithFrameStack() {
 	s.Equal(mockNextNode, next)
 	s.Equal(0, ctx.frameDepth())
 }
+
+// --- handleParallelResponse ---
+
+func (s *EngineTestSuite) newParallelBranchNode(
+	id string, nodeResp *common.NodeResponse) *coremock.ExecutorBackedNodeInterfaceMock {
+	t := s.T()
+	mockExecutor := coremock.NewExecutorInterfaceMock(t)
+	mockExecutor.On("GetName").Return(id + "-executor").Maybe()
+	mockExecutor.On("GetType").Return(providers.ExecutorTypeUtility).Maybe()
+
+	node := coremock.NewExecutorBackedNodeInterfaceMock(t)
+	node.On("GetID").Return(id).Maybe()
+	node.On("GetType").Return(common.NodeTypeTaskExecution).Maybe()
+	node.On("GetInputs").Return([]providers.Input{}).Maybe()
+	node.On("GetExecutor").Return(mockExecutor).Maybe()
+	node.On("GetMode").Return("").Maybe()
+	node.On("ShouldExecute", mock.Anything).Return(true).Maybe()
+	node.On("Execute", mock.Anything).Return(nodeResp, nil).Maybe()
+	return node
+}
+
+func (s *EngineTestSuite) TestHandleParallelResponse_RecordsBranchOutcomes() {
+	t := s.T()
+	emailNode := s.newParallelBranchNode("send-email", &common.NodeResponse{
+		Status:      common.NodeStatusComplete,
+		NextNodeID:  "join",
+		RuntimeData: map[string]string{"emailSent": "true"},
+	})
+	smsNode := s.newParallelBranchNode("send-sms", &common.NodeResponse{
+		Status:      common.NodeStatusFailure,
+		RuntimeData: map[string]string{"smsSent": "true"},
+	})
+	joinNode := coremock.NewJoinNodeInterfaceMock(t)
+	joinNode.On("GetID").Return("join").Maybe()
+
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+	mockGraph.On("GetNode", "send-email").Return(emailNode, true)
+	mockGraph.On("GetNode", "send-sms").Return(smsNode, true)
+	mockGraph.On("GetNode", "join").Return(joinNode, true)
+
+	fe := &flowEngine{logger: log.GetLogger()}
+	ctx := &EngineContext{
+		Context:          context.Background(),
+		FlowType:         providers.FlowTypeRegistration,
+		Graph:            mockGraph,
+		RuntimeData:      map[string]string{"existing": "value"},
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+	}
+	nodeResp := &common.NodeResponse{
+		Status:           common.NodeStatusParallel,
+		ParallelBranches: []string{"send-email", "send-sms"},
+		NextNodeID:       "join",
+	}
+
+	next, svcErr := fe.handleParallelResponse(ctx, nodeResp, log.GetLogger())
+
+	s.Nil(svcErr)
+	s.Equal(joinNode, next)
+	s.Equal(joinNode, ctx.CurrentNode)
+	s.Equal("value", ctx.RuntimeData["existing"])
+	s.Equal("true", ctx.RuntimeData["emailSent"])
+	s.NotContains(ctx.RuntimeData, "smsSent")
+	s.Equal(string(common.NodeStatusComplete), ctx.RuntimeData[core.ParallelBranchStatusKey("send-email")])
+	s.Equal(string(common.NodeStatusFailure), ctx.RuntimeData[core.ParallelBranchStatusKey("send-sms")])
+	s.Contains(ctx.ExecutionHistory, "send-email")
+	s.Contains(ctx.ExecutionHistory, "send-sms")
+}
+
+func (s *EngineTestSuite) TestHandleParallelResponse_FollowsBranchChain() {
+	t := s.T()
+	generateNode := s.newParallelBranchNode("generate-otp", &common.NodeResponse{
+		Status:      common.NodeStatusComplete,
+		NextNodeID:  "send-otp",
+		RuntimeData: map[string]string{"otp": "generated"},
+	})
+	sendNode := s.newParallelBranchNode("send-otp", &common.NodeResponse{
+		Status:     common.NodeStatusComplete,
+		NextNodeID: "join",
+	})
+	joinNode := coremock.NewJoinNodeInterfaceMock(t)
+	joinNode.On("GetID").Return("join").Maybe()
+
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+	mockGraph.On("GetNode", "generate-otp").Return(generateNode, true)
+	mockGraph.On("GetNode", "send-otp").Return(sendNode, true)
+	mockGraph.On("GetNode", "join").Return(joinNode, true)
+
+	fe := &flowEngine{logger: log.GetLogger()}
+	ctx := &EngineContext{
+		Context:          context.Background(),
+		FlowType:         providers.FlowTypeRegistration,
+		Graph:            mockGraph,
+		ExecutionHistory: map[string]*providers.NodeExecutionRecord{},
+	}
+	nodeResp := &common.NodeResponse{
+		Status:           common.NodeStatusParallel,
+		ParallelBranches: []string{"generate-otp"},
+		NextNodeID:       "join",
+	}
+
+	next, svcErr := fe.handleParallelResponse(ctx, nodeResp, log.GetLogger())
+
+	s.Nil(svcErr)
+	s.Equal(joinNode, next)
+	s.Equal("generated", ctx.RuntimeData["otp"])
+	s.Equal(string(common.NodeStatusComplete), ctx.RuntimeData[core.ParallelBranchStatusKey("generate-otp")])
+	s.Len(ctx.ExecutionHistory, 2)
+}
+
+func (s *EngineTestSuite) TestHandleParallelResponse_NonTaskNodeFailsBranch() {
+	t := s.T()
+	promptNode := coremock.NewPromptNodeInterfaceMock(t)
+	promptNode.On("GetType").Return(common.NodeTypePrompt)
+	joinNode := coremock.NewJoinNodeInterfaceMock(t)
+	joinNode.On("GetID").Return("join").Maybe()
+
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+	mockGraph.On("GetNode", "prompt").Return(promptNode, true)
+	mockGraph.On("GetNode", "join").Return(joinNode, true)
+
+	fe := &flowEngine{logger: log.GetLogger()}
+	ctx := &EngineContext{Context: context.Background(), Graph: mockGraph}
+	nodeResp := &common.NodeResponse{
+		Status:           common.NodeStatusParallel,
+		ParallelBranches: []string{"prompt"},
+		NextNodeID:       "join",
+	}
+
+	next, svcErr := fe.handleParallelResponse(ctx, nodeResp, log.GetLogger())
+
+	s.Nil(svcErr)
+	s.Equal(joinNode, next)
+	s.Equal(string(common.NodeStatusFailure), ctx.RuntimeData[core.ParallelBranchStatusKey("prompt")])
+}
+
+func (s *EngineTestSuite) TestHandleParallelResponse_MissingBranches() {
+	fe := &flowEngine{logger: log.GetLogger()}
+	ctx := &EngineContext{Context: context.Background()}
+
+	next, svcErr := fe.handleParallelResponse(ctx,
+		&common.NodeResponse{Status: common.NodeStatusParallel, NextNodeID: "join"}, log.GetLogger())
+
+	s.Nil(next)
+	s.NotNil(svcErr)
+	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
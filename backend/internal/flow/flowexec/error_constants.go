@@ -209,3 +209,47 @@ var ErrorMaxCallDepthExceeded = tidcommon.ServiceError{
 		DefaultValue: "The maximum allowed call depth has been exceeded during flow execution",
 	},
 }
+
+// ErrorAccessPolicyDenied defines the error when the access policy of the application blocks the
+// client IP address or its country from initiating a flow.
+var ErrorAccessPolicyDenied = tidcommon.ServiceError{
+	Code: "FES-1014",
+	Type: tidcommon.ClientErrorType,
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.access_policy_denied",
+		DefaultValue: "Access denied",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.access_policy_denied_description",
+		DefaultValue: "The application does not allow access from the client network or location",
+	},
+}
+
+// ErrorExecutionTraceNotFound defines the error when no debug trace is recorded for the given
+// flow execution.
+var ErrorExecutionTraceNotFound = tidcommon.ServiceError{
+	Code: "FES-1015",
+	Type: tidcommon.ClientErrorType,
+	Error: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.execution_trace_not_found",
+		DefaultValue: "Execution trace not found",
+	},
+	ErrorDescription: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.execution_trace_not_found_description",
+		DefaultValue: "No debug trace is recorded for the given flow execution",
+	},
+}
+
+// APIErrorDebugTraceNotPermitted defines the error response when a caller without administrative
+// permissions requests or reads the debug trace of a flow execution.
+var APIErrorDebugTraceNotPermitted = apierror.ErrorResponse{
+	Code: "FES-1016",
+	Message: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.debug_trace_not_permitted",
+		DefaultValue: "Debug trace not permitted",
+	},
+	Description: tidcommon.I18nMessage{
+		Key:          "error.flowexecservice.debug_trace_not_permitted_description",
+		DefaultValue: "Debug tracing of a flow execution requires administrative permissions",
+	},
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package flowexec
+
+import (
+	"context"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// ExecutionStatus is the status of a flow execution reported on the flow execution event stream.
+type ExecutionStatus string
+
+const (
+	// ExecutionStatusPending means the flow execution is waiting on its current step.
+	ExecutionStatusPending ExecutionStatus = "PENDING"
+	// ExecutionStatusReady means the current step can make progress, and the client should resume the
+	// flow by calling the flow execute endpoint.
+	ExecutionStatusReady ExecutionStatus = "READY"
+	// ExecutionStatusEnded means the flow execution has completed or expired.
+	ExecutionStatusEnded ExecutionStatus = "ENDED"
+)
+
+// ExecutionEvent is an event on the flow execution event stream.
+type ExecutionEvent struct {
+	ExecutionID string          `json:"executionId"`
+	Status      ExecutionStatus `json:"status"`
+}
+
+// WatchExecution streams the status of a flow execution. The first event reports the execution as
+// pending, and the stream is closed after a READY or ENDED event, when watching fails, or when ctx is
+// done.
+func (s *flowExecService) WatchExecution(ctx context.Context, executionID string) (
+	<-chan ExecutionEvent, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecService"))
+
+	if executionID == "" {
+		return nil, &ErrorInvalidExecutionID
+	}
+
+	// The stored context is kept as a baseline to detect updates made to the flow while it is watched.
+	stored, err := s.flowStore.GetFlowContext(ctx, executionID)
+	if err != nil {
+		logger.Error(ctx, "Error retrieving flow context from store",
+			log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if stored == nil {
+		return nil, &ErrorInvalidExecutionID
+	}
+
+	engineCtx, svcErr := s.loadContextFromStore(ctx, executionID, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	events := make(chan ExecutionEvent, 1)
+	events <- ExecutionEvent{ExecutionID: executionID, Status: ExecutionStatusPending}
+	go s.watchExecution(ctx, executionID, stored.Context, s.getAsyncStepExecutor(engineCtx),
+		engineCtx.RuntimeData, events, logger)
+
+	return events, nil
+}
+
+// watchExecution waits until the flow execution can make progress or ends, and sends the resulting
+// event. The pending step of the current node is awaited through its executor when the executor
+// supports it, and the stored context is checked for updates and removal after each wait.
+func (s *flowExecService) watchExecution(ctx context.Context, executionID, baseline string,
+	asyncExec executor.AsyncStepExecutor, runtimeData map[string]string, events chan<- ExecutionEvent,
+	logger *log.Logger) {
+	defer close(events)
+
+	for {
+		if asyncExec != nil {
+			resolved, err := asyncExec.AwaitStep(ctx, runtimeData)
+			if err != nil {
+				logger.Error(ctx, "Failed to await the pending step of the flow execution",
+					log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+				return
+			}
+			if resolved {
+				sendExecutionEvent(ctx, events, ExecutionEvent{ExecutionID: executionID,
+					Status: ExecutionStatusReady})
+				return
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		stored, err := s.flowStore.GetFlowContext(ctx, executionID)
+		if err != nil {
+			logger.Error(ctx, "Error retrieving flow context from store",
+				log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+			return
+		}
+		switch {
+		case stored == nil:
+			sendExecutionEvent(ctx, events, ExecutionEvent{ExecutionID: executionID, Status: ExecutionStatusEnded})
+			return
+		case stored.Context != baseline:
+			sendExecutionEvent(ctx, events, ExecutionEvent{ExecutionID: executionID, Status: ExecutionStatusReady})
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(executionWatchInterval):
+		}
+	}
+}
+
+// getAsyncStepExecutor returns the executor of the current node when the node is a task execution node
+// whose executor completes its step asynchronously.
+func (s *flowExecService) getAsyncStepExecutor(engineCtx *EngineContext) executor.AsyncStepExecutor {
+	if engineCtx.CurrentNode == nil || engineCtx.CurrentNode.GetType() != common.NodeTypeTaskExecution {
+		return nil
+	}
+	node, ok := engineCtx.CurrentNode.(core.ExecutorBackedNodeInterface)
+	if !ok || node.GetExecutorName() == "" {
+		return nil
+	}
+	exec, err := s.executorRegistry.GetExecutor(node.GetExecutorName())
+	if err != nil {
+		return nil
+	}
+	asyncExec, _ := exec.(executor.AsyncStepExecutor)
+	return asyncExec
+}
+
+// sendExecutionEvent sends the event unless ctx is done first.
+func sendExecutionEvent(ctx context.Context, events chan<- ExecutionEvent, evt ExecutionEvent) {
+	select {
+	case events <- evt:
+	case <-ctx.Done():
+	}
+}
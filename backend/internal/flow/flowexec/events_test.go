@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package flowexec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/executormock"
+)
+
+// asyncStepExecutorStub is an executor whose step is completed asynchronously.
+type asyncStepExecutorStub struct {
+	providers.Executor
+	resolved bool
+	err      error
+}
+
+func (e *asyncStepExecutorStub) AwaitStep(_ context.Context, _ map[string]string) (bool, error) {
+	return e.resolved, e.err
+}
+
+type ExecutionEventsTestSuite struct {
+	suite.Suite
+	mockStore *flowStoreInterfaceMock
+	service   *flowExecService
+}
+
+func TestExecutionEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(ExecutionEventsTestSuite))
+}
+
+func (s *ExecutionEventsTestSuite) SetupTest() {
+	s.mockStore = newFlowStoreInterfaceMock(s.T())
+	s.service = &flowExecService{flowStore: s.mockStore, cfg: testFlowExecCfg}
+}
+
+// collectEvents runs the watch loop and returns the events it sent.
+func (s *ExecutionEventsTestSuite) collectEvents(asyncExec *asyncStepExecutorStub) []ExecutionEvent {
+	events := make(chan ExecutionEvent, 1)
+	var exec executor.AsyncStepExecutor
+	if asyncExec != nil {
+		exec = asyncExec
+	}
+	go s.service.watchExecution(context.Background(), "exec-1", "baseline", exec, map[string]string{},
+		events, log.GetLogger())
+
+	var got []ExecutionEvent
+	for evt := range events {
+		got = append(got, evt)
+	}
+	return got
+}
+
+func (s *ExecutionEventsTestSuite) TestWatchExecution_InvalidExecutionID() {
+	s.mockStore.EXPECT().GetFlowContext(mock.Anything, "missing").Return(nil, nil)
+
+	events, svcErr := s.service.WatchExecution(context.Background(), "")
+	s.Nil(events)
+	s.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+
+	events, svcErr = s.service.WatchExecution(context.Background(), "missing")
+	s.Nil(events)
+	s.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+}
+
+func (s *ExecutionEventsTestSuite) TestWatchExecution_StoreError() {
+	s.mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").Return(nil, errors.New("store down"))
+
+	events, svcErr := s.service.WatchExecution(context.Background(), "exec-1")
+
+	s.Nil(events)
+	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (s *ExecutionEventsTestSuite) TestWatchExecution_StepResolved() {
+	got := s.collectEvents(&asyncStepExecutorStub{resolved: true})
+
+	s.Equal([]ExecutionEvent{{ExecutionID: "exec-1", Status: ExecutionStatusReady}}, got)
+}
+
+func (s *ExecutionEventsTestSuite) TestWatchExecution_StepError() {
+	got := s.collectEvents(&asyncStepExecutorStub{err: errors.New("push store down")})
+
+	s.Empty(got)
+}
+
+func (s *ExecutionEventsTestSuite) TestWatchExecution_ContextRemoved() {
+	s.mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").Return(nil, nil)
+
+	got := s.collectEvents(&asyncStepExecutorStub{})
+
+	s.Equal([]ExecutionEvent{{ExecutionID: "exec-1", Status: ExecutionStatusEnded}}, got)
+}
+
+func (s *ExecutionEventsTestSuite) TestWatchExecution_ContextUpdated() {
+	s.mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(&FlowContextDB{ExecutionID: "exec-1", Context: "updated"}, nil)
+
+	got := s.collectEvents(nil)
+
+	s.Equal([]ExecutionEvent{{ExecutionID: "exec-1", Status: ExecutionStatusReady}}, got)
+}
+
+func (s *ExecutionEventsTestSuite) TestWatchExecution_CancelledWhilePending() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Run(func(_ context.Context, _ string) { cancel() }).
+		Return(&FlowContextDB{ExecutionID: "exec-1", Context: "baseline"}, nil).Once()
+	events := make(chan ExecutionEvent, 1)
+	done := make(chan struct{})
+	go func() {
+		s.service.watchExecution(ctx, "exec-1", "baseline", nil, nil, events, log.GetLogger())
+		close(done)
+	}()
+
+	<-done
+
+	_, open := <-events
+	s.False(open)
+}
+
+func (s *ExecutionEventsTestSuite) TestGetAsyncStepExecutor() {
+	asyncExec := &asyncStepExecutorStub{}
+	mockRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
+	mockRegistry.EXPECT().GetExecutor("PushAuthExecutor").Return(asyncExec, nil)
+	mockRegistry.EXPECT().GetExecutor("BasicAuthExecutor").Return(coremock.NewExecutorInterfaceMock(s.T()), nil)
+	mockRegistry.EXPECT().GetExecutor("Unknown").Return(nil, errors.New("not found"))
+	s.service.executorRegistry = mockRegistry
+
+	newNode := func(executorName string) *coremock.ExecutorBackedNodeInterfaceMock {
+		node := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+		node.EXPECT().GetType().Return(common.NodeTypeTaskExecution)
+		node.EXPECT().GetExecutorName().Return(executorName)
+		return node
+	}
+
+	s.Same(asyncExec, s.service.getAsyncStepExecutor(&EngineContext{CurrentNode: newNode("PushAuthExecutor")}))
+	s.Nil(s.service.getAsyncStepExecutor(&EngineContext{CurrentNode: newNode("BasicAuthExecutor")}))
+	s.Nil(s.service.getAsyncStepExecutor(&EngineContext{CurrentNode: newNode("Unknown")}))
+	s.Nil(s.service.getAsyncStepExecutor(&EngineContext{}))
+}
@@ -20,24 +20,34 @@ package flowexec
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 // FlowExecutionHandler handles flow execution requests.
 type flowExecutionHandler struct {
 	flowExecService FlowExecServiceInterface
+	localizer       *stepLocalizer
 }
 
-func newFlowExecutionHandler(flowExecService FlowExecServiceInterface) *flowExecutionHandler {
+// newFlowExecutionHandler creates a new flow execution handler. Flow step responses are localized on
+// the server only when a localizer is given.
+func newFlowExecutionHandler(flowExecService FlowExecServiceInterface,
+	localizer *stepLocalizer) *flowExecutionHandler {
 	return &flowExecutionHandler{
 		flowExecService: flowExecService,
+		localizer:       localizer,
 	}
 }
 
@@ -61,8 +71,22 @@ func (h *flowExecutionHandler) HandleFlowExecutionRequest(w http.ResponseWriter,
 	challengeToken := sysutils.SanitizeString(flowR.ChallengeToken)
 	flowSecret := sysutils.SanitizeString(r.Header.Get(serverconst.FlowSecretHeaderName))
 
+	// The User-Agent lets executors recognise the device the flow runs on.
+	execCtx := sysContext.WithUserAgent(r.Context(), r.UserAgent())
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		// The client certificate lets executors authenticate the user over mTLS.
+		execCtx = sysContext.WithClientCertificates(execCtx, r.TLS.PeerCertificates)
+	}
+	if flowR.Debug {
+		// Debug traces expose the flow context, so only administrators may request them.
+		if !security.HasSystemPermission(security.GetPermissions(r.Context())) {
+			sysutils.WriteErrorResponse(r.Context(), w, http.StatusForbidden, APIErrorDebugTraceNotPermitted)
+			return
+		}
+		execCtx = withDebugTrace(execCtx)
+	}
 	flowStep, flowErr := h.flowExecService.Execute(
-		r.Context(), appID, executionID, flowTypeStr, verbose, action, inputs, challengeToken, flowSecret)
+		execCtx, appID, executionID, flowTypeStr, verbose, action, inputs, challengeToken, flowSecret)
 
 	if flowErr != nil {
 		handleFlowError(r.Context(), w, flowErr)
@@ -76,6 +100,12 @@ func (h *flowExecutionHandler) HandleFlowExecutionRequest(w http.ResponseWriter,
 		stepErrorResp = &resp
 	}
 
+	if h.localizer != nil {
+		language := h.localizer.resolveLanguage(r.Context(), flowStep.UILocales,
+			r.Header.Get(serverconst.AcceptLanguageHeaderName))
+		h.localizer.localize(r.Context(), language, &flowStep.Data, stepErrorResp)
+	}
+
 	flowResp := FlowResponse{
 		ExecutionID:    flowStep.ExecutionID,
 		StepID:         flowStep.StepID,
@@ -93,6 +123,87 @@ func (h *flowExecutionHandler) HandleFlowExecutionRequest(w http.ResponseWriter,
 		log.String(log.LoggerKeyExecutionID, flowResp.ExecutionID))
 }
 
+// HandleGetExecutionTraceRequest handles the request to retrieve the debug trace of a flow execution.
+func (h *flowExecutionHandler) HandleGetExecutionTraceRequest(w http.ResponseWriter, r *http.Request) {
+	// Debug traces expose the flow inputs, so only administrators may read them.
+	if !security.HasSystemPermission(security.GetPermissions(r.Context())) {
+		sysutils.WriteErrorResponse(r.Context(), w, http.StatusForbidden, APIErrorDebugTraceNotPermitted)
+		return
+	}
+	executionID := sysutils.SanitizeString(r.PathValue("id"))
+
+	trace, svcErr := h.flowExecService.GetExecutionTrace(r.Context(), executionID)
+	if svcErr != nil {
+		handleFlowError(r.Context(), w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(r.Context(), w, http.StatusOK, trace)
+}
+
+// HandleExecutionEventsRequest streams the status of a flow execution as server-sent events, so that the
+// client learns when to resume the flow instead of polling the flow execute endpoint.
+func (h *flowExecutionHandler) HandleExecutionEventsRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecutionHandler"))
+	executionID := sysutils.SanitizeString(r.PathValue("id"))
+
+	events, svcErr := h.flowExecService.WatchExecution(r.Context(), executionID)
+	if svcErr != nil {
+		handleFlowError(r.Context(), w, svcErr)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	extendEventStreamDeadline(rc)
+	w.Header().Set(serverconst.ContentTypeHeaderName, contentTypeEventStream)
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoCache)
+	// Stops reverse proxies such as nginx from buffering the stream.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	heartbeat := time.NewTicker(executionEventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		var payload []byte
+		select {
+		case <-r.Context().Done():
+			return
+		case evt, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(evt)
+			if err != nil {
+				logger.Error(r.Context(), "Failed to encode flow execution event",
+					log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+				return
+			}
+			payload = fmt.Appendf(nil, "event: %s\ndata: %s\n\n", executionEventName, data)
+		case <-heartbeat.C:
+			payload = []byte(": keep-alive\n\n")
+		}
+
+		if _, err := w.Write(payload); err != nil {
+			logger.Debug(r.Context(), "Flow execution event stream closed",
+				log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			logger.Debug(r.Context(), "Failed to flush flow execution event stream",
+				log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+			return
+		}
+		extendEventStreamDeadline(rc)
+	}
+}
+
+// extendEventStreamDeadline extends the write deadline of an event stream past the next heartbeat, as
+// the server write timeout would otherwise end the stream.
+func extendEventStreamDeadline(rc *http.ResponseController) {
+	_ = rc.SetWriteDeadline(time.Now().Add(executionEventHeartbeatInterval + executionEventWriteTimeout))
+}
+
 // handleFlowError handles errors that occur during flow execution as an API error response.
 func handleFlowError(ctx context.Context, w http.ResponseWriter, flowErr *tidcommon.ServiceError) {
 	errResp := apierror.ErrorResponse{
@@ -104,10 +215,12 @@ func handleFlowError(ctx context.Context, w http.ResponseWriter, flowErr *tidcom
 	statusCode := http.StatusInternalServerError
 	if flowErr.Type == tidcommon.ClientErrorType {
 		switch flowErr.Code {
-		case ErrorDirectFlowInitiationNotPermitted.Code:
+		case ErrorDirectFlowInitiationNotPermitted.Code, ErrorAccessPolicyDenied.Code:
 			statusCode = http.StatusForbidden
 		case ErrorFlowSecretRequired.Code, ErrorFlowSecretInvalid.Code:
 			statusCode = http.StatusUnauthorized
+		case ErrorExecutionTraceNotFound.Code:
+			statusCode = http.StatusNotFound
 		default:
 			statusCode = http.StatusBadRequest
 		}
@@ -21,6 +21,9 @@ package flowexec
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -31,6 +34,9 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/security"
 )
 
 const testFlowExecRequestBody = `{"applicationId":"app-1","flowType":"AUTHENTICATION","action":"submit"}`
@@ -46,7 +52,7 @@ func TestHandlerTestSuite(t *testing.T) {
 func (s *HandlerTestSuite) TestNewFlowExecutionHandler() {
 	t := s.T()
 	mockSvc := NewFlowExecServiceInterfaceMock(t)
-	h := newFlowExecutionHandler(mockSvc)
+	h := newFlowExecutionHandler(mockSvc, nil)
 	s.NotNil(h)
 	s.Equal(mockSvc, h.flowExecService)
 }
@@ -71,6 +77,12 @@ func (s *HandlerTestSuite) TestHandleFlowError_ForbiddenError_Returns403() {
 	s.Equal(http.StatusForbidden, w.Code)
 }
 
+func (s *HandlerTestSuite) TestHandleFlowError_AccessPolicyDenied_Returns403() {
+	w := httptest.NewRecorder()
+	handleFlowError(context.Background(), w, &ErrorAccessPolicyDenied)
+	s.Equal(http.StatusForbidden, w.Code)
+}
+
 func (s *HandlerTestSuite) TestHandleFlowError_ServerError_Returns500() {
 	w := httptest.NewRecorder()
 	svcErr := &tidcommon.ServiceError{
@@ -104,7 +116,7 @@ func (s *HandlerTestSuite) TestConvertToAPIError() {
 func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_InvalidJSON() {
 	t := s.T()
 	mockSvc := NewFlowExecServiceInterfaceMock(t)
-	h := newFlowExecutionHandler(mockSvc)
+	h := newFlowExecutionHandler(mockSvc, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString("not-json"))
 	req.Header.Set("Content-Type", "application/json")
@@ -121,7 +133,7 @@ func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_ServiceError() {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, &ErrorDirectFlowInitiationNotPermitted)
 
-	h := newFlowExecutionHandler(mockSvc)
+	h := newFlowExecutionHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString(testFlowExecRequestBody))
 	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
@@ -141,7 +153,7 @@ func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_Success() {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(flowStep, (*tidcommon.ServiceError)(nil))
 
-	h := newFlowExecutionHandler(mockSvc)
+	h := newFlowExecutionHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString(testFlowExecRequestBody))
 	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
@@ -150,6 +162,28 @@ func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_Success() {
 	s.Equal(http.StatusOK, w.Code)
 }
 
+func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_PassesClientCertificates() {
+	t := s.T()
+	mockSvc := NewFlowExecServiceInterfaceMock(t)
+	cert := &x509.Certificate{SerialNumber: big.NewInt(1)}
+	mockSvc.EXPECT().Execute(mock.MatchedBy(func(ctx context.Context) bool {
+		certs := sysContext.GetClientCertificates(ctx)
+		return len(certs) == 1 && certs[0] == cert
+	}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).
+		Return(&FlowStep{ExecutionID: "exec-1", Status: providers.FlowStatusIncomplete},
+			(*tidcommon.ServiceError)(nil))
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString(testFlowExecRequestBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	w := httptest.NewRecorder()
+
+	h.HandleFlowExecutionRequest(w, req)
+	s.Equal(http.StatusOK, w.Code)
+}
+
 func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_StepWithError() {
 	t := s.T()
 	mockSvc := NewFlowExecServiceInterfaceMock(t)
@@ -169,7 +203,7 @@ func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_StepWithError() {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(flowStep, (*tidcommon.ServiceError)(nil))
 
-	h := newFlowExecutionHandler(mockSvc)
+	h := newFlowExecutionHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString(testFlowExecRequestBody))
 	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
@@ -177,3 +211,148 @@ func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_StepWithError() {
 	h.HandleFlowExecutionRequest(w, req)
 	s.Equal(http.StatusOK, w.Code)
 }
+
+func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_DebugRequiresAdmin() {
+	security.InitSystemPermissions("")
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	body := `{"applicationId":"app-1","flowType":"AUTHENTICATION","action":"submit","debug":true}`
+	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	h.HandleFlowExecutionRequest(w, req)
+	s.Equal(http.StatusForbidden, w.Code)
+	s.Contains(w.Body.String(), APIErrorDebugTraceNotPermitted.Code)
+	mockSvc.AssertNotCalled(s.T(), "Execute")
+}
+
+func (s *HandlerTestSuite) TestHandleFlowExecutionRequest_DebugByAdminRequestsTrace() {
+	security.InitSystemPermissions("")
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+	mockSvc.EXPECT().Execute(mock.MatchedBy(func(ctx context.Context) bool {
+		return isDebugTraceRequested(ctx)
+	}), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).
+		Return(&FlowStep{ExecutionID: "exec-1", Status: providers.FlowStatusIncomplete},
+			(*tidcommon.ServiceError)(nil))
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	body := `{"applicationId":"app-1","flowType":"AUTHENTICATION","action":"submit","debug":true}`
+	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(security.WithSecurityContextTest(req.Context(),
+		security.NewSecurityContextForTest("admin", "ou-1", "token", []string{"system"}, nil)))
+	w := httptest.NewRecorder()
+
+	h.HandleFlowExecutionRequest(w, req)
+	s.Equal(http.StatusOK, w.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleGetExecutionTraceRequest_Success() {
+	security.InitSystemPermissions("")
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+	mockSvc.EXPECT().GetExecutionTrace(mock.Anything, "exec-1").
+		Return(&FlowTrace{ExecutionID: "exec-1", Entries: []FlowTraceEntry{{Sequence: 1, NodeID: "n1"}}},
+			(*tidcommon.ServiceError)(nil))
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/exec-1/trace", nil)
+	req.SetPathValue("id", "exec-1")
+	req = req.WithContext(security.WithSecurityContextTest(req.Context(),
+		security.NewSecurityContextForTest("admin", "ou-1", "token", []string{"system"}, nil)))
+	w := httptest.NewRecorder()
+
+	h.HandleGetExecutionTraceRequest(w, req)
+	s.Equal(http.StatusOK, w.Code)
+	s.Contains(w.Body.String(), `"nodeId":"n1"`)
+}
+
+func (s *HandlerTestSuite) TestHandleGetExecutionTraceRequest_RequiresAdmin() {
+	security.InitSystemPermissions("")
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/exec-1/trace", nil)
+	req.SetPathValue("id", "exec-1")
+	req = req.WithContext(security.WithSecurityContextTest(req.Context(),
+		security.NewSecurityContextForTest("user-1", "ou-1", "token", []string{"flow:read"}, nil)))
+	w := httptest.NewRecorder()
+
+	h.HandleGetExecutionTraceRequest(w, req)
+	s.Equal(http.StatusForbidden, w.Code)
+	s.Contains(w.Body.String(), APIErrorDebugTraceNotPermitted.Code)
+	mockSvc.AssertNotCalled(s.T(), "GetExecutionTrace")
+}
+
+func (s *HandlerTestSuite) TestHandleGetExecutionTraceRequest_NotFound() {
+	security.InitSystemPermissions("")
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+	mockSvc.EXPECT().GetExecutionTrace(mock.Anything, "missing").
+		Return(nil, &ErrorExecutionTraceNotFound)
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/missing/trace", nil)
+	req.SetPathValue("id", "missing")
+	req = req.WithContext(security.WithSecurityContextTest(req.Context(),
+		security.NewSecurityContextForTest("admin", "ou-1", "token", []string{"system"}, nil)))
+	w := httptest.NewRecorder()
+
+	h.HandleGetExecutionTraceRequest(w, req)
+	s.Equal(http.StatusNotFound, w.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleExecutionEventsRequest_StreamsEvents() {
+	events := make(chan ExecutionEvent, 2)
+	events <- ExecutionEvent{ExecutionID: "exec-1", Status: ExecutionStatusPending}
+	events <- ExecutionEvent{ExecutionID: "exec-1", Status: ExecutionStatusReady}
+	close(events)
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+	mockSvc.EXPECT().WatchExecution(mock.Anything, "exec-1").
+		Return((<-chan ExecutionEvent)(events), (*tidcommon.ServiceError)(nil))
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/exec-1/events", nil)
+	req.SetPathValue("id", "exec-1")
+	w := httptest.NewRecorder()
+
+	h.HandleExecutionEventsRequest(w, req)
+	s.Equal(http.StatusOK, w.Code)
+	s.Equal("text/event-stream", w.Header().Get("Content-Type"))
+	s.True(w.Flushed)
+	s.Equal("event: status\ndata: {\"executionId\":\"exec-1\",\"status\":\"PENDING\"}\n\n"+
+		"event: status\ndata: {\"executionId\":\"exec-1\",\"status\":\"READY\"}\n\n", w.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandleExecutionEventsRequest_InvalidExecutionID() {
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+	mockSvc.EXPECT().WatchExecution(mock.Anything, "missing").
+		Return(nil, &ErrorInvalidExecutionID)
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/missing/events", nil)
+	req.SetPathValue("id", "missing")
+	w := httptest.NewRecorder()
+
+	h.HandleExecutionEventsRequest(w, req)
+	s.Equal(http.StatusBadRequest, w.Code)
+	s.Contains(w.Body.String(), ErrorInvalidExecutionID.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleExecutionEventsRequest_ClientDisconnected() {
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+	mockSvc.EXPECT().WatchExecution(mock.Anything, "exec-1").
+		Return(make(<-chan ExecutionEvent), (*tidcommon.ServiceError)(nil))
+
+	h := newFlowExecutionHandler(mockSvc, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/exec-1/events", nil).WithContext(ctx)
+	req.SetPathValue("id", "exec-1")
+	w := httptest.NewRecorder()
+
+	h.HandleExecutionEventsRequest(w, req)
+	s.Equal(http.StatusOK, w.Code)
+	s.Empty(w.Body.String())
+}
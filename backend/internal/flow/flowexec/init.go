@@ -21,6 +21,7 @@ package flowexec
 import (
 	"net/http"
 
+	"github.com/thunder-id/thunderid/internal/authn/accesspolicy"
 	flowconfig "github.com/thunder-id/thunderid/internal/flow/config"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
 	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
@@ -36,6 +37,7 @@ func Initialize(
 	mux *http.ServeMux,
 	flowProvider providers.FlowProvider,
 	actorProvider providers.ActorProvider,
+	accessPolicySvc accesspolicy.AccessPolicyServiceInterface,
 	executorRegistry executor.ExecutorRegistryInterface,
 	interceptorRegistry interceptor.InterceptorRegistryInterface,
 	observabilitySvc providers.ObservabilityProvider,
@@ -43,16 +45,23 @@ func Initialize(
 	graphBuilder graphbuilder.GraphBuilderInterface,
 	storeProvider providers.RuntimeStoreProvider,
 	transactioner transaction.Transactioner,
+	i18nProvider providers.I18nProvider,
 	cfg flowconfig.Config,
 ) (FlowExecServiceInterface, error) {
 	flowStore := newFlowStore(storeProvider)
 	interceptorRunner := newInterceptorRunner(interceptorRegistry)
 	flowEngine := newFlowEngine(executorRegistry, interceptorRunner, observabilitySvc,
 		flowProvider, graphBuilder)
-	flowExecService := newFlowExecService(flowProvider, flowStore, flowEngine,
-		actorProvider, observabilitySvc, transactioner, cryptoSvc, graphBuilder, cfg)
+	traceStore := newFlowTraceStore(storeProvider)
+	flowExecService := newFlowExecService(flowProvider, flowStore, traceStore, flowEngine,
+		actorProvider, accessPolicySvc, observabilitySvc, transactioner, cryptoSvc, graphBuilder,
+		executorRegistry, cfg)
 
-	handler := newFlowExecutionHandler(flowExecService)
+	var localizer *stepLocalizer
+	if cfg.Flow.LocalizeResponses && i18nProvider != nil {
+		localizer = newStepLocalizer(i18nProvider)
+	}
+	handler := newFlowExecutionHandler(flowExecService, localizer)
 	registerRoutes(mux, handler)
 
 	return flowExecService, nil
@@ -60,10 +69,11 @@ func Initialize(
 
 func registerRoutes(mux *http.ServeMux, handler *flowExecutionHandler) {
 	opts := middleware.CORSOptions{
-		AllowedMethods:   []string{"POST"},
-		AllowedHeaders:   middleware.DefaultAllowedHeaders,
-		AllowCredentials: true,
-		MaxAge:           600,
+		AllowedMethods:          []string{"POST"},
+		AllowedHeaders:          middleware.DefaultAllowedHeaders,
+		AllowCredentials:        true,
+		MaxAge:                  600,
+		AllowApplicationOrigins: true,
 	}
 	mux.HandleFunc(middleware.WithCORS("POST /flow/execute",
 		middleware.CorrelationIDMiddleware(http.HandlerFunc(handler.HandleFlowExecutionRequest)).ServeHTTP, opts))
@@ -71,4 +81,33 @@ func registerRoutes(mux *http.ServeMux, handler *flowExecutionHandler) {
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts))
+
+	traceOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /flow-executions/{id}/trace",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(handler.HandleGetExecutionTraceRequest)).ServeHTTP,
+		traceOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flow-executions/{id}/trace",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, traceOpts))
+
+	eventsOpts := middleware.CORSOptions{
+		AllowedMethods:          []string{"GET"},
+		AllowedHeaders:          middleware.DefaultAllowedHeaders,
+		AllowCredentials:        true,
+		MaxAge:                  600,
+		AllowApplicationOrigins: true,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /flow-executions/{id}/events",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(handler.HandleExecutionEventsRequest)).ServeHTTP,
+		eventsOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flow-executions/{id}/events",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, eventsOpts))
 }
@@ -32,4 +32,6 @@ type FlowExecServiceInterface interface {
 		*FlowStep, *tidcommon.ServiceError)
 	InitiateFlow(ctx context.Context, initContext *FlowInitContext) (string, *tidcommon.ServiceError)
 	InitiateAndExecute(ctx context.Context, initContext *FlowInitContext) (*FlowStep, *tidcommon.ServiceError)
+	GetExecutionTrace(ctx context.Context, executionID string) (*FlowTrace, *tidcommon.ServiceError)
+	WatchExecution(ctx context.Context, executionID string) (<-chan ExecutionEvent, *tidcommon.ServiceError)
 }
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	goi18n "golang.org/x/text/language"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysi18n "github.com/thunder-id/thunderid/internal/system/i18n/core"
+	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// i18nReferencePattern matches i18n references of the form "{{ t(namespace:key) }}".
+var i18nReferencePattern = regexp.MustCompile(`\{\{\s*t\(\s*([^:()\s]+):([^()\s]+)\s*\)\s*\}\}`)
+
+// stepLocalizer resolves i18n references in flow step responses against the translation
+// bundles of the deployment.
+type stepLocalizer struct {
+	i18nProvider providers.I18nProvider
+	logger       *log.Logger
+}
+
+// newStepLocalizer creates a new instance of stepLocalizer.
+func newStepLocalizer(i18nProvider providers.I18nProvider) *stepLocalizer {
+	return &stepLocalizer{
+		i18nProvider: i18nProvider,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowStepLocalizer")),
+	}
+}
+
+// resolveLanguage selects the best supported language for the given preferences. The space-separated
+// ui_locales of the authorization request take precedence over the Accept-Language header. Returns an
+// empty string when no preference is given.
+func (l *stepLocalizer) resolveLanguage(ctx context.Context, uiLocales, acceptLanguage string) string {
+	preferred := make([]goi18n.Tag, 0)
+	for _, locale := range strings.Fields(uiLocales) {
+		if tag, err := goi18n.Parse(locale); err == nil {
+			preferred = append(preferred, tag)
+		}
+	}
+	if acceptLanguage != "" {
+		if tags, _, err := goi18n.ParseAcceptLanguage(acceptLanguage); err == nil {
+			preferred = append(preferred, tags...)
+		}
+	}
+	if len(preferred) == 0 {
+		return ""
+	}
+
+	languages, svcErr := l.i18nProvider.ListLanguages(ctx)
+	if svcErr != nil {
+		l.logger.Debug(ctx, "Failed to list languages for localization",
+			log.String("error", svcErr.Error.DefaultValue))
+		return ""
+	}
+
+	// The system language is placed first so that it is selected when no preference matches.
+	available := []string{i18nmgt.SystemLanguage}
+	for _, language := range languages {
+		if language != i18nmgt.SystemLanguage {
+			available = append(available, language)
+		}
+	}
+	availableTags := make([]goi18n.Tag, 0, len(available))
+	for _, language := range available {
+		availableTags = append(availableTags, goi18n.Make(language))
+	}
+
+	_, index, _ := goi18n.NewMatcher(availableTags).Match(preferred...)
+	return available[index]
+}
+
+// localize resolves the i18n references in the step data and error response for the given language.
+// References that cannot be resolved are left unchanged so that clients can still resolve them.
+func (l *stepLocalizer) localize(ctx context.Context, language string, data *FlowData,
+	errResp *apierror.ErrorResponse) {
+	if language == "" {
+		return
+	}
+
+	namespaces := make(map[string]struct{})
+	collectI18nNamespaces(data.Meta, namespaces)
+	collectI18nNamespaces(data.AdditionalData[common.DataPromptMessage], namespaces)
+	if errResp != nil {
+		namespaces[i18nmgt.SystemNamespace] = struct{}{}
+	}
+	if len(namespaces) == 0 {
+		return
+	}
+
+	translations := make(map[string]map[string]string, len(namespaces))
+	for namespace := range namespaces {
+		resp, svcErr := l.i18nProvider.ResolveTranslations(ctx, language, namespace)
+		if svcErr != nil {
+			l.logger.Debug(ctx, "Failed to resolve translations for localization",
+				log.String("language", language), log.String("namespace", namespace),
+				log.String("error", svcErr.Error.DefaultValue))
+			continue
+		}
+		if resp != nil {
+			translations[namespace] = resp.Translations[namespace]
+		}
+	}
+
+	if data.Meta != nil {
+		data.Meta = localizeValue(data.Meta, translations)
+	}
+	if message, ok := data.AdditionalData[common.DataPromptMessage]; ok {
+		data.AdditionalData[common.DataPromptMessage] = localizeString(message, translations)
+	}
+	if errResp != nil {
+		errResp.Message = localizeMessage(errResp.Message, translations[i18nmgt.SystemNamespace])
+		errResp.Description = localizeMessage(errResp.Description, translations[i18nmgt.SystemNamespace])
+	}
+}
+
+// collectI18nNamespaces collects the namespaces of the i18n references found in the given value.
+func collectI18nNamespaces(value interface{}, namespaces map[string]struct{}) {
+	switch v := value.(type) {
+	case string:
+		for _, match := range i18nReferencePattern.FindAllStringSubmatch(v, -1) {
+			namespaces[match[1]] = struct{}{}
+		}
+	case map[string]interface{}:
+		for _, item := range v {
+			collectI18nNamespaces(item, namespaces)
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectI18nNamespaces(item, namespaces)
+		}
+	}
+}
+
+// localizeValue returns a copy of the given value with its i18n references resolved. The value is
+// copied since node meta is shared across executions of the same flow graph.
+func localizeValue(value interface{}, translations map[string]map[string]string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return localizeString(v, translations)
+	case map[string]interface{}:
+		localized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			localized[key] = localizeValue(item, translations)
+		}
+		return localized
+	case []interface{}:
+		localized := make([]interface{}, len(v))
+		for i, item := range v {
+			localized[i] = localizeValue(item, translations)
+		}
+		return localized
+	default:
+		return value
+	}
+}
+
+// localizeString replaces every resolvable i18n reference in the given string with its translation.
+func localizeString(value string, translations map[string]map[string]string) string {
+	return i18nReferencePattern.ReplaceAllStringFunc(value, func(reference string) string {
+		match := i18nReferencePattern.FindStringSubmatch(reference)
+		if translation, ok := translations[match[1]][match[2]]; ok {
+			return translation
+		}
+		return reference
+	})
+}
+
+// localizeMessage replaces the default value of the message with its translation. Messages whose
+// default value was customized at runtime are left unchanged, as the translation of the key would
+// discard the runtime detail.
+func localizeMessage(message tidcommon.I18nMessage,
+	translations map[string]string) tidcommon.I18nMessage {
+	if message.Key == "" {
+		return message
+	}
+	if defaultValue, ok := sysi18n.GetDefault(message.Key); !ok || defaultValue != message.DefaultValue {
+		return message
+	}
+	if translation, ok := translations[message.Key]; ok {
+		message.DefaultValue = translation
+	}
+	return message
+}
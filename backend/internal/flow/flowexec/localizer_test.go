@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/tests/mocks/i18n/mgtmock"
+)
+
+type StepLocalizerTestSuite struct {
+	suite.Suite
+	mockI18nService *mgtmock.I18nServiceInterfaceMock
+	localizer       *stepLocalizer
+}
+
+func TestStepLocalizerTestSuite(t *testing.T) {
+	suite.Run(t, new(StepLocalizerTestSuite))
+}
+
+func (s *StepLocalizerTestSuite) SetupTest() {
+	s.mockI18nService = mgtmock.NewI18nServiceInterfaceMock(s.T())
+	s.localizer = newStepLocalizer(s.mockI18nService)
+}
+
+func (s *StepLocalizerTestSuite) TestResolveLanguage() {
+	testCases := []struct {
+		name           string
+		uiLocales      string
+		acceptLanguage string
+		expected       string
+	}{
+		{"UILocalesTakePrecedence", "fr-CA de", "de-DE,de;q=0.9", "fr"},
+		{"AcceptLanguage", "", "de-DE,de;q=0.9,en;q=0.5", "de"},
+		{"UnsupportedFallsBackToSystemLanguage", "ja", "", i18nmgt.SystemLanguage},
+		{"InvalidUILocalesAreIgnored", "not_a_locale", "de", "de"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockI18nService.On("ListLanguages", mock.Anything).
+				Return([]string{i18nmgt.SystemLanguage, "fr", "de"}, nil).Once()
+
+			s.Equal(tc.expected, s.localizer.resolveLanguage(context.Background(), tc.uiLocales, tc.acceptLanguage))
+		})
+	}
+}
+
+func (s *StepLocalizerTestSuite) TestResolveLanguage_NoPreference() {
+	s.Equal("", s.localizer.resolveLanguage(context.Background(), "", ""))
+}
+
+func (s *StepLocalizerTestSuite) TestResolveLanguage_ListLanguagesFails() {
+	s.mockI18nService.On("ListLanguages", mock.Anything).Return(nil, &tidcommon.InternalServerError)
+
+	s.Equal("", s.localizer.resolveLanguage(context.Background(), "fr", ""))
+}
+
+func (s *StepLocalizerTestSuite) TestLocalize_MetaAndPromptMessage() {
+	meta := map[string]interface{}{
+		"components": []interface{}{
+			map[string]interface{}{"type": "TEXT", "label": "{{ t(signup:heading) }}"},
+			map[string]interface{}{"type": "ACTION", "label": "{{t(elements:buttons.submit.text)}}"},
+			map[string]interface{}{"type": "TEXT", "label": "{{ t(signup:unknown) }}"},
+		},
+	}
+	s.mockI18nService.On("ResolveTranslations", mock.Anything, "fr", "signup").
+		Return(&providers.LanguageTranslationsResponse{
+			Language:     "fr",
+			Translations: map[string]map[string]string{"signup": {"heading": "Créer un compte"}},
+		}, nil)
+	s.mockI18nService.On("ResolveTranslations", mock.Anything, "fr", "elements").
+		Return(&providers.LanguageTranslationsResponse{
+			Language:     "fr",
+			Translations: map[string]map[string]string{"elements": {"buttons.submit.text": "Envoyer"}},
+		}, nil)
+
+	data := &FlowData{
+		Meta:           meta,
+		AdditionalData: map[string]string{common.DataPromptMessage: "{{ t(signup:heading) }}!"},
+	}
+	s.localizer.localize(context.Background(), "fr", data, nil)
+
+	components := data.Meta.(map[string]interface{})["components"].([]interface{})
+	s.Equal("Créer un compte", components[0].(map[string]interface{})["label"])
+	s.Equal("Envoyer", components[1].(map[string]interface{})["label"])
+	s.Equal("{{ t(signup:unknown) }}", components[2].(map[string]interface{})["label"])
+	s.Equal("Créer un compte!", data.AdditionalData[common.DataPromptMessage])
+
+	// The shared node meta must not be modified.
+	original := meta["components"].([]interface{})[0].(map[string]interface{})
+	s.Equal("{{ t(signup:heading) }}", original["label"])
+}
+
+func (s *StepLocalizerTestSuite) TestLocalize_ErrorResponse() {
+	s.mockI18nService.On("ResolveTranslations", mock.Anything, "fr", i18nmgt.SystemNamespace).
+		Return(&providers.LanguageTranslationsResponse{
+			Language: "fr",
+			Translations: map[string]map[string]string{i18nmgt.SystemNamespace: {
+				ErrorFlowSecretInvalid.Error.Key:            "Secret de flux invalide",
+				ErrorFlowSecretInvalid.ErrorDescription.Key: "Le secret de flux est invalide",
+			}},
+		}, nil)
+
+	errResp := convertToAPIError(&ErrorFlowSecretInvalid)
+	errResp.Description.DefaultValue = "Runtime detail from the executor"
+	s.localizer.localize(context.Background(), "fr", &FlowData{}, &errResp)
+
+	s.Equal("Secret de flux invalide", errResp.Message.DefaultValue)
+	s.Equal(ErrorFlowSecretInvalid.Error.Key, errResp.Message.Key)
+	s.Equal("Runtime detail from the executor", errResp.Description.DefaultValue)
+}
+
+func (s *StepLocalizerTestSuite) TestLocalize_NoLanguage() {
+	data := &FlowData{Meta: map[string]interface{}{"label": "{{ t(signup:heading) }}"}}
+	s.localizer.localize(context.Background(), "", data, nil)
+
+	s.Equal("{{ t(signup:heading) }}", data.Meta.(map[string]interface{})["label"])
+}
+
+func (s *StepLocalizerTestSuite) TestHandleFlowExecutionRequest_LocalizesResponse() {
+	mockSvc := NewFlowExecServiceInterfaceMock(s.T())
+	mockSvc.On("Execute", mock.Anything, "app-1", "", "AUTHENTICATION", false, "submit",
+		mock.Anything, "", "").
+		Return(&FlowStep{
+			ExecutionID: "exec-1",
+			Status:      providers.FlowStatusIncomplete,
+			Data:        FlowData{Meta: map[string]interface{}{"label": "{{ t(signup:heading) }}"}},
+			UILocales:   "fr",
+		}, nil)
+	s.mockI18nService.On("ListLanguages", mock.Anything).Return([]string{i18nmgt.SystemLanguage, "fr"}, nil)
+	s.mockI18nService.On("ResolveTranslations", mock.Anything, "fr", "signup").
+		Return(&providers.LanguageTranslationsResponse{
+			Language:     "fr",
+			Translations: map[string]map[string]string{"signup": {"heading": "Créer un compte"}},
+		}, nil)
+
+	h := newFlowExecutionHandler(mockSvc, s.localizer)
+	req := httptest.NewRequest(http.MethodPost, "/flow/execute", bytes.NewBufferString(testFlowExecRequestBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept-Language", "de")
+	w := httptest.NewRecorder()
+
+	h.HandleFlowExecutionRequest(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var resp struct {
+		Data  FlowData                `json:"data"`
+		Error *apierror.ErrorResponse `json:"error"`
+	}
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	s.Equal("Créer un compte", resp.Data.Meta.(map[string]interface{})["label"])
+}
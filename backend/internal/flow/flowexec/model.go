@@ -35,7 +35,8 @@ import (
 )
 
 // frame captures the per-call execution state saved when a CALL node pushes execution
-// into a callee flow and restored when the callee returns.
+// into a callee flow and restored when the callee returns. Frames are persisted with the flow
+// context so that any node can resume the flow; forwardedData is transient and is not persisted.
 type frame struct {
 	graph               core.GraphInterface
 	flowType            providers.FlowType
@@ -60,6 +61,7 @@ type EngineContext struct {
 	FlowType       providers.FlowType
 	AppID          string
 	Verbose        bool
+	Debug          bool
 	UserInputs     map[string]string
 	RuntimeData    map[string]string
 	ForwardedData  map[string]interface{}
@@ -87,6 +89,9 @@ type EngineContext struct {
 	frameStack []*frame
 	// sharedRuntimeData is a cross-frame key-value store available to executors that opt in.
 	sharedRuntimeData map[string]string
+	// traceEntries collects the node execution snapshots recorded within the current request when
+	// debug tracing is enabled.
+	traceEntries []FlowTraceEntry
 }
 
 // mergeRuntimeData merges the given data into RuntimeData.
@@ -137,6 +142,15 @@ func (e *EngineContext) popFrame() *frame {
 	return top
 }
 
+// getUILocales returns the end-user preferred locales recorded for the flow. The locales are recorded
+// in the runtime data of the root flow, which is saved in the bottom frame while a callee is executing.
+func (e *EngineContext) getUILocales() string {
+	if len(e.frameStack) > 0 {
+		return e.frameStack[0].runtimeData[common.RuntimeKeyUILocales]
+	}
+	return e.RuntimeData[common.RuntimeKeyUILocales]
+}
+
 // frameDepth returns the number of saved frames (0 means root flow).
 func (e *EngineContext) frameDepth() int {
 	return len(e.frameStack)
@@ -215,6 +229,8 @@ type FlowStep struct {
 	Data           FlowData
 	Assertion      string
 	Error          *tidcommon.ServiceError
+	// UILocales holds the end-user preferred locales recorded for the flow, if any.
+	UILocales string
 }
 
 // FlowData holds the data returned by a flow execution step
@@ -244,6 +260,7 @@ type FlowRequest struct {
 	ApplicationID  string            `json:"applicationId"`
 	FlowType       string            `json:"flowType"`
 	Verbose        bool              `json:"verbose,omitempty"`
+	Debug          bool              `json:"debug,omitempty"`
 	ExecutionID    string            `json:"executionId"`
 	ChallengeToken string            `json:"challengeToken,omitempty"`
 	Action         string            `json:"action"`
@@ -275,6 +292,7 @@ type serializedFrame struct {
 	CurrentAction    *string `json:"currentAction,omitempty"`
 	CurrentSegmentID *string `json:"currentSegmentId,omitempty"`
 	RuntimeData      *string `json:"runtimeData,omitempty"`
+	AdditionalData   *string `json:"additionalData,omitempty"`
 	ResumeCallNodeID string  `json:"resumeCallNodeId,omitempty"`
 }
 
@@ -282,6 +300,7 @@ type serializedFrame struct {
 type flowContextContent struct {
 	AppID                 string  `json:"appId"`
 	Verbose               bool    `json:"verbose"`
+	Debug                 bool    `json:"debug,omitempty"`
 	CurrentNodeID         *string `json:"currentNodeId,omitempty"`
 	CurrentAction         *string `json:"currentAction,omitempty"`
 	CurrentSegmentID      *string `json:"currentSegmentId,omitempty"`
@@ -454,6 +473,7 @@ func (f *FlowContextDB) ToEngineContext(ctx context.Context,
 		FlowType:              graph.GetType(),
 		AppID:                 content.AppID,
 		Verbose:               content.Verbose,
+		Debug:                 content.Debug,
 		UserInputs:            userInputs,
 		RuntimeData:           runtimeData,
 		CurrentNode:           currentNode,
@@ -596,6 +616,7 @@ func (f *FlowContextDB) FromEngineContext(ctx EngineContext) error {
 	content := flowContextContent{
 		AppID:                 ctx.AppID,
 		Verbose:               ctx.Verbose,
+		Debug:                 ctx.Debug,
 		CurrentNodeID:         currentNodeID,
 		CurrentAction:         currentAction,
 		CurrentSegmentID:      currentSegmentID,
@@ -668,6 +689,13 @@ func (f *FlowContextDB) deserializeFrameStack(ctx context.Context,
 			}
 		}
 
+		var additionalData map[string]string
+		if sf.AdditionalData != nil {
+			if err := json.Unmarshal([]byte(*sf.AdditionalData), &additionalData); err != nil {
+				return nil, err
+			}
+		}
+
 		frames = append(frames, &frame{
 			graph:            frameGraph,
 			flowType:         frameGraph.GetType(),
@@ -675,6 +703,7 @@ func (f *FlowContextDB) deserializeFrameStack(ctx context.Context,
 			currentAction:    currentAction,
 			currentSegmentID: currentSegmentID,
 			runtimeData:      runtimeData,
+			additionalData:   additionalData,
 			resumeCallNodeID: sf.ResumeCallNodeID,
 		})
 	}
@@ -719,6 +748,14 @@ func (f *FlowContextDB) serializeFrameStack(frameStack []*frame) (*string, error
 			s := string(b)
 			sf.RuntimeData = &s
 		}
+		if len(f.additionalData) > 0 {
+			b, err := json.Marshal(f.additionalData)
+			if err != nil {
+				return nil, err
+			}
+			s := string(b)
+			sf.AdditionalData = &s
+		}
 
 		serializedFrames = append(serializedFrames, sf)
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
@@ -1301,6 +1302,7 @@ func (s *ModelTestSuite) TestDeserializeFrameStack_WithCurrentNodeAndOptionalFie
 		currentAction:    "my-action",
 		currentSegmentID: "my-seg",
 		runtimeData:      map[string]string{"k": "v"},
+		additionalData:   map[string]string{"ak": "av"},
 		resumeCallNodeID: "call-1",
 	}
 	serialized, err := dbModel.serializeFrameStack([]*frame{f})
@@ -1318,6 +1320,7 @@ func (s *ModelTestSuite) TestDeserializeFrameStack_WithCurrentNodeAndOptionalFie
 	s.Equal("my-action", frames[0].currentAction)
 	s.Equal("my-seg", frames[0].currentSegmentID)
 	s.Equal("v", frames[0].runtimeData["k"])
+	s.Equal("av", frames[0].additionalData["ak"])
 }
 
 func (s *ModelTestSuite) TestDeserializeFrameStack_InvalidRuntimeDataJSON() {
@@ -1338,6 +1341,24 @@ func (s *ModelTestSuite) TestDeserializeFrameStack_InvalidRuntimeDataJSON() {
 	s.Error(err)
 }
 
+func (s *ModelTestSuite) TestDeserializeFrameStack_InvalidAdditionalDataJSON() {
+	t := s.T()
+	mockGraph := coremock.NewGraphInterfaceMock(t)
+
+	bad := "not-json"
+	frames := []serializedFrame{{GraphID: "graph-1", AdditionalData: &bad}}
+	b, _ := json.Marshal(frames)
+	frameStackStr := string(b)
+	content := flowContextContent{FrameStack: &frameStackStr}
+
+	dbModel := &FlowContextDB{}
+	resolver := graphResolverFunc(func(_ context.Context, _ string) (core.GraphInterface, error) {
+		return mockGraph, nil
+	})
+	_, err := dbModel.deserializeFrameStack(context.Background(), content, resolver)
+	s.Error(err)
+}
+
 func (s *ModelTestSuite) TestDeserializeFrameStack_NodeIDNotInGraph() {
 	t := s.T()
 	mockGraph := coremock.NewGraphInterfaceMock(t)
@@ -1359,3 +1380,14 @@ func (s *ModelTestSuite) TestDeserializeFrameStack_NodeIDNotInGraph() {
 	s.Len(result, 1)
 	s.Nil(result[0].currentNode)
 }
+
+func (s *ModelTestSuite) TestGetUILocales_FromRootFrame() {
+	ctx := &EngineContext{
+		RuntimeData: map[string]string{common.RuntimeKeyUILocales: "fr-CA"},
+	}
+	s.Equal("fr-CA", ctx.getUILocales())
+
+	ctx.pushFrame("call-node")
+	ctx.RuntimeData = map[string]string{}
+	s.Equal("fr-CA", ctx.getUILocales())
+}
@@ -28,10 +28,12 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
 	"github.com/thunder-id/thunderid/internal/actorprovider"
+	"github.com/thunder-id/thunderid/internal/authn/accesspolicy"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	flowconfig "github.com/thunder-id/thunderid/internal/flow/config"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
 	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
 	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
@@ -48,8 +50,11 @@ type flowExecService struct {
 	flowEngine       flowEngineInterface
 	flowProvider     providers.FlowProvider
 	graphBuilder     graphbuilder.GraphBuilderInterface
+	executorRegistry executor.ExecutorRegistryInterface
 	flowStore        flowStoreInterface
+	traceStore       flowTraceStoreInterface
 	actorProvider    providers.ActorProvider
+	accessPolicySvc  accesspolicy.AccessPolicyServiceInterface
 	observabilitySvc providers.ObservabilityProvider
 	transactioner    transaction.Transactioner
 	cryptoSvc        kmprovider.RuntimeCryptoProvider
@@ -58,22 +63,27 @@ type flowExecService struct {
 
 // newFlowExecService creates a new instance of flowExecService with the provided dependencies.
 func newFlowExecService(flowProvider providers.FlowProvider,
-	flowStore flowStoreInterface, flowEngine flowEngineInterface,
+	flowStore flowStoreInterface, traceStore flowTraceStoreInterface, flowEngine flowEngineInterface,
 	actorProvider providers.ActorProvider,
+	accessPolicySvc accesspolicy.AccessPolicyServiceInterface,
 	observabilitySvc providers.ObservabilityProvider,
 	transactioner transaction.Transactioner,
 	cryptoSvc kmprovider.RuntimeCryptoProvider,
 	graphBuilder graphbuilder.GraphBuilderInterface,
+	executorRegistry executor.ExecutorRegistryInterface,
 	cfg flowconfig.Config) FlowExecServiceInterface {
 	return &flowExecService{
 		flowProvider:     flowProvider,
 		flowStore:        flowStore,
+		traceStore:       traceStore,
 		flowEngine:       flowEngine,
 		actorProvider:    actorProvider,
+		accessPolicySvc:  accessPolicySvc,
 		observabilitySvc: observabilitySvc,
 		transactioner:    transactioner,
 		cryptoSvc:        cryptoSvc,
 		graphBuilder:     graphBuilder,
+		executorRegistry: executorRegistry,
 		cfg:              cfg,
 	}
 }
@@ -127,8 +137,12 @@ func (s *flowExecService) Execute(ctx context.Context,
 
 	// Set trace ID to engine context (request context is already set during context loading)
 	engineCtx.TraceID = traceID
+	if isDebugTraceRequested(ctx) {
+		engineCtx.Debug = true
+	}
 
 	flowStep, flowErr := s.flowEngine.Execute(engineCtx)
+	s.storeExecutionTrace(ctx, engineCtx, logger)
 
 	if flowErr != nil {
 		if !isNewFlow(executionID) {
@@ -140,6 +154,7 @@ func (s *flowExecService) Execute(ctx context.Context,
 		}
 		return nil, flowErr
 	}
+	flowStep.UILocales = engineCtx.getUILocales()
 
 	if isComplete(flowStep) {
 		if !isNewFlow(executionID) {
@@ -168,6 +183,57 @@ func (s *flowExecService) Execute(ctx context.Context,
 	return &flowStep, nil
 }
 
+// GetExecutionTrace returns the debug trace recorded for the given flow execution.
+func (s *flowExecService) GetExecutionTrace(ctx context.Context, executionID string) (
+	*FlowTrace, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecService"))
+
+	if executionID == "" {
+		return nil, &ErrorInvalidExecutionID
+	}
+
+	trace, err := s.traceStore.GetFlowTrace(ctx, executionID)
+	if err != nil {
+		logger.Error(ctx, "Failed to retrieve flow execution trace",
+			log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if trace == nil {
+		return nil, &ErrorExecutionTraceNotFound
+	}
+
+	return trace, nil
+}
+
+// storeExecutionTrace appends the node snapshots recorded during the current request to the debug
+// trace of the flow execution. Failures are logged and do not affect the flow execution.
+func (s *flowExecService) storeExecutionTrace(ctx context.Context, engineCtx *EngineContext, logger *log.Logger) {
+	if !engineCtx.Debug || len(engineCtx.traceEntries) == 0 || engineCtx.ExecutionID == "" {
+		return
+	}
+
+	trace, err := s.traceStore.GetFlowTrace(ctx, engineCtx.ExecutionID)
+	if err != nil {
+		logger.Error(ctx, "Failed to retrieve flow execution trace",
+			log.String(log.LoggerKeyExecutionID, engineCtx.ExecutionID), log.Error(err))
+		return
+	}
+	if trace == nil {
+		trace = &FlowTrace{
+			ExecutionID: engineCtx.ExecutionID,
+			FlowType:    string(engineCtx.FlowType),
+			AppID:       engineCtx.AppID,
+		}
+	}
+	mergeFlowTrace(trace, engineCtx.traceEntries)
+	engineCtx.traceEntries = nil
+
+	if err := s.traceStore.StoreFlowTrace(ctx, *trace, flowTraceExpiry); err != nil {
+		logger.Error(ctx, "Failed to store flow execution trace",
+			log.String(log.LoggerKeyExecutionID, engineCtx.ExecutionID), log.Error(err))
+	}
+}
+
 // initContext initializes a new flow context with the given details.
 func (s *flowExecService) loadNewContext(ctx context.Context, appID, flowTypeStr string, verbose bool,
 	action string, inputs map[string]string, flowSecret string, logger *log.Logger) (
@@ -181,7 +247,7 @@ func (s *flowExecService) loadNewContext(ctx context.Context, appID, flowTypeStr
 		return nil, svcErr
 	}
 
-	engineCtx, err := s.initContext(ctx, appID, flowType, verbose, logger)
+	engineCtx, err := s.initContext(ctx, appID, flowType, verbose, "", logger)
 	if err != nil {
 		return nil, err
 	}
@@ -280,10 +346,11 @@ func isClientCredentialsOnly(grantTypes []string) bool {
 	return len(grantTypes) == 1 && grantTypes[0] == string(providers.GrantTypeClientCredentials)
 }
 
-// initContext initializes a new flow context with the given details.
+// initContext initializes a new flow context with the given details. When sharedOUID is set, the
+// flow selection and application view honour the overrides of that shared organization unit.
 func (s *flowExecService) initContext(ctx context.Context, appID string, flowType providers.FlowType,
-	verbose bool, logger *log.Logger) (*EngineContext, *tidcommon.ServiceError) {
-	graphID, svcErr := s.getFlowGraph(ctx, appID, flowType, logger)
+	verbose bool, sharedOUID string, logger *log.Logger) (*EngineContext, *tidcommon.ServiceError) {
+	graphID, svcErr := s.getFlowGraph(ctx, appID, flowType, sharedOUID, logger)
 	if svcErr != nil {
 		return nil, svcErr
 	}
@@ -319,6 +386,9 @@ func (s *flowExecService) initContext(ctx context.Context, appID string, flowTyp
 	engineCtx.Context = ctx
 	engineCtx.AppID = appID
 	engineCtx.Verbose = verbose
+	if sharedOUID != "" {
+		engineCtx.RuntimeData = map[string]string{common.RuntimeKeySharedOUID: sharedOUID}
+	}
 
 	// Set application context if required
 	if err := s.setApplicationToContext(&engineCtx, logger); err != nil {
@@ -462,6 +532,15 @@ func (s *flowExecService) setApplicationToContext(engineCtx *EngineContext,
 			log.String("appID", engineCtx.AppID), log.String("errorCode", svcErr.Code))
 		return svcErr
 	}
+	if s.accessPolicySvc != nil && !app.AccessPolicy.IsEmpty() &&
+		!s.accessPolicySvc.IsAllowed(engineCtx.Context, app.ID, app.AccessPolicy) {
+		return &ErrorAccessPolicyDenied
+	}
+	// Apply the allowed user types override of the shared organization unit the login was routed to.
+	if shared := app.Sharing.FindByOUID(engineCtx.RuntimeData[common.RuntimeKeySharedOUID]); shared != nil &&
+		len(shared.AllowedUserTypes) > 0 {
+		app.AllowedUserTypes = shared.AllowedUserTypes
+	}
 	engineCtx.Application = *app
 	return nil
 }
@@ -562,8 +641,9 @@ func (s *flowExecService) encryptEngineContext(ctx context.Context, engineCtx *E
 
 // getFlowGraph checks if the provided entity ID is valid and returns the associated flow ID.
 // Entity-agnostic: works for any entity (application, agent, ...) that has an inbound-client row.
+// Flow overrides of the shared organization unit identified by sharedOUID take precedence.
 func (s *flowExecService) getFlowGraph(ctx context.Context, appID string, flowType providers.FlowType,
-	logger *log.Logger) (string, *tidcommon.ServiceError) {
+	sharedOUID string, logger *log.Logger) (string, *tidcommon.ServiceError) {
 	// Handle app-independent system flows
 	if flowType == providers.FlowTypeUserOnboarding {
 		return s.getSystemFlowGraph(ctx, flowType, logger)
@@ -585,10 +665,13 @@ func (s *flowExecService) getFlowGraph(ctx context.Context, appID string, flowTy
 	if client == nil {
 		return "", &ErrorInvalidAppID
 	}
+	shared := client.Sharing.FindByOUID(sharedOUID)
 
 	if flowType == providers.FlowTypeRegistration {
 		if !client.IsRegistrationFlowEnabled {
 			return "", &ErrorRegistrationFlowDisabled
+		} else if shared != nil && shared.RegistrationFlowID != "" {
+			return shared.RegistrationFlowID, nil
 		} else if client.RegistrationFlowID == "" {
 			logger.Error(ctx, "Registration flow is not configured for the entity",
 				log.String("appID", appID))
@@ -609,6 +692,9 @@ func (s *flowExecService) getFlowGraph(ctx context.Context, appID string, flowTy
 	}
 
 	// Default to authentication flow ID
+	if shared != nil && shared.AuthFlowID != "" {
+		return shared.AuthFlowID, nil
+	}
 	if client.AuthFlowID == "" {
 		logger.Error(ctx, "Authentication flow is not configured for the entity",
 			log.String("appID", appID))
@@ -716,7 +802,8 @@ func (s *flowExecService) InitiateFlow(ctx context.Context,
 
 	// Initialize the engine context
 	// This uses verbose true to ensure step layouts are returned during execution
-	engineCtx, err := s.initContext(ctx, initContext.ApplicationID, flowType, true, logger)
+	engineCtx, err := s.initContext(ctx, initContext.ApplicationID, flowType, true,
+		initContext.RuntimeData[common.RuntimeKeySharedOUID], logger)
 	if err != nil {
 		logger.Error(ctx, "Failed to initialize flow context",
 			log.String("appID", initContext.ApplicationID),
@@ -766,7 +853,8 @@ func (s *flowExecService) InitiateAndExecute(ctx context.Context,
 		return nil, &ErrorInvalidFlowInitContext
 	}
 
-	engineCtx, err := s.initContext(ctx, initContext.ApplicationID, flowType, true, logger)
+	engineCtx, err := s.initContext(ctx, initContext.ApplicationID, flowType, true,
+		initContext.RuntimeData[common.RuntimeKeySharedOUID], logger)
 	if err != nil {
 		logger.Error(ctx, "Failed to initialize flow context",
 			log.String("appID", initContext.ApplicationID),
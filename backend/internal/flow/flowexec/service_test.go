@@ -51,6 +51,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/accesspolicymock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
@@ -2022,7 +2023,7 @@ func (s *ServiceTestSuite) TestGetFlowGraph_RegistrationAndRecovery() {
 				mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, lookupID).Return(tt.client, nil)
 			}
 
-			graphID, svcErr := service.getFlowGraph(context.Background(), lookupID, tt.flowType, log.GetLogger())
+			graphID, svcErr := service.getFlowGraph(context.Background(), lookupID, tt.flowType, "", log.GetLogger())
 
 			if tt.expectedCode != "" {
 				s.NotNil(svcErr)
@@ -2054,7 +2055,7 @@ func (s *ServiceTestSuite) TestGetFlowGraph_MissingConfiguredFlowID() {
 		}, nil)
 
 	graphID, svcErr := service.getFlowGraph(context.Background(), appID,
-		providers.FlowTypeRegistration, log.GetLogger())
+		providers.FlowTypeRegistration, "", log.GetLogger())
 
 	s.Empty(graphID)
 	s.NotNil(svcErr)
@@ -2074,13 +2075,51 @@ func (s *ServiceTestSuite) TestGetFlowGraph_NilClient() {
 		Return((*inboundmodel.InboundClient)(nil), nil)
 
 	graphID, svcErr := service.getFlowGraph(context.Background(), appID,
-		providers.FlowTypeAuthentication, log.GetLogger())
+		providers.FlowTypeAuthentication, "", log.GetLogger())
 
 	s.Empty(graphID)
 	s.NotNil(svcErr)
 	s.Equal(ErrorInvalidAppID.Code, svcErr.Code)
 }
 
+func (s *ServiceTestSuite) TestGetFlowGraph_SharedOUOverride() {
+	appID := "test-app-shared"
+	mockInboundClient := inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	mockEntityProvider := entityprovidermock.NewEntityProviderInterfaceMock(s.T())
+	service := &flowExecService{
+		actorProvider: actorprovider.Initialize(mockInboundClient, mockEntityProvider, noopAuthnMgr()),
+		cfg:           testFlowExecCfg,
+	}
+
+	mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, appID).Return(
+		&inboundmodel.InboundClient{
+			ID:                        appID,
+			AuthFlowID:                "app-auth-flow",
+			IsRegistrationFlowEnabled: true,
+			RegistrationFlowID:        "app-reg-flow",
+			Sharing: &providers.ApplicationSharing{
+				OrganizationUnits: []providers.SharedOrganizationUnit{
+					{OUID: "ou-1", AuthFlowID: "ou-auth-flow"},
+				},
+			},
+		}, nil)
+
+	graphID, svcErr := service.getFlowGraph(context.Background(), appID,
+		providers.FlowTypeAuthentication, "ou-1", log.GetLogger())
+	s.Nil(svcErr)
+	s.Equal("ou-auth-flow", graphID)
+
+	graphID, svcErr = service.getFlowGraph(context.Background(), appID,
+		providers.FlowTypeRegistration, "ou-1", log.GetLogger())
+	s.Nil(svcErr)
+	s.Equal("app-reg-flow", graphID)
+
+	graphID, svcErr = service.getFlowGraph(context.Background(), appID,
+		providers.FlowTypeAuthentication, "ou-unknown", log.GetLogger())
+	s.Nil(svcErr)
+	s.Equal("app-auth-flow", graphID)
+}
+
 func (s *ServiceTestSuite) TestExecute_NewFlow_IncompleteStoresContext() {
 	appID := "test-app-new-flow"
 
@@ -2329,6 +2368,39 @@ func (s *ServiceTestSuite) TestSetApplicationToContext_BuildApplicationError() {
 	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
 }
 
+func (s *ServiceTestSuite) TestSetApplicationToContext_AccessPolicy() {
+	policy := &providers.ApplicationAccessPolicy{DeniedCountries: []string{"GB"}}
+	for _, allowed := range []bool{true, false} {
+		mockProvider := actorprovidermock.NewActorProviderMock(s.T())
+		mockProvider.EXPECT().GetInboundClientByID(mock.Anything, "app-1").
+			Return(&inboundmodel.InboundClient{ID: "app-1", AccessPolicy: policy}, nil)
+		mockProvider.EXPECT().GetActor("app-1").Return(nil, &actorprovider.ErrorEntityNotFound)
+		mockAccessPolicy := accesspolicymock.NewAccessPolicyServiceInterfaceMock(s.T())
+		mockAccessPolicy.EXPECT().IsAllowed(mock.Anything, "app-1", policy).Return(allowed)
+
+		service := &flowExecService{
+			actorProvider:   mockProvider,
+			accessPolicySvc: mockAccessPolicy,
+			cfg:             testFlowExecCfg,
+		}
+		engineCtx := &EngineContext{
+			Context:  context.Background(),
+			AppID:    "app-1",
+			FlowType: providers.FlowTypeAuthentication,
+		}
+
+		svcErr := service.setApplicationToContext(engineCtx, log.GetLogger())
+
+		if allowed {
+			s.Nil(svcErr)
+			s.Equal(policy, engineCtx.Application.AccessPolicy)
+			continue
+		}
+		s.NotNil(svcErr)
+		s.Equal(ErrorAccessPolicyDenied.Code, svcErr.Code)
+	}
+}
+
 // --- checkDirectFlowInitiationAllowed ---
 
 // A new flow is rejected at initiation when the app is classified as RedirectOnly (an
@@ -2799,3 +2871,97 @@ func (s *ServiceTestSuite) TestLoadContextFromStore_GetFlowGraphError() {
 func noopAuthnMgr() *managermock.AuthnProviderManagerMock {
 	return &managermock.AuthnProviderManagerMock{}
 }
+
+func (s *ServiceTestSuite) TestGetExecutionTrace_Found() {
+	mockTraceStore := newFlowTraceStoreInterfaceMock(s.T())
+	trace := &FlowTrace{ExecutionID: "exec-1", Entries: []FlowTraceEntry{{Sequence: 1, NodeID: "n1"}}}
+	mockTraceStore.EXPECT().GetFlowTrace(mock.Anything, "exec-1").Return(trace, nil)
+	service := &flowExecService{traceStore: mockTraceStore, cfg: testFlowExecCfg}
+
+	got, svcErr := service.GetExecutionTrace(context.Background(), "exec-1")
+
+	s.Nil(svcErr)
+	s.Equal(trace, got)
+}
+
+func (s *ServiceTestSuite) TestGetExecutionTrace_NotFound() {
+	mockTraceStore := newFlowTraceStoreInterfaceMock(s.T())
+	mockTraceStore.EXPECT().GetFlowTrace(mock.Anything, "missing").Return(nil, nil)
+	service := &flowExecService{traceStore: mockTraceStore, cfg: testFlowExecCfg}
+
+	got, svcErr := service.GetExecutionTrace(context.Background(), "missing")
+
+	s.Nil(got)
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorExecutionTraceNotFound.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestGetExecutionTrace_EmptyExecutionID() {
+	service := &flowExecService{cfg: testFlowExecCfg}
+
+	got, svcErr := service.GetExecutionTrace(context.Background(), "")
+
+	s.Nil(got)
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestGetExecutionTrace_StoreError() {
+	mockTraceStore := newFlowTraceStoreInterfaceMock(s.T())
+	mockTraceStore.EXPECT().GetFlowTrace(mock.Anything, "exec-1").Return(nil, errors.New("store down"))
+	service := &flowExecService{traceStore: mockTraceStore, cfg: testFlowExecCfg}
+
+	got, svcErr := service.GetExecutionTrace(context.Background(), "exec-1")
+
+	s.Nil(got)
+	s.Require().NotNil(svcErr)
+	s.Equal(tidcommon.InternalServerError.Code, svcErr.Code)
+}
+
+func (s *ServiceTestSuite) TestStoreExecutionTrace_AppendsToExistingTrace() {
+	mockTraceStore := newFlowTraceStoreInterfaceMock(s.T())
+	existing := &FlowTrace{ExecutionID: "exec-1", Entries: []FlowTraceEntry{{Sequence: 1, NodeID: "n1"}}}
+	mockTraceStore.EXPECT().GetFlowTrace(mock.Anything, "exec-1").Return(existing, nil)
+	mockTraceStore.EXPECT().StoreFlowTrace(mock.Anything, mock.MatchedBy(func(trace FlowTrace) bool {
+		return len(trace.Entries) == 2 && trace.Entries[1].Sequence == 2 && trace.Entries[1].NodeID == "n2"
+	}), flowTraceExpiry).Return(nil)
+	service := &flowExecService{traceStore: mockTraceStore, cfg: testFlowExecCfg}
+	engineCtx := &EngineContext{
+		ExecutionID:  "exec-1",
+		Debug:        true,
+		traceEntries: []FlowTraceEntry{{NodeID: "n2"}},
+	}
+
+	service.storeExecutionTrace(context.Background(), engineCtx, log.GetLogger())
+
+	s.Nil(engineCtx.traceEntries)
+}
+
+func (s *ServiceTestSuite) TestStoreExecutionTrace_CreatesTrace() {
+	mockTraceStore := newFlowTraceStoreInterfaceMock(s.T())
+	mockTraceStore.EXPECT().GetFlowTrace(mock.Anything, "exec-1").Return(nil, nil)
+	mockTraceStore.EXPECT().StoreFlowTrace(mock.Anything, mock.MatchedBy(func(trace FlowTrace) bool {
+		return trace.ExecutionID == "exec-1" && trace.AppID == "app-1" &&
+			trace.FlowType == string(providers.FlowTypeAuthentication) && len(trace.Entries) == 1
+	}), flowTraceExpiry).Return(errors.New("store down"))
+	service := &flowExecService{traceStore: mockTraceStore, cfg: testFlowExecCfg}
+	engineCtx := &EngineContext{
+		ExecutionID:  "exec-1",
+		AppID:        "app-1",
+		FlowType:     providers.FlowTypeAuthentication,
+		Debug:        true,
+		traceEntries: []FlowTraceEntry{{NodeID: "n1"}},
+	}
+
+	// Store failures must not panic or surface to the caller.
+	service.storeExecutionTrace(context.Background(), engineCtx, log.GetLogger())
+}
+
+func (s *ServiceTestSuite) TestStoreExecutionTrace_SkipsWhenNotDebug() {
+	service := &flowExecService{cfg: testFlowExecCfg}
+	engineCtx := &EngineContext{ExecutionID: "exec-1", traceEntries: []FlowTraceEntry{{NodeID: "n1"}}}
+
+	service.storeExecutionTrace(context.Background(), engineCtx, log.GetLogger())
+
+	s.Len(engineCtx.traceEntries, 1)
+}
@@ -84,3 +84,47 @@ func (s *flowStore) UpdateFlowContext(ctx context.Context, dbModel FlowContextDB
 func (s *flowStore) DeleteFlowContext(ctx context.Context, executionID string) error {
 	return s.store.Delete(ctx, providers.NamespaceFlow, executionID)
 }
+
+// flowTraceStoreInterface defines the methods for flow debug trace storage operations.
+type flowTraceStoreInterface interface {
+	StoreFlowTrace(ctx context.Context, trace FlowTrace, expirySeconds int64) error
+	GetFlowTrace(ctx context.Context, executionID string) (*FlowTrace, error)
+}
+
+// flowTraceStore adapts a runtime store provider to flow debug trace storage. Traces are stored
+// under the flow trace namespace, keyed by execution ID, as a serialized FlowTrace.
+type flowTraceStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newFlowTraceStore creates a flow debug trace store backed by the given runtime store provider.
+func newFlowTraceStore(store providers.RuntimeStoreProvider) flowTraceStoreInterface {
+	return &flowTraceStore{store: store}
+}
+
+// StoreFlowTrace serializes and stores the flow trace with the given TTL in seconds, replacing any
+// trace previously stored for the execution.
+func (s *flowTraceStore) StoreFlowTrace(ctx context.Context, trace FlowTrace, expirySeconds int64) error {
+	data, err := json.Marshal(trace)
+	if err != nil {
+		return fmt.Errorf("failed to marshal flow trace: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceFlowTrace, trace.ExecutionID, data, expirySeconds)
+}
+
+// GetFlowTrace retrieves and deserializes the flow trace. Returns nil when not found or expired.
+func (s *flowTraceStore) GetFlowTrace(ctx context.Context, executionID string) (*FlowTrace, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceFlowTrace, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flow trace: %w", err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var trace FlowTrace
+	if err := json.Unmarshal(data, &trace); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal flow trace: %w", err)
+	}
+	return &trace, nil
+}
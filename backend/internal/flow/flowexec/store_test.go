@@ -92,3 +92,52 @@ func (s *FlowStoreTestSuite) TestDelete() {
 func (s *FlowStoreTestSuite) TestDelete_MissingIsIdempotent() {
 	s.NoError(s.store.DeleteFlowContext(s.ctx, "missing"))
 }
+
+// FlowTraceStoreTestSuite exercises the flowTraceStore adapter against a real in-memory runtime store.
+type FlowTraceStoreTestSuite struct {
+	suite.Suite
+	store flowTraceStoreInterface
+	ctx   context.Context
+}
+
+func TestFlowTraceStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowTraceStoreTestSuite))
+}
+
+func (s *FlowTraceStoreTestSuite) SetupTest() {
+	s.store = newFlowTraceStore(inmemory.Initialize("test-deployment"))
+	s.ctx = context.Background()
+}
+
+func (s *FlowTraceStoreTestSuite) TestStoreAndGet() {
+	trace := FlowTrace{
+		ExecutionID: "exec-1",
+		FlowType:    "AUTHENTICATION",
+		Entries:     []FlowTraceEntry{{Sequence: 1, NodeID: "n1"}},
+	}
+	s.Require().NoError(s.store.StoreFlowTrace(s.ctx, trace, 60))
+
+	got, err := s.store.GetFlowTrace(s.ctx, "exec-1")
+	s.Require().NoError(err)
+	s.Require().NotNil(got)
+	s.Equal("AUTHENTICATION", got.FlowType)
+	s.Require().Len(got.Entries, 1)
+	s.Equal("n1", got.Entries[0].NodeID)
+}
+
+func (s *FlowTraceStoreTestSuite) TestStore_ReplacesExistingTrace() {
+	s.Require().NoError(s.store.StoreFlowTrace(s.ctx, FlowTrace{ExecutionID: "exec-2"}, 60))
+	s.Require().NoError(s.store.StoreFlowTrace(s.ctx,
+		FlowTrace{ExecutionID: "exec-2", Entries: []FlowTraceEntry{{Sequence: 1}}}, 60))
+
+	got, err := s.store.GetFlowTrace(s.ctx, "exec-2")
+	s.Require().NoError(err)
+	s.Require().NotNil(got)
+	s.Len(got.Entries, 1)
+}
+
+func (s *FlowTraceStoreTestSuite) TestGet_NotFound_ReturnsNil() {
+	got, err := s.store.GetFlowTrace(s.ctx, "missing")
+	s.Require().NoError(err)
+	s.Nil(got)
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// sensitiveTraceKeyFragments lists fragments of data keys whose values are redacted from trace
+// snapshots regardless of the input definitions of the node.
+var sensitiveTraceKeyFragments = []string{
+	"password", "passcode", "secret", "token", "otp", "credential", "assertion", "privatekey", "apikey",
+}
+
+// sensitiveTraceKeys lists data keys whose values are redacted from trace snapshots when they match
+// exactly.
+var sensitiveTraceKeys = []string{"code", "pin"}
+
+// FlowTrace holds the debug trace recorded for a flow execution.
+type FlowTrace struct {
+	ExecutionID string           `json:"executionId"`
+	FlowType    string           `json:"flowType"`
+	AppID       string           `json:"appId,omitempty"`
+	Entries     []FlowTraceEntry `json:"entries"`
+}
+
+// FlowTraceEntry records a single node execution along with the node context before and after it.
+type FlowTraceEntry struct {
+	Sequence  int                  `json:"sequence"`
+	NodeID    string               `json:"nodeId"`
+	NodeType  string               `json:"nodeType"`
+	StartedAt int64                `json:"startedAt"`
+	EndedAt   int64                `json:"endedAt"`
+	Status    string               `json:"status,omitempty"`
+	Error     string               `json:"error,omitempty"`
+	Before    NodeContextSnapshot  `json:"before"`
+	After     *NodeContextSnapshot `json:"after,omitempty"`
+}
+
+// NodeContextSnapshot is a redacted copy of the node context at a point in time.
+type NodeContextSnapshot struct {
+	CurrentAction   string                 `json:"currentAction,omitempty"`
+	UserInputs      map[string]string      `json:"userInputs,omitempty"`
+	RuntimeData     map[string]string      `json:"runtimeData,omitempty"`
+	ForwardedData   map[string]interface{} `json:"forwardedData,omitempty"`
+	IsAuthenticated bool                   `json:"isAuthenticated"`
+}
+
+// debugTraceContextKey is the context key that marks a flow execution request as debug traced.
+type debugTraceContextKey struct{}
+
+// withDebugTrace returns a context that requests debug tracing for the flow execution.
+func withDebugTrace(ctx context.Context) context.Context {
+	return context.WithValue(ctx, debugTraceContextKey{}, true)
+}
+
+// isDebugTraceRequested reports whether debug tracing is requested for the flow execution.
+func isDebugTraceRequested(ctx context.Context) bool {
+	requested, _ := ctx.Value(debugTraceContextKey{}).(bool)
+	return requested
+}
+
+// snapshotNodeContext captures a redacted copy of the given node context. Values of inputs the node
+// marks as sensitive, and of keys that look like secrets, are replaced with a redaction marker.
+func snapshotNodeContext(nodeCtx *providers.NodeContext, sensitiveKeys map[string]struct{}) NodeContextSnapshot {
+	snapshot := NodeContextSnapshot{
+		CurrentAction:   nodeCtx.CurrentAction,
+		UserInputs:      redactStringMap(nodeCtx.UserInputs, sensitiveKeys),
+		RuntimeData:     redactStringMap(nodeCtx.RuntimeData, sensitiveKeys),
+		IsAuthenticated: nodeCtx.AuthUser.IsAuthenticated(),
+	}
+	if len(nodeCtx.ForwardedData) > 0 {
+		snapshot.ForwardedData = make(map[string]interface{}, len(nodeCtx.ForwardedData))
+		for key, value := range nodeCtx.ForwardedData {
+			if isSensitiveTraceKey(key, sensitiveKeys) {
+				snapshot.ForwardedData[key] = redactedTraceValue
+				continue
+			}
+			snapshot.ForwardedData[key] = value
+		}
+	}
+	return snapshot
+}
+
+// snapshotNodeResponse captures a redacted copy of the node context after the node executed, with
+// the runtime and forwarded data the node returned applied on top of it.
+func snapshotNodeResponse(nodeCtx *providers.NodeContext, nodeResp *common.NodeResponse,
+	sensitiveKeys map[string]struct{}) NodeContextSnapshot {
+	after := *nodeCtx
+	if nodeResp != nil {
+		if len(nodeResp.RuntimeData) > 0 {
+			after.RuntimeData = make(map[string]string, len(nodeCtx.RuntimeData)+len(nodeResp.RuntimeData))
+			for key, value := range nodeCtx.RuntimeData {
+				after.RuntimeData[key] = value
+			}
+			for key, value := range nodeResp.RuntimeData {
+				after.RuntimeData[key] = value
+			}
+		}
+		after.ForwardedData = nodeResp.ForwardedData
+		if nodeResp.AuthUser.IsAuthenticated() {
+			after.AuthUser = nodeResp.AuthUser
+		}
+	}
+	return snapshotNodeContext(&after, sensitiveKeys)
+}
+
+// getSensitiveInputKeys returns the identifiers of the inputs the node or its executor marks as
+// sensitive.
+func getSensitiveInputKeys(node core.NodeInterface) map[string]struct{} {
+	inputs := getNodeInputs(node)
+	if execNode, ok := node.(core.ExecutorBackedNodeInterface); ok {
+		if executor := execNode.GetExecutor(); executor != nil {
+			inputs = append(inputs, executor.GetDefaultInputs()...)
+		}
+	}
+
+	keys := make(map[string]struct{})
+	for _, input := range inputs {
+		if input.IsSensitive() {
+			keys[input.Identifier] = struct{}{}
+		}
+	}
+	return keys
+}
+
+// isSensitiveTraceKey reports whether the value of the given key must be redacted from a snapshot.
+func isSensitiveTraceKey(key string, sensitiveKeys map[string]struct{}) bool {
+	if _, ok := sensitiveKeys[key]; ok {
+		return true
+	}
+	normalized := strings.NewReplacer("_", "", "-", "", ".", "").Replace(strings.ToLower(key))
+	for _, exact := range sensitiveTraceKeys {
+		if normalized == exact {
+			return true
+		}
+	}
+	for _, fragment := range sensitiveTraceKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactStringMap returns a copy of the given map with sensitive values redacted.
+func redactStringMap(data map[string]string, sensitiveKeys map[string]struct{}) map[string]string {
+	if len(data) == 0 {
+		return nil
+	}
+	redacted := make(map[string]string, len(data))
+	for key, value := range data {
+		if isSensitiveTraceKey(key, sensitiveKeys) {
+			redacted[key] = redactedTraceValue
+			continue
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
+
+// recordTraceEntry appends a trace entry for the executed node to the engine context.
+func recordTraceEntry(ctx *EngineContext, node core.NodeInterface, before, after NodeContextSnapshot,
+	nodeResp *common.NodeResponse, nodeErr *tidcommon.ServiceError, startedAt, endedAt int64) {
+	entry := FlowTraceEntry{
+		NodeID:    node.GetID(),
+		NodeType:  string(node.GetType()),
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+		Before:    before,
+		After:     &after,
+	}
+	if nodeResp != nil {
+		entry.Status = string(nodeResp.Status)
+	}
+	if nodeErr != nil {
+		entry.Error = nodeErr.Code + ": " + nodeErr.Error.DefaultValue
+	}
+	ctx.traceEntries = append(ctx.traceEntries, entry)
+}
+
+// mergeFlowTrace appends the given entries to the trace, numbering them in execution order and
+// keeping only the most recent entries once the trace reaches its size limit.
+func mergeFlowTrace(trace *FlowTrace, entries []FlowTraceEntry) {
+	next := 1
+	if len(trace.Entries) > 0 {
+		next = trace.Entries[len(trace.Entries)-1].Sequence + 1
+	}
+	for _, entry := range entries {
+		entry.Sequence = next
+		next++
+		trace.Entries = append(trace.Entries, entry)
+	}
+	if len(trace.Entries) > maxFlowTraceEntries {
+		trace.Entries = trace.Entries[len(trace.Entries)-maxFlowTraceEntries:]
+	}
+}
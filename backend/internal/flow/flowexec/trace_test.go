@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type TraceTestSuite struct {
+	suite.Suite
+}
+
+func TestTraceTestSuite(t *testing.T) {
+	suite.Run(t, new(TraceTestSuite))
+}
+
+func (s *TraceTestSuite) TestDebugTraceContext() {
+	s.False(isDebugTraceRequested(context.Background()))
+	s.True(isDebugTraceRequested(withDebugTrace(context.Background())))
+}
+
+func (s *TraceTestSuite) TestSnapshotNodeContext_RedactsSensitiveValues() {
+	nodeCtx := &providers.NodeContext{
+		CurrentAction: "submit",
+		UserInputs: map[string]string{
+			"username": "alice",
+			"pinValue": "1234",
+			"code":     "auth-code",
+			"api_key":  "key",
+		},
+		RuntimeData:   map[string]string{"client_secret": "s3cr3t", "userID": "user-1"},
+		ForwardedData: map[string]interface{}{"accessToken": "tok", "flowHint": "login"},
+	}
+
+	snapshot := snapshotNodeContext(nodeCtx, map[string]struct{}{"pinValue": {}})
+
+	s.Equal("submit", snapshot.CurrentAction)
+	s.Equal("alice", snapshot.UserInputs["username"])
+	s.Equal(redactedTraceValue, snapshot.UserInputs["pinValue"])
+	s.Equal(redactedTraceValue, snapshot.UserInputs["code"])
+	s.Equal(redactedTraceValue, snapshot.UserInputs["api_key"])
+	s.Equal(redactedTraceValue, snapshot.RuntimeData["client_secret"])
+	s.Equal("user-1", snapshot.RuntimeData["userID"])
+	s.Equal(redactedTraceValue, snapshot.ForwardedData["accessToken"])
+	s.Equal("login", snapshot.ForwardedData["flowHint"])
+	s.False(snapshot.IsAuthenticated)
+
+	// The snapshot must not share maps with the node context.
+	s.Equal("1234", nodeCtx.UserInputs["pinValue"])
+}
+
+func (s *TraceTestSuite) TestSnapshotNodeContext_EmptyContext() {
+	snapshot := snapshotNodeContext(&providers.NodeContext{}, nil)
+
+	s.Nil(snapshot.UserInputs)
+	s.Nil(snapshot.RuntimeData)
+	s.Nil(snapshot.ForwardedData)
+}
+
+func (s *TraceTestSuite) TestMergeFlowTrace_NumbersEntriesInOrder() {
+	trace := &FlowTrace{ExecutionID: "exec-1"}
+
+	mergeFlowTrace(trace, []FlowTraceEntry{{NodeID: "a"}, {NodeID: "b"}})
+	mergeFlowTrace(trace, []FlowTraceEntry{{NodeID: "c"}})
+
+	s.Require().Len(trace.Entries, 3)
+	s.Equal(1, trace.Entries[0].Sequence)
+	s.Equal(3, trace.Entries[2].Sequence)
+	s.Equal("c", trace.Entries[2].NodeID)
+}
+
+func (s *TraceTestSuite) TestMergeFlowTrace_KeepsMostRecentEntries() {
+	trace := &FlowTrace{ExecutionID: "exec-1"}
+	entries := make([]FlowTraceEntry, maxFlowTraceEntries+5)
+
+	mergeFlowTrace(trace, entries)
+
+	s.Len(trace.Entries, maxFlowTraceEntries)
+	s.Equal(6, trace.Entries[0].Sequence)
+	s.Equal(maxFlowTraceEntries+5, trace.Entries[maxFlowTraceEntries-1].Sequence)
+}
@@ -22,7 +22,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -31,6 +33,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
+	"github.com/thunder-id/thunderid/internal/system/circuitbreaker"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
@@ -181,7 +184,9 @@ func (b *graphBuilder) processNode(
 		nodeDef.OnFailure == "" &&
 		len(nodeDef.Prompts) == 0 &&
 		nodeDef.Next == "" &&
-		nodeDef.Flow == nil
+		nodeDef.Flow == nil &&
+		nodeDef.Decision == nil &&
+		nodeDef.Parallel == nil
 
 	// TODO: Temporarily add the call node validation here.
 	// Should be moved to flow validator once implemented.
@@ -217,6 +222,15 @@ func (b *graphBuilder) processNode(
 		return err
 	}
 	b.configureCallNodeReference(nodeDef, node)
+	if err := b.configureDecisionNode(nodeDef, node, edges); err != nil {
+		return err
+	}
+	if err := b.configureParallelNode(nodeDef, node, edges); err != nil {
+		return err
+	}
+	if err := b.configureJoinNode(nodeDef, allNodes, node); err != nil {
+		return err
+	}
 
 	// Add node to the graph
 	if err := graph.AddNode(node); err != nil {
@@ -226,7 +240,8 @@ func (b *graphBuilder) processNode(
 	return nil
 }
 
-// configureNodeNavigation configures the onSuccess and onFailure properties for a node.
+// configureNodeNavigation configures the onSuccess, onFailure, onIncomplete and onCircuitOpen properties
+// for a node.
 func (b *graphBuilder) configureNodeNavigation(nodeDef *providers.NodeDefinition, allNodes []providers.NodeDefinition,
 	node core.NodeInterface, edges map[string][]string) error {
 	// Set onSuccess if defined
@@ -244,8 +259,8 @@ func (b *graphBuilder) configureNodeNavigation(nodeDef *providers.NodeDefinition
 
 	// Set onFailure if defined
 	if nodeDef.OnFailure != "" {
-		// CALL nodes may route onFailure to any node type, not just PROMPT.
-		if nodeDef.Type != string(common.NodeTypeCall) {
+		// CALL and JOIN nodes may route onFailure to any node type, not just PROMPT.
+		if nodeDef.Type != string(common.NodeTypeCall) && nodeDef.Type != string(common.NodeTypeJoin) {
 			if err := b.validateOnFailureTarget(allNodes, nodeDef.OnFailure); err != nil {
 				return fmt.Errorf("invalid onFailure configuration for node %s: %w", nodeDef.ID, err)
 			}
@@ -256,6 +271,9 @@ func (b *graphBuilder) configureNodeNavigation(nodeDef *providers.NodeDefinition
 		if callNode, ok := node.(core.CallNodeInterface); ok {
 			callNode.SetOnFailure(nodeDef.OnFailure)
 		}
+		if joinNode, ok := node.(core.JoinNodeInterface); ok {
+			joinNode.SetOnFailure(nodeDef.OnFailure)
+		}
 
 		// Add edge for graph structure
 		if _, exists := edges[nodeDef.ID]; !exists {
@@ -280,6 +298,24 @@ func (b *graphBuilder) configureNodeNavigation(nodeDef *providers.NodeDefinition
 		edges[nodeDef.ID] = append(edges[nodeDef.ID], nodeDef.OnIncomplete)
 	}
 
+	// Set onCircuitOpen if defined. The fallback branch may start with any node type.
+	if nodeDef.OnCircuitOpen != "" {
+		taskNode, ok := node.(core.ExecutorBackedNodeInterface)
+		if !ok {
+			return fmt.Errorf("node %s: 'onCircuitOpen' is only allowed on TASK_EXECUTION nodes", nodeDef.ID)
+		}
+		if !nodeExists(allNodes, nodeDef.OnCircuitOpen) {
+			return fmt.Errorf("invalid onCircuitOpen configuration for node %s: target node not found", nodeDef.ID)
+		}
+		taskNode.SetOnCircuitOpen(nodeDef.OnCircuitOpen)
+
+		// Add edge for graph structure
+		if _, exists := edges[nodeDef.ID]; !exists {
+			edges[nodeDef.ID] = []string{}
+		}
+		edges[nodeDef.ID] = append(edges[nodeDef.ID], nodeDef.OnCircuitOpen)
+	}
+
 	return nil
 }
 
@@ -309,10 +345,23 @@ func (b *graphBuilder) validateOnIncompleteTarget(nodes []providers.NodeDefiniti
 	return errors.New("onIncomplete target node not found")
 }
 
+// nodeExists reports whether a node with the given ID is defined.
+func nodeExists(nodes []providers.NodeDefinition, nodeID string) bool {
+	for _, node := range nodes {
+		if node.ID == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
 // validateCallNodeDefinition validates the constraints specific to CALL nodes.
 func (b *graphBuilder) validateCallNodeDefinition(nodeDef *providers.NodeDefinition) error {
-	if nodeDef.Flow == nil || nodeDef.Flow.Ref == "" {
-		return fmt.Errorf("CALL node %s: 'flow.ref' is required", nodeDef.ID)
+	if nodeDef.Flow == nil || (nodeDef.Flow.Ref == "" && nodeDef.Flow.Handle == "") {
+		return fmt.Errorf("CALL node %s: 'flow.ref' or 'flow.handle' is required", nodeDef.ID)
+	}
+	if nodeDef.Flow.Ref != "" && nodeDef.Flow.Handle != "" {
+		return fmt.Errorf("CALL node %s: only one of 'flow.ref' and 'flow.handle' is allowed", nodeDef.ID)
 	}
 	if nodeDef.OnSuccess == "" {
 		return fmt.Errorf("CALL node %s: 'onSuccess' is required", nodeDef.ID)
@@ -329,16 +378,130 @@ func (b *graphBuilder) validateCallNodeDefinition(nodeDef *providers.NodeDefinit
 	return nil
 }
 
-// configureCallNodeReference sets the referenced flow ID on CALL nodes.
+// configureCallNodeReference sets the referenced flow ID or handle and the returned outputs on CALL nodes.
 func (b *graphBuilder) configureCallNodeReference(nodeDef *providers.NodeDefinition, node core.NodeInterface) {
-	if nodeDef.Flow == nil || nodeDef.Flow.Ref == "" {
+	if nodeDef.Flow == nil || (nodeDef.Flow.Ref == "" && nodeDef.Flow.Handle == "") {
 		return
 	}
 	if callNode, ok := node.(core.CallNodeInterface); ok {
-		callNode.SetReferencedFlow(nodeDef.Flow.Ref)
+		if nodeDef.Flow.Ref != "" {
+			callNode.SetReferencedFlow(nodeDef.Flow.Ref)
+		} else {
+			callNode.SetReferencedFlowHandle(nodeDef.Flow.Handle)
+		}
+		if len(nodeDef.Flow.Outputs) > 0 {
+			callNode.SetOutputs(nodeDef.Flow.Outputs)
+		}
 	}
 }
 
+// configureDecisionNode sets the branches and the default next node on DECISION nodes and adds an
+// edge for every node they can route to.
+func (b *graphBuilder) configureDecisionNode(nodeDef *providers.NodeDefinition, node core.NodeInterface,
+	edges map[string][]string) error {
+	decisionNode, ok := node.(core.DecisionNodeInterface)
+	if !ok {
+		if nodeDef.Decision != nil {
+			return fmt.Errorf("'decision' field is only valid on DECISION nodes, but node %s is of type %s",
+				nodeDef.ID, nodeDef.Type)
+		}
+		return nil
+	}
+	if nodeDef.Decision == nil || nodeDef.Decision.Default == "" {
+		return fmt.Errorf("DECISION node %s: 'decision.default' is required", nodeDef.ID)
+	}
+
+	branches := make([]core.DecisionBranch, 0, len(nodeDef.Decision.Branches))
+	targets := make([]string, 0, len(nodeDef.Decision.Branches)+1)
+	for _, branchDef := range nodeDef.Decision.Branches {
+		conditions := make([]core.DecisionCondition, 0, len(branchDef.Conditions))
+		for _, conditionDef := range branchDef.Conditions {
+			conditions = append(conditions, core.DecisionCondition{
+				Key:      conditionDef.Key,
+				Operator: conditionDef.Operator,
+				Value:    conditionDef.Value,
+			})
+		}
+		branches = append(branches, core.DecisionBranch{Conditions: conditions, Next: branchDef.Next})
+		targets = append(targets, branchDef.Next)
+	}
+	decisionNode.SetBranches(branches)
+	decisionNode.SetDefaultNext(nodeDef.Decision.Default)
+	targets = append(targets, nodeDef.Decision.Default)
+
+	for _, target := range targets {
+		if target == "" || slices.Contains(edges[nodeDef.ID], target) {
+			continue
+		}
+		edges[nodeDef.ID] = append(edges[nodeDef.ID], target)
+	}
+	return nil
+}
+
+// configureParallelNode sets the branches and the join node on PARALLEL nodes and adds an edge for
+// every branch and for the join node.
+func (b *graphBuilder) configureParallelNode(nodeDef *providers.NodeDefinition, node core.NodeInterface,
+	edges map[string][]string) error {
+	parallelNode, ok := node.(core.ParallelNodeInterface)
+	if !ok {
+		if nodeDef.Parallel != nil {
+			return fmt.Errorf("'parallel' field is only valid on PARALLEL nodes, but node %s is of type %s",
+				nodeDef.ID, nodeDef.Type)
+		}
+		return nil
+	}
+	if nodeDef.Parallel == nil || len(nodeDef.Parallel.Branches) == 0 {
+		return fmt.Errorf("PARALLEL node %s: 'parallel.branches' is required", nodeDef.ID)
+	}
+	if nodeDef.Parallel.Join == "" {
+		return fmt.Errorf("PARALLEL node %s: 'parallel.join' is required", nodeDef.ID)
+	}
+
+	parallelNode.SetBranches(append([]string{}, nodeDef.Parallel.Branches...))
+	parallelNode.SetJoinNode(nodeDef.Parallel.Join)
+
+	targets := make([]string, 0, len(nodeDef.Parallel.Branches)+1)
+	targets = append(targets, nodeDef.Parallel.Branches...)
+	targets = append(targets, nodeDef.Parallel.Join)
+	for _, target := range targets {
+		if target == "" || slices.Contains(edges[nodeDef.ID], target) {
+			continue
+		}
+		edges[nodeDef.ID] = append(edges[nodeDef.ID], target)
+	}
+	return nil
+}
+
+// configureJoinNode sets the join mode on JOIN nodes and the branches of every PARALLEL node that
+// converges at them.
+func (b *graphBuilder) configureJoinNode(nodeDef *providers.NodeDefinition, allNodes []providers.NodeDefinition,
+	node core.NodeInterface) error {
+	joinNode, ok := node.(core.JoinNodeInterface)
+	if !ok {
+		if nodeDef.Join != nil {
+			return fmt.Errorf("'join' field is only valid on JOIN nodes, but node %s is of type %s",
+				nodeDef.ID, nodeDef.Type)
+		}
+		return nil
+	}
+	if nodeDef.OnSuccess == "" {
+		return fmt.Errorf("JOIN node %s: 'onSuccess' is required", nodeDef.ID)
+	}
+
+	if nodeDef.Join != nil {
+		joinNode.SetMode(nodeDef.Join.Mode)
+	}
+
+	branches := make([]string, 0)
+	for _, candidate := range allNodes {
+		if candidate.Parallel != nil && candidate.Parallel.Join == nodeDef.ID {
+			branches = append(branches, candidate.Parallel.Branches...)
+		}
+	}
+	joinNode.SetBranches(branches)
+	return nil
+}
+
 // configureNodeInputs configures the inputs for executor-backed nodes.
 // Validation rules on executor inputs are intentionally not propagated:
 // executor inputs are read from runtime context (already validated at the
@@ -591,6 +754,30 @@ func (b *graphBuilder) configureNodeExecutor(
 		}
 	}
 
+	if nodeDef.Executor.RetryCount < 0 || nodeDef.Executor.RetryDelay < 0 || nodeDef.Executor.Timeout < 0 {
+		return fmt.Errorf("executor retryCount, retryDelay and timeout must not be negative")
+	}
+	var breakerSettings *circuitbreaker.Settings
+	if cbDef := nodeDef.Executor.CircuitBreaker; cbDef != nil {
+		if cbDef.FailureThreshold < 0 || cbDef.OpenDuration < 0 {
+			return fmt.Errorf("executor circuitBreaker failureThreshold and openDuration must not be negative")
+		}
+		breakerSettings = &circuitbreaker.Settings{
+			FailureThreshold: cbDef.FailureThreshold,
+			OpenDuration:     time.Duration(cbDef.OpenDuration) * time.Millisecond,
+		}
+	} else if nodeDef.OnCircuitOpen != "" {
+		return fmt.Errorf("node %s: 'onCircuitOpen' requires an executor circuitBreaker", nodeDef.ID)
+	}
+	if nodeDef.Executor.RetryCount > 0 || nodeDef.Executor.Timeout > 0 || breakerSettings != nil {
+		executableNode.SetTaskExecutionPolicy(core.TaskExecutionPolicy{
+			RetryCount:     nodeDef.Executor.RetryCount,
+			RetryDelay:     time.Duration(nodeDef.Executor.RetryDelay) * time.Millisecond,
+			Timeout:        time.Duration(nodeDef.Executor.Timeout) * time.Millisecond,
+			CircuitBreaker: breakerSettings,
+		})
+	}
+
 	return nil
 }
 
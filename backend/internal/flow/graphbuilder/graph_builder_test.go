@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
+	"github.com/thunder-id/thunderid/internal/system/circuitbreaker"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
@@ -1161,6 +1163,133 @@ func (s *GraphBuilderTestSuite) TestConfigureNodeExecutor_WithModeSuccess() {
 	s.Nil(err)
 }
 
+func (s *GraphBuilderTestSuite) TestConfigureNodeExecutor_WithTaskExecutionPolicy() {
+	nodeDef := &providers.NodeDefinition{
+		ID:   "task",
+		Type: "TASK_EXECUTION",
+		Executor: &providers.ExecutorDefinition{
+			Name:       "test-executor",
+			RetryCount: 3,
+			RetryDelay: 500,
+			Timeout:    10000,
+		},
+	}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	mockTaskNode.EXPECT().SetExecutorName("test-executor")
+	mockTaskNode.EXPECT().SetTaskExecutionPolicy(core.TaskExecutionPolicy{
+		RetryCount: 3,
+		RetryDelay: 500 * time.Millisecond,
+		Timeout:    10 * time.Second,
+	})
+
+	err := s.builder.configureNodeExecutor(context.Background(), nodeDef, mockTaskNode)
+
+	s.Nil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeExecutor_WithCircuitBreaker() {
+	nodeDef := &providers.NodeDefinition{
+		ID:   "task",
+		Type: "TASK_EXECUTION",
+		Executor: &providers.ExecutorDefinition{
+			Name:           "test-executor",
+			CircuitBreaker: &providers.CircuitBreakerDefinition{FailureThreshold: 3, OpenDuration: 60000},
+		},
+		OnCircuitOpen: "fallback",
+	}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	mockTaskNode.EXPECT().SetExecutorName("test-executor")
+	mockTaskNode.EXPECT().SetTaskExecutionPolicy(core.TaskExecutionPolicy{
+		CircuitBreaker: &circuitbreaker.Settings{FailureThreshold: 3, OpenDuration: time.Minute},
+	})
+
+	err := s.builder.configureNodeExecutor(context.Background(), nodeDef, mockTaskNode)
+
+	s.Nil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeExecutor_OnCircuitOpenWithoutCircuitBreaker() {
+	nodeDef := &providers.NodeDefinition{
+		ID:            "task",
+		Type:          "TASK_EXECUTION",
+		Executor:      &providers.ExecutorDefinition{Name: "test-executor"},
+		OnCircuitOpen: "fallback",
+	}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	mockTaskNode.EXPECT().SetExecutorName("test-executor")
+
+	err := s.builder.configureNodeExecutor(context.Background(), nodeDef, mockTaskNode)
+
+	s.Error(err)
+	s.Contains(err.Error(), "requires an executor circuitBreaker")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeNavigation_OnCircuitOpen() {
+	nodeDef := &providers.NodeDefinition{ID: "task", Type: "TASK_EXECUTION", OnCircuitOpen: "fallback"}
+	allNodes := []providers.NodeDefinition{*nodeDef, {ID: "fallback", Type: "TASK_EXECUTION"}}
+	edges := map[string][]string{}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+	mockTaskNode.EXPECT().SetOnCircuitOpen("fallback")
+
+	err := s.builder.configureNodeNavigation(nodeDef, allNodes, mockTaskNode, edges)
+
+	s.Nil(err)
+	s.Equal([]string{"fallback"}, edges["task"])
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeNavigation_OnCircuitOpenTargetNotFound() {
+	nodeDef := &providers.NodeDefinition{ID: "task", Type: "TASK_EXECUTION", OnCircuitOpen: "non-existent"}
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	err := s.builder.configureNodeNavigation(nodeDef, []providers.NodeDefinition{*nodeDef}, mockTaskNode,
+		map[string][]string{})
+
+	s.Error(err)
+	s.Contains(err.Error(), "target node not found")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeNavigation_OnCircuitOpenOnNonTaskNode() {
+	nodeDef := &providers.NodeDefinition{ID: "prompt", Type: "PROMPT", OnCircuitOpen: "fallback"}
+	allNodes := []providers.NodeDefinition{*nodeDef, {ID: "fallback", Type: "PROMPT"}}
+	mockPromptNode := coremock.NewPromptNodeInterfaceMock(s.T())
+
+	err := s.builder.configureNodeNavigation(nodeDef, allNodes, mockPromptNode, map[string][]string{})
+
+	s.Error(err)
+	s.Contains(err.Error(), "only allowed on TASK_EXECUTION nodes")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeExecutor_NegativeTaskExecutionPolicy() {
+	nodeDef := &providers.NodeDefinition{
+		ID:   "task",
+		Type: "TASK_EXECUTION",
+		Executor: &providers.ExecutorDefinition{
+			Name:       "test-executor",
+			RetryCount: -1,
+		},
+	}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	mockTaskNode.EXPECT().SetExecutorName("test-executor")
+
+	err := s.builder.configureNodeExecutor(context.Background(), nodeDef, mockTaskNode)
+
+	s.Error(err)
+	s.Contains(err.Error(), "must not be negative")
+}
+
 func (s *GraphBuilderTestSuite) TestConfigureNodeExecutor_WithoutModeSuccess() {
 	nodeDef := &providers.NodeDefinition{
 		ID:   "task",
@@ -1868,6 +1997,92 @@ func (s *GraphBuilderTestSuite) TestBuildGraph_CallNode_ValidDefinition() {
 	s.Nil(err)
 }
 
+func (s *GraphBuilderTestSuite) TestBuildGraph_CallNode_FlowHandleAndOutputs() {
+	flow := &providers.CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{ID: "start", Type: "START", OnSuccess: "call-1"},
+			{
+				ID:   "call-1",
+				Type: "CALL",
+				Flow: &providers.FlowReferenceDefinition{
+					Handle: "mfa-subflow", Outputs: []string{"mfaMethod"}},
+				OnSuccess: "end",
+			},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	mockStartNode := coremock.NewRepresentationNodeInterfaceMock(s.T())
+	mockCallNode := coremock.NewCallNodeInterfaceMock(s.T())
+	mockEndNode := coremock.NewRepresentationNodeInterfaceMock(s.T())
+
+	s.mockFlowFactory.EXPECT().CreateGraph(
+		"flow-1", providers.FlowTypeAuthentication, 0).Return(mockGraph)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"start", "START", map[string]interface{}(nil), false, false).Return(mockStartNode, nil)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"call-1", "CALL", map[string]interface{}(nil), false, false).Return(mockCallNode, nil)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"end", "END", map[string]interface{}(nil), false, true).Return(mockEndNode, nil)
+
+	mockStartNode.EXPECT().SetOnSuccess("call-1")
+	mockCallNode.EXPECT().SetOnSuccess("end")
+	mockCallNode.EXPECT().SetReferencedFlowHandle("mfa-subflow")
+	mockCallNode.EXPECT().SetOutputs([]string{"mfaMethod"})
+	mockCallNode.EXPECT().GetType().Return(common.NodeTypeCall).Maybe()
+
+	mockGraph.EXPECT().AddNode(mockStartNode).Return(nil)
+	mockGraph.EXPECT().AddNode(mockCallNode).Return(nil)
+	mockGraph.EXPECT().AddNode(mockEndNode).Return(nil)
+	mockGraph.EXPECT().AddEdge("start", "call-1").Return(nil)
+	mockGraph.EXPECT().AddEdge("call-1", "end").Return(nil)
+	mockGraph.EXPECT().GetNodes().Return(
+		map[string]core.NodeInterface{"start": mockStartNode, "call-1": mockCallNode, "end": mockEndNode})
+	mockStartNode.EXPECT().GetType().Return(common.NodeTypeStart)
+	mockEndNode.EXPECT().GetType().Return(common.NodeTypeEnd).Maybe()
+	mockStartNode.EXPECT().GetID().Return("start")
+	mockGraph.EXPECT().SetStartNode("start").Return(nil)
+	mockGraph.EXPECT().SetInterceptors(mock.Anything)
+
+	graph, err := s.builder.buildGraph(context.Background(), flow)
+
+	s.NotNil(graph)
+	s.Nil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_CallNode_RefAndHandle() {
+	flow := &providers.CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes: []providers.NodeDefinition{
+			{
+				ID:        "call-1",
+				Type:      "CALL",
+				Flow:      &providers.FlowReferenceDefinition{Ref: "target-flow", Handle: "mfa-subflow"},
+				OnSuccess: "end",
+			},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	s.mockFlowFactory.EXPECT().CreateGraph(
+		"flow-1", providers.FlowTypeAuthentication, 0).Return(mockGraph)
+
+	graph, err := s.builder.buildGraph(context.Background(), flow)
+
+	s.Nil(graph)
+	s.NotNil(err)
+	s.Contains(err.Error(), "only one of")
+}
+
 func (s *GraphBuilderTestSuite) TestBuildGraph_CallNode_OnFailureToNonPromptNode_Accepted() {
 	// CALL nodes are allowed to route onFailure to non-PROMPT nodes (unlike TASK_EXECUTION nodes).
 	flow := &providers.CompleteFlowDefinition{
@@ -1956,3 +2171,194 @@ func (s *GraphBuilderTestSuite) TestConfigureNodePrompts_InvalidRegexFailsBuild(
 	s.Contains(err.Error(), "password")
 	s.Contains(err.Error(), "invalid validation regex")
 }
+
+func (s *GraphBuilderTestSuite) TestConfigureDecisionNode_Success() {
+	nodeDef := &providers.NodeDefinition{
+		ID:   "decision",
+		Type: "DECISION",
+		Decision: &providers.DecisionDefinition{
+			Branches: []providers.DecisionBranchDefinition{
+				{
+					Conditions: []providers.DecisionConditionDefinition{
+						{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+					},
+					Next: "mfa",
+				},
+				{
+					Conditions: []providers.DecisionConditionDefinition{
+						{Key: "{{ctx(riskScore)}}", Operator: providers.DecisionOperatorGreaterThan, Value: "70"},
+					},
+					Next: "mfa",
+				},
+			},
+			Default: "end",
+		},
+	}
+
+	mockDecisionNode := coremock.NewDecisionNodeInterfaceMock(s.T())
+	mockDecisionNode.EXPECT().SetBranches([]core.DecisionBranch{
+		{
+			Conditions: []core.DecisionCondition{
+				{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+			},
+			Next: "mfa",
+		},
+		{
+			Conditions: []core.DecisionCondition{
+				{Key: "{{ctx(riskScore)}}", Operator: providers.DecisionOperatorGreaterThan, Value: "70"},
+			},
+			Next: "mfa",
+		},
+	})
+	mockDecisionNode.EXPECT().SetDefaultNext("end")
+
+	edges := map[string][]string{}
+	err := s.builder.configureDecisionNode(nodeDef, mockDecisionNode, edges)
+
+	s.Nil(err)
+	s.Equal([]string{"mfa", "end"}, edges["decision"])
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureDecisionNode_MissingDefault() {
+	nodeDef := &providers.NodeDefinition{
+		ID:   "decision",
+		Type: "DECISION",
+		Decision: &providers.DecisionDefinition{
+			Branches: []providers.DecisionBranchDefinition{{Next: "mfa"}},
+		},
+	}
+
+	mockDecisionNode := coremock.NewDecisionNodeInterfaceMock(s.T())
+
+	err := s.builder.configureDecisionNode(nodeDef, mockDecisionNode, map[string][]string{})
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "'decision.default' is required")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureDecisionNode_DecisionOnNonDecisionNode() {
+	nodeDef := &providers.NodeDefinition{
+		ID:       "task",
+		Type:     "TASK_EXECUTION",
+		Decision: &providers.DecisionDefinition{Default: "end"},
+	}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	err := s.builder.configureDecisionNode(nodeDef, mockTaskNode, map[string][]string{})
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "'decision' field is only valid on DECISION nodes")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureDecisionNode_SkipsNonDecisionNode() {
+	nodeDef := &providers.NodeDefinition{ID: "task", Type: "TASK_EXECUTION"}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+	edges := map[string][]string{}
+
+	err := s.builder.configureDecisionNode(nodeDef, mockTaskNode, edges)
+
+	s.Nil(err)
+	s.Empty(edges)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureParallelNode_Success() {
+	nodeDef := &providers.NodeDefinition{
+		ID:   "parallel",
+		Type: "PARALLEL",
+		Parallel: &providers.ParallelDefinition{
+			Branches: []string{"send-email", "send-sms"},
+			Join:     "join",
+		},
+	}
+
+	mockParallelNode := coremock.NewParallelNodeInterfaceMock(s.T())
+	mockParallelNode.EXPECT().SetBranches([]string{"send-email", "send-sms"})
+	mockParallelNode.EXPECT().SetJoinNode("join")
+
+	edges := map[string][]string{}
+	err := s.builder.configureParallelNode(nodeDef, mockParallelNode, edges)
+
+	s.Nil(err)
+	s.Equal([]string{"send-email", "send-sms", "join"}, edges["parallel"])
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureParallelNode_MissingJoin() {
+	nodeDef := &providers.NodeDefinition{
+		ID:       "parallel",
+		Type:     "PARALLEL",
+		Parallel: &providers.ParallelDefinition{Branches: []string{"send-email"}},
+	}
+
+	mockParallelNode := coremock.NewParallelNodeInterfaceMock(s.T())
+
+	err := s.builder.configureParallelNode(nodeDef, mockParallelNode, map[string][]string{})
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "'parallel.join' is required")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureParallelNode_ParallelOnNonParallelNode() {
+	nodeDef := &providers.NodeDefinition{
+		ID:       "task",
+		Type:     "TASK_EXECUTION",
+		Parallel: &providers.ParallelDefinition{Branches: []string{"a"}, Join: "join"},
+	}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	err := s.builder.configureParallelNode(nodeDef, mockTaskNode, map[string][]string{})
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "'parallel' field is only valid on PARALLEL nodes")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureJoinNode_Success() {
+	allNodes := []providers.NodeDefinition{
+		{
+			ID:   "parallel",
+			Type: "PARALLEL",
+			Parallel: &providers.ParallelDefinition{
+				Branches: []string{"send-email", "send-sms"},
+				Join:     "join",
+			},
+		},
+		{ID: "join", Type: "JOIN", Join: &providers.JoinDefinition{Mode: providers.JoinModeAny},
+			OnSuccess: "otp-prompt"},
+	}
+
+	mockJoinNode := coremock.NewJoinNodeInterfaceMock(s.T())
+	mockJoinNode.EXPECT().SetMode(providers.JoinModeAny)
+	mockJoinNode.EXPECT().SetBranches([]string{"send-email", "send-sms"})
+
+	err := s.builder.configureJoinNode(&allNodes[1], allNodes, mockJoinNode)
+
+	s.Nil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureJoinNode_MissingOnSuccess() {
+	nodeDef := &providers.NodeDefinition{ID: "join", Type: "JOIN"}
+
+	mockJoinNode := coremock.NewJoinNodeInterfaceMock(s.T())
+
+	err := s.builder.configureJoinNode(nodeDef, []providers.NodeDefinition{*nodeDef}, mockJoinNode)
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "'onSuccess' is required")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureJoinNode_JoinOnNonJoinNode() {
+	nodeDef := &providers.NodeDefinition{
+		ID:   "task",
+		Type: "TASK_EXECUTION",
+		Join: &providers.JoinDefinition{Mode: providers.JoinModeAll},
+	}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	err := s.builder.configureJoinNode(nodeDef, []providers.NodeDefinition{*nodeDef}, mockTaskNode)
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "'join' field is only valid on JOIN nodes")
+}
@@ -38,6 +38,65 @@ func (_m *FlowValidatorInterfaceMock) EXPECT() *FlowValidatorInterfaceMock_Expec
 	return &FlowValidatorInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// AnalyzeFlowDefinition provides a mock function for the type FlowValidatorInterfaceMock
+func (_mock *FlowValidatorInterfaceMock) AnalyzeFlowDefinition(ctx context.Context, flowDef *FlowDefinition) []FlowValidationIssue {
+	ret := _mock.Called(ctx, flowDef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyzeFlowDefinition")
+	}
+
+	var r0 []FlowValidationIssue
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FlowDefinition) []FlowValidationIssue); ok {
+		r0 = returnFunc(ctx, flowDef)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]FlowValidationIssue)
+		}
+	}
+	return r0
+}
+
+// FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnalyzeFlowDefinition'
+type FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call struct {
+	*mock.Call
+}
+
+// AnalyzeFlowDefinition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowDef *FlowDefinition
+func (_e *FlowValidatorInterfaceMock_Expecter) AnalyzeFlowDefinition(ctx interface{}, flowDef interface{}) *FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call {
+	return &FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call{Call: _e.mock.On("AnalyzeFlowDefinition", ctx, flowDef)}
+}
+
+func (_c *FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call) Run(run func(ctx context.Context, flowDef *FlowDefinition)) *FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *FlowDefinition
+		if args[1] != nil {
+			arg1 = args[1].(*FlowDefinition)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call) Return(flowValidationIssues []FlowValidationIssue) *FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call {
+	_c.Call.Return(flowValidationIssues)
+	return _c
+}
+
+func (_c *FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call) RunAndReturn(run func(ctx context.Context, flowDef *FlowDefinition) []FlowValidationIssue) *FlowValidatorInterfaceMock_AnalyzeFlowDefinition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateFlowDefinition provides a mock function for the type FlowValidatorInterfaceMock
 func (_mock *FlowValidatorInterfaceMock) ValidateFlowDefinition(ctx context.Context, flowDef *FlowDefinition) *common.ServiceError {
 	ret := _mock.Called(ctx, flowDef)
@@ -25,6 +25,8 @@ const (
 	maxPageSize = 100
 	// maxAllowedVersionHistory is the maximum number of versions to keep for a flow definition
 	maxAllowedVersionHistory = 50
+	// maxExecutorRetryCount is the maximum number of retries allowed on a TASK_EXECUTION node
+	maxExecutorRetryCount = 10
 	// defaultVersionHistory is the default number of versions to keep for a flow definition
 	defaultVersionHistory = 10
 )
@@ -67,3 +69,64 @@ var authToRegLabelTerms = []struct{ auth, reg string }{
 	{"Log In", "Register"},
 	{"Login", "Register"},
 }
+
+// FlowValidationIssueType identifies the kind of problem reported by the flow validation API.
+type FlowValidationIssueType string
+
+const (
+	// IssueTypeInvalidDefinition reports a definition-level error such as missing metadata or a
+	// malformed node configuration.
+	IssueTypeInvalidDefinition FlowValidationIssueType = "INVALID_DEFINITION"
+	// IssueTypeUnreachableNode reports a node that cannot be reached from the START node.
+	IssueTypeUnreachableNode FlowValidationIssueType = "UNREACHABLE_NODE"
+	// IssueTypeMissingExecutor reports a task execution node whose executor is absent or not registered.
+	IssueTypeMissingExecutor FlowValidationIssueType = "MISSING_EXECUTOR"
+	// IssueTypeDanglingReference reports an edge that points to a node that does not exist.
+	IssueTypeDanglingReference FlowValidationIssueType = "DANGLING_REFERENCE"
+	// IssueTypeCyclicPath reports a cycle that never passes through a PROMPT node and would
+	// therefore loop forever at runtime.
+	IssueTypeCyclicPath FlowValidationIssueType = "CYCLIC_PATH"
+)
+
+// FlowSimulationStatus is the terminal state of a flow dry run.
+type FlowSimulationStatus string
+
+const (
+	// SimulationStatusCompleted indicates the dry run reached the END node.
+	SimulationStatusCompleted FlowSimulationStatus = "COMPLETED"
+	// SimulationStatusFailed indicates an executor failed with no onFailure path to follow.
+	SimulationStatusFailed FlowSimulationStatus = "FAILED"
+	// SimulationStatusHalted indicates the dry run could not continue because the graph is broken.
+	SimulationStatusHalted FlowSimulationStatus = "HALTED"
+	// SimulationStatusStepLimitReached indicates the dry run was stopped after the maximum number of steps.
+	SimulationStatusStepLimitReached FlowSimulationStatus = "STEP_LIMIT_REACHED"
+)
+
+// FlowSimulationOutcome is the result of visiting a single node during a flow dry run.
+type FlowSimulationOutcome string
+
+const (
+	// SimulationOutcomeStarted is recorded for the START node.
+	SimulationOutcomeStarted FlowSimulationOutcome = "STARTED"
+	// SimulationOutcomeSuccess is recorded when an executor or called flow completes successfully.
+	SimulationOutcomeSuccess FlowSimulationOutcome = "SUCCESS"
+	// SimulationOutcomeFailure is recorded when a node is listed in the failing nodes of the request.
+	SimulationOutcomeFailure FlowSimulationOutcome = "FAILURE"
+	// SimulationOutcomeIncomplete is recorded when an executor lacks required inputs.
+	SimulationOutcomeIncomplete FlowSimulationOutcome = "INCOMPLETE"
+	// SimulationOutcomeSkipped is recorded when a node condition does not match the mock inputs.
+	SimulationOutcomeSkipped FlowSimulationOutcome = "SKIPPED"
+	// SimulationOutcomePrompted is recorded when a PROMPT node is shown to the user.
+	SimulationOutcomePrompted FlowSimulationOutcome = "PROMPTED"
+	// SimulationOutcomeCompleted is recorded for the END node.
+	SimulationOutcomeCompleted FlowSimulationOutcome = "COMPLETED"
+	// SimulationOutcomeNodeNotFound is recorded when an edge points to a node that does not exist.
+	SimulationOutcomeNodeNotFound FlowSimulationOutcome = "NODE_NOT_FOUND"
+)
+
+const (
+	// defaultSimulationMaxSteps is the default number of nodes visited before a dry run is stopped
+	defaultSimulationMaxSteps = 100
+	// maxSimulationMaxSteps is the upper bound for the number of nodes visited in a dry run
+	maxSimulationMaxSteps = 1000
+)
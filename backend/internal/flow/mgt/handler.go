@@ -93,6 +93,9 @@ func (h *flowMgtHandler) createFlow(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	flowDefRequest, err := utils.DecodeJSONBody[FlowDefinitionRequest](r)
 	if err != nil {
+		if utils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleInvalidRequestError(ctx, w)
 		return
 	}
@@ -108,6 +111,35 @@ func (h *flowMgtHandler) createFlow(w http.ResponseWriter, r *http.Request) {
 	h.logger.Debug(ctx, "Flow created successfully", log.String(logKeyFlowID, createdFlow.ID))
 }
 
+// validateFlow handles POST requests to validate a flow definition without persisting it.
+func (h *flowMgtHandler) validateFlow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	validationRequest, err := utils.DecodeJSONBody[FlowValidationRequest](r)
+	if err != nil {
+		if utils.WriteValidationErrorResponse(w, err) {
+			return
+		}
+		handleInvalidRequestError(ctx, w)
+		return
+	}
+
+	sanitized := sanitizeFlowDefinitionRequest(&FlowDefinitionRequest{
+		Handle:       validationRequest.Handle,
+		Name:         validationRequest.Name,
+		FlowType:     validationRequest.FlowType,
+		Interceptors: validationRequest.Interceptors,
+		Nodes:        validationRequest.Nodes,
+	})
+	result, svcErr := h.service.ValidateFlow(ctx, sanitized, validationRequest.Simulation)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	utils.WriteSuccessResponse(ctx, w, http.StatusOK, result)
+	h.logger.Debug(ctx, "Flow validation request handled successfully", log.Int(logKeyCount, len(result.Issues)))
+}
+
 // getFlow handles GET requests to retrieve a flow definition by its ID.
 func (h *flowMgtHandler) getFlow(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -157,6 +189,9 @@ func (h *flowMgtHandler) updateFlow(w http.ResponseWriter, r *http.Request) {
 
 	flowDefRequest, err := utils.DecodeJSONBody[FlowDefinitionRequest](r)
 	if err != nil {
+		if utils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleInvalidRequestError(ctx, w)
 		return
 	}
@@ -251,6 +286,9 @@ func (h *flowMgtHandler) restoreFlowVersion(w http.ResponseWriter, r *http.Reque
 
 	request, err := utils.DecodeJSONBody[RestoreVersionRequest](r)
 	if err != nil {
+		if utils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleInvalidRequestError(ctx, w)
 		return
 	}
@@ -323,7 +361,7 @@ func handleInvalidRequestError(ctx context.Context, w http.ResponseWriter) {
 		Message:     ErrorInvalidRequestFormat.Error,
 		Description: ErrorInvalidRequestFormat.ErrorDescription,
 	}
-	utils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+	utils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 }
 
 // handleError writes an error response based on the provided ServiceError.
@@ -348,5 +386,5 @@ func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.S
 			log.String("description", svcErr.ErrorDescription.DefaultValue))
 	}
 
-	utils.WriteErrorResponse(ctx, w, statusCode, errResp)
+	utils.WriteProblemResponse(ctx, w, statusCode, errResp)
 }
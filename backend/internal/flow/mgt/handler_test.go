@@ -207,6 +207,67 @@ func (s *FlowMgtHandlerTestSuite) TestCreateFlow_ServiceError() {
 	s.Equal(http.StatusBadRequest, w.Code)
 }
 
+// Test validateFlow
+
+func (s *FlowMgtHandlerTestSuite) TestValidateFlow_Success() {
+	request := FlowValidationRequest{
+		Handle:     "new-flow-handle",
+		Name:       "New Flow",
+		FlowType:   providers.FlowTypeAuthentication,
+		Nodes:      []providers.NodeDefinition{{ID: "start", Type: "START"}},
+		Simulation: &FlowSimulationRequest{Inputs: map[string]string{"username": "alice"}},
+	}
+	expectedDef := &FlowDefinition{
+		Handle:   request.Handle,
+		Name:     request.Name,
+		FlowType: request.FlowType,
+		Nodes:    request.Nodes,
+	}
+	validation := &FlowValidationResponse{
+		Valid:  false,
+		Issues: []FlowValidationIssue{{Type: IssueTypeDanglingReference, NodeID: "start"}},
+	}
+	s.mockService.EXPECT().ValidateFlow(mock.Anything, expectedDef, request.Simulation).Return(validation, nil)
+
+	body, _ := json.Marshal(request)
+	req := httptest.NewRequest(http.MethodPost, "/flows/validate", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.validateFlow(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response FlowValidationResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	s.NoError(err)
+	s.False(response.Valid)
+	s.Require().Len(response.Issues, 1)
+	s.Equal(IssueTypeDanglingReference, response.Issues[0].Type)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestValidateFlow_InvalidJSON() {
+	req := httptest.NewRequest(http.MethodPost, "/flows/validate", bytes.NewReader([]byte("invalid json")))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.validateFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestValidateFlow_ServiceError() {
+	s.mockService.EXPECT().ValidateFlow(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, &ErrorInvalidRequestFormat)
+
+	req := httptest.NewRequest(http.MethodPost, "/flows/validate", bytes.NewReader([]byte(`{"nodes":[]}`)))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.validateFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
 // Test getFlow
 
 func (s *FlowMgtHandlerTestSuite) TestGetFlow_Success() {
@@ -620,6 +620,12 @@ func (s *flowInferenceService) insertNodeBefore(nodes *[]providers.NodeDefinitio
 			modified = true
 		}
 
+		// Update onCircuitOpen if it points to target
+		if node.OnCircuitOpen != "" && node.OnCircuitOpen == targetNodeID {
+			node.OnCircuitOpen = newNode.ID
+			modified = true
+		}
+
 		// Update prompts that have actions pointing to target
 		for j := range node.Prompts {
 			if node.Prompts[j].Action != nil && node.Prompts[j].Action.NextNode == targetNodeID {
@@ -175,6 +175,11 @@ func registerRoutes(mux *http.ServeMux, handler *flowMgtHandler) {
 		w.WriteHeader(http.StatusNoContent)
 	}, opts1))
 
+	mux.HandleFunc(middleware.WithCORS("POST /flows/validate", handler.validateFlow, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/validate", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts1))
+
 	opts2 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
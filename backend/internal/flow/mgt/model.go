@@ -23,27 +23,28 @@ package flowmgt
 
 import (
 	"github.com/thunder-id/thunderid/internal/system/mcp/tool"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // FlowDefinition represents the structure of a flow definition.
 type FlowDefinition struct {
 	ID           string                            `json:"id,omitempty"           yaml:"id,omitempty"           jsonschema:"Optional explicit ID for the flow. When omitted a UUID is generated."`
-	Handle       string                            `json:"handle"                                               jsonschema:"Unique identifier for the flow (lowercase, alphanumeric with dashes/underscores). Example: 'basic-login', 'invite-registration'"          validate:"required"`
-	Name         string                            `json:"name"                                                 jsonschema:"Display name for the flow. Example: 'Basic Login Flow', 'Invite Registration'"                                                            validate:"required"`
-	FlowType     providers.FlowType                `json:"flowType"                                             jsonschema:"Type of flow: 'AUTHENTICATION' for login flows or 'REGISTRATION' for signup flows"                                                        validate:"required"`
+	Handle       string                            `json:"handle"                                               jsonschema:"Unique identifier for the flow (lowercase, alphanumeric with dashes/underscores). Example: 'basic-login', 'invite-registration'"          native:"required,max=100"`
+	Name         string                            `json:"name"                                                 jsonschema:"Display name for the flow. Example: 'Basic Login Flow', 'Invite Registration'"                                                            native:"required,max=100"`
+	FlowType     providers.FlowType                `json:"flowType"                                             jsonschema:"Type of flow: 'AUTHENTICATION' for login flows or 'REGISTRATION' for signup flows"                                                        native:"required,oneof=AUTHENTICATION REGISTRATION USER_ONBOARDING RECOVERY"`
 	Interceptors []providers.InterceptorDefinition `json:"interceptors,omitempty" yaml:"interceptors,omitempty" jsonschema:"Optional array of interceptor declarations for cross-cutting concerns (e.g., CAPTCHA, rate limiting)."`
-	Nodes        []providers.NodeDefinition        `json:"nodes"                                                jsonschema:"Array of nodes defining the flow steps. Must include START and END nodes. Use get_flow on existing flows to see node structure examples." validate:"required"`
+	Nodes        []providers.NodeDefinition        `json:"nodes"                                                jsonschema:"Array of nodes defining the flow steps. Must include START and END nodes. Use get_flow on existing flows to see node structure examples." native:"required"`
 }
 
 // FlowDefinitionRequest represents the API request body for create/update flow operations.
 // ID is intentionally excluded from API payloads.
 type FlowDefinitionRequest struct {
-	Handle       string                            `json:"handle"                 validate:"required"`
-	Name         string                            `json:"name"                   validate:"required"`
-	FlowType     providers.FlowType                `json:"flowType"               validate:"required"`
+	Handle       string                            `json:"handle"                 native:"required,max=100"`
+	Name         string                            `json:"name"                   native:"required,max=100"`
+	FlowType     providers.FlowType                `json:"flowType"               native:"required,oneof=AUTHENTICATION REGISTRATION USER_ONBOARDING RECOVERY"`
 	Interceptors []providers.InterceptorDefinition `json:"interceptors,omitempty"`
-	Nodes        []providers.NodeDefinition        `json:"nodes"                  validate:"required"`
+	Nodes        []providers.NodeDefinition        `json:"nodes"`
 }
 
 // BasicFlowDefinition represents basic information about a flow definition.
@@ -95,7 +96,7 @@ type BasicFlowVersion struct {
 
 // RestoreVersionRequest represents a request to restore a specific version.
 type RestoreVersionRequest struct {
-	Version int `json:"version" validate:"required"`
+	Version int `json:"version" native:"required,min=1"`
 }
 
 // Link represents a hypermedia link for pagination.
@@ -129,3 +130,55 @@ type updateFlowInput struct {
 	Nodes        []providers.NodeDefinition        `json:"nodes"                  jsonschema:"Array of nodes defining the flow steps. Required for PUT."`
 	Interceptors []providers.InterceptorDefinition `json:"interceptors,omitempty" jsonschema:"Optional array of interceptor declarations for cross-cutting concerns."`
 }
+
+// FlowValidationRequest represents the API request body for validating a flow definition without
+// persisting it. When Simulation is set, the graph is also walked with the supplied mock inputs.
+type FlowValidationRequest struct {
+	Handle       string                            `json:"handle"`
+	Name         string                            `json:"name"`
+	FlowType     providers.FlowType                `json:"flowType"`
+	Interceptors []providers.InterceptorDefinition `json:"interceptors,omitempty"`
+	Nodes        []providers.NodeDefinition        `json:"nodes"`
+	Simulation   *FlowSimulationRequest            `json:"simulation,omitempty"`
+}
+
+// FlowSimulationRequest holds the mock data used to walk a flow graph during a dry run.
+type FlowSimulationRequest struct {
+	Inputs       map[string]string `json:"inputs,omitempty"`
+	Actions      map[string]string `json:"actions,omitempty"`
+	FailingNodes []string          `json:"failingNodes,omitempty"`
+	MaxSteps     int               `json:"maxSteps,omitempty"`
+}
+
+// FlowValidationResponse represents the result of validating a flow definition.
+type FlowValidationResponse struct {
+	Valid      bool                  `json:"valid"`
+	Issues     []FlowValidationIssue `json:"issues"`
+	Simulation *FlowSimulationResult `json:"simulation,omitempty"`
+}
+
+// FlowValidationIssue describes a single problem found in a flow definition.
+type FlowValidationIssue struct {
+	Type    FlowValidationIssueType `json:"type"`
+	NodeID  string                  `json:"nodeId,omitempty"`
+	Message tidcommon.I18nMessage   `json:"message"`
+}
+
+// FlowSimulationResult represents the outcome of a dry run over a flow graph.
+type FlowSimulationResult struct {
+	Status FlowSimulationStatus `json:"status"`
+	Steps  []FlowSimulationStep `json:"steps"`
+}
+
+// FlowSimulationStep describes a single node visited during a dry run.
+type FlowSimulationStep struct {
+	NodeID        string                `json:"nodeId"`
+	NodeType      string                `json:"nodeType"`
+	Executor      string                `json:"executor,omitempty"`
+	Flow          string                `json:"flow,omitempty"`
+	Inputs        []string              `json:"inputs,omitempty"`
+	MissingInputs []string              `json:"missingInputs,omitempty"`
+	Action        string                `json:"action,omitempty"`
+	Outcome       FlowSimulationOutcome `json:"outcome"`
+	NextNode      string                `json:"nextNode,omitempty"`
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package flowmgt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+)
+
+// FindUnregisteredExecutors returns a description of each flow node that references an executor missing
+// from the registry. Flow definitions are validated when they are saved, but a flow stored before an
+// executor was removed or renamed would otherwise fail only when a user reaches the node.
+func FindUnregisteredExecutors(ctx context.Context, service FlowMgtServiceInterface,
+	registry executor.ExecutorRegistryInterface) ([]string, error) {
+	var problems []string
+	for offset := 0; ; offset += maxPageSize {
+		list, svcErr := service.ListFlows(ctx, maxPageSize, offset, "")
+		if svcErr != nil {
+			return nil, fmt.Errorf("failed to list flows: %s: %s", svcErr.Code, svcErr.ErrorDescription.DefaultValue)
+		}
+
+		for _, basic := range list.Flows {
+			flow, svcErr := service.GetFlow(ctx, basic.ID)
+			if svcErr != nil {
+				return nil, fmt.Errorf("failed to get flow %s: %s: %s",
+					basic.ID, svcErr.Code, svcErr.ErrorDescription.DefaultValue)
+			}
+			for _, node := range flow.Nodes {
+				if node.Executor == nil || node.Executor.Name == "" || registry.IsRegistered(node.Executor.Name) {
+					continue
+				}
+				problems = append(problems, fmt.Sprintf("flow %q (%s): node %q references executor %q, "+
+					"which is not registered", flow.Handle, flow.ID, node.ID, node.Executor.Name))
+			}
+		}
+
+		if len(list.Flows) == 0 || offset+len(list.Flows) >= list.TotalResults {
+			return problems, nil
+		}
+	}
+}
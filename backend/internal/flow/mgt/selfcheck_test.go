@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package flowmgt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/executormock"
+)
+
+type SelfCheckTestSuite struct {
+	suite.Suite
+	mockService  *FlowMgtServiceInterfaceMock
+	mockRegistry *executormock.ExecutorRegistryInterfaceMock
+}
+
+func TestSelfCheckTestSuite(t *testing.T) {
+	suite.Run(t, new(SelfCheckTestSuite))
+}
+
+func (s *SelfCheckTestSuite) SetupTest() {
+	s.mockService = NewFlowMgtServiceInterfaceMock(s.T())
+	s.mockRegistry = executormock.NewExecutorRegistryInterfaceMock(s.T())
+}
+
+func (s *SelfCheckTestSuite) TestFindUnregisteredExecutors_ReportsMissingExecutor() {
+	ctx := context.Background()
+	s.mockService.On("ListFlows", ctx, maxPageSize, 0, providers.FlowType("")).Return(&FlowListResponse{
+		TotalResults: 1,
+		Flows:        []BasicFlowDefinition{{ID: "flow-1"}},
+	}, nil)
+	s.mockService.On("GetFlow", ctx, "flow-1").Return(&providers.CompleteFlowDefinition{
+		ID:     "flow-1",
+		Handle: "basic-login",
+		Nodes: []providers.NodeDefinition{
+			{ID: "start", Type: "START"},
+			{ID: "basic", Executor: &providers.ExecutorDefinition{Name: "BasicAuthExecutor"}},
+			{ID: "legacy", Executor: &providers.ExecutorDefinition{Name: "LegacyExecutor"}},
+		},
+	}, nil)
+	s.mockRegistry.On("IsRegistered", "BasicAuthExecutor").Return(true)
+	s.mockRegistry.On("IsRegistered", "LegacyExecutor").Return(false)
+
+	problems, err := FindUnregisteredExecutors(ctx, s.mockService, s.mockRegistry)
+
+	s.Require().NoError(err)
+	s.Require().Len(problems, 1)
+	s.Contains(problems[0], "basic-login")
+	s.Contains(problems[0], "LegacyExecutor")
+}
+
+func (s *SelfCheckTestSuite) TestFindUnregisteredExecutors_ReadsAllPages() {
+	ctx := context.Background()
+	firstPage := make([]BasicFlowDefinition, maxPageSize)
+	for i := range firstPage {
+		firstPage[i] = BasicFlowDefinition{ID: "flow"}
+	}
+	s.mockService.On("ListFlows", ctx, maxPageSize, 0, providers.FlowType("")).Return(&FlowListResponse{
+		TotalResults: maxPageSize + 1,
+		Flows:        firstPage,
+	}, nil)
+	s.mockService.On("ListFlows", ctx, maxPageSize, maxPageSize, providers.FlowType("")).Return(&FlowListResponse{
+		TotalResults: maxPageSize + 1,
+		Flows:        []BasicFlowDefinition{{ID: "last"}},
+	}, nil)
+	s.mockService.On("GetFlow", ctx, mock.Anything).Return(&providers.CompleteFlowDefinition{}, nil)
+
+	problems, err := FindUnregisteredExecutors(ctx, s.mockService, s.mockRegistry)
+
+	s.Require().NoError(err)
+	s.Empty(problems)
+	s.mockService.AssertNumberOfCalls(s.T(), "GetFlow", maxPageSize+1)
+}
+
+func (s *SelfCheckTestSuite) TestFindUnregisteredExecutors_ListError() {
+	ctx := context.Background()
+	s.mockService.On("ListFlows", ctx, maxPageSize, 0, providers.FlowType("")).
+		Return(nil, &tidcommon.InternalServerError)
+
+	problems, err := FindUnregisteredExecutors(ctx, s.mockService, s.mockRegistry)
+
+	s.Error(err)
+	s.Nil(problems)
+}
@@ -55,6 +55,8 @@ type FlowMgtServiceInterface interface {
 		ctx context.Context,
 		flowDef *FlowDefinition,
 	) (*providers.CompleteFlowDefinition, *tidcommon.ServiceError)
+	ValidateFlow(ctx context.Context, flowDef *FlowDefinition, simulation *FlowSimulationRequest) (
+		*FlowValidationResponse, *tidcommon.ServiceError)
 	GetFlow(ctx context.Context, flowID string) (*providers.CompleteFlowDefinition, *tidcommon.ServiceError)
 	GetFlowByHandle(ctx context.Context, handle string, flowType providers.FlowType) (
 		*providers.CompleteFlowDefinition, *tidcommon.ServiceError)
@@ -207,6 +209,29 @@ func (s *flowMgtService) CreateFlow(ctx context.Context, flowDef *FlowDefinition
 	return createdFlow, nil
 }
 
+// ValidateFlow checks a flow definition without persisting it and reports every issue found. When a
+// simulation request is provided, the flow graph is also walked as a dry run using its mock data.
+func (s *flowMgtService) ValidateFlow(
+	ctx context.Context, flowDef *FlowDefinition, simulation *FlowSimulationRequest,
+) (*FlowValidationResponse, *tidcommon.ServiceError) {
+	if flowDef == nil {
+		return nil, &ErrorInvalidRequestFormat
+	}
+
+	issues := s.flowValidator.AnalyzeFlowDefinition(ctx, flowDef)
+	response := &FlowValidationResponse{
+		Valid:  len(issues) == 0,
+		Issues: issues,
+	}
+	if simulation != nil {
+		response.Simulation = simulateFlow(flowDef.Nodes, simulation)
+	}
+
+	s.logger.Debug(ctx, "Flow definition validated",
+		log.Bool("valid", response.Valid), log.Int(logKeyCount, len(issues)))
+	return response, nil
+}
+
 // GetFlow retrieves a flow definition by its ID.
 func (s *flowMgtService) GetFlow(ctx context.Context, flowID string) (
 	*providers.CompleteFlowDefinition, *tidcommon.ServiceError) {
@@ -905,6 +905,52 @@ func (s *FlowMgtServiceTestSuite) TestCreateFlow_DuplicateHandleCheckError() {
 	s.Equal(&tidcommon.InternalServerError, err)
 }
 
+// ValidateFlow tests
+
+func (s *FlowMgtServiceTestSuite) TestValidateFlow_Valid() {
+	flowDef := &FlowDefinition{
+		Handle:   "test-flow",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes:    validFlowNodes(),
+	}
+	s.mockValidator.EXPECT().AnalyzeFlowDefinition(mock.Anything, flowDef).Return([]FlowValidationIssue{})
+
+	result, err := s.service.ValidateFlow(context.Background(), flowDef, nil)
+
+	s.Nil(err)
+	s.True(result.Valid)
+	s.Empty(result.Issues)
+	s.Nil(result.Simulation)
+}
+
+func (s *FlowMgtServiceTestSuite) TestValidateFlow_WithIssuesAndSimulation() {
+	flowDef := &FlowDefinition{
+		Handle:   "test-flow",
+		Name:     "Test Flow",
+		FlowType: providers.FlowTypeAuthentication,
+		Nodes:    validFlowNodes(),
+	}
+	issues := []FlowValidationIssue{{Type: IssueTypeUnreachableNode, NodeID: "orphan"}}
+	s.mockValidator.EXPECT().AnalyzeFlowDefinition(mock.Anything, flowDef).Return(issues)
+
+	result, err := s.service.ValidateFlow(context.Background(), flowDef, &FlowSimulationRequest{})
+
+	s.Nil(err)
+	s.False(result.Valid)
+	s.Equal(issues, result.Issues)
+	s.Require().NotNil(result.Simulation)
+	s.NotEmpty(result.Simulation.Steps)
+	s.Equal("start", result.Simulation.Steps[0].NodeID)
+}
+
+func (s *FlowMgtServiceTestSuite) TestValidateFlow_NilDefinition() {
+	result, err := s.service.ValidateFlow(context.Background(), nil, nil)
+
+	s.Nil(result)
+	s.Equal(&ErrorInvalidRequestFormat, err)
+}
+
 // GetFlow tests
 
 func (s *FlowMgtServiceTestSuite) TestGetFlow_Success() {
@@ -0,0 +1,289 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// simulateFlow walks the flow graph from the START node without invoking any executor, using the
+// mock data in the request to decide the path taken. Executors succeed unless their node is listed
+// as failing, node conditions are matched against the mock inputs, and PROMPT nodes take the
+// requested action or fall back to their first one. Inputs requested by a prompt are treated as
+// submitted afterwards so that retry loops do not repeat forever.
+func simulateFlow(nodes []providers.NodeDefinition, request *FlowSimulationRequest) *FlowSimulationResult {
+	maxSteps := request.MaxSteps
+	if maxSteps <= 0 {
+		maxSteps = defaultSimulationMaxSteps
+	}
+	if maxSteps > maxSimulationMaxSteps {
+		maxSteps = maxSimulationMaxSteps
+	}
+
+	nodeIndex := make(map[string]*providers.NodeDefinition, len(nodes))
+	currentNodeID := ""
+	for i := range nodes {
+		nodeIndex[nodes[i].ID] = &nodes[i]
+		if currentNodeID == "" && nodes[i].Type == string(common.NodeTypeStart) {
+			currentNodeID = nodes[i].ID
+		}
+	}
+
+	result := &FlowSimulationResult{Steps: make([]FlowSimulationStep, 0)}
+	if currentNodeID == "" {
+		result.Status = SimulationStatusHalted
+		return result
+	}
+
+	available := make(map[string]bool, len(request.Inputs))
+	for identifier := range request.Inputs {
+		available[identifier] = true
+	}
+	branchStatus := make(map[string]bool)
+
+	for len(result.Steps) < maxSteps {
+		node, exists := nodeIndex[currentNodeID]
+		if !exists {
+			result.Steps = append(result.Steps, FlowSimulationStep{
+				NodeID:  currentNodeID,
+				Outcome: SimulationOutcomeNodeNotFound,
+			})
+			result.Status = SimulationStatusHalted
+			return result
+		}
+
+		step := simulateNode(node, request, available, branchStatus)
+		result.Steps = append(result.Steps, step)
+		if node.Type == string(common.NodeTypeParallel) && step.Outcome == SimulationOutcomeSuccess {
+			result.Steps = append(result.Steps, simulateParallelBranches(
+				node, nodeIndex, request, available, branchStatus, maxSteps-len(result.Steps))...)
+		}
+
+		switch {
+		case step.Outcome == SimulationOutcomeCompleted:
+			result.Status = SimulationStatusCompleted
+			return result
+		case step.NextNode == "" && step.Outcome == SimulationOutcomeFailure:
+			result.Status = SimulationStatusFailed
+			return result
+		case step.NextNode == "":
+			result.Status = SimulationStatusHalted
+			return result
+		}
+		currentNodeID = step.NextNode
+	}
+
+	result.Status = SimulationStatusStepLimitReached
+	return result
+}
+
+// simulateNode records the visit of a single node and determines the next node to visit.
+func simulateNode(
+	node *providers.NodeDefinition, request *FlowSimulationRequest, available map[string]bool,
+	branchStatus map[string]bool,
+) FlowSimulationStep {
+	step := FlowSimulationStep{NodeID: node.ID, NodeType: node.Type}
+
+	if node.Condition != nil && node.Condition.Key != "" &&
+		request.Inputs[node.Condition.Key] != node.Condition.Value {
+		step.Outcome = SimulationOutcomeSkipped
+		step.NextNode = node.Condition.OnSkip
+		return step
+	}
+
+	switch node.Type {
+	case string(common.NodeTypeStart):
+		step.Outcome = SimulationOutcomeStarted
+		step.NextNode = node.OnSuccess
+	case string(common.NodeTypeEnd):
+		step.Outcome = SimulationOutcomeCompleted
+	case string(common.NodeTypeTaskExecution):
+		simulateTaskExecutionNode(node, request, available, &step)
+	case string(common.NodeTypePrompt):
+		simulatePromptNode(node, request, available, &step)
+	case string(common.NodeTypeDecision):
+		simulateDecisionNode(node, request, &step)
+	case string(common.NodeTypeParallel):
+		step.Outcome = SimulationOutcomeSuccess
+		if node.Parallel != nil {
+			step.NextNode = node.Parallel.Join
+		}
+	case string(common.NodeTypeJoin):
+		simulateJoinNode(node, branchStatus, &step)
+	case string(common.NodeTypeCall):
+		if node.Flow != nil {
+			step.Flow = node.Flow.Ref
+			if step.Flow == "" {
+				step.Flow = node.Flow.Handle
+			}
+		}
+		step.Outcome = SimulationOutcomeSuccess
+		step.NextNode = node.OnSuccess
+	}
+	return step
+}
+
+// simulateTaskExecutionNode records an executor run. The executor is incomplete when one of its
+// required inputs has not been provided yet and the node defines an onIncomplete path.
+func simulateTaskExecutionNode(node *providers.NodeDefinition, request *FlowSimulationRequest,
+	available map[string]bool, step *FlowSimulationStep) {
+	if node.Executor != nil {
+		step.Executor = node.Executor.Name
+		for _, input := range node.Executor.Inputs {
+			step.Inputs = append(step.Inputs, input.Identifier)
+			if input.Required && !available[input.Identifier] {
+				step.MissingInputs = append(step.MissingInputs, input.Identifier)
+			}
+		}
+	}
+
+	switch {
+	case len(step.MissingInputs) > 0 && node.OnIncomplete != "":
+		step.Outcome = SimulationOutcomeIncomplete
+		step.NextNode = node.OnIncomplete
+	case slices.Contains(request.FailingNodes, node.ID):
+		step.Outcome = SimulationOutcomeFailure
+		step.NextNode = node.OnFailure
+	default:
+		step.Outcome = SimulationOutcomeSuccess
+		step.NextNode = node.OnSuccess
+	}
+}
+
+// simulateDecisionNode evaluates the branches of a DECISION node against the mock inputs, which
+// stand in for both the runtime data and the user inputs of the flow context.
+func simulateDecisionNode(node *providers.NodeDefinition, request *FlowSimulationRequest,
+	step *FlowSimulationStep) {
+	step.Outcome = SimulationOutcomeSuccess
+	if node.Decision == nil {
+		return
+	}
+
+	nodeCtx := &providers.NodeContext{RuntimeData: request.Inputs, UserInputs: request.Inputs}
+	step.NextNode = node.Decision.Default
+	for _, branchDef := range node.Decision.Branches {
+		branch := core.DecisionBranch{Next: branchDef.Next}
+		for _, conditionDef := range branchDef.Conditions {
+			branch.Conditions = append(branch.Conditions, core.DecisionCondition{
+				Key:      conditionDef.Key,
+				Operator: conditionDef.Operator,
+				Value:    conditionDef.Value,
+			})
+		}
+		if core.EvaluateDecisionBranch(nodeCtx, branch) {
+			step.NextNode = branch.Next
+			return
+		}
+	}
+}
+
+// simulateParallelBranches walks each branch of a PARALLEL node one after the other until it reaches the
+// join node, and records whether the branch succeeded, replacing the outcomes of any earlier PARALLEL
+// node. A branch fails when one of its nodes does not succeed. At most limit steps are returned.
+func simulateParallelBranches(node *providers.NodeDefinition, nodeIndex map[string]*providers.NodeDefinition,
+	request *FlowSimulationRequest, available map[string]bool, branchStatus map[string]bool,
+	limit int) []FlowSimulationStep {
+	steps := make([]FlowSimulationStep, 0)
+	clear(branchStatus)
+	if node.Parallel == nil {
+		return steps
+	}
+
+	for _, branch := range node.Parallel.Branches {
+		branchStatus[branch] = false
+		visited := make(map[string]bool)
+		nodeID := branch
+		for nodeID != node.Parallel.Join && !visited[nodeID] && len(steps) < limit {
+			visited[nodeID] = true
+			branchNode, exists := nodeIndex[nodeID]
+			if !exists {
+				steps = append(steps, FlowSimulationStep{NodeID: nodeID, Outcome: SimulationOutcomeNodeNotFound})
+				break
+			}
+
+			step := simulateNode(branchNode, request, available, branchStatus)
+			steps = append(steps, step)
+			if branchNode.Type != string(common.NodeTypeTaskExecution) ||
+				(step.Outcome != SimulationOutcomeSuccess && step.Outcome != SimulationOutcomeSkipped) {
+				break
+			}
+			nodeID = step.NextNode
+		}
+		branchStatus[branch] = nodeID == node.Parallel.Join
+	}
+	return steps
+}
+
+// simulateJoinNode applies the join mode to the branch outcomes recorded by the PARALLEL node.
+func simulateJoinNode(node *providers.NodeDefinition, branchStatus map[string]bool, step *FlowSimulationStep) {
+	succeeded := 0
+	for _, ok := range branchStatus {
+		if ok {
+			succeeded++
+		}
+	}
+
+	satisfied := len(branchStatus) > 0 && succeeded == len(branchStatus)
+	if node.Join != nil && node.Join.Mode == providers.JoinModeAny {
+		satisfied = succeeded > 0
+	}
+	if satisfied {
+		step.Outcome = SimulationOutcomeSuccess
+		step.NextNode = node.OnSuccess
+		return
+	}
+	step.Outcome = SimulationOutcomeFailure
+	step.NextNode = node.OnFailure
+}
+
+// simulatePromptNode records the inputs a PROMPT node would request and the action it would take.
+func simulatePromptNode(node *providers.NodeDefinition, request *FlowSimulationRequest,
+	available map[string]bool, step *FlowSimulationStep) {
+	step.Outcome = SimulationOutcomePrompted
+	if len(node.Prompts) == 0 {
+		step.NextNode = node.Next
+		return
+	}
+
+	prompt := node.Prompts[0]
+	if actionRef, ok := request.Actions[node.ID]; ok {
+		for _, candidate := range node.Prompts {
+			if candidate.Action != nil && candidate.Action.Ref == actionRef {
+				prompt = candidate
+				break
+			}
+		}
+	}
+
+	for _, input := range prompt.Inputs {
+		step.Inputs = append(step.Inputs, input.Identifier)
+		if input.Required && !available[input.Identifier] {
+			step.MissingInputs = append(step.MissingInputs, input.Identifier)
+		}
+		available[input.Identifier] = true
+	}
+	if prompt.Action != nil {
+		step.Action = prompt.Action.Ref
+		step.NextNode = prompt.Action.NextNode
+	}
+}
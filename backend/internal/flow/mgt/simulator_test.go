@@ -0,0 +1,221 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type SimulatorTestSuite struct {
+	suite.Suite
+}
+
+func TestSimulatorTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulatorTestSuite))
+}
+
+// loginFlowNodes returns a flow that prompts for credentials, authenticates and retries on failure.
+func loginFlowNodes() []providers.NodeDefinition {
+	return []providers.NodeDefinition{
+		{ID: "start", Type: string(common.NodeTypeStart), OnSuccess: "auth"},
+		{
+			ID: "auth", Type: string(common.NodeTypeTaskExecution),
+			Executor: &providers.ExecutorDefinition{
+				Name: "BasicAuthExecutor",
+				Inputs: []providers.InputDefinition{
+					{Identifier: "username", Required: true},
+					{Identifier: "password", Required: true},
+				},
+			},
+			OnSuccess:    "end",
+			OnFailure:    "login",
+			OnIncomplete: "login",
+		},
+		{
+			ID: "login", Type: string(common.NodeTypePrompt),
+			Prompts: []providers.PromptDefinition{
+				{
+					Inputs: []providers.InputDefinition{
+						{Identifier: "username", Required: true},
+						{Identifier: "password", Required: true},
+					},
+					Action: &providers.ActionDefinition{Ref: "submit", NextNode: "auth"},
+				},
+				{Action: &providers.ActionDefinition{Ref: "federated", NextNode: "call"}},
+			},
+		},
+		{ID: "call", Type: string(common.NodeTypeCall), Flow: &providers.FlowReferenceDefinition{Ref: "idp-flow"},
+			OnSuccess: "end"},
+		{ID: "end", Type: string(common.NodeTypeEnd)},
+	}
+}
+
+func stepNodeIDs(result *FlowSimulationResult) []string {
+	ids := make([]string, 0, len(result.Steps))
+	for _, step := range result.Steps {
+		ids = append(ids, step.NodeID)
+	}
+	return ids
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_PromptsForMissingInputs() {
+	result := simulateFlow(loginFlowNodes(), &FlowSimulationRequest{})
+
+	s.Equal(SimulationStatusCompleted, result.Status)
+	s.Equal([]string{"start", "auth", "login", "auth", "end"}, stepNodeIDs(result))
+	s.Equal(SimulationOutcomeIncomplete, result.Steps[1].Outcome)
+	s.Equal("BasicAuthExecutor", result.Steps[1].Executor)
+	s.Equal([]string{"username", "password"}, result.Steps[1].MissingInputs)
+	s.Equal(SimulationOutcomePrompted, result.Steps[2].Outcome)
+	s.Equal("submit", result.Steps[2].Action)
+	s.Equal([]string{"username", "password"}, result.Steps[2].MissingInputs)
+	s.Equal(SimulationOutcomeSuccess, result.Steps[3].Outcome)
+	s.Empty(result.Steps[3].MissingInputs)
+	s.Equal(SimulationOutcomeCompleted, result.Steps[4].Outcome)
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_WithMockInputs() {
+	result := simulateFlow(loginFlowNodes(), &FlowSimulationRequest{
+		Inputs: map[string]string{"username": "alice", "password": "secret"},
+	})
+
+	s.Equal(SimulationStatusCompleted, result.Status)
+	s.Equal([]string{"start", "auth", "end"}, stepNodeIDs(result))
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_SelectedAction() {
+	result := simulateFlow(loginFlowNodes(), &FlowSimulationRequest{
+		Actions: map[string]string{"login": "federated"},
+	})
+
+	s.Equal(SimulationStatusCompleted, result.Status)
+	s.Equal([]string{"start", "auth", "login", "call", "end"}, stepNodeIDs(result))
+	s.Equal("federated", result.Steps[2].Action)
+	s.Equal("idp-flow", result.Steps[3].Flow)
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_FailingNodeFollowsOnFailure() {
+	result := simulateFlow(loginFlowNodes(), &FlowSimulationRequest{
+		Inputs:       map[string]string{"username": "alice", "password": "wrong"},
+		FailingNodes: []string{"auth"},
+		MaxSteps:     6,
+	})
+
+	s.Equal(SimulationStatusStepLimitReached, result.Status)
+	s.Len(result.Steps, 6)
+	s.Equal(SimulationOutcomeFailure, result.Steps[1].Outcome)
+	s.Equal("login", result.Steps[1].NextNode)
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_FailingNodeWithoutOnFailure() {
+	nodes := minimalValidNodes()
+
+	result := simulateFlow(nodes, &FlowSimulationRequest{FailingNodes: []string{"task"}})
+
+	s.Equal(SimulationStatusFailed, result.Status)
+	s.Equal([]string{"start", "task"}, stepNodeIDs(result))
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_ConditionSkipsNode() {
+	nodes := minimalValidNodes()
+	nodes[1].Condition = &providers.ConditionDefinition{Key: "mfa", Value: "enabled", OnSkip: "end"}
+
+	skipped := simulateFlow(nodes, &FlowSimulationRequest{})
+	matched := simulateFlow(nodes, &FlowSimulationRequest{Inputs: map[string]string{"mfa": "enabled"}})
+
+	s.Equal(SimulationOutcomeSkipped, skipped.Steps[1].Outcome)
+	s.Empty(skipped.Steps[1].Executor)
+	s.Equal(SimulationOutcomeSuccess, matched.Steps[1].Outcome)
+	s.Equal("test-executor", matched.Steps[1].Executor)
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_DecisionNode() {
+	nodes := []providers.NodeDefinition{
+		{ID: "start", Type: string(common.NodeTypeStart), OnSuccess: "decision"},
+		*validDecisionNode(),
+		{ID: "mfa", Type: string(common.NodeTypeTaskExecution),
+			Executor: &providers.ExecutorDefinition{Name: "SMSOTPAuthExecutor"}, OnSuccess: "end"},
+		{ID: "end", Type: string(common.NodeTypeEnd)},
+	}
+
+	admin := simulateFlow(nodes, &FlowSimulationRequest{Inputs: map[string]string{"userType": "admin"}})
+	customer := simulateFlow(nodes, &FlowSimulationRequest{Inputs: map[string]string{"userType": "customer"}})
+
+	s.Equal(SimulationStatusCompleted, admin.Status)
+	s.Equal([]string{"start", "decision", "mfa", "end"}, stepNodeIDs(admin))
+	s.Equal(SimulationOutcomeSuccess, admin.Steps[1].Outcome)
+	s.Equal("mfa", admin.Steps[1].NextNode)
+	s.Equal(SimulationStatusCompleted, customer.Status)
+	s.Equal([]string{"start", "decision", "end"}, stepNodeIDs(customer))
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_ParallelBranchesAndJoin() {
+	result := simulateFlow(parallelFlowNodes(), &FlowSimulationRequest{})
+
+	s.Equal(SimulationStatusCompleted, result.Status)
+	s.Equal([]string{"start", "parallel", "send-email", "send-sms", "join", "end"}, stepNodeIDs(result))
+	s.Equal("join", result.Steps[1].NextNode)
+	s.Equal(SimulationOutcomeSuccess, result.Steps[4].Outcome)
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_JoinModes() {
+	anyNodes := parallelFlowNodes()
+	oneFailing := simulateFlow(anyNodes, &FlowSimulationRequest{FailingNodes: []string{"send-sms"}})
+	s.Equal(SimulationOutcomeSuccess, oneFailing.Steps[4].Outcome)
+
+	allNodes := parallelFlowNodes()
+	allNodes[4].Join.Mode = providers.JoinModeAll
+	allNodes[4].OnFailure = ""
+	allFailing := simulateFlow(allNodes, &FlowSimulationRequest{FailingNodes: []string{"send-sms"}})
+	s.Equal(SimulationStatusFailed, allFailing.Status)
+	s.Equal(SimulationOutcomeFailure, allFailing.Steps[4].Outcome)
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_DanglingReference() {
+	nodes := minimalValidNodes()
+	nodes[1].OnSuccess = "missing"
+
+	result := simulateFlow(nodes, &FlowSimulationRequest{})
+
+	s.Equal(SimulationStatusHalted, result.Status)
+	s.Equal([]string{"start", "task", "missing"}, stepNodeIDs(result))
+	s.Equal(SimulationOutcomeNodeNotFound, result.Steps[2].Outcome)
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_NoStartNode() {
+	result := simulateFlow(minimalValidNodes()[1:], &FlowSimulationRequest{})
+
+	s.Equal(SimulationStatusHalted, result.Status)
+	s.Empty(result.Steps)
+}
+
+func (s *SimulatorTestSuite) TestSimulateFlow_MaxStepsIsCapped() {
+	nodes := minimalValidNodes()
+	nodes[1].OnSuccess = "task"
+
+	result := simulateFlow(nodes, &FlowSimulationRequest{MaxSteps: maxSimulationMaxSteps + 1})
+
+	s.Equal(SimulationStatusStepLimitReached, result.Status)
+	s.Len(result.Steps, maxSimulationMaxSteps)
+}
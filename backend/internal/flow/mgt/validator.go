@@ -21,12 +21,15 @@ package flowmgt
 import (
 	"context"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
 	"github.com/thunder-id/thunderid/internal/flow/graphbuilder"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
+	"github.com/thunder-id/thunderid/internal/system/featureflag"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
@@ -37,6 +40,9 @@ import (
 type FlowValidatorInterface interface {
 	// ValidateFlowDefinition validates the provided flow definition and returns a ServiceError if validation fails.
 	ValidateFlowDefinition(ctx context.Context, flowDef *FlowDefinition) *tidcommon.ServiceError
+	// AnalyzeFlowDefinition inspects the provided flow definition and reports every issue found
+	// instead of stopping at the first one.
+	AnalyzeFlowDefinition(ctx context.Context, flowDef *FlowDefinition) []FlowValidationIssue
 }
 
 // flowValidator is responsible for validating flow definitions,
@@ -75,6 +81,10 @@ func (v *flowValidator) ValidateFlowDefinition(
 		return err
 	}
 
+	if err := v.validateEnabledNodeTypes(ctx, flowDef.Nodes); err != nil {
+		return err
+	}
+
 	if err := v.validateNodes(flowDef.Nodes, nodeIndex); err != nil {
 		return err
 	}
@@ -202,6 +212,26 @@ func buildNodeIndex(nodes []providers.NodeDefinition) (map[string]*providers.Nod
 	return index, nil
 }
 
+// validateEnabledNodeTypes checks that node types under incremental rollout are enabled for the
+// deployment.
+func (v *flowValidator) validateEnabledNodeTypes(
+	ctx context.Context, nodes []providers.NodeDefinition,
+) *tidcommon.ServiceError {
+	for _, node := range nodes {
+		if node.Type != string(common.NodeTypeParallel) && node.Type != string(common.NodeTypeJoin) {
+			continue
+		}
+		if !featureflag.IsEnabled(ctx, featureflag.ParallelFlowNodes, "") {
+			return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+				Key:          "error.flowmgtservice.node_type_not_enabled_description",
+				DefaultValue: "Node '{{param(nodeID)}}' has type '{{param(type)}}', which is not enabled",
+				Params:       map[string]string{"nodeID": node.ID, "type": node.Type},
+			})
+		}
+	}
+	return nil
+}
+
 // validateNodeTypesAndCardinality checks that every node has a valid type
 // and that exactly one START and one END node exist.
 func (v *flowValidator) validateNodeTypesAndCardinality(nodes []providers.NodeDefinition) *tidcommon.ServiceError {
@@ -269,6 +299,11 @@ func collectAllNodeReferences(nodes []providers.NodeDefinition) []nodeReference
 				sourceNodeID: node.ID, targetNodeID: node.OnIncomplete, fieldName: "onIncomplete",
 			})
 		}
+		if node.OnCircuitOpen != "" {
+			refs = append(refs, nodeReference{
+				sourceNodeID: node.ID, targetNodeID: node.OnCircuitOpen, fieldName: "onCircuitOpen",
+			})
+		}
 		if node.Next != "" {
 			refs = append(refs, nodeReference{
 				sourceNodeID: node.ID, targetNodeID: node.Next, fieldName: "next",
@@ -286,6 +321,34 @@ func collectAllNodeReferences(nodes []providers.NodeDefinition) []nodeReference
 				})
 			}
 		}
+		if node.Decision != nil {
+			for _, branch := range node.Decision.Branches {
+				if branch.Next != "" {
+					refs = append(refs, nodeReference{
+						sourceNodeID: node.ID, targetNodeID: branch.Next, fieldName: "decision.branches.next",
+					})
+				}
+			}
+			if node.Decision.Default != "" {
+				refs = append(refs, nodeReference{
+					sourceNodeID: node.ID, targetNodeID: node.Decision.Default, fieldName: "decision.default",
+				})
+			}
+		}
+		if node.Parallel != nil {
+			for _, branch := range node.Parallel.Branches {
+				if branch != "" {
+					refs = append(refs, nodeReference{
+						sourceNodeID: node.ID, targetNodeID: branch, fieldName: "parallel.branches",
+					})
+				}
+			}
+			if node.Parallel.Join != "" {
+				refs = append(refs, nodeReference{
+					sourceNodeID: node.ID, targetNodeID: node.Parallel.Join, fieldName: "parallel.join",
+				})
+			}
+		}
 	}
 	return refs
 }
@@ -321,8 +384,17 @@ func (v *flowValidator) validateReachability(nodes []providers.NodeDefinition) *
 		}
 	}
 
-	adjacency := buildAdjacencyList(nodes)
+	visited := findReachableNodes(startNodeID, buildAdjacencyList(nodes))
+	for _, node := range nodes {
+		if !visited[node.ID] {
+			return tidcommon.CustomServiceError(ErrorInvalidFlowStructure, orphanedNodeMessage(node.ID))
+		}
+	}
+	return nil
+}
 
+// findReachableNodes returns the set of node IDs reachable from the given node via BFS.
+func findReachableNodes(startNodeID string, adjacency map[string][]string) map[string]bool {
 	visited := make(map[string]bool)
 	queue := []string{startNodeID}
 	visited[startNodeID] = true
@@ -337,17 +409,16 @@ func (v *flowValidator) validateReachability(nodes []providers.NodeDefinition) *
 			}
 		}
 	}
+	return visited
+}
 
-	for _, node := range nodes {
-		if !visited[node.ID] {
-			return tidcommon.CustomServiceError(ErrorInvalidFlowStructure, tidcommon.I18nMessage{
-				Key:          "error.flowmgtservice.orphaned_node_description",
-				DefaultValue: "Node '{{param(nodeID)}}' is not reachable from the START node",
-				Params:       map[string]string{"nodeID": node.ID},
-			})
-		}
+// orphanedNodeMessage builds the message reported for a node that is not reachable from START.
+func orphanedNodeMessage(nodeID string) tidcommon.I18nMessage {
+	return tidcommon.I18nMessage{
+		Key:          "error.flowmgtservice.orphaned_node_description",
+		DefaultValue: "Node '{{param(nodeID)}}' is not reachable from the START node",
+		Params:       map[string]string{"nodeID": nodeID},
 	}
-	return nil
 }
 
 // validateTermination checks that all reachable nodes can reach the END node via reverse BFS.
@@ -408,6 +479,9 @@ func buildAdjacencyList(nodes []providers.NodeDefinition) map[string][]string {
 		if node.OnIncomplete != "" {
 			adj[node.ID] = append(adj[node.ID], node.OnIncomplete)
 		}
+		if node.OnCircuitOpen != "" {
+			adj[node.ID] = append(adj[node.ID], node.OnCircuitOpen)
+		}
 		if node.Next != "" {
 			adj[node.ID] = append(adj[node.ID], node.Next)
 		}
@@ -419,6 +493,26 @@ func buildAdjacencyList(nodes []providers.NodeDefinition) map[string][]string {
 				adj[node.ID] = append(adj[node.ID], prompt.Action.NextNode)
 			}
 		}
+		if node.Decision != nil {
+			for _, branch := range node.Decision.Branches {
+				if branch.Next != "" {
+					adj[node.ID] = append(adj[node.ID], branch.Next)
+				}
+			}
+			if node.Decision.Default != "" {
+				adj[node.ID] = append(adj[node.ID], node.Decision.Default)
+			}
+		}
+		if node.Parallel != nil {
+			for _, branch := range node.Parallel.Branches {
+				if branch != "" {
+					adj[node.ID] = append(adj[node.ID], branch)
+				}
+			}
+			if node.Parallel.Join != "" {
+				adj[node.ID] = append(adj[node.ID], node.Parallel.Join)
+			}
+		}
 	}
 	return adj
 }
@@ -450,6 +544,35 @@ func (v *flowValidator) validateNodes(
 func (v *flowValidator) validateNodeFormat(
 	node *providers.NodeDefinition, nodeIndex map[string]*providers.NodeDefinition,
 ) *tidcommon.ServiceError {
+	if node.Decision != nil && node.Type != string(common.NodeTypeDecision) {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.decision_on_non_decision_node_description",
+			DefaultValue: "Node '{{param(nodeID)}}' must not have a decision unless its type is DECISION",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Parallel != nil && node.Type != string(common.NodeTypeParallel) {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.parallel_on_non_parallel_node_description",
+			DefaultValue: "Node '{{param(nodeID)}}' must not have parallel branches unless its type is PARALLEL",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Join != nil && node.Type != string(common.NodeTypeJoin) {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.join_on_non_join_node_description",
+			DefaultValue: "Node '{{param(nodeID)}}' must not have a join mode unless its type is JOIN",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.OnCircuitOpen != "" && node.Type != string(common.NodeTypeTaskExecution) {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.circuit_open_on_non_task_node_description",
+			DefaultValue: "Node '{{param(nodeID)}}' must not have onCircuitOpen unless its type is TASK_EXECUTION",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+
 	switch node.Type {
 	case string(common.NodeTypeStart):
 		return v.validateStartNode(node)
@@ -461,6 +584,12 @@ func (v *flowValidator) validateNodeFormat(
 		return v.validatePromptNode(node)
 	case string(common.NodeTypeCall):
 		return v.validateCallNode(node)
+	case string(common.NodeTypeDecision):
+		return v.validateDecisionNode(node)
+	case string(common.NodeTypeParallel):
+		return v.validateParallelNode(node, nodeIndex)
+	case string(common.NodeTypeJoin):
+		return v.validateJoinNode(node)
 	}
 	return nil
 }
@@ -557,11 +686,7 @@ func (v *flowValidator) validateTaskExecutionNode(
 	node *providers.NodeDefinition, nodeIndex map[string]*providers.NodeDefinition,
 ) *tidcommon.ServiceError {
 	if node.Executor == nil || node.Executor.Name == "" {
-		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
-			Key:          "error.flowmgtservice.task_node_missing_executor_description",
-			DefaultValue: "TASK_EXECUTION node '{{param(nodeID)}}' must have an executor with a non-empty name",
-			Params:       map[string]string{"nodeID": node.ID},
-		})
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, missingExecutorMessage(node.ID))
 	}
 	if node.OnSuccess == "" {
 		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
@@ -570,6 +695,36 @@ func (v *flowValidator) validateTaskExecutionNode(
 			Params:       map[string]string{"nodeID": node.ID},
 		})
 	}
+	if node.Executor.RetryCount < 0 || node.Executor.RetryDelay < 0 || node.Executor.Timeout < 0 {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key: "error.flowmgtservice.task_node_negative_execution_policy_description",
+			DefaultValue: "TASK_EXECUTION node '{{param(nodeID)}}': retryCount, retryDelay and timeout " +
+				"must not be negative",
+			Params: map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Executor.RetryCount > maxExecutorRetryCount {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.task_node_retry_count_exceeded_description",
+			DefaultValue: "TASK_EXECUTION node '{{param(nodeID)}}': retryCount must not exceed {{param(max)}}",
+			Params:       map[string]string{"nodeID": node.ID, "max": strconv.Itoa(maxExecutorRetryCount)},
+		})
+	}
+	if cb := node.Executor.CircuitBreaker; cb != nil && (cb.FailureThreshold < 0 || cb.OpenDuration < 0) {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key: "error.flowmgtservice.task_node_negative_circuit_breaker_description",
+			DefaultValue: "TASK_EXECUTION node '{{param(nodeID)}}': circuitBreaker failureThreshold and " +
+				"openDuration must not be negative",
+			Params: map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.OnCircuitOpen != "" && node.Executor.CircuitBreaker == nil {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.task_node_circuit_open_without_breaker_description",
+			DefaultValue: "TASK_EXECUTION node '{{param(nodeID)}}': onCircuitOpen requires an executor circuitBreaker",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
 	if node.OnFailure != "" {
 		if target, ok := nodeIndex[node.OnFailure]; ok && target.Type != string(common.NodeTypePrompt) {
 			return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
@@ -593,6 +748,15 @@ func (v *flowValidator) validateTaskExecutionNode(
 	return nil
 }
 
+// missingExecutorMessage builds the message reported for a TASK_EXECUTION node without an executor.
+func missingExecutorMessage(nodeID string) tidcommon.I18nMessage {
+	return tidcommon.I18nMessage{
+		Key:          "error.flowmgtservice.task_node_missing_executor_description",
+		DefaultValue: "TASK_EXECUTION node '{{param(nodeID)}}' must have an executor with a non-empty name",
+		Params:       map[string]string{"nodeID": nodeID},
+	}
+}
+
 // validatePromptNode validates a PROMPT node by dispatching to the appropriate sub-validator.
 func (v *flowValidator) validatePromptNode(node *providers.NodeDefinition) *tidcommon.ServiceError {
 	hasPrompts := len(node.Prompts) > 0
@@ -699,13 +863,29 @@ func (v *flowValidator) validateValidationRules(
 
 // validateCallNode validates the format of a CALL node.
 func (v *flowValidator) validateCallNode(node *providers.NodeDefinition) *tidcommon.ServiceError {
-	if node.Flow == nil || node.Flow.Ref == "" {
+	if node.Flow == nil || (node.Flow.Ref == "" && node.Flow.Handle == "") {
 		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
 			Key:          "error.flowmgtservice.call_node_missing_flow_ref_description",
-			DefaultValue: "CALL node '{{param(nodeID)}}' must have a flow reference with a non-empty ref",
+			DefaultValue: "CALL node '{{param(nodeID)}}' must have a flow reference with a non-empty ref or handle",
 			Params:       map[string]string{"nodeID": node.ID},
 		})
 	}
+	if node.Flow.Ref != "" && node.Flow.Handle != "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.call_node_ambiguous_flow_ref_description",
+			DefaultValue: "CALL node '{{param(nodeID)}}' must reference a flow by either ref or handle, not both",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	for _, output := range node.Flow.Outputs {
+		if output == "" {
+			return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+				Key:          "error.flowmgtservice.call_node_empty_output_description",
+				DefaultValue: "CALL node '{{param(nodeID)}}' must not have empty output keys",
+				Params:       map[string]string{"nodeID": node.ID},
+			})
+		}
+	}
 	if node.OnSuccess == "" {
 		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
 			Key:          "error.flowmgtservice.call_node_missing_on_success_description",
@@ -744,6 +924,169 @@ func (v *flowValidator) validateCallNode(node *providers.NodeDefinition) *tidcom
 	return nil
 }
 
+// validateDecisionNode validates the format of a DECISION node.
+func (v *flowValidator) validateDecisionNode(node *providers.NodeDefinition) *tidcommon.ServiceError {
+	if node.Decision == nil || len(node.Decision.Branches) == 0 {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.decision_node_missing_branches_description",
+			DefaultValue: "DECISION node '{{param(nodeID)}}' must have at least one branch",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Decision.Default == "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.decision_node_missing_default_description",
+			DefaultValue: "DECISION node '{{param(nodeID)}}' must have a default node",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Executor != nil || len(node.Prompts) > 0 || node.Flow != nil || node.OnSuccess != "" ||
+		node.OnFailure != "" || node.OnIncomplete != "" || node.Next != "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key: "error.flowmgtservice.decision_node_has_unsupported_fields_description",
+			DefaultValue: "DECISION node '{{param(nodeID)}}' must only route through its decision branches " +
+				"and default node",
+			Params: map[string]string{"nodeID": node.ID},
+		})
+	}
+
+	for i, branch := range node.Decision.Branches {
+		if branch.Next == "" {
+			return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+				Key:          "error.flowmgtservice.decision_branch_missing_next_description",
+				DefaultValue: "DECISION node '{{param(nodeID)}}': branch at index {{param(index)}} must have next",
+				Params:       map[string]string{"nodeID": node.ID, "index": strconv.Itoa(i)},
+			})
+		}
+		if len(branch.Conditions) == 0 {
+			return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+				Key: "error.flowmgtservice.decision_branch_missing_conditions_description",
+				DefaultValue: "DECISION node '{{param(nodeID)}}': branch at index {{param(index)}} " +
+					"must have at least one condition",
+				Params: map[string]string{"nodeID": node.ID, "index": strconv.Itoa(i)},
+			})
+		}
+		for _, condition := range branch.Conditions {
+			if err := v.validateDecisionCondition(node.ID, i, condition); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateParallelNode validates the format of a PARALLEL node and that each of its branches is a chain
+// of TASK_EXECUTION nodes, linked through onSuccess, that ends at the join node.
+func (v *flowValidator) validateParallelNode(
+	node *providers.NodeDefinition, nodeIndex map[string]*providers.NodeDefinition,
+) *tidcommon.ServiceError {
+	if node.Parallel == nil || len(node.Parallel.Branches) < 2 {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.parallel_node_missing_branches_description",
+			DefaultValue: "PARALLEL node '{{param(nodeID)}}' must have at least two branches",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Parallel.Join == "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.parallel_node_missing_join_description",
+			DefaultValue: "PARALLEL node '{{param(nodeID)}}' must have a join node",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Executor != nil || len(node.Prompts) > 0 || node.Flow != nil || node.OnSuccess != "" ||
+		node.OnFailure != "" || node.OnIncomplete != "" || node.Next != "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key: "error.flowmgtservice.parallel_node_has_unsupported_fields_description",
+			DefaultValue: "PARALLEL node '{{param(nodeID)}}' must only route through its branches " +
+				"and join node",
+			Params: map[string]string{"nodeID": node.ID},
+		})
+	}
+	if joinNode, exists := nodeIndex[node.Parallel.Join]; exists && joinNode.Type != string(common.NodeTypeJoin) {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.parallel_node_invalid_join_description",
+			DefaultValue: "PARALLEL node '{{param(nodeID)}}' must reference a JOIN node as its join node",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+
+	for _, branch := range node.Parallel.Branches {
+		visited := make(map[string]bool)
+		for nodeID := branch; nodeID != node.Parallel.Join; {
+			branchNode, exists := nodeIndex[nodeID]
+			if !exists {
+				// Dangling references are reported by the reference validation.
+				break
+			}
+			if visited[nodeID] || branchNode.Type != string(common.NodeTypeTaskExecution) ||
+				branchNode.OnSuccess == "" {
+				return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+					Key: "error.flowmgtservice.parallel_node_invalid_branch_description",
+					DefaultValue: "PARALLEL node '{{param(nodeID)}}': branch '{{param(branch)}}' must be a chain " +
+						"of TASK_EXECUTION nodes that reaches the join node through onSuccess",
+					Params: map[string]string{"nodeID": node.ID, "branch": branch},
+				})
+			}
+			visited[nodeID] = true
+			nodeID = branchNode.OnSuccess
+		}
+	}
+	return nil
+}
+
+// validateJoinNode validates the format of a JOIN node.
+func (v *flowValidator) validateJoinNode(node *providers.NodeDefinition) *tidcommon.ServiceError {
+	if node.OnSuccess == "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.join_node_missing_on_success_description",
+			DefaultValue: "JOIN node '{{param(nodeID)}}' must have onSuccess",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	if node.Join != nil && node.Join.Mode != "" && !providers.ValidJoinModes[node.Join.Mode] {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.join_node_invalid_mode_description",
+			DefaultValue: "JOIN node '{{param(nodeID)}}' has invalid mode '{{param(mode)}}'",
+			Params:       map[string]string{"nodeID": node.ID, "mode": string(node.Join.Mode)},
+		})
+	}
+	if node.Executor != nil || len(node.Prompts) > 0 || node.Flow != nil || node.OnIncomplete != "" ||
+		node.Next != "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key:          "error.flowmgtservice.join_node_has_unsupported_fields_description",
+			DefaultValue: "JOIN node '{{param(nodeID)}}' must only route through onSuccess and onFailure",
+			Params:       map[string]string{"nodeID": node.ID},
+		})
+	}
+	return nil
+}
+
+// validateDecisionCondition validates a single condition of a DECISION node branch.
+func (v *flowValidator) validateDecisionCondition(
+	nodeID string, index int, condition providers.DecisionConditionDefinition,
+) *tidcommon.ServiceError {
+	if condition.Key == "" {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key: "error.flowmgtservice.decision_condition_missing_key_description",
+			DefaultValue: "DECISION node '{{param(nodeID)}}': conditions of branch at index " +
+				"{{param(index)}} must have a key",
+			Params: map[string]string{"nodeID": nodeID, "index": strconv.Itoa(index)},
+		})
+	}
+	if !providers.ValidDecisionOperators[condition.Operator] {
+		return tidcommon.CustomServiceError(ErrorInvalidNodeConfig, tidcommon.I18nMessage{
+			Key: "error.flowmgtservice.decision_condition_invalid_operator_description",
+			DefaultValue: "DECISION node '{{param(nodeID)}}': branch at index {{param(index)}} " +
+				"has invalid operator '{{param(operator)}}'",
+			Params: map[string]string{
+				"nodeID": nodeID, "index": strconv.Itoa(index), "operator": string(condition.Operator),
+			},
+		})
+	}
+	return nil
+}
+
 // ---------------------------------------------------------------------------
 // Scope: Executor validation
 // ---------------------------------------------------------------------------
@@ -871,3 +1214,218 @@ func (v *flowValidator) validateInterceptorApplyTo(
 	}
 	return nil
 }
+
+// ---------------------------------------------------------------------------
+// Scope: Analysis (reports every issue instead of failing fast)
+// ---------------------------------------------------------------------------
+
+// AnalyzeFlowDefinition reports unreachable nodes, missing executors, dangling references and cycles
+// that never yield to the user. When none of these are found, the full validation is run as well so
+// that a definition reported as valid can also be persisted.
+func (v *flowValidator) AnalyzeFlowDefinition(
+	ctx context.Context, flowDef *FlowDefinition,
+) []FlowValidationIssue {
+	issues := make([]FlowValidationIssue, 0)
+	if flowDef == nil {
+		return append(issues, newDefinitionIssue(&ErrorInvalidRequestFormat))
+	}
+
+	nodeIndex, err := buildNodeIndex(flowDef.Nodes)
+	if err != nil {
+		return append(issues, newDefinitionIssue(err))
+	}
+	if err := v.validateNodeTypesAndCardinality(flowDef.Nodes); err != nil {
+		return append(issues, newDefinitionIssue(err))
+	}
+
+	issues = append(issues, v.findDanglingReferences(flowDef.Nodes, nodeIndex)...)
+	issues = append(issues, findUnreachableNodes(flowDef.Nodes)...)
+	issues = append(issues, v.findMissingExecutors(flowDef.Nodes)...)
+	issues = append(issues, findCyclesWithoutPrompt(flowDef.Nodes, nodeIndex)...)
+
+	if len(issues) == 0 {
+		if err := v.ValidateFlowDefinition(ctx, flowDef); err != nil {
+			issues = append(issues, newDefinitionIssue(err))
+		}
+	}
+	return issues
+}
+
+// newDefinitionIssue converts a fail-fast validation error into a validation issue.
+func newDefinitionIssue(svcErr *tidcommon.ServiceError) FlowValidationIssue {
+	return FlowValidationIssue{
+		Type:    IssueTypeInvalidDefinition,
+		NodeID:  svcErr.ErrorDescription.Params["nodeID"],
+		Message: svcErr.ErrorDescription,
+	}
+}
+
+// findDanglingReferences reports every node reference that points to a non-existent node.
+func (v *flowValidator) findDanglingReferences(
+	nodes []providers.NodeDefinition, nodeIndex map[string]*providers.NodeDefinition,
+) []FlowValidationIssue {
+	var issues []FlowValidationIssue
+	for _, ref := range collectAllNodeReferences(nodes) {
+		if err := v.validateNodeReferences([]nodeReference{ref}, nodeIndex); err != nil {
+			issues = append(issues, FlowValidationIssue{
+				Type:    IssueTypeDanglingReference,
+				NodeID:  ref.sourceNodeID,
+				Message: err.ErrorDescription,
+			})
+		}
+	}
+	return issues
+}
+
+// findUnreachableNodes reports every node that cannot be reached from the START node.
+func findUnreachableNodes(nodes []providers.NodeDefinition) []FlowValidationIssue {
+	var startNodeID string
+	for _, node := range nodes {
+		if node.Type == string(common.NodeTypeStart) {
+			startNodeID = node.ID
+			break
+		}
+	}
+
+	var issues []FlowValidationIssue
+	visited := findReachableNodes(startNodeID, buildAdjacencyList(nodes))
+	for _, node := range nodes {
+		if !visited[node.ID] {
+			issues = append(issues, FlowValidationIssue{
+				Type:    IssueTypeUnreachableNode,
+				NodeID:  node.ID,
+				Message: orphanedNodeMessage(node.ID),
+			})
+		}
+	}
+	return issues
+}
+
+// findMissingExecutors reports TASK_EXECUTION nodes whose executor is absent or not registered.
+func (v *flowValidator) findMissingExecutors(nodes []providers.NodeDefinition) []FlowValidationIssue {
+	var issues []FlowValidationIssue
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Type != string(common.NodeTypeTaskExecution) {
+			continue
+		}
+		if node.Executor == nil || node.Executor.Name == "" {
+			issues = append(issues, FlowValidationIssue{
+				Type:    IssueTypeMissingExecutor,
+				NodeID:  node.ID,
+				Message: missingExecutorMessage(node.ID),
+			})
+			continue
+		}
+		if err := v.validateExecutors(node); err != nil {
+			issues = append(issues, FlowValidationIssue{
+				Type:    IssueTypeMissingExecutor,
+				NodeID:  node.ID,
+				Message: err.ErrorDescription,
+			})
+		}
+	}
+	return issues
+}
+
+// findCyclesWithoutPrompt reports cycles in the flow graph that never pass through a PROMPT node.
+// Cycles through a PROMPT node are legitimate retry loops since they wait for user input, while any
+// other cycle would keep executing at runtime without ever returning to the user.
+func findCyclesWithoutPrompt(
+	nodes []providers.NodeDefinition, nodeIndex map[string]*providers.NodeDefinition,
+) []FlowValidationIssue {
+	order := make(map[string]int, len(nodes))
+	for i, node := range nodes {
+		order[node.ID] = i
+	}
+
+	var issues []FlowValidationIssue
+	for _, component := range findStronglyConnectedNodes(nodes, nodeIndex) {
+		hasPrompt := false
+		for _, nodeID := range component {
+			if nodeIndex[nodeID].Type == string(common.NodeTypePrompt) {
+				hasPrompt = true
+				break
+			}
+		}
+		if hasPrompt {
+			continue
+		}
+
+		sort.Slice(component, func(i, j int) bool { return order[component[i]] < order[component[j]] })
+		issues = append(issues, FlowValidationIssue{
+			Type:   IssueTypeCyclicPath,
+			NodeID: component[0],
+			Message: tidcommon.I18nMessage{
+				Key:          "error.flowmgtservice.cyclic_path_without_prompt_description",
+				DefaultValue: "Nodes {{param(nodeIDs)}} form a cycle that never reaches a PROMPT node",
+				Params:       map[string]string{"nodeIDs": strings.Join(component, ", ")},
+			},
+		})
+	}
+	sort.Slice(issues, func(i, j int) bool { return order[issues[i].NodeID] < order[issues[j].NodeID] })
+	return issues
+}
+
+// findStronglyConnectedNodes returns the groups of nodes that form cycles using Tarjan's algorithm.
+// Single nodes are only included when they reference themselves.
+func findStronglyConnectedNodes(
+	nodes []providers.NodeDefinition, nodeIndex map[string]*providers.NodeDefinition,
+) [][]string {
+	adjacency := buildAdjacencyList(nodes)
+	index := make(map[string]int, len(nodes))
+	lowLink := make(map[string]int, len(nodes))
+	onStack := make(map[string]bool, len(nodes))
+	var stack []string
+	var components [][]string
+	counter := 0
+
+	var visit func(nodeID string)
+	visit = func(nodeID string) {
+		index[nodeID] = counter
+		lowLink[nodeID] = counter
+		counter++
+		stack = append(stack, nodeID)
+		onStack[nodeID] = true
+
+		selfLoop := false
+		for _, target := range adjacency[nodeID] {
+			if _, exists := nodeIndex[target]; !exists {
+				continue
+			}
+			if target == nodeID {
+				selfLoop = true
+			}
+			if _, visited := index[target]; !visited {
+				visit(target)
+				lowLink[nodeID] = min(lowLink[nodeID], lowLink[target])
+			} else if onStack[target] {
+				lowLink[nodeID] = min(lowLink[nodeID], index[target])
+			}
+		}
+
+		if lowLink[nodeID] != index[nodeID] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == nodeID {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			components = append(components, component)
+		}
+	}
+
+	for _, node := range nodes {
+		if _, visited := index[node.ID]; !visited {
+			visit(node.ID)
+		}
+	}
+	return components
+}
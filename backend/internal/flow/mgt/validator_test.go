@@ -27,6 +27,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/interceptor"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/executormock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/interceptormock"
@@ -587,6 +588,125 @@ func (s *ValidatorTestSuite) TestValidateTaskExecutionNode_MissingOnSuccess() {
 	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
 }
 
+func (s *ValidatorTestSuite) TestValidateTaskExecutionNode_ValidExecutionPolicy() {
+	nodes := minimalValidNodes()
+	index, _ := buildNodeIndex(nodes)
+	node := &providers.NodeDefinition{
+		ID:   "task",
+		Type: string(common.NodeTypeTaskExecution),
+		Executor: &providers.ExecutorDefinition{
+			Name: "exec", RetryCount: 3, RetryDelay: 500, Timeout: 10000},
+		OnSuccess: "end",
+	}
+	err := s.v.validateTaskExecutionNode(node, index)
+	s.Nil(err)
+}
+
+func (s *ValidatorTestSuite) TestValidateTaskExecutionNode_NegativeExecutionPolicy() {
+	nodes := minimalValidNodes()
+	index, _ := buildNodeIndex(nodes)
+	node := &providers.NodeDefinition{
+		ID:        "task",
+		Type:      string(common.NodeTypeTaskExecution),
+		Executor:  &providers.ExecutorDefinition{Name: "exec", Timeout: -1},
+		OnSuccess: "end",
+	}
+	err := s.v.validateTaskExecutionNode(node, index)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "must not be negative")
+}
+
+func (s *ValidatorTestSuite) TestValidateTaskExecutionNode_RetryCountExceeded() {
+	nodes := minimalValidNodes()
+	index, _ := buildNodeIndex(nodes)
+	node := &providers.NodeDefinition{
+		ID:        "task",
+		Type:      string(common.NodeTypeTaskExecution),
+		Executor:  &providers.ExecutorDefinition{Name: "exec", RetryCount: maxExecutorRetryCount + 1},
+		OnSuccess: "end",
+	}
+	err := s.v.validateTaskExecutionNode(node, index)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.String(), "must not exceed 10")
+}
+
+func (s *ValidatorTestSuite) TestValidateTaskExecutionNode_ValidCircuitBreaker() {
+	nodes := minimalValidNodes()
+	index, _ := buildNodeIndex(nodes)
+	node := &providers.NodeDefinition{
+		ID:   "task",
+		Type: string(common.NodeTypeTaskExecution),
+		Executor: &providers.ExecutorDefinition{
+			Name:           "exec",
+			CircuitBreaker: &providers.CircuitBreakerDefinition{FailureThreshold: 3, OpenDuration: 60000},
+		},
+		OnSuccess:     "end",
+		OnCircuitOpen: "end",
+	}
+	err := s.v.validateTaskExecutionNode(node, index)
+	s.Nil(err)
+}
+
+func (s *ValidatorTestSuite) TestValidateTaskExecutionNode_NegativeCircuitBreaker() {
+	nodes := minimalValidNodes()
+	index, _ := buildNodeIndex(nodes)
+	node := &providers.NodeDefinition{
+		ID:   "task",
+		Type: string(common.NodeTypeTaskExecution),
+		Executor: &providers.ExecutorDefinition{
+			Name:           "exec",
+			CircuitBreaker: &providers.CircuitBreakerDefinition{FailureThreshold: -1},
+		},
+		OnSuccess: "end",
+	}
+	err := s.v.validateTaskExecutionNode(node, index)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "must not be negative")
+}
+
+func (s *ValidatorTestSuite) TestValidateTaskExecutionNode_OnCircuitOpenWithoutCircuitBreaker() {
+	nodes := minimalValidNodes()
+	index, _ := buildNodeIndex(nodes)
+	node := &providers.NodeDefinition{
+		ID:            "task",
+		Type:          string(common.NodeTypeTaskExecution),
+		Executor:      &providers.ExecutorDefinition{Name: "exec"},
+		OnSuccess:     "end",
+		OnCircuitOpen: "end",
+	}
+	err := s.v.validateTaskExecutionNode(node, index)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "requires an executor circuitBreaker")
+}
+
+func (s *ValidatorTestSuite) TestValidateNodeFormat_OnCircuitOpenOnNonTaskNode() {
+	nodes := minimalValidNodes()
+	index, _ := buildNodeIndex(nodes)
+	node := &providers.NodeDefinition{
+		ID:            "start",
+		Type:          string(common.NodeTypeStart),
+		OnSuccess:     "end",
+		OnCircuitOpen: "end",
+	}
+	err := s.v.validateNodeFormat(node, index)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "must not have onCircuitOpen")
+}
+
+func (s *ValidatorTestSuite) TestCollectAllNodeReferences_IncludesOnCircuitOpen() {
+	nodes := []providers.NodeDefinition{
+		{ID: "task", Type: string(common.NodeTypeTaskExecution), OnCircuitOpen: "fallback"},
+	}
+	refs := collectAllNodeReferences(nodes)
+	s.Contains(refs, nodeReference{sourceNodeID: "task", targetNodeID: "fallback", fieldName: "onCircuitOpen"})
+	s.Equal([]string{"fallback"}, buildAdjacencyList(nodes)["task"])
+}
+
 func (s *ValidatorTestSuite) TestValidateTaskExecutionNode_OnFailurePointsToNonPrompt() {
 	nodes := []providers.NodeDefinition{
 		{ID: "start", Type: string(common.NodeTypeStart), OnSuccess: "task"},
@@ -776,6 +896,44 @@ func (s *ValidatorTestSuite) TestValidateCallNode_EmptyFlowRef() {
 	s.Contains(err.ErrorDescription.DefaultValue, "flow reference")
 }
 
+func (s *ValidatorTestSuite) TestValidateCallNode_ValidWithHandleAndOutputs() {
+	node := &providers.NodeDefinition{
+		ID:   "call-sub",
+		Type: string(common.NodeTypeCall),
+		Flow: &providers.FlowReferenceDefinition{
+			Handle: "mfa-subflow", Outputs: []string{"mfaMethod"}},
+		OnSuccess: "end",
+	}
+	err := s.v.validateCallNode(node)
+	s.Nil(err)
+}
+
+func (s *ValidatorTestSuite) TestValidateCallNode_RefAndHandle() {
+	node := &providers.NodeDefinition{
+		ID:        "call-sub",
+		Type:      string(common.NodeTypeCall),
+		Flow:      &providers.FlowReferenceDefinition{Ref: "sub-flow-id", Handle: "mfa-subflow"},
+		OnSuccess: "end",
+	}
+	err := s.v.validateCallNode(node)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "not both")
+}
+
+func (s *ValidatorTestSuite) TestValidateCallNode_EmptyOutput() {
+	node := &providers.NodeDefinition{
+		ID:        "call-sub",
+		Type:      string(common.NodeTypeCall),
+		Flow:      &providers.FlowReferenceDefinition{Handle: "mfa-subflow", Outputs: []string{""}},
+		OnSuccess: "end",
+	}
+	err := s.v.validateCallNode(node)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "empty output")
+}
+
 func (s *ValidatorTestSuite) TestValidateCallNode_MissingOnSuccess() {
 	node := &providers.NodeDefinition{
 		ID:   "call-sub",
@@ -844,6 +1002,258 @@ func (s *ValidatorTestSuite) TestValidateCallNode_HasOnIncomplete() {
 	s.Contains(err.ErrorDescription.DefaultValue, "must not have onIncomplete")
 }
 
+// ---------------------------------------------------------------------------
+// validateDecisionNode
+// ---------------------------------------------------------------------------
+
+func validDecisionNode() *providers.NodeDefinition {
+	return &providers.NodeDefinition{
+		ID:   "decision",
+		Type: string(common.NodeTypeDecision),
+		Decision: &providers.DecisionDefinition{
+			Branches: []providers.DecisionBranchDefinition{
+				{
+					Conditions: []providers.DecisionConditionDefinition{
+						{Key: "{{ctx(userType)}}", Operator: providers.DecisionOperatorEquals, Value: "admin"},
+					},
+					Next: "mfa",
+				},
+			},
+			Default: "end",
+		},
+	}
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_Valid() {
+	err := s.v.validateDecisionNode(validDecisionNode())
+	s.Nil(err)
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_MissingBranches() {
+	node := validDecisionNode()
+	node.Decision.Branches = nil
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "at least one branch")
+
+	node.Decision = nil
+	err = s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "at least one branch")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_MissingDefault() {
+	node := validDecisionNode()
+	node.Decision.Default = ""
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "default node")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_HasUnsupportedFields() {
+	node := validDecisionNode()
+	node.OnSuccess = "end"
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must only route through its decision branches")
+
+	node = validDecisionNode()
+	node.Executor = &providers.ExecutorDefinition{Name: "some-executor"}
+	err = s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must only route through its decision branches")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_BranchMissingNext() {
+	node := validDecisionNode()
+	node.Decision.Branches[0].Next = ""
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must have next")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_BranchMissingConditions() {
+	node := validDecisionNode()
+	node.Decision.Branches[0].Conditions = nil
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "at least one condition")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_ConditionMissingKey() {
+	node := validDecisionNode()
+	node.Decision.Branches[0].Conditions[0].Key = ""
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must have a key")
+}
+
+func (s *ValidatorTestSuite) TestValidateDecisionNode_InvalidOperator() {
+	node := validDecisionNode()
+	node.Decision.Branches[0].Conditions[0].Operator = "MATCHES"
+	err := s.v.validateDecisionNode(node)
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.String(), "invalid operator 'MATCHES'")
+}
+
+func (s *ValidatorTestSuite) TestValidateNodeFormat_DecisionOnNonDecisionNode() {
+	node := &providers.NodeDefinition{
+		ID:       "task",
+		Type:     string(common.NodeTypeTaskExecution),
+		Decision: &providers.DecisionDefinition{Default: "end"},
+	}
+	err := s.v.validateNodeFormat(node, map[string]*providers.NodeDefinition{})
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "must not have a decision")
+}
+
+// ---------------------------------------------------------------------------
+// validateParallelNode / validateJoinNode
+// ---------------------------------------------------------------------------
+
+// parallelFlowNodes returns nodes that send an email and an SMS OTP in parallel and join them.
+func parallelFlowNodes() []providers.NodeDefinition {
+	return []providers.NodeDefinition{
+		{ID: "start", Type: string(common.NodeTypeStart), OnSuccess: "parallel"},
+		{
+			ID:       "parallel",
+			Type:     string(common.NodeTypeParallel),
+			Parallel: &providers.ParallelDefinition{Branches: []string{"send-email", "send-sms"}, Join: "join"},
+		},
+		{ID: "send-email", Type: string(common.NodeTypeTaskExecution),
+			Executor: &providers.ExecutorDefinition{Name: "EmailExecutor"}, OnSuccess: "join"},
+		{ID: "send-sms", Type: string(common.NodeTypeTaskExecution),
+			Executor: &providers.ExecutorDefinition{Name: "SMSExecutor"}, OnSuccess: "join"},
+		{ID: "join", Type: string(common.NodeTypeJoin), Join: &providers.JoinDefinition{Mode: providers.JoinModeAny},
+			OnSuccess: "end", OnFailure: "end"},
+		{ID: "end", Type: string(common.NodeTypeEnd)},
+	}
+}
+
+func parallelNodeIndex(nodes []providers.NodeDefinition) map[string]*providers.NodeDefinition {
+	index := make(map[string]*providers.NodeDefinition, len(nodes))
+	for i := range nodes {
+		index[nodes[i].ID] = &nodes[i]
+	}
+	return index
+}
+
+func (s *ValidatorTestSuite) TestValidateEnabledNodeTypes_EnabledByDefault() {
+	s.Nil(s.v.validateEnabledNodeTypes(context.Background(), parallelFlowNodes()))
+}
+
+func (s *ValidatorTestSuite) TestValidateEnabledNodeTypes_ParallelNodesDisabled() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("", &config.Config{FeatureFlags: map[string]bool{"parallel_flow_nodes": false}})
+	defer config.ResetServerRuntime()
+
+	err := s.v.validateEnabledNodeTypes(context.Background(), parallelFlowNodes())
+
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Equal("error.flowmgtservice.node_type_not_enabled_description", err.ErrorDescription.Key)
+	s.Nil(s.v.validateEnabledNodeTypes(context.Background(), minimalValidNodes()))
+}
+
+func (s *ValidatorTestSuite) TestValidateParallelNode_Valid() {
+	nodes := parallelFlowNodes()
+	err := s.v.validateParallelNode(&nodes[1], parallelNodeIndex(nodes))
+	s.Nil(err)
+}
+
+func (s *ValidatorTestSuite) TestValidateParallelNode_TooFewBranches() {
+	nodes := parallelFlowNodes()
+	nodes[1].Parallel.Branches = []string{"send-email"}
+	err := s.v.validateParallelNode(&nodes[1], parallelNodeIndex(nodes))
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidNodeConfig.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "at least two branches")
+}
+
+func (s *ValidatorTestSuite) TestValidateParallelNode_MissingJoin() {
+	nodes := parallelFlowNodes()
+	nodes[1].Parallel.Join = ""
+	err := s.v.validateParallelNode(&nodes[1], parallelNodeIndex(nodes))
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must have a join node")
+}
+
+func (s *ValidatorTestSuite) TestValidateParallelNode_HasUnsupportedFields() {
+	nodes := parallelFlowNodes()
+	nodes[1].OnSuccess = "end"
+	err := s.v.validateParallelNode(&nodes[1], parallelNodeIndex(nodes))
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must only route through its branches")
+}
+
+func (s *ValidatorTestSuite) TestValidateParallelNode_JoinIsNotJoinNode() {
+	nodes := parallelFlowNodes()
+	nodes[1].Parallel.Join = "end"
+	err := s.v.validateParallelNode(&nodes[1], parallelNodeIndex(nodes))
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must reference a JOIN node")
+}
+
+func (s *ValidatorTestSuite) TestValidateParallelNode_BranchNotReachingJoin() {
+	nodes := parallelFlowNodes()
+	nodes[3].OnSuccess = "end"
+	err := s.v.validateParallelNode(&nodes[1], parallelNodeIndex(nodes))
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.String(), "branch 'send-sms' must be a chain of TASK_EXECUTION nodes")
+}
+
+func (s *ValidatorTestSuite) TestValidateJoinNode_Valid() {
+	nodes := parallelFlowNodes()
+	err := s.v.validateJoinNode(&nodes[4])
+	s.Nil(err)
+}
+
+func (s *ValidatorTestSuite) TestValidateJoinNode_MissingOnSuccess() {
+	nodes := parallelFlowNodes()
+	nodes[4].OnSuccess = ""
+	err := s.v.validateJoinNode(&nodes[4])
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must have onSuccess")
+}
+
+func (s *ValidatorTestSuite) TestValidateJoinNode_InvalidMode() {
+	nodes := parallelFlowNodes()
+	nodes[4].Join.Mode = "SOME"
+	err := s.v.validateJoinNode(&nodes[4])
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.String(), "invalid mode 'SOME'")
+}
+
+func (s *ValidatorTestSuite) TestValidateJoinNode_HasUnsupportedFields() {
+	nodes := parallelFlowNodes()
+	nodes[4].Executor = &providers.ExecutorDefinition{Name: "some-executor"}
+	err := s.v.validateJoinNode(&nodes[4])
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must only route through onSuccess and onFailure")
+}
+
+func (s *ValidatorTestSuite) TestValidateNodeFormat_ParallelAndJoinOnOtherNodes() {
+	node := &providers.NodeDefinition{
+		ID:       "task",
+		Type:     string(common.NodeTypeTaskExecution),
+		Parallel: &providers.ParallelDefinition{Branches: []string{"a", "b"}, Join: "join"},
+	}
+	err := s.v.validateNodeFormat(node, map[string]*providers.NodeDefinition{})
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must not have parallel branches")
+
+	node = &providers.NodeDefinition{
+		ID:   "task",
+		Type: string(common.NodeTypeTaskExecution),
+		Join: &providers.JoinDefinition{Mode: providers.JoinModeAll},
+	}
+	err = s.v.validateNodeFormat(node, map[string]*providers.NodeDefinition{})
+	s.Require().NotNil(err)
+	s.Contains(err.ErrorDescription.DefaultValue, "must not have a join mode")
+}
+
 // ---------------------------------------------------------------------------
 // validateInputDefinitions
 // ---------------------------------------------------------------------------
@@ -1238,6 +1648,31 @@ func (s *ValidatorTestSuite) TestCollectAllNodeReferences_PromptActionNextNode()
 	s.Equal("end", refs[0].targetNodeID)
 }
 
+func (s *ValidatorTestSuite) TestCollectAllNodeReferences_DecisionBranches() {
+	refs := collectAllNodeReferences([]providers.NodeDefinition{*validDecisionNode()})
+	s.Len(refs, 2)
+	s.Equal("decision.branches.next", refs[0].fieldName)
+	s.Equal("mfa", refs[0].targetNodeID)
+	s.Equal("decision.default", refs[1].fieldName)
+	s.Equal("end", refs[1].targetNodeID)
+
+	adj := buildAdjacencyList([]providers.NodeDefinition{*validDecisionNode()})
+	s.Equal([]string{"mfa", "end"}, adj["decision"])
+}
+
+func (s *ValidatorTestSuite) TestCollectAllNodeReferences_ParallelBranches() {
+	nodes := parallelFlowNodes()[1:2]
+	refs := collectAllNodeReferences(nodes)
+	s.Len(refs, 3)
+	s.Equal("parallel.branches", refs[0].fieldName)
+	s.Equal("send-email", refs[0].targetNodeID)
+	s.Equal("parallel.join", refs[2].fieldName)
+	s.Equal("join", refs[2].targetNodeID)
+
+	adj := buildAdjacencyList(nodes)
+	s.Equal([]string{"send-email", "send-sms", "join"}, adj["parallel"])
+}
+
 // ---------------------------------------------------------------------------
 // validateExecutors
 // ---------------------------------------------------------------------------
@@ -1306,3 +1741,155 @@ func (s *ValidatorTestSuite) TestValidateNodes_Valid() {
 	err := s.v.validateNodes(nodes, index)
 	s.Nil(err)
 }
+
+// ---------------------------------------------------------------------------
+// AnalyzeFlowDefinition
+// ---------------------------------------------------------------------------
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_Valid() {
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	s.mockGraphBuilder.EXPECT().ValidateGraph(mock.Anything, mock.Anything).Return(nil)
+
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), minimalValidFlow())
+
+	s.NotNil(issues)
+	s.Empty(issues)
+}
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_NilFlowDef() {
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), nil)
+
+	s.Require().Len(issues, 1)
+	s.Equal(IssueTypeInvalidDefinition, issues[0].Type)
+}
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_MissingStartNode() {
+	flowDef := minimalValidFlow()
+	flowDef.Nodes = flowDef.Nodes[1:]
+
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), flowDef)
+
+	s.Require().Len(issues, 1)
+	s.Equal(IssueTypeInvalidDefinition, issues[0].Type)
+	s.Equal("error.flowmgtservice.missing_start_node_description", issues[0].Message.Key)
+}
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_ReportsAllIssues() {
+	s.mockExecutorRegistry.EXPECT().IsRegistered("unknown-executor").Return(false)
+	flowDef := minimalValidFlow()
+	flowDef.Nodes = []providers.NodeDefinition{
+		{ID: "start", Type: string(common.NodeTypeStart), OnSuccess: "task"},
+		{
+			ID: "task", Type: string(common.NodeTypeTaskExecution),
+			Executor:  &providers.ExecutorDefinition{Name: "unknown-executor"},
+			OnSuccess: "missing",
+		},
+		{ID: "orphan", Type: string(common.NodeTypeTaskExecution), OnSuccess: "end"},
+		{ID: "end", Type: string(common.NodeTypeEnd)},
+	}
+
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), flowDef)
+
+	s.Require().Len(issues, 5)
+	s.Equal(IssueTypeDanglingReference, issues[0].Type)
+	s.Equal("task", issues[0].NodeID)
+	s.Equal("missing", issues[0].Message.Params["targetNodeID"])
+	s.Equal(IssueTypeUnreachableNode, issues[1].Type)
+	s.Equal("orphan", issues[1].NodeID)
+	s.Equal(IssueTypeUnreachableNode, issues[2].Type)
+	s.Equal("end", issues[2].NodeID)
+	s.Equal(IssueTypeMissingExecutor, issues[3].Type)
+	s.Equal("task", issues[3].NodeID)
+	s.Equal("error.flowmgtservice.executor_not_registered_description", issues[3].Message.Key)
+	s.Equal(IssueTypeMissingExecutor, issues[4].Type)
+	s.Equal("orphan", issues[4].NodeID)
+}
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_MissingExecutorDefinition() {
+	flowDef := minimalValidFlow()
+	flowDef.Nodes[1].Executor = nil
+
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), flowDef)
+
+	s.Require().Len(issues, 1)
+	s.Equal(IssueTypeMissingExecutor, issues[0].Type)
+	s.Equal("error.flowmgtservice.task_node_missing_executor_description", issues[0].Message.Key)
+}
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_CycleWithoutPrompt() {
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	flowDef := minimalValidFlow()
+	flowDef.Nodes = []providers.NodeDefinition{
+		{ID: "start", Type: string(common.NodeTypeStart), OnSuccess: "task1"},
+		{
+			ID: "task1", Type: string(common.NodeTypeTaskExecution),
+			Executor:  &providers.ExecutorDefinition{Name: "test-executor"},
+			OnSuccess: "task2",
+		},
+		{
+			ID: "task2", Type: string(common.NodeTypeTaskExecution),
+			Executor:  &providers.ExecutorDefinition{Name: "test-executor"},
+			OnSuccess: "task1",
+			Condition: &providers.ConditionDefinition{Key: "done", Value: "true", OnSkip: "end"},
+		},
+		{ID: "end", Type: string(common.NodeTypeEnd)},
+	}
+
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), flowDef)
+
+	s.Require().Len(issues, 1)
+	s.Equal(IssueTypeCyclicPath, issues[0].Type)
+	s.Equal("task1", issues[0].NodeID)
+	s.Equal("task1, task2", issues[0].Message.Params["nodeIDs"])
+}
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_SelfLoop() {
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	flowDef := minimalValidFlow()
+	flowDef.Nodes[1].Condition = &providers.ConditionDefinition{Key: "k", Value: "v", OnSkip: "end"}
+	flowDef.Nodes[1].OnSuccess = "task"
+
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), flowDef)
+
+	s.Require().Len(issues, 1)
+	s.Equal(IssueTypeCyclicPath, issues[0].Type)
+	s.Equal("task", issues[0].Message.Params["nodeIDs"])
+}
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_RetryLoopThroughPromptIsAllowed() {
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	s.mockGraphBuilder.EXPECT().ValidateGraph(mock.Anything, mock.Anything).Return(nil)
+	flowDef := minimalValidFlow()
+	flowDef.Nodes = []providers.NodeDefinition{
+		{ID: "start", Type: string(common.NodeTypeStart), OnSuccess: "prompt"},
+		{
+			ID: "prompt", Type: string(common.NodeTypePrompt),
+			Prompts: []providers.PromptDefinition{
+				{Action: &providers.ActionDefinition{Ref: "submit", NextNode: "task"}},
+			},
+		},
+		{
+			ID: "task", Type: string(common.NodeTypeTaskExecution),
+			Executor:  &providers.ExecutorDefinition{Name: "test-executor"},
+			OnSuccess: "end",
+			OnFailure: "prompt",
+		},
+		{ID: "end", Type: string(common.NodeTypeEnd)},
+	}
+
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), flowDef)
+
+	s.Empty(issues)
+}
+
+func (s *ValidatorTestSuite) TestAnalyzeFlowDefinition_FallsBackToFullValidation() {
+	s.mockExecutorRegistry.EXPECT().IsRegistered("test-executor").Return(true)
+	flowDef := minimalValidFlow()
+	flowDef.Handle = ""
+
+	issues := s.v.AnalyzeFlowDefinition(context.Background(), flowDef)
+
+	s.Require().Len(issues, 1)
+	s.Equal(IssueTypeInvalidDefinition, issues[0].Type)
+	s.Equal(ErrorMissingFlowHandle.ErrorDescription.Key, issues[0].Message.Key)
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package graphql
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for GraphQL operations.
+var (
+	// ErrorInvalidRequest is the error returned when the request body is not a GraphQL request.
+	ErrorInvalidRequest = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "GQL-1001",
+		Error: common.I18nMessage{
+			Key:          "error.graphqlservice.invalid_request",
+			DefaultValue: "Invalid GraphQL request",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.graphqlservice.invalid_request_description",
+			DefaultValue: "The request body must be a JSON object with a query string",
+		},
+	}
+	// ErrorInsufficientPermissions is the error reported for a field the caller is not permitted to read.
+	ErrorInsufficientPermissions = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "GQL-1002",
+		Error: common.I18nMessage{
+			Key:          "error.graphqlservice.insufficient_permissions",
+			DefaultValue: "Insufficient permissions",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.graphqlservice.insufficient_permissions_description",
+			DefaultValue: "The caller does not have the permission required to read this field",
+		},
+	}
+	// ErrorInvalidFilter is the error returned when the users filter is not an object of attribute values.
+	ErrorInvalidFilter = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "GQL-1003",
+		Error: common.I18nMessage{
+			Key:          "error.graphqlservice.invalid_filter",
+			DefaultValue: "Invalid filter",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.graphqlservice.invalid_filter_description",
+			DefaultValue: "The filter must be an object that maps attribute names to string, number or boolean values",
+		},
+	}
+)
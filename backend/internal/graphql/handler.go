@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// graphqlHandler is the handler for GraphQL requests.
+type graphqlHandler struct {
+	schema   *graphql.Schema
+	maxDepth int
+	logger   *log.Logger
+}
+
+// newGraphQLHandler creates a new instance of graphqlHandler.
+func newGraphQLHandler(s *graphql.Schema, maxDepth int) *graphqlHandler {
+	return &graphqlHandler{
+		schema:   s,
+		maxDepth: maxDepth,
+		logger:   log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// HandleGraphQL handles a GraphQL request. A request that is not a GraphQL request is rejected with a
+// bad request error; otherwise the response follows the GraphQL conventions and reports syntax,
+// validation and field errors in its errors entry.
+func (h *graphqlHandler) HandleGraphQL(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+
+	req, err := sysutils.DecodeJSONBody[Request](r)
+	if err != nil || strings.TrimSpace(req.Query) == "" {
+		handleError(ctx, w, &ErrorInvalidRequest)
+		return
+	}
+
+	response := execute(ctx, h.schema, req, h.maxDepth)
+	if len(response.Errors) > 0 {
+		h.logger.Debug(ctx, "GraphQL request completed with errors",
+			log.String("operationName", req.OperationName), log.Int("errorCount", len(response.Errors)))
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, response)
+}
+
+// handleError maps a service error to an HTTP error response.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *common.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == common.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package graphql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+func TestHandleGraphQL(t *testing.T) {
+	m, s := newResolverMocks(t)
+	m.users.On("GetUser", mock.Anything, "u1", true).Return(&user.User{ID: "u1", Display: "Alice"}, nil)
+	handler := newGraphQLHandler(s, 10)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/graphql",
+		strings.NewReader(`{"query":"query Q($id: ID!) { user(id: $id) { display } }","variables":{"id":"u1"}}`))
+	handler.HandleGraphQL(rec, req.WithContext(contextWithPermissions("system")))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data":{"user":{"display":"Alice"}}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.HandleGraphQL(rec, httptest.NewRequest(http.MethodPost, "/graphql",
+		strings.NewReader(`{"query":"{ user { display } }"}`)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"errors":[`)
+	assert.NotContains(t, rec.Body.String(), `"data"`)
+
+	for _, body := range []string{`not json`, `{"query":"  "}`, `{"query":1}`} {
+		rec = httptest.NewRecorder()
+		handler.HandleGraphQL(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body)))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrorInvalidRequest.Code)
+	}
+}
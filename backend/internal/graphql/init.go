@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package graphql provides a read-only GraphQL API that composes users, groups, roles, sessions and
+// applications in a single query for the admin console. Each field is authorized with the permission
+// that reading the resource requires in the REST API; a field the caller may not read is returned as
+// null with an error, without failing the rest of the query.
+package graphql
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/application"
+	"github.com/thunder-id/thunderid/internal/device"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// Initialize wires the GraphQL schema and route. Nothing is registered when the GraphQL API is
+// disabled.
+func Initialize(mux *http.ServeMux, cfg config.GraphQLConfig, userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface, roleService role.RoleServiceInterface,
+	applicationService application.ApplicationServiceInterface, deviceService device.DeviceServiceInterface) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	s, err := newSchema(&resolver{
+		userService:        userService,
+		groupService:       groupService,
+		roleService:        roleService,
+		applicationService: applicationService,
+		deviceService:      deviceService,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build the GraphQL schema: %w", err)
+	}
+	registerRoutes(mux, newGraphQLHandler(s, cfg.MaxDepth))
+	return nil
+}
+
+// registerRoutes registers the routes for GraphQL operations.
+func registerRoutes(mux *http.ServeMux, handler *graphqlHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /graphql", handler.HandleGraphQL, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /graphql", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts))
+}
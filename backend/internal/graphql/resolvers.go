@@ -0,0 +1,341 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/thunder-id/thunderid/internal/application"
+	"github.com/thunder-id/thunderid/internal/device"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/role"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// resolver resolves the fields of the admin console schema from the management services.
+type resolver struct {
+	userService        user.UserServiceInterface
+	groupService       group.GroupServiceInterface
+	roleService        role.RoleServiceInterface
+	applicationService application.ApplicationServiceInterface
+	deviceService      device.DeviceServiceInterface
+}
+
+// newSchema builds the schema served by the GraphQL API.
+func newSchema(r *resolver) (*graphql.Schema, error) {
+	readUser := func() string { return security.ResolveActionPermission(security.ActionReadUser) }
+	listUsers := func() string { return security.ResolveActionPermission(security.ActionListUsers) }
+	readGroup := func() string { return security.ResolveActionPermission(security.ActionReadGroup) }
+	listGroups := func() string { return security.ResolveActionPermission(security.ActionListGroups) }
+	listApplications := func() string { return security.ResolveActionPermission(security.ActionListApplications) }
+	root := security.GetSystemRootPermission
+
+	session := newObjectType("Session", graphql.Fields{
+		"id":        {Type: graphql.ID},
+		"userAgent": {Type: graphql.String},
+		"platform":  {Type: graphql.String},
+		"firstSeen": {Type: graphql.DateTime},
+		"lastSeen":  {Type: graphql.DateTime},
+		"aal":       {Type: graphql.String},
+	})
+	effectiveRole := newObjectType("EffectiveRole", graphql.Fields{
+		"id":        {Type: graphql.ID},
+		"name":      {Type: graphql.String},
+		"ouId":      {Type: graphql.ID},
+		"direct":    {Type: graphql.Boolean},
+		"viaGroups": {Type: graphql.NewList(graphql.ID)},
+	})
+	roleType := newObjectType("Role", graphql.Fields{
+		"id":          {Type: graphql.ID},
+		"name":        {Type: graphql.String},
+		"description": {Type: graphql.String},
+		"ouId":        {Type: graphql.ID},
+		"isReadOnly":  {Type: graphql.Boolean},
+	})
+	applicationType := newObjectType("Application", graphql.Fields{
+		"id":          {Type: graphql.ID},
+		"ouId":        {Type: graphql.ID},
+		"name":        {Type: graphql.String},
+		"description": {Type: graphql.String},
+		"clientId":    {Type: graphql.String},
+		"logoUrl":     {Type: graphql.String},
+		"template":    {Type: graphql.String},
+		"isReadOnly":  {Type: graphql.Boolean},
+	})
+
+	// Users and groups reference each other through their memberships, so the fields of these types
+	// are declared as thunks that are resolved when the schema is built.
+	var userType, groupType *graphql.Object
+	userGroup := newLazyObjectType("UserGroup", func() graphql.Fields {
+		return graphql.Fields{
+			"id":   {Type: graphql.ID},
+			"name": {Type: graphql.String},
+			"ouId": {Type: graphql.ID},
+			"group": {Type: groupType,
+				Resolve: authorized(readGroup, func(p graphql.ResolveParams) (interface{}, error) {
+					return r.group(p.Context, stringProperty(p.Source, "id"))
+				})},
+		}
+	})
+	member := newLazyObjectType("GroupMember", func() graphql.Fields {
+		return graphql.Fields{
+			"id":      {Type: graphql.ID},
+			"type":    {Type: graphql.String},
+			"display": {Type: graphql.String},
+			"user": {Type: userType,
+				Resolve: authorized(readUser, func(p graphql.ResolveParams) (interface{}, error) {
+					if stringProperty(p.Source, "type") != string(group.MemberTypeUser) {
+						return nil, nil
+					}
+					return r.user(p.Context, stringProperty(p.Source, "id"))
+				})},
+		}
+	})
+
+	userType = newLazyObjectType("User", func() graphql.Fields {
+		return graphql.Fields{
+			"id":         {Type: graphql.ID},
+			"ouId":       {Type: graphql.ID},
+			"type":       {Type: graphql.String},
+			"display":    {Type: graphql.String},
+			"state":      {Type: graphql.String},
+			"isReadOnly": {Type: graphql.Boolean},
+			"attributes": {Type: jsonType},
+			"groups": {Type: newListType("UserGroupList", "groups", userGroup), Args: pageArgs(),
+				Resolve: authorized(listGroups, func(p graphql.ResolveParams) (interface{}, error) {
+					limit, offset := page(p.Args)
+					groups, svcErr := r.userService.GetUserGroups(p.Context, stringProperty(p.Source, "id"),
+						limit, offset)
+					return result(groups, svcErr)
+				})},
+			"roles": {Type: graphql.NewList(effectiveRole),
+				Resolve: authorized(root, func(p graphql.ResolveParams) (interface{}, error) {
+					access, svcErr := r.userService.GetEffectiveAccess(p.Context, stringProperty(p.Source, "id"))
+					if svcErr != nil {
+						return nil, toFieldError(svcErr)
+					}
+					return access.Roles, nil
+				})},
+			"sessions": {Type: graphql.NewList(session),
+				Resolve: authorized(readUser, func(p graphql.ResolveParams) (interface{}, error) {
+					devices, svcErr := r.deviceService.ListDevices(p.Context, stringProperty(p.Source, "id"))
+					if svcErr != nil {
+						return nil, toFieldError(svcErr)
+					}
+					return devices, nil
+				})},
+		}
+	})
+	groupType = newLazyObjectType("Group", func() graphql.Fields {
+		return graphql.Fields{
+			"id":          {Type: graphql.ID},
+			"name":        {Type: graphql.String},
+			"description": {Type: graphql.String},
+			"ouId":        {Type: graphql.ID},
+			"isReadOnly":  {Type: graphql.Boolean},
+			"members": {Type: newListType("MemberList", "members", member), Args: pageArgs(),
+				Resolve: authorized(readGroup, func(p graphql.ResolveParams) (interface{}, error) {
+					limit, offset := page(p.Args)
+					members, svcErr := r.groupService.GetGroupMembers(p.Context, stringProperty(p.Source, "id"),
+						limit, offset, true)
+					return result(members, svcErr)
+				})},
+		}
+	})
+
+	usersArgs := pageArgs()
+	usersArgs["filter"] = &graphql.ArgumentConfig{Type: jsonType}
+
+	query := newObjectType("Query", graphql.Fields{
+		"user": {Type: userType, Args: idArgs(),
+			Resolve: authorized(readUser, func(p graphql.ResolveParams) (interface{}, error) {
+				return r.user(p.Context, p.Args["id"].(string))
+			})},
+		"users": {Type: newListType("UserList", "users", userType), Args: usersArgs,
+			Resolve: authorized(listUsers, func(p graphql.ResolveParams) (interface{}, error) {
+				filters, err := userFilters(p.Args["filter"])
+				if err != nil {
+					return nil, err
+				}
+				limit, offset := page(p.Args)
+				users, svcErr := r.userService.GetUserList(p.Context, limit, offset, filters, true)
+				return result(users, svcErr)
+			})},
+		"group": {Type: groupType, Args: idArgs(),
+			Resolve: authorized(readGroup, func(p graphql.ResolveParams) (interface{}, error) {
+				return r.group(p.Context, p.Args["id"].(string))
+			})},
+		"groups": {Type: newListType("GroupList", "groups", groupType), Args: pageArgs(),
+			Resolve: authorized(listGroups, func(p graphql.ResolveParams) (interface{}, error) {
+				limit, offset := page(p.Args)
+				groups, svcErr := r.groupService.GetGroupList(p.Context, limit, offset, true)
+				return result(groups, svcErr)
+			})},
+		"roles": {Type: newListType("RoleList", "roles", roleType), Args: pageArgs(),
+			Resolve: authorized(root, func(p graphql.ResolveParams) (interface{}, error) {
+				limit, offset := page(p.Args)
+				roles, svcErr := r.roleService.GetRoleList(p.Context, limit, offset)
+				return result(roles, svcErr)
+			})},
+		"application": {Type: applicationType, Args: idArgs(),
+			Resolve: authorized(root, func(p graphql.ResolveParams) (interface{}, error) {
+				app, svcErr := r.applicationService.GetApplication(p.Context, p.Args["id"].(string))
+				return result(app, svcErr)
+			})},
+		"applications": {Type: newObjectType("ApplicationList", graphql.Fields{
+			"totalResults": {Type: graphql.Int},
+			"count":        {Type: graphql.Int},
+			"applications": {Type: graphql.NewList(applicationType)},
+		}), Resolve: authorized(listApplications, func(p graphql.ResolveParams) (interface{}, error) {
+			apps, svcErr := r.applicationService.GetApplicationList(p.Context)
+			return result(apps, svcErr)
+		})},
+	})
+
+	s, err := graphql.NewSchema(graphql.SchemaConfig{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// user returns the user with the given ID.
+func (r *resolver) user(ctx context.Context, userID string) (interface{}, error) {
+	u, svcErr := r.userService.GetUser(ctx, userID, true)
+	return result(u, svcErr)
+}
+
+// group returns the group with the given ID.
+func (r *resolver) group(ctx context.Context, groupID string) (interface{}, error) {
+	g, svcErr := r.groupService.GetGroup(ctx, groupID, true)
+	return result(g, svcErr)
+}
+
+// newObjectType returns an object type with the given fields.
+func newObjectType(name string, fields graphql.Fields) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{Name: name, Fields: fields})
+}
+
+// newLazyObjectType returns an object type whose fields are built when the schema is built, so they
+// may reference types that are declared after it.
+func newLazyObjectType(name string, fields func() graphql.Fields) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{Name: name, Fields: graphql.FieldsThunk(fields)})
+}
+
+// newListType returns a paginated list type that holds its items in the itemsField field, matching the
+// list responses of the REST API.
+func newListType(name, itemsField string, item graphql.Type) *graphql.Object {
+	return newObjectType(name, graphql.Fields{
+		"totalResults": {Type: graphql.Int},
+		"startIndex":   {Type: graphql.Int},
+		"count":        {Type: graphql.Int},
+		itemsField:     {Type: graphql.NewList(item)},
+	})
+}
+
+// idArgs returns the arguments of a field that looks up a resource by its ID.
+func idArgs() graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}}
+}
+
+// pageArgs returns the pagination arguments of a list field.
+func pageArgs() graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		"limit":  {Type: graphql.Int, DefaultValue: serverconst.DefaultPageSize},
+		"offset": {Type: graphql.Int, DefaultValue: 0},
+	}
+}
+
+// page returns the coerced pagination arguments, falling back to the defaults for explicit nulls.
+func page(args map[string]interface{}) (int, int) {
+	limit, ok := args["limit"].(int)
+	if !ok {
+		limit = serverconst.DefaultPageSize
+	}
+	offset, _ := args["offset"].(int)
+	return limit, offset
+}
+
+// userFilters converts the users filter argument into the attribute filters of the user service. The
+// string values are sanitized as in the REST API.
+func userFilters(filter interface{}) (map[string]interface{}, error) {
+	filters := make(map[string]interface{})
+	if filter == nil {
+		return filters, nil
+	}
+	attributes, ok := filter.(map[string]interface{})
+	if !ok {
+		return nil, toFieldError(&ErrorInvalidFilter)
+	}
+	for key, value := range attributes {
+		switch v := value.(type) {
+		case string:
+			filters[sysutils.SanitizeString(key)] = sysutils.SanitizeString(v)
+		case int64, float64, bool:
+			filters[sysutils.SanitizeString(key)] = v
+		default:
+			return nil, toFieldError(&ErrorInvalidFilter)
+		}
+	}
+	return filters, nil
+}
+
+// stringProperty reads a string property of a resolved object the way the default field resolver
+// reads it.
+func stringProperty(source interface{}, name string) string {
+	value, _ := graphql.DefaultResolveFn(graphql.ResolveParams{
+		Source: source,
+		Info:   graphql.ResolveInfo{FieldName: name},
+	})
+	if s, ok := value.(string); ok {
+		return s
+	}
+	if s, ok := value.(group.MemberType); ok {
+		return string(s)
+	}
+	return ""
+}
+
+// result converts the outcome of a service call into the outcome of a resolver.
+func result(value interface{}, svcErr *common.ServiceError) (interface{}, error) {
+	if svcErr != nil {
+		return nil, toFieldError(svcErr)
+	}
+	return value, nil
+}
+
+// toFieldError converts a service error into a field error. The details of server errors are not
+// disclosed.
+func toFieldError(svcErr *common.ServiceError) error {
+	if svcErr.Type == common.ServerErrorType {
+		return &fieldError{code: common.InternalServerError.Code,
+			message: common.InternalServerError.ErrorDescription.DefaultValue}
+	}
+	message := svcErr.ErrorDescription.String()
+	if message == "" {
+		message = svcErr.Error.String()
+	}
+	return &fieldError{code: svcErr.Code, message: message}
+}
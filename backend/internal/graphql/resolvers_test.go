@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	"github.com/thunder-id/thunderid/internal/device"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/applicationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/devicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+// resolverMocks holds the service mocks behind the admin console schema.
+type resolverMocks struct {
+	users        *usermock.UserServiceInterfaceMock
+	groups       *groupmock.GroupServiceInterfaceMock
+	roles        *rolemock.RoleServiceInterfaceMock
+	applications *applicationmock.ApplicationServiceInterfaceMock
+	devices      *devicemock.DeviceServiceInterfaceMock
+}
+
+func newResolverMocks(t *testing.T) (*resolverMocks, *graphql.Schema) {
+	security.InitSystemPermissions("")
+	m := &resolverMocks{
+		users:        usermock.NewUserServiceInterfaceMock(t),
+		groups:       groupmock.NewGroupServiceInterfaceMock(t),
+		roles:        rolemock.NewRoleServiceInterfaceMock(t),
+		applications: applicationmock.NewApplicationServiceInterfaceMock(t),
+		devices:      devicemock.NewDeviceServiceInterfaceMock(t),
+	}
+	s, err := newSchema(&resolver{
+		userService:        m.users,
+		groupService:       m.groups,
+		roleService:        m.roles,
+		applicationService: m.applications,
+		deviceService:      m.devices,
+	})
+	require.NoError(t, err)
+	return m, s
+}
+
+// contextWithPermissions returns a context of a caller holding the given permissions.
+func contextWithPermissions(permissions ...string) context.Context {
+	return security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest("admin", "", "token", permissions, nil))
+}
+
+// executeQuery executes a query and returns the JSON encoded response.
+func executeQuery(t *testing.T, s *graphql.Schema, ctx context.Context, query string,
+	variables map[string]interface{}) string {
+	t.Helper()
+	encoded, err := json.Marshal(execute(ctx, s, &Request{Query: query, Variables: variables}, 10))
+	require.NoError(t, err)
+	return string(encoded)
+}
+
+func TestAdminConsolePage(t *testing.T) {
+	m, s := newResolverMocks(t)
+	lastSeen := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	m.users.On("GetUser", mock.Anything, "u1", true).Return(&user.User{ID: "u1", OUID: "ou1", Type: "employee",
+		Display: "Alice", State: providers.EntityState("active"),
+		Attributes: json.RawMessage(`{"email":"alice@example.com"}`)}, nil)
+	m.users.On("GetUserGroups", mock.Anything, "u1", 5, 0).Return(&user.UserGroupListResponse{
+		TotalResults: 1, Count: 1, Groups: []providers.EntityGroup{{ID: "g1", Name: "admins", OUID: "ou1"}},
+	}, nil)
+	m.groups.On("GetGroup", mock.Anything, "g1", true).Return(&group.Group{ID: "g1", Name: "admins"}, nil)
+	m.groups.On("GetGroupMembers", mock.Anything, "g1", 30, 0, true).Return(&group.MemberListResponse{
+		TotalResults: 1, Count: 1, Members: []group.Member{{ID: "u1", Type: group.MemberTypeUser, Display: "Alice"}},
+	}, nil)
+	m.users.On("GetEffectiveAccess", mock.Anything, "u1").Return(&user.EffectiveAccess{
+		Roles: []user.EffectiveRole{{ID: "r1", Name: "auditor", ViaGroups: []string{"g1"}}},
+	}, nil)
+	m.devices.On("ListDevices", mock.Anything, "u1").Return([]device.Device{
+		{ID: "d1", Platform: "macOS", FirstSeen: lastSeen, LastSeen: lastSeen, AAL: "aal2"},
+	}, nil)
+	m.roles.On("GetRoleList", mock.Anything, 30, 0).Return(&role.RoleList{
+		TotalResults: 1, Count: 1, Roles: []role.Role{{ID: "r1", Name: "auditor", OUID: "ou1"}},
+	}, nil)
+	m.applications.On("GetApplicationList", mock.Anything).Return(&model.ApplicationListResponse{
+		TotalResults: 1, Count: 1, Applications: []model.BasicApplicationResponse{{ID: "a1", Name: "Console",
+			ClientID: "console"}},
+	}, nil)
+
+	result := executeQuery(t, s, contextWithPermissions("system"), `query Page($id: ID!) {
+		user(id: $id) {
+			id ouId type display state isReadOnly attributes
+			groups(limit: 5) { totalResults groups { id name group { members { members { id user { display } } } } } }
+			roles { name direct viaGroups }
+			sessions { id platform lastSeen aal }
+		}
+		roles { totalResults roles { id ouId } }
+		applications { count applications { id name clientId } }
+	}`, map[string]interface{}{"id": "u1"})
+
+	assert.JSONEq(t, `{"data": {
+		"user": {
+			"id": "u1", "ouId": "ou1", "type": "employee", "display": "Alice", "state": "active",
+			"isReadOnly": false, "attributes": {"email": "alice@example.com"},
+			"groups": {"totalResults": 1, "groups": [{"id": "g1", "name": "admins",
+				"group": {"members": {"members": [{"id": "u1", "user": {"display": "Alice"}}]}}}]},
+			"roles": [{"name": "auditor", "direct": false, "viaGroups": ["g1"]}],
+			"sessions": [{"id": "d1", "platform": "macOS", "lastSeen": "2026-03-01T10:00:00Z", "aal": "aal2"}]
+		},
+		"roles": {"totalResults": 1, "roles": [{"id": "r1", "ouId": "ou1"}]},
+		"applications": {"count": 1, "applications": [{"id": "a1", "name": "Console", "clientId": "console"}]}
+	}}`, result)
+}
+
+func TestFieldLevelAuthorization(t *testing.T) {
+	m, s := newResolverMocks(t)
+	m.users.On("GetUser", mock.Anything, "u1", true).Return(&user.User{ID: "u1"}, nil)
+	m.devices.On("ListDevices", mock.Anything, "u1").Return([]device.Device{{ID: "d1"}}, nil)
+
+	ctx := contextWithPermissions(security.ResolveActionPermission(security.ActionReadUser))
+	result := executeQuery(t, s, ctx, `{ user(id: "u1") { id sessions { id } roles { id } } groups { count } }`, nil)
+
+	assert.JSONEq(t, `{
+		"data": {"user": {"id": "u1", "sessions": [{"id": "d1"}], "roles": null}, "groups": null},
+		"errors": [
+			{"message": "The caller does not have the permission required to read this field",
+				"locations": [{"line": 1, "column": 39}], "path": ["user", "roles"],
+				"extensions": {"code": "GQL-1002"}},
+			{"message": "The caller does not have the permission required to read this field",
+				"locations": [{"line": 1, "column": 54}], "path": ["groups"], "extensions": {"code": "GQL-1002"}}
+		]
+	}`, result)
+	m.users.AssertNotCalled(t, "GetEffectiveAccess", mock.Anything, mock.Anything)
+}
+
+func TestServiceErrors(t *testing.T) {
+	m, s := newResolverMocks(t)
+	m.users.On("GetUser", mock.Anything, "missing", true).Return(nil, &user.ErrorUserNotFound)
+	m.groups.On("GetGroupList", mock.Anything, 30, 0, true).Return(nil, &common.ServiceError{
+		Type: common.ServerErrorType, Code: "GRP-5000",
+		ErrorDescription: common.I18nMessage{DefaultValue: "connection refused by db-primary"},
+	})
+
+	result := executeQuery(t, s, contextWithPermissions("system"),
+		`{ user(id: "missing") { id } groups { count } }`, nil)
+
+	assert.JSONEq(t, `{
+		"data": {"user": null, "groups": null},
+		"errors": [
+			{"message": "The user with the specified id does not exist", "locations": [{"line": 1, "column": 3}],
+				"path": ["user"], "extensions": {"code": "USR-1003"}},
+			{"message": "An unexpected error occurred while processing the request",
+				"locations": [{"line": 1, "column": 30}], "path": ["groups"], "extensions": {"code": "SSE-5000"}}
+		]
+	}`, result)
+}
+
+func TestUsersFilter(t *testing.T) {
+	m, s := newResolverMocks(t)
+	m.users.On("GetUserList", mock.Anything, 10, 20,
+		map[string]interface{}{"email": "alice@example.com", "age": float64(30)}, true).
+		Return(&user.UserListResponse{TotalResults: 1, Count: 1, Users: []user.User{{ID: "u1"}}}, nil)
+	ctx := contextWithPermissions("system")
+
+	result := executeQuery(t, s, ctx, `query Q($filter: JSON) {
+		users(limit: 10, offset: 20, filter: $filter) { totalResults users { id } }
+	}`, map[string]interface{}{"filter": map[string]interface{}{"email": "alice@example.com", "age": float64(30)}})
+	assert.JSONEq(t, `{"data": {"users": {"totalResults": 1, "users": [{"id": "u1"}]}}}`, result)
+
+	result = executeQuery(t, s, ctx, `{ users(filter: ["email"]) { count } }`, nil)
+	assert.Contains(t, result, `"code":"GQL-1003"`)
+	assert.Contains(t, result, `"users":null`)
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// jsonType is an arbitrary JSON value, such as the attributes of a user.
+var jsonType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "The `JSON` scalar type represents an arbitrary JSON value.",
+	Serialize: func(value interface{}) interface{} {
+		if raw, ok := value.(json.RawMessage); ok && len(raw) == 0 {
+			return nil
+		}
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+	ParseLiteral: parseJSONLiteral,
+})
+
+// parseJSONLiteral converts an argument literal into the JSON value it denotes. It returns nil, which
+// the executor reports as an invalid value, for literals that have no JSON representation.
+func parseJSONLiteral(valueAST ast.Value) interface{} {
+	switch v := valueAST.(type) {
+	case *ast.StringValue:
+		return v.Value
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.IntValue:
+		if i, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+			return i
+		}
+	case *ast.FloatValue:
+		if f, err := strconv.ParseFloat(v.Value, 64); err == nil {
+			return f
+		}
+	case *ast.ListValue:
+		values := make([]interface{}, 0, len(v.Values))
+		for _, item := range v.Values {
+			value := parseJSONLiteral(item)
+			if value == nil {
+				return nil
+			}
+			values = append(values, value)
+		}
+		return values
+	case *ast.ObjectValue:
+		values := make(map[string]interface{}, len(v.Fields))
+		for _, field := range v.Fields {
+			value := parseJSONLiteral(field.Value)
+			if value == nil {
+				return nil
+			}
+			values[field.Name.Value] = value
+		}
+		return values
+	}
+	return nil
+}
+
+// fieldError is an error returned by a resolver. Its code is reported in the error extensions.
+type fieldError struct {
+	code    string
+	message string
+}
+
+func (e *fieldError) Error() string {
+	return e.message
+}
+
+// Extensions returns the extensions reported with the error in the GraphQL response.
+func (e *fieldError) Extensions() map[string]interface{} {
+	if e.code == "" {
+		return nil
+	}
+	return map[string]interface{}{"code": e.code}
+}
+
+// authorized wraps the resolver of a field so that it only runs for callers holding the permission
+// the field requires. A field the caller may not read is returned as null with an error. A nil
+// resolver reads the property of the parent with the same name.
+func authorized(permission func() string, resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	if resolve == nil {
+		resolve = graphql.DefaultResolveFn
+	}
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		if !security.HasSufficientPermission(security.GetPermissions(p.Context), permission()) {
+			return nil, &fieldError{code: ErrorInsufficientPermissions.Code,
+				message: ErrorInsufficientPermissions.ErrorDescription.DefaultValue}
+		}
+		return resolve(p)
+	}
+}
+
+// execute parses, validates and executes a request against the schema. A query whose fields nest
+// deeper than maxDepth is rejected before execution; a non-positive maxDepth disables the check.
+func execute(ctx context.Context, s *graphql.Schema, req *Request, maxDepth int) *Response {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &Response{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if validation := graphql.ValidateDocument(s, doc, nil); !validation.IsValid {
+		return &Response{Errors: validation.Errors}
+	}
+
+	if maxDepth > 0 && queryDepth(doc) > maxDepth {
+		return &Response{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(
+			fmt.Sprintf("Query depth exceeds the maximum allowed depth of %d.", maxDepth))}}
+	}
+
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        *s,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+	sortErrors(result.Errors)
+	return &Response{Data: result.Data, Errors: result.Errors}
+}
+
+// sortErrors orders field errors by their position in the query. The executor resolves the fields
+// of an object in no particular order, so without sorting the same request could report its
+// errors in a different order each time.
+func sortErrors(errs []gqlerrors.FormattedError) {
+	sort.SliceStable(errs, func(i, j int) bool {
+		if len(errs[i].Locations) == 0 || len(errs[j].Locations) == 0 {
+			return len(errs[i].Locations) < len(errs[j].Locations)
+		}
+		a, b := errs[i].Locations[0], errs[j].Locations[0]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+// queryDepth returns the deepest nesting of fields selected by the operations of a validated
+// document, expanding fragments.
+func queryDepth(doc *ast.Document) int {
+	d := &depthCounter{fragments: make(map[string]*ast.FragmentDefinition), depths: make(map[string]int)}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			d.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	depth := 0
+	for _, def := range doc.Definitions {
+		if op, ok := def.(*ast.OperationDefinition); ok {
+			depth = max(depth, d.selectionDepth(op.SelectionSet))
+		}
+	}
+	return depth
+}
+
+// depthCounter measures the nesting of selection sets. The depth of each fragment is computed once,
+// so a document that spreads the same fragment many times is measured in linear time. Validation
+// rejects fragment cycles, so expanding a fragment always terminates.
+type depthCounter struct {
+	fragments map[string]*ast.FragmentDefinition
+	depths    map[string]int
+}
+
+// selectionDepth returns the deepest nesting of fields in a selection set.
+func (d *depthCounter) selectionDepth(set *ast.SelectionSet) int {
+	if set == nil {
+		return 0
+	}
+	depth := 0
+	for _, selection := range set.Selections {
+		switch node := selection.(type) {
+		case *ast.Field:
+			depth = max(depth, 1+d.selectionDepth(node.SelectionSet))
+		case *ast.InlineFragment:
+			depth = max(depth, d.selectionDepth(node.SelectionSet))
+		case *ast.FragmentSpread:
+			depth = max(depth, d.fragmentDepth(node.Name.Value))
+		}
+	}
+	return depth
+}
+
+// fragmentDepth returns the deepest nesting of fields in the named fragment.
+func (d *depthCounter) fragmentDepth(name string) int {
+	if depth, ok := d.depths[name]; ok {
+		return depth
+	}
+	fragment, ok := d.fragments[name]
+	if !ok {
+		return 0
+	}
+	depth := d.selectionDepth(fragment.SelectionSet)
+	d.depths[name] = depth
+	return depth
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// newTestSchema returns a schema of items for exercising the execution helpers independently of the
+// services.
+func newTestSchema(t *testing.T) *graphql.Schema {
+	t.Helper()
+	item := newObjectType("Item", graphql.Fields{
+		"id":     {Type: graphql.ID},
+		"count":  {Type: graphql.Int},
+		"secret": {Type: graphql.String, Resolve: authorized(func() string { return "system:secret" }, nil)},
+		"broken": {Type: graphql.String, Resolve: func(graphql.ResolveParams) (interface{}, error) {
+			return nil, &fieldError{code: "TST-1001", message: "broken field"}
+		}},
+		"empty": {Type: jsonType, Resolve: func(graphql.ResolveParams) (interface{}, error) {
+			return json.RawMessage(nil), nil
+		}},
+	})
+	newItem := func(id string) map[string]interface{} {
+		return map[string]interface{}{"id": id, "count": 3, "secret": "s3cret"}
+	}
+	query := newObjectType("Query", graphql.Fields{
+		"item": {Type: item, Args: idArgs(), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return newItem(p.Args["id"].(string)), nil
+		}},
+		"items": {Type: graphql.NewList(item), Args: pageArgs(), Resolve: func(p graphql.ResolveParams) (
+			interface{}, error) {
+			limit, _ := page(p.Args)
+			var items []map[string]interface{}
+			for i := 0; i < limit; i++ {
+				items = append(items, newItem(strings.Repeat("i", i+1)))
+			}
+			return items, nil
+		}},
+		"echo": {Type: jsonType, Args: graphql.FieldConfigArgument{"value": {Type: jsonType}},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Args["value"], nil
+			}},
+	})
+	s, err := graphql.NewSchema(graphql.SchemaConfig{Query: query})
+	require.NoError(t, err)
+	return &s
+}
+
+// executeJSON executes a request against the test schema and returns the JSON encoded response.
+func executeJSON(t *testing.T, ctx context.Context, req *Request, maxDepth int) string {
+	t.Helper()
+	encoded, err := json.Marshal(execute(ctx, newTestSchema(t), req, maxDepth))
+	require.NoError(t, err)
+	return string(encoded)
+}
+
+func TestExecute_FieldErrors(t *testing.T) {
+	security.InitSystemPermissions("")
+
+	result := executeJSON(t, context.Background(), &Request{Query: `{ items(limit: 1) { id secret broken } }`}, 0)
+	assert.JSONEq(t, `{
+		"data": {"items": [{"id": "i", "secret": null, "broken": null}]},
+		"errors": [
+			{"message": "The caller does not have the permission required to read this field",
+				"locations": [{"line": 1, "column": 24}], "path": ["items", 0, "secret"],
+				"extensions": {"code": "GQL-1002"}},
+			{"message": "broken field", "locations": [{"line": 1, "column": 31}], "path": ["items", 0, "broken"],
+				"extensions": {"code": "TST-1001"}}
+		]
+	}`, result)
+
+	ctx := security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest("admin", "", "token", []string{"system"}, nil))
+	assert.JSONEq(t, `{"data":{"item":{"secret":"s3cret"}}}`,
+		executeJSON(t, ctx, &Request{Query: `{ item(id: "1") { secret } }`}, 0))
+}
+
+func TestExecute_RequestErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"SyntaxError", `{ item(id: "1") { id }`},
+		{"UnknownField", `{ item(id: "1") { name } }`},
+		{"MissingRequiredArgument", `{ item { id } }`},
+		{"Mutation", `mutation { item(id: "1") { id } }`},
+		{"AmbiguousOperation", `query A { item(id: "1") { id } } query B { item(id: "2") { id } }`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response := execute(context.Background(), newTestSchema(t), &Request{Query: tc.query}, 0)
+			assert.Nil(t, response.Data)
+			assert.NotEmpty(t, response.Errors)
+		})
+	}
+}
+
+func TestExecute_OperationName(t *testing.T) {
+	result := executeJSON(t, context.Background(), &Request{
+		Query:         `query A { item(id: "1") { id } } query B($id: ID!) { item(id: $id) { id } }`,
+		OperationName: "B",
+		Variables:     map[string]interface{}{"id": "2"},
+	}, 0)
+	assert.JSONEq(t, `{"data":{"item":{"id":"2"}}}`, result)
+}
+
+func TestExecute_JSONScalar(t *testing.T) {
+	result := executeJSON(t, context.Background(), &Request{
+		Query: `{ echo(value: {a: [1, 2.5, "x", true]}) item(id: "1") { empty } }`,
+	}, 0)
+	assert.JSONEq(t, `{"data":{"echo":{"a":[1,2.5,"x",true]},"item":{"empty":null}}}`, result)
+
+	result = executeJSON(t, context.Background(), &Request{
+		Query:     `query Q($v: JSON) { echo(value: $v) }`,
+		Variables: map[string]interface{}{"v": map[string]interface{}{"b": "y"}},
+	}, 0)
+	assert.JSONEq(t, `{"data":{"echo":{"b":"y"}}}`, result)
+}
+
+func TestExecute_MaxDepth(t *testing.T) {
+	result := executeJSON(t, context.Background(), &Request{Query: `{ item(id: "1") { id } }`}, 2)
+	assert.JSONEq(t, `{"data":{"item":{"id":"1"}}}`, result)
+
+	for _, query := range []string{
+		`{ item(id: "1") { id } ...F } fragment F on Query { items { id } }`,
+		`{ ... on Query { item(id: "1") { id } } }`,
+	} {
+		response := execute(context.Background(), newTestSchema(t), &Request{Query: query}, 1)
+		require.Len(t, response.Errors, 1, query)
+		assert.Equal(t, "Query depth exceeds the maximum allowed depth of 1.", response.Errors[0].Message)
+		assert.Nil(t, response.Data)
+	}
+}
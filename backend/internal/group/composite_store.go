@@ -381,6 +381,31 @@ func (c *compositeGroupStore) GetGroupsByIDs(ctx context.Context, groupIDs []str
 	return append(dbGroups, fileGroups...), nil
 }
 
+// GetTransitiveGroupMembers returns all members of a group across both stores, including members of
+// nested groups, deduplicated by member type and ID. As with GetTransitiveGroupsForEntity, each store
+// resolves nesting only within itself.
+func (c *compositeGroupStore) GetTransitiveGroupMembers(ctx context.Context, groupID string) ([]Member, error) {
+	dbMembers, err := c.dbStore.GetTransitiveGroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	fileMembers, err := c.fileStore.GetTransitiveGroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[Member]bool, len(dbMembers))
+	result := make([]Member, 0, len(dbMembers)+len(fileMembers))
+	for _, m := range append(dbMembers, fileMembers...) {
+		if !seen[m] {
+			result = append(result, m)
+			seen[m] = true
+		}
+	}
+	return result, nil
+}
+
 // IsGroupDeclarative checks if the group exists in the file-based store.
 func (c *compositeGroupStore) IsGroupDeclarative(ctx context.Context, id string) (bool, error) {
 	return c.fileStore.IsGroupDeclarative(ctx, id)
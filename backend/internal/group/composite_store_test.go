@@ -310,3 +310,30 @@ func (suite *CompositeGroupStoreTestSuite) TestGetTransitiveGroupsForEntity_File
 	suite.Error(err)
 	suite.Equal(testErr, err)
 }
+
+// --- GetTransitiveGroupMembers ---
+
+func (suite *CompositeGroupStoreTestSuite) TestGetTransitiveGroupMembers_MergesAndDeduplicates() {
+	suite.mockDBStore.On("GetTransitiveGroupMembers", mock.Anything, "grp1").
+		Return([]Member{{ID: "user1", Type: memberTypeEntity}, {ID: "grp2", Type: MemberTypeGroup}}, nil)
+	suite.mockFileStore.On("GetTransitiveGroupMembers", mock.Anything, "grp1").
+		Return([]Member{{ID: "grp2", Type: MemberTypeGroup}, {ID: "user2", Type: memberTypeEntity}}, nil)
+
+	members, err := suite.store.GetTransitiveGroupMembers(context.Background(), "grp1")
+
+	suite.NoError(err)
+	suite.Equal([]Member{
+		{ID: "user1", Type: memberTypeEntity},
+		{ID: "grp2", Type: MemberTypeGroup},
+		{ID: "user2", Type: memberTypeEntity},
+	}, members)
+}
+
+func (suite *CompositeGroupStoreTestSuite) TestGetTransitiveGroupMembers_DBStoreError() {
+	testErr := errors.New("db error")
+	suite.mockDBStore.On("GetTransitiveGroupMembers", mock.Anything, "grp1").Return(nil, testErr)
+
+	_, err := suite.store.GetTransitiveGroupMembers(context.Background(), "grp1")
+
+	suite.Equal(testErr, err)
+}
@@ -183,6 +183,20 @@ var (
 			DefaultValue: "The member type must be 'user', 'group', or 'app'",
 		},
 	}
+	// ErrorGroupMembershipCycle is the error returned when adding a group member would make a group
+	// a member of itself, directly or through nested groups.
+	ErrorGroupMembershipCycle = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "GRP-1017",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.groupservice.group_membership_cycle",
+			DefaultValue: "Group membership cycle",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.groupservice.group_membership_cycle_description",
+			DefaultValue: "A group cannot be a member of itself, directly or through nested groups",
+		},
+	}
 )
 
 // Declarative mode errors for group management operations.
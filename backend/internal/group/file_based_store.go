@@ -555,6 +555,53 @@ func (f *fileBasedGroupStore) GetTransitiveGroupsForEntity(
 	return result, nil
 }
 
+// GetTransitiveGroupMembers returns all members of the given declarative group, including members
+// of nested groups. A BFS walk follows MemberTypeGroup edges downward; a visited set prevents
+// infinite loops on cyclic group graphs.
+func (f *fileBasedGroupStore) GetTransitiveGroupMembers(ctx context.Context, groupID string) ([]Member, error) {
+	list, err := f.GenericFileBasedStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	allGroups := make(map[string]groupDeclarativeResource, len(list))
+	for _, item := range list {
+		grpData, err := groupFromDeclarativeData(item.ID.ID, item.Data)
+		if err != nil {
+			continue
+		}
+		allGroups[grpData.ID] = grpData
+	}
+
+	visitedGroups := map[string]bool{groupID: true}
+	seenMembers := make(map[Member]bool)
+	result := make([]Member, 0)
+
+	queue := []string{groupID}
+	for len(queue) > 0 {
+		currentID := queue[0]
+		queue = queue[1:]
+		grp, ok := allGroups[currentID]
+		if !ok {
+			continue
+		}
+		for _, member := range grp.Members {
+			key := Member{ID: member.ID, Type: member.Type}
+			if seenMembers[key] {
+				continue
+			}
+			seenMembers[key] = true
+			result = append(result, key)
+			if member.Type == MemberTypeGroup && !visitedGroups[member.ID] {
+				visitedGroups[member.ID] = true
+				queue = append(queue, member.ID)
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // isGroupNotFoundError checks whether the error signals a missing entity.
 func isGroupNotFoundError(err error) bool {
 	if err == nil {
@@ -509,5 +509,40 @@ func (suite *GroupFileBasedStoreTestSuite) TestGetTransitiveGroupsForEntity_NoCy
 	suite.Len(groups, 2, "each group must appear exactly once despite the cycle")
 }
 
+func (suite *GroupFileBasedStoreTestSuite) TestGetTransitiveGroupMembers_NestedAndCircularGroups() {
+	suite.seedGroup(groupDeclarativeResource{
+		ID: "gA", Name: "A", OUID: "ou1",
+		Members: []Member{
+			{ID: "user1", Type: memberTypeEntity},
+			{ID: "gB", Type: MemberTypeGroup},
+		},
+	})
+	suite.seedGroup(groupDeclarativeResource{
+		ID: "gB", Name: "B", OUID: "ou1",
+		Members: []Member{
+			{ID: "user2", Type: memberTypeEntity},
+			{ID: "user1", Type: memberTypeEntity},
+			{ID: "gA", Type: MemberTypeGroup},
+		},
+	})
+
+	members, err := suite.store.GetTransitiveGroupMembers(context.Background(), "gA")
+
+	suite.NoError(err)
+	suite.ElementsMatch([]Member{
+		{ID: "user1", Type: memberTypeEntity},
+		{ID: "gB", Type: MemberTypeGroup},
+		{ID: "user2", Type: memberTypeEntity},
+		{ID: "gA", Type: MemberTypeGroup},
+	}, members)
+}
+
+func (suite *GroupFileBasedStoreTestSuite) TestGetTransitiveGroupMembers_UnknownGroup() {
+	members, err := suite.store.GetTransitiveGroupMembers(context.Background(), "missing")
+
+	suite.NoError(err)
+	suite.Empty(members)
+}
+
 // Ensure the return type satisfies entity.GroupMembershipProvider.
 var _ entitypkg.GroupMembershipProvider = (*fileBasedGroupStore)(nil)
@@ -1125,6 +1125,74 @@ func (_c *groupStoreInterfaceMock_GetGroupsByOrganizationUnitCount_Call) RunAndR
 	return _c
 }
 
+// GetTransitiveGroupMembers provides a mock function for the type groupStoreInterfaceMock
+func (_mock *groupStoreInterfaceMock) GetTransitiveGroupMembers(ctx context.Context, groupID string) ([]Member, error) {
+	ret := _mock.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransitiveGroupMembers")
+	}
+
+	var r0 []Member
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]Member, error)); ok {
+		return returnFunc(ctx, groupID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []Member); ok {
+		r0 = returnFunc(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Member)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// groupStoreInterfaceMock_GetTransitiveGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransitiveGroupMembers'
+type groupStoreInterfaceMock_GetTransitiveGroupMembers_Call struct {
+	*mock.Call
+}
+
+// GetTransitiveGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+func (_e *groupStoreInterfaceMock_Expecter) GetTransitiveGroupMembers(ctx interface{}, groupID interface{}) *groupStoreInterfaceMock_GetTransitiveGroupMembers_Call {
+	return &groupStoreInterfaceMock_GetTransitiveGroupMembers_Call{Call: _e.mock.On("GetTransitiveGroupMembers", ctx, groupID)}
+}
+
+func (_c *groupStoreInterfaceMock_GetTransitiveGroupMembers_Call) Run(run func(ctx context.Context, groupID string)) *groupStoreInterfaceMock_GetTransitiveGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *groupStoreInterfaceMock_GetTransitiveGroupMembers_Call) Return(members []Member, err error) *groupStoreInterfaceMock_GetTransitiveGroupMembers_Call {
+	_c.Call.Return(members, err)
+	return _c
+}
+
+func (_c *groupStoreInterfaceMock_GetTransitiveGroupMembers_Call) RunAndReturn(run func(ctx context.Context, groupID string) ([]Member, error)) *groupStoreInterfaceMock_GetTransitiveGroupMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTransitiveGroupsForEntity provides a mock function for the type groupStoreInterfaceMock
 func (_mock *groupStoreInterfaceMock) GetTransitiveGroupsForEntity(ctx context.Context, entityID string) ([]providers.EntityGroup, error) {
 	ret := _mock.Called(ctx, entityID)
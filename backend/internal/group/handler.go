@@ -130,7 +130,7 @@ func (gh *groupHandler) HandleGroupPostRequest(w http.ResponseWriter, r *http.Re
 				Params:       map[string]string{"error": err.Error()},
 			},
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -173,7 +173,7 @@ func (gh *groupHandler) HandleGroupPostByPathRequest(w http.ResponseWriter, r *h
 				Params:       map[string]string{"error": err.Error()},
 			},
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -202,7 +202,7 @@ func (gh *groupHandler) HandleGroupGetRequest(w http.ResponseWriter, r *http.Req
 			Message:     ErrorMissingGroupID.Error,
 			Description: ErrorMissingGroupID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -232,7 +232,7 @@ func (gh *groupHandler) HandleGroupPutRequest(w http.ResponseWriter, r *http.Req
 			Message:     ErrorMissingGroupID.Error,
 			Description: ErrorMissingGroupID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -252,7 +252,7 @@ func (gh *groupHandler) HandleGroupPutRequest(w http.ResponseWriter, r *http.Req
 				Params:       map[string]string{"error": err.Error()},
 			},
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -268,6 +268,66 @@ func (gh *groupHandler) HandleGroupPutRequest(w http.ResponseWriter, r *http.Req
 	logger.Debug(ctx, "Successfully updated group", log.String("group id", id))
 }
 
+// HandleGroupPatchRequest handles the partial update group request. The body is a JSON Merge Patch
+// (RFC 7396) document applied to the group's current name, description and organization unit.
+func (gh *groupHandler) HandleGroupPatchRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorMissingGroupID.Code,
+			Message:     ErrorMissingGroupID.Error,
+			Description: ErrorMissingGroupID.ErrorDescription,
+		}
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	current, svcErr := gh.groupService.GetGroup(ctx, id, false)
+	if svcErr != nil {
+		gh.handleError(ctx, w, svcErr)
+		return
+	}
+
+	updateRequest, err := sysutils.DecodeMergePatchBody[UpdateGroupRequest](r, UpdateGroupRequest{
+		Name:        current.Name,
+		Description: current.Description,
+		OUID:        current.OUID,
+	})
+	if err != nil {
+		var valErr *sysutils.ValidationError
+		if errors.As(err, &valErr) {
+			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
+			return
+		}
+		errResp := apierror.ErrorResponse{
+			Code:    ErrorInvalidRequestFormat.Code,
+			Message: ErrorInvalidRequestFormat.Error,
+			Description: tidcommon.I18nMessage{
+				Key:          "error.groupservice.patch_group_request_parse_failed_description",
+				DefaultValue: "Failed to parse merge patch document: {{param(error)}}",
+				Params:       map[string]string{"error": err.Error()},
+			},
+		}
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	sanitizedRequest := gh.sanitizeUpdateGroupRequest(updateRequest)
+	group, svcErr := gh.groupService.UpdateGroup(ctx, id, sanitizedRequest)
+	if svcErr != nil {
+		gh.handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, group)
+
+	logger.Debug(ctx, "Successfully patched group", log.String("group id", id))
+}
+
 // HandleGroupDeleteRequest handles the delete group request.
 func (gh *groupHandler) HandleGroupDeleteRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -281,7 +341,7 @@ func (gh *groupHandler) HandleGroupDeleteRequest(w http.ResponseWriter, r *http.
 			Message:     ErrorMissingGroupID.Error,
 			Description: ErrorMissingGroupID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -297,6 +357,23 @@ func (gh *groupHandler) HandleGroupDeleteRequest(w http.ResponseWriter, r *http.
 
 // HandleGroupMembersGetRequest handles the get group members request.
 func (gh *groupHandler) HandleGroupMembersGetRequest(w http.ResponseWriter, r *http.Request) {
+	gh.handleMemberListRequest(w, r, gh.groupService.GetGroupMembers, "Successfully retrieved group members")
+}
+
+// HandleGroupEffectiveMembersGetRequest handles the get effective group members request, which lists
+// the members of a group including those inherited through nested groups.
+func (gh *groupHandler) HandleGroupEffectiveMembersGetRequest(w http.ResponseWriter, r *http.Request) {
+	gh.handleMemberListRequest(w, r, gh.groupService.GetEffectiveMembers,
+		"Successfully retrieved effective group members")
+}
+
+// handleMemberListRequest is the shared implementation of the member list handlers.
+func (gh *groupHandler) handleMemberListRequest(
+	w http.ResponseWriter, r *http.Request,
+	list func(ctx context.Context, groupID string, limit, offset int, includeDisplay bool) (
+		*MemberListResponse, *tidcommon.ServiceError),
+	successMsg string,
+) {
 	ctx := r.Context()
 
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
@@ -308,7 +385,7 @@ func (gh *groupHandler) HandleGroupMembersGetRequest(w http.ResponseWriter, r *h
 			Message:     ErrorMissingGroupID.Error,
 			Description: ErrorMissingGroupID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -320,7 +397,7 @@ func (gh *groupHandler) HandleGroupMembersGetRequest(w http.ResponseWriter, r *h
 
 	includeDisplay := r.URL.Query().Get(sysutils.QueryParamInclude) == sysutils.IncludeValueDisplay
 
-	memberListResponse, svcErr := gh.groupService.GetGroupMembers(ctx, id, limit, offset, includeDisplay)
+	memberListResponse, svcErr := list(ctx, id, limit, offset, includeDisplay)
 	if svcErr != nil {
 		gh.handleError(ctx, w, svcErr)
 		return
@@ -328,7 +405,7 @@ func (gh *groupHandler) HandleGroupMembersGetRequest(w http.ResponseWriter, r *h
 
 	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, memberListResponse)
 
-	logger.Debug(ctx, "Successfully retrieved group members", log.String("group id", id),
+	logger.Debug(ctx, successMsg, log.String("group id", id),
 		log.Int("limit", limit), log.Int("offset", offset),
 		log.Int("totalResults", memberListResponse.TotalResults),
 		log.Int("count", memberListResponse.Count))
@@ -419,7 +496,8 @@ func (gh *groupHandler) handleError(ctx context.Context, w http.ResponseWriter,
 			ErrorInvalidRequestFormat.Code, ErrorMissingGroupID.Code,
 			ErrorInvalidLimit.Code, ErrorInvalidOffset.Code,
 			ErrorEmptyMembers.Code, ErrorInvalidMemberType.Code,
-			ErrorInvalidMemberID.Code, ErrorInvalidGroupMemberID.Code:
+			ErrorInvalidMemberID.Code, ErrorInvalidGroupMemberID.Code,
+			ErrorGroupMembershipCycle.Code:
 			statusCode = http.StatusBadRequest
 		case tidcommon.ErrorUnauthorized.Code:
 			statusCode = http.StatusForbidden
@@ -435,7 +513,7 @@ func (gh *groupHandler) handleError(ctx context.Context, w http.ResponseWriter,
 		Message:     svcErr.Error,
 		Description: svcErr.ErrorDescription,
 	}
-	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+	sysutils.WriteProblemResponse(ctx, w, statusCode, errResp)
 }
 
 // sanitizeCreateGroupRequest sanitizes the create group request input.
@@ -444,6 +522,7 @@ func (gh *groupHandler) sanitizeCreateGroupRequest(request *CreateGroupRequest)
 		Name:        sysutils.SanitizeString(request.Name),
 		Description: sysutils.SanitizeString(request.Description),
 		OUID:        sysutils.SanitizeString(request.OUID),
+		OUHandle:    sysutils.SanitizeString(request.OUHandle),
 	}
 
 	if request.Members != nil {
@@ -523,7 +602,7 @@ func extractAndValidatePath(w http.ResponseWriter, r *http.Request) (string, boo
 				DefaultValue: "Handle path is required",
 			},
 		}
-		sysutils.WriteErrorResponse(r.Context(), w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(r.Context(), w, http.StatusBadRequest, errResp)
 		return "", true
 	}
 	return path, false
@@ -47,7 +47,7 @@ var testEncodingErrorBody = func() string {
 		Message:     tidcommon.ErrorEncodingError.Error,
 		Description: tidcommon.ErrorEncodingError.ErrorDescription,
 	}
-	b, _ := json.Marshal(resp)
+	b, _ := json.Marshal(apierror.NewProblemDetails(http.StatusInternalServerError, resp))
 	return string(b)
 }()
 
@@ -234,6 +234,44 @@ func (suite *GroupHandlerTestSuite) TestGroupHandler_RegisterRoutesGroupMembersD
 	require.Equal(t, http.StatusOK, resp.Code)
 }
 
+func (suite *GroupHandlerTestSuite) TestGroupHandler_RegisterRoutesGroupEffectiveMembersDispatch() {
+	t := suite.T()
+	suite.ensureRuntime()
+	mux := http.NewServeMux()
+	serviceMock := NewGroupServiceInterfaceMock(t)
+	handler := newGroupHandler(serviceMock)
+	registerRoutes(mux, handler)
+
+	serviceMock.
+		On("GetEffectiveMembers", mock.Anything, "grp-001", serverconst.DefaultPageSize, 0, false).
+		Return(&MemberListResponse{}, nil).
+		Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/groups/grp-001/effective-members", nil)
+	resp := httptest.NewRecorder()
+	mux.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+}
+
+func (suite *GroupHandlerTestSuite) TestGroupHandler_HandleGroupMembersAddRequest_Cycle() {
+	t := suite.T()
+	serviceMock := NewGroupServiceInterfaceMock(t)
+	serviceMock.
+		On("AddGroupMembers", mock.Anything, "grp-001", []Member{{ID: "grp-002", Type: MemberTypeGroup}}).
+		Return(nil, &ErrorGroupMembershipCycle).
+		Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/groups/grp-001/members/add",
+		strings.NewReader(`{"members":[{"id":"grp-002","type":"group"}]}`))
+	req.SetPathValue("id", "grp-001")
+	resp := httptest.NewRecorder()
+	newGroupHandler(serviceMock).HandleGroupMembersAddRequest(resp, req)
+
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Contains(t, resp.Body.String(), ErrorGroupMembershipCycle.Code)
+}
+
 func (suite *GroupHandlerTestSuite) TestGroupHandler_RegisterRoutesGroupIDNotFoundPath() {
 	t := suite.T()
 	suite.ensureRuntime()
@@ -343,7 +381,7 @@ func (suite *GroupHandlerTestSuite) TestGroupHandler_HandleGroupListRequest() {
 			requestPath: "/groups?limit=invalid",
 			assertBody: func(recorder *httptest.ResponseRecorder) {
 				suite.Require().Equal(http.StatusBadRequest, recorder.Code)
-				suite.Require().Equal(serverconst.ContentTypeJSON,
+				suite.Require().Equal(serverconst.ContentTypeProblemJSON,
 					recorder.Header().Get(serverconst.ContentTypeHeaderName))
 
 				var body apierror.ErrorResponse
@@ -582,7 +620,7 @@ func (suite *GroupHandlerTestSuite) TestGroupHandler_HandleGroupPostRequest() {
 			body: "{invalid json",
 			assert: func(rr *httptest.ResponseRecorder) {
 				require.Equal(suite.T(), http.StatusBadRequest, rr.Code)
-				require.Equal(suite.T(), serverconst.ContentTypeJSON,
+				require.Equal(suite.T(), serverconst.ContentTypeProblemJSON,
 					rr.Header().Get(serverconst.ContentTypeHeaderName))
 				var body apierror.ErrorResponse
 				require.NoError(suite.T(), json.Unmarshal(rr.Body.Bytes(), &body))
@@ -1726,3 +1764,90 @@ func (suite *GroupHandlerTestSuite) TestGroupHandler_ValidationError_NameTooShor
 	// Confirms validation intercepted the flow and short-circuited the mock service call
 	serviceMock.AssertNotCalled(suite.T(), "CreateGroup", mock.Anything, mock.Anything)
 }
+
+func (suite *GroupHandlerTestSuite) TestGroupHandler_HandleGroupPatchRequest() {
+	testCases := []handlerTestCase{
+		{
+			name:           "success merges patch into current group",
+			method:         http.MethodPatch,
+			url:            "/groups/grp-001",
+			pathParamKey:   "id",
+			pathParamValue: "grp-001",
+			body:           `{"description":"updated"}`,
+			setJSONHeader:  true,
+			setup: func(serviceMock *GroupServiceInterfaceMock) {
+				serviceMock.
+					On("GetGroup", mock.Anything, "grp-001", false).
+					Return(&Group{ID: "grp-001", Name: "team", Description: "old", OUID: testOUID}, nil).
+					Once()
+				serviceMock.
+					On("UpdateGroup", mock.Anything, "grp-001", mock.MatchedBy(func(request UpdateGroupRequest) bool {
+						return request.Name == "team" && request.Description == "updated" && request.OUID == testOUID
+					})).
+					Return(&Group{ID: "grp-001"}, nil).
+					Once()
+			},
+			assert: func(rr *httptest.ResponseRecorder) {
+				require.Equal(suite.T(), http.StatusOK, rr.Code)
+			},
+		},
+		{
+			name:           "group not found",
+			method:         http.MethodPatch,
+			url:            "/groups/grp-001",
+			pathParamKey:   "id",
+			pathParamValue: "grp-001",
+			body:           `{"description":"updated"}`,
+			setJSONHeader:  true,
+			setup: func(serviceMock *GroupServiceInterfaceMock) {
+				serviceMock.
+					On("GetGroup", mock.Anything, "grp-001", false).
+					Return(nil, &ErrorGroupNotFound).
+					Once()
+			},
+			assert: func(rr *httptest.ResponseRecorder) {
+				require.Equal(suite.T(), http.StatusNotFound, rr.Code)
+			},
+			assertService: func(serviceMock *GroupServiceInterfaceMock) {
+				serviceMock.AssertNotCalled(suite.T(), "UpdateGroup", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:           "removing required field fails validation",
+			method:         http.MethodPatch,
+			url:            "/groups/grp-001",
+			pathParamKey:   "id",
+			pathParamValue: "grp-001",
+			body:           `{"name":null}`,
+			setJSONHeader:  true,
+			setup: func(serviceMock *GroupServiceInterfaceMock) {
+				serviceMock.
+					On("GetGroup", mock.Anything, "grp-001", false).
+					Return(&Group{ID: "grp-001", Name: "team", OUID: testOUID}, nil).
+					Once()
+			},
+			assert: func(rr *httptest.ResponseRecorder) {
+				require.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+			},
+			assertService: func(serviceMock *GroupServiceInterfaceMock) {
+				serviceMock.AssertNotCalled(suite.T(), "UpdateGroup", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:   "missing id",
+			method: http.MethodPatch,
+			url:    "/groups/",
+			body:   `{}`,
+			assert: func(rr *httptest.ResponseRecorder) {
+				require.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+			},
+			assertService: func(serviceMock *GroupServiceInterfaceMock) {
+				serviceMock.AssertNotCalled(suite.T(), "GetGroup", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	runHandlerTestCases(suite, testCases, func(handler *groupHandler, writer http.ResponseWriter, req *http.Request) {
+		handler.HandleGroupPatchRequest(writer, req)
+	})
+}
@@ -147,12 +147,12 @@ func registerRoutes(mux *http.ServeMux, groupHandler *groupHandler) {
 	}, opts1))
 
 	opts2 := middleware.CORSOptions{
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
-	// Special handling for /groups/{id} and /groups/{id}/members
+	// Special handling for /groups/{id}, /groups/{id}/members and /groups/{id}/effective-members
 	mux.HandleFunc(middleware.WithCORS("GET /groups/",
 		func(w http.ResponseWriter, r *http.Request) {
 			path := strings.TrimPrefix(r.URL.Path, "/groups/")
@@ -163,11 +163,14 @@ func registerRoutes(mux *http.ServeMux, groupHandler *groupHandler) {
 				groupHandler.HandleGroupGetRequest(w, r)
 			} else if len(segments) == 2 && segments[1] == "members" {
 				groupHandler.HandleGroupMembersGetRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "effective-members" {
+				groupHandler.HandleGroupEffectiveMembersGetRequest(w, r)
 			} else {
 				http.NotFound(w, r)
 			}
 		}, opts2))
 	mux.HandleFunc(middleware.WithCORS("PUT /groups/{id}", groupHandler.HandleGroupPutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PATCH /groups/{id}", groupHandler.HandleGroupPatchRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("DELETE /groups/{id}", groupHandler.HandleGroupDeleteRequest, opts2))
 	// Handle OPTIONS preflight for /groups/{id} and /groups/{id}/members using the same
 	// catch-all pattern as the GET handler above, to avoid conflicts with /groups/tree/{path...}.
@@ -52,8 +52,8 @@ func (t MemberType) IsEntityType() bool {
 
 // Member represents a member of a group (either user or another group).
 type Member struct {
-	ID      string     `json:"id" yaml:"id"`
-	Type    MemberType `json:"type" yaml:"type"`
+	ID      string     `json:"id" yaml:"id" native:"required"`
+	Type    MemberType `json:"type" yaml:"type" native:"required,oneof=user app agent group"`
 	Display string     `json:"display,omitempty" yaml:"display,omitempty"`
 }
 
@@ -99,22 +99,23 @@ type GroupDAO struct {
 
 // MembersRequest represents the request body for adding or removing members from a group.
 type MembersRequest struct {
-	Members []Member `json:"members"`
+	Members []Member `json:"members" native:"dive"`
 }
 
 // CreateGroupRequest represents the request body for creating a group.
 type CreateGroupRequest struct {
 	ID          string   `json:"-"`
 	Name        string   `json:"name" native:"required,min=3,max=64"`
-	Description string   `json:"description,omitempty"`
-	OUID        string   `json:"ouId" native:"required"`
+	Description string   `json:"description,omitempty" native:"omitempty,max=255"`
+	OUID        string   `json:"ouId,omitempty"`
+	OUHandle    string   `json:"ouHandle,omitempty"`
 	Members     []Member `json:"members,omitempty" native:"dive"`
 }
 
 // UpdateGroupRequest represents the request body for updating a group.
 type UpdateGroupRequest struct {
 	Name        string `json:"name" native:"required,min=3,max=64"`
-	Description string `json:"description,omitempty"`
+	Description string `json:"description,omitempty" native:"omitempty,max=255"`
 	OUID        string `json:"ouId" native:"required"`
 }
 
@@ -58,6 +58,8 @@ type GroupServiceInterface interface {
 	DeleteGroup(ctx context.Context, groupID string) *tidcommon.ServiceError
 	GetGroupMembers(ctx context.Context, groupID string, limit, offset int, includeDisplay bool) (
 		*MemberListResponse, *tidcommon.ServiceError)
+	GetEffectiveMembers(ctx context.Context, groupID string, limit, offset int, includeDisplay bool) (
+		*MemberListResponse, *tidcommon.ServiceError)
 	ValidateGroupIDs(ctx context.Context, groupIDs []string) *tidcommon.ServiceError
 	GetGroupsByIDs(ctx context.Context, groupIDs []string) (map[string]*Group, *tidcommon.ServiceError)
 	AddGroupMembers(ctx context.Context, groupID string, members []Member) (*Group, *tidcommon.ServiceError)
@@ -287,6 +289,10 @@ func (gs *groupService) CreateGroup(ctx context.Context, request CreateGroupRequ
 		return nil, &ErrorDeclarativeModeGroupCreateNotAllowed
 	}
 
+	if err := gs.resolveOUIDFromHandle(ctx, &request); err != nil {
+		return nil, err
+	}
+
 	if err := gs.validateCreateGroupRequest(request); err != nil {
 		return nil, err
 	}
@@ -708,6 +714,76 @@ func (gs *groupService) GetGroupMembers(ctx context.Context, groupID string, lim
 	return response, nil
 }
 
+// GetEffectiveMembers retrieves the users, apps and agents that belong to a group either directly or
+// through nested groups. Nested groups themselves are not included.
+func (gs *groupService) GetEffectiveMembers(ctx context.Context, groupID string, limit, offset int,
+	includeDisplay bool) (*MemberListResponse, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if err := validatePaginationParams(limit, offset); err != nil {
+		return nil, err
+	}
+
+	if groupID == "" {
+		return nil, &ErrorMissingGroupID
+	}
+
+	existingGroupDAO, err := gs.groupStore.GetGroup(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, ErrGroupNotFound) {
+			logger.Debug(ctx, "Group not found", log.String("id", groupID))
+			return nil, &ErrorGroupNotFound
+		}
+		logger.Error(ctx, "Failed to retrieve group", log.String("id", groupID), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	if err := gs.checkGroupAccess(
+		ctx,
+		security.ActionReadGroup,
+		existingGroupDAO.OUID,
+		groupID,
+	); err != nil {
+		return nil, err
+	}
+
+	allMembers, err := gs.groupStore.GetTransitiveGroupMembers(ctx, groupID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get transitive group members", log.String("groupID", groupID),
+			log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	seen := make(map[string]bool, len(allMembers))
+	effective := make([]Member, 0, len(allMembers))
+	for _, m := range allMembers {
+		if m.Type == MemberTypeGroup || seen[m.ID] {
+			continue
+		}
+		seen[m.ID] = true
+		effective = append(effective, m)
+	}
+
+	totalCount := len(effective)
+	page := effective[min(offset, totalCount):min(offset+limit, totalCount)]
+
+	members, svcErr := gs.resolveMembers(ctx, page, includeDisplay, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	baseURL := fmt.Sprintf("/groups/%s/effective-members", groupID)
+	links := utils.BuildPaginationLinks(baseURL, limit, offset, totalCount, utils.DisplayQueryParam(includeDisplay))
+
+	return &MemberListResponse{
+		TotalResults: totalCount,
+		Members:      members,
+		StartIndex:   offset + 1,
+		Count:        len(members),
+		Links:        links,
+	}, nil
+}
+
 // resolveMembers resolves the public member type (user/app) from the internal 'entity' type
 // and optionally populates display names.
 func (gs *groupService) resolveMembers(
@@ -807,7 +883,7 @@ func (gs *groupService) AddGroupMembers(
 	log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
 		Debug(ctx, "Adding members to group", log.String("id", groupID))
 	return gs.modifyGroupMembers(ctx, groupID, members,
-		gs.groupStore.AddGroupMembers,
+		gs.groupStore.AddGroupMembers, gs.checkMembershipCycle,
 		"Failed to add members to group",
 		"Successfully added members to group",
 	)
@@ -819,7 +895,7 @@ func (gs *groupService) RemoveGroupMembers(
 	log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
 		Debug(ctx, "Removing members from group", log.String("id", groupID))
 	return gs.modifyGroupMembers(ctx, groupID, members,
-		gs.groupStore.RemoveGroupMembers,
+		gs.groupStore.RemoveGroupMembers, nil,
 		"Failed to remove members from group",
 		"Successfully removed members from group",
 	)
@@ -827,11 +903,13 @@ func (gs *groupService) RemoveGroupMembers(
 
 // modifyGroupMembers is the shared implementation for AddGroupMembers and RemoveGroupMembers.
 // It validates, normalizes, and applies storeOp inside a transaction, then resolves member types.
+// When precheck is set, it runs in the same transaction right before storeOp.
 func (gs *groupService) modifyGroupMembers(
 	ctx context.Context,
 	groupID string,
 	members []Member,
 	storeOp func(context.Context, string, []Member) error,
+	precheck func(context.Context, string, []Member) *tidcommon.ServiceError,
 	errMsg, successMsg string,
 ) (*Group, *tidcommon.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
@@ -904,6 +982,13 @@ func (gs *groupService) modifyGroupMembers(
 			return errors.New("rollback for unauthorized access")
 		}
 
+		if precheck != nil {
+			if svcErr := precheck(txCtx, groupID, members); svcErr != nil {
+				capturedSvcErr = svcErr
+				return errors.New("rollback for failed member precheck")
+			}
+		}
+
 		if err := storeOp(txCtx, groupID, members); err != nil {
 			return err
 		}
@@ -936,6 +1021,64 @@ func (gs *groupService) modifyGroupMembers(
 	return &updatedGroup, nil
 }
 
+// checkMembershipCycle reports ErrorGroupMembershipCycle when adding the given members to the group
+// would make the group a member of itself, either directly or because the group is already a
+// transitive member of one of the groups being added.
+func (gs *groupService) checkMembershipCycle(
+	ctx context.Context, groupID string, members []Member,
+) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	for _, m := range members {
+		if m.Type != MemberTypeGroup {
+			continue
+		}
+		if m.ID == groupID {
+			return &ErrorGroupMembershipCycle
+		}
+
+		nested, err := gs.groupStore.GetTransitiveGroupMembers(ctx, m.ID)
+		if err != nil {
+			logger.Error(ctx, "Failed to resolve nested group members", log.String("groupID", m.ID),
+				log.Error(err))
+			return &tidcommon.InternalServerError
+		}
+		for _, n := range nested {
+			if n.Type == MemberTypeGroup && n.ID == groupID {
+				logger.Debug(ctx, "Group membership cycle detected", log.String("groupID", groupID),
+					log.String("memberGroupID", m.ID))
+				return &ErrorGroupMembershipCycle
+			}
+		}
+	}
+	return nil
+}
+
+// resolveOUIDFromHandle sets the request's OU ID from its OU handle path when no OU ID is given.
+func (gs *groupService) resolveOUIDFromHandle(
+	ctx context.Context, request *CreateGroupRequest,
+) *tidcommon.ServiceError {
+	if request.OUID != "" || request.OUHandle == "" {
+		return nil
+	}
+
+	if err := gs.validateAndProcessHandlePath(request.OUHandle); err != nil {
+		return err
+	}
+
+	ou, svcErr := gs.ouService.GetOrganizationUnitByPath(ctx, request.OUHandle)
+	if svcErr != nil {
+		if svcErr.Code == oupkg.ErrorOrganizationUnitNotFound.Code ||
+			svcErr.Code == oupkg.ErrorInvalidHandlePath.Code {
+			return &ErrorInvalidOUID
+		}
+		return svcErr
+	}
+
+	request.OUID = ou.ID
+	return nil
+}
+
 // validateCreateGroupRequest validates the create group request.
 func (gs *groupService) validateCreateGroupRequest(request CreateGroupRequest) *tidcommon.ServiceError {
 	if request.Name == "" {
@@ -635,6 +635,43 @@ func (suite *GroupServiceTestSuite) TestGroupService_CreateGroup() {
 			},
 			expectErr: &ErrorInvalidOUID,
 		},
+		{
+			name: "success with organization unit handle",
+			request: CreateGroupRequest{
+				Name:     "engineering",
+				OUHandle: "engineering/emea",
+			},
+			setup: func(args *setupArgs) {
+				args.ou.On("GetOrganizationUnitByPath", mock.Anything, "engineering/emea").
+					Return(providers.OrganizationUnit{ID: "ou-001"}, nil).
+					Once()
+				args.ou.On("IsOrganizationUnitExists", mock.Anything, "ou-001").
+					Return(true, nil).
+					Once()
+				args.store.On("CheckGroupNameConflictForCreate", mock.Anything, "engineering", "ou-001").
+					Return(nil).
+					Once()
+				args.store.On("CreateGroup", mock.Anything, mock.MatchedBy(func(group GroupDAO) bool {
+					return group.OUID == "ou-001"
+				})).
+					Return(nil).
+					Once()
+			},
+			expectRes: true,
+		},
+		{
+			name: "unknown organization unit handle",
+			request: CreateGroupRequest{
+				Name:     "engineering",
+				OUHandle: "missing",
+			},
+			setup: func(args *setupArgs) {
+				args.ou.On("GetOrganizationUnitByPath", mock.Anything, "missing").
+					Return(providers.OrganizationUnit{}, &oupkg.ErrorOrganizationUnitNotFound).
+					Once()
+			},
+			expectErr: &ErrorInvalidOUID,
+		},
 		{
 			name: "invalid user IDs",
 			request: CreateGroupRequest{
@@ -1710,6 +1747,83 @@ func (suite *GroupServiceTestSuite) TestGroupService_GetGroupMembers() {
 	}
 }
 
+func (suite *GroupServiceTestSuite) TestGroupService_GetEffectiveMembers() {
+	storeMock := newGroupStoreInterfaceMock(suite.T())
+	storeMock.On("GetGroup", mock.Anything, "grp-001").
+		Return(GroupDAO{ID: "grp-001"}, nil).
+		Once()
+	storeMock.On("GetTransitiveGroupMembers", mock.Anything, "grp-001").
+		Return([]Member{
+			{ID: "app-001", Type: memberTypeEntity},
+			{ID: "usr-001", Type: memberTypeEntity},
+			{ID: "grp-002", Type: MemberTypeGroup},
+			{ID: "usr-002", Type: memberTypeEntity},
+		}, nil).
+		Once()
+
+	entitySvcMock := entitymock.NewEntityServiceInterfaceMock(suite.T())
+	entitySvcMock.On("GetEntitiesByIDs", mock.Anything, []string{"usr-001", "usr-002"}).
+		Return([]providers.Entity{
+			{ID: "usr-001", Category: providers.EntityCategoryUser},
+			{ID: "usr-002", Category: providers.EntityCategoryUser},
+		}, nil).Once()
+
+	service := &groupService{
+		authzService:  newAllowAllAuthz(suite.T()),
+		groupStore:    storeMock,
+		entityService: entitySvcMock,
+	}
+
+	response, err := service.GetEffectiveMembers(context.Background(), "grp-001", 2, 1, false)
+	suite.Require().Nil(err)
+	suite.Require().Equal(3, response.TotalResults)
+	suite.Require().Equal(2, response.StartIndex)
+	suite.Require().Equal([]Member{
+		{ID: "usr-001", Type: MemberTypeUser},
+		{ID: "usr-002", Type: MemberTypeUser},
+	}, response.Members)
+}
+
+func (suite *GroupServiceTestSuite) TestGroupService_GetEffectiveMembers_Errors() {
+	suite.Run("group not found", func() {
+		storeMock := newGroupStoreInterfaceMock(suite.T())
+		storeMock.On("GetGroup", mock.Anything, "grp-001").
+			Return(GroupDAO{}, ErrGroupNotFound).Once()
+		service := &groupService{authzService: newAllowAllAuthz(suite.T()), groupStore: storeMock}
+
+		response, err := service.GetEffectiveMembers(context.Background(), "grp-001", 5, 0, false)
+		suite.Require().Nil(response)
+		suite.Require().Equal(ErrorGroupNotFound, *err)
+	})
+
+	suite.Run("store error", func() {
+		storeMock := newGroupStoreInterfaceMock(suite.T())
+		storeMock.On("GetGroup", mock.Anything, "grp-001").
+			Return(GroupDAO{ID: "grp-001"}, nil).Once()
+		storeMock.On("GetTransitiveGroupMembers", mock.Anything, "grp-001").
+			Return(nil, errors.New("db error")).Once()
+		service := &groupService{authzService: newAllowAllAuthz(suite.T()), groupStore: storeMock}
+
+		response, err := service.GetEffectiveMembers(context.Background(), "grp-001", 5, 0, false)
+		suite.Require().Nil(response)
+		suite.Require().Equal(tidcommon.InternalServerError, *err)
+	})
+
+	suite.Run("offset beyond results", func() {
+		storeMock := newGroupStoreInterfaceMock(suite.T())
+		storeMock.On("GetGroup", mock.Anything, "grp-001").
+			Return(GroupDAO{ID: "grp-001"}, nil).Once()
+		storeMock.On("GetTransitiveGroupMembers", mock.Anything, "grp-001").
+			Return([]Member{{ID: "grp-002", Type: MemberTypeGroup}}, nil).Once()
+		service := &groupService{authzService: newAllowAllAuthz(suite.T()), groupStore: storeMock}
+
+		response, err := service.GetEffectiveMembers(context.Background(), "grp-001", 5, 10, false)
+		suite.Require().Nil(err)
+		suite.Require().Equal(0, response.TotalResults)
+		suite.Require().Empty(response.Members)
+	})
+}
+
 func (suite *GroupServiceTestSuite) TestGroupService_GetGroupMembers_WithDisplay() {
 	storeMock := newGroupStoreInterfaceMock(suite.T())
 	storeMock.On("GetGroup", mock.Anything, "grp-001").
@@ -2260,6 +2374,66 @@ func (suite *GroupServiceTestSuite) TestGroupService_AddGroupMembers() {
 			authzSetup: newAccessDeniedUpdateGroupAuthz,
 			wantErr:    &tidcommon.ErrorUnauthorized,
 		},
+		{
+			name:    "group added to itself",
+			groupID: "grp-001",
+			members: []Member{{ID: "grp-001", Type: MemberTypeGroup}},
+			setup: func(storeMock *groupStoreInterfaceMock, _ *entitymock.EntityServiceInterfaceMock) {
+				storeMock.On("GetGroup", mock.Anything, "grp-001").
+					Return(GroupDAO{ID: "grp-001", Name: "test"}, nil)
+				storeMock.On("ValidateGroupIDs", mock.Anything, []string{"grp-001"}).
+					Return([]string{}, nil).Once()
+			},
+			wantErr: &ErrorGroupMembershipCycle,
+		},
+		{
+			name:    "nested group contains target group",
+			groupID: "grp-001",
+			members: []Member{{ID: "grp-002", Type: MemberTypeGroup}},
+			setup: func(storeMock *groupStoreInterfaceMock, _ *entitymock.EntityServiceInterfaceMock) {
+				storeMock.On("GetGroup", mock.Anything, "grp-001").
+					Return(GroupDAO{ID: "grp-001", Name: "test"}, nil)
+				storeMock.On("ValidateGroupIDs", mock.Anything, []string{"grp-002"}).
+					Return([]string{}, nil).Once()
+				storeMock.On("GetTransitiveGroupMembers", mock.Anything, "grp-002").
+					Return([]Member{
+						{ID: "grp-003", Type: MemberTypeGroup},
+						{ID: "grp-001", Type: MemberTypeGroup},
+					}, nil).Once()
+			},
+			wantErr: &ErrorGroupMembershipCycle,
+		},
+		{
+			name:    "nested group without cycle",
+			groupID: "grp-001",
+			members: []Member{{ID: "grp-002", Type: MemberTypeGroup}},
+			setup: func(storeMock *groupStoreInterfaceMock, _ *entitymock.EntityServiceInterfaceMock) {
+				storeMock.On("GetGroup", mock.Anything, "grp-001").
+					Return(GroupDAO{ID: "grp-001", Name: "test"}, nil)
+				storeMock.On("ValidateGroupIDs", mock.Anything, []string{"grp-002"}).
+					Return([]string{}, nil).Once()
+				storeMock.On("GetTransitiveGroupMembers", mock.Anything, "grp-002").
+					Return([]Member{{ID: "usr-001", Type: memberTypeEntity}}, nil).Once()
+				storeMock.On("AddGroupMembers", mock.Anything, "grp-001",
+					[]Member{{ID: "grp-002", Type: MemberTypeGroup}}).
+					Return(nil).Once()
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "cycle check failure",
+			groupID: "grp-001",
+			members: []Member{{ID: "grp-002", Type: MemberTypeGroup}},
+			setup: func(storeMock *groupStoreInterfaceMock, _ *entitymock.EntityServiceInterfaceMock) {
+				storeMock.On("GetGroup", mock.Anything, "grp-001").
+					Return(GroupDAO{ID: "grp-001", Name: "test"}, nil)
+				storeMock.On("ValidateGroupIDs", mock.Anything, []string{"grp-002"}).
+					Return([]string{}, nil).Once()
+				storeMock.On("GetTransitiveGroupMembers", mock.Anything, "grp-002").
+					Return(nil, errors.New("db error")).Once()
+			},
+			wantErr: &tidcommon.InternalServerError,
+		},
 	}
 
 	suite.runGroupMemberTests(testCases, func(svc *groupService, ctx context.Context, id string, members []Member) (
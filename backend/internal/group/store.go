@@ -57,6 +57,7 @@ type groupStoreInterface interface {
 	GetGroupsByIDs(ctx context.Context, groupIDs []string) ([]GroupBasicDAO, error)
 	IsGroupDeclarative(ctx context.Context, id string) (bool, error)
 	GetTransitiveGroupsForEntity(ctx context.Context, entityID string) ([]providers.EntityGroup, error)
+	GetTransitiveGroupMembers(ctx context.Context, groupID string) ([]Member, error)
 }
 
 // groupStore is the default implementation of groupStoreInterface.
@@ -612,6 +613,34 @@ func (s *groupStore) GetTransitiveGroupsForEntity(
 	return groups, nil
 }
 
+// GetTransitiveGroupMembers retrieves all members of a group, including members of nested groups,
+// using a recursive CTE. Nested groups are returned as MemberTypeGroup members.
+func (s *groupStore) GetTransitiveGroupMembers(ctx context.Context, groupID string) ([]Member, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, QueryGetTransitiveGroupMembers, groupID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transitive group members: %w", err)
+	}
+
+	members := make([]Member, 0, len(results))
+	for _, row := range results {
+		memberID, ok := row["member_id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse member_id as string")
+		}
+		memberType, ok := row["member_type"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse member_type as string")
+		}
+		members = append(members, Member{ID: memberID, Type: MemberType(memberType)})
+	}
+	return members, nil
+}
+
 // buildGroupFromResultRow constructs a GroupDAO from a database result row.
 func buildGroupFromResultRow(row map[string]interface{}) (GroupDAO, error) {
 	groupID, ok := row["id"].(string)
@@ -652,13 +681,16 @@ func addMembersToGroup(
 	members []Member,
 	deploymentID string,
 ) error {
+	if len(members) == 0 {
+		return nil
+	}
 	now := time.Now().UTC()
+	rows := make([][]interface{}, 0, len(members))
 	for _, member := range members {
-		_, err := dbClient.ExecuteContext(
-			ctx, QueryAddMemberToGroup, groupID, member.Type, member.ID, deploymentID, now, now)
-		if err != nil {
-			return fmt.Errorf("failed to add member to group: %w", err)
-		}
+		rows = append(rows, []interface{}{groupID, member.Type, member.ID, deploymentID, now, now})
+	}
+	if _, err := dbClient.BatchExecuteContext(ctx, QueryAddMembersToGroup, rows); err != nil {
+		return fmt.Errorf("failed to add member to group: %w", err)
 	}
 	return nil
 }
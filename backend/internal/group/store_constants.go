@@ -58,6 +58,26 @@ var (
 		INNER JOIN "GROUP" G ON tg.GROUP_ID = G.ID AND G.DEPLOYMENT_ID = $2
 		ORDER BY G.NAME`,
 	}
+
+	// QueryGetTransitiveGroupMembers retrieves all members of a group, including members inherited
+	// through nested groups, using a recursive CTE. UNION discards repeated rows, so the recursion
+	// terminates even if the stored membership graph contains a cycle.
+	QueryGetTransitiveGroupMembers = dbmodel.DBQuery{
+		ID: "GRQ-GROUP_MGT-24",
+		Query: `WITH RECURSIVE transitive_members AS (
+			SELECT GMR.MEMBER_ID, GMR.MEMBER_TYPE
+			FROM "GROUP_MEMBER_REFERENCE" GMR
+			WHERE GMR.GROUP_ID = $1 AND GMR.DEPLOYMENT_ID = $2
+			UNION
+			SELECT GMR.MEMBER_ID, GMR.MEMBER_TYPE
+			FROM "GROUP_MEMBER_REFERENCE" GMR
+			INNER JOIN transitive_members tm ON GMR.GROUP_ID = tm.MEMBER_ID
+			WHERE tm.MEMBER_TYPE = 'group' AND GMR.DEPLOYMENT_ID = $2
+		)
+		SELECT MEMBER_ID, MEMBER_TYPE
+		FROM transitive_members
+		ORDER BY MEMBER_TYPE, MEMBER_ID`,
+	}
 )
 
 // buildGetGroupsCountByOUIDsQuery returns the query and args to count groups
@@ -205,13 +225,13 @@ var (
 			`WHERE MEMBER_TYPE = $1 AND MEMBER_ID = $2 AND DEPLOYMENT_ID = $3`,
 	}
 
-	// QueryAddMemberToGroup is the query to assign member to a group.
-	QueryAddMemberToGroup = dbmodel.DBQuery{
+	// QueryAddMembersToGroup is the batch query to assign members to a group.
+	QueryAddMembersToGroup = dbmodel.DBBatchInsertQuery{
 		ID: "GRQ-GROUP_MGT-12",
-		Query: `INSERT INTO "GROUP_MEMBER_REFERENCE" ` +
-			`(GROUP_ID, MEMBER_TYPE, MEMBER_ID, DEPLOYMENT_ID, CREATED_AT, UPDATED_AT) ` +
-			`VALUES ($1, $2, $3, $4, $5, $6) ` +
-			`ON CONFLICT (GROUP_ID, MEMBER_TYPE, MEMBER_ID, DEPLOYMENT_ID) DO NOTHING`,
+		Prefix: `INSERT INTO "GROUP_MEMBER_REFERENCE" ` +
+			`(GROUP_ID, MEMBER_TYPE, MEMBER_ID, DEPLOYMENT_ID, CREATED_AT, UPDATED_AT)`,
+		Suffix:  `ON CONFLICT (GROUP_ID, MEMBER_TYPE, MEMBER_ID, DEPLOYMENT_ID) DO NOTHING`,
+		Columns: 6,
 	}
 
 	// QueryCheckGroupNameConflict is the query to check if a group name conflicts within the same organization unit.
@@ -1812,15 +1812,13 @@ func (suite *GroupStoreTestSuite) TestGroupStore_AddMembersToGroupReturnsError()
 
 	dbClientMock.
 		On(
-			"ExecuteContext",
+			"BatchExecuteContext",
 			mock.Anything,
-			QueryAddMemberToGroup,
-			"grp-001",
-			mock.Anything, // MemberType to avoid type mismatch
-			"usr-1",
-			testDeploymentID,
-			mock.Anything, // CREATED_AT
-			mock.Anything, // UPDATED_AT
+			QueryAddMembersToGroup,
+			mock.MatchedBy(func(rows [][]interface{}) bool {
+				return len(rows) == 1 && rows[0][0] == "grp-001" && rows[0][2] == "usr-1" &&
+					rows[0][3] == testDeploymentID
+			}),
 		).
 		Return(int64(0), errors.New("insert fail")).
 		Once()
@@ -1837,6 +1835,30 @@ func (suite *GroupStoreTestSuite) TestGroupStore_AddMembersToGroupReturnsError()
 	require.Contains(t, err.Error(), "failed to add member to group")
 }
 
+func (suite *GroupStoreTestSuite) TestGroupStore_AddMembersToGroupUsesSingleBatch() {
+	t := suite.T()
+	dbClientMock := providermock.NewDBClientInterfaceMock(t)
+
+	dbClientMock.
+		On("BatchExecuteContext", mock.Anything, QueryAddMembersToGroup,
+			mock.MatchedBy(func(rows [][]interface{}) bool {
+				return len(rows) == 2 && rows[0][2] == "usr-1" && rows[1][2] == "grp-002"
+			})).
+		Return(int64(2), nil).
+		Once()
+
+	err := addMembersToGroup(
+		context.Background(),
+		dbClientMock,
+		"grp-001",
+		[]Member{{ID: "usr-1", Type: memberTypeEntity}, {ID: "grp-002", Type: MemberTypeGroup}},
+		testDeploymentID,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, addMembersToGroup(context.Background(), dbClientMock, "grp-001", nil, testDeploymentID))
+}
+
 func (suite *GroupStoreTestSuite) TestGroupStore_GetTransitiveGroupsForEntity() {
 	testCases := []struct {
 		name          string
@@ -1981,6 +2003,54 @@ func (suite *GroupStoreTestSuite) TestGroupStore_GetTransitiveGroupsForEntity()
 	}
 }
 
+func (suite *GroupStoreTestSuite) TestGroupStore_GetTransitiveGroupMembers() {
+	suite.Run("success", func() {
+		providerMock := providermock.NewDBProviderInterfaceMock(suite.T())
+		dbClientMock := providermock.NewDBClientInterfaceMock(suite.T())
+		providerMock.On("GetUserDBClient").Return(dbClientMock, nil).Once()
+		dbClientMock.On("QueryContext", mock.Anything,
+			QueryGetTransitiveGroupMembers, "grp-001", testDeploymentID).
+			Return([]map[string]interface{}{
+				{"member_id": "usr-001", "member_type": "entity"},
+				{"member_id": "grp-002", "member_type": "group"},
+			}, nil).Once()
+		store := &groupStore{dbProvider: providerMock, deploymentID: testDeploymentID}
+
+		members, err := store.GetTransitiveGroupMembers(context.Background(), "grp-001")
+		suite.Require().NoError(err)
+		suite.Require().Equal([]Member{
+			{ID: "usr-001", Type: memberTypeEntity},
+			{ID: "grp-002", Type: MemberTypeGroup},
+		}, members)
+	})
+
+	suite.Run("query error", func() {
+		providerMock := providermock.NewDBProviderInterfaceMock(suite.T())
+		dbClientMock := providermock.NewDBClientInterfaceMock(suite.T())
+		providerMock.On("GetUserDBClient").Return(dbClientMock, nil).Once()
+		dbClientMock.On("QueryContext", mock.Anything,
+			QueryGetTransitiveGroupMembers, "grp-001", testDeploymentID).
+			Return(nil, errors.New("query fail")).Once()
+		store := &groupStore{dbProvider: providerMock, deploymentID: testDeploymentID}
+
+		_, err := store.GetTransitiveGroupMembers(context.Background(), "grp-001")
+		suite.Require().ErrorContains(err, "failed to get transitive group members")
+	})
+
+	suite.Run("invalid row", func() {
+		providerMock := providermock.NewDBProviderInterfaceMock(suite.T())
+		dbClientMock := providermock.NewDBClientInterfaceMock(suite.T())
+		providerMock.On("GetUserDBClient").Return(dbClientMock, nil).Once()
+		dbClientMock.On("QueryContext", mock.Anything,
+			QueryGetTransitiveGroupMembers, "grp-001", testDeploymentID).
+			Return([]map[string]interface{}{{"member_id": "usr-001", "member_type": 1}}, nil).Once()
+		store := &groupStore{dbProvider: providerMock, deploymentID: testDeploymentID}
+
+		_, err := store.GetTransitiveGroupMembers(context.Background(), "grp-001")
+		suite.Require().ErrorContains(err, "failed to parse member_type as string")
+	})
+}
+
 func (suite *GroupStoreTestSuite) TestDeleteMembershipsByMember() {
 	suite.Run("success returns rows affected", func() {
 		providerMock := providermock.NewDBProviderInterfaceMock(suite.T())
@@ -519,6 +519,68 @@ func (_c *IDPServiceInterfaceMock_GetIdentityProvidersByProperty_Call) RunAndRet
 	return _c
 }
 
+// ReencryptSecrets provides a mock function for the type IDPServiceInterfaceMock
+func (_mock *IDPServiceInterfaceMock) ReencryptSecrets(ctx context.Context) (int, *common.ServiceError) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReencryptSecrets")
+	}
+
+	var r0 int
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, *common.ServiceError)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) *common.ServiceError); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// IDPServiceInterfaceMock_ReencryptSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReencryptSecrets'
+type IDPServiceInterfaceMock_ReencryptSecrets_Call struct {
+	*mock.Call
+}
+
+// ReencryptSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IDPServiceInterfaceMock_Expecter) ReencryptSecrets(ctx interface{}) *IDPServiceInterfaceMock_ReencryptSecrets_Call {
+	return &IDPServiceInterfaceMock_ReencryptSecrets_Call{Call: _e.mock.On("ReencryptSecrets", ctx)}
+}
+
+func (_c *IDPServiceInterfaceMock_ReencryptSecrets_Call) Run(run func(ctx context.Context)) *IDPServiceInterfaceMock_ReencryptSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *IDPServiceInterfaceMock_ReencryptSecrets_Call) Return(n int, serviceError *common.ServiceError) *IDPServiceInterfaceMock_ReencryptSecrets_Call {
+	_c.Call.Return(n, serviceError)
+	return _c
+}
+
+func (_c *IDPServiceInterfaceMock_ReencryptSecrets_Call) RunAndReturn(run func(ctx context.Context) (int, *common.ServiceError)) *IDPServiceInterfaceMock_ReencryptSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDependencyRegistry provides a mock function for the type IDPServiceInterfaceMock
 func (_mock *IDPServiceInterfaceMock) SetDependencyRegistry(r resourcedependency.Registry) {
 	_mock.Called(r)
@@ -38,6 +38,10 @@ const (
 	PropTrustedTokenAudience  = "trusted_token_audience"
 )
 
+// secretProperties lists the properties that are always stored encrypted, even when the request
+// does not mark them as secret.
+var secretProperties = []string{PropClientSecret}
+
 // Known endpoints for Google OAuth2/OIDC.
 const (
 	googleAuthorizationEndpoint = "https://accounts.google.com/o/oauth2/v2/auth"
@@ -52,6 +52,9 @@ func (ih *idpHandler) HandleIDPPostRequest(w http.ResponseWriter, r *http.Reques
 
 	createRequest, err := sysutils.DecodeJSONBody[idpRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		errResp := apierror.ErrorResponse{
 			Code:        ErrorInvalidRequestFormat.Code,
 			Message:     ErrorInvalidRequestFormat.Error,
@@ -164,6 +167,9 @@ func (ih *idpHandler) HandleIDPPutRequest(w http.ResponseWriter, r *http.Request
 
 	updateRequest, err := sysutils.DecodeJSONBody[idpRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		errResp := apierror.ErrorResponse{
 			Code:        ErrorInvalidRequestFormat.Code,
 			Message:     ErrorInvalidRequestFormat.Error,
@@ -34,9 +34,9 @@ type BasicIDPDTO struct {
 
 // idpRequest represents the request payload for creating or updating an identity provider.
 type idpRequest struct {
-	Name                   string                            `json:"name"`
-	Description            string                            `json:"description,omitempty"`
-	Type                   string                            `json:"type"`
+	Name                   string                            `json:"name" native:"max=255"`
+	Description            string                            `json:"description,omitempty" native:"max=500"`
+	Type                   string                            `json:"type" native:"max=20"`
 	Properties             []cmodels.PropertyDTO             `json:"properties,omitempty"`
 	AttributeConfiguration *providers.AttributeConfiguration `json:"attributeConfiguration,omitempty"`
 }
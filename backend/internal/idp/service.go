@@ -52,6 +52,7 @@ type IDPServiceInterface interface {
 	DeleteIdentityProvider(ctx context.Context, idpID string) *tidcommon.ServiceError
 	GetIDPUsages(ctx context.Context, idpID string) (*resourcedependency.DependenciesResponse, *tidcommon.ServiceError)
 	SetDependencyRegistry(r resourcedependency.Registry)
+	ReencryptSecrets(ctx context.Context) (int, *tidcommon.ServiceError)
 }
 
 // idpService is the default implementation of the IdPServiceInterface.
@@ -339,6 +340,55 @@ func (is *idpService) DeleteIdentityProvider(ctx context.Context, idpID string)
 	return nil
 }
 
+// ReencryptSecrets encrypts the secret properties of every mutable identity provider again with the
+// current encryption key. Client secrets stored as plain values are encrypted as well. It returns the
+// number of identity providers that were rewritten.
+func (is *idpService) ReencryptSecrets(ctx context.Context) (int, *tidcommon.ServiceError) {
+	logger := is.logger
+	if isDeclarativeModeEnabled() {
+		return 0, nil
+	}
+
+	idps, err := is.idpStore.GetIdentityProviderList(ctx)
+	if err != nil {
+		logger.Error(ctx, "Failed to list identity providers", log.Error(err))
+		return 0, &tidcommon.InternalServerError
+	}
+
+	updated := 0
+	for _, basicIDP := range idps {
+		if basicIDP.IsReadOnly {
+			continue
+		}
+		rewritten := false
+		err := is.transactioner.Transact(ctx, func(txCtx context.Context) error {
+			idp, err := is.idpStore.GetIdentityProvider(txCtx, basicIDP.ID)
+			if err != nil {
+				return err
+			}
+			properties, changed, err := reencryptProperties(idp.Properties)
+			if err != nil || !changed {
+				return err
+			}
+			idp.Properties = properties
+			if err := is.idpStore.UpdateIdentityProvider(txCtx, idp); err != nil {
+				return err
+			}
+			rewritten = true
+			return nil
+		})
+		if err != nil {
+			logger.Error(ctx, "Failed to re-encrypt identity provider secrets", log.Error(err),
+				log.String("idpID", basicIDP.ID))
+			return updated, &tidcommon.InternalServerError
+		}
+		if rewritten {
+			updated++
+		}
+	}
+	return updated, nil
+}
+
 // SetDependencyRegistry injects the dependency registry. Called by servicemanager after the
 // provider services are initialized to avoid a cyclic import.
 func (is *idpService) SetDependencyRegistry(r resourcedependency.Registry) {
@@ -37,6 +37,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/utils"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 )
 
@@ -95,6 +96,11 @@ func (s *IDPServiceTestSuite) SetupTest() {
 		DeclarativeResources: config.DeclarativeResources{
 			Enabled: false,
 		},
+		Crypto: config.CryptoConfig{
+			Encryption: engineconfig.EncryptionConfig{
+				Key: testCryptoKey,
+			},
+		},
 	}
 	_ = config.InitializeServerRuntime("/tmp/test", testConfig)
 
@@ -147,6 +153,32 @@ func (s *IDPServiceTestSuite) TestCreateIdentityProvider_Success() {
 	s.mockStore.AssertExpectations(s.T())
 }
 
+// TestCreateIdentityProvider_EncryptsClientSecret tests that the client secret is stored encrypted
+// even when the request does not mark it as secret.
+func (s *IDPServiceTestSuite) TestCreateIdentityProvider_EncryptsClientSecret() {
+	idp := &providers.IDPDTO{
+		Name:       "Test IDP",
+		Type:       providers.IDPTypeOIDC,
+		Properties: createOIDCProperties(),
+	}
+
+	s.mockStore.On("GetIdentityProviderByName", mock.Anything, "Test IDP").
+		Return((*providers.IDPDTO)(nil), ErrIDPNotFound)
+	s.mockStore.On("CreateIdentityProvider", mock.Anything, mock.Anything).Return(nil)
+
+	result, err := s.idpService.CreateIdentityProvider(context.Background(), idp)
+
+	s.Nil(err)
+	for _, prop := range result.Properties {
+		if prop.GetName() == PropClientSecret {
+			s.True(prop.IsSecret())
+			value, getErr := prop.GetValue()
+			s.NoError(getErr)
+			s.Equal("test-secret", value)
+		}
+	}
+}
+
 // TestCreateIdentityProvider_NilIDP tests nil IDP validation
 func (s *IDPServiceTestSuite) TestCreateIdentityProvider_NilIDP() {
 	result, err := s.idpService.CreateIdentityProvider(context.Background(), nil)
@@ -1344,3 +1376,52 @@ func (s *IDPServiceTestSuite) TestValidateAttributeConfiguration_UnknownEntityTy
 	s.NotNil(svcErr)
 	s.Equal(ErrorInvalidAttributeConfiguration.Code, svcErr.Code)
 }
+
+// TestReencryptSecrets tests that secret properties of mutable identity providers are rewritten with
+// the current key and that declarative identity providers are skipped.
+func (s *IDPServiceTestSuite) TestReencryptSecrets() {
+	clientID, _ := cmodels.NewProperty(PropClientID, "test-client", false)
+	plainSecret, _ := cmodels.NewProperty(PropClientSecret, "test-secret", false)
+	mutableIDP := &providers.IDPDTO{
+		ID:         mutableIDPTestID,
+		Name:       "Mutable IDP",
+		Type:       providers.IDPTypeOIDC,
+		Properties: []cmodels.Property{*clientID, *plainSecret},
+	}
+
+	s.mockStore.On("GetIdentityProviderList", mock.Anything).Return([]BasicIDPDTO{
+		{ID: mutableIDPTestID, Name: "Mutable IDP"},
+		{ID: declarativeIDPTestID, Name: "Declarative IDP", IsReadOnly: true},
+	}, nil)
+	s.mockStore.On("GetIdentityProvider", mock.Anything, mutableIDPTestID).Return(mutableIDP, nil)
+	s.mockStore.On("UpdateIdentityProvider", mock.Anything, mock.MatchedBy(func(idp *providers.IDPDTO) bool {
+		for _, prop := range idp.Properties {
+			if prop.GetName() == PropClientID && prop.IsSecret() {
+				return false
+			}
+			if prop.GetName() == PropClientSecret && !prop.IsSecret() {
+				return false
+			}
+		}
+		return GetPropertyValue(idp.Properties, PropClientSecret) == "test-secret"
+	})).Return(nil)
+
+	updated, err := s.idpService.ReencryptSecrets(context.Background())
+
+	s.Nil(err)
+	s.Equal(1, updated)
+	s.mockStore.AssertNotCalled(s.T(), "GetIdentityProvider", mock.Anything, declarativeIDPTestID)
+}
+
+// TestReencryptSecrets_StoreError tests that a store failure stops the migration.
+func (s *IDPServiceTestSuite) TestReencryptSecrets_StoreError() {
+	s.mockStore.On("GetIdentityProviderList", mock.Anything).
+		Return([]BasicIDPDTO{{ID: mutableIDPTestID}}, nil)
+	s.mockStore.On("GetIdentityProvider", mock.Anything, mutableIDPTestID).
+		Return((*providers.IDPDTO)(nil), errors.New("db error"))
+
+	updated, err := s.idpService.ReencryptSecrets(context.Background())
+
+	s.Equal(&tidcommon.InternalServerError, err)
+	s.Equal(0, updated)
+}
@@ -239,6 +239,15 @@ func validateIDPProperties(ctx context.Context, idpType providers.IDPType, prope
 				Params:       map[string]string{"property": propName},
 			})
 		}
+		if !prop.IsSecret() && slices.Contains(secretProperties, propName) {
+			secretProp, err := cmodels.NewProperty(propName, propertyValue, true)
+			if err != nil {
+				logger.Error(ctx, "Failed to encrypt secret property", log.String("property", propName),
+					log.Error(err))
+				return nil, &tidcommon.InternalServerError
+			}
+			prop = *secretProp
+		}
 
 		filteredPropsMap[propName] = prop
 		filteredPropKeys = append(filteredPropKeys, propName)
@@ -354,3 +363,28 @@ func propertyMapToSlice(propertyMap map[string]cmodels.Property) []cmodels.Prope
 	}
 	return properties
 }
+
+// reencryptProperties encrypts every secret property again with the current encryption key. Properties
+// listed in secretProperties are encrypted even when they were stored as plain values. It reports
+// whether any property was rewritten.
+func reencryptProperties(properties []cmodels.Property) ([]cmodels.Property, bool, error) {
+	result := make([]cmodels.Property, 0, len(properties))
+	changed := false
+	for _, prop := range properties {
+		if !prop.IsSecret() && !slices.Contains(secretProperties, prop.GetName()) {
+			result = append(result, prop)
+			continue
+		}
+		value, err := prop.GetValue()
+		if err != nil {
+			return nil, false, err
+		}
+		reencrypted, err := cmodels.NewProperty(prop.GetName(), value, true)
+		if err != nil {
+			return nil, false, err
+		}
+		result = append(result, *reencrypted)
+		changed = true
+	}
+	return result, changed, nil
+}
@@ -152,6 +152,21 @@ func (c *cachedBackStore) GetEntityIDsByReference(
 	return c.inner.GetEntityIDsByReference(ctx, refType, refID, limit, offset)
 }
 
+func (c *cachedBackStore) CreateOAuthProfileRevision(ctx context.Context, entityID string,
+	revision inboundmodel.OAuthProfileRevision) error {
+	return c.inner.CreateOAuthProfileRevision(ctx, entityID, revision)
+}
+
+func (c *cachedBackStore) GetOAuthProfileRevisionList(ctx context.Context, entityID string,
+	limit, offset int) ([]inboundmodel.OAuthProfileRevision, int, error) {
+	return c.inner.GetOAuthProfileRevisionList(ctx, entityID, limit, offset)
+}
+
+func (c *cachedBackStore) GetOAuthProfileRevision(ctx context.Context, entityID string,
+	version int) (*inboundmodel.OAuthProfileRevision, error) {
+	return c.inner.GetOAuthProfileRevision(ctx, entityID, version)
+}
+
 // --- Cache helpers ---
 
 func (c *cachedBackStore) cacheInboundClient(ctx context.Context, client *inboundmodel.InboundClient) {
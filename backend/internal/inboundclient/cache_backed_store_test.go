@@ -292,6 +292,25 @@ func (suite *CacheBackedStoreTestSuite) TestIsDeclarative_Delegates() {
 	suite.True(suite.cachedStore.IsDeclarative(ctx, "c1"))
 }
 
+// OAuth profile revisions — delegate to inner without caching.
+func (suite *CacheBackedStoreTestSuite) TestOAuthProfileRevisions_Delegate() {
+	ctx := context.Background()
+	revision := inboundmodel.OAuthProfileRevision{ChangedBy: "u1"}
+	suite.mockStore.EXPECT().CreateOAuthProfileRevision(mock.Anything, "e1", revision).Return(nil)
+	suite.mockStore.EXPECT().GetOAuthProfileRevisionList(mock.Anything, "e1", 10, 0).
+		Return([]inboundmodel.OAuthProfileRevision{revision}, 1, nil)
+	suite.mockStore.EXPECT().GetOAuthProfileRevision(mock.Anything, "e1", 1).Return(&revision, nil)
+
+	suite.NoError(suite.cachedStore.CreateOAuthProfileRevision(ctx, "e1", revision))
+	revisions, total, err := suite.cachedStore.GetOAuthProfileRevisionList(ctx, "e1", 10, 0)
+	suite.NoError(err)
+	suite.Equal(1, total)
+	suite.Len(revisions, 1)
+	got, err := suite.cachedStore.GetOAuthProfileRevision(ctx, "e1", 1)
+	suite.NoError(err)
+	suite.Equal(&revision, got)
+}
+
 // ----- cache helper edge cases -----
 
 func (suite *CacheBackedStoreTestSuite) TestCacheInboundClient_NilNoOp() {
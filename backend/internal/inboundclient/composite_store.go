@@ -165,6 +165,23 @@ func (c *compositeStore) DeleteOAuthProfile(ctx context.Context, entityID string
 	return c.dbStore.DeleteOAuthProfile(ctx, entityID)
 }
 
+// CreateOAuthProfileRevision records the revision in the DB store; declarative inbound clients are
+// immutable and have no history.
+func (c *compositeStore) CreateOAuthProfileRevision(ctx context.Context, entityID string,
+	revision inboundmodel.OAuthProfileRevision) error {
+	return c.dbStore.CreateOAuthProfileRevision(ctx, entityID, revision)
+}
+
+func (c *compositeStore) GetOAuthProfileRevisionList(ctx context.Context, entityID string,
+	limit, offset int) ([]inboundmodel.OAuthProfileRevision, int, error) {
+	return c.dbStore.GetOAuthProfileRevisionList(ctx, entityID, limit, offset)
+}
+
+func (c *compositeStore) GetOAuthProfileRevision(ctx context.Context, entityID string,
+	version int) (*inboundmodel.OAuthProfileRevision, error) {
+	return c.dbStore.GetOAuthProfileRevision(ctx, entityID, version)
+}
+
 func (c *compositeStore) InboundClientExists(ctx context.Context, entityID string) (bool, error) {
 	return declarativeresource.CompositeBooleanCheckHelper(
 		func() (bool, error) { return c.fileStore.InboundClientExists(ctx, entityID) },
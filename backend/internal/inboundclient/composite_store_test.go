@@ -154,6 +154,25 @@ func (suite *CompositeStoreTestSuite) TestCreateInboundClient_DelegatesToDB() {
 	suite.NoError(err)
 }
 
+// OAuth profile revisions delegate to DB store.
+func (suite *CompositeStoreTestSuite) TestOAuthProfileRevisions_DelegateToDB() {
+	ctx := context.Background()
+	revision := inboundmodel.OAuthProfileRevision{ChangedBy: "u1"}
+	suite.dbMock.EXPECT().CreateOAuthProfileRevision(mock.Anything, "e1", revision).Return(nil)
+	suite.dbMock.EXPECT().GetOAuthProfileRevisionList(mock.Anything, "e1", 10, 0).
+		Return([]inboundmodel.OAuthProfileRevision{revision}, 1, nil)
+	suite.dbMock.EXPECT().GetOAuthProfileRevision(mock.Anything, "e1", 1).Return(&revision, nil)
+
+	suite.NoError(suite.composite.CreateOAuthProfileRevision(ctx, "e1", revision))
+	revisions, total, err := suite.composite.GetOAuthProfileRevisionList(ctx, "e1", 10, 0)
+	suite.NoError(err)
+	suite.Equal(1, total)
+	suite.Len(revisions, 1)
+	got, err := suite.composite.GetOAuthProfileRevision(ctx, "e1", 1)
+	suite.NoError(err)
+	suite.Equal(&revision, got)
+}
+
 // CreateOAuthProfile delegates to DB store.
 func (suite *CompositeStoreTestSuite) TestCreateOAuthProfile_DelegatesToDB() {
 	ctx := context.Background()
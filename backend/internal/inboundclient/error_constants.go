@@ -42,6 +42,10 @@ var (
 	// SyncOAuthProfile/Delete) when the targeted entity is sourced from a declarative file.
 	// Callers translate this into their own "declarative resource is read-only" error.
 	ErrCannotModifyDeclarative = errors.New("cannot modify declarative inbound client")
+
+	// ErrOAuthProfileRevisionNotFound is returned when the requested revision of an OAuth profile does
+	// not exist.
+	ErrOAuthProfileRevisionNotFound = errors.New("OAuth profile revision not found")
 )
 
 var (
@@ -72,6 +76,14 @@ var (
 	ErrOAuthInvalidRedirectURI = errors.New("invalid redirect URI")
 	// ErrOAuthRedirectURIFragmentNotAllowed is returned when a redirect URI contains a fragment.
 	ErrOAuthRedirectURIFragmentNotAllowed = errors.New("redirect URI must not contain a fragment")
+	// ErrOAuthRedirectURIWildcardHostNotAllowed is returned when a redirect URI host contains a wildcard.
+	ErrOAuthRedirectURIWildcardHostNotAllowed = errors.New("redirect URI host must not contain a wildcard")
+	// ErrOAuthInvalidRedirectURIMatching is returned when the redirect URI matching policy is unknown.
+	ErrOAuthInvalidRedirectURIMatching = errors.New("invalid redirect URI matching policy")
+	// ErrOAuthInvalidAllowedOrigin is returned when an allowed origin is not a concrete http(s) origin.
+	ErrOAuthInvalidAllowedOrigin = errors.New("invalid allowed origin")
+	// ErrOAuthInvalidAllowedNetwork is returned when an allowed network is not a CIDR range or IP address.
+	ErrOAuthInvalidAllowedNetwork = errors.New("invalid allowed network")
 	// ErrOAuthAuthCodeRequiresRedirectURIs is returned when authorization_code grant has no redirect URIs.
 	ErrOAuthAuthCodeRequiresRedirectURIs = errors.New("authorization_code grant requires redirect URIs")
 	// ErrOAuthInvalidGrantType is returned when an unsupported grant type is specified.
@@ -108,6 +120,15 @@ var (
 	ErrOAuthPublicClientMustUseNoneAuth = errors.New("public client must use none auth method")
 	// ErrOAuthPublicClientMustHavePKCE is returned when a public client does not have PKCE required.
 	ErrOAuthPublicClientMustHavePKCE = errors.New("public client must have PKCE required")
+	// ErrOAuthInvalidApplicationType is returned when the application type is unknown.
+	ErrOAuthInvalidApplicationType = errors.New("invalid application type")
+	// ErrOAuthApplicationTypeCannotUseClientSecret is returned when a native or SPA client uses a client secret.
+	ErrOAuthApplicationTypeCannotUseClientSecret = errors.New("native and SPA clients cannot use a client secret")
+	// ErrOAuthApplicationTypeRequiresPKCE is returned when a native or SPA client does not have PKCE required.
+	ErrOAuthApplicationTypeRequiresPKCE = errors.New("native and SPA clients must have PKCE required")
+	// ErrOAuthRedirectURINotAllowedForApplicationType is returned when a redirect URI scheme or host is not
+	// permitted for the application type.
+	ErrOAuthRedirectURINotAllowedForApplicationType = errors.New("redirect URI is not allowed for the application type")
 
 	// ErrCertValueRequired is returned when a certificate value is missing.
 	ErrCertValueRequired = errors.New("certificate value is required")
@@ -148,6 +169,12 @@ var (
 	ErrOAuthUserInfoAlgRequiresResponseType = errors.New(
 		"userinfo responseType is required when signingAlg or encryptionAlg is set")
 
+	// ErrOAuthIDTokenUnsupportedSigningAlg is returned when the ID token signing algorithm is not supported.
+	ErrOAuthIDTokenUnsupportedSigningAlg = errors.New("unsupported ID token signing algorithm")
+	// ErrOAuthAccessTokenUnsupportedSigningAlg is returned when the access token signing algorithm is not supported.
+	ErrOAuthAccessTokenUnsupportedSigningAlg = errors.New("unsupported access token signing algorithm")
+	// ErrOAuthAccessTokenInvalidClaimsPolicy is returned when the access token claims policy is malformed.
+	ErrOAuthAccessTokenInvalidClaimsPolicy = errors.New("invalid access token claims policy")
 	// ErrOAuthIDTokenUnsupportedEncryptionAlg is returned when the ID token encryption algorithm is not supported.
 	ErrOAuthIDTokenUnsupportedEncryptionAlg = errors.New("unsupported ID token encryption algorithm")
 	// ErrOAuthIDTokenUnsupportedEncryptionEnc is returned when the ID token content-encryption
@@ -169,6 +196,16 @@ var (
 	// ErrOAuthIDTokenEncryptionFieldsNotAllowed is returned when encryption fields are set for JWT responseType.
 	ErrOAuthIDTokenEncryptionFieldsNotAllowed = errors.New(
 		"idToken encryptionAlg and encryptionEnc must not be set when responseType is JWT")
+	// ErrOAuthTokenValidityOutOfBounds is returned when a token validity period is negative or outside the
+	// deployment's token lifetime bounds.
+	ErrOAuthTokenValidityOutOfBounds = errors.New("token validity period is outside the allowed bounds")
+	// ErrOAuthGrantValidityInvalidGrantType is returned when grant validity periods are keyed by an
+	// unsupported grant type.
+	ErrOAuthGrantValidityInvalidGrantType = errors.New("grant validity periods reference an unsupported grant type")
+	// ErrOAuthRefreshTokenInvalidMaxLifetime is returned when the refresh token maximum lifetime is negative
+	// or shorter than the refresh token validity period.
+	ErrOAuthRefreshTokenInvalidMaxLifetime = errors.New(
+		"refresh token maxLifetime must not be negative or shorter than the refresh token validity period")
 )
 
 // Certificate operation labels used in CertOperationError.
@@ -165,6 +165,25 @@ func (f *fileBasedStore) DeleteOAuthProfile(_ context.Context, _ string) error {
 	return errors.New("DeleteOAuthProfile is not supported in file-based store")
 }
 
+// CreateOAuthProfileRevision is not supported in the file store.
+func (f *fileBasedStore) CreateOAuthProfileRevision(_ context.Context, _ string,
+	_ inboundmodel.OAuthProfileRevision) error {
+	return errors.New("CreateOAuthProfileRevision is not supported in file-based store")
+}
+
+// GetOAuthProfileRevisionList returns no revisions, since declarative inbound clients are immutable.
+func (f *fileBasedStore) GetOAuthProfileRevisionList(_ context.Context, _ string, _, _ int) (
+	[]inboundmodel.OAuthProfileRevision, int, error) {
+	return []inboundmodel.OAuthProfileRevision{}, 0, nil
+}
+
+// GetOAuthProfileRevision returns ErrOAuthProfileRevisionNotFound, since declarative inbound clients
+// are immutable.
+func (f *fileBasedStore) GetOAuthProfileRevision(_ context.Context, _ string, _ int) (
+	*inboundmodel.OAuthProfileRevision, error) {
+	return nil, ErrOAuthProfileRevisionNotFound
+}
+
 // InboundClientExists reports whether an inbound client with the given entity ID is present
 // in the file store.
 func (f *fileBasedStore) InboundClientExists(_ context.Context, entityID string) (bool, error) {
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package inboundclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// recordOAuthProfileRevision records the change of an entity's OAuth profile from previous to current,
+// attributed to the authenticated caller. Nothing is recorded when the profiles do not differ.
+func (s *inboundClientService) recordOAuthProfileRevision(ctx context.Context, entityID string,
+	previous, current *providers.OAuthProfile) error {
+	changes, err := diffOAuthProfiles(previous, current)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return s.store.CreateOAuthProfileRevision(ctx, entityID, inboundmodel.OAuthProfileRevision{
+		OAuthProfile: current,
+		Changes:      changes,
+		ChangedBy:    security.GetSubject(ctx),
+		CreatedAt:    time.Now().UTC(),
+	})
+}
+
+// diffOAuthProfiles returns the field-level changes between two OAuth profiles, ordered by field. A nil
+// profile has no fields, so every field of the other profile is reported as added or removed.
+func diffOAuthProfiles(previous, current *providers.OAuthProfile) ([]inboundmodel.ConfigChange, error) {
+	previousFields, err := flattenOAuthProfile(previous)
+	if err != nil {
+		return nil, err
+	}
+	currentFields, err := flattenOAuthProfile(current)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldSet := make(map[string]struct{}, len(previousFields)+len(currentFields))
+	for field := range previousFields {
+		fieldSet[field] = struct{}{}
+	}
+	for field := range currentFields {
+		fieldSet[field] = struct{}{}
+	}
+	fields := make([]string, 0, len(fieldSet))
+	for field := range fieldSet {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	changes := make([]inboundmodel.ConfigChange, 0)
+	for _, field := range fields {
+		previousValue, currentValue := previousFields[field], currentFields[field]
+		if reflect.DeepEqual(previousValue, currentValue) {
+			continue
+		}
+		changes = append(changes, inboundmodel.ConfigChange{
+			Field:         field,
+			PreviousValue: previousValue,
+			NewValue:      currentValue,
+		})
+	}
+	return changes, nil
+}
+
+// flattenOAuthProfile returns the fields of the profile's JSON representation keyed by their
+// dot-separated path. Lists are kept whole, and empty values are left out so that a nil and an empty
+// list compare equal.
+func flattenOAuthProfile(profile *providers.OAuthProfile) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if profile == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OAuth profile: %w", err)
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal OAuth profile: %w", err)
+	}
+
+	flattenConfigFields("", document, fields)
+	return fields, nil
+}
+
+// flattenConfigFields adds the leaf fields of the object to fields, prefixing their names with prefix.
+func flattenConfigFields(prefix string, object map[string]interface{}, fields map[string]interface{}) {
+	for key, value := range object {
+		field := key
+		if prefix != "" {
+			field = prefix + "." + key
+		}
+		switch v := value.(type) {
+		case nil:
+			continue
+		case map[string]interface{}:
+			flattenConfigFields(field, v, fields)
+		case []interface{}:
+			if len(v) > 0 {
+				fields[field] = v
+			}
+		default:
+			fields[field] = v
+		}
+	}
+}
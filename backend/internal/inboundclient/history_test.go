@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package inboundclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	sysconfig "github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type OAuthProfileHistoryTestSuite struct {
+	suite.Suite
+}
+
+func TestOAuthProfileHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OAuthProfileHistoryTestSuite))
+}
+
+func (suite *OAuthProfileHistoryTestSuite) SetupTest() {
+	sysconfig.ResetServerRuntime()
+	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", &sysconfig.Config{}))
+}
+
+func (suite *OAuthProfileHistoryTestSuite) TestDiffOAuthProfiles() {
+	testCases := []struct {
+		name     string
+		previous *providers.OAuthProfile
+		current  *providers.OAuthProfile
+		expected []inboundmodel.ConfigChange
+	}{
+		{
+			name:     "Unchanged",
+			previous: validOAuthProfile(),
+			current:  validOAuthProfile(),
+			expected: []inboundmodel.ConfigChange{},
+		},
+		{
+			name:     "BothNil",
+			expected: []inboundmodel.ConfigChange{},
+		},
+		{
+			name:     "ChangedRedirectURIs",
+			previous: validOAuthProfile(),
+			current: func() *providers.OAuthProfile {
+				p := validOAuthProfile()
+				p.RedirectURIs = []string{"https://app.example.com/callback"}
+				return p
+			}(),
+			expected: []inboundmodel.ConfigChange{
+				{
+					Field:         "redirectUris",
+					PreviousValue: []interface{}{"https://app.example.com/cb"},
+					NewValue:      []interface{}{"https://app.example.com/callback"},
+				},
+			},
+		},
+		{
+			name:     "NestedFieldAdded",
+			previous: validOAuthProfile(),
+			current: func() *providers.OAuthProfile {
+				p := validOAuthProfile()
+				p.Token = &providers.OAuthTokenConfig{
+					AccessToken: &providers.AccessTokenConfig{SigningAlg: "PS256"},
+				}
+				return p
+			}(),
+			expected: []inboundmodel.ConfigChange{
+				{Field: "token.accessToken.signingAlg", NewValue: "PS256"},
+			},
+		},
+		{
+			name:     "NilAndEmptyListsAreEqual",
+			previous: &providers.OAuthProfile{TokenEndpointAuthMethod: "none"},
+			current: &providers.OAuthProfile{
+				TokenEndpointAuthMethod: "none",
+				RedirectURIs:            []string{},
+				GrantTypes:              []string{},
+			},
+			expected: []inboundmodel.ConfigChange{},
+		},
+		{
+			name:     "ProfileRemoved",
+			previous: &providers.OAuthProfile{TokenEndpointAuthMethod: "none", PKCERequired: true},
+			expected: []inboundmodel.ConfigChange{
+				{Field: "dpopBoundAccessTokens", PreviousValue: false},
+				{Field: "enforceEssentialClaims", PreviousValue: false},
+				{Field: "includeActClaim", PreviousValue: false},
+				{Field: "pkceRequired", PreviousValue: true},
+				{Field: "publicClient", PreviousValue: false},
+				{Field: "requirePushedAuthorizationRequests", PreviousValue: false},
+				{Field: "tokenEndpointAuthMethod", PreviousValue: "none"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			changes, err := diffOAuthProfiles(tc.previous, tc.current)
+			suite.NoError(err)
+			suite.Equal(tc.expected, changes)
+		})
+	}
+}
+
+// storedOAuthProfile returns validOAuthProfile as persisted, with the defaults applied on write.
+func storedOAuthProfile() *providers.OAuthProfile {
+	p := validOAuthProfile()
+	p.UserInfo = &providers.UserInfoConfig{ResponseType: providers.UserInfoResponseTypeJSON}
+	return p
+}
+
+func (suite *OAuthProfileHistoryTestSuite) TestUpdateInboundClient_RecordsRevision() {
+	existing := storedOAuthProfile()
+	desired := validOAuthProfile()
+	desired.RedirectURIs = []string{"https://app.example.com/callback"}
+
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().UpdateInboundClient(mock.Anything, mock.Anything).Return(nil)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(existing, nil)
+	store.EXPECT().UpdateOAuthProfile(mock.Anything, "p1", desired).Return(nil)
+	store.EXPECT().CreateOAuthProfileRevision(mock.Anything, "p1",
+		mock.MatchedBy(func(r inboundmodel.OAuthProfileRevision) bool {
+			return r.OAuthProfile == desired &&
+				r.ChangedBy == "admin-user" &&
+				!r.CreatedAt.IsZero() &&
+				len(r.Changes) == 1 && r.Changes[0].Field == "redirectUris"
+		})).Return(nil)
+
+	ctx := security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest("admin-user", "ou1", "tok", nil, nil))
+	err := newServiceForTest(store).UpdateInboundClient(ctx, ptrInboundClient(), desired, true, "", "")
+	suite.NoError(err)
+}
+
+func (suite *OAuthProfileHistoryTestSuite) TestUpdateInboundClient_UnchangedProfileRecordsNoRevision() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().UpdateInboundClient(mock.Anything, mock.Anything).Return(nil)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(storedOAuthProfile(), nil)
+	store.EXPECT().UpdateOAuthProfile(mock.Anything, "p1", mock.Anything).Return(nil)
+
+	err := newServiceForTest(store).UpdateInboundClient(context.Background(), ptrInboundClient(),
+		validOAuthProfile(), true, "", "")
+	suite.NoError(err)
+	store.AssertNotCalled(suite.T(), "CreateOAuthProfileRevision", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OAuthProfileHistoryTestSuite) TestUpdateInboundClient_RemovedProfileRecordsRevision() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().UpdateInboundClient(mock.Anything, mock.Anything).Return(nil)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(validOAuthProfile(), nil)
+	store.EXPECT().DeleteOAuthProfile(mock.Anything, "p1").Return(nil)
+	store.EXPECT().CreateOAuthProfileRevision(mock.Anything, "p1",
+		mock.MatchedBy(func(r inboundmodel.OAuthProfileRevision) bool {
+			return r.OAuthProfile == nil && len(r.Changes) > 0
+		})).Return(nil)
+
+	err := newServiceForTest(store).UpdateInboundClient(context.Background(), ptrInboundClient(),
+		nil, false, "", "")
+	suite.NoError(err)
+}
+
+func (suite *OAuthProfileHistoryTestSuite) TestUpdateInboundClient_RevisionErrorFailsUpdate() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().UpdateInboundClient(mock.Anything, mock.Anything).Return(nil)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(nil, ErrInboundClientNotFound)
+	store.EXPECT().CreateOAuthProfile(mock.Anything, "p1", mock.Anything).Return(nil)
+	store.EXPECT().CreateOAuthProfileRevision(mock.Anything, "p1", mock.Anything).
+		Return(errors.New("db error"))
+
+	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, nil, nil)
+	err := svc.UpdateInboundClient(context.Background(), ptrInboundClient(), validOAuthProfile(), true, "", "")
+	suite.Error(err)
+}
+
+func (suite *OAuthProfileHistoryTestSuite) TestGetOAuthProfileRevisionList() {
+	revisions := []inboundmodel.OAuthProfileRevision{{Version: 2}, {Version: 1}}
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().GetOAuthProfileRevisionList(mock.Anything, "p1", 10, 0).Return(revisions, 2, nil)
+
+	result, total, err := newServiceForTest(store).GetOAuthProfileRevisionList(context.Background(), "p1", 10, 0)
+	suite.NoError(err)
+	suite.Equal(2, total)
+	suite.Equal(revisions, result)
+}
+
+func (suite *OAuthProfileHistoryTestSuite) TestGetOAuthProfileRevisionList_InvalidPagination() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	svc := newServiceForTest(store)
+
+	_, _, err := svc.GetOAuthProfileRevisionList(context.Background(), "p1", -1, 0)
+	suite.Error(err)
+	_, _, err = svc.GetOAuthProfileRevisionList(context.Background(), "p1", 10, -1)
+	suite.Error(err)
+}
+
+func (suite *OAuthProfileHistoryTestSuite) TestGetOAuthProfileRevision_NotFound() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().GetOAuthProfileRevision(mock.Anything, "p1", 7).Return(nil, ErrOAuthProfileRevisionNotFound)
+
+	revision, err := newServiceForTest(store).GetOAuthProfileRevision(context.Background(), "p1", 7)
+	suite.Nil(revision)
+	suite.ErrorIs(err, ErrOAuthProfileRevisionNotFound)
+}
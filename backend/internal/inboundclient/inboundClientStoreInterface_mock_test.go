@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -158,6 +159,69 @@ func (_c *inboundClientStoreInterfaceMock_CreateOAuthProfile_Call) RunAndReturn(
 	return _c
 }
 
+// CreateOAuthProfileRevision provides a mock function for the type inboundClientStoreInterfaceMock
+func (_mock *inboundClientStoreInterfaceMock) CreateOAuthProfileRevision(ctx context.Context, entityID string, revision model.OAuthProfileRevision) error {
+	ret := _mock.Called(ctx, entityID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOAuthProfileRevision")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, model.OAuthProfileRevision) error); ok {
+		r0 = returnFunc(ctx, entityID, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOAuthProfileRevision'
+type inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call struct {
+	*mock.Call
+}
+
+// CreateOAuthProfileRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - revision model.OAuthProfileRevision
+func (_e *inboundClientStoreInterfaceMock_Expecter) CreateOAuthProfileRevision(ctx interface{}, entityID interface{}, revision interface{}) *inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call {
+	return &inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call{Call: _e.mock.On("CreateOAuthProfileRevision", ctx, entityID, revision)}
+}
+
+func (_c *inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call) Run(run func(ctx context.Context, entityID string, revision model.OAuthProfileRevision)) *inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 model.OAuthProfileRevision
+		if args[2] != nil {
+			arg2 = args[2].(model.OAuthProfileRevision)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call) Return(err error) *inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call) RunAndReturn(run func(ctx context.Context, entityID string, revision model.OAuthProfileRevision) error) *inboundClientStoreInterfaceMock_CreateOAuthProfileRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteInboundClient provides a mock function for the type inboundClientStoreInterfaceMock
 func (_mock *inboundClientStoreInterfaceMock) DeleteInboundClient(ctx context.Context, entityID string) error {
 	ret := _mock.Called(ctx, entityID)
@@ -568,6 +632,166 @@ func (_c *inboundClientStoreInterfaceMock_GetOAuthProfileByEntityID_Call) RunAnd
 	return _c
 }
 
+// GetOAuthProfileRevision provides a mock function for the type inboundClientStoreInterfaceMock
+func (_mock *inboundClientStoreInterfaceMock) GetOAuthProfileRevision(ctx context.Context, entityID string, version int) (*model.OAuthProfileRevision, error) {
+	ret := _mock.Called(ctx, entityID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOAuthProfileRevision")
+	}
+
+	var r0 *model.OAuthProfileRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*model.OAuthProfileRevision, error)); ok {
+		return returnFunc(ctx, entityID, version)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *model.OAuthProfileRevision); ok {
+		r0 = returnFunc(ctx, entityID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OAuthProfileRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, entityID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOAuthProfileRevision'
+type inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call struct {
+	*mock.Call
+}
+
+// GetOAuthProfileRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - version int
+func (_e *inboundClientStoreInterfaceMock_Expecter) GetOAuthProfileRevision(ctx interface{}, entityID interface{}, version interface{}) *inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call {
+	return &inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call{Call: _e.mock.On("GetOAuthProfileRevision", ctx, entityID, version)}
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call) Run(run func(ctx context.Context, entityID string, version int)) *inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call) Return(oAuthProfileRevision *model.OAuthProfileRevision, err error) *inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call {
+	_c.Call.Return(oAuthProfileRevision, err)
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call) RunAndReturn(run func(ctx context.Context, entityID string, version int) (*model.OAuthProfileRevision, error)) *inboundClientStoreInterfaceMock_GetOAuthProfileRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOAuthProfileRevisionList provides a mock function for the type inboundClientStoreInterfaceMock
+func (_mock *inboundClientStoreInterfaceMock) GetOAuthProfileRevisionList(ctx context.Context, entityID string, limit int, offset int) ([]model.OAuthProfileRevision, int, error) {
+	ret := _mock.Called(ctx, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOAuthProfileRevisionList")
+	}
+
+	var r0 []model.OAuthProfileRevision
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]model.OAuthProfileRevision, int, error)); ok {
+		return returnFunc(ctx, entityID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []model.OAuthProfileRevision); ok {
+		r0 = returnFunc(ctx, entityID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OAuthProfileRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) int); ok {
+		r1 = returnFunc(ctx, entityID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, int, int) error); ok {
+		r2 = returnFunc(ctx, entityID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOAuthProfileRevisionList'
+type inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call struct {
+	*mock.Call
+}
+
+// GetOAuthProfileRevisionList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - limit int
+//   - offset int
+func (_e *inboundClientStoreInterfaceMock_Expecter) GetOAuthProfileRevisionList(ctx interface{}, entityID interface{}, limit interface{}, offset interface{}) *inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call {
+	return &inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call{Call: _e.mock.On("GetOAuthProfileRevisionList", ctx, entityID, limit, offset)}
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call) Run(run func(ctx context.Context, entityID string, limit int, offset int)) *inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call) Return(oAuthProfileRevisions []model.OAuthProfileRevision, n int, err error) *inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call {
+	_c.Call.Return(oAuthProfileRevisions, n, err)
+	return _c
+}
+
+func (_c *inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call) RunAndReturn(run func(ctx context.Context, entityID string, limit int, offset int) ([]model.OAuthProfileRevision, int, error)) *inboundClientStoreInterfaceMock_GetOAuthProfileRevisionList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotalInboundClientCount provides a mock function for the type inboundClientStoreInterfaceMock
 func (_mock *inboundClientStoreInterfaceMock) GetTotalInboundClientCount(ctx context.Context) (int, error) {
 	ret := _mock.Called(ctx)
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// ConfigChange describes the change of a single field between two revisions of a configuration.
+// Nested fields are addressed with a dot-separated path; lists are compared as a whole. A field that
+// was added has no previous value and a field that was removed has no new value.
+type ConfigChange struct {
+	Field         string      `json:"field"`
+	PreviousValue interface{} `json:"previousValue,omitempty"`
+	NewValue      interface{} `json:"newValue,omitempty"`
+}
+
+// OAuthProfileRevision is a recorded revision of an entity's OAuth profile. OAuthProfile is the profile
+// as saved by the change, or nil when the change removed the OAuth configuration.
+type OAuthProfileRevision struct {
+	Version      int
+	OAuthProfile *providers.OAuthProfile
+	Changes      []ConfigChange
+	ChangedBy    string
+	CreatedAt    time.Time
+}
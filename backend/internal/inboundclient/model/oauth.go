@@ -44,6 +44,8 @@ var (
 type OAuthConfig struct {
 	ClientID                           string                            `json:"clientId,omitempty"                 yaml:"clientId,omitempty"`
 	RedirectURIs                       []string                          `json:"redirectUris,omitempty"             yaml:"redirectUris,omitempty"`
+	RedirectURIMatching                providers.RedirectURIMatching     `json:"redirectUriMatching,omitempty"      yaml:"redirectUriMatching,omitempty"`
+	ApplicationType                    providers.ApplicationType         `json:"applicationType,omitempty"          yaml:"applicationType,omitempty"`
 	GrantTypes                         []providers.GrantType             `json:"grantTypes,omitempty"               yaml:"grantTypes,omitempty"`
 	ResponseTypes                      []providers.ResponseType          `json:"responseTypes,omitempty"            yaml:"responseTypes,omitempty"`
 	TokenEndpointAuthMethod            providers.TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"  yaml:"tokenEndpointAuthMethod,omitempty"`
@@ -52,12 +54,21 @@ type OAuthConfig struct {
 	RequirePushedAuthorizationRequests bool                              `json:"requirePushedAuthorizationRequests" yaml:"requirePushedAuthorizationRequests"`
 	DPoPBoundAccessTokens              bool                              `json:"dpopBoundAccessTokens"              yaml:"dpopBoundAccessTokens"`
 	IncludeActClaim                    bool                              `json:"includeActClaim"                    yaml:"includeActClaim"`
+	EnforceEssentialClaims             bool                              `json:"enforceEssentialClaims"             yaml:"enforceEssentialClaims"`
 	Token                              *providers.OAuthTokenConfig       `json:"token,omitempty"                    yaml:"token,omitempty"`
 	Scopes                             []string                          `json:"scopes,omitempty"                   yaml:"scopes,omitempty"`
 	UserInfo                           *providers.UserInfoConfig         `json:"userInfo,omitempty"                 yaml:"userInfo,omitempty"`
 	ScopeClaims                        map[string][]string               `json:"scopeClaims,omitempty"              yaml:"scopeClaims,omitempty"`
 	Certificate                        *providers.Certificate            `json:"certificate,omitempty"              yaml:"certificate,omitempty"`
 	AcrValues                          []string                          `json:"acrValues,omitempty"                yaml:"acrValues,omitempty"`
+	AllowedOrigins                     []string                          `json:"allowedOrigins,omitempty"           yaml:"allowedOrigins,omitempty"`
+	AllowedNetworks                    []string                          `json:"allowedNetworks,omitempty"          yaml:"allowedNetworks,omitempty"`
+}
+
+// SupportedTokenSigningAlgs lists the JWS algorithms that access tokens and ID tokens can be signed
+// with. Signing with an algorithm requires a configured key of the matching type.
+var SupportedTokenSigningAlgs = []string{
+	string(jws.RS256), string(jws.ES256), string(jws.ES384), string(jws.ES512), string(jws.EdDSA),
 }
 
 // SupportedIDTokenEncryptionAlgs lists JWE key-management algorithms supported for ID token encryption.
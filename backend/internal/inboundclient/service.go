@@ -39,10 +39,13 @@ import (
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/cors"
 	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/netaccess"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
@@ -63,6 +66,9 @@ type InboundClientServiceInterface interface {
 	// UpdateInboundClient validates and persists updates to an inbound client, certificates, and OAuth config.
 	UpdateInboundClient(ctx context.Context, client *inboundmodel.InboundClient,
 		oauthProfile *providers.OAuthProfile, hasClientSecret bool, oauthClientID string, entityName string) error
+	// UpdateInboundClientProperties replaces the free-form properties of an inbound client without
+	// touching its flows, OAuth profile, or certificates.
+	UpdateInboundClientProperties(ctx context.Context, entityID string, properties map[string]interface{}) error
 	// DeleteInboundClient removes the inbound client, OAuth profile, and certificates for the given entity.
 	DeleteInboundClient(ctx context.Context, entityID string) error
 	// Validate resolves flow defaults and validates FK constraints and OAuth profile without persisting.
@@ -76,6 +82,8 @@ type InboundClientServiceInterface interface {
 	GetOAuthProfileByEntityID(ctx context.Context, entityID string) (*providers.OAuthProfile, error)
 	// GetOAuthClientByClientID resolves a full OAuthClient by its public client_id.
 	GetOAuthClientByClientID(ctx context.Context, clientID string) (*providers.OAuthClient, error)
+	// GetAllowedOrigins returns the CORS origins registered across all OAuth clients.
+	GetAllowedOrigins(ctx context.Context) ([]string, error)
 
 	// IsDeclarative reports whether the entity's inbound profile was loaded from a declarative resource file.
 	IsDeclarative(ctx context.Context, entityID string) bool
@@ -85,6 +93,15 @@ type InboundClientServiceInterface interface {
 	// GetCertificate retrieves the certificate for the given reference type and ID.
 	GetCertificate(ctx context.Context, refType cert.CertificateReferenceType, refID string) (
 		*inboundmodel.Certificate, *CertOperationError)
+
+	// GetOAuthProfileRevisionList returns a page of the revisions of the entity's OAuth profile, most
+	// recent first and without the saved profile, together with the total number of revisions.
+	GetOAuthProfileRevisionList(ctx context.Context, entityID string, limit, offset int) (
+		[]inboundmodel.OAuthProfileRevision, int, error)
+	// GetOAuthProfileRevision returns a revision of the entity's OAuth profile by version. It returns
+	// ErrOAuthProfileRevisionNotFound when the revision does not exist.
+	GetOAuthProfileRevision(ctx context.Context, entityID string, version int) (
+		*inboundmodel.OAuthProfileRevision, error)
 }
 
 type inboundClientService struct {
@@ -97,6 +114,7 @@ type inboundClientService struct {
 	flowMgt        flowmgt.FlowMgtServiceInterface
 	entityType     entitytype.EntityTypeServiceInterface
 	consentService consent.ConsentServiceInterface
+	scopeService   scope.ScopeServiceInterface
 	logger         *log.Logger
 }
 
@@ -109,6 +127,7 @@ func newInboundClientService(store inboundClientStoreInterface, transactioner tr
 	flowMgt flowmgt.FlowMgtServiceInterface,
 	entityType entitytype.EntityTypeServiceInterface,
 	consentService consent.ConsentServiceInterface,
+	scopeService scope.ScopeServiceInterface,
 ) InboundClientServiceInterface {
 	return &inboundClientService{
 		store:          store,
@@ -120,6 +139,7 @@ func newInboundClientService(store inboundClientStoreInterface, transactioner tr
 		flowMgt:        flowMgt,
 		entityType:     entityType,
 		consentService: consentService,
+		scopeService:   scopeService,
 		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "InboundClientService")),
 	}
 }
@@ -153,7 +173,7 @@ func (s *inboundClientService) CreateInboundClient(ctx context.Context, client *
 	if err := validateOAuthCertificateClientID(oauthProfile, oauthClientID); err != nil {
 		return err
 	}
-	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		if err := s.store.CreateInboundClient(txCtx, *client); err != nil {
 			return err
 		}
@@ -170,6 +190,9 @@ func (s *inboundClientService) CreateInboundClient(ctx context.Context, client *
 			if err := s.store.CreateOAuthProfile(txCtx, client.ID, oauthProfile); err != nil {
 				return err
 			}
+			if err := s.recordOAuthProfileRevision(txCtx, client.ID, nil, oauthProfile); err != nil {
+				return err
+			}
 		}
 		if s.consentService != nil && s.consentService.IsEnabled() {
 			if err := s.syncConsentOnCreate(txCtx, client.ID, entityName, client, oauthProfile); err != nil {
@@ -178,6 +201,11 @@ func (s *inboundClientService) CreateInboundClient(ctx context.Context, client *
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	cors.InvalidateApplicationOrigins()
+	return nil
 }
 
 // GetInboundClientByEntityID returns the inbound client for the given entity.
@@ -235,7 +263,7 @@ func (s *inboundClientService) UpdateInboundClient(ctx context.Context, client *
 	if err := validateOAuthCertificateClientID(oauthProfile, oauthClientID); err != nil {
 		return err
 	}
-	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		if err := s.store.UpdateInboundClient(txCtx, *client); err != nil {
 			return err
 		}
@@ -267,6 +295,32 @@ func (s *inboundClientService) UpdateInboundClient(ctx context.Context, client *
 		}
 		return s.syncOAuthProfile(txCtx, client.ID, oauthProfile)
 	})
+	if err != nil {
+		return err
+	}
+	cors.InvalidateApplicationOrigins()
+	return nil
+}
+
+// UpdateInboundClientProperties replaces the free-form properties of an inbound client without
+// touching its flows, OAuth profile, or certificates.
+func (s *inboundClientService) UpdateInboundClientProperties(
+	ctx context.Context, entityID string, properties map[string]interface{},
+) error {
+	if s.store.IsDeclarative(ctx, entityID) {
+		return ErrCannotModifyDeclarative
+	}
+	client, err := s.store.GetInboundClientByEntityID(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return ErrInboundClientNotFound
+	}
+	client.Properties = properties
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		return s.store.UpdateInboundClient(txCtx, *client)
+	})
 }
 
 // Validate resolves flow defaults and validates FK constraints and OAuth profile without persisting.
@@ -361,7 +415,7 @@ func (s *inboundClientService) DeleteInboundClient(ctx context.Context, entityID
 	}
 	// Capture OAuth client_id before the caller deletes the entity itself.
 	oauthClientID := s.resolveClientID(ctx, entityID)
-	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		if err := s.store.DeleteInboundClient(txCtx, entityID); err != nil {
 			return err
 		}
@@ -374,6 +428,11 @@ func (s *inboundClientService) DeleteInboundClient(ctx context.Context, entityID
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	cors.InvalidateApplicationOrigins()
+	return nil
 }
 
 // GetOAuthProfileByEntityID returns the stored OAuth profile for the given entity.
@@ -382,7 +441,72 @@ func (s *inboundClientService) GetOAuthProfileByEntityID(ctx context.Context, en
 	return s.store.GetOAuthProfileByEntityID(ctx, entityID)
 }
 
-// syncOAuthProfile creates, updates, or deletes the stored OAuth profile to match the desired state.
+// GetAllowedOrigins returns the CORS origins registered across all OAuth clients. A client's explicit
+// allowed origins take precedence; without them, the origins of its http(s) redirect URIs are used.
+func (s *inboundClientService) GetAllowedOrigins(ctx context.Context) ([]string, error) {
+	clients, err := s.store.GetInboundClientList(ctx, serverconst.MaxCompositeStoreRecords)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{})
+	origins := make([]string, 0)
+	for _, c := range clients {
+		profile, err := s.store.GetOAuthProfileByEntityID(ctx, c.ID)
+		if err != nil {
+			if errors.Is(err, ErrInboundClientNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		for _, origin := range allowedOriginsForProfile(profile) {
+			if _, ok := seen[origin]; ok {
+				continue
+			}
+			seen[origin] = struct{}{}
+			origins = append(origins, origin)
+		}
+	}
+	return origins, nil
+}
+
+// allowedOriginsForProfile returns the explicit allowed origins of an OAuth profile, or the origins
+// derived from its http(s) redirect URIs when none are set.
+func allowedOriginsForProfile(p *providers.OAuthProfile) []string {
+	if p == nil {
+		return nil
+	}
+	if len(p.AllowedOrigins) > 0 {
+		return p.AllowedOrigins
+	}
+	origins := make([]string, 0, len(p.RedirectURIs))
+	for _, uri := range p.RedirectURIs {
+		if origin, ok := cors.OriginFromURL(uri); ok {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// GetOAuthProfileRevisionList returns a page of the revisions of the entity's OAuth profile.
+func (s *inboundClientService) GetOAuthProfileRevisionList(ctx context.Context, entityID string,
+	limit, offset int) ([]inboundmodel.OAuthProfileRevision, int, error) {
+	if limit < 0 {
+		return nil, 0, fmt.Errorf("invalid limit: must be non-negative, got %d", limit)
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("invalid offset: must be non-negative, got %d", offset)
+	}
+	return s.store.GetOAuthProfileRevisionList(ctx, entityID, limit, offset)
+}
+
+// GetOAuthProfileRevision returns a revision of the entity's OAuth profile by version.
+func (s *inboundClientService) GetOAuthProfileRevision(ctx context.Context, entityID string,
+	version int) (*inboundmodel.OAuthProfileRevision, error) {
+	return s.store.GetOAuthProfileRevision(ctx, entityID, version)
+}
+
+// syncOAuthProfile creates, updates, or deletes the stored OAuth profile to match the desired state and
+// records the change in the profile's history.
 func (s *inboundClientService) syncOAuthProfile(ctx context.Context, entityID string,
 	desired *providers.OAuthProfile) error {
 	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -392,14 +516,18 @@ func (s *inboundClientService) syncOAuthProfile(ctx context.Context, entityID st
 		}
 		switch {
 		case desired != nil && existing != nil:
-			return s.store.UpdateOAuthProfile(txCtx, entityID, desired)
+			err = s.store.UpdateOAuthProfile(txCtx, entityID, desired)
 		case desired != nil && existing == nil:
-			return s.store.CreateOAuthProfile(txCtx, entityID, desired)
+			err = s.store.CreateOAuthProfile(txCtx, entityID, desired)
 		case desired == nil && existing != nil:
-			return s.store.DeleteOAuthProfile(txCtx, entityID)
+			err = s.store.DeleteOAuthProfile(txCtx, entityID)
 		default:
 			return nil
 		}
+		if err != nil {
+			return err
+		}
+		return s.recordOAuthProfileRevision(txCtx, entityID, existing, desired)
 	})
 }
 
@@ -442,6 +570,9 @@ func (s *inboundClientService) GetOAuthClientByClientID(ctx context.Context, cli
 	}
 
 	client := BuildOAuthClient(entityID, clientID, ouID, e.Category, oauthProfile)
+	if err := s.applyScopeDefinitions(ctx, client); err != nil {
+		return nil, err
+	}
 
 	certificate, opErr := s.GetCertificate(ctx, cert.CertificateReferenceTypeOAuthApp, clientID)
 	if opErr != nil {
@@ -467,13 +598,18 @@ func BuildOAuthClient(
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
 		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		RedirectURIMatching:                providers.RedirectURIMatching(p.RedirectURIMatching),
+		ApplicationType:                    providers.ApplicationType(p.ApplicationType),
 		IncludeActClaim:                    p.IncludeActClaim,
+		EnforceEssentialClaims:             p.EnforceEssentialClaims,
 		Scopes:                             p.Scopes,
 		ScopeClaims:                        p.ScopeClaims,
 		Token:                              p.Token,
 		UserInfo:                           p.UserInfo,
 		Certificate:                        p.Certificate,
 		AcrValues:                          p.AcrValues,
+		AllowedOrigins:                     p.AllowedOrigins,
+		AllowedNetworks:                    p.AllowedNetworks,
 	}
 	for _, gt := range p.GrantTypes {
 		client.GrantTypes = append(client.GrantTypes, providers.GrantType(gt))
@@ -484,6 +620,33 @@ func BuildOAuthClient(
 	return client
 }
 
+// applyScopeDefinitions adds the claim bindings of defined scopes listed by the client to the
+// client's scope claims mapping, so that those scopes release their bound claims. Claims mapped by
+// the client itself for a scope take precedence over the scope definition.
+func (s *inboundClientService) applyScopeDefinitions(ctx context.Context, client *providers.OAuthClient) error {
+	if s.scopeService == nil || len(client.Scopes) == 0 {
+		return nil
+	}
+
+	definitions, svcErr := s.scopeService.GetScopesByNames(ctx, client.Scopes)
+	if svcErr != nil {
+		return fmt.Errorf("failed to get scope definitions: %s", svcErr.Error.DefaultValue)
+	}
+
+	scopeClaims := make(map[string][]string, len(client.ScopeClaims)+len(definitions))
+	for name, claims := range client.ScopeClaims {
+		scopeClaims[name] = claims
+	}
+	for _, definition := range definitions {
+		if _, exists := scopeClaims[definition.Name]; exists || len(definition.Claims) == 0 {
+			continue
+		}
+		scopeClaims[definition.Name] = definition.Claims
+	}
+	client.ScopeClaims = scopeClaims
+	return nil
+}
+
 // resolveFlowDefaults fills AuthFlowID, RegistrationFlowID, and RecoveryFlowID with system
 // defaults when empty, using the auth flow's handle to locate matching flows of each type.
 func (s *inboundClientService) resolveFlowDefaults(ctx context.Context, c *inboundmodel.InboundClient) error {
@@ -655,6 +818,12 @@ func validateOAuthProfile(p *providers.OAuthProfile, hasClientSecret bool) error
 	if err := validateRedirectURIs(p); err != nil {
 		return err
 	}
+	if err := validateAllowedOrigins(p.AllowedOrigins); err != nil {
+		return err
+	}
+	if _, err := netaccess.ParseNetworks(p.AllowedNetworks); err != nil {
+		return ErrOAuthInvalidAllowedNetwork
+	}
 	if err := validateGrantAndResponseTypes(p); err != nil {
 		return err
 	}
@@ -666,12 +835,113 @@ func validateOAuthProfile(p *providers.OAuthProfile, hasClientSecret bool) error
 			return err
 		}
 	}
+	if err := validateApplicationType(p, hasClientSecret); err != nil {
+		return err
+	}
 	if err := validateUserInfoConfig(p); err != nil {
 		return err
 	}
 	if err := validateIDTokenConfig(p); err != nil {
 		return err
 	}
+	if err := validateTokenSigningAlgs(p.Token); err != nil {
+		return err
+	}
+	if err := validateAccessTokenClaimsPolicy(p.Token); err != nil {
+		return err
+	}
+	return validateTokenValidityPeriods(p.Token)
+}
+
+// validateAllowedOrigins checks that every explicitly allowed CORS origin is a concrete http(s) origin.
+func validateAllowedOrigins(origins []string) error {
+	for _, origin := range origins {
+		if cors.ValidateApplicationOrigin(origin) != nil {
+			return ErrOAuthInvalidAllowedOrigin
+		}
+	}
+	return nil
+}
+
+// validateTokenSigningAlgs checks that the access token and ID token signing algorithms, when set,
+// are supported.
+func validateTokenSigningAlgs(token *providers.OAuthTokenConfig) error {
+	if token == nil {
+		return nil
+	}
+	if at := token.AccessToken; at != nil && at.SigningAlg != "" &&
+		!slices.Contains(inboundmodel.SupportedTokenSigningAlgs, at.SigningAlg) {
+		return ErrOAuthAccessTokenUnsupportedSigningAlg
+	}
+	if it := token.IDToken; it != nil && it.SigningAlg != "" &&
+		!slices.Contains(inboundmodel.SupportedTokenSigningAlgs, it.SigningAlg) {
+		return ErrOAuthIDTokenUnsupportedSigningAlg
+	}
+	return nil
+}
+
+// validateAccessTokenClaimsPolicy checks that the access token claims policy, when set, names only
+// non-empty claims, does not both exclude and hash a claim, and gates scoped claims on a non-empty scope.
+func validateAccessTokenClaimsPolicy(token *providers.OAuthTokenConfig) error {
+	if token == nil || token.AccessToken == nil || token.AccessToken.ClaimsPolicy == nil {
+		return nil
+	}
+	policy := token.AccessToken.ClaimsPolicy
+	for _, claim := range policy.ExcludedClaims {
+		if strings.TrimSpace(claim) == "" || slices.Contains(policy.HashedClaims, claim) {
+			return ErrOAuthAccessTokenInvalidClaimsPolicy
+		}
+	}
+	for _, claim := range policy.HashedClaims {
+		if strings.TrimSpace(claim) == "" {
+			return ErrOAuthAccessTokenInvalidClaimsPolicy
+		}
+	}
+	for claim, scope := range policy.ScopedClaims {
+		if strings.TrimSpace(claim) == "" || strings.TrimSpace(scope) == "" {
+			return ErrOAuthAccessTokenInvalidClaimsPolicy
+		}
+	}
+	return nil
+}
+
+// validateTokenValidityPeriods checks that every explicitly set token validity period, including the
+// per-grant overrides, falls within the deployment's token lifetime bounds.
+func validateTokenValidityPeriods(token *providers.OAuthTokenConfig) error {
+	if token == nil {
+		return nil
+	}
+	periods := make([]int64, 0, 4+3*len(token.GrantValidityPeriods))
+	if token.AccessToken != nil {
+		periods = append(periods, token.AccessToken.UserConfig.ValidityPeriodOrZero(),
+			token.AccessToken.ClientConfig.ValidityPeriodOrZero())
+	}
+	if token.IDToken != nil {
+		periods = append(periods, token.IDToken.ValidityPeriod)
+	}
+	if token.RefreshToken != nil {
+		periods = append(periods, token.RefreshToken.ValidityPeriod)
+	}
+	for grantType, grantPeriods := range token.GrantValidityPeriods {
+		if !providers.GrantType(grantType).IsValid() {
+			return ErrOAuthGrantValidityInvalidGrantType
+		}
+		if grantPeriods != nil {
+			periods = append(periods, grantPeriods.AccessToken, grantPeriods.RefreshToken, grantPeriods.IDToken)
+		}
+	}
+
+	bounds := config.GetServerRuntime().Config.OAuth.TokenLifetime
+	for _, period := range periods {
+		if period < 0 || (period > 0 && !bounds.Allows(period)) {
+			return ErrOAuthTokenValidityOutOfBounds
+		}
+	}
+	if rt := token.RefreshToken; rt != nil {
+		if rt.MaxLifetime < 0 || (rt.MaxLifetime > 0 && rt.MaxLifetime < rt.ValidityPeriod) {
+			return ErrOAuthRefreshTokenInvalidMaxLifetime
+		}
+	}
 	return nil
 }
 
@@ -782,6 +1052,9 @@ func validateIDTokenConfig(p *providers.OAuthProfile) error {
 
 // validateRedirectURIs validates redirect URIs and authorization_code grant requirements.
 func validateRedirectURIs(p *providers.OAuthProfile) error {
+	if !providers.RedirectURIMatching(p.RedirectURIMatching).IsValid() {
+		return ErrOAuthInvalidRedirectURIMatching
+	}
 	for _, redirectURI := range p.RedirectURIs {
 		// Reject wildcards in the scheme before URL parsing — url.Parse may misinterpret them.
 		if idx := strings.Index(redirectURI, "://"); idx != -1 {
@@ -802,14 +1075,8 @@ func validateRedirectURIs(p *providers.OAuthProfile) error {
 		if parsedURI.Fragment != "" {
 			return ErrOAuthRedirectURIFragmentNotAllowed
 		}
-		wildcardEnabled := config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI
 		if strings.ContainsRune(parsedURI.Host, '*') {
-			if !wildcardEnabled {
-				return ErrOAuthInvalidRedirectURI
-			}
-			if err := validateHostWildcardPattern(parsedURI.Host); err != nil {
-				return err
-			}
+			return ErrOAuthRedirectURIWildcardHostNotAllowed
 		}
 		if strings.ContainsRune(parsedURI.RawQuery, '*') {
 			return ErrOAuthInvalidRedirectURI
@@ -817,7 +1084,8 @@ func validateRedirectURIs(p *providers.OAuthProfile) error {
 		if containsInvalidWildcardSegment(parsedURI.Path) {
 			return ErrOAuthInvalidRedirectURI
 		}
-		if strings.ContainsRune(parsedURI.Path, '*') && !wildcardEnabled {
+		if strings.ContainsRune(parsedURI.Path, '*') &&
+			!config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI {
 			return ErrOAuthInvalidRedirectURI
 		}
 	}
@@ -828,24 +1096,6 @@ func validateRedirectURIs(p *providers.OAuthProfile) error {
 	return nil
 }
 
-// validateHostWildcardPattern enforces structural rules for wildcards in the host
-// component: no * in the port portion of host:port, and no whole-label *. * matches one
-// or more alphanumeric characters at match time, enforced by the matcher itself.
-func validateHostWildcardPattern(host string) error {
-	if i := strings.LastIndex(host, ":"); i != -1 {
-		if strings.ContainsRune(host[i+1:], '*') {
-			return ErrOAuthInvalidRedirectURI
-		}
-		host = host[:i]
-	}
-	for _, label := range strings.Split(host, ".") {
-		if label == "*" {
-			return ErrOAuthInvalidRedirectURI
-		}
-	}
-	return nil
-}
-
 // containsInvalidWildcardSegment returns true if any path segment mixes * with other
 // characters (e.g. "foo*") or contains regex metacharacters (e.g. "[a-z]+").
 func containsInvalidWildcardSegment(p string) bool {
@@ -948,6 +1198,53 @@ func validatePublicClient(p *providers.OAuthProfile) error {
 	return nil
 }
 
+// validateApplicationType applies the redirect URI and client authentication rules of the
+// application type. Clients without an application type keep only the generic rules.
+func validateApplicationType(p *providers.OAuthProfile, hasClientSecret bool) error {
+	appType := providers.ApplicationType(p.ApplicationType)
+	if !appType.IsValid() {
+		return ErrOAuthInvalidApplicationType
+	}
+	if appType == "" {
+		return nil
+	}
+	for _, redirectURI := range p.RedirectURIs {
+		if !isRedirectURIAllowedForApplicationType(appType, redirectURI) {
+			return ErrOAuthRedirectURINotAllowedForApplicationType
+		}
+	}
+	if !appType.IsPublic() {
+		return nil
+	}
+	method := providers.TokenEndpointAuthMethod(p.TokenEndpointAuthMethod)
+	if hasClientSecret || method == providers.TokenEndpointAuthMethodClientSecretBasic ||
+		method == providers.TokenEndpointAuthMethodClientSecretPost {
+		return ErrOAuthApplicationTypeCannotUseClientSecret
+	}
+	if !p.PKCERequired {
+		return ErrOAuthApplicationTypeRequiresPKCE
+	}
+	return nil
+}
+
+// isRedirectURIAllowedForApplicationType reports whether the redirect URI scheme suits the
+// application type. Native apps may use custom schemes, claimed https links and http loopback
+// redirects (RFC 8252 §7); web and SPA apps are limited to http and https.
+func isRedirectURIAllowedForApplicationType(appType providers.ApplicationType, redirectURI string) bool {
+	parsedURI, err := sysutils.ParseURL(redirectURI)
+	if err != nil {
+		return false
+	}
+	switch parsedURI.Scheme {
+	case "https":
+		return true
+	case "http":
+		return appType != providers.ApplicationTypeNative || providers.IsLoopbackHost(parsedURI.Hostname())
+	default:
+		return appType == providers.ApplicationTypeNative
+	}
+}
+
 // validateFKs validates all FK references on an inbound client.
 func (s *inboundClientService) validateFKs(ctx context.Context, c *inboundmodel.InboundClient) error {
 	if c == nil {
@@ -971,6 +1268,34 @@ func (s *inboundClientService) validateFKs(ctx context.Context, c *inboundmodel.
 	if err := s.validateAllowedUserTypes(ctx, c.AllowedUserTypes); err != nil {
 		return err
 	}
+	if err := s.validateSharing(ctx, c.Sharing); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateSharing validates the FK references of each per-OU sharing override.
+func (s *inboundClientService) validateSharing(ctx context.Context, sharing *providers.ApplicationSharing) error {
+	if sharing == nil {
+		return nil
+	}
+	for _, ou := range sharing.OrganizationUnits {
+		if err := s.validateAuthFlowID(ctx, ou.AuthFlowID); err != nil {
+			return err
+		}
+		if err := s.validateRegistrationFlowID(ctx, ou.RegistrationFlowID); err != nil {
+			return err
+		}
+		if err := s.validateThemeID(ctx, ou.ThemeID); err != nil {
+			return err
+		}
+		if err := s.validateLayoutID(ctx, ou.LayoutID); err != nil {
+			return err
+		}
+		if err := s.validateAllowedUserTypes(ctx, ou.AllowedUserTypes); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1186,10 +1511,15 @@ func applyInboundDefaults(c *inboundmodel.InboundClient, oauthProfile *providers
 		assertion = c.Assertion
 	}
 	accessToken, idToken, refreshToken := resolveOAuthTokens(oauthProfile.Token, assertion)
+	var grantValidityPeriods map[string]*providers.GrantValidityPeriods
+	if oauthProfile.Token != nil {
+		grantValidityPeriods = oauthProfile.Token.GrantValidityPeriods
+	}
 	oauthProfile.Token = &providers.OAuthTokenConfig{
-		AccessToken:  accessToken,
-		IDToken:      idToken,
-		RefreshToken: refreshToken,
+		AccessToken:          accessToken,
+		IDToken:              idToken,
+		RefreshToken:         refreshToken,
+		GrantValidityPeriods: grantValidityPeriods,
 	}
 	oauthProfile.UserInfo = resolveUserInfo(oauthProfile.UserInfo, idToken)
 	oauthProfile.ScopeClaims = resolveScopeClaims(oauthProfile.ScopeClaims)
@@ -1241,6 +1571,8 @@ func resolveOAuthTokens(in *providers.OAuthTokenConfig,
 	}
 	if in != nil && in.AccessToken != nil {
 		accessToken.ClientConfig = in.AccessToken.ClientConfig
+		accessToken.SigningAlg = in.AccessToken.SigningAlg
+		accessToken.ClaimsPolicy = in.AccessToken.ClaimsPolicy
 	}
 
 	var idToken *providers.IDTokenConfig
@@ -1251,6 +1583,7 @@ func resolveOAuthTokens(in *providers.OAuthTokenConfig,
 			ResponseType:   in.IDToken.ResponseType,
 			EncryptionAlg:  in.IDToken.EncryptionAlg,
 			EncryptionEnc:  in.IDToken.EncryptionEnc,
+			SigningAlg:     in.IDToken.SigningAlg,
 		}
 	}
 	if idToken != nil {
@@ -1271,6 +1604,8 @@ func resolveOAuthTokens(in *providers.OAuthTokenConfig,
 	if in != nil && in.RefreshToken != nil {
 		refreshToken = &providers.RefreshTokenConfig{
 			ValidityPeriod: in.RefreshToken.ValidityPeriod,
+			SlidingExpiry:  in.RefreshToken.SlidingExpiry,
+			MaxLifetime:    in.RefreshToken.MaxLifetime,
 		}
 	}
 
@@ -36,6 +36,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	entitytypepkg "github.com/thunder-id/thunderid/internal/entitytype"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/scope"
 	sysconfig "github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
@@ -47,6 +48,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/scopemock"
 )
 
 type InboundClientServiceTestSuite struct {
@@ -63,12 +65,12 @@ func (suite *InboundClientServiceTestSuite) SetupTest() {
 }
 
 func newServiceForTest(store inboundClientStoreInterface) InboundClientServiceInterface {
-	return newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, nil)
+	return newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, nil, nil)
 }
 
 func newServiceWithCert(certService cert.CertificateServiceInterface) *inboundClientService {
 	svc := newInboundClientService(
-		nil, transaction.NewNoOpTransactioner(), certService, nil, nil, nil, nil, nil, nil,
+		nil, transaction.NewNoOpTransactioner(), certService, nil, nil, nil, nil, nil, nil, nil,
 	)
 	return svc.(*inboundClientService)
 }
@@ -125,6 +127,7 @@ func (suite *InboundClientServiceTestSuite) TestCreateInboundClient_PersistsBoth
 	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
 	store.EXPECT().CreateInboundClient(mock.Anything, mock.Anything).Return(nil)
 	store.EXPECT().CreateOAuthProfile(mock.Anything, "p1", mock.Anything).Return(nil)
+	store.EXPECT().CreateOAuthProfileRevision(mock.Anything, "p1", mock.Anything).Return(nil)
 
 	svc := newServiceForTest(store)
 	err := svc.CreateInboundClient(context.Background(), ptrInboundClient(),
@@ -543,12 +546,45 @@ func (suite *InboundClientServiceTestSuite) TestUpdateInboundClient_Succeeds() {
 	// syncOAuthProfile path: GetOAuthProfileByEntityID returns not found → CreateOAuthProfile
 	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(nil, ErrInboundClientNotFound)
 	store.EXPECT().CreateOAuthProfile(mock.Anything, "p1", mock.Anything).Return(nil)
+	store.EXPECT().CreateOAuthProfileRevision(mock.Anything, "p1", mock.Anything).Return(nil)
 
-	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, nil)
+	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, nil, nil)
 	err := svc.UpdateInboundClient(context.Background(), ptrInboundClient(), validOAuthProfile(), true, "", "")
 	assert.NoError(suite.T(), err)
 }
 
+func (suite *InboundClientServiceTestSuite) TestUpdateInboundClientProperties_ReplacesProperties() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	existing := validInboundClient()
+	existing.Properties = map[string]interface{}{"url": "https://old.example.com"}
+	store.EXPECT().GetInboundClientByEntityID(mock.Anything, "p1").Return(&existing, nil)
+	props := map[string]interface{}{"branding": map[string]interface{}{"logoUrl": "https://example.com/l.png"}}
+	store.EXPECT().UpdateInboundClient(mock.Anything, mock.MatchedBy(func(c inboundmodel.InboundClient) bool {
+		return c.ID == "p1" && c.AuthFlowID == "flow-1" && assert.ObjectsAreEqual(props, c.Properties)
+	})).Return(nil)
+
+	err := newServiceForTest(store).UpdateInboundClientProperties(context.Background(), "p1", props)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *InboundClientServiceTestSuite) TestUpdateInboundClientProperties_RefusesDeclarative() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(true)
+
+	err := newServiceForTest(store).UpdateInboundClientProperties(context.Background(), "p1", nil)
+	assert.ErrorIs(suite.T(), err, ErrCannotModifyDeclarative)
+}
+
+func (suite *InboundClientServiceTestSuite) TestUpdateInboundClientProperties_NotFound() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetInboundClientByEntityID(mock.Anything, "p1").Return(nil, nil)
+
+	err := newServiceForTest(store).UpdateInboundClientProperties(context.Background(), "p1", nil)
+	assert.ErrorIs(suite.T(), err, ErrInboundClientNotFound)
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidate_ValidProfile() {
 	store := newInboundClientStoreInterfaceMock(suite.T())
 	svc := newServiceForTest(store)
@@ -574,7 +610,7 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_WildcardInH
 		GrantTypes:   []string{"authorization_code"},
 	}
 	err := validateRedirectURIs(p)
-	assert.ErrorIs(suite.T(), err, ErrOAuthInvalidRedirectURI)
+	assert.ErrorIs(suite.T(), err, ErrOAuthRedirectURIWildcardHostNotAllowed)
 }
 
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_WildcardInQuery_Rejected() {
@@ -1183,7 +1219,7 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcar
 		RedirectURIs: []string{"https://*.app.com/cb"},
 		GrantTypes:   []string{"authorization_code"},
 	}
-	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURI)
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIWildcardHostNotAllowed)
 }
 
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_QueryWildcardRejected() {
@@ -1194,7 +1230,7 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_QueryWildca
 	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURI)
 }
 
-// ----- Host wildcard registration with allow_wildcard_redirect_uri = true -----
+// ----- Host wildcards are rejected even with allow_wildcard_redirect_uri = true -----
 
 func (suite *InboundClientServiceTestSuite) enableWildcardConfig() {
 	sysconfig.ResetServerRuntime()
@@ -1203,71 +1239,137 @@ func (suite *InboundClientServiceTestSuite) enableWildcardConfig() {
 	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", cfg))
 }
 
-func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardLabelInternal_Accepted() {
-	suite.enableWildcardConfig()
-	p := &providers.OAuthProfile{
-		RedirectURIs:  []string{"https://tenant-app-*-*.gateway.example.com/cb"},
-		GrantTypes:    []string{"authorization_code"},
-		ResponseTypes: []string{"code"},
-	}
-	assert.NoError(suite.T(), validateRedirectURIs(p))
-}
-
-func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardSimplePattern_Accepted() {
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardWithFlag_Rejected() {
 	suite.enableWildcardConfig()
-	p := &providers.OAuthProfile{
-		RedirectURIs:  []string{"https://app-*.example.com/cb"},
-		GrantTypes:    []string{"authorization_code"},
-		ResponseTypes: []string{"code"},
+	for _, redirectURI := range []string{
+		"https://tenant-app-*-*.gateway.example.com/cb",
+		"https://app-*.example.com/cb",
+		"https://*.example.com/cb",
+		"https://app-*.example.com:8443/cb",
+		"https://app-*.example.com/cb/*",
+	} {
+		p := &providers.OAuthProfile{
+			RedirectURIs:  []string{redirectURI},
+			GrantTypes:    []string{"authorization_code"},
+			ResponseTypes: []string{"code"},
+		}
+		assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIWildcardHostNotAllowed, redirectURI)
 	}
-	assert.NoError(suite.T(), validateRedirectURIs(p))
 }
 
-func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardWholeLabel_Rejected() {
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_PortWildcard_Rejected() {
 	suite.enableWildcardConfig()
 	p := &providers.OAuthProfile{
-		RedirectURIs: []string{"https://*.example.com/cb"},
+		RedirectURIs: []string{"https://app.example.com:80*0/cb"},
 		GrantTypes:   []string{"authorization_code"},
 	}
 	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURI)
 }
 
-func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardInPort_Rejected() {
-	suite.enableWildcardConfig()
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardFlagOff_Rejected() {
+	// SetupTest already initializes with AllowWildcardRedirectURI = false.
 	p := &providers.OAuthProfile{
-		RedirectURIs: []string{"https://app.example.com:80*0/cb"},
+		RedirectURIs: []string{"https://app-*.example.com/cb"},
 		GrantTypes:   []string{"authorization_code"},
 	}
-	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURI)
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIWildcardHostNotAllowed)
 }
 
-func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardWithPort_Accepted() {
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_PathWildcardWithFlag_Accepted() {
 	suite.enableWildcardConfig()
 	p := &providers.OAuthProfile{
-		RedirectURIs:  []string{"https://app-*.example.com:8443/cb"},
+		RedirectURIs:  []string{"https://app.example.com/cb/*"},
 		GrantTypes:    []string{"authorization_code"},
 		ResponseTypes: []string{"code"},
 	}
 	assert.NoError(suite.T(), validateRedirectURIs(p))
 }
 
-func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardFlagOff_Rejected() {
-	// SetupTest already initializes with AllowWildcardRedirectURI = false.
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_RedirectURIMatching() {
 	p := &providers.OAuthProfile{
-		RedirectURIs: []string{"https://app-*.example.com/cb"},
-		GrantTypes:   []string{"authorization_code"},
+		RedirectURIs:        []string{"http://127.0.0.1/callback"},
+		GrantTypes:          []string{"authorization_code"},
+		RedirectURIMatching: string(providers.RedirectURIMatchingLoopback),
+	}
+	assert.NoError(suite.T(), validateRedirectURIs(p))
+
+	p.RedirectURIMatching = string(providers.RedirectURIMatchingExact)
+	assert.NoError(suite.T(), validateRedirectURIs(p))
+
+	p.RedirectURIMatching = "pattern"
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURIMatching)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateApplicationType_RedirectURISchemes() {
+	cases := []struct {
+		appType     providers.ApplicationType
+		redirectURI string
+		allowed     bool
+	}{
+		{providers.ApplicationTypeNative, "com.example.app:/callback", true},
+		{providers.ApplicationTypeNative, "https://app.example.com/callback", true},
+		{providers.ApplicationTypeNative, "http://127.0.0.1/callback", true},
+		{providers.ApplicationTypeNative, "http://[::1]:8080/callback", true},
+		{providers.ApplicationTypeNative, "http://localhost/callback", true},
+		{providers.ApplicationTypeNative, "http://app.example.com/callback", false},
+		{providers.ApplicationTypeSPA, "https://app.example.com/callback", true},
+		{providers.ApplicationTypeSPA, "http://localhost:3000/callback", true},
+		{providers.ApplicationTypeSPA, "com.example.app:/callback", false},
+		{providers.ApplicationTypeWeb, "http://app.example.com/callback", true},
+		{providers.ApplicationTypeWeb, "com.example.app:/callback", false},
+		{"", "com.example.app:/callback", true},
+	}
+	for _, tc := range cases {
+		p := &providers.OAuthProfile{
+			ApplicationType:         string(tc.appType),
+			RedirectURIs:            []string{tc.redirectURI},
+			TokenEndpointAuthMethod: string(providers.TokenEndpointAuthMethodNone),
+			PublicClient:            true,
+			PKCERequired:            true,
+		}
+		err := validateApplicationType(p, false)
+		if tc.allowed {
+			assert.NoError(suite.T(), err, "%s %s", tc.appType, tc.redirectURI)
+		} else {
+			assert.ErrorIs(suite.T(), err, ErrOAuthRedirectURINotAllowedForApplicationType,
+				"%s %s", tc.appType, tc.redirectURI)
+		}
 	}
-	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURI)
 }
 
-func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardMixedWithPath_Accepted() {
-	suite.enableWildcardConfig()
-	p := &providers.OAuthProfile{
-		RedirectURIs:  []string{"https://app-*.example.com/cb/*"},
-		GrantTypes:    []string{"authorization_code"},
-		ResponseTypes: []string{"code"},
+func (suite *InboundClientServiceTestSuite) TestValidateApplicationType_ClientAuthentication() {
+	newProfile := func(appType providers.ApplicationType, method providers.TokenEndpointAuthMethod,
+		pkce bool) *providers.OAuthProfile {
+		return &providers.OAuthProfile{
+			ApplicationType:         string(appType),
+			RedirectURIs:            []string{"https://app.example.com/callback"},
+			TokenEndpointAuthMethod: string(method),
+			PKCERequired:            pkce,
+		}
 	}
-	assert.NoError(suite.T(), validateRedirectURIs(p))
+
+	for _, appType := range []providers.ApplicationType{providers.ApplicationTypeNative, providers.ApplicationTypeSPA} {
+		assert.ErrorIs(suite.T(), validateApplicationType(
+			newProfile(appType, providers.TokenEndpointAuthMethodClientSecretBasic, true), false),
+			ErrOAuthApplicationTypeCannotUseClientSecret)
+		assert.ErrorIs(suite.T(), validateApplicationType(
+			newProfile(appType, providers.TokenEndpointAuthMethodClientSecretPost, true), false),
+			ErrOAuthApplicationTypeCannotUseClientSecret)
+		assert.ErrorIs(suite.T(), validateApplicationType(
+			newProfile(appType, providers.TokenEndpointAuthMethodNone, true), true),
+			ErrOAuthApplicationTypeCannotUseClientSecret)
+		assert.ErrorIs(suite.T(), validateApplicationType(
+			newProfile(appType, providers.TokenEndpointAuthMethodNone, false), false),
+			ErrOAuthApplicationTypeRequiresPKCE)
+		assert.NoError(suite.T(), validateApplicationType(
+			newProfile(appType, providers.TokenEndpointAuthMethodNone, true), false))
+	}
+
+	assert.NoError(suite.T(), validateApplicationType(
+		newProfile(providers.ApplicationTypeWeb, providers.TokenEndpointAuthMethodClientSecretBasic, false), true))
+	assert.ErrorIs(suite.T(), validateApplicationType(
+		newProfile("desktop", providers.TokenEndpointAuthMethodNone, true), false),
+		ErrOAuthInvalidApplicationType)
 }
 
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_MissingSchemeRejected() {
@@ -1591,7 +1693,7 @@ func (suite *InboundClientServiceTestSuite) TestUpdateInboundClient_WithRecovery
 	})).Return(nil)
 	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(nil, ErrInboundClientNotFound)
 
-	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, nil)
+	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, nil, nil)
 	client := ptrInboundClient()
 	client.RecoveryFlowID = "recovery-1"
 	client.IsRecoveryFlowEnabled = true
@@ -1846,6 +1948,52 @@ func (suite *InboundClientServiceTestSuite) TestValidateUniqueInboundClientID_St
 	assert.ErrorContains(suite.T(), err, "failed to check inbound client existence")
 }
 
+// ----- applyScopeDefinitions -----
+
+func (suite *InboundClientServiceTestSuite) TestApplyScopeDefinitions_MergesDefinedClaims() {
+	scopeSvc := scopemock.NewScopeServiceInterfaceMock(suite.T())
+	scopeSvc.On("GetScopesByNames", mock.Anything, []string{"openid", "orders", "billing", "audit"}).
+		Return([]scope.Scope{
+			{Name: "orders", Claims: []string{"order_id"}},
+			{Name: "billing", Claims: []string{"billing_id"}},
+			{Name: "audit", Claims: []string{}},
+		}, nil).Once()
+	svc := &inboundClientService{scopeService: scopeSvc}
+	appScopeClaims := map[string][]string{"billing": {"account"}}
+	client := &providers.OAuthClient{
+		Scopes:      []string{"openid", "orders", "billing", "audit"},
+		ScopeClaims: appScopeClaims,
+	}
+
+	err := svc.applyScopeDefinitions(context.Background(), client)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string][]string{
+		"orders":  {"order_id"},
+		"billing": {"account"},
+	}, client.ScopeClaims)
+	assert.Len(suite.T(), appScopeClaims, 1, "the stored mapping must not be modified")
+}
+
+func (suite *InboundClientServiceTestSuite) TestApplyScopeDefinitions_NoScopeService() {
+	svc := &inboundClientService{}
+	client := &providers.OAuthClient{Scopes: []string{"orders"}}
+
+	assert.NoError(suite.T(), svc.applyScopeDefinitions(context.Background(), client))
+	assert.Nil(suite.T(), client.ScopeClaims)
+}
+
+func (suite *InboundClientServiceTestSuite) TestApplyScopeDefinitions_ServiceError() {
+	scopeSvc := scopemock.NewScopeServiceInterfaceMock(suite.T())
+	scopeSvc.On("GetScopesByNames", mock.Anything, []string{"orders"}).
+		Return(nil, &tidcommon.InternalServerError).Once()
+	svc := &inboundClientService{scopeService: scopeSvc}
+
+	err := svc.applyScopeDefinitions(context.Background(), &providers.OAuthClient{Scopes: []string{"orders"}})
+
+	assert.Error(suite.T(), err)
+}
+
 // ----- GetOAuthClientByClientID -----
 
 func (suite *InboundClientServiceTestSuite) TestGetOAuthClientByClientID_NoEntityProvider() {
@@ -2203,7 +2351,7 @@ func (suite *InboundClientServiceTestSuite) TestCreateInboundClient_RejectsInval
 	us.EXPECT().GetAttributes(mock.Anything, entitytypepkg.TypeCategoryUser, "employee", false, true, false).
 		Return([]entitytypepkg.AttributeInfo{{Attribute: "email"}}, nil)
 
-	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, us, nil)
+	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, us, nil, nil)
 
 	c := validInboundClient()
 	c.AllowedUserTypes = []string{"employee"}
@@ -2227,7 +2375,7 @@ func (suite *InboundClientServiceTestSuite) TestUpdateInboundClient_RejectsInval
 	us.EXPECT().GetAttributes(mock.Anything, entitytypepkg.TypeCategoryUser, "employee", false, true, false).
 		Return([]entitytypepkg.AttributeInfo{{Attribute: "email"}}, nil)
 
-	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, us, nil)
+	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, us, nil, nil)
 
 	c := validInboundClient()
 	c.AllowedUserTypes = []string{"employee"}
@@ -2251,7 +2399,7 @@ func (suite *InboundClientServiceTestSuite) TestValidate_RejectsInvalidUserAttri
 	us.EXPECT().GetAttributes(mock.Anything, entitytypepkg.TypeCategoryUser, "employee", false, true, false).
 		Return([]entitytypepkg.AttributeInfo{{Attribute: "email"}}, nil)
 
-	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, us, nil)
+	svc := newInboundClientService(store, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, us, nil, nil)
 
 	c := validInboundClient()
 	c.AllowedUserTypes = []string{"employee"}
@@ -2268,7 +2416,7 @@ func (suite *InboundClientServiceTestSuite) TestValidate_RejectsInvalidUserAttri
 
 func newInboundClientServiceWithConsent(consentSvc consent.ConsentServiceInterface) *inboundClientService {
 	svc := newInboundClientService(
-		nil, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, consentSvc,
+		nil, transaction.NewNoOpTransactioner(), nil, nil, nil, nil, nil, nil, consentSvc, nil,
 	)
 	return svc.(*inboundClientService)
 }
@@ -2401,3 +2549,193 @@ func (suite *InboundClientServiceTestSuite) TestGetEntityIDsByThemeID_Success()
 	assert.Equal(suite.T(), 2, total)
 	assert.Equal(suite.T(), []string{"app-1", "app-2"}, ids)
 }
+
+// ----- Token validity periods -----
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenValidityPeriods_Bounds() {
+	sysconfig.ResetServerRuntime()
+	cfg := &sysconfig.Config{}
+	cfg.OAuth.TokenLifetime.MinValidityPeriod = 60
+	cfg.OAuth.TokenLifetime.MaxValidityPeriod = 86400
+	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", cfg))
+
+	within := &providers.OAuthTokenConfig{
+		AccessToken: &providers.AccessTokenConfig{UserConfig: &providers.AccessTokenSubConfig{ValidityPeriod: 900}},
+		GrantValidityPeriods: map[string]*providers.GrantValidityPeriods{
+			string(providers.GrantTypeCIBA): {AccessToken: 300},
+		},
+	}
+	assert.NoError(suite.T(), validateTokenValidityPeriods(within))
+	assert.NoError(suite.T(), validateTokenValidityPeriods(nil))
+
+	tooShort := &providers.OAuthTokenConfig{
+		GrantValidityPeriods: map[string]*providers.GrantValidityPeriods{
+			string(providers.GrantTypeCIBA): {IDToken: 30},
+		},
+	}
+	assert.ErrorIs(suite.T(), validateTokenValidityPeriods(tooShort), ErrOAuthTokenValidityOutOfBounds)
+
+	tooLong := &providers.OAuthTokenConfig{RefreshToken: &providers.RefreshTokenConfig{ValidityPeriod: 86401}}
+	assert.ErrorIs(suite.T(), validateTokenValidityPeriods(tooLong), ErrOAuthTokenValidityOutOfBounds)
+
+	negative := &providers.OAuthTokenConfig{IDToken: &providers.IDTokenConfig{ValidityPeriod: -1}}
+	assert.ErrorIs(suite.T(), validateTokenValidityPeriods(negative), ErrOAuthTokenValidityOutOfBounds)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenValidityPeriods_UnknownGrantType() {
+	token := &providers.OAuthTokenConfig{
+		GrantValidityPeriods: map[string]*providers.GrantValidityPeriods{"implicit": {AccessToken: 300}},
+	}
+	assert.ErrorIs(suite.T(), validateTokenValidityPeriods(token), ErrOAuthGrantValidityInvalidGrantType)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenValidityPeriods_RefreshMaxLifetime() {
+	sysconfig.ResetServerRuntime()
+	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", &sysconfig.Config{}))
+
+	valid := &providers.OAuthTokenConfig{RefreshToken: &providers.RefreshTokenConfig{
+		ValidityPeriod: 2592000, SlidingExpiry: true, MaxLifetime: 7776000,
+	}}
+	assert.NoError(suite.T(), validateTokenValidityPeriods(valid))
+
+	shorter := &providers.OAuthTokenConfig{RefreshToken: &providers.RefreshTokenConfig{
+		ValidityPeriod: 3600, MaxLifetime: 1800,
+	}}
+	assert.ErrorIs(suite.T(), validateTokenValidityPeriods(shorter), ErrOAuthRefreshTokenInvalidMaxLifetime)
+
+	negative := &providers.OAuthTokenConfig{RefreshToken: &providers.RefreshTokenConfig{MaxLifetime: -1}}
+	assert.ErrorIs(suite.T(), validateTokenValidityPeriods(negative), ErrOAuthRefreshTokenInvalidMaxLifetime)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenSigningAlgs() {
+	assert.NoError(suite.T(), validateTokenSigningAlgs(nil))
+
+	valid := &providers.OAuthTokenConfig{
+		AccessToken: &providers.AccessTokenConfig{SigningAlg: "ES384"},
+		IDToken:     &providers.IDTokenConfig{SigningAlg: "EdDSA"},
+	}
+	assert.NoError(suite.T(), validateTokenSigningAlgs(valid))
+
+	badAccess := &providers.OAuthTokenConfig{AccessToken: &providers.AccessTokenConfig{SigningAlg: "HS256"}}
+	assert.ErrorIs(suite.T(), validateTokenSigningAlgs(badAccess), ErrOAuthAccessTokenUnsupportedSigningAlg)
+
+	badID := &providers.OAuthTokenConfig{IDToken: &providers.IDTokenConfig{SigningAlg: "none"}}
+	assert.ErrorIs(suite.T(), validateTokenSigningAlgs(badID), ErrOAuthIDTokenUnsupportedSigningAlg)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateAccessTokenClaimsPolicy() {
+	withPolicy := func(policy *providers.AccessTokenClaimsPolicy) *providers.OAuthTokenConfig {
+		return &providers.OAuthTokenConfig{AccessToken: &providers.AccessTokenConfig{ClaimsPolicy: policy}}
+	}
+
+	assert.NoError(suite.T(), validateAccessTokenClaimsPolicy(nil))
+	assert.NoError(suite.T(), validateAccessTokenClaimsPolicy(withPolicy(&providers.AccessTokenClaimsPolicy{
+		ExcludedClaims: []string{"phone_number"},
+		HashedClaims:   []string{"email"},
+		ScopedClaims:   map[string]string{"address": "address"},
+	})))
+
+	invalid := []*providers.AccessTokenClaimsPolicy{
+		{ExcludedClaims: []string{" "}},
+		{HashedClaims: []string{""}},
+		{ExcludedClaims: []string{"email"}, HashedClaims: []string{"email"}},
+		{ScopedClaims: map[string]string{"address": ""}},
+		{ScopedClaims: map[string]string{"": "address"}},
+	}
+	for _, policy := range invalid {
+		assert.ErrorIs(suite.T(), validateAccessTokenClaimsPolicy(withPolicy(policy)),
+			ErrOAuthAccessTokenInvalidClaimsPolicy)
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestResolveOAuthTokens_KeepsSigningAlgs() {
+	sysconfig.ResetServerRuntime()
+	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", &sysconfig.Config{}))
+
+	accessToken, idToken, _ := resolveOAuthTokens(&providers.OAuthTokenConfig{
+		AccessToken: &providers.AccessTokenConfig{SigningAlg: "ES256"},
+		IDToken:     &providers.IDTokenConfig{SigningAlg: "EdDSA"},
+	}, nil)
+	assert.Equal(suite.T(), "ES256", accessToken.SigningAlg)
+	assert.Equal(suite.T(), "EdDSA", idToken.SigningAlg)
+}
+
+func (suite *InboundClientServiceTestSuite) TestApplyInboundDefaults_KeepsRefreshTokenPolicy() {
+	profile := validOAuthProfile()
+	profile.Token = &providers.OAuthTokenConfig{
+		RefreshToken: &providers.RefreshTokenConfig{SlidingExpiry: true, MaxLifetime: 7776000},
+	}
+	client := validInboundClient()
+
+	applyInboundDefaults(&client, profile)
+
+	assert.True(suite.T(), profile.Token.RefreshToken.SlidingExpiry)
+	assert.Equal(suite.T(), int64(7776000), profile.Token.RefreshToken.MaxLifetime)
+}
+
+func (suite *InboundClientServiceTestSuite) TestApplyInboundDefaults_KeepsGrantValidityPeriods() {
+	grantPeriods := map[string]*providers.GrantValidityPeriods{
+		string(providers.GrantTypeAuthorizationCode): {AccessToken: 600},
+	}
+	profile := validOAuthProfile()
+	profile.Token = &providers.OAuthTokenConfig{GrantValidityPeriods: grantPeriods}
+	client := validInboundClient()
+
+	applyInboundDefaults(&client, profile)
+
+	assert.Equal(suite.T(), grantPeriods, profile.Token.GrantValidityPeriods)
+	assert.NotNil(suite.T(), profile.Token.RefreshToken)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateOAuthProfile_AllowedOrigins() {
+	p := validOAuthProfile()
+	p.AllowedOrigins = []string{"https://spa.example.com", "http://localhost:3000"}
+	assert.NoError(suite.T(), validateOAuthProfile(p, true))
+
+	for _, origin := range []string{"https://spa.example.com/app", "https://*.example.com", "null", "myapp://cb"} {
+		p.AllowedOrigins = []string{origin}
+		assert.ErrorIs(suite.T(), validateOAuthProfile(p, true), ErrOAuthInvalidAllowedOrigin, origin)
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateOAuthProfile_AllowedNetworks() {
+	p := validOAuthProfile()
+	p.AllowedNetworks = []string{"10.0.0.0/8", "192.0.2.10", "2001:db8::/32"}
+	assert.NoError(suite.T(), validateOAuthProfile(p, true))
+
+	for _, network := range []string{"10.0.0.0/33", "example.com", ""} {
+		p.AllowedNetworks = []string{network}
+		assert.ErrorIs(suite.T(), validateOAuthProfile(p, true), ErrOAuthInvalidAllowedNetwork, network)
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestGetAllowedOrigins() {
+	explicit := validOAuthProfile()
+	explicit.AllowedOrigins = []string{"https://spa.example.com"}
+	derived := validOAuthProfile()
+	derived.RedirectURIs = []string{
+		"https://app.example.com/cb", "https://app.example.com/other", "com.example.app://cb",
+	}
+
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().GetInboundClientList(mock.Anything, mock.Anything).Return([]inboundmodel.InboundClient{
+		{ID: "explicit"}, {ID: "derived"}, {ID: "no-oauth"},
+	}, nil)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "explicit").Return(explicit, nil)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "derived").Return(derived, nil)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "no-oauth").Return(nil, ErrInboundClientNotFound)
+
+	origins, err := newServiceForTest(store).GetAllowedOrigins(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"https://spa.example.com", "https://app.example.com"}, origins)
+}
+
+func (suite *InboundClientServiceTestSuite) TestGetAllowedOrigins_StoreError() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().GetInboundClientList(mock.Anything, mock.Anything).
+		Return([]inboundmodel.InboundClient{{ID: "p1"}}, nil)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(nil, errors.New("db down"))
+
+	_, err := newServiceForTest(store).GetAllowedOrigins(context.Background())
+	assert.Error(suite.T(), err)
+}
@@ -37,10 +37,12 @@ import (
 // inboundClientJSONBlob is the internal structure for marshaling/unmarshaling the
 // PROPERTIES column.
 type inboundClientJSONBlob struct {
-	Assertion        *inboundmodel.AssertionConfig    `json:"assertion,omitempty"`
-	LoginConsent     *inboundmodel.LoginConsentConfig `json:"loginConsent,omitempty"`
-	AllowedUserTypes []string                         `json:"allowedUserTypes,omitempty"`
-	Properties       map[string]interface{}           `json:"properties,omitempty"`
+	Assertion        *inboundmodel.AssertionConfig      `json:"assertion,omitempty"`
+	LoginConsent     *inboundmodel.LoginConsentConfig   `json:"loginConsent,omitempty"`
+	AllowedUserTypes []string                           `json:"allowedUserTypes,omitempty"`
+	Sharing          *providers.ApplicationSharing      `json:"sharing,omitempty"`
+	AccessPolicy     *providers.ApplicationAccessPolicy `json:"accessPolicy,omitempty"`
+	Properties       map[string]interface{}             `json:"properties,omitempty"`
 }
 
 // inboundClientStoreInterface defines persistence operations for inbound clients.
@@ -60,6 +62,16 @@ type inboundClientStoreInterface interface {
 	DeleteInboundClient(ctx context.Context, entityID string) error
 	DeleteOAuthProfile(ctx context.Context, entityID string) error
 	InboundClientExists(ctx context.Context, entityID string) (bool, error)
+	// CreateOAuthProfileRevision records a revision of the entity's OAuth profile as the next version.
+	CreateOAuthProfileRevision(ctx context.Context, entityID string,
+		revision inboundmodel.OAuthProfileRevision) error
+	// GetOAuthProfileRevisionList returns a page of the entity's OAuth profile revisions, most recent
+	// first and without the saved profile, together with the total number of revisions.
+	GetOAuthProfileRevisionList(ctx context.Context, entityID string, limit, offset int) (
+		[]inboundmodel.OAuthProfileRevision, int, error)
+	// GetOAuthProfileRevision returns a revision of the entity's OAuth profile by version.
+	GetOAuthProfileRevision(ctx context.Context, entityID string, version int) (
+		*inboundmodel.OAuthProfileRevision, error)
 	// IsDeclarative reports whether the inbound client with the given entity ID is sourced
 	// from a declarative (YAML) resource and therefore immutable. DB-backed stores always
 	// return false; file-based stores return true when the inbound client exists in their
@@ -113,6 +125,8 @@ func marshalInboundClient(c inboundmodel.InboundClient) (
 		Assertion:        c.Assertion,
 		LoginConsent:     c.LoginConsent,
 		AllowedUserTypes: c.AllowedUserTypes,
+		Sharing:          c.Sharing,
+		AccessPolicy:     c.AccessPolicy,
 		Properties:       c.Properties,
 	}
 	propertiesBytes, err = marshalNullableJSON(blob)
@@ -388,6 +402,98 @@ func (st *store) UpdateOAuthProfile(ctx context.Context, entityID string,
 	return nil
 }
 
+// CreateOAuthProfileRevision records a revision of the entity's OAuth profile. The version is assigned
+// as the one following the latest recorded version.
+func (st *store) CreateOAuthProfileRevision(ctx context.Context, entityID string,
+	revision inboundmodel.OAuthProfileRevision) error {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetLatestOAuthProfileRevisionVersion,
+		entityID, st.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to get latest OAuth profile revision: %w", err)
+	}
+	latestVersion := 0
+	if len(results) > 0 {
+		if v, ok := results[0]["latest_version"].(int64); ok {
+			latestVersion = int(v)
+		}
+	}
+
+	profileJSON, err := marshalOAuthProfile(revision.OAuthProfile)
+	if err != nil {
+		return err
+	}
+	changesJSON, err := json.Marshal(revision.Changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal OAuth profile changes: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateOAuthProfileRevision, entityID, latestVersion+1,
+		profileJSON, changesJSON, revision.ChangedBy, revision.CreatedAt, st.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to insert OAuth profile revision: %w", err)
+	}
+	return nil
+}
+
+// GetOAuthProfileRevisionList retrieves a page of the entity's OAuth profile revisions, most recent first.
+func (st *store) GetOAuthProfileRevisionList(ctx context.Context, entityID string, limit, offset int) (
+	[]inboundmodel.OAuthProfileRevision, int, error) {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	countResults, err := dbClient.QueryContext(ctx, queryGetOAuthProfileRevisionCount, entityID, st.deploymentID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+	total := 0
+	if len(countResults) > 0 {
+		if v, ok := countResults[0]["total"].(int64); ok {
+			total = int(v)
+		}
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetOAuthProfileRevisionList,
+		entityID, st.deploymentID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	revisions := make([]inboundmodel.OAuthProfileRevision, 0, len(results))
+	for _, row := range results {
+		revision, err := buildOAuthProfileRevisionFromRow(row)
+		if err != nil {
+			return nil, 0, err
+		}
+		revisions = append(revisions, *revision)
+	}
+	return revisions, total, nil
+}
+
+// GetOAuthProfileRevision retrieves a revision of the entity's OAuth profile by version.
+func (st *store) GetOAuthProfileRevision(ctx context.Context, entityID string, version int) (
+	*inboundmodel.OAuthProfileRevision, error) {
+	dbClient, err := st.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetOAuthProfileRevision, entityID, version, st.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrOAuthProfileRevisionNotFound
+	}
+	return buildOAuthProfileRevisionFromRow(results[0])
+}
+
 // marshalOAuthProfile serializes an OAuthProfile to the OAUTH_CONFIG JSON format.
 // Returns nil bytes for nil input.
 func marshalOAuthProfile(p *providers.OAuthProfile) (json.RawMessage, error) {
@@ -493,6 +599,8 @@ func buildInboundClientFromRow(ctx context.Context, row map[string]interface{})
 			client.Assertion = blob.Assertion
 			client.LoginConsent = blob.LoginConsent
 			client.AllowedUserTypes = blob.AllowedUserTypes
+			client.Sharing = blob.Sharing
+			client.AccessPolicy = blob.AccessPolicy
 			client.Properties = blob.Properties
 		}
 	}
@@ -514,6 +622,39 @@ func buildOAuthProfileFromRow(row map[string]interface{}) (*providers.OAuthProfi
 	return &p, nil
 }
 
+// buildOAuthProfileRevisionFromRow builds an OAuthProfileRevision from a result row. The saved profile is
+// only populated when the row carries the oauth_config column.
+func buildOAuthProfileRevisionFromRow(row map[string]interface{}) (*inboundmodel.OAuthProfileRevision, error) {
+	version, ok := row["version"].(int64)
+	if !ok {
+		return nil, fmt.Errorf("version field missing or invalid type")
+	}
+	createdAt, err := utils.ParseDBTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []inboundmodel.ConfigChange
+	if changesStr := parseJSONColumnString(row, "changes"); changesStr != "" {
+		if err := json.Unmarshal([]byte(changesStr), &changes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal OAuth profile changes JSON: %w", err)
+		}
+	}
+
+	profile, err := buildOAuthProfileFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inboundmodel.OAuthProfileRevision{
+		Version:      int(version),
+		OAuthProfile: profile,
+		Changes:      changes,
+		ChangedBy:    parseStringColumn(row, "changed_by"),
+		CreatedAt:    createdAt,
+	}, nil
+}
+
 // marshalNullableJSON marshals a value to JSON, returning nil for nil/empty input.
 func marshalNullableJSON(v interface{}) (interface{}, error) {
 	if v == nil {
@@ -131,4 +131,41 @@ var (
 		Query: `SELECT COUNT(*) as total FROM "INBOUND_CLIENT" WHERE ` +
 			`(AUTH_FLOW_ID = $1 OR REGISTRATION_FLOW_ID = $2 OR RECOVERY_FLOW_ID = $3) AND DEPLOYMENT_ID = $4`,
 	}
+
+	// queryGetLatestOAuthProfileRevisionVersion retrieves the latest revision version of an entity's
+	// OAuth profile, or 0 when no revision is recorded.
+	queryGetLatestOAuthProfileRevisionVersion = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-19",
+		Query: `SELECT COALESCE(MAX(VERSION), 0) as latest_version FROM "OAUTH_INBOUND_PROFILE_HISTORY" ` +
+			`WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCreateOAuthProfileRevision records a revision of an entity's OAuth profile.
+	queryCreateOAuthProfileRevision = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-20",
+		Query: `INSERT INTO "OAUTH_INBOUND_PROFILE_HISTORY" (ENTITY_ID, VERSION, OAUTH_CONFIG, CHANGES, ` +
+			`CHANGED_BY, CREATED_AT, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+
+	// queryGetOAuthProfileRevisionCount retrieves the number of recorded revisions of an entity's OAuth profile.
+	queryGetOAuthProfileRevisionCount = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-21",
+		Query: `SELECT COUNT(*) as total FROM "OAUTH_INBOUND_PROFILE_HISTORY" ` +
+			`WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetOAuthProfileRevisionList retrieves a page of the revisions of an entity's OAuth profile,
+	// most recent first. The saved profile is not selected.
+	queryGetOAuthProfileRevisionList = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-22",
+		Query: `SELECT VERSION, CHANGES, CHANGED_BY, CREATED_AT FROM "OAUTH_INBOUND_PROFILE_HISTORY" ` +
+			`WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2 ORDER BY VERSION DESC LIMIT $3 OFFSET $4`,
+	}
+
+	// queryGetOAuthProfileRevision retrieves a revision of an entity's OAuth profile by version.
+	queryGetOAuthProfileRevision = dbmodel.DBQuery{
+		ID: "ASQ-INBC_MGT-23",
+		Query: `SELECT VERSION, OAUTH_CONFIG, CHANGES, CHANGED_BY, CREATED_AT ` +
+			`FROM "OAUTH_INBOUND_PROFILE_HISTORY" WHERE ENTITY_ID = $1 AND VERSION = $2 AND DEPLOYMENT_ID = $3`,
+	}
 )
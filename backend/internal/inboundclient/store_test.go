@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -840,3 +841,124 @@ func (suite *InboundClientStoreTestSuite) TestGetEntityIDsByReference_UnknownTyp
 	suite.Empty(ids)
 	suite.mockDBProvider.AssertNotCalled(suite.T(), "GetConfigDBClient")
 }
+
+func (suite *InboundClientStoreTestSuite) TestCreateOAuthProfileRevision() {
+	revision := inboundmodel.OAuthProfileRevision{
+		OAuthProfile: &providers.OAuthProfile{RedirectURIs: []string{"https://app.example.com/cb"}},
+		Changes:      []inboundmodel.ConfigChange{{Field: "redirectUris", NewValue: "https://app.example.com/cb"}},
+		ChangedBy:    "admin-user",
+		CreatedAt:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	suite.Run("assigns the next version", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetLatestOAuthProfileRevisionVersion,
+			testEntityID, testServerID).
+			Return([]map[string]interface{}{{"latest_version": int64(3)}}, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateOAuthProfileRevision,
+			testEntityID, 4, mock.Anything, mock.Anything, "admin-user", revision.CreatedAt, testServerID).
+			Return(int64(1), nil).Once()
+
+		err := suite.store.CreateOAuthProfileRevision(context.Background(), testEntityID, revision)
+		suite.NoError(err)
+	})
+
+	suite.Run("starts at version one", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetLatestOAuthProfileRevisionVersion,
+			testEntityID, testServerID).
+			Return([]map[string]interface{}{{"latest_version": nil}}, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateOAuthProfileRevision,
+			testEntityID, 1, mock.Anything, mock.Anything, "admin-user", revision.CreatedAt, testServerID).
+			Return(int64(1), nil).Once()
+
+		err := suite.store.CreateOAuthProfileRevision(context.Background(), testEntityID, revision)
+		suite.NoError(err)
+	})
+
+	suite.Run("returns error when insert fails", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetLatestOAuthProfileRevisionVersion,
+			testEntityID, testServerID).
+			Return([]map[string]interface{}{}, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateOAuthProfileRevision,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything).
+			Return(int64(0), errors.New("insert failed")).Once()
+
+		err := suite.store.CreateOAuthProfileRevision(context.Background(), testEntityID, revision)
+		suite.Error(err)
+	})
+}
+
+func (suite *InboundClientStoreTestSuite) TestGetOAuthProfileRevisionList() {
+	suite.Run("returns revisions without saved profiles", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetOAuthProfileRevisionCount,
+			testEntityID, testServerID).
+			Return([]map[string]interface{}{{"total": int64(2)}}, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetOAuthProfileRevisionList,
+			testEntityID, testServerID, 1, 0).
+			Return([]map[string]interface{}{
+				{
+					"version":    int64(2),
+					"changes":    `[{"field":"redirectUris","newValue":["https://app.example.com/cb"]}]`,
+					"changed_by": "admin-user",
+					"created_at": "2026-01-02 03:04:05",
+				},
+			}, nil).Once()
+
+		revisions, total, err := suite.store.GetOAuthProfileRevisionList(context.Background(), testEntityID, 1, 0)
+		suite.NoError(err)
+		suite.Equal(2, total)
+		suite.Len(revisions, 1)
+		suite.Equal(2, revisions[0].Version)
+		suite.Equal("admin-user", revisions[0].ChangedBy)
+		suite.Equal("redirectUris", revisions[0].Changes[0].Field)
+		suite.Nil(revisions[0].OAuthProfile)
+	})
+
+	suite.Run("returns error when count query fails", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetOAuthProfileRevisionCount,
+			testEntityID, testServerID).
+			Return(nil, errors.New("query failed")).Once()
+
+		_, _, err := suite.store.GetOAuthProfileRevisionList(context.Background(), testEntityID, 1, 0)
+		suite.Error(err)
+	})
+}
+
+func (suite *InboundClientStoreTestSuite) TestGetOAuthProfileRevision() {
+	suite.Run("returns revision with saved profile", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetOAuthProfileRevision,
+			testEntityID, 2, testServerID).
+			Return([]map[string]interface{}{
+				{
+					"version":      int64(2),
+					"oauth_config": `{"redirectUris":["https://app.example.com/cb"]}`,
+					"changes":      `[]`,
+					"changed_by":   nil,
+					"created_at":   "2026-01-02 03:04:05",
+				},
+			}, nil).Once()
+
+		revision, err := suite.store.GetOAuthProfileRevision(context.Background(), testEntityID, 2)
+		suite.NoError(err)
+		suite.Equal(2, revision.Version)
+		suite.Empty(revision.ChangedBy)
+		suite.Equal([]string{"https://app.example.com/cb"}, revision.OAuthProfile.RedirectURIs)
+	})
+
+	suite.Run("returns not found for unknown version", func() {
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryGetOAuthProfileRevision,
+			testEntityID, 9, testServerID).
+			Return([]map[string]interface{}{}, nil).Once()
+
+		revision, err := suite.store.GetOAuthProfileRevision(context.Background(), testEntityID, 9)
+		suite.Nil(revision)
+		suite.ErrorIs(err, ErrOAuthProfileRevisionNotFound)
+	})
+}
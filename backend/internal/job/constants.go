@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package job runs long-running operations in the background. Submitted jobs wait in a bounded queue
+// for a fixed pool of workers, and the status, progress and per-item errors of every job are kept in
+// the runtime store so any node can report them through the job status API.
+package job
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used for logging in the job package.
+	loggerComponentName = "JobService"
+
+	// defaultWorkers is the number of workers used when jobs.workers is not configured.
+	defaultWorkers = 2
+	// defaultQueueSize is the number of waiting jobs allowed when jobs.queue_size is not configured.
+	defaultQueueSize = 100
+	// defaultRetention is how long a job status is kept when jobs.retention_seconds is not configured.
+	defaultRetention = 24 * time.Hour
+
+	// maxItemErrors bounds the per-item errors kept for a job, so a large failing batch cannot grow the
+	// stored status without limit. The failed count still covers every failed item.
+	maxItemErrors = 1000
+	// progressSaveInterval is the minimum time between two progress updates written to the runtime store
+	// while a job runs.
+	progressSaveInterval = time.Second
+)
+
+// Status is the lifecycle state of a job.
+type Status string
+
+const (
+	// StatusPending denotes a job waiting in the queue for a worker.
+	StatusPending Status = "PENDING"
+	// StatusRunning denotes a job being processed by a worker.
+	StatusRunning Status = "RUNNING"
+	// StatusCompleted denotes a job whose items were all processed. Individual items may have failed.
+	StatusCompleted Status = "COMPLETED"
+	// StatusFailed denotes a job that stopped before processing all of its items.
+	StatusFailed Status = "FAILED"
+)
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for job operations.
+var (
+	// ErrorJobNotFound is the error returned when the job does not exist, has expired or was submitted by
+	// another caller.
+	ErrorJobNotFound = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "JOB-1001",
+		Error: common.I18nMessage{
+			Key:          "error.jobservice.job_not_found",
+			DefaultValue: "Job not found",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.jobservice.job_not_found_description",
+			DefaultValue: "The job does not exist or its status is no longer available",
+		},
+	}
+	// ErrorJobQueueFull is the error returned when the job queue has no room for another job.
+	ErrorJobQueueFull = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "JOB-1002",
+		Error: common.I18nMessage{
+			Key:          "error.jobservice.job_queue_full",
+			DefaultValue: "Too many pending jobs",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.jobservice.job_queue_full_description",
+			DefaultValue: "The job queue is full; retry once the pending jobs have completed",
+		},
+	}
+)
+
+// Server errors for job operations.
+var (
+	// ErrorJobInterrupted is the error recorded on a job that was stopped by a server shutdown.
+	ErrorJobInterrupted = common.ServiceError{
+		Type: common.ServerErrorType,
+		Code: "JOB-5001",
+		Error: common.I18nMessage{
+			Key:          "error.jobservice.job_interrupted",
+			DefaultValue: "Job interrupted",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.jobservice.job_interrupted_description",
+			DefaultValue: "The server stopped before the job completed; items already processed are kept",
+		},
+	}
+)
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// jobHandler is the handler for job status operations.
+type jobHandler struct {
+	jobService JobServiceInterface
+}
+
+// newJobHandler creates a new instance of jobHandler.
+func newJobHandler(jobService JobServiceInterface) *jobHandler {
+	return &jobHandler{jobService: jobService}
+}
+
+// HandleJobGetRequest handles the request to read the status of a job.
+func (h *jobHandler) HandleJobGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	job, svcErr := h.jobService.GetJob(ctx, r.PathValue("id"))
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, job)
+}
+
+// handleError maps a service error to an HTTP error response.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *common.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == common.ClientErrorType {
+		statusCode = http.StatusBadRequest
+		if svcErr.Code == ErrorJobNotFound.Code {
+			statusCode = http.StatusNotFound
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteProblemResponse(ctx, w, statusCode, errResp)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+func TestHandleJobGetRequest(t *testing.T) {
+	mockService := NewJobServiceInterfaceMock(t)
+	mockService.On("GetJob", mock.Anything, "job-1").Return(&Job{ID: "job-1", Status: StatusRunning}, nil)
+	mockService.On("GetJob", mock.Anything, "missing").Return(nil, &ErrorJobNotFound)
+	mockService.On("GetJob", mock.Anything, "broken").Return(nil, &common.InternalServerError)
+
+	mux := http.NewServeMux()
+	registerRoutes(mux, newJobHandler(mockService))
+
+	for _, tc := range []struct {
+		id     string
+		status int
+		body   string
+	}{
+		{"job-1", http.StatusOK, `"status":"RUNNING"`},
+		{"missing", http.StatusNotFound, ErrorJobNotFound.Code},
+		{"broken", http.StatusInternalServerError, common.InternalServerError.Code},
+	} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jobs/"+tc.id, nil))
+		assert.Equal(t, tc.status, rec.Code, tc.id)
+		assert.Contains(t, rec.Body.String(), tc.body, tc.id)
+	}
+}
+
+func TestInitializeAppliesDefaults(t *testing.T) {
+	service, pool := Initialize(http.NewServeMux(), inmemory.Initialize("test-deployment"), config.JobsConfig{})
+
+	svc := service.(*jobService)
+	assert.Same(t, svc, pool)
+	assert.Equal(t, defaultWorkers, svc.workers)
+	assert.Equal(t, defaultQueueSize, cap(svc.queue))
+	assert.Equal(t, defaultRetention, svc.store.(*jobStore).retention)
+}
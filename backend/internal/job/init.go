@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize wires the job store, service and status route. The returned worker pool must be started
+// for queued jobs to run, and stopped during shutdown.
+func Initialize(mux *http.ServeMux, storeProvider providers.RuntimeStoreProvider,
+	cfg config.JobsConfig) (JobServiceInterface, WorkerPool) {
+	workers, queueSize, retention := cfg.Workers, cfg.QueueSize, cfg.Retention()
+	if workers == 0 {
+		workers = defaultWorkers
+	}
+	if queueSize == 0 {
+		queueSize = defaultQueueSize
+	}
+	if retention == 0 {
+		retention = defaultRetention
+	}
+
+	service := newJobService(newJobStore(storeProvider, retention), workers, queueSize)
+	registerRoutes(mux, newJobHandler(service))
+	return service, service
+}
+
+// registerRoutes registers the routes for job status operations.
+func registerRoutes(mux *http.ServeMux, handler *jobHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /jobs/{id}", handler.HandleJobGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /jobs/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts))
+}
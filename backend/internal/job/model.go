@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Job is the status of a background job as reported by the job status API.
+type Job struct {
+	ID          string       `json:"id"`
+	Type        string       `json:"type"`
+	Status      Status       `json:"status"`
+	Total       int          `json:"total"`
+	Processed   int          `json:"processed"`
+	Succeeded   int          `json:"succeeded"`
+	Failed      int          `json:"failed"`
+	Errors      []ItemError  `json:"errors"`
+	Error       *ErrorDetail `json:"error,omitempty"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+	CompletedAt *time.Time   `json:"completedAt,omitempty"`
+}
+
+// ErrorDetail describes why an item or a whole job failed.
+type ErrorDetail struct {
+	Code        string             `json:"code"`
+	Message     common.I18nMessage `json:"message"`
+	Description common.I18nMessage `json:"description"`
+}
+
+// ItemError is the error of a single item of a job. Item identifies the item in the terms of the
+// operation, such as its position in the request or the ID of the resource.
+type ItemError struct {
+	Item string `json:"item"`
+	ErrorDetail
+}
+
+// Task is the work of a job. It reports the outcome of every item through the tracker and returns a
+// service error only when the job as a whole cannot continue. The context carries the security context
+// of the submitting request and is cancelled when the server shuts down.
+type Task func(ctx context.Context, tracker Tracker) *common.ServiceError
+
+// Tracker records the progress of a running job.
+type Tracker interface {
+	// SetTotal sets the number of items of the job, for tasks that discover their items while running.
+	SetTotal(total int)
+	// Succeeded records an item that was processed successfully.
+	Succeeded()
+	// Failed records an item that could not be processed.
+	Failed(item string, svcErr *common.ServiceError)
+}
+
+// jobRecord is the stored form of a job. The owner is the subject that submitted the job and is the
+// only caller allowed to read it.
+type jobRecord struct {
+	Job
+	Owner string `json:"owner"`
+}
+
+// queuedJob is a submitted job waiting for a worker.
+type queuedJob struct {
+	ctx    context.Context
+	record *jobRecord
+	task   Task
+}
+
+// newErrorDetail converts a service error to the error detail reported for a job.
+func newErrorDetail(svcErr *common.ServiceError) ErrorDetail {
+	return ErrorDetail{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+}
@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// JobServiceInterface defines the interface for submitting background jobs and reading their status.
+type JobServiceInterface interface {
+	// Submit queues the task as a new job of the given type with the given number of items and returns
+	// the pending job. It returns ErrorJobQueueFull when no more jobs can be queued.
+	Submit(ctx context.Context, jobType string, total int, task Task) (*Job, *common.ServiceError)
+
+	// GetJob returns the status of a job submitted by the caller.
+	GetJob(ctx context.Context, id string) (*Job, *common.ServiceError)
+}
+
+// WorkerPool runs the queued jobs in the background.
+type WorkerPool interface {
+	// Start launches the workers. It returns immediately; jobs run in the background.
+	Start(ctx context.Context)
+	// Stop cancels the running jobs, waits for the workers to exit and marks the jobs that did not
+	// complete as failed.
+	Stop()
+}
+
+// jobService queues jobs on a bounded channel consumed by a fixed number of workers.
+type jobService struct {
+	store       jobStoreInterface
+	queue       chan *queuedJob
+	workers     int
+	generateID  func() (string, error)
+	now         func() time.Time
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	stopOnce    sync.Once
+	startedOnce sync.Once
+}
+
+// newJobService creates a new instance of jobService.
+func newJobService(store jobStoreInterface, workers, queueSize int) *jobService {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &jobService{
+		store:      store,
+		queue:      make(chan *queuedJob, queueSize),
+		workers:    workers,
+		generateID: utils.GenerateUUIDv7,
+		now:        time.Now,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// Submit queues the task as a new job of the given type with the given number of items and returns
+// the pending job. It returns ErrorJobQueueFull when no more jobs can be queued.
+func (s *jobService) Submit(
+	ctx context.Context, jobType string, total int, task Task) (*Job, *common.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	id, err := s.generateID()
+	if err != nil {
+		logger.Error(ctx, "Failed to generate job ID", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	now := s.now().UTC()
+	record := &jobRecord{
+		Job: Job{
+			ID:        id,
+			Type:      jobType,
+			Status:    StatusPending,
+			Total:     total,
+			Errors:    []ItemError{},
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Owner: security.GetSubject(ctx),
+	}
+	if err := s.store.SaveJob(ctx, record); err != nil {
+		logger.Error(ctx, "Failed to save job", log.String("jobID", id), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	// The pending status is saved before queueing so a worker's first update cannot be overwritten.
+	job := record.Job
+	select {
+	case s.queue <- &queuedJob{ctx: context.WithoutCancel(ctx), record: record, task: task}:
+	default:
+		if err := s.store.DeleteJob(ctx, id); err != nil {
+			logger.Error(ctx, "Failed to delete rejected job", log.String("jobID", id), log.Error(err))
+		}
+		return nil, &ErrorJobQueueFull
+	}
+
+	logger.Debug(ctx, "Job submitted", log.String("jobID", id), log.String("type", jobType))
+	return &job, nil
+}
+
+// GetJob returns the status of a job submitted by the caller.
+func (s *jobService) GetJob(ctx context.Context, id string) (*Job, *common.ServiceError) {
+	record, err := s.store.GetJob(ctx, id)
+	if err != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error(ctx, "Failed to get job", log.String("jobID", id), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	// Jobs of other callers are reported as not found so their IDs cannot be probed.
+	if record == nil || record.Owner != security.GetSubject(ctx) {
+		return nil, &ErrorJobNotFound
+	}
+	return &record.Job, nil
+}
+
+// Start launches the workers. It returns immediately; jobs run in the background.
+func (s *jobService) Start(_ context.Context) {
+	s.startedOnce.Do(func() {
+		for range s.workers {
+			s.wg.Add(1)
+			go s.work()
+		}
+	})
+}
+
+// Stop cancels the running jobs, waits for the workers to exit and marks the jobs that did not
+// complete as failed.
+func (s *jobService) Stop() {
+	s.stopOnce.Do(func() {
+		s.cancel()
+		s.wg.Wait()
+
+		for {
+			select {
+			case queued := <-s.queue:
+				s.finish(queued.ctx, queued.record, &ErrorJobInterrupted)
+			default:
+				return
+			}
+		}
+	})
+}
+
+// work runs queued jobs until the service is stopped.
+func (s *jobService) work() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case queued := <-s.queue:
+			// Both cases can be ready at once; a job taken after Stop must not start.
+			if s.ctx.Err() != nil {
+				s.finish(queued.ctx, queued.record, &ErrorJobInterrupted)
+				return
+			}
+			s.run(queued)
+		}
+	}
+}
+
+// run processes a single job and records its final status.
+func (s *jobService) run(queued *queuedJob) {
+	// The task keeps the values of the submitting request, such as its security context, but is only
+	// cancelled when the service stops.
+	ctx, cancel := context.WithCancel(queued.ctx)
+	defer cancel()
+	stop := context.AfterFunc(s.ctx, cancel)
+	defer stop()
+
+	record := queued.record
+	record.Status = StatusRunning
+	t := &tracker{service: s, ctx: queued.ctx, record: record}
+	t.save(true)
+
+	svcErr := s.execute(ctx, queued.task, t)
+	if svcErr == nil && ctx.Err() != nil {
+		svcErr = &ErrorJobInterrupted
+	}
+	s.finish(queued.ctx, record, svcErr)
+}
+
+// execute runs the task, converting a panic into a failed job so a faulty task cannot stop the worker.
+func (s *jobService) execute(ctx context.Context, task Task, t *tracker) (svcErr *common.ServiceError) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+			logger.Error(ctx, "Job panicked", log.String("jobID", t.record.ID), log.Any("panic", r))
+			svcErr = &common.InternalServerError
+		}
+	}()
+	return task(ctx, t)
+}
+
+// finish records the final status of a job.
+func (s *jobService) finish(ctx context.Context, record *jobRecord, svcErr *common.ServiceError) {
+	now := s.now().UTC()
+	record.Status = StatusCompleted
+	if svcErr != nil {
+		detail := newErrorDetail(svcErr)
+		record.Status = StatusFailed
+		record.Error = &detail
+	}
+	record.CompletedAt = &now
+	record.UpdatedAt = now
+	s.save(ctx, record)
+}
+
+// save writes the job to the store, logging failures since a worker has no caller to report them to.
+func (s *jobService) save(ctx context.Context, record *jobRecord) {
+	if err := s.store.SaveJob(ctx, record); err != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error(ctx, "Failed to save job status", log.String("jobID", record.ID), log.Error(err))
+	}
+}
+
+// tracker records the progress of a running job and writes it to the store at most once every
+// progressSaveInterval.
+type tracker struct {
+	service   *jobService
+	ctx       context.Context
+	record    *jobRecord
+	lastSaved time.Time
+}
+
+// SetTotal sets the number of items of the job.
+func (t *tracker) SetTotal(total int) {
+	t.record.Total = total
+	t.save(false)
+}
+
+// Succeeded records an item that was processed successfully.
+func (t *tracker) Succeeded() {
+	t.record.Processed++
+	t.record.Succeeded++
+	t.save(false)
+}
+
+// Failed records an item that could not be processed.
+func (t *tracker) Failed(item string, svcErr *common.ServiceError) {
+	t.record.Processed++
+	t.record.Failed++
+	if len(t.record.Errors) < maxItemErrors {
+		t.record.Errors = append(t.record.Errors, ItemError{Item: item, ErrorDetail: newErrorDetail(svcErr)})
+	}
+	t.save(false)
+}
+
+// save writes the progress to the store when forced or when the save interval has passed.
+func (t *tracker) save(force bool) {
+	now := t.service.now()
+	if !force && now.Sub(t.lastSaved) < progressSaveInterval {
+		return
+	}
+	t.lastSaved = now
+	t.record.UpdatedAt = now.UTC()
+	t.service.save(t.ctx, t.record)
+}
@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type JobServiceTestSuite struct {
+	suite.Suite
+	runtimeStore providers.RuntimeStoreProvider
+	service      *jobService
+}
+
+func TestJobServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(JobServiceTestSuite))
+}
+
+func (suite *JobServiceTestSuite) SetupTest() {
+	suite.runtimeStore = inmemory.Initialize("test-deployment")
+	suite.service = newJobService(newJobStore(suite.runtimeStore, time.Hour), 1, 2)
+}
+
+func (suite *JobServiceTestSuite) TearDownTest() {
+	suite.service.Stop()
+}
+
+// waitForJob polls the job until it leaves the pending and running states.
+func (suite *JobServiceTestSuite) waitForJob(id string) *Job {
+	var job *Job
+	suite.Require().Eventually(func() bool {
+		var svcErr *common.ServiceError
+		job, svcErr = suite.service.GetJob(context.Background(), id)
+		suite.Require().Nil(svcErr)
+		return job.Status == StatusCompleted || job.Status == StatusFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	return job
+}
+
+func (suite *JobServiceTestSuite) TestSubmit_RunsTaskAndRecordsItems() {
+	suite.service.Start(context.Background())
+
+	submitted, svcErr := suite.service.Submit(context.Background(), "TEST", 3,
+		func(_ context.Context, tracker Tracker) *common.ServiceError {
+			tracker.Succeeded()
+			tracker.Failed("1", &ErrorJobNotFound)
+			tracker.Succeeded()
+			return nil
+		})
+	suite.Require().Nil(svcErr)
+	suite.Equal(StatusPending, submitted.Status)
+	suite.Equal("TEST", submitted.Type)
+	suite.Equal(3, submitted.Total)
+	suite.NotEmpty(submitted.ID)
+
+	job := suite.waitForJob(submitted.ID)
+	suite.Equal(StatusCompleted, job.Status)
+	suite.Equal(3, job.Processed)
+	suite.Equal(2, job.Succeeded)
+	suite.Equal(1, job.Failed)
+	suite.Require().Len(job.Errors, 1)
+	suite.Equal("1", job.Errors[0].Item)
+	suite.Equal(ErrorJobNotFound.Code, job.Errors[0].Code)
+	suite.Equal(ErrorJobNotFound.Error, job.Errors[0].Message)
+	suite.Nil(job.Error)
+	suite.NotNil(job.CompletedAt)
+}
+
+func (suite *JobServiceTestSuite) TestSubmit_SetTotalAndItemErrorLimit() {
+	suite.service.Start(context.Background())
+
+	submitted, svcErr := suite.service.Submit(context.Background(), "TEST", 0,
+		func(_ context.Context, tracker Tracker) *common.ServiceError {
+			tracker.SetTotal(maxItemErrors + 5)
+			for range maxItemErrors + 5 {
+				tracker.Failed("item", &ErrorJobNotFound)
+			}
+			return nil
+		})
+	suite.Require().Nil(svcErr)
+
+	job := suite.waitForJob(submitted.ID)
+	suite.Equal(maxItemErrors+5, job.Total)
+	suite.Equal(maxItemErrors+5, job.Failed)
+	suite.Len(job.Errors, maxItemErrors)
+}
+
+func (suite *JobServiceTestSuite) TestSubmit_TaskErrorFailsJob() {
+	suite.service.Start(context.Background())
+
+	submitted, _ := suite.service.Submit(context.Background(), "TEST", 1,
+		func(context.Context, Tracker) *common.ServiceError {
+			return &common.InternalServerError
+		})
+
+	job := suite.waitForJob(submitted.ID)
+	suite.Equal(StatusFailed, job.Status)
+	suite.Require().NotNil(job.Error)
+	suite.Equal(common.InternalServerError.Code, job.Error.Code)
+}
+
+func (suite *JobServiceTestSuite) TestSubmit_TaskPanicFailsJobAndKeepsWorker() {
+	suite.service.Start(context.Background())
+
+	panicked, _ := suite.service.Submit(context.Background(), "TEST", 1,
+		func(context.Context, Tracker) *common.ServiceError {
+			panic("boom")
+		})
+	suite.Equal(StatusFailed, suite.waitForJob(panicked.ID).Status)
+
+	next, _ := suite.service.Submit(context.Background(), "TEST", 0,
+		func(context.Context, Tracker) *common.ServiceError { return nil })
+	suite.Equal(StatusCompleted, suite.waitForJob(next.ID).Status)
+}
+
+func (suite *JobServiceTestSuite) TestSubmit_QueueFull() {
+	noop := func(context.Context, Tracker) *common.ServiceError { return nil }
+	for range 2 {
+		_, svcErr := suite.service.Submit(context.Background(), "TEST", 0, noop)
+		suite.Require().Nil(svcErr)
+	}
+
+	job, svcErr := suite.service.Submit(context.Background(), "TEST", 0, noop)
+	suite.Nil(job)
+	suite.Equal(&ErrorJobQueueFull, svcErr)
+}
+
+func (suite *JobServiceTestSuite) TestStop_InterruptsRunningAndQueuedJobs() {
+	started := make(chan struct{})
+	running, _ := suite.service.Submit(context.Background(), "TEST", 1,
+		func(ctx context.Context, _ Tracker) *common.ServiceError {
+			close(started)
+			<-ctx.Done()
+			return nil
+		})
+	queued, _ := suite.service.Submit(context.Background(), "TEST", 1,
+		func(context.Context, Tracker) *common.ServiceError { return nil })
+
+	suite.service.Start(context.Background())
+	<-started
+	suite.service.Stop()
+
+	for _, id := range []string{running.ID, queued.ID} {
+		job, svcErr := suite.service.GetJob(context.Background(), id)
+		suite.Require().Nil(svcErr)
+		suite.Equal(StatusFailed, job.Status)
+		suite.Equal(ErrorJobInterrupted.Code, job.Error.Code)
+	}
+}
+
+func (suite *JobServiceTestSuite) TestTask_KeepsRequestValuesAfterRequestEnds() {
+	type ctxKey struct{}
+	suite.service.Start(context.Background())
+
+	requestCtx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "value"))
+	seen := make(chan any, 1)
+	submitted, _ := suite.service.Submit(requestCtx, "TEST", 0,
+		func(ctx context.Context, _ Tracker) *common.ServiceError {
+			seen <- ctx.Value(ctxKey{})
+			if ctx.Err() != nil {
+				return &common.InternalServerError
+			}
+			return nil
+		})
+	cancel()
+
+	suite.Equal(StatusCompleted, suite.waitForJob(submitted.ID).Status)
+	suite.Equal("value", <-seen)
+}
+
+func (suite *JobServiceTestSuite) TestGetJob_NotFoundForUnknownOrForeignJob() {
+	_, svcErr := suite.service.GetJob(context.Background(), "missing")
+	suite.Equal(&ErrorJobNotFound, svcErr)
+
+	data, err := json.Marshal(&jobRecord{Job: Job{ID: "foreign", Status: StatusCompleted}, Owner: "someone-else"})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.runtimeStore.Put(context.Background(), providers.NamespaceJobStatus, "foreign",
+		data, 60))
+
+	_, svcErr = suite.service.GetJob(context.Background(), "foreign")
+	suite.Equal(&ErrorJobNotFound, svcErr)
+}
+
+func (suite *JobServiceTestSuite) TestGetJob_StoreError() {
+	store := newJobStoreInterfaceMock(suite.T())
+	store.On("GetJob", mock.Anything, "job-1").Return(nil, errors.New("store down"))
+	service := newJobService(store, 1, 1)
+
+	_, svcErr := service.GetJob(context.Background(), "job-1")
+	suite.Equal(&common.InternalServerError, svcErr)
+}
+
+func (suite *JobServiceTestSuite) TestSubmit_StoreError() {
+	store := newJobStoreInterfaceMock(suite.T())
+	store.On("SaveJob", mock.Anything, mock.Anything).Return(errors.New("store down"))
+	service := newJobService(store, 1, 1)
+
+	job, svcErr := service.Submit(context.Background(), "TEST", 0, nil)
+	suite.Nil(job)
+	suite.Equal(&common.InternalServerError, svcErr)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// jobStoreInterface defines the interface for the job store.
+type jobStoreInterface interface {
+	// GetJob returns the stored job, or nil when it does not exist or has expired.
+	GetJob(ctx context.Context, id string) (*jobRecord, error)
+
+	// SaveJob stores the job. The entry expires after the retention period.
+	SaveJob(ctx context.Context, record *jobRecord) error
+
+	// DeleteJob removes the job.
+	DeleteJob(ctx context.Context, id string) error
+}
+
+// jobStore keeps jobs in the runtime store so their status is visible from every node.
+type jobStore struct {
+	store     providers.RuntimeStoreProvider
+	retention time.Duration
+}
+
+// newJobStore creates a new instance of jobStore.
+func newJobStore(store providers.RuntimeStoreProvider, retention time.Duration) jobStoreInterface {
+	return &jobStore{store: store, retention: retention}
+}
+
+// GetJob returns the stored job, or nil when it does not exist or has expired.
+func (s *jobStore) GetJob(ctx context.Context, id string) (*jobRecord, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceJobStatus, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+	var record jobRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job: %w", err)
+	}
+	return &record, nil
+}
+
+// SaveJob stores the job. The entry expires after the retention period.
+func (s *jobStore) SaveJob(ctx context.Context, record *jobRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceJobStatus, record.ID, data, int64(s.retention.Seconds()))
+}
+
+// DeleteJob removes the job.
+func (s *jobStore) DeleteJob(ctx context.Context, id string) error {
+	return s.store.Delete(ctx, providers.NamespaceJobStatus, id)
+}
@@ -45,9 +45,9 @@ type NotificationSenderDTO struct {
 
 // NotificationSenderRequest represents the request structure for creating or updating a notification sender.
 type NotificationSenderRequest struct {
-	Name        string                `json:"name"`
-	Description string                `json:"description"`
-	Provider    string                `json:"provider"`
+	Name        string                `json:"name"        native:"max=255"`
+	Description string                `json:"description" native:"max=500"`
+	Provider    string                `json:"provider"    native:"max=20"`
 	Properties  []cmodels.PropertyDTO `json:"properties"`
 }
 
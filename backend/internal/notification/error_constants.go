@@ -235,4 +235,18 @@ var (
 				"Remove or reassign them first.",
 		},
 	}
+	// ErrorOTPResendThrottled is returned when an OTP is requested for a recipient before the resend
+	// interval of the previous OTP has elapsed.
+	ErrorOTPResendThrottled = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "MNS-1017",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.notificationservice.otp_resend_throttled",
+			DefaultValue: "OTP requested too soon",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.notificationservice.otp_resend_throttled_description",
+			DefaultValue: "An OTP was sent to this recipient recently. Wait before requesting another one",
+		},
+	}
 )
@@ -84,6 +84,9 @@ func (h *messageNotificationSenderHandler) HandleSenderCreateRequest(w http.Resp
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationHandler"))
 	sender, err := sysutils.DecodeJSONBody[common.NotificationSenderRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		h.handleError(ctx, w, &ErrorInvalidRequestFormat, "Failed to parse request body: "+err.Error())
 		return
 	}
@@ -169,6 +172,9 @@ func (h *messageNotificationSenderHandler) HandleSenderUpdateRequest(w http.Resp
 
 	sender, err := sysutils.DecodeJSONBody[common.NotificationSenderRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		h.handleError(ctx, w, &ErrorInvalidRequestFormat, "Failed to parse request body: "+err.Error())
 		return
 	}
@@ -219,6 +225,9 @@ func (h *messageNotificationSenderHandler) HandleOTPSendRequest(w http.ResponseW
 	ctx := r.Context()
 	request, err := sysutils.DecodeJSONBody[common.SendOTPRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		h.handleError(ctx, w, &ErrorInvalidRequestFormat, "Failed to parse request body: "+err.Error())
 		return
 	}
@@ -247,6 +256,9 @@ func (h *messageNotificationSenderHandler) HandleOTPVerifyRequest(w http.Respons
 	ctx := r.Context()
 	request, err := sysutils.DecodeJSONBody[common.VerifyOTPRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		h.handleError(ctx, w, &ErrorInvalidRequestFormat, "Failed to parse request body: "+err.Error())
 		return
 	}
@@ -289,6 +301,8 @@ func (h *messageNotificationSenderHandler) handleError(ctx context.Context, w ht
 		case ErrorDuplicateSenderName.Code,
 			ErrorSenderHasBlockingDependencies.Code:
 			statusCode = http.StatusConflict
+		case ErrorOTPResendThrottled.Code:
+			statusCode = http.StatusTooManyRequests
 		default:
 			statusCode = http.StatusBadRequest
 		}
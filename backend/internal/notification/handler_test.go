@@ -324,6 +324,17 @@ func (suite *MessageHandlerTestSuite) TestHandleOTPSendRequest_InvalidRecipient(
 	suite.Equal(http.StatusBadRequest, rr2.Code)
 }
 
+func (suite *MessageHandlerTestSuite) TestHandleOTPSendRequest_ResendThrottled() {
+	mOtp := NewOTPServiceInterfaceMock(suite.T())
+	handler := newMessageNotificationSenderHandler(nil, mOtp)
+	b, _ := json.Marshal(common.SendOTPRequest{Recipient: "+15559876543", SenderID: "s1", Channel: "sms"})
+	mOtp.On("SendOTP", mock.Anything, mock.Anything).Return(nil, &ErrorOTPResendThrottled).Once()
+	req := httptest.NewRequest(http.MethodPost, "/otp/send", bytes.NewBuffer(b))
+	rr := httptest.NewRecorder()
+	handler.HandleOTPSendRequest(rr, req)
+	suite.Equal(http.StatusTooManyRequests, rr.Code)
+}
+
 func (suite *MessageHandlerTestSuite) TestHandleOTPVerifyRequest_InvalidJSON() {
 	handler := newMessageNotificationSenderHandler(nil, nil)
 	req3 := httptest.NewRequest(http.MethodPost, "/otp/verify", bytes.NewBufferString("invalid"))
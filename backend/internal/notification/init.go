@@ -30,11 +30,12 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/template"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // Initialize creates and configures the notification service components.
 func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface,
-	templateService template.TemplateServiceInterface) (
+	templateService template.TemplateServiceInterface, runtimeStore providers.RuntimeStoreProvider) (
 	NotificationSenderMgtSvcInterface, OTPServiceInterface, NotificationSenderServiceInterface,
 	declarativeresource.ResourceExporter, error) {
 	var notificationStore notificationStoreInterface
@@ -62,7 +63,7 @@ func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface,
 	}
 
 	clientFactory := client.Initialize()
-	otpService := newOTPService(mgtService, jwtService, templateService, clientFactory)
+	otpService := newOTPService(mgtService, jwtService, templateService, clientFactory, newOTPStore(runtimeStore))
 	notificationSenderService := newNotificationSenderService(mgtService, clientFactory)
 	handler := newMessageNotificationSenderHandler(mgtService, otpService)
 	registerRoutes(mux, handler)
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
@@ -87,7 +88,8 @@ func (suite *InitTestSuite) TearDownSuite() {
 }
 
 func (suite *InitTestSuite) TestInitialize() {
-	mgtService, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	mgtService, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	suite.NotNil(mgtService)
@@ -225,7 +227,8 @@ properties:
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_ListEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodGet, "/notification-senders/message", nil)
@@ -237,7 +240,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_ListEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_CreateEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/message", nil)
@@ -249,7 +253,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_CreateEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_GetByIDEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodGet, "/notification-senders/message/test-id", nil)
@@ -261,7 +266,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_GetByIDEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_UpdateEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPut, "/notification-senders/message/test-id", nil)
@@ -273,7 +279,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_UpdateEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_DeleteEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodDelete, "/notification-senders/message/test-id", nil)
@@ -285,7 +292,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_DeleteEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_SendOTPEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/otp/send", nil)
@@ -297,7 +305,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_SendOTPEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_VerifyOTPEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/otp/verify", nil)
@@ -309,7 +318,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_VerifyOTPEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_CORSPreflight() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService,
+		inmemory.Initialize("test"))
 	suite.NoError(err)
 
 	paths := []string{
@@ -564,7 +574,7 @@ func (suite *InitTestSuite) TestInitialize_WithDeclarativeResourcesEnabled_Inval
 	mux := http.NewServeMux()
 
 	// Initialize should return an error due to invalid YAML
-	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService, inmemory.Initialize("test"))
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to load notification sender resources")
 
@@ -645,7 +655,7 @@ properties:
 	mux := http.NewServeMux()
 
 	// Initialize should return an error due to validation failure
-	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService, inmemory.Initialize("test"))
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to load notification sender resources")
 
@@ -20,7 +20,10 @@ package notification
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -37,16 +40,22 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/template"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
-const otpSessionAudience = "otp-svc"
+const (
+	otpSessionAudience = "otp-svc"
+	// defaultMaxVerifyAttempts is the number of wrong codes accepted when none is configured.
+	defaultMaxVerifyAttempts = 3
+)
 
-// otpSessionData holds the data encoded in the OTP session JWT.
-// JSON field names match those used by authn/otp for session token compatibility.
+// otpSessionData holds the data encoded in the OTP session JWT. The token references the OTP state in
+// the runtime store and carries the secret used to hash the code, which is never persisted.
 type otpSessionData struct {
+	SessionID     string `json:"session_id"`
+	Secret        string `json:"secret"`
 	Recipient     string `json:"recipient"`
 	RecipientAttr string `json:"recipientAttr,omitempty"`
-	OTPValue      string `json:"otp_value"`
 	ExpiryTime    int64  `json:"expiry_time"`
 }
 
@@ -72,22 +81,25 @@ type otpService struct {
 	senderMgtService NotificationSenderMgtSvcInterface
 	clientFactory    client.ClientFactoryInterface
 	templateService  template.TemplateServiceInterface
+	store            otpStoreInterface
 }
 
 // newOTPService returns a new instance of OTPServiceInterface.
 func newOTPService(notifSenderSvc NotificationSenderMgtSvcInterface,
 	jwtSvc jwt.JWTServiceInterface, templateSvc template.TemplateServiceInterface,
-	clientFactory client.ClientFactoryInterface) OTPServiceInterface {
+	clientFactory client.ClientFactoryInterface, store otpStoreInterface) OTPServiceInterface {
 	return &otpService{
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OTPService")),
 		jwtService:       jwtSvc,
 		senderMgtService: notifSenderSvc,
 		clientFactory:    clientFactory,
 		templateService:  templateSvc,
+		store:            store,
 	}
 }
 
 // GenerateOTP generates an OTP and session token for the recipient without delivering it.
+// Only a hash of the OTP is stored, and a new OTP is refused while the recipient's resend interval is open.
 func (s *otpService) GenerateOTP(ctx context.Context, recipient, recipientAttr string) (
 	string, string, int64, *tidcommon.ServiceError) {
 	logger := s.logger
@@ -97,25 +109,62 @@ func (s *otpService) GenerateOTP(ctx context.Context, recipient, recipientAttr s
 		return "", "", 0, &ErrorInvalidRecipient
 	}
 
+	resendInterval := s.getResendInterval()
+	if resendInterval > 0 {
+		throttled, err := s.store.IsResendThrottled(ctx, recipient)
+		if err != nil {
+			logger.Error(ctx, "Failed to check OTP resend interval", log.Error(err))
+			return "", "", 0, &tidcommon.InternalServerError
+		}
+		if throttled {
+			logger.Debug(ctx, "OTP resend throttled", log.MaskedString("recipient", recipient))
+			return "", "", 0, &ErrorOTPResendThrottled
+		}
+	}
+
 	otp, err := s.generateOTP()
 	if err != nil {
 		logger.Error(ctx, "Failed to generate OTP", log.Error(err))
 		return "", "", 0, &tidcommon.InternalServerError
 	}
 
+	secret, err := cryptolib.GenerateSecureToken()
+	if err != nil {
+		logger.Error(ctx, "Failed to generate OTP secret", log.Error(err))
+		return "", "", 0, &tidcommon.InternalServerError
+	}
+
 	sessionData := otpSessionData{
+		SessionID:     sysutils.GenerateUUID(),
+		Secret:        secret,
 		Recipient:     recipient,
 		RecipientAttr: recipientAttr,
-		OTPValue:      cryptolib.GenerateThumbprintFromString(otp.Value),
 		ExpiryTime:    otp.ExpiryTimeInMillis,
 	}
 
+	record := otpRecord{
+		CodeHash:   hashOTP(secret, otp.Value),
+		ExpiryTime: otp.ExpiryTimeInMillis,
+	}
+	validity := time.Duration(s.getOTPValidityPeriodInMillis()) * time.Millisecond
+	if err := s.store.CreateOTP(ctx, sessionData.SessionID, record, validity); err != nil {
+		logger.Error(ctx, "Failed to store OTP", log.Error(err))
+		return "", "", 0, &tidcommon.InternalServerError
+	}
+
 	sessionToken, err := s.createSessionToken(ctx, sessionData)
 	if err != nil {
 		logger.Error(ctx, "Failed to create OTP session token", log.Error(err))
 		return "", "", 0, &tidcommon.InternalServerError
 	}
 
+	if resendInterval > 0 {
+		if err := s.store.MarkSent(ctx, recipient, resendInterval); err != nil {
+			logger.Error(ctx, "Failed to record OTP resend interval", log.Error(err))
+			return "", "", 0, &tidcommon.InternalServerError
+		}
+	}
+
 	expirySeconds := s.getOTPValidityPeriodInMillis() / 1000
 	logger.Debug(ctx, "OTP generated successfully", log.MaskedString("recipient", recipient))
 	return sessionToken, otp.Value, expirySeconds, nil
@@ -149,6 +198,9 @@ func (s *otpService) SendOTP(
 
 	sessionToken, otpValue, _, otpErr := s.GenerateOTP(ctx, otpDTO.Recipient, "mobile_number")
 	if otpErr != nil {
+		if otpErr.Code == ErrorOTPResendThrottled.Code {
+			return nil, otpErr
+		}
 		logger.Error(ctx, "Failed to generate OTP", log.String("error", otpErr.Code))
 		return nil, &tidcommon.InternalServerError
 	}
@@ -169,7 +221,8 @@ func (s *otpService) SendOTP(
 	}, nil
 }
 
-// VerifyOTP verifies the provided OTP against the session token.
+// VerifyOTP verifies the provided OTP against the session token. A verified OTP is consumed, and an
+// OTP is invalidated once the configured number of wrong codes has been submitted for it.
 func (s *otpService) VerifyOTP(
 	ctx context.Context, otpDTO common.VerifyOTPDTO) (*common.VerifyOTPResultDTO, *tidcommon.ServiceError) {
 	logger := s.logger
@@ -184,22 +237,36 @@ func (s *otpService) VerifyOTP(
 		return nil, svcErr
 	}
 
+	invalidResult := &common.VerifyOTPResultDTO{
+		Status:        common.OTPVerifyStatusInvalid,
+		Recipient:     sessionData.Recipient,
+		RecipientAttr: sessionData.RecipientAttr,
+	}
+
 	if time.Now().UnixMilli() > sessionData.ExpiryTime {
 		logger.Debug(ctx, "OTP has expired")
-		return &common.VerifyOTPResultDTO{
-			Status:        common.OTPVerifyStatusInvalid,
-			Recipient:     sessionData.Recipient,
-			RecipientAttr: sessionData.RecipientAttr,
-		}, nil
+		return invalidResult, nil
 	}
 
-	if cryptolib.GenerateThumbprintFromString(otpDTO.OTPCode) != sessionData.OTPValue {
+	// Take the record before comparing the code, so that only one request at a time can check a code
+	// against it. This keeps concurrent requests from verifying the same OTP twice, or from checking
+	// several wrong codes against the same attempt count. A wrong code puts the record back.
+	record, err := s.store.TakeOTP(ctx, sessionData.SessionID)
+	if err != nil {
+		logger.Error(ctx, "Failed to take OTP", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if record == nil || time.Now().UnixMilli() > record.ExpiryTime {
+		logger.Debug(ctx, "OTP is no longer valid")
+		return invalidResult, nil
+	}
+
+	if !hmac.Equal([]byte(hashOTP(sessionData.Secret, otpDTO.OTPCode)), []byte(record.CodeHash)) {
 		logger.Debug(ctx, "Invalid OTP provided")
-		return &common.VerifyOTPResultDTO{
-			Status:        common.OTPVerifyStatusInvalid,
-			Recipient:     sessionData.Recipient,
-			RecipientAttr: sessionData.RecipientAttr,
-		}, nil
+		if svcErr := s.recordFailedAttempt(ctx, sessionData.SessionID, *record); svcErr != nil {
+			return nil, svcErr
+		}
+		return invalidResult, nil
 	}
 
 	return &common.VerifyOTPResultDTO{
@@ -209,6 +276,23 @@ func (s *otpService) VerifyOTP(
 	}, nil
 }
 
+// recordFailedAttempt counts a wrong code against an OTP taken from the store. The OTP is put back with
+// the attempt counted, unless the maximum number of attempts is reached, which leaves it invalidated.
+func (s *otpService) recordFailedAttempt(ctx context.Context, sessionID string,
+	record otpRecord) *tidcommon.ServiceError {
+	record.Attempts++
+	if record.Attempts >= s.getMaxVerifyAttempts() {
+		s.logger.Debug(ctx, "Maximum OTP verification attempts reached", log.Int("attempts", record.Attempts))
+		return nil
+	}
+
+	if err := s.store.RestoreOTP(ctx, sessionID, record); err != nil {
+		s.logger.Error(ctx, "Failed to update OTP attempts", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	return nil
+}
+
 // validateOTPSendRequest validates the OTP send request.
 func (s *otpService) validateOTPSendRequest(request common.SendOTPDTO) *tidcommon.ServiceError {
 	if strings.TrimSpace(request.Recipient) == "" {
@@ -332,6 +416,9 @@ func (s *otpService) verifyAndDecodeSessionToken(ctx context.Context, token stri
 	if err := json.Unmarshal(otpDataBytes, &sessionData); err != nil {
 		return nil, &ErrorInvalidSessionToken
 	}
+	if sessionData.SessionID == "" || sessionData.Secret == "" {
+		return nil, &ErrorInvalidSessionToken
+	}
 
 	return &sessionData, nil
 }
@@ -359,3 +446,23 @@ func (s *otpService) getOTPLength() int {
 func (s *otpService) getOTPValidityPeriodInMillis() int64 {
 	return int64(s.resolveOTPConfig().ValidityPeriodSeconds) * 1000
 }
+
+// getMaxVerifyAttempts returns the number of wrong codes accepted before an OTP is invalidated.
+func (s *otpService) getMaxVerifyAttempts() int {
+	if maxAttempts := s.resolveOTPConfig().MaxVerifyAttempts; maxAttempts > 0 {
+		return maxAttempts
+	}
+	return defaultMaxVerifyAttempts
+}
+
+// getResendInterval returns the minimum interval between OTPs for the same recipient.
+func (s *otpService) getResendInterval() time.Duration {
+	return time.Duration(s.resolveOTPConfig().ResendIntervalSeconds) * time.Second
+}
+
+// hashOTP returns the HMAC-SHA256 of the OTP keyed with the session secret.
+func hashOTP(secret, otpValue string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(otpValue))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -24,7 +24,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 
@@ -34,9 +37,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
-	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/template"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
@@ -60,6 +63,7 @@ type OTPServiceTestSuite struct {
 	mockJWTService      *jwtmock.JWTServiceInterfaceMock
 	mockSenderService   *NotificationSenderMgtSvcInterfaceMock
 	mockTemplateService *templatemock.TemplateServiceInterfaceMock
+	store               otpStoreInterface
 	service             *otpService
 }
 
@@ -97,10 +101,12 @@ func (suite *OTPServiceTestSuite) SetupTest() {
 		Length:                6,
 		UseNumericOnly:        true,
 		ValidityPeriodSeconds: 120,
+		MaxVerifyAttempts:     3,
 	}
 	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	suite.mockSenderService = NewNotificationSenderMgtSvcInterfaceMock(suite.T())
 	suite.mockTemplateService = templatemock.NewTemplateServiceInterfaceMock(suite.T())
+	suite.store = newOTPStore(inmemory.Initialize("test"))
 
 	suite.service = &otpService{
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OTPService")),
@@ -108,6 +114,7 @@ func (suite *OTPServiceTestSuite) SetupTest() {
 		senderMgtService: suite.mockSenderService,
 		clientFactory:    clientmock.NewClientFactoryInterfaceMock(suite.T()),
 		templateService:  suite.mockTemplateService,
+		store:            suite.store,
 	}
 }
 
@@ -157,6 +164,53 @@ func (suite *OTPServiceTestSuite) TestGenerateOTP_Success() {
 	}
 }
 
+func (suite *OTPServiceTestSuite) TestGenerateOTP_StoresOnlyHashedCode() {
+	var claims map[string]interface{}
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, otpSessionAudience, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Run(func(args mock.Arguments) {
+		claims = args.Get(4).(map[string]interface{})
+	}).Return("session-token-123", int64(0), (*tidcommon.ServiceError)(nil)).Once()
+
+	_, otpValue, _, err := suite.service.GenerateOTP(context.Background(), "+15559876543", "mobile_number")
+	suite.Nil(err)
+
+	sessionData := claims["otp_data"].(otpSessionData)
+	suite.NotEmpty(sessionData.SessionID)
+	suite.NotEmpty(sessionData.Secret)
+
+	record, storeErr := suite.store.GetOTP(context.Background(), sessionData.SessionID)
+	suite.NoError(storeErr)
+	suite.Require().NotNil(record)
+	suite.NotContains(record.CodeHash, otpValue)
+	suite.Equal(hashOTP(sessionData.Secret, otpValue), record.CodeHash)
+	suite.Zero(record.Attempts)
+}
+
+func (suite *OTPServiceTestSuite) TestGenerateOTP_ResendThrottled() {
+	config.GetServerRuntime().Config.Notification.OTP.ResendIntervalSeconds = 30
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, otpSessionAudience, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return("session-token-123", int64(0), (*tidcommon.ServiceError)(nil)).Once()
+
+	_, _, _, err := suite.service.GenerateOTP(context.Background(), "+15559876543", "mobile_number")
+	suite.Nil(err)
+
+	_, _, _, err = suite.service.GenerateOTP(context.Background(), "+15559876543", "mobile_number")
+	suite.NotNil(err)
+	suite.Equal(ErrorOTPResendThrottled.Code, err.Code)
+
+	// Other recipients are not affected.
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, otpSessionAudience, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return("session-token-456", int64(0), (*tidcommon.ServiceError)(nil)).Once()
+	_, _, _, err = suite.service.GenerateOTP(context.Background(), "+15550000000", "mobile_number")
+	suite.Nil(err)
+}
+
 func (suite *OTPServiceTestSuite) TestGenerateOTP_JWTError() {
 	jwtErr := &tidcommon.ServiceError{
 		Type:  tidcommon.ServerErrorType,
@@ -517,14 +571,22 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_EmptyOTPCode() {
 	suite.Equal(ErrorInvalidOTP.Code, err.Code)
 }
 
-func (suite *OTPServiceTestSuite) TestVerifyOTP_Success() {
+// createTestOTP stores an OTP for the given code and returns a session token that references it.
+func (suite *OTPServiceTestSuite) createTestOTP(code string) string {
 	sessionData := otpSessionData{
+		SessionID:     "session-1",
+		Secret:        "secret-1",
 		Recipient:     "+15559876543",
 		RecipientAttr: "mobile_number",
-		OTPValue:      cryptolib.GenerateThumbprintFromString("123456"),
 		ExpiryTime:    9999999999999,
 	}
-	testToken := buildTestJWT(sessionData)
+	record := otpRecord{CodeHash: hashOTP(sessionData.Secret, code), ExpiryTime: sessionData.ExpiryTime}
+	suite.Require().NoError(suite.store.CreateOTP(context.Background(), sessionData.SessionID, record, time.Minute))
+	return buildTestJWT(sessionData)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_Success() {
+	testToken := suite.createTestOTP("123456")
 
 	suite.mockJWTService.On("VerifyJWT",
 		mock.Anything, testToken, otpSessionAudience, mock.Anything,
@@ -538,16 +600,30 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_Success() {
 	suite.Equal(common.OTPVerifyStatusVerified, res.Status)
 	suite.Equal("+15559876543", res.Recipient)
 	suite.Equal("mobile_number", res.RecipientAttr)
+
+	record, storeErr := suite.store.GetOTP(context.Background(), "session-1")
+	suite.NoError(storeErr)
+	suite.Nil(record, "a verified OTP must be consumed")
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_Replay() {
+	testToken := suite.createTestOTP("123456")
+	suite.mockJWTService.On("VerifyJWT",
+		mock.Anything, testToken, otpSessionAudience, mock.Anything,
+	).Return((*tidcommon.ServiceError)(nil)).Twice()
+
+	req := common.VerifyOTPDTO{SessionToken: testToken, OTPCode: "123456"}
+	res, err := suite.service.VerifyOTP(context.Background(), req)
+	suite.Nil(err)
+	suite.Equal(common.OTPVerifyStatusVerified, res.Status)
+
+	res, err = suite.service.VerifyOTP(context.Background(), req)
+	suite.Nil(err)
+	suite.Equal(common.OTPVerifyStatusInvalid, res.Status)
 }
 
 func (suite *OTPServiceTestSuite) TestVerifyOTP_IncorrectOTP() {
-	sessionData := otpSessionData{
-		Recipient:     "+15559876543",
-		RecipientAttr: "mobile_number",
-		OTPValue:      cryptolib.GenerateThumbprintFromString("123456"),
-		ExpiryTime:    9999999999999,
-	}
-	testToken := buildTestJWT(sessionData)
+	testToken := suite.createTestOTP("123456")
 
 	suite.mockJWTService.On("VerifyJWT",
 		mock.Anything, testToken, otpSessionAudience, mock.Anything,
@@ -559,13 +635,103 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_IncorrectOTP() {
 	suite.Nil(err)
 	suite.NotNil(res)
 	suite.Equal(common.OTPVerifyStatusInvalid, res.Status)
+
+	record, storeErr := suite.store.GetOTP(context.Background(), "session-1")
+	suite.NoError(storeErr)
+	suite.Require().NotNil(record)
+	suite.Equal(1, record.Attempts)
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_MaxAttemptsInvalidatesOTP() {
+	testToken := suite.createTestOTP("123456")
+	suite.mockJWTService.On("VerifyJWT",
+		mock.Anything, testToken, otpSessionAudience, mock.Anything,
+	).Return((*tidcommon.ServiceError)(nil)).Times(4)
+
+	for i := 0; i < 3; i++ {
+		res, err := suite.service.VerifyOTP(context.Background(),
+			common.VerifyOTPDTO{SessionToken: testToken, OTPCode: "000000"})
+		suite.Nil(err)
+		suite.Equal(common.OTPVerifyStatusInvalid, res.Status)
+	}
+
+	// The correct code is rejected once the attempts are exhausted.
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: testToken, OTPCode: "123456"})
+	suite.Nil(err)
+	suite.Equal(common.OTPVerifyStatusInvalid, res.Status)
+}
+
+// countingOTPStore counts the OTP records handed out for verification.
+type countingOTPStore struct {
+	otpStoreInterface
+	taken atomic.Int32
+}
+
+func (s *countingOTPStore) TakeOTP(ctx context.Context, sessionID string) (*otpRecord, error) {
+	record, err := s.otpStoreInterface.TakeOTP(ctx, sessionID)
+	if record != nil {
+		s.taken.Add(1)
+	}
+	return record, err
+}
+
+// Concurrent wrong codes are each counted, so no more codes than the attempt limit are ever checked.
+func (suite *OTPServiceTestSuite) TestVerifyOTP_ConcurrentIncorrectOTPs() {
+	store := &countingOTPStore{otpStoreInterface: suite.store}
+	suite.service.store = store
+	testToken := suite.createTestOTP("123456")
+	suite.mockJWTService.On("VerifyJWT",
+		mock.Anything, testToken, otpSessionAudience, mock.Anything,
+	).Return((*tidcommon.ServiceError)(nil))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := suite.service.VerifyOTP(context.Background(),
+				common.VerifyOTPDTO{SessionToken: testToken, OTPCode: "000000"})
+			suite.Nil(err)
+			suite.Equal(common.OTPVerifyStatusInvalid, res.Status)
+		}()
+	}
+	wg.Wait()
+
+	for {
+		record, err := suite.store.GetOTP(context.Background(), "session-1")
+		suite.Require().NoError(err)
+		if record == nil {
+			break
+		}
+		_, svcErr := suite.service.VerifyOTP(context.Background(),
+			common.VerifyOTPDTO{SessionToken: testToken, OTPCode: "000000"})
+		suite.Require().Nil(svcErr)
+	}
+
+	suite.Equal(int32(3), store.taken.Load())
+}
+
+func (suite *OTPServiceTestSuite) TestVerifyOTP_TokenWithoutSession() {
+	testToken := buildTestJWT(otpSessionData{Recipient: "+15559876543", ExpiryTime: 9999999999999})
+	suite.mockJWTService.On("VerifyJWT",
+		mock.Anything, testToken, otpSessionAudience, mock.Anything,
+	).Return((*tidcommon.ServiceError)(nil)).Once()
+
+	res, err := suite.service.VerifyOTP(context.Background(),
+		common.VerifyOTPDTO{SessionToken: testToken, OTPCode: "123456"})
+
+	suite.Nil(res)
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidSessionToken.Code, err.Code)
 }
 
 func (suite *OTPServiceTestSuite) TestVerifyOTP_ExpiredOTP() {
 	sessionData := otpSessionData{
+		SessionID:     "session-1",
+		Secret:        "secret-1",
 		Recipient:     "+15559876543",
 		RecipientAttr: "mobile_number",
-		OTPValue:      cryptolib.GenerateThumbprintFromString("123456"),
 		ExpiryTime:    1, // expired
 	}
 	testToken := buildTestJWT(sessionData)
@@ -617,6 +783,6 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_MalformedJWTPayload() {
 
 func (suite *OTPServiceTestSuite) TestNewOTPService_Constructor() {
 	svc := newOTPService(suite.mockSenderService, suite.mockJWTService,
-		suite.mockTemplateService, clientmock.NewClientFactoryInterfaceMock(suite.T()))
+		suite.mockTemplateService, clientmock.NewClientFactoryInterfaceMock(suite.T()), suite.store)
 	suite.NotNil(svc)
 }
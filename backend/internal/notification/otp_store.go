@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// otpRecord is the server-side state of an issued OTP. Only a keyed hash of the code is kept, and the
+// key travels in the session token, so the record alone cannot be used to recover the code.
+type otpRecord struct {
+	CodeHash   string `json:"codeHash"`
+	Attempts   int    `json:"attempts"`
+	ExpiryTime int64  `json:"expiryTime"`
+}
+
+// otpStoreInterface defines the interface for the OTP state store.
+type otpStoreInterface interface {
+	// CreateOTP stores the state of a newly issued OTP for the given lifetime.
+	CreateOTP(ctx context.Context, sessionID string, record otpRecord, ttl time.Duration) error
+
+	// GetOTP returns the state of an OTP, or nil when it is unknown, used up, or has expired.
+	GetOTP(ctx context.Context, sessionID string) (*otpRecord, error)
+
+	// RestoreOTP stores the state of an OTP taken with TakeOTP again, for the rest of its lifetime.
+	// An OTP that has expired in the meantime is not stored.
+	RestoreOTP(ctx context.Context, sessionID string, record otpRecord) error
+
+	// TakeOTP removes and returns the state of an OTP, or nil when it is unknown, used up, or has expired.
+	TakeOTP(ctx context.Context, sessionID string) (*otpRecord, error)
+
+	// IsResendThrottled reports whether an OTP was issued to the recipient within the resend interval.
+	IsResendThrottled(ctx context.Context, recipient string) (bool, error)
+
+	// MarkSent records that an OTP was issued to the recipient, throttling resends for the interval.
+	MarkSent(ctx context.Context, recipient string, interval time.Duration) error
+}
+
+// otpStore keeps OTP state and resend markers in the runtime store. Recipients are stored as
+// hashes so that the resend markers do not reveal phone numbers or email addresses.
+type otpStore struct {
+	store providers.RuntimeStoreProvider
+}
+
+// newOTPStore creates a new instance of otpStore.
+func newOTPStore(store providers.RuntimeStoreProvider) otpStoreInterface {
+	return &otpStore{store: store}
+}
+
+// CreateOTP stores the state of a newly issued OTP for the given lifetime.
+func (s *otpStore) CreateOTP(ctx context.Context, sessionID string, record otpRecord, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal OTP record: %w", err)
+	}
+	return s.store.Put(ctx, providers.NamespaceOTPSession, sessionID, data, int64(ttl.Seconds()))
+}
+
+// GetOTP returns the state of an OTP, or nil when it is unknown, used up, or has expired.
+func (s *otpStore) GetOTP(ctx context.Context, sessionID string) (*otpRecord, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceOTPSession, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OTP record: %w", err)
+	}
+	return unmarshalOTPRecord(data)
+}
+
+// RestoreOTP stores the state of an OTP taken with TakeOTP again, for the rest of its lifetime.
+func (s *otpStore) RestoreOTP(ctx context.Context, sessionID string, record otpRecord) error {
+	remaining := time.Until(time.UnixMilli(record.ExpiryTime))
+	if remaining <= 0 {
+		return nil
+	}
+	// Round up so that an OTP is never dropped before it expires.
+	return s.CreateOTP(ctx, sessionID, record, remaining.Truncate(time.Second)+time.Second)
+}
+
+// TakeOTP removes and returns the state of an OTP, or nil when it is unknown, used up, or has expired.
+func (s *otpStore) TakeOTP(ctx context.Context, sessionID string) (*otpRecord, error) {
+	data, err := s.store.Take(ctx, providers.NamespaceOTPSession, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take OTP record: %w", err)
+	}
+	return unmarshalOTPRecord(data)
+}
+
+// IsResendThrottled reports whether an OTP was issued to the recipient within the resend interval.
+func (s *otpStore) IsResendThrottled(ctx context.Context, recipient string) (bool, error) {
+	data, err := s.store.Get(ctx, providers.NamespaceOTPResend, cryptolib.HashToken(recipient))
+	if err != nil {
+		return false, fmt.Errorf("failed to get OTP resend marker: %w", err)
+	}
+	return data != nil, nil
+}
+
+// MarkSent records that an OTP was issued to the recipient, throttling resends for the interval.
+func (s *otpStore) MarkSent(ctx context.Context, recipient string, interval time.Duration) error {
+	data := []byte(strconv.FormatInt(time.Now().UnixMilli(), 10))
+	if err := s.store.Put(ctx, providers.NamespaceOTPResend, cryptolib.HashToken(recipient), data,
+		int64(interval.Seconds())); err != nil {
+		return fmt.Errorf("failed to store OTP resend marker: %w", err)
+	}
+	return nil
+}
+
+// unmarshalOTPRecord decodes a stored OTP record, returning nil for a missing entry.
+func unmarshalOTPRecord(data []byte) (*otpRecord, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var record otpRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal OTP record: %w", err)
+	}
+	return &record, nil
+}
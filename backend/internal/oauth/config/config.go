@@ -26,23 +26,40 @@ import (
 
 // Config holds configuration values required by OAuth services.
 type Config struct {
-	DeploymentID  string
-	RuntimeDBType string
-	BaseURL       string
-	JWT           engineconfig.JWTConfig
-	OAuth         engineconfig.OAuthConfig
-	GateClient    engineconfig.GateClientConfig
+	DeploymentID   string
+	DeploymentMode engineconfig.DeploymentMode
+	RuntimeDBType  string
+	BaseURL        string
+	JWT            engineconfig.JWTConfig
+	OAuth          engineconfig.OAuthConfig
+	GateClient     engineconfig.GateClientConfig
+
+	// gateClientSource reads the current gate client configuration, so a configuration reload reaches
+	// the OAuth handlers. When nil, GateClient is used as is.
+	gateClientSource func() engineconfig.GateClientConfig
+}
+
+// CurrentGateClient returns the gate client configuration in effect.
+func (c Config) CurrentGateClient() engineconfig.GateClientConfig {
+	if c.gateClientSource != nil {
+		return c.gateClientSource()
+	}
+	return c.GateClient
 }
 
 // FromServerRuntime builds OAuth configuration from the global server runtime.
 func FromServerRuntime() Config {
 	runtime := config.GetServerRuntime()
 	return Config{
-		DeploymentID:  runtime.Config.Server.Identifier,
-		RuntimeDBType: runtime.Config.Database.Runtime.Type,
-		BaseURL:       config.GetServerURL(&runtime.Config.Server),
-		JWT:           runtime.Config.JWT,
-		OAuth:         runtime.Config.OAuth,
-		GateClient:    runtime.Config.GateClient,
+		DeploymentID:   runtime.Config.Server.Identifier,
+		DeploymentMode: runtime.Config.Server.DeploymentMode,
+		RuntimeDBType:  runtime.Config.Database.Runtime.Type,
+		BaseURL:        config.GetServerURL(&runtime.Config.Server),
+		JWT:            runtime.Config.JWT,
+		OAuth:          runtime.Config.OAuth,
+		GateClient:     runtime.Config.GateClient,
+		gateClientSource: func() engineconfig.GateClientConfig {
+			return config.GetServerRuntime().Config.GateClient
+		},
 	}
 }
@@ -80,3 +80,25 @@ func (s *OAuthConfigTestSuite) TestFromServerRuntime() {
 	s.Equal(int64(600), result.OAuth.PAR.ExpiresIn)
 	s.Equal("localhost", result.GateClient.Hostname)
 }
+
+func (s *OAuthConfigTestSuite) TestCurrentGateClient_FollowsReload() {
+	cfg := &config.Config{
+		GateClient: engineconfig.GateClientConfig{Scheme: "https", Hostname: "localhost", Port: 5190,
+			LoginPath: "/signin"},
+	}
+	s.Require().NoError(config.InitializeServerRuntime("/tmp/test-oauth-config", cfg))
+	result := FromServerRuntime()
+
+	reloaded := *cfg
+	reloaded.GateClient.LoginPath = "/gate/signin"
+	config.ReloadServerRuntime(&reloaded)
+
+	s.Equal("/signin", result.GateClient.LoginPath)
+	s.Equal("/gate/signin", result.CurrentGateClient().LoginPath)
+}
+
+func (s *OAuthConfigTestSuite) TestCurrentGateClient_Static() {
+	cfg := Config{GateClient: engineconfig.GateClientConfig{LoginPath: "/login"}}
+
+	s.Equal("/login", cfg.CurrentGateClient().LoginPath)
+}
@@ -23,6 +23,8 @@ import (
 	"net/http"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/claimsource"
+	"github.com/thunder-id/thunderid/internal/device"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/jwks"
@@ -36,14 +38,17 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/testtoken"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/token"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/userinfo"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	scopemgt "github.com/thunder-id/thunderid/internal/scope"
 	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/usage"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -64,6 +69,10 @@ func Initialize(
 	i18nService providers.I18nProvider,
 	idpService providers.IDPProvider,
 	dpopVerifier dpop.VerifierInterface,
+	deviceService device.DeviceServiceInterface,
+	scopeService scopemgt.ScopeServiceInterface,
+	claimSourceService claimsource.ClaimSourceServiceInterface,
+	usageMeter usage.MeterInterface,
 	cfg oauthconfig.Config,
 ) error {
 	jwks.Initialize(mux, runtimeCrypto)
@@ -75,29 +84,30 @@ func Initialize(
 	discoveryService := discovery.Initialize(mux, runtimeCrypto, cfg)
 	// The enforcement service (revocation read path) is built before the token service so it can be
 	// injected into the validator, which enforces the deny list as the final step of every validation.
-	enforcementService, refreshTokenRevoker := revocation.Initialize(
+	enforcementService, refreshTokenRevoker, codeReplayRevoker := revocation.Initialize(
 		mux, jwtService, actorProvider, authnProvider, discoveryService, observabilitySvc)
 	tokenBuilder, tokenValidator := tokenservice.Initialize(
-		cfg, jwtService, jweService, resolver, idpService, enforcementService)
+		cfg, jwtService, jweService, resolver, idpService, enforcementService, claimSourceService)
 	parService := par.Initialize(mux, actorProvider, authnProvider, jwtService, discoveryService,
 		resourceService, dpopVerifier, cfg)
 	cibaService := ciba.Initialize(mux, jwtService, actorProvider, authnProvider, flowExecService,
 		discoveryService, resourceService, cfg)
 	oauth2AuthzService, err := oauth2authz.Initialize(mux, actorProvider, resourceService,
-		jwtService, flowExecService, parService, cfg)
+		jwtService, flowExecService, parService, scopeService, codeReplayRevoker, cfg)
 	if err != nil {
 		return err
 	}
 	grantHandlerProvider := granthandlers.Initialize(
 		jwtService, oauth2AuthzService, tokenBuilder, tokenValidator,
 		attributeCacheSvc, ouService, authzService, actorProvider, resourceService, cibaService,
-		refreshTokenRevoker, cfg)
+		refreshTokenRevoker, deviceService, scopeService, cfg)
 	token.Initialize(mux, jwtService, actorProvider, authnProvider, grantHandlerProvider,
-		scopeValidator, observabilitySvc, discoveryService, dpopVerifier, cfg)
+		scopeValidator, observabilitySvc, discoveryService, dpopVerifier, usageMeter, cfg)
 	introspect.Initialize(mux, jwtService, actorProvider, authnProvider, discoveryService, tokenValidator)
+	testtoken.Initialize(mux, jwtService, actorProvider, authnProvider, discoveryService, tokenBuilder, cfg)
 	userinfo.Initialize(mux, jwtService, jweService, resolver,
 		tokenValidator, actorProvider, attributeCacheSvc,
-		discoveryService, dpopVerifier, cfg)
+		discoveryService, dpopVerifier, claimSourceService, cfg)
 	callback.Initialize(mux, oauth2AuthzService, cibaService, cfg)
 	return nil
 }
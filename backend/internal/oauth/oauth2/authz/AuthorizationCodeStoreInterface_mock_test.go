@@ -38,25 +38,27 @@ func (_m *AuthorizationCodeStoreInterfaceMock) EXPECT() *AuthorizationCodeStoreI
 }
 
 // ConsumeAuthorizationCode provides a mock function for the type AuthorizationCodeStoreInterfaceMock
-func (_mock *AuthorizationCodeStoreInterfaceMock) ConsumeAuthorizationCode(ctx context.Context, authCode string) (bool, error) {
-	ret := _mock.Called(ctx, authCode)
+func (_mock *AuthorizationCodeStoreInterfaceMock) ConsumeAuthorizationCode(ctx context.Context, clientID string, authCode string) (*AuthorizationCode, error) {
+	ret := _mock.Called(ctx, clientID, authCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ConsumeAuthorizationCode")
 	}
 
-	var r0 bool
+	var r0 *AuthorizationCode
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
-		return returnFunc(ctx, authCode)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*AuthorizationCode, error)); ok {
+		return returnFunc(ctx, clientID, authCode)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
-		r0 = returnFunc(ctx, authCode)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *AuthorizationCode); ok {
+		r0 = returnFunc(ctx, clientID, authCode)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AuthorizationCode)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, authCode)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, clientID, authCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,12 +72,13 @@ type AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call struct {
 
 // ConsumeAuthorizationCode is a helper method to define mock.On call
 //   - ctx context.Context
+//   - clientID string
 //   - authCode string
-func (_e *AuthorizationCodeStoreInterfaceMock_Expecter) ConsumeAuthorizationCode(ctx interface{}, authCode interface{}) *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call {
-	return &AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call{Call: _e.mock.On("ConsumeAuthorizationCode", ctx, authCode)}
+func (_e *AuthorizationCodeStoreInterfaceMock_Expecter) ConsumeAuthorizationCode(ctx interface{}, clientID interface{}, authCode interface{}) *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call {
+	return &AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call{Call: _e.mock.On("ConsumeAuthorizationCode", ctx, clientID, authCode)}
 }
 
-func (_c *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call) Run(run func(ctx context.Context, authCode string)) *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call {
+func (_c *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call) Run(run func(ctx context.Context, clientID string, authCode string)) *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -85,20 +88,25 @@ func (_c *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call) Run
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call) Return(b bool, err error) *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call {
-	_c.Call.Return(b, err)
+func (_c *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call) Return(authorizationCode *AuthorizationCode, err error) *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call {
+	_c.Call.Return(authorizationCode, err)
 	return _c
 }
 
-func (_c *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call) RunAndReturn(run func(ctx context.Context, authCode string) (bool, error)) *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call {
+func (_c *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call) RunAndReturn(run func(ctx context.Context, clientID string, authCode string) (*AuthorizationCode, error)) *AuthorizationCodeStoreInterfaceMock_ConsumeAuthorizationCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -227,3 +235,66 @@ func (_c *AuthorizationCodeStoreInterfaceMock_InsertAuthorizationCode_Call) RunA
 	_c.Call.Return(run)
 	return _c
 }
+
+// RecordIssuedTokens provides a mock function for the type AuthorizationCodeStoreInterfaceMock
+func (_mock *AuthorizationCodeStoreInterfaceMock) RecordIssuedTokens(ctx context.Context, authCode string, tokens []IssuedToken) error {
+	ret := _mock.Called(ctx, authCode, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordIssuedTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []IssuedToken) error); ok {
+		r0 = returnFunc(ctx, authCode, tokens)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordIssuedTokens'
+type AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call struct {
+	*mock.Call
+}
+
+// RecordIssuedTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authCode string
+//   - tokens []IssuedToken
+func (_e *AuthorizationCodeStoreInterfaceMock_Expecter) RecordIssuedTokens(ctx interface{}, authCode interface{}, tokens interface{}) *AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call {
+	return &AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call{Call: _e.mock.On("RecordIssuedTokens", ctx, authCode, tokens)}
+}
+
+func (_c *AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call) Run(run func(ctx context.Context, authCode string, tokens []IssuedToken)) *AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []IssuedToken
+		if args[2] != nil {
+			arg2 = args[2].([]IssuedToken)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call) Return(err error) *AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call) RunAndReturn(run func(ctx context.Context, authCode string, tokens []IssuedToken) error) *AuthorizationCodeStoreInterfaceMock_RecordIssuedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -254,3 +254,66 @@ func (_c *AuthorizeServiceInterfaceMock_HandleInitialAuthorizationRequest_Call)
 	_c.Call.Return(run)
 	return _c
 }
+
+// RecordIssuedTokens provides a mock function for the type AuthorizeServiceInterfaceMock
+func (_mock *AuthorizeServiceInterfaceMock) RecordIssuedTokens(ctx context.Context, code string, tokens []IssuedToken) error {
+	ret := _mock.Called(ctx, code, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordIssuedTokens")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []IssuedToken) error); ok {
+		r0 = returnFunc(ctx, code, tokens)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordIssuedTokens'
+type AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call struct {
+	*mock.Call
+}
+
+// RecordIssuedTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - tokens []IssuedToken
+func (_e *AuthorizeServiceInterfaceMock_Expecter) RecordIssuedTokens(ctx interface{}, code interface{}, tokens interface{}) *AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call {
+	return &AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call{Call: _e.mock.On("RecordIssuedTokens", ctx, code, tokens)}
+}
+
+func (_c *AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call) Run(run func(ctx context.Context, code string, tokens []IssuedToken)) *AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []IssuedToken
+		if args[2] != nil {
+			arg2 = args[2].([]IssuedToken)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call) Return(err error) *AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call) RunAndReturn(run func(ctx context.Context, code string, tokens []IssuedToken) error) *AuthorizeServiceInterfaceMock_RecordIssuedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// boltAuthorizationCodeStore is the bolt-backed implementation of AuthorizationCodeStoreInterface.
+type boltAuthorizationCodeStore struct {
+	client       provider.BoltProviderInterface
+	deploymentID string
+}
+
+// newBoltAuthorizationCodeStore creates a new bolt-backed authorization code store.
+func newBoltAuthorizationCodeStore(
+	p provider.BoltProviderInterface, deploymentID string,
+) AuthorizationCodeStoreInterface {
+	return &boltAuthorizationCodeStore{
+		client:       p,
+		deploymentID: deploymentID,
+	}
+}
+
+// authCodeKey builds the bolt key for an authorization code.
+func (s *boltAuthorizationCodeStore) authCodeKey(code string) string {
+	return fmt.Sprintf("runtime:%s:authcode:%s", s.deploymentID, code)
+}
+
+// InsertAuthorizationCode serializes the authorization code and stores it in bolt with a TTL.
+func (s *boltAuthorizationCodeStore) InsertAuthorizationCode(
+	_ context.Context, authzCode AuthorizationCode,
+) error {
+	data, err := json.Marshal(authzCode)
+	if err != nil {
+		return fmt.Errorf("failed to marshal authorization code: %w", err)
+	}
+
+	ttl := time.Until(authzCode.ExpiryTime)
+	if ttl <= 0 {
+		return fmt.Errorf("authorization code already expired")
+	}
+	if err := s.client.Set(s.authCodeKey(authzCode.Code), data, ttl); err != nil {
+		return fmt.Errorf("failed to store authorization code in bolt: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeAuthorizationCode atomically transitions an ACTIVE code issued to the client to INACTIVE and
+// returns the consumed code. Returns errAuthorizationCodeNotFound when no active code issued to the
+// client matched, which includes a code that was already consumed.
+func (s *boltAuthorizationCodeStore) ConsumeAuthorizationCode(
+	_ context.Context, clientID, authCode string,
+) (*AuthorizationCode, error) {
+	var result AuthorizationCode
+	err := s.client.Modify(s.authCodeKey(authCode), func(value []byte) ([]byte, error) {
+		if value == nil {
+			return nil, errAuthorizationCodeNotFound
+		}
+		if err := json.Unmarshal(value, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal authorization code: %w", err)
+		}
+		if result.State != AuthCodeStateActive || result.ClientID != clientID {
+			return nil, errAuthorizationCodeNotFound
+		}
+		result.State = AuthCodeStateInactive
+		data, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal authorization code: %w", err)
+		}
+		return data, nil
+	})
+	if err != nil {
+		if errors.Is(err, errAuthorizationCodeNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to consume authorization code: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAuthorizationCode retrieves an authorization code by code value.
+func (s *boltAuthorizationCodeStore) GetAuthorizationCode(
+	_ context.Context, authCode string,
+) (*AuthorizationCode, error) {
+	data, err := s.client.Get(s.authCodeKey(authCode))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authorization code from bolt: %w", err)
+	}
+	if data == nil {
+		return nil, errAuthorizationCodeNotFound
+	}
+
+	var result AuthorizationCode
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal authorization code: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RecordIssuedTokens records the tokens issued from an authorization code, replacing any previously
+// recorded tokens.
+func (s *boltAuthorizationCodeStore) RecordIssuedTokens(
+	_ context.Context, authCode string, tokens []IssuedToken,
+) error {
+	err := s.client.Modify(s.authCodeKey(authCode), func(value []byte) ([]byte, error) {
+		if value == nil {
+			return nil, errAuthorizationCodeNotFound
+		}
+		var record AuthorizationCode
+		if err := json.Unmarshal(value, &record); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal authorization code: %w", err)
+		}
+		record.IssuedTokens = tokens
+		data, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal authorization code: %w", err)
+		}
+		return data, nil
+	})
+	if err != nil {
+		if errors.Is(err, errAuthorizationCodeNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to record issued tokens in bolt: %w", err)
+	}
+	return nil
+}
@@ -30,16 +30,17 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 )
 
-// consumeAuthCodeScript atomically transitions an authorization code from ACTIVE to INACTIVE.
-// Returns 1 on success, 0 if not found or already consumed.
+// consumeAuthCodeScript atomically transitions an authorization code issued to the client from ACTIVE
+// to INACTIVE. Returns the code as stored before the transition, or nil if not found, issued to another
+// client or already consumed.
 var consumeAuthCodeScript = redis.NewScript(`
 local val = redis.call('GET', KEYS[1])
-if not val then return 0 end
+if not val then return false end
 local data = cjson.decode(val)
-if data['State'] ~= ARGV[1] then return 0 end
+if data['State'] ~= ARGV[1] or data['ClientID'] ~= ARGV[3] then return false end
 data['State'] = ARGV[2]
 redis.call('SET', KEYS[1], cjson.encode(data), 'KEEPTTL')
-return 1
+return val
 `)
 
 // authCodeRedisClient abstracts the Redis commands used by the authorization code store.
@@ -92,17 +93,28 @@ func (s *redisAuthorizationCodeStore) InsertAuthorizationCode(
 	return nil
 }
 
-// ConsumeAuthorizationCode atomically transitions an ACTIVE code to INACTIVE.
-// Returns true if consumed, false if not found or already consumed.
+// ConsumeAuthorizationCode atomically transitions an ACTIVE code issued to the client to INACTIVE and
+// returns the consumed code. Returns errAuthorizationCodeNotFound when no active code issued to the
+// client matched, which includes a code that was already consumed.
 func (s *redisAuthorizationCodeStore) ConsumeAuthorizationCode(
-	ctx context.Context, authCode string,
-) (bool, error) {
-	n, err := consumeAuthCodeScript.Run(ctx, s.client, []string{s.authCodeKey(authCode)},
-		AuthCodeStateActive, AuthCodeStateInactive).Int()
-	if err != nil && !errors.Is(err, redis.Nil) {
-		return false, fmt.Errorf("failed to consume authorization code: %w", err)
+	ctx context.Context, clientID, authCode string,
+) (*AuthorizationCode, error) {
+	data, err := consumeAuthCodeScript.Run(ctx, s.client, []string{s.authCodeKey(authCode)},
+		AuthCodeStateActive, AuthCodeStateInactive, clientID).Text()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errAuthorizationCodeNotFound
+		}
+		return nil, fmt.Errorf("failed to consume authorization code: %w", err)
 	}
-	return n == 1, nil
+
+	var result AuthorizationCode
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal authorization code: %w", err)
+	}
+	result.State = AuthCodeStateInactive
+
+	return &result, nil
 }
 
 // GetAuthorizationCode retrieves an authorization code by code value.
@@ -124,3 +136,25 @@ func (s *redisAuthorizationCodeStore) GetAuthorizationCode(
 
 	return &result, nil
 }
+
+// RecordIssuedTokens records the tokens issued from an authorization code, replacing any previously
+// recorded tokens. The code is consumed before tokens are issued from it, so no concurrent write can
+// change the stored code in between.
+func (s *redisAuthorizationCodeStore) RecordIssuedTokens(
+	ctx context.Context, authCode string, tokens []IssuedToken,
+) error {
+	record, err := s.GetAuthorizationCode(ctx, authCode)
+	if err != nil {
+		return err
+	}
+	record.IssuedTokens = tokens
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal authorization code: %w", err)
+	}
+	if err := s.client.Set(ctx, s.authCodeKey(authCode), data, redis.KeepTTL).Err(); err != nil {
+		return fmt.Errorf("failed to record issued tokens in Redis: %w", err)
+	}
+	return nil
+}
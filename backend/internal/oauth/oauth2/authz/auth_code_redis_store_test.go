@@ -164,50 +164,67 @@ func (suite *RedisAuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_Unma
 // Tests for ConsumeAuthorizationCode
 //
 // consumeAuthCodeScript.Run() calls EvalSha with the script's precomputed SHA.
-// The Lua script returns 1 when consumed, 0 when not found or already consumed.
+// The Lua script returns the stored code when consumed, nil when not found, issued to another
+// client or already consumed.
 
 func (suite *RedisAuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_Success() {
+	data, _ := json.Marshal(suite.authCode)
 	cmd := redis.NewCmd(suite.ctx)
-	cmd.SetVal(int64(1))
+	cmd.SetVal(string(data))
 	suite.mockClient.On("EvalSha", suite.ctx, consumeAuthCodeScript.Hash(),
-		[]string{suite.redisKey}, AuthCodeStateActive, AuthCodeStateInactive).Return(cmd)
+		[]string{suite.redisKey}, AuthCodeStateActive, AuthCodeStateInactive, "test-client-id").Return(cmd)
 
-	consumed, err := suite.store.ConsumeAuthorizationCode(suite.ctx, redisTestAuthCode)
+	result, err := suite.store.ConsumeAuthorizationCode(suite.ctx, "test-client-id", redisTestAuthCode)
 	suite.NoError(err)
-	suite.True(consumed)
+	suite.Equal(suite.authCode.CodeID, result.CodeID)
+	suite.Equal(AuthCodeStateInactive, result.State)
 }
 
 func (suite *RedisAuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_AlreadyConsumed() {
-	cmd := redis.NewCmd(suite.ctx)
-	cmd.SetVal(int64(0))
-	suite.mockClient.On("EvalSha", suite.ctx, consumeAuthCodeScript.Hash(),
-		[]string{suite.redisKey}, AuthCodeStateActive, AuthCodeStateInactive).Return(cmd)
-
-	consumed, err := suite.store.ConsumeAuthorizationCode(suite.ctx, redisTestAuthCode)
-	suite.NoError(err)
-	suite.False(consumed)
-}
-
-func (suite *RedisAuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_RedisNil_TreatedAsNotConsumed() {
-	// redis.Nil is treated as "not consumed" rather than an error.
 	cmd := redis.NewCmd(suite.ctx)
 	cmd.SetErr(redis.Nil)
 	suite.mockClient.On("EvalSha", suite.ctx, consumeAuthCodeScript.Hash(),
-		[]string{suite.redisKey}, AuthCodeStateActive, AuthCodeStateInactive).Return(cmd)
+		[]string{suite.redisKey}, AuthCodeStateActive, AuthCodeStateInactive, "test-client-id").Return(cmd)
 
-	consumed, err := suite.store.ConsumeAuthorizationCode(suite.ctx, redisTestAuthCode)
-	suite.NoError(err)
-	suite.False(consumed)
+	result, err := suite.store.ConsumeAuthorizationCode(suite.ctx, "test-client-id", redisTestAuthCode)
+	suite.ErrorIs(err, errAuthorizationCodeNotFound)
+	suite.Nil(result)
 }
 
 func (suite *RedisAuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_ScriptError() {
 	cmd := redis.NewCmd(suite.ctx)
 	cmd.SetErr(errors.New("WRONGTYPE Operation against a key holding the wrong kind of value"))
 	suite.mockClient.On("EvalSha", suite.ctx, consumeAuthCodeScript.Hash(),
-		[]string{suite.redisKey}, AuthCodeStateActive, AuthCodeStateInactive).Return(cmd)
+		[]string{suite.redisKey}, AuthCodeStateActive, AuthCodeStateInactive, "test-client-id").Return(cmd)
 
-	consumed, err := suite.store.ConsumeAuthorizationCode(suite.ctx, redisTestAuthCode)
+	result, err := suite.store.ConsumeAuthorizationCode(suite.ctx, "test-client-id", redisTestAuthCode)
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to consume authorization code")
-	suite.False(consumed)
+	suite.Nil(result)
+}
+
+// Tests for RecordIssuedTokens
+
+func (suite *RedisAuthorizationCodeStoreTestSuite) TestRecordIssuedTokens_Success() {
+	data, _ := json.Marshal(suite.authCode)
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal(string(data))
+	suite.mockClient.On("Get", suite.ctx, suite.redisKey).Return(stringCmd)
+	suite.mockClient.On("Set", suite.ctx, suite.redisKey, mock.MatchedBy(func(v []byte) bool {
+		var stored AuthorizationCode
+		return json.Unmarshal(v, &stored) == nil && len(stored.IssuedTokens) == 1 &&
+			stored.IssuedTokens[0].JTI == "at-jti"
+	}), time.Duration(redis.KeepTTL)).Return(redis.NewStatusCmd(suite.ctx))
+
+	err := suite.store.RecordIssuedTokens(suite.ctx, redisTestAuthCode, []IssuedToken{{JTI: "at-jti"}})
+	suite.NoError(err)
+}
+
+func (suite *RedisAuthorizationCodeStoreTestSuite) TestRecordIssuedTokens_NotFound() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("Get", suite.ctx, suite.redisKey).Return(stringCmd)
+
+	err := suite.store.RecordIssuedTokens(suite.ctx, redisTestAuthCode, []IssuedToken{{JTI: "at-jti"}})
+	suite.ErrorIs(err, errAuthorizationCodeNotFound)
 }
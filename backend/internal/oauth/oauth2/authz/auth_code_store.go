@@ -38,6 +38,7 @@ const (
 	columnNameAuthZData            = "authz_data"
 	columnNameTimeCreated          = "time_created"
 	columnNameExpiryTime           = "expiry_time"
+	columnNameIssuedTokens         = "issued_tokens"
 	jsonDataKeyRedirectURI         = "redirect_uri"
 	jsonDataKeyRedirectURIProvided = "redirect_uri_provided"
 	jsonDataKeyAuthorizedUserID    = "authorized_user_id"
@@ -51,13 +52,15 @@ const (
 	jsonDataKeyNonce               = "nonce"
 	jsonDataKeyCompletedACR        = "completed_acr"
 	jsonDataKeyDPoPJkt             = "dpop_jkt"
+	jsonDataKeyDeviceID            = "device_id"
 )
 
 // AuthorizationCodeStoreInterface defines the interface for managing authorization codes.
 type AuthorizationCodeStoreInterface interface {
 	InsertAuthorizationCode(ctx context.Context, authzCode AuthorizationCode) error
-	ConsumeAuthorizationCode(ctx context.Context, authCode string) (bool, error)
+	ConsumeAuthorizationCode(ctx context.Context, clientID, authCode string) (*AuthorizationCode, error)
 	GetAuthorizationCode(ctx context.Context, authCode string) (*AuthorizationCode, error)
+	RecordIssuedTokens(ctx context.Context, authCode string, tokens []IssuedToken) error
 }
 
 // authorizationCodeStore implements the AuthorizationCodeStoreInterface for managing authorization codes.
@@ -97,21 +100,27 @@ func (acs *authorizationCodeStore) InsertAuthorizationCode(
 	return nil
 }
 
-// ConsumeAuthorizationCode atomically transitions an ACTIVE authorization code to INACTIVE.
-// Returns true if the code was successfully consumed, false if the code was already consumed,
-// and false if a database error occurs.
-func (acs *authorizationCodeStore) ConsumeAuthorizationCode(ctx context.Context, authCode string) (bool, error) {
+// ConsumeAuthorizationCode atomically transitions an ACTIVE authorization code issued to the client
+// to INACTIVE and returns the consumed code. Returns errAuthorizationCodeNotFound when no active code
+// issued to the client matched, which includes a code that was already consumed.
+func (acs *authorizationCodeStore) ConsumeAuthorizationCode(
+	ctx context.Context, clientID, authCode string,
+) (*AuthorizationCode, error) {
 	dbClient, err := acs.dbProvider.GetRuntimeDBClient()
 	if err != nil {
-		return false, fmt.Errorf("failed to get database client: %w", err)
+		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	rowsAffected, err := dbClient.ExecuteContext(ctx, queryConsumeAuthorizationCode,
-		AuthCodeStateInactive, authCode, AuthCodeStateActive, acs.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryConsumeAuthorizationCode,
+		AuthCodeStateInactive, authCode, clientID, AuthCodeStateActive, acs.deploymentID)
 	if err != nil {
-		return false, fmt.Errorf("error consuming authorization code: %w", err)
+		return nil, fmt.Errorf("error consuming authorization code: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, errAuthorizationCodeNotFound
 	}
-	return rowsAffected > 0, nil
+
+	return buildAuthorizationCodeFromResultRow(results[0])
 }
 
 // GetAuthorizationCode retrieves an authorization code by code value.
@@ -135,6 +144,28 @@ func (acs *authorizationCodeStore) GetAuthorizationCode(
 	return buildAuthorizationCodeFromResultRow(row)
 }
 
+// RecordIssuedTokens records the tokens issued from an authorization code, replacing any previously
+// recorded tokens.
+func (acs *authorizationCodeStore) RecordIssuedTokens(
+	ctx context.Context, authCode string, tokens []IssuedToken,
+) error {
+	dbClient, err := acs.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	tokensJSON, err := json.Marshal(tokens)
+	if err != nil {
+		return fmt.Errorf("error marshaling issued tokens to JSON: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateIssuedTokens, string(tokensJSON), authCode,
+		acs.deploymentID); err != nil {
+		return fmt.Errorf("error recording issued tokens: %w", err)
+	}
+	return nil
+}
+
 // getJSONDataBytes prepares the JSON data bytes for the authorization code.
 func (acs *authorizationCodeStore) getJSONDataBytes(authzCode AuthorizationCode) ([]byte, error) {
 	jsonData := map[string]interface{}{
@@ -149,6 +180,7 @@ func (acs *authorizationCodeStore) getJSONDataBytes(authzCode AuthorizationCode)
 		jsonDataKeyNonce:               authzCode.Nonce,
 		jsonDataKeyCompletedACR:        authzCode.CompletedACR,
 		jsonDataKeyDPoPJkt:             authzCode.DPoPJkt,
+		jsonDataKeyDeviceID:            authzCode.DeviceID,
 	}
 
 	// Include user attributes if present
@@ -220,9 +252,40 @@ func buildAuthorizationCodeFromResultRow(row map[string]interface{}) (*Authoriza
 		ExpiryTime:  expiryTime,
 	}
 
+	issuedTokens, err := parseIssuedTokens(row[columnNameIssuedTokens])
+	if err != nil {
+		return nil, err
+	}
+	authzCode.IssuedTokens = issuedTokens
+
 	return appendAuthzDataJSON(row, &authzCode)
 }
 
+// parseIssuedTokens parses the issued tokens recorded for an authorization code. A code from which no
+// tokens were issued has no recorded tokens.
+func parseIssuedTokens(value interface{}) ([]IssuedToken, error) {
+	var tokensJSON []byte
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		tokensJSON = []byte(v)
+	case []byte:
+		tokensJSON = v
+	default:
+		return nil, errors.New("issued_tokens is of unexpected type")
+	}
+	if len(tokensJSON) == 0 {
+		return nil, nil
+	}
+
+	var tokens []IssuedToken
+	if err := json.Unmarshal(tokensJSON, &tokens); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal issued_tokens JSON: %w", err)
+	}
+	return tokens, nil
+}
+
 // appendAuthzDataJSON parses and appends authz_data JSON fields to the AuthorizationCode struct.
 func appendAuthzDataJSON(row map[string]interface{}, authzCode *AuthorizationCode) (*AuthorizationCode, error) {
 	var dataJSON string
@@ -284,6 +347,9 @@ func appendAuthzDataJSON(row map[string]interface{}, authzCode *AuthorizationCod
 	if dpopJkt, ok := authzData[jsonDataKeyDPoPJkt].(string); ok {
 		authzCode.DPoPJkt = dpopJkt
 	}
+	if deviceID, ok := authzData[jsonDataKeyDeviceID].(string); ok {
+		authzCode.DeviceID = deviceID
+	}
 
 	if claimsData, ok := authzData[jsonDataKeyClaimsRequest]; ok && claimsData != nil {
 		claimsRequest, err := parseClaimsRequestFromJSON(claimsData)
@@ -254,13 +254,27 @@ func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_EmptyCode
 
 func (suite *AuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_Success() {
 	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
-	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryConsumeAuthorizationCode,
-		AuthCodeStateInactive, "test-code", AuthCodeStateActive, testDeploymentID).
-		Return(int64(1), nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryConsumeAuthorizationCode,
+		AuthCodeStateInactive, "test-code", "test-client-id", AuthCodeStateActive, testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"code_id":            "test-code-id",
+				"authorization_code": "test-code",
+				"client_id":          "test-client-id",
+				"state":              AuthCodeStateInactive,
+				"authz_data":         `{"authorized_user_id":"test-user-id","scopes":"openid"}`,
+				"time_created":       "2023-01-01 12:00:00",
+				"expiry_time":        "2023-01-01 12:10:00",
+				"issued_tokens":      nil,
+			},
+		}, nil)
 
-	consumed, err := suite.store.ConsumeAuthorizationCode(context.Background(), "test-code")
+	result, err := suite.store.ConsumeAuthorizationCode(context.Background(), "test-client-id", "test-code")
 	assert.NoError(suite.T(), err)
-	assert.True(suite.T(), consumed)
+	assert.Equal(suite.T(), "test-code-id", result.CodeID)
+	assert.Equal(suite.T(), "test-user-id", result.AuthorizedUserID)
+	assert.Equal(suite.T(), AuthCodeStateInactive, result.State)
+	assert.Empty(suite.T(), result.IssuedTokens)
 
 	suite.mockdbProvider.AssertExpectations(suite.T())
 	suite.mockDBClient.AssertExpectations(suite.T())
@@ -268,13 +282,13 @@ func (suite *AuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_Succe
 
 func (suite *AuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_AlreadyConsumed() {
 	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
-	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryConsumeAuthorizationCode,
-		AuthCodeStateInactive, "test-code", AuthCodeStateActive, testDeploymentID).
-		Return(int64(0), nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryConsumeAuthorizationCode,
+		AuthCodeStateInactive, "test-code", "test-client-id", AuthCodeStateActive, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
 
-	consumed, err := suite.store.ConsumeAuthorizationCode(context.Background(), "test-code")
-	assert.NoError(suite.T(), err)
-	assert.False(suite.T(), consumed)
+	result, err := suite.store.ConsumeAuthorizationCode(context.Background(), "test-client-id", "test-code")
+	assert.ErrorIs(suite.T(), err, errAuthorizationCodeNotFound)
+	assert.Nil(suite.T(), result)
 
 	suite.mockdbProvider.AssertExpectations(suite.T())
 	suite.mockDBClient.AssertExpectations(suite.T())
@@ -283,28 +297,100 @@ func (suite *AuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_Alrea
 func (suite *AuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_DBClientError() {
 	suite.mockdbProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
 
-	consumed, err := suite.store.ConsumeAuthorizationCode(context.Background(), "test-code")
+	result, err := suite.store.ConsumeAuthorizationCode(context.Background(), "test-client-id", "test-code")
 	assert.Error(suite.T(), err)
-	assert.False(suite.T(), consumed)
+	assert.Nil(suite.T(), result)
 
 	suite.mockdbProvider.AssertExpectations(suite.T())
 }
 
-func (suite *AuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_ExecuteError() {
+func (suite *AuthorizationCodeStoreTestSuite) TestConsumeAuthorizationCode_QueryError() {
 	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
-	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryConsumeAuthorizationCode,
-		AuthCodeStateInactive, "test-code", AuthCodeStateActive, testDeploymentID).
-		Return(int64(0), errors.New("execute error"))
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryConsumeAuthorizationCode,
+		AuthCodeStateInactive, "test-code", "test-client-id", AuthCodeStateActive, testDeploymentID).
+		Return(nil, errors.New("query error"))
 
-	consumed, err := suite.store.ConsumeAuthorizationCode(context.Background(), "test-code")
+	result, err := suite.store.ConsumeAuthorizationCode(context.Background(), "test-client-id", "test-code")
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "error consuming authorization code")
-	assert.False(suite.T(), consumed)
+	assert.Nil(suite.T(), result)
 
 	suite.mockdbProvider.AssertExpectations(suite.T())
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
 
+func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_WithIssuedTokens() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthorizationCode,
+		"test-code", testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"code_id":            "test-code-id",
+				"authorization_code": "test-code",
+				"client_id":          "test-client-id",
+				"state":              AuthCodeStateInactive,
+				"authz_data":         `{"authorized_user_id":"test-user-id"}`,
+				"time_created":       "2023-01-01 12:00:00",
+				"expiry_time":        "2023-01-01 12:10:00",
+				"issued_tokens":      []byte(`[{"jti":"at-jti","expiryTime":"2023-01-01T13:00:00Z"}]`),
+			},
+		}, nil)
+
+	result, err := suite.store.GetAuthorizationCode(context.Background(), "test-code")
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.IssuedTokens, 1)
+	assert.Equal(suite.T(), "at-jti", result.IssuedTokens[0].JTI)
+	assert.Equal(suite.T(), time.Date(2023, 1, 1, 13, 0, 0, 0, time.UTC), result.IssuedTokens[0].ExpiryTime)
+}
+
+func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_InvalidIssuedTokens() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthorizationCode,
+		"test-code", testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"code_id":            "test-code-id",
+				"authorization_code": "test-code",
+				"client_id":          "test-client-id",
+				"state":              AuthCodeStateInactive,
+				"authz_data":         `{"authorized_user_id":"test-user-id"}`,
+				"time_created":       "2023-01-01 12:00:00",
+				"expiry_time":        "2023-01-01 12:10:00",
+				"issued_tokens":      `{`,
+			},
+		}, nil)
+
+	result, err := suite.store.GetAuthorizationCode(context.Background(), "test-code")
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *AuthorizationCodeStoreTestSuite) TestRecordIssuedTokens() {
+	expiry := time.Date(2023, 1, 1, 13, 0, 0, 0, time.UTC)
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateIssuedTokens,
+		`[{"jti":"at-jti","expiryTime":"2023-01-01T13:00:00Z"}]`, "test-code", testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.RecordIssuedTokens(context.Background(), "test-code",
+		[]IssuedToken{{JTI: "at-jti", ExpiryTime: expiry}})
+	assert.NoError(suite.T(), err)
+
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
+
+func (suite *AuthorizationCodeStoreTestSuite) TestRecordIssuedTokens_ExecuteError() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateIssuedTokens,
+		mock.Anything, "test-code", testDeploymentID).
+		Return(int64(0), errors.New("execute error"))
+
+	err := suite.store.RecordIssuedTokens(context.Background(), "test-code",
+		[]IssuedToken{{JTI: "at-jti"}})
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "error recording issued tokens")
+}
+
 const testTimeString = "2023-12-01 10:30:45.123456789"
 
 func (suite *AuthorizationCodeStoreTestSuite) TestParseTimeField_StringInput() {
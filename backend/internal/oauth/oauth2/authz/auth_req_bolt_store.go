@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// boltAuthorizationRequestStore is the bolt-backed implementation of authorizationRequestStoreInterface.
+type boltAuthorizationRequestStore struct {
+	client         provider.BoltProviderInterface
+	deploymentID   string
+	validityPeriod time.Duration
+}
+
+// newBoltAuthorizationRequestStore creates a new bolt-backed authorization request store.
+func newBoltAuthorizationRequestStore(
+	p provider.BoltProviderInterface, deploymentID string,
+) authorizationRequestStoreInterface {
+	return &boltAuthorizationRequestStore{
+		client:         p,
+		deploymentID:   deploymentID,
+		validityPeriod: 10 * time.Minute,
+	}
+}
+
+// authReqKey builds the bolt key for an authorization request.
+func (s *boltAuthorizationRequestStore) authReqKey(key string) string {
+	return fmt.Sprintf("runtime:%s:authreq:%s", s.deploymentID, key)
+}
+
+// AddRequest adds an authorization request context entry to bolt with a TTL.
+func (s *boltAuthorizationRequestStore) AddRequest(_ context.Context, value authRequestContext) (string, error) {
+	key, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate UUID: %w", err)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request context: %w", err)
+	}
+
+	if err := s.client.Set(s.authReqKey(key), data, s.validityPeriod); err != nil {
+		return "", fmt.Errorf("failed to store authorization request in bolt: %w", err)
+	}
+
+	return key, nil
+}
+
+// GetRequest retrieves an authorization request context entry from bolt.
+func (s *boltAuthorizationRequestStore) GetRequest(
+	_ context.Context, key string,
+) (bool, authRequestContext, error) {
+	if key == "" {
+		return false, authRequestContext{}, nil
+	}
+
+	data, err := s.client.Get(s.authReqKey(key))
+	if err != nil {
+		return false, authRequestContext{}, fmt.Errorf("failed to get authorization request from bolt: %w", err)
+	}
+	if data == nil {
+		return false, authRequestContext{}, nil
+	}
+
+	var result authRequestContext
+	if err := json.Unmarshal(data, &result); err != nil {
+		return false, authRequestContext{}, fmt.Errorf("failed to unmarshal authorization request: %w", err)
+	}
+
+	return true, result, nil
+}
+
+// ClearRequest removes a specific authorization request context entry from bolt.
+func (s *boltAuthorizationRequestStore) ClearRequest(_ context.Context, key string) error {
+	if key == "" {
+		return nil
+	}
+
+	if err := s.client.Del(s.authReqKey(key)); err != nil {
+		return fmt.Errorf("failed to delete authorization request from bolt: %w", err)
+	}
+
+	return nil
+}
@@ -34,6 +34,10 @@ import (
 // authRequestContext holds OAuth authorization request information.
 type authRequestContext struct {
 	OAuthParameters model.OAuthParameters
+	// ApplicationID is the ID of the application the flow assertion must be issued for.
+	ApplicationID string
+	// AssertionNonce is the per-request nonce the flow assertion must carry.
+	AssertionNonce string
 }
 
 // authorizationRequestStoreInterface defines the interface for authorization request storage.
@@ -155,6 +159,8 @@ func (authzRS *authorizationRequestStore) getJSONDataBytes(authRequestCtx authRe
 		jsonKeyClaimsLocales:       authRequestCtx.OAuthParameters.ClaimsLocales,
 		jsonKeyNonce:               authRequestCtx.OAuthParameters.Nonce,
 		jsonKeyDPoPJkt:             authRequestCtx.OAuthParameters.DPoPJkt,
+		jsonKeyApplicationID:       authRequestCtx.ApplicationID,
+		jsonKeyAssertionNonce:      authRequestCtx.AssertionNonce,
 	}
 
 	// Add claims_request if present
@@ -257,9 +263,17 @@ func (authzRS *authorizationRequestStore) buildAuthRequestContextFromResultRow(
 		oauthParams.ClaimsRequest = claimsRequest
 	}
 
-	return authRequestContext{
+	authRequestCtx := authRequestContext{
 		OAuthParameters: oauthParams,
-	}, nil
+	}
+	if applicationID, ok := requestDataMap[jsonKeyApplicationID].(string); ok {
+		authRequestCtx.ApplicationID = applicationID
+	}
+	if assertionNonce, ok := requestDataMap[jsonKeyAssertionNonce].(string); ok {
+		authRequestCtx.AssertionNonce = assertionNonce
+	}
+
+	return authRequestCtx, nil
 }
 
 // convertToStringArray converts []interface{} to []string.
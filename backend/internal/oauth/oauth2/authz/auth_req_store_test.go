@@ -189,6 +189,8 @@ func (suite *AuthorizationRequestStoreTestSuite) TestGetRequest_Success() {
 		"code_challenge":        "test-challenge",
 		"code_challenge_method": "S256",
 		"resource":              []interface{}{"https://api.example.com/resource"},
+		"application_id":        "test-app-id",
+		"assertion_nonce":       "test-nonce",
 	}
 	requestDataJSON, _ := json.Marshal(requestData)
 
@@ -219,6 +221,8 @@ func (suite *AuthorizationRequestStoreTestSuite) TestGetRequest_Success() {
 	assert.Equal(suite.T(), "test-challenge", result.OAuthParameters.CodeChallenge)
 	assert.Equal(suite.T(), "S256", result.OAuthParameters.CodeChallengeMethod)
 	assert.Equal(suite.T(), []string{"https://api.example.com/resource"}, result.OAuthParameters.Resources)
+	assert.Equal(suite.T(), "test-app-id", result.ApplicationID)
+	assert.Equal(suite.T(), "test-nonce", result.AssertionNonce)
 
 	suite.mockdbProvider.AssertExpectations(suite.T())
 	suite.mockDBClient.AssertExpectations(suite.T())
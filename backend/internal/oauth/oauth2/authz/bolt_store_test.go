@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider/bolttest"
+)
+
+const boltTestDeploymentID = "test-bolt-deployment"
+
+type BoltAuthorizationStoreTestSuite struct {
+	suite.Suite
+	codeStore AuthorizationCodeStoreInterface
+	reqStore  authorizationRequestStoreInterface
+	ctx       context.Context
+	authCode  AuthorizationCode
+}
+
+func TestBoltAuthorizationStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(BoltAuthorizationStoreTestSuite))
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) SetupTest() {
+	p := bolttest.NewProvider(suite.T())
+	suite.codeStore = newBoltAuthorizationCodeStore(p, boltTestDeploymentID)
+	suite.reqStore = newBoltAuthorizationRequestStore(p, boltTestDeploymentID)
+	suite.ctx = context.Background()
+	suite.authCode = AuthorizationCode{
+		CodeID:           "test-code-id",
+		Code:             "test-auth-code",
+		ClientID:         "test-client-id",
+		RedirectURI:      "https://client.example.com/callback",
+		AuthorizedUserID: "test-user-id",
+		TimeCreated:      time.Now(),
+		ExpiryTime:       time.Now().Add(10 * time.Minute),
+		Scopes:           "read write",
+		State:            AuthCodeStateActive,
+	}
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestKeys() {
+	suite.Equal("runtime:test-bolt-deployment:authcode:abc",
+		suite.codeStore.(*boltAuthorizationCodeStore).authCodeKey("abc"))
+	suite.Equal("runtime:test-bolt-deployment:authreq:abc",
+		suite.reqStore.(*boltAuthorizationRequestStore).authReqKey("abc"))
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestInsertAndGetAuthorizationCode() {
+	suite.Require().NoError(suite.codeStore.InsertAuthorizationCode(suite.ctx, suite.authCode))
+
+	result, err := suite.codeStore.GetAuthorizationCode(suite.ctx, suite.authCode.Code)
+	suite.Require().NoError(err)
+	suite.Equal(suite.authCode.ClientID, result.ClientID)
+	suite.Equal(AuthCodeStateActive, result.State)
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestInsertAuthorizationCode_AlreadyExpired() {
+	suite.authCode.ExpiryTime = time.Now().Add(-time.Minute)
+
+	suite.Error(suite.codeStore.InsertAuthorizationCode(suite.ctx, suite.authCode))
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestGetAuthorizationCode_NotFound() {
+	_, err := suite.codeStore.GetAuthorizationCode(suite.ctx, "missing")
+	suite.ErrorIs(err, errAuthorizationCodeNotFound)
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestConsumeAuthorizationCode() {
+	suite.Require().NoError(suite.codeStore.InsertAuthorizationCode(suite.ctx, suite.authCode))
+
+	result, err := suite.codeStore.ConsumeAuthorizationCode(suite.ctx, suite.authCode.ClientID, suite.authCode.Code)
+	suite.Require().NoError(err)
+	suite.Equal(AuthCodeStateInactive, result.State)
+
+	stored, err := suite.codeStore.GetAuthorizationCode(suite.ctx, suite.authCode.Code)
+	suite.Require().NoError(err)
+	suite.Equal(AuthCodeStateInactive, stored.State)
+
+	_, err = suite.codeStore.ConsumeAuthorizationCode(suite.ctx, suite.authCode.ClientID, suite.authCode.Code)
+	suite.ErrorIs(err, errAuthorizationCodeNotFound)
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestConsumeAuthorizationCode_OtherClient() {
+	suite.Require().NoError(suite.codeStore.InsertAuthorizationCode(suite.ctx, suite.authCode))
+
+	_, err := suite.codeStore.ConsumeAuthorizationCode(suite.ctx, "other-client", suite.authCode.Code)
+	suite.ErrorIs(err, errAuthorizationCodeNotFound)
+
+	stored, err := suite.codeStore.GetAuthorizationCode(suite.ctx, suite.authCode.Code)
+	suite.Require().NoError(err)
+	suite.Equal(AuthCodeStateActive, stored.State)
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestConsumeAuthorizationCode_NotFound() {
+	_, err := suite.codeStore.ConsumeAuthorizationCode(suite.ctx, suite.authCode.ClientID, "missing")
+	suite.ErrorIs(err, errAuthorizationCodeNotFound)
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestRecordIssuedTokens() {
+	suite.Require().NoError(suite.codeStore.InsertAuthorizationCode(suite.ctx, suite.authCode))
+	tokens := []IssuedToken{{JTI: "jti-1", ExpiryTime: time.Now().Add(time.Hour).UTC().Truncate(time.Second)}}
+
+	suite.Require().NoError(suite.codeStore.RecordIssuedTokens(suite.ctx, suite.authCode.Code, tokens))
+
+	stored, err := suite.codeStore.GetAuthorizationCode(suite.ctx, suite.authCode.Code)
+	suite.Require().NoError(err)
+	suite.Equal(tokens, stored.IssuedTokens)
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestRecordIssuedTokens_NotFound() {
+	err := suite.codeStore.RecordIssuedTokens(suite.ctx, "missing", nil)
+	suite.ErrorIs(err, errAuthorizationCodeNotFound)
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestRequestLifecycle() {
+	reqCtx := authRequestContext{
+		OAuthParameters: model.OAuthParameters{ClientID: "test-client-id", State: "xyz"},
+		ApplicationID:   "app-1",
+	}
+
+	key, err := suite.reqStore.AddRequest(suite.ctx, reqCtx)
+	suite.Require().NoError(err)
+	suite.NotEmpty(key)
+
+	found, result, err := suite.reqStore.GetRequest(suite.ctx, key)
+	suite.Require().NoError(err)
+	suite.True(found)
+	suite.Equal(reqCtx, result)
+
+	suite.Require().NoError(suite.reqStore.ClearRequest(suite.ctx, key))
+
+	found, _, err = suite.reqStore.GetRequest(suite.ctx, key)
+	suite.NoError(err)
+	suite.False(found)
+}
+
+func (suite *BoltAuthorizationStoreTestSuite) TestRequest_EmptyKey() {
+	found, _, err := suite.reqStore.GetRequest(suite.ctx, "")
+	suite.NoError(err)
+	suite.False(found)
+	suite.NoError(suite.reqStore.ClearRequest(suite.ctx, ""))
+}
@@ -25,3 +25,10 @@ const (
 	AuthCodeStateExpired  = "EXPIRED"
 	AuthCodeStateRevoked  = "REVOKED"
 )
+
+// assertionJTINamespace identifies flow assertions consumed by the authorization callback in the
+// shared JTI replay store.
+const assertionJTINamespace = "flow_assertion"
+
+// assertionNonceLength is the byte length of the nonce binding a flow assertion to an authorization request.
+const assertionNonceLength = 32
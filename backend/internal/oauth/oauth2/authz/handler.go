@@ -212,10 +212,11 @@ func (ah *authorizeHandler) getOAuthMessageForPostRequest(r *http.Request) (*OAu
 
 // getLoginPageRedirectURI constructs the login page URL with the provided query parameters.
 func getLoginPageRedirectURI(gateClientConfig oauthconfig.Config, queryParams map[string]string) (string, error) {
+	gateClient := gateClientConfig.CurrentGateClient()
 	loginPageURL := (&url.URL{
-		Scheme: gateClientConfig.GateClient.Scheme,
-		Host:   fmt.Sprintf("%s:%d", gateClientConfig.GateClient.Hostname, gateClientConfig.GateClient.Port),
-		Path:   gateClientConfig.GateClient.LoginPath,
+		Scheme: gateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", gateClient.Hostname, gateClient.Port),
+		Path:   gateClient.LoginPath,
 	}).String()
 
 	return oauth2utils.GetURIWithQueryParams(loginPageURL, queryParams)
@@ -247,10 +248,11 @@ func (ah *authorizeHandler) redirectToLoginPage(w http.ResponseWriter, r *http.R
 
 // getErrorPageRedirectURL constructs the error page URL with the provided error code and message.
 func getErrorPageRedirectURL(cfg oauthconfig.Config, code, msg string) (string, error) {
+	gateClient := cfg.CurrentGateClient()
 	errorPageURL := (&url.URL{
-		Scheme: cfg.GateClient.Scheme,
-		Host:   fmt.Sprintf("%s:%d", cfg.GateClient.Hostname, cfg.GateClient.Port),
-		Path:   cfg.GateClient.ErrorPath,
+		Scheme: gateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", gateClient.Hostname, gateClient.Port),
+		Path:   gateClient.ErrorPath,
 	}).String()
 
 	queryParams := map[string]string{
@@ -742,6 +742,27 @@ func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_Succes
 	assert.Equal(suite.T(), "read write", clms.authorizedPermissions)
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_JTIAndExpiry() {
+	// JWT payload: {"sub":"test-user","jti":"assertion-jti","exp":1701421500}
+	jwtToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJqdGkiOiJhc3NlcnRpb24tanRpIiwiZXhwIjoxNzAxNDIxNTAwfQ."
+
+	clms, _, err := decodeAttributesFromAssertion(jwtToken)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "assertion-jti", clms.jti)
+	assert.Equal(suite.T(), time.Unix(1701421500, 0), clms.expiry)
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_NonNumericExpiry() {
+	// JWT payload: {"sub":"test-user","exp":"soon"}
+	jwtToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0.eyJzdWIiOiJ0ZXN0LXVzZXIiLCJleHAiOiJzb29uIn0."
+
+	_, _, err := decodeAttributesFromAssertion(jwtToken)
+
+	assert.ErrorIs(suite.T(), err, errAssertionClaimInvalid)
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_DecodeError() {
 	invalidJWT := "invalid.jwt.token"
 
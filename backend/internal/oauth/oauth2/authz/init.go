@@ -24,7 +24,10 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
+	scopemgt "github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -40,6 +43,8 @@ func Initialize(
 	jwtService jwt.JWTServiceInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
 	parService par.PARServiceInterface,
+	scopeService scopemgt.ScopeServiceInterface,
+	replayRevoker revocation.CodeReplayRevokerInterface,
 	cfg oauthconfig.Config,
 ) (AuthorizeServiceInterface, error) {
 	authzCodeStore, authzReqStore, transactioner, err := initializeAuthorizationStores(cfg)
@@ -49,7 +54,8 @@ func Initialize(
 
 	authzService := newAuthorizeService(
 		actorProvider, resourceService, jwtService, flowExecService,
-		authzCodeStore, authzReqStore, parService, transactioner, cfg,
+		authzCodeStore, authzReqStore, parService, scopeService, replayRevoker, jti.Initialize(cfg),
+		transactioner, cfg,
 	)
 	authzHandler := newAuthorizeHandler(authzService, cfg)
 	registerRoutes(mux, authzHandler)
@@ -66,6 +72,13 @@ func initializeAuthorizationStores(cfg oauthconfig.Config) (
 			transaction.NewNoOpTransactioner(),
 			nil
 	}
+	if cfg.RuntimeDBType == provider.DataSourceTypeBolt {
+		boltProvider := provider.GetBoltProvider()
+		return newBoltAuthorizationCodeStore(boltProvider, cfg.DeploymentID),
+			newBoltAuthorizationRequestStore(boltProvider, cfg.DeploymentID),
+			transaction.NewNoOpTransactioner(),
+			nil
+	}
 	dbProvider := provider.GetDBProvider()
 	transactioner, err := dbProvider.GetRuntimeDBTransactioner()
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"path/filepath"
 	"testing"
 
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
@@ -54,15 +55,16 @@ func TestInitTestSuite(t *testing.T) {
 
 func (suite *InitTestSuite) SetupTest() {
 	// Initialize Runtime config with basic test config
+	dbPath := filepath.Join(suite.T().TempDir(), "test.db")
 	testConfig := &config.Config{
 		Database: config.DatabaseConfig{
 			Config: config.DataSource{
 				Type:   "sqlite",
-				SQLite: config.SQLiteDataSource{Path: "test.db"},
+				SQLite: config.SQLiteDataSource{Path: dbPath},
 			},
 			Runtime: config.DataSource{
 				Type:   "sqlite",
-				SQLite: config.SQLiteDataSource{Path: "test.db"},
+				SQLite: config.SQLiteDataSource{Path: dbPath},
 			},
 		},
 		GateClient: engineconfig.GateClientConfig{
@@ -93,7 +95,7 @@ func (suite *InitTestSuite) TestInitialize() {
 		mux,
 		actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr()),
 		suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil, testhelpers.OAuthConfig(),
+		suite.mockJWTService, suite.mockFlowExecService, nil, nil, nil, testhelpers.OAuthConfig(),
 	)
 
 	assert.NoError(suite.T(), err)
@@ -108,7 +110,7 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 		mux,
 		actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr()),
 		suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil, testhelpers.OAuthConfig(),
+		suite.mockJWTService, suite.mockFlowExecService, nil, nil, nil, testhelpers.OAuthConfig(),
 	)
 	assert.NoError(suite.T(), err)
 
@@ -125,7 +127,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_CORSConfiguration() {
 		mux,
 		actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr()),
 		suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil, testhelpers.OAuthConfig(),
+		suite.mockJWTService, suite.mockFlowExecService, nil, nil, nil, testhelpers.OAuthConfig(),
 	)
 	assert.NoError(suite.T(), err)
 
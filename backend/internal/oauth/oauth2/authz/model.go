@@ -54,6 +54,15 @@ type AuthorizationCode struct {
 	Nonce               string
 	CompletedACR        string
 	DPoPJkt             string
+	DeviceID            string
+	IssuedTokens        []IssuedToken
+}
+
+// IssuedToken identifies a token issued from an authorization code, kept so the token can be revoked
+// when the code is replayed.
+type IssuedToken struct {
+	JTI        string    `json:"jti"`
+	ExpiryTime time.Time `json:"expiryTime"`
 }
 
 // AuthZPostRequest represents the request body for the authorization POST request.
@@ -83,9 +92,13 @@ type AuthorizationError struct {
 
 // assertionClaims represents the claims extracted from the flow assertion JWT.
 type assertionClaims struct {
-	userID                 string
-	authorizedPermissions  string
-	attributeCacheID       string
-	completedACR           string
-	authorizationRequestID string
+	userID                    string
+	authorizedPermissions     string
+	attributeCacheID          string
+	completedACR              string
+	authorizationRequestID    string
+	authorizationRequestNonce string
+	jti                       string
+	expiry                    time.Time
+	deviceID                  string
 }
@@ -21,6 +21,9 @@ package authz
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"maps"
@@ -33,11 +36,14 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz/requestvalidator"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jti"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	scopemgt "github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
@@ -48,6 +54,7 @@ import (
 // AuthorizeServiceInterface defines the interface for authorization services.
 type AuthorizeServiceInterface interface {
 	GetAuthorizationCodeDetails(ctx context.Context, clientID string, code string) (*AuthorizationCode, error)
+	RecordIssuedTokens(ctx context.Context, code string, tokens []IssuedToken) error
 	HandleInitialAuthorizationRequest(
 		ctx context.Context, msg *OAuthMessage,
 	) (*AuthorizationInitResult, *AuthorizationError)
@@ -65,6 +72,9 @@ type authorizeService struct {
 	parService      par.PARServiceInterface
 	jwtService      jwt.JWTServiceInterface
 	flowExecService flowexec.FlowExecServiceInterface
+	scopeService    scopemgt.ScopeServiceInterface
+	replayRevoker   revocation.CodeReplayRevokerInterface
+	jtiStore        jti.JTIStoreInterface
 	transactioner   transaction.Transactioner
 	logger          *log.Logger
 }
@@ -78,6 +88,9 @@ func newAuthorizeService(
 	authCodeStore AuthorizationCodeStoreInterface,
 	authReqStore authorizationRequestStoreInterface,
 	parService par.PARServiceInterface,
+	scopeService scopemgt.ScopeServiceInterface,
+	replayRevoker revocation.CodeReplayRevokerInterface,
+	jtiStore jti.JTIStoreInterface,
 	transactioner transaction.Transactioner,
 	cfg oauthconfig.Config,
 ) AuthorizeServiceInterface {
@@ -91,38 +104,45 @@ func newAuthorizeService(
 		parService:      parService,
 		jwtService:      jwtService,
 		flowExecService: flowExecService,
+		scopeService:    scopeService,
+		replayRevoker:   replayRevoker,
+		jtiStore:        jtiStore,
 		transactioner:   transactioner,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeService")),
 	}
 }
 
-// GetAuthorizationCodeDetails retrieves and consumes the authorization code.
+// GetAuthorizationCodeDetails consumes the authorization code and returns its details. A code that
+// was already consumed is rejected and the tokens issued from it are revoked (RFC 6749 §4.1.2).
 func (as *authorizeService) GetAuthorizationCodeDetails(
 	ctx context.Context, clientID string, code string,
 ) (*AuthorizationCode, error) {
-	var record *AuthorizationCode
+	var record, replayed *AuthorizationCode
 	err := as.transactioner.Transact(ctx, func(ctx context.Context) error {
 		var err error
-		record, err = as.authCodeStore.GetAuthorizationCode(ctx, code)
-		if err != nil {
+		record, err = as.authCodeStore.ConsumeAuthorizationCode(ctx, clientID, code)
+		if !errors.Is(err, errAuthorizationCodeNotFound) {
 			return err
 		}
 
-		if record.ClientID != clientID {
-			return errors.New("client ID mismatch for authorization code")
-		}
-
-		consumed, err := as.authCodeStore.ConsumeAuthorizationCode(ctx, code)
+		// No active code issued to the client matched. Look the code up to tell a replay apart
+		// from an unknown code or a code presented by another client.
+		existing, err := as.authCodeStore.GetAuthorizationCode(ctx, code)
 		if err != nil {
 			return err
 		}
-		if !consumed {
-			// TODO: Revoke all access tokens already granted for this authorization code
-			// when the code has already been consumed (replay attack detected).
+		if existing.ClientID != clientID {
+			return errors.New("client ID mismatch for authorization code")
+		}
+		if existing.State == AuthCodeStateInactive {
+			replayed = existing
 			return errAuthorizationCodeAlreadyConsumed
 		}
-		return nil
+		return errAuthorizationCodeNotFound
 	})
+	if replayed != nil {
+		as.revokeIssuedTokens(ctx, replayed)
+	}
 	if err != nil {
 		as.logger.Error(ctx, "Failed to get authorization code details", log.Error(err))
 		return nil, err
@@ -130,6 +150,35 @@ func (as *authorizeService) GetAuthorizationCodeDetails(
 	return record, nil
 }
 
+// RecordIssuedTokens records the tokens issued from the authorization code so they can be revoked if
+// the code is replayed.
+func (as *authorizeService) RecordIssuedTokens(ctx context.Context, code string, tokens []IssuedToken) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	if err := as.authCodeStore.RecordIssuedTokens(ctx, code, tokens); err != nil {
+		as.logger.Error(ctx, "Failed to record tokens issued from authorization code", log.Error(err))
+		return err
+	}
+	return nil
+}
+
+// revokeIssuedTokens revokes the tokens issued from a replayed authorization code. A token that cannot
+// be revoked is logged and the remaining tokens are still revoked.
+func (as *authorizeService) revokeIssuedTokens(ctx context.Context, replayed *AuthorizationCode) {
+	as.logger.Warn(ctx, "Authorization code replay detected, revoking the tokens issued from the code",
+		log.String("client_id", replayed.ClientID), log.Int("issued_tokens", len(replayed.IssuedTokens)))
+	if as.replayRevoker == nil {
+		return
+	}
+	for _, token := range replayed.IssuedTokens {
+		if err := as.replayRevoker.RevokeCodeReplayToken(ctx, token.JTI, token.ExpiryTime); err != nil {
+			as.logger.Error(ctx, "Failed to revoke token issued from replayed authorization code",
+				log.Error(err))
+		}
+	}
+}
+
 // HandleInitialAuthorizationRequest processes an initial authorization request from the client.
 // Returns the query params needed to redirect to the login page, or a structured authorization error.
 func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Context, msg *OAuthMessage) (
@@ -220,6 +269,7 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 
 	// Extract claims_locales parameter.
 	claimsLocales := msg.RequestQueryParams[oauth2const.RequestParamClaimsLocales]
+	uiLocales := msg.RequestQueryParams[oauth2const.RequestParamUILocales]
 
 	nonce := msg.RequestQueryParams[oauth2const.RequestParamNonce]
 	acrValues := msg.RequestQueryParams[oauth2const.RequestParamAcrValues]
@@ -287,10 +337,13 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		Resources:           resources,
 		ClaimsRequest:       claimsRequest,
 		ClaimsLocales:       claimsLocales,
+		UILocales:           uiLocales,
 		Nonce:               nonce,
 		AcrValues:           acrValues,
 		DPoPJkt:             dpopJkt,
 		Prompt:              prompt,
+		LoginHint:           msg.RequestQueryParams[oauth2const.RequestParamLoginHint],
+		OrgHandle:           msg.RequestQueryParams[oauth2const.RequestParamOrgHandle],
 	}
 
 	// Set the redirect URI if not provided in the request. Invalid cases are already handled at this point.
@@ -315,12 +368,36 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 func (as *authorizeService) initiateFlowAndStoreRequest(
 	ctx context.Context, oauthParams *oauth2model.OAuthParameters, app *providers.OAuthClient,
 ) (*AuthorizationInitResult, *AuthorizationError) {
-	effectiveAcrValues := requestvalidator.ResolveACRValues(oauthParams.AcrValues, app.AcrValues)
+	effectiveAcrValues, authErr := as.resolveStepUpACRValues(ctx, oauthParams,
+		requestvalidator.ResolveACRValues(oauthParams.AcrValues, app.AcrValues))
+	if authErr != nil {
+		return nil, authErr
+	}
 	essentialAttributes, optionalAttributes := getRequiredAttributes(
 		oauthParams.StandardScopes, oauthParams.ClaimsRequest, oauthParams.ResponseType, app)
 
+	sharedOU, authErr := as.resolveSharedOrganizationUnit(ctx, oauthParams, app)
+	if authErr != nil {
+		return nil, authErr
+	}
+
+	// Bind the flow assertion to this request with a nonce the flow engine must echo back.
+	assertionNonce, nonceErr := generateAssertionNonce()
+	if nonceErr != nil {
+		as.logger.Error(ctx, "Failed to generate the assertion nonce", log.Error(nonceErr))
+		return nil, &AuthorizationError{
+			Code:              oauth2const.ErrorServerError,
+			Message:           "Failed to process authorization request",
+			SendErrorToClient: true,
+			ClientRedirectURI: oauthParams.RedirectURI,
+			State:             oauthParams.State,
+		}
+	}
+
 	authRequestCtx := authRequestContext{
 		OAuthParameters: *oauthParams,
+		ApplicationID:   app.ID,
+		AssertionNonce:  assertionNonce,
 	}
 
 	// Store authorization request context in the store.
@@ -339,19 +416,30 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	// Initiate flow with OAuth context.
 	runtimeData := map[string]string{
 		flowcm.RuntimeKeyClientID:                      oauthParams.ClientID,
+		flowcm.RuntimeKeyRequestedScopes:               utils.StringifyStringArray(oauthParams.StandardScopes, " "),
 		flowcm.RuntimeKeyRequestedPermissions:          utils.StringifyStringArray(oauthParams.PermissionScopes, " "),
 		flowcm.RuntimeKeyRequiredEssentialAttributes:   essentialAttributes,
 		flowcm.RuntimeKeyRequiredOptionalAttributes:    optionalAttributes,
 		flowcm.RuntimeKeyRequiredLocales:               oauthParams.ClaimsLocales,
 		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: fmt.Sprintf("%d", as.resolveUserAttributesCacheTTL(app)),
 		flowcm.RuntimeKeyAuthorizationRequestID:        identifier,
+		flowcm.RuntimeKeyAuthorizationRequestNonce:     assertionNonce,
 	}
 	if effectiveAcrValues != "" {
 		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = effectiveAcrValues
 	}
+	if oauthParams.UILocales != "" {
+		runtimeData[flowcm.RuntimeKeyUILocales] = oauthParams.UILocales
+	}
 	if slices.Contains(strings.Fields(oauthParams.Prompt), oauth2const.PromptConsent) {
 		runtimeData[flowcm.RuntimeKeyForceConsentReprompt] = "true"
 	}
+	if app.EnforceEssentialClaims && essentialAttributes != "" {
+		runtimeData[flowcm.RuntimeKeyEnforceEssentialAttributes] = "true"
+	}
+	if sharedOU != nil {
+		runtimeData[flowcm.RuntimeKeySharedOUID] = sharedOU.OUID
+	}
 	flowInitCtx := &flowexec.FlowInitContext{
 		ApplicationID: app.ID,
 		FlowType:      string(providers.FlowTypeAuthentication),
@@ -359,6 +447,15 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	}
 
 	executionID, flowErr := as.flowExecService.InitiateFlow(ctx, flowInitCtx)
+	if flowErr != nil && flowErr.Code == flowexec.ErrorAccessPolicyDenied.Code {
+		return nil, &AuthorizationError{
+			Code:              oauth2const.ErrorAccessDenied,
+			Message:           "The application does not allow access from the client network or location",
+			SendErrorToClient: true,
+			ClientRedirectURI: oauthParams.RedirectURI,
+			State:             oauthParams.State,
+		}
+	}
 	if flowErr != nil {
 		as.logger.Error(ctx, "Failed to initiate authentication flow",
 			log.String("error_code", flowErr.Code))
@@ -376,6 +473,9 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	queryParams[oauth2const.AuthID] = identifier
 	queryParams[oauth2const.AppID] = app.ID
 	queryParams[oauth2const.ExecutionID] = executionID
+	if sharedOU != nil {
+		queryParams[oauth2const.OrgHandle] = sharedOU.OUHandle
+	}
 
 	// Add insecure warning if the redirect URI is not using TLS.
 	// TODO: May require another redirection to a warn consent page when it directly goes to a federated IDP.
@@ -397,6 +497,47 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	return &AuthorizationInitResult{QueryParams: queryParams}, nil
 }
 
+// resolveSharedOrganizationUnit resolves the shared organization unit the login should be routed to,
+// using the orgHandle hint or, when absent, the email domain of the login_hint. An orgHandle that
+// does not match an organization unit the application is shared with is rejected; an unmatched
+// login_hint falls back to the application's own login.
+func (as *authorizeService) resolveSharedOrganizationUnit(
+	ctx context.Context, oauthParams *oauth2model.OAuthParameters, app *providers.OAuthClient,
+) (*providers.SharedOrganizationUnit, *AuthorizationError) {
+	if oauthParams.OrgHandle == "" && oauthParams.LoginHint == "" {
+		return nil, nil
+	}
+
+	client, svcErr := as.inboundClient.GetInboundClientByID(ctx, app.ID)
+	if svcErr != nil || client == nil {
+		as.logger.Error(ctx, "Failed to retrieve inbound client for organization routing",
+			log.String("client_id", app.ClientID))
+		return nil, &AuthorizationError{
+			Code:              oauth2const.ErrorServerError,
+			Message:           "Failed to process authorization request",
+			SendErrorToClient: true,
+			ClientRedirectURI: oauthParams.RedirectURI,
+			State:             oauthParams.State,
+		}
+	}
+
+	if oauthParams.OrgHandle != "" {
+		sharedOU := client.Sharing.FindByOUHandle(oauthParams.OrgHandle)
+		if sharedOU == nil {
+			return nil, &AuthorizationError{
+				Code:              oauth2const.ErrorInvalidRequest,
+				Message:           "The application is not shared with the requested organization",
+				SendErrorToClient: true,
+				ClientRedirectURI: oauthParams.RedirectURI,
+				State:             oauthParams.State,
+			}
+		}
+		return sharedOU, nil
+	}
+
+	return client.Sharing.FindByEmailDomain(oauthParams.LoginHint), nil
+}
+
 // HandleAuthorizationCallback processes the callback assertion from the flow engine.
 // Returns the client redirect URI (with authorization code) on success, or a structured error.
 func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (
@@ -435,7 +576,7 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 		}
 
 		// Verify the assertion.
-		if err := as.verifyAssertion(ctx, assertion); err != nil {
+		if err := as.verifyAssertion(ctx, assertion, authRequestCtx.ApplicationID); err != nil {
 			as.logger.Debug(ctx, "Assertion verification failed", log.Error(err))
 			authErr = &AuthorizationError{
 				Code:              oauth2const.ErrorInvalidRequest,
@@ -471,8 +612,9 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 			return err
 		}
 
-		// Bind the assertion to the specific authorization request
-		if claims.authorizationRequestID == "" || claims.authorizationRequestID != authID {
+		// Bind the assertion to the specific authorization request and the nonce issued for it.
+		if claims.authorizationRequestID == "" || claims.authorizationRequestID != authID ||
+			!isAssertionNonceValid(claims.authorizationRequestNonce, authRequestCtx.AssertionNonce) {
 			as.logger.Debug(ctx, "Assertion is not bound to the authorization request")
 			authErr = &AuthorizationError{
 				Code:              oauth2const.ErrorAccessDenied,
@@ -484,6 +626,11 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 			return errors.New("assertion not bound to authorization request")
 		}
 
+		// Reject an assertion that is too old or has already been posted to the callback.
+		if authErr, err = as.consumeAssertion(ctx, authRequestCtx, &claims, authTime); authErr != nil {
+			return err
+		}
+
 		if claims.userID == "" {
 			authErr = &AuthorizationError{
 				Code:              oauth2const.ErrorServerError,
@@ -524,6 +671,16 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 			authRequestCtx.OAuthParameters.PermissionScopes = []string{}
 		}
 
+		// Remove the restricted scopes the user may not be granted.
+		if authErr, err = as.enforceScopeRestrictions(ctx, authRequestCtx, claims.userID); authErr != nil {
+			return err
+		}
+
+		// Reject the request when the authentication does not satisfy a requested step-up scope.
+		if authErr, err = as.enforceStepUpScopes(ctx, authRequestCtx, claims.completedACR); authErr != nil {
+			return err
+		}
+
 		// Generate the authorization code.
 		authzCode, err := createAuthorizationCode(as.cfg, authRequestCtx, &claims, authTime)
 		if err != nil {
@@ -589,6 +746,171 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 	return redirectURI, nil
 }
 
+// enforceScopeRestrictions removes the restricted scopes the user may not be granted from the
+// authorization request. A restricted scope configured to reject the request fails it with
+// access_denied.
+func (as *authorizeService) enforceScopeRestrictions(
+	ctx context.Context, authRequestCtx *authRequestContext, userID string,
+) (*AuthorizationError, error) {
+	params := &authRequestCtx.OAuthParameters
+	requested := append(append([]string{}, params.StandardScopes...), params.PermissionScopes...)
+	if as.scopeService == nil || len(requested) == 0 {
+		return nil, nil
+	}
+
+	granted, svcErr := as.scopeService.EnforceScopeRestrictions(ctx, userID, requested)
+	if svcErr != nil {
+		authErr := &AuthorizationError{
+			Code:              oauth2const.ErrorServerError,
+			Message:           "Failed to process authorization request",
+			SendErrorToClient: true,
+			ClientRedirectURI: params.RedirectURI,
+			State:             params.State,
+		}
+		if svcErr.Code == scopemgt.ErrorRestrictedScopeDenied.Code {
+			authErr.Code = oauth2const.ErrorAccessDenied
+			authErr.Message = "The user is not allowed to be granted a requested restricted scope"
+			return authErr, errors.New("restricted scope denied")
+		}
+		return authErr, errors.New("failed to enforce scope restrictions")
+	}
+
+	isDenied := func(scope string) bool { return !slices.Contains(granted, scope) }
+	params.StandardScopes = slices.DeleteFunc(params.StandardScopes, isDenied)
+	params.PermissionScopes = slices.DeleteFunc(params.PermissionScopes, isDenied)
+	return nil, nil
+}
+
+// consumeAssertion rejects a flow assertion older than the configured maximum age and records its jti,
+// so a captured assertion cannot be posted to the callback twice.
+func (as *authorizeService) consumeAssertion(
+	ctx context.Context, authRequestCtx *authRequestContext, claims *assertionClaims, issuedAt time.Time,
+) (*AuthorizationError, error) {
+	params := &authRequestCtx.OAuthParameters
+	rejected := &AuthorizationError{
+		Code:              oauth2const.ErrorInvalidRequest,
+		Message:           "Assertion is expired or has already been used",
+		SendErrorToClient: true,
+		ClientRedirectURI: params.RedirectURI,
+		State:             params.State,
+	}
+
+	if maxAge := as.cfg.OAuth.AuthorizationCode.GetAssertionMaxAge(); maxAge > 0 &&
+		(issuedAt.IsZero() || time.Since(issuedAt) > maxAge) {
+		as.logger.Debug(ctx, "Assertion exceeds the maximum age")
+		return rejected, errors.New("assertion exceeds the maximum age")
+	}
+
+	if as.jtiStore == nil {
+		return nil, nil
+	}
+	if claims.jti == "" {
+		as.logger.Debug(ctx, "Assertion has no jti claim")
+		return rejected, errors.New("assertion has no jti claim")
+	}
+	recorded, err := as.jtiStore.RecordJTI(ctx, assertionJTINamespace, claims.jti, claims.expiry)
+	if err != nil {
+		return &AuthorizationError{
+			Code:              oauth2const.ErrorServerError,
+			Message:           "Failed to process authorization request",
+			SendErrorToClient: true,
+			ClientRedirectURI: params.RedirectURI,
+			State:             params.State,
+		}, fmt.Errorf("failed to record the assertion jti: %w", err)
+	}
+	if !recorded {
+		as.logger.Warn(ctx, "Flow assertion replay detected", log.String("client_id", params.ClientID))
+		return rejected, errors.New("assertion replayed")
+	}
+	return nil, nil
+}
+
+// resolveStepUpACRValues narrows the effective ACR values to the authentication classes that satisfy
+// every requested step-up scope, so the login flow drives the user through a qualifying authentication.
+// When none of the requested ACR values qualify, the qualifying classes themselves are requested.
+func (as *authorizeService) resolveStepUpACRValues(
+	ctx context.Context, oauthParams *oauth2model.OAuthParameters, acrValues string,
+) (string, *AuthorizationError) {
+	requiredACRs, authErr := as.getStepUpACRs(ctx, oauthParams)
+	if authErr != nil || len(requiredACRs) == 0 {
+		return acrValues, authErr
+	}
+
+	scopeNames := slices.Sorted(maps.Keys(requiredACRs))
+	qualifying := slices.Clone(requiredACRs[scopeNames[0]])
+	for _, name := range scopeNames[1:] {
+		qualifying = slices.DeleteFunc(qualifying, func(acr string) bool {
+			return !slices.Contains(requiredACRs[name], acr)
+		})
+	}
+	if len(qualifying) == 0 {
+		return "", &AuthorizationError{
+			Code:              oauth2const.ErrorInvalidScope,
+			Message:           "The requested scopes require authentication classes that cannot be satisfied together",
+			SendErrorToClient: true,
+			ClientRedirectURI: oauthParams.RedirectURI,
+			State:             oauthParams.State,
+		}
+	}
+
+	narrowed := slices.DeleteFunc(strings.Fields(acrValues), func(acr string) bool {
+		return !slices.Contains(qualifying, acr)
+	})
+	if len(narrowed) == 0 {
+		narrowed = qualifying
+	}
+	return strings.Join(narrowed, " "), nil
+}
+
+// enforceStepUpScopes rejects the authorization request with insufficient_user_authentication
+// (RFC 9470) when the completed authentication class does not satisfy a requested step-up scope.
+func (as *authorizeService) enforceStepUpScopes(
+	ctx context.Context, authRequestCtx *authRequestContext, completedACR string,
+) (*AuthorizationError, error) {
+	params := &authRequestCtx.OAuthParameters
+	requiredACRs, authErr := as.getStepUpACRs(ctx, params)
+	if authErr != nil {
+		return authErr, errors.New("failed to resolve step-up scopes")
+	}
+
+	for _, acrs := range requiredACRs {
+		if !slices.Contains(acrs, completedACR) {
+			return &AuthorizationError{
+				Code:              oauth2const.ErrorInsufficientUserAuthentication,
+				Message:           "The authentication does not meet the level required by a requested scope",
+				SendErrorToClient: true,
+				ClientRedirectURI: params.RedirectURI,
+				State:             params.State,
+			}, errors.New("insufficient user authentication")
+		}
+	}
+	return nil, nil
+}
+
+// getStepUpACRs returns the required ACR values of the requested scopes that declare them.
+func (as *authorizeService) getStepUpACRs(
+	ctx context.Context, params *oauth2model.OAuthParameters,
+) (map[string][]string, *AuthorizationError) {
+	requested := append(append([]string{}, params.StandardScopes...), params.PermissionScopes...)
+	if as.scopeService == nil || len(requested) == 0 {
+		return nil, nil
+	}
+
+	requiredACRs, svcErr := as.scopeService.GetRequiredACRs(ctx, requested)
+	if svcErr != nil {
+		as.logger.Error(ctx, "Failed to resolve the required ACR values of the requested scopes",
+			log.String("error_code", svcErr.Code))
+		return nil, &AuthorizationError{
+			Code:              oauth2const.ErrorServerError,
+			Message:           "Failed to process authorization request",
+			SendErrorToClient: true,
+			ClientRedirectURI: params.RedirectURI,
+			State:             params.State,
+		}
+	}
+	return requiredACRs, nil
+}
+
 // loadAuthRequestContext loads the authorization request context from the store using the auth ID.
 func (as *authorizeService) loadAuthRequestContext(ctx context.Context, authID string) (*authRequestContext, error) {
 	ok, authRequestCtx, err := as.authReqStore.GetRequest(ctx, authID)
@@ -609,14 +931,32 @@ func (as *authorizeService) loadAuthRequestContext(ctx context.Context, authID s
 }
 
 // verifyAssertion verifies the JWT assertion.
-func (as *authorizeService) verifyAssertion(ctx context.Context, assertion string) error {
-	if err := as.jwtService.VerifyJWT(ctx, assertion, "", ""); err != nil {
-		as.logger.Debug(ctx, "Invalid assertion signature", log.String("error", err.Error.DefaultValue))
-		return errors.New("invalid assertion signature")
+func (as *authorizeService) verifyAssertion(ctx context.Context, assertion, applicationID string) error {
+	if applicationID == "" {
+		return errors.New("authorization request is not bound to an application")
+	}
+	if err := as.jwtService.VerifyJWT(ctx, assertion, applicationID, as.cfg.JWT.Issuer); err != nil {
+		as.logger.Debug(ctx, "Invalid assertion", log.String("error", err.Error.DefaultValue))
+		return errors.New("invalid assertion")
 	}
 	return nil
 }
 
+// generateAssertionNonce generates the random nonce that binds a flow assertion to an authorization request.
+func generateAssertionNonce() (string, error) {
+	nonce := make([]byte, assertionNonceLength)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes for the assertion nonce: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(nonce), nil
+}
+
+// isAssertionNonceValid reports whether the assertion carries the nonce issued for the authorization request.
+func isAssertionNonceValid(assertionNonce, expectedNonce string) bool {
+	return expectedNonce != "" &&
+		subtle.ConstantTimeCompare([]byte(assertionNonce), []byte(expectedNonce)) == 1
+}
+
 // decodeAttributesFromAssertion decodes user attributes from the flow assertion JWT using the
 // shared base decoder. authorized_permissions is auth-code-specific and extracted separately
 // from the raw payload returned alongside the common claims.
@@ -636,6 +976,10 @@ func decodeAttributesFromAssertion(assertion string) (assertionClaims, time.Time
 		claims.authorizedPermissions = v
 	}
 
+	if v, ok := payload[oauth2const.ClaimDeviceID].(string); ok {
+		claims.deviceID = v
+	}
+
 	if v, ok := payload[oauth2const.ClaimAuthorizationRequestID]; ok {
 		strValue, ok := v.(string)
 		if !ok {
@@ -645,6 +989,28 @@ func decodeAttributesFromAssertion(assertion string) (assertionClaims, time.Time
 		claims.authorizationRequestID = strValue
 	}
 
+	if v, ok := payload[oauth2const.ClaimJTI].(string); ok {
+		claims.jti = v
+	}
+
+	if v, ok := payload["exp"]; ok {
+		exp, ok := utils.ToInt64(v)
+		if !ok {
+			return assertionClaims{}, time.Time{}, fmt.Errorf(
+				"%w: 'exp' claim is not a number", errAssertionClaimInvalid)
+		}
+		claims.expiry = time.Unix(exp, 0)
+	}
+
+	if v, ok := payload[oauth2const.ClaimAuthorizationRequestNonce]; ok {
+		strValue, ok := v.(string)
+		if !ok {
+			return assertionClaims{}, time.Time{}, fmt.Errorf(
+				"%w: 'authorization_request_nonce' claim is not a string", errAssertionClaimInvalid)
+		}
+		claims.authorizationRequestNonce = strValue
+	}
+
 	return claims, base.AuthTime, nil
 }
 
@@ -710,6 +1076,7 @@ func createAuthorizationCode(
 		Nonce:               authRequestCtx.OAuthParameters.Nonce,
 		CompletedACR:        claims.completedACR,
 		DPoPJkt:             authRequestCtx.OAuthParameters.DPoPJkt,
+		DeviceID:            claims.deviceID,
 	}, nil
 }
 
@@ -915,10 +1282,12 @@ func validateSubClaimConstraint(claimsRequest *oauth2model.ClaimsRequest, actual
 // A fixed buffer of attributeCacheTTLBufferSeconds is added to cover the window between
 // authentication completion and token issuance.
 func (as *authorizeService) resolveUserAttributesCacheTTL(app *providers.OAuthClient) int64 {
-	maxTTL := tokenservice.ResolveTokenConfig(
-		as.cfg, app, tokenservice.TokenTypeAccess, app.UserAccessTokenConfig().ValidityPeriodOrZero()).ValidityPeriod
+	grantType := string(providers.GrantTypeAuthorizationCode)
+	maxTTL := tokenservice.ResolveTokenConfig(as.cfg, app, tokenservice.TokenTypeAccess, grantType,
+		app.UserAccessTokenConfig().ValidityPeriodOrZero()).ValidityPeriod
 	if app.IsAllowedGrantType(providers.GrantTypeRefreshToken) {
-		refreshTTL := tokenservice.ResolveTokenConfig(as.cfg, app, tokenservice.TokenTypeRefresh, 0).ValidityPeriod
+		refreshTTL := tokenservice.ResolveTokenConfig(
+			as.cfg, app, tokenservice.TokenTypeRefresh, grantType, 0).ValidityPeriod
 		if refreshTTL > maxTTL {
 			maxTTL = refreshTTL
 		}
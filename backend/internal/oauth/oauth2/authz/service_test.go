@@ -23,6 +23,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -39,6 +40,7 @@ import (
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	scopemgt "github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -47,6 +49,9 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/jtimock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/revocationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/scopemock"
 )
 
 func authorizeServiceCfgFromRuntime() oauthconfig.Config {
@@ -70,16 +75,31 @@ func (s *stubTransactioner) Transact(ctx context.Context, txFunc func(context.Co
 }
 
 // JWT constants used in service tests. All happy-path assertions are bound to testAuthID via
-// the authorization_request_id claim so they pass the assertion<->authorization request binding check.
+// the authorization_request_id claim and carry testAssertionNonce, so they pass the
+// assertion<->authorization request binding check.
 const (
+	testAssertionAppID = "test-app-id"
+	testAssertionNonce = "test-nonce"
+	testIssuer         = "https://localhost:8090"
+
 	// Header: {"alg":"none","typ":"JWT"}
-	// Payload: {"sub":"test-user","iat":1701421200,"authorization_request_id":"test-auth-id"}
+	// Payload: {"sub":"test-user","iat":1701421200,"authorization_request_id":"test-auth-id",
+	//   "authorization_request_nonce":"test-nonce"}
 	svcJWTWithIat = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
-		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE3MDE0MjEyMDAsImF1dGhvcml6YXRpb25fcmVxdWVzdF9pZCI6InRlc3QtYXV0aC1pZCJ9."
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE3MDE0MjEyMDAsImF1dGhvcml6YXRpb25fcmVxdWVzdF9pZCI6InRlc3QtYXV0aC1p" +
+		"ZCIsImF1dGhvcml6YXRpb25fcmVxdWVzdF9ub25jZSI6InRlc3Qtbm9uY2UifQ."
 	// Header: {"alg":"none","typ":"JWT"}
-	// Payload: {"sub":"test-user","authorization_request_id":"test-auth-id"}
+	// Payload: {"sub":"test-user","authorization_request_id":"test-auth-id",
+	//   "authorization_request_nonce":"test-nonce"}
 	svcJWTMinimal = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
-		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJhdXRob3JpemF0aW9uX3JlcXVlc3RfaWQiOiJ0ZXN0LWF1dGgtaWQifQ."
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJhdXRob3JpemF0aW9uX3JlcXVlc3RfaWQiOiJ0ZXN0LWF1dGgtaWQiLCJhdXRob3JpemF0aW9u" +
+		"X3JlcXVlc3Rfbm9uY2UiOiJ0ZXN0LW5vbmNlIn0."
+	// Header: {"alg":"none","typ":"JWT"}
+	// Payload: {"sub":"test-user","iat":1701421200,"authorization_request_id":"test-auth-id",
+	//   "authorization_request_nonce":"other-nonce"}
+	svcJWTWrongNonce = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE3MDE0MjEyMDAsImF1dGhvcml6YXRpb25fcmVxdWVzdF9pZCI6InRlc3QtYXV0aC1p" +
+		"ZCIsImF1dGhvcml6YXRpb25fcmVxdWVzdF9ub25jZSI6Im90aGVyLW5vbmNlIn0."
 	// Header: {"alg":"none","typ":"JWT"}
 	// Payload: {"sub":"test-user","iat":1701421200} — no authorization_request_id claim (unbound).
 	svcJWTUnbound = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0.eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE3MDE0MjEyMDB9."
@@ -102,6 +122,7 @@ type AuthorizeServiceTestSuite struct {
 	mockAuthReqStore    *authorizationRequestStoreInterfaceMock
 	mockFlowExecService *flowexecmock.FlowExecServiceInterfaceMock
 	mockValidator       *AuthorizationValidatorInterfaceMock
+	mockReplayRevoker   *revocationmock.CodeReplayRevokerInterfaceMock
 }
 
 func TestAuthorizeServiceTestSuite(t *testing.T) {
@@ -123,7 +144,7 @@ func (suite *AuthorizeServiceTestSuite) BeforeTest(suiteName, testName string) {
 			Runtime: config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: ":memory:"}},
 		},
 		JWT: engineconfig.JWTConfig{
-			Issuer: "https://localhost:8090",
+			Issuer: testIssuer,
 		},
 		OAuth: engineconfig.OAuthConfig{
 			AuthorizationCode: engineconfig.AuthorizationCodeConfig{ValidityPeriod: 600},
@@ -140,18 +161,24 @@ func (suite *AuthorizeServiceTestSuite) SetupTest() {
 	suite.mockAuthReqStore = newAuthorizationRequestStoreInterfaceMock(suite.T())
 	suite.mockFlowExecService = flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	suite.mockValidator = NewAuthorizationValidatorInterfaceMock(suite.T())
+	suite.mockReplayRevoker = revocationmock.NewCodeReplayRevokerInterfaceMock(suite.T())
 }
 
-// newService builds an authorizeService with all mocked dependencies.
+// newService builds an authorizeService with all mocked dependencies. The assertion age check is
+// disabled because the assertion fixtures carry a fixed iat.
 func (suite *AuthorizeServiceTestSuite) newService() *authorizeService {
+	cfg := authorizeServiceCfgFromRuntime()
+	disabled := int64(0)
+	cfg.OAuth.AuthorizationCode.AssertionMaxAge = &disabled
 	return &authorizeService{
-		cfg:             authorizeServiceCfgFromRuntime(),
+		cfg:             cfg,
 		inboundClient:   actorprovider.Initialize(suite.mockInboundClient, suite.mockEntityProvider, noopAuthnMgr()),
 		authZValidator:  suite.mockValidator,
 		authCodeStore:   suite.mockAuthzCodeStore,
 		authReqStore:    suite.mockAuthReqStore,
 		jwtService:      suite.mockJWTService,
 		flowExecService: suite.mockFlowExecService,
+		replayRevoker:   suite.mockReplayRevoker,
 		transactioner:   &stubTransactioner{},
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeServiceTest")),
 	}
@@ -301,6 +328,26 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Fl
 	assert.Equal(suite.T(), "test-state", authErr.State)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AccessPolicyDenied() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+		Return(false, "", "")
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).
+		Return("", &flowexec.ErrorAccessPolicyDenied)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.testMsg())
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorAccessDenied, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "https://client.example.com/callback", authErr.ClientRedirectURI)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_StoreRequestError() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -449,6 +496,148 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Wi
 	assert.NotNil(suite.T(), result)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_WithUILocales() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+		Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.AnythingOfType("*flowexec.FlowInitContext")).
+		Run(func(_ context.Context, initContext *flowexec.FlowInitContext) {
+			assert.Equal(suite.T(), "fr-CA en", initContext.RuntimeData[flowcm.RuntimeKeyUILocales])
+		}).
+		Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, authRequestCtx authRequestContext) {
+			assert.Equal(suite.T(), "fr-CA en", authRequestCtx.OAuthParameters.UILocales)
+		}).
+		Return(testAuthID, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":     "test-client-id",
+			"redirect_uri":  "https://client.example.com/callback",
+			"response_type": "code",
+			"scope":         "openid",
+			"ui_locales":    "fr-CA en",
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
+// sharedInboundClient returns an inbound client shared with the "acme" organization unit.
+func (suite *AuthorizeServiceTestSuite) sharedInboundClient() *providers.InboundClient {
+	return &providers.InboundClient{
+		ID: "test-app-id",
+		Sharing: &providers.ApplicationSharing{
+			OrganizationUnits: []providers.SharedOrganizationUnit{
+				{OUID: "ou-acme", OUHandle: "acme", EmailDomains: []string{"acme.com"}},
+			},
+		},
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_WithOrgHandle() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, "test-app-id").
+		Return(suite.sharedInboundClient(), nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+		Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.AnythingOfType("*flowexec.FlowInitContext")).
+		Run(func(_ context.Context, initContext *flowexec.FlowInitContext) {
+			assert.Equal(suite.T(), "ou-acme", initContext.RuntimeData[flowcm.RuntimeKeySharedOUID])
+		}).
+		Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":     "test-client-id",
+			"redirect_uri":  "https://client.example.com/callback",
+			"response_type": "code",
+			"scope":         "openid",
+			"orgHandle":     "ACME",
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "acme", result.QueryParams[oauth2const.OrgHandle])
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_WithUnknownOrgHandle() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, "test-app-id").
+		Return(suite.sharedInboundClient(), nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+		Return(false, "", "")
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":     "test-client-id",
+			"redirect_uri":  "https://client.example.com/callback",
+			"response_type": "code",
+			"scope":         "openid",
+			"orgHandle":     "globex",
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_WithLoginHintDomain() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, "test-app-id").
+		Return(suite.sharedInboundClient(), nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+		Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.AnythingOfType("*flowexec.FlowInitContext")).
+		Run(func(_ context.Context, initContext *flowexec.FlowInitContext) {
+			assert.Equal(suite.T(), "ou-acme", initContext.RuntimeData[flowcm.RuntimeKeySharedOUID])
+		}).
+		Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":     "test-client-id",
+			"redirect_uri":  "https://client.example.com/callback",
+			"response_type": "code",
+			"scope":         "openid",
+			"login_hint":    "alice@Acme.com",
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SetsRuntimeRequiredAttrs() {
 	app := suite.testApp()
 	app.Token = &providers.OAuthTokenConfig{
@@ -463,6 +652,7 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Se
 		UserAttributes: []string{"phone_number"},
 	}
 
+	var storedCtx authRequestContext
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
 	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
 		Return(false, "", "")
@@ -473,13 +663,20 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Se
 			assert.Equal(suite.T(), string(providers.FlowTypeAuthentication), initContext.FlowType)
 			assert.Equal(suite.T(), "test-client-id", initContext.RuntimeData[flowcm.RuntimeKeyClientID])
 			assert.Equal(suite.T(), testAuthID, initContext.RuntimeData[flowcm.RuntimeKeyAuthorizationRequestID])
+			assert.Equal(suite.T(), storedCtx.AssertionNonce,
+				initContext.RuntimeData[flowcm.RuntimeKeyAuthorizationRequestNonce])
 			assert.ElementsMatch(suite.T(), []string{"email"},
 				strings.Fields(initContext.RuntimeData[flowcm.RuntimeKeyRequiredEssentialAttributes]))
 			assert.ElementsMatch(suite.T(), []string{"user_id", "phone_number"},
 				strings.Fields(initContext.RuntimeData[flowcm.RuntimeKeyRequiredOptionalAttributes]))
+			assert.NotContains(suite.T(), initContext.RuntimeData, flowcm.RuntimeKeyEnforceEssentialAttributes)
 		}).
 		Return("test-flow-id", nil)
-	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, value authRequestContext) {
+			storedCtx = value
+		}).
+		Return(testAuthID, nil)
 
 	msg := &OAuthMessage{
 		RequestType: oauth2const.TypeInitialAuthorizationRequest,
@@ -498,6 +695,73 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Se
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), "test-flow-id", result.QueryParams[oauth2const.ExecutionID])
+	assert.Equal(suite.T(), "test-app-id", storedCtx.ApplicationID)
+	assert.NotEmpty(suite.T(), storedCtx.AssertionNonce)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_EnforceEssentialClaims() {
+	testCases := []struct {
+		name           string
+		claims         string
+		expectEnforced bool
+	}{
+		{
+			name:           "EssentialClaimRequested",
+			claims:         `{"id_token":{"email":{"essential":true}}}`,
+			expectEnforced: true,
+		},
+		{
+			name:           "NoEssentialClaimRequested",
+			claims:         `{"id_token":{"email":null}}`,
+			expectEnforced: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			app := suite.testApp()
+			app.EnforceEssentialClaims = true
+			app.Token = &providers.OAuthTokenConfig{
+				IDToken: &providers.IDTokenConfig{UserAttributes: []string{"email"}},
+			}
+
+			suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").
+				Return(app, nil)
+			suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, mock.Anything, app).
+				Return(false, "", "")
+			suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+				mock.AnythingOfType("*flowexec.FlowInitContext")).
+				Run(func(_ context.Context, initContext *flowexec.FlowInitContext) {
+					if tc.expectEnforced {
+						assert.Equal(suite.T(), "true",
+							initContext.RuntimeData[flowcm.RuntimeKeyEnforceEssentialAttributes])
+					} else {
+						assert.NotContains(suite.T(), initContext.RuntimeData,
+							flowcm.RuntimeKeyEnforceEssentialAttributes)
+					}
+				}).
+				Return("test-flow-id", nil)
+			suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+			msg := &OAuthMessage{
+				RequestType: oauth2const.TypeInitialAuthorizationRequest,
+				RequestQueryParams: map[string]string{
+					"client_id":     "test-client-id",
+					"redirect_uri":  "https://client.example.com/callback",
+					"response_type": "code",
+					"scope":         "openid",
+					"claims":        tc.claims,
+				},
+			}
+
+			svc := suite.newService()
+			result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+			assert.Nil(suite.T(), authErr)
+			assert.NotNil(suite.T(), result)
+		})
+	}
 }
 
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_InvalidAuthID() {
@@ -530,6 +794,8 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_MissingA
 			RedirectURI: "https://client.example.com/callback",
 			State:       "test-state",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
@@ -552,11 +818,14 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_InvalidA
 			RedirectURI: "https://client.example.com/callback",
 			State:       "test-state",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
 	suite.mockJWTService.EXPECT().
-		VerifyJWT(mock.Anything, "invalid-assertion", "", "").Return(&jwt.ErrorInvalidTokenSignature)
+		VerifyJWT(mock.Anything, "invalid-assertion", testAssertionAppID, testIssuer).
+		Return(&jwt.ErrorInvalidTokenSignature)
 
 	svc := suite.newService()
 	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "invalid-assertion")
@@ -576,11 +845,13 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FailedTo
 			RedirectURI: "https://client.example.com/callback",
 			State:       "test-state",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
 	// VerifyJWT succeeds but "not.valid.jwt" cannot be decoded as a valid JWT payload.
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, "not.valid.jwt", "", "").Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, "not.valid.jwt", testAssertionAppID, testIssuer).Return(nil)
 
 	svc := suite.newService()
 	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "not.valid.jwt")
@@ -602,10 +873,12 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_UnboundA
 			RedirectURI: "https://client.example.com/callback",
 			State:       "test-state",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTUnbound, "", "").Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTUnbound, testAssertionAppID, testIssuer).Return(nil)
 
 	svc := suite.newService()
 	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTUnbound)
@@ -627,10 +900,12 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_Mismatch
 			RedirectURI: "https://client.example.com/callback",
 			State:       "test-state",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTMismatched, "", "").Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTMismatched, testAssertionAppID, testIssuer).Return(nil)
 
 	svc := suite.newService()
 	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTMismatched)
@@ -641,6 +916,78 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_Mismatch
 	assert.Equal(suite.T(), "Assertion does not match the authorization request", authErr.Message)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WrongAssertionNonce() {
+	// Assertion is bound to the request ID but carries a nonce issued for another request → must reject.
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+			State:       "test-state",
+		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().
+		VerifyJWT(mock.Anything, svcJWTWrongNonce, testAssertionAppID, testIssuer).Return(nil)
+
+	svc := suite.newService()
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWrongNonce)
+
+	assert.Empty(suite.T(), redirectURI)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorAccessDenied, authErr.Code)
+	assert.Equal(suite.T(), "Assertion does not match the authorization request", authErr.Message)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_RequestWithoutNonce() {
+	// A request stored without an assertion nonce cannot be completed, even by a matching assertion.
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+		},
+		ApplicationID: testAssertionAppID,
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().
+		VerifyJWT(mock.Anything, svcJWTWithIat, testAssertionAppID, testIssuer).Return(nil)
+
+	svc := suite.newService()
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Empty(suite.T(), redirectURI)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorAccessDenied, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_RequestWithoutApplication() {
+	// Without the application the assertion audience cannot be checked, so the assertion is rejected
+	// before its signature is verified.
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+			State:       "test-state",
+		},
+		AssertionNonce: testAssertionNonce,
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+
+	svc := suite.newService()
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Empty(suite.T(), redirectURI)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "VerifyJWT", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_NonStringAuthReqID() {
 	// Assertion's authorization_request_id claim is not a string → malformed client input,
 	// mapped to invalid_request rather than server_error.
@@ -650,10 +997,13 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_NonStrin
 			RedirectURI: "https://client.example.com/callback",
 			State:       "test-state",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTNonStringAuthReqID, "", "").Return(nil)
+	suite.mockJWTService.EXPECT().
+		VerifyJWT(mock.Anything, svcJWTNonStringAuthReqID, testAssertionAppID, testIssuer).Return(nil)
 
 	svc := suite.newService()
 	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTNonStringAuthReqID)
@@ -673,10 +1023,12 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_PersistA
 			RedirectURI: "https://client.example.com/callback",
 			State:       "test-state",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, "", "").Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, testAssertionAppID, testIssuer).Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().
 		InsertAuthorizationCode(mock.Anything, mock.Anything).
 		Return(errors.New("db error"))
@@ -698,10 +1050,12 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_Success(
 			ClientID:    "test-client",
 			RedirectURI: "https://client.example.com/callback",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, "", "").Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, testAssertionAppID, testIssuer).Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
 	svc := suite.newService()
@@ -721,10 +1075,12 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WithStat
 			RedirectURI: "https://client.example.com/callback",
 			State:       "test-state-123",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, "", "").Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, testAssertionAppID, testIssuer).Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
 	svc := suite.newService()
@@ -745,10 +1101,12 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_EmptyAut
 			RedirectURI:      "https://client.example.com/callback",
 			PermissionScopes: []string{"read", "write"},
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, "", "").Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTWithIat, testAssertionAppID, testIssuer).Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
 	svc := suite.newService()
@@ -765,10 +1123,12 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_CreateAu
 			ClientID:    "",
 			RedirectURI: "https://client.example.com/callback",
 		},
+		ApplicationID:  testAssertionAppID,
+		AssertionNonce: testAssertionNonce,
 	}
 	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
-	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTMinimal, "", "").Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(mock.Anything, svcJWTMinimal, testAssertionAppID, testIssuer).Return(nil)
 
 	svc := suite.newService()
 	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTMinimal)
@@ -778,7 +1138,21 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_CreateAu
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_ConsumeError() {
+	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "client-id", "code").
+		Return(nil, errors.New("database error"))
+
+	svc := suite.newService()
+	result, err := svc.GetAuthorizationCodeDetails(context.Background(), "client-id", "code")
+
+	assert.Nil(suite.T(), result)
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "database error")
+}
+
 func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_GetError() {
+	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "client-id", "code").
+		Return(nil, errAuthorizationCodeNotFound)
 	suite.mockAuthzCodeStore.EXPECT().GetAuthorizationCode(mock.Anything, "code").
 		Return(nil, errors.New("database error"))
 
@@ -791,6 +1165,8 @@ func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_GetError
 }
 
 func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_NotFound() {
+	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "client-id", "invalid-code").
+		Return(nil, errAuthorizationCodeNotFound)
 	suite.mockAuthzCodeStore.EXPECT().GetAuthorizationCode(mock.Anything, "invalid-code").
 		Return(nil, errAuthorizationCodeNotFound)
 
@@ -803,11 +1179,14 @@ func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_NotFound
 
 func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_ClientIDMismatch() {
 	authCode := &AuthorizationCode{
-		CodeID:   "code-id-123",
-		Code:     "valid-code",
-		ClientID: "other-client-id",
-		State:    AuthCodeStateActive,
+		CodeID:       "code-id-123",
+		Code:         "valid-code",
+		ClientID:     "other-client-id",
+		State:        AuthCodeStateInactive,
+		IssuedTokens: []IssuedToken{{JTI: "at-jti"}},
 	}
+	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "client-id", "valid-code").
+		Return(nil, errAuthorizationCodeNotFound)
 	suite.mockAuthzCodeStore.EXPECT().GetAuthorizationCode(mock.Anything, "valid-code").
 		Return(authCode, nil)
 
@@ -817,39 +1196,30 @@ func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_ClientID
 	assert.Nil(suite.T(), result)
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "client ID mismatch")
+	suite.mockReplayRevoker.AssertNotCalled(suite.T(), "RevokeCodeReplayToken", mock.Anything, mock.Anything,
+		mock.Anything)
 }
 
-func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_ConsumeError() {
-	record := &AuthorizationCode{
-		CodeID:   "code-id-123",
-		Code:     "code",
-		ClientID: "client-id",
-		State:    AuthCodeStateActive,
-	}
-	suite.mockAuthzCodeStore.EXPECT().GetAuthorizationCode(mock.Anything, "code").
-		Return(record, nil)
-	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "code").
-		Return(false, errors.New("database error"))
-
-	svc := suite.newService()
-	result, err := svc.GetAuthorizationCodeDetails(context.Background(), "client-id", "code")
-
-	assert.Nil(suite.T(), result)
-	assert.Error(suite.T(), err)
-	assert.Contains(suite.T(), err.Error(), "database error")
-}
-
-func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_AlreadyConsumed() {
+func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_AlreadyConsumed_RevokesIssuedTokens() {
+	atExpiry := time.Now().Add(time.Hour)
+	rtExpiry := time.Now().Add(24 * time.Hour)
 	record := &AuthorizationCode{
 		CodeID:   "code-id-123",
 		Code:     "code",
 		ClientID: "client-id",
 		State:    AuthCodeStateInactive,
+		IssuedTokens: []IssuedToken{
+			{JTI: "at-jti", ExpiryTime: atExpiry},
+			{JTI: "rt-jti", ExpiryTime: rtExpiry},
+		},
 	}
+	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "client-id", "code").
+		Return(nil, errAuthorizationCodeNotFound)
 	suite.mockAuthzCodeStore.EXPECT().GetAuthorizationCode(mock.Anything, "code").
 		Return(record, nil)
-	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "code").
-		Return(false, nil)
+	suite.mockReplayRevoker.EXPECT().RevokeCodeReplayToken(mock.Anything, "at-jti", atExpiry).
+		Return(errors.New("operation database unavailable"))
+	suite.mockReplayRevoker.EXPECT().RevokeCodeReplayToken(mock.Anything, "rt-jti", rtExpiry).Return(nil)
 
 	svc := suite.newService()
 	result, err := svc.GetAuthorizationCodeDetails(context.Background(), "client-id", "code")
@@ -864,12 +1234,10 @@ func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_Success(
 		Code:             "valid-code",
 		ClientID:         "client-id",
 		AuthorizedUserID: "user-123",
-		State:            AuthCodeStateActive,
+		State:            AuthCodeStateInactive,
 	}
-	suite.mockAuthzCodeStore.EXPECT().GetAuthorizationCode(mock.Anything, "valid-code").
+	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "client-id", "valid-code").
 		Return(record, nil)
-	suite.mockAuthzCodeStore.EXPECT().ConsumeAuthorizationCode(mock.Anything, "valid-code").
-		Return(true, nil)
 
 	svc := suite.newService()
 	result, err := svc.GetAuthorizationCodeDetails(context.Background(), "client-id", "valid-code")
@@ -880,6 +1248,16 @@ func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_Success(
 	assert.Equal(suite.T(), "user-123", result.AuthorizedUserID)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestRecordIssuedTokens() {
+	tokens := []IssuedToken{{JTI: "at-jti"}}
+	suite.mockAuthzCodeStore.EXPECT().RecordIssuedTokens(mock.Anything, "code", tokens).Return(nil)
+
+	svc := suite.newService()
+
+	assert.NoError(suite.T(), svc.RecordIssuedTokens(context.Background(), "code", tokens))
+	assert.NoError(suite.T(), svc.RecordIssuedTokens(context.Background(), "code", nil))
+}
+
 func (suite *AuthorizeServiceTestSuite) TestDetermineClaimsForTokens_NilApp() {
 	accessTokenClaims, idTokenClaims, userInfoClaims := determineClaimsForTokens(
 		[]string{"openid", "profile"},
@@ -2080,6 +2458,253 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Ac
 	assert.NotNil(suite.T(), result)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestEnforceScopeRestrictions_RemovesDeniedScopes() {
+	scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+	scopeService.EXPECT().EnforceScopeRestrictions(mock.Anything, "user-1",
+		[]string{"openid", "payroll", "orders:read", "admin:write"}).
+		Return([]string{"openid", "orders:read"}, nil)
+	svc := suite.newService()
+	svc.scopeService = scopeService
+	authRequestCtx := &authRequestContext{OAuthParameters: oauth2model.OAuthParameters{
+		StandardScopes:   []string{"openid", "payroll"},
+		PermissionScopes: []string{"orders:read", "admin:write"},
+	}}
+
+	authErr, err := svc.enforceScopeRestrictions(context.Background(), authRequestCtx, "user-1")
+
+	suite.Nil(authErr)
+	suite.NoError(err)
+	suite.Equal([]string{"openid"}, authRequestCtx.OAuthParameters.StandardScopes)
+	suite.Equal([]string{"orders:read"}, authRequestCtx.OAuthParameters.PermissionScopes)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestEnforceScopeRestrictions_Errors() {
+	cases := []struct {
+		name     string
+		svcErr   *tidcommon.ServiceError
+		expected string
+	}{
+		{"Rejected", &scopemgt.ErrorRestrictedScopeDenied, oauth2const.ErrorAccessDenied},
+		{"ServerError", &tidcommon.InternalServerError, oauth2const.ErrorServerError},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+			scopeService.EXPECT().EnforceScopeRestrictions(mock.Anything, "user-1", []string{"admin:write"}).
+				Return(nil, tc.svcErr)
+			svc := suite.newService()
+			svc.scopeService = scopeService
+			authRequestCtx := &authRequestContext{OAuthParameters: oauth2model.OAuthParameters{
+				PermissionScopes: []string{"admin:write"},
+				RedirectURI:      "https://client.example.com/callback",
+				State:            "state-1",
+			}}
+
+			authErr, err := svc.enforceScopeRestrictions(context.Background(), authRequestCtx, "user-1")
+
+			suite.Error(err)
+			suite.Require().NotNil(authErr)
+			suite.Equal(tc.expected, authErr.Code)
+			suite.True(authErr.SendErrorToClient)
+			suite.Equal("state-1", authErr.State)
+		})
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestEnforceScopeRestrictions_WithoutScopeService() {
+	svc := suite.newService()
+	authRequestCtx := &authRequestContext{OAuthParameters: oauth2model.OAuthParameters{
+		PermissionScopes: []string{"admin:write"},
+	}}
+
+	authErr, err := svc.enforceScopeRestrictions(context.Background(), authRequestCtx, "user-1")
+
+	suite.Nil(authErr)
+	suite.NoError(err)
+	suite.Equal([]string{"admin:write"}, authRequestCtx.OAuthParameters.PermissionScopes)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestResolveStepUpACRValues() {
+	cases := []struct {
+		name         string
+		requiredACRs map[string][]string
+		acrValues    string
+		expected     string
+	}{
+		{"NoStepUpScopes", map[string][]string{}, "acr-basic", "acr-basic"},
+		{"KeepsQualifyingValues", map[string][]string{"payroll": {"acr-mfa", "acr-passkey"}},
+			"acr-basic acr-passkey", "acr-passkey"},
+		{"FallsBackToQualifyingClasses", map[string][]string{"payroll": {"acr-mfa", "acr-passkey"}},
+			"acr-basic", "acr-mfa acr-passkey"},
+		{"IntersectsScopes", map[string][]string{
+			"payroll": {"acr-mfa", "acr-passkey"}, "admin:write": {"acr-passkey"}}, "", "acr-passkey"},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+			scopeService.EXPECT().GetRequiredACRs(mock.Anything, []string{"openid", "payroll", "admin:write"}).
+				Return(tc.requiredACRs, nil)
+			svc := suite.newService()
+			svc.scopeService = scopeService
+			params := &oauth2model.OAuthParameters{
+				StandardScopes:   []string{"openid", "payroll"},
+				PermissionScopes: []string{"admin:write"},
+			}
+
+			acrValues, authErr := svc.resolveStepUpACRValues(context.Background(), params, tc.acrValues)
+
+			suite.Nil(authErr)
+			suite.Equal(tc.expected, acrValues)
+		})
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestResolveStepUpACRValues_Errors() {
+	cases := []struct {
+		name         string
+		requiredACRs map[string][]string
+		svcErr       *tidcommon.ServiceError
+		expected     string
+	}{
+		{"Unsatisfiable", map[string][]string{"payroll": {"acr-mfa"}, "admin:write": {"acr-passkey"}},
+			nil, oauth2const.ErrorInvalidScope},
+		{"ServerError", nil, &tidcommon.InternalServerError, oauth2const.ErrorServerError},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+			scopeService.EXPECT().GetRequiredACRs(mock.Anything, []string{"payroll", "admin:write"}).
+				Return(tc.requiredACRs, tc.svcErr)
+			svc := suite.newService()
+			svc.scopeService = scopeService
+			params := &oauth2model.OAuthParameters{
+				PermissionScopes: []string{"payroll", "admin:write"},
+				State:            "state-1",
+			}
+
+			_, authErr := svc.resolveStepUpACRValues(context.Background(), params, "acr-mfa")
+
+			suite.Require().NotNil(authErr)
+			suite.Equal(tc.expected, authErr.Code)
+			suite.True(authErr.SendErrorToClient)
+			suite.Equal("state-1", authErr.State)
+		})
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestEnforceStepUpScopes() {
+	cases := []struct {
+		name         string
+		completedACR string
+		expected     string
+	}{
+		{"Satisfied", "acr-passkey", ""},
+		{"Insufficient", "acr-basic", oauth2const.ErrorInsufficientUserAuthentication},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+			scopeService.EXPECT().GetRequiredACRs(mock.Anything, []string{"openid", "payroll"}).
+				Return(map[string][]string{"payroll": {"acr-mfa", "acr-passkey"}}, nil)
+			svc := suite.newService()
+			svc.scopeService = scopeService
+			authRequestCtx := &authRequestContext{OAuthParameters: oauth2model.OAuthParameters{
+				StandardScopes: []string{"openid", "payroll"},
+				State:          "state-1",
+			}}
+
+			authErr, err := svc.enforceStepUpScopes(context.Background(), authRequestCtx, tc.completedACR)
+
+			if tc.expected == "" {
+				suite.Nil(authErr)
+				suite.NoError(err)
+				return
+			}
+			suite.Error(err)
+			suite.Require().NotNil(authErr)
+			suite.Equal(tc.expected, authErr.Code)
+			suite.True(authErr.SendErrorToClient)
+			suite.Equal("state-1", authErr.State)
+		})
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestEnforceStepUpScopes_WithoutScopeService() {
+	svc := suite.newService()
+	authRequestCtx := &authRequestContext{OAuthParameters: oauth2model.OAuthParameters{
+		PermissionScopes: []string{"payroll"},
+	}}
+
+	authErr, err := svc.enforceStepUpScopes(context.Background(), authRequestCtx, "")
+
+	suite.Nil(authErr)
+	suite.NoError(err)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestConsumeAssertion() {
+	expiry := time.Now().Add(time.Minute)
+	cases := []struct {
+		name       string
+		issuedAt   time.Time
+		jti        string
+		recordsJTI bool
+		recorded   bool
+		storeErr   error
+		expected   string
+	}{
+		{"Fresh", time.Now(), "assertion-jti", true, true, nil, ""},
+		{"Replayed", time.Now(), "assertion-jti", true, false, nil, oauth2const.ErrorInvalidRequest},
+		{"StoreError", time.Now(), "assertion-jti", true, false, errors.New("store down"),
+			oauth2const.ErrorServerError},
+		{"MissingJTI", time.Now(), "", false, false, nil, oauth2const.ErrorInvalidRequest},
+		{"TooOld", time.Now().Add(-10 * time.Minute), "assertion-jti", false, false, nil,
+			oauth2const.ErrorInvalidRequest},
+		{"MissingIssuedAt", time.Time{}, "assertion-jti", false, false, nil, oauth2const.ErrorInvalidRequest},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			jtiStore := jtimock.NewJTIStoreInterfaceMock(suite.T())
+			if tc.recordsJTI {
+				jtiStore.EXPECT().RecordJTI(mock.Anything, assertionJTINamespace, "assertion-jti", expiry).
+					Return(tc.recorded, tc.storeErr)
+			}
+			// Unset the maximum age so the default applies.
+			svc := suite.newService()
+			svc.cfg.OAuth.AuthorizationCode.AssertionMaxAge = nil
+			svc.jtiStore = jtiStore
+			authRequestCtx := &authRequestContext{OAuthParameters: oauth2model.OAuthParameters{
+				RedirectURI: "https://client.example.com/callback",
+				State:       "state-1",
+			}}
+			claims := &assertionClaims{jti: tc.jti, expiry: expiry}
+
+			authErr, err := svc.consumeAssertion(context.Background(), authRequestCtx, claims, tc.issuedAt)
+
+			if tc.expected == "" {
+				suite.Nil(authErr)
+				suite.NoError(err)
+				return
+			}
+			suite.Error(err)
+			suite.Require().NotNil(authErr)
+			suite.Equal(tc.expected, authErr.Code)
+			suite.True(authErr.SendErrorToClient)
+			suite.Equal("state-1", authErr.State)
+		})
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestConsumeAssertion_AgeCheckDisabled() {
+	svc := suite.newService()
+	authRequestCtx := &authRequestContext{}
+
+	authErr, err := svc.consumeAssertion(context.Background(), authRequestCtx, &assertionClaims{},
+		time.Now().Add(-time.Hour))
+
+	suite.Nil(authErr)
+	suite.NoError(err)
+}
+
 // noopAuthnMgr returns an authentication-provider mock with no expectations, for tests that
 // build a real actor provider but never exercise actor authentication.
 func noopAuthnMgr() *managermock.AuthnProviderManagerMock {
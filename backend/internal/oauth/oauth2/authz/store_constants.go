@@ -36,6 +36,8 @@ const (
 	jsonKeyClaimsLocales       = "claims_locales"
 	jsonKeyNonce               = "nonce"
 	jsonKeyDPoPJkt             = "dpop_jkt"
+	jsonKeyApplicationID       = "application_id"
+	jsonKeyAssertionNonce      = "assertion_nonce"
 )
 
 // Database column names for authorization request storage.
@@ -54,14 +56,25 @@ var queryInsertAuthorizationCode = dbmodel.DBQuery{
 var queryGetAuthorizationCode = dbmodel.DBQuery{
 	ID: "AZQ-ACS-02",
 	Query: `SELECT CODE_ID, AUTHORIZATION_CODE, CLIENT_ID, STATE, AUTHZ_DATA, TIME_CREATED, ` +
-		`EXPIRY_TIME FROM "AUTHORIZATION_CODE" WHERE AUTHORIZATION_CODE = $1 AND DEPLOYMENT_ID = $2`,
+		`EXPIRY_TIME, ISSUED_TOKENS FROM "AUTHORIZATION_CODE" WHERE AUTHORIZATION_CODE = $1 ` +
+		`AND DEPLOYMENT_ID = $2`,
 }
 
-// queryConsumeAuthorizationCode atomically consumes an authorization code (ACTIVE → INACTIVE).
+// queryConsumeAuthorizationCode atomically consumes an authorization code issued to the client
+// (ACTIVE → INACTIVE) and returns the consumed row. No row is returned when the code is unknown,
+// issued to another client or already consumed.
 var queryConsumeAuthorizationCode = dbmodel.DBQuery{
 	ID: "AZQ-ACS-04",
-	Query: `UPDATE "AUTHORIZATION_CODE" SET STATE = $1 WHERE AUTHORIZATION_CODE = $2 ` +
-		`AND STATE = $3 AND DEPLOYMENT_ID = $4`,
+	Query: `UPDATE "AUTHORIZATION_CODE" SET STATE = $1 WHERE AUTHORIZATION_CODE = $2 AND CLIENT_ID = $3 ` +
+		`AND STATE = $4 AND DEPLOYMENT_ID = $5 RETURNING CODE_ID, AUTHORIZATION_CODE, CLIENT_ID, STATE, ` +
+		`AUTHZ_DATA, TIME_CREATED, EXPIRY_TIME, ISSUED_TOKENS`,
+}
+
+// queryUpdateIssuedTokens records the tokens issued from an authorization code.
+var queryUpdateIssuedTokens = dbmodel.DBQuery{
+	ID: "AZQ-ACS-05",
+	Query: `UPDATE "AUTHORIZATION_CODE" SET ISSUED_TOKENS = $1 WHERE AUTHORIZATION_CODE = $2 ` +
+		`AND DEPLOYMENT_ID = $3`,
 }
 
 // queryInsertAuthRequest is the query to insert a new authorization request context.
@@ -168,7 +168,7 @@ func (
 	code,
 	msg,
 	state string) {
-	gateClientConfig := d.cfg.GateClient
+	gateClientConfig := d.cfg.CurrentGateClient()
 	errorPageURL := (&url.URL{
 		Scheme: gateClientConfig.Scheme,
 		Host:   fmt.Sprintf("%s:%d", gateClientConfig.Hostname, gateClientConfig.Port),
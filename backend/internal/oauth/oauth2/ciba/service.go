@@ -148,6 +148,7 @@ func (s *cibaService) InitiateBackchannelAuth(
 	runtimeData := map[string]string{
 		flowcm.RuntimeKeyAuthorizationRequestID:      authReqID,
 		flowcm.RuntimeKeyClientID:                    oauthApp.ClientID,
+		flowcm.RuntimeKeyRequestedScopes:             utils.StringifyStringArray(oidcScopes, " "),
 		flowcm.RuntimeKeyRequestedPermissions:        utils.StringifyStringArray(permissionScopes, " "),
 		flowcm.RuntimeKeyRequiredEssentialAttributes: "",
 		flowcm.RuntimeKeyRequiredOptionalAttributes: getRequiredOptionalAttributes(
@@ -491,10 +492,12 @@ func defaultBindingMessage(authReqID string) string {
 // fixed buffer. Setting this in the flow runtime data is what makes the auth assertion cache the
 // resolved attributes and emit the aci claim (consumed by the CIBA callback).
 func (s *cibaService) resolveUserAttributesCacheTTL(app *providers.OAuthClient) int64 {
-	maxTTL := tokenservice.ResolveTokenConfig(
-		s.cfg, app, tokenservice.TokenTypeAccess, app.UserAccessTokenConfig().ValidityPeriodOrZero()).ValidityPeriod
+	grantType := string(providers.GrantTypeCIBA)
+	maxTTL := tokenservice.ResolveTokenConfig(s.cfg, app, tokenservice.TokenTypeAccess, grantType,
+		app.UserAccessTokenConfig().ValidityPeriodOrZero()).ValidityPeriod
 	if app.IsAllowedGrantType(providers.GrantTypeRefreshToken) {
-		refreshTTL := tokenservice.ResolveTokenConfig(s.cfg, app, tokenservice.TokenTypeRefresh, 0).ValidityPeriod
+		refreshTTL := tokenservice.ResolveTokenConfig(
+			s.cfg, app, tokenservice.TokenTypeRefresh, grantType, 0).ValidityPeriod
 		if refreshTTL > maxTTL {
 			maxTTL = refreshTTL
 		}
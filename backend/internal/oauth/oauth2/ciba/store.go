@@ -28,12 +28,15 @@ import (
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
-// newCIBAStore returns a Redis-backed store when the runtime database is configured for Redis,
-// and falls back to the SQL-backed store otherwise. This mirrors the selection pattern used
-// by the authz package for its authorization request and code stores.
+// newCIBAStore returns a Redis-backed or bolt-backed store when the runtime database is configured
+// for one of them, and falls back to the SQL-backed store otherwise. This mirrors the selection
+// pattern used by the authz package for its authorization request and code stores.
 func newCIBAStore(cfg oauthconfig.Config) CIBARequestStoreInterface {
-	if cfg.RuntimeDBType == provider.DataSourceTypeRedis {
+	switch cfg.RuntimeDBType {
+	case provider.DataSourceTypeRedis:
 		return newRedisCIBARequestStore(provider.GetRedisProvider(), cfg.DeploymentID)
+	case provider.DataSourceTypeBolt:
+		return newBoltCIBARequestStore(provider.GetBoltProvider(), cfg.DeploymentID)
 	}
 	return newCIBARequestStore(cfg.DeploymentID)
 }
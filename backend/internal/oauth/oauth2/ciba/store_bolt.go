@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// errCIBAStateMismatch signals that a bolt update found the request in an unexpected state.
+var errCIBAStateMismatch = errors.New("ciba authentication request state mismatch")
+
+// boltCIBARequestStore is the bolt-backed implementation of CIBARequestStoreInterface.
+type boltCIBARequestStore struct {
+	client       provider.BoltProviderInterface
+	deploymentID string
+}
+
+// newBoltCIBARequestStore creates a new bolt-backed CIBA request store.
+func newBoltCIBARequestStore(
+	p provider.BoltProviderInterface, deploymentID string,
+) CIBARequestStoreInterface {
+	return &boltCIBARequestStore{
+		client:       p,
+		deploymentID: deploymentID,
+	}
+}
+
+// cibaKey builds the bolt key for a CIBA authentication request.
+func (s *boltCIBARequestStore) cibaKey(authReqID string) string {
+	return fmt.Sprintf("runtime:%s:ciba-auth-req:%s", s.deploymentID, authReqID)
+}
+
+// Add inserts a new CIBA authentication request into bolt with a TTL derived from ExpiryTime.
+func (s *boltCIBARequestStore) Add(_ context.Context, request *CIBAAuthRequest) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal CIBA request: %w", err)
+	}
+
+	ttl := time.Until(request.ExpiryTime)
+	if ttl <= 0 {
+		return fmt.Errorf("CIBA request already expired")
+	}
+
+	if err := s.client.Set(s.cibaKey(request.AuthReqID), data, ttl); err != nil {
+		return fmt.Errorf("failed to store CIBA request in bolt: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a CIBA authentication request by ID. Returns ErrCIBARequestNotFound if absent.
+func (s *boltCIBARequestStore) GetByID(_ context.Context, authReqID string) (*CIBAAuthRequest, error) {
+	if authReqID == "" {
+		return nil, ErrCIBARequestNotFound
+	}
+
+	data, err := s.client.Get(s.cibaKey(authReqID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CIBA request from bolt: %w", err)
+	}
+	if data == nil {
+		return nil, ErrCIBARequestNotFound
+	}
+
+	var request CIBAAuthRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal CIBA request: %w", err)
+	}
+
+	return &request, nil
+}
+
+// MarkAuthenticated atomically transitions a pending request to authenticated, preventing
+// concurrent callbacks from both succeeding on the same request.
+func (s *boltCIBARequestStore) MarkAuthenticated(_ context.Context, authReqID, userID,
+	authorizedScopes, attributeCacheID, completedACR string, authTime time.Time) error {
+	err := s.update(authReqID, func(record *CIBAAuthRequest) error {
+		if record.State != CIBAStatePending {
+			return errCIBAStateMismatch
+		}
+		record.State = CIBAStateAuthenticated
+		record.UserID = userID
+		record.AuthorizedScopes = authorizedScopes
+		record.AttributeCacheID = attributeCacheID
+		record.CompletedACR = completedACR
+		record.AuthTime = authTime.UTC()
+		return nil
+	})
+	switch {
+	case errors.Is(err, ErrCIBARequestNotFound):
+		return fmt.Errorf("CIBA request %s not found", authReqID)
+	case errors.Is(err, errCIBAStateMismatch):
+		return fmt.Errorf("CIBA request %s is not pending", authReqID)
+	case err != nil:
+		return fmt.Errorf("failed to mark CIBA request as authenticated: %w", err)
+	}
+	return nil
+}
+
+// MarkConsumed atomically transitions an authenticated request to consumed, preventing
+// double-token issuance under concurrent polls. Returns false if the request is not in the
+// AUTHENTICATED state (already consumed or otherwise terminal).
+func (s *boltCIBARequestStore) MarkConsumed(_ context.Context, authReqID string) (bool, error) {
+	err := s.update(authReqID, func(record *CIBAAuthRequest) error {
+		if record.State != CIBAStateAuthenticated {
+			return errCIBAStateMismatch
+		}
+		record.State = CIBAStateConsumed
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrCIBARequestNotFound) || errors.Is(err, errCIBAStateMismatch) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to consume CIBA request: %w", err)
+	}
+	return true, nil
+}
+
+// UpdateLastPolled updates the last polled timestamp of a CIBA authentication request.
+func (s *boltCIBARequestStore) UpdateLastPolled(_ context.Context, authReqID string, polledAt time.Time) error {
+	return s.update(authReqID, func(record *CIBAAuthRequest) error {
+		record.LastPolledAt = polledAt
+		return nil
+	})
+}
+
+// UpdateState updates the state of a CIBA authentication request.
+func (s *boltCIBARequestStore) UpdateState(_ context.Context, authReqID string, state CIBARequestState) error {
+	return s.update(authReqID, func(record *CIBAAuthRequest) error {
+		record.State = state
+		return nil
+	})
+}
+
+// update atomically applies fn to a stored request and writes it back, preserving the remaining TTL.
+// Returns ErrCIBARequestNotFound when the request does not exist, or the error returned by fn.
+func (s *boltCIBARequestStore) update(authReqID string, fn func(record *CIBAAuthRequest) error) error {
+	if authReqID == "" {
+		return ErrCIBARequestNotFound
+	}
+
+	return s.client.Modify(s.cibaKey(authReqID), func(value []byte) ([]byte, error) {
+		if value == nil {
+			return nil, ErrCIBARequestNotFound
+		}
+		var record CIBAAuthRequest
+		if err := json.Unmarshal(value, &record); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal CIBA request: %w", err)
+		}
+		if err := fn(&record); err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal CIBA request: %w", err)
+		}
+		return data, nil
+	})
+}
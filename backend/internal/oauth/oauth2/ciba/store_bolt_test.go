@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package ciba
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider/bolttest"
+)
+
+type BoltCIBARequestStoreTestSuite struct {
+	suite.Suite
+	store   *boltCIBARequestStore
+	ctx     context.Context
+	request *CIBAAuthRequest
+}
+
+func TestBoltCIBARequestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(BoltCIBARequestStoreTestSuite))
+}
+
+func (s *BoltCIBARequestStoreTestSuite) SetupTest() {
+	s.store = newBoltCIBARequestStore(bolttest.NewProvider(s.T()), "test-deployment").(*boltCIBARequestStore)
+	s.ctx = context.Background()
+	s.request = &CIBAAuthRequest{
+		AuthReqID:      "req-1",
+		ClientID:       "client-1",
+		StandardScopes: "openid",
+		State:          CIBAStatePending,
+		ExpiryTime:     time.Now().Add(5 * time.Minute),
+	}
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestCIBAKey() {
+	s.Equal("runtime:test-deployment:ciba-auth-req:req-1", s.store.cibaKey("req-1"))
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestAddAndGetByID() {
+	s.Require().NoError(s.store.Add(s.ctx, s.request))
+
+	result, err := s.store.GetByID(s.ctx, "req-1")
+	s.Require().NoError(err)
+	s.Equal("client-1", result.ClientID)
+	s.Equal(CIBAStatePending, result.State)
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestAdd_AlreadyExpired() {
+	s.request.ExpiryTime = time.Now().Add(-time.Second)
+	s.Error(s.store.Add(s.ctx, s.request))
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestGetByID_NotFound() {
+	_, err := s.store.GetByID(s.ctx, "missing")
+	s.ErrorIs(err, ErrCIBARequestNotFound)
+
+	_, err = s.store.GetByID(s.ctx, "")
+	s.ErrorIs(err, ErrCIBARequestNotFound)
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestMarkAuthenticatedAndConsumed() {
+	s.Require().NoError(s.store.Add(s.ctx, s.request))
+	authTime := time.Now().UTC().Truncate(time.Second)
+
+	s.Require().NoError(s.store.MarkAuthenticated(s.ctx, "req-1", "user-1", "openid", "cache-1", "acr-1", authTime))
+	s.Error(s.store.MarkAuthenticated(s.ctx, "req-1", "user-2", "openid", "", "", authTime),
+		"only a pending request can be authenticated")
+
+	result, err := s.store.GetByID(s.ctx, "req-1")
+	s.Require().NoError(err)
+	s.Equal(CIBAStateAuthenticated, result.State)
+	s.Equal("user-1", result.UserID)
+	s.Equal("cache-1", result.AttributeCacheID)
+	s.Equal("acr-1", result.CompletedACR)
+	s.True(authTime.Equal(result.AuthTime))
+
+	consumed, err := s.store.MarkConsumed(s.ctx, "req-1")
+	s.NoError(err)
+	s.True(consumed)
+
+	consumed, err = s.store.MarkConsumed(s.ctx, "req-1")
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestMarkAuthenticated_NotFound() {
+	err := s.store.MarkAuthenticated(s.ctx, "missing", "user-1", "openid", "", "", time.Now())
+	s.ErrorContains(err, "not found")
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestMarkConsumed_NotFound() {
+	consumed, err := s.store.MarkConsumed(s.ctx, "missing")
+	s.NoError(err)
+	s.False(consumed)
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestUpdateLastPolledAndState() {
+	s.Require().NoError(s.store.Add(s.ctx, s.request))
+	polledAt := time.Now().UTC().Truncate(time.Second)
+
+	s.Require().NoError(s.store.UpdateLastPolled(s.ctx, "req-1", polledAt))
+	s.Require().NoError(s.store.UpdateState(s.ctx, "req-1", CIBAStateDenied))
+
+	result, err := s.store.GetByID(s.ctx, "req-1")
+	s.Require().NoError(err)
+	s.True(polledAt.Equal(result.LastPolledAt))
+	s.Equal(CIBAStateDenied, result.State)
+}
+
+func (s *BoltCIBARequestStoreTestSuite) TestUpdateState_NotFound() {
+	s.ErrorIs(s.store.UpdateState(s.ctx, "missing", CIBAStateExpired), ErrCIBARequestNotFound)
+	s.ErrorIs(s.store.UpdateLastPolled(s.ctx, "missing", time.Now()), ErrCIBARequestNotFound)
+}
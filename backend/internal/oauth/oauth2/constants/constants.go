@@ -58,12 +58,14 @@ const (
 	RequestParamAudience            string = "audience"
 	RequestParamClaims              string = "claims"
 	RequestParamClaimsLocales       string = "claims_locales"
+	RequestParamUILocales           string = "ui_locales"
 	RequestParamNonce               string = "nonce"
 	RequestParamPrompt              string = "prompt"
 	RequestParamRequestURI          string = "request_uri"
 	RequestParamAcrValues           string = "acr_values"
 	RequestParamDPoPJkt             string = "dpop_jkt"
 	RequestParamLoginHint           string = "login_hint"
+	RequestParamOrgHandle           string = "orgHandle"
 	RequestParamIDTokenHint         string = "id_token_hint"
 	RequestParamLoginHintToken      string = "login_hint_token" // #nosec G101
 	RequestParamBindingMessage      string = "binding_message"
@@ -103,6 +105,7 @@ const (
 	ShowInsecureWarning   string = "showInsecureWarning"
 	AppID                 string = "applicationId"
 	ExecutionID           string = "executionId"
+	OrgHandle             string = "orgHandle"
 	Assertion             string = "assertion"
 )
 
@@ -189,6 +192,10 @@ const (
 	ErrorExpiredToken             string = "expired_token" // #nosec G101
 	ErrorUnknownUserID            string = "unknown_user_id"
 	ErrorInvalidBindingMessage    string = "invalid_binding_message"
+	ErrorQuotaExceeded            string = "quota_exceeded"
+	// ErrorInsufficientUserAuthentication is the RFC 9470 step-up error returned when the authentication
+	// does not meet the authentication class a requested scope requires.
+	ErrorInsufficientUserAuthentication string = "insufficient_user_authentication"
 )
 
 // UnSupportedGrantTypeError is returned when an unsupported grant type is requested.
@@ -253,11 +260,21 @@ const (
 	ClaimClaimsLocales          string = "claims_locales"
 	ClaimCompletedAuthClass     string = "completed_auth_class"
 	ClaimDPoPJkt                string = "dpop_jkt"
+	ClaimOriginalIssuedAt       string = "orig_iat"
 	ClaimAuthorizedPermissions  string = "authorized_permissions"
 	ClaimAuthorizationRequestID string = "authorization_request_id"
 	ClaimClientID               string = "client_id"
+	// ClaimDeviceID binds the flow assertion and the refresh tokens issued from it to the device the
+	// user signed in from.
+	ClaimDeviceID string = "device_id"
+	// ClaimAuthorizationRequestNonce carries the nonce of the authorization request in the flow assertion.
+	ClaimAuthorizationRequestNonce string = "authorization_request_nonce"
 )
 
+// ClaimLanguageTagSeparator separates a claim name from the BCP47 language tag of a localized
+// claim value, as in "name#ja-Kana-JP" (OpenID Connect Core 1.0, Section 5.2).
+const ClaimLanguageTagSeparator = "#"
+
 // OIDC subject types.
 const (
 	SubjectTypePublic string = "public"
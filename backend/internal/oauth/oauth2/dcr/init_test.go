@@ -21,6 +21,7 @@ package dcr
 import (
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -46,11 +47,12 @@ func (suite *InitTestSuite) SetupTest() {
 	config.ResetServerRuntime()
 	suite.mockAppService = applicationmock.NewApplicationServiceInterfaceMock(suite.T())
 	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	dbPath := filepath.Join(suite.T().TempDir(), "test.db")
 	testConfig := &config.Config{
 		Database: config.DatabaseConfig{
-			Config:  config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: "test.db"}},
-			Runtime: config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: "test.db"}},
-			User:    config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: "test.db"}},
+			Config:  config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: dbPath}},
+			Runtime: config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: dbPath}},
+			User:    config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: dbPath}},
 		},
 	}
 	_ = config.InitializeServerRuntime("", testConfig)
@@ -85,11 +87,12 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 
 func (suite *InitTestSuite) TestInitialize_ReturnsError_WhenRuntimeTransactionerUnavailable() {
 	config.ResetServerRuntime()
+	dbPath := filepath.Join(suite.T().TempDir(), "test.db")
 	testConfig := &config.Config{
 		Database: config.DatabaseConfig{
-			Config:  config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: "test.db"}},
+			Config:  config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: dbPath}},
 			Runtime: config.DataSource{},
-			User:    config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: "test.db"}},
+			User:    config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: dbPath}},
 		},
 	}
 	_ = config.InitializeServerRuntime("", testConfig)
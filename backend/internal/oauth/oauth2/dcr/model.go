@@ -51,9 +51,11 @@ type DCRRegistrationRequest struct {
 
 	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
 	DPoPBoundAccessTokens              bool   `json:"dpop_bound_access_tokens,omitempty"`
+	ApplicationType                    string `json:"application_type,omitempty"`
 	UserInfoSignedResponseAlg          string `json:"userinfo_signed_response_alg,omitempty"`
 	UserInfoEncryptedResponseAlg       string `json:"userinfo_encrypted_response_alg,omitempty"`
 	UserInfoEncryptedResponseEnc       string `json:"userinfo_encrypted_response_enc,omitempty"`
+	IDTokenSignedResponseAlg           string `json:"id_token_signed_response_alg,omitempty"`
 	IDTokenEncryptedResponseAlg        string `json:"id_token_encrypted_response_alg,omitempty"`
 	IDTokenEncryptedResponseEnc        string `json:"id_token_encrypted_response_enc,omitempty"`
 	// Localized variant maps — populated from #-keyed JSON fields (e.g. "client_name#fr").
@@ -148,9 +150,11 @@ type DCRRegistrationResponse struct {
 
 	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
 	DPoPBoundAccessTokens              bool   `json:"dpop_bound_access_tokens,omitempty"`
+	ApplicationType                    string `json:"application_type,omitempty"`
 	UserInfoSignedResponseAlg          string `json:"userinfo_signed_response_alg,omitempty"`
 	UserInfoEncryptedResponseAlg       string `json:"userinfo_encrypted_response_alg,omitempty"`
 	UserInfoEncryptedResponseEnc       string `json:"userinfo_encrypted_response_enc,omitempty"`
+	IDTokenSignedResponseAlg           string `json:"id_token_signed_response_alg,omitempty"`
 	IDTokenEncryptedResponseAlg        string `json:"id_token_encrypted_response_alg,omitempty"`
 	IDTokenEncryptedResponseEnc        string `json:"id_token_encrypted_response_enc,omitempty"`
 	// Localized variant maps — injected as #-keyed top-level fields during serialization.
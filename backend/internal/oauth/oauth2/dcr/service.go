@@ -250,6 +250,7 @@ func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
 		PKCERequired:                       isPublicClient,
 		RequirePushedAuthorizationRequests: request.RequirePushedAuthorizationRequests,
 		DPoPBoundAccessTokens:              request.DPoPBoundAccessTokens,
+		ApplicationType:                    providers.ApplicationType(request.ApplicationType),
 		Scopes:                             scopes,
 		UserInfo:                           buildUserInfoConfig(request),
 		Token:                              buildTokenConfig(request),
@@ -315,15 +316,23 @@ func buildTokenConfig(request *DCRRegistrationRequest) *providers.OAuthTokenConf
 	return &providers.OAuthTokenConfig{IDToken: idToken}
 }
 
-// buildIDTokenConfig maps ID token encryption fields from a DCR request to an IDTokenConfig.
+// buildIDTokenConfig maps ID token signing and encryption fields from a DCR request to an IDTokenConfig.
 func buildIDTokenConfig(request *DCRRegistrationRequest) *providers.IDTokenConfig {
-	if request.IDTokenEncryptedResponseAlg == "" && request.IDTokenEncryptedResponseEnc == "" {
+	hasEnc := request.IDTokenEncryptedResponseAlg != "" || request.IDTokenEncryptedResponseEnc != ""
+	if !hasEnc && request.IDTokenSignedResponseAlg == "" {
 		return nil
 	}
+	if !hasEnc {
+		return &providers.IDTokenConfig{
+			ResponseType: providers.IDTokenResponseTypeJWT,
+			SigningAlg:   request.IDTokenSignedResponseAlg,
+		}
+	}
 	return &providers.IDTokenConfig{
 		ResponseType:  providers.IDTokenResponseTypeJWE,
 		EncryptionAlg: request.IDTokenEncryptedResponseAlg,
 		EncryptionEnc: request.IDTokenEncryptedResponseEnc,
+		SigningAlg:    request.IDTokenSignedResponseAlg,
 	}
 }
 
@@ -363,8 +372,9 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 		userInfoEncryptedEnc = oauthConfig.UserInfo.EncryptionEnc
 	}
 
-	var idTokenEncryptedAlg, idTokenEncryptedEnc string
+	var idTokenSignedAlg, idTokenEncryptedAlg, idTokenEncryptedEnc string
 	if oauthConfig.Token != nil && oauthConfig.Token.IDToken != nil {
+		idTokenSignedAlg = oauthConfig.Token.IDToken.SigningAlg
 		idTokenEncryptedAlg = oauthConfig.Token.IDToken.EncryptionAlg
 		idTokenEncryptedEnc = oauthConfig.Token.IDToken.EncryptionEnc
 	}
@@ -389,9 +399,11 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 		AppID:                              appDTO.ID,
 		RequirePushedAuthorizationRequests: oauthConfig.RequirePushedAuthorizationRequests,
 		DPoPBoundAccessTokens:              oauthConfig.DPoPBoundAccessTokens,
+		ApplicationType:                    string(oauthConfig.ApplicationType),
 		UserInfoSignedResponseAlg:          userInfoSignedAlg,
 		UserInfoEncryptedResponseAlg:       userInfoEncryptedAlg,
 		UserInfoEncryptedResponseEnc:       userInfoEncryptedEnc,
+		IDTokenSignedResponseAlg:           idTokenSignedAlg,
 		IDTokenEncryptedResponseAlg:        idTokenEncryptedAlg,
 		IDTokenEncryptedResponseEnc:        idTokenEncryptedEnc,
 	}
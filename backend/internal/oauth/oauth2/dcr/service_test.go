@@ -718,6 +718,16 @@ func (s *DCRServiceTestSuite) TestBuildIDTokenConfig_MapsAlgAndEnc() {
 	s.Equal("A256GCM", cfg.EncryptionEnc)
 }
 
+// TestBuildIDTokenConfig_MapsSigningAlg verifies that id_token_signed_response_alg alone yields a
+// signed JWT ID token configuration.
+func (s *DCRServiceTestSuite) TestBuildIDTokenConfig_MapsSigningAlg() {
+	cfg := buildIDTokenConfig(&DCRRegistrationRequest{IDTokenSignedResponseAlg: "ES256"})
+	s.Require().NotNil(cfg)
+	s.Equal(providers.IDTokenResponseTypeJWT, cfg.ResponseType)
+	s.Equal("ES256", cfg.SigningAlg)
+	s.Empty(cfg.EncryptionAlg)
+}
+
 // TestRegisterClient_WithIDTokenEncryption verifies that DCR registration round-trips
 // IDTokenEncryptedResponseAlg and IDTokenEncryptedResponseEnc correctly.
 func (s *DCRServiceTestSuite) TestRegisterClient_WithIDTokenEncryption() {
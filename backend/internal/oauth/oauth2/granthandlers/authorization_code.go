@@ -33,6 +33,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -209,6 +210,7 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 	// Carry the full (un-narrowed) audiences in OriginalAudiences so the token service can
 	// pass them to IssueRefreshToken (RFC 8707 §5 — refresh token preserves original audience).
 	accessToken.OriginalAudiences = fullAudiences
+	accessToken.DeviceID = authCode.DeviceID
 
 	// Build token response
 	tokenResponse := &model.TokenResponseDTO{
@@ -225,8 +227,10 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 			AuthTime:       authCode.TimeCreated.Unix(),
 			OAuthApp:       oauthApp,
 			ClaimsRequest:  authCode.ClaimsRequest,
+			ClaimsLocales:  authCode.ClaimsLocales,
 			Nonce:          authCode.Nonce,
 			CompletedACR:   authCode.CompletedACR,
+			GrantType:      string(providers.GrantTypeAuthorizationCode),
 		})
 		if err != nil {
 			logger.Error(ctx, "Failed to generate ID token", log.Error(err))
@@ -241,6 +245,43 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 	return tokenResponse, nil
 }
 
+// RecordIssuedTokens records the access and refresh tokens issued from the authorization code so they
+// can be revoked if the code is replayed. Recording is best effort: a failure is logged and does not
+// fail the token request, as the code has already been consumed.
+func (h *authorizationCodeGrantHandler) RecordIssuedTokens(
+	ctx context.Context, code string, tokenResponse *model.TokenResponseDTO,
+) {
+	if tokenResponse == nil {
+		return
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizationCodeGrantHandler"))
+
+	issued := make([]authz.IssuedToken, 0, 2)
+	for _, token := range []model.TokenDTO{tokenResponse.AccessToken, tokenResponse.RefreshToken} {
+		if token.Token == "" {
+			continue
+		}
+		payload, err := jwt.DecodeJWTPayload(token.Token)
+		if err != nil {
+			logger.Debug(ctx, "Failed to decode token issued from authorization code", log.Error(err))
+			continue
+		}
+		jti, _ := payload[constants.ClaimJTI].(string)
+		if jti == "" {
+			continue
+		}
+		issued = append(issued, authz.IssuedToken{
+			JTI:        jti,
+			ExpiryTime: time.Unix(token.IssuedAt+token.ExpiresIn, 0).UTC(),
+		})
+	}
+
+	if err := h.authzService.RecordIssuedTokens(ctx, code, issued); err != nil {
+		logger.Warn(ctx, "Tokens issued from the authorization code will not be revoked on code replay",
+			log.Error(err))
+	}
+}
+
 func (h *authorizationCodeGrantHandler) retrieveAndValidateAuthCode(
 	ctx context.Context,
 	tokenRequest *model.TokenRequest,
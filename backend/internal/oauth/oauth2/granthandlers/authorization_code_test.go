@@ -1571,3 +1571,35 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_DPoPBoundCo
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), constants.TokenTypeDPoP, result.AccessToken.TokenType)
 }
+
+// Unsigned test tokens carrying only a jti claim.
+const (
+	testCodeAccessToken  = "eyJhbGciOiJub25lIn0.eyJqdGkiOiJhdC1qdGkifQ.sig"
+	testCodeRefreshToken = "eyJhbGciOiJub25lIn0.eyJqdGkiOiJydC1qdGkifQ.sig"
+)
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestRecordIssuedTokens() {
+	tokenResponse := &model.TokenResponseDTO{
+		AccessToken:  model.TokenDTO{Token: testCodeAccessToken, IssuedAt: 1000, ExpiresIn: 3600},
+		RefreshToken: model.TokenDTO{Token: testCodeRefreshToken, IssuedAt: 1000, ExpiresIn: 86400},
+		IDToken:      model.TokenDTO{Token: "not-recorded"},
+	}
+	suite.mockAuthzService.EXPECT().RecordIssuedTokens(mock.Anything, "test-code", []authz.IssuedToken{
+		{JTI: "at-jti", ExpiryTime: time.Unix(4600, 0).UTC()},
+		{JTI: "rt-jti", ExpiryTime: time.Unix(87400, 0).UTC()},
+	}).Return(nil)
+
+	suite.handler.RecordIssuedTokens(context.Background(), "test-code", tokenResponse)
+}
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestRecordIssuedTokens_RecordErrorIsNotFatal() {
+	tokenResponse := &model.TokenResponseDTO{
+		AccessToken: model.TokenDTO{Token: testCodeAccessToken, IssuedAt: 1000, ExpiresIn: 3600},
+	}
+	suite.mockAuthzService.EXPECT().RecordIssuedTokens(mock.Anything, "test-code", mock.Anything).
+		Return(errors.New("database error"))
+
+	suite.NotPanics(func() {
+		suite.handler.RecordIssuedTokens(context.Background(), "test-code", tokenResponse)
+	})
+}
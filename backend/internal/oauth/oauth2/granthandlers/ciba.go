@@ -29,6 +29,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	scopemgt "github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
@@ -38,6 +39,7 @@ type cibaGrantHandler struct {
 	cibaService    ciba.CIBAServiceInterface
 	tokenBuilder   tokenservice.TokenBuilderInterface
 	attributeCache attributecache.AttributeCacheServiceInterface
+	scopeService   scopemgt.ScopeServiceInterface
 	logger         *log.Logger
 }
 
@@ -46,11 +48,13 @@ func newCIBAGrantHandler(
 	cibaService ciba.CIBAServiceInterface,
 	tokenBuilder tokenservice.TokenBuilderInterface,
 	attributeCache attributecache.AttributeCacheServiceInterface,
+	scopeService scopemgt.ScopeServiceInterface,
 ) GrantHandlerInterface {
 	return &cibaGrantHandler{
 		cibaService:    cibaService,
 		tokenBuilder:   tokenBuilder,
 		attributeCache: attributeCache,
+		scopeService:   scopeService,
 		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CIBAGrantHandler")),
 	}
 }
@@ -180,7 +184,11 @@ func (h *cibaGrantHandler) issueTokens(ctx context.Context, record *ciba.CIBAAut
 	if scopeStr == "" {
 		scopeStr = record.StandardScopes
 	}
-	scopes := tokenservice.ParseScopes(scopeStr)
+	scopes, errResp := enforceScopeRestrictions(ctx, h.scopeService, record.UserID,
+		tokenservice.ParseScopes(scopeStr))
+	if errResp != nil {
+		return nil, errResp
+	}
 
 	attrs := make(map[string]interface{})
 	if record.AttributeCacheID != "" {
@@ -229,6 +237,7 @@ func (h *cibaGrantHandler) issueTokens(ctx context.Context, record *ciba.CIBAAut
 			AuthTime:       record.AuthTime.Unix(),
 			OAuthApp:       oauthApp,
 			CompletedACR:   record.CompletedACR,
+			GrantType:      string(providers.GrantTypeCIBA),
 		})
 		if idErr != nil {
 			h.logger.Error(ctx, "Failed to generate ID token", log.Error(idErr))
@@ -35,9 +35,11 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	scopemgt "github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/cibamock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/scopemock"
 )
 
 type CIBAGrantHandlerTestSuite struct {
@@ -59,7 +61,7 @@ func (suite *CIBAGrantHandlerTestSuite) SetupTest() {
 	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
 	suite.mockAttrCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
 	suite.handler = newCIBAGrantHandler(suite.mockCIBAService, suite.mockTokenBuilder,
-		suite.mockAttrCacheService)
+		suite.mockAttrCacheService, nil)
 	suite.oauthApp = &providers.OAuthClient{ClientID: "client-1"}
 	suite.tokenReq = &model.TokenRequest{
 		GrantType: string(providers.GrantTypeCIBA),
@@ -242,6 +244,44 @@ func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_Authenticated_NoOpenIDSk
 	suite.Empty(resp.IDToken.Token)
 }
 
+func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_Authenticated_RemovesRestrictedScopes() {
+	scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+	handler := newCIBAGrantHandler(suite.mockCIBAService, suite.mockTokenBuilder, suite.mockAttrCacheService,
+		scopeService)
+	record := suite.pendingRecord()
+	record.State = ciba.CIBAStateAuthenticated
+	record.AuthorizedScopes = "payroll " + testScopeRead
+	suite.mockCIBAService.EXPECT().GetByAuthReqID(mock.Anything, "auth-req-1").Return(record, nil)
+	scopeService.EXPECT().EnforceScopeRestrictions(mock.Anything, "user-1", []string{"payroll", testScopeRead}).
+		Return([]string{testScopeRead}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.MatchedBy(
+		func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.Scopes) == 1 && ctx.Scopes[0] == testScopeRead
+		})).Return(&model.TokenDTO{Token: "access-token", TokenType: "Bearer"}, nil)
+	suite.mockCIBAService.EXPECT().MarkConsumed(mock.Anything, "auth-req-1").Return(true, nil)
+
+	resp, errResp := handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(errResp)
+	suite.Equal("access-token", resp.AccessToken.Token)
+}
+
+func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_Authenticated_RestrictedScopeRejected() {
+	scopeService := scopemock.NewScopeServiceInterfaceMock(suite.T())
+	handler := newCIBAGrantHandler(suite.mockCIBAService, suite.mockTokenBuilder, suite.mockAttrCacheService,
+		scopeService)
+	record := suite.pendingRecord()
+	record.State = ciba.CIBAStateAuthenticated
+	record.AuthorizedScopes = "payroll"
+	suite.mockCIBAService.EXPECT().GetByAuthReqID(mock.Anything, "auth-req-1").Return(record, nil)
+	scopeService.EXPECT().EnforceScopeRestrictions(mock.Anything, "user-1", []string{"payroll"}).
+		Return(nil, &scopemgt.ErrorRestrictedScopeDenied)
+
+	resp, errResp := handler.HandleGrant(context.Background(), suite.tokenReq, suite.oauthApp)
+	suite.Nil(resp)
+	suite.Require().NotNil(errResp)
+	suite.Equal(constants.ErrorInvalidScope, errResp.Error)
+}
+
 func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_Authenticated_OneTimeUseRace() {
 	record := suite.pendingRecord()
 	record.State = ciba.CIBAStateAuthenticated
@@ -22,7 +22,9 @@ package granthandlers
 import (
 	"context"
 
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	scopemgt "github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -51,3 +53,33 @@ type RefreshTokenGrantHandlerInterface interface {
 		attributeCacheID string,
 	) *model.ErrorResponse
 }
+
+// AuthorizationCodeGrantHandlerInterface defines the interface for handling authorization code grants.
+type AuthorizationCodeGrantHandlerInterface interface {
+	GrantHandlerInterface
+	RecordIssuedTokens(ctx context.Context, code string, tokenResponse *model.TokenResponseDTO)
+}
+
+// enforceScopeRestrictions removes the restricted scopes the subject may not be granted. A restricted
+// scope configured to reject the request fails the grant with invalid_scope.
+func enforceScopeRestrictions(ctx context.Context, scopeService scopemgt.ScopeServiceInterface,
+	subject string, scopes []string) ([]string, *model.ErrorResponse) {
+	if scopeService == nil || len(scopes) == 0 {
+		return scopes, nil
+	}
+
+	granted, svcErr := scopeService.EnforceScopeRestrictions(ctx, subject, scopes)
+	if svcErr != nil {
+		if svcErr.Code == scopemgt.ErrorRestrictedScopeDenied.Code {
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorInvalidScope,
+				ErrorDescription: "The subject is not allowed to be granted a requested restricted scope",
+			}
+		}
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+	return granted, nil
+}
@@ -28,7 +28,9 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"github.com/thunder-id/thunderid/internal/actorprovider"
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/device"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
@@ -37,6 +39,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	scopemgt "github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -50,6 +53,9 @@ type refreshTokenGrantHandler struct {
 	attrCacheService attributecache.AttributeCacheServiceInterface
 	resourceService  providers.ResourceServerProvider
 	refreshRevoker   revocation.RefreshTokenRevokerInterface
+	actorProvider    providers.ActorProvider
+	deviceService    device.DeviceServiceInterface
+	scopeService     scopemgt.ScopeServiceInterface
 }
 
 // newRefreshTokenGrantHandler creates a new instance of RefreshTokenGrantHandler.
@@ -60,6 +66,9 @@ func newRefreshTokenGrantHandler(
 	attrCacheService attributecache.AttributeCacheServiceInterface,
 	resourceService providers.ResourceServerProvider,
 	refreshRevoker revocation.RefreshTokenRevokerInterface,
+	actorProvider providers.ActorProvider,
+	deviceService device.DeviceServiceInterface,
+	scopeService scopemgt.ScopeServiceInterface,
 	cfg oauthconfig.Config,
 ) RefreshTokenGrantHandlerInterface {
 	return &refreshTokenGrantHandler{
@@ -70,6 +79,9 @@ func newRefreshTokenGrantHandler(
 		attrCacheService: attrCacheService,
 		resourceService:  resourceService,
 		refreshRevoker:   refreshRevoker,
+		actorProvider:    actorProvider,
+		deviceService:    deviceService,
+		scopeService:     scopeService,
 	}
 }
 
@@ -132,11 +144,37 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		return nil, errResp
 	}
 
+	if errResp := h.checkSubjectActive(ctx, refreshTokenClaims.Sub, logger); errResp != nil {
+		return nil, errResp
+	}
+
+	if errResp := h.checkDeviceTrusted(ctx, refreshTokenClaims.DeviceID, logger); errResp != nil {
+		return nil, errResp
+	}
+
+	// The refresh token chain ends at the app's maximum lifetime, counted from the original sign-in.
+	if maxLifetime := oauthApp.RefreshTokenMaxLifetime(); maxLifetime > 0 &&
+		time.Now().Unix() >= refreshTokenClaims.OriginalIat+maxLifetime {
+		logger.Debug(ctx, "Refresh token exceeded the maximum lifetime")
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Refresh token has reached its maximum lifetime, re-authentication is required",
+		}
+	}
+
 	newTokenScopes, scopeErr := h.validateAndApplyScopes(ctx, tokenRequest.Scope, refreshTokenClaims.Scopes, logger)
 	if scopeErr != nil {
 		return nil, scopeErr
 	}
 
+	// Restricted scopes are re-evaluated on every refresh, so a subject that lost the required role or
+	// organization unit stops receiving them.
+	newTokenScopes, scopeErr = enforceScopeRestrictions(
+		ctx, h.scopeService, refreshTokenClaims.Sub, newTokenScopes)
+	if scopeErr != nil {
+		return nil, scopeErr
+	}
+
 	// Compute narrowed audiences per RFC 8707 §2.1. When the client supplies resource parameters,
 	// narrow the audience to the intersection with the original refresh-token audiences.
 	// An empty intersection is a client error (invalid_target).
@@ -246,6 +284,8 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 			UserAttributes: attrs,
 			OAuthApp:       oauthApp,
 			ClaimsRequest:  refreshTokenClaims.ClaimsRequest,
+			ClaimsLocales:  refreshTokenClaims.ClaimsLocales,
+			GrantType:      refreshTokenClaims.GrantType,
 		})
 		if idErr != nil {
 			logger.Error(ctx, "Failed to generate ID token", log.Error(idErr))
@@ -257,17 +297,18 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		tokenResponse.IDToken = *idToken
 	}
 
-	renewRefreshToken := h.cfg.OAuth.RefreshToken.RenewOnGrant
+	// Sliding expiry renews the refresh token on every use, regardless of the deployment setting.
+	renewRefreshToken := h.cfg.OAuth.RefreshToken.RenewOnGrant || oauthApp.HasSlidingRefreshExpiry()
 
-	// Issue a new refresh token if renew_on_grant is enabled; otherwise reuse the existing one.
+	// Issue a new refresh token if renewal applies; otherwise reuse the existing one.
 	// RFC 8707 §5: the refresh token preserves the full original audience, not the narrowed one.
 	if renewRefreshToken {
 		logger.Debug(ctx, "Renewing refresh token", log.String("client_id", tokenRequest.ClientID))
-		errResp := h.IssueRefreshToken(ctx, tokenResponse, oauthApp,
+		errResp := h.issueRefreshToken(ctx, tokenResponse, oauthApp,
 			refreshTokenClaims.Sub, refreshTokenClaims.Audiences,
 			refreshTokenClaims.GrantType, newTokenScopes,
 			refreshTokenClaims.ClaimsRequest, refreshTokenClaims.ClaimsLocales,
-			refreshTokenClaims.AttributeCacheID)
+			refreshTokenClaims.AttributeCacheID, refreshTokenClaims.DeviceID, refreshTokenClaims.OriginalIat)
 		if errResp != nil && errResp.Error != "" {
 			logger.Error(ctx, "Failed to issue refresh token", log.String("error", errResp.Error))
 			return nil, errResp
@@ -296,8 +337,8 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		}
 	}
 
-	if errResp := h.extendCacheTTL(ctx, cacheEntry, oauthApp, refreshTokenClaims.Iat,
-		accessToken.ExpiresIn, renewRefreshToken, refreshTokenClaims.AttributeCacheID,
+	if errResp := h.extendCacheTTL(ctx, cacheEntry, oauthApp, refreshTokenClaims.GrantType,
+		refreshTokenClaims.Iat, accessToken.ExpiresIn, renewRefreshToken, refreshTokenClaims.AttributeCacheID,
 		logger); errResp != nil {
 		return nil, errResp
 	}
@@ -315,6 +356,28 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 	claimsRequest *model.ClaimsRequest,
 	claimsLocales string,
 	attributeCacheID string,
+) *model.ErrorResponse {
+	var deviceID string
+	if tokenResponse != nil {
+		deviceID = tokenResponse.AccessToken.DeviceID
+	}
+	return h.issueRefreshToken(ctx, tokenResponse, oauthApp, subject, audiences, grantType, scopes,
+		claimsRequest, claimsLocales, attributeCacheID, deviceID, 0)
+}
+
+// issueRefreshToken generates a refresh token that continues the chain started at originalIssuedAt
+// (0 to start a new chain). A non-empty deviceID binds the token to the device the user signed in from.
+func (h *refreshTokenGrantHandler) issueRefreshToken(
+	ctx context.Context,
+	tokenResponse *model.TokenResponseDTO,
+	oauthApp *providers.OAuthClient,
+	subject string, audiences []string, grantType string,
+	scopes []string,
+	claimsRequest *model.ClaimsRequest,
+	claimsLocales string,
+	attributeCacheID string,
+	deviceID string,
+	originalIssuedAt int64,
 ) *model.ErrorResponse {
 	tokenCtx := &tokenservice.RefreshTokenBuildContext{
 		ClientID:             oauthApp.ClientID,
@@ -327,6 +390,8 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 		ClaimsRequest:        claimsRequest,
 		ClaimsLocales:        claimsLocales,
 		DPoPJkt:              dpopJktForRefresh(ctx, oauthApp),
+		DeviceID:             deviceID,
+		OriginalIssuedAt:     originalIssuedAt,
 	}
 	if oauthApp.ShouldAppendActorClaim() {
 		tokenCtx.ActorSub = oauthApp.ID
@@ -334,6 +399,12 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 
 	// Build refresh token using token builder
 	refreshToken, err := h.tokenBuilder.BuildRefreshToken(ctx, tokenCtx)
+	if errors.Is(err, tokenservice.ErrRefreshTokenMaxLifetimeExceeded) {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Refresh token has reached its maximum lifetime, re-authentication is required",
+		}
+	}
 	if err != nil {
 		return &model.ErrorResponse{
 			Error:            constants.ErrorServerError,
@@ -341,6 +412,8 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 		}
 	}
 
+	h.registerRefreshToken(ctx, refreshToken)
+
 	if tokenResponse == nil {
 		tokenResponse = &model.TokenResponseDTO{}
 	}
@@ -348,6 +421,114 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 	return nil
 }
 
+// registerRefreshToken records the issued refresh token so that administrators can list it and revoke
+// it by user or client. A failure is logged and does not fail the grant: the token stays revocable on
+// its own through the deny list.
+func (h *refreshTokenGrantHandler) registerRefreshToken(ctx context.Context, refreshToken *model.TokenDTO) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RefreshTokenGrantHandler"))
+
+	payload, err := jwt.DecodeJWTPayload(refreshToken.Token)
+	if err != nil {
+		logger.Debug(ctx, "Failed to decode the issued refresh token", log.Error(err))
+		return
+	}
+	jti, _ := payload[constants.ClaimJTI].(string)
+
+	issued := revocation.IssuedRefreshToken{
+		JTI:        jti,
+		ClientID:   refreshToken.ClientID,
+		Subject:    refreshToken.Subject,
+		IssuedAt:   time.Unix(refreshToken.IssuedAt, 0).UTC(),
+		ExpiryTime: time.Unix(refreshToken.IssuedAt+refreshToken.ExpiresIn, 0).UTC(),
+	}
+	if err := h.refreshRevoker.RegisterRefreshToken(ctx, issued); err != nil {
+		logger.Warn(ctx, "The issued refresh token will not be listed for administrative revocation",
+			log.Error(err))
+	}
+}
+
+// checkDeviceTrusted rejects the grant when the refresh token is bound to a device whose trust was
+// revoked. Tokens without a device binding are not restricted.
+func (h *refreshTokenGrantHandler) checkDeviceTrusted(
+	ctx context.Context, deviceID string, logger *log.Logger) *model.ErrorResponse {
+	if h.deviceService == nil || deviceID == "" {
+		return nil
+	}
+
+	trusted, err := h.deviceService.IsDeviceTrusted(ctx, deviceID)
+	if err != nil {
+		logger.Error(ctx, "Failed to verify the trust of the refresh token device", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to verify the trust of the refresh token device",
+		}
+	}
+	if !trusted {
+		logger.Debug(ctx, "Refresh token device is no longer trusted", log.String("device_id", deviceID))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Refresh token was issued to a device that is no longer trusted",
+		}
+	}
+	return nil
+}
+
+// checkSubjectActive rejects the grant when the subject of the refresh token is a locally stored
+// entity that is no longer active, such as a locked, disabled or deleted user. Subjects without a
+// local entity record are not restricted.
+func (h *refreshTokenGrantHandler) checkSubjectActive(
+	ctx context.Context, sub string, logger *log.Logger) *model.ErrorResponse {
+	if h.actorProvider == nil || sub == "" {
+		return nil
+	}
+
+	entity, svcErr := h.actorProvider.GetActor(sub)
+	if svcErr != nil {
+		if svcErr.Code == actorprovider.ErrorEntityNotFound.Code {
+			return h.checkSubjectNotDeleted(ctx, sub, logger)
+		}
+		logger.Error(ctx, "Failed to resolve the refresh token subject",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to verify the state of the refresh token subject",
+		}
+	}
+
+	if entity != nil && entity.State != "" && entity.State != providers.EntityStateActive {
+		logger.Debug(ctx, "Refresh token subject is not active", log.String("state", string(entity.State)))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "The user account is not active",
+		}
+	}
+	return nil
+}
+
+// checkSubjectNotDeleted rejects the grant when the subject of the refresh token, which has no active
+// entity record, was soft-deleted. Deleted entities are hidden from regular lookups, so without this
+// check a deleted user could keep refreshing tokens.
+func (h *refreshTokenGrantHandler) checkSubjectNotDeleted(
+	ctx context.Context, sub string, logger *log.Logger) *model.ErrorResponse {
+	_, svcErr := h.actorProvider.GetDeletedActor(sub)
+	if svcErr == nil {
+		logger.Debug(ctx, "Refresh token subject has been deleted")
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "The user account is not active",
+		}
+	}
+	if svcErr.Code == actorprovider.ErrorEntityNotFound.Code {
+		return nil
+	}
+	logger.Error(ctx, "Failed to resolve the deleted refresh token subject",
+		log.String("error", svcErr.ErrorDescription.DefaultValue))
+	return &model.ErrorResponse{
+		Error:            constants.ErrorServerError,
+		ErrorDescription: "Failed to verify the state of the refresh token subject",
+	}
+}
+
 // dpopJktForRefresh returns the DPoP jkt to bind onto a newly issued refresh token.
 // Confidential clients receive unbound refresh tokens.
 func dpopJktForRefresh(ctx context.Context, oauthApp *providers.OAuthClient) string {
@@ -368,6 +549,7 @@ func (h *refreshTokenGrantHandler) extendCacheTTL(
 	ctx context.Context,
 	cacheEntry *attributecache.AttributeCache,
 	oauthApp *providers.OAuthClient,
+	grantType string,
 	refreshIat, accessExpiresIn int64,
 	renewRefreshToken bool,
 	cacheID string,
@@ -378,7 +560,7 @@ func (h *refreshTokenGrantHandler) extendCacheTTL(
 	}
 	now := time.Now().Unix()
 	refreshValidity := tokenservice.ResolveTokenConfig(
-		h.cfg, oauthApp, tokenservice.TokenTypeRefresh, 0).ValidityPeriod
+		h.cfg, oauthApp, tokenservice.TokenTypeRefresh, grantType, 0).ValidityPeriod
 	if renewRefreshToken {
 		refreshIat = now // newly issued token starts from now
 	}
@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/actorprovider"
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
@@ -42,7 +43,9 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/devicemock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/revocationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
@@ -67,6 +70,8 @@ type RefreshTokenGrantHandlerTestSuite struct {
 	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
 	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
 	mockRefreshRevoker   *revocationmock.RefreshTokenRevokerInterfaceMock
+	mockActorProvider    *actorprovidermock.ActorProviderMock
+	mockDeviceService    *devicemock.DeviceServiceInterfaceMock
 	oauthApp             *providers.OAuthClient
 	validRefreshToken    string
 	validClaims          map[string]interface{}
@@ -102,6 +107,14 @@ func (suite *RefreshTokenGrantHandlerTestSuite) SetupTest() {
 	suite.mockAttrCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
 	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
 	suite.mockRefreshRevoker = revocationmock.NewRefreshTokenRevokerInterfaceMock(suite.T())
+	suite.mockDeviceService = devicemock.NewDeviceServiceInterfaceMock(suite.T())
+	suite.mockActorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.mockActorProvider.On("GetActor", mock.Anything).
+		Return(func(actorID string) *providers.Entity {
+			return &providers.Entity{ID: actorID, State: providers.EntityStateActive}
+		}, func(_ string) *tidcommon.ServiceError {
+			return nil
+		}).Maybe()
 
 	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, mock.Anything).
 		Return(func(_ context.Context, identifier string) *providers.ResourceServer {
@@ -155,6 +168,9 @@ func (suite *RefreshTokenGrantHandlerTestSuite) rebuildHandlerWithConfig() {
 		suite.mockAttrCacheService,
 		suite.mockResourceService,
 		suite.mockRefreshRevoker,
+		suite.mockActorProvider,
+		suite.mockDeviceService,
+		nil,
 		suite.testCfg,
 	).(*refreshTokenGrantHandler)
 }
@@ -168,7 +184,8 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestNewRefreshTokenGrantHandler(
 		suite.mockTokenBuilder,
 		suite.mockTokenValidator,
 		suite.mockAttrCacheService,
-		suite.mockResourceService, suite.mockRefreshRevoker, testhelpers.OAuthConfig())
+		suite.mockResourceService, suite.mockRefreshRevoker, suite.mockActorProvider,
+		suite.mockDeviceService, nil, testhelpers.OAuthConfig())
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*RefreshTokenGrantHandlerInterface)(nil), handler)
 }
@@ -257,6 +274,135 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_EnforcementUnava
 	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
 }
 
+// A refresh token whose subject has been locked or disabled is rejected with invalid_grant.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_InactiveSubjectRejected() {
+	for _, state := range []providers.EntityState{
+		providers.EntityStateDisabled, providers.EntityStateLocked, providers.EntityStatePending,
+	} {
+		suite.Run(string(state), func() {
+			suite.mockTokenValidator = tokenservicemock.NewTokenValidatorInterfaceMock(suite.T())
+			suite.mockTokenValidator.
+				On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken, testRefreshTokenClientID).
+				Return(&tokenservice.RefreshTokenClaims{Sub: testRefreshTokenUserID}, nil)
+			suite.mockActorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+			suite.mockActorProvider.On("GetActor", testRefreshTokenUserID).
+				Return(&providers.Entity{ID: testRefreshTokenUserID, State: state}, nil)
+			suite.rebuildHandlerWithConfig()
+
+			response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+			assert.Nil(suite.T(), response)
+			assert.NotNil(suite.T(), err)
+			assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+		})
+	}
+}
+
+// Subjects without a local entity record, such as client credential subjects, are not restricted.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckSubjectActive_UnknownSubjectAllowed() {
+	suite.mockActorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.mockActorProvider.On("GetActor", "unknown").Return(nil, &actorprovider.ErrorEntityNotFound)
+	suite.mockActorProvider.On("GetDeletedActor", "unknown").Return(nil, &actorprovider.ErrorEntityNotFound)
+	suite.rebuildHandlerWithConfig()
+
+	err := suite.handler.checkSubjectActive(context.Background(), "unknown", log.GetLogger())
+	assert.Nil(suite.T(), err)
+}
+
+// A soft-deleted subject is hidden from regular lookups but is still rejected with invalid_grant.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_SoftDeletedSubjectRejected() {
+	suite.mockTokenValidator = tokenservicemock.NewTokenValidatorInterfaceMock(suite.T())
+	suite.mockTokenValidator.
+		On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{Sub: testRefreshTokenUserID}, nil)
+	suite.mockActorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.mockActorProvider.On("GetActor", testRefreshTokenUserID).
+		Return(nil, &actorprovider.ErrorEntityNotFound)
+	suite.mockActorProvider.On("GetDeletedActor", testRefreshTokenUserID).
+		Return(&providers.Entity{ID: testRefreshTokenUserID, State: providers.EntityStateActive}, nil)
+	suite.rebuildHandlerWithConfig()
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+}
+
+// A failure to look up a deleted subject fails closed with server_error.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckSubjectActive_DeletedLookupFailureFailsClosed() {
+	suite.mockActorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.mockActorProvider.On("GetActor", testRefreshTokenUserID).Return(nil, &actorprovider.ErrorEntityNotFound)
+	suite.mockActorProvider.On("GetDeletedActor", testRefreshTokenUserID).
+		Return(nil, &tidcommon.InternalServerError)
+	suite.rebuildHandlerWithConfig()
+
+	err := suite.handler.checkSubjectActive(context.Background(), testRefreshTokenUserID, log.GetLogger())
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
+// A failure to resolve the subject fails closed with server_error.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckSubjectActive_LookupFailureFailsClosed() {
+	suite.mockActorProvider = actorprovidermock.NewActorProviderMock(suite.T())
+	suite.mockActorProvider.On("GetActor", testRefreshTokenUserID).
+		Return(nil, &tidcommon.InternalServerError)
+	suite.rebuildHandlerWithConfig()
+
+	err := suite.handler.checkSubjectActive(context.Background(), testRefreshTokenUserID, log.GetLogger())
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
+// A refresh token issued to a device whose trust was revoked is rejected with invalid_grant.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RevokedDeviceRejected() {
+	suite.mockTokenValidator.
+		On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{Sub: testRefreshTokenUserID, DeviceID: "device-1"}, nil)
+	suite.mockDeviceService.On("IsDeviceTrusted", mock.Anything, "device-1").Return(false, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+}
+
+// A failure to verify the device trust fails closed with server_error.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckDeviceTrusted_LookupFailureFailsClosed() {
+	suite.mockDeviceService.On("IsDeviceTrusted", mock.Anything, "device-1").
+		Return(false, errors.New("store unavailable"))
+
+	err := suite.handler.checkDeviceTrusted(context.Background(), "device-1", log.GetLogger())
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
+// Tokens without a device binding and tokens of trusted devices are not restricted.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckDeviceTrusted_Allowed() {
+	suite.mockDeviceService.On("IsDeviceTrusted", mock.Anything, "device-1").Return(true, nil)
+
+	assert.Nil(suite.T(), suite.handler.checkDeviceTrusted(context.Background(), "", log.GetLogger()))
+	assert.Nil(suite.T(), suite.handler.checkDeviceTrusted(context.Background(), "device-1", log.GetLogger()))
+}
+
+// The device binding of the access token is carried into the refresh token.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_BindsDevice() {
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything, mock.MatchedBy(
+		func(ctx *tokenservice.RefreshTokenBuildContext) bool {
+			return ctx.DeviceID == "device-1"
+		})).Return(&model.TokenDTO{Token: "new.refresh.token"}, nil)
+
+	tokenResponse := &model.TokenResponseDTO{AccessToken: model.TokenDTO{DeviceID: "device-1"}}
+
+	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
+		testRefreshTokenUserID, []string{testRefreshTokenAudience},
+		"authorization_code", []string{"read"}, nil, "", "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "new.refresh.token", tokenResponse.RefreshToken.Token)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_Success() {
 	// Mock token builder for refresh token generation
 	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything, mock.MatchedBy(
@@ -290,6 +436,53 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_Success()
 	assert.Equal(suite.T(), testRefreshTokenClientID, tokenResponse.RefreshToken.ClientID)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_RegistersRefreshToken() {
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything, mock.Anything).Return(&model.TokenDTO{
+		Token:     testCodeRefreshToken,
+		IssuedAt:  int64(1000),
+		ExpiresIn: 86400,
+		ClientID:  testRefreshTokenClientID,
+		Subject:   testRefreshTokenUserID,
+	}, nil)
+	suite.mockRefreshRevoker.On("RegisterRefreshToken", mock.Anything, revocation.IssuedRefreshToken{
+		JTI:        "rt-jti",
+		ClientID:   testRefreshTokenClientID,
+		Subject:    testRefreshTokenUserID,
+		IssuedAt:   time.Unix(1000, 0).UTC(),
+		ExpiryTime: time.Unix(87400, 0).UTC(),
+	}).Return(nil)
+
+	tokenResponse := &model.TokenResponseDTO{}
+
+	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
+		testRefreshTokenUserID, []string{testRefreshTokenAudience},
+		"authorization_code", []string{"read"}, nil, "", "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), testCodeRefreshToken, tokenResponse.RefreshToken.Token)
+}
+
+// A failure to register the refresh token does not fail the grant.
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_RegistrationFailureDoesNotFailGrant() {
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything, mock.Anything).Return(&model.TokenDTO{
+		Token:     testCodeRefreshToken,
+		IssuedAt:  int64(1000),
+		ExpiresIn: 86400,
+		ClientID:  testRefreshTokenClientID,
+	}, nil)
+	suite.mockRefreshRevoker.On("RegisterRefreshToken", mock.Anything, mock.Anything).
+		Return(errors.New("db down"))
+
+	tokenResponse := &model.TokenResponseDTO{}
+
+	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
+		testRefreshTokenUserID, []string{testRefreshTokenAudience},
+		"authorization_code", []string{"read"}, nil, "", "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), testCodeRefreshToken, tokenResponse.RefreshToken.Token)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_JWTGenerationError() {
 	// Mock token builder to return error
 	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything, mock.Anything).
@@ -640,6 +833,69 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_Success_WithRene
 	assert.Equal(suite.T(), "new.refresh.token", response.RefreshToken.Token)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_MaxLifetimeExceeded() {
+	suite.oauthApp.Token = &providers.OAuthTokenConfig{
+		RefreshToken: &providers.RefreshTokenConfig{ValidityPeriod: 3600, MaxLifetime: 7200},
+	}
+	suite.mockTokenValidator.
+		On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:         testRefreshTokenUserID,
+			Audiences:   []string{testRefreshTokenAudience},
+			Scopes:      []string{"read"},
+			GrantType:   "authorization_code",
+			OriginalIat: time.Now().Unix() - 7200,
+		}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything, mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_SlidingExpiry_RenewsWithOriginalIat() {
+	suite.testCfg.OAuth.RefreshToken.RenewOnGrant = false
+	suite.rebuildHandlerWithConfig()
+	suite.oauthApp.Token = &providers.OAuthTokenConfig{
+		RefreshToken: &providers.RefreshTokenConfig{ValidityPeriod: 3600, SlidingExpiry: true},
+	}
+	originalIat := time.Now().Unix() - 600
+
+	suite.mockTokenValidator.
+		On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:         testRefreshTokenUserID,
+			Audiences:   []string{testRefreshTokenAudience},
+			Scopes:      []string{"read"},
+			GrantType:   "authorization_code",
+			Iat:         int64(suite.validClaims["iat"].(float64)),
+			OriginalIat: originalIat,
+		}, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything, mock.Anything).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+		Scopes:    []string{"read"},
+	}, nil)
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything, mock.MatchedBy(
+		func(ctx *tokenservice.RefreshTokenBuildContext) bool {
+			return ctx.OriginalIssuedAt == originalIat
+		})).Return(&model.TokenDTO{
+		Token:     "new.refresh.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+		Scopes:    []string{"read"},
+	}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), response)
+	assert.Equal(suite.T(), "new.refresh.token", response.RefreshToken.Token)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_GetAttributeCacheError() {
 	suite.mockTokenValidator.
 		On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken, testRefreshTokenClientID).
@@ -1203,7 +1459,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ExtendsCache_Eve
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestExtendCacheTTL_NilCacheEntry_NoOp() {
 	result := suite.handler.extendCacheTTL(
-		context.Background(), nil, suite.oauthApp,
+		context.Background(), nil, suite.oauthApp, string(providers.GrantTypeAuthorizationCode),
 		time.Now().Unix()-3600, 3600, false, testCacheID, log.GetLogger(),
 	)
 
@@ -1220,7 +1476,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestExtendCacheTTL_ExtendsRegard
 		Return((*tidcommon.ServiceError)(nil)).Once()
 
 	result := suite.handler.extendCacheTTL(
-		context.Background(), cacheEntry, suite.oauthApp,
+		context.Background(), cacheEntry, suite.oauthApp, string(providers.GrantTypeAuthorizationCode),
 		time.Now().Unix()-3600, 3600, false, testCacheID, log.GetLogger(),
 	)
 
@@ -1239,7 +1495,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestExtendCacheTTL_RefreshOutliv
 		Return((*tidcommon.ServiceError)(nil))
 
 	result := suite.handler.extendCacheTTL(
-		context.Background(), cacheEntry, suite.oauthApp,
+		context.Background(), cacheEntry, suite.oauthApp, string(providers.GrantTypeAuthorizationCode),
 		time.Now().Unix()-3600, 3600, false, testCacheID, log.GetLogger(),
 	)
 
@@ -1257,7 +1513,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestExtendCacheTTL_AccessOutlive
 		Return((*tidcommon.ServiceError)(nil))
 
 	result := suite.handler.extendCacheTTL(
-		context.Background(), cacheEntry, suite.oauthApp,
+		context.Background(), cacheEntry, suite.oauthApp, string(providers.GrantTypeAuthorizationCode),
 		time.Now().Unix()-83000, 7200, false, testCacheID, log.GetLogger(),
 	)
 
@@ -1274,7 +1530,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestExtendCacheTTL_RenewOnGrant_
 		Return((*tidcommon.ServiceError)(nil))
 
 	result := suite.handler.extendCacheTTL(
-		context.Background(), cacheEntry, suite.oauthApp,
+		context.Background(), cacheEntry, suite.oauthApp, string(providers.GrantTypeAuthorizationCode),
 		time.Now().Unix()-3600, // stale iat — ignored when renewRefreshToken=true
 		3600, true, testCacheID, log.GetLogger(),
 	)
@@ -1298,7 +1554,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestExtendCacheTTL_ExtendFails_R
 		Return(extendErr)
 
 	result := suite.handler.extendCacheTTL(
-		context.Background(), cacheEntry, suite.oauthApp,
+		context.Background(), cacheEntry, suite.oauthApp, string(providers.GrantTypeAuthorizationCode),
 		time.Now().Unix()-83000, 7200, false, testCacheID, log.GetLogger(),
 	)
 
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package jti
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// boltStore persists JTIs in bolt, scoped by deployment.
+type boltStore struct {
+	client       provider.BoltProviderInterface
+	deploymentID string
+}
+
+// newBoltStore builds a bolt-backed JTI store from the given provider.
+func newBoltStore(p provider.BoltProviderInterface, deploymentID string) JTIStoreInterface {
+	return &boltStore{
+		client:       p,
+		deploymentID: deploymentID,
+	}
+}
+
+// jtiKey returns the namespaced bolt key for a given JTI.
+func (s *boltStore) jtiKey(namespace, jti string) string {
+	return fmt.Sprintf("runtime:%s:jti:%s:%s", s.deploymentID, namespace, jti)
+}
+
+// RecordJTI returns false without error when the key already existed, signaling a replay.
+func (s *boltStore) RecordJTI(
+	_ context.Context, namespace, jti string, expiry time.Time,
+) (bool, error) {
+	ttl := time.Until(expiry)
+	if ttl <= 0 {
+		return true, nil
+	}
+	ok, err := s.client.SetNX(s.jtiKey(namespace, jti), []byte("1"), ttl)
+	if err != nil {
+		return false, fmt.Errorf("failed to record jti in bolt: %w", err)
+	}
+	return ok, nil
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package jti
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider/bolttest"
+)
+
+func TestBoltStore_JTIKey(t *testing.T) {
+	store := &boltStore{deploymentID: "dep1"}
+	assert.Equal(t, "runtime:dep1:jti:client-1:abc", store.jtiKey("client-1", "abc"))
+}
+
+func TestBoltStore_RecordJTI(t *testing.T) {
+	store := newBoltStore(bolttest.NewProvider(t), "dep1")
+	ctx := context.Background()
+	expiry := time.Now().Add(time.Minute)
+
+	ok, err := store.RecordJTI(ctx, "client-1", "abc", expiry)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = store.RecordJTI(ctx, "client-1", "abc", expiry)
+	require.NoError(t, err)
+	assert.False(t, ok, "a replayed jti must be rejected")
+
+	ok, err = store.RecordJTI(ctx, "client-2", "abc", expiry)
+	require.NoError(t, err)
+	assert.True(t, ok, "namespaces do not share jtis")
+}
+
+func TestBoltStore_RecordJTI_AlreadyExpired(t *testing.T) {
+	store := newBoltStore(bolttest.NewProvider(t), "dep1")
+
+	ok, err := store.RecordJTI(context.Background(), "client-1", "abc", time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
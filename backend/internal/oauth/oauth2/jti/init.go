@@ -23,11 +23,14 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 )
 
-// Initialize returns a JTI replay-cache backend (Redis or relational DB, selected
+// Initialize returns a JTI replay-cache backend (Redis, bolt or relational DB, selected
 // by the runtime datasource configuration).
 func Initialize(cfg oauthconfig.Config) JTIStoreInterface {
-	if cfg.RuntimeDBType == provider.DataSourceTypeRedis {
+	switch cfg.RuntimeDBType {
+	case provider.DataSourceTypeRedis:
 		return newRedisStore(provider.GetRedisProvider(), cfg.DeploymentID)
+	case provider.DataSourceTypeBolt:
+		return newBoltStore(provider.GetBoltProvider(), cfg.DeploymentID)
 	}
 	return newDBStore(cfg.DeploymentID)
 }
@@ -41,10 +41,13 @@ type OAuthParameters struct {
 	Resources           []string
 	ClaimsRequest       *ClaimsRequest
 	ClaimsLocales       string
+	UILocales           string
 	Nonce               string
 	AcrValues           string
 	DPoPJkt             string
 	Prompt              string
+	LoginHint           string
+	OrgHandle           string
 }
 
 // VerifiedClaimsMember is the OIDC Identity Assurance member name that may appear in the
@@ -67,6 +67,7 @@ type TokenDTO struct {
 	OriginalAudiences []string
 	ClaimsRequest     *ClaimsRequest
 	ClaimsLocales     string
+	DeviceID          string
 }
 
 // TokenResponseDTO represents the data transfer object for token responses.
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package par
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// boltPARRequestStore is the bolt-backed implementation of parStoreInterface.
+type boltPARRequestStore struct {
+	client       provider.BoltProviderInterface
+	deploymentID string
+}
+
+// newBoltPARRequestStore creates a new bolt-backed PAR request store.
+func newBoltPARRequestStore(p provider.BoltProviderInterface, deploymentID string) parStoreInterface {
+	return &boltPARRequestStore{
+		client:       p,
+		deploymentID: deploymentID,
+	}
+}
+
+// parKey builds the bolt key for a PAR random key.
+func (s *boltPARRequestStore) parKey(randomKey string) string {
+	return fmt.Sprintf("runtime:%s:par:%s", s.deploymentID, randomKey)
+}
+
+// Store persists a pushed authorization request in bolt with a TTL.
+func (s *boltPARRequestStore) Store(
+	_ context.Context, request pushedAuthorizationRequest, expirySeconds int64,
+) (string, error) {
+	randomKey, err := generateRandomKey()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate request URI: %w", err)
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal PAR request: %w", err)
+	}
+
+	ttl := time.Duration(expirySeconds) * time.Second
+	if err := s.client.Set(s.parKey(randomKey), data, ttl); err != nil {
+		return "", fmt.Errorf("failed to store PAR request in bolt: %w", err)
+	}
+
+	return randomKey, nil
+}
+
+// Consume atomically retrieves and deletes a pushed authorization request.
+func (s *boltPARRequestStore) Consume(
+	_ context.Context, randomKey string,
+) (pushedAuthorizationRequest, bool, error) {
+	data, err := s.client.GetDel(s.parKey(randomKey))
+	if err != nil {
+		return pushedAuthorizationRequest{}, false, fmt.Errorf("failed to get PAR request from bolt: %w", err)
+	}
+	if data == nil {
+		return pushedAuthorizationRequest{}, false, nil
+	}
+
+	var request pushedAuthorizationRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return pushedAuthorizationRequest{}, false, fmt.Errorf("failed to unmarshal PAR request: %w", err)
+	}
+	return request, true, nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package par
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider/bolttest"
+)
+
+type BoltStoreTestSuite struct {
+	suite.Suite
+	store   parStoreInterface
+	ctx     context.Context
+	testReq pushedAuthorizationRequest
+}
+
+func TestBoltStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(BoltStoreTestSuite))
+}
+
+func (s *BoltStoreTestSuite) SetupTest() {
+	s.store = newBoltPARRequestStore(bolttest.NewProvider(s.T()), "test-deployment-id")
+	s.ctx = context.Background()
+	s.testReq = pushedAuthorizationRequest{
+		ClientID: "test-client",
+		OAuthParameters: model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://example.com/callback",
+			State:       "test-state",
+		},
+	}
+}
+
+func (s *BoltStoreTestSuite) TestParKey() {
+	s.Equal("runtime:test-deployment-id:par:"+testRandomKey, s.store.(*boltPARRequestStore).parKey(testRandomKey))
+}
+
+func (s *BoltStoreTestSuite) TestStoreAndConsume() {
+	randomKey, err := s.store.Store(s.ctx, s.testReq, 60)
+	s.Require().NoError(err)
+	s.NotEmpty(randomKey)
+
+	request, found, err := s.store.Consume(s.ctx, randomKey)
+	s.NoError(err)
+	s.True(found)
+	s.Equal(s.testReq, request)
+
+	// A request can only be consumed once.
+	_, found, err = s.store.Consume(s.ctx, randomKey)
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *BoltStoreTestSuite) TestConsume_NotFound() {
+	_, found, err := s.store.Consume(s.ctx, "missing")
+	s.NoError(err)
+	s.False(found)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/system/featureflag"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -68,6 +69,16 @@ func (h *parHandler) HandlePARRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var appID string
+	if clientInfo.OAuthApp != nil {
+		appID = clientInfo.OAuthApp.ID
+	}
+	if !featureflag.IsEnabled(ctx, featureflag.PAR, appID) {
+		utils.WriteJSONError(ctx, w, oauth2const.ErrorUnauthorizedClient,
+			"Pushed authorization requests are not enabled for this client", http.StatusBadRequest, nil)
+		return
+	}
+
 	// Parse form-encoded body.
 	if err := r.ParseForm(); err != nil {
 		utils.WriteJSONError(ctx, w, oauth2const.ErrorInvalidRequest, "Failed to parse request body",
@@ -76,7 +87,7 @@ func (h *parHandler) HandlePARRequest(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// A DPoP proof at the PAR endpoint binds the auth code to the proof's key.
-	dpopHeaderJkt, errCode, errDesc := h.verifyDPoPHeader(ctx, r)
+	dpopHeaderJkt, errCode, errDesc := h.verifyDPoPHeader(ctx, r, appID)
 	if errCode != "" {
 		statusCode := http.StatusBadRequest
 		if errCode == oauth2const.ErrorServerError {
@@ -125,11 +136,15 @@ func (h *parHandler) HandlePARRequest(w http.ResponseWriter, r *http.Request) {
 }
 
 // verifyDPoPHeader verifies the DPoP proof header if present and returns the JKT, error code, and error description.
-func (h *parHandler) verifyDPoPHeader(ctx context.Context, r *http.Request) (string, string, string) {
+// A proof is rejected when DPoP is not enabled for the application.
+func (h *parHandler) verifyDPoPHeader(ctx context.Context, r *http.Request, appID string) (string, string, string) {
 	dpopHeaders := r.Header.Values(oauth2const.HeaderDPoP)
 	if len(dpopHeaders) == 0 {
 		return "", "", ""
 	}
+	if !featureflag.IsEnabled(ctx, featureflag.DPoP, appID) {
+		return "", oauth2const.ErrorInvalidDPoPProof, "DPoP is not enabled for this client"
+	}
 	if len(dpopHeaders) > 1 {
 		return "", oauth2const.ErrorInvalidDPoPProof, "Multiple DPoP headers"
 	}
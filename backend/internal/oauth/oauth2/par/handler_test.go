@@ -95,6 +95,52 @@ func (s *HandlerTestSuite) TestHandlePAR_Success() {
 	assert.Equal(s.T(), int64(60), resp.ExpiresIn)
 }
 
+// withFeatureFlags re-initializes the server runtime with the given deployment feature flags.
+func (s *HandlerTestSuite) withFeatureFlags(flags map[string]bool) {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("", &config.Config{FeatureFlags: flags})
+}
+
+func (s *HandlerTestSuite) TestHandlePAR_FeatureDisabled() {
+	s.withFeatureFlags(map[string]bool{"par": false})
+	svc := NewPARServiceInterfaceMock(s.T())
+	handler := newPARHandler(svc, nil, "https://example.test/oauth2/par")
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/par", strings.NewReader(testResponseTypeCodeBody))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	clientInfo := &clientauth.OAuthClientInfo{ClientID: "test-client", OAuthApp: &providers.OAuthClient{ID: "app-1"}}
+	req = req.WithContext(context.WithValue(req.Context(), clientauth.OAuthClientKey, clientInfo))
+
+	rec := httptest.NewRecorder()
+	handler.HandlePARRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+	var errResp map[string]string
+	assert.NoError(s.T(), json.NewDecoder(rec.Body).Decode(&errResp))
+	assert.Equal(s.T(), oauth2const.ErrorUnauthorizedClient, errResp["error"])
+}
+
+func (s *HandlerTestSuite) TestHandlePAR_DPoPFeatureDisabled() {
+	s.withFeatureFlags(map[string]bool{"dpop": false})
+	svc := NewPARServiceInterfaceMock(s.T())
+	verifier := dpopmock.NewVerifierInterfaceMock(s.T())
+	handler := newPARHandler(svc, verifier, "https://example.test/oauth2/par")
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/par", strings.NewReader(testResponseTypeCodeBody))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(oauth2const.HeaderDPoP, "proof")
+	clientInfo := &clientauth.OAuthClientInfo{ClientID: "test-client", OAuthApp: &providers.OAuthClient{ID: "app-1"}}
+	req = req.WithContext(context.WithValue(req.Context(), clientauth.OAuthClientKey, clientInfo))
+
+	rec := httptest.NewRecorder()
+	handler.HandlePARRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+	var errResp map[string]string
+	assert.NoError(s.T(), json.NewDecoder(rec.Body).Decode(&errResp))
+	assert.Equal(s.T(), oauth2const.ErrorInvalidDPoPProof, errResp["error"])
+}
+
 func (s *HandlerTestSuite) TestHandlePAR_NoClientAuth() {
 	svc := NewPARServiceInterfaceMock(s.T())
 	handler := newPARHandler(svc, nil, "https://example.test/oauth2/par")
@@ -55,8 +55,11 @@ func Initialize(
 
 // initializePARStore selects the PAR store implementation based on the configured runtime DB type.
 func initializePARStore(cfg oauthconfig.Config) parStoreInterface {
-	if cfg.RuntimeDBType == provider.DataSourceTypeRedis {
+	switch cfg.RuntimeDBType {
+	case provider.DataSourceTypeRedis:
 		return newRedisPARRequestStore(provider.GetRedisProvider(), cfg.DeploymentID)
+	case provider.DataSourceTypeBolt:
+		return newBoltPARRequestStore(provider.GetBoltProvider(), cfg.DeploymentID)
 	}
 	return newPARRequestStore(cfg.DeploymentID)
 }
@@ -138,10 +138,13 @@ func (s *parService) HandlePushedAuthorizationRequest(
 		Resources:           resources,
 		ClaimsRequest:       claimsRequest,
 		ClaimsLocales:       params[oauth2const.RequestParamClaimsLocales],
+		UILocales:           params[oauth2const.RequestParamUILocales],
 		Nonce:               params[oauth2const.RequestParamNonce],
 		AcrValues:           params[oauth2const.RequestParamAcrValues],
 		DPoPJkt:             resolveDPoPJkt(params[oauth2const.RequestParamDPoPJkt], dpopHeaderJkt),
 		Prompt:              params[oauth2const.RequestParamPrompt],
+		LoginHint:           params[oauth2const.RequestParamLoginHint],
+		OrgHandle:           params[oauth2const.RequestParamOrgHandle],
 	}
 
 	parRequest := pushedAuthorizationRequest{
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
 // NewRevocationServiceInterfaceMock creates a new instance of RevocationServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -38,6 +39,332 @@ func (_m *RevocationServiceInterfaceMock) EXPECT() *RevocationServiceInterfaceMo
 	return &RevocationServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// ListRefreshTokens provides a mock function for the type RevocationServiceInterfaceMock
+func (_mock *RevocationServiceInterfaceMock) ListRefreshTokens(ctx context.Context, filter RefreshTokenFilter, limit int, offset int) (*RefreshTokenListResponse, *common.ServiceError) {
+	ret := _mock.Called(ctx, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRefreshTokens")
+	}
+
+	var r0 *RefreshTokenListResponse
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RefreshTokenFilter, int, int) (*RefreshTokenListResponse, *common.ServiceError)); ok {
+		return returnFunc(ctx, filter, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RefreshTokenFilter, int, int) *RefreshTokenListResponse); ok {
+		r0 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RefreshTokenListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, RefreshTokenFilter, int, int) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// RevocationServiceInterfaceMock_ListRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRefreshTokens'
+type RevocationServiceInterfaceMock_ListRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// ListRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter RefreshTokenFilter
+//   - limit int
+//   - offset int
+func (_e *RevocationServiceInterfaceMock_Expecter) ListRefreshTokens(ctx interface{}, filter interface{}, limit interface{}, offset interface{}) *RevocationServiceInterfaceMock_ListRefreshTokens_Call {
+	return &RevocationServiceInterfaceMock_ListRefreshTokens_Call{Call: _e.mock.On("ListRefreshTokens", ctx, filter, limit, offset)}
+}
+
+func (_c *RevocationServiceInterfaceMock_ListRefreshTokens_Call) Run(run func(ctx context.Context, filter RefreshTokenFilter, limit int, offset int)) *RevocationServiceInterfaceMock_ListRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 RefreshTokenFilter
+		if args[1] != nil {
+			arg1 = args[1].(RefreshTokenFilter)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_ListRefreshTokens_Call) Return(refreshTokenListResponse *RefreshTokenListResponse, serviceError *common.ServiceError) *RevocationServiceInterfaceMock_ListRefreshTokens_Call {
+	_c.Call.Return(refreshTokenListResponse, serviceError)
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_ListRefreshTokens_Call) RunAndReturn(run func(ctx context.Context, filter RefreshTokenFilter, limit int, offset int) (*RefreshTokenListResponse, *common.ServiceError)) *RevocationServiceInterfaceMock_ListRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterRefreshToken provides a mock function for the type RevocationServiceInterfaceMock
+func (_mock *RevocationServiceInterfaceMock) RegisterRefreshToken(ctx context.Context, token IssuedRefreshToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, IssuedRefreshToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationServiceInterfaceMock_RegisterRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterRefreshToken'
+type RevocationServiceInterfaceMock_RegisterRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RegisterRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token IssuedRefreshToken
+func (_e *RevocationServiceInterfaceMock_Expecter) RegisterRefreshToken(ctx interface{}, token interface{}) *RevocationServiceInterfaceMock_RegisterRefreshToken_Call {
+	return &RevocationServiceInterfaceMock_RegisterRefreshToken_Call{Call: _e.mock.On("RegisterRefreshToken", ctx, token)}
+}
+
+func (_c *RevocationServiceInterfaceMock_RegisterRefreshToken_Call) Run(run func(ctx context.Context, token IssuedRefreshToken)) *RevocationServiceInterfaceMock_RegisterRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 IssuedRefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(IssuedRefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RegisterRefreshToken_Call) Return(err error) *RevocationServiceInterfaceMock_RegisterRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RegisterRefreshToken_Call) RunAndReturn(run func(ctx context.Context, token IssuedRefreshToken) error) *RevocationServiceInterfaceMock_RegisterRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeClientTokensAsAdmin provides a mock function for the type RevocationServiceInterfaceMock
+func (_mock *RevocationServiceInterfaceMock) RevokeClientTokensAsAdmin(ctx context.Context, clientID string) *common.ServiceError {
+	ret := _mock.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeClientTokensAsAdmin")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeClientTokensAsAdmin'
+type RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call struct {
+	*mock.Call
+}
+
+// RevokeClientTokensAsAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+func (_e *RevocationServiceInterfaceMock_Expecter) RevokeClientTokensAsAdmin(ctx interface{}, clientID interface{}) *RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call {
+	return &RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call{Call: _e.mock.On("RevokeClientTokensAsAdmin", ctx, clientID)}
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call) Run(run func(ctx context.Context, clientID string)) *RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call) Return(serviceError *common.ServiceError) *RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call) RunAndReturn(run func(ctx context.Context, clientID string) *common.ServiceError) *RevocationServiceInterfaceMock_RevokeClientTokensAsAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeCodeReplayToken provides a mock function for the type RevocationServiceInterfaceMock
+func (_mock *RevocationServiceInterfaceMock) RevokeCodeReplayToken(ctx context.Context, jti string, expiryTime time.Time) error {
+	ret := _mock.Called(ctx, jti, expiryTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeCodeReplayToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, jti, expiryTime)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeCodeReplayToken'
+type RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call struct {
+	*mock.Call
+}
+
+// RevokeCodeReplayToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+//   - expiryTime time.Time
+func (_e *RevocationServiceInterfaceMock_Expecter) RevokeCodeReplayToken(ctx interface{}, jti interface{}, expiryTime interface{}) *RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call {
+	return &RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call{Call: _e.mock.On("RevokeCodeReplayToken", ctx, jti, expiryTime)}
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call) Run(run func(ctx context.Context, jti string, expiryTime time.Time)) *RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call) Return(err error) *RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call) RunAndReturn(run func(ctx context.Context, jti string, expiryTime time.Time) error) *RevocationServiceInterfaceMock_RevokeCodeReplayToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeJTIAsAdmin provides a mock function for the type RevocationServiceInterfaceMock
+func (_mock *RevocationServiceInterfaceMock) RevokeJTIAsAdmin(ctx context.Context, jti string, expiryTime time.Time) *common.ServiceError {
+	ret := _mock.Called(ctx, jti, expiryTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeJTIAsAdmin")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, jti, expiryTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeJTIAsAdmin'
+type RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call struct {
+	*mock.Call
+}
+
+// RevokeJTIAsAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+//   - expiryTime time.Time
+func (_e *RevocationServiceInterfaceMock_Expecter) RevokeJTIAsAdmin(ctx interface{}, jti interface{}, expiryTime interface{}) *RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call {
+	return &RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call{Call: _e.mock.On("RevokeJTIAsAdmin", ctx, jti, expiryTime)}
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call) Run(run func(ctx context.Context, jti string, expiryTime time.Time)) *RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call) Return(serviceError *common.ServiceError) *RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call) RunAndReturn(run func(ctx context.Context, jti string, expiryTime time.Time) *common.ServiceError) *RevocationServiceInterfaceMock_RevokeJTIAsAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeRefreshToken provides a mock function for the type RevocationServiceInterfaceMock
 func (_mock *RevocationServiceInterfaceMock) RevokeRefreshToken(ctx context.Context, jti string, expiryTime time.Time) error {
 	ret := _mock.Called(ctx, jti, expiryTime)
@@ -178,3 +505,121 @@ func (_c *RevocationServiceInterfaceMock_RevokeToken_Call) RunAndReturn(run func
 	_c.Call.Return(run)
 	return _c
 }
+
+// RevokeTokenAsAdmin provides a mock function for the type RevocationServiceInterfaceMock
+func (_mock *RevocationServiceInterfaceMock) RevokeTokenAsAdmin(ctx context.Context, token string) *common.ServiceError {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeTokenAsAdmin")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeTokenAsAdmin'
+type RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call struct {
+	*mock.Call
+}
+
+// RevokeTokenAsAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *RevocationServiceInterfaceMock_Expecter) RevokeTokenAsAdmin(ctx interface{}, token interface{}) *RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call {
+	return &RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call{Call: _e.mock.On("RevokeTokenAsAdmin", ctx, token)}
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call) Run(run func(ctx context.Context, token string)) *RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call) Return(serviceError *common.ServiceError) *RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call) RunAndReturn(run func(ctx context.Context, token string) *common.ServiceError) *RevocationServiceInterfaceMock_RevokeTokenAsAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeUserTokensAsAdmin provides a mock function for the type RevocationServiceInterfaceMock
+func (_mock *RevocationServiceInterfaceMock) RevokeUserTokensAsAdmin(ctx context.Context, userID string) *common.ServiceError {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserTokensAsAdmin")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserTokensAsAdmin'
+type RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call struct {
+	*mock.Call
+}
+
+// RevokeUserTokensAsAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *RevocationServiceInterfaceMock_Expecter) RevokeUserTokensAsAdmin(ctx interface{}, userID interface{}) *RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call {
+	return &RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call{Call: _e.mock.On("RevokeUserTokensAsAdmin", ctx, userID)}
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call) Run(run func(ctx context.Context, userID string)) *RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call) Return(serviceError *common.ServiceError) *RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call) RunAndReturn(run func(ctx context.Context, userID string) *common.ServiceError) *RevocationServiceInterfaceMock_RevokeUserTokensAsAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
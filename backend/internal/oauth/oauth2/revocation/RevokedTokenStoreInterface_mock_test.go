@@ -37,6 +37,188 @@ func (_m *RevokedTokenStoreInterfaceMock) EXPECT() *RevokedTokenStoreInterfaceMo
 	return &RevokedTokenStoreInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// DeleteRefreshToken provides a mock function for the type RevokedTokenStoreInterfaceMock
+func (_mock *RevokedTokenStoreInterfaceMock) DeleteRefreshToken(ctx context.Context, jti string) error {
+	ret := _mock.Called(ctx, jti)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, jti)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRefreshToken'
+type RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call struct {
+	*mock.Call
+}
+
+// DeleteRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+func (_e *RevokedTokenStoreInterfaceMock_Expecter) DeleteRefreshToken(ctx interface{}, jti interface{}) *RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call {
+	return &RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call{Call: _e.mock.On("DeleteRefreshToken", ctx, jti)}
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call) Run(run func(ctx context.Context, jti string)) *RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call) Return(err error) *RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call) RunAndReturn(run func(ctx context.Context, jti string) error) *RevokedTokenStoreInterfaceMock_DeleteRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshTokens provides a mock function for the type RevokedTokenStoreInterfaceMock
+func (_mock *RevokedTokenStoreInterfaceMock) GetRefreshTokens(ctx context.Context, filter RefreshTokenFilter) ([]IssuedRefreshToken, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshTokens")
+	}
+
+	var r0 []IssuedRefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RefreshTokenFilter) ([]IssuedRefreshToken, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RefreshTokenFilter) []IssuedRefreshToken); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]IssuedRefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, RefreshTokenFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshTokens'
+type RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// GetRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter RefreshTokenFilter
+func (_e *RevokedTokenStoreInterfaceMock_Expecter) GetRefreshTokens(ctx interface{}, filter interface{}) *RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call {
+	return &RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call{Call: _e.mock.On("GetRefreshTokens", ctx, filter)}
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call) Run(run func(ctx context.Context, filter RefreshTokenFilter)) *RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 RefreshTokenFilter
+		if args[1] != nil {
+			arg1 = args[1].(RefreshTokenFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call) Return(issuedRefreshTokens []IssuedRefreshToken, err error) *RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call {
+	_c.Call.Return(issuedRefreshTokens, err)
+	return _c
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call) RunAndReturn(run func(ctx context.Context, filter RefreshTokenFilter) ([]IssuedRefreshToken, error)) *RevokedTokenStoreInterfaceMock_GetRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertRefreshToken provides a mock function for the type RevokedTokenStoreInterfaceMock
+func (_mock *RevokedTokenStoreInterfaceMock) InsertRefreshToken(ctx context.Context, token IssuedRefreshToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, IssuedRefreshToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertRefreshToken'
+type RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call struct {
+	*mock.Call
+}
+
+// InsertRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token IssuedRefreshToken
+func (_e *RevokedTokenStoreInterfaceMock_Expecter) InsertRefreshToken(ctx interface{}, token interface{}) *RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call {
+	return &RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call{Call: _e.mock.On("InsertRefreshToken", ctx, token)}
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call) Run(run func(ctx context.Context, token IssuedRefreshToken)) *RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 IssuedRefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(IssuedRefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call) Return(err error) *RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call) RunAndReturn(run func(ctx context.Context, token IssuedRefreshToken) error) *RevokedTokenStoreInterfaceMock_InsertRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertRevokedToken provides a mock function for the type RevokedTokenStoreInterfaceMock
 func (_mock *RevokedTokenStoreInterfaceMock) InsertRevokedToken(ctx context.Context, token RevokedToken) error {
 	ret := _mock.Called(ctx, token)
@@ -159,3 +341,89 @@ func (_c *RevokedTokenStoreInterfaceMock_IsTokenRevoked_Call) RunAndReturn(run f
 	_c.Call.Return(run)
 	return _c
 }
+
+// ListRefreshTokens provides a mock function for the type RevokedTokenStoreInterfaceMock
+func (_mock *RevokedTokenStoreInterfaceMock) ListRefreshTokens(ctx context.Context, filter RefreshTokenFilter, limit int, offset int) ([]IssuedRefreshToken, int, error) {
+	ret := _mock.Called(ctx, filter, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRefreshTokens")
+	}
+
+	var r0 []IssuedRefreshToken
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RefreshTokenFilter, int, int) ([]IssuedRefreshToken, int, error)); ok {
+		return returnFunc(ctx, filter, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RefreshTokenFilter, int, int) []IssuedRefreshToken); ok {
+		r0 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]IssuedRefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, RefreshTokenFilter, int, int) int); ok {
+		r1 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, RefreshTokenFilter, int, int) error); ok {
+		r2 = returnFunc(ctx, filter, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRefreshTokens'
+type RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call struct {
+	*mock.Call
+}
+
+// ListRefreshTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter RefreshTokenFilter
+//   - limit int
+//   - offset int
+func (_e *RevokedTokenStoreInterfaceMock_Expecter) ListRefreshTokens(ctx interface{}, filter interface{}, limit interface{}, offset interface{}) *RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call {
+	return &RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call{Call: _e.mock.On("ListRefreshTokens", ctx, filter, limit, offset)}
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call) Run(run func(ctx context.Context, filter RefreshTokenFilter, limit int, offset int)) *RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 RefreshTokenFilter
+		if args[1] != nil {
+			arg1 = args[1].(RefreshTokenFilter)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call) Return(issuedRefreshTokens []IssuedRefreshToken, n int, err error) *RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call {
+	_c.Call.Return(issuedRefreshTokens, n, err)
+	return _c
+}
+
+func (_c *RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call) RunAndReturn(run func(ctx context.Context, filter RefreshTokenFilter, limit int, offset int) ([]IssuedRefreshToken, int, error)) *RevokedTokenStoreInterfaceMock_ListRefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -18,7 +18,11 @@
 
 package revocation
 
-import "errors"
+import (
+	"errors"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
 
 // ErrTokenRevoked indicates the presented token's JTI is on the deny list.
 var ErrTokenRevoked = errors.New("token has been revoked")
@@ -26,3 +30,76 @@ var ErrTokenRevoked = errors.New("token has been revoked")
 // ErrEnforcementUnavailable indicates the deny list could not be consulted (operation DB
 // unavailable or the circuit is open). Under the fail-closed policy callers MUST reject the token.
 var ErrEnforcementUnavailable = errors.New("token revocation enforcement is unavailable")
+
+// Client errors for administrative token search and revocation.
+var (
+	// ErrorInvalidAdminRevokeRequest is returned when a request carries neither a token nor a jti with
+	// its expiry time.
+	ErrorInvalidAdminRevokeRequest = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "RVK-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.revocationservice.invalid_request",
+			DefaultValue: "Invalid revocation request",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.revocationservice.invalid_request_description",
+			DefaultValue: "Provide exactly one of a token, a jti together with the token's expiry time, " +
+				"a user ID or a client ID",
+		},
+	}
+	// ErrorInvalidTokenForRevocation is returned when the token was not issued by this server or has no jti.
+	ErrorInvalidTokenForRevocation = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "RVK-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.revocationservice.invalid_token",
+			DefaultValue: "Invalid token",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.revocationservice.invalid_token_description",
+			DefaultValue: "The token was not issued by this server or cannot be revoked",
+		},
+	}
+	// ErrorInvalidLimit is returned when the limit parameter of a token search is invalid.
+	ErrorInvalidLimit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "RVK-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.revocationservice.invalid_limit_parameter",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.revocationservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is returned when the offset parameter of a token search is invalid.
+	ErrorInvalidOffset = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "RVK-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.revocationservice.invalid_offset_parameter",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.revocationservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+	// ErrorInvalidIssueTimeFilter is returned when the issuedAfter or issuedBefore parameter of a token
+	// search is invalid.
+	ErrorInvalidIssueTimeFilter = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "RVK-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.revocationservice.invalid_issue_time_filter",
+			DefaultValue: "Invalid issue time filter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key: "error.revocationservice.invalid_issue_time_filter_description",
+			DefaultValue: "The issuedAfter and issuedBefore parameters must be positive integers " +
+				"in seconds since the epoch",
+		},
+	}
+)
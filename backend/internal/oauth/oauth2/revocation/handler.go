@@ -19,12 +19,19 @@
 package revocation
 
 import (
+	"context"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
 // revocationHandler handles OAuth 2.0 token revocation requests (RFC 7009).
@@ -83,3 +90,143 @@ func (h *revocationHandler) HandleRevoke(w http.ResponseWriter, r *http.Request)
 		w.WriteHeader(http.StatusOK)
 	}
 }
+
+// HandleAdminRevoke handles administrative revocation requests. The route is not public, so the
+// security middleware has already authorized the caller for system administration.
+func (h *revocationHandler) HandleAdminRevoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	request, err := sysutils.DecodeJSONBody[AdminRevokeRequest](r)
+	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
+		handleAdminError(ctx, w, &ErrorInvalidAdminRevokeRequest)
+		return
+	}
+
+	var svcErr *tidcommon.ServiceError
+	switch {
+	case countSetFields(request.Token, request.JTI, request.UserID, request.ClientID) != 1:
+		svcErr = &ErrorInvalidAdminRevokeRequest
+	case request.Token != "":
+		svcErr = h.service.RevokeTokenAsAdmin(ctx, request.Token)
+	case request.JTI != "" && request.ExpiresAt > 0:
+		svcErr = h.service.RevokeJTIAsAdmin(ctx, request.JTI, time.Unix(request.ExpiresAt, 0))
+	case request.UserID != "":
+		svcErr = h.service.RevokeUserTokensAsAdmin(ctx, request.UserID)
+	case request.ClientID != "":
+		svcErr = h.service.RevokeClientTokensAsAdmin(ctx, request.ClientID)
+	default:
+		svcErr = &ErrorInvalidAdminRevokeRequest
+	}
+	if svcErr != nil {
+		handleAdminError(ctx, w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleListTokens handles requests to list the active refresh tokens, optionally filtered by user,
+// client or issue time. The route is not public, so the security middleware has already authorized the
+// caller for system administration.
+func (h *revocationHandler) HandleListTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	limit, offset, svcErr := parsePaginationParams(query)
+	if svcErr != nil {
+		handleAdminError(ctx, w, svcErr)
+		return
+	}
+	issuedAfter, svcErr := parseIssueTimeParam(query, queryParamIssuedAfter)
+	if svcErr != nil {
+		handleAdminError(ctx, w, svcErr)
+		return
+	}
+	issuedBefore, svcErr := parseIssueTimeParam(query, queryParamIssuedBefore)
+	if svcErr != nil {
+		handleAdminError(ctx, w, svcErr)
+		return
+	}
+
+	filter := RefreshTokenFilter{
+		UserID:       query.Get(queryParamUserID),
+		ClientID:     query.Get(queryParamClientID),
+		IssuedAfter:  issuedAfter,
+		IssuedBefore: issuedBefore,
+	}
+	response, svcErr := h.service.ListRefreshTokens(ctx, filter, limit, offset)
+	if svcErr != nil {
+		handleAdminError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, response)
+}
+
+// parsePaginationParams parses the limit and offset query parameters, applying the default page size
+// when the limit is absent.
+func parsePaginationParams(query url.Values) (int, int, *tidcommon.ServiceError) {
+	limit := serverconst.DefaultPageSize
+	if limitStr := query.Get(queryParamLimit); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			return 0, 0, &ErrorInvalidLimit
+		}
+		limit = parsedLimit
+	}
+
+	offset := 0
+	if offsetStr := query.Get(queryParamOffset); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil || parsedOffset < 0 {
+			return 0, 0, &ErrorInvalidOffset
+		}
+		offset = parsedOffset
+	}
+
+	return limit, offset, nil
+}
+
+// parseIssueTimeParam parses an issue time query parameter given in seconds since the epoch. An absent
+// parameter yields the zero time, which does not filter.
+func parseIssueTimeParam(query url.Values, name string) (time.Time, *tidcommon.ServiceError) {
+	value := query.Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}, &ErrorInvalidIssueTimeFilter
+	}
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+// countSetFields returns the number of non-empty values.
+func countSetFields(values ...string) int {
+	count := 0
+	for _, v := range values {
+		if v != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// handleAdminError maps a service error of the administrative endpoint to an HTTP error response.
+func handleAdminError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteProblemResponse(ctx, w, statusCode, errResp)
+}
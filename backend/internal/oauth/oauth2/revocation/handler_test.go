@@ -25,12 +25,14 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
 
 type RevocationHandlerTestSuite struct {
@@ -109,3 +111,163 @@ func (s *RevocationHandlerTestSuite) TestHandleRevoke_ServerError() {
 	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
 	assert.Contains(s.T(), rec.Body.String(), "server_error")
 }
+
+// newAdminRevokeRequest builds a POST /tokens/revoke request with a JSON body.
+func newAdminRevokeRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/tokens/revoke", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleAdminRevoke_Token() {
+	s.serviceMock.On("RevokeTokenAsAdmin", mock.Anything, "tok").Return(nil)
+
+	rec := httptest.NewRecorder()
+	s.handler.HandleAdminRevoke(rec, newAdminRevokeRequest(`{"token":"tok"}`))
+
+	assert.Equal(s.T(), http.StatusNoContent, rec.Code)
+	s.serviceMock.AssertExpectations(s.T())
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleAdminRevoke_JTI() {
+	s.serviceMock.On("RevokeJTIAsAdmin", mock.Anything, "jti-1", mock.MatchedBy(func(t time.Time) bool {
+		return t.Unix() == 1900000000
+	})).Return(nil)
+
+	rec := httptest.NewRecorder()
+	s.handler.HandleAdminRevoke(rec, newAdminRevokeRequest(`{"jti":"jti-1","expiresAt":1900000000}`))
+
+	assert.Equal(s.T(), http.StatusNoContent, rec.Code)
+	s.serviceMock.AssertExpectations(s.T())
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleAdminRevoke_InvalidRequest() {
+	testCases := []string{
+		`{}`,
+		`{"jti":"jti-1"}`,
+		`{"token":"tok","jti":"jti-1","expiresAt":1900000000}`,
+		`{"userId":"user-1","clientId":"client-1"}`,
+		`{"token":"tok","userId":"user-1"}`,
+		`not-json`,
+	}
+	for _, body := range testCases {
+		rec := httptest.NewRecorder()
+		s.handler.HandleAdminRevoke(rec, newAdminRevokeRequest(body))
+
+		assert.Equal(s.T(), http.StatusBadRequest, rec.Code, body)
+		assert.Contains(s.T(), rec.Body.String(), ErrorInvalidAdminRevokeRequest.Code, body)
+	}
+	s.serviceMock.AssertNotCalled(s.T(), "RevokeTokenAsAdmin", mock.Anything, mock.Anything)
+	s.serviceMock.AssertNotCalled(s.T(), "RevokeJTIAsAdmin", mock.Anything, mock.Anything, mock.Anything)
+	s.serviceMock.AssertNotCalled(s.T(), "RevokeUserTokensAsAdmin", mock.Anything, mock.Anything)
+	s.serviceMock.AssertNotCalled(s.T(), "RevokeClientTokensAsAdmin", mock.Anything, mock.Anything)
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleAdminRevoke_UserID() {
+	s.serviceMock.On("RevokeUserTokensAsAdmin", mock.Anything, "user-1").Return(nil)
+
+	rec := httptest.NewRecorder()
+	s.handler.HandleAdminRevoke(rec, newAdminRevokeRequest(`{"userId":"user-1"}`))
+
+	assert.Equal(s.T(), http.StatusNoContent, rec.Code)
+	s.serviceMock.AssertExpectations(s.T())
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleAdminRevoke_ClientID() {
+	s.serviceMock.On("RevokeClientTokensAsAdmin", mock.Anything, "client-1").Return(nil)
+
+	rec := httptest.NewRecorder()
+	s.handler.HandleAdminRevoke(rec, newAdminRevokeRequest(`{"clientId":"client-1"}`))
+
+	assert.Equal(s.T(), http.StatusNoContent, rec.Code)
+	s.serviceMock.AssertExpectations(s.T())
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleAdminRevoke_InvalidToken() {
+	s.serviceMock.On("RevokeTokenAsAdmin", mock.Anything, "tok").Return(&ErrorInvalidTokenForRevocation)
+
+	rec := httptest.NewRecorder()
+	s.handler.HandleAdminRevoke(rec, newAdminRevokeRequest(`{"token":"tok"}`))
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(s.T(), rec.Body.String(), ErrorInvalidTokenForRevocation.Code)
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleAdminRevoke_ServerError() {
+	s.serviceMock.On("RevokeTokenAsAdmin", mock.Anything, "tok").Return(&tidcommon.InternalServerError)
+
+	rec := httptest.NewRecorder()
+	s.handler.HandleAdminRevoke(rec, newAdminRevokeRequest(`{"token":"tok"}`))
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleListTokens_Success() {
+	expectedFilter := RefreshTokenFilter{
+		UserID:       "user-1",
+		ClientID:     "client-1",
+		IssuedAfter:  time.Unix(1800000000, 0).UTC(),
+		IssuedBefore: time.Unix(1900000000, 0).UTC(),
+	}
+	s.serviceMock.On("ListRefreshTokens", mock.Anything, expectedFilter, 5, 10).Return(&RefreshTokenListResponse{
+		TotalResults: 11,
+		StartIndex:   11,
+		Count:        1,
+		Tokens:       []RefreshTokenResponse{{JTI: "rt-1", ClientID: "client-1", UserID: "user-1"}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/tokens?userId=user-1&clientId=client-1"+
+		"&issuedAfter=1800000000&issuedBefore=1900000000&limit=5&offset=10", nil)
+	rec := httptest.NewRecorder()
+	s.handler.HandleListTokens(rec, req)
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+	assert.Contains(s.T(), rec.Body.String(), `"jti":"rt-1"`)
+	assert.Contains(s.T(), rec.Body.String(), `"totalResults":11`)
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleListTokens_DefaultPagination() {
+	s.serviceMock.On("ListRefreshTokens", mock.Anything, RefreshTokenFilter{}, 30, 0).
+		Return(&RefreshTokenListResponse{Tokens: []RefreshTokenResponse{}}, nil)
+
+	rec := httptest.NewRecorder()
+	s.handler.HandleListTokens(rec, httptest.NewRequest(http.MethodGet, "/tokens", nil))
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+	s.serviceMock.AssertExpectations(s.T())
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleListTokens_InvalidQueryParams() {
+	testCases := []struct {
+		name         string
+		query        string
+		expectedCode string
+	}{
+		{name: "NonNumericLimit", query: "limit=abc", expectedCode: ErrorInvalidLimit.Code},
+		{name: "ZeroLimit", query: "limit=0", expectedCode: ErrorInvalidLimit.Code},
+		{name: "NegativeOffset", query: "offset=-1", expectedCode: ErrorInvalidOffset.Code},
+		{name: "NonNumericIssuedAfter", query: "issuedAfter=yesterday", expectedCode: ErrorInvalidIssueTimeFilter.Code},
+		{name: "NegativeIssuedBefore", query: "issuedBefore=-5", expectedCode: ErrorInvalidIssueTimeFilter.Code},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			rec := httptest.NewRecorder()
+			s.handler.HandleListTokens(rec, httptest.NewRequest(http.MethodGet, "/tokens?"+tc.query, nil))
+
+			assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+			assert.Contains(s.T(), rec.Body.String(), tc.expectedCode)
+		})
+	}
+	s.serviceMock.AssertNotCalled(s.T(), "ListRefreshTokens", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (s *RevocationHandlerTestSuite) TestHandleListTokens_ServiceError() {
+	s.serviceMock.On("ListRefreshTokens", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, &tidcommon.InternalServerError)
+
+	rec := httptest.NewRecorder()
+	s.handler.HandleListTokens(rec, httptest.NewRequest(http.MethodGet, "/tokens", nil))
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
@@ -34,9 +34,11 @@ import (
 )
 
 // Initialize wires the revocation feature: it constructs the shared enforcement service (read path)
-// and registers the RFC 7009 revocation endpoint (write path). It returns the enforcement service (to
-// inject into the hot paths — refresh grant, token exchange, introspection) and the refresh-token
-// revoker (to inject into the refresh grant for single-use rotation).
+// and registers the RFC 7009 and administrative revocation endpoints (write path) together with the
+// administrative refresh token list. It returns the enforcement service (to inject into the hot paths —
+// refresh grant, token exchange, introspection), the refresh-token revoker (to inject into the refresh
+// grant for refresh token registration and single-use rotation) and the code-replay revoker (to inject
+// into the authorization code flow for revoking tokens issued from a replayed code).
 func Initialize(
 	mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface,
@@ -44,15 +46,15 @@ func Initialize(
 	authnProvider providers.AuthnProviderManager,
 	discoveryService discovery.DiscoveryServiceInterface,
 	observabilitySvc providers.ObservabilityProvider,
-) (EnforcementServiceInterface, RefreshTokenRevokerInterface) {
+) (EnforcementServiceInterface, RefreshTokenRevokerInterface, CodeReplayRevokerInterface) {
 	enforcementService := newEnforcementService(observabilitySvc)
 	revocationService := newRevocationService(jwtService, newRevokedTokenStore(), observabilitySvc)
 	revocationHandler := newRevocationHandler(revocationService)
 	registerRoutes(mux, revocationHandler, actorProvider, authnProvider, jwtService, discoveryService)
-	return enforcementService, revocationService
+	return enforcementService, revocationService, revocationService
 }
 
-// registerRoutes registers the routes for the token revocation endpoint.
+// registerRoutes registers the routes for the token revocation endpoints and the administrative token list.
 func registerRoutes(
 	mux *http.ServeMux,
 	revocationHandler *revocationHandler,
@@ -82,4 +84,24 @@ func registerRoutes(
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts))
+
+	// The administrative endpoint sits outside /oauth2 so that it is not public and requires the root
+	// system permission.
+	mux.HandleFunc(middleware.WithCORS("POST /tokens/revoke", revocationHandler.HandleAdminRevoke, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /tokens/revoke",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "OPTIONS"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /tokens", revocationHandler.HandleListTokens, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /tokens",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, listOpts))
 }
@@ -69,13 +69,15 @@ func (suite *InitTestSuite) TearDownTest() {
 func (suite *InitTestSuite) TestInitialize() {
 	mux := http.NewServeMux()
 
-	enforcementService, refreshTokenRevoker := Initialize(
+	enforcementService, refreshTokenRevoker, codeReplayRevoker := Initialize(
 		mux, suite.mockJWTService, nil, nil, suite.mockDiscoveryService, nil)
 
 	assert.NotNil(suite.T(), enforcementService)
 	assert.Implements(suite.T(), (*EnforcementServiceInterface)(nil), enforcementService)
 	assert.NotNil(suite.T(), refreshTokenRevoker)
 	assert.Implements(suite.T(), (*RefreshTokenRevokerInterface)(nil), refreshTokenRevoker)
+	assert.NotNil(suite.T(), codeReplayRevoker)
+	assert.Implements(suite.T(), (*CodeReplayRevokerInterface)(nil), codeReplayRevoker)
 }
 
 func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
@@ -89,4 +91,6 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 
 	_, pattern = mux.Handler(&http.Request{Method: "OPTIONS", URL: &url.URL{Path: "/oauth2/revoke"}})
 	assert.Contains(suite.T(), pattern, "/oauth2/revoke")
+	_, pattern = mux.Handler(&http.Request{Method: "POST", URL: &url.URL{Path: "/tokens/revoke"}})
+	assert.Contains(suite.T(), pattern, "/tokens/revoke")
 }
@@ -39,6 +39,10 @@ const (
 	RevocationReasonExplicit RevocationReason = "explicit"
 	// RevocationReasonRefreshRotation denotes revocation of a consumed refresh token on rotation.
 	RevocationReasonRefreshRotation RevocationReason = "refresh_rotation"
+	// RevocationReasonCodeReplay denotes revocation of a token issued from a replayed authorization code.
+	RevocationReasonCodeReplay RevocationReason = "code_replay"
+	// RevocationReasonAdmin denotes revocation by an administrator through the management API.
+	RevocationReasonAdmin RevocationReason = "admin"
 )
 
 // RevokedToken represents a single revoked token entry in the deny list.
@@ -54,3 +58,64 @@ type RevokedToken struct {
 	// ExpiryTime is the revoked token's original expiry; the row is removable once this passes.
 	ExpiryTime time.Time
 }
+
+// IssuedRefreshToken represents an entry in the registry of issued refresh tokens.
+type IssuedRefreshToken struct {
+	// JTI is the jti claim of the refresh token.
+	JTI string
+	// ClientID is the client the refresh token was issued to.
+	ClientID string
+	// Subject is the subject of the access tokens obtained with the refresh token.
+	Subject string
+	// IssuedAt is the time the refresh token was issued.
+	IssuedAt time.Time
+	// ExpiryTime is the refresh token's expiry; the row is removable once this passes.
+	ExpiryTime time.Time
+}
+
+// RefreshTokenFilter narrows a search of the issued refresh tokens. Empty fields match all tokens.
+type RefreshTokenFilter struct {
+	// UserID matches the subject of the refresh token.
+	UserID string
+	// ClientID matches the client the refresh token was issued to.
+	ClientID string
+	// IssuedAfter matches tokens issued at or after this time.
+	IssuedAfter time.Time
+	// IssuedBefore matches tokens issued before this time.
+	IssuedBefore time.Time
+}
+
+// RefreshTokenResponse is an active refresh token in the administrative token list.
+type RefreshTokenResponse struct {
+	JTI      string `json:"jti"`
+	ClientID string `json:"clientId"`
+	UserID   string `json:"userId"`
+	// IssuedAt is the time, in seconds since the epoch, the refresh token was issued.
+	IssuedAt int64 `json:"issuedAt"`
+	// ExpiresAt is the exp claim, in seconds since the epoch, of the refresh token.
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// RefreshTokenListResponse is the response body for listing the active refresh tokens.
+type RefreshTokenListResponse struct {
+	TotalResults int                    `json:"totalResults"`
+	StartIndex   int                    `json:"startIndex"`
+	Count        int                    `json:"count"`
+	Tokens       []RefreshTokenResponse `json:"tokens"`
+}
+
+// AdminRevokeRequest is the body of an administrative revocation request. Exactly one of Token, JTI
+// together with ExpiresAt, UserID or ClientID must be set.
+type AdminRevokeRequest struct {
+	// Token is the token to revoke.
+	Token string `json:"token,omitempty"`
+	// JTI is the jti claim of a token that is not at hand, for example one taken from an audit event.
+	JTI string `json:"jti,omitempty"`
+	// ExpiresAt is the exp claim, in seconds since the epoch, of the token identified by JTI.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+	// UserID revokes all active refresh tokens of the user, for example when the user signs out everywhere.
+	UserID string `json:"userId,omitempty"`
+	// ClientID revokes all active refresh tokens issued to the client, for example when its secret is
+	// compromised.
+	ClientID string `json:"clientId,omitempty"`
+}
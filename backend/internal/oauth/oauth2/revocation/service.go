@@ -24,16 +24,20 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	syscontext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // RevocationServiceInterface defines the OAuth2 token revocation service (RFC 7009).
 type RevocationServiceInterface interface {
 	RefreshTokenRevokerInterface
+	CodeReplayRevokerInterface
+	AdminRevokerInterface
 
 	// RevokeToken revokes the presented token on behalf of the authenticated client.
 	//
@@ -47,16 +51,57 @@ type RevocationServiceInterface interface {
 	RevokeToken(ctx context.Context, token, tokenTypeHint, authenticatedClientID string) (RevokeOutcome, error)
 }
 
-// RefreshTokenRevokerInterface is the narrow write seam the refresh grant uses to enforce single-use
-// refresh tokens (RFC 9700 §4.14.2): the consumed refresh token is recorded on the deny list so it
-// cannot be replayed. It exposes no read or client-facing revocation.
+// RefreshTokenRevokerInterface is the narrow write seam the refresh grant uses to register the refresh
+// tokens it issues, so that administrators can find and revoke them, and to enforce single-use refresh
+// tokens (RFC 9700 §4.14.2): the consumed refresh token is recorded on the deny list so it cannot be
+// replayed. It exposes no read or client-facing revocation.
 type RefreshTokenRevokerInterface interface {
+	// RegisterRefreshToken records an issued refresh token in the registry of active refresh tokens.
+	// A token without a jti is a no-op.
+	RegisterRefreshToken(ctx context.Context, token IssuedRefreshToken) error
+
 	// RevokeRefreshToken records the refresh token's jti on the deny list with the refresh_rotation
 	// reason. expiryTime is the token's original expiry, which bounds the deny-list entry's lifetime.
 	// An empty jti is a no-op.
 	RevokeRefreshToken(ctx context.Context, jti string, expiryTime time.Time) error
 }
 
+// CodeReplayRevokerInterface is the narrow write seam the authorization code flow uses when a code is
+// presented more than once (RFC 6749 §4.1.2): the tokens previously issued from the code are recorded
+// on the deny list. It exposes no read or client-facing revocation.
+type CodeReplayRevokerInterface interface {
+	// RevokeCodeReplayToken records the jti of a token issued from a replayed authorization code on the
+	// deny list with the code_replay reason. expiryTime is the token's original expiry, which bounds the
+	// deny-list entry's lifetime. An empty jti is a no-op.
+	RevokeCodeReplayToken(ctx context.Context, jti string, expiryTime time.Time) error
+}
+
+// AdminRevokerInterface is the seam of the management API, used to find the active refresh tokens and to
+// revoke a compromised token, the tokens of a signed-out user or the tokens of a compromised client
+// regardless of the client they were issued to.
+type AdminRevokerInterface interface {
+	// ListRefreshTokens returns a page of the active refresh tokens that match the filter, most recently
+	// issued first.
+	ListRefreshTokens(ctx context.Context, filter RefreshTokenFilter, limit, offset int) (
+		*RefreshTokenListResponse, *tidcommon.ServiceError)
+
+	// RevokeUserTokensAsAdmin records all active refresh tokens of the user on the deny list with the
+	// admin reason.
+	RevokeUserTokensAsAdmin(ctx context.Context, userID string) *tidcommon.ServiceError
+
+	// RevokeClientTokensAsAdmin records all active refresh tokens issued to the client on the deny list
+	// with the admin reason.
+	RevokeClientTokensAsAdmin(ctx context.Context, clientID string) *tidcommon.ServiceError
+
+	// RevokeTokenAsAdmin records the token's jti on the deny list with the admin reason. The token must
+	// carry a valid signature of this server; expired tokens are accepted. No ownership check is applied.
+	RevokeTokenAsAdmin(ctx context.Context, token string) *tidcommon.ServiceError
+
+	// RevokeJTIAsAdmin records a jti on the deny list with the admin reason when the token itself is not
+	// at hand. expiryTime is the token's expiry, which bounds the deny-list entry's lifetime.
+	RevokeJTIAsAdmin(ctx context.Context, jti string, expiryTime time.Time) *tidcommon.ServiceError
+}
+
 // revocationService implements RevocationServiceInterface.
 type revocationService struct {
 	jwtService       jwt.JWTServiceInterface
@@ -125,14 +170,25 @@ func (s *revocationService) RevokeToken(
 		RevokedAt:        time.Now().UTC(),
 		ExpiryTime:       extractExpiryTime(payload),
 	}
-	if err := s.store.InsertRevokedToken(ctx, revoked); err != nil {
+	if err := s.recordRevocation(ctx, revoked); err != nil {
 		return RevokeOutcomeRevoked, fmt.Errorf("failed to record token revocation: %w", err)
 	}
 
-	s.publishTokenRevokedEvent(ctx, authenticatedClientID, jti)
+	s.publishTokenRevokedEvent(ctx, authenticatedClientID, jti, RevocationReasonExplicit)
 	return RevokeOutcomeRevoked, nil
 }
 
+// RegisterRefreshToken records an issued refresh token in the registry of active refresh tokens.
+func (s *revocationService) RegisterRefreshToken(ctx context.Context, token IssuedRefreshToken) error {
+	if token.JTI == "" {
+		return nil
+	}
+	if err := s.store.InsertRefreshToken(ctx, token); err != nil {
+		return fmt.Errorf("failed to register refresh token: %w", err)
+	}
+	return nil
+}
+
 // RevokeRefreshToken records a refresh token on the deny list with the refresh_rotation reason,
 // enforcing single-use on rotation. The token was already validated by the refresh grant, so no
 // signature or ownership check is repeated here. An empty jti is a no-op.
@@ -146,13 +202,168 @@ func (s *revocationService) RevokeRefreshToken(ctx context.Context, jti string,
 		RevokedAt:        time.Now().UTC(),
 		ExpiryTime:       expiryTime,
 	}
-	if err := s.store.InsertRevokedToken(ctx, revoked); err != nil {
+	if err := s.recordRevocation(ctx, revoked); err != nil {
 		return fmt.Errorf("failed to record refresh token revocation: %w", err)
 	}
 	s.logger.Debug(ctx, "Revoked refresh token")
 	return nil
 }
 
+// RevokeCodeReplayToken records a token issued from a replayed authorization code on the deny list with
+// the code_replay reason. The token was issued by this server, so no signature or ownership check is
+// performed. An empty jti is a no-op.
+func (s *revocationService) RevokeCodeReplayToken(ctx context.Context, jti string, expiryTime time.Time) error {
+	if jti == "" {
+		return nil
+	}
+	revoked := RevokedToken{
+		JTI:              jti,
+		RevocationReason: RevocationReasonCodeReplay,
+		RevokedAt:        time.Now().UTC(),
+		ExpiryTime:       expiryTime,
+	}
+	if err := s.recordRevocation(ctx, revoked); err != nil {
+		return fmt.Errorf("failed to record code replay revocation: %w", err)
+	}
+	s.logger.Debug(ctx, "Revoked token issued from a replayed authorization code")
+	return nil
+}
+
+// RevokeTokenAsAdmin verifies the token's signature and records its jti on the deny list with the admin
+// reason. Unlike RevokeToken, a token that cannot be verified is reported to the caller rather than
+// treated as a no-op, so an administrator is not misled into believing a token was revoked.
+func (s *revocationService) RevokeTokenAsAdmin(ctx context.Context, token string) *tidcommon.ServiceError {
+	if token == "" {
+		return &ErrorInvalidAdminRevokeRequest
+	}
+	if err := s.jwtService.VerifyJWTSignature(ctx, token); err != nil {
+		s.logger.Debug(ctx, "Administrative revocation request for a token that failed signature verification")
+		return &ErrorInvalidTokenForRevocation
+	}
+
+	_, payload, decodeErr := jwt.DecodeJWT(token)
+	if decodeErr != nil {
+		return &ErrorInvalidTokenForRevocation
+	}
+	jti, _ := payload[constants.ClaimJTI].(string)
+	if jti == "" {
+		return &ErrorInvalidTokenForRevocation
+	}
+
+	clientID, _ := payload[constants.ClaimClientID].(string)
+	return s.revokeAsAdmin(ctx, jti, clientID, extractExpiryTime(payload))
+}
+
+// RevokeJTIAsAdmin records a jti on the deny list with the admin reason.
+func (s *revocationService) RevokeJTIAsAdmin(ctx context.Context, jti string,
+	expiryTime time.Time) *tidcommon.ServiceError {
+	if jti == "" || expiryTime.IsZero() {
+		return &ErrorInvalidAdminRevokeRequest
+	}
+	return s.revokeAsAdmin(ctx, jti, "", expiryTime.UTC())
+}
+
+// ListRefreshTokens returns a page of the active refresh tokens that match the filter.
+func (s *revocationService) ListRefreshTokens(ctx context.Context, filter RefreshTokenFilter,
+	limit, offset int) (*RefreshTokenListResponse, *tidcommon.ServiceError) {
+	if limit < 1 || limit > serverconst.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+
+	tokens, totalCount, err := s.store.ListRefreshTokens(ctx, filter, limit, offset)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list refresh tokens", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	response := &RefreshTokenListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(tokens),
+		Tokens:       make([]RefreshTokenResponse, 0, len(tokens)),
+	}
+	for _, token := range tokens {
+		response.Tokens = append(response.Tokens, RefreshTokenResponse{
+			JTI:       token.JTI,
+			ClientID:  token.ClientID,
+			UserID:    token.Subject,
+			IssuedAt:  token.IssuedAt.Unix(),
+			ExpiresAt: token.ExpiryTime.Unix(),
+		})
+	}
+	return response, nil
+}
+
+// RevokeUserTokensAsAdmin revokes all active refresh tokens of the user.
+func (s *revocationService) RevokeUserTokensAsAdmin(ctx context.Context, userID string) *tidcommon.ServiceError {
+	if userID == "" {
+		return &ErrorInvalidAdminRevokeRequest
+	}
+	return s.revokeRefreshTokensAsAdmin(ctx, RefreshTokenFilter{UserID: userID})
+}
+
+// RevokeClientTokensAsAdmin revokes all active refresh tokens issued to the client.
+func (s *revocationService) RevokeClientTokensAsAdmin(ctx context.Context,
+	clientID string) *tidcommon.ServiceError {
+	if clientID == "" {
+		return &ErrorInvalidAdminRevokeRequest
+	}
+	return s.revokeRefreshTokensAsAdmin(ctx, RefreshTokenFilter{ClientID: clientID})
+}
+
+// revokeRefreshTokensAsAdmin revokes every active refresh token that matches the filter. Tokens revoked
+// before a failure stay revoked, so a retry only revokes the remaining ones.
+func (s *revocationService) revokeRefreshTokensAsAdmin(ctx context.Context,
+	filter RefreshTokenFilter) *tidcommon.ServiceError {
+	tokens, err := s.store.GetRefreshTokens(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get refresh tokens for revocation", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	for _, token := range tokens {
+		if svcErr := s.revokeAsAdmin(ctx, token.JTI, token.ClientID, token.ExpiryTime); svcErr != nil {
+			return svcErr
+		}
+	}
+
+	s.logger.Debug(ctx, "Revoked refresh tokens as administrator", log.Int("count", len(tokens)))
+	return nil
+}
+
+// revokeAsAdmin writes an administrative deny-list entry and emits the audit event.
+func (s *revocationService) revokeAsAdmin(ctx context.Context, jti, clientID string,
+	expiryTime time.Time) *tidcommon.ServiceError {
+	revoked := RevokedToken{
+		JTI:              jti,
+		RevocationReason: RevocationReasonAdmin,
+		RevokedAt:        time.Now().UTC(),
+		ExpiryTime:       expiryTime,
+	}
+	if err := s.recordRevocation(ctx, revoked); err != nil {
+		s.logger.Error(ctx, "Failed to record administrative token revocation", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+
+	s.publishTokenRevokedEvent(ctx, clientID, jti, RevocationReasonAdmin)
+	return nil
+}
+
+// recordRevocation writes a deny-list entry and removes the token from the registry of active refresh
+// tokens. The deny list is authoritative, so a failure to update the registry is only logged.
+func (s *revocationService) recordRevocation(ctx context.Context, revoked RevokedToken) error {
+	if err := s.store.InsertRevokedToken(ctx, revoked); err != nil {
+		return err
+	}
+	if err := s.store.DeleteRefreshToken(ctx, revoked.JTI); err != nil {
+		s.logger.Warn(ctx, "Failed to remove a revoked token from the refresh token registry", log.Error(err))
+	}
+	return nil
+}
+
 // extractExpiryTime returns the token's exp claim as a time, falling back to now when absent
 // (an absent/expired exp simply makes the deny-list row immediately cleanup-eligible).
 func extractExpiryTime(payload map[string]interface{}) time.Time {
@@ -163,7 +374,8 @@ func extractExpiryTime(payload map[string]interface{}) time.Time {
 }
 
 // publishTokenRevokedEvent emits a TOKEN_REVOKED audit event.
-func (s *revocationService) publishTokenRevokedEvent(ctx context.Context, clientID, jti string) {
+func (s *revocationService) publishTokenRevokedEvent(ctx context.Context, clientID, jti string,
+	reason RevocationReason) {
 	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
 		return
 	}
@@ -176,7 +388,7 @@ func (s *revocationService) publishTokenRevokedEvent(ctx context.Context, client
 		WithStatus(providers.StatusSuccess).
 		WithData(event.DataKey.ClientID, clientID).
 		WithData(event.DataKey.JTI, jti).
-		WithData(event.DataKey.RevocationReason, string(RevocationReasonExplicit))
+		WithData(event.DataKey.RevocationReason, string(reason))
 
 	s.observabilitySvc.PublishEvent(ctx, evt)
 }
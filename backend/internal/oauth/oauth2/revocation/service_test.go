@@ -75,6 +75,7 @@ func (s *RevocationServiceTestSuite) TestRevokeToken_Success() {
 	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.MatchedBy(func(rt RevokedToken) bool {
 		return rt.JTI == "jti-123" && rt.RevocationReason == RevocationReasonExplicit
 	})).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
 	s.obsMock.On("IsEnabled").Return(false)
 
 	revokeOutcome, err := s.service.RevokeToken(context.Background(), token, "", testClientID)
@@ -86,6 +87,7 @@ func (s *RevocationServiceTestSuite) TestRevokeToken_PublishesAuditEvent() {
 	token := buildToken(map[string]interface{}{"jti": "jti-evt", "client_id": testClientID})
 	s.jwtServiceMock.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
 	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.Anything).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
 	s.obsMock.On("IsEnabled").Return(true)
 	s.obsMock.On("PublishEvent", mock.Anything, mock.Anything).Return()
 
@@ -114,6 +116,7 @@ func (s *RevocationServiceTestSuite) TestRevokeToken_ExpiredTokenStillRevocable(
 	})
 	s.jwtServiceMock.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
 	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.Anything).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
 	s.obsMock.On("IsEnabled").Return(false)
 
 	revokeOutcome, err := s.service.RevokeToken(context.Background(), token, "", testClientID)
@@ -160,6 +163,7 @@ func (s *RevocationServiceTestSuite) TestRevokeRefreshToken_RecordsWithRotationR
 			rt.RevocationReason == RevocationReasonRefreshRotation &&
 			rt.ExpiryTime.Equal(expiry)
 	})).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
 
 	err := revoker.RevokeRefreshToken(context.Background(), "rotated-jti", expiry)
 	assert.NoError(s.T(), err)
@@ -181,3 +185,259 @@ func (s *RevocationServiceTestSuite) TestRevokeRefreshToken_StoreErrorPropagates
 	err := revoker.RevokeRefreshToken(context.Background(), "jti-x", time.Now().UTC())
 	assert.Error(s.T(), err)
 }
+
+func (s *RevocationServiceTestSuite) TestRevokeCodeReplayToken_RecordsWithCodeReplayReason() {
+	revoker := s.service.(CodeReplayRevokerInterface)
+	expiry := time.Now().Add(time.Hour).UTC()
+	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.MatchedBy(func(rt RevokedToken) bool {
+		return rt.JTI == "code-jti" &&
+			rt.RevocationReason == RevocationReasonCodeReplay &&
+			rt.ExpiryTime.Equal(expiry)
+	})).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
+
+	err := revoker.RevokeCodeReplayToken(context.Background(), "code-jti", expiry)
+	assert.NoError(s.T(), err)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeCodeReplayToken_EmptyJTIIsNoOp() {
+	revoker := s.service.(CodeReplayRevokerInterface)
+
+	err := revoker.RevokeCodeReplayToken(context.Background(), "", time.Now().UTC())
+	assert.NoError(s.T(), err)
+	s.storeMock.AssertNotCalled(s.T(), "InsertRevokedToken", mock.Anything, mock.Anything)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeTokenAsAdmin_RecordsWithAdminReason() {
+	exp := time.Now().Add(time.Hour).Unix()
+	token := buildToken(map[string]interface{}{
+		"jti":       "admin-jti",
+		"client_id": "other-client",
+		"exp":       float64(exp),
+	})
+	s.jwtServiceMock.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
+	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.MatchedBy(func(rt RevokedToken) bool {
+		return rt.JTI == "admin-jti" &&
+			rt.RevocationReason == RevocationReasonAdmin &&
+			rt.ExpiryTime.Equal(time.Unix(exp, 0).UTC())
+	})).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	s.obsMock.On("IsEnabled").Return(false)
+
+	svcErr := s.service.RevokeTokenAsAdmin(context.Background(), token)
+	assert.Nil(s.T(), svcErr)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeTokenAsAdmin_EmptyToken() {
+	svcErr := s.service.RevokeTokenAsAdmin(context.Background(), "")
+	assert.Equal(s.T(), &ErrorInvalidAdminRevokeRequest, svcErr)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeTokenAsAdmin_InvalidSignature() {
+	token := buildToken(map[string]interface{}{"jti": "admin-jti"})
+	s.jwtServiceMock.On("VerifyJWTSignature", mock.Anything, token).Return(&serviceerror.ServiceError{
+		Type: serviceerror.ServerErrorType, Code: "INVALID_SIGNATURE",
+	})
+
+	svcErr := s.service.RevokeTokenAsAdmin(context.Background(), token)
+	assert.Equal(s.T(), &ErrorInvalidTokenForRevocation, svcErr)
+	s.storeMock.AssertNotCalled(s.T(), "InsertRevokedToken", mock.Anything, mock.Anything)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeTokenAsAdmin_NoJti() {
+	token := buildToken(map[string]interface{}{"client_id": testClientID})
+	s.jwtServiceMock.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
+
+	svcErr := s.service.RevokeTokenAsAdmin(context.Background(), token)
+	assert.Equal(s.T(), &ErrorInvalidTokenForRevocation, svcErr)
+	s.storeMock.AssertNotCalled(s.T(), "InsertRevokedToken", mock.Anything, mock.Anything)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeTokenAsAdmin_StoreError() {
+	token := buildToken(map[string]interface{}{"jti": "admin-jti"})
+	s.jwtServiceMock.On("VerifyJWTSignature", mock.Anything, token).Return(nil)
+	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	svcErr := s.service.RevokeTokenAsAdmin(context.Background(), token)
+	assert.Equal(s.T(), &serviceerror.InternalServerError, svcErr)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeJTIAsAdmin_RecordsWithAdminReason() {
+	expiry := time.Now().Add(time.Hour).UTC()
+	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.MatchedBy(func(rt RevokedToken) bool {
+		return rt.JTI == "admin-jti" &&
+			rt.RevocationReason == RevocationReasonAdmin &&
+			rt.ExpiryTime.Equal(expiry)
+	})).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil)
+	s.obsMock.On("IsEnabled").Return(true)
+	s.obsMock.On("PublishEvent", mock.Anything, mock.Anything).Return()
+
+	svcErr := s.service.RevokeJTIAsAdmin(context.Background(), "admin-jti", expiry)
+	assert.Nil(s.T(), svcErr)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeJTIAsAdmin_MissingInput() {
+	svcErr := s.service.RevokeJTIAsAdmin(context.Background(), "", time.Now())
+	assert.Equal(s.T(), &ErrorInvalidAdminRevokeRequest, svcErr)
+
+	svcErr = s.service.RevokeJTIAsAdmin(context.Background(), "admin-jti", time.Time{})
+	assert.Equal(s.T(), &ErrorInvalidAdminRevokeRequest, svcErr)
+	s.storeMock.AssertNotCalled(s.T(), "InsertRevokedToken", mock.Anything, mock.Anything)
+}
+
+func (s *RevocationServiceTestSuite) TestRecordRevocation_RegistryDeleteFailureIsNotFatal() {
+	revoker := s.service.(RefreshTokenRevokerInterface)
+	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.Anything).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, "rt-jti").Return(errors.New("db down"))
+
+	err := revoker.RevokeRefreshToken(context.Background(), "rt-jti", time.Now().UTC())
+	assert.NoError(s.T(), err)
+}
+
+func (s *RevocationServiceTestSuite) TestRegisterRefreshToken_Success() {
+	revoker := s.service.(RefreshTokenRevokerInterface)
+	token := IssuedRefreshToken{
+		JTI:        "rt-jti",
+		ClientID:   testClientID,
+		Subject:    "user-1",
+		IssuedAt:   time.Now().UTC(),
+		ExpiryTime: time.Now().Add(time.Hour).UTC(),
+	}
+	s.storeMock.On("InsertRefreshToken", mock.Anything, token).Return(nil)
+
+	err := revoker.RegisterRefreshToken(context.Background(), token)
+	assert.NoError(s.T(), err)
+}
+
+func (s *RevocationServiceTestSuite) TestRegisterRefreshToken_EmptyJTIIsNoOp() {
+	revoker := s.service.(RefreshTokenRevokerInterface)
+
+	err := revoker.RegisterRefreshToken(context.Background(), IssuedRefreshToken{ClientID: testClientID})
+	assert.NoError(s.T(), err)
+	s.storeMock.AssertNotCalled(s.T(), "InsertRefreshToken", mock.Anything, mock.Anything)
+}
+
+func (s *RevocationServiceTestSuite) TestRegisterRefreshToken_StoreError() {
+	revoker := s.service.(RefreshTokenRevokerInterface)
+	s.storeMock.On("InsertRefreshToken", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	err := revoker.RegisterRefreshToken(context.Background(), IssuedRefreshToken{JTI: "rt-jti"})
+	assert.Error(s.T(), err)
+}
+
+func (s *RevocationServiceTestSuite) TestListRefreshTokens_Success() {
+	issuedAt := time.Unix(1800000000, 0).UTC()
+	expiry := time.Unix(1800086400, 0).UTC()
+	filter := RefreshTokenFilter{UserID: "user-1"}
+	s.storeMock.On("ListRefreshTokens", mock.Anything, filter, 10, 20).Return([]IssuedRefreshToken{
+		{JTI: "rt-1", ClientID: testClientID, Subject: "user-1", IssuedAt: issuedAt, ExpiryTime: expiry},
+	}, 21, nil)
+
+	response, svcErr := s.service.ListRefreshTokens(context.Background(), filter, 10, 20)
+	assert.Nil(s.T(), svcErr)
+	assert.Equal(s.T(), &RefreshTokenListResponse{
+		TotalResults: 21,
+		StartIndex:   21,
+		Count:        1,
+		Tokens: []RefreshTokenResponse{
+			{JTI: "rt-1", ClientID: testClientID, UserID: "user-1", IssuedAt: 1800000000, ExpiresAt: 1800086400},
+		},
+	}, response)
+}
+
+func (s *RevocationServiceTestSuite) TestListRefreshTokens_InvalidPagination() {
+	testCases := []struct {
+		name          string
+		limit         int
+		offset        int
+		expectedError *serviceerror.ServiceError
+	}{
+		{name: "ZeroLimit", limit: 0, offset: 0, expectedError: &ErrorInvalidLimit},
+		{name: "LimitAboveMaximum", limit: 101, offset: 0, expectedError: &ErrorInvalidLimit},
+		{name: "NegativeOffset", limit: 10, offset: -1, expectedError: &ErrorInvalidOffset},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			response, svcErr := s.service.ListRefreshTokens(context.Background(), RefreshTokenFilter{},
+				tc.limit, tc.offset)
+			assert.Nil(s.T(), response)
+			assert.Equal(s.T(), tc.expectedError, svcErr)
+		})
+	}
+	s.storeMock.AssertNotCalled(s.T(), "ListRefreshTokens", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (s *RevocationServiceTestSuite) TestListRefreshTokens_StoreError() {
+	s.storeMock.On("ListRefreshTokens", mock.Anything, mock.Anything, 10, 0).
+		Return(nil, 0, errors.New("db down"))
+
+	response, svcErr := s.service.ListRefreshTokens(context.Background(), RefreshTokenFilter{}, 10, 0)
+	assert.Nil(s.T(), response)
+	assert.Equal(s.T(), &serviceerror.InternalServerError, svcErr)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeUserTokensAsAdmin_RevokesAllTokens() {
+	expiry := time.Now().Add(time.Hour).UTC()
+	s.storeMock.On("GetRefreshTokens", mock.Anything, RefreshTokenFilter{UserID: "user-1"}).
+		Return([]IssuedRefreshToken{
+			{JTI: "rt-1", ClientID: "client-a", Subject: "user-1", ExpiryTime: expiry},
+			{JTI: "rt-2", ClientID: "client-b", Subject: "user-1", ExpiryTime: expiry},
+		}, nil)
+	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.MatchedBy(func(rt RevokedToken) bool {
+		return (rt.JTI == "rt-1" || rt.JTI == "rt-2") &&
+			rt.RevocationReason == RevocationReasonAdmin &&
+			rt.ExpiryTime.Equal(expiry)
+	})).Return(nil).Times(2)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, mock.Anything).Return(nil).Times(2)
+	s.obsMock.On("IsEnabled").Return(false)
+
+	svcErr := s.service.RevokeUserTokensAsAdmin(context.Background(), "user-1")
+	assert.Nil(s.T(), svcErr)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeUserTokensAsAdmin_EmptyUserID() {
+	svcErr := s.service.RevokeUserTokensAsAdmin(context.Background(), "")
+	assert.Equal(s.T(), &ErrorInvalidAdminRevokeRequest, svcErr)
+	s.storeMock.AssertNotCalled(s.T(), "GetRefreshTokens", mock.Anything, mock.Anything)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeClientTokensAsAdmin_RevokesAllTokens() {
+	expiry := time.Now().Add(time.Hour).UTC()
+	s.storeMock.On("GetRefreshTokens", mock.Anything, RefreshTokenFilter{ClientID: testClientID}).
+		Return([]IssuedRefreshToken{
+			{JTI: "rt-1", ClientID: testClientID, Subject: "user-1", ExpiryTime: expiry},
+		}, nil)
+	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.MatchedBy(func(rt RevokedToken) bool {
+		return rt.JTI == "rt-1" && rt.RevocationReason == RevocationReasonAdmin
+	})).Return(nil)
+	s.storeMock.On("DeleteRefreshToken", mock.Anything, "rt-1").Return(nil)
+	s.obsMock.On("IsEnabled").Return(false)
+
+	svcErr := s.service.RevokeClientTokensAsAdmin(context.Background(), testClientID)
+	assert.Nil(s.T(), svcErr)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeClientTokensAsAdmin_EmptyClientID() {
+	svcErr := s.service.RevokeClientTokensAsAdmin(context.Background(), "")
+	assert.Equal(s.T(), &ErrorInvalidAdminRevokeRequest, svcErr)
+	s.storeMock.AssertNotCalled(s.T(), "GetRefreshTokens", mock.Anything, mock.Anything)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeClientTokensAsAdmin_GetTokensError() {
+	s.storeMock.On("GetRefreshTokens", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	svcErr := s.service.RevokeClientTokensAsAdmin(context.Background(), testClientID)
+	assert.Equal(s.T(), &serviceerror.InternalServerError, svcErr)
+}
+
+func (s *RevocationServiceTestSuite) TestRevokeClientTokensAsAdmin_InsertError() {
+	s.storeMock.On("GetRefreshTokens", mock.Anything, mock.Anything).Return([]IssuedRefreshToken{
+		{JTI: "rt-1", ClientID: testClientID, ExpiryTime: time.Now().Add(time.Hour).UTC()},
+	}, nil)
+	s.storeMock.On("InsertRevokedToken", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	svcErr := s.service.RevokeClientTokensAsAdmin(context.Background(), testClientID)
+	assert.Equal(s.T(), &serviceerror.InternalServerError, svcErr)
+}
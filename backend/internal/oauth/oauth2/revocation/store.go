@@ -30,12 +30,23 @@ import (
 
 // RevokedTokenStoreInterface defines the deny-list persistence for single-token revocation: the
 // write path (InsertRevokedToken) used by the RFC 7009 revocation service and the read path
-// (IsTokenRevoked) used by the enforcement service on the AS hot path.
+// (IsTokenRevoked) used by the enforcement service on the AS hot path. It also keeps the registry of
+// issued refresh tokens that the management API searches and revokes in bulk.
 type RevokedTokenStoreInterface interface {
 	// InsertRevokedToken writes a JTI to the deny list. The write is idempotent.
 	InsertRevokedToken(ctx context.Context, token RevokedToken) error
 	// IsTokenRevoked reports whether a non-expired deny-list entry exists for the given JTI.
 	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	// InsertRefreshToken records an issued refresh token in the registry. The write is idempotent.
+	InsertRefreshToken(ctx context.Context, token IssuedRefreshToken) error
+	// DeleteRefreshToken removes a refresh token from the registry. An unknown JTI is a no-op.
+	DeleteRefreshToken(ctx context.Context, jti string) error
+	// ListRefreshTokens returns a page of the active refresh tokens that match the filter, most recently
+	// issued first, together with the total number of matching tokens.
+	ListRefreshTokens(ctx context.Context, filter RefreshTokenFilter, limit, offset int) (
+		[]IssuedRefreshToken, int, error)
+	// GetRefreshTokens returns all active refresh tokens that match the filter.
+	GetRefreshTokens(ctx context.Context, filter RefreshTokenFilter) ([]IssuedRefreshToken, error)
 }
 
 // revokedTokenStore implements RevokedTokenStoreInterface against the operation database.
@@ -79,17 +90,153 @@ func (s *revokedTokenStore) InsertRevokedToken(ctx context.Context, token Revoke
 	return nil
 }
 
-// IsTokenRevoked reports whether a non-expired deny-list entry exists for the given JTI.
+// IsTokenRevoked reports whether a non-expired deny-list entry exists for the given JTI. The deny
+// list is read from the primary database so that a token revoked moments ago is never accepted.
 func (s *revokedTokenStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
 	dbClient, err := s.dbProvider.GetOperationDBClient()
 	if err != nil {
 		return false, fmt.Errorf("failed to get operation database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryIsTokenRevoked, jti, time.Now().UTC(), s.deploymentID)
+	results, err := dbClient.QueryContext(provider.WithPrimaryReads(ctx), queryIsTokenRevoked, jti,
+		time.Now().UTC(), s.deploymentID)
 	if err != nil {
 		return false, fmt.Errorf("error checking token revocation: %w", err)
 	}
 
 	return len(results) > 0, nil
 }
+
+// InsertRefreshToken records an issued refresh token. A duplicate (deployment, jti) is a no-op.
+func (s *revokedTokenStore) InsertRefreshToken(ctx context.Context, token IssuedRefreshToken) error {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get operation database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryInsertRefreshToken, token.JTI, token.ClientID, token.Subject,
+		token.IssuedAt, token.ExpiryTime, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("error inserting refresh token: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRefreshToken removes a refresh token from the registry.
+func (s *revokedTokenStore) DeleteRefreshToken(ctx context.Context, jti string) error {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get operation database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteRefreshToken, jti, s.deploymentID); err != nil {
+		return fmt.Errorf("error deleting refresh token: %w", err)
+	}
+
+	return nil
+}
+
+// ListRefreshTokens returns a page of the active refresh tokens that match the filter and the total
+// number of matching tokens.
+func (s *revokedTokenStore) ListRefreshTokens(ctx context.Context, filter RefreshTokenFilter,
+	limit, offset int) ([]IssuedRefreshToken, int, error) {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get operation database client: %w", err)
+	}
+
+	args := s.buildRefreshTokenFilterArgs(filter)
+	countResults, err := dbClient.QueryContext(ctx, queryCountRefreshTokens, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error counting refresh tokens: %w", err)
+	}
+	totalCount, err := parseCountResult(countResults)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListRefreshTokens, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error listing refresh tokens: %w", err)
+	}
+	tokens, err := buildRefreshTokensFromResults(results)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tokens, totalCount, nil
+}
+
+// GetRefreshTokens returns all active refresh tokens that match the filter. They are read from the
+// primary database so that a bulk revocation also covers tokens issued moments before.
+func (s *revokedTokenStore) GetRefreshTokens(ctx context.Context,
+	filter RefreshTokenFilter) ([]IssuedRefreshToken, error) {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operation database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(provider.WithPrimaryReads(ctx), queryGetRefreshTokens,
+		s.buildRefreshTokenFilterArgs(filter)...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting refresh tokens: %w", err)
+	}
+
+	return buildRefreshTokensFromResults(results)
+}
+
+// buildRefreshTokenFilterArgs returns the query arguments for refreshTokenFilterCondition. Unset issue
+// time bounds are passed as NULL so that they do not filter.
+func (s *revokedTokenStore) buildRefreshTokenFilterArgs(filter RefreshTokenFilter) []interface{} {
+	var issuedAfter, issuedBefore interface{}
+	if !filter.IssuedAfter.IsZero() {
+		issuedAfter = filter.IssuedAfter.UTC()
+	}
+	if !filter.IssuedBefore.IsZero() {
+		issuedBefore = filter.IssuedBefore.UTC()
+	}
+	return []interface{}{s.deploymentID, time.Now().UTC(), filter.UserID, filter.ClientID,
+		issuedAfter, issuedBefore}
+}
+
+// buildRefreshTokensFromResults converts the rows of a refresh token query into IssuedRefreshToken values.
+func buildRefreshTokensFromResults(results []map[string]interface{}) ([]IssuedRefreshToken, error) {
+	tokens := make([]IssuedRefreshToken, 0, len(results))
+	for _, row := range results {
+		jti, _ := row["jti"].(string)
+		if jti == "" {
+			return nil, fmt.Errorf("invalid or missing jti in refresh token row")
+		}
+		clientID, _ := row["client_id"].(string)
+		subject, _ := row["subject"].(string)
+		issuedAt, err := utils.ParseDBTimeField(row["issued_at"], "issued_at")
+		if err != nil {
+			return nil, err
+		}
+		expiryTime, err := utils.ParseDBTimeField(row["expiry_time"], "expiry_time")
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, IssuedRefreshToken{
+			JTI:        jti,
+			ClientID:   clientID,
+			Subject:    subject,
+			IssuedAt:   issuedAt,
+			ExpiryTime: expiryTime,
+		})
+	}
+	return tokens, nil
+}
+
+// parseCountResult parses the total from the result of a count query.
+func parseCountResult(results []map[string]interface{}) (int, error) {
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	if countVal, ok := results[0]["total"].(int64); ok {
+		return int(countVal), nil
+	}
+	return 0, fmt.Errorf("failed to parse total from query result")
+}
@@ -33,3 +33,43 @@ var queryIsTokenRevoked = dbmodel.DBQuery{
 	ID:    "RVQ-RTS-02",
 	Query: `SELECT 1 FROM "REVOKED_TOKEN" WHERE JTI = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
 }
+
+// refreshTokenFilterCondition selects the non-expired refresh tokens of this deployment that match the
+// optional subject, client and issue-time filters. An empty string or a NULL filter value matches all.
+const refreshTokenFilterCondition = `DEPLOYMENT_ID = $1 AND EXPIRY_TIME > $2 ` +
+	`AND ($3 = '' OR SUBJECT = $3) AND ($4 = '' OR CLIENT_ID = $4) ` +
+	`AND ($5 IS NULL OR ISSUED_AT >= $5) AND ($6 IS NULL OR ISSUED_AT < $6)`
+
+// queryInsertRefreshToken records an issued refresh token. A duplicate (DEPLOYMENT_ID, JTI) is a no-op.
+var queryInsertRefreshToken = dbmodel.DBQuery{
+	ID: "RVQ-RTS-03",
+	Query: `INSERT INTO "REFRESH_TOKEN" (JTI, CLIENT_ID, SUBJECT, ISSUED_AT, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (DEPLOYMENT_ID, JTI) DO NOTHING`,
+}
+
+// queryDeleteRefreshToken removes an issued refresh token from the registry.
+var queryDeleteRefreshToken = dbmodel.DBQuery{
+	ID:    "RVQ-RTS-04",
+	Query: `DELETE FROM "REFRESH_TOKEN" WHERE JTI = $1 AND DEPLOYMENT_ID = $2`,
+}
+
+// queryCountRefreshTokens counts the active refresh tokens that match the filter.
+var queryCountRefreshTokens = dbmodel.DBQuery{
+	ID:    "RVQ-RTS-05",
+	Query: `SELECT COUNT(*) as total FROM "REFRESH_TOKEN" WHERE ` + refreshTokenFilterCondition,
+}
+
+// queryListRefreshTokens returns a page of the active refresh tokens that match the filter, most
+// recently issued first.
+var queryListRefreshTokens = dbmodel.DBQuery{
+	ID: "RVQ-RTS-06",
+	Query: `SELECT JTI, CLIENT_ID, SUBJECT, ISSUED_AT, EXPIRY_TIME FROM "REFRESH_TOKEN" WHERE ` +
+		refreshTokenFilterCondition + ` ORDER BY ISSUED_AT DESC, JTI LIMIT $7 OFFSET $8`,
+}
+
+// queryGetRefreshTokens returns all active refresh tokens that match the filter.
+var queryGetRefreshTokens = dbmodel.DBQuery{
+	ID: "RVQ-RTS-07",
+	Query: `SELECT JTI, CLIENT_ID, SUBJECT, ISSUED_AT, EXPIRY_TIME FROM "REFRESH_TOKEN" WHERE ` +
+		refreshTokenFilterCondition,
+}
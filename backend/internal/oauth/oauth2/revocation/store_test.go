@@ -196,3 +196,137 @@ func (suite *RevokedTokenStoreTestSuite) TestIsTokenRevoked_QueryError() {
 
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
+
+func (suite *RevokedTokenStoreTestSuite) TestInsertRefreshToken_Success() {
+	token := IssuedRefreshToken{
+		JTI:        "rt-jti",
+		ClientID:   "client-1",
+		Subject:    "user-1",
+		IssuedAt:   time.Now().UTC(),
+		ExpiryTime: time.Now().UTC().Add(time.Hour),
+	}
+	suite.mockdbProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertRefreshToken,
+		token.JTI, token.ClientID, token.Subject, token.IssuedAt, token.ExpiryTime, testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.InsertRefreshToken(context.Background(), token)
+	assert.NoError(suite.T(), err)
+
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
+
+func (suite *RevokedTokenStoreTestSuite) TestInsertRefreshToken_ExecError() {
+	suite.mockdbProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertRefreshToken,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, testDeploymentID).
+		Return(int64(0), errors.New("execute error"))
+
+	err := suite.store.InsertRefreshToken(context.Background(), IssuedRefreshToken{JTI: "rt-jti"})
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "error inserting refresh token")
+}
+
+func (suite *RevokedTokenStoreTestSuite) TestDeleteRefreshToken_Success() {
+	suite.mockdbProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteRefreshToken, "rt-jti", testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.DeleteRefreshToken(context.Background(), "rt-jti")
+	assert.NoError(suite.T(), err)
+
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
+
+func (suite *RevokedTokenStoreTestSuite) TestDeleteRefreshToken_DBClientError() {
+	suite.mockdbProvider.On("GetOperationDBClient").Return(nil, errors.New("db client error"))
+
+	err := suite.store.DeleteRefreshToken(context.Background(), "rt-jti")
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "db client error")
+}
+
+func (suite *RevokedTokenStoreTestSuite) TestListRefreshTokens_Success() {
+	issuedAfter := time.Unix(1800000000, 0).UTC()
+	filter := RefreshTokenFilter{UserID: "user-1", IssuedAfter: issuedAfter}
+	suite.mockdbProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryCountRefreshTokens,
+		testDeploymentID, mock.Anything, "user-1", "", issuedAfter, nil).
+		Return([]map[string]interface{}{{"total": int64(3)}}, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListRefreshTokens,
+		testDeploymentID, mock.Anything, "user-1", "", issuedAfter, nil, 2, 1).
+		Return([]map[string]interface{}{
+			{
+				"jti":         "rt-1",
+				"client_id":   "client-1",
+				"subject":     "user-1",
+				"issued_at":   "2027-01-15 08:00:00",
+				"expiry_time": "2027-01-16 08:00:00",
+			},
+		}, nil)
+
+	tokens, total, err := suite.store.ListRefreshTokens(context.Background(), filter, 2, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, total)
+	assert.Len(suite.T(), tokens, 1)
+	assert.Equal(suite.T(), "rt-1", tokens[0].JTI)
+	assert.Equal(suite.T(), "client-1", tokens[0].ClientID)
+	assert.Equal(suite.T(), "user-1", tokens[0].Subject)
+	assert.Equal(suite.T(), time.Date(2027, 1, 16, 8, 0, 0, 0, time.UTC), tokens[0].ExpiryTime)
+
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
+
+func (suite *RevokedTokenStoreTestSuite) TestListRefreshTokens_CountError() {
+	suite.mockdbProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryCountRefreshTokens,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]map[string]interface{}(nil), errors.New("query error"))
+
+	tokens, total, err := suite.store.ListRefreshTokens(context.Background(), RefreshTokenFilter{}, 10, 0)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), tokens)
+	assert.Zero(suite.T(), total)
+	assert.Contains(suite.T(), err.Error(), "error counting refresh tokens")
+}
+
+func (suite *RevokedTokenStoreTestSuite) TestGetRefreshTokens_Success() {
+	suite.mockdbProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetRefreshTokens,
+		testDeploymentID, mock.Anything, "", "client-1", nil, nil).
+		Return([]map[string]interface{}{
+			{
+				"jti":         "rt-1",
+				"client_id":   "client-1",
+				"subject":     "user-1",
+				"issued_at":   "2027-01-15 08:00:00",
+				"expiry_time": "2027-01-16 08:00:00",
+			},
+			{
+				"jti":         "rt-2",
+				"client_id":   "client-1",
+				"subject":     "user-2",
+				"issued_at":   "2027-01-15 09:00:00",
+				"expiry_time": "2027-01-16 09:00:00",
+			},
+		}, nil)
+
+	tokens, err := suite.store.GetRefreshTokens(context.Background(), RefreshTokenFilter{ClientID: "client-1"})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), tokens, 2)
+	assert.Equal(suite.T(), "rt-2", tokens[1].JTI)
+	assert.Equal(suite.T(), "user-2", tokens[1].Subject)
+
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
+
+func (suite *RevokedTokenStoreTestSuite) TestGetRefreshTokens_InvalidRow() {
+	suite.mockdbProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetRefreshTokens,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]map[string]interface{}{{"client_id": "client-1"}}, nil)
+
+	tokens, err := suite.store.GetRefreshTokens(context.Background(), RefreshTokenFilter{ClientID: "client-1"})
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), tokens)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package testtoken
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// testTokenHandler handles development test token requests.
+type testTokenHandler struct {
+	service TestTokenServiceInterface
+	logger  *log.Logger
+}
+
+// newTestTokenHandler creates a new test token handler (internal use).
+func newTestTokenHandler(service TestTokenServiceInterface) *testTokenHandler {
+	return &testTokenHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TestTokenHandler")),
+	}
+}
+
+// HandleTestTokenRequest issues tokens for the requested user and scopes without running a flow.
+func (h *testTokenHandler) HandleTestTokenRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		sysutils.WriteJSONError(ctx, w, constants.ErrorInvalidRequest, "Failed to parse request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	// Get authenticated client from context (set by ClientAuthMiddleware).
+	clientInfo := clientauth.GetOAuthClient(ctx)
+	if clientInfo == nil {
+		h.logger.Error(ctx, "OAuth client not found in context - ClientAuthMiddleware must be applied")
+		sysutils.WriteJSONError(ctx, w, constants.ErrorServerError, "Something went wrong",
+			http.StatusInternalServerError, nil)
+		return
+	}
+
+	userID := r.FormValue(requestParamUserID)
+	scopes := strings.Fields(r.FormValue(constants.RequestParamScope))
+
+	tokenResponse, errResp := h.service.IssueTestToken(ctx, userID, scopes, clientInfo.OAuthApp)
+	if errResp != nil {
+		statusCode := http.StatusBadRequest
+		if errResp.Error == constants.ErrorServerError {
+			statusCode = http.StatusInternalServerError
+		}
+		sysutils.WriteJSONError(ctx, w, errResp.Error, errResp.ErrorDescription, statusCode, nil)
+		return
+	}
+
+	// Must include the following headers when sensitive data is returned.
+	w.Header().Set(sysconst.CacheControlHeaderName, sysconst.CacheControlNoStore)
+	w.Header().Set(sysconst.PragmaHeaderName, sysconst.PragmaNoCache)
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, tokenResponse)
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package testtoken
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type TestTokenHandlerTestSuite struct {
+	suite.Suite
+	mockService *TestTokenServiceInterfaceMock
+	handler     *testTokenHandler
+	clientInfo  *clientauth.OAuthClientInfo
+}
+
+func TestTestTokenHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TestTokenHandlerTestSuite))
+}
+
+func (s *TestTokenHandlerTestSuite) SetupTest() {
+	s.mockService = NewTestTokenServiceInterfaceMock(s.T())
+	s.handler = newTestTokenHandler(s.mockService)
+	s.clientInfo = &clientauth.OAuthClientInfo{
+		ClientID: testClientID,
+		OAuthApp: &providers.OAuthClient{ClientID: testClientID},
+	}
+}
+
+func (s *TestTokenHandlerTestSuite) newRequest(body string, withClient bool) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, testTokenPath, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if withClient {
+		req = req.WithContext(context.WithValue(req.Context(), clientauth.OAuthClientKey, s.clientInfo))
+	}
+	return req
+}
+
+func (s *TestTokenHandlerTestSuite) TestHandleTestTokenRequest_Success() {
+	s.mockService.EXPECT().IssueTestToken(mock.Anything, testUserID, []string{"openid", "read"},
+		s.clientInfo.OAuthApp).
+		Return(&model.TokenResponse{AccessToken: "access-token", TokenType: "Bearer", IDToken: "id-token"}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleTestTokenRequest(rr, s.newRequest("user_id=user-123&scope=openid+read", true))
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), "no-store", rr.Header().Get("Cache-Control"))
+	var resp model.TokenResponse
+	assert.NoError(s.T(), json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(s.T(), "access-token", resp.AccessToken)
+	assert.Equal(s.T(), "id-token", resp.IDToken)
+}
+
+func (s *TestTokenHandlerTestSuite) TestHandleTestTokenRequest_ParseFormError() {
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleTestTokenRequest(rr, s.newRequest("%", true))
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), constants.ErrorInvalidRequest)
+}
+
+func (s *TestTokenHandlerTestSuite) TestHandleTestTokenRequest_MissingClient() {
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleTestTokenRequest(rr, s.newRequest("user_id=user-123", false))
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), constants.ErrorServerError)
+}
+
+func (s *TestTokenHandlerTestSuite) TestHandleTestTokenRequest_ServiceErrors() {
+	testCases := []struct {
+		name           string
+		errResp        *model.ErrorResponse
+		expectedStatus int
+	}{
+		{
+			name:           "ClientError",
+			errResp:        &model.ErrorResponse{Error: constants.ErrorInvalidRequest, ErrorDescription: "bad user"},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "ServerError",
+			errResp:        &model.ErrorResponse{Error: constants.ErrorServerError, ErrorDescription: "failed"},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockService.EXPECT().IssueTestToken(mock.Anything, testUserID, []string{}, s.clientInfo.OAuthApp).
+				Return(nil, tc.errResp)
+			rr := httptest.NewRecorder()
+
+			s.handler.HandleTestTokenRequest(rr, s.newRequest("user_id=user-123", true))
+
+			assert.Equal(s.T(), tc.expectedStatus, rr.Code)
+			assert.Contains(s.T(), rr.Body.String(), tc.errResp.Error)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package testtoken
+
+import (
+	"context"
+	"net/http"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize registers the development-only test token endpoint when it is enabled in the
+// configuration. The endpoint is only registered in a development or test deployment and for a
+// non-empty list of allowed clients. It returns nil when the endpoint is not registered.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	actorProvider providers.ActorProvider,
+	authnProvider providers.AuthnProviderManager,
+	discoveryService discovery.DiscoveryServiceInterface,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	cfg oauthconfig.Config,
+) TestTokenServiceInterface {
+	if !cfg.OAuth.TestToken.Enabled {
+		return nil
+	}
+
+	// This runs outside any request, so context.Background() is used (no request trace ID).
+	ctx := context.Background()
+	logger := log.GetLogger()
+	if !cfg.DeploymentMode.AllowsDevelopmentFeatures() {
+		logger.Error(ctx, "The test token endpoint is enabled but not registered: it is only available "+
+			"when server.deployment_mode is development or test", log.String("path", testTokenPath))
+		return nil
+	}
+	if len(cfg.OAuth.TestToken.AllowedClients) == 0 {
+		logger.Error(ctx, "The test token endpoint is enabled but not registered: "+
+			"oauth.test_token.allowed_clients is empty", log.String("path", testTokenPath))
+		return nil
+	}
+
+	logger.Warn(ctx,
+		"The test token endpoint is enabled. It issues tokens without authentication and must not be "+
+			"enabled in production deployments", log.String("path", testTokenPath))
+
+	testTokenService := newTestTokenService(actorProvider, tokenBuilder, cfg.OAuth.TestToken.AllowedClients)
+	testTokenHandler := newTestTokenHandler(testTokenService)
+	registerRoutes(mux, testTokenHandler, actorProvider, authnProvider, jwtService, discoveryService)
+	return testTokenService
+}
+
+// registerRoutes registers the routes for the test token endpoint.
+func registerRoutes(
+	mux *http.ServeMux,
+	testTokenHandler *testTokenHandler,
+	actorProvider providers.ActorProvider,
+	authnProvider providers.AuthnProviderManager,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST", "OPTIONS"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	// Client assertions for this endpoint use the token endpoint as their audience.
+	endpointURL := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpoint
+	clientAuthMiddleware := clientauth.ClientAuthMiddleware(actorProvider, authnProvider, jwtService, endpointURL)
+	handler := clientAuthMiddleware(http.HandlerFunc(testTokenHandler.HandleTestTokenRequest))
+
+	pattern, wrappedHandler := middleware.WithCORS(
+		"POST "+testTokenPath,
+		handler.ServeHTTP,
+		opts,
+	)
+	mux.HandleFunc(pattern, wrappedHandler)
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+testTokenPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
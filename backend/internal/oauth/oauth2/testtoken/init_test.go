@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package testtoken
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/discoverymock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+)
+
+type InitTestSuite struct {
+	suite.Suite
+	mockJWTService       *jwtmock.JWTServiceInterfaceMock
+	mockDiscoveryService *discoverymock.DiscoveryServiceInterfaceMock
+	mockTokenBuilder     *tokenservicemock.TokenBuilderInterfaceMock
+}
+
+func TestInitTestSuite(t *testing.T) {
+	suite.Run(t, new(InitTestSuite))
+}
+
+func (suite *InitTestSuite) SetupTest() {
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockDiscoveryService = discoverymock.NewDiscoveryServiceInterfaceMock(suite.T())
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+}
+
+func (suite *InitTestSuite) TestInitialize_Disabled() {
+	mux := http.NewServeMux()
+
+	service := Initialize(mux, suite.mockJWTService, nil, nil, suite.mockDiscoveryService,
+		suite.mockTokenBuilder, oauthconfig.Config{})
+
+	assert.Nil(suite.T(), service)
+	_, pattern := mux.Handler(&http.Request{Method: "POST", URL: &url.URL{Path: testTokenPath}})
+	assert.Empty(suite.T(), pattern)
+}
+
+func (suite *InitTestSuite) TestInitialize_Enabled() {
+	suite.mockDiscoveryService.On("GetOAuth2AuthorizationServerMetadata", mock.Anything).
+		Return(&discovery.OAuth2AuthorizationServerMetadata{
+			TokenEndpoint: "https://localhost:8090/oauth2/token",
+		})
+	cfg := suite.enabledConfig()
+	mux := http.NewServeMux()
+
+	service := Initialize(mux, suite.mockJWTService, nil, nil, suite.mockDiscoveryService,
+		suite.mockTokenBuilder, cfg)
+
+	assert.NotNil(suite.T(), service)
+	_, pattern := mux.Handler(&http.Request{Method: "POST", URL: &url.URL{Path: testTokenPath}})
+	assert.Contains(suite.T(), pattern, testTokenPath)
+
+	_, pattern = mux.Handler(&http.Request{Method: "OPTIONS", URL: &url.URL{Path: testTokenPath}})
+	assert.Contains(suite.T(), pattern, testTokenPath)
+}
+
+func (suite *InitTestSuite) TestInitialize_NotRegisteredOutsideDevelopment() {
+	for _, mode := range []engineconfig.DeploymentMode{"", engineconfig.DeploymentModeProduction} {
+		cfg := suite.enabledConfig()
+		cfg.DeploymentMode = mode
+		mux := http.NewServeMux()
+
+		service := Initialize(mux, suite.mockJWTService, nil, nil, suite.mockDiscoveryService,
+			suite.mockTokenBuilder, cfg)
+
+		assert.Nil(suite.T(), service, "mode %q", mode)
+		_, pattern := mux.Handler(&http.Request{Method: "POST", URL: &url.URL{Path: testTokenPath}})
+		assert.Empty(suite.T(), pattern, "mode %q", mode)
+	}
+}
+
+func (suite *InitTestSuite) TestInitialize_NotRegisteredWithoutAllowedClients() {
+	cfg := suite.enabledConfig()
+	cfg.OAuth.TestToken.AllowedClients = nil
+	mux := http.NewServeMux()
+
+	service := Initialize(mux, suite.mockJWTService, nil, nil, suite.mockDiscoveryService,
+		suite.mockTokenBuilder, cfg)
+
+	assert.Nil(suite.T(), service)
+	_, pattern := mux.Handler(&http.Request{Method: "POST", URL: &url.URL{Path: testTokenPath}})
+	assert.Empty(suite.T(), pattern)
+}
+
+func (suite *InitTestSuite) enabledConfig() oauthconfig.Config {
+	cfg := oauthconfig.Config{DeploymentMode: engineconfig.DeploymentModeDevelopment}
+	cfg.OAuth.TestToken.Enabled = true
+	cfg.OAuth.TestToken.AllowedClients = []string{testClientID}
+	return cfg
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package testtoken provides a development-only endpoint that issues tokens for a given user
+// and set of scopes without running an authentication flow.
+package testtoken
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/actorprovider"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// TestTokenServiceInterface defines the interface for issuing development test tokens.
+type TestTokenServiceInterface interface {
+	IssueTestToken(ctx context.Context, userID string, scopes []string,
+		oauthApp *providers.OAuthClient) (*model.TokenResponse, *model.ErrorResponse)
+}
+
+// testTokenService implements the TestTokenServiceInterface.
+type testTokenService struct {
+	actorProvider  providers.ActorProvider
+	tokenBuilder   tokenservice.TokenBuilderInterface
+	allowedClients []string
+	logger         *log.Logger
+}
+
+// newTestTokenService creates a new testTokenService instance (internal use).
+func newTestTokenService(
+	actorProvider providers.ActorProvider,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	allowedClients []string,
+) TestTokenServiceInterface {
+	return &testTokenService{
+		actorProvider:  actorProvider,
+		tokenBuilder:   tokenBuilder,
+		allowedClients: allowedClients,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TestTokenService")),
+	}
+}
+
+// IssueTestToken issues an access token, and an ID token when the openid scope is requested, for the
+// given user on behalf of the authenticated client. Only allowed confidential clients may request test
+// tokens. The user must exist and be active; no authentication, consent or scope authorization is
+// performed.
+func (s *testTokenService) IssueTestToken(ctx context.Context, userID string, scopes []string,
+	oauthApp *providers.OAuthClient) (*model.TokenResponse, *model.ErrorResponse) {
+	if userID == "" {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "The user_id parameter is required",
+		}
+	}
+	if oauthApp == nil {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+	if oauthApp.PublicClient || oauthApp.TokenEndpointAuthMethod == providers.TokenEndpointAuthMethodNone ||
+		!slices.Contains(s.allowedClients, oauthApp.ClientID) {
+		s.logger.Warn(ctx, "Rejected test token request from a client that is not allowed",
+			log.String("client_id", oauthApp.ClientID))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorUnauthorizedClient,
+			ErrorDescription: "The client is not allowed to request test tokens",
+		}
+	}
+
+	attrs, errResp := s.resolveUserAttributes(ctx, userID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	userSubConfig := oauthApp.UserAccessTokenConfig()
+	accessToken, err := s.tokenBuilder.BuildAccessToken(ctx, &tokenservice.AccessTokenBuildContext{
+		Subject:           userID,
+		Audiences:         []string{oauthApp.ClientID},
+		ClientID:          oauthApp.ClientID,
+		Scopes:            scopes,
+		SubjectAttributes: tokenservice.FilterAttributesByAllowList(attrs, userSubConfig),
+		OAuthApp:          oauthApp,
+		ValidityPeriod:    userSubConfig.ValidityPeriodOrZero(),
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate test access token", log.Error(err))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+
+	tokenResponse := &model.TokenResponse{
+		AccessToken: accessToken.Token,
+		TokenType:   accessToken.TokenType,
+		ExpiresIn:   accessToken.ExpiresIn,
+		Scope:       strings.Join(accessToken.Scopes, " "),
+	}
+
+	if slices.Contains(scopes, constants.ScopeOpenID) {
+		idToken, idErr := s.tokenBuilder.BuildIDToken(ctx, &tokenservice.IDTokenBuildContext{
+			Subject:        userID,
+			Audience:       oauthApp.ClientID,
+			Scopes:         scopes,
+			UserAttributes: attrs,
+			AuthTime:       accessToken.IssuedAt,
+			OAuthApp:       oauthApp,
+		})
+		if idErr != nil {
+			s.logger.Error(ctx, "Failed to generate test ID token", log.Error(idErr))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to generate token",
+			}
+		}
+		tokenResponse.IDToken = idToken.Token
+	}
+
+	s.logger.Warn(ctx, "Issued test token without an authentication flow",
+		log.String("client_id", oauthApp.ClientID), log.MaskedString("user_id", userID))
+
+	return tokenResponse, nil
+}
+
+// resolveUserAttributes loads the user and returns its attributes, rejecting unknown or inactive users.
+func (s *testTokenService) resolveUserAttributes(
+	ctx context.Context, userID string) (map[string]interface{}, *model.ErrorResponse) {
+	entity, svcErr := s.actorProvider.GetActor(userID)
+	if svcErr != nil {
+		if svcErr.Code == actorprovider.ErrorEntityNotFound.Code {
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorInvalidRequest,
+				ErrorDescription: "The specified user does not exist",
+			}
+		}
+		s.logger.Error(ctx, "Failed to resolve the test token user",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+	if entity == nil {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "The specified user does not exist",
+		}
+	}
+	if entity.State != "" && entity.State != providers.EntityStateActive {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "The specified user is not active",
+		}
+	}
+
+	attrs := make(map[string]interface{})
+	if len(entity.Attributes) > 0 {
+		if err := json.Unmarshal(entity.Attributes, &attrs); err != nil {
+			s.logger.Error(ctx, "Failed to unmarshal test token user attributes", log.Error(err))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to generate token",
+			}
+		}
+	}
+	return attrs, nil
+}
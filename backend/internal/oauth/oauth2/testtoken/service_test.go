@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package testtoken
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/actorprovider"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+)
+
+const (
+	testUserID   = "user-123"
+	testClientID = "test-client"
+)
+
+type TestTokenServiceTestSuite struct {
+	suite.Suite
+	mockActorProvider *actorprovidermock.ActorProviderMock
+	mockTokenBuilder  *tokenservicemock.TokenBuilderInterfaceMock
+	service           TestTokenServiceInterface
+	oauthApp          *providers.OAuthClient
+}
+
+func TestTestTokenServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TestTokenServiceTestSuite))
+}
+
+func (s *TestTokenServiceTestSuite) SetupTest() {
+	s.mockActorProvider = actorprovidermock.NewActorProviderMock(s.T())
+	s.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(s.T())
+	s.service = newTestTokenService(s.mockActorProvider, s.mockTokenBuilder, []string{testClientID})
+	s.oauthApp = &providers.OAuthClient{
+		ID:                      "app-1",
+		ClientID:                testClientID,
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodClientSecretBasic,
+	}
+}
+
+func (s *TestTokenServiceTestSuite) activeUser() *providers.Entity {
+	return &providers.Entity{
+		ID:         testUserID,
+		State:      providers.EntityStateActive,
+		Attributes: json.RawMessage(`{"email":"user@example.com"}`),
+	}
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_AccessTokenOnly() {
+	s.mockActorProvider.EXPECT().GetActor(testUserID).Return(s.activeUser(), nil)
+	s.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything,
+		mock.MatchedBy(func(tc *tokenservice.AccessTokenBuildContext) bool {
+			return tc.Subject == testUserID && tc.ClientID == testClientID &&
+				len(tc.Audiences) == 1 && tc.Audiences[0] == testClientID &&
+				len(tc.Scopes) == 2 && tc.OAuthApp == s.oauthApp
+		})).
+		Return(&model.TokenDTO{
+			Token: "access-token", TokenType: "Bearer", ExpiresIn: 3600, Scopes: []string{"read", "write"},
+		}, nil)
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, []string{"read", "write"},
+		s.oauthApp)
+
+	s.Nil(errResp)
+	s.Require().NotNil(resp)
+	s.Equal("access-token", resp.AccessToken)
+	s.Equal("Bearer", resp.TokenType)
+	s.Equal(int64(3600), resp.ExpiresIn)
+	s.Equal("read write", resp.Scope)
+	s.Empty(resp.IDToken)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_WithIDToken() {
+	s.mockActorProvider.EXPECT().GetActor(testUserID).Return(s.activeUser(), nil)
+	s.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.Anything).
+		Return(&model.TokenDTO{Token: "access-token", TokenType: "Bearer", IssuedAt: 1000,
+			Scopes: []string{constants.ScopeOpenID}}, nil)
+	s.mockTokenBuilder.EXPECT().BuildIDToken(mock.Anything,
+		mock.MatchedBy(func(tc *tokenservice.IDTokenBuildContext) bool {
+			return tc.Subject == testUserID && tc.Audience == testClientID && tc.AuthTime == 1000 &&
+				tc.UserAttributes["email"] == "user@example.com"
+		})).
+		Return(&model.TokenDTO{Token: "id-token"}, nil)
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID,
+		[]string{constants.ScopeOpenID}, s.oauthApp)
+
+	s.Nil(errResp)
+	s.Require().NotNil(resp)
+	s.Equal("id-token", resp.IDToken)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_MissingUserID() {
+	resp, errResp := s.service.IssueTestToken(context.Background(), "", nil, s.oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorInvalidRequest, errResp.Error)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_MissingClient() {
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, nil, nil)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_ClientNotAllowed() {
+	oauthApp := &providers.OAuthClient{ID: "app-2", ClientID: "other-client",
+		TokenEndpointAuthMethod: providers.TokenEndpointAuthMethodClientSecretBasic}
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, nil, oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorUnauthorizedClient, errResp.Error)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_PublicClient() {
+	s.oauthApp.PublicClient = true
+	s.oauthApp.TokenEndpointAuthMethod = providers.TokenEndpointAuthMethodNone
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, nil, s.oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorUnauthorizedClient, errResp.Error)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_UserNotFound() {
+	s.mockActorProvider.EXPECT().GetActor(testUserID).Return(nil, &actorprovider.ErrorEntityNotFound)
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, nil, s.oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorInvalidRequest, errResp.Error)
+	s.Equal("The specified user does not exist", errResp.ErrorDescription)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_UserLookupFails() {
+	s.mockActorProvider.EXPECT().GetActor(testUserID).Return(nil, &tidcommon.ServiceError{
+		Type:             tidcommon.ServerErrorType,
+		Code:             "ENT-5000",
+		ErrorDescription: tidcommon.I18nMessage{DefaultValue: "internal error"},
+	})
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, nil, s.oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_InactiveUser() {
+	user := s.activeUser()
+	user.State = providers.EntityState("LOCKED")
+	s.mockActorProvider.EXPECT().GetActor(testUserID).Return(user, nil)
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, nil, s.oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorInvalidRequest, errResp.Error)
+	s.Equal("The specified user is not active", errResp.ErrorDescription)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_InvalidAttributes() {
+	user := s.activeUser()
+	user.Attributes = json.RawMessage(`not-json`)
+	s.mockActorProvider.EXPECT().GetActor(testUserID).Return(user, nil)
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, nil, s.oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_AccessTokenBuildFails() {
+	s.mockActorProvider.EXPECT().GetActor(testUserID).Return(s.activeUser(), nil)
+	s.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.Anything).
+		Return(nil, errors.New("signing failed"))
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID, nil, s.oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorServerError, errResp.Error)
+}
+
+func (s *TestTokenServiceTestSuite) TestIssueTestToken_IDTokenBuildFails() {
+	s.mockActorProvider.EXPECT().GetActor(testUserID).Return(s.activeUser(), nil)
+	s.mockTokenBuilder.EXPECT().BuildAccessToken(mock.Anything, mock.Anything).
+		Return(&model.TokenDTO{Token: "access-token"}, nil)
+	s.mockTokenBuilder.EXPECT().BuildIDToken(mock.Anything, mock.Anything).
+		Return(nil, errors.New("signing failed"))
+
+	resp, errResp := s.service.IssueTestToken(context.Background(), testUserID,
+		[]string{constants.ScopeOpenID}, s.oauthApp)
+
+	s.Nil(resp)
+	s.Require().NotNil(errResp)
+	s.Equal(constants.ErrorServerError, errResp.Error)
+}
@@ -123,6 +123,8 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 			switch tokenError.Error {
 			case constants.ErrorServerError:
 				statusCode = http.StatusInternalServerError
+			case constants.ErrorQuotaExceeded:
+				statusCode = http.StatusTooManyRequests
 			default:
 				statusCode = http.StatusBadRequest
 			}
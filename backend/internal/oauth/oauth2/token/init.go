@@ -30,6 +30,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/usage"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -44,12 +45,13 @@ func Initialize(
 	observabilitySvc providers.ObservabilityProvider,
 	discoveryService discovery.DiscoveryServiceInterface,
 	dpopVerifier dpop.VerifierInterface,
+	usageMeter usage.MeterInterface,
 	cfg oauthconfig.Config,
 ) TokenHandlerInterface {
 	tokenEndpoint := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpoint
 	dpopRequired := cfg.OAuth.DPoP.Required
 	tokenSvc := newTokenService(grantHandlerProvider, scopeValidator, observabilitySvc,
-		dpopVerifier, tokenEndpoint, dpopRequired)
+		dpopVerifier, usageMeter, tokenEndpoint, dpopRequired)
 	tokenHandler := newTokenHandler(tokenSvc, observabilitySvc)
 	registerRoutes(mux, tokenHandler, actorProvider, authnProvider, jwtService, discoveryService)
 	return tokenHandler
@@ -65,10 +67,11 @@ func registerRoutes(
 	discoveryService discovery.DiscoveryServiceInterface,
 ) {
 	corsOpts := middleware.CORSOptions{
-		AllowedMethods:   []string{"POST"},
-		AllowedHeaders:   middleware.DefaultAllowedHeaders,
-		AllowCredentials: true,
-		MaxAge:           600,
+		AllowedMethods:          []string{"POST", "OPTIONS"},
+		AllowedHeaders:          []string{"Content-Type", "Authorization", "DPoP"},
+		AllowCredentials:        true,
+		MaxAge:                  600,
+		AllowApplicationOrigins: true,
 	}
 
 	endpointURL := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpoint
@@ -82,4 +85,8 @@ func registerRoutes(
 	)
 
 	mux.HandleFunc(pattern, wrappedHandler)
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/token",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, corsOpts))
 }
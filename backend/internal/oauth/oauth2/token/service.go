@@ -32,8 +32,11 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/featureflag"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/netaccess"
 	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/usage"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
@@ -52,6 +55,7 @@ type tokenService struct {
 	scopeValidator       scope.ScopeValidatorInterface
 	observabilitySvc     providers.ObservabilityProvider
 	dpopVerifier         dpop.VerifierInterface
+	usageMeter           usage.MeterInterface
 	tokenEndpoint        string
 	dpopRequired         bool
 }
@@ -62,6 +66,7 @@ func newTokenService(
 	scopeValidator scope.ScopeValidatorInterface,
 	observabilitySvc providers.ObservabilityProvider,
 	dpopVerifier dpop.VerifierInterface,
+	usageMeter usage.MeterInterface,
 	tokenEndpoint string,
 	dpopRequired bool,
 ) TokenServiceInterface {
@@ -70,6 +75,7 @@ func newTokenService(
 		scopeValidator:       scopeValidator,
 		observabilitySvc:     observabilitySvc,
 		dpopVerifier:         dpopVerifier,
+		usageMeter:           usageMeter,
 		tokenEndpoint:        tokenEndpoint,
 		dpopRequired:         dpopRequired,
 	}
@@ -141,6 +147,17 @@ func (ts *tokenService) ProcessTokenRequest(
 		}
 	}
 
+	// Validate the caller's network against the application's allowed networks.
+	if len(oauthApp.AllowedNetworks) > 0 &&
+		!netaccess.IsAllowed(oauthApp.AllowedNetworks, sysContext.GetClientIP(ctx)) {
+		publishTokenIssuanceFailedEvent(ts.observabilitySvc, ctx, clientID, grantTypeStr, scopeStr,
+			401, "Client not authorized from network", startTime)
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorUnauthorizedClient,
+			ErrorDescription: "The client is not authorized to request tokens from this network",
+		}
+	}
+
 	// Validate the token request via the grant handler.
 	tokenError := grantHandler.ValidateGrant(ctx, tokenRequest, oauthApp)
 	if tokenError != nil && tokenError.Error != "" {
@@ -168,6 +185,16 @@ func (ts *tokenService) ProcessTokenRequest(
 		return nil, dpopErr
 	}
 
+	// Reject the request before the grant is consumed when the monthly token quota is used up.
+	if ts.usageMeter != nil && ts.usageMeter.TokenQuotaExceeded(ctx) {
+		publishTokenIssuanceFailedEvent(ts.observabilitySvc, ctx, clientID, grantTypeStr, scopeStr,
+			429, "Token quota exceeded", startTime)
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorQuotaExceeded,
+			ErrorDescription: "The monthly token quota of the deployment has been exceeded",
+		}
+	}
+
 	// Delegate to the grant handler for token generation.
 	tokenRespDTO, tokenError := grantHandler.HandleGrant(ctx, tokenRequest, oauthApp)
 	if tokenError != nil {
@@ -242,6 +269,17 @@ func (ts *tokenService) ProcessTokenRequest(
 		}
 	}
 
+	// Record the tokens issued from the authorization code so they can be revoked on code replay.
+	if grantType == providers.GrantTypeAuthorizationCode {
+		if codeGrantHandler, ok := grantHandler.(granthandlers.AuthorizationCodeGrantHandlerInterface); ok {
+			codeGrantHandler.RecordIssuedTokens(ctx, tokenRequest.Code, tokenRespDTO)
+		}
+	}
+
+	if ts.usageMeter != nil {
+		ts.usageMeter.RecordTokenIssued(ctx, tokenRespDTO.AccessToken.Subject, oauthApp.ID)
+	}
+
 	// Build token response.
 	scopes := strings.Join(tokenRespDTO.AccessToken.Scopes, " ")
 	tokenResponse := &model.TokenResponse{
@@ -273,7 +311,8 @@ func (ts *tokenService) ProcessTokenRequest(
 
 // verifyDPoPProof validates the DPoP proof when present and stores the resulting jkt
 // in ctx for downstream grant handlers. A missing proof is rejected when the client
-// requires dpop-bound access tokens or oauth.dpop.required is true.
+// requires dpop-bound access tokens or oauth.dpop.required is true, and a proof is
+// rejected when the dpop feature flag is off for the client.
 func (ts *tokenService) verifyDPoPProof(ctx *context.Context, oauthApp *providers.OAuthClient) *model.ErrorResponse {
 	proof := dpop.GetProof(*ctx)
 	if proof == "" {
@@ -285,6 +324,16 @@ func (ts *tokenService) verifyDPoPProof(ctx *context.Context, oauthApp *provider
 		}
 		return nil
 	}
+	var appID string
+	if oauthApp != nil {
+		appID = oauthApp.ID
+	}
+	if !featureflag.IsEnabled(*ctx, featureflag.DPoP, appID) {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidDPoPProof,
+			ErrorDescription: "DPoP is not enabled for this client",
+		}
+	}
 	if ts.dpopVerifier == nil {
 		return &model.ErrorResponse{
 			Error:            constants.ErrorServerError,
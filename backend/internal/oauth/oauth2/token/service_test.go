@@ -21,6 +21,7 @@ package token
 import (
 	"context"
 	"errors"
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,11 +32,14 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/dpopmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/granthandlersmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/scopemock"
 	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/usagemock"
 )
 
 type TokenServiceTestSuite struct {
@@ -71,7 +75,7 @@ func (suite *TokenServiceTestSuite) SetupTest() {
 // newService builds a fresh tokenService using the suite's mocks.
 func (suite *TokenServiceTestSuite) newService() TokenServiceInterface {
 	return newTokenService(suite.mockGrantProvider, suite.mockScopeValidator, suite.mockObsSvc,
-		suite.mockDPoPVerifier, "https://example.test/oauth2/token", false)
+		suite.mockDPoPVerifier, nil, "https://example.test/oauth2/token", false)
 }
 
 // defaultApp returns an OAuthClient that allows the authorization_code grant.
@@ -170,6 +174,25 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_UnauthorizedClient()
 	assert.Equal(suite.T(), constants.ErrorUnauthorizedClient, errResp.Error)
 }
 
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_NetworkNotAllowed() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeAuthorizationCode),
+	}
+	app := suite.defaultApp()
+	app.AllowedNetworks = []string{"10.0.0.0/8"}
+	ctx := sysContext.WithClientIP(context.Background(), netip.MustParseAddr("192.0.2.10"))
+
+	svc := suite.newService()
+	_, errResp := svc.ProcessTokenRequest(ctx, req, app)
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorUnauthorizedClient, errResp.Error)
+	assert.Equal(suite.T(), "The client is not authorized to request tokens from this network",
+		errResp.ErrorDescription)
+	suite.mockGrantHandler.AssertNotCalled(suite.T(), "ValidateGrant", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *TokenServiceTestSuite) TestProcessTokenRequest_ValidateGrantError() {
 	req := &model.TokenRequest{
 		ClientID:  "test-client-id",
@@ -330,6 +353,57 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_Success() {
 	assert.Equal(suite.T(), "openid profile", tokenResp.Scope)
 }
 
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_TokenQuotaExceeded() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeAuthorizationCode),
+		Code:      "test-code",
+	}
+	app := suite.defaultApp()
+
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", "test-client-id").Return("", nil)
+	mockMeter := usagemock.NewMeterInterfaceMock(suite.T())
+	mockMeter.On("TokenQuotaExceeded", mock.Anything).Return(true)
+
+	svc := newTokenService(suite.mockGrantProvider, suite.mockScopeValidator, suite.mockObsSvc,
+		suite.mockDPoPVerifier, mockMeter, "https://example.test/oauth2/token", false)
+	tokenResp, errResp := svc.ProcessTokenRequest(context.Background(), req, app)
+
+	assert.Nil(suite.T(), tokenResp)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorQuotaExceeded, errResp.Error)
+	suite.mockGrantHandler.AssertNotCalled(suite.T(), "HandleGrant", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_RecordsIssuedToken() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeAuthorizationCode),
+		Code:      "test-code",
+	}
+	app := suite.defaultApp()
+	app.ID = "app-id"
+
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", "test-client-id").Return("", nil)
+	tokenRespDTO := &model.TokenResponseDTO{
+		AccessToken: model.TokenDTO{Token: "access-token-123", TokenType: "Bearer", Subject: "user-1"},
+	}
+	suite.mockGrantHandler.On("HandleGrant", mock.Anything, mock.Anything, app).Return(tokenRespDTO, nil)
+	suite.mockGrantHandler.On("RecordIssuedTokens", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+	mockMeter := usagemock.NewMeterInterfaceMock(suite.T())
+	mockMeter.On("TokenQuotaExceeded", mock.Anything).Return(false)
+	mockMeter.On("RecordTokenIssued", mock.Anything, "user-1", "app-id").Return().Once()
+
+	svc := newTokenService(suite.mockGrantProvider, suite.mockScopeValidator, suite.mockObsSvc,
+		suite.mockDPoPVerifier, mockMeter, "https://example.test/oauth2/token", false)
+	tokenResp, errResp := svc.ProcessTokenRequest(context.Background(), req, app)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "access-token-123", tokenResp.AccessToken)
+}
+
 func (suite *TokenServiceTestSuite) TestProcessTokenRequest_DPoPProof_Verified_PropagatesJktToHandler() {
 	req := &model.TokenRequest{
 		ClientID:  "test-client-id",
@@ -401,6 +475,35 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_DPoPProof_VerifyFail
 	assert.Equal(suite.T(), constants.ErrorInvalidDPoPProof, errResp.Error)
 }
 
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_DPoPProof_FeatureDisabled_InvalidDPoPProof() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("", &config.Config{FeatureFlags: map[string]bool{"dpop": false}})
+	defer config.ResetServerRuntime()
+
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeAuthorizationCode),
+		Code:      "test-code",
+		Scope:     "openid",
+	}
+	app := suite.defaultApp()
+
+	suite.mockGrantProvider.ExpectedCalls = nil
+	suite.mockGrantProvider.
+		On("GetGrantHandler", providers.GrantTypeAuthorizationCode).
+		Return(suite.mockGrantHandler, nil)
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+
+	svc := suite.newService()
+	ctx := dpop.WithProof(context.Background(), "eyJ.dpop.proof")
+	_, errResp := svc.ProcessTokenRequest(ctx, req, app)
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidDPoPProof, errResp.Error)
+	suite.mockDPoPVerifier.AssertNotCalled(suite.T(), "Verify", mock.Anything, mock.Anything)
+}
+
 func (suite *TokenServiceTestSuite) TestProcessTokenRequest_NoDPoPProof_VerifierNotInvoked() {
 	req := &model.TokenRequest{
 		ClientID:  "test-client-id",
@@ -475,7 +578,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_NoDPoPProof_GlobalRe
 	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
 
 	svc := newTokenService(suite.mockGrantProvider, suite.mockScopeValidator, suite.mockObsSvc,
-		suite.mockDPoPVerifier, "https://example.test/oauth2/token", true)
+		suite.mockDPoPVerifier, nil, "https://example.test/oauth2/token", true)
 	_, errResp := svc.ProcessTokenRequest(context.Background(), req, app)
 
 	assert.NotNil(suite.T(), errResp)
@@ -539,6 +642,36 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_WithRefreshToken() {
 	assert.Equal(suite.T(), "access-token-123", tokenResp.AccessToken)
 }
 
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_RecordsTokensIssuedFromCode() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(providers.GrantTypeAuthorizationCode),
+		Code:      "test-code",
+		Scope:     "openid",
+	}
+	app := suite.defaultApp()
+
+	mockCodeHandler := granthandlersmock.NewAuthorizationCodeGrantHandlerInterfaceMock(suite.T())
+	suite.mockGrantProvider.ExpectedCalls = nil
+	suite.mockGrantProvider.
+		On("GetGrantHandler", providers.GrantTypeAuthorizationCode).
+		Return(mockCodeHandler, nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+
+	tokenRespDTO := &model.TokenResponseDTO{
+		AccessToken: model.TokenDTO{Token: "access-token-123", TokenType: "Bearer", ExpiresIn: 3600},
+	}
+	mockCodeHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	mockCodeHandler.On("HandleGrant", mock.Anything, mock.Anything, app).Return(tokenRespDTO, nil)
+	mockCodeHandler.On("RecordIssuedTokens", mock.Anything, "test-code", tokenRespDTO).Return()
+
+	svc := suite.newService()
+	tokenResp, errResp := svc.ProcessTokenRequest(context.Background(), req, app)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "access-token-123", tokenResp.AccessToken)
+}
+
 func (suite *TokenServiceTestSuite) TestProcessTokenRequest_RefreshTokenIssuanceError() {
 	req := &model.TokenRequest{
 		ClientID:  "test-client-id",
@@ -20,8 +20,11 @@ package tokenservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/claimsource"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
@@ -33,6 +36,10 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
+// ErrRefreshTokenMaxLifetimeExceeded is returned when a refresh token would be issued past the app's
+// maximum refresh token lifetime.
+var ErrRefreshTokenMaxLifetimeExceeded = errors.New("refresh token maximum lifetime exceeded")
+
 // TokenBuilderInterface defines the interface for building OAuth2 tokens.
 type TokenBuilderInterface interface {
 	BuildAccessToken(ctx context.Context, tokenCtx *AccessTokenBuildContext) (*oauth2model.TokenDTO, error)
@@ -42,10 +49,11 @@ type TokenBuilderInterface interface {
 
 // TokenBuilder implements TokenBuilderInterface.
 type tokenBuilder struct {
-	cfg          oauthconfig.Config
-	jwtService   jwt.JWTServiceInterface
-	jweService   jwe.JWEServiceInterface
-	jwksResolver *jwksresolver.Resolver
+	cfg                oauthconfig.Config
+	jwtService         jwt.JWTServiceInterface
+	jweService         jwe.JWEServiceInterface
+	jwksResolver       *jwksresolver.Resolver
+	claimSourceService claimsource.ClaimSourceServiceInterface
 }
 
 // newTokenBuilder creates a new TokenBuilder instance.
@@ -54,12 +62,14 @@ func newTokenBuilder(
 	jwtService jwt.JWTServiceInterface,
 	jweService jwe.JWEServiceInterface,
 	resolver *jwksresolver.Resolver,
+	claimSourceService claimsource.ClaimSourceServiceInterface,
 ) TokenBuilderInterface {
 	return &tokenBuilder{
-		cfg:          cfg,
-		jwtService:   jwtService,
-		jweService:   jweService,
-		jwksResolver: resolver,
+		cfg:                cfg,
+		jwtService:         jwtService,
+		jweService:         jweService,
+		jwksResolver:       resolver,
+		claimSourceService: claimSourceService,
 	}
 }
 
@@ -72,7 +82,8 @@ func (tb *tokenBuilder) BuildAccessToken(
 		return nil, fmt.Errorf("build context cannot be nil")
 	}
 
-	tokenConfig := ResolveTokenConfig(tb.cfg, tokenCtx.OAuthApp, TokenTypeAccess, tokenCtx.ValidityPeriod)
+	tokenConfig := ResolveTokenConfig(
+		tb.cfg, tokenCtx.OAuthApp, TokenTypeAccess, tokenCtx.GrantType, tokenCtx.ValidityPeriod)
 
 	jwtClaims, claimsErr := tb.buildAccessTokenClaims(tokenCtx)
 	if claimsErr != nil {
@@ -104,7 +115,7 @@ func (tb *tokenBuilder) BuildAccessToken(
 		tokenConfig.ValidityPeriod,
 		jwtClaims,
 		jwt.TokenTypeAccessToken,
-		"",
+		tokenCtx.OAuthApp.AccessTokenSigningAlg(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %v", err.Error)
@@ -135,9 +146,13 @@ func (tb *tokenBuilder) buildAccessTokenClaims(
 		claims["grant_type"] = ctx.GrantType
 	}
 
-	// Merge the subject's attributes (already resolved and filtered by the grant handler).
+	// Merge the subject's attributes (already resolved and filtered by the grant handler), applying
+	// the application's claims policy so that excluded or masked attributes never reach the token.
+	claimsPolicy := ctx.OAuthApp.AccessTokenClaimsPolicy()
 	for key, value := range ctx.SubjectAttributes {
-		claims[key] = value
+		if policyValue, include := applyAccessTokenClaimsPolicy(claimsPolicy, key, value, ctx.Scopes); include {
+			claims[key] = policyValue
+		}
 	}
 
 	// Set after merging subject attributes to prevent them from overwriting this system claim.
@@ -205,13 +220,30 @@ func (tb *tokenBuilder) BuildRefreshToken(
 		return nil, fmt.Errorf("build context cannot be nil")
 	}
 
-	tokenConfig := ResolveTokenConfig(tb.cfg, tokenCtx.OAuthApp, TokenTypeRefresh, 0)
+	tokenConfig := ResolveTokenConfig(tb.cfg, tokenCtx.OAuthApp, TokenTypeRefresh, tokenCtx.GrantType, 0)
 
 	claims, claimsErr := tb.buildRefreshTokenClaims(tokenCtx)
 	if claimsErr != nil {
 		return nil, fmt.Errorf("failed to build refresh token claims: %w", claimsErr)
 	}
 
+	// Cap the validity so the token never outlives the app's maximum refresh token lifetime.
+	now := time.Now().Unix()
+	originalIssuedAt := tokenCtx.OriginalIssuedAt
+	if originalIssuedAt == 0 {
+		originalIssuedAt = now
+	}
+	claims[constants.ClaimOriginalIssuedAt] = originalIssuedAt
+	if maxLifetime := tokenCtx.OAuthApp.RefreshTokenMaxLifetime(); maxLifetime > 0 {
+		remaining := originalIssuedAt + maxLifetime - now
+		if remaining <= 0 {
+			return nil, ErrRefreshTokenMaxLifetimeExceeded
+		}
+		if remaining < tokenConfig.ValidityPeriod {
+			tokenConfig.ValidityPeriod = remaining
+		}
+	}
+
 	tokenDTO := &oauth2model.TokenDTO{
 		ExpiresIn:     tokenConfig.ValidityPeriod,
 		Scopes:        tokenCtx.Scopes,
@@ -283,6 +315,10 @@ func (tb *tokenBuilder) buildRefreshTokenClaims(ctx *RefreshTokenBuildContext) (
 		claims[constants.ClaimDPoPJkt] = ctx.DPoPJkt
 	}
 
+	if ctx.DeviceID != "" {
+		claims[constants.ClaimDeviceID] = ctx.DeviceID
+	}
+
 	return claims, nil
 }
 
@@ -295,9 +331,9 @@ func (tb *tokenBuilder) BuildIDToken(
 		return nil, fmt.Errorf("build context cannot be nil")
 	}
 
-	tokenConfig := ResolveTokenConfig(tb.cfg, tokenCtx.OAuthApp, TokenTypeID, 0)
+	tokenConfig := ResolveTokenConfig(tb.cfg, tokenCtx.OAuthApp, TokenTypeID, tokenCtx.GrantType, 0)
 
-	jwtClaims := tb.buildIDTokenClaims(tokenCtx)
+	jwtClaims := tb.buildIDTokenClaims(ctx, tokenCtx)
 
 	tokenDTO := &oauth2model.TokenDTO{
 		ExpiresIn: tokenConfig.ValidityPeriod,
@@ -316,7 +352,7 @@ func (tb *tokenBuilder) BuildIDToken(
 		tokenConfig.ValidityPeriod,
 		jwtClaims,
 		jwt.TokenTypeJWT,
-		"",
+		tokenCtx.OAuthApp.IDTokenSigningAlg(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate ID token: %v", err.Error)
@@ -360,8 +396,10 @@ func (tb *tokenBuilder) BuildIDToken(
 	return tokenDTO, nil
 }
 
-// buildIDTokenClaims builds the claims map for an ID token (OIDC).
-func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]interface{} {
+// buildIDTokenClaims builds the claims map for an ID token (OIDC). Requested claims the user has no
+// local value for are referenced from registered claim sources as aggregated or distributed claims.
+func (tb *tokenBuilder) buildIDTokenClaims(
+	reqCtx context.Context, ctx *IDTokenBuildContext) map[string]interface{} {
 	claims := make(map[string]interface{})
 
 	if ctx.AuthTime > 0 {
@@ -376,7 +414,8 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 		claims["acr"] = ctx.CompletedACR
 	}
 
-	userAttributes := ctx.UserAttributes
+	// Resolve localized attribute values against the requested claims locales.
+	userAttributes := LocalizeUserAttributes(ctx.UserAttributes, ctx.ClaimsLocales)
 	if userAttributes == nil {
 		userAttributes = make(map[string]interface{})
 	}
@@ -408,5 +447,10 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 		claims[key] = value
 	}
 
+	if tb.claimSourceService != nil {
+		requested := RequestedClaimNames(ctx.Scopes, idTokenClaims, scopeClaimsMapping, allowedUserAttributes)
+		tb.claimSourceService.AddClaimReferences(reqCtx, claims, ctx.Subject, ctx.Audience, requested)
+	}
+
 	return claims
 }
@@ -48,6 +48,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/tests/mocks/claimsourcemock"
 	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwemock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
@@ -111,7 +112,7 @@ func (suite *TokenBuilderTestSuite) TestNewTokenBuilder() {
 	jwtService := jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	builder := newTokenBuilder(oauthconfig.Config{
 		JWT: engineconfig.JWTConfig{Issuer: "https://example.com", ValidityPeriod: 3600},
-	}, jwtService, nil, nil)
+	}, jwtService, nil, nil, nil)
 
 	assert.NotNil(suite.T(), builder)
 	assert.Implements(suite.T(), (*TokenBuilderInterface)(nil), builder)
@@ -229,6 +230,50 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_UserAttributes_EmbedsCo
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_UserAttributes_AppliesClaimsPolicy() {
+	oauthApp := &providers.OAuthClient{
+		ClientID: "test-client",
+		Token: &providers.OAuthTokenConfig{
+			AccessToken: &providers.AccessTokenConfig{
+				UserConfig: &providers.AccessTokenSubConfig{Attributes: []string{"email", "phone", "address"}},
+				ClaimsPolicy: &providers.AccessTokenClaimsPolicy{
+					ExcludedClaims: []string{"phone"},
+					HashedClaims:   []string{"email"},
+					ScopedClaims:   map[string]string{"address": "address"},
+				},
+			},
+		},
+	}
+	ctx := &AccessTokenBuildContext{
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		Scopes:    []string{"read"},
+		SubjectAttributes: map[string]interface{}{
+			"email": "a@b.com", "phone": "+94770000000", "address": "Colombo",
+		},
+		GrantType:      string(providers.GrantTypeAuthorizationCode),
+		OAuthApp:       oauthApp,
+		ValidityPeriod: oauthApp.UserAccessTokenConfig().ValidityPeriodOrZero(),
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://example.com", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, hasPhone := claims["phone"]
+			_, hasAddress := claims["address"]
+			return claims["email"] == hashClaimString("a@b.com") && !hasPhone && !hasAddress &&
+				claims["scope"] == "read"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithActorClaim() {
 	actorClaims := &SubjectTokenClaims{
 		Sub:            "actor123",
@@ -649,6 +694,55 @@ func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_Basic() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_MaxLifetime_CapsValidity() {
+	oauthApp := &providers.OAuthClient{
+		ClientID: "test-client",
+		Token: &providers.OAuthTokenConfig{
+			RefreshToken: &providers.RefreshTokenConfig{ValidityPeriod: 3600, MaxLifetime: 7200},
+		},
+	}
+	originalIat := time.Now().Unix() - 7000
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "test-client", "https://example.com",
+		mock.MatchedBy(func(validity int64) bool { return validity > 0 && validity <= 200 }),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[constants.ClaimOriginalIssuedAt] == originalIat
+		}), mock.Anything, mock.Anything,
+	).Return(testRefreshToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildRefreshToken(context.Background(), &RefreshTokenBuildContext{
+		ClientID:         "test-client",
+		GrantType:        string(providers.GrantTypeAuthorizationCode),
+		OAuthApp:         oauthApp,
+		OriginalIssuedAt: originalIat,
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.LessOrEqual(suite.T(), result.ExpiresIn, int64(200))
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_MaxLifetime_Exceeded() {
+	oauthApp := &providers.OAuthClient{
+		ClientID: "test-client",
+		Token: &providers.OAuthTokenConfig{
+			RefreshToken: &providers.RefreshTokenConfig{ValidityPeriod: 3600, MaxLifetime: 7200},
+		},
+	}
+
+	result, err := suite.builder.BuildRefreshToken(context.Background(), &RefreshTokenBuildContext{
+		ClientID:         "test-client",
+		GrantType:        string(providers.GrantTypeAuthorizationCode),
+		OAuthApp:         oauthApp,
+		OriginalIssuedAt: time.Now().Unix() - 7200,
+	})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, ErrRefreshTokenMaxLifetimeExceeded)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT")
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithDPoPJkt() {
 	const testJkt = "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I"
 
@@ -1028,6 +1122,71 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_Basic() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_AddsClaimSourceReferences() {
+	oauthApp := &providers.OAuthClient{
+		ClientID: "app123",
+		Token: &providers.OAuthTokenConfig{
+			IDToken: &providers.IDTokenConfig{UserAttributes: []string{"name", "credit_score"}},
+		},
+		ScopeClaims: map[string][]string{"credit": {"credit_score"}},
+	}
+	ctx := &IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "app123",
+		Scopes:         []string{"openid", "profile", "credit"},
+		UserAttributes: map[string]interface{}{"name": testUserName},
+		OAuthApp:       oauthApp,
+	}
+
+	mockClaimSource := claimsourcemock.NewClaimSourceServiceInterfaceMock(suite.T())
+	mockClaimSource.On("AddClaimReferences", mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool { return claims["name"] == testUserName }),
+		"user123", "app123", []string{"name", "credit_score"},
+	).Run(func(args mock.Arguments) {
+		claims := args.Get(1).(map[string]interface{})
+		claims["_claim_names"] = map[string]interface{}{"credit_score": "credit"}
+	}).Return()
+	suite.builder.claimSourceService = mockClaimSource
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://example.com", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["_claim_names"] != nil && claims["name"] == testUserName
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testIDToken, result.Token)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_UsesConfiguredSigningAlg() {
+	oauthApp := &providers.OAuthClient{
+		ClientID: "test-client",
+		Token: &providers.OAuthTokenConfig{
+			IDToken: &providers.IDTokenConfig{ValidityPeriod: 3600, SigningAlg: "ES256"},
+		},
+	}
+	ctx := &IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "app123",
+		Scopes:         []string{"openid"},
+		UserAttributes: map[string]interface{}{"sub": "user123"},
+		OAuthApp:       oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://example.com", int64(3600), mock.Anything, mock.Anything, "ES256",
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testIDToken, result.Token)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithNonce() {
 	ctx := &IDTokenBuildContext{
 		Subject:        "user123",
@@ -1206,6 +1365,49 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithStandardOIDCSco
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithClaimsLocales() {
+	oauthAppWithUserAttrs := &providers.OAuthClient{
+		ClientID: "test-client",
+		Token: &providers.OAuthTokenConfig{
+			IDToken: &providers.IDTokenConfig{
+				ValidityPeriod: 3600,
+				UserAttributes: []string{"name", "email"},
+			},
+		},
+	}
+
+	ctx := &IDTokenBuildContext{
+		Subject:  "user123",
+		Audience: "app123",
+		Scopes:   []string{"openid", "profile", "email"},
+		UserAttributes: map[string]interface{}{
+			"name":    testUserName,
+			"name#ja": "山田太郎",
+			"email":   "john@example.com",
+		},
+		ClaimsLocales: "ja-JP en",
+		AuthTime:      time.Now().Unix(),
+		OAuthApp:      oauthAppWithUserAttrs,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://example.com",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, hasTaggedName := claims["name#ja"]
+			return claims["name"] == "山田太郎" && claims["email"] == "john@example.com" && !hasTaggedName
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(context.Background(), ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_NoUserAttributes() {
 	ctx := &IDTokenBuildContext{
 		Subject:        "user123",
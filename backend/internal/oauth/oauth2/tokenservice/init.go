@@ -19,6 +19,7 @@
 package tokenservice
 
 import (
+	"github.com/thunder-id/thunderid/internal/claimsource"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
@@ -36,8 +37,9 @@ func Initialize(
 	resolver *jwksresolver.Resolver,
 	idpService providers.IDPProvider,
 	enforcementService revocation.EnforcementServiceInterface,
+	claimSourceService claimsource.ClaimSourceServiceInterface,
 ) (TokenBuilderInterface, TokenValidatorInterface) {
-	tokenBuilder := newTokenBuilder(cfg, jwtService, jweService, resolver)
+	tokenBuilder := newTokenBuilder(cfg, jwtService, jweService, resolver, claimSourceService)
 	tokenValidator := newTokenValidator(cfg, jwtService, idpService, enforcementService)
 	return tokenBuilder, tokenValidator
 }
@@ -82,6 +82,12 @@ type RefreshTokenBuildContext struct {
 	ClaimsLocales        string
 	DPoPJkt              string
 	ActorSub             string
+	// DeviceID binds the refresh token to the device the user signed in from, so revoking the
+	// device's trust ends the refresh token chain.
+	DeviceID string
+	// OriginalIssuedAt is the issue time of the first refresh token in the chain, carried over on
+	// renewal so the app's maximum refresh token lifetime is enforced (0 for a first issuance).
+	OriginalIssuedAt int64
 }
 
 // IDTokenBuildContext contains all the information needed to build an ID token (OIDC).
@@ -93,8 +99,11 @@ type IDTokenBuildContext struct {
 	AuthTime       int64
 	OAuthApp       *providers.OAuthClient
 	ClaimsRequest  *oauth2model.ClaimsRequest
+	ClaimsLocales  string
 	Nonce          string
 	CompletedACR   string
+	// GrantType is the grant that originally issued the tokens, used to resolve per-grant validity.
+	GrantType string
 }
 
 // RefreshTokenClaims represents the validated claims from a refresh token.
@@ -109,11 +118,15 @@ type RefreshTokenClaims struct {
 	ClaimsLocales    string
 	DPoPJkt          string
 	ActorSub         string
+	DeviceID         string
 	// JTI is the refresh token's unique identifier, used for deny-list (revocation) enforcement.
 	JTI string
 	// Exp is the refresh token's expiry (exp claim); used to bound the deny-list entry when the token
 	// is revoked on rotation.
 	Exp int64
+	// OriginalIat is the issue time of the first refresh token in the chain. Falls back to Iat for
+	// tokens issued without the orig_iat claim.
+	OriginalIat int64
 }
 
 // SubjectTokenClaims represents the validated claims from a subject token (for token exchange).
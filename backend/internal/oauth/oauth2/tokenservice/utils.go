@@ -20,11 +20,15 @@ package tokenservice
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"slices"
 	"strings"
 
+	"golang.org/x/text/language"
+
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
@@ -57,9 +61,10 @@ func JoinScopes(scopes []string) string {
 
 // ResolveTokenConfig resolves the token configuration from the OAuth app or falls back to global config.
 // accessValidityPeriod is the token subject's configured access-token validity (0 to use the
-// global default); it is only consulted for TokenTypeAccess.
+// global default); it is only consulted for TokenTypeAccess. A validity period the app sets for
+// grantType takes precedence over the token-type level configuration.
 func ResolveTokenConfig(
-	cfg oauthconfig.Config, oauthApp *providers.OAuthClient, tokenType TokenType,
+	cfg oauthconfig.Config, oauthApp *providers.OAuthClient, tokenType TokenType, grantType string,
 	accessValidityPeriod int64,
 ) *TokenConfig {
 	tokenConfig := &TokenConfig{
@@ -67,18 +72,29 @@ func ResolveTokenConfig(
 		ValidityPeriod: cfg.JWT.ValidityPeriod,
 	}
 
+	var grantPeriods *providers.GrantValidityPeriods
+	if oauthApp != nil {
+		grantPeriods = oauthApp.Token.GrantValidityPeriodsFor(grantType)
+	}
+
 	// Override with token-type specific configuration if available
 	switch tokenType {
 	case TokenTypeAccess:
 		if accessValidityPeriod > 0 {
 			tokenConfig.ValidityPeriod = accessValidityPeriod
 		}
+		if grantPeriods != nil && grantPeriods.AccessToken > 0 {
+			tokenConfig.ValidityPeriod = grantPeriods.AccessToken
+		}
 	case TokenTypeID:
 		if oauthApp != nil && oauthApp.Token != nil && oauthApp.Token.IDToken != nil {
 			if oauthApp.Token.IDToken.ValidityPeriod > 0 {
 				tokenConfig.ValidityPeriod = oauthApp.Token.IDToken.ValidityPeriod
 			}
 		}
+		if grantPeriods != nil && grantPeriods.IDToken > 0 {
+			tokenConfig.ValidityPeriod = grantPeriods.IDToken
+		}
 	case TokenTypeRefresh:
 		if cfg.OAuth.RefreshToken.ValidityPeriod > 0 {
 			tokenConfig.ValidityPeriod = cfg.OAuth.RefreshToken.ValidityPeriod
@@ -88,6 +104,9 @@ func ResolveTokenConfig(
 				tokenConfig.ValidityPeriod = oauthApp.Token.RefreshToken.ValidityPeriod
 			}
 		}
+		if grantPeriods != nil && grantPeriods.RefreshToken > 0 {
+			tokenConfig.ValidityPeriod = grantPeriods.RefreshToken
+		}
 	}
 
 	return tokenConfig
@@ -264,7 +283,9 @@ func FetchUserAttributes(
 		if len(allowedClaims) == 0 {
 			return false // Only add special claims if explicitly allowed
 		}
-		return slices.Contains(allowedClaims, claimName)
+		// Localized variants of an allowed claim are kept so the claim can be resolved per locale.
+		baseName, _, _ := strings.Cut(claimName, constants.ClaimLanguageTagSeparator)
+		return slices.Contains(allowedClaims, baseName)
 	}
 
 	if attributeCacheKey != "" {
@@ -285,6 +306,87 @@ func FetchUserAttributes(
 	return attrs, nil
 }
 
+// LocalizeUserAttributes resolves localized user attributes against the requested claims locales.
+// Attributes stored with a language tag (e.g. "name#ja") are removed from the returned map, and the
+// value of the variant that best matches the space-separated claimsLocales replaces the value of the
+// untagged attribute. Locales are tried in order, first for a variant with the same tag and then for
+// a variant in the same language. Attributes without a matching variant keep their untagged value.
+func LocalizeUserAttributes(
+	userAttributes map[string]interface{}, claimsLocales string,
+) map[string]interface{} {
+	if userAttributes == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{}, len(userAttributes))
+	variants := make(map[string]map[string]interface{})
+	for key, value := range userAttributes {
+		baseName, tag, localized := strings.Cut(key, constants.ClaimLanguageTagSeparator)
+		if !localized {
+			result[key] = value
+			continue
+		}
+		if variants[baseName] == nil {
+			variants[baseName] = make(map[string]interface{})
+		}
+		variants[baseName][tag] = value
+	}
+
+	preferred := make([]language.Tag, 0)
+	for _, locale := range strings.Fields(claimsLocales) {
+		if tag, err := language.Parse(locale); err == nil {
+			preferred = append(preferred, tag)
+		}
+	}
+	if len(preferred) == 0 {
+		return result
+	}
+
+	for baseName, values := range variants {
+		if value := selectLocalizedValue(values, preferred); value != nil {
+			result[baseName] = value
+		}
+	}
+
+	return result
+}
+
+// selectLocalizedValue returns the value of the variant, keyed by language tag, that best matches the
+// preferred tags, or nil when no variant matches.
+func selectLocalizedValue(values map[string]interface{}, preferred []language.Tag) interface{} {
+	available := make(map[language.Tag]interface{}, len(values))
+	for tag, value := range values {
+		if parsed, err := language.Parse(tag); err == nil && value != nil {
+			available[parsed] = value
+		}
+	}
+
+	for _, want := range preferred {
+		if value, ok := available[want]; ok {
+			return value
+		}
+
+		// Fall back to the variant in the same language with the fewest subtags, e.g. "ja" for "ja-JP".
+		wantBase, _ := want.Base()
+		var match interface{}
+		matchTag := ""
+		for tag, value := range available {
+			if base, _ := tag.Base(); base != wantBase {
+				continue
+			}
+			if match == nil || len(tag.String()) < len(matchTag) ||
+				(len(tag.String()) == len(matchTag) && tag.String() < matchTag) {
+				match, matchTag = value, tag.String()
+			}
+		}
+		if match != nil {
+			return match
+		}
+	}
+
+	return nil
+}
+
 // BuildClaims builds claims by merging scope-based claims with explicit claims request.
 // Explicit claims override scope claims. Returns empty if allowedUserAttributes is not configured.
 // The requestedClaims should contain only the relevant claims map (IDToken or UserInfo) for the target.
@@ -331,6 +433,46 @@ func BuildClaims(
 	return result
 }
 
+// RequestedClaimNames returns the names of the claims requested through the scopes and the explicit
+// claims request that the application allows, regardless of whether the user has values for them.
+// Explicitly requested claims constrained to specific values are left out, since the value of a
+// claim served by an external claim source cannot be checked. Returns nil without the openid scope.
+func RequestedClaimNames(
+	scopes []string,
+	requestedClaims map[string]*model.IndividualClaimRequest,
+	scopeClaimsMapping map[string][]string,
+	allowedUserAttributes []string,
+) []string {
+	if !slices.Contains(scopes, constants.ScopeOpenID) || len(allowedUserAttributes) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0)
+	addName := func(name string) {
+		if slices.Contains(allowedUserAttributes, name) && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	for _, scope := range scopes {
+		scopeClaims, exists := scopeClaimsMapping[scope]
+		if !exists {
+			if standardScope, ok := constants.StandardOIDCScopes[scope]; ok {
+				scopeClaims = standardScope.Claims
+			}
+		}
+		for _, claim := range scopeClaims {
+			addName(claim)
+		}
+	}
+	for claimName, claimReq := range requestedClaims {
+		if claimReq == nil || (claimReq.Value == nil && len(claimReq.Values) == 0) {
+			addName(claimName)
+		}
+	}
+	return names
+}
+
 // buildClaimsFromScopes builds claims from OIDC scopes based on scope-to-claims mapping.
 func buildClaimsFromScopes(
 	scopes []string,
@@ -455,6 +597,61 @@ func FilterAttributesByAllowList(
 	return filtered
 }
 
+// applyAccessTokenClaimsPolicy applies the application's access token claims policy to a single
+// subject attribute. It returns the value to embed and whether the attribute should be embedded at
+// all, given the scopes granted to the token.
+func applyAccessTokenClaimsPolicy(
+	policy *providers.AccessTokenClaimsPolicy, key string, value interface{}, scopes []string,
+) (interface{}, bool) {
+	if policy == nil {
+		return value, true
+	}
+	if slices.Contains(policy.ExcludedClaims, key) {
+		return nil, false
+	}
+	if requiredScope, ok := policy.ScopedClaims[key]; ok && !slices.Contains(scopes, requiredScope) {
+		return nil, false
+	}
+	if slices.Contains(policy.HashedClaims, key) {
+		return hashClaimValue(value), true
+	}
+	return value, true
+}
+
+// hashClaimValue replaces a claim value with the base64url-encoded SHA-256 hash of it. String list
+// values are hashed element by element so that resource servers can still match individual entries;
+// any other non-string value is hashed over its JSON encoding.
+func hashClaimValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return hashClaimString(v)
+	case []string:
+		hashed := make([]string, len(v))
+		for i, item := range v {
+			hashed[i] = hashClaimString(item)
+		}
+		return hashed
+	case []interface{}:
+		hashed := make([]interface{}, len(v))
+		for i, item := range v {
+			hashed[i] = hashClaimValue(item)
+		}
+		return hashed
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			encoded = []byte(fmt.Sprint(v))
+		}
+		return hashClaimString(string(encoded))
+	}
+}
+
+// hashClaimString returns the base64url-encoded SHA-256 hash of the given string.
+func hashClaimString(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
 // BuildClientAttributes gathers all OAuth client/application-scoped attributes that should be added
 // to an access token for the given OAuth application.
 func BuildClientAttributes(
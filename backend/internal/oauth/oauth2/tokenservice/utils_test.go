@@ -34,6 +34,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/tests/mocks/actorprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
@@ -468,6 +469,102 @@ func (suite *UtilsTestSuite) TestFetchUserAttributes_NilAllowedClaims() {
 	mockAttrCacheService.AssertExpectations(suite.T())
 }
 
+func (suite *UtilsTestSuite) TestFetchUserAttributes_IncludesLocalizedVariants() {
+	mockAttrCacheService := attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
+	mockAttrCacheService.On("GetAttributeCache", mock.Anything, "cache-key-123").
+		Return(&attributecache.AttributeCache{
+			ID: "cache-key-123",
+			Attributes: map[string]interface{}{
+				"name":     "Taro Yamada",
+				"name#ja":  "山田太郎",
+				"email":    "test@example.com",
+				"email#ja": "test@example.jp",
+			},
+		}, nil)
+
+	attrs, err := FetchUserAttributes(context.Background(), mockAttrCacheService,
+		[]string{"name"}, "cache-key-123")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]interface{}{
+		"name":    "Taro Yamada",
+		"name#ja": "山田太郎",
+	}, attrs)
+}
+
+func (suite *UtilsTestSuite) TestLocalizeUserAttributes() {
+	userAttributes := map[string]interface{}{
+		"name":               "Taro Yamada",
+		"name#ja-Kana-JP":    "ヤマダタロウ",
+		"name#ja-Hani-JP":    "山田太郎",
+		"name#fr":            "Taro Yamada (fr)",
+		"nickname#ja":        "たろう",
+		"email":              "test@example.com",
+		"given_name#invalid": "ignored",
+	}
+
+	testCases := []struct {
+		name          string
+		claimsLocales string
+		expected      map[string]interface{}
+	}{
+		{
+			name:          "NoLocalesKeepsUntaggedValues",
+			claimsLocales: "",
+			expected: map[string]interface{}{
+				"name":  "Taro Yamada",
+				"email": "test@example.com",
+			},
+		},
+		{
+			name:          "ExactMatch",
+			claimsLocales: "ja-Kana-JP",
+			expected: map[string]interface{}{
+				"name":     "ヤマダタロウ",
+				"nickname": "たろう",
+				"email":    "test@example.com",
+			},
+		},
+		{
+			name:          "FirstMatchingLocaleWins",
+			claimsLocales: "de fr ja-Kana-JP",
+			expected: map[string]interface{}{
+				"name":     "Taro Yamada (fr)",
+				"nickname": "たろう",
+				"email":    "test@example.com",
+			},
+		},
+		{
+			name:          "SameLanguageMatch",
+			claimsLocales: "ja-JP",
+			expected: map[string]interface{}{
+				"name":     "山田太郎",
+				"nickname": "たろう",
+				"email":    "test@example.com",
+			},
+		},
+		{
+			name:          "NoMatchingVariantKeepsUntaggedValue",
+			claimsLocales: "de",
+			expected: map[string]interface{}{
+				"name":  "Taro Yamada",
+				"email": "test@example.com",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			result := LocalizeUserAttributes(userAttributes, tc.claimsLocales)
+			assert.Equal(suite.T(), tc.expected, result)
+		})
+	}
+}
+
+func (suite *UtilsTestSuite) TestLocalizeUserAttributes_NilAttributes() {
+	assert.Nil(suite.T(), LocalizeUserAttributes(nil, "ja"))
+}
+
 func (suite *UtilsTestSuite) TestFetchUserAttributes_CacheWithoutUserType() {
 	mockAttrCacheService := attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
 
@@ -539,7 +636,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithServerLevel
 		},
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(86400), result.ValidityPeriod)
@@ -557,7 +654,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithoutServerLe
 		},
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(3600), result.ValidityPeriod)
@@ -576,7 +673,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithNilOAuthApp
 		},
 	}
 
-	result := ResolveTokenConfig(cfg, nil, TokenTypeRefresh, 0)
+	result := ResolveTokenConfig(cfg, nil, TokenTypeRefresh, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(604800), result.ValidityPeriod)
@@ -601,7 +698,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithTokenConfig
 		Token:    &providers.OAuthTokenConfig{},
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(86400), result.ValidityPeriod)
@@ -616,7 +713,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_AccessToken_WithNilOAuthApp(
 		},
 	}
 
-	result := ResolveTokenConfig(cfg, nil, TokenTypeAccess, 0)
+	result := ResolveTokenConfig(cfg, nil, TokenTypeAccess, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(3600), result.ValidityPeriod)
@@ -636,7 +733,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_AccessToken_WithNilToken() {
 		Token:    nil,
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeAccess, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeAccess, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(3600), result.ValidityPeriod)
@@ -663,7 +760,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_AccessToken_WithAppLevelConf
 	}
 
 	result := ResolveTokenConfig(
-		cfg, oauthApp, TokenTypeAccess, oauthApp.UserAccessTokenConfig().ValidityPeriodOrZero())
+		cfg, oauthApp, TokenTypeAccess, "", oauthApp.UserAccessTokenConfig().ValidityPeriodOrZero())
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(7200), result.ValidityPeriod)
@@ -677,7 +774,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_IDToken_WithNilOAuthApp() {
 		},
 	}
 
-	result := ResolveTokenConfig(cfg, nil, TokenTypeID, 0)
+	result := ResolveTokenConfig(cfg, nil, TokenTypeID, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(3600), result.ValidityPeriod)
@@ -697,7 +794,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_IDToken_WithNilToken() {
 		Token:    nil,
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeID, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeID, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(3600), result.ValidityPeriod)
@@ -721,7 +818,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_IDToken_WithAppLevelConfig()
 		},
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeID, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeID, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), int64(1800), result.ValidityPeriod)
@@ -735,7 +832,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_WithCustomIssuer_NilOAuthApp
 		},
 	}
 
-	result := ResolveTokenConfig(cfg, nil, TokenTypeAccess, 0)
+	result := ResolveTokenConfig(cfg, nil, TokenTypeAccess, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), "https://thunder.io", result.Issuer)
@@ -754,7 +851,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_WithTokenConfig_UsesServerIs
 		Token:    &providers.OAuthTokenConfig{},
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeAccess, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeAccess, "", 0)
 
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), "https://thunder.io", result.Issuer)
@@ -1013,7 +1110,7 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithAppLevelCon
 		},
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, "", 0)
 
 	suite.Equal(int64(7200), result.ValidityPeriod)
 }
@@ -1033,7 +1130,101 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_FallsBackToServ
 		Token: &providers.OAuthTokenConfig{},
 	}
 
-	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, 0)
+	result := ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, "", 0)
 
 	suite.Equal(int64(86400), result.ValidityPeriod)
 }
+
+func (suite *UtilsTestSuite) TestResolveTokenConfig_GrantValidityPeriods() {
+	cfg := oauthconfig.Config{
+		JWT: engineconfig.JWTConfig{
+			Issuer:         "https://thunder.io",
+			ValidityPeriod: 3600,
+		},
+		OAuth: engineconfig.OAuthConfig{
+			RefreshToken: engineconfig.RefreshTokenConfig{ValidityPeriod: 86400},
+		},
+	}
+
+	oauthApp := &providers.OAuthClient{
+		Token: &providers.OAuthTokenConfig{
+			IDToken:      &providers.IDTokenConfig{ValidityPeriod: 1800},
+			RefreshToken: &providers.RefreshTokenConfig{ValidityPeriod: 7200},
+			GrantValidityPeriods: map[string]*providers.GrantValidityPeriods{
+				string(providers.GrantTypeCIBA):          {AccessToken: 300, RefreshToken: 900, IDToken: 120},
+				string(providers.GrantTypeTokenExchange): {AccessToken: 60},
+			},
+		},
+	}
+	ciba := string(providers.GrantTypeCIBA)
+	tokenExchange := string(providers.GrantTypeTokenExchange)
+
+	suite.Equal(int64(300), ResolveTokenConfig(cfg, oauthApp, TokenTypeAccess, ciba, 1200).ValidityPeriod)
+	suite.Equal(int64(900), ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, ciba, 0).ValidityPeriod)
+	suite.Equal(int64(120), ResolveTokenConfig(cfg, oauthApp, TokenTypeID, ciba, 0).ValidityPeriod)
+
+	// Token types without a grant override keep the application-level validity periods.
+	suite.Equal(int64(60), ResolveTokenConfig(cfg, oauthApp, TokenTypeAccess, tokenExchange, 1200).ValidityPeriod)
+	suite.Equal(int64(7200), ResolveTokenConfig(cfg, oauthApp, TokenTypeRefresh, tokenExchange, 0).ValidityPeriod)
+	suite.Equal(int64(1800), ResolveTokenConfig(cfg, oauthApp, TokenTypeID, tokenExchange, 0).ValidityPeriod)
+
+	// Grants without overrides are unaffected.
+	suite.Equal(int64(1200), ResolveTokenConfig(
+		cfg, oauthApp, TokenTypeAccess, string(providers.GrantTypeAuthorizationCode), 1200).ValidityPeriod)
+}
+
+func (suite *UtilsTestSuite) TestApplyAccessTokenClaimsPolicy() {
+	policy := &providers.AccessTokenClaimsPolicy{
+		ExcludedClaims: []string{"phone_number"},
+		HashedClaims:   []string{"email", "groups", "age"},
+		ScopedClaims:   map[string]string{"address": "address", "email": "email"},
+	}
+	scopes := []string{"openid", "email"}
+
+	value, include := applyAccessTokenClaimsPolicy(nil, "email", "a@b.com", scopes)
+	suite.True(include)
+	suite.Equal("a@b.com", value)
+
+	_, include = applyAccessTokenClaimsPolicy(policy, "phone_number", "+1555", scopes)
+	suite.False(include)
+
+	_, include = applyAccessTokenClaimsPolicy(policy, "address", "Colombo", scopes)
+	suite.False(include)
+
+	value, include = applyAccessTokenClaimsPolicy(policy, "email", "a@b.com", scopes)
+	suite.True(include)
+	suite.Equal("-5jUStdQGpWfP09KPwBP4tnlgepiB-IYxLAsCKTXWt8", value)
+
+	value, include = applyAccessTokenClaimsPolicy(policy, "groups", []interface{}{"a@b.com"}, scopes)
+	suite.True(include)
+	suite.Equal([]interface{}{"-5jUStdQGpWfP09KPwBP4tnlgepiB-IYxLAsCKTXWt8"}, value)
+
+	value, include = applyAccessTokenClaimsPolicy(policy, "age", 42, scopes)
+	suite.True(include)
+	suite.Equal(hashClaimString("42"), value)
+
+	value, include = applyAccessTokenClaimsPolicy(policy, "given_name", "Alice", scopes)
+	suite.True(include)
+	suite.Equal("Alice", value)
+
+	// A scoped claim whose scope is not granted is dropped even when it is also hashed.
+	_, include = applyAccessTokenClaimsPolicy(policy, "email", "a@b.com", []string{"openid"})
+	suite.False(include)
+}
+
+func (suite *UtilsTestSuite) TestRequestedClaimNames() {
+	allowed := []string{"email", "credit_score", "salary", "given_name"}
+	requested := map[string]*model.IndividualClaimRequest{
+		"salary":       nil,
+		"given_name":   {Value: "Alice"},
+		"nickname":     {Essential: true},
+		"credit_score": {Essential: true},
+	}
+
+	names := RequestedClaimNames([]string{"openid", "email", "credit"}, requested,
+		map[string][]string{"credit": {"credit_score"}}, allowed)
+
+	suite.ElementsMatch([]string{"email", "credit_score", "salary"}, names)
+	suite.Nil(RequestedClaimNames([]string{"email"}, requested, nil, allowed))
+	suite.Nil(RequestedClaimNames([]string{"openid", "email"}, requested, nil, nil))
+}
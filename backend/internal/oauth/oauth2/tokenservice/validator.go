@@ -158,10 +158,15 @@ func (tv *tokenValidator) ValidateRefreshToken(
 	grantType, _ := extractStringClaim(claims, "grant_type")
 	iat, _ := extractInt64Claim(claims, "iat")
 	exp, _ := extractInt64Claim(claims, "exp")
+	originalIat, _ := extractInt64Claim(claims, constants.ClaimOriginalIssuedAt)
+	if originalIat == 0 {
+		originalIat = iat
+	}
 	scopes := extractScopesFromClaims(claims, false)
 	attributeCacheID, _ := extractStringClaim(claims, "aci")
 	actorSub, _ := extractStringClaim(claims, "act_sub")
 	jti, _ := extractStringClaim(claims, "jti")
+	deviceID, _ := extractStringClaim(claims, constants.ClaimDeviceID)
 
 	// Extract claims request if present
 	var claimsRequest *oauth2model.ClaimsRequest
@@ -202,8 +207,10 @@ func (tv *tokenValidator) ValidateRefreshToken(
 		ClaimsLocales:    claimsLocales,
 		DPoPJkt:          dpopJkt,
 		ActorSub:         actorSub,
+		DeviceID:         deviceID,
 		JTI:              jti,
 		Exp:              exp,
+		OriginalIat:      originalIat,
 	}, nil
 }
 
@@ -23,6 +23,7 @@ import (
 	"net/http"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/claimsource"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
@@ -46,10 +47,11 @@ func Initialize(
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
 	discoveryService discovery.DiscoveryServiceInterface,
 	dpopVerifier dpop.VerifierInterface,
+	claimSourceService claimsource.ClaimSourceServiceInterface,
 	cfg oauthconfig.Config,
 ) userInfoServiceInterface {
 	userInfoService := newUserInfoService(jwtService, jweService, resolver, tokenValidator,
-		actorProvider, attributeCacheSvc, dpopVerifier, cfg)
+		actorProvider, attributeCacheSvc, dpopVerifier, claimSourceService, cfg)
 	userInfoEndpoint := discoveryService.GetOAuth2AuthorizationServerMetadata(
 		context.Background()).UserInfoEndpoint
 	dpopAlgs := cfg.OAuth.DPoP.AllowedAlgs
@@ -61,10 +63,11 @@ func Initialize(
 // registerRoutes registers the routes for the UserInfo endpoint.
 func registerRoutes(mux *http.ServeMux, userInfoHandler *userInfoHandler) {
 	opts := middleware.CORSOptions{
-		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
-		AllowedHeaders:   middleware.DefaultAllowedHeaders,
-		AllowCredentials: true,
-		MaxAge:           600,
+		AllowedMethods:          []string{"GET", "POST", "OPTIONS"},
+		AllowedHeaders:          []string{"Content-Type", "Authorization", "DPoP"},
+		AllowCredentials:        true,
+		MaxAge:                  600,
+		AllowApplicationOrigins: true,
 	}
 
 	mux.HandleFunc(middleware.WithCORS("GET "+constants.OAuth2UserInfoEndpoint,
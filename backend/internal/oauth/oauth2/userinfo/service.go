@@ -28,6 +28,7 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/claimsource"
 	oauthconfig "github.com/thunder-id/thunderid/internal/oauth/config"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
@@ -53,15 +54,16 @@ type userInfoServiceInterface interface {
 
 // userInfoService implements the userInfoServiceInterface.
 type userInfoService struct {
-	cfg               oauthconfig.Config
-	jwtService        jwt.JWTServiceInterface
-	jweService        jwe.JWEServiceInterface
-	jwksResolver      *jwksresolver.Resolver
-	tokenValidator    tokenservice.TokenValidatorInterface
-	inboundClient     providers.ActorProvider
-	attributeCacheSvc attributecache.AttributeCacheServiceInterface
-	dpopVerifier      dpop.VerifierInterface
-	logger            *log.Logger
+	cfg                oauthconfig.Config
+	jwtService         jwt.JWTServiceInterface
+	jweService         jwe.JWEServiceInterface
+	jwksResolver       *jwksresolver.Resolver
+	tokenValidator     tokenservice.TokenValidatorInterface
+	inboundClient      providers.ActorProvider
+	attributeCacheSvc  attributecache.AttributeCacheServiceInterface
+	dpopVerifier       dpop.VerifierInterface
+	claimSourceService claimsource.ClaimSourceServiceInterface
+	logger             *log.Logger
 }
 
 // newUserInfoService creates a new userInfoService instance.
@@ -73,19 +75,21 @@ func newUserInfoService(
 	actorProvider providers.ActorProvider,
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
 	dpopVerifier dpop.VerifierInterface,
+	claimSourceService claimsource.ClaimSourceServiceInterface,
 	cfg oauthconfig.Config,
 ) userInfoServiceInterface {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, serviceLoggerComponentName))
 	return &userInfoService{
-		cfg:               cfg,
-		jwtService:        jwtService,
-		jweService:        jweService,
-		jwksResolver:      resolver,
-		tokenValidator:    tokenValidator,
-		inboundClient:     actorProvider,
-		attributeCacheSvc: attributeCacheSvc,
-		dpopVerifier:      dpopVerifier,
-		logger:            logger,
+		cfg:                cfg,
+		jwtService:         jwtService,
+		jweService:         jweService,
+		jwksResolver:       resolver,
+		tokenValidator:     tokenValidator,
+		inboundClient:      actorProvider,
+		attributeCacheSvc:  attributeCacheSvc,
+		dpopVerifier:       dpopVerifier,
+		claimSourceService: claimSourceService,
+		logger:             logger,
 	}
 }
 
@@ -198,6 +202,10 @@ func (s *userInfoService) buildResponseFromClaims(
 		return nil, &tidcommon.InternalServerError
 	}
 
+	// Resolve localized attribute values against the claims locales recorded in the access token.
+	claimsLocales, _ := tokenClaims[constants.ClaimClaimsLocales].(string)
+	userAttributes = tokenservice.LocalizeUserAttributes(userAttributes, claimsLocales)
+
 	response, svcErr := s.buildUserInfoResponse(ctx, sub, scopes, userAttributes, oauthApp, tokenClaims)
 	if svcErr != nil {
 		return nil, svcErr
@@ -410,7 +418,9 @@ func (s *userInfoService) getOAuthApp(
 }
 
 // buildUserInfoResponse builds the final UserInfo response from sub, scopes, and user attributes.
-// It also processes any explicit claims request embedded in the access token.
+// It also processes any explicit claims request embedded in the access token. Requested claims the
+// user has no local value for are referenced from registered claim sources as aggregated or
+// distributed claims.
 func (s *userInfoService) buildUserInfoResponse(ctx context.Context,
 	sub string,
 	scopes []string,
@@ -454,6 +464,12 @@ func (s *userInfoService) buildUserInfoResponse(ctx context.Context,
 		response[key] = value
 	}
 
+	if s.claimSourceService != nil && oauthApp != nil {
+		requested := tokenservice.RequestedClaimNames(
+			scopes, userInfoClaims, scopeClaimsMapping, allowedUserAttributes)
+		s.claimSourceService.AddClaimReferences(ctx, response, sub, oauthApp.ClientID, requested)
+	}
+
 	return response, nil
 }
 
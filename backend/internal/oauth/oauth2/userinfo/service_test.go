@@ -49,6 +49,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/claimsourcemock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
@@ -88,7 +89,7 @@ func (s *UserInfoServiceTestSuite) SetupTest() {
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
 		actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr()),
-		s.mockAttributeCacheService, nil,
+		s.mockAttributeCacheService, nil, nil,
 		oauthconfig.Config{JWT: engineconfig.JWTConfig{Issuer: testUserInfoIssuer, ValidityPeriod: 600}},
 	)
 
@@ -155,7 +156,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_RevocationUnavailable(
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr())
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	token := "token.revocation.unavailable"
 	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
@@ -359,6 +360,45 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_StandardScopes() {
 	s.mockInboundClient.AssertExpectations(s.T())
 }
 
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithClaimsLocales() {
+	claims := map[string]interface{}{
+		"exp":            float64(time.Now().Add(time.Hour).Unix()),
+		"nbf":            float64(time.Now().Add(-time.Minute).Unix()),
+		"sub":            "user123",
+		"scope":          "openid profile email",
+		"client_id":      "client123",
+		"aci":            "cache-locale-123",
+		"claims_locales": "ja-JP",
+	}
+	token := s.createToken(claims)
+
+	userAttrs := map[string]interface{}{
+		"name":     "John Doe",
+		"name#ja":  "ジョン・ドウ",
+		"email":    "john@example.com",
+		"email#ja": "john@example.jp",
+	}
+
+	oauthApp := &providers.OAuthClient{
+		UserInfo: &providers.UserInfoConfig{
+			UserAttributes: []string{"name", "email"},
+		},
+	}
+
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-locale-123").Return(
+		&attributecache.AttributeCache{ID: "cache-locale-123", Attributes: userAttrs}, nil)
+	s.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "client123").Return(oauthApp, nil)
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Nil(s.T(), svcErr)
+	assert.NotNil(s.T(), response)
+	assert.Equal(s.T(), "ジョン・ドウ", response.JSONBody["name"])
+	assert.Equal(s.T(), "john@example.jp", response.JSONBody["email"])
+	assert.NotContains(s.T(), response.JSONBody, "name#ja")
+}
+
 // TestGetUserInfo_Success_WithGroups tests successful response with groups
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithGroups() {
 	claims := map[string]interface{}{
@@ -465,6 +505,49 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithScopeClaimsMappin
 	s.mockInboundClient.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_Success_WithClaimSourceReferences tests that requested claims without a local value
+// are handed to the claim source service for aggregated or distributed claim references.
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithClaimSourceReferences() {
+	claims := map[string]interface{}{
+		"exp":       float64(time.Now().Add(time.Hour).Unix()),
+		"nbf":       float64(time.Now().Add(-time.Minute).Unix()),
+		"sub":       "user123",
+		"scope":     "openid credit",
+		"client_id": "client123",
+		"aci":       "cache-credit-123",
+	}
+	token := s.createToken(claims)
+
+	oauthApp := &providers.OAuthClient{
+		ClientID: "client123",
+		UserInfo: &providers.UserInfoConfig{
+			UserAttributes: []string{"name", "credit_score"},
+		},
+		ScopeClaims: map[string][]string{
+			"credit": {"name", "credit_score"},
+		},
+	}
+	mockClaimSource := claimsourcemock.NewClaimSourceServiceInterfaceMock(s.T())
+	mockClaimSource.On("AddClaimReferences", mock.Anything, mock.Anything, "user123", "client123",
+		[]string{"name", "credit_score"}).Run(func(args mock.Arguments) {
+		response := args.Get(1).(map[string]interface{})
+		response["_claim_names"] = map[string]interface{}{"credit_score": "credit"}
+	}).Return()
+	s.userInfoService.(*userInfoService).claimSourceService = mockClaimSource
+
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-credit-123").Return(
+		&attributecache.AttributeCache{ID: "cache-credit-123",
+			Attributes: map[string]interface{}{"name": "John Doe"}}, nil)
+	s.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "client123").Return(oauthApp, nil)
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Nil(s.T(), svcErr)
+	assert.Equal(s.T(), "John Doe", response.JSONBody["name"])
+	assert.Equal(s.T(), map[string]interface{}{"credit_score": "credit"}, response.JSONBody["_claim_names"])
+}
+
 // TestGetUserInfo_Success_NoAppConfig tests successful response without app config
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_NoAppConfig() {
 	claims := map[string]interface{}{
@@ -1188,7 +1271,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_NotBoundToken_Rejected
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr())
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	claims := map[string]any{
 		"sub":   "user123",
@@ -1214,7 +1297,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfoForDPoP_VerifierFails_Rejected
 	actorProv := actorprovider.Initialize(s.mockInboundClient, s.mockEntityProvider, noopAuthnMgr())
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
-		actorProv, s.mockAttributeCacheService, verifier, userInfoTestConfig())
+		actorProv, s.mockAttributeCacheService, verifier, nil, userInfoTestConfig())
 
 	claims := map[string]any{
 		"sub":   "user123",
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package openid4vci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// boltOpenID4VCIStore is the bolt-backed implementation of openID4VCIStoreInterface.
+// Entries expire with their records, so no cleanup job is required.
+type boltOpenID4VCIStore struct {
+	client       provider.BoltProviderInterface
+	deploymentID string
+	logger       *log.Logger
+}
+
+// newBoltOpenID4VCIStore creates a new bolt-backed OpenID4VCI runtime store.
+func newBoltOpenID4VCIStore(p provider.BoltProviderInterface) openID4VCIStoreInterface {
+	return &boltOpenID4VCIStore{
+		client:       p,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OpenID4VCIStore")),
+	}
+}
+
+// nonceKey builds the bolt key for a c_nonce.
+func (s *boltOpenID4VCIStore) nonceKey(nonce string) string {
+	return fmt.Sprintf("runtime:%s:openid4vci:nonce:%s", s.deploymentID, nonce)
+}
+
+// offerKey builds the bolt key for a credential offer.
+func (s *boltOpenID4VCIStore) offerKey(id string) string {
+	return fmt.Sprintf("runtime:%s:openid4vci:offer:%s", s.deploymentID, id)
+}
+
+// SaveNonce stores a nonce record in bolt with a TTL matching its expiry.
+func (s *boltOpenID4VCIStore) SaveNonce(_ context.Context, nonce string, rec *nonceRecord) error {
+	return s.save(s.nonceKey(nonce), rec, rec.ExpiresAt, "nonce")
+}
+
+// GetNonce retrieves a stored nonce record, returning false if it is not found.
+func (s *boltOpenID4VCIStore) GetNonce(ctx context.Context, nonce string) (*nonceRecord, bool) {
+	var rec nonceRecord
+	if !s.load(ctx, s.nonceKey(nonce), &rec, "nonce") {
+		return nil, false
+	}
+	return &rec, true
+}
+
+// DeleteNonce removes a nonce record from bolt.
+func (s *boltOpenID4VCIStore) DeleteNonce(_ context.Context, nonce string) error {
+	if err := s.client.Del(s.nonceKey(nonce)); err != nil {
+		return fmt.Errorf("failed to delete nonce from bolt: %w", err)
+	}
+	return nil
+}
+
+// SaveOffer stores a credential offer record in bolt with a TTL matching its expiry.
+func (s *boltOpenID4VCIStore) SaveOffer(_ context.Context, id string, rec *offerRecord) error {
+	return s.save(s.offerKey(id), rec, rec.ExpiresAt, "credential offer")
+}
+
+// GetOffer retrieves a stored credential offer by ID, returning false if it is not found.
+func (s *boltOpenID4VCIStore) GetOffer(ctx context.Context, id string) (*offerRecord, bool) {
+	var rec offerRecord
+	if !s.load(ctx, s.offerKey(id), &rec, "credential offer") {
+		return nil, false
+	}
+	return &rec, true
+}
+
+// save marshals a record and stores it under key until expiresAt. Records that have
+// already expired are not stored.
+func (s *boltOpenID4VCIStore) save(key string, rec interface{}, expiresAt time.Time, kind string) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return fmt.Errorf("%s has already expired", kind)
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", kind, err)
+	}
+	if err := s.client.Set(key, data, ttl); err != nil {
+		return fmt.Errorf("failed to store %s in bolt: %w", kind, err)
+	}
+	return nil
+}
+
+// load reads the record stored under key into dest, returning false if it is absent or unreadable.
+func (s *boltOpenID4VCIStore) load(ctx context.Context, key string, dest interface{}, kind string) bool {
+	data, err := s.client.Get(key)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get "+kind+" from bolt", log.Error(err))
+		return false
+	}
+	if data == nil {
+		return false
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		s.logger.Error(ctx, "Failed to unmarshal "+kind, log.Error(err))
+		return false
+	}
+	return true
+}
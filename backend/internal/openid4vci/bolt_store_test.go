@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package openid4vci
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider/bolttest"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+type BoltOpenID4VCIStoreTestSuite struct {
+	suite.Suite
+	store *boltOpenID4VCIStore
+	ctx   context.Context
+}
+
+func TestBoltOpenID4VCIStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(BoltOpenID4VCIStoreTestSuite))
+}
+
+func (suite *BoltOpenID4VCIStoreTestSuite) SetupTest() {
+	suite.store = &boltOpenID4VCIStore{
+		client:       bolttest.NewProvider(suite.T()),
+		deploymentID: "test-deployment",
+		logger:       log.GetLogger(),
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *BoltOpenID4VCIStoreTestSuite) TestKeys() {
+	suite.Equal("runtime:test-deployment:openid4vci:nonce:n1", suite.store.nonceKey("n1"))
+	suite.Equal("runtime:test-deployment:openid4vci:offer:o1", suite.store.offerKey("o1"))
+}
+
+func (suite *BoltOpenID4VCIStoreTestSuite) TestNonceLifecycle() {
+	rec := &nonceRecord{ExpiresAt: time.Now().Add(time.Minute).UTC().Truncate(time.Second)}
+	suite.Require().NoError(suite.store.SaveNonce(suite.ctx, "n1", rec))
+
+	got, ok := suite.store.GetNonce(suite.ctx, "n1")
+	suite.Require().True(ok)
+	suite.True(rec.ExpiresAt.Equal(got.ExpiresAt))
+
+	suite.Require().NoError(suite.store.DeleteNonce(suite.ctx, "n1"))
+	_, ok = suite.store.GetNonce(suite.ctx, "n1")
+	suite.False(ok)
+}
+
+func (suite *BoltOpenID4VCIStoreTestSuite) TestSaveOfferAndGetOffer() {
+	rec := &offerRecord{
+		Offer:     map[string]interface{}{"credential_issuer": "https://issuer.example"},
+		ExpiresAt: time.Now().Add(time.Minute).UTC().Truncate(time.Second),
+	}
+	suite.Require().NoError(suite.store.SaveOffer(suite.ctx, "o1", rec))
+
+	got, ok := suite.store.GetOffer(suite.ctx, "o1")
+	suite.Require().True(ok)
+	suite.Equal(rec.Offer, got.Offer)
+
+	_, ok = suite.store.GetOffer(suite.ctx, "missing")
+	suite.False(ok)
+}
+
+func (suite *BoltOpenID4VCIStoreTestSuite) TestSave_AlreadyExpired() {
+	err := suite.store.SaveNonce(suite.ctx, "n1", &nonceRecord{ExpiresAt: time.Now().Add(-time.Second)})
+	suite.ErrorContains(err, "nonce has already expired")
+}
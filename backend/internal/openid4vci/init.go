@@ -28,6 +28,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/jose/jws"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
@@ -102,7 +103,7 @@ func Initialize(
 		EnforceScope:         cfg.EnforceScope,
 	}, cryptoProvider, kmprovider.KeyRef{KeyID: cfg.SigningKeyID},
 		string(signingKey.Algorithm), signingKey.Thumbprint, x5c,
-		newOpenID4VCIStore(), jwtService, userService, credSvc)
+		initializeStore(), jwtService, userService, credSvc)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +113,17 @@ func Initialize(
 	return svc, nil
 }
 
+// initializeStore selects the runtime store implementation based on the configured runtime DB type.
+func initializeStore() openID4VCIStoreInterface {
+	switch config.GetServerRuntime().Config.Database.Runtime.Type {
+	case provider.DataSourceTypeRedis:
+		return newRedisOpenID4VCIStore(provider.GetRedisProvider())
+	case provider.DataSourceTypeBolt:
+		return newBoltOpenID4VCIStore(provider.GetBoltProvider())
+	}
+	return newOpenID4VCIStore()
+}
+
 // registerRoutes registers the OpenID4VCI HTTP routes with CORS middleware on the given mux.
 func registerRoutes(mux *http.ServeMux, h *openID4VCIHandler) {
 	opts := middleware.CORSOptions{
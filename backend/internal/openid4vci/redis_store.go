@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package openid4vci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// redisClient abstracts the Redis commands used by the OpenID4VCI runtime store.
+type redisClient interface {
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+}
+
+// redisOpenID4VCIStore is the Redis-backed implementation of openID4VCIStoreInterface.
+// Entries expire with their records, so no cleanup job is required.
+type redisOpenID4VCIStore struct {
+	client       redisClient
+	keyPrefix    string
+	deploymentID string
+	logger       *log.Logger
+}
+
+// newRedisOpenID4VCIStore creates a new Redis-backed OpenID4VCI runtime store.
+func newRedisOpenID4VCIStore(p provider.RedisProviderInterface) openID4VCIStoreInterface {
+	return &redisOpenID4VCIStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OpenID4VCIStore")),
+	}
+}
+
+// nonceKey builds the Redis key for a c_nonce.
+func (s *redisOpenID4VCIStore) nonceKey(nonce string) string {
+	return fmt.Sprintf("%s:runtime:%s:openid4vci:nonce:%s", s.keyPrefix, s.deploymentID, nonce)
+}
+
+// offerKey builds the Redis key for a credential offer.
+func (s *redisOpenID4VCIStore) offerKey(id string) string {
+	return fmt.Sprintf("%s:runtime:%s:openid4vci:offer:%s", s.keyPrefix, s.deploymentID, id)
+}
+
+// SaveNonce stores a nonce record in Redis with a TTL matching its expiry.
+func (s *redisOpenID4VCIStore) SaveNonce(ctx context.Context, nonce string, rec *nonceRecord) error {
+	return s.save(ctx, s.nonceKey(nonce), rec, rec.ExpiresAt, "nonce")
+}
+
+// GetNonce retrieves a stored nonce record, returning false if it is not found.
+func (s *redisOpenID4VCIStore) GetNonce(ctx context.Context, nonce string) (*nonceRecord, bool) {
+	var rec nonceRecord
+	if !s.load(ctx, s.nonceKey(nonce), &rec, "nonce") {
+		return nil, false
+	}
+	return &rec, true
+}
+
+// DeleteNonce removes a nonce record from Redis.
+func (s *redisOpenID4VCIStore) DeleteNonce(ctx context.Context, nonce string) error {
+	if err := s.client.Del(ctx, s.nonceKey(nonce)).Err(); err != nil {
+		return fmt.Errorf("failed to delete nonce from Redis: %w", err)
+	}
+	return nil
+}
+
+// SaveOffer stores a credential offer record in Redis with a TTL matching its expiry.
+func (s *redisOpenID4VCIStore) SaveOffer(ctx context.Context, id string, rec *offerRecord) error {
+	return s.save(ctx, s.offerKey(id), rec, rec.ExpiresAt, "credential offer")
+}
+
+// GetOffer retrieves a stored credential offer by ID, returning false if it is not found.
+func (s *redisOpenID4VCIStore) GetOffer(ctx context.Context, id string) (*offerRecord, bool) {
+	var rec offerRecord
+	if !s.load(ctx, s.offerKey(id), &rec, "credential offer") {
+		return nil, false
+	}
+	return &rec, true
+}
+
+// save marshals a record and stores it under key until expiresAt. Records that have
+// already expired are not stored.
+func (s *redisOpenID4VCIStore) save(
+	ctx context.Context, key string, rec interface{}, expiresAt time.Time, kind string,
+) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return fmt.Errorf("%s has already expired", kind)
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", kind, err)
+	}
+	if err := s.client.Set(ctx, key, data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store %s in Redis: %w", kind, err)
+	}
+	return nil
+}
+
+// load reads the record stored under key into dest, returning false if it is absent or unreadable.
+func (s *redisOpenID4VCIStore) load(ctx context.Context, key string, dest interface{}, kind string) bool {
+	data, err := s.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			s.logger.Error(ctx, "Failed to get "+kind+" from Redis", log.Error(err))
+		}
+		return false
+	}
+	if err := json.Unmarshal(data, dest); err != nil {
+		s.logger.Error(ctx, "Failed to unmarshal "+kind, log.Error(err))
+		return false
+	}
+	return true
+}
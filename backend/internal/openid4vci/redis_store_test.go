@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package openid4vci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment"
+)
+
+type RedisOpenID4VCIStoreTestSuite struct {
+	suite.Suite
+	store      *redisOpenID4VCIStore
+	mockClient *redisClientMock
+}
+
+func TestRedisOpenID4VCIStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisOpenID4VCIStoreTestSuite))
+}
+
+func (suite *RedisOpenID4VCIStoreTestSuite) SetupTest() {
+	suite.mockClient = newRedisClientMock(suite.T())
+	suite.store = &redisOpenID4VCIStore{
+		client:       suite.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+		logger:       log.GetLogger(),
+	}
+}
+
+func (suite *RedisOpenID4VCIStoreTestSuite) TestKeys() {
+	suite.Equal(fmt.Sprintf("%s:runtime:%s:openid4vci:nonce:n-1", redisTestKeyPrefix, redisTestDeploymentID),
+		suite.store.nonceKey("n-1"))
+	suite.Equal(fmt.Sprintf("%s:runtime:%s:openid4vci:offer:o-1", redisTestKeyPrefix, redisTestDeploymentID),
+		suite.store.offerKey("o-1"))
+}
+
+// A nonce is stored with a TTL bounded by its expiry and read back unchanged.
+func (suite *RedisOpenID4VCIStoreTestSuite) TestSaveAndGetNonce_RoundTrip() {
+	key := suite.store.nonceKey("n-1")
+	rec := &nonceRecord{ExpiresAt: time.Now().Add(time.Minute)}
+
+	var stored []byte
+	suite.mockClient.On("Set", context.Background(), key, mock.Anything,
+		mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 0 && ttl <= time.Minute })).
+		Run(func(args mock.Arguments) { stored = args.Get(2).([]byte) }).
+		Return(redis.NewStatusCmd(context.Background()))
+	suite.Require().NoError(suite.store.SaveNonce(context.Background(), "n-1", rec))
+
+	stringCmd := redis.NewStringCmd(context.Background())
+	stringCmd.SetVal(string(stored))
+	suite.mockClient.On("Get", context.Background(), key).Return(stringCmd)
+
+	got, ok := suite.store.GetNonce(context.Background(), "n-1")
+	suite.Require().True(ok)
+	suite.True(rec.ExpiresAt.Equal(got.ExpiresAt))
+}
+
+func (suite *RedisOpenID4VCIStoreTestSuite) TestSaveAndGetOffer_RoundTrip() {
+	key := suite.store.offerKey("o-1")
+	rec := &offerRecord{
+		Offer:     map[string]interface{}{"credential_issuer": "https://issuer.example"},
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	var stored []byte
+	suite.mockClient.On("Set", context.Background(), key, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { stored = args.Get(2).([]byte) }).
+		Return(redis.NewStatusCmd(context.Background()))
+	suite.Require().NoError(suite.store.SaveOffer(context.Background(), "o-1", rec))
+
+	stringCmd := redis.NewStringCmd(context.Background())
+	stringCmd.SetVal(string(stored))
+	suite.mockClient.On("Get", context.Background(), key).Return(stringCmd)
+
+	got, ok := suite.store.GetOffer(context.Background(), "o-1")
+	suite.Require().True(ok)
+	suite.Equal("https://issuer.example", got.Offer["credential_issuer"])
+}
+
+func (suite *RedisOpenID4VCIStoreTestSuite) TestSaveNonce_AlreadyExpired() {
+	err := suite.store.SaveNonce(context.Background(), "n-1", &nonceRecord{ExpiresAt: time.Now().Add(-time.Second)})
+	suite.Error(err)
+	suite.mockClient.AssertNotCalled(suite.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RedisOpenID4VCIStoreTestSuite) TestSaveOffer_SetError() {
+	statusCmd := redis.NewStatusCmd(context.Background())
+	statusCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Set", context.Background(), suite.store.offerKey("o-1"), mock.Anything, mock.Anything).
+		Return(statusCmd)
+
+	err := suite.store.SaveOffer(context.Background(), "o-1",
+		&offerRecord{Offer: map[string]interface{}{}, ExpiresAt: time.Now().Add(time.Minute)})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to store credential offer in Redis")
+}
+
+func (suite *RedisOpenID4VCIStoreTestSuite) TestGetNonce_NotFound() {
+	stringCmd := redis.NewStringCmd(context.Background())
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("Get", context.Background(), suite.store.nonceKey("n-1")).Return(stringCmd)
+
+	got, ok := suite.store.GetNonce(context.Background(), "n-1")
+	suite.False(ok)
+	suite.Nil(got)
+}
+
+func (suite *RedisOpenID4VCIStoreTestSuite) TestGetOffer_GetError() {
+	stringCmd := redis.NewStringCmd(context.Background())
+	stringCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Get", context.Background(), suite.store.offerKey("o-1")).Return(stringCmd)
+
+	got, ok := suite.store.GetOffer(context.Background(), "o-1")
+	suite.False(ok)
+	suite.Nil(got)
+}
+
+func (suite *RedisOpenID4VCIStoreTestSuite) TestDeleteNonce() {
+	suite.mockClient.On("Del", context.Background(), suite.store.nonceKey("n-1")).
+		Return(redis.NewIntCmd(context.Background()))
+	suite.NoError(suite.store.DeleteNonce(context.Background(), "n-1"))
+
+	intCmd := redis.NewIntCmd(context.Background())
+	intCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Del", context.Background(), suite.store.nonceKey("n-2")).Return(intCmd)
+	err := suite.store.DeleteNonce(context.Background(), "n-2")
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to delete nonce from Redis")
+}
@@ -91,6 +91,29 @@ func (s *cacheBackedOUStore) GetOrganizationUnitByHandle(
 	return ou, nil
 }
 
+// GetOrganizationUnitByPath resolves a handle path one segment at a time through the
+// handle+parent cache, so repeated lookups of the same path avoid hitting the store.
+func (s *cacheBackedOUStore) GetOrganizationUnitByPath(
+	ctx context.Context, handles []string) (providers.OrganizationUnit, error) {
+	if len(handles) == 0 {
+		return providers.OrganizationUnit{}, ErrOrganizationUnitNotFound
+	}
+
+	var current providers.OrganizationUnit
+	var parentID *string
+	for _, handle := range handles {
+		ou, err := s.GetOrganizationUnitByHandle(ctx, handle, parentID)
+		if err != nil {
+			return providers.OrganizationUnit{}, err
+		}
+		current = ou
+		id := current.ID
+		parentID = &id
+	}
+
+	return current, nil
+}
+
 func (s *cacheBackedOUStore) UpdateOrganizationUnit(ctx context.Context, ou providers.OrganizationUnit) error {
 	// Capture old handle+parent key before the store call so we can invalidate it on success.
 	oldHandleParentKey := s.getHandleParentKey(ctx, ou.ID)
@@ -139,11 +162,6 @@ func (s *cacheBackedOUStore) GetOrganizationUnitsByIDs(
 	return s.store.GetOrganizationUnitsByIDs(ctx, ids)
 }
 
-func (s *cacheBackedOUStore) GetOrganizationUnitByPath(
-	ctx context.Context, handles []string) (providers.OrganizationUnit, error) {
-	return s.store.GetOrganizationUnitByPath(ctx, handles)
-}
-
 func (s *cacheBackedOUStore) IsOrganizationUnitExists(ctx context.Context, id string) (bool, error) {
 	if cached, ok := s.ouByIDCache.Get(ctx, cache.CacheKey{Key: id}); ok && cached != nil {
 		return true, nil
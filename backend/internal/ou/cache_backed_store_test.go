@@ -222,6 +222,42 @@ func (s *CacheBackedOUStoreTestSuite) TestGetOrganizationUnitByHandle_StoreError
 	s.False(ok)
 }
 
+// --- GetOrganizationUnitByPath tests ---
+
+func (s *CacheBackedOUStoreTestSuite) TestGetOrganizationUnitByPath_WalksHandlesAndCaches() {
+	root := providers.OrganizationUnit{ID: "root-id", Handle: "engineering"}
+	rootID := root.ID
+	child := providers.OrganizationUnit{ID: "child-id", Handle: "emea", Parent: &rootID}
+	s.mockStore.On("GetOrganizationUnitByHandle", mock.Anything, "engineering",
+		(*string)(nil)).Return(root, nil).Once()
+	s.mockStore.On("GetOrganizationUnitByHandle", mock.Anything, "emea",
+		&rootID).Return(child, nil).Once()
+
+	result, err := s.cachedStore.GetOrganizationUnitByPath(
+		context.Background(), []string{"engineering", "emea"})
+	s.Nil(err)
+	s.Equal("child-id", result.ID)
+
+	// Second lookup is served entirely from the handle+parent cache.
+	result, err = s.cachedStore.GetOrganizationUnitByPath(
+		context.Background(), []string{"engineering", "emea"})
+	s.Nil(err)
+	s.Equal("child-id", result.ID)
+	s.mockStore.AssertExpectations(s.T())
+	s.mockStore.AssertNotCalled(s.T(), "GetOrganizationUnitByPath")
+}
+
+func (s *CacheBackedOUStoreTestSuite) TestGetOrganizationUnitByPath_NotFound() {
+	s.mockStore.On("GetOrganizationUnitByHandle", mock.Anything, "missing",
+		(*string)(nil)).Return(providers.OrganizationUnit{}, ErrOrganizationUnitNotFound).Once()
+
+	_, err := s.cachedStore.GetOrganizationUnitByPath(context.Background(), []string{"missing", "child"})
+	s.ErrorIs(err, ErrOrganizationUnitNotFound)
+
+	_, err = s.cachedStore.GetOrganizationUnitByPath(context.Background(), []string{})
+	s.ErrorIs(err, ErrOrganizationUnitNotFound)
+}
+
 // --- CreateOrganizationUnit tests ---
 
 func (s *CacheBackedOUStoreTestSuite) TestCreateOrganizationUnit_CachesBothKeys() {
@@ -428,12 +464,6 @@ func (s *CacheBackedOUStoreTestSuite) TestPassThroughMethods() {
 	s.Nil(err)
 	s.Empty(byIDs)
 
-	s.mockStore.On("GetOrganizationUnitByPath", mock.Anything,
-		[]string{"root", "child"}).Return(providers.OrganizationUnit{ID: "path-ou"}, nil).Once()
-	byPath, err := s.cachedStore.GetOrganizationUnitByPath(ctx, []string{"root", "child"})
-	s.Nil(err)
-	s.Equal("path-ou", byPath.ID)
-
 	s.mockStore.On("IsOrganizationUnitDeclarative", mock.Anything, "ou-1").Return(true).Once()
 	declarative := s.cachedStore.IsOrganizationUnitDeclarative(ctx, "ou-1")
 	s.True(declarative)
@@ -29,6 +29,7 @@ import (
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
+	"github.com/thunder-id/thunderid/internal/job"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/filter"
@@ -38,6 +39,9 @@ import (
 
 const loggerComponentName = "OrganizationUnitHandler"
 
+// queryParamRecursive is the query parameter that deletes an organization unit with its descendants.
+const queryParamRecursive = "recursive"
+
 // organizationUnitHandler is the handler for organization unit management operations.
 type organizationUnitHandler struct {
 	service OrganizationUnitServiceInterface
@@ -175,6 +179,61 @@ func (ouh *organizationUnitHandler) HandleOUPutRequest(w http.ResponseWriter, r
 	logger.Debug(ctx, "Successfully updated organization unit", log.String("ouId", id))
 }
 
+// HandleOUPatchRequest handles the partial update organization unit request. The body is a JSON
+// Merge Patch (RFC 7396) document applied to the organization unit's current values.
+func (ouh *organizationUnitHandler) HandleOUPatchRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	id, idValidateFailed := extractAndValidateID(w, r)
+	if idValidateFailed {
+		return
+	}
+
+	current, svcErr := ouh.service.GetOrganizationUnit(ctx, id)
+	if svcErr != nil {
+		ouh.handleError(ctx, w, svcErr)
+		return
+	}
+
+	updateRequest, err := sysutils.DecodeMergePatchBody[OrganizationUnitRequest](r, OrganizationUnitRequest{
+		Handle:          current.Handle,
+		Name:            current.Name,
+		Description:     current.Description,
+		Parent:          current.Parent,
+		ThemeID:         current.ThemeID,
+		LayoutID:        current.LayoutID,
+		LogoURL:         current.LogoURL,
+		TosURI:          current.TosURI,
+		PolicyURI:       current.PolicyURI,
+		CookiePolicyURI: current.CookiePolicyURI,
+	})
+	if err != nil {
+		var valErr *sysutils.ValidationError
+		if errors.As(err, &valErr) {
+			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
+			return
+		}
+		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
+			Code:        ErrorInvalidRequestFormat.Code,
+			Message:     ErrorInvalidRequestFormat.Error,
+			Description: ErrorInvalidRequestFormat.ErrorDescription,
+		})
+		return
+	}
+	sanitizedRequest := ouh.sanitizeOrganizationUnitRequest(*updateRequest)
+
+	ou, svcErr := ouh.service.UpdateOrganizationUnit(ctx, id, sanitizedRequest)
+	if svcErr != nil {
+		ouh.handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, ou)
+
+	logger.Debug(ctx, "Successfully patched organization unit", log.String("ouId", id))
+}
+
 // HandleOUDeleteRequest handles the delete organization unit request.
 func (ouh *organizationUnitHandler) HandleOUDeleteRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -185,6 +244,20 @@ func (ouh *organizationUnitHandler) HandleOUDeleteRequest(w http.ResponseWriter,
 		return
 	}
 
+	if r.URL.Query().Get(queryParamRecursive) == "true" {
+		deleteJob, svcErr := ouh.service.DeleteOrganizationUnitSubtree(ctx, id)
+		if svcErr != nil {
+			ouh.handleError(ctx, w, svcErr)
+			return
+		}
+
+		w.Header().Set("Location", "/jobs/"+deleteJob.ID)
+		sysutils.WriteSuccessResponse(ctx, w, http.StatusAccepted, deleteJob)
+		logger.Debug(ctx, "Submitted organization unit subtree deletion", log.String("ouId", id),
+			log.String("jobId", deleteJob.ID))
+		return
+	}
+
 	svcErr := ouh.service.DeleteOrganizationUnit(ctx, id)
 	if svcErr != nil {
 		ouh.handleError(ctx, w, svcErr)
@@ -258,6 +331,8 @@ func (
 			statusCode = http.StatusBadRequest
 		} else if svcErr.Code == tidcommon.ErrorUnauthorized.Code {
 			statusCode = http.StatusForbidden
+		} else if svcErr.Code == job.ErrorJobQueueFull.Code {
+			statusCode = http.StatusTooManyRequests
 		}
 	default:
 		statusCode = http.StatusInternalServerError
@@ -35,6 +35,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/job"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
@@ -214,6 +215,31 @@ func (suite *OrganizationUnitHandlerTestSuite) TestOUHandler_RegisterRoutes() {
 			},
 			wantStatus: http.StatusOK,
 		},
+		{
+			name:   "handle path dispatch",
+			method: http.MethodGet,
+			path:   "/organization-units/handle/engineering/emea",
+			setup: func(serviceMock *OrganizationUnitServiceInterfaceMock) {
+				serviceMock.
+					On("GetOrganizationUnitByPath", mock.Anything, "engineering/emea").
+					Return(providers.OrganizationUnit{ID: "ou-emea"}, nil).
+					Once()
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "handle path users dispatch",
+			method: http.MethodGet,
+			path:   "/organization-units/handle/engineering/users",
+			setup: func(serviceMock *OrganizationUnitServiceInterfaceMock) {
+				serviceMock.
+					On("GetOrganizationUnitUsersByPath", mock.Anything, "engineering",
+						serverconst.DefaultPageSize, 0, false).
+					Return(&UserListResponse{}, nil).
+					Once()
+			},
+			wantStatus: http.StatusOK,
+		},
 		{
 			name:       "unknown subresource",
 			method:     http.MethodGet,
@@ -1069,6 +1095,43 @@ func (suite *OrganizationUnitHandlerTestSuite) TestOUHandler_HandleOUDeleteReque
 	}
 }
 
+func (suite *OrganizationUnitHandlerTestSuite) TestOUHandler_HandleOUDeleteRequest_Recursive() {
+	suite.Run("submits subtree deletion job", func() {
+		serviceMock := NewOrganizationUnitServiceInterfaceMock(suite.T())
+		serviceMock.On("DeleteOrganizationUnitSubtree", mock.Anything, "ou-1").
+			Return(&job.Job{ID: "job-1", Type: jobTypeSubtreeDelete, Status: job.StatusPending}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/organization-units/ou-1?recursive=true", nil)
+		req.SetPathValue("id", "ou-1")
+		recorder := httptest.NewRecorder()
+		newOrganizationUnitHandler(serviceMock).HandleOUDeleteRequest(recorder, req)
+
+		suite.Equal(http.StatusAccepted, recorder.Code)
+		suite.Equal("/jobs/job-1", recorder.Header().Get("Location"))
+		var body job.Job
+		suite.NoError(json.Unmarshal(recorder.Body.Bytes(), &body))
+		suite.Equal("job-1", body.ID)
+		suite.Equal(job.StatusPending, body.Status)
+		serviceMock.AssertNotCalled(suite.T(), "DeleteOrganizationUnit", mock.Anything, mock.Anything)
+	})
+
+	suite.Run("job queue full", func() {
+		serviceMock := NewOrganizationUnitServiceInterfaceMock(suite.T())
+		serviceMock.On("DeleteOrganizationUnitSubtree", mock.Anything, "ou-1").
+			Return(nil, &job.ErrorJobQueueFull).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/organization-units/ou-1?recursive=true", nil)
+		req.SetPathValue("id", "ou-1")
+		recorder := httptest.NewRecorder()
+		newOrganizationUnitHandler(serviceMock).HandleOUDeleteRequest(recorder, req)
+
+		suite.Equal(http.StatusTooManyRequests, recorder.Code)
+		var body apierror.ErrorResponse
+		suite.NoError(json.Unmarshal(recorder.Body.Bytes(), &body))
+		suite.Equal(job.ErrorJobQueueFull.Code, body.Code)
+	})
+}
+
 func (suite *OrganizationUnitHandlerTestSuite) TestOUHandler_HandleOUChildrenListRequest() {
 	testCases := []ouHandlerTestCase{
 		{
@@ -2130,3 +2193,78 @@ func (suite *OrganizationUnitHandlerTestSuite) TestOUHandler_handleErrorStatusMa
 		})
 	}
 }
+
+func (suite *OrganizationUnitHandlerTestSuite) TestOUHandler_HandleOUPatchRequest() {
+	parent := "parent-ou"
+	current := providers.OrganizationUnit{
+		ID: defaultOURequestID, Handle: "finance", Name: "Finance", Description: "old", Parent: &parent,
+	}
+	testCases := []ouHandlerTestCase{
+		{
+			name:           "merges patch into current organization unit",
+			method:         http.MethodPatch,
+			url:            "/organization-units/" + defaultOURequestID,
+			body:           `{"description":"updated"}`,
+			setJSONHeader:  true,
+			pathParamKey:   "id",
+			pathParamValue: defaultOURequestID,
+			setup: func(serviceMock *OrganizationUnitServiceInterfaceMock) {
+				serviceMock.On("GetOrganizationUnit", mock.Anything, defaultOURequestID).Return(current, nil).Once()
+				serviceMock.
+					On("UpdateOrganizationUnit", mock.Anything, defaultOURequestID,
+						mock.MatchedBy(func(req providers.OrganizationUnitRequestWithID) bool {
+							return req.Handle == "finance" && req.Name == "Finance" &&
+								req.Description == "updated" && req.Parent != nil && *req.Parent == parent
+						})).
+					Return(providers.OrganizationUnit{ID: defaultOURequestID}, nil).
+					Once()
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				suite.Equal(http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:           "organization unit not found",
+			method:         http.MethodPatch,
+			url:            "/organization-units/" + defaultOURequestID,
+			body:           `{"description":"updated"}`,
+			setJSONHeader:  true,
+			pathParamKey:   "id",
+			pathParamValue: defaultOURequestID,
+			setup: func(serviceMock *OrganizationUnitServiceInterfaceMock) {
+				serviceMock.On("GetOrganizationUnit", mock.Anything, defaultOURequestID).
+					Return(providers.OrganizationUnit{}, &ErrorOrganizationUnitNotFound).Once()
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				suite.Equal(http.StatusNotFound, recorder.Code)
+			},
+			assertService: func(serviceMock *OrganizationUnitServiceInterfaceMock) {
+				serviceMock.AssertNotCalled(suite.T(), "UpdateOrganizationUnit", mock.Anything, mock.Anything,
+					mock.Anything)
+			},
+		},
+		{
+			name:           "invalid patch document",
+			method:         http.MethodPatch,
+			url:            "/organization-units/" + defaultOURequestID,
+			body:           "{invalid",
+			setJSONHeader:  true,
+			pathParamKey:   "id",
+			pathParamValue: defaultOURequestID,
+			setup: func(serviceMock *OrganizationUnitServiceInterfaceMock) {
+				serviceMock.On("GetOrganizationUnit", mock.Anything, defaultOURequestID).Return(current, nil).Once()
+			},
+			assert: func(recorder *httptest.ResponseRecorder) {
+				suite.Equal(http.StatusBadRequest, recorder.Code)
+				var resp apierror.ErrorResponse
+				suite.NoError(json.Unmarshal(recorder.Body.Bytes(), &resp))
+				suite.Equal(ErrorInvalidRequestFormat.Code, resp.Code)
+			},
+		},
+	}
+
+	suite.runHandlerTestCases(testCases,
+		func(handler *organizationUnitHandler, writer http.ResponseWriter, req *http.Request) {
+			handler.HandleOUPatchRequest(writer, req)
+		})
+}
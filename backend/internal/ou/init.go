@@ -26,6 +26,7 @@ import (
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/thunder-id/thunderid/internal/job"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
@@ -42,13 +43,14 @@ func Initialize(
 	mcpServer *mcp.Server,
 	cacheManager cache.CacheManagerInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	jobService job.JobServiceInterface,
 ) (ConfigurableOUService, sysauthz.OUHierarchyResolver, declarativeresource.ResourceExporter, error) {
 	ouStore, transactioner, err := initializeStore(cacheManager)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	ouService := newOrganizationUnitService(authzService, ouStore, transactioner)
+	ouService := newOrganizationUnitService(authzService, ouStore, transactioner, jobService)
 
 	ouHandler := newOrganizationUnitHandler(ouService)
 	registerRoutes(mux, ouHandler)
@@ -158,7 +160,7 @@ func registerRoutes(mux *http.ServeMux, ouHandler *organizationUnitHandler) {
 		}, corsOptions1))
 
 	corsOptions2 := middleware.CORSOptions{
-		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedMethods:   []string{"GET", "PUT", "PATCH", "DELETE"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
 		AllowCredentials: true,
 		MaxAge:           600,
@@ -194,6 +196,8 @@ func registerRoutes(mux *http.ServeMux, ouHandler *organizationUnitHandler) {
 		}, corsOptions2))
 	mux.HandleFunc(middleware.WithCORS("PUT /organization-units/{id}",
 		ouHandler.HandleOUPutRequest, corsOptions2))
+	mux.HandleFunc(middleware.WithCORS("PATCH /organization-units/{id}",
+		ouHandler.HandleOUPatchRequest, corsOptions2))
 	mux.HandleFunc(middleware.WithCORS("DELETE /organization-units/{id}",
 		ouHandler.HandleOUDeleteRequest, corsOptions2))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /organization-units/{id}",
@@ -201,7 +205,18 @@ func registerRoutes(mux *http.ServeMux, ouHandler *organizationUnitHandler) {
 			w.WriteHeader(http.StatusNoContent)
 		}, corsOptions2))
 
-	mux.HandleFunc(middleware.WithCORS("GET /organization-units/tree/{path...}",
+	// Hierarchical handle paths are addressable under both /tree and /handle; the latter mirrors
+	// the handle references accepted in user and group payloads.
+	for _, prefix := range []string{"/organization-units/tree/", "/organization-units/handle/"} {
+		registerPathRoutes(mux, ouHandler, prefix, corsOptions2)
+	}
+}
+
+// registerPathRoutes registers the hierarchical handle path routes under the given prefix.
+func registerPathRoutes(
+	mux *http.ServeMux, ouHandler *organizationUnitHandler, prefix string, corsOptions middleware.CORSOptions,
+) {
+	mux.HandleFunc(middleware.WithCORS("GET "+prefix+"{path...}",
 		func(w http.ResponseWriter, r *http.Request) {
 			pathValue := r.PathValue("path")
 			handlers := map[string]func(http.ResponseWriter, *http.Request){
@@ -220,16 +235,16 @@ func registerRoutes(mux *http.ServeMux, ouHandler *organizationUnitHandler) {
 				}
 			}
 
-			newPath := "/organization-units/tree/" + pathValue
+			newPath := prefix + pathValue
 			r.URL.Path = newPath
 			ouHandler.HandleOUGetByPathRequest(w, r)
-		}, corsOptions2))
-	mux.HandleFunc(middleware.WithCORS("PUT /organization-units/tree/{path...}",
-		ouHandler.HandleOUPutByPathRequest, corsOptions2))
-	mux.HandleFunc(middleware.WithCORS("DELETE /organization-units/tree/{path...}",
-		ouHandler.HandleOUDeleteByPathRequest, corsOptions2))
-	mux.HandleFunc(middleware.WithCORS("OPTIONS /organization-units/tree/{path...}",
+		}, corsOptions))
+	mux.HandleFunc(middleware.WithCORS("PUT "+prefix+"{path...}",
+		ouHandler.HandleOUPutByPathRequest, corsOptions))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+prefix+"{path...}",
+		ouHandler.HandleOUDeleteByPathRequest, corsOptions))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+prefix+"{path...}",
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
-		}, corsOptions2))
+		}, corsOptions))
 }
@@ -29,6 +29,7 @@ import (
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
+	"github.com/thunder-id/thunderid/internal/job"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
@@ -40,6 +41,13 @@ import (
 
 const loggerComponentNameService = "OrganizationUnitService"
 
+const (
+	// jobTypeSubtreeDelete is the type of the jobs that delete an organization unit subtree.
+	jobTypeSubtreeDelete = "OU_SUBTREE_DELETE"
+	// subtreePageSize is the number of child organization units read per page while listing a subtree.
+	subtreePageSize = 100
+)
+
 // OrganizationUnitServiceInterface defines the interface for organization unit service operations.
 type OrganizationUnitServiceInterface interface {
 	GetOrganizationUnitList(
@@ -64,6 +72,7 @@ type OrganizationUnitServiceInterface interface {
 	) (providers.OrganizationUnit, *tidcommon.ServiceError)
 	DeleteOrganizationUnit(ctx context.Context, id string) *tidcommon.ServiceError
 	DeleteOrganizationUnitByPath(ctx context.Context, handlePath string) *tidcommon.ServiceError
+	DeleteOrganizationUnitSubtree(ctx context.Context, id string) (*job.Job, *tidcommon.ServiceError)
 	GetOrganizationUnitChildren(
 		ctx context.Context, id string, limit, offset int, f *tidcommon.FilterGroup,
 	) (*providers.OrganizationUnitListResponse, *tidcommon.ServiceError)
@@ -115,6 +124,7 @@ type organizationUnitService struct {
 	groupResolver      OUGroupResolver
 	roleResolver       OURoleResolver
 	dependencyRegistry resourcedependency.Registry
+	jobService         job.JobServiceInterface
 }
 
 func (ous *organizationUnitService) SetOUUserResolver(resolver OUUserResolver) {
@@ -140,11 +150,13 @@ func newOrganizationUnitService(
 	authzService sysauthz.SystemAuthorizationServiceInterface,
 	ouStore organizationUnitStoreInterface,
 	transactioner transaction.Transactioner,
+	jobService job.JobServiceInterface,
 ) ConfigurableOUService {
 	return &organizationUnitService{
 		authzService:  authzService,
 		ouStore:       ouStore,
 		transactioner: transactioner,
+		jobService:    jobService,
 	}
 }
 
@@ -811,6 +823,74 @@ func (ous *organizationUnitService) DeleteOrganizationUnitByPath(
 	return nil
 }
 
+// DeleteOrganizationUnitSubtree submits a job that deletes an organization unit together with all of its
+// descendant organization units, deepest first. Users and groups are not deleted, so an organization
+// unit that still has them is reported as a failed item and its ancestors fail as they keep a child.
+func (ous *organizationUnitService) DeleteOrganizationUnitSubtree(
+	ctx context.Context, id string,
+) (*job.Job, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentNameService))
+	logger.Debug(ctx, "Deleting organization unit subtree", log.String("ouID", id))
+
+	if svcErr := ous.checkOUAccess(ctx, security.ActionDeleteOU, id); svcErr != nil {
+		return nil, svcErr
+	}
+
+	exists, err := ous.ouStore.IsOrganizationUnitExists(ctx, id)
+	if err != nil {
+		logger.Error(ctx, "Failed to check organization unit existence", log.Error(err), log.String("ouID", id))
+		return nil, &tidcommon.InternalServerError
+	}
+	if !exists {
+		return nil, &ErrorOrganizationUnitNotFound
+	}
+	if ous.ouStore.IsOrganizationUnitDeclarative(ctx, id) {
+		return nil, &ErrorCannotModifyDeclarativeResource
+	}
+
+	return ous.jobService.Submit(ctx, jobTypeSubtreeDelete, 0,
+		func(ctx context.Context, tracker job.Tracker) *tidcommon.ServiceError {
+			ids, err := ous.getSubtreeIDs(ctx, id)
+			if err != nil {
+				logger.Error(ctx, "Failed to list organization unit subtree", log.Error(err), log.String("ouID", id))
+				return &tidcommon.InternalServerError
+			}
+			tracker.SetTotal(len(ids))
+
+			// Every organization unit is listed after its parent, so deleting in reverse removes the
+			// children first.
+			for i := len(ids) - 1; i >= 0 && ctx.Err() == nil; i-- {
+				if svcErr := ous.DeleteOrganizationUnit(ctx, ids[i]); svcErr != nil {
+					tracker.Failed(ids[i], svcErr)
+					continue
+				}
+				tracker.Succeeded()
+			}
+			return nil
+		})
+}
+
+// getSubtreeIDs returns the ID of the organization unit followed by the IDs of its descendants in
+// breadth-first order.
+func (ous *organizationUnitService) getSubtreeIDs(ctx context.Context, id string) ([]string, error) {
+	ids := []string{id}
+	for next := 0; next < len(ids); next++ {
+		for offset := 0; ; offset += subtreePageSize {
+			children, err := ous.ouStore.GetOrganizationUnitChildrenList(ctx, ids[next], subtreePageSize, offset, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range children {
+				ids = append(ids, child.ID)
+			}
+			if len(children) < subtreePageSize {
+				break
+			}
+		}
+	}
+	return ids, nil
+}
+
 // deleteOUInternal deletes an organization unit by ID after checking if it has child resources.
 func (ous *organizationUnitService) deleteOUInternal(
 	ctx context.Context, id string, logger *log.Logger,
@@ -31,11 +31,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/job"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/tests/mocks/jobmock"
 	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
 )
 
@@ -1421,6 +1423,117 @@ func (suite *OrganizationUnitServiceTestSuite) TestOUService_DeleteOrganizationU
 	}
 }
 
+// recordingTracker is a job.Tracker that keeps the reported outcomes in memory.
+type recordingTracker struct {
+	total     int
+	succeeded int
+	failed    []string
+}
+
+func (t *recordingTracker) SetTotal(total int) { t.total = total }
+
+func (t *recordingTracker) Succeeded() { t.succeeded++ }
+
+func (t *recordingTracker) Failed(item string, _ *tidcommon.ServiceError) {
+	t.failed = append(t.failed, item)
+}
+
+// submitAndRun expects a subtree delete job to be submitted and runs its task with a recording tracker.
+func (suite *OrganizationUnitServiceTestSuite) submitAndRun(
+	service *organizationUnitService,
+) (*recordingTracker, *tidcommon.ServiceError) {
+	jobService := jobmock.NewJobServiceInterfaceMock(suite.T())
+	service.jobService = jobService
+
+	var task job.Task
+	jobService.On("Submit", mock.Anything, jobTypeSubtreeDelete, 0, mock.Anything).
+		Run(func(args mock.Arguments) { task = args.Get(3).(job.Task) }).
+		Return(&job.Job{ID: "job-1", Status: job.StatusPending}, nil).Once()
+
+	submitted, svcErr := service.DeleteOrganizationUnitSubtree(context.Background(), "root")
+	suite.Require().Nil(svcErr)
+	suite.Require().Equal("job-1", submitted.ID)
+
+	tracker := &recordingTracker{}
+	return tracker, task(context.Background(), tracker)
+}
+
+func (suite *OrganizationUnitServiceTestSuite) TestOUService_DeleteOrganizationUnitSubtree() {
+	suite.Run("deletes descendants before their parents", func() {
+		store := newOrganizationUnitStoreInterfaceMock(suite.T())
+		store.On("IsOrganizationUnitExists", mock.Anything, mock.Anything).Return(true, nil)
+		store.On("IsOrganizationUnitDeclarative", mock.Anything, mock.Anything).Return(false)
+		store.On("GetOrganizationUnitChildrenList", mock.Anything, "root", subtreePageSize, 0, mock.Anything).
+			Return([]providers.OrganizationUnitBasic{{ID: "a"}, {ID: "b"}}, nil).Once()
+		store.On("GetOrganizationUnitChildrenList", mock.Anything, "a", subtreePageSize, 0, mock.Anything).
+			Return([]providers.OrganizationUnitBasic{{ID: "c"}}, nil).Once()
+		for _, id := range []string{"b", "c"} {
+			store.On("GetOrganizationUnitChildrenList", mock.Anything, id, subtreePageSize, 0, mock.Anything).
+				Return([]providers.OrganizationUnitBasic{}, nil).Once()
+		}
+
+		var deleted []string
+		store.On("DeleteOrganizationUnit", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { deleted = append(deleted, args.String(1)) }).
+			Return(func(_ context.Context, id string) error {
+				if id == "b" {
+					return errors.New("boom")
+				}
+				return nil
+			})
+
+		service := suite.newService(store, newAllowAllAuthz(suite.T()))
+		service.SetDependencyRegistry(&stubDependencyRegistry{resp: emptyDeps()})
+
+		tracker, taskErr := suite.submitAndRun(service)
+		suite.Require().Nil(taskErr)
+		suite.Require().Equal([]string{"c", "b", "a", "root"}, deleted)
+		suite.Require().Equal(4, tracker.total)
+		suite.Require().Equal(3, tracker.succeeded)
+		suite.Require().Equal([]string{"b"}, tracker.failed)
+	})
+
+	suite.Run("listing the subtree fails", func() {
+		store := newOrganizationUnitStoreInterfaceMock(suite.T())
+		store.On("IsOrganizationUnitExists", mock.Anything, "root").Return(true, nil).Once()
+		store.On("IsOrganizationUnitDeclarative", mock.Anything, "root").Return(false).Once()
+		store.On("GetOrganizationUnitChildrenList", mock.Anything, "root", subtreePageSize, 0, mock.Anything).
+			Return([]providers.OrganizationUnitBasic(nil), errors.New("boom")).Once()
+
+		tracker, taskErr := suite.submitAndRun(suite.newService(store, newAllowAllAuthz(suite.T())))
+		suite.Require().Equal(&tidcommon.InternalServerError, taskErr)
+		suite.Require().Zero(tracker.total)
+	})
+
+	suite.Run("not found", func() {
+		store := newOrganizationUnitStoreInterfaceMock(suite.T())
+		store.On("IsOrganizationUnitExists", mock.Anything, "root").Return(false, nil).Once()
+
+		service := suite.newService(store, newAllowAllAuthz(suite.T()))
+		_, svcErr := service.DeleteOrganizationUnitSubtree(context.Background(), "root")
+		suite.Require().Equal(&ErrorOrganizationUnitNotFound, svcErr)
+	})
+
+	suite.Run("declarative organization unit", func() {
+		store := newOrganizationUnitStoreInterfaceMock(suite.T())
+		store.On("IsOrganizationUnitExists", mock.Anything, "root").Return(true, nil).Once()
+		store.On("IsOrganizationUnitDeclarative", mock.Anything, "root").Return(true).Once()
+
+		service := suite.newService(store, newAllowAllAuthz(suite.T()))
+		_, svcErr := service.DeleteOrganizationUnitSubtree(context.Background(), "root")
+		suite.Require().Equal(&ErrorCannotModifyDeclarativeResource, svcErr)
+	})
+
+	suite.Run("unauthorized", func() {
+		authz := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+		authz.On("IsActionAllowed", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+
+		service := suite.newService(newOrganizationUnitStoreInterfaceMock(suite.T()), authz)
+		_, svcErr := service.DeleteOrganizationUnitSubtree(context.Background(), "root")
+		suite.Require().Equal(&tidcommon.ErrorUnauthorized, svcErr)
+	})
+}
+
 func (suite *OrganizationUnitServiceTestSuite) TestOUService_GetResourceDependencies() {
 	suite.Run("reports child organization units as restrict", func() {
 		store := newOrganizationUnitStoreInterfaceMock(suite.T())
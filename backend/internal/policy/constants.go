@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import "regexp"
+
+const (
+	// loggerComponentName is the component name used for logging in the policy package.
+	loggerComponentName = "PolicyService"
+
+	// maxPolicyVersionLength is the maximum length of a policy version label.
+	maxPolicyVersionLength = 50
+	// maxPolicyTitleLength is the maximum length of a policy title.
+	maxPolicyTitleLength = 255
+	// maxPolicyURLLength is the maximum length of a policy document URL.
+	maxPolicyURLLength = 2048
+	// maxPolicyHandleLength is the maximum length of a policy handle.
+	maxPolicyHandleLength = 100
+	// maxPublishRequestBodyBytes caps the publish request body; policy metadata is small.
+	maxPublishRequestBodyBytes = 64 << 10 // 64 KiB
+)
+
+// policyHandleRegex validates policy handles: lowercase alphanumerics, underscores and hyphens,
+// starting and ending with an alphanumeric character.
+var policyHandleRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*[a-z0-9]$|^[a-z0-9]$`)
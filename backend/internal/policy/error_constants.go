@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for policy operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "POL-1001",
+		Error: common.I18nMessage{
+			Key:          "error.policyservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.policyservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidPolicyHandle is the error returned when the policy handle is missing or malformed.
+	ErrorInvalidPolicyHandle = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "POL-1002",
+		Error: common.I18nMessage{
+			Key:          "error.policyservice.invalid_policy_handle",
+			DefaultValue: "Invalid policy handle",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.policyservice.invalid_policy_handle_description",
+			DefaultValue: "The policy handle must contain only lowercase letters, digits, underscores and hyphens",
+		},
+	}
+
+	// ErrorInvalidPolicyVersion is the error returned when the policy version is missing or too long.
+	ErrorInvalidPolicyVersion = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "POL-1003",
+		Error: common.I18nMessage{
+			Key:          "error.policyservice.invalid_policy_version",
+			DefaultValue: "Invalid policy version",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.policyservice.invalid_policy_version_description",
+			DefaultValue: "The policy version is required and must not exceed 50 characters",
+		},
+	}
+
+	// ErrorInvalidPolicyTitle is the error returned when the policy title is missing or too long.
+	ErrorInvalidPolicyTitle = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "POL-1004",
+		Error: common.I18nMessage{
+			Key:          "error.policyservice.invalid_policy_title",
+			DefaultValue: "Invalid policy title",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.policyservice.invalid_policy_title_description",
+			DefaultValue: "The policy title is required and must not exceed 255 characters",
+		},
+	}
+
+	// ErrorInvalidPolicyURL is the error returned when the policy document URL is not a valid http or https URL.
+	ErrorInvalidPolicyURL = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "POL-1005",
+		Error: common.I18nMessage{
+			Key:          "error.policyservice.invalid_policy_url",
+			DefaultValue: "Invalid policy URL",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.policyservice.invalid_policy_url_description",
+			DefaultValue: "The policy URL must be an absolute http or https URL",
+		},
+	}
+
+	// ErrorPolicyNotFound is the error returned when no version of the policy has been published.
+	ErrorPolicyNotFound = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "POL-1006",
+		Error: common.I18nMessage{
+			Key:          "error.policyservice.policy_not_found",
+			DefaultValue: "Policy not found",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.policyservice.policy_not_found_description",
+			DefaultValue: "No version of the requested policy has been published",
+		},
+	}
+
+	// ErrorPolicyVersionAlreadyExists is the error returned when the policy version has already been published.
+	ErrorPolicyVersionAlreadyExists = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "POL-1007",
+		Error: common.I18nMessage{
+			Key:          "error.policyservice.policy_version_already_exists",
+			DefaultValue: "Policy version already exists",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.policyservice.policy_version_already_exists_description",
+			DefaultValue: "The requested version of the policy has already been published",
+		},
+	}
+)
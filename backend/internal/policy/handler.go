@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// policyHandler is the handler for policy management operations.
+type policyHandler struct {
+	policyService PolicyServiceInterface
+}
+
+// newPolicyHandler creates a new instance of policyHandler.
+func newPolicyHandler(policyService PolicyServiceInterface) *policyHandler {
+	return &policyHandler{policyService: policyService}
+}
+
+// HandleListPolicies handles GET /policies, returning the current version of every policy.
+func (h *policyHandler) HandleListPolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	policies, svcErr := h.policyService.ListCurrentPolicies(ctx)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK,
+		PolicyVersionListResponse{TotalResults: len(policies), Policies: policies})
+}
+
+// HandleListPolicyVersions handles GET /policies/{handle}/versions, returning every published
+// version of a policy, latest first.
+func (h *policyHandler) HandleListPolicyVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	versions, svcErr := h.policyService.ListPolicyVersions(ctx, r.PathValue("handle"))
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK,
+		PolicyVersionListResponse{TotalResults: len(versions), Policies: versions})
+}
+
+// HandlePublishPolicyVersion handles POST /policies/{handle}/versions, publishing a new current
+// version of a policy.
+func (h *policyHandler) HandlePublishPolicyVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxPublishRequestBodyBytes)
+	var request PublishPolicyVersionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handleError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	policyVersion, svcErr := h.policyService.PublishPolicyVersion(ctx, r.PathValue("handle"), request)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, policyVersion)
+}
+
+// handleError maps a service error to an HTTP error response.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *common.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == common.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorPolicyNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorPolicyVersionAlreadyExists.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *PolicyServiceInterfaceMock
+	handler     *policyHandler
+	mux         *http.ServeMux
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.mockService = NewPolicyServiceInterfaceMock(suite.T())
+	suite.handler = newPolicyHandler(suite.mockService)
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, suite.handler)
+}
+
+func (suite *HandlerTestSuite) decodeErrorCode(body []byte) string {
+	var errResp struct {
+		Code string `json:"code"`
+	}
+	suite.Require().NoError(json.Unmarshal(body, &errResp))
+	return errResp.Code
+}
+
+func (suite *HandlerTestSuite) TestHandleListPolicies_OK() {
+	suite.mockService.EXPECT().ListCurrentPolicies(mock.Anything).
+		Return([]PolicyVersion{{Handle: "tos", Version: "2.0"}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/policies", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var resp PolicyVersionListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), 1, resp.TotalResults)
+	assert.Equal(suite.T(), "2.0", resp.Policies[0].Version)
+}
+
+func (suite *HandlerTestSuite) TestHandleListPolicies_ServiceError() {
+	suite.mockService.EXPECT().ListCurrentPolicies(mock.Anything).Return(nil, &common.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodGet, "/policies", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleListPolicyVersions_NotFound() {
+	suite.mockService.EXPECT().ListPolicyVersions(mock.Anything, "tos").Return(nil, &ErrorPolicyNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/policies/tos/versions", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Equal(suite.T(), ErrorPolicyNotFound.Code, suite.decodeErrorCode(w.Body.Bytes()))
+}
+
+func (suite *HandlerTestSuite) TestHandlePublishPolicyVersion_Created() {
+	request := PublishPolicyVersionRequest{Version: "2.0", Title: "Terms", URL: "https://example.com/tos"}
+	suite.mockService.EXPECT().PublishPolicyVersion(mock.Anything, "tos", request).
+		Return(&PolicyVersion{Handle: "tos", Version: "2.0"}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/policies/tos/versions",
+		strings.NewReader(`{"version":"2.0","title":"Terms","url":"https://example.com/tos"}`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandlePublishPolicyVersion_Errors() {
+	suite.Run("MalformedBody", func() {
+		req := httptest.NewRequest(http.MethodPost, "/policies/tos/versions", strings.NewReader(`{`))
+		w := httptest.NewRecorder()
+		suite.mux.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assert.Equal(suite.T(), ErrorInvalidRequestFormat.Code, suite.decodeErrorCode(w.Body.Bytes()))
+	})
+
+	suite.Run("DuplicateVersion", func() {
+		suite.mockService.EXPECT().PublishPolicyVersion(mock.Anything, "tos", mock.Anything).
+			Return(nil, &ErrorPolicyVersionAlreadyExists).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/policies/tos/versions", strings.NewReader(`{"version":"1"}`))
+		w := httptest.NewRecorder()
+		suite.mux.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusConflict, w.Code)
+	})
+
+	suite.Run("InvalidURL", func() {
+		suite.mockService.EXPECT().PublishPolicyVersion(mock.Anything, "tos", mock.Anything).
+			Return(nil, &ErrorInvalidPolicyURL).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/policies/tos/versions", strings.NewReader(`{"version":"1"}`))
+		w := httptest.NewRecorder()
+		suite.mux.ServeHTTP(w, req)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize wires the policy store, service, and management routes.
+func Initialize(mux *http.ServeMux) PolicyServiceInterface {
+	service := newPolicyService(newPolicyStore())
+	registerRoutes(mux, newPolicyHandler(service))
+	return service
+}
+
+// registerRoutes registers the routes for policy management operations.
+func registerRoutes(mux *http.ServeMux, handler *policyHandler) {
+	noContent := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /policies", handler.HandleListPolicies, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /policies", noContent, listOpts))
+
+	versionOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /policies/{handle}/versions",
+		handler.HandleListPolicyVersions, versionOpts))
+	mux.HandleFunc(middleware.WithCORS("POST /policies/{handle}/versions",
+		handler.HandlePublishPolicyVersion, versionOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /policies/{handle}/versions", noContent, versionOpts))
+}
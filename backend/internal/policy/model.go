@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import "time"
+
+// PolicyVersion represents a published version of a policy document such as terms of service.
+type PolicyVersion struct {
+	ID          string    `json:"id"`
+	Handle      string    `json:"handle"`
+	Version     string    `json:"version"`
+	Title       string    `json:"title"`
+	URL         string    `json:"url"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// PublishPolicyVersionRequest represents the request body for publishing a new policy version.
+type PublishPolicyVersionRequest struct {
+	Version string `json:"version"`
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+}
+
+// PolicyVersionListResponse represents the response body for policy version listings.
+type PolicyVersionListResponse struct {
+	TotalResults int             `json:"totalResults"`
+	Policies     []PolicyVersion `json:"policies"`
+}
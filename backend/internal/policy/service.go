@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// PolicyServiceInterface defines the operations for managing versioned policy documents and
+// tracking their acceptance.
+type PolicyServiceInterface interface {
+	ListCurrentPolicies(ctx context.Context) ([]PolicyVersion, *common.ServiceError)
+	ListPolicyVersions(ctx context.Context, handle string) ([]PolicyVersion, *common.ServiceError)
+	PublishPolicyVersion(ctx context.Context, handle string,
+		request PublishPolicyVersionRequest) (*PolicyVersion, *common.ServiceError)
+	GetPendingPolicies(ctx context.Context, entityID string, handles []string) (
+		[]PolicyVersion, *common.ServiceError)
+	RecordPolicyAcceptance(ctx context.Context, entityID string, policies []PolicyVersion) *common.ServiceError
+}
+
+// policyService is the default implementation of PolicyServiceInterface.
+type policyService struct {
+	store  policyStoreInterface
+	logger *log.Logger
+}
+
+// newPolicyService creates a new instance of policyService.
+func newPolicyService(store policyStoreInterface) PolicyServiceInterface {
+	return &policyService{
+		store:  store,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ListCurrentPolicies returns the current (latest published) version of every policy.
+func (s *policyService) ListCurrentPolicies(ctx context.Context) ([]PolicyVersion, *common.ServiceError) {
+	versions, err := s.store.ListPolicyVersions(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list policy versions", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	// Versions are grouped by handle with the latest first, so the first row of each handle is current.
+	current := make([]PolicyVersion, 0)
+	for _, version := range versions {
+		if len(current) == 0 || current[len(current)-1].Handle != version.Handle {
+			current = append(current, version)
+		}
+	}
+	return current, nil
+}
+
+// ListPolicyVersions returns every published version of a policy, latest first.
+func (s *policyService) ListPolicyVersions(
+	ctx context.Context, handle string) ([]PolicyVersion, *common.ServiceError) {
+	if !isValidPolicyHandle(handle) {
+		return nil, &ErrorInvalidPolicyHandle
+	}
+
+	versions, err := s.store.ListPolicyVersionsByHandle(ctx, handle)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list policy versions", log.String("handle", handle), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	if len(versions) == 0 {
+		return nil, &ErrorPolicyNotFound
+	}
+	return versions, nil
+}
+
+// PublishPolicyVersion publishes a new version of a policy. The new version becomes the current
+// version, so users who accepted an earlier version are prompted to accept it again.
+func (s *policyService) PublishPolicyVersion(ctx context.Context, handle string,
+	request PublishPolicyVersionRequest) (*PolicyVersion, *common.ServiceError) {
+	request.Version = strings.TrimSpace(request.Version)
+	request.Title = strings.TrimSpace(request.Title)
+	request.URL = strings.TrimSpace(request.URL)
+	if svcErr := validatePublishRequest(handle, request); svcErr != nil {
+		return nil, svcErr
+	}
+
+	existing, err := s.store.ListPolicyVersionsByHandle(ctx, handle)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list policy versions", log.String("handle", handle), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	if slices.ContainsFunc(existing, func(v PolicyVersion) bool { return v.Version == request.Version }) {
+		return nil, &ErrorPolicyVersionAlreadyExists
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate policy version ID", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	policyVersion := PolicyVersion{
+		ID:          id,
+		Handle:      handle,
+		Version:     request.Version,
+		Title:       request.Title,
+		URL:         request.URL,
+		PublishedAt: time.Now().UTC(),
+	}
+	if err := s.store.CreatePolicyVersion(ctx, policyVersion); err != nil {
+		s.logger.Error(ctx, "Failed to create policy version", log.String("handle", handle), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Published policy version", log.String("handle", handle),
+		log.String("version", policyVersion.Version))
+	return &policyVersion, nil
+}
+
+// GetPendingPolicies returns the current versions of the given policies that the entity has not
+// accepted. Policies without a published version are skipped.
+func (s *policyService) GetPendingPolicies(ctx context.Context, entityID string, handles []string) (
+	[]PolicyVersion, *common.ServiceError) {
+	pending := make([]PolicyVersion, 0)
+	seen := make(map[string]bool, len(handles))
+	for _, handle := range handles {
+		if seen[handle] {
+			continue
+		}
+		seen[handle] = true
+
+		versions, err := s.store.ListPolicyVersionsByHandle(ctx, handle)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to list policy versions", log.String("handle", handle), log.Error(err))
+			return nil, &common.InternalServerError
+		}
+		if len(versions) == 0 {
+			s.logger.Debug(ctx, "No published version for policy; skipping", log.String("handle", handle))
+			continue
+		}
+
+		current := versions[0]
+		accepted, err := s.store.IsPolicyVersionAccepted(ctx, entityID, handle, current.Version)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to check policy acceptance", log.String("handle", handle),
+				log.Error(err))
+			return nil, &common.InternalServerError
+		}
+		if !accepted {
+			pending = append(pending, current)
+		}
+	}
+	return pending, nil
+}
+
+// RecordPolicyAcceptance records that the entity accepted the given policy versions.
+func (s *policyService) RecordPolicyAcceptance(
+	ctx context.Context, entityID string, policies []PolicyVersion) *common.ServiceError {
+	acceptedAt := time.Now().UTC()
+	for _, policy := range policies {
+		if err := s.store.CreatePolicyAcceptance(ctx, entityID, policy.Handle, policy.Version,
+			acceptedAt); err != nil {
+			s.logger.Error(ctx, "Failed to record policy acceptance", log.String("handle", policy.Handle),
+				log.Error(err))
+			return &common.InternalServerError
+		}
+	}
+	return nil
+}
+
+// validatePublishRequest validates the handle and body of a publish request.
+func validatePublishRequest(handle string, request PublishPolicyVersionRequest) *common.ServiceError {
+	if !isValidPolicyHandle(handle) {
+		return &ErrorInvalidPolicyHandle
+	}
+	if request.Version == "" || len(request.Version) > maxPolicyVersionLength {
+		return &ErrorInvalidPolicyVersion
+	}
+	if request.Title == "" || len(request.Title) > maxPolicyTitleLength {
+		return &ErrorInvalidPolicyTitle
+	}
+	if len(request.URL) > maxPolicyURLLength {
+		return &ErrorInvalidPolicyURL
+	}
+	parsedURL, err := url.Parse(request.URL)
+	if err != nil || (parsedURL.Scheme != "https" && parsedURL.Scheme != "http") || parsedURL.Host == "" {
+		return &ErrorInvalidPolicyURL
+	}
+	return nil
+}
+
+// isValidPolicyHandle reports whether the handle is well-formed.
+func isValidPolicyHandle(handle string) bool {
+	return len(handle) <= maxPolicyHandleLength && policyHandleRegex.MatchString(handle)
+}
@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const testEntityID = "entity-1"
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	mockStore *policyStoreInterfaceMock
+	service   PolicyServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStore = newPolicyStoreInterfaceMock(suite.T())
+	suite.service = newPolicyService(suite.mockStore)
+}
+
+func testPolicyVersion(handle, version string) PolicyVersion {
+	return PolicyVersion{ID: handle + "-" + version, Handle: handle, Version: version, Title: "Terms",
+		URL: "https://example.com/" + handle, PublishedAt: time.Now().UTC()}
+}
+
+// --- ListCurrentPolicies ---
+
+func (suite *ServiceTestSuite) TestListCurrentPolicies_ReturnsLatestPerHandle() {
+	suite.mockStore.On("ListPolicyVersions", mock.Anything).Return([]PolicyVersion{
+		testPolicyVersion("privacy", "3"), testPolicyVersion("privacy", "2"),
+		testPolicyVersion("tos", "2.0"), testPolicyVersion("tos", "1.0"),
+	}, nil)
+
+	policies, svcErr := suite.service.ListCurrentPolicies(suite.ctx)
+
+	suite.Nil(svcErr)
+	suite.Len(policies, 2)
+	suite.Equal("3", policies[0].Version)
+	suite.Equal("2.0", policies[1].Version)
+}
+
+func (suite *ServiceTestSuite) TestListCurrentPolicies_StoreError() {
+	suite.mockStore.On("ListPolicyVersions", mock.Anything).Return(nil, errors.New("db error"))
+
+	_, svcErr := suite.service.ListCurrentPolicies(suite.ctx)
+
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
+
+// --- ListPolicyVersions ---
+
+func (suite *ServiceTestSuite) TestListPolicyVersions() {
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "tos").
+		Return([]PolicyVersion{testPolicyVersion("tos", "2.0")}, nil)
+
+	versions, svcErr := suite.service.ListPolicyVersions(suite.ctx, "tos")
+
+	suite.Nil(svcErr)
+	suite.Len(versions, 1)
+}
+
+func (suite *ServiceTestSuite) TestListPolicyVersions_Errors() {
+	_, svcErr := suite.service.ListPolicyVersions(suite.ctx, "Bad Handle")
+	suite.Equal(ErrorInvalidPolicyHandle.Code, svcErr.Code)
+
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "missing").Return([]PolicyVersion{}, nil)
+	_, svcErr = suite.service.ListPolicyVersions(suite.ctx, "missing")
+	suite.Equal(ErrorPolicyNotFound.Code, svcErr.Code)
+}
+
+// --- PublishPolicyVersion ---
+
+func (suite *ServiceTestSuite) TestPublishPolicyVersion() {
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "tos").
+		Return([]PolicyVersion{testPolicyVersion("tos", "1.0")}, nil)
+	suite.mockStore.On("CreatePolicyVersion", mock.Anything, mock.MatchedBy(func(v PolicyVersion) bool {
+		return v.ID != "" && v.Handle == "tos" && v.Version == "2.0" && !v.PublishedAt.IsZero()
+	})).Return(nil)
+
+	published, svcErr := suite.service.PublishPolicyVersion(suite.ctx, "tos", PublishPolicyVersionRequest{
+		Version: " 2.0 ", Title: "Terms of Service", URL: "https://example.com/tos/v2",
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal("2.0", published.Version)
+	suite.Equal("Terms of Service", published.Title)
+}
+
+func (suite *ServiceTestSuite) TestPublishPolicyVersion_ValidationErrors() {
+	valid := PublishPolicyVersionRequest{Version: "1", Title: "Terms", URL: "https://example.com/tos"}
+	testCases := []struct {
+		name    string
+		handle  string
+		mutate  func(*PublishPolicyVersionRequest)
+		errCode string
+	}{
+		{"InvalidHandle", "-tos", func(*PublishPolicyVersionRequest) {}, ErrorInvalidPolicyHandle.Code},
+		{"MissingVersion", "tos", func(r *PublishPolicyVersionRequest) { r.Version = " " },
+			ErrorInvalidPolicyVersion.Code},
+		{"MissingTitle", "tos", func(r *PublishPolicyVersionRequest) { r.Title = "" }, ErrorInvalidPolicyTitle.Code},
+		{"RelativeURL", "tos", func(r *PublishPolicyVersionRequest) { r.URL = "/tos" }, ErrorInvalidPolicyURL.Code},
+		{"UnsupportedScheme", "tos", func(r *PublishPolicyVersionRequest) { r.URL = "javascript://x" },
+			ErrorInvalidPolicyURL.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			request := valid
+			tc.mutate(&request)
+
+			_, svcErr := suite.service.PublishPolicyVersion(suite.ctx, tc.handle, request)
+
+			suite.Equal(tc.errCode, svcErr.Code)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestPublishPolicyVersion_DuplicateVersion() {
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "tos").
+		Return([]PolicyVersion{testPolicyVersion("tos", "1.0")}, nil)
+
+	_, svcErr := suite.service.PublishPolicyVersion(suite.ctx, "tos", PublishPolicyVersionRequest{
+		Version: "1.0", Title: "Terms", URL: "https://example.com/tos",
+	})
+
+	suite.Equal(ErrorPolicyVersionAlreadyExists.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestPublishPolicyVersion_StoreError() {
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "tos").Return([]PolicyVersion{}, nil)
+	suite.mockStore.On("CreatePolicyVersion", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	_, svcErr := suite.service.PublishPolicyVersion(suite.ctx, "tos", PublishPolicyVersionRequest{
+		Version: "1.0", Title: "Terms", URL: "https://example.com/tos",
+	})
+
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
+
+// --- GetPendingPolicies ---
+
+func (suite *ServiceTestSuite) TestGetPendingPolicies() {
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "tos").
+		Return([]PolicyVersion{testPolicyVersion("tos", "2.0"), testPolicyVersion("tos", "1.0")}, nil).Once()
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "privacy").
+		Return([]PolicyVersion{testPolicyVersion("privacy", "1")}, nil).Once()
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "unpublished").
+		Return([]PolicyVersion{}, nil).Once()
+	suite.mockStore.On("IsPolicyVersionAccepted", mock.Anything, testEntityID, "tos", "2.0").Return(false, nil)
+	suite.mockStore.On("IsPolicyVersionAccepted", mock.Anything, testEntityID, "privacy", "1").Return(true, nil)
+
+	pending, svcErr := suite.service.GetPendingPolicies(suite.ctx, testEntityID,
+		[]string{"tos", "privacy", "unpublished", "tos"})
+
+	suite.Nil(svcErr)
+	suite.Len(pending, 1)
+	suite.Equal("tos", pending[0].Handle)
+	suite.Equal("2.0", pending[0].Version)
+}
+
+func (suite *ServiceTestSuite) TestGetPendingPolicies_StoreErrors() {
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "broken").Return(nil, errors.New("db error"))
+	_, svcErr := suite.service.GetPendingPolicies(suite.ctx, testEntityID, []string{"broken"})
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+
+	suite.mockStore.On("ListPolicyVersionsByHandle", mock.Anything, "tos").
+		Return([]PolicyVersion{testPolicyVersion("tos", "1")}, nil)
+	suite.mockStore.On("IsPolicyVersionAccepted", mock.Anything, testEntityID, "tos", "1").
+		Return(false, errors.New("db error"))
+	_, svcErr = suite.service.GetPendingPolicies(suite.ctx, testEntityID, []string{"tos"})
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
+
+// --- RecordPolicyAcceptance ---
+
+func (suite *ServiceTestSuite) TestRecordPolicyAcceptance() {
+	suite.mockStore.On("CreatePolicyAcceptance", mock.Anything, testEntityID, "tos", "2.0", mock.Anything).
+		Return(nil)
+	suite.mockStore.On("CreatePolicyAcceptance", mock.Anything, testEntityID, "privacy", "1", mock.Anything).
+		Return(nil)
+
+	svcErr := suite.service.RecordPolicyAcceptance(suite.ctx, testEntityID,
+		[]PolicyVersion{testPolicyVersion("tos", "2.0"), testPolicyVersion("privacy", "1")})
+
+	suite.Nil(svcErr)
+}
+
+func (suite *ServiceTestSuite) TestRecordPolicyAcceptance_StoreError() {
+	suite.mockStore.On("CreatePolicyAcceptance", mock.Anything, testEntityID, "tos", "2.0", mock.Anything).
+		Return(errors.New("db error"))
+
+	svcErr := suite.service.RecordPolicyAcceptance(suite.ctx, testEntityID,
+		[]PolicyVersion{testPolicyVersion("tos", "2.0")})
+
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// policyStoreInterface defines the persistence operations for policy versions and acceptances.
+// Policy versions live in the configuration database; acceptance records live in the user database.
+type policyStoreInterface interface {
+	ListPolicyVersions(ctx context.Context) ([]PolicyVersion, error)
+	ListPolicyVersionsByHandle(ctx context.Context, handle string) ([]PolicyVersion, error)
+	CreatePolicyVersion(ctx context.Context, policyVersion PolicyVersion) error
+	IsPolicyVersionAccepted(ctx context.Context, entityID, handle, version string) (bool, error)
+	CreatePolicyAcceptance(ctx context.Context, entityID, handle, version string, acceptedAt time.Time) error
+}
+
+// policyStore is the database-backed policy store.
+type policyStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPolicyStore creates a new instance of policyStore.
+func newPolicyStore() policyStoreInterface {
+	return &policyStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// ListPolicyVersions returns the versions of all policies, grouped by handle with the latest version first.
+func (s *policyStore) ListPolicyVersions(ctx context.Context) ([]PolicyVersion, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListPolicyVersions, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy versions: %w", err)
+	}
+	return buildPolicyVersionsFromResults(results)
+}
+
+// ListPolicyVersionsByHandle returns the versions of a policy, latest first.
+func (s *policyStore) ListPolicyVersionsByHandle(ctx context.Context, handle string) ([]PolicyVersion, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListPolicyVersionsByHandle, handle, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy versions: %w", err)
+	}
+	return buildPolicyVersionsFromResults(results)
+}
+
+// CreatePolicyVersion persists a new policy version.
+func (s *policyStore) CreatePolicyVersion(ctx context.Context, policyVersion PolicyVersion) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreatePolicyVersion, policyVersion.ID, policyVersion.Handle,
+		policyVersion.Version, policyVersion.Title, policyVersion.URL, policyVersion.PublishedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to create policy version: %w", err)
+	}
+	return nil
+}
+
+// IsPolicyVersionAccepted reports whether the entity has accepted the given policy version.
+func (s *policyStore) IsPolicyVersionAccepted(
+	ctx context.Context, entityID, handle, version string) (bool, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPolicyAcceptance, entityID, handle, version,
+		s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get policy acceptance: %w", err)
+	}
+	return len(results) > 0, nil
+}
+
+// CreatePolicyAcceptance records that the entity accepted the given policy version.
+func (s *policyStore) CreatePolicyAcceptance(
+	ctx context.Context, entityID, handle, version string, acceptedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreatePolicyAcceptance, entityID, handle, version, acceptedAt,
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to create policy acceptance: %w", err)
+	}
+	return nil
+}
+
+// buildPolicyVersionsFromResults converts database result rows into policy versions.
+func buildPolicyVersionsFromResults(results []map[string]interface{}) ([]PolicyVersion, error) {
+	versions := make([]PolicyVersion, 0, len(results))
+	for _, row := range results {
+		policyVersion, err := buildPolicyVersionFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, policyVersion)
+	}
+	return versions, nil
+}
+
+// buildPolicyVersionFromRow converts a single database result row into a policy version.
+func buildPolicyVersionFromRow(row map[string]interface{}) (PolicyVersion, error) {
+	publishedAt, err := sysutils.ParseDBTimeField(row["published_at"], "published_at")
+	if err != nil {
+		return PolicyVersion{}, err
+	}
+
+	policyVersion := PolicyVersion{PublishedAt: publishedAt}
+	for column, target := range map[string]*string{
+		"id":      &policyVersion.ID,
+		"handle":  &policyVersion.Handle,
+		"version": &policyVersion.Version,
+		"title":   &policyVersion.Title,
+		"url":     &policyVersion.URL,
+	} {
+		value, ok := row[column].(string)
+		if !ok {
+			return PolicyVersion{}, fmt.Errorf("failed to parse %s as string", column)
+		}
+		*target = value
+	}
+	return policyVersion, nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+const (
+	// policyVersionColumns is the column list selected for policy versions.
+	policyVersionColumns = `ID, HANDLE, VERSION, TITLE, URL, PUBLISHED_AT`
+)
+
+var (
+	// queryListPolicyVersionsByHandle retrieves the versions of a policy, latest first.
+	queryListPolicyVersionsByHandle = dbmodel.DBQuery{
+		ID: "POLQ-POLICY_MGT-01",
+		Query: `SELECT ` + policyVersionColumns + ` FROM "POLICY_VERSION" ` +
+			`WHERE HANDLE = $1 AND DEPLOYMENT_ID = $2 ORDER BY PUBLISHED_AT DESC, ID DESC`,
+	}
+
+	// queryListPolicyVersions retrieves the versions of all policies, grouped by handle with the
+	// latest version of each handle first.
+	queryListPolicyVersions = dbmodel.DBQuery{
+		ID: "POLQ-POLICY_MGT-02",
+		Query: `SELECT ` + policyVersionColumns + ` FROM "POLICY_VERSION" ` +
+			`WHERE DEPLOYMENT_ID = $1 ORDER BY HANDLE, PUBLISHED_AT DESC, ID DESC`,
+	}
+
+	// queryCreatePolicyVersion inserts a new policy version.
+	queryCreatePolicyVersion = dbmodel.DBQuery{
+		ID: "POLQ-POLICY_MGT-03",
+		Query: `INSERT INTO "POLICY_VERSION" (ID, HANDLE, VERSION, TITLE, URL, PUBLISHED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+
+	// queryGetPolicyAcceptance retrieves the acceptance record of an entity for a policy version.
+	queryGetPolicyAcceptance = dbmodel.DBQuery{
+		ID: "POLQ-POLICY_MGT-04",
+		Query: `SELECT ACCEPTED_AT FROM "POLICY_ACCEPTANCE" ` +
+			`WHERE ENTITY_ID = $1 AND POLICY_HANDLE = $2 AND VERSION = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryCreatePolicyAcceptance records the acceptance of a policy version by an entity. Accepting
+	// a version that was already accepted keeps the original acceptance time.
+	queryCreatePolicyAcceptance = dbmodel.DBQuery{
+		ID: "POLQ-POLICY_MGT-05",
+		Query: `INSERT INTO "POLICY_ACCEPTANCE" (ENTITY_ID, POLICY_HANDLE, VERSION, ACCEPTED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`,
+	}
+)
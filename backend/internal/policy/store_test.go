@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *policyStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &policyStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func testPolicyVersionRow(handle, version string) map[string]interface{} {
+	return map[string]interface{}{
+		"id": "id-" + version, "handle": handle, "version": version, "title": "Terms",
+		"url": "https://example.com/tos", "published_at": "2026-01-02 03:04:05",
+	}
+}
+
+func (suite *StoreTestSuite) TestListPolicyVersions() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListPolicyVersions, testDeploymentID).
+		Return([]map[string]interface{}{testPolicyVersionRow("tos", "2.0"), testPolicyVersionRow("tos", "1.0")}, nil)
+
+	versions, err := suite.store.ListPolicyVersions(suite.ctx)
+
+	suite.NoError(err)
+	suite.Len(versions, 2)
+	suite.Equal("2.0", versions[0].Version)
+	suite.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), versions[0].PublishedAt.UTC())
+}
+
+func (suite *StoreTestSuite) TestListPolicyVersionsByHandle() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListPolicyVersionsByHandle, "tos", testDeploymentID).
+		Return([]map[string]interface{}{testPolicyVersionRow("tos", "2.0")}, nil)
+
+	versions, err := suite.store.ListPolicyVersionsByHandle(suite.ctx, "tos")
+
+	suite.NoError(err)
+	suite.Len(versions, 1)
+	suite.Equal("tos", versions[0].Handle)
+}
+
+func (suite *StoreTestSuite) TestListPolicyVersions_Errors() {
+	suite.Run("ClientError", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("client error"))
+
+		_, err := suite.store.ListPolicyVersions(suite.ctx)
+		suite.Error(err)
+	})
+
+	suite.Run("QueryError", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryListPolicyVersions, testDeploymentID).
+			Return(nil, errors.New("query error"))
+
+		_, err := suite.store.ListPolicyVersions(suite.ctx)
+		suite.Error(err)
+	})
+
+	suite.Run("MalformedRow", func() {
+		suite.SetupTest()
+		row := testPolicyVersionRow("tos", "1.0")
+		delete(row, "title")
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryListPolicyVersions, testDeploymentID).
+			Return([]map[string]interface{}{row}, nil)
+
+		_, err := suite.store.ListPolicyVersions(suite.ctx)
+		suite.Error(err)
+	})
+}
+
+func (suite *StoreTestSuite) TestCreatePolicyVersion() {
+	publishedAt := time.Now().UTC()
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreatePolicyVersion, "id-1", "tos", "1.0",
+		"Terms", "https://example.com/tos", publishedAt, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.CreatePolicyVersion(suite.ctx, PolicyVersion{ID: "id-1", Handle: "tos", Version: "1.0",
+		Title: "Terms", URL: "https://example.com/tos", PublishedAt: publishedAt})
+
+	suite.NoError(err)
+}
+
+func (suite *StoreTestSuite) TestIsPolicyVersionAccepted() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPolicyAcceptance, "entity-1", "tos", "1.0",
+		testDeploymentID).Return([]map[string]interface{}{{"accepted_at": "2026-01-02 03:04:05"}}, nil).Once()
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetPolicyAcceptance, "entity-1", "tos", "2.0",
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	accepted, err := suite.store.IsPolicyVersionAccepted(suite.ctx, "entity-1", "tos", "1.0")
+	suite.NoError(err)
+	suite.True(accepted)
+
+	accepted, err = suite.store.IsPolicyVersionAccepted(suite.ctx, "entity-1", "tos", "2.0")
+	suite.NoError(err)
+	suite.False(accepted)
+}
+
+func (suite *StoreTestSuite) TestCreatePolicyAcceptance() {
+	acceptedAt := time.Now().UTC()
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreatePolicyAcceptance, "entity-1", "tos", "1.0",
+		acceptedAt, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.CreatePolicyAcceptance(suite.ctx, "entity-1", "tos", "1.0", acceptedAt)
+
+	suite.NoError(err)
+}
+
+func (suite *StoreTestSuite) TestCreatePolicyAcceptance_ExecuteError() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreatePolicyAcceptance, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("exec error"))
+
+	err := suite.store.CreatePolicyAcceptance(suite.ctx, "entity-1", "tos", "1.0", time.Now().UTC())
+
+	suite.Error(err)
+}
@@ -52,7 +52,7 @@ type ActionResponse struct {
 	Handle      string               `json:"handle"`
 	Description string               `json:"description,omitempty"`
 	Permission  string               `json:"permission"`
-	Kind        providers.ActionKind `json:"kind,omitempty"`
+	Kind        providers.ActionKind `json:"kind,omitempty"        native:"omitempty,oneof=tool resource"`
 }
 
 // LinkResponse represents a pagination link.
@@ -90,27 +90,27 @@ type ActionListResponse struct {
 
 // CreateResourceServerRequest represents the request to create a resource server.
 type CreateResourceServerRequest struct {
-	Name        string                       `json:"name"                  native:"required"`
+	Name        string                       `json:"name"                  native:"required,max=100"`
 	Description string                       `json:"description,omitempty"`
 	Handle      string                       `json:"handle,omitempty"      native:"omitempty,max=100"`
-	Identifier  string                       `json:"identifier,omitempty"`
-	Type        providers.ResourceServerType `json:"type,omitempty"`
+	Identifier  string                       `json:"identifier,omitempty"  native:"omitempty,max=2048"`
+	Type        providers.ResourceServerType `json:"type,omitempty"        native:"omitempty,oneof=API MCP CUSTOM"`
 	OUID        string                       `json:"ouId"                  native:"required"`
-	Delimiter   string                       `json:"delimiter,omitempty"`
+	Delimiter   string                       `json:"delimiter,omitempty"   native:"omitempty,len=1"`
 }
 
 // UpdateResourceServerRequest represents the request to update a resource server.
 type UpdateResourceServerRequest struct {
-	Name        string `json:"name"`
+	Name        string `json:"name"                  native:"omitempty,max=100"`
 	Description string `json:"description,omitempty"`
 	Handle      string `json:"handle,omitempty"      native:"omitempty,max=100"`
-	Identifier  string `json:"identifier,omitempty"`
+	Identifier  string `json:"identifier,omitempty"  native:"omitempty,max=2048"`
 	OUID        string `json:"ouId"                  native:"required"`
 }
 
 // CreateResourceRequest represents the request to create a resource.
 type CreateResourceRequest struct {
-	Name        string  `json:"name"`
+	Name        string  `json:"name"                  native:"omitempty,max=100"`
 	Handle      string  `json:"handle"                native:"required,max=100"`
 	Description string  `json:"description,omitempty"`
 	Parent      *string `json:"parent"`
@@ -118,21 +118,21 @@ type CreateResourceRequest struct {
 
 // UpdateResourceRequest represents the request to update a resource.
 type UpdateResourceRequest struct {
-	Name        string `json:"name"                  native:"required"`
+	Name        string `json:"name"                  native:"required,max=100"`
 	Description string `json:"description,omitempty"`
 }
 
 // CreateActionRequest represents the request to create an action.
 type CreateActionRequest struct {
-	Name        string               `json:"name"                  native:"required"`
+	Name        string               `json:"name"                  native:"required,max=100"`
 	Handle      string               `json:"handle"                native:"required,max=100"`
 	Description string               `json:"description,omitempty"`
-	Kind        providers.ActionKind `json:"kind,omitempty"`
+	Kind        providers.ActionKind `json:"kind,omitempty"        native:"omitempty,oneof=tool resource"`
 }
 
 // UpdateActionRequest represents the request to update an action.
 type UpdateActionRequest struct {
-	Name        string `json:"name"                  native:"required"`
+	Name        string `json:"name"                  native:"required,max=100"`
 	Description string `json:"description,omitempty"`
 }
 
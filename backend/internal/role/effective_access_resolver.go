@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+
+	resourcepkg "github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// effectiveAccessResolver implements user.EffectiveAccessResolver using the role store. It resolves
+// the roles assigned to a user directly and through each of the user's groups, and the permissions
+// those roles grant on each resource server.
+type effectiveAccessResolver struct {
+	store           roleStoreInterface
+	resourceService resourcepkg.ResourceServiceInterface
+}
+
+// newEffectiveAccessResolver creates a new EffectiveAccessResolver backed by the given role store.
+func newEffectiveAccessResolver(store roleStoreInterface,
+	resourceService resourcepkg.ResourceServiceInterface) user.EffectiveAccessResolver {
+	return &effectiveAccessResolver{store: store, resourceService: resourceService}
+}
+
+// ResolveEffectiveAccess returns the roles, permissions and resource server scopes the user holds
+// directly and through the given groups.
+func (r *effectiveAccessResolver) ResolveEffectiveAccess(
+	ctx context.Context, userID string, groups []providers.EntityGroup,
+) (*user.EffectiveAccess, error) {
+	roles := make(map[string]*user.EffectiveRole)
+	roleOrder := make([]string, 0)
+	addRole := func(roleID string) *user.EffectiveRole {
+		if role, ok := roles[roleID]; ok {
+			return role
+		}
+		role := &user.EffectiveRole{ID: roleID}
+		roles[roleID] = role
+		roleOrder = append(roleOrder, roleID)
+		return role
+	}
+
+	directRoleIDs, err := r.store.GetEntityRoleIDs(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, roleID := range directRoleIDs {
+		addRole(roleID).Direct = true
+	}
+	for _, group := range groups {
+		groupRoleIDs, err := r.store.GetEntityRoleIDs(ctx, "", []string{group.ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, roleID := range groupRoleIDs {
+			role := addRole(roleID)
+			role.ViaGroups = append(role.ViaGroups, group.ID)
+		}
+	}
+
+	access := &user.EffectiveAccess{
+		Groups:          groups,
+		Roles:           make([]user.EffectiveRole, 0, len(roleOrder)),
+		Permissions:     []string{},
+		ResourceServers: []user.EffectiveResourceServer{},
+	}
+	if access.Groups == nil {
+		access.Groups = []providers.EntityGroup{}
+	}
+
+	scopesByServer := make(map[string]map[string]bool)
+	permissionSet := make(map[string]bool)
+	for _, roleID := range roleOrder {
+		details, err := r.store.GetRole(ctx, roleID)
+		if err != nil {
+			// An assignment can outlive a declarative role whose definition was removed.
+			if errors.Is(err, ErrRoleNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		role := roles[roleID]
+		role.Name = details.Name
+		role.OUID = details.OUID
+		access.Roles = append(access.Roles, *role)
+
+		for _, resourcePermissions := range details.Permissions {
+			scopes, ok := scopesByServer[resourcePermissions.ResourceServerID]
+			if !ok {
+				scopes = make(map[string]bool)
+				scopesByServer[resourcePermissions.ResourceServerID] = scopes
+			}
+			for _, permission := range resourcePermissions.Permissions {
+				scopes[permission] = true
+				permissionSet[permission] = true
+			}
+		}
+	}
+	sort.Slice(access.Roles, func(i, j int) bool {
+		return access.Roles[i].Name < access.Roles[j].Name
+	})
+	access.Permissions = sortedKeys(permissionSet)
+
+	serverIDs := make([]string, 0, len(scopesByServer))
+	for serverID := range scopesByServer {
+		serverIDs = append(serverIDs, serverID)
+	}
+	sort.Strings(serverIDs)
+	for _, serverID := range serverIDs {
+		resourceServer := user.EffectiveResourceServer{ID: serverID, Scopes: sortedKeys(scopesByServer[serverID])}
+		rs, svcErr := r.resourceService.GetResourceServer(ctx, serverID)
+		if svcErr != nil && svcErr.Code != resourcepkg.ErrorResourceServerNotFound.Code {
+			return nil, fmt.Errorf("failed to get resource server %q: %s", serverID, svcErr.Error.DefaultValue)
+		}
+		if rs != nil {
+			resourceServer.Name = rs.Name
+			resourceServer.Handle = rs.Handle
+			resourceServer.Identifier = rs.Identifier
+		}
+		access.ResourceServers = append(access.ResourceServers, resourceServer)
+	}
+
+	return access, nil
+}
+
+// sortedKeys returns the keys of the set in ascending order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	resourcepkg "github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/user"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+func TestEffectiveAccessResolver_ResolveEffectiveAccess(t *testing.T) {
+	ctx := context.Background()
+	groups := []providers.EntityGroup{{ID: "g1", Name: "Engineering", OUID: "ou-1"}}
+
+	t.Run("merges direct and group roles", func(t *testing.T) {
+		store := newRoleStoreInterfaceMock(t)
+		store.On("GetEntityRoleIDs", ctx, "user-1", []string(nil)).Return([]string{"r1"}, nil).Once()
+		store.On("GetEntityRoleIDs", ctx, "", []string{"g1"}).Return([]string{"r1", "r2"}, nil).Once()
+		store.On("GetRole", ctx, "r1").Return(RoleWithPermissions{
+			ID: "r1", Name: "Editor", OUID: "ou-1",
+			Permissions: []ResourcePermissions{{ResourceServerID: "rs-1", Permissions: []string{"write", "read"}}},
+		}, nil).Once()
+		store.On("GetRole", ctx, "r2").Return(RoleWithPermissions{
+			ID: "r2", Name: "Auditor", OUID: "ou-1",
+			Permissions: []ResourcePermissions{
+				{ResourceServerID: "rs-1", Permissions: []string{"read"}},
+				{ResourceServerID: "rs-2", Permissions: []string{"audit"}},
+			},
+		}, nil).Once()
+		resourceService := resourcemock.NewResourceServiceInterfaceMock(t)
+		resourceService.On("GetResourceServer", ctx, "rs-1").Return(&providers.ResourceServer{
+			ID: "rs-1", Name: "Orders", Handle: "orders", Identifier: "https://orders.example.com",
+		}, nil).Once()
+		resourceService.On("GetResourceServer", ctx, "rs-2").
+			Return(nil, &resourcepkg.ErrorResourceServerNotFound).Once()
+
+		resolver := newEffectiveAccessResolver(store, resourceService)
+		access, err := resolver.ResolveEffectiveAccess(ctx, "user-1", groups)
+
+		require.NoError(t, err)
+		require.Equal(t, groups, access.Groups)
+		require.Equal(t, []user.EffectiveRole{
+			{ID: "r2", Name: "Auditor", OUID: "ou-1", ViaGroups: []string{"g1"}},
+			{ID: "r1", Name: "Editor", OUID: "ou-1", Direct: true, ViaGroups: []string{"g1"}},
+		}, access.Roles)
+		require.Equal(t, []string{"audit", "read", "write"}, access.Permissions)
+		require.Equal(t, []user.EffectiveResourceServer{
+			{ID: "rs-1", Name: "Orders", Handle: "orders", Identifier: "https://orders.example.com",
+				Scopes: []string{"read", "write"}},
+			{ID: "rs-2", Scopes: []string{"audit"}},
+		}, access.ResourceServers)
+	})
+
+	t.Run("no roles", func(t *testing.T) {
+		store := newRoleStoreInterfaceMock(t)
+		store.On("GetEntityRoleIDs", ctx, "user-1", []string(nil)).Return([]string{}, nil).Once()
+
+		resolver := newEffectiveAccessResolver(store, resourcemock.NewResourceServiceInterfaceMock(t))
+		access, err := resolver.ResolveEffectiveAccess(ctx, "user-1", nil)
+
+		require.NoError(t, err)
+		require.Empty(t, access.Groups)
+		require.NotNil(t, access.Groups)
+		require.Empty(t, access.Roles)
+		require.Empty(t, access.Permissions)
+		require.Empty(t, access.ResourceServers)
+	})
+
+	t.Run("skips missing role", func(t *testing.T) {
+		store := newRoleStoreInterfaceMock(t)
+		store.On("GetEntityRoleIDs", ctx, "user-1", []string(nil)).Return([]string{"r1"}, nil).Once()
+		store.On("GetRole", ctx, "r1").Return(RoleWithPermissions{}, ErrRoleNotFound).Once()
+
+		resolver := newEffectiveAccessResolver(store, resourcemock.NewResourceServiceInterfaceMock(t))
+		access, err := resolver.ResolveEffectiveAccess(ctx, "user-1", nil)
+
+		require.NoError(t, err)
+		require.Empty(t, access.Roles)
+	})
+
+	t.Run("assignment store error", func(t *testing.T) {
+		store := newRoleStoreInterfaceMock(t)
+		store.On("GetEntityRoleIDs", ctx, "user-1", []string(nil)).Return([]string{"r1"}, nil).Once()
+		store.On("GetEntityRoleIDs", ctx, "", []string{"g1"}).Return(nil, errors.New("db error")).Once()
+
+		resolver := newEffectiveAccessResolver(store, resourcemock.NewResourceServiceInterfaceMock(t))
+		access, err := resolver.ResolveEffectiveAccess(ctx, "user-1", groups)
+
+		require.Error(t, err)
+		require.Nil(t, access)
+	})
+
+	t.Run("role store error", func(t *testing.T) {
+		store := newRoleStoreInterfaceMock(t)
+		store.On("GetEntityRoleIDs", ctx, "user-1", []string(nil)).Return([]string{"r1"}, nil).Once()
+		store.On("GetRole", ctx, "r1").Return(RoleWithPermissions{}, errors.New("db error")).Once()
+
+		resolver := newEffectiveAccessResolver(store, resourcemock.NewResourceServiceInterfaceMock(t))
+		access, err := resolver.ResolveEffectiveAccess(ctx, "user-1", nil)
+
+		require.Error(t, err)
+		require.Nil(t, access)
+	})
+
+	t.Run("resource service error", func(t *testing.T) {
+		store := newRoleStoreInterfaceMock(t)
+		store.On("GetEntityRoleIDs", ctx, "user-1", []string(nil)).Return([]string{"r1"}, nil).Once()
+		store.On("GetRole", ctx, "r1").Return(RoleWithPermissions{
+			ID: "r1", Name: "Editor",
+			Permissions: []ResourcePermissions{{ResourceServerID: "rs-1", Permissions: []string{"read"}}},
+		}, nil).Once()
+		resourceService := resourcemock.NewResourceServiceInterfaceMock(t)
+		resourceService.On("GetResourceServer", ctx, "rs-1").
+			Return(nil, &tidcommon.InternalServerError).Once()
+
+		resolver := newEffectiveAccessResolver(store, resourceService)
+		access, err := resolver.ResolveEffectiveAccess(ctx, "user-1", nil)
+
+		require.Error(t, err)
+		require.Nil(t, access)
+	})
+}
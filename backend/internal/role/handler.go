@@ -262,6 +262,9 @@ func (rh *roleHandler) HandleRoleAddAssignmentsRequest(w http.ResponseWriter, r
 	id := r.PathValue("id")
 	assignmentsRequest, err := sysutils.DecodeJSONBody[AssignmentsRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(ctx, w, &ErrorInvalidRequestFormat)
 		return
 	}
@@ -289,6 +292,9 @@ func (rh *roleHandler) HandleRoleRemoveAssignmentsRequest(w http.ResponseWriter,
 	id := r.PathValue("id")
 	assignmentsRequest, err := sysutils.DecodeJSONBody[AssignmentsRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		handleError(ctx, w, &ErrorInvalidRequestFormat)
 		return
 	}
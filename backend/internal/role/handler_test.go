@@ -207,7 +207,8 @@ func (suite *RoleHandlerTestSuite) TestHandleRoleAddAssignmentsRequest_Validatio
 	suite.handler.HandleRoleAddAssignmentsRequest(w, req)
 
 	suite.Equal(http.StatusBadRequest, w.Code)
-	suite.Contains(w.Body.String(), "Invalid request format")
+	suite.Contains(w.Body.String(), "INVALID_INPUT_METADATA")
+	suite.Contains(w.Body.String(), "assignments")
 
 	suite.mockAssignmentService.AssertNotCalled(
 		suite.T(), "AddAssignments", mock.Anything, mock.Anything, mock.Anything)
@@ -31,6 +31,7 @@ import (
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/user"
 )
 
 // Initialize initializes the role service and registers its routes.
@@ -43,12 +44,12 @@ func Initialize(
 	entityTypeService entitytype.EntityTypeServiceInterface,
 ) (
 	RoleServiceInterface, RoleAssignmentServiceInterface, oupkg.OURoleResolver,
-	declarativeresource.ResourceExporter, error,
+	user.EffectiveAccessResolver, declarativeresource.ResourceExporter, error,
 ) {
 	// Step 1: Initialize store and transactioner based on store mode (no declarative loading yet)
 	roleStore, transactioner, fileStore, dbStore, err := initializeStore()
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, err
 	}
 
 	// Step 2: Create service with store
@@ -60,7 +61,7 @@ func Initialize(
 	// Step 3: Load declarative resources into store (if applicable)
 	if fileStore != nil {
 		if err := loadDeclarativeResources(fileStore, dbStore, roleService); err != nil {
-			return nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, err
 		}
 	}
 
@@ -71,7 +72,8 @@ func Initialize(
 	registerRoutes(mux, roleHandler)
 	exporter := newRoleExporter(roleService, assignmentService)
 	ouRoleResolver := newOURoleResolver(roleStore)
-	return roleService, assignmentService, ouRoleResolver, exporter, nil
+	effectiveAccessResolver := newEffectiveAccessResolver(roleStore, resourceService)
+	return roleService, assignmentService, ouRoleResolver, effectiveAccessResolver, exporter, nil
 }
 
 // Store Selection (based on role.store configuration):
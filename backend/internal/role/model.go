@@ -77,7 +77,7 @@ type RoleSummaryResponse struct {
 type RoleResponse struct {
 	ID          string                `json:"id"`
 	Name        string                `json:"name"`
-	Description string                `json:"description,omitempty"`
+	Description string                `json:"description,omitempty" native:"omitempty,max=255"`
 	OUID        string                `json:"ouId"`
 	OUHandle    string                `json:"ouHandle,omitempty"`
 	Permissions []ResourcePermissions `json:"permissions"`
@@ -86,17 +86,17 @@ type RoleResponse struct {
 // CreateRoleRequest represents the request body for creating a role.
 type CreateRoleRequest struct {
 	Name        string                `json:"name"                  native:"required,min=3,max=64"`
-	Description string                `json:"description,omitempty"`
+	Description string                `json:"description,omitempty" native:"omitempty,max=255"`
 	OUID        string                `json:"ouId"                  native:"required"`
-	Permissions []ResourcePermissions `json:"permissions"`
-	Assignments []AssignmentRequest   `json:"assignments,omitempty"`
+	Permissions []ResourcePermissions `json:"permissions"           native:"dive"`
+	Assignments []AssignmentRequest   `json:"assignments,omitempty" native:"omitempty,dive"`
 }
 
 // CreateRoleResponse represents the response body for creating a role.
 type CreateRoleResponse struct {
 	ID          string                `json:"id"`
 	Name        string                `json:"name"`
-	Description string                `json:"description,omitempty"`
+	Description string                `json:"description,omitempty" native:"omitempty,max=255"`
 	OUID        string                `json:"ouId"`
 	OUHandle    string                `json:"ouHandle,omitempty"`
 	Permissions []ResourcePermissions `json:"permissions"`
@@ -106,9 +106,9 @@ type CreateRoleResponse struct {
 // UpdateRoleRequest represents the request body for updating a role.
 type UpdateRoleRequest struct {
 	Name        string                `json:"name"                  native:"required,min=3,max=64"`
-	Description string                `json:"description,omitempty"`
+	Description string                `json:"description,omitempty" native:"omitempty,max=255"`
 	OUID        string                `json:"ouId"                  native:"required"`
-	Permissions []ResourcePermissions `json:"permissions"`
+	Permissions []ResourcePermissions `json:"permissions"           native:"dive"`
 }
 
 // AssignmentsRequest represents the request body for adding or removing assignments.
@@ -138,8 +138,8 @@ type AssignmentListResponse struct {
 
 // ResourcePermissions represents permissions grouped by resource server.
 type ResourcePermissions struct {
-	ResourceServerID string   `json:"resourceServerId" yaml:"resourceServerId"`
-	Permissions      []string `json:"permissions"      yaml:"permissions"`
+	ResourceServerID string   `json:"resourceServerId" yaml:"resourceServerId" native:"required"`
+	Permissions      []string `json:"permissions"      yaml:"permissions"      native:"dive,max=1000"`
 }
 
 // RoleCreationDetail represents the parameters for creating a role.
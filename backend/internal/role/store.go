@@ -533,12 +533,15 @@ func addAssignmentsToRole(
 	assignments []RoleAssignment,
 	deploymentID string,
 ) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+	rows := make([][]interface{}, 0, len(assignments))
 	for _, assignment := range assignments {
-		_, err := dbClient.ExecuteContext(
-			ctx, queryCreateRoleAssignment, id, assignment.Type, assignment.ID, deploymentID)
-		if err != nil {
-			return fmt.Errorf("failed to add assignment to role: %w", err)
-		}
+		rows = append(rows, []interface{}{id, assignment.Type, assignment.ID, deploymentID})
+	}
+	if _, err := dbClient.BatchExecuteContext(ctx, queryCreateRoleAssignments, rows); err != nil {
+		return fmt.Errorf("failed to add assignment to role: %w", err)
 	}
 	return nil
 }
@@ -83,11 +83,12 @@ var (
 		Query: `DELETE FROM "ROLE_PERMISSION" WHERE ROLE_ID = $1 AND DEPLOYMENT_ID = $2`,
 	}
 
-	// queryCreateRoleAssignment creates a new role assignment.
-	queryCreateRoleAssignment = dbmodel.DBQuery{
-		ID: "RLQ-ROLE_MGT-10",
-		Query: `INSERT INTO "ROLE_ASSIGNMENT" (ROLE_ID, ASSIGNEE_TYPE, ASSIGNEE_ID, DEPLOYMENT_ID)
-			VALUES ($1, $2, $3, $4) ON CONFLICT (ROLE_ID, DEPLOYMENT_ID, ASSIGNEE_TYPE, ASSIGNEE_ID) DO NOTHING`,
+	// queryCreateRoleAssignments creates role assignments in batch.
+	queryCreateRoleAssignments = dbmodel.DBBatchInsertQuery{
+		ID:      "RLQ-ROLE_MGT-10",
+		Prefix:  `INSERT INTO "ROLE_ASSIGNMENT" (ROLE_ID, ASSIGNEE_TYPE, ASSIGNEE_ID, DEPLOYMENT_ID)`,
+		Suffix:  `ON CONFLICT (ROLE_ID, DEPLOYMENT_ID, ASSIGNEE_TYPE, ASSIGNEE_ID) DO NOTHING`,
+		Columns: 4,
 	}
 
 	// queryGetRoleAssignments retrieves all assignments for a role with pagination.
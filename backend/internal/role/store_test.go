@@ -460,8 +460,8 @@ func (suite *RoleStoreTestSuite) TestCreateRole() {
 					"perm1", testDeploymentID).Return(int64(1), nil)
 				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateRolePermission, "role1", "rs1",
 					"perm2", testDeploymentID).Return(int64(1), nil)
-				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateRoleAssignment, "role1",
-					assigneeTypeEntity, "user1", testDeploymentID).Return(int64(1), nil)
+				suite.mockDBClient.On("BatchExecuteContext", mock.Anything, queryCreateRoleAssignments,
+					[][]interface{}{{"role1", assigneeTypeEntity, "user1", testDeploymentID}}).Return(int64(1), nil)
 			},
 			shouldErr: false,
 		},
@@ -554,8 +554,8 @@ func (suite *RoleStoreTestSuite) TestCreateRole() {
 				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
 				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateRole, "role1", "ou1", "Test Role",
 					"Test Description", testDeploymentID).Return(int64(1), nil)
-				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateRoleAssignment, "role1",
-					assigneeTypeEntity, "user1", testDeploymentID).
+				suite.mockDBClient.On("BatchExecuteContext", mock.Anything, queryCreateRoleAssignments,
+					[][]interface{}{{"role1", assigneeTypeEntity, "user1", testDeploymentID}}).
 					Return(int64(0), assignError)
 			},
 			shouldErr: true,
@@ -1189,8 +1189,8 @@ func (suite *RoleStoreTestSuite) TestAddAssignments() {
 			},
 			setupMocks: func() {
 				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
-				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateRoleAssignment, "role1",
-					assigneeTypeEntity, testUserID1, testDeploymentID).Return(int64(1), nil)
+				suite.mockDBClient.On("BatchExecuteContext", mock.Anything, queryCreateRoleAssignments,
+					[][]interface{}{{"role1", assigneeTypeEntity, testUserID1, testDeploymentID}}).Return(int64(1), nil)
 			},
 			shouldErr: false,
 		},
@@ -1203,8 +1203,9 @@ func (suite *RoleStoreTestSuite) TestAddAssignments() {
 			setupMocks: func() {
 				execError := errors.New("insert failed")
 				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
-				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateRoleAssignment, "role1",
-					assigneeTypeEntity, testUserID1, testDeploymentID).Return(int64(0), execError)
+				suite.mockDBClient.On("BatchExecuteContext", mock.Anything, queryCreateRoleAssignments,
+					[][]interface{}{{"role1", assigneeTypeEntity, testUserID1, testDeploymentID}}).
+					Return(int64(0), execError)
 			},
 			shouldErr:    true,
 			errorMessage: "failed to add assignment to role",
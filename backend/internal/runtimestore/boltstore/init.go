@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package boltstore provides a runtime store implementation backed by the embedded bolt key-value store.
+package boltstore
+
+import (
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize creates and returns a new BoltStore instance for the given deployment.
+func Initialize(deploymentID string) (providers.RuntimeStoreProvider, transaction.Transactioner, error) {
+	return newBoltStore(dbprovider.GetBoltProvider(), deploymentID), transaction.NewNoOpTransactioner(), nil
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package boltstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// keyFormat is the format string used to build bolt store keys.
+const keyFormat = "runtime:%s:%s:%s"
+
+// boltStore implements the RuntimeStoreProvider interface using the embedded bolt store as the backend.
+type boltStore struct {
+	deploymentID string
+	client       dbprovider.BoltProviderInterface
+	logger       *log.Logger
+}
+
+func newBoltStore(client dbprovider.BoltProviderInterface, deploymentID string) providers.RuntimeStoreProvider {
+	return &boltStore{
+		deploymentID: deploymentID,
+		client:       client,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BoltStore")),
+	}
+}
+
+// Put stores a value in the bolt store with the specified TTL.
+func (b *boltStore) Put(ctx context.Context, namespace providers.RuntimeStoreNamespace,
+	key string, value []byte, ttlSeconds int64) error {
+	if err := b.client.Set(b.getFormattedKey(namespace, key), value, ttl(ttlSeconds)); err != nil {
+		return fmt.Errorf("failed to store in bolt: %w", err)
+	}
+
+	b.logger.Debug(ctx, "Stored in bolt", log.String("key", key))
+	return nil
+}
+
+// Get retrieves a value from the bolt store by its key.
+func (b *boltStore) Get(_ context.Context, namespace providers.RuntimeStoreNamespace,
+	key string) ([]byte, error) {
+	data, err := b.client.Get(b.getFormattedKey(namespace, key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data from bolt: %w", err)
+	}
+	return data, nil
+}
+
+// Update updates the value associated with a key in the bolt store, preserving its TTL.
+func (b *boltStore) Update(_ context.Context, namespace providers.RuntimeStoreNamespace,
+	key string, value []byte) error {
+	return b.client.Modify(b.getFormattedKey(namespace, key), func(current []byte) ([]byte, error) {
+		if current == nil {
+			return nil, providers.ErrRuntimeStoreKeyNotFound
+		}
+		return value, nil
+	})
+}
+
+// Delete removes a value from the bolt store by its key.
+func (b *boltStore) Delete(_ context.Context, namespace providers.RuntimeStoreNamespace,
+	key string) error {
+	if err := b.client.Del(b.getFormattedKey(namespace, key)); err != nil {
+		return fmt.Errorf("failed to delete from bolt: %w", err)
+	}
+	return nil
+}
+
+// Take retrieves and removes a value from the bolt store by its key.
+func (b *boltStore) Take(ctx context.Context, namespace providers.RuntimeStoreNamespace,
+	key string) ([]byte, error) {
+	data, err := b.client.GetDel(b.getFormattedKey(namespace, key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to take data from bolt: %w", err)
+	}
+
+	b.logger.Debug(ctx, "Taken from bolt", log.String("key", key))
+	return data, nil
+}
+
+// ExtendTTL extends the TTL of an existing entry in the bolt store.
+func (b *boltStore) ExtendTTL(_ context.Context, namespace providers.RuntimeStoreNamespace,
+	key string, ttlSeconds int64) error {
+	ok, err := b.client.Expire(b.getFormattedKey(namespace, key), ttl(ttlSeconds))
+	if err != nil {
+		return fmt.Errorf("failed to extend TTL in bolt: %w", err)
+	}
+	if !ok {
+		return providers.ErrRuntimeStoreKeyNotFound
+	}
+	return nil
+}
+
+// getFormattedKey builds the bolt key.
+func (b *boltStore) getFormattedKey(namespace providers.RuntimeStoreNamespace, key string) string {
+	return fmt.Sprintf(keyFormat, b.deploymentID, namespace, key)
+}
+
+// ttl converts a TTL in seconds to a duration. Zero or a negative value keeps the entry until it is
+// deleted.
+func ttl(ttlSeconds int64) time.Duration {
+	if ttlSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(ttlSeconds) * time.Second
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package boltstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider/bolttest"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type BoltStoreTestSuite struct {
+	suite.Suite
+	store providers.RuntimeStoreProvider
+	ctx   context.Context
+}
+
+func TestBoltStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(BoltStoreTestSuite))
+}
+
+func (s *BoltStoreTestSuite) SetupTest() {
+	s.store = newBoltStore(bolttest.NewProvider(s.T()), "dep1")
+	s.ctx = context.Background()
+}
+
+func (s *BoltStoreTestSuite) TestGetFormattedKey() {
+	key := s.store.(*boltStore).getFormattedKey(providers.NamespaceFlow, "abc123")
+	s.Equal("runtime:dep1:flow:state:abc123", key)
+}
+
+func (s *BoltStoreTestSuite) TestPutAndGet() {
+	s.Require().NoError(s.store.Put(s.ctx, providers.NamespaceFlow, "k", []byte("v"), 60))
+
+	data, err := s.store.Get(s.ctx, providers.NamespaceFlow, "k")
+	s.NoError(err)
+	s.Equal([]byte("v"), data)
+
+	// Namespaces do not share keys.
+	data, err = s.store.Get(s.ctx, providers.NamespacePAR, "k")
+	s.NoError(err)
+	s.Nil(data)
+}
+
+func (s *BoltStoreTestSuite) TestDeploymentsDoNotShareKeys() {
+	s.Require().NoError(s.store.Put(s.ctx, providers.NamespaceFlow, "k", []byte("v"), 0))
+
+	other := newBoltStore(s.store.(*boltStore).client, "dep2")
+	data, err := other.Get(s.ctx, providers.NamespaceFlow, "k")
+	s.NoError(err)
+	s.Nil(data)
+}
+
+func (s *BoltStoreTestSuite) TestUpdate() {
+	s.ErrorIs(s.store.Update(s.ctx, providers.NamespaceFlow, "k", []byte("v")),
+		providers.ErrRuntimeStoreKeyNotFound)
+
+	s.Require().NoError(s.store.Put(s.ctx, providers.NamespaceFlow, "k", []byte("v1"), 60))
+	s.NoError(s.store.Update(s.ctx, providers.NamespaceFlow, "k", []byte("v2")))
+
+	data, err := s.store.Get(s.ctx, providers.NamespaceFlow, "k")
+	s.NoError(err)
+	s.Equal([]byte("v2"), data)
+}
+
+func (s *BoltStoreTestSuite) TestDelete() {
+	s.Require().NoError(s.store.Put(s.ctx, providers.NamespaceFlow, "k", []byte("v"), 60))
+	s.NoError(s.store.Delete(s.ctx, providers.NamespaceFlow, "k"))
+	s.NoError(s.store.Delete(s.ctx, providers.NamespaceFlow, "k"))
+
+	data, err := s.store.Get(s.ctx, providers.NamespaceFlow, "k")
+	s.NoError(err)
+	s.Nil(data)
+}
+
+func (s *BoltStoreTestSuite) TestTake() {
+	s.Require().NoError(s.store.Put(s.ctx, providers.NamespaceFlow, "k", []byte("v"), 60))
+
+	data, err := s.store.Take(s.ctx, providers.NamespaceFlow, "k")
+	s.NoError(err)
+	s.Equal([]byte("v"), data)
+
+	data, err = s.store.Take(s.ctx, providers.NamespaceFlow, "k")
+	s.NoError(err)
+	s.Nil(data)
+}
+
+func (s *BoltStoreTestSuite) TestExtendTTL() {
+	s.ErrorIs(s.store.ExtendTTL(s.ctx, providers.NamespaceFlow, "k", 60), providers.ErrRuntimeStoreKeyNotFound)
+
+	s.Require().NoError(s.store.Put(s.ctx, providers.NamespaceFlow, "k", []byte("v"), 60))
+	s.NoError(s.store.ExtendTTL(s.ctx, providers.NamespaceFlow, "k", 120))
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dbstore
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	namespaceConstantsFile = "../../../pkg/thunderidengine/providers/constants.go"
+	postgresRuntimeSchema  = "../../../dbscripts/runtimedb/postgres.sql"
+)
+
+// TestPostgresSchema_PartitionPerNamespace guards against adding a runtime store namespace without
+// its PostgreSQL partition. RUNTIME_STORE has no default partition, so a write to a namespace
+// without one fails.
+func TestPostgresSchema_PartitionPerNamespace(t *testing.T) {
+	schema, err := os.ReadFile(postgresRuntimeSchema)
+	require.NoError(t, err)
+
+	namespaces := runtimeStoreNamespaces(t)
+	require.NotEmpty(t, namespaces)
+	for name, value := range namespaces {
+		partition := `PARTITION OF "RUNTIME_STORE" FOR VALUES IN ('` + value + `')`
+		require.True(t, strings.Contains(string(schema), partition),
+			"%s (%q) has no RUNTIME_STORE partition in %s", name, value, postgresRuntimeSchema)
+	}
+}
+
+// runtimeStoreNamespaces returns the RuntimeStoreNamespace constants declared in the providers
+// package, keyed by constant name.
+func runtimeStoreNamespaces(t *testing.T) map[string]string {
+	file, err := parser.ParseFile(token.NewFileSet(), namespaceConstantsFile, nil, 0)
+	require.NoError(t, err)
+
+	namespaces := map[string]string{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			typeIdent, ok := valueSpec.Type.(*ast.Ident)
+			if !ok || typeIdent.Name != "RuntimeStoreNamespace" {
+				continue
+			}
+			for i, name := range valueSpec.Names {
+				literal, ok := valueSpec.Values[i].(*ast.BasicLit)
+				require.True(t, ok, "%s must be a string literal", name.Name)
+				value, err := strconv.Unquote(literal.Value)
+				require.NoError(t, err)
+				namespaces[name.Name] = value
+			}
+		}
+	}
+	return namespaces
+}
@@ -20,6 +20,7 @@
 package runtimestore
 
 import (
+	"github.com/thunder-id/thunderid/internal/runtimestore/boltstore"
 	"github.com/thunder-id/thunderid/internal/runtimestore/dbstore"
 	"github.com/thunder-id/thunderid/internal/runtimestore/redisstore"
 	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
@@ -28,10 +29,14 @@ import (
 )
 
 // Initialize returns the runtime store provider backing the given runtime datasource type.
-// Redis-backed runtimes use the Redis store; all others use the relational database store.
+// Redis-backed runtimes use the Redis store and bolt-backed runtimes the embedded bolt store; all others
+// use the relational database store.
 func Initialize(runtimeDBType, deploymentID string) (providers.RuntimeStoreProvider, transaction.Transactioner, error) {
-	if runtimeDBType == dbprovider.DataSourceTypeRedis {
+	switch runtimeDBType {
+	case dbprovider.DataSourceTypeRedis:
 		return redisstore.Initialize(deploymentID)
+	case dbprovider.DataSourceTypeBolt:
+		return boltstore.Initialize(deploymentID)
 	}
 	return dbstore.Initialize(deploymentID)
 }
@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	scopeByIDCacheName   = "ScopeByIDCache"
+	scopeByNameCacheName = "ScopeByNameCache"
+	scopeListCacheName   = "ScopeListCache"
+)
+
+// scopeListCacheKey is the key of the single entry in the scope list cache.
+var scopeListCacheKey = cache.CacheKey{Key: "all"}
+
+// cacheBackedScopeStore wraps a scopeStoreInterface with caching of scope definitions by ID and by
+// name, and of the full scope list that scope names are resolved against at token issuance.
+type cacheBackedScopeStore struct {
+	scopeByIDCache   cache.CacheInterface[*Scope]
+	scopeByNameCache cache.CacheInterface[*Scope]
+	scopeListCache   cache.CacheInterface[[]Scope]
+	store            scopeStoreInterface
+	logger           *log.Logger
+}
+
+// newCacheBackedScopeStore creates a cache-backed wrapper around the given store.
+func newCacheBackedScopeStore(store scopeStoreInterface,
+	scopeByIDCache cache.CacheInterface[*Scope],
+	scopeByNameCache cache.CacheInterface[*Scope],
+	scopeListCache cache.CacheInterface[[]Scope]) scopeStoreInterface {
+	return &cacheBackedScopeStore{
+		scopeByIDCache:   scopeByIDCache,
+		scopeByNameCache: scopeByNameCache,
+		scopeListCache:   scopeListCache,
+		store:            store,
+		logger: log.GetLogger().With(
+			log.String(log.LoggerKeyComponentName, "CacheBackedScopeStore")),
+	}
+}
+
+// ListScopes returns all scope definitions, using the cache if available.
+func (s *cacheBackedScopeStore) ListScopes(ctx context.Context) ([]Scope, error) {
+	if cached, ok := s.scopeListCache.Get(ctx, scopeListCacheKey); ok && cached != nil {
+		return cached, nil
+	}
+
+	scopes, err := s.store.ListScopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.scopeListCache.Set(ctx, scopeListCacheKey, scopes); err != nil {
+		s.logger.Warn(ctx, "Failed to cache the scope list", log.Error(err))
+	}
+	return scopes, nil
+}
+
+// GetScopeByID returns the scope definition with the given ID, using the cache if available.
+func (s *cacheBackedScopeStore) GetScopeByID(ctx context.Context, id string) (Scope, error) {
+	if cached, ok := s.scopeByIDCache.Get(ctx, cache.CacheKey{Key: id}); ok && cached != nil {
+		return *cached, nil
+	}
+
+	scope, err := s.store.GetScopeByID(ctx, id)
+	if err != nil {
+		return Scope{}, err
+	}
+	s.cacheScope(ctx, &scope)
+	return scope, nil
+}
+
+// GetScopeByName returns the scope definition with the given name, using the cache if available.
+func (s *cacheBackedScopeStore) GetScopeByName(ctx context.Context, name string) (Scope, error) {
+	if cached, ok := s.scopeByNameCache.Get(ctx, cache.CacheKey{Key: name}); ok && cached != nil {
+		return *cached, nil
+	}
+
+	scope, err := s.store.GetScopeByName(ctx, name)
+	if err != nil {
+		return Scope{}, err
+	}
+	s.cacheScope(ctx, &scope)
+	return scope, nil
+}
+
+// CreateScope creates the scope definition, caches it and invalidates the scope list.
+func (s *cacheBackedScopeStore) CreateScope(ctx context.Context, scope Scope) error {
+	if err := s.store.CreateScope(ctx, scope); err != nil {
+		return err
+	}
+	s.cacheScope(ctx, &scope)
+	s.invalidateScopeList(ctx)
+	return nil
+}
+
+// UpdateScope updates the scope definition and refreshes the caches. The entry for the previous
+// name is removed so that a renamed scope is no longer found by its old name.
+func (s *cacheBackedScopeStore) UpdateScope(ctx context.Context, scope Scope) error {
+	// Capture the old name before the store call so it can be invalidated on success.
+	oldName := s.getScopeName(ctx, scope.ID)
+
+	if err := s.store.UpdateScope(ctx, scope); err != nil {
+		return err
+	}
+
+	if oldName != "" {
+		s.deleteCacheKey(ctx, s.scopeByNameCache, oldName)
+	}
+	s.cacheScope(ctx, &scope)
+	s.invalidateScopeList(ctx)
+	return nil
+}
+
+// DeleteScope deletes the scope definition and invalidates the caches.
+func (s *cacheBackedScopeStore) DeleteScope(ctx context.Context, id string) error {
+	// Capture the name before the store call so it can be invalidated on success.
+	name := s.getScopeName(ctx, id)
+
+	if err := s.store.DeleteScope(ctx, id); err != nil {
+		return err
+	}
+
+	s.deleteCacheKey(ctx, s.scopeByIDCache, id)
+	if name != "" {
+		s.deleteCacheKey(ctx, s.scopeByNameCache, name)
+	}
+	s.invalidateScopeList(ctx)
+	return nil
+}
+
+// getScopeName returns the current name of the scope with the given ID, or an empty string when
+// the scope cannot be read.
+func (s *cacheBackedScopeStore) getScopeName(ctx context.Context, id string) string {
+	scope, err := s.GetScopeByID(ctx, id)
+	if err != nil {
+		return ""
+	}
+	return scope.Name
+}
+
+// cacheScope caches the scope definition by ID and by name.
+func (s *cacheBackedScopeStore) cacheScope(ctx context.Context, scope *Scope) {
+	if scope.ID != "" {
+		if err := s.scopeByIDCache.Set(ctx, cache.CacheKey{Key: scope.ID}, scope); err != nil {
+			s.logger.Warn(ctx, "Failed to cache scope by ID", log.String("id", scope.ID), log.Error(err))
+		}
+	}
+	if scope.Name != "" {
+		if err := s.scopeByNameCache.Set(ctx, cache.CacheKey{Key: scope.Name}, scope); err != nil {
+			s.logger.Warn(ctx, "Failed to cache scope by name", log.String("name", scope.Name), log.Error(err))
+		}
+	}
+}
+
+// invalidateScopeList removes the cached scope list.
+func (s *cacheBackedScopeStore) invalidateScopeList(ctx context.Context) {
+	if err := s.scopeListCache.Delete(ctx, scopeListCacheKey); err != nil {
+		s.logger.Warn(ctx, "Failed to invalidate the scope list cache", log.Error(err))
+	}
+}
+
+// deleteCacheKey removes the entry with the given key from the cache.
+func (s *cacheBackedScopeStore) deleteCacheKey(ctx context.Context, c cache.CacheInterface[*Scope], key string) {
+	if err := c.Delete(ctx, cache.CacheKey{Key: key}); err != nil {
+		s.logger.Warn(ctx, "Failed to delete scope cache entry", log.String("cache", c.GetName()),
+			log.String("key", key), log.Error(err))
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/tests/mocks/cachemock"
+)
+
+type CacheBackedScopeStoreTestSuite struct {
+	suite.Suite
+	ctx         context.Context
+	mockStore   *scopeStoreInterfaceMock
+	byIDData    map[string]*Scope
+	byNameData  map[string]*Scope
+	listData    map[string][]Scope
+	cachedStore scopeStoreInterface
+}
+
+func TestCacheBackedScopeStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheBackedScopeStoreTestSuite))
+}
+
+func (s *CacheBackedScopeStoreTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockStore = newScopeStoreInterfaceMock(s.T())
+	s.byIDData = make(map[string]*Scope)
+	s.byNameData = make(map[string]*Scope)
+	s.listData = make(map[string][]Scope)
+
+	byIDCache := cachemock.NewCacheInterfaceMock[*Scope](s.T())
+	byNameCache := cachemock.NewCacheInterfaceMock[*Scope](s.T())
+	listCache := cachemock.NewCacheInterfaceMock[[]Scope](s.T())
+	setupScopeCacheMock(byIDCache, s.byIDData)
+	setupScopeCacheMock(byNameCache, s.byNameData)
+	setupScopeCacheMock(listCache, s.listData)
+
+	s.cachedStore = newCacheBackedScopeStore(s.mockStore, byIDCache, byNameCache, listCache)
+}
+
+func setupScopeCacheMock[T any](mockCache *cachemock.CacheInterfaceMock[T], data map[string]T) {
+	mockCache.EXPECT().Set(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, key cache.CacheKey, value T) error {
+			data[key.Key] = value
+			return nil
+		}).Maybe()
+	mockCache.EXPECT().Get(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, key cache.CacheKey) (T, bool) {
+			val, ok := data[key.Key]
+			return val, ok
+		}).Maybe()
+	mockCache.EXPECT().Delete(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, key cache.CacheKey) error {
+			delete(data, key.Key)
+			return nil
+		}).Maybe()
+	mockCache.EXPECT().GetName().Return("mockCache").Maybe()
+}
+
+func (s *CacheBackedScopeStoreTestSuite) TestListScopes_CachesResult() {
+	scopes := []Scope{{ID: "s1", Name: "orders:read"}, {ID: "s2", Name: "orders:write"}}
+	s.mockStore.On("ListScopes", s.ctx).Return(scopes, nil).Once()
+
+	first, err := s.cachedStore.ListScopes(s.ctx)
+	s.Require().NoError(err)
+	second, err := s.cachedStore.ListScopes(s.ctx)
+	s.Require().NoError(err)
+
+	s.Equal(scopes, first)
+	s.Equal(scopes, second)
+}
+
+func (s *CacheBackedScopeStoreTestSuite) TestListScopes_StoreErrorNotCached() {
+	s.mockStore.On("ListScopes", s.ctx).Return(nil, errors.New("db down")).Once()
+
+	_, err := s.cachedStore.ListScopes(s.ctx)
+	s.Error(err)
+	s.Empty(s.listData)
+}
+
+func (s *CacheBackedScopeStoreTestSuite) TestGetScopeByName_CachesByIDAndName() {
+	scope := Scope{ID: "s1", Name: "orders:read"}
+	s.mockStore.On("GetScopeByName", s.ctx, "orders:read").Return(scope, nil).Once()
+
+	result, err := s.cachedStore.GetScopeByName(s.ctx, "orders:read")
+	s.Require().NoError(err)
+	s.Equal(scope, result)
+
+	// Both lookups are now served from the caches.
+	result, err = s.cachedStore.GetScopeByName(s.ctx, "orders:read")
+	s.Require().NoError(err)
+	s.Equal(scope, result)
+	result, err = s.cachedStore.GetScopeByID(s.ctx, "s1")
+	s.Require().NoError(err)
+	s.Equal(scope, result)
+}
+
+func (s *CacheBackedScopeStoreTestSuite) TestGetScopeByID_NotFoundNotCached() {
+	s.mockStore.On("GetScopeByID", s.ctx, "missing").Return(Scope{}, errScopeNotFound).Once()
+
+	_, err := s.cachedStore.GetScopeByID(s.ctx, "missing")
+	s.ErrorIs(err, errScopeNotFound)
+	s.Empty(s.byIDData)
+}
+
+func (s *CacheBackedScopeStoreTestSuite) TestCreateScope_InvalidatesList() {
+	s.listData[scopeListCacheKey.Key] = []Scope{}
+	scope := Scope{ID: "s1", Name: "orders:read"}
+	s.mockStore.On("CreateScope", s.ctx, scope).Return(nil).Once()
+
+	s.Require().NoError(s.cachedStore.CreateScope(s.ctx, scope))
+
+	s.NotContains(s.listData, scopeListCacheKey.Key)
+	s.Equal(&scope, s.byIDData["s1"])
+	s.Equal(&scope, s.byNameData["orders:read"])
+}
+
+func (s *CacheBackedScopeStoreTestSuite) TestUpdateScope_RenameRemovesOldName() {
+	old := Scope{ID: "s1", Name: "orders:read"}
+	s.byIDData["s1"] = &old
+	s.byNameData["orders:read"] = &old
+	s.listData[scopeListCacheKey.Key] = []Scope{old}
+	renamed := Scope{ID: "s1", Name: "orders:view"}
+	s.mockStore.On("UpdateScope", s.ctx, renamed).Return(nil).Once()
+
+	s.Require().NoError(s.cachedStore.UpdateScope(s.ctx, renamed))
+
+	s.NotContains(s.byNameData, "orders:read")
+	s.Equal(&renamed, s.byNameData["orders:view"])
+	s.Equal(&renamed, s.byIDData["s1"])
+	s.NotContains(s.listData, scopeListCacheKey.Key)
+}
+
+func (s *CacheBackedScopeStoreTestSuite) TestUpdateScope_StoreErrorKeepsCache() {
+	old := Scope{ID: "s1", Name: "orders:read"}
+	s.byIDData["s1"] = &old
+	s.byNameData["orders:read"] = &old
+	renamed := Scope{ID: "s1", Name: "orders:view"}
+	s.mockStore.On("UpdateScope", s.ctx, renamed).Return(errScopeNotFound).Once()
+
+	s.ErrorIs(s.cachedStore.UpdateScope(s.ctx, renamed), errScopeNotFound)
+
+	s.Equal(&old, s.byNameData["orders:read"])
+	s.NotContains(s.byNameData, "orders:view")
+}
+
+func (s *CacheBackedScopeStoreTestSuite) TestDeleteScope_InvalidatesCaches() {
+	scope := Scope{ID: "s1", Name: "orders:read"}
+	s.byIDData["s1"] = &scope
+	s.byNameData["orders:read"] = &scope
+	s.listData[scopeListCacheKey.Key] = []Scope{scope}
+	s.mockStore.On("DeleteScope", s.ctx, "s1").Return(nil).Once()
+
+	s.Require().NoError(s.cachedStore.DeleteScope(s.ctx, "s1"))
+
+	s.Empty(s.byIDData)
+	s.Empty(s.byNameData)
+	s.Empty(s.listData)
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package scope provides management of OAuth scope definitions. A scope definition gives a scope
+// requested by applications a display name, a description shown on the consent screen, and the
+// user claims it releases.
+package scope
+
+import "regexp"
+
+const (
+	// loggerComponentName is the component name used for logging in the scope package.
+	loggerComponentName = "ScopeService"
+
+	// maxScopeNameLength is the maximum length of a scope name.
+	maxScopeNameLength = 100
+	// maxScopeDisplayNameLength is the maximum length of a scope display name.
+	maxScopeDisplayNameLength = 255
+	// maxScopeDescriptionLength is the maximum length of a scope description.
+	maxScopeDescriptionLength = 1024
+	// maxScopeClaimLength is the maximum length of a claim name bound to a scope.
+	maxScopeClaimLength = 100
+	// maxScopeRestrictionEntries is the maximum number of roles or organization units in a scope restriction.
+	maxScopeRestrictionEntries = 100
+	// maxScopeRequiredACRs is the maximum number of authentication classes a scope can require.
+	maxScopeRequiredACRs = 20
+	// maxScopeACRLength is the maximum length of an authentication class required by a scope.
+	maxScopeACRLength = 255
+	// maxScopeRequestBodyBytes caps the create and update request bodies; scope definitions are small.
+	maxScopeRequestBodyBytes = 64 << 10 // 64 KiB
+)
+
+// scopeNameRegex validates scope names against the scope-token grammar of RFC 6749 section 3.3:
+// printable ASCII characters except space, double quote and backslash.
+var scopeNameRegex = regexp.MustCompile(`^[\x21\x23-\x5B\x5D-\x7E]+$`)
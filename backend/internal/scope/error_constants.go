@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for scope operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1001",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidScopeName is the error returned when the scope name is missing or malformed.
+	ErrorInvalidScopeName = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1002",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_scope_name",
+			DefaultValue: "Invalid scope name",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key: "error.scopeservice.invalid_scope_name_description",
+			DefaultValue: "The scope name is required, must not exceed 100 characters and must not contain " +
+				"spaces, double quotes or backslashes",
+		},
+	}
+
+	// ErrorInvalidDisplayName is the error returned when the display name is missing or too long.
+	ErrorInvalidDisplayName = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1003",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_display_name",
+			DefaultValue: "Invalid display name",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_display_name_description",
+			DefaultValue: "The display name is required and must not exceed 255 characters",
+		},
+	}
+
+	// ErrorInvalidDescription is the error returned when the description is too long.
+	ErrorInvalidDescription = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1004",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_description",
+			DefaultValue: "Invalid description",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_description_description",
+			DefaultValue: "The description must not exceed 1024 characters",
+		},
+	}
+
+	// ErrorInvalidClaims is the error returned when a claim bound to the scope is empty or too long.
+	ErrorInvalidClaims = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1005",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_claims",
+			DefaultValue: "Invalid claims",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_claims_description",
+			DefaultValue: "Each claim name must be non-empty and must not exceed 100 characters",
+		},
+	}
+
+	// ErrorScopeNotFound is the error returned when the scope definition does not exist.
+	ErrorScopeNotFound = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1006",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.scope_not_found",
+			DefaultValue: "Scope not found",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.scopeservice.scope_not_found_description",
+			DefaultValue: "The scope with the specified id does not exist",
+		},
+	}
+
+	// ErrorScopeAlreadyExists is the error returned when a scope with the same name is already defined.
+	ErrorScopeAlreadyExists = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1007",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.scope_already_exists",
+			DefaultValue: "Scope already exists",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.scopeservice.scope_already_exists_description",
+			DefaultValue: "A scope with the same name is already defined",
+		},
+	}
+
+	// ErrorInvalidRestriction is the error returned when the scope restriction is malformed.
+	ErrorInvalidRestriction = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1008",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_restriction",
+			DefaultValue: "Invalid scope restriction",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key: "error.scopeservice.invalid_restriction_description",
+			DefaultValue: "A scope restriction must list at least one role or organization unit, at most 100 " +
+				"of each, and an enforcement of downscope or reject",
+		},
+	}
+
+	// ErrorRestrictedScopeDenied is the error returned when a restricted scope configured to reject
+	// the request is requested by a subject that does not satisfy the restriction.
+	ErrorRestrictedScopeDenied = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1009",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.restricted_scope_denied",
+			DefaultValue: "Restricted scope denied",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.scopeservice.restricted_scope_denied_description",
+			DefaultValue: "The subject is not allowed to be granted one or more of the requested scopes",
+		},
+	}
+
+	// ErrorInvalidRequiredACRs is the error returned when the required authentication classes of a
+	// scope are invalid.
+	ErrorInvalidRequiredACRs = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SCP-1010",
+		Error: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_required_acrs",
+			DefaultValue: "Invalid required authentication classes",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.scopeservice.invalid_required_acrs_description",
+			DefaultValue: "A scope can require at most 20 non-empty authentication classes of up to 255 characters",
+		},
+	}
+)
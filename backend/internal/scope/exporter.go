@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const (
+	resourceTypeScope = "scope"
+	paramTypeScope    = "Scope"
+)
+
+// scopeExporter implements declarativeresource.ResourceExporter for scope definitions.
+type scopeExporter struct {
+	service ScopeServiceInterface
+}
+
+// newScopeExporter creates a new scope exporter.
+func newScopeExporter(service ScopeServiceInterface) *scopeExporter {
+	return &scopeExporter{service: service}
+}
+
+// GetResourceType returns the resource type for scope definitions.
+func (e *scopeExporter) GetResourceType() string {
+	return resourceTypeScope
+}
+
+// GetParameterizerType returns the parameterizer type for scope definitions.
+func (e *scopeExporter) GetParameterizerType() string {
+	return paramTypeScope
+}
+
+// GetAllResourceIDs returns the IDs of all scope definitions.
+func (e *scopeExporter) GetAllResourceIDs(ctx context.Context) ([]string, *common.ServiceError) {
+	scopes, svcErr := e.service.ListScopes(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	ids := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		ids = append(ids, scope.ID)
+	}
+	return ids, nil
+}
+
+// GetResourceByID retrieves a scope definition by its ID.
+func (e *scopeExporter) GetResourceByID(ctx context.Context, id string) (
+	interface{}, string, *common.ServiceError,
+) {
+	scope, svcErr := e.service.GetScope(ctx, id)
+	if svcErr != nil {
+		return nil, "", svcErr
+	}
+	return scope, scope.Name, nil
+}
+
+// ValidateResource validates a scope definition and extracts its name.
+func (e *scopeExporter) ValidateResource(ctx context.Context,
+	resource interface{}, id string, logger *log.Logger) (string, *declarativeresource.ExportError) {
+	scope, ok := resource.(*Scope)
+	if !ok {
+		return "", declarativeresource.CreateTypeError(resourceTypeScope, id)
+	}
+	if exportErr := declarativeresource.ValidateResourceName(
+		ctx, scope.Name, resourceTypeScope, id, "SCOPE_VALIDATION_ERROR", logger); exportErr != nil {
+		return "", exportErr
+	}
+	return scope.Name, nil
+}
+
+// GetResourceRules returns the parameterization rules; scope definitions carry no parameterized fields.
+func (e *scopeExporter) GetResourceRules() *declarativeresource.ResourceRules {
+	return &declarativeresource.ResourceRules{Variables: []string{}, ArrayVariables: []string{}}
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+func TestScopeExporter_Type(t *testing.T) {
+	exporter := newScopeExporter(NewScopeServiceInterfaceMock(t))
+	assert.Equal(t, "scope", exporter.GetResourceType())
+	assert.Equal(t, "Scope", exporter.GetParameterizerType())
+}
+
+func TestScopeExporter_GetAllResourceIDs(t *testing.T) {
+	service := NewScopeServiceInterfaceMock(t)
+	service.EXPECT().ListScopes(mock.Anything).Return([]Scope{{ID: "s1"}, {ID: "s2"}}, nil)
+
+	ids, svcErr := newScopeExporter(service).GetAllResourceIDs(context.Background())
+	assert.Nil(t, svcErr)
+	assert.Equal(t, []string{"s1", "s2"}, ids)
+}
+
+func TestScopeExporter_GetAllResourceIDs_Error(t *testing.T) {
+	service := NewScopeServiceInterfaceMock(t)
+	service.EXPECT().ListScopes(mock.Anything).Return(nil, &common.InternalServerError)
+
+	_, svcErr := newScopeExporter(service).GetAllResourceIDs(context.Background())
+	assert.Same(t, &common.InternalServerError, svcErr)
+}
+
+func TestScopeExporter_GetResourceByID(t *testing.T) {
+	service := NewScopeServiceInterfaceMock(t)
+	service.EXPECT().GetScope(mock.Anything, "s1").
+		Return(&Scope{ID: "s1", Name: "profile:read", DisplayName: "Read your profile"}, nil)
+
+	resource, name, svcErr := newScopeExporter(service).GetResourceByID(context.Background(), "s1")
+	assert.Nil(t, svcErr)
+	assert.Equal(t, "profile:read", name)
+	assert.Equal(t, "Read your profile", resource.(*Scope).DisplayName)
+}
+
+func TestScopeExporter_GetResourceByID_Error(t *testing.T) {
+	service := NewScopeServiceInterfaceMock(t)
+	service.EXPECT().GetScope(mock.Anything, "s1").Return(nil, &ErrorScopeNotFound)
+
+	_, _, svcErr := newScopeExporter(service).GetResourceByID(context.Background(), "s1")
+	assert.Same(t, &ErrorScopeNotFound, svcErr)
+}
+
+func TestScopeExporter_ValidateResource(t *testing.T) {
+	exporter := newScopeExporter(NewScopeServiceInterfaceMock(t))
+
+	name, exportErr := exporter.ValidateResource(context.Background(),
+		&Scope{ID: "s1", Name: "profile:read"}, "s1", log.GetLogger())
+	assert.Nil(t, exportErr)
+	assert.Equal(t, "profile:read", name)
+}
+
+func TestScopeExporter_ValidateResource_WrongType(t *testing.T) {
+	exporter := newScopeExporter(NewScopeServiceInterfaceMock(t))
+
+	_, exportErr := exporter.ValidateResource(context.Background(), "not a scope", "s1", log.GetLogger())
+	assert.NotNil(t, exportErr)
+}
+
+func TestScopeExporter_ValidateResource_EmptyName(t *testing.T) {
+	exporter := newScopeExporter(NewScopeServiceInterfaceMock(t))
+
+	_, exportErr := exporter.ValidateResource(context.Background(), &Scope{ID: "s1"}, "s1", log.GetLogger())
+	assert.NotNil(t, exportErr)
+}
+
+// TestScopeRequest_YAMLRoundTrip confirms an exported scope decodes back into the request the importer applies.
+func TestScopeRequest_YAMLRoundTrip(t *testing.T) {
+	out, err := yaml.Marshal(&Scope{
+		ID:          "s1",
+		Name:        "payroll:read",
+		DisplayName: "Read payroll",
+		Claims:      []string{"email"},
+		Restriction: &ScopeRestriction{Roles: []string{"payroll-admin"}, Enforcement: RestrictionEnforcementReject},
+	})
+	require.NoError(t, err)
+
+	var req ScopeRequest
+	require.NoError(t, yaml.Unmarshal(out, &req))
+	assert.Equal(t, "payroll:read", req.Name)
+	assert.Equal(t, "Read payroll", req.DisplayName)
+	assert.Equal(t, []string{"email"}, req.Claims)
+	require.NotNil(t, req.Restriction)
+	assert.Equal(t, []string{"payroll-admin"}, req.Restriction.Roles)
+	assert.Equal(t, RestrictionEnforcementReject, req.Restriction.Enforcement)
+}
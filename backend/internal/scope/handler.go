@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// scopeHandler is the handler for scope management operations.
+type scopeHandler struct {
+	scopeService ScopeServiceInterface
+}
+
+// newScopeHandler creates a new instance of scopeHandler.
+func newScopeHandler(scopeService ScopeServiceInterface) *scopeHandler {
+	return &scopeHandler{scopeService: scopeService}
+}
+
+// HandleListScopes handles GET /scopes, returning every scope definition.
+func (h *scopeHandler) HandleListScopes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	scopes, svcErr := h.scopeService.ListScopes(ctx)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, ScopeListResponse{TotalResults: len(scopes), Scopes: scopes})
+}
+
+// HandleCreateScope handles POST /scopes, defining a new scope.
+func (h *scopeHandler) HandleCreateScope(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	request, ok := decodeScopeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	scope, svcErr := h.scopeService.CreateScope(ctx, request)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, scope)
+}
+
+// HandleGetScope handles GET /scopes/{id}, returning a scope definition.
+func (h *scopeHandler) HandleGetScope(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	scope, svcErr := h.scopeService.GetScope(ctx, r.PathValue("id"))
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, scope)
+}
+
+// HandleUpdateScope handles PUT /scopes/{id}, replacing a scope definition.
+func (h *scopeHandler) HandleUpdateScope(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	request, ok := decodeScopeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	scope, svcErr := h.scopeService.UpdateScope(ctx, r.PathValue("id"), request)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, scope)
+}
+
+// HandleDeleteScope handles DELETE /scopes/{id}, deleting a scope definition.
+func (h *scopeHandler) HandleDeleteScope(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if svcErr := h.scopeService.DeleteScope(ctx, r.PathValue("id")); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeScopeRequest decodes the scope request body, writing an error response when it is malformed.
+func decodeScopeRequest(w http.ResponseWriter, r *http.Request) (ScopeRequest, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxScopeRequestBodyBytes)
+	var request ScopeRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handleError(r.Context(), w, &ErrorInvalidRequestFormat)
+		return ScopeRequest{}, false
+	}
+	return request, true
+}
+
+// handleError maps a service error to an HTTP error response.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *common.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == common.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorScopeNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorScopeAlreadyExists.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteProblemResponse(ctx, w, statusCode, errResp)
+}
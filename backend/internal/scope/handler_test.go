@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *ScopeServiceInterfaceMock
+	handler     *scopeHandler
+	mux         *http.ServeMux
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.mockService = NewScopeServiceInterfaceMock(suite.T())
+	suite.handler = newScopeHandler(suite.mockService)
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, suite.handler)
+}
+
+func (suite *HandlerTestSuite) decodeErrorCode(body []byte) string {
+	var errResp struct {
+		Code string `json:"code"`
+	}
+	suite.Require().NoError(json.Unmarshal(body, &errResp))
+	return errResp.Code
+}
+
+func (suite *HandlerTestSuite) TestHandleListScopes_OK() {
+	suite.mockService.EXPECT().ListScopes(mock.Anything).Return([]Scope{testScope("s1", "orders")}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/scopes", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var resp ScopeListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), 1, resp.TotalResults)
+	assert.Equal(suite.T(), "orders", resp.Scopes[0].Name)
+}
+
+func (suite *HandlerTestSuite) TestHandleCreateScope_Created() {
+	suite.mockService.EXPECT().CreateScope(mock.Anything, ScopeRequest{
+		Name: "orders", DisplayName: "Orders", Claims: []string{"order_id"},
+	}).Return(&Scope{ID: "s1", Name: "orders", DisplayName: "Orders", Claims: []string{"order_id"}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/scopes",
+		strings.NewReader(`{"name":"orders","displayName":"Orders","claims":["order_id"]}`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	var resp Scope
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), "s1", resp.ID)
+}
+
+func (suite *HandlerTestSuite) TestHandleCreateScope_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/scopes", strings.NewReader(`{`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Equal(suite.T(), ErrorInvalidRequestFormat.Code, suite.decodeErrorCode(w.Body.Bytes()))
+}
+
+func (suite *HandlerTestSuite) TestHandleCreateScope_Conflict() {
+	suite.mockService.EXPECT().CreateScope(mock.Anything, mock.Anything).Return(nil, &ErrorScopeAlreadyExists)
+
+	req := httptest.NewRequest(http.MethodPost, "/scopes", strings.NewReader(`{"name":"orders"}`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleGetScope_NotFound() {
+	suite.mockService.EXPECT().GetScope(mock.Anything, "s1").Return(nil, &ErrorScopeNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/scopes/s1", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Equal(suite.T(), ErrorScopeNotFound.Code, suite.decodeErrorCode(w.Body.Bytes()))
+}
+
+func (suite *HandlerTestSuite) TestHandleUpdateScope_OK() {
+	suite.mockService.EXPECT().UpdateScope(mock.Anything, "s1", ScopeRequest{Name: "orders", DisplayName: "Orders"}).
+		Return(&Scope{ID: "s1", Name: "orders", DisplayName: "Orders"}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/scopes/s1",
+		strings.NewReader(`{"name":"orders","displayName":"Orders"}`))
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleDeleteScope_NoContent() {
+	suite.mockService.EXPECT().DeleteScope(mock.Anything, "s1").Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/scopes/s1", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleDeleteScope_ServiceError() {
+	suite.mockService.EXPECT().DeleteScope(mock.Anything, "s1").Return(&common.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodDelete, "/scopes/s1", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
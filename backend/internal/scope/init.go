@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize wires the scope store, service, management routes and exporter. The entity provider,
+// role service and organization unit service resolve the subjects that scope restrictions are
+// evaluated against. Scope definitions are cached in the caches of the given cache manager.
+func Initialize(
+	mux *http.ServeMux,
+	cacheManager cache.CacheManagerInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	roleService role.RoleServiceInterface,
+	ouService oupkg.OrganizationUnitServiceInterface,
+) (ScopeServiceInterface, declarativeresource.ResourceExporter) {
+	store := wrapWithCache(newScopeStore(), cacheManager)
+	service := newScopeService(store, entityProvider, roleService, ouService)
+	registerRoutes(mux, newScopeHandler(service))
+	return service, newScopeExporter(service)
+}
+
+// wrapWithCache wraps the given store with a cache-backed store if a cache manager is provided.
+func wrapWithCache(store scopeStoreInterface, cacheManager cache.CacheManagerInterface) scopeStoreInterface {
+	if cacheManager == nil {
+		return store
+	}
+	return newCacheBackedScopeStore(store,
+		cache.GetCache[*Scope](cacheManager, scopeByIDCacheName),
+		cache.GetCache[*Scope](cacheManager, scopeByNameCacheName),
+		cache.GetCache[[]Scope](cacheManager, scopeListCacheName))
+}
+
+// registerRoutes registers the routes for scope management operations.
+func registerRoutes(mux *http.ServeMux, handler *scopeHandler) {
+	noContent := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /scopes", handler.HandleListScopes, listOpts))
+	mux.HandleFunc(middleware.WithCORS("POST /scopes", handler.HandleCreateScope, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /scopes", noContent, listOpts))
+
+	itemOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /scopes/{id}", handler.HandleGetScope, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT /scopes/{id}", handler.HandleUpdateScope, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /scopes/{id}", handler.HandleDeleteScope, itemOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /scopes/{id}", noContent, itemOpts))
+}
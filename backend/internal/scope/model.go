@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+// Scope represents an OAuth scope definition.
+type Scope struct {
+	ID          string            `json:"id"                    yaml:"id"`
+	Name        string            `json:"name"                  yaml:"name"`
+	DisplayName string            `json:"displayName"           yaml:"displayName"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Claims      []string          `json:"claims"                yaml:"claims"`
+	Restriction *ScopeRestriction `json:"restriction,omitempty" yaml:"restriction,omitempty"`
+	// RequiredACRs lists the authentication classes (ACR values) that qualify for the scope. A scope
+	// with required ACRs is granted only when the user authenticated with one of them.
+	RequiredACRs []string `json:"requiredAcrs,omitempty" yaml:"requiredAcrs,omitempty"`
+}
+
+// ScopeRestriction limits who can be granted a scope. A restricted scope is granted only to subjects
+// that hold at least one of the roles or belong to one of the organization units (or their
+// descendants).
+type ScopeRestriction struct {
+	Roles       []string               `json:"roles,omitempty" yaml:"roles,omitempty"`
+	OUs         []string               `json:"ous,omitempty"   yaml:"ous,omitempty"`
+	Enforcement RestrictionEnforcement `json:"enforcement"     yaml:"enforcement"`
+}
+
+// RestrictionEnforcement defines how a request for a restricted scope is handled when the subject
+// does not satisfy the restriction.
+type RestrictionEnforcement string
+
+const (
+	// RestrictionEnforcementDownscope silently removes the scope from the grant.
+	RestrictionEnforcementDownscope RestrictionEnforcement = "downscope"
+	// RestrictionEnforcementReject rejects the whole request.
+	RestrictionEnforcementReject RestrictionEnforcement = "reject"
+)
+
+// ScopeRequest represents the request body for creating or updating a scope definition.
+type ScopeRequest struct {
+	Name        string            `json:"name"                  yaml:"name"`
+	DisplayName string            `json:"displayName"           yaml:"displayName"`
+	Description string            `json:"description"           yaml:"description,omitempty"`
+	Claims      []string          `json:"claims"                yaml:"claims"`
+	Restriction *ScopeRestriction `json:"restriction,omitempty" yaml:"restriction,omitempty"`
+	// RequiredACRs lists the authentication classes (ACR values) that qualify for the scope.
+	RequiredACRs []string `json:"requiredAcrs,omitempty" yaml:"requiredAcrs,omitempty"`
+}
+
+// ScopeListResponse represents the response body for scope definition listings.
+type ScopeListResponse struct {
+	TotalResults int     `json:"totalResults"`
+	Scopes       []Scope `json:"scopes"`
+}
@@ -0,0 +1,440 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// ScopeServiceInterface defines the operations for managing scope definitions.
+type ScopeServiceInterface interface {
+	ListScopes(ctx context.Context) ([]Scope, *common.ServiceError)
+	CreateScope(ctx context.Context, request ScopeRequest) (*Scope, *common.ServiceError)
+	GetScope(ctx context.Context, id string) (*Scope, *common.ServiceError)
+	UpdateScope(ctx context.Context, id string, request ScopeRequest) (*Scope, *common.ServiceError)
+	DeleteScope(ctx context.Context, id string) *common.ServiceError
+	GetScopesByNames(ctx context.Context, names []string) ([]Scope, *common.ServiceError)
+	EnforceScopeRestrictions(ctx context.Context, subjectID string, scopes []string) (
+		[]string, *common.ServiceError)
+	GetRequiredACRs(ctx context.Context, scopes []string) (map[string][]string, *common.ServiceError)
+}
+
+// scopeService is the default implementation of ScopeServiceInterface.
+type scopeService struct {
+	store          scopeStoreInterface
+	entityProvider entityprovider.EntityProviderInterface
+	roleService    role.RoleServiceInterface
+	ouService      oupkg.OrganizationUnitServiceInterface
+	logger         *log.Logger
+}
+
+// newScopeService creates a new instance of scopeService.
+func newScopeService(
+	store scopeStoreInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	roleService role.RoleServiceInterface,
+	ouService oupkg.OrganizationUnitServiceInterface,
+) ScopeServiceInterface {
+	return &scopeService{
+		store:          store,
+		entityProvider: entityProvider,
+		roleService:    roleService,
+		ouService:      ouService,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ListScopes returns all scope definitions ordered by name.
+func (s *scopeService) ListScopes(ctx context.Context) ([]Scope, *common.ServiceError) {
+	scopes, err := s.store.ListScopes(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list scopes", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	return scopes, nil
+}
+
+// CreateScope defines a new scope. Scope names are unique.
+func (s *scopeService) CreateScope(ctx context.Context, request ScopeRequest) (*Scope, *common.ServiceError) {
+	scope, svcErr := buildScope(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if _, err := s.store.GetScopeByName(ctx, scope.Name); err == nil {
+		return nil, &ErrorScopeAlreadyExists
+	} else if !errors.Is(err, errScopeNotFound) {
+		s.logger.Error(ctx, "Failed to get scope by name", log.String("name", scope.Name), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate scope ID", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	scope.ID = id
+
+	if err := s.store.CreateScope(ctx, scope); err != nil {
+		s.logger.Error(ctx, "Failed to create scope", log.String("name", scope.Name), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Created scope", log.String("id", scope.ID), log.String("name", scope.Name))
+	return &scope, nil
+}
+
+// GetScope returns the scope definition with the given ID.
+func (s *scopeService) GetScope(ctx context.Context, id string) (*Scope, *common.ServiceError) {
+	scope, err := s.store.GetScopeByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, errScopeNotFound) {
+			return nil, &ErrorScopeNotFound
+		}
+		s.logger.Error(ctx, "Failed to get scope", log.String("id", id), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	return &scope, nil
+}
+
+// UpdateScope replaces the scope definition with the given ID. Renaming a scope to the name of
+// another defined scope is rejected.
+func (s *scopeService) UpdateScope(
+	ctx context.Context, id string, request ScopeRequest) (*Scope, *common.ServiceError) {
+	scope, svcErr := buildScope(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	scope.ID = id
+
+	existing, err := s.store.GetScopeByName(ctx, scope.Name)
+	if err == nil && existing.ID != id {
+		return nil, &ErrorScopeAlreadyExists
+	} else if err != nil && !errors.Is(err, errScopeNotFound) {
+		s.logger.Error(ctx, "Failed to get scope by name", log.String("name", scope.Name), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	if err := s.store.UpdateScope(ctx, scope); err != nil {
+		if errors.Is(err, errScopeNotFound) {
+			return nil, &ErrorScopeNotFound
+		}
+		s.logger.Error(ctx, "Failed to update scope", log.String("id", id), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Updated scope", log.String("id", id), log.String("name", scope.Name))
+	return &scope, nil
+}
+
+// DeleteScope deletes the scope definition with the given ID. Applications that list the scope
+// keep it as a free-form scope without a definition.
+func (s *scopeService) DeleteScope(ctx context.Context, id string) *common.ServiceError {
+	if err := s.store.DeleteScope(ctx, id); err != nil {
+		if errors.Is(err, errScopeNotFound) {
+			return &ErrorScopeNotFound
+		}
+		s.logger.Error(ctx, "Failed to delete scope", log.String("id", id), log.Error(err))
+		return &common.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Deleted scope", log.String("id", id))
+	return nil
+}
+
+// GetScopesByNames returns the definitions of the given scope names, in the order the names are
+// given. Names without a definition are skipped.
+func (s *scopeService) GetScopesByNames(ctx context.Context, names []string) ([]Scope, *common.ServiceError) {
+	if len(names) == 0 {
+		return []Scope{}, nil
+	}
+
+	scopes, err := s.store.ListScopes(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list scopes", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	byName := make(map[string]Scope, len(scopes))
+	for _, scope := range scopes {
+		byName[scope.Name] = scope
+	}
+
+	result := make([]Scope, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		scope, ok := byName[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		result = append(result, scope)
+	}
+	return result, nil
+}
+
+// EnforceScopeRestrictions returns the scopes from the given list that the subject may be granted.
+// Restricted scopes are granted only when the subject holds one of the restriction roles or belongs
+// to one of the restriction organization units or their descendants. A restricted scope the subject
+// does not qualify for is removed from the result, or, when its restriction enforcement is reject,
+// fails the whole request with ErrorRestrictedScopeDenied. An empty subject ID qualifies for no
+// restricted scope.
+func (s *scopeService) EnforceScopeRestrictions(
+	ctx context.Context, subjectID string, scopes []string) ([]string, *common.ServiceError) {
+	definitions, svcErr := s.GetScopesByNames(ctx, scopes)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	restrictions := make(map[string]*ScopeRestriction)
+	for _, definition := range definitions {
+		if definition.Restriction != nil {
+			restrictions[definition.Name] = definition.Restriction
+		}
+	}
+	if len(restrictions) == 0 {
+		return scopes, nil
+	}
+
+	subject, svcErr := s.resolveRestrictionSubject(ctx, subjectID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	granted := make([]string, 0, len(scopes))
+	denied := make([]string, 0)
+	for _, name := range scopes {
+		restriction, ok := restrictions[name]
+		if !ok {
+			granted = append(granted, name)
+			continue
+		}
+
+		allowed, svcErr := s.satisfiesRestriction(ctx, subject, restriction)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		if allowed {
+			granted = append(granted, name)
+			continue
+		}
+		if restriction.Enforcement == RestrictionEnforcementReject {
+			s.logger.Debug(ctx, "Rejected request for a restricted scope", log.String("scope", name))
+			return nil, &ErrorRestrictedScopeDenied
+		}
+		denied = append(denied, name)
+	}
+
+	if len(denied) > 0 {
+		s.logger.Debug(ctx, "Removed restricted scopes from the grant", log.Any("scopes", denied))
+	}
+	return granted, nil
+}
+
+// GetRequiredACRs returns the required authentication classes of the given scopes, keyed by scope
+// name. Scopes without required ACRs and undefined scopes are omitted.
+func (s *scopeService) GetRequiredACRs(
+	ctx context.Context, scopes []string) (map[string][]string, *common.ServiceError) {
+	definitions, svcErr := s.GetScopesByNames(ctx, scopes)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	requiredACRs := make(map[string][]string)
+	for _, definition := range definitions {
+		if len(definition.RequiredACRs) > 0 {
+			requiredACRs[definition.Name] = definition.RequiredACRs
+		}
+	}
+	return requiredACRs, nil
+}
+
+// restrictionSubject holds the attributes of a subject that scope restrictions are evaluated against.
+type restrictionSubject struct {
+	ouID  string
+	roles []string
+}
+
+// resolveRestrictionSubject loads the organization unit and the roles, held directly or through
+// groups, of the subject.
+func (s *scopeService) resolveRestrictionSubject(
+	ctx context.Context, subjectID string) (restrictionSubject, *common.ServiceError) {
+	if subjectID == "" {
+		return restrictionSubject{}, nil
+	}
+
+	entity, err := s.entityProvider.GetEntity(subjectID)
+	if err != nil {
+		if err.Code == entityprovider.ErrorCodeEntityNotFound {
+			return restrictionSubject{}, nil
+		}
+		s.logger.Error(ctx, "Failed to get the subject of a scope restriction",
+			log.MaskedString(log.LoggerKeyUserID, subjectID), log.Any("error", err))
+		return restrictionSubject{}, &common.InternalServerError
+	}
+
+	groups, err := s.entityProvider.GetTransitiveEntityGroups(subjectID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get the groups of a scope restriction subject",
+			log.MaskedString(log.LoggerKeyUserID, subjectID), log.Any("error", err))
+		return restrictionSubject{}, &common.InternalServerError
+	}
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+
+	roles, svcErr := s.roleService.GetUserRoles(ctx, subjectID, groupIDs)
+	if svcErr != nil {
+		s.logger.Error(ctx, "Failed to get the roles of a scope restriction subject",
+			log.MaskedString(log.LoggerKeyUserID, subjectID), log.Any("error", svcErr))
+		return restrictionSubject{}, &common.InternalServerError
+	}
+
+	return restrictionSubject{ouID: entity.OUID, roles: roles}, nil
+}
+
+// satisfiesRestriction reports whether the subject holds one of the restriction roles or belongs to
+// one of the restriction organization units or their descendants.
+func (s *scopeService) satisfiesRestriction(
+	ctx context.Context, subject restrictionSubject, restriction *ScopeRestriction) (bool, *common.ServiceError) {
+	for _, roleName := range restriction.Roles {
+		if slices.Contains(subject.roles, roleName) {
+			return true, nil
+		}
+	}
+	if subject.ouID == "" {
+		return false, nil
+	}
+	for _, ouID := range restriction.OUs {
+		isParent, svcErr := s.ouService.IsParent(ctx, ouID, subject.ouID)
+		if svcErr != nil {
+			s.logger.Error(ctx, "Failed to check the organization unit of a scope restriction",
+				log.String("ouId", ouID), log.Any("error", svcErr))
+			return false, &common.InternalServerError
+		}
+		if isParent {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// buildScope validates a create or update request and converts it into a scope definition.
+func buildScope(request ScopeRequest) (Scope, *common.ServiceError) {
+	scope := Scope{
+		Name:        strings.TrimSpace(request.Name),
+		DisplayName: strings.TrimSpace(request.DisplayName),
+		Description: strings.TrimSpace(request.Description),
+		Claims:      make([]string, 0, len(request.Claims)),
+	}
+
+	if len(scope.Name) > maxScopeNameLength || !scopeNameRegex.MatchString(scope.Name) {
+		return Scope{}, &ErrorInvalidScopeName
+	}
+	if scope.DisplayName == "" || len(scope.DisplayName) > maxScopeDisplayNameLength {
+		return Scope{}, &ErrorInvalidDisplayName
+	}
+	if len(scope.Description) > maxScopeDescriptionLength {
+		return Scope{}, &ErrorInvalidDescription
+	}
+	for _, claim := range request.Claims {
+		claim = strings.TrimSpace(claim)
+		if claim == "" || len(claim) > maxScopeClaimLength {
+			return Scope{}, &ErrorInvalidClaims
+		}
+		if !slices.Contains(scope.Claims, claim) {
+			scope.Claims = append(scope.Claims, claim)
+		}
+	}
+
+	if request.Restriction != nil {
+		restriction, svcErr := buildScopeRestriction(*request.Restriction)
+		if svcErr != nil {
+			return Scope{}, svcErr
+		}
+		scope.Restriction = restriction
+	}
+
+	if len(request.RequiredACRs) > maxScopeRequiredACRs {
+		return Scope{}, &ErrorInvalidRequiredACRs
+	}
+	for _, acr := range request.RequiredACRs {
+		acr = strings.TrimSpace(acr)
+		if acr == "" || len(acr) > maxScopeACRLength {
+			return Scope{}, &ErrorInvalidRequiredACRs
+		}
+		if !slices.Contains(scope.RequiredACRs, acr) {
+			scope.RequiredACRs = append(scope.RequiredACRs, acr)
+		}
+	}
+	return scope, nil
+}
+
+// buildScopeRestriction validates a scope restriction, trims and deduplicates its roles and
+// organization units, and defaults the enforcement to downscope.
+func buildScopeRestriction(request ScopeRestriction) (*ScopeRestriction, *common.ServiceError) {
+	restriction := &ScopeRestriction{
+		Roles:       make([]string, 0, len(request.Roles)),
+		OUs:         make([]string, 0, len(request.OUs)),
+		Enforcement: request.Enforcement,
+	}
+	for _, entries := range []struct {
+		values []string
+		target *[]string
+	}{
+		{request.Roles, &restriction.Roles},
+		{request.OUs, &restriction.OUs},
+	} {
+		for _, value := range entries.values {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return nil, &ErrorInvalidRestriction
+			}
+			if !slices.Contains(*entries.target, value) {
+				*entries.target = append(*entries.target, value)
+			}
+		}
+		if len(*entries.target) > maxScopeRestrictionEntries {
+			return nil, &ErrorInvalidRestriction
+		}
+	}
+
+	if len(restriction.Roles) == 0 && len(restriction.OUs) == 0 {
+		return nil, &ErrorInvalidRestriction
+	}
+	switch restriction.Enforcement {
+	case "":
+		restriction.Enforcement = RestrictionEnforcementDownscope
+	case RestrictionEnforcementDownscope, RestrictionEnforcementReject:
+	default:
+		return nil, &ErrorInvalidRestriction
+	}
+	return restriction, nil
+}
@@ -0,0 +1,399 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	mockStore          *scopeStoreInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockRoleService    *rolemock.RoleServiceInterfaceMock
+	mockOUService      *oumock.OrganizationUnitServiceInterfaceMock
+	service            ScopeServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStore = newScopeStoreInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockRoleService = rolemock.NewRoleServiceInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.service = newScopeService(suite.mockStore, suite.mockEntityProvider, suite.mockRoleService,
+		suite.mockOUService)
+}
+
+func testScope(id, name string) Scope {
+	return Scope{ID: id, Name: name, DisplayName: "Display " + name, Claims: []string{}}
+}
+
+// --- CreateScope ---
+
+func (suite *ServiceTestSuite) TestCreateScope_Success() {
+	suite.mockStore.On("GetScopeByName", mock.Anything, "orders").Return(Scope{}, errScopeNotFound)
+	suite.mockStore.On("CreateScope", mock.Anything, mock.MatchedBy(func(s Scope) bool {
+		return s.ID != "" && s.Name == "orders" && s.DisplayName == "Orders" &&
+			len(s.Claims) == 2 && s.Claims[0] == "order_id" && s.Claims[1] == "region"
+	})).Return(nil)
+
+	scope, svcErr := suite.service.CreateScope(suite.ctx, ScopeRequest{
+		Name: " orders ", DisplayName: "Orders", Description: "View your orders",
+		Claims: []string{"order_id", " region", "order_id"},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal("orders", scope.Name)
+	suite.Equal("View your orders", scope.Description)
+	suite.Equal([]string{"order_id", "region"}, scope.Claims)
+}
+
+func (suite *ServiceTestSuite) TestCreateScope_Validation() {
+	cases := []struct {
+		name     string
+		request  ScopeRequest
+		expected string
+	}{
+		{"MissingName", ScopeRequest{DisplayName: "Orders"}, ErrorInvalidScopeName.Code},
+		{"NameWithSpace", ScopeRequest{Name: "read orders", DisplayName: "Orders"}, ErrorInvalidScopeName.Code},
+		{"NameWithQuote", ScopeRequest{Name: `read"`, DisplayName: "Orders"}, ErrorInvalidScopeName.Code},
+		{"NameTooLong", ScopeRequest{Name: strings.Repeat("a", 101), DisplayName: "Orders"},
+			ErrorInvalidScopeName.Code},
+		{"MissingDisplayName", ScopeRequest{Name: "orders"}, ErrorInvalidDisplayName.Code},
+		{"DescriptionTooLong", ScopeRequest{Name: "orders", DisplayName: "Orders",
+			Description: strings.Repeat("a", 1025)}, ErrorInvalidDescription.Code},
+		{"EmptyClaim", ScopeRequest{Name: "orders", DisplayName: "Orders", Claims: []string{" "}},
+			ErrorInvalidClaims.Code},
+		{"EmptyRestriction", ScopeRequest{Name: "orders", DisplayName: "Orders",
+			Restriction: &ScopeRestriction{}}, ErrorInvalidRestriction.Code},
+		{"BlankRestrictionRole", ScopeRequest{Name: "orders", DisplayName: "Orders",
+			Restriction: &ScopeRestriction{Roles: []string{" "}}}, ErrorInvalidRestriction.Code},
+		{"UnknownEnforcement", ScopeRequest{Name: "orders", DisplayName: "Orders",
+			Restriction: &ScopeRestriction{Roles: []string{"admin"}, Enforcement: "deny"}},
+			ErrorInvalidRestriction.Code},
+		{"BlankRequiredACR", ScopeRequest{Name: "orders", DisplayName: "Orders",
+			RequiredACRs: []string{" "}}, ErrorInvalidRequiredACRs.Code},
+		{"TooManyRequiredACRs", ScopeRequest{Name: "orders", DisplayName: "Orders",
+			RequiredACRs: make([]string, 21)}, ErrorInvalidRequiredACRs.Code},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			_, svcErr := suite.service.CreateScope(suite.ctx, tc.request)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestCreateScope_AlreadyExists() {
+	suite.mockStore.On("GetScopeByName", mock.Anything, "orders").Return(testScope("s1", "orders"), nil)
+
+	_, svcErr := suite.service.CreateScope(suite.ctx, ScopeRequest{Name: "orders", DisplayName: "Orders"})
+
+	suite.Equal(ErrorScopeAlreadyExists.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestCreateScope_StoreError() {
+	suite.mockStore.On("GetScopeByName", mock.Anything, "orders").Return(Scope{}, errScopeNotFound)
+	suite.mockStore.On("CreateScope", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	_, svcErr := suite.service.CreateScope(suite.ctx, ScopeRequest{Name: "orders", DisplayName: "Orders"})
+
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
+
+// --- GetScope ---
+
+func (suite *ServiceTestSuite) TestGetScope() {
+	suite.mockStore.On("GetScopeByID", mock.Anything, "s1").Return(testScope("s1", "orders"), nil)
+
+	scope, svcErr := suite.service.GetScope(suite.ctx, "s1")
+
+	suite.Nil(svcErr)
+	suite.Equal("orders", scope.Name)
+}
+
+func (suite *ServiceTestSuite) TestGetScope_NotFound() {
+	suite.mockStore.On("GetScopeByID", mock.Anything, "s1").Return(Scope{}, errScopeNotFound)
+
+	_, svcErr := suite.service.GetScope(suite.ctx, "s1")
+
+	suite.Equal(ErrorScopeNotFound.Code, svcErr.Code)
+}
+
+// --- UpdateScope ---
+
+func (suite *ServiceTestSuite) TestUpdateScope_SameName() {
+	suite.mockStore.On("GetScopeByName", mock.Anything, "orders").Return(testScope("s1", "orders"), nil)
+	suite.mockStore.On("UpdateScope", mock.Anything, mock.MatchedBy(func(s Scope) bool {
+		return s.ID == "s1" && s.DisplayName == "Your orders"
+	})).Return(nil)
+
+	scope, svcErr := suite.service.UpdateScope(suite.ctx, "s1",
+		ScopeRequest{Name: "orders", DisplayName: "Your orders"})
+
+	suite.Nil(svcErr)
+	suite.Equal("s1", scope.ID)
+}
+
+func (suite *ServiceTestSuite) TestUpdateScope_NameTakenByAnotherScope() {
+	suite.mockStore.On("GetScopeByName", mock.Anything, "orders").Return(testScope("s2", "orders"), nil)
+
+	_, svcErr := suite.service.UpdateScope(suite.ctx, "s1", ScopeRequest{Name: "orders", DisplayName: "Orders"})
+
+	suite.Equal(ErrorScopeAlreadyExists.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestUpdateScope_NotFound() {
+	suite.mockStore.On("GetScopeByName", mock.Anything, "orders").Return(Scope{}, errScopeNotFound)
+	suite.mockStore.On("UpdateScope", mock.Anything, mock.Anything).Return(errScopeNotFound)
+
+	_, svcErr := suite.service.UpdateScope(suite.ctx, "s1", ScopeRequest{Name: "orders", DisplayName: "Orders"})
+
+	suite.Equal(ErrorScopeNotFound.Code, svcErr.Code)
+}
+
+// --- DeleteScope ---
+
+func (suite *ServiceTestSuite) TestDeleteScope() {
+	suite.mockStore.On("DeleteScope", mock.Anything, "s1").Return(nil)
+
+	suite.Nil(suite.service.DeleteScope(suite.ctx, "s1"))
+}
+
+func (suite *ServiceTestSuite) TestDeleteScope_NotFound() {
+	suite.mockStore.On("DeleteScope", mock.Anything, "s1").Return(errScopeNotFound)
+
+	svcErr := suite.service.DeleteScope(suite.ctx, "s1")
+
+	suite.Equal(ErrorScopeNotFound.Code, svcErr.Code)
+}
+
+// --- GetScopesByNames ---
+
+func (suite *ServiceTestSuite) TestGetScopesByNames_KeepsRequestOrderAndSkipsUndefined() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{
+		testScope("s1", "billing"), testScope("s2", "orders"),
+	}, nil)
+
+	scopes, svcErr := suite.service.GetScopesByNames(suite.ctx, []string{"orders", "openid", "billing", "orders"})
+
+	suite.Nil(svcErr)
+	suite.Len(scopes, 2)
+	suite.Equal("orders", scopes[0].Name)
+	suite.Equal("billing", scopes[1].Name)
+}
+
+func (suite *ServiceTestSuite) TestGetScopesByNames_NoNames() {
+	scopes, svcErr := suite.service.GetScopesByNames(suite.ctx, nil)
+
+	suite.Nil(svcErr)
+	suite.Empty(scopes)
+}
+
+func (suite *ServiceTestSuite) TestGetScopesByNames_StoreError() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return(nil, errors.New("db error"))
+
+	_, svcErr := suite.service.GetScopesByNames(suite.ctx, []string{"orders"})
+
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestCreateScope_RestrictionDefaultsToDownscope() {
+	suite.mockStore.On("GetScopeByName", mock.Anything, "orders").Return(Scope{}, errScopeNotFound)
+	suite.mockStore.On("CreateScope", mock.Anything, mock.Anything).Return(nil)
+
+	scope, svcErr := suite.service.CreateScope(suite.ctx, ScopeRequest{
+		Name: "orders", DisplayName: "Orders",
+		Restriction: &ScopeRestriction{Roles: []string{"admin", " admin"}, OUs: []string{"ou-1"}},
+	})
+
+	suite.Nil(svcErr)
+	suite.Require().NotNil(scope.Restriction)
+	suite.Equal([]string{"admin"}, scope.Restriction.Roles)
+	suite.Equal([]string{"ou-1"}, scope.Restriction.OUs)
+	suite.Equal(RestrictionEnforcementDownscope, scope.Restriction.Enforcement)
+}
+
+func (suite *ServiceTestSuite) TestCreateScope_RequiredACRsTrimmedAndDeduplicated() {
+	suite.mockStore.On("GetScopeByName", mock.Anything, "payments").Return(Scope{}, errScopeNotFound)
+	suite.mockStore.On("CreateScope", mock.Anything, mock.Anything).Return(nil)
+
+	scope, svcErr := suite.service.CreateScope(suite.ctx, ScopeRequest{
+		Name: "payments", DisplayName: "Payments", RequiredACRs: []string{"mfa", " hwk", "mfa "},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"mfa", "hwk"}, scope.RequiredACRs)
+}
+
+// --- GetRequiredACRs ---
+
+func (suite *ServiceTestSuite) TestGetRequiredACRs() {
+	payments := testScope("s2", "payments")
+	payments.RequiredACRs = []string{"mfa"}
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{testScope("s1", "orders"), payments}, nil)
+
+	requiredACRs, svcErr := suite.service.GetRequiredACRs(suite.ctx, []string{"openid", "orders", "payments"})
+
+	suite.Nil(svcErr)
+	suite.Equal(map[string][]string{"payments": {"mfa"}}, requiredACRs)
+}
+
+func (suite *ServiceTestSuite) TestGetRequiredACRs_StoreError() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return(nil, errors.New("db error"))
+
+	_, svcErr := suite.service.GetRequiredACRs(suite.ctx, []string{"payments"})
+
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
+
+// --- EnforceScopeRestrictions ---
+
+func restrictedScope(id, name string, restriction ScopeRestriction) Scope {
+	scope := testScope(id, name)
+	scope.Restriction = &restriction
+	return scope
+}
+
+func (suite *ServiceTestSuite) mockSubject(ouID string, roles []string) {
+	suite.mockEntityProvider.On("GetEntity", "user-1").Return(&providers.Entity{ID: "user-1", OUID: ouID}, nil)
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "user-1").
+		Return([]providers.EntityGroup{{ID: "group-1"}}, nil)
+	suite.mockRoleService.On("GetUserRoles", mock.Anything, "user-1", []string{"group-1"}).Return(roles, nil)
+}
+
+func (suite *ServiceTestSuite) TestEnforceScopeRestrictions_NoRestrictedScopes() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{testScope("s1", "orders")}, nil)
+
+	scopes, svcErr := suite.service.EnforceScopeRestrictions(suite.ctx, "user-1", []string{"openid", "orders"})
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"openid", "orders"}, scopes)
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "GetEntity", mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestEnforceScopeRestrictions_GrantedByRole() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{
+		restrictedScope("s1", "admin:write", ScopeRestriction{
+			Roles: []string{"admin"}, Enforcement: RestrictionEnforcementReject,
+		}),
+	}, nil)
+	suite.mockSubject("ou-1", []string{"admin"})
+
+	scopes, svcErr := suite.service.EnforceScopeRestrictions(suite.ctx, "user-1", []string{"openid", "admin:write"})
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"openid", "admin:write"}, scopes)
+}
+
+func (suite *ServiceTestSuite) TestEnforceScopeRestrictions_GrantedByParentOU() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{
+		restrictedScope("s1", "payroll", ScopeRestriction{
+			OUs: []string{"ou-finance"}, Enforcement: RestrictionEnforcementDownscope,
+		}),
+	}, nil)
+	suite.mockSubject("ou-payables", nil)
+	suite.mockOUService.On("IsParent", mock.Anything, "ou-finance", "ou-payables").Return(true, nil)
+
+	scopes, svcErr := suite.service.EnforceScopeRestrictions(suite.ctx, "user-1", []string{"payroll"})
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"payroll"}, scopes)
+}
+
+func (suite *ServiceTestSuite) TestEnforceScopeRestrictions_Downscopes() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{
+		restrictedScope("s1", "payroll", ScopeRestriction{
+			Roles: []string{"hr"}, OUs: []string{"ou-finance"}, Enforcement: RestrictionEnforcementDownscope,
+		}),
+	}, nil)
+	suite.mockSubject("ou-sales", []string{"sales"})
+	suite.mockOUService.On("IsParent", mock.Anything, "ou-finance", "ou-sales").Return(false, nil)
+
+	scopes, svcErr := suite.service.EnforceScopeRestrictions(suite.ctx, "user-1", []string{"openid", "payroll"})
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"openid"}, scopes)
+}
+
+func (suite *ServiceTestSuite) TestEnforceScopeRestrictions_Rejects() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{
+		restrictedScope("s1", "admin:write", ScopeRestriction{
+			Roles: []string{"admin"}, Enforcement: RestrictionEnforcementReject,
+		}),
+	}, nil)
+	suite.mockSubject("ou-1", []string{"viewer"})
+
+	_, svcErr := suite.service.EnforceScopeRestrictions(suite.ctx, "user-1", []string{"admin:write"})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRestrictedScopeDenied.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestEnforceScopeRestrictions_UnknownSubject() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{
+		restrictedScope("s1", "admin:write", ScopeRestriction{
+			Roles: []string{"admin"}, Enforcement: RestrictionEnforcementDownscope,
+		}),
+	}, nil)
+	suite.mockEntityProvider.On("GetEntity", "external-sub").Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""))
+
+	scopes, svcErr := suite.service.EnforceScopeRestrictions(suite.ctx, "external-sub", []string{"admin:write"})
+
+	suite.Nil(svcErr)
+	suite.Empty(scopes)
+}
+
+func (suite *ServiceTestSuite) TestEnforceScopeRestrictions_RoleLookupError() {
+	suite.mockStore.On("ListScopes", mock.Anything).Return([]Scope{
+		restrictedScope("s1", "admin:write", ScopeRestriction{
+			Roles: []string{"admin"}, Enforcement: RestrictionEnforcementDownscope,
+		}),
+	}, nil)
+	suite.mockEntityProvider.On("GetEntity", "user-1").Return(&providers.Entity{ID: "user-1"}, nil)
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "user-1").Return([]providers.EntityGroup{}, nil)
+	suite.mockRoleService.On("GetUserRoles", mock.Anything, "user-1", []string{}).
+		Return(nil, &common.InternalServerError)
+
+	_, svcErr := suite.service.EnforceScopeRestrictions(suite.ctx, "user-1", []string{"admin:write"})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(common.InternalServerError.Code, svcErr.Code)
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// errScopeNotFound is returned by the store when the scope definition does not exist.
+var errScopeNotFound = errors.New("scope not found")
+
+// scopeStoreInterface defines the persistence operations for scope definitions.
+type scopeStoreInterface interface {
+	ListScopes(ctx context.Context) ([]Scope, error)
+	GetScopeByID(ctx context.Context, id string) (Scope, error)
+	GetScopeByName(ctx context.Context, name string) (Scope, error)
+	CreateScope(ctx context.Context, scope Scope) error
+	UpdateScope(ctx context.Context, scope Scope) error
+	DeleteScope(ctx context.Context, id string) error
+}
+
+// scopeStore is the database-backed scope store. Scope definitions live in the configuration database.
+type scopeStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newScopeStore creates a new instance of scopeStore.
+func newScopeStore() scopeStoreInterface {
+	return &scopeStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// ListScopes returns all scope definitions ordered by name.
+func (s *scopeStore) ListScopes(ctx context.Context) ([]Scope, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListScopes, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scopes: %w", err)
+	}
+
+	scopes := make([]Scope, 0, len(results))
+	for _, row := range results {
+		scope, err := buildScopeFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+// GetScopeByID returns the scope definition with the given ID.
+func (s *scopeStore) GetScopeByID(ctx context.Context, id string) (Scope, error) {
+	return s.getScope(ctx, queryGetScopeByID, id)
+}
+
+// GetScopeByName returns the scope definition with the given name.
+func (s *scopeStore) GetScopeByName(ctx context.Context, name string) (Scope, error) {
+	return s.getScope(ctx, queryGetScopeByName, name)
+}
+
+// CreateScope persists a new scope definition.
+func (s *scopeStore) CreateScope(ctx context.Context, scope Scope) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	claims, restriction, requiredACRs, err := marshalScopeColumns(scope)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateScope, scope.ID, scope.Name, scope.DisplayName,
+		scope.Description, claims, restriction, requiredACRs, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to create scope: %w", err)
+	}
+	return nil
+}
+
+// UpdateScope updates an existing scope definition.
+func (s *scopeStore) UpdateScope(ctx context.Context, scope Scope) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	claims, restriction, requiredACRs, err := marshalScopeColumns(scope)
+	if err != nil {
+		return err
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryUpdateScope, scope.ID, scope.Name, scope.DisplayName,
+		scope.Description, claims, restriction, requiredACRs, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update scope: %w", err)
+	}
+	if rows == 0 {
+		return errScopeNotFound
+	}
+	return nil
+}
+
+// DeleteScope deletes a scope definition.
+func (s *scopeStore) DeleteScope(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteScope, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete scope: %w", err)
+	}
+	if rows == 0 {
+		return errScopeNotFound
+	}
+	return nil
+}
+
+// getScope runs a single-scope lookup query keyed by the given value.
+func (s *scopeStore) getScope(ctx context.Context, query dbmodel.DBQuery, key string) (Scope, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return Scope{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, key, s.deploymentID)
+	if err != nil {
+		return Scope{}, fmt.Errorf("failed to get scope: %w", err)
+	}
+	if len(results) == 0 {
+		return Scope{}, errScopeNotFound
+	}
+	return buildScopeFromRow(results[0])
+}
+
+// buildScopeFromRow converts a database result row into a scope definition.
+func buildScopeFromRow(row map[string]interface{}) (Scope, error) {
+	var scope Scope
+	for column, target := range map[string]*string{
+		"id":           &scope.ID,
+		"name":         &scope.Name,
+		"display_name": &scope.DisplayName,
+	} {
+		value, ok := row[column].(string)
+		if !ok {
+			return Scope{}, fmt.Errorf("failed to parse %s as string", column)
+		}
+		*target = value
+	}
+	if description, ok := row["description"].(string); ok {
+		scope.Description = description
+	}
+
+	scope.Claims = []string{}
+	if claims := textColumn(row["claims"]); claims != "" {
+		if err := json.Unmarshal([]byte(claims), &scope.Claims); err != nil {
+			return Scope{}, fmt.Errorf("failed to parse scope claims: %w", err)
+		}
+	}
+	if restriction := textColumn(row["restriction"]); restriction != "" {
+		scope.Restriction = &ScopeRestriction{}
+		if err := json.Unmarshal([]byte(restriction), scope.Restriction); err != nil {
+			return Scope{}, fmt.Errorf("failed to parse scope restriction: %w", err)
+		}
+	}
+	if requiredACRs := textColumn(row["required_acrs"]); requiredACRs != "" {
+		if err := json.Unmarshal([]byte(requiredACRs), &scope.RequiredACRs); err != nil {
+			return Scope{}, fmt.Errorf("failed to parse scope required ACRs: %w", err)
+		}
+	}
+	return scope, nil
+}
+
+// marshalScopeColumns serializes the JSON columns of a scope definition. An unrestricted scope
+// stores a NULL restriction, and a scope without required ACRs stores NULL required ACRs.
+func marshalScopeColumns(scope Scope) (string, interface{}, interface{}, error) {
+	claims, err := json.Marshal(scope.Claims)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to marshal scope claims: %w", err)
+	}
+	var restriction, requiredACRs interface{}
+	if scope.Restriction != nil {
+		data, err := json.Marshal(scope.Restriction)
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("failed to marshal scope restriction: %w", err)
+		}
+		restriction = string(data)
+	}
+	if len(scope.RequiredACRs) > 0 {
+		data, err := json.Marshal(scope.RequiredACRs)
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("failed to marshal scope required ACRs: %w", err)
+		}
+		requiredACRs = string(data)
+	}
+	return string(claims), restriction, requiredACRs, nil
+}
+
+// textColumn returns the value of a text column, which drivers return either as a string or as bytes.
+func textColumn(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+	return ""
+}
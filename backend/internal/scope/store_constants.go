@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+const (
+	// scopeColumns is the column list selected for scope definitions.
+	scopeColumns = `ID, NAME, DISPLAY_NAME, DESCRIPTION, CLAIMS, RESTRICTION, REQUIRED_ACRS`
+)
+
+var (
+	// queryListScopes retrieves all scope definitions ordered by name.
+	queryListScopes = dbmodel.DBQuery{
+		ID:    "SCPQ-SCOPE_MGT-01",
+		Query: `SELECT ` + scopeColumns + ` FROM "OAUTH_SCOPE" WHERE DEPLOYMENT_ID = $1 ORDER BY NAME`,
+	}
+
+	// queryGetScopeByID retrieves a scope definition by its ID.
+	queryGetScopeByID = dbmodel.DBQuery{
+		ID:    "SCPQ-SCOPE_MGT-02",
+		Query: `SELECT ` + scopeColumns + ` FROM "OAUTH_SCOPE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetScopeByName retrieves a scope definition by its name.
+	queryGetScopeByName = dbmodel.DBQuery{
+		ID:    "SCPQ-SCOPE_MGT-03",
+		Query: `SELECT ` + scopeColumns + ` FROM "OAUTH_SCOPE" WHERE NAME = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCreateScope inserts a new scope definition.
+	queryCreateScope = dbmodel.DBQuery{
+		ID: "SCPQ-SCOPE_MGT-04",
+		Query: `INSERT INTO "OAUTH_SCOPE" (ID, NAME, DISPLAY_NAME, DESCRIPTION, CLAIMS, RESTRICTION, ` +
+			`REQUIRED_ACRS, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryUpdateScope updates an existing scope definition.
+	queryUpdateScope = dbmodel.DBQuery{
+		ID: "SCPQ-SCOPE_MGT-05",
+		Query: `UPDATE "OAUTH_SCOPE" SET NAME = $2, DISPLAY_NAME = $3, DESCRIPTION = $4, CLAIMS = $5, ` +
+			`RESTRICTION = $6, REQUIRED_ACRS = $7 WHERE ID = $1 AND DEPLOYMENT_ID = $8`,
+	}
+
+	// queryDeleteScope deletes a scope definition.
+	queryDeleteScope = dbmodel.DBQuery{
+		ID:    "SCPQ-SCOPE_MGT-06",
+		Query: `DELETE FROM "OAUTH_SCOPE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
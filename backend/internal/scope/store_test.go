@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *scopeStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &scopeStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func testScopeRow(id, name string, claims interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"id": id, "name": name, "display_name": "Orders", "description": "View your orders", "claims": claims,
+	}
+}
+
+func (suite *StoreTestSuite) TestListScopes() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListScopes, testDeploymentID).
+		Return([]map[string]interface{}{
+			testScopeRow("s1", "orders", `["order_id"]`),
+			testScopeRow("s2", "billing", []byte(`[]`)),
+		}, nil)
+
+	scopes, err := suite.store.ListScopes(suite.ctx)
+
+	suite.NoError(err)
+	suite.Len(scopes, 2)
+	suite.Equal("orders", scopes[0].Name)
+	suite.Equal("View your orders", scopes[0].Description)
+	suite.Equal([]string{"order_id"}, scopes[0].Claims)
+	suite.Empty(scopes[1].Claims)
+}
+
+func (suite *StoreTestSuite) TestListScopes_InvalidClaims() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListScopes, testDeploymentID).
+		Return([]map[string]interface{}{testScopeRow("s1", "orders", `{`)}, nil)
+
+	_, err := suite.store.ListScopes(suite.ctx)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestGetScopeByName_NotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetScopeByName, "orders", testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, err := suite.store.GetScopeByName(suite.ctx, "orders")
+
+	suite.ErrorIs(err, errScopeNotFound)
+}
+
+func (suite *StoreTestSuite) TestCreateScope() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateScope, "s1", "orders", "Orders", "",
+		`["order_id"]`, nil, nil, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.CreateScope(suite.ctx,
+		Scope{ID: "s1", Name: "orders", DisplayName: "Orders", Claims: []string{"order_id"}})
+
+	suite.NoError(err)
+}
+
+func (suite *StoreTestSuite) TestCreateScope_WithRestriction() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateScope, "s1", "orders", "Orders", "",
+		`[]`, `{"roles":["admin"],"enforcement":"reject"}`, nil, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.CreateScope(suite.ctx, Scope{ID: "s1", Name: "orders", DisplayName: "Orders",
+		Claims: []string{}, Restriction: &ScopeRestriction{
+			Roles: []string{"admin"}, Enforcement: RestrictionEnforcementReject,
+		}})
+
+	suite.NoError(err)
+}
+
+func (suite *StoreTestSuite) TestCreateScope_WithRequiredACRs() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateScope, "s1", "payments", "Payments", "",
+		`[]`, nil, `["mfa"]`, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.CreateScope(suite.ctx, Scope{ID: "s1", Name: "payments", DisplayName: "Payments",
+		Claims: []string{}, RequiredACRs: []string{"mfa"}})
+
+	suite.NoError(err)
+}
+
+func (suite *StoreTestSuite) TestGetScopeByID_WithRequiredACRs() {
+	row := testScopeRow("s1", "payments", `[]`)
+	row["required_acrs"] = `["mfa","hwk"]`
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetScopeByID, "s1", testDeploymentID).
+		Return([]map[string]interface{}{row}, nil)
+
+	scope, err := suite.store.GetScopeByID(suite.ctx, "s1")
+
+	suite.NoError(err)
+	suite.Equal([]string{"mfa", "hwk"}, scope.RequiredACRs)
+}
+
+func (suite *StoreTestSuite) TestGetScopeByID_WithRestriction() {
+	row := testScopeRow("s1", "orders", `[]`)
+	row["restriction"] = []byte(`{"ous":["ou-1"],"enforcement":"downscope"}`)
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetScopeByID, "s1", testDeploymentID).
+		Return([]map[string]interface{}{row}, nil)
+
+	scope, err := suite.store.GetScopeByID(suite.ctx, "s1")
+
+	suite.NoError(err)
+	suite.Require().NotNil(scope.Restriction)
+	suite.Equal([]string{"ou-1"}, scope.Restriction.OUs)
+	suite.Equal(RestrictionEnforcementDownscope, scope.Restriction.Enforcement)
+}
+
+func (suite *StoreTestSuite) TestUpdateScope_NotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateScope, "s1", "orders", "Orders", "",
+		`[]`, nil, nil, testDeploymentID).Return(int64(0), nil)
+
+	err := suite.store.UpdateScope(suite.ctx,
+		Scope{ID: "s1", Name: "orders", DisplayName: "Orders", Claims: []string{}})
+
+	suite.ErrorIs(err, errScopeNotFound)
+}
+
+func (suite *StoreTestSuite) TestDeleteScope_Errors() {
+	suite.Run("ClientError", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("client error"))
+
+		suite.Error(suite.store.DeleteScope(suite.ctx, "s1"))
+	})
+
+	suite.Run("NotFound", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+		suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteScope, "s1", testDeploymentID).
+			Return(int64(0), nil)
+
+		suite.ErrorIs(suite.store.DeleteScope(suite.ctx, "s1"), errScopeNotFound)
+	})
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for search operations.
+var (
+	// ErrorInvalidQuery is the error returned when the search query is missing, too long, or has no
+	// searchable characters.
+	ErrorInvalidQuery = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SRH-1001",
+		Error: common.I18nMessage{
+			Key:          "error.searchservice.invalid_query",
+			DefaultValue: "Invalid search query",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.searchservice.invalid_query_description",
+			DefaultValue: "The q parameter must contain letters or digits and be at most 100 characters long",
+		},
+	}
+	// ErrorInvalidType is the error returned when an unknown result type is requested.
+	ErrorInvalidType = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SRH-1002",
+		Error: common.I18nMessage{
+			Key:          "error.searchservice.invalid_type",
+			DefaultValue: "Invalid result type",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.searchservice.invalid_type_description",
+			DefaultValue: "The types parameter accepts user, application, and group",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit is out of range.
+	ErrorInvalidLimit = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "SRH-1003",
+		Error: common.I18nMessage{
+			Key:          "error.searchservice.invalid_limit",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.searchservice.invalid_limit_description",
+			DefaultValue: "The limit parameter must be a positive integer of at most 100",
+		},
+	}
+)
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// searchHandler is the handler for search operations.
+type searchHandler struct {
+	searchService SearchServiceInterface
+}
+
+// newSearchHandler creates a new instance of searchHandler.
+func newSearchHandler(searchService SearchServiceInterface) *searchHandler {
+	return &searchHandler{searchService: searchService}
+}
+
+// HandleSearch handles the request to search resources by the q query parameter. The optional types
+// parameter is a comma-separated list of result types and the optional limit parameter caps the
+// number of results.
+func (h *searchHandler) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	params := r.URL.Query()
+
+	var types []ResultType
+	if typesParam := params.Get("types"); typesParam != "" {
+		for _, resultType := range strings.Split(typesParam, ",") {
+			types = append(types, ResultType(strings.TrimSpace(resultType)))
+		}
+	}
+
+	limit := 0
+	if limitParam := params.Get("limit"); limitParam != "" {
+		parsed, err := strconv.Atoi(limitParam)
+		if err != nil || parsed <= 0 {
+			handleError(ctx, w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	response, svcErr := h.searchService.Search(ctx, params.Get("q"), types, limit)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, response)
+}
+
+// handleError maps a service error to an HTTP error response.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *common.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == common.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
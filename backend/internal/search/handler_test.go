@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandleSearch(t *testing.T) {
+	mockService := NewSearchServiceInterfaceMock(t)
+	mockService.On("Search", mock.Anything, "john", []ResultType{ResultTypeUser, ResultTypeGroup}, 5).
+		Return(&SearchResponse{Query: "john", Results: []SearchResult{}}, nil)
+	mockService.On("Search", mock.Anything, "", []ResultType(nil), 0).Return(nil, &ErrorInvalidQuery)
+	handler := newSearchHandler(mockService)
+
+	rec := httptest.NewRecorder()
+	handler.HandleSearch(rec, httptest.NewRequest(http.MethodGet, "/search?q=john&types=user,%20group&limit=5", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"query":"john"`)
+
+	rec = httptest.NewRecorder()
+	handler.HandleSearch(rec, httptest.NewRequest(http.MethodGet, "/search", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrorInvalidQuery.Code)
+
+	rec = httptest.NewRecorder()
+	handler.HandleSearch(rec, httptest.NewRequest(http.MethodGet, "/search?q=john&limit=ten", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrorInvalidLimit.Code)
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize wires the search store, service and route.
+func Initialize(mux *http.ServeMux, authzService sysauthz.SystemAuthorizationServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface) SearchServiceInterface {
+	service := newSearchService(newSearchStore(), authzService, entityTypeService,
+		config.GetServerRuntime().Config.User.SensitiveAttributes)
+	registerRoutes(mux, newSearchHandler(service))
+	return service
+}
+
+// registerRoutes registers the routes for search operations.
+func registerRoutes(mux *http.ServeMux, handler *searchHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /search", handler.HandleSearch, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /search", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts))
+}
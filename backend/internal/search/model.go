@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import "encoding/json"
+
+// ResultType is the kind of resource a search result refers to.
+type ResultType string
+
+const (
+	// ResultTypeUser identifies a user, matched by its attribute values.
+	ResultTypeUser ResultType = "user"
+	// ResultTypeApplication identifies an application, matched by its name.
+	ResultTypeApplication ResultType = "application"
+	// ResultTypeGroup identifies a group, matched by its name.
+	ResultTypeGroup ResultType = "group"
+)
+
+// allResultTypes lists the result types searched when the request does not name any.
+var allResultTypes = []ResultType{ResultTypeUser, ResultTypeApplication, ResultTypeGroup}
+
+// SearchResult is a resource matching a search query.
+type SearchResult struct {
+	Type    ResultType `json:"type"`
+	ID      string     `json:"id"`
+	OUID    string     `json:"ouId"`
+	Display string     `json:"display"`
+	// Score is the relevance of the result. Results are ordered by descending score.
+	Score float64 `json:"score"`
+}
+
+// SearchResponse is the response of a search.
+type SearchResponse struct {
+	Query   string         `json:"query"`
+	Count   int            `json:"count"`
+	Results []SearchResult `json:"results"`
+}
+
+// searchMatch is a row matched by the search store.
+type searchMatch struct {
+	ID   string
+	OUID string
+	// Name is the name of an application or group.
+	Name string
+	// UserType and Attributes are set for users, whose display value is resolved from the attributes.
+	UserType   string
+	Attributes json.RawMessage
+	Score      float64
+}
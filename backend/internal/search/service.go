@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package search provides a search across users, applications and groups for the admin console.
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// SearchServiceInterface defines the operations for searching resources.
+type SearchServiceInterface interface {
+	Search(ctx context.Context, query string, types []ResultType, limit int) (
+		*SearchResponse, *common.ServiceError)
+}
+
+// searchService searches the resources the caller is allowed to list.
+type searchService struct {
+	store               searchStoreInterface
+	authzService        sysauthz.SystemAuthorizationServiceInterface
+	entityTypeService   entitytype.EntityTypeServiceInterface
+	sensitiveAttributes []string
+	logger              *log.Logger
+}
+
+// newSearchService creates a new instance of searchService.
+func newSearchService(store searchStoreInterface, authzService sysauthz.SystemAuthorizationServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface, sensitiveAttributes []string) SearchServiceInterface {
+	return &searchService{
+		store:               store,
+		authzService:        authzService,
+		entityTypeService:   entityTypeService,
+		sensitiveAttributes: sensitiveAttributes,
+		logger:              log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// Search returns at most limit resources of the given types that match the query, best match first.
+// All types are searched when types is empty, and the default page size is used when limit is zero.
+// Each type is limited to the resources the caller is allowed to list; a type the caller cannot list
+// is left out of the results rather than failing the search.
+func (s *searchService) Search(ctx context.Context, query string, types []ResultType, limit int) (
+	*SearchResponse, *common.ServiceError) {
+	if len(query) > maxQueryLength {
+		return nil, &ErrorInvalidQuery
+	}
+	term := normalizeTerm(query)
+	if term == "" {
+		return nil, &ErrorInvalidQuery
+	}
+	if limit == 0 {
+		limit = serverconst.DefaultPageSize
+	} else if limit < 0 || limit > serverconst.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if len(types) == 0 {
+		types = allResultTypes
+	}
+	for _, resultType := range types {
+		if !slices.Contains(allResultTypes, resultType) {
+			return nil, &ErrorInvalidType
+		}
+	}
+
+	results := make([]SearchResult, 0)
+	for _, resultType := range allResultTypes {
+		if !slices.Contains(types, resultType) {
+			continue
+		}
+		typeResults, svcErr := s.searchType(ctx, resultType, term, limit)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		results = append(results, typeResults...)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return &SearchResponse{
+		Query:   query,
+		Count:   len(results),
+		Results: results,
+	}, nil
+}
+
+// searchType searches the resources of a single type the caller is allowed to list.
+func (s *searchService) searchType(ctx context.Context, resultType ResultType, term string, limit int) (
+	[]SearchResult, *common.ServiceError) {
+	ouIDs, allowed, svcErr := s.resolveScope(ctx, resultType)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if !allowed {
+		return nil, nil
+	}
+
+	var hiddenAttributes []string
+	if resultType == ResultTypeUser {
+		if hiddenAttributes, svcErr = s.resolveHiddenAttributes(ctx); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	matches, err := s.store.Search(ctx, resultType, term, ouIDs, hiddenAttributes, limit)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to search resources", log.String("type", string(resultType)),
+			log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	var displayPaths map[string]string
+	if resultType == ResultTypeUser {
+		userTypes := make([]string, 0, len(matches))
+		for _, match := range matches {
+			userTypes = append(userTypes, match.UserType)
+		}
+		displayPaths = user.ResolveDisplayAttributePaths(ctx, userTypes, s.entityTypeService, s.logger)
+	}
+
+	results := make([]SearchResult, 0, len(matches))
+	for _, match := range matches {
+		display := match.Name
+		if resultType == ResultTypeUser {
+			attributes := withoutAttributes(match.Attributes, hiddenAttributes)
+			display = utils.ResolveDisplay(match.ID, match.UserType, attributes, displayPaths)
+		}
+		results = append(results, SearchResult{
+			Type:    resultType,
+			ID:      match.ID,
+			OUID:    match.OUID,
+			Display: display,
+			Score:   match.Score,
+		})
+	}
+	return results, nil
+}
+
+// resolveScope returns the organization units the caller may list resources of the type from. An
+// empty list with allowed set means the caller may list resources from every organization unit.
+// Applications are not scoped by organization unit and are either all listable or none are.
+func (s *searchService) resolveScope(ctx context.Context, resultType ResultType) (
+	ouIDs []string, allowed bool, svcErr *common.ServiceError) {
+	if resultType == ResultTypeApplication {
+		allowed, svcErr := s.authzService.IsActionAllowed(ctx, security.ActionListApplications, nil)
+		if svcErr != nil {
+			s.logger.Error(ctx, "Failed to check permission to list applications", log.Any("error", svcErr))
+			return nil, false, &common.InternalServerError
+		}
+		return nil, allowed, nil
+	}
+
+	action := security.ActionListUsers
+	if resultType == ResultTypeGroup {
+		action = security.ActionListGroups
+	}
+	accessible, svcErr := s.authzService.GetAccessibleResources(ctx, action, security.ResourceTypeOU)
+	if svcErr != nil {
+		s.logger.Error(ctx, "Failed to resolve accessible resources for search",
+			log.String("type", string(resultType)), log.Any("error", svcErr))
+		return nil, false, &common.InternalServerError
+	}
+	if accessible.AllAllowed {
+		return nil, true, nil
+	}
+	return accessible.IDs, len(accessible.IDs) > 0, nil
+}
+
+// resolveHiddenAttributes returns the sensitive user attributes the caller may not read. They are
+// neither matched nor shown, so that a search cannot reveal their values. A caller is only shown them
+// when allowed to read sensitive attributes in every organization unit.
+func (s *searchService) resolveHiddenAttributes(ctx context.Context) ([]string, *common.ServiceError) {
+	if len(s.sensitiveAttributes) == 0 {
+		return nil, nil
+	}
+	accessible, svcErr := s.authzService.GetAccessibleResources(ctx,
+		security.ActionReadSensitiveUserAttributes, security.ResourceTypeOU)
+	if svcErr != nil {
+		s.logger.Error(ctx, "Failed to check permission to read sensitive user attributes",
+			log.Any("error", svcErr))
+		return nil, &common.InternalServerError
+	}
+	if accessible.AllAllowed {
+		return nil, nil
+	}
+	return s.sensitiveAttributes, nil
+}
+
+// withoutAttributes returns the JSON object of attributes without the named top-level attributes.
+// Attributes that cannot be parsed are dropped so that a hidden value is never displayed.
+func withoutAttributes(attributes json.RawMessage, names []string) json.RawMessage {
+	if len(names) == 0 || len(attributes) == 0 {
+		return attributes
+	}
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(attributes, &attrs); err != nil {
+		return nil
+	}
+	for _, name := range names {
+		delete(attrs, name)
+	}
+	filtered, err := json.Marshal(attrs)
+	if err != nil {
+		return nil
+	}
+	return filtered
+}
+
+// normalizeTerm lowercases the query and reduces it to words of letters, digits and the characters
+// common in user names and emails, separated by single spaces. Any other character separates words,
+// which keeps the term safe to use in a full-text or LIKE pattern.
+func normalizeTerm(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '@' && r != '.' && r != '-'
+	})
+	return strings.Join(words, " ")
+}
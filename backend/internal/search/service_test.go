@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	mockStore         *searchStoreInterfaceMock
+	mockAuthz         *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockEntityTypeSvc *entitytypemock.EntityTypeServiceInterfaceMock
+	service           SearchServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStore = newSearchStoreInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockEntityTypeSvc = entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
+	suite.service = newSearchService(suite.mockStore, suite.mockAuthz, suite.mockEntityTypeSvc, nil)
+}
+
+func (suite *ServiceTestSuite) allowAll() {
+	suite.mockAuthz.On("GetAccessibleResources", mock.Anything, mock.Anything, security.ResourceTypeOU).
+		Return(&sysauthz.AccessibleResources{AllAllowed: true}, nil).Maybe()
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionListApplications,
+		(*sysauthz.ActionContext)(nil)).Return(true, nil).Maybe()
+}
+
+func (suite *ServiceTestSuite) TestSearch_MergesTypesByScore() {
+	suite.allowAll()
+	suite.mockStore.On("Search", mock.Anything, ResultTypeUser, "john doe", []string(nil), []string(nil), 2).
+		Return([]searchMatch{
+			{ID: "u1", OUID: "ou1", UserType: "person", Attributes: []byte(`{"name":"John Doe"}`), Score: 0.4},
+		}, nil)
+	suite.mockStore.On("Search", mock.Anything, ResultTypeApplication, "john doe", []string(nil), []string(nil), 2).
+		Return([]searchMatch{{ID: "a1", OUID: "ou1", Name: "John Doe App", Score: 0.9}}, nil)
+	suite.mockStore.On("Search", mock.Anything, ResultTypeGroup, "john doe", []string(nil), []string(nil), 2).
+		Return([]searchMatch{{ID: "g1", OUID: "ou1", Name: "John Doe Fans", Score: 0.1}}, nil)
+	suite.mockEntityTypeSvc.On("GetDisplayAttributesByNames", mock.Anything, entitytype.TypeCategoryUser,
+		[]string{"person"}).Return(map[string]string{"person": "name"}, nil)
+
+	response, svcErr := suite.service.Search(suite.ctx, "  John_Doe! ", nil, 2)
+
+	suite.Nil(svcErr)
+	suite.Equal("  John_Doe! ", response.Query)
+	suite.Equal(2, response.Count)
+	suite.Equal([]SearchResult{
+		{Type: ResultTypeApplication, ID: "a1", OUID: "ou1", Display: "John Doe App", Score: 0.9},
+		{Type: ResultTypeUser, ID: "u1", OUID: "ou1", Display: "John Doe", Score: 0.4},
+	}, response.Results)
+}
+
+func (suite *ServiceTestSuite) TestSearch_FiltersByAccessibleOUs() {
+	suite.mockAuthz.On("GetAccessibleResources", mock.Anything, security.ActionListGroups,
+		security.ResourceTypeOU).Return(&sysauthz.AccessibleResources{IDs: []string{"ou1"}}, nil)
+	suite.mockStore.On("Search", mock.Anything, ResultTypeGroup, "admins", []string{"ou1"}, []string(nil),
+		serverconst.DefaultPageSize).Return([]searchMatch{{ID: "g1", OUID: "ou1", Name: "Admins", Score: 1}}, nil)
+
+	response, svcErr := suite.service.Search(suite.ctx, "admins", []ResultType{ResultTypeGroup}, 0)
+
+	suite.Nil(svcErr)
+	suite.Equal(1, response.Count)
+	suite.Equal("Admins", response.Results[0].Display)
+}
+
+func (suite *ServiceTestSuite) TestSearch_SkipsTypesTheCallerCannotList() {
+	suite.mockAuthz.On("GetAccessibleResources", mock.Anything, security.ActionListUsers,
+		security.ResourceTypeOU).Return(&sysauthz.AccessibleResources{}, nil)
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionListApplications,
+		(*sysauthz.ActionContext)(nil)).Return(false, nil)
+
+	response, svcErr := suite.service.Search(suite.ctx, "john",
+		[]ResultType{ResultTypeApplication, ResultTypeUser, ResultTypeUser}, 10)
+
+	suite.Nil(svcErr)
+	suite.Equal(0, response.Count)
+	suite.NotNil(response.Results)
+	suite.mockStore.AssertNotCalled(suite.T(), "Search", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestSearch_InvalidRequest() {
+	testCases := []struct {
+		name    string
+		query   string
+		types   []ResultType
+		limit   int
+		wantErr *common.ServiceError
+	}{
+		{name: "empty query", query: "", wantErr: &ErrorInvalidQuery},
+		{name: "no searchable characters", query: "%_*", wantErr: &ErrorInvalidQuery},
+		{name: "query too long", query: strings.Repeat("a", maxQueryLength+1), wantErr: &ErrorInvalidQuery},
+		{name: "unknown type", query: "john", types: []ResultType{"role"}, wantErr: &ErrorInvalidType},
+		{name: "negative limit", query: "john", limit: -1, wantErr: &ErrorInvalidLimit},
+		{name: "limit too large", query: "john", limit: serverconst.MaxPageSize + 1, wantErr: &ErrorInvalidLimit},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, svcErr := suite.service.Search(suite.ctx, tc.query, tc.types, tc.limit)
+
+			suite.Nil(response)
+			suite.Equal(tc.wantErr, svcErr)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestSearch_StoreError() {
+	suite.allowAll()
+	suite.mockStore.On("Search", mock.Anything, ResultTypeUser, "john", []string(nil), []string(nil), 10).
+		Return(nil, errors.New("db error"))
+
+	response, svcErr := suite.service.Search(suite.ctx, "john", []ResultType{ResultTypeUser}, 10)
+
+	suite.Nil(response)
+	suite.Equal(&common.InternalServerError, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestSearch_AuthorizationError() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionListApplications,
+		(*sysauthz.ActionContext)(nil)).Return(false, &common.InternalServerError)
+
+	response, svcErr := suite.service.Search(suite.ctx, "portal", []ResultType{ResultTypeApplication}, 10)
+
+	suite.Nil(response)
+	suite.Equal(&common.InternalServerError, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestSearch_HidesSensitiveAttributes() {
+	service := newSearchService(suite.mockStore, suite.mockAuthz, suite.mockEntityTypeSvc,
+		[]string{"nationalId"})
+	suite.mockAuthz.On("GetAccessibleResources", mock.Anything, security.ActionListUsers,
+		security.ResourceTypeOU).Return(&sysauthz.AccessibleResources{AllAllowed: true}, nil)
+	suite.mockAuthz.On("GetAccessibleResources", mock.Anything, security.ActionReadSensitiveUserAttributes,
+		security.ResourceTypeOU).Return(&sysauthz.AccessibleResources{IDs: []string{"ou1"}}, nil)
+	suite.mockStore.On("Search", mock.Anything, ResultTypeUser, "john", []string(nil), []string{"nationalId"},
+		10).Return([]searchMatch{
+		{ID: "u1", OUID: "ou1", UserType: "person", Attributes: []byte(`{"nationalId":"123","email":"j@x.com"}`),
+			Score: 0.5},
+	}, nil)
+	suite.mockEntityTypeSvc.On("GetDisplayAttributesByNames", mock.Anything, entitytype.TypeCategoryUser,
+		[]string{"person"}).Return(map[string]string{"person": "nationalId"}, nil)
+
+	response, svcErr := service.Search(suite.ctx, "john", []ResultType{ResultTypeUser}, 10)
+
+	suite.Nil(svcErr)
+	suite.Equal(1, response.Count)
+	suite.NotContains(response.Results[0].Display, "123")
+}
+
+func (suite *ServiceTestSuite) TestSearch_SensitiveAttributesSearchableWithPermission() {
+	service := newSearchService(suite.mockStore, suite.mockAuthz, suite.mockEntityTypeSvc,
+		[]string{"nationalId"})
+	suite.allowAll()
+	suite.mockStore.On("Search", mock.Anything, ResultTypeUser, "123", []string(nil), []string(nil), 10).
+		Return([]searchMatch{
+			{ID: "u1", OUID: "ou1", UserType: "person", Attributes: []byte(`{"nationalId":"123"}`), Score: 0.5},
+		}, nil)
+	suite.mockEntityTypeSvc.On("GetDisplayAttributesByNames", mock.Anything, entitytype.TypeCategoryUser,
+		[]string{"person"}).Return(map[string]string{"person": "nationalId"}, nil)
+
+	response, svcErr := service.Search(suite.ctx, "123", []ResultType{ResultTypeUser}, 10)
+
+	suite.Nil(svcErr)
+	suite.Equal("123", response.Results[0].Display)
+}
+
+func (suite *ServiceTestSuite) TestNormalizeTerm() {
+	suite.Equal("john.doe@example.com", normalizeTerm("John.Doe@Example.com"))
+	suite.Equal("mary-jane o brien", normalizeTerm(" Mary-Jane  O'Brien "))
+	suite.Equal("a b c", normalizeTerm("a%b_c"))
+	suite.Equal("", normalizeTerm("*&|!"))
+}
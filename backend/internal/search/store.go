@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// searchStoreInterface defines the persistence operations for searching resources.
+type searchStoreInterface interface {
+	Search(ctx context.Context, resultType ResultType, term string, ouIDs, excludedAttributes []string,
+		limit int) ([]searchMatch, error)
+}
+
+// searchStore is the database-backed search store. Users, applications and groups are all kept in
+// the user database.
+type searchStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newSearchStore creates a new instance of searchStore.
+func newSearchStore() searchStoreInterface {
+	return &searchStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// Search returns at most limit resources of the result type that match the normalized term, best
+// match first. When ouIDs is non-empty, only resources in those organization units are returned.
+// Users are not matched by the values of excludedAttributes.
+func (s *searchStore) Search(ctx context.Context, resultType ResultType, term string,
+	ouIDs, excludedAttributes []string, limit int) ([]searchMatch, error) {
+	baseQuery, ok := searchQueries[resultType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported result type: %s", resultType)
+	}
+
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if resultType != ResultTypeUser {
+		excludedAttributes = nil
+	}
+	args := make([]interface{}, 0, 3+len(ouIDs)+len(excludedAttributes))
+	args = append(args, term, s.deploymentID, limit)
+	for _, ouID := range ouIDs {
+		args = append(args, ouID)
+	}
+	for _, name := range excludedAttributes {
+		args = append(args, name)
+	}
+
+	query := buildSearchQuery(baseQuery, len(ouIDs), len(excludedAttributes))
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s resources: %w", resultType, err)
+	}
+
+	matches := make([]searchMatch, 0, len(results))
+	for _, row := range results {
+		match, err := buildSearchMatch(resultType, row)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
+
+// buildSearchMatch builds a search match from a result row.
+func buildSearchMatch(resultType ResultType, row map[string]interface{}) (searchMatch, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return searchMatch{}, fmt.Errorf("failed to parse id as string")
+	}
+	ouID, ok := row["ou_id"].(string)
+	if !ok {
+		return searchMatch{}, fmt.Errorf("failed to parse ou_id as string")
+	}
+	score, err := parseFloat64(row["score"])
+	if err != nil {
+		return searchMatch{}, err
+	}
+
+	match := searchMatch{ID: id, OUID: ouID, Score: score}
+	if resultType != ResultTypeUser {
+		match.Name = parseString(row["name"])
+		return match, nil
+	}
+
+	match.UserType = parseString(row["type"])
+	if attributes := parseString(row["attributes"]); attributes != "" {
+		match.Attributes = json.RawMessage(attributes)
+	}
+	return match, nil
+}
+
+// parseString converts a text database value into a string. A NULL value becomes an empty string.
+func parseString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
+
+// parseFloat64 converts a numeric database value into a float64.
+func parseFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case []byte:
+		return strconv.ParseFloat(string(v), 64)
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("unexpected numeric type: %T", value)
+	}
+}
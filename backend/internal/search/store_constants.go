@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// The search queries take the normalized search term as $1, the deployment ID as $2, the limit as
+// $3, the accessible organization unit IDs from $4 onward and then the names of the user attributes
+// that must not be matched. On PostgreSQL the term is matched
+// with full-text search, each word as a prefix. On SQLite, which has no full-text index over these
+// tables, the term is matched as a case-insensitive substring.
+const (
+	// postgresTSQuery turns the space-separated words of the term into a prefix query for each word.
+	postgresTSQuery = `to_tsquery('simple', replace($1, ' ', ':* & ') || ':*') AS Q`
+	// sqliteScore ranks an exact match above a prefix match above any other substring match.
+	sqliteScore = `CASE WHEN LOWER(%[1]s) = $1 THEN 1.0 WHEN LOWER(%[1]s) LIKE $1 || '%%' THEN 0.75 ELSE 0.5 END`
+	// ouFilterPlaceholder marks where the organization unit filter is inserted.
+	ouFilterPlaceholder = "{{OU_FILTER}}"
+	// searchableAttributesPlaceholder marks the user attributes matched on PostgreSQL.
+	searchableAttributesPlaceholder = "{{SEARCHABLE_ATTRIBUTES}}"
+	// attributeFilterPlaceholder marks where the filter on the user attributes matched on SQLite is inserted.
+	attributeFilterPlaceholder = "{{ATTRIBUTE_FILTER}}"
+)
+
+var (
+	// querySearchUsers matches users by the string values of their attributes, leaving out the
+	// excluded attributes.
+	querySearchUsers = dbmodel.DBQuery{
+		ID: "SRQ-SEARCH-01",
+		PostgresQuery: `SELECT ID, OU_ID, TYPE, ATTRIBUTES, ` +
+			`ts_rank(jsonb_to_tsvector('simple', ` + searchableAttributesPlaceholder + `, '["string"]'), Q) AS SCORE ` +
+			`FROM "ENTITY", ` + postgresTSQuery + ` ` +
+			`WHERE CATEGORY = 'user' AND STATE <> 'DELETED' AND DEPLOYMENT_ID = $2` + ouFilterPlaceholder + ` ` +
+			`AND jsonb_to_tsvector('simple', ` + searchableAttributesPlaceholder + `, '["string"]') @@ Q ` +
+			`ORDER BY SCORE DESC, ID LIMIT $3`,
+		SQLiteQuery: `SELECT ID, OU_ID, TYPE, ATTRIBUTES, SCORE FROM (` +
+			`SELECT E.ID, E.OU_ID, E.TYPE, E.ATTRIBUTES, (SELECT MAX(` + fmt.Sprintf(sqliteScore, "J.value") + `) ` +
+			`FROM json_each(E.ATTRIBUTES) AS J WHERE J.type = 'text'` + attributeFilterPlaceholder + ` AND LOWER(J.value) LIKE '%' || $1 || '%') AS SCORE ` +
+			`FROM "ENTITY" AS E ` +
+			`WHERE E.CATEGORY = 'user' AND E.STATE <> 'DELETED' AND E.DEPLOYMENT_ID = $2` + ouFilterPlaceholder +
+			`) WHERE SCORE IS NOT NULL ORDER BY SCORE DESC, ID LIMIT $3`,
+	}
+
+	// querySearchApplications matches applications by name.
+	querySearchApplications = dbmodel.DBQuery{
+		ID: "SRQ-SEARCH-02",
+		PostgresQuery: `SELECT ID, OU_ID, SYSTEM_ATTRIBUTES->>'name' AS NAME, ` +
+			`ts_rank(to_tsvector('simple', COALESCE(SYSTEM_ATTRIBUTES->>'name', '')), Q) AS SCORE ` +
+			`FROM "ENTITY", ` + postgresTSQuery + ` ` +
+			`WHERE CATEGORY = 'app' AND STATE <> 'DELETED' AND DEPLOYMENT_ID = $2` + ouFilterPlaceholder + ` ` +
+			`AND to_tsvector('simple', COALESCE(SYSTEM_ATTRIBUTES->>'name', '')) @@ Q ` +
+			`ORDER BY SCORE DESC, ID LIMIT $3`,
+		SQLiteQuery: `SELECT ID, OU_ID, NAME, ` + fmt.Sprintf(sqliteScore, "NAME") + ` AS SCORE FROM (` +
+			`SELECT ID, OU_ID, json_extract(SYSTEM_ATTRIBUTES, '$.name') AS NAME FROM "ENTITY" ` +
+			`WHERE CATEGORY = 'app' AND STATE <> 'DELETED' AND DEPLOYMENT_ID = $2` + ouFilterPlaceholder +
+			`) WHERE LOWER(NAME) LIKE '%' || $1 || '%' ORDER BY SCORE DESC, ID LIMIT $3`,
+	}
+
+	// querySearchGroups matches groups by name.
+	querySearchGroups = dbmodel.DBQuery{
+		ID: "SRQ-SEARCH-03",
+		PostgresQuery: `SELECT ID, OU_ID, NAME, ts_rank(to_tsvector('simple', NAME), Q) AS SCORE ` +
+			`FROM "GROUP", ` + postgresTSQuery + ` ` +
+			`WHERE DEPLOYMENT_ID = $2` + ouFilterPlaceholder + ` AND to_tsvector('simple', NAME) @@ Q ` +
+			`ORDER BY SCORE DESC, ID LIMIT $3`,
+		SQLiteQuery: `SELECT ID, OU_ID, NAME, ` + fmt.Sprintf(sqliteScore, "NAME") + ` AS SCORE FROM "GROUP" ` +
+			`WHERE DEPLOYMENT_ID = $2` + ouFilterPlaceholder + ` AND LOWER(NAME) LIKE '%' || $1 || '%' ` +
+			`ORDER BY SCORE DESC, ID LIMIT $3`,
+	}
+)
+
+// searchQueries maps each result type to its search query.
+var searchQueries = map[ResultType]dbmodel.DBQuery{
+	ResultTypeUser:        querySearchUsers,
+	ResultTypeApplication: querySearchApplications,
+	ResultTypeGroup:       querySearchGroups,
+}
+
+// buildSearchQuery completes a search query with a filter on ouCount organization unit IDs and
+// leaves excludedCount user attributes out of the match. No filter is added when a count is zero.
+func buildSearchQuery(query dbmodel.DBQuery, ouCount, excludedCount int) dbmodel.DBQuery {
+	filter := ""
+	if ouCount > 0 {
+		filter = " AND OU_ID IN (" + buildPlaceholders(4, ouCount) + ")"
+	}
+	searchableAttributes := "ATTRIBUTES"
+	attributeFilter := ""
+	if excludedCount > 0 {
+		excluded := buildPlaceholders(4+ouCount, excludedCount)
+		searchableAttributes = "(ATTRIBUTES - ARRAY[" + excluded + "]::text[])"
+		attributeFilter = " AND J.key NOT IN (" + excluded + ")"
+	}
+
+	postgresQuery := strings.Replace(query.PostgresQuery, ouFilterPlaceholder, filter, 1)
+	postgresQuery = strings.ReplaceAll(postgresQuery, searchableAttributesPlaceholder, searchableAttributes)
+	sqliteQuery := strings.Replace(query.SQLiteQuery, ouFilterPlaceholder, filter, 1)
+	sqliteQuery = strings.Replace(sqliteQuery, attributeFilterPlaceholder, attributeFilter, 1)
+	return dbmodel.DBQuery{
+		ID:            query.ID,
+		Query:         postgresQuery,
+		PostgresQuery: postgresQuery,
+		SQLiteQuery:   sqliteQuery,
+	}
+}
+
+// buildPlaceholders returns count comma-separated positional placeholders starting at $first.
+func buildPlaceholders(first, count int) string {
+	placeholders := make([]string, count)
+	for i := range placeholders {
+		placeholders[i] = fmt.Sprintf("$%d", first+i)
+	}
+	return strings.Join(placeholders, ", ")
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *searchStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &searchStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *StoreTestSuite) TestSearch_Users() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, buildSearchQuery(querySearchUsers, 0, 0), "john",
+		testDeploymentID, 10).
+		Return([]map[string]interface{}{
+			{"id": "u1", "ou_id": "ou1", "type": "person", "attributes": []byte(`{"email":"john@x.com"}`),
+				"score": float64(0.6)},
+			{"id": "u2", "ou_id": "ou1", "type": "person", "attributes": nil, "score": []byte("0.5")},
+		}, nil)
+
+	matches, err := suite.store.Search(suite.ctx, ResultTypeUser, "john", nil, nil, 10)
+
+	suite.NoError(err)
+	suite.Equal([]searchMatch{
+		{ID: "u1", OUID: "ou1", UserType: "person", Attributes: json.RawMessage(`{"email":"john@x.com"}`),
+			Score: 0.6},
+		{ID: "u2", OUID: "ou1", UserType: "person", Score: 0.5},
+	}, matches)
+}
+
+func (suite *StoreTestSuite) TestSearch_GroupsFilteredByOU() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, buildSearchQuery(querySearchGroups, 2, 0), "admins",
+		testDeploymentID, 5, "ou1", "ou2").
+		Return([]map[string]interface{}{
+			{"id": "g1", "ou_id": "ou2", "name": "Admins", "score": float32(0.25)},
+		}, nil)
+
+	matches, err := suite.store.Search(suite.ctx, ResultTypeGroup, "admins", []string{"ou1", "ou2"}, nil, 5)
+
+	suite.NoError(err)
+	suite.Equal([]searchMatch{{ID: "g1", OUID: "ou2", Name: "Admins", Score: 0.25}}, matches)
+}
+
+func (suite *StoreTestSuite) TestSearch_UsersExcludingAttributes() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	query := buildSearchQuery(querySearchUsers, 1, 2)
+	suite.Contains(query.PostgresQuery, "(ATTRIBUTES - ARRAY[$5, $6]::text[])")
+	suite.NotContains(query.PostgresQuery, "jsonb_to_tsvector('simple', ATTRIBUTES,")
+	suite.Contains(query.SQLiteQuery, "AND J.key NOT IN ($5, $6)")
+	suite.mockDBClient.On("QueryContext", mock.Anything, query, "john", testDeploymentID, 10, "ou1",
+		"nationalId", "salaryBand").Return([]map[string]interface{}{}, nil)
+
+	matches, err := suite.store.Search(suite.ctx, ResultTypeUser, "john", []string{"ou1"},
+		[]string{"nationalId", "salaryBand"}, 10)
+
+	suite.NoError(err)
+	suite.Empty(matches)
+}
+
+func (suite *StoreTestSuite) TestSearch_UnsupportedType() {
+	_, err := suite.store.Search(suite.ctx, ResultType("role"), "john", nil, nil, 10)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestSearch_QueryError() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(nil, errors.New("db error"))
+
+	_, err := suite.store.Search(suite.ctx, ResultTypeApplication, "portal", nil, nil, 10)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestSearch_InvalidScore() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return([]map[string]interface{}{
+		{"id": "a1", "ou_id": "ou1", "name": "Portal", "score": true},
+	}, nil)
+
+	_, err := suite.store.Search(suite.ctx, ResultTypeApplication, "portal", nil, nil, 10)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestBuildSearchQuery() {
+	for _, query := range []dbmodel.DBQuery{querySearchUsers, querySearchApplications, querySearchGroups} {
+		unfiltered := buildSearchQuery(query, 0, 0)
+		for _, placeholder := range []string{ouFilterPlaceholder, searchableAttributesPlaceholder,
+			attributeFilterPlaceholder} {
+			suite.NotContains(unfiltered.PostgresQuery, placeholder)
+			suite.NotContains(unfiltered.SQLiteQuery, placeholder)
+		}
+		suite.NotContains(unfiltered.PostgresQuery, "OU_ID IN")
+
+		filtered := buildSearchQuery(query, 2, 0)
+		suite.Equal(query.ID, filtered.ID)
+		suite.Equal(filtered.PostgresQuery, filtered.Query)
+		suite.True(strings.Contains(filtered.PostgresQuery, "AND OU_ID IN ($4, $5)"))
+		suite.True(strings.Contains(filtered.SQLiteQuery, "AND OU_ID IN ($4, $5)"))
+	}
+}
@@ -27,12 +27,15 @@ const (
 	ConfigNameCORS ConfigName = "cors"
 	// ConfigNameDefaultResourceServer is the configuration key for the default resource server.
 	ConfigNameDefaultResourceServer ConfigName = "defaultResourceServer"
+	// ConfigNameFeatureFlags is the configuration key for the runtime feature flag overrides.
+	ConfigNameFeatureFlags ConfigName = "featureFlags"
 )
 
 // supportedConfigNames lists all the supported server configuration names.
 var supportedConfigNames = []ConfigName{
 	ConfigNameCORS,
 	ConfigNameDefaultResourceServer,
+	ConfigNameFeatureFlags,
 }
 
 // IsValid reports whether the config name is one of the supported values.
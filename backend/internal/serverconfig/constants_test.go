@@ -29,3 +29,9 @@ func TestDefaultResourceServerConfigNameSupported(t *testing.T) {
 	assert.True(t, ConfigNameDefaultResourceServer.IsValid())
 	assert.Contains(t, supportedConfigNames, ConfigNameDefaultResourceServer)
 }
+
+func TestFeatureFlagsConfigNameSupported(t *testing.T) {
+	assert.Equal(t, ConfigName("featureFlags"), ConfigNameFeatureFlags)
+	assert.True(t, ConfigNameFeatureFlags.IsValid())
+	assert.Contains(t, supportedConfigNames, ConfigNameFeatureFlags)
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package signingkey manages the lifecycle of the keys that sign JWTs issued by the server:
+// rotating to a new active key and keeping retired keys verifiable until they expire.
+package signingkey
+
+import "time"
+
+// KeyStatus represents the lifecycle state of a signing key.
+type KeyStatus string
+
+const (
+	// KeyStatusActive marks the key used to sign new JWTs.
+	KeyStatusActive KeyStatus = "ACTIVE"
+	// KeyStatusRetired marks a previously active key that is still published for verification.
+	KeyStatusRetired KeyStatus = "RETIRED"
+)
+
+const (
+	// loggerComponentName is the component name used for logging in the signing key package.
+	loggerComponentName = "SigningKeyService"
+
+	// signingCertificateValidity is the validity of the self-signed certificate created for a rotated
+	// key. JWT verification relies on the key's retirement expiry, not the certificate validity.
+	signingCertificateValidity = 10 * 365 * 24 * time.Hour
+	// signingCertificateCommonName is the subject common name of certificates created for rotated keys.
+	signingCertificateCommonName = "ThunderID Signing Key"
+)
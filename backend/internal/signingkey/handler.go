@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// signingKeyHandler is the handler for signing key management operations.
+type signingKeyHandler struct {
+	signingKeyService SigningKeyServiceInterface
+}
+
+// newSigningKeyHandler creates a new instance of signingKeyHandler.
+func newSigningKeyHandler(signingKeyService SigningKeyServiceInterface) *signingKeyHandler {
+	return &signingKeyHandler{signingKeyService: signingKeyService}
+}
+
+// HandleListSigningKeys handles GET /signing-keys, returning the active and retired signing keys.
+func (h *signingKeyHandler) HandleListSigningKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	keys, svcErr := h.signingKeyService.ListSigningKeys(ctx)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, SigningKeyListResponse{TotalResults: len(keys), Keys: keys})
+}
+
+// HandleRotateSigningKey handles POST /signing-keys/rotate, creating a new active signing key and
+// retiring the previous one.
+func (h *signingKeyHandler) HandleRotateSigningKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	key, svcErr := h.signingKeyService.RotateSigningKey(ctx)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, key)
+}
+
+// handleError maps a service error to an HTTP error response.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *common.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == common.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *SigningKeyServiceInterfaceMock
+	mux         *http.ServeMux
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.mockService = NewSigningKeyServiceInterfaceMock(suite.T())
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, newSigningKeyHandler(suite.mockService))
+}
+
+func (suite *HandlerTestSuite) TestHandleListSigningKeys_OK() {
+	suite.mockService.EXPECT().ListSigningKeys(mock.Anything).Return([]SigningKey{
+		{ID: "new-key", KeyID: "kid-2", Status: KeyStatusActive},
+		{ID: "default-key", KeyID: "kid-1", Status: KeyStatusRetired},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/signing-keys", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var resp SigningKeyListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), 2, resp.TotalResults)
+	assert.Equal(suite.T(), "kid-2", resp.Keys[0].KeyID)
+}
+
+func (suite *HandlerTestSuite) TestHandleRotateSigningKey_Created() {
+	suite.mockService.EXPECT().RotateSigningKey(mock.Anything).
+		Return(&SigningKey{ID: "new-key", KeyID: "kid-2", Status: KeyStatusActive}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/signing-keys/rotate", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	var resp SigningKey
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), KeyStatusActive, resp.Status)
+}
+
+func (suite *HandlerTestSuite) TestHandleRotateSigningKey_ServiceError() {
+	suite.mockService.EXPECT().RotateSigningKey(mock.Anything).Return(nil, &common.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodPost, "/signing-keys/rotate", nil)
+	w := httptest.NewRecorder()
+	suite.mux.ServeHTTP(w, req)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
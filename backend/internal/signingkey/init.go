@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pki"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// defaultRetiredKeyValidityPeriod is used when crypto.key_rotation.retired_key_validity_period is unset.
+const defaultRetiredKeyValidityPeriod = 7 * 24 * time.Hour
+
+// Initialize wires the signing key store and service, loads the persisted signing keys so JWTs are
+// signed with the active key, and registers the signing key management routes. The returned syncer
+// is nil when periodic reloading is disabled.
+func Initialize(mux *http.ServeMux, pkiService pki.PKIServiceInterface,
+	cryptoProvider kmprovider.RuntimeCryptoProvider, configCrypto kmprovider.ConfigCryptoProvider,
+	jwtService jwt.JWTServiceInterface) (SigningKeyServiceInterface, KeySyncer, error) {
+	transactioner, err := provider.GetDBProvider().GetConfigDBTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := config.GetServerRuntime().Config
+	retiredValidity := time.Duration(cfg.Crypto.KeyRotation.RetiredKeyValidityPeriod) * time.Second
+	if retiredValidity <= 0 {
+		retiredValidity = defaultRetiredKeyValidityPeriod
+	}
+
+	service := newSigningKeyService(newSigningKeyStore(), transactioner, pkiService, cryptoProvider,
+		configCrypto, jwtService, cfg.JWT.PreferredKeyID, retiredValidity)
+	if err := service.SyncSigningKeys(context.Background()); err != nil {
+		return nil, nil, err
+	}
+	registerRoutes(mux, newSigningKeyHandler(service))
+
+	var syncer KeySyncer
+	if cfg.Crypto.KeyRotation.SyncInterval > 0 {
+		syncer = newKeySyncer(service, time.Duration(cfg.Crypto.KeyRotation.SyncInterval)*time.Second)
+	}
+	return service, syncer, nil
+}
+
+// registerRoutes registers the routes for signing key management operations.
+func registerRoutes(mux *http.ServeMux, handler *signingKeyHandler) {
+	noContent := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /signing-keys", handler.HandleListSigningKeys, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /signing-keys", noContent, listOpts))
+
+	rotateOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /signing-keys/rotate", handler.HandleRotateSigningKey, rotateOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /signing-keys/rotate", noContent, rotateOpts))
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import "time"
+
+// SigningKey describes a JWT signing key and its lifecycle state.
+type SigningKey struct {
+	ID        string     `json:"id"`
+	KeyID     string     `json:"kid"`
+	Algorithm string     `json:"algorithm"`
+	Status    KeyStatus  `json:"status"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	RetiredAt *time.Time `json:"retiredAt,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// SigningKeyListResponse represents the response body for signing key listings.
+type SigningKeyListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	Keys         []SigningKey `json:"keys"`
+}
+
+// signingKeyRecord is the persisted form of a signing key. PrivateKey (encrypted) and Certificate
+// are PEM encoded and empty for keys loaded from the deployment configuration.
+type signingKeyRecord struct {
+	ID          string
+	Status      KeyStatus
+	PrivateKey  string
+	Certificate string
+	CreatedAt   time.Time
+	RetiredAt   *time.Time
+	ExpiresAt   *time.Time
+}
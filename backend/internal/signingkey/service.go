@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pki"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// SigningKeyServiceInterface defines the operations for managing JWT signing keys.
+type SigningKeyServiceInterface interface {
+	ListSigningKeys(ctx context.Context) ([]SigningKey, *common.ServiceError)
+	RotateSigningKey(ctx context.Context) (*SigningKey, *common.ServiceError)
+	SyncSigningKeys(ctx context.Context) error
+}
+
+// signingKeyService is the default implementation of SigningKeyServiceInterface.
+type signingKeyService struct {
+	store           signingKeyStoreInterface
+	transactioner   transaction.Transactioner
+	pkiService      pki.PKIServiceInterface
+	cryptoProvider  kmprovider.RuntimeCryptoProvider
+	configCrypto    kmprovider.ConfigCryptoProvider
+	jwtService      jwt.JWTServiceInterface
+	configuredKeyID string
+	retiredValidity time.Duration
+	logger          *log.Logger
+	// mu serializes rotation and synchronization, and guards activeKeyID.
+	mu          sync.Mutex
+	activeKeyID string
+}
+
+// newSigningKeyService creates a new instance of signingKeyService. configuredKeyID is the signing key
+// from the deployment configuration, which stays active until the first rotation.
+func newSigningKeyService(store signingKeyStoreInterface, transactioner transaction.Transactioner,
+	pkiService pki.PKIServiceInterface, cryptoProvider kmprovider.RuntimeCryptoProvider,
+	configCrypto kmprovider.ConfigCryptoProvider, jwtService jwt.JWTServiceInterface,
+	configuredKeyID string, retiredValidity time.Duration) SigningKeyServiceInterface {
+	return &signingKeyService{
+		store:           store,
+		transactioner:   transactioner,
+		pkiService:      pkiService,
+		cryptoProvider:  cryptoProvider,
+		configCrypto:    configCrypto,
+		jwtService:      jwtService,
+		configuredKeyID: configuredKeyID,
+		retiredValidity: retiredValidity,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+		activeKeyID:     configuredKeyID,
+	}
+}
+
+// ListSigningKeys returns the active signing key followed by the retired keys that are still
+// published for verification.
+func (s *signingKeyService) ListSigningKeys(ctx context.Context) ([]SigningKey, *common.ServiceError) {
+	records, err := s.store.ListSigningKeys(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list signing keys", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	now := time.Now()
+	var active *SigningKey
+	retired := make([]SigningKey, 0, len(records))
+	for _, record := range records {
+		if record.isExpired(now) {
+			continue
+		}
+		switch record.Status {
+		case KeyStatusActive:
+			// Records are newest first, so the first active record is the current signing key.
+			if active == nil {
+				key := s.describeKey(ctx, record)
+				active = &key
+			}
+		case KeyStatusRetired:
+			retired = append(retired, s.describeKey(ctx, record))
+		}
+	}
+	if active == nil {
+		key := s.describeKey(ctx, signingKeyRecord{ID: s.configuredKeyID, Status: KeyStatusActive})
+		active = &key
+	}
+	return append([]SigningKey{*active}, retired...), nil
+}
+
+// RotateSigningKey creates a new signing key of the same type as the active key and makes it active.
+// The previous key is retired: it stays published and verifiable until its expiry so that tokens it
+// signed remain valid.
+func (s *signingKeyService) RotateSigningKey(ctx context.Context) (*SigningKey, *common.ServiceError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previousKeyID := s.activeKeyID
+	currentCert, svcErr := s.pkiService.GetX509Certificate(ctx, previousKeyID)
+	if svcErr != nil {
+		s.logger.Error(ctx, "Failed to load the active signing key", log.String("keyID", previousKeyID))
+		return nil, &common.InternalServerError
+	}
+
+	now := time.Now().UTC()
+	certificate, privateKeyPEM, certificatePEM, err := createSigningKey(currentCert.PublicKey, now)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to create signing key", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	encryptedKey, err := s.configCrypto.Encrypt(ctx, privateKeyPEM)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to encrypt signing key", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate signing key ID", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	expiresAt := now.Add(s.retiredValidity)
+	record := signingKeyRecord{
+		ID:          id,
+		Status:      KeyStatusActive,
+		PrivateKey:  string(encryptedKey),
+		Certificate: string(certificatePEM),
+		CreatedAt:   now,
+	}
+	err = s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		retiredCount, err := s.store.RetireActiveSigningKeys(txCtx, now, expiresAt)
+		if err != nil {
+			return err
+		}
+		// Until the first rotation the active key comes from the deployment configuration and has no
+		// record, so record its retirement.
+		if retiredCount == 0 {
+			if err := s.store.CreateSigningKey(txCtx, signingKeyRecord{
+				ID: previousKeyID, Status: KeyStatusRetired, CreatedAt: now, RetiredAt: &now, ExpiresAt: &expiresAt,
+			}); err != nil {
+				return err
+			}
+		}
+		if err := s.store.CreateSigningKey(txCtx, record); err != nil {
+			return err
+		}
+		return s.store.DeleteExpiredSigningKeys(txCtx, now)
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to persist signing key rotation", log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	if err := s.activateKey(ctx, id, certificate, previousKeyID, expiresAt); err != nil {
+		s.logger.Error(ctx, "Failed to activate rotated signing key", log.String("keyID", id), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	s.logger.Info(ctx, "Rotated signing key", log.String("keyID", id), log.String("retiredKeyID", previousKeyID))
+	key := s.describeKey(ctx, record)
+	return &key, nil
+}
+
+// SyncSigningKeys loads the persisted signing keys into the key manager and switches JWT signing to
+// the active key. It runs at startup and periodically so that every node follows a rotation.
+func (s *signingKeyService) SyncSigningKeys(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records, err := s.store.ListSigningKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list signing keys: %w", err)
+	}
+
+	now := time.Now()
+	activeKeyID := ""
+	for _, record := range records {
+		if record.isExpired(now) {
+			continue
+		}
+		if err := s.registerKey(ctx, record); err != nil {
+			return err
+		}
+		switch record.Status {
+		case KeyStatusActive:
+			if activeKeyID == "" {
+				activeKeyID = record.ID
+			}
+		case KeyStatusRetired:
+			if record.ExpiresAt != nil {
+				if err := s.pkiService.SetKeyExpiry(record.ID, *record.ExpiresAt); err != nil {
+					// A retired configured key may have been removed from the deployment configuration.
+					s.logger.Debug(ctx, "Retired signing key is not loaded", log.String("keyID", record.ID))
+				}
+			}
+		}
+	}
+	if activeKeyID == "" {
+		activeKeyID = s.configuredKeyID
+	}
+
+	if activeKeyID != s.activeKeyID {
+		if err := s.jwtService.SetSigningKey(ctx, activeKeyID); err != nil {
+			return fmt.Errorf("failed to switch to signing key %s: %w", activeKeyID, err)
+		}
+		s.logger.Info(ctx, "Switched to the active signing key", log.String("keyID", activeKeyID))
+		s.activeKeyID = activeKeyID
+	}
+	return nil
+}
+
+// registerKey adds the key material of a rotated key to the key manager, if not already present.
+func (s *signingKeyService) registerKey(ctx context.Context, record signingKeyRecord) error {
+	if record.Certificate == "" || s.pkiService.GetCertThumbprint(record.ID) != "" {
+		return nil
+	}
+	privateKeyPEM, err := s.configCrypto.Decrypt(ctx, []byte(record.PrivateKey))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt signing key %s: %w", record.ID, err)
+	}
+	certificate, err := tls.X509KeyPair([]byte(record.Certificate), privateKeyPEM)
+	if err != nil {
+		return fmt.Errorf("failed to load signing key %s: %w", record.ID, err)
+	}
+	return s.pkiService.AddKey(record.ID, certificate)
+}
+
+// activateKey registers a newly rotated key, switches JWT signing to it, and sets the expiry of the
+// key it replaces.
+func (s *signingKeyService) activateKey(ctx context.Context, keyID string, certificate tls.Certificate,
+	previousKeyID string, previousExpiresAt time.Time) error {
+	if err := s.pkiService.AddKey(keyID, certificate); err != nil {
+		return err
+	}
+	if err := s.jwtService.SetSigningKey(ctx, keyID); err != nil {
+		return err
+	}
+	s.activeKeyID = keyID
+	return s.pkiService.SetKeyExpiry(previousKeyID, previousExpiresAt)
+}
+
+// describeKey builds the API representation of a signing key.
+func (s *signingKeyService) describeKey(ctx context.Context, record signingKeyRecord) SigningKey {
+	key := SigningKey{
+		ID:        record.ID,
+		Status:    record.Status,
+		RetiredAt: record.RetiredAt,
+		ExpiresAt: record.ExpiresAt,
+	}
+	if !record.CreatedAt.IsZero() && record.Certificate != "" {
+		createdAt := record.CreatedAt
+		key.CreatedAt = &createdAt
+	}
+
+	keys, err := s.cryptoProvider.GetPublicKeys(ctx, kmprovider.PublicKeyFilter{KeyID: record.ID})
+	if err != nil || len(keys) == 0 {
+		s.logger.Debug(ctx, "Signing key is not loaded on this node", log.String("keyID", record.ID))
+		return key
+	}
+	key.KeyID = keys[0].Thumbprint
+	key.Algorithm = string(keys[0].Algorithm)
+	return key
+}
+
+// isExpired reports whether a retired key has passed its expiry.
+func (r signingKeyRecord) isExpired(now time.Time) bool {
+	return r.ExpiresAt != nil && !now.Before(*r.ExpiresAt)
+}
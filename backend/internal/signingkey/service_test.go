@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/pki/pkimock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const testConfiguredKeyID = "default-key"
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx              context.Context
+	mockStore        *signingKeyStoreInterfaceMock
+	mockPKI          *pkimock.PKIServiceInterfaceMock
+	mockCrypto       *cryptomock.RuntimeCryptoProviderMock
+	mockConfigCrypto *cryptomock.ConfigCryptoProviderMock
+	mockJWT          *jwtmock.JWTServiceInterfaceMock
+	service          SigningKeyServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStore = newSigningKeyStoreInterfaceMock(suite.T())
+	suite.mockPKI = pkimock.NewPKIServiceInterfaceMock(suite.T())
+	suite.mockCrypto = cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	suite.mockConfigCrypto = cryptomock.NewConfigCryptoProviderMock(suite.T())
+	suite.mockJWT = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.service = newSigningKeyService(suite.mockStore, transaction.NewNoOpTransactioner(), suite.mockPKI,
+		suite.mockCrypto, suite.mockConfigCrypto, suite.mockJWT, testConfiguredKeyID, time.Hour)
+
+	// The config crypto mock passes content through unchanged.
+	passThrough := func(_ context.Context, content []byte) ([]byte, error) { return content, nil }
+	suite.mockConfigCrypto.EXPECT().Encrypt(mock.Anything, mock.Anything).RunAndReturn(passThrough).Maybe()
+	suite.mockConfigCrypto.EXPECT().Decrypt(mock.Anything, mock.Anything).RunAndReturn(passThrough).Maybe()
+}
+
+// configuredCertificate returns a certificate for the configured key, which determines the type of
+// the rotated key.
+func (suite *ServiceTestSuite) configuredCertificate() *x509.Certificate {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	return &x509.Certificate{PublicKey: &privateKey.PublicKey}
+}
+
+// rotatedRecord returns a persisted record holding real key material.
+func (suite *ServiceTestSuite) rotatedRecord(id string, status KeyStatus) signingKeyRecord {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	_, keyPEM, certPEM, err := createSigningKey(&privateKey.PublicKey, time.Now())
+	suite.Require().NoError(err)
+	return signingKeyRecord{ID: id, Status: status, PrivateKey: string(keyPEM), Certificate: string(certPEM),
+		CreatedAt: time.Now().UTC()}
+}
+
+// --- RotateSigningKey ---
+
+func (suite *ServiceTestSuite) TestRotateSigningKey_FirstRotationRetiresConfiguredKey() {
+	suite.mockPKI.On("GetX509Certificate", mock.Anything, testConfiguredKeyID).
+		Return(suite.configuredCertificate(), nil)
+	suite.mockStore.On("RetireActiveSigningKeys", mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), nil)
+	suite.mockStore.On("CreateSigningKey", mock.Anything, mock.MatchedBy(func(r signingKeyRecord) bool {
+		return r.ID == testConfiguredKeyID && r.Status == KeyStatusRetired && r.PrivateKey == "" &&
+			r.ExpiresAt != nil
+	})).Return(nil).Once()
+	suite.mockStore.On("CreateSigningKey", mock.Anything, mock.MatchedBy(func(r signingKeyRecord) bool {
+		return r.ID != testConfiguredKeyID && r.Status == KeyStatusActive && r.Certificate != ""
+	})).Return(nil).Once()
+	suite.mockStore.On("DeleteExpiredSigningKeys", mock.Anything, mock.Anything).Return(nil)
+
+	var newKeyID string
+	suite.mockPKI.On("AddKey", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		newKeyID = args.String(0)
+	}).Return(nil)
+	suite.mockJWT.On("SetSigningKey", mock.Anything, mock.Anything).Return(nil)
+	suite.mockPKI.On("SetKeyExpiry", testConfiguredKeyID, mock.Anything).Return(nil)
+	suite.mockCrypto.On("GetPublicKeys", mock.Anything, mock.Anything).Return([]kmprovider.PublicKeyInfo{
+		{Algorithm: cryptolib.AlgorithmES256, Thumbprint: "new-kid"},
+	}, nil)
+
+	key, svcErr := suite.service.RotateSigningKey(suite.ctx)
+
+	suite.Require().Nil(svcErr)
+	assert.Equal(suite.T(), newKeyID, key.ID)
+	assert.Equal(suite.T(), "new-kid", key.KeyID)
+	assert.Equal(suite.T(), string(cryptolib.AlgorithmES256), key.Algorithm)
+	assert.Equal(suite.T(), KeyStatusActive, key.Status)
+	suite.mockJWT.AssertCalled(suite.T(), "SetSigningKey", mock.Anything, newKeyID)
+}
+
+func (suite *ServiceTestSuite) TestRotateSigningKey_RetiresRotatedKey() {
+	suite.mockPKI.On("GetX509Certificate", mock.Anything, testConfiguredKeyID).
+		Return(suite.configuredCertificate(), nil)
+	suite.mockStore.On("RetireActiveSigningKeys", mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(1), nil)
+	suite.mockStore.On("CreateSigningKey", mock.Anything, mock.MatchedBy(func(r signingKeyRecord) bool {
+		return r.Status == KeyStatusActive
+	})).Return(nil).Once()
+	suite.mockStore.On("DeleteExpiredSigningKeys", mock.Anything, mock.Anything).Return(nil)
+	suite.mockPKI.On("AddKey", mock.Anything, mock.Anything).Return(nil)
+	suite.mockJWT.On("SetSigningKey", mock.Anything, mock.Anything).Return(nil)
+	suite.mockPKI.On("SetKeyExpiry", testConfiguredKeyID, mock.Anything).Return(nil)
+	suite.mockCrypto.On("GetPublicKeys", mock.Anything, mock.Anything).Return([]kmprovider.PublicKeyInfo{}, nil)
+
+	_, svcErr := suite.service.RotateSigningKey(suite.ctx)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *ServiceTestSuite) TestRotateSigningKey_PersistFailure() {
+	suite.mockPKI.On("GetX509Certificate", mock.Anything, testConfiguredKeyID).
+		Return(suite.configuredCertificate(), nil)
+	suite.mockStore.On("RetireActiveSigningKeys", mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("db down"))
+
+	key, svcErr := suite.service.RotateSigningKey(suite.ctx)
+
+	suite.Nil(key)
+	suite.Equal(&common.InternalServerError, svcErr)
+	suite.mockPKI.AssertNotCalled(suite.T(), "AddKey", mock.Anything, mock.Anything)
+	suite.mockJWT.AssertNotCalled(suite.T(), "SetSigningKey", mock.Anything, mock.Anything)
+}
+
+// --- SyncSigningKeys ---
+
+func (suite *ServiceTestSuite) TestSyncSigningKeys_ActivatesPersistedKey() {
+	expiresAt := time.Now().Add(time.Hour)
+	active := suite.rotatedRecord("rotated-key", KeyStatusActive)
+	suite.mockStore.On("ListSigningKeys", mock.Anything).Return([]signingKeyRecord{
+		active,
+		{ID: testConfiguredKeyID, Status: KeyStatusRetired, ExpiresAt: &expiresAt},
+	}, nil)
+	suite.mockPKI.On("GetCertThumbprint", "rotated-key").Return("")
+	suite.mockPKI.On("AddKey", "rotated-key", mock.AnythingOfType("tls.Certificate")).Return(nil)
+	suite.mockPKI.On("SetKeyExpiry", testConfiguredKeyID, expiresAt).Return(nil)
+	suite.mockJWT.On("SetSigningKey", mock.Anything, "rotated-key").Return(nil)
+
+	suite.NoError(suite.service.SyncSigningKeys(suite.ctx))
+
+	// A second sync with no changes does not switch the signing key again.
+	suite.mockPKI.On("GetCertThumbprint", "rotated-key").Unset()
+	suite.mockPKI.On("GetCertThumbprint", "rotated-key").Return("thumbprint")
+	suite.NoError(suite.service.SyncSigningKeys(suite.ctx))
+	suite.mockJWT.AssertNumberOfCalls(suite.T(), "SetSigningKey", 1)
+	suite.mockPKI.AssertNumberOfCalls(suite.T(), "AddKey", 1)
+}
+
+func (suite *ServiceTestSuite) TestSyncSigningKeys_SkipsExpiredKeys() {
+	expired := suite.rotatedRecord("expired-key", KeyStatusRetired)
+	expiresAt := time.Now().Add(-time.Minute)
+	expired.ExpiresAt = &expiresAt
+	suite.mockStore.On("ListSigningKeys", mock.Anything).Return([]signingKeyRecord{expired}, nil)
+
+	suite.NoError(suite.service.SyncSigningKeys(suite.ctx))
+	suite.mockPKI.AssertNotCalled(suite.T(), "AddKey", mock.Anything, mock.Anything)
+	suite.mockJWT.AssertNotCalled(suite.T(), "SetSigningKey", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestSyncSigningKeys_InvalidKeyMaterial() {
+	record := suite.rotatedRecord("rotated-key", KeyStatusActive)
+	record.PrivateKey = "not a key"
+	suite.mockStore.On("ListSigningKeys", mock.Anything).Return([]signingKeyRecord{record}, nil)
+	suite.mockPKI.On("GetCertThumbprint", "rotated-key").Return("")
+
+	suite.Error(suite.service.SyncSigningKeys(suite.ctx))
+	suite.mockJWT.AssertNotCalled(suite.T(), "SetSigningKey", mock.Anything, mock.Anything)
+}
+
+// --- ListSigningKeys ---
+
+func (suite *ServiceTestSuite) TestListSigningKeys_ConfiguredKeyActiveBeforeRotation() {
+	suite.mockStore.On("ListSigningKeys", mock.Anything).Return([]signingKeyRecord{}, nil)
+	suite.mockCrypto.On("GetPublicKeys", mock.Anything, kmprovider.PublicKeyFilter{KeyID: testConfiguredKeyID}).
+		Return([]kmprovider.PublicKeyInfo{{Algorithm: cryptolib.AlgorithmRS256, Thumbprint: "config-kid"}}, nil)
+
+	keys, svcErr := suite.service.ListSigningKeys(suite.ctx)
+
+	suite.Require().Nil(svcErr)
+	suite.Require().Len(keys, 1)
+	assert.Equal(suite.T(), testConfiguredKeyID, keys[0].ID)
+	assert.Equal(suite.T(), KeyStatusActive, keys[0].Status)
+	assert.Equal(suite.T(), "config-kid", keys[0].KeyID)
+}
+
+func (suite *ServiceTestSuite) TestListSigningKeys_ActiveFirstAndExpiredOmitted() {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+	suite.mockStore.On("ListSigningKeys", mock.Anything).Return([]signingKeyRecord{
+		{ID: "old-key", Status: KeyStatusRetired, ExpiresAt: &future},
+		{ID: "new-key", Status: KeyStatusActive},
+		{ID: testConfiguredKeyID, Status: KeyStatusRetired, ExpiresAt: &past},
+	}, nil)
+	suite.mockCrypto.On("GetPublicKeys", mock.Anything, mock.Anything).Return([]kmprovider.PublicKeyInfo{}, nil)
+
+	keys, svcErr := suite.service.ListSigningKeys(suite.ctx)
+
+	suite.Require().Nil(svcErr)
+	suite.Require().Len(keys, 2)
+	assert.Equal(suite.T(), "new-key", keys[0].ID)
+	assert.Equal(suite.T(), "old-key", keys[1].ID)
+}
+
+// --- createSigningKey ---
+
+func (suite *ServiceTestSuite) TestCreateSigningKey_MatchesKeyType() {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	edPublic, _, err := ed25519.GenerateKey(rand.Reader)
+	suite.Require().NoError(err)
+
+	for name, template := range map[string]interface{}{"rsa": &rsaKey.PublicKey, "ed25519": edPublic} {
+		suite.Run(name, func() {
+			certificate, keyPEM, certPEM, err := createSigningKey(template, time.Now())
+			suite.Require().NoError(err)
+			parsed, err := tls.X509KeyPair(certPEM, keyPEM)
+			suite.Require().NoError(err)
+			assert.IsType(suite.T(), certificate.PrivateKey, parsed.PrivateKey)
+		})
+	}
+
+	_, _, _, err = createSigningKey("unsupported", time.Now())
+	suite.ErrorIs(err, errUnsupportedKeyType)
+}
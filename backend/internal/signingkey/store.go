@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// signingKeyStoreInterface defines the persistence operations for signing keys.
+type signingKeyStoreInterface interface {
+	ListSigningKeys(ctx context.Context) ([]signingKeyRecord, error)
+	CreateSigningKey(ctx context.Context, record signingKeyRecord) error
+	RetireActiveSigningKeys(ctx context.Context, retiredAt, expiresAt time.Time) (int64, error)
+	DeleteExpiredSigningKeys(ctx context.Context, now time.Time) error
+}
+
+// signingKeyStore is the database-backed signing key store.
+type signingKeyStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newSigningKeyStore creates a new instance of signingKeyStore.
+func newSigningKeyStore() signingKeyStoreInterface {
+	return &signingKeyStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// ListSigningKeys returns the signing keys of the deployment, newest first.
+func (s *signingKeyStore) ListSigningKeys(ctx context.Context) ([]signingKeyRecord, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListSigningKeys, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signing keys: %w", err)
+	}
+
+	records := make([]signingKeyRecord, 0, len(results))
+	for _, row := range results {
+		record, err := buildSigningKeyRecordFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// CreateSigningKey persists a signing key.
+func (s *signingKeyStore) CreateSigningKey(ctx context.Context, record signingKeyRecord) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateSigningKey, record.ID, string(record.Status),
+		nullableString(record.PrivateKey), nullableString(record.Certificate), record.CreatedAt,
+		nullableTime(record.RetiredAt), nullableTime(record.ExpiresAt), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to create signing key: %w", err)
+	}
+	return nil
+}
+
+// RetireActiveSigningKeys retires every active signing key and returns the number of keys retired.
+func (s *signingKeyStore) RetireActiveSigningKeys(
+	ctx context.Context, retiredAt, expiresAt time.Time) (int64, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryRetireActiveSigningKeys, string(KeyStatusRetired),
+		retiredAt, expiresAt, string(KeyStatusActive), s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retire signing keys: %w", err)
+	}
+	return rows, nil
+}
+
+// DeleteExpiredSigningKeys deletes retired signing keys whose expiry has passed.
+func (s *signingKeyStore) DeleteExpiredSigningKeys(ctx context.Context, now time.Time) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteExpiredSigningKeys, string(KeyStatusRetired), now,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete expired signing keys: %w", err)
+	}
+	return nil
+}
+
+// buildSigningKeyRecordFromRow converts a database result row into a signing key record.
+func buildSigningKeyRecordFromRow(row map[string]interface{}) (signingKeyRecord, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return signingKeyRecord{}, fmt.Errorf("failed to parse id as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return signingKeyRecord{}, fmt.Errorf("failed to parse status as string")
+	}
+	createdAt, err := sysutils.ParseDBTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return signingKeyRecord{}, err
+	}
+
+	record := signingKeyRecord{
+		ID:          id,
+		Status:      KeyStatus(status),
+		PrivateKey:  optionalString(row["private_key"]),
+		Certificate: optionalString(row["certificate"]),
+		CreatedAt:   createdAt,
+	}
+	if record.RetiredAt, err = optionalTime(row["retired_at"], "retired_at"); err != nil {
+		return signingKeyRecord{}, err
+	}
+	if record.ExpiresAt, err = optionalTime(row["expires_at"], "expires_at"); err != nil {
+		return signingKeyRecord{}, err
+	}
+	return record, nil
+}
+
+// optionalString reads a nullable text column.
+func optionalString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
+
+// optionalTime reads a nullable time column.
+func optionalTime(value interface{}, fieldName string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	parsed, err := sysutils.ParseDBTimeField(value, fieldName)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// nullableString maps an empty string to a NULL column value.
+func nullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// nullableTime maps a nil time to a NULL column value.
+func nullableTime(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
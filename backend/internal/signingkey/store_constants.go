@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+var (
+	// queryListSigningKeys retrieves the signing keys of the deployment, newest first.
+	queryListSigningKeys = dbmodel.DBQuery{
+		ID: "SGKQ-SIGNING_KEY_MGT-01",
+		Query: `SELECT ID, STATUS, PRIVATE_KEY, CERTIFICATE, CREATED_AT, RETIRED_AT, EXPIRES_AT ` +
+			`FROM "SIGNING_KEY" WHERE DEPLOYMENT_ID = $1 ORDER BY CREATED_AT DESC`,
+	}
+
+	// queryCreateSigningKey inserts a signing key.
+	queryCreateSigningKey = dbmodel.DBQuery{
+		ID: "SGKQ-SIGNING_KEY_MGT-02",
+		Query: `INSERT INTO "SIGNING_KEY" ` +
+			`(ID, STATUS, PRIVATE_KEY, CERTIFICATE, CREATED_AT, RETIRED_AT, EXPIRES_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryRetireActiveSigningKeys retires every active signing key of the deployment.
+	queryRetireActiveSigningKeys = dbmodel.DBQuery{
+		ID: "SGKQ-SIGNING_KEY_MGT-03",
+		Query: `UPDATE "SIGNING_KEY" SET STATUS = $1, RETIRED_AT = $2, EXPIRES_AT = $3 ` +
+			`WHERE STATUS = $4 AND DEPLOYMENT_ID = $5`,
+	}
+
+	// queryDeleteExpiredSigningKeys deletes retired signing keys whose expiry has passed.
+	queryDeleteExpiredSigningKeys = dbmodel.DBQuery{
+		ID:    "SGKQ-SIGNING_KEY_MGT-04",
+		Query: `DELETE FROM "SIGNING_KEY" WHERE STATUS = $1 AND EXPIRES_AT <= $2 AND DEPLOYMENT_ID = $3`,
+	}
+)
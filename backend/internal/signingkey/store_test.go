@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *signingKeyStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &signingKeyStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *StoreTestSuite) TestListSigningKeys() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListSigningKeys, testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"id": "rotated-key", "status": "ACTIVE", "private_key": []byte("encrypted"),
+				"certificate": "cert", "created_at": "2026-01-02 03:04:05", "retired_at": nil, "expires_at": nil,
+			},
+			{
+				"id": "default-key", "status": "RETIRED", "private_key": nil, "certificate": nil,
+				"created_at": "2026-01-02 03:04:05", "retired_at": "2026-01-02 03:04:05",
+				"expires_at": "2026-01-09 03:04:05",
+			},
+		}, nil)
+
+	records, err := suite.store.ListSigningKeys(suite.ctx)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+	suite.Equal(KeyStatusActive, records[0].Status)
+	suite.Equal("encrypted", records[0].PrivateKey)
+	suite.Nil(records[0].ExpiresAt)
+	suite.Empty(records[1].Certificate)
+	suite.Equal(time.Date(2026, 1, 9, 3, 4, 5, 0, time.UTC), records[1].ExpiresAt.UTC())
+}
+
+func (suite *StoreTestSuite) TestListSigningKeys_Errors() {
+	suite.Run("QueryError", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryListSigningKeys, testDeploymentID).
+			Return(nil, errors.New("query error"))
+		_, err := suite.store.ListSigningKeys(suite.ctx)
+		suite.Error(err)
+	})
+	suite.Run("InvalidRow", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+		suite.mockDBClient.On("QueryContext", mock.Anything, queryListSigningKeys, testDeploymentID).
+			Return([]map[string]interface{}{{"id": "key", "status": "ACTIVE", "created_at": 42}}, nil)
+		_, err := suite.store.ListSigningKeys(suite.ctx)
+		suite.Error(err)
+	})
+}
+
+func (suite *StoreTestSuite) TestCreateSigningKey_ConfiguredKeyStoresNullMaterial() {
+	now := time.Now().UTC()
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateSigningKey, "default-key", "RETIRED",
+		nil, nil, now, now, now, testDeploymentID).Return(int64(1), nil)
+
+	err := suite.store.CreateSigningKey(suite.ctx, signingKeyRecord{
+		ID: "default-key", Status: KeyStatusRetired, CreatedAt: now, RetiredAt: &now, ExpiresAt: &now,
+	})
+
+	suite.NoError(err)
+}
+
+func (suite *StoreTestSuite) TestRetireActiveSigningKeys() {
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Hour)
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryRetireActiveSigningKeys, "RETIRED", now, expiresAt,
+		"ACTIVE", testDeploymentID).Return(int64(1), nil)
+
+	retired, err := suite.store.RetireActiveSigningKeys(suite.ctx, now, expiresAt)
+
+	suite.NoError(err)
+	suite.Equal(int64(1), retired)
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// KeySyncer owns the background loop that reloads signing keys rotated by another node. Start begins
+// the loop and Stop halts it during graceful shutdown.
+type KeySyncer interface {
+	// Start begins the periodic reload loop. It returns immediately; reloads run in the background.
+	Start(ctx context.Context)
+	// Stop halts the reload loop and waits for an in-flight reload to finish.
+	Stop()
+}
+
+// keySyncer periodically reloads the persisted signing keys through the signing key service.
+type keySyncer struct {
+	service  SigningKeyServiceInterface
+	interval time.Duration
+	logger   *log.Logger
+	cancel   context.CancelFunc
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// newKeySyncer creates a syncer that reloads the signing keys every interval.
+func newKeySyncer(service SigningKeyServiceInterface, interval time.Duration) KeySyncer {
+	return &keySyncer{
+		service:  service,
+		interval: interval,
+		logger:   log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Start launches the periodic reload loop.
+func (k *keySyncer) Start(ctx context.Context) {
+	ctx, k.cancel = context.WithCancel(ctx)
+	go func() {
+		defer close(k.doneCh)
+		ticker := time.NewTicker(k.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := k.service.SyncSigningKeys(ctx); err != nil {
+					k.logger.Error(ctx, "Failed to reload signing keys", log.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Stop cancels the reload loop and waits for it to exit. It is safe to call more than once.
+func (k *keySyncer) Stop() {
+	k.stopOnce.Do(func() {
+		if k.cancel != nil {
+			k.cancel()
+			<-k.doneCh
+		}
+	})
+}
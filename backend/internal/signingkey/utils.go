@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"time"
+)
+
+// minRSAKeySize is the minimum size of a rotated RSA signing key.
+const minRSAKeySize = 2048
+
+// errUnsupportedKeyType is returned when the active key type cannot be used to create a new key.
+var errUnsupportedKeyType = errors.New("unsupported signing key type")
+
+// createSigningKey creates a key of the same type as the given public key with a self-signed
+// certificate. It returns the key pair along with the PEM encoded private key and certificate.
+func createSigningKey(template crypto.PublicKey, now time.Time) (tls.Certificate, []byte, []byte, error) {
+	signer, err := generateKey(template)
+	if err != nil {
+		return tls.Certificate{}, nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, nil, nil, err
+	}
+	certTemplate := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: signingCertificateCommonName},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(signingCertificateValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, certTemplate, certTemplate, signer.Public(), signer)
+	if err != nil {
+		return tls.Certificate{}, nil, nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(signer)
+	if err != nil {
+		return tls.Certificate{}, nil, nil, err
+	}
+
+	certificate := tls.Certificate{Certificate: [][]byte{certDER}, PrivateKey: signer}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	return certificate, keyPEM, certPEM, nil
+}
+
+// generateKey generates a private key of the same type and size as the given public key.
+func generateKey(template crypto.PublicKey) (crypto.Signer, error) {
+	switch pub := template.(type) {
+	case *rsa.PublicKey:
+		return rsa.GenerateKey(rand.Reader, max(pub.N.BitLen(), minRSAKeySize))
+	case *ecdsa.PublicKey:
+		return ecdsa.GenerateKey(pub.Curve, rand.Reader)
+	case ed25519.PublicKey:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	default:
+		return nil, errUnsupportedKeyType
+	}
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package apidocs serves the OpenAPI 3.1 document of the ThunderID management and OAuth APIs at
+// /api-docs. The document is merged from the per-API specifications in the repository's api
+// directory by `go generate` and embedded in the binary, so client generators and API gateways can
+// fetch it from a running server.
+package apidocs
+
+// productName is the product name used in the merged document's info block.
+const productName = "ThunderID"
+
+// openAPIVersion is the OpenAPI version of the merged document.
+const openAPIVersion = "3.1.0"
+
+// Query parameter and media types used to negotiate the document format.
+const (
+	queryParamFormat  = "format"
+	formatYAML        = "yaml"
+	contentTypeJSON   = "application/json"
+	contentTypeYAML   = "application/yaml"
+	cacheControlValue = "public, max-age=3600"
+)
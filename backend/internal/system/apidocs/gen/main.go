@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Command gen regenerates the embedded OpenAPI document from the specifications in the repository's
+// api directory. It is run by `go generate` from the apidocs package directory.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/thunder-id/thunderid/internal/system/apidocs"
+)
+
+// specDir is the api directory relative to the apidocs package directory.
+const specDir = "../../../../api"
+
+// outputFile is the embedded document, relative to the apidocs package directory.
+const outputFile = "openapi.json"
+
+func main() {
+	spec, err := apidocs.Build(os.DirFS(specDir))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to build the OpenAPI document:", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(outputFile, spec, 0o600); err != nil {
+		fmt.Fprintln(os.Stderr, "failed to write the OpenAPI document:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// apiDocsHandler serves the OpenAPI document in JSON and YAML. Both encodings are prepared once at
+// startup.
+type apiDocsHandler struct {
+	jsonDocument []byte
+	yamlDocument []byte
+	logger       *log.Logger
+}
+
+// newAPIDocsHandler creates a new instance of apiDocsHandler.
+func newAPIDocsHandler(jsonDocument, yamlDocument []byte) *apiDocsHandler {
+	return &apiDocsHandler{
+		jsonDocument: jsonDocument,
+		yamlDocument: yamlDocument,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "APIDocsHandler")),
+	}
+}
+
+// HandleGetAPIDocs handles GET /api-docs. The document is returned as YAML when the format query
+// parameter is yaml or the Accept header asks for YAML, and as JSON otherwise.
+func (h *apiDocsHandler) HandleGetAPIDocs(w http.ResponseWriter, r *http.Request) {
+	body, contentType := h.jsonDocument, contentTypeJSON
+	if r.URL.Query().Get(queryParamFormat) == formatYAML ||
+		strings.Contains(r.Header.Get("Accept"), formatYAML) {
+		body, contentType = h.yamlDocument, contentTypeYAML
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", cacheControlValue)
+	w.Header().Add("Vary", "Accept")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		h.logger.Error(r.Context(), "Failed to write the OpenAPI document", log.Error(err))
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const testSpec = `{"openapi":"3.1.0","servers":[{"url":"https://{host}:{port}"}],"paths":{}}`
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mux *http.ServeMux
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	handler, err := buildHandler([]byte(testSpec), "https://id.example.com/")
+	suite.Require().NoError(err)
+	suite.mux = http.NewServeMux()
+	registerRoutes(suite.mux, handler)
+}
+
+// serve sends a GET request for the target with the Accept header.
+func (suite *HandlerTestSuite) serve(target, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	suite.mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *HandlerTestSuite) TestGetAPIDocs_JSON() {
+	rec := suite.serve("/api-docs", "")
+
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal(contentTypeJSON, rec.Header().Get("Content-Type"))
+	var document map[string]any
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &document))
+	suite.Equal([]any{map[string]any{"url": "https://id.example.com"}}, document["servers"])
+}
+
+func (suite *HandlerTestSuite) TestGetAPIDocs_YAML() {
+	for _, rec := range []*httptest.ResponseRecorder{
+		suite.serve("/api-docs?format=yaml", ""),
+		suite.serve("/api-docs", "application/yaml"),
+	} {
+		suite.Equal(http.StatusOK, rec.Code)
+		suite.Equal(contentTypeYAML, rec.Header().Get("Content-Type"))
+		var document map[string]any
+		suite.Require().NoError(yaml.Unmarshal(rec.Body.Bytes(), &document))
+		suite.Equal("3.1.0", document["openapi"])
+	}
+}
+
+func (suite *HandlerTestSuite) TestBuildHandler_InvalidSpec() {
+	_, err := buildHandler([]byte("not json"), "https://id.example.com")
+	suite.Error(err)
+}
+
+func (suite *HandlerTestSuite) TestInitialize() {
+	disabled := http.NewServeMux()
+	suite.Require().NoError(Initialize(disabled, config.APIDocsConfig{}, "https://id.example.com"))
+	rec := httptest.NewRecorder()
+	disabled.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api-docs", nil))
+	suite.Equal(http.StatusNotFound, rec.Code)
+
+	enabled := http.NewServeMux()
+	suite.Require().NoError(Initialize(enabled, config.APIDocsConfig{Enabled: true}, "https://id.example.com"))
+	rec = httptest.NewRecorder()
+	enabled.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api-docs", nil))
+	suite.Equal(http.StatusOK, rec.Code)
+	var document map[string]any
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &document))
+	suite.Contains(document["paths"], "/users")
+}
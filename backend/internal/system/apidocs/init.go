@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"net/http"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize registers the /api-docs route when enabled in the configuration. The embedded document
+// is served with its servers set to serverURL.
+func Initialize(mux *http.ServeMux, cfg config.APIDocsConfig, serverURL string) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	handler, err := buildHandler(embeddedSpec, serverURL)
+	if err != nil {
+		return err
+	}
+	registerRoutes(mux, handler)
+	return nil
+}
+
+// buildHandler prepares the JSON and YAML encodings of the document for the server URL.
+func buildHandler(spec []byte, serverURL string) (*apiDocsHandler, error) {
+	document, err := withServer(spec, serverURL)
+	if err != nil {
+		return nil, err
+	}
+	jsonDocument, err := encodeJSON(document)
+	if err != nil {
+		return nil, err
+	}
+	yamlDocument, err := yaml.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	return newAPIDocsHandler(jsonDocument, yamlDocument), nil
+}
+
+// registerRoutes registers the routes for the OpenAPI document.
+func registerRoutes(mux *http.ServeMux, handler *apiDocsHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods: []string{"GET"},
+		AllowedHeaders: middleware.DefaultAllowedHeaders,
+		MaxAge:         600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /api-docs", handler.HandleGetAPIDocs, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /api-docs", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts))
+}
@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:generate go run ./gen
+
+// embeddedSpec is the merged document generated from the api directory.
+//
+//go:embed openapi.json
+var embeddedSpec []byte
+
+// operationMethods are the path item keys that hold operations.
+var operationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// Build merges the OpenAPI 3.0 specifications at the root of specs into a single OpenAPI 3.1
+// document encoded as indented JSON. It follows the merge rules of the documentation site: tags are
+// de-duplicated by name, a specification's top-level security applies to each of its operations that
+// declares none, and a component defined by several specifications takes the last definition in file
+// name order.
+func Build(specs fs.FS) ([]byte, error) {
+	names, err := fs.Glob(specs, "*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no API specification files found")
+	}
+	sort.Strings(names)
+
+	tags := []any{}
+	tagNames := map[string]bool{}
+	paths := map[string]any{}
+	components := map[string]any{}
+	var license any
+
+	for _, name := range names {
+		data, err := fs.ReadFile(specs, name)
+		if err != nil {
+			return nil, err
+		}
+		spec, err := decodeYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		if info, ok := spec["info"].(map[string]any); ok && license == nil {
+			license = info["license"]
+		}
+
+		specTags, _ := spec["tags"].([]any)
+		for _, tag := range specTags {
+			tagName, _ := tag.(map[string]any)["name"].(string)
+			if !tagNames[tagName] {
+				tagNames[tagName] = true
+				tags = append(tags, tag)
+			}
+		}
+
+		security, hasSecurity := spec["security"]
+		specPaths, _ := spec["paths"].(map[string]any)
+		for path, item := range specPaths {
+			pathItem, _ := item.(map[string]any)
+			merged, _ := paths[path].(map[string]any)
+			if merged == nil {
+				merged = map[string]any{}
+				paths[path] = merged
+			}
+			for key, value := range pathItem {
+				if operation, ok := value.(map[string]any); ok && hasSecurity &&
+					slices.Contains(operationMethods, key) {
+					if _, ok := operation["security"]; !ok {
+						operation["security"] = security
+					}
+				}
+				merged[key] = value
+			}
+		}
+
+		specComponents, _ := spec["components"].(map[string]any)
+		for kind, entries := range specComponents {
+			merged, _ := components[kind].(map[string]any)
+			if merged == nil {
+				merged = map[string]any{}
+				components[kind] = merged
+			}
+			for entryName, entry := range entries.(map[string]any) {
+				merged[entryName] = entry
+			}
+		}
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].(map[string]any)["name"].(string) < tags[j].(map[string]any)["name"].(string)
+	})
+	convertSchemas(paths)
+	convertSchemas(components)
+
+	return encodeJSON(map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":       productName + " API Reference",
+			"version":     "1.0",
+			"description": "Complete API reference for " + productName + " identity and access management.",
+			"license":     license,
+		},
+		"servers": []any{map[string]any{
+			"url": "https://{host}:{port}",
+			"variables": map[string]any{
+				"host": map[string]any{"default": "localhost"},
+				"port": map[string]any{"default": "8090"},
+			},
+		}},
+		"security":   []any{map[string]any{"OAuth2": []any{"system"}}},
+		"tags":       tags,
+		"paths":      paths,
+		"components": components,
+	})
+}
+
+// convertSchemas rewrites the OpenAPI 3.0 schema keywords that changed in 3.1: nullable becomes a
+// "null" type, and boolean exclusiveMinimum and exclusiveMaximum take the value of minimum and
+// maximum. Examples are left untouched since they hold payloads rather than schemas.
+func convertSchemas(node any) {
+	switch value := node.(type) {
+	case map[string]any:
+		if nullable, ok := value["nullable"].(bool); ok {
+			delete(value, "nullable")
+			if schemaType, ok := value["type"].(string); ok && nullable {
+				value["type"] = []any{schemaType, "null"}
+				if enum, ok := value["enum"].([]any); ok && !slices.Contains(enum, nil) {
+					value["enum"] = append(enum, nil)
+				}
+			}
+		}
+		convertExclusiveBound(value, "exclusiveMinimum", "minimum")
+		convertExclusiveBound(value, "exclusiveMaximum", "maximum")
+		for key, child := range value {
+			if key != "example" && key != "examples" {
+				convertSchemas(child)
+			}
+		}
+	case []any:
+		for _, child := range value {
+			convertSchemas(child)
+		}
+	}
+}
+
+// convertExclusiveBound replaces a boolean exclusive bound with the numeric bound it qualifies.
+func convertExclusiveBound(schema map[string]any, exclusiveKey, boundKey string) {
+	exclusive, ok := schema[exclusiveKey].(bool)
+	if !ok {
+		return
+	}
+	delete(schema, exclusiveKey)
+	if bound, ok := schema[boundKey]; ok && exclusive {
+		schema[exclusiveKey] = bound
+		delete(schema, boundKey)
+	}
+}
+
+// decodeYAML decodes a YAML mapping into JSON-compatible values. Scalars other than booleans, numbers
+// and nulls are kept as strings, so that dates in examples are not turned into timestamps.
+func decodeYAML(data []byte) (map[string]any, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	value, err := nodeValue(&root)
+	if err != nil {
+		return nil, err
+	}
+	mapping, ok := value.(map[string]any)
+	if !ok {
+		return nil, errors.New("document is not a mapping")
+	}
+	return mapping, nil
+}
+
+// nodeValue converts a YAML node into a JSON-compatible value.
+func nodeValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return nodeValue(node.Content[0])
+	case yaml.AliasNode:
+		return nodeValue(node.Alias)
+	case yaml.MappingNode:
+		mapping := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := nodeValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			mapping[node.Content[i].Value] = value
+		}
+		return mapping, nil
+	case yaml.SequenceNode:
+		list := make([]any, 0, len(node.Content))
+		for _, child := range node.Content {
+			value, err := nodeValue(child)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		return list, nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			return nil, nil
+		case "!!bool", "!!int", "!!float":
+			var value any
+			if err := node.Decode(&value); err != nil {
+				return nil, err
+			}
+			return value, nil
+		default:
+			return node.Value, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported YAML node at line %d", node.Line)
+	}
+}
+
+// encodeJSON encodes the value as indented JSON without HTML escaping.
+func encodeJSON(value any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// withServer returns the document with its servers replaced by the server URL.
+func withServer(spec []byte, serverURL string) (map[string]any, error) {
+	var document map[string]any
+	if err := json.Unmarshal(spec, &document); err != nil {
+		return nil, err
+	}
+	document["servers"] = []any{map[string]any{"url": strings.TrimRight(serverURL, "/")}}
+	return document, nil
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// apiDir is the repository's api directory relative to this package.
+const apiDir = "../../../../api"
+
+type SpecTestSuite struct {
+	suite.Suite
+}
+
+func TestSpecTestSuite(t *testing.T) {
+	suite.Run(t, new(SpecTestSuite))
+}
+
+// build merges the specs and decodes the result.
+func (suite *SpecTestSuite) build(specs fstest.MapFS) map[string]any {
+	spec, err := Build(specs)
+	suite.Require().NoError(err)
+	var document map[string]any
+	suite.Require().NoError(json.Unmarshal(spec, &document))
+	return document
+}
+
+func (suite *SpecTestSuite) TestEmbeddedSpecIsUpToDate() {
+	if _, err := os.Stat(apiDir); err != nil {
+		suite.T().Skip("api directory is not available")
+	}
+
+	spec, err := Build(os.DirFS(apiDir))
+
+	suite.Require().NoError(err)
+	suite.True(bytes.Equal(spec, embeddedSpec),
+		"openapi.json is out of date; run `go generate ./internal/system/apidocs` from the backend directory")
+}
+
+func (suite *SpecTestSuite) TestBuild_MergesSpecifications() {
+	document := suite.build(fstest.MapFS{
+		"a.yaml": {Data: []byte(`
+openapi: 3.0.3
+info:
+  title: A
+  license:
+    name: Apache 2.0
+tags:
+  - name: Users
+security:
+  - OAuth2: [system]
+paths:
+  /users:
+    get:
+      responses:
+        "200":
+          description: OK
+    post:
+      security: []
+      responses:
+        201:
+          description: Created
+components:
+  schemas:
+    Error:
+      description: first
+`)},
+		"b.yaml": {Data: []byte(`
+openapi: 3.0.3
+info:
+  title: B
+tags:
+  - name: Groups
+  - name: Users
+paths:
+  /users:
+    delete:
+      responses:
+        "204":
+          description: Deleted
+components:
+  schemas:
+    Error:
+      description: second
+`)},
+		"notes.txt": {Data: []byte("ignored")},
+	})
+
+	suite.Equal(openAPIVersion, document["openapi"])
+	suite.Equal(map[string]any{"name": "Apache 2.0"}, document["info"].(map[string]any)["license"])
+	suite.Equal([]any{map[string]any{"name": "Groups"}, map[string]any{"name": "Users"}}, document["tags"])
+
+	users := document["paths"].(map[string]any)["/users"].(map[string]any)
+	suite.Equal([]any{map[string]any{"OAuth2": []any{"system"}}}, users["get"].(map[string]any)["security"])
+	suite.Equal([]any{}, users["post"].(map[string]any)["security"])
+	suite.Contains(users["post"].(map[string]any)["responses"], "201")
+	suite.NotContains(users["delete"], "security")
+
+	schemas := document["components"].(map[string]any)["schemas"].(map[string]any)
+	suite.Equal("second", schemas["Error"].(map[string]any)["description"])
+}
+
+func (suite *SpecTestSuite) TestBuild_ConvertsSchemasToOpenAPI31() {
+	document := suite.build(fstest.MapFS{
+		"a.yaml": {Data: []byte(`
+openapi: 3.0.3
+paths: {}
+components:
+  schemas:
+    Item:
+      type: object
+      properties:
+        parent:
+          type: string
+          nullable: true
+        state:
+          type: string
+          enum: [active, disabled]
+          nullable: true
+        name:
+          type: string
+          nullable: false
+        count:
+          type: integer
+          minimum: 0
+          exclusiveMinimum: true
+          maximum: 10
+          exclusiveMaximum: false
+        createdAt:
+          type: string
+          example: 2026-01-02
+      example:
+        nullable: true
+`)},
+	})
+
+	item := document["components"].(map[string]any)["schemas"].(map[string]any)["Item"].(map[string]any)
+	properties := item["properties"].(map[string]any)
+	suite.Equal(map[string]any{"type": []any{"string", "null"}}, properties["parent"])
+	suite.Equal(map[string]any{"type": []any{"string", "null"}, "enum": []any{"active", "disabled", nil}},
+		properties["state"])
+	suite.Equal(map[string]any{"type": "string"}, properties["name"])
+	suite.Equal(map[string]any{"type": "integer", "exclusiveMinimum": float64(0), "maximum": float64(10)},
+		properties["count"])
+	suite.Equal("2026-01-02", properties["createdAt"].(map[string]any)["example"])
+	suite.Equal(map[string]any{"nullable": true}, item["example"])
+}
+
+func (suite *SpecTestSuite) TestBuild_Errors() {
+	_, err := Build(fstest.MapFS{})
+	suite.Error(err)
+
+	_, err = Build(fstest.MapFS{"a.yaml": {Data: []byte("openapi: [")}})
+	suite.ErrorContains(err, "a.yaml")
+
+	_, err = Build(fstest.MapFS{"a.yaml": {Data: []byte("- item")}})
+	suite.Error(err)
+}
@@ -63,7 +63,7 @@ func Run(ctx context.Context, importSvc importer.ImportServiceInterface, opts Op
 	logger.Info(ctx, "Starting in-process bootstrap of default resources",
 		log.String("defaultsDir", opts.DefaultsDir))
 
-	content, err := loadBundle(opts.DefaultsDir)
+	content, err := LoadBundle(opts.DefaultsDir)
 	if err != nil {
 		return err
 	}
@@ -134,15 +134,16 @@ func checkImportOutcome(ctx context.Context, logger *log.Logger, response *impor
 		response.Summary.Failed, strings.Join(failures, "; "))
 }
 
-// loadBundle reads every YAML file under dir (recursively), in a stable order, and
+// LoadBundle reads every YAML file under dir (recursively), in a stable order, and
 // concatenates them into a single multi-document import payload. The import service
 // orders documents by dependency, so file order only affects same-type sequencing.
-func loadBundle(dir string) (string, error) {
+// A missing directory yields an empty bundle.
+func LoadBundle(dir string) (string, error) {
 	if _, err := os.Stat(dir); err != nil {
 		if os.IsNotExist(err) {
 			return "", nil
 		}
-		return "", fmt.Errorf("failed to access bootstrap resource directory %q: %w", dir, err)
+		return "", fmt.Errorf("failed to access resource directory %q: %w", dir, err)
 	}
 
 	var paths []string
@@ -162,7 +163,7 @@ func loadBundle(dir string) (string, error) {
 		return nil
 	})
 	if walkErr != nil {
-		return "", fmt.Errorf("failed to scan bootstrap resource directory %q: %w", dir, walkErr)
+		return "", fmt.Errorf("failed to scan resource directory %q: %w", dir, walkErr)
 	}
 	sort.Strings(paths)
 
@@ -170,7 +171,7 @@ func loadBundle(dir string) (string, error) {
 	for _, path := range paths {
 		data, err := os.ReadFile(path) //nolint:gosec // paths come from the trusted server home
 		if err != nil {
-			return "", fmt.Errorf("failed to read bootstrap definition %q: %w", path, err)
+			return "", fmt.Errorf("failed to read resource definition %q: %w", path, err)
 		}
 		if builder.Len() > 0 {
 			builder.WriteString("\n---\n")
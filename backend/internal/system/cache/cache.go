@@ -64,12 +64,15 @@ func (c *Cache[T]) Set(ctx context.Context, key CacheKey, value T) error {
 	return nil
 }
 
-// Get retrieves a value from the cache.
+// Get retrieves a value from the cache. Lookups on an enabled cache are recorded as hit or miss
+// in the cache metrics.
 func (c *Cache[T]) Get(ctx context.Context, key CacheKey) (T, bool) {
 	if c.IsEnabled() && c.cacheImpl.IsEnabled() {
 		if value, found := c.cacheImpl.Get(ctx, key); found {
+			recordLookup(ctx, c.cacheName, cacheResultHit)
 			return value, true
 		}
+		recordLookup(ctx, c.cacheName, cacheResultMiss)
 	}
 
 	var zero T
@@ -20,6 +20,7 @@ package cache
 
 import (
 	"context"
+	"math"
 	"reflect"
 	"sync"
 	"time"
@@ -246,8 +247,37 @@ func buildRedisKeyPrefix(basePrefix, deploymentID string) string {
 	return basePrefix + ":" + deploymentID
 }
 
+// CacheOption customizes a cache when it is created by GetCache or GetInMemoryCache.
+type CacheOption func(*cacheOptions)
+
+// cacheOptions holds the settings applied by CacheOption values.
+type cacheOptions struct {
+	defaultTTL time.Duration
+}
+
+// WithDefaultTTL sets the entry TTL for a cache that has no TTL of its own in the cache
+// configuration. A TTL configured for the cache by name still takes precedence, and the TTL is
+// rounded up to whole seconds.
+func WithDefaultTTL(ttl time.Duration) CacheOption {
+	return func(o *cacheOptions) {
+		o.defaultTTL = ttl
+	}
+}
+
+// applyCacheOptions returns the cache property with the given options applied.
+func applyCacheOptions(cacheProperty engineconfig.CacheProperty, opts []CacheOption) engineconfig.CacheProperty {
+	var options cacheOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if cacheProperty.TTL <= 0 && options.defaultTTL > 0 {
+		cacheProperty.TTL = int(math.Ceil(options.defaultTTL.Seconds()))
+	}
+	return cacheProperty
+}
+
 // newCache creates a new cache instance.
-func newCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[T] {
+func newCache[T any](cm CacheManagerInterface, cacheName string, opts ...CacheOption) CacheInterface[T] {
 	// Cache infrastructure logging has no request scope, so context.Background() is used.
 	ctx := context.Background()
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheManager"),
@@ -263,7 +293,7 @@ func newCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[
 		}
 	}
 
-	cacheProperty := getCacheProperty(cacheConfig, cacheName)
+	cacheProperty := applyCacheOptions(getCacheProperty(cacheConfig, cacheName), opts)
 
 	if cacheProperty.Disabled {
 		logger.Debug(ctx, "Individual cache is disabled, returning empty")
@@ -325,7 +355,8 @@ func newCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[
 }
 
 // GetInMemoryCache returns a singleton in-memory cache instance for the given type and cache name.
-func GetInMemoryCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[T] {
+// The options apply only when the call creates the cache.
+func GetInMemoryCache[T any](cm CacheManagerInterface, cacheName string, opts ...CacheOption) CacheInterface[T] {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheManager"))
 
 	var t T
@@ -356,7 +387,7 @@ func GetInMemoryCache[T any](cm CacheManagerInterface, cacheName string) CacheIn
 		log.String("cacheName", cacheName), log.String("type", typeName))
 
 	cacheConfig := cm.getCacheConfig()
-	cacheProperty := getCacheProperty(cacheConfig, cacheName)
+	cacheProperty := applyCacheOptions(getCacheProperty(cacheConfig, cacheName), opts)
 
 	var internalCache CacheInterface[T]
 	if cacheConfig.Disabled || cacheProperty.Disabled {
@@ -375,7 +406,8 @@ func GetInMemoryCache[T any](cm CacheManagerInterface, cacheName string) CacheIn
 }
 
 // GetCache returns a singleton cache instance for the given type and cache name.
-func GetCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[T] {
+// The options apply only when the call creates the cache.
+func GetCache[T any](cm CacheManagerInterface, cacheName string, opts ...CacheOption) CacheInterface[T] {
 	// Cache infrastructure logging has no request scope, so context.Background() is used.
 	ctx := context.Background()
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheManager"))
@@ -415,7 +447,7 @@ func GetCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[
 
 	// Create a new cache
 	logger.Debug(ctx, "Creating new cache", log.String("cacheName", cacheName), log.String("type", typeName))
-	newCacheInst := newCache[T](cm, cacheName)
+	newCacheInst := newCache[T](cm, cacheName, opts...)
 	cm.addCache(cacheKey, newCacheInst)
 
 	return newCacheInst
@@ -440,6 +440,44 @@ func (suite *CacheManagerTestSuite) TestNewCache() {
 	assert.True(t, cache4.IsEnabled())
 }
 
+func (suite *CacheManagerTestSuite) TestApplyCacheOptions() {
+	testCases := []struct {
+		name        string
+		propertyTTL int
+		opts        []CacheOption
+		expectedTTL int
+	}{
+		{"NoOptions", 0, nil, 0},
+		{"DefaultTTLApplied", 0, []CacheOption{WithDefaultTTL(90 * time.Second)}, 90},
+		{"DefaultTTLRoundedUp", 0, []CacheOption{WithDefaultTTL(1500 * time.Millisecond)}, 2},
+		{"ConfiguredTTLTakesPrecedence", 30, []CacheOption{WithDefaultTTL(90 * time.Second)}, 30},
+		{"ZeroDefaultTTLIgnored", 0, []CacheOption{WithDefaultTTL(0)}, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			property := applyCacheOptions(engineconfig.CacheProperty{Name: "c", TTL: tc.propertyTTL}, tc.opts)
+			suite.Equal(tc.expectedTTL, property.TTL)
+			suite.Equal("c", property.Name)
+		})
+	}
+}
+
+func (suite *CacheManagerTestSuite) TestGetCacheWithDefaultTTL() {
+	cm := Initialize(engineconfig.CacheConfig{Type: "inmemory", TTL: 3600}, "test-deployment")
+	defer cm.Close()
+
+	cacheInst := GetCache[string](cm, "defaultTTLCache", WithDefaultTTL(2*time.Minute))
+	impl, ok := cacheInst.(*Cache[string]).cacheImpl.(*inMemoryCache[string])
+	suite.Require().True(ok)
+	suite.Equal(2*time.Minute, impl.ttl)
+
+	// The options of a later call do not change an existing cache.
+	again := GetCache[string](cm, "defaultTTLCache", WithDefaultTTL(time.Minute))
+	suite.Same(cacheInst, again)
+	suite.Equal(2*time.Minute, impl.ttl)
+}
+
 func (suite *CacheManagerTestSuite) TestGetCleanupInterval() {
 	t := suite.T()
 	config := engineconfig.CacheConfig{
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// cacheResultHit is the lookup result recorded when the key is found in the cache.
+	cacheResultHit = "hit"
+	// cacheResultMiss is the lookup result recorded when the key is not found in the cache.
+	cacheResultMiss = "miss"
+)
+
+type cacheMetrics struct {
+	once    sync.Once
+	lookups metric.Int64Counter
+}
+
+var metrics cacheMetrics
+
+func initCacheMetrics() {
+	metrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/cache")
+		metrics.lookups, _ = meter.Int64Counter(
+			"thunderid_cache_lookups_total",
+			metric.WithDescription("Cache lookups by cache name and result"),
+		)
+	})
+}
+
+// recordLookup records a cache lookup. The result is hit or miss.
+func recordLookup(ctx context.Context, cacheName, result string) {
+	initCacheMetrics()
+	if metrics.lookups == nil {
+		return
+	}
+	metrics.lookups.Add(
+		ctx,
+		1,
+		metric.WithAttributes(
+			attribute.String("cache.name", cacheName),
+			attribute.String("cache.result", result),
+		),
+	)
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package circuitbreaker stops calls to a failing dependency for a while, so that callers fail fast
+// instead of waiting for every call to time out.
+//
+// A breaker starts closed and lets every call through. After a number of consecutive failures it
+// opens and rejects calls. Once the open period has passed, it lets a single trial call through: a
+// successful trial closes the breaker and a failed one opens it again.
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// defaultFailureThreshold is the number of consecutive failures that opens a breaker when the
+	// settings do not give one.
+	defaultFailureThreshold = 5
+	// defaultOpenDuration is how long a breaker stays open when the settings do not give a duration.
+	defaultOpenDuration = 30 * time.Second
+)
+
+// ErrOpen is returned for calls that are rejected because the breaker is open.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the state of a breaker.
+type State string
+
+const (
+	// StateClosed lets every call through.
+	StateClosed State = "closed"
+	// StateOpen rejects calls until the open period has passed.
+	StateOpen State = "open"
+	// StateHalfOpen lets a single trial call through and rejects the others.
+	StateHalfOpen State = "half_open"
+)
+
+// Settings configures a breaker. Zero values fall back to the package defaults.
+type Settings struct {
+	FailureThreshold int
+	OpenDuration     time.Duration
+}
+
+// Breaker is a circuit breaker for one dependency. It is safe for concurrent use.
+type Breaker struct {
+	name      string
+	threshold int
+	openFor   time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+}
+
+// New creates a closed breaker with the given name and settings.
+func New(name string, settings Settings) *Breaker {
+	threshold := settings.FailureThreshold
+	if threshold <= 0 {
+		threshold = defaultFailureThreshold
+	}
+	openFor := settings.OpenDuration
+	if openFor <= 0 {
+		openFor = defaultOpenDuration
+	}
+	return &Breaker{
+		name:      name,
+		threshold: threshold,
+		openFor:   openFor,
+		now:       time.Now,
+		state:     StateClosed,
+	}
+}
+
+// Name returns the name of the breaker.
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State returns the current state of the breaker. An open breaker whose open period has passed is
+// reported as open until the next call is allowed through.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow reports whether a call may go ahead. Every allowed call must be followed by Success,
+// Failure or Cancel.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateClosed:
+		return true
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.openFor {
+			recordRejected(b.name)
+			return false
+		}
+		b.transition(StateHalfOpen)
+		return true
+	default:
+		// A trial call is already in flight.
+		recordRejected(b.name)
+		return false
+	}
+}
+
+// Success records a successful call. It closes a half-open breaker.
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	if b.state != StateClosed {
+		b.transition(StateClosed)
+	}
+}
+
+// Failure records a failed call. It opens the breaker when the failure threshold is reached, or
+// when the trial call of a half-open breaker fails.
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == StateHalfOpen || (b.state == StateClosed && b.failures >= b.threshold) {
+		b.openedAt = b.now()
+		b.transition(StateOpen)
+	}
+}
+
+// Cancel records a call that ended without telling whether the dependency is healthy, such as a
+// call cancelled by its caller. A half-open breaker goes back to open, and the next call is allowed
+// through as a new trial.
+func (b *Breaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateHalfOpen {
+		b.state = StateOpen
+	}
+}
+
+// transition moves the breaker to the given state. The caller must hold the lock.
+func (b *Breaker) transition(state State) {
+	b.state = state
+	if state == StateClosed {
+		b.failures = 0
+	}
+	recordTransition(b.name, state)
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CircuitBreaker"),
+		log.String("breaker", b.name))
+	// State changes are not tied to a single request, so context.Background() is used.
+	if state == StateOpen {
+		logger.Warn(context.Background(), "Circuit breaker opened", log.Int("failures", b.failures),
+			log.Any("openFor", b.openFor))
+	} else {
+		logger.Debug(context.Background(), "Circuit breaker state changed", log.String("state", string(state)))
+	}
+}
+
+// Registry holds one breaker per name, so that every caller of a dependency shares its breaker.
+type Registry struct {
+	mu       sync.Mutex
+	breakers map[string]*Breaker
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{breakers: make(map[string]*Breaker)}
+}
+
+// Get returns the breaker with the given name, creating it with the given settings on first use.
+func (r *Registry) Get(name string, settings Settings) *Breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if b, ok := r.breakers[name]; ok {
+		return b
+	}
+	b := New(name, settings)
+	r.breakers[name] = b
+	return b
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package circuitbreaker
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CircuitBreakerTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func TestCircuitBreakerTestSuite(t *testing.T) {
+	suite.Run(t, new(CircuitBreakerTestSuite))
+}
+
+func (s *CircuitBreakerTestSuite) SetupTest() {
+	s.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// newBreaker creates a breaker whose clock is the suite's clock.
+func (s *CircuitBreakerTestSuite) newBreaker(threshold int, openFor time.Duration) *Breaker {
+	b := New("test", Settings{FailureThreshold: threshold, OpenDuration: openFor})
+	b.now = func() time.Time { return s.now }
+	return b
+}
+
+func (s *CircuitBreakerTestSuite) TestNew_Defaults() {
+	b := New("idp", Settings{})
+	s.Equal("idp", b.Name())
+	s.Equal(defaultFailureThreshold, b.threshold)
+	s.Equal(defaultOpenDuration, b.openFor)
+	s.Equal(StateClosed, b.State())
+}
+
+func (s *CircuitBreakerTestSuite) TestOpensAfterConsecutiveFailures() {
+	b := s.newBreaker(3, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		s.True(b.Allow())
+		b.Failure()
+	}
+	s.Equal(StateClosed, b.State())
+
+	s.True(b.Allow())
+	b.Failure()
+	s.Equal(StateOpen, b.State())
+	s.False(b.Allow())
+}
+
+func (s *CircuitBreakerTestSuite) TestSuccessResetsFailureCount() {
+	b := s.newBreaker(2, time.Minute)
+
+	b.Failure()
+	b.Success()
+	b.Failure()
+	s.Equal(StateClosed, b.State())
+}
+
+func (s *CircuitBreakerTestSuite) TestHalfOpenTrialSuccessCloses() {
+	b := s.newBreaker(1, time.Minute)
+	b.Failure()
+	s.False(b.Allow())
+
+	s.now = s.now.Add(time.Minute)
+	s.True(b.Allow())
+	s.Equal(StateHalfOpen, b.State())
+	// Only the trial call goes through while half-open.
+	s.False(b.Allow())
+
+	b.Success()
+	s.Equal(StateClosed, b.State())
+	s.True(b.Allow())
+}
+
+func (s *CircuitBreakerTestSuite) TestHalfOpenTrialFailureReopens() {
+	b := s.newBreaker(1, time.Minute)
+	b.Failure()
+
+	s.now = s.now.Add(time.Minute)
+	s.True(b.Allow())
+	b.Failure()
+	s.Equal(StateOpen, b.State())
+
+	// The open period starts again from the failed trial.
+	s.now = s.now.Add(30 * time.Second)
+	s.False(b.Allow())
+	s.now = s.now.Add(30 * time.Second)
+	s.True(b.Allow())
+}
+
+func (s *CircuitBreakerTestSuite) TestCancelReleasesTrial() {
+	b := s.newBreaker(1, time.Minute)
+	b.Failure()
+
+	s.now = s.now.Add(time.Minute)
+	s.True(b.Allow())
+	b.Cancel()
+	s.Equal(StateOpen, b.State())
+
+	// The next call becomes the new trial without waiting for another open period.
+	s.True(b.Allow())
+	s.Equal(StateHalfOpen, b.State())
+}
+
+func (s *CircuitBreakerTestSuite) TestCancelWhenClosedIsNoOp() {
+	b := s.newBreaker(1, time.Minute)
+	s.True(b.Allow())
+	b.Cancel()
+	s.Equal(StateClosed, b.State())
+}
+
+func (s *CircuitBreakerTestSuite) TestRegistry_SharesBreakerByName() {
+	r := NewRegistry()
+
+	a := r.Get("idp.example.com", Settings{FailureThreshold: 2})
+	again := r.Get("idp.example.com", Settings{FailureThreshold: 10})
+	other := r.Get("sms.example.com", Settings{})
+
+	s.Same(a, again)
+	s.Equal(2, again.threshold)
+	s.NotSame(a, other)
+}
+
+func (s *CircuitBreakerTestSuite) TestConcurrentUse() {
+	b := New("concurrent", Settings{FailureThreshold: 1000})
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if b.Allow() {
+				if i%2 == 0 {
+					b.Success()
+				} else {
+					b.Failure()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	s.Equal(StateClosed, b.State())
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package circuitbreaker
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type breakerMetrics struct {
+	once        sync.Once
+	transitions metric.Int64Counter
+	rejected    metric.Int64Counter
+}
+
+var metrics breakerMetrics
+
+func initBreakerMetrics() {
+	metrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/circuitbreaker")
+		metrics.transitions, _ = meter.Int64Counter(
+			"thunderid_circuit_breaker_transitions_total",
+			metric.WithDescription("Circuit breaker state changes by breaker and new state"),
+		)
+		metrics.rejected, _ = meter.Int64Counter(
+			"thunderid_circuit_breaker_rejected_total",
+			metric.WithDescription("Calls rejected by an open circuit breaker by breaker"),
+		)
+	})
+}
+
+// recordTransition records that a breaker moved to the given state.
+func recordTransition(name string, state State) {
+	initBreakerMetrics()
+	if metrics.transitions == nil {
+		return
+	}
+	metrics.transitions.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("breaker.name", name),
+		attribute.String("breaker.state", string(state)),
+	))
+}
+
+// recordRejected records a call rejected by a breaker.
+func recordRejected(name string) {
+	initBreakerMetrics()
+	if metrics.rejected == nil {
+		return
+	}
+	metrics.rejected.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("breaker.name", name),
+	))
+}
@@ -28,8 +28,10 @@ import (
 	urlpath "path"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/log/rollingfile"
@@ -48,12 +50,13 @@ type TLSConfig struct {
 
 // DataSource holds the individual database connection details.
 // Type is the only common field; connection parameters live under the
-// matching sub-struct (Postgres, SQLite, or Redis).
+// matching sub-struct (Postgres, SQLite, Redis, or Bolt).
 type DataSource struct {
 	Type     string             `yaml:"type"     json:"type"`
 	Postgres PostgresDataSource `yaml:"postgres" json:"postgres"`
 	SQLite   SQLiteDataSource   `yaml:"sqlite"   json:"sqlite"`
 	Redis    RedisDataSource    `yaml:"redis"    json:"redis"`
+	Bolt     BoltDataSource     `yaml:"bolt"     json:"bolt"`
 }
 
 // PostgresDataSource holds PostgreSQL-specific connection details.
@@ -67,9 +70,32 @@ type PostgresDataSource struct {
 	MaxOpenConns      int    `yaml:"max_open_conns"       json:"max_open_conns"`
 	MaxIdleConns      int    `yaml:"max_idle_conns"       json:"max_idle_conns"`
 	ConnMaxLifetime   int    `yaml:"conn_max_lifetime"    json:"conn_max_lifetime"`
+	ConnMaxIdleTime   int    `yaml:"conn_max_idle_time"   json:"conn_max_idle_time"`
+	ConnectTimeout    int    `yaml:"connect_timeout"      json:"connect_timeout"`
 	MaxRetries        int    `yaml:"max_retries"          json:"max_retries"`
 	MinRetryBackoffMS int    `yaml:"min_retry_backoff_ms" json:"min_retry_backoff_ms"`
 	MaxRetryBackoffMS int    `yaml:"max_retry_backoff_ms" json:"max_retry_backoff_ms"`
+	// StatementTimeoutMS is sent to the server as the statement_timeout session parameter.
+	StatementTimeoutMS int `yaml:"statement_timeout_ms" json:"statement_timeout_ms"`
+	// PgBouncerMode avoids startup parameters and named prepared statements, which PgBouncer
+	// rejects in transaction pooling mode.
+	PgBouncerMode bool `yaml:"pgbouncer_mode" json:"pgbouncer_mode"`
+	// Replicas are read replicas of the database. Reads outside a transaction are spread across
+	// them while writes go to the primary. Replicas use the credentials and pool settings of the
+	// primary. Replicas of the runtime database are ignored.
+	Replicas []PostgresReplica `yaml:"replicas" json:"replicas"`
+	// MaxReplicaLagMS excludes a replica from reads while its replay lag exceeds this value. Zero
+	// uses the default tolerance and a negative value disables the lag check.
+	MaxReplicaLagMS int `yaml:"max_replica_lag_ms" json:"max_replica_lag_ms"`
+	// StatementCacheSize is the number of prepared statements kept per connection pool. Zero uses
+	// the default size and a negative value disables caching. Caching is always off in PgBouncer mode.
+	StatementCacheSize int `yaml:"statement_cache_size" json:"statement_cache_size"`
+}
+
+// PostgresReplica holds the address of a PostgreSQL read replica.
+type PostgresReplica struct {
+	Hostname string `yaml:"hostname" json:"hostname"`
+	Port     int    `yaml:"port"     json:"port"`
 }
 
 // SQLiteDataSource holds SQLite-specific connection details.
@@ -79,9 +105,16 @@ type SQLiteDataSource struct {
 	MaxOpenConns      int    `yaml:"max_open_conns"       json:"max_open_conns"`
 	MaxIdleConns      int    `yaml:"max_idle_conns"       json:"max_idle_conns"`
 	ConnMaxLifetime   int    `yaml:"conn_max_lifetime"    json:"conn_max_lifetime"`
+	ConnMaxIdleTime   int    `yaml:"conn_max_idle_time"   json:"conn_max_idle_time"`
 	MaxRetries        int    `yaml:"max_retries"          json:"max_retries"`
 	MinRetryBackoffMS int    `yaml:"min_retry_backoff_ms" json:"min_retry_backoff_ms"`
 	MaxRetryBackoffMS int    `yaml:"max_retry_backoff_ms" json:"max_retry_backoff_ms"`
+	// StatementCacheSize is the number of prepared statements kept per connection pool. Zero uses
+	// the default size and a negative value disables caching.
+	StatementCacheSize int `yaml:"statement_cache_size" json:"statement_cache_size"`
+	// SerializeWrites runs the statements that write outside a transaction one at a time, so that
+	// concurrent writers queue in the server instead of contending for the database lock.
+	SerializeWrites bool `yaml:"serialize_writes" json:"serialize_writes"`
 }
 
 // RedisDataSource holds Redis-specific connection details.
@@ -99,6 +132,13 @@ type RedisDataSource struct {
 	WriteTimeoutMS    int    `yaml:"write_timeout_ms"     json:"write_timeout_ms"`
 }
 
+// BoltDataSource holds the details of an embedded bbolt key-value store. It is only supported for the
+// runtime datasource.
+type BoltDataSource struct {
+	// Path is the store file, relative to the server home.
+	Path string `yaml:"path" json:"path"`
+}
+
 // DatabaseConfig holds the different database configuration details.
 type DatabaseConfig struct {
 	Config    DataSource `yaml:"config"    json:"config"`
@@ -107,6 +147,62 @@ type DatabaseConfig struct {
 	Operation DataSource `yaml:"operation" json:"operation"`
 }
 
+// Validate checks the connection pool settings of every configured data source.
+func (c *DatabaseConfig) Validate() error {
+	for name, ds := range map[string]DataSource{
+		"config": c.Config, "runtime": c.Runtime, "user": c.User, "operation": c.Operation,
+	} {
+		if ds.Type == "bolt" && name != "runtime" {
+			return fmt.Errorf("database.%s.type cannot be bolt; it is only supported for the runtime datasource",
+				name)
+		}
+		if err := ds.validate("database." + name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks the pool settings of the data source's active sub-config. path is the
+// config path used in error messages.
+func (ds *DataSource) validate(path string) error {
+	switch ds.Type {
+	case "postgres":
+		pg := ds.Postgres
+		if err := validatePool(path+".postgres", pg.MaxOpenConns, pg.MaxIdleConns,
+			pg.ConnMaxLifetime, pg.ConnMaxIdleTime); err != nil {
+			return err
+		}
+		if pg.ConnectTimeout < 0 || pg.StatementTimeoutMS < 0 {
+			return fmt.Errorf("%s.postgres.connect_timeout and statement_timeout_ms must not be negative", path)
+		}
+		if pg.PgBouncerMode && pg.StatementTimeoutMS > 0 {
+			return fmt.Errorf("%s.postgres.statement_timeout_ms cannot be sent through PgBouncer; "+
+				"set statement_timeout on the database role instead", path)
+		}
+	case "sqlite":
+		sl := ds.SQLite
+		return validatePool(path+".sqlite", sl.MaxOpenConns, sl.MaxIdleConns,
+			sl.ConnMaxLifetime, sl.ConnMaxIdleTime)
+	case "bolt":
+		if ds.Bolt.Path == "" {
+			return fmt.Errorf("%s.bolt.path is required", path)
+		}
+	}
+	return nil
+}
+
+// validatePool checks SQL connection pool settings. Zero values keep the driver defaults.
+func validatePool(path string, maxOpen, maxIdle, maxLifetime, maxIdleTime int) error {
+	if maxOpen < 0 || maxIdle < 0 || maxLifetime < 0 || maxIdleTime < 0 {
+		return fmt.Errorf("%s connection pool settings must not be negative", path)
+	}
+	if maxOpen > 0 && maxIdle > maxOpen {
+		return fmt.Errorf("%s.max_idle_conns (%d) must not exceed max_open_conns (%d)", path, maxIdle, maxOpen)
+	}
+	return nil
+}
+
 // NotificationConfig holds the notification configuration details.
 type NotificationConfig struct {
 	OTP OTPConfig `yaml:"otp" json:"otp"`
@@ -118,10 +214,15 @@ func (c *NotificationConfig) Validate() error {
 }
 
 // OTPConfig holds the OTP generation configuration details.
+// MaxVerifyAttempts is the number of wrong codes accepted before an OTP is invalidated, and 0 applies
+// the default. ResendIntervalSeconds is the minimum gap between OTPs for the same recipient, and 0
+// disables resend throttling.
 type OTPConfig struct {
 	Length                int  `yaml:"length"                  json:"length"`
 	UseNumericOnly        bool `yaml:"use_numeric_only"        json:"use_numeric_only"`
 	ValidityPeriodSeconds int  `yaml:"validity_period_seconds" json:"validity_period_seconds"`
+	MaxVerifyAttempts     int  `yaml:"max_verify_attempts"     json:"max_verify_attempts"`
+	ResendIntervalSeconds int  `yaml:"resend_interval_seconds" json:"resend_interval_seconds"`
 }
 
 // Validate ensures OTP configuration values are within accepted bounds.
@@ -133,6 +234,13 @@ func (c *OTPConfig) Validate() error {
 		return fmt.Errorf("notification.otp.validity_period_seconds must be in [30, 600] (got %d)",
 			c.ValidityPeriodSeconds)
 	}
+	if c.MaxVerifyAttempts < 0 || c.MaxVerifyAttempts > 10 {
+		return fmt.Errorf("notification.otp.max_verify_attempts must be in [0, 10] (got %d)", c.MaxVerifyAttempts)
+	}
+	if c.ResendIntervalSeconds < 0 || c.ResendIntervalSeconds > 300 {
+		return fmt.Errorf("notification.otp.resend_interval_seconds must be in [0, 300] (got %d)",
+			c.ResendIntervalSeconds)
+	}
 	return nil
 }
 
@@ -141,6 +249,33 @@ type CryptoConfig struct {
 	Encryption      engineconfig.EncryptionConfig `yaml:"encryption"       json:"encryption"`
 	PasswordHashing PasswordHashingConfig         `yaml:"password_hashing" json:"password_hashing"`
 	Keys            []engineconfig.KeyConfig      `yaml:"keys"             json:"keys"`
+	KeyRotation     KeyRotationConfig             `yaml:"key_rotation"     json:"key_rotation"`
+	ExternalKeys    []ExternalKeyConfig           `yaml:"external_keys"    json:"external_keys"`
+}
+
+// ExternalKeyConfig describes a signing key whose private key is held by an external key manager.
+type ExternalKeyConfig struct {
+	// ID is the key id used to reference the key, for example from jwt.preferred_key_id.
+	ID string `yaml:"id" json:"id"`
+	// Provider is the external key manager holding the key: aws_kms or gcp_kms.
+	Provider string `yaml:"provider" json:"provider"`
+	// KeyRef identifies the key in the provider: a key id or ARN for AWS KMS, or a crypto key
+	// version resource name for GCP KMS.
+	KeyRef string `yaml:"key_ref" json:"key_ref"`
+	// Region is the AWS region of the key. Defaults to the AWS_REGION environment variable.
+	Region string `yaml:"region" json:"region"`
+	// Endpoint overrides the provider's API endpoint, for example to use a private endpoint.
+	Endpoint string `yaml:"endpoint" json:"endpoint"`
+}
+
+// KeyRotationConfig holds the signing key rotation configuration.
+type KeyRotationConfig struct {
+	// RetiredKeyValidityPeriod is how long, in seconds, a retired signing key stays published in the
+	// JWKS and usable for verification. It should cover the longest token validity period.
+	RetiredKeyValidityPeriod int64 `yaml:"retired_key_validity_period" json:"retired_key_validity_period"`
+	// SyncInterval is how often, in seconds, each node reloads signing keys rotated by another node.
+	// Zero disables the periodic reload.
+	SyncInterval int64 `yaml:"sync_interval" json:"sync_interval"`
 }
 
 // PasswordHashingConfig holds the password hashing configuration details.
@@ -149,6 +284,8 @@ type PasswordHashingConfig struct {
 	Argon2ID  Argon2IDConfig `yaml:"argon2id"  json:"argon2id"`
 	PBKDF2    PBKDF2Config   `yaml:"pbkdf2"    json:"pbkdf2"`
 	SHA256    SHA256Config   `yaml:"sha256"    json:"sha256"`
+	Scrypt    ScryptConfig   `yaml:"scrypt"    json:"scrypt"`
+	Bcrypt    BcryptConfig   `yaml:"bcrypt"    json:"bcrypt"`
 }
 
 // Argon2IDConfig holds the Argon2id password hashing configuration details.
@@ -172,6 +309,20 @@ type SHA256Config struct {
 	SaltSize int `yaml:"salt_size" json:"salt_size"`
 }
 
+// ScryptConfig holds the scrypt password hashing configuration details.
+type ScryptConfig struct {
+	Cost        int `yaml:"cost"        json:"cost"`
+	BlockSize   int `yaml:"block_size"  json:"block_size"`
+	Parallelism int `yaml:"parallelism" json:"parallelism"`
+	KeySize     int `yaml:"key_size"    json:"key_size"`
+	SaltSize    int `yaml:"salt_size"   json:"salt_size"`
+}
+
+// BcryptConfig holds the bcrypt password hashing configuration details.
+type BcryptConfig struct {
+	Cost int `yaml:"cost" json:"cost"`
+}
+
 // UserConfig holds the user management configuration details.
 type UserConfig struct {
 	IndexedAttributes []string `yaml:"indexed_attributes" json:"indexed_attributes"`
@@ -181,6 +332,661 @@ type UserConfig struct {
 	//   - If DeclarativeResources.Enabled = true: behaves as "declarative"
 	//   - If DeclarativeResources.Enabled = false: behaves as "mutable"
 	Store string `yaml:"store"              json:"store"`
+	// SensitiveAttributes lists the top-level user attributes returned only to callers holding the
+	// sensitive user attributes permission. Other callers receive users without these attributes.
+	SensitiveAttributes []string `yaml:"sensitive_attributes" json:"sensitive_attributes"`
+}
+
+// SystemInfoConfig holds the configuration for the system information endpoints. The authenticated
+// /system/info endpoint is always available to administrators; PublicEnabled additionally exposes a
+// minimal unauthenticated /system/info/public endpoint reporting only the product name and version.
+type SystemInfoConfig struct {
+	PublicEnabled bool              `yaml:"public_enabled" json:"public_enabled"`
+	SecurityTxt   SecurityTxtConfig `yaml:"security_txt"   json:"security_txt"`
+}
+
+// Validate checks the system information configuration for correctness.
+func (c *SystemInfoConfig) Validate() error {
+	return c.SecurityTxt.Validate()
+}
+
+// APIDocsConfig holds the configuration for the OpenAPI document served at /api-docs.
+type APIDocsConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+}
+
+// MockIdPConfig configures the embedded mock OpenID Connect provider used by integration tests and local
+// development to exercise federated sign-in without an external identity provider. It listens on its own
+// port and must never be enabled in production.
+type MockIdPConfig struct {
+	Enabled      bool                   `yaml:"enabled"       json:"enabled"`
+	Hostname     string                 `yaml:"hostname"      json:"hostname"`
+	Port         int                    `yaml:"port"          json:"port"`
+	ClientID     string                 `yaml:"client_id"     json:"client_id"`
+	ClientSecret string                 `yaml:"client_secret" json:"client_secret"`
+	Claims       map[string]interface{} `yaml:"claims"        json:"claims"`
+}
+
+// GRPCConfig configures the gRPC management API, which serves the user, group, application and flow
+// management operations on its own port alongside the REST API.
+type GRPCConfig struct {
+	Enabled  bool   `yaml:"enabled"  json:"enabled"`
+	Hostname string `yaml:"hostname" json:"hostname"`
+	Port     int    `yaml:"port"     json:"port"`
+}
+
+// GraphQLConfig configures the read-only GraphQL API, which composes users, groups, roles, sessions and
+// applications in a single query. MaxDepth limits how deeply the selections of a query may nest.
+type GraphQLConfig struct {
+	Enabled  bool `yaml:"enabled"   json:"enabled"`
+	MaxDepth int  `yaml:"max_depth" json:"max_depth"`
+}
+
+// Validate ensures the depth limit is positive when the GraphQL API is enabled.
+func (c *GraphQLConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxDepth < 1 {
+		return fmt.Errorf("graphql.max_depth must be at least 1 (got %d)", c.MaxDepth)
+	}
+	return nil
+}
+
+// AdminNotificationsConfig configures the WebSocket channel that pushes admin-relevant events, such as account
+// lockouts, failure spikes and webhook delivery failures, to the operations dashboard. A failure spike is
+// reported when FailureSpikeThreshold failed events are observed within FailureSpikeWindowSeconds.
+// MaxConnections limits the number of connected dashboards.
+type AdminNotificationsConfig struct {
+	Enabled                   bool `yaml:"enabled"                      json:"enabled"`
+	FailureSpikeThreshold     int  `yaml:"failure_spike_threshold"      json:"failure_spike_threshold"`
+	FailureSpikeWindowSeconds int  `yaml:"failure_spike_window_seconds" json:"failure_spike_window_seconds"`
+	MaxConnections            int  `yaml:"max_connections"              json:"max_connections"`
+}
+
+// Validate ensures the failure spike settings and the connection limit are positive when admin
+// notifications are enabled.
+func (c *AdminNotificationsConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.FailureSpikeThreshold < 1 {
+		return fmt.Errorf("admin_notifications.failure_spike_threshold must be at least 1 (got %d)",
+			c.FailureSpikeThreshold)
+	}
+	if c.FailureSpikeWindowSeconds < 1 {
+		return fmt.Errorf("admin_notifications.failure_spike_window_seconds must be at least 1 (got %d)",
+			c.FailureSpikeWindowSeconds)
+	}
+	if c.MaxConnections < 1 {
+		return fmt.Errorf("admin_notifications.max_connections must be at least 1 (got %d)", c.MaxConnections)
+	}
+	return nil
+}
+
+// FaultInjectionConfig holds the settings for injecting latency and errors into database calls,
+// executor runs and outbound HTTP requests during resilience testing.
+type FaultInjectionConfig struct {
+	Enabled bool                       `yaml:"enabled" json:"enabled"`
+	Rules   []FaultInjectionRuleConfig `yaml:"rules"   json:"rules"`
+}
+
+// FaultInjectionRuleConfig describes a single probabilistic fault. Target is one of "database",
+// "executor" or "http", and Match selects calls by query ID, executor name or host respectively.
+type FaultInjectionRuleConfig struct {
+	Target      string  `yaml:"target"      json:"target"`
+	Match       string  `yaml:"match"       json:"match"`
+	Probability float64 `yaml:"probability" json:"probability"`
+	LatencyMs   int     `yaml:"latency_ms"  json:"latency_ms"`
+	Error       bool    `yaml:"error"       json:"error"`
+}
+
+// SecurityTxtConfig holds the RFC 9116 security.txt fields served at /.well-known/security.txt. The
+// file is served only when at least one contact is configured. The Expires field is computed at
+// request time as ExpiresInDays from now.
+type SecurityTxtConfig struct {
+	Contacts           []string `yaml:"contacts"            json:"contacts"`
+	ExpiresInDays      int      `yaml:"expires_in_days"     json:"expires_in_days"`
+	Policy             string   `yaml:"policy"              json:"policy"`
+	PreferredLanguages string   `yaml:"preferred_languages" json:"preferred_languages"`
+}
+
+// Validate ensures every contact is an absolute URI and the expiry is within the one-year window
+// recommended by RFC 9116.
+func (c *SecurityTxtConfig) Validate() error {
+	if len(c.Contacts) == 0 {
+		return nil
+	}
+	for _, contact := range c.Contacts {
+		u, err := url.Parse(contact)
+		if err != nil || u.Scheme == "" {
+			return fmt.Errorf("system_info.security_txt.contacts entry %q must be an absolute URI "+
+				"(e.g. mailto:security@example.com)", contact)
+		}
+	}
+	if c.ExpiresInDays < 1 || c.ExpiresInDays > 365 {
+		return fmt.Errorf("system_info.security_txt.expires_in_days must be in [1, 365] (got %d)",
+			c.ExpiresInDays)
+	}
+	return nil
+}
+
+// SoftDeleteConfig controls soft deletion of users and applications. When enabled, deletes mark the
+// entity as deleted instead of removing it; deleted entities can be listed and restored for
+// RetentionDays, after which a background job permanently purges them every PurgeIntervalMinutes.
+type SoftDeleteConfig struct {
+	Enabled              bool `yaml:"enabled"                json:"enabled"`
+	RetentionDays        int  `yaml:"retention_days"         json:"retention_days"`
+	PurgeIntervalMinutes int  `yaml:"purge_interval_minutes" json:"purge_interval_minutes"`
+}
+
+// Validate ensures the retention window and purge interval are positive when soft deletion is enabled.
+func (c *SoftDeleteConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RetentionDays < 1 {
+		return fmt.Errorf("soft_delete.retention_days must be at least 1 (got %d)", c.RetentionDays)
+	}
+	if c.PurgeIntervalMinutes < 1 {
+		return fmt.Errorf("soft_delete.purge_interval_minutes must be at least 1 (got %d)",
+			c.PurgeIntervalMinutes)
+	}
+	return nil
+}
+
+// Retention returns the window during which a soft-deleted entity can still be restored.
+func (c *SoftDeleteConfig) Retention() time.Duration {
+	return time.Duration(c.RetentionDays) * 24 * time.Hour
+}
+
+// PurgeInterval returns how often expired soft-deleted entities are purged.
+func (c *SoftDeleteConfig) PurgeInterval() time.Duration {
+	return time.Duration(c.PurgeIntervalMinutes) * time.Minute
+}
+
+// UsageConfig controls usage metering. When enabled, monthly active users, token issuance and API calls
+// are counted per deployment and persisted every FlushIntervalSeconds. A positive
+// Quotas.TokensPerMonth rejects token requests once the deployment has issued that many tokens in the
+// current calendar month (UTC).
+type UsageConfig struct {
+	Enabled              bool             `yaml:"enabled"                json:"enabled"`
+	FlushIntervalSeconds int              `yaml:"flush_interval_seconds" json:"flush_interval_seconds"`
+	Quotas               UsageQuotaConfig `yaml:"quotas"                 json:"quotas"`
+}
+
+// UsageQuotaConfig holds the usage quotas. Zero disables a quota.
+type UsageQuotaConfig struct {
+	TokensPerMonth int64 `yaml:"tokens_per_month" json:"tokens_per_month"`
+}
+
+// Validate ensures the flush interval is positive and the quotas are not negative when metering is
+// enabled.
+func (c *UsageConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.FlushIntervalSeconds < 1 {
+		return fmt.Errorf("usage.flush_interval_seconds must be at least 1 (got %d)", c.FlushIntervalSeconds)
+	}
+	if c.Quotas.TokensPerMonth < 0 {
+		return fmt.Errorf("usage.quotas.tokens_per_month must not be negative (got %d)", c.Quotas.TokensPerMonth)
+	}
+	return nil
+}
+
+// FlushInterval returns how often the usage counters are persisted.
+func (c *UsageConfig) FlushInterval() time.Duration {
+	return time.Duration(c.FlushIntervalSeconds) * time.Second
+}
+
+// OutboxConfig controls the transactional outbox for audit and webhook events. When enabled, events
+// of state changes are written in the same database transaction as the change and a background
+// dispatcher delivers them to the observability outputs every PollIntervalSeconds, retrying a
+// failed delivery up to MaxAttempts times. Delivered events are kept for RetentionHours.
+type OutboxConfig struct {
+	Enabled             bool `yaml:"enabled"               json:"enabled"`
+	PollIntervalSeconds int  `yaml:"poll_interval_seconds" json:"poll_interval_seconds"`
+	BatchSize           int  `yaml:"batch_size"            json:"batch_size"`
+	MaxAttempts         int  `yaml:"max_attempts"          json:"max_attempts"`
+	RetentionHours      int  `yaml:"retention_hours"       json:"retention_hours"`
+}
+
+// Validate ensures the dispatcher settings are positive when the outbox is enabled.
+func (c *OutboxConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.PollIntervalSeconds < 1 {
+		return fmt.Errorf("outbox.poll_interval_seconds must be at least 1 (got %d)", c.PollIntervalSeconds)
+	}
+	if c.BatchSize < 1 {
+		return fmt.Errorf("outbox.batch_size must be at least 1 (got %d)", c.BatchSize)
+	}
+	if c.MaxAttempts < 1 {
+		return fmt.Errorf("outbox.max_attempts must be at least 1 (got %d)", c.MaxAttempts)
+	}
+	if c.RetentionHours < 1 {
+		return fmt.Errorf("outbox.retention_hours must be at least 1 (got %d)", c.RetentionHours)
+	}
+	return nil
+}
+
+// PollInterval returns the interval between dispatcher runs.
+func (c *OutboxConfig) PollInterval() time.Duration {
+	return time.Duration(c.PollIntervalSeconds) * time.Second
+}
+
+// Retention returns how long delivered events are kept.
+func (c *OutboxConfig) Retention() time.Duration {
+	return time.Duration(c.RetentionHours) * time.Hour
+}
+
+// RetentionConfig controls the purging of expired and processed data. When enabled, a background job
+// deletes, every PurgeIntervalMinutes and in batches of BatchSize rows, the authorization codes and
+// requests, sessions, and other runtime records that expired more than the corresponding number of hours
+// ago, and the delivered or failed audit events of the outbox older than AuditEventDays.
+type RetentionConfig struct {
+	Enabled                bool `yaml:"enabled"                  json:"enabled"`
+	PurgeIntervalMinutes   int  `yaml:"purge_interval_minutes"   json:"purge_interval_minutes"`
+	BatchSize              int  `yaml:"batch_size"               json:"batch_size"`
+	AuthorizationCodeHours int  `yaml:"authorization_code_hours" json:"authorization_code_hours"`
+	SessionHours           int  `yaml:"session_hours"            json:"session_hours"`
+	RuntimeDataHours       int  `yaml:"runtime_data_hours"       json:"runtime_data_hours"`
+	AuditEventDays         int  `yaml:"audit_event_days"         json:"audit_event_days"`
+}
+
+// Validate ensures the purge interval, batch size, and audit event retention are positive and the
+// grace periods after expiry are not negative when retention is enabled.
+func (c *RetentionConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.PurgeIntervalMinutes < 1 {
+		return fmt.Errorf("retention.purge_interval_minutes must be at least 1 (got %d)", c.PurgeIntervalMinutes)
+	}
+	if c.BatchSize < 1 {
+		return fmt.Errorf("retention.batch_size must be at least 1 (got %d)", c.BatchSize)
+	}
+	if c.AuthorizationCodeHours < 0 {
+		return fmt.Errorf("retention.authorization_code_hours must not be negative (got %d)",
+			c.AuthorizationCodeHours)
+	}
+	if c.SessionHours < 0 {
+		return fmt.Errorf("retention.session_hours must not be negative (got %d)", c.SessionHours)
+	}
+	if c.RuntimeDataHours < 0 {
+		return fmt.Errorf("retention.runtime_data_hours must not be negative (got %d)", c.RuntimeDataHours)
+	}
+	if c.AuditEventDays < 1 {
+		return fmt.Errorf("retention.audit_event_days must be at least 1 (got %d)", c.AuditEventDays)
+	}
+	return nil
+}
+
+// PurgeInterval returns how often expired data is purged.
+func (c *RetentionConfig) PurgeInterval() time.Duration {
+	return time.Duration(c.PurgeIntervalMinutes) * time.Minute
+}
+
+// AuthorizationCodeRetention returns how long expired authorization codes and requests are kept.
+func (c *RetentionConfig) AuthorizationCodeRetention() time.Duration {
+	return time.Duration(c.AuthorizationCodeHours) * time.Hour
+}
+
+// SessionRetention returns how long expired sessions are kept.
+func (c *RetentionConfig) SessionRetention() time.Duration {
+	return time.Duration(c.SessionHours) * time.Hour
+}
+
+// RuntimeDataRetention returns how long other expired runtime records are kept.
+func (c *RetentionConfig) RuntimeDataRetention() time.Duration {
+	return time.Duration(c.RuntimeDataHours) * time.Hour
+}
+
+// AuditEventRetention returns how long delivered and failed audit events are kept.
+func (c *RetentionConfig) AuditEventRetention() time.Duration {
+	return time.Duration(c.AuditEventDays) * 24 * time.Hour
+}
+
+// AnomalyDetectionConfig controls login anomaly detection. When enabled, the AnomalyDetectionExecutor
+// remembers the devices and the last location each user signed in from for RetentionDays, keeping at most
+// MaxKnownDevices devices per user, and flags a sign-in from an unseen device or one that would require
+// travelling faster than ImpossibleTravelSpeedKmh since the previous sign-in.
+type AnomalyDetectionConfig struct {
+	Enabled                  bool    `yaml:"enabled"                     json:"enabled"`
+	RetentionDays            int     `yaml:"retention_days"              json:"retention_days"`
+	MaxKnownDevices          int     `yaml:"max_known_devices"           json:"max_known_devices"`
+	ImpossibleTravelSpeedKmh float64 `yaml:"impossible_travel_speed_kmh" json:"impossible_travel_speed_kmh"`
+}
+
+// Validate ensures the retention window, device limit, and travel speed are positive when anomaly
+// detection is enabled.
+func (c *AnomalyDetectionConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RetentionDays < 1 {
+		return fmt.Errorf("anomaly_detection.retention_days must be at least 1 (got %d)", c.RetentionDays)
+	}
+	if c.MaxKnownDevices < 1 {
+		return fmt.Errorf("anomaly_detection.max_known_devices must be at least 1 (got %d)", c.MaxKnownDevices)
+	}
+	if c.ImpossibleTravelSpeedKmh <= 0 {
+		return fmt.Errorf("anomaly_detection.impossible_travel_speed_kmh must be positive (got %g)",
+			c.ImpossibleTravelSpeedKmh)
+	}
+	return nil
+}
+
+// PushAuthConfig controls push notification authentication. An approval request expires after
+// ChallengeTTLSeconds, and each poll of the flow waits up to LongPollSeconds for the decision of the
+// device. Zero values fall back to the push package defaults. Devices can be registered for the
+// platforms whose push service is configured.
+type PushAuthConfig struct {
+	ChallengeTTLSeconds int            `yaml:"challenge_ttl_seconds" json:"challenge_ttl_seconds"`
+	LongPollSeconds     int            `yaml:"long_poll_seconds"     json:"long_poll_seconds"`
+	FCM                 FCMPushConfig  `yaml:"fcm"                   json:"fcm"`
+	APNs                APNsPushConfig `yaml:"apns"                  json:"apns"`
+}
+
+// FCMPushConfig holds the Firebase Cloud Messaging settings. ServiceAccountFile is the JSON key of a
+// service account allowed to send messages in the Firebase project, and Endpoint overrides the FCM
+// HTTP v1 send endpoint. FCM is enabled when ProjectID is set.
+type FCMPushConfig struct {
+	ProjectID          string `yaml:"project_id"           json:"project_id"`
+	ServiceAccountFile string `yaml:"service_account_file" json:"service_account_file"`
+	Endpoint           string `yaml:"endpoint"             json:"endpoint"`
+}
+
+// APNsPushConfig holds the Apple Push Notification service settings. PrivateKeyFile is the .p8
+// token signing key identified by KeyID, Topic is the bundle ID of the app, and Endpoint overrides
+// the APNs host selected by Production. APNs is enabled when TeamID is set.
+type APNsPushConfig struct {
+	TeamID         string `yaml:"team_id"          json:"team_id"`
+	KeyID          string `yaml:"key_id"           json:"key_id"`
+	PrivateKeyFile string `yaml:"private_key_file" json:"private_key_file"`
+	Topic          string `yaml:"topic"            json:"topic"`
+	Production     bool   `yaml:"production"       json:"production"`
+	Endpoint       string `yaml:"endpoint"         json:"endpoint"`
+}
+
+// Validate ensures the durations are not negative, that the long poll ends before the approval
+// request expires, and that the configured push services are complete.
+func (c *PushAuthConfig) Validate() error {
+	if c.ChallengeTTLSeconds < 0 {
+		return fmt.Errorf("push_auth.challenge_ttl_seconds must not be negative (got %d)", c.ChallengeTTLSeconds)
+	}
+	if c.LongPollSeconds < 0 {
+		return fmt.Errorf("push_auth.long_poll_seconds must not be negative (got %d)", c.LongPollSeconds)
+	}
+	if c.ChallengeTTLSeconds > 0 && c.LongPollSeconds >= c.ChallengeTTLSeconds {
+		return fmt.Errorf("push_auth.long_poll_seconds must be less than challenge_ttl_seconds (got %d)",
+			c.LongPollSeconds)
+	}
+	if c.FCM.ProjectID != "" && c.FCM.ServiceAccountFile == "" {
+		return fmt.Errorf("push_auth.fcm.service_account_file is required when fcm.project_id is set")
+	}
+	if c.APNs.TeamID != "" && (c.APNs.KeyID == "" || c.APNs.PrivateKeyFile == "" || c.APNs.Topic == "") {
+		return fmt.Errorf("push_auth.apns.key_id, private_key_file and topic are required when apns.team_id is set")
+	}
+	return nil
+}
+
+// CrossDeviceAuthConfig controls QR code sign-in, where a sign-in started on one device is approved
+// on a phone on which the user is already signed in. A sign-in request expires after
+// RequestTTLSeconds, and each poll of the flow waits up to LongPollSeconds for the approval.
+// ApprovalURL is the page the QR code opens on the phone; it defaults to /cross-device on the gate
+// client. Zero values fall back to the crossdevice package defaults.
+type CrossDeviceAuthConfig struct {
+	RequestTTLSeconds int    `yaml:"request_ttl_seconds" json:"request_ttl_seconds"`
+	LongPollSeconds   int    `yaml:"long_poll_seconds"   json:"long_poll_seconds"`
+	ApprovalURL       string `yaml:"approval_url"        json:"approval_url"`
+}
+
+// Validate ensures the durations are not negative, that the long poll ends before the sign-in
+// request expires, and that the approval URL is absolute.
+func (c *CrossDeviceAuthConfig) Validate() error {
+	if c.RequestTTLSeconds < 0 {
+		return fmt.Errorf("cross_device_auth.request_ttl_seconds must not be negative (got %d)",
+			c.RequestTTLSeconds)
+	}
+	if c.LongPollSeconds < 0 {
+		return fmt.Errorf("cross_device_auth.long_poll_seconds must not be negative (got %d)", c.LongPollSeconds)
+	}
+	if c.RequestTTLSeconds > 0 && c.LongPollSeconds >= c.RequestTTLSeconds {
+		return fmt.Errorf("cross_device_auth.long_poll_seconds must be less than request_ttl_seconds (got %d)",
+			c.LongPollSeconds)
+	}
+	if c.ApprovalURL != "" {
+		u, err := url.Parse(c.ApprovalURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("cross_device_auth.approval_url must be an absolute URL (got %q)", c.ApprovalURL)
+		}
+	}
+	return nil
+}
+
+// CertificateAuthConfig controls X.509 client certificate authentication, for smart cards and
+// mTLS clients. When Enabled, the server asks TLS clients for a certificate; certificates are
+// validated against the CA certificates in CAFiles rather than during the TLS handshake, so that a
+// client without a certificate can still reach the other endpoints.
+type CertificateAuthConfig struct {
+	Enabled     bool                         `yaml:"enabled"      json:"enabled"`
+	CAFiles     []string                     `yaml:"ca_files"     json:"ca_files"`
+	UserMapping CertificateUserMappingConfig `yaml:"user_mapping" json:"user_mapping"`
+	Revocation  CertificateRevocationConfig  `yaml:"revocation"   json:"revocation"`
+}
+
+// CertificateUserMappingConfig selects the certificate field that identifies the user and the user
+// attribute it is matched against. Source is one of subject_dn, subject_cn, san_email, san_upn, or
+// san_dns; empty values fall back to the certificate package defaults.
+type CertificateUserMappingConfig struct {
+	Source    string `yaml:"source"    json:"source"`
+	Attribute string `yaml:"attribute" json:"attribute"`
+}
+
+// CertificateRevocationConfig controls the revocation check of client certificates. CRLFiles are
+// checked first; OCSPEnabled queries the responder named in the certificate. A certificate whose
+// status cannot be determined is rejected unless SoftFail is set.
+type CertificateRevocationConfig struct {
+	CRLFiles           []string `yaml:"crl_files"            json:"crl_files"`
+	OCSPEnabled        bool     `yaml:"ocsp_enabled"         json:"ocsp_enabled"`
+	OCSPTimeoutSeconds int      `yaml:"ocsp_timeout_seconds" json:"ocsp_timeout_seconds"`
+	SoftFail           bool     `yaml:"soft_fail"            json:"soft_fail"`
+}
+
+// certificateMappingSources lists the certificate fields a user can be identified by.
+var certificateMappingSources = []string{"subject_dn", "subject_cn", "san_email", "san_upn", "san_dns"}
+
+// Validate ensures that an enabled certificate authentication has trusted CAs, that the mapping
+// source is known, and that the OCSP timeout is not negative.
+func (c *CertificateAuthConfig) Validate() error {
+	if c.Enabled && len(c.CAFiles) == 0 {
+		return fmt.Errorf("certificate_auth.ca_files is required when certificate_auth is enabled")
+	}
+	if c.UserMapping.Source != "" && !slices.Contains(certificateMappingSources, c.UserMapping.Source) {
+		return fmt.Errorf("certificate_auth.user_mapping.source must be one of %s (got %q)",
+			strings.Join(certificateMappingSources, ", "), c.UserMapping.Source)
+	}
+	if c.Revocation.OCSPTimeoutSeconds < 0 {
+		return fmt.Errorf("certificate_auth.revocation.ocsp_timeout_seconds must not be negative (got %d)",
+			c.Revocation.OCSPTimeoutSeconds)
+	}
+	return nil
+}
+
+// SessionConfig limits the concurrent sessions of a user. A session is a trusted device the user
+// has signed in from; revoking the device ends it. When a sign-in from a new device would exceed
+// MaxConcurrentSessions, LimitStrategy either denies the sign-in (deny_new) or ends the sessions of
+// the least recently used devices (terminate_oldest). Zero means no limit beyond the number of
+// devices remembered per user.
+type SessionConfig struct {
+	MaxConcurrentSessions int    `yaml:"max_concurrent_sessions" json:"max_concurrent_sessions"`
+	LimitStrategy         string `yaml:"limit_strategy"          json:"limit_strategy"`
+}
+
+// sessionLimitStrategies are the accepted values of SessionConfig.LimitStrategy.
+var sessionLimitStrategies = []string{"terminate_oldest", "deny_new"}
+
+// Validate ensures the session limit is not negative and the limit strategy is known.
+func (c *SessionConfig) Validate() error {
+	if c.MaxConcurrentSessions < 0 {
+		return fmt.Errorf("session.max_concurrent_sessions must not be negative (got %d)", c.MaxConcurrentSessions)
+	}
+	if c.LimitStrategy != "" && !slices.Contains(sessionLimitStrategies, c.LimitStrategy) {
+		return fmt.Errorf("session.limit_strategy must be one of %s (got %q)",
+			strings.Join(sessionLimitStrategies, ", "), c.LimitStrategy)
+	}
+	return nil
+}
+
+// OutboundHTTPConfig controls the HTTP calls the server makes to external services, such as
+// federated identity providers, webhooks and external flow actions. The top-level settings apply to
+// every host; a Destinations entry overrides them for the hosts it matches. Relative file paths are
+// resolved against the server home.
+type OutboundHTTPConfig struct {
+	TimeoutSeconds int                          `yaml:"timeout_seconds" json:"timeout_seconds"`
+	Proxy          OutboundProxyConfig          `yaml:"proxy"           json:"proxy"`
+	TLS            OutboundTLSConfig            `yaml:"tls"             json:"tls"`
+	Retry          OutboundRetryConfig          `yaml:"retry"           json:"retry"`
+	CircuitBreaker OutboundCircuitBreakerConfig `yaml:"circuit_breaker" json:"circuit_breaker"`
+	Destinations   []OutboundDestinationConfig  `yaml:"destinations"    json:"destinations"`
+}
+
+// OutboundProxyConfig routes outbound calls through an HTTP proxy. Hosts in NoProxy, or under a
+// domain listed with a leading dot, are called directly. An empty URL uses the proxy from the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+type OutboundProxyConfig struct {
+	URL     string   `yaml:"url"      json:"url"`
+	NoProxy []string `yaml:"no_proxy" json:"no_proxy"`
+}
+
+// OutboundTLSConfig holds the TLS settings of outbound calls. CAFile adds trusted CA certificates
+// to the system pool; CertFile and KeyFile present a client certificate for mutual TLS.
+type OutboundTLSConfig struct {
+	CAFile   string `yaml:"ca_file"   json:"ca_file"`
+	CertFile string `yaml:"cert_file" json:"cert_file"`
+	KeyFile  string `yaml:"key_file"  json:"key_file"`
+}
+
+// OutboundRetryConfig retries idempotent calls that fail with a connection error or a 502, 503 or
+// 504 response. MaxAttempts counts the first attempt, so 0 and 1 disable retries. The backoff
+// doubles after each attempt, from InitialBackoffMS up to MaxBackoffMS.
+type OutboundRetryConfig struct {
+	MaxAttempts      int `yaml:"max_attempts"       json:"max_attempts"`
+	InitialBackoffMS int `yaml:"initial_backoff_ms" json:"initial_backoff_ms"`
+	MaxBackoffMS     int `yaml:"max_backoff_ms"     json:"max_backoff_ms"`
+}
+
+// OutboundCircuitBreakerConfig stops calls to a host after FailureThreshold consecutive failures,
+// where a failure is a connection error, a timeout or a 5xx response. Calls fail fast for
+// OpenSeconds, after which a single trial call decides whether the host is used again.
+type OutboundCircuitBreakerConfig struct {
+	Enabled          bool `yaml:"enabled"           json:"enabled"`
+	FailureThreshold int  `yaml:"failure_threshold" json:"failure_threshold"`
+	OpenSeconds      int  `yaml:"open_seconds"      json:"open_seconds"`
+}
+
+// OutboundDestinationConfig overrides the outbound settings for a host. Host is an exact host name
+// or a wildcard such as *.example.com. A zero timeout and nil blocks keep the top-level settings.
+type OutboundDestinationConfig struct {
+	Host           string                        `yaml:"host"            json:"host"`
+	TimeoutSeconds int                           `yaml:"timeout_seconds" json:"timeout_seconds"`
+	Proxy          *OutboundProxyConfig          `yaml:"proxy"           json:"proxy"`
+	TLS            *OutboundTLSConfig            `yaml:"tls"             json:"tls"`
+	Retry          *OutboundRetryConfig          `yaml:"retry"           json:"retry"`
+	CircuitBreaker *OutboundCircuitBreakerConfig `yaml:"circuit_breaker" json:"circuit_breaker"`
+}
+
+// Validate ensures the timeouts, retry and circuit breaker settings are not negative, that the
+// proxy URLs are absolute, that client certificates come with their keys, and that every
+// destination names a host.
+func (c *OutboundHTTPConfig) Validate() error {
+	if err := validateOutboundSettings("outbound_http", c.TimeoutSeconds, &c.Proxy, &c.TLS, &c.Retry,
+		&c.CircuitBreaker); err != nil {
+		return err
+	}
+	for i, dest := range c.Destinations {
+		path := fmt.Sprintf("outbound_http.destinations[%d]", i)
+		if strings.TrimSpace(dest.Host) == "" {
+			return fmt.Errorf("%s.host is required", path)
+		}
+		if err := validateOutboundSettings(path, dest.TimeoutSeconds, dest.Proxy, dest.TLS, dest.Retry,
+			dest.CircuitBreaker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateOutboundSettings checks one set of outbound settings. Nil blocks are not checked.
+func validateOutboundSettings(path string, timeout int, proxy *OutboundProxyConfig, tlsCfg *OutboundTLSConfig,
+	retry *OutboundRetryConfig, breaker *OutboundCircuitBreakerConfig) error {
+	if timeout < 0 {
+		return fmt.Errorf("%s.timeout_seconds must not be negative (got %d)", path, timeout)
+	}
+	if proxy != nil && proxy.URL != "" {
+		u, err := url.Parse(proxy.URL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("%s.proxy.url must be an absolute URL (got %q)", path, proxy.URL)
+		}
+	}
+	if tlsCfg != nil && (tlsCfg.CertFile == "") != (tlsCfg.KeyFile == "") {
+		return fmt.Errorf("%s.tls.cert_file and %s.tls.key_file must be set together", path, path)
+	}
+	if retry != nil {
+		if retry.MaxAttempts < 0 || retry.InitialBackoffMS < 0 || retry.MaxBackoffMS < 0 {
+			return fmt.Errorf("%s.retry settings must not be negative", path)
+		}
+	}
+	if breaker != nil && (breaker.FailureThreshold < 0 || breaker.OpenSeconds < 0) {
+		return fmt.Errorf("%s.circuit_breaker settings must not be negative", path)
+	}
+	return nil
+}
+
+// GeoIPConfig maps client IP address ranges to geographic locations. The most specific matching
+// network wins; addresses outside every network have no known location.
+type GeoIPConfig struct {
+	Networks []GeoIPNetworkConfig `yaml:"networks" json:"networks"`
+}
+
+// GeoIPNetworkConfig assigns a location to a CIDR range or a single IP address. Country is an
+// ISO 3166-1 alpha-2 code.
+type GeoIPNetworkConfig struct {
+	CIDR      string  `yaml:"cidr"      json:"cidr"`
+	Country   string  `yaml:"country"   json:"country"`
+	Latitude  float64 `yaml:"latitude"  json:"latitude"`
+	Longitude float64 `yaml:"longitude" json:"longitude"`
+}
+
+// JobsConfig controls the background job workers that run bulk operations. Up to QueueSize submitted
+// jobs wait for one of Workers workers, and the status of each job can be read for RetentionSeconds
+// after it was last updated. Zero values fall back to the job package defaults.
+type JobsConfig struct {
+	Workers          int `yaml:"workers"           json:"workers"`
+	QueueSize        int `yaml:"queue_size"        json:"queue_size"`
+	RetentionSeconds int `yaml:"retention_seconds" json:"retention_seconds"`
+}
+
+// Validate ensures the worker count, queue size and retention are not negative.
+func (c *JobsConfig) Validate() error {
+	if c.Workers < 0 {
+		return fmt.Errorf("jobs.workers must not be negative (got %d)", c.Workers)
+	}
+	if c.QueueSize < 0 {
+		return fmt.Errorf("jobs.queue_size must not be negative (got %d)", c.QueueSize)
+	}
+	if c.RetentionSeconds < 0 {
+		return fmt.Errorf("jobs.retention_seconds must not be negative (got %d)", c.RetentionSeconds)
+	}
+	return nil
+}
+
+// Retention returns how long the status of a job is kept after its last update.
+func (c *JobsConfig) Retention() time.Duration {
+	return time.Duration(c.RetentionSeconds) * time.Second
 }
 
 // PasskeyConfig holds the passkey configuration details.
@@ -251,6 +1057,7 @@ type OpenID4VCIConfig struct {
 type AuthnProviderConfig struct {
 	Type string     `yaml:"type" json:"type"`
 	Rest RestConfig `yaml:"rest" json:"rest"`
+	LDAP LDAPConfig `yaml:"ldap" json:"ldap"`
 }
 
 // UserProviderConfig holds the user provider configuration details.
@@ -260,7 +1067,27 @@ type UserProviderConfig struct {
 
 // EntityProviderConfig holds the entity provider configuration details.
 type EntityProviderConfig struct {
-	Type string `yaml:"type" json:"type"`
+	Type       string                 `yaml:"type"       json:"type"`
+	Federation EntityFederationConfig `yaml:"federation" json:"federation"`
+}
+
+// EntityFederationConfig holds the member providers of the federated entity provider, in
+// resolution order, and the routing of organization units to members for entity creation.
+type EntityFederationConfig struct {
+	Providers []FederatedEntityProviderConfig `yaml:"providers"  json:"providers"`
+	OURouting map[string]string               `yaml:"ou_routing" json:"ou_routing"`
+}
+
+// FederatedEntityProviderConfig holds the configuration of a member of the federated entity provider.
+type FederatedEntityProviderConfig struct {
+	Name string     `yaml:"name" json:"name"`
+	Type string     `yaml:"type" json:"type"`
+	Rest RestConfig `yaml:"rest" json:"rest"`
+	LDAP LDAPConfig `yaml:"ldap" json:"ldap"`
+	// IDAttribute, UserType and OUID describe the entities of an ldap member.
+	IDAttribute string `yaml:"id_attribute" json:"id_attribute"`
+	UserType    string `yaml:"user_type"    json:"user_type"`
+	OUID        string `yaml:"ou_id"        json:"ou_id"`
 }
 
 // RestConfig holds the REST authentication provider configuration details.
@@ -276,9 +1103,68 @@ type RestSecurityConfig struct {
 	APIKey string `yaml:"api_key" json:"api_key"`
 }
 
-// EmailConfig holds the email configuration details.
+// LDAPConfig holds the LDAP authentication provider configuration details.
+type LDAPConfig struct {
+	URL              string                 `yaml:"url"               json:"url"`
+	BindDN           string                 `yaml:"bind_dn"           json:"bind_dn"`
+	BindPassword     string                 `yaml:"bind_password"     json:"bind_password"`
+	BaseDN           string                 `yaml:"base_dn"           json:"base_dn"`
+	UserObjectClass  string                 `yaml:"user_object_class" json:"user_object_class"`
+	LoginAttribute   string                 `yaml:"login_attribute"   json:"login_attribute"`
+	LoginIdentifier  string                 `yaml:"login_identifier"  json:"login_identifier"`
+	AttributeMapping map[string]string      `yaml:"attribute_mapping" json:"attribute_mapping"`
+	Timeout          int                    `yaml:"timeout"           json:"timeout"`
+	Provisioning     LDAPProvisioningConfig `yaml:"provisioning"      json:"provisioning"`
+}
+
+// LDAPProvisioningConfig holds the settings for creating local users on their first LDAP sign-in.
+type LDAPProvisioningConfig struct {
+	Enabled  bool   `yaml:"enabled"   json:"enabled"`
+	UserType string `yaml:"user_type" json:"user_type"`
+	OUID     string `yaml:"ou_id"     json:"ou_id"`
+}
+
+// EmailConfig holds the email configuration details. Providers lists the email providers to send
+// through in failover order; an empty list sends through SMTP only.
 type EmailConfig struct {
-	SMTP SMTPEmailConfig `yaml:"smtp" json:"smtp"`
+	Providers      []string                  `yaml:"providers"       json:"providers"`
+	SMTP           SMTPEmailConfig           `yaml:"smtp"            json:"smtp"`
+	SES            SESEmailConfig            `yaml:"ses"             json:"ses"`
+	SendGrid       SendGridEmailConfig       `yaml:"sendgrid"        json:"sendgrid"`
+	DeliveryStatus EmailDeliveryStatusConfig `yaml:"delivery_status" json:"delivery_status"`
+}
+
+// Supported email providers.
+const (
+	EmailProviderSMTP     = "smtp"
+	EmailProviderSES      = "ses"
+	EmailProviderSendGrid = "sendgrid"
+)
+
+// Validate ensures the providers are supported and listed once, and that the delivery status
+// callbacks are protected by a token and kept for at least a day when enabled.
+func (c *EmailConfig) Validate() error {
+	seen := make(map[string]bool, len(c.Providers))
+	for _, provider := range c.Providers {
+		if provider != EmailProviderSMTP && provider != EmailProviderSES && provider != EmailProviderSendGrid {
+			return fmt.Errorf("email.providers contains an unsupported provider %q", provider)
+		}
+		if seen[provider] {
+			return fmt.Errorf("email.providers lists the provider %q more than once", provider)
+		}
+		seen[provider] = true
+	}
+	if !c.DeliveryStatus.Enabled {
+		return nil
+	}
+	if c.DeliveryStatus.CallbackToken == "" {
+		return fmt.Errorf("email.delivery_status.callback_token is required when delivery status is enabled")
+	}
+	if c.DeliveryStatus.RetentionDays < 1 {
+		return fmt.Errorf("email.delivery_status.retention_days must be at least 1 (got %d)",
+			c.DeliveryStatus.RetentionDays)
+	}
+	return nil
 }
 
 // SMTPEmailConfig holds the SMTP email configuration details.
@@ -292,11 +1178,53 @@ type SMTPEmailConfig struct {
 	EnableAuthentication *bool  `yaml:"enable_authentication" json:"enable_authentication"`
 }
 
+// SESEmailConfig holds the Amazon SES email configuration details. Endpoint overrides the regional
+// SES endpoint, and ConfigurationSet names the SES configuration set that publishes delivery events.
+type SESEmailConfig struct {
+	Region           string `yaml:"region"            json:"region"`
+	AccessKeyID      string `yaml:"access_key_id"     json:"access_key_id"`
+	SecretAccessKey  string `yaml:"secret_access_key" json:"secret_access_key"`
+	SessionToken     string `yaml:"session_token"     json:"session_token"`
+	FromAddress      string `yaml:"from_address"      json:"from_address"`
+	ConfigurationSet string `yaml:"configuration_set" json:"configuration_set"`
+	Endpoint         string `yaml:"endpoint"          json:"endpoint"`
+}
+
+// SendGridEmailConfig holds the SendGrid email configuration details. Endpoint overrides the SendGrid
+// mail send API URL.
+type SendGridEmailConfig struct {
+	APIKey      string `yaml:"api_key"      json:"api_key"`
+	FromAddress string `yaml:"from_address" json:"from_address"`
+	Endpoint    string `yaml:"endpoint"     json:"endpoint"`
+}
+
+// EmailDeliveryStatusConfig controls the recording of the delivery status callbacks of the email
+// providers. Callbacks must carry CallbackToken, and the recorded events are kept for RetentionDays.
+type EmailDeliveryStatusConfig struct {
+	Enabled       bool   `yaml:"enabled"        json:"enabled"`
+	CallbackToken string `yaml:"callback_token" json:"callback_token"`
+	RetentionDays int    `yaml:"retention_days" json:"retention_days"`
+}
+
+// Retention returns how long recorded delivery events are kept.
+func (c *EmailDeliveryStatusConfig) Retention() time.Duration {
+	return time.Duration(c.RetentionDays) * 24 * time.Hour
+}
+
 // DeclarativeResources holds the configuration details for the declarative resources.
 type DeclarativeResources struct {
 	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
 }
 
+// ConfigAsCodeConfig controls the startup reconciliation of the configuration-as-code directory. When
+// enabled, the resources declared in Directory are created or updated in the database on every start.
+// With Prune, resources reconciled on an earlier start that are no longer declared are deleted.
+type ConfigAsCodeConfig struct {
+	Enabled   bool   `yaml:"enabled"   json:"enabled"`
+	Directory string `yaml:"directory" json:"directory"`
+	Prune     bool   `yaml:"prune"     json:"prune"`
+}
+
 // OrganizationUnitConfig holds the organization unit service configuration.
 type OrganizationUnitConfig struct {
 	// Store defines the storage mode for organization units.
@@ -551,6 +1479,7 @@ type Config struct {
 	Crypto               CryptoConfig                     `yaml:"crypto"                json:"crypto"`
 	User                 UserConfig                       `yaml:"user"                  json:"user"`
 	DeclarativeResources DeclarativeResources             `yaml:"declarative_resources" json:"declarative_resources"`
+	ConfigAsCode         ConfigAsCodeConfig               `yaml:"config_as_code"        json:"config_as_code"`
 	Resource             engineconfig.ResourceConfig      `yaml:"resource"              json:"resource"`
 	OrganizationUnit     OrganizationUnitConfig           `yaml:"organization_unit"     json:"organization_unit"`
 	IdentityProvider     IdentityProviderConfig           `yaml:"identity_provider"     json:"identity_provider"`
@@ -573,6 +1502,26 @@ type Config struct {
 	Email                EmailConfig                      `yaml:"email"                 json:"email"`
 	Notification         NotificationConfig               `yaml:"notification"          json:"notification"`
 	Consent              engineconfig.ConsentConfig       `yaml:"consent"               json:"consent"`
+	SystemInfo           SystemInfoConfig                 `yaml:"system_info"           json:"system_info"`
+	APIDocs              APIDocsConfig                    `yaml:"api_docs"              json:"api_docs"`
+	MockIdP              MockIdPConfig                    `yaml:"mock_idp"              json:"mock_idp"`
+	GRPC                 GRPCConfig                       `yaml:"grpc"                  json:"grpc"`
+	GraphQL              GraphQLConfig                    `yaml:"graphql"               json:"graphql"`
+	AdminNotifications   AdminNotificationsConfig         `yaml:"admin_notifications"   json:"admin_notifications"`
+	FaultInjection       FaultInjectionConfig             `yaml:"fault_injection"       json:"fault_injection"`
+	SoftDelete           SoftDeleteConfig                 `yaml:"soft_delete"           json:"soft_delete"`
+	Usage                UsageConfig                      `yaml:"usage"                 json:"usage"`
+	Outbox               OutboxConfig                     `yaml:"outbox"                json:"outbox"`
+	Retention            RetentionConfig                  `yaml:"retention"             json:"retention"`
+	AnomalyDetection     AnomalyDetectionConfig           `yaml:"anomaly_detection"     json:"anomaly_detection"`
+	GeoIP                GeoIPConfig                      `yaml:"geo_ip"                json:"geo_ip"`
+	PushAuth             PushAuthConfig                   `yaml:"push_auth"             json:"push_auth"`
+	CrossDeviceAuth      CrossDeviceAuthConfig            `yaml:"cross_device_auth"     json:"cross_device_auth"`
+	CertificateAuth      CertificateAuthConfig            `yaml:"certificate_auth"      json:"certificate_auth"`
+	Jobs                 JobsConfig                       `yaml:"jobs"                  json:"jobs"`
+	Session              SessionConfig                    `yaml:"session"               json:"session"`
+	FeatureFlags         map[string]bool                  `yaml:"feature_flags"         json:"feature_flags"`
+	OutboundHTTP         OutboundHTTPConfig               `yaml:"outbound_http"         json:"outbound_http"`
 }
 
 // LoadConfig loads the configurations from the specified YAML file and applies defaults.
@@ -652,9 +1601,18 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 		cfg.JWT.Issuer = engineconfig.GetServerURL(&cfg.Server)
 	}
 
+	if err := cfg.Database.Validate(); err != nil {
+		return nil, err
+	}
 	if err := cfg.Server.SecurityConfig.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.Server.Maintenance.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Server.DeploymentMode.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Validate ACR-AMR mapping.
 	if err := cfg.OAuth.AuthClass.Validate(); err != nil {
@@ -663,9 +1621,57 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 	if err := cfg.OAuth.DPoP.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.OAuth.TokenLifetime.Validate(); err != nil {
+		return nil, err
+	}
 	if err := cfg.Notification.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.Email.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.SystemInfo.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.SoftDelete.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Usage.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.GraphQL.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.AdminNotifications.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Outbox.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Retention.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.AnomalyDetection.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Jobs.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.PushAuth.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.CrossDeviceAuth.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.CertificateAuth.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Session.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OutboundHTTP.Validate(); err != nil {
+		return nil, err
+	}
 
 	return &cfg, nil
 }
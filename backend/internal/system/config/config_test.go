@@ -23,6 +23,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -466,6 +467,13 @@ func (suite *ConfigTestSuite) TestLogConfigOverrideToZeroValues() {
 	assert.Equal(suite.T(), boolPtr(false), base.Log.Output.Console.Enabled, "false must override the true default")
 	assert.Equal(suite.T(), intPtr(0), base.Log.Output.File.Rotation.MaxBackups, "0 must override the non-zero default")
 
+	// An explicit zero assertion max age disables the check rather than falling back to the default.
+	maxAge, disabled := int64(300), int64(0)
+	base.OAuth.AuthorizationCode.AssertionMaxAge = &maxAge
+	user.OAuth.AuthorizationCode.AssertionMaxAge = &disabled
+	mergeConfigs(base, user)
+	assert.Zero(suite.T(), base.OAuth.AuthorizationCode.GetAssertionMaxAge(), "0 must override the default max age")
+
 	// An omitted (nil) user value keeps the base default.
 	base2 := &Config{}
 	base2.Log.Output.Console.Enabled = boolPtr(true)
@@ -1526,9 +1534,430 @@ func (suite *ConfigTestSuite) TestOTPConfig_Validate_ValidityAboveMax() {
 	assert.Contains(suite.T(), err.Error(), "notification.otp.validity_period_seconds")
 }
 
+func (suite *ConfigTestSuite) TestOTPConfig_Validate_MaxVerifyAttemptsOutOfRange() {
+	cfg := &OTPConfig{Length: 6, ValidityPeriodSeconds: 120, MaxVerifyAttempts: 11}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "notification.otp.max_verify_attempts")
+}
+
+func (suite *ConfigTestSuite) TestOTPConfig_Validate_ResendIntervalOutOfRange() {
+	cfg := &OTPConfig{Length: 6, ValidityPeriodSeconds: 120, ResendIntervalSeconds: -1}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "notification.otp.resend_interval_seconds")
+}
+
 func (suite *ConfigTestSuite) TestNotificationConfig_Validate_DelegatesToOTP() {
 	cfg := &NotificationConfig{OTP: OTPConfig{Length: 3, ValidityPeriodSeconds: 120}}
 	err := cfg.Validate()
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "notification.otp.length")
 }
+
+func (suite *ConfigTestSuite) TestSecurityTxtConfig_Validate_NoContactsSkipsValidation() {
+	cfg := &SecurityTxtConfig{ExpiresInDays: 0}
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestSecurityTxtConfig_Validate_Valid() {
+	cfg := &SecurityTxtConfig{
+		Contacts:      []string{"mailto:security@example.com", "https://example.com/security"},
+		ExpiresInDays: 180,
+	}
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestSecurityTxtConfig_Validate_RelativeContact() {
+	cfg := &SecurityTxtConfig{Contacts: []string{"security@example.com"}, ExpiresInDays: 180}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "system_info.security_txt.contacts")
+}
+
+func (suite *ConfigTestSuite) TestSecurityTxtConfig_Validate_ExpiryOutOfRange() {
+	for _, days := range []int{0, 366} {
+		cfg := &SecurityTxtConfig{Contacts: []string{"mailto:security@example.com"}, ExpiresInDays: days}
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), "system_info.security_txt.expires_in_days")
+	}
+}
+
+func (suite *ConfigTestSuite) TestSystemInfoConfig_Validate_DelegatesToSecurityTxt() {
+	cfg := &SystemInfoConfig{SecurityTxt: SecurityTxtConfig{Contacts: []string{"nope"}, ExpiresInDays: 180}}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "system_info.security_txt.contacts")
+}
+
+func (suite *ConfigTestSuite) TestSoftDeleteConfig_Validate() {
+	assert.NoError(suite.T(), (&SoftDeleteConfig{}).Validate())
+	assert.NoError(suite.T(), (&SoftDeleteConfig{
+		Enabled: true, RetentionDays: 30, PurgeIntervalMinutes: 60}).Validate())
+
+	err := (&SoftDeleteConfig{Enabled: true, PurgeIntervalMinutes: 60}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "soft_delete.retention_days")
+
+	err = (&SoftDeleteConfig{Enabled: true, RetentionDays: 30}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "soft_delete.purge_interval_minutes")
+}
+
+func (suite *ConfigTestSuite) TestUsageConfig_Validate() {
+	assert.NoError(suite.T(), (&UsageConfig{}).Validate())
+	assert.NoError(suite.T(), (&UsageConfig{
+		Enabled: true, FlushIntervalSeconds: 60, Quotas: UsageQuotaConfig{TokensPerMonth: 1000}}).Validate())
+
+	err := (&UsageConfig{Enabled: true}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "usage.flush_interval_seconds")
+
+	err = (&UsageConfig{Enabled: true, FlushIntervalSeconds: 60,
+		Quotas: UsageQuotaConfig{TokensPerMonth: -1}}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "usage.quotas.tokens_per_month")
+}
+
+func (suite *ConfigTestSuite) TestGraphQLConfig_Validate() {
+	assert.NoError(suite.T(), (&GraphQLConfig{}).Validate())
+	assert.NoError(suite.T(), (&GraphQLConfig{Enabled: true, MaxDepth: 10}).Validate())
+
+	err := (&GraphQLConfig{Enabled: true}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "graphql.max_depth")
+}
+
+func (suite *ConfigTestSuite) TestAdminNotificationsConfig_Validate() {
+	valid := AdminNotificationsConfig{Enabled: true, FailureSpikeThreshold: 50, FailureSpikeWindowSeconds: 60,
+		MaxConnections: 100}
+	assert.NoError(suite.T(), (&AdminNotificationsConfig{}).Validate())
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := map[string]func(c *AdminNotificationsConfig){
+		"admin_notifications.failure_spike_threshold": func(c *AdminNotificationsConfig) {
+			c.FailureSpikeThreshold = 0
+		},
+		"admin_notifications.failure_spike_window_seconds": func(c *AdminNotificationsConfig) {
+			c.FailureSpikeWindowSeconds = 0
+		},
+		"admin_notifications.max_connections": func(c *AdminNotificationsConfig) { c.MaxConnections = -1 },
+	}
+	for field, mutate := range testCases {
+		cfg := valid
+		mutate(&cfg)
+		err := cfg.Validate()
+		assert.Error(suite.T(), err, field)
+		assert.Contains(suite.T(), err.Error(), field)
+	}
+}
+
+func (suite *ConfigTestSuite) TestOutboxConfig_Validate() {
+	valid := OutboxConfig{Enabled: true, PollIntervalSeconds: 5, BatchSize: 100, MaxAttempts: 10, RetentionHours: 24}
+	assert.NoError(suite.T(), (&OutboxConfig{}).Validate())
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := map[string]func(c *OutboxConfig){
+		"outbox.poll_interval_seconds": func(c *OutboxConfig) { c.PollIntervalSeconds = 0 },
+		"outbox.batch_size":            func(c *OutboxConfig) { c.BatchSize = 0 },
+		"outbox.max_attempts":          func(c *OutboxConfig) { c.MaxAttempts = 0 },
+		"outbox.retention_hours":       func(c *OutboxConfig) { c.RetentionHours = 0 },
+	}
+	for setting, invalidate := range testCases {
+		cfg := valid
+		invalidate(&cfg)
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), setting)
+	}
+}
+
+func (suite *ConfigTestSuite) TestRetentionConfig_Validate() {
+	valid := RetentionConfig{Enabled: true, PurgeIntervalMinutes: 60, BatchSize: 1000, AuthorizationCodeHours: 1,
+		SessionHours: 24, RuntimeDataHours: 24, AuditEventDays: 90}
+	assert.NoError(suite.T(), (&RetentionConfig{}).Validate())
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := map[string]func(c *RetentionConfig){
+		"retention.purge_interval_minutes":   func(c *RetentionConfig) { c.PurgeIntervalMinutes = 0 },
+		"retention.batch_size":               func(c *RetentionConfig) { c.BatchSize = 0 },
+		"retention.authorization_code_hours": func(c *RetentionConfig) { c.AuthorizationCodeHours = -1 },
+		"retention.session_hours":            func(c *RetentionConfig) { c.SessionHours = -1 },
+		"retention.runtime_data_hours":       func(c *RetentionConfig) { c.RuntimeDataHours = -1 },
+		"retention.audit_event_days":         func(c *RetentionConfig) { c.AuditEventDays = 0 },
+	}
+	for setting, invalidate := range testCases {
+		cfg := valid
+		invalidate(&cfg)
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), setting)
+	}
+}
+
+func (suite *ConfigTestSuite) TestEmailConfig_Validate() {
+	valid := EmailConfig{
+		Providers: []string{EmailProviderSendGrid, EmailProviderSMTP},
+		DeliveryStatus: EmailDeliveryStatusConfig{
+			Enabled: true, CallbackToken: "token", RetentionDays: 30,
+		},
+	}
+	assert.NoError(suite.T(), (&EmailConfig{}).Validate())
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := map[string]func(c *EmailConfig){
+		"unsupported provider":                 func(c *EmailConfig) { c.Providers = []string{"mailgun"} },
+		"more than once":                       func(c *EmailConfig) { c.Providers = []string{"ses", "ses"} },
+		"email.delivery_status.callback_token": func(c *EmailConfig) { c.DeliveryStatus.CallbackToken = "" },
+		"email.delivery_status.retention_days": func(c *EmailConfig) { c.DeliveryStatus.RetentionDays = 0 },
+	}
+	for message, invalidate := range testCases {
+		cfg := valid
+		invalidate(&cfg)
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), message)
+	}
+	assert.Equal(suite.T(), 48*time.Hour, (&EmailDeliveryStatusConfig{RetentionDays: 2}).Retention())
+}
+
+func (suite *ConfigTestSuite) TestJobsConfig_Validate() {
+	assert.NoError(suite.T(), (&JobsConfig{}).Validate())
+	assert.NoError(suite.T(), (&JobsConfig{Workers: 2, QueueSize: 100, RetentionSeconds: 60}).Validate())
+
+	for field, cfg := range map[string]JobsConfig{
+		"jobs.workers":           {Workers: -1},
+		"jobs.queue_size":        {QueueSize: -1},
+		"jobs.retention_seconds": {RetentionSeconds: -1},
+	} {
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), field)
+	}
+	assert.Equal(suite.T(), time.Minute, (&JobsConfig{RetentionSeconds: 60}).Retention())
+}
+
+func (suite *ConfigTestSuite) TestPushAuthConfig_Validate() {
+	valid := PushAuthConfig{
+		ChallengeTTLSeconds: 120,
+		LongPollSeconds:     10,
+		FCM:                 FCMPushConfig{ProjectID: "project", ServiceAccountFile: "sa.json"},
+		APNs:                APNsPushConfig{TeamID: "team", KeyID: "key", PrivateKeyFile: "key.p8", Topic: "app"},
+	}
+	assert.NoError(suite.T(), (&PushAuthConfig{}).Validate())
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := map[string]func(c *PushAuthConfig){
+		"push_auth.challenge_ttl_seconds": func(c *PushAuthConfig) { c.ChallengeTTLSeconds = -1 },
+		"push_auth.long_poll_seconds":     func(c *PushAuthConfig) { c.LongPollSeconds = 120 },
+		"push_auth.fcm.service_account":   func(c *PushAuthConfig) { c.FCM.ServiceAccountFile = "" },
+		"push_auth.apns.key_id":           func(c *PushAuthConfig) { c.APNs.Topic = "" },
+		"must not be negative (got -5)":   func(c *PushAuthConfig) { c.LongPollSeconds = -5 },
+		"less than challenge_ttl_seconds": func(c *PushAuthConfig) { c.LongPollSeconds = 200 },
+	}
+	for message, invalidate := range testCases {
+		cfg := valid
+		invalidate(&cfg)
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), message)
+	}
+}
+
+func (suite *ConfigTestSuite) TestCrossDeviceAuthConfig_Validate() {
+	valid := CrossDeviceAuthConfig{
+		RequestTTLSeconds: 120,
+		LongPollSeconds:   10,
+		ApprovalURL:       "https://account.example.com/cross-device",
+	}
+	assert.NoError(suite.T(), (&CrossDeviceAuthConfig{}).Validate())
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := map[string]func(c *CrossDeviceAuthConfig){
+		"cross_device_auth.request_ttl_seconds": func(c *CrossDeviceAuthConfig) { c.RequestTTLSeconds = -1 },
+		"must not be negative (got -5)":         func(c *CrossDeviceAuthConfig) { c.LongPollSeconds = -5 },
+		"less than request_ttl_seconds":         func(c *CrossDeviceAuthConfig) { c.LongPollSeconds = 120 },
+		"cross_device_auth.approval_url":        func(c *CrossDeviceAuthConfig) { c.ApprovalURL = "/cross-device" },
+	}
+	for message, invalidate := range testCases {
+		cfg := valid
+		invalidate(&cfg)
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), message)
+	}
+}
+
+func (suite *ConfigTestSuite) TestSessionConfig_Validate() {
+	valid := SessionConfig{MaxConcurrentSessions: 3, LimitStrategy: "deny_new"}
+	assert.NoError(suite.T(), (&SessionConfig{}).Validate())
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := map[string]func(c *SessionConfig){
+		"session.max_concurrent_sessions must not be negative": func(c *SessionConfig) { c.MaxConcurrentSessions = -1 },
+		"session.limit_strategy must be one of":                func(c *SessionConfig) { c.LimitStrategy = "deny_all" },
+	}
+	for message, invalidate := range testCases {
+		cfg := valid
+		invalidate(&cfg)
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), message)
+	}
+}
+
+func (suite *ConfigTestSuite) TestCertificateAuthConfig_Validate() {
+	valid := CertificateAuthConfig{
+		Enabled:     true,
+		CAFiles:     []string{"repository/resources/security/client-ca.pem"},
+		UserMapping: CertificateUserMappingConfig{Source: "san_email", Attribute: "email"},
+		Revocation:  CertificateRevocationConfig{OCSPEnabled: true, OCSPTimeoutSeconds: 5},
+	}
+	assert.NoError(suite.T(), (&CertificateAuthConfig{}).Validate())
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := map[string]func(c *CertificateAuthConfig){
+		"certificate_auth.ca_files is required": func(c *CertificateAuthConfig) { c.CAFiles = nil },
+		"certificate_auth.user_mapping.source":  func(c *CertificateAuthConfig) { c.UserMapping.Source = "serial" },
+		"ocsp_timeout_seconds must not be negative": func(c *CertificateAuthConfig) {
+			c.Revocation.OCSPTimeoutSeconds = -1
+		},
+	}
+	for message, invalidate := range testCases {
+		cfg := valid
+		invalidate(&cfg)
+		err := cfg.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), message)
+	}
+}
+
+func (suite *ConfigTestSuite) TestDatabaseConfig_Validate() {
+	valid := DatabaseConfig{
+		Config: DataSource{Type: "sqlite", SQLite: SQLiteDataSource{MaxOpenConns: 10, MaxIdleConns: 5}},
+		Runtime: DataSource{Type: "postgres", Postgres: PostgresDataSource{
+			MaxOpenConns: 20, MaxIdleConns: 20, ConnMaxIdleTime: 300, StatementTimeoutMS: 30000,
+		}},
+		User: DataSource{Type: "redis"},
+	}
+	assert.NoError(suite.T(), valid.Validate())
+	valid.Runtime = DataSource{Type: "bolt", Bolt: BoltDataSource{Path: "database/runtimedb.bolt"}}
+	assert.NoError(suite.T(), valid.Validate())
+
+	testCases := []struct {
+		name     string
+		cfg      DatabaseConfig
+		contains string
+	}{
+		{
+			name: "IdleExceedsOpen",
+			cfg: DatabaseConfig{User: DataSource{Type: "sqlite", SQLite: SQLiteDataSource{
+				MaxOpenConns: 5, MaxIdleConns: 10,
+			}}},
+			contains: "database.user.sqlite.max_idle_conns",
+		},
+		{
+			name: "NegativePoolSetting",
+			cfg: DatabaseConfig{Config: DataSource{Type: "postgres", Postgres: PostgresDataSource{
+				ConnMaxIdleTime: -1,
+			}}},
+			contains: "database.config.postgres connection pool settings",
+		},
+		{
+			name: "NegativeStatementTimeout",
+			cfg: DatabaseConfig{Runtime: DataSource{Type: "postgres", Postgres: PostgresDataSource{
+				StatementTimeoutMS: -1,
+			}}},
+			contains: "database.runtime.postgres.connect_timeout",
+		},
+		{
+			name: "StatementTimeoutWithPgBouncer",
+			cfg: DatabaseConfig{Operation: DataSource{Type: "postgres", Postgres: PostgresDataSource{
+				StatementTimeoutMS: 1000, PgBouncerMode: true,
+			}}},
+			contains: "database.operation.postgres.statement_timeout_ms",
+		},
+		{
+			name:     "BoltWithoutPath",
+			cfg:      DatabaseConfig{Runtime: DataSource{Type: "bolt"}},
+			contains: "database.runtime.bolt.path is required",
+		},
+		{
+			name:     "BoltForUserDatasource",
+			cfg:      DatabaseConfig{User: DataSource{Type: "bolt", Bolt: BoltDataSource{Path: "userdb.bolt"}}},
+			contains: "database.user.type cannot be bolt",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.cfg.Validate()
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.contains)
+		})
+	}
+}
+
+func (suite *ConfigTestSuite) TestOutboundHTTPConfig_Validate_Valid() {
+	cfg := OutboundHTTPConfig{
+		TimeoutSeconds: 10,
+		Proxy:          OutboundProxyConfig{URL: "http://proxy.internal:3128", NoProxy: []string{".internal"}},
+		Retry:          OutboundRetryConfig{MaxAttempts: 3, InitialBackoffMS: 100, MaxBackoffMS: 1000},
+		CircuitBreaker: OutboundCircuitBreakerConfig{Enabled: true, FailureThreshold: 5, OpenSeconds: 30},
+		Destinations: []OutboundDestinationConfig{{
+			Host: "*.idp.example.com",
+			TLS:  &OutboundTLSConfig{CertFile: "repository/resources/security/client.crt", KeyFile: "client.key"},
+		}},
+	}
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.NoError(suite.T(), (&OutboundHTTPConfig{}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestOutboundHTTPConfig_Validate_Invalid() {
+	testCases := []struct {
+		name     string
+		cfg      OutboundHTTPConfig
+		contains string
+	}{
+		{"NegativeTimeout", OutboundHTTPConfig{TimeoutSeconds: -1}, "outbound_http.timeout_seconds"},
+		{
+			"RelativeProxyURL",
+			OutboundHTTPConfig{Proxy: OutboundProxyConfig{URL: "proxy:3128"}},
+			"outbound_http.proxy.url",
+		},
+		{
+			"CertWithoutKey",
+			OutboundHTTPConfig{TLS: OutboundTLSConfig{CertFile: "client.crt"}},
+			"outbound_http.tls.cert_file and outbound_http.tls.key_file",
+		},
+		{
+			"NegativeRetry",
+			OutboundHTTPConfig{Retry: OutboundRetryConfig{MaxAttempts: -1}},
+			"outbound_http.retry",
+		},
+		{
+			"NegativeBreakerThreshold",
+			OutboundHTTPConfig{CircuitBreaker: OutboundCircuitBreakerConfig{FailureThreshold: -1}},
+			"outbound_http.circuit_breaker",
+		},
+		{
+			"DestinationWithoutHost",
+			OutboundHTTPConfig{Destinations: []OutboundDestinationConfig{{TimeoutSeconds: 5}}},
+			"outbound_http.destinations[0].host is required",
+		},
+		{
+			"InvalidDestinationOverride",
+			OutboundHTTPConfig{Destinations: []OutboundDestinationConfig{{
+				Host: "api.example.com", Retry: &OutboundRetryConfig{MaxBackoffMS: -5},
+			}}},
+			"outbound_http.destinations[0].retry",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.cfg.Validate()
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.contains)
+		})
+	}
+}
@@ -22,11 +22,14 @@ import (
 	"context"
 	"net"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 )
 
 // ServerRuntime holds the runtime configuration for the server.
@@ -38,76 +41,154 @@ type ServerRuntime struct {
 }
 
 var (
-	runtimeConfig *ServerRuntime
+	runtimeConfig atomic.Pointer[ServerRuntime]
 	once          sync.Once
+	reloadMu      sync.Mutex
 )
 
+// ReloadableSections lists the configuration settings that ReloadServerRuntime applies to a running
+// server. Changes to any other setting take effect only after a restart.
+var ReloadableSections = []string{"log.level", "gate_client", "feature_flags"}
+
+// ReloadResult describes the outcome of a configuration reload.
+type ReloadResult struct {
+	// Applied lists the reloadable settings whose values changed and were applied.
+	Applied []string
+	// RequiresRestart lists the changed top-level sections that were not applied.
+	RequiresRestart []string
+}
+
 // InitializeServerRuntime initializes the server runtime configurations.
 func InitializeServerRuntime(serverHome string, config *Config) error {
 	once.Do(func() {
-		loginPath := config.GateClient.LoginPath
-		if strings.TrimSpace(loginPath) == "" {
-			loginPath = "/signin"
-		}
-		callbackPath := config.GateClient.CallbackPath
-		if strings.TrimSpace(callbackPath) == "" {
-			callbackPath = "/callback"
-		}
+		loginURL, callbackURL := resolveGateClientURLs(config.GateClient)
+		runtimeConfig.Store(&ServerRuntime{
+			ServerHome:            serverHome,
+			GateClientLoginURL:    loginURL,
+			GateClientCallbackURL: callbackURL,
+			Config:                *config,
+		})
+	})
+	return nil
+}
 
-		portStr := strconv.Itoa(config.GateClient.Port)
-		hostWithPort := net.JoinHostPort(config.GateClient.Hostname, portStr)
+// ReloadServerRuntime applies the reloadable settings of a freshly loaded configuration to the server
+// runtime. The current snapshot is copied, the reloadable settings are replaced and the new snapshot is
+// swapped in atomically, so a reader sees either the old or the new configuration and never a mix. The
+// caller is responsible for validating the configuration, which LoadConfig does.
+func ReloadServerRuntime(config *Config) ReloadResult {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
 
-		baseURL := &url.URL{
-			Scheme: config.GateClient.Scheme,
-			Host:   hostWithPort,
-		}
+	current := GetServerRuntime()
+	result := ReloadResult{
+		RequiresRestart: changedSections(current.Config, withReloadableSettings(*config, current.Config)),
+	}
+	if current.Config.Log.Level != config.Log.Level {
+		result.Applied = append(result.Applied, "log.level")
+	}
+	if !reflect.DeepEqual(current.Config.GateClient, config.GateClient) {
+		result.Applied = append(result.Applied, "gate_client")
+	}
+	if !reflect.DeepEqual(current.Config.FeatureFlags, config.FeatureFlags) {
+		result.Applied = append(result.Applied, "feature_flags")
+	}
+	if len(result.Applied) == 0 {
+		return result
+	}
 
-		parsedPath, err := url.Parse(loginPath)
-		if err != nil || parsedPath == nil {
-			// Runtime initialization runs during application startup, outside any request.
-			log.GetLogger().Warn(context.Background(),
-				"Invalid gate client login path configured. Falling back to default '/signin'",
-				log.String("configuredPath", loginPath),
-				log.Error(err),
-			)
-			parsedPath = &url.URL{Path: "/signin"}
-		}
+	next := *current
+	next.Config = withReloadableSettings(current.Config, *config)
+	next.GateClientLoginURL, next.GateClientCallbackURL = resolveGateClientURLs(next.Config.GateClient)
+	runtimeConfig.Store(&next)
+	return result
+}
+
+// withReloadableSettings returns base with its reloadable settings taken from source.
+func withReloadableSettings(base, source Config) Config {
+	base.Log.Level = source.Log.Level
+	base.GateClient = source.GateClient
+	base.FeatureFlags = source.FeatureFlags
+	return base
+}
 
-		parsedCallbackPath, err := url.Parse(callbackPath)
-		if err != nil || parsedCallbackPath == nil {
-			// Runtime initialization runs during application startup, outside any request.
-			log.GetLogger().Warn(context.Background(),
-				"Invalid gate client callback path configured. Falling back to default '/callback'",
-				log.String("configuredPath", callbackPath),
-				log.Error(err),
-			)
-			parsedCallbackPath = &url.URL{Path: "/callback"}
+// changedSections returns the YAML names of the top-level sections that differ between two
+// configurations.
+func changedSections(a, b Config) []string {
+	var changed []string
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	for i := 0; i < va.NumField(); i++ {
+		if reflect.DeepEqual(va.Field(i).Interface(), vb.Field(i).Interface()) {
+			continue
 		}
+		name, _, _ := strings.Cut(va.Type().Field(i).Tag.Get("yaml"), ",")
+		changed = append(changed, name)
+	}
+	return changed
+}
 
-		parsedURL := baseURL.ResolveReference(parsedPath)
-		parsedCallbackURL := baseURL.ResolveReference(parsedCallbackPath)
+// resolveGateClientURLs builds the gate client login and callback URLs, falling back to the default
+// paths when a configured path cannot be parsed.
+func resolveGateClientURLs(gateClient engineconfig.GateClientConfig) (*url.URL, *url.URL) {
+	loginPath := gateClient.LoginPath
+	if strings.TrimSpace(loginPath) == "" {
+		loginPath = "/signin"
+	}
+	callbackPath := gateClient.CallbackPath
+	if strings.TrimSpace(callbackPath) == "" {
+		callbackPath = "/callback"
+	}
 
-		runtimeConfig = &ServerRuntime{
-			ServerHome:            serverHome,
-			GateClientLoginURL:    parsedURL,
-			GateClientCallbackURL: parsedCallbackURL,
-			Config:                *config,
-		}
-	})
-	return nil
+	portStr := strconv.Itoa(gateClient.Port)
+	hostWithPort := net.JoinHostPort(gateClient.Hostname, portStr)
+
+	baseURL := &url.URL{
+		Scheme: gateClient.Scheme,
+		Host:   hostWithPort,
+	}
+
+	parsedPath, err := url.Parse(loginPath)
+	if err != nil || parsedPath == nil {
+		// Runtime initialization runs outside any request.
+		log.GetLogger().Warn(context.Background(),
+			"Invalid gate client login path configured. Falling back to default '/signin'",
+			log.String("configuredPath", loginPath),
+			log.Error(err),
+		)
+		parsedPath = &url.URL{Path: "/signin"}
+	}
+
+	parsedCallbackPath, err := url.Parse(callbackPath)
+	if err != nil || parsedCallbackPath == nil {
+		// Runtime initialization runs outside any request.
+		log.GetLogger().Warn(context.Background(),
+			"Invalid gate client callback path configured. Falling back to default '/callback'",
+			log.String("configuredPath", callbackPath),
+			log.Error(err),
+		)
+		parsedCallbackPath = &url.URL{Path: "/callback"}
+	}
+
+	return baseURL.ResolveReference(parsedPath), baseURL.ResolveReference(parsedCallbackPath)
 }
 
 // GetServerRuntime returns the server runtime configurations.
 func GetServerRuntime() *ServerRuntime {
-	if runtimeConfig == nil {
+	runtime := runtimeConfig.Load()
+	if runtime == nil {
 		panic("Server runtime is not initialized")
 	}
-	return runtimeConfig
+	return runtime
+}
+
+// IsServerRuntimeInitialized reports whether the server runtime has been initialized.
+func IsServerRuntimeInitialized() bool {
+	return runtimeConfig.Load() != nil
 }
 
 // ResetServerRuntime resets the server runtime.
 // This should only be used in tests to reset the singleton state.
 func ResetServerRuntime() {
-	runtimeConfig = nil
+	runtimeConfig.Store(nil)
 	once = sync.Once{}
 }
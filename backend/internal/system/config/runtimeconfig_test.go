@@ -37,7 +37,7 @@ func TestRuntimeConfigSuite(t *testing.T) {
 }
 
 func (suite *RuntimeConfigTestSuite) BeforeTest(suiteName, testName string) {
-	runtimeConfig = nil
+	runtimeConfig.Store(nil)
 	once = sync.Once{}
 }
 
@@ -57,7 +57,7 @@ func (suite *RuntimeConfigTestSuite) TestInitializeServerRuntime() {
 
 	assert.NoError(suite.T(), err)
 
-	runtime := runtimeConfig
+	runtime := runtimeConfig.Load()
 	assert.NotNil(suite.T(), runtime)
 	assert.Equal(suite.T(), "/test/thunderid/home", runtime.ServerHome)
 	assert.Equal(suite.T(), config.Server.Hostname, runtime.Config.Server.Hostname)
@@ -95,6 +95,14 @@ func (suite *RuntimeConfigTestSuite) TestInitializeServerRuntimeOnlyOnce() {
 	assert.Equal(suite.T(), 8000, runtime.Config.Server.Port)
 }
 
+func (suite *RuntimeConfigTestSuite) TestIsServerRuntimeInitialized() {
+	assert.False(suite.T(), IsServerRuntimeInitialized())
+
+	assert.NoError(suite.T(), InitializeServerRuntime("/test/home", &Config{}))
+
+	assert.True(suite.T(), IsServerRuntimeInitialized())
+}
+
 func (suite *RuntimeConfigTestSuite) TestGetServerRuntime() {
 	config := &Config{
 		Server: engineconfig.ServerConfig{
@@ -114,7 +122,7 @@ func (suite *RuntimeConfigTestSuite) TestGetServerRuntime() {
 }
 
 func (suite *RuntimeConfigTestSuite) TestGetServerRuntimePanic() {
-	runtimeConfig = nil
+	runtimeConfig.Store(nil)
 
 	assert.Panics(suite.T(), func() {
 		GetServerRuntime()
@@ -160,3 +168,58 @@ func (suite *RuntimeConfigTestSuite) TestInitializeServerRuntime_InvalidCallback
 	assert.Equal(suite.T(), "/callback", runtime.GateClientCallbackURL.Path)
 	assert.Equal(suite.T(), "https://localhost:8443/callback", runtime.GateClientCallbackURL.String())
 }
+
+func (suite *RuntimeConfigTestSuite) TestReloadServerRuntime_AppliesReloadableSettings() {
+	initial := &Config{}
+	initial.Server.Port = 8090
+	initial.Log.Level = "info"
+	initial.GateClient = engineconfig.GateClientConfig{Scheme: "https", Hostname: "localhost", Port: 5190,
+		LoginPath: "/signin"}
+	assert.NoError(suite.T(), InitializeServerRuntime("/test/home", initial))
+	before := GetServerRuntime()
+
+	reloaded := *initial
+	reloaded.Log.Level = "debug"
+	reloaded.GateClient.LoginPath = "/gate/signin"
+	reloaded.Server.Port = 9090
+
+	result := ReloadServerRuntime(&reloaded)
+
+	assert.Equal(suite.T(), []string{"log.level", "gate_client"}, result.Applied)
+	assert.Equal(suite.T(), []string{"server"}, result.RequiresRestart)
+	runtime := GetServerRuntime()
+	assert.NotSame(suite.T(), before, runtime)
+	assert.Equal(suite.T(), "debug", runtime.Config.Log.Level)
+	assert.Equal(suite.T(), "/gate/signin", runtime.GateClientLoginURL.Path)
+	assert.Equal(suite.T(), 8090, runtime.Config.Server.Port)
+	assert.Equal(suite.T(), "info", before.Config.Log.Level)
+	assert.Equal(suite.T(), "/test/home", runtime.ServerHome)
+}
+
+func (suite *RuntimeConfigTestSuite) TestReloadServerRuntime_AppliesFeatureFlags() {
+	initial := &Config{FeatureFlags: map[string]bool{"par": true}}
+	assert.NoError(suite.T(), InitializeServerRuntime("/test/home", initial))
+
+	reloaded := *initial
+	reloaded.FeatureFlags = map[string]bool{"par": false}
+
+	result := ReloadServerRuntime(&reloaded)
+
+	assert.Equal(suite.T(), []string{"feature_flags"}, result.Applied)
+	assert.Empty(suite.T(), result.RequiresRestart)
+	assert.False(suite.T(), GetServerRuntime().Config.FeatureFlags["par"])
+}
+
+func (suite *RuntimeConfigTestSuite) TestReloadServerRuntime_NoChanges() {
+	initial := &Config{}
+	initial.Log.Level = "info"
+	assert.NoError(suite.T(), InitializeServerRuntime("/test/home", initial))
+	before := GetServerRuntime()
+
+	reloaded := *initial
+	result := ReloadServerRuntime(&reloaded)
+
+	assert.Empty(suite.T(), result.Applied)
+	assert.Empty(suite.T(), result.RequiresRestart)
+	assert.Same(suite.T(), before, GetServerRuntime())
+}
@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package configascode reconciles the resources declared in the configuration-as-code directory into
+// the database at startup. Declared resources are created or updated through the import service, so a
+// directory under version control reproduces the same applications, flows, scopes and organization
+// units in every environment. With pruning enabled, resources reconciled on an earlier start that are
+// no longer declared are deleted; resources created through the API or the bootstrap are never pruned.
+package configascode
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/bootstrap"
+	"github.com/thunder-id/thunderid/internal/system/importer"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Resource types that can be pruned. They match the resource types reported by the import service.
+const (
+	ResourceTypeApplication      = "application"
+	ResourceTypeFlow             = "flow"
+	ResourceTypeScope            = "scope"
+	ResourceTypeOrganizationUnit = "organization_unit"
+)
+
+// pruneOrder deletes resources before the resources they reference: applications reference flows and
+// organization units, and scopes reference organization units in their restrictions.
+var pruneOrder = []string{
+	ResourceTypeApplication,
+	ResourceTypeScope,
+	ResourceTypeFlow,
+	ResourceTypeOrganizationUnit,
+}
+
+// ResourceDeleter deletes a resource of one type by its ID.
+type ResourceDeleter struct {
+	// Delete deletes the resource with the given ID.
+	Delete func(ctx context.Context, id string) *tidcommon.ServiceError
+	// NotFoundCode is the error code Delete returns when the resource no longer exists.
+	NotFoundCode string
+}
+
+// Options configures a reconciliation run.
+type Options struct {
+	// Directory holds the declared resource definitions.
+	Directory string
+	// Prune deletes the resources reconciled on an earlier run that are no longer declared.
+	Prune bool
+}
+
+// ReconcilerInterface defines the startup reconciliation of the configuration-as-code directory.
+type ReconcilerInterface interface {
+	Reconcile(ctx context.Context, opts Options) error
+}
+
+type reconciler struct {
+	importService importer.ImportServiceInterface
+	store         managedResourceStoreInterface
+	deleters      map[string]ResourceDeleter
+	logger        *log.Logger
+}
+
+// Initialize creates the configuration-as-code reconciler. The deleters are keyed by resource type and
+// select the resource types that pruning removes.
+func Initialize(
+	importService importer.ImportServiceInterface, deleters map[string]ResourceDeleter,
+) ReconcilerInterface {
+	return newReconciler(importService, newManagedResourceStore(), deleters)
+}
+
+func newReconciler(
+	importService importer.ImportServiceInterface, store managedResourceStoreInterface,
+	deleters map[string]ResourceDeleter,
+) *reconciler {
+	return &reconciler{
+		importService: importService,
+		store:         store,
+		deleters:      deleters,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ConfigAsCode")),
+	}
+}
+
+// Reconcile creates or updates every resource declared in the directory and records it as managed.
+// The run fails on the first resource that cannot be applied, before anything is pruned. A missing or
+// empty directory is treated as a likely misconfiguration: nothing is applied or pruned.
+func (r *reconciler) Reconcile(ctx context.Context, opts Options) error {
+	ctx = security.WithRuntimeContext(ctx)
+
+	content, err := bootstrap.LoadBundle(opts.Directory)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(content) == "" {
+		r.logger.Warn(ctx, "No configuration-as-code resource definitions found; skipping reconciliation",
+			log.String("directory", opts.Directory))
+		return nil
+	}
+
+	resolved, err := utils.SubstituteEnvironmentVariables([]byte(content))
+	if err != nil {
+		return fmt.Errorf("failed to resolve configuration-as-code template variables: %w", err)
+	}
+
+	declared, err := r.apply(ctx, string(resolved))
+	if err != nil {
+		return err
+	}
+
+	for resource := range declared {
+		if err := r.store.AddManagedResource(ctx, resource); err != nil {
+			return err
+		}
+	}
+
+	pruned := 0
+	if opts.Prune {
+		if pruned, err = r.prune(ctx, declared); err != nil {
+			return err
+		}
+	}
+
+	r.logger.Info(ctx, "Configuration-as-code reconciliation completed",
+		log.Int("reconciled", len(declared)), log.Int("pruned", pruned))
+	return nil
+}
+
+// apply imports the declared resources and returns the resources it created or updated.
+func (r *reconciler) apply(ctx context.Context, content string) (map[managedResource]struct{}, error) {
+	upsert := true
+	continueOnError := false
+	response, svcErr := r.importService.ImportResources(ctx, &importer.ImportRequest{
+		Content: content,
+		Options: &importer.ImportOptions{
+			Upsert:          &upsert,
+			ContinueOnError: &continueOnError,
+			Target:          "runtime",
+		},
+	})
+	if svcErr != nil {
+		return nil, fmt.Errorf("configuration-as-code import failed [%s]: %s",
+			svcErr.Code, svcErr.Error.DefaultValue)
+	}
+	if response == nil || response.Summary == nil {
+		return nil, fmt.Errorf("configuration-as-code import returned no result")
+	}
+
+	declared := make(map[managedResource]struct{}, len(response.Results))
+	var failures []string
+	for _, result := range response.Results {
+		if result.Status != "success" {
+			failures = append(failures,
+				fmt.Sprintf("%s %q (%s): %s", result.ResourceType, result.ResourceName, result.Code, result.Message))
+			continue
+		}
+		r.logger.Debug(ctx, "Configuration-as-code resource reconciled",
+			log.String("resourceType", result.ResourceType),
+			log.String("resourceName", result.ResourceName),
+			log.String("operation", result.Operation))
+		if result.ResourceID != "" {
+			declared[managedResource{ResourceType: result.ResourceType, ResourceID: result.ResourceID}] = struct{}{}
+		}
+	}
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("configuration-as-code import failed for %d resource(s): %s",
+			len(failures), strings.Join(failures, "; "))
+	}
+	return declared, nil
+}
+
+// prune deletes the managed resources that are no longer declared and returns how many it deleted. A
+// resource that cannot be deleted, for example an organization unit that still has members, is logged
+// and kept as managed so the next start retries it.
+func (r *reconciler) prune(ctx context.Context, declared map[managedResource]struct{}) (int, error) {
+	managed, err := r.store.ListManagedResources(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := 0
+	for _, resourceType := range pruneOrder {
+		deleter, ok := r.deleters[resourceType]
+		if !ok {
+			continue
+		}
+		for _, resource := range managed {
+			if resource.ResourceType != resourceType {
+				continue
+			}
+			if _, ok := declared[resource]; ok {
+				continue
+			}
+
+			if svcErr := deleter.Delete(ctx, resource.ResourceID); svcErr != nil &&
+				svcErr.Code != deleter.NotFoundCode {
+				r.logger.Warn(ctx, "Failed to prune configuration-as-code resource",
+					log.String("resourceType", resource.ResourceType),
+					log.String("resourceID", resource.ResourceID),
+					log.String("code", svcErr.Code))
+				continue
+			}
+			if err := r.store.RemoveManagedResource(ctx, resource); err != nil {
+				return pruned, err
+			}
+			r.logger.Info(ctx, "Pruned configuration-as-code resource",
+				log.String("resourceType", resource.ResourceType),
+				log.String("resourceID", resource.ResourceID))
+			pruned++
+		}
+	}
+	return pruned, nil
+}
@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configascode
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/importer"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+const testNotFoundCode = "TST-1001"
+
+// stubImportService records the request it receives and returns a configured result.
+type stubImportService struct {
+	lastRequest *importer.ImportRequest
+	response    *importer.ImportResponse
+	err         *tidcommon.ServiceError
+}
+
+func (s *stubImportService) ImportResources(_ context.Context, request *importer.ImportRequest) (
+	*importer.ImportResponse, *tidcommon.ServiceError) {
+	s.lastRequest = request
+	return s.response, s.err
+}
+
+func (s *stubImportService) DeleteResource(_ context.Context, _ *importer.DeleteResourceRequest) (
+	*importer.DeleteResourceResponse, *tidcommon.ServiceError) {
+	return nil, nil
+}
+
+// fakeManagedResourceStore keeps the managed resource records in memory.
+type fakeManagedResourceStore struct {
+	resources map[managedResource]struct{}
+}
+
+func newFakeManagedResourceStore(resources ...managedResource) *fakeManagedResourceStore {
+	store := &fakeManagedResourceStore{resources: map[managedResource]struct{}{}}
+	for _, resource := range resources {
+		store.resources[resource] = struct{}{}
+	}
+	return store
+}
+
+func (f *fakeManagedResourceStore) ListManagedResources(_ context.Context) ([]managedResource, error) {
+	resources := make([]managedResource, 0, len(f.resources))
+	for resource := range f.resources {
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+func (f *fakeManagedResourceStore) AddManagedResource(_ context.Context, resource managedResource) error {
+	f.resources[resource] = struct{}{}
+	return nil
+}
+
+func (f *fakeManagedResourceStore) RemoveManagedResource(_ context.Context, resource managedResource) error {
+	delete(f.resources, resource)
+	return nil
+}
+
+// recordingDeleter records the IDs it is asked to delete and fails for the configured IDs.
+type recordingDeleter struct {
+	deleted []string
+	errors  map[string]*tidcommon.ServiceError
+}
+
+func (d *recordingDeleter) deleter() ResourceDeleter {
+	return ResourceDeleter{
+		Delete: func(_ context.Context, id string) *tidcommon.ServiceError {
+			d.deleted = append(d.deleted, id)
+			return d.errors[id]
+		},
+		NotFoundCode: testNotFoundCode,
+	}
+}
+
+func writeDefinitions(t *testing.T, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "resources.yaml"), []byte(content), 0o600))
+	return dir
+}
+
+func successResponse(results ...importer.ImportItemOutcome) *importer.ImportResponse {
+	for i := range results {
+		results[i].Status = "success"
+	}
+	return &importer.ImportResponse{
+		Summary: &importer.ImportSummary{Imported: len(results)},
+		Results: results,
+	}
+}
+
+func TestReconcile_AppliesAndRecordsDeclaredResources(t *testing.T) {
+	importSvc := &stubImportService{response: successResponse(
+		importer.ImportItemOutcome{ResourceType: ResourceTypeApplication, ResourceID: "app-1", ResourceName: "App"},
+		importer.ImportItemOutcome{ResourceType: ResourceTypeScope, ResourceID: "scope-1", ResourceName: "read"},
+	)}
+	store := newFakeManagedResourceStore()
+	dir := writeDefinitions(t, "# resource_type: application\nname: App\n")
+
+	err := newReconciler(importSvc, store, nil).Reconcile(context.Background(), Options{Directory: dir})
+
+	require.NoError(t, err)
+	require.NotNil(t, importSvc.lastRequest)
+	assert.Contains(t, importSvc.lastRequest.Content, "name: App")
+	assert.True(t, importSvc.lastRequest.Options.IsUpsertEnabled())
+	assert.False(t, *importSvc.lastRequest.Options.ContinueOnError)
+	assert.Contains(t, store.resources, managedResource{ResourceTypeApplication, "app-1"})
+	assert.Contains(t, store.resources, managedResource{ResourceTypeScope, "scope-1"})
+}
+
+func TestReconcile_ResolvesEnvironmentVariables(t *testing.T) {
+	t.Setenv("CAC_TEST_APP_NAME", "Resolved App")
+	importSvc := &stubImportService{response: successResponse()}
+	dir := writeDefinitions(t, "# resource_type: application\nname: {{ .CAC_TEST_APP_NAME }}\n")
+
+	err := newReconciler(importSvc, newFakeManagedResourceStore(), nil).
+		Reconcile(context.Background(), Options{Directory: dir})
+
+	require.NoError(t, err)
+	assert.Contains(t, importSvc.lastRequest.Content, "name: Resolved App")
+}
+
+func TestReconcile_MissingDirectorySkipsImportAndPrune(t *testing.T) {
+	importSvc := &stubImportService{}
+	store := newFakeManagedResourceStore(managedResource{ResourceTypeApplication, "app-1"})
+	apps := &recordingDeleter{}
+
+	err := newReconciler(importSvc, store, map[string]ResourceDeleter{ResourceTypeApplication: apps.deleter()}).
+		Reconcile(context.Background(), Options{Directory: filepath.Join(t.TempDir(), "missing"), Prune: true})
+
+	require.NoError(t, err)
+	assert.Nil(t, importSvc.lastRequest)
+	assert.Empty(t, apps.deleted)
+	assert.Len(t, store.resources, 1)
+}
+
+func TestReconcile_FailedResourceFailsBeforePrune(t *testing.T) {
+	importSvc := &stubImportService{response: &importer.ImportResponse{
+		Summary: &importer.ImportSummary{Failed: 1},
+		Results: []importer.ImportItemOutcome{{
+			ResourceType: ResourceTypeApplication, ResourceName: "App", Status: "failed",
+			Code: "APP-1012", Message: "invalid redirect URI",
+		}},
+	}}
+	store := newFakeManagedResourceStore(managedResource{ResourceTypeApplication, "app-old"})
+	apps := &recordingDeleter{}
+	dir := writeDefinitions(t, "# resource_type: application\nname: App\n")
+
+	err := newReconciler(importSvc, store, map[string]ResourceDeleter{ResourceTypeApplication: apps.deleter()}).
+		Reconcile(context.Background(), Options{Directory: dir, Prune: true})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "APP-1012")
+	assert.Empty(t, apps.deleted)
+}
+
+func TestReconcile_ImportServiceError(t *testing.T) {
+	importSvc := &stubImportService{err: &tidcommon.InternalServerError}
+	dir := writeDefinitions(t, "# resource_type: application\nname: App\n")
+
+	err := newReconciler(importSvc, newFakeManagedResourceStore(), nil).
+		Reconcile(context.Background(), Options{Directory: dir})
+
+	require.Error(t, err)
+}
+
+func TestReconcile_PrunesOnlyUndeclaredManagedResources(t *testing.T) {
+	importSvc := &stubImportService{response: successResponse(
+		importer.ImportItemOutcome{ResourceType: ResourceTypeApplication, ResourceID: "app-kept"},
+	)}
+	store := newFakeManagedResourceStore(
+		managedResource{ResourceTypeApplication, "app-kept"},
+		managedResource{ResourceTypeApplication, "app-removed"},
+		managedResource{ResourceTypeFlow, "flow-removed"},
+	)
+	apps := &recordingDeleter{}
+	flows := &recordingDeleter{}
+	dir := writeDefinitions(t, "# resource_type: application\nname: App\n")
+
+	err := newReconciler(importSvc, store, map[string]ResourceDeleter{
+		ResourceTypeApplication: apps.deleter(),
+		ResourceTypeFlow:        flows.deleter(),
+	}).Reconcile(context.Background(), Options{Directory: dir, Prune: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app-removed"}, apps.deleted)
+	assert.Equal(t, []string{"flow-removed"}, flows.deleted)
+	assert.Equal(t, map[managedResource]struct{}{{ResourceTypeApplication, "app-kept"}: {}}, store.resources)
+}
+
+func TestReconcile_WithoutPruneKeepsUndeclaredResources(t *testing.T) {
+	importSvc := &stubImportService{response: successResponse()}
+	store := newFakeManagedResourceStore(managedResource{ResourceTypeApplication, "app-removed"})
+	apps := &recordingDeleter{}
+	dir := writeDefinitions(t, "# resource_type: application\nname: App\n")
+
+	err := newReconciler(importSvc, store, map[string]ResourceDeleter{ResourceTypeApplication: apps.deleter()}).
+		Reconcile(context.Background(), Options{Directory: dir})
+
+	require.NoError(t, err)
+	assert.Empty(t, apps.deleted)
+	assert.Len(t, store.resources, 1)
+}
+
+func TestReconcile_PruneFailureKeepsRecordAndNotFoundDropsIt(t *testing.T) {
+	importSvc := &stubImportService{response: successResponse()}
+	store := newFakeManagedResourceStore(
+		managedResource{ResourceTypeOrganizationUnit, "ou-in-use"},
+		managedResource{ResourceTypeOrganizationUnit, "ou-gone"},
+	)
+	ous := &recordingDeleter{errors: map[string]*tidcommon.ServiceError{
+		"ou-in-use": {Code: "OU-1004"},
+		"ou-gone":   {Code: testNotFoundCode},
+	}}
+	dir := writeDefinitions(t, "# resource_type: organization_unit\nhandle: root\nname: Root\n")
+
+	err := newReconciler(importSvc, store, map[string]ResourceDeleter{ResourceTypeOrganizationUnit: ous.deleter()}).
+		Reconcile(context.Background(), Options{Directory: dir, Prune: true})
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ou-in-use", "ou-gone"}, ous.deleted)
+	assert.Equal(t, map[managedResource]struct{}{{ResourceTypeOrganizationUnit, "ou-in-use"}: {}}, store.resources)
+}
+
+func TestReconcile_PruneSkipsTypesWithoutDeleter(t *testing.T) {
+	importSvc := &stubImportService{response: successResponse()}
+	store := newFakeManagedResourceStore(managedResource{"role", "role-1"})
+	dir := writeDefinitions(t, "# resource_type: application\nname: App\n")
+
+	err := newReconciler(importSvc, store, map[string]ResourceDeleter{}).
+		Reconcile(context.Background(), Options{Directory: dir, Prune: true})
+
+	require.NoError(t, err)
+	assert.Len(t, store.resources, 1)
+}
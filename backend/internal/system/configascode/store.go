@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configascode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// managedResource identifies a resource created or updated from the configuration-as-code directory.
+type managedResource struct {
+	ResourceType string
+	ResourceID   string
+}
+
+// managedResourceStoreInterface defines the persistence operations for reconciled resource records.
+type managedResourceStoreInterface interface {
+	ListManagedResources(ctx context.Context) ([]managedResource, error)
+	AddManagedResource(ctx context.Context, resource managedResource) error
+	RemoveManagedResource(ctx context.Context, resource managedResource) error
+}
+
+// managedResourceStore is the database-backed record of reconciled resources, kept in the
+// configuration database next to the resources themselves.
+type managedResourceStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newManagedResourceStore creates a new instance of managedResourceStore.
+func newManagedResourceStore() managedResourceStoreInterface {
+	return &managedResourceStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// ListManagedResources returns every resource recorded by earlier reconciliations.
+func (s *managedResourceStore) ListManagedResources(ctx context.Context) ([]managedResource, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListManagedResources, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed resources: %w", err)
+	}
+
+	resources := make([]managedResource, 0, len(results))
+	for _, row := range results {
+		resourceType, ok := row["resource_type"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse resource_type as string")
+		}
+		resourceID, ok := row["resource_id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse resource_id as string")
+		}
+		resources = append(resources, managedResource{ResourceType: resourceType, ResourceID: resourceID})
+	}
+	return resources, nil
+}
+
+// AddManagedResource records a reconciled resource. Recording an already recorded resource is a no-op.
+func (s *managedResourceStore) AddManagedResource(ctx context.Context, resource managedResource) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryAddManagedResource,
+		resource.ResourceType, resource.ResourceID, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to add managed resource: %w", err)
+	}
+	return nil
+}
+
+// RemoveManagedResource removes the record of a reconciled resource.
+func (s *managedResourceStore) RemoveManagedResource(ctx context.Context, resource managedResource) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryRemoveManagedResource,
+		resource.ResourceType, resource.ResourceID, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to remove managed resource: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configascode
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+var (
+	// queryListManagedResources retrieves every resource recorded by earlier reconciliations.
+	queryListManagedResources = dbmodel.DBQuery{
+		ID: "CACQ-CONFIG_AS_CODE-01",
+		Query: `SELECT RESOURCE_TYPE, RESOURCE_ID FROM "CONFIG_AS_CODE_RESOURCE" WHERE DEPLOYMENT_ID = $1 ` +
+			`ORDER BY RESOURCE_TYPE, RESOURCE_ID`,
+	}
+
+	// queryAddManagedResource records a reconciled resource, ignoring resources already recorded.
+	queryAddManagedResource = dbmodel.DBQuery{
+		ID: "CACQ-CONFIG_AS_CODE-02",
+		Query: `INSERT INTO "CONFIG_AS_CODE_RESOURCE" (RESOURCE_TYPE, RESOURCE_ID, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`,
+	}
+
+	// queryRemoveManagedResource removes the record of a reconciled resource.
+	queryRemoveManagedResource = dbmodel.DBQuery{
+		ID: "CACQ-CONFIG_AS_CODE-03",
+		Query: `DELETE FROM "CONFIG_AS_CODE_RESOURCE" WHERE RESOURCE_TYPE = $1 AND RESOURCE_ID = $2 ` +
+			`AND DEPLOYMENT_ID = $3`,
+	}
+)
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configascode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *managedResourceStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &managedResourceStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *StoreTestSuite) TestListManagedResources() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListManagedResources, testDeploymentID).
+		Return([]map[string]interface{}{
+			{"resource_type": "application", "resource_id": "app-1"},
+			{"resource_type": "scope", "resource_id": "scope-1"},
+		}, nil)
+
+	resources, err := suite.store.ListManagedResources(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal([]managedResource{{"application", "app-1"}, {"scope", "scope-1"}}, resources)
+}
+
+func (suite *StoreTestSuite) TestListManagedResources_InvalidRow() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListManagedResources, testDeploymentID).
+		Return([]map[string]interface{}{{"resource_type": "application"}}, nil)
+
+	_, err := suite.store.ListManagedResources(suite.ctx)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestListManagedResources_DBClientError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db down"))
+
+	_, err := suite.store.ListManagedResources(suite.ctx)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestAddManagedResource() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryAddManagedResource,
+		"flow", "flow-1", testDeploymentID).Return(int64(1), nil)
+
+	suite.NoError(suite.store.AddManagedResource(suite.ctx, managedResource{"flow", "flow-1"}))
+}
+
+func (suite *StoreTestSuite) TestRemoveManagedResource_QueryError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryRemoveManagedResource,
+		"flow", "flow-1", testDeploymentID).Return(int64(0), errors.New("query failed"))
+
+	suite.Error(suite.store.RemoveManagedResource(suite.ctx, managedResource{"flow", "flow-1"}))
+}
@@ -0,0 +1,25 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package configreload reloads the deployment configuration of a running server. A reload is triggered by
+// SIGHUP or by the POST /system/config/reload endpoint. Only the settings listed in
+// config.ReloadableSections are applied; changes to other sections are reported as requiring a restart.
+package configreload
+
+// loggerComponentName is the component name used for logging in the configreload package.
+const loggerComponentName = "ConfigReload"
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configreload
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for configuration reload operations.
+var (
+	// ErrorInvalidConfiguration is the error returned when the configuration cannot be loaded or fails
+	// validation. The running configuration is left unchanged.
+	ErrorInvalidConfiguration = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "CFR-1001",
+		Error: common.I18nMessage{
+			Key:          "error.configreloadservice.invalid_configuration",
+			DefaultValue: "Invalid configuration",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.configreloadservice.invalid_configuration_description",
+			DefaultValue: "The configuration failed to load or validate; the running configuration is unchanged",
+		},
+	}
+)
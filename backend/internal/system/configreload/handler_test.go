@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configreload
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandleReload(t *testing.T) {
+	mockService := NewConfigReloadServiceInterfaceMock(t)
+	mockService.On("Reload", mock.Anything).
+		Return(&ReloadResponse{Applied: []string{"log.level"}, RequiresRestart: []string{}}, nil).Once()
+	mockService.On("Reload", mock.Anything).Return(nil, &ErrorInvalidConfiguration).Once()
+	handler := newConfigReloadHandler(mockService)
+
+	rec := httptest.NewRecorder()
+	handler.HandleReload(rec, httptest.NewRequest(http.MethodPost, "/system/config/reload", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"applied":["log.level"]`)
+
+	rec = httptest.NewRecorder()
+	handler.HandleReload(rec, httptest.NewRequest(http.MethodPost, "/system/config/reload", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrorInvalidConfiguration.Code)
+}
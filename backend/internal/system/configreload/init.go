@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configreload
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the configuration reload service and registers its route. The configuration is
+// reloaded from the given deployment and default configuration files.
+func Initialize(mux *http.ServeMux, configPath, defaultPath, serverHome string) ConfigReloadServiceInterface {
+	service := newConfigReloadService(configPath, defaultPath, serverHome)
+	registerRoutes(mux, newConfigReloadHandler(service))
+	return service
+}
+
+// registerRoutes registers the routes for configuration reload operations.
+func registerRoutes(mux *http.ServeMux, handler *configReloadHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /system/config/reload", handler.HandleReload, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /system/config/reload", func(w http.ResponseWriter,
+		_ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts))
+}
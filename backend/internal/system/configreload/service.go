@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configreload
+
+import (
+	"context"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// ConfigReloadServiceInterface defines the operation for reloading the deployment configuration.
+type ConfigReloadServiceInterface interface {
+	Reload(ctx context.Context) (*ReloadResponse, *common.ServiceError)
+}
+
+// configReloadService reloads the configuration from the files the server was started with.
+type configReloadService struct {
+	configPath  string
+	defaultPath string
+	serverHome  string
+	loadConfig  func(configPath, defaultPath, serverHome string) (*config.Config, error)
+	setLogLevel func(level string) error
+	logger      *log.Logger
+}
+
+// newConfigReloadService creates a new instance of configReloadService.
+func newConfigReloadService(configPath, defaultPath, serverHome string) ConfigReloadServiceInterface {
+	return &configReloadService{
+		configPath:  configPath,
+		defaultPath: defaultPath,
+		serverHome:  serverHome,
+		loadConfig:  config.LoadConfig,
+		setLogLevel: log.GetLogger().SetLevel,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// Reload loads and validates the configuration files and applies the reloadable settings. The log
+// level is applied before the runtime snapshot is swapped, so an invalid level rejects the whole reload.
+func (s *configReloadService) Reload(ctx context.Context) (*ReloadResponse, *common.ServiceError) {
+	cfg, err := s.loadConfig(s.configPath, s.defaultPath, s.serverHome)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to load the configuration for reload", log.Error(err))
+		return nil, &ErrorInvalidConfiguration
+	}
+	if cfg.Log.Level != config.GetServerRuntime().Config.Log.Level {
+		if err := s.setLogLevel(cfg.Log.Level); err != nil {
+			s.logger.Error(ctx, "Invalid log level in the reloaded configuration", log.Error(err))
+			return nil, &ErrorInvalidConfiguration
+		}
+	}
+
+	result := config.ReloadServerRuntime(cfg)
+	response := &ReloadResponse{Applied: result.Applied, RequiresRestart: result.RequiresRestart}
+	if response.Applied == nil {
+		response.Applied = []string{}
+	}
+	if response.RequiresRestart == nil {
+		response.RequiresRestart = []string{}
+	}
+
+	s.logger.Info(ctx, "Configuration reloaded", log.Any("applied", response.Applied))
+	if len(response.RequiresRestart) > 0 {
+		s.logger.Warn(ctx, "Configuration changes that require a restart were not applied",
+			log.Any("sections", response.RequiresRestart))
+	}
+	return response, nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configreload
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	initial  config.Config
+	loaded   *config.Config
+	loadErr  error
+	levelSet string
+	levelErr error
+	service  *configReloadService
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.initial = config.Config{}
+	suite.initial.Log.Level = "info"
+	suite.initial.GateClient.Hostname = "localhost"
+	suite.Require().NoError(config.InitializeServerRuntime("/test/home", &suite.initial))
+
+	reloaded := suite.initial
+	suite.loaded = &reloaded
+	suite.loadErr = nil
+	suite.levelSet = ""
+	suite.levelErr = nil
+	suite.service = &configReloadService{
+		loadConfig: func(_, _, _ string) (*config.Config, error) {
+			return suite.loaded, suite.loadErr
+		},
+		setLogLevel: func(level string) error {
+			suite.levelSet = level
+			return suite.levelErr
+		},
+		logger: log.GetLogger(),
+	}
+}
+
+func (suite *ServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *ServiceTestSuite) TestReload_AppliesReloadableSettings() {
+	suite.loaded.Log.Level = "debug"
+	suite.loaded.GateClient.LoginPath = "/gate/signin"
+	suite.loaded.Server.Port = 9090
+
+	response, svcErr := suite.service.Reload(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"log.level", "gate_client"}, response.Applied)
+	suite.Equal([]string{"server"}, response.RequiresRestart)
+	suite.Equal("debug", suite.levelSet)
+	suite.Equal("/gate/signin", config.GetServerRuntime().Config.GateClient.LoginPath)
+}
+
+func (suite *ServiceTestSuite) TestReload_NoChanges() {
+	response, svcErr := suite.service.Reload(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Empty(response.Applied)
+	suite.NotNil(response.Applied)
+	suite.NotNil(response.RequiresRestart)
+	suite.Empty(suite.levelSet)
+}
+
+func (suite *ServiceTestSuite) TestReload_LoadError() {
+	suite.loadErr = errors.New("invalid soft_delete.retention_days")
+
+	_, svcErr := suite.service.Reload(context.Background())
+
+	suite.Equal(&ErrorInvalidConfiguration, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestReload_InvalidLogLevelLeavesRuntimeUnchanged() {
+	suite.loaded.Log.Level = "verbose"
+	suite.loaded.GateClient.LoginPath = "/gate/signin"
+	suite.levelErr = errors.New("invalid log level")
+
+	_, svcErr := suite.service.Reload(context.Background())
+
+	suite.Equal(&ErrorInvalidConfiguration, svcErr)
+	suite.Equal("info", config.GetServerRuntime().Config.Log.Level)
+	suite.Empty(config.GetServerRuntime().Config.GateClient.LoginPath)
+}
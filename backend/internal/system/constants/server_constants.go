@@ -28,6 +28,9 @@ const AuthorizationHeaderName = "Authorization"
 // AcceptHeaderName is the name of the accept header used in HTTP requests.
 const AcceptHeaderName = "Accept"
 
+// AcceptLanguageHeaderName is the name of the accept language header used in HTTP requests.
+const AcceptLanguageHeaderName = "Accept-Language"
+
 // ContentTypeHeaderName is the name of the content type header used in HTTP requests.
 const ContentTypeHeaderName = "Content-Type"
 
@@ -48,9 +51,23 @@ const AuthSchemeBasic = "Basic "
 // AuthSchemeBearer is the authentication scheme prefix used in HTTP Bearer authentication.
 const AuthSchemeBearer = "Bearer "
 
+// WebSocketProtocolHeaderName is the name of the header that carries the subprotocols of a WebSocket handshake.
+const WebSocketProtocolHeaderName = "Sec-WebSocket-Protocol"
+
+// WebSocketBearerProtocolPrefix prefixes a bearer token offered as a WebSocket subprotocol. Browsers cannot set
+// the Authorization header on a WebSocket handshake, so the token is carried as a subprotocol instead.
+const WebSocketBearerProtocolPrefix = "bearer."
+
+// AdminNotificationsPath is the path of the admin notifications WebSocket endpoint, the only endpoint that
+// accepts a bearer token offered as a WebSocket subprotocol.
+const AdminNotificationsPath = "/admin-notifications"
+
 // ContentTypeJSON is the content type for JSON data.
 const ContentTypeJSON = "application/json"
 
+// ContentTypeProblemJSON is the content type for RFC 9457 problem details.
+const ContentTypeProblemJSON = "application/problem+json"
+
 // ContentTypeJWT is the content type for JWT data.
 const ContentTypeJWT = "application/jwt"
 
@@ -16,13 +16,16 @@
  * under the License.
  */
 
-// Package context provides utilities for managing trace IDs (correlation IDs)
+// Package context provides utilities for managing trace IDs (correlation IDs) and request metadata
+// carried in the request context.
 package context
 
 import (
 	"context"
 	"crypto/rand"
+	"crypto/x509"
 	"fmt"
+	"net/netip"
 )
 
 type contextKey string
@@ -30,6 +33,15 @@ type contextKey string
 const (
 	// TraceIDKey is the context key for storing the trace ID (correlation ID).
 	TraceIDKey contextKey = "trace_id"
+
+	// UserAgentKey is the context key for storing the User-Agent header of the request.
+	UserAgentKey contextKey = "user_agent"
+
+	// ClientCertificatesKey is the context key for storing the TLS client certificates of the request.
+	ClientCertificatesKey contextKey = "client_certificates"
+
+	// ClientIPKey is the context key for storing the resolved client IP address of the request.
+	ClientIPKey contextKey = "client_ip"
 )
 
 // ============================================================================
@@ -95,3 +107,61 @@ func EnsureTraceID(ctx context.Context) context.Context {
 
 	return ctx
 }
+
+// ============================================================================
+// Request Metadata Functions
+// ============================================================================
+
+// WithUserAgent adds the User-Agent header of the request to the context.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, UserAgentKey, userAgent)
+}
+
+// GetUserAgent retrieves the User-Agent header stored in the context, or an empty string.
+func GetUserAgent(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	userAgent, _ := ctx.Value(UserAgentKey).(string)
+	return userAgent
+}
+
+// WithClientCertificates adds the certificates the client presented during the TLS handshake to the
+// context, leaf certificate first.
+func WithClientCertificates(ctx context.Context, certificates []*x509.Certificate) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ClientCertificatesKey, certificates)
+}
+
+// GetClientCertificates retrieves the TLS client certificates stored in the context, or nil.
+func GetClientCertificates(ctx context.Context) []*x509.Certificate {
+	if ctx == nil {
+		return nil
+	}
+	certificates, _ := ctx.Value(ClientCertificatesKey).([]*x509.Certificate)
+	return certificates
+}
+
+// WithClientIP adds the client IP address of the request, resolved through the trusted proxies, to
+// the context.
+func WithClientIP(ctx context.Context, addr netip.Addr) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ClientIPKey, addr)
+}
+
+// GetClientIP retrieves the client IP address stored in the context. The returned address is invalid
+// when the client IP middleware did not run or the address could not be determined.
+func GetClientIP(ctx context.Context) netip.Addr {
+	if ctx == nil {
+		return netip.Addr{}
+	}
+	addr, _ := ctx.Value(ClientIPKey).(netip.Addr)
+	return addr
+}
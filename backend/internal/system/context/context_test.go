@@ -20,6 +20,9 @@ package context
 
 import (
 	"context"
+	"crypto/x509"
+	"math/big"
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -256,3 +259,29 @@ func (s *ContextTestSuite) TestGenerateUUID_MultipleGenerations() {
 		seen[uuid] = true
 	}
 }
+
+func (s *ContextTestSuite) TestUserAgent() {
+	s.Equal("", GetUserAgent(context.Background()))
+	s.Equal("", GetUserAgent(nil)) //nolint:staticcheck // Testing nil context handling
+
+	ctx := WithUserAgent(context.Background(), "Mozilla/5.0")
+	s.Equal("Mozilla/5.0", GetUserAgent(ctx))
+}
+
+func (s *ContextTestSuite) TestClientCertificates() {
+	s.Nil(GetClientCertificates(context.Background()))
+	s.Nil(GetClientCertificates(nil)) //nolint:staticcheck // Testing nil context handling
+
+	certificates := []*x509.Certificate{{SerialNumber: big.NewInt(1)}}
+	ctx := WithClientCertificates(context.Background(), certificates)
+	s.Equal(certificates, GetClientCertificates(ctx))
+}
+
+func (s *ContextTestSuite) TestClientIP() {
+	s.False(GetClientIP(context.Background()).IsValid())
+	s.False(GetClientIP(nil).IsValid()) //nolint:staticcheck // Testing nil context handling
+
+	addr := netip.MustParseAddr("192.0.2.10")
+	ctx := WithClientIP(context.Background(), addr)
+	s.Equal(addr, GetClientIP(ctx))
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cors
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// DefaultApplicationOriginsTTL is how long the application origin matcher is reused before the
+// registered origins are listed again.
+const DefaultApplicationOriginsTTL = 30 * time.Second
+
+// ApplicationOriginSource lists the origins registered by applications, either explicitly or derived
+// from their redirect URIs.
+type ApplicationOriginSource interface {
+	GetAllowedOrigins(ctx context.Context) ([]string, error)
+}
+
+// cachedAppMatcher pairs the application origin matcher with the time it stops being reused.
+type cachedAppMatcher struct {
+	matcher   *Matcher
+	expiresAt time.Time
+}
+
+// appOriginState stores the application origin source and the matcher built from its last listing.
+type appOriginState struct {
+	source ApplicationOriginSource
+	ttl    time.Duration
+	cache  atomic.Pointer[cachedAppMatcher]
+	mu     sync.Mutex
+	now    func() time.Time
+}
+
+// appOrigins is the process-wide application origin state.
+var appOrigins = appOriginState{now: time.Now}
+
+// InitializeApplicationOrigins installs the source of application origins and clears the cached
+// matcher. A non-positive ttl falls back to DefaultApplicationOriginsTTL.
+func InitializeApplicationOrigins(source ApplicationOriginSource, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultApplicationOriginsTTL
+	}
+	appOrigins.mu.Lock()
+	defer appOrigins.mu.Unlock()
+	appOrigins.source = source
+	appOrigins.ttl = ttl
+	appOrigins.cache.Store(nil)
+}
+
+// InvalidateApplicationOrigins drops the cached application origin matcher so the next request lists
+// the registered origins again. Called after an application's OAuth configuration changes.
+func InvalidateApplicationOrigins() {
+	appOrigins.cache.Store(nil)
+}
+
+// GetApplicationMatcher returns the matcher over the origins registered by applications. A nil matcher
+// means no application origins are allowed.
+func GetApplicationMatcher(ctx context.Context) *Matcher {
+	return appOrigins.resolve(ctx)
+}
+
+func (a *appOriginState) resolve(ctx context.Context) *Matcher {
+	if c := a.cache.Load(); c != nil && a.now().Before(c.expiresAt) {
+		return c.matcher
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if c := a.cache.Load(); c != nil && a.now().Before(c.expiresAt) {
+		return c.matcher
+	}
+	if a.source == nil {
+		return nil
+	}
+
+	origins, err := a.source.GetAllowedOrigins(ctx)
+	if err != nil {
+		// Keep serving the previous matcher, if any, rather than denying every application origin on a
+		// transient read failure. The listing is retried once the TTL elapses, not on every request.
+		corsLogger().Warn(ctx, "Failed to list application allowed origins", log.Error(err))
+		var previous *Matcher
+		if c := a.cache.Load(); c != nil {
+			previous = c.matcher
+		}
+		a.cache.Store(&cachedAppMatcher{matcher: previous, expiresAt: a.now().Add(a.ttl)})
+		return previous
+	}
+
+	rules := make([]originRule, 0, len(origins))
+	for _, origin := range origins {
+		canonical, err := canonicalize(origin)
+		if err != nil {
+			corsLogger().Debug(ctx, "Skipping invalid application allowed origin",
+				log.String("origin", origin), log.Error(err))
+			continue
+		}
+		rules = append(rules, literalRule{canonical: canonical})
+	}
+	m := newMatcher(rules)
+	a.cache.Store(&cachedAppMatcher{matcher: m, expiresAt: a.now().Add(a.ttl)})
+	return m
+}
+
+// ValidateApplicationOrigin checks that value is a concrete http(s) origin that an application may
+// register: scheme and host with an optional port, without a path, wildcard, or the "null" origin.
+func ValidateApplicationOrigin(value string) error {
+	if strings.Contains(value, "*") {
+		return ErrWildcardLiteral
+	}
+	parsed, err := ParseOrigin(value)
+	if err != nil {
+		return err
+	}
+	if parsed.IsNull {
+		return fmt.Errorf("%w: the null origin cannot be registered", ErrInvalidOrigin)
+	}
+	return nil
+}
+
+// OriginFromURL returns the origin (scheme, host, and port) of an http(s) URL. ok is false for other
+// schemes, such as the custom schemes used by native application redirect URIs.
+func OriginFromURL(rawURL string) (origin string, ok bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case schemeHTTP, schemeHTTPS:
+		return strings.ToLower(u.Scheme) + "://" + u.Host, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingSource serves application origins from a function and counts the listings.
+type countingSource struct {
+	calls int
+	list  func() ([]string, error)
+}
+
+func (s *countingSource) GetAllowedOrigins(_ context.Context) ([]string, error) {
+	s.calls++
+	return s.list()
+}
+
+// useAppOrigins installs source with a controllable clock and restores the defaults after the test.
+func useAppOrigins(t *testing.T, source ApplicationOriginSource) *time.Time {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	InitializeApplicationOrigins(source, time.Minute)
+	appOrigins.now = func() time.Time { return now }
+	t.Cleanup(func() {
+		InitializeApplicationOrigins(nil, 0)
+		appOrigins.now = time.Now
+	})
+	return &now
+}
+
+func TestGetApplicationMatcher_NoSourceReturnsNil(t *testing.T) {
+	useAppOrigins(t, nil)
+	assert.Nil(t, GetApplicationMatcher(context.Background()))
+}
+
+func TestGetApplicationMatcher_MatchesCanonicalOriginsAndSkipsInvalid(t *testing.T) {
+	source := &countingSource{list: func() ([]string, error) {
+		return []string{"HTTPS://App.Example.com", "not a url", "myapp://callback"}, nil
+	}}
+	useAppOrigins(t, source)
+
+	m := GetApplicationMatcher(context.Background())
+	assert.True(t, mustMatch(t, m, "https://app.example.com"))
+	assert.False(t, mustMatch(t, m, "https://other.example.com"))
+	assert.Equal(t, 1, m.Size())
+}
+
+func TestGetApplicationMatcher_CachesUntilTTLOrInvalidation(t *testing.T) {
+	origins := []string{"https://a.example.com"}
+	source := &countingSource{list: func() ([]string, error) { return origins, nil }}
+	now := useAppOrigins(t, source)
+	ctx := context.Background()
+
+	assert.True(t, mustMatch(t, GetApplicationMatcher(ctx), "https://a.example.com"))
+	origins = []string{"https://b.example.com"}
+	assert.True(t, mustMatch(t, GetApplicationMatcher(ctx), "https://a.example.com"))
+	assert.Equal(t, 1, source.calls)
+
+	*now = now.Add(2 * time.Minute)
+	assert.True(t, mustMatch(t, GetApplicationMatcher(ctx), "https://b.example.com"))
+	assert.Equal(t, 2, source.calls)
+
+	origins = []string{"https://c.example.com"}
+	InvalidateApplicationOrigins()
+	assert.True(t, mustMatch(t, GetApplicationMatcher(ctx), "https://c.example.com"))
+	assert.Equal(t, 3, source.calls)
+}
+
+func TestGetApplicationMatcher_ListingErrorKeepsPreviousMatcher(t *testing.T) {
+	var listErr error
+	source := &countingSource{list: func() ([]string, error) {
+		return []string{"https://a.example.com"}, listErr
+	}}
+	now := useAppOrigins(t, source)
+	ctx := context.Background()
+
+	assert.True(t, mustMatch(t, GetApplicationMatcher(ctx), "https://a.example.com"))
+
+	listErr = errors.New("store unavailable")
+	*now = now.Add(2 * time.Minute)
+	assert.True(t, mustMatch(t, GetApplicationMatcher(ctx), "https://a.example.com"))
+	assert.True(t, mustMatch(t, GetApplicationMatcher(ctx), "https://a.example.com"))
+	assert.Equal(t, 2, source.calls)
+}
+
+func TestValidateApplicationOrigin(t *testing.T) {
+	assert.NoError(t, ValidateApplicationOrigin("https://app.example.com"))
+	assert.NoError(t, ValidateApplicationOrigin("http://localhost:3000"))
+	assert.ErrorIs(t, ValidateApplicationOrigin("https://*.example.com"), ErrWildcardLiteral)
+	assert.ErrorIs(t, ValidateApplicationOrigin("null"), ErrInvalidOrigin)
+	assert.ErrorIs(t, ValidateApplicationOrigin("https://app.example.com/callback"), ErrInvalidOrigin)
+	assert.ErrorIs(t, ValidateApplicationOrigin("myapp://callback"), ErrInvalidOrigin)
+}
+
+func TestOriginFromURL(t *testing.T) {
+	origin, ok := OriginFromURL("HTTPS://app.example.com:8443/callback?x=1")
+	assert.True(t, ok)
+	assert.Equal(t, "https://app.example.com:8443", origin)
+
+	_, ok = OriginFromURL("com.example.app://callback")
+	assert.False(t, ok)
+	_, ok = OriginFromURL("/relative/path")
+	assert.False(t, ok)
+}
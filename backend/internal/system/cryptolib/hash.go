@@ -26,10 +26,13 @@ import (
 	"crypto/subtle"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"hash"
 
 	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
 )
 
 const (
@@ -47,15 +50,23 @@ const (
 	PBKDF2 CredAlgorithm = "PBKDF2"
 	// ARGON2ID represents the Argon2id key derivation function.
 	ARGON2ID CredAlgorithm = "ARGON2ID"
+	// SCRYPT represents the scrypt key derivation function.
+	SCRYPT CredAlgorithm = "SCRYPT"
+	// BCRYPT represents the bcrypt password hashing function. The salt and cost are encoded in the
+	// hash itself, so hashes migrated from other systems can be verified without extra parameters.
+	BCRYPT CredAlgorithm = "BCRYPT"
 )
 
 // CredParameters holds the parameters for credential hashing algorithms.
+// Cost is the bcrypt cost factor or the scrypt CPU/memory cost (N); BlockSize is the scrypt block size (r).
 type CredParameters struct {
 	Iterations  int
 	Parallelism int
 	Memory      int
 	KeySize     int
 	Salt        string
+	Cost        int
+	BlockSize   int
 }
 
 // Credential represents the output of a credential hash operation.
@@ -87,12 +98,23 @@ type HashConfig struct {
 	SaltSize    int
 	Iterations  int
 	KeySize     int
+	Cost        int
+	BlockSize   int
 }
 
 // HashServiceInterface defines the interface for credential hashing services.
 type HashServiceInterface interface {
 	Generate(credentialValue []byte) (Credential, error)
 	Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error)
+	NeedsRehash(referenceCredential Credential) bool
+}
+
+// hashService generates credentials with the configured algorithm and verifies credentials hashed
+// with any supported algorithm, so that changing the configured algorithm does not invalidate
+// credentials hashed before the change.
+type hashService struct {
+	cfg      HashConfig
+	generate func(credentialValue []byte) (Credential, error)
 }
 
 // Initialize returns a HashServiceInterface configured according to cfg.
@@ -101,6 +123,55 @@ func Initialize(cfg HashConfig) (HashServiceInterface, error) {
 	return newHashService(cfg)
 }
 
+// Generate hashes the credential with the configured algorithm and parameters.
+func (h *hashService) Generate(credentialValue []byte) (Credential, error) {
+	return h.generate(credentialValue)
+}
+
+// Verify checks the credential against a reference hashed with any supported algorithm. The
+// algorithm parameters are taken from the reference credential.
+func (h *hashService) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	switch referenceCredential.Algorithm {
+	case SHA256:
+		return (&sha256HashProvider{}).Verify(credentialValueToVerify, referenceCredential)
+	case PBKDF2:
+		return (&pbkdf2HashProvider{}).Verify(credentialValueToVerify, referenceCredential)
+	case ARGON2ID:
+		return (&argon2idHashProvider{}).Verify(credentialValueToVerify, referenceCredential)
+	case SCRYPT:
+		return (&scryptHashProvider{}).Verify(credentialValueToVerify, referenceCredential)
+	case BCRYPT:
+		return (&bcryptHashProvider{}).Verify(credentialValueToVerify, referenceCredential)
+	default:
+		return false, fmt.Errorf("unsupported hash algorithm: %s", referenceCredential.Algorithm)
+	}
+}
+
+// NeedsRehash reports whether the reference credential was hashed with an algorithm or parameters
+// other than the configured ones, and should be hashed again once the plain value is known.
+func (h *hashService) NeedsRehash(referenceCredential Credential) bool {
+	if referenceCredential.Algorithm != h.cfg.Algorithm {
+		return true
+	}
+	params := referenceCredential.Parameters
+	switch h.cfg.Algorithm {
+	case PBKDF2:
+		return params.Iterations != h.cfg.Iterations || params.KeySize != h.cfg.KeySize
+	case ARGON2ID:
+		return params.Iterations != h.cfg.Iterations || params.KeySize != h.cfg.KeySize ||
+			params.Memory != h.cfg.Memory || params.Parallelism != h.cfg.Parallelism
+	case SCRYPT:
+		return params.Cost != h.cfg.Cost || params.BlockSize != h.cfg.BlockSize ||
+			params.Parallelism != h.cfg.Parallelism || params.KeySize != h.cfg.KeySize
+	case BCRYPT:
+		// The cost is read from the hash so that imported hashes without parameters are compared too.
+		cost, err := bcrypt.Cost([]byte(referenceCredential.Hash))
+		return err != nil || cost != h.cfg.Cost
+	default:
+		return false
+	}
+}
+
 type sha256HashProvider struct {
 	SaltSize int
 }
@@ -119,13 +190,34 @@ type argon2idHashProvider struct {
 	KeySize     int
 }
 
+type scryptHashProvider struct {
+	SaltSize    int
+	Cost        int
+	BlockSize   int
+	Parallelism int
+	KeySize     int
+}
+
+type bcryptHashProvider struct {
+	Cost int
+}
+
 func newHashService(cfg HashConfig) (HashServiceInterface, error) {
+	generate, err := newGenerator(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &hashService{cfg: cfg, generate: generate}, nil
+}
+
+// newGenerator returns the credential hash function of the configured algorithm.
+func newGenerator(cfg HashConfig) (func(credentialValue []byte) (Credential, error), error) {
 	switch cfg.Algorithm {
 	case SHA256:
 		if err := validatePositiveInt(cfg.SaltSize, "salt size"); err != nil {
 			return nil, err
 		}
-		return newSHA256Provider(cfg.SaltSize), nil
+		return newSHA256Provider(cfg.SaltSize).Generate, nil
 	case PBKDF2:
 		if err := validatePositiveInt(cfg.SaltSize, "salt size"); err != nil {
 			return nil, err
@@ -136,7 +228,7 @@ func newHashService(cfg HashConfig) (HashServiceInterface, error) {
 		if err := validatePositiveInt(cfg.KeySize, "key size"); err != nil {
 			return nil, err
 		}
-		return newPBKDF2Provider(cfg.SaltSize, cfg.Iterations, cfg.KeySize), nil
+		return newPBKDF2Provider(cfg.SaltSize, cfg.Iterations, cfg.KeySize).Generate, nil
 	case ARGON2ID:
 		if err := validatePositiveInt(cfg.SaltSize, "salt size"); err != nil {
 			return nil, err
@@ -153,7 +245,21 @@ func newHashService(cfg HashConfig) (HashServiceInterface, error) {
 		if err := validatePositiveIntWithMax(cfg.KeySize, maxUint32, "key size"); err != nil {
 			return nil, err
 		}
-		return newArgon2idProvider(cfg.SaltSize, cfg.Memory, cfg.Iterations, cfg.Parallelism, cfg.KeySize), nil
+		return newArgon2idProvider(cfg.SaltSize, cfg.Memory, cfg.Iterations, cfg.Parallelism, cfg.KeySize).Generate,
+			nil
+	case SCRYPT:
+		if err := validatePositiveInt(cfg.SaltSize, "salt size"); err != nil {
+			return nil, err
+		}
+		if err := validateScryptParameters(cfg.Cost, cfg.BlockSize, cfg.Parallelism, cfg.KeySize); err != nil {
+			return nil, err
+		}
+		return newScryptProvider(cfg.SaltSize, cfg.Cost, cfg.BlockSize, cfg.Parallelism, cfg.KeySize).Generate, nil
+	case BCRYPT:
+		if cfg.Cost < bcrypt.MinCost || cfg.Cost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+		return newBcryptProvider(cfg.Cost).Generate, nil
 	default:
 		return nil, fmt.Errorf("unsupported hash algorithm: %s", cfg.Algorithm)
 	}
@@ -330,6 +436,94 @@ func (a *argon2idHashProvider) Verify(credentialValueToVerify []byte, referenceC
 	return subtle.ConstantTimeCompare(h, referenceHash) == 1, nil
 }
 
+func newScryptProvider(saltSize, cost, blockSize, parallelism, keySize int) *scryptHashProvider {
+	return &scryptHashProvider{
+		SaltSize:    saltSize,
+		Cost:        cost,
+		BlockSize:   blockSize,
+		Parallelism: parallelism,
+		KeySize:     keySize,
+	}
+}
+
+func (a *scryptHashProvider) Generate(credentialValue []byte) (Credential, error) {
+	credSalt, err := generateSalt(a.SaltSize)
+	if err != nil {
+		return Credential{}, err
+	}
+	h, err := scrypt.Key(credentialValue, credSalt, a.Cost, a.BlockSize, a.Parallelism, a.KeySize)
+	if err != nil {
+		return Credential{}, err
+	}
+	return Credential{
+		Algorithm: SCRYPT,
+		Hash:      hex.EncodeToString(h),
+		Parameters: CredParameters{
+			Cost:        a.Cost,
+			BlockSize:   a.BlockSize,
+			Parallelism: a.Parallelism,
+			KeySize:     a.KeySize,
+			Salt:        hex.EncodeToString(credSalt),
+		},
+	}, nil
+}
+
+func (a *scryptHashProvider) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if err := validateCredentialAlgorithm(referenceCredential, SCRYPT); err != nil {
+		return false, err
+	}
+	params := referenceCredential.Parameters
+	if err := validateScryptParameters(params.Cost, params.BlockSize, params.Parallelism, params.KeySize); err != nil {
+		return false, err
+	}
+	saltBytes, err := decodeSalt(params.Salt)
+	if err != nil {
+		return false, err
+	}
+	h, err := scrypt.Key(credentialValueToVerify, saltBytes, params.Cost, params.BlockSize, params.Parallelism,
+		params.KeySize)
+	if err != nil {
+		return false, err
+	}
+	referenceHash, err := hex.DecodeString(referenceCredential.Hash)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(h, referenceHash) == 1, nil
+}
+
+func newBcryptProvider(cost int) *bcryptHashProvider {
+	return &bcryptHashProvider{Cost: cost}
+}
+
+func (a *bcryptHashProvider) Generate(credentialValue []byte) (Credential, error) {
+	h, err := bcrypt.GenerateFromPassword(credentialValue, a.Cost)
+	if err != nil {
+		return Credential{}, err
+	}
+	return Credential{
+		Algorithm: BCRYPT,
+		Hash:      string(h),
+		Parameters: CredParameters{
+			Cost: a.Cost,
+		},
+	}, nil
+}
+
+func (a *bcryptHashProvider) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if err := validateCredentialAlgorithm(referenceCredential, BCRYPT); err != nil {
+		return false, err
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(referenceCredential.Hash), credentialValueToVerify)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, err
+}
+
 // GenerateThumbprint generates a SHA-256 thumbprint for the given data.
 func GenerateThumbprint(data []byte) string {
 	h := sha256.Sum256(data)
@@ -394,6 +588,20 @@ func validateCredentialAlgorithm(referenceCredential Credential, expected CredAl
 	return nil
 }
 
+// validateScryptParameters checks the scrypt parameters; the cost must be a power of two greater than one.
+func validateScryptParameters(cost, blockSize, parallelism, keySize int) error {
+	if cost <= 1 || cost&(cost-1) != 0 {
+		return fmt.Errorf("cost must be a power of two greater than 1")
+	}
+	if err := validatePositiveInt(blockSize, "block size"); err != nil {
+		return err
+	}
+	if err := validatePositiveInt(parallelism, "parallelism"); err != nil {
+		return err
+	}
+	return validatePositiveInt(keySize, "key size")
+}
+
 func validatePositiveInt(value int, name string) error {
 	_, err := requirePositiveInt(value, name)
 	return err
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 )
@@ -1289,3 +1290,128 @@ func (suite *HashUtilsTestSuite) TestGetHash() {
 	_, err := GetHash("INVALID")
 	suite.Error(err)
 }
+
+func (suite *HashServiceTestSuite) TestVerify_CredentialHashedWithAnotherAlgorithm() {
+	sha256Service, err := Initialize(HashConfig{Algorithm: SHA256, SaltSize: defaultSaltSize})
+	require.NoError(suite.T(), err)
+	credential, err := sha256Service.Generate([]byte("client-secret"))
+	require.NoError(suite.T(), err)
+
+	pbkdf2Service, err := Initialize(HashConfig{
+		Algorithm:  PBKDF2,
+		SaltSize:   defaultSaltSize,
+		Iterations: 1000,
+		KeySize:    defaultPBKDF2KeySize,
+	})
+	require.NoError(suite.T(), err)
+
+	ok, err := pbkdf2Service.Verify([]byte("client-secret"), credential)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+	ok, err = pbkdf2Service.Verify([]byte("wrong"), credential)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+
+	_, err = pbkdf2Service.Verify([]byte("client-secret"), Credential{Algorithm: "BCRYPT"})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *HashServiceTestSuite) TestNeedsRehash() {
+	cfg := HashConfig{Algorithm: ARGON2ID, SaltSize: defaultSaltSize, Memory: 1024, Iterations: 1,
+		Parallelism: 1, KeySize: 32}
+	hashService, err := Initialize(cfg)
+	require.NoError(suite.T(), err)
+
+	current, err := hashService.Generate([]byte("client-secret"))
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), hashService.NeedsRehash(current))
+
+	ok, err := hashService.Verify([]byte("client-secret"), current)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+
+	weaker := current
+	weaker.Parameters.Memory = 512
+	assert.True(suite.T(), hashService.NeedsRehash(weaker))
+	assert.True(suite.T(), hashService.NeedsRehash(Credential{Algorithm: SHA256}))
+}
+
+func (suite *HashServiceTestSuite) TestScryptHashAndVerify() {
+	hashService, err := Initialize(HashConfig{Algorithm: SCRYPT, SaltSize: defaultSaltSize, Cost: 1024,
+		BlockSize: 8, Parallelism: 1, KeySize: 32})
+	require.NoError(suite.T(), err)
+
+	credential, err := hashService.Generate([]byte("password"))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), SCRYPT, credential.Algorithm)
+	assert.Equal(suite.T(), 1024, credential.Parameters.Cost)
+	assert.Equal(suite.T(), 8, credential.Parameters.BlockSize)
+	assert.False(suite.T(), hashService.NeedsRehash(credential))
+
+	ok, err := hashService.Verify([]byte("password"), credential)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+	ok, err = hashService.Verify([]byte("wrong"), credential)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+
+	weaker := credential
+	weaker.Parameters.Cost = 512
+	assert.True(suite.T(), hashService.NeedsRehash(weaker))
+}
+
+func (suite *HashServiceTestSuite) TestScrypt_InvalidParameters() {
+	testCases := []HashConfig{
+		{Algorithm: SCRYPT, SaltSize: defaultSaltSize, Cost: 1000, BlockSize: 8, Parallelism: 1, KeySize: 32},
+		{Algorithm: SCRYPT, SaltSize: defaultSaltSize, Cost: 1024, BlockSize: 0, Parallelism: 1, KeySize: 32},
+		{Algorithm: SCRYPT, SaltSize: defaultSaltSize, Cost: 1024, BlockSize: 8, Parallelism: 0, KeySize: 32},
+		{Algorithm: SCRYPT, SaltSize: 0, Cost: 1024, BlockSize: 8, Parallelism: 1, KeySize: 32},
+	}
+	for _, cfg := range testCases {
+		_, err := Initialize(cfg)
+		assert.Error(suite.T(), err)
+	}
+
+	_, err := (&scryptHashProvider{}).Verify([]byte("password"), Credential{Algorithm: SCRYPT})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *HashServiceTestSuite) TestBcryptHashAndVerify() {
+	hashService, err := Initialize(HashConfig{Algorithm: BCRYPT, Cost: bcrypt.MinCost})
+	require.NoError(suite.T(), err)
+
+	credential, err := hashService.Generate([]byte("password"))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), BCRYPT, credential.Algorithm)
+	assert.False(suite.T(), hashService.NeedsRehash(credential))
+
+	ok, err := hashService.Verify([]byte("password"), credential)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+	ok, err = hashService.Verify([]byte("wrong"), credential)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+
+	_, err = Initialize(HashConfig{Algorithm: BCRYPT, Cost: bcrypt.MaxCost + 1})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *HashServiceTestSuite) TestVerify_MigratedBcryptHash() {
+	// A hash exported from another system carries its salt and cost and has no stored parameters.
+	legacyHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(suite.T(), err)
+	legacy := Credential{Algorithm: BCRYPT, Hash: string(legacyHash)}
+
+	hashService, err := Initialize(HashConfig{Algorithm: PBKDF2, SaltSize: defaultSaltSize,
+		Iterations: 1000, KeySize: defaultPBKDF2KeySize})
+	require.NoError(suite.T(), err)
+
+	ok, err := hashService.Verify([]byte("password"), legacy)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+	assert.True(suite.T(), hashService.NeedsRehash(legacy))
+
+	bcryptService, err := Initialize(HashConfig{Algorithm: BCRYPT, Cost: bcrypt.MinCost + 1})
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), bcryptService.NeedsRehash(legacy))
+}
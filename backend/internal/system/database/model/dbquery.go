@@ -18,6 +18,11 @@
 
 package model
 
+import (
+	"strconv"
+	"strings"
+)
+
 // DBQueryInterface defines the interface for database queries.
 type DBQueryInterface interface {
 	GetID() string
@@ -55,3 +60,44 @@ func (d *DBQuery) GetQuery(dbType string) string {
 	// Fall back to the default query
 	return d.Query
 }
+
+// DBBatchInsertQuery describes a multi-row INSERT whose VALUES clause is generated for the number
+// of rows being written.
+type DBBatchInsertQuery struct {
+	// ID is the unique identifier for the query.
+	ID string
+	// Prefix is the statement up to, but excluding, the VALUES keyword.
+	Prefix string
+	// Suffix is appended after the generated VALUES tuples, e.g. an ON CONFLICT clause.
+	Suffix string
+	// Columns is the number of bind parameters in each row.
+	Columns int
+}
+
+// Expand builds the single statement that inserts rowCount rows. Placeholders are numbered
+// sequentially across rows.
+func (q *DBBatchInsertQuery) Expand(rowCount int) DBQuery {
+	var sb strings.Builder
+	sb.WriteString(q.Prefix)
+	sb.WriteString(" VALUES ")
+	param := 1
+	for r := 0; r < rowCount; r++ {
+		if r > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('(')
+		for c := 0; c < q.Columns; c++ {
+			if c > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("$" + strconv.Itoa(param))
+			param++
+		}
+		sb.WriteByte(')')
+	}
+	if q.Suffix != "" {
+		sb.WriteByte(' ')
+		sb.WriteString(q.Suffix)
+	}
+	return DBQuery{ID: q.ID, Query: sb.String()}
+}
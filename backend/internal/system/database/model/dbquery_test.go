@@ -114,3 +114,20 @@ func (suite *DBQueryTestSuite) TestGetQuery_UnknownDBType() {
 func (suite *DBQueryTestSuite) TestDBQuery_ImplementsInterface() {
 	var _ DBQueryInterface = (*DBQuery)(nil)
 }
+
+func (suite *DBQueryTestSuite) TestBatchInsertQuery_Expand() {
+	query := DBBatchInsertQuery{
+		ID:      "TEST-BATCH",
+		Prefix:  `INSERT INTO "T" (A, B)`,
+		Suffix:  "ON CONFLICT (A) DO NOTHING",
+		Columns: 2,
+	}
+
+	expanded := query.Expand(3)
+	suite.Equal("TEST-BATCH", expanded.GetID())
+	suite.Equal(`INSERT INTO "T" (A, B) VALUES ($1, $2), ($3, $4), ($5, $6) ON CONFLICT (A) DO NOTHING`,
+		expanded.GetQuery("postgres"))
+
+	single := (&DBBatchInsertQuery{Prefix: `INSERT INTO "T" (A, B)`, Columns: 2}).Expand(1)
+	suite.Equal(`INSERT INTO "T" (A, B) VALUES ($1, $2)`, single.GetQuery("sqlite"))
+}
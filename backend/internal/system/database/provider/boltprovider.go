@@ -0,0 +1,329 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// DataSourceTypeBolt is the type identifier for an embedded bbolt key-value data source.
+const DataSourceTypeBolt = "bolt"
+
+const (
+	// boltBucket is the bucket that holds every runtime entry.
+	boltBucket = "runtime"
+	// boltOpenTimeout bounds the wait for the file lock held by another process.
+	boltOpenTimeout = 5 * time.Second
+	// boltSweepInterval is how often expired entries are removed from the file.
+	boltSweepInterval = time.Minute
+	// boltExpiryLength is the length of the expiry time stored in front of every value.
+	boltExpiryLength = 8
+)
+
+// IsKeyValueRuntimeType reports whether a runtime datasource type is a key-value store rather than a SQL
+// database.
+func IsKeyValueRuntimeType(dataSourceType string) bool {
+	return dataSourceType == DataSourceTypeRedis || dataSourceType == DataSourceTypeBolt
+}
+
+// BoltProviderInterface provides the embedded key-value store used for runtime state when the runtime
+// datasource is "bolt". Entries with a TTL are hidden once expired and removed in the background.
+type BoltProviderInterface interface {
+	// Get returns the value of a key, or nil if the key does not exist.
+	Get(key string) ([]byte, error)
+	// Set stores a value. A zero TTL keeps the entry until it is deleted.
+	Set(key string, value []byte, ttl time.Duration) error
+	// SetNX stores a value only if the key does not exist, and reports whether it was stored.
+	SetNX(key string, value []byte, ttl time.Duration) (bool, error)
+	// GetDel returns the value of a key and deletes it, or returns nil if the key does not exist.
+	GetDel(key string) ([]byte, error)
+	// Del deletes the given keys. Keys that do not exist are ignored.
+	Del(keys ...string) error
+	// Expire sets a new TTL on a key and reports whether the key exists.
+	Expire(key string, ttl time.Duration) (bool, error)
+	// Modify atomically replaces the value of a key with the value returned by fn, keeping its TTL. fn
+	// receives nil if the key does not exist. The entry is left unchanged when fn returns a nil value or
+	// an error, and the error is returned.
+	Modify(key string, fn func(value []byte) ([]byte, error)) error
+}
+
+// BoltProviderCloser is a separate interface for closing the provider.
+// Only the lifecycle manager should use this interface.
+type BoltProviderCloser interface {
+	Close() error
+}
+
+// boltProvider is the implementation of BoltProviderInterface.
+type boltProvider struct {
+	db        *bolt.DB
+	now       func() time.Time
+	stopSweep chan struct{}
+	sweepDone chan struct{}
+	closeOnce sync.Once
+	logger    *log.Logger
+}
+
+var (
+	boltInstance *boltProvider
+	boltOnce     sync.Once
+)
+
+// initBoltProvider initializes the singleton bolt provider.
+func initBoltProvider() {
+	boltOnce.Do(func() {
+		cfg := config.GetServerRuntime().Config.Database.Runtime
+		// This is a no-op when runtime.type is not "bolt".
+		if cfg.Type != DataSourceTypeBolt {
+			return
+		}
+
+		// The bolt store is opened at startup, outside any request.
+		ctx := context.Background()
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BoltProvider"))
+
+		dbPath := path.Join(config.GetServerRuntime().ServerHome, cfg.Bolt.Path)
+		p, err := NewBoltProvider(dbPath)
+		if err != nil {
+			logger.Fatal(ctx, "Failed to open bolt runtime store", log.Error(err))
+		}
+
+		logger.Info(ctx, "Opened bolt runtime store", log.String("path", dbPath))
+		boltInstance = p.(*boltProvider)
+	})
+}
+
+// GetBoltProvider returns the singleton bolt provider.
+func GetBoltProvider() BoltProviderInterface {
+	initBoltProvider()
+	return boltInstance
+}
+
+// NewBoltProvider opens the bolt store at the given file path and starts removing expired entries. The
+// server uses the singleton returned by GetBoltProvider; this is used to open a store of its own, such
+// as in tests. The returned provider also implements BoltProviderCloser.
+func NewBoltProvider(dbPath string) (BoltProviderInterface, error) {
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create bolt store directory: %w", err)
+	}
+	db, err := bolt.Open(dbPath, 0o600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt store: %w", err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(boltBucket))
+		return err
+	}); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to create bolt bucket: %w", err), db.Close())
+	}
+
+	p := &boltProvider{
+		db:        db,
+		now:       time.Now,
+		stopSweep: make(chan struct{}),
+		sweepDone: make(chan struct{}),
+		logger:    log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BoltProvider")),
+	}
+	go p.sweepLoop()
+	return p, nil
+}
+
+// Get returns the value of a key, or nil if the key does not exist.
+func (p *boltProvider) Get(key string) ([]byte, error) {
+	var value []byte
+	err := p.db.View(func(tx *bolt.Tx) error {
+		value = p.live(tx.Bucket([]byte(boltBucket)).Get([]byte(key)))
+		return nil
+	})
+	return value, err
+}
+
+// Set stores a value. A zero TTL keeps the entry until it is deleted.
+func (p *boltProvider) Set(key string, value []byte, ttl time.Duration) error {
+	return p.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(boltBucket)).Put([]byte(key), p.encode(value, p.expiry(ttl)))
+	})
+}
+
+// SetNX stores a value only if the key does not exist, and reports whether it was stored.
+func (p *boltProvider) SetNX(key string, value []byte, ttl time.Duration) (bool, error) {
+	stored := false
+	err := p.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(boltBucket))
+		if p.live(b.Get([]byte(key))) != nil {
+			return nil
+		}
+		stored = true
+		return b.Put([]byte(key), p.encode(value, p.expiry(ttl)))
+	})
+	return stored, err
+}
+
+// GetDel returns the value of a key and deletes it, or returns nil if the key does not exist.
+func (p *boltProvider) GetDel(key string) ([]byte, error) {
+	var value []byte
+	err := p.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(boltBucket))
+		value = p.live(b.Get([]byte(key)))
+		return b.Delete([]byte(key))
+	})
+	return value, err
+}
+
+// Del deletes the given keys. Keys that do not exist are ignored.
+func (p *boltProvider) Del(keys ...string) error {
+	return p.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(boltBucket))
+		for _, key := range keys {
+			if err := b.Delete([]byte(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Expire sets a new TTL on a key and reports whether the key exists.
+func (p *boltProvider) Expire(key string, ttl time.Duration) (bool, error) {
+	exists := false
+	err := p.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(boltBucket))
+		value := p.live(b.Get([]byte(key)))
+		if value == nil {
+			return nil
+		}
+		exists = true
+		return b.Put([]byte(key), p.encode(value, p.expiry(ttl)))
+	})
+	return exists, err
+}
+
+// Modify atomically replaces the value of a key with the value returned by fn, keeping its TTL.
+func (p *boltProvider) Modify(key string, fn func(value []byte) ([]byte, error)) error {
+	return p.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(boltBucket))
+		raw := b.Get([]byte(key))
+		value := p.live(raw)
+		updated, err := fn(value)
+		if err != nil || updated == nil {
+			return err
+		}
+		var expiry uint64
+		if value != nil {
+			expiry = binary.BigEndian.Uint64(raw[:boltExpiryLength])
+		}
+		return b.Put([]byte(key), p.encode(updated, expiry))
+	})
+}
+
+// Close stops removing expired entries and closes the bolt store. Called by the lifecycle manager on
+// shutdown.
+func (p *boltProvider) Close() error {
+	var err error
+	p.closeOnce.Do(func() {
+		close(p.stopSweep)
+		<-p.sweepDone
+		err = p.db.Close()
+	})
+	return err
+}
+
+// expiry returns the expiry time of an entry stored now with the given TTL, in Unix nanoseconds. Zero
+// means the entry does not expire.
+func (p *boltProvider) expiry(ttl time.Duration) uint64 {
+	if ttl <= 0 {
+		return 0
+	}
+	return uint64(p.now().Add(ttl).UnixNano()) //nolint:gosec // Unix times after 1970 are positive.
+}
+
+// encode prefixes a value with its expiry time.
+func (p *boltProvider) encode(value []byte, expiry uint64) []byte {
+	encoded := make([]byte, boltExpiryLength+len(value))
+	binary.BigEndian.PutUint64(encoded, expiry)
+	copy(encoded[boltExpiryLength:], value)
+	return encoded
+}
+
+// live returns a copy of the value of a stored entry, or nil if there is no entry or it has expired.
+// The copy keeps the value valid after the transaction ends.
+func (p *boltProvider) live(raw []byte) []byte {
+	if len(raw) < boltExpiryLength || p.expired(raw) {
+		return nil
+	}
+	return append([]byte{}, raw[boltExpiryLength:]...)
+}
+
+// expired reports whether a stored entry has expired.
+func (p *boltProvider) expired(raw []byte) bool {
+	expiry := binary.BigEndian.Uint64(raw[:boltExpiryLength])
+	return expiry != 0 && expiry <= uint64(p.now().UnixNano()) //nolint:gosec // See expiry.
+}
+
+// sweepLoop removes expired entries periodically until the provider is closed.
+func (p *boltProvider) sweepLoop() {
+	defer close(p.sweepDone)
+	ticker := time.NewTicker(boltSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stopSweep:
+			return
+		case <-ticker.C:
+			if err := p.sweep(); err != nil {
+				// Sweeping runs in the background, outside any request.
+				p.logger.Error(context.Background(), "Failed to remove expired bolt entries", log.Error(err))
+			}
+		}
+	}
+}
+
+// sweep removes the expired entries.
+func (p *boltProvider) sweep() error {
+	return p.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(boltBucket))
+		var expiredKeys [][]byte
+		if err := b.ForEach(func(k, v []byte) error {
+			if len(v) >= boltExpiryLength && p.expired(v) {
+				expiredKeys = append(expiredKeys, append([]byte{}, k...))
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range expiredKeys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package provider
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	bolt "go.etcd.io/bbolt"
+)
+
+type BoltProviderTestSuite struct {
+	suite.Suite
+	provider *boltProvider
+	now      time.Time
+}
+
+func TestBoltProviderTestSuite(t *testing.T) {
+	suite.Run(t, new(BoltProviderTestSuite))
+}
+
+func (suite *BoltProviderTestSuite) SetupTest() {
+	p, err := NewBoltProvider(filepath.Join(suite.T().TempDir(), "nested", "runtime.bolt"))
+	suite.Require().NoError(err)
+	suite.provider = p.(*boltProvider)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.provider.now = func() time.Time { return suite.now }
+}
+
+func (suite *BoltProviderTestSuite) TearDownTest() {
+	suite.NoError(suite.provider.Close())
+}
+
+func (suite *BoltProviderTestSuite) TestSetAndGet() {
+	suite.Require().NoError(suite.provider.Set("k", []byte("v"), 0))
+
+	value, err := suite.provider.Get("k")
+	suite.NoError(err)
+	suite.Equal([]byte("v"), value)
+
+	value, err = suite.provider.Get("missing")
+	suite.NoError(err)
+	suite.Nil(value)
+}
+
+func (suite *BoltProviderTestSuite) TestExpiredEntryIsHidden() {
+	suite.Require().NoError(suite.provider.Set("k", []byte("v"), time.Minute))
+	suite.now = suite.now.Add(time.Minute)
+
+	value, err := suite.provider.Get("k")
+	suite.NoError(err)
+	suite.Nil(value)
+
+	exists, err := suite.provider.Expire("k", time.Minute)
+	suite.NoError(err)
+	suite.False(exists)
+}
+
+func (suite *BoltProviderTestSuite) TestSetNX() {
+	stored, err := suite.provider.SetNX("k", []byte("first"), time.Minute)
+	suite.NoError(err)
+	suite.True(stored)
+
+	stored, err = suite.provider.SetNX("k", []byte("second"), time.Minute)
+	suite.NoError(err)
+	suite.False(stored)
+
+	// An expired entry no longer blocks the key.
+	suite.now = suite.now.Add(time.Minute)
+	stored, err = suite.provider.SetNX("k", []byte("third"), time.Minute)
+	suite.NoError(err)
+	suite.True(stored)
+
+	value, _ := suite.provider.Get("k")
+	suite.Equal([]byte("third"), value)
+}
+
+func (suite *BoltProviderTestSuite) TestGetDel() {
+	suite.Require().NoError(suite.provider.Set("k", []byte("v"), time.Minute))
+
+	value, err := suite.provider.GetDel("k")
+	suite.NoError(err)
+	suite.Equal([]byte("v"), value)
+
+	value, err = suite.provider.GetDel("k")
+	suite.NoError(err)
+	suite.Nil(value)
+}
+
+func (suite *BoltProviderTestSuite) TestDel() {
+	suite.Require().NoError(suite.provider.Set("a", []byte("1"), 0))
+	suite.Require().NoError(suite.provider.Set("b", []byte("2"), 0))
+
+	suite.NoError(suite.provider.Del("a", "b", "missing"))
+
+	value, _ := suite.provider.Get("a")
+	suite.Nil(value)
+	value, _ = suite.provider.Get("b")
+	suite.Nil(value)
+}
+
+func (suite *BoltProviderTestSuite) TestExpire() {
+	suite.Require().NoError(suite.provider.Set("k", []byte("v"), time.Minute))
+
+	exists, err := suite.provider.Expire("k", time.Hour)
+	suite.NoError(err)
+	suite.True(exists)
+
+	suite.now = suite.now.Add(30 * time.Minute)
+	value, _ := suite.provider.Get("k")
+	suite.Equal([]byte("v"), value)
+}
+
+func (suite *BoltProviderTestSuite) TestModifyKeepsTTL() {
+	suite.Require().NoError(suite.provider.Set("k", []byte("v1"), time.Minute))
+
+	suite.NoError(suite.provider.Modify("k", func(value []byte) ([]byte, error) {
+		suite.Equal([]byte("v1"), value)
+		return []byte("v2"), nil
+	}))
+	value, _ := suite.provider.Get("k")
+	suite.Equal([]byte("v2"), value)
+
+	suite.now = suite.now.Add(time.Minute)
+	value, _ = suite.provider.Get("k")
+	suite.Nil(value)
+}
+
+func (suite *BoltProviderTestSuite) TestModifyLeavesEntryOnNilOrError() {
+	suite.Require().NoError(suite.provider.Set("k", []byte("v"), 0))
+
+	suite.NoError(suite.provider.Modify("k", func([]byte) ([]byte, error) { return nil, nil }))
+	errFailed := errors.New("failed")
+	suite.ErrorIs(suite.provider.Modify("k", func([]byte) ([]byte, error) {
+		return []byte("changed"), errFailed
+	}), errFailed)
+
+	value, _ := suite.provider.Get("k")
+	suite.Equal([]byte("v"), value)
+
+	suite.NoError(suite.provider.Modify("missing", func(value []byte) ([]byte, error) {
+		suite.Nil(value)
+		return nil, nil
+	}))
+}
+
+func (suite *BoltProviderTestSuite) TestSweepRemovesExpiredEntries() {
+	suite.Require().NoError(suite.provider.Set("expiring", []byte("v"), time.Minute))
+	suite.Require().NoError(suite.provider.Set("kept", []byte("v"), 0))
+	suite.now = suite.now.Add(time.Minute)
+
+	suite.NoError(suite.provider.sweep())
+
+	count := 0
+	suite.NoError(suite.provider.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(boltBucket)).ForEach(func(k, _ []byte) error {
+			suite.Equal("kept", string(k))
+			count++
+			return nil
+		})
+	}))
+	suite.Equal(1, count)
+}
+
+func (suite *BoltProviderTestSuite) TestEntriesSurviveReopen() {
+	dbPath := suite.provider.db.Path()
+	suite.Require().NoError(suite.provider.Set("k", []byte("v"), time.Hour))
+	suite.Require().NoError(suite.provider.Close())
+
+	p, err := NewBoltProvider(dbPath)
+	suite.Require().NoError(err)
+	suite.provider = p.(*boltProvider)
+	suite.provider.now = func() time.Time { return suite.now }
+
+	value, err := suite.provider.Get("k")
+	suite.NoError(err)
+	suite.Equal([]byte("v"), value)
+}
+
+func (suite *BoltProviderTestSuite) TestIsKeyValueRuntimeType() {
+	suite.True(IsKeyValueRuntimeType(DataSourceTypeRedis))
+	suite.True(IsKeyValueRuntimeType(DataSourceTypeBolt))
+	suite.False(IsKeyValueRuntimeType(dataSourceTypeSQLite))
+}
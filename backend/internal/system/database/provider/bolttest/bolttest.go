@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package bolttest provides a bolt runtime store for tests of the stores built on it.
+package bolttest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// NewProvider opens a bolt store in a temporary directory that is closed when the test ends.
+func NewProvider(t *testing.T) provider.BoltProviderInterface {
+	t.Helper()
+	p, err := provider.NewBoltProvider(filepath.Join(t.TempDir(), "runtime.bolt"))
+	if err != nil {
+		t.Fatalf("failed to open bolt store: %v", err)
+	}
+	t.Cleanup(func() {
+		if closer, ok := p.(provider.BoltProviderCloser); ok {
+			_ = closer.Close()
+		}
+	})
+	return p
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// CheckDataSource verifies that a configured data source can be reached. Connections opened for the
+// check are closed before returning. A SQLite database file must already exist, since opening a missing
+// file would create an empty database without the schema. The bolt store is not opened, as a running
+// server holds its lock; only its directory is checked for write access.
+func CheckDataSource(dataSource config.DataSource, dbName string) error {
+	switch dataSource.Type {
+	case "":
+		return fmt.Errorf("database %s: type is not configured", dbName)
+	case DataSourceTypeRedis:
+		client := newRedisClient(dataSource.Redis)
+		err := client.Ping(context.Background()).Err()
+		if closeErr := client.Close(); closeErr != nil {
+			err = errors.Join(err, closeErr)
+		}
+		if err != nil {
+			return fmt.Errorf("database %s: failed to connect to Redis at %s: %w",
+				dbName, dataSource.Redis.Address, err)
+		}
+		return nil
+	case DataSourceTypeBolt:
+		dbPath := path.Join(config.GetServerRuntime().ServerHome, dataSource.Bolt.Path)
+		if err := checkWritableDir(filepath.Dir(dbPath)); err != nil {
+			return fmt.Errorf("database %s: bolt store %s is not writable: %w", dbName, dbPath, err)
+		}
+		return nil
+	case dataSourceTypeSQLite:
+		dbPath, _, _ := strings.Cut(dataSource.SQLite.Path, "?")
+		if !strings.HasPrefix(dbPath, "file:") && dbPath != ":memory:" {
+			dbPath = path.Join(config.GetServerRuntime().ServerHome, dbPath)
+			if _, err := os.Stat(dbPath); err != nil {
+				return fmt.Errorf("database %s: SQLite database file %s is not accessible: %w", dbName, dbPath, err)
+			}
+		}
+	case dataSourceTypePostgres:
+	default:
+		return fmt.Errorf("database %s: unsupported type %q", dbName, dataSource.Type)
+	}
+
+	db, err := (&dbProvider{}).openDB(dataSource, dbName)
+	if err != nil {
+		return err
+	}
+	if err := db.Close(); err != nil {
+		return fmt.Errorf("failed to close database %s: %w", dbName, err)
+	}
+	return nil
+}
+
+// checkWritableDir verifies that files can be created in the directory, or in its nearest existing
+// ancestor when the directory is yet to be created.
+func checkWritableDir(dir string) error {
+	for {
+		info, err := os.Stat(dir)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("%s is not a directory", dir)
+			}
+			break
+		}
+		if !errors.Is(err, os.ErrNotExist) || filepath.Dir(dir) == dir {
+			return err
+		}
+		dir = filepath.Dir(dir)
+	}
+
+	f, err := os.CreateTemp(dir, ".writecheck-*")
+	if err != nil {
+		return err
+	}
+	return errors.Join(f.Close(), os.Remove(f.Name()))
+}
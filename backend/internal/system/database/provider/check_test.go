@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type CheckDataSourceTestSuite struct {
+	suite.Suite
+	home string
+}
+
+func TestCheckDataSourceSuite(t *testing.T) {
+	suite.Run(t, new(CheckDataSourceTestSuite))
+}
+
+func (suite *CheckDataSourceTestSuite) SetupTest() {
+	suite.home = suite.T().TempDir()
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime(suite.home, &config.Config{}))
+}
+
+func (suite *CheckDataSourceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *CheckDataSourceTestSuite) TestSQLite_ExistingFile() {
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.home, "config.db"), nil, 0o600))
+
+	err := CheckDataSource(config.DataSource{
+		Type:   dataSourceTypeSQLite,
+		SQLite: config.SQLiteDataSource{Path: "config.db"},
+	}, dbNameConfig)
+
+	suite.NoError(err)
+}
+
+func (suite *CheckDataSourceTestSuite) TestSQLite_MissingFile() {
+	err := CheckDataSource(config.DataSource{
+		Type:   dataSourceTypeSQLite,
+		SQLite: config.SQLiteDataSource{Path: "missing.db"},
+	}, dbNameConfig)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "missing.db")
+	suite.NoFileExists(filepath.Join(suite.home, "missing.db"))
+}
+
+func (suite *CheckDataSourceTestSuite) TestSQLite_InMemory() {
+	err := CheckDataSource(config.DataSource{
+		Type:   dataSourceTypeSQLite,
+		SQLite: config.SQLiteDataSource{Path: "file::memory:?cache=shared"},
+	}, dbNameRuntime)
+
+	suite.NoError(err)
+}
+
+func (suite *CheckDataSourceTestSuite) TestBolt_CreatableDirectory() {
+	err := CheckDataSource(config.DataSource{
+		Type: DataSourceTypeBolt,
+		Bolt: config.BoltDataSource{Path: "database/nested/runtimedb.bolt"},
+	}, dbNameRuntime)
+
+	suite.NoError(err)
+	suite.NoDirExists(filepath.Join(suite.home, "database"))
+}
+
+func (suite *CheckDataSourceTestSuite) TestBolt_PathUnderFile() {
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.home, "database"), nil, 0o600))
+
+	err := CheckDataSource(config.DataSource{
+		Type: DataSourceTypeBolt,
+		Bolt: config.BoltDataSource{Path: "database/runtimedb.bolt"},
+	}, dbNameRuntime)
+
+	suite.Error(err)
+}
+
+func (suite *CheckDataSourceTestSuite) TestRedis_Unreachable() {
+	err := CheckDataSource(config.DataSource{
+		Type:  DataSourceTypeRedis,
+		Redis: config.RedisDataSource{Address: "127.0.0.1:1", DialTimeoutMS: 100},
+	}, dbNameRuntime)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "127.0.0.1:1")
+}
+
+func (suite *CheckDataSourceTestSuite) TestTypeNotConfigured() {
+	suite.Error(CheckDataSource(config.DataSource{}, dbNameOperation))
+}
+
+func (suite *CheckDataSourceTestSuite) TestUnsupportedType() {
+	err := CheckDataSource(config.DataSource{Type: "mysql"}, dbNameUser)
+
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "mysql")
+}
@@ -22,9 +22,15 @@ package provider
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/faultinjection"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 
@@ -32,6 +38,20 @@ import (
 	_ "modernc.org/sqlite"
 )
 
+const (
+	// maxBatchRows caps the rows in a single batch statement to bound statement size.
+	maxBatchRows = 1000
+	// maxBatchParamsPostgres is the PostgreSQL wire protocol limit on bind parameters per statement.
+	maxBatchParamsPostgres = 65535
+	// maxBatchParamsSQLite is SQLite's default SQLITE_MAX_VARIABLE_NUMBER.
+	maxBatchParamsSQLite = 32766
+
+	// Query targets reported in the query latency metric.
+	queryTargetPrimary     = "primary"
+	queryTargetReplica     = "replica"
+	queryTargetTransaction = "transaction"
+)
+
 // DBClientInterface defines the interface for database operations.
 type DBClientInterface interface {
 	// Query executes a sql query that returns rows, typically a SELECT, and returns the result as a slice of maps.
@@ -42,6 +62,9 @@ type DBClientInterface interface {
 	Execute(query model.DBQuery, args ...interface{}) (int64, error)
 	// ExecuteContext executes a sql query without returning data with context support for transactions.
 	ExecuteContext(ctx context.Context, query model.DBQuery, args ...interface{}) (int64, error)
+	// BatchExecuteContext inserts the given rows with as few multi-row statements as the database
+	// allows, and returns the total number of rows affected.
+	BatchExecuteContext(ctx context.Context, query model.DBBatchInsertQuery, rows [][]interface{}) (int64, error)
 	// BeginTx starts a new database transaction.
 	BeginTx() (model.TxInterface, error)
 	// GetTransactioner returns the transactioner for this client.
@@ -54,6 +77,14 @@ type DBClient struct {
 	dbType      string
 	dbName      string
 	retryConfig retryConfig
+	// replicas serve reads outside transactions when read replicas are configured.
+	replicas *replicaSet
+	// stmts caches prepared statements of the primary. It is nil when statement caching is disabled.
+	stmts *stmtCache
+	// writeMu serializes statements that write outside a transaction, so that writers of a SQLite
+	// database queue in the process instead of contending for its lock. It is nil when writes are not
+	// serialized.
+	writeMu *sync.Mutex
 }
 
 // NewDBClient creates a new instance of DBClient with the provided database connection.
@@ -72,29 +103,44 @@ func (client *DBClient) Query(query model.DBQuery, args ...interface{}) ([]map[s
 }
 
 // QueryContext executes a sql query that returns rows with context support for transactions.
-// If a transaction exists in the context, it will be used automatically.
+// If a transaction exists in the context, it will be used automatically. Otherwise, read-only
+// queries run on a read replica when replicas are configured, unless the context requests primary
+// reads; a failed replica read is retried on the primary. Outside a transaction the query runs
+// through a cached prepared statement when statement caching is enabled.
 func (client *DBClient) QueryContext(
 	ctx context.Context,
 	query model.DBQuery,
 	args ...interface{},
-) ([]map[string]interface{}, error) {
+) (results []map[string]interface{}, err error) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DBClient"))
 	logger.Debug(ctx, "Executing query", log.String("queryID", query.GetID()))
 
 	sqlQuery := query.GetQuery(client.dbType)
+	start := time.Now()
+	target := queryTargetPrimary
+	defer func() {
+		recordDBQueryLatency(ctx, client.dbType, client.dbName, query.GetID(), "query", target, err,
+			time.Since(start))
+	}()
 
 	// Check if there's a transaction in the context for this database
 	var rows *sql.Rows
-	var err error
 	if tx := transaction.KeyedTxFromContext(ctx, client.dbName); tx != nil {
-		rows, err = tx.QueryContext(ctx, sqlQuery, args...)
+		target = queryTargetTransaction
+		if err = injectDBFault(ctx, query.GetID()); err == nil {
+			rows, err = tx.QueryContext(ctx, sqlQuery, args...)
+		}
+	} else if r := client.pickReplica(ctx, sqlQuery); r != nil {
+		target = queryTargetReplica
+		rows, err = client.queryWithRetry(ctx, r.db, r.stmts, query.GetID(), sqlQuery, args...)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logger.Warn(ctx, "Query on database replica failed, retrying on the primary",
+				log.String("queryID", query.GetID()), log.String("replica", r.name), log.Error(err))
+			target = queryTargetPrimary
+			rows, err = client.queryWithRetry(ctx, client.db, client.stmts, query.GetID(), sqlQuery, args...)
+		}
 	} else {
-		err = withRetryDB(ctx, client.dbType, client.dbName, query.GetID(), client.retryConfig,
-			func(execCtx context.Context) error {
-				var queryErr error
-				rows, queryErr = client.db.GetSQLDB().QueryContext(execCtx, sqlQuery, args...)
-				return queryErr
-			})
+		rows, err = client.queryWithRetry(ctx, client.db, client.stmts, query.GetID(), sqlQuery, args...)
 	}
 
 	if err != nil {
@@ -111,7 +157,6 @@ func (client *DBClient) QueryContext(
 		return nil, err
 	}
 
-	var results []map[string]interface{}
 	for rows.Next() {
 		row := make([]interface{}, len(columns))
 		rowPointers := make([]interface{}, len(columns))
@@ -138,33 +183,121 @@ func (client *DBClient) QueryContext(
 	return results, nil
 }
 
+// pickReplica returns the replica to run a query on, or nil when it must run on the primary.
+func (client *DBClient) pickReplica(ctx context.Context, sqlQuery string) *replica {
+	if client.replicas == nil || usePrimaryReads(ctx) || !isReadOnlyQuery(sqlQuery) {
+		return nil
+	}
+	return client.replicas.pick()
+}
+
+// queryWithRetry runs a query on db, retrying transient failures.
+func (client *DBClient) queryWithRetry(ctx context.Context, db model.DBInterface, stmts *stmtCache,
+	queryID, sqlQuery string, args ...interface{}) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := withRetryDB(ctx, client.dbType, client.dbName, queryID, client.retryConfig,
+		func(execCtx context.Context) error {
+			var queryErr error
+			rows, queryErr = queryDB(execCtx, db, stmts, queryID, sqlQuery, args...)
+			return queryErr
+		})
+	return rows, err
+}
+
+// queryDB runs a query on db through its cached prepared statement, or directly when the query is
+// not cached. The statement can be released once the query returns: database/sql keeps it open
+// until the rows are closed.
+func queryDB(ctx context.Context, db model.DBInterface, stmts *stmtCache, queryID, sqlQuery string,
+	args ...interface{}) (*sql.Rows, error) {
+	if err := injectDBFault(ctx, queryID); err != nil {
+		return nil, err
+	}
+	entry, err := stmts.acquire(ctx, queryID, sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return db.GetSQLDB().QueryContext(ctx, sqlQuery, args...)
+	}
+	defer stmts.release(entry)
+	return entry.stmt.QueryContext(ctx, args...)
+}
+
+// execDB runs a statement on db through its cached prepared statement, or directly when the
+// statement is not cached.
+func execDB(ctx context.Context, db model.DBInterface, stmts *stmtCache, queryID, sqlQuery string,
+	args ...interface{}) (sql.Result, error) {
+	if err := injectDBFault(ctx, queryID); err != nil {
+		return nil, err
+	}
+	entry, err := stmts.acquire(ctx, queryID, sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return db.GetSQLDB().ExecContext(ctx, sqlQuery, args...)
+	}
+	defer stmts.release(entry)
+	return entry.stmt.ExecContext(ctx, args...)
+}
+
+// injectDBFault applies the active fault injection rules to a database call. Injected failures wrap
+// driver.ErrBadConn so they are treated like a dropped connection, including by the retry wrapper.
+func injectDBFault(ctx context.Context, queryID string) error {
+	err := faultinjection.Inject(ctx, faultinjection.TargetDatabase, queryID)
+	if errors.Is(err, faultinjection.ErrInjectedFault) {
+		return fmt.Errorf("%w: %w", err, driver.ErrBadConn)
+	}
+	return err
+}
+
 // Execute executes a sql query without returning data in any rows, and returns number of rows affected.
 func (client *DBClient) Execute(query model.DBQuery, args ...interface{}) (int64, error) {
 	return client.ExecuteContext(context.Background(), query, args...)
 }
 
 // ExecuteContext executes a sql query without returning data with context support for transactions.
-// If a transaction exists in the context, it will be used automatically.
-func (client *DBClient) ExecuteContext(ctx context.Context, query model.DBQuery, args ...interface{}) (int64, error) {
+// If a transaction exists in the context, it will be used automatically. Otherwise the statement
+// runs through a cached prepared statement when statement caching is enabled, and is retried when
+// SQLite reports the database as busy.
+func (client *DBClient) ExecuteContext(ctx context.Context, query model.DBQuery,
+	args ...interface{}) (rowsAffected int64, err error) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DBClient"))
 	logger.Debug(ctx, "Executing query", log.String("queryID", query.GetID()))
 
 	sqlQuery := query.GetQuery(client.dbType)
+	start := time.Now()
+	target := queryTargetPrimary
+	defer func() {
+		recordDBQueryLatency(ctx, client.dbType, client.dbName, query.GetID(), "execute", target, err,
+			time.Since(start))
+	}()
 
 	// Check if there's a transaction in the context for this database
 	var res sql.Result
-	var err error
 	if tx := transaction.KeyedTxFromContext(ctx, client.dbName); tx != nil {
-		res, err = tx.ExecContext(ctx, sqlQuery, args...)
+		target = queryTargetTransaction
+		if err = injectDBFault(ctx, query.GetID()); err == nil {
+			res, err = tx.ExecContext(ctx, sqlQuery, args...)
+		}
 	} else {
-		res, err = client.db.GetSQLDB().ExecContext(ctx, sqlQuery, args...)
+		err = withRetryDBOn(ctx, client.dbType, client.dbName, query.GetID(), client.retryConfig,
+			isSQLiteBusyError, func(execCtx context.Context) error {
+				if client.writeMu != nil {
+					client.writeMu.Lock()
+					defer client.writeMu.Unlock()
+				}
+				var execErr error
+				res, execErr = execDB(execCtx, client.db, client.stmts, query.GetID(), sqlQuery, args...)
+				return execErr
+			})
 	}
 
 	if err != nil {
 		return 0, err
 	}
 
-	rowsAffected, err := res.RowsAffected()
+	rowsAffected, err = res.RowsAffected()
 	if err != nil {
 		return 0, err
 	}
@@ -172,6 +305,49 @@ func (client *DBClient) ExecuteContext(ctx context.Context, query model.DBQuery,
 	return rowsAffected, nil
 }
 
+// BatchExecuteContext expands query into multi-row INSERT statements and executes them. Rows are
+// split into chunks that stay within the bind parameter limit of the database dialect. If a
+// transaction exists in the context, every chunk is executed in it.
+func (client *DBClient) BatchExecuteContext(ctx context.Context, query model.DBBatchInsertQuery,
+	rows [][]interface{}) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	if query.Columns <= 0 {
+		return 0, fmt.Errorf("batch query %s must declare at least one column", query.ID)
+	}
+
+	chunkSize := client.maxBatchRows(query.Columns)
+	var total int64
+	for start := 0; start < len(rows); start += chunkSize {
+		end := min(start+chunkSize, len(rows))
+		args := make([]interface{}, 0, (end-start)*query.Columns)
+		for _, row := range rows[start:end] {
+			if len(row) != query.Columns {
+				return total, fmt.Errorf("batch query %s expects %d values per row, got %d",
+					query.ID, query.Columns, len(row))
+			}
+			args = append(args, row...)
+		}
+
+		affected, err := client.ExecuteContext(ctx, query.Expand(end-start), args...)
+		if err != nil {
+			return total, err
+		}
+		total += affected
+	}
+	return total, nil
+}
+
+// maxBatchRows returns the number of rows with the given column count that fit in one statement.
+func (client *DBClient) maxBatchRows(columns int) int {
+	maxParams := maxBatchParamsPostgres
+	if client.dbType == dataSourceTypeSQLite {
+		maxParams = maxBatchParamsSQLite
+	}
+	return max(1, min(maxBatchRows, maxParams/columns))
+}
+
 // BeginTx starts a new database transaction.
 func (client *DBClient) BeginTx() (model.TxInterface, error) {
 	tx, err := client.db.Begin()
@@ -186,7 +362,11 @@ func (client *DBClient) GetTransactioner() (transaction.Transactioner, error) {
 	return transaction.NewTransactioner(client.db.GetSQLDB(), client.dbName), nil
 }
 
-// Close closes the database connection.
+// Close closes the cached statements, the database connection and the replica connections.
 func (client *DBClient) close() error {
-	return client.db.Close()
+	err := errors.Join(client.stmts.close(), client.db.Close())
+	if client.replicas != nil {
+		err = errors.Join(err, client.replicas.close())
+	}
+	return err
 }
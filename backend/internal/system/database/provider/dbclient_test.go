@@ -25,6 +25,7 @@ import (
 	"errors"
 	"net"
 	"os"
+	"regexp"
 	"syscall"
 	"testing"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"github.com/lib/pq"
 
 	"github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/faultinjection"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 
 	"github.com/stretchr/testify/assert"
@@ -136,6 +138,25 @@ func (suite *DBClientTestSuite) TestQueryDatabaseError() {
 	assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
 }
 
+func (suite *DBClientTestSuite) TestQueryInjectedFault() {
+	suite.Require().NoError(faultinjection.Initialize(faultinjection.Config{
+		Enabled: true,
+		Rules: []faultinjection.Rule{
+			{Target: faultinjection.TargetDatabase, Match: "test_query_fault", Probability: 1, Fail: true},
+		},
+	}))
+	defer func() { _ = faultinjection.Initialize(faultinjection.Config{}) }()
+
+	results, err := suite.dbClient.Query(model.DBQuery{ID: "test_query_fault", Query: "SELECT id FROM users"})
+
+	assert.ErrorIs(suite.T(), err, faultinjection.ErrInjectedFault)
+	assert.ErrorIs(suite.T(), err, driver.ErrBadConn)
+	assert.Nil(suite.T(), results)
+
+	_, err = suite.dbClient.Execute(model.DBQuery{ID: "test_query_fault", Query: "DELETE FROM users"})
+	assert.ErrorIs(suite.T(), err, faultinjection.ErrInjectedFault)
+}
+
 func (suite *DBClientTestSuite) TestExecuteSuccess() {
 	testQuery := model.DBQuery{
 		ID:    "test_execute_success",
@@ -484,3 +505,65 @@ func (suite *DBClientTestSuite) TestIsRetryableDBError() {
 	assert.False(suite.T(), isRetryableDBError(sql.ErrNoRows))
 	assert.False(suite.T(), isRetryableDBError(errors.New("syntax error near FROM")))
 }
+
+func (suite *DBClientTestSuite) TestBatchExecuteContext_SingleStatement() {
+	query := model.DBBatchInsertQuery{
+		ID:      "test_batch",
+		Prefix:  `INSERT INTO "T" (A, B)`,
+		Suffix:  "ON CONFLICT DO NOTHING",
+		Columns: 2,
+	}
+
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		`INSERT INTO "T" (A, B) VALUES ($1, $2), ($3, $4) ON CONFLICT DO NOTHING`)).
+		WithArgs("a1", "b1", "a2", "b2").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	affected, err := suite.dbClient.BatchExecuteContext(context.Background(), query,
+		[][]interface{}{{"a1", "b1"}, {"a2", "b2"}})
+	suite.NoError(err)
+	suite.Equal(int64(2), affected)
+}
+
+func (suite *DBClientTestSuite) TestBatchExecuteContext_SplitsIntoChunks() {
+	query := model.DBBatchInsertQuery{ID: "test_batch_chunks", Prefix: `INSERT INTO "T" (A)`, Columns: 1}
+	rows := make([][]interface{}, maxBatchRows+1)
+	for i := range rows {
+		rows[i] = []interface{}{i}
+	}
+
+	suite.mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "T" (A) VALUES ($1), ($2)`)).
+		WillReturnResult(sqlmock.NewResult(0, maxBatchRows))
+	suite.mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "T" (A) VALUES ($1)`) + "$").
+		WithArgs(maxBatchRows).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	affected, err := suite.dbClient.BatchExecuteContext(context.Background(), query, rows)
+	suite.NoError(err)
+	suite.Equal(int64(maxBatchRows+1), affected)
+}
+
+func (suite *DBClientTestSuite) TestBatchExecuteContext_EmptyRows() {
+	affected, err := suite.dbClient.BatchExecuteContext(context.Background(),
+		model.DBBatchInsertQuery{ID: "test_batch_empty", Columns: 1}, nil)
+	suite.NoError(err)
+	suite.Equal(int64(0), affected)
+}
+
+func (suite *DBClientTestSuite) TestBatchExecuteContext_RowWidthMismatch() {
+	_, err := suite.dbClient.BatchExecuteContext(context.Background(),
+		model.DBBatchInsertQuery{ID: "test_batch_width", Prefix: `INSERT INTO "T" (A, B)`, Columns: 2},
+		[][]interface{}{{"a1"}})
+	suite.Error(err)
+	suite.Contains(err.Error(), "expects 2 values per row")
+}
+
+func (suite *DBClientTestSuite) TestMaxBatchRows() {
+	pg := &DBClient{dbType: dataSourceTypePostgres}
+	sqlite := &DBClient{dbType: dataSourceTypeSQLite}
+
+	suite.Equal(maxBatchRows, pg.maxBatchRows(6))
+	suite.Equal(maxBatchParamsPostgres/100, pg.maxBatchRows(100))
+	suite.Equal(maxBatchParamsSQLite/100, sqlite.maxBatchRows(100))
+	suite.Equal(1, sqlite.maxBatchRows(maxBatchParamsSQLite+1))
+}
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -148,9 +149,9 @@ func (d *dbProvider) GetUserDBTransactioner() (transaction.Transactioner, error)
 
 // GetRuntimeDBTransactioner returns a transactioner for the runtime database.
 func (d *dbProvider) GetRuntimeDBTransactioner() (transaction.Transactioner, error) {
-	// When the runtime store is Redis, a no-op transactioner is returned since Redis does
-	// not support SQL-style transactions.
-	if config.GetServerRuntime().Config.Database.Runtime.Type == DataSourceTypeRedis {
+	// When the runtime store is Redis or bolt, a no-op transactioner is returned since neither
+	// supports SQL-style transactions.
+	if IsKeyValueRuntimeType(config.GetServerRuntime().Config.Database.Runtime.Type) {
 		return transaction.NewNoOpTransactioner(), nil
 	}
 	return d.getTransactioner(d.GetRuntimeDBClient, dbNameRuntime)
@@ -188,7 +189,7 @@ func (d *dbProvider) initializeAllClients() {
 	}
 
 	runtimeDBConfig := config.GetServerRuntime().Config.Database.Runtime
-	if runtimeDBConfig.Type != DataSourceTypeRedis {
+	if !IsKeyValueRuntimeType(runtimeDBConfig.Type) {
 		err = d.initializeClient(&d.runtimeClient, runtimeDBConfig, dbNameRuntime)
 		if err != nil {
 			logger.Error(ctx, "Failed to initialize runtime database client", log.Error(err))
@@ -221,10 +222,13 @@ func (d *dbProvider) getOrInitClient(
 	if dataSource.Type == "" {
 		return nil, fmt.Errorf("database type is not configured")
 	}
-	// Redis runtime stores bypass the SQL client entirely
+	// Redis and bolt runtime stores bypass the SQL client entirely
 	if dataSource.Type == DataSourceTypeRedis {
 		return nil, fmt.Errorf("runtime database is configured as Redis; use RedisProvider instead")
 	}
+	if dataSource.Type == DataSourceTypeBolt {
+		return nil, fmt.Errorf("runtime database is configured as bolt; use BoltProvider instead")
+	}
 
 	mutex.RLock()
 	if *clientPtr != nil {
@@ -250,67 +254,122 @@ func (d *dbProvider) getOrInitClient(
 
 // initializeClient initializes a database client and assigns it to the provided pointer.
 func (d *dbProvider) initializeClient(clientPtr *DBClientInterface, dataSource config.DataSource, dbName string) error {
+	db, err := d.openDB(dataSource, dbName)
+	if err != nil {
+		return err
+	}
+
+	var rc retryConfig
+	var cacheSize int
+	var serializeWrites bool
+	switch dataSource.Type {
+	case dataSourceTypePostgres:
+		// PgBouncer in transaction pooling mode cannot track named prepared statements.
+		if !dataSource.Postgres.PgBouncerMode {
+			cacheSize = statementCacheSize(dataSource.Postgres.StatementCacheSize)
+		}
+		rc = retryConfig{
+			MaxAttempts: dataSource.Postgres.MaxRetries,
+			MinBackoff:  time.Duration(dataSource.Postgres.MinRetryBackoffMS) * time.Millisecond,
+			MaxBackoff:  time.Duration(dataSource.Postgres.MaxRetryBackoffMS) * time.Millisecond,
+		}
+	case dataSourceTypeSQLite:
+		cacheSize = statementCacheSize(dataSource.SQLite.StatementCacheSize)
+		rc = retryConfig{
+			MaxAttempts: dataSource.SQLite.MaxRetries,
+			MinBackoff:  time.Duration(dataSource.SQLite.MinRetryBackoffMS) * time.Millisecond,
+			MaxBackoff:  time.Duration(dataSource.SQLite.MaxRetryBackoffMS) * time.Millisecond,
+		}
+		serializeWrites = dataSource.SQLite.SerializeWrites
+	}
+
+	dbConfig := d.getDBConfig(dataSource)
+	client := NewDBClient(model.NewDB(db), dbConfig.driverName, dbName, rc).(*DBClient)
+	client.stmts = newStmtCache(db, dbName, cacheSize)
+	client.replicas = d.openReplicas(dataSource, dbName, cacheSize)
+	if serializeWrites {
+		client.writeMu = &sync.Mutex{}
+	}
+	*clientPtr = client
+	return nil
+}
+
+// openReplicas connects to the read replicas of a PostgreSQL data source. A replica that cannot be
+// reached is left out so that the primary keeps serving its reads. The runtime database never reads
+// from replicas: authorization requests, flow contexts, and token IDs are read right after they are
+// written and a stale read there is a security issue.
+func (d *dbProvider) openReplicas(dataSource config.DataSource, dbName string, cacheSize int) *replicaSet {
+	if dataSource.Type != dataSourceTypePostgres || len(dataSource.Postgres.Replicas) == 0 {
+		return nil
+	}
+	// This runs outside any request, so context.Background() is used (no request trace ID).
+	ctx := context.Background()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DBProvider"))
+
+	if dbName == dbNameRuntime {
+		logger.Warn(ctx, "Ignoring read replicas configured for the runtime database, reads use the primary")
+		return nil
+	}
+
+	replicas := make([]*replica, 0, len(dataSource.Postgres.Replicas))
+	for _, replicaConfig := range dataSource.Postgres.Replicas {
+		replicaSource := dataSource
+		replicaSource.Postgres.Hostname = replicaConfig.Hostname
+		replicaSource.Postgres.Port = replicaConfig.Port
+		name := fmt.Sprintf("%s:%d", replicaConfig.Hostname, replicaConfig.Port)
+
+		db, err := d.openDB(replicaSource, dbName+" replica "+name)
+		if err != nil {
+			logger.Error(ctx, "Failed to initialize database replica, reads will skip it",
+				log.String("database", dbName), log.String("replica", name), log.Error(err))
+			continue
+		}
+		replicas = append(replicas, &replica{db: model.NewDB(db), name: name,
+			stmts: newStmtCache(db, dbName, cacheSize)})
+	}
+	if len(replicas) == 0 {
+		return nil
+	}
+	return newReplicaSet(replicas, maxReplicaLag(dataSource.Postgres.MaxReplicaLagMS))
+}
+
+// openDB opens and verifies a connection pool for the data source.
+func (d *dbProvider) openDB(dataSource config.DataSource, dbName string) (*sql.DB, error) {
 	dbConfig := d.getDBConfig(dataSource)
 
 	db, err := sql.Open(dbConfig.driverName, dbConfig.dsn)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database %s: %w", dbName, err)
+		return nil, fmt.Errorf("failed to connect to database %s: %w", dbName, err)
 	}
 
 	// Configure connection pool using values from the type-specific sub-config.
-	var maxOpenConns, maxIdleConns, connMaxLifetime int
+	var maxOpenConns, maxIdleConns, connMaxLifetime, connMaxIdleTime int
 	switch dataSource.Type {
 	case dataSourceTypePostgres:
 		maxOpenConns = dataSource.Postgres.MaxOpenConns
 		maxIdleConns = dataSource.Postgres.MaxIdleConns
 		connMaxLifetime = dataSource.Postgres.ConnMaxLifetime
+		connMaxIdleTime = dataSource.Postgres.ConnMaxIdleTime
 	case dataSourceTypeSQLite:
 		maxOpenConns = dataSource.SQLite.MaxOpenConns
 		maxIdleConns = dataSource.SQLite.MaxIdleConns
 		connMaxLifetime = dataSource.SQLite.ConnMaxLifetime
+		connMaxIdleTime = dataSource.SQLite.ConnMaxIdleTime
 	}
 	db.SetMaxOpenConns(maxOpenConns)
 	db.SetMaxIdleConns(maxIdleConns)
 	db.SetConnMaxLifetime(time.Duration(connMaxLifetime) * time.Second)
+	db.SetConnMaxIdleTime(time.Duration(connMaxIdleTime) * time.Second)
 
 	// Test the database connection.
 	if err := db.Ping(); err != nil {
 		if closeErr := db.Close(); closeErr != nil {
-			return fmt.Errorf("failed to ping database %s: %w (close error: %w)", dbName, err, closeErr)
-		}
-		return fmt.Errorf("failed to ping database %s: %w", dbName, err)
-	}
-
-	// Enable foreign key constraints for SQLite databases
-	if dbConfig.driverName == dataSourceTypeSQLite {
-		_, err := db.Exec("PRAGMA foreign_keys = ON;")
-		if err != nil {
-			if closeErr := db.Close(); closeErr != nil {
-				return fmt.Errorf("failed to enable foreign key constraints for %s: %w (close error: %w)",
-					dbName, err, closeErr)
-			}
-			return fmt.Errorf("failed to enable foreign key constraints for %s: %w", dbName, err)
-		}
-	}
-
-	var rc retryConfig
-	switch dataSource.Type {
-	case dataSourceTypePostgres:
-		rc = retryConfig{
-			MaxAttempts: dataSource.Postgres.MaxRetries,
-			MinBackoff:  time.Duration(dataSource.Postgres.MinRetryBackoffMS) * time.Millisecond,
-			MaxBackoff:  time.Duration(dataSource.Postgres.MaxRetryBackoffMS) * time.Millisecond,
-		}
-	case dataSourceTypeSQLite:
-		rc = retryConfig{
-			MaxAttempts: dataSource.SQLite.MaxRetries,
-			MinBackoff:  time.Duration(dataSource.SQLite.MinRetryBackoffMS) * time.Millisecond,
-			MaxBackoff:  time.Duration(dataSource.SQLite.MaxRetryBackoffMS) * time.Millisecond,
+			return nil, fmt.Errorf("failed to ping database %s: %w (close error: %w)", dbName, err, closeErr)
 		}
+		return nil, fmt.Errorf("failed to ping database %s: %w", dbName, err)
 	}
 
-	*clientPtr = NewDBClient(model.NewDB(db), dbConfig.driverName, dbName, rc)
-	return nil
+	return db, nil
 }
 
 // getDBConfig returns the database configuration based on the provided data source.
@@ -323,14 +382,25 @@ func (d *dbProvider) getDBConfig(dataSource config.DataSource) dbConfig {
 		dbConfig.driverName = dataSourceTypePostgres
 		dbConfig.dsn = fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 			pg.Hostname, pg.Port, pg.Username, pg.Password, pg.Name, pg.SSLMode)
+		if pg.ConnectTimeout > 0 {
+			dbConfig.dsn += fmt.Sprintf(" connect_timeout=%d", pg.ConnectTimeout)
+		}
+		if pg.PgBouncerMode {
+			// Send parameters inline so that no prepared statement outlives a pooled transaction.
+			dbConfig.dsn += " binary_parameters=yes"
+		} else if pg.StatementTimeoutMS > 0 {
+			dbConfig.dsn += fmt.Sprintf(" options='-c statement_timeout=%d'", pg.StatementTimeoutMS)
+		}
 	case dataSourceTypeSQLite:
 		sl := dataSource.SQLite
 		dbConfig.driverName = dataSourceTypeSQLite
-		options := sl.Options
-		if options != "" && options[0] != '?' {
-			options = "?" + options
+		// Options may also be given in the path. Foreign keys and the other pragmas are set through the
+		// options so that they apply to every pooled connection.
+		dbPath, options, _ := strings.Cut(sl.Path, "?")
+		if sl.Options != "" {
+			options = strings.TrimPrefix(options+"&"+strings.TrimPrefix(sl.Options, "?"), "&")
 		}
-		dbConfig.dsn = fmt.Sprintf("%s%s", path.Join(config.GetServerRuntime().ServerHome, sl.Path), options)
+		dbConfig.dsn = path.Join(config.GetServerRuntime().ServerHome, dbPath) + normalizeSQLiteOptions(options)
 	}
 
 	return dbConfig
@@ -347,13 +417,16 @@ func (d *dbProvider) Close() error {
 	userErr := d.closeClient(&d.userClient, &d.userMutex, "user")
 	operationErr := d.closeClient(&d.operationClient, &d.operationMutex, "operation")
 
-	// Close the Redis runtime provider if it was initialized.
-	var redisErr error
+	// Close the Redis or bolt runtime provider if it was initialized.
+	var redisErr, boltErr error
 	if redisInstance != nil {
 		redisErr = redisInstance.Close()
 	}
+	if boltInstance != nil {
+		boltErr = boltInstance.Close()
+	}
 
-	return errors.Join(configErr, runtimeErr, userErr, operationErr, redisErr)
+	return errors.Join(configErr, runtimeErr, userErr, operationErr, redisErr, boltErr)
 }
 
 // closeClient is a helper to close a DB client with locking.
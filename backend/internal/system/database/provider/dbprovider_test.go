@@ -118,3 +118,45 @@ func (suite *DBProviderTestSuite) TestGetOperationDBTransactioner_Success() {
 	suite.NoError(err)
 	suite.NotNil(txer)
 }
+
+func (suite *DBProviderTestSuite) TestGetDBConfig_PostgresPoolOptions() {
+	provider := &dbProvider{}
+	base := config.PostgresDataSource{
+		Hostname: "db", Port: 5432, Username: "u", Password: "p", Name: "n", SSLMode: "disable",
+	}
+
+	cfg := provider.getDBConfig(config.DataSource{Type: "postgres", Postgres: base})
+	suite.Equal("host=db port=5432 user=u password=p dbname=n sslmode=disable", cfg.dsn)
+
+	withTimeouts := base
+	withTimeouts.ConnectTimeout = 5
+	withTimeouts.StatementTimeoutMS = 30000
+	cfg = provider.getDBConfig(config.DataSource{Type: "postgres", Postgres: withTimeouts})
+	suite.Contains(cfg.dsn, " connect_timeout=5")
+	suite.Contains(cfg.dsn, " options='-c statement_timeout=30000'")
+
+	pgBouncer := base
+	pgBouncer.PgBouncerMode = true
+	cfg = provider.getDBConfig(config.DataSource{Type: "postgres", Postgres: pgBouncer})
+	suite.Contains(cfg.dsn, " binary_parameters=yes")
+	suite.NotContains(cfg.dsn, "options=")
+}
+
+func (suite *DBProviderTestSuite) TestGetDBConfig_SQLiteOptions() {
+	provider := &dbProvider{}
+
+	cfg := provider.getDBConfig(config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{
+		Path: "database/runtimedb.db", Options: "_journal_mode=WAL&_busy_timeout=2000",
+	}})
+
+	suite.Equal("sqlite", cfg.driverName)
+	suite.Equal("database/runtimedb.db?_pragma=busy_timeout%282000%29&_pragma=journal_mode%28WAL%29"+
+		"&_pragma=foreign_keys%281%29&_txlock=immediate", cfg.dsn)
+
+	cfg = provider.getDBConfig(config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{
+		Path: "file::memory:?cache=shared", Options: "_txlock=deferred",
+	}})
+
+	suite.Equal("file::memory:?_pragma=busy_timeout%285000%29&_pragma=foreign_keys%281%29"+
+		"&_pragma=journal_mode%28WAL%29&_txlock=deferred&cache=shared", cfg.dsn)
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type dbQueryMetrics struct {
+	once           sync.Once
+	queryLatency   metric.Float64Histogram
+	statementCache metric.Int64Counter
+}
+
+var queryMetrics dbQueryMetrics
+
+func initDBQueryMetrics() {
+	queryMetrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/database/query")
+		queryMetrics.queryLatency, _ = meter.Float64Histogram(
+			"thunderid_db_query_seconds",
+			metric.WithDescription("Latency of DB queries by query ID, including reading the result rows"),
+		)
+		queryMetrics.statementCache, _ = meter.Int64Counter(
+			"thunderid_db_statement_cache_total",
+			metric.WithDescription("Prepared statement cache lookups by query ID and result"),
+		)
+	})
+}
+
+// recordDBQueryLatency records the latency of a query. The target is where the query ran:
+// the primary, a replica, or a transaction.
+func recordDBQueryLatency(
+	ctx context.Context,
+	dbType, dbName, queryID, operation, target string,
+	err error,
+	duration time.Duration,
+) {
+	initDBQueryMetrics()
+	if queryMetrics.queryLatency == nil {
+		return
+	}
+	status := "success"
+	switch {
+	case errors.Is(err, context.Canceled):
+		status = "cancelled"
+	case err != nil:
+		status = "failed"
+	}
+	queryMetrics.queryLatency.Record(
+		ctx,
+		duration.Seconds(),
+		metric.WithAttributes(
+			attribute.String("db.type", dbType),
+			attribute.String("db.name", dbName),
+			attribute.String("db.query_id", queryID),
+			attribute.String("db.operation", operation),
+			attribute.String("db.target", target),
+			attribute.String("db.status", status),
+		),
+	)
+}
+
+// recordStatementCache records a prepared statement cache lookup. The result is hit, miss, or
+// bypass for a query whose SQL varies between calls.
+func recordStatementCache(ctx context.Context, dbName, queryID, result string) {
+	initDBQueryMetrics()
+	if queryMetrics.statementCache == nil {
+		return
+	}
+	queryMetrics.statementCache.Add(
+		ctx,
+		1,
+		metric.WithAttributes(
+			attribute.String("db.name", dbName),
+			attribute.String("db.query_id", queryID),
+			attribute.String("db.cache_result", result),
+		),
+	)
+}
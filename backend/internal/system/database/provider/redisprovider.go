@@ -68,18 +68,7 @@ func initRedisProvider() {
 		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RedisProvider"))
 
 		r := cfg.Redis
-		client := redis.NewClient(&redis.Options{
-			Addr:            r.Address,
-			Username:        r.Username,
-			Password:        r.Password,
-			DB:              r.DB,
-			MaxRetries:      r.MaxRetries,
-			MinRetryBackoff: time.Duration(r.MinRetryBackoffMS) * time.Millisecond,
-			MaxRetryBackoff: time.Duration(r.MaxRetryBackoffMS) * time.Millisecond,
-			DialTimeout:     time.Duration(r.DialTimeoutMS) * time.Millisecond,
-			ReadTimeout:     time.Duration(r.ReadTimeoutMS) * time.Millisecond,
-			WriteTimeout:    time.Duration(r.WriteTimeoutMS) * time.Millisecond,
-		})
+		client := newRedisClient(r)
 
 		if err := client.Ping(context.Background()).Err(); err != nil {
 			if closeErr := client.Close(); closeErr != nil {
@@ -100,6 +89,22 @@ func initRedisProvider() {
 	})
 }
 
+// newRedisClient creates a Redis client for the given data source configuration.
+func newRedisClient(r config.RedisDataSource) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:            r.Address,
+		Username:        r.Username,
+		Password:        r.Password,
+		DB:              r.DB,
+		MaxRetries:      r.MaxRetries,
+		MinRetryBackoff: time.Duration(r.MinRetryBackoffMS) * time.Millisecond,
+		MaxRetryBackoff: time.Duration(r.MaxRetryBackoffMS) * time.Millisecond,
+		DialTimeout:     time.Duration(r.DialTimeoutMS) * time.Millisecond,
+		ReadTimeout:     time.Duration(r.ReadTimeoutMS) * time.Millisecond,
+		WriteTimeout:    time.Duration(r.WriteTimeoutMS) * time.Millisecond,
+	})
+}
+
 // GetRedisProvider returns the singleton Redis provider.
 func GetRedisProvider() RedisProviderInterface {
 	initRedisProvider()
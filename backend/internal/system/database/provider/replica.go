@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// defaultMaxReplicaLag is the tolerated replay lag when none is configured.
+	defaultMaxReplicaLag = time.Second
+	// replicaLagCheckInterval is how often the replay lag of a replica is measured.
+	replicaLagCheckInterval = 5 * time.Second
+	// replicaLagCheckTimeout bounds a single lag measurement.
+	replicaLagCheckTimeout = 2 * time.Second
+	// replicaLagQuery returns the replay lag of a PostgreSQL standby in milliseconds. A standby that
+	// has replayed everything it received reports no lag, so an idle primary does not make its
+	// replicas look stale.
+	replicaLagQuery = `SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0 ` +
+		`ELSE COALESCE(EXTRACT(EPOCH FROM (now() - pg_last_xact_replay_timestamp())) * 1000, 0) END`
+)
+
+type primaryReadsKey struct{}
+
+// WithPrimaryReads returns a context whose reads go to the primary database. Use it for reads
+// that must observe a write made moments before, which a lagging replica may not have applied.
+func WithPrimaryReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadsKey{}, true)
+}
+
+// usePrimaryReads reports whether reads in the context must go to the primary database.
+func usePrimaryReads(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryReadsKey{}).(bool)
+	return primary
+}
+
+// maxReplicaLag resolves the configured replay lag tolerance. Zero selects the default tolerance and
+// a negative value disables the lag check.
+func maxReplicaLag(configuredMS int) time.Duration {
+	if configuredMS == 0 {
+		return defaultMaxReplicaLag
+	}
+	return time.Duration(max(configuredMS, 0)) * time.Millisecond
+}
+
+// isReadOnlyQuery reports whether a query only reads data and can therefore run on a replica.
+// Statements that write or lock rows, such as UPDATE ... RETURNING and SELECT ... FOR UPDATE,
+// must run on the primary.
+func isReadOnlyQuery(sqlQuery string) bool {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(sqlQuery), " "))
+	if !strings.HasPrefix(normalized, "SELECT ") {
+		return false
+	}
+	for _, lockClause := range []string{" FOR UPDATE", " FOR NO KEY UPDATE", " FOR SHARE", " FOR KEY SHARE"} {
+		if strings.Contains(normalized, lockClause) {
+			return false
+		}
+	}
+	return true
+}
+
+// replica is a read replica and its lag state.
+type replica struct {
+	db   model.DBInterface
+	name string
+	// stmts caches prepared statements of the replica. It is nil when statement caching is disabled.
+	stmts *stmtCache
+	// lagging is set while the replica lags beyond the tolerated lag or cannot be reached.
+	lagging   atomic.Bool
+	lastCheck atomic.Int64
+	checking  atomic.Bool
+}
+
+// replicaSet spreads reads across read replicas in turn, skipping replicas that lag too far behind
+// the primary.
+type replicaSet struct {
+	replicas []*replica
+	next     atomic.Uint64
+	// maxLag is the tolerated replay lag. Zero disables the lag check.
+	maxLag time.Duration
+	// checkLag measures the replay lag of a replica.
+	checkLag func(ctx context.Context, db model.DBInterface) (time.Duration, error)
+	logger   *log.Logger
+}
+
+// newReplicaSet creates a replica set over the given replica databases.
+func newReplicaSet(replicas []*replica, maxLag time.Duration) *replicaSet {
+	return &replicaSet{
+		replicas: replicas,
+		maxLag:   maxLag,
+		checkLag: queryReplicaLag,
+		logger:   log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DBReplicaSet")),
+	}
+}
+
+// pick returns the next replica to read from, or nil when every replica lags too far behind.
+func (s *replicaSet) pick() *replica {
+	count := uint64(len(s.replicas))
+	if count == 0 {
+		return nil
+	}
+	start := s.next.Add(1)
+	for i := uint64(0); i < count; i++ {
+		r := s.replicas[(start+i)%count]
+		s.refreshLag(r)
+		if !r.lagging.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+// refreshLag starts a background lag measurement of the replica when the last one is stale.
+// Reads do not wait for the measurement.
+func (s *replicaSet) refreshLag(r *replica) {
+	if s.maxLag <= 0 {
+		return
+	}
+	if time.Since(time.Unix(0, r.lastCheck.Load())) < replicaLagCheckInterval {
+		return
+	}
+	if !r.checking.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer r.checking.Store(false)
+		s.measureLag(r)
+	}()
+}
+
+// measureLag measures the replay lag of the replica and records whether it is lagging.
+func (s *replicaSet) measureLag(r *replica) {
+	// Lag checks run in the background, outside any request.
+	ctx, cancel := context.WithTimeout(context.Background(), replicaLagCheckTimeout)
+	defer cancel()
+
+	lag, err := s.checkLag(ctx, r.db)
+	r.lastCheck.Store(time.Now().UnixNano())
+	if err != nil {
+		if !r.lagging.Swap(true) {
+			s.logger.Warn(ctx, "Excluding unreachable database replica from reads",
+				log.String("replica", r.name), log.Error(err))
+		}
+		return
+	}
+
+	lagging := lag > s.maxLag
+	if r.lagging.Swap(lagging) != lagging {
+		if lagging {
+			s.logger.Warn(ctx, "Excluding lagging database replica from reads",
+				log.String("replica", r.name), log.Int("lagMS", int(lag.Milliseconds())))
+		} else {
+			s.logger.Info(ctx, "Database replica caught up, resuming reads", log.String("replica", r.name))
+		}
+	}
+}
+
+// close closes the cached statements and the database of every replica.
+func (s *replicaSet) close() error {
+	var errs []error
+	for _, r := range s.replicas {
+		if err := errors.Join(r.stmts.close(), r.db.Close()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// queryReplicaLag measures the replay lag of a PostgreSQL standby.
+func queryReplicaLag(ctx context.Context, db model.DBInterface) (time.Duration, error) {
+	var lagMS float64
+	if err := db.GetSQLDB().QueryRowContext(ctx, replicaLagQuery).Scan(&lagMS); err != nil {
+		return 0, err
+	}
+	return time.Duration(lagMS * float64(time.Millisecond)), nil
+}
@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+type ReplicaTestSuite struct {
+	suite.Suite
+	primaryDB   *sql.DB
+	primaryMock sqlmock.Sqlmock
+	replicaDB   *sql.DB
+	replicaMock sqlmock.Sqlmock
+	client      *DBClient
+}
+
+func TestReplicaTestSuite(t *testing.T) {
+	suite.Run(t, new(ReplicaTestSuite))
+}
+
+func (suite *ReplicaTestSuite) SetupTest() {
+	var err error
+	suite.primaryDB, suite.primaryMock, err = sqlmock.New()
+	suite.Require().NoError(err)
+	suite.replicaDB, suite.replicaMock, err = sqlmock.New()
+	suite.Require().NoError(err)
+
+	suite.client = NewDBClient(model.NewDB(suite.primaryDB), "mock", "test",
+		retryConfig{MaxAttempts: -1}).(*DBClient)
+	suite.client.replicas = newReplicaSet(
+		[]*replica{{db: model.NewDB(suite.replicaDB), name: "replica-1"}}, 0)
+}
+
+func (suite *ReplicaTestSuite) TearDownTest() {
+	suite.NoError(suite.primaryMock.ExpectationsWereMet())
+	suite.NoError(suite.replicaMock.ExpectationsWereMet())
+}
+
+func selectQuery() model.DBQuery {
+	return model.DBQuery{ID: "RQ-01", Query: "SELECT ID FROM TOKEN WHERE ID = $1"}
+}
+
+func (suite *ReplicaTestSuite) TestQueryContext_ReadGoesToReplica() {
+	suite.replicaMock.ExpectQuery("SELECT ID FROM TOKEN").WithArgs("t1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("t1"))
+
+	results, err := suite.client.QueryContext(context.Background(), selectQuery(), "t1")
+
+	suite.NoError(err)
+	suite.Len(results, 1)
+}
+
+func (suite *ReplicaTestSuite) TestQueryContext_WriteWithReturningGoesToPrimary() {
+	query := model.DBQuery{ID: "RQ-02", Query: "UPDATE TOKEN SET STATE = $1 WHERE ID = $2 RETURNING ID"}
+	suite.primaryMock.ExpectQuery("UPDATE TOKEN").WithArgs("USED", "t1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("t1"))
+
+	_, err := suite.client.QueryContext(context.Background(), query, "USED", "t1")
+
+	suite.NoError(err)
+}
+
+func (suite *ReplicaTestSuite) TestQueryContext_PrimaryReadsContext() {
+	suite.primaryMock.ExpectQuery("SELECT ID FROM TOKEN").WithArgs("t1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("t1"))
+
+	_, err := suite.client.QueryContext(WithPrimaryReads(context.Background()), selectQuery(), "t1")
+
+	suite.NoError(err)
+}
+
+func (suite *ReplicaTestSuite) TestQueryContext_ReplicaFailureFallsBackToPrimary() {
+	suite.replicaMock.ExpectQuery("SELECT ID FROM TOKEN").WithArgs("t1").
+		WillReturnError(errors.New("connection refused"))
+	suite.primaryMock.ExpectQuery("SELECT ID FROM TOKEN").WithArgs("t1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("t1"))
+
+	results, err := suite.client.QueryContext(context.Background(), selectQuery(), "t1")
+
+	suite.NoError(err)
+	suite.Len(results, 1)
+}
+
+func (suite *ReplicaTestSuite) TestQueryContext_NoReplicaAvailableUsesPrimary() {
+	suite.client.replicas.replicas[0].lagging.Store(true)
+	suite.primaryMock.ExpectQuery("SELECT ID FROM TOKEN").WithArgs("t1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("t1"))
+
+	_, err := suite.client.QueryContext(context.Background(), selectQuery(), "t1")
+
+	suite.NoError(err)
+}
+
+func (suite *ReplicaTestSuite) TestExecuteContext_GoesToPrimary() {
+	query := model.DBQuery{ID: "RQ-03", Query: "DELETE FROM TOKEN WHERE ID = $1"}
+	suite.primaryMock.ExpectExec("DELETE FROM TOKEN").WithArgs("t1").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	affected, err := suite.client.ExecuteContext(context.Background(), query, "t1")
+
+	suite.NoError(err)
+	suite.Equal(int64(1), affected)
+}
+
+func (suite *ReplicaTestSuite) TestClose_ClosesReplicas() {
+	suite.primaryMock.ExpectClose()
+	suite.replicaMock.ExpectClose()
+
+	suite.NoError(suite.client.close())
+}
+
+func TestIsReadOnlyQuery(t *testing.T) {
+	testCases := []struct {
+		query    string
+		readOnly bool
+	}{
+		{"SELECT * FROM ENTITY", true},
+		{"  select id\n\tFROM ENTITY WHERE ID = $1", true},
+		{"SELECT * FROM JOB WHERE STATE = $1 FOR UPDATE SKIP LOCKED", false},
+		{"SELECT * FROM JOB\nFOR   SHARE", false},
+		{"UPDATE TOKEN SET STATE = $1 RETURNING ID", false},
+		{"INSERT INTO TOKEN (ID) VALUES ($1) RETURNING ID", false},
+		{"WITH deleted AS (DELETE FROM TOKEN RETURNING ID) SELECT COUNT(*) FROM deleted", false},
+	}
+	for _, tc := range testCases {
+		if got := isReadOnlyQuery(tc.query); got != tc.readOnly {
+			t.Errorf("isReadOnlyQuery(%q) = %v, want %v", tc.query, got, tc.readOnly)
+		}
+	}
+}
+
+func TestReplicaSet_PickRoundRobinSkipsLagging(t *testing.T) {
+	a := &replica{name: "a"}
+	b := &replica{name: "b"}
+	c := &replica{name: "c"}
+	set := newReplicaSet([]*replica{a, b, c}, 0)
+	b.lagging.Store(true)
+
+	picked := map[string]int{}
+	for range 4 {
+		picked[set.pick().name]++
+	}
+	if picked["b"] != 0 || picked["a"] == 0 || picked["c"] == 0 {
+		t.Fatalf("unexpected distribution %v", picked)
+	}
+
+	a.lagging.Store(true)
+	c.lagging.Store(true)
+	if r := set.pick(); r != nil {
+		t.Fatalf("expected no replica, got %s", r.name)
+	}
+}
+
+func TestReplicaSet_MeasureLag(t *testing.T) {
+	r := &replica{name: "a"}
+	set := newReplicaSet([]*replica{r}, 100*time.Millisecond)
+
+	set.checkLag = func(context.Context, model.DBInterface) (time.Duration, error) {
+		return 250 * time.Millisecond, nil
+	}
+	set.measureLag(r)
+	if !r.lagging.Load() {
+		t.Fatal("expected a replica beyond the tolerated lag to be excluded")
+	}
+
+	set.checkLag = func(context.Context, model.DBInterface) (time.Duration, error) {
+		return 20 * time.Millisecond, nil
+	}
+	set.measureLag(r)
+	if r.lagging.Load() {
+		t.Fatal("expected a caught-up replica to be included")
+	}
+
+	set.checkLag = func(context.Context, model.DBInterface) (time.Duration, error) {
+		return 0, errors.New("connection refused")
+	}
+	set.measureLag(r)
+	if !r.lagging.Load() {
+		t.Fatal("expected an unreachable replica to be excluded")
+	}
+	if r.lastCheck.Load() == 0 {
+		t.Fatal("expected the check time to be recorded")
+	}
+}
+
+func TestReplicaSet_RefreshLagSkipsRecentCheck(t *testing.T) {
+	r := &replica{name: "a"}
+	set := newReplicaSet([]*replica{r}, 100*time.Millisecond)
+	set.checkLag = func(context.Context, model.DBInterface) (time.Duration, error) {
+		t.Error("lag should not be measured again within the check interval")
+		return 0, nil
+	}
+	r.lastCheck.Store(time.Now().UnixNano())
+
+	set.refreshLag(r)
+	if r.checking.Load() {
+		t.Fatal("expected no lag check to start")
+	}
+}
+
+func TestMaxReplicaLag(t *testing.T) {
+	testCases := []struct {
+		configured int
+		want       time.Duration
+	}{
+		{0, defaultMaxReplicaLag},
+		{250, 250 * time.Millisecond},
+		{-1, 0},
+	}
+	for _, tc := range testCases {
+		if got := maxReplicaLag(tc.configured); got != tc.want {
+			t.Errorf("maxReplicaLag(%d) = %v, want %v", tc.configured, got, tc.want)
+		}
+	}
+}
+
+func TestOpenReplicas_RuntimeDatabaseIgnoresReplicas(t *testing.T) {
+	dataSource := config.DataSource{
+		Type: dataSourceTypePostgres,
+		Postgres: config.PostgresDataSource{
+			Replicas: []config.PostgresReplica{{Hostname: "replica.invalid", Port: 5432}},
+		},
+	}
+	if set := (&dbProvider{}).openReplicas(dataSource, dbNameRuntime, 0); set != nil {
+		t.Fatal("expected the runtime database to read from the primary only")
+	}
+}
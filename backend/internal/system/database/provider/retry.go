@@ -85,6 +85,17 @@ func withRetryDB(
 	dbType, dbName, queryID string,
 	retryConfig retryConfig,
 	fn func(context.Context) error,
+) error {
+	return withRetryDBOn(ctx, dbType, dbName, queryID, retryConfig, isRetryableDBError, fn)
+}
+
+// withRetryDBOn runs fn, retrying the failures for which retryable reports true.
+func withRetryDBOn(
+	ctx context.Context,
+	dbType, dbName, queryID string,
+	retryConfig retryConfig,
+	retryable func(error) bool,
+	fn func(context.Context) error,
 ) error {
 	config := normalizeRetryConfig(retryConfig)
 	if config.MaxAttempts <= 0 {
@@ -109,7 +120,7 @@ func withRetryDB(
 			return err
 		}
 
-		if !isRetryableDBError(err) {
+		if !retryable(err) {
 			recordDBOperationLatency(ctx, dbType, dbName, queryID, "failed", time.Since(start))
 			return err
 		}
@@ -206,6 +217,10 @@ func isRetryableDBError(err error) bool {
 		}
 	}
 
+	if isSQLiteBusyError(err) {
+		return true
+	}
+
 	if isTransientNetworkError(err) {
 		return true
 	}
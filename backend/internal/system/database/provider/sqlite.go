@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package provider
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	// sqliteDefaultJournalMode lets readers proceed while a write is in progress.
+	sqliteDefaultJournalMode = "WAL"
+	// sqliteDefaultBusyTimeoutMS is how long a connection waits for a lock held by another connection.
+	sqliteDefaultBusyTimeoutMS = "5000"
+	// sqliteDefaultTxLock starts transactions with the write lock taken, so that a transaction never
+	// fails upgrading a read lock held alongside another writer.
+	sqliteDefaultTxLock = "immediate"
+)
+
+// sqliteLegacyPragmaOptions maps connection options of other SQLite drivers to the pragma they set.
+// The driver in use ignores them, so they are rewritten as _pragma options.
+var sqliteLegacyPragmaOptions = []struct{ option, pragma string }{
+	{"_busy_timeout", "busy_timeout"},
+	{"_foreign_keys", "foreign_keys"},
+	{"_journal_mode", "journal_mode"},
+}
+
+// sqliteDefaultPragmas are the pragmas set on every connection unless the options set them.
+var sqliteDefaultPragmas = []struct{ name, value string }{
+	{"busy_timeout", sqliteDefaultBusyTimeoutMS},
+	{"foreign_keys", "1"},
+	{"journal_mode", sqliteDefaultJournalMode},
+}
+
+// normalizeSQLiteOptions returns the connection options of a SQLite data source with the WAL journal
+// mode, a busy timeout, foreign key enforcement and immediate transactions applied unless the options
+// set them. Options of other drivers are rewritten to the form the driver in use reads. Options that
+// cannot be parsed are returned unchanged so that the driver reports them.
+func normalizeSQLiteOptions(options string) string {
+	options = strings.TrimPrefix(options, "?")
+	values, err := url.ParseQuery(options)
+	if err != nil {
+		return "?" + options
+	}
+
+	for _, legacy := range sqliteLegacyPragmaOptions {
+		if value := values.Get(legacy.option); value != "" && !hasSQLitePragma(values, legacy.pragma) {
+			values.Add("_pragma", legacy.pragma+"("+value+")")
+		}
+		values.Del(legacy.option)
+	}
+	for _, pragma := range sqliteDefaultPragmas {
+		if !hasSQLitePragma(values, pragma.name) {
+			values.Add("_pragma", pragma.name+"("+pragma.value+")")
+		}
+	}
+	if values.Get("_txlock") == "" {
+		values.Set("_txlock", sqliteDefaultTxLock)
+	}
+	return "?" + values.Encode()
+}
+
+// hasSQLitePragma reports whether the options set the named pragma.
+func hasSQLitePragma(values url.Values, name string) bool {
+	for _, pragma := range values["_pragma"] {
+		pragma = strings.ToLower(strings.TrimSpace(pragma))
+		if end := strings.IndexAny(pragma, "(= "); end >= 0 {
+			pragma = pragma[:end]
+		}
+		if pragma == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isSQLiteBusyError reports whether err is SQLite failing to take a lock held by another connection.
+// The statement did not run, so it is safe to retry even when it writes.
+func isSQLiteBusyError(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	switch sqliteErr.Code() & 0xff {
+	case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+type SQLiteTestSuite struct {
+	suite.Suite
+	dbPath string
+}
+
+func TestSQLiteSuite(t *testing.T) {
+	suite.Run(t, new(SQLiteTestSuite))
+}
+
+func (suite *SQLiteTestSuite) SetupTest() {
+	suite.dbPath = filepath.Join(suite.T().TempDir(), "test.db")
+	db := suite.openDB("_pragma=journal_mode(WAL)")
+	_, err := db.Exec("CREATE TABLE ENTRY (ID INTEGER PRIMARY KEY, NAME TEXT)")
+	suite.Require().NoError(err)
+}
+
+// openDB opens the test database without a busy timeout, so lock contention fails at once.
+func (suite *SQLiteTestSuite) openDB(options string) *sql.DB {
+	db, err := sql.Open(dataSourceTypeSQLite, suite.dbPath+"?"+options)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+// lockDatabase holds the write lock of the test database until the returned function is called.
+func (suite *SQLiteTestSuite) lockDatabase() func() {
+	db := suite.openDB("_txlock=immediate")
+	tx, err := db.Begin()
+	suite.Require().NoError(err)
+	return func() { _ = tx.Rollback() }
+}
+
+func (suite *SQLiteTestSuite) TestNormalizeSQLiteOptions_AppliesDefaults() {
+	values, err := url.ParseQuery(normalizeSQLiteOptions("")[1:])
+	suite.Require().NoError(err)
+
+	suite.ElementsMatch([]string{"busy_timeout(5000)", "foreign_keys(1)", "journal_mode(WAL)"}, values["_pragma"])
+	suite.Equal("immediate", values.Get("_txlock"))
+}
+
+func (suite *SQLiteTestSuite) TestNormalizeSQLiteOptions_RewritesLegacyOptions() {
+	values, err := url.ParseQuery(normalizeSQLiteOptions("?_journal_mode=DELETE&_busy_timeout=100&_foreign_keys=0")[1:])
+	suite.Require().NoError(err)
+
+	suite.ElementsMatch([]string{"busy_timeout(100)", "foreign_keys(0)", "journal_mode(DELETE)"}, values["_pragma"])
+	suite.Empty(values.Get("_journal_mode"))
+	suite.Empty(values.Get("_busy_timeout"))
+	suite.Empty(values.Get("_foreign_keys"))
+}
+
+func (suite *SQLiteTestSuite) TestNormalizeSQLiteOptions_KeepsExplicitSettings() {
+	values, err := url.ParseQuery(normalizeSQLiteOptions(
+		"_pragma=busy_timeout(250)&_pragma=journal_mode = TRUNCATE&_busy_timeout=9000&_txlock=deferred")[1:])
+	suite.Require().NoError(err)
+
+	suite.ElementsMatch([]string{"busy_timeout(250)", "journal_mode = TRUNCATE", "foreign_keys(1)"}, values["_pragma"])
+	suite.Equal("deferred", values.Get("_txlock"))
+}
+
+func (suite *SQLiteTestSuite) TestNormalizeSQLiteOptions_InvalidOptionsUnchanged() {
+	suite.Equal("?_pragma=%zz", normalizeSQLiteOptions("_pragma=%zz"))
+}
+
+func (suite *SQLiteTestSuite) TestIsSQLiteBusyError() {
+	unlock := suite.lockDatabase()
+	defer unlock()
+
+	_, err := suite.openDB("").Exec("INSERT INTO ENTRY (NAME) VALUES ('a')")
+	suite.Require().Error(err)
+	suite.True(isSQLiteBusyError(err))
+	suite.True(isRetryableDBError(err))
+	suite.False(isSQLiteBusyError(sql.ErrNoRows))
+}
+
+func (suite *SQLiteTestSuite) TestExecuteContext_RetriesWhenBusy() {
+	client := NewDBClient(model.NewDB(suite.openDB("")), dataSourceTypeSQLite, "test",
+		retryConfig{MaxAttempts: 20, MinBackoff: 10 * time.Millisecond, MaxBackoff: 20 * time.Millisecond})
+	unlock := suite.lockDatabase()
+	time.AfterFunc(50*time.Millisecond, unlock)
+
+	affected, err := client.ExecuteContext(context.Background(),
+		model.DBQuery{ID: "insert_entry", Query: "INSERT INTO ENTRY (NAME) VALUES ('a')"})
+
+	suite.NoError(err)
+	suite.Equal(int64(1), affected)
+}
+
+func (suite *SQLiteTestSuite) TestExecuteContext_BusyWithoutRetries() {
+	client := NewDBClient(model.NewDB(suite.openDB("")), dataSourceTypeSQLite, "test", retryConfig{MaxAttempts: -1})
+	unlock := suite.lockDatabase()
+	defer unlock()
+
+	_, err := client.ExecuteContext(context.Background(),
+		model.DBQuery{ID: "insert_entry", Query: "INSERT INTO ENTRY (NAME) VALUES ('a')"})
+
+	suite.True(isSQLiteBusyError(err))
+}
+
+func (suite *SQLiteTestSuite) TestExecuteContext_SerializedWritesDoNotContend() {
+	// Each writer has its own pool, so without serialization they would contend for the lock.
+	writeMu := &sync.Mutex{}
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for range 20 {
+		client := NewDBClient(model.NewDB(suite.openDB("")), dataSourceTypeSQLite, "test",
+			retryConfig{MaxAttempts: -1}).(*DBClient)
+		client.writeMu = writeMu
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				if _, err := client.ExecuteContext(context.Background(),
+					model.DBQuery{ID: "insert_entry", Query: "INSERT INTO ENTRY (NAME) VALUES ('a')"}); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		suite.NoError(err)
+	}
+	rows, err := suite.openDB("").Query("SELECT COUNT(*) FROM ENTRY")
+	suite.Require().NoError(err)
+	defer func() { _ = rows.Close() }()
+	suite.Require().True(rows.Next())
+	var count int
+	suite.Require().NoError(rows.Scan(&count))
+	suite.Equal(200, count)
+}
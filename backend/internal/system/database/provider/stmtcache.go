@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provider
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+)
+
+// defaultStatementCacheSize is the number of prepared statements kept per connection pool when the
+// data source does not configure a size.
+const defaultStatementCacheSize = 200
+
+// cachedStmt is a prepared statement held by the statement cache.
+type cachedStmt struct {
+	queryID string
+	sql     string
+	stmt    *sql.Stmt
+	// refs counts the callers using the statement. An evicted statement is closed once the last
+	// caller releases it.
+	refs    int
+	evicted bool
+	elem    *list.Element
+}
+
+// stmtCache keeps prepared statements of a connection pool keyed by query ID, evicting the least
+// recently used statement when full. database/sql prepares a cached statement on each pooled
+// connection the first time it runs there, so repeated queries skip the parse and plan step.
+type stmtCache struct {
+	db     *sql.DB
+	dbName string
+	size   int
+	mu     sync.Mutex
+	stmts  map[string]*cachedStmt
+	lru    *list.List
+}
+
+// newStmtCache creates a statement cache over db. A size of zero or less disables caching and
+// returns nil.
+func newStmtCache(db *sql.DB, dbName string, size int) *stmtCache {
+	if size <= 0 {
+		return nil
+	}
+	return &stmtCache{
+		db:     db,
+		dbName: dbName,
+		size:   size,
+		stmts:  make(map[string]*cachedStmt),
+		lru:    list.New(),
+	}
+}
+
+// statementCacheSize resolves the configured cache size. Zero selects the default size and a
+// negative value disables caching.
+func statementCacheSize(configured int) int {
+	if configured == 0 {
+		return defaultStatementCacheSize
+	}
+	return max(configured, 0)
+}
+
+// acquire returns the prepared statement for a query, preparing it on a cache miss. The caller
+// must release the statement when done. A query ID whose SQL differs from the cached statement,
+// such as a query built with a variable number of filters, is not served from the cache and
+// acquire returns nil.
+func (c *stmtCache) acquire(ctx context.Context, queryID, sqlQuery string) (*cachedStmt, error) {
+	if c == nil || queryID == "" {
+		return nil, nil
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.stmts[queryID]; ok {
+		if entry.sql != sqlQuery {
+			c.mu.Unlock()
+			recordStatementCache(ctx, c.dbName, queryID, "bypass")
+			return nil, nil
+		}
+		entry.refs++
+		c.lru.MoveToFront(entry.elem)
+		c.mu.Unlock()
+		recordStatementCache(ctx, c.dbName, queryID, "hit")
+		return entry, nil
+	}
+	c.mu.Unlock()
+
+	recordStatementCache(ctx, c.dbName, queryID, "miss")
+	stmt, err := c.db.PrepareContext(ctx, sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.stmts[queryID]; ok {
+		// Another caller prepared the statement concurrently. Keep the cached one.
+		if closeErr := stmt.Close(); closeErr != nil {
+			return nil, closeErr
+		}
+		if existing.sql != sqlQuery {
+			return nil, nil
+		}
+		existing.refs++
+		c.lru.MoveToFront(existing.elem)
+		return existing, nil
+	}
+
+	entry := &cachedStmt{queryID: queryID, sql: sqlQuery, stmt: stmt, refs: 1}
+	entry.elem = c.lru.PushFront(entry)
+	c.stmts[queryID] = entry
+	if c.lru.Len() > c.size {
+		c.evictLocked(c.lru.Back().Value.(*cachedStmt))
+	}
+	return entry, nil
+}
+
+// release returns a statement obtained from acquire to the cache.
+func (c *stmtCache) release(entry *cachedStmt) {
+	if entry == nil {
+		return
+	}
+	c.mu.Lock()
+	entry.refs--
+	closeNow := entry.evicted && entry.refs == 0
+	c.mu.Unlock()
+	if closeNow {
+		_ = entry.stmt.Close()
+	}
+}
+
+// evictLocked removes a statement from the cache and closes it when no caller is using it.
+// The caller must hold the cache lock.
+func (c *stmtCache) evictLocked(entry *cachedStmt) {
+	c.lru.Remove(entry.elem)
+	delete(c.stmts, entry.queryID)
+	entry.evicted = true
+	if entry.refs == 0 {
+		_ = entry.stmt.Close()
+	}
+}
+
+// close closes every cached statement.
+func (c *stmtCache) close() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var errs []error
+	for _, entry := range c.stmts {
+		if err := entry.stmt.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	c.stmts = make(map[string]*cachedStmt)
+	c.lru.Init()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+type StmtCacheTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	client *DBClient
+}
+
+func TestStmtCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(StmtCacheTestSuite))
+}
+
+func (suite *StmtCacheTestSuite) SetupTest() {
+	var err error
+	suite.db, suite.mock, err = sqlmock.New()
+	suite.Require().NoError(err)
+
+	suite.client = NewDBClient(model.NewDB(suite.db), "postgres", "test",
+		retryConfig{MaxAttempts: -1}).(*DBClient)
+	suite.client.stmts = newStmtCache(suite.db, "test", 2)
+}
+
+func (suite *StmtCacheTestSuite) TearDownTest() {
+	suite.NoError(suite.mock.ExpectationsWereMet())
+}
+
+func (suite *StmtCacheTestSuite) TestQueryContext_PreparesOnce() {
+	query := model.DBQuery{ID: "SC-01", Query: "SELECT ID FROM ENTITY WHERE ID = $1"}
+	prepared := suite.mock.ExpectPrepare("SELECT ID FROM ENTITY")
+	prepared.ExpectQuery().WithArgs("e1").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("e1"))
+	prepared.ExpectQuery().WithArgs("e2").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("e2"))
+
+	first, err := suite.client.QueryContext(context.Background(), query, "e1")
+	suite.NoError(err)
+	second, err := suite.client.QueryContext(context.Background(), query, "e2")
+	suite.NoError(err)
+
+	suite.Equal("e1", first[0]["id"])
+	suite.Equal("e2", second[0]["id"])
+}
+
+func (suite *StmtCacheTestSuite) TestExecuteContext_UsesPreparedStatement() {
+	query := model.DBQuery{ID: "SC-02", Query: "DELETE FROM ENTITY WHERE ID = $1"}
+	prepared := suite.mock.ExpectPrepare("DELETE FROM ENTITY")
+	prepared.ExpectExec().WithArgs("e1").WillReturnResult(sqlmock.NewResult(0, 1))
+	prepared.ExpectExec().WithArgs("e2").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	affected, err := suite.client.ExecuteContext(context.Background(), query, "e1")
+	suite.NoError(err)
+	suite.Equal(int64(1), affected)
+	affected, err = suite.client.ExecuteContext(context.Background(), query, "e2")
+	suite.NoError(err)
+	suite.Equal(int64(0), affected)
+}
+
+func (suite *StmtCacheTestSuite) TestQueryContext_VaryingSQLBypassesCache() {
+	suite.mock.ExpectPrepare("SELECT ID FROM ENTITY WHERE OU_ID = \\$1$").
+		ExpectQuery().WithArgs("ou1").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	suite.mock.ExpectQuery("SELECT ID FROM ENTITY WHERE OU_ID = \\$1 AND TYPE = \\$2").
+		WithArgs("ou1", "employee").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := suite.client.QueryContext(context.Background(),
+		model.DBQuery{ID: "SC-03", Query: "SELECT ID FROM ENTITY WHERE OU_ID = $1"}, "ou1")
+	suite.NoError(err)
+	_, err = suite.client.QueryContext(context.Background(),
+		model.DBQuery{ID: "SC-03", Query: "SELECT ID FROM ENTITY WHERE OU_ID = $1 AND TYPE = $2"},
+		"ou1", "employee")
+	suite.NoError(err)
+}
+
+func (suite *StmtCacheTestSuite) TestQueryContext_EvictsLeastRecentlyUsed() {
+	first := suite.mock.ExpectPrepare("SELECT 1").WillBeClosed()
+	first.ExpectQuery().WillReturnRows(sqlmock.NewRows([]string{"n"}).AddRow(1))
+	suite.mock.ExpectPrepare("SELECT 2").ExpectQuery().WillReturnRows(sqlmock.NewRows([]string{"n"}).AddRow(2))
+	suite.mock.ExpectPrepare("SELECT 3").ExpectQuery().WillReturnRows(sqlmock.NewRows([]string{"n"}).AddRow(3))
+
+	for _, query := range []model.DBQuery{
+		{ID: "SC-10", Query: "SELECT 1"},
+		{ID: "SC-11", Query: "SELECT 2"},
+		{ID: "SC-12", Query: "SELECT 3"},
+	} {
+		_, err := suite.client.QueryContext(context.Background(), query)
+		suite.NoError(err)
+	}
+
+	suite.Len(suite.client.stmts.stmts, 2)
+	suite.NotContains(suite.client.stmts.stmts, "SC-10")
+}
+
+func (suite *StmtCacheTestSuite) TestQueryContext_TransactionSkipsCache() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectQuery("SELECT ID FROM ENTITY").WithArgs("e1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("e1"))
+	suite.mock.ExpectCommit()
+
+	tx, err := suite.db.Begin()
+	suite.Require().NoError(err)
+	ctx := transaction.WithKeyedTx(context.Background(), "test", tx)
+
+	_, err = suite.client.QueryContext(ctx,
+		model.DBQuery{ID: "SC-04", Query: "SELECT ID FROM ENTITY WHERE ID = $1"}, "e1")
+	suite.NoError(err)
+	suite.NoError(tx.Commit())
+	suite.Empty(suite.client.stmts.stmts)
+}
+
+func (suite *StmtCacheTestSuite) TestClose_ClosesStatements() {
+	suite.mock.ExpectPrepare("SELECT 1").WillBeClosed().
+		ExpectQuery().WillReturnRows(sqlmock.NewRows([]string{"n"}).AddRow(1))
+	suite.mock.ExpectClose()
+
+	_, err := suite.client.QueryContext(context.Background(), model.DBQuery{ID: "SC-05", Query: "SELECT 1"})
+	suite.NoError(err)
+
+	suite.NoError(suite.client.close())
+}
+
+func TestStatementCacheSize(t *testing.T) {
+	testCases := []struct {
+		configured int
+		expected   int
+	}{
+		{0, defaultStatementCacheSize},
+		{50, 50},
+		{-1, 0},
+	}
+	for _, tc := range testCases {
+		if got := statementCacheSize(tc.configured); got != tc.expected {
+			t.Errorf("statementCacheSize(%d) = %d, want %d", tc.configured, got, tc.expected)
+		}
+	}
+	if newStmtCache(nil, "test", 0) != nil {
+		t.Error("expected a zero size to disable the cache")
+	}
+}
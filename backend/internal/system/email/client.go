@@ -22,6 +22,9 @@ package email
 
 import "context"
 
+// maxProviderResponseSize bounds how much of an error response of an email provider API is read.
+const maxProviderResponseSize = 4096
+
 // EmailClientInterface defines the interface for sending emails.
 type EmailClientInterface interface {
 	// Send sends an email using the provided EmailData.
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// deliveryEventStoreInterface defines the storage operations for email delivery events.
+type deliveryEventStoreInterface interface {
+	insertEvent(ctx context.Context, event DeliveryEvent, expiry time.Time) error
+	listEvents(ctx context.Context, filter DeliveryEventFilter) ([]DeliveryEvent, error)
+}
+
+// deliveryEventStore is the operation database backed delivery event store.
+type deliveryEventStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDeliveryEventStore creates a new instance of deliveryEventStore.
+func newDeliveryEventStore() deliveryEventStoreInterface {
+	return &deliveryEventStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// insertEvent records a delivery event that is kept until the expiry.
+func (s *deliveryEventStore) insertEvent(ctx context.Context, event DeliveryEvent, expiry time.Time) error {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryInsertDeliveryEvent, event.ID, event.Provider, event.MessageID,
+		event.Recipient, event.Status, event.Detail, event.OccurredAt, event.CreatedAt, expiry,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to insert delivery event: %w", err)
+	}
+	return nil
+}
+
+// listEvents returns the latest delivery events that match the filter.
+func (s *deliveryEventStore) listEvents(ctx context.Context, filter DeliveryEventFilter) ([]DeliveryEvent,
+	error) {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListDeliveryEvents, s.deploymentID, filter.Recipient,
+		filter.MessageID, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delivery events: %w", err)
+	}
+
+	events := make([]DeliveryEvent, 0, len(results))
+	for _, row := range results {
+		event, err := buildDeliveryEventFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// buildDeliveryEventFromResultRow builds a delivery event from a database result row.
+func buildDeliveryEventFromResultRow(row map[string]interface{}) (DeliveryEvent, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return DeliveryEvent{}, fmt.Errorf("failed to parse id as string")
+	}
+	occurredAt, err := sysutils.ParseDBTimeField(row["occurred_at"], "occurred_at")
+	if err != nil {
+		return DeliveryEvent{}, err
+	}
+	createdAt, err := sysutils.ParseDBTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return DeliveryEvent{}, err
+	}
+
+	return DeliveryEvent{
+		ID:         id,
+		Provider:   sysutils.ConvertInterfaceValueToString(row["provider"]),
+		MessageID:  sysutils.ConvertInterfaceValueToString(row["message_id"]),
+		Recipient:  sysutils.ConvertInterfaceValueToString(row["recipient"]),
+		Status:     sysutils.ConvertInterfaceValueToString(row["status"]),
+		Detail:     sysutils.ConvertInterfaceValueToString(row["detail"]),
+		OccurredAt: occurredAt,
+		CreatedAt:  createdAt,
+	}, nil
+}
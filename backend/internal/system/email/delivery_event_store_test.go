@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment"
+
+type DeliveryEventStoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *deliveryEventStore
+}
+
+func TestDeliveryEventStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryEventStoreTestSuite))
+}
+
+func (suite *DeliveryEventStoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &deliveryEventStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *DeliveryEventStoreTestSuite) TestInsertEvent() {
+	occurredAt := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	createdAt := occurredAt.Add(time.Minute)
+	expiry := createdAt.Add(24 * time.Hour)
+	event := DeliveryEvent{ID: "event-id", Provider: "ses", MessageID: "msg-1", Recipient: "a@example.com",
+		Status: DeliveryStatusBounced, Detail: "Permanent", OccurredAt: occurredAt, CreatedAt: createdAt}
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryInsertDeliveryEvent, "event-id", "ses", "msg-1",
+		"a@example.com", DeliveryStatusBounced, "Permanent", occurredAt, createdAt, expiry, testDeploymentID).
+		Return(int64(1), nil)
+
+	err := suite.store.insertEvent(suite.ctx, event, expiry)
+
+	suite.NoError(err)
+}
+
+func (suite *DeliveryEventStoreTestSuite) TestInsertEvent_ClientError() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(nil, errors.New("no client"))
+
+	err := suite.store.insertEvent(suite.ctx, DeliveryEvent{}, time.Now())
+
+	suite.ErrorContains(err, "failed to get database client")
+}
+
+func (suite *DeliveryEventStoreTestSuite) TestListEvents() {
+	occurredAt := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListDeliveryEvents, testDeploymentID, "a@example.com",
+		"", 10).Return([]map[string]interface{}{{
+		"id":          "event-id",
+		"provider":    "sendgrid",
+		"message_id":  "msg-1",
+		"recipient":   "a@example.com",
+		"status":      DeliveryStatusDelivered,
+		"detail":      "250 OK",
+		"occurred_at": occurredAt,
+		"created_at":  occurredAt,
+	}}, nil)
+
+	events, err := suite.store.listEvents(suite.ctx, DeliveryEventFilter{Recipient: "a@example.com", Limit: 10})
+
+	suite.NoError(err)
+	suite.Equal([]DeliveryEvent{{ID: "event-id", Provider: "sendgrid", MessageID: "msg-1",
+		Recipient: "a@example.com", Status: DeliveryStatusDelivered, Detail: "250 OK", OccurredAt: occurredAt,
+		CreatedAt: occurredAt}}, events)
+}
+
+func (suite *DeliveryEventStoreTestSuite) TestListEvents_QueryError() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListDeliveryEvents, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+	_, err := suite.store.listEvents(suite.ctx, DeliveryEventFilter{Limit: 10})
+
+	suite.ErrorContains(err, "failed to list delivery events")
+}
+
+func (suite *DeliveryEventStoreTestSuite) TestListEvents_InvalidRow() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListDeliveryEvents, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return([]map[string]interface{}{{"id": 1}}, nil)
+
+	_, err := suite.store.listEvents(suite.ctx, DeliveryEventFilter{Limit: 10})
+
+	suite.ErrorContains(err, "failed to parse id")
+}
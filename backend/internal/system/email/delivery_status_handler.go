@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strconv"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	queryParamCallbackToken = "token"
+	queryParamRecipient     = "recipient"
+	queryParamMessageID     = "messageId"
+	queryParamLimit         = "limit"
+	maxCallbackPayloadSize  = 1 << 20
+)
+
+// deliveryStatusHandler handles the delivery status callbacks of the email providers and the
+// requests to list the recorded delivery events.
+type deliveryStatusHandler struct {
+	service       *deliveryStatusService
+	callbackToken string
+	logger        *log.Logger
+}
+
+// newDeliveryStatusHandler creates a new instance of deliveryStatusHandler.
+func newDeliveryStatusHandler(store deliveryEventStoreInterface,
+	deliveryConfig config.EmailDeliveryStatusConfig) *deliveryStatusHandler {
+	return &deliveryStatusHandler{
+		service:       newDeliveryStatusService(store, deliveryConfig.Retention()),
+		callbackToken: deliveryConfig.CallbackToken,
+		logger: log.GetLogger().With(
+			log.String(log.LoggerKeyComponentName, deliveryStatusLoggerComponentName)),
+	}
+}
+
+// HandleDeliveryStatusCallback handles POST /email/delivery-status/{provider}. The callback must carry
+// the configured callback token in the token query parameter.
+func (h *deliveryStatusHandler) HandleDeliveryStatusCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := r.URL.Query().Get(queryParamCallbackToken)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.callbackToken)) != 1 {
+		writeServiceError(ctx, w, http.StatusUnauthorized, &ErrorInvalidCallbackToken)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCallbackPayloadSize))
+	if err != nil {
+		writeServiceError(ctx, w, http.StatusBadRequest, &ErrorInvalidCallbackPayload)
+		return
+	}
+
+	now := h.service.now().UTC()
+	var events []DeliveryEvent
+	switch r.PathValue("provider") {
+	case config.EmailProviderSendGrid:
+		events, err = parseSendGridEvents(payload, now)
+	case config.EmailProviderSES:
+		events, err = h.parseSESCallback(ctx, payload)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		h.logger.Debug(ctx, "Failed to parse the delivery status callback", log.Error(err))
+		writeServiceError(ctx, w, http.StatusBadRequest, &ErrorInvalidCallbackPayload)
+		return
+	}
+
+	if err := h.service.recordEvents(ctx, events); err != nil {
+		h.logger.Error(ctx, "Failed to record email delivery events", log.Error(err))
+		writeServiceError(ctx, w, http.StatusInternalServerError, &tidcommon.InternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// parseSESCallback parses an SNS message posted to the SES callback. A subscription confirmation
+// yields no events; its confirmation URL is logged for the operator to confirm the subscription.
+func (h *deliveryStatusHandler) parseSESCallback(ctx context.Context, payload []byte) ([]DeliveryEvent, error) {
+	message, err := parseSNSMessage(payload)
+	if err != nil {
+		return nil, err
+	}
+	switch message.Type {
+	case snsTypeSubscriptionConfirmation:
+		h.logger.Info(ctx, "Received an SNS subscription confirmation for SES delivery status. "+
+			"Visit the subscribe URL to confirm the subscription", log.String("topicArn", message.TopicArn),
+			log.String("subscribeUrl", message.SubscribeURL))
+		return nil, nil
+	case snsTypeNotification:
+		return parseSESEvents(message.Message, h.service.now().UTC())
+	default:
+		return nil, nil
+	}
+}
+
+// HandleDeliveryEventListRequest handles GET /email/delivery-events.
+func (h *deliveryStatusHandler) HandleDeliveryEventListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	filter := DeliveryEventFilter{
+		Recipient: query.Get(queryParamRecipient),
+		MessageID: query.Get(queryParamMessageID),
+	}
+	if limit := query.Get(queryParamLimit); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed < 1 {
+			writeServiceError(ctx, w, http.StatusBadRequest, &ErrorInvalidLimit)
+			return
+		}
+		filter.Limit = parsed
+	}
+
+	events, svcErr := h.service.listEvents(ctx, filter)
+	if svcErr != nil {
+		statusCode := http.StatusInternalServerError
+		if svcErr.Type == tidcommon.ClientErrorType {
+			statusCode = http.StatusBadRequest
+		}
+		writeServiceError(ctx, w, statusCode, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, events)
+}
+
+// writeServiceError writes the error response of a service error.
+func writeServiceError(ctx context.Context, w http.ResponseWriter, statusCode int,
+	svcErr *tidcommon.ServiceError) {
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const testCallbackToken = "callback-token"
+
+type DeliveryStatusHandlerTestSuite struct {
+	suite.Suite
+	mockStore *deliveryEventStoreInterfaceMock
+	handler   *deliveryStatusHandler
+	now       time.Time
+}
+
+func TestDeliveryStatusHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryStatusHandlerTestSuite))
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) SetupSuite() {
+	testConfig := &config.Config{}
+	err := config.InitializeServerRuntime("", testConfig)
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	suite.mockStore = newDeliveryEventStoreInterfaceMock(suite.T())
+	suite.handler = newDeliveryStatusHandler(suite.mockStore, config.EmailDeliveryStatusConfig{
+		Enabled:       true,
+		CallbackToken: testCallbackToken,
+		RetentionDays: 7,
+	})
+	suite.handler.service.now = func() time.Time { return suite.now }
+	suite.handler.service.uuidGenerator = func() (string, error) { return "event-id", nil }
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) callback(provider, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/email/delivery-status/"+provider+"?token="+token,
+		strings.NewReader(body))
+	req.SetPathValue("provider", provider)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryStatusCallback(rr, req)
+	return rr
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestCallback_SendGrid() {
+	expected := DeliveryEvent{
+		ID:         "event-id",
+		Provider:   config.EmailProviderSendGrid,
+		MessageID:  "sg-1",
+		Recipient:  "a@example.com",
+		Status:     DeliveryStatusDelivered,
+		OccurredAt: suite.now,
+		CreatedAt:  suite.now,
+	}
+	suite.mockStore.On("insertEvent", mock.Anything, expected, suite.now.Add(7*24*time.Hour)).Return(nil)
+
+	rr := suite.callback(config.EmailProviderSendGrid, testCallbackToken,
+		`[{"email":"a@example.com","event":"delivered","sg_message_id":"sg-1.x"},{"event":"delivered"}]`)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.mockStore.AssertNumberOfCalls(suite.T(), "insertEvent", 1)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestCallback_SESNotification() {
+	notification, err := json.Marshal(snsMessage{
+		Type: snsTypeNotification,
+		Message: `{"eventType":"Complaint","mail":{"messageId":"ses-1"},"complaint":` +
+			`{"complainedRecipients":[{"emailAddress":"a@example.com"}],"complaintFeedbackType":"abuse"}}`,
+	})
+	suite.Require().NoError(err)
+	suite.mockStore.On("insertEvent", mock.Anything, mock.MatchedBy(func(event DeliveryEvent) bool {
+		return event.Provider == config.EmailProviderSES && event.MessageID == "ses-1" &&
+			event.Status == DeliveryStatusComplained && event.Detail == "abuse"
+	}), mock.Anything).Return(nil)
+
+	rr := suite.callback(config.EmailProviderSES, testCallbackToken, string(notification))
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestCallback_SESSubscriptionConfirmation() {
+	rr := suite.callback(config.EmailProviderSES, testCallbackToken,
+		`{"Type":"SubscriptionConfirmation","SubscribeURL":"https://sns.example.com/confirm"}`)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.mockStore.AssertNotCalled(suite.T(), "insertEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestCallback_InvalidToken() {
+	rr := suite.callback(config.EmailProviderSendGrid, "wrong", `[]`)
+
+	suite.Equal(http.StatusUnauthorized, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidCallbackToken.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestCallback_UnknownProvider() {
+	rr := suite.callback("mailgun", testCallbackToken, `[]`)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestCallback_InvalidPayload() {
+	rr := suite.callback(config.EmailProviderSendGrid, testCallbackToken, `not json`)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidCallbackPayload.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestCallback_StoreError() {
+	suite.mockStore.On("insertEvent", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	rr := suite.callback(config.EmailProviderSendGrid, testCallbackToken,
+		`[{"email":"a@example.com","event":"open","sg_message_id":"sg-1"}]`)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestListEvents_DefaultLimit() {
+	events := []DeliveryEvent{{ID: "event-id", Recipient: "a@example.com", Status: DeliveryStatusBounced}}
+	suite.mockStore.On("listEvents", mock.Anything, DeliveryEventFilter{Recipient: "a@example.com",
+		Limit: defaultDeliveryEventLimit}).Return(events, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/email/delivery-events?recipient=a@example.com", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryEventListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response []DeliveryEvent
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Equal("event-id", response[0].ID)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestListEvents_LimitIsCapped() {
+	suite.mockStore.On("listEvents", mock.Anything, DeliveryEventFilter{MessageID: "msg-1",
+		Limit: maxDeliveryEventLimit}).Return([]DeliveryEvent{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/email/delivery-events?messageId=msg-1&limit=10000", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryEventListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestListEvents_InvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/email/delivery-events?limit=abc", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryEventListRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidLimit.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestListEvents_StoreError() {
+	suite.mockStore.On("listEvents", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+	req := httptest.NewRequest(http.MethodGet, "/email/delivery-events", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryEventListRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestServiceListEvents_NegativeLimit() {
+	_, svcErr := suite.handler.service.listEvents(context.Background(), DeliveryEventFilter{Limit: -1})
+
+	suite.Equal(&ErrorInvalidLimit, svcErr)
+}
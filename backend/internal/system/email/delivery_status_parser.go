@@ -0,0 +1,244 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// SNS message types posted to the SES delivery status callback.
+const (
+	snsTypeNotification             = "Notification"
+	snsTypeSubscriptionConfirmation = "SubscriptionConfirmation"
+)
+
+// snsMessage is an Amazon SNS message posted to an HTTPS subscription.
+type snsMessage struct {
+	Type         string `json:"Type"`
+	TopicArn     string `json:"TopicArn"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+// sesRecipient is a recipient listed in an SES event.
+type sesRecipient struct {
+	EmailAddress   string `json:"emailAddress"`
+	DiagnosticCode string `json:"diagnosticCode"`
+}
+
+// sesEvent is an SES event notification or event publishing record delivered through SNS.
+type sesEvent struct {
+	EventType        string `json:"eventType"`
+	NotificationType string `json:"notificationType"`
+	Mail             struct {
+		MessageID   string   `json:"messageId"`
+		Timestamp   string   `json:"timestamp"`
+		Destination []string `json:"destination"`
+	} `json:"mail"`
+	Bounce *struct {
+		BounceType        string         `json:"bounceType"`
+		BouncedRecipients []sesRecipient `json:"bouncedRecipients"`
+		Timestamp         string         `json:"timestamp"`
+	} `json:"bounce"`
+	Complaint *struct {
+		ComplainedRecipients  []sesRecipient `json:"complainedRecipients"`
+		ComplaintFeedbackType string         `json:"complaintFeedbackType"`
+		Timestamp             string         `json:"timestamp"`
+	} `json:"complaint"`
+	Delivery *struct {
+		Recipients   []string `json:"recipients"`
+		SMTPResponse string   `json:"smtpResponse"`
+		Timestamp    string   `json:"timestamp"`
+	} `json:"delivery"`
+	DeliveryDelay *struct {
+		DelayType         string         `json:"delayType"`
+		DelayedRecipients []sesRecipient `json:"delayedRecipients"`
+		Timestamp         string         `json:"timestamp"`
+	} `json:"deliveryDelay"`
+	Reject *struct {
+		Reason string `json:"reason"`
+	} `json:"reject"`
+}
+
+// sendGridEvent is an event of the SendGrid event webhook.
+type sendGridEvent struct {
+	Email       string `json:"email"`
+	Timestamp   int64  `json:"timestamp"`
+	Event       string `json:"event"`
+	SGMessageID string `json:"sg_message_id"`
+	Reason      string `json:"reason"`
+	Response    string `json:"response"`
+}
+
+// sesStatuses maps the SES event types to delivery statuses.
+var sesStatuses = map[string]string{
+	"send":              DeliveryStatusSent,
+	"delivery":          DeliveryStatusDelivered,
+	"deliverydelay":     DeliveryStatusDeferred,
+	"bounce":            DeliveryStatusBounced,
+	"reject":            DeliveryStatusRejected,
+	"complaint":         DeliveryStatusComplained,
+	"open":              DeliveryStatusOpened,
+	"click":             DeliveryStatusClicked,
+	"subscription":      DeliveryStatusUnsubscribed,
+	"rendering failure": DeliveryStatusFailed,
+}
+
+// sendGridStatuses maps the SendGrid event types to delivery statuses.
+var sendGridStatuses = map[string]string{
+	"processed":         DeliveryStatusSent,
+	"delivered":         DeliveryStatusDelivered,
+	"deferred":          DeliveryStatusDeferred,
+	"bounce":            DeliveryStatusBounced,
+	"dropped":           DeliveryStatusDropped,
+	"spamreport":        DeliveryStatusComplained,
+	"open":              DeliveryStatusOpened,
+	"click":             DeliveryStatusClicked,
+	"unsubscribe":       DeliveryStatusUnsubscribed,
+	"group_unsubscribe": DeliveryStatusUnsubscribed,
+}
+
+// parseSNSMessage parses an SNS message posted to the SES delivery status callback.
+func parseSNSMessage(payload []byte) (*snsMessage, error) {
+	var message snsMessage
+	if err := json.Unmarshal(payload, &message); err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// parseSESEvents converts an SES event delivered in an SNS notification to one delivery event per
+// affected recipient. Times that are missing or malformed default to now.
+func parseSESEvents(message string, now time.Time) ([]DeliveryEvent, error) {
+	var event sesEvent
+	if err := json.Unmarshal([]byte(message), &event); err != nil {
+		return nil, err
+	}
+
+	eventType := event.EventType
+	if eventType == "" {
+		eventType = event.NotificationType
+	}
+	status := mapStatus(sesStatuses, eventType)
+	occurredAt := parseEventTime(event.Mail.Timestamp, now)
+	recipients := make([]sesRecipient, 0, len(event.Mail.Destination))
+	for _, address := range event.Mail.Destination {
+		recipients = append(recipients, sesRecipient{EmailAddress: address})
+	}
+	detail := ""
+
+	switch {
+	case event.Bounce != nil:
+		recipients = event.Bounce.BouncedRecipients
+		occurredAt = parseEventTime(event.Bounce.Timestamp, occurredAt)
+		detail = event.Bounce.BounceType
+	case event.Complaint != nil:
+		recipients = event.Complaint.ComplainedRecipients
+		occurredAt = parseEventTime(event.Complaint.Timestamp, occurredAt)
+		detail = event.Complaint.ComplaintFeedbackType
+	case event.Delivery != nil:
+		recipients = make([]sesRecipient, 0, len(event.Delivery.Recipients))
+		for _, address := range event.Delivery.Recipients {
+			recipients = append(recipients, sesRecipient{EmailAddress: address})
+		}
+		occurredAt = parseEventTime(event.Delivery.Timestamp, occurredAt)
+		detail = event.Delivery.SMTPResponse
+	case event.DeliveryDelay != nil:
+		recipients = event.DeliveryDelay.DelayedRecipients
+		occurredAt = parseEventTime(event.DeliveryDelay.Timestamp, occurredAt)
+		detail = event.DeliveryDelay.DelayType
+	case event.Reject != nil:
+		detail = event.Reject.Reason
+	}
+
+	events := make([]DeliveryEvent, 0, len(recipients))
+	for _, recipient := range recipients {
+		recipientDetail := detail
+		if recipient.DiagnosticCode != "" {
+			recipientDetail = strings.TrimSpace(detail + " " + recipient.DiagnosticCode)
+		}
+		events = append(events, DeliveryEvent{
+			Provider:   config.EmailProviderSES,
+			MessageID:  event.Mail.MessageID,
+			Recipient:  recipient.EmailAddress,
+			Status:     status,
+			Detail:     recipientDetail,
+			OccurredAt: occurredAt,
+		})
+	}
+	return events, nil
+}
+
+// parseSendGridEvents converts the events of a SendGrid event webhook request to delivery events.
+// The message ID is the X-Message-Id returned when the email was sent, without the suffix SendGrid
+// appends to it.
+func parseSendGridEvents(payload []byte, now time.Time) ([]DeliveryEvent, error) {
+	var sgEvents []sendGridEvent
+	if err := json.Unmarshal(payload, &sgEvents); err != nil {
+		return nil, err
+	}
+
+	events := make([]DeliveryEvent, 0, len(sgEvents))
+	for _, sgEvent := range sgEvents {
+		messageID, _, _ := strings.Cut(sgEvent.SGMessageID, ".")
+		occurredAt := now
+		if sgEvent.Timestamp > 0 {
+			occurredAt = time.Unix(sgEvent.Timestamp, 0).UTC()
+		}
+		detail := sgEvent.Reason
+		if detail == "" {
+			detail = sgEvent.Response
+		}
+		events = append(events, DeliveryEvent{
+			Provider:   config.EmailProviderSendGrid,
+			MessageID:  messageID,
+			Recipient:  sgEvent.Email,
+			Status:     mapStatus(sendGridStatuses, sgEvent.Event),
+			Detail:     detail,
+			OccurredAt: occurredAt,
+		})
+	}
+	return events, nil
+}
+
+// mapStatus returns the delivery status of a provider event type, or the lower-cased event type when
+// it is not known.
+func mapStatus(statuses map[string]string, eventType string) string {
+	eventType = strings.ToLower(strings.TrimSpace(eventType))
+	if status, ok := statuses[eventType]; ok {
+		return status
+	}
+	return eventType
+}
+
+// parseEventTime parses an RFC 3339 event time, or returns the fallback when it is missing or malformed.
+func parseEventTime(value string, fallback time.Time) time.Time {
+	if value == "" {
+		return fallback
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return fallback
+	}
+	return t.UTC()
+}
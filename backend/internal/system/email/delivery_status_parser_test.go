@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+var testParseNow = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+func TestParseSESEvents_Bounce(t *testing.T) {
+	message := `{"eventType":"Bounce","mail":{"messageId":"ses-1","timestamp":"2026-10-18T10:00:00.000Z",
+		"destination":["a@example.com","b@example.com"]},"bounce":{"bounceType":"Permanent",
+		"timestamp":"2026-10-18T10:00:05.000Z","bouncedRecipients":[{"emailAddress":"a@example.com",
+		"diagnosticCode":"smtp; 550 5.1.1 user unknown"}]}}`
+
+	events, err := parseSESEvents(message, testParseNow)
+
+	require.NoError(t, err)
+	require.Equal(t, []DeliveryEvent{{
+		Provider:   config.EmailProviderSES,
+		MessageID:  "ses-1",
+		Recipient:  "a@example.com",
+		Status:     DeliveryStatusBounced,
+		Detail:     "Permanent smtp; 550 5.1.1 user unknown",
+		OccurredAt: time.Date(2026, 10, 18, 10, 0, 5, 0, time.UTC),
+	}}, events)
+}
+
+func TestParseSESEvents_DeliveryNotification(t *testing.T) {
+	message := `{"notificationType":"Delivery","mail":{"messageId":"ses-2","destination":["a@example.com"]},
+		"delivery":{"recipients":["a@example.com"],"smtpResponse":"250 OK","timestamp":"invalid"}}`
+
+	events, err := parseSESEvents(message, testParseNow)
+
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, DeliveryStatusDelivered, events[0].Status)
+	require.Equal(t, "250 OK", events[0].Detail)
+	require.Equal(t, testParseNow, events[0].OccurredAt)
+}
+
+func TestParseSESEvents_EventWithoutRecipientsUsesDestination(t *testing.T) {
+	message := `{"eventType":"Open","mail":{"messageId":"ses-3","timestamp":"2026-10-18T09:00:00Z",
+		"destination":["a@example.com","b@example.com"]}}`
+
+	events, err := parseSESEvents(message, testParseNow)
+
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, DeliveryStatusOpened, events[1].Status)
+	require.Equal(t, "b@example.com", events[1].Recipient)
+}
+
+func TestParseSESEvents_InvalidMessage(t *testing.T) {
+	_, err := parseSESEvents("not json", testParseNow)
+
+	require.Error(t, err)
+}
+
+func TestParseSendGridEvents(t *testing.T) {
+	payload := []byte(`[
+		{"email":"a@example.com","timestamp":1792324800,"event":"bounce","sg_message_id":"sg-1.filter0001",
+			"reason":"550 mailbox unavailable"},
+		{"email":"b@example.com","event":"deferred","sg_message_id":"sg-2","response":"try later"},
+		{"email":"c@example.com","event":"custom_event","sg_message_id":"sg-3"}
+	]`)
+
+	events, err := parseSendGridEvents(payload, testParseNow)
+
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, DeliveryEvent{
+		Provider:   config.EmailProviderSendGrid,
+		MessageID:  "sg-1",
+		Recipient:  "a@example.com",
+		Status:     DeliveryStatusBounced,
+		Detail:     "550 mailbox unavailable",
+		OccurredAt: time.Unix(1792324800, 0).UTC(),
+	}, events[0])
+	require.Equal(t, DeliveryStatusDeferred, events[1].Status)
+	require.Equal(t, "try later", events[1].Detail)
+	require.Equal(t, testParseNow, events[1].OccurredAt)
+	require.Equal(t, "custom_event", events[2].Status)
+}
+
+func TestParseSendGridEvents_InvalidPayload(t *testing.T) {
+	_, err := parseSendGridEvents([]byte(`{"event":"open"}`), testParseNow)
+
+	require.Error(t, err)
+}
+
+func TestParseSNSMessage(t *testing.T) {
+	message, err := parseSNSMessage([]byte(`{"Type":"SubscriptionConfirmation",
+		"SubscribeURL":"https://sns.example.com/confirm"}`))
+
+	require.NoError(t, err)
+	require.Equal(t, snsTypeSubscriptionConfirmation, message.Type)
+	require.Equal(t, "https://sns.example.com/confirm", message.SubscribeURL)
+}
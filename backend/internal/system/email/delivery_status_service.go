@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"time"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	deliveryStatusLoggerComponentName = "EmailDeliveryStatusService"
+	defaultDeliveryEventLimit         = 50
+	maxDeliveryEventLimit             = 500
+)
+
+// deliveryStatusService records the delivery statuses reported by the email providers and lists them
+// for troubleshooting.
+type deliveryStatusService struct {
+	store         deliveryEventStoreInterface
+	retention     time.Duration
+	uuidGenerator func() (string, error)
+	now           func() time.Time
+	logger        *log.Logger
+}
+
+// newDeliveryStatusService creates a new delivery status service that keeps events for the retention.
+func newDeliveryStatusService(store deliveryEventStoreInterface, retention time.Duration) *deliveryStatusService {
+	return &deliveryStatusService{
+		store:         store,
+		retention:     retention,
+		uuidGenerator: sysutils.GenerateUUIDv7,
+		now:           time.Now,
+		logger: log.GetLogger().With(
+			log.String(log.LoggerKeyComponentName, deliveryStatusLoggerComponentName)),
+	}
+}
+
+// recordEvents records the delivery events. Events without a recipient are skipped.
+func (s *deliveryStatusService) recordEvents(ctx context.Context, events []DeliveryEvent) error {
+	now := s.now().UTC()
+	expiry := now.Add(s.retention)
+	for _, event := range events {
+		if event.Recipient == "" {
+			continue
+		}
+		id, err := s.uuidGenerator()
+		if err != nil {
+			return err
+		}
+		event.ID = id
+		event.CreatedAt = now
+		if err := s.store.insertEvent(ctx, event, expiry); err != nil {
+			return err
+		}
+		s.logger.Debug(ctx, "Recorded email delivery event", log.String("provider", event.Provider),
+			log.String("messageId", event.MessageID), log.MaskedString("recipient", event.Recipient),
+			log.String("status", event.Status))
+	}
+	return nil
+}
+
+// listEvents returns the latest delivery events that match the filter. A zero limit lists the
+// default number of events, and larger limits are capped.
+func (s *deliveryStatusService) listEvents(ctx context.Context, filter DeliveryEventFilter) ([]DeliveryEvent,
+	*tidcommon.ServiceError) {
+	if filter.Limit < 0 {
+		return nil, &ErrorInvalidLimit
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultDeliveryEventLimit
+	}
+	filter.Limit = min(filter.Limit, maxDeliveryEventLimit)
+
+	events, err := s.store.listEvents(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list email delivery events", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return events, nil
+}
@@ -18,7 +18,11 @@
 
 package email
 
-import "errors"
+import (
+	"errors"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
 
 // Client errors for email service
 var (
@@ -35,6 +39,12 @@ var (
 	// ErrorInvalidCredentials is returned when the SMTP username or password is empty but authentication is enabled.
 	ErrorInvalidCredentials = errors.New("invalid credentials: username and password cannot be empty " +
 		"when authentication is enabled")
+	// ErrorInvalidRegion is returned when the SES region is empty.
+	ErrorInvalidRegion = errors.New("invalid region: the SES region cannot be empty")
+	// ErrorInvalidAPICredentials is returned when the API key or access keys of a provider are empty.
+	ErrorInvalidAPICredentials = errors.New("invalid credentials: the provider API credentials cannot be empty")
+	// ErrorUnsupportedProvider is returned when the configured email provider is not supported.
+	ErrorUnsupportedProvider = errors.New("unsupported email provider")
 )
 
 // Server errors for email service
@@ -45,4 +55,52 @@ var (
 	ErrorSMTPAuth = errors.New("smtp authentication failed")
 	// ErrorEmailSendFailed is returned when the email fails to send.
 	ErrorEmailSendFailed = errors.New("email sending failed")
+	// ErrorProviderRequestFailed is returned when the API of an email provider rejects the request.
+	ErrorProviderRequestFailed = errors.New("email provider request failed")
+)
+
+// Client errors for the delivery status API.
+var (
+	// ErrorInvalidCallbackToken is returned when a delivery status callback does not carry the
+	// configured callback token.
+	ErrorInvalidCallbackToken = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "EML-1001",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.emailservice.invalid_callback_token",
+			DefaultValue: "Invalid callback token",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.emailservice.invalid_callback_token_description",
+			DefaultValue: "The delivery status callback does not carry a valid callback token",
+		},
+	}
+
+	// ErrorInvalidCallbackPayload is returned when the payload of a delivery status callback is malformed.
+	ErrorInvalidCallbackPayload = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "EML-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.emailservice.invalid_callback_payload",
+			DefaultValue: "Invalid callback payload",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.emailservice.invalid_callback_payload_description",
+			DefaultValue: "The delivery status callback payload is malformed",
+		},
+	}
+
+	// ErrorInvalidLimit is returned when the limit of the delivery event list is not a positive number.
+	ErrorInvalidLimit = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "EML-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.emailservice.invalid_limit",
+			DefaultValue: "Invalid limit",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.emailservice.invalid_limit_description",
+			DefaultValue: "The limit must be a positive number",
+		},
+	}
 )
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const failoverLoggerComponentName = "FailoverEmailClient"
+
+// namedEmailClient is an email client with the name of its provider.
+type namedEmailClient struct {
+	name   string
+	client EmailClientInterface
+}
+
+// failoverClient implements the EmailClientInterface by sending through the providers in order until
+// one of them accepts the email.
+type failoverClient struct {
+	clients []namedEmailClient
+}
+
+// newFailoverClient creates a new failover client over the providers in the given order.
+func newFailoverClient(clients []namedEmailClient) EmailClientInterface {
+	return &failoverClient{clients: clients}
+}
+
+// Send sends the email through the first provider that accepts it. An email that is invalid is not
+// retried on the next provider, since every provider would reject it.
+func (c *failoverClient) Send(ctx context.Context, emailData EmailData) error {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, failoverLoggerComponentName))
+
+	var errs []error
+	for _, provider := range c.clients {
+		err := provider.client.Send(ctx, emailData)
+		if err == nil {
+			return nil
+		}
+		if isInvalidEmailError(err) {
+			return err
+		}
+		logger.Warn(ctx, "Failed to send email, trying the next provider", log.String("provider", provider.name),
+			log.Error(err))
+		errs = append(errs, fmt.Errorf("%s: %w", provider.name, err))
+	}
+	return fmt.Errorf("%w: all providers failed: %w", ErrorEmailSendFailed, errors.Join(errs...))
+}
+
+// isInvalidEmailError reports whether the error is caused by the email rather than the provider.
+func isInvalidEmailError(err error) bool {
+	return errors.Is(err, ErrorInvalidRecipient) || errors.Is(err, ErrorInvalidSubject)
+}
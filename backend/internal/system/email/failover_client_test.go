@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type FailoverClientTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	emailData EmailData
+	primary   *EmailClientInterfaceMock
+	secondary *EmailClientInterfaceMock
+	client    EmailClientInterface
+}
+
+func TestFailoverClientTestSuite(t *testing.T) {
+	suite.Run(t, new(FailoverClientTestSuite))
+}
+
+func (suite *FailoverClientTestSuite) SetupSuite() {
+	testConfig := &config.Config{}
+	err := config.InitializeServerRuntime("", testConfig)
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *FailoverClientTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.emailData = EmailData{To: []string{"to@example.com"}, Subject: "Hi", Body: "b"}
+	suite.primary = NewEmailClientInterfaceMock(suite.T())
+	suite.secondary = NewEmailClientInterfaceMock(suite.T())
+	suite.client = newFailoverClient([]namedEmailClient{
+		{name: config.EmailProviderSES, client: suite.primary},
+		{name: config.EmailProviderSendGrid, client: suite.secondary},
+	})
+}
+
+func (suite *FailoverClientTestSuite) TestSend_PrimarySucceeds() {
+	suite.primary.On("Send", suite.ctx, suite.emailData).Return(nil)
+
+	err := suite.client.Send(suite.ctx, suite.emailData)
+
+	suite.NoError(err)
+	suite.secondary.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything)
+}
+
+func (suite *FailoverClientTestSuite) TestSend_FailsOverToSecondary() {
+	suite.primary.On("Send", suite.ctx, suite.emailData).Return(ErrorProviderRequestFailed)
+	suite.secondary.On("Send", suite.ctx, suite.emailData).Return(nil)
+
+	err := suite.client.Send(suite.ctx, suite.emailData)
+
+	suite.NoError(err)
+}
+
+func (suite *FailoverClientTestSuite) TestSend_AllProvidersFail() {
+	suite.primary.On("Send", suite.ctx, suite.emailData).Return(ErrorProviderRequestFailed)
+	suite.secondary.On("Send", suite.ctx, suite.emailData).Return(errors.New("timeout"))
+
+	err := suite.client.Send(suite.ctx, suite.emailData)
+
+	suite.ErrorIs(err, ErrorEmailSendFailed)
+	suite.ErrorIs(err, ErrorProviderRequestFailed)
+	suite.ErrorContains(err, "sendgrid: timeout")
+}
+
+func (suite *FailoverClientTestSuite) TestSend_InvalidEmailIsNotRetried() {
+	suite.primary.On("Send", suite.ctx, suite.emailData).Return(ErrorInvalidRecipient)
+
+	err := suite.client.Send(suite.ctx, suite.emailData)
+
+	suite.ErrorIs(err, ErrorInvalidRecipient)
+	suite.secondary.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything)
+}
+
+func (suite *FailoverClientTestSuite) TestNewEmailClientFromConfig_SingleProvider() {
+	client, err := newEmailClientFromConfig(config.EmailConfig{
+		Providers: []string{config.EmailProviderSendGrid},
+		SendGrid:  config.SendGridEmailConfig{APIKey: "key", FromAddress: "sender@example.com"},
+	})
+
+	suite.NoError(err)
+	suite.IsType(&sendGridClient{}, client)
+}
+
+func (suite *FailoverClientTestSuite) TestNewEmailClientFromConfig_SeveralProviders() {
+	client, err := newEmailClientFromConfig(config.EmailConfig{
+		Providers: []string{config.EmailProviderSES, config.EmailProviderSendGrid},
+		SES: config.SESEmailConfig{Region: "eu-west-1", AccessKeyID: "AKID", SecretAccessKey: "secret",
+			FromAddress: "sender@example.com"},
+		SendGrid: config.SendGridEmailConfig{APIKey: "key", FromAddress: "sender@example.com"},
+	})
+
+	suite.NoError(err)
+	failover, ok := client.(*failoverClient)
+	suite.True(ok)
+	suite.Len(failover.clients, 2)
+	suite.Equal(config.EmailProviderSES, failover.clients[0].name)
+	suite.Equal(config.EmailProviderSendGrid, failover.clients[1].name)
+}
+
+func (suite *FailoverClientTestSuite) TestNewEmailClientFromConfig_InvalidProviderConfig() {
+	_, err := newEmailClientFromConfig(config.EmailConfig{
+		Providers: []string{config.EmailProviderSendGrid},
+		SendGrid:  config.SendGridEmailConfig{FromAddress: "sender@example.com"},
+	})
+
+	suite.ErrorIs(err, ErrorInvalidAPICredentials)
+	suite.ErrorContains(err, "sendgrid")
+}
+
+func (suite *FailoverClientTestSuite) TestNewEmailClientFromConfig_UnsupportedProvider() {
+	_, err := newEmailClientFromConfig(config.EmailConfig{Providers: []string{"mailgun"}})
+
+	suite.ErrorIs(err, ErrorUnsupportedProvider)
+}
@@ -18,7 +18,89 @@
 
 package email
 
-// Initialize creates and returns the configured email client.
-func Initialize() (EmailClientInterface, error) {
-	return NewSMTPClientFromConfig()
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize creates and returns the configured email client. When delivery status recording is
+// enabled, it also registers the delivery status callback and delivery event routes.
+func Initialize(mux *http.ServeMux) (EmailClientInterface, error) {
+	emailConfig := config.GetServerRuntime().Config.Email
+	if emailConfig.DeliveryStatus.Enabled {
+		handler := newDeliveryStatusHandler(newDeliveryEventStore(), emailConfig.DeliveryStatus)
+		registerRoutes(mux, handler)
+	}
+	return newEmailClientFromConfig(emailConfig)
+}
+
+// newEmailClientFromConfig creates the email client of the configured providers. A single provider
+// is used directly, and several providers are tried in the configured order.
+func newEmailClientFromConfig(emailConfig config.EmailConfig) (EmailClientInterface, error) {
+	if len(emailConfig.Providers) == 0 {
+		return NewSMTPClientFromConfig()
+	}
+
+	clients := make([]namedEmailClient, 0, len(emailConfig.Providers))
+	for _, provider := range emailConfig.Providers {
+		client, err := newProviderClient(emailConfig, provider)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the %s email client: %w", provider, err)
+		}
+		clients = append(clients, namedEmailClient{name: provider, client: client})
+	}
+	if len(clients) == 1 {
+		return clients[0].client, nil
+	}
+	return newFailoverClient(clients), nil
+}
+
+// newProviderClient creates the email client of a provider.
+func newProviderClient(emailConfig config.EmailConfig, provider string) (EmailClientInterface, error) {
+	switch provider {
+	case config.EmailProviderSMTP:
+		return NewSMTPClientFromConfig()
+	case config.EmailProviderSES:
+		return newSESClient(sesConfig{
+			region: emailConfig.SES.Region,
+			credentials: awsCredentials{
+				accessKeyID:     emailConfig.SES.AccessKeyID,
+				secretAccessKey: emailConfig.SES.SecretAccessKey,
+				sessionToken:    emailConfig.SES.SessionToken,
+			},
+			from:             emailConfig.SES.FromAddress,
+			configurationSet: emailConfig.SES.ConfigurationSet,
+			endpoint:         emailConfig.SES.Endpoint,
+		})
+	case config.EmailProviderSendGrid:
+		return newSendGridClient(sendGridConfig{
+			apiKey:   emailConfig.SendGrid.APIKey,
+			from:     emailConfig.SendGrid.FromAddress,
+			endpoint: emailConfig.SendGrid.Endpoint,
+		})
+	default:
+		return nil, ErrorUnsupportedProvider
+	}
+}
+
+// registerRoutes registers the delivery status routes. The callback route is called by the email
+// providers rather than browsers, so it is registered without CORS.
+func registerRoutes(mux *http.ServeMux, handler *deliveryStatusHandler) {
+	mux.HandleFunc("POST /email/delivery-status/{provider}", handler.HandleDeliveryStatusCallback)
+
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /email/delivery-events",
+		handler.HandleDeliveryEventListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /email/delivery-events",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
 }
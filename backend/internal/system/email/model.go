@@ -18,6 +18,8 @@
 
 package email
 
+import "time"
+
 // EmailData represents the data structure for an email message.
 type EmailData struct {
 	To      []string `json:"to"`      // recipient email addresses
@@ -41,3 +43,37 @@ type smtpConfig struct {
 type smtpClient struct {
 	config smtpConfig
 }
+
+// Delivery statuses recorded from the callbacks of the email providers.
+const (
+	DeliveryStatusSent         = "sent"
+	DeliveryStatusDelivered    = "delivered"
+	DeliveryStatusDeferred     = "deferred"
+	DeliveryStatusBounced      = "bounced"
+	DeliveryStatusDropped      = "dropped"
+	DeliveryStatusRejected     = "rejected"
+	DeliveryStatusComplained   = "complained"
+	DeliveryStatusOpened       = "opened"
+	DeliveryStatusClicked      = "clicked"
+	DeliveryStatusUnsubscribed = "unsubscribed"
+	DeliveryStatusFailed       = "failed"
+)
+
+// DeliveryEvent is a delivery status reported by an email provider for one recipient of a message.
+type DeliveryEvent struct {
+	ID         string    `json:"id"`
+	Provider   string    `json:"provider"`
+	MessageID  string    `json:"messageId,omitempty"`
+	Recipient  string    `json:"recipient"`
+	Status     string    `json:"status"`
+	Detail     string    `json:"detail,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// DeliveryEventFilter narrows the listed delivery events. Empty fields match all.
+type DeliveryEventFilter struct {
+	Recipient string
+	MessageID string
+	Limit     int
+}
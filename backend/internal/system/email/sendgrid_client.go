@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	sendGridLoggerComponentName = "SendGridEmailClient"
+	sendGridDefaultEndpoint     = "https://api.sendgrid.com/v3/mail/send"
+	providerHTTPTimeout         = 30 * time.Second
+)
+
+// sendGridConfig holds the settings of the SendGrid client.
+type sendGridConfig struct {
+	apiKey   string
+	from     string
+	endpoint string
+}
+
+// sendGridClient implements the EmailClientInterface using the SendGrid mail send API.
+type sendGridClient struct {
+	config     sendGridConfig
+	httpClient syshttp.HTTPClientInterface
+}
+
+// sendGridAddress is an email address in a SendGrid request.
+type sendGridAddress struct {
+	Email string `json:"email"`
+}
+
+// sendGridPersonalization is the recipients block of a SendGrid request.
+type sendGridPersonalization struct {
+	To  []sendGridAddress `json:"to"`
+	CC  []sendGridAddress `json:"cc,omitempty"`
+	BCC []sendGridAddress `json:"bcc,omitempty"`
+}
+
+// sendGridContent is a body of a SendGrid request.
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// sendGridRequest is the request body of the SendGrid mail send API.
+type sendGridRequest struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+}
+
+// newSendGridClient creates a new instance of sendGridClient after validating the configuration.
+func newSendGridClient(config sendGridConfig) (EmailClientInterface, error) {
+	config.from = strings.TrimSpace(config.from)
+	if config.from == "" || !IsValidEmail(config.from) {
+		return nil, ErrorInvalidSender
+	}
+	if strings.TrimSpace(config.apiKey) == "" {
+		return nil, ErrorInvalidAPICredentials
+	}
+	if config.endpoint == "" {
+		config.endpoint = sendGridDefaultEndpoint
+	}
+	return &sendGridClient{
+		config:     config,
+		httpClient: syshttp.NewHTTPClientWithTimeout(providerHTTPTimeout),
+	}, nil
+}
+
+// Send sends the email through the SendGrid mail send API.
+func (c *sendGridClient) Send(ctx context.Context, emailData EmailData) error {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, sendGridLoggerComponentName))
+
+	if _, err := validateRecipients(&emailData); err != nil {
+		return err
+	}
+
+	personalization := sendGridPersonalization{
+		To:  toSendGridAddresses(emailData.To),
+		CC:  toSendGridAddresses(emailData.CC),
+		BCC: toSendGridAddresses(emailData.BCC),
+	}
+	contentType := "text/plain"
+	if emailData.IsHTML {
+		contentType = "text/html"
+	}
+	payload, err := json.Marshal(sendGridRequest{
+		Personalizations: []sendGridPersonalization{personalization},
+		From:             sendGridAddress{Email: c.config.from},
+		Subject:          emailData.Subject,
+		Content:          []sendGridContent{{Type: contentType, Value: emailData.Body}},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrorEmailSendFailed, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrorEmailSendFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.config.apiKey)
+
+	logger.Debug(ctx, "Sending email via SendGrid", log.Int("recipientCount", len(emailData.To)))
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrorProviderRequestFailed, err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.Error(ctx, "Failed to close response body", log.Error(closeErr))
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxProviderResponseSize))
+		return fmt.Errorf("%w: sendgrid returned status %d: %s", ErrorProviderRequestFailed, resp.StatusCode,
+			string(body))
+	}
+
+	logger.Debug(ctx, "Email sent successfully via SendGrid",
+		log.String("messageId", resp.Header.Get("X-Message-Id")))
+	return nil
+}
+
+// toSendGridAddresses converts the addresses to SendGrid addresses.
+func toSendGridAddresses(addresses []string) []sendGridAddress {
+	if len(addresses) == 0 {
+		return nil
+	}
+	result := make([]sendGridAddress, 0, len(addresses))
+	for _, address := range addresses {
+		result = append(result, sendGridAddress{Email: address})
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+type SendGridClientTestSuite struct {
+	suite.Suite
+}
+
+func TestSendGridClientTestSuite(t *testing.T) {
+	suite.Run(t, new(SendGridClientTestSuite))
+}
+
+func (suite *SendGridClientTestSuite) SetupSuite() {
+	testConfig := &config.Config{}
+	err := config.InitializeServerRuntime("", testConfig)
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *SendGridClientTestSuite) TestNewSendGridClient_Success() {
+	client, err := newSendGridClient(sendGridConfig{apiKey: "key", from: "sender@example.com"})
+
+	suite.NoError(err)
+	sgClient, ok := client.(*sendGridClient)
+	suite.True(ok)
+	suite.Equal(sendGridDefaultEndpoint, sgClient.config.endpoint)
+}
+
+func (suite *SendGridClientTestSuite) TestNewSendGridClient_InvalidFrom_Error() {
+	_, err := newSendGridClient(sendGridConfig{apiKey: "key", from: "not-an-email"})
+
+	suite.ErrorIs(err, ErrorInvalidSender)
+}
+
+func (suite *SendGridClientTestSuite) TestNewSendGridClient_EmptyAPIKey_Error() {
+	_, err := newSendGridClient(sendGridConfig{apiKey: " ", from: "sender@example.com"})
+
+	suite.ErrorIs(err, ErrorInvalidAPICredentials)
+}
+
+func (suite *SendGridClientTestSuite) TestSend_Success() {
+	var received sendGridRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("Bearer key", r.Header.Get("Authorization"))
+		suite.Equal("application/json", r.Header.Get("Content-Type"))
+		suite.NoError(json.NewDecoder(r.Body).Decode(&received))
+		w.Header().Set("X-Message-Id", "msg-1")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client, err := newSendGridClient(sendGridConfig{apiKey: "key", from: "sender@example.com",
+		endpoint: server.URL})
+	suite.NoError(err)
+
+	err = client.Send(context.Background(), EmailData{
+		To:      []string{"to@example.com"},
+		CC:      []string{"cc@example.com"},
+		Subject: "Hello",
+		Body:    "<p>Hi</p>",
+		IsHTML:  true,
+	})
+
+	suite.NoError(err)
+	suite.Equal("sender@example.com", received.From.Email)
+	suite.Equal("Hello", received.Subject)
+	suite.Len(received.Personalizations, 1)
+	suite.Equal([]sendGridAddress{{Email: "to@example.com"}}, received.Personalizations[0].To)
+	suite.Equal([]sendGridAddress{{Email: "cc@example.com"}}, received.Personalizations[0].CC)
+	suite.Nil(received.Personalizations[0].BCC)
+	suite.Equal([]sendGridContent{{Type: "text/html", Value: "<p>Hi</p>"}}, received.Content)
+}
+
+func (suite *SendGridClientTestSuite) TestSend_ErrorStatus() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"bad key"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := newSendGridClient(sendGridConfig{apiKey: "key", from: "sender@example.com",
+		endpoint: server.URL})
+	suite.NoError(err)
+
+	err = client.Send(context.Background(), EmailData{To: []string{"to@example.com"}, Subject: "Hi", Body: "b"})
+
+	suite.ErrorIs(err, ErrorProviderRequestFailed)
+	suite.ErrorContains(err, "bad key")
+}
+
+func (suite *SendGridClientTestSuite) TestSend_InvalidRecipient_Error() {
+	client, err := newSendGridClient(sendGridConfig{apiKey: "key", from: "sender@example.com"})
+	suite.NoError(err)
+
+	err = client.Send(context.Background(), EmailData{Subject: "Hi", Body: "b"})
+
+	suite.ErrorIs(err, ErrorInvalidRecipient)
+}
+
+func (suite *SendGridClientTestSuite) TestSend_TransportError() {
+	mockHTTP := httpmock.NewHTTPClientInterfaceMock(suite.T())
+	mockHTTP.On("Do", mock.Anything).Return(nil, errors.New("connection refused"))
+	client := &sendGridClient{
+		config:     sendGridConfig{apiKey: "key", from: "sender@example.com", endpoint: "http://127.0.0.1:0"},
+		httpClient: mockHTTP,
+	}
+
+	err := client.Send(context.Background(), EmailData{To: []string{"to@example.com"}, Subject: "Hi", Body: "b"})
+
+	suite.ErrorIs(err, ErrorProviderRequestFailed)
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	sesLoggerComponentName = "SESEmailClient"
+	sesEndpointFormat      = "https://email.%s.amazonaws.com/v2/email/outbound-emails"
+	sesSigningService      = "ses"
+	sesCharset             = "UTF-8"
+)
+
+// sesConfig holds the settings of the Amazon SES client.
+type sesConfig struct {
+	region           string
+	credentials      awsCredentials
+	from             string
+	configurationSet string
+	endpoint         string
+}
+
+// sesClient implements the EmailClientInterface using the Amazon SES v2 SendEmail API.
+type sesClient struct {
+	config     sesConfig
+	httpClient syshttp.HTTPClientInterface
+	now        func() time.Time
+}
+
+// sesContent is a text block of an SES request.
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+// sesBody is the body of an SES message, holding either the HTML or the text part.
+type sesBody struct {
+	HTML *sesContent `json:"Html,omitempty"`
+	Text *sesContent `json:"Text,omitempty"`
+}
+
+// sesDestination holds the recipients of an SES request.
+type sesDestination struct {
+	ToAddresses  []string `json:"ToAddresses,omitempty"`
+	CcAddresses  []string `json:"CcAddresses,omitempty"`
+	BccAddresses []string `json:"BccAddresses,omitempty"`
+}
+
+// sesSimpleMessage is a message SES formats from its subject and body.
+type sesSimpleMessage struct {
+	Subject sesContent `json:"Subject"`
+	Body    sesBody    `json:"Body"`
+}
+
+// sesEmailContent holds the message of an SES request.
+type sesEmailContent struct {
+	Simple sesSimpleMessage `json:"Simple"`
+}
+
+// sesSendEmailRequest is the request body of the SES v2 SendEmail API.
+type sesSendEmailRequest struct {
+	FromEmailAddress     string          `json:"FromEmailAddress"`
+	Destination          sesDestination  `json:"Destination"`
+	Content              sesEmailContent `json:"Content"`
+	ConfigurationSetName string          `json:"ConfigurationSetName,omitempty"`
+}
+
+// sesSendEmailResponse is the response body of the SES v2 SendEmail API.
+type sesSendEmailResponse struct {
+	MessageID string `json:"MessageId"`
+}
+
+// newSESClient creates a new instance of sesClient after validating the configuration.
+func newSESClient(config sesConfig) (EmailClientInterface, error) {
+	config.from = strings.TrimSpace(config.from)
+	if config.from == "" || !IsValidEmail(config.from) {
+		return nil, ErrorInvalidSender
+	}
+	if strings.TrimSpace(config.region) == "" {
+		return nil, ErrorInvalidRegion
+	}
+	if config.credentials.accessKeyID == "" || config.credentials.secretAccessKey == "" {
+		return nil, ErrorInvalidAPICredentials
+	}
+	if config.endpoint == "" {
+		config.endpoint = fmt.Sprintf(sesEndpointFormat, config.region)
+	}
+	return &sesClient{
+		config:     config,
+		httpClient: syshttp.NewHTTPClientWithTimeout(providerHTTPTimeout),
+		now:        time.Now,
+	}, nil
+}
+
+// Send sends the email through the Amazon SES v2 SendEmail API.
+func (c *sesClient) Send(ctx context.Context, emailData EmailData) error {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, sesLoggerComponentName))
+
+	if _, err := validateRecipients(&emailData); err != nil {
+		return err
+	}
+
+	body := sesBody{}
+	if emailData.IsHTML {
+		body.HTML = &sesContent{Data: emailData.Body, Charset: sesCharset}
+	} else {
+		body.Text = &sesContent{Data: emailData.Body, Charset: sesCharset}
+	}
+	payload, err := json.Marshal(sesSendEmailRequest{
+		FromEmailAddress: c.config.from,
+		Destination: sesDestination{
+			ToAddresses:  emailData.To,
+			CcAddresses:  emailData.CC,
+			BccAddresses: emailData.BCC,
+		},
+		Content: sesEmailContent{Simple: sesSimpleMessage{
+			Subject: sesContent{Data: emailData.Subject, Charset: sesCharset},
+			Body:    body,
+		}},
+		ConfigurationSetName: c.config.configurationSet,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrorEmailSendFailed, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrorEmailSendFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	signSigV4(req, payload, c.config.credentials, c.config.region, sesSigningService, c.now())
+
+	logger.Debug(ctx, "Sending email via SES", log.Int("recipientCount", len(emailData.To)))
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrorProviderRequestFailed, err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.Error(ctx, "Failed to close response body", log.Error(closeErr))
+		}
+	}()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxProviderResponseSize))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: ses returned status %d: %s", ErrorProviderRequestFailed, resp.StatusCode,
+			string(respBody))
+	}
+
+	var result sesSendEmailResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		logger.Warn(ctx, "Failed to parse the SES response", log.Error(err))
+	}
+	logger.Debug(ctx, "Email sent successfully via SES", log.String("messageId", result.MessageID))
+	return nil
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type SESClientTestSuite struct {
+	suite.Suite
+}
+
+func TestSESClientTestSuite(t *testing.T) {
+	suite.Run(t, new(SESClientTestSuite))
+}
+
+func (suite *SESClientTestSuite) SetupSuite() {
+	testConfig := &config.Config{}
+	err := config.InitializeServerRuntime("", testConfig)
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *SESClientTestSuite) getValidSESConfig(endpoint string) sesConfig {
+	return sesConfig{
+		region:           "us-east-1",
+		credentials:      awsCredentials{accessKeyID: "AKID", secretAccessKey: "secret"},
+		from:             "sender@example.com",
+		configurationSet: "tracking",
+		endpoint:         endpoint,
+	}
+}
+
+func (suite *SESClientTestSuite) TestNewSESClient_DefaultEndpoint() {
+	client, err := newSESClient(suite.getValidSESConfig(""))
+
+	suite.NoError(err)
+	sesCl, ok := client.(*sesClient)
+	suite.True(ok)
+	suite.Equal("https://email.us-east-1.amazonaws.com/v2/email/outbound-emails", sesCl.config.endpoint)
+}
+
+func (suite *SESClientTestSuite) TestNewSESClient_ValidationErrors() {
+	testCases := []struct {
+		name     string
+		modify   func(conf *sesConfig)
+		expected error
+	}{
+		{"InvalidFrom", func(conf *sesConfig) { conf.from = "" }, ErrorInvalidSender},
+		{"EmptyRegion", func(conf *sesConfig) { conf.region = " " }, ErrorInvalidRegion},
+		{"EmptyAccessKey", func(conf *sesConfig) { conf.credentials.accessKeyID = "" }, ErrorInvalidAPICredentials},
+		{"EmptySecret", func(conf *sesConfig) { conf.credentials.secretAccessKey = "" }, ErrorInvalidAPICredentials},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			conf := suite.getValidSESConfig("")
+			tc.modify(&conf)
+
+			_, err := newSESClient(conf)
+
+			suite.ErrorIs(err, tc.expected)
+		})
+	}
+}
+
+func (suite *SESClientTestSuite) TestSend_Success() {
+	var received sesSendEmailRequest
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		suite.NoError(json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(`{"MessageId":"msg-1"}`))
+	}))
+	defer server.Close()
+
+	client, err := newSESClient(suite.getValidSESConfig(server.URL))
+	suite.NoError(err)
+	client.(*sesClient).now = func() time.Time { return time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC) }
+
+	err = client.Send(context.Background(), EmailData{
+		To:      []string{"to@example.com"},
+		BCC:     []string{"bcc@example.com"},
+		Subject: "Hello",
+		Body:    "Hi",
+	})
+
+	suite.NoError(err)
+	suite.True(strings.HasPrefix(authorization,
+		"AWS4-HMAC-SHA256 Credential=AKID/20261018/us-east-1/ses/aws4_request"))
+	suite.Equal("sender@example.com", received.FromEmailAddress)
+	suite.Equal([]string{"to@example.com"}, received.Destination.ToAddresses)
+	suite.Equal([]string{"bcc@example.com"}, received.Destination.BccAddresses)
+	suite.Equal("Hello", received.Content.Simple.Subject.Data)
+	suite.Nil(received.Content.Simple.Body.HTML)
+	suite.Equal("Hi", received.Content.Simple.Body.Text.Data)
+	suite.Equal("tracking", received.ConfigurationSetName)
+}
+
+func (suite *SESClientTestSuite) TestSend_ErrorStatus() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Email address is not verified"}`))
+	}))
+	defer server.Close()
+
+	client, err := newSESClient(suite.getValidSESConfig(server.URL))
+	suite.NoError(err)
+
+	err = client.Send(context.Background(), EmailData{To: []string{"to@example.com"}, Subject: "Hi", Body: "b"})
+
+	suite.ErrorIs(err, ErrorProviderRequestFailed)
+	suite.ErrorContains(err, "not verified")
+}
+
+func (suite *SESClientTestSuite) TestSend_InvalidSubject_Error() {
+	client, err := newSESClient(suite.getValidSESConfig(""))
+	suite.NoError(err)
+
+	err = client.Send(context.Background(), EmailData{To: []string{"to@example.com"}, Subject: "Hi\r\nBcc: x"})
+
+	suite.ErrorIs(err, ErrorInvalidSubject)
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSignSigV4_GetVanilla verifies the signer against the get-vanilla case of the AWS SigV4 test suite.
+func TestSignSigV4_GetVanilla(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	require.NoError(t, err)
+	credentials := awsCredentials{
+		accessKeyID:     "AKIDEXAMPLE",
+		secretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}
+
+	signSigV4(req, nil, credentials, "us-east-1", "service", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	require.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	require.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+		"SignedHeaders=host;x-amz-date, "+
+		"Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		req.Header.Get("Authorization"))
+}
+
+func TestSignSigV4_SignsContentTypeAndSessionToken(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://email.us-east-1.amazonaws.com/v2/email/outbound-emails",
+		nil)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	signSigV4(req, []byte("{}"), awsCredentials{accessKeyID: "AKID", secretAccessKey: "secret",
+		sessionToken: "token"}, "us-east-1", "ses", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	require.Equal(t, "token", req.Header.Get("X-Amz-Security-Token"))
+	require.Contains(t, req.Header.Get("Authorization"),
+		"Credential=AKID/20260102/us-east-1/ses/aws4_request, "+
+			"SignedHeaders=content-type;host;x-amz-date;x-amz-security-token, Signature=")
+}
+
+func TestCanonicalQueryString(t *testing.T) {
+	query := map[string][]string{"b": {"2", "1"}, "a": {"x y"}}
+
+	require.Equal(t, "a=x%20y&b=1&b=2", canonicalQueryString(query))
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryInsertDeliveryEvent records a delivery event.
+	queryInsertDeliveryEvent = dbmodel.DBQuery{
+		ID: "EMQ-DE-01",
+		Query: `INSERT INTO "EMAIL_DELIVERY_EVENT" (ID, PROVIDER, MESSAGE_ID, RECIPIENT, STATUS, DETAIL, ` +
+			`OCCURRED_AT, CREATED_AT, EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+	}
+
+	// queryListDeliveryEvents lists the latest delivery events, optionally of a recipient or message.
+	queryListDeliveryEvents = dbmodel.DBQuery{
+		ID: "EMQ-DE-02",
+		Query: `SELECT ID, PROVIDER, MESSAGE_ID, RECIPIENT, STATUS, DETAIL, OCCURRED_AT, CREATED_AT ` +
+			`FROM "EMAIL_DELIVERY_EVENT" WHERE DEPLOYMENT_ID = $1 AND ($2 = '' OR RECIPIENT = $2) ` +
+			`AND ($3 = '' OR MESSAGE_ID = $3) ORDER BY OCCURRED_AT DESC, ID DESC LIMIT $4`,
+	}
+)
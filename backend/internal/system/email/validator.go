@@ -19,6 +19,7 @@
 package email
 
 import (
+	"fmt"
 	"net/mail"
 	"strings"
 )
@@ -42,3 +43,50 @@ func IsValidEmail(emailAddr string) bool {
 
 	return addr.Address == emailAddr
 }
+
+// validateRecipients validates and trims, in place, the recipient email addresses in the To, CC, and BCC
+// fields and the subject, and returns all the recipient addresses.
+func validateRecipients(emailData *EmailData) ([]string, error) {
+	var allRecipients []string
+	hasRecipient := false
+
+	// Inline helper to validate and clean a specific group of addresses
+	processGroup := func(addresses []string) ([]string, error) {
+		var cleaned []string
+		for _, address := range addresses {
+			trimmed := strings.TrimSpace(address)
+			if trimmed == "" {
+				return nil, fmt.Errorf("%w: recipient address cannot be empty", ErrorInvalidRecipient)
+			}
+			if !IsValidEmail(trimmed) {
+				return nil, fmt.Errorf("%w: invalid recipient address '%s'", ErrorInvalidRecipient, trimmed)
+			}
+			cleaned = append(cleaned, trimmed)
+			allRecipients = append(allRecipients, trimmed)
+			hasRecipient = true
+		}
+		return cleaned, nil
+	}
+
+	var err error
+	if emailData.To, err = processGroup(emailData.To); err != nil {
+		return nil, err
+	}
+	if emailData.CC, err = processGroup(emailData.CC); err != nil {
+		return nil, err
+	}
+	if emailData.BCC, err = processGroup(emailData.BCC); err != nil {
+		return nil, err
+	}
+
+	if !hasRecipient {
+		return nil, ErrorInvalidRecipient
+	}
+
+	// Reject CR/LF in Subject to prevent header injection.
+	if strings.ContainsAny(emailData.Subject, "\r\n") {
+		return nil, ErrorInvalidSubject
+	}
+
+	return allRecipients, nil
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apierror
+
+import "net/http"
+
+// ProblemTypePrefix prefixes the error code to form the type URI of a problem details object, so
+// that each error code identifies a stable problem type.
+const ProblemTypePrefix = "urn:thunderid:error:"
+
+// ProblemDetails defines an RFC 9457 problem details error response. The code, message and
+// description members of ErrorResponse are kept as extension members, so clients that read the
+// i18n error shape continue to work.
+type ProblemDetails struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	ErrorResponse
+}
+
+// NewProblemDetails creates the problem details for an error response returned with the status
+// code. The type is derived from the error code, and the title and detail from the default values
+// of the message and description.
+func NewProblemDetails(statusCode int, errorResp ErrorResponse) ProblemDetails {
+	problem := ProblemDetails{
+		Type:          "about:blank",
+		Title:         errorResp.Message.DefaultValue,
+		Status:        statusCode,
+		Detail:        errorResp.Description.DefaultValue,
+		ErrorResponse: errorResp,
+	}
+	if errorResp.Code != "" {
+		problem.Type = ProblemTypePrefix + errorResp.Code
+	}
+	if problem.Title == "" {
+		problem.Title = http.StatusText(statusCode)
+	}
+	return problem
+}
@@ -51,6 +51,9 @@ func (eh *exportHandler) HandleExportRequest(w http.ResponseWriter, r *http.Requ
 
 	exportRequest, err := sysutils.DecodeJSONBody[ExportRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		errResp := apierror.ErrorResponse{
 			Code:        ErrorInvalidRequest.Code,
 			Message:     ErrorInvalidRequest.Error,
@@ -104,6 +107,9 @@ func (eh *exportHandler) HandleExportZipRequest(w http.ResponseWriter, r *http.R
 
 	exportRequest, err := sysutils.DecodeJSONBody[ExportRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		errResp := apierror.ErrorResponse{
 			Code:        ErrorInvalidRequest.Code,
 			Message:     ErrorInvalidRequest.Error,
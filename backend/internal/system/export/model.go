@@ -32,6 +32,7 @@ type ExportRequest struct {
 	Groups              []string `json:"groups,omitempty"`
 	ResourceServers     []string `json:"resourceServers,omitempty"`
 	Roles               []string `json:"roles,omitempty"`
+	Scopes              []string `json:"scopes,omitempty"`
 	Flows               []string `json:"flows,omitempty"`
 	Translations        []string `json:"translations,omitempty"`
 	Layouts             []string `json:"layouts,omitempty"`
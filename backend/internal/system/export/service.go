@@ -51,6 +51,7 @@ const (
 	resourceTypeGroup              = "group"
 	resourceTypeResourceServer     = "resource_server"
 	resourceTypeRole               = "role"
+	resourceTypeScope              = "scope"
 	resourceTypeFlow               = "flow"
 	resourceTypeTranslation        = "translation"
 	resourceTypeLayout             = "layout"
@@ -131,6 +132,7 @@ func (es *exportService) ExportResources(
 		resourceTypeGroup:              request.Groups,
 		resourceTypeResourceServer:     request.ResourceServers,
 		resourceTypeRole:               request.Roles,
+		resourceTypeScope:              request.Scopes,
 		resourceTypeFlow:               request.Flows,
 		resourceTypeTranslation:        request.Translations,
 		resourceTypeLayout:             request.Layouts,
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package faultinjection
+
+import "time"
+
+// Config holds the fault injection settings, mapped by the caller from the deployment configuration.
+// It is intentionally decoupled from system/config so the instrumented packages do not depend on the
+// global configuration type.
+type Config struct {
+	// Enabled turns fault injection on. When false, Inject never delays or fails a call.
+	Enabled bool
+	// Rules are evaluated independently for every instrumented call.
+	Rules []Rule
+}
+
+// Rule describes a fault injected into calls of a single target.
+type Rule struct {
+	// Target is the kind of call the rule applies to.
+	Target Target
+	// Match selects calls by name: the query ID for database calls, the executor name for executor
+	// runs and the host for outbound HTTP. An empty value or "*" matches every call, and a value
+	// ending with "*" matches names with that prefix.
+	Match string
+	// Probability is the chance, between 0 and 1, that the rule applies to a matching call.
+	Probability float64
+	// Latency is the delay added to the call when the rule applies.
+	Latency time.Duration
+	// Fail makes the call fail with ErrInjectedFault when the rule applies.
+	Fail bool
+}
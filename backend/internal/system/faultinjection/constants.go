@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package faultinjection
+
+import "errors"
+
+// Target identifies the kind of call a fault is injected into.
+type Target string
+
+const (
+	// TargetDatabase injects faults into database queries and statements.
+	TargetDatabase Target = "database"
+	// TargetExecutor injects faults into flow executor runs.
+	TargetExecutor Target = "executor"
+	// TargetHTTP injects faults into outbound HTTP requests such as webhooks and federation calls.
+	TargetHTTP Target = "http"
+)
+
+// ErrInjectedFault is returned by calls that fail because of an injected fault.
+var ErrInjectedFault = errors.New("injected fault")
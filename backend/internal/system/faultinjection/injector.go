@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package faultinjection injects latency and errors into database calls, executor runs and outbound
+// HTTP requests based on probabilistic rules, so retry and timeout behavior can be verified under
+// controlled failure. It is intended for resilience testing and must not be enabled in production.
+package faultinjection
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// randFloat64 returns the random number used to decide whether a rule applies. Tests replace it to
+// make decisions deterministic.
+var randFloat64 = rand.Float64
+
+// activeRules holds the rules in effect. It is nil when fault injection is disabled.
+var activeRules atomic.Pointer[[]Rule]
+
+// Initialize validates cfg and activates its rules for all instrumented calls. Calling it again
+// replaces the active rules; a disabled configuration turns fault injection off.
+func Initialize(cfg Config) error {
+	if !cfg.Enabled {
+		activeRules.Store(nil)
+		return nil
+	}
+
+	rules := make([]Rule, 0, len(cfg.Rules))
+	for i, rule := range cfg.Rules {
+		if err := validateRule(rule); err != nil {
+			return fmt.Errorf("invalid fault injection rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+	activeRules.Store(&rules)
+
+	log.GetLogger().Warn(context.Background(), "Fault injection is enabled. Do not use this in production",
+		log.Int("rules", len(rules)))
+	return nil
+}
+
+// validateRule ensures the rule targets a known call type and injects a valid fault.
+func validateRule(rule Rule) error {
+	switch rule.Target {
+	case TargetDatabase, TargetExecutor, TargetHTTP:
+	default:
+		return fmt.Errorf("unsupported target %q", rule.Target)
+	}
+	if rule.Probability < 0 || rule.Probability > 1 {
+		return fmt.Errorf("probability must be between 0 and 1, got %v", rule.Probability)
+	}
+	if rule.Latency < 0 {
+		return fmt.Errorf("latency must not be negative, got %s", rule.Latency)
+	}
+	if rule.Latency == 0 && !rule.Fail {
+		return fmt.Errorf("rule must inject latency, an error, or both")
+	}
+	return nil
+}
+
+// Inject applies the active rules matching the target and name to the current call. The latency of
+// every applied rule is added before returning, and ErrInjectedFault is returned when any applied rule
+// fails the call. If the context ends while waiting, its error is returned instead.
+func Inject(ctx context.Context, target Target, name string) error {
+	rules := activeRules.Load()
+	if rules == nil {
+		return nil
+	}
+
+	var latency time.Duration
+	fail := false
+	for _, rule := range *rules {
+		if rule.Target != target || !matchesName(rule.Match, name) {
+			continue
+		}
+		if rule.Probability <= 0 || randFloat64() >= rule.Probability {
+			continue
+		}
+		latency += rule.Latency
+		fail = fail || rule.Fail
+	}
+	if latency == 0 && !fail {
+		return nil
+	}
+
+	log.GetLogger().Debug(ctx, "Injecting fault", log.String("target", string(target)),
+		log.String("name", name), log.String("latency", latency.String()), log.Bool("fail", fail))
+
+	if latency > 0 {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		timer := time.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if fail {
+		return fmt.Errorf("%w: %s %s", ErrInjectedFault, target, name)
+	}
+	return nil
+}
+
+// matchesName reports whether the rule match expression selects the given call name.
+func matchesName(match, name string) bool {
+	if match == "" || match == "*" {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(match, "*"); ok {
+		return strings.HasPrefix(name, prefix)
+	}
+	return match == name
+}
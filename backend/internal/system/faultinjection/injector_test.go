@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package faultinjection
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type InjectorTestSuite struct {
+	suite.Suite
+}
+
+func TestInjectorTestSuite(t *testing.T) {
+	suite.Run(t, new(InjectorTestSuite))
+}
+
+func (s *InjectorTestSuite) SetupTest() {
+	randFloat64 = func() float64 { return 0 }
+}
+
+func (s *InjectorTestSuite) TearDownTest() {
+	randFloat64 = rand.Float64
+	s.Require().NoError(Initialize(Config{}))
+}
+
+func (s *InjectorTestSuite) TestInject_DisabledIsNoop() {
+	s.Require().NoError(Initialize(Config{
+		Enabled: false,
+		Rules:   []Rule{{Target: TargetDatabase, Probability: 1, Fail: true}},
+	}))
+
+	s.NoError(Inject(context.Background(), TargetDatabase, "query"))
+}
+
+func (s *InjectorTestSuite) TestInject_FailsMatchingCall() {
+	s.Require().NoError(Initialize(Config{
+		Enabled: true,
+		Rules:   []Rule{{Target: TargetDatabase, Match: "ASQ-*", Probability: 1, Fail: true}},
+	}))
+
+	err := Inject(context.Background(), TargetDatabase, "ASQ-ENTITY-01")
+	s.True(errors.Is(err, ErrInjectedFault))
+	s.NoError(Inject(context.Background(), TargetDatabase, "OTHER-01"))
+	s.NoError(Inject(context.Background(), TargetHTTP, "ASQ-ENTITY-01"))
+}
+
+func (s *InjectorTestSuite) TestInject_RespectsProbability() {
+	s.Require().NoError(Initialize(Config{
+		Enabled: true,
+		Rules:   []Rule{{Target: TargetExecutor, Probability: 0.3, Fail: true}},
+	}))
+
+	randFloat64 = func() float64 { return 0.5 }
+	s.NoError(Inject(context.Background(), TargetExecutor, "BasicAuthExecutor"))
+
+	randFloat64 = func() float64 { return 0.1 }
+	s.Error(Inject(context.Background(), TargetExecutor, "BasicAuthExecutor"))
+}
+
+func (s *InjectorTestSuite) TestInject_AddsLatency() {
+	s.Require().NoError(Initialize(Config{
+		Enabled: true,
+		Rules: []Rule{
+			{Target: TargetHTTP, Match: "idp.example.com", Probability: 1, Latency: 20 * time.Millisecond},
+		},
+	}))
+
+	start := time.Now()
+	s.NoError(Inject(context.Background(), TargetHTTP, "idp.example.com"))
+	s.GreaterOrEqual(time.Since(start), 20*time.Millisecond)
+}
+
+func (s *InjectorTestSuite) TestInject_LatencyStopsWhenContextEnds() {
+	s.Require().NoError(Initialize(Config{
+		Enabled: true,
+		Rules:   []Rule{{Target: TargetHTTP, Probability: 1, Latency: time.Minute}},
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := Inject(ctx, TargetHTTP, "idp.example.com")
+	s.True(errors.Is(err, context.DeadlineExceeded))
+}
+
+func (s *InjectorTestSuite) TestInitialize_RejectsInvalidRules() {
+	invalid := []Rule{
+		{Target: "queue", Probability: 1, Fail: true},
+		{Target: TargetDatabase, Probability: 1.5, Fail: true},
+		{Target: TargetDatabase, Probability: 1, Latency: -time.Second},
+		{Target: TargetDatabase, Probability: 1},
+	}
+	for _, rule := range invalid {
+		s.Error(Initialize(Config{Enabled: true, Rules: []Rule{rule}}))
+	}
+}
+
+func (s *InjectorTestSuite) TestMatchesName() {
+	s.True(matchesName("", "any"))
+	s.True(matchesName("*", "any"))
+	s.True(matchesName("api.*", "api.example.com"))
+	s.False(matchesName("api.*", "idp.example.com"))
+	s.True(matchesName("idp.example.com", "idp.example.com"))
+	s.False(matchesName("idp.example.com", "idp.example.org"))
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package featureflag decides whether a capability that is being rolled out incrementally is enabled,
+// for the whole deployment or for a single application.
+//
+// A flag is resolved from the most specific setting that is present: an application override in the
+// featureFlags server-config section, the deployment-wide value in that section, the feature_flags
+// setting in deployment.yaml, and finally the built-in default of the feature.
+package featureflag
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Feature identifies a capability guarded by a feature flag.
+type Feature string
+
+const (
+	// PAR guards the pushed authorization request endpoint.
+	PAR Feature = "par"
+	// DPoP guards the acceptance of DPoP proofs at the token and pushed authorization request endpoints.
+	DPoP Feature = "dpop"
+	// ParallelFlowNodes guards the PARALLEL and JOIN flow node types.
+	ParallelFlowNodes Feature = "parallel_flow_nodes"
+)
+
+// defaults holds the built-in state of each known feature. Features that already shipped default to
+// enabled so that introducing a flag does not change the behavior of existing deployments.
+var defaults = map[Feature]bool{
+	PAR:               true,
+	DPoP:              true,
+	ParallelFlowNodes: true,
+}
+
+// IsKnown reports whether the feature is one of the features guarded by a flag.
+func IsKnown(feature Feature) bool {
+	_, ok := defaults[feature]
+	return ok
+}
+
+// ServerConfigReader reads the merged value of a server-config section.
+type ServerConfigReader interface {
+	GetMergedConfig(ctx context.Context, name string) (any, *common.ServiceError)
+}
+
+// configSectionFeatureFlags is the server-config section holding the runtime flag overrides.
+const configSectionFeatureFlags = "featureFlags"
+
+// readerHolder wraps the reader so that it can be stored atomically.
+type readerHolder struct {
+	reader ServerConfigReader
+}
+
+// current is the process-wide server-config reader. Until it is set, flags resolve from deployment.yaml
+// and the built-in defaults.
+var current atomic.Pointer[readerHolder]
+
+// Initialize installs the server-config reader used for the runtime flag overrides.
+func Initialize(reader ServerConfigReader) {
+	current.Store(&readerHolder{reader: reader})
+	for name := range config.GetServerRuntime().Config.FeatureFlags {
+		if !IsKnown(Feature(name)) {
+			logger().Warn(context.Background(), "Ignoring unknown feature in feature_flags",
+				log.String("feature", name))
+		}
+	}
+}
+
+// IsEnabled reports whether the feature is enabled for the application. An empty application ID
+// resolves the deployment-wide state. When the runtime overrides cannot be read, the flag resolves
+// from deployment.yaml and the built-in default.
+func IsEnabled(ctx context.Context, feature Feature, appID string) bool {
+	if holder := current.Load(); holder != nil && holder.reader != nil {
+		if enabled, ok := runtimeOverride(ctx, holder.reader, feature, appID); ok {
+			return enabled
+		}
+	}
+	if config.IsServerRuntimeInitialized() {
+		if enabled, ok := config.GetServerRuntime().Config.FeatureFlags[string(feature)]; ok {
+			return enabled
+		}
+	}
+	return defaults[feature]
+}
+
+// runtimeOverride returns the state set for the feature in the featureFlags server-config section, and
+// whether one is set.
+func runtimeOverride(ctx context.Context, reader ServerConfigReader, feature Feature,
+	appID string) (bool, bool) {
+	v, svcErr := reader.GetMergedConfig(ctx, configSectionFeatureFlags)
+	if svcErr != nil {
+		logger().Warn(ctx, "Failed to read the feature flag overrides; using the configured defaults",
+			log.String("code", svcErr.Code))
+		return false, false
+	}
+	cfg, ok := v.(FlagsConfig)
+	if !ok {
+		return false, false
+	}
+	flag, ok := cfg.Flags[feature]
+	if !ok {
+		return false, false
+	}
+	if appID != "" {
+		if enabled, ok := flag.Applications[appID]; ok {
+			return enabled, true
+		}
+	}
+	if flag.Enabled != nil {
+		return *flag.Enabled, true
+	}
+	return false, false
+}
+
+func logger() *log.Logger {
+	return log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FeatureFlag"))
+}
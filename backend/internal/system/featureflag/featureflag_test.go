@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package featureflag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// fakeReader returns a fixed featureFlags section value or error.
+type fakeReader struct {
+	value  any
+	svcErr *common.ServiceError
+}
+
+func (r fakeReader) GetMergedConfig(_ context.Context, _ string) (any, *common.ServiceError) {
+	return r.value, r.svcErr
+}
+
+type FeatureFlagTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestFeatureFlagTestSuite(t *testing.T) {
+	suite.Run(t, new(FeatureFlagTestSuite))
+}
+
+func (suite *FeatureFlagTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	config.ResetServerRuntime()
+	current.Store(nil)
+}
+
+func (suite *FeatureFlagTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+	current.Store(nil)
+}
+
+// initialize sets the deployment.yaml flags and installs a reader returning the runtime overrides.
+func (suite *FeatureFlagTestSuite) initialize(deployment map[string]bool, overrides FlagsConfig) {
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{FeatureFlags: deployment}))
+	Initialize(fakeReader{value: overrides})
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func (suite *FeatureFlagTestSuite) TestIsEnabled_BuiltInDefaultWithoutRuntime() {
+	suite.True(IsEnabled(suite.ctx, PAR, ""))
+	suite.False(IsEnabled(suite.ctx, Feature("unknown"), ""))
+}
+
+func (suite *FeatureFlagTestSuite) TestIsEnabled_DeploymentConfigOverridesDefault() {
+	suite.initialize(map[string]bool{"par": false}, FlagsConfig{})
+
+	suite.False(IsEnabled(suite.ctx, PAR, "app-1"))
+	suite.True(IsEnabled(suite.ctx, DPoP, "app-1"))
+}
+
+func (suite *FeatureFlagTestSuite) TestIsEnabled_RuntimeOverrideTakesPrecedence() {
+	suite.initialize(map[string]bool{"dpop": false}, FlagsConfig{Flags: map[Feature]FlagConfig{
+		DPoP: {Enabled: boolPtr(true)},
+	}})
+
+	suite.True(IsEnabled(suite.ctx, DPoP, ""))
+}
+
+func (suite *FeatureFlagTestSuite) TestIsEnabled_ApplicationOverride() {
+	suite.initialize(nil, FlagsConfig{Flags: map[Feature]FlagConfig{
+		DPoP: {Enabled: boolPtr(false), Applications: map[string]bool{"pilot-app": true}},
+		PAR:  {Applications: map[string]bool{"legacy-app": false}},
+	}})
+
+	suite.True(IsEnabled(suite.ctx, DPoP, "pilot-app"))
+	suite.False(IsEnabled(suite.ctx, DPoP, "other-app"))
+	suite.False(IsEnabled(suite.ctx, DPoP, ""))
+	suite.False(IsEnabled(suite.ctx, PAR, "legacy-app"))
+	suite.True(IsEnabled(suite.ctx, PAR, "other-app"))
+}
+
+func (suite *FeatureFlagTestSuite) TestIsEnabled_ReadFailureFallsBackToConfig() {
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{
+		FeatureFlags: map[string]bool{"par": false},
+	}))
+	Initialize(fakeReader{svcErr: &common.InternalServerError})
+
+	suite.False(IsEnabled(suite.ctx, PAR, "app-1"))
+	suite.True(IsEnabled(suite.ctx, DPoP, "app-1"))
+}
+
+func (suite *FeatureFlagTestSuite) TestIsKnown() {
+	suite.True(IsKnown(ParallelFlowNodes))
+	suite.False(IsKnown(Feature("unknown")))
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package featureflag
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// FlagConfig is the runtime state of one feature. Enabled sets the deployment-wide state when present;
+// Applications sets the state for individual applications by application ID and takes precedence.
+type FlagConfig struct {
+	Enabled      *bool           `json:"enabled,omitempty"      yaml:"enabled,omitempty"`
+	Applications map[string]bool `json:"applications,omitempty" yaml:"applications,omitempty"`
+}
+
+// FlagsConfig is the featureFlags server-config section value.
+type FlagsConfig struct {
+	Flags map[Feature]FlagConfig `json:"flags" yaml:"flags"`
+}
+
+// FlagsHandler decodes, validates, and merges the featureFlags server-config section.
+type FlagsHandler struct{}
+
+// Decode parses a raw JSON featureFlags value into FlagsConfig.
+func (FlagsHandler) Decode(raw json.RawMessage) (any, error) {
+	if len(raw) == 0 {
+		return FlagsConfig{Flags: map[Feature]FlagConfig{}}, nil
+	}
+	var cfg FlagsConfig
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Flags == nil {
+		cfg.Flags = map[Feature]FlagConfig{}
+	}
+	return cfg, nil
+}
+
+// Validate checks that incoming only sets known features and non-empty application IDs.
+func (FlagsHandler) Validate(incoming, _, _ any) error {
+	cfg, _ := incoming.(FlagsConfig)
+	for feature, flag := range cfg.Flags {
+		if !IsKnown(feature) {
+			return fmt.Errorf("unknown feature %q", feature)
+		}
+		for appID := range flag.Applications {
+			if appID == "" {
+				return errors.New("application ID must not be empty")
+			}
+		}
+	}
+	return nil
+}
+
+// Merge overlays the writable flags on the read-only flags. For each feature, a writable deployment-wide
+// state replaces the read-only one, and writable application states replace read-only ones for the same
+// application.
+func (FlagsHandler) Merge(readOnly, writable any) any {
+	out := map[Feature]FlagConfig{}
+	for _, layer := range []any{readOnly, writable} {
+		cfg, _ := layer.(FlagsConfig)
+		for feature, flag := range cfg.Flags {
+			merged := out[feature]
+			if flag.Enabled != nil {
+				merged.Enabled = flag.Enabled
+			}
+			for appID, enabled := range flag.Applications {
+				if merged.Applications == nil {
+					merged.Applications = map[string]bool{}
+				}
+				merged.Applications[appID] = enabled
+			}
+			out[feature] = merged
+		}
+	}
+	return FlagsConfig{Flags: out}
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package featureflag
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FlagsHandlerTestSuite struct {
+	suite.Suite
+	handler FlagsHandler
+}
+
+func TestFlagsHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(FlagsHandlerTestSuite))
+}
+
+func (suite *FlagsHandlerTestSuite) TestDecode_Empty() {
+	v, err := suite.handler.Decode(nil)
+
+	suite.Require().NoError(err)
+	suite.Equal(FlagsConfig{Flags: map[Feature]FlagConfig{}}, v)
+}
+
+func (suite *FlagsHandlerTestSuite) TestDecode_Value() {
+	v, err := suite.handler.Decode(json.RawMessage(
+		`{"flags":{"dpop":{"enabled":false,"applications":{"app-1":true}}}}`))
+
+	suite.Require().NoError(err)
+	cfg := v.(FlagsConfig)
+	suite.False(*cfg.Flags[DPoP].Enabled)
+	suite.Equal(map[string]bool{"app-1": true}, cfg.Flags[DPoP].Applications)
+}
+
+func (suite *FlagsHandlerTestSuite) TestDecode_Malformed() {
+	_, err := suite.handler.Decode(json.RawMessage(`{"flags":[]}`))
+
+	suite.Error(err)
+}
+
+func (suite *FlagsHandlerTestSuite) TestValidate() {
+	valid := FlagsConfig{Flags: map[Feature]FlagConfig{PAR: {Applications: map[string]bool{"app-1": false}}}}
+	suite.NoError(suite.handler.Validate(valid, nil, nil))
+
+	unknown := FlagsConfig{Flags: map[Feature]FlagConfig{"teleport": {Enabled: boolPtr(true)}}}
+	suite.ErrorContains(suite.handler.Validate(unknown, nil, nil), "teleport")
+
+	emptyApp := FlagsConfig{Flags: map[Feature]FlagConfig{PAR: {Applications: map[string]bool{"": true}}}}
+	suite.Error(suite.handler.Validate(emptyApp, nil, nil))
+}
+
+func (suite *FlagsHandlerTestSuite) TestMerge_WritableOverridesReadOnly() {
+	readOnly := FlagsConfig{Flags: map[Feature]FlagConfig{
+		PAR:  {Enabled: boolPtr(true), Applications: map[string]bool{"app-1": false, "app-2": false}},
+		DPoP: {Enabled: boolPtr(false)},
+	}}
+	writable := FlagsConfig{Flags: map[Feature]FlagConfig{
+		PAR:               {Applications: map[string]bool{"app-2": true}},
+		ParallelFlowNodes: {Enabled: boolPtr(false)},
+	}}
+
+	merged := suite.handler.Merge(readOnly, writable).(FlagsConfig)
+
+	suite.True(*merged.Flags[PAR].Enabled)
+	suite.Equal(map[string]bool{"app-1": false, "app-2": true}, merged.Flags[PAR].Applications)
+	suite.False(*merged.Flags[DPoP].Enabled)
+	suite.False(*merged.Flags[ParallelFlowNodes].Enabled)
+	suite.Equal(map[string]bool{"app-1": false, "app-2": false}, readOnly.Flags[PAR].Applications)
+}
+
+func (suite *FlagsHandlerTestSuite) TestMerge_EmptyLayers() {
+	suite.Equal(FlagsConfig{Flags: map[Feature]FlagConfig{}}, suite.handler.Merge(nil, nil))
+}
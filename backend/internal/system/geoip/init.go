@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package geoip resolves client IP addresses to geographic locations using the network table in the
+// geo_ip deployment configuration.
+package geoip
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/netaccess"
+)
+
+// LocatorInterface resolves IP addresses to locations.
+type LocatorInterface interface {
+	// Locate returns the location of addr. ok is false when the location is unknown.
+	Locate(addr netip.Addr) (loc Location, ok bool)
+}
+
+// locatedNetwork pairs a network with its location.
+type locatedNetwork struct {
+	prefix   netip.Prefix
+	location Location
+}
+
+// staticLocator resolves addresses against a fixed table ordered from the most to the least
+// specific network.
+type staticLocator struct {
+	networks []locatedNetwork
+}
+
+// Initialize builds a locator from cfg. A malformed network or an out-of-range coordinate returns a
+// non-nil error.
+func Initialize(cfg config.GeoIPConfig) (LocatorInterface, error) {
+	networks := make([]locatedNetwork, 0, len(cfg.Networks))
+	for _, n := range cfg.Networks {
+		prefixes, err := netaccess.ParseNetworks([]string{n.CIDR})
+		if err != nil {
+			return nil, fmt.Errorf("invalid geo_ip network: %w", err)
+		}
+		if n.Latitude < -90 || n.Latitude > 90 || n.Longitude < -180 || n.Longitude > 180 {
+			return nil, fmt.Errorf("invalid geo_ip coordinates for network %q", n.CIDR)
+		}
+		networks = append(networks, locatedNetwork{
+			prefix: prefixes[0],
+			location: Location{
+				Country:   strings.ToUpper(strings.TrimSpace(n.Country)),
+				Latitude:  n.Latitude,
+				Longitude: n.Longitude,
+			},
+		})
+	}
+	sort.SliceStable(networks, func(i, j int) bool {
+		return networks[i].prefix.Bits() > networks[j].prefix.Bits()
+	})
+	return &staticLocator{networks: networks}, nil
+}
+
+// Locate returns the location of the most specific network containing addr.
+func (l *staticLocator) Locate(addr netip.Addr) (Location, bool) {
+	if !addr.IsValid() {
+		return Location{}, false
+	}
+	addr = addr.Unmap()
+	for _, n := range l.networks {
+		if n.prefix.Contains(addr) {
+			return n.location, true
+		}
+	}
+	return Location{}, false
+}
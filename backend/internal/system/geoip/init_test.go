@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package geoip
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+func TestInitialize_InvalidEntries(t *testing.T) {
+	_, err := Initialize(config.GeoIPConfig{Networks: []config.GeoIPNetworkConfig{{CIDR: "10.0.0.0/33"}}})
+	assert.Error(t, err)
+
+	_, err = Initialize(config.GeoIPConfig{Networks: []config.GeoIPNetworkConfig{
+		{CIDR: "10.0.0.0/8", Latitude: 91},
+	}})
+	assert.Error(t, err)
+}
+
+func TestLocate_MostSpecificNetworkWins(t *testing.T) {
+	locator, err := Initialize(config.GeoIPConfig{Networks: []config.GeoIPNetworkConfig{
+		{CIDR: "10.0.0.0/8", Country: "lk", Latitude: 6.93, Longitude: 79.85},
+		{CIDR: "10.20.0.0/16", Country: "GB", Latitude: 51.51, Longitude: -0.13},
+	}})
+	require.NoError(t, err)
+
+	loc, ok := locator.Locate(netip.MustParseAddr("10.20.1.1"))
+	assert.True(t, ok)
+	assert.Equal(t, "GB", loc.Country)
+
+	loc, ok = locator.Locate(netip.MustParseAddr("::ffff:10.1.1.1"))
+	assert.True(t, ok)
+	assert.Equal(t, "LK", loc.Country)
+
+	_, ok = locator.Locate(netip.MustParseAddr("192.0.2.1"))
+	assert.False(t, ok)
+	_, ok = locator.Locate(netip.Addr{})
+	assert.False(t, ok)
+}
+
+func TestDistanceKm(t *testing.T) {
+	colombo := Location{Latitude: 6.93, Longitude: 79.85}
+	london := Location{Latitude: 51.51, Longitude: -0.13}
+
+	assert.InDelta(t, 8700, DistanceKm(colombo, london), 50)
+	assert.Zero(t, DistanceKm(london, london))
+}
@@ -0,0 +1,259 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+// decodeOptions tolerates the REST response fields that have no counterpart in the protobuf messages.
+var decodeOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// restBridge serves gRPC calls by dispatching the equivalent REST requests to the REST API handler.
+type restBridge struct {
+	handler http.Handler
+}
+
+// newRESTBridge creates a bridge that dispatches to handler.
+func newRESTBridge(handler http.Handler) *restBridge {
+	return &restBridge{handler: handler}
+}
+
+// restResponse is a REST response captured in memory.
+type restResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newRESTResponse() *restResponse {
+	return &restResponse{header: make(http.Header), status: http.StatusOK}
+}
+
+func (r *restResponse) Header() http.Header { return r.header }
+
+func (r *restResponse) Write(b []byte) (int, error) { return r.body.Write(b) }
+
+func (r *restResponse) WriteHeader(statusCode int) { r.status = statusCode }
+
+// call dispatches a REST request on behalf of the gRPC call in ctx and returns the response body. The
+// body, when not nil, is encoded as JSON. A non-2xx response is returned as a gRPC status error.
+func (b *restBridge) call(ctx context.Context, method, path string, query url.Values,
+	body interface{}) ([]byte, error) {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		encoded, err := encodeBody(body)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	target := &url.URL{Path: path, RawQuery: query.Encode()}
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), reqBody)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build request: %v", err)
+	}
+	req.RequestURI = target.RequestURI()
+	req.Header.Set("Accept", serverconst.ContentTypeJSON)
+	if body != nil {
+		req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, name := range forwardedHeaders {
+			if values := md.Get(strings.ToLower(name)); len(values) > 0 {
+				req.Header.Set(name, values[0])
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		req.RemoteAddr = p.Addr.String()
+	}
+
+	resp := newRESTResponse()
+	b.handler.ServeHTTP(resp, req)
+
+	if resp.status < http.StatusOK || resp.status >= http.StatusMultipleChoices {
+		return nil, toStatusError(resp)
+	}
+	return resp.body.Bytes(), nil
+}
+
+// callInto dispatches a REST request like call and decodes the JSON response into out.
+func (b *restBridge) callInto(ctx context.Context, method, path string, body interface{},
+	out proto.Message) error {
+	data, err := b.call(ctx, method, path, nil, body)
+	if err != nil {
+		return err
+	}
+	return decodeMessage(data, out)
+}
+
+// list fetches every item of a REST list API, calling send for each one as its page arrives. When
+// paginated is false the API returns all items in a single response. itemsKey is the response field
+// holding the items.
+func (b *restBridge) list(ctx context.Context, path string, query url.Values, pageSize int32,
+	paginated bool, itemsKey string, send func(json.RawMessage) error) error {
+	if pageSize < 0 {
+		return status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+	if query == nil {
+		query = url.Values{}
+	}
+	limit := int(pageSize)
+	if limit == 0 {
+		limit = serverconst.DefaultPageSize
+	}
+
+	offset := 0
+	for {
+		if paginated {
+			query.Set(queryParamLimit, strconv.Itoa(limit))
+			query.Set(queryParamOffset, strconv.Itoa(offset))
+		}
+		data, err := b.call(ctx, http.MethodGet, path, query, nil)
+		if err != nil {
+			return err
+		}
+
+		var page map[string]json.RawMessage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return status.Errorf(codes.Internal, "failed to decode list response: %v", err)
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(page[itemsKey], &items); err != nil && page[itemsKey] != nil {
+			return status.Errorf(codes.Internal, "failed to decode list response: %v", err)
+		}
+		for _, item := range items {
+			if err := send(item); err != nil {
+				return err
+			}
+		}
+
+		var total int
+		_ = json.Unmarshal(page["totalResults"], &total)
+		offset += len(items)
+		if !paginated || len(items) == 0 || offset >= total {
+			return nil
+		}
+	}
+}
+
+// encodeBody encodes a request body as JSON. Protobuf messages are encoded with their JSON field
+// names, which match the REST API.
+func encodeBody(body interface{}) ([]byte, error) {
+	if msg, ok := body.(proto.Message); ok {
+		return protojson.Marshal(msg)
+	}
+	return json.Marshal(body)
+}
+
+// decodeMessage decodes a REST response into a protobuf message.
+func decodeMessage(data []byte, out proto.Message) error {
+	if err := decodeOptions.Unmarshal(data, out); err != nil {
+		return status.Errorf(codes.Internal, "failed to decode response: %v", err)
+	}
+	return nil
+}
+
+// restError is the error body returned by the REST API. The message and description are either plain
+// strings or localizable messages carrying a default value.
+type restError struct {
+	Code        string          `json:"code"`
+	Message     json.RawMessage `json:"message"`
+	Description json.RawMessage `json:"description"`
+}
+
+// toStatusError converts a REST error response into a gRPC status error carrying the error code and
+// description of the response.
+func toStatusError(resp *restResponse) error {
+	code := statusCode(resp.status)
+
+	var restErr restError
+	if err := json.Unmarshal(resp.body.Bytes(), &restErr); err != nil || restErr.Code == "" {
+		return status.Error(code, http.StatusText(resp.status))
+	}
+	text := messageText(restErr.Description)
+	if text == "" {
+		text = messageText(restErr.Message)
+	}
+	return status.Error(code, fmt.Sprintf("%s: %s", restErr.Code, text))
+}
+
+// messageText returns the text of a REST error message.
+func messageText(raw json.RawMessage) string {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	var message struct {
+		DefaultValue string `json:"defaultValue"`
+	}
+	if err := json.Unmarshal(raw, &message); err == nil {
+		return message.DefaultValue
+	}
+	return ""
+}
+
+// statusCode maps an HTTP status code to the equivalent gRPC status code.
+func statusCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if httpStatus >= http.StatusInternalServerError {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grpcapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusCode(t *testing.T) {
+	cases := map[int]codes.Code{
+		http.StatusBadRequest:          codes.InvalidArgument,
+		http.StatusUnauthorized:        codes.Unauthenticated,
+		http.StatusForbidden:           codes.PermissionDenied,
+		http.StatusNotFound:            codes.NotFound,
+		http.StatusConflict:            codes.AlreadyExists,
+		http.StatusTooManyRequests:     codes.ResourceExhausted,
+		http.StatusServiceUnavailable:  codes.Unavailable,
+		http.StatusInternalServerError: codes.Internal,
+		http.StatusTeapot:              codes.Unknown,
+	}
+	for httpStatus, expected := range cases {
+		assert.Equal(t, expected, statusCode(httpStatus), "status %d", httpStatus)
+	}
+}
+
+func TestMessageText(t *testing.T) {
+	assert.Equal(t, "plain", messageText(json.RawMessage(`"plain"`)))
+	assert.Equal(t, "localized", messageText(json.RawMessage(`{"key":"k","defaultValue":"localized"}`)))
+	assert.Empty(t, messageText(nil))
+}
+
+func TestToStatusError_WithoutErrorBody(t *testing.T) {
+	resp := newRESTResponse()
+	resp.WriteHeader(http.StatusServiceUnavailable)
+	_, _ = resp.Write([]byte("maintenance"))
+
+	err := toStatusError(resp)
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, http.StatusText(http.StatusServiceUnavailable), status.Convert(err).Message())
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grpcapi
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+// Config holds the gRPC management API settings, mapped by the caller from the deployment
+// configuration. It is intentionally decoupled from system/config so this package does not depend on
+// the global configuration type.
+type Config struct {
+	// Enabled starts the gRPC server. When false, Initialize returns a no-op server.
+	Enabled bool
+	// Address is the host:port the gRPC server listens on.
+	Address string
+	// TLSConfig secures the listener. When nil, the server accepts plaintext connections.
+	TLSConfig *tls.Config
+	// Handler is the REST API handler, including its middleware chain. Every RPC is served by
+	// dispatching the equivalent REST request to it.
+	Handler http.Handler
+}
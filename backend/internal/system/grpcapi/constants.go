@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grpcapi
+
+import (
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/idempotency"
+)
+
+// REST API paths served over gRPC.
+const (
+	usersPath        = "/users"
+	groupsPath       = "/groups"
+	applicationsPath = "/applications"
+	flowsPath        = "/flows"
+)
+
+// Query parameters of the REST list APIs.
+const (
+	queryParamLimit    = "limit"
+	queryParamOffset   = "offset"
+	queryParamFilter   = "filter"
+	queryParamFlowType = "flowType"
+)
+
+// forwardedHeaders are the request headers copied from the incoming call metadata to the REST request.
+// Metadata keys are the lowercase header names.
+var forwardedHeaders = []string{
+	serverconst.AuthorizationHeaderName,
+	serverconst.AcceptLanguageHeaderName,
+	serverconst.CorrelationIDHeaderName,
+	idempotency.HeaderIdempotencyKey,
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package grpcapi serves the core management operations — users, groups, applications and flows —
+// over gRPC alongside the REST API. Each RPC is translated into the equivalent REST request and
+// dispatched in-process through the REST handler chain, so both surfaces share the service layer,
+// authorization checks, validation and audit trail. List RPCs stream results, fetching pages from the
+// service as the client consumes them.
+package grpcapi
+
+import (
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/thunder-id/thunderid/internal/system/grpcapi/managementpb"
+)
+
+// Initialize builds the gRPC server from cfg. When disabled it returns a no-op server. The caller owns
+// the lifecycle of the returned server and must call Start to begin serving.
+func Initialize(cfg Config) (Server, error) {
+	if !cfg.Enabled {
+		return noopServer{}, nil
+	}
+	if cfg.Address == "" {
+		return nil, errors.New("gRPC server address is required")
+	}
+	if cfg.Handler == nil {
+		return nil, errors.New("gRPC server requires the REST API handler")
+	}
+
+	var opts []grpc.ServerOption
+	if cfg.TLSConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(cfg.TLSConfig)))
+	}
+	server := grpc.NewServer(opts...)
+
+	bridge := newRESTBridge(cfg.Handler)
+	managementpb.RegisterUserServiceServer(server, newUserServer(bridge))
+	managementpb.RegisterGroupServiceServer(server, newGroupServer(bridge))
+	managementpb.RegisterApplicationServiceServer(server, newApplicationServer(bridge))
+	managementpb.RegisterFlowServiceServer(server, newFlowServer(bridge))
+
+	return newGRPCServer(cfg.Address, server), nil
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitialize_DisabledReturnsNoop(t *testing.T) {
+	server, err := Initialize(Config{Enabled: false})
+
+	assert.NoError(t, err)
+	assert.IsType(t, noopServer{}, server)
+	assert.NoError(t, server.Start(context.Background()))
+	assert.NoError(t, server.Stop(context.Background()))
+}
+
+func TestInitialize_RequiresAddress(t *testing.T) {
+	server, err := Initialize(Config{Enabled: true, Handler: http.NewServeMux()})
+
+	assert.Error(t, err)
+	assert.Nil(t, server)
+}
+
+func TestInitialize_RequiresHandler(t *testing.T) {
+	server, err := Initialize(Config{Enabled: true, Address: "127.0.0.1:0"})
+
+	assert.Error(t, err)
+	assert.Nil(t, server)
+}
+
+func TestInitialize_StartAndStop(t *testing.T) {
+	server, err := Initialize(Config{Enabled: true, Address: "127.0.0.1:0", Handler: http.NewServeMux()})
+	require.NoError(t, err)
+	assert.IsType(t, &grpcServer{}, server)
+
+	require.NoError(t, server.Start(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, server.Stop(ctx))
+}
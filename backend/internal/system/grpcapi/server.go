@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// Server is the lifecycle of the gRPC management API server.
+type Server interface {
+	// Start begins serving on the configured address. It returns once the listener is bound; calls
+	// are served in the background.
+	Start(ctx context.Context) error
+	// Stop drains in-flight calls and shuts the listener down. Calls still running when ctx is done
+	// are cancelled.
+	Stop(ctx context.Context) error
+}
+
+// noopServer is returned when the gRPC server is disabled.
+type noopServer struct{}
+
+func (noopServer) Start(context.Context) error { return nil }
+
+func (noopServer) Stop(context.Context) error { return nil }
+
+// grpcServer serves the management services on its own listener.
+type grpcServer struct {
+	address string
+	server  *grpc.Server
+	logger  *log.Logger
+}
+
+// newGRPCServer creates a server that serves the services registered on server at address.
+func newGRPCServer(address string, server *grpc.Server) *grpcServer {
+	return &grpcServer{
+		address: address,
+		server:  server,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "GRPCServer")),
+	}
+}
+
+// Start binds the listener and serves calls in the background.
+func (s *grpcServer) Start(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info(ctx, "gRPC management API started", log.String("address", ln.Addr().String()))
+	go func() {
+		if err := s.server.Serve(ln); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			s.logger.Error(context.Background(), "gRPC server stopped serving", log.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Stop drains in-flight calls, forcing them closed once ctx is done.
+func (s *grpcServer) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		<-stopped
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,380 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/thunder-id/thunderid/internal/system/grpcapi/managementpb"
+)
+
+// userServer serves UserService over the /users REST API.
+type userServer struct {
+	managementpb.UnimplementedUserServiceServer
+	bridge *restBridge
+}
+
+func newUserServer(bridge *restBridge) *userServer {
+	return &userServer{bridge: bridge}
+}
+
+// GetUser returns the user with the requested ID.
+func (s *userServer) GetUser(ctx context.Context, req *managementpb.GetUserRequest) (*managementpb.User, error) {
+	path, err := resourcePath(usersPath, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	user := &managementpb.User{}
+	if err := s.bridge.callInto(ctx, http.MethodGet, path, nil, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ListUsers streams the users matching the requested filter.
+func (s *userServer) ListUsers(req *managementpb.ListUsersRequest,
+	stream grpc.ServerStreamingServer[managementpb.User]) error {
+	query := url.Values{}
+	if req.GetFilter() != "" {
+		query.Set(queryParamFilter, req.GetFilter())
+	}
+	return s.bridge.list(stream.Context(), usersPath, query, req.GetPageSize(), true, "users",
+		func(item json.RawMessage) error {
+			user := &managementpb.User{}
+			if err := decodeMessage(item, user); err != nil {
+				return err
+			}
+			return stream.Send(user)
+		})
+}
+
+// CreateUser creates a user.
+func (s *userServer) CreateUser(ctx context.Context,
+	req *managementpb.CreateUserRequest) (*managementpb.User, error) {
+	user := &managementpb.User{}
+	if err := s.bridge.callInto(ctx, http.MethodPost, usersPath, req, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// UpdateUser replaces the user with the requested ID.
+func (s *userServer) UpdateUser(ctx context.Context,
+	req *managementpb.UpdateUserRequest) (*managementpb.User, error) {
+	path, err := resourcePath(usersPath, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := proto.Clone(req).(*managementpb.UpdateUserRequest)
+	body.Id = ""
+
+	user := &managementpb.User{}
+	if err := s.bridge.callInto(ctx, http.MethodPut, path, body, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// DeleteUser deletes the user with the requested ID.
+func (s *userServer) DeleteUser(ctx context.Context, req *managementpb.DeleteUserRequest) (*emptypb.Empty, error) {
+	return s.bridge.delete(ctx, usersPath, req.GetId())
+}
+
+// groupServer serves GroupService over the /groups REST API.
+type groupServer struct {
+	managementpb.UnimplementedGroupServiceServer
+	bridge *restBridge
+}
+
+func newGroupServer(bridge *restBridge) *groupServer {
+	return &groupServer{bridge: bridge}
+}
+
+// GetGroup returns the group with the requested ID.
+func (s *groupServer) GetGroup(ctx context.Context,
+	req *managementpb.GetGroupRequest) (*managementpb.Group, error) {
+	path, err := resourcePath(groupsPath, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	group := &managementpb.Group{}
+	if err := s.bridge.callInto(ctx, http.MethodGet, path, nil, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// ListGroups streams every group.
+func (s *groupServer) ListGroups(req *managementpb.ListGroupsRequest,
+	stream grpc.ServerStreamingServer[managementpb.Group]) error {
+	return s.bridge.list(stream.Context(), groupsPath, nil, req.GetPageSize(), true, "groups",
+		func(item json.RawMessage) error {
+			group := &managementpb.Group{}
+			if err := decodeMessage(item, group); err != nil {
+				return err
+			}
+			return stream.Send(group)
+		})
+}
+
+// CreateGroup creates a group.
+func (s *groupServer) CreateGroup(ctx context.Context,
+	req *managementpb.CreateGroupRequest) (*managementpb.Group, error) {
+	group := &managementpb.Group{}
+	if err := s.bridge.callInto(ctx, http.MethodPost, groupsPath, req, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// UpdateGroup replaces the group with the requested ID.
+func (s *groupServer) UpdateGroup(ctx context.Context,
+	req *managementpb.UpdateGroupRequest) (*managementpb.Group, error) {
+	path, err := resourcePath(groupsPath, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := proto.Clone(req).(*managementpb.UpdateGroupRequest)
+	body.Id = ""
+
+	group := &managementpb.Group{}
+	if err := s.bridge.callInto(ctx, http.MethodPut, path, body, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// DeleteGroup deletes the group with the requested ID.
+func (s *groupServer) DeleteGroup(ctx context.Context,
+	req *managementpb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	return s.bridge.delete(ctx, groupsPath, req.GetId())
+}
+
+// applicationServer serves ApplicationService over the /applications REST API.
+type applicationServer struct {
+	managementpb.UnimplementedApplicationServiceServer
+	bridge *restBridge
+}
+
+func newApplicationServer(bridge *restBridge) *applicationServer {
+	return &applicationServer{bridge: bridge}
+}
+
+// GetApplication returns the application with the requested ID.
+func (s *applicationServer) GetApplication(ctx context.Context,
+	req *managementpb.GetApplicationRequest) (*managementpb.Application, error) {
+	path, err := resourcePath(applicationsPath, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.bridge.call(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeApplication(data)
+}
+
+// ListApplications streams every application.
+func (s *applicationServer) ListApplications(_ *managementpb.ListApplicationsRequest,
+	stream grpc.ServerStreamingServer[managementpb.Application]) error {
+	return s.bridge.list(stream.Context(), applicationsPath, nil, 0, false, "applications",
+		func(item json.RawMessage) error {
+			app, err := decodeApplication(item)
+			if err != nil {
+				return err
+			}
+			return stream.Send(app)
+		})
+}
+
+// CreateApplication creates an application from the requested definition.
+func (s *applicationServer) CreateApplication(ctx context.Context,
+	req *managementpb.CreateApplicationRequest) (*managementpb.Application, error) {
+	if req.GetDefinition() == nil {
+		return nil, status.Error(codes.InvalidArgument, "definition is required")
+	}
+	data, err := s.bridge.call(ctx, http.MethodPost, applicationsPath, nil, req.GetDefinition())
+	if err != nil {
+		return nil, err
+	}
+	return decodeApplication(data)
+}
+
+// UpdateApplication replaces the application with the requested ID.
+func (s *applicationServer) UpdateApplication(ctx context.Context,
+	req *managementpb.UpdateApplicationRequest) (*managementpb.Application, error) {
+	path, err := resourcePath(applicationsPath, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetDefinition() == nil {
+		return nil, status.Error(codes.InvalidArgument, "definition is required")
+	}
+	data, err := s.bridge.call(ctx, http.MethodPut, path, nil, req.GetDefinition())
+	if err != nil {
+		return nil, err
+	}
+	return decodeApplication(data)
+}
+
+// DeleteApplication deletes the application with the requested ID.
+func (s *applicationServer) DeleteApplication(ctx context.Context,
+	req *managementpb.DeleteApplicationRequest) (*emptypb.Empty, error) {
+	return s.bridge.delete(ctx, applicationsPath, req.GetId())
+}
+
+// flowServer serves FlowService over the /flows REST API.
+type flowServer struct {
+	managementpb.UnimplementedFlowServiceServer
+	bridge *restBridge
+}
+
+func newFlowServer(bridge *restBridge) *flowServer {
+	return &flowServer{bridge: bridge}
+}
+
+// GetFlow returns the flow definition with the requested ID.
+func (s *flowServer) GetFlow(ctx context.Context, req *managementpb.GetFlowRequest) (*managementpb.Flow, error) {
+	path, err := resourcePath(flowsPath, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.bridge.call(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeFlow(data)
+}
+
+// ListFlows streams the flow definitions of the requested type.
+func (s *flowServer) ListFlows(req *managementpb.ListFlowsRequest,
+	stream grpc.ServerStreamingServer[managementpb.Flow]) error {
+	query := url.Values{}
+	if req.GetFlowType() != "" {
+		query.Set(queryParamFlowType, req.GetFlowType())
+	}
+	return s.bridge.list(stream.Context(), flowsPath, query, req.GetPageSize(), true, "flows",
+		func(item json.RawMessage) error {
+			flow, err := decodeFlow(item)
+			if err != nil {
+				return err
+			}
+			return stream.Send(flow)
+		})
+}
+
+// CreateFlow creates a flow from the requested definition.
+func (s *flowServer) CreateFlow(ctx context.Context,
+	req *managementpb.CreateFlowRequest) (*managementpb.Flow, error) {
+	if req.GetDefinition() == nil {
+		return nil, status.Error(codes.InvalidArgument, "definition is required")
+	}
+	data, err := s.bridge.call(ctx, http.MethodPost, flowsPath, nil, req.GetDefinition())
+	if err != nil {
+		return nil, err
+	}
+	return decodeFlow(data)
+}
+
+// UpdateFlow replaces the flow definition with the requested ID.
+func (s *flowServer) UpdateFlow(ctx context.Context,
+	req *managementpb.UpdateFlowRequest) (*managementpb.Flow, error) {
+	path, err := resourcePath(flowsPath, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetDefinition() == nil {
+		return nil, status.Error(codes.InvalidArgument, "definition is required")
+	}
+	data, err := s.bridge.call(ctx, http.MethodPut, path, nil, req.GetDefinition())
+	if err != nil {
+		return nil, err
+	}
+	return decodeFlow(data)
+}
+
+// DeleteFlow deletes the flow definition with the requested ID.
+func (s *flowServer) DeleteFlow(ctx context.Context, req *managementpb.DeleteFlowRequest) (*emptypb.Empty, error) {
+	return s.bridge.delete(ctx, flowsPath, req.GetId())
+}
+
+// delete dispatches a REST delete of the resource with id under collectionPath.
+func (b *restBridge) delete(ctx context.Context, collectionPath, id string) (*emptypb.Empty, error) {
+	path, err := resourcePath(collectionPath, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := b.call(ctx, http.MethodDelete, path, nil, nil); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// resourcePath returns the REST path of the resource with id under collectionPath.
+func resourcePath(collectionPath, id string) (string, error) {
+	if id == "" {
+		return "", status.Error(codes.InvalidArgument, "id is required")
+	}
+	return collectionPath + "/" + url.PathEscape(id), nil
+}
+
+// decodeApplication decodes a REST application into its summary fields and full definition.
+func decodeApplication(data []byte) (*managementpb.Application, error) {
+	app := &managementpb.Application{}
+	definition, err := decodeWithDefinition(data, app)
+	if err != nil {
+		return nil, err
+	}
+	app.Definition = definition
+	return app, nil
+}
+
+// decodeFlow decodes a REST flow definition into its summary fields and full definition.
+func decodeFlow(data []byte) (*managementpb.Flow, error) {
+	flow := &managementpb.Flow{}
+	definition, err := decodeWithDefinition(data, flow)
+	if err != nil {
+		return nil, err
+	}
+	flow.Definition = definition
+	return flow, nil
+}
+
+// decodeWithDefinition decodes the summary fields of a REST resource into out and returns the whole
+// resource as a struct.
+func decodeWithDefinition(data []byte, out proto.Message) (*structpb.Struct, error) {
+	if err := decodeMessage(data, out); err != nil {
+		return nil, err
+	}
+	definition := &structpb.Struct{}
+	if err := decodeMessage(data, definition); err != nil {
+		return nil, err
+	}
+	return definition, nil
+}
@@ -0,0 +1,308 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/thunder-id/thunderid/internal/system/grpcapi/managementpb"
+)
+
+type ServicesTestSuite struct {
+	suite.Suite
+	mux      *http.ServeMux
+	server   *grpc.Server
+	conn     *grpc.ClientConn
+	requests []*http.Request
+	bodies   []string
+}
+
+func TestServicesTestSuite(t *testing.T) {
+	suite.Run(t, new(ServicesTestSuite))
+}
+
+func (suite *ServicesTestSuite) SetupTest() {
+	suite.mux = http.NewServeMux()
+	suite.requests = nil
+	suite.bodies = nil
+
+	recorder := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		suite.requests = append(suite.requests, r)
+		suite.bodies = append(suite.bodies, string(body))
+		suite.mux.ServeHTTP(w, r)
+	})
+
+	listener := bufconn.Listen(1024 * 1024)
+	suite.server = grpc.NewServer()
+	bridge := newRESTBridge(recorder)
+	managementpb.RegisterUserServiceServer(suite.server, newUserServer(bridge))
+	managementpb.RegisterGroupServiceServer(suite.server, newGroupServer(bridge))
+	managementpb.RegisterApplicationServiceServer(suite.server, newApplicationServer(bridge))
+	managementpb.RegisterFlowServiceServer(suite.server, newFlowServer(bridge))
+	go func() { _ = suite.server.Serve(listener) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	suite.Require().NoError(err)
+	suite.conn = conn
+}
+
+func (suite *ServicesTestSuite) TearDownTest() {
+	_ = suite.conn.Close()
+	suite.server.Stop()
+}
+
+func (suite *ServicesTestSuite) respond(pattern string, statusCode int, body string) {
+	suite.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+func (suite *ServicesTestSuite) TestGetUser_ForwardsCredentialsAndDecodesUser() {
+	suite.respond("GET /users/{id}", http.StatusOK,
+		`{"id":"u1","ouId":"ou1","type":"employee","attributes":{"email":"a@example.com"},"state":"ACTIVE"}`)
+	client := managementpb.NewUserServiceClient(suite.conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"authorization", "Bearer token", "x-correlation-id", "trace-1")
+
+	user, err := client.GetUser(ctx, &managementpb.GetUserRequest{Id: "u1"})
+
+	suite.Require().NoError(err)
+	suite.Equal("u1", user.GetId())
+	suite.Equal("ou1", user.GetOuId())
+	suite.Equal("employee", user.GetType())
+	suite.Equal("a@example.com", user.GetAttributes().GetFields()["email"].GetStringValue())
+	suite.Require().Len(suite.requests, 1)
+	suite.Equal("/users/u1", suite.requests[0].URL.Path)
+	suite.Equal("Bearer token", suite.requests[0].Header.Get("Authorization"))
+	suite.Equal("trace-1", suite.requests[0].Header.Get("X-Correlation-ID"))
+}
+
+func (suite *ServicesTestSuite) TestGetUser_RequiresID() {
+	client := managementpb.NewUserServiceClient(suite.conn)
+
+	_, err := client.GetUser(context.Background(), &managementpb.GetUserRequest{})
+
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	suite.Empty(suite.requests)
+}
+
+func (suite *ServicesTestSuite) TestGetUser_MapsRESTErrors() {
+	suite.respond("GET /users/{id}", http.StatusNotFound,
+		`{"code":"USR-1003","message":{"key":"k","defaultValue":"User not found"},`+
+			`"description":{"key":"d","defaultValue":"The user does not exist"}}`)
+	client := managementpb.NewUserServiceClient(suite.conn)
+
+	_, err := client.GetUser(context.Background(), &managementpb.GetUserRequest{Id: "missing"})
+
+	suite.Equal(codes.NotFound, status.Code(err))
+	suite.Equal("USR-1003: The user does not exist", status.Convert(err).Message())
+}
+
+func (suite *ServicesTestSuite) TestGetUser_MapsUnauthenticated() {
+	suite.respond("GET /users/{id}", http.StatusUnauthorized, `{"code":"AUTH-4010","message":"Unauthorized"}`)
+	client := managementpb.NewUserServiceClient(suite.conn)
+
+	_, err := client.GetUser(context.Background(), &managementpb.GetUserRequest{Id: "u1"})
+
+	suite.Equal(codes.Unauthenticated, status.Code(err))
+	suite.Equal("AUTH-4010: Unauthorized", status.Convert(err).Message())
+}
+
+func (suite *ServicesTestSuite) TestListUsers_StreamsEveryPage() {
+	suite.mux.HandleFunc("GET /users", func(w http.ResponseWriter, r *http.Request) {
+		var body string
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			body = `{"totalResults":3,"startIndex":1,"count":2,"users":[{"id":"u1"},{"id":"u2"}]}`
+		default:
+			body = `{"totalResults":3,"startIndex":3,"count":1,"users":[{"id":"u3"}]}`
+		}
+		_, _ = w.Write([]byte(body))
+	})
+	client := managementpb.NewUserServiceClient(suite.conn)
+
+	stream, err := client.ListUsers(context.Background(),
+		&managementpb.ListUsersRequest{Filter: `type eq "employee"`, PageSize: 2})
+	suite.Require().NoError(err)
+
+	var ids []string
+	for {
+		user, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		suite.Require().NoError(err)
+		ids = append(ids, user.GetId())
+	}
+
+	suite.Equal([]string{"u1", "u2", "u3"}, ids)
+	suite.Require().Len(suite.requests, 2)
+	suite.Equal("2", suite.requests[0].URL.Query().Get("limit"))
+	suite.Equal("0", suite.requests[0].URL.Query().Get("offset"))
+	suite.Equal("2", suite.requests[1].URL.Query().Get("offset"))
+	suite.Equal(`type eq "employee"`, suite.requests[1].URL.Query().Get("filter"))
+}
+
+func (suite *ServicesTestSuite) TestListUsers_RejectsNegativePageSize() {
+	client := managementpb.NewUserServiceClient(suite.conn)
+
+	stream, err := client.ListUsers(context.Background(), &managementpb.ListUsersRequest{PageSize: -1})
+	suite.Require().NoError(err)
+	_, err = stream.Recv()
+
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *ServicesTestSuite) TestCreateUser_SendsRESTBody() {
+	suite.respond("POST /users", http.StatusCreated, `{"id":"u1","ouId":"ou1","type":"employee"}`)
+	client := managementpb.NewUserServiceClient(suite.conn)
+	attributes, err := structpb.NewStruct(map[string]interface{}{"email": "a@example.com"})
+	suite.Require().NoError(err)
+
+	user, err := client.CreateUser(context.Background(), &managementpb.CreateUserRequest{
+		OuId: "ou1", Type: "employee", Attributes: attributes,
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal("u1", user.GetId())
+	suite.JSONEq(`{"ouId":"ou1","type":"employee","attributes":{"email":"a@example.com"}}`, suite.bodies[0])
+	suite.Equal("application/json", suite.requests[0].Header.Get("Content-Type"))
+}
+
+func (suite *ServicesTestSuite) TestUpdateGroup_SendsBodyWithoutID() {
+	suite.respond("PUT /groups/{id}", http.StatusOK,
+		`{"id":"g1","name":"admins","ouId":"ou1","members":[{"id":"u1","type":"user"}]}`)
+	client := managementpb.NewGroupServiceClient(suite.conn)
+
+	group, err := client.UpdateGroup(context.Background(), &managementpb.UpdateGroupRequest{
+		Id: "g1", Name: "admins", OuId: "ou1",
+		Members: []*managementpb.GroupMember{{Id: "u1", Type: "user"}},
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal("admins", group.GetName())
+	suite.Require().Len(group.GetMembers(), 1)
+	suite.Equal("/groups/g1", suite.requests[0].URL.Path)
+	suite.JSONEq(`{"name":"admins","ouId":"ou1","members":[{"id":"u1","type":"user"}]}`, suite.bodies[0])
+}
+
+func (suite *ServicesTestSuite) TestListApplications_ReadsSinglePage() {
+	suite.respond("GET /applications", http.StatusOK,
+		`{"totalResults":2,"count":2,"applications":[{"id":"a1","name":"App 1","clientId":"c1"},`+
+			`{"id":"a2","name":"App 2"}]}`)
+	client := managementpb.NewApplicationServiceClient(suite.conn)
+
+	stream, err := client.ListApplications(context.Background(), &managementpb.ListApplicationsRequest{})
+	suite.Require().NoError(err)
+
+	var apps []*managementpb.Application
+	for {
+		app, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		suite.Require().NoError(err)
+		apps = append(apps, app)
+	}
+
+	suite.Require().Len(apps, 2)
+	suite.Equal("c1", apps[0].GetClientId())
+	suite.Equal("App 1", apps[0].GetDefinition().GetFields()["name"].GetStringValue())
+	suite.Require().Len(suite.requests, 1)
+	suite.Empty(suite.requests[0].URL.RawQuery)
+}
+
+func (suite *ServicesTestSuite) TestCreateApplication_RequiresDefinition() {
+	client := managementpb.NewApplicationServiceClient(suite.conn)
+
+	_, err := client.CreateApplication(context.Background(), &managementpb.CreateApplicationRequest{})
+
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *ServicesTestSuite) TestDeleteApplication() {
+	suite.respond("DELETE /applications/{id}", http.StatusNoContent, "")
+	client := managementpb.NewApplicationServiceClient(suite.conn)
+
+	_, err := client.DeleteApplication(context.Background(), &managementpb.DeleteApplicationRequest{Id: "a1"})
+
+	suite.Require().NoError(err)
+	suite.Equal(http.MethodDelete, suite.requests[0].Method)
+	suite.Equal("/applications/a1", suite.requests[0].URL.Path)
+}
+
+func (suite *ServicesTestSuite) TestCreateFlow_DecodesSummaryAndDefinition() {
+	suite.respond("POST /flows", http.StatusCreated,
+		`{"id":"f1","handle":"basic","name":"Basic","flowType":"AUTHENTICATION","activeVersion":1,`+
+			`"nodes":[{"id":"start","type":"START"}]}`)
+	client := managementpb.NewFlowServiceClient(suite.conn)
+	definition, err := structpb.NewStruct(map[string]interface{}{
+		"handle": "basic", "name": "Basic", "flowType": "AUTHENTICATION",
+	})
+	suite.Require().NoError(err)
+
+	flow, err := client.CreateFlow(context.Background(), &managementpb.CreateFlowRequest{Definition: definition})
+
+	suite.Require().NoError(err)
+	suite.Equal("f1", flow.GetId())
+	suite.Equal("AUTHENTICATION", flow.GetFlowType())
+	suite.Equal(int32(1), flow.GetActiveVersion())
+	suite.Len(flow.GetDefinition().GetFields()["nodes"].GetListValue().GetValues(), 1)
+
+	var sent map[string]interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(suite.bodies[0]), &sent))
+	suite.Equal("basic", sent["handle"])
+}
+
+func (suite *ServicesTestSuite) TestListFlows_FiltersByType() {
+	suite.respond("GET /flows", http.StatusOK, `{"totalResults":1,"startIndex":1,"count":1,"flows":[{"id":"f1"}]}`)
+	client := managementpb.NewFlowServiceClient(suite.conn)
+
+	stream, err := client.ListFlows(context.Background(), &managementpb.ListFlowsRequest{FlowType: "REGISTRATION"})
+	suite.Require().NoError(err)
+	flow, err := stream.Recv()
+	suite.Require().NoError(err)
+	_, err = stream.Recv()
+
+	suite.Equal("f1", flow.GetId())
+	suite.Equal(io.EOF, err)
+	suite.Equal("REGISTRATION", suite.requests[0].URL.Query().Get("flowType"))
+	suite.Equal("30", suite.requests[0].URL.Query().Get("limit"))
+}
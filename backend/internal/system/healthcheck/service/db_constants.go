@@ -35,3 +35,6 @@ var queryUserDBTable = model.DBQuery{
 	ID:    "HLC-00003",
 	Query: "SELECT 1",
 }
+
+// boltHealthCheckKey is the key read to check that the bolt runtime store is readable.
+const boltHealthCheckKey = "healthcheck"
@@ -38,14 +38,17 @@ type HealthCheckServiceInterface interface {
 type HealthCheckService struct {
 	DBProvider    provider.DBProviderInterface
 	RedisProvider provider.RedisProviderInterface
+	BoltProvider  provider.BoltProviderInterface
 }
 
 // Initialize creates a new instance of HealthCheckService with the provided dependencies.
 func Initialize(dbProvider provider.DBProviderInterface,
-	redisProvider provider.RedisProviderInterface) HealthCheckServiceInterface {
+	redisProvider provider.RedisProviderInterface,
+	boltProvider provider.BoltProviderInterface) HealthCheckServiceInterface {
 	return &HealthCheckService{
 		DBProvider:    dbProvider,
 		RedisProvider: redisProvider,
+		BoltProvider:  boltProvider,
 	}
 }
 
@@ -90,8 +93,11 @@ func (hcs *HealthCheckService) checkConfigDatabaseStatus(ctx context.Context, qu
 
 // checkRuntimeDatabaseStatus checks the status of the runtime database with the specified query.
 func (hcs *HealthCheckService) checkRuntimeDatabaseStatus(ctx context.Context, query dbmodel.DBQuery) model.Status {
-	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+	switch config.GetServerRuntime().Config.Database.Runtime.Type {
+	case provider.DataSourceTypeRedis:
 		return hcs.checkRedisRuntimeStatus(ctx)
+	case provider.DataSourceTypeBolt:
+		return hcs.checkBoltRuntimeStatus(ctx)
 	}
 	dbClient, err := hcs.DBProvider.GetRuntimeDBClient()
 	return hcs.executeDatabaseHealthCheck(ctx, "RuntimeDB", dbClient, err, query)
@@ -111,6 +117,20 @@ func (hcs *HealthCheckService) checkRedisRuntimeStatus(ctx context.Context) mode
 	return model.StatusUp
 }
 
+// checkBoltRuntimeStatus checks the health of the bolt runtime store by reading from it.
+func (hcs *HealthCheckService) checkBoltRuntimeStatus(ctx context.Context) model.Status {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "HealthCheckService"))
+	if hcs.BoltProvider == nil {
+		logger.Error(ctx, "Bolt runtime provider is not initialized")
+		return model.StatusDown
+	}
+	if _, err := hcs.BoltProvider.Get(boltHealthCheckKey); err != nil {
+		logger.Error(ctx, "Failed to read from bolt runtime store", log.Error(err))
+		return model.StatusDown
+	}
+	return model.StatusUp
+}
+
 // checkUserDatabaseStatus checks the status of the runtime database with the specified query.
 func (hcs *HealthCheckService) checkUserDatabaseStatus(ctx context.Context, query dbmodel.DBQuery) model.Status {
 	dbClient, err := hcs.DBProvider.GetUserDBClient()
@@ -64,7 +64,7 @@ func (suite *HealthCheckServiceTestSuite) SetupTest() {
 	}
 	_ = config.InitializeServerRuntime("test", testConfig)
 
-	suite.service = Initialize(nil, nil)
+	suite.service = Initialize(nil, nil, nil)
 }
 
 func (suite *HealthCheckServiceTestSuite) BeforeTest(suiteName, testName string) {
@@ -276,3 +276,29 @@ func (suite *HealthCheckServiceTestSuite) TestCheckReadiness_DBRetrievalError()
 
 	suite.mockDBProvider.AssertExpectations(suite.T())
 }
+
+func (suite *HealthCheckServiceTestSuite) TestCheckReadiness_BoltRuntime() {
+	runtimeCfg := &config.GetServerRuntime().Config.Database.Runtime
+	runtimeCfg.Type = "bolt"
+	defer func() { runtimeCfg.Type = "sqlite" }()
+
+	suite.mockConfigDB.On("Query", queryConfigDBTable).Return([]map[string]interface{}{}, nil)
+	suite.mockUserDB.On("Query", queryUserDBTable).Return([]map[string]interface{}{}, nil)
+
+	boltProvider := dbprovidermock.NewBoltProviderInterfaceMock(suite.T())
+	suite.service.(*HealthCheckService).BoltProvider = boltProvider
+	defer func() { suite.service.(*HealthCheckService).BoltProvider = nil }()
+
+	boltProvider.EXPECT().Get(boltHealthCheckKey).Return(nil, nil).Once()
+	assert.Equal(suite.T(), model.StatusUp, suite.service.CheckReadiness(context.Background()).Status)
+
+	boltProvider.EXPECT().Get(boltHealthCheckKey).Return(nil, errors.New("bolt error")).Once()
+	serverStatus := suite.service.CheckReadiness(context.Background())
+	assert.Equal(suite.T(), model.StatusDown, serverStatus.Status)
+	for _, status := range serverStatus.ServiceStatus {
+		if status.ServiceName == "RuntimeDB" {
+			assert.Equal(suite.T(), model.StatusDown, status.Status)
+		}
+	}
+	suite.mockDBProvider.AssertNotCalled(suite.T(), "GetRuntimeDBClient")
+}
@@ -21,6 +21,9 @@
 //
 //   - NewHTTPClient() - creates a client with default 30s timeout
 //   - NewHTTPClientWithTimeout(duration) - creates a client with custom timeout
+//   - NewHTTP2ClientWithTimeout(duration) - creates an HTTP/2 capable client with custom timeout
+//   - NewOutboundHTTPClient(duration) - creates a client for external services that applies the
+//     outbound HTTP configuration: timeouts, retries, TLS, proxy and circuit breakers
 //
 // Usage examples:
 //
@@ -43,6 +46,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/faultinjection"
 )
 
 // HTTPClientInterface defines the interface for HTTP client operations.
@@ -86,6 +90,23 @@ func NewHTTPClientWithTimeout(timeout time.Duration) HTTPClientInterface {
 	}
 }
 
+// NewHTTP2ClientWithTimeout creates an HTTPClient with a custom timeout that negotiates HTTP/2 over
+// TLS. Use this for services that only accept HTTP/2, such as the Apple Push Notification service.
+func NewHTTP2ClientWithTimeout(timeout time.Duration) HTTPClientInterface {
+	return &HTTPClient{
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				ForceAttemptHTTP2: true,
+				// #nosec G402 -- Min TLS version is TLS 1.2 or higher based on config
+				TLSClientConfig: &tls.Config{
+					MinVersion: GetTLSVersion(config.GetServerRuntime().Config),
+				},
+			},
+		},
+	}
+}
+
 // NewHTTPClientWithCheckRedirect creates an HTTPClient with a custom redirect policy.
 // Use this when redirect behavior must be controlled, e.g. to prevent HTTPS→HTTP downgrades.
 // Requires server runtime to be initialized before calling (reads TLS config at construction time).
@@ -194,25 +215,53 @@ func IsSSRFSafeURL(rawURL string) error {
 
 // Do executes an HTTP request and returns an HTTP response.
 func (c *HTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if err := faultinjection.Inject(req.Context(), faultinjection.TargetHTTP, req.URL.Hostname()); err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
 	return c.client.Do(req)
 }
 
 // Get issues a GET to the specified URL.
 func (c *HTTPClient) Get(url string) (*http.Response, error) {
+	if err := injectFault(url); err != nil {
+		return nil, err
+	}
 	return c.client.Get(url)
 }
 
 // Head issues a HEAD to the specified URL.
 func (c *HTTPClient) Head(url string) (*http.Response, error) {
+	if err := injectFault(url); err != nil {
+		return nil, err
+	}
 	return c.client.Head(url)
 }
 
 // Post issues a POST to the specified URL.
 func (c *HTTPClient) Post(url, contentType string, body io.Reader) (*http.Response, error) {
+	if err := injectFault(url); err != nil {
+		return nil, err
+	}
 	return c.client.Post(url, contentType, body)
 }
 
 // PostForm issues a POST to the specified URL, with data's keys and values URL-encoded as the request body.
 func (c *HTTPClient) PostForm(url string, data url.Values) (*http.Response, error) {
+	if err := injectFault(url); err != nil {
+		return nil, err
+	}
 	return c.client.PostForm(url, data)
 }
+
+// injectFault applies the active fault injection rules to a request for rawURL, matched by its host.
+// An unparsable URL is left for the HTTP client to reject.
+func injectFault(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil
+	}
+	return faultinjection.Inject(context.Background(), faultinjection.TargetHTTP, parsed.Hostname())
+}
@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/faultinjection"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -71,6 +72,18 @@ func (suite *HTTPClientTestSuite) TestNewHTTPClientWithTimeout() {
 	assert.Equal(suite.T(), timeout, httpClient.client.Timeout)
 }
 
+func (suite *HTTPClientTestSuite) TestNewHTTP2ClientWithTimeout() {
+	timeout := 5 * time.Second
+	client := NewHTTP2ClientWithTimeout(timeout)
+	assert.NotNil(suite.T(), client)
+
+	httpClient := client.(*HTTPClient)
+	assert.Equal(suite.T(), timeout, httpClient.client.Timeout)
+	transport, ok := httpClient.client.Transport.(*http.Transport)
+	assert.True(suite.T(), ok)
+	assert.True(suite.T(), transport.ForceAttemptHTTP2)
+}
+
 func (suite *HTTPClientTestSuite) TestNewHTTPClientWithDefaultSettings() {
 	// Test default behavior when no client is provided
 	client := NewHTTPClient()
@@ -296,3 +309,30 @@ func (suite *HTTPClientTestSuite) TestPostForm() {
 
 	_ = resp.Body.Close()
 }
+
+func (suite *HTTPClientTestSuite) TestInjectedFault() {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	suite.Require().NoError(faultinjection.Initialize(faultinjection.Config{
+		Enabled: true,
+		Rules: []faultinjection.Rule{
+			{Target: faultinjection.TargetHTTP, Match: "127.0.0.1", Probability: 1, Fail: true},
+		},
+	}))
+	defer func() { _ = faultinjection.Initialize(faultinjection.Config{}) }()
+
+	client := NewHTTPClient()
+
+	resp, err := client.Get(testServer.URL)
+	assert.ErrorIs(suite.T(), err, faultinjection.ErrInjectedFault)
+	assert.Nil(suite.T(), resp)
+
+	req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+	assert.NoError(suite.T(), err)
+	resp, err = client.Do(req)
+	assert.ErrorIs(suite.T(), err, faultinjection.ErrInjectedFault)
+	assert.Nil(suite.T(), resp)
+}
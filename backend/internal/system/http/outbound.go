@@ -0,0 +1,459 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/circuitbreaker"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const (
+	// defaultRetryInitialBackoff is the first retry delay when the retry settings do not give one.
+	defaultRetryInitialBackoff = 200 * time.Millisecond
+	// defaultRetryMaxBackoff caps the retry delay when the retry settings do not give a cap.
+	defaultRetryMaxBackoff = 2 * time.Second
+)
+
+var (
+	outboundMu       sync.Mutex
+	outboundRegistry *outboundDestinations
+)
+
+// NewOutboundHTTPClient creates a client for calls to external services, such as federated
+// identity providers, webhooks and external flow actions. Each request is made with the outbound
+// HTTP settings for its host: the timeout of every attempt, retries of idempotent calls, TLS with
+// custom CAs and client certificates, the proxy, and a circuit breaker shared by all outbound
+// clients. defaultTimeout applies to hosts without a configured timeout.
+// Requires server runtime to be initialized before calling.
+func NewOutboundHTTPClient(defaultTimeout time.Duration) HTTPClientInterface {
+	return &HTTPClient{
+		client: &http.Client{
+			Transport: &outboundTransport{
+				destinations:   getOutboundDestinations(),
+				defaultTimeout: defaultTimeout,
+			},
+		},
+	}
+}
+
+// getOutboundDestinations returns the outbound state shared by every outbound client, building it
+// from the server runtime on first use.
+func getOutboundDestinations() *outboundDestinations {
+	outboundMu.Lock()
+	defer outboundMu.Unlock()
+
+	if outboundRegistry == nil {
+		runtime := config.GetServerRuntime()
+		outboundRegistry = newOutboundDestinations(runtime.Config.OutboundHTTP, runtime.ServerHome,
+			GetTLSVersion(runtime.Config))
+	}
+	return outboundRegistry
+}
+
+// outboundSettings is the effective outbound configuration for a host.
+type outboundSettings struct {
+	// key identifies the configuration the settings come from: the destination host pattern, or an
+	// empty string for the top-level settings.
+	key     string
+	timeout time.Duration
+	proxy   config.OutboundProxyConfig
+	tls     config.OutboundTLSConfig
+	retry   config.OutboundRetryConfig
+	breaker config.OutboundCircuitBreakerConfig
+}
+
+// outboundDestinations resolves the outbound settings of a host and holds the state shared by every
+// outbound client: one transport per destination and one circuit breaker per host.
+type outboundDestinations struct {
+	cfg        config.OutboundHTTPConfig
+	serverHome string
+	minTLS     uint16
+	breakers   *circuitbreaker.Registry
+
+	mu         sync.Mutex
+	transports map[string]*http.Transport
+}
+
+// newOutboundDestinations creates the outbound state for the given configuration.
+func newOutboundDestinations(cfg config.OutboundHTTPConfig, serverHome string,
+	minTLS uint16) *outboundDestinations {
+	return &outboundDestinations{
+		cfg:        cfg,
+		serverHome: serverHome,
+		minTLS:     minTLS,
+		breakers:   circuitbreaker.NewRegistry(),
+		transports: make(map[string]*http.Transport),
+	}
+}
+
+// settingsFor returns the outbound settings for the host. A destination with the exact host name
+// wins over a wildcard destination; among wildcards the first match in the configuration wins.
+func (d *outboundDestinations) settingsFor(host string) outboundSettings {
+	settings := outboundSettings{
+		timeout: time.Duration(d.cfg.TimeoutSeconds) * time.Second,
+		proxy:   d.cfg.Proxy,
+		tls:     d.cfg.TLS,
+		retry:   d.cfg.Retry,
+		breaker: d.cfg.CircuitBreaker,
+	}
+
+	dest := d.matchDestination(strings.ToLower(host))
+	if dest == nil {
+		return settings
+	}
+	settings.key = dest.Host
+	if dest.TimeoutSeconds > 0 {
+		settings.timeout = time.Duration(dest.TimeoutSeconds) * time.Second
+	}
+	if dest.Proxy != nil {
+		settings.proxy = *dest.Proxy
+	}
+	if dest.TLS != nil {
+		settings.tls = *dest.TLS
+	}
+	if dest.Retry != nil {
+		settings.retry = *dest.Retry
+	}
+	if dest.CircuitBreaker != nil {
+		settings.breaker = *dest.CircuitBreaker
+	}
+	return settings
+}
+
+// matchDestination returns the destination configured for the host, or nil.
+func (d *outboundDestinations) matchDestination(host string) *config.OutboundDestinationConfig {
+	var wildcard *config.OutboundDestinationConfig
+	for i := range d.cfg.Destinations {
+		dest := &d.cfg.Destinations[i]
+		pattern := strings.ToLower(dest.Host)
+		if pattern == host {
+			return dest
+		}
+		if wildcard == nil && strings.HasPrefix(pattern, "*.") && strings.HasSuffix(host, pattern[1:]) {
+			wildcard = dest
+		}
+	}
+	return wildcard
+}
+
+// transportFor returns the transport for the settings, building it on first use. Transports are
+// shared by the hosts of a destination so that their connections are reused.
+func (d *outboundDestinations) transportFor(settings outboundSettings) (*http.Transport, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if transport, ok := d.transports[settings.key]; ok {
+		return transport, nil
+	}
+	tlsConfig, err := d.buildTLSConfig(settings.tls)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyFunc(settings.proxy)
+	transport.TLSClientConfig = tlsConfig
+	d.transports[settings.key] = transport
+	return transport, nil
+}
+
+// buildTLSConfig builds the client TLS configuration with the configured CAs and client certificate.
+func (d *outboundDestinations) buildTLSConfig(cfg config.OutboundTLSConfig) (*tls.Config, error) {
+	// #nosec G402 -- Min TLS version is TLS 1.2 or higher based on config
+	tlsConfig := &tls.Config{MinVersion: d.minTLS}
+
+	if cfg.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := os.ReadFile(d.resolvePath(cfg.CAFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read outbound CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in outbound CA file %q", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(d.resolvePath(cfg.CertFile), d.resolvePath(cfg.KeyFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load outbound client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// resolvePath resolves a path relative to the server home.
+func (d *outboundDestinations) resolvePath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(d.serverHome, path)
+}
+
+// breakerFor returns the circuit breaker of the host, or nil when circuit breaking is disabled.
+func (d *outboundDestinations) breakerFor(
+	host string, cfg config.OutboundCircuitBreakerConfig) *circuitbreaker.Breaker {
+	if !cfg.Enabled {
+		return nil
+	}
+	return d.breakers.Get("http:"+host, circuitbreaker.Settings{
+		FailureThreshold: cfg.FailureThreshold,
+		OpenDuration:     time.Duration(cfg.OpenSeconds) * time.Second,
+	})
+}
+
+// proxyFunc returns the proxy selection of the transport. Without a configured proxy URL the proxy
+// comes from the environment.
+func proxyFunc(cfg config.OutboundProxyConfig) func(*http.Request) (*url.URL, error) {
+	if cfg.URL == "" {
+		return http.ProxyFromEnvironment
+	}
+	proxyURL, err := url.Parse(cfg.URL)
+	if err != nil {
+		return func(*http.Request) (*url.URL, error) {
+			return nil, fmt.Errorf("invalid outbound proxy URL: %w", err)
+		}
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		if bypassProxy(req.URL.Hostname(), cfg.NoProxy) {
+			return nil, nil
+		}
+		return proxyURL, nil
+	}
+}
+
+// bypassProxy reports whether the host is called directly. An entry matches the host exactly, and
+// an entry with a leading dot also matches the hosts under that domain.
+func bypassProxy(host string, noProxy []string) bool {
+	host = strings.ToLower(host)
+	for _, entry := range noProxy {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if host == strings.TrimPrefix(entry, ".") {
+			return true
+		}
+		if strings.HasPrefix(entry, ".") && strings.HasSuffix(host, entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// outboundTransport makes each request with the outbound settings of its host.
+type outboundTransport struct {
+	destinations   *outboundDestinations
+	defaultTimeout time.Duration
+}
+
+// RoundTrip makes the request, retrying idempotent requests that fail with a connection error or
+// a 502, 503 or 504 response. Calls to a host whose circuit breaker is open fail with
+// circuitbreaker.ErrOpen without reaching the host.
+func (t *outboundTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Hostname()
+	settings := t.destinations.settingsFor(host)
+	transport, err := t.destinations.transportFor(settings)
+	if err != nil {
+		closeRequestBody(req)
+		return nil, err
+	}
+	breaker := t.destinations.breakerFor(host, settings.breaker)
+
+	timeout := settings.timeout
+	if timeout <= 0 {
+		timeout = t.defaultTimeout
+	}
+	attempts := 1
+	if settings.retry.MaxAttempts > 1 && isRetryable(req) {
+		attempts = settings.retry.MaxAttempts
+	}
+	backoff := retryBackoff(settings.retry)
+
+	for attempt := 1; ; attempt++ {
+		if breaker != nil && !breaker.Allow() {
+			closeRequestBody(req)
+			return nil, fmt.Errorf("%w for host %s", circuitbreaker.ErrOpen, host)
+		}
+
+		resp, cancel, err := t.attempt(transport, req, attempt, timeout)
+		if breaker != nil {
+			recordOutcome(req.Context(), breaker, resp, err)
+		}
+		if attempt >= attempts || req.Context().Err() != nil || !shouldRetry(resp, err) {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		cancel()
+
+		if err := sleepContext(req.Context(), backoff.next(attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt makes one attempt of the request with its own timeout. The returned cancel function
+// releases the timeout and must be called once the response body is no longer read.
+func (t *outboundTransport) attempt(transport http.RoundTripper, req *http.Request, attempt int,
+	timeout time.Duration) (*http.Response, context.CancelFunc, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	attemptReq := req.Clone(ctx)
+	if attempt > 1 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, cancel, err
+		}
+		attemptReq.Body = body
+	}
+
+	resp, err := transport.RoundTrip(attemptReq)
+	return resp, cancel, err
+}
+
+// recordOutcome records the result of an attempt in the circuit breaker. Connection errors,
+// timeouts and 5xx responses are failures; a request cancelled by its caller tells nothing about
+// the host.
+func recordOutcome(ctx context.Context, breaker *circuitbreaker.Breaker, resp *http.Response, err error) {
+	switch {
+	case ctx.Err() != nil:
+		breaker.Cancel()
+	case err != nil || resp.StatusCode >= http.StatusInternalServerError:
+		breaker.Failure()
+	default:
+		breaker.Success()
+	}
+}
+
+// isRetryable reports whether the request can be sent again: the method is idempotent and the body,
+// if any, can be recreated.
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// shouldRetry reports whether the result of an attempt is worth retrying.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, circuitbreaker.ErrOpen)
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoffSchedule is the exponential delay between retries.
+type backoffSchedule struct {
+	initial time.Duration
+	max     time.Duration
+}
+
+// retryBackoff returns the backoff schedule of the retry settings.
+func retryBackoff(cfg config.OutboundRetryConfig) backoffSchedule {
+	schedule := backoffSchedule{
+		initial: time.Duration(cfg.InitialBackoffMS) * time.Millisecond,
+		max:     time.Duration(cfg.MaxBackoffMS) * time.Millisecond,
+	}
+	if schedule.initial <= 0 {
+		schedule.initial = defaultRetryInitialBackoff
+	}
+	if schedule.max <= 0 {
+		schedule.max = defaultRetryMaxBackoff
+	}
+	return schedule
+}
+
+// next returns the delay after the given attempt, doubling from the initial delay up to the cap.
+func (b backoffSchedule) next(attempt int) time.Duration {
+	delay := b.initial
+	for i := 1; i < attempt && delay < b.max; i++ {
+		delay *= 2
+	}
+	return min(delay, b.max)
+}
+
+// sleepContext waits for the delay or until the context is done.
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// closeRequestBody closes the body of a request that is not sent, as a RoundTripper must.
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
+// cancelOnClose releases the timeout of an attempt when the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and releases the timeout.
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
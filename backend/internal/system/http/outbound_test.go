@@ -0,0 +1,324 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package http
+
+import (
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/circuitbreaker"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type OutboundClientTestSuite struct {
+	suite.Suite
+}
+
+func TestOutboundClientTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboundClientTestSuite))
+}
+
+// newClient creates an outbound client for the given configuration.
+func (s *OutboundClientTestSuite) newClient(cfg config.OutboundHTTPConfig, defaultTimeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &outboundTransport{
+			destinations:   newOutboundDestinations(cfg, s.T().TempDir(), 0),
+			defaultTimeout: defaultTimeout,
+		},
+	}
+}
+
+// countingServer starts a server that answers with the given statuses in turn, repeating the last.
+func countingServer(hits *int32, statuses ...int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(hits, 1))
+		status := statuses[min(n, len(statuses))-1]
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, "attempt")
+	}))
+}
+
+func (s *OutboundClientTestSuite) TestSettingsFor() {
+	d := newOutboundDestinations(config.OutboundHTTPConfig{
+		TimeoutSeconds: 10,
+		Retry:          config.OutboundRetryConfig{MaxAttempts: 2},
+		Destinations: []config.OutboundDestinationConfig{
+			{Host: "*.example.com", TimeoutSeconds: 3},
+			{Host: "idp.example.com", Retry: &config.OutboundRetryConfig{MaxAttempts: 5}},
+		},
+	}, "", 0)
+
+	def := d.settingsFor("other.org")
+	s.Equal("", def.key)
+	s.Equal(10*time.Second, def.timeout)
+	s.Equal(2, def.retry.MaxAttempts)
+
+	wildcard := d.settingsFor("SMS.example.com")
+	s.Equal("*.example.com", wildcard.key)
+	s.Equal(3*time.Second, wildcard.timeout)
+	s.Equal(2, wildcard.retry.MaxAttempts)
+
+	exact := d.settingsFor("idp.example.com")
+	s.Equal("idp.example.com", exact.key)
+	s.Equal(10*time.Second, exact.timeout)
+	s.Equal(5, exact.retry.MaxAttempts)
+
+	s.Equal("", d.settingsFor("example.com").key)
+}
+
+func (s *OutboundClientTestSuite) TestBypassProxy() {
+	noProxy := []string{"localhost", ".internal", " "}
+	s.True(bypassProxy("localhost", noProxy))
+	s.True(bypassProxy("api.internal", noProxy))
+	s.True(bypassProxy("internal", noProxy))
+	s.False(bypassProxy("example.com", noProxy))
+	s.False(bypassProxy("notinternal", noProxy))
+}
+
+func (s *OutboundClientTestSuite) TestBackoffSchedule() {
+	b := retryBackoff(config.OutboundRetryConfig{InitialBackoffMS: 100, MaxBackoffMS: 350})
+	s.Equal(100*time.Millisecond, b.next(1))
+	s.Equal(200*time.Millisecond, b.next(2))
+	s.Equal(350*time.Millisecond, b.next(3))
+	s.Equal(350*time.Millisecond, b.next(10))
+
+	def := retryBackoff(config.OutboundRetryConfig{})
+	s.Equal(defaultRetryInitialBackoff, def.initial)
+	s.Equal(defaultRetryMaxBackoff, def.max)
+}
+
+func (s *OutboundClientTestSuite) TestRetriesIdempotentRequest() {
+	var hits int32
+	server := countingServer(&hits, http.StatusServiceUnavailable, http.StatusOK)
+	defer server.Close()
+
+	client := s.newClient(config.OutboundHTTPConfig{
+		Retry: config.OutboundRetryConfig{MaxAttempts: 3, InitialBackoffMS: 1},
+	}, time.Second)
+
+	resp, err := client.Get(server.URL)
+	s.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal(int32(2), atomic.LoadInt32(&hits))
+}
+
+func (s *OutboundClientTestSuite) TestReturnsLastResponseWhenRetriesExhausted() {
+	var hits int32
+	server := countingServer(&hits, http.StatusBadGateway)
+	defer server.Close()
+
+	client := s.newClient(config.OutboundHTTPConfig{
+		Retry: config.OutboundRetryConfig{MaxAttempts: 3, InitialBackoffMS: 1},
+	}, time.Second)
+
+	resp, err := client.Get(server.URL)
+	s.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+	s.Equal(http.StatusBadGateway, resp.StatusCode)
+	s.Equal(int32(3), atomic.LoadInt32(&hits))
+}
+
+func (s *OutboundClientTestSuite) TestDoesNotRetryPost() {
+	var hits int32
+	server := countingServer(&hits, http.StatusServiceUnavailable, http.StatusOK)
+	defer server.Close()
+
+	client := s.newClient(config.OutboundHTTPConfig{
+		Retry: config.OutboundRetryConfig{MaxAttempts: 3, InitialBackoffMS: 1},
+	}, time.Second)
+
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	s.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+	s.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+	s.Equal(int32(1), atomic.LoadInt32(&hits))
+}
+
+func (s *OutboundClientTestSuite) TestRetryResendsBody() {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := s.newClient(config.OutboundHTTPConfig{
+		Retry: config.OutboundRetryConfig{MaxAttempts: 2, InitialBackoffMS: 1},
+	}, time.Second)
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("payload"))
+	s.Require().NoError(err)
+	resp, err := client.Do(req)
+	s.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+	s.Equal([]string{"payload", "payload"}, bodies)
+}
+
+func (s *OutboundClientTestSuite) TestAttemptTimeout() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := s.newClient(config.OutboundHTTPConfig{}, 50*time.Millisecond)
+
+	start := time.Now()
+	_, err := client.Get(server.URL)
+	s.Error(err)
+	s.Less(time.Since(start), time.Second)
+}
+
+func (s *OutboundClientTestSuite) TestBodyReadableAfterResponse() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "response body")
+	}))
+	defer server.Close()
+
+	client := s.newClient(config.OutboundHTTPConfig{}, time.Second)
+
+	resp, err := client.Get(server.URL)
+	s.Require().NoError(err)
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	s.NoError(resp.Body.Close())
+	s.Equal("response body", string(body))
+}
+
+func (s *OutboundClientTestSuite) TestCircuitBreakerFailsFast() {
+	var hits int32
+	server := countingServer(&hits, http.StatusInternalServerError)
+	defer server.Close()
+
+	client := s.newClient(config.OutboundHTTPConfig{
+		CircuitBreaker: config.OutboundCircuitBreakerConfig{Enabled: true, FailureThreshold: 2, OpenSeconds: 60},
+	}, time.Second)
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		s.Require().NoError(err)
+		_ = resp.Body.Close()
+	}
+
+	_, err := client.Get(server.URL)
+	s.Require().Error(err)
+	s.True(errors.Is(err, circuitbreaker.ErrOpen))
+	s.Equal(int32(2), atomic.LoadInt32(&hits))
+}
+
+func (s *OutboundClientTestSuite) TestCustomCA() {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(s.T().TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	s.Require().NoError(os.WriteFile(caFile, certPEM, 0o600))
+
+	_, err := s.newClient(config.OutboundHTTPConfig{}, time.Second).Get(server.URL)
+	s.Error(err, "the test server certificate is not trusted by default")
+
+	client := s.newClient(config.OutboundHTTPConfig{TLS: config.OutboundTLSConfig{CAFile: caFile}}, time.Second)
+	resp, err := client.Get(server.URL)
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *OutboundClientTestSuite) TestInvalidTLSFiles() {
+	client := s.newClient(config.OutboundHTTPConfig{
+		TLS: config.OutboundTLSConfig{CertFile: "missing.crt", KeyFile: "missing.key"},
+	}, time.Second)
+	_, err := client.Get("https://idp.example.com")
+	s.Error(err)
+	s.Contains(err.Error(), "failed to load outbound client certificate")
+
+	client = s.newClient(config.OutboundHTTPConfig{TLS: config.OutboundTLSConfig{CAFile: "missing.pem"}}, time.Second)
+	_, err = client.Get("https://idp.example.com")
+	s.Error(err)
+	s.Contains(err.Error(), "failed to read outbound CA file")
+}
+
+func (s *OutboundClientTestSuite) TestProxy() {
+	var proxied int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&proxied, 1)
+		_, _ = io.WriteString(w, "via proxy "+r.URL.Host)
+	}))
+	defer proxy.Close()
+
+	direct := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "direct")
+	}))
+	defer direct.Close()
+
+	client := s.newClient(config.OutboundHTTPConfig{
+		Proxy: config.OutboundProxyConfig{URL: proxy.URL, NoProxy: []string{"127.0.0.1"}},
+	}, time.Second)
+
+	resp, err := client.Get("http://idp.example.com/jwks")
+	s.Require().NoError(err)
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	s.Equal("via proxy idp.example.com", string(body))
+
+	resp, err = client.Get(direct.URL)
+	s.Require().NoError(err)
+	body, _ = io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	s.Equal("direct", string(body))
+	s.Equal(int32(1), atomic.LoadInt32(&proxied))
+}
+
+func (s *OutboundClientTestSuite) TestNewOutboundHTTPClient_SharesState() {
+	config.ResetServerRuntime()
+	s.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+	outboundMu.Lock()
+	outboundRegistry = nil
+	outboundMu.Unlock()
+
+	first := NewOutboundHTTPClient(time.Second).(*HTTPClient)
+	second := NewOutboundHTTPClient(5 * time.Second).(*HTTPClient)
+
+	firstTransport := first.client.Transport.(*outboundTransport)
+	secondTransport := second.client.Transport.(*outboundTransport)
+	s.Same(firstTransport.destinations, secondTransport.destinations)
+	s.Equal(5*time.Second, secondTransport.defaultTimeout)
+}
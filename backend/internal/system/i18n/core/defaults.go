@@ -37,12 +37,16 @@ var defaultMessages = map[string]string{
 	"design.resolve.error.unsupported_type_description": "The specified resolve type is not yet supported. Currently only 'APP' type is supported",
 	"error.actor_not_found": "Actor not found",
 	"error.actor_not_found_description": "The requested actor does not exist",
+	"error.agentservice.accesstoken_invalid_claims_policy_description": "Access token claims policy must use non-empty claim names, must not both exclude and hash a claim, and must map scoped claims to a non-empty scope",
+	"error.agentservice.accesstoken_unsupported_signing_alg_description": "Access token signing algorithm is not supported",
 	"error.agentservice.agent_already_exists_with_client_id": "Client ID already in use",
 	"error.agentservice.agent_already_exists_with_client_id_description": "An entity with the same client ID already exists",
 	"error.agentservice.agent_already_exists_with_name": "Agent already exists",
 	"error.agentservice.agent_already_exists_with_name_description": "An agent with the same name already exists",
 	"error.agentservice.agent_not_found": "Agent not found",
 	"error.agentservice.agent_not_found_description": "The agent with the specified id does not exist",
+	"error.agentservice.application_type_cannot_use_client_secret_description": "Native and SPA applications cannot use a client secret",
+	"error.agentservice.application_type_requires_pkce_description": "Native and SPA applications must have PKCE required set to true",
 	"error.agentservice.attribute_conflict": "Attribute conflict",
 	"error.agentservice.attribute_conflict_description": "An agent with the same unique attribute value already exists",
 	"error.agentservice.auth_code_requires_code_response_type_description": "authorization_code grant type requires 'code' response type",
@@ -67,10 +71,12 @@ var defaultMessages = map[string]string{
 	"error.agentservice.idtoken_unsupported_encryption_alg_description": "ID token encryption algorithm is not supported",
 	"error.agentservice.idtoken_unsupported_encryption_enc_description": "ID token content-encryption algorithm is not supported",
 	"error.agentservice.idtoken_unsupported_response_type_description": "ID token responseType is not supported",
+	"error.agentservice.idtoken_unsupported_signing_alg_description": "ID token signing algorithm is not supported",
 	"error.agentservice.invalid_agent_name": "Invalid agent name",
 	"error.agentservice.invalid_agent_name_description": "The agent name must be provided and non-empty",
 	"error.agentservice.invalid_agent_type": "Invalid agent type",
 	"error.agentservice.invalid_agent_type_description": "The agent type must be provided",
+	"error.agentservice.invalid_application_type_description": "Application type must be 'web', 'native' or 'spa'",
 	"error.agentservice.invalid_auth_flow_id": "Invalid auth flow ID",
 	"error.agentservice.invalid_auth_flow_id_description": "The provided authentication flow ID is invalid",
 	"error.agentservice.invalid_certificate_type": "Invalid certificate type",
@@ -95,6 +101,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_public_client_configuration_description": "The public client configuration is invalid",
 	"error.agentservice.invalid_redirect_uri": "Invalid redirect URI",
 	"error.agentservice.invalid_redirect_uri_description": "One or more redirect URIs are not valid",
+	"error.agentservice.invalid_redirect_uri_matching_description": "Redirect URI matching must be 'exact' or 'loopback'",
 	"error.agentservice.invalid_registration_flow_id": "Invalid registration flow ID",
 	"error.agentservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.agentservice.invalid_request_format": "Invalid request format",
@@ -125,6 +132,8 @@ var defaultMessages = map[string]string{
 	"error.agentservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.agentservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
 	"error.agentservice.redirect_uri_fragment_not_allowed_description": "Redirect URIs must not contain a fragment component",
+	"error.agentservice.redirect_uri_not_allowed_for_application_type_description": "Web and SPA applications must use http or https redirect URIs; native applications must use a custom scheme, https, or an http loopback redirect URI",
+	"error.agentservice.redirect_uri_wildcard_host_not_allowed_description": "Redirect URI hosts must not contain wildcards",
 	"error.agentservice.refresh_token_cannot_be_sole_grant_description": "refresh_token grant type cannot be used without another grant type",
 	"error.agentservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
 	"error.agentservice.schema_validation_failed": "Schema validation failed",
@@ -143,12 +152,16 @@ var defaultMessages = map[string]string{
 	"error.agentservice.userinfo_unsupported_encryption_enc_description": "userinfo content-encryption algorithm is not supported",
 	"error.agentservice.userinfo_unsupported_response_type_description": "userinfo responseType is not supported",
 	"error.agentservice.userinfo_unsupported_signing_alg_description": "userinfo signing algorithm is not supported",
+	"error.applicationservice.accesstoken_invalid_claims_policy_description": "Access token claims policy must use non-empty claim names, must not both exclude and hash a claim, and must map scoped claims to a non-empty scope",
+	"error.applicationservice.accesstoken_unsupported_signing_alg_description": "Access token signing algorithm is not supported",
 	"error.applicationservice.application_already_exists": "Application already exists",
 	"error.applicationservice.application_already_exists_description": "An application with the same name already exists",
 	"error.applicationservice.application_is_nil": "Application is nil",
 	"error.applicationservice.application_is_nil_description": "The provided application object is nil",
 	"error.applicationservice.application_not_found": "Application not found",
 	"error.applicationservice.application_not_found_description": "The requested application could not be found",
+	"error.applicationservice.application_type_cannot_use_client_secret_description": "Native and SPA applications cannot use a client secret",
+	"error.applicationservice.application_type_requires_pkce_description": "Native and SPA applications must have PKCE required set to true",
 	"error.applicationservice.application_with_client_id_already_exists": "Application with client ID already exists",
 	"error.applicationservice.application_with_client_id_already_exists_description": "An application with the same client ID already exists",
 	"error.applicationservice.auth_code_requires_code_response_type_description": "authorization_code grant type requires 'code' response type",
@@ -168,6 +181,9 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.consent_synchronization_failed_description": "Failed to synchronize consent configurations for the application",
 	"error.applicationservice.error_retrieving_flow_definition": "Error retrieving flow definition",
 	"error.applicationservice.error_retrieving_flow_definition_description": "An error occurred while retrieving the flow definition",
+	"error.applicationservice.grant_validity_invalid_grant_type_description": "Grant validity periods must be keyed by a supported grant type",
+	"error.applicationservice.history_version_not_found": "History version not found",
+	"error.applicationservice.history_version_not_found_description": "The requested version of the application's configuration history could not be found",
 	"error.applicationservice.idtoken_encryption_alg_requires_enc_description": "idToken encryptionEnc is required when encryptionAlg is set",
 	"error.applicationservice.idtoken_encryption_enc_requires_alg_description": "idToken encryptionAlg is required when encryptionEnc is set",
 	"error.applicationservice.idtoken_encryption_fields_not_allowed_description": "idToken encryptionAlg and encryptionEnc must not be set when responseType is JWT",
@@ -176,17 +192,25 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.idtoken_unsupported_encryption_alg_description": "ID token encryption algorithm is not supported",
 	"error.applicationservice.idtoken_unsupported_encryption_enc_description": "ID token content-encryption algorithm is not supported",
 	"error.applicationservice.idtoken_unsupported_response_type_description": "ID token responseType is not supported",
+	"error.applicationservice.idtoken_unsupported_signing_alg_description": "ID token signing algorithm is not supported",
+	"error.applicationservice.invalid_access_policy": "Invalid access policy",
+	"error.applicationservice.invalid_access_policy_description": "Networks must be valid IP addresses or CIDR ranges, and countries must be ISO 3166-1 alpha-2 codes",
 	"error.applicationservice.invalid_acr_values": "Invalid ACR value",
 	"error.applicationservice.invalid_acr_values_description": "One or more ACR values in acr_values are not recognized by the system",
 	"error.applicationservice.invalid_acr_values_unrecognized": "ACR value '{{param(acr)}}' is not recognized by the system",
+	"error.applicationservice.invalid_allowed_network_description": "Allowed networks must be CIDR ranges or IP addresses",
+	"error.applicationservice.invalid_allowed_origin_description": "Allowed origins must be http or https origins without a path, wildcard, or the null origin",
 	"error.applicationservice.invalid_application_id": "Invalid application ID",
 	"error.applicationservice.invalid_application_id_description": "The provided application ID is invalid or empty",
 	"error.applicationservice.invalid_application_name": "Invalid application name",
 	"error.applicationservice.invalid_application_name_description": "The provided application name is invalid or empty",
+	"error.applicationservice.invalid_application_type_description": "Application type must be 'web', 'native' or 'spa'",
 	"error.applicationservice.invalid_application_url": "Invalid application URL",
 	"error.applicationservice.invalid_application_url_description": "The provided application URL is not a valid URI",
 	"error.applicationservice.invalid_auth_flow_id": "Invalid auth flow ID",
 	"error.applicationservice.invalid_auth_flow_id_description": "The provided authentication flow ID is invalid",
+	"error.applicationservice.invalid_branding": "Invalid branding",
+	"error.applicationservice.invalid_branding_description": "The logo URL must be a valid URL, colors must be hex values, and localized text must be keyed by valid language tags",
 	"error.applicationservice.invalid_certificate_type": "Invalid certificate type",
 	"error.applicationservice.invalid_certificate_type_description": "The provided certificate type is not supported",
 	"error.applicationservice.invalid_certificate_value": "Invalid certificate value",
@@ -195,28 +219,37 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_client_id_description": "The provided client ID is invalid or empty",
 	"error.applicationservice.invalid_grant_type": "Invalid grant type",
 	"error.applicationservice.invalid_grant_type_description": "One or more provided grant types are invalid",
+	"error.applicationservice.invalid_history_version": "Invalid history version",
+	"error.applicationservice.invalid_history_version_description": "The history version must be a positive integer",
 	"error.applicationservice.invalid_inbound_auth_config": "Invalid inbound auth config",
 	"error.applicationservice.invalid_inbound_auth_config_description": "The provided inbound authentication configuration is invalid",
 	"error.applicationservice.invalid_jwks_uri": "Invalid JWKS URI",
 	"error.applicationservice.invalid_jwks_uri_description": "The provided JWKS URI is not a valid URI",
 	"error.applicationservice.invalid_jwks_uri_scheme": "Invalid JWKS URI scheme",
 	"error.applicationservice.invalid_jwks_uri_scheme_description": "'jwks_uri' must use HTTPS scheme",
+	"error.applicationservice.invalid_limit": "Invalid pagination parameter",
+	"error.applicationservice.invalid_limit_description": "The limit parameter must be between 1 and 100",
 	"error.applicationservice.invalid_logo_url": "Invalid logo URL",
 	"error.applicationservice.invalid_logo_url_description": "The provided logo URL is not a valid URI",
 	"error.applicationservice.invalid_oauth_configuration": "Invalid OAuth configuration",
 	"error.applicationservice.invalid_oauth_configuration_description": "The OAuth configuration is invalid",
+	"error.applicationservice.invalid_offset": "Invalid pagination parameter",
+	"error.applicationservice.invalid_offset_description": "The offset parameter must be a non-negative integer",
 	"error.applicationservice.invalid_public_client_configuration": "Invalid public client configuration",
 	"error.applicationservice.invalid_public_client_configuration_description": "The public client configuration is invalid",
 	"error.applicationservice.invalid_recovery_flow_id": "Invalid recovery flow ID",
 	"error.applicationservice.invalid_recovery_flow_id_description": "The provided recovery flow ID is invalid",
 	"error.applicationservice.invalid_redirect_uri": "Invalid redirect URI",
 	"error.applicationservice.invalid_redirect_uri_description": "One or more provided redirect URIs are not valid URIs",
+	"error.applicationservice.invalid_redirect_uri_matching_description": "Redirect URI matching must be 'exact' or 'loopback'",
 	"error.applicationservice.invalid_registration_flow_id": "Invalid registration flow ID",
 	"error.applicationservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.applicationservice.invalid_request_format": "Invalid request format",
 	"error.applicationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.applicationservice.invalid_response_type": "Invalid response type",
 	"error.applicationservice.invalid_response_type_description": "One or more provided response types are invalid",
+	"error.applicationservice.invalid_sharing_configuration": "Invalid sharing configuration",
+	"error.applicationservice.invalid_sharing_configuration_description": "Each shared organization unit must exist and be listed once, and an email domain can be claimed by only one organization unit",
 	"error.applicationservice.invalid_token_endpoint_auth_method": "Invalid token endpoint authentication method",
 	"error.applicationservice.invalid_token_endpoint_auth_method_description": "The provided token endpoint authentication method is invalid",
 	"error.applicationservice.invalid_user_attribute": "Invalid user attribute",
@@ -237,11 +270,17 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.applicationservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
 	"error.applicationservice.redirect_uri_fragment_not_allowed_description": "Redirect URIs must not contain a fragment component",
+	"error.applicationservice.redirect_uri_not_allowed_for_application_type_description": "Web and SPA applications must use http or https redirect URIs; native applications must use a custom scheme, https, or an http loopback redirect URI",
+	"error.applicationservice.redirect_uri_wildcard_host_not_allowed_description": "Redirect URI hosts must not contain wildcards",
 	"error.applicationservice.refresh_token_cannot_be_sole_grant_description": "refresh_token grant type cannot be used without another grant type",
+	"error.applicationservice.refresh_token_invalid_max_lifetime_description": "Refresh token maximum lifetime must not be shorter than the refresh token validity period",
 	"error.applicationservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
+	"error.applicationservice.restore_window_expired": "Restore window expired",
+	"error.applicationservice.restore_window_expired_description": "The retention window for restoring the application has passed",
 	"error.applicationservice.result_limit_exceeded": "Result limit exceeded",
 	"error.applicationservice.theme_not_found": "Theme not found",
 	"error.applicationservice.theme_not_found_description": "The specified theme configuration does not exist",
+	"error.applicationservice.token_validity_out_of_bounds_description": "Token validity periods must fall within the token lifetime bounds of the deployment",
 	"error.applicationservice.userinfo_alg_requires_response_type_description": "userinfo responseType is required when signingAlg or encryptionAlg is set",
 	"error.applicationservice.userinfo_encryption_alg_requires_enc_description": "userinfo encryptionEnc is required when encryptionAlg is set",
 	"error.applicationservice.userinfo_encryption_enc_requires_alg_description": "userinfo encryptionAlg is required when encryptionEnc is set",
@@ -288,6 +327,8 @@ var defaultMessages = map[string]string{
 	"error.authnmgrservice.get_entity_reference_client_error_description": "The entity reference fetch was rejected by the provider",
 	"error.authnmgrservice.invalid_request": "Invalid request",
 	"error.authnmgrservice.invalid_request_description": "The authentication request is invalid",
+	"error.authnmgrservice.user_not_active": "User not active",
+	"error.authnmgrservice.user_not_active_description": "The user account is locked, disabled or pending activation",
 	"error.authnmgrservice.user_not_found": "User not found",
 	"error.authnmgrservice.user_not_found_description": "No user found matching the provided identifiers",
 	"error.authnotpservice.error_processing_otp": "Error processing OTP",
@@ -304,6 +345,8 @@ var defaultMessages = map[string]string{
 	"error.authnotpservice.invalid_sender_id_description": "The provided sender ID is invalid or empty",
 	"error.authnotpservice.invalid_session_token": "Invalid session token",
 	"error.authnotpservice.invalid_session_token_description": "The provided session token is invalid or empty",
+	"error.authnotpservice.otp_resend_throttled": "OTP requested too soon",
+	"error.authnotpservice.otp_resend_throttled_description": "An OTP was sent to this recipient recently. Wait before requesting another one",
 	"error.authnotpservice.unsupported_channel": "Unsupported channel",
 	"error.authnotpservice.unsupported_channel_description": "The provided channel is not supported for OTP authentication",
 	"error.authnservice.ambiguous_user": "Ambiguous user",
@@ -387,6 +430,18 @@ var defaultMessages = map[string]string{
 	"error.authzen.missing_resource_id_description": "Resource id is required",
 	"error.authzen.missing_subject": "Missing subject",
 	"error.authzen.missing_subject_description": "Subject id is required",
+	"error.certificateauthservice.certificate_auth_disabled": "Certificate authentication disabled",
+	"error.certificateauthservice.certificate_auth_disabled_description": "Certificate authentication is not enabled on the server",
+	"error.certificateauthservice.certificate_not_presented": "Certificate not presented",
+	"error.certificateauthservice.certificate_not_presented_description": "The client did not present a certificate",
+	"error.certificateauthservice.certificate_revoked": "Certificate revoked",
+	"error.certificateauthservice.certificate_revoked_description": "The certificate was revoked by its issuer",
+	"error.certificateauthservice.identity_not_found": "Certificate identity not found",
+	"error.certificateauthservice.identity_not_found_description": "The certificate does not carry the field that identifies the user",
+	"error.certificateauthservice.invalid_certificate": "Invalid certificate",
+	"error.certificateauthservice.invalid_certificate_description": "The certificate is not trusted or not valid for client authentication",
+	"error.certificateauthservice.revocation_status_unknown": "Revocation status unknown",
+	"error.certificateauthservice.revocation_status_unknown_description": "The revocation status of the certificate could not be determined",
 	"error.certservice.certificate_already_exists": "Certificate already exists",
 	"error.certservice.certificate_already_exists_description": "A certificate with the same reference type and ID already exists",
 	"error.certservice.certificate_not_found": "Certificate not found",
@@ -403,6 +458,22 @@ var defaultMessages = map[string]string{
 	"error.certservice.invalid_reference_type_description": "The provided certificate reference type is invalid",
 	"error.certservice.reference_update_not_allowed": "Reference update is not allowed",
 	"error.certservice.reference_update_not_allowed_description": "Updating the reference type or ID of an existing certificate is not allowed",
+	"error.claimsourceservice.claim_source_already_exists": "Claim source already exists",
+	"error.claimsourceservice.claim_source_already_exists_description": "A claim source with the same name already exists",
+	"error.claimsourceservice.claim_source_not_found": "Claim source not found",
+	"error.claimsourceservice.claim_source_not_found_description": "The claim source with the specified id does not exist",
+	"error.claimsourceservice.invalid_claims": "Invalid claims",
+	"error.claimsourceservice.invalid_claims_description": "At least one claim is required, and each claim name must be non-empty, must not exceed 100 characters and must not start with an underscore",
+	"error.claimsourceservice.invalid_endpoint": "Invalid claim source endpoint",
+	"error.claimsourceservice.invalid_endpoint_description": "The claim source endpoint must be an absolute HTTPS URL of at most 2048 characters",
+	"error.claimsourceservice.invalid_name": "Invalid claim source name",
+	"error.claimsourceservice.invalid_name_description": "The claim source name is required, must not exceed 100 characters, must start with a letter or digit and may contain only letters, digits, dots, underscores and hyphens",
+	"error.claimsourceservice.invalid_request_format": "Invalid request format",
+	"error.claimsourceservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.claimsourceservice.invalid_type": "Invalid claim source type",
+	"error.claimsourceservice.invalid_type_description": "The claim source type must be aggregated or distributed",
+	"error.configreloadservice.invalid_configuration": "Invalid configuration",
+	"error.configreloadservice.invalid_configuration_description": "The configuration failed to load or validate; the running configuration is unchanged",
 	"error.consentenforcerservice.consent_create_failed": "Failed to create consent record",
 	"error.consentenforcerservice.consent_create_failed_description": "Error while creating consent record in the consent service",
 	"error.consentenforcerservice.consent_search_failed": "Failed to search consent records",
@@ -453,6 +524,18 @@ var defaultMessages = map[string]string{
 	"error.consentservice.purpose_not_found_description": "The consent purpose with the specified ID does not exist",
 	"error.consentservice.unauthorized": "Unauthorized to access consent service",
 	"error.consentservice.unauthorized_description": "The consent service returned an unauthorized response",
+	"error.crossdeviceauthservice.invalid_request_format": "Invalid request format",
+	"error.crossdeviceauthservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.crossdeviceauthservice.request_already_claimed": "Sign-in request already claimed",
+	"error.crossdeviceauthservice.request_already_claimed_description": "The sign-in request was already claimed by another user",
+	"error.crossdeviceauthservice.request_already_decided": "Sign-in request already decided",
+	"error.crossdeviceauthservice.request_already_decided_description": "The sign-in request was already approved or denied",
+	"error.crossdeviceauthservice.request_not_approved": "Sign-in request not approved",
+	"error.crossdeviceauthservice.request_not_approved_description": "The sign-in request was not approved",
+	"error.crossdeviceauthservice.request_not_claimed": "Sign-in request not claimed",
+	"error.crossdeviceauthservice.request_not_claimed_description": "The sign-in request must be claimed before it can be approved or denied",
+	"error.crossdeviceauthservice.request_not_found": "Sign-in request not found",
+	"error.crossdeviceauthservice.request_not_found_description": "The sign-in request is unknown or has expired",
 	"error.dcr.invalid_client_metadata": "Invalid client metadata",
 	"error.dcr.invalid_client_metadata_description": "One or more client metadata values are invalid",
 	"error.dcr.invalid_redirect_uri": "Invalid redirect URI",
@@ -471,6 +554,14 @@ var defaultMessages = map[string]string{
 	"error.declarative_resource.delete_operation_not_allowed_description": "Deleting declarative resources is not permitted",
 	"error.declarative_resource.update_operation_not_allowed": "Declarative resource update operation is not allowed",
 	"error.declarative_resource.update_operation_not_allowed_description": "Updating declarative resources is not permitted",
+	"error.deviceservice.device_not_found": "Device not found",
+	"error.deviceservice.device_not_found_description": "The device is not known for the user",
+	"error.emailservice.invalid_callback_payload": "Invalid callback payload",
+	"error.emailservice.invalid_callback_payload_description": "The delivery status callback payload is malformed",
+	"error.emailservice.invalid_callback_token": "Invalid callback token",
+	"error.emailservice.invalid_callback_token_description": "The delivery status callback does not carry a valid callback token",
+	"error.emailservice.invalid_limit": "Invalid limit",
+	"error.emailservice.invalid_limit_description": "The limit must be a positive number",
 	"error.encoding_error": "Encoding error",
 	"error.encoding_error_description": "An error occurred while encoding the response",
 	"error.entity_not_found": "Entity not found",
@@ -536,8 +627,16 @@ var defaultMessages = map[string]string{
 	"error.exportservice.no_resources_found": "No resources found",
 	"error.exportservice.no_resources_found_description": "No valid resources found for the provided identifiers",
 	"error.exportservice.no_valid_resources_for_export_description": "No valid resources found for export",
+	"error.flow.core.executor_circuit_open": "Executor temporarily unavailable",
+	"error.flow.core.executor_circuit_open_description": "The step is temporarily unavailable. Please try again later",
 	"error.flow.core.executor_prerequisite_not_met": "A prerequisite for the executor was not met",
 	"error.flow.core.executor_prerequisite_not_met_description": "One or more prerequisites required for the executor were not satisfied. Please check the inputs and try again.",
+	"error.flow.core.executor_retry_exhausted": "Executor retries exhausted",
+	"error.flow.core.executor_retry_exhausted_description": "The step could not be completed after retrying. Please try again later",
+	"error.flow.core.executor_timed_out": "Executor timed out",
+	"error.flow.core.executor_timed_out_description": "The step did not complete in time. Please try again later",
+	"error.flow.core.parallel_branches_failed": "Parallel branches failed",
+	"error.flow.core.parallel_branches_failed_description": "Not enough parallel branches completed successfully to continue the flow",
 	"error.flow.core.prompt_invalid_action": "Invalid action provided",
 	"error.flow.core.prompt_invalid_action_description": "The action provided is not valid for the current flow step",
 	"error.flow.graphbuilder.graph_build_failure": "Graph build failure",
@@ -545,10 +644,16 @@ var defaultMessages = map[string]string{
 	"error.flow.graphbuilder.invalid_flow_data": "Invalid flow data",
 	"error.flow.graphbuilder.invalid_flow_data_description": "The flow definition contains invalid data",
 	"error.flow.graphbuilder.invalid_flow_data_nil_or_empty_description": "Flow definition is nil or has no nodes",
+	"error.flowexecservice.access_policy_denied": "Access denied",
+	"error.flowexecservice.access_policy_denied_description": "The application does not allow access from the client network or location",
 	"error.flowexecservice.application_retrieval_error": "Application retrieval error",
 	"error.flowexecservice.application_retrieval_error_description": "Error while retrieving application details",
+	"error.flowexecservice.debug_trace_not_permitted": "Debug trace not permitted",
+	"error.flowexecservice.debug_trace_not_permitted_description": "Debug tracing of a flow execution requires administrative permissions",
 	"error.flowexecservice.direct_flow_initiation_not_permitted": "Direct flow initiation not permitted",
 	"error.flowexecservice.direct_flow_initiation_not_permitted_description": "Direct flow initiation is not permitted for this application type",
+	"error.flowexecservice.execution_trace_not_found": "Execution trace not found",
+	"error.flowexecservice.execution_trace_not_found_description": "No debug trace is recorded for the given flow execution",
 	"error.flowexecservice.flow_secret_invalid": "Authentication failed",
 	"error.flowexecservice.flow_secret_invalid_description": "The provided flow secret is invalid",
 	"error.flowexecservice.flow_secret_required": "Authentication required",
@@ -585,14 +690,26 @@ var defaultMessages = map[string]string{
 	"error.flowmetaservice.ou_not_found": "Resource not found",
 	"error.flowmetaservice.ou_not_found_description": "The specified organization unit does not exist",
 	"error.flowmetaservice.resource_not_found": "Resource not found",
+	"error.flowmgtservice.call_node_ambiguous_flow_ref_description": "CALL node '{{param(nodeID)}}' must reference a flow by either ref or handle, not both",
+	"error.flowmgtservice.call_node_empty_output_description": "CALL node '{{param(nodeID)}}' must not have empty output keys",
 	"error.flowmgtservice.call_node_has_executor_description": "CALL node '{{param(nodeID)}}' must not have an executor",
 	"error.flowmgtservice.call_node_has_next_description": "CALL node '{{param(nodeID)}}' must not have next",
 	"error.flowmgtservice.call_node_has_on_incomplete_description": "CALL node '{{param(nodeID)}}' must not have onIncomplete",
 	"error.flowmgtservice.call_node_has_prompts_description": "CALL node '{{param(nodeID)}}' must not have prompts",
-	"error.flowmgtservice.call_node_missing_flow_ref_description": "CALL node '{{param(nodeID)}}' must have a flow reference with a non-empty ref",
+	"error.flowmgtservice.call_node_missing_flow_ref_description": "CALL node '{{param(nodeID)}}' must have a flow reference with a non-empty ref or handle",
 	"error.flowmgtservice.call_node_missing_on_success_description": "CALL node '{{param(nodeID)}}' must have onSuccess",
 	"error.flowmgtservice.cannot_update_flow_type": "Invalid update request",
 	"error.flowmgtservice.cannot_update_flow_type_description": "The flow type cannot be changed once created",
+	"error.flowmgtservice.circuit_open_on_non_task_node_description": "Node '{{param(nodeID)}}' must not have onCircuitOpen unless its type is TASK_EXECUTION",
+	"error.flowmgtservice.cyclic_path_without_prompt_description": "Nodes {{param(nodeIDs)}} form a cycle that never reaches a PROMPT node",
+	"error.flowmgtservice.decision_branch_missing_conditions_description": "DECISION node '{{param(nodeID)}}': branch at index {{param(index)}} must have at least one condition",
+	"error.flowmgtservice.decision_branch_missing_next_description": "DECISION node '{{param(nodeID)}}': branch at index {{param(index)}} must have next",
+	"error.flowmgtservice.decision_condition_invalid_operator_description": "DECISION node '{{param(nodeID)}}': branch at index {{param(index)}} has invalid operator '{{param(operator)}}'",
+	"error.flowmgtservice.decision_condition_missing_key_description": "DECISION node '{{param(nodeID)}}': conditions of branch at index {{param(index)}} must have a key",
+	"error.flowmgtservice.decision_node_has_unsupported_fields_description": "DECISION node '{{param(nodeID)}}' must only route through its decision branches and default node",
+	"error.flowmgtservice.decision_node_missing_branches_description": "DECISION node '{{param(nodeID)}}' must have at least one branch",
+	"error.flowmgtservice.decision_node_missing_default_description": "DECISION node '{{param(nodeID)}}' must have a default node",
+	"error.flowmgtservice.decision_on_non_decision_node_description": "Node '{{param(nodeID)}}' must not have a decision unless its type is DECISION",
 	"error.flowmgtservice.duplicate_end_node_description": "Flow definition must have exactly one END node, found multiple",
 	"error.flowmgtservice.duplicate_flow_handle": "Duplicate flow handle",
 	"error.flowmgtservice.duplicate_flow_handle_description": "A flow with this handle already exists for the given flow type",
@@ -654,17 +771,28 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.invalid_node_reference": "Invalid node reference",
 	"error.flowmgtservice.invalid_node_reference_description": "References a non-existent node",
 	"error.flowmgtservice.invalid_node_type_description": "Node '{{param(nodeID)}}' has invalid type '{{param(type)}}'",
+	"error.flowmgtservice.node_type_not_enabled_description": "Node '{{param(nodeID)}}' has type '{{param(type)}}', which is not enabled",
 	"error.flowmgtservice.invalid_offset_parameter": "Invalid pagination parameter",
 	"error.flowmgtservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.flowmgtservice.invalid_regex_pattern_description": "Node '{{param(nodeID)}}': input '{{param(inputID)}}' has invalid regex pattern: {{param(error)}}",
 	"error.flowmgtservice.invalid_request_format": "Invalid request format",
 	"error.flowmgtservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.flowmgtservice.invalid_validation_rule_type_description": "Node '{{param(nodeID)}}': input '{{param(inputID)}}' has invalid validation rule type '{{param(ruleType)}}'",
+	"error.flowmgtservice.join_node_has_unsupported_fields_description": "JOIN node '{{param(nodeID)}}' must only route through onSuccess and onFailure",
+	"error.flowmgtservice.join_node_invalid_mode_description": "JOIN node '{{param(nodeID)}}' has invalid mode '{{param(mode)}}'",
+	"error.flowmgtservice.join_node_missing_on_success_description": "JOIN node '{{param(nodeID)}}' must have onSuccess",
+	"error.flowmgtservice.join_on_non_join_node_description": "Node '{{param(nodeID)}}' must not have a join mode unless its type is JOIN",
 	"error.flowmgtservice.missing_end_node_description": "Flow definition must have exactly one END node",
 	"error.flowmgtservice.missing_start_node_description": "Flow definition must have exactly one START node",
 	"error.flowmgtservice.no_termination_description": "Node '{{param(nodeID)}}' has no path to the END node",
 	"error.flowmgtservice.node_references_nonexistent_description": "Node '{{param(sourceNodeID)}}' references non-existent node '{{param(targetNodeID)}}' in '{{param(fieldName)}}'",
 	"error.flowmgtservice.orphaned_node_description": "Node '{{param(nodeID)}}' is not reachable from the START node",
+	"error.flowmgtservice.parallel_node_has_unsupported_fields_description": "PARALLEL node '{{param(nodeID)}}' must only route through its branches and join node",
+	"error.flowmgtservice.parallel_node_invalid_branch_description": "PARALLEL node '{{param(nodeID)}}': branch '{{param(branch)}}' must be a chain of TASK_EXECUTION nodes that reaches the join node through onSuccess",
+	"error.flowmgtservice.parallel_node_invalid_join_description": "PARALLEL node '{{param(nodeID)}}' must reference a JOIN node as its join node",
+	"error.flowmgtservice.parallel_node_missing_branches_description": "PARALLEL node '{{param(nodeID)}}' must have at least two branches",
+	"error.flowmgtservice.parallel_node_missing_join_description": "PARALLEL node '{{param(nodeID)}}' must have a join node",
+	"error.flowmgtservice.parallel_on_non_parallel_node_description": "Node '{{param(nodeID)}}' must not have parallel branches unless its type is PARALLEL",
 	"error.flowmgtservice.prompt_missing_action_description": "PROMPT node '{{param(nodeID)}}': prompt at index {{param(index)}} must have an action with nextNode",
 	"error.flowmgtservice.prompt_node_has_both_prompts_and_next_description": "PROMPT node '{{param(nodeID)}}' must have either prompts or next, not both",
 	"error.flowmgtservice.prompt_node_missing_prompts_or_next_description": "PROMPT node '{{param(nodeID)}}' must have either prompts or next",
@@ -674,10 +802,14 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.start_node_has_on_incomplete_description": "START node '{{param(nodeID)}}' must not have onIncomplete",
 	"error.flowmgtservice.start_node_has_prompts_description": "START node '{{param(nodeID)}}' must not have prompts",
 	"error.flowmgtservice.start_node_missing_on_success_description": "START node '{{param(nodeID)}}' must have onSuccess",
+	"error.flowmgtservice.task_node_circuit_open_without_breaker_description": "TASK_EXECUTION node '{{param(nodeID)}}': onCircuitOpen requires an executor circuitBreaker",
 	"error.flowmgtservice.task_node_invalid_failure_target_description": "TASK_EXECUTION node '{{param(nodeID)}}': onFailure must point to a PROMPT node",
 	"error.flowmgtservice.task_node_invalid_incomplete_target_description": "TASK_EXECUTION node '{{param(nodeID)}}': onIncomplete must point to a PROMPT node",
 	"error.flowmgtservice.task_node_missing_executor_description": "TASK_EXECUTION node '{{param(nodeID)}}' must have an executor with a non-empty name",
 	"error.flowmgtservice.task_node_missing_on_success_description": "TASK_EXECUTION node '{{param(nodeID)}}' must have onSuccess",
+	"error.flowmgtservice.task_node_negative_circuit_breaker_description": "TASK_EXECUTION node '{{param(nodeID)}}': circuitBreaker failureThreshold and openDuration must not be negative",
+	"error.flowmgtservice.task_node_negative_execution_policy_description": "TASK_EXECUTION node '{{param(nodeID)}}': retryCount, retryDelay and timeout must not be negative",
+	"error.flowmgtservice.task_node_retry_count_exceeded_description": "TASK_EXECUTION node '{{param(nodeID)}}': retryCount must not exceed {{param(max)}}",
 	"error.groupservice.cannot_create_group_in_declarative_only_mode": "Cannot create group in declarative-only mode",
 	"error.groupservice.cannot_create_group_in_declarative_only_mode_description": "Group creation is not allowed when running in declarative-only mode. Groups must be defined in declarative configuration files",
 	"error.groupservice.cannot_delete_group": "Cannot delete group",
@@ -688,6 +820,8 @@ var defaultMessages = map[string]string{
 	"error.groupservice.create_group_request_parse_failed_description": "Failed to parse request body: {{param(error)}}",
 	"error.groupservice.empty_members_list": "Empty members list",
 	"error.groupservice.empty_members_list_description": "The members list cannot be empty",
+	"error.groupservice.group_membership_cycle": "Group membership cycle",
+	"error.groupservice.group_membership_cycle_description": "A group cannot be a member of itself, directly or through nested groups",
 	"error.groupservice.group_name_conflict": "Group name conflict",
 	"error.groupservice.group_name_conflict_description": "A group with the same name exists under the same parent",
 	"error.groupservice.group_not_found": "Group not found",
@@ -709,6 +843,7 @@ var defaultMessages = map[string]string{
 	"error.groupservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.groupservice.missing_group_id": "Invalid request format",
 	"error.groupservice.missing_group_id_description": "Group ID is required",
+	"error.groupservice.patch_group_request_parse_failed_description": "Failed to parse merge patch document: {{param(error)}}",
 	"error.groupservice.update_group_request_parse_failed_description": "Failed to parse request body: {{param(error)}}",
 	"error.i18nservice.empty_translations": "Empty translations",
 	"error.i18nservice.empty_translations_description": "At least one translation must be provided",
@@ -729,6 +864,16 @@ var defaultMessages = map[string]string{
 	"error.i18nservice.translation_not_found": "Translation not found",
 	"error.i18nservice.translation_not_found_description": "The requested translation does not exist for the specified language, namespace, and key",
 	"error.i18nservice.translation_not_found_for_language": "Translation not found for {{param(id)}}",
+	"error.idempotency.invalid_key": "Invalid idempotency key",
+	"error.idempotency.invalid_key_description": "The Idempotency-Key header must be 1 to 255 printable ASCII characters",
+	"error.idempotency.invalid_request_body": "Invalid request body",
+	"error.idempotency.invalid_request_body_description": "The request body could not be read",
+	"error.idempotency.key_reused": "Idempotency key reused",
+	"error.idempotency.key_reused_description": "The idempotency key was already used for a request with a different payload",
+	"error.idempotency.request_in_progress": "Request in progress",
+	"error.idempotency.request_in_progress_description": "A request with the same idempotency key is still being processed",
+	"error.idempotency.store_unavailable": "Idempotency store unavailable",
+	"error.idempotency.store_unavailable_description": "The request could not be processed safely; retry with the same idempotency key",
 	"error.idpservice.attribute_configuration_duplicate_target_description": "local attribute name '{{param(attribute)}}' appears as a mapping target more than once",
 	"error.idpservice.attribute_configuration_duplicate_user_type_description": "user type '{{param(userType)}}' is configured more than once",
 	"error.idpservice.attribute_configuration_empty_claim_description": "attribute mapping must not contain empty attribute names",
@@ -787,6 +932,12 @@ var defaultMessages = map[string]string{
 	"error.interceptor.failed_description": "A flow interceptor rejected the request",
 	"error.internal_server_error": "Internal server error",
 	"error.internal_server_error_description": "An unexpected error occurred while processing the request",
+	"error.jobservice.job_interrupted": "Job interrupted",
+	"error.jobservice.job_interrupted_description": "The server stopped before the job completed; items already processed are kept",
+	"error.jobservice.job_not_found": "Job not found",
+	"error.jobservice.job_not_found_description": "The job does not exist or its status is no longer available",
+	"error.jobservice.job_queue_full": "Too many pending jobs",
+	"error.jobservice.job_queue_full_description": "The job queue is full; retry once the pending jobs have completed",
 	"error.jweservice.decoding_jwe_error": "JWE decode error",
 	"error.jweservice.decoding_jwe_error_description": "Error occurred while decoding JWE token",
 	"error.jweservice.decryption_failed": "JWE decryption failed",
@@ -832,6 +983,12 @@ var defaultMessages = map[string]string{
 	"error.magiclinkservice.resolving_user_description": "An error occurred while resolving the user for the recipient",
 	"error.magiclinkservice.token_generation_failed": "Token generation failed",
 	"error.magiclinkservice.token_generation_failed_description": "Failed to generate magic link token",
+	"error.maintenance.endpoint_disabled": "Endpoint temporarily unavailable",
+	"error.maintenance.endpoint_disabled_description": "The requested endpoint is disabled for maintenance",
+	"error.maintenance.read_only": "Service in read-only mode",
+	"error.maintenance.read_only_description": "The server is under maintenance and does not accept changes at the moment",
+	"error.netaccess.network_not_allowed": "Network not allowed",
+	"error.netaccess.network_not_allowed_description": "The management endpoints cannot be called from this network",
 	"error.notificationclient.unsupported_notification_provider": "Unsupported notification provider",
 	"error.notificationclient.unsupported_notification_provider.description": "The requested notification provider is not supported.",
 	"error.notificationservice.duplicate_sender_name": "Duplicate sender name",
@@ -856,6 +1013,8 @@ var defaultMessages = map[string]string{
 	"error.notificationservice.invalid_sender_type_description": "The provided sender type is invalid or unsupported",
 	"error.notificationservice.invalid_session_token": "Invalid session token",
 	"error.notificationservice.invalid_session_token_description": "The provided session token is invalid, malformed, or expired",
+	"error.notificationservice.otp_resend_throttled": "OTP requested too soon",
+	"error.notificationservice.otp_resend_throttled_description": "An OTP was sent to this recipient recently. Wait before requesting another one",
 	"error.notificationservice.sender_has_blocking_dependencies": "Notification sender cannot be deleted",
 	"error.notificationservice.sender_has_blocking_dependencies_description": "The notification sender cannot be deleted because other resources depend on it. Remove or reassign them first.",
 	"error.notificationservice.sender_not_found": "Sender not found",
@@ -923,6 +1082,44 @@ var defaultMessages = map[string]string{
 	"error.passkeyservice.session_expired_description": "The session has expired. Please start a new session",
 	"error.passkeyservice.user_not_found": "User not found",
 	"error.passkeyservice.user_not_found_description": "The specified user was not found",
+	"error.policyservice.invalid_policy_handle": "Invalid policy handle",
+	"error.policyservice.invalid_policy_handle_description": "The policy handle must contain only lowercase letters, digits, underscores and hyphens",
+	"error.policyservice.invalid_policy_title": "Invalid policy title",
+	"error.policyservice.invalid_policy_title_description": "The policy title is required and must not exceed 255 characters",
+	"error.policyservice.invalid_policy_url": "Invalid policy URL",
+	"error.policyservice.invalid_policy_url_description": "The policy URL must be an absolute http or https URL",
+	"error.policyservice.invalid_policy_version": "Invalid policy version",
+	"error.policyservice.invalid_policy_version_description": "The policy version is required and must not exceed 50 characters",
+	"error.policyservice.invalid_request_format": "Invalid request format",
+	"error.policyservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.policyservice.policy_not_found": "Policy not found",
+	"error.policyservice.policy_not_found_description": "No version of the requested policy has been published",
+	"error.policyservice.policy_version_already_exists": "Policy version already exists",
+	"error.policyservice.policy_version_already_exists_description": "The requested version of the policy has already been published",
+	"error.pushauthservice.challenge_already_decided": "Approval request already decided",
+	"error.pushauthservice.challenge_already_decided_description": "The approval request was already approved or denied",
+	"error.pushauthservice.challenge_not_approved": "Approval request not approved",
+	"error.pushauthservice.challenge_not_approved_description": "The approval request was not approved on a registered device",
+	"error.pushauthservice.challenge_not_found": "Approval request not found",
+	"error.pushauthservice.challenge_not_found_description": "The approval request is unknown or has expired",
+	"error.pushauthservice.device_limit_reached": "Device limit reached",
+	"error.pushauthservice.device_limit_reached_description": "The maximum number of push devices is already registered; remove a device first",
+	"error.pushauthservice.device_not_found": "Device not found",
+	"error.pushauthservice.device_not_found_description": "The push device is not registered for the user",
+	"error.pushauthservice.invalid_device_credentials": "Invalid device credentials",
+	"error.pushauthservice.invalid_device_credentials_description": "The device is not allowed to decide the approval request",
+	"error.pushauthservice.invalid_device_name": "Invalid device name",
+	"error.pushauthservice.invalid_device_name_description": "The device name must be between 1 and 100 characters",
+	"error.pushauthservice.invalid_push_token": "Invalid push token",
+	"error.pushauthservice.invalid_push_token_description": "The push token is missing or too long",
+	"error.pushauthservice.invalid_request_format": "Invalid request format",
+	"error.pushauthservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.pushauthservice.no_registered_device": "No registered device",
+	"error.pushauthservice.no_registered_device_description": "The user has not registered a device for push notifications",
+	"error.pushauthservice.number_mismatch": "Number mismatch",
+	"error.pushauthservice.number_mismatch_description": "The selected number does not match the number shown at sign-in; the request was denied",
+	"error.pushauthservice.unsupported_platform": "Unsupported platform",
+	"error.pushauthservice.unsupported_platform_description": "Push notifications are not configured for the requested platform",
 	"error.resourceservice.action_not_found": "Action not found",
 	"error.resourceservice.action_not_found_description": "The action with the specified id does not exist",
 	"error.resourceservice.cannot_delete": "Cannot delete",
@@ -973,6 +1170,16 @@ var defaultMessages = map[string]string{
 	"error.resourceservice.resource_server_not_found_description": "The resource server with the specified id does not exist",
 	"error.resourceservice.result_limit_exceeded_in_composite_mode": "Result limit exceeded in composite mode",
 	"error.resourceservice.result_limit_exceeded_in_composite_mode_description": "The total number of records exceeds the maximum limit in composite mode",
+	"error.revocationservice.invalid_issue_time_filter": "Invalid issue time filter",
+	"error.revocationservice.invalid_issue_time_filter_description": "The issuedAfter and issuedBefore parameters must be positive integers in seconds since the epoch",
+	"error.revocationservice.invalid_limit_parameter": "Invalid pagination parameter",
+	"error.revocationservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.revocationservice.invalid_offset_parameter": "Invalid pagination parameter",
+	"error.revocationservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.revocationservice.invalid_request": "Invalid revocation request",
+	"error.revocationservice.invalid_request_description": "Provide exactly one of a token, a jti together with the token's expiry time, a user ID or a client ID",
+	"error.revocationservice.invalid_token": "Invalid token",
+	"error.revocationservice.invalid_token_description": "The token was not issued by this server or cannot be revoked",
 	"error.roleservice.cannot_create_role_in_declarative_only_mode": "Cannot create role in declarative-only mode",
 	"error.roleservice.cannot_create_role_in_declarative_only_mode_description": "Role creation is not allowed when running in declarative-only mode. Roles must be defined in declarative configuration files",
 	"error.roleservice.cannot_delete_role": "Cannot delete role",
@@ -1009,6 +1216,42 @@ var defaultMessages = map[string]string{
 	"error.roleservice.role_name_conflict_description": "A role with the same name exists under the same organization unit",
 	"error.roleservice.role_not_found": "Role not found",
 	"error.roleservice.role_not_found_description": "The role with the specified id does not exist",
+	"error.scopeservice.invalid_claims": "Invalid claims",
+	"error.scopeservice.invalid_claims_description": "Each claim name must be non-empty and must not exceed 100 characters",
+	"error.scopeservice.invalid_description": "Invalid description",
+	"error.scopeservice.invalid_description_description": "The description must not exceed 1024 characters",
+	"error.scopeservice.invalid_display_name": "Invalid display name",
+	"error.scopeservice.invalid_display_name_description": "The display name is required and must not exceed 255 characters",
+	"error.scopeservice.invalid_request_format": "Invalid request format",
+	"error.scopeservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.scopeservice.invalid_required_acrs": "Invalid required authentication classes",
+	"error.scopeservice.invalid_required_acrs_description": "A scope can require at most 20 non-empty authentication classes of up to 255 characters",
+	"error.scopeservice.invalid_restriction": "Invalid scope restriction",
+	"error.scopeservice.invalid_restriction_description": "A scope restriction must list at least one role or organization unit, at most 100 of each, and an enforcement of downscope or reject",
+	"error.scopeservice.invalid_scope_name": "Invalid scope name",
+	"error.scopeservice.invalid_scope_name_description": "The scope name is required, must not exceed 100 characters and must not contain spaces, double quotes or backslashes",
+	"error.scopeservice.restricted_scope_denied": "Restricted scope denied",
+	"error.scopeservice.restricted_scope_denied_description": "The subject is not allowed to be granted one or more of the requested scopes",
+	"error.scopeservice.scope_already_exists": "Scope already exists",
+	"error.scopeservice.scope_already_exists_description": "A scope with the same name is already defined",
+	"error.scopeservice.scope_not_found": "Scope not found",
+	"error.scopeservice.scope_not_found_description": "The scope with the specified id does not exist",
+	"error.graphqlservice.invalid_request": "Invalid GraphQL request",
+	"error.graphqlservice.invalid_request_description": "The request body must be a JSON object with a query string",
+	"error.graphqlservice.insufficient_permissions": "Insufficient permissions",
+	"error.graphqlservice.insufficient_permissions_description": "The caller does not have the permission required to read this field",
+	"error.graphqlservice.invalid_filter": "Invalid filter",
+	"error.graphqlservice.invalid_filter_description": "The filter must be an object that maps attribute names to string, number or boolean values",
+	"error.adminnotificationservice.invalid_upgrade_request": "Invalid upgrade request",
+	"error.adminnotificationservice.invalid_upgrade_request_description": "The request must be a WebSocket upgrade that offers the admin-notifications.v1 subprotocol",
+	"error.adminnotificationservice.too_many_connections": "Too many connections",
+	"error.adminnotificationservice.too_many_connections_description": "The maximum number of admin notification connections is reached; retry later",
+	"error.searchservice.invalid_query": "Invalid search query",
+	"error.searchservice.invalid_query_description": "The q parameter must contain letters or digits and be at most 100 characters long",
+	"error.searchservice.invalid_type": "Invalid result type",
+	"error.searchservice.invalid_type_description": "The types parameter accepts user, application, and group",
+	"error.searchservice.invalid_limit": "Invalid limit parameter",
+	"error.searchservice.invalid_limit_description": "The limit parameter must be a positive integer of at most 100",
 	"error.serverconfigservice.config_not_found": "Server configuration not found",
 	"error.serverconfigservice.config_not_found_description": "The requested server configuration does not exist",
 	"error.serverconfigservice.invalid_config_value": "Invalid server configuration value",
@@ -1017,11 +1260,29 @@ var defaultMessages = map[string]string{
 	"error.serverconfigservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.serverconfigservice.unsupported_config_name": "Unsupported configuration name",
 	"error.serverconfigservice.unsupported_config_name_description": "The requested server configuration name is not supported",
+	"error.templateservice.duplicate_template": "Duplicate template",
+	"error.templateservice.duplicate_template_description": "A template already exists for the scenario, type, application and locale",
+	"error.templateservice.invalid_locale": "Invalid locale",
+	"error.templateservice.invalid_locale_description": "The locale must be a valid BCP 47 language tag",
+	"error.templateservice.invalid_request_format": "Invalid request format",
+	"error.templateservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.templateservice.invalid_scenario": "Invalid scenario",
+	"error.templateservice.invalid_scenario_description": "The template scenario is not supported",
+	"error.templateservice.invalid_template_content": "Invalid template content",
+	"error.templateservice.invalid_template_content_description": "The template has a missing body or subject, or an unsupported content type",
+	"error.templateservice.invalid_template_type": "Invalid template type",
+	"error.templateservice.invalid_template_type_description": "The template type must be email or sms",
+	"error.templateservice.notification_template_not_found": "Notification template not found",
+	"error.templateservice.notification_template_not_found_description": "The requested notification template does not exist",
 	"error.templateservice.template_not_found": "Template not found",
 	"error.templateservice.template_not_found_description": "The requested template does not exist for the given scenario",
+	"error.templateservice.undeclared_variable": "Undeclared template variable",
+	"error.templateservice.undeclared_variable_description": "The subject or body uses a variable that is not declared in the template variables",
 	"error.themeservice.invalid_limit_value_description": "Limit must be between 1 and {{param(max)}}",
 	"error.unauthorized": "Unauthorized",
 	"error.unauthorized_description": "The caller is not authorized to perform this operation",
+	"error.usageservice.invalid_period": "Invalid usage period",
+	"error.usageservice.invalid_period_description": "The period must be a calendar month in the YYYY-MM format",
 	"error.userinfoservice.client_credentials_not_supported": "Invalid access token",
 	"error.userinfoservice.client_credentials_not_supported_description": "UserInfo endpoint is not applicable for client_credentials grant type",
 	"error.userinfoservice.dpop_bound_token_bearer_scheme": "Invalid access token",
@@ -1052,6 +1313,12 @@ var defaultMessages = map[string]string{
 	"error.userservice.handle_path_required_description": "Handle path is required for this operation",
 	"error.userservice.invalid_credential": "Invalid request format",
 	"error.userservice.invalid_credential_description": "Invalid credential fields in request",
+	"error.userservice.invalid_current_password": "Invalid current password",
+	"error.userservice.invalid_current_password_description": "The provided current password is incorrect",
+	"error.userservice.invalid_delete_mode": "Invalid delete mode",
+	"error.userservice.invalid_delete_mode_description": "The only supported delete mode is erase",
+	"error.userservice.invalid_erasure_confirmation": "Invalid erasure confirmation",
+	"error.userservice.invalid_erasure_confirmation_description": "The erasure confirmation token is invalid or has expired",
 	"error.userservice.invalid_filter_parameter": "Invalid filter parameter",
 	"error.userservice.invalid_filter_parameter_description": "The filter format is invalid",
 	"error.userservice.invalid_group_id": "Invalid group ID",
@@ -1066,6 +1333,10 @@ var defaultMessages = map[string]string{
 	"error.userservice.invalid_organization_unit_description": "Organization unit id must be specified as a valid UUID",
 	"error.userservice.invalid_request_format": "Invalid request format",
 	"error.userservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.userservice.invalid_state_transition": "Invalid account state transition",
+	"error.userservice.invalid_state_transition_description": "The requested account state change is not allowed from the current state of the user",
+	"error.userservice.invalid_time_filter": "Invalid time filter",
+	"error.userservice.invalid_time_filter_description": "The from and to parameters must be RFC 3339 timestamps with from not after to",
 	"error.userservice.missing_credentials": "Missing credentials",
 	"error.userservice.missing_credentials_description": "At least one credential field must be provided",
 	"error.userservice.missing_required_fields": "Missing required fields",
@@ -1076,10 +1347,16 @@ var defaultMessages = map[string]string{
 	"error.userservice.organization_unit_mismatch_description": "The organization unit does not match the user type configuration",
 	"error.userservice.organization_unit_not_found": "Organization unit not found",
 	"error.userservice.organization_unit_not_found_description": "The specified organization unit does not exist",
+	"error.userservice.restore_window_expired": "Restore window expired",
+	"error.userservice.restore_window_expired_description": "The retention window for restoring the user has passed",
 	"error.userservice.schema_validation_failed": "Schema validation failed",
 	"error.userservice.schema_validation_failed_description": "User attributes do not conform to the required schema",
+	"error.userservice.self_service_attribute_not_editable": "Attribute not editable",
+	"error.userservice.self_service_attribute_not_editable_description": "One or more attributes cannot be modified through self-service",
 	"error.userservice.user_has_blocking_dependencies": "User cannot be deleted",
 	"error.userservice.user_has_blocking_dependencies_description": "The user cannot be deleted because other resources depend on it. Remove or reassign them first.",
+	"error.userservice.user_not_active": "User not active",
+	"error.userservice.user_not_active_description": "The user account is not active",
 	"error.userservice.user_not_found": "User not found",
 	"error.userservice.user_not_found_description": "The user with the specified id does not exist",
 	"error.userservice.user_type_not_found": "User type not found",
@@ -1128,6 +1405,12 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.authorization_failed_desc": "Authorization validation failed for the current user",
 	"flows.executor.errors.cannot_provision_automatically": "Cannot provision user automatically",
 	"flows.executor.errors.cannot_provision_automatically_desc": "The user cannot be provisioned automatically with the provided information",
+	"flows.executor.errors.certificate_authentication_failed": "Certificate authentication failed",
+	"flows.executor.errors.certificate_authentication_failed_desc": "The client certificate is not valid for signing in",
+	"flows.executor.errors.certificate_not_presented": "No client certificate presented",
+	"flows.executor.errors.certificate_not_presented_desc": "Insert your smart card or select a client certificate and try again",
+	"flows.executor.errors.concurrent_session_limit_reached": "Too many active sessions",
+	"flows.executor.errors.concurrent_session_limit_reached_desc": "Sign out from another device before signing in from this one",
 	"flows.executor.errors.consent_decisions_missing": "Consent decisions input is missing or empty",
 	"flows.executor.errors.consent_decisions_missing_desc": "The consent decisions input is missing or empty",
 	"flows.executor.errors.consent_decisions_parse": "Failed to parse consent decisions",
@@ -1152,6 +1435,14 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.credential_set_failed_desc": "An error occurred while setting the user credentials",
 	"flows.executor.errors.credential_value_empty": "Credential value is empty",
 	"flows.executor.errors.credential_value_empty_desc": "The credential value must not be empty for the credential setter",
+	"flows.executor.errors.cross_device_authentication_failed": "Cross-device authentication failed",
+	"flows.executor.errors.cross_device_authentication_failed_desc": "An error occurred while authenticating the approved sign-in request",
+	"flows.executor.errors.cross_device_denied": "Sign-in request denied",
+	"flows.executor.errors.cross_device_denied_desc": "The sign-in request was denied on the device that scanned the QR code",
+	"flows.executor.errors.cross_device_expired": "The QR code sign-in request expired",
+	"flows.executor.errors.cross_device_expired_desc": "The sign-in request was not approved on another device before it expired",
+	"flows.executor.errors.cross_device_initiate_failed": "Failed to start the QR code sign-in",
+	"flows.executor.errors.cross_device_initiate_failed_desc": "An error occurred while creating the cross-device sign-in request",
 	"flows.executor.errors.cross_ou_provisioning_target_missing": "Target OU is not set for cross-OU provisioning",
 	"flows.executor.errors.cross_ou_provisioning_target_missing_desc": "A target organization unit must be specified for cross-OU user provisioning",
 	"flows.executor.errors.email_recipient_missing": "Email recipient is required",
@@ -1160,6 +1451,16 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.email_send_failed_desc": "An error occurred while sending the email",
 	"flows.executor.errors.email_service_not_configured": "Email service is not configured",
 	"flows.executor.errors.email_service_not_configured_desc": "The email notification service has not been configured",
+	"flows.executor.errors.essential_attributes_unavailable": "Required information unavailable",
+	"flows.executor.errors.essential_attributes_unavailable_desc": "The application requires information that is not available for your account",
+	"flows.executor.errors.external_task_config_invalid": "Invalid external task configuration",
+	"flows.executor.errors.external_task_config_invalid_desc": "The external task executor is not configured correctly",
+	"flows.executor.errors.external_task_failed": "External task failed",
+	"flows.executor.errors.external_task_failed_desc": "The external task service reported a failure",
+	"flows.executor.errors.external_task_invalid_response": "Invalid external task response",
+	"flows.executor.errors.external_task_invalid_response_desc": "The external task service returned a response that does not match the expected contract",
+	"flows.executor.errors.external_task_request_rejected": "External task request rejected",
+	"flows.executor.errors.external_task_request_rejected_desc": "The external task service rejected the request",
 	"flows.executor.errors.failed_to_identify_user": "Failed to identify user",
 	"flows.executor.errors.failed_to_identify_user_desc": "Unable to identify the user with the provided information",
 	"flows.executor.errors.http_request_config_invalid": "Configuration error",
@@ -1212,6 +1513,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.openid4vp_initiate_failed_desc": "An error occurred while initiating the OpenID4VP presentation request",
 	"flows.executor.errors.openid4vp_verification_failed": "OpenID4VP presentation verification failed",
 	"flows.executor.errors.openid4vp_verification_failed_desc": "The OpenID4VP presentation verification failed",
+	"flows.executor.errors.otp_resend_throttled": "OTP requested too soon",
+	"flows.executor.errors.otp_resend_throttled_desc": "A code was sent recently. Wait a moment before requesting a new one",
 	"flows.executor.errors.ou_creation_failed": "Organization unit creation failed",
 	"flows.executor.errors.ou_creation_failed_desc": "An error occurred while creating the organization unit",
 	"flows.executor.errors.ou_creation_prereq_failed": "Prerequisites validation failed for OU creation",
@@ -1230,6 +1533,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.passkey_auth_failed_desc": "An error occurred while authenticating with the passkey",
 	"flows.executor.errors.passkey_registration_failed": "Passkey registration failed",
 	"flows.executor.errors.passkey_registration_failed_desc": "An error occurred while registering the passkey",
+	"flows.executor.errors.policies_not_accepted": "Policies not accepted",
+	"flows.executor.errors.policies_not_accepted_desc": "The current version of every required policy must be accepted to continue",
 	"flows.executor.errors.prerequisites_failed": "Prerequisites validation failed",
 	"flows.executor.errors.prerequisites_failed_desc": "The prerequisites for this operation have not been met",
 	"flows.executor.errors.provisioning_assignment_failed": "Failed to assign groups and roles",
@@ -1240,12 +1545,26 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.provisioning_failed_desc": "An error occurred while provisioning the user",
 	"flows.executor.errors.provisioning_user_attrs_missing": "No user attributes provided for provisioning",
 	"flows.executor.errors.provisioning_user_attrs_missing_desc": "User attributes are required to provision a new user",
+	"flows.executor.errors.push_authentication_failed": "Push authentication failed",
+	"flows.executor.errors.push_authentication_failed_desc": "An error occurred while authenticating the approved sign-in request",
+	"flows.executor.errors.push_denied": "Sign-in request rejected",
+	"flows.executor.errors.push_denied_desc": "The sign-in request was rejected on the registered device",
+	"flows.executor.errors.push_expired": "The push approval request expired",
+	"flows.executor.errors.push_expired_desc": "The sign-in request was not approved on the device before it expired",
+	"flows.executor.errors.push_initiate_failed": "Failed to send the push approval request",
+	"flows.executor.errors.push_initiate_failed_desc": "An error occurred while sending the approval request to the registered devices",
+	"flows.executor.errors.push_no_registered_device": "No device registered for push approval",
+	"flows.executor.errors.push_no_registered_device_desc": "The user has no device registered to approve sign-in requests",
+	"flows.executor.errors.push_number_mismatch": "Sign-in request number mismatch",
+	"flows.executor.errors.push_number_mismatch_desc": "The number selected on the device does not match the number shown on the sign-in screen",
 	"flows.executor.errors.self_reg_disabled_for_user_type": "Self-registration is disabled for the user type",
 	"flows.executor.errors.self_reg_disabled_for_user_type_desc": "Self-registration is not enabled for the selected user type",
 	"flows.executor.errors.self_reg_not_available_for_app": "Self-registration not available for this application",
 	"flows.executor.errors.self_reg_not_available_for_app_desc": "Self-registration is not available for this application",
 	"flows.executor.errors.self_registration_disabled": "Self-registration not enabled",
 	"flows.executor.errors.self_registration_disabled_desc": "Self-registration is not enabled for this application or user type",
+	"flows.executor.errors.sign_in_anomaly_detected": "Unusual sign-in detected",
+	"flows.executor.errors.sign_in_anomaly_detected_desc": "The sign-in was blocked because it differs from the usual sign-in activity of the user",
 	"flows.executor.errors.sms_invalid_phone": "SMS recipient is not a valid phone number",
 	"flows.executor.errors.sms_invalid_phone_desc": "The provided SMS recipient is not a valid phone number",
 	"flows.executor.errors.sms_provider_not_configured": "SMS notification provider is not configured",
@@ -1254,6 +1573,8 @@ var defaultMessages = map[string]string{
 	"flows.executor.errors.sms_recipient_missing_desc": "An SMS recipient must be provided to send the notification",
 	"flows.executor.errors.sms_template_missing": "SMS template is required",
 	"flows.executor.errors.sms_template_missing_desc": "An SMS template must be provided to send the notification",
+	"flows.executor.errors.user_account_not_active": "User account is not active",
+	"flows.executor.errors.user_account_not_active_desc": "The user account is locked, disabled or pending activation",
 	"flows.executor.errors.user_already_exists": "User already exists",
 	"flows.executor.errors.user_already_exists_desc": "A user already exists with the provided attributes",
 	"flows.executor.errors.user_already_exists_in_target_ou": "User already exists in the target organization",
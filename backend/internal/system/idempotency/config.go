@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idempotency
+
+import "time"
+
+// Config holds the idempotency settings, mapped by the caller from the deployment server
+// idempotency config. It is intentionally decoupled from system/config so this package does not
+// depend on the global configuration type.
+type Config struct {
+	// Enabled turns on idempotency key handling. When false the middleware is a pass-through.
+	Enabled bool
+	// TTL is how long a completed response is kept for replay. Zero uses the default of 24 hours.
+	TTL time.Duration
+	// Endpoints lists the "METHOD /path" entries that honour the Idempotency-Key header. An empty
+	// list guards the user, application, group and organization unit create endpoints.
+	Endpoints []string
+}
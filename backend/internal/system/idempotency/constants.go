@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package idempotency provides the HTTP middleware that makes create requests safe to retry. A
+// request carrying an Idempotency-Key header is processed once per caller and key; retries with the
+// same key and payload receive the stored response instead of creating a duplicate resource.
+package idempotency
+
+import "time"
+
+const (
+	// HeaderIdempotencyKey is the request header carrying the client generated idempotency key.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed is set to "true" on responses replayed from a stored record.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	// maxKeyLength is the maximum accepted length of an idempotency key.
+	maxKeyLength = 255
+	// defaultTTL is how long a completed record is kept when the configuration leaves it unset.
+	defaultTTL = 24 * time.Hour
+	// processingTTL bounds how long an interrupted request blocks retries with the same key.
+	processingTTL = 5 * time.Minute
+)
+
+// defaultEndpoints are the create endpoints guarded when the configuration lists none.
+var defaultEndpoints = []string{
+	"POST /users",
+	"POST /applications",
+	"POST /groups",
+	"POST /organization-units",
+}
+
+// replayedHeaders are the response headers stored with a record and set again on replay.
+var replayedHeaders = []string{"Content-Type", "Location", "ETag", "Last-Modified"}
+
+// recordStatus is the processing state of an idempotency record.
+type recordStatus string
+
+const (
+	// recordStatusProcessing marks a request that is still being handled.
+	recordStatusProcessing recordStatus = "processing"
+	// recordStatusCompleted marks a request whose response has been stored.
+	recordStatusCompleted recordStatus = "completed"
+)
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idempotency
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// errInvalidEndpoint is returned by Initialize when an endpoint entry is not of the form
+// "METHOD /path".
+var errInvalidEndpoint = errors.New("invalid idempotency endpoint")
+
+// Idempotency error responses, returned by the idempotency middleware.
+var (
+	// errInvalidKey is returned with 400 when the Idempotency-Key header is malformed.
+	errInvalidKey = apierror.ErrorResponse{
+		Code: "IDM-1001",
+		Message: tidcommon.I18nMessage{
+			Key:          "error.idempotency.invalid_key",
+			DefaultValue: "Invalid idempotency key",
+		},
+		Description: tidcommon.I18nMessage{
+			Key:          "error.idempotency.invalid_key_description",
+			DefaultValue: "The Idempotency-Key header must be 1 to 255 printable ASCII characters",
+		},
+	}
+
+	// errKeyReused is returned with 422 when a key is reused with a different request payload.
+	errKeyReused = apierror.ErrorResponse{
+		Code: "IDM-1002",
+		Message: tidcommon.I18nMessage{
+			Key:          "error.idempotency.key_reused",
+			DefaultValue: "Idempotency key reused",
+		},
+		Description: tidcommon.I18nMessage{
+			Key:          "error.idempotency.key_reused_description",
+			DefaultValue: "The idempotency key was already used for a request with a different payload",
+		},
+	}
+
+	// errRequestInProgress is returned with 409 while the original request for a key is still
+	// being processed.
+	errRequestInProgress = apierror.ErrorResponse{
+		Code: "IDM-1003",
+		Message: tidcommon.I18nMessage{
+			Key:          "error.idempotency.request_in_progress",
+			DefaultValue: "Request in progress",
+		},
+		Description: tidcommon.I18nMessage{
+			Key:          "error.idempotency.request_in_progress_description",
+			DefaultValue: "A request with the same idempotency key is still being processed",
+		},
+	}
+
+	// errInvalidRequestBody is returned with 400 when the request body cannot be read.
+	errInvalidRequestBody = apierror.ErrorResponse{
+		Code: "IDM-1004",
+		Message: tidcommon.I18nMessage{
+			Key:          "error.idempotency.invalid_request_body",
+			DefaultValue: "Invalid request body",
+		},
+		Description: tidcommon.I18nMessage{
+			Key:          "error.idempotency.invalid_request_body_description",
+			DefaultValue: "The request body could not be read",
+		},
+	}
+
+	// errStoreUnavailable is returned with 503 when the idempotency record cannot be read or
+	// written, so the request is not processed without duplicate protection.
+	errStoreUnavailable = apierror.ErrorResponse{
+		Code: "IDM-5031",
+		Message: tidcommon.I18nMessage{
+			Key:          "error.idempotency.store_unavailable",
+			DefaultValue: "Idempotency store unavailable",
+		},
+		Description: tidcommon.I18nMessage{
+			Key:          "error.idempotency.store_unavailable_description",
+			DefaultValue: "The request could not be processed safely; retry with the same idempotency key",
+		},
+	}
+)
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idempotency
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// Initialize builds the idempotency middleware from cfg, persisting records in store. When cfg is
+// disabled it returns a pass-through middleware so the request hot path is unaffected. A malformed
+// endpoint entry returns a non-nil error.
+func Initialize(cfg Config, store providers.RuntimeStoreProvider) (func(http.Handler) http.Handler, error) {
+	if !cfg.Enabled {
+		return func(next http.Handler) http.Handler { return next }, nil
+	}
+
+	entries := cfg.Endpoints
+	if len(entries) == 0 {
+		entries = defaultEndpoints
+	}
+	endpoints, err := parseEndpoints(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	g := &guard{
+		endpoints: endpoints,
+		ttl:       ttl,
+		store:     store,
+	}
+	return g.middleware, nil
+}
+
+// parseEndpoints parses "METHOD /path" entries.
+func parseEndpoints(entries []string) ([]endpoint, error) {
+	endpoints := make([]endpoint, 0, len(entries))
+	for _, entry := range entries {
+		fields := strings.Fields(entry)
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "/") {
+			return nil, fmt.Errorf("%w: %q", errInvalidEndpoint, entry)
+		}
+		endpoints = append(endpoints, endpoint{method: strings.ToUpper(fields[0]), pathPattern: fields[1]})
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// endpoint is a parsed guarded endpoint entry.
+type endpoint struct {
+	method      string
+	pathPattern string
+}
+
+// matches reports whether the request method and path match the endpoint.
+func (e endpoint) matches(method, requestPath string) bool {
+	return e.method == method && utils.MatchPathPattern(e.pathPattern, requestPath)
+}
+
+// guard processes requests on the guarded endpoints at most once per caller and idempotency key.
+type guard struct {
+	endpoints []endpoint
+	ttl       time.Duration
+	store     providers.RuntimeStoreProvider
+	// inFlight holds the record keys being processed on this node, so concurrent duplicates are
+	// rejected without waiting for the persisted processing record to become visible.
+	inFlight sync.Map
+}
+
+// middleware wraps next so that a retried request with the same idempotency key and payload
+// receives the stored response instead of reaching next again.
+func (g *guard) middleware(next http.Handler) http.Handler {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "IdempotencyMiddleware"))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(HeaderIdempotencyKey)
+		if key == "" || !g.guards(r.Method, r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		if !isValidKey(key) {
+			utils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errInvalidKey)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			utils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errInvalidRequestBody)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		recordKey := buildRecordKey(security.GetSubject(ctx), r.Method, r.URL.Path, key)
+		fingerprint := buildFingerprint(r.URL.RawQuery, body)
+
+		if _, loaded := g.inFlight.LoadOrStore(recordKey, struct{}{}); loaded {
+			utils.WriteProblemResponse(ctx, w, http.StatusConflict, errRequestInProgress)
+			return
+		}
+		defer g.inFlight.Delete(recordKey)
+
+		existing, err := g.load(ctx, recordKey)
+		if err != nil {
+			logger.Error(ctx, "Failed to load idempotency record", log.Error(err))
+			utils.WriteProblemResponse(ctx, w, http.StatusServiceUnavailable, errStoreUnavailable)
+			return
+		}
+		if existing != nil {
+			g.respondWithRecord(ctx, w, existing, fingerprint)
+			return
+		}
+
+		if err := g.save(ctx, recordKey, &record{Status: recordStatusProcessing, Fingerprint: fingerprint},
+			processingTTL); err != nil {
+			logger.Error(ctx, "Failed to store idempotency record", log.Error(err))
+			utils.WriteProblemResponse(ctx, w, http.StatusServiceUnavailable, errStoreUnavailable)
+			return
+		}
+
+		recorder := newResponseRecorder(w)
+		next.ServeHTTP(recorder, r)
+		g.complete(ctx, logger, recordKey, fingerprint, recorder)
+	})
+}
+
+// guards reports whether the request targets one of the guarded endpoints.
+func (g *guard) guards(method, requestPath string) bool {
+	for _, e := range g.endpoints {
+		if e.matches(method, requestPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// respondWithRecord answers a retried request from its stored record.
+func (g *guard) respondWithRecord(ctx context.Context, w http.ResponseWriter, rec *record, fingerprint string) {
+	if rec.Fingerprint != fingerprint {
+		utils.WriteProblemResponse(ctx, w, http.StatusUnprocessableEntity, errKeyReused)
+		return
+	}
+	if rec.Status != recordStatusCompleted {
+		utils.WriteProblemResponse(ctx, w, http.StatusConflict, errRequestInProgress)
+		return
+	}
+
+	for name, value := range rec.Header {
+		w.Header().Set(name, value)
+	}
+	w.Header().Set(HeaderIdempotentReplayed, "true")
+	w.WriteHeader(rec.StatusCode)
+	_, _ = w.Write(rec.Body)
+}
+
+// complete stores the response of a processed request for replay. Server errors are not stored so
+// the request can be retried with the same key.
+func (g *guard) complete(ctx context.Context, logger *log.Logger, recordKey, fingerprint string,
+	recorder *responseRecorder) {
+	if recorder.statusCode >= http.StatusInternalServerError {
+		if err := g.store.Delete(ctx, providers.NamespaceIdempotency, recordKey); err != nil {
+			logger.Error(ctx, "Failed to release idempotency record", log.Error(err))
+		}
+		return
+	}
+
+	header := make(map[string]string, len(replayedHeaders))
+	for _, name := range replayedHeaders {
+		if value := recorder.Header().Get(name); value != "" {
+			header[name] = value
+		}
+	}
+	rec := &record{
+		Status:      recordStatusCompleted,
+		Fingerprint: fingerprint,
+		StatusCode:  recorder.statusCode,
+		Header:      header,
+		Body:        recorder.body.Bytes(),
+	}
+	if err := g.save(ctx, recordKey, rec, g.ttl); err != nil {
+		logger.Error(ctx, "Failed to store idempotent response", log.Error(err))
+	}
+}
+
+// load returns the record stored under recordKey, or nil when there is none.
+func (g *guard) load(ctx context.Context, recordKey string) (*record, error) {
+	data, err := g.store.Get(ctx, providers.NamespaceIdempotency, recordKey)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	var rec record
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// save stores rec under recordKey for ttl.
+func (g *guard) save(ctx context.Context, recordKey string, rec *record, ttl time.Duration) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return g.store.Put(ctx, providers.NamespaceIdempotency, recordKey, data, int64(ttl.Seconds()))
+}
+
+// isValidKey reports whether key is 1 to maxKeyLength printable ASCII characters.
+func isValidKey(key string) bool {
+	if len(key) > maxKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return key != ""
+}
+
+// buildRecordKey scopes the idempotency key to the caller and endpoint, hashing the result so the
+// stored key has a fixed length and does not reveal the caller.
+func buildRecordKey(subject, method, requestPath, key string) string {
+	sum := sha256.Sum256([]byte(subject + "\x00" + method + " " + requestPath + "\x00" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// buildFingerprint identifies the request payload so a key reused with different content is
+// detected.
+func buildFingerprint(rawQuery string, body []byte) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(rawQuery + "\x00"))
+	_, _ = h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder passes the response through to the client while keeping a copy for replay.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+}
+
+// WriteHeader records the status code and forwards it.
+func (rr *responseRecorder) WriteHeader(statusCode int) {
+	if rr.wroteHeader {
+		return
+	}
+	rr.statusCode = statusCode
+	rr.wroteHeader = true
+	rr.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write records the body and forwards it.
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if !rr.wroteHeader {
+		rr.WriteHeader(http.StatusOK)
+	}
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying response writer for http.ResponseController.
+func (rr *responseRecorder) Unwrap() http.ResponseWriter {
+	return rr.ResponseWriter
+}
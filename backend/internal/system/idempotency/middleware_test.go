@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+type IdempotencyTestSuite struct {
+	suite.Suite
+	store   providers.RuntimeStoreProvider
+	handler http.Handler
+	calls   int
+	status  int
+}
+
+func TestIdempotencySuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyTestSuite))
+}
+
+func (suite *IdempotencyTestSuite) SetupTest() {
+	suite.store = inmemory.Initialize("test-deployment")
+	suite.calls = 0
+	suite.status = http.StatusCreated
+
+	mw, err := Initialize(Config{Enabled: true}, suite.store)
+	suite.Require().NoError(err)
+	suite.handler = mw(http.HandlerFunc(suite.create))
+}
+
+// create is the downstream handler; it echoes a counter so replays are distinguishable from new calls.
+func (suite *IdempotencyTestSuite) create(w http.ResponseWriter, r *http.Request) {
+	suite.calls++
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/users/u1")
+	w.WriteHeader(suite.status)
+	_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(suite.calls) + `,"request":` + string(body) + `}`))
+}
+
+func (suite *IdempotencyTestSuite) serve(method, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(HeaderIdempotencyKey, key)
+	}
+	rec := httptest.NewRecorder()
+	suite.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *IdempotencyTestSuite) TestInitialize_DisabledPassesThrough() {
+	mw, err := Initialize(Config{}, nil)
+	suite.Require().NoError(err)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users", nil)
+	req.Header.Set(HeaderIdempotencyKey, "k1")
+	mw(next).ServeHTTP(rec, req)
+
+	suite.Equal(http.StatusTeapot, rec.Code)
+}
+
+func (suite *IdempotencyTestSuite) TestInitialize_InvalidEndpoint() {
+	for _, entry := range []string{"/users", "POST users", "POST /users extra"} {
+		_, err := Initialize(Config{Enabled: true, Endpoints: []string{entry}}, suite.store)
+		suite.ErrorIs(err, errInvalidEndpoint, entry)
+	}
+}
+
+func (suite *IdempotencyTestSuite) TestRequestsWithoutKeyOrOnOtherEndpointsPassThrough() {
+	suite.serve(http.MethodPost, "/users", "", `{"a":1}`)
+	suite.serve(http.MethodPost, "/users", "", `{"a":1}`)
+	suite.serve(http.MethodPost, "/roles", "k1", `{"a":1}`)
+	suite.serve(http.MethodPost, "/roles", "k1", `{"a":1}`)
+	suite.serve(http.MethodPut, "/users", "k1", `{"a":1}`)
+	suite.serve(http.MethodPut, "/users", "k1", `{"a":1}`)
+
+	suite.Equal(6, suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestRetryReplaysStoredResponse() {
+	for _, path := range []string{"/users", "/applications", "/groups", "/organization-units"} {
+		suite.SetupTest()
+
+		first := suite.serve(http.MethodPost, path, "key-1", `{"name":"a"}`)
+		retry := suite.serve(http.MethodPost, path, "key-1", `{"name":"a"}`)
+
+		suite.Equal(1, suite.calls, path)
+		suite.Equal(http.StatusCreated, retry.Code)
+		suite.Equal(first.Body.String(), retry.Body.String())
+		suite.Equal("application/json", retry.Header().Get("Content-Type"))
+		suite.Equal("/users/u1", retry.Header().Get("Location"))
+		suite.Equal("true", retry.Header().Get(HeaderIdempotentReplayed))
+		suite.Empty(first.Header().Get(HeaderIdempotentReplayed))
+	}
+}
+
+func (suite *IdempotencyTestSuite) TestDifferentKeysAreProcessedSeparately() {
+	suite.serve(http.MethodPost, "/users", "key-1", `{"name":"a"}`)
+	suite.serve(http.MethodPost, "/users", "key-2", `{"name":"a"}`)
+
+	suite.Equal(2, suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestKeyReusedWithDifferentPayload() {
+	suite.serve(http.MethodPost, "/users", "key-1", `{"name":"a"}`)
+	rec := suite.serve(http.MethodPost, "/users", "key-1", `{"name":"b"}`)
+
+	suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+	suite.Contains(rec.Body.String(), errKeyReused.Code)
+	suite.Equal(1, suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestInvalidKey() {
+	for _, key := range []string{strings.Repeat("k", maxKeyLength+1), "bad\tkey", "ключ"} {
+		rec := suite.serve(http.MethodPost, "/users", key, `{}`)
+
+		suite.Equal(http.StatusBadRequest, rec.Code)
+		suite.Contains(rec.Body.String(), errInvalidKey.Code)
+	}
+	suite.Zero(suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestClientErrorIsReplayed() {
+	suite.status = http.StatusConflict
+
+	suite.serve(http.MethodPost, "/groups", "key-1", `{"name":"dup"}`)
+	rec := suite.serve(http.MethodPost, "/groups", "key-1", `{"name":"dup"}`)
+
+	suite.Equal(http.StatusConflict, rec.Code)
+	suite.Equal(1, suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestServerErrorIsNotStored() {
+	suite.status = http.StatusInternalServerError
+	suite.serve(http.MethodPost, "/users", "key-1", `{"name":"a"}`)
+
+	suite.status = http.StatusCreated
+	rec := suite.serve(http.MethodPost, "/users", "key-1", `{"name":"a"}`)
+
+	suite.Equal(http.StatusCreated, rec.Code)
+	suite.Equal(2, suite.calls)
+	suite.Empty(rec.Header().Get(HeaderIdempotentReplayed))
+}
+
+func (suite *IdempotencyTestSuite) TestRequestInProgress() {
+	recordKey := buildRecordKey("", http.MethodPost, "/users", "key-1")
+	g := &guard{store: suite.store}
+	suite.Require().NoError(g.save(context.Background(), recordKey,
+		&record{Status: recordStatusProcessing, Fingerprint: buildFingerprint("", []byte(`{}`))}, processingTTL))
+
+	rec := suite.serve(http.MethodPost, "/users", "key-1", `{}`)
+
+	suite.Equal(http.StatusConflict, rec.Code)
+	suite.Contains(rec.Body.String(), errRequestInProgress.Code)
+	suite.Zero(suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestConcurrentDuplicateOnSameNode() {
+	g := &guard{endpoints: []endpoint{{method: http.MethodPost, pathPattern: "/users"}}, ttl: defaultTTL,
+		store: suite.store}
+	g.inFlight.Store(buildRecordKey("", http.MethodPost, "/users", "key-1"), struct{}{})
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{}`))
+	req.Header.Set(HeaderIdempotencyKey, "key-1")
+	rec := httptest.NewRecorder()
+	g.middleware(http.HandlerFunc(suite.create)).ServeHTTP(rec, req)
+
+	suite.Equal(http.StatusConflict, rec.Code)
+	suite.Zero(suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestStoreFailureRejectsRequest() {
+	mw, err := Initialize(Config{Enabled: true}, failingStore{RuntimeStoreProvider: suite.store})
+	suite.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{}`))
+	req.Header.Set(HeaderIdempotencyKey, "key-1")
+	rec := httptest.NewRecorder()
+	mw(http.HandlerFunc(suite.create)).ServeHTTP(rec, req)
+
+	suite.Equal(http.StatusServiceUnavailable, rec.Code)
+	suite.Zero(suite.calls)
+}
+
+func (suite *IdempotencyTestSuite) TestRecordKeyIsScopedToCallerAndEndpoint() {
+	base := buildRecordKey("user-1", http.MethodPost, "/users", "key-1")
+
+	suite.Len(base, 64)
+	suite.NotEqual(base, buildRecordKey("user-2", http.MethodPost, "/users", "key-1"))
+	suite.NotEqual(base, buildRecordKey("user-1", http.MethodPost, "/groups", "key-1"))
+	suite.NotEqual(base, buildRecordKey("user-1", http.MethodPost, "/users", "key-2"))
+}
+
+// failingStore is a runtime store whose reads fail.
+type failingStore struct {
+	providers.RuntimeStoreProvider
+}
+
+func (failingStore) Get(context.Context, providers.RuntimeStoreNamespace, string) ([]byte, error) {
+	return nil, errors.New("store unavailable")
+}
@@ -44,6 +44,9 @@ func newImportHandler(service ImportServiceInterface) *importHandler {
 func (ih *importHandler) HandleImportRequest(w http.ResponseWriter, r *http.Request) {
 	importRequest, err := sysutils.DecodeJSONBody[ImportRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		errResp := apierror.ErrorResponse{
 			Code:        ErrorInvalidImportRequest.Code,
 			Message:     ErrorInvalidImportRequest.Error,
@@ -65,6 +68,9 @@ func (ih *importHandler) HandleImportRequest(w http.ResponseWriter, r *http.Requ
 func (ih *importHandler) HandleDeleteImportRequest(w http.ResponseWriter, r *http.Request) {
 	deleteRequest, err := sysutils.DecodeJSONBody[DeleteResourceRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		errResp := apierror.ErrorResponse{
 			Code:        ErrorInvalidImportRequest.Code,
 			Message:     ErrorInvalidImportRequest.Error,
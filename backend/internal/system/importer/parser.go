@@ -33,6 +33,7 @@ const (
 	resourceTypeEntityType              = "user_type"
 	resourceTypeResourceServer          = "resource_server"
 	resourceTypeRole                    = "role"
+	resourceTypeScope                   = "scope"
 	resourceTypeGroup                   = "group"
 	resourceTypeIdentityProvider        = "identity_provider"
 	resourceTypeNotificationSender      = "notification_sender"
@@ -168,6 +169,7 @@ func isKnownResourceType(resourceType string) bool {
 		resourceTypeEntityType:              {},
 		resourceTypeResourceServer:          {},
 		resourceTypeRole:                    {},
+		resourceTypeScope:                   {},
 		resourceTypeIdentityProvider:        {},
 		resourceTypeNotificationSender:      {},
 		resourceTypeFlow:                    {},
@@ -206,6 +208,10 @@ func classifyResourceType(node *yaml.Node) string {
 		matches = append(matches, resourceTypeRole)
 	}
 
+	if hasAllKeys(node, "name", "displayName", "claims") {
+		matches = append(matches, resourceTypeScope)
+	}
+
 	if hasAllKeys(node, "displayName", "theme") {
 		matches = append(matches, resourceTypeTheme)
 	}
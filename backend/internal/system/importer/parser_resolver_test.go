@@ -154,6 +154,11 @@ func TestClassifyResourceType_AdditionalResources(t *testing.T) {
 			expected: resourceTypeResourceServer,
 		},
 		{name: "role", yamlDoc: "id: role-1\nname: Admin\npermissions: []\n", expected: resourceTypeRole},
+		{
+			name:     "scope",
+			yamlDoc:  "id: sc-1\nname: profile:read\ndisplayName: Read your profile\nclaims: []\n",
+			expected: resourceTypeScope,
+		},
 		{name: "theme", yamlDoc: "id: th-1\ndisplayName: Theme\ntheme: {}\n", expected: resourceTypeTheme},
 		{name: "layout", yamlDoc: "id: ly-1\ndisplayName: Layout\nlayout: {}\n", expected: resourceTypeLayout},
 		{name: "user", yamlDoc: "id: u-1\ntype: person\nouId: ou-1\nattributes: {}\n", expected: resourceTypeUser},
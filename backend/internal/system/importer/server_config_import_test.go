@@ -49,7 +49,7 @@ func (f *fakeServerConfigService) SetConfig(
 }
 
 func newServerConfigImportService(sc serverConfigAdapter) ImportServiceInterface {
-	return newImportService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, sc)
+	return newImportService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, sc, nil)
 }
 
 const serverConfigImportDoc = `# resource_type: server_config
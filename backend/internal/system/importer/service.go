@@ -38,6 +38,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/scope"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/user"
 	"github.com/thunder-id/thunderid/internal/vc/credential"
@@ -116,6 +117,12 @@ type roleAdapter interface {
 		*tidcommon.ServiceError)
 }
 
+type scopeAdapter interface {
+	CreateScope(ctx context.Context, request scope.ScopeRequest) (*scope.Scope, *tidcommon.ServiceError)
+	UpdateScope(ctx context.Context, id string, request scope.ScopeRequest) (*scope.Scope, *tidcommon.ServiceError)
+	GetScopesByNames(ctx context.Context, names []string) ([]scope.Scope, *tidcommon.ServiceError)
+}
+
 type roleAssignmentAdapter interface {
 	AddAssignments(ctx context.Context, id string, assignments []role.RoleAssignment) *tidcommon.ServiceError
 }
@@ -233,6 +240,7 @@ type importService struct {
 	presentationDefinitionService  presentationDefinitionAdapter
 	credentialConfigurationService credentialConfigurationAdapter
 	serverConfigService            serverConfigAdapter
+	scopeService                   scopeAdapter
 }
 
 func newImportService(
@@ -253,6 +261,7 @@ func newImportService(
 	presentationDefinitionService presentationDefinitionAdapter,
 	credentialConfigurationService credentialConfigurationAdapter,
 	serverConfigService serverConfigAdapter,
+	scopeService scopeAdapter,
 ) ImportServiceInterface {
 	return &importService{
 		applicationService:             applicationService,
@@ -272,6 +281,7 @@ func newImportService(
 		presentationDefinitionService:  presentationDefinitionService,
 		credentialConfigurationService: credentialConfigurationService,
 		serverConfigService:            serverConfigService,
+		scopeService:                   scopeService,
 	}
 }
 
@@ -411,6 +421,8 @@ func (s *importService) importDocument(
 		return s.importEntityType(ctx, doc, options, dryRun)
 	case resourceTypeRole:
 		return s.importRole(ctx, doc, options, dryRun)
+	case resourceTypeScope:
+		return s.importScope(ctx, doc, options, dryRun)
 	case resourceTypeGroup:
 		return s.importGroup(ctx, doc, options, dryRun)
 	case resourceTypeResourceServer:
@@ -680,6 +692,7 @@ var resourceDependencyOrder = []string{
 	resourceTypeUser,
 	resourceTypeGroup,
 	resourceTypeRole,
+	resourceTypeScope,
 	resourceTypeTranslation,
 	resourceTypePresentationDefinition,
 	resourceTypeCredentialConfiguration,
@@ -863,6 +876,8 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 			Assertion:                 req.Assertion,
 			LoginConsent:              req.LoginConsent,
 			AllowedUserTypes:          req.AllowedUserTypes,
+			Sharing:                   req.Sharing,
+			AccessPolicy:              req.AccessPolicy,
 		},
 		Template:   req.Template,
 		FlowSecret: req.FlowSecret,
@@ -893,12 +908,16 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 					PKCERequired:                       config.OAuthConfig.PKCERequired,
 					PublicClient:                       config.OAuthConfig.PublicClient,
 					RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+					RedirectURIMatching:                config.OAuthConfig.RedirectURIMatching,
+					ApplicationType:                    config.OAuthConfig.ApplicationType,
 					Token:                              config.OAuthConfig.Token,
 					Scopes:                             config.OAuthConfig.Scopes,
 					UserInfo:                           config.OAuthConfig.UserInfo,
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
 					AcrValues:                          config.OAuthConfig.AcrValues,
+					AllowedOrigins:                     config.OAuthConfig.AllowedOrigins,
+					AllowedNetworks:                    config.OAuthConfig.AllowedNetworks,
 				},
 			})
 		}
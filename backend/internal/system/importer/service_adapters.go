@@ -33,6 +33,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/scope"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -369,6 +370,53 @@ func (s *importService) importRole(
 	return successOutcome(resourceTypeRole, created.ID, created.Name, operationCreate)
 }
 
+// importScope imports a scope definition. Scope IDs differ between environments, so an existing
+// definition is matched by name.
+func (s *importService) importScope(
+	ctx context.Context, doc parsedDocument, options *ImportOptions, dryRun bool,
+) ImportItemOutcome {
+	if s.scopeService == nil {
+		return unsupportedAdapterOutcome(resourceTypeScope, "scope")
+	}
+
+	var req scope.ScopeRequest
+	if err := doc.Node.Decode(&req); err != nil {
+		return decodeErrorOutcome(resourceTypeScope, "", req.Name, err)
+	}
+
+	existingID := ""
+	if options.IsUpsertEnabled() && req.Name != "" {
+		existing, svcErr := s.scopeService.GetScopesByNames(ctx, []string{req.Name})
+		if svcErr != nil {
+			return serviceErrorOutcome(resourceTypeScope, "", req.Name, operationUpdate, svcErr)
+		}
+		if len(existing) > 0 {
+			existingID = existing[0].ID
+		}
+	}
+
+	if dryRun {
+		if existingID != "" {
+			return successOutcome(resourceTypeScope, existingID, req.Name, operationUpdate)
+		}
+		return successOutcome(resourceTypeScope, "", req.Name, operationCreate)
+	}
+
+	if existingID != "" {
+		updated, svcErr := s.scopeService.UpdateScope(ctx, existingID, req)
+		if svcErr != nil {
+			return serviceErrorOutcome(resourceTypeScope, existingID, req.Name, operationUpdate, svcErr)
+		}
+		return successOutcome(resourceTypeScope, updated.ID, updated.Name, operationUpdate)
+	}
+
+	created, svcErr := s.scopeService.CreateScope(ctx, req)
+	if svcErr != nil {
+		return serviceErrorOutcome(resourceTypeScope, "", req.Name, operationCreate, svcErr)
+	}
+	return successOutcome(resourceTypeScope, created.ID, created.Name, operationCreate)
+}
+
 func (s *importService) importGroup(
 	ctx context.Context, doc parsedDocument, options *ImportOptions, dryRun bool,
 ) ImportItemOutcome {
@@ -21,6 +21,7 @@
 package jose
 
 import (
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	joseconfig "github.com/thunder-id/thunderid/internal/system/jose/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -29,9 +30,10 @@ import (
 
 // Initialize initializes the JOSE services (JWT and JWE).
 func Initialize(
-	runtimeProvider kmprovider.RuntimeCryptoProvider, cfg joseconfig.Config,
+	cacheManager cache.CacheManagerInterface, runtimeProvider kmprovider.RuntimeCryptoProvider,
+	cfg joseconfig.Config,
 ) (jwt.JWTServiceInterface, jwe.JWEServiceInterface, error) {
-	jwtService, err := jwt.Initialize(runtimeProvider, cfg)
+	jwtService, err := jwt.Initialize(cacheManager, runtimeProvider, cfg)
 	if err != nil {
 		return nil, nil, err
 	}
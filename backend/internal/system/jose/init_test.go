@@ -92,7 +92,7 @@ func (suite *JOSEInitTestSuite) TestInitialize_Success() {
 			},
 		}, nil)
 
-	jwtService, jweService, err := Initialize(suite.mockRuntime, joseconfig.Config{PreferredKeyID: "test-key-id"})
+	jwtService, jweService, err := Initialize(nil, suite.mockRuntime, joseconfig.Config{PreferredKeyID: "test-key-id"})
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), jwtService)
@@ -106,7 +106,7 @@ func (suite *JOSEInitTestSuite) TestInitialize_JWTInitializationFailure() {
 		GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{KeyID: "test-key-id"}).
 		Return(nil, errors.New("provider unavailable"))
 
-	jwtService, jweService, err := Initialize(suite.mockRuntime, joseconfig.Config{PreferredKeyID: "test-key-id"})
+	jwtService, jweService, err := Initialize(nil, suite.mockRuntime, joseconfig.Config{PreferredKeyID: "test-key-id"})
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), jwtService)
@@ -121,7 +121,7 @@ func (suite *JOSEInitTestSuite) TestInitialize_NilRuntimeProvider() {
 		}
 	}()
 
-	jwtService, jweService, err := Initialize(nil, joseconfig.Config{PreferredKeyID: "test-key-id"})
+	jwtService, jweService, err := Initialize(nil, nil, joseconfig.Config{PreferredKeyID: "test-key-id"})
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), jwtService)
@@ -140,7 +140,7 @@ func (suite *JOSEInitTestSuite) TestInitialize_ValidatesServiceInterfaces() {
 			},
 		}, nil)
 
-	jwtService, jweService, err := Initialize(suite.mockRuntime, joseconfig.Config{PreferredKeyID: "test-key-id"})
+	jwtService, jweService, err := Initialize(nil, suite.mockRuntime, joseconfig.Config{PreferredKeyID: "test-key-id"})
 
 	assert.NoError(suite.T(), err)
 	if jwtService != nil {
@@ -25,3 +25,6 @@ const (
 	// TokenTypeAccessToken is the JWT type header value for access tokens as defined in RFC 9068.
 	TokenTypeAccessToken = "at+jwt"
 )
+
+// jwksCacheName is the name of the cache that holds JWKS responses fetched from external endpoints.
+const jwksCacheName = "JWKSCache"
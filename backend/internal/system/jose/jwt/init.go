@@ -22,15 +22,25 @@ package jwt
 import (
 	"time"
 
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	httpservice "github.com/thunder-id/thunderid/internal/system/http"
 	joseconfig "github.com/thunder-id/thunderid/internal/system/jose/config"
 	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
 )
 
-// Initialize initializes the JWT service.
+// Initialize initializes the JWT service. JWKS responses fetched from external endpoints are kept
+// in the JWKS cache for the configured JWKS cache TTL. A nil cache manager or a zero TTL disables
+// the cache.
 func Initialize(
-	runtimeProvider kmprovider.RuntimeCryptoProvider, cfg joseconfig.Config,
+	cacheManager cache.CacheManagerInterface, runtimeProvider kmprovider.RuntimeCryptoProvider,
+	cfg joseconfig.Config,
 ) (JWTServiceInterface, error) {
 	httpClient := httpservice.NewHTTPClientWithTimeout(10 * time.Second)
-	return newJWTService(httpClient, runtimeProvider, cfg)
+
+	var jwksCache cache.CacheInterface[[]map[string]interface{}]
+	if cacheManager != nil && cfg.JWKSCacheTTL > 0 {
+		jwksCache = cache.GetCache[[]map[string]interface{}](cacheManager, jwksCacheName,
+			cache.WithDefaultTTL(cfg.JWKSCacheTTL))
+	}
+	return newJWTService(httpClient, jwksCache, runtimeProvider, cfg)
 }
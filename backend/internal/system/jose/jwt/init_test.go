@@ -26,15 +26,18 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	joseconfig "github.com/thunder-id/thunderid/internal/system/jose/config"
 	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
 )
 
@@ -116,12 +119,47 @@ func (suite *InitTestSuite) TestInitialize_Success() {
 			Thumbprint: "test-kid",
 		}}, nil)
 
-	jwtService, err := Initialize(cryptoMock, cfg)
+	jwtService, err := Initialize(nil, cryptoMock, cfg)
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), jwtService)
 	assert.Implements(suite.T(), (*JWTServiceInterface)(nil), jwtService)
 }
 
+func (suite *InitTestSuite) TestInitialize_JWKSCache() {
+	cacheManager := cache.Initialize(engineconfig.CacheConfig{Type: "inmemory", Size: 10}, "test-deployment")
+	defer cacheManager.Close()
+
+	testCases := []struct {
+		name         string
+		cacheManager cache.CacheManagerInterface
+		ttl          time.Duration
+		expectCache  bool
+	}{
+		{"CacheEnabled", cacheManager, 5 * time.Minute, true},
+		{"ZeroTTLDisablesCache", cacheManager, 0, false},
+		{"NoCacheManager", nil, 5 * time.Minute, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cryptoMock := cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+			cryptoMock.EXPECT().
+				GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{KeyID: "test-kid"}).
+				Return([]kmprovider.PublicKeyInfo{{
+					KeyID:      "test-kid",
+					Algorithm:  cryptolib.AlgorithmRS256,
+					PublicKey:  &suite.testPrivateKey.PublicKey,
+					Thumbprint: "test-kid",
+				}}, nil)
+
+			service, err := Initialize(tc.cacheManager, cryptoMock,
+				joseconfig.Config{PreferredKeyID: "test-kid", JWKSCacheTTL: tc.ttl})
+			suite.Require().NoError(err)
+			suite.Equal(tc.expectCache, service.(*jwtService).jwksCache != nil)
+		})
+	}
+}
+
 func (suite *InitTestSuite) TestInitialize_PublicKeyRetrievalError() {
 	cfg := joseconfig.Config{
 		Issuer:         "https://auth.example.com",
@@ -134,7 +172,7 @@ func (suite *InitTestSuite) TestInitialize_PublicKeyRetrievalError() {
 		GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{KeyID: "test-kid"}).
 		Return(nil, errors.New("provider unavailable"))
 
-	jwtService, err := Initialize(cryptoMock, cfg)
+	jwtService, err := Initialize(nil, cryptoMock, cfg)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), jwtService)
 	assert.Contains(suite.T(), err.Error(), "failed to retrieve public key")
@@ -157,7 +195,7 @@ func (suite *InitTestSuite) TestInitialize_WithoutPreferredKeyID() {
 			Thumbprint: "test-kid",
 		}}, nil)
 
-	jwtService, err := Initialize(cryptoMock, cfg)
+	jwtService, err := Initialize(nil, cryptoMock, cfg)
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), jwtService)
 }
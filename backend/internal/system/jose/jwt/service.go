@@ -33,6 +33,7 @@ import (
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	httpservice "github.com/thunder-id/thunderid/internal/system/http"
 	joseconfig "github.com/thunder-id/thunderid/internal/system/jose/config"
@@ -54,12 +55,7 @@ type JWTServiceInterface interface {
 	VerifyJWTSignature(ctx context.Context, jwtToken string) *tidcommon.ServiceError
 	VerifyJWTSignatureWithPublicKey(jwtToken string, jwtPublicKey crypto.PublicKey) *tidcommon.ServiceError
 	VerifyJWTSignatureWithJWKS(ctx context.Context, jwtToken string, jwksURL string) *tidcommon.ServiceError
-}
-
-// jwksCacheEntry holds a cached JWKS response with its expiry time.
-type jwksCacheEntry struct {
-	keys      []map[string]interface{}
-	expiresAt time.Time
+	SetSigningKey(ctx context.Context, keyID string) error
 }
 
 // jwtService implements the JWTServiceInterface for generating and managing JWT tokens.
@@ -70,64 +66,124 @@ type jwtService struct {
 	jwsAlg         jws.Algorithm
 	kid            string
 	logger         *log.Logger
-	jwksCache      sync.Map
+	jwksCache      cache.CacheInterface[[]map[string]interface{}]
 	httpClient     httpservice.HTTPClientInterface
+	// signingKeyMu guards keyRef, jwsAlg and kid, which change when the signing key is rotated.
+	signingKeyMu sync.RWMutex
 }
 
 // newJWTService creates a new JWT service instance.
+// A nil JWKS cache disables caching of fetched JWKS responses.
 func newJWTService(
-	httpClient httpservice.HTTPClientInterface, cryptoProvider kmprovider.RuntimeCryptoProvider,
-	cfg joseconfig.Config,
+	httpClient httpservice.HTTPClientInterface, jwksCache cache.CacheInterface[[]map[string]interface{}],
+	cryptoProvider kmprovider.RuntimeCryptoProvider, cfg joseconfig.Config,
 ) (JWTServiceInterface, error) {
 	preferredKid := cfg.PreferredKeyID
-	keyRef := kmprovider.KeyRef{KeyID: preferredKid}
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "JWTService"))
 
-	keys, err := cryptoProvider.GetPublicKeys(context.Background(), kmprovider.PublicKeyFilter{KeyID: preferredKid})
+	key, err := resolveSigningKey(context.Background(), cryptoProvider, preferredKid)
 	if err != nil {
-		return nil, errors.New("failed to retrieve public key for the key id: " + preferredKid)
-	}
-	if len(keys) == 0 {
-		return nil, errors.New("no public key found for the key id: " + preferredKid)
-	}
-	key := keys[0]
-
-	if _, err := jws.MapAlgorithmToSignAlg(jws.Algorithm(key.Algorithm)); err != nil {
-		return nil, errors.New("unsupported algorithm for key id: " + preferredKid)
+		return nil, err
 	}
 
 	return &jwtService{
 		cryptoProvider: cryptoProvider,
 		cfg:            cfg,
-		keyRef:         keyRef,
+		keyRef:         kmprovider.KeyRef{KeyID: preferredKid},
 		jwsAlg:         jws.Algorithm(key.Algorithm),
 		kid:            key.Thumbprint,
 		logger:         logger,
+		jwksCache:      jwksCache,
 		httpClient:     httpClient,
 	}, nil
 }
 
+// resolveSigningKey looks up the public key information of a signing key and checks that its
+// algorithm can be used to sign JWTs.
+func resolveSigningKey(ctx context.Context, cryptoProvider kmprovider.RuntimeCryptoProvider,
+	keyID string) (*kmprovider.PublicKeyInfo, error) {
+	keys, err := cryptoProvider.GetPublicKeys(ctx, kmprovider.PublicKeyFilter{KeyID: keyID})
+	if err != nil {
+		return nil, errors.New("failed to retrieve public key for the key id: " + keyID)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no public key found for the key id: " + keyID)
+	}
+	key := keys[0]
+
+	if _, err := jws.MapAlgorithmToSignAlg(jws.Algorithm(key.Algorithm)); err != nil {
+		return nil, errors.New("unsupported algorithm for key id: " + keyID)
+	}
+	return &key, nil
+}
+
+// SetSigningKey switches the key used to sign new JWTs. JWTs signed with the previous key remain
+// verifiable for as long as the crypto provider keeps that key.
+func (js *jwtService) SetSigningKey(ctx context.Context, keyID string) error {
+	key, err := resolveSigningKey(ctx, js.cryptoProvider, keyID)
+	if err != nil {
+		return err
+	}
+
+	js.signingKeyMu.Lock()
+	defer js.signingKeyMu.Unlock()
+	js.keyRef = kmprovider.KeyRef{KeyID: keyID}
+	js.jwsAlg = jws.Algorithm(key.Algorithm)
+	js.kid = key.Thumbprint
+	return nil
+}
+
+// signingKey returns the key reference, algorithm and kid of the current signing key.
+func (js *jwtService) signingKey() (kmprovider.KeyRef, jws.Algorithm, string) {
+	js.signingKeyMu.RLock()
+	defer js.signingKeyMu.RUnlock()
+	return js.keyRef, js.jwsAlg, js.kid
+}
+
+// keyForAlgorithm returns the key reference and kid of a configured key that signs with the given
+// algorithm. When several keys qualify, the one with the lowest key id is used so that every node
+// makes the same choice.
+func (js *jwtService) keyForAlgorithm(ctx context.Context, alg jws.Algorithm) (kmprovider.KeyRef, string, bool) {
+	keys, err := js.cryptoProvider.GetPublicKeys(ctx, kmprovider.PublicKeyFilter{Algorithm: cryptolib.Algorithm(alg)})
+	if err != nil {
+		js.logger.Error(ctx, "Failed to retrieve signing keys", log.String("alg", string(alg)), log.Error(err))
+		return kmprovider.KeyRef{}, "", false
+	}
+	if len(keys) == 0 {
+		return kmprovider.KeyRef{}, "", false
+	}
+	selected := keys[0]
+	for _, key := range keys[1:] {
+		if key.KeyID < selected.KeyID {
+			selected = key
+		}
+	}
+	return kmprovider.KeyRef{KeyID: selected.KeyID}, selected.Thumbprint, true
+}
+
 // GenerateJWT generates a JWT signed with the server's private key.
 // The typ parameter sets the JWT header "typ" field. If empty, defaults to "JWT".
-// The alg parameter overrides the signing algorithm (e.g. "RS256"). When empty, the server's
-// default algorithm is used. When set but incompatible with the server's private key,
-// ErrorUnsupportedJWSAlgorithm is returned.
+// The alg parameter overrides the signing algorithm (e.g. "ES256"). When empty or equal to the
+// algorithm of the active signing key, the active signing key is used. Otherwise the token is signed
+// with another configured key of that algorithm, and ErrorUnsupportedJWSAlgorithm is returned when
+// no such key exists.
 // claims["aud"] must be set by the caller as either a string or []string; omitting it
 // or providing another type is a programmer error and returns InternalServerError.
 func (js *jwtService) GenerateJWT(
 	ctx context.Context, sub, iss string, validityPeriod int64, claims map[string]interface{}, typ, alg string,
 ) (string, int64, *tidcommon.ServiceError) {
-	jwsAlg := js.jwsAlg
-	if alg != "" {
-		if alg != string(js.jwsAlg) {
-			return "", 0, &ErrorUnsupportedJWSAlgorithm
-		}
-		jwsAlg = jws.Algorithm(alg)
-	}
 	if js.cryptoProvider == nil {
 		js.logger.Error(ctx, "Crypto provider not initialized for JWT generation")
 		return "", 0, &tidcommon.InternalServerError
 	}
+	keyRef, jwsAlg, kid := js.signingKey()
+	if alg != "" && alg != string(jwsAlg) {
+		var ok bool
+		if keyRef, kid, ok = js.keyForAlgorithm(ctx, jws.Algorithm(alg)); !ok {
+			return "", 0, &ErrorUnsupportedJWSAlgorithm
+		}
+		jwsAlg = jws.Algorithm(alg)
+	}
 
 	// Validate that claims["aud"] is present and of an accepted type.
 	audValue, hasAud := claims["aud"]
@@ -150,7 +206,7 @@ func (js *jwtService) GenerateJWT(
 	header := map[string]string{
 		"alg": string(jwsAlg),
 		"typ": typ,
-		"kid": js.kid,
+		"kid": kid,
 	}
 
 	headerJSON, err := json.Marshal(header)
@@ -206,7 +262,7 @@ func (js *jwtService) GenerateJWT(
 
 	// Create the signing input and sign it with the crypto provider.
 	signingInput := headerBase64 + "." + payloadBase64
-	signature, err := js.cryptoProvider.Sign(ctx, js.keyRef, string(jwsAlg), []byte(signingInput))
+	signature, err := js.cryptoProvider.Sign(ctx, keyRef, string(jwsAlg), []byte(signingInput))
 	if err != nil {
 		js.logger.Error(ctx, "Failed to sign JWT: "+err.Error())
 		return "", 0, &tidcommon.InternalServerError
@@ -393,10 +449,10 @@ func (js *jwtService) VerifyJWTSignatureWithJWKS(
 // getJWKSKeys returns JWKS keys for the given URL, using a TTL-based cache.
 func (js *jwtService) getJWKSKeys(
 	ctx context.Context, jwksURL string) ([]map[string]interface{}, *tidcommon.ServiceError) {
-	if cached, ok := js.jwksCache.Load(jwksURL); ok {
-		entry := cached.(*jwksCacheEntry)
-		if time.Now().Before(entry.expiresAt) {
-			return entry.keys, nil
+	cacheKey := cache.CacheKey{Key: jwksURL}
+	if js.jwksCache != nil {
+		if keys, ok := js.jwksCache.Get(ctx, cacheKey); ok {
+			return keys, nil
 		}
 	}
 
@@ -430,10 +486,9 @@ func (js *jwtService) getJWKSKeys(
 		return nil, &ErrorFailedToParseJWKS
 	}
 
-	js.jwksCache.Store(jwksURL, &jwksCacheEntry{
-		keys:      jwks.Keys,
-		expiresAt: time.Now().Add(js.cfg.JWKSCacheTTL),
-	})
+	if js.jwksCache != nil {
+		_ = js.jwksCache.Set(ctx, cacheKey, jwks.Keys)
+	}
 
 	return jwks.Keys, nil
 }
@@ -47,6 +47,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	httpservice "github.com/thunder-id/thunderid/internal/system/http"
@@ -54,6 +55,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/jose/jws"
 	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
 )
 
@@ -192,7 +194,7 @@ func (suite *JWTServiceTestSuite) TestNewJWTService() {
 		}, nil)
 
 	cfg := joseconfig.Config{PreferredKeyID: "test-kid"}
-	service, err := Initialize(cryptoMock, cfg)
+	service, err := Initialize(nil, cryptoMock, cfg)
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), service)
 	assert.Implements(suite.T(), (*JWTServiceInterface)(nil), service)
@@ -260,7 +262,7 @@ func (suite *JWTServiceTestSuite) TestInitScenarios() {
 			}
 
 			cfg := joseconfig.Config{PreferredKeyID: "test-kid"}
-			service, err := Initialize(cryptoMock, cfg)
+			service, err := Initialize(nil, cryptoMock, cfg)
 
 			if tc.expectSuccess {
 				assert.NoError(t, err)
@@ -1493,7 +1495,51 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithPublicKey() {
 	}
 }
 
+func (suite *JWTServiceTestSuite) TestSetSigningKey() {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	cryptoMock := cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	cryptoMock.EXPECT().
+		GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{KeyID: "rotated-key"}).
+		Return([]kmprovider.PublicKeyInfo{
+			{
+				KeyID:      "rotated-key",
+				Algorithm:  cryptolib.AlgorithmES256,
+				PublicKey:  &ecKey.PublicKey,
+				Thumbprint: "rotated-kid",
+			},
+		}, nil)
+	cryptoMock.EXPECT().
+		GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{KeyID: "missing-key"}).
+		Return([]kmprovider.PublicKeyInfo{}, nil)
+	cryptoMock.EXPECT().
+		Sign(mock.Anything, kmprovider.KeyRef{KeyID: "rotated-key"}, string(jws.ES256), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ kmprovider.KeyRef, _ string, content []byte) ([]byte, error) {
+			return cryptolib.Generate(content, cryptolib.ECDSASHA256, ecKey)
+		})
+	suite.jwtService.cryptoProvider = cryptoMock
+
+	suite.Require().NoError(suite.jwtService.SetSigningKey(context.Background(), "rotated-key"))
+	assert.Error(suite.T(), suite.jwtService.SetSigningKey(context.Background(), "missing-key"))
+
+	token, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIssuer, 3600, map[string]interface{}{"aud": testAudience}, TokenTypeJWT, "")
+	suite.Require().Nil(svcErr)
+	header, err := DecodeJWTHeader(token)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "rotated-kid", header["kid"])
+	assert.Equal(suite.T(), string(jws.ES256), header["alg"])
+}
+
 func (suite *JWTServiceTestSuite) TestGenerateJWTUnsupportedAlgOverride() {
+	cryptoMock := suite.jwtService.cryptoProvider.(*cryptomock.RuntimeCryptoProviderMock)
+	cryptoMock.EXPECT().
+		GetPublicKeys(mock.Anything, mock.MatchedBy(func(f kmprovider.PublicKeyFilter) bool {
+			return f.Algorithm != ""
+		})).
+		Return([]kmprovider.PublicKeyInfo{}, nil)
+
 	for _, alg := range []string{"ES256", "invalid"} {
 		suite.T().Run(alg, func(t *testing.T) {
 			_, _, err := suite.jwtService.GenerateJWT(context.Background(),
@@ -1504,6 +1550,35 @@ func (suite *JWTServiceTestSuite) TestGenerateJWTUnsupportedAlgOverride() {
 	}
 }
 
+func (suite *JWTServiceTestSuite) TestGenerateJWTWithAlgOfAnotherConfiguredKey() {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	cryptoMock := suite.jwtService.cryptoProvider.(*cryptomock.RuntimeCryptoProviderMock)
+	cryptoMock.EXPECT().
+		GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{Algorithm: cryptolib.AlgorithmES256}).
+		Return([]kmprovider.PublicKeyInfo{
+			{KeyID: "ec-key-b", Algorithm: cryptolib.AlgorithmES256, Thumbprint: "ec-kid-b"},
+			{KeyID: "ec-key-a", Algorithm: cryptolib.AlgorithmES256, PublicKey: &ecKey.PublicKey,
+				Thumbprint: "ec-kid-a"},
+		}, nil)
+	cryptoMock.EXPECT().
+		Sign(mock.Anything, kmprovider.KeyRef{KeyID: "ec-key-a"}, string(jws.ES256), mock.Anything).
+		RunAndReturn(func(_ context.Context, _ kmprovider.KeyRef, _ string, content []byte) ([]byte, error) {
+			return cryptolib.Generate(content, cryptolib.ECDSASHA256, ecKey)
+		})
+
+	token, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIssuer, 3600, map[string]interface{}{"aud": testAudience}, TokenTypeJWT, "ES256")
+	suite.Require().Nil(svcErr)
+
+	header, err := DecodeJWTHeader(token)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "ec-kid-a", header["kid"])
+	assert.Equal(suite.T(), string(jws.ES256), header["alg"])
+	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithPublicKey(token, &ecKey.PublicKey))
+}
+
 func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureUnsupportedAlgFromProvider() {
 	token, _, genErr := suite.jwtService.GenerateJWT(context.Background(),
 		"test-subject", testIssuer, 3600, map[string]interface{}{"aud": testAudience}, TokenTypeJWT, "")
@@ -1560,10 +1635,12 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSUsesCache() {
 	// Point 3 catches a buggy cache that stores or returns entries without keying by
 	// URL — without it, a single shared `cached` slot would still pass points 1 and 2.
 	//
-	// The default SetupTest config has JWKSCacheTTL == 0, which would cause the cache to
-	// expire instantly (Now().Before(Now()) == false). Inject a positive TTL here so the
-	// cache actually retains entries.
-	suite.jwtService.cfg.JWKSCacheTTL = 300 * time.Second
+	// The default SetupTest service has no JWKS cache. Inject an in-memory cache here so
+	// fetched JWKS responses are retained.
+	cacheManager := cache.Initialize(engineconfig.CacheConfig{Type: "inmemory", Size: 10}, "test-deployment")
+	defer cacheManager.Close()
+	suite.jwtService.jwksCache = cache.GetCache[[]map[string]interface{}](cacheManager, jwksCacheName,
+		cache.WithDefaultTTL(300*time.Second))
 
 	jwksData := suite.createMockJWKSData()
 	makeServer := func(counter *int32) *httptest.Server {
@@ -2031,7 +2108,7 @@ func (suite *JWTServiceTestSuite) TestInitWithECDSAKeys() {
 				}).Maybe()
 
 			cfg := joseconfig.Config{PreferredKeyID: "test-kid"}
-			service, err := Initialize(cryptoMock, cfg)
+			service, err := Initialize(nil, cryptoMock, cfg)
 
 			assert.NoError(t, err)
 			assert.NotNil(t, service)
@@ -2084,7 +2161,7 @@ func (suite *JWTServiceTestSuite) TestInitWithEd25519Key() {
 		}).Maybe()
 
 	cfg := joseconfig.Config{PreferredKeyID: "test-kid"}
-	service, err := Initialize(cryptoMock, cfg)
+	service, err := Initialize(nil, cryptoMock, cfg)
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), service)
 
@@ -2120,7 +2197,7 @@ func (suite *JWTServiceTestSuite) TestInitWithECPrivateKeyFormat() {
 		}}, nil)
 
 	cfg := joseconfig.Config{PreferredKeyID: "test-kid"}
-	service, err := Initialize(cryptoMock, cfg)
+	service, err := Initialize(nil, cryptoMock, cfg)
 
 	assert.NoError(suite.T(), err)
 
@@ -2144,7 +2221,7 @@ func (suite *JWTServiceTestSuite) TestInitWithUnsupportedECCurve() {
 		}}, nil)
 
 	cfg := joseconfig.Config{PreferredKeyID: "test-kid"}
-	_, err = Initialize(cryptoMock, cfg)
+	_, err = Initialize(nil, cryptoMock, cfg)
 
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "unsupported algorithm")
@@ -20,6 +20,7 @@ package defaultkm
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -29,16 +30,23 @@ import (
 	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
 )
 
+// configCryptoService encrypts configuration secrets with envelope encryption. Every value is
+// encrypted with a fresh data key, and the data key is encrypted with the current master key.
+// Retired master keys are kept only to decrypt values encrypted before the last key change.
 type configCryptoService struct {
 	defaultKeyID string
 	keys         map[string][]byte
 }
 
-func newConfigCryptoService(key []byte) kmprovider.ConfigCryptoProvider {
+func newConfigCryptoService(key []byte, previousKeys ...[]byte) kmprovider.ConfigCryptoProvider {
 	kid := cryptolib.GenerateThumbprint(key)
+	keys := map[string][]byte{kid: key}
+	for _, previousKey := range previousKeys {
+		keys[cryptolib.GenerateThumbprint(previousKey)] = previousKey
+	}
 	return &configCryptoService{
 		defaultKeyID: kid,
-		keys:         map[string][]byte{kid: key},
+		keys:         keys,
 	}
 }
 
@@ -47,16 +55,23 @@ func (es *configCryptoService) Encrypt(_ context.Context, plaintext []byte) ([]b
 	if len(key) == 0 {
 		return nil, errors.New("default encryption key not found")
 	}
-	ciphertext, _, err := cryptolib.Encrypt(
-		key, &cryptolib.AlgorithmParams{Algorithm: cryptolib.AlgorithmAESGCM}, plaintext,
-	)
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	encryptedKey, err := encryptAESGCM(key, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := encryptAESGCM(dataKey, plaintext)
 	if err != nil {
 		return nil, err
 	}
 	encData := EncryptedData{
-		Algorithm:  AESGCM,
-		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
-		KeyID:      es.defaultKeyID,
+		Algorithm:    AESGCMEnvelope,
+		Ciphertext:   base64.StdEncoding.EncodeToString(ciphertext),
+		KeyID:        es.defaultKeyID,
+		EncryptedKey: base64.StdEncoding.EncodeToString(encryptedKey),
 	}
 	jsonData, err := json.Marshal(encData)
 	if err != nil {
@@ -70,7 +85,7 @@ func (es *configCryptoService) Decrypt(_ context.Context, encodedData []byte) ([
 	if err := json.Unmarshal(encodedData, &encData); err != nil {
 		return nil, fmt.Errorf("invalid data format: %w", err)
 	}
-	if encData.Algorithm != AESGCM {
+	if encData.Algorithm != AESGCM && encData.Algorithm != AESGCMEnvelope {
 		return nil, fmt.Errorf("unsupported algorithm: %s", encData.Algorithm)
 	}
 	ciphertext, err := base64.StdEncoding.DecodeString(encData.Ciphertext)
@@ -81,7 +96,20 @@ func (es *configCryptoService) Decrypt(_ context.Context, encodedData []byte) ([
 	if len(key) == 0 {
 		return nil, errors.New("decryption key not found for kid")
 	}
-	return cryptolib.Decrypt(key, cryptolib.AlgorithmParams{Algorithm: cryptolib.AlgorithmAESGCM}, ciphertext)
+	if encData.Algorithm == AESGCM {
+		// Values encrypted before envelope encryption was introduced use the master key directly.
+		return decryptAESGCM(key, ciphertext)
+	}
+
+	encryptedKey, err := base64.StdEncoding.DecodeString(encData.EncryptedKey)
+	if err != nil || len(encryptedKey) == 0 {
+		return nil, errors.New("invalid encrypted data key")
+	}
+	dataKey, err := decryptAESGCM(key, encryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+	return decryptAESGCM(dataKey, ciphertext)
 }
 
 func (es *configCryptoService) defaultKey() []byte {
@@ -97,3 +125,14 @@ func (es *configCryptoService) keyForDecrypt(kid string) []byte {
 	}
 	return es.keys[kid]
 }
+
+func encryptAESGCM(key, plaintext []byte) ([]byte, error) {
+	ciphertext, _, err := cryptolib.Encrypt(
+		key, &cryptolib.AlgorithmParams{Algorithm: cryptolib.AlgorithmAESGCM}, plaintext,
+	)
+	return ciphertext, err
+}
+
+func decryptAESGCM(key, ciphertext []byte) ([]byte, error) {
+	return cryptolib.Decrypt(key, cryptolib.AlgorithmParams{Algorithm: cryptolib.AlgorithmAESGCM}, ciphertext)
+}
@@ -19,11 +19,16 @@
 package defaultkm
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 )
 
 // TestEncryptionService_Encrypt_NoDefaultKey covers lines 48-50: when the
@@ -49,3 +54,60 @@ func TestEncryptionService_Encrypt_InvalidKeySize(t *testing.T) {
 	_, err := es.Encrypt(context.Background(), []byte("plaintext"))
 	require.Error(t, err)
 }
+
+func TestEncryptionService_EnvelopeRoundTrip(t *testing.T) {
+	key := bytes.Repeat([]byte{0x11}, 32)
+	es := newConfigCryptoService(key)
+
+	first, err := es.Encrypt(context.Background(), []byte("client-secret"))
+	require.NoError(t, err)
+	second, err := es.Encrypt(context.Background(), []byte("client-secret"))
+	require.NoError(t, err)
+
+	var encData EncryptedData
+	require.NoError(t, json.Unmarshal(first, &encData))
+	assert.Equal(t, AESGCMEnvelope, encData.Algorithm)
+	assert.NotEmpty(t, encData.EncryptedKey)
+	assert.NotEqual(t, first, second, "each value should be encrypted with its own data key")
+
+	plaintext, err := es.Decrypt(context.Background(), first)
+	require.NoError(t, err)
+	assert.Equal(t, "client-secret", string(plaintext))
+}
+
+func TestEncryptionService_DecryptsLegacyDirectEncryption(t *testing.T) {
+	key := bytes.Repeat([]byte{0x22}, 32)
+	ciphertext, err := encryptAESGCM(key, []byte("legacy"))
+	require.NoError(t, err)
+	legacy, err := json.Marshal(EncryptedData{
+		Algorithm:  AESGCM,
+		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+		KeyID:      cryptolib.GenerateThumbprint(key),
+	})
+	require.NoError(t, err)
+
+	plaintext, err := newConfigCryptoService(key).Decrypt(context.Background(), legacy)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", string(plaintext))
+}
+
+func TestEncryptionService_DecryptsWithPreviousKey(t *testing.T) {
+	oldKey := bytes.Repeat([]byte{0x33}, 32)
+	newKey := bytes.Repeat([]byte{0x44}, 32)
+	encrypted, err := newConfigCryptoService(oldKey).Encrypt(context.Background(), []byte("secret"))
+	require.NoError(t, err)
+
+	_, err = newConfigCryptoService(newKey).Decrypt(context.Background(), encrypted)
+	assert.ErrorContains(t, err, "decryption key not found")
+
+	rotated := newConfigCryptoService(newKey, oldKey)
+	plaintext, err := rotated.Decrypt(context.Background(), encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(plaintext))
+
+	reencrypted, err := rotated.Encrypt(context.Background(), plaintext)
+	require.NoError(t, err)
+	var encData EncryptedData
+	require.NoError(t, json.Unmarshal(reencrypted, &encData))
+	assert.Equal(t, cryptolib.GenerateThumbprint(newKey), encData.KeyID)
+}
@@ -22,6 +22,8 @@ package defaultkm
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -62,16 +64,33 @@ func Initialize(pkiSvc pki.PKIServiceInterface) (
 }
 
 func initConfigProvider() (kmprovider.ConfigCryptoProvider, error) {
-	encryptionKey := config.GetServerRuntime().Config.Crypto.Encryption.Key
-	if encryptionKey == "" {
+	encryptionCfg := config.GetServerRuntime().Config.Crypto.Encryption
+	if encryptionCfg.Key == "" {
 		return nil, errors.New("encryption key not configured in crypto.encryption.key")
 	}
-	key, err := hex.DecodeString(encryptionKey)
+	key, err := decodeEncryptionKey(encryptionCfg.Key)
+	if err != nil {
+		return nil, err
+	}
+	previousKeys := make([][]byte, 0, len(encryptionCfg.PreviousKeys))
+	for _, encoded := range encryptionCfg.PreviousKeys {
+		previousKey, err := decodeEncryptionKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key in crypto.encryption.previous_keys: %w", err)
+		}
+		previousKeys = append(previousKeys, previousKey)
+	}
+	return newConfigCryptoService(key, previousKeys...), nil
+}
+
+// decodeEncryptionKey decodes a hex encoded AES key and checks its length.
+func decodeEncryptionKey(encoded string) ([]byte, error) {
+	key, err := hex.DecodeString(strings.TrimSpace(encoded))
 	if err != nil {
 		return nil, err
 	}
 	if len(key) != 16 && len(key) != 24 && len(key) != 32 {
 		return nil, errors.New("invalid AES key length: must be 16, 24, or 32 bytes")
 	}
-	return newConfigCryptoService(key), nil
+	return key, nil
 }
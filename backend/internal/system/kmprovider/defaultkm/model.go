@@ -24,11 +24,19 @@ type CryptoAlgorithm string
 const (
 	// AESGCM represents AES-GCM encryption algorithm.
 	AESGCM CryptoAlgorithm = "AES-GCM"
+	// AESGCMEnvelope represents AES-GCM envelope encryption, where the content is encrypted with a
+	// per-value data key and the data key is encrypted with the master key.
+	AESGCMEnvelope CryptoAlgorithm = "AES-GCM-ENVELOPE"
 )
 
+// dataKeySize is the size in bytes of the data keys generated for envelope encryption.
+const dataKeySize = 32
+
 // EncryptedData represents the structure of encrypted data with metadata.
 type EncryptedData struct {
 	Algorithm  CryptoAlgorithm `json:"alg"`
 	Ciphertext string          `json:"ct"`
 	KeyID      string          `json:"kid"`
+	// EncryptedKey is the data key encrypted with the master key. Set only for envelope encryption.
+	EncryptedKey string `json:"ek,omitempty"`
 }
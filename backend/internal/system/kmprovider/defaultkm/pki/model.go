@@ -21,6 +21,7 @@ package pki
 import (
 	"crypto"
 	"crypto/tls"
+	"time"
 )
 
 // PKIAlgorithm represents the algorithm used in the PKI.
@@ -46,4 +47,6 @@ type PKI struct {
 	PrivateKey  crypto.PrivateKey
 	Certificate tls.Certificate
 	ThumbPrint  string
+	// ExpiresAt is when a retired key stops being usable. The zero value means the key does not expire.
+	ExpiresAt time.Time
 }
@@ -29,9 +29,12 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"slices"
+	"sync"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
@@ -50,10 +53,14 @@ type PKIServiceInterface interface {
 	GetCertificateChain(id string) [][]byte
 	GetSupportedSigningAlgorithms() []string
 	GetTLSConfig() (*tls.Config, error)
+	AddKey(id string, certificate tls.Certificate) error
+	SetKeyExpiry(id string, expiresAt time.Time) error
 }
 
-// pkiService stores loaded certificates indexed by their ID.
+// pkiService stores loaded certificates indexed by their ID. Keys added at runtime by key rotation
+// share the map with the configured keys, so access is guarded by mu.
 type pkiService struct {
+	mu           sync.RWMutex
 	certificates map[string]PKI
 	logger       *log.Logger
 }
@@ -86,21 +93,11 @@ func newPKIService() (PKIServiceInterface, error) {
 		if err != nil {
 			return nil, err
 		}
-		algorithm, err := getAlgorithmFromKey(tlsCert.PrivateKey)
+		pkiEntry, err := newPKI(keyConfig.ID, tlsCert)
 		if err != nil {
 			return nil, err
 		}
-		thumbprint, err := getThumbprint(tlsCert)
-		if err != nil {
-			return nil, err
-		}
-		certificates[keyConfig.ID] = PKI{
-			ID:          keyConfig.ID,
-			Algorithm:   algorithm,
-			PrivateKey:  tlsCert.PrivateKey,
-			Certificate: tlsCert,
-			ThumbPrint:  thumbprint,
-		}
+		certificates[keyConfig.ID] = pkiEntry
 	}
 
 	if len(certificates) == 0 {
@@ -113,9 +110,67 @@ func newPKIService() (PKIServiceInterface, error) {
 	}, nil
 }
 
+// AddKey registers a key/certificate pair at runtime, such as a key created by key rotation.
+func (s *pkiService) AddKey(id string, certificate tls.Certificate) error {
+	if id == "" {
+		return errors.New("key ID is empty")
+	}
+	pkiEntry, err := newPKI(id, certificate)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.certificates[id]; exists {
+		return fmt.Errorf("a key with ID %s is already registered", id)
+	}
+	s.certificates[id] = pkiEntry
+	return nil
+}
+
+// SetKeyExpiry sets the time after which the key is no longer used for signing, verification or
+// publishing. It is applied to retired keys so tokens they signed stay verifiable until they expire.
+func (s *pkiService) SetKeyExpiry(id string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cert, exists := s.certificates[id]
+	if !exists {
+		return fmt.Errorf("no key registered with ID %s", id)
+	}
+	cert.ExpiresAt = expiresAt
+	s.certificates[id] = cert
+	return nil
+}
+
+// getCertificate returns the unexpired key/certificate pair registered with the given ID.
+func (s *pkiService) getCertificate(id string) (PKI, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cert, exists := s.certificates[id]
+	if !exists || cert.isExpired(time.Now()) {
+		return PKI{}, false
+	}
+	return cert, true
+}
+
+// liveCertificates returns a snapshot of the unexpired key/certificate pairs.
+func (s *pkiService) liveCertificates() map[string]PKI {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	now := time.Now()
+	live := make(map[string]PKI, len(s.certificates))
+	for id, cert := range s.certificates {
+		if !cert.isExpired(now) {
+			live[id] = cert
+		}
+	}
+	return live
+}
+
 // GetPrivateKey retrieves the private key associated with the given ID.
 func (s *pkiService) GetPrivateKey(ctx context.Context, id string) (crypto.PrivateKey, *tidcommon.ServiceError) {
-	cert, exists := s.certificates[id]
+	cert, exists := s.getCertificate(id)
 	if !exists || cert.PrivateKey == nil {
 		s.logger.Error(ctx, "Private key not found for certificate ID: "+id)
 		return nil, &tidcommon.InternalServerError
@@ -125,7 +180,7 @@ func (s *pkiService) GetPrivateKey(ctx context.Context, id string) (crypto.Priva
 
 // GetCertificateChain returns the DER-encoded certificate chain for the given ID (leaf first).
 func (s *pkiService) GetCertificateChain(id string) [][]byte {
-	cert, exists := s.certificates[id]
+	cert, exists := s.getCertificate(id)
 	if !exists {
 		return nil
 	}
@@ -134,7 +189,7 @@ func (s *pkiService) GetCertificateChain(id string) [][]byte {
 
 // GetCertThumbprint retrieves the thumbprint of the certificate associated with the given ID.
 func (s *pkiService) GetCertThumbprint(id string) string {
-	cert, exists := s.certificates[id]
+	cert, exists := s.getCertificate(id)
 	if !exists {
 		return ""
 	}
@@ -144,7 +199,7 @@ func (s *pkiService) GetCertThumbprint(id string) string {
 // GetX509Certificate retrieves the x509 certificate associated with the given ID.
 func (s *pkiService) GetX509Certificate(
 	ctx context.Context, id string) (*x509.Certificate, *tidcommon.ServiceError) {
-	cert, exists := s.certificates[id]
+	cert, exists := s.getCertificate(id)
 	if !exists {
 		s.logger.Error(ctx, "Certificate not found for certificate ID: "+id)
 		return nil, &tidcommon.InternalServerError
@@ -165,7 +220,7 @@ func (s *pkiService) GetX509Certificate(
 func (s *pkiService) GetAllX509Certificates(
 	ctx context.Context) (map[string]*x509.Certificate, *tidcommon.ServiceError) {
 	result := make(map[string]*x509.Certificate)
-	for id, cert := range s.certificates {
+	for id, cert := range s.liveCertificates() {
 		if len(cert.Certificate.Certificate) == 0 {
 			s.logger.Error(ctx, "Certificate data is empty for certificate ID: "+id)
 			return nil, &tidcommon.InternalServerError
@@ -184,7 +239,7 @@ func (s *pkiService) GetAllX509Certificates(
 // supported across all configured keys.
 func (s *pkiService) GetSupportedSigningAlgorithms() []string {
 	var result []string
-	for _, cert := range s.certificates {
+	for _, cert := range s.liveCertificates() {
 		for _, alg := range pkiAlgorithmToJWSAlgorithms(cert.Algorithm) {
 			if !slices.Contains(result, alg) {
 				result = append(result, alg)
@@ -220,6 +275,33 @@ func pkiAlgorithmToJWSAlgorithms(alg PKIAlgorithm) []string {
 	}
 }
 
+// newPKI builds the PKI entry for a loaded key/certificate pair.
+func newPKI(id string, certificate tls.Certificate) (PKI, error) {
+	if len(certificate.Certificate) == 0 {
+		return PKI{}, errors.New("certificate data is empty for key ID " + id)
+	}
+	algorithm, err := getAlgorithmFromKey(certificate.PrivateKey)
+	if err != nil {
+		return PKI{}, err
+	}
+	thumbprint, err := getThumbprint(certificate)
+	if err != nil {
+		return PKI{}, err
+	}
+	return PKI{
+		ID:          id,
+		Algorithm:   algorithm,
+		PrivateKey:  certificate.PrivateKey,
+		Certificate: certificate,
+		ThumbPrint:  thumbprint,
+	}, nil
+}
+
+// isExpired reports whether the key has passed its expiry time.
+func (p PKI) isExpired(now time.Time) bool {
+	return !p.ExpiresAt.IsZero() && !now.Before(p.ExpiresAt)
+}
+
 // getAlgorithmFromKey determines the PKIAlgorithm based on the type of the private key.
 func getAlgorithmFromKey(key crypto.PrivateKey) (PKIAlgorithm, error) {
 	switch k := key.(type) {
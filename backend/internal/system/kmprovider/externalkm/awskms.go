@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	httpservice "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+// awsCredentials are the AWS credentials used to sign KMS requests.
+type awsCredentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// awsKMSSigner signs with an asymmetric AWS KMS key through the KMS JSON API.
+type awsKMSSigner struct {
+	keyID       string
+	region      string
+	endpoint    string
+	credentials awsCredentials
+	httpClient  httpservice.HTTPClientInterface
+	now         func() time.Time
+}
+
+// awsSigningAlgorithms maps signing algorithms to AWS KMS signing algorithm names.
+var awsSigningAlgorithms = map[cryptolib.SignAlgorithm]string{
+	cryptolib.RSASHA256:    "RSASSA_PKCS1_V1_5_SHA_256",
+	cryptolib.RSASHA512:    "RSASSA_PKCS1_V1_5_SHA_512",
+	cryptolib.RSAPSSSHA256: "RSASSA_PSS_SHA_256",
+	cryptolib.ECDSASHA256:  "ECDSA_SHA_256",
+	cryptolib.ECDSASHA384:  "ECDSA_SHA_384",
+	cryptolib.ECDSASHA512:  "ECDSA_SHA_512",
+}
+
+// newAWSKMSSigner creates a signer for an AWS KMS key. Credentials are read from the standard
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+func newAWSKMSSigner(keyID, region, endpoint string, httpClient httpservice.HTTPClientInterface) (
+	*awsKMSSigner, error) {
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+	if region == "" {
+		return nil, errors.New("AWS region is not configured for the KMS key")
+	}
+	creds := awsCredentials{
+		accessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.accessKeyID == "" || creds.secretAccessKey == "" {
+		return nil, errors.New("AWS credentials are not configured in the environment")
+	}
+	if endpoint == "" {
+		endpoint = "https://kms." + region + ".amazonaws.com"
+	}
+	return &awsKMSSigner{
+		keyID:       keyID,
+		region:      region,
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		credentials: creds,
+		httpClient:  httpClient,
+		now:         time.Now,
+	}, nil
+}
+
+// PublicKey fetches the public key of the KMS key.
+func (s *awsKMSSigner) PublicKey(ctx context.Context) (crypto.PublicKey, error) {
+	var resp struct {
+		PublicKey string `json:"PublicKey"`
+	}
+	if err := s.call(ctx, awsTargetGetKey, map[string]string{"KeyId": s.keyID}, &resp); err != nil {
+		return nil, err
+	}
+	der, err := base64.StdEncoding.DecodeString(resp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key returned by AWS KMS: %w", err)
+	}
+	return x509.ParsePKIXPublicKey(der)
+}
+
+// Sign signs the digest with the KMS key.
+func (s *awsKMSSigner) Sign(ctx context.Context, alg cryptolib.SignAlgorithm, digest []byte) ([]byte, error) {
+	awsAlg, ok := awsSigningAlgorithms[alg]
+	if !ok {
+		return nil, errUnsupportedSignAlgorithm
+	}
+	req := map[string]string{
+		"KeyId":            s.keyID,
+		"Message":          base64.StdEncoding.EncodeToString(digest),
+		"MessageType":      "DIGEST",
+		"SigningAlgorithm": awsAlg,
+	}
+	var resp struct {
+		Signature string `json:"Signature"`
+	}
+	if err := s.call(ctx, awsTargetSign, req, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Signature)
+}
+
+// call invokes a KMS API operation and decodes its JSON response.
+func (s *awsKMSSigner) call(ctx context.Context, target string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+"/", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", awsKMSContentType)
+	req.Header.Set("X-Amz-Target", target)
+	signAWSRequest(req, payload, s.credentials, s.region, awsKMSService, s.now().UTC())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("AWS KMS request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read AWS KMS response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var kmsErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(respBody, &kmsErr)
+		return fmt.Errorf("AWS KMS %s returned status %d: %s %s", target, resp.StatusCode, kmsErr.Type,
+			kmsErr.Message)
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+// signAWSRequest signs the request with AWS Signature Version 4.
+func signAWSRequest(req *http.Request, payload []byte, creds awsCredentials, region, service string,
+	now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(req.Header.Get(name))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method, path, canonicalQuery(req.URL.Query()), canonicalHeaders.String(), signedHeaders,
+		sha256Hex(payload),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+creds.secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+creds.accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+// canonicalQuery returns the query string in the canonical form required by Signature Version 4.
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vs := append([]string(nil), values[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			parts = append(parts, url.QueryEscape(k)+"="+url.QueryEscape(v))
+		}
+	}
+	return strings.ReplaceAll(strings.Join(parts, "&"), "+", "%20")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	httpservice "github.com/thunder-id/thunderid/internal/system/http"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+)
+
+func newTestHTTPClient(t *testing.T) httpservice.HTTPClientInterface {
+	config.ResetServerRuntime()
+	require.NoError(t, config.InitializeServerRuntime("", &config.Config{}))
+	t.Cleanup(config.ResetServerRuntime)
+	return httpservice.NewHTTPClientWithTimeout(requestTimeout)
+}
+
+func TestNewAWSKMSSigner_RequiresRegionAndCredentials(t *testing.T) {
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+
+	_, err := newAWSKMSSigner("key-id", "", "", nil)
+	assert.ErrorContains(t, err, "region")
+
+	_, err = newAWSKMSSigner("key-id", "eu-west-1", "", nil)
+	assert.ErrorContains(t, err, "credentials")
+
+	t.Setenv("AWS_REGION", "eu-west-1")
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	signer, err := newAWSKMSSigner("key-id", "", "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://kms.eu-west-1.amazonaws.com", signer.endpoint)
+}
+
+func TestAWSKMSSigner_PublicKeyAndSign(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, awsKMSContentType, r.Header.Get("Content-Type"))
+		assert.Equal(t, "20260102T030405Z", r.Header.Get("X-Amz-Date"))
+		assert.Equal(t, "session", r.Header.Get("X-Amz-Security-Token"))
+		auth := r.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(auth,
+			"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20260102/eu-west-1/kms/aws4_request, "+
+				"SignedHeaders=content-type;host;x-amz-date;x-amz-security-token;x-amz-target, Signature="))
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "alias/signing", body["KeyId"])
+
+		switch r.Header.Get("X-Amz-Target") {
+		case awsTargetGetKey:
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"PublicKey": base64.StdEncoding.EncodeToString(pubDER),
+			})
+		case awsTargetSign:
+			assert.Equal(t, "DIGEST", body["MessageType"])
+			assert.Equal(t, "ECDSA_SHA_256", body["SigningAlgorithm"])
+			digest, err := base64.StdEncoding.DecodeString(body["Message"])
+			assert.NoError(t, err)
+			sig, err := ecKey.Sign(rand.Reader, digest, crypto.SHA256)
+			assert.NoError(t, err)
+			_ = json.NewEncoder(w).Encode(map[string]string{"Signature": base64.StdEncoding.EncodeToString(sig)})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"UnknownOperationException","message":"unknown"}`))
+		}
+	}))
+	defer server.Close()
+
+	signer := &awsKMSSigner{
+		keyID:       "alias/signing",
+		region:      "eu-west-1",
+		endpoint:    server.URL,
+		credentials: awsCredentials{accessKeyID: "AKIDEXAMPLE", secretAccessKey: "secret", sessionToken: "session"},
+		httpClient:  newTestHTTPClient(t),
+		now:         func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) },
+	}
+
+	key, err := loadExternalKey(context.Background(), "kms-key", signer)
+	require.NoError(t, err)
+	assert.Equal(t, cryptolib.AlgorithmES256, key.algorithm)
+
+	svc := newRuntimeCryptoService(nil, []*externalKey{key})
+	content := []byte("header.payload")
+	signature, err := svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "kms-key"}, "ES256", content)
+	require.NoError(t, err)
+	assert.NoError(t, cryptolib.Verify(content, signature, cryptolib.ECDSASHA256, &ecKey.PublicKey))
+
+	_, err = signer.Sign(context.Background(), cryptolib.ED25519, []byte("digest"))
+	assert.ErrorIs(t, err, errUnsupportedSignAlgorithm)
+}
+
+func TestAWSKMSSigner_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"__type":"AccessDeniedException","message":"not authorized"}`))
+	}))
+	defer server.Close()
+
+	signer := &awsKMSSigner{
+		keyID: "alias/signing", region: "eu-west-1", endpoint: server.URL,
+		credentials: awsCredentials{accessKeyID: "AKIDEXAMPLE", secretAccessKey: "secret"},
+		httpClient:  newTestHTTPClient(t), now: time.Now,
+	}
+	_, err := signer.PublicKey(context.Background())
+	assert.ErrorContains(t, err, "AccessDeniedException not authorized")
+}
+
+func TestSignAWSRequest_CanonicalizesQueryAndHeaders(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://kms.eu-west-1.amazonaws.com/?b=2&a=1", nil)
+	require.NoError(t, err)
+	signAWSRequest(req, nil, awsCredentials{accessKeyID: "AKID", secretAccessKey: "secret"}, "eu-west-1", "kms",
+		time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	first := req.Header.Get("Authorization")
+
+	req2, err := http.NewRequest(http.MethodGet, "https://kms.eu-west-1.amazonaws.com/?a=1&b=2", nil)
+	require.NoError(t, err)
+	signAWSRequest(req2, nil, awsCredentials{accessKeyID: "AKID", secretAccessKey: "secret"}, "eu-west-1", "kms",
+		time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	assert.Equal(t, first, req2.Header.Get("Authorization"))
+	assert.Contains(t, first, "SignedHeaders=host;x-amz-date,")
+	assert.Equal(t, "a=1&b=2", canonicalQuery(req.URL.Query()))
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package externalkm provides a key manager that delegates signing to external key managers such as
+// AWS KMS and GCP KMS, so that signing private keys never reside on the server.
+package externalkm
+
+import "time"
+
+const (
+	// ProviderAWSKMS identifies keys held in AWS KMS.
+	ProviderAWSKMS = "aws_kms"
+	// ProviderGCPKMS identifies keys held in GCP Cloud KMS.
+	ProviderGCPKMS = "gcp_kms"
+)
+
+const (
+	loggerComponentName = "ExternalKMRuntimeCryptoService"
+
+	// requestTimeout bounds every call to an external key manager.
+	requestTimeout = 10 * time.Second
+
+	awsKMSService     = "kms"
+	awsKMSContentType = "application/x-amz-json-1.1"
+	awsTargetSign     = "TrentService.Sign"
+	awsTargetGetKey   = "TrentService.GetPublicKey"
+
+	gcpKMSEndpoint = "https://cloudkms.googleapis.com"
+	// gcpMetadataTokenURL returns access tokens for the service account attached to the workload.
+	gcpMetadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	// gcpTokenRefreshMargin renews cached access tokens this long before they expire.
+	gcpTokenRefreshMargin = time.Minute
+)
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	httpservice "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+// gcpKMSSigner signs with an asymmetric Cloud KMS crypto key version through the Cloud KMS REST API.
+// Access tokens come from the metadata server of the workload's service account.
+type gcpKMSSigner struct {
+	keyName    string
+	endpoint   string
+	tokenURL   string
+	httpClient httpservice.HTTPClientInterface
+	now        func() time.Time
+
+	mu          sync.Mutex
+	algorithm   string
+	accessToken string
+	tokenExpiry time.Time
+}
+
+// gcpSigningAlgorithms maps signing algorithms to the prefix and suffix of the Cloud KMS key
+// algorithms that produce them. A Cloud KMS key signs with exactly one algorithm.
+var gcpSigningAlgorithms = map[cryptolib.SignAlgorithm][2]string{
+	cryptolib.RSASHA256:    {"RSA_SIGN_PKCS1_", "_SHA256"},
+	cryptolib.RSASHA512:    {"RSA_SIGN_PKCS1_", "_SHA512"},
+	cryptolib.RSAPSSSHA256: {"RSA_SIGN_PSS_", "_SHA256"},
+	cryptolib.ECDSASHA256:  {"EC_SIGN_P256_", "_SHA256"},
+	cryptolib.ECDSASHA384:  {"EC_SIGN_P384_", "_SHA384"},
+}
+
+// newGCPKMSSigner creates a signer for a Cloud KMS crypto key version.
+func newGCPKMSSigner(keyName, endpoint string, httpClient httpservice.HTTPClientInterface) (*gcpKMSSigner, error) {
+	if !strings.Contains(keyName, "/cryptoKeyVersions/") {
+		return nil, errors.New("GCP KMS key_ref must be a crypto key version resource name")
+	}
+	if endpoint == "" {
+		endpoint = gcpKMSEndpoint
+	}
+	return &gcpKMSSigner{
+		keyName:    strings.TrimPrefix(keyName, "/"),
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		tokenURL:   gcpMetadataTokenURL,
+		httpClient: httpClient,
+		now:        time.Now,
+	}, nil
+}
+
+// PublicKey fetches the public key of the crypto key version and records its signing algorithm.
+func (s *gcpKMSSigner) PublicKey(ctx context.Context) (crypto.PublicKey, error) {
+	var resp struct {
+		PEM       string `json:"pem"`
+		Algorithm string `json:"algorithm"`
+	}
+	if err := s.call(ctx, http.MethodGet, "/v1/"+s.keyName+"/publicKey", nil, &resp); err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(resp.PEM))
+	if block == nil {
+		return nil, errors.New("invalid public key returned by GCP KMS")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.algorithm = resp.Algorithm
+	s.mu.Unlock()
+	return pub, nil
+}
+
+// Sign signs the digest with the crypto key version.
+func (s *gcpKMSSigner) Sign(ctx context.Context, alg cryptolib.SignAlgorithm, digest []byte) ([]byte, error) {
+	affixes, ok := gcpSigningAlgorithms[alg]
+	s.mu.Lock()
+	keyAlgorithm := s.algorithm
+	s.mu.Unlock()
+	if !ok || !strings.HasPrefix(keyAlgorithm, affixes[0]) || !strings.HasSuffix(keyAlgorithm, affixes[1]) {
+		return nil, errUnsupportedSignAlgorithm
+	}
+
+	digestField := "sha" + strings.TrimPrefix(affixes[1], "_SHA")
+	req := map[string]interface{}{
+		"digest": map[string]string{digestField: base64.StdEncoding.EncodeToString(digest)},
+	}
+	var resp struct {
+		Signature string `json:"signature"`
+	}
+	if err := s.call(ctx, http.MethodPost, "/v1/"+s.keyName+":asymmetricSign", req, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Signature)
+}
+
+// call invokes a Cloud KMS API method and decodes its JSON response.
+func (s *gcpKMSSigner) call(ctx context.Context, method, path string, body, out interface{}) error {
+	token, err := s.token(ctx)
+	if err != nil {
+		return err
+	}
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("GCP KMS request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read GCP KMS response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.Unmarshal(respBody, &apiErr)
+		return fmt.Errorf("GCP KMS returned status %d: %s", resp.StatusCode, apiErr.Error.Message)
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+// token returns a cached access token, fetching a new one from the metadata server when the cached
+// token is about to expire.
+func (s *gcpKMSSigner) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.accessToken != "" && s.now().Add(gcpTokenRefreshMargin).Before(s.tokenExpiry) {
+		return s.accessToken, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.tokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch GCP access token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GCP metadata server returned status %d", resp.StatusCode)
+	}
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("invalid GCP access token response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", errors.New("GCP metadata server returned an empty access token")
+	}
+	s.accessToken = tokenResp.AccessToken
+	s.tokenExpiry = s.now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	return s.accessToken, nil
+}
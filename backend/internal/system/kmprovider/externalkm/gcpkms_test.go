@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+)
+
+const testGCPKeyName = "projects/p/locations/global/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"
+
+func TestNewGCPKMSSigner_RequiresKeyVersion(t *testing.T) {
+	_, err := newGCPKMSSigner("projects/p/locations/global/keyRings/r/cryptoKeys/k", "", nil)
+	assert.Error(t, err)
+
+	signer, err := newGCPKMSSigner(testGCPKeyName, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, gcpKMSEndpoint, signer.endpoint)
+}
+
+func TestGCPKMSSigner_PublicKeyAndSign(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			tokenRequests++
+			assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "gcp-token", "expires_in": 3600})
+			return
+		}
+		assert.Equal(t, "Bearer gcp-token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/v1/" + testGCPKeyName + "/publicKey":
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"pem": string(pubPEM), "algorithm": "RSA_SIGN_PKCS1_2048_SHA256",
+			})
+		case "/v1/" + testGCPKeyName + ":asymmetricSign":
+			var body struct {
+				Digest map[string]string `json:"digest"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			digest, err := base64.StdEncoding.DecodeString(body.Digest["sha256"])
+			assert.NoError(t, err)
+			sig, err := rsaKey.Sign(rand.Reader, digest, crypto.SHA256)
+			assert.NoError(t, err)
+			_ = json.NewEncoder(w).Encode(map[string]string{"signature": base64.StdEncoding.EncodeToString(sig)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"not found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	signer, err := newGCPKMSSigner(testGCPKeyName, server.URL, newTestHTTPClient(t))
+	require.NoError(t, err)
+	signer.tokenURL = server.URL + "/token"
+
+	key, err := loadExternalKey(context.Background(), "kms-key", signer)
+	require.NoError(t, err)
+	assert.Equal(t, cryptolib.AlgorithmRS256, key.algorithm)
+
+	svc := newRuntimeCryptoService(nil, []*externalKey{key})
+	content := []byte("header.payload")
+	signature, err := svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "kms-key"}, "RS256", content)
+	require.NoError(t, err)
+	assert.NoError(t, cryptolib.Verify(content, signature, cryptolib.RSASHA256, &rsaKey.PublicKey))
+	assert.Equal(t, 1, tokenRequests, "the access token should be cached")
+
+	_, err = svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "kms-key"}, "PS256", content)
+	assert.ErrorIs(t, err, errUnsupportedSignAlgorithm)
+}
+
+func TestGCPKMSSigner_RefreshesExpiredToken(t *testing.T) {
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		tokenRequests++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "gcp-token", "expires_in": 30})
+	}))
+	defer server.Close()
+
+	signer, err := newGCPKMSSigner(testGCPKeyName, server.URL, newTestHTTPClient(t))
+	require.NoError(t, err)
+	signer.tokenURL = server.URL
+	signer.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	for range 2 {
+		token, err := signer.token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "gcp-token", token)
+	}
+	assert.Equal(t, 2, tokenRequests, "tokens expiring within the refresh margin should not be reused")
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	httpservice "github.com/thunder-id/thunderid/internal/system/http"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+)
+
+// Initialize adds the external keys configured under crypto.external_keys to the given provider.
+// The public key of every external key is fetched once here, so startup fails when a key manager
+// is unreachable or a key is misconfigured. base is returned unchanged when no keys are configured.
+func Initialize(base kmprovider.RuntimeCryptoProvider) (kmprovider.RuntimeCryptoProvider, error) {
+	keyConfigs := config.GetServerRuntime().Config.Crypto.ExternalKeys
+	if len(keyConfigs) == 0 {
+		return base, nil
+	}
+
+	httpClient := httpservice.NewHTTPClientWithTimeout(requestTimeout)
+	keys := make([]*externalKey, 0, len(keyConfigs))
+	seen := make(map[string]bool, len(keyConfigs))
+	for _, keyCfg := range keyConfigs {
+		if keyCfg.ID == "" || keyCfg.KeyRef == "" {
+			return nil, errors.New("external keys require both id and key_ref")
+		}
+		if seen[keyCfg.ID] {
+			return nil, fmt.Errorf("duplicate external key id %s", keyCfg.ID)
+		}
+		seen[keyCfg.ID] = true
+
+		existing, err := base.GetPublicKeys(context.Background(), kmprovider.PublicKeyFilter{KeyID: keyCfg.ID})
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return nil, fmt.Errorf("external key id %s is already used by a key under crypto.keys", keyCfg.ID)
+		}
+
+		signer, err := newSigner(keyCfg, httpClient)
+		if err != nil {
+			return nil, fmt.Errorf("external key %s: %w", keyCfg.ID, err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		key, err := loadExternalKey(ctx, keyCfg.ID, signer)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return newRuntimeCryptoService(base, keys), nil
+}
+
+// newSigner creates the signer for the key manager named in the key configuration.
+func newSigner(keyCfg config.ExternalKeyConfig, httpClient httpservice.HTTPClientInterface) (keySigner, error) {
+	switch keyCfg.Provider {
+	case ProviderAWSKMS:
+		return newAWSKMSSigner(keyCfg.KeyRef, keyCfg.Region, keyCfg.Endpoint, httpClient)
+	case ProviderGCPKMS:
+		return newGCPKMSSigner(keyCfg.KeyRef, keyCfg.Endpoint, httpClient)
+	default:
+		return nil, fmt.Errorf("unsupported provider %q: supported providers are %s and %s",
+			keyCfg.Provider, ProviderAWSKMS, ProviderGCPKMS)
+	}
+}
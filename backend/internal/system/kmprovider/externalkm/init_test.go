@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+)
+
+func initRuntime(t *testing.T, keys ...config.ExternalKeyConfig) {
+	config.ResetServerRuntime()
+	cfg := &config.Config{}
+	cfg.Crypto.ExternalKeys = keys
+	require.NoError(t, config.InitializeServerRuntime("", cfg))
+	t.Cleanup(config.ResetServerRuntime)
+}
+
+func TestInitialize_NoExternalKeysReturnsBase(t *testing.T) {
+	initRuntime(t)
+	base := cryptomock.NewRuntimeCryptoProviderMock(t)
+
+	provider, err := Initialize(base)
+	require.NoError(t, err)
+	assert.Same(t, base, provider)
+}
+
+func TestInitialize_InvalidConfiguration(t *testing.T) {
+	testCases := []struct {
+		name    string
+		keys    []config.ExternalKeyConfig
+		wantErr string
+	}{
+		{"MissingKeyRef", []config.ExternalKeyConfig{{ID: "kms", Provider: ProviderGCPKMS}}, "key_ref"},
+		{"UnsupportedProvider", []config.ExternalKeyConfig{{ID: "kms", Provider: "pkcs11", KeyRef: "slot-0"}},
+			"unsupported provider"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			initRuntime(t, tc.keys...)
+			base := cryptomock.NewRuntimeCryptoProviderMock(t)
+			base.EXPECT().GetPublicKeys(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+			_, err := Initialize(base)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestInitialize_RejectsIDOfFileBasedKey(t *testing.T) {
+	initRuntime(t, config.ExternalKeyConfig{ID: "default-key", Provider: ProviderGCPKMS, KeyRef: testGCPKeyName})
+	base := cryptomock.NewRuntimeCryptoProviderMock(t)
+	base.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{KeyID: "default-key"}).
+		Return([]kmprovider.PublicKeyInfo{{KeyID: "default-key"}}, nil)
+
+	_, err := Initialize(base)
+	assert.ErrorContains(t, err, "already used")
+}
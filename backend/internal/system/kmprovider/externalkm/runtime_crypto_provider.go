@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// externalKey is a signing key held by an external key manager. Its public key is fetched once at
+// startup and cached for JWKS publishing and verification.
+type externalKey struct {
+	id         string
+	signer     keySigner
+	publicKey  crypto.PublicKey
+	algorithm  cryptolib.Algorithm
+	thumbprint string
+}
+
+// runtimeCryptoService signs with external keys and delegates every other operation, including all
+// file-based keys, to the wrapped provider.
+type runtimeCryptoService struct {
+	base   kmprovider.RuntimeCryptoProvider
+	keys   map[string]*externalKey
+	logger *log.Logger
+}
+
+// newRuntimeCryptoService creates a RuntimeCryptoProvider that adds the given external keys to base.
+func newRuntimeCryptoService(base kmprovider.RuntimeCryptoProvider,
+	keys []*externalKey) kmprovider.RuntimeCryptoProvider {
+	keyMap := make(map[string]*externalKey, len(keys))
+	for _, key := range keys {
+		keyMap[key.id] = key
+	}
+	return &runtimeCryptoService{
+		base:   base,
+		keys:   keyMap,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// loadExternalKey fetches the public key of an external key and derives its algorithm and kid.
+func loadExternalKey(ctx context.Context, id string, signer keySigner) (*externalKey, error) {
+	pub, err := signer.PublicKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch public key for key id %s: %w", id, err)
+	}
+	alg, err := algorithmForPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("key id %s: %w", id, err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("key id %s: %w", id, err)
+	}
+	return &externalKey{
+		id:         id,
+		signer:     signer,
+		publicKey:  pub,
+		algorithm:  alg,
+		thumbprint: cryptolib.GenerateThumbprint(der),
+	}, nil
+}
+
+func (s *runtimeCryptoService) Encrypt(
+	ctx context.Context, keyRef *kmprovider.KeyRef, params cryptolib.AlgorithmParams, content []byte,
+) ([]byte, *cryptolib.CryptoDetails, error) {
+	return s.base.Encrypt(ctx, keyRef, params, content)
+}
+
+func (s *runtimeCryptoService) Decrypt(
+	ctx context.Context, keyRef *kmprovider.KeyRef, params cryptolib.AlgorithmParams, content []byte,
+) ([]byte, error) {
+	return s.base.Decrypt(ctx, keyRef, params, content)
+}
+
+func (s *runtimeCryptoService) Sign(
+	ctx context.Context, keyRef kmprovider.KeyRef, alg string, content []byte,
+) ([]byte, error) {
+	key, ok := s.keys[keyRef.KeyID]
+	if !ok {
+		return s.base.Sign(ctx, keyRef, alg, content)
+	}
+	signAlg, err := cryptolib.SignAlgorithmFor(cryptolib.Algorithm(alg))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", kmprovider.ErrUnsupportedAlgorithm, alg)
+	}
+	hashed, err := digest(signAlg, content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", kmprovider.ErrUnsupportedAlgorithm, alg)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	signature, err := key.signer.Sign(ctx, signAlg, hashed)
+	if err != nil {
+		s.logger.Error(ctx, "External key manager failed to sign", log.String("keyID", key.id), log.Error(err))
+		return nil, fmt.Errorf("failed to sign with key id %s: %w", key.id, err)
+	}
+	if ecPub, ok := key.publicKey.(*ecdsa.PublicKey); ok {
+		return ecdsaDERToJWS(signature, ecPub)
+	}
+	return signature, nil
+}
+
+func (s *runtimeCryptoService) Verify(
+	ctx context.Context, kid string, alg string, content []byte, signature []byte,
+) error {
+	for _, key := range s.keys {
+		if key.thumbprint != kid {
+			continue
+		}
+		signAlg, err := cryptolib.SignAlgorithmFor(cryptolib.Algorithm(alg))
+		if err != nil {
+			return fmt.Errorf("%w: %q", kmprovider.ErrUnsupportedAlgorithm, alg)
+		}
+		return cryptolib.Verify(content, signature, signAlg, key.publicKey)
+	}
+	return s.base.Verify(ctx, kid, alg, content, signature)
+}
+
+func (s *runtimeCryptoService) GetPublicKeys(
+	ctx context.Context, filter kmprovider.PublicKeyFilter,
+) ([]kmprovider.PublicKeyInfo, error) {
+	keys, err := s.base.GetPublicKeys(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range s.keys {
+		if filter.KeyID != "" && filter.KeyID != key.id {
+			continue
+		}
+		if filter.Algorithm != "" && filter.Algorithm != key.algorithm {
+			continue
+		}
+		keys = append(keys, kmprovider.PublicKeyInfo{
+			KeyID:      key.id,
+			Algorithm:  key.algorithm,
+			PublicKey:  key.publicKey,
+			Thumbprint: key.thumbprint,
+		})
+	}
+	return keys, nil
+}
+
+func (s *runtimeCryptoService) GetTLSMaterial(ctx context.Context) (*kmprovider.TLSMaterial, error) {
+	return s.base.GetTLSMaterial(ctx)
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+)
+
+// localSigner stands in for an external key manager by signing digests with an in-memory key.
+type localSigner struct {
+	key    crypto.Signer
+	pubErr error
+}
+
+func (s *localSigner) PublicKey(_ context.Context) (crypto.PublicKey, error) {
+	if s.pubErr != nil {
+		return nil, s.pubErr
+	}
+	return s.key.Public(), nil
+}
+
+func (s *localSigner) Sign(_ context.Context, alg cryptolib.SignAlgorithm, digest []byte) ([]byte, error) {
+	switch alg {
+	case cryptolib.RSASHA256, cryptolib.ECDSASHA256:
+		return s.key.Sign(rand.Reader, digest, crypto.SHA256)
+	default:
+		return nil, errUnsupportedSignAlgorithm
+	}
+}
+
+func newECExternalKey(t *testing.T, id string) (*externalKey, *ecdsa.PrivateKey) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key, err := loadExternalKey(context.Background(), id, &localSigner{key: ecKey})
+	require.NoError(t, err)
+	return key, ecKey
+}
+
+func TestLoadExternalKey(t *testing.T) {
+	key, _ := newECExternalKey(t, "kms-key")
+	assert.Equal(t, cryptolib.AlgorithmES256, key.algorithm)
+	assert.NotEmpty(t, key.thumbprint)
+
+	_, err := loadExternalKey(context.Background(), "kms-key", &localSigner{pubErr: errors.New("denied")})
+	assert.ErrorContains(t, err, "denied")
+}
+
+func TestSign_ExternalECKeyProducesJWSSignature(t *testing.T) {
+	key, ecKey := newECExternalKey(t, "kms-key")
+	svc := newRuntimeCryptoService(cryptomock.NewRuntimeCryptoProviderMock(t), []*externalKey{key})
+
+	content := []byte("header.payload")
+	signature, err := svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "kms-key"}, "ES256", content)
+	require.NoError(t, err)
+	assert.Len(t, signature, 64)
+	assert.NoError(t, cryptolib.Verify(content, signature, cryptolib.ECDSASHA256, &ecKey.PublicKey))
+
+	assert.NoError(t, svc.Verify(context.Background(), key.thumbprint, "ES256", content, signature))
+	assert.Error(t, svc.Verify(context.Background(), key.thumbprint, "ES256", []byte("tampered"), signature))
+}
+
+func TestSign_ExternalRSAKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key, err := loadExternalKey(context.Background(), "kms-rsa", &localSigner{key: rsaKey})
+	require.NoError(t, err)
+	svc := newRuntimeCryptoService(cryptomock.NewRuntimeCryptoProviderMock(t), []*externalKey{key})
+
+	content := []byte("header.payload")
+	signature, err := svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "kms-rsa"}, "RS256", content)
+	require.NoError(t, err)
+	assert.NoError(t, cryptolib.Verify(content, signature, cryptolib.RSASHA256, &rsaKey.PublicKey))
+
+	_, err = svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "kms-rsa"}, "EdDSA", content)
+	assert.ErrorIs(t, err, kmprovider.ErrUnsupportedAlgorithm)
+	_, err = svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "kms-rsa"}, "RS512", content)
+	assert.ErrorIs(t, err, errUnsupportedSignAlgorithm)
+}
+
+func TestSignAndVerify_DelegateForOtherKeys(t *testing.T) {
+	key, _ := newECExternalKey(t, "kms-key")
+	base := cryptomock.NewRuntimeCryptoProviderMock(t)
+	base.EXPECT().Sign(mock.Anything, kmprovider.KeyRef{KeyID: "file-key"}, "RS256", []byte("data")).
+		Return([]byte("sig"), nil)
+	base.EXPECT().Verify(mock.Anything, "file-kid", "RS256", []byte("data"), []byte("sig")).Return(nil)
+	svc := newRuntimeCryptoService(base, []*externalKey{key})
+
+	signature, err := svc.Sign(context.Background(), kmprovider.KeyRef{KeyID: "file-key"}, "RS256", []byte("data"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("sig"), signature)
+	assert.NoError(t, svc.Verify(context.Background(), "file-kid", "RS256", []byte("data"), []byte("sig")))
+}
+
+func TestGetPublicKeys_MergesExternalKeys(t *testing.T) {
+	key, ecKey := newECExternalKey(t, "kms-key")
+	base := cryptomock.NewRuntimeCryptoProviderMock(t)
+	base.EXPECT().GetPublicKeys(mock.Anything, mock.Anything).
+		Return([]kmprovider.PublicKeyInfo{{KeyID: "file-key", Algorithm: cryptolib.AlgorithmRS256}}, nil)
+	svc := newRuntimeCryptoService(base, []*externalKey{key})
+
+	keys, err := svc.GetPublicKeys(context.Background(), kmprovider.PublicKeyFilter{})
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "kms-key", keys[1].KeyID)
+	assert.Equal(t, &ecKey.PublicKey, keys[1].PublicKey)
+	assert.Equal(t, key.thumbprint, keys[1].Thumbprint)
+	assert.Nil(t, keys[1].CertificateDER)
+
+	keys, err = svc.GetPublicKeys(context.Background(), kmprovider.PublicKeyFilter{Algorithm: cryptolib.AlgorithmRS256})
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package externalkm
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+)
+
+// keySigner signs digests with a private key held by an external key manager.
+type keySigner interface {
+	// PublicKey fetches the public key of the external key.
+	PublicKey(ctx context.Context) (crypto.PublicKey, error)
+	// Sign signs the digest of the content with the given algorithm. ECDSA signatures are returned
+	// DER encoded, as external key managers produce them.
+	Sign(ctx context.Context, alg cryptolib.SignAlgorithm, digest []byte) ([]byte, error)
+}
+
+// errUnsupportedSignAlgorithm is returned when a key manager cannot sign with an algorithm.
+var errUnsupportedSignAlgorithm = errors.New("signing algorithm not supported by the external key manager")
+
+// digest hashes content with the hash function used by the signing algorithm.
+func digest(alg cryptolib.SignAlgorithm, content []byte) ([]byte, error) {
+	switch alg {
+	case cryptolib.RSASHA256, cryptolib.RSAPSSSHA256, cryptolib.ECDSASHA256:
+		sum := sha256.Sum256(content)
+		return sum[:], nil
+	case cryptolib.ECDSASHA384:
+		sum := sha512.Sum384(content)
+		return sum[:], nil
+	case cryptolib.RSASHA512, cryptolib.ECDSASHA512:
+		sum := sha512.Sum512(content)
+		return sum[:], nil
+	default:
+		return nil, errUnsupportedSignAlgorithm
+	}
+}
+
+// algorithmForPublicKey returns the JWS algorithm advertised for a public key, matching the
+// algorithms the default key manager advertises for file-based keys.
+func algorithmForPublicKey(pub crypto.PublicKey) (cryptolib.Algorithm, error) {
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		return cryptolib.AlgorithmRS256, nil
+	case *ecdsa.PublicKey:
+		switch key.Curve.Params().Name {
+		case "P-256":
+			return cryptolib.AlgorithmES256, nil
+		case "P-384":
+			return cryptolib.AlgorithmES384, nil
+		case "P-521":
+			return cryptolib.AlgorithmES512, nil
+		}
+		return "", fmt.Errorf("unsupported EC curve %s", key.Curve.Params().Name)
+	default:
+		return "", fmt.Errorf("unsupported public key type %T", pub)
+	}
+}
+
+// ecdsaDERToJWS converts a DER encoded ECDSA signature to the fixed-size R || S form required by
+// RFC 7518 §3.4.
+func ecdsaDERToJWS(der []byte, pub *ecdsa.PublicKey) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(der, &sig)
+	if err != nil || len(rest) > 0 || sig.R == nil || sig.S == nil {
+		return nil, errors.New("invalid ECDSA signature returned by the external key manager")
+	}
+	coordSize := (pub.Curve.Params().BitSize + 7) / 8
+	if sig.R.Sign() < 0 || sig.S.Sign() < 0 || len(sig.R.Bytes()) > coordSize || len(sig.S.Bytes()) > coordSize {
+		return nil, errors.New("invalid ECDSA signature returned by the external key manager")
+	}
+	out := make([]byte, 2*coordSize)
+	sig.R.FillBytes(out[:coordSize])
+	sig.S.FillBytes(out[coordSize:])
+	return out, nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/common"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pki"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/externalkm"
 )
 
 // RuntimeCryptoProvider is a type alias for convenience.
@@ -32,9 +33,16 @@ type RuntimeCryptoProvider = common.RuntimeCryptoProvider
 type ConfigCryptoProvider = common.ConfigCryptoProvider
 
 // Initialize initializes and returns both RuntimeCryptoProvider and ConfigCryptoProvider.
-// The pkiService is injected as a dependency.
-// Currently hardcoded to use the default KM provider, but structured to support
-// provider selection based on server configuration in the future.
+// The pkiService is injected as a dependency. File-based keys are served by the default KM provider;
+// keys configured under crypto.external_keys are signed by their external key manager.
 func Initialize(pkiService pki.PKIServiceInterface) (common.RuntimeCryptoProvider, common.ConfigCryptoProvider, error) {
-	return defaultkm.Initialize(pkiService)
+	runtimeSvc, configSvc, err := defaultkm.Initialize(pkiService)
+	if err != nil {
+		return nil, nil, err
+	}
+	runtimeSvc, err = externalkm.Initialize(runtimeSvc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return runtimeSvc, configSvc, nil
 }
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ldap
+
+import (
+	"strings"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// And returns a filter that matches entries matching all the filters.
+func And(filters ...string) string {
+	return "(&" + strings.Join(filters, "") + ")"
+}
+
+// Or returns a filter that matches entries matching any of the filters.
+func Or(filters ...string) string {
+	return "(|" + strings.Join(filters, "") + ")"
+}
+
+// Equal returns a filter that matches entries whose attribute has the given value. The value is
+// escaped, so user input can be placed in it.
+func Equal(attribute, value string) string {
+	return "(" + attribute + "=" + goldap.EscapeFilter(value) + ")"
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package ldap connects to LDAP and Active Directory servers through go-ldap. It adds the
+// connection settings shared by the directory providers and an interface for the operations they
+// use, so the providers can be tested without a server.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// ConnInterface defines the operations on an open directory connection.
+type ConnInterface interface {
+	Bind(username, password string) error
+	Search(request *goldap.SearchRequest) (*goldap.SearchResult, error)
+	Close() error
+}
+
+// Dial connects to the server at rawURL, which uses the ldap or ldaps scheme. The timeout bounds
+// the connection and each later operation.
+func Dial(ctx context.Context, rawURL string, timeout time.Duration, tlsConfig *tls.Config) (*goldap.Conn, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: invalid URL: %w", err)
+	}
+
+	host := parsed.Host
+	dialer := &net.Dialer{Timeout: timeout}
+	var conn net.Conn
+	isTLS := false
+	switch strings.ToLower(parsed.Scheme) {
+	case "ldap":
+		if parsed.Port() == "" {
+			host = net.JoinHostPort(parsed.Hostname(), goldap.DefaultLdapPort)
+		}
+		conn, err = dialer.DialContext(ctx, "tcp", host)
+	case "ldaps":
+		if parsed.Port() == "" {
+			host = net.JoinHostPort(parsed.Hostname(), goldap.DefaultLdapsPort)
+		}
+		cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+		if tlsConfig != nil {
+			cfg = tlsConfig.Clone()
+		}
+		if cfg.ServerName == "" {
+			cfg.ServerName = parsed.Hostname()
+		}
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: cfg}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", host)
+		isTLS = true
+	default:
+		return nil, fmt.Errorf("ldap: unsupported URL scheme %q", parsed.Scheme)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ldap: failed to connect: %w", err)
+	}
+
+	ldapConn := goldap.NewConn(conn, isTLS)
+	ldapConn.Start()
+	ldapConn.SetTimeout(timeout)
+	return ldapConn, nil
+}
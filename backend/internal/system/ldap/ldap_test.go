@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ldap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilters(t *testing.T) {
+	filter := And(Equal("objectClass", "person"), Or(Equal("uid", "alice"), Equal("uid", "*)(uid=*")))
+
+	assert.Equal(t, `(&(objectClass=person)(|(uid=alice)(uid=\2a\29\28uid=\2a)))`, filter)
+}
+
+func TestDial_UnsupportedScheme(t *testing.T) {
+	conn, err := Dial(context.Background(), "http://localhost:389", time.Second, nil)
+
+	assert.Nil(t, conn)
+	assert.ErrorContains(t, err, "unsupported URL scheme")
+}
@@ -43,8 +43,11 @@ func AccessLogHandler(logger *Logger, next http.Handler) http.Handler {
 		// Calculate elapsed time in milliseconds
 		elapsedMs := time.Since(start).Milliseconds()
 
-		host, _, _ := net.SplitHostPort(r.RemoteAddr)
-		if host == "" {
+		// Prefer the client IP address resolved through the trusted proxies over the direct peer.
+		var host string
+		if clientIP := sysContext.GetClientIP(r.Context()); clientIP.IsValid() {
+			host = clientIP.String()
+		} else if host, _, _ = net.SplitHostPort(r.RemoteAddr); host == "" {
 			host = r.RemoteAddr
 		}
 
@@ -84,3 +87,8 @@ func (lrw *loggingResponseWriter) Write(b []byte) (int, error) {
 	lrw.size += size
 	return size, err
 }
+
+// Unwrap returns the underlying response writer for http.ResponseController.
+func (lrw *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lrw.ResponseWriter
+}
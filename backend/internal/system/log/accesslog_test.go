@@ -23,11 +23,14 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 )
 
 type AccessLogTestSuite struct {
@@ -85,6 +88,22 @@ func (suite *AccessLogTestSuite) TestAccessLogHandler() {
 	assert.NotContains(suite.T(), output, `\"`)
 }
 
+func (suite *AccessLogTestSuite) TestAccessLogHandler_UsesResolvedClientIP() {
+	var buf bytes.Buffer
+	log := &Logger{internal: slog.New(slog.NewTextHandler(&buf, nil))}
+	handler := AccessLogHandler(log, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "172.16.0.1:12345"
+	req = req.WithContext(sysContext.WithClientIP(req.Context(), netip.MustParseAddr("198.51.100.7")))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Contains(suite.T(), buf.String(), "198.51.100.7 - -")
+	assert.NotContains(suite.T(), buf.String(), "172.16.0.1")
+}
+
 func (suite *AccessLogTestSuite) TestLoggingResponseWriter() {
 	rec := httptest.NewRecorder()
 	lrw := &loggingResponseWriter{
@@ -112,3 +131,12 @@ func (suite *AccessLogTestSuite) TestLoggingResponseWriter() {
 	// Verify the actual content was written to the underlying ResponseWriter
 	assert.Equal(suite.T(), "test content more", rec.Body.String())
 }
+
+func (suite *AccessLogTestSuite) TestLoggingResponseWriter_Flush() {
+	rec := httptest.NewRecorder()
+	lrw := &loggingResponseWriter{ResponseWriter: rec, statusCode: http.StatusOK}
+
+	assert.Same(suite.T(), rec, lrw.Unwrap())
+	assert.NoError(suite.T(), http.NewResponseController(lrw).Flush())
+	assert.True(suite.T(), rec.Flushed)
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package maintenance
+
+import "time"
+
+// Config holds the maintenance-mode settings, mapped by the caller from the deployment server
+// maintenance config. It is intentionally decoupled from system/config so this package does not
+// depend on the global configuration type.
+type Config struct {
+	// ReadOnly rejects every state-changing request except those on the built-in read-only safe
+	// paths and AllowedPaths.
+	ReadOnly bool
+	// AllowTokenIssuance keeps the login and token endpoints open while ReadOnly is set.
+	AllowTokenIssuance bool
+	// AllowedPaths lists additional path patterns exempt from read-only mode.
+	AllowedPaths []string
+	// DisabledEndpoints lists "[METHOD] /path" entries that are rejected regardless of ReadOnly.
+	DisabledEndpoints []string
+	// RetryAfter is advertised in the Retry-After header of rejected requests. Zero omits the header.
+	RetryAfter time.Duration
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package maintenance
+
+// readOnlySafePaths are POST endpoints that only read state and therefore stay available in
+// read-only mode. Uses the same glob syntax as the security public paths.
+var readOnlySafePaths = []string{
+	"/health/**",
+	"/oauth2/introspect",
+	"/oauth2/userinfo",
+	"/access/v1/**",
+}
+
+// tokenIssuancePaths are the login and token endpoints kept open in read-only mode when token
+// issuance is allowed.
+var tokenIssuancePaths = []string{
+	"/oauth2/authorize",
+	"/oauth2/par",
+	"/oauth2/token",
+	"/oauth2/auth/callback",
+	"/flow/execute/**",
+	"/auth/**",
+}
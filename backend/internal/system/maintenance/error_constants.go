@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package maintenance
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// errInvalidDisabledEndpoint is returned by Initialize when a disabled endpoint entry is not of the
+// form "[METHOD] /path".
+var errInvalidDisabledEndpoint = errors.New("invalid disabled endpoint")
+
+// Maintenance error responses, returned with HTTP 503 by the maintenance middleware.
+var (
+	// errReadOnlyMode is returned when a state-changing request is received in read-only mode.
+	errReadOnlyMode = apierror.ErrorResponse{
+		Code: "MNT-5031",
+		Message: tidcommon.I18nMessage{
+			Key:          "error.maintenance.read_only",
+			DefaultValue: "Service in read-only mode",
+		},
+		Description: tidcommon.I18nMessage{
+			Key:          "error.maintenance.read_only_description",
+			DefaultValue: "The server is under maintenance and does not accept changes at the moment",
+		},
+	}
+
+	// errEndpointDisabled is returned when the requested endpoint has been disabled for maintenance.
+	errEndpointDisabled = apierror.ErrorResponse{
+		Code: "MNT-5032",
+		Message: tidcommon.I18nMessage{
+			Key:          "error.maintenance.endpoint_disabled",
+			DefaultValue: "Endpoint temporarily unavailable",
+		},
+		Description: tidcommon.I18nMessage{
+			Key:          "error.maintenance.endpoint_disabled_description",
+			DefaultValue: "The requested endpoint is disabled for maintenance",
+		},
+	}
+)
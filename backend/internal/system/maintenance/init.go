@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package maintenance provides the HTTP middleware that enforces the server maintenance switches:
+// a global read-only mode and a per-endpoint disable list. Rejected requests receive 503 Service
+// Unavailable so clients and load balancers treat the condition as temporary.
+package maintenance
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Initialize builds the maintenance middleware from cfg. When neither read-only mode nor any
+// disabled endpoint is configured it returns a pass-through middleware so the request hot path is
+// unaffected. A malformed disabled endpoint entry returns a non-nil error.
+func Initialize(cfg Config) (func(http.Handler) http.Handler, error) {
+	if !cfg.ReadOnly && len(cfg.DisabledEndpoints) == 0 {
+		return func(next http.Handler) http.Handler { return next }, nil
+	}
+
+	disabled, err := parseEndpoints(cfg.DisabledEndpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := append([]string{}, readOnlySafePaths...)
+	if cfg.AllowTokenIssuance {
+		allowed = append(allowed, tokenIssuancePaths...)
+	}
+	allowed = append(allowed, cfg.AllowedPaths...)
+
+	g := &gate{
+		readOnly:     cfg.ReadOnly,
+		allowedPaths: allowed,
+		disabled:     disabled,
+		retryAfter:   cfg.RetryAfter,
+	}
+	return g.middleware, nil
+}
+
+// parseEndpoints parses "[METHOD] /path" entries. An entry without a method matches every method.
+func parseEndpoints(entries []string) ([]endpoint, error) {
+	endpoints := make([]endpoint, 0, len(entries))
+	for _, entry := range entries {
+		fields := strings.Fields(entry)
+		var e endpoint
+		switch len(fields) {
+		case 1:
+			e = endpoint{pathPattern: fields[0]}
+		case 2:
+			e = endpoint{method: strings.ToUpper(fields[0]), pathPattern: fields[1]}
+		default:
+			return nil, fmt.Errorf("%w: %q", errInvalidDisabledEndpoint, entry)
+		}
+		if !strings.HasPrefix(e.pathPattern, "/") {
+			return nil, fmt.Errorf("%w: %q", errInvalidDisabledEndpoint, entry)
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, nil
+}
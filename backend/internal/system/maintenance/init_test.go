@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package maintenance
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type MaintenanceTestSuite struct {
+	suite.Suite
+}
+
+func TestMaintenanceSuite(t *testing.T) {
+	suite.Run(t, new(MaintenanceTestSuite))
+}
+
+// serve runs a request through the middleware built from cfg and returns the recorder and whether
+// the downstream handler was reached.
+func (suite *MaintenanceTestSuite) serve(cfg Config, method, path string) (*httptest.ResponseRecorder, bool) {
+	mw, err := Initialize(cfg)
+	suite.Require().NoError(err)
+
+	reached := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	mw(next).ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec, reached
+}
+
+func (suite *MaintenanceTestSuite) TestInitialize_DisabledPassesThrough() {
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodDelete} {
+		rec, reached := suite.serve(Config{}, method, "/users")
+		suite.True(reached)
+		suite.Equal(http.StatusOK, rec.Code)
+	}
+}
+
+func (suite *MaintenanceTestSuite) TestInitialize_InvalidDisabledEndpoint() {
+	for _, entry := range []string{"", "POST /users extra", "POST users"} {
+		_, err := Initialize(Config{DisabledEndpoints: []string{entry}})
+		suite.ErrorIs(err, errInvalidDisabledEndpoint, entry)
+	}
+}
+
+func (suite *MaintenanceTestSuite) TestReadOnly_AllowsReadMethods() {
+	cfg := Config{ReadOnly: true}
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodOptions} {
+		_, reached := suite.serve(cfg, method, "/users")
+		suite.True(reached, method)
+	}
+}
+
+func (suite *MaintenanceTestSuite) TestReadOnly_RejectsWrites() {
+	cfg := Config{ReadOnly: true, RetryAfter: 2 * time.Minute}
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		rec, reached := suite.serve(cfg, method, "/users/123")
+		suite.False(reached, method)
+		suite.Equal(http.StatusServiceUnavailable, rec.Code)
+		suite.Equal("120", rec.Header().Get("Retry-After"))
+
+		var body apierror.ErrorResponse
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+		suite.Equal(errReadOnlyMode.Code, body.Code)
+	}
+}
+
+func (suite *MaintenanceTestSuite) TestReadOnly_AllowsSafePaths() {
+	cfg := Config{ReadOnly: true}
+	for _, path := range []string{"/oauth2/introspect", "/oauth2/userinfo", "/access/v1/evaluation"} {
+		_, reached := suite.serve(cfg, http.MethodPost, path)
+		suite.True(reached, path)
+	}
+}
+
+func (suite *MaintenanceTestSuite) TestReadOnly_TokenIssuance() {
+	paths := []string{"/oauth2/token", "/flow/execute", "/auth/credentials/authenticate"}
+
+	for _, path := range paths {
+		_, reached := suite.serve(Config{ReadOnly: true}, http.MethodPost, path)
+		suite.False(reached, path)
+	}
+	for _, path := range paths {
+		_, reached := suite.serve(Config{ReadOnly: true, AllowTokenIssuance: true}, http.MethodPost, path)
+		suite.True(reached, path)
+	}
+}
+
+func (suite *MaintenanceTestSuite) TestReadOnly_AllowedPaths() {
+	cfg := Config{ReadOnly: true, AllowedPaths: []string{"/users/*/credentials"}}
+
+	_, reached := suite.serve(cfg, http.MethodPut, "/users/123/credentials")
+	suite.True(reached)
+
+	_, reached = suite.serve(cfg, http.MethodPut, "/users/123")
+	suite.False(reached)
+}
+
+func (suite *MaintenanceTestSuite) TestReadOnly_NoRetryAfterWhenZero() {
+	rec, _ := suite.serve(Config{ReadOnly: true}, http.MethodPost, "/users")
+	suite.Equal(http.StatusServiceUnavailable, rec.Code)
+	suite.Empty(rec.Header().Get("Retry-After"))
+}
+
+func (suite *MaintenanceTestSuite) TestDisabledEndpoints() {
+	cfg := Config{DisabledEndpoints: []string{"post /users", "/oauth2/par"}}
+
+	rec, reached := suite.serve(cfg, http.MethodPost, "/users")
+	suite.False(reached)
+	var body apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+	suite.Equal(errEndpointDisabled.Code, body.Code)
+
+	_, reached = suite.serve(cfg, http.MethodGet, "/users")
+	suite.True(reached)
+
+	_, reached = suite.serve(cfg, http.MethodGet, "/oauth2/par")
+	suite.False(reached)
+}
+
+func (suite *MaintenanceTestSuite) TestDisabledEndpoints_OverrideSafePaths() {
+	cfg := Config{ReadOnly: true, DisabledEndpoints: []string{"POST /oauth2/introspect"}}
+
+	rec, reached := suite.serve(cfg, http.MethodPost, "/oauth2/introspect")
+	suite.False(reached)
+	var body apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+	suite.Equal(errEndpointDisabled.Code, body.Code)
+}
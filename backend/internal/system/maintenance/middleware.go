@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package maintenance
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// endpoint is a parsed disabled endpoint entry. An empty method matches every method.
+type endpoint struct {
+	method      string
+	pathPattern string
+}
+
+// matches reports whether the request method and path match the endpoint.
+func (e endpoint) matches(method, requestPath string) bool {
+	if e.method != "" && e.method != method {
+		return false
+	}
+	return utils.MatchPathPattern(e.pathPattern, requestPath)
+}
+
+// gate evaluates incoming requests against the maintenance switches.
+type gate struct {
+	readOnly     bool
+	allowedPaths []string
+	disabled     []endpoint
+	retryAfter   time.Duration
+}
+
+// middleware wraps next so that requests rejected by the maintenance switches never reach it.
+func (g *gate) middleware(next http.Handler) http.Handler {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "MaintenanceMiddleware"))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		errResp, rejected := g.check(r.Method, r.URL.Path)
+		if !rejected {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		logger.Debug(ctx, "Request rejected by maintenance mode",
+			log.String("method", r.Method), log.String("path", r.URL.Path), log.String("code", errResp.Code))
+		if g.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(g.retryAfter.Seconds())))
+		}
+		utils.WriteErrorResponse(ctx, w, http.StatusServiceUnavailable, errResp)
+	})
+}
+
+// check returns the error response for a request rejected by the maintenance switches. Disabled
+// endpoints are checked first so an endpoint can be switched off even when it is read-only safe.
+func (g *gate) check(method, requestPath string) (apierror.ErrorResponse, bool) {
+	for _, e := range g.disabled {
+		if e.matches(method, requestPath) {
+			return errEndpointDisabled, true
+		}
+	}
+
+	if !g.readOnly || isReadMethod(method) {
+		return apierror.ErrorResponse{}, false
+	}
+	for _, pattern := range g.allowedPaths {
+		if utils.MatchPathPattern(pattern, requestPath) {
+			return apierror.ErrorResponse{}, false
+		}
+	}
+	return errReadOnlyMode, true
+}
+
+// isReadMethod reports whether method is a safe method that does not change server state.
+func isReadMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -137,6 +137,11 @@ func (m *MockJWTService) VerifyJWTSignatureWithJWKS(
 	return args.Get(0).(*tidcommon.ServiceError)
 }
 
+func (m *MockJWTService) SetSigningKey(ctx context.Context, keyID string) error {
+	args := m.Called(ctx, keyID)
+	return args.Error(0)
+}
+
 type TokenVerifierTestSuite struct {
 	suite.Suite
 }
@@ -45,11 +45,17 @@ var DefaultAllowedHeaders = []string{"Content-Type", "Authorization"}
 // in seconds; zero suppresses the Access-Control-Max-Age header. The
 // per-request Origin echo is decided by the global matcher and never
 // influenced by these options.
+//
+// AllowApplicationOrigins additionally admits the origins registered by
+// applications (explicit allowed origins, or the origins of their redirect
+// URIs). It is enabled only on the endpoints browser-based applications call
+// directly, such as the token, userinfo, and flow execution endpoints.
 type CORSOptions struct {
-	AllowedMethods   []string
-	AllowedHeaders   []string
-	AllowCredentials bool
-	MaxAge           int
+	AllowedMethods          []string
+	AllowedHeaders          []string
+	AllowCredentials        bool
+	MaxAge                  int
+	AllowApplicationOrigins bool
 }
 
 // WithCORS wraps an HTTP handler with CORS handling: origin validation,
@@ -90,7 +96,7 @@ func applyCORSHeaders(w http.ResponseWriter, r *http.Request, opts CORSOptions)
 		return
 	}
 
-	allow, echo := matchOrigin(r.Context(), parsed)
+	allow, echo := matchOrigin(r.Context(), parsed, opts.AllowApplicationOrigins)
 	if !allow {
 		logger().Debug(r.Context(), "CORS origin rejected by matcher",
 			log.String("origin", requestOrigin))
@@ -121,12 +127,17 @@ func applyCORSHeaders(w http.ResponseWriter, r *http.Request, opts CORSOptions)
 }
 
 // matchOrigin reports whether the parsed origin is allowed and returns the value to echo on a hit.
-func matchOrigin(ctx context.Context, parsed cors.ParseResult) (bool, string) {
-	m := cors.GetDynamicMatcher(ctx)
-	if m == nil {
+// Deployment origins are checked first; application origins only when the route opts in.
+func matchOrigin(ctx context.Context, parsed cors.ParseResult, allowAppOrigins bool) (bool, string) {
+	if m := cors.GetDynamicMatcher(ctx); m != nil {
+		if allow, echo := m.Match(parsed); allow {
+			return true, echo
+		}
+	}
+	if !allowAppOrigins {
 		return false, ""
 	}
-	return m.Match(parsed)
+	return cors.GetApplicationMatcher(ctx).Match(parsed)
 }
 
 // isPreflight reports whether r is a CORS preflight request. A preflight is
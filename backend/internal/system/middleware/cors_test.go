@@ -19,9 +19,11 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -501,3 +503,41 @@ func (suite *CORSMiddlewareTestSuite) TestWithCORS_HotReloadMatcherTakesEffect()
 	wrapped(w3, req3)
 	assert.Equal(suite.T(), "https://other.com", w3.Header().Get("Access-Control-Allow-Origin"))
 }
+
+// --- Application origins. -------------------------------------------------
+
+// staticOriginSource serves a fixed list of application origins.
+type staticOriginSource []string
+
+func (s staticOriginSource) GetAllowedOrigins(_ context.Context) ([]string, error) {
+	return s, nil
+}
+
+func (suite *CORSMiddlewareTestSuite) TestWithCORS_ApplicationOriginsRequireOptIn() {
+	cors.InitializeApplicationOrigins(staticOriginSource{"https://spa.example.org"}, time.Minute)
+	defer cors.InitializeApplicationOrigins(nil, 0)
+
+	_, deploymentOnly := WithCORS("POST /test", noopHandler, fullOpts)
+	req, w := preflightRequest("/test", "https://spa.example.org", "POST")
+	deploymentOnly(w, req)
+	assert.Empty(suite.T(), w.Header().Get("Access-Control-Allow-Origin"))
+
+	appOpts := fullOpts
+	appOpts.AllowApplicationOrigins = true
+	_, withApps := WithCORS("POST /test", noopHandler, appOpts)
+
+	req, w = preflightRequest("/test", "https://spa.example.org", "POST")
+	withApps(w, req)
+	assert.Equal(suite.T(), "https://spa.example.org", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(suite.T(), "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal(suite.T(), "GET, POST", w.Header().Get("Access-Control-Allow-Methods"))
+
+	// Deployment origins stay allowed on routes that admit application origins.
+	req, w = newGetRequest("https://example.com")
+	withApps(w, req)
+	assert.Equal(suite.T(), "https://example.com", w.Header().Get("Access-Control-Allow-Origin"))
+
+	req, w = newGetRequest("https://unknown.example.org")
+	withApps(w, req)
+	assert.Empty(suite.T(), w.Header().Get("Access-Control-Allow-Origin"))
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package mockidp
+
+import "time"
+
+// Paths served by the mock provider.
+const (
+	discoveryPath     = "/.well-known/openid-configuration"
+	authorizePath     = "/authorize"
+	tokenPath         = "/token"
+	userInfoPath      = "/userinfo"
+	jwksPath          = "/jwks"
+	controlClaimsPath = "/mock/claims"
+	controlErrorsPath = "/mock/errors"
+	controlResetPath  = "/mock/reset"
+)
+
+// Endpoint names accepted by the error injection control endpoint.
+const (
+	endpointAuthorize = "authorize"
+	endpointToken     = "token"
+	endpointUserInfo  = "userinfo"
+	endpointJWKS      = "jwks"
+)
+
+const (
+	// signingKeyID is the key ID of the generated ID token signing key.
+	signingKeyID = "mock-idp-signing-key"
+	// authCodeValidity is how long an issued authorization code can be redeemed.
+	authCodeValidity = 5 * time.Minute
+	// tokenValidity is the lifetime of issued access and ID tokens.
+	tokenValidity = time.Hour
+	// defaultSubject is used when the configured claims do not carry a sub claim.
+	defaultSubject = "mock-user"
+)
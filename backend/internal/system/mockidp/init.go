@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package mockidp provides an embedded OpenID Connect provider for integration tests and local
+// development. It runs on its own listener, signs every user in without prompting, and exposes control
+// endpoints to change the issued claims and inject errors, so federated sign-in can be exercised end to
+// end without an external identity provider.
+package mockidp
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+)
+
+// signingKeyBits is the size of the generated RSA signing key.
+const signingKeyBits = 2048
+
+// Initialize builds the mock provider from cfg. When disabled it returns a no-op server. The caller
+// owns the lifecycle of the returned server and must call Start to begin serving.
+func Initialize(cfg Config) (Server, error) {
+	if !cfg.Enabled {
+		return noopServer{}, nil
+	}
+	if cfg.Address == "" {
+		return nil, errors.New("mock identity provider address is required")
+	}
+	if cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return nil, errors.New("mock identity provider client credentials are required")
+	}
+
+	signingKey, err := rsa.GenerateKey(rand.Reader, signingKeyBits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate mock identity provider signing key: %w", err)
+	}
+	return newMockIdP(cfg, signingKey), nil
+}
@@ -0,0 +1,448 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package mockidp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// Server is the lifecycle of the embedded mock OpenID Connect provider.
+type Server interface {
+	// Start begins serving on the configured address. It returns once the listener is bound;
+	// requests are served in the background.
+	Start(ctx context.Context) error
+	// Stop shuts the listener down and releases its resources.
+	Stop(ctx context.Context) error
+}
+
+// noopServer is returned when the mock provider is disabled.
+type noopServer struct{}
+
+func (noopServer) Start(context.Context) error { return nil }
+
+func (noopServer) Stop(context.Context) error { return nil }
+
+// mockIdP is an in-memory OpenID Connect provider that signs every user in without prompting. It
+// supports the authorization code grant with optional PKCE, and lets tests replace the issued claims
+// and inject errors per endpoint at runtime.
+type mockIdP struct {
+	cfg        Config
+	issuer     string
+	signingKey *rsa.PrivateKey
+	server     *http.Server
+	logger     *log.Logger
+
+	mu           sync.Mutex
+	claims       map[string]interface{}
+	errors       map[string]InjectedError
+	authCodes    map[string]*authCode
+	accessTokens map[string]map[string]interface{}
+}
+
+// newMockIdP creates a mock provider for cfg with a freshly generated signing key.
+func newMockIdP(cfg Config, signingKey *rsa.PrivateKey) *mockIdP {
+	m := &mockIdP{
+		cfg:          cfg,
+		issuer:       "http://" + cfg.Address,
+		signingKey:   signingKey,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "MockIdP")),
+		claims:       copyClaims(cfg.Claims),
+		errors:       make(map[string]InjectedError),
+		authCodes:    make(map[string]*authCode),
+		accessTokens: make(map[string]map[string]interface{}),
+	}
+	m.server = &http.Server{
+		Addr:              cfg.Address,
+		Handler:           m.routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ErrorLog:          log.NewServerErrorLog(m.logger),
+	}
+	return m
+}
+
+// routes registers the provider and control endpoints.
+func (m *mockIdP) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+discoveryPath, m.handleDiscovery)
+	mux.HandleFunc("GET "+authorizePath, m.handleAuthorize)
+	mux.HandleFunc("POST "+tokenPath, m.handleToken)
+	mux.HandleFunc("GET "+userInfoPath, m.handleUserInfo)
+	mux.HandleFunc("POST "+userInfoPath, m.handleUserInfo)
+	mux.HandleFunc("GET "+jwksPath, m.handleJWKS)
+	mux.HandleFunc("PUT "+controlClaimsPath, m.handleSetClaims)
+	mux.HandleFunc("PUT "+controlErrorsPath+"/{endpoint}", m.handleInjectError)
+	mux.HandleFunc("DELETE "+controlErrorsPath, m.handleClearErrors)
+	mux.HandleFunc("POST "+controlResetPath, m.handleReset)
+	return mux
+}
+
+// Start binds the listener and serves requests in the background.
+func (m *mockIdP) Start(ctx context.Context) error {
+	ln, err := net.Listen("tcp", m.cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Warn(ctx, "The mock identity provider is enabled. It signs in every request without "+
+		"authentication and must not be enabled in production deployments", log.String("issuer", m.issuer))
+
+	go func() {
+		if err := m.server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			m.logger.Error(context.Background(), "Mock identity provider stopped serving", log.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Stop shuts the listener down gracefully.
+func (m *mockIdP) Stop(ctx context.Context) error {
+	return m.server.Shutdown(ctx)
+}
+
+// handleDiscovery serves the OpenID Provider metadata.
+func (m *mockIdP) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, discoveryDocument{
+		Issuer:                            m.issuer,
+		AuthorizationEndpoint:             m.issuer + authorizePath,
+		TokenEndpoint:                     m.issuer + tokenPath,
+		UserInfoEndpoint:                  m.issuer + userInfoPath,
+		JWKSURI:                           m.issuer + jwksPath,
+		ResponseTypesSupported:            []string{"code"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{"RS256"},
+		ScopesSupported:                   []string{"openid", "profile", "email"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+	})
+}
+
+// handleAuthorize signs the user in without prompting and redirects back with an authorization code,
+// or with the injected error.
+func (m *mockIdP) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		writeError(w, http.StatusBadRequest, "invalid_request", "A valid redirect_uri is required")
+		return
+	}
+	if query.Get("client_id") != m.cfg.ClientID {
+		writeError(w, http.StatusBadRequest, "unauthorized_client", "Unknown client_id")
+		return
+	}
+
+	params := redirectURI.Query()
+	if state := query.Get("state"); state != "" {
+		params.Set("state", state)
+	}
+
+	m.mu.Lock()
+	injected, hasError := m.errors[endpointAuthorize]
+	if hasError {
+		m.mu.Unlock()
+		params.Set("error", injected.Error)
+		if injected.ErrorDescription != "" {
+			params.Set("error_description", injected.ErrorDescription)
+		}
+		redirectURI.RawQuery = params.Encode()
+		http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+		return
+	}
+	if query.Get("response_type") != "code" {
+		m.mu.Unlock()
+		params.Set("error", "unsupported_response_type")
+		redirectURI.RawQuery = params.Encode()
+		http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+		return
+	}
+
+	code := randomString()
+	m.authCodes[code] = &authCode{
+		redirectURI:   query.Get("redirect_uri"),
+		scope:         query.Get("scope"),
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+		claims:        copyClaims(m.claims),
+		expiresAt:     time.Now().Add(authCodeValidity),
+	}
+	m.mu.Unlock()
+
+	params.Set("code", code)
+	redirectURI.RawQuery = params.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// handleToken redeems an authorization code for an access token and a signed ID token.
+func (m *mockIdP) handleToken(w http.ResponseWriter, r *http.Request) {
+	if m.writeInjectedError(w, endpointToken, http.StatusBadRequest) {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", "Failed to parse request body")
+		return
+	}
+	if !m.authenticateClient(r) {
+		writeError(w, http.StatusUnauthorized, "invalid_client", "Client authentication failed")
+		return
+	}
+	if r.FormValue("grant_type") != "authorization_code" {
+		writeError(w, http.StatusBadRequest, "unsupported_grant_type", "Only authorization_code is supported")
+		return
+	}
+
+	m.mu.Lock()
+	code, ok := m.authCodes[r.FormValue("code")]
+	delete(m.authCodes, r.FormValue("code"))
+	m.mu.Unlock()
+
+	if !ok || time.Now().After(code.expiresAt) {
+		writeError(w, http.StatusBadRequest, "invalid_grant", "Invalid or expired authorization code")
+		return
+	}
+	if code.redirectURI != r.FormValue("redirect_uri") {
+		writeError(w, http.StatusBadRequest, "invalid_grant", "Redirect URI mismatch")
+		return
+	}
+	if code.codeChallenge != "" && !verifyCodeChallenge(code.codeChallenge, r.FormValue("code_verifier")) {
+		writeError(w, http.StatusBadRequest, "invalid_grant", "Invalid code_verifier")
+		return
+	}
+
+	idToken, err := m.signIDToken(code.claims, code.nonce)
+	if err != nil {
+		m.logger.Error(r.Context(), "Failed to sign mock ID token", log.Error(err))
+		writeError(w, http.StatusInternalServerError, "server_error", "Failed to sign the ID token")
+		return
+	}
+
+	accessToken := randomString()
+	m.mu.Lock()
+	m.accessTokens[accessToken] = code.claims
+	m.mu.Unlock()
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, tokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(tokenValidity.Seconds()),
+		IDToken:     idToken,
+		Scope:       code.scope,
+	})
+}
+
+// handleUserInfo returns the claims of the user the bearer access token was issued to.
+func (m *mockIdP) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	if m.writeInjectedError(w, endpointUserInfo, http.StatusUnauthorized) {
+		return
+	}
+
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	m.mu.Lock()
+	claims, ok := m.accessTokens[token]
+	m.mu.Unlock()
+	if !found || !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeError(w, http.StatusUnauthorized, "invalid_token", "Invalid access token")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, claims)
+}
+
+// handleJWKS publishes the public key that verifies issued ID tokens.
+func (m *mockIdP) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	if m.writeInjectedError(w, endpointJWKS, http.StatusInternalServerError) {
+		return
+	}
+
+	pub := m.signingKey.PublicKey
+	writeJSON(w, http.StatusOK, jwks{Keys: []jwk{{
+		Kty: "RSA",
+		Use: "sig",
+		Kid: signingKeyID,
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}}})
+}
+
+// handleSetClaims replaces the claims issued for subsequent sign-ins.
+func (m *mockIdP) handleSetClaims(w http.ResponseWriter, r *http.Request) {
+	var claims map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&claims); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", "The claims must be a JSON object")
+		return
+	}
+
+	m.mu.Lock()
+	m.claims = claims
+	m.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleInjectError makes an endpoint return the given error until the injection is cleared.
+func (m *mockIdP) handleInjectError(w http.ResponseWriter, r *http.Request) {
+	endpoint := r.PathValue("endpoint")
+	switch endpoint {
+	case endpointAuthorize, endpointToken, endpointUserInfo, endpointJWKS:
+	default:
+		writeError(w, http.StatusNotFound, "invalid_request", "Unknown endpoint: "+endpoint)
+		return
+	}
+
+	var injected InjectedError
+	if err := json.NewDecoder(r.Body).Decode(&injected); err != nil || injected.Error == "" {
+		writeError(w, http.StatusBadRequest, "invalid_request", "The error field is required")
+		return
+	}
+
+	m.mu.Lock()
+	m.errors[endpoint] = injected
+	m.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleClearErrors removes all injected errors.
+func (m *mockIdP) handleClearErrors(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	m.errors = make(map[string]InjectedError)
+	m.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleReset restores the configured claims and discards injected errors, codes and tokens.
+func (m *mockIdP) handleReset(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	m.claims = copyClaims(m.cfg.Claims)
+	m.errors = make(map[string]InjectedError)
+	m.authCodes = make(map[string]*authCode)
+	m.accessTokens = make(map[string]map[string]interface{})
+	m.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeInjectedError writes the error injected for endpoint, if any, and reports whether it did.
+func (m *mockIdP) writeInjectedError(w http.ResponseWriter, endpoint string, defaultStatus int) bool {
+	m.mu.Lock()
+	injected, ok := m.errors[endpoint]
+	m.mu.Unlock()
+	if !ok {
+		return false
+	}
+
+	status := injected.StatusCode
+	if status == 0 {
+		status = defaultStatus
+	}
+	writeError(w, status, injected.Error, injected.ErrorDescription)
+	return true
+}
+
+// authenticateClient checks the client credentials sent with client_secret_basic or client_secret_post.
+func (m *mockIdP) authenticateClient(r *http.Request) bool {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.FormValue("client_id")
+		clientSecret = r.FormValue("client_secret")
+	}
+	return clientID == m.cfg.ClientID &&
+		subtle.ConstantTimeCompare([]byte(clientSecret), []byte(m.cfg.ClientSecret)) == 1
+}
+
+// signIDToken issues an RS256 ID token carrying claims for the configured client.
+func (m *mockIdP) signIDToken(claims map[string]interface{}, nonce string) (string, error) {
+	now := time.Now()
+	payload := copyClaims(claims)
+	if _, ok := payload["sub"]; !ok {
+		payload["sub"] = defaultSubject
+	}
+	payload["iss"] = m.issuer
+	payload["aud"] = m.cfg.ClientID
+	payload["iat"] = now.Unix()
+	payload["exp"] = now.Add(tokenValidity).Unix()
+	if nonce != "" {
+		payload["nonce"] = nonce
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": signingKeyID})
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(body)
+	signature, err := cryptolib.Generate([]byte(signingInput), cryptolib.RSASHA256, m.signingKey)
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// verifyCodeChallenge checks an S256 PKCE code verifier against the stored challenge.
+func verifyCodeChallenge(challenge, verifier string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:]) == challenge
+}
+
+// copyClaims returns a shallow copy of claims that is never nil.
+func copyClaims(claims map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(claims))
+	for k, v := range claims {
+		copied[k] = v
+	}
+	return copied
+}
+
+// randomString returns a URL-safe random value for codes and access tokens.
+func randomString() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// writeJSON writes data as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+// writeError writes an OAuth 2.0 error response.
+func writeError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, errorResponse{Error: code, ErrorDescription: description})
+}
@@ -0,0 +1,351 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package mockidp
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	"github.com/thunder-id/thunderid/internal/system/jose/jws"
+)
+
+const (
+	testClientID     = "mock-client"
+	testClientSecret = "mock-secret"
+	testRedirectURI  = "https://localhost:8090/callback"
+)
+
+var testSigningKey = func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, signingKeyBits)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+type MockIdPTestSuite struct {
+	suite.Suite
+	idp    *mockIdP
+	server *httptest.Server
+	client *http.Client
+}
+
+func TestMockIdPTestSuite(t *testing.T) {
+	suite.Run(t, new(MockIdPTestSuite))
+}
+
+func (s *MockIdPTestSuite) SetupTest() {
+	s.idp = newMockIdP(Config{
+		Enabled:      true,
+		Address:      "localhost:8100",
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		Claims:       map[string]interface{}{"sub": "user-1", "email": "user1@example.com"},
+	}, testSigningKey)
+	s.server = httptest.NewServer(s.idp.routes())
+	s.client = &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+}
+
+func (s *MockIdPTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// authorize calls the authorization endpoint and returns the query of the redirect back to the client.
+func (s *MockIdPTestSuite) authorize(extra url.Values) url.Values {
+	params := url.Values{
+		"client_id":     {testClientID},
+		"redirect_uri":  {testRedirectURI},
+		"response_type": {"code"},
+		"scope":         {"openid email"},
+		"state":         {"state-1"},
+		"nonce":         {"nonce-1"},
+	}
+	for k, v := range extra {
+		params[k] = v
+	}
+	resp, err := s.client.Get(s.server.URL + authorizePath + "?" + params.Encode())
+	s.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+	s.Require().Equal(http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	s.Require().NoError(err)
+	s.Require().True(strings.HasPrefix(location.String(), testRedirectURI))
+	return location.Query()
+}
+
+// redeem exchanges code at the token endpoint with client_secret_basic.
+func (s *MockIdPTestSuite) redeem(code string, extra url.Values) *http.Response {
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {testRedirectURI},
+	}
+	for k, v := range extra {
+		form[k] = v
+	}
+	req, err := http.NewRequest(http.MethodPost, s.server.URL+tokenPath, strings.NewReader(form.Encode()))
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(testClientID, testClientSecret)
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *MockIdPTestSuite) decode(resp *http.Response, v interface{}) {
+	defer func() { _ = resp.Body.Close() }()
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(v))
+}
+
+func (s *MockIdPTestSuite) put(path, body string) *http.Response {
+	req, err := http.NewRequest(http.MethodPut, s.server.URL+path, strings.NewReader(body))
+	s.Require().NoError(err)
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	return resp
+}
+
+func (s *MockIdPTestSuite) TestDiscovery() {
+	resp, err := s.client.Get(s.server.URL + discoveryPath)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	var doc discoveryDocument
+	s.decode(resp, &doc)
+	s.Equal("http://localhost:8100", doc.Issuer)
+	s.Equal("http://localhost:8100/token", doc.TokenEndpoint)
+	s.Equal("http://localhost:8100/jwks", doc.JWKSURI)
+}
+
+func (s *MockIdPTestSuite) TestAuthorizationCodeFlow() {
+	query := s.authorize(nil)
+	s.Equal("state-1", query.Get("state"))
+	s.Require().NotEmpty(query.Get("code"))
+
+	resp := s.redeem(query.Get("code"), nil)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	var tokens tokenResponse
+	s.decode(resp, &tokens)
+	s.Equal("Bearer", tokens.TokenType)
+	s.Equal("openid email", tokens.Scope)
+
+	claims := s.verifyIDToken(tokens.IDToken)
+	s.Equal("user-1", claims["sub"])
+	s.Equal("user1@example.com", claims["email"])
+	s.Equal("http://localhost:8100", claims["iss"])
+	s.Equal(testClientID, claims["aud"])
+	s.Equal("nonce-1", claims["nonce"])
+
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+userInfoPath, nil)
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	userInfoResp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, userInfoResp.StatusCode)
+	var userInfo map[string]interface{}
+	s.decode(userInfoResp, &userInfo)
+	s.Equal("user1@example.com", userInfo["email"])
+}
+
+func (s *MockIdPTestSuite) TestToken_CodeIsSingleUse() {
+	code := s.authorize(nil).Get("code")
+	first := s.redeem(code, nil)
+	_ = first.Body.Close()
+	s.Equal(http.StatusOK, first.StatusCode)
+
+	second := s.redeem(code, nil)
+	var errResp errorResponse
+	s.decode(second, &errResp)
+	s.Equal(http.StatusBadRequest, second.StatusCode)
+	s.Equal("invalid_grant", errResp.Error)
+}
+
+func (s *MockIdPTestSuite) TestToken_InvalidClientSecret() {
+	code := s.authorize(nil).Get("code")
+	form := url.Values{
+		"grant_type": {"authorization_code"}, "code": {code}, "redirect_uri": {testRedirectURI},
+		"client_id": {testClientID}, "client_secret": {"wrong"},
+	}
+
+	resp, err := s.client.PostForm(s.server.URL+tokenPath, form)
+	s.Require().NoError(err)
+	var errResp errorResponse
+	s.decode(resp, &errResp)
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+	s.Equal("invalid_client", errResp.Error)
+}
+
+func (s *MockIdPTestSuite) TestToken_PKCE() {
+	verifier := "a-sufficiently-long-code-verifier-for-the-mock-idp-tests"
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	code := s.authorize(url.Values{"code_challenge": {challenge}, "code_challenge_method": {"S256"}}).Get("code")
+	resp := s.redeem(code, url.Values{"code_verifier": {"wrong-verifier"}})
+	_ = resp.Body.Close()
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	code = s.authorize(url.Values{"code_challenge": {challenge}, "code_challenge_method": {"S256"}}).Get("code")
+	resp = s.redeem(code, url.Values{"code_verifier": {verifier}})
+	_ = resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *MockIdPTestSuite) TestAuthorize_UnknownClient() {
+	resp, err := s.client.Get(s.server.URL + authorizePath + "?client_id=other&redirect_uri=" +
+		url.QueryEscape(testRedirectURI) + "&response_type=code")
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *MockIdPTestSuite) TestSetClaims() {
+	resp := s.put(controlClaimsPath, `{"sub":"user-2","groups":["admins"]}`)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	tokenResp := s.redeem(s.authorize(nil).Get("code"), nil)
+	var tokens tokenResponse
+	s.decode(tokenResp, &tokens)
+	claims := s.verifyIDToken(tokens.IDToken)
+	s.Equal("user-2", claims["sub"])
+	s.Equal([]interface{}{"admins"}, claims["groups"])
+	s.NotContains(claims, "email")
+}
+
+func (s *MockIdPTestSuite) TestSetClaims_InvalidBody() {
+	resp := s.put(controlClaimsPath, `["not-an-object"]`)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *MockIdPTestSuite) TestInjectError_Authorize() {
+	resp := s.put(controlErrorsPath+"/authorize", `{"error":"access_denied","error_description":"User declined"}`)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	query := s.authorize(nil)
+	s.Equal("access_denied", query.Get("error"))
+	s.Equal("User declined", query.Get("error_description"))
+	s.Equal("state-1", query.Get("state"))
+	s.Empty(query.Get("code"))
+}
+
+func (s *MockIdPTestSuite) TestInjectError_TokenWithStatus() {
+	code := s.authorize(nil).Get("code")
+	s.put(controlErrorsPath+"/token", `{"error":"temporarily_unavailable","status_code":503}`)
+
+	resp := s.redeem(code, nil)
+	var errResp errorResponse
+	s.decode(resp, &errResp)
+	s.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+	s.Equal("temporarily_unavailable", errResp.Error)
+}
+
+func (s *MockIdPTestSuite) TestInjectError_JWKSUsesDefaultStatus() {
+	s.put(controlErrorsPath+"/jwks", `{"error":"server_error"}`)
+
+	resp, err := s.client.Get(s.server.URL + jwksPath)
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(http.StatusInternalServerError, resp.StatusCode)
+}
+
+func (s *MockIdPTestSuite) TestInjectError_Validation() {
+	s.Equal(http.StatusNotFound, s.put(controlErrorsPath+"/logout", `{"error":"server_error"}`).StatusCode)
+	s.Equal(http.StatusBadRequest, s.put(controlErrorsPath+"/token", `{}`).StatusCode)
+}
+
+func (s *MockIdPTestSuite) TestClearErrorsAndReset() {
+	s.put(controlClaimsPath, `{"sub":"user-2"}`)
+	s.put(controlErrorsPath+"/userinfo", `{"error":"invalid_token"}`)
+
+	req, err := http.NewRequest(http.MethodDelete, s.server.URL+controlErrorsPath, nil)
+	s.Require().NoError(err)
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+	s.Empty(s.idp.errors)
+
+	s.put(controlErrorsPath+"/userinfo", `{"error":"invalid_token"}`)
+	resp, err = s.client.Post(s.server.URL+controlResetPath, "application/json", nil)
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+	s.Empty(s.idp.errors)
+	s.Equal("user-1", s.idp.claims["sub"])
+}
+
+func (s *MockIdPTestSuite) TestUserInfo_InvalidToken() {
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+userInfoPath, nil)
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer unknown")
+
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+// verifyIDToken checks the ID token signature against the published JWKS and returns its claims.
+func (s *MockIdPTestSuite) verifyIDToken(token string) map[string]interface{} {
+	resp, err := s.client.Get(s.server.URL + jwksPath)
+	s.Require().NoError(err)
+	var keySet map[string][]map[string]interface{}
+	s.decode(resp, &keySet)
+	s.Require().Len(keySet["keys"], 1)
+	publicKey, err := jws.JWKToPublicKey(keySet["keys"][0])
+	s.Require().NoError(err)
+
+	parts := strings.Split(token, ".")
+	s.Require().Len(parts, 3)
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	s.Require().NoError(err)
+	s.Require().NoError(cryptolib.Verify([]byte(parts[0]+"."+parts[1]), signature, cryptolib.RSASHA256,
+		publicKey))
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	s.Require().NoError(err)
+	var claims map[string]interface{}
+	s.Require().NoError(json.Unmarshal(payload, &claims))
+	return claims
+}
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	sum := sha256.Sum256([]byte("verifier"))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	assert.True(t, verifyCodeChallenge(challenge, "verifier"))
+	assert.False(t, verifyCodeChallenge(challenge, "other"))
+	require.False(t, verifyCodeChallenge(challenge, ""))
+}
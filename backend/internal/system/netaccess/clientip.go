@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package netaccess
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// clientIPResolver resolves the client IP address of a request from its direct peer and, when the
+// peer is a trusted proxy, from the configured client IP headers.
+type clientIPResolver struct {
+	trustedProxies []netip.Prefix
+	headers        []string
+}
+
+// newClientIPResolver creates a clientIPResolver. headers are matched case-insensitively; an
+// unsupported header returns an error wrapping ErrUnsupportedClientIPHeader.
+func newClientIPResolver(trustedProxies []netip.Prefix, headers []string) (*clientIPResolver, error) {
+	if len(headers) == 0 {
+		headers = defaultClientIPHeaders
+	}
+	canonical := make([]string, 0, len(headers))
+	for _, header := range headers {
+		header = http.CanonicalHeaderKey(strings.TrimSpace(header))
+		if !slices.Contains(supportedClientIPHeaders, header) {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedClientIPHeader, header)
+		}
+		canonical = append(canonical, header)
+	}
+	return &clientIPResolver{trustedProxies: trustedProxies, headers: canonical}, nil
+}
+
+// resolve returns the client IP address of r. The client IP headers are honoured only when the
+// direct peer is a trusted proxy, and the first configured header present on the request wins. The
+// chain is then walked from the right, skipping trusted proxies, and the first untrusted address is
+// the client. This prevents a client from spoofing its address by sending its own header.
+func (c *clientIPResolver) resolve(r *http.Request) netip.Addr {
+	peer := parseRemoteAddr(r.RemoteAddr)
+	if !peer.IsValid() || !Contains(c.trustedProxies, peer) {
+		return peer
+	}
+
+	for _, header := range c.headers {
+		values := r.Header.Values(header)
+		if len(values) == 0 {
+			continue
+		}
+		return c.walk(peer, headerHops(header, values))
+	}
+	return peer
+}
+
+// walk returns the client address from hops, ordered from the client to the proxy nearest to peer.
+func (c *clientIPResolver) walk(peer netip.Addr, hops []string) netip.Addr {
+	client := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			// A malformed or obfuscated hop breaks the chain; fall back to the last address that was
+			// verified.
+			return client
+		}
+		client = addr.Unmap()
+		if !Contains(c.trustedProxies, client) {
+			return client
+		}
+	}
+	return client
+}
+
+// parseRemoteAddr parses the host part of an http.Request RemoteAddr.
+func parseRemoteAddr(remoteAddr string) netip.Addr {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addr.Unmap()
+}
+
+// headerHops flattens the values of the given client IP header into the ordered list of hops.
+func headerHops(header string, values []string) []string {
+	switch header {
+	case headerForwarded:
+		return forwardedHops(values)
+	case headerXRealIP:
+		// Only the value set by the nearest proxy is meaningful.
+		return []string{strings.TrimSpace(values[len(values)-1])}
+	default:
+		return forwardedForHops(values)
+	}
+}
+
+// forwardedForHops flattens the X-Forwarded-For header values into the ordered list of hops.
+func forwardedForHops(values []string) []string {
+	hops := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	return hops
+}
+
+// forwardedHops flattens the Forwarded header values (RFC 7239) into the ordered list of hops, one
+// per forwarded element. An element without a "for" parameter yields an empty, malformed hop.
+func forwardedHops(values []string) []string {
+	hops := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, element := range strings.Split(value, ",") {
+			if strings.TrimSpace(element) == "" {
+				continue
+			}
+			hops = append(hops, forwardedFor(element))
+		}
+	}
+	return hops
+}
+
+// forwardedFor returns the address of the "for" parameter of a Forwarded element without quotes,
+// IPv6 brackets or port.
+func forwardedFor(element string) string {
+	for _, pair := range strings.Split(element, ";") {
+		name, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || !strings.EqualFold(name, "for") {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		if strings.HasPrefix(value, "[") {
+			if end := strings.Index(value, "]"); end > 0 {
+				return value[1:end]
+			}
+			return value
+		}
+		if host, _, err := net.SplitHostPort(value); err == nil {
+			return host
+		}
+		return value
+	}
+	return ""
+}
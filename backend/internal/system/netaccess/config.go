@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package netaccess
+
+// Config holds the network access settings, mapped by the caller from the deployment server
+// network_access config. It is intentionally decoupled from system/config so this package does
+// not depend on the global configuration type.
+type Config struct {
+	// AdminNetworks lists the CIDR ranges (or single IP addresses) allowed to call the management
+	// endpoints. Empty leaves the management endpoints reachable from any network.
+	AdminNetworks []string
+	// AdminExemptPaths lists additional authenticated path patterns that are not management
+	// endpoints and stay reachable from any network.
+	AdminExemptPaths []string
+	// TrustedProxies lists the CIDR ranges of reverse proxies whose client IP headers are trusted
+	// when resolving the client IP address.
+	TrustedProxies []string
+	// ClientIPHeaders lists, in order of precedence, the headers read from a trusted proxy to
+	// resolve the client IP address. Supported values are Forwarded, X-Forwarded-For and X-Real-IP.
+	// Empty uses X-Forwarded-For only.
+	ClientIPHeaders []string
+}
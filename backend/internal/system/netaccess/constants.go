@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package netaccess
+
+// nonManagementPaths are authenticated endpoints that serve end users and resource servers rather
+// than administrators, so the admin network list does not apply to them. Public endpoints are
+// excluded separately. Uses the same glob syntax as the security public paths.
+var nonManagementPaths = []string{
+	"/users/me",
+	"/users/me/**",
+	"/access/v1/**",
+}
+
+// Headers a trusted proxy may use to convey the client IP address.
+const (
+	// headerForwarded is the standard header carrying the client and proxy chain (RFC 7239).
+	headerForwarded = "Forwarded"
+	// headerXForwardedFor is the de-facto standard header carrying the client and proxy chain.
+	headerXForwardedFor = "X-Forwarded-For"
+	// headerXRealIP is the header carrying the single client address set by the proxy.
+	headerXRealIP = "X-Real-Ip"
+)
+
+// defaultClientIPHeaders is the header precedence used when none is configured.
+var defaultClientIPHeaders = []string{headerXForwardedFor}
+
+// supportedClientIPHeaders lists the headers that can be configured to convey the client IP address.
+var supportedClientIPHeaders = []string{headerForwarded, headerXForwardedFor, headerXRealIP}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package netaccess
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// ErrInvalidNetwork is returned when a network entry is neither a CIDR range nor an IP address.
+var ErrInvalidNetwork = errors.New("invalid network")
+
+// ErrUnsupportedClientIPHeader is returned when a configured client IP header is not supported.
+var ErrUnsupportedClientIPHeader = errors.New("unsupported client IP header")
+
+// errNetworkNotAllowed is returned with HTTP 403 when a management endpoint is called from outside
+// the admin networks.
+var errNetworkNotAllowed = apierror.ErrorResponse{
+	Code: "NET-4031",
+	Message: tidcommon.I18nMessage{
+		Key:          "error.netaccess.network_not_allowed",
+		DefaultValue: "Network not allowed",
+	},
+	Description: tidcommon.I18nMessage{
+		Key:          "error.netaccess.network_not_allowed_description",
+		DefaultValue: "The management endpoints cannot be called from this network",
+	},
+}
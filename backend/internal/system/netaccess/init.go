@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package netaccess provides the HTTP middleware that resolves the client IP address, honouring
+// X-Forwarded-For only from trusted proxies, and restricts the management endpoints to the admin
+// networks. The resolved address is stored in the request context for per-application checks.
+package netaccess
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// Initialize builds the network access middlewares from cfg. The client IP middleware resolves the
+// client IP address of every request into the request context and must run ahead of every handler
+// that reads it; the guard middleware restricts the management endpoints only when admin networks
+// are configured. A malformed network entry or an unsupported client IP header returns a non-nil
+// error.
+func Initialize(cfg Config) (clientIP, guardMiddleware func(http.Handler) http.Handler, err error) {
+	adminNetworks, err := ParseNetworks(cfg.AdminNetworks)
+	if err != nil {
+		return nil, nil, err
+	}
+	trustedProxies, err := ParseNetworks(cfg.TrustedProxies)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolver, err := newClientIPResolver(trustedProxies, cfg.ClientIPHeaders)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exempt := append([]string{}, security.PublicPaths()...)
+	exempt = append(exempt, nonManagementPaths...)
+	exempt = append(exempt, cfg.AdminExemptPaths...)
+
+	g := &guard{
+		adminNetworks: adminNetworks,
+		exemptPaths:   exempt,
+	}
+	return resolver.middleware, g.middleware, nil
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package netaccess
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type NetworkAccessTestSuite struct {
+	suite.Suite
+}
+
+func TestNetworkAccessSuite(t *testing.T) {
+	suite.Run(t, new(NetworkAccessTestSuite))
+}
+
+// serve runs a request from remoteAddr through the middlewares built from cfg and returns the
+// recorder and the client IP address seen by the downstream handler, which is invalid when the
+// handler was not reached.
+func (suite *NetworkAccessTestSuite) serve(cfg Config, path, remoteAddr string,
+	forwardedFor ...string) (*httptest.ResponseRecorder, netip.Addr) {
+	return suite.serveWithHeaders(cfg, path, remoteAddr, http.Header{headerXForwardedFor: forwardedFor})
+}
+
+// serveWithHeaders is serve with arbitrary request headers.
+func (suite *NetworkAccessTestSuite) serveWithHeaders(cfg Config, path, remoteAddr string,
+	headers http.Header) (*httptest.ResponseRecorder, netip.Addr) {
+	clientIP, guard, err := Initialize(cfg)
+	suite.Require().NoError(err)
+
+	var seen netip.Addr
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = sysContext.GetClientIP(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = remoteAddr
+	for name, values := range headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+	rec := httptest.NewRecorder()
+	clientIP(guard(next)).ServeHTTP(rec, req)
+	return rec, seen
+}
+
+func (suite *NetworkAccessTestSuite) TestInitialize_InvalidNetwork() {
+	_, _, err := Initialize(Config{AdminNetworks: []string{"10.0.0.0/33"}})
+	suite.ErrorIs(err, ErrInvalidNetwork)
+
+	_, _, err = Initialize(Config{TrustedProxies: []string{"proxy.internal"}})
+	suite.ErrorIs(err, ErrInvalidNetwork)
+}
+
+func (suite *NetworkAccessTestSuite) TestInitialize_UnsupportedClientIPHeader() {
+	_, _, err := Initialize(Config{ClientIPHeaders: []string{"X-Client-Address"}})
+	suite.ErrorIs(err, ErrUnsupportedClientIPHeader)
+
+	_, _, err = Initialize(Config{ClientIPHeaders: []string{"forwarded", "x-real-ip"}})
+	suite.NoError(err)
+}
+
+func (suite *NetworkAccessTestSuite) TestNoAdminNetworks_AllowsEverything() {
+	rec, seen := suite.serve(Config{}, "/applications", "192.0.2.10:5000")
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal(netip.MustParseAddr("192.0.2.10"), seen)
+}
+
+func (suite *NetworkAccessTestSuite) TestAdminNetworks_RestrictManagementPaths() {
+	cfg := Config{AdminNetworks: []string{"10.0.0.0/8"}}
+
+	rec, _ := suite.serve(cfg, "/applications", "10.1.2.3:5000")
+	suite.Equal(http.StatusOK, rec.Code)
+
+	rec, seen := suite.serve(cfg, "/applications", "192.0.2.10:5000")
+	suite.Equal(http.StatusForbidden, rec.Code)
+	suite.False(seen.IsValid())
+
+	var body apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+	suite.Equal(errNetworkNotAllowed.Code, body.Code)
+}
+
+func (suite *NetworkAccessTestSuite) TestAdminNetworks_ExemptPaths() {
+	cfg := Config{AdminNetworks: []string{"10.0.0.0/8"}, AdminExemptPaths: []string{"/reports/**"}}
+	for _, path := range []string{"/oauth2/token", "/health/liveness", "/users/me", "/reports/daily"} {
+		rec, _ := suite.serve(cfg, path, "192.0.2.10:5000")
+		suite.Equal(http.StatusOK, rec.Code, path)
+	}
+}
+
+func (suite *NetworkAccessTestSuite) TestForwardedFor_IgnoredFromUntrustedPeer() {
+	cfg := Config{AdminNetworks: []string{"10.0.0.0/8"}}
+	rec, _ := suite.serve(cfg, "/applications", "192.0.2.10:5000", "10.1.2.3")
+	suite.Equal(http.StatusForbidden, rec.Code)
+}
+
+func (suite *NetworkAccessTestSuite) TestForwardedFor_ResolvedThroughTrustedProxies() {
+	cfg := Config{TrustedProxies: []string{"172.16.0.0/12"}}
+
+	// A client-supplied entry left of the real client address is not trusted.
+	_, seen := suite.serve(cfg, "/oauth2/token", "172.16.0.1:5000", "10.9.9.9, 198.51.100.7", "172.16.0.2")
+	suite.Equal(netip.MustParseAddr("198.51.100.7"), seen)
+
+	// A malformed hop stops the walk at the last verified address.
+	_, seen = suite.serve(cfg, "/oauth2/token", "172.16.0.1:5000", "garbage, 172.16.0.2")
+	suite.Equal(netip.MustParseAddr("172.16.0.2"), seen)
+}
+
+func (suite *NetworkAccessTestSuite) TestForwarded_Resolved() {
+	cfg := Config{TrustedProxies: []string{"172.16.0.0/12"}, ClientIPHeaders: []string{"Forwarded"}}
+
+	_, seen := suite.serveWithHeaders(cfg, "/oauth2/token", "172.16.0.1:5000", http.Header{
+		headerForwarded: {`for=10.9.9.9, for="[2001:db8::7]:4711";proto=https`, "for=172.16.0.2;by=172.16.0.1"},
+	})
+	suite.Equal(netip.MustParseAddr("2001:db8::7"), seen)
+
+	// An obfuscated identifier stops the walk at the last verified address.
+	_, seen = suite.serveWithHeaders(cfg, "/oauth2/token", "172.16.0.1:5000", http.Header{
+		headerForwarded: {"for=_hidden, for=172.16.0.2:8080"},
+	})
+	suite.Equal(netip.MustParseAddr("172.16.0.2"), seen)
+}
+
+func (suite *NetworkAccessTestSuite) TestClientIPHeaders_Precedence() {
+	cfg := Config{
+		TrustedProxies:  []string{"172.16.0.0/12"},
+		ClientIPHeaders: []string{"X-Real-IP", "X-Forwarded-For"},
+	}
+	headers := http.Header{headerXForwardedFor: {"198.51.100.7"}}
+
+	_, seen := suite.serveWithHeaders(cfg, "/oauth2/token", "172.16.0.1:5000", headers)
+	suite.Equal(netip.MustParseAddr("198.51.100.7"), seen)
+
+	headers.Set(headerXRealIP, "203.0.113.5")
+	_, seen = suite.serveWithHeaders(cfg, "/oauth2/token", "172.16.0.1:5000", headers)
+	suite.Equal(netip.MustParseAddr("203.0.113.5"), seen)
+
+	// Headers that are not configured are ignored.
+	_, seen = suite.serveWithHeaders(Config{TrustedProxies: cfg.TrustedProxies}, "/oauth2/token",
+		"172.16.0.1:5000", http.Header{headerXRealIP: {"203.0.113.5"}})
+	suite.Equal(netip.MustParseAddr("172.16.0.1"), seen)
+}
+
+func (suite *NetworkAccessTestSuite) TestIsAllowed() {
+	addr := netip.MustParseAddr("192.0.2.10")
+	suite.True(IsAllowed([]string{"bad", "192.0.2.0/24"}, addr))
+	suite.True(IsAllowed([]string{"192.0.2.10"}, netip.MustParseAddr("::ffff:192.0.2.10")))
+	suite.False(IsAllowed([]string{"10.0.0.0/8"}, addr))
+	suite.False(IsAllowed([]string{"0.0.0.0/0"}, netip.Addr{}))
+}
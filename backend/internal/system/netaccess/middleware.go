@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package netaccess
+
+import (
+	"net/http"
+	"net/netip"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// middleware wraps next so that the resolved client IP address is available to every
+// downstream handler through the request context.
+func (c *clientIPResolver) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := sysContext.WithClientIP(r.Context(), c.resolve(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// guard enforces the admin network list against the resolved client IP address.
+type guard struct {
+	adminNetworks []netip.Prefix
+	exemptPaths   []string
+}
+
+// middleware wraps next so that management requests from outside the admin networks never reach it.
+// The client IP address is read from the request context, set by the client IP middleware.
+func (g *guard) middleware(next http.Handler) http.Handler {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NetworkAccessMiddleware"))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if g.isManagementPath(r.URL.Path) && !Contains(g.adminNetworks, sysContext.GetClientIP(ctx)) {
+			logger.Debug(ctx, "Management request rejected by network access control",
+				log.String("method", r.Method), log.String("path", r.URL.Path))
+			utils.WriteErrorResponse(ctx, w, http.StatusForbidden, errNetworkNotAllowed)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isManagementPath reports whether requestPath is a management endpoint restricted to the admin
+// networks. Every authenticated endpoint is a management endpoint unless it is exempt.
+func (g *guard) isManagementPath(requestPath string) bool {
+	if len(g.adminNetworks) == 0 {
+		return false
+	}
+	for _, pattern := range g.exemptPaths {
+		if utils.MatchPathPattern(pattern, requestPath) {
+			return false
+		}
+	}
+	return true
+}
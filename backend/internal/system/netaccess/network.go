@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package netaccess
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// ParseNetworks parses CIDR ranges and single IP addresses into prefixes. A single address is
+// treated as a full-length prefix. Any malformed entry returns an error wrapping ErrInvalidNetwork.
+func ParseNetworks(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidNetwork, entry)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidNetwork, entry)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// Contains reports whether addr falls within any of the prefixes. An invalid addr is never
+// contained.
+func Contains(prefixes []netip.Prefix, addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllowed reports whether addr falls within any of the network entries. Malformed entries are
+// ignored; entries are validated when they are configured.
+func IsAllowed(entries []string, addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	for _, entry := range entries {
+		prefixes, err := ParseNetworks([]string{entry})
+		if err != nil {
+			continue
+		}
+		if Contains(prefixes, addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -18,6 +18,12 @@
 
 package adapter
 
+import (
+	"time"
+
+	httpservice "github.com/thunder-id/thunderid/internal/system/http"
+)
+
 // InitializeConsoleAdapter creates and returns a console adapter that writes formatted events to stdout.
 //
 // Returns:
@@ -51,3 +57,18 @@ func InitializeConsoleAdapter() OutputAdapterInterface {
 func InitializeFileAdapter(filePath string) (OutputAdapterInterface, error) {
 	return NewFileAdapter(filePath)
 }
+
+// InitializeWebhookAdapter creates and returns a webhook adapter that POSTs formatted events to url.
+// When secret is non-empty, each request body is signed with HMAC-SHA256 and the signature is sent in
+// the X-ThunderID-Signature header as "sha256=<hex>".
+//
+// Parameters:
+//   - url: The endpoint that receives the events
+//   - secret: The HMAC key used to sign requests, or empty for unsigned requests
+//   - timeout: The timeout for each delivery
+//
+// Returns:
+//   - OutputAdapterInterface: The initialized webhook adapter instance
+func InitializeWebhookAdapter(url, secret string, timeout time.Duration) OutputAdapterInterface {
+	return newWebhookAdapter(url, secret, httpservice.NewOutboundHTTPClient(timeout))
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adapter
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	httpservice "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+const (
+	// webhookSignatureHeader carries the HMAC-SHA256 signature of the request body.
+	webhookSignatureHeader = "X-ThunderID-Signature"
+	// webhookSignaturePrefix identifies the signature algorithm in webhookSignatureHeader.
+	webhookSignaturePrefix = "sha256="
+)
+
+// webhookAdapter POSTs each event to an HTTP endpoint.
+type webhookAdapter struct {
+	url    string
+	secret []byte
+	client httpservice.HTTPClientInterface
+	mu     sync.Mutex
+	closed bool
+}
+
+var _ OutputAdapterInterface = (*webhookAdapter)(nil)
+
+// newWebhookAdapter creates a webhook adapter that posts to url using client. An empty secret sends
+// unsigned requests.
+func newWebhookAdapter(url, secret string, client httpservice.HTTPClientInterface) *webhookAdapter {
+	return &webhookAdapter{
+		url:    url,
+		secret: []byte(secret),
+		client: client,
+	}
+}
+
+// Write POSTs data to the webhook endpoint. Any non-2xx response is returned as an error.
+func (wa *webhookAdapter) Write(data []byte) error {
+	wa.mu.Lock()
+	closed := wa.closed
+	wa.mu.Unlock()
+	if closed {
+		return fmt.Errorf("webhook adapter is closed")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, wa.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(wa.secret) > 0 {
+		req.Header.Set(webhookSignatureHeader, webhookSignaturePrefix+signWebhookBody(wa.secret, data))
+	}
+
+	resp, err := wa.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver webhook: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Flush is a no-op for the webhook adapter as every event is delivered when it is written.
+func (wa *webhookAdapter) Flush() error {
+	return nil
+}
+
+// Close closes the webhook adapter.
+func (wa *webhookAdapter) Close() error {
+	wa.mu.Lock()
+	defer wa.mu.Unlock()
+
+	wa.closed = true
+	return nil
+}
+
+// GetName returns the name of this adapter.
+func (wa *webhookAdapter) GetName() string {
+	return "WebhookAdapter"
+}
+
+// signWebhookBody returns the hex-encoded HMAC-SHA256 of body keyed with secret.
+func signWebhookBody(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
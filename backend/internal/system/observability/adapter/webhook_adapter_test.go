@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adapter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookAdapter_WriteSignsBody(t *testing.T) {
+	var received []byte
+	var signature, contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get(webhookSignatureHeader)
+		contentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	adp := newWebhookAdapter(server.URL, "webhook-secret", server.Client())
+	require.NoError(t, adp.Write([]byte(`{"type":"NEW_DEVICE_LOGIN"}`)))
+
+	mac := hmac.New(sha256.New, []byte("webhook-secret"))
+	_, _ = mac.Write(received)
+	assert.Equal(t, `{"type":"NEW_DEVICE_LOGIN"}`, string(received))
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), signature)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, "WebhookAdapter", adp.GetName())
+}
+
+func TestWebhookAdapter_WriteWithoutSecretIsUnsigned(t *testing.T) {
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(webhookSignatureHeader)
+	}))
+	defer server.Close()
+
+	adp := newWebhookAdapter(server.URL, "", server.Client())
+	require.NoError(t, adp.Write([]byte(`{}`)))
+	assert.Empty(t, signature)
+}
+
+func TestWebhookAdapter_WriteErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	adp := newWebhookAdapter(server.URL, "", server.Client())
+	assert.ErrorContains(t, adp.Write([]byte(`{}`)), "status 502")
+
+	require.NoError(t, adp.Flush())
+	require.NoError(t, adp.Close())
+	assert.ErrorContains(t, adp.Write([]byte(`{}`)), "closed")
+}
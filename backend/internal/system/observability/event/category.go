@@ -51,6 +51,9 @@ const (
 	// CategoryFlows groups all flow orchestration events for tracing end-to-end flows.
 	CategoryFlows EventCategory = "observability.flows"
 
+	// CategorySecurity groups security events such as detected login anomalies.
+	CategorySecurity EventCategory = "observability.security"
+
 	// CategoryAll is a special category that matches all events.
 	// Subscribers to this category receive all events regardless of type.
 	CategoryAll EventCategory = "observability.all"
@@ -77,6 +80,8 @@ var eventTypeToCategory = map[providers.EventType]EventCategory{
 	EventTypeTokenIssuanceFailed:    CategoryAuthentication,
 	EventTypeTokenRevoked:           CategoryAuthentication,
 	EventTypeOperationDBUnavailable: CategoryAuthentication,
+	EventTypeUserStateChanged:       CategoryAuthentication,
+	EventTypeUserErased:             CategoryAuthentication,
 
 	// Flow events
 	EventTypeFlowStarted:                CategoryFlows,
@@ -86,6 +91,11 @@ var eventTypeToCategory = map[providers.EventType]EventCategory{
 	EventTypeFlowUserInputRequired:      CategoryFlows,
 	EventTypeFlowCompleted:              CategoryFlows,
 	EventTypeFlowFailed:                 CategoryFlows,
+
+	// Security events
+	EventTypeNewDeviceLogin:     CategorySecurity,
+	EventTypeImpossibleTravel:   CategorySecurity,
+	EventTypeAccessPolicyDenied: CategorySecurity,
 }
 
 // GetCategory returns the category for a given event type.
@@ -105,6 +115,7 @@ func GetAllCategories() []EventCategory {
 		CategoryAuthentication,
 		CategoryAuthorization,
 		CategoryFlows,
+		CategorySecurity,
 	}
 }
 
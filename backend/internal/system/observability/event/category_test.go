@@ -172,6 +172,7 @@ func TestGetAllCategories(t *testing.T) {
 		CategoryAuthentication: false,
 		CategoryAuthorization:  false,
 		CategoryFlows:          false,
+		CategorySecurity:       false,
 	}
 
 	for _, cat := range categories {
@@ -28,6 +28,15 @@ const (
 
 	// ComponentAuthHandler identifies events from authentication handlers.
 	ComponentAuthHandler = "AuthHandler"
+
+	// ComponentUserManagement identifies events from user management services.
+	ComponentUserManagement = "UserManagement"
+
+	// ComponentAnomalyDetection identifies events from login anomaly detection.
+	ComponentAnomalyDetection = "AnomalyDetection"
+
+	// ComponentAccessPolicy identifies events from application network and geolocation access policies.
+	ComponentAccessPolicy = "AccessPolicy"
 )
 
 // Authentication and Authorization Event Types
@@ -50,6 +59,14 @@ const (
 	// deny-list (revocation) check becomes unavailable and enforcement fails closed.
 	EventTypeOperationDBUnavailable providers.EventType = "OPERATION_DB_UNAVAILABLE"
 
+	// Account Lifecycle Events
+
+	// EventTypeUserStateChanged is triggered when an administrator changes the account state of a user.
+	EventTypeUserStateChanged providers.EventType = "USER_STATE_CHANGED"
+
+	// EventTypeUserErased is triggered when a user and their personal data are erased on request.
+	EventTypeUserErased providers.EventType = "USER_ERASED"
+
 	// Flow Execution Events
 
 	// EventTypeFlowStarted is triggered when a flow execution begins.
@@ -72,4 +89,17 @@ const (
 
 	// EventTypeFlowFailed is triggered when flow execution fails.
 	EventTypeFlowFailed providers.EventType = "FLOW_FAILED"
+
+	// Security Events
+
+	// EventTypeNewDeviceLogin is triggered when a user signs in from a device not seen before.
+	EventTypeNewDeviceLogin providers.EventType = "NEW_DEVICE_LOGIN"
+
+	// EventTypeImpossibleTravel is triggered when a user signs in from a location that could not be
+	// reached from the previous sign-in location in the elapsed time.
+	EventTypeImpossibleTravel providers.EventType = "IMPOSSIBLE_TRAVEL"
+
+	// EventTypeAccessPolicyDenied is triggered when an application's access policy blocks an
+	// authentication attempt based on the client IP address or its country.
+	EventTypeAccessPolicyDenied providers.EventType = "ACCESS_POLICY_DENIED"
 )
@@ -32,6 +32,11 @@ var DataKey = struct {
 	ClientID string
 	EntityID string
 
+	// Account Lifecycle Keys
+	ActorID       string
+	PreviousState string
+	AccountState  string
+
 	// Flow Execution Keys
 	ExecutionID   string
 	FlowType      string
@@ -52,6 +57,16 @@ var DataKey = struct {
 	JTI              string
 	RevocationReason string
 
+	// Security Keys
+	DeviceID        string
+	Country         string
+	PreviousCountry string
+	DistanceKm      string
+	TravelSpeedKmh  string
+	PreviousLoginAt string
+	ClientIP        string
+	DenyReason      string
+
 	// Event Metadata Keys
 	Message     string
 	Error       string
@@ -69,6 +84,11 @@ var DataKey = struct {
 	ClientID: "client_id",
 	EntityID: "app_id",
 
+	// Account Lifecycle Keys
+	ActorID:       "actor_id",
+	PreviousState: "previous_state",
+	AccountState:  "account_state",
+
 	// Flow Execution Keys
 	ExecutionID:   "execution_id",
 	FlowType:      "flow_type",
@@ -89,6 +109,16 @@ var DataKey = struct {
 	JTI:              "jti",
 	RevocationReason: "revocation_reason",
 
+	// Security Keys
+	DeviceID:        "device_id",
+	Country:         "country",
+	PreviousCountry: "previous_country",
+	DistanceKm:      "distance_km",
+	TravelSpeedKmh:  "travel_speed_kmh",
+	PreviousLoginAt: "previous_login_at",
+	ClientIP:        "client_ip",
+	DenyReason:      "deny_reason",
+
 	// Event Metadata Keys
 	Message:     "message",
 	Error:       "error",
@@ -36,6 +36,10 @@ type ObservabilityServiceInterface interface {
 	// The context carries the request trace ID used for correlated logging.
 	PublishEvent(ctx context.Context, evt *providers.Event)
 
+	// DeliverEvent delivers an event synchronously to every active subscriber.
+	// It returns an error when observability is disabled or any subscriber failed.
+	DeliverEvent(ctx context.Context, evt *providers.Event) error
+
 	// IsEnabled returns true if observability is enabled and operational.
 	IsEnabled() bool
 
@@ -22,9 +22,12 @@ package observability
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
 	"github.com/thunder-id/thunderid/internal/system/observability/publisher"
 	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
@@ -155,6 +158,15 @@ func (s *Service) PublishEvent(ctx context.Context, evt *providers.Event) {
 		return
 	}
 
+	// Record the client IP address of the request for auditing unless the publisher already did.
+	if evt != nil {
+		if clientIP := sysContext.GetClientIP(ctx); clientIP.IsValid() {
+			if _, exists := evt.Data[event.DataKey.ClientIP]; !exists {
+				evt.WithData(event.DataKey.ClientIP, clientIP.String())
+			}
+		}
+	}
+
 	// Publisher handles nil check and validation
 	s.publisher.Publish(ctx, evt)
 
@@ -167,6 +179,47 @@ func (s *Service) PublishEvent(ctx context.Context, evt *providers.Event) {
 	}
 }
 
+// DeliverEvent delivers an event synchronously to every active subscriber and returns the
+// failures of the subscribers, joined. Unlike PublishEvent it waits for the subscribers, so the
+// outbox dispatcher knows whether an event reached the outputs before marking it delivered.
+func (s *Service) DeliverEvent(ctx context.Context, evt *providers.Event) error {
+	if !s.IsEnabled() {
+		return errors.New("observability is disabled")
+	}
+	if evt == nil {
+		return errors.New("event is nil")
+	}
+	if err := evt.Validate(); err != nil {
+		return fmt.Errorf("invalid event: %w", err)
+	}
+
+	var errs []error
+	for _, sub := range s.publisher.GetSubscribers() {
+		// Subscribers filter the event themselves based on their categories.
+		if err := deliverToSubscriber(sub, evt); err != nil {
+			errs = append(errs, fmt.Errorf("subscriber %s: %w", sub.GetID(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	s.logger.Debug(ctx, "Event delivered",
+		log.String("eventType", evt.Type),
+		log.String("eventID", evt.EventID))
+	return nil
+}
+
+// deliverToSubscriber hands an event to one subscriber, turning a panic into an error.
+func deliverToSubscriber(sub subscriber.SubscriberInterface, evt *providers.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("subscriber panicked: %v", r)
+		}
+	}()
+	return sub.OnEvent(evt)
+}
+
 // IsEnabled returns true if observability is enabled and operational.
 func (s *Service) IsEnabled() bool {
 	return s.config.Enabled && s.publisher != nil
@@ -20,10 +20,14 @@ package observability
 
 import (
 	"context"
+	"errors"
+	"net/netip"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/observability/event"
 	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -141,6 +145,28 @@ func TestService_PublishEvent(t *testing.T) {
 	}
 }
 
+func TestService_PublishEventAddsClientIP(t *testing.T) {
+	svc := setupTestService(true)
+	defer svc.Shutdown()
+
+	ctx := sysContext.WithClientIP(context.Background(), netip.MustParseAddr("198.51.100.7"))
+	evt := event.NewEvent("trace-123", string(event.EventTypeTokenIssued), "test")
+	svc.PublishEvent(ctx, evt)
+
+	if evt.Data[event.DataKey.ClientIP] != "198.51.100.7" {
+		t.Errorf("Expected client IP to be recorded, got %v", evt.Data[event.DataKey.ClientIP])
+	}
+
+	// A client IP address set by the publisher is kept.
+	evt = event.NewEvent("trace-124", string(event.EventTypeTokenIssued), "test").
+		WithData(event.DataKey.ClientIP, "203.0.113.5")
+	svc.PublishEvent(ctx, evt)
+
+	if evt.Data[event.DataKey.ClientIP] != "203.0.113.5" {
+		t.Errorf("Expected publisher client IP to be kept, got %v", evt.Data[event.DataKey.ClientIP])
+	}
+}
+
 func TestService_PublishEventDisabled(t *testing.T) {
 	svc := setupTestService(false)
 
@@ -269,3 +295,57 @@ func TestService_ConcurrentPublish(t *testing.T) {
 func TestService_SubscriberPanic(t *testing.T) {
 	t.Skip("Test uses RegisterSubscriber - needs update for registry pattern")
 }
+
+// failingSubscriber is a subscriber whose event handling always fails.
+type failingSubscriber struct{}
+
+func (failingSubscriber) GetID() string { return "failing" }
+func (failingSubscriber) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryAll}
+}
+func (failingSubscriber) OnEvent(*providers.Event) error { return errors.New("endpoint unavailable") }
+func (failingSubscriber) Close() error                   { return nil }
+func (failingSubscriber) IsEnabled() bool                { return true }
+func (failingSubscriber) Initialize() error              { return nil }
+
+func TestService_DeliverEvent(t *testing.T) {
+	svc := setupTestService(true)
+	defer svc.Shutdown()
+
+	evt := event.NewEvent("trace-123", string(event.EventTypeTokenIssued), "test")
+	evt.WithStatus(providers.StatusSuccess)
+
+	if err := svc.DeliverEvent(context.Background(), evt); err != nil {
+		t.Errorf("DeliverEvent() error = %v, want nil", err)
+	}
+}
+
+func TestService_DeliverEventSubscriberFailure(t *testing.T) {
+	svc := setupTestService(true)
+	defer svc.Shutdown()
+	svc.GetPublisher().Subscribe(failingSubscriber{})
+
+	evt := event.NewEvent("trace-123", string(event.EventTypeTokenIssued), "test")
+
+	err := svc.DeliverEvent(context.Background(), evt)
+	if err == nil || !strings.Contains(err.Error(), "endpoint unavailable") {
+		t.Errorf("DeliverEvent() error = %v, want the subscriber failure", err)
+	}
+}
+
+func TestService_DeliverEventDisabledOrInvalid(t *testing.T) {
+	disabled := setupTestService(false)
+	evt := event.NewEvent("trace-123", string(event.EventTypeTokenIssued), "test")
+	if err := disabled.DeliverEvent(context.Background(), evt); err == nil {
+		t.Error("DeliverEvent() should fail when observability is disabled")
+	}
+
+	svc := setupTestService(true)
+	defer svc.Shutdown()
+	if err := svc.DeliverEvent(context.Background(), nil); err == nil {
+		t.Error("DeliverEvent() should fail for a nil event")
+	}
+	if err := svc.DeliverEvent(context.Background(), &providers.Event{}); err == nil {
+		t.Error("DeliverEvent() should fail for an invalid event")
+	}
+}
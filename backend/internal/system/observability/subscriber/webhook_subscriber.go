@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/adapter"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/formatter"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	webhookSubscriberComponentName = "WebhookSubscriber"
+	defaultWebhookTimeout          = 10 * time.Second
+)
+
+// WebhookSubscriber delivers observability events to an HTTP endpoint as JSON.
+// It supports category-based filtering and optional HMAC request signing.
+type WebhookSubscriber struct {
+	id         string
+	categories []event.EventCategory
+	formatter  formatter.FormatterInterface
+	adapter    adapter.OutputAdapterInterface
+	logger     *log.Logger
+}
+
+var _ SubscriberInterface = (*WebhookSubscriber)(nil)
+
+// WebhookDeliveryFailureListener is notified when an event could not be delivered to the webhook endpoint.
+type WebhookDeliveryFailureListener func(evt *providers.Event, err error)
+
+var (
+	deliveryFailureListenersMu sync.RWMutex
+	deliveryFailureListeners   = map[int]WebhookDeliveryFailureListener{}
+	nextDeliveryFailureID      int
+)
+
+// AddWebhookDeliveryFailureListener registers a listener for webhook delivery failures and returns a
+// function that removes it. Listeners run on the delivery goroutine and must not block.
+func AddWebhookDeliveryFailureListener(listener WebhookDeliveryFailureListener) func() {
+	deliveryFailureListenersMu.Lock()
+	defer deliveryFailureListenersMu.Unlock()
+
+	id := nextDeliveryFailureID
+	nextDeliveryFailureID++
+	deliveryFailureListeners[id] = listener
+
+	return func() {
+		deliveryFailureListenersMu.Lock()
+		defer deliveryFailureListenersMu.Unlock()
+		delete(deliveryFailureListeners, id)
+	}
+}
+
+// notifyDeliveryFailure calls every registered delivery failure listener.
+func notifyDeliveryFailure(evt *providers.Event, err error) {
+	deliveryFailureListenersMu.RLock()
+	defer deliveryFailureListenersMu.RUnlock()
+
+	for _, listener := range deliveryFailureListeners {
+		listener(evt, err)
+	}
+}
+
+// init registers the webhook subscriber factory with the global registry.
+// This runs before main() and only registers the factory function.
+// No configuration access or instance creation happens here.
+func init() {
+	RegisterSubscriberFactory("webhook", func() SubscriberInterface {
+		return NewWebhookSubscriber()
+	})
+}
+
+// NewWebhookSubscriber creates a new webhook subscriber instance.
+func NewWebhookSubscriber() *WebhookSubscriber {
+	return &WebhookSubscriber{}
+}
+
+// IsEnabled checks if the webhook subscriber should be activated based on configuration.
+func (ws *WebhookSubscriber) IsEnabled() bool {
+	return config.GetServerRuntime().Config.Observability.Output.Webhook.Enabled
+}
+
+// Initialize sets up the webhook subscriber with the provided configuration.
+func (ws *WebhookSubscriber) Initialize() error {
+	// Subscriber initialization runs during application startup, outside any request.
+	ctx := context.Background()
+
+	webhookConfig := config.GetServerRuntime().Config.Observability.Output.Webhook
+	if err := validateWebhookURL(webhookConfig.URL); err != nil {
+		return err
+	}
+
+	timeout := defaultWebhookTimeout
+	if webhookConfig.TimeoutSeconds > 0 {
+		timeout = time.Duration(webhookConfig.TimeoutSeconds) * time.Second
+	}
+
+	ws.categories = convertCategories(webhookConfig.Categories)
+	if len(ws.categories) == 0 {
+		ws.categories = []event.EventCategory{event.CategoryAll}
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate webhook subscriber ID: %w", err)
+	}
+
+	ws.id = id
+	ws.formatter = formatter.Initialize(formatJSON)
+	ws.adapter = adapter.InitializeWebhookAdapter(webhookConfig.URL, webhookConfig.Secret, timeout)
+	ws.logger = log.GetLogger().With(log.String(log.LoggerKeyComponentName, webhookSubscriberComponentName))
+
+	ws.logger.Debug(ctx, "Webhook subscriber initialized",
+		log.Bool("signed", webhookConfig.Secret != ""),
+		log.Int("categories", len(ws.categories)))
+
+	return nil
+}
+
+// GetID returns the unique identifier for this subscriber.
+func (ws *WebhookSubscriber) GetID() string {
+	return ws.id
+}
+
+// GetCategories returns the categories this subscriber is interested in.
+func (ws *WebhookSubscriber) GetCategories() []event.EventCategory {
+	if len(ws.categories) > 0 {
+		return ws.categories
+	}
+	// Default: all categories
+	return []event.EventCategory{event.CategoryAll}
+}
+
+// OnEvent is called when a new event is published.
+func (ws *WebhookSubscriber) OnEvent(evt *providers.Event) error {
+	if err := processEvent(evt, ws.formatter, ws.adapter, ws.logger, "webhook"); err != nil {
+		notifyDeliveryFailure(evt, err)
+		return err
+	}
+	return nil
+}
+
+// Close closes the subscriber and releases resources.
+func (ws *WebhookSubscriber) Close() error {
+	if ws.adapter == nil {
+		return nil
+	}
+	// Subscriber shutdown runs during application teardown, outside any request.
+	ctx := context.Background()
+
+	ws.logger.Info(ctx, "Closing webhook subscriber", log.String("subscriberID", ws.id))
+	if err := ws.adapter.Close(); err != nil {
+		ws.logger.Error(ctx, "Failed to close webhook adapter", log.Error(err))
+		return err
+	}
+	return nil
+}
+
+// validateWebhookURL checks that rawURL is an absolute http(s) URL.
+func validateWebhookURL(rawURL string) error {
+	if rawURL == "" {
+		return errors.New("observability.output.webhook.url is required when the webhook output is enabled")
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return fmt.Errorf("observability.output.webhook.url must be an absolute http or https URL")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package subscriber
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+func TestWebhookSubscriber_IsEnabled(t *testing.T) {
+	setupTestConfig(t)
+	defer resetTestConfig()
+
+	sub := NewWebhookSubscriber()
+	assert.False(t, sub.IsEnabled())
+
+	config.GetServerRuntime().Config.Observability.Output.Webhook.Enabled = true
+	assert.True(t, sub.IsEnabled())
+}
+
+func TestWebhookSubscriber_InitializeRejectsInvalidURL(t *testing.T) {
+	setupTestConfig(t)
+	defer resetTestConfig()
+
+	cfg := &config.GetServerRuntime().Config.Observability.Output.Webhook
+	for _, rawURL := range []string{"", "ftp://siem.example.com/hook", "/relative"} {
+		cfg.URL = rawURL
+		assert.Error(t, NewWebhookSubscriber().Initialize(), rawURL)
+	}
+}
+
+func TestWebhookSubscriber_DeliversEvents(t *testing.T) {
+	setupTestConfig(t)
+	defer resetTestConfig()
+
+	received := make(chan map[string]interface{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]interface{}
+		_ = json.Unmarshal(body, &payload)
+		received <- payload
+	}))
+	defer server.Close()
+
+	cfg := &config.GetServerRuntime().Config.Observability.Output.Webhook
+	cfg.Enabled = true
+	cfg.URL = server.URL
+	cfg.Categories = []string{string(event.CategorySecurity)}
+
+	sub := NewWebhookSubscriber()
+	require.NoError(t, sub.Initialize())
+	assert.NotEmpty(t, sub.GetID())
+	assert.Equal(t, []event.EventCategory{event.CategorySecurity}, sub.GetCategories())
+
+	evt := event.NewEvent("trace-1", string(event.EventTypeNewDeviceLogin), event.ComponentAnomalyDetection).
+		WithData(event.DataKey.UserID, "user-1")
+	require.NoError(t, sub.OnEvent(evt))
+
+	payload := <-received
+	assert.Equal(t, string(event.EventTypeNewDeviceLogin), payload["type"])
+	require.NoError(t, sub.Close())
+}
+
+func TestWebhookSubscriber_NotifiesDeliveryFailureListeners(t *testing.T) {
+	setupTestConfig(t)
+	defer resetTestConfig()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cfg := &config.GetServerRuntime().Config.Observability.Output.Webhook
+	cfg.Enabled = true
+	cfg.URL = server.URL
+
+	sub := NewWebhookSubscriber()
+	require.NoError(t, sub.Initialize())
+	defer func() { _ = sub.Close() }()
+
+	var failed []string
+	remove := AddWebhookDeliveryFailureListener(func(evt *providers.Event, err error) {
+		assert.Error(t, err)
+		failed = append(failed, evt.EventID)
+	})
+
+	evt := event.NewEvent("trace-1", string(event.EventTypeNewDeviceLogin), event.ComponentAnomalyDetection)
+	assert.Error(t, sub.OnEvent(evt))
+	assert.Equal(t, []string{evt.EventID}, failed)
+
+	remove()
+	assert.Error(t, sub.OnEvent(evt))
+	assert.Len(t, failed, 1)
+}
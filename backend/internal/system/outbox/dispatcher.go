@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const (
+	dispatcherLoggerComponentName = "OutboxDispatcher"
+	// deliveryLease is how long a claimed event is hidden from other dispatchers. An event whose
+	// dispatcher stops before finishing is delivered again once the lease passes.
+	deliveryLease = 2 * time.Minute
+	// retryBaseDelay is the delay before the first retry of a failed delivery. It doubles with every
+	// further attempt up to retryMaxDelay.
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = 15 * time.Minute
+	// cleanupInterval is how often delivered events past the retention are deleted.
+	cleanupInterval = time.Hour
+)
+
+// EventSink delivers outbox events to their destinations.
+type EventSink interface {
+	// DeliverEvent delivers an event synchronously and returns an error when any destination failed.
+	DeliverEvent(ctx context.Context, evt *providers.Event) error
+	// IsEnabled reports whether the sink has any destination.
+	IsEnabled() bool
+}
+
+// Dispatcher owns the background loop that delivers outbox events. Start begins the loop and Stop
+// halts it during graceful shutdown.
+type Dispatcher interface {
+	// Start begins the periodic dispatch loop. It returns immediately; dispatching runs in the
+	// background.
+	Start(ctx context.Context)
+	// Stop halts the dispatch loop and waits for an in-flight dispatch to finish.
+	Stop()
+}
+
+// dispatcher periodically delivers due outbox events to the event sink. Every event is claimed
+// before it is delivered, so several nodes can run a dispatcher over the same outbox. Delivery is at
+// least once: an event is delivered again when its dispatcher stops before recording the outcome,
+// and receivers deduplicate by event ID.
+type dispatcher struct {
+	store       outboxStoreInterface
+	sink        EventSink
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	retention   time.Duration
+	now         func() time.Time
+	lastCleanup time.Time
+	logger      *log.Logger
+	cancel      context.CancelFunc
+	doneCh      chan struct{}
+	stopOnce    sync.Once
+}
+
+// newDispatcher creates a dispatcher that delivers up to batchSize due events every interval.
+func newDispatcher(store outboxStoreInterface, sink EventSink, interval time.Duration, batchSize,
+	maxAttempts int, retention time.Duration) *dispatcher {
+	return &dispatcher{
+		store:       store,
+		sink:        sink,
+		interval:    interval,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		retention:   retention,
+		now:         func() time.Time { return time.Now().UTC() },
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, dispatcherLoggerComponentName)),
+		doneCh:      make(chan struct{}),
+	}
+}
+
+// Start launches the periodic dispatch loop.
+func (d *dispatcher) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+	go func() {
+		defer close(d.doneCh)
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				d.dispatch(ctx)
+				d.cleanup(ctx)
+			}
+		}
+	}()
+}
+
+// Stop cancels the dispatch loop and waits for it to exit. It is safe to call more than once.
+func (d *dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		if d.cancel != nil {
+			d.cancel()
+			<-d.doneCh
+		}
+	})
+}
+
+// dispatch delivers the due events batch by batch until no full batch is left.
+func (d *dispatcher) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		records, err := d.store.ListDueEvents(ctx, d.now(), d.batchSize)
+		if err != nil {
+			d.logger.Error(ctx, "Failed to list due outbox events", log.Error(err))
+			return
+		}
+		for _, record := range records {
+			if ctx.Err() != nil {
+				return
+			}
+			d.dispatchRecord(ctx, record)
+		}
+		if len(records) < d.batchSize {
+			return
+		}
+	}
+}
+
+// dispatchRecord claims one event, delivers it, and records the outcome. A failed delivery is
+// retried with exponential backoff until the attempts are exhausted.
+func (d *dispatcher) dispatchRecord(ctx context.Context, record outboxRecord) {
+	now := d.now()
+	claimed, err := d.store.ClaimEvent(ctx, record.ID, now, now.Add(deliveryLease))
+	if err != nil {
+		d.logger.Error(ctx, "Failed to claim outbox event", log.String("eventID", record.EventID),
+			log.Error(err))
+		return
+	}
+	if !claimed {
+		return
+	}
+	attempt := record.Attempts + 1
+
+	var evt providers.Event
+	if err := json.Unmarshal([]byte(record.Payload), &evt); err != nil {
+		d.logger.Error(ctx, "Discarding undecodable outbox event", log.String("eventID", record.EventID),
+			log.Error(err))
+		d.markFailed(ctx, record, err.Error())
+		return
+	}
+
+	eventCtx := sysContext.WithTraceID(ctx, evt.TraceID)
+	deliverErr := d.sink.DeliverEvent(eventCtx, &evt)
+	if deliverErr == nil {
+		if err := d.store.MarkDelivered(ctx, record.ID, d.now()); err != nil {
+			d.logger.Error(eventCtx, "Failed to mark outbox event delivered", log.String("eventID", record.EventID),
+				log.Error(err))
+		}
+		return
+	}
+
+	if attempt >= d.maxAttempts {
+		d.logger.Error(eventCtx, "Giving up on outbox event after the last delivery attempt",
+			log.String("eventID", record.EventID), log.String("eventType", record.EventType),
+			log.Int("attempts", attempt), log.Error(deliverErr))
+		d.markFailed(ctx, record, deliverErr.Error())
+		return
+	}
+
+	d.logger.Warn(eventCtx, "Failed to deliver outbox event, scheduling a retry",
+		log.String("eventID", record.EventID), log.Int("attempt", attempt), log.Error(deliverErr))
+	if err := d.store.ScheduleRetry(ctx, record.ID, d.now().Add(retryDelay(attempt)),
+		deliverErr.Error()); err != nil {
+		d.logger.Error(eventCtx, "Failed to schedule outbox event retry", log.String("eventID", record.EventID),
+			log.Error(err))
+	}
+}
+
+// markFailed marks an event as failed, logging when the outcome cannot be recorded.
+func (d *dispatcher) markFailed(ctx context.Context, record outboxRecord, lastError string) {
+	if err := d.store.MarkFailed(ctx, record.ID, d.now(), lastError); err != nil {
+		d.logger.Error(ctx, "Failed to mark outbox event failed", log.String("eventID", record.EventID),
+			log.Error(err))
+	}
+}
+
+// cleanup deletes delivered events past the retention, at most once per cleanup interval.
+func (d *dispatcher) cleanup(ctx context.Context) {
+	now := d.now()
+	if now.Sub(d.lastCleanup) < cleanupInterval {
+		return
+	}
+	d.lastCleanup = now
+
+	deleted, err := d.store.DeleteDelivered(ctx, now.Add(-d.retention))
+	if err != nil {
+		d.logger.Error(ctx, "Failed to delete delivered outbox events", log.Error(err))
+		return
+	}
+	if deleted > 0 {
+		d.logger.Debug(ctx, "Deleted delivered outbox events", log.Int("count", int(deleted)))
+	}
+}
+
+// retryDelay returns the delay before the next delivery attempt after the given number of attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMaxDelay)
+}
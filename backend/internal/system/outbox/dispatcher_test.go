@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+var testNow = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+func newTestDispatcher(t *testing.T) (*dispatcher, *outboxStoreInterfaceMock, *EventSinkMock) {
+	store := newOutboxStoreInterfaceMock(t)
+	sink := NewEventSinkMock(t)
+	d := newDispatcher(store, sink, time.Second, 2, 3, time.Hour)
+	d.now = func() time.Time { return testNow }
+	return d, store, sink
+}
+
+func newTestRecord(t *testing.T, id string, attempts int) outboxRecord {
+	payload, err := json.Marshal(newTestEvent())
+	require.NoError(t, err)
+	return outboxRecord{ID: id, EventID: "evt-" + id, EventType: "USER_STATE_CHANGED", Payload: string(payload),
+		Attempts: attempts}
+}
+
+func TestDispatcher_Dispatch_Delivers(t *testing.T) {
+	d, store, sink := newTestDispatcher(t)
+	store.On("ListDueEvents", mock.Anything, testNow, 2).Return([]outboxRecord{newTestRecord(t, "r1", 0)}, nil).Once()
+	store.On("ClaimEvent", mock.Anything, "r1", testNow, testNow.Add(deliveryLease)).Return(true, nil).Once()
+	sink.On("DeliverEvent", mock.Anything, mock.MatchedBy(func(evt *providers.Event) bool {
+		return evt.EventID == "evt-1" && evt.Type == "USER_STATE_CHANGED"
+	})).Return(nil).Once()
+	store.On("MarkDelivered", mock.Anything, "r1", testNow).Return(nil).Once()
+
+	d.dispatch(context.Background())
+}
+
+func TestDispatcher_Dispatch_SkipsEventClaimedElsewhere(t *testing.T) {
+	d, store, _ := newTestDispatcher(t)
+	store.On("ListDueEvents", mock.Anything, testNow, 2).Return([]outboxRecord{newTestRecord(t, "r1", 0)}, nil).Once()
+	store.On("ClaimEvent", mock.Anything, "r1", mock.Anything, mock.Anything).Return(false, nil).Once()
+
+	// The sink mock fails the test if the event is delivered.
+	d.dispatch(context.Background())
+}
+
+func TestDispatcher_Dispatch_SchedulesRetry(t *testing.T) {
+	d, store, sink := newTestDispatcher(t)
+	store.On("ListDueEvents", mock.Anything, testNow, 2).Return([]outboxRecord{newTestRecord(t, "r1", 1)}, nil).Once()
+	store.On("ClaimEvent", mock.Anything, "r1", mock.Anything, mock.Anything).Return(true, nil).Once()
+	sink.On("DeliverEvent", mock.Anything, mock.Anything).Return(errors.New("webhook returned 503")).Once()
+	store.On("ScheduleRetry", mock.Anything, "r1", testNow.Add(2*retryBaseDelay), "webhook returned 503").
+		Return(nil).Once()
+
+	d.dispatch(context.Background())
+}
+
+func TestDispatcher_Dispatch_MarksFailedAfterLastAttempt(t *testing.T) {
+	d, store, sink := newTestDispatcher(t)
+	store.On("ListDueEvents", mock.Anything, testNow, 2).Return([]outboxRecord{newTestRecord(t, "r1", 2)}, nil).Once()
+	store.On("ClaimEvent", mock.Anything, "r1", mock.Anything, mock.Anything).Return(true, nil).Once()
+	sink.On("DeliverEvent", mock.Anything, mock.Anything).Return(errors.New("webhook returned 503")).Once()
+	store.On("MarkFailed", mock.Anything, "r1", testNow, "webhook returned 503").Return(nil).Once()
+
+	d.dispatch(context.Background())
+}
+
+func TestDispatcher_Dispatch_MarksUndecodableEventFailed(t *testing.T) {
+	d, store, _ := newTestDispatcher(t)
+	store.On("ListDueEvents", mock.Anything, testNow, 2).Return([]outboxRecord{
+		{ID: "r1", EventID: "evt-1", EventType: "USER_STATE_CHANGED", Payload: "not json"},
+	}, nil).Once()
+	store.On("ClaimEvent", mock.Anything, "r1", mock.Anything, mock.Anything).Return(true, nil).Once()
+	store.On("MarkFailed", mock.Anything, "r1", testNow, mock.Anything).Return(nil).Once()
+
+	d.dispatch(context.Background())
+}
+
+func TestDispatcher_Dispatch_DrainsFullBatches(t *testing.T) {
+	d, store, sink := newTestDispatcher(t)
+	store.On("ListDueEvents", mock.Anything, testNow, 2).Return([]outboxRecord{
+		newTestRecord(t, "r1", 0), newTestRecord(t, "r2", 0),
+	}, nil).Once()
+	store.On("ListDueEvents", mock.Anything, testNow, 2).Return([]outboxRecord{newTestRecord(t, "r3", 0)}, nil).Once()
+	store.On("ClaimEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Times(3)
+	sink.On("DeliverEvent", mock.Anything, mock.Anything).Return(nil).Times(3)
+	store.On("MarkDelivered", mock.Anything, mock.Anything, testNow).Return(nil).Times(3)
+
+	d.dispatch(context.Background())
+}
+
+func TestDispatcher_Dispatch_ListError(t *testing.T) {
+	d, store, _ := newTestDispatcher(t)
+	store.On("ListDueEvents", mock.Anything, testNow, 2).Return(nil, errors.New("db down")).Once()
+
+	d.dispatch(context.Background())
+}
+
+func TestDispatcher_Cleanup(t *testing.T) {
+	d, store, _ := newTestDispatcher(t)
+	store.On("DeleteDelivered", mock.Anything, testNow.Add(-time.Hour)).Return(int64(3), nil).Once()
+
+	d.cleanup(context.Background())
+	// A second cleanup within the cleanup interval is skipped.
+	d.cleanup(context.Background())
+}
+
+func TestDispatcher_StartStop(t *testing.T) {
+	store := newOutboxStoreInterfaceMock(t)
+	sink := NewEventSinkMock(t)
+	listed := make(chan struct{}, 1)
+	store.EXPECT().ListDueEvents(mock.Anything, mock.Anything, 10).Run(func(context.Context, time.Time, int) {
+		select {
+		case listed <- struct{}{}:
+		default:
+		}
+	}).Return(nil, nil)
+	store.On("DeleteDelivered", mock.Anything, mock.Anything).Return(int64(0), nil).Maybe()
+
+	d := newDispatcher(store, sink, 10*time.Millisecond, 10, 3, time.Hour)
+	d.Start(context.Background())
+
+	select {
+	case <-listed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("dispatcher did not run")
+	}
+	d.Stop()
+	d.Stop()
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, retryBaseDelay, retryDelay(1))
+	require.Equal(t, 2*retryBaseDelay, retryDelay(2))
+	require.Equal(t, 8*retryBaseDelay, retryDelay(4))
+	require.Equal(t, retryMaxDelay, retryDelay(30))
+}
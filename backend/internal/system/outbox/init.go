@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// Initialize wires the outbox store, service and dispatcher. It returns nil values when the outbox
+// is disabled or the sink has no destination, in which case callers publish events directly.
+func Initialize(cfg config.OutboxConfig, sink EventSink) (OutboxServiceInterface, Dispatcher, error) {
+	if !cfg.Enabled {
+		return nil, nil, nil
+	}
+	if sink == nil || !sink.IsEnabled() {
+		// Initialization runs during application startup, outside any request.
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, dispatcherLoggerComponentName)).
+			Warn(context.Background(), "The outbox is enabled but observability is disabled, events are not recorded")
+		return nil, nil, nil
+	}
+
+	transactioner, err := provider.GetDBProvider().GetUserDBTransactioner()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user database transactioner: %w", err)
+	}
+
+	store := newOutboxStore()
+	dispatcher := newDispatcher(store, sink, cfg.PollInterval(), cfg.BatchSize, cfg.MaxAttempts, cfg.Retention())
+	return newOutboxService(store, transactioner), dispatcher, nil
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package outbox implements the transactional outbox for audit and webhook events. An event is
+// written to the outbox in the same database transaction as the state change it describes, and a
+// background dispatcher delivers it to the observability outputs, so an event is neither lost when
+// the change commits nor emitted when the change rolls back.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// OutboxServiceInterface records the events of state changes in the transactional outbox.
+type OutboxServiceInterface interface {
+	// Commit runs change in a user database transaction and records evt in the outbox in the same
+	// transaction. The event is stored only when the change commits, and the error of the change
+	// is returned as is.
+	Commit(ctx context.Context, evt *providers.Event, change func(txCtx context.Context) error) error
+
+	// Pseudonymize replaces every occurrence of value in the recorded event payloads with pseudonym,
+	// so that events kept for audit no longer reference an erased subject. It returns the number of
+	// events updated.
+	Pseudonymize(ctx context.Context, value, pseudonym string) (int64, error)
+}
+
+// outboxService is the default implementation of OutboxServiceInterface.
+type outboxService struct {
+	store         outboxStoreInterface
+	transactioner transaction.Transactioner
+	now           func() time.Time
+}
+
+// newOutboxService creates a new instance of outboxService.
+func newOutboxService(store outboxStoreInterface, transactioner transaction.Transactioner) OutboxServiceInterface {
+	return &outboxService{
+		store:         store,
+		transactioner: transactioner,
+		now:           func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Commit runs change and records evt in one user database transaction.
+func (s *outboxService) Commit(ctx context.Context, evt *providers.Event,
+	change func(txCtx context.Context) error) error {
+	if evt == nil {
+		return fmt.Errorf("outbox event is nil")
+	}
+	if err := evt.Validate(); err != nil {
+		return fmt.Errorf("invalid outbox event: %w", err)
+	}
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox event: %w", err)
+	}
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate outbox record ID: %w", err)
+	}
+	record := outboxRecord{ID: id, EventID: evt.EventID, EventType: evt.Type, Payload: string(payload)}
+
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if err := change(txCtx); err != nil {
+			return err
+		}
+		return s.store.InsertEvent(txCtx, record, s.now())
+	})
+}
+
+// Pseudonymize replaces value with pseudonym in the payloads of the recorded events.
+func (s *outboxService) Pseudonymize(ctx context.Context, value, pseudonym string) (int64, error) {
+	if value == "" {
+		return 0, fmt.Errorf("value to pseudonymize is empty")
+	}
+	return s.store.ReplaceInPayloads(ctx, value, pseudonym)
+}
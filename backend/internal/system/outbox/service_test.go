@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/transactionmock"
+)
+
+func newTestEvent() *providers.Event {
+	return &providers.Event{
+		TraceID:   "trace-1",
+		EventID:   "evt-1",
+		Type:      "USER_STATE_CHANGED",
+		Timestamp: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Component: "UserManagement",
+		Status:    providers.StatusSuccess,
+		Data:      map[string]interface{}{"user_id": "u1"},
+	}
+}
+
+func newTestService(t *testing.T) (*outboxService, *outboxStoreInterfaceMock, *transactionmock.TransactionerMock) {
+	store := newOutboxStoreInterfaceMock(t)
+	transactioner := transactionmock.NewTransactionerMock(t)
+	transactioner.EXPECT().Transact(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, txFunc func(context.Context) error) error {
+			return txFunc(ctx)
+		}).Maybe()
+	svc := newOutboxService(store, transactioner).(*outboxService)
+	return svc, store, transactioner
+}
+
+func TestOutboxService_Commit(t *testing.T) {
+	svc, store, _ := newTestService(t)
+	evt := newTestEvent()
+
+	var order []string
+	store.EXPECT().InsertEvent(mock.Anything, mock.MatchedBy(func(record outboxRecord) bool {
+		var stored providers.Event
+		return record.ID != "" && record.EventID == "evt-1" && record.EventType == "USER_STATE_CHANGED" &&
+			json.Unmarshal([]byte(record.Payload), &stored) == nil && stored.EventID == "evt-1"
+	}), mock.AnythingOfType("time.Time")).Run(func(context.Context, outboxRecord, time.Time) {
+		order = append(order, "insert")
+	}).Return(nil).Once()
+
+	err := svc.Commit(context.Background(), evt, func(context.Context) error {
+		order = append(order, "change")
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"change", "insert"}, order)
+}
+
+func TestOutboxService_Commit_ChangeError(t *testing.T) {
+	svc, _, _ := newTestService(t)
+	changeErr := errors.New("update failed")
+
+	err := svc.Commit(context.Background(), newTestEvent(), func(context.Context) error {
+		return changeErr
+	})
+
+	// The change error is returned as is and no event is recorded.
+	require.ErrorIs(t, err, changeErr)
+}
+
+func TestOutboxService_Commit_InsertError(t *testing.T) {
+	svc, store, _ := newTestService(t)
+	store.On("InsertEvent", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	err := svc.Commit(context.Background(), newTestEvent(), func(context.Context) error { return nil })
+
+	require.Error(t, err)
+}
+
+func TestOutboxService_Commit_InvalidEvent(t *testing.T) {
+	svc, _, transactioner := newTestService(t)
+	evt := newTestEvent()
+	evt.TraceID = ""
+
+	called := false
+	err := svc.Commit(context.Background(), evt, func(context.Context) error {
+		called = true
+		return nil
+	})
+
+	require.Error(t, err)
+	require.False(t, called)
+	transactioner.AssertNotCalled(t, "Transact", mock.Anything, mock.Anything)
+}
+
+func TestOutboxService_Pseudonymize(t *testing.T) {
+	svc, store, _ := newTestService(t)
+	store.EXPECT().ReplaceInPayloads(mock.Anything, "u1", "erased-1").Return(int64(2), nil).Once()
+
+	updated, err := svc.Pseudonymize(context.Background(), "u1", "erased-1")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated)
+}
+
+func TestOutboxService_Pseudonymize_EmptyValue(t *testing.T) {
+	svc, _, _ := newTestService(t)
+
+	_, err := svc.Pseudonymize(context.Background(), "", "erased-1")
+
+	require.Error(t, err)
+}
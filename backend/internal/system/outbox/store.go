@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// outboxStoreInterface defines the persistence operations for the outbox.
+type outboxStoreInterface interface {
+	InsertEvent(ctx context.Context, record outboxRecord, createdAt time.Time) error
+	ListDueEvents(ctx context.Context, now time.Time, limit int) ([]outboxRecord, error)
+	ClaimEvent(ctx context.Context, id string, now, leaseUntil time.Time) (bool, error)
+	MarkDelivered(ctx context.Context, id string, processedAt time.Time) error
+	ScheduleRetry(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error
+	MarkFailed(ctx context.Context, id string, processedAt time.Time, lastError string) error
+	DeleteDelivered(ctx context.Context, processedBefore time.Time) (int64, error)
+	ReplaceInPayloads(ctx context.Context, value, replacement string) (int64, error)
+}
+
+// outboxStore is the database-backed outbox store. The outbox lives in the user database, so an
+// event is written in the same transaction as the user state change it describes.
+type outboxStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newOutboxStore creates a new instance of outboxStore.
+func newOutboxStore() outboxStoreInterface {
+	return &outboxStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// InsertEvent adds a pending event to the outbox. It joins the user database transaction of the
+// context when there is one.
+func (s *outboxStore) InsertEvent(ctx context.Context, record outboxRecord, createdAt time.Time) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryInsertEvent, record.ID, record.EventID, record.EventType,
+		record.Payload, createdAt, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to insert outbox event: %w", err)
+	}
+	return nil
+}
+
+// ListDueEvents returns up to limit pending events whose next attempt is due, oldest first.
+func (s *outboxStore) ListDueEvents(ctx context.Context, now time.Time, limit int) ([]outboxRecord, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListDueEvents, now, s.deploymentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+
+	records := make([]outboxRecord, 0, len(results))
+	for _, row := range results {
+		record, err := buildOutboxRecord(row)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// ClaimEvent leases a due event until leaseUntil and counts the delivery attempt. It reports false
+// when the event was claimed by another dispatcher or is no longer pending.
+func (s *outboxStore) ClaimEvent(ctx context.Context, id string, now, leaseUntil time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	affected, err := dbClient.ExecuteContext(ctx, queryClaimEvent, leaseUntil, id, now, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim outbox event: %w", err)
+	}
+	return affected == 1, nil
+}
+
+// MarkDelivered marks an event as delivered.
+func (s *outboxStore) MarkDelivered(ctx context.Context, id string, processedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryMarkDelivered, processedAt, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to mark outbox event delivered: %w", err)
+	}
+	return nil
+}
+
+// ScheduleRetry records a failed delivery and schedules the next attempt.
+func (s *outboxStore) ScheduleRetry(ctx context.Context, id string, nextAttemptAt time.Time,
+	lastError string) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryScheduleRetry, nextAttemptAt, lastError, id,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to schedule outbox event retry: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed marks an event whose delivery attempts are exhausted.
+func (s *outboxStore) MarkFailed(ctx context.Context, id string, processedAt time.Time, lastError string) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryMarkFailed, processedAt, lastError, id,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to mark outbox event failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteDelivered deletes the events delivered before processedBefore and returns how many were
+// deleted.
+func (s *outboxStore) DeleteDelivered(ctx context.Context, processedBefore time.Time) (int64, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	deleted, err := dbClient.ExecuteContext(ctx, queryDeleteDelivered, processedBefore, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete delivered outbox events: %w", err)
+	}
+	return deleted, nil
+}
+
+// ReplaceInPayloads replaces every occurrence of value in the event payloads with replacement and
+// returns how many events were updated.
+func (s *outboxStore) ReplaceInPayloads(ctx context.Context, value, replacement string) (int64, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	updated, err := dbClient.ExecuteContext(ctx, queryReplaceInPayloads, value, replacement,
+		"%"+value+"%", s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to replace value in outbox event payloads: %w", err)
+	}
+	return updated, nil
+}
+
+// buildOutboxRecord converts a database row to an outbox record.
+func buildOutboxRecord(row map[string]interface{}) (outboxRecord, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return outboxRecord{}, fmt.Errorf("failed to parse id as string")
+	}
+	eventID, ok := row["event_id"].(string)
+	if !ok {
+		return outboxRecord{}, fmt.Errorf("failed to parse event_id as string")
+	}
+	eventType, ok := row["event_type"].(string)
+	if !ok {
+		return outboxRecord{}, fmt.Errorf("failed to parse event_type as string")
+	}
+	var payload string
+	switch v := row["payload"].(type) {
+	case string:
+		payload = v
+	case []byte:
+		payload = string(v)
+	default:
+		return outboxRecord{}, fmt.Errorf("failed to parse payload as string")
+	}
+	var attempts int
+	switch v := row["attempts"].(type) {
+	case int64:
+		attempts = int(v)
+	case int:
+		attempts = v
+	case float64:
+		attempts = int(v)
+	default:
+		return outboxRecord{}, fmt.Errorf("failed to parse attempts as integer")
+	}
+
+	return outboxRecord{ID: id, EventID: eventID, EventType: eventType, Payload: payload, Attempts: attempts}, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package outbox
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+var (
+	// queryInsertEvent adds a pending event to the outbox.
+	queryInsertEvent = dbmodel.DBQuery{
+		ID: "OBXQ-OUTBOX-01",
+		Query: `INSERT INTO "OUTBOX_EVENT" (ID, EVENT_ID, EVENT_TYPE, PAYLOAD, STATUS, ATTEMPTS, CREATED_AT, ` +
+			`NEXT_ATTEMPT_AT, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, 'PENDING', 0, $5, $5, $6)`,
+	}
+
+	// queryListDueEvents lists the pending events whose next attempt is due, oldest first.
+	queryListDueEvents = dbmodel.DBQuery{
+		ID: "OBXQ-OUTBOX-02",
+		Query: `SELECT ID, EVENT_ID, EVENT_TYPE, PAYLOAD, ATTEMPTS FROM "OUTBOX_EVENT" ` +
+			`WHERE STATUS = 'PENDING' AND NEXT_ATTEMPT_AT <= $1 AND DEPLOYMENT_ID = $2 ` +
+			`ORDER BY CREATED_AT, ID LIMIT $3`,
+	}
+
+	// queryClaimEvent leases a due event to one dispatcher by moving its next attempt past the lease,
+	// so that other nodes skip it while it is being delivered.
+	queryClaimEvent = dbmodel.DBQuery{
+		ID: "OBXQ-OUTBOX-03",
+		Query: `UPDATE "OUTBOX_EVENT" SET NEXT_ATTEMPT_AT = $1, ATTEMPTS = ATTEMPTS + 1 ` +
+			`WHERE ID = $2 AND STATUS = 'PENDING' AND NEXT_ATTEMPT_AT <= $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryMarkDelivered marks an event as delivered.
+	queryMarkDelivered = dbmodel.DBQuery{
+		ID: "OBXQ-OUTBOX-04",
+		Query: `UPDATE "OUTBOX_EVENT" SET STATUS = 'DELIVERED', PROCESSED_AT = $1, LAST_ERROR = NULL ` +
+			`WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryScheduleRetry records a failed delivery and schedules the next attempt.
+	queryScheduleRetry = dbmodel.DBQuery{
+		ID: "OBXQ-OUTBOX-05",
+		Query: `UPDATE "OUTBOX_EVENT" SET NEXT_ATTEMPT_AT = $1, LAST_ERROR = $2 ` +
+			`WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryMarkFailed marks an event whose delivery attempts are exhausted.
+	queryMarkFailed = dbmodel.DBQuery{
+		ID: "OBXQ-OUTBOX-06",
+		Query: `UPDATE "OUTBOX_EVENT" SET STATUS = 'FAILED', PROCESSED_AT = $1, LAST_ERROR = $2 ` +
+			`WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryDeleteDelivered deletes the events delivered before a point in time.
+	queryDeleteDelivered = dbmodel.DBQuery{
+		ID: "OBXQ-OUTBOX-07",
+		Query: `DELETE FROM "OUTBOX_EVENT" WHERE STATUS = 'DELIVERED' AND PROCESSED_AT < $1 ` +
+			`AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryReplaceInPayloads replaces a value in the payloads of the events that reference it.
+	queryReplaceInPayloads = dbmodel.DBQuery{
+		ID: "OBXQ-OUTBOX-08",
+		Query: `UPDATE "OUTBOX_EVENT" SET PAYLOAD = REPLACE(PAYLOAD, $1, $2) WHERE PAYLOAD LIKE $3 ` +
+			`AND DEPLOYMENT_ID = $4`,
+	}
+)
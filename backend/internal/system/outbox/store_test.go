@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	now            time.Time
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *outboxStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &outboxStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *StoreTestSuite) TestInsertEvent() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertEvent, "rec-1", "evt-1",
+		"USER_STATE_CHANGED", `{"event_id":"evt-1"}`, suite.now, testDeploymentID).Return(int64(1), nil)
+
+	suite.NoError(suite.store.InsertEvent(suite.ctx, outboxRecord{
+		ID: "rec-1", EventID: "evt-1", EventType: "USER_STATE_CHANGED", Payload: `{"event_id":"evt-1"}`,
+	}, suite.now))
+}
+
+func (suite *StoreTestSuite) TestInsertEvent_ExecuteError() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertEvent, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("db error"))
+
+	suite.Error(suite.store.InsertEvent(suite.ctx, outboxRecord{ID: "rec-1"}, suite.now))
+}
+
+func (suite *StoreTestSuite) TestListDueEvents() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListDueEvents, suite.now, testDeploymentID, 10).
+		Return([]map[string]interface{}{
+			{"id": "rec-1", "event_id": "evt-1", "event_type": "T", "payload": "{}", "attempts": int64(0)},
+			{"id": "rec-2", "event_id": "evt-2", "event_type": "T", "payload": []byte("{}"), "attempts": float64(3)},
+		}, nil)
+
+	records, err := suite.store.ListDueEvents(suite.ctx, suite.now, 10)
+
+	suite.NoError(err)
+	suite.Equal([]outboxRecord{
+		{ID: "rec-1", EventID: "evt-1", EventType: "T", Payload: "{}", Attempts: 0},
+		{ID: "rec-2", EventID: "evt-2", EventType: "T", Payload: "{}", Attempts: 3},
+	}, records)
+}
+
+func (suite *StoreTestSuite) TestListDueEvents_InvalidRow() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryListDueEvents, suite.now, testDeploymentID, 10).
+		Return([]map[string]interface{}{
+			{"id": "rec-1", "event_id": "evt-1", "event_type": "T", "payload": "{}", "attempts": "one"},
+		}, nil)
+
+	_, err := suite.store.ListDueEvents(suite.ctx, suite.now, 10)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestClaimEvent() {
+	lease := suite.now.Add(deliveryLease)
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryClaimEvent, lease, "rec-1", suite.now,
+		testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryClaimEvent, lease, "rec-2", suite.now,
+		testDeploymentID).Return(int64(0), nil).Once()
+
+	claimed, err := suite.store.ClaimEvent(suite.ctx, "rec-1", suite.now, lease)
+	suite.NoError(err)
+	suite.True(claimed)
+
+	// Another dispatcher claimed the event first.
+	claimed, err = suite.store.ClaimEvent(suite.ctx, "rec-2", suite.now, lease)
+	suite.NoError(err)
+	suite.False(claimed)
+}
+
+func (suite *StoreTestSuite) TestRecordOutcomes() {
+	next := suite.now.Add(time.Minute)
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryMarkDelivered, suite.now, "rec-1",
+		testDeploymentID).Return(int64(1), nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryScheduleRetry, next, "timeout", "rec-2",
+		testDeploymentID).Return(int64(1), nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryMarkFailed, suite.now, "gone", "rec-3",
+		testDeploymentID).Return(int64(1), nil)
+
+	suite.NoError(suite.store.MarkDelivered(suite.ctx, "rec-1", suite.now))
+	suite.NoError(suite.store.ScheduleRetry(suite.ctx, "rec-2", next, "timeout"))
+	suite.NoError(suite.store.MarkFailed(suite.ctx, "rec-3", suite.now, "gone"))
+}
+
+func (suite *StoreTestSuite) TestDeleteDelivered() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteDelivered, suite.now, testDeploymentID).
+		Return(int64(4), nil)
+
+	deleted, err := suite.store.DeleteDelivered(suite.ctx, suite.now)
+
+	suite.NoError(err)
+	suite.Equal(int64(4), deleted)
+}
+
+func (suite *StoreTestSuite) TestReplaceInPayloads() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryReplaceInPayloads, "u1", "erased-1", "%u1%",
+		testDeploymentID).Return(int64(2), nil)
+
+	updated, err := suite.store.ReplaceInPayloads(suite.ctx, "u1", "erased-1")
+
+	suite.NoError(err)
+	suite.Equal(int64(2), updated)
+}
+
+func (suite *StoreTestSuite) TestGetUserDBClientError() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(nil, errors.New("db unavailable"))
+
+	_, err := suite.store.ListDueEvents(suite.ctx, suite.now, 10)
+
+	suite.Error(err)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// Initialize creates the retention purger. It returns nil when retention is disabled. The runtime
+// tables are skipped when the runtime datasource is Redis or bolt, which expire their entries by
+// themselves.
+func Initialize(cfg config.RetentionConfig, runtimeDBType string) Purger {
+	if !cfg.Enabled {
+		return nil
+	}
+	return newPurger(newPurgeStore(), buildTargets(cfg, runtimeDBType), cfg.PurgeInterval(), cfg.BatchSize)
+}
+
+// buildTargets returns the purge targets of the configured retention categories.
+func buildTargets(cfg config.RetentionConfig, runtimeDBType string) []purgeTarget {
+	var targets []purgeTarget
+	if !provider.IsKeyValueRuntimeType(runtimeDBType) {
+		runtimeTarget := func(category, table string, retention time.Duration,
+			query dbmodel.DBQuery) purgeTarget {
+			return purgeTarget{category: category, table: table, database: purgeDatabaseRuntime,
+				query: query, retention: retention}
+		}
+		authzRetention := cfg.AuthorizationCodeRetention()
+		sessionRetention := cfg.SessionRetention()
+		runtimeRetention := cfg.RuntimeDataRetention()
+		targets = append(targets,
+			runtimeTarget(CategoryAuthorizationCode, "AUTHORIZATION_CODE", authzRetention,
+				queryPurgeAuthorizationCodes),
+			runtimeTarget(CategoryAuthorizationCode, "AUTHORIZATION_REQUEST", authzRetention,
+				queryPurgeAuthorizationRequests),
+			runtimeTarget(CategoryAuthorizationCode, "PAR_REQUEST", authzRetention, queryPurgePARRequests),
+			runtimeTarget(CategoryAuthorizationCode, "CIBA_AUTH_REQUEST", authzRetention, queryPurgeCIBARequests),
+			runtimeTarget(CategoryAuthorizationCode, "RUNTIME_STORE", authzRetention,
+				queryPurgeRuntimeAuthorizationEntries),
+			runtimeTarget(CategorySession, "WEBAUTHN_SESSION", sessionRetention, queryPurgeWebAuthnSessions),
+			runtimeTarget(CategorySession, "RUNTIME_STORE", sessionRetention, queryPurgeRuntimeFlowEntries),
+			runtimeTarget(CategoryRuntimeData, "JTI_RECORD", runtimeRetention, queryPurgeJTIRecords),
+			runtimeTarget(CategoryRuntimeData, "OPENID4VP_REQUEST_STATE", runtimeRetention,
+				queryPurgeVPRequestStates),
+			runtimeTarget(CategoryRuntimeData, "OPENID4VCI_NONCE", runtimeRetention, queryPurgeVCINonces),
+			runtimeTarget(CategoryRuntimeData, "OPENID4VCI_CREDENTIAL_OFFER", runtimeRetention,
+				queryPurgeVCIOffers),
+			runtimeTarget(CategoryRuntimeData, "RUNTIME_STORE", runtimeRetention, queryPurgeRuntimeOtherEntries),
+		)
+	}
+	return append(targets,
+		purgeTarget{category: CategoryRuntimeData, table: "EMAIL_DELIVERY_EVENT", database: purgeDatabaseOperation,
+			query: queryPurgeEmailDeliveryEvents, retention: cfg.RuntimeDataRetention()},
+		purgeTarget{category: CategoryAuditEvent, table: "OUTBOX_EVENT", database: purgeDatabaseUser,
+			query: queryPurgeAuditEvents, retention: cfg.AuditEventRetention()})
+}
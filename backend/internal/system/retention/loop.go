@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// PurgeLoop runs a purge function every interval in the background. It implements Purger and backs
+// both the retention purger and the soft-deleted entity purger.
+type PurgeLoop struct {
+	interval time.Duration
+	purge    func(ctx context.Context)
+	cancel   context.CancelFunc
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewPurgeLoop creates a loop that calls purge every interval once started.
+func NewPurgeLoop(interval time.Duration, purge func(ctx context.Context)) *PurgeLoop {
+	return &PurgeLoop{
+		interval: interval,
+		purge:    purge,
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Start launches the periodic purge loop.
+func (l *PurgeLoop) Start(ctx context.Context) {
+	ctx, l.cancel = context.WithCancel(ctx)
+	go func() {
+		defer close(l.doneCh)
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				l.purge(ctx)
+			}
+		}
+	}()
+}
+
+// Stop cancels the purge loop and waits for it to exit. It is safe to call more than once.
+func (l *PurgeLoop) Stop() {
+	l.stopOnce.Do(func() {
+		if l.cancel != nil {
+			l.cancel()
+			<-l.doneCh
+		}
+	})
+}
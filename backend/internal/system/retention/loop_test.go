@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeLoop_RunsUntilStopped(t *testing.T) {
+	var runs atomic.Int32
+	ran := make(chan struct{}, 1)
+	loop := NewPurgeLoop(10*time.Millisecond, func(ctx context.Context) {
+		runs.Add(1)
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+	})
+
+	loop.Start(context.Background())
+	select {
+	case <-ran:
+	case <-time.After(2 * time.Second):
+		t.Fatal("purge loop did not run")
+	}
+	loop.Stop()
+	loop.Stop()
+
+	stoppedAt := runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, stoppedAt, runs.Load())
+}
+
+func TestPurgeLoop_StopWithoutStart(t *testing.T) {
+	loop := NewPurgeLoop(time.Minute, func(context.Context) {})
+	loop.Stop()
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type purgeMetrics struct {
+	once   sync.Once
+	purged metric.Int64Counter
+}
+
+var metrics purgeMetrics
+
+func initPurgeMetrics() {
+	metrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/retention")
+		metrics.purged, _ = meter.Int64Counter(
+			"thunderid_retention_purged_total",
+			metric.WithDescription("Records permanently deleted after their retention by category and target"),
+		)
+	})
+}
+
+// RecordPurged records that count records of a retention category were purged. The target is the
+// table the records were deleted from, or the entity category for soft-deleted entities.
+func RecordPurged(ctx context.Context, category, target string, count int64) {
+	if count <= 0 {
+		return
+	}
+	initPurgeMetrics()
+	if metrics.purged == nil {
+		return
+	}
+	metrics.purged.Add(
+		ctx,
+		count,
+		metric.WithAttributes(
+			attribute.String("retention.category", category),
+			attribute.String("retention.target", target),
+		),
+	)
+}
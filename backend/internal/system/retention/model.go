@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	"time"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// Retention categories reported on the purged records metric.
+const (
+	// CategoryAuthorizationCode covers authorization codes and pending authorization requests.
+	CategoryAuthorizationCode = "authorization_code"
+	// CategorySession covers flow and passkey ceremony sessions.
+	CategorySession = "session"
+	// CategoryRuntimeData covers the other short-lived runtime records, such as replay protection
+	// records, verifiable credential nonces and email delivery events.
+	CategoryRuntimeData = "runtime_data"
+	// CategoryAuditEvent covers the delivered and failed audit events of the outbox.
+	CategoryAuditEvent = "audit_event"
+	// CategorySoftDeletedEntity covers the soft-deleted entities purged by the entity purger.
+	CategorySoftDeletedEntity = "soft_deleted_entity"
+)
+
+// purgeDatabase identifies the database a purge target lives in.
+type purgeDatabase int
+
+const (
+	purgeDatabaseRuntime purgeDatabase = iota
+	purgeDatabaseUser
+	purgeDatabaseOperation
+)
+
+// purgeTarget is a set of rows the purger deletes once they are past their retention.
+type purgeTarget struct {
+	category  string
+	table     string
+	database  purgeDatabase
+	query     dbmodel.DBQuery
+	retention time.Duration
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package retention purges expired runtime data and processed audit events once their retention
+// window has passed, so that the databases do not grow without bound.
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	purgerLoggerComponentName = "RetentionPurger"
+	// maxBatchesPerRun caps how many batches of one target are deleted per run, so a large backlog is
+	// drained over several runs instead of holding the loop and the database for a long time.
+	maxBatchesPerRun = 50
+)
+
+// Purger owns the background loop that deletes data past its retention. Start begins the loop and
+// Stop halts it during graceful shutdown.
+type Purger interface {
+	// Start begins the periodic purge loop. It returns immediately; purging runs in the background.
+	Start(ctx context.Context)
+	// Stop halts the purge loop and waits for an in-flight purge to finish.
+	Stop()
+}
+
+// purger periodically deletes the rows of every target that are past the target's retention, in
+// batches of batchSize rows. Several nodes may purge the same tables; a row deleted by one node is
+// simply not found by the others.
+type purger struct {
+	*PurgeLoop
+	store     purgeStoreInterface
+	targets   []purgeTarget
+	batchSize int
+	now       func() time.Time
+	logger    *log.Logger
+}
+
+// newPurger creates a purger that purges the targets every interval.
+func newPurger(store purgeStoreInterface, targets []purgeTarget, interval time.Duration,
+	batchSize int) *purger {
+	p := &purger{
+		store:     store,
+		targets:   targets,
+		batchSize: batchSize,
+		now:       func() time.Time { return time.Now().UTC() },
+		logger:    log.GetLogger().With(log.String(log.LoggerKeyComponentName, purgerLoggerComponentName)),
+	}
+	p.PurgeLoop = NewPurgeLoop(interval, p.purge)
+	return p
+}
+
+// purge purges every target. A failed target is logged and retried on the next run.
+func (p *purger) purge(ctx context.Context) {
+	now := p.now()
+	for _, target := range p.targets {
+		if ctx.Err() != nil {
+			return
+		}
+		p.purgeTarget(ctx, target, now.Add(-target.retention))
+	}
+}
+
+// purgeTarget deletes the rows of a target past the cutoff batch by batch until no full batch is
+// left or the per-run cap is reached.
+func (p *purger) purgeTarget(ctx context.Context, target purgeTarget, cutoff time.Time) {
+	var total int64
+	for batch := 0; batch < maxBatchesPerRun && ctx.Err() == nil; batch++ {
+		deleted, err := p.store.PurgeBatch(ctx, target, cutoff, p.batchSize)
+		if err != nil {
+			p.logger.Error(ctx, "Failed to purge expired records", log.String("category", target.category),
+				log.String("table", target.table), log.Error(err))
+			break
+		}
+		total += deleted
+		if deleted < int64(p.batchSize) {
+			break
+		}
+	}
+
+	RecordPurged(ctx, target.category, target.table, total)
+	if total > 0 {
+		p.logger.Debug(ctx, "Purged expired records", log.String("category", target.category),
+			log.String("table", target.table), log.Int("count", int(total)))
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+var testNow = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+func newTestPurger(t *testing.T, targets ...purgeTarget) (*purger, *purgeStoreInterfaceMock) {
+	store := newPurgeStoreInterfaceMock(t)
+	p := newPurger(store, targets, time.Minute, 10)
+	p.now = func() time.Time { return testNow }
+	return p, store
+}
+
+func TestPurger_Purge_UsesTargetRetention(t *testing.T) {
+	codes := purgeTarget{category: CategoryAuthorizationCode, table: "AUTHORIZATION_CODE",
+		query: queryPurgeAuthorizationCodes, retention: time.Hour}
+	events := purgeTarget{category: CategoryAuditEvent, table: "OUTBOX_EVENT", database: purgeDatabaseUser,
+		query: queryPurgeAuditEvents, retention: 90 * 24 * time.Hour}
+	p, store := newTestPurger(t, codes, events)
+	store.On("PurgeBatch", mock.Anything, codes, testNow.Add(-time.Hour), 10).Return(int64(4), nil).Once()
+	store.On("PurgeBatch", mock.Anything, events, testNow.Add(-90*24*time.Hour), 10).Return(int64(0), nil).Once()
+
+	p.purge(context.Background())
+}
+
+func TestPurger_Purge_DrainsFullBatches(t *testing.T) {
+	target := purgeTarget{category: CategorySession, table: "WEBAUTHN_SESSION", query: queryPurgeWebAuthnSessions}
+	p, store := newTestPurger(t, target)
+	store.On("PurgeBatch", mock.Anything, target, testNow, 10).Return(int64(10), nil).Twice()
+	store.On("PurgeBatch", mock.Anything, target, testNow, 10).Return(int64(3), nil).Once()
+
+	p.purge(context.Background())
+}
+
+func TestPurger_Purge_StopsAtBatchCap(t *testing.T) {
+	target := purgeTarget{category: CategoryRuntimeData, table: "JTI_RECORD", query: queryPurgeJTIRecords}
+	p, store := newTestPurger(t, target)
+	store.On("PurgeBatch", mock.Anything, target, testNow, 10).Return(int64(10), nil).Times(maxBatchesPerRun)
+
+	p.purge(context.Background())
+}
+
+func TestPurger_Purge_ContinuesAfterFailedTarget(t *testing.T) {
+	failing := purgeTarget{category: CategoryRuntimeData, table: "JTI_RECORD", query: queryPurgeJTIRecords}
+	next := purgeTarget{category: CategoryRuntimeData, table: "OPENID4VCI_NONCE", query: queryPurgeVCINonces}
+	p, store := newTestPurger(t, failing, next)
+	store.On("PurgeBatch", mock.Anything, failing, testNow, 10).Return(int64(0), errors.New("db error")).Once()
+	store.On("PurgeBatch", mock.Anything, next, testNow, 10).Return(int64(1), nil).Once()
+
+	p.purge(context.Background())
+}
+
+func TestPurger_StartStop(t *testing.T) {
+	p, _ := newTestPurger(t)
+	p.Start(context.Background())
+	p.Stop()
+	p.Stop()
+}
+
+func TestBuildTargets(t *testing.T) {
+	cfg := config.RetentionConfig{Enabled: true, PurgeIntervalMinutes: 60, BatchSize: 100,
+		AuthorizationCodeHours: 1, SessionHours: 24, RuntimeDataHours: 48, AuditEventDays: 90}
+
+	targets := buildTargets(cfg, "postgres")
+
+	retentionByCategory := map[string]time.Duration{}
+	for _, target := range targets {
+		retentionByCategory[target.category] = target.retention
+	}
+	require.Equal(t, map[string]time.Duration{
+		CategoryAuthorizationCode: time.Hour,
+		CategorySession:           24 * time.Hour,
+		CategoryRuntimeData:       48 * time.Hour,
+		CategoryAuditEvent:        90 * 24 * time.Hour,
+	}, retentionByCategory)
+	require.Len(t, targets, 14)
+}
+
+func TestBuildTargets_KeyValueRuntimeKeepsOnlySQLTargets(t *testing.T) {
+	for _, runtimeDBType := range []string{provider.DataSourceTypeRedis, provider.DataSourceTypeBolt} {
+		targets := buildTargets(config.RetentionConfig{RuntimeDataHours: 24, AuditEventDays: 30}, runtimeDBType)
+
+		require.Len(t, targets, 2, runtimeDBType)
+		require.Equal(t, "EMAIL_DELIVERY_EVENT", targets[0].table)
+		require.Equal(t, purgeDatabaseOperation, targets[0].database)
+		require.Equal(t, CategoryAuditEvent, targets[1].category)
+		require.Equal(t, purgeDatabaseUser, targets[1].database)
+	}
+}
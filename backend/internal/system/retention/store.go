@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// purgeStoreInterface defines the persistence operations for purging expired data.
+type purgeStoreInterface interface {
+	PurgeBatch(ctx context.Context, target purgeTarget, cutoff time.Time, limit int) (int64, error)
+}
+
+// purgeStore is the database-backed purge store.
+type purgeStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPurgeStore creates a new instance of purgeStore.
+func newPurgeStore() purgeStoreInterface {
+	return &purgeStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// PurgeBatch deletes up to limit rows of the target that are past the cutoff and returns how many
+// were deleted.
+func (s *purgeStore) PurgeBatch(ctx context.Context, target purgeTarget, cutoff time.Time,
+	limit int) (int64, error) {
+	var dbClient provider.DBClientInterface
+	var err error
+	switch target.database {
+	case purgeDatabaseUser:
+		dbClient, err = s.dbProvider.GetUserDBClient()
+	case purgeDatabaseOperation:
+		dbClient, err = s.dbProvider.GetOperationDBClient()
+	default:
+		dbClient, err = s.dbProvider.GetRuntimeDBClient()
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	deleted, err := dbClient.ExecuteContext(ctx, target.query, cutoff, s.deploymentID, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge %s: %w", target.table, err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// Every purge query takes the cutoff as $1, the deployment ID as $2 and the batch size as $3, and
+// deletes at most one batch of the rows that are past the cutoff.
+var (
+	// queryPurgeAuthorizationCodes deletes a batch of expired authorization codes.
+	queryPurgeAuthorizationCodes = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-01",
+		Query: `DELETE FROM "AUTHORIZATION_CODE" WHERE CODE_ID IN (SELECT CODE_ID FROM "AUTHORIZATION_CODE" ` +
+			`WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeAuthorizationRequests deletes a batch of expired authorization requests.
+	queryPurgeAuthorizationRequests = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-02",
+		Query: `DELETE FROM "AUTHORIZATION_REQUEST" WHERE DEPLOYMENT_ID = $2 AND AUTH_ID IN ` +
+			`(SELECT AUTH_ID FROM "AUTHORIZATION_REQUEST" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgePARRequests deletes a batch of expired pushed authorization requests.
+	queryPurgePARRequests = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-03",
+		Query: `DELETE FROM "PAR_REQUEST" WHERE REQUEST_URI IN (SELECT REQUEST_URI FROM "PAR_REQUEST" ` +
+			`WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeCIBARequests deletes a batch of expired CIBA authentication requests.
+	queryPurgeCIBARequests = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-04",
+		Query: `DELETE FROM "CIBA_AUTH_REQUEST" WHERE DEPLOYMENT_ID = $2 AND AUTH_REQ_ID IN ` +
+			`(SELECT AUTH_REQ_ID FROM "CIBA_AUTH_REQUEST" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeWebAuthnSessions deletes a batch of expired passkey ceremony sessions.
+	queryPurgeWebAuthnSessions = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-05",
+		Query: `DELETE FROM "WEBAUTHN_SESSION" WHERE DEPLOYMENT_ID = $2 AND SESSION_KEY IN ` +
+			`(SELECT SESSION_KEY FROM "WEBAUTHN_SESSION" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeJTIRecords deletes a batch of expired replay protection records.
+	queryPurgeJTIRecords = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-06",
+		Query: `DELETE FROM "JTI_RECORD" WHERE DEPLOYMENT_ID = $2 AND (NAMESPACE, JTI) IN ` +
+			`(SELECT NAMESPACE, JTI FROM "JTI_RECORD" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeVPRequestStates deletes a batch of expired OpenID4VP request states.
+	queryPurgeVPRequestStates = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-07",
+		Query: `DELETE FROM "OPENID4VP_REQUEST_STATE" WHERE STATE IN (SELECT STATE FROM "OPENID4VP_REQUEST_STATE" ` +
+			`WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeVCINonces deletes a batch of expired OpenID4VCI nonces.
+	queryPurgeVCINonces = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-08",
+		Query: `DELETE FROM "OPENID4VCI_NONCE" WHERE NONCE IN (SELECT NONCE FROM "OPENID4VCI_NONCE" ` +
+			`WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeVCIOffers deletes a batch of expired OpenID4VCI credential offers.
+	queryPurgeVCIOffers = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-09",
+		Query: `DELETE FROM "OPENID4VCI_CREDENTIAL_OFFER" WHERE ID IN (SELECT ID FROM "OPENID4VCI_CREDENTIAL_OFFER" ` +
+			`WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeRuntimeAuthorizationEntries deletes a batch of expired runtime store entries of the
+	// authorization code, authorization request, PAR and CIBA namespaces.
+	queryPurgeRuntimeAuthorizationEntries = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-10",
+		Query: `DELETE FROM "RUNTIME_STORE" WHERE DEPLOYMENT_ID = $2 AND (NAMESPACE, KEY) IN ` +
+			`(SELECT NAMESPACE, KEY FROM "RUNTIME_STORE" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 ` +
+			`AND NAMESPACE IN ('authz:code', 'authz:req', 'par:req', 'ciba:req') LIMIT $3)`,
+	}
+
+	// queryPurgeRuntimeFlowEntries deletes a batch of expired flow sessions from the runtime store.
+	queryPurgeRuntimeFlowEntries = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-11",
+		Query: `DELETE FROM "RUNTIME_STORE" WHERE DEPLOYMENT_ID = $2 AND (NAMESPACE, KEY) IN ` +
+			`(SELECT NAMESPACE, KEY FROM "RUNTIME_STORE" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 ` +
+			`AND NAMESPACE = 'flow:state' LIMIT $3)`,
+	}
+
+	// queryPurgeRuntimeOtherEntries deletes a batch of expired runtime store entries of the namespaces
+	// not covered by the authorization and flow queries.
+	queryPurgeRuntimeOtherEntries = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-12",
+		Query: `DELETE FROM "RUNTIME_STORE" WHERE DEPLOYMENT_ID = $2 AND (NAMESPACE, KEY) IN ` +
+			`(SELECT NAMESPACE, KEY FROM "RUNTIME_STORE" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 ` +
+			`AND NAMESPACE NOT IN ('authz:code', 'authz:req', 'par:req', 'ciba:req', 'flow:state') LIMIT $3)`,
+	}
+
+	// queryPurgeAuditEvents deletes a batch of delivered or failed outbox events created before the
+	// cutoff. Pending events are kept until the dispatcher settles them.
+	queryPurgeAuditEvents = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-13",
+		Query: `DELETE FROM "OUTBOX_EVENT" WHERE DEPLOYMENT_ID = $2 AND ID IN (SELECT ID FROM "OUTBOX_EVENT" ` +
+			`WHERE STATUS IN ('DELIVERED', 'FAILED') AND CREATED_AT < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+
+	// queryPurgeEmailDeliveryEvents deletes a batch of expired email delivery events.
+	queryPurgeEmailDeliveryEvents = dbmodel.DBQuery{
+		ID: "RTNQ-PURGE-14",
+		Query: `DELETE FROM "EMAIL_DELIVERY_EVENT" WHERE DEPLOYMENT_ID = $2 AND ID IN (SELECT ID FROM ` +
+			`"EMAIL_DELIVERY_EVENT" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2 LIMIT $3)`,
+	}
+)
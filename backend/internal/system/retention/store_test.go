@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	cutoff         time.Time
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *purgeStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.cutoff = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &purgeStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *StoreTestSuite) TestPurgeBatch_RuntimeTarget() {
+	target := purgeTarget{category: CategoryAuthorizationCode, table: "AUTHORIZATION_CODE",
+		database: purgeDatabaseRuntime, query: queryPurgeAuthorizationCodes}
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryPurgeAuthorizationCodes, suite.cutoff,
+		testDeploymentID, 100).Return(int64(42), nil)
+
+	deleted, err := suite.store.PurgeBatch(suite.ctx, target, suite.cutoff, 100)
+
+	suite.NoError(err)
+	suite.Equal(int64(42), deleted)
+}
+
+func (suite *StoreTestSuite) TestPurgeBatch_UserTarget() {
+	target := purgeTarget{category: CategoryAuditEvent, table: "OUTBOX_EVENT", database: purgeDatabaseUser,
+		query: queryPurgeAuditEvents}
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryPurgeAuditEvents, suite.cutoff,
+		testDeploymentID, 100).Return(int64(3), nil)
+
+	deleted, err := suite.store.PurgeBatch(suite.ctx, target, suite.cutoff, 100)
+
+	suite.NoError(err)
+	suite.Equal(int64(3), deleted)
+}
+
+func (suite *StoreTestSuite) TestPurgeBatch_OperationTarget() {
+	target := purgeTarget{category: CategoryRuntimeData, table: "EMAIL_DELIVERY_EVENT",
+		database: purgeDatabaseOperation, query: queryPurgeEmailDeliveryEvents}
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryPurgeEmailDeliveryEvents, suite.cutoff,
+		testDeploymentID, 100).Return(int64(7), nil)
+
+	deleted, err := suite.store.PurgeBatch(suite.ctx, target, suite.cutoff, 100)
+
+	suite.NoError(err)
+	suite.Equal(int64(7), deleted)
+}
+
+func (suite *StoreTestSuite) TestPurgeBatch_ClientError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("no client"))
+
+	_, err := suite.store.PurgeBatch(suite.ctx, purgeTarget{query: queryPurgeJTIRecords}, suite.cutoff, 100)
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestPurgeBatch_ExecuteError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryPurgeJTIRecords, mock.Anything, mock.Anything,
+		mock.Anything).Return(int64(0), errors.New("db error"))
+
+	_, err := suite.store.PurgeBatch(suite.ctx, purgeTarget{table: "JTI_RECORD", query: queryPurgeJTIRecords},
+		suite.cutoff, 100)
+
+	suite.ErrorContains(err, "JTI_RECORD")
+}
@@ -45,11 +45,12 @@ func newJWTAuthenticator(jwtService jwt.JWTServiceInterface) *jwtAuthenticator {
 	}
 }
 
-// CanHandle checks if the request contains a Bearer token in the Authorization header.
+// CanHandle checks if the request contains a Bearer token in the Authorization header, or a bearer
+// subprotocol on a WebSocket handshake to the admin notifications endpoint.
 // RFC 7235 §2.1: The authentication scheme token is case-insensitive.
 func (h *jwtAuthenticator) CanHandle(r *http.Request) bool {
 	authHeader := r.Header.Get(constants.AuthorizationHeaderName)
-	return utils.HasPrefixFold(authHeader, constants.AuthSchemeBearer)
+	return utils.HasPrefixFold(authHeader, constants.AuthSchemeBearer) || extractWebSocketToken(r) != ""
 }
 
 // Authenticate validates the JWT token and builds a SecurityContext.
@@ -59,7 +60,9 @@ func (h *jwtAuthenticator) Authenticate(r *http.Request) (*SecurityContext, erro
 	authHeader := r.Header.Get(constants.AuthorizationHeaderName)
 	token, err := extractToken(authHeader)
 	if err != nil {
-		return nil, err
+		if token = extractWebSocketToken(r); token == "" {
+			return nil, err
+		}
 	}
 
 	if token == "" {
@@ -169,6 +172,24 @@ func extractToken(authHeader string) (string, error) {
 	return token, nil
 }
 
+// extractWebSocketToken extracts the bearer token offered as a subprotocol of a WebSocket handshake to the
+// admin notifications endpoint. It returns an empty string for any other request, or when the handshake
+// offers no bearer subprotocol.
+func extractWebSocketToken(r *http.Request) string {
+	if r.URL.Path != constants.AdminNotificationsPath || !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return ""
+	}
+	for _, value := range r.Header.Values(constants.WebSocketProtocolHeaderName) {
+		for _, protocol := range strings.Split(value, ",") {
+			protocol = strings.TrimSpace(protocol)
+			if strings.HasPrefix(protocol, constants.WebSocketBearerProtocolPrefix) {
+				return strings.TrimPrefix(protocol, constants.WebSocketBearerProtocolPrefix)
+			}
+		}
+	}
+	return ""
+}
+
 // extractIssuer returns the iss claim of the token, or an empty string if the
 // token payload cannot be decoded or carries no string iss claim.
 func extractIssuer(token string) string {
@@ -240,6 +240,59 @@ func (suite *JWTAuthenticatorTestSuite) TestAuthenticate() {
 	}
 }
 
+func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_WebSocketBearerProtocol() {
+	// Payload: {"sub":"user123","scope":"system users:read","ouId":"ou1","app_id":"app1"}
+	//nolint:gosec,lll // Test data, not a real credential
+	validToken := "eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiJ1c2VyMTIzIiwic2NvcGUiOiJzeXN0ZW0gdXNlcnM6cmVhZCIsIm91SWQiOiJvdTEiLCJhcHBfaWQiOiJhcHAxIn0.signature"
+	suite.mockJWT.On("VerifyJWT", mock.Anything, validToken, "", "").Return(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin-notifications", nil)
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Protocol", "admin-notifications.v1, bearer."+validToken)
+
+	suite.True(suite.authenticator.CanHandle(req))
+	securityCtx, err := suite.authenticator.Authenticate(req)
+	suite.NoError(err)
+	suite.Equal("user123", GetSubject(withSecurityContext(context.Background(), securityCtx)))
+}
+
+func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_WebSocketBearerProtocolOnOtherPath() {
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Protocol", "admin-notifications.v1, bearer.token123")
+
+	suite.False(suite.authenticator.CanHandle(req))
+	securityCtx, err := suite.authenticator.Authenticate(req)
+	suite.Nil(securityCtx)
+	suite.ErrorIs(err, errMissingAuthHeader)
+	suite.mockJWT.AssertNotCalled(suite.T(), "VerifyJWT", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *JWTAuthenticatorTestSuite) TestCanHandle_WebSocketBearerProtocol() {
+	tests := []struct {
+		name     string
+		path     string
+		upgrade  string
+		protocol string
+		expected bool
+	}{
+		{"Bearer protocol", "/admin-notifications", "websocket", "admin-notifications.v1, bearer.token123", true},
+		{"No bearer protocol", "/admin-notifications", "websocket", "admin-notifications.v1", false},
+		{"Not a WebSocket handshake", "/admin-notifications", "", "bearer.token123", false},
+		{"Other path", "/users", "websocket", "admin-notifications.v1, bearer.token123", false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Upgrade", tt.upgrade)
+			req.Header.Set("Sec-WebSocket-Protocol", tt.protocol)
+
+			suite.Equal(tt.expected, suite.authenticator.CanHandle(req))
+		})
+	}
+}
+
 func (suite *JWTAuthenticatorTestSuite) TestExtractPermissionsFromJWTClaims() {
 	tests := []struct {
 		name                string
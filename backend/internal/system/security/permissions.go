@@ -38,6 +38,9 @@ var directAuthPaths = []string{
 var publicPaths = append([]string{
 	"/health/**",
 	"/flow/execute/**",
+	// The flow execution event stream only reports the status of the execution. Resuming the flow still
+	// goes through the flow execute endpoint.
+	"/flow-executions/*/events",
 	"/flow/meta",
 	"/oauth2/**",
 	// OpenID4VP wallet- and RP-facing endpoints are public; management endpoints
@@ -57,6 +60,9 @@ var publicPaths = append([]string{
 	"/.well-known/openid-credential-issuer",
 	"/.well-known/oauth-authorization-server/**",
 	"/.well-known/oauth-protected-resource",
+	"/.well-known/security.txt",
+	"/system/info/public",
+	"/api-docs",
 	"/gate/**",
 	"/console/**",
 	"/error/**",
@@ -65,8 +71,17 @@ var publicPaths = append([]string{
 	"/i18n/languages/*/translations/resolve",
 	"/i18n/languages/*/translations/ns/*/keys/*/resolve",
 	"/mcp/**", // MCP authorization is handled at MCP server handler.
+	// Email provider delivery status callbacks are authorized by the configured callback token.
+	"/email/delivery-status/*",
+	// Push approval decisions are authorized by the device secret of the deciding device.
+	"/push-auth/challenges/*/decision",
 }, directAuthPaths...)
 
+// PublicPaths returns a copy of the path patterns that are exempt from authentication.
+func PublicPaths() []string {
+	return append([]string{}, publicPaths...)
+}
+
 // ---- Resource types ----
 
 // ResourceType defines the category of system resource being acted upon.
@@ -115,6 +130,14 @@ const (
 	ActionDeleteUser Action = "user:delete"
 	// ActionListUsers lists users.
 	ActionListUsers Action = "user:list"
+	// ActionReadSensitiveUserAttributes reads the sensitive attributes of a user.
+	ActionReadSensitiveUserAttributes Action = "user:read-sensitive"
+	// ActionExportUserData exports all personal data held about a user. Not listed in the action
+	// permission map, so only root callers may perform it.
+	ActionExportUserData Action = "user:export-data"
+	// ActionEraseUser erases a user and their personal data. Not listed in the action permission map,
+	// so only root callers may perform it.
+	ActionEraseUser Action = "user:erase"
 
 	// ActionCreateGroup creates a new group.
 	ActionCreateGroup Action = "group:create"
@@ -148,6 +171,9 @@ const (
 	ActionDeleteAgentType Action = "agenttype:delete"
 	// ActionListAgentTypes lists agent types.
 	ActionListAgentTypes Action = "agenttype:list"
+
+	// ActionListApplications lists applications.
+	ActionListApplications Action = "application:list"
 )
 
 // ---- Permissions ----
@@ -160,6 +186,7 @@ type SystemPermissions struct {
 	OUView        string
 	User          string
 	UserView      string
+	UserSensitive string
 	Group         string
 	GroupView     string
 	UserType      string
@@ -193,6 +220,7 @@ func InitSystemPermissions(handle string) {
 		OUView:        buildPermission(handle, "system", "ou", "view"),
 		User:          buildPermission(handle, "system", "user"),
 		UserView:      buildPermission(handle, "system", "user", "view"),
+		UserSensitive: buildPermission(handle, "system", "user", "sensitive"),
 		Group:         buildPermission(handle, "system", "group"),
 		GroupView:     buildPermission(handle, "system", "group", "view"),
 		UserType:      buildPermission(handle, "system", "usertype"),
@@ -212,11 +240,12 @@ func InitSystemPermissions(handle string) {
 		ActionListChildOUs: p.OU,
 
 		// User actions.
-		ActionCreateUser: p.User,
-		ActionReadUser:   p.UserView,
-		ActionUpdateUser: p.User,
-		ActionDeleteUser: p.User,
-		ActionListUsers:  p.UserView,
+		ActionCreateUser:                  p.User,
+		ActionReadUser:                    p.UserView,
+		ActionUpdateUser:                  p.User,
+		ActionDeleteUser:                  p.User,
+		ActionListUsers:                   p.UserView,
+		ActionReadSensitiveUserAttributes: p.UserSensitive,
 
 		// Group actions.
 		ActionCreateGroup: p.Group,
@@ -247,7 +276,14 @@ func InitSystemPermissions(handle string) {
 		{"PUT /users/me", ""},
 		{"GET /users/me/**", ""},
 		{"PUT /users/me/**", ""},
+		{"PATCH /users/me/profile", ""},
 		{"POST /users/me/update-credentials", ""},
+		{"POST /users/me/password", ""},
+		{"DELETE /users/me/devices/*", ""},
+		{"POST /users/me/push-devices", ""},
+		{"DELETE /users/me/push-devices/*", ""},
+		{"POST /users/me/cross-device-requests/*/claim", ""},
+		{"POST /users/me/cross-device-requests/*/decision", ""},
 		{"GET /register/passkey/**", ""},
 		{"POST /register/passkey/**", ""},
 
@@ -264,9 +300,15 @@ func InitSystemPermissions(handle string) {
 		// User APIs.
 		{"GET /users", p.UserView},
 		{"POST /users", p.User},
+		{"POST /users/bulk", p.User},
+		{"GET /users/*/gdpr-export", p.Root},
 		{"GET /users/**", p.UserView},
 		{"PUT /users/**", p.User},
 		{"DELETE /users/**", p.User},
+		{"POST /users/*/lock", p.User},
+		{"POST /users/*/unlock", p.User},
+		{"POST /users/*/disable", p.User},
+		{"POST /users/*/enable", p.User},
 
 		// Group APIs.
 		{"GET /groups", p.GroupView},
@@ -290,6 +332,15 @@ func InitSystemPermissions(handle string) {
 		{"PUT /agent-types/**", p.AgentType},
 		{"DELETE /agent-types/**", p.AgentType},
 
+		// Job status API — any authenticated user; the job service only returns the caller's own jobs.
+		{"GET /jobs/*", ""},
+
+		// Search API — any authenticated user; each result type is filtered by the caller's permissions.
+		{"GET /search", ""},
+
+		// GraphQL API — any authenticated user; each field is authorized with the permission it requires.
+		{"POST /graphql", ""},
+
 		// Import APIs.
 		{"POST /import", p.Root},
 		{"POST /import/delete", p.Root},
@@ -220,6 +220,10 @@ func (s *SecurityContextTestSuite) TestResolveActionPermission() {
 		{name: "UpdateUser", action: ActionUpdateUser, wantPerm: p.User},
 		{name: "DeleteUser", action: ActionDeleteUser, wantPerm: p.User},
 		{name: "ListUsers", action: ActionListUsers, wantPerm: p.UserView},
+		{name: "ReadSensitiveUserAttributes", action: ActionReadSensitiveUserAttributes,
+			wantPerm: p.UserSensitive},
+		{name: "ExportUserData_RootOnly", action: ActionExportUserData, wantPerm: p.Root},
+		{name: "EraseUser_RootOnly", action: ActionEraseUser, wantPerm: p.Root},
 
 		// Group actions.
 		{name: "CreateGroup", action: ActionCreateGroup, wantPerm: p.Group},
@@ -282,6 +286,7 @@ func TestInitSystemPermissions_EmptyHandle(t *testing.T) {
 	assert.Equal(t, "system:ou:view", p.OUView)
 	assert.Equal(t, "system:user", p.User)
 	assert.Equal(t, "system:user:view", p.UserView)
+	assert.Equal(t, "system:user:sensitive", p.UserSensitive)
 	assert.Equal(t, "system:group", p.Group)
 	assert.Equal(t, "system:group:view", p.GroupView)
 	assert.Equal(t, "system:usertype", p.UserType)
@@ -300,6 +305,7 @@ func TestInitSystemPermissions_NonEmptyHandle(t *testing.T) {
 	assert.Equal(t, "mgmt:system:ou:view", p.OUView)
 	assert.Equal(t, "mgmt:system:user", p.User)
 	assert.Equal(t, "mgmt:system:user:view", p.UserView)
+	assert.Equal(t, "mgmt:system:user:sensitive", p.UserSensitive)
 	assert.Equal(t, "mgmt:system:group", p.Group)
 	assert.Equal(t, "mgmt:system:group:view", p.GroupView)
 	assert.Equal(t, "mgmt:system:usertype", p.UserType)
@@ -358,6 +364,15 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 		{name: "GET /groups exact", method: http.MethodGet, path: "/groups", wantPerm: p.GroupView},
 		{name: "POST /groups exact", method: http.MethodPost, path: "/groups", wantPerm: p.Group},
 
+		{
+			name:   "POST /users/{id}/disable account state",
+			method: http.MethodPost, path: "/users/u-123/disable", wantPerm: p.User,
+		},
+		{
+			name:   "POST /users/{id}/unlock account state",
+			method: http.MethodPost, path: "/users/u-123/unlock", wantPerm: p.User,
+		},
+
 		// ---- Self-service paths (empty permission = any authenticated user) ----
 		{name: "GET /users/me self-service", method: http.MethodGet, path: "/users/me", wantPerm: ""},
 		{name: "PUT /users/me self-service", method: http.MethodPut, path: "/users/me", wantPerm: ""},
@@ -367,6 +382,30 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			path:     "/users/me/update-credentials",
 			wantPerm: "",
 		},
+		{
+			name:   "PATCH /users/me/profile self-service",
+			method: http.MethodPatch, path: "/users/me/profile", wantPerm: "",
+		},
+		{
+			name:   "POST /users/me/password self-service",
+			method: http.MethodPost, path: "/users/me/password", wantPerm: "",
+		},
+		{
+			name:   "POST /users/me/push-devices self-service",
+			method: http.MethodPost, path: "/users/me/push-devices", wantPerm: "",
+		},
+		{
+			name:   "DELETE /users/me/push-devices/{deviceId} self-service",
+			method: http.MethodDelete, path: "/users/me/push-devices/d-1", wantPerm: "",
+		},
+		{
+			name:   "POST /users/me/cross-device-requests/{reference}/claim self-service",
+			method: http.MethodPost, path: "/users/me/cross-device-requests/ref-1/claim", wantPerm: "",
+		},
+		{
+			name:   "POST /users/me/cross-device-requests/{reference}/decision self-service",
+			method: http.MethodPost, path: "/users/me/cross-device-requests/ref-1/decision", wantPerm: "",
+		},
 		{
 			name:   "GET /register/passkey/start self-service",
 			method: http.MethodGet, path: "/register/passkey/start", wantPerm: "",
@@ -393,6 +432,10 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			name:   "GET /users/{id} prefix",
 			method: http.MethodGet, path: "/users/user-456", wantPerm: p.UserView,
 		},
+		{
+			name:   "GET /users/{id}/gdpr-export requires root",
+			method: http.MethodGet, path: "/users/user-456/gdpr-export", wantPerm: p.Root,
+		},
 		{
 			name:   "PUT /users/{id} prefix",
 			method: http.MethodPut, path: "/users/user-456", wantPerm: p.User,
@@ -417,6 +460,11 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			method: http.MethodGet, path: "/users/me/profile", wantPerm: "",
 		},
 
+		// ---- Bulk operations and job status ----
+		{name: "POST /users/bulk", method: http.MethodPost, path: "/users/bulk", wantPerm: p.User},
+		{name: "GET /jobs/{id} any caller", method: http.MethodGet, path: "/jobs/job-1", wantPerm: ""},
+		{name: "GET /search any caller", method: http.MethodGet, path: "/search", wantPerm: ""},
+
 		// ---- OU tree paths ----
 		{
 			name:   "GET /organization-units/tree",
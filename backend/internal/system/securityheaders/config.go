@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securityheaders
+
+// Config holds the security header settings, mapped by the caller from the deployment server
+// security_headers config. It is intentionally decoupled from system/config so this package does
+// not depend on the global configuration type.
+type Config struct {
+	// Enabled turns the middleware on. When false, responses carry only the headers set by handlers.
+	Enabled bool
+	// HSTSMaxAgeSeconds is the Strict-Transport-Security max-age. Zero omits the header.
+	HSTSMaxAgeSeconds int
+	// HSTSIncludeSubdomains adds the includeSubDomains directive to Strict-Transport-Security.
+	HSTSIncludeSubdomains bool
+	// ContentSecurityPolicy is the default Content-Security-Policy. Empty omits the header.
+	ContentSecurityPolicy string
+	// ReferrerPolicy is the default Referrer-Policy. Empty omits the header.
+	ReferrerPolicy string
+	// Overrides replace the default policies for matching paths. The first matching override wins.
+	Overrides []Override
+}
+
+// Override replaces the default policies for requests whose path matches one of Paths. An empty
+// policy keeps the default value.
+type Override struct {
+	Paths                 []string
+	ContentSecurityPolicy string
+	ReferrerPolicy        string
+}
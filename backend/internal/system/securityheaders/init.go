@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package securityheaders provides the HTTP middleware that sets browser security headers
+// (Strict-Transport-Security, X-Content-Type-Options, Referrer-Policy, and Content-Security-Policy)
+// on every server response.
+package securityheaders
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Initialize builds the security headers middleware from cfg. When the headers are disabled it
+// returns a pass-through middleware so the request hot path is unaffected. An override without
+// paths, or with a path that does not start with "/", returns a non-nil error.
+func Initialize(cfg Config) (func(http.Handler) http.Handler, error) {
+	if !cfg.Enabled {
+		return func(next http.Handler) http.Handler { return next }, nil
+	}
+
+	for i, o := range cfg.Overrides {
+		if len(o.Paths) == 0 {
+			return nil, fmt.Errorf("%w: override %d has no paths", errInvalidOverride, i)
+		}
+		for _, p := range o.Paths {
+			if !strings.HasPrefix(p, "/") {
+				return nil, fmt.Errorf("%w: %q", errInvalidOverride, p)
+			}
+		}
+	}
+
+	w := &writer{
+		contentSecurityPolicy: cfg.ContentSecurityPolicy,
+		referrerPolicy:        cfg.ReferrerPolicy,
+		overrides:             cfg.Overrides,
+	}
+	if cfg.HSTSMaxAgeSeconds > 0 {
+		w.hsts = "max-age=" + strconv.Itoa(cfg.HSTSMaxAgeSeconds)
+		if cfg.HSTSIncludeSubdomains {
+			w.hsts += "; includeSubDomains"
+		}
+	}
+	return w.middleware, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securityheaders
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SecurityHeadersTestSuite struct {
+	suite.Suite
+}
+
+func TestSecurityHeadersSuite(t *testing.T) {
+	suite.Run(t, new(SecurityHeadersTestSuite))
+}
+
+// serve runs a GET request for path through the middleware built from cfg. handler, when non-nil,
+// runs as the downstream handler.
+func (suite *SecurityHeadersTestSuite) serve(cfg Config, path string,
+	handler http.HandlerFunc) *httptest.ResponseRecorder {
+	mw, err := Initialize(cfg)
+	suite.Require().NoError(err)
+	if handler == nil {
+		handler = func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	}
+	rec := httptest.NewRecorder()
+	mw(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func testConfig() Config {
+	return Config{
+		Enabled:               true,
+		HSTSMaxAgeSeconds:     31536000,
+		HSTSIncludeSubdomains: true,
+		ContentSecurityPolicy: "default-src 'none'",
+		ReferrerPolicy:        "no-referrer",
+		Overrides: []Override{{
+			Paths:                 []string{"/gate/**"},
+			ContentSecurityPolicy: "default-src 'self'",
+		}},
+	}
+}
+
+func (suite *SecurityHeadersTestSuite) TestInitialize_DisabledPassesThrough() {
+	rec := suite.serve(Config{ContentSecurityPolicy: "default-src 'none'"}, "/users", nil)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Empty(rec.Header().Get(headerContentTypeOptions))
+	suite.Empty(rec.Header().Get("Content-Security-Policy"))
+}
+
+func (suite *SecurityHeadersTestSuite) TestInitialize_InvalidOverride() {
+	for _, o := range []Override{{}, {Paths: []string{"gate/**"}}} {
+		_, err := Initialize(Config{Enabled: true, Overrides: []Override{o}})
+		suite.ErrorIs(err, errInvalidOverride)
+	}
+}
+
+func (suite *SecurityHeadersTestSuite) TestDefaults() {
+	rec := suite.serve(testConfig(), "/oauth2/token", nil)
+	suite.Equal("max-age=31536000; includeSubDomains", rec.Header().Get(headerStrictTransportSecurity))
+	suite.Equal("nosniff", rec.Header().Get(headerContentTypeOptions))
+	suite.Equal("no-referrer", rec.Header().Get(headerReferrerPolicy))
+	suite.Equal("default-src 'none'", rec.Header().Get("Content-Security-Policy"))
+}
+
+func (suite *SecurityHeadersTestSuite) TestOverrideReplacesOnlyConfiguredPolicies() {
+	rec := suite.serve(testConfig(), "/gate/signin", nil)
+	suite.Equal("default-src 'self'", rec.Header().Get("Content-Security-Policy"))
+	suite.Equal("no-referrer", rec.Header().Get(headerReferrerPolicy))
+
+	rec = suite.serve(testConfig(), "/gate", nil)
+	suite.Equal("default-src 'self'", rec.Header().Get("Content-Security-Policy"))
+}
+
+func (suite *SecurityHeadersTestSuite) TestEmptyValuesOmitHeaders() {
+	rec := suite.serve(Config{Enabled: true}, "/users", nil)
+	suite.Empty(rec.Header().Get(headerStrictTransportSecurity))
+	suite.Empty(rec.Header().Get(headerReferrerPolicy))
+	suite.Empty(rec.Header().Get("Content-Security-Policy"))
+	suite.Equal("nosniff", rec.Header().Get(headerContentTypeOptions))
+
+	cfg := Config{Enabled: true, HSTSMaxAgeSeconds: 600}
+	rec = suite.serve(cfg, "/users", nil)
+	suite.Equal("max-age=600", rec.Header().Get(headerStrictTransportSecurity))
+}
+
+func (suite *SecurityHeadersTestSuite) TestHandlerValueWins() {
+	rec := suite.serve(testConfig(), "/oauth2/authorize", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+		w.WriteHeader(http.StatusFound)
+	})
+	suite.Equal("frame-ancestors 'none'", rec.Header().Get("Content-Security-Policy"))
+}
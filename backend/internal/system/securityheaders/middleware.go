@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securityheaders
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	headerStrictTransportSecurity = "Strict-Transport-Security"
+	headerContentTypeOptions      = "X-Content-Type-Options"
+	headerReferrerPolicy          = "Referrer-Policy"
+	contentTypeOptionsNoSniff     = "nosniff"
+)
+
+// writer sets the configured security headers on responses.
+type writer struct {
+	hsts                  string
+	contentSecurityPolicy string
+	referrerPolicy        string
+	overrides             []Override
+}
+
+// middleware wraps next so that every response carries the security headers. The headers are set
+// before next runs, so a handler that sets one of them itself keeps its own value.
+func (s *writer) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := w.Header()
+		if s.hsts != "" {
+			h.Set(headerStrictTransportSecurity, s.hsts)
+		}
+		h.Set(headerContentTypeOptions, contentTypeOptionsNoSniff)
+
+		csp, referrer := s.policiesFor(r.URL.Path)
+		if csp != "" {
+			h.Set(constants.ContentSecurityPolicyHeaderName, csp)
+		}
+		if referrer != "" {
+			h.Set(headerReferrerPolicy, referrer)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// policiesFor returns the Content-Security-Policy and Referrer-Policy for requestPath, applying the
+// first override whose paths match.
+func (s *writer) policiesFor(requestPath string) (csp, referrer string) {
+	csp, referrer = s.contentSecurityPolicy, s.referrerPolicy
+	for _, o := range s.overrides {
+		if !matchesAny(o.Paths, requestPath) {
+			continue
+		}
+		if o.ContentSecurityPolicy != "" {
+			csp = o.ContentSecurityPolicy
+		}
+		if o.ReferrerPolicy != "" {
+			referrer = o.ReferrerPolicy
+		}
+		break
+	}
+	return csp, referrer
+}
+
+// matchesAny reports whether requestPath matches any of the path patterns.
+func matchesAny(patterns []string, requestPath string) bool {
+	for _, pattern := range patterns {
+		if utils.MatchPathPattern(pattern, requestPath) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package selfcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// ConfigChecks returns the checks of the server configuration: the databases are reachable, the key and
+// certificate files load, the gate client URL is valid and the listeners do not share a port.
+func ConfigChecks(runtime *config.ServerRuntime) []CheckFunc {
+	return []CheckFunc{
+		func(context.Context) []Finding { return checkDatabases(&runtime.Config) },
+		func(context.Context) []Finding { return checkKeys(runtime.ServerHome, &runtime.Config) },
+		func(context.Context) []Finding { return checkGateClient(&runtime.Config) },
+		func(context.Context) []Finding { return checkPorts(&runtime.Config) },
+	}
+}
+
+// checkDatabases verifies that each configured data source can be reached. The operation database is
+// optional and is checked only when a type is configured.
+func checkDatabases(cfg *config.Config) []Finding {
+	dataSources := []struct {
+		name       string
+		dataSource config.DataSource
+	}{
+		{"config", cfg.Database.Config},
+		{"runtime", cfg.Database.Runtime},
+		{"user", cfg.Database.User},
+	}
+	if cfg.Database.Operation.Type != "" {
+		dataSources = append(dataSources, struct {
+			name       string
+			dataSource config.DataSource
+		}{"operation", cfg.Database.Operation})
+	}
+
+	var findings []Finding
+	for _, ds := range dataSources {
+		if err := provider.CheckDataSource(ds.dataSource, ds.name); err != nil {
+			findings = append(findings, Finding{Check: CheckDatabase, Message: fmt.Sprintf(
+				"%v; check database.%s in the deployment configuration", err, ds.name)})
+		}
+	}
+	return findings
+}
+
+// checkKeys verifies that every configured signing key, and the TLS certificate when TLS is enabled,
+// loads from its files.
+func checkKeys(serverHome string, cfg *config.Config) []Finding {
+	var findings []Finding
+	for i, key := range cfg.Crypto.Keys {
+		if err := loadKeyPair(serverHome, key.CertFile, key.KeyFile); err != nil {
+			findings = append(findings, Finding{Check: CheckKeys, Message: fmt.Sprintf(
+				"crypto.keys[%d] (id %q): %v; check that cert_file and key_file exist, are readable "+
+					"and belong together", i, key.ID, err)})
+		}
+	}
+	if !cfg.Server.HTTPOnly {
+		if err := loadKeyPair(serverHome, cfg.TLS.CertFile, cfg.TLS.KeyFile); err != nil {
+			findings = append(findings, Finding{Check: CheckKeys, Message: fmt.Sprintf(
+				"tls: %v; check tls.cert_file and tls.key_file, or set server.http_only when TLS is "+
+					"terminated elsewhere", err)})
+		}
+	}
+	return findings
+}
+
+// loadKeyPair loads a certificate and private key from files relative to the server home.
+func loadKeyPair(serverHome, certFile, keyFile string) error {
+	if certFile == "" || keyFile == "" {
+		return fmt.Errorf("certificate or key file is not configured")
+	}
+	certPath := path.Join(serverHome, certFile)
+	keyPath := path.Join(serverHome, keyFile)
+	if _, err := tls.LoadX509KeyPair(certPath, keyPath); err != nil {
+		return fmt.Errorf("failed to load key pair from %s and %s: %w", certPath, keyPath, err)
+	}
+	return nil
+}
+
+// checkGateClient verifies that the gate client settings form a valid browser-reachable URL, since the
+// login, error and callback redirects are built from them.
+func checkGateClient(cfg *config.Config) []Finding {
+	gc := cfg.GateClient
+	var findings []Finding
+	add := func(format string, args ...interface{}) {
+		findings = append(findings, Finding{Check: CheckGateClient, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if gc.Scheme != "http" && gc.Scheme != "https" {
+		add("gate_client.scheme %q is not supported; use http or https", gc.Scheme)
+	}
+	if strings.TrimSpace(gc.Hostname) == "" {
+		add("gate_client.hostname is empty; set server.public_url or gate_client.hostname")
+	}
+	if gc.Port < 1 || gc.Port > 65535 {
+		add("gate_client.port %d is out of range; use a port between 1 and 65535", gc.Port)
+	}
+	paths := []struct {
+		name  string
+		value string
+	}{
+		{"path", gc.Path},
+		{"login_path", gc.LoginPath},
+		{"error_path", gc.ErrorPath},
+		{"callback_path", gc.CallbackPath},
+	}
+	for _, p := range paths {
+		if p.value == "" {
+			continue
+		}
+		parsed, err := url.Parse(p.value)
+		if err != nil || parsed.Scheme != "" || parsed.Host != "" || !strings.HasPrefix(parsed.Path, "/") {
+			add("gate_client.%s %q is not a valid absolute path; use a path such as /gate/signin", p.name, p.value)
+		}
+	}
+	return findings
+}
+
+// listener is an address the server binds to.
+type listener struct {
+	name     string
+	hostname string
+	port     int
+}
+
+// checkPorts verifies that the enabled listeners use valid ports and that no two of them bind to the
+// same address.
+func checkPorts(cfg *config.Config) []Finding {
+	listeners := []listener{{"server", cfg.Server.Hostname, cfg.Server.Port}}
+	if cfg.GRPC.Enabled {
+		listeners = append(listeners, listener{"grpc", cfg.GRPC.Hostname, cfg.GRPC.Port})
+	}
+	if cfg.MockIdP.Enabled {
+		listeners = append(listeners, listener{"mock_idp", cfg.MockIdP.Hostname, cfg.MockIdP.Port})
+	}
+
+	var findings []Finding
+	for i, l := range listeners {
+		if l.port < 1 || l.port > 65535 {
+			findings = append(findings, Finding{Check: CheckPorts, Message: fmt.Sprintf(
+				"%s.port %d is out of range; use a port between 1 and 65535", l.name, l.port)})
+			continue
+		}
+		for _, other := range listeners[:i] {
+			if other.port == l.port && hostsOverlap(other.hostname, l.hostname) {
+				findings = append(findings, Finding{Check: CheckPorts, Message: fmt.Sprintf(
+					"%s and %s both listen on port %d; change %s.port", other.name, l.name, l.port, l.name)})
+			}
+		}
+	}
+	return findings
+}
+
+// hostsOverlap reports whether listeners on the two hosts would bind the same address. A bind-all host
+// overlaps with every other host.
+func hostsOverlap(a, b string) bool {
+	return isBindAll(a) || isBindAll(b) || strings.EqualFold(a, b)
+}
+
+func isBindAll(host string) bool {
+	host = strings.TrimSpace(host)
+	if host == "" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsUnspecified()
+}
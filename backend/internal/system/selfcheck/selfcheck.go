@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package selfcheck verifies that a deployment is consistent before the server starts serving requests,
+// so that a misconfiguration fails at startup with an actionable message rather than mid-request.
+package selfcheck
+
+import (
+	"context"
+	"fmt"
+)
+
+// Names of the built-in checks, used to prefix their findings.
+const (
+	CheckDatabase   = "database"
+	CheckKeys       = "keys"
+	CheckGateClient = "gate_client"
+	CheckPorts      = "ports"
+	CheckFlows      = "flows"
+)
+
+// Finding is a problem found by a check.
+type Finding struct {
+	Check   string
+	Message string
+}
+
+// String returns the finding as a single line prefixed with the check name.
+func (f Finding) String() string {
+	return fmt.Sprintf("[%s] %s", f.Check, f.Message)
+}
+
+// CheckFunc inspects one aspect of the deployment and returns the problems it finds.
+type CheckFunc func(ctx context.Context) []Finding
+
+// Run runs the checks in order and returns the findings of all of them.
+func Run(ctx context.Context, checks ...CheckFunc) []Finding {
+	var findings []Finding
+	for _, check := range checks {
+		findings = append(findings, check(ctx)...)
+	}
+	return findings
+}
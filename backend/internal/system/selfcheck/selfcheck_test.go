@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2025-2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package selfcheck
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	engineconfig "github.com/thunder-id/thunderid/pkg/thunderidengine/config"
+)
+
+type SelfCheckTestSuite struct {
+	suite.Suite
+	home string
+}
+
+func TestSelfCheckTestSuite(t *testing.T) {
+	suite.Run(t, new(SelfCheckTestSuite))
+}
+
+func (suite *SelfCheckTestSuite) SetupTest() {
+	suite.home = suite.T().TempDir()
+}
+
+func (suite *SelfCheckTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// validConfig returns a configuration that passes every check.
+func (suite *SelfCheckTestSuite) validConfig() *config.Config {
+	suite.writeKeyPair("server")
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.home, "config.db"), nil, 0o600))
+	sqlite := config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: "config.db"}}
+
+	return &config.Config{
+		Server: engineconfig.ServerConfig{Hostname: "localhost", Port: 8090},
+		GRPC:   config.GRPCConfig{Enabled: true, Hostname: "localhost", Port: 9090},
+		GateClient: engineconfig.GateClientConfig{
+			Hostname: "localhost", Port: 8090, Scheme: "https", Path: "/gate", LoginPath: "/gate/signin",
+		},
+		TLS: config.TLSConfig{CertFile: "server.cert", KeyFile: "server.key"},
+		Crypto: config.CryptoConfig{
+			Keys: []engineconfig.KeyConfig{{ID: "default", CertFile: "server.cert", KeyFile: "server.key"}},
+		},
+		Database: config.DatabaseConfig{
+			Config:  sqlite,
+			Runtime: config.DataSource{Type: "bolt", Bolt: config.BoltDataSource{Path: "database/runtime.bolt"}},
+			User:    sqlite,
+		},
+	}
+}
+
+// writeKeyPair writes a self-signed certificate and its key as <name>.cert and <name>.key.
+func (suite *SelfCheckTestSuite) writeKeyPair(name string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	suite.Require().NoError(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.home, name+".cert"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.home, name+".key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+// run initializes the server runtime with the configuration and runs the configuration checks.
+func (suite *SelfCheckTestSuite) run(cfg *config.Config) []Finding {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime(suite.home, cfg))
+	return Run(context.Background(), ConfigChecks(config.GetServerRuntime())...)
+}
+
+// checks returns the check names of the findings.
+func checks(findings []Finding) []string {
+	names := make([]string, 0, len(findings))
+	for _, f := range findings {
+		names = append(names, f.Check)
+	}
+	return names
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_ValidConfig() {
+	suite.Empty(suite.run(suite.validConfig()))
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_MissingDatabaseFile() {
+	cfg := suite.validConfig()
+	cfg.Database.User.SQLite.Path = "missing.db"
+
+	findings := suite.run(cfg)
+
+	suite.Equal([]string{CheckDatabase}, checks(findings))
+	suite.Contains(findings[0].Message, "database.user")
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_OperationDatabaseCheckedWhenConfigured() {
+	cfg := suite.validConfig()
+	cfg.Database.Operation = config.DataSource{Type: "sqlite", SQLite: config.SQLiteDataSource{Path: "ops.db"}}
+
+	findings := suite.run(cfg)
+
+	suite.Equal([]string{CheckDatabase}, checks(findings))
+	suite.Contains(findings[0].Message, "database.operation")
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_UnreadableSigningKey() {
+	cfg := suite.validConfig()
+	cfg.Crypto.Keys = append(cfg.Crypto.Keys, engineconfig.KeyConfig{
+		ID: "rotated", CertFile: "rotated.cert", KeyFile: "rotated.key",
+	})
+
+	findings := suite.run(cfg)
+
+	suite.Equal([]string{CheckKeys}, checks(findings))
+	suite.Contains(findings[0].Message, `crypto.keys[1] (id "rotated")`)
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_MismatchedKeyPair() {
+	suite.writeKeyPair("other")
+	cfg := suite.validConfig()
+	cfg.Crypto.Keys[0].KeyFile = "other.key"
+
+	suite.Equal([]string{CheckKeys}, checks(suite.run(cfg)))
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_TLSSkippedWhenHTTPOnly() {
+	cfg := suite.validConfig()
+	cfg.TLS = config.TLSConfig{}
+
+	suite.Equal([]string{CheckKeys}, checks(suite.run(cfg)))
+
+	cfg.Server.HTTPOnly = true
+	suite.Empty(suite.run(cfg))
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_InvalidGateClient() {
+	cfg := suite.validConfig()
+	cfg.GateClient.Scheme = "ftp"
+	cfg.GateClient.Port = 70000
+	cfg.GateClient.LoginPath = "https://login.example.com/signin"
+
+	suite.Equal([]string{CheckGateClient, CheckGateClient, CheckGateClient}, checks(suite.run(cfg)))
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_PortCollision() {
+	cfg := suite.validConfig()
+	cfg.GRPC.Hostname = "0.0.0.0"
+	cfg.GRPC.Port = cfg.Server.Port
+
+	findings := suite.run(cfg)
+
+	suite.Equal([]string{CheckPorts}, checks(findings))
+	suite.Contains(findings[0].Message, "server and grpc both listen on port 8090")
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_SamePortOnDistinctHosts() {
+	cfg := suite.validConfig()
+	cfg.GRPC.Hostname = "127.0.0.2"
+	cfg.GRPC.Port = cfg.Server.Port
+
+	suite.Empty(suite.run(cfg))
+}
+
+func (suite *SelfCheckTestSuite) TestConfigChecks_DisabledListenerIgnored() {
+	cfg := suite.validConfig()
+	cfg.MockIdP = config.MockIdPConfig{Hostname: "localhost", Port: cfg.Server.Port}
+
+	suite.Empty(suite.run(cfg))
+}
+
+func (suite *SelfCheckTestSuite) TestRun_CollectsFindingsInOrder() {
+	first := func(context.Context) []Finding { return []Finding{{Check: "a", Message: "one"}} }
+	second := func(context.Context) []Finding { return []Finding{{Check: "b", Message: "two"}} }
+
+	findings := Run(context.Background(), first, second)
+
+	suite.Equal([]string{"[a] one", "[b] two"}, []string{findings[0].String(), findings[1].String()})
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysinfo
+
+// productName is the product name reported by the system information endpoints.
+const productName = "ThunderID"
+
+// unknownVersion is reported when the binary was built without version information.
+const unknownVersion = "unknown"
+
+// securityTxtContentType is the media type mandated by RFC 9116 for security.txt.
+const securityTxtContentType = "text/plain; charset=utf-8"
+
+// Datasource names reported in SystemInfo.Databases.
+const (
+	datasourceConfig    = "config"
+	datasourceRuntime   = "runtime"
+	datasourceUser      = "user"
+	datasourceOperation = "operation"
+)
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysinfo
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// systemInfoHandler is the handler for the system information endpoints.
+type systemInfoHandler struct {
+	systemInfoService SystemInfoServiceInterface
+	logger            *log.Logger
+}
+
+// newSystemInfoHandler creates a new instance of systemInfoHandler.
+func newSystemInfoHandler(systemInfoService SystemInfoServiceInterface) *systemInfoHandler {
+	return &systemInfoHandler{
+		systemInfoService: systemInfoService,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SystemInfoHandler")),
+	}
+}
+
+// HandleGetSystemInfo handles GET /system/info.
+func (h *systemInfoHandler) HandleGetSystemInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, svcErr := h.systemInfoService.GetSystemInfo(ctx)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, info)
+}
+
+// HandleGetPublicSystemInfo handles GET /system/info/public.
+func (h *systemInfoHandler) HandleGetPublicSystemInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, h.systemInfoService.GetPublicSystemInfo(ctx))
+}
+
+// HandleGetSecurityTxt handles GET /.well-known/security.txt.
+func (h *systemInfoHandler) HandleGetSecurityTxt(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	body, ok := h.systemInfoService.GetSecurityTxt(ctx)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", securityTxtContentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(body)); err != nil {
+		h.logger.Error(ctx, "Failed to write security.txt response", log.Error(err))
+	}
+}
+
+// handleError writes the error response for a service error.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysinfo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+)
+
+type SystemInfoHandlerTestSuite struct {
+	suite.Suite
+	cfg        *config.Config
+	cryptoMock *cryptomock.RuntimeCryptoProviderMock
+}
+
+func TestSystemInfoHandlerSuite(t *testing.T) {
+	suite.Run(t, new(SystemInfoHandlerTestSuite))
+}
+
+func (suite *SystemInfoHandlerTestSuite) SetupTest() {
+	suite.cfg = &config.Config{}
+	suite.cryptoMock = cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+}
+
+// serve registers the routes against a fresh mux and runs a GET request for path.
+func (suite *SystemInfoHandlerTestSuite) serve(path string, publicEnabled bool) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	handler := newSystemInfoHandler(newSystemInfoService(suite.cfg, suite.cryptoMock, BuildInfo{Version: "v1.0.0"}))
+	registerRoutes(mux, handler, publicEnabled)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func (suite *SystemInfoHandlerTestSuite) TestHandleGetSystemInfo_Success() {
+	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{}).
+		Return([]kmprovider.PublicKeyInfo{{KeyID: "kid"}}, nil)
+
+	rec := suite.serve("/system/info", false)
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var info SystemInfo
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &info))
+	suite.Equal("v1.0.0", info.Version)
+	suite.Require().Len(info.Keys, 1)
+	suite.Equal("kid", info.Keys[0].KeyID)
+}
+
+func (suite *SystemInfoHandlerTestSuite) TestHandleGetSystemInfo_Error() {
+	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{}).
+		Return(nil, errors.New("failure"))
+
+	rec := suite.serve("/system/info", false)
+	suite.Equal(http.StatusInternalServerError, rec.Code)
+
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &errResp))
+	suite.Equal(tidcommon.InternalServerError.Code, errResp.Code)
+}
+
+func (suite *SystemInfoHandlerTestSuite) TestHandleGetPublicSystemInfo_Enabled() {
+	rec := suite.serve("/system/info/public", true)
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var info PublicSystemInfo
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &info))
+	suite.Equal(PublicSystemInfo{ProductName: productName, Version: "v1.0.0"}, info)
+}
+
+func (suite *SystemInfoHandlerTestSuite) TestHandleGetPublicSystemInfo_Disabled() {
+	rec := suite.serve("/system/info/public", false)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *SystemInfoHandlerTestSuite) TestHandleGetSecurityTxt_NotConfigured() {
+	rec := suite.serve("/.well-known/security.txt", false)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *SystemInfoHandlerTestSuite) TestHandleGetSecurityTxt_Configured() {
+	suite.cfg.SystemInfo.SecurityTxt = config.SecurityTxtConfig{
+		Contacts:      []string{"mailto:security@example.com"},
+		ExpiresInDays: 90,
+	}
+
+	rec := suite.serve("/.well-known/security.txt", false)
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal(securityTxtContentType, rec.Header().Get("Content-Type"))
+	suite.Contains(rec.Body.String(), "Contact: mailto:security@example.com\n")
+	suite.Contains(rec.Body.String(), "Expires: ")
+}
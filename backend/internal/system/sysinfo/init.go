@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package sysinfo provides the system information endpoints used by operators, monitoring, and
+// support tooling to introspect a deployment: the authenticated /system/info endpoint, the optional
+// public /system/info/public endpoint, and the RFC 9116 /.well-known/security.txt document.
+package sysinfo
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the system information service and registers its routes. The public
+// endpoint is registered only when enabled in the configuration.
+func Initialize(mux *http.ServeMux, cryptoProvider kmprovider.RuntimeCryptoProvider,
+	buildInfo BuildInfo) SystemInfoServiceInterface {
+	cfg := &config.GetServerRuntime().Config
+	service := newSystemInfoService(cfg, cryptoProvider, buildInfo)
+	handler := newSystemInfoHandler(service)
+	registerRoutes(mux, handler, cfg.SystemInfo.PublicEnabled)
+	return service
+}
+
+// registerRoutes registers the routes for the system information endpoints.
+func registerRoutes(mux *http.ServeMux, handler *systemInfoHandler, publicEnabled bool) {
+	noContent := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET /system/info", handler.HandleGetSystemInfo, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /system/info", noContent, opts))
+
+	if publicEnabled {
+		mux.HandleFunc(middleware.WithCORS("GET /system/info/public", handler.HandleGetPublicSystemInfo, opts))
+		mux.HandleFunc(middleware.WithCORS("OPTIONS /system/info/public", noContent, opts))
+	}
+
+	mux.HandleFunc("GET /.well-known/security.txt", handler.HandleGetSecurityTxt)
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysinfo
+
+import "time"
+
+// BuildInfo holds the build metadata stamped into the server binary at link time.
+type BuildInfo struct {
+	Version   string
+	BuildDate string
+}
+
+// SystemInfo is the response body of the authenticated GET /system/info endpoint.
+type SystemInfo struct {
+	ProductName  string                  `json:"productName"`
+	Version      string                  `json:"version"`
+	BuildDate    string                  `json:"buildDate,omitempty"`
+	DeploymentID string                  `json:"deploymentId"`
+	StartedAt    time.Time               `json:"startedAt"`
+	Features     map[string]bool         `json:"features"`
+	Databases    map[string]DatabaseInfo `json:"databases"`
+	Keys         []KeyInfo               `json:"keys"`
+}
+
+// DatabaseInfo describes a configured datasource.
+type DatabaseInfo struct {
+	Type          string `json:"type"`
+	SchemaVersion string `json:"schemaVersion"`
+}
+
+// KeyInfo describes a signing key. The validity window and age are reported only for certificate-backed
+// keys.
+type KeyInfo struct {
+	KeyID     string     `json:"kid"`
+	Algorithm string     `json:"algorithm"`
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	NotAfter  *time.Time `json:"notAfter,omitempty"`
+	AgeDays   *int       `json:"ageDays,omitempty"`
+}
+
+// PublicSystemInfo is the response body of the unauthenticated GET /system/info/public endpoint.
+type PublicSystemInfo struct {
+	ProductName string `json:"productName"`
+	Version     string `json:"version"`
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysinfo
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// SystemInfoServiceInterface defines the interface for the system information service.
+type SystemInfoServiceInterface interface {
+	GetSystemInfo(ctx context.Context) (*SystemInfo, *tidcommon.ServiceError)
+	GetPublicSystemInfo(ctx context.Context) *PublicSystemInfo
+	GetSecurityTxt(ctx context.Context) (string, bool)
+}
+
+// systemInfoService implements the SystemInfoServiceInterface.
+type systemInfoService struct {
+	cfg            *config.Config
+	cryptoProvider kmprovider.RuntimeCryptoProvider
+	buildInfo      BuildInfo
+	startedAt      time.Time
+	now            func() time.Time
+	logger         *log.Logger
+}
+
+// newSystemInfoService creates a new instance of systemInfoService.
+func newSystemInfoService(cfg *config.Config, cryptoProvider kmprovider.RuntimeCryptoProvider,
+	buildInfo BuildInfo) *systemInfoService {
+	if buildInfo.Version == "" {
+		buildInfo.Version = unknownVersion
+	}
+	return &systemInfoService{
+		cfg:            cfg,
+		cryptoProvider: cryptoProvider,
+		buildInfo:      buildInfo,
+		startedAt:      time.Now().UTC(),
+		now:            time.Now,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SystemInfoService")),
+	}
+}
+
+// GetSystemInfo returns the build, feature, datasource, and signing key metadata of the deployment.
+func (s *systemInfoService) GetSystemInfo(ctx context.Context) (*SystemInfo, *tidcommon.ServiceError) {
+	keys, err := s.getKeyInfo(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to retrieve public keys", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	return &SystemInfo{
+		ProductName:  productName,
+		Version:      s.buildInfo.Version,
+		BuildDate:    s.buildInfo.BuildDate,
+		DeploymentID: s.cfg.Server.Identifier,
+		StartedAt:    s.startedAt,
+		Features:     s.getFeatures(),
+		Databases:    s.getDatabases(),
+		Keys:         keys,
+	}, nil
+}
+
+// GetPublicSystemInfo returns the minimal metadata exposed on the unauthenticated endpoint.
+func (s *systemInfoService) GetPublicSystemInfo(ctx context.Context) *PublicSystemInfo {
+	return &PublicSystemInfo{
+		ProductName: productName,
+		Version:     s.buildInfo.Version,
+	}
+}
+
+// GetSecurityTxt renders the RFC 9116 security.txt document. It reports false when no contact is
+// configured, in which case the document must not be served.
+func (s *systemInfoService) GetSecurityTxt(ctx context.Context) (string, bool) {
+	st := s.cfg.SystemInfo.SecurityTxt
+	if len(st.Contacts) == 0 {
+		return "", false
+	}
+
+	var b strings.Builder
+	for _, contact := range st.Contacts {
+		fmt.Fprintf(&b, "Contact: %s\n", contact)
+	}
+	expires := s.now().UTC().AddDate(0, 0, st.ExpiresInDays).Truncate(time.Second)
+	fmt.Fprintf(&b, "Expires: %s\n", expires.Format(time.RFC3339))
+	if st.Policy != "" {
+		fmt.Fprintf(&b, "Policy: %s\n", st.Policy)
+	}
+	if st.PreferredLanguages != "" {
+		fmt.Fprintf(&b, "Preferred-Languages: %s\n", st.PreferredLanguages)
+	}
+	return b.String(), true
+}
+
+// getFeatures reports the optional capabilities switched on in the deployment configuration.
+func (s *systemInfoService) getFeatures() map[string]bool {
+	return map[string]bool{
+		"consent":              s.cfg.Consent.Enabled,
+		"declarativeResources": s.cfg.DeclarativeResources.Enabled,
+		"directAuthSecret":     s.cfg.Server.SecurityConfig.DirectAuthSecret != "",
+		"dpopRequired":         s.cfg.OAuth.DPoP.Required,
+		"maintenanceReadOnly":  s.cfg.Server.Maintenance.ReadOnly,
+		"observability":        s.cfg.Observability.Enabled,
+		"parRequired":          s.cfg.OAuth.PAR.RequirePAR,
+		"publicSystemInfo":     s.cfg.SystemInfo.PublicEnabled,
+		"tokenRevocation":      s.cfg.Server.SecurityConfig.TokenRevocation.Enabled,
+		"wildcardRedirectURI":  s.cfg.OAuth.AllowWildcardRedirectURI,
+	}
+}
+
+// getDatabases reports the type of each configured datasource. The database schemas ship with the
+// server release (dbscripts), so the schema version is the server version.
+func (s *systemInfoService) getDatabases() map[string]DatabaseInfo {
+	db := s.cfg.Database
+	info := func(dsType string) DatabaseInfo {
+		return DatabaseInfo{Type: dsType, SchemaVersion: s.buildInfo.Version}
+	}
+	return map[string]DatabaseInfo{
+		datasourceConfig:    info(db.Config.Type),
+		datasourceRuntime:   info(db.Runtime.Type),
+		datasourceUser:      info(db.User.Type),
+		datasourceOperation: info(db.Operation.Type),
+	}
+}
+
+// getKeyInfo reports the signing keys known to the runtime crypto provider along with the validity
+// window and age of certificate-backed keys.
+func (s *systemInfoService) getKeyInfo(ctx context.Context) ([]KeyInfo, error) {
+	publicKeys, err := s.cryptoProvider.GetPublicKeys(ctx, kmprovider.PublicKeyFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	keys := make([]KeyInfo, 0, len(publicKeys))
+	for _, pk := range publicKeys {
+		key := KeyInfo{KeyID: pk.KeyID, Algorithm: string(pk.Algorithm)}
+		if len(pk.CertificateDER) > 0 {
+			cert, err := x509.ParseCertificate(pk.CertificateDER)
+			if err != nil {
+				s.logger.Debug(ctx, "Failed to parse key certificate", log.String("keyID", pk.KeyID),
+					log.Error(err))
+			} else {
+				notBefore, notAfter := cert.NotBefore.UTC(), cert.NotAfter.UTC()
+				ageDays := int(now.Sub(notBefore).Hours() / 24)
+				key.NotBefore, key.NotAfter, key.AgeDays = &notBefore, &notAfter, &ageDays
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
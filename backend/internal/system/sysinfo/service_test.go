@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysinfo
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolib"
+	kmprovider "github.com/thunder-id/thunderid/internal/system/kmprovider/common"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+)
+
+var testNow = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+type SystemInfoServiceTestSuite struct {
+	suite.Suite
+	cfg        *config.Config
+	cryptoMock *cryptomock.RuntimeCryptoProviderMock
+	service    *systemInfoService
+}
+
+func TestSystemInfoServiceSuite(t *testing.T) {
+	suite.Run(t, new(SystemInfoServiceTestSuite))
+}
+
+func (suite *SystemInfoServiceTestSuite) SetupTest() {
+	suite.cfg = &config.Config{}
+	suite.cfg.Server.Identifier = "deployment-1"
+	suite.cfg.Database.Config.Type = "sqlite"
+	suite.cfg.Database.Runtime.Type = "redis"
+	suite.cfg.Database.User.Type = "postgres"
+	suite.cfg.Database.Operation.Type = "postgres"
+	suite.cfg.Consent.Enabled = true
+	suite.cryptoMock = cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	suite.service = newSystemInfoService(suite.cfg, suite.cryptoMock,
+		BuildInfo{Version: "v1.2.3", BuildDate: "2026-05-01"})
+	suite.service.now = func() time.Time { return testNow }
+}
+
+// createCertificate returns a self-signed certificate valid from notBefore for one year.
+func (suite *SystemInfoServiceTestSuite) createCertificate(notBefore time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.AddDate(1, 0, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	suite.Require().NoError(err)
+	return der
+}
+
+func (suite *SystemInfoServiceTestSuite) TestNewSystemInfoService_DefaultsUnknownVersion() {
+	svc := newSystemInfoService(suite.cfg, suite.cryptoMock, BuildInfo{})
+	suite.Equal(unknownVersion, svc.GetPublicSystemInfo(context.Background()).Version)
+}
+
+func (suite *SystemInfoServiceTestSuite) TestGetSystemInfo_Success() {
+	certDER := suite.createCertificate(testNow.AddDate(0, 0, -30))
+	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{}).
+		Return([]kmprovider.PublicKeyInfo{
+			{KeyID: "cert-key", Algorithm: cryptolib.AlgorithmES256, CertificateDER: certDER},
+			{KeyID: "bare-key", Algorithm: cryptolib.AlgorithmRS256},
+		}, nil)
+
+	info, svcErr := suite.service.GetSystemInfo(context.Background())
+	suite.Nil(svcErr)
+	suite.Require().NotNil(info)
+
+	suite.Equal(productName, info.ProductName)
+	suite.Equal("v1.2.3", info.Version)
+	suite.Equal("2026-05-01", info.BuildDate)
+	suite.Equal("deployment-1", info.DeploymentID)
+	suite.True(info.Features["consent"])
+	suite.False(info.Features["tokenRevocation"])
+	suite.Equal(DatabaseInfo{Type: "redis", SchemaVersion: "v1.2.3"}, info.Databases[datasourceRuntime])
+	suite.Equal("postgres", info.Databases[datasourceUser].Type)
+
+	suite.Require().Len(info.Keys, 2)
+	suite.Equal("cert-key", info.Keys[0].KeyID)
+	suite.Equal(string(cryptolib.AlgorithmES256), info.Keys[0].Algorithm)
+	suite.Require().NotNil(info.Keys[0].AgeDays)
+	suite.Equal(30, *info.Keys[0].AgeDays)
+	suite.Require().NotNil(info.Keys[0].NotAfter)
+	suite.Equal("bare-key", info.Keys[1].KeyID)
+	suite.Nil(info.Keys[1].AgeDays)
+	suite.Nil(info.Keys[1].NotBefore)
+}
+
+func (suite *SystemInfoServiceTestSuite) TestGetSystemInfo_InvalidCertificateOmitsAge() {
+	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{}).
+		Return([]kmprovider.PublicKeyInfo{{KeyID: "kid", CertificateDER: []byte("not-a-cert")}}, nil)
+
+	info, svcErr := suite.service.GetSystemInfo(context.Background())
+	suite.Nil(svcErr)
+	suite.Require().Len(info.Keys, 1)
+	suite.Nil(info.Keys[0].AgeDays)
+}
+
+func (suite *SystemInfoServiceTestSuite) TestGetSystemInfo_KeyRetrievalFails() {
+	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{}).
+		Return(nil, errors.New("provider unavailable"))
+
+	info, svcErr := suite.service.GetSystemInfo(context.Background())
+	suite.Nil(info)
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *SystemInfoServiceTestSuite) TestGetPublicSystemInfo() {
+	info := suite.service.GetPublicSystemInfo(context.Background())
+	suite.Equal(&PublicSystemInfo{ProductName: productName, Version: "v1.2.3"}, info)
+}
+
+func (suite *SystemInfoServiceTestSuite) TestGetSecurityTxt_NotConfigured() {
+	body, ok := suite.service.GetSecurityTxt(context.Background())
+	suite.False(ok)
+	suite.Empty(body)
+}
+
+func (suite *SystemInfoServiceTestSuite) TestGetSecurityTxt_Configured() {
+	suite.cfg.SystemInfo.SecurityTxt = config.SecurityTxtConfig{
+		Contacts:           []string{"mailto:security@example.com", "https://example.com/report"},
+		ExpiresInDays:      30,
+		Policy:             "https://example.com/policy",
+		PreferredLanguages: "en, fr",
+	}
+
+	body, ok := suite.service.GetSecurityTxt(context.Background())
+	suite.True(ok)
+	suite.Equal(strings.Join([]string{
+		"Contact: mailto:security@example.com",
+		"Contact: https://example.com/report",
+		"Expires: 2026-07-01T12:00:00Z",
+		"Policy: https://example.com/policy",
+		"Preferred-Languages: en, fr",
+		"",
+	}, "\n"), body)
+}
@@ -195,3 +195,91 @@ func (_c *TemplateServiceInterfaceMock_Render_Call) RunAndReturn(run func(ctx co
 	_c.Call.Return(run)
 	return _c
 }
+
+// RenderFor provides a mock function for the type TemplateServiceInterfaceMock
+func (_mock *TemplateServiceInterfaceMock) RenderFor(ctx context.Context, scenario ScenarioType, tmplType TemplateType, selector TemplateSelector, data TemplateData) (*RenderedTemplate, *common.ServiceError) {
+	ret := _mock.Called(ctx, scenario, tmplType, selector, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderFor")
+	}
+
+	var r0 *RenderedTemplate
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ScenarioType, TemplateType, TemplateSelector, TemplateData) (*RenderedTemplate, *common.ServiceError)); ok {
+		return returnFunc(ctx, scenario, tmplType, selector, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ScenarioType, TemplateType, TemplateSelector, TemplateData) *RenderedTemplate); ok {
+		r0 = returnFunc(ctx, scenario, tmplType, selector, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RenderedTemplate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ScenarioType, TemplateType, TemplateSelector, TemplateData) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, scenario, tmplType, selector, data)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// TemplateServiceInterfaceMock_RenderFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderFor'
+type TemplateServiceInterfaceMock_RenderFor_Call struct {
+	*mock.Call
+}
+
+// RenderFor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scenario ScenarioType
+//   - tmplType TemplateType
+//   - selector TemplateSelector
+//   - data TemplateData
+func (_e *TemplateServiceInterfaceMock_Expecter) RenderFor(ctx interface{}, scenario interface{}, tmplType interface{}, selector interface{}, data interface{}) *TemplateServiceInterfaceMock_RenderFor_Call {
+	return &TemplateServiceInterfaceMock_RenderFor_Call{Call: _e.mock.On("RenderFor", ctx, scenario, tmplType, selector, data)}
+}
+
+func (_c *TemplateServiceInterfaceMock_RenderFor_Call) Run(run func(ctx context.Context, scenario ScenarioType, tmplType TemplateType, selector TemplateSelector, data TemplateData)) *TemplateServiceInterfaceMock_RenderFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ScenarioType
+		if args[1] != nil {
+			arg1 = args[1].(ScenarioType)
+		}
+		var arg2 TemplateType
+		if args[2] != nil {
+			arg2 = args[2].(TemplateType)
+		}
+		var arg3 TemplateSelector
+		if args[3] != nil {
+			arg3 = args[3].(TemplateSelector)
+		}
+		var arg4 TemplateData
+		if args[4] != nil {
+			arg4 = args[4].(TemplateData)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_RenderFor_Call) Return(renderedTemplate *RenderedTemplate, serviceError *common.ServiceError) *TemplateServiceInterfaceMock_RenderFor_Call {
+	_c.Call.Return(renderedTemplate, serviceError)
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_RenderFor_Call) RunAndReturn(run func(ctx context.Context, scenario ScenarioType, tmplType TemplateType, selector TemplateSelector, data TemplateData) (*RenderedTemplate, *common.ServiceError)) *TemplateServiceInterfaceMock_RenderFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
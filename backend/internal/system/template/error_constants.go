@@ -45,4 +45,116 @@ var (
 			DefaultValue: "The requested template does not exist for the given scenario",
 		},
 	}
+
+	// ErrorInvalidRequestFormat is returned when the request body is malformed.
+	ErrorInvalidRequestFormat = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TMP-1002",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidScenario is returned when the template scenario is not supported.
+	ErrorInvalidScenario = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TMP-1003",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_scenario",
+			DefaultValue: "Invalid scenario",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_scenario_description",
+			DefaultValue: "The template scenario is not supported",
+		},
+	}
+
+	// ErrorInvalidTemplateType is returned when the template type is not email or sms.
+	ErrorInvalidTemplateType = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TMP-1004",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_template_type",
+			DefaultValue: "Invalid template type",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_template_type_description",
+			DefaultValue: "The template type must be email or sms",
+		},
+	}
+
+	// ErrorInvalidTemplateContent is returned when the subject, body or content type of a template is invalid.
+	ErrorInvalidTemplateContent = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TMP-1005",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_template_content",
+			DefaultValue: "Invalid template content",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_template_content_description",
+			DefaultValue: "The template has a missing body or subject, or an unsupported content type",
+		},
+	}
+
+	// ErrorInvalidLocale is returned when the locale is not a valid language tag.
+	ErrorInvalidLocale = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TMP-1006",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_locale",
+			DefaultValue: "Invalid locale",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.templateservice.invalid_locale_description",
+			DefaultValue: "The locale must be a valid BCP 47 language tag",
+		},
+	}
+
+	// ErrorUndeclaredVariable is returned when the template uses a placeholder that is not a declared variable.
+	ErrorUndeclaredVariable = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TMP-1007",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.templateservice.undeclared_variable",
+			DefaultValue: "Undeclared template variable",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.templateservice.undeclared_variable_description",
+			DefaultValue: "The subject or body uses a variable that is not declared in the template variables",
+		},
+	}
+
+	// ErrorDuplicateTemplate is returned when a template already exists for the same scope.
+	ErrorDuplicateTemplate = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TMP-1008",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.templateservice.duplicate_template",
+			DefaultValue: "Duplicate template",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.templateservice.duplicate_template_description",
+			DefaultValue: "A template already exists for the scenario, type, application and locale",
+		},
+	}
+
+	// ErrorNotificationTemplateNotFound is returned when the requested notification template does not exist.
+	ErrorNotificationTemplateNotFound = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "TMP-1009",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.templateservice.notification_template_not_found",
+			DefaultValue: "Notification template not found",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.templateservice.notification_template_not_found_description",
+			DefaultValue: "The requested notification template does not exist",
+		},
+	}
 )
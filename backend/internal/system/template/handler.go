@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"context"
+	"net/http"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	queryParamScenario      = "scenario"
+	queryParamType          = "type"
+	queryParamApplicationID = "applicationId"
+)
+
+// notificationTemplateHandler handles the HTTP requests for notification template management.
+type notificationTemplateHandler struct {
+	mgtService NotificationTemplateMgtServiceInterface
+}
+
+// newNotificationTemplateHandler creates a new instance of notificationTemplateHandler.
+func newNotificationTemplateHandler(mgtService NotificationTemplateMgtServiceInterface) *notificationTemplateHandler {
+	return &notificationTemplateHandler{mgtService: mgtService}
+}
+
+// HandleTemplateListRequest handles GET /notification-templates.
+func (h *notificationTemplateHandler) HandleTemplateListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	filter := NotificationTemplateFilter{
+		Scenario:      ScenarioType(query.Get(queryParamScenario)),
+		Type:          TemplateType(query.Get(queryParamType)),
+		ApplicationID: query.Get(queryParamApplicationID),
+	}
+
+	templates, svcErr := h.mgtService.ListTemplates(ctx, filter)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, templates)
+}
+
+// HandleTemplateCreateRequest handles POST /notification-templates.
+func (h *notificationTemplateHandler) HandleTemplateCreateRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	request, err := sysutils.DecodeJSONBody[NotificationTemplateRequest](r)
+	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
+		handleError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	tmpl, svcErr := h.mgtService.CreateTemplate(ctx, *request)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusCreated, tmpl)
+}
+
+// HandleTemplateGetRequest handles GET /notification-templates/{id}.
+func (h *notificationTemplateHandler) HandleTemplateGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tmpl, svcErr := h.mgtService.GetTemplate(ctx, r.PathValue("id"))
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, tmpl)
+}
+
+// HandleTemplateUpdateRequest handles PUT /notification-templates/{id}.
+func (h *notificationTemplateHandler) HandleTemplateUpdateRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	request, err := sysutils.DecodeJSONBody[NotificationTemplateRequest](r)
+	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
+		handleError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	tmpl, svcErr := h.mgtService.UpdateTemplate(ctx, r.PathValue("id"), *request)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, tmpl)
+}
+
+// HandleTemplateDeleteRequest handles DELETE /notification-templates/{id}.
+func (h *notificationTemplateHandler) HandleTemplateDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if svcErr := h.mgtService.DeleteTemplate(ctx, r.PathValue("id")); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+}
+
+// handleError writes the error response of a service error.
+func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == tidcommon.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorNotificationTemplateNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorDuplicateTemplate.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	sysutils.WriteErrorResponse(ctx, w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type NotificationTemplateHandlerTestSuite struct {
+	suite.Suite
+	mockService *NotificationTemplateMgtServiceInterfaceMock
+	handler     *notificationTemplateHandler
+}
+
+func TestNotificationTemplateHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationTemplateHandlerTestSuite))
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) SetupTest() {
+	suite.mockService = NewNotificationTemplateMgtServiceInterfaceMock(suite.T())
+	suite.handler = newNotificationTemplateHandler(suite.mockService)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateListRequest() {
+	filter := NotificationTemplateFilter{Scenario: ScenarioOTP, Type: TemplateTypeSMS, ApplicationID: "app-1"}
+	suite.mockService.On("ListTemplates", mock.Anything, filter).
+		Return([]NotificationTemplate{{ID: "tmpl-1"}}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-templates?scenario=OTP&type=sms&applicationId=app-1",
+		nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var res []NotificationTemplate
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Len(res, 1)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateListRequest_InvalidFilter() {
+	suite.mockService.On("ListTemplates", mock.Anything, mock.Anything).Return(nil, &ErrorInvalidScenario).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-templates?scenario=UNKNOWN", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateListRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidScenario.Code, errResp.Code)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateCreateRequest() {
+	request := NotificationTemplateRequest{Scenario: ScenarioOTP, Type: TemplateTypeSMS, Body: "Code"}
+	suite.mockService.On("CreateTemplate", mock.Anything, request).
+		Return(&NotificationTemplate{ID: "tmpl-1", Scenario: ScenarioOTP}, nil).Once()
+
+	body, err := json.Marshal(request)
+	suite.NoError(err)
+	req := httptest.NewRequest(http.MethodPost, "/notification-templates", bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateCreateRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+	var res NotificationTemplate
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Equal("tmpl-1", res.ID)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateCreateRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/notification-templates", bytes.NewBufferString("{invalid"))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateCreateRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateCreateRequest_Duplicate() {
+	suite.mockService.On("CreateTemplate", mock.Anything, mock.Anything).Return(nil, &ErrorDuplicateTemplate).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/notification-templates",
+		bytes.NewBufferString(`{"scenario":"OTP","type":"sms","body":"Code"}`))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateCreateRequest(rr, req)
+
+	suite.Equal(http.StatusConflict, rr.Code)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateGetRequest() {
+	suite.mockService.On("GetTemplate", mock.Anything, "tmpl-1").Return(&NotificationTemplate{ID: "tmpl-1"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-templates/tmpl-1", nil)
+	req.SetPathValue("id", "tmpl-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateGetRequest_NotFound() {
+	suite.mockService.On("GetTemplate", mock.Anything, "missing").
+		Return(nil, &ErrorNotificationTemplateNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-templates/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateUpdateRequest() {
+	suite.mockService.On("UpdateTemplate", mock.Anything, "tmpl-1", mock.Anything).
+		Return(&NotificationTemplate{ID: "tmpl-1"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPut, "/notification-templates/tmpl-1",
+		bytes.NewBufferString(`{"scenario":"OTP","type":"sms","body":"Code"}`))
+	req.SetPathValue("id", "tmpl-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateUpdateRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateDeleteRequest() {
+	suite.mockService.On("DeleteTemplate", mock.Anything, "tmpl-1").Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/notification-templates/tmpl-1", nil)
+	req.SetPathValue("id", "tmpl-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *NotificationTemplateHandlerTestSuite) TestHandleTemplateDeleteRequest_ServerError() {
+	suite.mockService.On("DeleteTemplate", mock.Anything, "tmpl-1").Return(&tidcommon.InternalServerError).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/notification-templates/tmpl-1", nil)
+	req.SetPathValue("id", "tmpl-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
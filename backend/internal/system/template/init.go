@@ -18,16 +18,72 @@
 
 package template
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
 
-// Initialize sets up the template service with a file-based store loaded from declarative resources.
-func Initialize() (TemplateServiceInterface, error) {
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+// Initialize sets up the template service with a file-based store of the default templates loaded
+// from declarative resources and, unless declarative resources are enabled, a database store of the
+// notification templates that override them. It registers the notification template routes.
+func Initialize(mux *http.ServeMux) (TemplateServiceInterface, error) {
 	fileStore := newTemplateFileBasedStore()
 
 	if err := loadDeclarativeResources(fileStore); err != nil {
 		return nil, fmt.Errorf("failed to initialize template service: %w", err)
 	}
 
-	service := newTemplateService(fileStore)
+	var overrideStore notificationTemplateStoreInterface
+	var tx transaction.Transactioner
+	if !declarativeresource.IsDeclarativeModeEnabled() {
+		var err error
+		overrideStore, tx, err = newNotificationTemplateStore()
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize notification template store: %w", err)
+		}
+	}
+
+	service := newTemplateService(fileStore, overrideStore)
+	handler := newNotificationTemplateHandler(newNotificationTemplateMgtService(overrideStore, tx))
+	registerRoutes(mux, handler)
 	return service, nil
 }
+
+// registerRoutes registers the HTTP routes for notification template management.
+func registerRoutes(mux *http.ServeMux, handler *notificationTemplateHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /notification-templates",
+		handler.HandleTemplateListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST /notification-templates",
+		handler.HandleTemplateCreateRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-templates",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /notification-templates/{id}",
+		handler.HandleTemplateGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /notification-templates/{id}",
+		handler.HandleTemplateUpdateRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /notification-templates/{id}",
+		handler.HandleTemplateDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-templates/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
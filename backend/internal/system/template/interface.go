@@ -34,11 +34,20 @@ type TemplateServiceInterface interface {
 		tmplType TemplateType,
 	) (*TemplateDTO, *tidcommon.ServiceError)
 
-	// Render renders a template with the provided data.
+	// Render renders the deployment template of a scenario with the provided data.
 	Render(
 		ctx context.Context,
 		scenario ScenarioType,
 		tmplType TemplateType,
 		data TemplateData,
 	) (*RenderedTemplate, *tidcommon.ServiceError)
+
+	// RenderFor renders the template of a scenario that best matches the selector with the provided data.
+	RenderFor(
+		ctx context.Context,
+		scenario ScenarioType,
+		tmplType TemplateType,
+		selector TemplateSelector,
+		data TemplateData,
+	) (*RenderedTemplate, *tidcommon.ServiceError)
 }
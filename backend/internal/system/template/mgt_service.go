@@ -0,0 +1,299 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	goi18n "golang.org/x/text/language"
+
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	mgtServiceLoggerComponentName = "NotificationTemplateMgtService"
+	contentTypeTextPlain          = "text/plain"
+	contentTypeTextHTML           = "text/html"
+)
+
+// variableNameRegex matches the names allowed for template variables.
+var variableNameRegex = regexp.MustCompile(`^\w+$`)
+
+// NotificationTemplateMgtServiceInterface defines the operations for managing the notification
+// templates that override the default templates.
+type NotificationTemplateMgtServiceInterface interface {
+	CreateTemplate(ctx context.Context, request NotificationTemplateRequest) (*NotificationTemplate,
+		*tidcommon.ServiceError)
+	ListTemplates(ctx context.Context, filter NotificationTemplateFilter) ([]NotificationTemplate,
+		*tidcommon.ServiceError)
+	GetTemplate(ctx context.Context, id string) (*NotificationTemplate, *tidcommon.ServiceError)
+	UpdateTemplate(ctx context.Context, id string, request NotificationTemplateRequest) (*NotificationTemplate,
+		*tidcommon.ServiceError)
+	DeleteTemplate(ctx context.Context, id string) *tidcommon.ServiceError
+}
+
+// notificationTemplateMgtService implements NotificationTemplateMgtServiceInterface. The store is nil
+// when declarative resources are enabled, in which case no template is stored.
+type notificationTemplateMgtService struct {
+	store         notificationTemplateStoreInterface
+	transactioner transaction.Transactioner
+	uuidGenerator func() (string, error)
+	logger        *log.Logger
+}
+
+// newNotificationTemplateMgtService creates a new notification template management service.
+func newNotificationTemplateMgtService(store notificationTemplateStoreInterface,
+	tx transaction.Transactioner) NotificationTemplateMgtServiceInterface {
+	return &notificationTemplateMgtService{
+		store:         store,
+		transactioner: tx,
+		uuidGenerator: sysutils.GenerateUUIDv7,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, mgtServiceLoggerComponentName)),
+	}
+}
+
+// CreateTemplate creates a notification template.
+func (s *notificationTemplateMgtService) CreateTemplate(ctx context.Context,
+	request NotificationTemplateRequest) (*NotificationTemplate, *tidcommon.ServiceError) {
+	if err := declarativeresource.CheckDeclarativeCreate(); err != nil {
+		return nil, err
+	}
+
+	tmpl, svcErr := buildNotificationTemplate(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	id, err := s.uuidGenerator()
+	if err != nil {
+		s.logger.Error(ctx, "Failed to generate UUID", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	tmpl.ID = id
+
+	transactErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if svcErr = s.checkDuplicate(txCtx, tmpl); svcErr != nil {
+			return errors.New("duplicate template")
+		}
+		return s.store.createTemplate(txCtx, *tmpl)
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if transactErr != nil {
+		s.logger.Error(ctx, "Failed to create notification template", log.Error(transactErr))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Created notification template", log.String("id", tmpl.ID),
+		log.String("scenario", string(tmpl.Scenario)))
+	return tmpl, nil
+}
+
+// ListTemplates returns the notification templates that match the filter.
+func (s *notificationTemplateMgtService) ListTemplates(ctx context.Context,
+	filter NotificationTemplateFilter) ([]NotificationTemplate, *tidcommon.ServiceError) {
+	if filter.Scenario != "" && !IsValidScenario(filter.Scenario) {
+		return nil, &ErrorInvalidScenario
+	}
+	if filter.Type != "" && !isValidTemplateType(filter.Type) {
+		return nil, &ErrorInvalidTemplateType
+	}
+	if s.store == nil {
+		return []NotificationTemplate{}, nil
+	}
+
+	templates, err := s.store.listTemplates(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list notification templates", log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	return templates, nil
+}
+
+// GetTemplate returns the notification template with the given ID.
+func (s *notificationTemplateMgtService) GetTemplate(ctx context.Context, id string) (*NotificationTemplate,
+	*tidcommon.ServiceError) {
+	if s.store == nil {
+		return nil, &ErrorNotificationTemplateNotFound
+	}
+
+	tmpl, err := s.store.getTemplateByID(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get notification template", log.String("id", id), log.Error(err))
+		return nil, &tidcommon.InternalServerError
+	}
+	if tmpl == nil {
+		return nil, &ErrorNotificationTemplateNotFound
+	}
+	return tmpl, nil
+}
+
+// UpdateTemplate replaces the notification template with the given ID.
+func (s *notificationTemplateMgtService) UpdateTemplate(ctx context.Context, id string,
+	request NotificationTemplateRequest) (*NotificationTemplate, *tidcommon.ServiceError) {
+	if err := declarativeresource.CheckDeclarativeUpdate(); err != nil {
+		return nil, err
+	}
+
+	tmpl, svcErr := buildNotificationTemplate(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	tmpl.ID = id
+
+	transactErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.getTemplateByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			svcErr = &ErrorNotificationTemplateNotFound
+			return errors.New("template not found")
+		}
+		if svcErr = s.checkDuplicate(txCtx, tmpl); svcErr != nil {
+			return errors.New("duplicate template")
+		}
+		return s.store.updateTemplate(txCtx, *tmpl)
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if transactErr != nil {
+		s.logger.Error(ctx, "Failed to update notification template", log.String("id", id),
+			log.Error(transactErr))
+		return nil, &tidcommon.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Updated notification template", log.String("id", id))
+	return tmpl, nil
+}
+
+// DeleteTemplate deletes the notification template with the given ID, after which the scenario falls
+// back to the next matching template.
+func (s *notificationTemplateMgtService) DeleteTemplate(ctx context.Context, id string) *tidcommon.ServiceError {
+	if err := declarativeresource.CheckDeclarativeDelete(); err != nil {
+		return err
+	}
+
+	var svcErr *tidcommon.ServiceError
+	transactErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.getTemplateByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			svcErr = &ErrorNotificationTemplateNotFound
+			return errors.New("template not found")
+		}
+		return s.store.deleteTemplate(txCtx, id)
+	})
+	if svcErr != nil {
+		return svcErr
+	}
+	if transactErr != nil {
+		s.logger.Error(ctx, "Failed to delete notification template", log.String("id", id),
+			log.Error(transactErr))
+		return &tidcommon.InternalServerError
+	}
+
+	s.logger.Debug(ctx, "Deleted notification template", log.String("id", id))
+	return nil
+}
+
+// checkDuplicate returns an error when another template exists for the scope of the template.
+func (s *notificationTemplateMgtService) checkDuplicate(ctx context.Context,
+	tmpl *NotificationTemplate) *tidcommon.ServiceError {
+	existing, err := s.store.getTemplateByScope(ctx, tmpl.Scenario, tmpl.Type, tmpl.ApplicationID, tmpl.Locale)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to check for a duplicate notification template", log.Error(err))
+		return &tidcommon.InternalServerError
+	}
+	if existing != nil && existing.ID != tmpl.ID {
+		return &ErrorDuplicateTemplate
+	}
+	return nil
+}
+
+// buildNotificationTemplate validates the request and converts it to a notification template. The
+// locale is stored in its canonical form.
+func buildNotificationTemplate(request NotificationTemplateRequest) (*NotificationTemplate,
+	*tidcommon.ServiceError) {
+	if !IsValidScenario(request.Scenario) {
+		return nil, &ErrorInvalidScenario
+	}
+	if !isValidTemplateType(request.Type) {
+		return nil, &ErrorInvalidTemplateType
+	}
+	if request.Body == "" || (request.Type == TemplateTypeEmail && request.Subject == "") {
+		return nil, &ErrorInvalidTemplateContent
+	}
+	if request.ContentType != "" && request.ContentType != contentTypeTextPlain &&
+		request.ContentType != contentTypeTextHTML {
+		return nil, &ErrorInvalidTemplateContent
+	}
+
+	locale := ""
+	if request.Locale != "" {
+		tag, err := goi18n.Parse(request.Locale)
+		if err != nil {
+			return nil, &ErrorInvalidLocale
+		}
+		locale = tag.String()
+	}
+
+	for _, variable := range request.Variables {
+		if !variableNameRegex.MatchString(variable) {
+			return nil, &ErrorUndeclaredVariable
+		}
+	}
+	if len(request.Variables) > 0 {
+		for _, text := range []string{request.Subject, request.Body} {
+			for _, match := range ctxPlaceholderRegex.FindAllStringSubmatch(text, -1) {
+				if !slices.Contains(request.Variables, match[1]) {
+					return nil, &ErrorUndeclaredVariable
+				}
+			}
+		}
+	}
+
+	return &NotificationTemplate{
+		DisplayName:   request.DisplayName,
+		Scenario:      request.Scenario,
+		Type:          request.Type,
+		ApplicationID: request.ApplicationID,
+		Locale:        locale,
+		Subject:       request.Subject,
+		ContentType:   request.ContentType,
+		Body:          request.Body,
+		Variables:     request.Variables,
+	}, nil
+}
+
+// isValidTemplateType checks if the given template type is supported.
+func isValidTemplateType(tmplType TemplateType) bool {
+	return tmplType == TemplateTypeEmail || tmplType == TemplateTypeSMS
+}
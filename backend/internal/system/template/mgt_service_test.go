@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type fakeTransactioner struct{}
+
+func (f *fakeTransactioner) Transact(ctx context.Context, txFunc func(context.Context) error) error {
+	return txFunc(ctx)
+}
+
+type NotificationTemplateMgtServiceTestSuite struct {
+	suite.Suite
+	mockStore *notificationTemplateStoreInterfaceMock
+	service   *notificationTemplateMgtService
+}
+
+func TestNotificationTemplateMgtServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationTemplateMgtServiceTestSuite))
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime(suite.T().TempDir(), &config.Config{})
+	suite.Require().NoError(err)
+
+	suite.mockStore = newNotificationTemplateStoreInterfaceMock(suite.T())
+	svc := newNotificationTemplateMgtService(suite.mockStore, &fakeTransactioner{})
+	suite.service = svc.(*notificationTemplateMgtService)
+	suite.service.uuidGenerator = func() (string, error) { return "tmpl-1", nil }
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func validEmailTemplateRequest() NotificationTemplateRequest {
+	return NotificationTemplateRequest{
+		DisplayName: "Invite",
+		Scenario:    ScenarioUserInvite,
+		Type:        TemplateTypeEmail,
+		Locale:      "fr-fr",
+		Subject:     "Bienvenue",
+		ContentType: contentTypeTextHTML,
+		Body:        "Lien: {{ctx(inviteLink)}}",
+		Variables:   []string{"inviteLink"},
+	}
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestCreateTemplate() {
+	suite.mockStore.On("getTemplateByScope", mock.Anything, ScenarioUserInvite, TemplateTypeEmail, "", "fr-FR").
+		Return(nil, nil)
+	suite.mockStore.On("createTemplate", mock.Anything, mock.MatchedBy(func(tmpl NotificationTemplate) bool {
+		return tmpl.ID == "tmpl-1" && tmpl.Locale == "fr-FR"
+	})).Return(nil)
+
+	tmpl, svcErr := suite.service.CreateTemplate(context.Background(), validEmailTemplateRequest())
+
+	suite.Nil(svcErr)
+	suite.Equal("tmpl-1", tmpl.ID)
+	suite.Equal("fr-FR", tmpl.Locale)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestCreateTemplate_Duplicate() {
+	suite.mockStore.On("getTemplateByScope", mock.Anything, ScenarioUserInvite, TemplateTypeEmail, "", "fr-FR").
+		Return(&NotificationTemplate{ID: "existing"}, nil)
+
+	tmpl, svcErr := suite.service.CreateTemplate(context.Background(), validEmailTemplateRequest())
+
+	suite.Nil(tmpl)
+	suite.Equal(&ErrorDuplicateTemplate, svcErr)
+	suite.mockStore.AssertNotCalled(suite.T(), "createTemplate", mock.Anything, mock.Anything)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestCreateTemplate_StoreError() {
+	suite.mockStore.On("getTemplateByScope", mock.Anything, ScenarioUserInvite, TemplateTypeEmail, "", "fr-FR").
+		Return(nil, nil)
+	suite.mockStore.On("createTemplate", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	tmpl, svcErr := suite.service.CreateTemplate(context.Background(), validEmailTemplateRequest())
+
+	suite.Nil(tmpl)
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestCreateTemplate_DeclarativeMode() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime(suite.T().TempDir(), &config.Config{
+		DeclarativeResources: config.DeclarativeResources{Enabled: true},
+	})
+	suite.Require().NoError(err)
+
+	tmpl, svcErr := suite.service.CreateTemplate(context.Background(), validEmailTemplateRequest())
+
+	suite.Nil(tmpl)
+	suite.NotNil(svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestCreateTemplate_ValidationErrors() {
+	testCases := []struct {
+		name     string
+		modify   func(*NotificationTemplateRequest)
+		expected *tidcommon.ServiceError
+	}{
+		{"InvalidScenario", func(r *NotificationTemplateRequest) { r.Scenario = "UNKNOWN" }, &ErrorInvalidScenario},
+		{"InvalidType", func(r *NotificationTemplateRequest) { r.Type = "push" }, &ErrorInvalidTemplateType},
+		{"EmptyBody", func(r *NotificationTemplateRequest) { r.Body = "" }, &ErrorInvalidTemplateContent},
+		{"EmailWithoutSubject", func(r *NotificationTemplateRequest) { r.Subject = "" },
+			&ErrorInvalidTemplateContent},
+		{"InvalidContentType", func(r *NotificationTemplateRequest) { r.ContentType = "application/json" },
+			&ErrorInvalidTemplateContent},
+		{"InvalidLocale", func(r *NotificationTemplateRequest) { r.Locale = "not a locale" }, &ErrorInvalidLocale},
+		{"InvalidVariableName", func(r *NotificationTemplateRequest) { r.Variables = []string{"invite-link"} },
+			&ErrorUndeclaredVariable},
+		{"UndeclaredPlaceholder", func(r *NotificationTemplateRequest) { r.Variables = []string{"otp"} },
+			&ErrorUndeclaredVariable},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			request := validEmailTemplateRequest()
+			tc.modify(&request)
+
+			tmpl, svcErr := suite.service.CreateTemplate(context.Background(), request)
+
+			suite.Nil(tmpl)
+			suite.Equal(tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestCreateTemplate_SMSWithoutSubject() {
+	request := NotificationTemplateRequest{
+		Scenario: ScenarioOTP,
+		Type:     TemplateTypeSMS,
+		Body:     "Code: {{ctx(otp)}}",
+	}
+	suite.mockStore.On("getTemplateByScope", mock.Anything, ScenarioOTP, TemplateTypeSMS, "", "").Return(nil, nil)
+	suite.mockStore.On("createTemplate", mock.Anything, mock.Anything).Return(nil)
+
+	tmpl, svcErr := suite.service.CreateTemplate(context.Background(), request)
+
+	suite.Nil(svcErr)
+	suite.Equal("", tmpl.Locale)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestListTemplates() {
+	filter := NotificationTemplateFilter{Scenario: ScenarioOTP, Type: TemplateTypeSMS}
+	suite.mockStore.On("listTemplates", mock.Anything, filter).Return([]NotificationTemplate{{ID: "tmpl-1"}}, nil)
+
+	templates, svcErr := suite.service.ListTemplates(context.Background(), filter)
+
+	suite.Nil(svcErr)
+	suite.Len(templates, 1)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestListTemplates_InvalidFilter() {
+	templates, svcErr := suite.service.ListTemplates(context.Background(),
+		NotificationTemplateFilter{Scenario: "UNKNOWN"})
+	suite.Nil(templates)
+	suite.Equal(&ErrorInvalidScenario, svcErr)
+
+	templates, svcErr = suite.service.ListTemplates(context.Background(), NotificationTemplateFilter{Type: "push"})
+	suite.Nil(templates)
+	suite.Equal(&ErrorInvalidTemplateType, svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestListTemplates_WithoutStore() {
+	svc := newNotificationTemplateMgtService(nil, nil)
+
+	templates, svcErr := svc.ListTemplates(context.Background(), NotificationTemplateFilter{})
+
+	suite.Nil(svcErr)
+	suite.Empty(templates)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestGetTemplate() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "tmpl-1").Return(&NotificationTemplate{ID: "tmpl-1"}, nil)
+
+	tmpl, svcErr := suite.service.GetTemplate(context.Background(), "tmpl-1")
+
+	suite.Nil(svcErr)
+	suite.Equal("tmpl-1", tmpl.ID)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestGetTemplate_NotFound() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "missing").Return(nil, nil)
+
+	tmpl, svcErr := suite.service.GetTemplate(context.Background(), "missing")
+
+	suite.Nil(tmpl)
+	suite.Equal(&ErrorNotificationTemplateNotFound, svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestGetTemplate_StoreError() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "tmpl-1").Return(nil, errors.New("db error"))
+
+	tmpl, svcErr := suite.service.GetTemplate(context.Background(), "tmpl-1")
+
+	suite.Nil(tmpl)
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestUpdateTemplate() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "tmpl-1").Return(&NotificationTemplate{ID: "tmpl-1"}, nil)
+	suite.mockStore.On("getTemplateByScope", mock.Anything, ScenarioUserInvite, TemplateTypeEmail, "", "fr-FR").
+		Return(&NotificationTemplate{ID: "tmpl-1"}, nil)
+	suite.mockStore.On("updateTemplate", mock.Anything, mock.MatchedBy(func(tmpl NotificationTemplate) bool {
+		return tmpl.ID == "tmpl-1"
+	})).Return(nil)
+
+	tmpl, svcErr := suite.service.UpdateTemplate(context.Background(), "tmpl-1", validEmailTemplateRequest())
+
+	suite.Nil(svcErr)
+	suite.Equal("tmpl-1", tmpl.ID)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestUpdateTemplate_NotFound() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "missing").Return(nil, nil)
+
+	tmpl, svcErr := suite.service.UpdateTemplate(context.Background(), "missing", validEmailTemplateRequest())
+
+	suite.Nil(tmpl)
+	suite.Equal(&ErrorNotificationTemplateNotFound, svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestUpdateTemplate_ConflictsWithAnotherTemplate() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "tmpl-1").Return(&NotificationTemplate{ID: "tmpl-1"}, nil)
+	suite.mockStore.On("getTemplateByScope", mock.Anything, ScenarioUserInvite, TemplateTypeEmail, "", "fr-FR").
+		Return(&NotificationTemplate{ID: "tmpl-2"}, nil)
+
+	tmpl, svcErr := suite.service.UpdateTemplate(context.Background(), "tmpl-1", validEmailTemplateRequest())
+
+	suite.Nil(tmpl)
+	suite.Equal(&ErrorDuplicateTemplate, svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestDeleteTemplate() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "tmpl-1").Return(&NotificationTemplate{ID: "tmpl-1"}, nil)
+	suite.mockStore.On("deleteTemplate", mock.Anything, "tmpl-1").Return(nil)
+
+	svcErr := suite.service.DeleteTemplate(context.Background(), "tmpl-1")
+
+	suite.Nil(svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestDeleteTemplate_NotFound() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "missing").Return(nil, nil)
+
+	svcErr := suite.service.DeleteTemplate(context.Background(), "missing")
+
+	suite.Equal(&ErrorNotificationTemplateNotFound, svcErr)
+}
+
+func (suite *NotificationTemplateMgtServiceTestSuite) TestDeleteTemplate_StoreError() {
+	suite.mockStore.On("getTemplateByID", mock.Anything, "tmpl-1").Return(&NotificationTemplate{ID: "tmpl-1"}, nil)
+	suite.mockStore.On("deleteTemplate", mock.Anything, "tmpl-1").Return(errors.New("db error"))
+
+	svcErr := suite.service.DeleteTemplate(context.Background(), "tmpl-1")
+
+	suite.Equal(&tidcommon.InternalServerError, svcErr)
+}
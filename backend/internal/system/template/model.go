@@ -81,3 +81,59 @@ type RenderedTemplate struct {
 	Body    string
 	IsHTML  bool
 }
+
+// TemplateSelector narrows the template rendered for a scenario to an application and the preferred
+// locales of the recipient, most preferred first. An empty selector selects the deployment template.
+type TemplateSelector struct {
+	ApplicationID string
+	Locales       []string
+}
+
+// NotificationTemplate is a template stored in the database that overrides the default template of a
+// scenario and channel. An empty ApplicationID applies it to all applications and an empty Locale to
+// all locales. Variables declares the {{ctx(name)}} placeholders the subject and body may use.
+type NotificationTemplate struct {
+	ID            string       `json:"id"`
+	DisplayName   string       `json:"displayName,omitempty"`
+	Scenario      ScenarioType `json:"scenario"`
+	Type          TemplateType `json:"type"`
+	ApplicationID string       `json:"applicationId,omitempty"`
+	Locale        string       `json:"locale,omitempty"`
+	Subject       string       `json:"subject,omitempty"`
+	ContentType   string       `json:"contentType,omitempty"`
+	Body          string       `json:"body"`
+	Variables     []string     `json:"variables,omitempty"`
+}
+
+// toTemplateDTO converts the notification template to the DTO the renderer uses.
+func (t *NotificationTemplate) toTemplateDTO() *TemplateDTO {
+	return &TemplateDTO{
+		ID:          t.ID,
+		DisplayName: t.DisplayName,
+		Scenario:    t.Scenario,
+		Type:        t.Type,
+		Subject:     t.Subject,
+		ContentType: t.ContentType,
+		Body:        t.Body,
+	}
+}
+
+// NotificationTemplateRequest is the request body to create or update a notification template.
+type NotificationTemplateRequest struct {
+	DisplayName   string       `json:"displayName"`
+	Scenario      ScenarioType `json:"scenario"`
+	Type          TemplateType `json:"type"`
+	ApplicationID string       `json:"applicationId"`
+	Locale        string       `json:"locale"`
+	Subject       string       `json:"subject"`
+	ContentType   string       `json:"contentType"`
+	Body          string       `json:"body"`
+	Variables     []string     `json:"variables"`
+}
+
+// NotificationTemplateFilter narrows the listed notification templates. Empty fields match all.
+type NotificationTemplateFilter struct {
+	Scenario      ScenarioType
+	Type          TemplateType
+	ApplicationID string
+}
@@ -0,0 +1,240 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// notificationTemplateStoreInterface defines the storage operations for notification templates.
+type notificationTemplateStoreInterface interface {
+	createTemplate(ctx context.Context, tmpl NotificationTemplate) error
+	getTemplateByID(ctx context.Context, id string) (*NotificationTemplate, error)
+	getTemplateByScope(ctx context.Context, scenario ScenarioType, tmplType TemplateType,
+		appID, locale string) (*NotificationTemplate, error)
+	listTemplates(ctx context.Context, filter NotificationTemplateFilter) ([]NotificationTemplate, error)
+	listScenarioTemplates(ctx context.Context, scenario ScenarioType, tmplType TemplateType,
+		appID string) ([]NotificationTemplate, error)
+	updateTemplate(ctx context.Context, tmpl NotificationTemplate) error
+	deleteTemplate(ctx context.Context, id string) error
+}
+
+// notificationTemplateStore is the database-backed notification template store.
+type notificationTemplateStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newNotificationTemplateStore returns a new notification template store and the transactioner of
+// the configuration database.
+func newNotificationTemplateStore() (notificationTemplateStoreInterface, transaction.Transactioner, error) {
+	dbProvider := provider.GetDBProvider()
+	client, err := dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	tx, err := client.GetTransactioner()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transactioner: %w", err)
+	}
+	return &notificationTemplateStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, tx, nil
+}
+
+// createTemplate creates a notification template.
+func (s *notificationTemplateStore) createTemplate(ctx context.Context, tmpl NotificationTemplate) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	variables, err := serializeVariables(tmpl.Variables)
+	if err != nil {
+		return err
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateNotificationTemplate, tmpl.ID, tmpl.DisplayName,
+		string(tmpl.Scenario), string(tmpl.Type), tmpl.ApplicationID, tmpl.Locale, tmpl.Subject,
+		tmpl.ContentType, tmpl.Body, variables, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to create notification template: %w", err)
+	}
+	return nil
+}
+
+// getTemplateByID returns the notification template with the given ID, or nil when it does not exist.
+func (s *notificationTemplateStore) getTemplateByID(ctx context.Context, id string) (
+	*NotificationTemplate, error) {
+	return s.getSingleTemplate(ctx, func(dbClient provider.DBClientInterface) ([]map[string]interface{}, error) {
+		return dbClient.QueryContext(ctx, queryGetNotificationTemplateByID, id, s.deploymentID)
+	})
+}
+
+// getTemplateByScope returns the notification template of a scenario, type, application and locale,
+// or nil when it does not exist.
+func (s *notificationTemplateStore) getTemplateByScope(ctx context.Context, scenario ScenarioType,
+	tmplType TemplateType, appID, locale string) (*NotificationTemplate, error) {
+	return s.getSingleTemplate(ctx, func(dbClient provider.DBClientInterface) ([]map[string]interface{}, error) {
+		return dbClient.QueryContext(ctx, queryGetNotificationTemplateByScope, string(scenario), string(tmplType),
+			appID, locale, s.deploymentID)
+	})
+}
+
+// listTemplates returns the notification templates that match the filter.
+func (s *notificationTemplateStore) listTemplates(ctx context.Context, filter NotificationTemplateFilter) (
+	[]NotificationTemplate, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListNotificationTemplates, string(filter.Scenario),
+		string(filter.Type), filter.ApplicationID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification templates: %w", err)
+	}
+	return buildTemplatesFromResultRows(results)
+}
+
+// listScenarioTemplates returns the notification templates of a scenario and type that apply to the
+// application, including the templates of the deployment.
+func (s *notificationTemplateStore) listScenarioTemplates(ctx context.Context, scenario ScenarioType,
+	tmplType TemplateType, appID string) ([]NotificationTemplate, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListScenarioTemplates, string(scenario), string(tmplType),
+		appID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scenario templates: %w", err)
+	}
+	return buildTemplatesFromResultRows(results)
+}
+
+// updateTemplate updates a notification template.
+func (s *notificationTemplateStore) updateTemplate(ctx context.Context, tmpl NotificationTemplate) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	variables, err := serializeVariables(tmpl.Variables)
+	if err != nil {
+		return err
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateNotificationTemplate, tmpl.DisplayName,
+		string(tmpl.Scenario), string(tmpl.Type), tmpl.ApplicationID, tmpl.Locale, tmpl.Subject,
+		tmpl.ContentType, tmpl.Body, variables, tmpl.ID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to update notification template: %w", err)
+	}
+	return nil
+}
+
+// deleteTemplate deletes a notification template.
+func (s *notificationTemplateStore) deleteTemplate(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteNotificationTemplate, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete notification template: %w", err)
+	}
+	return nil
+}
+
+// getSingleTemplate runs a query that matches at most one notification template.
+func (s *notificationTemplateStore) getSingleTemplate(ctx context.Context,
+	query func(dbClient provider.DBClientInterface) ([]map[string]interface{}, error)) (
+	*NotificationTemplate, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := query(dbClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification template: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	return buildTemplateFromResultRow(results[0])
+}
+
+// buildTemplatesFromResultRows builds notification templates from database result rows.
+func buildTemplatesFromResultRows(rows []map[string]interface{}) ([]NotificationTemplate, error) {
+	templates := make([]NotificationTemplate, 0, len(rows))
+	for _, row := range rows {
+		tmpl, err := buildTemplateFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, *tmpl)
+	}
+	return templates, nil
+}
+
+// buildTemplateFromResultRow builds a notification template from a database result row.
+func buildTemplateFromResultRow(row map[string]interface{}) (*NotificationTemplate, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+
+	tmpl := &NotificationTemplate{
+		ID:            id,
+		DisplayName:   sysutils.ConvertInterfaceValueToString(row["display_name"]),
+		Scenario:      ScenarioType(sysutils.ConvertInterfaceValueToString(row["scenario"])),
+		Type:          TemplateType(sysutils.ConvertInterfaceValueToString(row["type"])),
+		ApplicationID: sysutils.ConvertInterfaceValueToString(row["app_id"]),
+		Locale:        sysutils.ConvertInterfaceValueToString(row["locale"]),
+		Subject:       sysutils.ConvertInterfaceValueToString(row["subject"]),
+		ContentType:   sysutils.ConvertInterfaceValueToString(row["content_type"]),
+		Body:          sysutils.ConvertInterfaceValueToString(row["body"]),
+	}
+	if variables := sysutils.ConvertInterfaceValueToString(row["variables"]); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &tmpl.Variables); err != nil {
+			return nil, fmt.Errorf("failed to parse variables of notification template: %w", err)
+		}
+	}
+	return tmpl, nil
+}
+
+// serializeVariables encodes the declared variables as a JSON array, or returns an empty string when
+// there are none.
+func serializeVariables(variables []string) (string, error) {
+	if len(variables) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(variables)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize variables of notification template: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type NotificationTemplateStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *notificationTemplateStore
+}
+
+func TestNotificationTemplateStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationTemplateStoreTestSuite))
+}
+
+func (suite *NotificationTemplateStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &notificationTemplateStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestCreateTemplate() {
+	tmpl := NotificationTemplate{
+		ID:          "tmpl-1",
+		DisplayName: "Invite",
+		Scenario:    ScenarioUserInvite,
+		Type:        TemplateTypeEmail,
+		Locale:      "fr-FR",
+		Subject:     "Bienvenue",
+		ContentType: "text/html",
+		Body:        "Lien: {{ctx(inviteLink)}}",
+		Variables:   []string{"inviteLink"},
+	}
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateNotificationTemplate,
+		"tmpl-1", "Invite", "USER_INVITE", "email", "", "fr-FR", "Bienvenue", "text/html",
+		"Lien: {{ctx(inviteLink)}}", `["inviteLink"]`, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createTemplate(context.Background(), tmpl)
+	suite.NoError(err)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestCreateTemplate_GetConfigDBClientError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createTemplate(context.Background(), NotificationTemplate{ID: "tmpl-1"})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestGetTemplateByID() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetNotificationTemplateByID,
+		"tmpl-1", testDeploymentID).Return([]map[string]interface{}{
+		{
+			"id":        "tmpl-1",
+			"scenario":  "OTP",
+			"type":      "sms",
+			"app_id":    "app-1",
+			"locale":    "de",
+			"body":      "Code: {{ctx(otp)}}",
+			"variables": `["otp"]`,
+		},
+	}, nil).Once()
+
+	tmpl, err := suite.store.getTemplateByID(context.Background(), "tmpl-1")
+	suite.NoError(err)
+	suite.Equal("tmpl-1", tmpl.ID)
+	suite.Equal(ScenarioOTP, tmpl.Scenario)
+	suite.Equal(TemplateTypeSMS, tmpl.Type)
+	suite.Equal("app-1", tmpl.ApplicationID)
+	suite.Equal("de", tmpl.Locale)
+	suite.Equal([]string{"otp"}, tmpl.Variables)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestGetTemplateByID_NotFound() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetNotificationTemplateByID,
+		"missing", testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	tmpl, err := suite.store.getTemplateByID(context.Background(), "missing")
+	suite.NoError(err)
+	suite.Nil(tmpl)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestGetTemplateByID_InvalidVariables() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetNotificationTemplateByID,
+		"tmpl-1", testDeploymentID).Return([]map[string]interface{}{
+		{"id": "tmpl-1", "variables": "not-json"},
+	}, nil).Once()
+
+	tmpl, err := suite.store.getTemplateByID(context.Background(), "tmpl-1")
+	suite.Error(err)
+	suite.Nil(tmpl)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestGetTemplateByScope() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetNotificationTemplateByScope,
+		"OTP", "sms", "", "", testDeploymentID).Return([]map[string]interface{}{{"id": "tmpl-1"}}, nil).Once()
+
+	tmpl, err := suite.store.getTemplateByScope(context.Background(), ScenarioOTP, TemplateTypeSMS, "", "")
+	suite.NoError(err)
+	suite.Equal("tmpl-1", tmpl.ID)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestListTemplates() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListNotificationTemplates,
+		"OTP", "", "app-1", testDeploymentID).Return([]map[string]interface{}{
+		{"id": "tmpl-1"}, {"id": "tmpl-2"},
+	}, nil).Once()
+
+	templates, err := suite.store.listTemplates(context.Background(),
+		NotificationTemplateFilter{Scenario: ScenarioOTP, ApplicationID: "app-1"})
+	suite.NoError(err)
+	suite.Len(templates, 2)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestListTemplates_QueryError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListNotificationTemplates,
+		"", "", "", testDeploymentID).Return(nil, errors.New("query failed")).Once()
+
+	templates, err := suite.store.listTemplates(context.Background(), NotificationTemplateFilter{})
+	suite.Error(err)
+	suite.Nil(templates)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestListScenarioTemplates() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListScenarioTemplates,
+		"OTP", "sms", "app-1", testDeploymentID).Return([]map[string]interface{}{{"id": "tmpl-1"}}, nil).Once()
+
+	templates, err := suite.store.listScenarioTemplates(context.Background(), ScenarioOTP, TemplateTypeSMS, "app-1")
+	suite.NoError(err)
+	suite.Len(templates, 1)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestUpdateTemplate() {
+	tmpl := NotificationTemplate{ID: "tmpl-1", Scenario: ScenarioOTP, Type: TemplateTypeSMS, Body: "Code"}
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateNotificationTemplate,
+		"", "OTP", "sms", "", "", "", "", "Code", "", "tmpl-1", testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.updateTemplate(context.Background(), tmpl)
+	suite.NoError(err)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestDeleteTemplate() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteNotificationTemplate,
+		"tmpl-1", testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.deleteTemplate(context.Background(), "tmpl-1")
+	suite.NoError(err)
+}
+
+func (suite *NotificationTemplateStoreTestSuite) TestDeleteTemplate_ExecuteError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteNotificationTemplate,
+		"tmpl-1", testDeploymentID).Return(int64(0), errors.New("exec failed")).Once()
+
+	err := suite.store.deleteTemplate(context.Background(), "tmpl-1")
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to delete notification template")
+}
@@ -25,22 +25,29 @@ import (
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
+	goi18n "golang.org/x/text/language"
+
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
 var ctxPlaceholderRegex = regexp.MustCompile(`\{\{ctx\((\w+)\)}}`)
 
-// templateService implements TemplateServiceInterface using a templateStoreInterface.
+// templateService implements TemplateServiceInterface using a templateStoreInterface for the default
+// templates and an optional notificationTemplateStoreInterface for the templates that override them.
 type templateService struct {
-	store  templateStoreInterface
-	logger *log.Logger
+	store         templateStoreInterface
+	overrideStore notificationTemplateStoreInterface
+	logger        *log.Logger
 }
 
-// newTemplateService creates a new template service with the provided store.
-func newTemplateService(store templateStoreInterface) TemplateServiceInterface {
+// newTemplateService creates a new template service with the provided stores. A nil override store
+// renders the default templates only.
+func newTemplateService(store templateStoreInterface,
+	overrideStore notificationTemplateStoreInterface) TemplateServiceInterface {
 	return &templateService{
-		store:  store,
-		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TemplateService")),
+		store:         store,
+		overrideStore: overrideStore,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TemplateService")),
 	}
 }
 
@@ -67,15 +74,30 @@ func (s *templateService) GetTemplateByScenario(
 	return tmpl, nil
 }
 
-// Render renders a template for the specified scenario and template type using the provided data.
+// Render renders the deployment template for the specified scenario and template type using the
+// provided data.
 func (s *templateService) Render(
 	ctx context.Context,
 	scenario ScenarioType,
 	tmplType TemplateType,
 	data TemplateData,
+) (*RenderedTemplate, *tidcommon.ServiceError) {
+	return s.RenderFor(ctx, scenario, tmplType, TemplateSelector{}, data)
+}
+
+// RenderFor renders the template for the specified scenario and template type that best matches the
+// selector using the provided data. A template of the application is preferred over one of the
+// deployment, and within each a template of a preferred locale over the one for all locales. The
+// default template of the scenario is rendered when no stored template matches.
+func (s *templateService) RenderFor(
+	ctx context.Context,
+	scenario ScenarioType,
+	tmplType TemplateType,
+	selector TemplateSelector,
+	data TemplateData,
 ) (*RenderedTemplate, *tidcommon.ServiceError) {
 	s.logger.Debug(ctx, "Rendering template", log.String("scenario", string(scenario)))
-	tmpl, svcErr := s.GetTemplateByScenario(ctx, scenario, tmplType)
+	tmpl, svcErr := s.resolveTemplate(ctx, scenario, tmplType, selector)
 	if svcErr != nil {
 		return nil, svcErr
 	}
@@ -113,3 +135,68 @@ func (s *templateService) Render(
 
 	return rendered, nil
 }
+
+// resolveTemplate returns the stored template that best matches the selector, or the default template
+// of the scenario when none matches.
+func (s *templateService) resolveTemplate(
+	ctx context.Context,
+	scenario ScenarioType,
+	tmplType TemplateType,
+	selector TemplateSelector,
+) (*TemplateDTO, *tidcommon.ServiceError) {
+	if s.overrideStore != nil {
+		candidates, err := s.overrideStore.listScenarioTemplates(ctx, scenario, tmplType, selector.ApplicationID)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to list notification templates of scenario",
+				log.String("scenario", string(scenario)), log.Error(err))
+			return nil, &tidcommon.InternalServerError
+		}
+		if tmpl := selectTemplate(candidates, selector); tmpl != nil {
+			return tmpl.toTemplateDTO(), nil
+		}
+	}
+	return s.GetTemplateByScenario(ctx, scenario, tmplType)
+}
+
+// selectTemplate picks the candidate that best matches the selector: the templates of the application
+// are tried before those of the deployment, and within each the best match of the preferred locales
+// before the template for all locales. Returns nil when no candidate applies.
+func selectTemplate(candidates []NotificationTemplate, selector TemplateSelector) *NotificationTemplate {
+	preferred := make([]goi18n.Tag, 0, len(selector.Locales))
+	for _, locale := range selector.Locales {
+		if tag, err := goi18n.Parse(locale); err == nil {
+			preferred = append(preferred, tag)
+		}
+	}
+
+	scopes := []string{""}
+	if selector.ApplicationID != "" {
+		scopes = []string{selector.ApplicationID, ""}
+	}
+	for _, appID := range scopes {
+		var fallback *NotificationTemplate
+		localized := make([]*NotificationTemplate, 0)
+		tags := make([]goi18n.Tag, 0)
+		for i := range candidates {
+			candidate := &candidates[i]
+			if candidate.ApplicationID != appID {
+				continue
+			}
+			if candidate.Locale == "" {
+				fallback = candidate
+				continue
+			}
+			localized = append(localized, candidate)
+			tags = append(tags, goi18n.Make(candidate.Locale))
+		}
+		if len(preferred) > 0 && len(localized) > 0 {
+			if _, index, confidence := goi18n.NewMatcher(tags).Match(preferred...); confidence != goi18n.No {
+				return localized[index]
+			}
+		}
+		if fallback != nil {
+			return fallback
+		}
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -41,7 +42,7 @@ func TestTemplateServiceTestSuite(t *testing.T) {
 
 func (suite *TemplateServiceTestSuite) SetupTest() {
 	suite.mockStore = newTemplateStoreInterfaceMock(suite.T())
-	suite.service = newTemplateService(suite.mockStore)
+	suite.service = newTemplateService(suite.mockStore, nil)
 }
 
 func (suite *TemplateServiceTestSuite) TestGetTemplateByScenario() {
@@ -176,3 +177,84 @@ func (suite *TemplateServiceTestSuite) TestRender_SelfRegistrationScenario() {
 	suite.Equal("Register at https://example.com/invite", res.Body)
 	suite.False(res.IsHTML)
 }
+
+func (suite *TemplateServiceTestSuite) TestRenderFor_UsesOverrideTemplate() {
+	overrideStore := newNotificationTemplateStoreInterfaceMock(suite.T())
+	overrideStore.On("listScenarioTemplates", mock.Anything, ScenarioOTP, TemplateTypeSMS, "app-1").
+		Return([]NotificationTemplate{
+			{ID: "deployment", Type: TemplateTypeSMS, Body: "Code: {{ctx(otp)}}"},
+			{ID: "app", Type: TemplateTypeSMS, ApplicationID: "app-1", Body: "App code: {{ctx(otp)}}"},
+		}, nil)
+	service := newTemplateService(suite.mockStore, overrideStore)
+
+	res, err := service.RenderFor(context.Background(), ScenarioOTP, TemplateTypeSMS,
+		TemplateSelector{ApplicationID: "app-1"}, TemplateData{"otp": "123456"})
+	suite.Nil(err)
+	suite.Equal("App code: 123456", res.Body)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetTemplateByScenario", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TemplateServiceTestSuite) TestRenderFor_FallsBackToDefaultTemplate() {
+	overrideStore := newNotificationTemplateStoreInterfaceMock(suite.T())
+	overrideStore.On("listScenarioTemplates", mock.Anything, ScenarioOTP, TemplateTypeSMS, "").
+		Return([]NotificationTemplate{}, nil)
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).
+		Return(&TemplateDTO{ID: "default", Type: TemplateTypeSMS, Body: "Default: {{ctx(otp)}}"}, nil)
+	service := newTemplateService(suite.mockStore, overrideStore)
+
+	res, err := service.Render(context.Background(), ScenarioOTP, TemplateTypeSMS, TemplateData{"otp": "42"})
+	suite.Nil(err)
+	suite.Equal("Default: 42", res.Body)
+}
+
+func (suite *TemplateServiceTestSuite) TestRenderFor_OverrideStoreError() {
+	overrideStore := newNotificationTemplateStoreInterfaceMock(suite.T())
+	overrideStore.On("listScenarioTemplates", mock.Anything, ScenarioOTP, TemplateTypeSMS, "").
+		Return(nil, errors.New("db error"))
+	service := newTemplateService(suite.mockStore, overrideStore)
+
+	res, err := service.Render(context.Background(), ScenarioOTP, TemplateTypeSMS, TemplateData{})
+	suite.Nil(res)
+	suite.Equal(&tidcommon.InternalServerError, err)
+}
+
+func TestSelectTemplate(t *testing.T) {
+	candidates := []NotificationTemplate{
+		{ID: "deployment"},
+		{ID: "deployment-fr", Locale: "fr"},
+		{ID: "app", ApplicationID: "app-1"},
+		{ID: "app-de", ApplicationID: "app-1", Locale: "de"},
+	}
+
+	testCases := []struct {
+		name       string
+		candidates []NotificationTemplate
+		selector   TemplateSelector
+		expected   string
+	}{
+		{"NoCandidates", nil, TemplateSelector{ApplicationID: "app-1"}, ""},
+		{"DeploymentWithoutLocale", candidates, TemplateSelector{}, "deployment"},
+		{"DeploymentLocale", candidates, TemplateSelector{Locales: []string{"fr-CA"}}, "deployment-fr"},
+		{"ApplicationWithoutLocale", candidates, TemplateSelector{ApplicationID: "app-1"}, "app"},
+		{"ApplicationLocale", candidates, TemplateSelector{ApplicationID: "app-1", Locales: []string{"de"}},
+			"app-de"},
+		{"ApplicationPreferredOverDeploymentLocale", candidates,
+			TemplateSelector{ApplicationID: "app-1", Locales: []string{"fr"}}, "app"},
+		{"UnmatchedLocale", candidates, TemplateSelector{Locales: []string{"ja"}}, "deployment"},
+		{"InvalidLocaleIgnored", candidates, TemplateSelector{Locales: []string{"not a locale"}}, "deployment"},
+		{"OnlyLocalizedTemplates", candidates[1:2], TemplateSelector{Locales: []string{"ja"}}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl := selectTemplate(tc.candidates, tc.selector)
+			if tc.expected == "" {
+				assert.Nil(t, tmpl)
+				return
+			}
+			if assert.NotNil(t, tmpl) {
+				assert.Equal(t, tc.expected, tmpl.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+const notificationTemplateColumns = `ID, DISPLAY_NAME, SCENARIO, TYPE, APP_ID, LOCALE, SUBJECT, CONTENT_TYPE, BODY, ` +
+	`VARIABLES`
+
+var (
+	// queryCreateNotificationTemplate is the query to create a notification template.
+	queryCreateNotificationTemplate = dbmodel.DBQuery{
+		ID: "TMQ-NT-01",
+		Query: `INSERT INTO "NOTIFICATION_TEMPLATE" (` + notificationTemplateColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+	}
+
+	// queryGetNotificationTemplateByID is the query to get a notification template by its ID.
+	queryGetNotificationTemplateByID = dbmodel.DBQuery{
+		ID: "TMQ-NT-02",
+		Query: `SELECT ` + notificationTemplateColumns + ` FROM "NOTIFICATION_TEMPLATE" ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetNotificationTemplateByScope is the query to get the notification template of a scenario,
+	// type, application and locale.
+	queryGetNotificationTemplateByScope = dbmodel.DBQuery{
+		ID: "TMQ-NT-03",
+		Query: `SELECT ` + notificationTemplateColumns + ` FROM "NOTIFICATION_TEMPLATE" ` +
+			`WHERE SCENARIO = $1 AND TYPE = $2 AND APP_ID = $3 AND LOCALE = $4 AND DEPLOYMENT_ID = $5`,
+	}
+
+	// queryListNotificationTemplates is the query to list notification templates. An empty filter value
+	// matches all templates.
+	queryListNotificationTemplates = dbmodel.DBQuery{
+		ID: "TMQ-NT-04",
+		Query: `SELECT ` + notificationTemplateColumns + ` FROM "NOTIFICATION_TEMPLATE" ` +
+			`WHERE ($1 = '' OR SCENARIO = $1) AND ($2 = '' OR TYPE = $2) AND ($3 = '' OR APP_ID = $3) ` +
+			`AND DEPLOYMENT_ID = $4 ORDER BY SCENARIO, TYPE, APP_ID, LOCALE`,
+	}
+
+	// queryListScenarioTemplates is the query to list the notification templates of a scenario and type
+	// that apply to an application: those of the application and those of the deployment.
+	queryListScenarioTemplates = dbmodel.DBQuery{
+		ID: "TMQ-NT-05",
+		Query: `SELECT ` + notificationTemplateColumns + ` FROM "NOTIFICATION_TEMPLATE" ` +
+			`WHERE SCENARIO = $1 AND TYPE = $2 AND (APP_ID = '' OR APP_ID = $3) AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryUpdateNotificationTemplate is the query to update a notification template.
+	queryUpdateNotificationTemplate = dbmodel.DBQuery{
+		ID: "TMQ-NT-06",
+		PostgresQuery: `UPDATE "NOTIFICATION_TEMPLATE" SET DISPLAY_NAME = $1, SCENARIO = $2, TYPE = $3, ` +
+			`APP_ID = $4, LOCALE = $5, SUBJECT = $6, CONTENT_TYPE = $7, BODY = $8, VARIABLES = $9, ` +
+			`UPDATED_AT = NOW() WHERE ID = $10 AND DEPLOYMENT_ID = $11`,
+		SQLiteQuery: `UPDATE "NOTIFICATION_TEMPLATE" SET DISPLAY_NAME = $1, SCENARIO = $2, TYPE = $3, ` +
+			`APP_ID = $4, LOCALE = $5, SUBJECT = $6, CONTENT_TYPE = $7, BODY = $8, VARIABLES = $9, ` +
+			`UPDATED_AT = datetime('now') WHERE ID = $10 AND DEPLOYMENT_ID = $11`,
+		Query: `UPDATE "NOTIFICATION_TEMPLATE" SET DISPLAY_NAME = $1, SCENARIO = $2, TYPE = $3, ` +
+			`APP_ID = $4, LOCALE = $5, SUBJECT = $6, CONTENT_TYPE = $7, BODY = $8, VARIABLES = $9, ` +
+			`UPDATED_AT = datetime('now') WHERE ID = $10 AND DEPLOYMENT_ID = $11`,
+	}
+
+	// queryDeleteNotificationTemplate is the query to delete a notification template.
+	queryDeleteNotificationTemplate = dbmodel.DBQuery{
+		ID:    "TMQ-NT-07",
+		Query: `DELETE FROM "NOTIFICATION_TEMPLATE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -28,8 +28,6 @@ import (
 	"net/http"
 	"net/url"
 	"path"
-	"reflect"
-	"strconv"
 	"strings"
 	"unicode"
 
@@ -61,8 +59,14 @@ func GetCustomErrorMessage(fe localFieldError) string {
 		return fmt.Sprintf("The field '%s' contains malformed or unparseable JSON formatting.", fe.Field())
 	case "url":
 		return fmt.Sprintf("The field '%s' must be a valid, well-formed URL.", fe.Field())
+	case "email":
+		return fmt.Sprintf("The field '%s' must be a valid email address.", fe.Field())
+	case "uuid":
+		return fmt.Sprintf("The field '%s' must be a valid UUID.", fe.Field())
+	case "len":
+		return fmt.Sprintf("The field '%s' must be exactly %s characters long.", fe.Field(), fe.Param())
 	default:
-		return fmt.Sprintf("The field '%s' failed validation validation check (%s).", fe.Field(), fe.Tag())
+		return fmt.Sprintf("The field '%s' failed validation check (%s).", fe.Field(), fe.Tag())
 	}
 }
 
@@ -81,18 +85,6 @@ func WriteStructuredErrorResponse(w http.ResponseWriter, statusCode int, message
 	_ = json.NewEncoder(w).Encode(response)
 }
 
-// Validatable defines the interface for types that support self-validation rules.
-type Validatable interface {
-	Validate() map[string]string
-}
-
-// ValidationError is a lightweight wrapper for native field errors
-type ValidationError struct {
-	Errors map[string]string
-}
-
-func (e *ValidationError) Error() string { return "Validation Failed" }
-
 // DecodeJSONBody decodes JSON from the request body into any struct type T.
 func DecodeJSONBody[T any](r *http.Request) (*T, error) {
 	var data T
@@ -105,90 +97,6 @@ func DecodeJSONBody[T any](r *http.Request) (*T, error) {
 	return &data, nil
 }
 
-func validateStructNatively(s interface{}) map[string]string {
-	fieldErrors := make(map[string]string)
-	val := reflect.ValueOf(s)
-
-	// Handle pointers automatically
-	if val.Kind() == reflect.Ptr {
-		val = val.Elem()
-	}
-	if val.Kind() != reflect.Struct {
-		return nil
-	}
-
-	typ := val.Type()
-
-	for i := 0; i < val.NumField(); i++ {
-		field := val.Field(i)
-		fieldType := typ.Field(i)
-
-		// Look for our company's approved native constraint tag
-		tagValue := fieldType.Tag.Get("native")
-		if tagValue == "" {
-			continue
-		}
-
-		jsonName := fieldType.Tag.Get("json")
-		if jsonName == "" || strings.Contains(jsonName, ",") {
-			jsonName = fieldType.Name
-		}
-		jsonName = strings.Split(jsonName, ",")[0]
-
-		// Parse individual rules split by commas (e.g., "required,min=3")
-		rules := strings.Split(tagValue, ",")
-		for _, rule := range rules {
-			rule = strings.TrimSpace(rule)
-
-			// Rule 1: Required
-			if rule == "required" {
-				if isZeroValue(field) {
-					fieldErrors[jsonName] = "The field '" + jsonName + "' is missing but is strictly required."
-					break
-				}
-			}
-
-			// Rule 2: Minimum Length Bounds
-			if strings.HasPrefix(rule, "min=") {
-				minStr := strings.TrimPrefix(rule, "min=")
-				minVal, _ := strconv.Atoi(minStr)
-				if field.Kind() == reflect.String && len(field.String()) < minVal {
-					fieldErrors[jsonName] = "The field '" + jsonName +
-						"' must be at least " + minStr + " characters long."
-					break
-				}
-			}
-
-			// Rule 3: Maximum Length Bounds
-			if strings.HasPrefix(rule, "max=") {
-				maxStr := strings.TrimPrefix(rule, "max=")
-				maxVal, _ := strconv.Atoi(maxStr)
-				if field.Kind() == reflect.String && len(field.String()) > maxVal {
-					fieldErrors[jsonName] = "The field '" + jsonName +
-						"' exceeds its maximum allowed size of " + maxStr + " characters."
-					break
-				}
-			}
-		}
-	}
-
-	if len(fieldErrors) > 0 {
-		return fieldErrors
-	}
-	return nil
-}
-
-func isZeroValue(v reflect.Value) bool {
-	switch v.Kind() {
-	case reflect.String:
-		return strings.TrimSpace(v.String()) == ""
-	case reflect.Slice, reflect.Array, reflect.Map:
-		return v.Len() == 0
-	default:
-		return v.IsZero()
-	}
-}
-
 // WriteJSONError writes a JSON error response with the given details.
 func WriteJSONError(ctx context.Context, w http.ResponseWriter, code, desc string, statusCode int,
 	respHeaders []map[string]string) {
@@ -318,6 +226,16 @@ func isHostAlphaNum(b byte) bool {
 	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z')
 }
 
+// MatchPathPattern reports whether requestPath matches the glob-style pattern. * matches exactly
+// one path segment and ** matches zero or more segments. Both paths are cleaned (resolving . and
+// .. segments) before matching to prevent path traversal.
+func MatchPathPattern(pattern, requestPath string) bool {
+	if pattern == "" || requestPath == "" {
+		return false
+	}
+	return matchPathPattern(path.Clean(pattern), path.Clean(requestPath))
+}
+
 // matchPathPattern reports whether incomingPath matches patternPath.
 // Wildcards * (one segment) and ** (zero or more segments) are supported in patternPath.
 func matchPathPattern(patternPath, incomingPath string) bool {
@@ -573,3 +491,23 @@ func WriteErrorResponse(ctx context.Context, w http.ResponseWriter, statusCode i
 		_, _ = w.Write(b)
 	}
 }
+
+// WriteProblemResponse writes an RFC 9457 problem details response with the given status code and
+// error details. The body carries the code, message and description of the error response as
+// extension members.
+func WriteProblemResponse(ctx context.Context, w http.ResponseWriter, statusCode int,
+	errorResp apierror.ErrorResponse) {
+	logger := log.GetLogger()
+	w.Header().Set(constants.ContentTypeHeaderName, constants.ContentTypeProblemJSON)
+	w.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(w).Encode(apierror.NewProblemDetails(statusCode, errorResp)); err != nil {
+		logger.Error(ctx, "Failed to encode problem details response", log.Error(err))
+		b, _ := json.Marshal(apierror.NewProblemDetails(http.StatusInternalServerError, apierror.ErrorResponse{
+			Code:        tidcommon.ErrorEncodingError.Code,
+			Message:     tidcommon.ErrorEncodingError.Error,
+			Description: tidcommon.ErrorEncodingError.ErrorDescription,
+		}))
+		_, _ = w.Write(b)
+	}
+}
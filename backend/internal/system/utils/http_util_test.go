@@ -861,6 +861,57 @@ func (suite *HTTPUtilTestSuite) TestWriteI18nErrorResponse() {
 	}
 }
 
+func (suite *HTTPUtilTestSuite) TestWriteProblemResponse() {
+	w := httptest.NewRecorder()
+	errorResp := apierror.ErrorResponse{
+		Code:        "USR-1003",
+		Message:     tidcommon.I18nMessage{Key: "error.user_not_found", DefaultValue: "User not found"},
+		Description: tidcommon.I18nMessage{Key: "error.user_not_found_desc", DefaultValue: "No user with the ID"},
+	}
+
+	WriteProblemResponse(context.Background(), w, http.StatusNotFound, errorResp)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+	suite.Equal("application/problem+json", w.Header().Get("Content-Type"))
+	var problem apierror.ProblemDetails
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &problem))
+	suite.Equal(apierror.ProblemDetails{
+		Type:          "urn:thunderid:error:USR-1003",
+		Title:         "User not found",
+		Status:        http.StatusNotFound,
+		Detail:        "No user with the ID",
+		ErrorResponse: errorResp,
+	}, problem)
+
+	var legacy apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &legacy))
+	suite.Equal(errorResp, legacy)
+}
+
+func (suite *HTTPUtilTestSuite) TestWriteProblemResponse_WithoutCodeOrMessage() {
+	w := httptest.NewRecorder()
+
+	WriteProblemResponse(context.Background(), w, http.StatusBadRequest, apierror.ErrorResponse{})
+
+	var problem apierror.ProblemDetails
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &problem))
+	suite.Equal("about:blank", problem.Type)
+	suite.Equal("Bad Request", problem.Title)
+	suite.Empty(problem.Detail)
+}
+
+func (suite *HTTPUtilTestSuite) TestWriteProblemResponse_EncodingFallback() {
+	rec := httptest.NewRecorder()
+	w := &failingWriter{ResponseRecorder: rec}
+
+	WriteProblemResponse(context.Background(), w, http.StatusBadRequest, apierror.ErrorResponse{Code: "test_error"})
+
+	var problem apierror.ProblemDetails
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &problem))
+	suite.Equal(tidcommon.ErrorEncodingError.Code, problem.Code)
+	suite.Equal(apierror.ProblemTypePrefix+tidcommon.ErrorEncodingError.Code, problem.Type)
+}
+
 func (suite *HTTPUtilTestSuite) TestDecodeJSONResponse() {
 	type testStruct struct {
 		Name string `json:"name"`
@@ -1173,6 +1224,32 @@ func (suite *HTTPUtilTestSuite) TestMatchURIPattern() {
 	}
 }
 
+func (suite *HTTPUtilTestSuite) TestMatchPathPattern() {
+	tests := []struct {
+		name      string
+		pattern   string
+		path      string
+		wantMatch bool
+	}{
+		{name: "ExactMatch", pattern: "/users", path: "/users", wantMatch: true},
+		{name: "ExactMismatch", pattern: "/users", path: "/groups", wantMatch: false},
+		{name: "SingleStarMatchesOneSegment", pattern: "/users/*", path: "/users/abc", wantMatch: true},
+		{name: "SingleStarNoMatchTwoSegments", pattern: "/users/*", path: "/users/a/b", wantMatch: false},
+		{name: "DoubleStarMatchesBase", pattern: "/flow/execute/**", path: "/flow/execute", wantMatch: true},
+		{name: "DoubleStarMatchesSubpaths", pattern: "/flow/execute/**", path: "/flow/execute/a/b", wantMatch: true},
+		{name: "TrailingSlashCleaned", pattern: "/users", path: "/users/", wantMatch: true},
+		{name: "TraversalCleaned", pattern: "/health/**", path: "/health/../users", wantMatch: false},
+		{name: "EmptyPattern", pattern: "", path: "/users", wantMatch: false},
+		{name: "EmptyPath", pattern: "/users", path: "", wantMatch: false},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantMatch, MatchPathPattern(tt.pattern, tt.path))
+		})
+	}
+}
+
 func (suite *HTTPUtilTestSuite) TestValidationCustomErrorsAndStructuredResponse() {
 	suite.T().Run("TranslateAndWriteValidationMap", func(t *testing.T) {
 		urlFieldError := mockFieldError{tag: "url", field: "URLField"}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrInvalidMergePatch is returned when a merge patch document is not a JSON object.
+var ErrInvalidMergePatch = errors.New("merge patch must be a JSON object")
+
+// ApplyMergePatch applies a JSON Merge Patch (RFC 7396) document to the original JSON document and
+// returns the patched document. Members set to null in the patch are removed from the original,
+// objects are merged recursively and every other value (including arrays) replaces the original.
+func ApplyMergePatch(original, patch []byte) ([]byte, error) {
+	patchValue, err := decodeJSONValue(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode merge patch: %w", err)
+	}
+	if _, ok := patchValue.(map[string]interface{}); !ok {
+		return nil, ErrInvalidMergePatch
+	}
+
+	originalValue, err := decodeJSONValue(original)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode original document: %w", err)
+	}
+
+	return json.Marshal(mergePatchValue(originalValue, patchValue))
+}
+
+// DecodeMergePatchBody reads a JSON Merge Patch document from the request body, applies it to the
+// JSON representation of current and decodes the result into T. The patched value is validated the
+// same way as DecodeJSONBody, so a patch cannot produce a request that a full update would reject.
+func DecodeMergePatchBody[T any](r *http.Request, current interface{}) (*T, error) {
+	patch, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, errors.New("failed to read request body: " + err.Error())
+	}
+
+	original, err := json.Marshal(current)
+	if err != nil {
+		return nil, errors.New("failed to encode current resource: " + err.Error())
+	}
+
+	patched, err := ApplyMergePatch(original, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	var data T
+	if err := json.Unmarshal(patched, &data); err != nil {
+		return nil, errors.New("failed to decode JSON: " + err.Error())
+	}
+	if fieldErrors := validateStructNatively(data); fieldErrors != nil {
+		return nil, &ValidationError{Errors: fieldErrors}
+	}
+	return &data, nil
+}
+
+// mergePatchValue implements the MergePatch function of RFC 7396 section 2.
+func mergePatchValue(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{}, len(patchObj))
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergePatchValue(targetObj[key], value)
+	}
+	return targetObj
+}
+
+// decodeJSONValue decodes a JSON document, keeping numbers as json.Number so that integer values
+// survive the round trip without losing precision.
+func decodeJSONValue(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after JSON document")
+	}
+	return value, nil
+}
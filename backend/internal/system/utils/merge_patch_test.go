@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MergePatchTestSuite struct {
+	suite.Suite
+}
+
+func TestMergePatchSuite(t *testing.T) {
+	suite.Run(t, new(MergePatchTestSuite))
+}
+
+func (suite *MergePatchTestSuite) TestApplyMergePatch() {
+	// Cases from RFC 7396 Appendix A, with a top-level object patch.
+	cases := []struct {
+		name     string
+		original string
+		patch    string
+		expected string
+	}{
+		{"replace member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"add member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"remove member", `{"a":"b"}`, `{"a":null}`, `{}`},
+		{"remove one of two", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"array replaced", `{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"value replaced by array", `{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{"nested merge", `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{"arrays not merged", `{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{"nested null in new object", `{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{"non-object original", `["a","b"]`, `{"a":"b"}`, `{"a":"b"}`},
+		{"nested object created", `{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{"large integer kept", `{"n":9007199254740993}`, `{"m":1}`, `{"m":1,"n":9007199254740993}`},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			patched, err := ApplyMergePatch([]byte(tc.original), []byte(tc.patch))
+			suite.Require().NoError(err)
+			suite.JSONEq(tc.expected, string(patched))
+		})
+	}
+}
+
+func (suite *MergePatchTestSuite) TestApplyMergePatch_NonObjectPatch() {
+	_, err := ApplyMergePatch([]byte(`{"a":"b"}`), []byte(`["c"]`))
+	suite.ErrorIs(err, ErrInvalidMergePatch)
+}
+
+func (suite *MergePatchTestSuite) TestApplyMergePatch_InvalidJSON() {
+	_, err := ApplyMergePatch([]byte(`{"a":"b"}`), []byte(`{"a":`))
+	suite.Error(err)
+
+	_, err = ApplyMergePatch([]byte(`{"a":"b"}`), []byte(`{"a":1} {"b":2}`))
+	suite.Error(err)
+}
+
+type mergePatchTarget struct {
+	Name        string `json:"name" native:"required"`
+	Description string `json:"description,omitempty"`
+}
+
+func (suite *MergePatchTestSuite) TestDecodeMergePatchBody() {
+	req := httptest.NewRequest("PATCH", "/resources/1", strings.NewReader(`{"description":"updated"}`))
+
+	result, err := DecodeMergePatchBody[mergePatchTarget](req,
+		mergePatchTarget{Name: "resource", Description: "original"})
+
+	suite.Require().NoError(err)
+	suite.Equal("resource", result.Name)
+	suite.Equal("updated", result.Description)
+}
+
+func (suite *MergePatchTestSuite) TestDecodeMergePatchBody_ValidationError() {
+	req := httptest.NewRequest("PATCH", "/resources/1", strings.NewReader(`{"name":null}`))
+
+	_, err := DecodeMergePatchBody[mergePatchTarget](req, mergePatchTarget{Name: "resource"})
+
+	var valErr *ValidationError
+	suite.Require().ErrorAs(err, &valErr)
+	suite.Contains(valErr.Errors, "name")
+}
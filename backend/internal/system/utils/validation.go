@@ -0,0 +1,371 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// nativeTagName is the struct tag holding the comma separated validation rules of a request field,
+// e.g. `native:"required,min=3,max=100"`.
+//
+// Supported rules:
+//   - required: the field must be present and non-empty.
+//   - omitempty: skip the remaining rules when the field is empty.
+//   - min=N, max=N, len=N: bounds on the character count of strings, the item count of slices and
+//     maps, or the value of numbers.
+//   - oneof=a b c: the value must be one of the space separated options.
+//   - url, email, uuid: format checks for strings.
+//   - dive: apply the rules that follow to every element of a slice, array or map.
+//
+// Nested structs, pointers to structs and the elements of a dived collection are validated
+// recursively, and violations are reported against their JSON path, e.g. "members[0].type".
+const nativeTagName = "native"
+
+// Validatable defines the interface for types that support self-validation rules.
+// Validate is invoked after the tag rules of the type pass, so it can focus on cross-field checks.
+type Validatable interface {
+	Validate() map[string]string
+}
+
+// ValidationError is a lightweight wrapper for native field errors
+type ValidationError struct {
+	Errors map[string]string
+}
+
+func (e *ValidationError) Error() string { return "Validation Failed" }
+
+// WriteValidationErrorResponse writes a 400 response listing the field errors when err is a
+// ValidationError and reports whether a response was written.
+func WriteValidationErrorResponse(w http.ResponseWriter, err error) bool {
+	var valErr *ValidationError
+	if !errors.As(err, &valErr) {
+		return false
+	}
+	WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
+	return true
+}
+
+// ValidateStruct validates s against its native tag rules and returns a ValidationError listing
+// every violation, or nil when s is valid.
+func ValidateStruct(s interface{}) error {
+	if fieldErrors := validateStructNatively(s); fieldErrors != nil {
+		return &ValidationError{Errors: fieldErrors}
+	}
+	return nil
+}
+
+// fieldError describes a single rule violation in the shape expected by GetCustomErrorMessage.
+type fieldError struct {
+	tag   string
+	field string
+	param string
+}
+
+func (fe fieldError) Tag() string   { return fe.tag }
+func (fe fieldError) Field() string { return fe.field }
+func (fe fieldError) Param() string { return fe.param }
+
+// structValidator collects the violations found while walking a request payload.
+type structValidator struct {
+	errors map[string]string
+}
+
+func validateStructNatively(s interface{}) map[string]string {
+	v := &structValidator{errors: make(map[string]string)}
+	v.validateValue(reflect.ValueOf(s), "")
+
+	if len(v.errors) > 0 {
+		return v.errors
+	}
+	return nil
+}
+
+// validateValue validates the struct behind val, if any, followed by its own Validate method.
+func (v *structValidator) validateValue(val reflect.Value, path string) {
+	val, ok := indirect(val)
+	if !ok || val.Kind() != reflect.Struct {
+		return
+	}
+
+	errCount := len(v.errors)
+	v.validateStruct(val, path)
+	if len(v.errors) == errCount {
+		v.validateSelf(val, path)
+	}
+}
+
+func (v *structValidator) validateStruct(val reflect.Value, path string) {
+	typ := val.Type()
+	for i := 0; i < val.NumField(); i++ {
+		fieldType := typ.Field(i)
+		name, skip := jsonFieldName(fieldType)
+		if skip {
+			continue
+		}
+
+		tagValue := fieldType.Tag.Get(nativeTagName)
+		// Fields of embedded structs are promoted to the parent JSON object.
+		if fieldType.Anonymous && tagValue == "" && fieldType.Tag.Get("json") == "" {
+			v.validateValue(val.Field(i), path)
+			continue
+		}
+		if !fieldType.IsExported() {
+			continue
+		}
+
+		v.validateField(val.Field(i), joinFieldPath(path, name), splitRules(tagValue))
+	}
+}
+
+// validateField applies rules to a single field in order, stopping at the first violation.
+func (v *structValidator) validateField(field reflect.Value, path string, rules []string) {
+	for i, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "omitempty":
+			if isZeroValue(field) {
+				return
+			}
+		case "required":
+			if isZeroValue(field) {
+				v.addError(fieldError{tag: name, field: path})
+				return
+			}
+		case "dive":
+			v.validateElements(field, path, rules[i+1:])
+			return
+		default:
+			value, ok := indirect(field)
+			if !ok {
+				continue
+			}
+			if msg := checkRule(value, name, param, path); msg != "" {
+				v.errors[path] = msg
+				return
+			}
+		}
+	}
+	v.validateValue(field, path)
+}
+
+// validateElements applies rules to every element of a slice, array or map field.
+func (v *structValidator) validateElements(field reflect.Value, path string, rules []string) {
+	field, ok := indirect(field)
+	if !ok {
+		return
+	}
+
+	switch field.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < field.Len(); i++ {
+			v.validateField(field.Index(i), fmt.Sprintf("%s[%d]", path, i), rules)
+		}
+	case reflect.Map:
+		for _, key := range field.MapKeys() {
+			v.validateField(field.MapIndex(key), fmt.Sprintf("%s[%v]", path, key.Interface()), rules)
+		}
+	}
+}
+
+// validateSelf invokes the Validate method of val when its type implements Validatable.
+// Values reached through unexported embedded fields are skipped, as their methods are promoted to
+// the parent struct.
+func (v *structValidator) validateSelf(val reflect.Value, path string) {
+	if !val.CanInterface() {
+		return
+	}
+	if !val.CanAddr() {
+		addressable := reflect.New(val.Type()).Elem()
+		addressable.Set(val)
+		val = addressable
+	}
+
+	validatable, ok := val.Addr().Interface().(Validatable)
+	if !ok {
+		return
+	}
+	for field, msg := range validatable.Validate() {
+		v.errors[joinFieldPath(path, field)] = msg
+	}
+}
+
+func (v *structValidator) addError(fe fieldError) {
+	v.errors[fe.field] = GetCustomErrorMessage(fe)
+}
+
+// checkRule evaluates a single parameterised or format rule and returns the violation message,
+// or an empty string when the value satisfies the rule. Unknown rules are ignored.
+func checkRule(value reflect.Value, rule, param, path string) string {
+	fe := fieldError{tag: rule, field: path, param: param}
+	switch rule {
+	case "min", "max", "len":
+		return checkBound(value, fe)
+	case "oneof":
+		if value.Kind() == reflect.String && value.String() == "" {
+			return ""
+		}
+		actual := fmt.Sprint(value.Interface())
+		for _, option := range strings.Fields(param) {
+			if option == actual {
+				return ""
+			}
+		}
+	case "url":
+		if value.Kind() != reflect.String || isValidURL(value.String()) {
+			return ""
+		}
+	case "email":
+		if value.Kind() != reflect.String || isValidEmail(value.String()) {
+			return ""
+		}
+	case "uuid":
+		if value.Kind() != reflect.String || IsValidUUID(value.String()) {
+			return ""
+		}
+	default:
+		return ""
+	}
+	return GetCustomErrorMessage(fe)
+}
+
+// checkBound evaluates min, max and len against the character count of strings, the item count
+// of collections or the value of numbers.
+func checkBound(value reflect.Value, fe fieldError) string {
+	limit, err := strconv.ParseFloat(fe.param, 64)
+	if err != nil {
+		return ""
+	}
+
+	var actual float64
+	var unit string
+	switch value.Kind() {
+	case reflect.String:
+		actual = float64(utf8.RuneCountInString(value.String()))
+	case reflect.Slice, reflect.Array, reflect.Map:
+		actual, unit = float64(value.Len()), "items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		actual, unit = float64(value.Int()), "value"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		actual, unit = float64(value.Uint()), "value"
+	case reflect.Float32, reflect.Float64:
+		actual, unit = value.Float(), "value"
+	default:
+		return ""
+	}
+
+	violated := (fe.tag == "min" && actual < limit) || (fe.tag == "max" && actual > limit) ||
+		(fe.tag == "len" && actual != limit)
+	if !violated {
+		return ""
+	}
+
+	switch unit {
+	case "items":
+		return boundMessage(fe, "contain at least %s items", "contain at most %s items", "contain exactly %s items")
+	case "value":
+		return boundMessage(fe, "be at least %s", "be at most %s", "be exactly %s")
+	default:
+		return GetCustomErrorMessage(fe)
+	}
+}
+
+func boundMessage(fe fieldError, minFormat, maxFormat, lenFormat string) string {
+	format := lenFormat
+	switch fe.tag {
+	case "min":
+		format = minFormat
+	case "max":
+		format = maxFormat
+	}
+	return fmt.Sprintf("The field '%s' must "+format+".", fe.field, fe.param)
+}
+
+func isValidURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && parsed.Scheme != "" && (parsed.Host != "" || parsed.Opaque != "")
+}
+
+func isValidEmail(value string) bool {
+	addr, err := mail.ParseAddress(value)
+	return err == nil && addr.Address == value
+}
+
+// jsonFieldName returns the JSON member name of a struct field and whether the field is excluded
+// from JSON encoding.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return "", true
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, false
+}
+
+func joinFieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func splitRules(tagValue string) []string {
+	if tagValue == "" {
+		return nil
+	}
+	rules := strings.Split(tagValue, ",")
+	for i := range rules {
+		rules[i] = strings.TrimSpace(rules[i])
+	}
+	return rules
+}
+
+// indirect dereferences pointers and interfaces, reporting false when a nil is reached.
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, v.IsValid()
+}
+
+func isZeroValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(v.String()) == ""
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	case reflect.Invalid:
+		return true
+	default:
+		return v.IsZero()
+	}
+}
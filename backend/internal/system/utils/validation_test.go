@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ValidationTestSuite struct {
+	suite.Suite
+}
+
+func TestValidationSuite(t *testing.T) {
+	suite.Run(t, new(ValidationTestSuite))
+}
+
+type validationMember struct {
+	ID   string `json:"id"   native:"required"`
+	Type string `json:"type" native:"required,oneof=user group"`
+}
+
+type validationAudit struct {
+	Owner string `json:"owner" native:"omitempty,email"`
+}
+
+type validationPayload struct {
+	validationAudit
+	Name     string             `json:"name,omitempty"     native:"required,min=2,max=5"`
+	Homepage string             `json:"homepage,omitempty" native:"omitempty,url"`
+	ParentID string             `json:"parentId,omitempty" native:"omitempty,uuid"`
+	Code     string             `json:"code,omitempty"     native:"omitempty,len=4"`
+	Retries  int                `json:"retries"            native:"min=1,max=3"`
+	Tags     []string           `json:"tags,omitempty"     native:"max=2,dive,max=3"`
+	Members  []validationMember `json:"members,omitempty"  native:"dive"`
+	Primary  *validationMember  `json:"primary,omitempty"`
+	Ignored  string             `json:"-"                  native:"required"`
+}
+
+type selfValidatingPayload struct {
+	From int `json:"from" native:"required"`
+	To   int `json:"to"   native:"required"`
+}
+
+func (p selfValidatingPayload) Validate() map[string]string {
+	if p.To < p.From {
+		return map[string]string{"to": "The field 'to' must not be less than 'from'."}
+	}
+	return nil
+}
+
+func validPayload() validationPayload {
+	return validationPayload{Name: "abc", Retries: 2}
+}
+
+func (suite *ValidationTestSuite) TestValidateStruct_ValidPayload() {
+	payload := validPayload()
+	payload.Homepage = "https://example.com/app"
+	payload.ParentID = "0190b6a4-6f4b-7c1e-9a52-3f2d1c0b9e8a"
+	payload.Code = "ab12"
+	payload.Owner = "owner@example.com"
+	payload.Tags = []string{"a", "bcd"}
+	payload.Members = []validationMember{{ID: "m1", Type: "user"}}
+
+	suite.NoError(ValidateStruct(payload))
+	suite.NoError(ValidateStruct(&payload))
+}
+
+func (suite *ValidationTestSuite) TestValidateStruct_FieldRules() {
+	testCases := []struct {
+		name     string
+		mutate   func(p *validationPayload)
+		field    string
+		contains string
+	}{
+		{"Required", func(p *validationPayload) { p.Name = "  " }, "name", "strictly required"},
+		{"MinCharacters", func(p *validationPayload) { p.Name = "a" }, "name", "at least 2 characters"},
+		{"MaxCountsRunes", func(p *validationPayload) { p.Name = "ääääää" }, "name", "maximum allowed size of 5"},
+		{"URL", func(p *validationPayload) { p.Homepage = "not a url" }, "homepage", "well-formed URL"},
+		{"UUID", func(p *validationPayload) { p.ParentID = "123" }, "parentId", "valid UUID"},
+		{"Len", func(p *validationPayload) { p.Code = "abc" }, "code", "exactly 4 characters"},
+		{"EmbeddedEmail", func(p *validationPayload) { p.Owner = "owner" }, "owner", "valid email"},
+		{"NumberMin", func(p *validationPayload) { p.Retries = 0 }, "retries", "must be at least 1"},
+		{"NumberMax", func(p *validationPayload) { p.Retries = 4 }, "retries", "must be at most 3"},
+		{"CollectionMax", func(p *validationPayload) { p.Tags = []string{"a", "b", "c"} }, "tags",
+			"contain at most 2 items"},
+		{"DiveElementRule", func(p *validationPayload) { p.Tags = []string{"a", "long"} }, "tags[1]",
+			"maximum allowed size of 3"},
+		{"DiveNestedStruct", func(p *validationPayload) {
+			p.Members = []validationMember{{ID: "m1", Type: "user"}, {ID: "m2", Type: "robot"}}
+		}, "members[1].type", "must be one of: [user group]"},
+		{"NestedPointer", func(p *validationPayload) { p.Primary = &validationMember{Type: "user"} },
+			"primary.id", "strictly required"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			payload := validPayload()
+			tc.mutate(&payload)
+
+			err := ValidateStruct(payload)
+
+			var valErr *ValidationError
+			suite.Require().True(errors.As(err, &valErr))
+			suite.Len(valErr.Errors, 1)
+			suite.Contains(valErr.Errors[tc.field], tc.contains)
+		})
+	}
+}
+
+func (suite *ValidationTestSuite) TestValidateStruct_ReportsEveryViolation() {
+	err := ValidateStruct(validationPayload{
+		Members: []validationMember{{}},
+	})
+
+	var valErr *ValidationError
+	suite.Require().True(errors.As(err, &valErr))
+	suite.ElementsMatch([]string{"name", "retries", "members[0].id", "members[0].type"},
+		keysOf(valErr.Errors))
+}
+
+func (suite *ValidationTestSuite) TestValidateStruct_RunsValidatableAfterTagRules() {
+	err := ValidateStruct(selfValidatingPayload{From: 5, To: 1})
+
+	var valErr *ValidationError
+	suite.Require().True(errors.As(err, &valErr))
+	suite.Contains(valErr.Errors["to"], "must not be less than")
+
+	err = ValidateStruct(selfValidatingPayload{From: 5})
+	suite.Require().True(errors.As(err, &valErr))
+	suite.Contains(valErr.Errors["to"], "strictly required")
+}
+
+func (suite *ValidationTestSuite) TestValidateStruct_NilAndNonStruct() {
+	suite.NoError(ValidateStruct(nil))
+	suite.NoError(ValidateStruct((*validationPayload)(nil)))
+	suite.NoError(ValidateStruct(42))
+}
+
+func (suite *ValidationTestSuite) TestWriteValidationErrorResponse() {
+	w := httptest.NewRecorder()
+	err := &ValidationError{Errors: map[string]string{"members[0].type": "invalid"}}
+
+	suite.True(WriteValidationErrorResponse(w, err))
+	suite.Equal(http.StatusBadRequest, w.Code)
+
+	var body map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &body))
+	suite.Equal("INVALID_INPUT_METADATA", body["code"])
+	suite.Equal(map[string]interface{}{"members[0].type": "invalid"}, body["errors"])
+}
+
+func (suite *ValidationTestSuite) TestWriteValidationErrorResponse_OtherError() {
+	w := httptest.NewRecorder()
+
+	suite.False(WriteValidationErrorResponse(w, errors.New("failed to decode JSON")))
+	suite.Zero(w.Body.Len())
+}
+
+func keysOf(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
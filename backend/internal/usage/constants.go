@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package usage meters monthly active users, token issuance and API calls per deployment. Counts are
+// kept in memory, added to the operation database on every flush and reported through the usage API.
+// An optional monthly token quota rejects token requests once the deployment has used it up.
+package usage
+
+const (
+	// loggerComponentName is the component name used for logging in the usage package.
+	loggerComponentName = "UsageMeter"
+
+	// periodLayout formats a calendar month in UTC as a usage period, for example 2026-10.
+	periodLayout = "2006-01"
+
+	// metricTokensIssued counts the token responses issued by the token endpoint.
+	metricTokensIssued = "tokens_issued"
+	// metricAPICalls counts the HTTP requests served.
+	metricAPICalls = "api_calls"
+
+	// healthPathPrefix identifies the health check requests, which are not counted as API calls.
+	healthPathPrefix = "/health/"
+)
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// Client errors for usage operations.
+var (
+	// ErrorInvalidPeriod is the error returned when the requested period is not a YYYY-MM month.
+	ErrorInvalidPeriod = common.ServiceError{
+		Type: common.ClientErrorType,
+		Code: "USG-1001",
+		Error: common.I18nMessage{
+			Key:          "error.usageservice.invalid_period",
+			DefaultValue: "Invalid usage period",
+		},
+		ErrorDescription: common.I18nMessage{
+			Key:          "error.usageservice.invalid_period_description",
+			DefaultValue: "The period must be a calendar month in the YYYY-MM format",
+		},
+	}
+)
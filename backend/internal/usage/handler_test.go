@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandleGetUsage(t *testing.T) {
+	mockService := NewUsageServiceInterfaceMock(t)
+	mockService.On("GetUsage", mock.Anything, "2026-09").Return(&UsageReport{Period: "2026-09"}, nil)
+	mockService.On("GetUsage", mock.Anything, "bad").Return(nil, &ErrorInvalidPeriod)
+	handler := newUsageHandler(mockService)
+
+	rec := httptest.NewRecorder()
+	handler.HandleGetUsage(rec, httptest.NewRequest(http.MethodGet, "/usage?period=2026-09", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"period":"2026-09"`)
+
+	rec = httptest.NewRecorder()
+	handler.HandleGetUsage(rec, httptest.NewRequest(http.MethodGet, "/usage?period=bad", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrorInvalidPeriod.Code)
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize wires the usage store, meter, service and reporting route. It returns nil when usage
+// metering is disabled, in which case nothing is counted and no quota is enforced.
+func Initialize(mux *http.ServeMux, cfg config.UsageConfig) MeterInterface {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	store := newUsageStore()
+	meter := newMeter(store, cfg.Quotas.TokensPerMonth, cfg.FlushInterval())
+	registerRoutes(mux, newUsageHandler(newUsageService(store, meter, cfg.Quotas.TokensPerMonth)))
+	return meter
+}
+
+// registerRoutes registers the routes for usage reporting operations.
+func registerRoutes(mux *http.ServeMux, handler *usageHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /usage", handler.HandleGetUsage, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /usage", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts))
+}
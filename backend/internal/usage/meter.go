@@ -0,0 +1,286 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// MeterInterface records the usage of the deployment and enforces the configured quotas.
+type MeterInterface interface {
+	// RecordTokenIssued counts an issued token and marks the subject as an active user. Tokens whose
+	// subject is the requesting client itself, such as client credentials tokens, do not count a user.
+	RecordTokenIssued(ctx context.Context, subject, clientID string)
+	// RecordAPICall counts a served HTTP request.
+	RecordAPICall()
+	// TokenQuotaExceeded reports whether the monthly token quota of the current period is used up.
+	TokenQuotaExceeded(ctx context.Context) bool
+	// Middleware counts every request passed through it as an API call, except health checks.
+	Middleware(next http.Handler) http.Handler
+	// Start begins the periodic flush loop. It returns immediately; flushing runs in the background.
+	Start(ctx context.Context)
+	// Stop halts the flush loop and flushes the remaining usage.
+	Stop()
+}
+
+// meter keeps the usage counted since the last flush in memory and periodically adds it to the store.
+// Flushes are additive, so every server node of a deployment contributes to the same counters.
+type meter struct {
+	store          usageStoreInterface
+	tokenQuota     int64
+	interval       time.Duration
+	now            func() time.Time
+	logger         *log.Logger
+	mu             sync.Mutex
+	pending        map[string]*pendingUsage
+	seenUsers      map[string]struct{}
+	seenPeriod     string
+	persistedToken map[string]int64
+	cancel         context.CancelFunc
+	doneCh         chan struct{}
+	stopOnce       sync.Once
+}
+
+// newMeter creates a meter that flushes to the store every interval and enforces the token quota when
+// it is greater than zero.
+func newMeter(store usageStoreInterface, tokenQuota int64, interval time.Duration) *meter {
+	return &meter{
+		store:          store,
+		tokenQuota:     tokenQuota,
+		interval:       interval,
+		now:            time.Now,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+		pending:        make(map[string]*pendingUsage),
+		seenUsers:      make(map[string]struct{}),
+		persistedToken: make(map[string]int64),
+		doneCh:         make(chan struct{}),
+	}
+}
+
+// RecordTokenIssued counts an issued token and marks the subject as an active user of the period.
+func (m *meter) RecordTokenIssued(ctx context.Context, subject, clientID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	period := m.currentPeriod()
+	usage := m.pendingFor(period)
+	usage.tokensIssued++
+
+	if subject == "" || subject == clientID {
+		return
+	}
+	if m.seenPeriod != period {
+		m.seenUsers = make(map[string]struct{})
+		m.seenPeriod = period
+	}
+	if _, seen := m.seenUsers[subject]; seen {
+		return
+	}
+	m.seenUsers[subject] = struct{}{}
+	usage.activeUsers[subject] = struct{}{}
+}
+
+// RecordAPICall counts a served HTTP request in the current period.
+func (m *meter) RecordAPICall() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pendingFor(m.currentPeriod()).apiCalls++
+}
+
+// TokenQuotaExceeded reports whether the tokens persisted at the last flush plus the tokens counted
+// since then reach the monthly quota. Across several nodes the check is approximate by up to one
+// flush interval of the other nodes' issuance.
+func (m *meter) TokenQuotaExceeded(ctx context.Context) bool {
+	if m.tokenQuota <= 0 {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	period := m.currentPeriod()
+	used := m.persistedToken[period]
+	if usage, ok := m.pending[period]; ok {
+		used += usage.tokensIssued
+	}
+	return used >= m.tokenQuota
+}
+
+// Middleware counts every request passed through it as an API call, except health checks.
+func (m *meter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, healthPathPrefix) {
+			m.RecordAPICall()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Start loads the persisted token count of the current period and launches the periodic flush loop.
+func (m *meter) Start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(security.WithRuntimeContext(ctx))
+	m.refreshPersistedTokens(ctx)
+	go func() {
+		defer close(m.doneCh)
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.flush(ctx)
+			}
+		}
+	}()
+}
+
+// Stop cancels the flush loop, waits for it to exit and flushes the usage counted since the last
+// flush. It is safe to call more than once.
+func (m *meter) Stop() {
+	m.stopOnce.Do(func() {
+		if m.cancel != nil {
+			m.cancel()
+			<-m.doneCh
+		}
+		m.flush(security.WithRuntimeContext(context.Background()))
+	})
+}
+
+// pendingSnapshot returns a copy of the usage counted for the period since the last flush.
+func (m *meter) pendingSnapshot(period string) pendingUsage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	usage, ok := m.pending[period]
+	if !ok {
+		return pendingUsage{}
+	}
+	return pendingUsage{tokensIssued: usage.tokensIssued, apiCalls: usage.apiCalls,
+		activeUsers: copyUsers(usage.activeUsers)}
+}
+
+// flush adds the pending usage to the store. Usage that fails to persist is merged back so it is
+// retried on the next flush.
+func (m *meter) flush(ctx context.Context) {
+	m.mu.Lock()
+	pending := m.pending
+	m.pending = make(map[string]*pendingUsage)
+	m.mu.Unlock()
+
+	for period, usage := range pending {
+		if err := m.persist(ctx, period, usage); err != nil {
+			m.logger.Error(ctx, "Failed to persist usage counters", log.String("period", period), log.Error(err))
+			m.mu.Lock()
+			m.mergePending(period, usage)
+			m.mu.Unlock()
+		}
+	}
+	m.refreshPersistedTokens(ctx)
+}
+
+// persist adds one period's pending usage to the store and clears each part once it is stored, so a
+// partial failure does not count the stored parts twice when retried.
+func (m *meter) persist(ctx context.Context, period string, usage *pendingUsage) error {
+	if usage.tokensIssued > 0 {
+		if err := m.store.AddCounter(ctx, period, metricTokensIssued, usage.tokensIssued); err != nil {
+			return err
+		}
+		usage.tokensIssued = 0
+	}
+	if usage.apiCalls > 0 {
+		if err := m.store.AddCounter(ctx, period, metricAPICalls, usage.apiCalls); err != nil {
+			return err
+		}
+		usage.apiCalls = 0
+	}
+	if len(usage.activeUsers) > 0 {
+		subjects := make([]string, 0, len(usage.activeUsers))
+		for subject := range usage.activeUsers {
+			subjects = append(subjects, subject)
+		}
+		if err := m.store.AddActiveUsers(ctx, period, subjects); err != nil {
+			return err
+		}
+		usage.activeUsers = make(map[string]struct{})
+	}
+	return nil
+}
+
+// refreshPersistedTokens reloads the stored token count of the current period, which includes the
+// tokens issued by other server nodes of the deployment.
+func (m *meter) refreshPersistedTokens(ctx context.Context) {
+	if m.tokenQuota <= 0 {
+		return
+	}
+
+	period := m.currentPeriod()
+	counters, err := m.store.GetCounters(ctx, period)
+	if err != nil {
+		m.logger.Error(ctx, "Failed to load usage counters", log.String("period", period), log.Error(err))
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.persistedToken = map[string]int64{period: counters[metricTokensIssued]}
+}
+
+// mergePending adds usage back into the pending usage of the period. The caller must hold the lock.
+func (m *meter) mergePending(period string, usage *pendingUsage) {
+	target := m.pendingFor(period)
+	target.tokensIssued += usage.tokensIssued
+	target.apiCalls += usage.apiCalls
+	for subject := range usage.activeUsers {
+		target.activeUsers[subject] = struct{}{}
+	}
+}
+
+// pendingFor returns the pending usage of the period, creating it on first use. The caller must hold
+// the lock.
+func (m *meter) pendingFor(period string) *pendingUsage {
+	usage, ok := m.pending[period]
+	if !ok {
+		usage = &pendingUsage{activeUsers: make(map[string]struct{})}
+		m.pending[period] = usage
+	}
+	return usage
+}
+
+// currentPeriod returns the usage period of the current time.
+func (m *meter) currentPeriod() string {
+	return m.now().UTC().Format(periodLayout)
+}
+
+// copyUsers returns a copy of a set of subjects.
+func copyUsers(users map[string]struct{}) map[string]struct{} {
+	copied := make(map[string]struct{}, len(users))
+	for subject := range users {
+		copied[subject] = struct{}{}
+	}
+	return copied
+}
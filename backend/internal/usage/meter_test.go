@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const testPeriod = "2026-10"
+
+type MeterTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	mockStore *usageStoreInterfaceMock
+}
+
+func TestMeterTestSuite(t *testing.T) {
+	suite.Run(t, new(MeterTestSuite))
+}
+
+func (suite *MeterTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStore = newUsageStoreInterfaceMock(suite.T())
+}
+
+func (suite *MeterTestSuite) newMeter(tokenQuota int64) *meter {
+	m := newMeter(suite.mockStore, tokenQuota, time.Minute)
+	m.now = func() time.Time { return time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC) }
+	return m
+}
+
+func (suite *MeterTestSuite) TestRecordTokenIssued_CountsDistinctUsers() {
+	m := suite.newMeter(0)
+
+	m.RecordTokenIssued(suite.ctx, "user-1", "app-1")
+	m.RecordTokenIssued(suite.ctx, "user-1", "app-1")
+	m.RecordTokenIssued(suite.ctx, "user-2", "app-1")
+	m.RecordTokenIssued(suite.ctx, "app-1", "app-1")
+
+	pending := m.pendingSnapshot(testPeriod)
+	suite.Equal(int64(4), pending.tokensIssued)
+	suite.Len(pending.activeUsers, 2)
+}
+
+func (suite *MeterTestSuite) TestMiddleware_SkipsHealthChecks() {
+	m := suite.newMeter(0)
+	handler := m.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health/liveness", nil))
+
+	suite.Equal(int64(1), m.pendingSnapshot(testPeriod).apiCalls)
+}
+
+func (suite *MeterTestSuite) TestTokenQuotaExceeded() {
+	m := suite.newMeter(3)
+	suite.mockStore.On("GetCounters", mock.Anything, testPeriod).
+		Return(map[string]int64{metricTokensIssued: 2}, nil).Once()
+	m.refreshPersistedTokens(suite.ctx)
+
+	suite.False(m.TokenQuotaExceeded(suite.ctx))
+	m.RecordTokenIssued(suite.ctx, "user-1", "app-1")
+	suite.True(m.TokenQuotaExceeded(suite.ctx))
+}
+
+func (suite *MeterTestSuite) TestTokenQuotaExceeded_NoQuota() {
+	m := suite.newMeter(0)
+	m.RecordTokenIssued(suite.ctx, "user-1", "app-1")
+
+	suite.False(m.TokenQuotaExceeded(suite.ctx))
+}
+
+func (suite *MeterTestSuite) TestFlush_PersistsPendingUsage() {
+	m := suite.newMeter(10)
+	m.RecordTokenIssued(suite.ctx, "user-1", "app-1")
+	m.RecordAPICall()
+
+	suite.mockStore.On("AddCounter", mock.Anything, testPeriod, metricTokensIssued, int64(1)).Return(nil).Once()
+	suite.mockStore.On("AddCounter", mock.Anything, testPeriod, metricAPICalls, int64(1)).Return(nil).Once()
+	suite.mockStore.On("AddActiveUsers", mock.Anything, testPeriod, []string{"user-1"}).Return(nil).Once()
+	suite.mockStore.On("GetCounters", mock.Anything, testPeriod).
+		Return(map[string]int64{metricTokensIssued: 6}, nil).Once()
+
+	m.flush(suite.ctx)
+
+	pending := m.pendingSnapshot(testPeriod)
+	suite.Zero(pending.tokensIssued)
+	suite.Zero(pending.apiCalls)
+	suite.Equal(int64(6), m.persistedToken[testPeriod])
+}
+
+func (suite *MeterTestSuite) TestFlush_RetainsUsageOnFailure() {
+	m := suite.newMeter(0)
+	m.RecordTokenIssued(suite.ctx, "user-1", "app-1")
+	m.RecordAPICall()
+
+	suite.mockStore.On("AddCounter", mock.Anything, testPeriod, metricTokensIssued, int64(1)).Return(nil).Once()
+	suite.mockStore.On("AddCounter", mock.Anything, testPeriod, metricAPICalls, int64(1)).
+		Return(errors.New("db error")).Once()
+
+	m.flush(suite.ctx)
+
+	pending := m.pendingSnapshot(testPeriod)
+	suite.Zero(pending.tokensIssued)
+	suite.Equal(int64(1), pending.apiCalls)
+	suite.Len(pending.activeUsers, 1)
+}
+
+func (suite *MeterTestSuite) TestStop_FlushesRemainingUsage() {
+	m := suite.newMeter(0)
+	m.Start(suite.ctx)
+	m.RecordAPICall()
+
+	suite.mockStore.On("AddCounter", mock.Anything, testPeriod, metricAPICalls, int64(1)).Return(nil).Once()
+
+	m.Stop()
+	m.Stop()
+
+	suite.Zero(m.pendingSnapshot(testPeriod).apiCalls)
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+// UsageReport is the usage of the deployment in one calendar month.
+type UsageReport struct {
+	Period       string      `json:"period"`
+	ActiveUsers  int64       `json:"activeUsers"`
+	TokensIssued int64       `json:"tokensIssued"`
+	APICalls     int64       `json:"apiCalls"`
+	Quotas       QuotaReport `json:"quotas"`
+}
+
+// QuotaReport describes the quotas that apply to the reported period.
+type QuotaReport struct {
+	// TokensPerMonth is the monthly token quota, omitted when no quota is configured.
+	TokensPerMonth int64 `json:"tokensPerMonth,omitempty"`
+	// TokensRemaining is the number of tokens that can still be issued in the period.
+	TokensRemaining *int64 `json:"tokensRemaining,omitempty"`
+}
+
+// pendingUsage holds the usage counted since the last flush for one period.
+type pendingUsage struct {
+	tokensIssued int64
+	apiCalls     int64
+	activeUsers  map[string]struct{}
+}
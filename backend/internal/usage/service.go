@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// UsageServiceInterface defines the operations for reading the usage of the deployment.
+type UsageServiceInterface interface {
+	GetUsage(ctx context.Context, period string) (*UsageReport, *common.ServiceError)
+}
+
+// usageService reports the usage persisted in the store together with the usage this node has counted
+// since its last flush.
+type usageService struct {
+	store      usageStoreInterface
+	meter      *meter
+	tokenQuota int64
+	logger     *log.Logger
+}
+
+// newUsageService creates a new instance of usageService.
+func newUsageService(store usageStoreInterface, meter *meter, tokenQuota int64) UsageServiceInterface {
+	return &usageService{
+		store:      store,
+		meter:      meter,
+		tokenQuota: tokenQuota,
+		logger:     log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetUsage returns the usage of the period, or of the current period when period is empty.
+func (s *usageService) GetUsage(ctx context.Context, period string) (*UsageReport, *common.ServiceError) {
+	if period == "" {
+		period = s.meter.currentPeriod()
+	} else if _, err := time.Parse(periodLayout, period); err != nil {
+		return nil, &ErrorInvalidPeriod
+	}
+
+	counters, err := s.store.GetCounters(ctx, period)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get usage counters", log.String("period", period), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+	activeUsers, err := s.store.CountActiveUsers(ctx, period)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to count active users", log.String("period", period), log.Error(err))
+		return nil, &common.InternalServerError
+	}
+
+	pending := s.meter.pendingSnapshot(period)
+	report := &UsageReport{
+		Period:       period,
+		ActiveUsers:  activeUsers + int64(len(pending.activeUsers)),
+		TokensIssued: counters[metricTokensIssued] + pending.tokensIssued,
+		APICalls:     counters[metricAPICalls] + pending.apiCalls,
+	}
+	if s.tokenQuota > 0 {
+		remaining := s.tokenQuota - report.TokensIssued
+		if remaining < 0 {
+			remaining = 0
+		}
+		report.Quotas = QuotaReport{TokensPerMonth: s.tokenQuota, TokensRemaining: &remaining}
+	}
+	return report, nil
+}
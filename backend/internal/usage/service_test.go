@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	mockStore *usageStoreInterfaceMock
+	meter     *meter
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockStore = newUsageStoreInterfaceMock(suite.T())
+	suite.meter = newMeter(suite.mockStore, 0, time.Minute)
+	suite.meter.now = func() time.Time { return time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC) }
+}
+
+func (suite *ServiceTestSuite) TestGetUsage_IncludesPendingUsage() {
+	suite.meter.RecordTokenIssued(suite.ctx, "user-9", "app-1")
+	suite.meter.RecordAPICall()
+	suite.mockStore.On("GetCounters", mock.Anything, testPeriod).
+		Return(map[string]int64{metricTokensIssued: 10, metricAPICalls: 100}, nil)
+	suite.mockStore.On("CountActiveUsers", mock.Anything, testPeriod).Return(int64(4), nil)
+
+	report, svcErr := newUsageService(suite.mockStore, suite.meter, 0).GetUsage(suite.ctx, "")
+
+	suite.Nil(svcErr)
+	suite.Equal(testPeriod, report.Period)
+	suite.Equal(int64(5), report.ActiveUsers)
+	suite.Equal(int64(11), report.TokensIssued)
+	suite.Equal(int64(101), report.APICalls)
+	suite.Nil(report.Quotas.TokensRemaining)
+}
+
+func (suite *ServiceTestSuite) TestGetUsage_ReportsRemainingQuota() {
+	suite.mockStore.On("GetCounters", mock.Anything, "2026-09").
+		Return(map[string]int64{metricTokensIssued: 120}, nil)
+	suite.mockStore.On("CountActiveUsers", mock.Anything, "2026-09").Return(int64(0), nil)
+
+	report, svcErr := newUsageService(suite.mockStore, suite.meter, 100).GetUsage(suite.ctx, "2026-09")
+
+	suite.Nil(svcErr)
+	suite.Equal(int64(100), report.Quotas.TokensPerMonth)
+	suite.Equal(int64(0), *report.Quotas.TokensRemaining)
+}
+
+func (suite *ServiceTestSuite) TestGetUsage_InvalidPeriod() {
+	_, svcErr := newUsageService(suite.mockStore, suite.meter, 0).GetUsage(suite.ctx, "2026-13")
+
+	suite.Equal(&ErrorInvalidPeriod, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestGetUsage_StoreError() {
+	suite.mockStore.On("GetCounters", mock.Anything, testPeriod).Return(nil, errors.New("db error"))
+
+	_, svcErr := newUsageService(suite.mockStore, suite.meter, 0).GetUsage(suite.ctx, testPeriod)
+
+	suite.Equal(&common.InternalServerError, svcErr)
+}
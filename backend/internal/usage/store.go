@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// usageStoreInterface defines the persistence operations for usage counters.
+type usageStoreInterface interface {
+	AddCounter(ctx context.Context, period, metric string, increment int64) error
+	GetCounters(ctx context.Context, period string) (map[string]int64, error)
+	AddActiveUsers(ctx context.Context, period string, subjects []string) error
+	CountActiveUsers(ctx context.Context, period string) (int64, error)
+}
+
+// usageStore is the database-backed usage store. Usage is kept in the operation database because it
+// must survive a runtime database flush.
+type usageStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newUsageStore creates a new instance of usageStore.
+func newUsageStore() usageStoreInterface {
+	return &usageStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// AddCounter adds the increment to a usage counter of the period.
+func (s *usageStore) AddCounter(ctx context.Context, period, metric string, increment int64) error {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryAddCounter, period, metric, increment,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to add usage counter: %w", err)
+	}
+	return nil
+}
+
+// GetCounters returns the usage counters of the period keyed by metric.
+func (s *usageStore) GetCounters(ctx context.Context, period string) (map[string]int64, error) {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetCounters, period, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage counters: %w", err)
+	}
+
+	counters := make(map[string]int64, len(results))
+	for _, row := range results {
+		metric, ok := row["metric"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse metric as string")
+		}
+		value, err := parseInt64(row["value"])
+		if err != nil {
+			return nil, err
+		}
+		counters[metric] = value
+	}
+	return counters, nil
+}
+
+// AddActiveUsers records the subjects as active in the period.
+func (s *usageStore) AddActiveUsers(ctx context.Context, period string, subjects []string) error {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	for _, subject := range subjects {
+		if _, err := dbClient.ExecuteContext(ctx, queryAddActiveUser, period, subject,
+			s.deploymentID); err != nil {
+			return fmt.Errorf("failed to add active user: %w", err)
+		}
+	}
+	return nil
+}
+
+// CountActiveUsers returns the number of distinct active users of the period.
+func (s *usageStore) CountActiveUsers(ctx context.Context, period string) (int64, error) {
+	dbClient, err := s.dbProvider.GetOperationDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountActiveUsers, period, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active users: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+	return parseInt64(results[0]["count"])
+}
+
+// parseInt64 converts a numeric database value into an int64.
+func parseInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected numeric type: %T", value)
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+var (
+	// queryAddCounter adds an increment to a usage counter, creating the counter on first use.
+	queryAddCounter = dbmodel.DBQuery{
+		ID: "USGQ-USAGE_MGT-01",
+		Query: `INSERT INTO "USAGE_COUNTER" (PERIOD, METRIC, VALUE, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4) ` +
+			`ON CONFLICT (DEPLOYMENT_ID, PERIOD, METRIC) DO UPDATE SET VALUE = "USAGE_COUNTER".VALUE + EXCLUDED.VALUE`,
+	}
+
+	// queryGetCounters retrieves the usage counters of a period.
+	queryGetCounters = dbmodel.DBQuery{
+		ID:    "USGQ-USAGE_MGT-02",
+		Query: `SELECT METRIC, VALUE FROM "USAGE_COUNTER" WHERE PERIOD = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryAddActiveUser records a user as active in a period, ignoring users already recorded.
+	queryAddActiveUser = dbmodel.DBQuery{
+		ID: "USGQ-USAGE_MGT-03",
+		Query: `INSERT INTO "USAGE_ACTIVE_USER" (PERIOD, SUBJECT, DEPLOYMENT_ID) VALUES ($1, $2, $3) ` +
+			`ON CONFLICT DO NOTHING`,
+	}
+
+	// queryCountActiveUsers counts the distinct active users of a period.
+	queryCountActiveUsers = dbmodel.DBQuery{
+		ID:    "USGQ-USAGE_MGT-04",
+		Query: `SELECT COUNT(*) AS count FROM "USAGE_ACTIVE_USER" WHERE PERIOD = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
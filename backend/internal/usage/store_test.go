@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *usageStore
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (suite *StoreTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &usageStore{dbProvider: suite.mockDBProvider, deploymentID: testDeploymentID}
+}
+
+func (suite *StoreTestSuite) TestAddCounter() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryAddCounter, "2026-10", metricTokensIssued,
+		int64(5), testDeploymentID).Return(int64(1), nil)
+
+	suite.NoError(suite.store.AddCounter(suite.ctx, "2026-10", metricTokensIssued, 5))
+}
+
+func (suite *StoreTestSuite) TestAddCounter_ExecuteError() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryAddCounter, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return(int64(0), errors.New("db error"))
+
+	suite.Error(suite.store.AddCounter(suite.ctx, "2026-10", metricAPICalls, 1))
+}
+
+func (suite *StoreTestSuite) TestGetCounters() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetCounters, "2026-10", testDeploymentID).
+		Return([]map[string]interface{}{
+			{"metric": metricTokensIssued, "value": int64(7)},
+			{"metric": metricAPICalls, "value": float64(42)},
+		}, nil)
+
+	counters, err := suite.store.GetCounters(suite.ctx, "2026-10")
+
+	suite.NoError(err)
+	suite.Equal(map[string]int64{metricTokensIssued: 7, metricAPICalls: 42}, counters)
+}
+
+func (suite *StoreTestSuite) TestGetCounters_InvalidValue() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetCounters, "2026-10", testDeploymentID).
+		Return([]map[string]interface{}{{"metric": metricTokensIssued, "value": "seven"}}, nil)
+
+	_, err := suite.store.GetCounters(suite.ctx, "2026-10")
+
+	suite.Error(err)
+}
+
+func (suite *StoreTestSuite) TestAddActiveUsers() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryAddActiveUser, "2026-10", "user-1",
+		testDeploymentID).Return(int64(1), nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryAddActiveUser, "2026-10", "user-2",
+		testDeploymentID).Return(int64(0), nil)
+
+	suite.NoError(suite.store.AddActiveUsers(suite.ctx, "2026-10", []string{"user-1", "user-2"}))
+}
+
+func (suite *StoreTestSuite) TestCountActiveUsers() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryCountActiveUsers, "2026-10", testDeploymentID).
+		Return([]map[string]interface{}{{"count": int64(3)}}, nil)
+
+	count, err := suite.store.CountActiveUsers(suite.ctx, "2026-10")
+
+	suite.NoError(err)
+	suite.Equal(int64(3), count)
+}
+
+func (suite *StoreTestSuite) TestGetOperationDBClientError() {
+	suite.mockDBProvider.On("GetOperationDBClient").Return(nil, errors.New("db unavailable"))
+
+	_, err := suite.store.CountActiveUsers(suite.ctx, "2026-10")
+
+	suite.Error(err)
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/job"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 )
@@ -40,6 +41,147 @@ func (_m *UserServiceInterfaceMock) EXPECT() *UserServiceInterfaceMock_Expecter
 	return &UserServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// ChangeSelfPassword provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) ChangeSelfPassword(ctx context.Context, userID string, request ChangeSelfPasswordRequest) *common.ServiceError {
+	ret := _mock.Called(ctx, userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeSelfPassword")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ChangeSelfPasswordRequest) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// UserServiceInterfaceMock_ChangeSelfPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeSelfPassword'
+type UserServiceInterfaceMock_ChangeSelfPassword_Call struct {
+	*mock.Call
+}
+
+// ChangeSelfPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - request ChangeSelfPasswordRequest
+func (_e *UserServiceInterfaceMock_Expecter) ChangeSelfPassword(ctx interface{}, userID interface{}, request interface{}) *UserServiceInterfaceMock_ChangeSelfPassword_Call {
+	return &UserServiceInterfaceMock_ChangeSelfPassword_Call{Call: _e.mock.On("ChangeSelfPassword", ctx, userID, request)}
+}
+
+func (_c *UserServiceInterfaceMock_ChangeSelfPassword_Call) Run(run func(ctx context.Context, userID string, request ChangeSelfPasswordRequest)) *UserServiceInterfaceMock_ChangeSelfPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 ChangeSelfPasswordRequest
+		if args[2] != nil {
+			arg2 = args[2].(ChangeSelfPasswordRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ChangeSelfPassword_Call) Return(serviceError *common.ServiceError) *UserServiceInterfaceMock_ChangeSelfPassword_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ChangeSelfPassword_Call) RunAndReturn(run func(ctx context.Context, userID string, request ChangeSelfPasswordRequest) *common.ServiceError) *UserServiceInterfaceMock_ChangeSelfPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeUserState provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) ChangeUserState(ctx context.Context, userID string, action AccountStateAction) (*User, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeUserState")
+	}
+
+	var r0 *User
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, AccountStateAction) (*User, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID, action)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, AccountStateAction) *User); ok {
+		r0 = returnFunc(ctx, userID, action)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, AccountStateAction) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, action)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_ChangeUserState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeUserState'
+type UserServiceInterfaceMock_ChangeUserState_Call struct {
+	*mock.Call
+}
+
+// ChangeUserState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - action AccountStateAction
+func (_e *UserServiceInterfaceMock_Expecter) ChangeUserState(ctx interface{}, userID interface{}, action interface{}) *UserServiceInterfaceMock_ChangeUserState_Call {
+	return &UserServiceInterfaceMock_ChangeUserState_Call{Call: _e.mock.On("ChangeUserState", ctx, userID, action)}
+}
+
+func (_c *UserServiceInterfaceMock_ChangeUserState_Call) Run(run func(ctx context.Context, userID string, action AccountStateAction)) *UserServiceInterfaceMock_ChangeUserState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 AccountStateAction
+		if args[2] != nil {
+			arg2 = args[2].(AccountStateAction)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ChangeUserState_Call) Return(user *User, serviceError *common.ServiceError) *UserServiceInterfaceMock_ChangeUserState_Call {
+	_c.Call.Return(user, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ChangeUserState_Call) RunAndReturn(run func(ctx context.Context, userID string, action AccountStateAction) (*User, *common.ServiceError)) *UserServiceInterfaceMock_ChangeUserState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) CreateUser(ctx context.Context, user *User) (*User, *common.ServiceError) {
 	ret := _mock.Called(ctx, user)
@@ -186,6 +328,76 @@ func (_c *UserServiceInterfaceMock_CreateUserByPath_Call) RunAndReturn(run func(
 	return _c
 }
 
+// CreateUsers provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) CreateUsers(ctx context.Context, users []*User) (*job.Job, *common.ServiceError) {
+	ret := _mock.Called(ctx, users)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUsers")
+	}
+
+	var r0 *job.Job
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*User) (*job.Job, *common.ServiceError)); ok {
+		return returnFunc(ctx, users)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*User) *job.Job); ok {
+		r0 = returnFunc(ctx, users)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*job.Job)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*User) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, users)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_CreateUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUsers'
+type UserServiceInterfaceMock_CreateUsers_Call struct {
+	*mock.Call
+}
+
+// CreateUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - users []*User
+func (_e *UserServiceInterfaceMock_Expecter) CreateUsers(ctx interface{}, users interface{}) *UserServiceInterfaceMock_CreateUsers_Call {
+	return &UserServiceInterfaceMock_CreateUsers_Call{Call: _e.mock.On("CreateUsers", ctx, users)}
+}
+
+func (_c *UserServiceInterfaceMock_CreateUsers_Call) Run(run func(ctx context.Context, users []*User)) *UserServiceInterfaceMock_CreateUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*User
+		if args[1] != nil {
+			arg1 = args[1].([]*User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_CreateUsers_Call) Return(job1 *job.Job, serviceError *common.ServiceError) *UserServiceInterfaceMock_CreateUsers_Call {
+	_c.Call.Return(job1, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_CreateUsers_Call) RunAndReturn(run func(ctx context.Context, users []*User) (*job.Job, *common.ServiceError)) *UserServiceInterfaceMock_CreateUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUser provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) DeleteUser(ctx context.Context, userID string) *common.ServiceError {
 	ret := _mock.Called(ctx, userID)
@@ -245,6 +457,298 @@ func (_c *UserServiceInterfaceMock_DeleteUser_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// GetDeletedUserList provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetDeletedUserList(ctx context.Context, limit int, offset int) (*UserListResponse, *common.ServiceError) {
+	ret := _mock.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedUserList")
+	}
+
+	var r0 *UserListResponse
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) (*UserListResponse, *common.ServiceError)); ok {
+		return returnFunc(ctx, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) *UserListResponse); ok {
+		r0 = returnFunc(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, limit, offset)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetDeletedUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedUserList'
+type UserServiceInterfaceMock_GetDeletedUserList_Call struct {
+	*mock.Call
+}
+
+// GetDeletedUserList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+func (_e *UserServiceInterfaceMock_Expecter) GetDeletedUserList(ctx interface{}, limit interface{}, offset interface{}) *UserServiceInterfaceMock_GetDeletedUserList_Call {
+	return &UserServiceInterfaceMock_GetDeletedUserList_Call{Call: _e.mock.On("GetDeletedUserList", ctx, limit, offset)}
+}
+
+func (_c *UserServiceInterfaceMock_GetDeletedUserList_Call) Run(run func(ctx context.Context, limit int, offset int)) *UserServiceInterfaceMock_GetDeletedUserList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetDeletedUserList_Call) Return(userListResponse *UserListResponse, serviceError *common.ServiceError) *UserServiceInterfaceMock_GetDeletedUserList_Call {
+	_c.Call.Return(userListResponse, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetDeletedUserList_Call) RunAndReturn(run func(ctx context.Context, limit int, offset int) (*UserListResponse, *common.ServiceError)) *UserServiceInterfaceMock_GetDeletedUserList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEffectiveAccess provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetEffectiveAccess(ctx context.Context, userID string) (*EffectiveAccess, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEffectiveAccess")
+	}
+
+	var r0 *EffectiveAccess
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*EffectiveAccess, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *EffectiveAccess); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*EffectiveAccess)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetEffectiveAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEffectiveAccess'
+type UserServiceInterfaceMock_GetEffectiveAccess_Call struct {
+	*mock.Call
+}
+
+// GetEffectiveAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) GetEffectiveAccess(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_GetEffectiveAccess_Call {
+	return &UserServiceInterfaceMock_GetEffectiveAccess_Call{Call: _e.mock.On("GetEffectiveAccess", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_GetEffectiveAccess_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_GetEffectiveAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetEffectiveAccess_Call) Return(effectiveAccess *EffectiveAccess, serviceError *common.ServiceError) *UserServiceInterfaceMock_GetEffectiveAccess_Call {
+	_c.Call.Return(effectiveAccess, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetEffectiveAccess_Call) RunAndReturn(run func(ctx context.Context, userID string) (*EffectiveAccess, *common.ServiceError)) *UserServiceInterfaceMock_GetEffectiveAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSelfProfile provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetSelfProfile(ctx context.Context, userID string) (*SelfProfile, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSelfProfile")
+	}
+
+	var r0 *SelfProfile
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*SelfProfile, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *SelfProfile); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SelfProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetSelfProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSelfProfile'
+type UserServiceInterfaceMock_GetSelfProfile_Call struct {
+	*mock.Call
+}
+
+// GetSelfProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) GetSelfProfile(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_GetSelfProfile_Call {
+	return &UserServiceInterfaceMock_GetSelfProfile_Call{Call: _e.mock.On("GetSelfProfile", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_GetSelfProfile_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_GetSelfProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetSelfProfile_Call) Return(selfProfile *SelfProfile, serviceError *common.ServiceError) *UserServiceInterfaceMock_GetSelfProfile_Call {
+	_c.Call.Return(selfProfile, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetSelfProfile_Call) RunAndReturn(run func(ctx context.Context, userID string) (*SelfProfile, *common.ServiceError)) *UserServiceInterfaceMock_GetSelfProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSelfUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetSelfUser(ctx context.Context, userID string, includeDisplay bool) (*User, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID, includeDisplay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSelfUser")
+	}
+
+	var r0 *User
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) (*User, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID, includeDisplay)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) *User); ok {
+		r0 = returnFunc(ctx, userID, includeDisplay)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, includeDisplay)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetSelfUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSelfUser'
+type UserServiceInterfaceMock_GetSelfUser_Call struct {
+	*mock.Call
+}
+
+// GetSelfUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - includeDisplay bool
+func (_e *UserServiceInterfaceMock_Expecter) GetSelfUser(ctx interface{}, userID interface{}, includeDisplay interface{}) *UserServiceInterfaceMock_GetSelfUser_Call {
+	return &UserServiceInterfaceMock_GetSelfUser_Call{Call: _e.mock.On("GetSelfUser", ctx, userID, includeDisplay)}
+}
+
+func (_c *UserServiceInterfaceMock_GetSelfUser_Call) Run(run func(ctx context.Context, userID string, includeDisplay bool)) *UserServiceInterfaceMock_GetSelfUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetSelfUser_Call) Return(user *User, serviceError *common.ServiceError) *UserServiceInterfaceMock_GetSelfUser_Call {
+	_c.Call.Return(user, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetSelfUser_Call) RunAndReturn(run func(ctx context.Context, userID string, includeDisplay bool) (*User, *common.ServiceError)) *UserServiceInterfaceMock_GetSelfUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUser provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) GetUser(ctx context.Context, userID string, includeDisplay bool) (*User, *common.ServiceError) {
 	ret := _mock.Called(ctx, userID, includeDisplay)
@@ -655,6 +1159,141 @@ func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// PatchSelfProfile provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) PatchSelfProfile(ctx context.Context, userID string, attributes json.RawMessage) (*SelfProfile, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchSelfProfile")
+	}
+
+	var r0 *SelfProfile
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) (*SelfProfile, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID, attributes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) *SelfProfile); ok {
+		r0 = returnFunc(ctx, userID, attributes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SelfProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, json.RawMessage) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, attributes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_PatchSelfProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchSelfProfile'
+type UserServiceInterfaceMock_PatchSelfProfile_Call struct {
+	*mock.Call
+}
+
+// PatchSelfProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - attributes json.RawMessage
+func (_e *UserServiceInterfaceMock_Expecter) PatchSelfProfile(ctx interface{}, userID interface{}, attributes interface{}) *UserServiceInterfaceMock_PatchSelfProfile_Call {
+	return &UserServiceInterfaceMock_PatchSelfProfile_Call{Call: _e.mock.On("PatchSelfProfile", ctx, userID, attributes)}
+}
+
+func (_c *UserServiceInterfaceMock_PatchSelfProfile_Call) Run(run func(ctx context.Context, userID string, attributes json.RawMessage)) *UserServiceInterfaceMock_PatchSelfProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 json.RawMessage
+		if args[2] != nil {
+			arg2 = args[2].(json.RawMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_PatchSelfProfile_Call) Return(selfProfile *SelfProfile, serviceError *common.ServiceError) *UserServiceInterfaceMock_PatchSelfProfile_Call {
+	_c.Call.Return(selfProfile, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_PatchSelfProfile_Call) RunAndReturn(run func(ctx context.Context, userID string, attributes json.RawMessage) (*SelfProfile, *common.ServiceError)) *UserServiceInterfaceMock_PatchSelfProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) PurgeUser(ctx context.Context, userID string) *common.ServiceError {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeUser")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// UserServiceInterfaceMock_PurgeUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeUser'
+type UserServiceInterfaceMock_PurgeUser_Call struct {
+	*mock.Call
+}
+
+// PurgeUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) PurgeUser(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_PurgeUser_Call {
+	return &UserServiceInterfaceMock_PurgeUser_Call{Call: _e.mock.On("PurgeUser", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_PurgeUser_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_PurgeUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_PurgeUser_Call) Return(serviceError *common.ServiceError) *UserServiceInterfaceMock_PurgeUser_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_PurgeUser_Call) RunAndReturn(run func(ctx context.Context, userID string) *common.ServiceError) *UserServiceInterfaceMock_PurgeUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResolveUserOUHandle provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) ResolveUserOUHandle(ctx context.Context, user *User) *common.ServiceError {
 	ret := _mock.Called(ctx, user)
@@ -714,6 +1353,76 @@ func (_c *UserServiceInterfaceMock_ResolveUserOUHandle_Call) RunAndReturn(run fu
 	return _c
 }
 
+// RestoreUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) RestoreUser(ctx context.Context, userID string) (*User, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUser")
+	}
+
+	var r0 *User
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*User, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type UserServiceInterfaceMock_RestoreUser_Call struct {
+	*mock.Call
+}
+
+// RestoreUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) RestoreUser(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_RestoreUser_Call {
+	return &UserServiceInterfaceMock_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_RestoreUser_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_RestoreUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_RestoreUser_Call) Return(user *User, serviceError *common.ServiceError) *UserServiceInterfaceMock_RestoreUser_Call {
+	_c.Call.Return(user, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_RestoreUser_Call) RunAndReturn(run func(ctx context.Context, userID string) (*User, *common.ServiceError)) *UserServiceInterfaceMock_RestoreUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDependencyRegistry provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) SetDependencyRegistry(r resourcedependency.Registry) {
 	_mock.Called(r)
@@ -754,6 +1463,122 @@ func (_c *UserServiceInterfaceMock_SetDependencyRegistry_Call) RunAndReturn(run
 	return _c
 }
 
+// SetEffectiveAccessResolver provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) SetEffectiveAccessResolver(resolver EffectiveAccessResolver) {
+	_mock.Called(resolver)
+	return
+}
+
+// UserServiceInterfaceMock_SetEffectiveAccessResolver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEffectiveAccessResolver'
+type UserServiceInterfaceMock_SetEffectiveAccessResolver_Call struct {
+	*mock.Call
+}
+
+// SetEffectiveAccessResolver is a helper method to define mock.On call
+//   - resolver EffectiveAccessResolver
+func (_e *UserServiceInterfaceMock_Expecter) SetEffectiveAccessResolver(resolver interface{}) *UserServiceInterfaceMock_SetEffectiveAccessResolver_Call {
+	return &UserServiceInterfaceMock_SetEffectiveAccessResolver_Call{Call: _e.mock.On("SetEffectiveAccessResolver", resolver)}
+}
+
+func (_c *UserServiceInterfaceMock_SetEffectiveAccessResolver_Call) Run(run func(resolver EffectiveAccessResolver)) *UserServiceInterfaceMock_SetEffectiveAccessResolver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 EffectiveAccessResolver
+		if args[0] != nil {
+			arg0 = args[0].(EffectiveAccessResolver)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetEffectiveAccessResolver_Call) Return() *UserServiceInterfaceMock_SetEffectiveAccessResolver_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetEffectiveAccessResolver_Call) RunAndReturn(run func(resolver EffectiveAccessResolver)) *UserServiceInterfaceMock_SetEffectiveAccessResolver_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateSelfUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) UpdateSelfUser(ctx context.Context, userID string, attributes json.RawMessage) (*User, *common.ServiceError) {
+	ret := _mock.Called(ctx, userID, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSelfUser")
+	}
+
+	var r0 *User
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) (*User, *common.ServiceError)); ok {
+		return returnFunc(ctx, userID, attributes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) *User); ok {
+		r0 = returnFunc(ctx, userID, attributes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, json.RawMessage) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, attributes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_UpdateSelfUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSelfUser'
+type UserServiceInterfaceMock_UpdateSelfUser_Call struct {
+	*mock.Call
+}
+
+// UpdateSelfUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - attributes json.RawMessage
+func (_e *UserServiceInterfaceMock_Expecter) UpdateSelfUser(ctx interface{}, userID interface{}, attributes interface{}) *UserServiceInterfaceMock_UpdateSelfUser_Call {
+	return &UserServiceInterfaceMock_UpdateSelfUser_Call{Call: _e.mock.On("UpdateSelfUser", ctx, userID, attributes)}
+}
+
+func (_c *UserServiceInterfaceMock_UpdateSelfUser_Call) Run(run func(ctx context.Context, userID string, attributes json.RawMessage)) *UserServiceInterfaceMock_UpdateSelfUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 json.RawMessage
+		if args[2] != nil {
+			arg2 = args[2].(json.RawMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UpdateSelfUser_Call) Return(user *User, serviceError *common.ServiceError) *UserServiceInterfaceMock_UpdateSelfUser_Call {
+	_c.Call.Return(user, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UpdateSelfUser_Call) RunAndReturn(run func(ctx context.Context, userID string, attributes json.RawMessage) (*User, *common.ServiceError)) *UserServiceInterfaceMock_UpdateSelfUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function for the type UserServiceInterfaceMock
 func (_mock *UserServiceInterfaceMock) UpdateUser(ctx context.Context, userID string, user *User) (*User, *common.ServiceError) {
 	ret := _mock.Called(ctx, userID, user)
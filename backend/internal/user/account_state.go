@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+// AccountStateAction represents an administrative change to the account state of a user.
+type AccountStateAction string
+
+const (
+	// AccountStateActionLock locks an active account.
+	AccountStateActionLock AccountStateAction = "lock"
+	// AccountStateActionUnlock returns a locked account to the active state.
+	AccountStateActionUnlock AccountStateAction = "unlock"
+	// AccountStateActionDisable disables an account that has not been deleted.
+	AccountStateActionDisable AccountStateAction = "disable"
+	// AccountStateActionEnable activates a disabled or pending account.
+	AccountStateActionEnable AccountStateAction = "enable"
+)
+
+// accountStateTransition describes the states an action may be applied from and the resulting state.
+type accountStateTransition struct {
+	from []providers.EntityState
+	to   providers.EntityState
+}
+
+// accountStateTransitions defines the account state machine enforced by ChangeUserState.
+var accountStateTransitions = map[AccountStateAction]accountStateTransition{
+	AccountStateActionLock: {
+		from: []providers.EntityState{providers.EntityStateActive},
+		to:   providers.EntityStateLocked,
+	},
+	AccountStateActionUnlock: {
+		from: []providers.EntityState{providers.EntityStateLocked},
+		to:   providers.EntityStateActive,
+	},
+	AccountStateActionDisable: {
+		from: []providers.EntityState{
+			providers.EntityStateActive, providers.EntityStateLocked, providers.EntityStatePending,
+		},
+		to: providers.EntityStateDisabled,
+	},
+	AccountStateActionEnable: {
+		from: []providers.EntityState{providers.EntityStateDisabled, providers.EntityStatePending},
+		to:   providers.EntityStateActive,
+	},
+}
+
+// isAccountStateAction reports whether the given path segment names an account state action.
+func isAccountStateAction(segment string) bool {
+	_, ok := accountStateTransitions[AccountStateAction(segment)]
+	return ok
+}
+
+// ChangeUserState applies an account state action to a user and emits a USER_STATE_CHANGED audit
+// event. Locked, disabled and pending users cannot authenticate or refresh tokens.
+func (us *userService) ChangeUserState(
+	ctx context.Context, userID string, action AccountStateAction,
+) (*User, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug(ctx, "Changing user account state", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.String("action", string(action)))
+
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+	transition, ok := accountStateTransitions[action]
+	if !ok {
+		return nil, &ErrorInvalidStateTransition
+	}
+
+	existingEntity, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, &ErrorUserNotFound
+		}
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if existingEntity.Category != providers.EntityCategoryUser {
+		return nil, &ErrorUserNotFound
+	}
+
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionUpdateUser, existingEntity.OUID, userID); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := us.checkUserDeclarative(ctx, userID, logger); svcErr != nil {
+		return nil, svcErr
+	}
+
+	previousState := existingEntity.State
+	if !slices.Contains(transition.from, previousState) {
+		logger.Debug(ctx, "Account state transition not allowed", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("state", string(previousState)), log.String("action", string(action)))
+		return nil, &ErrorInvalidStateTransition
+	}
+
+	if err := us.applyUserState(ctx, userID, previousState, transition.to); err != nil {
+		if svcErr := mapEntityError(err); svcErr != nil {
+			return nil, svcErr
+		}
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to update user account state", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	updated := entityToUser(existingEntity)
+	updated.State = transition.to
+
+	logger.Debug(ctx, "Successfully changed user account state", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.String("state", string(transition.to)))
+	return &updated, nil
+}
+
+// applyUserState updates the account state of a user and emits the USER_STATE_CHANGED audit event.
+// With the outbox enabled the event is recorded in the same transaction as the state change, so it
+// is delivered only when the change commits.
+func (us *userService) applyUserState(
+	ctx context.Context, userID string, previousState, state providers.EntityState,
+) error {
+	if us.outboxService == nil || us.observabilitySvc == nil || !us.observabilitySvc.IsEnabled() {
+		if err := us.entityService.UpdateEntityState(ctx, userID, state); err != nil {
+			return err
+		}
+		us.publishUserStateChangedEvent(ctx, userID, previousState, state)
+		return nil
+	}
+
+	evt := newUserStateChangedEvent(ctx, userID, previousState, state)
+	return us.outboxService.Commit(ctx, evt, func(txCtx context.Context) error {
+		return us.entityService.UpdateEntityState(txCtx, userID, state)
+	})
+}
+
+// publishUserStateChangedEvent emits a USER_STATE_CHANGED audit event.
+func (us *userService) publishUserStateChangedEvent(
+	ctx context.Context, userID string, previousState, state providers.EntityState,
+) {
+	if us.observabilitySvc == nil || !us.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	us.observabilitySvc.PublishEvent(ctx, newUserStateChangedEvent(ctx, userID, previousState, state))
+}
+
+// newUserStateChangedEvent builds a USER_STATE_CHANGED audit event.
+func newUserStateChangedEvent(
+	ctx context.Context, userID string, previousState, state providers.EntityState,
+) *providers.Event {
+	return event.NewEvent(
+		syscontext.GetTraceID(ctx),
+		string(event.EventTypeUserStateChanged),
+		event.ComponentUserManagement,
+	).
+		WithStatus(providers.StatusSuccess).
+		WithData(event.DataKey.UserID, userID).
+		WithData(event.DataKey.ActorID, security.GetSubject(ctx)).
+		WithData(event.DataKey.PreviousState, string(previousState)).
+		WithData(event.DataKey.AccountState, string(state))
+}
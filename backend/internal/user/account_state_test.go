@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	entitypkg "github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/outboxmock"
+)
+
+// newAccountStateTestService returns a user service backed by a user in the given account state.
+func newAccountStateTestService(t *testing.T, state providers.EntityState) (
+	*userService, *entitymock.EntityServiceInterfaceMock, *observabilityprovidermock.ObservabilityProviderMock,
+) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&providers.Entity{Category: providers.EntityCategoryUser, ID: svcTestUserID1, Type: testUserType,
+			State: state}, nil).Maybe()
+
+	obsMock := observabilityprovidermock.NewObservabilityProviderMock(t)
+	obsMock.On("IsEnabled").Return(true).Maybe()
+
+	return &userService{
+		entityService:    entityMock,
+		authzService:     newAllowAllAuthz(t),
+		observabilitySvc: obsMock,
+	}, entityMock, obsMock
+}
+
+func TestUserService_ChangeUserState_AllowedTransitions(t *testing.T) {
+	testCases := []struct {
+		from   providers.EntityState
+		action AccountStateAction
+		to     providers.EntityState
+	}{
+		{providers.EntityStateActive, AccountStateActionLock, providers.EntityStateLocked},
+		{providers.EntityStateLocked, AccountStateActionUnlock, providers.EntityStateActive},
+		{providers.EntityStateActive, AccountStateActionDisable, providers.EntityStateDisabled},
+		{providers.EntityStateLocked, AccountStateActionDisable, providers.EntityStateDisabled},
+		{providers.EntityStatePending, AccountStateActionDisable, providers.EntityStateDisabled},
+		{providers.EntityStateDisabled, AccountStateActionEnable, providers.EntityStateActive},
+		{providers.EntityStatePending, AccountStateActionEnable, providers.EntityStateActive},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.from)+"_"+string(tc.action), func(t *testing.T) {
+			service, entityMock, obsMock := newAccountStateTestService(t, tc.from)
+			entityMock.On("UpdateEntityState", mock.Anything, svcTestUserID1, tc.to).Return(nil).Once()
+			obsMock.On("PublishEvent", mock.Anything, mock.MatchedBy(func(evt *providers.Event) bool {
+				return evt.Type == string(event.EventTypeUserStateChanged) &&
+					evt.Data[event.DataKey.PreviousState] == string(tc.from) &&
+					evt.Data[event.DataKey.AccountState] == string(tc.to)
+			})).Return().Once()
+
+			user, err := service.ChangeUserState(context.Background(), svcTestUserID1, tc.action)
+			require.Nil(t, err)
+			require.Equal(t, tc.to, user.State)
+		})
+	}
+}
+
+func TestUserService_ChangeUserState_RejectedTransitions(t *testing.T) {
+	testCases := []struct {
+		from   providers.EntityState
+		action AccountStateAction
+	}{
+		{providers.EntityStateLocked, AccountStateActionLock},
+		{providers.EntityStateDisabled, AccountStateActionLock},
+		{providers.EntityStateActive, AccountStateActionUnlock},
+		{providers.EntityStateDisabled, AccountStateActionUnlock},
+		{providers.EntityStateDisabled, AccountStateActionDisable},
+		{providers.EntityStateActive, AccountStateActionEnable},
+		{providers.EntityStateLocked, AccountStateActionEnable},
+		{providers.EntityStateActive, AccountStateAction("suspend")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.from)+"_"+string(tc.action), func(t *testing.T) {
+			service, _, _ := newAccountStateTestService(t, tc.from)
+
+			user, err := service.ChangeUserState(context.Background(), svcTestUserID1, tc.action)
+			require.Nil(t, user)
+			require.Equal(t, ErrorInvalidStateTransition.Code, err.Code)
+		})
+	}
+}
+
+func TestUserService_ChangeUserState_Failures(t *testing.T) {
+	t.Run("MissingUserID", func(t *testing.T) {
+		service, _, _ := newAccountStateTestService(t, providers.EntityStateActive)
+
+		_, err := service.ChangeUserState(context.Background(), "", AccountStateActionLock)
+		require.Equal(t, ErrorMissingUserID.Code, err.Code)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		service, entityMock, _ := newAccountStateTestService(t, providers.EntityStateActive)
+		entityMock.On("GetEntity", mock.Anything, "missing").Return(nil, entitypkg.ErrEntityNotFound).Once()
+
+		_, err := service.ChangeUserState(context.Background(), "missing", AccountStateActionLock)
+		require.Equal(t, ErrorUserNotFound.Code, err.Code)
+	})
+
+	t.Run("StoreError", func(t *testing.T) {
+		service, entityMock, _ := newAccountStateTestService(t, providers.EntityStateActive)
+		entityMock.On("UpdateEntityState", mock.Anything, svcTestUserID1, providers.EntityStateLocked).
+			Return(errors.New("store error")).Once()
+
+		_, err := service.ChangeUserState(context.Background(), svcTestUserID1, AccountStateActionLock)
+		require.Equal(t, tidcommon.InternalServerError.Code, err.Code)
+	})
+}
+
+func TestUserService_ChangeUserState_Outbox(t *testing.T) {
+	t.Run("RecordsEventWithStateChange", func(t *testing.T) {
+		service, entityMock, _ := newAccountStateTestService(t, providers.EntityStateActive)
+		outboxMock := outboxmock.NewOutboxServiceInterfaceMock(t)
+		service.outboxService = outboxMock
+		entityMock.On("UpdateEntityState", mock.Anything, svcTestUserID1, providers.EntityStateLocked).
+			Return(nil).Once()
+		outboxMock.EXPECT().Commit(mock.Anything, mock.MatchedBy(func(evt *providers.Event) bool {
+			return evt.Type == string(event.EventTypeUserStateChanged) &&
+				evt.Data[event.DataKey.AccountState] == string(providers.EntityStateLocked)
+		}), mock.Anything).RunAndReturn(
+			func(ctx context.Context, _ *providers.Event, change func(txCtx context.Context) error) error {
+				return change(ctx)
+			}).Once()
+
+		// The event is not published directly; the observability mock fails the test if it is.
+		user, err := service.ChangeUserState(context.Background(), svcTestUserID1, AccountStateActionLock)
+		require.Nil(t, err)
+		require.Equal(t, providers.EntityStateLocked, user.State)
+	})
+
+	t.Run("StateChangeError", func(t *testing.T) {
+		service, entityMock, _ := newAccountStateTestService(t, providers.EntityStateActive)
+		outboxMock := outboxmock.NewOutboxServiceInterfaceMock(t)
+		service.outboxService = outboxMock
+		entityMock.On("UpdateEntityState", mock.Anything, svcTestUserID1, providers.EntityStateLocked).
+			Return(entitypkg.ErrEntityNotFound).Once()
+		outboxMock.EXPECT().Commit(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, _ *providers.Event, change func(txCtx context.Context) error) error {
+				return change(ctx)
+			}).Once()
+
+		_, err := service.ChangeUserState(context.Background(), svcTestUserID1, AccountStateActionLock)
+		require.Equal(t, ErrorUserNotFound.Code, err.Code)
+	})
+}
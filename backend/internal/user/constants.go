@@ -29,6 +29,10 @@ const (
 	CredentialTypePasskey CredentialType = "passkey"
 )
 
+// CredentialTypePassword is the schema-defined credential type changed through the self-service
+// password endpoint.
+const CredentialTypePassword CredentialType = "password"
+
 // systemManagedCredentialTypes defines credential types that are managed by the system,
 // not through user types. These may support multiple values per user.
 var systemManagedCredentialTypes = []CredentialType{
@@ -44,3 +48,48 @@ func (ct CredentialType) String() string {
 func (ct CredentialType) IsSystemManaged() bool {
 	return slices.Contains(systemManagedCredentialTypes, ct)
 }
+
+const (
+	// queryParamPermanent is the query parameter that forces a permanent delete when soft deletion is enabled.
+	queryParamPermanent = "permanent"
+	// deletedUsersPathSegment is the path segment under /users that lists soft-deleted users.
+	deletedUsersPathSegment = "deleted"
+	// devicesPathSegment is the path segment under /users/{id} that lists the devices of a user.
+	devicesPathSegment = "devices"
+	// loginHistoryPathSegment is the path segment under /users/{id} that lists the sign-in attempts
+	// of a user.
+	loginHistoryPathSegment = "login-history"
+	// effectiveAccessPathSegment is the path segment under /users/{id} that resolves the access a user
+	// effectively holds.
+	effectiveAccessPathSegment = "effective-access"
+	// gdprExportPathSegment is the path segment under /users/{id} that exports the personal data held
+	// about a user.
+	gdprExportPathSegment = "gdpr-export"
+	// queryParamMode is the query parameter that selects the delete mode.
+	queryParamMode = "mode"
+	// deleteModeErase is the delete mode that erases the personal data of a user.
+	deleteModeErase = "erase"
+	// queryParamConfirmation is the query parameter that carries the erasure confirmation token.
+	queryParamConfirmation = "confirmation"
+)
+
+const (
+	// erasureConfirmationTTLSeconds is how long an erasure confirmation token stays valid.
+	erasureConfirmationTTLSeconds = 15 * 60
+	// erasureTokenBytes is the number of random bytes in an erasure confirmation token.
+	erasureTokenBytes = 32
+	// erasedUserPseudonymPrefix prefixes the pseudonym that replaces an erased user in audit events.
+	erasedUserPseudonymPrefix = "erased-"
+	// linkedAccountSubjectAttribute is the user attribute holding the subject of a linked federated
+	// identity.
+	linkedAccountSubjectAttribute = "sub"
+	// defaultConsentOUID is the organization unit consents are recorded under.
+	defaultConsentOUID = "default"
+)
+
+const (
+	// jobTypeBulkCreate is the type of the jobs that create users in bulk.
+	jobTypeBulkCreate = "USER_BULK_CREATE"
+	// maxBulkCreateUsers is the maximum number of users accepted by a single bulk create request.
+	maxBulkCreateUsers = 1000
+)
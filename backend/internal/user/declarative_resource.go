@@ -341,16 +341,10 @@ func parseCredentials(credentialsMap map[string]interface{}) (Credentials, error
 			}
 
 			credential := Credential{
-				StorageType: "hash",
-				StorageAlgo: hashedCred.Algorithm,
-				StorageAlgoParams: cryptolib.CredParameters{
-					Iterations:  hashedCred.Parameters.Iterations,
-					Memory:      hashedCred.Parameters.Memory,
-					Parallelism: hashedCred.Parameters.Parallelism,
-					KeySize:     hashedCred.Parameters.KeySize,
-					Salt:        hashedCred.Parameters.Salt,
-				},
-				Value: hashedCred.Hash,
+				StorageType:       "hash",
+				StorageAlgo:       hashedCred.Algorithm,
+				StorageAlgoParams: hashedCred.Parameters,
+				Value:             hashedCred.Hash,
 			}
 
 			credentials[credentialType] = []Credential{credential}
@@ -425,16 +419,10 @@ func parseCredentialObject(
 		}
 
 		return Credential{
-			StorageType: "hash",
-			StorageAlgo: hashedCred.Algorithm,
-			StorageAlgoParams: cryptolib.CredParameters{
-				Iterations:  hashedCred.Parameters.Iterations,
-				Memory:      hashedCred.Parameters.Memory,
-				Parallelism: hashedCred.Parameters.Parallelism,
-				KeySize:     hashedCred.Parameters.KeySize,
-				Salt:        hashedCred.Parameters.Salt,
-			},
-			Value: hashedCred.Hash,
+			StorageType:       "hash",
+			StorageAlgo:       hashedCred.Algorithm,
+			StorageAlgoParams: hashedCred.Parameters,
+			Value:             hashedCred.Hash,
 		}, nil
 	}
 
@@ -453,16 +441,24 @@ func parseCredentialObject(
 	}
 
 	iterations, _ := paramsMap["iterations"].(int)
+	memory, _ := paramsMap["memory"].(int)
+	parallelism, _ := paramsMap["parallelism"].(int)
 	keySize, _ := paramsMap["keySize"].(int)
 	salt, _ := paramsMap["salt"].(string)
+	cost, _ := paramsMap["cost"].(int)
+	blockSize, _ := paramsMap["blockSize"].(int)
 
 	return Credential{
 		StorageType: storageType,
 		StorageAlgo: cryptolib.CredAlgorithm(storageAlgo),
 		StorageAlgoParams: cryptolib.CredParameters{
-			Iterations: iterations,
-			KeySize:    keySize,
-			Salt:       salt,
+			Iterations:  iterations,
+			Memory:      memory,
+			Parallelism: parallelism,
+			KeySize:     keySize,
+			Salt:        salt,
+			Cost:        cost,
+			BlockSize:   blockSize,
 		},
 		Value: value,
 	}, nil
@@ -482,6 +478,11 @@ func buildHashCfgForUser() (cryptolib.HashConfig, error) {
 		return cryptolib.HashConfig{Algorithm: alg, SaltSize: cfg.Argon2ID.SaltSize,
 			Iterations: cfg.Argon2ID.Iterations, Memory: cfg.Argon2ID.Memory,
 			Parallelism: cfg.Argon2ID.Parallelism, KeySize: cfg.Argon2ID.KeySize}, nil
+	case cryptolib.SCRYPT:
+		return cryptolib.HashConfig{Algorithm: alg, SaltSize: cfg.Scrypt.SaltSize, Cost: cfg.Scrypt.Cost,
+			BlockSize: cfg.Scrypt.BlockSize, Parallelism: cfg.Scrypt.Parallelism, KeySize: cfg.Scrypt.KeySize}, nil
+	case cryptolib.BCRYPT:
+		return cryptolib.HashConfig{Algorithm: alg, Cost: cfg.Bcrypt.Cost}, nil
 	default:
 		return cryptolib.HashConfig{}, fmt.Errorf("unrecognized password hashing algorithm %q", cfg.Algorithm)
 	}
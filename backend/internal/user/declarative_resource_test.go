@@ -147,6 +147,25 @@ func (suite *DeclarativeResourceTestSuite) TestParseCredentialObject_SystemManag
 	suite.Equal("raw", cred.Value)
 }
 
+func (suite *DeclarativeResourceTestSuite) TestParseCredentialObject_MigratedBcryptHash() {
+	hashService, err := cryptolib.Initialize(
+		cryptolib.HashConfig{Algorithm: cryptolib.PBKDF2, SaltSize: 16, Iterations: 1, KeySize: 32},
+	)
+	suite.Require().NoError(err)
+	legacyHash := "$2a$04$abcdefghijklmnopqrstuuQ4ZKfz7C4hZpuGQ3G2iAgbgZ0xkk3Ye"
+	cred, err := parseCredentialObject(map[string]interface{}{
+		"value":             legacyHash,
+		"storageType":       "hash",
+		"storageAlgo":       "BCRYPT",
+		"storageAlgoParams": map[string]interface{}{"cost": 4},
+	}, hashService, CredentialType("password"))
+
+	suite.NoError(err)
+	suite.Equal(cryptolib.BCRYPT, cred.StorageAlgo)
+	suite.Equal(4, cred.StorageAlgoParams.Cost)
+	suite.Equal(legacyHash, cred.Value)
+}
+
 func (suite *DeclarativeResourceTestSuite) TestParseToUser_HashesCredentials() {
 	yamlData := []byte("" +
 		"id: user-1\n" +
@@ -404,7 +423,7 @@ func (suite *DeclarativeResourceTestSuite) TestBuildHashCfgForUser_UnrecognizedA
 	err := config.InitializeServerRuntime("test", &config.Config{
 		Crypto: config.CryptoConfig{
 			PasswordHashing: config.PasswordHashingConfig{
-				Algorithm: "BCRYPT",
+				Algorithm: "MD5",
 			},
 		},
 	})
@@ -412,5 +431,29 @@ func (suite *DeclarativeResourceTestSuite) TestBuildHashCfgForUser_UnrecognizedA
 
 	_, err = buildHashCfgForUser()
 	suite.Error(err)
-	suite.Contains(err.Error(), "BCRYPT")
+	suite.Contains(err.Error(), "MD5")
+}
+
+func (suite *DeclarativeResourceTestSuite) TestBuildHashCfgForUser_BcryptAndScrypt() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("test", &config.Config{
+		Crypto: config.CryptoConfig{
+			PasswordHashing: config.PasswordHashingConfig{
+				Algorithm: "bcrypt",
+				Bcrypt:    config.BcryptConfig{Cost: 12},
+				Scrypt:    config.ScryptConfig{Cost: 1024, BlockSize: 8, Parallelism: 1, KeySize: 32, SaltSize: 16},
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	cfg, err := buildHashCfgForUser()
+	suite.Require().NoError(err)
+	suite.Equal(cryptolib.HashConfig{Algorithm: cryptolib.BCRYPT, Cost: 12}, cfg)
+
+	config.GetServerRuntime().Config.Crypto.PasswordHashing.Algorithm = "SCRYPT"
+	cfg, err = buildHashCfgForUser()
+	suite.Require().NoError(err)
+	suite.Equal(cryptolib.HashConfig{Algorithm: cryptolib.SCRYPT, SaltSize: 16, Cost: 1024, BlockSize: 8,
+		Parallelism: 1, KeySize: 32}, cfg)
 }
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/device"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// userDeviceHandler is the handler for the devices of a user.
+type userDeviceHandler struct {
+	userService   UserServiceInterface
+	deviceService device.DeviceServiceInterface
+}
+
+// newUserDeviceHandler creates a new instance of userDeviceHandler.
+func newUserDeviceHandler(userService UserServiceInterface,
+	deviceService device.DeviceServiceInterface) *userDeviceHandler {
+	return &userDeviceHandler{
+		userService:   userService,
+		deviceService: deviceService,
+	}
+}
+
+// HandleUserDevicesGetRequest handles GET /users/{id}/devices.
+func (h *userDeviceHandler) HandleUserDevicesGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+	// Resolving the user enforces that it exists and that the caller may access it.
+	if _, svcErr := h.userService.GetUser(ctx, id, false); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	h.writeDevices(w, r, id)
+}
+
+// HandleUserDeviceDeleteRequest handles DELETE /users/{id}/devices/{deviceId}.
+func (h *userDeviceHandler) HandleUserDeviceDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+	if _, svcErr := h.userService.GetUser(ctx, id, false); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	h.revokeDevice(w, r, id)
+}
+
+// HandleSelfDevicesGetRequest handles GET /users/me/devices.
+func (h *userDeviceHandler) HandleSelfDevicesGetRequest(w http.ResponseWriter, r *http.Request) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		handleError(r.Context(), w, &ErrorAuthenticationFailed)
+		return
+	}
+	h.writeDevices(w, r, userID)
+}
+
+// HandleSelfDeviceDeleteRequest handles DELETE /users/me/devices/{deviceId}.
+func (h *userDeviceHandler) HandleSelfDeviceDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		handleError(r.Context(), w, &ErrorAuthenticationFailed)
+		return
+	}
+	h.revokeDevice(w, r, userID)
+}
+
+// writeDevices writes the devices of the user.
+func (h *userDeviceHandler) writeDevices(w http.ResponseWriter, r *http.Request, userID string) {
+	ctx := r.Context()
+	devices, svcErr := h.deviceService.ListDevices(ctx, userID)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK,
+		device.DeviceListResponse{TotalResults: len(devices), Devices: devices})
+}
+
+// revokeDevice revokes the device named in the path for the user.
+func (h *userDeviceHandler) revokeDevice(w http.ResponseWriter, r *http.Request, userID string) {
+	ctx := r.Context()
+	deviceID := r.PathValue("deviceId")
+	if svcErr := h.deviceService.RevokeDevice(ctx, userID, deviceID); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+
+	log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)).Debug(ctx,
+		"Device revoked", log.MaskedString(log.LoggerKeyUserID, userID), log.String("deviceId", deviceID))
+}
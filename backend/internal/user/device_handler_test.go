@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/device"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/devicemock"
+)
+
+const testDeviceID = "device-1"
+
+func TestHandleUserDevicesGetRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetUser", mock.Anything, testUserID123, false).Return(&User{ID: testUserID123}, nil)
+	mockDeviceSvc := devicemock.NewDeviceServiceInterfaceMock(t)
+	mockDeviceSvc.On("ListDevices", mock.Anything, testUserID123).
+		Return([]device.Device{{ID: testDeviceID, Platform: "macOS"}}, nil)
+
+	handler := newUserDeviceHandler(mockSvc, mockDeviceSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/devices", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserDevicesGetRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp device.DeviceListResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 1, resp.TotalResults)
+	require.Equal(t, testDeviceID, resp.Devices[0].ID)
+}
+
+func TestHandleUserDevicesGetRequest_UserNotFound(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetUser", mock.Anything, testUserID123, false).Return(nil, &ErrorUserNotFound)
+	mockDeviceSvc := devicemock.NewDeviceServiceInterfaceMock(t)
+
+	handler := newUserDeviceHandler(mockSvc, mockDeviceSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/devices", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserDevicesGetRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	mockDeviceSvc.AssertNotCalled(t, "ListDevices", mock.Anything, mock.Anything)
+}
+
+func TestHandleUserDeviceDeleteRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetUser", mock.Anything, testUserID123, false).Return(&User{ID: testUserID123}, nil)
+	mockDeviceSvc := devicemock.NewDeviceServiceInterfaceMock(t)
+	mockDeviceSvc.On("RevokeDevice", mock.Anything, testUserID123, testDeviceID).Return(nil)
+
+	handler := newUserDeviceHandler(mockSvc, mockDeviceSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+testUserID123+"/devices/"+testDeviceID, nil)
+	req.SetPathValue("id", testUserID123)
+	req.SetPathValue("deviceId", testDeviceID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserDeviceDeleteRequest(rr, req)
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestHandleSelfDeviceDeleteRequest_DeviceNotFound(t *testing.T) {
+	authCtx := security.NewSecurityContextForTest(testUserID123, "", "", nil, nil)
+	mockDeviceSvc := devicemock.NewDeviceServiceInterfaceMock(t)
+	mockDeviceSvc.On("RevokeDevice", mock.Anything, testUserID123, testDeviceID).
+		Return(&device.ErrorDeviceNotFound)
+
+	handler := newUserDeviceHandler(NewUserServiceInterfaceMock(t), mockDeviceSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/devices/"+testDeviceID, nil)
+	req.SetPathValue("deviceId", testDeviceID)
+	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfDeviceDeleteRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, device.ErrorDeviceNotFound.Code, errResp.Code)
+}
+
+func TestHandleSelfDevicesGetRequest_Unauthorized(t *testing.T) {
+	handler := newUserDeviceHandler(NewUserServiceInterfaceMock(t), devicemock.NewDeviceServiceInterfaceMock(t))
+	req := httptest.NewRequest(http.MethodGet, "/users/me/devices", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfDevicesGetRequest(rr, req)
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
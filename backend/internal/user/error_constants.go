@@ -341,6 +341,117 @@ var (
 			DefaultValue: "The credential updates through this endpoint are not allowed",
 		},
 	}
+	// ErrorUserRestoreWindowExpired is returned when a soft-deleted user is restored after the
+	// retention window has passed.
+	ErrorUserRestoreWindowExpired = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1029",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.restore_window_expired",
+			DefaultValue: "Restore window expired",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.restore_window_expired_description",
+			DefaultValue: "The retention window for restoring the user has passed",
+		},
+	}
+	// ErrorSelfServiceAttributeNotEditable is returned when the authenticated user attempts to
+	// modify an attribute that the self-service policy of the user type does not allow.
+	ErrorSelfServiceAttributeNotEditable = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1030",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.self_service_attribute_not_editable",
+			DefaultValue: "Attribute not editable",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.self_service_attribute_not_editable_description",
+			DefaultValue: "One or more attributes cannot be modified through self-service",
+		},
+	}
+	// ErrorInvalidCurrentPassword is returned when the current password provided for a
+	// self-service password change is incorrect.
+	ErrorInvalidCurrentPassword = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1031",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_current_password",
+			DefaultValue: "Invalid current password",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_current_password_description",
+			DefaultValue: "The provided current password is incorrect",
+		},
+	}
+	// ErrorInvalidStateTransition is returned when an account state change is not allowed from the
+	// current state of the user.
+	ErrorInvalidStateTransition = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1032",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_state_transition",
+			DefaultValue: "Invalid account state transition",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_state_transition_description",
+			DefaultValue: "The requested account state change is not allowed from the current state of the user",
+		},
+	}
+	// ErrorUserNotActive is returned when an operation requires an active account but the user is
+	// locked, disabled or pending.
+	ErrorUserNotActive = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1033",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.user_not_active",
+			DefaultValue: "User not active",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.user_not_active_description",
+			DefaultValue: "The user account is not active",
+		},
+	}
+	// ErrorInvalidTimeFilter is returned when the from or to filter is not an RFC 3339 timestamp, or
+	// from is after to.
+	ErrorInvalidTimeFilter = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1034",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_time_filter",
+			DefaultValue: "Invalid time filter",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_time_filter_description",
+			DefaultValue: "The from and to parameters must be RFC 3339 timestamps with from not after to",
+		},
+	}
+	// ErrorInvalidDeleteMode is returned when the delete mode query parameter is not supported.
+	ErrorInvalidDeleteMode = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1035",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_delete_mode",
+			DefaultValue: "Invalid delete mode",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_delete_mode_description",
+			DefaultValue: "The only supported delete mode is erase",
+		},
+	}
+	// ErrorInvalidErasureConfirmation is returned when the erasure confirmation token is unknown,
+	// expired or issued for another user.
+	ErrorInvalidErasureConfirmation = tidcommon.ServiceError{
+		Type: tidcommon.ClientErrorType,
+		Code: "USR-1036",
+		Error: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_erasure_confirmation",
+			DefaultValue: "Invalid erasure confirmation",
+		},
+		ErrorDescription: tidcommon.I18nMessage{
+			Key:          "error.userservice.invalid_erasure_confirmation_description",
+			DefaultValue: "The erasure confirmation token is invalid or has expired",
+		},
+	}
 )
 
 // Error variables
@@ -0,0 +1,440 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
+	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/device"
+	"github.com/thunder-id/thunderid/internal/entity"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	syscontext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/outbox"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+)
+
+const privacyLoggerComponentName = "UserPrivacyService"
+
+// userPrivacyServiceInterface defines the data subject requests on users: exporting the personal data
+// held about a user and erasing it.
+type userPrivacyServiceInterface interface {
+	// ExportUserData returns the personal data held about the user.
+	ExportUserData(ctx context.Context, userID string) (*UserDataExport, *tidcommon.ServiceError)
+
+	// RequestErasure issues a short-lived confirmation token that EraseUser requires.
+	RequestErasure(ctx context.Context, userID string) (*UserErasureConfirmation, *tidcommon.ServiceError)
+
+	// EraseUser erases the user when the confirmation token matches the pending erasure request.
+	EraseUser(ctx context.Context, userID, confirmationToken string) (
+		*UserErasureResult, *tidcommon.ServiceError)
+}
+
+// userPrivacyService is the default implementation of userPrivacyServiceInterface.
+type userPrivacyService struct {
+	userService         UserServiceInterface
+	entityService       entity.EntityServiceInterface
+	authzService        sysauthz.SystemAuthorizationServiceInterface
+	deviceService       device.DeviceServiceInterface
+	loginHistoryService loginhistory.LoginHistoryServiceInterface
+	consentService      consent.ConsentServiceInterface
+	outboxService       outbox.OutboxServiceInterface
+	runtimeStore        providers.RuntimeStoreProvider
+	observabilitySvc    providers.ObservabilityProvider
+	now                 func() time.Time
+	logger              *log.Logger
+}
+
+// pendingErasure is the erasure request kept in the runtime store until it is confirmed. Only a
+// hash of the confirmation token is stored.
+type pendingErasure struct {
+	TokenHash string    `json:"tokenHash"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// newUserPrivacyService creates a new instance of userPrivacyService. The consent, outbox and
+// observability services are optional.
+func newUserPrivacyService(
+	userService UserServiceInterface,
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	deviceService device.DeviceServiceInterface,
+	loginHistoryService loginhistory.LoginHistoryServiceInterface,
+	consentService consent.ConsentServiceInterface,
+	outboxService outbox.OutboxServiceInterface,
+	runtimeStore providers.RuntimeStoreProvider,
+	observabilitySvc providers.ObservabilityProvider,
+) userPrivacyServiceInterface {
+	return &userPrivacyService{
+		userService:         userService,
+		entityService:       entityService,
+		authzService:        authzService,
+		deviceService:       deviceService,
+		loginHistoryService: loginHistoryService,
+		consentService:      consentService,
+		outboxService:       outboxService,
+		runtimeStore:        runtimeStore,
+		observabilitySvc:    observabilitySvc,
+		now:                 func() time.Time { return time.Now().UTC() },
+		logger:              log.GetLogger().With(log.String(log.LoggerKeyComponentName, privacyLoggerComponentName)),
+	}
+}
+
+// ExportUserData returns the profile, groups, consents, devices, sign-in history and linked accounts
+// of the user.
+func (s *userPrivacyService) ExportUserData(ctx context.Context, userID string) (
+	*UserDataExport, *tidcommon.ServiceError) {
+	user, svcErr := s.resolveUser(ctx, userID, security.ActionExportUserData)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	groups, svcErr := s.listGroups(ctx, userID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	consents, svcErr := s.listConsents(ctx, userID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	devices, svcErr := s.deviceService.ListDevices(ctx, userID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	history, svcErr := s.loginHistoryService.GetLoginHistory(ctx, userID, loginhistory.LoginHistoryFilter{})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	export := &UserDataExport{
+		ExportedAt:     s.now(),
+		User:           user,
+		Groups:         groups,
+		Consents:       make([]UserDataConsent, 0, len(consents)),
+		Devices:        devices,
+		LoginHistory:   history.Attempts,
+		LinkedAccounts: linkedAccounts(user),
+	}
+	for i := range consents {
+		export.Consents = append(export.Consents, toUserDataConsent(&consents[i]))
+	}
+
+	s.logger.Debug(ctx, "Exported user data", log.MaskedString(log.LoggerKeyUserID, userID))
+	return export, nil
+}
+
+// RequestErasure records a pending erasure of the user and returns the token that confirms it.
+func (s *userPrivacyService) RequestErasure(ctx context.Context, userID string) (
+	*UserErasureConfirmation, *tidcommon.ServiceError) {
+	if _, svcErr := s.resolveErasableUser(ctx, userID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	token, err := generateErasureToken()
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, s.logger, "Failed to generate erasure token", err)
+	}
+	pending := pendingErasure{
+		TokenHash: hashErasureToken(token),
+		ExpiresAt: s.now().Add(erasureConfirmationTTLSeconds * time.Second),
+	}
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, s.logger, "Failed to encode erasure request", err)
+	}
+	if err := s.runtimeStore.Put(ctx, providers.NamespaceUserErasure, userID, data,
+		erasureConfirmationTTLSeconds); err != nil {
+		return nil, logErrorAndReturnServerError(ctx, s.logger, "Failed to store erasure request", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	s.logger.Debug(ctx, "User erasure requested", log.MaskedString(log.LoggerKeyUserID, userID))
+	return &UserErasureConfirmation{UserID: userID, ConfirmationToken: token, ExpiresAt: pending.ExpiresAt}, nil
+}
+
+// EraseUser revokes the consents and devices of the user, removes the sign-in history, replaces the
+// user ID in recorded audit events with a pseudonym and then permanently deletes the user. The
+// confirmation is consumed only after the user is deleted, so a failed erasure can be retried with
+// the same token until it expires.
+func (s *userPrivacyService) EraseUser(ctx context.Context, userID, confirmationToken string) (
+	*UserErasureResult, *tidcommon.ServiceError) {
+	if _, svcErr := s.resolveErasableUser(ctx, userID); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.verifyErasureConfirmation(ctx, userID, confirmationToken); svcErr != nil {
+		return nil, svcErr
+	}
+
+	pseudonymID, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, s.logger, "Failed to generate pseudonym", err)
+	}
+	result := &UserErasureResult{UserID: userID, Pseudonym: erasedUserPseudonymPrefix + pseudonymID}
+
+	var svcErr *tidcommon.ServiceError
+	if result.ConsentsRevoked, svcErr = s.revokeConsents(ctx, userID); svcErr != nil {
+		return nil, svcErr
+	}
+	if result.DevicesRevoked, svcErr = s.revokeDevices(ctx, userID); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.loginHistoryService.DeleteLoginHistory(ctx, userID); svcErr != nil {
+		return nil, svcErr
+	}
+	if s.outboxService != nil {
+		result.AuditEventsAnonymized, err = s.outboxService.Pseudonymize(ctx, userID, result.Pseudonym)
+		if err != nil {
+			return nil, logErrorAndReturnServerError(ctx, s.logger, "Failed to anonymize audit events", err,
+				log.MaskedString(log.LoggerKeyUserID, userID))
+		}
+	}
+	if svcErr := s.userService.PurgeUser(ctx, userID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.runtimeStore.Delete(ctx, providers.NamespaceUserErasure, userID); err != nil {
+		s.logger.Warn(ctx, "Failed to remove confirmed erasure request",
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+	}
+	s.publishUserErasedEvent(ctx, result.Pseudonym)
+
+	s.logger.Debug(ctx, "User erased", log.MaskedString(log.LoggerKeyUserID, userID))
+	return result, nil
+}
+
+// resolveUser returns the user after checking that the caller may perform the action on it.
+func (s *userPrivacyService) resolveUser(ctx context.Context, userID string, action security.Action) (
+	*User, *tidcommon.ServiceError) {
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+	user, svcErr := s.userService.GetUser(ctx, userID, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action,
+		&sysauthz.ActionContext{ResourceType: security.ResourceTypeUser, OUID: user.OUID, ResourceID: userID})
+	if svcErr != nil {
+		s.logger.Error(ctx, "Failed to check authorization for action",
+			log.String("action", string(action)), log.Any("error", svcErr))
+		return nil, &tidcommon.InternalServerError
+	}
+	if !allowed {
+		return nil, &tidcommon.ErrorUnauthorized
+	}
+	return user, nil
+}
+
+// resolveErasableUser returns the user after checking that the caller may erase it and that it is
+// not a declarative user.
+func (s *userPrivacyService) resolveErasableUser(ctx context.Context, userID string) (
+	*User, *tidcommon.ServiceError) {
+	user, svcErr := s.resolveUser(ctx, userID, security.ActionEraseUser)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	isDeclarative, err := s.entityService.IsEntityDeclarative(ctx, userID)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, s.logger, "Failed to check if user is declarative", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if isDeclarative {
+		return nil, &ErrorCannotModifyDeclarativeResource
+	}
+	return user, nil
+}
+
+// verifyErasureConfirmation checks that the token matches the pending erasure of the user.
+func (s *userPrivacyService) verifyErasureConfirmation(
+	ctx context.Context, userID, token string,
+) *tidcommon.ServiceError {
+	if token == "" {
+		return &ErrorInvalidErasureConfirmation
+	}
+	data, err := s.runtimeStore.Get(ctx, providers.NamespaceUserErasure, userID)
+	if err != nil {
+		return logErrorAndReturnServerError(ctx, s.logger, "Failed to get erasure request", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if data == nil {
+		return &ErrorInvalidErasureConfirmation
+	}
+	var pending pendingErasure
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return logErrorAndReturnServerError(ctx, s.logger, "Failed to decode erasure request", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if subtle.ConstantTimeCompare([]byte(pending.TokenHash), []byte(hashErasureToken(token))) != 1 ||
+		!s.now().Before(pending.ExpiresAt) {
+		return &ErrorInvalidErasureConfirmation
+	}
+	return nil
+}
+
+// listGroups returns all groups the user belongs to.
+func (s *userPrivacyService) listGroups(ctx context.Context, userID string) (
+	[]providers.EntityGroup, *tidcommon.ServiceError) {
+	groups := make([]providers.EntityGroup, 0)
+	for {
+		page, svcErr := s.userService.GetUserGroups(ctx, userID, serverconst.MaxPageSize, len(groups))
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		groups = append(groups, page.Groups...)
+		if len(page.Groups) == 0 || len(groups) >= page.TotalResults {
+			return groups, nil
+		}
+	}
+}
+
+// listConsents returns the consent records of the user, or none when consent management is disabled.
+func (s *userPrivacyService) listConsents(ctx context.Context, userID string) (
+	[]providers.Consent, *tidcommon.ServiceError) {
+	if s.consentService == nil || !s.consentService.IsEnabled() {
+		return nil, nil
+	}
+	return s.consentService.SearchConsents(ctx, defaultConsentOUID,
+		&consent.ConsentSearchFilter{UserIDs: []string{userID}})
+}
+
+// revokeConsents revokes the active consents of the user and returns how many were revoked.
+func (s *userPrivacyService) revokeConsents(ctx context.Context, userID string) (int, *tidcommon.ServiceError) {
+	if s.consentService == nil || !s.consentService.IsEnabled() {
+		return 0, nil
+	}
+	consents, svcErr := s.consentService.SearchConsents(ctx, defaultConsentOUID,
+		&consent.ConsentSearchFilter{
+			UserIDs:         []string{userID},
+			ConsentStatuses: []providers.ConsentStatus{providers.ConsentStatusActive},
+		})
+	if svcErr != nil {
+		return 0, svcErr
+	}
+	for _, c := range consents {
+		if svcErr := s.consentService.RevokeConsent(ctx, defaultConsentOUID, c.ID,
+			&consent.ConsentRevokeRequest{Reason: "User erased"}); svcErr != nil {
+			return 0, svcErr
+		}
+	}
+	return len(consents), nil
+}
+
+// revokeDevices revokes the devices of the user and returns how many were revoked.
+func (s *userPrivacyService) revokeDevices(ctx context.Context, userID string) (int, *tidcommon.ServiceError) {
+	devices, svcErr := s.deviceService.ListDevices(ctx, userID)
+	if svcErr != nil {
+		return 0, svcErr
+	}
+	for _, d := range devices {
+		if svcErr := s.deviceService.RevokeDevice(ctx, userID, d.ID); svcErr != nil {
+			return 0, svcErr
+		}
+	}
+	return len(devices), nil
+}
+
+// publishUserErasedEvent emits a USER_ERASED audit event. The event names the erased user only by
+// its pseudonym.
+func (s *userPrivacyService) publishUserErasedEvent(ctx context.Context, pseudonym string) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	s.observabilitySvc.PublishEvent(ctx, event.NewEvent(
+		syscontext.GetTraceID(ctx),
+		string(event.EventTypeUserErased),
+		event.ComponentUserManagement,
+	).
+		WithStatus(providers.StatusSuccess).
+		WithData(event.DataKey.UserID, pseudonym).
+		WithData(event.DataKey.ActorID, security.GetSubject(ctx)))
+}
+
+// linkedAccounts returns the federated identity linked to the user through its subject attribute.
+func linkedAccounts(user *User) []LinkedAccount {
+	accounts := make([]LinkedAccount, 0)
+	if len(user.Attributes) == 0 {
+		return accounts
+	}
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(user.Attributes, &attributes); err != nil {
+		return accounts
+	}
+	if subject, ok := attributes[linkedAccountSubjectAttribute].(string); ok && subject != "" {
+		accounts = append(accounts, LinkedAccount{Subject: subject})
+	}
+	return accounts
+}
+
+// toUserDataConsent converts a consent record to its export representation.
+func toUserDataConsent(c *providers.Consent) UserDataConsent {
+	exported := UserDataConsent{
+		ID:            c.ID,
+		Type:          string(c.Type),
+		ApplicationID: c.GroupID,
+		Status:        string(c.Status),
+		ValidityTime:  c.ValidityTime,
+		CreatedTime:   c.CreatedTime,
+		UpdatedTime:   c.UpdatedTime,
+		Purposes:      make([]UserDataConsentPurpose, 0, len(c.Purposes)),
+	}
+	for _, purpose := range c.Purposes {
+		elements := make([]UserDataConsentElement, 0, len(purpose.Elements))
+		for _, element := range purpose.Elements {
+			elements = append(elements, UserDataConsentElement{
+				Name:      element.Name,
+				Namespace: string(element.Namespace),
+				Approved:  element.IsUserApproved,
+			})
+		}
+		exported.Purposes = append(exported.Purposes, UserDataConsentPurpose{Name: purpose.Name, Elements: elements})
+	}
+	return exported
+}
+
+// generateErasureToken returns a random erasure confirmation token.
+func generateErasureToken() (string, error) {
+	b := make([]byte, erasureTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashErasureToken returns the hash under which an erasure confirmation token is stored.
+func hashErasureToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// userPrivacyHandler is the handler for the data subject requests on users.
+type userPrivacyHandler struct {
+	privacyService userPrivacyServiceInterface
+}
+
+// newUserPrivacyHandler creates a new instance of userPrivacyHandler.
+func newUserPrivacyHandler(privacyService userPrivacyServiceInterface) *userPrivacyHandler {
+	return &userPrivacyHandler{privacyService: privacyService}
+}
+
+// HandleUserDataExportRequest handles GET /users/{id}/gdpr-export.
+func (h *userPrivacyHandler) HandleUserDataExportRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	export, svcErr := h.privacyService.ExportUserData(ctx, id)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"user-%s-export.json\"", id))
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, export)
+}
+
+// HandleUserEraseRequest handles DELETE /users/{id}?mode=erase. Without a confirmation token it
+// requests the erasure and returns the token; with the token it erases the user.
+func (h *userPrivacyHandler) HandleUserEraseRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+	id := r.PathValue("id")
+
+	query := r.URL.Query()
+	if query.Get(queryParamMode) != deleteModeErase {
+		handleError(ctx, w, &ErrorInvalidDeleteMode)
+		return
+	}
+
+	token := query.Get(queryParamConfirmation)
+	if token == "" {
+		confirmation, svcErr := h.privacyService.RequestErasure(ctx, id)
+		if svcErr != nil {
+			handleError(ctx, w, svcErr)
+			return
+		}
+		sysutils.WriteSuccessResponse(ctx, w, http.StatusAccepted, confirmation)
+		logger.Debug(ctx, "User erasure confirmation issued", log.MaskedString(log.LoggerKeyUserID, id))
+		return
+	}
+
+	result, svcErr := h.privacyService.EraseUser(ctx, id, token)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, result)
+	logger.Debug(ctx, "User erased", log.MaskedString(log.LoggerKeyUserID, id))
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+func TestHandleUserDataExportRequest_Success(t *testing.T) {
+	mockSvc := newUserPrivacyServiceInterfaceMock(t)
+	mockSvc.On("ExportUserData", mock.Anything, testUserID123).
+		Return(&UserDataExport{User: &User{ID: testUserID123}}, nil)
+
+	handler := newUserPrivacyHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/gdpr-export", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserDataExportRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Header().Get("Content-Disposition"), "attachment")
+	var resp UserDataExport
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, testUserID123, resp.User.ID)
+}
+
+func TestHandleUserEraseRequest_RequestsConfirmation(t *testing.T) {
+	mockSvc := newUserPrivacyServiceInterfaceMock(t)
+	mockSvc.On("RequestErasure", mock.Anything, testUserID123).
+		Return(&UserErasureConfirmation{UserID: testUserID123, ConfirmationToken: "token"}, nil)
+
+	handler := newUserPrivacyHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+testUserID123+"?mode=erase", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserEraseRequest(rr, req)
+
+	require.Equal(t, http.StatusAccepted, rr.Code)
+	var resp UserErasureConfirmation
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, "token", resp.ConfirmationToken)
+}
+
+func TestHandleUserEraseRequest_Confirmed(t *testing.T) {
+	mockSvc := newUserPrivacyServiceInterfaceMock(t)
+	mockSvc.On("EraseUser", mock.Anything, testUserID123, "token").
+		Return(&UserErasureResult{UserID: testUserID123, Pseudonym: "erased-1"}, nil)
+
+	handler := newUserPrivacyHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+testUserID123+"?mode=erase&confirmation=token", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserEraseRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp UserErasureResult
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, "erased-1", resp.Pseudonym)
+}
+
+func TestHandleUserEraseRequest_InvalidMode(t *testing.T) {
+	handler := newUserPrivacyHandler(newUserPrivacyServiceInterfaceMock(t))
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+testUserID123+"?mode=shred", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserEraseRequest(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+	require.Equal(t, ErrorInvalidDeleteMode.Code, errResp.Code)
+}
+
+func TestHandleUserEraseRequest_InvalidConfirmation(t *testing.T) {
+	mockSvc := newUserPrivacyServiceInterfaceMock(t)
+	mockSvc.On("EraseUser", mock.Anything, testUserID123, "stale").Return(nil, &ErrorInvalidErasureConfirmation)
+
+	handler := newUserPrivacyHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+testUserID123+"?mode=erase&confirmation=stale", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserEraseRequest(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
+	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/device"
+	"github.com/thunder-id/thunderid/internal/runtimestore/inmemory"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/loginhistorymock"
+	"github.com/thunder-id/thunderid/tests/mocks/consentmock"
+	"github.com/thunder-id/thunderid/tests/mocks/devicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/observabilityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/outboxmock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+type UserPrivacyServiceTestSuite struct {
+	suite.Suite
+	now              time.Time
+	userService      *UserServiceInterfaceMock
+	entityService    *entitymock.EntityServiceInterfaceMock
+	authzService     *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	deviceService    *devicemock.DeviceServiceInterfaceMock
+	loginHistory     *loginhistorymock.LoginHistoryServiceInterfaceMock
+	consentService   *consentmock.ConsentServiceInterfaceMock
+	outboxService    *outboxmock.OutboxServiceInterfaceMock
+	observabilitySvc *observabilityprovidermock.ObservabilityProviderMock
+	service          *userPrivacyService
+}
+
+func TestUserPrivacyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPrivacyServiceTestSuite))
+}
+
+func (suite *UserPrivacyServiceTestSuite) SetupTest() {
+	t := suite.T()
+	suite.now = time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	suite.userService = NewUserServiceInterfaceMock(t)
+	suite.userService.On("GetUser", mock.Anything, testUserID123, false).Return(&User{
+		ID: testUserID123, OUID: "ou-1", Attributes: json.RawMessage(`{"email":"a@b.c","sub":"google-42"}`),
+	}, nil).Maybe()
+	suite.entityService = entitymock.NewEntityServiceInterfaceMock(t)
+	suite.entityService.On("IsEntityDeclarative", mock.Anything, testUserID123).Return(false, nil).Maybe()
+	suite.authzService = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	suite.deviceService = devicemock.NewDeviceServiceInterfaceMock(t)
+	suite.loginHistory = loginhistorymock.NewLoginHistoryServiceInterfaceMock(t)
+	suite.consentService = consentmock.NewConsentServiceInterfaceMock(t)
+	suite.consentService.On("IsEnabled").Return(true).Maybe()
+	suite.outboxService = outboxmock.NewOutboxServiceInterfaceMock(t)
+	suite.observabilitySvc = observabilityprovidermock.NewObservabilityProviderMock(t)
+	suite.observabilitySvc.On("IsEnabled").Return(true).Maybe()
+
+	suite.service = newUserPrivacyService(suite.userService, suite.entityService, suite.authzService,
+		suite.deviceService, suite.loginHistory, suite.consentService, suite.outboxService,
+		inmemory.Initialize("test"), suite.observabilitySvc).(*userPrivacyService)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *UserPrivacyServiceTestSuite) allow(action security.Action) {
+	suite.authzService.On("IsActionAllowed", mock.Anything, action, mock.Anything).Return(true, nil)
+}
+
+func (suite *UserPrivacyServiceTestSuite) TestExportUserData() {
+	suite.allow(security.ActionExportUserData)
+	suite.userService.On("GetUserGroups", mock.Anything, testUserID123, mock.Anything, 0).Return(
+		&UserGroupListResponse{TotalResults: 1, Groups: []providers.EntityGroup{{ID: "g1", Name: "Staff"}}}, nil)
+	suite.consentService.On("SearchConsents", mock.Anything, defaultConsentOUID,
+		&consent.ConsentSearchFilter{UserIDs: []string{testUserID123}}).Return([]providers.Consent{{
+		ID: "c1", GroupID: "app-1", Status: providers.ConsentStatusActive,
+		Purposes: []providers.ConsentPurposeItem{{Name: "profile", Elements: []providers.ConsentElementApproval{
+			{Name: "email", Namespace: providers.NamespaceAttribute, IsUserApproved: true},
+		}}},
+	}}, nil)
+	suite.deviceService.On("ListDevices", mock.Anything, testUserID123).Return([]device.Device{{ID: "d1"}}, nil)
+	suite.loginHistory.On("GetLoginHistory", mock.Anything, testUserID123, loginhistory.LoginHistoryFilter{}).
+		Return(&loginhistory.LoginHistoryPage{TotalResults: 1, Attempts: []loginhistory.LoginAttempt{
+			{Outcome: loginhistory.LoginOutcomeSuccess},
+		}}, nil)
+
+	export, svcErr := suite.service.ExportUserData(context.Background(), testUserID123)
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(suite.now, export.ExportedAt)
+	suite.Equal(testUserID123, export.User.ID)
+	suite.Equal("g1", export.Groups[0].ID)
+	suite.Equal("app-1", export.Consents[0].ApplicationID)
+	suite.True(export.Consents[0].Purposes[0].Elements[0].Approved)
+	suite.Equal("d1", export.Devices[0].ID)
+	suite.Len(export.LoginHistory, 1)
+	suite.Equal([]LinkedAccount{{Subject: "google-42"}}, export.LinkedAccounts)
+}
+
+func (suite *UserPrivacyServiceTestSuite) TestExportUserData_Unauthorized() {
+	suite.authzService.On("IsActionAllowed", mock.Anything, security.ActionExportUserData, mock.Anything).
+		Return(false, nil)
+
+	export, svcErr := suite.service.ExportUserData(context.Background(), testUserID123)
+
+	suite.Nil(export)
+	suite.Equal(&tidcommon.ErrorUnauthorized, svcErr)
+}
+
+func (suite *UserPrivacyServiceTestSuite) TestEraseUser() {
+	suite.allow(security.ActionEraseUser)
+	suite.consentService.On("SearchConsents", mock.Anything, defaultConsentOUID, mock.Anything).
+		Return([]providers.Consent{{ID: "c1"}}, nil)
+	suite.consentService.On("RevokeConsent", mock.Anything, defaultConsentOUID, "c1", mock.Anything).Return(nil)
+	suite.deviceService.On("ListDevices", mock.Anything, testUserID123).Return([]device.Device{{ID: "d1"}}, nil)
+	suite.deviceService.On("RevokeDevice", mock.Anything, testUserID123, "d1").Return(nil)
+	suite.loginHistory.On("DeleteLoginHistory", mock.Anything, testUserID123).Return(nil)
+	suite.outboxService.On("Pseudonymize", mock.Anything, testUserID123,
+		mock.MatchedBy(func(pseudonym string) bool { return strings.HasPrefix(pseudonym, "erased-") })).
+		Return(int64(3), nil)
+	suite.userService.On("PurgeUser", mock.Anything, testUserID123).Return(nil).Once()
+	suite.observabilitySvc.On("PublishEvent", mock.Anything, mock.MatchedBy(func(evt *providers.Event) bool {
+		return evt.Type == string(event.EventTypeUserErased) && evt.Data[event.DataKey.UserID] != testUserID123
+	})).Return().Once()
+
+	confirmation, svcErr := suite.service.RequestErasure(context.Background(), testUserID123)
+	suite.Require().Nil(svcErr)
+	suite.Equal(suite.now.Add(erasureConfirmationTTLSeconds*time.Second), confirmation.ExpiresAt)
+
+	result, svcErr := suite.service.EraseUser(context.Background(), testUserID123, confirmation.ConfirmationToken)
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(1, result.ConsentsRevoked)
+	suite.Equal(1, result.DevicesRevoked)
+	suite.Equal(int64(3), result.AuditEventsAnonymized)
+
+	// The confirmation is consumed by a successful erasure.
+	_, svcErr = suite.service.EraseUser(context.Background(), testUserID123, confirmation.ConfirmationToken)
+	suite.Equal(&ErrorInvalidErasureConfirmation, svcErr)
+}
+
+func (suite *UserPrivacyServiceTestSuite) TestEraseUser_InvalidConfirmation() {
+	suite.allow(security.ActionEraseUser)
+	_, svcErr := suite.service.RequestErasure(context.Background(), testUserID123)
+	suite.Require().Nil(svcErr)
+
+	_, svcErr = suite.service.EraseUser(context.Background(), testUserID123, "wrong-token")
+
+	suite.Equal(&ErrorInvalidErasureConfirmation, svcErr)
+	suite.userService.AssertNotCalled(suite.T(), "PurgeUser", mock.Anything, mock.Anything)
+}
+
+func (suite *UserPrivacyServiceTestSuite) TestEraseUser_ExpiredConfirmation() {
+	suite.allow(security.ActionEraseUser)
+	confirmation, svcErr := suite.service.RequestErasure(context.Background(), testUserID123)
+	suite.Require().Nil(svcErr)
+	suite.now = suite.now.Add(erasureConfirmationTTLSeconds * time.Second)
+
+	_, svcErr = suite.service.EraseUser(context.Background(), testUserID123, confirmation.ConfirmationToken)
+
+	suite.Equal(&ErrorInvalidErasureConfirmation, svcErr)
+}
+
+func (suite *UserPrivacyServiceTestSuite) TestEraseUser_PurgeFailureKeepsConfirmation() {
+	suite.allow(security.ActionEraseUser)
+	suite.consentService.On("SearchConsents", mock.Anything, defaultConsentOUID, mock.Anything).
+		Return([]providers.Consent{}, nil)
+	suite.deviceService.On("ListDevices", mock.Anything, testUserID123).Return([]device.Device{}, nil)
+	suite.loginHistory.On("DeleteLoginHistory", mock.Anything, testUserID123).Return(nil)
+	suite.outboxService.On("Pseudonymize", mock.Anything, testUserID123, mock.Anything).Return(int64(0), nil)
+	suite.userService.On("PurgeUser", mock.Anything, testUserID123).Return(&ErrorUserHasBlockingDependencies).Once()
+	suite.userService.On("PurgeUser", mock.Anything, testUserID123).Return(nil).Once()
+	suite.observabilitySvc.On("PublishEvent", mock.Anything, mock.Anything).Return().Once()
+
+	confirmation, svcErr := suite.service.RequestErasure(context.Background(), testUserID123)
+	suite.Require().Nil(svcErr)
+
+	_, svcErr = suite.service.EraseUser(context.Background(), testUserID123, confirmation.ConfirmationToken)
+	suite.Equal(&ErrorUserHasBlockingDependencies, svcErr)
+
+	_, svcErr = suite.service.EraseUser(context.Background(), testUserID123, confirmation.ConfirmationToken)
+	suite.Nil(svcErr)
+}
+
+func (suite *UserPrivacyServiceTestSuite) TestRequestErasure_DeclarativeUser() {
+	suite.allow(security.ActionEraseUser)
+	suite.entityService.ExpectedCalls = nil
+	suite.entityService.On("IsEntityDeclarative", mock.Anything, testUserID123).Return(true, nil)
+
+	confirmation, svcErr := suite.service.RequestErasure(context.Background(), testUserID123)
+
+	suite.Nil(confirmation)
+	suite.Equal(&ErrorCannotModifyDeclarativeResource, svcErr)
+}
@@ -30,6 +30,8 @@ import (
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
+	"github.com/thunder-id/thunderid/internal/device"
+	"github.com/thunder-id/thunderid/internal/job"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -103,7 +105,7 @@ func (uh *userHandler) HandleUserPostRequest(w http.ResponseWriter, r *http.Requ
 			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
 			return
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
 			Code:        ErrorInvalidRequestFormat.Code,
 			Message:     ErrorInvalidRequestFormat.Error,
 			Description: ErrorInvalidRequestFormat.ErrorDescription,
@@ -113,6 +115,7 @@ func (uh *userHandler) HandleUserPostRequest(w http.ResponseWriter, r *http.Requ
 
 	user := &User{
 		OUID:       createRequest.OUID,
+		OUHandle:   createRequest.OUHandle,
 		Type:       createRequest.Type,
 		Attributes: createRequest.Attributes,
 	}
@@ -130,6 +133,48 @@ func (uh *userHandler) HandleUserPostRequest(w http.ResponseWriter, r *http.Requ
 	logger.Debug(ctx, "User POST response sent", log.MaskedString(log.LoggerKeyUserID, createdUser.ID))
 }
 
+// HandleUserBulkPostRequest handles the request to create users in bulk. The users are created by a
+// background job whose status is returned and can be followed at the Location header.
+func (uh *userHandler) HandleUserBulkPostRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	bulkRequest, err := sysutils.DecodeJSONBody[BulkCreateUserRequest](r)
+	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
+			Code:        ErrorInvalidRequestFormat.Code,
+			Message:     ErrorInvalidRequestFormat.Error,
+			Description: ErrorInvalidRequestFormat.ErrorDescription,
+		})
+		return
+	}
+
+	users := make([]*User, 0, len(bulkRequest.Users))
+	for _, createRequest := range bulkRequest.Users {
+		users = append(users, &User{
+			OUID:       createRequest.OUID,
+			OUHandle:   createRequest.OUHandle,
+			Type:       createRequest.Type,
+			Attributes: createRequest.Attributes,
+		})
+	}
+
+	createJob, svcErr := uh.userService.CreateUsers(ctx, users)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	w.Header().Set("Location", "/jobs/"+createJob.ID)
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusAccepted, createJob)
+
+	logger.Debug(ctx, "User bulk POST response sent", log.String("jobId", createJob.ID),
+		log.Int("count", len(users)))
+}
+
 // HandleUserGetRequest handles the user request.
 func (uh *userHandler) HandleUserGetRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -142,7 +187,7 @@ func (uh *userHandler) HandleUserGetRequest(w http.ResponseWriter, r *http.Reque
 			Message:     ErrorMissingUserID.Error,
 			Description: ErrorMissingUserID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
@@ -219,6 +264,29 @@ func (uh *userHandler) HandleUserUsagesGetRequest(w http.ResponseWriter, r *http
 	logger.Debug(ctx, "Successfully retrieved user usages", log.MaskedString(log.LoggerKeyUserID, id))
 }
 
+// HandleUserEffectiveAccessGetRequest handles the get user effective access request.
+func (uh *userHandler) HandleUserEffectiveAccessGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+
+	access, svcErr := uh.userService.GetEffectiveAccess(ctx, id)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, access)
+
+	logger.Debug(ctx, "Successfully retrieved user effective access", log.MaskedString(log.LoggerKeyUserID, id),
+		log.Int("roleCount", len(access.Roles)), log.Int("permissionCount", len(access.Permissions)))
+}
+
 // HandleUserPutRequest handles the user request.
 func (uh *userHandler) HandleUserPutRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -226,7 +294,7 @@ func (uh *userHandler) HandleUserPutRequest(w http.ResponseWriter, r *http.Reque
 
 	id := r.PathValue("id")
 	if id == "" {
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
 			Code:        ErrorMissingUserID.Code,
 			Message:     ErrorMissingUserID.Error,
 			Description: ErrorMissingUserID.ErrorDescription,
@@ -241,7 +309,7 @@ func (uh *userHandler) HandleUserPutRequest(w http.ResponseWriter, r *http.Reque
 			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
 			return
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
 			Code:        ErrorInvalidRequestFormat.Code,
 			Message:     ErrorInvalidRequestFormat.Error,
 			Description: ErrorInvalidRequestFormat.ErrorDescription,
@@ -263,6 +331,60 @@ func (uh *userHandler) HandleUserPutRequest(w http.ResponseWriter, r *http.Reque
 	logger.Debug(ctx, "User PUT response sent", log.MaskedString(log.LoggerKeyUserID, id))
 }
 
+// HandleUserPatchRequest handles the partial update user request. The body is a JSON Merge Patch
+// (RFC 7396) document applied to the current user, so a single attribute can be changed without
+// sending the whole user. The patched user is then saved like a full update.
+func (uh *userHandler) HandleUserPatchRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
+			Code:        ErrorMissingUserID.Code,
+			Message:     ErrorMissingUserID.Error,
+			Description: ErrorMissingUserID.ErrorDescription,
+		})
+		return
+	}
+
+	current, svcErr := uh.userService.GetUser(ctx, id, false)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	updateRequest, err := sysutils.DecodeMergePatchBody[User](r, User{
+		OUID:       current.OUID,
+		Type:       current.Type,
+		Attributes: current.Attributes,
+	})
+	if err != nil {
+		var valErr *sysutils.ValidationError
+		if errors.As(err, &valErr) {
+			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
+			return
+		}
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
+			Code:        ErrorInvalidRequestFormat.Code,
+			Message:     ErrorInvalidRequestFormat.Error,
+			Description: ErrorInvalidRequestFormat.ErrorDescription,
+		})
+		return
+	}
+	updateRequest.ID = id
+
+	user, svcErr := uh.userService.UpdateUser(ctx, id, updateRequest)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, user)
+
+	logger.Debug(ctx, "User PATCH response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
 // HandleUserDeleteRequest handles the delete user request.
 func (uh *userHandler) HandleUserDeleteRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -275,12 +397,17 @@ func (uh *userHandler) HandleUserDeleteRequest(w http.ResponseWriter, r *http.Re
 			Message:     ErrorMissingUserID.Error,
 			Description: ErrorMissingUserID.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, errResp)
 		return
 	}
 
-	// Delete the user using the user service.
-	svcErr := uh.userService.DeleteUser(ctx, id)
+	// Delete the user using the user service. permanent=true bypasses soft deletion.
+	var svcErr *tidcommon.ServiceError
+	if r.URL.Query().Get(queryParamPermanent) == "true" {
+		svcErr = uh.userService.PurgeUser(ctx, id)
+	} else {
+		svcErr = uh.userService.DeleteUser(ctx, id)
+	}
 	if svcErr != nil {
 		handleError(ctx, w, svcErr)
 		return
@@ -292,6 +419,70 @@ func (uh *userHandler) HandleUserDeleteRequest(w http.ResponseWriter, r *http.Re
 	logger.Debug(ctx, "User DELETE response sent", log.MaskedString(log.LoggerKeyUserID, id))
 }
 
+// HandleDeletedUserListRequest handles the list soft-deleted users request.
+func (uh *userHandler) HandleDeletedUserListRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	if limit == 0 {
+		limit = serverconst.DefaultPageSize
+	}
+
+	userListResponse, svcErr := uh.userService.GetDeletedUserList(ctx, limit, offset)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, userListResponse)
+
+	logger.Debug(ctx, "Successfully listed deleted users",
+		log.Int("limit", limit), log.Int("offset", offset),
+		log.Int("totalResults", userListResponse.TotalResults))
+}
+
+// HandleUserRestoreRequest handles the restore soft-deleted user request.
+func (uh *userHandler) HandleUserRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	user, svcErr := uh.userService.RestoreUser(ctx, id)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, user)
+
+	logger.Debug(ctx, "User restore response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleUserStateChangeRequest handles the lock, unlock, disable and enable account state requests.
+func (uh *userHandler) HandleUserStateChangeRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	action := AccountStateAction(r.PathValue("action"))
+	user, svcErr := uh.userService.ChangeUserState(ctx, id, action)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, user)
+
+	logger.Debug(ctx, "User state change response sent", log.MaskedString(log.LoggerKeyUserID, id),
+		log.String("action", string(action)))
+}
+
 // HandleUserListByPathRequest handles the list users by OU path request.
 func (uh *userHandler) HandleUserListByPathRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -353,7 +544,7 @@ func (uh *userHandler) HandleUserPostByPathRequest(w http.ResponseWriter, r *htt
 			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
 			return
 		}
-		sysutils.WriteErrorResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
+		sysutils.WriteProblemResponse(ctx, w, http.StatusBadRequest, apierror.ErrorResponse{
 			Code:        ErrorInvalidRequestFormat.Code,
 			Message:     ErrorInvalidRequestFormat.Error,
 			Description: ErrorInvalidRequestFormat.ErrorDescription,
@@ -387,7 +578,7 @@ func (uh *userHandler) HandleSelfUserGetRequest(w http.ResponseWriter, r *http.R
 	// Parse include parameter to check if display name should be included.
 	includeDisplay := r.URL.Query().Get(sysutils.QueryParamInclude) == sysutils.IncludeValueDisplay
 
-	user, svcErr := uh.userService.GetUser(ctx, userID, includeDisplay)
+	user, svcErr := uh.userService.GetSelfUser(ctx, userID, includeDisplay)
 	if svcErr != nil {
 		handleError(ctx, w, svcErr)
 		return
@@ -425,7 +616,7 @@ func (uh *userHandler) HandleSelfUserPutRequest(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	updatedUser, svcErr := uh.userService.UpdateUserAttributes(ctx, userID, updateRequest.Attributes)
+	updatedUser, svcErr := uh.userService.UpdateSelfUser(ctx, userID, updateRequest.Attributes)
 	if svcErr != nil {
 		handleError(ctx, w, svcErr)
 		return
@@ -436,6 +627,97 @@ func (uh *userHandler) HandleSelfUserPutRequest(w http.ResponseWriter, r *http.R
 	logger.Debug(ctx, "Self user PUT response sent", log.MaskedString(log.LoggerKeyUserID, userID))
 }
 
+// HandleSelfProfileGetRequest handles the self-service profile retrieval.
+func (uh *userHandler) HandleSelfProfileGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := security.GetSubject(ctx)
+	if strings.TrimSpace(userID) == "" {
+		handleError(ctx, w, &ErrorAuthenticationFailed)
+		return
+	}
+
+	profile, svcErr := uh.userService.GetSelfProfile(ctx, userID)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, profile)
+
+	logger.Debug(ctx, "Self profile GET response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleSelfProfilePatchRequest handles the self-service profile update.
+func (uh *userHandler) HandleSelfProfilePatchRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := security.GetSubject(ctx)
+	if strings.TrimSpace(userID) == "" {
+		handleError(ctx, w, &ErrorAuthenticationFailed)
+		return
+	}
+
+	updateRequest, err := sysutils.DecodeJSONBody[UpdateSelfUserRequest](r)
+	if err != nil {
+		var valErr *sysutils.ValidationError
+		if errors.As(err, &valErr) {
+			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
+			return
+		}
+		handleError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	if updateRequest == nil || len(updateRequest.Attributes) == 0 {
+		handleError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	profile, svcErr := uh.userService.PatchSelfProfile(ctx, userID, updateRequest.Attributes)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, profile)
+
+	logger.Debug(ctx, "Self profile PATCH response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleSelfPasswordChangeRequest handles the password change for the authenticated user.
+func (uh *userHandler) HandleSelfPasswordChangeRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID := security.GetSubject(ctx)
+	if strings.TrimSpace(userID) == "" {
+		handleError(ctx, w, &ErrorAuthenticationFailed)
+		return
+	}
+
+	changeRequest, err := sysutils.DecodeJSONBody[ChangeSelfPasswordRequest](r)
+	if err != nil {
+		var valErr *sysutils.ValidationError
+		if errors.As(err, &valErr) {
+			sysutils.WriteStructuredErrorResponse(w, http.StatusBadRequest, "Validation Failed", valErr.Errors)
+			return
+		}
+		handleError(ctx, w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	if svcErr := uh.userService.ChangeSelfPassword(ctx, userID, *changeRequest); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusNoContent, nil)
+	logger.Debug(ctx, "Self password change response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
 // HandleSelfUserCredentialUpdateRequest handles the credential update for the authenticated user.
 func (uh *userHandler) HandleSelfUserCredentialUpdateRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -553,11 +835,17 @@ func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.S
 		switch svcErr.Code {
 		case ErrorMissingUserID.Code,
 			ErrorUserNotFound.Code,
-			ErrorOrganizationUnitNotFound.Code:
+			ErrorOrganizationUnitNotFound.Code,
+			device.ErrorDeviceNotFound.Code:
 			statusCode = http.StatusNotFound
 		case ErrorAttributeConflict.Code,
-			ErrorUserHasBlockingDependencies.Code:
+			ErrorUserHasBlockingDependencies.Code,
+			ErrorInvalidStateTransition.Code:
 			statusCode = http.StatusConflict
+		case ErrorUserRestoreWindowExpired.Code:
+			statusCode = http.StatusGone
+		case job.ErrorJobQueueFull.Code:
+			statusCode = http.StatusTooManyRequests
 		case ErrorHandlePathRequired.Code,
 			ErrorInvalidHandlePath.Code,
 			ErrorMissingRequiredFields.Code,
@@ -566,7 +854,9 @@ func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.S
 			statusCode = http.StatusBadRequest
 		case ErrorAuthenticationFailed.Code:
 			statusCode = http.StatusUnauthorized
-		case tidcommon.ErrorUnauthorized.Code:
+		case tidcommon.ErrorUnauthorized.Code,
+			ErrorSelfServiceAttributeNotEditable.Code,
+			ErrorUserNotActive.Code:
 			statusCode = http.StatusForbidden
 		default:
 			statusCode = http.StatusBadRequest
@@ -581,7 +871,7 @@ func handleError(ctx context.Context, w http.ResponseWriter, svcErr *tidcommon.S
 		Description: svcErr.ErrorDescription,
 	}
 
-	sysutils.WriteErrorResponse(ctx, w, statusCode, errResp)
+	sysutils.WriteProblemResponse(ctx, w, statusCode, errResp)
 }
 
 // extractAndValidatePath extracts and validates the path parameter from the request.
@@ -593,7 +883,7 @@ func extractAndValidatePath(w http.ResponseWriter, r *http.Request) (string, boo
 			Message:     ErrorHandlePathRequired.Error,
 			Description: ErrorHandlePathRequired.ErrorDescription,
 		}
-		sysutils.WriteErrorResponse(r.Context(), w, http.StatusBadRequest, errResp)
+		sysutils.WriteProblemResponse(r.Context(), w, http.StatusBadRequest, errResp)
 		return "", true
 	}
 	return path, false
@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/thunder-id/thunderid/internal/job"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -52,7 +53,7 @@ func TestHandleSelfUserGetRequest_Success(t *testing.T) {
 		ID:         userID,
 		Attributes: json.RawMessage(`{"username":"alice"}`),
 	}
-	mockSvc.On("GetUser", mock.Anything, userID, false).Return(expectedUser, nil)
+	mockSvc.On("GetSelfUser", mock.Anything, userID, false).Return(expectedUser, nil)
 
 	handler := newUserHandler(mockSvc)
 	req := httptest.NewRequest(http.MethodGet, "/users/me", nil)
@@ -76,7 +77,7 @@ func TestHandleSelfUserGetRequest_IncludeDisplay(t *testing.T) {
 
 	mockSvc := NewUserServiceInterfaceMock(t)
 	expectedUser := &User{ID: userID}
-	mockSvc.On("GetUser", mock.Anything, userID, true).Return(expectedUser, nil)
+	mockSvc.On("GetSelfUser", mock.Anything, userID, true).Return(expectedUser, nil)
 
 	handler := newUserHandler(mockSvc)
 	req := httptest.NewRequest(http.MethodGet, "/users/me?include=display", nil)
@@ -115,7 +116,7 @@ func TestHandleSelfUserPutRequest_Success(t *testing.T) {
 		Type:       "employee",
 		Attributes: attributes,
 	}
-	mockSvc.On("UpdateUserAttributes", mock.Anything, userID, attributes).Return(updatedUser, nil)
+	mockSvc.On("UpdateSelfUser", mock.Anything, userID, attributes).Return(updatedUser, nil)
 
 	handler := newUserHandler(mockSvc)
 	body := bytes.NewBufferString(`{"attributes":{"email":"alice@example.com"}}`)
@@ -529,6 +530,62 @@ func TestHandleUserPostRequest_Success(t *testing.T) {
 	require.Equal(t, createdUser.ID, resp.ID)
 }
 
+func TestHandleUserBulkPostRequest(t *testing.T) {
+	t.Run("submits bulk create job", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("CreateUsers", mock.Anything, mock.MatchedBy(func(users []*User) bool {
+			return len(users) == 2 && users[0].OUID == "ou-1" && users[1].Type == "employee"
+		})).Return(&job.Job{ID: "job-1", Status: job.StatusPending, Total: 2}, nil).Once()
+
+		body := `{"users":[{"ouId":"ou-1","type":"employee","attributes":{"username":"a"}},` +
+			`{"ouId":"ou-1","type":"employee","attributes":{"username":"b"}}]}`
+		req := httptest.NewRequest(http.MethodPost, "/users/bulk", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+		newUserHandler(mockSvc).HandleUserBulkPostRequest(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		require.Equal(t, "/jobs/job-1", rr.Header().Get("Location"))
+		var resp job.Job
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		require.Equal(t, "job-1", resp.ID)
+		require.Equal(t, 2, resp.Total)
+	})
+
+	t.Run("rejects invalid items before submitting", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		req := httptest.NewRequest(http.MethodPost, "/users/bulk",
+			strings.NewReader(`{"users":[{"ouId":"ou-1","type":"employee"},{"ouHandle":"engineering"}]}`))
+		rr := httptest.NewRecorder()
+		newUserHandler(mockSvc).HandleUserBulkPostRequest(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "users[1].type")
+		mockSvc.AssertNotCalled(t, "CreateUsers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects empty list", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		req := httptest.NewRequest(http.MethodPost, "/users/bulk", strings.NewReader(`{"users":[]}`))
+		rr := httptest.NewRecorder()
+		newUserHandler(mockSvc).HandleUserBulkPostRequest(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		mockSvc.AssertNotCalled(t, "CreateUsers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("job queue full", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("CreateUsers", mock.Anything, mock.Anything).Return(nil, &job.ErrorJobQueueFull).Once()
+		req := httptest.NewRequest(http.MethodPost, "/users/bulk",
+			strings.NewReader(`{"users":[{"ouId":"ou-1","type":"employee"}]}`))
+		rr := httptest.NewRecorder()
+		newUserHandler(mockSvc).HandleUserBulkPostRequest(rr, req)
+
+		require.Equal(t, http.StatusTooManyRequests, rr.Code)
+		require.Contains(t, rr.Body.String(), job.ErrorJobQueueFull.Code)
+	})
+}
+
 func TestHandleUserGetRequest_Success(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
 	userID := testUserID123
@@ -587,6 +644,63 @@ func TestHandleUserPutRequest_Success(t *testing.T) {
 	require.Equal(t, userID, resp.ID)
 }
 
+func TestHandleUserPatchRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	userID := testUserID123
+	current := &User{
+		ID: userID, OUID: "ou-1", Type: "customer",
+		Attributes: json.RawMessage(`{"email":"a@example.com","name":"Old"}`),
+	}
+	mockSvc.On("GetUser", mock.Anything, userID, false).Return(current, nil)
+	mockSvc.On("UpdateUser", mock.Anything, userID, mock.MatchedBy(func(u *User) bool {
+		var attrs map[string]interface{}
+		if err := json.Unmarshal(u.Attributes, &attrs); err != nil {
+			return false
+		}
+		return u.ID == userID && u.OUID == "ou-1" && u.Type == "customer" &&
+			attrs["name"] == "New" && attrs["email"] == "a@example.com"
+	})).Return(&User{ID: userID}, nil)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodPatch, "/users/"+userID,
+		strings.NewReader(`{"attributes":{"name":"New"}}`))
+	req.SetPathValue("id", userID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserPatchRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestHandleUserPatchRequest_ErrorCases(t *testing.T) {
+	userID := testUserID123
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("GetUser", mock.Anything, userID, false).Return(nil, &ErrorUserNotFound)
+		handler := newUserHandler(mockSvc)
+		req := httptest.NewRequest(http.MethodPatch, "/users/"+userID, strings.NewReader(`{}`))
+		req.SetPathValue("id", userID)
+		rr := httptest.NewRecorder()
+		handler.HandleUserPatchRequest(rr, req)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		mockSvc.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NonObjectPatch", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("GetUser", mock.Anything, userID, false).Return(&User{ID: userID}, nil)
+		handler := newUserHandler(mockSvc)
+		req := httptest.NewRequest(http.MethodPatch, "/users/"+userID, strings.NewReader(`["x"]`))
+		req.SetPathValue("id", userID)
+		rr := httptest.NewRecorder()
+		handler.HandleUserPatchRequest(rr, req)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		mockSvc.AssertNotCalled(t, "UpdateUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestHandleUserDeleteRequest_Success(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
 	userID := testUserID123
@@ -950,7 +1064,7 @@ func TestHandleSelfUserGetRequest_ServiceError(t *testing.T) {
 	authCtx := security.NewSecurityContextForTest(userID, "", "", nil, nil)
 
 	mockSvc := NewUserServiceInterfaceMock(t)
-	mockSvc.On("GetUser", mock.Anything, userID, false).Return(nil, &ErrorUserNotFound).Once()
+	mockSvc.On("GetSelfUser", mock.Anything, userID, false).Return(nil, &ErrorUserNotFound).Once()
 
 	handler := newUserHandler(mockSvc)
 	req := httptest.NewRequest(http.MethodGet, "/users/me", nil)
@@ -1007,7 +1121,7 @@ func TestHandleSelfUserPutRequest_ServiceError(t *testing.T) {
 	attributes := json.RawMessage(`{"email":"alice@example.com"}`)
 
 	mockSvc := NewUserServiceInterfaceMock(t)
-	mockSvc.On("UpdateUserAttributes", mock.Anything, userID, attributes).
+	mockSvc.On("UpdateSelfUser", mock.Anything, userID, attributes).
 		Return(nil, &tidcommon.InternalServerError).Once()
 
 	handler := newUserHandler(mockSvc)
@@ -1302,3 +1416,174 @@ func TestHandleUserUsagesGetRequest_NotFound(t *testing.T) {
 
 	require.Equal(t, http.StatusNotFound, rr.Code)
 }
+
+func TestHandleUserEffectiveAccessGetRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetEffectiveAccess", mock.Anything, testUserID123).
+		Return(&EffectiveAccess{
+			Groups:      []providers.EntityGroup{{ID: "g1", Name: "Engineering"}},
+			Roles:       []EffectiveRole{{ID: "r1", Name: "Editor", Direct: true}},
+			Permissions: []string{"orders:read"},
+			ResourceServers: []EffectiveResourceServer{
+				{ID: "rs-1", Identifier: "https://orders.example.com", Scopes: []string{"orders:read"}},
+			},
+		}, nil)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/effective-access", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserEffectiveAccessGetRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response EffectiveAccess
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	require.Len(t, response.Roles, 1)
+	require.True(t, response.Roles[0].Direct)
+	require.Equal(t, []string{"orders:read"}, response.Permissions)
+	require.Len(t, response.ResourceServers, 1)
+	require.Equal(t, "https://orders.example.com", response.ResourceServers[0].Identifier)
+}
+
+func TestHandleUserEffectiveAccessGetRequest_NotFound(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetEffectiveAccess", mock.Anything, testUserID123).
+		Return(nil, &ErrorUserNotFound)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/effective-access", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserEffectiveAccessGetRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestHandleSelfProfileGetRequest_Success(t *testing.T) {
+	authCtx := security.NewSecurityContextForTest(testUserID123, "", "", nil, nil)
+	profile := &SelfProfile{
+		ID:                 testUserID123,
+		Type:               testUserType,
+		Attributes:         json.RawMessage(`{"email":"alice@example.com"}`),
+		EditableAttributes: []string{"email"},
+	}
+
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetSelfProfile", mock.Anything, testUserID123).Return(profile, nil).Once()
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/me/profile", nil)
+	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfProfileGetRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp SelfProfile
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, profile.EditableAttributes, resp.EditableAttributes)
+	require.JSONEq(t, string(profile.Attributes), string(resp.Attributes))
+}
+
+func TestHandleSelfProfilePatchRequest_NotEditable(t *testing.T) {
+	authCtx := security.NewSecurityContextForTest(testUserID123, "", "", nil, nil)
+
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("PatchSelfProfile", mock.Anything, testUserID123, json.RawMessage(`{"employeeId":"E-2"}`)).
+		Return(nil, &ErrorSelfServiceAttributeNotEditable).Once()
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodPatch, "/users/me/profile",
+		bytes.NewBufferString(`{"attributes":{"employeeId":"E-2"}}`))
+	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfProfilePatchRequest(rr, req)
+
+	require.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestHandleSelfProfilePatchRequest_Unauthorized(t *testing.T) {
+	handler := newUserHandler(NewUserServiceInterfaceMock(t))
+	req := httptest.NewRequest(http.MethodPatch, "/users/me/profile",
+		bytes.NewBufferString(`{"attributes":{"email":"alice@example.com"}}`))
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfProfilePatchRequest(rr, req)
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestHandleSelfPasswordChangeRequest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		body           string
+		svcErr         *tidcommon.ServiceError
+		callsService   bool
+		expectedStatus int
+	}{
+		{"Success", `{"currentPassword":"old-secret","newPassword":"new-secret"}`, nil, true,
+			http.StatusNoContent},
+		{"WrongCurrentPassword", `{"currentPassword":"wrong","newPassword":"new-secret"}`,
+			&ErrorInvalidCurrentPassword, true, http.StatusBadRequest},
+		{"MissingField", `{"currentPassword":"old-secret"}`, nil, false, http.StatusBadRequest},
+		{"InvalidBody", `{`, nil, false, http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authCtx := security.NewSecurityContextForTest(testUserID123, "", "", nil, nil)
+			mockSvc := NewUserServiceInterfaceMock(t)
+			if tc.callsService {
+				mockSvc.On("ChangeSelfPassword", mock.Anything, testUserID123, mock.Anything).
+					Return(tc.svcErr).Once()
+			}
+
+			handler := newUserHandler(mockSvc)
+			req := httptest.NewRequest(http.MethodPost, "/users/me/password", bytes.NewBufferString(tc.body))
+			req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+			rr := httptest.NewRecorder()
+
+			handler.HandleSelfPasswordChangeRequest(rr, req)
+
+			require.Equal(t, tc.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestHandleUserStateChangeRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("ChangeUserState", mock.Anything, testUserID123, AccountStateActionDisable).
+		Return(&User{ID: testUserID123, State: providers.EntityStateDisabled}, nil)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodPost, "/users/"+testUserID123+"/disable", nil)
+	req.SetPathValue("id", testUserID123)
+	req.SetPathValue("action", string(AccountStateActionDisable))
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserStateChangeRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response User
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	require.Equal(t, providers.EntityStateDisabled, response.State)
+}
+
+func TestHandleUserStateChangeRequest_InvalidTransition(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("ChangeUserState", mock.Anything, testUserID123, AccountStateActionUnlock).
+		Return(nil, &ErrorInvalidStateTransition)
+
+	handler := newUserHandler(mockSvc)
+	req := httptest.NewRequest(http.MethodPost, "/users/"+testUserID123+"/unlock", nil)
+	req.SetPathValue("id", testUserID123)
+	req.SetPathValue("action", string(AccountStateActionUnlock))
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserStateChangeRequest(rr, req)
+
+	require.Equal(t, http.StatusConflict, rr.Code)
+}
@@ -22,14 +22,20 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
+	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/device"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/job"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/outbox"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 )
 
 // Initialize initializes the user service and registers its routes.
@@ -39,9 +45,18 @@ func Initialize(
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc providers.ObservabilityProvider,
+	deviceService device.DeviceServiceInterface,
+	loginHistoryService loginhistory.LoginHistoryServiceInterface,
+	jobService job.JobServiceInterface,
+	outboxService outbox.OutboxServiceInterface,
+	consentService consent.ConsentServiceInterface,
+	runtimeStore providers.RuntimeStoreProvider,
 ) (UserServiceInterface, oupkg.OUUserResolver, declarativeresource.ResourceExporter, error) {
 	// Step 1: Create service with entity service
-	userService := newUserService(authzService, entityService, ouService, entityTypeService)
+	userService := newUserService(authzService, entityService, ouService, entityTypeService,
+		config.GetServerRuntime().Config.SoftDelete, config.GetServerRuntime().Config.User.SensitiveAttributes,
+		observabilitySvc, jobService, outboxService)
 
 	// Step 2: Load user-specific indexed attributes into the entity store.
 	if err := entityService.LoadIndexedAttributes(getUserIndexedAttributes()); err != nil {
@@ -57,7 +72,11 @@ func Initialize(
 	}
 
 	userHandler := newUserHandler(userService)
-	registerRoutes(mux, userHandler)
+	deviceHandler := newUserDeviceHandler(userService, deviceService)
+	loginHistoryHandler := newUserLoginHistoryHandler(userService, loginHistoryService)
+	privacyHandler := newUserPrivacyHandler(newUserPrivacyService(userService, entityService, authzService,
+		deviceService, loginHistoryService, consentService, outboxService, runtimeStore, observabilitySvc))
+	registerRoutes(mux, userHandler, deviceHandler, loginHistoryHandler, privacyHandler)
 
 	// Create resolver for OU package to query user data without cross-DB access
 	ouUserResolver := newOUUserResolver(entityService, entityTypeService)
@@ -86,7 +105,8 @@ func getUserIndexedAttributes() []string {
 }
 
 // registerRoutes registers the routes for user management operations.
-func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
+func registerRoutes(mux *http.ServeMux, userHandler *userHandler, deviceHandler *userDeviceHandler,
+	loginHistoryHandler *userLoginHistoryHandler, privacyHandler *userPrivacyHandler) {
 	opts1 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -100,7 +120,7 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 	}, opts1))
 
 	opts2 := middleware.CORSOptions{
-		AllowedMethods:   []string{"GET", "PUT", "DELETE", "POST"},
+		AllowedMethods:   []string{"GET", "PUT", "PATCH", "DELETE", "POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
 		AllowCredentials: true,
 		MaxAge:           600,
@@ -111,12 +131,22 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 			segments := strings.Split(path, "/")
 			r.SetPathValue("id", segments[0])
 
-			if len(segments) == 1 {
+			if len(segments) == 1 && segments[0] == deletedUsersPathSegment {
+				userHandler.HandleDeletedUserListRequest(w, r)
+			} else if len(segments) == 1 {
 				userHandler.HandleUserGetRequest(w, r)
 			} else if len(segments) == 2 && segments[1] == "groups" {
 				userHandler.HandleUserGroupsGetRequest(w, r)
 			} else if len(segments) == 2 && segments[1] == "usages" {
 				userHandler.HandleUserUsagesGetRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == devicesPathSegment {
+				deviceHandler.HandleUserDevicesGetRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == effectiveAccessPathSegment {
+				userHandler.HandleUserEffectiveAccessGetRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == loginHistoryPathSegment {
+				loginHistoryHandler.HandleUserLoginHistoryGetRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == gdprExportPathSegment {
+				privacyHandler.HandleUserDataExportRequest(w, r)
 			} else {
 				http.NotFound(w, r)
 			}
@@ -127,11 +157,25 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 			r.SetPathValue("id", strings.Split(path, "/")[0])
 			userHandler.HandleUserPutRequest(w, r)
 		}, opts2))
-	mux.HandleFunc(middleware.WithCORS("DELETE /users/",
+	mux.HandleFunc(middleware.WithCORS("PATCH /users/",
 		func(w http.ResponseWriter, r *http.Request) {
 			path := strings.TrimPrefix(r.URL.Path, "/users/")
 			r.SetPathValue("id", strings.Split(path, "/")[0])
-			userHandler.HandleUserDeleteRequest(w, r)
+			userHandler.HandleUserPatchRequest(w, r)
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/",
+		func(w http.ResponseWriter, r *http.Request) {
+			path := strings.TrimPrefix(r.URL.Path, "/users/")
+			segments := strings.Split(path, "/")
+			r.SetPathValue("id", segments[0])
+			if len(segments) == 3 && segments[1] == devicesPathSegment {
+				r.SetPathValue("deviceId", segments[2])
+				deviceHandler.HandleUserDeviceDeleteRequest(w, r)
+			} else if len(segments) == 1 && r.URL.Query().Has(queryParamMode) {
+				privacyHandler.HandleUserEraseRequest(w, r)
+			} else {
+				userHandler.HandleUserDeleteRequest(w, r)
+			}
 		}, opts2))
 	mux.HandleFunc(middleware.WithCORS("POST /users/",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -140,10 +184,18 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 			if len(segments) == 2 && segments[1] == "update-credentials" {
 				r.SetPathValue("id", segments[0])
 				userHandler.HandleUserCredentialUpdateRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "restore" {
+				r.SetPathValue("id", segments[0])
+				userHandler.HandleUserRestoreRequest(w, r)
+			} else if len(segments) == 2 && isAccountStateAction(segments[1]) {
+				r.SetPathValue("id", segments[0])
+				r.SetPathValue("action", segments[1])
+				userHandler.HandleUserStateChangeRequest(w, r)
 			} else {
 				http.NotFound(w, r)
 			}
 		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("POST /users/bulk", userHandler.HandleUserBulkPostRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	}, opts2))
@@ -160,6 +212,53 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 		w.WriteHeader(http.StatusNoContent)
 	}, optsSelf))
 
+	optsSelfProfile := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PATCH"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/profile",
+		userHandler.HandleSelfProfileGetRequest, optsSelfProfile))
+	mux.HandleFunc(middleware.WithCORS("PATCH /users/me/profile",
+		userHandler.HandleSelfProfilePatchRequest, optsSelfProfile))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/profile",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfProfile))
+
+	optsSelfDevices := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/devices",
+		deviceHandler.HandleSelfDevicesGetRequest, optsSelfDevices))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/devices",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfDevices))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/devices/{deviceId}",
+		deviceHandler.HandleSelfDeviceDeleteRequest, optsSelfDevices))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/devices/{deviceId}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfDevices))
+
+	optsSelfLoginHistory := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/login-history",
+		loginHistoryHandler.HandleSelfLoginHistoryGetRequest, optsSelfLoginHistory))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/login-history",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfLoginHistory))
+
 	optsSelfCredentials := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -172,6 +271,12 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, optsSelfCredentials))
+	mux.HandleFunc(middleware.WithCORS("POST /users/me/password",
+		userHandler.HandleSelfPasswordChangeRequest, optsSelfCredentials))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/password",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSelfCredentials))
 
 	opts3 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST"},
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// userLoginHistoryHandler is the handler for the login history of a user.
+type userLoginHistoryHandler struct {
+	userService         UserServiceInterface
+	loginHistoryService loginhistory.LoginHistoryServiceInterface
+}
+
+// newUserLoginHistoryHandler creates a new instance of userLoginHistoryHandler.
+func newUserLoginHistoryHandler(userService UserServiceInterface,
+	loginHistoryService loginhistory.LoginHistoryServiceInterface) *userLoginHistoryHandler {
+	return &userLoginHistoryHandler{
+		userService:         userService,
+		loginHistoryService: loginHistoryService,
+	}
+}
+
+// HandleUserLoginHistoryGetRequest handles GET /users/{id}/login-history.
+func (h *userLoginHistoryHandler) HandleUserLoginHistoryGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(ctx, w, &ErrorMissingUserID)
+		return
+	}
+	// Resolving the user enforces that it exists and that the caller may access it.
+	if _, svcErr := h.userService.GetUser(ctx, id, false); svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+	h.writeLoginHistory(w, r, id, "/users/"+id+"/"+loginHistoryPathSegment)
+}
+
+// HandleSelfLoginHistoryGetRequest handles GET /users/me/login-history.
+func (h *userLoginHistoryHandler) HandleSelfLoginHistoryGetRequest(w http.ResponseWriter, r *http.Request) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		handleError(r.Context(), w, &ErrorAuthenticationFailed)
+		return
+	}
+	h.writeLoginHistory(w, r, userID, "/users/me/"+loginHistoryPathSegment)
+}
+
+// writeLoginHistory writes the page of sign-in attempts of the user selected by the query parameters.
+func (h *userLoginHistoryHandler) writeLoginHistory(w http.ResponseWriter, r *http.Request,
+	userID, basePath string) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter, svcErr := parseLoginHistoryFilter(query)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	page, svcErr := h.loginHistoryService.GetLoginHistory(ctx, userID, *filter)
+	if svcErr != nil {
+		handleError(ctx, w, svcErr)
+		return
+	}
+
+	timeQuery := url.Values{}
+	for _, key := range []string{"from", "to"} {
+		if value := query.Get(key); value != "" {
+			timeQuery.Set(key, value)
+		}
+	}
+	extraQuery := ""
+	if len(timeQuery) > 0 {
+		extraQuery = "&" + timeQuery.Encode()
+	}
+
+	sysutils.WriteSuccessResponse(ctx, w, http.StatusOK, LoginHistoryResponse{
+		TotalResults: page.TotalResults,
+		StartIndex:   filter.Offset + 1,
+		Count:        len(page.Attempts),
+		Attempts:     page.Attempts,
+		Links: sysutils.BuildPaginationLinks(basePath, filter.Limit, filter.Offset, page.TotalResults,
+			extraQuery),
+	})
+
+	log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)).Debug(ctx,
+		"Successfully retrieved login history", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.Int("limit", filter.Limit), log.Int("offset", filter.Offset),
+		log.Int("totalResults", page.TotalResults))
+}
+
+// parseLoginHistoryFilter parses the pagination and time range query parameters of a login history
+// request.
+func parseLoginHistoryFilter(query url.Values) (*loginhistory.LoginHistoryFilter, *tidcommon.ServiceError) {
+	limit, offset, svcErr := parsePaginationParams(query)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if limit == 0 {
+		limit = serverconst.DefaultPageSize
+	}
+	if limit > serverconst.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+
+	filter := &loginhistory.LoginHistoryFilter{Limit: limit, Offset: offset}
+	if from := query.Get("from"); from != "" {
+		parsed, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return nil, &ErrorInvalidTimeFilter
+		}
+		filter.From = parsed
+	}
+	if to := query.Get("to"); to != "" {
+		parsed, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return nil, &ErrorInvalidTimeFilter
+		}
+		filter.To = parsed
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return nil, &ErrorInvalidTimeFilter
+	}
+	return filter, nil
+}
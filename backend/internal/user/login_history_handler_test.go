@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/loginhistorymock"
+)
+
+func TestHandleUserLoginHistoryGetRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetUser", mock.Anything, testUserID123, false).Return(&User{ID: testUserID123}, nil)
+	mockHistorySvc := loginhistorymock.NewLoginHistoryServiceInterfaceMock(t)
+	mockHistorySvc.On("GetLoginHistory", mock.Anything, testUserID123, loginhistory.LoginHistoryFilter{
+		From:   time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+		Limit:  1,
+		Offset: 0,
+	}).Return(&loginhistory.LoginHistoryPage{
+		TotalResults: 2,
+		Attempts:     []loginhistory.LoginAttempt{{Outcome: loginhistory.LoginOutcomeSuccess}},
+	}, nil)
+
+	handler := newUserLoginHistoryHandler(mockSvc, mockHistorySvc)
+	req := httptest.NewRequest(http.MethodGet,
+		"/users/"+testUserID123+"/login-history?limit=1&from=2026-05-01T00:00:00Z", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserLoginHistoryGetRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp LoginHistoryResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, 2, resp.TotalResults)
+	require.Equal(t, 1, resp.StartIndex)
+	require.Equal(t, 1, resp.Count)
+	require.NotEmpty(t, resp.Links)
+	require.Equal(t, "next", resp.Links[0].Rel)
+	require.Equal(t, "/users/"+testUserID123+"/login-history?offset=1&limit=1&from=2026-05-01T00%3A00%3A00Z",
+		resp.Links[0].Href)
+}
+
+func TestHandleUserLoginHistoryGetRequest_UserNotFound(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetUser", mock.Anything, testUserID123, false).Return(nil, &ErrorUserNotFound)
+	mockHistorySvc := loginhistorymock.NewLoginHistoryServiceInterfaceMock(t)
+
+	handler := newUserLoginHistoryHandler(mockSvc, mockHistorySvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/login-history", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserLoginHistoryGetRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	mockHistorySvc.AssertNotCalled(t, "GetLoginHistory", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandleSelfLoginHistoryGetRequest_DefaultPage(t *testing.T) {
+	authCtx := security.NewSecurityContextForTest(testUserID123, "", "", nil, nil)
+	mockHistorySvc := loginhistorymock.NewLoginHistoryServiceInterfaceMock(t)
+	mockHistorySvc.On("GetLoginHistory", mock.Anything, testUserID123, loginhistory.LoginHistoryFilter{
+		Limit: 30,
+	}).Return(&loginhistory.LoginHistoryPage{Attempts: []loginhistory.LoginAttempt{}}, nil)
+
+	handler := newUserLoginHistoryHandler(NewUserServiceInterfaceMock(t), mockHistorySvc)
+	req := httptest.NewRequest(http.MethodGet, "/users/me/login-history", nil)
+	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfLoginHistoryGetRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestHandleSelfLoginHistoryGetRequest_InvalidFilters(t *testing.T) {
+	authCtx := security.NewSecurityContextForTest(testUserID123, "", "", nil, nil)
+	tests := []struct {
+		name         string
+		query        string
+		expectedCode string
+	}{
+		{"MalformedFrom", "from=yesterday", ErrorInvalidTimeFilter.Code},
+		{"FromAfterTo", "from=2026-05-02T00:00:00Z&to=2026-05-01T00:00:00Z", ErrorInvalidTimeFilter.Code},
+		{"LimitAboveMaximum", "limit=101", ErrorInvalidLimit.Code},
+		{"NegativeOffset", "offset=-1", ErrorInvalidOffset.Code},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := newUserLoginHistoryHandler(NewUserServiceInterfaceMock(t),
+				loginhistorymock.NewLoginHistoryServiceInterfaceMock(t))
+			req := httptest.NewRequest(http.MethodGet, "/users/me/login-history?"+tc.query, nil)
+			req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+			rr := httptest.NewRecorder()
+
+			handler.HandleSelfLoginHistoryGetRequest(rr, req)
+
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			var errResp apierror.ErrorResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&errResp))
+			require.Equal(t, tc.expectedCode, errResp.Code)
+		})
+	}
+}
+
+func TestHandleSelfLoginHistoryGetRequest_Unauthorized(t *testing.T) {
+	handler := newUserLoginHistoryHandler(NewUserServiceInterfaceMock(t),
+		loginhistorymock.NewLoginHistoryServiceInterfaceMock(t))
+	req := httptest.NewRequest(http.MethodGet, "/users/me/login-history", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleSelfLoginHistoryGetRequest(rr, req)
+
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+}
@@ -19,8 +19,12 @@
 package user
 
 import (
+	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/authn/loginhistory"
+	"github.com/thunder-id/thunderid/internal/device"
 	"github.com/thunder-id/thunderid/internal/system/cryptolib"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -28,13 +32,15 @@ import (
 
 // User represents a user in the system.
 type User struct {
-	ID         string          `json:"id,omitempty"`
-	OUID       string          `json:"ouId,omitempty"`
-	OUHandle   string          `json:"ouHandle,omitempty"`
-	Type       string          `json:"type,omitempty"`
-	Attributes json.RawMessage `json:"attributes,omitempty"`
-	Display    string          `json:"display,omitempty"`
-	IsReadOnly bool            `json:"isReadOnly"`
+	ID         string                `json:"id,omitempty"`
+	OUID       string                `json:"ouId,omitempty"`
+	OUHandle   string                `json:"ouHandle,omitempty"`
+	Type       string                `json:"type,omitempty"`
+	Attributes json.RawMessage       `json:"attributes,omitempty"`
+	Display    string                `json:"display,omitempty"`
+	State      providers.EntityState `json:"state,omitempty"`
+	IsReadOnly bool                  `json:"isReadOnly"`
+	DeletedAt  *time.Time            `json:"deletedAt,omitempty"`
 }
 
 // Credential represents the credentials of a user.
@@ -75,19 +81,127 @@ type UserGroupListResponse struct {
 	Links        []utils.Link            `json:"links"`
 }
 
+// LoginHistoryResponse represents the response for listing the sign-in attempts of a user.
+type LoginHistoryResponse struct {
+	TotalResults int                         `json:"totalResults"`
+	StartIndex   int                         `json:"startIndex"`
+	Count        int                         `json:"count"`
+	Attempts     []loginhistory.LoginAttempt `json:"attempts"`
+	Links        []utils.Link                `json:"links"`
+}
+
+// EffectiveAccess represents the access a user effectively holds through role assignments made to
+// the user directly and to the groups the user belongs to.
+type EffectiveAccess struct {
+	Groups          []providers.EntityGroup   `json:"groups"`
+	Roles           []EffectiveRole           `json:"roles"`
+	Permissions     []string                  `json:"permissions"`
+	ResourceServers []EffectiveResourceServer `json:"resourceServers"`
+}
+
+// EffectiveRole represents a role held by a user and how it was assigned.
+type EffectiveRole struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	OUID      string   `json:"ouId"`
+	Direct    bool     `json:"direct"`
+	ViaGroups []string `json:"viaGroups,omitempty"`
+}
+
+// EffectiveResourceServer represents a resource server a user can obtain access tokens for, with the
+// scopes the user is permitted to request.
+type EffectiveResourceServer struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name,omitempty"`
+	Handle     string   `json:"handle,omitempty"`
+	Identifier string   `json:"identifier,omitempty"`
+	Scopes     []string `json:"scopes"`
+}
+
+// UserDataExport is the machine-readable archive of the personal data held about a user.
+type UserDataExport struct {
+	ExportedAt     time.Time                   `json:"exportedAt"`
+	User           *User                       `json:"user"`
+	Groups         []providers.EntityGroup     `json:"groups"`
+	Consents       []UserDataConsent           `json:"consents"`
+	Devices        []device.Device             `json:"devices"`
+	LoginHistory   []loginhistory.LoginAttempt `json:"loginHistory"`
+	LinkedAccounts []LinkedAccount             `json:"linkedAccounts"`
+}
+
+// UserDataConsent is a consent record of a user in a data export.
+type UserDataConsent struct {
+	ID            string                   `json:"id"`
+	Type          string                   `json:"type"`
+	ApplicationID string                   `json:"applicationId"`
+	Status        string                   `json:"status"`
+	ValidityTime  int64                    `json:"validityTime,omitempty"`
+	CreatedTime   int64                    `json:"createdTime"`
+	UpdatedTime   int64                    `json:"updatedTime"`
+	Purposes      []UserDataConsentPurpose `json:"purposes"`
+}
+
+// UserDataConsentPurpose is a purpose of a consent record and the elements the user decided on.
+type UserDataConsentPurpose struct {
+	Name     string                   `json:"name"`
+	Elements []UserDataConsentElement `json:"elements"`
+}
+
+// UserDataConsentElement is the decision of a user on one consent element.
+type UserDataConsentElement struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Approved  bool   `json:"approved"`
+}
+
+// LinkedAccount is a federated identity linked to a user.
+type LinkedAccount struct {
+	Subject string `json:"subject"`
+}
+
+// UserErasureConfirmation is returned when an erasure is requested. The erasure runs only when it
+// is repeated with the confirmation token before the token expires.
+type UserErasureConfirmation struct {
+	UserID            string    `json:"userId"`
+	ConfirmationToken string    `json:"confirmationToken"`
+	ExpiresAt         time.Time `json:"expiresAt"`
+}
+
+// UserErasureResult summarizes a completed erasure.
+type UserErasureResult struct {
+	UserID                string `json:"userId"`
+	Pseudonym             string `json:"pseudonym"`
+	ConsentsRevoked       int    `json:"consentsRevoked"`
+	DevicesRevoked        int    `json:"devicesRevoked"`
+	AuditEventsAnonymized int64  `json:"auditEventsAnonymized"`
+}
+
+// EffectiveAccessResolver resolves the roles and permissions a user holds directly and through the
+// given groups, without requiring direct import of the role package.
+type EffectiveAccessResolver interface {
+	ResolveEffectiveAccess(ctx context.Context, userID string, groups []providers.EntityGroup) (
+		*EffectiveAccess, error)
+}
+
 // CreateUserRequest represents the request body for creating a user.
 type CreateUserRequest struct {
-	OUID       string          `json:"ouId"                 native:"required"`
-	Type       string          `json:"type"                 native:"required"`
-	Groups     []string        `json:"groups,omitempty"`
+	OUID       string          `json:"ouId,omitempty"`
+	OUHandle   string          `json:"ouHandle,omitempty"`
+	Type       string          `json:"type"                 native:"required,max=50"`
+	Groups     []string        `json:"groups,omitempty"     native:"omitempty,dive,required"`
 	Attributes json.RawMessage `json:"attributes,omitempty" native:"omitempty"`
 }
 
+// BulkCreateUserRequest represents the request body for creating users in bulk.
+type BulkCreateUserRequest struct {
+	Users []CreateUserRequest `json:"users" native:"required,max=1000,dive"`
+}
+
 // UpdateUserRequest represents the request body for updating a user.
 type UpdateUserRequest struct {
 	OUID       string          `json:"ouId,omitempty"`
-	Type       string          `json:"type,omitempty"`
-	Groups     []string        `json:"groups,omitempty"`
+	Type       string          `json:"type,omitempty"       native:"omitempty,max=50"`
+	Groups     []string        `json:"groups,omitempty"     native:"omitempty,dive,required"`
 	Attributes json.RawMessage `json:"attributes,omitempty"`
 }
 
@@ -96,6 +210,22 @@ type UpdateSelfUserRequest struct {
 	Attributes json.RawMessage `json:"attributes,omitempty"`
 }
 
+// SelfProfile represents the profile of the authenticated user as exposed through self-service.
+// Attributes hidden by the self-service policy of the user type are omitted.
+type SelfProfile struct {
+	ID                 string          `json:"id"`
+	Type               string          `json:"type"`
+	Attributes         json.RawMessage `json:"attributes,omitempty"`
+	EditableAttributes []string        `json:"editableAttributes"`
+}
+
+// ChangeSelfPasswordRequest represents the request body for changing the password of the
+// authenticated user.
+type ChangeSelfPasswordRequest struct {
+	CurrentPassword string `json:"currentPassword" native:"required"`
+	NewPassword     string `json:"newPassword"     native:"required"`
+}
+
 // UpdateUserCredentialsRequest represents the request body for updating user credentials by an admin.
 type UpdateUserCredentialsRequest struct {
 	Credentials json.RawMessage `json:"credentials,omitempty"`
@@ -103,8 +233,8 @@ type UpdateUserCredentialsRequest struct {
 
 // CreateUserByPathRequest represents the request body for creating a user under a handle path.
 type CreateUserByPathRequest struct {
-	Type       string          `json:"type"                 native:"required"`
-	Groups     []string        `json:"groups,omitempty"`
+	Type       string          `json:"type"                 native:"required,max=50"`
+	Groups     []string        `json:"groups,omitempty"     native:"omitempty,dive,required"`
 	Attributes json.RawMessage `json:"attributes,omitempty"`
 }
 
@@ -115,7 +245,9 @@ func entityToUser(e *providers.Entity) User {
 		OUID:       e.OUID,
 		Type:       e.Type,
 		Attributes: e.Attributes,
+		State:      e.State,
 		IsReadOnly: e.IsReadOnly,
+		DeletedAt:  e.DeletedAt,
 	}
 }
 
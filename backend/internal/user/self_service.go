@@ -0,0 +1,325 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// selfServicePolicy maps the non-credential attributes of a user type to their self-service access.
+type selfServicePolicy map[string]entitytype.SelfServiceAccess
+
+// access returns the self-service access of the given attribute. Attributes that are not declared
+// in the user type fall back to the schema default.
+func (p selfServicePolicy) access(attribute string) entitytype.SelfServiceAccess {
+	if access, ok := p[attribute]; ok {
+		return access
+	}
+	return entitytype.SelfServiceReadWrite
+}
+
+// GetSelfUser retrieves the authenticated user, omitting the attributes hidden by the self-service
+// policy of the user type.
+func (us *userService) GetSelfUser(
+	ctx context.Context, userID string, includeDisplay bool,
+) (*User, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	user, policy, svcErr := us.getUserWithSelfServicePolicy(ctx, userID, includeDisplay, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	attributes, svcErr := filterSelfServiceAttributes(ctx, user.Attributes, policy, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	user.Attributes = attributes
+	return user, nil
+}
+
+// UpdateSelfUser replaces the editable attributes of the authenticated user. Attributes that are not
+// editable through self-service are preserved, and the request is rejected if it modifies any of them.
+func (us *userService) UpdateSelfUser(
+	ctx context.Context, userID string, attributes json.RawMessage,
+) (*User, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug(ctx, "Updating self user", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	user, policy, svcErr := us.updateSelfAttributes(ctx, userID, attributes, false, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	filtered, svcErr := filterSelfServiceAttributes(ctx, user.Attributes, policy, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	user.Attributes = filtered
+	return user, nil
+}
+
+// GetSelfProfile retrieves the self-service profile of the authenticated user.
+func (us *userService) GetSelfProfile(ctx context.Context, userID string) (*SelfProfile, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	user, policy, svcErr := us.getUserWithSelfServicePolicy(ctx, userID, false, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return buildSelfProfile(ctx, user, policy, logger)
+}
+
+// PatchSelfProfile merges the given attributes into the profile of the authenticated user. An
+// attribute with a null value is removed. Only attributes editable through self-service may change.
+func (us *userService) PatchSelfProfile(
+	ctx context.Context, userID string, attributes json.RawMessage,
+) (*SelfProfile, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug(ctx, "Patching self profile", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	user, policy, svcErr := us.updateSelfAttributes(ctx, userID, attributes, true, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return buildSelfProfile(ctx, user, policy, logger)
+}
+
+// ChangeSelfPassword changes the password of the authenticated user after verifying the current
+// password.
+func (us *userService) ChangeSelfPassword(
+	ctx context.Context, userID string, request ChangeSelfPasswordRequest,
+) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug(ctx, "Changing self password", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if strings.TrimSpace(userID) == "" {
+		return &ErrorAuthenticationFailed
+	}
+	if request.CurrentPassword == "" || request.NewPassword == "" {
+		return &ErrorMissingCredentials
+	}
+
+	_, err := us.entityService.AuthenticateEntityByID(ctx, userID, map[string]interface{}{
+		CredentialTypePassword.String(): request.CurrentPassword,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrAuthenticationFailed):
+			logger.Debug(ctx, "Current password verification failed", log.MaskedString(log.LoggerKeyUserID, userID))
+			return &ErrorInvalidCurrentPassword
+		case errors.Is(err, entity.ErrEntityNotFound):
+			return &ErrorUserNotFound
+		case errors.Is(err, entity.ErrEntityNotActive):
+			return &ErrorUserNotActive
+		default:
+			return logErrorAndReturnServerError(ctx, logger, "Failed to verify current password", err,
+				log.MaskedString(log.LoggerKeyUserID, userID))
+		}
+	}
+
+	credentials, err := json.Marshal(map[string]string{CredentialTypePassword.String(): request.NewPassword})
+	if err != nil {
+		return logErrorAndReturnServerError(ctx, logger, "Failed to marshal credentials", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	return us.UpdateUserCredentials(ctx, userID, credentials)
+}
+
+// updateSelfAttributes applies the requested attribute changes of the authenticated user under the
+// self-service policy of the user type and persists the result. When merge is false, the editable
+// attributes are replaced by the requested attributes.
+func (us *userService) updateSelfAttributes(
+	ctx context.Context, userID string, attributes json.RawMessage, merge bool, logger *log.Logger,
+) (*User, selfServicePolicy, *tidcommon.ServiceError) {
+	requested, err := decodeAttributes(attributes)
+	if err != nil || requested == nil {
+		return nil, nil, &ErrorInvalidRequestFormat
+	}
+
+	user, policy, svcErr := us.getUserWithSelfServicePolicy(ctx, userID, false, logger)
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+	current, err := decodeAttributes(user.Attributes)
+	if err != nil {
+		return nil, nil, logErrorAndReturnServerError(ctx, logger, "Failed to parse user attributes", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	updated := make(map[string]json.RawMessage, len(current))
+	for name, value := range current {
+		if merge || policy.access(name) != entitytype.SelfServiceReadWrite {
+			updated[name] = value
+		}
+	}
+	for name, value := range requested {
+		access := policy.access(name)
+		if access != entitytype.SelfServiceReadWrite {
+			// Read-only attributes may be echoed back unchanged, as clients send what they have read.
+			if access == entitytype.SelfServiceReadOnly && jsonValuesEqual(current[name], value) {
+				continue
+			}
+			logger.Debug(ctx, "Self-service update of a non-editable attribute rejected",
+				log.MaskedString(log.LoggerKeyUserID, userID), log.String("attribute", name))
+			return nil, nil, &ErrorSelfServiceAttributeNotEditable
+		}
+		if merge && bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			delete(updated, name)
+			continue
+		}
+		updated[name] = value
+	}
+
+	updatedJSON, err := json.Marshal(updated)
+	if err != nil {
+		return nil, nil, logErrorAndReturnServerError(ctx, logger, "Failed to marshal user attributes", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	updatedUser, svcErr := us.UpdateUserAttributes(ctx, userID, updatedJSON)
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+	return updatedUser, policy, nil
+}
+
+// getUserWithSelfServicePolicy retrieves the user together with the self-service policy of its type.
+func (us *userService) getUserWithSelfServicePolicy(
+	ctx context.Context, userID string, includeDisplay bool, logger *log.Logger,
+) (*User, selfServicePolicy, *tidcommon.ServiceError) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, nil, &ErrorAuthenticationFailed
+	}
+
+	user, svcErr := us.GetUser(ctx, userID, includeDisplay)
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+
+	if us.entityTypeService == nil {
+		logger.Error(ctx, "Entity type service is not configured for user operations")
+		return nil, nil, &tidcommon.InternalServerError
+	}
+	attributeInfos, svcErr := us.entityTypeService.GetAttributes(ctx,
+		entitytype.TypeCategoryUser, user.Type, false, true, false)
+	if svcErr != nil {
+		if svcErr.Code == entitytype.ErrorEntityTypeNotFound.Code {
+			return nil, nil, &ErrorEntityTypeNotFound
+		}
+		return nil, nil, logErrorAndReturnServerError(ctx, logger, "Failed to get attributes from schema",
+			fmt.Errorf("schema service error: %s", svcErr.ErrorDescription.DefaultValue),
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	policy := make(selfServicePolicy, len(attributeInfos))
+	for _, info := range attributeInfos {
+		policy[info.Attribute] = info.SelfService
+	}
+	return user, policy, nil
+}
+
+// buildSelfProfile builds the self-service profile of the user under the given policy.
+func buildSelfProfile(ctx context.Context, user *User, policy selfServicePolicy,
+	logger *log.Logger) (*SelfProfile, *tidcommon.ServiceError) {
+	attributes, svcErr := filterSelfServiceAttributes(ctx, user.Attributes, policy, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	editable := make([]string, 0, len(policy))
+	for name, access := range policy {
+		if access == entitytype.SelfServiceReadWrite {
+			editable = append(editable, name)
+		}
+	}
+	slices.Sort(editable)
+
+	return &SelfProfile{
+		ID:                 user.ID,
+		Type:               user.Type,
+		Attributes:         attributes,
+		EditableAttributes: editable,
+	}, nil
+}
+
+// filterSelfServiceAttributes removes the attributes hidden from self-service.
+func filterSelfServiceAttributes(ctx context.Context, attributes json.RawMessage, policy selfServicePolicy,
+	logger *log.Logger) (json.RawMessage, *tidcommon.ServiceError) {
+	decoded, err := decodeAttributes(attributes)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to parse user attributes", err)
+	}
+	if decoded == nil {
+		return attributes, nil
+	}
+
+	hidden := false
+	for name := range decoded {
+		if policy.access(name) == entitytype.SelfServiceNone {
+			delete(decoded, name)
+			hidden = true
+		}
+	}
+	if !hidden {
+		return attributes, nil
+	}
+
+	filtered, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to marshal user attributes", err)
+	}
+	return filtered, nil
+}
+
+// decodeAttributes decodes a JSON object of attributes. Returns nil when no attributes are given.
+func decodeAttributes(attributes json.RawMessage) (map[string]json.RawMessage, error) {
+	if len(bytes.TrimSpace(attributes)) == 0 {
+		return nil, nil
+	}
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(attributes, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// jsonValuesEqual reports whether the two JSON values are semantically equal.
+func jsonValuesEqual(a, b json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	var left, right interface{}
+	if json.Unmarshal(a, &left) != nil || json.Unmarshal(b, &right) != nil {
+		return false
+	}
+	return reflect.DeepEqual(left, right)
+}
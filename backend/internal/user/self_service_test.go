@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	entitypkg "github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+)
+
+const selfServiceTestAttributes = `{"email":"alice@example.com","employeeId":"E-1","riskScore":10}`
+
+// newSelfServiceTestService returns a user service whose user type marks employeeId as read-only and
+// riskScore as hidden from self-service.
+func newSelfServiceTestService(t *testing.T) (*userService, *entitymock.EntityServiceInterfaceMock) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&providers.Entity{Category: providers.EntityCategoryUser, ID: svcTestUserID1, Type: testUserType,
+			Attributes: json.RawMessage(selfServiceTestAttributes)}, nil).Maybe()
+
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, testUserType,
+		false, true, false).
+		Return([]entitytype.AttributeInfo{
+			{Attribute: "email", SelfService: entitytype.SelfServiceReadWrite},
+			{Attribute: "mobile", SelfService: entitytype.SelfServiceReadWrite},
+			{Attribute: "employeeId", SelfService: entitytype.SelfServiceReadOnly},
+			{Attribute: "riskScore", SelfService: entitytype.SelfServiceNone},
+		}, (*tidcommon.ServiceError)(nil)).Maybe()
+	entityTypeMock.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, testUserType,
+		true, false, false).
+		Return([]entitytype.AttributeInfo{{Attribute: "password"}}, (*tidcommon.ServiceError)(nil)).Maybe()
+
+	return &userService{
+		entityService:     entityMock,
+		entityTypeService: entityTypeMock,
+		authzService:      newAllowAllAuthz(t),
+	}, entityMock
+}
+
+func TestUserService_GetSelfUser_OmitsHiddenAttributes(t *testing.T) {
+	service, _ := newSelfServiceTestService(t)
+
+	user, err := service.GetSelfUser(context.Background(), svcTestUserID1, false)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"email":"alice@example.com","employeeId":"E-1"}`, string(user.Attributes))
+}
+
+func TestUserService_GetSelfProfile(t *testing.T) {
+	service, _ := newSelfServiceTestService(t)
+
+	profile, err := service.GetSelfProfile(context.Background(), svcTestUserID1)
+	require.Nil(t, err)
+	require.Equal(t, svcTestUserID1, profile.ID)
+	require.Equal(t, testUserType, profile.Type)
+	require.JSONEq(t, `{"email":"alice@example.com","employeeId":"E-1"}`, string(profile.Attributes))
+	require.Equal(t, []string{"email", "mobile"}, profile.EditableAttributes)
+}
+
+func TestUserService_GetSelfProfile_MissingUserID(t *testing.T) {
+	service, _ := newSelfServiceTestService(t)
+
+	profile, err := service.GetSelfProfile(context.Background(), "")
+	require.Nil(t, profile)
+	require.Equal(t, ErrorAuthenticationFailed.Code, err.Code)
+}
+
+func TestUserService_UpdateSelfUser_PreservesNonEditableAttributes(t *testing.T) {
+	service, entityMock := newSelfServiceTestService(t)
+	entityMock.On("UpdateAttributes", mock.Anything, svcTestUserID1, mock.Anything).
+		Run(func(args mock.Arguments) {
+			require.JSONEq(t, `{"mobile":"+94771234567","employeeId":"E-1","riskScore":10}`,
+				string(args.Get(2).(json.RawMessage)))
+		}).Return(nil).Once()
+
+	user, err := service.UpdateSelfUser(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"mobile":"+94771234567","employeeId":"E-1"}`))
+	require.Nil(t, err)
+	require.JSONEq(t, `{"mobile":"+94771234567","employeeId":"E-1"}`, string(user.Attributes))
+}
+
+func TestUserService_PatchSelfProfile_MergesAttributes(t *testing.T) {
+	service, entityMock := newSelfServiceTestService(t)
+	entityMock.On("UpdateAttributes", mock.Anything, svcTestUserID1, mock.Anything).
+		Run(func(args mock.Arguments) {
+			require.JSONEq(t, `{"mobile":"+94771234567","employeeId":"E-1","riskScore":10}`,
+				string(args.Get(2).(json.RawMessage)))
+		}).Return(nil).Once()
+
+	profile, err := service.PatchSelfProfile(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"email":null,"mobile":"+94771234567"}`))
+	require.Nil(t, err)
+	require.JSONEq(t, `{"mobile":"+94771234567","employeeId":"E-1"}`, string(profile.Attributes))
+}
+
+func TestUserService_SelfServiceUpdate_RejectsNonEditableAttributes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		attributes string
+		merge      bool
+	}{
+		{"ReplaceReadOnly", `{"email":"alice@example.com","employeeId":"E-2"}`, false},
+		{"ReplaceHidden", `{"email":"alice@example.com","riskScore":0}`, false},
+		{"PatchReadOnly", `{"employeeId":"E-2"}`, true},
+		{"PatchRemoveReadOnly", `{"employeeId":null}`, true},
+		{"PatchHidden", `{"riskScore":0}`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service, _ := newSelfServiceTestService(t)
+
+			var err *tidcommon.ServiceError
+			if tc.merge {
+				_, err = service.PatchSelfProfile(context.Background(), svcTestUserID1,
+					json.RawMessage(tc.attributes))
+			} else {
+				_, err = service.UpdateSelfUser(context.Background(), svcTestUserID1,
+					json.RawMessage(tc.attributes))
+			}
+			require.NotNil(t, err)
+			require.Equal(t, ErrorSelfServiceAttributeNotEditable.Code, err.Code)
+		})
+	}
+}
+
+func TestUserService_PatchSelfProfile_InvalidAttributes(t *testing.T) {
+	service, _ := newSelfServiceTestService(t)
+
+	_, err := service.PatchSelfProfile(context.Background(), svcTestUserID1, json.RawMessage(`["email"]`))
+	require.NotNil(t, err)
+	require.Equal(t, ErrorInvalidRequestFormat.Code, err.Code)
+}
+
+func TestUserService_ChangeSelfPassword_Succeeds(t *testing.T) {
+	service, entityMock := newSelfServiceTestService(t)
+	entityMock.On("AuthenticateEntityByID", mock.Anything, svcTestUserID1,
+		map[string]interface{}{"password": "old-secret"}).
+		Return(&entitypkg.AuthenticateResult{EntityID: svcTestUserID1}, nil).Once()
+	entityMock.On("UpdateCredentials", mock.Anything, svcTestUserID1,
+		json.RawMessage(`{"password":"new-secret"}`)).Return(nil).Once()
+
+	err := service.ChangeSelfPassword(context.Background(), svcTestUserID1,
+		ChangeSelfPasswordRequest{CurrentPassword: "old-secret", NewPassword: "new-secret"})
+	require.Nil(t, err)
+}
+
+func TestUserService_ChangeSelfPassword_Failures(t *testing.T) {
+	testCases := []struct {
+		name         string
+		request      ChangeSelfPasswordRequest
+		authErr      error
+		expectedCode string
+	}{
+		{"MissingNewPassword", ChangeSelfPasswordRequest{CurrentPassword: "old-secret"}, nil,
+			ErrorMissingCredentials.Code},
+		{"WrongCurrentPassword", ChangeSelfPasswordRequest{CurrentPassword: "wrong", NewPassword: "new-secret"},
+			entitypkg.ErrAuthenticationFailed, ErrorInvalidCurrentPassword.Code},
+		{"MissingUser", ChangeSelfPasswordRequest{CurrentPassword: "old-secret", NewPassword: "new-secret"},
+			entitypkg.ErrEntityNotFound, ErrorUserNotFound.Code},
+		{"InactiveUser", ChangeSelfPasswordRequest{CurrentPassword: "old-secret", NewPassword: "new-secret"},
+			entitypkg.ErrEntityNotActive, ErrorUserNotActive.Code},
+		{"StoreError", ChangeSelfPasswordRequest{CurrentPassword: "old-secret", NewPassword: "new-secret"},
+			errors.New("store error"), tidcommon.InternalServerError.Code},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service, entityMock := newSelfServiceTestService(t)
+			if tc.authErr != nil {
+				entityMock.On("AuthenticateEntityByID", mock.Anything, svcTestUserID1, mock.Anything).
+					Return(nil, tc.authErr).Once()
+			}
+
+			err := service.ChangeSelfPassword(context.Background(), svcTestUserID1, tc.request)
+			require.NotNil(t, err)
+			require.Equal(t, tc.expectedCode, err.Code)
+		})
+	}
+}
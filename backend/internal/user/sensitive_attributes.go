@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+)
+
+// canReadSensitiveAttributes reports whether the caller may read the sensitive attributes of the
+// user.
+func (us *userService) canReadSensitiveAttributes(ctx context.Context, user *User) (
+	bool, *tidcommon.ServiceError) {
+	if len(us.sensitiveAttributes) == 0 {
+		return true, nil
+	}
+
+	allowed, svcErr := us.authzService.IsActionAllowed(ctx, security.ActionReadSensitiveUserAttributes,
+		&sysauthz.ActionContext{ResourceType: security.ResourceTypeUser, OUID: user.OUID, ResourceID: user.ID})
+	if svcErr != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error(ctx, "Failed to check authorization for sensitive user attributes",
+			log.Any("error", svcErr))
+		return false, &tidcommon.InternalServerError
+	}
+	return allowed, nil
+}
+
+// redactSensitiveAttributes removes the sensitive attributes from each user whose sensitive
+// attributes the caller may not read.
+func (us *userService) redactSensitiveAttributes(ctx context.Context, users ...*User) *tidcommon.ServiceError {
+	if len(us.sensitiveAttributes) == 0 {
+		return nil
+	}
+
+	for _, user := range users {
+		if len(user.Attributes) == 0 {
+			continue
+		}
+		allowed, svcErr := us.canReadSensitiveAttributes(ctx, user)
+		if svcErr != nil {
+			return svcErr
+		}
+		if allowed {
+			continue
+		}
+
+		redacted, err := removeAttributes(user.Attributes, us.sensitiveAttributes)
+		if err != nil {
+			logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+			return logErrorAndReturnServerError(ctx, logger, "Failed to redact sensitive user attributes", err,
+				log.MaskedString(log.LoggerKeyUserID, user.ID))
+		}
+		user.Attributes = redacted
+	}
+	return nil
+}
+
+// redactSensitiveAttributesInList removes the sensitive attributes from the users of a list.
+func (us *userService) redactSensitiveAttributesInList(ctx context.Context, users []User) *tidcommon.ServiceError {
+	for i := range users {
+		if svcErr := us.redactSensitiveAttributes(ctx, &users[i]); svcErr != nil {
+			return svcErr
+		}
+	}
+	return nil
+}
+
+// retainSensitiveAttributes returns the attributes to store when the caller replaces the attributes
+// of the existing user. A caller who may not read the sensitive attributes may not change them either:
+// the existing values replace whatever the caller sent.
+func (us *userService) retainSensitiveAttributes(ctx context.Context, existing *User,
+	attributes json.RawMessage) (json.RawMessage, *tidcommon.ServiceError) {
+	if len(us.sensitiveAttributes) == 0 {
+		return attributes, nil
+	}
+	allowed, svcErr := us.canReadSensitiveAttributes(ctx, existing)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if allowed {
+		return attributes, nil
+	}
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	incoming := map[string]json.RawMessage{}
+	if len(attributes) > 0 {
+		if err := json.Unmarshal(attributes, &incoming); err != nil || incoming == nil {
+			return nil, &ErrorInvalidRequestFormat
+		}
+	}
+	var stored map[string]json.RawMessage
+	if len(existing.Attributes) > 0 {
+		if err := json.Unmarshal(existing.Attributes, &stored); err != nil {
+			return nil, logErrorAndReturnServerError(ctx, logger, "Failed to parse stored user attributes", err,
+				log.MaskedString(log.LoggerKeyUserID, existing.ID))
+		}
+	}
+
+	changed := false
+	for _, name := range us.sensitiveAttributes {
+		value, isStored := stored[name]
+		_, isIncoming := incoming[name]
+		if !isStored && !isIncoming {
+			continue
+		}
+		if isStored {
+			incoming[name] = value
+		} else {
+			delete(incoming, name)
+		}
+		changed = true
+	}
+	if !changed {
+		return attributes, nil
+	}
+
+	merged, err := json.Marshal(incoming)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to marshal user attributes", err,
+			log.MaskedString(log.LoggerKeyUserID, existing.ID))
+	}
+	return merged, nil
+}
+
+// removeAttributes returns the JSON object of attributes without the named top-level attributes.
+func removeAttributes(attributes json.RawMessage, names []string) (json.RawMessage, error) {
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(attributes, &attrs); err != nil {
+		return nil, fmt.Errorf("failed to parse attributes: %w", err)
+	}
+
+	removed := false
+	for _, name := range names {
+		if _, ok := attrs[name]; ok {
+			delete(attrs, name)
+			removed = true
+		}
+	}
+	if !removed {
+		return attributes, nil
+	}
+	return json.Marshal(attrs)
+}
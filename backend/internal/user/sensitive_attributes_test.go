@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
+	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+var testSensitiveAttributes = []string{"nationalId", "salaryBand"}
+
+// newSensitiveAttributesAuthz returns a mock authorization service that allows all actions and
+// decides reads of sensitive user attributes with canReadSensitive.
+func newSensitiveAttributesAuthz(
+	t *testing.T, canReadSensitive bool,
+) *sysauthzmock.SystemAuthorizationServiceInterfaceMock {
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, security.ActionReadSensitiveUserAttributes, mock.Anything).
+		Return(canReadSensitive, nil).Maybe()
+	authzMock.On("IsActionAllowed", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	authzMock.On("GetAccessibleResources", mock.Anything, mock.Anything, mock.Anything).
+		Return(&sysauthz.AccessibleResources{AllAllowed: true}, nil).Maybe()
+	return authzMock
+}
+
+func TestGetUser_SensitiveAttributes(t *testing.T) {
+	testCases := []struct {
+		name             string
+		canReadSensitive bool
+		wantAttributes   string
+	}{
+		{
+			name:             "RedactedWithoutPermission",
+			canReadSensitive: false,
+			wantAttributes:   `{"email":"alice@example.com"}`,
+		},
+		{
+			name:             "ReturnedWithPermission",
+			canReadSensitive: true,
+			wantAttributes:   `{"email":"alice@example.com","nationalId":"123","salaryBand":"B2"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+			entityMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&providers.Entity{
+				Category: providers.EntityCategoryUser, ID: svcTestUserID1, OUID: testOrgID, Type: testUserType,
+				Attributes: json.RawMessage(`{"email":"alice@example.com","nationalId":"123","salaryBand":"B2"}`),
+			}, nil)
+			service := &userService{
+				entityService:       entityMock,
+				authzService:        newSensitiveAttributesAuthz(t, tc.canReadSensitive),
+				sensitiveAttributes: testSensitiveAttributes,
+			}
+
+			user, svcErr := service.GetUser(context.Background(), svcTestUserID1, false)
+
+			require.Nil(t, svcErr)
+			assert.JSONEq(t, tc.wantAttributes, string(user.Attributes))
+		})
+	}
+}
+
+func TestGetUserList_RedactsSensitiveAttributes(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntityListCount", mock.Anything, providers.EntityCategoryUser, mock.Anything).Return(2, nil)
+	entityMock.On("GetEntityList", mock.Anything, providers.EntityCategoryUser, 10, 0, mock.Anything).
+		Return([]providers.Entity{
+			{ID: "user-1", OUID: testOrgID, Attributes: json.RawMessage(`{"email":"a@x.com","nationalId":"1"}`)},
+			{ID: "user-2", OUID: testOrgID, Attributes: json.RawMessage(`{"email":"b@x.com"}`)},
+		}, nil)
+	service := &userService{
+		entityService:       entityMock,
+		authzService:        newSensitiveAttributesAuthz(t, false),
+		sensitiveAttributes: testSensitiveAttributes,
+	}
+
+	response, svcErr := service.GetUserList(context.Background(), 10, 0, nil, false)
+
+	require.Nil(t, svcErr)
+	require.Len(t, response.Users, 2)
+	assert.JSONEq(t, `{"email":"a@x.com"}`, string(response.Users[0].Attributes))
+	assert.JSONEq(t, `{"email":"b@x.com"}`, string(response.Users[1].Attributes))
+}
+
+func TestGetUser_SensitiveAttributesAuthzError(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&providers.Entity{
+		Category: providers.EntityCategoryUser, ID: svcTestUserID1, OUID: testOrgID,
+		Attributes: json.RawMessage(`{"nationalId":"123"}`),
+	}, nil)
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, security.ActionReadUser, mock.Anything).Return(true, nil)
+	authzMock.On("IsActionAllowed", mock.Anything, security.ActionReadSensitiveUserAttributes, mock.Anything).
+		Return(false, &tidcommon.InternalServerError)
+	service := &userService{
+		entityService:       entityMock,
+		authzService:        authzMock,
+		sensitiveAttributes: testSensitiveAttributes,
+	}
+
+	user, svcErr := service.GetUser(context.Background(), svcTestUserID1, false)
+
+	assert.Nil(t, user)
+	assert.Equal(t, &tidcommon.InternalServerError, svcErr)
+}
+
+func TestUpdateUser_RetainsSensitiveAttributesWithoutPermission(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("IsEntityDeclarative", mock.Anything, svcTestUserID1).Return(false, nil)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&providers.Entity{
+		Category: providers.EntityCategoryUser, ID: svcTestUserID1, OUID: testOrgID, Type: testUserType,
+		Attributes: json.RawMessage(`{"email":"old@x.com","nationalId":"123","salaryBand":"B2"}`),
+	}, nil)
+	var stored json.RawMessage
+	entityMock.On("UpdateEntity", mock.Anything, svcTestUserID1, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(2).(*providers.Entity).Attributes
+		}).
+		Return(func(_ context.Context, _ string, e *providers.Entity) *providers.Entity {
+			return &providers.Entity{ID: svcTestUserID1, Attributes: e.Attributes}
+		}, nil)
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).
+		Return(true, (*tidcommon.ServiceError)(nil))
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
+		Return(&entitytype.EntityType{OUID: testOrgID}, (*tidcommon.ServiceError)(nil))
+	entityTypeMock.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, false, false).
+		Return([]entitytype.AttributeInfo{}, (*tidcommon.ServiceError)(nil))
+	service := &userService{
+		entityService:       entityMock,
+		ouService:           ouServiceMock,
+		entityTypeService:   entityTypeMock,
+		authzService:        newSensitiveAttributesAuthz(t, false),
+		sensitiveAttributes: testSensitiveAttributes,
+	}
+
+	// The caller sends back the redacted user with a changed email and tries to clear the salary band.
+	user, svcErr := service.UpdateUser(context.Background(), svcTestUserID1, &User{
+		OUID: testOrgID, Type: testUserType,
+		Attributes: json.RawMessage(`{"email":"new@x.com","nationalId":"999"}`),
+	})
+
+	require.Nil(t, svcErr)
+	assert.JSONEq(t, `{"email":"new@x.com","nationalId":"123","salaryBand":"B2"}`, string(stored))
+	assert.JSONEq(t, `{"email":"new@x.com"}`, string(user.Attributes))
+}
+
+func TestRetainSensitiveAttributes(t *testing.T) {
+	existing := &User{ID: svcTestUserID1, OUID: testOrgID,
+		Attributes: json.RawMessage(`{"email":"a@x.com","nationalId":"123"}`)}
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		service := &userService{}
+		attributes, svcErr := service.retainSensitiveAttributes(context.Background(), existing,
+			json.RawMessage(`{"nationalId":"999"}`))
+		require.Nil(t, svcErr)
+		assert.JSONEq(t, `{"nationalId":"999"}`, string(attributes))
+	})
+
+	t.Run("WithPermission", func(t *testing.T) {
+		service := &userService{authzService: newSensitiveAttributesAuthz(t, true),
+			sensitiveAttributes: testSensitiveAttributes}
+		attributes, svcErr := service.retainSensitiveAttributes(context.Background(), existing,
+			json.RawMessage(`{"nationalId":"999"}`))
+		require.Nil(t, svcErr)
+		assert.JSONEq(t, `{"nationalId":"999"}`, string(attributes))
+	})
+
+	t.Run("EmptyAttributesWithoutPermission", func(t *testing.T) {
+		service := &userService{authzService: newSensitiveAttributesAuthz(t, false),
+			sensitiveAttributes: testSensitiveAttributes}
+		attributes, svcErr := service.retainSensitiveAttributes(context.Background(), existing, nil)
+		require.Nil(t, svcErr)
+		assert.JSONEq(t, `{"nationalId":"123"}`, string(attributes))
+	})
+
+	t.Run("InvalidAttributesWithoutPermission", func(t *testing.T) {
+		service := &userService{authzService: newSensitiveAttributesAuthz(t, false),
+			sensitiveAttributes: testSensitiveAttributes}
+		_, svcErr := service.retainSensitiveAttributes(context.Background(), existing, json.RawMessage(`[1]`))
+		assert.Equal(t, &ErrorInvalidRequestFormat, svcErr)
+	})
+}
+
+func TestRemoveAttributes(t *testing.T) {
+	attributes, err := removeAttributes(json.RawMessage(`{"a":1,"b":{"c":2}}`), []string{"b", "d"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":1}`, string(attributes))
+
+	unchanged := json.RawMessage(`{"a": 1}`)
+	attributes, err = removeAttributes(unchanged, []string{"b"})
+	require.NoError(t, err)
+	assert.Equal(t, unchanged, attributes)
+
+	_, err = removeAttributes(json.RawMessage(`not-json`), []string{"b"})
+	assert.Error(t, err)
+}
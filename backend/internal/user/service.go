@@ -26,16 +26,21 @@ import (
 	"fmt"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/job"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/outbox"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
@@ -51,6 +56,7 @@ type UserServiceInterface interface {
 	GetUsersByPath(ctx context.Context, handlePath string, limit, offset int,
 		filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *tidcommon.ServiceError)
 	CreateUser(ctx context.Context, user *User) (*User, *tidcommon.ServiceError)
+	CreateUsers(ctx context.Context, users []*User) (*job.Job, *tidcommon.ServiceError)
 	CreateUserByPath(ctx context.Context, handlePath string,
 		request CreateUserByPathRequest) (*User, *tidcommon.ServiceError)
 	GetUser(ctx context.Context, userID string, includeDisplay bool) (*User, *tidcommon.ServiceError)
@@ -62,10 +68,24 @@ type UserServiceInterface interface {
 	UpdateUserCredentials(ctx context.Context, userID string,
 		credentials json.RawMessage) *tidcommon.ServiceError
 	DeleteUser(ctx context.Context, userID string) *tidcommon.ServiceError
+	PurgeUser(ctx context.Context, userID string) *tidcommon.ServiceError
+	RestoreUser(ctx context.Context, userID string) (*User, *tidcommon.ServiceError)
+	ChangeUserState(ctx context.Context, userID string, action AccountStateAction) (*User, *tidcommon.ServiceError)
+	GetDeletedUserList(ctx context.Context, limit, offset int) (*UserListResponse, *tidcommon.ServiceError)
 	ResolveUserOUHandle(ctx context.Context, user *User) *tidcommon.ServiceError
 	SetDependencyRegistry(r resourcedependency.Registry)
 	GetUserUsages(ctx context.Context, userID string) (
 		*resourcedependency.DependenciesResponse, *tidcommon.ServiceError)
+	SetEffectiveAccessResolver(resolver EffectiveAccessResolver)
+	GetEffectiveAccess(ctx context.Context, userID string) (*EffectiveAccess, *tidcommon.ServiceError)
+	GetSelfUser(ctx context.Context, userID string, includeDisplay bool) (*User, *tidcommon.ServiceError)
+	UpdateSelfUser(ctx context.Context, userID string,
+		attributes json.RawMessage) (*User, *tidcommon.ServiceError)
+	GetSelfProfile(ctx context.Context, userID string) (*SelfProfile, *tidcommon.ServiceError)
+	PatchSelfProfile(ctx context.Context, userID string,
+		attributes json.RawMessage) (*SelfProfile, *tidcommon.ServiceError)
+	ChangeSelfPassword(ctx context.Context, userID string,
+		request ChangeSelfPasswordRequest) *tidcommon.ServiceError
 }
 
 // userService is the default implementation of the UserServiceInterface.
@@ -76,6 +96,13 @@ type userService struct {
 	entityTypeService  entitytype.EntityTypeServiceInterface
 	uuidGenerator      func() (string, error)
 	dependencyRegistry resourcedependency.Registry
+	accessResolver     EffectiveAccessResolver
+	softDelete         config.SoftDeleteConfig
+	observabilitySvc   providers.ObservabilityProvider
+	jobService         job.JobServiceInterface
+	outboxService      outbox.OutboxServiceInterface
+	// sensitiveAttributes are the user attributes returned only to callers allowed to read them.
+	sensitiveAttributes []string
 }
 
 // newUserService creates a new instance of userService with injected dependencies.
@@ -84,13 +111,23 @@ func newUserService(
 	entityService entity.EntityServiceInterface,
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
+	softDelete config.SoftDeleteConfig,
+	sensitiveAttributes []string,
+	observabilitySvc providers.ObservabilityProvider,
+	jobService job.JobServiceInterface,
+	outboxService outbox.OutboxServiceInterface,
 ) UserServiceInterface {
 	return &userService{
-		authzService:      authzService,
-		entityService:     entityService,
-		ouService:         ouService,
-		entityTypeService: entityTypeService,
-		uuidGenerator:     utils.GenerateUUIDv7,
+		authzService:        authzService,
+		entityService:       entityService,
+		ouService:           ouService,
+		entityTypeService:   entityTypeService,
+		uuidGenerator:       utils.GenerateUUIDv7,
+		softDelete:          softDelete,
+		sensitiveAttributes: sensitiveAttributes,
+		observabilitySvc:    observabilitySvc,
+		jobService:          jobService,
+		outboxService:       outboxService,
 	}
 }
 
@@ -137,6 +174,9 @@ func (us *userService) listAllUsers(
 	}
 
 	users := entitiesToUsers(entities)
+	if svcErr := us.redactSensitiveAttributesInList(ctx, users); svcErr != nil {
+		return nil, svcErr
+	}
 	if includeDisplay {
 		us.populateUserDisplayNames(ctx, users, logger)
 		us.populateOUHandles(ctx, users, logger)
@@ -168,6 +208,9 @@ func (us *userService) listUsersByOUIDs(
 	}
 
 	users := entitiesToUsers(entities)
+	if svcErr := us.redactSensitiveAttributesInList(ctx, users); svcErr != nil {
+		return nil, svcErr
+	}
 	if includeDisplay {
 		us.populateUserDisplayNames(ctx, users, logger)
 		us.populateOUHandles(ctx, users, logger)
@@ -307,6 +350,27 @@ func (us *userService) GetUsersByPath(
 	return response, nil
 }
 
+// CreateUsers submits a job that creates the given users one by one. Each user is created as if through
+// CreateUser, so a failure is reported as an item error identified by the position of the user in the
+// list and does not stop the remaining users.
+func (us *userService) CreateUsers(ctx context.Context, users []*User) (*job.Job, *tidcommon.ServiceError) {
+	if len(users) == 0 || len(users) > maxBulkCreateUsers {
+		return nil, &ErrorInvalidRequestFormat
+	}
+
+	return us.jobService.Submit(ctx, jobTypeBulkCreate, len(users),
+		func(ctx context.Context, tracker job.Tracker) *tidcommon.ServiceError {
+			for i := 0; i < len(users) && ctx.Err() == nil; i++ {
+				if _, svcErr := us.CreateUser(ctx, users[i]); svcErr != nil {
+					tracker.Failed(strconv.Itoa(i), svcErr)
+					continue
+				}
+				tracker.Succeeded()
+			}
+			return nil
+		})
+}
+
 // CreateUser creates the user.
 func (us *userService) CreateUser(ctx context.Context, user *User) (*User, *tidcommon.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
@@ -315,6 +379,10 @@ func (us *userService) CreateUser(ctx context.Context, user *User) (*User, *tidc
 		return nil, &ErrorInvalidRequestFormat
 	}
 
+	if svcErr := us.resolveOUIDFromHandle(ctx, user, logger); svcErr != nil {
+		return nil, svcErr
+	}
+
 	// Check if caller is authorized to create users in the target OU.
 	if svcErr := us.checkUserAccess(ctx, security.ActionCreateUser, user.OUID, ""); svcErr != nil {
 		return nil, svcErr
@@ -416,6 +484,9 @@ func (us *userService) GetUser(
 	if svcErr := us.checkUserAccess(ctx, security.ActionReadUser, user.OUID, userID); svcErr != nil {
 		return nil, svcErr
 	}
+	if svcErr := us.redactSensitiveAttributes(ctx, &user); svcErr != nil {
+		return nil, svcErr
+	}
 
 	if includeDisplay {
 		displayAttrPaths := ResolveDisplayAttributePaths(
@@ -579,7 +650,14 @@ func (us *userService) UpdateUser(
 		}
 	}
 
+	attributes, svcErr := us.retainSensitiveAttributes(ctx, &existingUser, user.Attributes)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	user.Attributes = attributes
+
 	e := userToEntity(user)
+	e.State = existingEntity.State
 	e.SystemAttributes = existingEntity.SystemAttributes
 	updated, err := us.entityService.UpdateEntity(ctx, userID, e)
 	if err != nil {
@@ -592,6 +670,10 @@ func (us *userService) UpdateUser(
 
 	// Sync cleaned attributes back — entity service removed credential fields from Attributes.
 	user.Attributes = updated.Attributes
+	user.State = updated.State
+	if svcErr := us.redactSensitiveAttributes(ctx, user); svcErr != nil {
+		return nil, svcErr
+	}
 	logger.Debug(ctx, "Successfully updated user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return user, nil
 }
@@ -665,6 +747,10 @@ func (us *userService) UpdateUserAttributes(
 		return nil, svcErr
 	}
 
+	attributes, svcErr = us.retainSensitiveAttributes(ctx, &existingUser, attributes)
+	if svcErr != nil {
+		return nil, svcErr
+	}
 	existingUser.Attributes = attributes
 
 	if err := us.entityService.UpdateAttributes(ctx, userID, attributes); err != nil {
@@ -675,6 +761,9 @@ func (us *userService) UpdateUserAttributes(
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
 
+	if svcErr := us.redactSensitiveAttributes(ctx, &existingUser); svcErr != nil {
+		return nil, svcErr
+	}
 	logger.Debug(ctx, "Successfully updated user attributes", log.MaskedString(log.LoggerKeyUserID, userID))
 	return &existingUser, nil
 }
@@ -774,7 +863,8 @@ func (us *userService) UpdateUserCredentials(
 	return nil
 }
 
-// DeleteUser delete the user for given user id.
+// DeleteUser delete the user for given user id. When soft deletion is enabled the user is only marked
+// as deleted and its dependents are kept so it can be restored within the retention window.
 func (us *userService) DeleteUser(ctx context.Context, userID string) *tidcommon.ServiceError {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 	logger.Debug(ctx, "Deleting user", log.MaskedString(log.LoggerKeyUserID, userID))
@@ -814,16 +904,90 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) *tidcommon
 		return svcErr
 	}
 
+	if us.softDelete.Enabled {
+		if err := us.entityService.SoftDeleteEntity(ctx, userID); err != nil {
+			if errors.Is(err, entity.ErrEntityNotFound) {
+				return &ErrorUserNotFound
+			}
+			return logErrorAndReturnServerError(ctx, logger, "Failed to soft-delete user", err,
+				log.MaskedString(log.LoggerKeyUserID, userID))
+		}
+		logger.Debug(ctx, "Successfully soft-deleted user", log.MaskedString(log.LoggerKeyUserID, userID))
+		return nil
+	}
+
+	if svcErr := us.deleteUserPermanently(ctx, userID, logger); svcErr != nil {
+		return svcErr
+	}
+
+	logger.Debug(ctx, "Successfully deleted user", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// PurgeUser permanently deletes a user, whether it is active or soft-deleted, together with the
+// dependents that must be removed with it.
+func (us *userService) PurgeUser(ctx context.Context, userID string) *tidcommon.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug(ctx, "Purging user", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+
+	isDeleted := false
+	existingEntity, err := us.entityService.GetEntity(ctx, userID)
+	if errors.Is(err, entity.ErrEntityNotFound) {
+		existingEntity, err = us.entityService.GetDeletedEntity(ctx, userID)
+		isDeleted = true
+	}
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return &ErrorUserNotFound
+		}
+		return logErrorAndReturnServerError(ctx, logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if existingEntity.Category != providers.EntityCategoryUser {
+		return &ErrorUserNotFound
+	}
+
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionDeleteUser, existingEntity.OUID, userID); svcErr != nil {
+		return svcErr
+	}
+
+	// Soft-deleted users are always mutable; declarative users can never be soft-deleted.
+	if !isDeleted {
+		if svcErr := us.checkUserDeclarative(ctx, userID, logger); svcErr != nil {
+			return svcErr
+		}
+	}
+
+	if svcErr := us.ensureNoBlockingDependencies(ctx, userID, logger); svcErr != nil {
+		return svcErr
+	}
+
+	if svcErr := us.deleteUserPermanently(ctx, userID, logger); svcErr != nil {
+		return svcErr
+	}
+
+	logger.Debug(ctx, "Successfully purged user", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// deleteUserPermanently removes the user's dependents and then the user entity itself.
+func (us *userService) deleteUserPermanently(
+	ctx context.Context, userID string, logger *log.Logger,
+) *tidcommon.ServiceError {
 	// Remove dependents that must be deleted with the user (e.g. role assignments). Run before the
 	// entity delete so a cleanup failure aborts the operation and leaves the user retriable. The
 	// registry is guaranteed non-nil here because ensureNoBlockingDependencies fails closed otherwise.
-	if _, err = us.dependencyRegistry.CascadeDelete(ctx, resourcedependency.ResourceTypeUser, userID); err != nil {
+	if _, err := us.dependencyRegistry.CascadeDelete(ctx, resourcedependency.ResourceTypeUser, userID); err != nil {
 		return logErrorAndReturnServerError(ctx, logger, "Failed to cascade-delete user dependencies", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
 
-	err = us.entityService.DeleteEntity(ctx, userID)
-	if err != nil {
+	if err := us.entityService.DeleteEntity(ctx, userID); err != nil {
 		if errors.Is(err, entity.ErrEntityNotFound) {
 			logger.Debug(ctx, "User not found", log.MaskedString(log.LoggerKeyUserID, userID))
 			return &ErrorUserNotFound
@@ -831,11 +995,108 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) *tidcommon
 		return logErrorAndReturnServerError(ctx, logger, "Failed to delete user", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
-
-	logger.Debug(ctx, "Successfully deleted user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return nil
 }
 
+// RestoreUser returns a soft-deleted user to the state it had before it was deleted. Restores are
+// only allowed within the configured retention window.
+func (us *userService) RestoreUser(ctx context.Context, userID string) (*User, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug(ctx, "Restoring user", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	deletedEntity, err := us.entityService.GetDeletedEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, &ErrorUserNotFound
+		}
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to retrieve deleted user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if deletedEntity.Category != providers.EntityCategoryUser {
+		return nil, &ErrorUserNotFound
+	}
+
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionDeleteUser, deletedEntity.OUID, userID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	retention := us.softDelete.Retention()
+	if deletedEntity.DeletedAt != nil && time.Since(*deletedEntity.DeletedAt) > retention {
+		return nil, &ErrorUserRestoreWindowExpired
+	}
+
+	if err := us.entityService.RestoreEntity(ctx, userID); err != nil {
+		if svcErr := mapEntityError(err); svcErr != nil {
+			return nil, svcErr
+		}
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to restore user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	restoredEntity, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to retrieve restored user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	restored := entityToUser(restoredEntity)
+	if svcErr := us.redactSensitiveAttributes(ctx, &restored); svcErr != nil {
+		return nil, svcErr
+	}
+
+	logger.Debug(ctx, "Successfully restored user", log.MaskedString(log.LoggerKeyUserID, userID))
+	return &restored, nil
+}
+
+// GetDeletedUserList retrieves a page of soft-deleted users. Listing deleted users spans all
+// organization units, so it is limited to callers allowed to list every user.
+func (us *userService) GetDeletedUserList(
+	ctx context.Context, limit, offset int,
+) (*UserListResponse, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if err := validatePaginationParams(limit, offset); err != nil {
+		return nil, err
+	}
+
+	accessible, svcErr := us.authzService.GetAccessibleResources(
+		ctx, security.ActionListUsers, security.ResourceTypeOU)
+	if svcErr != nil {
+		logger.Error(ctx, "Failed to resolve accessible resources for listing deleted users",
+			log.Any("error", svcErr))
+		return nil, &tidcommon.InternalServerError
+	}
+	if !accessible.AllAllowed {
+		return nil, &tidcommon.ErrorUnauthorized
+	}
+
+	totalCount, err := us.entityService.GetDeletedEntityListCount(ctx, providers.EntityCategoryUser)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to get deleted user count", err)
+	}
+
+	entities, err := us.entityService.GetDeletedEntityList(ctx, providers.EntityCategoryUser, limit, offset)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to get deleted user list", err)
+	}
+	users := entitiesToUsers(entities)
+	if svcErr := us.redactSensitiveAttributesInList(ctx, users); svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &UserListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(users),
+		Users:        users,
+		Links:        utils.BuildPaginationLinks("/users/deleted", limit, offset, totalCount, ""),
+	}, nil
+}
+
 // ensureNoBlockingDependencies refuses deletion when other resources depend on the user in a way
 // that forbids it (behaviorOnDelete == restrict), such as agents that list the user as their owner.
 // Because deletion is destructive, it fails closed: if dependency data cannot be determined, the
@@ -900,6 +1161,60 @@ func (us *userService) SetDependencyRegistry(r resourcedependency.Registry) {
 	us.dependencyRegistry = r
 }
 
+// SetEffectiveAccessResolver sets the resolver used to compute the effective access of users. It is
+// set after the role service is initialized.
+func (us *userService) SetEffectiveAccessResolver(resolver EffectiveAccessResolver) {
+	us.accessResolver = resolver
+}
+
+// GetEffectiveAccess returns the roles, permissions and resource server scopes the user holds through
+// direct role assignments and the groups the user belongs to, including groups inherited through
+// nested group membership.
+func (us *userService) GetEffectiveAccess(
+	ctx context.Context, userID string,
+) (*EffectiveAccess, *tidcommon.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	userEntity, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, &ErrorUserNotFound
+		}
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if userEntity.Category != providers.EntityCategoryUser {
+		return nil, &ErrorUserNotFound
+	}
+
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionReadUser, userEntity.OUID, userID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if us.accessResolver == nil {
+		logger.Error(ctx, "Effective access resolver not set")
+		return nil, &tidcommon.InternalServerError
+	}
+
+	groups, err := us.entityService.GetTransitiveEntityGroups(ctx, userID)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to get transitive groups of user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	access, err := us.accessResolver.ResolveEffectiveAccess(ctx, userID, groups)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(ctx, logger, "Failed to resolve effective access of user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	return access, nil
+}
+
 // GetUserUsages returns the resources that reference this user, such as agents that list the user
 // as their owner. It is informational — it drives the pre-delete confirmation dialog and does not
 // gate deletion on the server.
@@ -988,6 +1303,38 @@ func (us *userService) populateOUHandles(ctx context.Context, users []User, logg
 	}
 }
 
+// resolveOUIDFromHandle sets the user's OU ID from its OU handle path when no OU ID is given.
+// The lookup is made with the caller's context, so the caller must be able to read the OU.
+func (us *userService) resolveOUIDFromHandle(
+	ctx context.Context, user *User, logger *log.Logger,
+) *tidcommon.ServiceError {
+	if user.OUID != "" || user.OUHandle == "" {
+		return nil
+	}
+	if us.ouService == nil {
+		logger.Error(ctx, "Organization unit service is not configured for user operations")
+		return &tidcommon.InternalServerError
+	}
+
+	ou, svcErr := us.ouService.GetOrganizationUnitByPath(ctx, user.OUHandle)
+	if svcErr != nil {
+		return mapOUServiceError(ctx,
+			svcErr,
+			logger,
+			"resolving organization unit by handle",
+			map[string]*tidcommon.ServiceError{
+				oupkg.ErrorOrganizationUnitNotFound.Code: &ErrorOrganizationUnitNotFound,
+				oupkg.ErrorInvalidHandlePath.Code:        &ErrorInvalidHandlePath,
+				tidcommon.ErrorUnauthorized.Code:         &tidcommon.ErrorUnauthorized,
+			},
+			log.String("ouHandle", user.OUHandle),
+		)
+	}
+
+	user.OUID = ou.ID
+	return nil
+}
+
 // validateOrganizationUnitForUserType ensures that the organization unit ID is valid and belongs to the user type.
 func (us *userService) validateOrganizationUnitForUserType(
 	ctx context.Context, userType, oUID string, logger *log.Logger,
@@ -1133,6 +1480,8 @@ func mapEntityError(err error) *tidcommon.ServiceError {
 		return &ErrorAttributeConflict
 	case errors.Is(err, entity.ErrInvalidCredential):
 		return &ErrorInvalidCredential
+	case errors.Is(err, entity.ErrEntityNotActive):
+		return &ErrorUserNotActive
 	default:
 		return nil
 	}
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	tidcommon "github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 
@@ -31,7 +32,9 @@ import (
 
 	entitypkg "github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/job"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/resourcedependency"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -40,6 +43,7 @@ import (
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/jobmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
 )
@@ -446,6 +450,130 @@ func TestUserService_CreateUser_CallsCreateEntity(t *testing.T) {
 	storeMock.AssertNumberOfCalls(t, "CreateEntity", 1)
 }
 
+func TestUserService_CreateUser_ResolvesOUHandle(t *testing.T) {
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.On("GetOrganizationUnitByPath", mock.Anything, "engineering/emea").
+		Return(providers.OrganizationUnit{ID: testOrgID}, (*tidcommon.ServiceError)(nil)).
+		Once()
+	ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).
+		Return(true, (*tidcommon.ServiceError)(nil)).
+		Once()
+
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
+		Return(&entitytype.EntityType{OUID: testOrgID}, (*tidcommon.ServiceError)(nil)).
+		Once()
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	storeMock.
+		On("CreateEntity", mock.Anything, mock.MatchedBy(func(e *providers.Entity) bool {
+			return e.OUID == testOrgID
+		}), mock.Anything).
+		Return(&providers.Entity{
+			OUID: testOrgID, Type: testUserType,
+			Attributes: json.RawMessage(`{}`),
+		}, nil).
+		Once()
+
+	service := &userService{
+		entityService:     storeMock,
+		ouService:         ouServiceMock,
+		entityTypeService: entityTypeMock,
+		authzService:      newAllowAllAuthz(t),
+		uuidGenerator:     utils.GenerateUUIDv7,
+	}
+
+	created, err := service.CreateUser(context.Background(), &User{
+		Type:       testUserType,
+		OUHandle:   "engineering/emea",
+		Attributes: json.RawMessage(`{}`),
+	})
+	require.Nil(t, err)
+	require.Equal(t, testOrgID, created.OUID)
+}
+
+func TestUserService_CreateUser_OUHandleNotFound(t *testing.T) {
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.On("GetOrganizationUnitByPath", mock.Anything, "missing").
+		Return(providers.OrganizationUnit{}, &oupkg.ErrorOrganizationUnitNotFound).
+		Once()
+
+	service := &userService{ouService: ouServiceMock}
+
+	created, err := service.CreateUser(context.Background(), &User{Type: testUserType, OUHandle: "missing"})
+	require.Nil(t, created)
+	require.NotNil(t, err)
+	require.Equal(t, ErrorOrganizationUnitNotFound, *err)
+}
+
+// recordingTracker is a job.Tracker that keeps the reported outcomes in memory.
+type recordingTracker struct {
+	succeeded int
+	failed    map[string]string
+}
+
+func (t *recordingTracker) SetTotal(int) {}
+
+func (t *recordingTracker) Succeeded() { t.succeeded++ }
+
+func (t *recordingTracker) Failed(item string, svcErr *tidcommon.ServiceError) {
+	t.failed[item] = svcErr.Code
+}
+
+func TestUserService_CreateUsers_CreatesEachUserInJob(t *testing.T) {
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).
+		Return(true, (*tidcommon.ServiceError)(nil)).Once()
+
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
+		Return(&entitytype.EntityType{OUID: testOrgID}, (*tidcommon.ServiceError)(nil)).Once()
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	storeMock.On("CreateEntity", mock.Anything, mock.Anything, mock.Anything).
+		Return(&providers.Entity{OUID: testOrgID, Type: testUserType, Attributes: json.RawMessage(`{}`)}, nil).
+		Once()
+
+	var task job.Task
+	jobServiceMock := jobmock.NewJobServiceInterfaceMock(t)
+	jobServiceMock.On("Submit", mock.Anything, jobTypeBulkCreate, 2, mock.Anything).
+		Run(func(args mock.Arguments) { task = args.Get(3).(job.Task) }).
+		Return(&job.Job{ID: "job-1", Status: job.StatusPending, Total: 2}, nil).Once()
+
+	service := &userService{
+		entityService:     storeMock,
+		ouService:         ouServiceMock,
+		entityTypeService: entityTypeMock,
+		authzService:      newAllowAllAuthz(t),
+		uuidGenerator:     utils.GenerateUUIDv7,
+		jobService:        jobServiceMock,
+	}
+
+	submitted, svcErr := service.CreateUsers(context.Background(), []*User{
+		nil,
+		{Type: testUserType, OUID: testOrgID, Attributes: json.RawMessage(`{}`)},
+	})
+	require.Nil(t, svcErr)
+	require.Equal(t, "job-1", submitted.ID)
+
+	tracker := &recordingTracker{failed: map[string]string{}}
+	require.Nil(t, task(context.Background(), tracker))
+	require.Equal(t, 1, tracker.succeeded)
+	require.Equal(t, map[string]string{"0": ErrorInvalidRequestFormat.Code}, tracker.failed)
+}
+
+func TestUserService_CreateUsers_RejectsEmptyAndOversizedBatches(t *testing.T) {
+	service := &userService{jobService: jobmock.NewJobServiceInterfaceMock(t)}
+
+	_, svcErr := service.CreateUsers(context.Background(), nil)
+	require.Equal(t, &ErrorInvalidRequestFormat, svcErr)
+
+	_, svcErr = service.CreateUsers(context.Background(), make([]*User, maxBulkCreateUsers+1))
+	require.Equal(t, &ErrorInvalidRequestFormat, svcErr)
+}
+
 func TestUserService_CreateUser_UUIDGenerationError(t *testing.T) {
 	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
 	ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).
@@ -1853,7 +1981,7 @@ func TestUserService_GetUsersByPath_WithIncludeDisplay_BatchFetchError(t *testin
 }
 
 func TestNewFunctions(t *testing.T) {
-	svc := newUserService(nil, nil, nil, nil)
+	svc := newUserService(nil, nil, nil, nil, config.SoftDeleteConfig{}, nil, nil, nil, nil)
 	require.NotNil(t, svc)
 
 	handler := newUserHandler(svc)
@@ -3839,3 +3967,203 @@ func TestUserService_GetUserUsages_RegistryError(t *testing.T) {
 	require.Nil(t, result)
 	require.NotNil(t, err)
 }
+
+func newSoftDeleteUserRegistry() *stubUsageRegistry {
+	total := 0
+	return &stubUsageRegistry{resp: &resourcedependency.DependenciesResponse{
+		TotalResults: &total, Usages: []resourcedependency.ResourceDependency{},
+	}}
+}
+
+func TestUserService_DeleteUser_SoftDelete(t *testing.T) {
+	storeMock := newDeletableUserStore(t)
+	storeMock.On("SoftDeleteEntity", mock.Anything, svcTestUserID1).Return(nil).Once()
+
+	service := &userService{
+		entityService:      storeMock,
+		authzService:       newAllowAllAuthz(t),
+		dependencyRegistry: newSoftDeleteUserRegistry(),
+		softDelete:         config.SoftDeleteConfig{Enabled: true, RetentionDays: 30, PurgeIntervalMinutes: 60},
+	}
+
+	err := service.DeleteUser(context.Background(), svcTestUserID1)
+	require.Nil(t, err)
+	storeMock.AssertNotCalled(t, "DeleteEntity", mock.Anything, mock.Anything)
+}
+
+func TestUserService_PurgeUser_DeletedUser(t *testing.T) {
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return((*providers.Entity)(nil), entitypkg.ErrEntityNotFound).Once()
+	storeMock.On("GetDeletedEntity", mock.Anything, svcTestUserID1).
+		Return(&providers.Entity{
+			Category: providers.EntityCategoryUser, ID: svcTestUserID1, OUID: testOrgID,
+		}, nil).Once()
+	storeMock.On("DeleteEntity", mock.Anything, svcTestUserID1).Return(nil).Once()
+
+	service := &userService{
+		entityService:      storeMock,
+		authzService:       newAllowAllAuthz(t),
+		dependencyRegistry: newSoftDeleteUserRegistry(),
+	}
+
+	err := service.PurgeUser(context.Background(), svcTestUserID1)
+	require.Nil(t, err)
+	storeMock.AssertNotCalled(t, "IsEntityDeclarative", mock.Anything, mock.Anything)
+}
+
+func TestUserService_RestoreUser(t *testing.T) {
+	deletedAt := time.Now().Add(-time.Hour)
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetDeletedEntity", mock.Anything, svcTestUserID1).
+		Return(&providers.Entity{
+			Category: providers.EntityCategoryUser, ID: svcTestUserID1, OUID: testOrgID,
+			State: providers.EntityStateDeleted, DeletedAt: &deletedAt,
+		}, nil).Once()
+	storeMock.On("RestoreEntity", mock.Anything, svcTestUserID1).Return(nil).Once()
+	storeMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&providers.Entity{
+			Category: providers.EntityCategoryUser, ID: svcTestUserID1, OUID: testOrgID,
+			State: providers.EntityStateLocked,
+		}, nil).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  newAllowAllAuthz(t),
+		softDelete:    config.SoftDeleteConfig{Enabled: true, RetentionDays: 30, PurgeIntervalMinutes: 60},
+	}
+
+	restored, err := service.RestoreUser(context.Background(), svcTestUserID1)
+	require.Nil(t, err)
+	require.Equal(t, svcTestUserID1, restored.ID)
+	require.Equal(t, providers.EntityStateLocked, restored.State)
+	require.Nil(t, restored.DeletedAt)
+}
+
+func TestUserService_RestoreUser_WindowExpired(t *testing.T) {
+	deletedAt := time.Now().Add(-48 * time.Hour)
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetDeletedEntity", mock.Anything, svcTestUserID1).
+		Return(&providers.Entity{
+			Category: providers.EntityCategoryUser, ID: svcTestUserID1, OUID: testOrgID, DeletedAt: &deletedAt,
+		}, nil).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  newAllowAllAuthz(t),
+		softDelete:    config.SoftDeleteConfig{Enabled: true, RetentionDays: 1, PurgeIntervalMinutes: 60},
+	}
+
+	_, err := service.RestoreUser(context.Background(), svcTestUserID1)
+	require.NotNil(t, err)
+	require.Equal(t, ErrorUserRestoreWindowExpired.Code, err.Code)
+	storeMock.AssertNotCalled(t, "RestoreEntity", mock.Anything, mock.Anything)
+}
+
+func TestUserService_RestoreUser_NotFound(t *testing.T) {
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetDeletedEntity", mock.Anything, svcTestUserID1).
+		Return((*providers.Entity)(nil), entitypkg.ErrEntityNotFound).Once()
+
+	service := &userService{entityService: storeMock, authzService: newAllowAllAuthz(t)}
+
+	_, err := service.RestoreUser(context.Background(), svcTestUserID1)
+	require.NotNil(t, err)
+	require.Equal(t, ErrorUserNotFound.Code, err.Code)
+}
+
+// --- GetEffectiveAccess tests ---
+
+func TestUserService_GetEffectiveAccess_MissingID(t *testing.T) {
+	service := &userService{}
+
+	result, err := service.GetEffectiveAccess(context.Background(), "")
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, ErrorMissingUserID.Code, err.Code)
+}
+
+func TestUserService_GetEffectiveAccess_WrongCategory(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&providers.Entity{ID: svcTestUserID1, Category: providers.EntityCategoryAgent}, nil).Once()
+
+	service := &userService{entityService: entityMock}
+
+	result, err := service.GetEffectiveAccess(context.Background(), svcTestUserID1)
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, ErrorUserNotFound.Code, err.Code)
+}
+
+func TestUserService_GetEffectiveAccess_Unauthorized(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, security.ActionReadUser, mock.Anything).
+		Return(false, nil).Once()
+
+	service := &userService{entityService: entityMock, authzService: authzMock}
+
+	result, err := service.GetEffectiveAccess(context.Background(), svcTestUserID1)
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, tidcommon.ErrorUnauthorized.Code, err.Code)
+}
+
+func TestUserService_GetEffectiveAccess_ResolverNotSet(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+
+	service := &userService{entityService: entityMock, authzService: newAllowAllAuthz(t)}
+
+	result, err := service.GetEffectiveAccess(context.Background(), svcTestUserID1)
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, tidcommon.InternalServerError.Code, err.Code)
+}
+
+func TestUserService_GetEffectiveAccess_Success(t *testing.T) {
+	groups := []providers.EntityGroup{{ID: "g1", Name: "Engineering"}}
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	entityMock.On("GetTransitiveEntityGroups", mock.Anything, svcTestUserID1).Return(groups, nil).Once()
+	resolverMock := NewEffectiveAccessResolverMock(t)
+	resolverMock.On("ResolveEffectiveAccess", mock.Anything, svcTestUserID1, groups).
+		Return(&EffectiveAccess{
+			Groups:      groups,
+			Roles:       []EffectiveRole{{ID: "r1", Name: "Editor", ViaGroups: []string{"g1"}}},
+			Permissions: []string{"read"},
+		}, nil).Once()
+
+	service := &userService{entityService: entityMock, authzService: newAllowAllAuthz(t)}
+	service.SetEffectiveAccessResolver(resolverMock)
+
+	result, err := service.GetEffectiveAccess(context.Background(), svcTestUserID1)
+	require.Nil(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Roles, 1)
+	require.Equal(t, []string{"read"}, result.Permissions)
+}
+
+func TestUserService_GetEffectiveAccess_ResolverError(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(newUserForUsages(svcTestUserID1), nil).Once()
+	entityMock.On("GetTransitiveEntityGroups", mock.Anything, svcTestUserID1).
+		Return([]providers.EntityGroup{}, nil).Once()
+	resolverMock := NewEffectiveAccessResolverMock(t)
+	resolverMock.On("ResolveEffectiveAccess", mock.Anything, svcTestUserID1, []providers.EntityGroup{}).
+		Return(nil, errors.New("db error")).Once()
+
+	service := &userService{entityService: entityMock, authzService: newAllowAllAuthz(t),
+		accessResolver: resolverMock}
+
+	result, err := service.GetEffectiveAccess(context.Background(), svcTestUserID1)
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, tidcommon.InternalServerError.Code, err.Code)
+}
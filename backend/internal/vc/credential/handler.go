@@ -44,6 +44,9 @@ func newConfigurationHandler(service CredentialConfigurationServiceInterface) *c
 func (h *configurationHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
 	req, err := sysutils.DecodeJSONBody[credentialConfigurationRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		writeConfigurationError(r.Context(), w, &ErrorConfigurationInvalidRequest)
 		return
 	}
@@ -89,6 +92,9 @@ func (h *configurationHandler) HandleUpdate(w http.ResponseWriter, r *http.Reque
 	}
 	req, err := sysutils.DecodeJSONBody[credentialConfigurationRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		writeConfigurationError(r.Context(), w, &ErrorConfigurationInvalidRequest)
 		return
 	}
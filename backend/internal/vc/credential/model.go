@@ -58,13 +58,13 @@ type CredentialConfigurationDTO struct {
 
 // credentialConfigurationRequest is the API request body for create/update.
 type credentialConfigurationRequest struct {
-	Handle          string             `json:"handle"`
+	Handle          string             `json:"handle" native:"max=255"`
 	OUID            string             `json:"ouId"`
 	OUHandle        string             `json:"ouHandle"`
-	Name            string             `json:"name"`
-	Description     string             `json:"description"`
-	Format          string             `json:"format"`
-	VCT             string             `json:"vct"`
+	Name            string             `json:"name" native:"max=255"`
+	Description     string             `json:"description" native:"max=255"`
+	Format          string             `json:"format" native:"max=64"`
+	VCT             string             `json:"vct" native:"max=512"`
 	Claims          []ClaimMapping     `json:"claims"`
 	Display         *CredentialDisplay `json:"display"`
 	ValiditySeconds *int               `json:"validitySeconds"`
@@ -44,6 +44,9 @@ func newDefinitionHandler(service PresentationDefinitionServiceInterface) *defin
 func (h *definitionHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
 	req, err := sysutils.DecodeJSONBody[presentationDefinitionRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		writeDefinitionError(r.Context(), w, &ErrorDefinitionInvalidRequest)
 		return
 	}
@@ -89,6 +92,9 @@ func (h *definitionHandler) HandleUpdate(w http.ResponseWriter, r *http.Request)
 	}
 	req, err := sysutils.DecodeJSONBody[presentationDefinitionRequest](r)
 	if err != nil {
+		if sysutils.WriteValidationErrorResponse(w, err) {
+			return
+		}
 		writeDefinitionError(r.Context(), w, &ErrorDefinitionInvalidRequest)
 		return
 	}
@@ -54,13 +54,13 @@ type PresentationDefinitionDTO struct {
 
 // presentationDefinitionRequest is the API request body for create/update.
 type presentationDefinitionRequest struct {
-	Handle               string              `json:"handle"`
+	Handle               string              `json:"handle" native:"max=255"`
 	OUID                 string              `json:"ouId"`
 	OUHandle             string              `json:"ouHandle"`
-	Name                 string              `json:"name"`
-	Description          string              `json:"description"`
-	VCT                  string              `json:"vct"`
-	Format               string              `json:"format"`
+	Name                 string              `json:"name" native:"max=255"`
+	Description          string              `json:"description" native:"max=255"`
+	VCT                  string              `json:"vct" native:"max=512"`
+	Format               string              `json:"format" native:"max=64"`
 	RequestedClaims      []string            `json:"requestedClaims"`
 	MandatoryClaims      []string            `json:"mandatoryClaims"`
 	OptionalClaims       []string            `json:"optionalClaims"`
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// InboundAuthTypeOAuth2 is the inbound authentication type of OAuth 2.0 and OpenID Connect clients.
+const InboundAuthTypeOAuth2 = "oauth2"
+
+// OAuthConfig is the OAuth 2.0 configuration of an application. ClientSecret is returned only when
+// the server issues a new secret. Token, UserInfo and Certificate are passed through unchanged.
+type OAuthConfig struct {
+	ClientID                           string              `json:"clientId,omitempty"`
+	ClientSecret                       string              `json:"clientSecret,omitempty"`
+	RedirectURIs                       []string            `json:"redirectUris,omitempty"`
+	RedirectURIMatching                string              `json:"redirectUriMatching,omitempty"`
+	ApplicationType                    string              `json:"applicationType,omitempty"`
+	GrantTypes                         []string            `json:"grantTypes,omitempty"`
+	ResponseTypes                      []string            `json:"responseTypes,omitempty"`
+	TokenEndpointAuthMethod            string              `json:"tokenEndpointAuthMethod,omitempty"`
+	PKCERequired                       bool                `json:"pkceRequired"`
+	PublicClient                       bool                `json:"publicClient"`
+	RequirePushedAuthorizationRequests bool                `json:"requirePushedAuthorizationRequests"`
+	DPoPBoundAccessTokens              bool                `json:"dpopBoundAccessTokens"`
+	IncludeActClaim                    bool                `json:"includeActClaim"`
+	EnforceEssentialClaims             bool                `json:"enforceEssentialClaims"`
+	Scopes                             []string            `json:"scopes,omitempty"`
+	ScopeClaims                        map[string][]string `json:"scopeClaims,omitempty"`
+	AcrValues                          []string            `json:"acrValues,omitempty"`
+	AllowedOrigins                     []string            `json:"allowedOrigins,omitempty"`
+	AllowedNetworks                    []string            `json:"allowedNetworks,omitempty"`
+	Token                              json.RawMessage     `json:"token,omitempty"`
+	UserInfo                           json.RawMessage     `json:"userInfo,omitempty"`
+	Certificate                        json.RawMessage     `json:"certificate,omitempty"`
+}
+
+// InboundAuthConfig is an inbound authentication configuration of an application.
+type InboundAuthConfig struct {
+	Type   string       `json:"type"`
+	Config *OAuthConfig `json:"config,omitempty"`
+}
+
+// Application is an application as returned by the application management API. Listed applications
+// carry ClientID but no inbound authentication configuration.
+type Application struct {
+	ID                        string              `json:"id"`
+	OUID                      string              `json:"ouId,omitempty"`
+	Name                      string              `json:"name"`
+	Description               string              `json:"description,omitempty"`
+	ClientID                  string              `json:"clientId,omitempty"`
+	Template                  string              `json:"template,omitempty"`
+	URL                       string              `json:"url,omitempty"`
+	LogoURL                   string              `json:"logoUrl,omitempty"`
+	AuthFlowID                string              `json:"authFlowId,omitempty"`
+	RegistrationFlowID        string              `json:"registrationFlowId,omitempty"`
+	IsRegistrationFlowEnabled bool                `json:"isRegistrationFlowEnabled"`
+	RecoveryFlowID            string              `json:"recoveryFlowId,omitempty"`
+	IsRecoveryFlowEnabled     bool                `json:"isRecoveryFlowEnabled"`
+	ThemeID                   string              `json:"themeId,omitempty"`
+	LayoutID                  string              `json:"layoutId,omitempty"`
+	AllowedUserTypes          []string            `json:"allowedUserTypes,omitempty"`
+	InboundAuthConfig         []InboundAuthConfig `json:"inboundAuthConfig,omitempty"`
+	Metadata                  map[string]any      `json:"metadata,omitempty"`
+	IsReadOnly                bool                `json:"isReadOnly,omitempty"`
+}
+
+// OAuthConfig returns the OAuth 2.0 configuration of the application, or nil when it has none.
+func (a *Application) OAuthConfig() *OAuthConfig {
+	for _, inbound := range a.InboundAuthConfig {
+		if inbound.Type == InboundAuthTypeOAuth2 {
+			return inbound.Config
+		}
+	}
+	return nil
+}
+
+// ApplicationRequest is the body of a create or update application request.
+type ApplicationRequest struct {
+	OUID                      string              `json:"ouId,omitempty"`
+	Name                      string              `json:"name"`
+	Description               string              `json:"description"`
+	Template                  string              `json:"template,omitempty"`
+	URL                       string              `json:"url,omitempty"`
+	LogoURL                   string              `json:"logoUrl,omitempty"`
+	AuthFlowID                string              `json:"authFlowId,omitempty"`
+	RegistrationFlowID        string              `json:"registrationFlowId,omitempty"`
+	IsRegistrationFlowEnabled bool                `json:"isRegistrationFlowEnabled"`
+	RecoveryFlowID            string              `json:"recoveryFlowId,omitempty"`
+	IsRecoveryFlowEnabled     bool                `json:"isRecoveryFlowEnabled"`
+	ThemeID                   string              `json:"themeId,omitempty"`
+	LayoutID                  string              `json:"layoutId,omitempty"`
+	AllowedUserTypes          []string            `json:"allowedUserTypes,omitempty"`
+	InboundAuthConfig         []InboundAuthConfig `json:"inboundAuthConfig,omitempty"`
+	Metadata                  map[string]any      `json:"metadata,omitempty"`
+}
+
+// ApplicationList is a list of applications.
+type ApplicationList struct {
+	TotalResults int           `json:"totalResults"`
+	Count        int           `json:"count"`
+	Applications []Application `json:"applications"`
+}
+
+// ApplicationsService is the client for the application management API.
+type ApplicationsService struct {
+	client *Client
+}
+
+// List returns the applications.
+func (s *ApplicationsService) List(ctx context.Context) (*ApplicationList, error) {
+	var list ApplicationList
+	if err := s.client.do(ctx, request{method: http.MethodGet, path: "/applications"}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Get returns the application with the ID.
+func (s *ApplicationsService) Get(ctx context.Context, id string) (*Application, error) {
+	return s.send(ctx, http.MethodGet, "/applications/"+id, nil)
+}
+
+// Create creates an application. The returned application carries the client secret when the server
+// generated one.
+func (s *ApplicationsService) Create(ctx context.Context, req ApplicationRequest) (*Application, error) {
+	return s.send(ctx, http.MethodPost, "/applications", req)
+}
+
+// Update replaces the application with the ID.
+func (s *ApplicationsService) Update(ctx context.Context, id string, req ApplicationRequest) (*Application, error) {
+	return s.send(ctx, http.MethodPut, "/applications/"+id, req)
+}
+
+// Delete deletes the application with the ID.
+func (s *ApplicationsService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, request{method: http.MethodDelete, path: "/applications/" + id}, nil)
+}
+
+// send sends a request that returns an application.
+func (s *ApplicationsService) send(ctx context.Context, method, path string, body any) (*Application, error) {
+	var app Application
+	if err := s.client.do(ctx, request{method: method, path: path, body: body}, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
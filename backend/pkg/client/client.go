@@ -0,0 +1,279 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package client is a Go client for the ThunderID management APIs. It covers users, groups,
+// organization units, applications and flows, and provides OAuth 2.0 token helpers, so integration
+// tests and automation do not need to build HTTP requests by hand.
+//
+// A client is created with New and exposes one service per API:
+//
+//	c, err := client.New("https://localhost:8090",
+//		client.WithClientCredentials(client.ClientCredentials{ClientID: "my-client", ClientSecret: "my-secret"},
+//			"system"))
+//	user, err := c.Users.Get(ctx, userID)
+//
+// Idempotent requests are retried on network errors and on 429, 502, 503 and 504 responses according
+// to the configured RetryPolicy. Non-idempotent requests are retried only on 429, which the server
+// returns before processing a request.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client is a client for the ThunderID management APIs. It is safe for concurrent use.
+type Client struct {
+	baseURL     *url.URL
+	httpClient  *http.Client
+	tokenSource TokenSource
+	retry       RetryPolicy
+	// clientCredentials, when set, are used to obtain the bearer token from the server itself.
+	clientCredentials *clientCredentialsOption
+	sleep             func(ctx context.Context, d time.Duration) error
+
+	// Users is the client for the user management API.
+	Users *UsersService
+	// Groups is the client for the group management API.
+	Groups *GroupsService
+	// OrganizationUnits is the client for the organization unit management API.
+	OrganizationUnits *OrganizationUnitsService
+	// Applications is the client for the application management API.
+	Applications *ApplicationsService
+	// Flows is the client for the flow management API.
+	Flows *FlowsService
+	// OAuth is the client for the OAuth 2.0 token endpoint.
+	OAuth *OAuthService
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests. The default is http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTokenSource sets the source of the bearer token sent with management API requests. Requests
+// are sent without an Authorization header when no token source is set.
+func WithTokenSource(tokenSource TokenSource) Option {
+	return func(c *Client) {
+		c.tokenSource = tokenSource
+	}
+}
+
+// WithAccessToken sends a fixed bearer token with management API requests.
+func WithAccessToken(token string) Option {
+	return WithTokenSource(StaticTokenSource(token))
+}
+
+// WithClientCredentials obtains the bearer token for management API requests from the server's token
+// endpoint with the client_credentials grant. Tokens are cached until shortly before they expire.
+func WithClientCredentials(creds ClientCredentials, scopes ...string) Option {
+	return func(c *Client) {
+		c.clientCredentials = &clientCredentialsOption{creds: creds, scopes: scopes}
+	}
+}
+
+// clientCredentialsOption holds the arguments of WithClientCredentials.
+type clientCredentialsOption struct {
+	creds  ClientCredentials
+	scopes []string
+}
+
+// WithRetryPolicy sets the retry policy. The default is DefaultRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// New creates a client for the server at baseURL, for example https://localhost:8090.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	parsed, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, errors.New("invalid base URL: scheme and host are required")
+	}
+
+	c := &Client{
+		baseURL:    parsed,
+		httpClient: http.DefaultClient,
+		retry:      DefaultRetryPolicy,
+		sleep:      sleepContext,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.Users = &UsersService{client: c}
+	c.Groups = &GroupsService{client: c}
+	c.OrganizationUnits = &OrganizationUnitsService{client: c}
+	c.Applications = &ApplicationsService{client: c}
+	c.Flows = &FlowsService{client: c}
+	c.OAuth = &OAuthService{client: c}
+	if c.clientCredentials != nil {
+		c.tokenSource = c.OAuth.TokenSource(c.clientCredentials.creds, c.clientCredentials.scopes...)
+	}
+	return c, nil
+}
+
+// request describes one API call.
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	// body is encoded as JSON unless form is set.
+	body any
+	// form is sent as application/x-www-form-urlencoded.
+	form url.Values
+	// header holds additional request headers.
+	header http.Header
+	// anonymous requests are sent without the bearer token.
+	anonymous bool
+}
+
+// do sends the request, retrying it according to the retry policy, and decodes a successful JSON
+// response into out when out is not nil.
+func (c *Client) do(ctx context.Context, req request, out any) error {
+	payload, contentType, err := encodeBody(req)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, req, payload, contentType)
+		if err != nil {
+			if ctx.Err() != nil || !c.shouldRetryError(req.method, attempt) {
+				return err
+			}
+			if err := c.sleep(ctx, c.retry.backoff(attempt, "")); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if c.shouldRetryStatus(req.method, resp.StatusCode, attempt) {
+			retryAfter := resp.Header.Get("Retry-After")
+			drainAndClose(resp)
+			if err := c.sleep(ctx, c.retry.backoff(attempt, retryAfter)); err != nil {
+				return err
+			}
+			continue
+		}
+		return decodeResponse(resp, out)
+	}
+}
+
+// send builds and sends one attempt of the request.
+func (c *Client) send(ctx context.Context, req request, payload []byte,
+	contentType string) (*http.Response, error) {
+	endpoint := c.baseURL.JoinPath(req.path)
+	if len(req.query) > 0 {
+		endpoint.RawQuery = req.query.Encode()
+	}
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, endpoint.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	for key, values := range req.header {
+		for _, value := range values {
+			httpReq.Header.Add(key, value)
+		}
+	}
+	if !req.anonymous && c.tokenSource != nil {
+		token, err := c.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to obtain access token: %w", err)
+		}
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return c.httpClient.Do(httpReq)
+}
+
+// encodeBody encodes the request body and returns it with its content type.
+func encodeBody(req request) ([]byte, string, error) {
+	switch {
+	case req.form != nil:
+		return []byte(req.form.Encode()), "application/x-www-form-urlencoded", nil
+	case req.body != nil:
+		payload, err := json.Marshal(req.body)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to encode request body: %w", err)
+		}
+		return payload, "application/json", nil
+	default:
+		return nil, "", nil
+	}
+}
+
+// decodeResponse converts an error status into an *APIError and decodes a successful response.
+func decodeResponse(resp *http.Response, out any) error {
+	defer drainAndClose(resp)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newAPIError(resp)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return nil
+}
+
+// drainAndClose discards the rest of the response body so the connection can be reused.
+func drainAndClose(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	_ = resp.Body.Close()
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
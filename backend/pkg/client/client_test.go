@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ClientTestSuite struct {
+	suite.Suite
+	sleeps []time.Duration
+}
+
+func TestClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
+
+func (suite *ClientTestSuite) SetupTest() {
+	suite.sleeps = nil
+}
+
+// newClient creates a client for the server that records backoff waits instead of sleeping.
+func (suite *ClientTestSuite) newClient(server *httptest.Server, opts ...Option) *Client {
+	c, err := New(server.URL, opts...)
+	suite.Require().NoError(err)
+	c.sleep = func(_ context.Context, d time.Duration) error {
+		suite.sleeps = append(suite.sleeps, d)
+		return nil
+	}
+	return c
+}
+
+func (suite *ClientTestSuite) TestNew_InvalidBaseURL() {
+	for _, baseURL := range []string{"", "localhost:8090", "://bad"} {
+		_, err := New(baseURL)
+		suite.Error(err, baseURL)
+	}
+}
+
+func (suite *ClientTestSuite) TestDo_SendsBearerTokenAndJSON() {
+	var gotAuth, gotContentType string
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotContentType = r.Header.Get("Content-Type")
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"u1","ouId":"ou1","type":"person"}`))
+	}))
+	defer server.Close()
+
+	c := suite.newClient(server, WithAccessToken("tok"))
+	user, err := c.Users.Create(context.Background(), UserRequest{
+		OUID: "ou1", Type: "person", Attributes: map[string]any{"username": "alice"},
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal("u1", user.ID)
+	suite.Equal("Bearer tok", gotAuth)
+	suite.Equal("application/json", gotContentType)
+	suite.Equal(map[string]any{"username": "alice"}, gotBody["attributes"])
+}
+
+func (suite *ClientTestSuite) TestDo_DecodesManagementError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"type":"urn:thunderid:error:USR-1003","title":"User not found","status":404,` +
+			`"code":"USR-1003","message":{"key":"k","defaultValue":"User not found"},` +
+			`"description":{"key":"d","defaultValue":"No user with the ID"}}`))
+	}))
+	defer server.Close()
+
+	_, err := suite.newClient(server).Users.Get(context.Background(), "missing")
+
+	var apiErr *APIError
+	suite.Require().True(errors.As(err, &apiErr))
+	suite.Equal(http.StatusNotFound, apiErr.StatusCode)
+	suite.Equal("urn:thunderid:error:USR-1003", apiErr.Type)
+	suite.Equal("USR-1003", apiErr.Code)
+	suite.Equal("User not found", apiErr.Message.DefaultValue)
+	suite.Equal("No user with the ID", apiErr.Description.DefaultValue)
+	suite.True(IsNotFound(err))
+	suite.False(IsConflict(err))
+	suite.Contains(err.Error(), "USR-1003")
+}
+
+func (suite *ClientTestSuite) TestDo_DecodesPlainStringMessages() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"code":"GRP-1004","message":"Conflict","description":"Name in use"}`))
+	}))
+	defer server.Close()
+
+	_, err := suite.newClient(server).Groups.Create(context.Background(), GroupRequest{Name: "g"})
+
+	var apiErr *APIError
+	suite.Require().True(errors.As(err, &apiErr))
+	suite.Equal("Name in use", apiErr.Description.DefaultValue)
+	suite.True(IsConflict(err))
+}
+
+func (suite *ClientTestSuite) TestDo_RetriesIdempotentRequestOnServiceUnavailable() {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"g1","name":"admins"}`))
+	}))
+	defer server.Close()
+
+	group, err := suite.newClient(server).Groups.Get(context.Background(), "g1")
+
+	suite.Require().NoError(err)
+	suite.Equal("admins", group.Name)
+	suite.Equal(int32(3), calls.Load())
+	suite.Equal([]time.Duration{200 * time.Millisecond, 400 * time.Millisecond}, suite.sleeps)
+}
+
+func (suite *ClientTestSuite) TestDo_DoesNotRetryPostOnServiceUnavailable() {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := suite.newClient(server).Groups.Create(context.Background(), GroupRequest{Name: "g"})
+
+	suite.Error(err)
+	suite.Equal(int32(1), calls.Load())
+}
+
+func (suite *ClientTestSuite) TestDo_RetriesPostOnTooManyRequestsAndResendsBody() {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"g1"}`))
+	}))
+	defer server.Close()
+
+	_, err := suite.newClient(server).Groups.Create(context.Background(), GroupRequest{Name: "g"})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(bodies, 2)
+	suite.Equal(bodies[0], bodies[1])
+	suite.Equal([]time.Duration{time.Second}, suite.sleeps)
+}
+
+func (suite *ClientTestSuite) TestDo_StopsAfterMaxAttempts() {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := suite.newClient(server, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+	err := c.Users.Delete(context.Background(), "u1")
+
+	var apiErr *APIError
+	suite.Require().True(errors.As(err, &apiErr))
+	suite.Equal(http.StatusBadGateway, apiErr.StatusCode)
+	suite.Equal(int32(2), calls.Load())
+}
+
+func (suite *ClientTestSuite) TestDo_NoRetry() {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := suite.newClient(server, WithRetryPolicy(NoRetry)).Users.Delete(context.Background(), "u1")
+
+	suite.Error(err)
+	suite.Equal(int32(1), calls.Load())
+}
+
+func (suite *ClientTestSuite) TestDo_TokenSourceError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Fail("request must not be sent")
+	}))
+	defer server.Close()
+
+	failing := tokenSourceFunc(func(context.Context) (string, error) { return "", errors.New("no token") })
+	_, err := suite.newClient(server, WithTokenSource(failing)).Users.Get(context.Background(), "u1")
+
+	suite.ErrorContains(err, "no token")
+}
+
+func (suite *ClientTestSuite) TestBackoff() {
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second, MaxBackoff: 3 * time.Second}
+
+	suite.Equal(time.Second, policy.backoff(1, ""))
+	suite.Equal(2*time.Second, policy.backoff(2, ""))
+	suite.Equal(3*time.Second, policy.backoff(3, ""))
+	suite.Equal(0*time.Second, policy.backoff(1, "0"))
+	suite.Equal(3*time.Second, policy.backoff(1, "60"))
+	suite.Equal(time.Second, policy.backoff(1, "Wed, 21 Oct 2015 07:28:00 GMT"))
+}
+
+// tokenSourceFunc adapts a function to TokenSource.
+type tokenSourceFunc func(ctx context.Context) (string, error)
+
+func (f tokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// I18nMessage is a message with a translation key and an English default.
+type I18nMessage struct {
+	Key          string `json:"key"`
+	DefaultValue string `json:"defaultValue"`
+}
+
+// APIError is returned for a response with a non-2xx status. Management APIs fill Code, Message and
+// Description, and Type when they return RFC 9457 problem details; the OAuth token endpoint fills
+// OAuthError and OAuthErrorDescription.
+type APIError struct {
+	StatusCode            int
+	Type                  string
+	Code                  string
+	Message               I18nMessage
+	Description           I18nMessage
+	OAuthError            string
+	OAuthErrorDescription string
+	// Body is the raw response body, kept for responses that match neither error format.
+	Body []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	switch {
+	case e.Code != "":
+		return fmt.Sprintf("thunderid: %d %s: %s", e.StatusCode, e.Code, e.Description.DefaultValue)
+	case e.OAuthError != "":
+		return fmt.Sprintf("thunderid: %d %s: %s", e.StatusCode, e.OAuthError, e.OAuthErrorDescription)
+	default:
+		return fmt.Sprintf("thunderid: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+}
+
+// IsNotFound reports whether err is an *APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an *APIError with status 409.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// hasStatus reports whether err is an *APIError with the status.
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// newAPIError builds an *APIError from an error response.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return apiErr
+	}
+	apiErr.Body = body
+
+	var payload struct {
+		Type             string          `json:"type"`
+		Code             string          `json:"code"`
+		Message          json.RawMessage `json:"message"`
+		Description      json.RawMessage `json:"description"`
+		Error            string          `json:"error"`
+		ErrorDescription string          `json:"error_description"`
+	}
+	if json.Unmarshal(body, &payload) != nil {
+		return apiErr
+	}
+	apiErr.Type = payload.Type
+	apiErr.Code = payload.Code
+	apiErr.Message = decodeMessage(payload.Message)
+	apiErr.Description = decodeMessage(payload.Description)
+	apiErr.OAuthError = payload.Error
+	apiErr.OAuthErrorDescription = payload.ErrorDescription
+	return apiErr
+}
+
+// decodeMessage decodes an i18n message object, accepting a plain string as the default value.
+func decodeMessage(raw json.RawMessage) I18nMessage {
+	var message I18nMessage
+	if json.Unmarshal(raw, &message) == nil {
+		return message
+	}
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		message.DefaultValue = text
+	}
+	return message
+}
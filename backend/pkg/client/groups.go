@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Group member types.
+const (
+	MemberTypeUser  = "user"
+	MemberTypeGroup = "group"
+	MemberTypeApp   = "app"
+	MemberTypeAgent = "agent"
+)
+
+// Member is a member of a group.
+type Member struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Display string `json:"display,omitempty"`
+}
+
+// Group is a group as returned by the group management API.
+type Group struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	OUID        string   `json:"ouId"`
+	OUHandle    string   `json:"ouHandle,omitempty"`
+	Members     []Member `json:"members,omitempty"`
+	IsReadOnly  bool     `json:"isReadOnly"`
+}
+
+// GroupRequest is the body of a create or update group request.
+type GroupRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	OUID        string   `json:"ouId"`
+	Members     []Member `json:"members,omitempty"`
+}
+
+// GroupList is a page of groups. Listed groups do not include members.
+type GroupList struct {
+	TotalResults int     `json:"totalResults"`
+	StartIndex   int     `json:"startIndex"`
+	Count        int     `json:"count"`
+	Groups       []Group `json:"groups"`
+	Links        []Link  `json:"links"`
+}
+
+// MemberList is a page of group members.
+type MemberList struct {
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	Count        int      `json:"count"`
+	Members      []Member `json:"members"`
+	Links        []Link   `json:"links"`
+}
+
+// GroupsService is the client for the group management API.
+type GroupsService struct {
+	client *Client
+}
+
+// List returns a page of groups.
+func (s *GroupsService) List(ctx context.Context, opts *ListOptions) (*GroupList, error) {
+	var list GroupList
+	err := s.client.do(ctx, request{method: http.MethodGet, path: "/groups", query: opts.query()}, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Get returns the group with the ID, including its direct members.
+func (s *GroupsService) Get(ctx context.Context, id string) (*Group, error) {
+	return s.send(ctx, http.MethodGet, "/groups/"+id, nil)
+}
+
+// Create creates a group.
+func (s *GroupsService) Create(ctx context.Context, req GroupRequest) (*Group, error) {
+	return s.send(ctx, http.MethodPost, "/groups", req)
+}
+
+// Update replaces the group with the ID, including its members.
+func (s *GroupsService) Update(ctx context.Context, id string, req GroupRequest) (*Group, error) {
+	return s.send(ctx, http.MethodPut, "/groups/"+id, req)
+}
+
+// Delete deletes the group with the ID.
+func (s *GroupsService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, request{method: http.MethodDelete, path: "/groups/" + id}, nil)
+}
+
+// ListMembers returns a page of the direct members of the group with the ID.
+func (s *GroupsService) ListMembers(ctx context.Context, id string, opts *ListOptions) (*MemberList, error) {
+	var list MemberList
+	req := request{method: http.MethodGet, path: "/groups/" + id + "/members", query: opts.query()}
+	if err := s.client.do(ctx, req, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// AddMembers adds members to the group with the ID and returns the updated group.
+func (s *GroupsService) AddMembers(ctx context.Context, id string, members ...Member) (*Group, error) {
+	return s.send(ctx, http.MethodPost, "/groups/"+id+"/members/add", memberChange{Members: members})
+}
+
+// RemoveMembers removes members from the group with the ID and returns the updated group.
+func (s *GroupsService) RemoveMembers(ctx context.Context, id string, members ...Member) (*Group, error) {
+	return s.send(ctx, http.MethodPost, "/groups/"+id+"/members/remove", memberChange{Members: members})
+}
+
+// memberChange is the body of an add or remove members request.
+type memberChange struct {
+	Members []Member `json:"members"`
+}
+
+// send sends a request that returns a group.
+func (s *GroupsService) send(ctx context.Context, method, path string, body any) (*Group, error) {
+	var group Group
+	if err := s.client.do(ctx, request{method: method, path: path, body: body}, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
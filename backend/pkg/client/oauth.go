@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin is how long before expiry a cached token is replaced.
+const tokenRefreshMargin = 30 * time.Second
+
+// TokenSource supplies the bearer token sent with management API requests.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticTokenSource returns a TokenSource that always supplies the token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+// staticTokenSource is a TokenSource with a fixed token.
+type staticTokenSource string
+
+// Token implements TokenSource.
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// Token is a token endpoint response.
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+}
+
+// ClientCredentials authenticates a confidential client with client_secret_basic.
+type ClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// OAuthService is the client for the OAuth 2.0 token endpoint. Token requests are authenticated with
+// the client credentials, never with the client's token source.
+type OAuthService struct {
+	client *Client
+}
+
+// ClientCredentialsToken requests a token with the client_credentials grant. Scopes are optional.
+func (s *OAuthService) ClientCredentialsToken(ctx context.Context, creds ClientCredentials,
+	scopes ...string) (*Token, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+	return s.token(ctx, creds, form)
+}
+
+// RefreshToken exchanges a refresh token for a new token.
+func (s *OAuthService) RefreshToken(ctx context.Context, creds ClientCredentials,
+	refreshToken string) (*Token, error) {
+	form := url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}}
+	return s.token(ctx, creds, form)
+}
+
+// ExchangeCode exchanges an authorization code for a token. codeVerifier is required when the
+// authorization request used PKCE.
+func (s *OAuthService) ExchangeCode(ctx context.Context, creds ClientCredentials, code, redirectURI,
+	codeVerifier string) (*Token, error) {
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {redirectURI},
+	}
+	if codeVerifier != "" {
+		form.Set("code_verifier", codeVerifier)
+	}
+	return s.token(ctx, creds, form)
+}
+
+// token sends a token request. Public clients send client_id in the form instead of authenticating.
+func (s *OAuthService) token(ctx context.Context, creds ClientCredentials, form url.Values) (*Token, error) {
+	header := http.Header{}
+	if creds.ClientSecret != "" {
+		header.Set("Authorization", "Basic "+basicAuth(creds.ClientID, creds.ClientSecret))
+	} else {
+		form.Set("client_id", creds.ClientID)
+	}
+
+	var token Token
+	req := request{method: http.MethodPost, path: "/oauth2/token", form: form, header: header, anonymous: true}
+	if err := s.client.do(ctx, req, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// basicAuth encodes client credentials for the Basic scheme, form-encoding them first as RFC 6749
+// section 2.3.1 requires.
+func basicAuth(clientID, clientSecret string) string {
+	req := &http.Request{Header: http.Header{}}
+	req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	return strings.TrimPrefix(req.Header.Get("Authorization"), "Basic ")
+}
+
+// TokenSource returns a TokenSource that obtains tokens with the client_credentials grant and caches
+// each token until shortly before it expires.
+func (s *OAuthService) TokenSource(creds ClientCredentials, scopes ...string) TokenSource {
+	return &cachingTokenSource{
+		fetch: func(ctx context.Context) (*Token, error) {
+			return s.ClientCredentialsToken(ctx, creds, scopes...)
+		},
+		now: time.Now,
+	}
+}
+
+// cachingTokenSource caches the token returned by fetch until shortly before it expires.
+type cachingTokenSource struct {
+	fetch func(ctx context.Context) (*Token, error)
+	now   func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// Token implements TokenSource.
+func (s *cachingTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Before(s.expiresAt) {
+		return s.token, nil
+	}
+	token, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token.AccessToken
+	s.expiresAt = s.now().Add(time.Duration(token.ExpiresIn)*time.Second - tokenRefreshMargin)
+	return s.token, nil
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OAuthTestSuite struct {
+	suite.Suite
+}
+
+func TestOAuthTestSuite(t *testing.T) {
+	suite.Run(t, new(OAuthTestSuite))
+}
+
+func (suite *OAuthTestSuite) TestClientCredentialsToken() {
+	var form url.Values
+	var user, pass string
+	var hasBasic bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/oauth2/token", r.URL.Path)
+		suite.Equal("application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		user, pass, hasBasic = r.BasicAuth()
+		suite.Require().NoError(r.ParseForm())
+		form = r.PostForm
+		_, _ = w.Write([]byte(`{"access_token":"at","token_type":"Bearer","expires_in":3600,"scope":"system"}`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, WithAccessToken("must-not-be-sent"))
+	suite.Require().NoError(err)
+	token, err := c.OAuth.ClientCredentialsToken(context.Background(),
+		ClientCredentials{ClientID: "my client", ClientSecret: "s:ecret"}, "system", "openid")
+
+	suite.Require().NoError(err)
+	suite.Equal("at", token.AccessToken)
+	suite.Equal(3600, token.ExpiresIn)
+	suite.True(hasBasic)
+	suite.Equal("my+client", user)
+	suite.Equal("s%3Aecret", pass)
+	suite.Equal("client_credentials", form.Get("grant_type"))
+	suite.Equal("system openid", form.Get("scope"))
+	suite.Empty(form.Get("client_id"))
+}
+
+func (suite *OAuthTestSuite) TestExchangeCode_PublicClient() {
+	var form url.Values
+	var hasAuth bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hasAuth = r.Header.Get("Authorization") != ""
+		suite.Require().NoError(r.ParseForm())
+		form = r.PostForm
+		_, _ = w.Write([]byte(`{"access_token":"at","id_token":"idt","refresh_token":"rt"}`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL)
+	suite.Require().NoError(err)
+	token, err := c.OAuth.ExchangeCode(context.Background(), ClientCredentials{ClientID: "spa"},
+		"code1", "https://app/cb", "verifier")
+
+	suite.Require().NoError(err)
+	suite.Equal("idt", token.IDToken)
+	suite.False(hasAuth)
+	suite.Equal("spa", form.Get("client_id"))
+	suite.Equal("authorization_code", form.Get("grant_type"))
+	suite.Equal("verifier", form.Get("code_verifier"))
+}
+
+func (suite *OAuthTestSuite) TestRefreshToken_DecodesOAuthError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Refresh token is expired"}`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL)
+	suite.Require().NoError(err)
+	_, err = c.OAuth.RefreshToken(context.Background(), ClientCredentials{ClientID: "c", ClientSecret: "s"}, "rt")
+
+	var apiErr *APIError
+	suite.Require().True(errors.As(err, &apiErr))
+	suite.Equal("invalid_grant", apiErr.OAuthError)
+	suite.Equal("Refresh token is expired", apiErr.OAuthErrorDescription)
+	suite.Contains(err.Error(), "invalid_grant")
+}
+
+func (suite *OAuthTestSuite) TestWithClientCredentials_CachesToken() {
+	var tokenCalls atomic.Int32
+	var gotAuth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/token" {
+			tokenCalls.Add(1)
+			_, _ = w.Write([]byte(`{"access_token":"at","expires_in":3600}`))
+			return
+		}
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"id":"u1"}`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, WithClientCredentials(ClientCredentials{ClientID: "c", ClientSecret: "s"}, "system"))
+	suite.Require().NoError(err)
+	for range 2 {
+		_, err = c.Users.Get(context.Background(), "u1")
+		suite.Require().NoError(err)
+	}
+
+	suite.Equal(int32(1), tokenCalls.Load())
+	suite.Equal([]string{"Bearer at", "Bearer at"}, gotAuth)
+}
+
+func (suite *OAuthTestSuite) TestCachingTokenSource_RefreshesBeforeExpiry() {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var fetches int
+	source := &cachingTokenSource{
+		fetch: func(context.Context) (*Token, error) {
+			fetches++
+			return &Token{AccessToken: "at" + string(rune('0'+fetches)), ExpiresIn: 60}, nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	token, err := source.Token(context.Background())
+	suite.Require().NoError(err)
+	suite.Equal("at1", token)
+
+	now = now.Add(29 * time.Second)
+	token, _ = source.Token(context.Background())
+	suite.Equal("at1", token)
+
+	now = now.Add(time.Second)
+	token, _ = source.Token(context.Background())
+	suite.Equal("at2", token)
+}
+
+func (suite *OAuthTestSuite) TestCachingTokenSource_FetchError() {
+	source := &cachingTokenSource{
+		fetch: func(context.Context) (*Token, error) { return nil, errors.New("unavailable") },
+		now:   time.Now,
+	}
+
+	_, err := source.Token(context.Background())
+
+	suite.ErrorContains(err, "unavailable")
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// OrganizationUnit is an organization unit as returned by the organization unit management API.
+type OrganizationUnit struct {
+	ID          string    `json:"id"`
+	Handle      string    `json:"handle"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Parent      *string   `json:"parent"`
+	ThemeID     string    `json:"themeId,omitempty"`
+	LayoutID    string    `json:"layoutId,omitempty"`
+	LogoURL     string    `json:"logoUrl,omitempty"`
+	IsReadOnly  bool      `json:"isReadOnly,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// OrganizationUnitRequest is the body of a create or update organization unit request. A nil Parent
+// creates a root organization unit.
+type OrganizationUnitRequest struct {
+	Handle      string  `json:"handle"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Parent      *string `json:"parent"`
+	ThemeID     string  `json:"themeId,omitempty"`
+	LayoutID    string  `json:"layoutId,omitempty"`
+	LogoURL     string  `json:"logoUrl,omitempty"`
+}
+
+// OrganizationUnitList is a page of organization units. Listed units do not include the parent,
+// theme or layout.
+type OrganizationUnitList struct {
+	TotalResults      int                `json:"totalResults"`
+	StartIndex        int                `json:"startIndex"`
+	Count             int                `json:"count"`
+	OrganizationUnits []OrganizationUnit `json:"organizationUnits"`
+	Links             []Link             `json:"links"`
+}
+
+// OrganizationUnitsService is the client for the organization unit management API.
+type OrganizationUnitsService struct {
+	client *Client
+}
+
+// List returns a page of root organization units.
+func (s *OrganizationUnitsService) List(ctx context.Context, opts *ListOptions) (*OrganizationUnitList, error) {
+	return s.list(ctx, "/organization-units", opts)
+}
+
+// ListChildren returns a page of the child organization units of the unit with the ID.
+func (s *OrganizationUnitsService) ListChildren(ctx context.Context, id string,
+	opts *ListOptions) (*OrganizationUnitList, error) {
+	return s.list(ctx, "/organization-units/"+id+"/ous", opts)
+}
+
+// Get returns the organization unit with the ID.
+func (s *OrganizationUnitsService) Get(ctx context.Context, id string) (*OrganizationUnit, error) {
+	return s.send(ctx, http.MethodGet, "/organization-units/"+id, nil)
+}
+
+// GetByPath returns the organization unit at a handle path such as "engineering/platform".
+func (s *OrganizationUnitsService) GetByPath(ctx context.Context, path string) (*OrganizationUnit, error) {
+	return s.send(ctx, http.MethodGet, "/organization-units/tree/"+path, nil)
+}
+
+// Create creates an organization unit.
+func (s *OrganizationUnitsService) Create(ctx context.Context,
+	req OrganizationUnitRequest) (*OrganizationUnit, error) {
+	return s.send(ctx, http.MethodPost, "/organization-units", req)
+}
+
+// Update replaces the organization unit with the ID.
+func (s *OrganizationUnitsService) Update(ctx context.Context, id string,
+	req OrganizationUnitRequest) (*OrganizationUnit, error) {
+	return s.send(ctx, http.MethodPut, "/organization-units/"+id, req)
+}
+
+// Delete deletes the organization unit with the ID.
+func (s *OrganizationUnitsService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, request{method: http.MethodDelete, path: "/organization-units/" + id}, nil)
+}
+
+// list sends a request that returns a page of organization units.
+func (s *OrganizationUnitsService) list(ctx context.Context, path string,
+	opts *ListOptions) (*OrganizationUnitList, error) {
+	var list OrganizationUnitList
+	if err := s.client.do(ctx, request{method: http.MethodGet, path: path, query: opts.query()}, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// send sends a request that returns an organization unit.
+func (s *OrganizationUnitsService) send(ctx context.Context, method, path string,
+	body any) (*OrganizationUnit, error) {
+	var ou OrganizationUnit
+	if err := s.client.do(ctx, request{method: method, path: path, body: body}, &ou); err != nil {
+		return nil, err
+	}
+	return &ou, nil
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// recordedRequest is a request received by the test server.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+}
+
+type ResourcesTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	client   *Client
+	requests []recordedRequest
+	response string
+}
+
+func TestResourcesTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourcesTestSuite))
+}
+
+func (suite *ResourcesTestSuite) SetupTest() {
+	suite.requests = nil
+	suite.response = `{}`
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		suite.requests = append(suite.requests, recordedRequest{
+			Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Body: string(body),
+		})
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(suite.response))
+	}))
+	var err error
+	suite.client, err = New(suite.server.URL + "/")
+	suite.Require().NoError(err)
+}
+
+func (suite *ResourcesTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+// lastRequest returns the last request received by the test server.
+func (suite *ResourcesTestSuite) lastRequest() recordedRequest {
+	suite.Require().NotEmpty(suite.requests)
+	return suite.requests[len(suite.requests)-1]
+}
+
+func (suite *ResourcesTestSuite) TestUsers() {
+	ctx := context.Background()
+	suite.response = `{"totalResults":1,"startIndex":1,"count":1,` +
+		`"users":[{"id":"u1","attributes":{"username":"alice"}}],"links":[{"href":"/users?offset=1","rel":"next"}]}`
+
+	list, err := suite.client.Users.List(ctx, &ListOptions{Limit: 10, Offset: 5, Filter: `username eq "alice"`})
+
+	suite.Require().NoError(err)
+	suite.Equal("/users", suite.lastRequest().Path)
+	suite.Equal("filter=username+eq+%22alice%22&limit=10&offset=5", suite.lastRequest().Query)
+	suite.Equal("u1", list.Users[0].ID)
+	suite.JSONEq(`{"username":"alice"}`, string(list.Users[0].Attributes))
+	suite.Equal("next", list.Links[0].Rel)
+
+	_, err = suite.client.Users.Update(ctx, "u1", UserRequest{OUID: "ou1", Type: "person"})
+	suite.Require().NoError(err)
+	suite.Equal(recordedRequest{Method: http.MethodPut, Path: "/users/u1", Body: `{"ouId":"ou1","type":"person"}`},
+		suite.lastRequest())
+
+	suite.Require().NoError(suite.client.Users.Delete(ctx, "u1"))
+	suite.Equal(http.MethodDelete, suite.lastRequest().Method)
+}
+
+func (suite *ResourcesTestSuite) TestGroups() {
+	ctx := context.Background()
+	suite.response = `{"id":"g1","name":"admins","members":[{"id":"u1","type":"user"}]}`
+
+	group, err := suite.client.Groups.AddMembers(ctx, "g1", Member{ID: "u1", Type: MemberTypeUser})
+
+	suite.Require().NoError(err)
+	suite.Equal("/groups/g1/members/add", suite.lastRequest().Path)
+	suite.JSONEq(`{"members":[{"id":"u1","type":"user"}]}`, suite.lastRequest().Body)
+	suite.Equal([]Member{{ID: "u1", Type: MemberTypeUser}}, group.Members)
+
+	_, err = suite.client.Groups.RemoveMembers(ctx, "g1", Member{ID: "u1", Type: MemberTypeUser})
+	suite.Require().NoError(err)
+	suite.Equal("/groups/g1/members/remove", suite.lastRequest().Path)
+
+	suite.response = `{"totalResults":1,"members":[{"id":"u1","type":"user","display":"alice"}]}`
+	members, err := suite.client.Groups.ListMembers(ctx, "g1", nil)
+	suite.Require().NoError(err)
+	suite.Equal("/groups/g1/members", suite.lastRequest().Path)
+	suite.Empty(suite.lastRequest().Query)
+	suite.Equal("alice", members.Members[0].Display)
+}
+
+func (suite *ResourcesTestSuite) TestOrganizationUnits() {
+	ctx := context.Background()
+	suite.response = `{"id":"ou2","handle":"platform","name":"Platform","parent":"ou1",` +
+		`"createdAt":"2026-01-02T03:04:05Z"}`
+
+	ou, err := suite.client.OrganizationUnits.GetByPath(ctx, "engineering/platform")
+
+	suite.Require().NoError(err)
+	suite.Equal("/organization-units/tree/engineering/platform", suite.lastRequest().Path)
+	suite.Require().NotNil(ou.Parent)
+	suite.Equal("ou1", *ou.Parent)
+	suite.Equal(2026, ou.CreatedAt.Year())
+
+	_, err = suite.client.OrganizationUnits.Create(ctx, OrganizationUnitRequest{Handle: "root", Name: "Root"})
+	suite.Require().NoError(err)
+	suite.JSONEq(`{"handle":"root","name":"Root","parent":null}`, suite.lastRequest().Body)
+
+	suite.response = `{"totalResults":1,"organizationUnits":[{"id":"ou2","handle":"platform"}]}`
+	children, err := suite.client.OrganizationUnits.ListChildren(ctx, "ou1", &ListOptions{Limit: 5})
+	suite.Require().NoError(err)
+	suite.Equal("/organization-units/ou1/ous", suite.lastRequest().Path)
+	suite.Equal("ou2", children.OrganizationUnits[0].ID)
+}
+
+func (suite *ResourcesTestSuite) TestApplications() {
+	ctx := context.Background()
+	suite.response = `{"id":"a1","name":"app","inboundAuthConfig":[{"type":"oauth2",` +
+		`"config":{"clientId":"cid","clientSecret":"secret","grantTypes":["client_credentials"],` +
+		`"token":{"accessToken":{"validityPeriod":3600}}}}]}`
+
+	app, err := suite.client.Applications.Create(ctx, ApplicationRequest{
+		Name: "app",
+		InboundAuthConfig: []InboundAuthConfig{{
+			Type:   InboundAuthTypeOAuth2,
+			Config: &OAuthConfig{GrantTypes: []string{"client_credentials"}},
+		}},
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal(http.MethodPost, suite.lastRequest().Method)
+	var sent ApplicationRequest
+	suite.Require().NoError(json.Unmarshal([]byte(suite.lastRequest().Body), &sent))
+	suite.Equal(InboundAuthTypeOAuth2, sent.InboundAuthConfig[0].Type)
+	suite.Require().NotNil(app.OAuthConfig())
+	suite.Equal("secret", app.OAuthConfig().ClientSecret)
+	suite.JSONEq(`{"accessToken":{"validityPeriod":3600}}`, string(app.OAuthConfig().Token))
+
+	suite.response = `{"totalResults":1,"count":1,"applications":[{"id":"a1","name":"app","clientId":"cid"}]}`
+	list, err := suite.client.Applications.List(ctx)
+	suite.Require().NoError(err)
+	suite.Equal("cid", list.Applications[0].ClientID)
+	suite.Nil(list.Applications[0].OAuthConfig())
+}
+
+func (suite *ResourcesTestSuite) TestFlows() {
+	ctx := context.Background()
+	suite.response = `{"totalResults":1,"flows":[{"id":"f1","handle":"basic","flowType":"AUTHENTICATION"}]}`
+
+	list, err := suite.client.Flows.List(ctx, FlowTypeAuthentication, &ListOptions{Limit: 1})
+
+	suite.Require().NoError(err)
+	suite.Equal("flowType=AUTHENTICATION&limit=1", suite.lastRequest().Query)
+	suite.Equal("f1", list.Flows[0].ID)
+
+	suite.response = `{"id":"f1","handle":"basic","nodes":[{"id":"start","type":"START"}]}`
+	flow, err := suite.client.Flows.Update(ctx, "f1", FlowRequest{
+		Handle: "basic", Name: "Basic", FlowType: FlowTypeAuthentication,
+		Nodes: []any{map[string]any{"id": "start", "type": "START"}},
+	})
+	suite.Require().NoError(err)
+	suite.Equal("/flows/f1", suite.lastRequest().Path)
+	suite.Require().Len(flow.Nodes, 1)
+	suite.JSONEq(`{"id":"start","type":"START"}`, string(flow.Nodes[0]))
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry. It doubles on every further retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts, including a wait requested through Retry-After.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy makes up to three attempts, waiting 200 ms and then 400 ms between them.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// NoRetry disables retries.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// shouldRetryError reports whether a request that failed without a response is retried. Only
+// idempotent requests are retried, since the server may have processed the failed attempt.
+func (c *Client) shouldRetryError(method string, attempt int) bool {
+	return attempt < c.retry.MaxAttempts && isIdempotent(method)
+}
+
+// shouldRetryStatus reports whether a response status is retried. 429 is retried for every method
+// because the server rejects the request before processing it.
+func (c *Client) shouldRetryStatus(method string, status, attempt int) bool {
+	if attempt >= c.retry.MaxAttempts {
+		return false
+	}
+	switch status {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait before the next attempt. A Retry-After value in seconds takes precedence
+// over the exponential backoff.
+func (p RetryPolicy) backoff(attempt int, retryAfter string) time.Duration {
+	wait := time.Duration(float64(p.InitialBackoff) * math.Pow(2, float64(attempt-1)))
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		wait = time.Duration(seconds) * time.Second
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// isIdempotent reports whether repeating a request with the method has the same effect as sending it
+// once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// User is a user as returned by the user management API.
+type User struct {
+	ID         string          `json:"id"`
+	OUID       string          `json:"ouId"`
+	OUHandle   string          `json:"ouHandle,omitempty"`
+	Type       string          `json:"type"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+	Display    string          `json:"display,omitempty"`
+	IsReadOnly bool            `json:"isReadOnly"`
+}
+
+// UserRequest is the body of a create or update user request. Attributes is encoded as a JSON object
+// and may be any value that encodes to one, such as a map or a struct.
+type UserRequest struct {
+	OUID       string   `json:"ouId"`
+	Type       string   `json:"type"`
+	Groups     []string `json:"groups,omitempty"`
+	Attributes any      `json:"attributes,omitempty"`
+}
+
+// UserList is a page of users.
+type UserList struct {
+	TotalResults int    `json:"totalResults"`
+	StartIndex   int    `json:"startIndex"`
+	Count        int    `json:"count"`
+	Users        []User `json:"users"`
+	Links        []Link `json:"links"`
+}
+
+// UsersService is the client for the user management API.
+type UsersService struct {
+	client *Client
+}
+
+// List returns a page of users. Filter supports expressions such as `username eq "alice"`.
+func (s *UsersService) List(ctx context.Context, opts *ListOptions) (*UserList, error) {
+	var list UserList
+	err := s.client.do(ctx, request{method: http.MethodGet, path: "/users", query: opts.query()}, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Get returns the user with the ID.
+func (s *UsersService) Get(ctx context.Context, id string) (*User, error) {
+	return s.send(ctx, http.MethodGet, "/users/"+id, nil)
+}
+
+// Create creates a user.
+func (s *UsersService) Create(ctx context.Context, req UserRequest) (*User, error) {
+	return s.send(ctx, http.MethodPost, "/users", req)
+}
+
+// Update replaces the user with the ID.
+func (s *UsersService) Update(ctx context.Context, id string, req UserRequest) (*User, error) {
+	return s.send(ctx, http.MethodPut, "/users/"+id, req)
+}
+
+// Delete deletes the user with the ID.
+func (s *UsersService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, request{method: http.MethodDelete, path: "/users/" + id}, nil)
+}
+
+// send sends a request that returns a user.
+func (s *UsersService) send(ctx context.Context, method, path string, body any) (*User, error) {
+	var user User
+	if err := s.client.do(ctx, request{method: method, path: path, body: body}, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -123,14 +123,113 @@ type RedisConfig struct {
 	WriteTimeoutMS    int    `yaml:"write_timeout_ms"     json:"write_timeout_ms"`
 }
 
+// MaintenanceConfig holds the operational switches used during planned maintenance.
+//
+// ReadOnly rejects every state-changing request (any method other than GET, HEAD, and OPTIONS) so
+// the databases can be put under maintenance without a full outage. Endpoints that only read state
+// over POST (introspection, userinfo, access evaluation) keep working, and AllowTokenIssuance
+// additionally keeps the login and token endpoints open so existing applications can still sign
+// users in. AllowedPaths lists further path patterns exempt from read-only mode.
+//
+// DisabledEndpoints lists individual endpoints to switch off regardless of ReadOnly. Each entry is
+// a path pattern optionally prefixed with an HTTP method (for example "POST /users" or
+// "/oauth2/par"). Path patterns use the same glob syntax as the public path list: "*" matches one
+// path segment and "**" matches zero or more segments.
+//
+// Rejected requests receive 503 Service Unavailable with a Retry-After header of
+// RetryAfterSeconds; zero omits the header.
+type MaintenanceConfig struct {
+	ReadOnly           bool     `yaml:"read_only"            json:"read_only"`
+	AllowTokenIssuance bool     `yaml:"allow_token_issuance" json:"allow_token_issuance"`
+	AllowedPaths       []string `yaml:"allowed_paths"        json:"allowed_paths"`
+	DisabledEndpoints  []string `yaml:"disabled_endpoints"   json:"disabled_endpoints"`
+	RetryAfterSeconds  int      `yaml:"retry_after_seconds"  json:"retry_after_seconds"`
+}
+
+// SecurityHeadersConfig holds the browser security headers set on every server response.
+//
+// HSTSMaxAgeSeconds sets Strict-Transport-Security; zero omits the header. ContentSecurityPolicy and
+// ReferrerPolicy are the defaults for every path, and an empty value omits the header.
+// X-Content-Type-Options: nosniff is always set while the headers are enabled. Overrides replace
+// the defaults for matching paths, such as the gate and console applications that need a policy
+// allowing their own scripts and styles. Handlers that set one of these headers themselves keep
+// their value.
+type SecurityHeadersConfig struct {
+	Enabled               bool                      `yaml:"enabled"                 json:"enabled"`
+	HSTSMaxAgeSeconds     int                       `yaml:"hsts_max_age_seconds"    json:"hsts_max_age_seconds"`
+	HSTSIncludeSubdomains bool                      `yaml:"hsts_include_subdomains" json:"hsts_include_subdomains"`
+	ContentSecurityPolicy string                    `yaml:"content_security_policy" json:"content_security_policy"`
+	ReferrerPolicy        string                    `yaml:"referrer_policy"         json:"referrer_policy"`
+	Overrides             []SecurityHeadersOverride `yaml:"overrides"               json:"overrides"`
+}
+
+// SecurityHeadersOverride replaces the default Content-Security-Policy and Referrer-Policy for the
+// listed path patterns. Paths use the same glob syntax as the public path list; an empty field keeps
+// the default value.
+type SecurityHeadersOverride struct {
+	Paths                 []string `yaml:"paths"                   json:"paths"`
+	ContentSecurityPolicy string   `yaml:"content_security_policy" json:"content_security_policy"`
+	ReferrerPolicy        string   `yaml:"referrer_policy"         json:"referrer_policy"`
+}
+
+// NetworkAccessConfig restricts where requests may come from.
+//
+// AdminNetworks lists the CIDR ranges (or single IP addresses) allowed to call the management
+// endpoints, meaning every authenticated endpoint except the self-service and access evaluation
+// endpoints and AdminExemptPaths. Empty leaves them reachable from any network. TrustedProxies lists
+// the reverse proxies whose client IP headers are used to resolve the client IP address; without it,
+// the address of the direct peer is used. ClientIPHeaders lists those headers in order of precedence
+// from Forwarded, X-Forwarded-For and X-Real-IP; empty reads X-Forwarded-For only.
+type NetworkAccessConfig struct {
+	AdminNetworks    []string `yaml:"admin_networks"     json:"admin_networks"`
+	AdminExemptPaths []string `yaml:"admin_exempt_paths" json:"admin_exempt_paths"`
+	TrustedProxies   []string `yaml:"trusted_proxies"    json:"trusted_proxies"`
+	ClientIPHeaders  []string `yaml:"client_ip_headers"  json:"client_ip_headers"`
+}
+
+// IdempotencyConfig controls the Idempotency-Key handling of the create endpoints.
+//
+// When enabled, a request to one of Endpoints carrying an Idempotency-Key header is processed once per
+// caller and key, and retries with the same payload receive the stored response for TTLSeconds.
+// Endpoints are "METHOD /path" entries; empty guards the user, application, group and organization
+// unit create endpoints.
+type IdempotencyConfig struct {
+	Enabled    bool     `yaml:"enabled"     json:"enabled"`
+	TTLSeconds int      `yaml:"ttl_seconds" json:"ttl_seconds"`
+	Endpoints  []string `yaml:"endpoints"   json:"endpoints"`
+}
+
 // ServerConfig holds the server configuration details.
 type ServerConfig struct {
-	Hostname       string         `yaml:"hostname"   json:"hostname"`
-	Port           int            `yaml:"port"       json:"port"`
-	HTTPOnly       bool           `yaml:"http_only"  json:"http_only"`
-	PublicURL      string         `yaml:"public_url" json:"public_url"`
-	Identifier     string         `yaml:"identifier" json:"identifier"`
-	SecurityConfig SecurityConfig `yaml:"security"   json:"security"`
+	Hostname        string                `yaml:"hostname"         json:"hostname"`
+	DeploymentMode  DeploymentMode        `yaml:"deployment_mode"  json:"deployment_mode"`
+	Port            int                   `yaml:"port"             json:"port"`
+	HTTPOnly        bool                  `yaml:"http_only"        json:"http_only"`
+	PublicURL       string                `yaml:"public_url"       json:"public_url"`
+	Identifier      string                `yaml:"identifier"       json:"identifier"`
+	SecurityConfig  SecurityConfig        `yaml:"security"         json:"security"`
+	Maintenance     MaintenanceConfig     `yaml:"maintenance"      json:"maintenance"`
+	SecurityHeaders SecurityHeadersConfig `yaml:"security_headers" json:"security_headers"`
+	NetworkAccess   NetworkAccessConfig   `yaml:"network_access"   json:"network_access"`
+	Idempotency     IdempotencyConfig     `yaml:"idempotency"      json:"idempotency"`
+}
+
+// DeploymentMode is the kind of deployment the server runs in. An unset mode means production.
+type DeploymentMode string
+
+const (
+	// DeploymentModeProduction is a production deployment. Development-only features are unavailable.
+	DeploymentModeProduction DeploymentMode = "production"
+	// DeploymentModeDevelopment is a local development deployment.
+	DeploymentModeDevelopment DeploymentMode = "development"
+	// DeploymentModeTest is a deployment that runs automated tests.
+	DeploymentModeTest DeploymentMode = "test"
+)
+
+// AllowsDevelopmentFeatures reports whether development-only features, such as the test token
+// endpoint, may be enabled in the deployment mode.
+func (m DeploymentMode) AllowsDevelopmentFeatures() bool {
+	return m == DeploymentModeDevelopment || m == DeploymentModeTest
 }
 
 // GateClientConfig holds the client configuration details.
@@ -147,6 +246,9 @@ type GateClientConfig struct {
 // EncryptionConfig holds the encryption configuration details.
 type EncryptionConfig struct {
 	Key string `yaml:"key" json:"key"`
+	// PreviousKeys lists retired master keys that are kept only to decrypt data encrypted before
+	// the current key was introduced.
+	PreviousKeys []string `yaml:"previous_keys" json:"previous_keys"`
 }
 
 // JWTConfig holds the JWT configuration details.
@@ -171,9 +273,33 @@ type RefreshTokenConfig struct {
 	ValidityPeriod        int64 `yaml:"validity_period"          json:"validity_period"`
 }
 
+// DefaultAssertionMaxAge is the maximum age, in seconds, of a flow assertion when none is configured.
+const DefaultAssertionMaxAge int64 = 300
+
 // AuthorizationCodeConfig holds the authorization code configuration details.
 type AuthorizationCodeConfig struct {
 	ValidityPeriod int64 `yaml:"validity_period" json:"validity_period"`
+	// AssertionMaxAge is the maximum age, in seconds, of a flow assertion posted to the authorization
+	// callback, measured from its iat claim. It is a pointer so that an explicit zero, which disables the
+	// check, overrides the default; nil means DefaultAssertionMaxAge.
+	AssertionMaxAge *int64 `yaml:"assertion_max_age" json:"assertion_max_age"`
+}
+
+// GetAssertionMaxAge returns the maximum age of a flow assertion, falling back to DefaultAssertionMaxAge
+// when none is configured. A zero duration means the age check is disabled.
+func (c AuthorizationCodeConfig) GetAssertionMaxAge() time.Duration {
+	maxAge := DefaultAssertionMaxAge
+	if c.AssertionMaxAge != nil {
+		maxAge = *c.AssertionMaxAge
+	}
+	return time.Duration(maxAge) * time.Second
+}
+
+// TokenLifetimeConfig holds the bounds that application-level token validity periods must fall within.
+// A zero bound is not enforced.
+type TokenLifetimeConfig struct {
+	MinValidityPeriod int64 `yaml:"min_validity_period" json:"min_validity_period"`
+	MaxValidityPeriod int64 `yaml:"max_validity_period" json:"max_validity_period"`
 }
 
 // DCRConfig holds the Dynamic Client Registration configuration.
@@ -187,6 +313,13 @@ type PARConfig struct {
 	ExpiresIn  int64 `yaml:"expires_in"  json:"expires_in"`
 }
 
+// TestTokenConfig holds the configuration of the development-only test token endpoint.
+type TestTokenConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// AllowedClients lists the client IDs of the confidential clients that may request test tokens.
+	AllowedClients []string `yaml:"allowed_clients" json:"allowed_clients"`
+}
+
 // DPoPConfig holds the OAuth 2.0 DPoP configuration.
 type DPoPConfig struct {
 	Required     bool     `yaml:"required"       json:"required"`
@@ -210,6 +343,8 @@ type OAuthConfig struct {
 	DPoP              DPoPConfig              `yaml:"dpop"                        json:"dpop"`
 	AuthClass         AuthClassConfig         `yaml:"auth_class"                  json:"auth_class"`
 	CIBA              CIBAConfig              `yaml:"ciba"                        json:"ciba"`
+	TokenLifetime     TokenLifetimeConfig     `yaml:"token_lifetime"              json:"token_lifetime"`
+	TestToken         TestTokenConfig         `yaml:"test_token"                  json:"test_token"`
 	// AllowWildcardRedirectURI enables wildcard pattern matching for redirect URIs.
 	// When false (default), only exact redirect URI matching is performed.
 	AllowWildcardRedirectURI bool `yaml:"allow_wildcard_redirect_uri" json:"allow_wildcard_redirect_uri"`
@@ -222,6 +357,9 @@ type FlowConfig struct {
 	MaxVersionHistory        int    `yaml:"max_version_history"         json:"max_version_history"`
 	AutoInferRegistration    bool   `yaml:"auto_infer_registration"     json:"auto_infer_registration"`
 	Store                    string `yaml:"store"                       json:"store"`
+	// LocalizeResponses resolves i18n references in flow execution responses on the server,
+	// using the ui_locales of the authorization request or the Accept-Language header.
+	LocalizeResponses bool `yaml:"localize_responses"          json:"localize_responses"`
 	// Executors lists built-in executor names to register (e.g. CredentialsAuthExecutor).
 	// When empty, all built-in executors are registered. When set, only listed executors
 	// are available; omit only executors you intentionally disable on this node.
@@ -269,6 +407,7 @@ type ObservabilityOutputConfig struct {
 	File          ObservabilityFileConfig    `yaml:"file"          json:"file"`
 	Console       ObservabilityConsoleConfig `yaml:"console"       json:"console"`
 	OpenTelemetry ObservabilityOTelConfig    `yaml:"opentelemetry" json:"opentelemetry"`
+	Webhook       ObservabilityWebhookConfig `yaml:"webhook"       json:"webhook"`
 }
 
 // ObservabilityFileConfig captures file sink settings for observability events.
@@ -288,6 +427,17 @@ type ObservabilityConsoleConfig struct {
 	Categories []string `yaml:"categories" json:"categories"`
 }
 
+// ObservabilityWebhookConfig captures webhook sink settings for observability events. Each event is
+// POSTed to URL as JSON; when Secret is set, the body is signed with HMAC-SHA256 and the signature is
+// sent in the X-ThunderID-Signature header.
+type ObservabilityWebhookConfig struct {
+	Enabled        bool     `yaml:"enabled"         json:"enabled"`
+	URL            string   `yaml:"url"             json:"url"`
+	Secret         string   `yaml:"secret"          json:"secret"`
+	TimeoutSeconds int      `yaml:"timeout_seconds" json:"timeout_seconds"`
+	Categories     []string `yaml:"categories"      json:"categories"`
+}
+
 // ObservabilityOTelConfig holds OpenTelemetry configuration.
 type ObservabilityOTelConfig struct {
 	Enabled        bool     `yaml:"enabled"         json:"enabled"`
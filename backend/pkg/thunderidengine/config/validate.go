@@ -40,6 +40,16 @@ func (c *SecurityConfig) Validate() error {
 	return c.TrustedIssuer.Validate()
 }
 
+// Validate checks that the deployment mode is a supported mode. An unset mode means production.
+func (m DeploymentMode) Validate() error {
+	switch m {
+	case "", DeploymentModeProduction, DeploymentModeDevelopment, DeploymentModeTest:
+		return nil
+	}
+	return fmt.Errorf("server.deployment_mode %q is not supported (supported: %q, %q, %q)",
+		m, DeploymentModeProduction, DeploymentModeDevelopment, DeploymentModeTest)
+}
+
 // Validate checks the token-revocation configuration. It runs only when the feature is enabled: an
 // unsupported source is rejected, a negative sync interval is rejected, and a non-positive interval
 // otherwise falls back to the default.
@@ -60,6 +70,29 @@ func (c *TokenRevocationConfig) Validate() error {
 	return nil
 }
 
+// Validate checks the maintenance configuration. Retry-After must be non-negative and every allowed
+// path and disabled endpoint must be a path pattern rooted at "/", optionally prefixed (for disabled
+// endpoints) with an HTTP method.
+func (c *MaintenanceConfig) Validate() error {
+	if c.RetryAfterSeconds < 0 {
+		return fmt.Errorf("server.maintenance.retry_after_seconds must be non-negative (got %d)",
+			c.RetryAfterSeconds)
+	}
+	for _, p := range c.AllowedPaths {
+		if !strings.HasPrefix(p, "/") {
+			return fmt.Errorf("server.maintenance.allowed_paths entry %q must start with '/'", p)
+		}
+	}
+	for _, endpoint := range c.DisabledEndpoints {
+		fields := strings.Fields(endpoint)
+		if len(fields) == 0 || len(fields) > 2 || !strings.HasPrefix(fields[len(fields)-1], "/") {
+			return fmt.Errorf("server.maintenance.disabled_endpoints entry %q must be \"[METHOD] /path\"",
+				endpoint)
+		}
+	}
+	return nil
+}
+
 // IsConfigured reports whether any DPoP field has been set. When false, callers should
 // skip validation: this matches the convention used by TrustedIssuerConfig and keeps
 // config-loading tests that omit the dpop section working without surprise failures.
@@ -187,3 +220,31 @@ func GetServerURL(server *ServerConfig) string {
 	}
 	return fmt.Sprintf("%s://%s:%d", scheme, server.Hostname, server.Port)
 }
+
+// Validate checks the token lifetime bounds. Both bounds must be non-negative, and when both are set
+// the maximum must not be less than the minimum.
+func (c *TokenLifetimeConfig) Validate() error {
+	if c.MinValidityPeriod < 0 {
+		return fmt.Errorf("oauth.token_lifetime.min_validity_period must be non-negative (got %d)",
+			c.MinValidityPeriod)
+	}
+	if c.MaxValidityPeriod < 0 {
+		return fmt.Errorf("oauth.token_lifetime.max_validity_period must be non-negative (got %d)",
+			c.MaxValidityPeriod)
+	}
+	if c.MaxValidityPeriod > 0 && c.MaxValidityPeriod < c.MinValidityPeriod {
+		return fmt.Errorf("oauth.token_lifetime.max_validity_period must not be less than min_validity_period")
+	}
+	return nil
+}
+
+// Allows reports whether the validity period falls within the configured bounds.
+func (c *TokenLifetimeConfig) Allows(validityPeriod int64) bool {
+	if c.MinValidityPeriod > 0 && validityPeriod < c.MinValidityPeriod {
+		return false
+	}
+	if c.MaxValidityPeriod > 0 && validityPeriod > c.MaxValidityPeriod {
+		return false
+	}
+	return true
+}
@@ -20,6 +20,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -53,6 +54,36 @@ func (suite *ValidateTestSuite) TestGetServerURL_HTTPScheme() {
 	assert.Equal(suite.T(), "http://localhost:8080", GetServerURL(cfg))
 }
 
+// ----- DeploymentMode -----
+
+func (suite *ValidateTestSuite) TestDeploymentMode_Validate() {
+	for _, m := range []DeploymentMode{"", DeploymentModeProduction, DeploymentModeDevelopment,
+		DeploymentModeTest} {
+		assert.NoError(suite.T(), m.Validate(), "mode %q", m)
+	}
+	assert.ErrorContains(suite.T(), DeploymentMode("staging").Validate(), "server.deployment_mode")
+}
+
+func (suite *ValidateTestSuite) TestDeploymentMode_AllowsDevelopmentFeatures() {
+	assert.False(suite.T(), DeploymentMode("").AllowsDevelopmentFeatures())
+	assert.False(suite.T(), DeploymentModeProduction.AllowsDevelopmentFeatures())
+	assert.True(suite.T(), DeploymentModeDevelopment.AllowsDevelopmentFeatures())
+	assert.True(suite.T(), DeploymentModeTest.AllowsDevelopmentFeatures())
+}
+
+// ----- AuthorizationCodeConfig -----
+
+func (suite *ValidateTestSuite) TestAuthorizationCodeConfig_GetAssertionMaxAge() {
+	assert.Equal(suite.T(), time.Duration(DefaultAssertionMaxAge)*time.Second,
+		AuthorizationCodeConfig{}.GetAssertionMaxAge())
+
+	maxAge := int64(60)
+	assert.Equal(suite.T(), time.Minute, AuthorizationCodeConfig{AssertionMaxAge: &maxAge}.GetAssertionMaxAge())
+
+	disabled := int64(0)
+	assert.Zero(suite.T(), AuthorizationCodeConfig{AssertionMaxAge: &disabled}.GetAssertionMaxAge())
+}
+
 // ----- TrustedIssuerConfig -----
 
 func (suite *ValidateTestSuite) TestTrustedIssuerConfig_IsConfigured() {
@@ -187,6 +218,39 @@ func (suite *ValidateTestSuite) TestTokenRevocationConfig_Validate() {
 	})
 }
 
+// ----- MaintenanceConfig -----
+
+func (suite *ValidateTestSuite) TestMaintenanceConfig_Validate() {
+	suite.T().Run("empty config passes", func(t *testing.T) {
+		assert.NoError(t, (&MaintenanceConfig{}).Validate())
+	})
+
+	suite.T().Run("valid config passes", func(t *testing.T) {
+		c := &MaintenanceConfig{
+			ReadOnly:          true,
+			AllowedPaths:      []string{"/health/**", "/users/*/credentials"},
+			DisabledEndpoints: []string{"POST /users", "/oauth2/par"},
+			RetryAfterSeconds: 120,
+		}
+		assert.NoError(t, c.Validate())
+	})
+
+	suite.T().Run("negative retry after fails", func(t *testing.T) {
+		assert.ErrorContains(t, (&MaintenanceConfig{RetryAfterSeconds: -1}).Validate(), "retry_after_seconds")
+	})
+
+	suite.T().Run("relative allowed path fails", func(t *testing.T) {
+		assert.ErrorContains(t, (&MaintenanceConfig{AllowedPaths: []string{"users"}}).Validate(), "allowed_paths")
+	})
+
+	suite.T().Run("malformed disabled endpoint fails", func(t *testing.T) {
+		for _, entry := range []string{"", "POST", "POST /users extra", "POST users"} {
+			assert.ErrorContains(t, (&MaintenanceConfig{DisabledEndpoints: []string{entry}}).Validate(),
+				"disabled_endpoints", entry)
+		}
+	})
+}
+
 // ----- DPoPConfig -----
 
 func (suite *ValidateTestSuite) TestDPoPConfig_IsConfigured() {
@@ -315,3 +379,30 @@ func (suite *ValidateTestSuite) TestCORSConfig_Validate() {
 		assert.Error(t, cors.Validate(origins))
 	})
 }
+
+// ----- TokenLifetimeConfig -----
+
+func (suite *ValidateTestSuite) TestTokenLifetimeConfig_Validate() {
+	suite.T().Run("unbounded config passes", func(t *testing.T) {
+		assert.NoError(t, (&TokenLifetimeConfig{}).Validate())
+	})
+
+	suite.T().Run("negative bounds fail", func(t *testing.T) {
+		assert.ErrorContains(t, (&TokenLifetimeConfig{MinValidityPeriod: -1}).Validate(), "min_validity_period")
+		assert.ErrorContains(t, (&TokenLifetimeConfig{MaxValidityPeriod: -1}).Validate(), "max_validity_period")
+	})
+
+	suite.T().Run("max below min fails", func(t *testing.T) {
+		c := &TokenLifetimeConfig{MinValidityPeriod: 600, MaxValidityPeriod: 300}
+		assert.ErrorContains(t, c.Validate(), "max_validity_period")
+	})
+}
+
+func (suite *ValidateTestSuite) TestTokenLifetimeConfig_Allows() {
+	c := &TokenLifetimeConfig{MinValidityPeriod: 60, MaxValidityPeriod: 3600}
+	suite.True(c.Allows(60))
+	suite.True(c.Allows(3600))
+	suite.False(c.Allows(59))
+	suite.False(c.Allows(3601))
+	suite.True((&TokenLifetimeConfig{}).Allows(86400 * 365))
+}
@@ -112,6 +112,37 @@ var ValidInterceptorScopes = map[InterceptorScope]bool{
 	InterceptorScopeSelected: true,
 }
 
+// DecisionOperator represents the comparison applied by a DECISION node condition.
+type DecisionOperator string
+
+// Decision operator constants.
+const (
+	DecisionOperatorEquals             DecisionOperator = "EQUALS"
+	DecisionOperatorNotEquals          DecisionOperator = "NOT_EQUALS"
+	DecisionOperatorIn                 DecisionOperator = "IN"
+	DecisionOperatorNotIn              DecisionOperator = "NOT_IN"
+	DecisionOperatorGreaterThan        DecisionOperator = "GREATER_THAN"
+	DecisionOperatorGreaterThanOrEqual DecisionOperator = "GREATER_THAN_OR_EQUAL"
+	DecisionOperatorLessThan           DecisionOperator = "LESS_THAN"
+	DecisionOperatorLessThanOrEqual    DecisionOperator = "LESS_THAN_OR_EQUAL"
+	DecisionOperatorExists             DecisionOperator = "EXISTS"
+	DecisionOperatorNotExists          DecisionOperator = "NOT_EXISTS"
+)
+
+// ValidDecisionOperators contains the set of valid decision operators for validation.
+var ValidDecisionOperators = map[DecisionOperator]bool{
+	DecisionOperatorEquals:             true,
+	DecisionOperatorNotEquals:          true,
+	DecisionOperatorIn:                 true,
+	DecisionOperatorNotIn:              true,
+	DecisionOperatorGreaterThan:        true,
+	DecisionOperatorGreaterThanOrEqual: true,
+	DecisionOperatorLessThan:           true,
+	DecisionOperatorLessThanOrEqual:    true,
+	DecisionOperatorExists:             true,
+	DecisionOperatorNotExists:          true,
+}
+
 // DesignResolveType represents the type of entity for design resolution.
 type DesignResolveType string
 
@@ -259,6 +290,46 @@ const (
 	UserInfoResponseTypeNESTEDJWT UserInfoResponseType = "NESTED_JWT"
 )
 
+// RedirectURIMatching is the policy used to match a requested redirect URI against the registered ones.
+type RedirectURIMatching string
+
+const (
+	// RedirectURIMatchingExact requires the redirect URI to equal a registered URI (default).
+	RedirectURIMatchingExact RedirectURIMatching = "exact"
+	// RedirectURIMatchingLoopback additionally accepts any port on registered loopback http URIs,
+	// as native apps listening on an ephemeral port require (RFC 8252 §7.3).
+	RedirectURIMatchingLoopback RedirectURIMatching = "loopback"
+)
+
+// IsValid checks if the RedirectURIMatching is valid. An empty value selects exact matching.
+func (m RedirectURIMatching) IsValid() bool {
+	return m == "" || m == RedirectURIMatchingExact || m == RedirectURIMatchingLoopback
+}
+
+// ApplicationType is the kind of OAuth client, which determines the redirect URIs and client
+// authentication it may use.
+type ApplicationType string
+
+const (
+	// ApplicationTypeWeb is a server-side web application that can keep a client secret.
+	ApplicationTypeWeb ApplicationType = "web"
+	// ApplicationTypeNative is a mobile or desktop application (RFC 8252).
+	ApplicationTypeNative ApplicationType = "native"
+	// ApplicationTypeSPA is a browser-based single-page application.
+	ApplicationTypeSPA ApplicationType = "spa"
+)
+
+// IsValid checks if the ApplicationType is valid. An empty value leaves the client unclassified.
+func (t ApplicationType) IsValid() bool {
+	return t == "" || t == ApplicationTypeWeb || t == ApplicationTypeNative || t == ApplicationTypeSPA
+}
+
+// IsPublic reports whether the application type runs on user-controlled devices and therefore
+// cannot hold a client secret.
+func (t ApplicationType) IsPublic() bool {
+	return t == ApplicationTypeNative || t == ApplicationTypeSPA
+}
+
 // CertificateType represents the type of certificates in the system.
 type CertificateType string
 
@@ -275,6 +346,14 @@ type EntityState string
 const (
 	// EntityStateActive represents an active entity.
 	EntityStateActive EntityState = "ACTIVE"
+	// EntityStateLocked represents an entity that is temporarily locked and cannot authenticate.
+	EntityStateLocked EntityState = "LOCKED"
+	// EntityStateDisabled represents an entity that has been disabled by an administrator.
+	EntityStateDisabled EntityState = "DISABLED"
+	// EntityStatePending represents an entity that has been created but not yet activated.
+	EntityStatePending EntityState = "PENDING"
+	// EntityStateDeleted represents an entity that has been soft-deleted and awaits restore or purge.
+	EntityStateDeleted EntityState = "DELETED"
 )
 
 // String returns the string representation of the entity state.
@@ -485,6 +564,7 @@ type RuntimeStoreNamespace string
 const (
 	NamespaceAttributeCache RuntimeStoreNamespace = "attribute:cache"
 	NamespaceFlow           RuntimeStoreNamespace = "flow:state"
+	NamespaceFlowTrace      RuntimeStoreNamespace = "flow:trace"
 	NamespaceAuthzCode      RuntimeStoreNamespace = "authz:code"
 	NamespaceAuthzReq       RuntimeStoreNamespace = "authz:req"
 	NamespacePAR            RuntimeStoreNamespace = "par:req"
@@ -493,6 +573,18 @@ const (
 	NamespaceVCINonce       RuntimeStoreNamespace = "vci:nonce"
 	NamespaceVCIOffer       RuntimeStoreNamespace = "vci:offer"
 	NamespaceVPState        RuntimeStoreNamespace = "vp:state"
+	NamespaceLoginProfile   RuntimeStoreNamespace = "anomaly:profile"
+	NamespaceUserDevices    RuntimeStoreNamespace = "device:user"
+	NamespaceDeviceTrust    RuntimeStoreNamespace = "device:trust"
+	NamespaceLoginHistory   RuntimeStoreNamespace = "login:history"
+	NamespaceIdempotency    RuntimeStoreNamespace = "idempotency:key"
+	NamespaceJobStatus      RuntimeStoreNamespace = "job:status"
+	NamespaceUserErasure    RuntimeStoreNamespace = "user:erasure"
+	NamespacePushDevices    RuntimeStoreNamespace = "push:device"
+	NamespacePushChallenge  RuntimeStoreNamespace = "push:challenge"
+	NamespaceCrossDeviceReq RuntimeStoreNamespace = "crossdevice:req"
+	NamespaceOTPSession     RuntimeStoreNamespace = "otp:session"
+	NamespaceOTPResend      RuntimeStoreNamespace = "otp:resend"
 )
 
 // Error constants
@@ -500,3 +592,20 @@ var (
 	// ErrRuntimeStoreKeyNotFound to identify key not found error in the runtime store providers
 	ErrRuntimeStoreKeyNotFound = errors.New("RuntimeStore key not found")
 )
+
+// JoinMode represents the completion semantics of a JOIN node.
+type JoinMode string
+
+// Join mode constants.
+const (
+	// JoinModeAll requires every branch of the parallel node to complete successfully.
+	JoinModeAll JoinMode = "ALL"
+	// JoinModeAny requires at least one branch of the parallel node to complete successfully.
+	JoinModeAny JoinMode = "ANY"
+)
+
+// ValidJoinModes contains the set of valid join modes for validation.
+var ValidJoinModes = map[JoinMode]bool{
+	JoinModeAll: true,
+	JoinModeAny: true,
+}
@@ -74,6 +74,26 @@ func (suite *ConstantsTestSuite) TestTokenEndpointAuthMethod_IsValid() {
 	assert.False(suite.T(), TokenEndpointAuthMethod("").IsValid())
 }
 
+func (suite *ConstantsTestSuite) TestRedirectURIMatching_IsValid() {
+	assert.True(suite.T(), RedirectURIMatching("").IsValid())
+	assert.True(suite.T(), RedirectURIMatchingExact.IsValid())
+	assert.True(suite.T(), RedirectURIMatchingLoopback.IsValid())
+	assert.False(suite.T(), RedirectURIMatching("pattern").IsValid())
+}
+
+func (suite *ConstantsTestSuite) TestApplicationType() {
+	assert.True(suite.T(), ApplicationType("").IsValid())
+	assert.True(suite.T(), ApplicationTypeWeb.IsValid())
+	assert.True(suite.T(), ApplicationTypeNative.IsValid())
+	assert.True(suite.T(), ApplicationTypeSPA.IsValid())
+	assert.False(suite.T(), ApplicationType("desktop").IsValid())
+
+	assert.True(suite.T(), ApplicationTypeNative.IsPublic())
+	assert.True(suite.T(), ApplicationTypeSPA.IsPublic())
+	assert.False(suite.T(), ApplicationTypeWeb.IsPublic())
+	assert.False(suite.T(), ApplicationType("").IsPublic())
+}
+
 func (suite *ConstantsTestSuite) TestEntityCategory_String() {
 	assert.Equal(suite.T(), "user", EntityCategoryUser.String())
 	assert.Equal(suite.T(), "app", EntityCategoryApp.String())
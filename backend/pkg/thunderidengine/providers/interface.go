@@ -56,6 +56,7 @@ type ActorProvider interface {
 		ctx context.Context, identifiers, credentials map[string]interface{},
 	) *common.ServiceError
 	GetActor(actorID string) (*Entity, *common.ServiceError)
+	GetDeletedActor(actorID string) (*Entity, *common.ServiceError)
 	GetActorGroups(actorID string) ([]EntityGroup, *common.ServiceError)
 }
 
@@ -125,8 +126,10 @@ type ConsentProvider interface {
 	// ResolveConsent checks whether the user has provided required consents for the given
 	// application, attribute set, and authorized permission set. Returns nil if all required
 	// consents are active; otherwise returns ConsentPromptData describing which purposes /
-	// elements still need user consent. When forceReprompt is true, consent is re-prompted for
-	// all required claims regardless of existing active consent.
+	// elements still need user consent. Permissions the user previously declined are not
+	// prompted again and are reported through DeclinedPermissions, with no purposes when nothing
+	// else needs consent. When forceReprompt is true, consent is re-prompted for all required
+	// claims regardless of existing active consent.
 	ResolveConsent(ctx context.Context, ouID, appID, appName, userID string,
 		essentialAttributes, optionalAttributes, authorizedPermissions []string,
 		availableAttributes *AttributesResponse, forceReprompt bool,
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"regexp"
 	"slices"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -79,7 +80,7 @@ type OrganizationUnitRequestWithID struct {
 	LogoURL         string  `json:"logoUrl,omitempty"         yaml:"logoUrl,omitempty"         native:"omitempty,url,max=2048"`
 	TosURI          string  `json:"tosUri,omitempty"          yaml:"tosUri,omitempty"          native:"omitempty,url,max=2048"`
 	PolicyURI       string  `json:"policyUri,omitempty"       yaml:"policyUri,omitempty"       native:"omitempty,url,max=2048"`
-	CookiePolicyURI string  `json:"cookiePolicyUri,omitempty" yaml:"cookiePolicyUri,omitempty" native:"url,max=2048"`
+	CookiePolicyURI string  `json:"cookiePolicyUri,omitempty" yaml:"cookiePolicyUri,omitempty" native:"omitempty,url,max=2048"`
 }
 
 // OrganizationUnitListResponse represents the response for listing organization units with pagination.
@@ -245,26 +246,64 @@ type NodePosition struct {
 
 // NodeDefinition represents a single node in a flow definition.
 type NodeDefinition struct {
-	ID           string                   `json:"id"                     yaml:"id"                     jsonschema:"Unique node identifier within the flow. Example: 'start', 'username-password', 'end'"`
-	Type         string                   `json:"type"                   yaml:"type"                   jsonschema:"Node type: 'START' (entry point), 'END' (exit point), 'TASK_EXECUTION' (backend logic), 'PROMPT' (user input), or 'CALL' (invoke another flow)"`
-	Layout       *NodeLayout              `json:"layout,omitempty"       yaml:"layout,omitempty"       jsonschema:"Optional UI layout information for flow composer (position and size on canvas)"`
-	Meta         interface{}              `json:"meta,omitempty"         yaml:"meta,omitempty"         jsonschema:"Optional metadata. For PROMPT nodes, must include 'components' array for UI rendering. See existing flows for examples."`
-	Prompts      []PromptDefinition       `json:"prompts,omitempty"      yaml:"prompts,omitempty"      jsonschema:"For PROMPT nodes: defines user inputs and actions. Each prompt has inputs (form fields) and an action (what happens on submit)."`
-	Variant      NodeVariant              `json:"variant,omitempty"      yaml:"variant,omitempty"      jsonschema:"Optional PROMPT node variant. Use 'LOGIN_OPTIONS' to enable login option filtering on this node."`
-	Next         string                   `json:"next,omitempty"         yaml:"next,omitempty"         jsonschema:"For display-only PROMPT nodes: ID of the next node. Mutually exclusive with 'prompts'."`
-	Message      string                   `json:"message,omitempty"      yaml:"message,omitempty"      jsonschema:"For display-only PROMPT nodes: textual message for non-verbose mode."`
-	Properties   map[string]interface{}   `json:"properties,omitempty"   yaml:"properties,omitempty"   jsonschema:"Optional node-specific properties for configuration"`
-	Executor     *ExecutorDefinition      `json:"executor,omitempty"     yaml:"executor,omitempty"     jsonschema:"For TASK_EXECUTION nodes: defines which executor to run (e.g., 'UsernamePasswordAuthenticator', 'OTPGenerator')"`
-	OnSuccess    string                   `json:"onSuccess,omitempty"    yaml:"onSuccess,omitempty"    jsonschema:"ID of the next node to execute on successful completion"`
-	OnFailure    string                   `json:"onFailure,omitempty"    yaml:"onFailure,omitempty"    jsonschema:"ID of the next node to execute on failure"`
-	OnIncomplete string                   `json:"onIncomplete,omitempty" yaml:"onIncomplete,omitempty" jsonschema:"For TASK_EXECUTION nodes: ID of the PROMPT node to forward to when user input is required."`
-	Condition    *ConditionDefinition     `json:"condition,omitempty"    yaml:"condition,omitempty"    jsonschema:"Optional condition to determine if this node should execute"`
-	Flow         *FlowReferenceDefinition `json:"flow,omitempty"       yaml:"flow,omitempty"         jsonschema:"For CALL nodes: identifies the target flow to invoke by its ID."`
-}
-
-// FlowReferenceDefinition identifies the target flow for a CALL node.
+	ID            string                   `json:"id"                     yaml:"id"                     jsonschema:"Unique node identifier within the flow. Example: 'start', 'username-password', 'end'"`
+	Type          string                   `json:"type"                   yaml:"type"                   jsonschema:"Node type: 'START' (entry point), 'END' (exit point), 'TASK_EXECUTION' (backend logic), 'PROMPT' (user input), or 'CALL' (invoke another flow)"`
+	Layout        *NodeLayout              `json:"layout,omitempty"       yaml:"layout,omitempty"       jsonschema:"Optional UI layout information for flow composer (position and size on canvas)"`
+	Meta          interface{}              `json:"meta,omitempty"         yaml:"meta,omitempty"         jsonschema:"Optional metadata. For PROMPT nodes, must include 'components' array for UI rendering. See existing flows for examples."`
+	Prompts       []PromptDefinition       `json:"prompts,omitempty"      yaml:"prompts,omitempty"      jsonschema:"For PROMPT nodes: defines user inputs and actions. Each prompt has inputs (form fields) and an action (what happens on submit)."`
+	Variant       NodeVariant              `json:"variant,omitempty"      yaml:"variant,omitempty"      jsonschema:"Optional PROMPT node variant. Use 'LOGIN_OPTIONS' to enable login option filtering on this node."`
+	Next          string                   `json:"next,omitempty"         yaml:"next,omitempty"         jsonschema:"For display-only PROMPT nodes: ID of the next node. Mutually exclusive with 'prompts'."`
+	Message       string                   `json:"message,omitempty"      yaml:"message,omitempty"      jsonschema:"For display-only PROMPT nodes: textual message for non-verbose mode."`
+	Properties    map[string]interface{}   `json:"properties,omitempty"   yaml:"properties,omitempty"   jsonschema:"Optional node-specific properties for configuration"`
+	Executor      *ExecutorDefinition      `json:"executor,omitempty"     yaml:"executor,omitempty"     jsonschema:"For TASK_EXECUTION nodes: defines which executor to run (e.g., 'UsernamePasswordAuthenticator', 'OTPGenerator')"`
+	OnSuccess     string                   `json:"onSuccess,omitempty"    yaml:"onSuccess,omitempty"    jsonschema:"ID of the next node to execute on successful completion"`
+	OnFailure     string                   `json:"onFailure,omitempty"    yaml:"onFailure,omitempty"    jsonschema:"ID of the next node to execute on failure"`
+	OnIncomplete  string                   `json:"onIncomplete,omitempty" yaml:"onIncomplete,omitempty" jsonschema:"For TASK_EXECUTION nodes: ID of the PROMPT node to forward to when user input is required."`
+	OnCircuitOpen string                   `json:"onCircuitOpen,omitempty" yaml:"onCircuitOpen,omitempty" jsonschema:"For TASK_EXECUTION nodes with a circuit breaker: ID of the node to forward to while the breaker is open."`
+	Condition     *ConditionDefinition     `json:"condition,omitempty"    yaml:"condition,omitempty"    jsonschema:"Optional condition to determine if this node should execute"`
+	Flow          *FlowReferenceDefinition `json:"flow,omitempty"       yaml:"flow,omitempty"         jsonschema:"For CALL nodes: identifies the target flow to invoke by its ID or handle."`
+	Decision      *DecisionDefinition      `json:"decision,omitempty"     yaml:"decision,omitempty"     jsonschema:"For DECISION nodes: ordered branches evaluated over the flow context and the default node to route to when none match."`
+	Parallel      *ParallelDefinition      `json:"parallel,omitempty"     yaml:"parallel,omitempty"     jsonschema:"For PARALLEL nodes: the branches to run concurrently and the JOIN node where they converge."`
+	Join          *JoinDefinition          `json:"join,omitempty"         yaml:"join,omitempty"         jsonschema:"For JOIN nodes: the completion semantics applied to the branches of the parallel node."`
+}
+
+// FlowReferenceDefinition identifies the target flow for a CALL node, either by ID or by handle.
 type FlowReferenceDefinition struct {
-	Ref string `json:"ref" yaml:"ref" jsonschema:"ID of the flow to invoke."`
+	Ref     string   `json:"ref,omitempty"     yaml:"ref,omitempty"     jsonschema:"ID of the flow to invoke. Mutually exclusive with handle."`
+	Handle  string   `json:"handle,omitempty"  yaml:"handle,omitempty"  jsonschema:"Handle of the flow to invoke. The flow is resolved at runtime among flows of the caller's flow type. Mutually exclusive with ref."`
+	Outputs []string `json:"outputs,omitempty" yaml:"outputs,omitempty" jsonschema:"Runtime data keys returned to the caller when the invoked flow completes. When omitted, all runtime data produced by the invoked flow is returned."`
+}
+
+// DecisionDefinition holds the routing rules of a DECISION node. Branches are evaluated in order and
+// the first branch whose conditions all match determines the next node.
+type DecisionDefinition struct {
+	Branches []DecisionBranchDefinition `json:"branches" yaml:"branches" jsonschema:"Ordered list of branches. The first branch whose conditions all match is taken."`
+	Default  string                     `json:"default"  yaml:"default"  jsonschema:"ID of the node to route to when no branch matches."`
+}
+
+// DecisionBranchDefinition routes to a node when all of its conditions match.
+type DecisionBranchDefinition struct {
+	Conditions []DecisionConditionDefinition `json:"conditions" yaml:"conditions" jsonschema:"Conditions that must all match for this branch to be taken."`
+	Next       string                        `json:"next"       yaml:"next"       jsonschema:"ID of the node to route to when this branch is taken."`
+}
+
+// DecisionConditionDefinition compares a value resolved from the flow context against a literal.
+type DecisionConditionDefinition struct {
+	Key      string           `json:"key"             yaml:"key"             jsonschema:"Value to evaluate. Supports {{ctx(key)}} placeholders for runtime data and user inputs and {{user(attribute)}} placeholders for attributes of the authenticated user. Example: '{{ctx(userType)}}'"`
+	Operator DecisionOperator `json:"operator"        yaml:"operator"        jsonschema:"Comparison to apply: EQUALS, NOT_EQUALS, IN, NOT_IN, GREATER_THAN, GREATER_THAN_OR_EQUAL, LESS_THAN, LESS_THAN_OR_EQUAL, EXISTS or NOT_EXISTS."`
+	Value    string           `json:"value,omitempty" yaml:"value,omitempty" jsonschema:"Literal to compare against. Comma-separated for IN and NOT_IN. Not used by EXISTS and NOT_EXISTS."`
+}
+
+// ParallelDefinition holds the branches of a PARALLEL node. Each branch is a chain of TASK_EXECUTION
+// nodes, linked through onSuccess, that ends at the join node.
+type ParallelDefinition struct {
+	Branches []string `json:"branches" yaml:"branches" jsonschema:"IDs of the first TASK_EXECUTION node of each branch to run concurrently."`
+	Join     string   `json:"join"     yaml:"join"     jsonschema:"ID of the JOIN node where all branches converge."`
+}
+
+// JoinDefinition holds the completion semantics of a JOIN node.
+type JoinDefinition struct {
+	Mode JoinMode `json:"mode,omitempty" yaml:"mode,omitempty" jsonschema:"ALL to require every branch to succeed or ANY to require at least one. Defaults to ALL."`
 }
 
 type nodeDefinitionAlias NodeDefinition
@@ -345,9 +384,19 @@ type PromptDefinition struct {
 
 // ExecutorDefinition represents the executor configuration for a node.
 type ExecutorDefinition struct {
-	Name   string            `json:"name"             yaml:"name"             jsonschema:"Name of the executor (e.g., 'UsernamePasswordAuthenticator')."`
-	Mode   string            `json:"mode,omitempty"   yaml:"mode,omitempty"   jsonschema:"Execution mode or configuration."`
-	Inputs []InputDefinition `json:"inputs,omitempty" yaml:"inputs,omitempty" jsonschema:"Static inputs or configuration parameters for the executor."`
+	Name           string                    `json:"name"             yaml:"name"             jsonschema:"Name of the executor (e.g., 'UsernamePasswordAuthenticator')."`
+	Mode           string                    `json:"mode,omitempty"   yaml:"mode,omitempty"   jsonschema:"Execution mode or configuration."`
+	Inputs         []InputDefinition         `json:"inputs,omitempty" yaml:"inputs,omitempty" jsonschema:"Static inputs or configuration parameters for the executor."`
+	RetryCount     int                       `json:"retryCount,omitempty" yaml:"retryCount,omitempty" jsonschema:"Number of times the executor is re-executed when it fails with an error. Defaults to 0."`
+	RetryDelay     int                       `json:"retryDelay,omitempty" yaml:"retryDelay,omitempty" jsonschema:"Delay in milliseconds between executor retries. Defaults to 0."`
+	Timeout        int                       `json:"timeout,omitempty"    yaml:"timeout,omitempty"    jsonschema:"Maximum execution time of the node in milliseconds, including retries. Defaults to no limit."`
+	CircuitBreaker *CircuitBreakerDefinition `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty" jsonschema:"Optional circuit breaker that fails the node fast while the executor keeps failing with errors."`
+}
+
+// CircuitBreakerDefinition represents the circuit breaker configuration for an executor.
+type CircuitBreakerDefinition struct {
+	FailureThreshold int `json:"failureThreshold,omitempty" yaml:"failureThreshold,omitempty" jsonschema:"Number of consecutive executor errors that open the breaker. Defaults to 5."`
+	OpenDuration     int `json:"openDuration,omitempty"     yaml:"openDuration,omitempty"     jsonschema:"Time in milliseconds the breaker stays open before a trial execution is allowed. Defaults to 30000."`
 }
 
 // ConditionDefinition represents a condition for node execution.
@@ -558,6 +607,8 @@ type OAuthClient struct {
 	OUID                               string                  `yaml:"ouId,omitempty"`
 	ClientID                           string                  `yaml:"clientId,omitempty"`
 	RedirectURIs                       []string                `yaml:"redirectUris,omitempty"`
+	RedirectURIMatching                RedirectURIMatching     `yaml:"redirectUriMatching,omitempty"`
+	ApplicationType                    ApplicationType         `yaml:"applicationType,omitempty"`
 	GrantTypes                         []GrantType             `yaml:"grantTypes,omitempty"`
 	ResponseTypes                      []ResponseType          `yaml:"responseTypes,omitempty"`
 	TokenEndpointAuthMethod            TokenEndpointAuthMethod `yaml:"tokenEndpointAuthMethod,omitempty"`
@@ -566,6 +617,7 @@ type OAuthClient struct {
 	RequirePushedAuthorizationRequests bool                    `yaml:"requirePushedAuthorizationRequests,omitempty"`
 	DPoPBoundAccessTokens              bool                    `yaml:"dpopBoundAccessTokens,omitempty"`
 	IncludeActClaim                    bool                    `yaml:"includeActClaim,omitempty"`
+	EnforceEssentialClaims             bool                    `yaml:"enforceEssentialClaims,omitempty"`
 	EntityCategory                     EntityCategory          `yaml:"entityCategory,omitempty"`
 	Token                              *OAuthTokenConfig       `yaml:"token,omitempty"`
 	Scopes                             []string                `yaml:"scopes,omitempty"`
@@ -573,6 +625,8 @@ type OAuthClient struct {
 	ScopeClaims                        map[string][]string     `yaml:"scopeClaims,omitempty"`
 	Certificate                        *Certificate            `yaml:"certificate,omitempty"`
 	AcrValues                          []string                `yaml:"acrValues,omitempty"`
+	AllowedOrigins                     []string                `yaml:"allowedOrigins,omitempty"`
+	AllowedNetworks                    []string                `yaml:"allowedNetworks,omitempty"`
 }
 
 // OAuthTokenConfig wraps access and ID token configs.
@@ -580,14 +634,45 @@ type OAuthTokenConfig struct {
 	AccessToken  *AccessTokenConfig  `json:"accessToken,omitempty"  yaml:"accessToken,omitempty"  jsonschema:"Access token configuration."`
 	IDToken      *IDTokenConfig      `json:"idToken,omitempty"      yaml:"idToken,omitempty"      jsonschema:"ID token configuration."`
 	RefreshToken *RefreshTokenConfig `json:"refreshToken,omitempty" yaml:"refreshToken,omitempty" jsonschema:"Refresh token configuration."`
+	// GrantValidityPeriods overrides token validity periods per grant type. Tokens renewed through the
+	// refresh_token grant keep the validity periods of the grant that originally issued them.
+	GrantValidityPeriods map[string]*GrantValidityPeriods `json:"grantValidityPeriods,omitempty" yaml:"grantValidityPeriods,omitempty" jsonschema:"Token validity periods in seconds overridden per grant type, keyed by grant type."`
+}
+
+// GrantValidityPeriods holds the token validity periods applied to tokens issued by one grant type.
+// A zero value keeps the application-level validity period of that token type.
+type GrantValidityPeriods struct {
+	AccessToken  int64 `json:"accessToken,omitempty"  yaml:"accessToken,omitempty"  jsonschema:"Access token validity period in seconds."`
+	RefreshToken int64 `json:"refreshToken,omitempty" yaml:"refreshToken,omitempty" jsonschema:"Refresh token validity period in seconds."`
+	IDToken      int64 `json:"idToken,omitempty"      yaml:"idToken,omitempty"      jsonschema:"ID token validity period in seconds."`
+}
+
+// GrantValidityPeriodsFor returns the validity period overrides for the given grant type, or nil
+// when the grant type has none.
+func (c *OAuthTokenConfig) GrantValidityPeriodsFor(grantType string) *GrantValidityPeriods {
+	if c == nil || grantType == "" {
+		return nil
+	}
+	return c.GrantValidityPeriods[grantType]
 }
 
 // AccessTokenConfig is the access token configuration, split by token subject: an end user
 // (UserConfig) or the OAuth client itself, issued only via the client_credentials grant
 // (ClientConfig).
 type AccessTokenConfig struct {
-	UserConfig   *AccessTokenSubConfig `json:"userConfig,omitempty"   yaml:"userConfig,omitempty"   jsonschema:"Access token configuration applied when the token subject is an end user."`
-	ClientConfig *AccessTokenSubConfig `json:"clientConfig,omitempty" yaml:"clientConfig,omitempty" jsonschema:"Access token configuration applied when the token subject is the OAuth client itself, issued only via the client_credentials grant."`
+	UserConfig   *AccessTokenSubConfig    `json:"userConfig,omitempty"   yaml:"userConfig,omitempty"   jsonschema:"Access token configuration applied when the token subject is an end user."`
+	ClientConfig *AccessTokenSubConfig    `json:"clientConfig,omitempty" yaml:"clientConfig,omitempty" jsonschema:"Access token configuration applied when the token subject is the OAuth client itself, issued only via the client_credentials grant."`
+	SigningAlg   string                   `json:"signingAlg,omitempty"   yaml:"signingAlg,omitempty"   jsonschema:"JWS algorithm used to sign access tokens. Defaults to the algorithm of the active signing key."`
+	ClaimsPolicy *AccessTokenClaimsPolicy `json:"claimsPolicy,omitempty" yaml:"claimsPolicy,omitempty" jsonschema:"Policy applied to the subject attributes embedded in access tokens, to keep personal data out of tokens that resource servers may log."`
+}
+
+// AccessTokenClaimsPolicy controls how subject attributes are embedded in access tokens. Attributes
+// can be left out, replaced with a hash of their value, or embedded only when the token is granted
+// a specific scope. System claims such as sub, scope and client_id are not affected.
+type AccessTokenClaimsPolicy struct {
+	ExcludedClaims []string          `json:"excludedClaims,omitempty" yaml:"excludedClaims,omitempty" jsonschema:"Attributes never embedded in access tokens."`
+	HashedClaims   []string          `json:"hashedClaims,omitempty"   yaml:"hashedClaims,omitempty"   jsonschema:"Attributes embedded as the base64url-encoded SHA-256 hash of their value instead of the value itself."`
+	ScopedClaims   map[string]string `json:"scopedClaims,omitempty"   yaml:"scopedClaims,omitempty"   jsonschema:"Attributes embedded only when the access token is granted the mapped scope, keyed by attribute name."`
 }
 
 // AccessTokenSubConfig holds the validity period and attribute selection for one access
@@ -612,11 +697,17 @@ type IDTokenConfig struct {
 	ResponseType   IDTokenResponseType `json:"responseType,omitempty"   yaml:"responseType,omitempty"   jsonschema:"ID token response type (JWT, JWE, NESTED_JWT). Defaults to JWT."`
 	EncryptionAlg  string              `json:"encryptionAlg,omitempty"  yaml:"encryptionAlg,omitempty"  jsonschema:"JWE key-management algorithm. Required when responseType is JWE or NESTED_JWT."`
 	EncryptionEnc  string              `json:"encryptionEnc,omitempty"  yaml:"encryptionEnc,omitempty"  jsonschema:"JWE content-encryption algorithm. Required when responseType is JWE or NESTED_JWT."`
+	SigningAlg     string              `json:"signingAlg,omitempty"     yaml:"signingAlg,omitempty"     jsonschema:"JWS algorithm used to sign ID tokens. Defaults to the algorithm of the active signing key."`
 }
 
 // RefreshTokenConfig is the refresh token configuration.
 type RefreshTokenConfig struct {
 	ValidityPeriod int64 `json:"validityPeriod,omitempty" yaml:"validityPeriod,omitempty" jsonschema:"Refresh token validity period in seconds."`
+	// SlidingExpiry issues a new refresh token with a fresh validity period on every use.
+	SlidingExpiry bool `json:"slidingExpiry,omitempty" yaml:"slidingExpiry,omitempty" jsonschema:"Issue a new refresh token with a fresh validity period on every use."`
+	// MaxLifetime caps the refresh token chain, counted from the original sign-in. Once it passes the
+	// user must re-authenticate, regardless of sliding expiry.
+	MaxLifetime int64 `json:"maxLifetime,omitempty" yaml:"maxLifetime,omitempty" jsonschema:"Absolute refresh token lifetime in seconds counted from the original sign-in. 0 means no limit."`
 }
 
 // UserInfoConfig is the user info endpoint configuration.
@@ -637,6 +728,8 @@ type Certificate struct {
 // OAuthProfile is the persistence shape (OAUTH_PROFILE JSONB column).
 type OAuthProfile struct {
 	RedirectURIs                       []string            `json:"redirectUris"`
+	RedirectURIMatching                string              `json:"redirectUriMatching,omitempty"`
+	ApplicationType                    string              `json:"applicationType,omitempty"`
 	GrantTypes                         []string            `json:"grantTypes"`
 	ResponseTypes                      []string            `json:"responseTypes"`
 	TokenEndpointAuthMethod            string              `json:"tokenEndpointAuthMethod"`
@@ -645,12 +738,15 @@ type OAuthProfile struct {
 	RequirePushedAuthorizationRequests bool                `json:"requirePushedAuthorizationRequests"`
 	DPoPBoundAccessTokens              bool                `json:"dpopBoundAccessTokens"`
 	IncludeActClaim                    bool                `json:"includeActClaim"`
+	EnforceEssentialClaims             bool                `json:"enforceEssentialClaims"`
 	Token                              *OAuthTokenConfig   `json:"token,omitempty"`
 	Scopes                             []string            `json:"scopes,omitempty"`
 	UserInfo                           *UserInfoConfig     `json:"userInfo,omitempty"`
 	ScopeClaims                        map[string][]string `json:"scopeClaims,omitempty"`
 	Certificate                        *Certificate        `json:"certificate,omitempty"`
 	AcrValues                          []string            `json:"acrValues,omitempty"`
+	AllowedOrigins                     []string            `json:"allowedOrigins,omitempty"`
+	AllowedNetworks                    []string            `json:"allowedNetworks,omitempty"`
 }
 
 // InboundClient is the persistence shape for protocol-agnostic inbound client record.
@@ -666,6 +762,8 @@ type InboundClient struct {
 	Assertion                 *AssertionConfig
 	LoginConsent              *LoginConsentConfig
 	AllowedUserTypes          []string
+	Sharing                   *ApplicationSharing
+	AccessPolicy              *ApplicationAccessPolicy
 	Properties                map[string]interface{}
 	IsReadOnly                bool
 }
@@ -692,6 +790,7 @@ type Entity struct {
 	Attributes       json.RawMessage `json:"attributes,omitempty"`
 	SystemAttributes json.RawMessage `json:"systemAttributes,omitempty"`
 	IsReadOnly       bool            `json:"isReadOnly"`
+	DeletedAt        *time.Time      `json:"deletedAt,omitempty"`
 }
 
 // EntityGroup represents a group with basic information for entity group membership queries.
@@ -787,6 +886,9 @@ type ConsentPromptData struct {
 	Purposes []ConsentPurposePrompt `json:"purposes"`
 	// SessionToken is the signed JWT token that encapsulates the consent session data
 	SessionToken string `json:"sessionToken,omitempty"`
+	// DeclinedPermissions is the list of authorized permissions the user declined in an active consent.
+	// These permissions are not prompted again and must be excluded from the issued grant
+	DeclinedPermissions []string `json:"-"`
 }
 
 // ConsentPurposePrompt holds a single consent purpose's elements that need user consent.
@@ -934,19 +1036,115 @@ type Application struct {
 
 // InboundAuthProfile is the wire field block embedded in entity DTOs (requests and responses).
 type InboundAuthProfile struct {
-	AuthFlowID                string              `json:"authFlowId,omitempty"             yaml:"authFlowId,omitempty"             jsonschema:"Authentication flow ID. Optional. Specifies which login flow to use (e.g., MFA, passwordless). If omitted, the default authentication flow is used."`
-	AuthFlowHandle            string              `json:"authFlowHandle,omitempty"         yaml:"authFlowHandle,omitempty"         jsonschema:"Authentication flow handle. Optional. Alternative to authFlowId — resolved to an ID at import time."`
-	RegistrationFlowID        string              `json:"registrationFlowId,omitempty"     yaml:"registrationFlowId,omitempty"     jsonschema:"Registration flow ID. Optional. Specifies the user registration/signup flow."`
-	RegistrationFlowHandle    string              `json:"registrationFlowHandle,omitempty" yaml:"registrationFlowHandle,omitempty" jsonschema:"Registration flow handle. Optional. Alternative to registrationFlowId — resolved to an ID at import time."`
-	IsRegistrationFlowEnabled bool                `json:"isRegistrationFlowEnabled"        yaml:"isRegistrationFlowEnabled"        jsonschema:"Enable self-service registration. Set to true to allow users to sign up themselves. Requires registrationFlowId or registrationFlowHandle to be set."`
-	RecoveryFlowID            string              `json:"recoveryFlowId,omitempty"         yaml:"recoveryFlowId,omitempty"         jsonschema:"Recovery flow ID. Optional. Specifies the user recovery flow."`
-	RecoveryFlowHandle        string              `json:"recoveryFlowHandle,omitempty"     yaml:"recoveryFlowHandle,omitempty"     jsonschema:"Recovery flow handle. Optional. Alternative to recoveryFlowId — resolved to an ID at import time."`
-	IsRecoveryFlowEnabled     bool                `json:"isRecoveryFlowEnabled"            yaml:"isRecoveryFlowEnabled"            jsonschema:"Enable self-service recovery. Set to true to allow users to recover their accounts (e.g., password reset). Requires recoveryFlowId or recoveryFlowHandle to be set."`
-	ThemeID                   string              `json:"themeId,omitempty"                yaml:"themeId,omitempty"                jsonschema:"Theme configuration ID. Optional. Customizes the visual styling of login pages."`
-	LayoutID                  string              `json:"layoutId,omitempty"               yaml:"layoutId,omitempty"               jsonschema:"Layout configuration ID. Optional. Customizes the screen structure and component positioning of login pages."`
-	Assertion                 *AssertionConfig    `json:"assertion,omitempty"              yaml:"assertion,omitempty"              jsonschema:"Assertion configuration. Optional. Customize assertion validity periods and included user attributes."`
-	LoginConsent              *LoginConsentConfig `json:"loginConsent,omitempty"           yaml:"loginConsent,omitempty"           jsonschema:"Login consent configuration settings."`
-	AllowedUserTypes          []string            `json:"allowedUserTypes,omitempty"       yaml:"allowedUserTypes,omitempty"       jsonschema:"Allowed user types. Optional. Restricts which user types can authenticate to and register against this resource."`
+	AuthFlowID                string                   `json:"authFlowId,omitempty"             yaml:"authFlowId,omitempty"             jsonschema:"Authentication flow ID. Optional. Specifies which login flow to use (e.g., MFA, passwordless). If omitted, the default authentication flow is used."`
+	AuthFlowHandle            string                   `json:"authFlowHandle,omitempty"         yaml:"authFlowHandle,omitempty"         jsonschema:"Authentication flow handle. Optional. Alternative to authFlowId — resolved to an ID at import time."`
+	RegistrationFlowID        string                   `json:"registrationFlowId,omitempty"     yaml:"registrationFlowId,omitempty"     jsonschema:"Registration flow ID. Optional. Specifies the user registration/signup flow."`
+	RegistrationFlowHandle    string                   `json:"registrationFlowHandle,omitempty" yaml:"registrationFlowHandle,omitempty" jsonschema:"Registration flow handle. Optional. Alternative to registrationFlowId — resolved to an ID at import time."`
+	IsRegistrationFlowEnabled bool                     `json:"isRegistrationFlowEnabled"        yaml:"isRegistrationFlowEnabled"        jsonschema:"Enable self-service registration. Set to true to allow users to sign up themselves. Requires registrationFlowId or registrationFlowHandle to be set."`
+	RecoveryFlowID            string                   `json:"recoveryFlowId,omitempty"         yaml:"recoveryFlowId,omitempty"         jsonschema:"Recovery flow ID. Optional. Specifies the user recovery flow."`
+	RecoveryFlowHandle        string                   `json:"recoveryFlowHandle,omitempty"     yaml:"recoveryFlowHandle,omitempty"     jsonschema:"Recovery flow handle. Optional. Alternative to recoveryFlowId — resolved to an ID at import time."`
+	IsRecoveryFlowEnabled     bool                     `json:"isRecoveryFlowEnabled"            yaml:"isRecoveryFlowEnabled"            jsonschema:"Enable self-service recovery. Set to true to allow users to recover their accounts (e.g., password reset). Requires recoveryFlowId or recoveryFlowHandle to be set."`
+	ThemeID                   string                   `json:"themeId,omitempty"                yaml:"themeId,omitempty"                jsonschema:"Theme configuration ID. Optional. Customizes the visual styling of login pages."`
+	LayoutID                  string                   `json:"layoutId,omitempty"               yaml:"layoutId,omitempty"               jsonschema:"Layout configuration ID. Optional. Customizes the screen structure and component positioning of login pages."`
+	Assertion                 *AssertionConfig         `json:"assertion,omitempty"              yaml:"assertion,omitempty"              jsonschema:"Assertion configuration. Optional. Customize assertion validity periods and included user attributes."`
+	LoginConsent              *LoginConsentConfig      `json:"loginConsent,omitempty"           yaml:"loginConsent,omitempty"           jsonschema:"Login consent configuration settings."`
+	AllowedUserTypes          []string                 `json:"allowedUserTypes,omitempty"       yaml:"allowedUserTypes,omitempty"       jsonschema:"Allowed user types. Optional. Restricts which user types can authenticate to and register against this resource."`
+	Sharing                   *ApplicationSharing      `json:"sharing,omitempty"                yaml:"sharing,omitempty"                jsonschema:"Organization unit sharing configuration. Optional. Shares the resource with organization units and defines per-OU login overrides."`
+	AccessPolicy              *ApplicationAccessPolicy `json:"accessPolicy,omitempty"           yaml:"accessPolicy,omitempty"           jsonschema:"Network and geolocation access policy. Optional. Restricts the client IP addresses and countries allowed to authenticate."`
+}
+
+// ApplicationAccessPolicy restricts the client networks and countries allowed to authenticate to an
+// inbound client. Denied entries take precedence; when any allow list is set the client must match one.
+type ApplicationAccessPolicy struct {
+	AllowedNetworks  []string `json:"allowedNetworks,omitempty"  yaml:"allowedNetworks,omitempty"  jsonschema:"IP addresses or CIDR ranges allowed to authenticate."`
+	DeniedNetworks   []string `json:"deniedNetworks,omitempty"   yaml:"deniedNetworks,omitempty"   jsonschema:"IP addresses or CIDR ranges denied from authenticating."`
+	AllowedCountries []string `json:"allowedCountries,omitempty" yaml:"allowedCountries,omitempty" jsonschema:"ISO 3166-1 alpha-2 country codes allowed to authenticate."`
+	DeniedCountries  []string `json:"deniedCountries,omitempty"  yaml:"deniedCountries,omitempty"  jsonschema:"ISO 3166-1 alpha-2 country codes denied from authenticating."`
+}
+
+// IsEmpty reports whether the policy places no restriction on the client.
+func (p *ApplicationAccessPolicy) IsEmpty() bool {
+	return p == nil || (len(p.AllowedNetworks) == 0 && len(p.DeniedNetworks) == 0 &&
+		len(p.AllowedCountries) == 0 && len(p.DeniedCountries) == 0)
+}
+
+// ApplicationSharing is the organization unit sharing configuration of an inbound client.
+type ApplicationSharing struct {
+	OrganizationUnits []SharedOrganizationUnit `json:"organizationUnits,omitempty" yaml:"organizationUnits,omitempty" jsonschema:"Organization units the resource is shared with."`
+}
+
+// SharedOrganizationUnit holds the per-OU overrides applied when a login is routed to a shared OU.
+type SharedOrganizationUnit struct {
+	OUID               string   `json:"ouId,omitempty"               yaml:"ouId,omitempty"               jsonschema:"Organization unit ID. Either ouId or ouHandle is required."`
+	OUHandle           string   `json:"ouHandle,omitempty"           yaml:"ouHandle,omitempty"           jsonschema:"Organization unit handle. Used as the orgHandle hint at the authorize endpoint."`
+	EmailDomains       []string `json:"emailDomains,omitempty"       yaml:"emailDomains,omitempty"       jsonschema:"Email domains routed to this organization unit through login_hint matching."`
+	AllowedUserTypes   []string `json:"allowedUserTypes,omitempty"   yaml:"allowedUserTypes,omitempty"   jsonschema:"Allowed user types override for this organization unit."`
+	AuthFlowID         string   `json:"authFlowId,omitempty"         yaml:"authFlowId,omitempty"         jsonschema:"Authentication flow ID override for this organization unit."`
+	RegistrationFlowID string   `json:"registrationFlowId,omitempty" yaml:"registrationFlowId,omitempty" jsonschema:"Registration flow ID override for this organization unit."`
+	ThemeID            string   `json:"themeId,omitempty"            yaml:"themeId,omitempty"            jsonschema:"Theme ID override for this organization unit."`
+	LayoutID           string   `json:"layoutId,omitempty"           yaml:"layoutId,omitempty"           jsonschema:"Layout ID override for this organization unit."`
+}
+
+// FindByOUID returns the shared organization unit with the given ID, or nil when not shared.
+func (s *ApplicationSharing) FindByOUID(ouID string) *SharedOrganizationUnit {
+	if s == nil || ouID == "" {
+		return nil
+	}
+	for i := range s.OrganizationUnits {
+		if s.OrganizationUnits[i].OUID == ouID {
+			return &s.OrganizationUnits[i]
+		}
+	}
+	return nil
+}
+
+// FindByOUHandle returns the shared organization unit with the given handle, or nil when not shared.
+func (s *ApplicationSharing) FindByOUHandle(handle string) *SharedOrganizationUnit {
+	if s == nil || handle == "" {
+		return nil
+	}
+	for i := range s.OrganizationUnits {
+		if strings.EqualFold(s.OrganizationUnits[i].OUHandle, handle) {
+			return &s.OrganizationUnits[i]
+		}
+	}
+	return nil
+}
+
+// FindByEmailDomain returns the shared organization unit that claims the domain of the given
+// email address, or nil when no shared organization unit matches.
+func (s *ApplicationSharing) FindByEmailDomain(email string) *SharedOrganizationUnit {
+	if s == nil {
+		return nil
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return nil
+	}
+	domain := email[at+1:]
+	for i := range s.OrganizationUnits {
+		for _, d := range s.OrganizationUnits[i].EmailDomains {
+			if strings.EqualFold(d, domain) {
+				return &s.OrganizationUnits[i]
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationBranding is the per-application branding rendered by the login UI.
+type ApplicationBranding struct {
+	LogoURL       string                       `json:"logoUrl,omitempty"       yaml:"logoUrl,omitempty"       jsonschema:"Logo image URL shown on the login pages." native:"omitempty,url,max=2048"`
+	Colors        *BrandingColors              `json:"colors,omitempty"        yaml:"colors,omitempty"        jsonschema:"Brand colors as hex values."`
+	LocalizedText map[string]map[string]string `json:"localizedText,omitempty" yaml:"localizedText,omitempty" jsonschema:"Display text keyed by language tag and then by text key."`
+}
+
+// BrandingColors holds the brand colors of an application as hex color values.
+type BrandingColors struct {
+	Primary    string `json:"primary,omitempty"    yaml:"primary,omitempty"`
+	Secondary  string `json:"secondary,omitempty"  yaml:"secondary,omitempty"`
+	Background string `json:"background,omitempty" yaml:"background,omitempty"`
+	Text       string `json:"text,omitempty"       yaml:"text,omitempty"`
 }
 
 // OAuthConfigWithSecret is the wire input shape and the create/update echo response shape.
@@ -955,6 +1153,8 @@ type OAuthConfigWithSecret struct {
 	ClientID                           string                  `json:"clientId,omitempty"                 yaml:"clientId,omitempty"                 jsonschema:"OAuth client ID (auto-generated if not provided)"`
 	ClientSecret                       string                  `json:"clientSecret,omitempty"             yaml:"clientSecret,omitempty"             jsonschema:"OAuth client secret (auto-generated if not provided)"`
 	RedirectURIs                       []string                `json:"redirectUris,omitempty"             yaml:"redirectUris,omitempty"             jsonschema:"Allowed redirect URIs. Required for Public (SPA/Mobile) and Confidential (Server) clients. Omit for M2M."`
+	RedirectURIMatching                RedirectURIMatching     `json:"redirectUriMatching,omitempty"      yaml:"redirectUriMatching,omitempty"      jsonschema:"Redirect URI matching policy: 'exact' (default) or 'loopback', which also accepts any port on registered http loopback URIs for native apps (RFC 8252)."`
+	ApplicationType                    ApplicationType         `json:"applicationType,omitempty"          yaml:"applicationType,omitempty"          jsonschema:"Application type: 'web', 'native' or 'spa'. Native apps may use custom scheme and claimed https redirect URIs; native and SPA apps must not use client secrets and require PKCE."`
 	GrantTypes                         []GrantType             `json:"grantTypes,omitempty"               yaml:"grantTypes,omitempty"               jsonschema:"OAuth grant types. Common: [authorization_code, refresh_token] for user apps, [client_credentials] for M2M."`
 	ResponseTypes                      []ResponseType          `json:"responseTypes,omitempty"            yaml:"responseTypes,omitempty"            jsonschema:"OAuth response types. Common: [code] for user apps. Omit for M2M."`
 	TokenEndpointAuthMethod            TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"  yaml:"tokenEndpointAuthMethod,omitempty"  jsonschema:"Client authentication method. Use 'none' for Public clients, 'client_secret_basic' for Confidential/M2M."`
@@ -963,12 +1163,15 @@ type OAuthConfigWithSecret struct {
 	RequirePushedAuthorizationRequests bool                    `json:"requirePushedAuthorizationRequests" yaml:"requirePushedAuthorizationRequests" jsonschema:"Require Pushed Authorization Requests (PAR) per RFC 9126."`
 	DPoPBoundAccessTokens              bool                    `json:"dpopBoundAccessTokens"              yaml:"dpopBoundAccessTokens"              jsonschema:"Require DPoP-bound access tokens (RFC 9449)."`
 	IncludeActClaim                    bool                    `json:"includeActClaim"                    yaml:"includeActClaim"                    jsonschema:"Include an implicit on-behalf-of 'act' claim (identifying the application entity) in access tokens issued through this client's authorization code flow. Agents always include it regardless of this setting."`
+	EnforceEssentialClaims             bool                    `json:"enforceEssentialClaims"             yaml:"enforceEssentialClaims"             jsonschema:"Fail the authorization request when an essential claim requested through the claims parameter cannot be provided, instead of omitting it."`
 	Token                              *OAuthTokenConfig       `json:"token,omitempty"                    yaml:"token,omitempty"                    jsonschema:"Token configuration for access tokens and ID tokens"`
 	Scopes                             []string                `json:"scopes,omitempty"                   yaml:"scopes,omitempty"                   jsonschema:"Allowed OAuth scopes. Add custom scopes as needed for your application."`
 	UserInfo                           *UserInfoConfig         `json:"userInfo,omitempty"                 yaml:"userInfo,omitempty"                 jsonschema:"UserInfo endpoint configuration. Configure user attributes returned from the OIDC userinfo endpoint."`
 	ScopeClaims                        map[string][]string     `json:"scopeClaims,omitempty"              yaml:"scopeClaims,omitempty"              jsonschema:"Scope-to-claims mapping. Maps OAuth scopes to user claims for both ID token and userinfo."`
 	Certificate                        *Certificate            `json:"certificate,omitempty"              yaml:"certificate,omitempty"              jsonschema:"Application certificate. Optional. For certificate-based authentication or JWT validation."`
 	AcrValues                          []string                `json:"acrValues,omitempty"                yaml:"acrValues,omitempty"                jsonschema:"Default ACR values applied when the request does not specify acr_values."`
+	AllowedOrigins                     []string                `json:"allowedOrigins,omitempty"           yaml:"allowedOrigins,omitempty"           jsonschema:"Browser origins allowed to call the token, userinfo, and flow execution endpoints cross-origin. Defaults to the origins of the http(s) redirect URIs."`
+	AllowedNetworks                    []string                `json:"allowedNetworks,omitempty"          yaml:"allowedNetworks,omitempty"          jsonschema:"CIDR ranges or IP addresses the client may request tokens from. Empty allows any network."`
 }
 
 // InboundAuthConfigWithSecret is the wire input wrapper and create/update echo response wrapper.
@@ -348,3 +348,26 @@ func (suite *ModelTestSuite) TestNodeContext_ConsumeInput_AccumulatesAcrossCalls
 
 	assert.Equal(suite.T(), []string{"a", "c", "b"}, nc.GetConsumedInputs())
 }
+
+func (s *ModelTestSuite) TestApplicationSharing_Lookups() {
+	sharing := &ApplicationSharing{
+		OrganizationUnits: []SharedOrganizationUnit{
+			{OUID: "ou-acme", OUHandle: "acme", EmailDomains: []string{"acme.com"}},
+			{OUID: "ou-globex", OUHandle: "globex"},
+		},
+	}
+
+	assert.Equal(s.T(), "globex", sharing.FindByOUID("ou-globex").OUHandle)
+	assert.Nil(s.T(), sharing.FindByOUID(""))
+	assert.Equal(s.T(), "ou-acme", sharing.FindByOUHandle("ACME").OUID)
+	assert.Nil(s.T(), sharing.FindByOUHandle("initech"))
+	assert.Equal(s.T(), "ou-acme", sharing.FindByEmailDomain("alice@Acme.com").OUID)
+	assert.Nil(s.T(), sharing.FindByEmailDomain("alice@globex.com"))
+	assert.Nil(s.T(), sharing.FindByEmailDomain("alice"))
+	assert.Nil(s.T(), sharing.FindByEmailDomain("alice@"))
+
+	var nilSharing *ApplicationSharing
+	assert.Nil(s.T(), nilSharing.FindByOUID("ou-acme"))
+	assert.Nil(s.T(), nilSharing.FindByOUHandle("acme"))
+	assert.Nil(s.T(), nilSharing.FindByEmailDomain("alice@acme.com"))
+}
@@ -21,6 +21,7 @@ package providers
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"slices"
 	"strings"
@@ -61,8 +62,13 @@ func (o *OAuthClient) IsAllowedTokenEndpointAuthMethod(method TokenEndpointAuthM
 	return o.TokenEndpointAuthMethod == method
 }
 
-// ValidateRedirectURI validates the given redirect URI against this client's registered URIs.
+// ValidateRedirectURI validates the given redirect URI against this client's registered URIs,
+// applying the client's redirect URI matching policy.
 func (o *OAuthClient) ValidateRedirectURI(ctx context.Context, redirectURI string) error {
+	if o.RedirectURIMatching == RedirectURIMatchingLoopback && redirectURI != "" &&
+		matchAnyLoopbackRedirectURI(o.RedirectURIs, redirectURI) {
+		return nil
+	}
 	return ValidateRedirectURI(ctx, o.RedirectURIs, redirectURI)
 }
 
@@ -101,6 +107,56 @@ func (o *OAuthClient) ClientAccessTokenConfig() *AccessTokenSubConfig {
 	return o.Token.AccessToken.ClientConfig
 }
 
+// AccessTokenClaimsPolicy returns the claims policy applied to access tokens, or nil if unset.
+func (o *OAuthClient) AccessTokenClaimsPolicy() *AccessTokenClaimsPolicy {
+	if o == nil || o.Token == nil || o.Token.AccessToken == nil {
+		return nil
+	}
+	return o.Token.AccessToken.ClaimsPolicy
+}
+
+// RefreshTokenConfig returns the refresh token configuration, or nil if unset.
+func (o *OAuthClient) RefreshTokenConfig() *RefreshTokenConfig {
+	if o == nil || o.Token == nil {
+		return nil
+	}
+	return o.Token.RefreshToken
+}
+
+// HasSlidingRefreshExpiry reports whether every use of a refresh token issues a new one with a
+// fresh validity period.
+func (o *OAuthClient) HasSlidingRefreshExpiry() bool {
+	cfg := o.RefreshTokenConfig()
+	return cfg != nil && cfg.SlidingExpiry
+}
+
+// RefreshTokenMaxLifetime returns the absolute refresh token lifetime in seconds, or 0 when unlimited.
+func (o *OAuthClient) RefreshTokenMaxLifetime() int64 {
+	cfg := o.RefreshTokenConfig()
+	if cfg == nil || cfg.MaxLifetime < 0 {
+		return 0
+	}
+	return cfg.MaxLifetime
+}
+
+// AccessTokenSigningAlg returns the JWS algorithm configured for access tokens, or an empty string
+// when the active signing key's algorithm applies.
+func (o *OAuthClient) AccessTokenSigningAlg() string {
+	if o == nil || o.Token == nil || o.Token.AccessToken == nil {
+		return ""
+	}
+	return o.Token.AccessToken.SigningAlg
+}
+
+// IDTokenSigningAlg returns the JWS algorithm configured for ID tokens, or an empty string when the
+// active signing key's algorithm applies.
+func (o *OAuthClient) IDTokenSigningAlg() string {
+	if o == nil || o.Token == nil || o.Token.IDToken == nil {
+		return ""
+	}
+	return o.Token.IDToken.SigningAlg
+}
+
 // ValidateRedirectURI validates the provided redirect URI against the registered list.
 func ValidateRedirectURI(ctx context.Context, redirectURIs []string, redirectURI string) error {
 	logger := log.GetLogger()
@@ -154,3 +210,33 @@ func matchAnyRedirectURIPattern(patterns []string, redirectURI string) bool {
 	}
 	return false
 }
+
+// matchAnyLoopbackRedirectURI reports whether the redirect URI matches a registered http loopback
+// URI in everything but the port, which native apps pick at runtime (RFC 8252 §7.3).
+func matchAnyLoopbackRedirectURI(registeredURIs []string, redirectURI string) bool {
+	requested, err := url.Parse(redirectURI)
+	if err != nil || requested.Scheme != "http" || requested.User != nil || requested.Fragment != "" ||
+		!IsLoopbackHost(requested.Hostname()) {
+		return false
+	}
+	for _, registeredURI := range registeredURIs {
+		registered, err := url.Parse(registeredURI)
+		if err != nil || registered.Scheme != "http" {
+			continue
+		}
+		if strings.EqualFold(registered.Hostname(), requested.Hostname()) &&
+			registered.EscapedPath() == requested.EscapedPath() && registered.RawQuery == requested.RawQuery {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLoopbackHost reports whether the host is a loopback IP literal or localhost.
+func IsLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -205,6 +205,36 @@ func (suite *OAuthClientTestSuite) TestOAuthClient_ValidateRedirectURI() {
 	assert.Error(suite.T(), client.ValidateRedirectURI(context.Background(), "https://other.com/callback"))
 }
 
+func (suite *OAuthClientTestSuite) TestOAuthClient_ValidateRedirectURI_LoopbackMatching() {
+	suite.setupRuntime(suite.T(), engineconfig.OAuthConfig{})
+	client := &OAuthClient{
+		RedirectURIs: []string{
+			"http://127.0.0.1/callback", "http://[::1]/callback", "http://localhost:8080/cb?x=1",
+			"https://example.com/callback",
+		},
+		RedirectURIMatching: RedirectURIMatchingLoopback,
+	}
+	ctx := context.Background()
+
+	assert.NoError(suite.T(), client.ValidateRedirectURI(ctx, "http://127.0.0.1:51004/callback"))
+	assert.NoError(suite.T(), client.ValidateRedirectURI(ctx, "http://[::1]:51004/callback"))
+	assert.NoError(suite.T(), client.ValidateRedirectURI(ctx, "http://localhost:3000/cb?x=1"))
+	assert.NoError(suite.T(), client.ValidateRedirectURI(ctx, "https://example.com/callback"))
+
+	assert.Error(suite.T(), client.ValidateRedirectURI(ctx, "http://127.0.0.1:51004/other"))
+	assert.Error(suite.T(), client.ValidateRedirectURI(ctx, "http://localhost:51004/callback"))
+	assert.Error(suite.T(), client.ValidateRedirectURI(ctx, "http://localhost:3000/cb?x=2"))
+	assert.Error(suite.T(), client.ValidateRedirectURI(ctx, "https://127.0.0.1:51004/callback"))
+	assert.Error(suite.T(), client.ValidateRedirectURI(ctx, "http://127.0.0.1:51004/callback#frag"))
+	assert.Error(suite.T(), client.ValidateRedirectURI(ctx, "https://example.com:8443/callback"))
+}
+
+func (suite *OAuthClientTestSuite) TestOAuthClient_ValidateRedirectURI_ExactMatchingIgnoresLoopbackPort() {
+	suite.setupRuntime(suite.T(), engineconfig.OAuthConfig{})
+	client := &OAuthClient{RedirectURIs: []string{"http://127.0.0.1/callback"}}
+	assert.Error(suite.T(), client.ValidateRedirectURI(context.Background(), "http://127.0.0.1:51004/callback"))
+}
+
 func (suite *OAuthClientTestSuite) TestValidateRedirectURI_InvalidRegisteredURI() {
 	suite.setupRuntime(suite.T(), engineconfig.OAuthConfig{})
 	err := ValidateRedirectURI(context.Background(), []string{"/relative/callback"}, "")
@@ -217,3 +247,17 @@ func (suite *OAuthClientTestSuite) TestValidateRedirectURI_SkipsInvalidWildcardP
 		[]string{"https://*", "https://example.com/callback"}, "https://example.com/callback")
 	assert.NoError(suite.T(), err)
 }
+
+func (suite *OAuthClientTestSuite) TestTokenSigningAlgs() {
+	var nilClient *OAuthClient
+	assert.Empty(suite.T(), nilClient.AccessTokenSigningAlg())
+	assert.Empty(suite.T(), nilClient.IDTokenSigningAlg())
+	assert.Empty(suite.T(), (&OAuthClient{Token: &OAuthTokenConfig{}}).IDTokenSigningAlg())
+
+	client := &OAuthClient{Token: &OAuthTokenConfig{
+		AccessToken: &AccessTokenConfig{SigningAlg: "ES256"},
+		IDToken:     &IDTokenConfig{SigningAlg: "EdDSA"},
+	}}
+	assert.Equal(suite.T(), "ES256", client.AccessTokenSigningAlg())
+	assert.Equal(suite.T(), "EdDSA", client.IDTokenSigningAlg())
+}
@@ -232,6 +232,70 @@ func (_c *ActorProviderMock_GetActorGroups_Call) RunAndReturn(run func(actorID s
 	return _c
 }
 
+// GetDeletedActor provides a mock function for the type ActorProviderMock
+func (_mock *ActorProviderMock) GetDeletedActor(actorID string) (*providers.Entity, *common.ServiceError) {
+	ret := _mock.Called(actorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedActor")
+	}
+
+	var r0 *providers.Entity
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(string) (*providers.Entity, *common.ServiceError)); ok {
+		return returnFunc(actorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *providers.Entity); ok {
+		r0 = returnFunc(actorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) *common.ServiceError); ok {
+		r1 = returnFunc(actorID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ActorProviderMock_GetDeletedActor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedActor'
+type ActorProviderMock_GetDeletedActor_Call struct {
+	*mock.Call
+}
+
+// GetDeletedActor is a helper method to define mock.On call
+//   - actorID string
+func (_e *ActorProviderMock_Expecter) GetDeletedActor(actorID interface{}) *ActorProviderMock_GetDeletedActor_Call {
+	return &ActorProviderMock_GetDeletedActor_Call{Call: _e.mock.On("GetDeletedActor", actorID)}
+}
+
+func (_c *ActorProviderMock_GetDeletedActor_Call) Run(run func(actorID string)) *ActorProviderMock_GetDeletedActor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ActorProviderMock_GetDeletedActor_Call) Return(entity *providers.Entity, serviceError *common.ServiceError) *ActorProviderMock_GetDeletedActor_Call {
+	_c.Call.Return(entity, serviceError)
+	return _c
+}
+
+func (_c *ActorProviderMock_GetDeletedActor_Call) RunAndReturn(run func(actorID string) (*providers.Entity, *common.ServiceError)) *ActorProviderMock_GetDeletedActor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetInboundClientByID provides a mock function for the type ActorProviderMock
 func (_mock *ActorProviderMock) GetInboundClientByID(ctx context.Context, id string) (*providers.InboundClient, *common.ServiceError) {
 	ret := _mock.Called(ctx, id)
@@ -240,6 +240,234 @@ func (_c *ApplicationServiceInterfaceMock_GetApplication_Call) RunAndReturn(run
 	return _c
 }
 
+// GetApplicationBranding provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) GetApplicationBranding(ctx context.Context, appID string) (*providers.ApplicationBranding, *common.ServiceError) {
+	ret := _mock.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplicationBranding")
+	}
+
+	var r0 *providers.ApplicationBranding
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*providers.ApplicationBranding, *common.ServiceError)); ok {
+		return returnFunc(ctx, appID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *providers.ApplicationBranding); ok {
+		r0 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.ApplicationBranding)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_GetApplicationBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApplicationBranding'
+type ApplicationServiceInterfaceMock_GetApplicationBranding_Call struct {
+	*mock.Call
+}
+
+// GetApplicationBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+func (_e *ApplicationServiceInterfaceMock_Expecter) GetApplicationBranding(ctx interface{}, appID interface{}) *ApplicationServiceInterfaceMock_GetApplicationBranding_Call {
+	return &ApplicationServiceInterfaceMock_GetApplicationBranding_Call{Call: _e.mock.On("GetApplicationBranding", ctx, appID)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationBranding_Call) Run(run func(ctx context.Context, appID string)) *ApplicationServiceInterfaceMock_GetApplicationBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationBranding_Call) Return(applicationBranding *providers.ApplicationBranding, serviceError *common.ServiceError) *ApplicationServiceInterfaceMock_GetApplicationBranding_Call {
+	_c.Call.Return(applicationBranding, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationBranding_Call) RunAndReturn(run func(ctx context.Context, appID string) (*providers.ApplicationBranding, *common.ServiceError)) *ApplicationServiceInterfaceMock_GetApplicationBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApplicationHistory provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) GetApplicationHistory(ctx context.Context, appID string, limit int, offset int) (*model.ApplicationHistoryListResponse, *common.ServiceError) {
+	ret := _mock.Called(ctx, appID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplicationHistory")
+	}
+
+	var r0 *model.ApplicationHistoryListResponse
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) (*model.ApplicationHistoryListResponse, *common.ServiceError)); ok {
+		return returnFunc(ctx, appID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) *model.ApplicationHistoryListResponse); ok {
+		r0 = returnFunc(ctx, appID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationHistoryListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, appID, limit, offset)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_GetApplicationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApplicationHistory'
+type ApplicationServiceInterfaceMock_GetApplicationHistory_Call struct {
+	*mock.Call
+}
+
+// GetApplicationHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - limit int
+//   - offset int
+func (_e *ApplicationServiceInterfaceMock_Expecter) GetApplicationHistory(ctx interface{}, appID interface{}, limit interface{}, offset interface{}) *ApplicationServiceInterfaceMock_GetApplicationHistory_Call {
+	return &ApplicationServiceInterfaceMock_GetApplicationHistory_Call{Call: _e.mock.On("GetApplicationHistory", ctx, appID, limit, offset)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationHistory_Call) Run(run func(ctx context.Context, appID string, limit int, offset int)) *ApplicationServiceInterfaceMock_GetApplicationHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationHistory_Call) Return(applicationHistoryListResponse *model.ApplicationHistoryListResponse, serviceError *common.ServiceError) *ApplicationServiceInterfaceMock_GetApplicationHistory_Call {
+	_c.Call.Return(applicationHistoryListResponse, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationHistory_Call) RunAndReturn(run func(ctx context.Context, appID string, limit int, offset int) (*model.ApplicationHistoryListResponse, *common.ServiceError)) *ApplicationServiceInterfaceMock_GetApplicationHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApplicationHistoryVersion provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) GetApplicationHistoryVersion(ctx context.Context, appID string, version int) (*model.ApplicationHistoryVersion, *common.ServiceError) {
+	ret := _mock.Called(ctx, appID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplicationHistoryVersion")
+	}
+
+	var r0 *model.ApplicationHistoryVersion
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*model.ApplicationHistoryVersion, *common.ServiceError)); ok {
+		return returnFunc(ctx, appID, version)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *model.ApplicationHistoryVersion); ok {
+		r0 = returnFunc(ctx, appID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationHistoryVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, appID, version)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApplicationHistoryVersion'
+type ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call struct {
+	*mock.Call
+}
+
+// GetApplicationHistoryVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - version int
+func (_e *ApplicationServiceInterfaceMock_Expecter) GetApplicationHistoryVersion(ctx interface{}, appID interface{}, version interface{}) *ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call {
+	return &ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call{Call: _e.mock.On("GetApplicationHistoryVersion", ctx, appID, version)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call) Run(run func(ctx context.Context, appID string, version int)) *ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call) Return(applicationHistoryVersion *model.ApplicationHistoryVersion, serviceError *common.ServiceError) *ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call {
+	_c.Call.Return(applicationHistoryVersion, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call) RunAndReturn(run func(ctx context.Context, appID string, version int) (*model.ApplicationHistoryVersion, *common.ServiceError)) *ApplicationServiceInterfaceMock_GetApplicationHistoryVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetApplicationList provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) GetApplicationList(ctx context.Context) (*model.ApplicationListResponse, *common.ServiceError) {
 	ret := _mock.Called(ctx)
@@ -304,6 +532,70 @@ func (_c *ApplicationServiceInterfaceMock_GetApplicationList_Call) RunAndReturn(
 	return _c
 }
 
+// GetDeletedApplicationList provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) GetDeletedApplicationList(ctx context.Context) (*model.ApplicationListResponse, *common.ServiceError) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedApplicationList")
+	}
+
+	var r0 *model.ApplicationListResponse
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*model.ApplicationListResponse, *common.ServiceError)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *model.ApplicationListResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) *common.ServiceError); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedApplicationList'
+type ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call struct {
+	*mock.Call
+}
+
+// GetDeletedApplicationList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ApplicationServiceInterfaceMock_Expecter) GetDeletedApplicationList(ctx interface{}) *ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call {
+	return &ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call{Call: _e.mock.On("GetDeletedApplicationList", ctx)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call) Run(run func(ctx context.Context)) *ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call) Return(applicationListResponse *model.ApplicationListResponse, serviceError *common.ServiceError) *ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call {
+	_c.Call.Return(applicationListResponse, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call) RunAndReturn(run func(ctx context.Context) (*model.ApplicationListResponse, *common.ServiceError)) *ApplicationServiceInterfaceMock_GetDeletedApplicationList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOAuthApplication provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) GetOAuthApplication(ctx context.Context, clientID string) (*providers.OAuthClient, *common.ServiceError) {
 	ret := _mock.Called(ctx, clientID)
@@ -448,6 +740,124 @@ func (_c *ApplicationServiceInterfaceMock_GetResourceDependencies_Call) RunAndRe
 	return _c
 }
 
+// PurgeApplication provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) PurgeApplication(ctx context.Context, appID string) *common.ServiceError {
+	ret := _mock.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeApplication")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// ApplicationServiceInterfaceMock_PurgeApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeApplication'
+type ApplicationServiceInterfaceMock_PurgeApplication_Call struct {
+	*mock.Call
+}
+
+// PurgeApplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+func (_e *ApplicationServiceInterfaceMock_Expecter) PurgeApplication(ctx interface{}, appID interface{}) *ApplicationServiceInterfaceMock_PurgeApplication_Call {
+	return &ApplicationServiceInterfaceMock_PurgeApplication_Call{Call: _e.mock.On("PurgeApplication", ctx, appID)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_PurgeApplication_Call) Run(run func(ctx context.Context, appID string)) *ApplicationServiceInterfaceMock_PurgeApplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_PurgeApplication_Call) Return(serviceError *common.ServiceError) *ApplicationServiceInterfaceMock_PurgeApplication_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_PurgeApplication_Call) RunAndReturn(run func(ctx context.Context, appID string) *common.ServiceError) *ApplicationServiceInterfaceMock_PurgeApplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreApplication provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) RestoreApplication(ctx context.Context, appID string) *common.ServiceError {
+	ret := _mock.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreApplication")
+	}
+
+	var r0 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.ServiceError); ok {
+		r0 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.ServiceError)
+		}
+	}
+	return r0
+}
+
+// ApplicationServiceInterfaceMock_RestoreApplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreApplication'
+type ApplicationServiceInterfaceMock_RestoreApplication_Call struct {
+	*mock.Call
+}
+
+// RestoreApplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+func (_e *ApplicationServiceInterfaceMock_Expecter) RestoreApplication(ctx interface{}, appID interface{}) *ApplicationServiceInterfaceMock_RestoreApplication_Call {
+	return &ApplicationServiceInterfaceMock_RestoreApplication_Call{Call: _e.mock.On("RestoreApplication", ctx, appID)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_RestoreApplication_Call) Run(run func(ctx context.Context, appID string)) *ApplicationServiceInterfaceMock_RestoreApplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_RestoreApplication_Call) Return(serviceError *common.ServiceError) *ApplicationServiceInterfaceMock_RestoreApplication_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_RestoreApplication_Call) RunAndReturn(run func(ctx context.Context, appID string) *common.ServiceError) *ApplicationServiceInterfaceMock_RestoreApplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDependencyRegistry provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) SetDependencyRegistry(r resourcedependency.Registry) {
 	_mock.Called(r)
@@ -564,6 +974,82 @@ func (_c *ApplicationServiceInterfaceMock_UpdateApplication_Call) RunAndReturn(r
 	return _c
 }
 
+// UpdateApplicationBranding provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) UpdateApplicationBranding(ctx context.Context, appID string, branding *providers.ApplicationBranding) (*providers.ApplicationBranding, *common.ServiceError) {
+	ret := _mock.Called(ctx, appID, branding)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateApplicationBranding")
+	}
+
+	var r0 *providers.ApplicationBranding
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *providers.ApplicationBranding) (*providers.ApplicationBranding, *common.ServiceError)); ok {
+		return returnFunc(ctx, appID, branding)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *providers.ApplicationBranding) *providers.ApplicationBranding); ok {
+		r0 = returnFunc(ctx, appID, branding)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.ApplicationBranding)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *providers.ApplicationBranding) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, appID, branding)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateApplicationBranding'
+type ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call struct {
+	*mock.Call
+}
+
+// UpdateApplicationBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - branding *providers.ApplicationBranding
+func (_e *ApplicationServiceInterfaceMock_Expecter) UpdateApplicationBranding(ctx interface{}, appID interface{}, branding interface{}) *ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call {
+	return &ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call{Call: _e.mock.On("UpdateApplicationBranding", ctx, appID, branding)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call) Run(run func(ctx context.Context, appID string, branding *providers.ApplicationBranding)) *ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *providers.ApplicationBranding
+		if args[2] != nil {
+			arg2 = args[2].(*providers.ApplicationBranding)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call) Return(applicationBranding *providers.ApplicationBranding, serviceError *common.ServiceError) *ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call {
+	_c.Call.Return(applicationBranding, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call) RunAndReturn(run func(ctx context.Context, appID string, branding *providers.ApplicationBranding) (*providers.ApplicationBranding, *common.ServiceError)) *ApplicationServiceInterfaceMock_UpdateApplicationBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateApplication provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) ValidateApplication(ctx context.Context, app *model.ApplicationDTO) (*model.ApplicationProcessedDTO, *providers.InboundAuthConfigWithSecret, *common.ServiceError) {
 	ret := _mock.Called(ctx, app)
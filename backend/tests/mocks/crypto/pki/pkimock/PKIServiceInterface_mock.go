@@ -9,6 +9,7 @@ import (
 	"crypto"
 	"crypto/tls"
 	"crypto/x509"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
@@ -41,6 +42,63 @@ func (_m *PKIServiceInterfaceMock) EXPECT() *PKIServiceInterfaceMock_Expecter {
 	return &PKIServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// AddKey provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) AddKey(id string, certificate tls.Certificate) error {
+	ret := _mock.Called(id, certificate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, tls.Certificate) error); ok {
+		r0 = returnFunc(id, certificate)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PKIServiceInterfaceMock_AddKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddKey'
+type PKIServiceInterfaceMock_AddKey_Call struct {
+	*mock.Call
+}
+
+// AddKey is a helper method to define mock.On call
+//   - id string
+//   - certificate tls.Certificate
+func (_e *PKIServiceInterfaceMock_Expecter) AddKey(id interface{}, certificate interface{}) *PKIServiceInterfaceMock_AddKey_Call {
+	return &PKIServiceInterfaceMock_AddKey_Call{Call: _e.mock.On("AddKey", id, certificate)}
+}
+
+func (_c *PKIServiceInterfaceMock_AddKey_Call) Run(run func(id string, certificate tls.Certificate)) *PKIServiceInterfaceMock_AddKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 tls.Certificate
+		if args[1] != nil {
+			arg1 = args[1].(tls.Certificate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_AddKey_Call) Return(err error) *PKIServiceInterfaceMock_AddKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_AddKey_Call) RunAndReturn(run func(id string, certificate tls.Certificate) error) *PKIServiceInterfaceMock_AddKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllX509Certificates provides a mock function for the type PKIServiceInterfaceMock
 func (_mock *PKIServiceInterfaceMock) GetAllX509Certificates(ctx context.Context) (map[string]*x509.Certificate, *common.ServiceError) {
 	ret := _mock.Called(ctx)
@@ -449,3 +507,60 @@ func (_c *PKIServiceInterfaceMock_GetX509Certificate_Call) RunAndReturn(run func
 	_c.Call.Return(run)
 	return _c
 }
+
+// SetKeyExpiry provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) SetKeyExpiry(id string, expiresAt time.Time) error {
+	ret := _mock.Called(id, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetKeyExpiry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = returnFunc(id, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PKIServiceInterfaceMock_SetKeyExpiry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetKeyExpiry'
+type PKIServiceInterfaceMock_SetKeyExpiry_Call struct {
+	*mock.Call
+}
+
+// SetKeyExpiry is a helper method to define mock.On call
+//   - id string
+//   - expiresAt time.Time
+func (_e *PKIServiceInterfaceMock_Expecter) SetKeyExpiry(id interface{}, expiresAt interface{}) *PKIServiceInterfaceMock_SetKeyExpiry_Call {
+	return &PKIServiceInterfaceMock_SetKeyExpiry_Call{Call: _e.mock.On("SetKeyExpiry", id, expiresAt)}
+}
+
+func (_c *PKIServiceInterfaceMock_SetKeyExpiry_Call) Run(run func(id string, expiresAt time.Time)) *PKIServiceInterfaceMock_SetKeyExpiry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_SetKeyExpiry_Call) Return(err error) *PKIServiceInterfaceMock_SetKeyExpiry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_SetKeyExpiry_Call) RunAndReturn(run func(id string, expiresAt time.Time) error) *PKIServiceInterfaceMock_SetKeyExpiry_Call {
+	_c.Call.Return(run)
+	return _c
+}
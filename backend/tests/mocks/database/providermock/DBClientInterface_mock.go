@@ -39,6 +39,78 @@ func (_m *DBClientInterfaceMock) EXPECT() *DBClientInterfaceMock_Expecter {
 	return &DBClientInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// BatchExecuteContext provides a mock function for the type DBClientInterfaceMock
+func (_mock *DBClientInterfaceMock) BatchExecuteContext(ctx context.Context, query model.DBBatchInsertQuery, rows [][]interface{}) (int64, error) {
+	ret := _mock.Called(ctx, query, rows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchExecuteContext")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.DBBatchInsertQuery, [][]interface{}) (int64, error)); ok {
+		return returnFunc(ctx, query, rows)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.DBBatchInsertQuery, [][]interface{}) int64); ok {
+		r0 = returnFunc(ctx, query, rows)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.DBBatchInsertQuery, [][]interface{}) error); ok {
+		r1 = returnFunc(ctx, query, rows)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DBClientInterfaceMock_BatchExecuteContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchExecuteContext'
+type DBClientInterfaceMock_BatchExecuteContext_Call struct {
+	*mock.Call
+}
+
+// BatchExecuteContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query model.DBBatchInsertQuery
+//   - rows [][]interface{}
+func (_e *DBClientInterfaceMock_Expecter) BatchExecuteContext(ctx interface{}, query interface{}, rows interface{}) *DBClientInterfaceMock_BatchExecuteContext_Call {
+	return &DBClientInterfaceMock_BatchExecuteContext_Call{Call: _e.mock.On("BatchExecuteContext", ctx, query, rows)}
+}
+
+func (_c *DBClientInterfaceMock_BatchExecuteContext_Call) Run(run func(ctx context.Context, query model.DBBatchInsertQuery, rows [][]interface{})) *DBClientInterfaceMock_BatchExecuteContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.DBBatchInsertQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.DBBatchInsertQuery)
+		}
+		var arg2 [][]interface{}
+		if args[2] != nil {
+			arg2 = args[2].([][]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DBClientInterfaceMock_BatchExecuteContext_Call) Return(n int64, err error) *DBClientInterfaceMock_BatchExecuteContext_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *DBClientInterfaceMock_BatchExecuteContext_Call) RunAndReturn(run func(ctx context.Context, query model.DBBatchInsertQuery, rows [][]interface{}) (int64, error)) *DBClientInterfaceMock_BatchExecuteContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BeginTx provides a mock function for the type DBClientInterfaceMock
 func (_mock *DBClientInterfaceMock) BeginTx() (model.TxInterface, error) {
 	ret := _mock.Called()
@@ -6,6 +6,7 @@ package entityprovidermock
 
 import (
 	"encoding/json"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
@@ -162,6 +163,208 @@ func (_c *EntityProviderInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(e
 	return _c
 }
 
+// GetDeletedEntity provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) GetDeletedEntity(entityID string) (*providers.Entity, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntity")
+	}
+
+	var r0 *providers.Entity
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string) (*providers.Entity, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *providers.Entity); ok {
+		r0 = returnFunc(entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(entityID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_GetDeletedEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntity'
+type EntityProviderInterfaceMock_GetDeletedEntity_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntity is a helper method to define mock.On call
+//   - entityID string
+func (_e *EntityProviderInterfaceMock_Expecter) GetDeletedEntity(entityID interface{}) *EntityProviderInterfaceMock_GetDeletedEntity_Call {
+	return &EntityProviderInterfaceMock_GetDeletedEntity_Call{Call: _e.mock.On("GetDeletedEntity", entityID)}
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntity_Call) Run(run func(entityID string)) *EntityProviderInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntity_Call) Return(entity *providers.Entity, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Return(entity, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntity_Call) RunAndReturn(run func(entityID string) (*providers.Entity, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_GetDeletedEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedEntityList provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) GetDeletedEntityList(category providers.EntityCategory, limit int, offset int) ([]providers.Entity, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(category, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntityList")
+	}
+
+	var r0 []providers.Entity
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(providers.EntityCategory, int, int) ([]providers.Entity, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(category, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(providers.EntityCategory, int, int) []providers.Entity); ok {
+		r0 = returnFunc(category, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]providers.Entity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(providers.EntityCategory, int, int) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(category, limit, offset)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_GetDeletedEntityList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntityList'
+type EntityProviderInterfaceMock_GetDeletedEntityList_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntityList is a helper method to define mock.On call
+//   - category providers.EntityCategory
+//   - limit int
+//   - offset int
+func (_e *EntityProviderInterfaceMock_Expecter) GetDeletedEntityList(category interface{}, limit interface{}, offset interface{}) *EntityProviderInterfaceMock_GetDeletedEntityList_Call {
+	return &EntityProviderInterfaceMock_GetDeletedEntityList_Call{Call: _e.mock.On("GetDeletedEntityList", category, limit, offset)}
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntityList_Call) Run(run func(category providers.EntityCategory, limit int, offset int)) *EntityProviderInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 providers.EntityCategory
+		if args[0] != nil {
+			arg0 = args[0].(providers.EntityCategory)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntityList_Call) Return(entitys []providers.Entity, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Return(entitys, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntityList_Call) RunAndReturn(run func(category providers.EntityCategory, limit int, offset int) ([]providers.Entity, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_GetDeletedEntityList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedEntityListCount provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) GetDeletedEntityListCount(category providers.EntityCategory) (int, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedEntityListCount")
+	}
+
+	var r0 int
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(providers.EntityCategory) (int, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(providers.EntityCategory) int); ok {
+		r0 = returnFunc(category)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(providers.EntityCategory) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(category)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_GetDeletedEntityListCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedEntityListCount'
+type EntityProviderInterfaceMock_GetDeletedEntityListCount_Call struct {
+	*mock.Call
+}
+
+// GetDeletedEntityListCount is a helper method to define mock.On call
+//   - category providers.EntityCategory
+func (_e *EntityProviderInterfaceMock_Expecter) GetDeletedEntityListCount(category interface{}) *EntityProviderInterfaceMock_GetDeletedEntityListCount_Call {
+	return &EntityProviderInterfaceMock_GetDeletedEntityListCount_Call{Call: _e.mock.On("GetDeletedEntityListCount", category)}
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntityListCount_Call) Run(run func(category providers.EntityCategory)) *EntityProviderInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 providers.EntityCategory
+		if args[0] != nil {
+			arg0 = args[0].(providers.EntityCategory)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntityListCount_Call) Return(n int, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Return(n, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetDeletedEntityListCount_Call) RunAndReturn(run func(category providers.EntityCategory) (int, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_GetDeletedEntityListCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntitiesByIDs provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) GetEntitiesByIDs(entityIDs []string) ([]providers.Entity, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(entityIDs)
@@ -440,6 +643,82 @@ func (_c *EntityProviderInterfaceMock_GetEntityListCount_Call) RunAndReturn(run
 	return _c
 }
 
+// GetExpiredDeletedEntityIDs provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) GetExpiredDeletedEntityIDs(category providers.EntityCategory, deletedBefore time.Time, limit int) ([]string, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(category, deletedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiredDeletedEntityIDs")
+	}
+
+	var r0 []string
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(providers.EntityCategory, time.Time, int) ([]string, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(category, deletedBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(providers.EntityCategory, time.Time, int) []string); ok {
+		r0 = returnFunc(category, deletedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(providers.EntityCategory, time.Time, int) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(category, deletedBefore, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiredDeletedEntityIDs'
+type EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call struct {
+	*mock.Call
+}
+
+// GetExpiredDeletedEntityIDs is a helper method to define mock.On call
+//   - category providers.EntityCategory
+//   - deletedBefore time.Time
+//   - limit int
+func (_e *EntityProviderInterfaceMock_Expecter) GetExpiredDeletedEntityIDs(category interface{}, deletedBefore interface{}, limit interface{}) *EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	return &EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call{Call: _e.mock.On("GetExpiredDeletedEntityIDs", category, deletedBefore, limit)}
+}
+
+func (_c *EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call) Run(run func(category providers.EntityCategory, deletedBefore time.Time, limit int)) *EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 providers.EntityCategory
+		if args[0] != nil {
+			arg0 = args[0].(providers.EntityCategory)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call) Return(strings []string, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Return(strings, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call) RunAndReturn(run func(category providers.EntityCategory, deletedBefore time.Time, limit int) ([]string, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_GetExpiredDeletedEntityIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTransitiveEntityGroups provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) GetTransitiveEntityGroups(entityID string) ([]providers.EntityGroup, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(entityID)
@@ -568,6 +847,59 @@ func (_c *EntityProviderInterfaceMock_IdentifyEntity_Call) RunAndReturn(run func
 	return _c
 }
 
+// RestoreEntity provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) RestoreEntity(entityID string) *entityprovider.EntityProviderError {
+	ret := _mock.Called(entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreEntity")
+	}
+
+	var r0 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string) *entityprovider.EntityProviderError); ok {
+		r0 = returnFunc(entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0
+}
+
+// EntityProviderInterfaceMock_RestoreEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreEntity'
+type EntityProviderInterfaceMock_RestoreEntity_Call struct {
+	*mock.Call
+}
+
+// RestoreEntity is a helper method to define mock.On call
+//   - entityID string
+func (_e *EntityProviderInterfaceMock_Expecter) RestoreEntity(entityID interface{}) *EntityProviderInterfaceMock_RestoreEntity_Call {
+	return &EntityProviderInterfaceMock_RestoreEntity_Call{Call: _e.mock.On("RestoreEntity", entityID)}
+}
+
+func (_c *EntityProviderInterfaceMock_RestoreEntity_Call) Run(run func(entityID string)) *EntityProviderInterfaceMock_RestoreEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_RestoreEntity_Call) Return(entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_RestoreEntity_Call {
+	_c.Call.Return(entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_RestoreEntity_Call) RunAndReturn(run func(entityID string) *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_RestoreEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchEntities provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) SearchEntities(filters map[string]interface{}) ([]*providers.Entity, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(filters)
@@ -632,6 +964,59 @@ func (_c *EntityProviderInterfaceMock_SearchEntities_Call) RunAndReturn(run func
 	return _c
 }
 
+// SoftDeleteEntity provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) SoftDeleteEntity(entityID string) *entityprovider.EntityProviderError {
+	ret := _mock.Called(entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteEntity")
+	}
+
+	var r0 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string) *entityprovider.EntityProviderError); ok {
+		r0 = returnFunc(entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0
+}
+
+// EntityProviderInterfaceMock_SoftDeleteEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeleteEntity'
+type EntityProviderInterfaceMock_SoftDeleteEntity_Call struct {
+	*mock.Call
+}
+
+// SoftDeleteEntity is a helper method to define mock.On call
+//   - entityID string
+func (_e *EntityProviderInterfaceMock_Expecter) SoftDeleteEntity(entityID interface{}) *EntityProviderInterfaceMock_SoftDeleteEntity_Call {
+	return &EntityProviderInterfaceMock_SoftDeleteEntity_Call{Call: _e.mock.On("SoftDeleteEntity", entityID)}
+}
+
+func (_c *EntityProviderInterfaceMock_SoftDeleteEntity_Call) Run(run func(entityID string)) *EntityProviderInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_SoftDeleteEntity_Call) Return(entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Return(entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_SoftDeleteEntity_Call) RunAndReturn(run func(entityID string) *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_SoftDeleteEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAttributes provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) UpdateAttributes(entityID string, attributes json.RawMessage) *entityprovider.EntityProviderError {
 	ret := _mock.Called(entityID, attributes)
@@ -151,6 +151,76 @@ func (_c *FlowExecServiceInterfaceMock_Execute_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// GetExecutionTrace provides a mock function for the type FlowExecServiceInterfaceMock
+func (_mock *FlowExecServiceInterfaceMock) GetExecutionTrace(ctx context.Context, executionID string) (*flowexec.FlowTrace, *common.ServiceError) {
+	ret := _mock.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutionTrace")
+	}
+
+	var r0 *flowexec.FlowTrace
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*flowexec.FlowTrace, *common.ServiceError)); ok {
+		return returnFunc(ctx, executionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *flowexec.FlowTrace); ok {
+		r0 = returnFunc(ctx, executionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flowexec.FlowTrace)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, executionID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowExecServiceInterfaceMock_GetExecutionTrace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutionTrace'
+type FlowExecServiceInterfaceMock_GetExecutionTrace_Call struct {
+	*mock.Call
+}
+
+// GetExecutionTrace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+func (_e *FlowExecServiceInterfaceMock_Expecter) GetExecutionTrace(ctx interface{}, executionID interface{}) *FlowExecServiceInterfaceMock_GetExecutionTrace_Call {
+	return &FlowExecServiceInterfaceMock_GetExecutionTrace_Call{Call: _e.mock.On("GetExecutionTrace", ctx, executionID)}
+}
+
+func (_c *FlowExecServiceInterfaceMock_GetExecutionTrace_Call) Run(run func(ctx context.Context, executionID string)) *FlowExecServiceInterfaceMock_GetExecutionTrace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_GetExecutionTrace_Call) Return(flowTrace *flowexec.FlowTrace, serviceError *common.ServiceError) *FlowExecServiceInterfaceMock_GetExecutionTrace_Call {
+	_c.Call.Return(flowTrace, serviceError)
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_GetExecutionTrace_Call) RunAndReturn(run func(ctx context.Context, executionID string) (*flowexec.FlowTrace, *common.ServiceError)) *FlowExecServiceInterfaceMock_GetExecutionTrace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InitiateAndExecute provides a mock function for the type FlowExecServiceInterfaceMock
 func (_mock *FlowExecServiceInterfaceMock) InitiateAndExecute(ctx context.Context, initContext *flowexec.FlowInitContext) (*flowexec.FlowStep, *common.ServiceError) {
 	ret := _mock.Called(ctx, initContext)
@@ -288,3 +358,71 @@ func (_c *FlowExecServiceInterfaceMock_InitiateFlow_Call) RunAndReturn(run func(
 	_c.Call.Return(run)
 	return _c
 }
+
+// WatchExecution provides a mock function for the type FlowExecServiceInterfaceMock
+func (_mock *FlowExecServiceInterfaceMock) WatchExecution(ctx context.Context, executionID string) (<-chan flowexec.ExecutionEvent, *common.ServiceError) {
+	ret := _mock.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchExecution")
+	}
+
+	var r0 <-chan flowexec.ExecutionEvent
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (<-chan flowexec.ExecutionEvent, *common.ServiceError)); ok {
+		return returnFunc(ctx, executionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) <-chan flowexec.ExecutionEvent); ok {
+		r0 = returnFunc(ctx, executionID)
+	} else {
+		r0 = ret.Get(0).(<-chan flowexec.ExecutionEvent)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, executionID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowExecServiceInterfaceMock_WatchExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchExecution'
+type FlowExecServiceInterfaceMock_WatchExecution_Call struct {
+	*mock.Call
+}
+
+// WatchExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+func (_e *FlowExecServiceInterfaceMock_Expecter) WatchExecution(ctx interface{}, executionID interface{}) *FlowExecServiceInterfaceMock_WatchExecution_Call {
+	return &FlowExecServiceInterfaceMock_WatchExecution_Call{Call: _e.mock.On("WatchExecution", ctx, executionID)}
+}
+
+func (_c *FlowExecServiceInterfaceMock_WatchExecution_Call) Run(run func(ctx context.Context, executionID string)) *FlowExecServiceInterfaceMock_WatchExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_WatchExecution_Call) Return(executionEvent <-chan flowexec.ExecutionEvent, serviceError *common.ServiceError) *FlowExecServiceInterfaceMock_WatchExecution_Call {
+	_c.Call.Return(executionEvent, serviceError)
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_WatchExecution_Call) RunAndReturn(run func(ctx context.Context, executionID string) (<-chan flowexec.ExecutionEvent, *common.ServiceError)) *FlowExecServiceInterfaceMock_WatchExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -1024,3 +1024,79 @@ func (_c *FlowMgtServiceInterfaceMock_UpdateFlow_Call) RunAndReturn(run func(ctx
 	_c.Call.Return(run)
 	return _c
 }
+
+// ValidateFlow provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) ValidateFlow(ctx context.Context, flowDef *flowmgt.FlowDefinition, simulation *flowmgt.FlowSimulationRequest) (*flowmgt.FlowValidationResponse, *common.ServiceError) {
+	ret := _mock.Called(ctx, flowDef, simulation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateFlow")
+	}
+
+	var r0 *flowmgt.FlowValidationResponse
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *flowmgt.FlowDefinition, *flowmgt.FlowSimulationRequest) (*flowmgt.FlowValidationResponse, *common.ServiceError)); ok {
+		return returnFunc(ctx, flowDef, simulation)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *flowmgt.FlowDefinition, *flowmgt.FlowSimulationRequest) *flowmgt.FlowValidationResponse); ok {
+		r0 = returnFunc(ctx, flowDef, simulation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flowmgt.FlowValidationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *flowmgt.FlowDefinition, *flowmgt.FlowSimulationRequest) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, flowDef, simulation)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_ValidateFlow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateFlow'
+type FlowMgtServiceInterfaceMock_ValidateFlow_Call struct {
+	*mock.Call
+}
+
+// ValidateFlow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowDef *flowmgt.FlowDefinition
+//   - simulation *flowmgt.FlowSimulationRequest
+func (_e *FlowMgtServiceInterfaceMock_Expecter) ValidateFlow(ctx interface{}, flowDef interface{}, simulation interface{}) *FlowMgtServiceInterfaceMock_ValidateFlow_Call {
+	return &FlowMgtServiceInterfaceMock_ValidateFlow_Call{Call: _e.mock.On("ValidateFlow", ctx, flowDef, simulation)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ValidateFlow_Call) Run(run func(ctx context.Context, flowDef *flowmgt.FlowDefinition, simulation *flowmgt.FlowSimulationRequest)) *FlowMgtServiceInterfaceMock_ValidateFlow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *flowmgt.FlowDefinition
+		if args[1] != nil {
+			arg1 = args[1].(*flowmgt.FlowDefinition)
+		}
+		var arg2 *flowmgt.FlowSimulationRequest
+		if args[2] != nil {
+			arg2 = args[2].(*flowmgt.FlowSimulationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ValidateFlow_Call) Return(flowValidationResponse *flowmgt.FlowValidationResponse, serviceError *common.ServiceError) *FlowMgtServiceInterfaceMock_ValidateFlow_Call {
+	_c.Call.Return(flowValidationResponse, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ValidateFlow_Call) RunAndReturn(run func(ctx context.Context, flowDef *flowmgt.FlowDefinition, simulation *flowmgt.FlowSimulationRequest) (*flowmgt.FlowValidationResponse, *common.ServiceError)) *FlowMgtServiceInterfaceMock_ValidateFlow_Call {
+	_c.Call.Return(run)
+	return _c
+}
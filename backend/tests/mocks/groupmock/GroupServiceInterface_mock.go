@@ -458,6 +458,94 @@ func (_c *GroupServiceInterfaceMock_DeleteGroup_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetEffectiveMembers provides a mock function for the type GroupServiceInterfaceMock
+func (_mock *GroupServiceInterfaceMock) GetEffectiveMembers(ctx context.Context, groupID string, limit int, offset int, includeDisplay bool) (*group.MemberListResponse, *common.ServiceError) {
+	ret := _mock.Called(ctx, groupID, limit, offset, includeDisplay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEffectiveMembers")
+	}
+
+	var r0 *group.MemberListResponse
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, bool) (*group.MemberListResponse, *common.ServiceError)); ok {
+		return returnFunc(ctx, groupID, limit, offset, includeDisplay)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, bool) *group.MemberListResponse); ok {
+		r0 = returnFunc(ctx, groupID, limit, offset, includeDisplay)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.MemberListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int, bool) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, groupID, limit, offset, includeDisplay)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// GroupServiceInterfaceMock_GetEffectiveMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEffectiveMembers'
+type GroupServiceInterfaceMock_GetEffectiveMembers_Call struct {
+	*mock.Call
+}
+
+// GetEffectiveMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - limit int
+//   - offset int
+//   - includeDisplay bool
+func (_e *GroupServiceInterfaceMock_Expecter) GetEffectiveMembers(ctx interface{}, groupID interface{}, limit interface{}, offset interface{}, includeDisplay interface{}) *GroupServiceInterfaceMock_GetEffectiveMembers_Call {
+	return &GroupServiceInterfaceMock_GetEffectiveMembers_Call{Call: _e.mock.On("GetEffectiveMembers", ctx, groupID, limit, offset, includeDisplay)}
+}
+
+func (_c *GroupServiceInterfaceMock_GetEffectiveMembers_Call) Run(run func(ctx context.Context, groupID string, limit int, offset int, includeDisplay bool)) *GroupServiceInterfaceMock_GetEffectiveMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *GroupServiceInterfaceMock_GetEffectiveMembers_Call) Return(memberListResponse *group.MemberListResponse, serviceError *common.ServiceError) *GroupServiceInterfaceMock_GetEffectiveMembers_Call {
+	_c.Call.Return(memberListResponse, serviceError)
+	return _c
+}
+
+func (_c *GroupServiceInterfaceMock_GetEffectiveMembers_Call) RunAndReturn(run func(ctx context.Context, groupID string, limit int, offset int, includeDisplay bool) (*group.MemberListResponse, *common.ServiceError)) *GroupServiceInterfaceMock_GetEffectiveMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGroup provides a mock function for the type GroupServiceInterfaceMock
 func (_mock *GroupServiceInterfaceMock) GetGroup(ctx context.Context, groupID string, includeDisplay bool) (*group.Group, *common.ServiceError) {
 	ret := _mock.Called(ctx, groupID, includeDisplay)
@@ -173,6 +173,68 @@ func (_c *InboundClientServiceInterfaceMock_DeleteInboundClient_Call) RunAndRetu
 	return _c
 }
 
+// GetAllowedOrigins provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) GetAllowedOrigins(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllowedOrigins")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InboundClientServiceInterfaceMock_GetAllowedOrigins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllowedOrigins'
+type InboundClientServiceInterfaceMock_GetAllowedOrigins_Call struct {
+	*mock.Call
+}
+
+// GetAllowedOrigins is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InboundClientServiceInterfaceMock_Expecter) GetAllowedOrigins(ctx interface{}) *InboundClientServiceInterfaceMock_GetAllowedOrigins_Call {
+	return &InboundClientServiceInterfaceMock_GetAllowedOrigins_Call{Call: _e.mock.On("GetAllowedOrigins", ctx)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetAllowedOrigins_Call) Run(run func(ctx context.Context)) *InboundClientServiceInterfaceMock_GetAllowedOrigins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetAllowedOrigins_Call) Return(strings []string, err error) *InboundClientServiceInterfaceMock_GetAllowedOrigins_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetAllowedOrigins_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *InboundClientServiceInterfaceMock_GetAllowedOrigins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCertificate provides a mock function for the type InboundClientServiceInterfaceMock
 func (_mock *InboundClientServiceInterfaceMock) GetCertificate(ctx context.Context, refType cert.CertificateReferenceType, refID string) (*model.Certificate, *inboundclient.CertOperationError) {
 	ret := _mock.Called(ctx, refType, refID)
@@ -607,6 +669,166 @@ func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileByEntityID_Call) RunA
 	return _c
 }
 
+// GetOAuthProfileRevision provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) GetOAuthProfileRevision(ctx context.Context, entityID string, version int) (*model.OAuthProfileRevision, error) {
+	ret := _mock.Called(ctx, entityID, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOAuthProfileRevision")
+	}
+
+	var r0 *model.OAuthProfileRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*model.OAuthProfileRevision, error)); ok {
+		return returnFunc(ctx, entityID, version)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *model.OAuthProfileRevision); ok {
+		r0 = returnFunc(ctx, entityID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OAuthProfileRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, entityID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOAuthProfileRevision'
+type InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call struct {
+	*mock.Call
+}
+
+// GetOAuthProfileRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - version int
+func (_e *InboundClientServiceInterfaceMock_Expecter) GetOAuthProfileRevision(ctx interface{}, entityID interface{}, version interface{}) *InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call {
+	return &InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call{Call: _e.mock.On("GetOAuthProfileRevision", ctx, entityID, version)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call) Run(run func(ctx context.Context, entityID string, version int)) *InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call) Return(oAuthProfileRevision *model.OAuthProfileRevision, err error) *InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call {
+	_c.Call.Return(oAuthProfileRevision, err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call) RunAndReturn(run func(ctx context.Context, entityID string, version int) (*model.OAuthProfileRevision, error)) *InboundClientServiceInterfaceMock_GetOAuthProfileRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOAuthProfileRevisionList provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) GetOAuthProfileRevisionList(ctx context.Context, entityID string, limit int, offset int) ([]model.OAuthProfileRevision, int, error) {
+	ret := _mock.Called(ctx, entityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOAuthProfileRevisionList")
+	}
+
+	var r0 []model.OAuthProfileRevision
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]model.OAuthProfileRevision, int, error)); ok {
+		return returnFunc(ctx, entityID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []model.OAuthProfileRevision); ok {
+		r0 = returnFunc(ctx, entityID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OAuthProfileRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) int); ok {
+		r1 = returnFunc(ctx, entityID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, int, int) error); ok {
+		r2 = returnFunc(ctx, entityID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOAuthProfileRevisionList'
+type InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call struct {
+	*mock.Call
+}
+
+// GetOAuthProfileRevisionList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - limit int
+//   - offset int
+func (_e *InboundClientServiceInterfaceMock_Expecter) GetOAuthProfileRevisionList(ctx interface{}, entityID interface{}, limit interface{}, offset interface{}) *InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call {
+	return &InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call{Call: _e.mock.On("GetOAuthProfileRevisionList", ctx, entityID, limit, offset)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call) Run(run func(ctx context.Context, entityID string, limit int, offset int)) *InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call) Return(oAuthProfileRevisions []model.OAuthProfileRevision, n int, err error) *InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call {
+	_c.Call.Return(oAuthProfileRevisions, n, err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call) RunAndReturn(run func(ctx context.Context, entityID string, limit int, offset int) ([]model.OAuthProfileRevision, int, error)) *InboundClientServiceInterfaceMock_GetOAuthProfileRevisionList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsDeclarative provides a mock function for the type InboundClientServiceInterfaceMock
 func (_mock *InboundClientServiceInterfaceMock) IsDeclarative(ctx context.Context, entityID string) bool {
 	ret := _mock.Called(ctx, entityID)
@@ -859,6 +1081,69 @@ func (_c *InboundClientServiceInterfaceMock_UpdateInboundClient_Call) RunAndRetu
 	return _c
 }
 
+// UpdateInboundClientProperties provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) UpdateInboundClientProperties(ctx context.Context, entityID string, properties map[string]interface{}) error {
+	ret := _mock.Called(ctx, entityID, properties)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInboundClientProperties")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) error); ok {
+		r0 = returnFunc(ctx, entityID, properties)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInboundClientProperties'
+type InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call struct {
+	*mock.Call
+}
+
+// UpdateInboundClientProperties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - properties map[string]interface{}
+func (_e *InboundClientServiceInterfaceMock_Expecter) UpdateInboundClientProperties(ctx interface{}, entityID interface{}, properties interface{}) *InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call {
+	return &InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call{Call: _e.mock.On("UpdateInboundClientProperties", ctx, entityID, properties)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call) Run(run func(ctx context.Context, entityID string, properties map[string]interface{})) *InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]interface{}
+		if args[2] != nil {
+			arg2 = args[2].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call) Return(err error) *InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call) RunAndReturn(run func(ctx context.Context, entityID string, properties map[string]interface{}) error) *InboundClientServiceInterfaceMock_UpdateInboundClientProperties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Validate provides a mock function for the type InboundClientServiceInterfaceMock
 func (_mock *InboundClientServiceInterfaceMock) Validate(ctx context.Context, client *model.InboundClient, oauthProfile *providers.OAuthProfile, hasClientSecret bool) error {
 	ret := _mock.Called(ctx, client, oauthProfile, hasClientSecret)
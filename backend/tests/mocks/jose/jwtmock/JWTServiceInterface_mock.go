@@ -143,6 +143,63 @@ func (_c *JWTServiceInterfaceMock_GenerateJWT_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// SetSigningKey provides a mock function for the type JWTServiceInterfaceMock
+func (_mock *JWTServiceInterfaceMock) SetSigningKey(ctx context.Context, keyID string) error {
+	ret := _mock.Called(ctx, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSigningKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// JWTServiceInterfaceMock_SetSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSigningKey'
+type JWTServiceInterfaceMock_SetSigningKey_Call struct {
+	*mock.Call
+}
+
+// SetSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyID string
+func (_e *JWTServiceInterfaceMock_Expecter) SetSigningKey(ctx interface{}, keyID interface{}) *JWTServiceInterfaceMock_SetSigningKey_Call {
+	return &JWTServiceInterfaceMock_SetSigningKey_Call{Call: _e.mock.On("SetSigningKey", ctx, keyID)}
+}
+
+func (_c *JWTServiceInterfaceMock_SetSigningKey_Call) Run(run func(ctx context.Context, keyID string)) *JWTServiceInterfaceMock_SetSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *JWTServiceInterfaceMock_SetSigningKey_Call) Return(err error) *JWTServiceInterfaceMock_SetSigningKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *JWTServiceInterfaceMock_SetSigningKey_Call) RunAndReturn(run func(ctx context.Context, keyID string) error) *JWTServiceInterfaceMock_SetSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyJWT provides a mock function for the type JWTServiceInterfaceMock
 func (_mock *JWTServiceInterfaceMock) VerifyJWT(ctx context.Context, jwtToken string, expectedAud string, expectedIss string) *common.ServiceError {
 	ret := _mock.Called(ctx, jwtToken, expectedAud, expectedIss)
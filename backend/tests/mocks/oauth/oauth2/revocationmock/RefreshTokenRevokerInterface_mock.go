@@ -9,6 +9,7 @@ import (
 	"time"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/revocation"
 )
 
 // NewRefreshTokenRevokerInterfaceMock creates a new instance of RefreshTokenRevokerInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -38,6 +39,63 @@ func (_m *RefreshTokenRevokerInterfaceMock) EXPECT() *RefreshTokenRevokerInterfa
 	return &RefreshTokenRevokerInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// RegisterRefreshToken provides a mock function for the type RefreshTokenRevokerInterfaceMock
+func (_mock *RefreshTokenRevokerInterfaceMock) RegisterRefreshToken(ctx context.Context, token revocation.IssuedRefreshToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterRefreshToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, revocation.IssuedRefreshToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterRefreshToken'
+type RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RegisterRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token revocation.IssuedRefreshToken
+func (_e *RefreshTokenRevokerInterfaceMock_Expecter) RegisterRefreshToken(ctx interface{}, token interface{}) *RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call {
+	return &RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call{Call: _e.mock.On("RegisterRefreshToken", ctx, token)}
+}
+
+func (_c *RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call) Run(run func(ctx context.Context, token revocation.IssuedRefreshToken)) *RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 revocation.IssuedRefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(revocation.IssuedRefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call) Return(err error) *RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call) RunAndReturn(run func(ctx context.Context, token revocation.IssuedRefreshToken) error) *RefreshTokenRevokerInterfaceMock_RegisterRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeRefreshToken provides a mock function for the type RefreshTokenRevokerInterfaceMock
 func (_mock *RefreshTokenRevokerInterfaceMock) RevokeRefreshToken(ctx context.Context, jti string, expiryTime time.Time) error {
 	ret := _mock.Called(ctx, jti, expiryTime)
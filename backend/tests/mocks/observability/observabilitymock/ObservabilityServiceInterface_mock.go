@@ -41,6 +41,63 @@ func (_m *ObservabilityServiceInterfaceMock) EXPECT() *ObservabilityServiceInter
 	return &ObservabilityServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// DeliverEvent provides a mock function for the type ObservabilityServiceInterfaceMock
+func (_mock *ObservabilityServiceInterfaceMock) DeliverEvent(ctx context.Context, evt *providers.Event) error {
+	ret := _mock.Called(ctx, evt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *providers.Event) error); ok {
+		r0 = returnFunc(ctx, evt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ObservabilityServiceInterfaceMock_DeliverEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliverEvent'
+type ObservabilityServiceInterfaceMock_DeliverEvent_Call struct {
+	*mock.Call
+}
+
+// DeliverEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - evt *providers.Event
+func (_e *ObservabilityServiceInterfaceMock_Expecter) DeliverEvent(ctx interface{}, evt interface{}) *ObservabilityServiceInterfaceMock_DeliverEvent_Call {
+	return &ObservabilityServiceInterfaceMock_DeliverEvent_Call{Call: _e.mock.On("DeliverEvent", ctx, evt)}
+}
+
+func (_c *ObservabilityServiceInterfaceMock_DeliverEvent_Call) Run(run func(ctx context.Context, evt *providers.Event)) *ObservabilityServiceInterfaceMock_DeliverEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *providers.Event
+		if args[1] != nil {
+			arg1 = args[1].(*providers.Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ObservabilityServiceInterfaceMock_DeliverEvent_Call) Return(err error) *ObservabilityServiceInterfaceMock_DeliverEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ObservabilityServiceInterfaceMock_DeliverEvent_Call) RunAndReturn(run func(ctx context.Context, evt *providers.Event) error) *ObservabilityServiceInterfaceMock_DeliverEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetActiveSubscribers provides a mock function for the type ObservabilityServiceInterfaceMock
 func (_mock *ObservabilityServiceInterfaceMock) GetActiveSubscribers() []subscriber.SubscriberInterface {
 	ret := _mock.Called()
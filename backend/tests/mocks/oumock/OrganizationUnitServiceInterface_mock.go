@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/job"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/common"
 	"github.com/thunder-id/thunderid/pkg/thunderidengine/providers"
@@ -226,6 +227,76 @@ func (_c *OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitByPath_Call
 	return _c
 }
 
+// DeleteOrganizationUnitSubtree provides a mock function for the type OrganizationUnitServiceInterfaceMock
+func (_mock *OrganizationUnitServiceInterfaceMock) DeleteOrganizationUnitSubtree(ctx context.Context, id string) (*job.Job, *common.ServiceError) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationUnitSubtree")
+	}
+
+	var r0 *job.Job
+	var r1 *common.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*job.Job, *common.ServiceError)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *job.Job); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*job.Job)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *common.ServiceError); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*common.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationUnitSubtree'
+type OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationUnitSubtree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *OrganizationUnitServiceInterfaceMock_Expecter) DeleteOrganizationUnitSubtree(ctx interface{}, id interface{}) *OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call {
+	return &OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call{Call: _e.mock.On("DeleteOrganizationUnitSubtree", ctx, id)}
+}
+
+func (_c *OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call) Run(run func(ctx context.Context, id string)) *OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call) Return(job1 *job.Job, serviceError *common.ServiceError) *OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call {
+	_c.Call.Return(job1, serviceError)
+	return _c
+}
+
+func (_c *OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call) RunAndReturn(run func(ctx context.Context, id string) (*job.Job, *common.ServiceError)) *OrganizationUnitServiceInterfaceMock_DeleteOrganizationUnitSubtree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrganizationUnit provides a mock function for the type OrganizationUnitServiceInterfaceMock
 func (_mock *OrganizationUnitServiceInterfaceMock) GetOrganizationUnit(ctx context.Context, id string) (providers.OrganizationUnit, *common.ServiceError) {
 	ret := _mock.Called(ctx, id)